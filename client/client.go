@@ -0,0 +1,142 @@
+// Package client is a Go client for the paperless service. It sets up the gRPC connection,
+// sends the caller's tenant, user and roles with every call, and adds helpers for uploads,
+// resumable downloads and paging through lists.
+//
+//	c, err := client.Dial("paperless:9400",
+//		client.WithCertificateFiles("client.crt", "client.key", "ca.crt"),
+//		client.WithIdentity(tenantID, userID),
+//		client.WithContentEndpoint("https://paperless:9404"),
+//	)
+//	if err != nil {
+//		return err
+//	}
+//	defer c.Close()
+//
+//	for doc, err := range c.ListDocuments(ctx, &paperlessV1.ListDocumentsRequest{}) {
+//		...
+//	}
+package client
+
+import (
+	"fmt"
+	"net/http"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// Client is a connection to the paperless service with a typed client for each of its
+// gRPC services. It is safe for concurrent use.
+type Client struct {
+	// conn is the connection Dial opened, nil for clients on a connection of the caller
+	conn *grpc.ClientConn
+	opts options
+
+	// contentClient fetches from the content endpoint, nil when none is configured
+	contentClient *http.Client
+
+	Documents     paperlessV1.PaperlessDocumentServiceClient
+	Categories    paperlessV1.PaperlessCategoryServiceClient
+	Permissions   paperlessV1.PaperlessPermissionServiceClient
+	Tags          paperlessV1.PaperlessTagServiceClient
+	Reviews       paperlessV1.PaperlessReviewServiceClient
+	Signatures    paperlessV1.PaperlessSignatureServiceClient
+	Notifications paperlessV1.PaperlessNotificationServiceClient
+	Webhooks      paperlessV1.PaperlessWebhookServiceClient
+	Imports       paperlessV1.PaperlessImportServiceClient
+	Audit         paperlessV1.PaperlessAuditServiceClient
+	Statistics    paperlessV1.PaperlessStatisticsServiceClient
+	Quotas        paperlessV1.PaperlessQuotaServiceClient
+	Settings      paperlessV1.PaperlessSettingsServiceClient
+	Storage       paperlessV1.PaperlessStorageServiceClient
+	Tenants       paperlessV1.PaperlessTenantServiceClient
+	Backups       paperlessV1.BackupServiceClient
+	Health        paperlessV1.PaperlessHealthServiceClient
+}
+
+// Dial connects to the paperless gRPC service at target, e.g. "paperless:9400". The
+// connection is established lazily, on the first call.
+func Dial(target string, opts ...Option) (*Client, error) {
+	o, err := newOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	creds := insecure.NewCredentials()
+	if o.tlsConfig != nil {
+		creds = credentials.NewTLS(o.tlsConfig)
+	}
+
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallSendMsgSize(o.maxMessageSize),
+			grpc.MaxCallRecvMsgSize(o.maxMessageSize),
+		),
+	}
+	dialOpts = append(dialOpts, o.dialOptions...)
+
+	conn, err := grpc.NewClient(target, dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create paperless client: %w", err)
+	}
+
+	c := newClient(conn, o)
+	c.conn = conn
+	return c, nil
+}
+
+// New creates a Client on a connection of the caller, e.g. one shared with other services.
+// Close leaves the connection open.
+func New(cc grpc.ClientConnInterface, opts ...Option) (*Client, error) {
+	o, err := newOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	return newClient(cc, o), nil
+}
+
+func newClient(cc grpc.ClientConnInterface, o options) *Client {
+	cc = &identityConn{cc: cc, opts: &o}
+
+	c := &Client{
+		opts:          o,
+		Documents:     paperlessV1.NewPaperlessDocumentServiceClient(cc),
+		Categories:    paperlessV1.NewPaperlessCategoryServiceClient(cc),
+		Permissions:   paperlessV1.NewPaperlessPermissionServiceClient(cc),
+		Tags:          paperlessV1.NewPaperlessTagServiceClient(cc),
+		Reviews:       paperlessV1.NewPaperlessReviewServiceClient(cc),
+		Signatures:    paperlessV1.NewPaperlessSignatureServiceClient(cc),
+		Notifications: paperlessV1.NewPaperlessNotificationServiceClient(cc),
+		Webhooks:      paperlessV1.NewPaperlessWebhookServiceClient(cc),
+		Imports:       paperlessV1.NewPaperlessImportServiceClient(cc),
+		Audit:         paperlessV1.NewPaperlessAuditServiceClient(cc),
+		Statistics:    paperlessV1.NewPaperlessStatisticsServiceClient(cc),
+		Quotas:        paperlessV1.NewPaperlessQuotaServiceClient(cc),
+		Settings:      paperlessV1.NewPaperlessSettingsServiceClient(cc),
+		Storage:       paperlessV1.NewPaperlessStorageServiceClient(cc),
+		Tenants:       paperlessV1.NewPaperlessTenantServiceClient(cc),
+		Backups:       paperlessV1.NewBackupServiceClient(cc),
+		Health:        paperlessV1.NewPaperlessHealthServiceClient(cc),
+	}
+	if o.contentEndpoint != "" {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = o.tlsConfig
+		c.contentClient = &http.Client{Transport: transport}
+	}
+	return c
+}
+
+// Close closes the connection opened by Dial
+func (c *Client) Close() error {
+	if c.contentClient != nil {
+		c.contentClient.CloseIdleConnections()
+	}
+	if c.conn == nil {
+		return nil
+	}
+	return c.conn.Close()
+}
@@ -0,0 +1,14 @@
+package client
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// WithIdempotencyKey returns a context whose mutating calls carry key as their idempotency key.
+// The service applies a call at most once per key: retrying it with the same key returns the
+// response of the first attempt instead of applying it again. Use one key per operation.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "idempotency-key", key)
+}
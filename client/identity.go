@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-tangra/go-tangra-common/grpcx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// identity is who a call is made for: the platform sends it as x-md-global-* metadata
+type identity struct {
+	tenantID uint32
+	userID   uint32
+	roles    []string
+}
+
+type identityKey struct{}
+
+// AsUser returns a context whose calls are made for userID in tenantID with roles, instead of
+// the identity given to WithIdentity. A userID of 0 sends no user.
+func AsUser(ctx context.Context, tenantID, userID uint32, roles ...string) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity{tenantID: tenantID, userID: userID, roles: roles})
+}
+
+// identityFor returns the identity of a call: the one of its context, or the default
+func (o *options) identityFor(ctx context.Context) identity {
+	if id, ok := ctx.Value(identityKey{}).(identity); ok {
+		return id
+	}
+	return o.identity
+}
+
+// headers returns the metadata carrying the identity
+func (id identity) headers() map[string]string {
+	h := make(map[string]string, 3)
+	if id.tenantID != 0 {
+		h[grpcx.MDTenantID] = strconv.FormatUint(uint64(id.tenantID), 10)
+	}
+	if id.userID != 0 {
+		h[grpcx.MDUserID] = strconv.FormatUint(uint64(id.userID), 10)
+	}
+	if len(id.roles) > 0 {
+		h[grpcx.MDRoles] = strings.Join(id.roles, ",")
+	}
+	return h
+}
+
+// setHTTPHeaders adds the identity to the headers of a content request
+func (id identity) setHTTPHeaders(h http.Header) {
+	for k, v := range id.headers() {
+		h.Set(k, v)
+	}
+}
+
+// outgoingContext adds the identity to the outgoing metadata, keeping metadata the caller set
+func (o *options) outgoingContext(ctx context.Context) context.Context {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	for k, v := range o.identityFor(ctx).headers() {
+		if len(md.Get(k)) == 0 {
+			md.Set(k, v)
+		}
+	}
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// identityConn sends the identity with every call on a connection
+type identityConn struct {
+	cc   grpc.ClientConnInterface
+	opts *options
+}
+
+func (c *identityConn) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	return c.cc.Invoke(c.opts.outgoingContext(ctx), method, args, reply, opts...)
+}
+
+func (c *identityConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return c.cc.NewStream(c.opts.outgoingContext(ctx), desc, method, opts...)
+}
@@ -0,0 +1,116 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/grpc"
+)
+
+// defaultMaxMessageSize bounds gRPC messages, and with them uploads and RPC downloads. The
+// server accepts the gRPC default of 4 MB unless configured otherwise.
+const defaultMaxMessageSize = 4 << 20
+
+// Option configures a Client
+type Option func(*options)
+
+type options struct {
+	tlsConfig       *tls.Config
+	identity        identity
+	contentEndpoint string
+	maxMessageSize  int
+	dialOptions     []grpc.DialOption
+
+	// err is the first error of an option, returned by Dial and New
+	err error
+}
+
+func newOptions(opts []Option) (options, error) {
+	o := options{maxMessageSize: defaultMaxMessageSize}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.err != nil {
+		return options{}, o.err
+	}
+	o.contentEndpoint = strings.TrimRight(o.contentEndpoint, "/")
+	return o, nil
+}
+
+// WithTLSConfig connects over TLS with cfg. Without TLS options the connection is plaintext,
+// for servers running without TLS.
+func WithTLSConfig(cfg *tls.Config) Option {
+	return func(o *options) {
+		o.tlsConfig = cfg.Clone()
+	}
+}
+
+// WithCertificateFiles connects over mutual TLS with the client certificate and key in
+// certFile and keyFile, trusting the server certificates issued by the CA in caFile
+func WithCertificateFiles(certFile, keyFile, caFile string) Option {
+	return func(o *options) {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			o.setErr(fmt.Errorf("failed to load client certificate: %w", err))
+			return
+		}
+		caPEM, err := os.ReadFile(caFile)
+		if err != nil {
+			o.setErr(fmt.Errorf("failed to read CA certificate: %w", err))
+			return
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			o.setErr(errors.New("no CA certificates found in " + caFile))
+			return
+		}
+		o.tlsConfig = &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			RootCAs:      pool,
+			MinVersion:   tls.VersionTLS12,
+		}
+	}
+}
+
+// WithIdentity sends tenantID, userID and roles with every call that doesn't set its own with
+// AsUser. A userID of 0 sends no user, e.g. for service accounts.
+func WithIdentity(tenantID, userID uint32, roles ...string) Option {
+	return func(o *options) {
+		o.identity = identity{tenantID: tenantID, userID: userID, roles: roles}
+	}
+}
+
+// WithContentEndpoint sets the base URL of the content endpoint, e.g. "https://paperless:9404".
+// Downloads then stream from it and resume where they broke off; without it they go through
+// the DownloadDocument RPC, which is limited to the maximum message size.
+func WithContentEndpoint(url string) Option {
+	return func(o *options) {
+		o.contentEndpoint = url
+	}
+}
+
+// WithMaxMessageSize sets the largest gRPC message sent or received, and with it the largest
+// file Upload accepts (default 4 MB). The server must accept messages of this size too.
+func WithMaxMessageSize(n int) Option {
+	return func(o *options) {
+		o.maxMessageSize = n
+	}
+}
+
+// WithDialOptions adds gRPC dial options, e.g. interceptors or keepalive parameters. They
+// apply only to connections opened by Dial.
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return func(o *options) {
+		o.dialOptions = append(o.dialOptions, opts...)
+	}
+}
+
+func (o *options) setErr(err error) {
+	if o.err == nil {
+		o.err = err
+	}
+}
@@ -0,0 +1,156 @@
+package client
+
+import (
+	"context"
+	"iter"
+
+	"google.golang.org/protobuf/proto"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// maxIterPageSize is the page size of iterators whose request doesn't set a smaller one. No
+// list caps pages below it, so every page but the last is full.
+const maxIterPageSize = 100
+
+// paginate yields the items of consecutive pages, starting at page and stopping after the
+// total or at the first empty page. An error is yielded once and ends the iteration.
+func paginate[T any](page, pageSize uint32, fetch func(page, pageSize uint32) ([]T, uint32, error)) iter.Seq2[T, error] {
+	if page == 0 {
+		page = 1
+	}
+	if pageSize == 0 || pageSize > maxIterPageSize {
+		pageSize = maxIterPageSize
+	}
+
+	return func(yield func(T, error) bool) {
+		for page := page; ; page++ {
+			items, total, err := fetch(page, pageSize)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if len(items) == 0 || (page-1)*pageSize+uint32(len(items)) >= total {
+				return
+			}
+		}
+	}
+}
+
+// ListDocuments iterates over the documents matching req, from its page on (default the first)
+func (c *Client) ListDocuments(ctx context.Context, req *paperlessV1.ListDocumentsRequest) iter.Seq2[*paperlessV1.Document, error] {
+	req = proto.CloneOf(req)
+	return paginate(req.GetPage(), req.GetPageSize(), func(page, pageSize uint32) ([]*paperlessV1.Document, uint32, error) {
+		req := proto.CloneOf(req)
+		req.Page, req.PageSize = &page, &pageSize
+		resp, err := c.Documents.ListDocuments(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Documents, resp.Total, nil
+	})
+}
+
+// SearchDocuments iterates over the documents matching a search
+func (c *Client) SearchDocuments(ctx context.Context, req *paperlessV1.SearchDocumentsRequest) iter.Seq2[*paperlessV1.Document, error] {
+	req = proto.CloneOf(req)
+	return paginate(req.GetPage(), req.GetPageSize(), func(page, pageSize uint32) ([]*paperlessV1.Document, uint32, error) {
+		req := proto.CloneOf(req)
+		req.Page, req.PageSize = &page, &pageSize
+		resp, err := c.Documents.SearchDocuments(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Documents, resp.Total, nil
+	})
+}
+
+// ListMyInbox iterates over the documents assigned to the caller
+func (c *Client) ListMyInbox(ctx context.Context, req *paperlessV1.ListMyInboxRequest) iter.Seq2[*paperlessV1.Document, error] {
+	req = proto.CloneOf(req)
+	return paginate(req.GetPage(), req.GetPageSize(), func(page, pageSize uint32) ([]*paperlessV1.Document, uint32, error) {
+		req := proto.CloneOf(req)
+		req.Page, req.PageSize = &page, &pageSize
+		resp, err := c.Documents.ListMyInbox(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Documents, resp.Total, nil
+	})
+}
+
+// ListCategories iterates over the categories matching req
+func (c *Client) ListCategories(ctx context.Context, req *paperlessV1.ListCategoriesRequest) iter.Seq2[*paperlessV1.Category, error] {
+	req = proto.CloneOf(req)
+	return paginate(req.GetPage(), req.GetPageSize(), func(page, pageSize uint32) ([]*paperlessV1.Category, uint32, error) {
+		req := proto.CloneOf(req)
+		req.Page, req.PageSize = &page, &pageSize
+		resp, err := c.Categories.ListCategories(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Categories, resp.Total, nil
+	})
+}
+
+// ListTags iterates over the tenant's tags matching req
+func (c *Client) ListTags(ctx context.Context, req *paperlessV1.ListTagsRequest) iter.Seq2[*paperlessV1.Tag, error] {
+	req = proto.CloneOf(req)
+	return paginate(req.GetPage(), req.GetPageSize(), func(page, pageSize uint32) ([]*paperlessV1.Tag, uint32, error) {
+		req := proto.CloneOf(req)
+		req.Page, req.PageSize = &page, &pageSize
+		resp, err := c.Tags.ListTags(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Tags, resp.Total, nil
+	})
+}
+
+// ListPermissions iterates over the permissions matching req
+func (c *Client) ListPermissions(ctx context.Context, req *paperlessV1.ListPermissionsRequest) iter.Seq2[*paperlessV1.PermissionTuple, error] {
+	req = proto.CloneOf(req)
+	return paginate(req.GetPage(), req.GetPageSize(), func(page, pageSize uint32) ([]*paperlessV1.PermissionTuple, uint32, error) {
+		req := proto.CloneOf(req)
+		req.Page, req.PageSize = &page, &pageSize
+		resp, err := c.Permissions.ListPermissions(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Permissions, resp.Total, nil
+	})
+}
+
+// ListDocumentReviews iterates over the reviews of a document, newest first
+func (c *Client) ListDocumentReviews(ctx context.Context, req *paperlessV1.ListDocumentReviewsRequest) iter.Seq2[*paperlessV1.ReviewTask, error] {
+	req = proto.CloneOf(req)
+	return paginate(req.GetPage(), req.GetPageSize(), func(page, pageSize uint32) ([]*paperlessV1.ReviewTask, uint32, error) {
+		req := proto.CloneOf(req)
+		req.Page, req.PageSize = &page, &pageSize
+		resp, err := c.Reviews.ListDocumentReviews(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Reviews, resp.Total, nil
+	})
+}
+
+// ListAuditEvents iterates over the audit events matching req
+func (c *Client) ListAuditEvents(ctx context.Context, req *paperlessV1.ListAuditEventsRequest) iter.Seq2[*paperlessV1.AuditEvent, error] {
+	req = proto.CloneOf(req)
+	return paginate(req.GetPage(), req.GetPageSize(), func(page, pageSize uint32) ([]*paperlessV1.AuditEvent, uint32, error) {
+		req := proto.CloneOf(req)
+		req.Page, req.PageSize = &page, &pageSize
+		resp, err := c.Audit.ListAuditEvents(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Events, resp.Total, nil
+	})
+}
@@ -0,0 +1,182 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+const (
+	// uploadOverhead leaves room in an upload message for the fields besides the file
+	uploadOverhead = 64 << 10
+
+	// maxDownloadResumes bounds how often a broken-off download is resumed
+	maxDownloadResumes = 3
+)
+
+var (
+	// ErrFileTooLarge is returned by Upload for files larger than the maximum message size allows
+	ErrFileTooLarge = errors.New("file exceeds the maximum message size")
+
+	// ErrContentChanged is returned by Download when the file changed while it was resumed
+	ErrContentChanged = errors.New("document content changed during download")
+)
+
+// Upload creates a document from the file read from r, with the name, category and other
+// fields of req; req itself is left unchanged. The API takes a file in one message, so r is
+// read into memory, and files larger than the maximum message size fail with ErrFileTooLarge
+// before anything is sent.
+func (c *Client) Upload(ctx context.Context, req *paperlessV1.CreateDocumentRequest, r io.Reader) (*paperlessV1.Document, error) {
+	limit := int64(c.opts.maxMessageSize - uploadOverhead)
+	var buf bytes.Buffer
+	n, err := io.Copy(&buf, io.LimitReader(r, limit+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	if n > limit {
+		return nil, ErrFileTooLarge
+	}
+
+	req = proto.CloneOf(req)
+	req.FileContent = buf.Bytes()
+
+	resp, err := c.Documents.CreateDocument(ctx, req, grpc.MaxCallSendMsgSize(c.opts.maxMessageSize))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Document, nil
+}
+
+// UploadFile creates a document from the file at path, as Upload does. The file name
+// defaults to the base name of path, and the document name to the file name.
+func (c *Client) UploadFile(ctx context.Context, req *paperlessV1.CreateDocumentRequest, path string) (*paperlessV1.Document, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	req = proto.CloneOf(req)
+	if req.FileName == "" {
+		req.FileName = filepath.Base(path)
+	}
+	if req.Name == "" {
+		req.Name = req.FileName
+	}
+	return c.Upload(ctx, req, f)
+}
+
+// Download writes the file of document id to w and returns the bytes written. With a content
+// endpoint the file is streamed, and a stream that breaks off is resumed where it stopped;
+// otherwise it is fetched whole through the DownloadDocument RPC.
+func (c *Client) Download(ctx context.Context, id string, w io.Writer) (int64, error) {
+	if c.contentClient == nil {
+		resp, err := c.Documents.DownloadDocument(ctx, &paperlessV1.DownloadDocumentRequest{Id: id},
+			grpc.MaxCallRecvMsgSize(c.opts.maxMessageSize))
+		if err != nil {
+			return 0, err
+		}
+		n, err := w.Write(resp.Content)
+		return int64(n), err
+	}
+
+	var written int64
+	etag := ""
+	for attempt := 0; ; attempt++ {
+		n, tag, err := c.downloadContent(ctx, id, w, written, etag)
+		written += n
+		if tag != "" {
+			etag = tag
+		}
+
+		var streamErr *contentStreamError
+		if err == nil || !errors.As(err, &streamErr) || ctx.Err() != nil || attempt >= maxDownloadResumes {
+			return written, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return written, ctx.Err()
+		case <-time.After(time.Duration(attempt+1) * time.Second):
+		}
+	}
+}
+
+// contentStreamError is a download that broke off after its response started, so it can
+// be resumed
+type contentStreamError struct {
+	err error
+}
+
+func (e *contentStreamError) Error() string { return e.err.Error() }
+func (e *contentStreamError) Unwrap() error { return e.err }
+
+// downloadContent fetches a file from the content endpoint from offset on, provided it still
+// has entity tag etag, and returns the bytes written and the file's entity tag
+func (c *Client) downloadContent(ctx context.Context, id string, w io.Writer, offset int64, etag string) (int64, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		c.opts.contentEndpoint+"/v1/documents/"+url.PathEscape(id)+"/content", nil)
+	if err != nil {
+		return 0, "", err
+	}
+	c.opts.identityFor(ctx).setHTTPHeaders(req.Header)
+	if offset > 0 {
+		req.Header.Set("Range", "bytes="+strconv.FormatInt(offset, 10)+"-")
+		if etag != "" {
+			req.Header.Set("If-Range", etag)
+		}
+	}
+
+	resp, err := c.contentClient.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusOK && offset > 0:
+		// The server sent the whole file instead of the rest, so it changed since the last attempt
+		return 0, "", ErrContentChanged
+	case resp.StatusCode == http.StatusOK, resp.StatusCode == http.StatusPartialContent:
+	case resp.StatusCode == http.StatusRequestedRangeNotSatisfiable && offset > 0:
+		// Everything was written before the stream broke off
+		return 0, etag, nil
+	default:
+		return 0, "", contentError(resp)
+	}
+
+	n, err := io.Copy(w, resp.Body)
+	if err == nil && resp.ContentLength >= 0 && n < resp.ContentLength {
+		err = io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return n, resp.Header.Get("ETag"), &contentStreamError{err: err}
+	}
+	return n, resp.Header.Get("ETag"), nil
+}
+
+// contentError converts an error response of the content endpoint to the service error it
+// carries
+func contentError(resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	se := &kerrors.Error{}
+	if err := json.Unmarshal(body, se); err != nil || se.Reason == "" {
+		return kerrors.New(resp.StatusCode, kerrors.UnknownReason, fmt.Sprintf("content endpoint returned status %d: %s", resp.StatusCode, string(body)))
+	}
+	return se
+}
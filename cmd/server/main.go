@@ -6,6 +6,7 @@ import (
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"github.com/spf13/cobra"
 
 	conf "github.com/tx7do/kratos-bootstrap/api/gen/go/conf/v1"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
@@ -13,6 +14,8 @@ import (
 	"github.com/go-tangra/go-tangra-common/registration"
 	"github.com/go-tangra/go-tangra-common/service"
 	"github.com/go-tangra/go-tangra-paperless/cmd/server/assets"
+	"github.com/go-tangra/go-tangra-paperless/internal/server"
+	paperlessService "github.com/go-tangra/go-tangra-paperless/internal/service"
 )
 
 var (
@@ -31,6 +34,24 @@ var globalRegHelper *registration.RegistrationHelper
 func newApp(
 	ctx *bootstrap.Context,
 	gs *grpc.Server,
+	ms *server.MetricsServer,
+	gws *server.GRPCWebServer,
+	cs *server.ContentServer,
+	lfs *server.LocalFileServer,
+	gqls *server.GraphQLServer,
+	signatureCallbacks *server.SignatureCallbackServer,
+	processor *paperlessService.DocumentProcessor,
+	gc *paperlessService.StorageGC,
+	tiering *paperlessService.StorageTiering,
+	retention *paperlessService.AuditRetention,
+	webhooks *paperlessService.WebhookDispatcher,
+	imports *paperlessService.ImportSyncer,
+	groups *paperlessService.GroupSyncer,
+	categoryCounts *paperlessService.CategoryCountRepair,
+	categoryDeletes *paperlessService.CategoryDeleteWorker,
+	tenantDeletes *paperlessService.TenantDeleteWorker,
+	dueDates *paperlessService.DueDateReminder,
+	statistics *paperlessService.StatisticsAggregator,
 ) *kratos.App {
 	globalRegHelper = registration.StartRegistration(ctx, ctx.GetLogger(), &registration.Config{
 		ModuleID:          moduleID,
@@ -47,7 +68,7 @@ func newApp(
 		MaxRetries:        60,
 	})
 
-	return bootstrap.NewApp(ctx, gs)
+	return bootstrap.NewApp(ctx, gs, gws, cs, lfs, gqls, ms, signatureCallbacks, processor, gc, tiering, retention, webhooks, imports, groups, categoryCounts, categoryDeletes, tenantDeletes, dueDates, statistics)
 }
 
 func runApp() error {
@@ -63,7 +84,9 @@ func runApp() error {
 	// Ensure registration cleanup on exit
 	defer globalRegHelper.Stop()
 
-	return bootstrap.RunApp(ctx, initApp)
+	return bootstrap.RunApp(ctx, initApp, func(root *cobra.Command) {
+		root.AddCommand(newMigrateCmd())
+	})
 }
 
 func main() {
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/spf13/cobra"
+
+	bConfig "github.com/tx7do/kratos-bootstrap/config"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data"
+)
+
+// newMigrateCmd creates the "migrate" command that manages the versioned database
+// migrations independently of starting the server
+func newMigrateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Manage versioned database migrations",
+	}
+
+	cmd.AddCommand(
+		&cobra.Command{
+			Use:   "up [N]",
+			Short: "Apply all pending migrations, or the next N",
+			Args:  cobra.MaximumNArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				steps, err := stepsArg(args, 0)
+				if err != nil {
+					return err
+				}
+				return withMigrator(cmd, func(ctx context.Context, m *data.Migrator) error {
+					applied, err := m.Up(ctx, steps)
+					if err != nil {
+						return err
+					}
+					fmt.Printf("applied %d migrations\n", applied)
+					return nil
+				})
+			},
+		},
+		&cobra.Command{
+			Use:   "down [N]",
+			Short: "Revert the last N applied migrations (default 1)",
+			Args:  cobra.MaximumNArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				steps, err := stepsArg(args, 1)
+				if err != nil {
+					return err
+				}
+				return withMigrator(cmd, func(ctx context.Context, m *data.Migrator) error {
+					reverted, err := m.Down(ctx, steps)
+					if err != nil {
+						return err
+					}
+					fmt.Printf("reverted %d migrations\n", reverted)
+					return nil
+				})
+			},
+		},
+		&cobra.Command{
+			Use:   "status",
+			Short: "Show the applied and pending migrations",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return withMigrator(cmd, func(ctx context.Context, m *data.Migrator) error {
+					status, err := m.Status(ctx)
+					if err != nil {
+						return err
+					}
+					fmt.Printf("version: %d (latest %d)\n", status.Version, status.Latest)
+					if status.Dirty {
+						fmt.Println("dirty: true")
+					}
+					for _, name := range status.Pending {
+						fmt.Printf("pending: %s\n", name)
+					}
+					return nil
+				})
+			},
+		},
+		&cobra.Command{
+			Use:   "force VERSION",
+			Short: "Record VERSION as applied without running migrations (-1 clears it)",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				version, err := strconv.ParseInt(args[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid version %q", args[0])
+				}
+				return withMigrator(cmd, func(ctx context.Context, m *data.Migrator) error {
+					if err := m.Force(ctx, version); err != nil {
+						return err
+					}
+					fmt.Printf("forced version %d\n", version)
+					return nil
+				})
+			},
+		},
+	)
+
+	return cmd
+}
+
+func stepsArg(args []string, def int) (int, error) {
+	if len(args) == 0 {
+		return def, nil
+	}
+	steps, err := strconv.Atoi(args[0])
+	if err != nil || steps < 1 {
+		return 0, fmt.Errorf("invalid step count %q", args[0])
+	}
+	return steps, nil
+}
+
+// withMigrator loads the bootstrap config from --conf, connects to the configured
+// database and runs fn with a Migrator
+func withMigrator(cmd *cobra.Command, fn func(ctx context.Context, m *data.Migrator) error) error {
+	confPath, err := cmd.Flags().GetString("conf")
+	if err != nil {
+		return err
+	}
+	if err = bConfig.LoadBootstrapConfig(confPath); err != nil {
+		return err
+	}
+	cfg := bConfig.GetBootstrapConfig()
+	if cfg == nil || cfg.Data == nil || cfg.Data.Database == nil {
+		return fmt.Errorf("no database configured in %s", confPath)
+	}
+
+	driver := cfg.Data.Database.GetDriver()
+	if driver != "postgres" && driver != "pgx" {
+		return fmt.Errorf("versioned migrations require PostgreSQL, got driver %q", driver)
+	}
+
+	db, err := sql.Open(driver, cfg.Data.Database.GetSource())
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	m, err := data.NewMigrator(db, log.NewHelper(log.With(log.DefaultLogger, "module", "paperless/migrate")))
+	if err != nil {
+		return err
+	}
+	return fn(cmd.Context(), m)
+}
@@ -28,42 +28,190 @@ func initApp(context *bootstrap.Context) (*kratos.App, func(), error) {
 	if err != nil {
 		return nil, nil, err
 	}
-	auditLogRepo := data.NewAuditLogRepo(context, entClient)
-	categoryRepo := data.NewCategoryRepo(context, entClient)
-	permissionRepo := data.NewPermissionRepo(context, entClient)
+	accessIndexRepo := data.NewAccessIndexRepo(context, entClient)
+	permissionRepo := data.NewPermissionRepo(context, entClient, accessIndexRepo)
 	permissionStore := providers.ProvidePermissionStore(permissionRepo)
-	documentRepo := data.NewDocumentRepo(context, entClient, categoryRepo)
-	resourceLookup := providers.ProvideResourceLookup(categoryRepo, documentRepo)
-	engine := providers.ProvideAuthzEngine(permissionStore, resourceLookup, context)
+	client, cleanup2, err := data.NewRedisClient(context)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	idGenerator := data.NewIDGenerator(context)
+	settingRepo := data.NewSettingRepo(context, entClient)
+	categoryRepo := data.NewCategoryRepo(context, entClient, client, accessIndexRepo, idGenerator, settingRepo)
+	readReplica, cleanup3, err := data.NewReadReplica(context, entClient)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	tenantSettingsRepo := data.NewTenantSettingsRepo(context, entClient)
+	documentRepo := data.NewDocumentRepo(context, entClient, readReplica, categoryRepo, accessIndexRepo, tenantSettingsRepo)
+	groupRepo := data.NewGroupRepo(context, entClient)
+	resourceLookup := providers.ProvideResourceLookup(categoryRepo, documentRepo, groupRepo)
+	accessIndex := providers.ProvideAccessIndex(accessIndexRepo)
+	engine, err := providers.ProvideAuthzEngine(permissionStore, resourceLookup, accessIndex, context)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	auditLogRepo := data.NewAuditLogRepo(context, entClient)
+	auditEventRepo := data.NewAuditEventRepo(context, entClient)
+	categoryDeleteJobRepo := data.NewCategoryDeleteJobRepo(context, entClient, idGenerator)
+	documentHistoryRepo := data.NewDocumentHistoryRepo(context, entClient)
+	outboxRepo := data.NewOutboxRepo(context, entClient)
+	eventPublisher, cleanup4, err := data.NewEventPublisher(context, outboxRepo)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	transaction := data.NewTransaction(context, entClient)
+	storageRouter, cleanup5, err := data.NewStorageRouter(context, settingRepo, tenantSettingsRepo)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	tenantKeyRepo := data.NewTenantKeyRepo(context, entClient)
+	storage, err := data.NewStorage(context, storageRouter, tenantKeyRepo)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	tikaClient, cleanup6, err := data.NewTikaClient(context)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	gotenbergClient, cleanup7, err := data.NewGotenbergClient(context)
+	if err != nil {
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	antivirusScanner, err := data.NewAntivirusScanner(context)
+	if err != nil {
+		cleanup7()
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	documentProcessor := service.NewDocumentProcessor(context, tikaClient, gotenbergClient, documentRepo, tenantSettingsRepo, eventPublisher, antivirusScanner, storage)
 	checker := providers.ProvideAuthzChecker(engine)
-	categoryService := service.NewCategoryService(context, categoryRepo, permissionRepo, checker)
-	storageClient, cleanup2, err := data.NewStorageClient(context)
+	categoryService := service.NewCategoryService(context, categoryRepo, permissionRepo, auditEventRepo, categoryDeleteJobRepo, documentRepo, documentHistoryRepo, eventPublisher, transaction, storage, documentProcessor, idGenerator, checker, engine)
+	tenantQuotaRepo := data.NewTenantQuotaRepo(context, entClient)
+	storageTiering := service.NewStorageTiering(context, storageRouter, documentRepo)
+	notificationClient, cleanup8 := data.NewNotificationClient(context)
+	notificationPreferenceRepo := data.NewNotificationPreferenceRepo(context, entClient)
+	notificationService := service.NewNotificationService(context, notificationClient, notificationPreferenceRepo, documentRepo, categoryRepo)
+	documentService := service.NewDocumentService(context, documentRepo, categoryRepo, tenantQuotaRepo, tenantSettingsRepo, permissionRepo, auditEventRepo, documentHistoryRepo, eventPublisher, transaction, storage, documentProcessor, storageTiering, notificationService, checker, idGenerator)
+	permissionService := service.NewPermissionService(context, permissionRepo, auditEventRepo, eventPublisher, transaction, engine, notificationService)
+	statisticsRepo := data.NewStatisticsRepo(context, entClient, readReplica)
+	statisticsService := service.NewStatisticsService(context, statisticsRepo, documentProcessor)
+	backupService := service.NewBackupService(context, entClient, engine, accessIndexRepo, documentRepo, storage, idGenerator)
+	storageGC := service.NewStorageGC(context, storage, documentRepo)
+	storageMigrator := service.NewStorageMigrator(context, storageRouter, documentRepo)
+	storageService := service.NewStorageService(context, storageGC, storageMigrator)
+	tenantDeleteJobRepo := data.NewTenantDeleteJobRepo(context, entClient, idGenerator)
+	tenantDataRepo := data.NewTenantDataRepo(context, entClient)
+	tenantService := service.NewTenantService(context, tenantDeleteJobRepo, tenantDataRepo)
+	quotaService := service.NewQuotaService(context, tenantQuotaRepo)
+	settingsService := service.NewSettingsService(context, tenantSettingsRepo, storageRouter)
+	auditService := service.NewAuditService(context, auditEventRepo, engine, checker)
+	healthService := service.NewHealthService(context, entClient, storageRouter, tikaClient, gotenbergClient)
+	webhookRepo := data.NewWebhookRepo(context, entClient, idGenerator)
+	webhookService := service.NewWebhookService(context, webhookRepo)
+	importRepo := data.NewImportRepo(context, entClient, idGenerator)
+	importSyncer := service.NewImportSyncer(context, importRepo, documentService)
+	importService := service.NewImportService(context, importRepo, categoryRepo, importSyncer)
+	signatureRepo := data.NewSignatureRepo(context, entClient, idGenerator)
+	signatureProvider, err := data.NewSignatureProvider(context)
 	if err != nil {
+		cleanup8()
+		cleanup7()
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	tikaClient, cleanup3, err := data.NewTikaClient(context)
+	signatureService := service.NewSignatureService(context, signatureRepo, documentRepo, auditEventRepo, eventPublisher, transaction, storage, documentProcessor, checker, signatureProvider)
+	reviewRepo := data.NewReviewRepo(context, entClient, idGenerator)
+	reviewService := service.NewReviewService(context, reviewRepo, documentRepo, auditEventRepo, notificationService, checker)
+	tagRepo := data.NewTagRepo(context, entClient)
+	tagService := service.NewTagService(context, tagRepo, transaction)
+	rateLimiter := server.NewRateLimiter(context)
+	idempotencyRepo := data.NewIdempotencyRepo(context, entClient)
+	idempotency := server.NewIdempotency(context, idempotencyRepo)
+	grpcServer := server.NewGRPCServer(context, certManager, engine, auditLogRepo, categoryService, documentService, permissionService, statisticsService, backupService, storageService, tenantService, quotaService, settingsService, auditService, healthService, webhookService, importService, signatureService, reviewService, notificationService, tagService, rateLimiter, idempotency)
+	metricsServer := server.NewMetricsServer(context)
+	grpcWebServer := server.NewGRPCWebServer(context, grpcServer, certManager)
+	contentServer := server.NewContentServer(context, certManager, engine, documentService, rateLimiter)
+	localFileServer := server.NewLocalFileServer(context, storageRouter)
+	graphQLService, err := service.NewGraphQLService(context, documentService, categoryService, tagService, permissionService, reviewService)
 	if err != nil {
+		cleanup8()
+		cleanup7()
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	gotenbergClient, cleanup4, err := data.NewGotenbergClient(context)
+	graphQLServer := server.NewGraphQLServer(context, certManager, engine, auditLogRepo, graphQLService, rateLimiter)
+	signatureCallbackServer := server.NewSignatureCallbackServer(context, signatureService)
+	auditRetention := service.NewAuditRetention(context, auditEventRepo, auditLogRepo)
+	webhookDispatcher := service.NewWebhookDispatcher(context, webhookRepo, eventPublisher)
+	groupDirectory, err := data.NewGroupDirectory(context)
 	if err != nil {
+		cleanup8()
+		cleanup7()
+		cleanup6()
+		cleanup5()
+		cleanup4()
 		cleanup3()
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	documentProcessor := service.NewDocumentProcessor(context, tikaClient, gotenbergClient, documentRepo)
-	documentService := service.NewDocumentService(context, documentRepo, categoryRepo, permissionRepo, storageClient, documentProcessor, checker)
-	permissionService := service.NewPermissionService(context, permissionRepo, engine)
-	statisticsRepo := data.NewStatisticsRepo(context, entClient)
-	statisticsService := service.NewStatisticsService(context, statisticsRepo)
-	backupService := service.NewBackupService(context, entClient)
-	grpcServer := server.NewGRPCServer(context, certManager, auditLogRepo, categoryService, documentService, permissionService, statisticsService, backupService)
-	app := newApp(context, grpcServer)
+	groupSyncer := service.NewGroupSyncer(context, groupRepo, transaction, groupDirectory)
+	categoryCountRepair := service.NewCategoryCountRepair(context, categoryRepo, transaction)
+	categoryDeleteWorker := service.NewCategoryDeleteWorker(context, categoryDeleteJobRepo, categoryRepo, documentRepo, permissionRepo, documentHistoryRepo, eventPublisher, transaction)
+	tenantDeleteWorker := service.NewTenantDeleteWorker(context, tenantDeleteJobRepo, tenantDataRepo, storage, transaction)
+	dueDateReminder := service.NewDueDateReminder(context, documentRepo, permissionRepo, notificationService, checker)
+	statisticsAggregator := service.NewStatisticsAggregator(context, statisticsService)
+	app := newApp(context, grpcServer, metricsServer, grpcWebServer, contentServer, localFileServer, graphQLServer, signatureCallbackServer, documentProcessor, storageGC, storageTiering, auditRetention, webhookDispatcher, importSyncer, groupSyncer, categoryCountRepair, categoryDeleteWorker, tenantDeleteWorker, dueDateReminder, statisticsAggregator)
 	return app, func() {
+		cleanup8()
+		cleanup7()
+		cleanup6()
+		cleanup5()
 		cleanup4()
 		cleanup3()
 		cleanup2()
@@ -7,6 +7,7 @@
 package paperlesspb
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -28,6 +29,8 @@ type RestoreMode int32
 const (
 	RestoreMode_RESTORE_MODE_SKIP      RestoreMode = 0
 	RestoreMode_RESTORE_MODE_OVERWRITE RestoreMode = 1
+	// Restore every entity under a new ID next to existing data, remapping references
+	RestoreMode_RESTORE_MODE_DUPLICATE RestoreMode = 2
 )
 
 // Enum value maps for RestoreMode.
@@ -35,10 +38,12 @@ var (
 	RestoreMode_name = map[int32]string{
 		0: "RESTORE_MODE_SKIP",
 		1: "RESTORE_MODE_OVERWRITE",
+		2: "RESTORE_MODE_DUPLICATE",
 	}
 	RestoreMode_value = map[string]int32{
 		"RESTORE_MODE_SKIP":      0,
 		"RESTORE_MODE_OVERWRITE": 1,
+		"RESTORE_MODE_DUPLICATE": 2,
 	}
 )
 
@@ -69,9 +74,119 @@ func (RestoreMode) EnumDescriptor() ([]byte, []int) {
 	return file_paperless_service_v1_backup_proto_rawDescGZIP(), []int{0}
 }
 
+// Entity types that can be selected for export
+type BackupEntityType int32
+
+const (
+	BackupEntityType_BACKUP_ENTITY_TYPE_UNSPECIFIED          BackupEntityType = 0
+	BackupEntityType_BACKUP_ENTITY_TYPE_CATEGORIES           BackupEntityType = 1
+	BackupEntityType_BACKUP_ENTITY_TYPE_DOCUMENTS            BackupEntityType = 2
+	BackupEntityType_BACKUP_ENTITY_TYPE_DOCUMENT_PERMISSIONS BackupEntityType = 3
+)
+
+// Enum value maps for BackupEntityType.
+var (
+	BackupEntityType_name = map[int32]string{
+		0: "BACKUP_ENTITY_TYPE_UNSPECIFIED",
+		1: "BACKUP_ENTITY_TYPE_CATEGORIES",
+		2: "BACKUP_ENTITY_TYPE_DOCUMENTS",
+		3: "BACKUP_ENTITY_TYPE_DOCUMENT_PERMISSIONS",
+	}
+	BackupEntityType_value = map[string]int32{
+		"BACKUP_ENTITY_TYPE_UNSPECIFIED":          0,
+		"BACKUP_ENTITY_TYPE_CATEGORIES":           1,
+		"BACKUP_ENTITY_TYPE_DOCUMENTS":            2,
+		"BACKUP_ENTITY_TYPE_DOCUMENT_PERMISSIONS": 3,
+	}
+)
+
+func (x BackupEntityType) Enum() *BackupEntityType {
+	p := new(BackupEntityType)
+	*p = x
+	return p
+}
+
+func (x BackupEntityType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BackupEntityType) Descriptor() protoreflect.EnumDescriptor {
+	return file_paperless_service_v1_backup_proto_enumTypes[1].Descriptor()
+}
+
+func (BackupEntityType) Type() protoreflect.EnumType {
+	return &file_paperless_service_v1_backup_proto_enumTypes[1]
+}
+
+func (x BackupEntityType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BackupEntityType.Descriptor instead.
+func (BackupEntityType) EnumDescriptor() ([]byte, []int) {
+	return file_paperless_service_v1_backup_proto_rawDescGZIP(), []int{1}
+}
+
+// Layout of an exported backup
+type BackupFormat int32
+
+const (
+	// Native backup, restorable with ImportBackup
+	BackupFormat_BACKUP_FORMAT_UNSPECIFIED BackupFormat = 0
+	// paperless-ngx export: a zip with manifest.json and the original files
+	BackupFormat_BACKUP_FORMAT_PAPERLESS_NGX BackupFormat = 1
+)
+
+// Enum value maps for BackupFormat.
+var (
+	BackupFormat_name = map[int32]string{
+		0: "BACKUP_FORMAT_UNSPECIFIED",
+		1: "BACKUP_FORMAT_PAPERLESS_NGX",
+	}
+	BackupFormat_value = map[string]int32{
+		"BACKUP_FORMAT_UNSPECIFIED":   0,
+		"BACKUP_FORMAT_PAPERLESS_NGX": 1,
+	}
+)
+
+func (x BackupFormat) Enum() *BackupFormat {
+	p := new(BackupFormat)
+	*p = x
+	return p
+}
+
+func (x BackupFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BackupFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_paperless_service_v1_backup_proto_enumTypes[2].Descriptor()
+}
+
+func (BackupFormat) Type() protoreflect.EnumType {
+	return &file_paperless_service_v1_backup_proto_enumTypes[2]
+}
+
+func (x BackupFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BackupFormat.Descriptor instead.
+func (BackupFormat) EnumDescriptor() ([]byte, []int) {
+	return file_paperless_service_v1_backup_proto_rawDescGZIP(), []int{2}
+}
+
 type ExportBackupRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      *uint32                `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TenantId *uint32                `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	// Include document files; data is then a zip archive with backup.json and files/{document_id}
+	IncludeFiles bool `protobuf:"varint,2,opt,name=include_files,json=includeFiles,proto3" json:"include_files,omitempty"`
+	// Entity types to export; all types when empty
+	EntityTypes []BackupEntityType `protobuf:"varint,3,rep,packed,name=entity_types,json=entityTypes,proto3,enum=paperless.service.v1.BackupEntityType" json:"entity_types,omitempty"`
+	// Export only this category, its descendants, their documents and the permissions on them
+	CategoryId *string `protobuf:"bytes,4,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	// Archive layout; paperless-ngx exports always include files and cannot be re-imported here
+	Format        BackupFormat `protobuf:"varint,5,opt,name=format,proto3,enum=paperless.service.v1.BackupFormat" json:"format,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -113,14 +228,44 @@ func (x *ExportBackupRequest) GetTenantId() uint32 {
 	return 0
 }
 
+func (x *ExportBackupRequest) GetIncludeFiles() bool {
+	if x != nil {
+		return x.IncludeFiles
+	}
+	return false
+}
+
+func (x *ExportBackupRequest) GetEntityTypes() []BackupEntityType {
+	if x != nil {
+		return x.EntityTypes
+	}
+	return nil
+}
+
+func (x *ExportBackupRequest) GetCategoryId() string {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return ""
+}
+
+func (x *ExportBackupRequest) GetFormat() BackupFormat {
+	if x != nil {
+		return x.Format
+	}
+	return BackupFormat_BACKUP_FORMAT_UNSPECIFIED
+}
+
 type ExportBackupResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Module        string                 `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
-	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
-	ExportedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=exported_at,json=exportedAt,proto3" json:"exported_at,omitempty"`
-	TenantId      uint32                 `protobuf:"varint,5,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	EntityCounts  map[string]int64       `protobuf:"bytes,6,rep,name=entity_counts,json=entityCounts,proto3" json:"entity_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Data         []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Module       string                 `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+	Version      string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	ExportedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=exported_at,json=exportedAt,proto3" json:"exported_at,omitempty"`
+	TenantId     uint32                 `protobuf:"varint,5,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	EntityCounts map[string]int64       `protobuf:"bytes,6,rep,name=entity_counts,json=entityCounts,proto3" json:"entity_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Files that could not be included
+	Warnings      []string `protobuf:"bytes,7,rep,name=warnings,proto3" json:"warnings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -197,10 +342,19 @@ func (x *ExportBackupResponse) GetEntityCounts() map[string]int64 {
 	return nil
 }
 
+func (x *ExportBackupResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
 type ImportBackupRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Mode          RestoreMode            `protobuf:"varint,2,opt,name=mode,proto3,enum=paperless.service.v1.RestoreMode" json:"mode,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Data  []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Mode  RestoreMode            `protobuf:"varint,2,opt,name=mode,proto3,enum=paperless.service.v1.RestoreMode" json:"mode,omitempty"`
+	// Check the backup and return the would-be results without writing anything
+	ValidateOnly  bool `protobuf:"varint,3,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -249,6 +403,13 @@ func (x *ImportBackupRequest) GetMode() RestoreMode {
 	return RestoreMode_RESTORE_MODE_SKIP
 }
 
+func (x *ImportBackupRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
 type ImportBackupResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -309,6 +470,563 @@ func (x *ImportBackupResponse) GetWarnings() []string {
 	return nil
 }
 
+type RestoreFromBackupRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Data  []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Documents to restore
+	DocumentIds []string `protobuf:"bytes,2,rep,name=document_ids,json=documentIds,proto3" json:"document_ids,omitempty"`
+	// Restore this category, its descendants and their documents
+	CategoryId *string     `protobuf:"bytes,3,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Mode       RestoreMode `protobuf:"varint,4,opt,name=mode,proto3,enum=paperless.service.v1.RestoreMode" json:"mode,omitempty"`
+	// Check the selection and return the would-be results without writing anything
+	ValidateOnly  bool `protobuf:"varint,5,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreFromBackupRequest) Reset() {
+	*x = RestoreFromBackupRequest{}
+	mi := &file_paperless_service_v1_backup_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreFromBackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreFromBackupRequest) ProtoMessage() {}
+
+func (x *RestoreFromBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_backup_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreFromBackupRequest.ProtoReflect.Descriptor instead.
+func (*RestoreFromBackupRequest) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_backup_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RestoreFromBackupRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *RestoreFromBackupRequest) GetDocumentIds() []string {
+	if x != nil {
+		return x.DocumentIds
+	}
+	return nil
+}
+
+func (x *RestoreFromBackupRequest) GetCategoryId() string {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return ""
+}
+
+func (x *RestoreFromBackupRequest) GetMode() RestoreMode {
+	if x != nil {
+		return x.Mode
+	}
+	return RestoreMode_RESTORE_MODE_SKIP
+}
+
+func (x *RestoreFromBackupRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+// A frame of a streamed backup
+type BackupChunk struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Position of the chunk in the stream, starting at 0
+	Sequence      uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Data          []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BackupChunk) Reset() {
+	*x = BackupChunk{}
+	mi := &file_paperless_service_v1_backup_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackupChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupChunk) ProtoMessage() {}
+
+func (x *BackupChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_backup_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupChunk.ProtoReflect.Descriptor instead.
+func (*BackupChunk) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_backup_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BackupChunk) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *BackupChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Sent once after the last chunk of a streamed export
+type ExportBackupStreamSummary struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Module       string                 `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Version      string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	ExportedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=exported_at,json=exportedAt,proto3" json:"exported_at,omitempty"`
+	TenantId     uint32                 `protobuf:"varint,4,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	EntityCounts map[string]int64       `protobuf:"bytes,5,rep,name=entity_counts,json=entityCounts,proto3" json:"entity_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Warnings     []string               `protobuf:"bytes,6,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	// Total size of the backup in bytes
+	Size uint64 `protobuf:"varint,7,opt,name=size,proto3" json:"size,omitempty"`
+	// Hex-encoded SHA-256 of the backup
+	Sha256        string `protobuf:"bytes,8,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportBackupStreamSummary) Reset() {
+	*x = ExportBackupStreamSummary{}
+	mi := &file_paperless_service_v1_backup_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportBackupStreamSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportBackupStreamSummary) ProtoMessage() {}
+
+func (x *ExportBackupStreamSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_backup_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportBackupStreamSummary.ProtoReflect.Descriptor instead.
+func (*ExportBackupStreamSummary) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_backup_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ExportBackupStreamSummary) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+func (x *ExportBackupStreamSummary) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ExportBackupStreamSummary) GetExportedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExportedAt
+	}
+	return nil
+}
+
+func (x *ExportBackupStreamSummary) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *ExportBackupStreamSummary) GetEntityCounts() map[string]int64 {
+	if x != nil {
+		return x.EntityCounts
+	}
+	return nil
+}
+
+func (x *ExportBackupStreamSummary) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *ExportBackupStreamSummary) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ExportBackupStreamSummary) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+type ExportBackupStreamResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ExportBackupStreamResponse_Chunk
+	//	*ExportBackupStreamResponse_Summary
+	Payload       isExportBackupStreamResponse_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportBackupStreamResponse) Reset() {
+	*x = ExportBackupStreamResponse{}
+	mi := &file_paperless_service_v1_backup_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportBackupStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportBackupStreamResponse) ProtoMessage() {}
+
+func (x *ExportBackupStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_backup_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportBackupStreamResponse.ProtoReflect.Descriptor instead.
+func (*ExportBackupStreamResponse) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_backup_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ExportBackupStreamResponse) GetPayload() isExportBackupStreamResponse_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ExportBackupStreamResponse) GetChunk() *BackupChunk {
+	if x != nil {
+		if x, ok := x.Payload.(*ExportBackupStreamResponse_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+func (x *ExportBackupStreamResponse) GetSummary() *ExportBackupStreamSummary {
+	if x != nil {
+		if x, ok := x.Payload.(*ExportBackupStreamResponse_Summary); ok {
+			return x.Summary
+		}
+	}
+	return nil
+}
+
+type isExportBackupStreamResponse_Payload interface {
+	isExportBackupStreamResponse_Payload()
+}
+
+type ExportBackupStreamResponse_Chunk struct {
+	Chunk *BackupChunk `protobuf:"bytes,1,opt,name=chunk,proto3,oneof"`
+}
+
+type ExportBackupStreamResponse_Summary struct {
+	Summary *ExportBackupStreamSummary `protobuf:"bytes,2,opt,name=summary,proto3,oneof"`
+}
+
+func (*ExportBackupStreamResponse_Chunk) isExportBackupStreamResponse_Payload() {}
+
+func (*ExportBackupStreamResponse_Summary) isExportBackupStreamResponse_Payload() {}
+
+// First message of a streamed import
+type ImportBackupStreamHeader struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Mode  RestoreMode            `protobuf:"varint,1,opt,name=mode,proto3,enum=paperless.service.v1.RestoreMode" json:"mode,omitempty"`
+	// Hex-encoded SHA-256 of the backup, verified before anything is restored
+	Sha256 *string `protobuf:"bytes,2,opt,name=sha256,proto3,oneof" json:"sha256,omitempty"`
+	// Check the backup and return the would-be results without writing anything
+	ValidateOnly  bool `protobuf:"varint,3,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportBackupStreamHeader) Reset() {
+	*x = ImportBackupStreamHeader{}
+	mi := &file_paperless_service_v1_backup_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportBackupStreamHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportBackupStreamHeader) ProtoMessage() {}
+
+func (x *ImportBackupStreamHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_backup_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportBackupStreamHeader.ProtoReflect.Descriptor instead.
+func (*ImportBackupStreamHeader) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_backup_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ImportBackupStreamHeader) GetMode() RestoreMode {
+	if x != nil {
+		return x.Mode
+	}
+	return RestoreMode_RESTORE_MODE_SKIP
+}
+
+func (x *ImportBackupStreamHeader) GetSha256() string {
+	if x != nil && x.Sha256 != nil {
+		return *x.Sha256
+	}
+	return ""
+}
+
+func (x *ImportBackupStreamHeader) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+type ImportBackupStreamRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ImportBackupStreamRequest_Header
+	//	*ImportBackupStreamRequest_Chunk
+	Payload       isImportBackupStreamRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportBackupStreamRequest) Reset() {
+	*x = ImportBackupStreamRequest{}
+	mi := &file_paperless_service_v1_backup_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportBackupStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportBackupStreamRequest) ProtoMessage() {}
+
+func (x *ImportBackupStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_backup_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportBackupStreamRequest.ProtoReflect.Descriptor instead.
+func (*ImportBackupStreamRequest) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_backup_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ImportBackupStreamRequest) GetPayload() isImportBackupStreamRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ImportBackupStreamRequest) GetHeader() *ImportBackupStreamHeader {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportBackupStreamRequest_Header); ok {
+			return x.Header
+		}
+	}
+	return nil
+}
+
+func (x *ImportBackupStreamRequest) GetChunk() *BackupChunk {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportBackupStreamRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isImportBackupStreamRequest_Payload interface {
+	isImportBackupStreamRequest_Payload()
+}
+
+type ImportBackupStreamRequest_Header struct {
+	Header *ImportBackupStreamHeader `protobuf:"bytes,1,opt,name=header,proto3,oneof"`
+}
+
+type ImportBackupStreamRequest_Chunk struct {
+	Chunk *BackupChunk `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*ImportBackupStreamRequest_Header) isImportBackupStreamRequest_Payload() {}
+
+func (*ImportBackupStreamRequest_Chunk) isImportBackupStreamRequest_Payload() {}
+
+// An update from ImportBackupWithProgress
+type ImportBackupProgress struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*ImportBackupProgress_Progress
+	//	*ImportBackupProgress_Result
+	Event isImportBackupProgress_Event `protobuf_oneof:"event"`
+	// Warnings raised since the previous update
+	Warnings      []string `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportBackupProgress) Reset() {
+	*x = ImportBackupProgress{}
+	mi := &file_paperless_service_v1_backup_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportBackupProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportBackupProgress) ProtoMessage() {}
+
+func (x *ImportBackupProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_backup_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportBackupProgress.ProtoReflect.Descriptor instead.
+func (*ImportBackupProgress) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_backup_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ImportBackupProgress) GetEvent() isImportBackupProgress_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *ImportBackupProgress) GetProgress() *EntityImportResult {
+	if x != nil {
+		if x, ok := x.Event.(*ImportBackupProgress_Progress); ok {
+			return x.Progress
+		}
+	}
+	return nil
+}
+
+func (x *ImportBackupProgress) GetResult() *ImportBackupResponse {
+	if x != nil {
+		if x, ok := x.Event.(*ImportBackupProgress_Result); ok {
+			return x.Result
+		}
+	}
+	return nil
+}
+
+func (x *ImportBackupProgress) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type isImportBackupProgress_Event interface {
+	isImportBackupProgress_Event()
+}
+
+type ImportBackupProgress_Progress struct {
+	// Running counts for the entity type being imported
+	Progress *EntityImportResult `protobuf:"bytes,1,opt,name=progress,proto3,oneof"`
+}
+
+type ImportBackupProgress_Result struct {
+	// Final result, sent once as the last message
+	Result *ImportBackupResponse `protobuf:"bytes,2,opt,name=result,proto3,oneof"`
+}
+
+func (*ImportBackupProgress_Progress) isImportBackupProgress_Event() {}
+
+func (*ImportBackupProgress_Result) isImportBackupProgress_Event() {}
+
 type EntityImportResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	EntityType    string                 `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
@@ -323,7 +1041,7 @@ type EntityImportResult struct {
 
 func (x *EntityImportResult) Reset() {
 	*x = EntityImportResult{}
-	mi := &file_paperless_service_v1_backup_proto_msgTypes[4]
+	mi := &file_paperless_service_v1_backup_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -335,7 +1053,7 @@ func (x *EntityImportResult) String() string {
 func (*EntityImportResult) ProtoMessage() {}
 
 func (x *EntityImportResult) ProtoReflect() protoreflect.Message {
-	mi := &file_paperless_service_v1_backup_proto_msgTypes[4]
+	mi := &file_paperless_service_v1_backup_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -348,7 +1066,7 @@ func (x *EntityImportResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EntityImportResult.ProtoReflect.Descriptor instead.
 func (*EntityImportResult) Descriptor() ([]byte, []int) {
-	return file_paperless_service_v1_backup_proto_rawDescGZIP(), []int{4}
+	return file_paperless_service_v1_backup_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *EntityImportResult) GetEntityType() string {
@@ -397,11 +1115,18 @@ var File_paperless_service_v1_backup_proto protoreflect.FileDescriptor
 
 const file_paperless_service_v1_backup_proto_rawDesc = "" +
 	"\n" +
-	"!paperless/service/v1/backup.proto\x12\x14paperless.service.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"E\n" +
+	"!paperless/service/v1/backup.proto\x12\x14paperless.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc0\x03\n" +
 	"\x13ExportBackupRequest\x12 \n" +
-	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01\x12#\n" +
+	"\rinclude_files\x18\x02 \x01(\bR\fincludeFiles\x12X\n" +
+	"\fentity_types\x18\x03 \x03(\x0e2&.paperless.service.v1.BackupEntityTypeB\r\xbaH\n" +
+	"\x92\x01\a\"\x05\x82\x01\x02\x10\x01R\ventityTypes\x12\xa3\x01\n" +
+	"\vcategory_id\x18\x04 \x01(\tB}\xbaHzrx\x18$2t^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})?$H\x01R\n" +
+	"categoryId\x88\x01\x01\x12D\n" +
+	"\x06format\x18\x05 \x01(\x0e2\".paperless.service.v1.BackupFormatB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06formatB\f\n" +
 	"\n" +
-	"_tenant_id\"\xda\x02\n" +
+	"_tenant_idB\x0e\n" +
+	"\f_category_id\"\xf6\x02\n" +
 	"\x14ExportBackupResponse\x12\x12\n" +
 	"\x04data\x18\x01 \x01(\fR\x04data\x12\x16\n" +
 	"\x06module\x18\x02 \x01(\tR\x06module\x12\x18\n" +
@@ -409,17 +1134,61 @@ const file_paperless_service_v1_backup_proto_rawDesc = "" +
 	"\vexported_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"exportedAt\x12\x1b\n" +
 	"\ttenant_id\x18\x05 \x01(\rR\btenantId\x12a\n" +
-	"\rentity_counts\x18\x06 \x03(\v2<.paperless.service.v1.ExportBackupResponse.EntityCountsEntryR\fentityCounts\x1a?\n" +
+	"\rentity_counts\x18\x06 \x03(\v2<.paperless.service.v1.ExportBackupResponse.EntityCountsEntryR\fentityCounts\x12\x1a\n" +
+	"\bwarnings\x18\a \x03(\tR\bwarnings\x1a?\n" +
 	"\x11EntityCountsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"`\n" +
-	"\x13ImportBackupRequest\x12\x12\n" +
-	"\x04data\x18\x01 \x01(\fR\x04data\x125\n" +
-	"\x04mode\x18\x02 \x01(\x0e2!.paperless.service.v1.RestoreModeR\x04mode\"\x90\x01\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\x98\x01\n" +
+	"\x13ImportBackupRequest\x12\x1b\n" +
+	"\x04data\x18\x01 \x01(\fB\a\xbaH\x04z\x02\x10\x01R\x04data\x12?\n" +
+	"\x04mode\x18\x02 \x01(\x0e2!.paperless.service.v1.RestoreModeB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04mode\x12#\n" +
+	"\rvalidate_only\x18\x03 \x01(\bR\fvalidateOnly\"\x90\x01\n" +
 	"\x14ImportBackupResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12B\n" +
 	"\aresults\x18\x02 \x03(\v2(.paperless.service.v1.EntityImportResultR\aresults\x12\x1a\n" +
-	"\bwarnings\x18\x03 \x03(\tR\bwarnings\"\xb1\x01\n" +
+	"\bwarnings\x18\x03 \x03(\tR\bwarnings\"\xfc\x03\n" +
+	"\x18RestoreFromBackupRequest\x12\x1b\n" +
+	"\x04data\x18\x01 \x01(\fB\a\xbaH\x04z\x02\x10\x01R\x04data\x12\xa6\x01\n" +
+	"\fdocument_ids\x18\x02 \x03(\tB\x82\x01\xbaH\x7f\x92\x01|\x10\xe8\a\"wru2s^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})$R\vdocumentIds\x12\xa3\x01\n" +
+	"\vcategory_id\x18\x03 \x01(\tB}\xbaHzrx\x18$2t^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})?$H\x00R\n" +
+	"categoryId\x88\x01\x01\x12?\n" +
+	"\x04mode\x18\x04 \x01(\x0e2!.paperless.service.v1.RestoreModeB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04mode\x12#\n" +
+	"\rvalidate_only\x18\x05 \x01(\bR\fvalidateOnlyB\x0e\n" +
+	"\f_category_id\"=\n" +
+	"\vBackupChunk\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x04R\bsequence\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\"\x98\x03\n" +
+	"\x19ExportBackupStreamSummary\x12\x16\n" +
+	"\x06module\x18\x01 \x01(\tR\x06module\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12;\n" +
+	"\vexported_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"exportedAt\x12\x1b\n" +
+	"\ttenant_id\x18\x04 \x01(\rR\btenantId\x12f\n" +
+	"\rentity_counts\x18\x05 \x03(\v2A.paperless.service.v1.ExportBackupStreamSummary.EntityCountsEntryR\fentityCounts\x12\x1a\n" +
+	"\bwarnings\x18\x06 \x03(\tR\bwarnings\x12\x12\n" +
+	"\x04size\x18\a \x01(\x04R\x04size\x12\x16\n" +
+	"\x06sha256\x18\b \x01(\tR\x06sha256\x1a?\n" +
+	"\x11EntityCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\xaf\x01\n" +
+	"\x1aExportBackupStreamResponse\x129\n" +
+	"\x05chunk\x18\x01 \x01(\v2!.paperless.service.v1.BackupChunkH\x00R\x05chunk\x12K\n" +
+	"\asummary\x18\x02 \x01(\v2/.paperless.service.v1.ExportBackupStreamSummaryH\x00R\asummaryB\t\n" +
+	"\apayload\"\x9e\x01\n" +
+	"\x18ImportBackupStreamHeader\x125\n" +
+	"\x04mode\x18\x01 \x01(\x0e2!.paperless.service.v1.RestoreModeR\x04mode\x12\x1b\n" +
+	"\x06sha256\x18\x02 \x01(\tH\x00R\x06sha256\x88\x01\x01\x12#\n" +
+	"\rvalidate_only\x18\x03 \x01(\bR\fvalidateOnlyB\t\n" +
+	"\a_sha256\"\xab\x01\n" +
+	"\x19ImportBackupStreamRequest\x12H\n" +
+	"\x06header\x18\x01 \x01(\v2..paperless.service.v1.ImportBackupStreamHeaderH\x00R\x06header\x129\n" +
+	"\x05chunk\x18\x02 \x01(\v2!.paperless.service.v1.BackupChunkH\x00R\x05chunkB\t\n" +
+	"\apayload\"\xc9\x01\n" +
+	"\x14ImportBackupProgress\x12F\n" +
+	"\bprogress\x18\x01 \x01(\v2(.paperless.service.v1.EntityImportResultH\x00R\bprogress\x12D\n" +
+	"\x06result\x18\x02 \x01(\v2*.paperless.service.v1.ImportBackupResponseH\x00R\x06result\x12\x1a\n" +
+	"\bwarnings\x18\x03 \x03(\tR\bwarningsB\a\n" +
+	"\x05event\"\xb1\x01\n" +
 	"\x12EntityImportResult\x12\x1f\n" +
 	"\ventity_type\x18\x01 \x01(\tR\n" +
 	"entityType\x12\x14\n" +
@@ -427,13 +1196,26 @@ const file_paperless_service_v1_backup_proto_rawDesc = "" +
 	"\acreated\x18\x03 \x01(\x03R\acreated\x12\x18\n" +
 	"\aupdated\x18\x04 \x01(\x03R\aupdated\x12\x18\n" +
 	"\askipped\x18\x05 \x01(\x03R\askipped\x12\x16\n" +
-	"\x06failed\x18\x06 \x01(\x03R\x06failed*@\n" +
+	"\x06failed\x18\x06 \x01(\x03R\x06failed*\\\n" +
 	"\vRestoreMode\x12\x15\n" +
 	"\x11RESTORE_MODE_SKIP\x10\x00\x12\x1a\n" +
-	"\x16RESTORE_MODE_OVERWRITE\x10\x012\x98\x02\n" +
+	"\x16RESTORE_MODE_OVERWRITE\x10\x01\x12\x1a\n" +
+	"\x16RESTORE_MODE_DUPLICATE\x10\x02*\xa8\x01\n" +
+	"\x10BackupEntityType\x12\"\n" +
+	"\x1eBACKUP_ENTITY_TYPE_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dBACKUP_ENTITY_TYPE_CATEGORIES\x10\x01\x12 \n" +
+	"\x1cBACKUP_ENTITY_TYPE_DOCUMENTS\x10\x02\x12+\n" +
+	"'BACKUP_ENTITY_TYPE_DOCUMENT_PERMISSIONS\x10\x03*N\n" +
+	"\fBackupFormat\x12\x1d\n" +
+	"\x19BACKUP_FORMAT_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bBACKUP_FORMAT_PAPERLESS_NGX\x10\x012\x8e\x06\n" +
 	"\rBackupService\x12\x80\x01\n" +
 	"\fExportBackup\x12).paperless.service.v1.ExportBackupRequest\x1a*.paperless.service.v1.ExportBackupResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/backup/export\x12\x83\x01\n" +
-	"\fImportBackup\x12).paperless.service.v1.ImportBackupRequest\x1a*.paperless.service.v1.ImportBackupResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/backup/importB\xeb\x01\n" +
+	"\fImportBackup\x12).paperless.service.v1.ImportBackupRequest\x1a*.paperless.service.v1.ImportBackupResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/backup/import\x12\x8e\x01\n" +
+	"\x11RestoreFromBackup\x12..paperless.service.v1.RestoreFromBackupRequest\x1a*.paperless.service.v1.ImportBackupResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/backup/restore\x12u\n" +
+	"\x18ImportBackupWithProgress\x12).paperless.service.v1.ImportBackupRequest\x1a*.paperless.service.v1.ImportBackupProgress\"\x000\x01\x12u\n" +
+	"\x12ExportBackupStream\x12).paperless.service.v1.ExportBackupRequest\x1a0.paperless.service.v1.ExportBackupStreamResponse\"\x000\x01\x12u\n" +
+	"\x12ImportBackupStream\x12/.paperless.service.v1.ImportBackupStreamRequest\x1a*.paperless.service.v1.ImportBackupResponse\"\x00(\x01B\xeb\x01\n" +
 	"\x18com.paperless.service.v1B\vBackupProtoP\x01ZPgithub.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1;paperlesspb\xa2\x02\x03PSX\xaa\x02\x14Paperless.Service.V1\xca\x02\x14Paperless\\Service\\V1\xe2\x02 Paperless\\Service\\V1\\GPBMetadata\xea\x02\x16Paperless::Service::V1b\x06proto3"
 
 var (
@@ -448,32 +1230,62 @@ func file_paperless_service_v1_backup_proto_rawDescGZIP() []byte {
 	return file_paperless_service_v1_backup_proto_rawDescData
 }
 
-var file_paperless_service_v1_backup_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_paperless_service_v1_backup_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_paperless_service_v1_backup_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_paperless_service_v1_backup_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_paperless_service_v1_backup_proto_goTypes = []any{
-	(RestoreMode)(0),              // 0: paperless.service.v1.RestoreMode
-	(*ExportBackupRequest)(nil),   // 1: paperless.service.v1.ExportBackupRequest
-	(*ExportBackupResponse)(nil),  // 2: paperless.service.v1.ExportBackupResponse
-	(*ImportBackupRequest)(nil),   // 3: paperless.service.v1.ImportBackupRequest
-	(*ImportBackupResponse)(nil),  // 4: paperless.service.v1.ImportBackupResponse
-	(*EntityImportResult)(nil),    // 5: paperless.service.v1.EntityImportResult
-	nil,                           // 6: paperless.service.v1.ExportBackupResponse.EntityCountsEntry
-	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+	(RestoreMode)(0),                   // 0: paperless.service.v1.RestoreMode
+	(BackupEntityType)(0),              // 1: paperless.service.v1.BackupEntityType
+	(BackupFormat)(0),                  // 2: paperless.service.v1.BackupFormat
+	(*ExportBackupRequest)(nil),        // 3: paperless.service.v1.ExportBackupRequest
+	(*ExportBackupResponse)(nil),       // 4: paperless.service.v1.ExportBackupResponse
+	(*ImportBackupRequest)(nil),        // 5: paperless.service.v1.ImportBackupRequest
+	(*ImportBackupResponse)(nil),       // 6: paperless.service.v1.ImportBackupResponse
+	(*RestoreFromBackupRequest)(nil),   // 7: paperless.service.v1.RestoreFromBackupRequest
+	(*BackupChunk)(nil),                // 8: paperless.service.v1.BackupChunk
+	(*ExportBackupStreamSummary)(nil),  // 9: paperless.service.v1.ExportBackupStreamSummary
+	(*ExportBackupStreamResponse)(nil), // 10: paperless.service.v1.ExportBackupStreamResponse
+	(*ImportBackupStreamHeader)(nil),   // 11: paperless.service.v1.ImportBackupStreamHeader
+	(*ImportBackupStreamRequest)(nil),  // 12: paperless.service.v1.ImportBackupStreamRequest
+	(*ImportBackupProgress)(nil),       // 13: paperless.service.v1.ImportBackupProgress
+	(*EntityImportResult)(nil),         // 14: paperless.service.v1.EntityImportResult
+	nil,                                // 15: paperless.service.v1.ExportBackupResponse.EntityCountsEntry
+	nil,                                // 16: paperless.service.v1.ExportBackupStreamSummary.EntityCountsEntry
+	(*timestamppb.Timestamp)(nil),      // 17: google.protobuf.Timestamp
 }
 var file_paperless_service_v1_backup_proto_depIdxs = []int32{
-	7, // 0: paperless.service.v1.ExportBackupResponse.exported_at:type_name -> google.protobuf.Timestamp
-	6, // 1: paperless.service.v1.ExportBackupResponse.entity_counts:type_name -> paperless.service.v1.ExportBackupResponse.EntityCountsEntry
-	0, // 2: paperless.service.v1.ImportBackupRequest.mode:type_name -> paperless.service.v1.RestoreMode
-	5, // 3: paperless.service.v1.ImportBackupResponse.results:type_name -> paperless.service.v1.EntityImportResult
-	1, // 4: paperless.service.v1.BackupService.ExportBackup:input_type -> paperless.service.v1.ExportBackupRequest
-	3, // 5: paperless.service.v1.BackupService.ImportBackup:input_type -> paperless.service.v1.ImportBackupRequest
-	2, // 6: paperless.service.v1.BackupService.ExportBackup:output_type -> paperless.service.v1.ExportBackupResponse
-	4, // 7: paperless.service.v1.BackupService.ImportBackup:output_type -> paperless.service.v1.ImportBackupResponse
-	6, // [6:8] is the sub-list for method output_type
-	4, // [4:6] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	1,  // 0: paperless.service.v1.ExportBackupRequest.entity_types:type_name -> paperless.service.v1.BackupEntityType
+	2,  // 1: paperless.service.v1.ExportBackupRequest.format:type_name -> paperless.service.v1.BackupFormat
+	17, // 2: paperless.service.v1.ExportBackupResponse.exported_at:type_name -> google.protobuf.Timestamp
+	15, // 3: paperless.service.v1.ExportBackupResponse.entity_counts:type_name -> paperless.service.v1.ExportBackupResponse.EntityCountsEntry
+	0,  // 4: paperless.service.v1.ImportBackupRequest.mode:type_name -> paperless.service.v1.RestoreMode
+	14, // 5: paperless.service.v1.ImportBackupResponse.results:type_name -> paperless.service.v1.EntityImportResult
+	0,  // 6: paperless.service.v1.RestoreFromBackupRequest.mode:type_name -> paperless.service.v1.RestoreMode
+	17, // 7: paperless.service.v1.ExportBackupStreamSummary.exported_at:type_name -> google.protobuf.Timestamp
+	16, // 8: paperless.service.v1.ExportBackupStreamSummary.entity_counts:type_name -> paperless.service.v1.ExportBackupStreamSummary.EntityCountsEntry
+	8,  // 9: paperless.service.v1.ExportBackupStreamResponse.chunk:type_name -> paperless.service.v1.BackupChunk
+	9,  // 10: paperless.service.v1.ExportBackupStreamResponse.summary:type_name -> paperless.service.v1.ExportBackupStreamSummary
+	0,  // 11: paperless.service.v1.ImportBackupStreamHeader.mode:type_name -> paperless.service.v1.RestoreMode
+	11, // 12: paperless.service.v1.ImportBackupStreamRequest.header:type_name -> paperless.service.v1.ImportBackupStreamHeader
+	8,  // 13: paperless.service.v1.ImportBackupStreamRequest.chunk:type_name -> paperless.service.v1.BackupChunk
+	14, // 14: paperless.service.v1.ImportBackupProgress.progress:type_name -> paperless.service.v1.EntityImportResult
+	6,  // 15: paperless.service.v1.ImportBackupProgress.result:type_name -> paperless.service.v1.ImportBackupResponse
+	3,  // 16: paperless.service.v1.BackupService.ExportBackup:input_type -> paperless.service.v1.ExportBackupRequest
+	5,  // 17: paperless.service.v1.BackupService.ImportBackup:input_type -> paperless.service.v1.ImportBackupRequest
+	7,  // 18: paperless.service.v1.BackupService.RestoreFromBackup:input_type -> paperless.service.v1.RestoreFromBackupRequest
+	5,  // 19: paperless.service.v1.BackupService.ImportBackupWithProgress:input_type -> paperless.service.v1.ImportBackupRequest
+	3,  // 20: paperless.service.v1.BackupService.ExportBackupStream:input_type -> paperless.service.v1.ExportBackupRequest
+	12, // 21: paperless.service.v1.BackupService.ImportBackupStream:input_type -> paperless.service.v1.ImportBackupStreamRequest
+	4,  // 22: paperless.service.v1.BackupService.ExportBackup:output_type -> paperless.service.v1.ExportBackupResponse
+	6,  // 23: paperless.service.v1.BackupService.ImportBackup:output_type -> paperless.service.v1.ImportBackupResponse
+	6,  // 24: paperless.service.v1.BackupService.RestoreFromBackup:output_type -> paperless.service.v1.ImportBackupResponse
+	13, // 25: paperless.service.v1.BackupService.ImportBackupWithProgress:output_type -> paperless.service.v1.ImportBackupProgress
+	10, // 26: paperless.service.v1.BackupService.ExportBackupStream:output_type -> paperless.service.v1.ExportBackupStreamResponse
+	6,  // 27: paperless.service.v1.BackupService.ImportBackupStream:output_type -> paperless.service.v1.ImportBackupResponse
+	22, // [22:28] is the sub-list for method output_type
+	16, // [16:22] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_paperless_service_v1_backup_proto_init() }
@@ -482,13 +1294,27 @@ func file_paperless_service_v1_backup_proto_init() {
 		return
 	}
 	file_paperless_service_v1_backup_proto_msgTypes[0].OneofWrappers = []any{}
+	file_paperless_service_v1_backup_proto_msgTypes[4].OneofWrappers = []any{}
+	file_paperless_service_v1_backup_proto_msgTypes[7].OneofWrappers = []any{
+		(*ExportBackupStreamResponse_Chunk)(nil),
+		(*ExportBackupStreamResponse_Summary)(nil),
+	}
+	file_paperless_service_v1_backup_proto_msgTypes[8].OneofWrappers = []any{}
+	file_paperless_service_v1_backup_proto_msgTypes[9].OneofWrappers = []any{
+		(*ImportBackupStreamRequest_Header)(nil),
+		(*ImportBackupStreamRequest_Chunk)(nil),
+	}
+	file_paperless_service_v1_backup_proto_msgTypes[10].OneofWrappers = []any{
+		(*ImportBackupProgress_Progress)(nil),
+		(*ImportBackupProgress_Result)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_paperless_service_v1_backup_proto_rawDesc), len(file_paperless_service_v1_backup_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   6,
+			NumEnums:      3,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -4,6 +4,7 @@
 package paperlesspb
 
 import (
+	validate "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	context "context"
 	redact "github.com/menta2k/protoc-gen-redact/v3/redact/v3"
 	grpc "google.golang.org/grpc"
@@ -19,6 +20,7 @@ var (
 	_ redact.Redactor
 	_ codes.Code
 	_ status.Status
+	_ validate.Rule
 	_ timestamppb.Timestamp
 )
 
@@ -62,6 +64,41 @@ func (s *redactedBackupServiceServer) ImportBackup(ctx context.Context, in *Impo
 	return res, err
 }
 
+// RestoreFromBackup is the redacted wrapper for the actual BackupServiceServer.RestoreFromBackup method
+// Unary RPC
+func (s *redactedBackupServiceServer) RestoreFromBackup(ctx context.Context, in *RestoreFromBackupRequest) (*ImportBackupResponse, error) {
+	res, err := s.srv.RestoreFromBackup(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ImportBackupWithProgress is the redacted wrapper for the actual BackupServiceServer.ImportBackupWithProgress method
+// Server streaming
+func (s *redactedBackupServiceServer) ImportBackupWithProgress(in *ImportBackupRequest, stream grpc.ServerStreamingServer[ImportBackupProgress]) error {
+	// Note: Redaction for server streaming is not fully implemented
+	// Streaming methods pass through without redaction
+	return s.srv.ImportBackupWithProgress(in, stream)
+}
+
+// ExportBackupStream is the redacted wrapper for the actual BackupServiceServer.ExportBackupStream method
+// Server streaming
+func (s *redactedBackupServiceServer) ExportBackupStream(in *ExportBackupRequest, stream grpc.ServerStreamingServer[ExportBackupStreamResponse]) error {
+	// Note: Redaction for server streaming is not fully implemented
+	// Streaming methods pass through without redaction
+	return s.srv.ExportBackupStream(in, stream)
+}
+
+// ImportBackupStream is the redacted wrapper for the actual BackupServiceServer.ImportBackupStream method
+// Client streaming
+func (s *redactedBackupServiceServer) ImportBackupStream(stream grpc.ClientStreamingServer[ImportBackupStreamRequest, ImportBackupResponse]) error {
+	// Note: Redaction for client streaming is not fully implemented
+	// Streaming methods pass through without redaction
+	return s.srv.ImportBackupStream(stream)
+}
+
 // Redact method implementation for ExportBackupRequest
 func (x *ExportBackupRequest) Redact() string {
 	if x == nil {
@@ -69,6 +106,14 @@ func (x *ExportBackupRequest) Redact() string {
 	}
 
 	// Safe field: TenantId
+
+	// Safe field: IncludeFiles
+
+	// Safe field: EntityTypes
+
+	// Safe field: CategoryId
+
+	// Safe field: Format
 	return x.String()
 }
 
@@ -89,6 +134,8 @@ func (x *ExportBackupResponse) Redact() string {
 	// Safe field: TenantId
 
 	// Safe field: EntityCounts
+
+	// Safe field: Warnings
 	return x.String()
 }
 
@@ -101,6 +148,8 @@ func (x *ImportBackupRequest) Redact() string {
 	// Safe field: Data
 
 	// Safe field: Mode
+
+	// Safe field: ValidateOnly
 	return x.String()
 }
 
@@ -118,6 +167,112 @@ func (x *ImportBackupResponse) Redact() string {
 	return x.String()
 }
 
+// Redact method implementation for RestoreFromBackupRequest
+func (x *RestoreFromBackupRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Data
+
+	// Safe field: DocumentIds
+
+	// Safe field: CategoryId
+
+	// Safe field: Mode
+
+	// Safe field: ValidateOnly
+	return x.String()
+}
+
+// Redact method implementation for BackupChunk
+func (x *BackupChunk) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Sequence
+
+	// Safe field: Data
+	return x.String()
+}
+
+// Redact method implementation for ExportBackupStreamSummary
+func (x *ExportBackupStreamSummary) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Module
+
+	// Safe field: Version
+
+	// Safe field: ExportedAt
+
+	// Safe field: TenantId
+
+	// Safe field: EntityCounts
+
+	// Safe field: Warnings
+
+	// Safe field: Size
+
+	// Safe field: Sha256
+	return x.String()
+}
+
+// Redact method implementation for ExportBackupStreamResponse
+func (x *ExportBackupStreamResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Chunk
+
+	// Safe field: Summary
+	return x.String()
+}
+
+// Redact method implementation for ImportBackupStreamHeader
+func (x *ImportBackupStreamHeader) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Mode
+
+	// Safe field: Sha256
+
+	// Safe field: ValidateOnly
+	return x.String()
+}
+
+// Redact method implementation for ImportBackupStreamRequest
+func (x *ImportBackupStreamRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Header
+
+	// Safe field: Chunk
+	return x.String()
+}
+
+// Redact method implementation for ImportBackupProgress
+func (x *ImportBackupProgress) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Progress
+
+	// Safe field: Result
+
+	// Safe field: Warnings
+	return x.String()
+}
+
 // Redact method implementation for EntityImportResult
 func (x *EntityImportResult) Redact() string {
 	if x == nil {
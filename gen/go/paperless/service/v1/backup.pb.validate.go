@@ -57,10 +57,18 @@ func (m *ExportBackupRequest) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for IncludeFiles
+
+	// no validation rules for Format
+
 	if m.TenantId != nil {
 		// no validation rules for TenantId
 	}
 
+	if m.CategoryId != nil {
+		// no validation rules for CategoryId
+	}
+
 	if len(errors) > 0 {
 		return ExportBackupRequestMultiError(errors)
 	}
@@ -308,6 +316,8 @@ func (m *ImportBackupRequest) validate(all bool) error {
 
 	// no validation rules for Mode
 
+	// no validation rules for ValidateOnly
+
 	if len(errors) > 0 {
 		return ImportBackupRequestMultiError(errors)
 	}
@@ -526,6 +536,1041 @@ var _ interface {
 	ErrorName() string
 } = ImportBackupResponseValidationError{}
 
+// Validate checks the field values on RestoreFromBackupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreFromBackupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreFromBackupRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreFromBackupRequestMultiError, or nil if none found.
+func (m *RestoreFromBackupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreFromBackupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Data
+
+	// no validation rules for Mode
+
+	// no validation rules for ValidateOnly
+
+	if m.CategoryId != nil {
+		// no validation rules for CategoryId
+	}
+
+	if len(errors) > 0 {
+		return RestoreFromBackupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreFromBackupRequestMultiError is an error wrapping multiple validation
+// errors returned by RestoreFromBackupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreFromBackupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreFromBackupRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreFromBackupRequestMultiError) AllErrors() []error { return m }
+
+// RestoreFromBackupRequestValidationError is the validation error returned by
+// RestoreFromBackupRequest.Validate if the designated constraints aren't met.
+type RestoreFromBackupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreFromBackupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreFromBackupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreFromBackupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreFromBackupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreFromBackupRequestValidationError) ErrorName() string {
+	return "RestoreFromBackupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreFromBackupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreFromBackupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreFromBackupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreFromBackupRequestValidationError{}
+
+// Validate checks the field values on BackupChunk with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BackupChunk) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BackupChunk with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BackupChunkMultiError, or
+// nil if none found.
+func (m *BackupChunk) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BackupChunk) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sequence
+
+	// no validation rules for Data
+
+	if len(errors) > 0 {
+		return BackupChunkMultiError(errors)
+	}
+
+	return nil
+}
+
+// BackupChunkMultiError is an error wrapping multiple validation errors
+// returned by BackupChunk.ValidateAll() if the designated constraints aren't met.
+type BackupChunkMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BackupChunkMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BackupChunkMultiError) AllErrors() []error { return m }
+
+// BackupChunkValidationError is the validation error returned by
+// BackupChunk.Validate if the designated constraints aren't met.
+type BackupChunkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BackupChunkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BackupChunkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BackupChunkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BackupChunkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BackupChunkValidationError) ErrorName() string { return "BackupChunkValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BackupChunkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBackupChunk.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BackupChunkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BackupChunkValidationError{}
+
+// Validate checks the field values on ExportBackupStreamSummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportBackupStreamSummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportBackupStreamSummary with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportBackupStreamSummaryMultiError, or nil if none found.
+func (m *ExportBackupStreamSummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportBackupStreamSummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Module
+
+	// no validation rules for Version
+
+	if all {
+		switch v := interface{}(m.GetExportedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportBackupStreamSummaryValidationError{
+					field:  "ExportedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportBackupStreamSummaryValidationError{
+					field:  "ExportedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExportedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportBackupStreamSummaryValidationError{
+				field:  "ExportedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TenantId
+
+	// no validation rules for EntityCounts
+
+	// no validation rules for Size
+
+	// no validation rules for Sha256
+
+	if len(errors) > 0 {
+		return ExportBackupStreamSummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportBackupStreamSummaryMultiError is an error wrapping multiple validation
+// errors returned by ExportBackupStreamSummary.ValidateAll() if the
+// designated constraints aren't met.
+type ExportBackupStreamSummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportBackupStreamSummaryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportBackupStreamSummaryMultiError) AllErrors() []error { return m }
+
+// ExportBackupStreamSummaryValidationError is the validation error returned by
+// ExportBackupStreamSummary.Validate if the designated constraints aren't met.
+type ExportBackupStreamSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportBackupStreamSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportBackupStreamSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportBackupStreamSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportBackupStreamSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportBackupStreamSummaryValidationError) ErrorName() string {
+	return "ExportBackupStreamSummaryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportBackupStreamSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportBackupStreamSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportBackupStreamSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportBackupStreamSummaryValidationError{}
+
+// Validate checks the field values on ExportBackupStreamResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportBackupStreamResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportBackupStreamResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportBackupStreamResponseMultiError, or nil if none found.
+func (m *ExportBackupStreamResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportBackupStreamResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Payload.(type) {
+	case *ExportBackupStreamResponse_Chunk:
+		if v == nil {
+			err := ExportBackupStreamResponseValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetChunk()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExportBackupStreamResponseValidationError{
+						field:  "Chunk",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExportBackupStreamResponseValidationError{
+						field:  "Chunk",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetChunk()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExportBackupStreamResponseValidationError{
+					field:  "Chunk",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ExportBackupStreamResponse_Summary:
+		if v == nil {
+			err := ExportBackupStreamResponseValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSummary()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExportBackupStreamResponseValidationError{
+						field:  "Summary",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExportBackupStreamResponseValidationError{
+						field:  "Summary",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSummary()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExportBackupStreamResponseValidationError{
+					field:  "Summary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return ExportBackupStreamResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportBackupStreamResponseMultiError is an error wrapping multiple
+// validation errors returned by ExportBackupStreamResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ExportBackupStreamResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportBackupStreamResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportBackupStreamResponseMultiError) AllErrors() []error { return m }
+
+// ExportBackupStreamResponseValidationError is the validation error returned
+// by ExportBackupStreamResponse.Validate if the designated constraints aren't met.
+type ExportBackupStreamResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportBackupStreamResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportBackupStreamResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportBackupStreamResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportBackupStreamResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportBackupStreamResponseValidationError) ErrorName() string {
+	return "ExportBackupStreamResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportBackupStreamResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportBackupStreamResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportBackupStreamResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportBackupStreamResponseValidationError{}
+
+// Validate checks the field values on ImportBackupStreamHeader with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportBackupStreamHeader) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportBackupStreamHeader with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportBackupStreamHeaderMultiError, or nil if none found.
+func (m *ImportBackupStreamHeader) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportBackupStreamHeader) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Mode
+
+	// no validation rules for ValidateOnly
+
+	if m.Sha256 != nil {
+		// no validation rules for Sha256
+	}
+
+	if len(errors) > 0 {
+		return ImportBackupStreamHeaderMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportBackupStreamHeaderMultiError is an error wrapping multiple validation
+// errors returned by ImportBackupStreamHeader.ValidateAll() if the designated
+// constraints aren't met.
+type ImportBackupStreamHeaderMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportBackupStreamHeaderMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportBackupStreamHeaderMultiError) AllErrors() []error { return m }
+
+// ImportBackupStreamHeaderValidationError is the validation error returned by
+// ImportBackupStreamHeader.Validate if the designated constraints aren't met.
+type ImportBackupStreamHeaderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportBackupStreamHeaderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportBackupStreamHeaderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportBackupStreamHeaderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportBackupStreamHeaderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportBackupStreamHeaderValidationError) ErrorName() string {
+	return "ImportBackupStreamHeaderValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportBackupStreamHeaderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportBackupStreamHeader.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportBackupStreamHeaderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportBackupStreamHeaderValidationError{}
+
+// Validate checks the field values on ImportBackupStreamRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportBackupStreamRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportBackupStreamRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportBackupStreamRequestMultiError, or nil if none found.
+func (m *ImportBackupStreamRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportBackupStreamRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Payload.(type) {
+	case *ImportBackupStreamRequest_Header:
+		if v == nil {
+			err := ImportBackupStreamRequestValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetHeader()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportBackupStreamRequestValidationError{
+						field:  "Header",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportBackupStreamRequestValidationError{
+						field:  "Header",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetHeader()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportBackupStreamRequestValidationError{
+					field:  "Header",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ImportBackupStreamRequest_Chunk:
+		if v == nil {
+			err := ImportBackupStreamRequestValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetChunk()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportBackupStreamRequestValidationError{
+						field:  "Chunk",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportBackupStreamRequestValidationError{
+						field:  "Chunk",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetChunk()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportBackupStreamRequestValidationError{
+					field:  "Chunk",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return ImportBackupStreamRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportBackupStreamRequestMultiError is an error wrapping multiple validation
+// errors returned by ImportBackupStreamRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ImportBackupStreamRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportBackupStreamRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportBackupStreamRequestMultiError) AllErrors() []error { return m }
+
+// ImportBackupStreamRequestValidationError is the validation error returned by
+// ImportBackupStreamRequest.Validate if the designated constraints aren't met.
+type ImportBackupStreamRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportBackupStreamRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportBackupStreamRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportBackupStreamRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportBackupStreamRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportBackupStreamRequestValidationError) ErrorName() string {
+	return "ImportBackupStreamRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportBackupStreamRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportBackupStreamRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportBackupStreamRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportBackupStreamRequestValidationError{}
+
+// Validate checks the field values on ImportBackupProgress with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportBackupProgress) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportBackupProgress with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportBackupProgressMultiError, or nil if none found.
+func (m *ImportBackupProgress) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportBackupProgress) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Event.(type) {
+	case *ImportBackupProgress_Progress:
+		if v == nil {
+			err := ImportBackupProgressValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetProgress()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportBackupProgressValidationError{
+						field:  "Progress",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportBackupProgressValidationError{
+						field:  "Progress",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetProgress()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportBackupProgressValidationError{
+					field:  "Progress",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ImportBackupProgress_Result:
+		if v == nil {
+			err := ImportBackupProgressValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetResult()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportBackupProgressValidationError{
+						field:  "Result",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportBackupProgressValidationError{
+						field:  "Result",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportBackupProgressValidationError{
+					field:  "Result",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return ImportBackupProgressMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportBackupProgressMultiError is an error wrapping multiple validation
+// errors returned by ImportBackupProgress.ValidateAll() if the designated
+// constraints aren't met.
+type ImportBackupProgressMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportBackupProgressMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportBackupProgressMultiError) AllErrors() []error { return m }
+
+// ImportBackupProgressValidationError is the validation error returned by
+// ImportBackupProgress.Validate if the designated constraints aren't met.
+type ImportBackupProgressValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportBackupProgressValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportBackupProgressValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportBackupProgressValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportBackupProgressValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportBackupProgressValidationError) ErrorName() string {
+	return "ImportBackupProgressValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportBackupProgressValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportBackupProgress.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportBackupProgressValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportBackupProgressValidationError{}
+
 // Validate checks the field values on EntityImportResult with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -19,8 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BackupService_ExportBackup_FullMethodName = "/paperless.service.v1.BackupService/ExportBackup"
-	BackupService_ImportBackup_FullMethodName = "/paperless.service.v1.BackupService/ImportBackup"
+	BackupService_ExportBackup_FullMethodName             = "/paperless.service.v1.BackupService/ExportBackup"
+	BackupService_ImportBackup_FullMethodName             = "/paperless.service.v1.BackupService/ImportBackup"
+	BackupService_RestoreFromBackup_FullMethodName        = "/paperless.service.v1.BackupService/RestoreFromBackup"
+	BackupService_ImportBackupWithProgress_FullMethodName = "/paperless.service.v1.BackupService/ImportBackupWithProgress"
+	BackupService_ExportBackupStream_FullMethodName       = "/paperless.service.v1.BackupService/ExportBackupStream"
+	BackupService_ImportBackupStream_FullMethodName       = "/paperless.service.v1.BackupService/ImportBackupStream"
 )
 
 // BackupServiceClient is the client API for BackupService service.
@@ -29,6 +33,14 @@ const (
 type BackupServiceClient interface {
 	ExportBackup(ctx context.Context, in *ExportBackupRequest, opts ...grpc.CallOption) (*ExportBackupResponse, error)
 	ImportBackup(ctx context.Context, in *ImportBackupRequest, opts ...grpc.CallOption) (*ImportBackupResponse, error)
+	// Restores selected documents or a category subtree from a backup
+	RestoreFromBackup(ctx context.Context, in *RestoreFromBackupRequest, opts ...grpc.CallOption) (*ImportBackupResponse, error)
+	// Imports like ImportBackup, streaming per-entity-type progress and warnings; gRPC only
+	ImportBackupWithProgress(ctx context.Context, in *ImportBackupRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ImportBackupProgress], error)
+	// Streams the backup in chunks followed by a summary; gRPC only
+	ExportBackupStream(ctx context.Context, in *ExportBackupRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportBackupStreamResponse], error)
+	// Restores a backup sent as a header followed by chunks; gRPC only
+	ImportBackupStream(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportBackupStreamRequest, ImportBackupResponse], error)
 }
 
 type backupServiceClient struct {
@@ -59,12 +71,81 @@ func (c *backupServiceClient) ImportBackup(ctx context.Context, in *ImportBackup
 	return out, nil
 }
 
+func (c *backupServiceClient) RestoreFromBackup(ctx context.Context, in *RestoreFromBackupRequest, opts ...grpc.CallOption) (*ImportBackupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportBackupResponse)
+	err := c.cc.Invoke(ctx, BackupService_RestoreFromBackup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backupServiceClient) ImportBackupWithProgress(ctx context.Context, in *ImportBackupRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ImportBackupProgress], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BackupService_ServiceDesc.Streams[0], BackupService_ImportBackupWithProgress_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportBackupRequest, ImportBackupProgress]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BackupService_ImportBackupWithProgressClient = grpc.ServerStreamingClient[ImportBackupProgress]
+
+func (c *backupServiceClient) ExportBackupStream(ctx context.Context, in *ExportBackupRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportBackupStreamResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BackupService_ServiceDesc.Streams[1], BackupService_ExportBackupStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportBackupRequest, ExportBackupStreamResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BackupService_ExportBackupStreamClient = grpc.ServerStreamingClient[ExportBackupStreamResponse]
+
+func (c *backupServiceClient) ImportBackupStream(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportBackupStreamRequest, ImportBackupResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BackupService_ServiceDesc.Streams[2], BackupService_ImportBackupStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportBackupStreamRequest, ImportBackupResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BackupService_ImportBackupStreamClient = grpc.ClientStreamingClient[ImportBackupStreamRequest, ImportBackupResponse]
+
 // BackupServiceServer is the server API for BackupService service.
 // All implementations must embed UnimplementedBackupServiceServer
 // for forward compatibility.
 type BackupServiceServer interface {
 	ExportBackup(context.Context, *ExportBackupRequest) (*ExportBackupResponse, error)
 	ImportBackup(context.Context, *ImportBackupRequest) (*ImportBackupResponse, error)
+	// Restores selected documents or a category subtree from a backup
+	RestoreFromBackup(context.Context, *RestoreFromBackupRequest) (*ImportBackupResponse, error)
+	// Imports like ImportBackup, streaming per-entity-type progress and warnings; gRPC only
+	ImportBackupWithProgress(*ImportBackupRequest, grpc.ServerStreamingServer[ImportBackupProgress]) error
+	// Streams the backup in chunks followed by a summary; gRPC only
+	ExportBackupStream(*ExportBackupRequest, grpc.ServerStreamingServer[ExportBackupStreamResponse]) error
+	// Restores a backup sent as a header followed by chunks; gRPC only
+	ImportBackupStream(grpc.ClientStreamingServer[ImportBackupStreamRequest, ImportBackupResponse]) error
 	mustEmbedUnimplementedBackupServiceServer()
 }
 
@@ -81,6 +162,18 @@ func (UnimplementedBackupServiceServer) ExportBackup(context.Context, *ExportBac
 func (UnimplementedBackupServiceServer) ImportBackup(context.Context, *ImportBackupRequest) (*ImportBackupResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ImportBackup not implemented")
 }
+func (UnimplementedBackupServiceServer) RestoreFromBackup(context.Context, *RestoreFromBackupRequest) (*ImportBackupResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreFromBackup not implemented")
+}
+func (UnimplementedBackupServiceServer) ImportBackupWithProgress(*ImportBackupRequest, grpc.ServerStreamingServer[ImportBackupProgress]) error {
+	return status.Error(codes.Unimplemented, "method ImportBackupWithProgress not implemented")
+}
+func (UnimplementedBackupServiceServer) ExportBackupStream(*ExportBackupRequest, grpc.ServerStreamingServer[ExportBackupStreamResponse]) error {
+	return status.Error(codes.Unimplemented, "method ExportBackupStream not implemented")
+}
+func (UnimplementedBackupServiceServer) ImportBackupStream(grpc.ClientStreamingServer[ImportBackupStreamRequest, ImportBackupResponse]) error {
+	return status.Error(codes.Unimplemented, "method ImportBackupStream not implemented")
+}
 func (UnimplementedBackupServiceServer) mustEmbedUnimplementedBackupServiceServer() {}
 func (UnimplementedBackupServiceServer) testEmbeddedByValue()                       {}
 
@@ -138,6 +231,53 @@ func _BackupService_ImportBackup_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BackupService_RestoreFromBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreFromBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackupServiceServer).RestoreFromBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BackupService_RestoreFromBackup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackupServiceServer).RestoreFromBackup(ctx, req.(*RestoreFromBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BackupService_ImportBackupWithProgress_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ImportBackupRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BackupServiceServer).ImportBackupWithProgress(m, &grpc.GenericServerStream[ImportBackupRequest, ImportBackupProgress]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BackupService_ImportBackupWithProgressServer = grpc.ServerStreamingServer[ImportBackupProgress]
+
+func _BackupService_ExportBackupStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportBackupRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BackupServiceServer).ExportBackupStream(m, &grpc.GenericServerStream[ExportBackupRequest, ExportBackupStreamResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BackupService_ExportBackupStreamServer = grpc.ServerStreamingServer[ExportBackupStreamResponse]
+
+func _BackupService_ImportBackupStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BackupServiceServer).ImportBackupStream(&grpc.GenericServerStream[ImportBackupStreamRequest, ImportBackupResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BackupService_ImportBackupStreamServer = grpc.ClientStreamingServer[ImportBackupStreamRequest, ImportBackupResponse]
+
 // BackupService_ServiceDesc is the grpc.ServiceDesc for BackupService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,7 +293,27 @@ var BackupService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ImportBackup",
 			Handler:    _BackupService_ImportBackup_Handler,
 		},
+		{
+			MethodName: "RestoreFromBackup",
+			Handler:    _BackupService_RestoreFromBackup_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportBackupWithProgress",
+			Handler:       _BackupService_ImportBackupWithProgress_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportBackupStream",
+			Handler:       _BackupService_ExportBackupStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportBackupStream",
+			Handler:       _BackupService_ImportBackupStream_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "paperless/service/v1/backup.proto",
 }
@@ -21,16 +21,20 @@ const _ = http.SupportPackageIsVersion1
 
 const OperationBackupServiceExportBackup = "/paperless.service.v1.BackupService/ExportBackup"
 const OperationBackupServiceImportBackup = "/paperless.service.v1.BackupService/ImportBackup"
+const OperationBackupServiceRestoreFromBackup = "/paperless.service.v1.BackupService/RestoreFromBackup"
 
 type BackupServiceHTTPServer interface {
 	ExportBackup(context.Context, *ExportBackupRequest) (*ExportBackupResponse, error)
 	ImportBackup(context.Context, *ImportBackupRequest) (*ImportBackupResponse, error)
+	// RestoreFromBackup Restores selected documents or a category subtree from a backup
+	RestoreFromBackup(context.Context, *RestoreFromBackupRequest) (*ImportBackupResponse, error)
 }
 
 func RegisterBackupServiceHTTPServer(s *http.Server, srv BackupServiceHTTPServer) {
 	r := s.Route("/")
 	r.GET("/v1/backup/export", _BackupService_ExportBackup0_HTTP_Handler(srv))
 	r.POST("/v1/backup/import", _BackupService_ImportBackup0_HTTP_Handler(srv))
+	r.POST("/v1/backup/restore", _BackupService_RestoreFromBackup0_HTTP_Handler(srv))
 }
 
 func _BackupService_ExportBackup0_HTTP_Handler(srv BackupServiceHTTPServer) func(ctx http.Context) error {
@@ -74,9 +78,33 @@ func _BackupService_ImportBackup0_HTTP_Handler(srv BackupServiceHTTPServer) func
 	}
 }
 
+func _BackupService_RestoreFromBackup0_HTTP_Handler(srv BackupServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RestoreFromBackupRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBackupServiceRestoreFromBackup)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RestoreFromBackup(ctx, req.(*RestoreFromBackupRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ImportBackupResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type BackupServiceHTTPClient interface {
 	ExportBackup(ctx context.Context, req *ExportBackupRequest, opts ...http.CallOption) (rsp *ExportBackupResponse, err error)
 	ImportBackup(ctx context.Context, req *ImportBackupRequest, opts ...http.CallOption) (rsp *ImportBackupResponse, err error)
+	// RestoreFromBackup Restores selected documents or a category subtree from a backup
+	RestoreFromBackup(ctx context.Context, req *RestoreFromBackupRequest, opts ...http.CallOption) (rsp *ImportBackupResponse, err error)
 }
 
 type BackupServiceHTTPClientImpl struct {
@@ -112,3 +140,17 @@ func (c *BackupServiceHTTPClientImpl) ImportBackup(ctx context.Context, in *Impo
 	}
 	return &out, nil
 }
+
+// RestoreFromBackup Restores selected documents or a category subtree from a backup
+func (c *BackupServiceHTTPClientImpl) RestoreFromBackup(ctx context.Context, in *RestoreFromBackupRequest, opts ...http.CallOption) (*ImportBackupResponse, error) {
+	var out ImportBackupResponse
+	pattern := "/v1/backup/restore"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBackupServiceRestoreFromBackup))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -11,7 +11,6 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -25,24 +24,202 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// How the subcategories of a category, or the root categories, are ordered
+type CategorySortMode int32
+
+const (
+	CategorySortMode_CATEGORY_SORT_MODE_UNSPECIFIED CategorySortMode = 0
+	// By sort_order, then name; ReorderCategories sets the order
+	CategorySortMode_CATEGORY_SORT_MODE_MANUAL CategorySortMode = 1
+	// By name
+	CategorySortMode_CATEGORY_SORT_MODE_ALPHABETICAL CategorySortMode = 2
+	// Most recent document activity first, then by name
+	CategorySortMode_CATEGORY_SORT_MODE_RECENT_ACTIVITY CategorySortMode = 3
+)
+
+// Enum value maps for CategorySortMode.
+var (
+	CategorySortMode_name = map[int32]string{
+		0: "CATEGORY_SORT_MODE_UNSPECIFIED",
+		1: "CATEGORY_SORT_MODE_MANUAL",
+		2: "CATEGORY_SORT_MODE_ALPHABETICAL",
+		3: "CATEGORY_SORT_MODE_RECENT_ACTIVITY",
+	}
+	CategorySortMode_value = map[string]int32{
+		"CATEGORY_SORT_MODE_UNSPECIFIED":     0,
+		"CATEGORY_SORT_MODE_MANUAL":          1,
+		"CATEGORY_SORT_MODE_ALPHABETICAL":    2,
+		"CATEGORY_SORT_MODE_RECENT_ACTIVITY": 3,
+	}
+)
+
+func (x CategorySortMode) Enum() *CategorySortMode {
+	p := new(CategorySortMode)
+	*p = x
+	return p
+}
+
+func (x CategorySortMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CategorySortMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_paperless_service_v1_category_proto_enumTypes[0].Descriptor()
+}
+
+func (CategorySortMode) Type() protoreflect.EnumType {
+	return &file_paperless_service_v1_category_proto_enumTypes[0]
+}
+
+func (x CategorySortMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CategorySortMode.Descriptor instead.
+func (CategorySortMode) EnumDescriptor() ([]byte, []int) {
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{0}
+}
+
+// What happens to a deleted category's contents
+type CategoryDeleteMode int32
+
+const (
+	// The category must be empty, unless force is set
+	CategoryDeleteMode_CATEGORY_DELETE_MODE_UNSPECIFIED CategoryDeleteMode = 0
+	// Delete the subcategories too; their documents become uncategorized
+	CategoryDeleteMode_CATEGORY_DELETE_MODE_SUBTREE CategoryDeleteMode = 1
+	// Delete the subcategories and move all their documents to the trash
+	CategoryDeleteMode_CATEGORY_DELETE_MODE_SUBTREE_WITH_DOCUMENTS CategoryDeleteMode = 2
+	// Move the documents and direct subcategories to target_category_id, then delete the category
+	CategoryDeleteMode_CATEGORY_DELETE_MODE_REASSIGN CategoryDeleteMode = 3
+)
+
+// Enum value maps for CategoryDeleteMode.
+var (
+	CategoryDeleteMode_name = map[int32]string{
+		0: "CATEGORY_DELETE_MODE_UNSPECIFIED",
+		1: "CATEGORY_DELETE_MODE_SUBTREE",
+		2: "CATEGORY_DELETE_MODE_SUBTREE_WITH_DOCUMENTS",
+		3: "CATEGORY_DELETE_MODE_REASSIGN",
+	}
+	CategoryDeleteMode_value = map[string]int32{
+		"CATEGORY_DELETE_MODE_UNSPECIFIED":            0,
+		"CATEGORY_DELETE_MODE_SUBTREE":                1,
+		"CATEGORY_DELETE_MODE_SUBTREE_WITH_DOCUMENTS": 2,
+		"CATEGORY_DELETE_MODE_REASSIGN":               3,
+	}
+)
+
+func (x CategoryDeleteMode) Enum() *CategoryDeleteMode {
+	p := new(CategoryDeleteMode)
+	*p = x
+	return p
+}
+
+func (x CategoryDeleteMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CategoryDeleteMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_paperless_service_v1_category_proto_enumTypes[1].Descriptor()
+}
+
+func (CategoryDeleteMode) Type() protoreflect.EnumType {
+	return &file_paperless_service_v1_category_proto_enumTypes[1]
+}
+
+func (x CategoryDeleteMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CategoryDeleteMode.Descriptor instead.
+func (CategoryDeleteMode) EnumDescriptor() ([]byte, []int) {
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{1}
+}
+
+type CategoryDeleteJobStatus int32
+
+const (
+	CategoryDeleteJobStatus_CATEGORY_DELETE_JOB_STATUS_UNSPECIFIED CategoryDeleteJobStatus = 0
+	CategoryDeleteJobStatus_CATEGORY_DELETE_JOB_STATUS_PENDING     CategoryDeleteJobStatus = 1
+	CategoryDeleteJobStatus_CATEGORY_DELETE_JOB_STATUS_RUNNING     CategoryDeleteJobStatus = 2
+	CategoryDeleteJobStatus_CATEGORY_DELETE_JOB_STATUS_SUCCEEDED   CategoryDeleteJobStatus = 3
+	CategoryDeleteJobStatus_CATEGORY_DELETE_JOB_STATUS_FAILED      CategoryDeleteJobStatus = 4
+)
+
+// Enum value maps for CategoryDeleteJobStatus.
+var (
+	CategoryDeleteJobStatus_name = map[int32]string{
+		0: "CATEGORY_DELETE_JOB_STATUS_UNSPECIFIED",
+		1: "CATEGORY_DELETE_JOB_STATUS_PENDING",
+		2: "CATEGORY_DELETE_JOB_STATUS_RUNNING",
+		3: "CATEGORY_DELETE_JOB_STATUS_SUCCEEDED",
+		4: "CATEGORY_DELETE_JOB_STATUS_FAILED",
+	}
+	CategoryDeleteJobStatus_value = map[string]int32{
+		"CATEGORY_DELETE_JOB_STATUS_UNSPECIFIED": 0,
+		"CATEGORY_DELETE_JOB_STATUS_PENDING":     1,
+		"CATEGORY_DELETE_JOB_STATUS_RUNNING":     2,
+		"CATEGORY_DELETE_JOB_STATUS_SUCCEEDED":   3,
+		"CATEGORY_DELETE_JOB_STATUS_FAILED":      4,
+	}
+)
+
+func (x CategoryDeleteJobStatus) Enum() *CategoryDeleteJobStatus {
+	p := new(CategoryDeleteJobStatus)
+	*p = x
+	return p
+}
+
+func (x CategoryDeleteJobStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CategoryDeleteJobStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_paperless_service_v1_category_proto_enumTypes[2].Descriptor()
+}
+
+func (CategoryDeleteJobStatus) Type() protoreflect.EnumType {
+	return &file_paperless_service_v1_category_proto_enumTypes[2]
+}
+
+func (x CategoryDeleteJobStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CategoryDeleteJobStatus.Descriptor instead.
+func (CategoryDeleteJobStatus) EnumDescriptor() ([]byte, []int) {
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{2}
+}
+
 // Category entity
 type Category struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TenantId         uint32                 `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	ParentId         *string                `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
-	Name             string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Path             string                 `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
-	Description      string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	Depth            int32                  `protobuf:"varint,7,opt,name=depth,proto3" json:"depth,omitempty"`
-	SortOrder        int32                  `protobuf:"varint,8,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
-	DocumentCount    int32                  `protobuf:"varint,9,opt,name=document_count,json=documentCount,proto3" json:"document_count,omitempty"`
-	SubcategoryCount int32                  `protobuf:"varint,10,opt,name=subcategory_count,json=subcategoryCount,proto3" json:"subcategory_count,omitempty"`
-	CreateTime       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	UpdateTime       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
-	CreatedBy        *uint32                `protobuf:"varint,13,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId             uint32                 `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	ParentId             *string                `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	Name                 string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Path                 string                 `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
+	Description          string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Depth                int32                  `protobuf:"varint,7,opt,name=depth,proto3" json:"depth,omitempty"`
+	SortOrder            int32                  `protobuf:"varint,8,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	DocumentCount        int32                  `protobuf:"varint,9,opt,name=document_count,json=documentCount,proto3" json:"document_count,omitempty"`
+	SubcategoryCount     int32                  `protobuf:"varint,10,opt,name=subcategory_count,json=subcategoryCount,proto3" json:"subcategory_count,omitempty"`
+	CreateTime           *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime           *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	CreatedBy            *uint32                `protobuf:"varint,13,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	Version              uint32                 `protobuf:"varint,14,opt,name=version,proto3" json:"version,omitempty"`                                                                               // Incremented on every write
+	SubtreeDocumentCount int32                  `protobuf:"varint,15,opt,name=subtree_document_count,json=subtreeDocumentCount,proto3" json:"subtree_document_count,omitempty"`                       // Documents in the category and its descendants
+	Color                string                 `protobuf:"bytes,16,opt,name=color,proto3" json:"color,omitempty"`                                                                                    // Display color as #RRGGBB
+	Icon                 string                 `protobuf:"bytes,17,opt,name=icon,proto3" json:"icon,omitempty"`                                                                                      // Icon name shown in the category tree
+	SubtreeDocumentBytes int64                  `protobuf:"varint,18,opt,name=subtree_document_bytes,json=subtreeDocumentBytes,proto3" json:"subtree_document_bytes,omitempty"`                       // Total file size of the documents in the category and its descendants
+	MaxDocuments         *int64                 `protobuf:"varint,19,opt,name=max_documents,json=maxDocuments,proto3,oneof" json:"max_documents,omitempty"`                                           // Most documents the category and its descendants may hold
+	MaxBytes             *int64                 `protobuf:"varint,20,opt,name=max_bytes,json=maxBytes,proto3,oneof" json:"max_bytes,omitempty"`                                                       // Most bytes the documents in the category and its descendants may take
+	Rules                *CategoryRules         `protobuf:"bytes,21,opt,name=rules,proto3" json:"rules,omitempty"`                                                                                    // Metadata given to documents filed or moved into the category
+	ChildSortMode        CategorySortMode       `protobuf:"varint,22,opt,name=child_sort_mode,json=childSortMode,proto3,enum=paperless.service.v1.CategorySortMode" json:"child_sort_mode,omitempty"` // How the subcategories are ordered
+	ActivityTime         *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=activity_time,json=activityTime,proto3" json:"activity_time,omitempty"`                                                  // Last time documents were added to, removed from or replaced in the category or its descendants
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *Category) Reset() {
@@ -166,6 +343,140 @@ func (x *Category) GetCreatedBy() uint32 {
 	return 0
 }
 
+func (x *Category) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Category) GetSubtreeDocumentCount() int32 {
+	if x != nil {
+		return x.SubtreeDocumentCount
+	}
+	return 0
+}
+
+func (x *Category) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *Category) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *Category) GetSubtreeDocumentBytes() int64 {
+	if x != nil {
+		return x.SubtreeDocumentBytes
+	}
+	return 0
+}
+
+func (x *Category) GetMaxDocuments() int64 {
+	if x != nil && x.MaxDocuments != nil {
+		return *x.MaxDocuments
+	}
+	return 0
+}
+
+func (x *Category) GetMaxBytes() int64 {
+	if x != nil && x.MaxBytes != nil {
+		return *x.MaxBytes
+	}
+	return 0
+}
+
+func (x *Category) GetRules() *CategoryRules {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *Category) GetChildSortMode() CategorySortMode {
+	if x != nil {
+		return x.ChildSortMode
+	}
+	return CategorySortMode_CATEGORY_SORT_MODE_UNSPECIFIED
+}
+
+func (x *Category) GetActivityTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActivityTime
+	}
+	return nil
+}
+
+// Metadata given to documents when they are filed or moved into a category
+type CategoryRules struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tags added to the document, replacing the values of tags it already has
+	Tags map[string]string `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Document type set on the document, unless empty
+	DocumentType string `protobuf:"bytes,2,opt,name=document_type,json=documentType,proto3" json:"document_type,omitempty"`
+	// Retention class set on the document, unless empty
+	RetentionClass string `protobuf:"bytes,3,opt,name=retention_class,json=retentionClass,proto3" json:"retention_class,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CategoryRules) Reset() {
+	*x = CategoryRules{}
+	mi := &file_paperless_service_v1_category_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryRules) ProtoMessage() {}
+
+func (x *CategoryRules) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_category_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryRules.ProtoReflect.Descriptor instead.
+func (*CategoryRules) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CategoryRules) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CategoryRules) GetDocumentType() string {
+	if x != nil {
+		return x.DocumentType
+	}
+	return ""
+}
+
+func (x *CategoryRules) GetRetentionClass() string {
+	if x != nil {
+		return x.RetentionClass
+	}
+	return ""
+}
+
 // Request to create a category
 type CreateCategoryRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -176,14 +487,21 @@ type CreateCategoryRequest struct {
 	// Optional description
 	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	// Sort order (lower numbers appear first)
-	SortOrder     int32 `protobuf:"varint,4,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	SortOrder int32 `protobuf:"varint,4,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	// Optional display color as #RRGGBB
+	Color string `protobuf:"bytes,5,opt,name=color,proto3" json:"color,omitempty"`
+	// Optional icon name, e.g. "folder" or "mdi:invoice"
+	Icon string `protobuf:"bytes,6,opt,name=icon,proto3" json:"icon,omitempty"`
+	// Copy the parent's explicit permissions onto the new subcategory
+	// (default: PAPERLESS_SUBCATEGORY_COPY_PERMISSIONS)
+	CopyParentPermissions *bool `protobuf:"varint,7,opt,name=copy_parent_permissions,json=copyParentPermissions,proto3,oneof" json:"copy_parent_permissions,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *CreateCategoryRequest) Reset() {
 	*x = CreateCategoryRequest{}
-	mi := &file_paperless_service_v1_category_proto_msgTypes[1]
+	mi := &file_paperless_service_v1_category_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -195,7 +513,7 @@ func (x *CreateCategoryRequest) String() string {
 func (*CreateCategoryRequest) ProtoMessage() {}
 
 func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_paperless_service_v1_category_proto_msgTypes[1]
+	mi := &file_paperless_service_v1_category_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -208,7 +526,7 @@ func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
 func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{1}
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateCategoryRequest) GetParentId() string {
@@ -239,6 +557,27 @@ func (x *CreateCategoryRequest) GetSortOrder() int32 {
 	return 0
 }
 
+func (x *CreateCategoryRequest) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetCopyParentPermissions() bool {
+	if x != nil && x.CopyParentPermissions != nil {
+		return *x.CopyParentPermissions
+	}
+	return false
+}
+
 type CreateCategoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
@@ -248,7 +587,7 @@ type CreateCategoryResponse struct {
 
 func (x *CreateCategoryResponse) Reset() {
 	*x = CreateCategoryResponse{}
-	mi := &file_paperless_service_v1_category_proto_msgTypes[2]
+	mi := &file_paperless_service_v1_category_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -260,7 +599,7 @@ func (x *CreateCategoryResponse) String() string {
 func (*CreateCategoryResponse) ProtoMessage() {}
 
 func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_paperless_service_v1_category_proto_msgTypes[2]
+	mi := &file_paperless_service_v1_category_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -273,7 +612,7 @@ func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCategoryResponse.ProtoReflect.Descriptor instead.
 func (*CreateCategoryResponse) Descriptor() ([]byte, []int) {
-	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{2}
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateCategoryResponse) GetCategory() *Category {
@@ -295,7 +634,7 @@ type GetCategoryRequest struct {
 
 func (x *GetCategoryRequest) Reset() {
 	*x = GetCategoryRequest{}
-	mi := &file_paperless_service_v1_category_proto_msgTypes[3]
+	mi := &file_paperless_service_v1_category_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -307,7 +646,7 @@ func (x *GetCategoryRequest) String() string {
 func (*GetCategoryRequest) ProtoMessage() {}
 
 func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_paperless_service_v1_category_proto_msgTypes[3]
+	mi := &file_paperless_service_v1_category_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -320,7 +659,7 @@ func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCategoryRequest.ProtoReflect.Descriptor instead.
 func (*GetCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{3}
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetCategoryRequest) GetId() string {
@@ -346,7 +685,7 @@ type GetCategoryResponse struct {
 
 func (x *GetCategoryResponse) Reset() {
 	*x = GetCategoryResponse{}
-	mi := &file_paperless_service_v1_category_proto_msgTypes[4]
+	mi := &file_paperless_service_v1_category_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -358,7 +697,7 @@ func (x *GetCategoryResponse) String() string {
 func (*GetCategoryResponse) ProtoMessage() {}
 
 func (x *GetCategoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_paperless_service_v1_category_proto_msgTypes[4]
+	mi := &file_paperless_service_v1_category_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -371,7 +710,7 @@ func (x *GetCategoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCategoryResponse.ProtoReflect.Descriptor instead.
 func (*GetCategoryResponse) Descriptor() ([]byte, []int) {
-	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{4}
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetCategoryResponse) GetCategory() *Category {
@@ -397,7 +736,7 @@ type ListCategoriesRequest struct {
 
 func (x *ListCategoriesRequest) Reset() {
 	*x = ListCategoriesRequest{}
-	mi := &file_paperless_service_v1_category_proto_msgTypes[5]
+	mi := &file_paperless_service_v1_category_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -409,7 +748,7 @@ func (x *ListCategoriesRequest) String() string {
 func (*ListCategoriesRequest) ProtoMessage() {}
 
 func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_paperless_service_v1_category_proto_msgTypes[5]
+	mi := &file_paperless_service_v1_category_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -422,7 +761,7 @@ func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
 func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
-	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{5}
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListCategoriesRequest) GetParentId() string {
@@ -463,7 +802,7 @@ type ListCategoriesResponse struct {
 
 func (x *ListCategoriesResponse) Reset() {
 	*x = ListCategoriesResponse{}
-	mi := &file_paperless_service_v1_category_proto_msgTypes[6]
+	mi := &file_paperless_service_v1_category_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -475,7 +814,7 @@ func (x *ListCategoriesResponse) String() string {
 func (*ListCategoriesResponse) ProtoMessage() {}
 
 func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_paperless_service_v1_category_proto_msgTypes[6]
+	mi := &file_paperless_service_v1_category_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -488,7 +827,7 @@ func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
 func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
-	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{6}
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListCategoriesResponse) GetCategories() []*Category {
@@ -514,14 +853,26 @@ type UpdateCategoryRequest struct {
 	// New description (optional)
 	Description *string `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
 	// New sort order (optional)
-	SortOrder     *int32 `protobuf:"varint,4,opt,name=sort_order,json=sortOrder,proto3,oneof" json:"sort_order,omitempty"`
+	SortOrder *int32 `protobuf:"varint,4,opt,name=sort_order,json=sortOrder,proto3,oneof" json:"sort_order,omitempty"`
+	// Version the client last read; the update fails with VERSION_CONFLICT if the category changed since
+	ExpectedVersion *uint32 `protobuf:"varint,5,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	// New color (optional), empty to clear
+	Color *string `protobuf:"bytes,6,opt,name=color,proto3,oneof" json:"color,omitempty"`
+	// New icon name (optional), empty to clear
+	Icon *string `protobuf:"bytes,7,opt,name=icon,proto3,oneof" json:"icon,omitempty"`
+	// New document quota of the subtree (optional, tenant admins only), 0 to remove it
+	MaxDocuments *int64 `protobuf:"varint,8,opt,name=max_documents,json=maxDocuments,proto3,oneof" json:"max_documents,omitempty"`
+	// New byte quota of the subtree (optional, tenant admins only), 0 to remove it
+	MaxBytes *int64 `protobuf:"varint,9,opt,name=max_bytes,json=maxBytes,proto3,oneof" json:"max_bytes,omitempty"`
+	// New rules (optional), replacing the current ones; an empty message removes them
+	Rules         *CategoryRules `protobuf:"bytes,10,opt,name=rules,proto3" json:"rules,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateCategoryRequest) Reset() {
 	*x = UpdateCategoryRequest{}
-	mi := &file_paperless_service_v1_category_proto_msgTypes[7]
+	mi := &file_paperless_service_v1_category_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -533,7 +884,7 @@ func (x *UpdateCategoryRequest) String() string {
 func (*UpdateCategoryRequest) ProtoMessage() {}
 
 func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_paperless_service_v1_category_proto_msgTypes[7]
+	mi := &file_paperless_service_v1_category_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -546,7 +897,7 @@ func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
 func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{7}
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UpdateCategoryRequest) GetId() string {
@@ -577,6 +928,48 @@ func (x *UpdateCategoryRequest) GetSortOrder() int32 {
 	return 0
 }
 
+func (x *UpdateCategoryRequest) GetExpectedVersion() uint32 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *UpdateCategoryRequest) GetColor() string {
+	if x != nil && x.Color != nil {
+		return *x.Color
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetIcon() string {
+	if x != nil && x.Icon != nil {
+		return *x.Icon
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetMaxDocuments() int64 {
+	if x != nil && x.MaxDocuments != nil {
+		return *x.MaxDocuments
+	}
+	return 0
+}
+
+func (x *UpdateCategoryRequest) GetMaxBytes() int64 {
+	if x != nil && x.MaxBytes != nil {
+		return *x.MaxBytes
+	}
+	return 0
+}
+
+func (x *UpdateCategoryRequest) GetRules() *CategoryRules {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
 type UpdateCategoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
@@ -586,7 +979,7 @@ type UpdateCategoryResponse struct {
 
 func (x *UpdateCategoryResponse) Reset() {
 	*x = UpdateCategoryResponse{}
-	mi := &file_paperless_service_v1_category_proto_msgTypes[8]
+	mi := &file_paperless_service_v1_category_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -598,7 +991,7 @@ func (x *UpdateCategoryResponse) String() string {
 func (*UpdateCategoryResponse) ProtoMessage() {}
 
 func (x *UpdateCategoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_paperless_service_v1_category_proto_msgTypes[8]
+	mi := &file_paperless_service_v1_category_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -611,7 +1004,7 @@ func (x *UpdateCategoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCategoryResponse.ProtoReflect.Descriptor instead.
 func (*UpdateCategoryResponse) Descriptor() ([]byte, []int) {
-	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{8}
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateCategoryResponse) GetCategory() *Category {
@@ -625,15 +1018,23 @@ func (x *UpdateCategoryResponse) GetCategory() *Category {
 type DeleteCategoryRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Force delete even if category contains items
-	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	// Force delete even if category contains items; same as CATEGORY_DELETE_MODE_SUBTREE
+	// when no mode is given
+	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	// What happens to the subcategories and documents
+	Mode CategoryDeleteMode `protobuf:"varint,3,opt,name=mode,proto3,enum=paperless.service.v1.CategoryDeleteMode" json:"mode,omitempty"`
+	// Category receiving the documents and subcategories in CATEGORY_DELETE_MODE_REASSIGN
+	// (root level if empty)
+	TargetCategoryId *string `protobuf:"bytes,4,opt,name=target_category_id,json=targetCategoryId,proto3,oneof" json:"target_category_id,omitempty"`
+	// Queue the deletion as a background job and return it instead of deleting right away
+	Background    bool `protobuf:"varint,5,opt,name=background,proto3" json:"background,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteCategoryRequest) Reset() {
 	*x = DeleteCategoryRequest{}
-	mi := &file_paperless_service_v1_category_proto_msgTypes[9]
+	mi := &file_paperless_service_v1_category_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -645,7 +1046,7 @@ func (x *DeleteCategoryRequest) String() string {
 func (*DeleteCategoryRequest) ProtoMessage() {}
 
 func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_paperless_service_v1_category_proto_msgTypes[9]
+	mi := &file_paperless_service_v1_category_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -658,7 +1059,7 @@ func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{9}
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeleteCategoryRequest) GetId() string {
@@ -675,31 +1076,1275 @@ func (x *DeleteCategoryRequest) GetForce() bool {
 	return false
 }
 
-// Request to move a category
-type MoveCategoryRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// New parent category ID (null to move to root)
-	NewParentId   *string `protobuf:"bytes,2,opt,name=new_parent_id,json=newParentId,proto3,oneof" json:"new_parent_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DeleteCategoryRequest) GetMode() CategoryDeleteMode {
+	if x != nil {
+		return x.Mode
+	}
+	return CategoryDeleteMode_CATEGORY_DELETE_MODE_UNSPECIFIED
+}
+
+func (x *DeleteCategoryRequest) GetTargetCategoryId() string {
+	if x != nil && x.TargetCategoryId != nil {
+		return *x.TargetCategoryId
+	}
+	return ""
+}
+
+func (x *DeleteCategoryRequest) GetBackground() bool {
+	if x != nil {
+		return x.Background
+	}
+	return false
+}
+
+type DeleteCategoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The queued job, set when background was requested
+	Job           *CategoryDeleteJob `protobuf:"bytes,1,opt,name=job,proto3,oneof" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryResponse) Reset() {
+	*x = DeleteCategoryResponse{}
+	mi := &file_paperless_service_v1_category_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryResponse) ProtoMessage() {}
+
+func (x *DeleteCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_category_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteCategoryResponse) GetJob() *CategoryDeleteJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+// A background category deletion
+type CategoryDeleteJob struct {
+	state             protoimpl.MessageState  `protogen:"open.v1"`
+	Id                string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CategoryId        string                  `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryPath      string                  `protobuf:"bytes,3,opt,name=category_path,json=categoryPath,proto3" json:"category_path,omitempty"`
+	Mode              CategoryDeleteMode      `protobuf:"varint,4,opt,name=mode,proto3,enum=paperless.service.v1.CategoryDeleteMode" json:"mode,omitempty"`
+	TargetCategoryId  *string                 `protobuf:"bytes,5,opt,name=target_category_id,json=targetCategoryId,proto3,oneof" json:"target_category_id,omitempty"`
+	Status            CategoryDeleteJobStatus `protobuf:"varint,6,opt,name=status,proto3,enum=paperless.service.v1.CategoryDeleteJobStatus" json:"status,omitempty"`
+	TotalItems        int64                   `protobuf:"varint,7,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"` // Categories and documents to process
+	ProcessedItems    int64                   `protobuf:"varint,8,opt,name=processed_items,json=processedItems,proto3" json:"processed_items,omitempty"`
+	CategoriesDeleted int64                   `protobuf:"varint,9,opt,name=categories_deleted,json=categoriesDeleted,proto3" json:"categories_deleted,omitempty"`
+	CategoriesMoved   int64                   `protobuf:"varint,10,opt,name=categories_moved,json=categoriesMoved,proto3" json:"categories_moved,omitempty"`
+	DocumentsDeleted  int64                   `protobuf:"varint,11,opt,name=documents_deleted,json=documentsDeleted,proto3" json:"documents_deleted,omitempty"`
+	DocumentsMoved    int64                   `protobuf:"varint,12,opt,name=documents_moved,json=documentsMoved,proto3" json:"documents_moved,omitempty"`
+	Error             string                  `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"` // Why the job failed
+	CreateTime        *timestamppb.Timestamp  `protobuf:"bytes,14,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime        *timestamppb.Timestamp  `protobuf:"bytes,15,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	FinishedAt        *timestamppb.Timestamp  `protobuf:"bytes,16,opt,name=finished_at,json=finishedAt,proto3,oneof" json:"finished_at,omitempty"`
+	CreatedBy         *uint32                 `protobuf:"varint,17,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CategoryDeleteJob) Reset() {
+	*x = CategoryDeleteJob{}
+	mi := &file_paperless_service_v1_category_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryDeleteJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryDeleteJob) ProtoMessage() {}
+
+func (x *CategoryDeleteJob) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_category_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryDeleteJob.ProtoReflect.Descriptor instead.
+func (*CategoryDeleteJob) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CategoryDeleteJob) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CategoryDeleteJob) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *CategoryDeleteJob) GetCategoryPath() string {
+	if x != nil {
+		return x.CategoryPath
+	}
+	return ""
+}
+
+func (x *CategoryDeleteJob) GetMode() CategoryDeleteMode {
+	if x != nil {
+		return x.Mode
+	}
+	return CategoryDeleteMode_CATEGORY_DELETE_MODE_UNSPECIFIED
+}
+
+func (x *CategoryDeleteJob) GetTargetCategoryId() string {
+	if x != nil && x.TargetCategoryId != nil {
+		return *x.TargetCategoryId
+	}
+	return ""
+}
+
+func (x *CategoryDeleteJob) GetStatus() CategoryDeleteJobStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CategoryDeleteJobStatus_CATEGORY_DELETE_JOB_STATUS_UNSPECIFIED
+}
+
+func (x *CategoryDeleteJob) GetTotalItems() int64 {
+	if x != nil {
+		return x.TotalItems
+	}
+	return 0
+}
+
+func (x *CategoryDeleteJob) GetProcessedItems() int64 {
+	if x != nil {
+		return x.ProcessedItems
+	}
+	return 0
+}
+
+func (x *CategoryDeleteJob) GetCategoriesDeleted() int64 {
+	if x != nil {
+		return x.CategoriesDeleted
+	}
+	return 0
+}
+
+func (x *CategoryDeleteJob) GetCategoriesMoved() int64 {
+	if x != nil {
+		return x.CategoriesMoved
+	}
+	return 0
+}
+
+func (x *CategoryDeleteJob) GetDocumentsDeleted() int64 {
+	if x != nil {
+		return x.DocumentsDeleted
+	}
+	return 0
+}
+
+func (x *CategoryDeleteJob) GetDocumentsMoved() int64 {
+	if x != nil {
+		return x.DocumentsMoved
+	}
+	return 0
+}
+
+func (x *CategoryDeleteJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CategoryDeleteJob) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *CategoryDeleteJob) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *CategoryDeleteJob) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *CategoryDeleteJob) GetCreatedBy() uint32 {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return 0
+}
+
+// Request to get a background category deletion
+type GetCategoryDeleteJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryDeleteJobRequest) Reset() {
+	*x = GetCategoryDeleteJobRequest{}
+	mi := &file_paperless_service_v1_category_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryDeleteJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryDeleteJobRequest) ProtoMessage() {}
+
+func (x *GetCategoryDeleteJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_category_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryDeleteJobRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryDeleteJobRequest) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetCategoryDeleteJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetCategoryDeleteJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *CategoryDeleteJob     `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryDeleteJobResponse) Reset() {
+	*x = GetCategoryDeleteJobResponse{}
+	mi := &file_paperless_service_v1_category_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryDeleteJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryDeleteJobResponse) ProtoMessage() {}
+
+func (x *GetCategoryDeleteJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_category_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryDeleteJobResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoryDeleteJobResponse) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetCategoryDeleteJobResponse) GetJob() *CategoryDeleteJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+// Request to move a category
+type MoveCategoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// New parent category ID (null to move to root)
+	NewParentId *string `protobuf:"bytes,2,opt,name=new_parent_id,json=newParentId,proto3,oneof" json:"new_parent_id,omitempty"`
+	// Version the client last read; the move fails with VERSION_CONFLICT if the category changed since
+	ExpectedVersion *uint32 `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *MoveCategoryRequest) Reset() {
 	*x = MoveCategoryRequest{}
-	mi := &file_paperless_service_v1_category_proto_msgTypes[10]
+	mi := &file_paperless_service_v1_category_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCategoryRequest) ProtoMessage() {}
+
+func (x *MoveCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_category_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCategoryRequest.ProtoReflect.Descriptor instead.
+func (*MoveCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MoveCategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MoveCategoryRequest) GetNewParentId() string {
+	if x != nil && x.NewParentId != nil {
+		return *x.NewParentId
+	}
+	return ""
+}
+
+func (x *MoveCategoryRequest) GetExpectedVersion() uint32 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+type MoveCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveCategoryResponse) Reset() {
+	*x = MoveCategoryResponse{}
+	mi := &file_paperless_service_v1_category_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCategoryResponse) ProtoMessage() {}
+
+func (x *MoveCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_category_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCategoryResponse.ProtoReflect.Descriptor instead.
+func (*MoveCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MoveCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+// Request to copy a category subtree
+type CopyCategoryTreeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Parent of the copy (null to copy to root)
+	NewParentId *string `protobuf:"bytes,2,opt,name=new_parent_id,json=newParentId,proto3,oneof" json:"new_parent_id,omitempty"`
+	// Name of the copy of the category (default: the category's name); its subcategories
+	// keep their names
+	Name *string `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	// Also copy the documents in the subtree, with their files
+	IncludeDocuments bool `protobuf:"varint,4,opt,name=include_documents,json=includeDocuments,proto3" json:"include_documents,omitempty"`
+	// Also copy the explicit permissions of the copied categories and documents
+	IncludePermissions bool `protobuf:"varint,5,opt,name=include_permissions,json=includePermissions,proto3" json:"include_permissions,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CopyCategoryTreeRequest) Reset() {
+	*x = CopyCategoryTreeRequest{}
+	mi := &file_paperless_service_v1_category_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyCategoryTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyCategoryTreeRequest) ProtoMessage() {}
+
+func (x *CopyCategoryTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_category_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyCategoryTreeRequest.ProtoReflect.Descriptor instead.
+func (*CopyCategoryTreeRequest) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CopyCategoryTreeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CopyCategoryTreeRequest) GetNewParentId() string {
+	if x != nil && x.NewParentId != nil {
+		return *x.NewParentId
+	}
+	return ""
+}
+
+func (x *CopyCategoryTreeRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *CopyCategoryTreeRequest) GetIncludeDocuments() bool {
+	if x != nil {
+		return x.IncludeDocuments
+	}
+	return false
+}
+
+func (x *CopyCategoryTreeRequest) GetIncludePermissions() bool {
+	if x != nil {
+		return x.IncludePermissions
+	}
+	return false
+}
+
+type CopyCategoryTreeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The copy of the category
+	Category *Category `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	// Categories and documents created
+	CategoryCount uint32 `protobuf:"varint,2,opt,name=category_count,json=categoryCount,proto3" json:"category_count,omitempty"`
+	DocumentCount uint32 `protobuf:"varint,3,opt,name=document_count,json=documentCount,proto3" json:"document_count,omitempty"`
+	// Documents that were not copied and why
+	Warnings      []string `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CopyCategoryTreeResponse) Reset() {
+	*x = CopyCategoryTreeResponse{}
+	mi := &file_paperless_service_v1_category_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyCategoryTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyCategoryTreeResponse) ProtoMessage() {}
+
+func (x *CopyCategoryTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_category_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyCategoryTreeResponse.ProtoReflect.Descriptor instead.
+func (*CopyCategoryTreeResponse) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CopyCategoryTreeResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *CopyCategoryTreeResponse) GetCategoryCount() uint32 {
+	if x != nil {
+		return x.CategoryCount
+	}
+	return 0
+}
+
+func (x *CopyCategoryTreeResponse) GetDocumentCount() uint32 {
+	if x != nil {
+		return x.DocumentCount
+	}
+	return 0
+}
+
+func (x *CopyCategoryTreeResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+// Request to set the sort mode of a category's subcategories
+type SetCategorySortModeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Category whose subcategories are ordered (null for the root categories)
+	ParentId      *string          `protobuf:"bytes,1,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	Mode          CategorySortMode `protobuf:"varint,2,opt,name=mode,proto3,enum=paperless.service.v1.CategorySortMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCategorySortModeRequest) Reset() {
+	*x = SetCategorySortModeRequest{}
+	mi := &file_paperless_service_v1_category_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCategorySortModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCategorySortModeRequest) ProtoMessage() {}
+
+func (x *SetCategorySortModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_category_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCategorySortModeRequest.ProtoReflect.Descriptor instead.
+func (*SetCategorySortModeRequest) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SetCategorySortModeRequest) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return ""
+}
+
+func (x *SetCategorySortModeRequest) GetMode() CategorySortMode {
+	if x != nil {
+		return x.Mode
+	}
+	return CategorySortMode_CATEGORY_SORT_MODE_UNSPECIFIED
+}
+
+type SetCategorySortModeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mode          CategorySortMode       `protobuf:"varint,1,opt,name=mode,proto3,enum=paperless.service.v1.CategorySortMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCategorySortModeResponse) Reset() {
+	*x = SetCategorySortModeResponse{}
+	mi := &file_paperless_service_v1_category_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCategorySortModeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCategorySortModeResponse) ProtoMessage() {}
+
+func (x *SetCategorySortModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_category_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCategorySortModeResponse.ProtoReflect.Descriptor instead.
+func (*SetCategorySortModeResponse) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SetCategorySortModeResponse) GetMode() CategorySortMode {
+	if x != nil {
+		return x.Mode
+	}
+	return CategorySortMode_CATEGORY_SORT_MODE_UNSPECIFIED
+}
+
+// Request to reorder the subcategories of a category
+type ReorderCategoriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Category whose subcategories are reordered (null for the root categories)
+	ParentId *string `protobuf:"bytes,1,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	// Subcategories in their new order; the ones left out follow in their current order
+	CategoryIds   []string `protobuf:"bytes,2,rep,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderCategoriesRequest) Reset() {
+	*x = ReorderCategoriesRequest{}
+	mi := &file_paperless_service_v1_category_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderCategoriesRequest) ProtoMessage() {}
+
+func (x *ReorderCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_category_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ReorderCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReorderCategoriesRequest) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return ""
+}
+
+func (x *ReorderCategoriesRequest) GetCategoryIds() []string {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+type ReorderCategoriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// All subcategories in their new order
+	Categories    []*Category `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderCategoriesResponse) Reset() {
+	*x = ReorderCategoriesResponse{}
+	mi := &file_paperless_service_v1_category_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderCategoriesResponse) ProtoMessage() {}
+
+func (x *ReorderCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_category_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ReorderCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ReorderCategoriesResponse) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+// Request to get category tree
+type GetCategoryTreeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Root category ID (null for entire tree)
+	RootId *string `protobuf:"bytes,1,opt,name=root_id,json=rootId,proto3,oneof" json:"root_id,omitempty"`
+	// Maximum depth to retrieve
+	MaxDepth *int32 `protobuf:"varint,2,opt,name=max_depth,json=maxDepth,proto3,oneof" json:"max_depth,omitempty"`
+	// Include document counts
+	IncludeCounts bool `protobuf:"varint,3,opt,name=include_counts,json=includeCounts,proto3" json:"include_counts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryTreeRequest) Reset() {
+	*x = GetCategoryTreeRequest{}
+	mi := &file_paperless_service_v1_category_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryTreeRequest) ProtoMessage() {}
+
+func (x *GetCategoryTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_category_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryTreeRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryTreeRequest) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetCategoryTreeRequest) GetRootId() string {
+	if x != nil && x.RootId != nil {
+		return *x.RootId
+	}
+	return ""
+}
+
+func (x *GetCategoryTreeRequest) GetMaxDepth() int32 {
+	if x != nil && x.MaxDepth != nil {
+		return *x.MaxDepth
+	}
+	return 0
+}
+
+func (x *GetCategoryTreeRequest) GetIncludeCounts() bool {
+	if x != nil {
+		return x.IncludeCounts
+	}
+	return false
+}
+
+// Category tree node
+type CategoryTreeNode struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Category *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Children []*CategoryTreeNode    `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`
+	// The category has subcategories the caller can read, also when children is empty
+	// because the node is at the depth limit
+	HasChildren   bool `protobuf:"varint,3,opt,name=has_children,json=hasChildren,proto3" json:"has_children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryTreeNode) Reset() {
+	*x = CategoryTreeNode{}
+	mi := &file_paperless_service_v1_category_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryTreeNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryTreeNode) ProtoMessage() {}
+
+func (x *CategoryTreeNode) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_category_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryTreeNode.ProtoReflect.Descriptor instead.
+func (*CategoryTreeNode) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CategoryTreeNode) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *CategoryTreeNode) GetChildren() []*CategoryTreeNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *CategoryTreeNode) GetHasChildren() bool {
+	if x != nil {
+		return x.HasChildren
+	}
+	return false
+}
+
+type GetCategoryTreeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roots         []*CategoryTreeNode    `protobuf:"bytes,1,rep,name=roots,proto3" json:"roots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryTreeResponse) Reset() {
+	*x = GetCategoryTreeResponse{}
+	mi := &file_paperless_service_v1_category_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryTreeResponse) ProtoMessage() {}
+
+func (x *GetCategoryTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_category_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryTreeResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoryTreeResponse) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetCategoryTreeResponse) GetRoots() []*CategoryTreeNode {
+	if x != nil {
+		return x.Roots
+	}
+	return nil
+}
+
+// Request to get the children of a category
+type GetCategoryChildrenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Parent category ID (null for root-level categories)
+	ParentId *string `protobuf:"bytes,1,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	// Pagination
+	Page     *uint32 `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize *uint32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	// Include document counts
+	IncludeCounts bool `protobuf:"varint,4,opt,name=include_counts,json=includeCounts,proto3" json:"include_counts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryChildrenRequest) Reset() {
+	*x = GetCategoryChildrenRequest{}
+	mi := &file_paperless_service_v1_category_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryChildrenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryChildrenRequest) ProtoMessage() {}
+
+func (x *GetCategoryChildrenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_category_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryChildrenRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryChildrenRequest) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetCategoryChildrenRequest) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return ""
+}
+
+func (x *GetCategoryChildrenRequest) GetPage() uint32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *GetCategoryChildrenRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *GetCategoryChildrenRequest) GetIncludeCounts() bool {
+	if x != nil {
+		return x.IncludeCounts
+	}
+	return false
+}
+
+type GetCategoryChildrenResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The children, without their own children; has_children tells which can be expanded
+	Children      []*CategoryTreeNode `protobuf:"bytes,1,rep,name=children,proto3" json:"children,omitempty"`
+	Total         uint32              `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryChildrenResponse) Reset() {
+	*x = GetCategoryChildrenResponse{}
+	mi := &file_paperless_service_v1_category_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryChildrenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryChildrenResponse) ProtoMessage() {}
+
+func (x *GetCategoryChildrenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_category_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryChildrenResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoryChildrenResponse) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetCategoryChildrenResponse) GetChildren() []*CategoryTreeNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *GetCategoryChildrenResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Request to get the ancestor chain of a category or document; exactly one ID is set
+type GetCategoryPathRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    *string                `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	DocumentId    *string                `protobuf:"bytes,2,opt,name=document_id,json=documentId,proto3,oneof" json:"document_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryPathRequest) Reset() {
+	*x = GetCategoryPathRequest{}
+	mi := &file_paperless_service_v1_category_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryPathRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryPathRequest) ProtoMessage() {}
+
+func (x *GetCategoryPathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_category_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryPathRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryPathRequest) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetCategoryPathRequest) GetCategoryId() string {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return ""
+}
+
+func (x *GetCategoryPathRequest) GetDocumentId() string {
+	if x != nil && x.DocumentId != nil {
+		return *x.DocumentId
+	}
+	return ""
+}
+
+// One category of a breadcrumb
+type CategoryPathSegment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The caller can read the category, so a breadcrumb can link to it
+	Readable      bool `protobuf:"varint,3,opt,name=readable,proto3" json:"readable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryPathSegment) Reset() {
+	*x = CategoryPathSegment{}
+	mi := &file_paperless_service_v1_category_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryPathSegment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryPathSegment) ProtoMessage() {}
+
+func (x *CategoryPathSegment) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_category_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryPathSegment.ProtoReflect.Descriptor instead.
+func (*CategoryPathSegment) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CategoryPathSegment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CategoryPathSegment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CategoryPathSegment) GetReadable() bool {
+	if x != nil {
+		return x.Readable
+	}
+	return false
+}
+
+type GetCategoryPathResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// From the root category down to the category itself, or to the document's category;
+	// empty for an uncategorized document
+	Segments      []*CategoryPathSegment `protobuf:"bytes,1,rep,name=segments,proto3" json:"segments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryPathResponse) Reset() {
+	*x = GetCategoryPathResponse{}
+	mi := &file_paperless_service_v1_category_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryPathResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryPathResponse) ProtoMessage() {}
+
+func (x *GetCategoryPathResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_category_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryPathResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoryPathResponse) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetCategoryPathResponse) GetSegments() []*CategoryPathSegment {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+// Request to rebuild category paths
+type RebuildCategoryPathsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tenant whose category tree is rebuilt (defaults to the caller's tenant)
+	TenantId *uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	// Only report the rows that would change, do not write them
+	DryRun        bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RebuildCategoryPathsRequest) Reset() {
+	*x = RebuildCategoryPathsRequest{}
+	mi := &file_paperless_service_v1_category_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MoveCategoryRequest) String() string {
+func (x *RebuildCategoryPathsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MoveCategoryRequest) ProtoMessage() {}
+func (*RebuildCategoryPathsRequest) ProtoMessage() {}
 
-func (x *MoveCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_paperless_service_v1_category_proto_msgTypes[10]
+func (x *RebuildCategoryPathsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_category_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -710,47 +2355,137 @@ func (x *MoveCategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MoveCategoryRequest.ProtoReflect.Descriptor instead.
-func (*MoveCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use RebuildCategoryPathsRequest.ProtoReflect.Descriptor instead.
+func (*RebuildCategoryPathsRequest) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *MoveCategoryRequest) GetId() string {
+func (x *RebuildCategoryPathsRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *RebuildCategoryPathsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// A category whose path or depth was recomputed
+type CategoryPathFix struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	OldPath       string                 `protobuf:"bytes,3,opt,name=old_path,json=oldPath,proto3" json:"old_path,omitempty"`
+	NewPath       string                 `protobuf:"bytes,4,opt,name=new_path,json=newPath,proto3" json:"new_path,omitempty"`
+	OldDepth      int32                  `protobuf:"varint,5,opt,name=old_depth,json=oldDepth,proto3" json:"old_depth,omitempty"`
+	NewDepth      int32                  `protobuf:"varint,6,opt,name=new_depth,json=newDepth,proto3" json:"new_depth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryPathFix) Reset() {
+	*x = CategoryPathFix{}
+	mi := &file_paperless_service_v1_category_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryPathFix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryPathFix) ProtoMessage() {}
+
+func (x *CategoryPathFix) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_category_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryPathFix.ProtoReflect.Descriptor instead.
+func (*CategoryPathFix) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CategoryPathFix) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *MoveCategoryRequest) GetNewParentId() string {
-	if x != nil && x.NewParentId != nil {
-		return *x.NewParentId
+func (x *CategoryPathFix) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-type MoveCategoryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CategoryPathFix) GetOldPath() string {
+	if x != nil {
+		return x.OldPath
+	}
+	return ""
 }
 
-func (x *MoveCategoryResponse) Reset() {
-	*x = MoveCategoryResponse{}
-	mi := &file_paperless_service_v1_category_proto_msgTypes[11]
+func (x *CategoryPathFix) GetNewPath() string {
+	if x != nil {
+		return x.NewPath
+	}
+	return ""
+}
+
+func (x *CategoryPathFix) GetOldDepth() int32 {
+	if x != nil {
+		return x.OldDepth
+	}
+	return 0
+}
+
+func (x *CategoryPathFix) GetNewDepth() int32 {
+	if x != nil {
+		return x.NewDepth
+	}
+	return 0
+}
+
+type RebuildCategoryPathsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of categories checked
+	Checked uint32 `protobuf:"varint,1,opt,name=checked,proto3" json:"checked,omitempty"`
+	// Categories whose path or depth was repaired (or would be, for dry runs)
+	Fixed []*CategoryPathFix `protobuf:"bytes,2,rep,name=fixed,proto3" json:"fixed,omitempty"`
+	// Categories whose parent chain is broken (missing parent or a cycle); they are left unchanged
+	UnreachableIds []string `protobuf:"bytes,3,rep,name=unreachable_ids,json=unreachableIds,proto3" json:"unreachable_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RebuildCategoryPathsResponse) Reset() {
+	*x = RebuildCategoryPathsResponse{}
+	mi := &file_paperless_service_v1_category_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MoveCategoryResponse) String() string {
+func (x *RebuildCategoryPathsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MoveCategoryResponse) ProtoMessage() {}
+func (*RebuildCategoryPathsResponse) ProtoMessage() {}
 
-func (x *MoveCategoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_paperless_service_v1_category_proto_msgTypes[11]
+func (x *RebuildCategoryPathsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_category_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -761,46 +2496,55 @@ func (x *MoveCategoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MoveCategoryResponse.ProtoReflect.Descriptor instead.
-func (*MoveCategoryResponse) Descriptor() ([]byte, []int) {
-	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use RebuildCategoryPathsResponse.ProtoReflect.Descriptor instead.
+func (*RebuildCategoryPathsResponse) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *MoveCategoryResponse) GetCategory() *Category {
+func (x *RebuildCategoryPathsResponse) GetChecked() uint32 {
 	if x != nil {
-		return x.Category
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *RebuildCategoryPathsResponse) GetFixed() []*CategoryPathFix {
+	if x != nil {
+		return x.Fixed
 	}
 	return nil
 }
 
-// Request to get category tree
-type GetCategoryTreeRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Root category ID (null for entire tree)
-	RootId *string `protobuf:"bytes,1,opt,name=root_id,json=rootId,proto3,oneof" json:"root_id,omitempty"`
-	// Maximum depth to retrieve
-	MaxDepth *int32 `protobuf:"varint,2,opt,name=max_depth,json=maxDepth,proto3,oneof" json:"max_depth,omitempty"`
-	// Include document counts
-	IncludeCounts bool `protobuf:"varint,3,opt,name=include_counts,json=includeCounts,proto3" json:"include_counts,omitempty"`
+func (x *RebuildCategoryPathsResponse) GetUnreachableIds() []string {
+	if x != nil {
+		return x.UnreachableIds
+	}
+	return nil
+}
+
+// Request to export a category subtree as a ZIP
+type ExportCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCategoryTreeRequest) Reset() {
-	*x = GetCategoryTreeRequest{}
-	mi := &file_paperless_service_v1_category_proto_msgTypes[12]
+func (x *ExportCategoryRequest) Reset() {
+	*x = ExportCategoryRequest{}
+	mi := &file_paperless_service_v1_category_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCategoryTreeRequest) String() string {
+func (x *ExportCategoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCategoryTreeRequest) ProtoMessage() {}
+func (*ExportCategoryRequest) ProtoMessage() {}
 
-func (x *GetCategoryTreeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_paperless_service_v1_category_proto_msgTypes[12]
+func (x *ExportCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_category_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -811,56 +2555,103 @@ func (x *GetCategoryTreeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCategoryTreeRequest.ProtoReflect.Descriptor instead.
-func (*GetCategoryTreeRequest) Descriptor() ([]byte, []int) {
-	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ExportCategoryRequest.ProtoReflect.Descriptor instead.
+func (*ExportCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *GetCategoryTreeRequest) GetRootId() string {
-	if x != nil && x.RootId != nil {
-		return *x.RootId
+func (x *ExportCategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetCategoryTreeRequest) GetMaxDepth() int32 {
-	if x != nil && x.MaxDepth != nil {
-		return *x.MaxDepth
+// A frame of an exported ZIP
+type CategoryExportChunk struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Position of the chunk in the stream, starting at 0
+	Sequence      uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Data          []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryExportChunk) Reset() {
+	*x = CategoryExportChunk{}
+	mi := &file_paperless_service_v1_category_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryExportChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryExportChunk) ProtoMessage() {}
+
+func (x *CategoryExportChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_category_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryExportChunk.ProtoReflect.Descriptor instead.
+func (*CategoryExportChunk) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CategoryExportChunk) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
 	}
 	return 0
 }
 
-func (x *GetCategoryTreeRequest) GetIncludeCounts() bool {
+func (x *CategoryExportChunk) GetData() []byte {
 	if x != nil {
-		return x.IncludeCounts
+		return x.Data
 	}
-	return false
+	return nil
 }
 
-// Category tree node
-type CategoryTreeNode struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
-	Children      []*CategoryTreeNode    `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`
+// Sent once after the last chunk of an export
+type CategoryExportSummary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Categories and documents in the ZIP
+	CategoryCount uint32 `protobuf:"varint,1,opt,name=category_count,json=categoryCount,proto3" json:"category_count,omitempty"`
+	DocumentCount uint32 `protobuf:"varint,2,opt,name=document_count,json=documentCount,proto3" json:"document_count,omitempty"`
+	// Documents left out, e.g. quarantined ones or files being restored from cold storage
+	Warnings []string `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	// Total size of the ZIP in bytes
+	Size uint64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	// Hex-encoded SHA-256 of the ZIP
+	Sha256        string `protobuf:"bytes,5,opt,name=sha256,proto3" json:"sha256,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CategoryTreeNode) Reset() {
-	*x = CategoryTreeNode{}
-	mi := &file_paperless_service_v1_category_proto_msgTypes[13]
+func (x *CategoryExportSummary) Reset() {
+	*x = CategoryExportSummary{}
+	mi := &file_paperless_service_v1_category_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CategoryTreeNode) String() string {
+func (x *CategoryExportSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CategoryTreeNode) ProtoMessage() {}
+func (*CategoryExportSummary) ProtoMessage() {}
 
-func (x *CategoryTreeNode) ProtoReflect() protoreflect.Message {
-	mi := &file_paperless_service_v1_category_proto_msgTypes[13]
+func (x *CategoryExportSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_category_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -871,47 +2662,72 @@ func (x *CategoryTreeNode) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CategoryTreeNode.ProtoReflect.Descriptor instead.
-func (*CategoryTreeNode) Descriptor() ([]byte, []int) {
-	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use CategoryExportSummary.ProtoReflect.Descriptor instead.
+func (*CategoryExportSummary) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *CategoryTreeNode) GetCategory() *Category {
+func (x *CategoryExportSummary) GetCategoryCount() uint32 {
 	if x != nil {
-		return x.Category
+		return x.CategoryCount
 	}
-	return nil
+	return 0
 }
 
-func (x *CategoryTreeNode) GetChildren() []*CategoryTreeNode {
+func (x *CategoryExportSummary) GetDocumentCount() uint32 {
 	if x != nil {
-		return x.Children
+		return x.DocumentCount
+	}
+	return 0
+}
+
+func (x *CategoryExportSummary) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
 	}
 	return nil
 }
 
-type GetCategoryTreeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Roots         []*CategoryTreeNode    `protobuf:"bytes,1,rep,name=roots,proto3" json:"roots,omitempty"`
+func (x *CategoryExportSummary) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *CategoryExportSummary) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+type ExportCategoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ExportCategoryResponse_Chunk
+	//	*ExportCategoryResponse_Summary
+	Payload       isExportCategoryResponse_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCategoryTreeResponse) Reset() {
-	*x = GetCategoryTreeResponse{}
-	mi := &file_paperless_service_v1_category_proto_msgTypes[14]
+func (x *ExportCategoryResponse) Reset() {
+	*x = ExportCategoryResponse{}
+	mi := &file_paperless_service_v1_category_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCategoryTreeResponse) String() string {
+func (x *ExportCategoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCategoryTreeResponse) ProtoMessage() {}
+func (*ExportCategoryResponse) ProtoMessage() {}
 
-func (x *GetCategoryTreeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_paperless_service_v1_category_proto_msgTypes[14]
+func (x *ExportCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_category_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -922,23 +2738,57 @@ func (x *GetCategoryTreeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCategoryTreeResponse.ProtoReflect.Descriptor instead.
-func (*GetCategoryTreeResponse) Descriptor() ([]byte, []int) {
-	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ExportCategoryResponse.ProtoReflect.Descriptor instead.
+func (*ExportCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_category_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GetCategoryTreeResponse) GetRoots() []*CategoryTreeNode {
+func (x *ExportCategoryResponse) GetPayload() isExportCategoryResponse_Payload {
 	if x != nil {
-		return x.Roots
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ExportCategoryResponse) GetChunk() *CategoryExportChunk {
+	if x != nil {
+		if x, ok := x.Payload.(*ExportCategoryResponse_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+func (x *ExportCategoryResponse) GetSummary() *CategoryExportSummary {
+	if x != nil {
+		if x, ok := x.Payload.(*ExportCategoryResponse_Summary); ok {
+			return x.Summary
+		}
 	}
 	return nil
 }
 
+type isExportCategoryResponse_Payload interface {
+	isExportCategoryResponse_Payload()
+}
+
+type ExportCategoryResponse_Chunk struct {
+	Chunk *CategoryExportChunk `protobuf:"bytes,1,opt,name=chunk,proto3,oneof"`
+}
+
+type ExportCategoryResponse_Summary struct {
+	Summary *CategoryExportSummary `protobuf:"bytes,2,opt,name=summary,proto3,oneof"`
+}
+
+func (*ExportCategoryResponse_Chunk) isExportCategoryResponse_Payload() {}
+
+func (*ExportCategoryResponse_Summary) isExportCategoryResponse_Payload() {}
+
 var File_paperless_service_v1_category_proto protoreflect.FileDescriptor
 
 const file_paperless_service_v1_category_proto_rawDesc = "" +
 	"\n" +
-	"#paperless/service/v1/category.proto\x12\x14paperless.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe7\x03\n" +
+	"#paperless/service/v1/category.proto\x12\x14paperless.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xcf\a\n" +
 	"\bCategory\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\ttenant_id\x18\x02 \x01(\rR\btenantId\x12 \n" +
@@ -957,30 +2807,54 @@ const file_paperless_service_v1_category_proto_rawDesc = "" +
 	"\vupdate_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"updateTime\x12\"\n" +
 	"\n" +
-	"created_by\x18\r \x01(\rH\x01R\tcreatedBy\x88\x01\x01B\f\n" +
+	"created_by\x18\r \x01(\rH\x01R\tcreatedBy\x88\x01\x01\x12\x18\n" +
+	"\aversion\x18\x0e \x01(\rR\aversion\x124\n" +
+	"\x16subtree_document_count\x18\x0f \x01(\x05R\x14subtreeDocumentCount\x12\x14\n" +
+	"\x05color\x18\x10 \x01(\tR\x05color\x12\x12\n" +
+	"\x04icon\x18\x11 \x01(\tR\x04icon\x124\n" +
+	"\x16subtree_document_bytes\x18\x12 \x01(\x03R\x14subtreeDocumentBytes\x12(\n" +
+	"\rmax_documents\x18\x13 \x01(\x03H\x02R\fmaxDocuments\x88\x01\x01\x12 \n" +
+	"\tmax_bytes\x18\x14 \x01(\x03H\x03R\bmaxBytes\x88\x01\x01\x129\n" +
+	"\x05rules\x18\x15 \x01(\v2#.paperless.service.v1.CategoryRulesR\x05rules\x12N\n" +
+	"\x0fchild_sort_mode\x18\x16 \x01(\x0e2&.paperless.service.v1.CategorySortModeR\rchildSortMode\x12?\n" +
+	"\ractivity_time\x18\x17 \x01(\v2\x1a.google.protobuf.TimestampR\factivityTimeB\f\n" +
 	"\n" +
 	"_parent_idB\r\n" +
-	"\v_created_by\"\xf4\x01\n" +
-	"\x15CreateCategoryRequest\x12=\n" +
-	"\tparent_id\x18\x01 \x01(\tB\x1b\xbaH\x18r\x16\x10\x00\x18$2\x10^[a-fA-F0-9\\-]*$H\x00R\bparentId\x88\x01\x01\x12C\n" +
+	"\v_created_byB\x10\n" +
+	"\x0e_max_documentsB\f\n" +
+	"\n" +
+	"_max_bytes\"\xeb\x01\n" +
+	"\rCategoryRules\x12A\n" +
+	"\x04tags\x18\x01 \x03(\v2-.paperless.service.v1.CategoryRules.TagsEntryR\x04tags\x12,\n" +
+	"\rdocument_type\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x18@R\fdocumentType\x120\n" +
+	"\x0fretention_class\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x18@R\x0eretentionClass\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x96\x04\n" +
+	"\x15CreateCategoryRequest\x12\xa1\x01\n" +
+	"\tparent_id\x18\x01 \x01(\tB\x7f\xbaH|rz\x10\x00\x18$2t^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})?$H\x00R\bparentId\x88\x01\x01\x12C\n" +
 	"\x04name\x18\x02 \x01(\tB/\xe0A\x02\xbaH)r'\x10\x01\x18\xff\x012 ^[a-zA-Z0-9][a-zA-Z0-9\\-_\\.\\s]*$R\x04name\x12*\n" +
 	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bR\vdescription\x12\x1d\n" +
 	"\n" +
-	"sort_order\x18\x04 \x01(\x05R\tsortOrderB\f\n" +
+	"sort_order\x18\x04 \x01(\x05R\tsortOrder\x121\n" +
+	"\x05color\x18\x05 \x01(\tB\x1b\xbaH\x18r\x162\x14^(#[0-9A-Fa-f]{6})?$R\x05color\x12/\n" +
+	"\x04icon\x18\x06 \x01(\tB\x1b\xbaH\x18r\x16\x18@2\x12^[a-zA-Z0-9\\-_:]*$R\x04icon\x12;\n" +
+	"\x17copy_parent_permissions\x18\a \x01(\bH\x01R\x15copyParentPermissions\x88\x01\x01B\f\n" +
 	"\n" +
-	"_parent_id\"T\n" +
+	"_parent_idB\x1a\n" +
+	"\x18_copy_parent_permissions\"T\n" +
 	"\x16CreateCategoryResponse\x12:\n" +
-	"\bcategory\x18\x01 \x01(\v2\x1e.paperless.service.v1.CategoryR\bcategory\"k\n" +
-	"\x12GetCategoryRequest\x12.\n" +
-	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x12%\n" +
+	"\bcategory\x18\x01 \x01(\v2\x1e.paperless.service.v1.CategoryR\bcategory\"\xd0\x01\n" +
+	"\x12GetCategoryRequest\x12\x92\x01\n" +
+	"\x02id\x18\x01 \x01(\tB\x81\x01\xe0A\x02\xbaH{ry\x10\x01\x18$2s^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})$R\x02id\x12%\n" +
 	"\x0einclude_counts\x18\x02 \x01(\bR\rincludeCounts\"Q\n" +
 	"\x13GetCategoryResponse\x12:\n" +
-	"\bcategory\x18\x01 \x01(\v2\x1e.paperless.service.v1.CategoryR\bcategory\"\xea\x01\n" +
-	"\x15ListCategoriesRequest\x12;\n" +
-	"\tparent_id\x18\x01 \x01(\tB\x19\xbaH\x16r\x14\x18$2\x10^[a-fA-F0-9\\-]*$H\x00R\bparentId\x88\x01\x01\x12\x17\n" +
-	"\x04page\x18\x02 \x01(\rH\x01R\x04page\x88\x01\x01\x12 \n" +
-	"\tpage_size\x18\x03 \x01(\rH\x02R\bpageSize\x88\x01\x01\x12$\n" +
-	"\vname_filter\x18\x04 \x01(\tH\x03R\n" +
+	"\bcategory\x18\x01 \x01(\v2\x1e.paperless.service.v1.CategoryR\bcategory\"\xe3\x02\n" +
+	"\x15ListCategoriesRequest\x12\x9f\x01\n" +
+	"\tparent_id\x18\x01 \x01(\tB}\xbaHzrx\x18$2t^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})?$H\x00R\bparentId\x88\x01\x01\x12\x17\n" +
+	"\x04page\x18\x02 \x01(\rH\x01R\x04page\x88\x01\x01\x12*\n" +
+	"\tpage_size\x18\x03 \x01(\rB\b\xbaH\x05*\x03\x18\xe8\aH\x02R\bpageSize\x88\x01\x01\x12.\n" +
+	"\vname_filter\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x03R\n" +
 	"nameFilter\x88\x01\x01B\f\n" +
 	"\n" +
 	"_parent_idB\a\n" +
@@ -992,48 +2866,215 @@ const file_paperless_service_v1_category_proto_rawDesc = "" +
 	"\n" +
 	"categories\x18\x01 \x03(\v2\x1e.paperless.service.v1.CategoryR\n" +
 	"categories\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\rR\x05total\"\x8b\x02\n" +
-	"\x15UpdateCategoryRequest\x12.\n" +
-	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x12E\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total\"\xef\x05\n" +
+	"\x15UpdateCategoryRequest\x12\x92\x01\n" +
+	"\x02id\x18\x01 \x01(\tB\x81\x01\xe0A\x02\xbaH{ry\x10\x01\x18$2s^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})$R\x02id\x12E\n" +
 	"\x04name\x18\x02 \x01(\tB,\xbaH)r'\x10\x01\x18\xff\x012 ^[a-zA-Z0-9][a-zA-Z0-9\\-_\\.\\s]*$H\x00R\x04name\x88\x01\x01\x12/\n" +
 	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bH\x01R\vdescription\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"sort_order\x18\x04 \x01(\x05H\x02R\tsortOrder\x88\x01\x01B\a\n" +
+	"sort_order\x18\x04 \x01(\x05H\x02R\tsortOrder\x88\x01\x01\x12.\n" +
+	"\x10expected_version\x18\x05 \x01(\rH\x03R\x0fexpectedVersion\x88\x01\x01\x126\n" +
+	"\x05color\x18\x06 \x01(\tB\x1b\xbaH\x18r\x162\x14^(#[0-9A-Fa-f]{6})?$H\x04R\x05color\x88\x01\x01\x124\n" +
+	"\x04icon\x18\a \x01(\tB\x1b\xbaH\x18r\x16\x18@2\x12^[a-zA-Z0-9\\-_:]*$H\x05R\x04icon\x88\x01\x01\x121\n" +
+	"\rmax_documents\x18\b \x01(\x03B\a\xbaH\x04\"\x02(\x00H\x06R\fmaxDocuments\x88\x01\x01\x12)\n" +
+	"\tmax_bytes\x18\t \x01(\x03B\a\xbaH\x04\"\x02(\x00H\aR\bmaxBytes\x88\x01\x01\x129\n" +
+	"\x05rules\x18\n" +
+	" \x01(\v2#.paperless.service.v1.CategoryRulesR\x05rulesB\a\n" +
 	"\x05_nameB\x0e\n" +
 	"\f_descriptionB\r\n" +
-	"\v_sort_order\"T\n" +
+	"\v_sort_orderB\x13\n" +
+	"\x11_expected_versionB\b\n" +
+	"\x06_colorB\a\n" +
+	"\x05_iconB\x10\n" +
+	"\x0e_max_documentsB\f\n" +
+	"\n" +
+	"_max_bytes\"T\n" +
 	"\x16UpdateCategoryResponse\x12:\n" +
-	"\bcategory\x18\x01 \x01(\v2\x1e.paperless.service.v1.CategoryR\bcategory\"]\n" +
-	"\x15DeleteCategoryRequest\x12.\n" +
-	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x12\x14\n" +
-	"\x05force\x18\x02 \x01(\bR\x05force\"\x9b\x01\n" +
-	"\x13MoveCategoryRequest\x12.\n" +
-	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x12B\n" +
-	"\rnew_parent_id\x18\x02 \x01(\tB\x19\xbaH\x16r\x14\x18$2\x10^[a-fA-F0-9\\-]*$H\x00R\vnewParentId\x88\x01\x01B\x10\n" +
-	"\x0e_new_parent_id\"R\n" +
+	"\bcategory\x18\x01 \x01(\v2\x1e.paperless.service.v1.CategoryR\bcategory\"\xf4\x03\n" +
+	"\x15DeleteCategoryRequest\x12\x92\x01\n" +
+	"\x02id\x18\x01 \x01(\tB\x81\x01\xe0A\x02\xbaH{ry\x10\x01\x18$2s^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})$R\x02id\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\x12F\n" +
+	"\x04mode\x18\x03 \x01(\x0e2(.paperless.service.v1.CategoryDeleteModeB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04mode\x12\xb0\x01\n" +
+	"\x12target_category_id\x18\x04 \x01(\tB}\xbaHzrx\x18$2t^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})?$H\x00R\x10targetCategoryId\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"background\x18\x05 \x01(\bR\n" +
+	"backgroundB\x15\n" +
+	"\x13_target_category_id\"`\n" +
+	"\x16DeleteCategoryResponse\x12>\n" +
+	"\x03job\x18\x01 \x01(\v2'.paperless.service.v1.CategoryDeleteJobH\x00R\x03job\x88\x01\x01B\x06\n" +
+	"\x04_job\"\xc7\x06\n" +
+	"\x11CategoryDeleteJob\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\tR\n" +
+	"categoryId\x12#\n" +
+	"\rcategory_path\x18\x03 \x01(\tR\fcategoryPath\x12<\n" +
+	"\x04mode\x18\x04 \x01(\x0e2(.paperless.service.v1.CategoryDeleteModeR\x04mode\x121\n" +
+	"\x12target_category_id\x18\x05 \x01(\tH\x00R\x10targetCategoryId\x88\x01\x01\x12E\n" +
+	"\x06status\x18\x06 \x01(\x0e2-.paperless.service.v1.CategoryDeleteJobStatusR\x06status\x12\x1f\n" +
+	"\vtotal_items\x18\a \x01(\x03R\n" +
+	"totalItems\x12'\n" +
+	"\x0fprocessed_items\x18\b \x01(\x03R\x0eprocessedItems\x12-\n" +
+	"\x12categories_deleted\x18\t \x01(\x03R\x11categoriesDeleted\x12)\n" +
+	"\x10categories_moved\x18\n" +
+	" \x01(\x03R\x0fcategoriesMoved\x12+\n" +
+	"\x11documents_deleted\x18\v \x01(\x03R\x10documentsDeleted\x12'\n" +
+	"\x0fdocuments_moved\x18\f \x01(\x03R\x0edocumentsMoved\x12\x14\n" +
+	"\x05error\x18\r \x01(\tR\x05error\x12;\n" +
+	"\vcreate_time\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x12@\n" +
+	"\vfinished_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\n" +
+	"finishedAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_by\x18\x11 \x01(\rH\x02R\tcreatedBy\x88\x01\x01B\x15\n" +
+	"\x13_target_category_idB\x0e\n" +
+	"\f_finished_atB\r\n" +
+	"\v_created_by\"\xb2\x01\n" +
+	"\x1bGetCategoryDeleteJobRequest\x12\x92\x01\n" +
+	"\x02id\x18\x01 \x01(\tB\x81\x01\xe0A\x02\xbaH{ry\x10\x01\x18$2s^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})$R\x02id\"Y\n" +
+	"\x1cGetCategoryDeleteJobResponse\x129\n" +
+	"\x03job\x18\x01 \x01(\v2'.paperless.service.v1.CategoryDeleteJobR\x03job\"\xaa\x03\n" +
+	"\x13MoveCategoryRequest\x12\x92\x01\n" +
+	"\x02id\x18\x01 \x01(\tB\x81\x01\xe0A\x02\xbaH{ry\x10\x01\x18$2s^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})$R\x02id\x12\xa6\x01\n" +
+	"\rnew_parent_id\x18\x02 \x01(\tB}\xbaHzrx\x18$2t^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})?$H\x00R\vnewParentId\x88\x01\x01\x12.\n" +
+	"\x10expected_version\x18\x03 \x01(\rH\x01R\x0fexpectedVersion\x88\x01\x01B\x10\n" +
+	"\x0e_new_parent_idB\x13\n" +
+	"\x11_expected_version\"R\n" +
 	"\x14MoveCategoryResponse\x12:\n" +
-	"\bcategory\x18\x01 \x01(\v2\x1e.paperless.service.v1.CategoryR\bcategory\"\xbf\x01\n" +
-	"\x16GetCategoryTreeRequest\x127\n" +
-	"\aroot_id\x18\x01 \x01(\tB\x19\xbaH\x16r\x14\x18$2\x10^[a-fA-F0-9\\-]*$H\x00R\x06rootId\x88\x01\x01\x12+\n" +
+	"\bcategory\x18\x01 \x01(\v2\x1e.paperless.service.v1.CategoryR\bcategory\"\x97\x04\n" +
+	"\x17CopyCategoryTreeRequest\x12\x92\x01\n" +
+	"\x02id\x18\x01 \x01(\tB\x81\x01\xe0A\x02\xbaH{ry\x10\x01\x18$2s^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})$R\x02id\x12\xa6\x01\n" +
+	"\rnew_parent_id\x18\x02 \x01(\tB}\xbaHzrx\x18$2t^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})?$H\x00R\vnewParentId\x88\x01\x01\x12E\n" +
+	"\x04name\x18\x03 \x01(\tB,\xbaH)r'\x10\x01\x18\xff\x012 ^[a-zA-Z0-9][a-zA-Z0-9\\-_\\.\\s]*$H\x01R\x04name\x88\x01\x01\x12+\n" +
+	"\x11include_documents\x18\x04 \x01(\bR\x10includeDocuments\x12/\n" +
+	"\x13include_permissions\x18\x05 \x01(\bR\x12includePermissionsB\x10\n" +
+	"\x0e_new_parent_idB\a\n" +
+	"\x05_name\"\xc0\x01\n" +
+	"\x18CopyCategoryTreeResponse\x12:\n" +
+	"\bcategory\x18\x01 \x01(\v2\x1e.paperless.service.v1.CategoryR\bcategory\x12%\n" +
+	"\x0ecategory_count\x18\x02 \x01(\rR\rcategoryCount\x12%\n" +
+	"\x0edocument_count\x18\x03 \x01(\rR\rdocumentCount\x12\x1a\n" +
+	"\bwarnings\x18\x04 \x03(\tR\bwarnings\"\x97\x02\n" +
+	"\x1aSetCategorySortModeRequest\x12\x9f\x01\n" +
+	"\tparent_id\x18\x01 \x01(\tB}\xbaHzrx\x18$2t^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})?$H\x00R\bparentId\x88\x01\x01\x12I\n" +
+	"\x04mode\x18\x02 \x01(\x0e2&.paperless.service.v1.CategorySortModeB\r\xe0A\x02\xbaH\a\x82\x01\x04\x10\x01 \x00R\x04modeB\f\n" +
+	"\n" +
+	"_parent_id\"Y\n" +
+	"\x1bSetCategorySortModeResponse\x12:\n" +
+	"\x04mode\x18\x01 \x01(\x0e2&.paperless.service.v1.CategorySortModeR\x04mode\"\xfd\x02\n" +
+	"\x18ReorderCategoriesRequest\x12\x9f\x01\n" +
+	"\tparent_id\x18\x01 \x01(\tB}\xbaHzrx\x18$2t^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})?$H\x00R\bparentId\x88\x01\x01\x12\xb0\x01\n" +
+	"\fcategory_ids\x18\x02 \x03(\tB\x8c\x01\xbaH\x88\x01\x92\x01\x84\x01\b\x01\x10\xe8\a\x18\x01\"{ry\x10\x01\x18$2s^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})$R\vcategoryIdsB\f\n" +
+	"\n" +
+	"_parent_id\"[\n" +
+	"\x19ReorderCategoriesResponse\x12>\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x1e.paperless.service.v1.CategoryR\n" +
+	"categories\"\xa4\x02\n" +
+	"\x16GetCategoryTreeRequest\x12\x9b\x01\n" +
+	"\aroot_id\x18\x01 \x01(\tB}\xbaHzrx\x18$2t^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})?$H\x00R\x06rootId\x88\x01\x01\x12+\n" +
 	"\tmax_depth\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18\x14(\x01H\x01R\bmaxDepth\x88\x01\x01\x12%\n" +
 	"\x0einclude_counts\x18\x03 \x01(\bR\rincludeCountsB\n" +
 	"\n" +
 	"\b_root_idB\f\n" +
 	"\n" +
-	"_max_depth\"\x92\x01\n" +
+	"_max_depth\"\xb5\x01\n" +
 	"\x10CategoryTreeNode\x12:\n" +
 	"\bcategory\x18\x01 \x01(\v2\x1e.paperless.service.v1.CategoryR\bcategory\x12B\n" +
-	"\bchildren\x18\x02 \x03(\v2&.paperless.service.v1.CategoryTreeNodeR\bchildren\"W\n" +
+	"\bchildren\x18\x02 \x03(\v2&.paperless.service.v1.CategoryTreeNodeR\bchildren\x12!\n" +
+	"\fhas_children\x18\x03 \x01(\bR\vhasChildren\"W\n" +
 	"\x17GetCategoryTreeResponse\x12<\n" +
-	"\x05roots\x18\x01 \x03(\v2&.paperless.service.v1.CategoryTreeNodeR\x05roots2\xc7\a\n" +
+	"\x05roots\x18\x01 \x03(\v2&.paperless.service.v1.CategoryTreeNodeR\x05roots\"\xcf\x02\n" +
+	"\x1aGetCategoryChildrenRequest\x12\x9f\x01\n" +
+	"\tparent_id\x18\x01 \x01(\tB}\xbaHzrx\x18$2t^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})?$H\x00R\bparentId\x88\x01\x01\x12\x17\n" +
+	"\x04page\x18\x02 \x01(\rH\x01R\x04page\x88\x01\x01\x12*\n" +
+	"\tpage_size\x18\x03 \x01(\rB\b\xbaH\x05*\x03\x18\xf4\x03H\x02R\bpageSize\x88\x01\x01\x12%\n" +
+	"\x0einclude_counts\x18\x04 \x01(\bR\rincludeCountsB\f\n" +
+	"\n" +
+	"_parent_idB\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_size\"w\n" +
+	"\x1bGetCategoryChildrenResponse\x12B\n" +
+	"\bchildren\x18\x01 \x03(\v2&.paperless.service.v1.CategoryTreeNodeR\bchildren\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total\"\x86\x03\n" +
+	"\x16GetCategoryPathRequest\x12\xa4\x01\n" +
+	"\vcategory_id\x18\x01 \x01(\tB~\xbaH{ry\x10\x01\x18$2s^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})$H\x00R\n" +
+	"categoryId\x88\x01\x01\x12\xa4\x01\n" +
+	"\vdocument_id\x18\x02 \x01(\tB~\xbaH{ry\x10\x01\x18$2s^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})$H\x01R\n" +
+	"documentId\x88\x01\x01B\x0e\n" +
+	"\f_category_idB\x0e\n" +
+	"\f_document_id\"U\n" +
+	"\x13CategoryPathSegment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\breadable\x18\x03 \x01(\bR\breadable\"`\n" +
+	"\x17GetCategoryPathResponse\x12E\n" +
+	"\bsegments\x18\x01 \x03(\v2).paperless.service.v1.CategoryPathSegmentR\bsegments\"f\n" +
+	"\x1bRebuildCategoryPathsRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRunB\f\n" +
+	"\n" +
+	"_tenant_id\"\xa5\x01\n" +
+	"\x0fCategoryPathFix\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\bold_path\x18\x03 \x01(\tR\aoldPath\x12\x19\n" +
+	"\bnew_path\x18\x04 \x01(\tR\anewPath\x12\x1b\n" +
+	"\told_depth\x18\x05 \x01(\x05R\boldDepth\x12\x1b\n" +
+	"\tnew_depth\x18\x06 \x01(\x05R\bnewDepth\"\x9e\x01\n" +
+	"\x1cRebuildCategoryPathsResponse\x12\x18\n" +
+	"\achecked\x18\x01 \x01(\rR\achecked\x12;\n" +
+	"\x05fixed\x18\x02 \x03(\v2%.paperless.service.v1.CategoryPathFixR\x05fixed\x12'\n" +
+	"\x0funreachable_ids\x18\x03 \x03(\tR\x0eunreachableIds\"\xac\x01\n" +
+	"\x15ExportCategoryRequest\x12\x92\x01\n" +
+	"\x02id\x18\x01 \x01(\tB\x81\x01\xe0A\x02\xbaH{ry\x10\x01\x18$2s^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})$R\x02id\"E\n" +
+	"\x13CategoryExportChunk\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x04R\bsequence\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\"\xad\x01\n" +
+	"\x15CategoryExportSummary\x12%\n" +
+	"\x0ecategory_count\x18\x01 \x01(\rR\rcategoryCount\x12%\n" +
+	"\x0edocument_count\x18\x02 \x01(\rR\rdocumentCount\x12\x1a\n" +
+	"\bwarnings\x18\x03 \x03(\tR\bwarnings\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x04R\x04size\x12\x16\n" +
+	"\x06sha256\x18\x05 \x01(\tR\x06sha256\"\xaf\x01\n" +
+	"\x16ExportCategoryResponse\x12A\n" +
+	"\x05chunk\x18\x01 \x01(\v2).paperless.service.v1.CategoryExportChunkH\x00R\x05chunk\x12G\n" +
+	"\asummary\x18\x02 \x01(\v2+.paperless.service.v1.CategoryExportSummaryH\x00R\asummaryB\t\n" +
+	"\apayload*\xa2\x01\n" +
+	"\x10CategorySortMode\x12\"\n" +
+	"\x1eCATEGORY_SORT_MODE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19CATEGORY_SORT_MODE_MANUAL\x10\x01\x12#\n" +
+	"\x1fCATEGORY_SORT_MODE_ALPHABETICAL\x10\x02\x12&\n" +
+	"\"CATEGORY_SORT_MODE_RECENT_ACTIVITY\x10\x03*\xb0\x01\n" +
+	"\x12CategoryDeleteMode\x12$\n" +
+	" CATEGORY_DELETE_MODE_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cCATEGORY_DELETE_MODE_SUBTREE\x10\x01\x12/\n" +
+	"+CATEGORY_DELETE_MODE_SUBTREE_WITH_DOCUMENTS\x10\x02\x12!\n" +
+	"\x1dCATEGORY_DELETE_MODE_REASSIGN\x10\x03*\xe6\x01\n" +
+	"\x17CategoryDeleteJobStatus\x12*\n" +
+	"&CATEGORY_DELETE_JOB_STATUS_UNSPECIFIED\x10\x00\x12&\n" +
+	"\"CATEGORY_DELETE_JOB_STATUS_PENDING\x10\x01\x12&\n" +
+	"\"CATEGORY_DELETE_JOB_STATUS_RUNNING\x10\x02\x12(\n" +
+	"$CATEGORY_DELETE_JOB_STATUS_SUCCEEDED\x10\x03\x12%\n" +
+	"!CATEGORY_DELETE_JOB_STATUS_FAILED\x10\x042\xa2\x11\n" +
 	"\x18PaperlessCategoryService\x12\x86\x01\n" +
 	"\x0eCreateCategory\x12+.paperless.service.v1.CreateCategoryRequest\x1a,.paperless.service.v1.CreateCategoryResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/categories\x12\x7f\n" +
 	"\vGetCategory\x12(.paperless.service.v1.GetCategoryRequest\x1a).paperless.service.v1.GetCategoryResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/categories/{id}\x12\x83\x01\n" +
 	"\x0eListCategories\x12+.paperless.service.v1.ListCategoriesRequest\x1a,.paperless.service.v1.ListCategoriesResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/categories\x12\x8b\x01\n" +
-	"\x0eUpdateCategory\x12+.paperless.service.v1.UpdateCategoryRequest\x1a,.paperless.service.v1.UpdateCategoryResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\x1a\x13/v1/categories/{id}\x12r\n" +
-	"\x0eDeleteCategory\x12+.paperless.service.v1.DeleteCategoryRequest\x1a\x16.google.protobuf.Empty\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/v1/categories/{id}\x12\x8a\x01\n" +
-	"\fMoveCategory\x12).paperless.service.v1.MoveCategoryRequest\x1a*.paperless.service.v1.MoveCategoryResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/categories/{id}/move\x12\x8b\x01\n" +
-	"\x0fGetCategoryTree\x12,.paperless.service.v1.GetCategoryTreeRequest\x1a-.paperless.service.v1.GetCategoryTreeResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/categories/treeB\xed\x01\n" +
+	"\x0eUpdateCategory\x12+.paperless.service.v1.UpdateCategoryRequest\x1a,.paperless.service.v1.UpdateCategoryResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\x1a\x13/v1/categories/{id}\x12\x88\x01\n" +
+	"\x0eDeleteCategory\x12+.paperless.service.v1.DeleteCategoryRequest\x1a,.paperless.service.v1.DeleteCategoryResponse\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/v1/categories/{id}\x12\xa6\x01\n" +
+	"\x14GetCategoryDeleteJob\x121.paperless.service.v1.GetCategoryDeleteJobRequest\x1a2.paperless.service.v1.GetCategoryDeleteJobResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/categories/delete-jobs/{id}\x12\x8a\x01\n" +
+	"\fMoveCategory\x12).paperless.service.v1.MoveCategoryRequest\x1a*.paperless.service.v1.MoveCategoryResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/categories/{id}/move\x12\x96\x01\n" +
+	"\x10CopyCategoryTree\x12-.paperless.service.v1.CopyCategoryTreeRequest\x1a..paperless.service.v1.CopyCategoryTreeResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/categories/{id}/copy\x12\x9f\x01\n" +
+	"\x13SetCategorySortMode\x120.paperless.service.v1.SetCategorySortModeRequest\x1a1.paperless.service.v1.SetCategorySortModeResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/categories/sort-mode\x12\x97\x01\n" +
+	"\x11ReorderCategories\x12..paperless.service.v1.ReorderCategoriesRequest\x1a/.paperless.service.v1.ReorderCategoriesResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/categories/reorder\x12\x8b\x01\n" +
+	"\x0fGetCategoryTree\x12,.paperless.service.v1.GetCategoryTreeRequest\x1a-.paperless.service.v1.GetCategoryTreeResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/categories/tree\x12\x9b\x01\n" +
+	"\x13GetCategoryChildren\x120.paperless.service.v1.GetCategoryChildrenRequest\x1a1.paperless.service.v1.GetCategoryChildrenResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/categories/children\x12\x8b\x01\n" +
+	"\x0fGetCategoryPath\x12,.paperless.service.v1.GetCategoryPathRequest\x1a-.paperless.service.v1.GetCategoryPathResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/categories/path\x12\xa6\x01\n" +
+	"\x14RebuildCategoryPaths\x121.paperless.service.v1.RebuildCategoryPathsRequest\x1a2.paperless.service.v1.RebuildCategoryPathsResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/categories/rebuild-paths\x12o\n" +
+	"\x0eExportCategory\x12+.paperless.service.v1.ExportCategoryRequest\x1a,.paperless.service.v1.ExportCategoryResponse\"\x000\x01B\xed\x01\n" +
 	"\x18com.paperless.service.v1B\rCategoryProtoP\x01ZPgithub.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1;paperlesspb\xa2\x02\x03PSX\xaa\x02\x14Paperless.Service.V1\xca\x02\x14Paperless\\Service\\V1\xe2\x02 Paperless\\Service\\V1\\GPBMetadata\xea\x02\x16Paperless::Service::V1b\x06proto3"
 
 var (
@@ -1048,56 +3089,121 @@ func file_paperless_service_v1_category_proto_rawDescGZIP() []byte {
 	return file_paperless_service_v1_category_proto_rawDescData
 }
 
-var file_paperless_service_v1_category_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_paperless_service_v1_category_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_paperless_service_v1_category_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_paperless_service_v1_category_proto_goTypes = []any{
-	(*Category)(nil),                // 0: paperless.service.v1.Category
-	(*CreateCategoryRequest)(nil),   // 1: paperless.service.v1.CreateCategoryRequest
-	(*CreateCategoryResponse)(nil),  // 2: paperless.service.v1.CreateCategoryResponse
-	(*GetCategoryRequest)(nil),      // 3: paperless.service.v1.GetCategoryRequest
-	(*GetCategoryResponse)(nil),     // 4: paperless.service.v1.GetCategoryResponse
-	(*ListCategoriesRequest)(nil),   // 5: paperless.service.v1.ListCategoriesRequest
-	(*ListCategoriesResponse)(nil),  // 6: paperless.service.v1.ListCategoriesResponse
-	(*UpdateCategoryRequest)(nil),   // 7: paperless.service.v1.UpdateCategoryRequest
-	(*UpdateCategoryResponse)(nil),  // 8: paperless.service.v1.UpdateCategoryResponse
-	(*DeleteCategoryRequest)(nil),   // 9: paperless.service.v1.DeleteCategoryRequest
-	(*MoveCategoryRequest)(nil),     // 10: paperless.service.v1.MoveCategoryRequest
-	(*MoveCategoryResponse)(nil),    // 11: paperless.service.v1.MoveCategoryResponse
-	(*GetCategoryTreeRequest)(nil),  // 12: paperless.service.v1.GetCategoryTreeRequest
-	(*CategoryTreeNode)(nil),        // 13: paperless.service.v1.CategoryTreeNode
-	(*GetCategoryTreeResponse)(nil), // 14: paperless.service.v1.GetCategoryTreeResponse
-	(*timestamppb.Timestamp)(nil),   // 15: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),           // 16: google.protobuf.Empty
+	(CategorySortMode)(0),                // 0: paperless.service.v1.CategorySortMode
+	(CategoryDeleteMode)(0),              // 1: paperless.service.v1.CategoryDeleteMode
+	(CategoryDeleteJobStatus)(0),         // 2: paperless.service.v1.CategoryDeleteJobStatus
+	(*Category)(nil),                     // 3: paperless.service.v1.Category
+	(*CategoryRules)(nil),                // 4: paperless.service.v1.CategoryRules
+	(*CreateCategoryRequest)(nil),        // 5: paperless.service.v1.CreateCategoryRequest
+	(*CreateCategoryResponse)(nil),       // 6: paperless.service.v1.CreateCategoryResponse
+	(*GetCategoryRequest)(nil),           // 7: paperless.service.v1.GetCategoryRequest
+	(*GetCategoryResponse)(nil),          // 8: paperless.service.v1.GetCategoryResponse
+	(*ListCategoriesRequest)(nil),        // 9: paperless.service.v1.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil),       // 10: paperless.service.v1.ListCategoriesResponse
+	(*UpdateCategoryRequest)(nil),        // 11: paperless.service.v1.UpdateCategoryRequest
+	(*UpdateCategoryResponse)(nil),       // 12: paperless.service.v1.UpdateCategoryResponse
+	(*DeleteCategoryRequest)(nil),        // 13: paperless.service.v1.DeleteCategoryRequest
+	(*DeleteCategoryResponse)(nil),       // 14: paperless.service.v1.DeleteCategoryResponse
+	(*CategoryDeleteJob)(nil),            // 15: paperless.service.v1.CategoryDeleteJob
+	(*GetCategoryDeleteJobRequest)(nil),  // 16: paperless.service.v1.GetCategoryDeleteJobRequest
+	(*GetCategoryDeleteJobResponse)(nil), // 17: paperless.service.v1.GetCategoryDeleteJobResponse
+	(*MoveCategoryRequest)(nil),          // 18: paperless.service.v1.MoveCategoryRequest
+	(*MoveCategoryResponse)(nil),         // 19: paperless.service.v1.MoveCategoryResponse
+	(*CopyCategoryTreeRequest)(nil),      // 20: paperless.service.v1.CopyCategoryTreeRequest
+	(*CopyCategoryTreeResponse)(nil),     // 21: paperless.service.v1.CopyCategoryTreeResponse
+	(*SetCategorySortModeRequest)(nil),   // 22: paperless.service.v1.SetCategorySortModeRequest
+	(*SetCategorySortModeResponse)(nil),  // 23: paperless.service.v1.SetCategorySortModeResponse
+	(*ReorderCategoriesRequest)(nil),     // 24: paperless.service.v1.ReorderCategoriesRequest
+	(*ReorderCategoriesResponse)(nil),    // 25: paperless.service.v1.ReorderCategoriesResponse
+	(*GetCategoryTreeRequest)(nil),       // 26: paperless.service.v1.GetCategoryTreeRequest
+	(*CategoryTreeNode)(nil),             // 27: paperless.service.v1.CategoryTreeNode
+	(*GetCategoryTreeResponse)(nil),      // 28: paperless.service.v1.GetCategoryTreeResponse
+	(*GetCategoryChildrenRequest)(nil),   // 29: paperless.service.v1.GetCategoryChildrenRequest
+	(*GetCategoryChildrenResponse)(nil),  // 30: paperless.service.v1.GetCategoryChildrenResponse
+	(*GetCategoryPathRequest)(nil),       // 31: paperless.service.v1.GetCategoryPathRequest
+	(*CategoryPathSegment)(nil),          // 32: paperless.service.v1.CategoryPathSegment
+	(*GetCategoryPathResponse)(nil),      // 33: paperless.service.v1.GetCategoryPathResponse
+	(*RebuildCategoryPathsRequest)(nil),  // 34: paperless.service.v1.RebuildCategoryPathsRequest
+	(*CategoryPathFix)(nil),              // 35: paperless.service.v1.CategoryPathFix
+	(*RebuildCategoryPathsResponse)(nil), // 36: paperless.service.v1.RebuildCategoryPathsResponse
+	(*ExportCategoryRequest)(nil),        // 37: paperless.service.v1.ExportCategoryRequest
+	(*CategoryExportChunk)(nil),          // 38: paperless.service.v1.CategoryExportChunk
+	(*CategoryExportSummary)(nil),        // 39: paperless.service.v1.CategoryExportSummary
+	(*ExportCategoryResponse)(nil),       // 40: paperless.service.v1.ExportCategoryResponse
+	nil,                                  // 41: paperless.service.v1.CategoryRules.TagsEntry
+	(*timestamppb.Timestamp)(nil),        // 42: google.protobuf.Timestamp
 }
 var file_paperless_service_v1_category_proto_depIdxs = []int32{
-	15, // 0: paperless.service.v1.Category.create_time:type_name -> google.protobuf.Timestamp
-	15, // 1: paperless.service.v1.Category.update_time:type_name -> google.protobuf.Timestamp
-	0,  // 2: paperless.service.v1.CreateCategoryResponse.category:type_name -> paperless.service.v1.Category
-	0,  // 3: paperless.service.v1.GetCategoryResponse.category:type_name -> paperless.service.v1.Category
-	0,  // 4: paperless.service.v1.ListCategoriesResponse.categories:type_name -> paperless.service.v1.Category
-	0,  // 5: paperless.service.v1.UpdateCategoryResponse.category:type_name -> paperless.service.v1.Category
-	0,  // 6: paperless.service.v1.MoveCategoryResponse.category:type_name -> paperless.service.v1.Category
-	0,  // 7: paperless.service.v1.CategoryTreeNode.category:type_name -> paperless.service.v1.Category
-	13, // 8: paperless.service.v1.CategoryTreeNode.children:type_name -> paperless.service.v1.CategoryTreeNode
-	13, // 9: paperless.service.v1.GetCategoryTreeResponse.roots:type_name -> paperless.service.v1.CategoryTreeNode
-	1,  // 10: paperless.service.v1.PaperlessCategoryService.CreateCategory:input_type -> paperless.service.v1.CreateCategoryRequest
-	3,  // 11: paperless.service.v1.PaperlessCategoryService.GetCategory:input_type -> paperless.service.v1.GetCategoryRequest
-	5,  // 12: paperless.service.v1.PaperlessCategoryService.ListCategories:input_type -> paperless.service.v1.ListCategoriesRequest
-	7,  // 13: paperless.service.v1.PaperlessCategoryService.UpdateCategory:input_type -> paperless.service.v1.UpdateCategoryRequest
-	9,  // 14: paperless.service.v1.PaperlessCategoryService.DeleteCategory:input_type -> paperless.service.v1.DeleteCategoryRequest
-	10, // 15: paperless.service.v1.PaperlessCategoryService.MoveCategory:input_type -> paperless.service.v1.MoveCategoryRequest
-	12, // 16: paperless.service.v1.PaperlessCategoryService.GetCategoryTree:input_type -> paperless.service.v1.GetCategoryTreeRequest
-	2,  // 17: paperless.service.v1.PaperlessCategoryService.CreateCategory:output_type -> paperless.service.v1.CreateCategoryResponse
-	4,  // 18: paperless.service.v1.PaperlessCategoryService.GetCategory:output_type -> paperless.service.v1.GetCategoryResponse
-	6,  // 19: paperless.service.v1.PaperlessCategoryService.ListCategories:output_type -> paperless.service.v1.ListCategoriesResponse
-	8,  // 20: paperless.service.v1.PaperlessCategoryService.UpdateCategory:output_type -> paperless.service.v1.UpdateCategoryResponse
-	16, // 21: paperless.service.v1.PaperlessCategoryService.DeleteCategory:output_type -> google.protobuf.Empty
-	11, // 22: paperless.service.v1.PaperlessCategoryService.MoveCategory:output_type -> paperless.service.v1.MoveCategoryResponse
-	14, // 23: paperless.service.v1.PaperlessCategoryService.GetCategoryTree:output_type -> paperless.service.v1.GetCategoryTreeResponse
-	17, // [17:24] is the sub-list for method output_type
-	10, // [10:17] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	42, // 0: paperless.service.v1.Category.create_time:type_name -> google.protobuf.Timestamp
+	42, // 1: paperless.service.v1.Category.update_time:type_name -> google.protobuf.Timestamp
+	4,  // 2: paperless.service.v1.Category.rules:type_name -> paperless.service.v1.CategoryRules
+	0,  // 3: paperless.service.v1.Category.child_sort_mode:type_name -> paperless.service.v1.CategorySortMode
+	42, // 4: paperless.service.v1.Category.activity_time:type_name -> google.protobuf.Timestamp
+	41, // 5: paperless.service.v1.CategoryRules.tags:type_name -> paperless.service.v1.CategoryRules.TagsEntry
+	3,  // 6: paperless.service.v1.CreateCategoryResponse.category:type_name -> paperless.service.v1.Category
+	3,  // 7: paperless.service.v1.GetCategoryResponse.category:type_name -> paperless.service.v1.Category
+	3,  // 8: paperless.service.v1.ListCategoriesResponse.categories:type_name -> paperless.service.v1.Category
+	4,  // 9: paperless.service.v1.UpdateCategoryRequest.rules:type_name -> paperless.service.v1.CategoryRules
+	3,  // 10: paperless.service.v1.UpdateCategoryResponse.category:type_name -> paperless.service.v1.Category
+	1,  // 11: paperless.service.v1.DeleteCategoryRequest.mode:type_name -> paperless.service.v1.CategoryDeleteMode
+	15, // 12: paperless.service.v1.DeleteCategoryResponse.job:type_name -> paperless.service.v1.CategoryDeleteJob
+	1,  // 13: paperless.service.v1.CategoryDeleteJob.mode:type_name -> paperless.service.v1.CategoryDeleteMode
+	2,  // 14: paperless.service.v1.CategoryDeleteJob.status:type_name -> paperless.service.v1.CategoryDeleteJobStatus
+	42, // 15: paperless.service.v1.CategoryDeleteJob.create_time:type_name -> google.protobuf.Timestamp
+	42, // 16: paperless.service.v1.CategoryDeleteJob.update_time:type_name -> google.protobuf.Timestamp
+	42, // 17: paperless.service.v1.CategoryDeleteJob.finished_at:type_name -> google.protobuf.Timestamp
+	15, // 18: paperless.service.v1.GetCategoryDeleteJobResponse.job:type_name -> paperless.service.v1.CategoryDeleteJob
+	3,  // 19: paperless.service.v1.MoveCategoryResponse.category:type_name -> paperless.service.v1.Category
+	3,  // 20: paperless.service.v1.CopyCategoryTreeResponse.category:type_name -> paperless.service.v1.Category
+	0,  // 21: paperless.service.v1.SetCategorySortModeRequest.mode:type_name -> paperless.service.v1.CategorySortMode
+	0,  // 22: paperless.service.v1.SetCategorySortModeResponse.mode:type_name -> paperless.service.v1.CategorySortMode
+	3,  // 23: paperless.service.v1.ReorderCategoriesResponse.categories:type_name -> paperless.service.v1.Category
+	3,  // 24: paperless.service.v1.CategoryTreeNode.category:type_name -> paperless.service.v1.Category
+	27, // 25: paperless.service.v1.CategoryTreeNode.children:type_name -> paperless.service.v1.CategoryTreeNode
+	27, // 26: paperless.service.v1.GetCategoryTreeResponse.roots:type_name -> paperless.service.v1.CategoryTreeNode
+	27, // 27: paperless.service.v1.GetCategoryChildrenResponse.children:type_name -> paperless.service.v1.CategoryTreeNode
+	32, // 28: paperless.service.v1.GetCategoryPathResponse.segments:type_name -> paperless.service.v1.CategoryPathSegment
+	35, // 29: paperless.service.v1.RebuildCategoryPathsResponse.fixed:type_name -> paperless.service.v1.CategoryPathFix
+	38, // 30: paperless.service.v1.ExportCategoryResponse.chunk:type_name -> paperless.service.v1.CategoryExportChunk
+	39, // 31: paperless.service.v1.ExportCategoryResponse.summary:type_name -> paperless.service.v1.CategoryExportSummary
+	5,  // 32: paperless.service.v1.PaperlessCategoryService.CreateCategory:input_type -> paperless.service.v1.CreateCategoryRequest
+	7,  // 33: paperless.service.v1.PaperlessCategoryService.GetCategory:input_type -> paperless.service.v1.GetCategoryRequest
+	9,  // 34: paperless.service.v1.PaperlessCategoryService.ListCategories:input_type -> paperless.service.v1.ListCategoriesRequest
+	11, // 35: paperless.service.v1.PaperlessCategoryService.UpdateCategory:input_type -> paperless.service.v1.UpdateCategoryRequest
+	13, // 36: paperless.service.v1.PaperlessCategoryService.DeleteCategory:input_type -> paperless.service.v1.DeleteCategoryRequest
+	16, // 37: paperless.service.v1.PaperlessCategoryService.GetCategoryDeleteJob:input_type -> paperless.service.v1.GetCategoryDeleteJobRequest
+	18, // 38: paperless.service.v1.PaperlessCategoryService.MoveCategory:input_type -> paperless.service.v1.MoveCategoryRequest
+	20, // 39: paperless.service.v1.PaperlessCategoryService.CopyCategoryTree:input_type -> paperless.service.v1.CopyCategoryTreeRequest
+	22, // 40: paperless.service.v1.PaperlessCategoryService.SetCategorySortMode:input_type -> paperless.service.v1.SetCategorySortModeRequest
+	24, // 41: paperless.service.v1.PaperlessCategoryService.ReorderCategories:input_type -> paperless.service.v1.ReorderCategoriesRequest
+	26, // 42: paperless.service.v1.PaperlessCategoryService.GetCategoryTree:input_type -> paperless.service.v1.GetCategoryTreeRequest
+	29, // 43: paperless.service.v1.PaperlessCategoryService.GetCategoryChildren:input_type -> paperless.service.v1.GetCategoryChildrenRequest
+	31, // 44: paperless.service.v1.PaperlessCategoryService.GetCategoryPath:input_type -> paperless.service.v1.GetCategoryPathRequest
+	34, // 45: paperless.service.v1.PaperlessCategoryService.RebuildCategoryPaths:input_type -> paperless.service.v1.RebuildCategoryPathsRequest
+	37, // 46: paperless.service.v1.PaperlessCategoryService.ExportCategory:input_type -> paperless.service.v1.ExportCategoryRequest
+	6,  // 47: paperless.service.v1.PaperlessCategoryService.CreateCategory:output_type -> paperless.service.v1.CreateCategoryResponse
+	8,  // 48: paperless.service.v1.PaperlessCategoryService.GetCategory:output_type -> paperless.service.v1.GetCategoryResponse
+	10, // 49: paperless.service.v1.PaperlessCategoryService.ListCategories:output_type -> paperless.service.v1.ListCategoriesResponse
+	12, // 50: paperless.service.v1.PaperlessCategoryService.UpdateCategory:output_type -> paperless.service.v1.UpdateCategoryResponse
+	14, // 51: paperless.service.v1.PaperlessCategoryService.DeleteCategory:output_type -> paperless.service.v1.DeleteCategoryResponse
+	17, // 52: paperless.service.v1.PaperlessCategoryService.GetCategoryDeleteJob:output_type -> paperless.service.v1.GetCategoryDeleteJobResponse
+	19, // 53: paperless.service.v1.PaperlessCategoryService.MoveCategory:output_type -> paperless.service.v1.MoveCategoryResponse
+	21, // 54: paperless.service.v1.PaperlessCategoryService.CopyCategoryTree:output_type -> paperless.service.v1.CopyCategoryTreeResponse
+	23, // 55: paperless.service.v1.PaperlessCategoryService.SetCategorySortMode:output_type -> paperless.service.v1.SetCategorySortModeResponse
+	25, // 56: paperless.service.v1.PaperlessCategoryService.ReorderCategories:output_type -> paperless.service.v1.ReorderCategoriesResponse
+	28, // 57: paperless.service.v1.PaperlessCategoryService.GetCategoryTree:output_type -> paperless.service.v1.GetCategoryTreeResponse
+	30, // 58: paperless.service.v1.PaperlessCategoryService.GetCategoryChildren:output_type -> paperless.service.v1.GetCategoryChildrenResponse
+	33, // 59: paperless.service.v1.PaperlessCategoryService.GetCategoryPath:output_type -> paperless.service.v1.GetCategoryPathResponse
+	36, // 60: paperless.service.v1.PaperlessCategoryService.RebuildCategoryPaths:output_type -> paperless.service.v1.RebuildCategoryPathsResponse
+	40, // 61: paperless.service.v1.PaperlessCategoryService.ExportCategory:output_type -> paperless.service.v1.ExportCategoryResponse
+	47, // [47:62] is the sub-list for method output_type
+	32, // [32:47] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_paperless_service_v1_category_proto_init() }
@@ -1106,23 +3212,37 @@ func file_paperless_service_v1_category_proto_init() {
 		return
 	}
 	file_paperless_service_v1_category_proto_msgTypes[0].OneofWrappers = []any{}
-	file_paperless_service_v1_category_proto_msgTypes[1].OneofWrappers = []any{}
-	file_paperless_service_v1_category_proto_msgTypes[5].OneofWrappers = []any{}
-	file_paperless_service_v1_category_proto_msgTypes[7].OneofWrappers = []any{}
+	file_paperless_service_v1_category_proto_msgTypes[2].OneofWrappers = []any{}
+	file_paperless_service_v1_category_proto_msgTypes[6].OneofWrappers = []any{}
+	file_paperless_service_v1_category_proto_msgTypes[8].OneofWrappers = []any{}
 	file_paperless_service_v1_category_proto_msgTypes[10].OneofWrappers = []any{}
+	file_paperless_service_v1_category_proto_msgTypes[11].OneofWrappers = []any{}
 	file_paperless_service_v1_category_proto_msgTypes[12].OneofWrappers = []any{}
+	file_paperless_service_v1_category_proto_msgTypes[15].OneofWrappers = []any{}
+	file_paperless_service_v1_category_proto_msgTypes[17].OneofWrappers = []any{}
+	file_paperless_service_v1_category_proto_msgTypes[19].OneofWrappers = []any{}
+	file_paperless_service_v1_category_proto_msgTypes[21].OneofWrappers = []any{}
+	file_paperless_service_v1_category_proto_msgTypes[23].OneofWrappers = []any{}
+	file_paperless_service_v1_category_proto_msgTypes[26].OneofWrappers = []any{}
+	file_paperless_service_v1_category_proto_msgTypes[28].OneofWrappers = []any{}
+	file_paperless_service_v1_category_proto_msgTypes[31].OneofWrappers = []any{}
+	file_paperless_service_v1_category_proto_msgTypes[37].OneofWrappers = []any{
+		(*ExportCategoryResponse_Chunk)(nil),
+		(*ExportCategoryResponse_Summary)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_paperless_service_v1_category_proto_rawDesc), len(file_paperless_service_v1_category_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   15,
+			NumEnums:      3,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_paperless_service_v1_category_proto_goTypes,
 		DependencyIndexes: file_paperless_service_v1_category_proto_depIdxs,
+		EnumInfos:         file_paperless_service_v1_category_proto_enumTypes,
 		MessageInfos:      file_paperless_service_v1_category_proto_msgTypes,
 	}.Build()
 	File_paperless_service_v1_category_proto = out.File
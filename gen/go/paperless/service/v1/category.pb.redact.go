@@ -11,7 +11,6 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -24,7 +23,6 @@ var (
 	_ status.Status
 	_ validate.Rule
 	_ annotations.FieldBehavior
-	_ emptypb.Empty
 	_ timestamppb.Timestamp
 )
 
@@ -92,7 +90,7 @@ func (s *redactedPaperlessCategoryServiceServer) UpdateCategory(ctx context.Cont
 
 // DeleteCategory is the redacted wrapper for the actual PaperlessCategoryServiceServer.DeleteCategory method
 // Unary RPC
-func (s *redactedPaperlessCategoryServiceServer) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest) (*emptypb.Empty, error) {
+func (s *redactedPaperlessCategoryServiceServer) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest) (*DeleteCategoryResponse, error) {
 	res, err := s.srv.DeleteCategory(ctx, in)
 	if !s.bypass.CheckInternal(ctx) {
 		// Apply redaction to the response
@@ -101,6 +99,17 @@ func (s *redactedPaperlessCategoryServiceServer) DeleteCategory(ctx context.Cont
 	return res, err
 }
 
+// GetCategoryDeleteJob is the redacted wrapper for the actual PaperlessCategoryServiceServer.GetCategoryDeleteJob method
+// Unary RPC
+func (s *redactedPaperlessCategoryServiceServer) GetCategoryDeleteJob(ctx context.Context, in *GetCategoryDeleteJobRequest) (*GetCategoryDeleteJobResponse, error) {
+	res, err := s.srv.GetCategoryDeleteJob(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // MoveCategory is the redacted wrapper for the actual PaperlessCategoryServiceServer.MoveCategory method
 // Unary RPC
 func (s *redactedPaperlessCategoryServiceServer) MoveCategory(ctx context.Context, in *MoveCategoryRequest) (*MoveCategoryResponse, error) {
@@ -112,6 +121,39 @@ func (s *redactedPaperlessCategoryServiceServer) MoveCategory(ctx context.Contex
 	return res, err
 }
 
+// CopyCategoryTree is the redacted wrapper for the actual PaperlessCategoryServiceServer.CopyCategoryTree method
+// Unary RPC
+func (s *redactedPaperlessCategoryServiceServer) CopyCategoryTree(ctx context.Context, in *CopyCategoryTreeRequest) (*CopyCategoryTreeResponse, error) {
+	res, err := s.srv.CopyCategoryTree(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// SetCategorySortMode is the redacted wrapper for the actual PaperlessCategoryServiceServer.SetCategorySortMode method
+// Unary RPC
+func (s *redactedPaperlessCategoryServiceServer) SetCategorySortMode(ctx context.Context, in *SetCategorySortModeRequest) (*SetCategorySortModeResponse, error) {
+	res, err := s.srv.SetCategorySortMode(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ReorderCategories is the redacted wrapper for the actual PaperlessCategoryServiceServer.ReorderCategories method
+// Unary RPC
+func (s *redactedPaperlessCategoryServiceServer) ReorderCategories(ctx context.Context, in *ReorderCategoriesRequest) (*ReorderCategoriesResponse, error) {
+	res, err := s.srv.ReorderCategories(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // GetCategoryTree is the redacted wrapper for the actual PaperlessCategoryServiceServer.GetCategoryTree method
 // Unary RPC
 func (s *redactedPaperlessCategoryServiceServer) GetCategoryTree(ctx context.Context, in *GetCategoryTreeRequest) (*GetCategoryTreeResponse, error) {
@@ -123,6 +165,47 @@ func (s *redactedPaperlessCategoryServiceServer) GetCategoryTree(ctx context.Con
 	return res, err
 }
 
+// GetCategoryChildren is the redacted wrapper for the actual PaperlessCategoryServiceServer.GetCategoryChildren method
+// Unary RPC
+func (s *redactedPaperlessCategoryServiceServer) GetCategoryChildren(ctx context.Context, in *GetCategoryChildrenRequest) (*GetCategoryChildrenResponse, error) {
+	res, err := s.srv.GetCategoryChildren(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// GetCategoryPath is the redacted wrapper for the actual PaperlessCategoryServiceServer.GetCategoryPath method
+// Unary RPC
+func (s *redactedPaperlessCategoryServiceServer) GetCategoryPath(ctx context.Context, in *GetCategoryPathRequest) (*GetCategoryPathResponse, error) {
+	res, err := s.srv.GetCategoryPath(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// RebuildCategoryPaths is the redacted wrapper for the actual PaperlessCategoryServiceServer.RebuildCategoryPaths method
+// Unary RPC
+func (s *redactedPaperlessCategoryServiceServer) RebuildCategoryPaths(ctx context.Context, in *RebuildCategoryPathsRequest) (*RebuildCategoryPathsResponse, error) {
+	res, err := s.srv.RebuildCategoryPaths(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ExportCategory is the redacted wrapper for the actual PaperlessCategoryServiceServer.ExportCategory method
+// Server streaming
+func (s *redactedPaperlessCategoryServiceServer) ExportCategory(in *ExportCategoryRequest, stream grpc.ServerStreamingServer[ExportCategoryResponse]) error {
+	// Note: Redaction for server streaming is not fully implemented
+	// Streaming methods pass through without redaction
+	return s.srv.ExportCategory(in, stream)
+}
+
 // Redact method implementation for Category
 func (x *Category) Redact() string {
 	if x == nil {
@@ -154,6 +237,40 @@ func (x *Category) Redact() string {
 	// Safe field: UpdateTime
 
 	// Safe field: CreatedBy
+
+	// Safe field: Version
+
+	// Safe field: SubtreeDocumentCount
+
+	// Safe field: Color
+
+	// Safe field: Icon
+
+	// Safe field: SubtreeDocumentBytes
+
+	// Safe field: MaxDocuments
+
+	// Safe field: MaxBytes
+
+	// Safe field: Rules
+
+	// Safe field: ChildSortMode
+
+	// Safe field: ActivityTime
+	return x.String()
+}
+
+// Redact method implementation for CategoryRules
+func (x *CategoryRules) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Tags
+
+	// Safe field: DocumentType
+
+	// Safe field: RetentionClass
 	return x.String()
 }
 
@@ -170,6 +287,12 @@ func (x *CreateCategoryRequest) Redact() string {
 	// Safe field: Description
 
 	// Safe field: SortOrder
+
+	// Safe field: Color
+
+	// Safe field: Icon
+
+	// Safe field: CopyParentPermissions
 	return x.String()
 }
 
@@ -246,6 +369,18 @@ func (x *UpdateCategoryRequest) Redact() string {
 	// Safe field: Description
 
 	// Safe field: SortOrder
+
+	// Safe field: ExpectedVersion
+
+	// Safe field: Color
+
+	// Safe field: Icon
+
+	// Safe field: MaxDocuments
+
+	// Safe field: MaxBytes
+
+	// Safe field: Rules
 	return x.String()
 }
 
@@ -268,6 +403,84 @@ func (x *DeleteCategoryRequest) Redact() string {
 	// Safe field: Id
 
 	// Safe field: Force
+
+	// Safe field: Mode
+
+	// Safe field: TargetCategoryId
+
+	// Safe field: Background
+	return x.String()
+}
+
+// Redact method implementation for DeleteCategoryResponse
+func (x *DeleteCategoryResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Job
+	return x.String()
+}
+
+// Redact method implementation for CategoryDeleteJob
+func (x *CategoryDeleteJob) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: CategoryId
+
+	// Safe field: CategoryPath
+
+	// Safe field: Mode
+
+	// Safe field: TargetCategoryId
+
+	// Safe field: Status
+
+	// Safe field: TotalItems
+
+	// Safe field: ProcessedItems
+
+	// Safe field: CategoriesDeleted
+
+	// Safe field: CategoriesMoved
+
+	// Safe field: DocumentsDeleted
+
+	// Safe field: DocumentsMoved
+
+	// Safe field: Error
+
+	// Safe field: CreateTime
+
+	// Safe field: UpdateTime
+
+	// Safe field: FinishedAt
+
+	// Safe field: CreatedBy
+	return x.String()
+}
+
+// Redact method implementation for GetCategoryDeleteJobRequest
+func (x *GetCategoryDeleteJobRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+	return x.String()
+}
+
+// Redact method implementation for GetCategoryDeleteJobResponse
+func (x *GetCategoryDeleteJobResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Job
 	return x.String()
 }
 
@@ -280,6 +493,8 @@ func (x *MoveCategoryRequest) Redact() string {
 	// Safe field: Id
 
 	// Safe field: NewParentId
+
+	// Safe field: ExpectedVersion
 	return x.String()
 }
 
@@ -293,6 +508,84 @@ func (x *MoveCategoryResponse) Redact() string {
 	return x.String()
 }
 
+// Redact method implementation for CopyCategoryTreeRequest
+func (x *CopyCategoryTreeRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: NewParentId
+
+	// Safe field: Name
+
+	// Safe field: IncludeDocuments
+
+	// Safe field: IncludePermissions
+	return x.String()
+}
+
+// Redact method implementation for CopyCategoryTreeResponse
+func (x *CopyCategoryTreeResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Category
+
+	// Safe field: CategoryCount
+
+	// Safe field: DocumentCount
+
+	// Safe field: Warnings
+	return x.String()
+}
+
+// Redact method implementation for SetCategorySortModeRequest
+func (x *SetCategorySortModeRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: ParentId
+
+	// Safe field: Mode
+	return x.String()
+}
+
+// Redact method implementation for SetCategorySortModeResponse
+func (x *SetCategorySortModeResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Mode
+	return x.String()
+}
+
+// Redact method implementation for ReorderCategoriesRequest
+func (x *ReorderCategoriesRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: ParentId
+
+	// Safe field: CategoryIds
+	return x.String()
+}
+
+// Redact method implementation for ReorderCategoriesResponse
+func (x *ReorderCategoriesResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Categories
+	return x.String()
+}
+
 // Redact method implementation for GetCategoryTreeRequest
 func (x *GetCategoryTreeRequest) Redact() string {
 	if x == nil {
@@ -316,6 +609,8 @@ func (x *CategoryTreeNode) Redact() string {
 	// Safe field: Category
 
 	// Safe field: Children
+
+	// Safe field: HasChildren
 	return x.String()
 }
 
@@ -328,3 +623,165 @@ func (x *GetCategoryTreeResponse) Redact() string {
 	// Safe field: Roots
 	return x.String()
 }
+
+// Redact method implementation for GetCategoryChildrenRequest
+func (x *GetCategoryChildrenRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: ParentId
+
+	// Safe field: Page
+
+	// Safe field: PageSize
+
+	// Safe field: IncludeCounts
+	return x.String()
+}
+
+// Redact method implementation for GetCategoryChildrenResponse
+func (x *GetCategoryChildrenResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Children
+
+	// Safe field: Total
+	return x.String()
+}
+
+// Redact method implementation for GetCategoryPathRequest
+func (x *GetCategoryPathRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: CategoryId
+
+	// Safe field: DocumentId
+	return x.String()
+}
+
+// Redact method implementation for CategoryPathSegment
+func (x *CategoryPathSegment) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: Name
+
+	// Safe field: Readable
+	return x.String()
+}
+
+// Redact method implementation for GetCategoryPathResponse
+func (x *GetCategoryPathResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Segments
+	return x.String()
+}
+
+// Redact method implementation for RebuildCategoryPathsRequest
+func (x *RebuildCategoryPathsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+
+	// Safe field: DryRun
+	return x.String()
+}
+
+// Redact method implementation for CategoryPathFix
+func (x *CategoryPathFix) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: Name
+
+	// Safe field: OldPath
+
+	// Safe field: NewPath
+
+	// Safe field: OldDepth
+
+	// Safe field: NewDepth
+	return x.String()
+}
+
+// Redact method implementation for RebuildCategoryPathsResponse
+func (x *RebuildCategoryPathsResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Checked
+
+	// Safe field: Fixed
+
+	// Safe field: UnreachableIds
+	return x.String()
+}
+
+// Redact method implementation for ExportCategoryRequest
+func (x *ExportCategoryRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+	return x.String()
+}
+
+// Redact method implementation for CategoryExportChunk
+func (x *CategoryExportChunk) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Sequence
+
+	// Safe field: Data
+	return x.String()
+}
+
+// Redact method implementation for CategoryExportSummary
+func (x *CategoryExportSummary) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: CategoryCount
+
+	// Safe field: DocumentCount
+
+	// Safe field: Warnings
+
+	// Safe field: Size
+
+	// Safe field: Sha256
+	return x.String()
+}
+
+// Redact method implementation for ExportCategoryResponse
+func (x *ExportCategoryResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Chunk
+
+	// Safe field: Summary
+	return x.String()
+}
@@ -133,6 +133,76 @@ func (m *Category) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Version
+
+	// no validation rules for SubtreeDocumentCount
+
+	// no validation rules for Color
+
+	// no validation rules for Icon
+
+	// no validation rules for SubtreeDocumentBytes
+
+	if all {
+		switch v := interface{}(m.GetRules()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CategoryValidationError{
+					field:  "Rules",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CategoryValidationError{
+					field:  "Rules",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRules()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CategoryValidationError{
+				field:  "Rules",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ChildSortMode
+
+	if all {
+		switch v := interface{}(m.GetActivityTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CategoryValidationError{
+					field:  "ActivityTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CategoryValidationError{
+					field:  "ActivityTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetActivityTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CategoryValidationError{
+				field:  "ActivityTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if m.ParentId != nil {
 		// no validation rules for ParentId
 	}
@@ -141,6 +211,14 @@ func (m *Category) validate(all bool) error {
 		// no validation rules for CreatedBy
 	}
 
+	if m.MaxDocuments != nil {
+		// no validation rules for MaxDocuments
+	}
+
+	if m.MaxBytes != nil {
+		// no validation rules for MaxBytes
+	}
+
 	if len(errors) > 0 {
 		return CategoryMultiError(errors)
 	}
@@ -218,6 +296,112 @@ var _ interface {
 	ErrorName() string
 } = CategoryValidationError{}
 
+// Validate checks the field values on CategoryRules with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CategoryRules) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CategoryRules with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CategoryRulesMultiError, or
+// nil if none found.
+func (m *CategoryRules) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CategoryRules) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tags
+
+	// no validation rules for DocumentType
+
+	// no validation rules for RetentionClass
+
+	if len(errors) > 0 {
+		return CategoryRulesMultiError(errors)
+	}
+
+	return nil
+}
+
+// CategoryRulesMultiError is an error wrapping multiple validation errors
+// returned by CategoryRules.ValidateAll() if the designated constraints
+// aren't met.
+type CategoryRulesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CategoryRulesMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CategoryRulesMultiError) AllErrors() []error { return m }
+
+// CategoryRulesValidationError is the validation error returned by
+// CategoryRules.Validate if the designated constraints aren't met.
+type CategoryRulesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CategoryRulesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CategoryRulesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CategoryRulesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CategoryRulesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CategoryRulesValidationError) ErrorName() string { return "CategoryRulesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CategoryRulesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCategoryRules.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CategoryRulesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CategoryRulesValidationError{}
+
 // Validate checks the field values on CreateCategoryRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -246,10 +430,18 @@ func (m *CreateCategoryRequest) validate(all bool) error {
 
 	// no validation rules for SortOrder
 
+	// no validation rules for Color
+
+	// no validation rules for Icon
+
 	if m.ParentId != nil {
 		// no validation rules for ParentId
 	}
 
+	if m.CopyParentPermissions != nil {
+		// no validation rules for CopyParentPermissions
+	}
+
 	if len(errors) > 0 {
 		return CreateCategoryRequestMultiError(errors)
 	}
@@ -978,6 +1170,35 @@ func (m *UpdateCategoryRequest) validate(all bool) error {
 
 	// no validation rules for Id
 
+	if all {
+		switch v := interface{}(m.GetRules()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateCategoryRequestValidationError{
+					field:  "Rules",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateCategoryRequestValidationError{
+					field:  "Rules",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRules()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateCategoryRequestValidationError{
+				field:  "Rules",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if m.Name != nil {
 		// no validation rules for Name
 	}
@@ -990,6 +1211,26 @@ func (m *UpdateCategoryRequest) validate(all bool) error {
 		// no validation rules for SortOrder
 	}
 
+	if m.ExpectedVersion != nil {
+		// no validation rules for ExpectedVersion
+	}
+
+	if m.Color != nil {
+		// no validation rules for Color
+	}
+
+	if m.Icon != nil {
+		// no validation rules for Icon
+	}
+
+	if m.MaxDocuments != nil {
+		// no validation rules for MaxDocuments
+	}
+
+	if m.MaxBytes != nil {
+		// no validation rules for MaxBytes
+	}
+
 	if len(errors) > 0 {
 		return UpdateCategoryRequestMultiError(errors)
 	}
@@ -1227,6 +1468,14 @@ func (m *DeleteCategoryRequest) validate(all bool) error {
 
 	// no validation rules for Force
 
+	// no validation rules for Mode
+
+	// no validation rules for Background
+
+	if m.TargetCategoryId != nil {
+		// no validation rules for TargetCategoryId
+	}
+
 	if len(errors) > 0 {
 		return DeleteCategoryRequestMultiError(errors)
 	}
@@ -1307,48 +1556,75 @@ var _ interface {
 	ErrorName() string
 } = DeleteCategoryRequestValidationError{}
 
-// Validate checks the field values on MoveCategoryRequest with the rules
+// Validate checks the field values on DeleteCategoryResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MoveCategoryRequest) Validate() error {
+func (m *DeleteCategoryResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MoveCategoryRequest with the rules
+// ValidateAll checks the field values on DeleteCategoryResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MoveCategoryRequestMultiError, or nil if none found.
-func (m *MoveCategoryRequest) ValidateAll() error {
+// DeleteCategoryResponseMultiError, or nil if none found.
+func (m *DeleteCategoryResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MoveCategoryRequest) validate(all bool) error {
+func (m *DeleteCategoryResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if m.Job != nil {
+
+		if all {
+			switch v := interface{}(m.GetJob()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DeleteCategoryResponseValidationError{
+						field:  "Job",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DeleteCategoryResponseValidationError{
+						field:  "Job",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DeleteCategoryResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	if m.NewParentId != nil {
-		// no validation rules for NewParentId
 	}
 
 	if len(errors) > 0 {
-		return MoveCategoryRequestMultiError(errors)
+		return DeleteCategoryResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// MoveCategoryRequestMultiError is an error wrapping multiple validation
-// errors returned by MoveCategoryRequest.ValidateAll() if the designated
+// DeleteCategoryResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteCategoryResponse.ValidateAll() if the designated
 // constraints aren't met.
-type MoveCategoryRequestMultiError []error
+type DeleteCategoryResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MoveCategoryRequestMultiError) Error() string {
+func (m DeleteCategoryResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1357,11 +1633,11 @@ func (m MoveCategoryRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MoveCategoryRequestMultiError) AllErrors() []error { return m }
+func (m DeleteCategoryResponseMultiError) AllErrors() []error { return m }
 
-// MoveCategoryRequestValidationError is the validation error returned by
-// MoveCategoryRequest.Validate if the designated constraints aren't met.
-type MoveCategoryRequestValidationError struct {
+// DeleteCategoryResponseValidationError is the validation error returned by
+// DeleteCategoryResponse.Validate if the designated constraints aren't met.
+type DeleteCategoryResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1369,24 +1645,24 @@ type MoveCategoryRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e MoveCategoryRequestValidationError) Field() string { return e.field }
+func (e DeleteCategoryResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MoveCategoryRequestValidationError) Reason() string { return e.reason }
+func (e DeleteCategoryResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MoveCategoryRequestValidationError) Cause() error { return e.cause }
+func (e DeleteCategoryResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MoveCategoryRequestValidationError) Key() bool { return e.key }
+func (e DeleteCategoryResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MoveCategoryRequestValidationError) ErrorName() string {
-	return "MoveCategoryRequestValidationError"
+func (e DeleteCategoryResponseValidationError) ErrorName() string {
+	return "DeleteCategoryResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MoveCategoryRequestValidationError) Error() string {
+func (e DeleteCategoryResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1398,14 +1674,14 @@ func (e MoveCategoryRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMoveCategoryRequest.%s: %s%s",
+		"invalid %sDeleteCategoryResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MoveCategoryRequestValidationError{}
+var _ error = DeleteCategoryResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1413,53 +1689,383 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MoveCategoryRequestValidationError{}
+} = DeleteCategoryResponseValidationError{}
 
-// Validate checks the field values on MoveCategoryResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MoveCategoryResponse) Validate() error {
+// Validate checks the field values on CategoryDeleteJob with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CategoryDeleteJob) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MoveCategoryResponse with the rules
+// ValidateAll checks the field values on CategoryDeleteJob with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MoveCategoryResponseMultiError, or nil if none found.
-func (m *MoveCategoryResponse) ValidateAll() error {
+// CategoryDeleteJobMultiError, or nil if none found.
+func (m *CategoryDeleteJob) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MoveCategoryResponse) validate(all bool) error {
+func (m *CategoryDeleteJob) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
+	// no validation rules for CategoryId
+
+	// no validation rules for CategoryPath
+
+	// no validation rules for Mode
+
+	// no validation rules for Status
+
+	// no validation rules for TotalItems
+
+	// no validation rules for ProcessedItems
+
+	// no validation rules for CategoriesDeleted
+
+	// no validation rules for CategoriesMoved
+
+	// no validation rules for DocumentsDeleted
+
+	// no validation rules for DocumentsMoved
+
+	// no validation rules for Error
+
 	if all {
-		switch v := interface{}(m.GetCategory()).(type) {
+		switch v := interface{}(m.GetCreateTime()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MoveCategoryResponseValidationError{
-					field:  "Category",
+				errors = append(errors, CategoryDeleteJobValidationError{
+					field:  "CreateTime",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MoveCategoryResponseValidationError{
-					field:  "Category",
+				errors = append(errors, CategoryDeleteJobValidationError{
+					field:  "CreateTime",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetCategory()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MoveCategoryResponseValidationError{
-				field:  "Category",
+			return CategoryDeleteJobValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CategoryDeleteJobValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CategoryDeleteJobValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CategoryDeleteJobValidationError{
+				field:  "UpdateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.TargetCategoryId != nil {
+		// no validation rules for TargetCategoryId
+	}
+
+	if m.FinishedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetFinishedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CategoryDeleteJobValidationError{
+						field:  "FinishedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CategoryDeleteJobValidationError{
+						field:  "FinishedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFinishedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CategoryDeleteJobValidationError{
+					field:  "FinishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.CreatedBy != nil {
+		// no validation rules for CreatedBy
+	}
+
+	if len(errors) > 0 {
+		return CategoryDeleteJobMultiError(errors)
+	}
+
+	return nil
+}
+
+// CategoryDeleteJobMultiError is an error wrapping multiple validation errors
+// returned by CategoryDeleteJob.ValidateAll() if the designated constraints
+// aren't met.
+type CategoryDeleteJobMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CategoryDeleteJobMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CategoryDeleteJobMultiError) AllErrors() []error { return m }
+
+// CategoryDeleteJobValidationError is the validation error returned by
+// CategoryDeleteJob.Validate if the designated constraints aren't met.
+type CategoryDeleteJobValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CategoryDeleteJobValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CategoryDeleteJobValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CategoryDeleteJobValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CategoryDeleteJobValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CategoryDeleteJobValidationError) ErrorName() string {
+	return "CategoryDeleteJobValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CategoryDeleteJobValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCategoryDeleteJob.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CategoryDeleteJobValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CategoryDeleteJobValidationError{}
+
+// Validate checks the field values on GetCategoryDeleteJobRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCategoryDeleteJobRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCategoryDeleteJobRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCategoryDeleteJobRequestMultiError, or nil if none found.
+func (m *GetCategoryDeleteJobRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCategoryDeleteJobRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetCategoryDeleteJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCategoryDeleteJobRequestMultiError is an error wrapping multiple
+// validation errors returned by GetCategoryDeleteJobRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetCategoryDeleteJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCategoryDeleteJobRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCategoryDeleteJobRequestMultiError) AllErrors() []error { return m }
+
+// GetCategoryDeleteJobRequestValidationError is the validation error returned
+// by GetCategoryDeleteJobRequest.Validate if the designated constraints
+// aren't met.
+type GetCategoryDeleteJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCategoryDeleteJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCategoryDeleteJobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCategoryDeleteJobRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCategoryDeleteJobRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCategoryDeleteJobRequestValidationError) ErrorName() string {
+	return "GetCategoryDeleteJobRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCategoryDeleteJobRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCategoryDeleteJobRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCategoryDeleteJobRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCategoryDeleteJobRequestValidationError{}
+
+// Validate checks the field values on GetCategoryDeleteJobResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCategoryDeleteJobResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCategoryDeleteJobResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCategoryDeleteJobResponseMultiError, or nil if none found.
+func (m *GetCategoryDeleteJobResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCategoryDeleteJobResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCategoryDeleteJobResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCategoryDeleteJobResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCategoryDeleteJobResponseValidationError{
+				field:  "Job",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1467,19 +2073,2455 @@ func (m *MoveCategoryResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return MoveCategoryResponseMultiError(errors)
+		return GetCategoryDeleteJobResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCategoryDeleteJobResponseMultiError is an error wrapping multiple
+// validation errors returned by GetCategoryDeleteJobResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetCategoryDeleteJobResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCategoryDeleteJobResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCategoryDeleteJobResponseMultiError) AllErrors() []error { return m }
+
+// GetCategoryDeleteJobResponseValidationError is the validation error returned
+// by GetCategoryDeleteJobResponse.Validate if the designated constraints
+// aren't met.
+type GetCategoryDeleteJobResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCategoryDeleteJobResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCategoryDeleteJobResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCategoryDeleteJobResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCategoryDeleteJobResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCategoryDeleteJobResponseValidationError) ErrorName() string {
+	return "GetCategoryDeleteJobResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCategoryDeleteJobResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCategoryDeleteJobResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCategoryDeleteJobResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCategoryDeleteJobResponseValidationError{}
+
+// Validate checks the field values on MoveCategoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MoveCategoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MoveCategoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MoveCategoryRequestMultiError, or nil if none found.
+func (m *MoveCategoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoveCategoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.NewParentId != nil {
+		// no validation rules for NewParentId
+	}
+
+	if m.ExpectedVersion != nil {
+		// no validation rules for ExpectedVersion
+	}
+
+	if len(errors) > 0 {
+		return MoveCategoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoveCategoryRequestMultiError is an error wrapping multiple validation
+// errors returned by MoveCategoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type MoveCategoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoveCategoryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoveCategoryRequestMultiError) AllErrors() []error { return m }
+
+// MoveCategoryRequestValidationError is the validation error returned by
+// MoveCategoryRequest.Validate if the designated constraints aren't met.
+type MoveCategoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoveCategoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoveCategoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoveCategoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoveCategoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoveCategoryRequestValidationError) ErrorName() string {
+	return "MoveCategoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MoveCategoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoveCategoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoveCategoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoveCategoryRequestValidationError{}
+
+// Validate checks the field values on MoveCategoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MoveCategoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MoveCategoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MoveCategoryResponseMultiError, or nil if none found.
+func (m *MoveCategoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoveCategoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCategory()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MoveCategoryResponseValidationError{
+					field:  "Category",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MoveCategoryResponseValidationError{
+					field:  "Category",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCategory()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MoveCategoryResponseValidationError{
+				field:  "Category",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MoveCategoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoveCategoryResponseMultiError is an error wrapping multiple validation
+// errors returned by MoveCategoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type MoveCategoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoveCategoryResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoveCategoryResponseMultiError) AllErrors() []error { return m }
+
+// MoveCategoryResponseValidationError is the validation error returned by
+// MoveCategoryResponse.Validate if the designated constraints aren't met.
+type MoveCategoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoveCategoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoveCategoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoveCategoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoveCategoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoveCategoryResponseValidationError) ErrorName() string {
+	return "MoveCategoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MoveCategoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoveCategoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoveCategoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoveCategoryResponseValidationError{}
+
+// Validate checks the field values on CopyCategoryTreeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CopyCategoryTreeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CopyCategoryTreeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CopyCategoryTreeRequestMultiError, or nil if none found.
+func (m *CopyCategoryTreeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CopyCategoryTreeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for IncludeDocuments
+
+	// no validation rules for IncludePermissions
+
+	if m.NewParentId != nil {
+		// no validation rules for NewParentId
+	}
+
+	if m.Name != nil {
+		// no validation rules for Name
+	}
+
+	if len(errors) > 0 {
+		return CopyCategoryTreeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CopyCategoryTreeRequestMultiError is an error wrapping multiple validation
+// errors returned by CopyCategoryTreeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CopyCategoryTreeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CopyCategoryTreeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CopyCategoryTreeRequestMultiError) AllErrors() []error { return m }
+
+// CopyCategoryTreeRequestValidationError is the validation error returned by
+// CopyCategoryTreeRequest.Validate if the designated constraints aren't met.
+type CopyCategoryTreeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CopyCategoryTreeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CopyCategoryTreeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CopyCategoryTreeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CopyCategoryTreeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CopyCategoryTreeRequestValidationError) ErrorName() string {
+	return "CopyCategoryTreeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CopyCategoryTreeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCopyCategoryTreeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CopyCategoryTreeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CopyCategoryTreeRequestValidationError{}
+
+// Validate checks the field values on CopyCategoryTreeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CopyCategoryTreeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CopyCategoryTreeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CopyCategoryTreeResponseMultiError, or nil if none found.
+func (m *CopyCategoryTreeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CopyCategoryTreeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCategory()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CopyCategoryTreeResponseValidationError{
+					field:  "Category",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CopyCategoryTreeResponseValidationError{
+					field:  "Category",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCategory()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CopyCategoryTreeResponseValidationError{
+				field:  "Category",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CategoryCount
+
+	// no validation rules for DocumentCount
+
+	if len(errors) > 0 {
+		return CopyCategoryTreeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CopyCategoryTreeResponseMultiError is an error wrapping multiple validation
+// errors returned by CopyCategoryTreeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CopyCategoryTreeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CopyCategoryTreeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CopyCategoryTreeResponseMultiError) AllErrors() []error { return m }
+
+// CopyCategoryTreeResponseValidationError is the validation error returned by
+// CopyCategoryTreeResponse.Validate if the designated constraints aren't met.
+type CopyCategoryTreeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CopyCategoryTreeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CopyCategoryTreeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CopyCategoryTreeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CopyCategoryTreeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CopyCategoryTreeResponseValidationError) ErrorName() string {
+	return "CopyCategoryTreeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CopyCategoryTreeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCopyCategoryTreeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CopyCategoryTreeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CopyCategoryTreeResponseValidationError{}
+
+// Validate checks the field values on SetCategorySortModeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetCategorySortModeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetCategorySortModeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetCategorySortModeRequestMultiError, or nil if none found.
+func (m *SetCategorySortModeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetCategorySortModeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Mode
+
+	if m.ParentId != nil {
+		// no validation rules for ParentId
+	}
+
+	if len(errors) > 0 {
+		return SetCategorySortModeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetCategorySortModeRequestMultiError is an error wrapping multiple
+// validation errors returned by SetCategorySortModeRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SetCategorySortModeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetCategorySortModeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetCategorySortModeRequestMultiError) AllErrors() []error { return m }
+
+// SetCategorySortModeRequestValidationError is the validation error returned
+// by SetCategorySortModeRequest.Validate if the designated constraints aren't met.
+type SetCategorySortModeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetCategorySortModeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetCategorySortModeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetCategorySortModeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetCategorySortModeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetCategorySortModeRequestValidationError) ErrorName() string {
+	return "SetCategorySortModeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetCategorySortModeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetCategorySortModeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetCategorySortModeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetCategorySortModeRequestValidationError{}
+
+// Validate checks the field values on SetCategorySortModeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetCategorySortModeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetCategorySortModeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetCategorySortModeResponseMultiError, or nil if none found.
+func (m *SetCategorySortModeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetCategorySortModeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Mode
+
+	if len(errors) > 0 {
+		return SetCategorySortModeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetCategorySortModeResponseMultiError is an error wrapping multiple
+// validation errors returned by SetCategorySortModeResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SetCategorySortModeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetCategorySortModeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetCategorySortModeResponseMultiError) AllErrors() []error { return m }
+
+// SetCategorySortModeResponseValidationError is the validation error returned
+// by SetCategorySortModeResponse.Validate if the designated constraints
+// aren't met.
+type SetCategorySortModeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetCategorySortModeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetCategorySortModeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetCategorySortModeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetCategorySortModeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetCategorySortModeResponseValidationError) ErrorName() string {
+	return "SetCategorySortModeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetCategorySortModeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetCategorySortModeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetCategorySortModeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetCategorySortModeResponseValidationError{}
+
+// Validate checks the field values on ReorderCategoriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReorderCategoriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReorderCategoriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReorderCategoriesRequestMultiError, or nil if none found.
+func (m *ReorderCategoriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReorderCategoriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.ParentId != nil {
+		// no validation rules for ParentId
+	}
+
+	if len(errors) > 0 {
+		return ReorderCategoriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReorderCategoriesRequestMultiError is an error wrapping multiple validation
+// errors returned by ReorderCategoriesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReorderCategoriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReorderCategoriesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReorderCategoriesRequestMultiError) AllErrors() []error { return m }
+
+// ReorderCategoriesRequestValidationError is the validation error returned by
+// ReorderCategoriesRequest.Validate if the designated constraints aren't met.
+type ReorderCategoriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReorderCategoriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReorderCategoriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReorderCategoriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReorderCategoriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReorderCategoriesRequestValidationError) ErrorName() string {
+	return "ReorderCategoriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReorderCategoriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReorderCategoriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReorderCategoriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReorderCategoriesRequestValidationError{}
+
+// Validate checks the field values on ReorderCategoriesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReorderCategoriesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReorderCategoriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReorderCategoriesResponseMultiError, or nil if none found.
+func (m *ReorderCategoriesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReorderCategoriesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCategories() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReorderCategoriesResponseValidationError{
+						field:  fmt.Sprintf("Categories[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReorderCategoriesResponseValidationError{
+						field:  fmt.Sprintf("Categories[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReorderCategoriesResponseValidationError{
+					field:  fmt.Sprintf("Categories[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReorderCategoriesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReorderCategoriesResponseMultiError is an error wrapping multiple validation
+// errors returned by ReorderCategoriesResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ReorderCategoriesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReorderCategoriesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReorderCategoriesResponseMultiError) AllErrors() []error { return m }
+
+// ReorderCategoriesResponseValidationError is the validation error returned by
+// ReorderCategoriesResponse.Validate if the designated constraints aren't met.
+type ReorderCategoriesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReorderCategoriesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReorderCategoriesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReorderCategoriesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReorderCategoriesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReorderCategoriesResponseValidationError) ErrorName() string {
+	return "ReorderCategoriesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReorderCategoriesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReorderCategoriesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReorderCategoriesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReorderCategoriesResponseValidationError{}
+
+// Validate checks the field values on GetCategoryTreeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCategoryTreeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCategoryTreeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCategoryTreeRequestMultiError, or nil if none found.
+func (m *GetCategoryTreeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCategoryTreeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IncludeCounts
+
+	if m.RootId != nil {
+		// no validation rules for RootId
+	}
+
+	if m.MaxDepth != nil {
+		// no validation rules for MaxDepth
+	}
+
+	if len(errors) > 0 {
+		return GetCategoryTreeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCategoryTreeRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCategoryTreeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCategoryTreeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCategoryTreeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCategoryTreeRequestMultiError) AllErrors() []error { return m }
+
+// GetCategoryTreeRequestValidationError is the validation error returned by
+// GetCategoryTreeRequest.Validate if the designated constraints aren't met.
+type GetCategoryTreeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCategoryTreeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCategoryTreeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCategoryTreeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCategoryTreeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCategoryTreeRequestValidationError) ErrorName() string {
+	return "GetCategoryTreeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCategoryTreeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCategoryTreeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCategoryTreeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCategoryTreeRequestValidationError{}
+
+// Validate checks the field values on CategoryTreeNode with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CategoryTreeNode) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CategoryTreeNode with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CategoryTreeNodeMultiError, or nil if none found.
+func (m *CategoryTreeNode) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CategoryTreeNode) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCategory()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CategoryTreeNodeValidationError{
+					field:  "Category",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CategoryTreeNodeValidationError{
+					field:  "Category",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCategory()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CategoryTreeNodeValidationError{
+				field:  "Category",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetChildren() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CategoryTreeNodeValidationError{
+						field:  fmt.Sprintf("Children[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CategoryTreeNodeValidationError{
+						field:  fmt.Sprintf("Children[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CategoryTreeNodeValidationError{
+					field:  fmt.Sprintf("Children[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for HasChildren
+
+	if len(errors) > 0 {
+		return CategoryTreeNodeMultiError(errors)
+	}
+
+	return nil
+}
+
+// CategoryTreeNodeMultiError is an error wrapping multiple validation errors
+// returned by CategoryTreeNode.ValidateAll() if the designated constraints
+// aren't met.
+type CategoryTreeNodeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CategoryTreeNodeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CategoryTreeNodeMultiError) AllErrors() []error { return m }
+
+// CategoryTreeNodeValidationError is the validation error returned by
+// CategoryTreeNode.Validate if the designated constraints aren't met.
+type CategoryTreeNodeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CategoryTreeNodeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CategoryTreeNodeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CategoryTreeNodeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CategoryTreeNodeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CategoryTreeNodeValidationError) ErrorName() string { return "CategoryTreeNodeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CategoryTreeNodeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCategoryTreeNode.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CategoryTreeNodeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CategoryTreeNodeValidationError{}
+
+// Validate checks the field values on GetCategoryTreeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCategoryTreeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCategoryTreeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCategoryTreeResponseMultiError, or nil if none found.
+func (m *GetCategoryTreeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCategoryTreeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRoots() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCategoryTreeResponseValidationError{
+						field:  fmt.Sprintf("Roots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCategoryTreeResponseValidationError{
+						field:  fmt.Sprintf("Roots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCategoryTreeResponseValidationError{
+					field:  fmt.Sprintf("Roots[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetCategoryTreeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCategoryTreeResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCategoryTreeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetCategoryTreeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCategoryTreeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCategoryTreeResponseMultiError) AllErrors() []error { return m }
+
+// GetCategoryTreeResponseValidationError is the validation error returned by
+// GetCategoryTreeResponse.Validate if the designated constraints aren't met.
+type GetCategoryTreeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCategoryTreeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCategoryTreeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCategoryTreeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCategoryTreeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCategoryTreeResponseValidationError) ErrorName() string {
+	return "GetCategoryTreeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCategoryTreeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCategoryTreeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCategoryTreeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCategoryTreeResponseValidationError{}
+
+// Validate checks the field values on GetCategoryChildrenRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCategoryChildrenRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCategoryChildrenRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCategoryChildrenRequestMultiError, or nil if none found.
+func (m *GetCategoryChildrenRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCategoryChildrenRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IncludeCounts
+
+	if m.ParentId != nil {
+		// no validation rules for ParentId
+	}
+
+	if m.Page != nil {
+		// no validation rules for Page
+	}
+
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
+
+	if len(errors) > 0 {
+		return GetCategoryChildrenRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCategoryChildrenRequestMultiError is an error wrapping multiple
+// validation errors returned by GetCategoryChildrenRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetCategoryChildrenRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCategoryChildrenRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCategoryChildrenRequestMultiError) AllErrors() []error { return m }
+
+// GetCategoryChildrenRequestValidationError is the validation error returned
+// by GetCategoryChildrenRequest.Validate if the designated constraints aren't met.
+type GetCategoryChildrenRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCategoryChildrenRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCategoryChildrenRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCategoryChildrenRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCategoryChildrenRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCategoryChildrenRequestValidationError) ErrorName() string {
+	return "GetCategoryChildrenRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCategoryChildrenRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCategoryChildrenRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCategoryChildrenRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCategoryChildrenRequestValidationError{}
+
+// Validate checks the field values on GetCategoryChildrenResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCategoryChildrenResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCategoryChildrenResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCategoryChildrenResponseMultiError, or nil if none found.
+func (m *GetCategoryChildrenResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCategoryChildrenResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetChildren() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCategoryChildrenResponseValidationError{
+						field:  fmt.Sprintf("Children[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCategoryChildrenResponseValidationError{
+						field:  fmt.Sprintf("Children[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCategoryChildrenResponseValidationError{
+					field:  fmt.Sprintf("Children[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return GetCategoryChildrenResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCategoryChildrenResponseMultiError is an error wrapping multiple
+// validation errors returned by GetCategoryChildrenResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetCategoryChildrenResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCategoryChildrenResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCategoryChildrenResponseMultiError) AllErrors() []error { return m }
+
+// GetCategoryChildrenResponseValidationError is the validation error returned
+// by GetCategoryChildrenResponse.Validate if the designated constraints
+// aren't met.
+type GetCategoryChildrenResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCategoryChildrenResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCategoryChildrenResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCategoryChildrenResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCategoryChildrenResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCategoryChildrenResponseValidationError) ErrorName() string {
+	return "GetCategoryChildrenResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCategoryChildrenResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCategoryChildrenResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCategoryChildrenResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCategoryChildrenResponseValidationError{}
+
+// Validate checks the field values on GetCategoryPathRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCategoryPathRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCategoryPathRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCategoryPathRequestMultiError, or nil if none found.
+func (m *GetCategoryPathRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCategoryPathRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.CategoryId != nil {
+		// no validation rules for CategoryId
+	}
+
+	if m.DocumentId != nil {
+		// no validation rules for DocumentId
+	}
+
+	if len(errors) > 0 {
+		return GetCategoryPathRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCategoryPathRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCategoryPathRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCategoryPathRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCategoryPathRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCategoryPathRequestMultiError) AllErrors() []error { return m }
+
+// GetCategoryPathRequestValidationError is the validation error returned by
+// GetCategoryPathRequest.Validate if the designated constraints aren't met.
+type GetCategoryPathRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCategoryPathRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCategoryPathRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCategoryPathRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCategoryPathRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCategoryPathRequestValidationError) ErrorName() string {
+	return "GetCategoryPathRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCategoryPathRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCategoryPathRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCategoryPathRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCategoryPathRequestValidationError{}
+
+// Validate checks the field values on CategoryPathSegment with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CategoryPathSegment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CategoryPathSegment with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CategoryPathSegmentMultiError, or nil if none found.
+func (m *CategoryPathSegment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CategoryPathSegment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Readable
+
+	if len(errors) > 0 {
+		return CategoryPathSegmentMultiError(errors)
+	}
+
+	return nil
+}
+
+// CategoryPathSegmentMultiError is an error wrapping multiple validation
+// errors returned by CategoryPathSegment.ValidateAll() if the designated
+// constraints aren't met.
+type CategoryPathSegmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CategoryPathSegmentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CategoryPathSegmentMultiError) AllErrors() []error { return m }
+
+// CategoryPathSegmentValidationError is the validation error returned by
+// CategoryPathSegment.Validate if the designated constraints aren't met.
+type CategoryPathSegmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CategoryPathSegmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CategoryPathSegmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CategoryPathSegmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CategoryPathSegmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CategoryPathSegmentValidationError) ErrorName() string {
+	return "CategoryPathSegmentValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CategoryPathSegmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCategoryPathSegment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CategoryPathSegmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CategoryPathSegmentValidationError{}
+
+// Validate checks the field values on GetCategoryPathResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCategoryPathResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCategoryPathResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCategoryPathResponseMultiError, or nil if none found.
+func (m *GetCategoryPathResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCategoryPathResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSegments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCategoryPathResponseValidationError{
+						field:  fmt.Sprintf("Segments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCategoryPathResponseValidationError{
+						field:  fmt.Sprintf("Segments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCategoryPathResponseValidationError{
+					field:  fmt.Sprintf("Segments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetCategoryPathResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCategoryPathResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCategoryPathResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetCategoryPathResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCategoryPathResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCategoryPathResponseMultiError) AllErrors() []error { return m }
+
+// GetCategoryPathResponseValidationError is the validation error returned by
+// GetCategoryPathResponse.Validate if the designated constraints aren't met.
+type GetCategoryPathResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCategoryPathResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCategoryPathResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCategoryPathResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCategoryPathResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCategoryPathResponseValidationError) ErrorName() string {
+	return "GetCategoryPathResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCategoryPathResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCategoryPathResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCategoryPathResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCategoryPathResponseValidationError{}
+
+// Validate checks the field values on RebuildCategoryPathsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RebuildCategoryPathsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RebuildCategoryPathsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RebuildCategoryPathsRequestMultiError, or nil if none found.
+func (m *RebuildCategoryPathsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RebuildCategoryPathsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DryRun
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if len(errors) > 0 {
+		return RebuildCategoryPathsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RebuildCategoryPathsRequestMultiError is an error wrapping multiple
+// validation errors returned by RebuildCategoryPathsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RebuildCategoryPathsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RebuildCategoryPathsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RebuildCategoryPathsRequestMultiError) AllErrors() []error { return m }
+
+// RebuildCategoryPathsRequestValidationError is the validation error returned
+// by RebuildCategoryPathsRequest.Validate if the designated constraints
+// aren't met.
+type RebuildCategoryPathsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RebuildCategoryPathsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RebuildCategoryPathsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RebuildCategoryPathsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RebuildCategoryPathsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RebuildCategoryPathsRequestValidationError) ErrorName() string {
+	return "RebuildCategoryPathsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RebuildCategoryPathsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRebuildCategoryPathsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RebuildCategoryPathsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RebuildCategoryPathsRequestValidationError{}
+
+// Validate checks the field values on CategoryPathFix with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CategoryPathFix) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CategoryPathFix with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CategoryPathFixMultiError, or nil if none found.
+func (m *CategoryPathFix) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CategoryPathFix) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for OldPath
+
+	// no validation rules for NewPath
+
+	// no validation rules for OldDepth
+
+	// no validation rules for NewDepth
+
+	if len(errors) > 0 {
+		return CategoryPathFixMultiError(errors)
+	}
+
+	return nil
+}
+
+// CategoryPathFixMultiError is an error wrapping multiple validation errors
+// returned by CategoryPathFix.ValidateAll() if the designated constraints
+// aren't met.
+type CategoryPathFixMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CategoryPathFixMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CategoryPathFixMultiError) AllErrors() []error { return m }
+
+// CategoryPathFixValidationError is the validation error returned by
+// CategoryPathFix.Validate if the designated constraints aren't met.
+type CategoryPathFixValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CategoryPathFixValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CategoryPathFixValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CategoryPathFixValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CategoryPathFixValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CategoryPathFixValidationError) ErrorName() string { return "CategoryPathFixValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CategoryPathFixValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCategoryPathFix.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CategoryPathFixValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CategoryPathFixValidationError{}
+
+// Validate checks the field values on RebuildCategoryPathsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RebuildCategoryPathsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RebuildCategoryPathsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RebuildCategoryPathsResponseMultiError, or nil if none found.
+func (m *RebuildCategoryPathsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RebuildCategoryPathsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Checked
+
+	for idx, item := range m.GetFixed() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RebuildCategoryPathsResponseValidationError{
+						field:  fmt.Sprintf("Fixed[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RebuildCategoryPathsResponseValidationError{
+						field:  fmt.Sprintf("Fixed[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RebuildCategoryPathsResponseValidationError{
+					field:  fmt.Sprintf("Fixed[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RebuildCategoryPathsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RebuildCategoryPathsResponseMultiError is an error wrapping multiple
+// validation errors returned by RebuildCategoryPathsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RebuildCategoryPathsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RebuildCategoryPathsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RebuildCategoryPathsResponseMultiError) AllErrors() []error { return m }
+
+// RebuildCategoryPathsResponseValidationError is the validation error returned
+// by RebuildCategoryPathsResponse.Validate if the designated constraints
+// aren't met.
+type RebuildCategoryPathsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RebuildCategoryPathsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RebuildCategoryPathsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RebuildCategoryPathsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RebuildCategoryPathsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RebuildCategoryPathsResponseValidationError) ErrorName() string {
+	return "RebuildCategoryPathsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RebuildCategoryPathsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRebuildCategoryPathsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RebuildCategoryPathsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RebuildCategoryPathsResponseValidationError{}
+
+// Validate checks the field values on ExportCategoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportCategoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportCategoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportCategoryRequestMultiError, or nil if none found.
+func (m *ExportCategoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportCategoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return ExportCategoryRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// MoveCategoryResponseMultiError is an error wrapping multiple validation
-// errors returned by MoveCategoryResponse.ValidateAll() if the designated
+// ExportCategoryRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportCategoryRequest.ValidateAll() if the designated
 // constraints aren't met.
-type MoveCategoryResponseMultiError []error
+type ExportCategoryRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MoveCategoryResponseMultiError) Error() string {
+func (m ExportCategoryRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1488,11 +4530,11 @@ func (m MoveCategoryResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MoveCategoryResponseMultiError) AllErrors() []error { return m }
+func (m ExportCategoryRequestMultiError) AllErrors() []error { return m }
 
-// MoveCategoryResponseValidationError is the validation error returned by
-// MoveCategoryResponse.Validate if the designated constraints aren't met.
-type MoveCategoryResponseValidationError struct {
+// ExportCategoryRequestValidationError is the validation error returned by
+// ExportCategoryRequest.Validate if the designated constraints aren't met.
+type ExportCategoryRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1500,24 +4542,24 @@ type MoveCategoryResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e MoveCategoryResponseValidationError) Field() string { return e.field }
+func (e ExportCategoryRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MoveCategoryResponseValidationError) Reason() string { return e.reason }
+func (e ExportCategoryRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MoveCategoryResponseValidationError) Cause() error { return e.cause }
+func (e ExportCategoryRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MoveCategoryResponseValidationError) Key() bool { return e.key }
+func (e ExportCategoryRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MoveCategoryResponseValidationError) ErrorName() string {
-	return "MoveCategoryResponseValidationError"
+func (e ExportCategoryRequestValidationError) ErrorName() string {
+	return "ExportCategoryRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MoveCategoryResponseValidationError) Error() string {
+func (e ExportCategoryRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1529,14 +4571,14 @@ func (e MoveCategoryResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMoveCategoryResponse.%s: %s%s",
+		"invalid %sExportCategoryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MoveCategoryResponseValidationError{}
+var _ error = ExportCategoryRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1544,54 +4586,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MoveCategoryResponseValidationError{}
+} = ExportCategoryRequestValidationError{}
 
-// Validate checks the field values on GetCategoryTreeRequest with the rules
+// Validate checks the field values on CategoryExportChunk with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCategoryTreeRequest) Validate() error {
+func (m *CategoryExportChunk) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCategoryTreeRequest with the rules
+// ValidateAll checks the field values on CategoryExportChunk with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCategoryTreeRequestMultiError, or nil if none found.
-func (m *GetCategoryTreeRequest) ValidateAll() error {
+// CategoryExportChunkMultiError, or nil if none found.
+func (m *CategoryExportChunk) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCategoryTreeRequest) validate(all bool) error {
+func (m *CategoryExportChunk) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for IncludeCounts
-
-	if m.RootId != nil {
-		// no validation rules for RootId
-	}
+	// no validation rules for Sequence
 
-	if m.MaxDepth != nil {
-		// no validation rules for MaxDepth
-	}
+	// no validation rules for Data
 
 	if len(errors) > 0 {
-		return GetCategoryTreeRequestMultiError(errors)
+		return CategoryExportChunkMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCategoryTreeRequestMultiError is an error wrapping multiple validation
-// errors returned by GetCategoryTreeRequest.ValidateAll() if the designated
+// CategoryExportChunkMultiError is an error wrapping multiple validation
+// errors returned by CategoryExportChunk.ValidateAll() if the designated
 // constraints aren't met.
-type GetCategoryTreeRequestMultiError []error
+type CategoryExportChunkMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCategoryTreeRequestMultiError) Error() string {
+func (m CategoryExportChunkMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1600,11 +4636,11 @@ func (m GetCategoryTreeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCategoryTreeRequestMultiError) AllErrors() []error { return m }
+func (m CategoryExportChunkMultiError) AllErrors() []error { return m }
 
-// GetCategoryTreeRequestValidationError is the validation error returned by
-// GetCategoryTreeRequest.Validate if the designated constraints aren't met.
-type GetCategoryTreeRequestValidationError struct {
+// CategoryExportChunkValidationError is the validation error returned by
+// CategoryExportChunk.Validate if the designated constraints aren't met.
+type CategoryExportChunkValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1612,24 +4648,24 @@ type GetCategoryTreeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCategoryTreeRequestValidationError) Field() string { return e.field }
+func (e CategoryExportChunkValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCategoryTreeRequestValidationError) Reason() string { return e.reason }
+func (e CategoryExportChunkValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCategoryTreeRequestValidationError) Cause() error { return e.cause }
+func (e CategoryExportChunkValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCategoryTreeRequestValidationError) Key() bool { return e.key }
+func (e CategoryExportChunkValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCategoryTreeRequestValidationError) ErrorName() string {
-	return "GetCategoryTreeRequestValidationError"
+func (e CategoryExportChunkValidationError) ErrorName() string {
+	return "CategoryExportChunkValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCategoryTreeRequestValidationError) Error() string {
+func (e CategoryExportChunkValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1641,14 +4677,14 @@ func (e GetCategoryTreeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCategoryTreeRequest.%s: %s%s",
+		"invalid %sCategoryExportChunk.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCategoryTreeRequestValidationError{}
+var _ error = CategoryExportChunkValidationError{}
 
 var _ interface {
 	Field() string
@@ -1656,107 +4692,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCategoryTreeRequestValidationError{}
+} = CategoryExportChunkValidationError{}
 
-// Validate checks the field values on CategoryTreeNode with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *CategoryTreeNode) Validate() error {
+// Validate checks the field values on CategoryExportSummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CategoryExportSummary) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CategoryTreeNode with the rules
+// ValidateAll checks the field values on CategoryExportSummary with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CategoryTreeNodeMultiError, or nil if none found.
-func (m *CategoryTreeNode) ValidateAll() error {
+// CategoryExportSummaryMultiError, or nil if none found.
+func (m *CategoryExportSummary) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CategoryTreeNode) validate(all bool) error {
+func (m *CategoryExportSummary) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetCategory()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CategoryTreeNodeValidationError{
-					field:  "Category",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CategoryTreeNodeValidationError{
-					field:  "Category",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCategory()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CategoryTreeNodeValidationError{
-				field:  "Category",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for CategoryCount
 
-	for idx, item := range m.GetChildren() {
-		_, _ = idx, item
+	// no validation rules for DocumentCount
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CategoryTreeNodeValidationError{
-						field:  fmt.Sprintf("Children[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CategoryTreeNodeValidationError{
-						field:  fmt.Sprintf("Children[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return CategoryTreeNodeValidationError{
-					field:  fmt.Sprintf("Children[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Size
 
-	}
+	// no validation rules for Sha256
 
 	if len(errors) > 0 {
-		return CategoryTreeNodeMultiError(errors)
+		return CategoryExportSummaryMultiError(errors)
 	}
 
 	return nil
 }
 
-// CategoryTreeNodeMultiError is an error wrapping multiple validation errors
-// returned by CategoryTreeNode.ValidateAll() if the designated constraints
-// aren't met.
-type CategoryTreeNodeMultiError []error
+// CategoryExportSummaryMultiError is an error wrapping multiple validation
+// errors returned by CategoryExportSummary.ValidateAll() if the designated
+// constraints aren't met.
+type CategoryExportSummaryMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CategoryTreeNodeMultiError) Error() string {
+func (m CategoryExportSummaryMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1765,11 +4746,11 @@ func (m CategoryTreeNodeMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CategoryTreeNodeMultiError) AllErrors() []error { return m }
+func (m CategoryExportSummaryMultiError) AllErrors() []error { return m }
 
-// CategoryTreeNodeValidationError is the validation error returned by
-// CategoryTreeNode.Validate if the designated constraints aren't met.
-type CategoryTreeNodeValidationError struct {
+// CategoryExportSummaryValidationError is the validation error returned by
+// CategoryExportSummary.Validate if the designated constraints aren't met.
+type CategoryExportSummaryValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1777,22 +4758,24 @@ type CategoryTreeNodeValidationError struct {
 }
 
 // Field function returns field value.
-func (e CategoryTreeNodeValidationError) Field() string { return e.field }
+func (e CategoryExportSummaryValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CategoryTreeNodeValidationError) Reason() string { return e.reason }
+func (e CategoryExportSummaryValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CategoryTreeNodeValidationError) Cause() error { return e.cause }
+func (e CategoryExportSummaryValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CategoryTreeNodeValidationError) Key() bool { return e.key }
+func (e CategoryExportSummaryValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CategoryTreeNodeValidationError) ErrorName() string { return "CategoryTreeNodeValidationError" }
+func (e CategoryExportSummaryValidationError) ErrorName() string {
+	return "CategoryExportSummaryValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CategoryTreeNodeValidationError) Error() string {
+func (e CategoryExportSummaryValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1804,14 +4787,14 @@ func (e CategoryTreeNodeValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCategoryTreeNode.%s: %s%s",
+		"invalid %sCategoryExportSummary.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CategoryTreeNodeValidationError{}
+var _ error = CategoryExportSummaryValidationError{}
 
 var _ interface {
 	Field() string
@@ -1819,78 +4802,131 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CategoryTreeNodeValidationError{}
+} = CategoryExportSummaryValidationError{}
 
-// Validate checks the field values on GetCategoryTreeResponse with the rules
+// Validate checks the field values on ExportCategoryResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCategoryTreeResponse) Validate() error {
+func (m *ExportCategoryResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCategoryTreeResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ExportCategoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCategoryTreeResponseMultiError, or nil if none found.
-func (m *GetCategoryTreeResponse) ValidateAll() error {
+// ExportCategoryResponseMultiError, or nil if none found.
+func (m *ExportCategoryResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCategoryTreeResponse) validate(all bool) error {
+func (m *ExportCategoryResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetRoots() {
-		_, _ = idx, item
+	switch v := m.Payload.(type) {
+	case *ExportCategoryResponse_Chunk:
+		if v == nil {
+			err := ExportCategoryResponseValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
 		if all {
-			switch v := interface{}(item).(type) {
+			switch v := interface{}(m.GetChunk()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetCategoryTreeResponseValidationError{
-						field:  fmt.Sprintf("Roots[%v]", idx),
+					errors = append(errors, ExportCategoryResponseValidationError{
+						field:  "Chunk",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetCategoryTreeResponseValidationError{
-						field:  fmt.Sprintf("Roots[%v]", idx),
+					errors = append(errors, ExportCategoryResponseValidationError{
+						field:  "Chunk",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetChunk()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetCategoryTreeResponseValidationError{
-					field:  fmt.Sprintf("Roots[%v]", idx),
+				return ExportCategoryResponseValidationError{
+					field:  "Chunk",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ExportCategoryResponse_Summary:
+		if v == nil {
+			err := ExportCategoryResponseValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSummary()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExportCategoryResponseValidationError{
+						field:  "Summary",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExportCategoryResponseValidationError{
+						field:  "Summary",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSummary()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExportCategoryResponseValidationError{
+					field:  "Summary",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
+	default:
+		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return GetCategoryTreeResponseMultiError(errors)
+		return ExportCategoryResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCategoryTreeResponseMultiError is an error wrapping multiple validation
-// errors returned by GetCategoryTreeResponse.ValidateAll() if the designated
+// ExportCategoryResponseMultiError is an error wrapping multiple validation
+// errors returned by ExportCategoryResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetCategoryTreeResponseMultiError []error
+type ExportCategoryResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCategoryTreeResponseMultiError) Error() string {
+func (m ExportCategoryResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1899,11 +4935,11 @@ func (m GetCategoryTreeResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCategoryTreeResponseMultiError) AllErrors() []error { return m }
+func (m ExportCategoryResponseMultiError) AllErrors() []error { return m }
 
-// GetCategoryTreeResponseValidationError is the validation error returned by
-// GetCategoryTreeResponse.Validate if the designated constraints aren't met.
-type GetCategoryTreeResponseValidationError struct {
+// ExportCategoryResponseValidationError is the validation error returned by
+// ExportCategoryResponse.Validate if the designated constraints aren't met.
+type ExportCategoryResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1911,24 +4947,24 @@ type GetCategoryTreeResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCategoryTreeResponseValidationError) Field() string { return e.field }
+func (e ExportCategoryResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCategoryTreeResponseValidationError) Reason() string { return e.reason }
+func (e ExportCategoryResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCategoryTreeResponseValidationError) Cause() error { return e.cause }
+func (e ExportCategoryResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCategoryTreeResponseValidationError) Key() bool { return e.key }
+func (e ExportCategoryResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCategoryTreeResponseValidationError) ErrorName() string {
-	return "GetCategoryTreeResponseValidationError"
+func (e ExportCategoryResponseValidationError) ErrorName() string {
+	return "ExportCategoryResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCategoryTreeResponseValidationError) Error() string {
+func (e ExportCategoryResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1940,14 +4976,14 @@ func (e GetCategoryTreeResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCategoryTreeResponse.%s: %s%s",
+		"invalid %sExportCategoryResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCategoryTreeResponseValidationError{}
+var _ error = ExportCategoryResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1955,4 +4991,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCategoryTreeResponseValidationError{}
+} = ExportCategoryResponseValidationError{}
@@ -11,7 +11,6 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -20,13 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PaperlessCategoryService_CreateCategory_FullMethodName  = "/paperless.service.v1.PaperlessCategoryService/CreateCategory"
-	PaperlessCategoryService_GetCategory_FullMethodName     = "/paperless.service.v1.PaperlessCategoryService/GetCategory"
-	PaperlessCategoryService_ListCategories_FullMethodName  = "/paperless.service.v1.PaperlessCategoryService/ListCategories"
-	PaperlessCategoryService_UpdateCategory_FullMethodName  = "/paperless.service.v1.PaperlessCategoryService/UpdateCategory"
-	PaperlessCategoryService_DeleteCategory_FullMethodName  = "/paperless.service.v1.PaperlessCategoryService/DeleteCategory"
-	PaperlessCategoryService_MoveCategory_FullMethodName    = "/paperless.service.v1.PaperlessCategoryService/MoveCategory"
-	PaperlessCategoryService_GetCategoryTree_FullMethodName = "/paperless.service.v1.PaperlessCategoryService/GetCategoryTree"
+	PaperlessCategoryService_CreateCategory_FullMethodName       = "/paperless.service.v1.PaperlessCategoryService/CreateCategory"
+	PaperlessCategoryService_GetCategory_FullMethodName          = "/paperless.service.v1.PaperlessCategoryService/GetCategory"
+	PaperlessCategoryService_ListCategories_FullMethodName       = "/paperless.service.v1.PaperlessCategoryService/ListCategories"
+	PaperlessCategoryService_UpdateCategory_FullMethodName       = "/paperless.service.v1.PaperlessCategoryService/UpdateCategory"
+	PaperlessCategoryService_DeleteCategory_FullMethodName       = "/paperless.service.v1.PaperlessCategoryService/DeleteCategory"
+	PaperlessCategoryService_GetCategoryDeleteJob_FullMethodName = "/paperless.service.v1.PaperlessCategoryService/GetCategoryDeleteJob"
+	PaperlessCategoryService_MoveCategory_FullMethodName         = "/paperless.service.v1.PaperlessCategoryService/MoveCategory"
+	PaperlessCategoryService_CopyCategoryTree_FullMethodName     = "/paperless.service.v1.PaperlessCategoryService/CopyCategoryTree"
+	PaperlessCategoryService_SetCategorySortMode_FullMethodName  = "/paperless.service.v1.PaperlessCategoryService/SetCategorySortMode"
+	PaperlessCategoryService_ReorderCategories_FullMethodName    = "/paperless.service.v1.PaperlessCategoryService/ReorderCategories"
+	PaperlessCategoryService_GetCategoryTree_FullMethodName      = "/paperless.service.v1.PaperlessCategoryService/GetCategoryTree"
+	PaperlessCategoryService_GetCategoryChildren_FullMethodName  = "/paperless.service.v1.PaperlessCategoryService/GetCategoryChildren"
+	PaperlessCategoryService_GetCategoryPath_FullMethodName      = "/paperless.service.v1.PaperlessCategoryService/GetCategoryPath"
+	PaperlessCategoryService_RebuildCategoryPaths_FullMethodName = "/paperless.service.v1.PaperlessCategoryService/RebuildCategoryPaths"
+	PaperlessCategoryService_ExportCategory_FullMethodName       = "/paperless.service.v1.PaperlessCategoryService/ExportCategory"
 )
 
 // PaperlessCategoryServiceClient is the client API for PaperlessCategoryService service.
@@ -43,12 +50,37 @@ type PaperlessCategoryServiceClient interface {
 	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
 	// Update category metadata
 	UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*UpdateCategoryResponse, error)
-	// Delete a category (must be empty by default)
-	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	// Move a category to a new parent
+	// Delete a category (must be empty by default). The mode decides what happens to its
+	// subcategories and documents; with background set, the deletion is queued as a job
+	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*DeleteCategoryResponse, error)
+	// Get the progress of a background category deletion
+	GetCategoryDeleteJob(ctx context.Context, in *GetCategoryDeleteJobRequest, opts ...grpc.CallOption) (*GetCategoryDeleteJobResponse, error)
+	// Move a category and its subtree to a new parent. Moving a category into itself or its own
+	// subtree fails with CIRCULAR_CATEGORY_REFERENCE
 	MoveCategory(ctx context.Context, in *MoveCategoryRequest, opts ...grpc.CallOption) (*MoveCategoryResponse, error)
-	// Get the category tree structure
+	// Copy a category and its subtree under a new parent, optionally with copies of their
+	// documents and explicit permissions
+	CopyCategoryTree(ctx context.Context, in *CopyCategoryTreeRequest, opts ...grpc.CallOption) (*CopyCategoryTreeResponse, error)
+	// Set how the subcategories of a category, or the root categories, are ordered
+	SetCategorySortMode(ctx context.Context, in *SetCategorySortModeRequest, opts ...grpc.CallOption) (*SetCategorySortModeResponse, error)
+	// Put the subcategories of a category, or the root categories, in the given order and switch
+	// them to manual ordering, e.g. after a drag and drop
+	ReorderCategories(ctx context.Context, in *ReorderCategoriesRequest, opts ...grpc.CallOption) (*ReorderCategoriesResponse, error)
+	// Get the category tree structure down to max_depth. Nodes at the depth limit report
+	// has_children, so their children can be loaded with GetCategoryChildren
 	GetCategoryTree(ctx context.Context, in *GetCategoryTreeRequest, opts ...grpc.CallOption) (*GetCategoryTreeResponse, error)
+	// Get a page of the children of a category (or of the root level) as tree nodes, for
+	// expanding the tree on demand
+	GetCategoryChildren(ctx context.Context, in *GetCategoryChildrenRequest, opts ...grpc.CallOption) (*GetCategoryChildrenResponse, error)
+	// Get the ancestor chain of a category or of a document's category, root first, for
+	// rendering breadcrumbs
+	GetCategoryPath(ctx context.Context, in *GetCategoryPathRequest, opts ...grpc.CallOption) (*GetCategoryPathResponse, error)
+	// Recompute the materialized path and depth of a tenant's categories from their parent
+	// links and repair rows that drifted (platform admins only)
+	RebuildCategoryPaths(ctx context.Context, in *RebuildCategoryPathsRequest, opts ...grpc.CallOption) (*RebuildCategoryPathsResponse, error)
+	// Stream a ZIP of a category subtree: a folder per category, the stored files of its
+	// documents and a manifest.json with their metadata; gRPC only
+	ExportCategory(ctx context.Context, in *ExportCategoryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportCategoryResponse], error)
 }
 
 type paperlessCategoryServiceClient struct {
@@ -99,9 +131,9 @@ func (c *paperlessCategoryServiceClient) UpdateCategory(ctx context.Context, in
 	return out, nil
 }
 
-func (c *paperlessCategoryServiceClient) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *paperlessCategoryServiceClient) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*DeleteCategoryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
+	out := new(DeleteCategoryResponse)
 	err := c.cc.Invoke(ctx, PaperlessCategoryService_DeleteCategory_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -109,6 +141,16 @@ func (c *paperlessCategoryServiceClient) DeleteCategory(ctx context.Context, in
 	return out, nil
 }
 
+func (c *paperlessCategoryServiceClient) GetCategoryDeleteJob(ctx context.Context, in *GetCategoryDeleteJobRequest, opts ...grpc.CallOption) (*GetCategoryDeleteJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCategoryDeleteJobResponse)
+	err := c.cc.Invoke(ctx, PaperlessCategoryService_GetCategoryDeleteJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *paperlessCategoryServiceClient) MoveCategory(ctx context.Context, in *MoveCategoryRequest, opts ...grpc.CallOption) (*MoveCategoryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MoveCategoryResponse)
@@ -119,6 +161,36 @@ func (c *paperlessCategoryServiceClient) MoveCategory(ctx context.Context, in *M
 	return out, nil
 }
 
+func (c *paperlessCategoryServiceClient) CopyCategoryTree(ctx context.Context, in *CopyCategoryTreeRequest, opts ...grpc.CallOption) (*CopyCategoryTreeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CopyCategoryTreeResponse)
+	err := c.cc.Invoke(ctx, PaperlessCategoryService_CopyCategoryTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paperlessCategoryServiceClient) SetCategorySortMode(ctx context.Context, in *SetCategorySortModeRequest, opts ...grpc.CallOption) (*SetCategorySortModeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCategorySortModeResponse)
+	err := c.cc.Invoke(ctx, PaperlessCategoryService_SetCategorySortMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paperlessCategoryServiceClient) ReorderCategories(ctx context.Context, in *ReorderCategoriesRequest, opts ...grpc.CallOption) (*ReorderCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderCategoriesResponse)
+	err := c.cc.Invoke(ctx, PaperlessCategoryService_ReorderCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *paperlessCategoryServiceClient) GetCategoryTree(ctx context.Context, in *GetCategoryTreeRequest, opts ...grpc.CallOption) (*GetCategoryTreeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetCategoryTreeResponse)
@@ -129,6 +201,55 @@ func (c *paperlessCategoryServiceClient) GetCategoryTree(ctx context.Context, in
 	return out, nil
 }
 
+func (c *paperlessCategoryServiceClient) GetCategoryChildren(ctx context.Context, in *GetCategoryChildrenRequest, opts ...grpc.CallOption) (*GetCategoryChildrenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCategoryChildrenResponse)
+	err := c.cc.Invoke(ctx, PaperlessCategoryService_GetCategoryChildren_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paperlessCategoryServiceClient) GetCategoryPath(ctx context.Context, in *GetCategoryPathRequest, opts ...grpc.CallOption) (*GetCategoryPathResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCategoryPathResponse)
+	err := c.cc.Invoke(ctx, PaperlessCategoryService_GetCategoryPath_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paperlessCategoryServiceClient) RebuildCategoryPaths(ctx context.Context, in *RebuildCategoryPathsRequest, opts ...grpc.CallOption) (*RebuildCategoryPathsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RebuildCategoryPathsResponse)
+	err := c.cc.Invoke(ctx, PaperlessCategoryService_RebuildCategoryPaths_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paperlessCategoryServiceClient) ExportCategory(ctx context.Context, in *ExportCategoryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportCategoryResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PaperlessCategoryService_ServiceDesc.Streams[0], PaperlessCategoryService_ExportCategory_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportCategoryRequest, ExportCategoryResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PaperlessCategoryService_ExportCategoryClient = grpc.ServerStreamingClient[ExportCategoryResponse]
+
 // PaperlessCategoryServiceServer is the server API for PaperlessCategoryService service.
 // All implementations must embed UnimplementedPaperlessCategoryServiceServer
 // for forward compatibility.
@@ -143,12 +264,37 @@ type PaperlessCategoryServiceServer interface {
 	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
 	// Update category metadata
 	UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryResponse, error)
-	// Delete a category (must be empty by default)
-	DeleteCategory(context.Context, *DeleteCategoryRequest) (*emptypb.Empty, error)
-	// Move a category to a new parent
+	// Delete a category (must be empty by default). The mode decides what happens to its
+	// subcategories and documents; with background set, the deletion is queued as a job
+	DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error)
+	// Get the progress of a background category deletion
+	GetCategoryDeleteJob(context.Context, *GetCategoryDeleteJobRequest) (*GetCategoryDeleteJobResponse, error)
+	// Move a category and its subtree to a new parent. Moving a category into itself or its own
+	// subtree fails with CIRCULAR_CATEGORY_REFERENCE
 	MoveCategory(context.Context, *MoveCategoryRequest) (*MoveCategoryResponse, error)
-	// Get the category tree structure
+	// Copy a category and its subtree under a new parent, optionally with copies of their
+	// documents and explicit permissions
+	CopyCategoryTree(context.Context, *CopyCategoryTreeRequest) (*CopyCategoryTreeResponse, error)
+	// Set how the subcategories of a category, or the root categories, are ordered
+	SetCategorySortMode(context.Context, *SetCategorySortModeRequest) (*SetCategorySortModeResponse, error)
+	// Put the subcategories of a category, or the root categories, in the given order and switch
+	// them to manual ordering, e.g. after a drag and drop
+	ReorderCategories(context.Context, *ReorderCategoriesRequest) (*ReorderCategoriesResponse, error)
+	// Get the category tree structure down to max_depth. Nodes at the depth limit report
+	// has_children, so their children can be loaded with GetCategoryChildren
 	GetCategoryTree(context.Context, *GetCategoryTreeRequest) (*GetCategoryTreeResponse, error)
+	// Get a page of the children of a category (or of the root level) as tree nodes, for
+	// expanding the tree on demand
+	GetCategoryChildren(context.Context, *GetCategoryChildrenRequest) (*GetCategoryChildrenResponse, error)
+	// Get the ancestor chain of a category or of a document's category, root first, for
+	// rendering breadcrumbs
+	GetCategoryPath(context.Context, *GetCategoryPathRequest) (*GetCategoryPathResponse, error)
+	// Recompute the materialized path and depth of a tenant's categories from their parent
+	// links and repair rows that drifted (platform admins only)
+	RebuildCategoryPaths(context.Context, *RebuildCategoryPathsRequest) (*RebuildCategoryPathsResponse, error)
+	// Stream a ZIP of a category subtree: a folder per category, the stored files of its
+	// documents and a manifest.json with their metadata; gRPC only
+	ExportCategory(*ExportCategoryRequest, grpc.ServerStreamingServer[ExportCategoryResponse]) error
 	mustEmbedUnimplementedPaperlessCategoryServiceServer()
 }
 
@@ -171,15 +317,39 @@ func (UnimplementedPaperlessCategoryServiceServer) ListCategories(context.Contex
 func (UnimplementedPaperlessCategoryServiceServer) UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateCategory not implemented")
 }
-func (UnimplementedPaperlessCategoryServiceServer) DeleteCategory(context.Context, *DeleteCategoryRequest) (*emptypb.Empty, error) {
+func (UnimplementedPaperlessCategoryServiceServer) DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteCategory not implemented")
 }
+func (UnimplementedPaperlessCategoryServiceServer) GetCategoryDeleteJob(context.Context, *GetCategoryDeleteJobRequest) (*GetCategoryDeleteJobResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCategoryDeleteJob not implemented")
+}
 func (UnimplementedPaperlessCategoryServiceServer) MoveCategory(context.Context, *MoveCategoryRequest) (*MoveCategoryResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method MoveCategory not implemented")
 }
+func (UnimplementedPaperlessCategoryServiceServer) CopyCategoryTree(context.Context, *CopyCategoryTreeRequest) (*CopyCategoryTreeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CopyCategoryTree not implemented")
+}
+func (UnimplementedPaperlessCategoryServiceServer) SetCategorySortMode(context.Context, *SetCategorySortModeRequest) (*SetCategorySortModeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetCategorySortMode not implemented")
+}
+func (UnimplementedPaperlessCategoryServiceServer) ReorderCategories(context.Context, *ReorderCategoriesRequest) (*ReorderCategoriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReorderCategories not implemented")
+}
 func (UnimplementedPaperlessCategoryServiceServer) GetCategoryTree(context.Context, *GetCategoryTreeRequest) (*GetCategoryTreeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetCategoryTree not implemented")
 }
+func (UnimplementedPaperlessCategoryServiceServer) GetCategoryChildren(context.Context, *GetCategoryChildrenRequest) (*GetCategoryChildrenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCategoryChildren not implemented")
+}
+func (UnimplementedPaperlessCategoryServiceServer) GetCategoryPath(context.Context, *GetCategoryPathRequest) (*GetCategoryPathResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCategoryPath not implemented")
+}
+func (UnimplementedPaperlessCategoryServiceServer) RebuildCategoryPaths(context.Context, *RebuildCategoryPathsRequest) (*RebuildCategoryPathsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RebuildCategoryPaths not implemented")
+}
+func (UnimplementedPaperlessCategoryServiceServer) ExportCategory(*ExportCategoryRequest, grpc.ServerStreamingServer[ExportCategoryResponse]) error {
+	return status.Error(codes.Unimplemented, "method ExportCategory not implemented")
+}
 func (UnimplementedPaperlessCategoryServiceServer) mustEmbedUnimplementedPaperlessCategoryServiceServer() {
 }
 func (UnimplementedPaperlessCategoryServiceServer) testEmbeddedByValue() {}
@@ -292,6 +462,24 @@ func _PaperlessCategoryService_DeleteCategory_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaperlessCategoryService_GetCategoryDeleteJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryDeleteJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaperlessCategoryServiceServer).GetCategoryDeleteJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaperlessCategoryService_GetCategoryDeleteJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaperlessCategoryServiceServer).GetCategoryDeleteJob(ctx, req.(*GetCategoryDeleteJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PaperlessCategoryService_MoveCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MoveCategoryRequest)
 	if err := dec(in); err != nil {
@@ -310,6 +498,60 @@ func _PaperlessCategoryService_MoveCategory_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaperlessCategoryService_CopyCategoryTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyCategoryTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaperlessCategoryServiceServer).CopyCategoryTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaperlessCategoryService_CopyCategoryTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaperlessCategoryServiceServer).CopyCategoryTree(ctx, req.(*CopyCategoryTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaperlessCategoryService_SetCategorySortMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCategorySortModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaperlessCategoryServiceServer).SetCategorySortMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaperlessCategoryService_SetCategorySortMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaperlessCategoryServiceServer).SetCategorySortMode(ctx, req.(*SetCategorySortModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaperlessCategoryService_ReorderCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaperlessCategoryServiceServer).ReorderCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaperlessCategoryService_ReorderCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaperlessCategoryServiceServer).ReorderCategories(ctx, req.(*ReorderCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PaperlessCategoryService_GetCategoryTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCategoryTreeRequest)
 	if err := dec(in); err != nil {
@@ -328,6 +570,71 @@ func _PaperlessCategoryService_GetCategoryTree_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaperlessCategoryService_GetCategoryChildren_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryChildrenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaperlessCategoryServiceServer).GetCategoryChildren(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaperlessCategoryService_GetCategoryChildren_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaperlessCategoryServiceServer).GetCategoryChildren(ctx, req.(*GetCategoryChildrenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaperlessCategoryService_GetCategoryPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaperlessCategoryServiceServer).GetCategoryPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaperlessCategoryService_GetCategoryPath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaperlessCategoryServiceServer).GetCategoryPath(ctx, req.(*GetCategoryPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaperlessCategoryService_RebuildCategoryPaths_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebuildCategoryPathsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaperlessCategoryServiceServer).RebuildCategoryPaths(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaperlessCategoryService_RebuildCategoryPaths_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaperlessCategoryServiceServer).RebuildCategoryPaths(ctx, req.(*RebuildCategoryPathsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaperlessCategoryService_ExportCategory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportCategoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PaperlessCategoryServiceServer).ExportCategory(m, &grpc.GenericServerStream[ExportCategoryRequest, ExportCategoryResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PaperlessCategoryService_ExportCategoryServer = grpc.ServerStreamingServer[ExportCategoryResponse]
+
 // PaperlessCategoryService_ServiceDesc is the grpc.ServiceDesc for PaperlessCategoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -355,15 +662,49 @@ var PaperlessCategoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCategory",
 			Handler:    _PaperlessCategoryService_DeleteCategory_Handler,
 		},
+		{
+			MethodName: "GetCategoryDeleteJob",
+			Handler:    _PaperlessCategoryService_GetCategoryDeleteJob_Handler,
+		},
 		{
 			MethodName: "MoveCategory",
 			Handler:    _PaperlessCategoryService_MoveCategory_Handler,
 		},
+		{
+			MethodName: "CopyCategoryTree",
+			Handler:    _PaperlessCategoryService_CopyCategoryTree_Handler,
+		},
+		{
+			MethodName: "SetCategorySortMode",
+			Handler:    _PaperlessCategoryService_SetCategorySortMode_Handler,
+		},
+		{
+			MethodName: "ReorderCategories",
+			Handler:    _PaperlessCategoryService_ReorderCategories_Handler,
+		},
 		{
 			MethodName: "GetCategoryTree",
 			Handler:    _PaperlessCategoryService_GetCategoryTree_Handler,
 		},
+		{
+			MethodName: "GetCategoryChildren",
+			Handler:    _PaperlessCategoryService_GetCategoryChildren_Handler,
+		},
+		{
+			MethodName: "GetCategoryPath",
+			Handler:    _PaperlessCategoryService_GetCategoryPath_Handler,
+		},
+		{
+			MethodName: "RebuildCategoryPaths",
+			Handler:    _PaperlessCategoryService_RebuildCategoryPaths_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportCategory",
+			Handler:       _PaperlessCategoryService_ExportCategory_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "paperless/service/v1/category.proto",
 }
@@ -10,7 +10,6 @@ import (
 	context "context"
 	http "github.com/go-kratos/kratos/v2/transport/http"
 	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -20,27 +19,56 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationPaperlessCategoryServiceCopyCategoryTree = "/paperless.service.v1.PaperlessCategoryService/CopyCategoryTree"
 const OperationPaperlessCategoryServiceCreateCategory = "/paperless.service.v1.PaperlessCategoryService/CreateCategory"
 const OperationPaperlessCategoryServiceDeleteCategory = "/paperless.service.v1.PaperlessCategoryService/DeleteCategory"
 const OperationPaperlessCategoryServiceGetCategory = "/paperless.service.v1.PaperlessCategoryService/GetCategory"
+const OperationPaperlessCategoryServiceGetCategoryChildren = "/paperless.service.v1.PaperlessCategoryService/GetCategoryChildren"
+const OperationPaperlessCategoryServiceGetCategoryDeleteJob = "/paperless.service.v1.PaperlessCategoryService/GetCategoryDeleteJob"
+const OperationPaperlessCategoryServiceGetCategoryPath = "/paperless.service.v1.PaperlessCategoryService/GetCategoryPath"
 const OperationPaperlessCategoryServiceGetCategoryTree = "/paperless.service.v1.PaperlessCategoryService/GetCategoryTree"
 const OperationPaperlessCategoryServiceListCategories = "/paperless.service.v1.PaperlessCategoryService/ListCategories"
 const OperationPaperlessCategoryServiceMoveCategory = "/paperless.service.v1.PaperlessCategoryService/MoveCategory"
+const OperationPaperlessCategoryServiceRebuildCategoryPaths = "/paperless.service.v1.PaperlessCategoryService/RebuildCategoryPaths"
+const OperationPaperlessCategoryServiceReorderCategories = "/paperless.service.v1.PaperlessCategoryService/ReorderCategories"
+const OperationPaperlessCategoryServiceSetCategorySortMode = "/paperless.service.v1.PaperlessCategoryService/SetCategorySortMode"
 const OperationPaperlessCategoryServiceUpdateCategory = "/paperless.service.v1.PaperlessCategoryService/UpdateCategory"
 
 type PaperlessCategoryServiceHTTPServer interface {
+	// CopyCategoryTree Copy a category and its subtree under a new parent, optionally with copies of their
+	// documents and explicit permissions
+	CopyCategoryTree(context.Context, *CopyCategoryTreeRequest) (*CopyCategoryTreeResponse, error)
 	// CreateCategory Create a new category
 	CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error)
-	// DeleteCategory Delete a category (must be empty by default)
-	DeleteCategory(context.Context, *DeleteCategoryRequest) (*emptypb.Empty, error)
+	// DeleteCategory Delete a category (must be empty by default). The mode decides what happens to its
+	// subcategories and documents; with background set, the deletion is queued as a job
+	DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error)
 	// GetCategory Get a category by ID
 	GetCategory(context.Context, *GetCategoryRequest) (*GetCategoryResponse, error)
-	// GetCategoryTree Get the category tree structure
+	// GetCategoryChildren Get a page of the children of a category (or of the root level) as tree nodes, for
+	// expanding the tree on demand
+	GetCategoryChildren(context.Context, *GetCategoryChildrenRequest) (*GetCategoryChildrenResponse, error)
+	// GetCategoryDeleteJob Get the progress of a background category deletion
+	GetCategoryDeleteJob(context.Context, *GetCategoryDeleteJobRequest) (*GetCategoryDeleteJobResponse, error)
+	// GetCategoryPath Get the ancestor chain of a category or of a document's category, root first, for
+	// rendering breadcrumbs
+	GetCategoryPath(context.Context, *GetCategoryPathRequest) (*GetCategoryPathResponse, error)
+	// GetCategoryTree Get the category tree structure down to max_depth. Nodes at the depth limit report
+	// has_children, so their children can be loaded with GetCategoryChildren
 	GetCategoryTree(context.Context, *GetCategoryTreeRequest) (*GetCategoryTreeResponse, error)
 	// ListCategories List categories in a parent category (or root if no parent specified)
 	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
-	// MoveCategory Move a category to a new parent
+	// MoveCategory Move a category and its subtree to a new parent. Moving a category into itself or its own
+	// subtree fails with CIRCULAR_CATEGORY_REFERENCE
 	MoveCategory(context.Context, *MoveCategoryRequest) (*MoveCategoryResponse, error)
+	// RebuildCategoryPaths Recompute the materialized path and depth of a tenant's categories from their parent
+	// links and repair rows that drifted (platform admins only)
+	RebuildCategoryPaths(context.Context, *RebuildCategoryPathsRequest) (*RebuildCategoryPathsResponse, error)
+	// ReorderCategories Put the subcategories of a category, or the root categories, in the given order and switch
+	// them to manual ordering, e.g. after a drag and drop
+	ReorderCategories(context.Context, *ReorderCategoriesRequest) (*ReorderCategoriesResponse, error)
+	// SetCategorySortMode Set how the subcategories of a category, or the root categories, are ordered
+	SetCategorySortMode(context.Context, *SetCategorySortModeRequest) (*SetCategorySortModeResponse, error)
 	// UpdateCategory Update category metadata
 	UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryResponse, error)
 }
@@ -52,8 +80,15 @@ func RegisterPaperlessCategoryServiceHTTPServer(s *http.Server, srv PaperlessCat
 	r.GET("/v1/categories", _PaperlessCategoryService_ListCategories0_HTTP_Handler(srv))
 	r.PUT("/v1/categories/{id}", _PaperlessCategoryService_UpdateCategory0_HTTP_Handler(srv))
 	r.DELETE("/v1/categories/{id}", _PaperlessCategoryService_DeleteCategory0_HTTP_Handler(srv))
+	r.GET("/v1/categories/delete-jobs/{id}", _PaperlessCategoryService_GetCategoryDeleteJob0_HTTP_Handler(srv))
 	r.POST("/v1/categories/{id}/move", _PaperlessCategoryService_MoveCategory0_HTTP_Handler(srv))
+	r.POST("/v1/categories/{id}/copy", _PaperlessCategoryService_CopyCategoryTree0_HTTP_Handler(srv))
+	r.POST("/v1/categories/sort-mode", _PaperlessCategoryService_SetCategorySortMode0_HTTP_Handler(srv))
+	r.POST("/v1/categories/reorder", _PaperlessCategoryService_ReorderCategories0_HTTP_Handler(srv))
 	r.GET("/v1/categories/tree", _PaperlessCategoryService_GetCategoryTree0_HTTP_Handler(srv))
+	r.GET("/v1/categories/children", _PaperlessCategoryService_GetCategoryChildren0_HTTP_Handler(srv))
+	r.GET("/v1/categories/path", _PaperlessCategoryService_GetCategoryPath0_HTTP_Handler(srv))
+	r.POST("/v1/categories/rebuild-paths", _PaperlessCategoryService_RebuildCategoryPaths0_HTTP_Handler(srv))
 }
 
 func _PaperlessCategoryService_CreateCategory0_HTTP_Handler(srv PaperlessCategoryServiceHTTPServer) func(ctx http.Context) error {
@@ -161,7 +196,29 @@ func _PaperlessCategoryService_DeleteCategory0_HTTP_Handler(srv PaperlessCategor
 		if err != nil {
 			return err
 		}
-		reply := out.(*emptypb.Empty)
+		reply := out.(*DeleteCategoryResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PaperlessCategoryService_GetCategoryDeleteJob0_HTTP_Handler(srv PaperlessCategoryServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCategoryDeleteJobRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPaperlessCategoryServiceGetCategoryDeleteJob)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCategoryDeleteJob(ctx, req.(*GetCategoryDeleteJobRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetCategoryDeleteJobResponse)
 		return ctx.Result(200, reply)
 	}
 }
@@ -191,6 +248,75 @@ func _PaperlessCategoryService_MoveCategory0_HTTP_Handler(srv PaperlessCategoryS
 	}
 }
 
+func _PaperlessCategoryService_CopyCategoryTree0_HTTP_Handler(srv PaperlessCategoryServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CopyCategoryTreeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPaperlessCategoryServiceCopyCategoryTree)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CopyCategoryTree(ctx, req.(*CopyCategoryTreeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CopyCategoryTreeResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PaperlessCategoryService_SetCategorySortMode0_HTTP_Handler(srv PaperlessCategoryServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetCategorySortModeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPaperlessCategoryServiceSetCategorySortMode)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetCategorySortMode(ctx, req.(*SetCategorySortModeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetCategorySortModeResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PaperlessCategoryService_ReorderCategories0_HTTP_Handler(srv PaperlessCategoryServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReorderCategoriesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPaperlessCategoryServiceReorderCategories)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReorderCategories(ctx, req.(*ReorderCategoriesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReorderCategoriesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _PaperlessCategoryService_GetCategoryTree0_HTTP_Handler(srv PaperlessCategoryServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetCategoryTreeRequest
@@ -210,19 +336,101 @@ func _PaperlessCategoryService_GetCategoryTree0_HTTP_Handler(srv PaperlessCatego
 	}
 }
 
+func _PaperlessCategoryService_GetCategoryChildren0_HTTP_Handler(srv PaperlessCategoryServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCategoryChildrenRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPaperlessCategoryServiceGetCategoryChildren)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCategoryChildren(ctx, req.(*GetCategoryChildrenRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetCategoryChildrenResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PaperlessCategoryService_GetCategoryPath0_HTTP_Handler(srv PaperlessCategoryServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCategoryPathRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPaperlessCategoryServiceGetCategoryPath)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCategoryPath(ctx, req.(*GetCategoryPathRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetCategoryPathResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PaperlessCategoryService_RebuildCategoryPaths0_HTTP_Handler(srv PaperlessCategoryServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RebuildCategoryPathsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPaperlessCategoryServiceRebuildCategoryPaths)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RebuildCategoryPaths(ctx, req.(*RebuildCategoryPathsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RebuildCategoryPathsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type PaperlessCategoryServiceHTTPClient interface {
+	// CopyCategoryTree Copy a category and its subtree under a new parent, optionally with copies of their
+	// documents and explicit permissions
+	CopyCategoryTree(ctx context.Context, req *CopyCategoryTreeRequest, opts ...http.CallOption) (rsp *CopyCategoryTreeResponse, err error)
 	// CreateCategory Create a new category
 	CreateCategory(ctx context.Context, req *CreateCategoryRequest, opts ...http.CallOption) (rsp *CreateCategoryResponse, err error)
-	// DeleteCategory Delete a category (must be empty by default)
-	DeleteCategory(ctx context.Context, req *DeleteCategoryRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// DeleteCategory Delete a category (must be empty by default). The mode decides what happens to its
+	// subcategories and documents; with background set, the deletion is queued as a job
+	DeleteCategory(ctx context.Context, req *DeleteCategoryRequest, opts ...http.CallOption) (rsp *DeleteCategoryResponse, err error)
 	// GetCategory Get a category by ID
 	GetCategory(ctx context.Context, req *GetCategoryRequest, opts ...http.CallOption) (rsp *GetCategoryResponse, err error)
-	// GetCategoryTree Get the category tree structure
+	// GetCategoryChildren Get a page of the children of a category (or of the root level) as tree nodes, for
+	// expanding the tree on demand
+	GetCategoryChildren(ctx context.Context, req *GetCategoryChildrenRequest, opts ...http.CallOption) (rsp *GetCategoryChildrenResponse, err error)
+	// GetCategoryDeleteJob Get the progress of a background category deletion
+	GetCategoryDeleteJob(ctx context.Context, req *GetCategoryDeleteJobRequest, opts ...http.CallOption) (rsp *GetCategoryDeleteJobResponse, err error)
+	// GetCategoryPath Get the ancestor chain of a category or of a document's category, root first, for
+	// rendering breadcrumbs
+	GetCategoryPath(ctx context.Context, req *GetCategoryPathRequest, opts ...http.CallOption) (rsp *GetCategoryPathResponse, err error)
+	// GetCategoryTree Get the category tree structure down to max_depth. Nodes at the depth limit report
+	// has_children, so their children can be loaded with GetCategoryChildren
 	GetCategoryTree(ctx context.Context, req *GetCategoryTreeRequest, opts ...http.CallOption) (rsp *GetCategoryTreeResponse, err error)
 	// ListCategories List categories in a parent category (or root if no parent specified)
 	ListCategories(ctx context.Context, req *ListCategoriesRequest, opts ...http.CallOption) (rsp *ListCategoriesResponse, err error)
-	// MoveCategory Move a category to a new parent
+	// MoveCategory Move a category and its subtree to a new parent. Moving a category into itself or its own
+	// subtree fails with CIRCULAR_CATEGORY_REFERENCE
 	MoveCategory(ctx context.Context, req *MoveCategoryRequest, opts ...http.CallOption) (rsp *MoveCategoryResponse, err error)
+	// RebuildCategoryPaths Recompute the materialized path and depth of a tenant's categories from their parent
+	// links and repair rows that drifted (platform admins only)
+	RebuildCategoryPaths(ctx context.Context, req *RebuildCategoryPathsRequest, opts ...http.CallOption) (rsp *RebuildCategoryPathsResponse, err error)
+	// ReorderCategories Put the subcategories of a category, or the root categories, in the given order and switch
+	// them to manual ordering, e.g. after a drag and drop
+	ReorderCategories(ctx context.Context, req *ReorderCategoriesRequest, opts ...http.CallOption) (rsp *ReorderCategoriesResponse, err error)
+	// SetCategorySortMode Set how the subcategories of a category, or the root categories, are ordered
+	SetCategorySortMode(ctx context.Context, req *SetCategorySortModeRequest, opts ...http.CallOption) (rsp *SetCategorySortModeResponse, err error)
 	// UpdateCategory Update category metadata
 	UpdateCategory(ctx context.Context, req *UpdateCategoryRequest, opts ...http.CallOption) (rsp *UpdateCategoryResponse, err error)
 }
@@ -235,6 +443,21 @@ func NewPaperlessCategoryServiceHTTPClient(client *http.Client) PaperlessCategor
 	return &PaperlessCategoryServiceHTTPClientImpl{client}
 }
 
+// CopyCategoryTree Copy a category and its subtree under a new parent, optionally with copies of their
+// documents and explicit permissions
+func (c *PaperlessCategoryServiceHTTPClientImpl) CopyCategoryTree(ctx context.Context, in *CopyCategoryTreeRequest, opts ...http.CallOption) (*CopyCategoryTreeResponse, error) {
+	var out CopyCategoryTreeResponse
+	pattern := "/v1/categories/{id}/copy"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPaperlessCategoryServiceCopyCategoryTree))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // CreateCategory Create a new category
 func (c *PaperlessCategoryServiceHTTPClientImpl) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...http.CallOption) (*CreateCategoryResponse, error) {
 	var out CreateCategoryResponse
@@ -249,9 +472,10 @@ func (c *PaperlessCategoryServiceHTTPClientImpl) CreateCategory(ctx context.Cont
 	return &out, nil
 }
 
-// DeleteCategory Delete a category (must be empty by default)
-func (c *PaperlessCategoryServiceHTTPClientImpl) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
-	var out emptypb.Empty
+// DeleteCategory Delete a category (must be empty by default). The mode decides what happens to its
+// subcategories and documents; with background set, the deletion is queued as a job
+func (c *PaperlessCategoryServiceHTTPClientImpl) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...http.CallOption) (*DeleteCategoryResponse, error) {
+	var out DeleteCategoryResponse
 	pattern := "/v1/categories/{id}"
 	path := binding.EncodeURL(pattern, in, true)
 	opts = append(opts, http.Operation(OperationPaperlessCategoryServiceDeleteCategory))
@@ -277,7 +501,52 @@ func (c *PaperlessCategoryServiceHTTPClientImpl) GetCategory(ctx context.Context
 	return &out, nil
 }
 
-// GetCategoryTree Get the category tree structure
+// GetCategoryChildren Get a page of the children of a category (or of the root level) as tree nodes, for
+// expanding the tree on demand
+func (c *PaperlessCategoryServiceHTTPClientImpl) GetCategoryChildren(ctx context.Context, in *GetCategoryChildrenRequest, opts ...http.CallOption) (*GetCategoryChildrenResponse, error) {
+	var out GetCategoryChildrenResponse
+	pattern := "/v1/categories/children"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPaperlessCategoryServiceGetCategoryChildren))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetCategoryDeleteJob Get the progress of a background category deletion
+func (c *PaperlessCategoryServiceHTTPClientImpl) GetCategoryDeleteJob(ctx context.Context, in *GetCategoryDeleteJobRequest, opts ...http.CallOption) (*GetCategoryDeleteJobResponse, error) {
+	var out GetCategoryDeleteJobResponse
+	pattern := "/v1/categories/delete-jobs/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPaperlessCategoryServiceGetCategoryDeleteJob))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetCategoryPath Get the ancestor chain of a category or of a document's category, root first, for
+// rendering breadcrumbs
+func (c *PaperlessCategoryServiceHTTPClientImpl) GetCategoryPath(ctx context.Context, in *GetCategoryPathRequest, opts ...http.CallOption) (*GetCategoryPathResponse, error) {
+	var out GetCategoryPathResponse
+	pattern := "/v1/categories/path"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPaperlessCategoryServiceGetCategoryPath))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetCategoryTree Get the category tree structure down to max_depth. Nodes at the depth limit report
+// has_children, so their children can be loaded with GetCategoryChildren
 func (c *PaperlessCategoryServiceHTTPClientImpl) GetCategoryTree(ctx context.Context, in *GetCategoryTreeRequest, opts ...http.CallOption) (*GetCategoryTreeResponse, error) {
 	var out GetCategoryTreeResponse
 	pattern := "/v1/categories/tree"
@@ -305,7 +574,8 @@ func (c *PaperlessCategoryServiceHTTPClientImpl) ListCategories(ctx context.Cont
 	return &out, nil
 }
 
-// MoveCategory Move a category to a new parent
+// MoveCategory Move a category and its subtree to a new parent. Moving a category into itself or its own
+// subtree fails with CIRCULAR_CATEGORY_REFERENCE
 func (c *PaperlessCategoryServiceHTTPClientImpl) MoveCategory(ctx context.Context, in *MoveCategoryRequest, opts ...http.CallOption) (*MoveCategoryResponse, error) {
 	var out MoveCategoryResponse
 	pattern := "/v1/categories/{id}/move"
@@ -319,6 +589,50 @@ func (c *PaperlessCategoryServiceHTTPClientImpl) MoveCategory(ctx context.Contex
 	return &out, nil
 }
 
+// RebuildCategoryPaths Recompute the materialized path and depth of a tenant's categories from their parent
+// links and repair rows that drifted (platform admins only)
+func (c *PaperlessCategoryServiceHTTPClientImpl) RebuildCategoryPaths(ctx context.Context, in *RebuildCategoryPathsRequest, opts ...http.CallOption) (*RebuildCategoryPathsResponse, error) {
+	var out RebuildCategoryPathsResponse
+	pattern := "/v1/categories/rebuild-paths"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPaperlessCategoryServiceRebuildCategoryPaths))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ReorderCategories Put the subcategories of a category, or the root categories, in the given order and switch
+// them to manual ordering, e.g. after a drag and drop
+func (c *PaperlessCategoryServiceHTTPClientImpl) ReorderCategories(ctx context.Context, in *ReorderCategoriesRequest, opts ...http.CallOption) (*ReorderCategoriesResponse, error) {
+	var out ReorderCategoriesResponse
+	pattern := "/v1/categories/reorder"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPaperlessCategoryServiceReorderCategories))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetCategorySortMode Set how the subcategories of a category, or the root categories, are ordered
+func (c *PaperlessCategoryServiceHTTPClientImpl) SetCategorySortMode(ctx context.Context, in *SetCategorySortModeRequest, opts ...http.CallOption) (*SetCategorySortModeResponse, error) {
+	var out SetCategorySortModeResponse
+	pattern := "/v1/categories/sort-mode"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPaperlessCategoryServiceSetCategorySortMode))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // UpdateCategory Update category metadata
 func (c *PaperlessCategoryServiceHTTPClientImpl) UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...http.CallOption) (*UpdateCategoryResponse, error) {
 	var out UpdateCategoryResponse
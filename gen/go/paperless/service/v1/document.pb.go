@@ -79,6 +79,156 @@ func (DocumentStatus) EnumDescriptor() ([]byte, []int) {
 	return file_paperless_service_v1_document_proto_rawDescGZIP(), []int{0}
 }
 
+// Storage tier of a document's file
+type StorageTier int32
+
+const (
+	StorageTier_STORAGE_TIER_UNSPECIFIED StorageTier = 0
+	StorageTier_STORAGE_TIER_HOT         StorageTier = 1 // Served from the regular storage backend
+	StorageTier_STORAGE_TIER_COLD        StorageTier = 2 // Moved to cold storage, restored on access
+)
+
+// Enum value maps for StorageTier.
+var (
+	StorageTier_name = map[int32]string{
+		0: "STORAGE_TIER_UNSPECIFIED",
+		1: "STORAGE_TIER_HOT",
+		2: "STORAGE_TIER_COLD",
+	}
+	StorageTier_value = map[string]int32{
+		"STORAGE_TIER_UNSPECIFIED": 0,
+		"STORAGE_TIER_HOT":         1,
+		"STORAGE_TIER_COLD":        2,
+	}
+)
+
+func (x StorageTier) Enum() *StorageTier {
+	p := new(StorageTier)
+	*p = x
+	return p
+}
+
+func (x StorageTier) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StorageTier) Descriptor() protoreflect.EnumDescriptor {
+	return file_paperless_service_v1_document_proto_enumTypes[1].Descriptor()
+}
+
+func (StorageTier) Type() protoreflect.EnumType {
+	return &file_paperless_service_v1_document_proto_enumTypes[1]
+}
+
+func (x StorageTier) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StorageTier.Descriptor instead.
+func (StorageTier) EnumDescriptor() ([]byte, []int) {
+	return file_paperless_service_v1_document_proto_rawDescGZIP(), []int{1}
+}
+
+// How a downloaded file is presented by the browser
+type ContentDisposition int32
+
+const (
+	ContentDisposition_CONTENT_DISPOSITION_UNSPECIFIED ContentDisposition = 0
+	ContentDisposition_CONTENT_DISPOSITION_ATTACHMENT  ContentDisposition = 1 // Save the file
+	ContentDisposition_CONTENT_DISPOSITION_INLINE      ContentDisposition = 2 // Display the file in the browser
+)
+
+// Enum value maps for ContentDisposition.
+var (
+	ContentDisposition_name = map[int32]string{
+		0: "CONTENT_DISPOSITION_UNSPECIFIED",
+		1: "CONTENT_DISPOSITION_ATTACHMENT",
+		2: "CONTENT_DISPOSITION_INLINE",
+	}
+	ContentDisposition_value = map[string]int32{
+		"CONTENT_DISPOSITION_UNSPECIFIED": 0,
+		"CONTENT_DISPOSITION_ATTACHMENT":  1,
+		"CONTENT_DISPOSITION_INLINE":      2,
+	}
+)
+
+func (x ContentDisposition) Enum() *ContentDisposition {
+	p := new(ContentDisposition)
+	*p = x
+	return p
+}
+
+func (x ContentDisposition) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContentDisposition) Descriptor() protoreflect.EnumDescriptor {
+	return file_paperless_service_v1_document_proto_enumTypes[2].Descriptor()
+}
+
+func (ContentDisposition) Type() protoreflect.EnumType {
+	return &file_paperless_service_v1_document_proto_enumTypes[2]
+}
+
+func (x ContentDisposition) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContentDisposition.Descriptor instead.
+func (ContentDisposition) EnumDescriptor() ([]byte, []int) {
+	return file_paperless_service_v1_document_proto_rawDescGZIP(), []int{2}
+}
+
+// Format of a downloaded file
+type DownloadFormat int32
+
+const (
+	DownloadFormat_DOWNLOAD_FORMAT_UNSPECIFIED DownloadFormat = 0
+	DownloadFormat_DOWNLOAD_FORMAT_ORIGINAL    DownloadFormat = 1 // The stored file as uploaded
+	DownloadFormat_DOWNLOAD_FORMAT_PDF         DownloadFormat = 2 // A PDF rendition, e.g. for printing
+)
+
+// Enum value maps for DownloadFormat.
+var (
+	DownloadFormat_name = map[int32]string{
+		0: "DOWNLOAD_FORMAT_UNSPECIFIED",
+		1: "DOWNLOAD_FORMAT_ORIGINAL",
+		2: "DOWNLOAD_FORMAT_PDF",
+	}
+	DownloadFormat_value = map[string]int32{
+		"DOWNLOAD_FORMAT_UNSPECIFIED": 0,
+		"DOWNLOAD_FORMAT_ORIGINAL":    1,
+		"DOWNLOAD_FORMAT_PDF":         2,
+	}
+)
+
+func (x DownloadFormat) Enum() *DownloadFormat {
+	p := new(DownloadFormat)
+	*p = x
+	return p
+}
+
+func (x DownloadFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DownloadFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_paperless_service_v1_document_proto_enumTypes[3].Descriptor()
+}
+
+func (DownloadFormat) Type() protoreflect.EnumType {
+	return &file_paperless_service_v1_document_proto_enumTypes[3]
+}
+
+func (x DownloadFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DownloadFormat.Descriptor instead.
+func (DownloadFormat) EnumDescriptor() ([]byte, []int) {
+	return file_paperless_service_v1_document_proto_rawDescGZIP(), []int{3}
+}
+
 // Document source - where the document originated from
 type DocumentSource int32
 
@@ -86,6 +236,7 @@ const (
 	DocumentSource_DOCUMENT_SOURCE_UNSPECIFIED DocumentSource = 0
 	DocumentSource_DOCUMENT_SOURCE_UPLOAD      DocumentSource = 1 // Uploaded manually by user
 	DocumentSource_DOCUMENT_SOURCE_EMAIL       DocumentSource = 2 // Received via email
+	DocumentSource_DOCUMENT_SOURCE_IMPORT      DocumentSource = 3 // Imported from Google Drive or SharePoint
 )
 
 // Enum value maps for DocumentSource.
@@ -94,11 +245,13 @@ var (
 		0: "DOCUMENT_SOURCE_UNSPECIFIED",
 		1: "DOCUMENT_SOURCE_UPLOAD",
 		2: "DOCUMENT_SOURCE_EMAIL",
+		3: "DOCUMENT_SOURCE_IMPORT",
 	}
 	DocumentSource_value = map[string]int32{
 		"DOCUMENT_SOURCE_UNSPECIFIED": 0,
 		"DOCUMENT_SOURCE_UPLOAD":      1,
 		"DOCUMENT_SOURCE_EMAIL":       2,
+		"DOCUMENT_SOURCE_IMPORT":      3,
 	}
 )
 
@@ -113,11 +266,11 @@ func (x DocumentSource) String() string {
 }
 
 func (DocumentSource) Descriptor() protoreflect.EnumDescriptor {
-	return file_paperless_service_v1_document_proto_enumTypes[1].Descriptor()
+	return file_paperless_service_v1_document_proto_enumTypes[4].Descriptor()
 }
 
 func (DocumentSource) Type() protoreflect.EnumType {
-	return &file_paperless_service_v1_document_proto_enumTypes[1]
+	return &file_paperless_service_v1_document_proto_enumTypes[4]
 }
 
 func (x DocumentSource) Number() protoreflect.EnumNumber {
@@ -126,35 +279,107 @@ func (x DocumentSource) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DocumentSource.Descriptor instead.
 func (DocumentSource) EnumDescriptor() ([]byte, []int) {
-	return file_paperless_service_v1_document_proto_rawDescGZIP(), []int{1}
+	return file_paperless_service_v1_document_proto_rawDescGZIP(), []int{4}
+}
+
+// Kind of change reported by WatchDocuments
+type DocumentChangeType int32
+
+const (
+	DocumentChangeType_DOCUMENT_CHANGE_TYPE_UNSPECIFIED DocumentChangeType = 0
+	DocumentChangeType_DOCUMENT_CHANGE_TYPE_CREATED     DocumentChangeType = 1
+	DocumentChangeType_DOCUMENT_CHANGE_TYPE_UPDATED     DocumentChangeType = 2
+	DocumentChangeType_DOCUMENT_CHANGE_TYPE_MOVED       DocumentChangeType = 3
+	DocumentChangeType_DOCUMENT_CHANGE_TYPE_PROCESSED   DocumentChangeType = 4 // Text extraction finished
+	DocumentChangeType_DOCUMENT_CHANGE_TYPE_DELETED     DocumentChangeType = 5
+)
+
+// Enum value maps for DocumentChangeType.
+var (
+	DocumentChangeType_name = map[int32]string{
+		0: "DOCUMENT_CHANGE_TYPE_UNSPECIFIED",
+		1: "DOCUMENT_CHANGE_TYPE_CREATED",
+		2: "DOCUMENT_CHANGE_TYPE_UPDATED",
+		3: "DOCUMENT_CHANGE_TYPE_MOVED",
+		4: "DOCUMENT_CHANGE_TYPE_PROCESSED",
+		5: "DOCUMENT_CHANGE_TYPE_DELETED",
+	}
+	DocumentChangeType_value = map[string]int32{
+		"DOCUMENT_CHANGE_TYPE_UNSPECIFIED": 0,
+		"DOCUMENT_CHANGE_TYPE_CREATED":     1,
+		"DOCUMENT_CHANGE_TYPE_UPDATED":     2,
+		"DOCUMENT_CHANGE_TYPE_MOVED":       3,
+		"DOCUMENT_CHANGE_TYPE_PROCESSED":   4,
+		"DOCUMENT_CHANGE_TYPE_DELETED":     5,
+	}
+)
+
+func (x DocumentChangeType) Enum() *DocumentChangeType {
+	p := new(DocumentChangeType)
+	*p = x
+	return p
+}
+
+func (x DocumentChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DocumentChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_paperless_service_v1_document_proto_enumTypes[5].Descriptor()
+}
+
+func (DocumentChangeType) Type() protoreflect.EnumType {
+	return &file_paperless_service_v1_document_proto_enumTypes[5]
+}
+
+func (x DocumentChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DocumentChangeType.Descriptor instead.
+func (DocumentChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_paperless_service_v1_document_proto_rawDescGZIP(), []int{5}
 }
 
 // Document entity
 type Document struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TenantId          uint32                 `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	CategoryId        *string                `protobuf:"bytes,3,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
-	CategoryPath      string                 `protobuf:"bytes,4,opt,name=category_path,json=categoryPath,proto3" json:"category_path,omitempty"`
-	Name              string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
-	Description       string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	FileKey           string                 `protobuf:"bytes,7,opt,name=file_key,json=fileKey,proto3" json:"file_key,omitempty"`
-	FileName          string                 `protobuf:"bytes,8,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
-	FileSize          int64                  `protobuf:"varint,9,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
-	MimeType          string                 `protobuf:"bytes,10,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
-	Checksum          string                 `protobuf:"bytes,11,opt,name=checksum,proto3" json:"checksum,omitempty"`
-	Status            DocumentStatus         `protobuf:"varint,12,opt,name=status,proto3,enum=paperless.service.v1.DocumentStatus" json:"status,omitempty"`
-	Source            DocumentSource         `protobuf:"varint,13,opt,name=source,proto3,enum=paperless.service.v1.DocumentSource" json:"source,omitempty"`
-	Tags              map[string]string      `protobuf:"bytes,14,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	CreateTime        *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	UpdateTime        *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
-	CreatedBy         *uint32                `protobuf:"varint,17,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
-	UpdatedBy         *uint32                `protobuf:"varint,18,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`
-	ContentText       string                 `protobuf:"bytes,19,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId     uint32                 `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	CategoryId   *string                `protobuf:"bytes,3,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	CategoryPath string                 `protobuf:"bytes,4,opt,name=category_path,json=categoryPath,proto3" json:"category_path,omitempty"`
+	Name         string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Description  string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	FileKey      string                 `protobuf:"bytes,7,opt,name=file_key,json=fileKey,proto3" json:"file_key,omitempty"`
+	FileName     string                 `protobuf:"bytes,8,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	FileSize     int64                  `protobuf:"varint,9,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	MimeType     string                 `protobuf:"bytes,10,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Checksum     string                 `protobuf:"bytes,11,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	Status       DocumentStatus         `protobuf:"varint,12,opt,name=status,proto3,enum=paperless.service.v1.DocumentStatus" json:"status,omitempty"`
+	Source       DocumentSource         `protobuf:"varint,13,opt,name=source,proto3,enum=paperless.service.v1.DocumentSource" json:"source,omitempty"`
+	Tags         map[string]string      `protobuf:"bytes,14,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreateTime   *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime   *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	CreatedBy    *uint32                `protobuf:"varint,17,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	UpdatedBy    *uint32                `protobuf:"varint,18,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`
+	// Text extracted from the file; only returned by GetDocument
+	ContentText string `protobuf:"bytes,19,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
+	// Metadata extracted from the file; only returned by GetDocument
 	ExtractedMetadata map[string]string      `protobuf:"bytes,20,rep,name=extracted_metadata,json=extractedMetadata,proto3" json:"extracted_metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	ProcessingStatus  string                 `protobuf:"bytes,21,opt,name=processing_status,json=processingStatus,proto3" json:"processing_status,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	StorageTier       StorageTier            `protobuf:"varint,22,opt,name=storage_tier,json=storageTier,proto3,enum=paperless.service.v1.StorageTier" json:"storage_tier,omitempty"`
+	LastAccessedAt    *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=last_accessed_at,json=lastAccessedAt,proto3,oneof" json:"last_accessed_at,omitempty"`
+	Version           uint32                 `protobuf:"varint,24,opt,name=version,proto3" json:"version,omitempty"`                                    // Incremented on every write
+	DocumentType      string                 `protobuf:"bytes,25,opt,name=document_type,json=documentType,proto3" json:"document_type,omitempty"`       // Kind of document, e.g. invoice or contract
+	RetentionClass    string                 `protobuf:"bytes,26,opt,name=retention_class,json=retentionClass,proto3" json:"retention_class,omitempty"` // Retention class the document is kept under
+	DueDate           *timestamppb.Timestamp `protobuf:"bytes,27,opt,name=due_date,json=dueDate,proto3,oneof" json:"due_date,omitempty"`                // Date the document is due, e.g. a contract's renewal date
+	AssigneeId        *uint32                `protobuf:"varint,28,opt,name=assignee_id,json=assigneeId,proto3,oneof" json:"assignee_id,omitempty"`      // User the document awaits action from
+	AssignedBy        *uint32                `protobuf:"varint,29,opt,name=assigned_by,json=assignedBy,proto3,oneof" json:"assigned_by,omitempty"`
+	AssignedAt        *timestamppb.Timestamp `protobuf:"bytes,30,opt,name=assigned_at,json=assignedAt,proto3,oneof" json:"assigned_at,omitempty"`
+	// content_text holds only the start of a text longer than the server's limit; only set by GetDocument
+	ContentTextTruncated bool `protobuf:"varint,31,opt,name=content_text_truncated,json=contentTextTruncated,proto3" json:"content_text_truncated,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *Document) Reset() {
@@ -334,6 +559,76 @@ func (x *Document) GetProcessingStatus() string {
 	return ""
 }
 
+func (x *Document) GetStorageTier() StorageTier {
+	if x != nil {
+		return x.StorageTier
+	}
+	return StorageTier_STORAGE_TIER_UNSPECIFIED
+}
+
+func (x *Document) GetLastAccessedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAccessedAt
+	}
+	return nil
+}
+
+func (x *Document) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Document) GetDocumentType() string {
+	if x != nil {
+		return x.DocumentType
+	}
+	return ""
+}
+
+func (x *Document) GetRetentionClass() string {
+	if x != nil {
+		return x.RetentionClass
+	}
+	return ""
+}
+
+func (x *Document) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *Document) GetAssigneeId() uint32 {
+	if x != nil && x.AssigneeId != nil {
+		return *x.AssigneeId
+	}
+	return 0
+}
+
+func (x *Document) GetAssignedBy() uint32 {
+	if x != nil && x.AssignedBy != nil {
+		return *x.AssignedBy
+	}
+	return 0
+}
+
+func (x *Document) GetAssignedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return nil
+}
+
+func (x *Document) GetContentTextTruncated() bool {
+	if x != nil {
+		return x.ContentTextTruncated
+	}
+	return false
+}
+
 // Request to create a document
 type CreateDocumentRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -592,8 +887,11 @@ type ListDocumentsRequest struct {
 	MimeTypeFilter *string `protobuf:"bytes,6,opt,name=mime_type_filter,json=mimeTypeFilter,proto3,oneof" json:"mime_type_filter,omitempty"`
 	// Include subcategories
 	IncludeSubcategories bool `protobuf:"varint,7,opt,name=include_subcategories,json=includeSubcategories,proto3" json:"include_subcategories,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// Read from the primary database instead of the read replica, e.g. to see a document
+	// created or changed just before
+	ConsistentRead bool `protobuf:"varint,8,opt,name=consistent_read,json=consistentRead,proto3" json:"consistent_read,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ListDocumentsRequest) Reset() {
@@ -675,6 +973,13 @@ func (x *ListDocumentsRequest) GetIncludeSubcategories() bool {
 	return false
 }
 
+func (x *ListDocumentsRequest) GetConsistentRead() bool {
+	if x != nil {
+		return x.ConsistentRead
+	}
+	return false
+}
+
 type ListDocumentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Documents     []*Document            `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents,omitempty"`
@@ -740,7 +1045,17 @@ type UpdateDocumentRequest struct {
 	// New tags (replaces existing)
 	Tags map[string]string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// Whether to update tags (if false, tags field is ignored)
-	UpdateTags    bool `protobuf:"varint,6,opt,name=update_tags,json=updateTags,proto3" json:"update_tags,omitempty"`
+	UpdateTags bool `protobuf:"varint,6,opt,name=update_tags,json=updateTags,proto3" json:"update_tags,omitempty"`
+	// Version the client last read; the update fails with VERSION_CONFLICT if the document changed since
+	ExpectedVersion *uint32 `protobuf:"varint,7,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	// New document type, empty to clear
+	DocumentType *string `protobuf:"bytes,8,opt,name=document_type,json=documentType,proto3,oneof" json:"document_type,omitempty"`
+	// New retention class, empty to clear
+	RetentionClass *string `protobuf:"bytes,9,opt,name=retention_class,json=retentionClass,proto3,oneof" json:"retention_class,omitempty"`
+	// New due date; a changed due date is reminded of again
+	DueDate *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	// Remove the due date (due_date is ignored)
+	ClearDueDate  bool `protobuf:"varint,11,opt,name=clear_due_date,json=clearDueDate,proto3" json:"clear_due_date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -817,6 +1132,41 @@ func (x *UpdateDocumentRequest) GetUpdateTags() bool {
 	return false
 }
 
+func (x *UpdateDocumentRequest) GetExpectedVersion() uint32 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *UpdateDocumentRequest) GetDocumentType() string {
+	if x != nil && x.DocumentType != nil {
+		return *x.DocumentType
+	}
+	return ""
+}
+
+func (x *UpdateDocumentRequest) GetRetentionClass() string {
+	if x != nil && x.RetentionClass != nil {
+		return *x.RetentionClass
+	}
+	return ""
+}
+
+func (x *UpdateDocumentRequest) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *UpdateDocumentRequest) GetClearDueDate() bool {
+	if x != nil {
+		return x.ClearDueDate
+	}
+	return false
+}
+
 type UpdateDocumentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Document      *Document              `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
@@ -921,8 +1271,10 @@ type MoveDocumentRequest struct {
 	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	// New category ID (null to move to root)
 	NewCategoryId *string `protobuf:"bytes,2,opt,name=new_category_id,json=newCategoryId,proto3,oneof" json:"new_category_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Version the client last read; the move fails with VERSION_CONFLICT if the document changed since
+	ExpectedVersion *uint32 `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *MoveDocumentRequest) Reset() {
@@ -969,6 +1321,13 @@ func (x *MoveDocumentRequest) GetNewCategoryId() string {
 	return ""
 }
 
+func (x *MoveDocumentRequest) GetExpectedVersion() uint32 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
 type MoveDocumentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Document      *Document              `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
@@ -1015,8 +1374,10 @@ func (x *MoveDocumentResponse) GetDocument() *Document {
 
 // Request to download document content
 type DownloadDocumentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// DOWNLOAD_FORMAT_PDF converts office documents and images to PDF (default original)
+	Format        *DownloadFormat `protobuf:"varint,2,opt,name=format,proto3,enum=paperless.service.v1.DownloadFormat,oneof" json:"format,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1058,11 +1419,18 @@ func (x *DownloadDocumentRequest) GetId() string {
 	return ""
 }
 
+func (x *DownloadDocumentRequest) GetFormat() DownloadFormat {
+	if x != nil && x.Format != nil {
+		return *x.Format
+	}
+	return DownloadFormat_DOWNLOAD_FORMAT_UNSPECIFIED
+}
+
 type DownloadDocumentResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// File content
 	Content []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	// Original file name
+	// File name, with a .pdf extension for PDF renditions
 	FileName string `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
 	// MIME type
 	MimeType string `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
@@ -1135,7 +1503,11 @@ type GetDocumentDownloadUrlRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	// URL expiration in seconds (default 3600)
-	ExpiresIn     *int32 `protobuf:"varint,2,opt,name=expires_in,json=expiresIn,proto3,oneof" json:"expires_in,omitempty"`
+	ExpiresIn *int32 `protobuf:"varint,2,opt,name=expires_in,json=expiresIn,proto3,oneof" json:"expires_in,omitempty"`
+	// Whether browsers should display the file or save it (default attachment)
+	Disposition *ContentDisposition `protobuf:"varint,3,opt,name=disposition,proto3,enum=paperless.service.v1.ContentDisposition,oneof" json:"disposition,omitempty"`
+	// File name offered to the browser (default: the document's original file name)
+	FileName      *string `protobuf:"bytes,4,opt,name=file_name,json=fileName,proto3,oneof" json:"file_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1184,6 +1556,20 @@ func (x *GetDocumentDownloadUrlRequest) GetExpiresIn() int32 {
 	return 0
 }
 
+func (x *GetDocumentDownloadUrlRequest) GetDisposition() ContentDisposition {
+	if x != nil && x.Disposition != nil {
+		return *x.Disposition
+	}
+	return ContentDisposition_CONTENT_DISPOSITION_UNSPECIFIED
+}
+
+func (x *GetDocumentDownloadUrlRequest) GetFileName() string {
+	if x != nil && x.FileName != nil {
+		return *x.FileName
+	}
+	return ""
+}
+
 type GetDocumentDownloadUrlResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
@@ -1236,42 +1622,29 @@ func (x *GetDocumentDownloadUrlResponse) GetExpiresAt() *timestamppb.Timestamp {
 	return nil
 }
 
-// Request to search documents
-type SearchDocumentsRequest struct {
+type GetDocumentPreviewUrlRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Search query (searches name, description, file_name)
-	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	// Limit search to category and subcategories (null for all)
-	CategoryId *string `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
-	// Include subcategories in search
-	IncludeSubcategories bool `protobuf:"varint,3,opt,name=include_subcategories,json=includeSubcategories,proto3" json:"include_subcategories,omitempty"`
-	// Pagination
-	Page     *uint32 `protobuf:"varint,4,opt,name=page,proto3,oneof" json:"page,omitempty"`
-	PageSize *uint32 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
-	// Filter by status
-	Status *DocumentStatus `protobuf:"varint,6,opt,name=status,proto3,enum=paperless.service.v1.DocumentStatus,oneof" json:"status,omitempty"`
-	// Filter by MIME type
-	MimeTypeFilter *string `protobuf:"bytes,7,opt,name=mime_type_filter,json=mimeTypeFilter,proto3,oneof" json:"mime_type_filter,omitempty"`
-	// Filter by tags (all tags must match)
-	Tags          map[string]string `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// URL expiration in seconds (default 3600)
+	ExpiresIn     *int32 `protobuf:"varint,2,opt,name=expires_in,json=expiresIn,proto3,oneof" json:"expires_in,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchDocumentsRequest) Reset() {
-	*x = SearchDocumentsRequest{}
+func (x *GetDocumentPreviewUrlRequest) Reset() {
+	*x = GetDocumentPreviewUrlRequest{}
 	mi := &file_paperless_service_v1_document_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchDocumentsRequest) String() string {
+func (x *GetDocumentPreviewUrlRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchDocumentsRequest) ProtoMessage() {}
+func (*GetDocumentPreviewUrlRequest) ProtoMessage() {}
 
-func (x *SearchDocumentsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetDocumentPreviewUrlRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_paperless_service_v1_document_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1283,68 +1656,998 @@ func (x *SearchDocumentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchDocumentsRequest.ProtoReflect.Descriptor instead.
-func (*SearchDocumentsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDocumentPreviewUrlRequest.ProtoReflect.Descriptor instead.
+func (*GetDocumentPreviewUrlRequest) Descriptor() ([]byte, []int) {
 	return file_paperless_service_v1_document_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *SearchDocumentsRequest) GetQuery() string {
+func (x *GetDocumentPreviewUrlRequest) GetId() string {
 	if x != nil {
-		return x.Query
+		return x.Id
 	}
 	return ""
 }
 
-func (x *SearchDocumentsRequest) GetCategoryId() string {
-	if x != nil && x.CategoryId != nil {
-		return *x.CategoryId
+func (x *GetDocumentPreviewUrlRequest) GetExpiresIn() int32 {
+	if x != nil && x.ExpiresIn != nil {
+		return *x.ExpiresIn
 	}
-	return ""
+	return 0
 }
 
-func (x *SearchDocumentsRequest) GetIncludeSubcategories() bool {
-	if x != nil {
-		return x.IncludeSubcategories
-	}
-	return false
+type GetDocumentPreviewUrlResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// URL of the PDF, displayed inline by browsers
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchDocumentsRequest) GetPage() uint32 {
+func (x *GetDocumentPreviewUrlResponse) Reset() {
+	*x = GetDocumentPreviewUrlResponse{}
+	mi := &file_paperless_service_v1_document_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDocumentPreviewUrlResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDocumentPreviewUrlResponse) ProtoMessage() {}
+
+func (x *GetDocumentPreviewUrlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_document_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDocumentPreviewUrlResponse.ProtoReflect.Descriptor instead.
+func (*GetDocumentPreviewUrlResponse) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_document_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetDocumentPreviewUrlResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *GetDocumentPreviewUrlResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// Request to search documents
+type SearchDocumentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Search query (searches name, description, file_name)
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Limit search to category and subcategories (null for all)
+	CategoryId *string `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	// Include subcategories in search
+	IncludeSubcategories bool `protobuf:"varint,3,opt,name=include_subcategories,json=includeSubcategories,proto3" json:"include_subcategories,omitempty"`
+	// Pagination
+	Page     *uint32 `protobuf:"varint,4,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize *uint32 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	// Filter by status
+	Status *DocumentStatus `protobuf:"varint,6,opt,name=status,proto3,enum=paperless.service.v1.DocumentStatus,oneof" json:"status,omitempty"`
+	// Filter by MIME type
+	MimeTypeFilter *string `protobuf:"bytes,7,opt,name=mime_type_filter,json=mimeTypeFilter,proto3,oneof" json:"mime_type_filter,omitempty"`
+	// Filter by tags (all tags must match)
+	Tags map[string]string `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Read from the primary database instead of the read replica, e.g. to see a document
+	// created or changed just before
+	ConsistentRead bool `protobuf:"varint,9,opt,name=consistent_read,json=consistentRead,proto3" json:"consistent_read,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SearchDocumentsRequest) Reset() {
+	*x = SearchDocumentsRequest{}
+	mi := &file_paperless_service_v1_document_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchDocumentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchDocumentsRequest) ProtoMessage() {}
+
+func (x *SearchDocumentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_document_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchDocumentsRequest.ProtoReflect.Descriptor instead.
+func (*SearchDocumentsRequest) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_document_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SearchDocumentsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchDocumentsRequest) GetCategoryId() string {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return ""
+}
+
+func (x *SearchDocumentsRequest) GetIncludeSubcategories() bool {
+	if x != nil {
+		return x.IncludeSubcategories
+	}
+	return false
+}
+
+func (x *SearchDocumentsRequest) GetPage() uint32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *SearchDocumentsRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchDocumentsRequest) GetStatus() DocumentStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return DocumentStatus_DOCUMENT_STATUS_UNSPECIFIED
+}
+
+func (x *SearchDocumentsRequest) GetMimeTypeFilter() string {
+	if x != nil && x.MimeTypeFilter != nil {
+		return *x.MimeTypeFilter
+	}
+	return ""
+}
+
+func (x *SearchDocumentsRequest) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SearchDocumentsRequest) GetConsistentRead() bool {
+	if x != nil {
+		return x.ConsistentRead
+	}
+	return false
+}
+
+type SearchDocumentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Documents     []*Document            `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents,omitempty"`
+	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchDocumentsResponse) Reset() {
+	*x = SearchDocumentsResponse{}
+	mi := &file_paperless_service_v1_document_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchDocumentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchDocumentsResponse) ProtoMessage() {}
+
+func (x *SearchDocumentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_document_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchDocumentsResponse.ProtoReflect.Descriptor instead.
+func (*SearchDocumentsResponse) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_document_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SearchDocumentsResponse) GetDocuments() []*Document {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+func (x *SearchDocumentsResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Request to batch delete documents
+type BatchDeleteDocumentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Ids   []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// Permanently delete (skip soft-delete and remove from storage)
+	Permanent     bool `protobuf:"varint,2,opt,name=permanent,proto3" json:"permanent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteDocumentsRequest) Reset() {
+	*x = BatchDeleteDocumentsRequest{}
+	mi := &file_paperless_service_v1_document_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteDocumentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteDocumentsRequest) ProtoMessage() {}
+
+func (x *BatchDeleteDocumentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_document_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteDocumentsRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteDocumentsRequest) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_document_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BatchDeleteDocumentsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BatchDeleteDocumentsRequest) GetPermanent() bool {
+	if x != nil {
+		return x.Permanent
+	}
+	return false
+}
+
+type BatchDeleteDocumentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of documents successfully deleted
+	DeletedCount uint32 `protobuf:"varint,1,opt,name=deleted_count,json=deletedCount,proto3" json:"deleted_count,omitempty"`
+	// IDs that failed to delete
+	FailedIds []string `protobuf:"bytes,2,rep,name=failed_ids,json=failedIds,proto3" json:"failed_ids,omitempty"`
+	// IDs of deleted documents whose files or permissions could not be removed; leftover files
+	// are removed by the storage garbage collector
+	CleanupFailedIds []string `protobuf:"bytes,3,rep,name=cleanup_failed_ids,json=cleanupFailedIds,proto3" json:"cleanup_failed_ids,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BatchDeleteDocumentsResponse) Reset() {
+	*x = BatchDeleteDocumentsResponse{}
+	mi := &file_paperless_service_v1_document_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteDocumentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteDocumentsResponse) ProtoMessage() {}
+
+func (x *BatchDeleteDocumentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_document_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteDocumentsResponse.ProtoReflect.Descriptor instead.
+func (*BatchDeleteDocumentsResponse) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_document_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BatchDeleteDocumentsResponse) GetDeletedCount() uint32 {
+	if x != nil {
+		return x.DeletedCount
+	}
+	return 0
+}
+
+func (x *BatchDeleteDocumentsResponse) GetFailedIds() []string {
+	if x != nil {
+		return x.FailedIds
+	}
+	return nil
+}
+
+func (x *BatchDeleteDocumentsResponse) GetCleanupFailedIds() []string {
+	if x != nil {
+		return x.CleanupFailedIds
+	}
+	return nil
+}
+
+// Metadata of a document at one point in time
+type DocumentSnapshot struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	CategoryId     *string                `protobuf:"bytes,3,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Status         DocumentStatus         `protobuf:"varint,4,opt,name=status,proto3,enum=paperless.service.v1.DocumentStatus" json:"status,omitempty"`
+	Tags           map[string]string      `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	DocumentType   string                 `protobuf:"bytes,6,opt,name=document_type,json=documentType,proto3" json:"document_type,omitempty"`
+	RetentionClass string                 `protobuf:"bytes,7,opt,name=retention_class,json=retentionClass,proto3" json:"retention_class,omitempty"`
+	DueDate        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=due_date,json=dueDate,proto3,oneof" json:"due_date,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DocumentSnapshot) Reset() {
+	*x = DocumentSnapshot{}
+	mi := &file_paperless_service_v1_document_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DocumentSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentSnapshot) ProtoMessage() {}
+
+func (x *DocumentSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_document_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocumentSnapshot.ProtoReflect.Descriptor instead.
+func (*DocumentSnapshot) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_document_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DocumentSnapshot) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DocumentSnapshot) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DocumentSnapshot) GetCategoryId() string {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return ""
+}
+
+func (x *DocumentSnapshot) GetStatus() DocumentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DocumentStatus_DOCUMENT_STATUS_UNSPECIFIED
+}
+
+func (x *DocumentSnapshot) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *DocumentSnapshot) GetDocumentType() string {
+	if x != nil {
+		return x.DocumentType
+	}
+	return ""
+}
+
+func (x *DocumentSnapshot) GetRetentionClass() string {
+	if x != nil {
+		return x.RetentionClass
+	}
+	return ""
+}
+
+func (x *DocumentSnapshot) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+// One change of a document's metadata
+type DocumentHistoryEntry struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DocumentId string                 `protobuf:"bytes,2,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	// User who made the change, empty for system changes
+	UserId string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Version of the document after the change
+	Version uint32 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	// Changed fields: name, description, category_id, status, tags, document_type, retention_class or due_date
+	ChangedFields []string               `protobuf:"bytes,5,rep,name=changed_fields,json=changedFields,proto3" json:"changed_fields,omitempty"`
+	Before        *DocumentSnapshot      `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
+	After         *DocumentSnapshot      `protobuf:"bytes,7,opt,name=after,proto3" json:"after,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DocumentHistoryEntry) Reset() {
+	*x = DocumentHistoryEntry{}
+	mi := &file_paperless_service_v1_document_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DocumentHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentHistoryEntry) ProtoMessage() {}
+
+func (x *DocumentHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_document_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocumentHistoryEntry.ProtoReflect.Descriptor instead.
+func (*DocumentHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_document_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DocumentHistoryEntry) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DocumentHistoryEntry) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *DocumentHistoryEntry) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DocumentHistoryEntry) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *DocumentHistoryEntry) GetChangedFields() []string {
+	if x != nil {
+		return x.ChangedFields
+	}
+	return nil
+}
+
+func (x *DocumentHistoryEntry) GetBefore() *DocumentSnapshot {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *DocumentHistoryEntry) GetAfter() *DocumentSnapshot {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *DocumentHistoryEntry) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+// Request to get the change history of a document
+type GetDocumentHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Pagination
+	Page          *uint32 `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize      *uint32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDocumentHistoryRequest) Reset() {
+	*x = GetDocumentHistoryRequest{}
+	mi := &file_paperless_service_v1_document_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDocumentHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDocumentHistoryRequest) ProtoMessage() {}
+
+func (x *GetDocumentHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_document_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDocumentHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetDocumentHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_document_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetDocumentHistoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetDocumentHistoryRequest) GetPage() uint32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *GetDocumentHistoryRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+type GetDocumentHistoryResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Entries       []*DocumentHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Total         uint32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDocumentHistoryResponse) Reset() {
+	*x = GetDocumentHistoryResponse{}
+	mi := &file_paperless_service_v1_document_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDocumentHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDocumentHistoryResponse) ProtoMessage() {}
+
+func (x *GetDocumentHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_document_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDocumentHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetDocumentHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_document_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetDocumentHistoryResponse) GetEntries() []*DocumentHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetDocumentHistoryResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Request to list documents due soon
+type ListDocumentsDueSoonRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Days ahead to look (default 30)
+	WithinDays *uint32 `protobuf:"varint,1,opt,name=within_days,json=withinDays,proto3,oneof" json:"within_days,omitempty"`
+	// Include documents whose due date has passed
+	IncludeOverdue bool `protobuf:"varint,2,opt,name=include_overdue,json=includeOverdue,proto3" json:"include_overdue,omitempty"`
+	// Only documents in this category (null for all)
+	CategoryId *string `protobuf:"bytes,3,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	// Include subcategories of category_id
+	IncludeSubcategories bool `protobuf:"varint,4,opt,name=include_subcategories,json=includeSubcategories,proto3" json:"include_subcategories,omitempty"`
+	// Pagination
+	Page          *uint32 `protobuf:"varint,5,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize      *uint32 `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDocumentsDueSoonRequest) Reset() {
+	*x = ListDocumentsDueSoonRequest{}
+	mi := &file_paperless_service_v1_document_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDocumentsDueSoonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDocumentsDueSoonRequest) ProtoMessage() {}
+
+func (x *ListDocumentsDueSoonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_document_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDocumentsDueSoonRequest.ProtoReflect.Descriptor instead.
+func (*ListDocumentsDueSoonRequest) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_document_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListDocumentsDueSoonRequest) GetWithinDays() uint32 {
+	if x != nil && x.WithinDays != nil {
+		return *x.WithinDays
+	}
+	return 0
+}
+
+func (x *ListDocumentsDueSoonRequest) GetIncludeOverdue() bool {
+	if x != nil {
+		return x.IncludeOverdue
+	}
+	return false
+}
+
+func (x *ListDocumentsDueSoonRequest) GetCategoryId() string {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return ""
+}
+
+func (x *ListDocumentsDueSoonRequest) GetIncludeSubcategories() bool {
+	if x != nil {
+		return x.IncludeSubcategories
+	}
+	return false
+}
+
+func (x *ListDocumentsDueSoonRequest) GetPage() uint32 {
 	if x != nil && x.Page != nil {
 		return *x.Page
 	}
 	return 0
 }
 
-func (x *SearchDocumentsRequest) GetPageSize() uint32 {
+func (x *ListDocumentsDueSoonRequest) GetPageSize() uint32 {
 	if x != nil && x.PageSize != nil {
 		return *x.PageSize
 	}
 	return 0
 }
 
-func (x *SearchDocumentsRequest) GetStatus() DocumentStatus {
-	if x != nil && x.Status != nil {
-		return *x.Status
+type ListDocumentsDueSoonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Documents     []*Document            `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents,omitempty"`
+	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDocumentsDueSoonResponse) Reset() {
+	*x = ListDocumentsDueSoonResponse{}
+	mi := &file_paperless_service_v1_document_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDocumentsDueSoonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDocumentsDueSoonResponse) ProtoMessage() {}
+
+func (x *ListDocumentsDueSoonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_document_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return DocumentStatus_DOCUMENT_STATUS_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *SearchDocumentsRequest) GetMimeTypeFilter() string {
-	if x != nil && x.MimeTypeFilter != nil {
-		return *x.MimeTypeFilter
+// Deprecated: Use ListDocumentsDueSoonResponse.ProtoReflect.Descriptor instead.
+func (*ListDocumentsDueSoonResponse) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_document_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListDocumentsDueSoonResponse) GetDocuments() []*Document {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+func (x *ListDocumentsDueSoonResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Request to assign a document
+type AssignDocumentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// User to assign the document to (null to unassign); the user must be able to read it
+	AssigneeId *uint32 `protobuf:"varint,2,opt,name=assignee_id,json=assigneeId,proto3,oneof" json:"assignee_id,omitempty"`
+	// Note for the assignee, sent with the notification
+	Note string `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	// Version the client last read; the assignment fails with VERSION_CONFLICT if the document changed since
+	ExpectedVersion *uint32 `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AssignDocumentRequest) Reset() {
+	*x = AssignDocumentRequest{}
+	mi := &file_paperless_service_v1_document_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignDocumentRequest) ProtoMessage() {}
+
+func (x *AssignDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_document_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignDocumentRequest.ProtoReflect.Descriptor instead.
+func (*AssignDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_document_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AssignDocumentRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *SearchDocumentsRequest) GetTags() map[string]string {
+func (x *AssignDocumentRequest) GetAssigneeId() uint32 {
+	if x != nil && x.AssigneeId != nil {
+		return *x.AssigneeId
+	}
+	return 0
+}
+
+func (x *AssignDocumentRequest) GetNote() string {
 	if x != nil {
-		return x.Tags
+		return x.Note
+	}
+	return ""
+}
+
+func (x *AssignDocumentRequest) GetExpectedVersion() uint32 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+type AssignDocumentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Document      *Document              `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignDocumentResponse) Reset() {
+	*x = AssignDocumentResponse{}
+	mi := &file_paperless_service_v1_document_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignDocumentResponse) ProtoMessage() {}
+
+func (x *AssignDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_document_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignDocumentResponse.ProtoReflect.Descriptor instead.
+func (*AssignDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_document_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AssignDocumentResponse) GetDocument() *Document {
+	if x != nil {
+		return x.Document
 	}
 	return nil
 }
 
-type SearchDocumentsResponse struct {
+// Request to list the caller's inbox
+type ListMyInboxRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Pagination
+	Page          *uint32 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize      *uint32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyInboxRequest) Reset() {
+	*x = ListMyInboxRequest{}
+	mi := &file_paperless_service_v1_document_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyInboxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyInboxRequest) ProtoMessage() {}
+
+func (x *ListMyInboxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_document_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyInboxRequest.ProtoReflect.Descriptor instead.
+func (*ListMyInboxRequest) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_document_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListMyInboxRequest) GetPage() uint32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListMyInboxRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+type ListMyInboxResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Documents     []*Document            `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents,omitempty"`
 	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
@@ -1352,21 +2655,21 @@ type SearchDocumentsResponse struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchDocumentsResponse) Reset() {
-	*x = SearchDocumentsResponse{}
-	mi := &file_paperless_service_v1_document_proto_msgTypes[17]
+func (x *ListMyInboxResponse) Reset() {
+	*x = ListMyInboxResponse{}
+	mi := &file_paperless_service_v1_document_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchDocumentsResponse) String() string {
+func (x *ListMyInboxResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchDocumentsResponse) ProtoMessage() {}
+func (*ListMyInboxResponse) ProtoMessage() {}
 
-func (x *SearchDocumentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_paperless_service_v1_document_proto_msgTypes[17]
+func (x *ListMyInboxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_document_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1377,50 +2680,53 @@ func (x *SearchDocumentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchDocumentsResponse.ProtoReflect.Descriptor instead.
-func (*SearchDocumentsResponse) Descriptor() ([]byte, []int) {
-	return file_paperless_service_v1_document_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ListMyInboxResponse.ProtoReflect.Descriptor instead.
+func (*ListMyInboxResponse) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_document_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *SearchDocumentsResponse) GetDocuments() []*Document {
+func (x *ListMyInboxResponse) GetDocuments() []*Document {
 	if x != nil {
 		return x.Documents
 	}
 	return nil
 }
 
-func (x *SearchDocumentsResponse) GetTotal() uint32 {
+func (x *ListMyInboxResponse) GetTotal() uint32 {
 	if x != nil {
 		return x.Total
 	}
 	return 0
 }
 
-// Request to batch delete documents
-type BatchDeleteDocumentsRequest struct {
+// Request to watch document changes
+type WatchDocumentsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	Ids   []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
-	// Permanently delete (skip soft-delete and remove from storage)
-	Permanent     bool `protobuf:"varint,2,opt,name=permanent,proto3" json:"permanent,omitempty"`
+	// Only documents in this category (null for all)
+	CategoryId *string `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	// Also documents in subcategories of category_id
+	IncludeSubcategories bool `protobuf:"varint,2,opt,name=include_subcategories,json=includeSubcategories,proto3" json:"include_subcategories,omitempty"`
+	// Only documents with all of these tags
+	Tags          map[string]string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BatchDeleteDocumentsRequest) Reset() {
-	*x = BatchDeleteDocumentsRequest{}
-	mi := &file_paperless_service_v1_document_proto_msgTypes[18]
+func (x *WatchDocumentsRequest) Reset() {
+	*x = WatchDocumentsRequest{}
+	mi := &file_paperless_service_v1_document_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BatchDeleteDocumentsRequest) String() string {
+func (x *WatchDocumentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchDeleteDocumentsRequest) ProtoMessage() {}
+func (*WatchDocumentsRequest) ProtoMessage() {}
 
-func (x *BatchDeleteDocumentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_paperless_service_v1_document_proto_msgTypes[18]
+func (x *WatchDocumentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_document_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1431,50 +2737,61 @@ func (x *BatchDeleteDocumentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchDeleteDocumentsRequest.ProtoReflect.Descriptor instead.
-func (*BatchDeleteDocumentsRequest) Descriptor() ([]byte, []int) {
-	return file_paperless_service_v1_document_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use WatchDocumentsRequest.ProtoReflect.Descriptor instead.
+func (*WatchDocumentsRequest) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_document_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *BatchDeleteDocumentsRequest) GetIds() []string {
-	if x != nil {
-		return x.Ids
+func (x *WatchDocumentsRequest) GetCategoryId() string {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
 	}
-	return nil
+	return ""
 }
 
-func (x *BatchDeleteDocumentsRequest) GetPermanent() bool {
+func (x *WatchDocumentsRequest) GetIncludeSubcategories() bool {
 	if x != nil {
-		return x.Permanent
+		return x.IncludeSubcategories
 	}
 	return false
 }
 
-type BatchDeleteDocumentsResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Number of documents successfully deleted
-	DeletedCount uint32 `protobuf:"varint,1,opt,name=deleted_count,json=deletedCount,proto3" json:"deleted_count,omitempty"`
-	// IDs that failed to delete
-	FailedIds     []string `protobuf:"bytes,2,rep,name=failed_ids,json=failedIds,proto3" json:"failed_ids,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *WatchDocumentsRequest) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
 }
 
-func (x *BatchDeleteDocumentsResponse) Reset() {
-	*x = BatchDeleteDocumentsResponse{}
-	mi := &file_paperless_service_v1_document_proto_msgTypes[19]
+// A change to a document
+type DocumentChange struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Type       DocumentChangeType     `protobuf:"varint,1,opt,name=type,proto3,enum=paperless.service.v1.DocumentChangeType" json:"type,omitempty"`
+	DocumentId string                 `protobuf:"bytes,2,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	// The document after the change; not set for deletions
+	Document *Document `protobuf:"bytes,3,opt,name=document,proto3" json:"document,omitempty"`
+	// Category a moved document came from
+	PreviousCategoryId *string                `protobuf:"bytes,4,opt,name=previous_category_id,json=previousCategoryId,proto3,oneof" json:"previous_category_id,omitempty"`
+	OccurTime          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=occur_time,json=occurTime,proto3" json:"occur_time,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DocumentChange) Reset() {
+	*x = DocumentChange{}
+	mi := &file_paperless_service_v1_document_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BatchDeleteDocumentsResponse) String() string {
+func (x *DocumentChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchDeleteDocumentsResponse) ProtoMessage() {}
+func (*DocumentChange) ProtoMessage() {}
 
-func (x *BatchDeleteDocumentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_paperless_service_v1_document_proto_msgTypes[19]
+func (x *DocumentChange) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_document_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1485,21 +2802,42 @@ func (x *BatchDeleteDocumentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchDeleteDocumentsResponse.ProtoReflect.Descriptor instead.
-func (*BatchDeleteDocumentsResponse) Descriptor() ([]byte, []int) {
-	return file_paperless_service_v1_document_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use DocumentChange.ProtoReflect.Descriptor instead.
+func (*DocumentChange) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_document_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *BatchDeleteDocumentsResponse) GetDeletedCount() uint32 {
+func (x *DocumentChange) GetType() DocumentChangeType {
 	if x != nil {
-		return x.DeletedCount
+		return x.Type
 	}
-	return 0
+	return DocumentChangeType_DOCUMENT_CHANGE_TYPE_UNSPECIFIED
 }
 
-func (x *BatchDeleteDocumentsResponse) GetFailedIds() []string {
+func (x *DocumentChange) GetDocumentId() string {
 	if x != nil {
-		return x.FailedIds
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *DocumentChange) GetDocument() *Document {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *DocumentChange) GetPreviousCategoryId() string {
+	if x != nil && x.PreviousCategoryId != nil {
+		return *x.PreviousCategoryId
+	}
+	return ""
+}
+
+func (x *DocumentChange) GetOccurTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurTime
 	}
 	return nil
 }
@@ -1508,7 +2846,7 @@ var File_paperless_service_v1_document_proto protoreflect.FileDescriptor
 
 const file_paperless_service_v1_document_proto_rawDesc = "" +
 	"\n" +
-	"#paperless/service/v1/document.proto\x12\x14paperless.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16redact/v3/redact.proto\"\xb8\b\n" +
+	"#paperless/service/v1/document.proto\x12\x14paperless.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16redact/v3/redact.proto\"\x83\r\n" +
 	"\bDocument\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\ttenant_id\x18\x02 \x01(\rR\btenantId\x12$\n" +
@@ -1536,7 +2874,20 @@ const file_paperless_service_v1_document_proto_rawDesc = "" +
 	"updated_by\x18\x12 \x01(\rH\x02R\tupdatedBy\x88\x01\x01\x12)\n" +
 	"\fcontent_text\x18\x13 \x01(\tB\x06ڶ\x1a\x02z\x00R\vcontentText\x12o\n" +
 	"\x12extracted_metadata\x18\x14 \x03(\v25.paperless.service.v1.Document.ExtractedMetadataEntryB\tڶ\x1a\x05\xa2\x01\x02\b\x01R\x11extractedMetadata\x12+\n" +
-	"\x11processing_status\x18\x15 \x01(\tR\x10processingStatus\x1a7\n" +
+	"\x11processing_status\x18\x15 \x01(\tR\x10processingStatus\x12D\n" +
+	"\fstorage_tier\x18\x16 \x01(\x0e2!.paperless.service.v1.StorageTierR\vstorageTier\x12I\n" +
+	"\x10last_accessed_at\x18\x17 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\x0elastAccessedAt\x88\x01\x01\x12\x18\n" +
+	"\aversion\x18\x18 \x01(\rR\aversion\x12#\n" +
+	"\rdocument_type\x18\x19 \x01(\tR\fdocumentType\x12'\n" +
+	"\x0fretention_class\x18\x1a \x01(\tR\x0eretentionClass\x12:\n" +
+	"\bdue_date\x18\x1b \x01(\v2\x1a.google.protobuf.TimestampH\x04R\adueDate\x88\x01\x01\x12$\n" +
+	"\vassignee_id\x18\x1c \x01(\rH\x05R\n" +
+	"assigneeId\x88\x01\x01\x12$\n" +
+	"\vassigned_by\x18\x1d \x01(\rH\x06R\n" +
+	"assignedBy\x88\x01\x01\x12@\n" +
+	"\vassigned_at\x18\x1e \x01(\v2\x1a.google.protobuf.TimestampH\aR\n" +
+	"assignedAt\x88\x01\x01\x124\n" +
+	"\x16content_text_truncated\x18\x1f \x01(\bR\x14contentTextTruncated\x1a7\n" +
 	"\tTagsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aD\n" +
@@ -1545,37 +2896,43 @@ const file_paperless_service_v1_document_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0e\n" +
 	"\f_category_idB\r\n" +
 	"\v_created_byB\r\n" +
-	"\v_updated_by\"\x87\x04\n" +
-	"\x15CreateDocumentRequest\x12?\n" +
-	"\vcategory_id\x18\x01 \x01(\tB\x19\xbaH\x16r\x14\x18$2\x10^[a-fA-F0-9\\-]*$H\x00R\n" +
+	"\v_updated_byB\x13\n" +
+	"\x11_last_accessed_atB\v\n" +
+	"\t_due_dateB\x0e\n" +
+	"\f_assignee_idB\x0e\n" +
+	"\f_assigned_byB\x0e\n" +
+	"\f_assigned_at\"\x90\x05\n" +
+	"\x15CreateDocumentRequest\x12\xa3\x01\n" +
+	"\vcategory_id\x18\x01 \x01(\tB}\xbaHzrx\x18$2t^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})?$H\x00R\n" +
 	"categoryId\x88\x01\x01\x12!\n" +
 	"\x04name\x18\x02 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12*\n" +
 	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80 R\vdescription\x12*\n" +
 	"\tfile_name\x18\x04 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\xff\x01R\bfileName\x129\n" +
 	"\ffile_content\x18\x05 \x01(\fB\x16\xe0A\x02ڶ\x1a\x0f\x82\x01\fFILE CONTENTR\vfileContent\x12%\n" +
-	"\tmime_type\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\bmimeType\x12I\n" +
-	"\x04tags\x18\a \x03(\v25.paperless.service.v1.CreateDocumentRequest.TagsEntryR\x04tags\x12<\n" +
-	"\x06source\x18\b \x01(\x0e2$.paperless.service.v1.DocumentSourceR\x06source\x1a7\n" +
+	"\tmime_type\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\bmimeType\x12c\n" +
+	"\x04tags\x18\a \x03(\v25.paperless.service.v1.CreateDocumentRequest.TagsEntryB\x18\xbaH\x15\x9a\x01\x12\x10d\"\ar\x05\x10\x01\x18\xff\x01*\x05r\x03\x18\xff\x01R\x04tags\x12F\n" +
+	"\x06source\x18\b \x01(\x0e2$.paperless.service.v1.DocumentSourceB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06source\x1a7\n" +
 	"\tTagsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0e\n" +
 	"\f_category_id\"T\n" +
 	"\x16CreateDocumentResponse\x12:\n" +
-	"\bdocument\x18\x01 \x01(\v2\x1e.paperless.service.v1.DocumentR\bdocument\"D\n" +
-	"\x12GetDocumentRequest\x12.\n" +
-	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\"Q\n" +
+	"\bdocument\x18\x01 \x01(\v2\x1e.paperless.service.v1.DocumentR\bdocument\"\xa9\x01\n" +
+	"\x12GetDocumentRequest\x12\x92\x01\n" +
+	"\x02id\x18\x01 \x01(\tB\x81\x01\xe0A\x02\xbaH{ry\x10\x01\x18$2s^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})$R\x02id\"Q\n" +
 	"\x13GetDocumentResponse\x12:\n" +
-	"\bdocument\x18\x01 \x01(\v2\x1e.paperless.service.v1.DocumentR\bdocument\"\xb6\x03\n" +
-	"\x14ListDocumentsRequest\x12?\n" +
-	"\vcategory_id\x18\x01 \x01(\tB\x19\xbaH\x16r\x14\x18$2\x10^[a-fA-F0-9\\-]*$H\x00R\n" +
+	"\bdocument\x18\x01 \x01(\v2\x1e.paperless.service.v1.DocumentR\bdocument\"\xec\x04\n" +
+	"\x14ListDocumentsRequest\x12\xa3\x01\n" +
+	"\vcategory_id\x18\x01 \x01(\tB}\xbaHzrx\x18$2t^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})?$H\x00R\n" +
 	"categoryId\x88\x01\x01\x12\x17\n" +
-	"\x04page\x18\x02 \x01(\rH\x01R\x04page\x88\x01\x01\x12 \n" +
-	"\tpage_size\x18\x03 \x01(\rH\x02R\bpageSize\x88\x01\x01\x12A\n" +
-	"\x06status\x18\x04 \x01(\x0e2$.paperless.service.v1.DocumentStatusH\x03R\x06status\x88\x01\x01\x12$\n" +
-	"\vname_filter\x18\x05 \x01(\tH\x04R\n" +
-	"nameFilter\x88\x01\x01\x12-\n" +
-	"\x10mime_type_filter\x18\x06 \x01(\tH\x05R\x0emimeTypeFilter\x88\x01\x01\x123\n" +
-	"\x15include_subcategories\x18\a \x01(\bR\x14includeSubcategoriesB\x0e\n" +
+	"\x04page\x18\x02 \x01(\rH\x01R\x04page\x88\x01\x01\x12*\n" +
+	"\tpage_size\x18\x03 \x01(\rB\b\xbaH\x05*\x03\x18\xe8\aH\x02R\bpageSize\x88\x01\x01\x12K\n" +
+	"\x06status\x18\x04 \x01(\x0e2$.paperless.service.v1.DocumentStatusB\b\xbaH\x05\x82\x01\x02\x10\x01H\x03R\x06status\x88\x01\x01\x12.\n" +
+	"\vname_filter\x18\x05 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x04R\n" +
+	"nameFilter\x88\x01\x01\x127\n" +
+	"\x10mime_type_filter\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x05R\x0emimeTypeFilter\x88\x01\x01\x123\n" +
+	"\x15include_subcategories\x18\a \x01(\bR\x14includeSubcategories\x12'\n" +
+	"\x0fconsistent_read\x18\b \x01(\bR\x0econsistentReadB\x0e\n" +
 	"\f_category_idB\a\n" +
 	"\x05_pageB\f\n" +
 	"\n" +
@@ -1585,59 +2942,87 @@ const file_paperless_service_v1_document_proto_rawDesc = "" +
 	"\x11_mime_type_filter\"k\n" +
 	"\x15ListDocumentsResponse\x12<\n" +
 	"\tdocuments\x18\x01 \x03(\v2\x1e.paperless.service.v1.DocumentR\tdocuments\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\rR\x05total\"\xa9\x03\n" +
-	"\x15UpdateDocumentRequest\x12.\n" +
-	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x12#\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total\"\xe4\x06\n" +
+	"\x15UpdateDocumentRequest\x12\x92\x01\n" +
+	"\x02id\x18\x01 \x01(\tB\x81\x01\xe0A\x02\xbaH{ry\x10\x01\x18$2s^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})$R\x02id\x12#\n" +
 	"\x04name\x18\x02 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x00R\x04name\x88\x01\x01\x12/\n" +
-	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80 H\x01R\vdescription\x88\x01\x01\x12A\n" +
-	"\x06status\x18\x04 \x01(\x0e2$.paperless.service.v1.DocumentStatusH\x02R\x06status\x88\x01\x01\x12I\n" +
-	"\x04tags\x18\x05 \x03(\v25.paperless.service.v1.UpdateDocumentRequest.TagsEntryR\x04tags\x12\x1f\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80 H\x01R\vdescription\x88\x01\x01\x12K\n" +
+	"\x06status\x18\x04 \x01(\x0e2$.paperless.service.v1.DocumentStatusB\b\xbaH\x05\x82\x01\x02\x10\x01H\x02R\x06status\x88\x01\x01\x12c\n" +
+	"\x04tags\x18\x05 \x03(\v25.paperless.service.v1.UpdateDocumentRequest.TagsEntryB\x18\xbaH\x15\x9a\x01\x12\x10d\"\ar\x05\x10\x01\x18\xff\x01*\x05r\x03\x18\xff\x01R\x04tags\x12\x1f\n" +
 	"\vupdate_tags\x18\x06 \x01(\bR\n" +
-	"updateTags\x1a7\n" +
+	"updateTags\x12.\n" +
+	"\x10expected_version\x18\a \x01(\rH\x03R\x0fexpectedVersion\x88\x01\x01\x121\n" +
+	"\rdocument_type\x18\b \x01(\tB\a\xbaH\x04r\x02\x18@H\x04R\fdocumentType\x88\x01\x01\x125\n" +
+	"\x0fretention_class\x18\t \x01(\tB\a\xbaH\x04r\x02\x18@H\x05R\x0eretentionClass\x88\x01\x01\x125\n" +
+	"\bdue_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x12$\n" +
+	"\x0eclear_due_date\x18\v \x01(\bR\fclearDueDate\x1a7\n" +
 	"\tTagsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\a\n" +
 	"\x05_nameB\x0e\n" +
 	"\f_descriptionB\t\n" +
-	"\a_status\"T\n" +
+	"\a_statusB\x13\n" +
+	"\x11_expected_versionB\x10\n" +
+	"\x0e_document_typeB\x12\n" +
+	"\x10_retention_class\"T\n" +
 	"\x16UpdateDocumentResponse\x12:\n" +
-	"\bdocument\x18\x01 \x01(\v2\x1e.paperless.service.v1.DocumentR\bdocument\"e\n" +
-	"\x15DeleteDocumentRequest\x12.\n" +
-	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x12\x1c\n" +
-	"\tpermanent\x18\x02 \x01(\bR\tpermanent\"\xa1\x01\n" +
-	"\x13MoveDocumentRequest\x12.\n" +
-	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x12F\n" +
-	"\x0fnew_category_id\x18\x02 \x01(\tB\x19\xbaH\x16r\x14\x18$2\x10^[a-fA-F0-9\\-]*$H\x00R\rnewCategoryId\x88\x01\x01B\x12\n" +
-	"\x10_new_category_id\"R\n" +
+	"\bdocument\x18\x01 \x01(\v2\x1e.paperless.service.v1.DocumentR\bdocument\"\xca\x01\n" +
+	"\x15DeleteDocumentRequest\x12\x92\x01\n" +
+	"\x02id\x18\x01 \x01(\tB\x81\x01\xe0A\x02\xbaH{ry\x10\x01\x18$2s^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})$R\x02id\x12\x1c\n" +
+	"\tpermanent\x18\x02 \x01(\bR\tpermanent\"\xb0\x03\n" +
+	"\x13MoveDocumentRequest\x12\x92\x01\n" +
+	"\x02id\x18\x01 \x01(\tB\x81\x01\xe0A\x02\xbaH{ry\x10\x01\x18$2s^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})$R\x02id\x12\xaa\x01\n" +
+	"\x0fnew_category_id\x18\x02 \x01(\tB}\xbaHzrx\x18$2t^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})?$H\x00R\rnewCategoryId\x88\x01\x01\x12.\n" +
+	"\x10expected_version\x18\x03 \x01(\rH\x01R\x0fexpectedVersion\x88\x01\x01B\x12\n" +
+	"\x10_new_category_idB\x13\n" +
+	"\x11_expected_version\"R\n" +
 	"\x14MoveDocumentResponse\x12:\n" +
-	"\bdocument\x18\x01 \x01(\v2\x1e.paperless.service.v1.DocumentR\bdocument\"I\n" +
-	"\x17DownloadDocumentRequest\x12.\n" +
-	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\"\x94\x01\n" +
+	"\bdocument\x18\x01 \x01(\v2\x1e.paperless.service.v1.DocumentR\bdocument\"\x86\x02\n" +
+	"\x17DownloadDocumentRequest\x12\x92\x01\n" +
+	"\x02id\x18\x01 \x01(\tB\x81\x01\xe0A\x02\xbaH{ry\x10\x01\x18$2s^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})$R\x02id\x12K\n" +
+	"\x06format\x18\x02 \x01(\x0e2$.paperless.service.v1.DownloadFormatB\b\xbaH\x05\x82\x01\x02\x10\x01H\x00R\x06format\x88\x01\x01B\t\n" +
+	"\a_format\"\x94\x01\n" +
 	"\x18DownloadDocumentResponse\x12!\n" +
 	"\acontent\x18\x01 \x01(\fB\aڶ\x1a\x03\x82\x01\x00R\acontent\x12\x1b\n" +
 	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12\x1b\n" +
 	"\tmime_type\x18\x03 \x01(\tR\bmimeType\x12\x1b\n" +
-	"\tfile_size\x18\x04 \x01(\x03R\bfileSize\"\x82\x01\n" +
-	"\x1dGetDocumentDownloadUrlRequest\x12.\n" +
-	"\x02id\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\x02id\x12\"\n" +
+	"\tfile_size\x18\x04 \x01(\x03R\bfileSize\"\x99\x03\n" +
+	"\x1dGetDocumentDownloadUrlRequest\x12\x92\x01\n" +
+	"\x02id\x18\x01 \x01(\tB\x81\x01\xe0A\x02\xbaH{ry\x10\x01\x18$2s^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})$R\x02id\x12/\n" +
+	"\n" +
+	"expires_in\x18\x02 \x01(\x05B\v\xbaH\b\x1a\x06\x18\x80\xf5$(\x00H\x00R\texpiresIn\x88\x01\x01\x12Y\n" +
+	"\vdisposition\x18\x03 \x01(\x0e2(.paperless.service.v1.ContentDispositionB\b\xbaH\x05\x82\x01\x02\x10\x01H\x01R\vdisposition\x88\x01\x01\x12*\n" +
+	"\tfile_name\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x02R\bfileName\x88\x01\x01B\r\n" +
+	"\v_expires_inB\x0e\n" +
+	"\f_dispositionB\f\n" +
 	"\n" +
-	"expires_in\x18\x02 \x01(\x05H\x00R\texpiresIn\x88\x01\x01B\r\n" +
-	"\v_expires_in\"u\n" +
+	"_file_name\"u\n" +
 	"\x1eGetDocumentDownloadUrlResponse\x12\x18\n" +
 	"\x03url\x18\x01 \x01(\tB\x06ڶ\x1a\x02z\x00R\x03url\x129\n" +
 	"\n" +
-	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\xac\x04\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\xf3\x01\n" +
+	"\x1cGetDocumentPreviewUrlRequest\x12\x92\x01\n" +
+	"\x02id\x18\x01 \x01(\tB\x81\x01\xe0A\x02\xbaH{ry\x10\x01\x18$2s^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})$R\x02id\x12/\n" +
+	"\n" +
+	"expires_in\x18\x02 \x01(\x05B\v\xbaH\b\x1a\x06\x18\x80\xf5$(\x00H\x00R\texpiresIn\x88\x01\x01B\r\n" +
+	"\v_expires_in\"t\n" +
+	"\x1dGetDocumentPreviewUrlResponse\x12\x18\n" +
+	"\x03url\x18\x01 \x01(\tB\x06ڶ\x1a\x02z\x00R\x03url\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\xf2\x05\n" +
 	"\x16SearchDocumentsRequest\x12#\n" +
-	"\x05query\x18\x01 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\xff\x01R\x05query\x12?\n" +
-	"\vcategory_id\x18\x02 \x01(\tB\x19\xbaH\x16r\x14\x18$2\x10^[a-fA-F0-9\\-]*$H\x00R\n" +
+	"\x05query\x18\x01 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\xff\x01R\x05query\x12\xa3\x01\n" +
+	"\vcategory_id\x18\x02 \x01(\tB}\xbaHzrx\x18$2t^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})?$H\x00R\n" +
 	"categoryId\x88\x01\x01\x123\n" +
 	"\x15include_subcategories\x18\x03 \x01(\bR\x14includeSubcategories\x12\x17\n" +
-	"\x04page\x18\x04 \x01(\rH\x01R\x04page\x88\x01\x01\x12 \n" +
-	"\tpage_size\x18\x05 \x01(\rH\x02R\bpageSize\x88\x01\x01\x12A\n" +
-	"\x06status\x18\x06 \x01(\x0e2$.paperless.service.v1.DocumentStatusH\x03R\x06status\x88\x01\x01\x12-\n" +
-	"\x10mime_type_filter\x18\a \x01(\tH\x04R\x0emimeTypeFilter\x88\x01\x01\x12J\n" +
-	"\x04tags\x18\b \x03(\v26.paperless.service.v1.SearchDocumentsRequest.TagsEntryR\x04tags\x1a7\n" +
+	"\x04page\x18\x04 \x01(\rH\x01R\x04page\x88\x01\x01\x12*\n" +
+	"\tpage_size\x18\x05 \x01(\rB\b\xbaH\x05*\x03\x18\xe8\aH\x02R\bpageSize\x88\x01\x01\x12K\n" +
+	"\x06status\x18\x06 \x01(\x0e2$.paperless.service.v1.DocumentStatusB\b\xbaH\x05\x82\x01\x02\x10\x01H\x03R\x06status\x88\x01\x01\x127\n" +
+	"\x10mime_type_filter\x18\a \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x04R\x0emimeTypeFilter\x88\x01\x01\x12d\n" +
+	"\x04tags\x18\b \x03(\v26.paperless.service.v1.SearchDocumentsRequest.TagsEntryB\x18\xbaH\x15\x9a\x01\x12\x10d\"\ar\x05\x10\x01\x18\xff\x01*\x05r\x03\x18\xff\x01R\x04tags\x12'\n" +
+	"\x0fconsistent_read\x18\t \x01(\bR\x0econsistentRead\x1a7\n" +
 	"\tTagsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0e\n" +
@@ -1649,23 +3034,134 @@ const file_paperless_service_v1_document_proto_rawDesc = "" +
 	"\x11_mime_type_filter\"m\n" +
 	"\x17SearchDocumentsResponse\x12<\n" +
 	"\tdocuments\x18\x01 \x03(\v2\x1e.paperless.service.v1.DocumentR\tdocuments\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\rR\x05total\"\\\n" +
-	"\x1bBatchDeleteDocumentsRequest\x12\x1f\n" +
-	"\x03ids\x18\x01 \x03(\tB\r\xe0A\x02\xbaH\a\x92\x01\x04\b\x01\x10dR\x03ids\x12\x1c\n" +
-	"\tpermanent\x18\x02 \x01(\bR\tpermanent\"b\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total\"\xd8\x01\n" +
+	"\x1bBatchDeleteDocumentsRequest\x12\x9a\x01\n" +
+	"\x03ids\x18\x01 \x03(\tB\x87\x01\xe0A\x02\xbaH\x80\x01\x92\x01}\b\x01\x10d\"wru2s^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})$R\x03ids\x12\x1c\n" +
+	"\tpermanent\x18\x02 \x01(\bR\tpermanent\"\x90\x01\n" +
 	"\x1cBatchDeleteDocumentsResponse\x12#\n" +
 	"\rdeleted_count\x18\x01 \x01(\rR\fdeletedCount\x12\x1d\n" +
 	"\n" +
-	"failed_ids\x18\x02 \x03(\tR\tfailedIds*\x88\x01\n" +
+	"failed_ids\x18\x02 \x03(\tR\tfailedIds\x12,\n" +
+	"\x12cleanup_failed_ids\x18\x03 \x03(\tR\x10cleanupFailedIds\"\xd2\x03\n" +
+	"\x10DocumentSnapshot\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12$\n" +
+	"\vcategory_id\x18\x03 \x01(\tH\x00R\n" +
+	"categoryId\x88\x01\x01\x12<\n" +
+	"\x06status\x18\x04 \x01(\x0e2$.paperless.service.v1.DocumentStatusR\x06status\x12D\n" +
+	"\x04tags\x18\x05 \x03(\v20.paperless.service.v1.DocumentSnapshot.TagsEntryR\x04tags\x12#\n" +
+	"\rdocument_type\x18\x06 \x01(\tR\fdocumentType\x12'\n" +
+	"\x0fretention_class\x18\a \x01(\tR\x0eretentionClass\x12:\n" +
+	"\bdue_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x01R\adueDate\x88\x01\x01\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0e\n" +
+	"\f_category_idB\v\n" +
+	"\t_due_date\"\xdc\x02\n" +
+	"\x14DocumentHistoryEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1f\n" +
+	"\vdocument_id\x18\x02 \x01(\tR\n" +
+	"documentId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\rR\aversion\x12%\n" +
+	"\x0echanged_fields\x18\x05 \x03(\tR\rchangedFields\x12>\n" +
+	"\x06before\x18\x06 \x01(\v2&.paperless.service.v1.DocumentSnapshotR\x06before\x12<\n" +
+	"\x05after\x18\a \x01(\v2&.paperless.service.v1.DocumentSnapshotR\x05after\x12;\n" +
+	"\vcreate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\"\x8c\x02\n" +
+	"\x19GetDocumentHistoryRequest\x12\x92\x01\n" +
+	"\x02id\x18\x01 \x01(\tB\x81\x01\xe0A\x02\xbaH{ry\x10\x01\x18$2s^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})$R\x02id\x12\x17\n" +
+	"\x04page\x18\x02 \x01(\rH\x00R\x04page\x88\x01\x01\x12*\n" +
+	"\tpage_size\x18\x03 \x01(\rB\b\xbaH\x05*\x03\x18\xe8\aH\x01R\bpageSize\x88\x01\x01B\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_size\"x\n" +
+	"\x1aGetDocumentHistoryResponse\x12D\n" +
+	"\aentries\x18\x01 \x03(\v2*.paperless.service.v1.DocumentHistoryEntryR\aentries\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total\"\xcd\x03\n" +
+	"\x1bListDocumentsDueSoonRequest\x12.\n" +
+	"\vwithin_days\x18\x01 \x01(\rB\b\xbaH\x05*\x03\x18\xcc\x1cH\x00R\n" +
+	"withinDays\x88\x01\x01\x12'\n" +
+	"\x0finclude_overdue\x18\x02 \x01(\bR\x0eincludeOverdue\x12\xa3\x01\n" +
+	"\vcategory_id\x18\x03 \x01(\tB}\xbaHzrx\x18$2t^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})?$H\x01R\n" +
+	"categoryId\x88\x01\x01\x123\n" +
+	"\x15include_subcategories\x18\x04 \x01(\bR\x14includeSubcategories\x12\x17\n" +
+	"\x04page\x18\x05 \x01(\rH\x02R\x04page\x88\x01\x01\x12*\n" +
+	"\tpage_size\x18\x06 \x01(\rB\b\xbaH\x05*\x03\x18\xe8\aH\x03R\bpageSize\x88\x01\x01B\x0e\n" +
+	"\f_within_daysB\x0e\n" +
+	"\f_category_idB\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_size\"r\n" +
+	"\x1cListDocumentsDueSoonResponse\x12<\n" +
+	"\tdocuments\x18\x01 \x03(\v2\x1e.paperless.service.v1.DocumentR\tdocuments\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total\"\xc5\x02\n" +
+	"\x15AssignDocumentRequest\x12\x92\x01\n" +
+	"\x02id\x18\x01 \x01(\tB\x81\x01\xe0A\x02\xbaH{ry\x10\x01\x18$2s^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})$R\x02id\x12$\n" +
+	"\vassignee_id\x18\x02 \x01(\rH\x00R\n" +
+	"assigneeId\x88\x01\x01\x12\x1c\n" +
+	"\x04note\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bR\x04note\x12.\n" +
+	"\x10expected_version\x18\x04 \x01(\rH\x01R\x0fexpectedVersion\x88\x01\x01B\x0e\n" +
+	"\f_assignee_idB\x13\n" +
+	"\x11_expected_version\"T\n" +
+	"\x16AssignDocumentResponse\x12:\n" +
+	"\bdocument\x18\x01 \x01(\v2\x1e.paperless.service.v1.DocumentR\bdocument\"p\n" +
+	"\x12ListMyInboxRequest\x12\x17\n" +
+	"\x04page\x18\x01 \x01(\rH\x00R\x04page\x88\x01\x01\x12*\n" +
+	"\tpage_size\x18\x02 \x01(\rB\b\xbaH\x05*\x03\x18\xe8\aH\x01R\bpageSize\x88\x01\x01B\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_size\"i\n" +
+	"\x13ListMyInboxResponse\x12<\n" +
+	"\tdocuments\x18\x01 \x03(\v2\x1e.paperless.service.v1.DocumentR\tdocuments\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total\"\xa0\x03\n" +
+	"\x15WatchDocumentsRequest\x12\xa3\x01\n" +
+	"\vcategory_id\x18\x01 \x01(\tB}\xbaHzrx\x18$2t^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})?$H\x00R\n" +
+	"categoryId\x88\x01\x01\x123\n" +
+	"\x15include_subcategories\x18\x02 \x01(\bR\x14includeSubcategories\x12c\n" +
+	"\x04tags\x18\x03 \x03(\v25.paperless.service.v1.WatchDocumentsRequest.TagsEntryB\x18\xbaH\x15\x9a\x01\x12\x10d\"\ar\x05\x10\x01\x18\xff\x01*\x05r\x03\x18\xff\x01R\x04tags\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0e\n" +
+	"\f_category_id\"\xb6\x02\n" +
+	"\x0eDocumentChange\x12<\n" +
+	"\x04type\x18\x01 \x01(\x0e2(.paperless.service.v1.DocumentChangeTypeR\x04type\x12\x1f\n" +
+	"\vdocument_id\x18\x02 \x01(\tR\n" +
+	"documentId\x12:\n" +
+	"\bdocument\x18\x03 \x01(\v2\x1e.paperless.service.v1.DocumentR\bdocument\x125\n" +
+	"\x14previous_category_id\x18\x04 \x01(\tH\x00R\x12previousCategoryId\x88\x01\x01\x129\n" +
+	"\n" +
+	"occur_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\toccurTimeB\x17\n" +
+	"\x15_previous_category_id*\x88\x01\n" +
 	"\x0eDocumentStatus\x12\x1f\n" +
 	"\x1bDOCUMENT_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16DOCUMENT_STATUS_ACTIVE\x10\x01\x12\x1c\n" +
 	"\x18DOCUMENT_STATUS_ARCHIVED\x10\x02\x12\x1b\n" +
-	"\x17DOCUMENT_STATUS_DELETED\x10\x03*h\n" +
+	"\x17DOCUMENT_STATUS_DELETED\x10\x03*X\n" +
+	"\vStorageTier\x12\x1c\n" +
+	"\x18STORAGE_TIER_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10STORAGE_TIER_HOT\x10\x01\x12\x15\n" +
+	"\x11STORAGE_TIER_COLD\x10\x02*}\n" +
+	"\x12ContentDisposition\x12#\n" +
+	"\x1fCONTENT_DISPOSITION_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eCONTENT_DISPOSITION_ATTACHMENT\x10\x01\x12\x1e\n" +
+	"\x1aCONTENT_DISPOSITION_INLINE\x10\x02*h\n" +
+	"\x0eDownloadFormat\x12\x1f\n" +
+	"\x1bDOWNLOAD_FORMAT_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18DOWNLOAD_FORMAT_ORIGINAL\x10\x01\x12\x17\n" +
+	"\x13DOWNLOAD_FORMAT_PDF\x10\x02*\x84\x01\n" +
 	"\x0eDocumentSource\x12\x1f\n" +
 	"\x1bDOCUMENT_SOURCE_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16DOCUMENT_SOURCE_UPLOAD\x10\x01\x12\x19\n" +
-	"\x15DOCUMENT_SOURCE_EMAIL\x10\x022\xad\v\n" +
+	"\x15DOCUMENT_SOURCE_EMAIL\x10\x02\x12\x1a\n" +
+	"\x16DOCUMENT_SOURCE_IMPORT\x10\x03*\xe4\x01\n" +
+	"\x12DocumentChangeType\x12$\n" +
+	" DOCUMENT_CHANGE_TYPE_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cDOCUMENT_CHANGE_TYPE_CREATED\x10\x01\x12 \n" +
+	"\x1cDOCUMENT_CHANGE_TYPE_UPDATED\x10\x02\x12\x1e\n" +
+	"\x1aDOCUMENT_CHANGE_TYPE_MOVED\x10\x03\x12\"\n" +
+	"\x1eDOCUMENT_CHANGE_TYPE_PROCESSED\x10\x04\x12 \n" +
+	"\x1cDOCUMENT_CHANGE_TYPE_DELETED\x10\x052\x94\x12\n" +
 	"\x18PaperlessDocumentService\x12\x85\x01\n" +
 	"\x0eCreateDocument\x12+.paperless.service.v1.CreateDocumentRequest\x1a,.paperless.service.v1.CreateDocumentResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/documents\x12~\n" +
 	"\vGetDocument\x12(.paperless.service.v1.GetDocumentRequest\x1a).paperless.service.v1.GetDocumentResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/documents/{id}\x12\x7f\n" +
@@ -1674,9 +3170,15 @@ const file_paperless_service_v1_document_proto_rawDesc = "" +
 	"\x0eDeleteDocument\x12+.paperless.service.v1.DeleteDocumentRequest\x1a\x16.google.protobuf.Empty\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/v1/documents/{id}\x12\x89\x01\n" +
 	"\fMoveDocument\x12).paperless.service.v1.MoveDocumentRequest\x1a*.paperless.service.v1.MoveDocumentResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/documents/{id}/move\x12\x96\x01\n" +
 	"\x10DownloadDocument\x12-.paperless.service.v1.DownloadDocumentRequest\x1a..paperless.service.v1.DownloadDocumentResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/documents/{id}/download\x12\xac\x01\n" +
-	"\x16GetDocumentDownloadUrl\x123.paperless.service.v1.GetDocumentDownloadUrlRequest\x1a4.paperless.service.v1.GetDocumentDownloadUrlResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/documents/{id}/download-url\x12\x8c\x01\n" +
+	"\x16GetDocumentDownloadUrl\x123.paperless.service.v1.GetDocumentDownloadUrlRequest\x1a4.paperless.service.v1.GetDocumentDownloadUrlResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/documents/{id}/download-url\x12\xa8\x01\n" +
+	"\x15GetDocumentPreviewUrl\x122.paperless.service.v1.GetDocumentPreviewUrlRequest\x1a3.paperless.service.v1.GetDocumentPreviewUrlResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/documents/{id}/preview-url\x12\x8c\x01\n" +
 	"\x0fSearchDocuments\x12,.paperless.service.v1.SearchDocumentsRequest\x1a-.paperless.service.v1.SearchDocumentsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/documents/search\x12\xa4\x01\n" +
-	"\x14BatchDeleteDocuments\x121.paperless.service.v1.BatchDeleteDocumentsRequest\x1a2.paperless.service.v1.BatchDeleteDocumentsResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/documents/batch-deleteB\xed\x01\n" +
+	"\x14BatchDeleteDocuments\x121.paperless.service.v1.BatchDeleteDocumentsRequest\x1a2.paperless.service.v1.BatchDeleteDocumentsResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/documents/batch-delete\x12\x9b\x01\n" +
+	"\x12GetDocumentHistory\x12/.paperless.service.v1.GetDocumentHistoryRequest\x1a0.paperless.service.v1.GetDocumentHistoryResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/documents/{id}/history\x12\x9d\x01\n" +
+	"\x14ListDocumentsDueSoon\x121.paperless.service.v1.ListDocumentsDueSoonRequest\x1a2.paperless.service.v1.ListDocumentsDueSoonResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/documents/due-soon\x12\x91\x01\n" +
+	"\x0eAssignDocument\x12+.paperless.service.v1.AssignDocumentRequest\x1a,.paperless.service.v1.AssignDocumentResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/documents/{id}/assign\x12\x7f\n" +
+	"\vListMyInbox\x12(.paperless.service.v1.ListMyInboxRequest\x1a).paperless.service.v1.ListMyInboxResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/documents/inbox\x12g\n" +
+	"\x0eWatchDocuments\x12+.paperless.service.v1.WatchDocumentsRequest\x1a$.paperless.service.v1.DocumentChange\"\x000\x01B\xed\x01\n" +
 	"\x18com.paperless.service.v1B\rDocumentProtoP\x01ZPgithub.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1;paperlesspb\xa2\x02\x03PSX\xaa\x02\x14Paperless.Service.V1\xca\x02\x14Paperless\\Service\\V1\xe2\x02 Paperless\\Service\\V1\\GPBMetadata\xea\x02\x16Paperless::Service::V1b\x06proto3"
 
 var (
@@ -1691,85 +3193,139 @@ func file_paperless_service_v1_document_proto_rawDescGZIP() []byte {
 	return file_paperless_service_v1_document_proto_rawDescData
 }
 
-var file_paperless_service_v1_document_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_paperless_service_v1_document_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_paperless_service_v1_document_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_paperless_service_v1_document_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
 var file_paperless_service_v1_document_proto_goTypes = []any{
 	(DocumentStatus)(0),                    // 0: paperless.service.v1.DocumentStatus
-	(DocumentSource)(0),                    // 1: paperless.service.v1.DocumentSource
-	(*Document)(nil),                       // 2: paperless.service.v1.Document
-	(*CreateDocumentRequest)(nil),          // 3: paperless.service.v1.CreateDocumentRequest
-	(*CreateDocumentResponse)(nil),         // 4: paperless.service.v1.CreateDocumentResponse
-	(*GetDocumentRequest)(nil),             // 5: paperless.service.v1.GetDocumentRequest
-	(*GetDocumentResponse)(nil),            // 6: paperless.service.v1.GetDocumentResponse
-	(*ListDocumentsRequest)(nil),           // 7: paperless.service.v1.ListDocumentsRequest
-	(*ListDocumentsResponse)(nil),          // 8: paperless.service.v1.ListDocumentsResponse
-	(*UpdateDocumentRequest)(nil),          // 9: paperless.service.v1.UpdateDocumentRequest
-	(*UpdateDocumentResponse)(nil),         // 10: paperless.service.v1.UpdateDocumentResponse
-	(*DeleteDocumentRequest)(nil),          // 11: paperless.service.v1.DeleteDocumentRequest
-	(*MoveDocumentRequest)(nil),            // 12: paperless.service.v1.MoveDocumentRequest
-	(*MoveDocumentResponse)(nil),           // 13: paperless.service.v1.MoveDocumentResponse
-	(*DownloadDocumentRequest)(nil),        // 14: paperless.service.v1.DownloadDocumentRequest
-	(*DownloadDocumentResponse)(nil),       // 15: paperless.service.v1.DownloadDocumentResponse
-	(*GetDocumentDownloadUrlRequest)(nil),  // 16: paperless.service.v1.GetDocumentDownloadUrlRequest
-	(*GetDocumentDownloadUrlResponse)(nil), // 17: paperless.service.v1.GetDocumentDownloadUrlResponse
-	(*SearchDocumentsRequest)(nil),         // 18: paperless.service.v1.SearchDocumentsRequest
-	(*SearchDocumentsResponse)(nil),        // 19: paperless.service.v1.SearchDocumentsResponse
-	(*BatchDeleteDocumentsRequest)(nil),    // 20: paperless.service.v1.BatchDeleteDocumentsRequest
-	(*BatchDeleteDocumentsResponse)(nil),   // 21: paperless.service.v1.BatchDeleteDocumentsResponse
-	nil,                                    // 22: paperless.service.v1.Document.TagsEntry
-	nil,                                    // 23: paperless.service.v1.Document.ExtractedMetadataEntry
-	nil,                                    // 24: paperless.service.v1.CreateDocumentRequest.TagsEntry
-	nil,                                    // 25: paperless.service.v1.UpdateDocumentRequest.TagsEntry
-	nil,                                    // 26: paperless.service.v1.SearchDocumentsRequest.TagsEntry
-	(*timestamppb.Timestamp)(nil),          // 27: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                  // 28: google.protobuf.Empty
+	(StorageTier)(0),                       // 1: paperless.service.v1.StorageTier
+	(ContentDisposition)(0),                // 2: paperless.service.v1.ContentDisposition
+	(DownloadFormat)(0),                    // 3: paperless.service.v1.DownloadFormat
+	(DocumentSource)(0),                    // 4: paperless.service.v1.DocumentSource
+	(DocumentChangeType)(0),                // 5: paperless.service.v1.DocumentChangeType
+	(*Document)(nil),                       // 6: paperless.service.v1.Document
+	(*CreateDocumentRequest)(nil),          // 7: paperless.service.v1.CreateDocumentRequest
+	(*CreateDocumentResponse)(nil),         // 8: paperless.service.v1.CreateDocumentResponse
+	(*GetDocumentRequest)(nil),             // 9: paperless.service.v1.GetDocumentRequest
+	(*GetDocumentResponse)(nil),            // 10: paperless.service.v1.GetDocumentResponse
+	(*ListDocumentsRequest)(nil),           // 11: paperless.service.v1.ListDocumentsRequest
+	(*ListDocumentsResponse)(nil),          // 12: paperless.service.v1.ListDocumentsResponse
+	(*UpdateDocumentRequest)(nil),          // 13: paperless.service.v1.UpdateDocumentRequest
+	(*UpdateDocumentResponse)(nil),         // 14: paperless.service.v1.UpdateDocumentResponse
+	(*DeleteDocumentRequest)(nil),          // 15: paperless.service.v1.DeleteDocumentRequest
+	(*MoveDocumentRequest)(nil),            // 16: paperless.service.v1.MoveDocumentRequest
+	(*MoveDocumentResponse)(nil),           // 17: paperless.service.v1.MoveDocumentResponse
+	(*DownloadDocumentRequest)(nil),        // 18: paperless.service.v1.DownloadDocumentRequest
+	(*DownloadDocumentResponse)(nil),       // 19: paperless.service.v1.DownloadDocumentResponse
+	(*GetDocumentDownloadUrlRequest)(nil),  // 20: paperless.service.v1.GetDocumentDownloadUrlRequest
+	(*GetDocumentDownloadUrlResponse)(nil), // 21: paperless.service.v1.GetDocumentDownloadUrlResponse
+	(*GetDocumentPreviewUrlRequest)(nil),   // 22: paperless.service.v1.GetDocumentPreviewUrlRequest
+	(*GetDocumentPreviewUrlResponse)(nil),  // 23: paperless.service.v1.GetDocumentPreviewUrlResponse
+	(*SearchDocumentsRequest)(nil),         // 24: paperless.service.v1.SearchDocumentsRequest
+	(*SearchDocumentsResponse)(nil),        // 25: paperless.service.v1.SearchDocumentsResponse
+	(*BatchDeleteDocumentsRequest)(nil),    // 26: paperless.service.v1.BatchDeleteDocumentsRequest
+	(*BatchDeleteDocumentsResponse)(nil),   // 27: paperless.service.v1.BatchDeleteDocumentsResponse
+	(*DocumentSnapshot)(nil),               // 28: paperless.service.v1.DocumentSnapshot
+	(*DocumentHistoryEntry)(nil),           // 29: paperless.service.v1.DocumentHistoryEntry
+	(*GetDocumentHistoryRequest)(nil),      // 30: paperless.service.v1.GetDocumentHistoryRequest
+	(*GetDocumentHistoryResponse)(nil),     // 31: paperless.service.v1.GetDocumentHistoryResponse
+	(*ListDocumentsDueSoonRequest)(nil),    // 32: paperless.service.v1.ListDocumentsDueSoonRequest
+	(*ListDocumentsDueSoonResponse)(nil),   // 33: paperless.service.v1.ListDocumentsDueSoonResponse
+	(*AssignDocumentRequest)(nil),          // 34: paperless.service.v1.AssignDocumentRequest
+	(*AssignDocumentResponse)(nil),         // 35: paperless.service.v1.AssignDocumentResponse
+	(*ListMyInboxRequest)(nil),             // 36: paperless.service.v1.ListMyInboxRequest
+	(*ListMyInboxResponse)(nil),            // 37: paperless.service.v1.ListMyInboxResponse
+	(*WatchDocumentsRequest)(nil),          // 38: paperless.service.v1.WatchDocumentsRequest
+	(*DocumentChange)(nil),                 // 39: paperless.service.v1.DocumentChange
+	nil,                                    // 40: paperless.service.v1.Document.TagsEntry
+	nil,                                    // 41: paperless.service.v1.Document.ExtractedMetadataEntry
+	nil,                                    // 42: paperless.service.v1.CreateDocumentRequest.TagsEntry
+	nil,                                    // 43: paperless.service.v1.UpdateDocumentRequest.TagsEntry
+	nil,                                    // 44: paperless.service.v1.SearchDocumentsRequest.TagsEntry
+	nil,                                    // 45: paperless.service.v1.DocumentSnapshot.TagsEntry
+	nil,                                    // 46: paperless.service.v1.WatchDocumentsRequest.TagsEntry
+	(*timestamppb.Timestamp)(nil),          // 47: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                  // 48: google.protobuf.Empty
 }
 var file_paperless_service_v1_document_proto_depIdxs = []int32{
 	0,  // 0: paperless.service.v1.Document.status:type_name -> paperless.service.v1.DocumentStatus
-	1,  // 1: paperless.service.v1.Document.source:type_name -> paperless.service.v1.DocumentSource
-	22, // 2: paperless.service.v1.Document.tags:type_name -> paperless.service.v1.Document.TagsEntry
-	27, // 3: paperless.service.v1.Document.create_time:type_name -> google.protobuf.Timestamp
-	27, // 4: paperless.service.v1.Document.update_time:type_name -> google.protobuf.Timestamp
-	23, // 5: paperless.service.v1.Document.extracted_metadata:type_name -> paperless.service.v1.Document.ExtractedMetadataEntry
-	24, // 6: paperless.service.v1.CreateDocumentRequest.tags:type_name -> paperless.service.v1.CreateDocumentRequest.TagsEntry
-	1,  // 7: paperless.service.v1.CreateDocumentRequest.source:type_name -> paperless.service.v1.DocumentSource
-	2,  // 8: paperless.service.v1.CreateDocumentResponse.document:type_name -> paperless.service.v1.Document
-	2,  // 9: paperless.service.v1.GetDocumentResponse.document:type_name -> paperless.service.v1.Document
-	0,  // 10: paperless.service.v1.ListDocumentsRequest.status:type_name -> paperless.service.v1.DocumentStatus
-	2,  // 11: paperless.service.v1.ListDocumentsResponse.documents:type_name -> paperless.service.v1.Document
-	0,  // 12: paperless.service.v1.UpdateDocumentRequest.status:type_name -> paperless.service.v1.DocumentStatus
-	25, // 13: paperless.service.v1.UpdateDocumentRequest.tags:type_name -> paperless.service.v1.UpdateDocumentRequest.TagsEntry
-	2,  // 14: paperless.service.v1.UpdateDocumentResponse.document:type_name -> paperless.service.v1.Document
-	2,  // 15: paperless.service.v1.MoveDocumentResponse.document:type_name -> paperless.service.v1.Document
-	27, // 16: paperless.service.v1.GetDocumentDownloadUrlResponse.expires_at:type_name -> google.protobuf.Timestamp
-	0,  // 17: paperless.service.v1.SearchDocumentsRequest.status:type_name -> paperless.service.v1.DocumentStatus
-	26, // 18: paperless.service.v1.SearchDocumentsRequest.tags:type_name -> paperless.service.v1.SearchDocumentsRequest.TagsEntry
-	2,  // 19: paperless.service.v1.SearchDocumentsResponse.documents:type_name -> paperless.service.v1.Document
-	3,  // 20: paperless.service.v1.PaperlessDocumentService.CreateDocument:input_type -> paperless.service.v1.CreateDocumentRequest
-	5,  // 21: paperless.service.v1.PaperlessDocumentService.GetDocument:input_type -> paperless.service.v1.GetDocumentRequest
-	7,  // 22: paperless.service.v1.PaperlessDocumentService.ListDocuments:input_type -> paperless.service.v1.ListDocumentsRequest
-	9,  // 23: paperless.service.v1.PaperlessDocumentService.UpdateDocument:input_type -> paperless.service.v1.UpdateDocumentRequest
-	11, // 24: paperless.service.v1.PaperlessDocumentService.DeleteDocument:input_type -> paperless.service.v1.DeleteDocumentRequest
-	12, // 25: paperless.service.v1.PaperlessDocumentService.MoveDocument:input_type -> paperless.service.v1.MoveDocumentRequest
-	14, // 26: paperless.service.v1.PaperlessDocumentService.DownloadDocument:input_type -> paperless.service.v1.DownloadDocumentRequest
-	16, // 27: paperless.service.v1.PaperlessDocumentService.GetDocumentDownloadUrl:input_type -> paperless.service.v1.GetDocumentDownloadUrlRequest
-	18, // 28: paperless.service.v1.PaperlessDocumentService.SearchDocuments:input_type -> paperless.service.v1.SearchDocumentsRequest
-	20, // 29: paperless.service.v1.PaperlessDocumentService.BatchDeleteDocuments:input_type -> paperless.service.v1.BatchDeleteDocumentsRequest
-	4,  // 30: paperless.service.v1.PaperlessDocumentService.CreateDocument:output_type -> paperless.service.v1.CreateDocumentResponse
-	6,  // 31: paperless.service.v1.PaperlessDocumentService.GetDocument:output_type -> paperless.service.v1.GetDocumentResponse
-	8,  // 32: paperless.service.v1.PaperlessDocumentService.ListDocuments:output_type -> paperless.service.v1.ListDocumentsResponse
-	10, // 33: paperless.service.v1.PaperlessDocumentService.UpdateDocument:output_type -> paperless.service.v1.UpdateDocumentResponse
-	28, // 34: paperless.service.v1.PaperlessDocumentService.DeleteDocument:output_type -> google.protobuf.Empty
-	13, // 35: paperless.service.v1.PaperlessDocumentService.MoveDocument:output_type -> paperless.service.v1.MoveDocumentResponse
-	15, // 36: paperless.service.v1.PaperlessDocumentService.DownloadDocument:output_type -> paperless.service.v1.DownloadDocumentResponse
-	17, // 37: paperless.service.v1.PaperlessDocumentService.GetDocumentDownloadUrl:output_type -> paperless.service.v1.GetDocumentDownloadUrlResponse
-	19, // 38: paperless.service.v1.PaperlessDocumentService.SearchDocuments:output_type -> paperless.service.v1.SearchDocumentsResponse
-	21, // 39: paperless.service.v1.PaperlessDocumentService.BatchDeleteDocuments:output_type -> paperless.service.v1.BatchDeleteDocumentsResponse
-	30, // [30:40] is the sub-list for method output_type
-	20, // [20:30] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	4,  // 1: paperless.service.v1.Document.source:type_name -> paperless.service.v1.DocumentSource
+	40, // 2: paperless.service.v1.Document.tags:type_name -> paperless.service.v1.Document.TagsEntry
+	47, // 3: paperless.service.v1.Document.create_time:type_name -> google.protobuf.Timestamp
+	47, // 4: paperless.service.v1.Document.update_time:type_name -> google.protobuf.Timestamp
+	41, // 5: paperless.service.v1.Document.extracted_metadata:type_name -> paperless.service.v1.Document.ExtractedMetadataEntry
+	1,  // 6: paperless.service.v1.Document.storage_tier:type_name -> paperless.service.v1.StorageTier
+	47, // 7: paperless.service.v1.Document.last_accessed_at:type_name -> google.protobuf.Timestamp
+	47, // 8: paperless.service.v1.Document.due_date:type_name -> google.protobuf.Timestamp
+	47, // 9: paperless.service.v1.Document.assigned_at:type_name -> google.protobuf.Timestamp
+	42, // 10: paperless.service.v1.CreateDocumentRequest.tags:type_name -> paperless.service.v1.CreateDocumentRequest.TagsEntry
+	4,  // 11: paperless.service.v1.CreateDocumentRequest.source:type_name -> paperless.service.v1.DocumentSource
+	6,  // 12: paperless.service.v1.CreateDocumentResponse.document:type_name -> paperless.service.v1.Document
+	6,  // 13: paperless.service.v1.GetDocumentResponse.document:type_name -> paperless.service.v1.Document
+	0,  // 14: paperless.service.v1.ListDocumentsRequest.status:type_name -> paperless.service.v1.DocumentStatus
+	6,  // 15: paperless.service.v1.ListDocumentsResponse.documents:type_name -> paperless.service.v1.Document
+	0,  // 16: paperless.service.v1.UpdateDocumentRequest.status:type_name -> paperless.service.v1.DocumentStatus
+	43, // 17: paperless.service.v1.UpdateDocumentRequest.tags:type_name -> paperless.service.v1.UpdateDocumentRequest.TagsEntry
+	47, // 18: paperless.service.v1.UpdateDocumentRequest.due_date:type_name -> google.protobuf.Timestamp
+	6,  // 19: paperless.service.v1.UpdateDocumentResponse.document:type_name -> paperless.service.v1.Document
+	6,  // 20: paperless.service.v1.MoveDocumentResponse.document:type_name -> paperless.service.v1.Document
+	3,  // 21: paperless.service.v1.DownloadDocumentRequest.format:type_name -> paperless.service.v1.DownloadFormat
+	2,  // 22: paperless.service.v1.GetDocumentDownloadUrlRequest.disposition:type_name -> paperless.service.v1.ContentDisposition
+	47, // 23: paperless.service.v1.GetDocumentDownloadUrlResponse.expires_at:type_name -> google.protobuf.Timestamp
+	47, // 24: paperless.service.v1.GetDocumentPreviewUrlResponse.expires_at:type_name -> google.protobuf.Timestamp
+	0,  // 25: paperless.service.v1.SearchDocumentsRequest.status:type_name -> paperless.service.v1.DocumentStatus
+	44, // 26: paperless.service.v1.SearchDocumentsRequest.tags:type_name -> paperless.service.v1.SearchDocumentsRequest.TagsEntry
+	6,  // 27: paperless.service.v1.SearchDocumentsResponse.documents:type_name -> paperless.service.v1.Document
+	0,  // 28: paperless.service.v1.DocumentSnapshot.status:type_name -> paperless.service.v1.DocumentStatus
+	45, // 29: paperless.service.v1.DocumentSnapshot.tags:type_name -> paperless.service.v1.DocumentSnapshot.TagsEntry
+	47, // 30: paperless.service.v1.DocumentSnapshot.due_date:type_name -> google.protobuf.Timestamp
+	28, // 31: paperless.service.v1.DocumentHistoryEntry.before:type_name -> paperless.service.v1.DocumentSnapshot
+	28, // 32: paperless.service.v1.DocumentHistoryEntry.after:type_name -> paperless.service.v1.DocumentSnapshot
+	47, // 33: paperless.service.v1.DocumentHistoryEntry.create_time:type_name -> google.protobuf.Timestamp
+	29, // 34: paperless.service.v1.GetDocumentHistoryResponse.entries:type_name -> paperless.service.v1.DocumentHistoryEntry
+	6,  // 35: paperless.service.v1.ListDocumentsDueSoonResponse.documents:type_name -> paperless.service.v1.Document
+	6,  // 36: paperless.service.v1.AssignDocumentResponse.document:type_name -> paperless.service.v1.Document
+	6,  // 37: paperless.service.v1.ListMyInboxResponse.documents:type_name -> paperless.service.v1.Document
+	46, // 38: paperless.service.v1.WatchDocumentsRequest.tags:type_name -> paperless.service.v1.WatchDocumentsRequest.TagsEntry
+	5,  // 39: paperless.service.v1.DocumentChange.type:type_name -> paperless.service.v1.DocumentChangeType
+	6,  // 40: paperless.service.v1.DocumentChange.document:type_name -> paperless.service.v1.Document
+	47, // 41: paperless.service.v1.DocumentChange.occur_time:type_name -> google.protobuf.Timestamp
+	7,  // 42: paperless.service.v1.PaperlessDocumentService.CreateDocument:input_type -> paperless.service.v1.CreateDocumentRequest
+	9,  // 43: paperless.service.v1.PaperlessDocumentService.GetDocument:input_type -> paperless.service.v1.GetDocumentRequest
+	11, // 44: paperless.service.v1.PaperlessDocumentService.ListDocuments:input_type -> paperless.service.v1.ListDocumentsRequest
+	13, // 45: paperless.service.v1.PaperlessDocumentService.UpdateDocument:input_type -> paperless.service.v1.UpdateDocumentRequest
+	15, // 46: paperless.service.v1.PaperlessDocumentService.DeleteDocument:input_type -> paperless.service.v1.DeleteDocumentRequest
+	16, // 47: paperless.service.v1.PaperlessDocumentService.MoveDocument:input_type -> paperless.service.v1.MoveDocumentRequest
+	18, // 48: paperless.service.v1.PaperlessDocumentService.DownloadDocument:input_type -> paperless.service.v1.DownloadDocumentRequest
+	20, // 49: paperless.service.v1.PaperlessDocumentService.GetDocumentDownloadUrl:input_type -> paperless.service.v1.GetDocumentDownloadUrlRequest
+	22, // 50: paperless.service.v1.PaperlessDocumentService.GetDocumentPreviewUrl:input_type -> paperless.service.v1.GetDocumentPreviewUrlRequest
+	24, // 51: paperless.service.v1.PaperlessDocumentService.SearchDocuments:input_type -> paperless.service.v1.SearchDocumentsRequest
+	26, // 52: paperless.service.v1.PaperlessDocumentService.BatchDeleteDocuments:input_type -> paperless.service.v1.BatchDeleteDocumentsRequest
+	30, // 53: paperless.service.v1.PaperlessDocumentService.GetDocumentHistory:input_type -> paperless.service.v1.GetDocumentHistoryRequest
+	32, // 54: paperless.service.v1.PaperlessDocumentService.ListDocumentsDueSoon:input_type -> paperless.service.v1.ListDocumentsDueSoonRequest
+	34, // 55: paperless.service.v1.PaperlessDocumentService.AssignDocument:input_type -> paperless.service.v1.AssignDocumentRequest
+	36, // 56: paperless.service.v1.PaperlessDocumentService.ListMyInbox:input_type -> paperless.service.v1.ListMyInboxRequest
+	38, // 57: paperless.service.v1.PaperlessDocumentService.WatchDocuments:input_type -> paperless.service.v1.WatchDocumentsRequest
+	8,  // 58: paperless.service.v1.PaperlessDocumentService.CreateDocument:output_type -> paperless.service.v1.CreateDocumentResponse
+	10, // 59: paperless.service.v1.PaperlessDocumentService.GetDocument:output_type -> paperless.service.v1.GetDocumentResponse
+	12, // 60: paperless.service.v1.PaperlessDocumentService.ListDocuments:output_type -> paperless.service.v1.ListDocumentsResponse
+	14, // 61: paperless.service.v1.PaperlessDocumentService.UpdateDocument:output_type -> paperless.service.v1.UpdateDocumentResponse
+	48, // 62: paperless.service.v1.PaperlessDocumentService.DeleteDocument:output_type -> google.protobuf.Empty
+	17, // 63: paperless.service.v1.PaperlessDocumentService.MoveDocument:output_type -> paperless.service.v1.MoveDocumentResponse
+	19, // 64: paperless.service.v1.PaperlessDocumentService.DownloadDocument:output_type -> paperless.service.v1.DownloadDocumentResponse
+	21, // 65: paperless.service.v1.PaperlessDocumentService.GetDocumentDownloadUrl:output_type -> paperless.service.v1.GetDocumentDownloadUrlResponse
+	23, // 66: paperless.service.v1.PaperlessDocumentService.GetDocumentPreviewUrl:output_type -> paperless.service.v1.GetDocumentPreviewUrlResponse
+	25, // 67: paperless.service.v1.PaperlessDocumentService.SearchDocuments:output_type -> paperless.service.v1.SearchDocumentsResponse
+	27, // 68: paperless.service.v1.PaperlessDocumentService.BatchDeleteDocuments:output_type -> paperless.service.v1.BatchDeleteDocumentsResponse
+	31, // 69: paperless.service.v1.PaperlessDocumentService.GetDocumentHistory:output_type -> paperless.service.v1.GetDocumentHistoryResponse
+	33, // 70: paperless.service.v1.PaperlessDocumentService.ListDocumentsDueSoon:output_type -> paperless.service.v1.ListDocumentsDueSoonResponse
+	35, // 71: paperless.service.v1.PaperlessDocumentService.AssignDocument:output_type -> paperless.service.v1.AssignDocumentResponse
+	37, // 72: paperless.service.v1.PaperlessDocumentService.ListMyInbox:output_type -> paperless.service.v1.ListMyInboxResponse
+	39, // 73: paperless.service.v1.PaperlessDocumentService.WatchDocuments:output_type -> paperless.service.v1.DocumentChange
+	58, // [58:74] is the sub-list for method output_type
+	42, // [42:58] is the sub-list for method input_type
+	42, // [42:42] is the sub-list for extension type_name
+	42, // [42:42] is the sub-list for extension extendee
+	0,  // [0:42] is the sub-list for field type_name
 }
 
 func init() { file_paperless_service_v1_document_proto_init() }
@@ -1782,15 +3338,24 @@ func file_paperless_service_v1_document_proto_init() {
 	file_paperless_service_v1_document_proto_msgTypes[5].OneofWrappers = []any{}
 	file_paperless_service_v1_document_proto_msgTypes[7].OneofWrappers = []any{}
 	file_paperless_service_v1_document_proto_msgTypes[10].OneofWrappers = []any{}
+	file_paperless_service_v1_document_proto_msgTypes[12].OneofWrappers = []any{}
 	file_paperless_service_v1_document_proto_msgTypes[14].OneofWrappers = []any{}
 	file_paperless_service_v1_document_proto_msgTypes[16].OneofWrappers = []any{}
+	file_paperless_service_v1_document_proto_msgTypes[18].OneofWrappers = []any{}
+	file_paperless_service_v1_document_proto_msgTypes[22].OneofWrappers = []any{}
+	file_paperless_service_v1_document_proto_msgTypes[24].OneofWrappers = []any{}
+	file_paperless_service_v1_document_proto_msgTypes[26].OneofWrappers = []any{}
+	file_paperless_service_v1_document_proto_msgTypes[28].OneofWrappers = []any{}
+	file_paperless_service_v1_document_proto_msgTypes[30].OneofWrappers = []any{}
+	file_paperless_service_v1_document_proto_msgTypes[32].OneofWrappers = []any{}
+	file_paperless_service_v1_document_proto_msgTypes[33].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_paperless_service_v1_document_proto_rawDesc), len(file_paperless_service_v1_document_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   25,
+			NumEnums:      6,
+			NumMessages:   41,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
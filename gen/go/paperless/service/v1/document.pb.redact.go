@@ -135,6 +135,17 @@ func (s *redactedPaperlessDocumentServiceServer) GetDocumentDownloadUrl(ctx cont
 	return res, err
 }
 
+// GetDocumentPreviewUrl is the redacted wrapper for the actual PaperlessDocumentServiceServer.GetDocumentPreviewUrl method
+// Unary RPC
+func (s *redactedPaperlessDocumentServiceServer) GetDocumentPreviewUrl(ctx context.Context, in *GetDocumentPreviewUrlRequest) (*GetDocumentPreviewUrlResponse, error) {
+	res, err := s.srv.GetDocumentPreviewUrl(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // SearchDocuments is the redacted wrapper for the actual PaperlessDocumentServiceServer.SearchDocuments method
 // Unary RPC
 func (s *redactedPaperlessDocumentServiceServer) SearchDocuments(ctx context.Context, in *SearchDocumentsRequest) (*SearchDocumentsResponse, error) {
@@ -157,6 +168,58 @@ func (s *redactedPaperlessDocumentServiceServer) BatchDeleteDocuments(ctx contex
 	return res, err
 }
 
+// GetDocumentHistory is the redacted wrapper for the actual PaperlessDocumentServiceServer.GetDocumentHistory method
+// Unary RPC
+func (s *redactedPaperlessDocumentServiceServer) GetDocumentHistory(ctx context.Context, in *GetDocumentHistoryRequest) (*GetDocumentHistoryResponse, error) {
+	res, err := s.srv.GetDocumentHistory(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ListDocumentsDueSoon is the redacted wrapper for the actual PaperlessDocumentServiceServer.ListDocumentsDueSoon method
+// Unary RPC
+func (s *redactedPaperlessDocumentServiceServer) ListDocumentsDueSoon(ctx context.Context, in *ListDocumentsDueSoonRequest) (*ListDocumentsDueSoonResponse, error) {
+	res, err := s.srv.ListDocumentsDueSoon(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// AssignDocument is the redacted wrapper for the actual PaperlessDocumentServiceServer.AssignDocument method
+// Unary RPC
+func (s *redactedPaperlessDocumentServiceServer) AssignDocument(ctx context.Context, in *AssignDocumentRequest) (*AssignDocumentResponse, error) {
+	res, err := s.srv.AssignDocument(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ListMyInbox is the redacted wrapper for the actual PaperlessDocumentServiceServer.ListMyInbox method
+// Unary RPC
+func (s *redactedPaperlessDocumentServiceServer) ListMyInbox(ctx context.Context, in *ListMyInboxRequest) (*ListMyInboxResponse, error) {
+	res, err := s.srv.ListMyInbox(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// WatchDocuments is the redacted wrapper for the actual PaperlessDocumentServiceServer.WatchDocuments method
+// Server streaming
+func (s *redactedPaperlessDocumentServiceServer) WatchDocuments(in *WatchDocumentsRequest, stream grpc.ServerStreamingServer[DocumentChange]) error {
+	// Note: Redaction for server streaming is not fully implemented
+	// Streaming methods pass through without redaction
+	return s.srv.WatchDocuments(in, stream)
+}
+
 // Redact method implementation for Document
 func (x *Document) Redact() string {
 	if x == nil {
@@ -206,6 +269,26 @@ func (x *Document) Redact() string {
 	x.ExtractedMetadata = map[string]string{}
 
 	// Safe field: ProcessingStatus
+
+	// Safe field: StorageTier
+
+	// Safe field: LastAccessedAt
+
+	// Safe field: Version
+
+	// Safe field: DocumentType
+
+	// Safe field: RetentionClass
+
+	// Safe field: DueDate
+
+	// Safe field: AssigneeId
+
+	// Safe field: AssignedBy
+
+	// Safe field: AssignedAt
+
+	// Safe field: ContentTextTruncated
 	return x.String()
 }
 
@@ -283,6 +366,8 @@ func (x *ListDocumentsRequest) Redact() string {
 	// Safe field: MimeTypeFilter
 
 	// Safe field: IncludeSubcategories
+
+	// Safe field: ConsistentRead
 	return x.String()
 }
 
@@ -315,6 +400,16 @@ func (x *UpdateDocumentRequest) Redact() string {
 	// Safe field: Tags
 
 	// Safe field: UpdateTags
+
+	// Safe field: ExpectedVersion
+
+	// Safe field: DocumentType
+
+	// Safe field: RetentionClass
+
+	// Safe field: DueDate
+
+	// Safe field: ClearDueDate
 	return x.String()
 }
 
@@ -349,6 +444,8 @@ func (x *MoveDocumentRequest) Redact() string {
 	// Safe field: Id
 
 	// Safe field: NewCategoryId
+
+	// Safe field: ExpectedVersion
 	return x.String()
 }
 
@@ -369,6 +466,8 @@ func (x *DownloadDocumentRequest) Redact() string {
 	}
 
 	// Safe field: Id
+
+	// Safe field: Format
 	return x.String()
 }
 
@@ -398,6 +497,10 @@ func (x *GetDocumentDownloadUrlRequest) Redact() string {
 	// Safe field: Id
 
 	// Safe field: ExpiresIn
+
+	// Safe field: Disposition
+
+	// Safe field: FileName
 	return x.String()
 }
 
@@ -414,6 +517,31 @@ func (x *GetDocumentDownloadUrlResponse) Redact() string {
 	return x.String()
 }
 
+// Redact method implementation for GetDocumentPreviewUrlRequest
+func (x *GetDocumentPreviewUrlRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: ExpiresIn
+	return x.String()
+}
+
+// Redact method implementation for GetDocumentPreviewUrlResponse
+func (x *GetDocumentPreviewUrlResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Redacting field: Url
+	x.Url = ``
+
+	// Safe field: ExpiresAt
+	return x.String()
+}
+
 // Redact method implementation for SearchDocumentsRequest
 func (x *SearchDocumentsRequest) Redact() string {
 	if x == nil {
@@ -435,6 +563,8 @@ func (x *SearchDocumentsRequest) Redact() string {
 	// Safe field: MimeTypeFilter
 
 	// Safe field: Tags
+
+	// Safe field: ConsistentRead
 	return x.String()
 }
 
@@ -471,5 +601,195 @@ func (x *BatchDeleteDocumentsResponse) Redact() string {
 	// Safe field: DeletedCount
 
 	// Safe field: FailedIds
+
+	// Safe field: CleanupFailedIds
+	return x.String()
+}
+
+// Redact method implementation for DocumentSnapshot
+func (x *DocumentSnapshot) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Name
+
+	// Safe field: Description
+
+	// Safe field: CategoryId
+
+	// Safe field: Status
+
+	// Safe field: Tags
+
+	// Safe field: DocumentType
+
+	// Safe field: RetentionClass
+
+	// Safe field: DueDate
+	return x.String()
+}
+
+// Redact method implementation for DocumentHistoryEntry
+func (x *DocumentHistoryEntry) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: DocumentId
+
+	// Safe field: UserId
+
+	// Safe field: Version
+
+	// Safe field: ChangedFields
+
+	// Safe field: Before
+
+	// Safe field: After
+
+	// Safe field: CreateTime
+	return x.String()
+}
+
+// Redact method implementation for GetDocumentHistoryRequest
+func (x *GetDocumentHistoryRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: Page
+
+	// Safe field: PageSize
+	return x.String()
+}
+
+// Redact method implementation for GetDocumentHistoryResponse
+func (x *GetDocumentHistoryResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Entries
+
+	// Safe field: Total
+	return x.String()
+}
+
+// Redact method implementation for ListDocumentsDueSoonRequest
+func (x *ListDocumentsDueSoonRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: WithinDays
+
+	// Safe field: IncludeOverdue
+
+	// Safe field: CategoryId
+
+	// Safe field: IncludeSubcategories
+
+	// Safe field: Page
+
+	// Safe field: PageSize
+	return x.String()
+}
+
+// Redact method implementation for ListDocumentsDueSoonResponse
+func (x *ListDocumentsDueSoonResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Documents
+
+	// Safe field: Total
+	return x.String()
+}
+
+// Redact method implementation for AssignDocumentRequest
+func (x *AssignDocumentRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: AssigneeId
+
+	// Safe field: Note
+
+	// Safe field: ExpectedVersion
+	return x.String()
+}
+
+// Redact method implementation for AssignDocumentResponse
+func (x *AssignDocumentResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Document
+	return x.String()
+}
+
+// Redact method implementation for ListMyInboxRequest
+func (x *ListMyInboxRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Page
+
+	// Safe field: PageSize
+	return x.String()
+}
+
+// Redact method implementation for ListMyInboxResponse
+func (x *ListMyInboxResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Documents
+
+	// Safe field: Total
+	return x.String()
+}
+
+// Redact method implementation for WatchDocumentsRequest
+func (x *WatchDocumentsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: CategoryId
+
+	// Safe field: IncludeSubcategories
+
+	// Safe field: Tags
+	return x.String()
+}
+
+// Redact method implementation for DocumentChange
+func (x *DocumentChange) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Type
+
+	// Safe field: DocumentId
+
+	// Safe field: Document
+
+	// Safe field: PreviousCategoryId
+
+	// Safe field: OccurTime
 	return x.String()
 }
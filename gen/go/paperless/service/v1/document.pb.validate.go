@@ -147,6 +147,16 @@ func (m *Document) validate(all bool) error {
 
 	// no validation rules for ProcessingStatus
 
+	// no validation rules for StorageTier
+
+	// no validation rules for Version
+
+	// no validation rules for DocumentType
+
+	// no validation rules for RetentionClass
+
+	// no validation rules for ContentTextTruncated
+
 	if m.CategoryId != nil {
 		// no validation rules for CategoryId
 	}
@@ -159,6 +169,113 @@ func (m *Document) validate(all bool) error {
 		// no validation rules for UpdatedBy
 	}
 
+	if m.LastAccessedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetLastAccessedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DocumentValidationError{
+						field:  "LastAccessedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DocumentValidationError{
+						field:  "LastAccessedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLastAccessedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DocumentValidationError{
+					field:  "LastAccessedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.DueDate != nil {
+
+		if all {
+			switch v := interface{}(m.GetDueDate()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DocumentValidationError{
+						field:  "DueDate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DocumentValidationError{
+						field:  "DueDate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDueDate()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DocumentValidationError{
+					field:  "DueDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.AssigneeId != nil {
+		// no validation rules for AssigneeId
+	}
+
+	if m.AssignedBy != nil {
+		// no validation rules for AssignedBy
+	}
+
+	if m.AssignedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetAssignedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DocumentValidationError{
+						field:  "AssignedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DocumentValidationError{
+						field:  "AssignedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAssignedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DocumentValidationError{
+					field:  "AssignedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return DocumentMultiError(errors)
 	}
@@ -746,6 +863,8 @@ func (m *ListDocumentsRequest) validate(all bool) error {
 
 	// no validation rules for IncludeSubcategories
 
+	// no validation rules for ConsistentRead
+
 	if m.CategoryId != nil {
 		// no validation rules for CategoryId
 	}
@@ -1016,6 +1135,37 @@ func (m *UpdateDocumentRequest) validate(all bool) error {
 
 	// no validation rules for UpdateTags
 
+	if all {
+		switch v := interface{}(m.GetDueDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateDocumentRequestValidationError{
+					field:  "DueDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateDocumentRequestValidationError{
+					field:  "DueDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDueDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateDocumentRequestValidationError{
+				field:  "DueDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ClearDueDate
+
 	if m.Name != nil {
 		// no validation rules for Name
 	}
@@ -1028,6 +1178,18 @@ func (m *UpdateDocumentRequest) validate(all bool) error {
 		// no validation rules for Status
 	}
 
+	if m.ExpectedVersion != nil {
+		// no validation rules for ExpectedVersion
+	}
+
+	if m.DocumentType != nil {
+		// no validation rules for DocumentType
+	}
+
+	if m.RetentionClass != nil {
+		// no validation rules for RetentionClass
+	}
+
 	if len(errors) > 0 {
 		return UpdateDocumentRequestMultiError(errors)
 	}
@@ -1373,6 +1535,10 @@ func (m *MoveDocumentRequest) validate(all bool) error {
 		// no validation rules for NewCategoryId
 	}
 
+	if m.ExpectedVersion != nil {
+		// no validation rules for ExpectedVersion
+	}
+
 	if len(errors) > 0 {
 		return MoveDocumentRequestMultiError(errors)
 	}
@@ -1608,6 +1774,10 @@ func (m *DownloadDocumentRequest) validate(all bool) error {
 
 	// no validation rules for Id
 
+	if m.Format != nil {
+		// no validation rules for Format
+	}
+
 	if len(errors) > 0 {
 		return DownloadDocumentRequestMultiError(errors)
 	}
@@ -1826,6 +1996,14 @@ func (m *GetDocumentDownloadUrlRequest) validate(all bool) error {
 		// no validation rules for ExpiresIn
 	}
 
+	if m.Disposition != nil {
+		// no validation rules for Disposition
+	}
+
+	if m.FileName != nil {
+		// no validation rules for FileName
+	}
+
 	if len(errors) > 0 {
 		return GetDocumentDownloadUrlRequestMultiError(errors)
 	}
@@ -2041,68 +2219,48 @@ var _ interface {
 	ErrorName() string
 } = GetDocumentDownloadUrlResponseValidationError{}
 
-// Validate checks the field values on SearchDocumentsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetDocumentPreviewUrlRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SearchDocumentsRequest) Validate() error {
+func (m *GetDocumentPreviewUrlRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SearchDocumentsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetDocumentPreviewUrlRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SearchDocumentsRequestMultiError, or nil if none found.
-func (m *SearchDocumentsRequest) ValidateAll() error {
+// GetDocumentPreviewUrlRequestMultiError, or nil if none found.
+func (m *GetDocumentPreviewUrlRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SearchDocumentsRequest) validate(all bool) error {
+func (m *GetDocumentPreviewUrlRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Query
-
-	// no validation rules for IncludeSubcategories
-
-	// no validation rules for Tags
-
-	if m.CategoryId != nil {
-		// no validation rules for CategoryId
-	}
-
-	if m.Page != nil {
-		// no validation rules for Page
-	}
-
-	if m.PageSize != nil {
-		// no validation rules for PageSize
-	}
-
-	if m.Status != nil {
-		// no validation rules for Status
-	}
+	// no validation rules for Id
 
-	if m.MimeTypeFilter != nil {
-		// no validation rules for MimeTypeFilter
+	if m.ExpiresIn != nil {
+		// no validation rules for ExpiresIn
 	}
 
 	if len(errors) > 0 {
-		return SearchDocumentsRequestMultiError(errors)
+		return GetDocumentPreviewUrlRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SearchDocumentsRequestMultiError is an error wrapping multiple validation
-// errors returned by SearchDocumentsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type SearchDocumentsRequestMultiError []error
+// GetDocumentPreviewUrlRequestMultiError is an error wrapping multiple
+// validation errors returned by GetDocumentPreviewUrlRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetDocumentPreviewUrlRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SearchDocumentsRequestMultiError) Error() string {
+func (m GetDocumentPreviewUrlRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2111,11 +2269,12 @@ func (m SearchDocumentsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SearchDocumentsRequestMultiError) AllErrors() []error { return m }
+func (m GetDocumentPreviewUrlRequestMultiError) AllErrors() []error { return m }
 
-// SearchDocumentsRequestValidationError is the validation error returned by
-// SearchDocumentsRequest.Validate if the designated constraints aren't met.
-type SearchDocumentsRequestValidationError struct {
+// GetDocumentPreviewUrlRequestValidationError is the validation error returned
+// by GetDocumentPreviewUrlRequest.Validate if the designated constraints
+// aren't met.
+type GetDocumentPreviewUrlRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2123,24 +2282,24 @@ type SearchDocumentsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SearchDocumentsRequestValidationError) Field() string { return e.field }
+func (e GetDocumentPreviewUrlRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SearchDocumentsRequestValidationError) Reason() string { return e.reason }
+func (e GetDocumentPreviewUrlRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SearchDocumentsRequestValidationError) Cause() error { return e.cause }
+func (e GetDocumentPreviewUrlRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SearchDocumentsRequestValidationError) Key() bool { return e.key }
+func (e GetDocumentPreviewUrlRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SearchDocumentsRequestValidationError) ErrorName() string {
-	return "SearchDocumentsRequestValidationError"
+func (e GetDocumentPreviewUrlRequestValidationError) ErrorName() string {
+	return "GetDocumentPreviewUrlRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SearchDocumentsRequestValidationError) Error() string {
+func (e GetDocumentPreviewUrlRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2152,14 +2311,14 @@ func (e SearchDocumentsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSearchDocumentsRequest.%s: %s%s",
+		"invalid %sGetDocumentPreviewUrlRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SearchDocumentsRequestValidationError{}
+var _ error = GetDocumentPreviewUrlRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2167,80 +2326,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SearchDocumentsRequestValidationError{}
+} = GetDocumentPreviewUrlRequestValidationError{}
 
-// Validate checks the field values on SearchDocumentsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetDocumentPreviewUrlResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SearchDocumentsResponse) Validate() error {
+func (m *GetDocumentPreviewUrlResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SearchDocumentsResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SearchDocumentsResponseMultiError, or nil if none found.
-func (m *SearchDocumentsResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetDocumentPreviewUrlResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetDocumentPreviewUrlResponseMultiError, or nil if none found.
+func (m *GetDocumentPreviewUrlResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SearchDocumentsResponse) validate(all bool) error {
+func (m *GetDocumentPreviewUrlResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetDocuments() {
-		_, _ = idx, item
+	// no validation rules for Url
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SearchDocumentsResponseValidationError{
-						field:  fmt.Sprintf("Documents[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SearchDocumentsResponseValidationError{
-						field:  fmt.Sprintf("Documents[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDocumentPreviewUrlResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return SearchDocumentsResponseValidationError{
-					field:  fmt.Sprintf("Documents[%v]", idx),
+				errors = append(errors, GetDocumentPreviewUrlResponseValidationError{
+					field:  "ExpiresAt",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDocumentPreviewUrlResponseValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for Total
-
 	if len(errors) > 0 {
-		return SearchDocumentsResponseMultiError(errors)
+		return GetDocumentPreviewUrlResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SearchDocumentsResponseMultiError is an error wrapping multiple validation
-// errors returned by SearchDocumentsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type SearchDocumentsResponseMultiError []error
+// GetDocumentPreviewUrlResponseMultiError is an error wrapping multiple
+// validation errors returned by GetDocumentPreviewUrlResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetDocumentPreviewUrlResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SearchDocumentsResponseMultiError) Error() string {
+func (m GetDocumentPreviewUrlResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2249,11 +2403,12 @@ func (m SearchDocumentsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SearchDocumentsResponseMultiError) AllErrors() []error { return m }
+func (m GetDocumentPreviewUrlResponseMultiError) AllErrors() []error { return m }
 
-// SearchDocumentsResponseValidationError is the validation error returned by
-// SearchDocumentsResponse.Validate if the designated constraints aren't met.
-type SearchDocumentsResponseValidationError struct {
+// GetDocumentPreviewUrlResponseValidationError is the validation error
+// returned by GetDocumentPreviewUrlResponse.Validate if the designated
+// constraints aren't met.
+type GetDocumentPreviewUrlResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2261,24 +2416,24 @@ type SearchDocumentsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SearchDocumentsResponseValidationError) Field() string { return e.field }
+func (e GetDocumentPreviewUrlResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SearchDocumentsResponseValidationError) Reason() string { return e.reason }
+func (e GetDocumentPreviewUrlResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SearchDocumentsResponseValidationError) Cause() error { return e.cause }
+func (e GetDocumentPreviewUrlResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SearchDocumentsResponseValidationError) Key() bool { return e.key }
+func (e GetDocumentPreviewUrlResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SearchDocumentsResponseValidationError) ErrorName() string {
-	return "SearchDocumentsResponseValidationError"
+func (e GetDocumentPreviewUrlResponseValidationError) ErrorName() string {
+	return "GetDocumentPreviewUrlResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SearchDocumentsResponseValidationError) Error() string {
+func (e GetDocumentPreviewUrlResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2290,14 +2445,14 @@ func (e SearchDocumentsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSearchDocumentsResponse.%s: %s%s",
+		"invalid %sGetDocumentPreviewUrlResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SearchDocumentsResponseValidationError{}
+var _ error = GetDocumentPreviewUrlResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2305,46 +2460,72 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SearchDocumentsResponseValidationError{}
+} = GetDocumentPreviewUrlResponseValidationError{}
 
-// Validate checks the field values on BatchDeleteDocumentsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on SearchDocumentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *BatchDeleteDocumentsRequest) Validate() error {
+func (m *SearchDocumentsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BatchDeleteDocumentsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SearchDocumentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// BatchDeleteDocumentsRequestMultiError, or nil if none found.
-func (m *BatchDeleteDocumentsRequest) ValidateAll() error {
+// SearchDocumentsRequestMultiError, or nil if none found.
+func (m *SearchDocumentsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BatchDeleteDocumentsRequest) validate(all bool) error {
+func (m *SearchDocumentsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Permanent
+	// no validation rules for Query
+
+	// no validation rules for IncludeSubcategories
+
+	// no validation rules for Tags
+
+	// no validation rules for ConsistentRead
+
+	if m.CategoryId != nil {
+		// no validation rules for CategoryId
+	}
+
+	if m.Page != nil {
+		// no validation rules for Page
+	}
+
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
+
+	if m.Status != nil {
+		// no validation rules for Status
+	}
+
+	if m.MimeTypeFilter != nil {
+		// no validation rules for MimeTypeFilter
+	}
 
 	if len(errors) > 0 {
-		return BatchDeleteDocumentsRequestMultiError(errors)
+		return SearchDocumentsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// BatchDeleteDocumentsRequestMultiError is an error wrapping multiple
-// validation errors returned by BatchDeleteDocumentsRequest.ValidateAll() if
-// the designated constraints aren't met.
-type BatchDeleteDocumentsRequestMultiError []error
+// SearchDocumentsRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchDocumentsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchDocumentsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BatchDeleteDocumentsRequestMultiError) Error() string {
+func (m SearchDocumentsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2353,12 +2534,11 @@ func (m BatchDeleteDocumentsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BatchDeleteDocumentsRequestMultiError) AllErrors() []error { return m }
+func (m SearchDocumentsRequestMultiError) AllErrors() []error { return m }
 
-// BatchDeleteDocumentsRequestValidationError is the validation error returned
-// by BatchDeleteDocumentsRequest.Validate if the designated constraints
-// aren't met.
-type BatchDeleteDocumentsRequestValidationError struct {
+// SearchDocumentsRequestValidationError is the validation error returned by
+// SearchDocumentsRequest.Validate if the designated constraints aren't met.
+type SearchDocumentsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2366,24 +2546,24 @@ type BatchDeleteDocumentsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e BatchDeleteDocumentsRequestValidationError) Field() string { return e.field }
+func (e SearchDocumentsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BatchDeleteDocumentsRequestValidationError) Reason() string { return e.reason }
+func (e SearchDocumentsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BatchDeleteDocumentsRequestValidationError) Cause() error { return e.cause }
+func (e SearchDocumentsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BatchDeleteDocumentsRequestValidationError) Key() bool { return e.key }
+func (e SearchDocumentsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BatchDeleteDocumentsRequestValidationError) ErrorName() string {
-	return "BatchDeleteDocumentsRequestValidationError"
+func (e SearchDocumentsRequestValidationError) ErrorName() string {
+	return "SearchDocumentsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e BatchDeleteDocumentsRequestValidationError) Error() string {
+func (e SearchDocumentsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2395,14 +2575,14 @@ func (e BatchDeleteDocumentsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBatchDeleteDocumentsRequest.%s: %s%s",
+		"invalid %sSearchDocumentsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BatchDeleteDocumentsRequestValidationError{}
+var _ error = SearchDocumentsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2410,46 +2590,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BatchDeleteDocumentsRequestValidationError{}
+} = SearchDocumentsRequestValidationError{}
 
-// Validate checks the field values on BatchDeleteDocumentsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on SearchDocumentsResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *BatchDeleteDocumentsResponse) Validate() error {
+func (m *SearchDocumentsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BatchDeleteDocumentsResponse with the
+// ValidateAll checks the field values on SearchDocumentsResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// BatchDeleteDocumentsResponseMultiError, or nil if none found.
-func (m *BatchDeleteDocumentsResponse) ValidateAll() error {
+// SearchDocumentsResponseMultiError, or nil if none found.
+func (m *SearchDocumentsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BatchDeleteDocumentsResponse) validate(all bool) error {
+func (m *SearchDocumentsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DeletedCount
+	for idx, item := range m.GetDocuments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchDocumentsResponseValidationError{
+						field:  fmt.Sprintf("Documents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchDocumentsResponseValidationError{
+						field:  fmt.Sprintf("Documents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchDocumentsResponseValidationError{
+					field:  fmt.Sprintf("Documents[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
 
 	if len(errors) > 0 {
-		return BatchDeleteDocumentsResponseMultiError(errors)
+		return SearchDocumentsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// BatchDeleteDocumentsResponseMultiError is an error wrapping multiple
-// validation errors returned by BatchDeleteDocumentsResponse.ValidateAll() if
-// the designated constraints aren't met.
-type BatchDeleteDocumentsResponseMultiError []error
+// SearchDocumentsResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchDocumentsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchDocumentsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BatchDeleteDocumentsResponseMultiError) Error() string {
+func (m SearchDocumentsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2458,12 +2672,11 @@ func (m BatchDeleteDocumentsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BatchDeleteDocumentsResponseMultiError) AllErrors() []error { return m }
+func (m SearchDocumentsResponseMultiError) AllErrors() []error { return m }
 
-// BatchDeleteDocumentsResponseValidationError is the validation error returned
-// by BatchDeleteDocumentsResponse.Validate if the designated constraints
-// aren't met.
-type BatchDeleteDocumentsResponseValidationError struct {
+// SearchDocumentsResponseValidationError is the validation error returned by
+// SearchDocumentsResponse.Validate if the designated constraints aren't met.
+type SearchDocumentsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2471,24 +2684,24 @@ type BatchDeleteDocumentsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e BatchDeleteDocumentsResponseValidationError) Field() string { return e.field }
+func (e SearchDocumentsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BatchDeleteDocumentsResponseValidationError) Reason() string { return e.reason }
+func (e SearchDocumentsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BatchDeleteDocumentsResponseValidationError) Cause() error { return e.cause }
+func (e SearchDocumentsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BatchDeleteDocumentsResponseValidationError) Key() bool { return e.key }
+func (e SearchDocumentsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BatchDeleteDocumentsResponseValidationError) ErrorName() string {
-	return "BatchDeleteDocumentsResponseValidationError"
+func (e SearchDocumentsResponseValidationError) ErrorName() string {
+	return "SearchDocumentsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e BatchDeleteDocumentsResponseValidationError) Error() string {
+func (e SearchDocumentsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2500,14 +2713,14 @@ func (e BatchDeleteDocumentsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBatchDeleteDocumentsResponse.%s: %s%s",
+		"invalid %sSearchDocumentsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BatchDeleteDocumentsResponseValidationError{}
+var _ error = SearchDocumentsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2515,4 +2728,1841 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BatchDeleteDocumentsResponseValidationError{}
+} = SearchDocumentsResponseValidationError{}
+
+// Validate checks the field values on BatchDeleteDocumentsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchDeleteDocumentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchDeleteDocumentsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchDeleteDocumentsRequestMultiError, or nil if none found.
+func (m *BatchDeleteDocumentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchDeleteDocumentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Permanent
+
+	if len(errors) > 0 {
+		return BatchDeleteDocumentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchDeleteDocumentsRequestMultiError is an error wrapping multiple
+// validation errors returned by BatchDeleteDocumentsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type BatchDeleteDocumentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchDeleteDocumentsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchDeleteDocumentsRequestMultiError) AllErrors() []error { return m }
+
+// BatchDeleteDocumentsRequestValidationError is the validation error returned
+// by BatchDeleteDocumentsRequest.Validate if the designated constraints
+// aren't met.
+type BatchDeleteDocumentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchDeleteDocumentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchDeleteDocumentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchDeleteDocumentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchDeleteDocumentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchDeleteDocumentsRequestValidationError) ErrorName() string {
+	return "BatchDeleteDocumentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchDeleteDocumentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchDeleteDocumentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchDeleteDocumentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchDeleteDocumentsRequestValidationError{}
+
+// Validate checks the field values on BatchDeleteDocumentsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchDeleteDocumentsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchDeleteDocumentsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchDeleteDocumentsResponseMultiError, or nil if none found.
+func (m *BatchDeleteDocumentsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchDeleteDocumentsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeletedCount
+
+	if len(errors) > 0 {
+		return BatchDeleteDocumentsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchDeleteDocumentsResponseMultiError is an error wrapping multiple
+// validation errors returned by BatchDeleteDocumentsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type BatchDeleteDocumentsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchDeleteDocumentsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchDeleteDocumentsResponseMultiError) AllErrors() []error { return m }
+
+// BatchDeleteDocumentsResponseValidationError is the validation error returned
+// by BatchDeleteDocumentsResponse.Validate if the designated constraints
+// aren't met.
+type BatchDeleteDocumentsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchDeleteDocumentsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchDeleteDocumentsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchDeleteDocumentsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchDeleteDocumentsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchDeleteDocumentsResponseValidationError) ErrorName() string {
+	return "BatchDeleteDocumentsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchDeleteDocumentsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchDeleteDocumentsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchDeleteDocumentsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchDeleteDocumentsResponseValidationError{}
+
+// Validate checks the field values on DocumentSnapshot with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DocumentSnapshot) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DocumentSnapshot with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DocumentSnapshotMultiError, or nil if none found.
+func (m *DocumentSnapshot) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DocumentSnapshot) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for Status
+
+	// no validation rules for Tags
+
+	// no validation rules for DocumentType
+
+	// no validation rules for RetentionClass
+
+	if m.CategoryId != nil {
+		// no validation rules for CategoryId
+	}
+
+	if m.DueDate != nil {
+
+		if all {
+			switch v := interface{}(m.GetDueDate()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DocumentSnapshotValidationError{
+						field:  "DueDate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DocumentSnapshotValidationError{
+						field:  "DueDate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDueDate()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DocumentSnapshotValidationError{
+					field:  "DueDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DocumentSnapshotMultiError(errors)
+	}
+
+	return nil
+}
+
+// DocumentSnapshotMultiError is an error wrapping multiple validation errors
+// returned by DocumentSnapshot.ValidateAll() if the designated constraints
+// aren't met.
+type DocumentSnapshotMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DocumentSnapshotMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DocumentSnapshotMultiError) AllErrors() []error { return m }
+
+// DocumentSnapshotValidationError is the validation error returned by
+// DocumentSnapshot.Validate if the designated constraints aren't met.
+type DocumentSnapshotValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DocumentSnapshotValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DocumentSnapshotValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DocumentSnapshotValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DocumentSnapshotValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DocumentSnapshotValidationError) ErrorName() string { return "DocumentSnapshotValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DocumentSnapshotValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDocumentSnapshot.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DocumentSnapshotValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DocumentSnapshotValidationError{}
+
+// Validate checks the field values on DocumentHistoryEntry with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DocumentHistoryEntry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DocumentHistoryEntry with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DocumentHistoryEntryMultiError, or nil if none found.
+func (m *DocumentHistoryEntry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DocumentHistoryEntry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for DocumentId
+
+	// no validation rules for UserId
+
+	// no validation rules for Version
+
+	if all {
+		switch v := interface{}(m.GetBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DocumentHistoryEntryValidationError{
+					field:  "Before",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DocumentHistoryEntryValidationError{
+					field:  "Before",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DocumentHistoryEntryValidationError{
+				field:  "Before",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DocumentHistoryEntryValidationError{
+					field:  "After",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DocumentHistoryEntryValidationError{
+					field:  "After",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DocumentHistoryEntryValidationError{
+				field:  "After",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DocumentHistoryEntryValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DocumentHistoryEntryValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DocumentHistoryEntryValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DocumentHistoryEntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// DocumentHistoryEntryMultiError is an error wrapping multiple validation
+// errors returned by DocumentHistoryEntry.ValidateAll() if the designated
+// constraints aren't met.
+type DocumentHistoryEntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DocumentHistoryEntryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DocumentHistoryEntryMultiError) AllErrors() []error { return m }
+
+// DocumentHistoryEntryValidationError is the validation error returned by
+// DocumentHistoryEntry.Validate if the designated constraints aren't met.
+type DocumentHistoryEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DocumentHistoryEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DocumentHistoryEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DocumentHistoryEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DocumentHistoryEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DocumentHistoryEntryValidationError) ErrorName() string {
+	return "DocumentHistoryEntryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DocumentHistoryEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDocumentHistoryEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DocumentHistoryEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DocumentHistoryEntryValidationError{}
+
+// Validate checks the field values on GetDocumentHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDocumentHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDocumentHistoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDocumentHistoryRequestMultiError, or nil if none found.
+func (m *GetDocumentHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDocumentHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.Page != nil {
+		// no validation rules for Page
+	}
+
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
+
+	if len(errors) > 0 {
+		return GetDocumentHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDocumentHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by GetDocumentHistoryRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetDocumentHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDocumentHistoryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDocumentHistoryRequestMultiError) AllErrors() []error { return m }
+
+// GetDocumentHistoryRequestValidationError is the validation error returned by
+// GetDocumentHistoryRequest.Validate if the designated constraints aren't met.
+type GetDocumentHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDocumentHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDocumentHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDocumentHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDocumentHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDocumentHistoryRequestValidationError) ErrorName() string {
+	return "GetDocumentHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDocumentHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDocumentHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDocumentHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDocumentHistoryRequestValidationError{}
+
+// Validate checks the field values on GetDocumentHistoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDocumentHistoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDocumentHistoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDocumentHistoryResponseMultiError, or nil if none found.
+func (m *GetDocumentHistoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDocumentHistoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEntries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetDocumentHistoryResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetDocumentHistoryResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetDocumentHistoryResponseValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return GetDocumentHistoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDocumentHistoryResponseMultiError is an error wrapping multiple
+// validation errors returned by GetDocumentHistoryResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetDocumentHistoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDocumentHistoryResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDocumentHistoryResponseMultiError) AllErrors() []error { return m }
+
+// GetDocumentHistoryResponseValidationError is the validation error returned
+// by GetDocumentHistoryResponse.Validate if the designated constraints aren't met.
+type GetDocumentHistoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDocumentHistoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDocumentHistoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDocumentHistoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDocumentHistoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDocumentHistoryResponseValidationError) ErrorName() string {
+	return "GetDocumentHistoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDocumentHistoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDocumentHistoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDocumentHistoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDocumentHistoryResponseValidationError{}
+
+// Validate checks the field values on ListDocumentsDueSoonRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDocumentsDueSoonRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDocumentsDueSoonRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDocumentsDueSoonRequestMultiError, or nil if none found.
+func (m *ListDocumentsDueSoonRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDocumentsDueSoonRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IncludeOverdue
+
+	// no validation rules for IncludeSubcategories
+
+	if m.WithinDays != nil {
+		// no validation rules for WithinDays
+	}
+
+	if m.CategoryId != nil {
+		// no validation rules for CategoryId
+	}
+
+	if m.Page != nil {
+		// no validation rules for Page
+	}
+
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
+
+	if len(errors) > 0 {
+		return ListDocumentsDueSoonRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDocumentsDueSoonRequestMultiError is an error wrapping multiple
+// validation errors returned by ListDocumentsDueSoonRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListDocumentsDueSoonRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDocumentsDueSoonRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDocumentsDueSoonRequestMultiError) AllErrors() []error { return m }
+
+// ListDocumentsDueSoonRequestValidationError is the validation error returned
+// by ListDocumentsDueSoonRequest.Validate if the designated constraints
+// aren't met.
+type ListDocumentsDueSoonRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDocumentsDueSoonRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDocumentsDueSoonRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDocumentsDueSoonRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDocumentsDueSoonRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDocumentsDueSoonRequestValidationError) ErrorName() string {
+	return "ListDocumentsDueSoonRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDocumentsDueSoonRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDocumentsDueSoonRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDocumentsDueSoonRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDocumentsDueSoonRequestValidationError{}
+
+// Validate checks the field values on ListDocumentsDueSoonResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDocumentsDueSoonResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDocumentsDueSoonResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDocumentsDueSoonResponseMultiError, or nil if none found.
+func (m *ListDocumentsDueSoonResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDocumentsDueSoonResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDocuments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListDocumentsDueSoonResponseValidationError{
+						field:  fmt.Sprintf("Documents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListDocumentsDueSoonResponseValidationError{
+						field:  fmt.Sprintf("Documents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListDocumentsDueSoonResponseValidationError{
+					field:  fmt.Sprintf("Documents[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListDocumentsDueSoonResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDocumentsDueSoonResponseMultiError is an error wrapping multiple
+// validation errors returned by ListDocumentsDueSoonResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListDocumentsDueSoonResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDocumentsDueSoonResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDocumentsDueSoonResponseMultiError) AllErrors() []error { return m }
+
+// ListDocumentsDueSoonResponseValidationError is the validation error returned
+// by ListDocumentsDueSoonResponse.Validate if the designated constraints
+// aren't met.
+type ListDocumentsDueSoonResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDocumentsDueSoonResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDocumentsDueSoonResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDocumentsDueSoonResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDocumentsDueSoonResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDocumentsDueSoonResponseValidationError) ErrorName() string {
+	return "ListDocumentsDueSoonResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDocumentsDueSoonResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDocumentsDueSoonResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDocumentsDueSoonResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDocumentsDueSoonResponseValidationError{}
+
+// Validate checks the field values on AssignDocumentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AssignDocumentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AssignDocumentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AssignDocumentRequestMultiError, or nil if none found.
+func (m *AssignDocumentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AssignDocumentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Note
+
+	if m.AssigneeId != nil {
+		// no validation rules for AssigneeId
+	}
+
+	if m.ExpectedVersion != nil {
+		// no validation rules for ExpectedVersion
+	}
+
+	if len(errors) > 0 {
+		return AssignDocumentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AssignDocumentRequestMultiError is an error wrapping multiple validation
+// errors returned by AssignDocumentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AssignDocumentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AssignDocumentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AssignDocumentRequestMultiError) AllErrors() []error { return m }
+
+// AssignDocumentRequestValidationError is the validation error returned by
+// AssignDocumentRequest.Validate if the designated constraints aren't met.
+type AssignDocumentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AssignDocumentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AssignDocumentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AssignDocumentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AssignDocumentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AssignDocumentRequestValidationError) ErrorName() string {
+	return "AssignDocumentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AssignDocumentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAssignDocumentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AssignDocumentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AssignDocumentRequestValidationError{}
+
+// Validate checks the field values on AssignDocumentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AssignDocumentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AssignDocumentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AssignDocumentResponseMultiError, or nil if none found.
+func (m *AssignDocumentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AssignDocumentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDocument()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AssignDocumentResponseValidationError{
+					field:  "Document",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AssignDocumentResponseValidationError{
+					field:  "Document",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDocument()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AssignDocumentResponseValidationError{
+				field:  "Document",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AssignDocumentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AssignDocumentResponseMultiError is an error wrapping multiple validation
+// errors returned by AssignDocumentResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AssignDocumentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AssignDocumentResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AssignDocumentResponseMultiError) AllErrors() []error { return m }
+
+// AssignDocumentResponseValidationError is the validation error returned by
+// AssignDocumentResponse.Validate if the designated constraints aren't met.
+type AssignDocumentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AssignDocumentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AssignDocumentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AssignDocumentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AssignDocumentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AssignDocumentResponseValidationError) ErrorName() string {
+	return "AssignDocumentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AssignDocumentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAssignDocumentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AssignDocumentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AssignDocumentResponseValidationError{}
+
+// Validate checks the field values on ListMyInboxRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMyInboxRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMyInboxRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMyInboxRequestMultiError, or nil if none found.
+func (m *ListMyInboxRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMyInboxRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Page != nil {
+		// no validation rules for Page
+	}
+
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
+
+	if len(errors) > 0 {
+		return ListMyInboxRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMyInboxRequestMultiError is an error wrapping multiple validation errors
+// returned by ListMyInboxRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListMyInboxRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMyInboxRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMyInboxRequestMultiError) AllErrors() []error { return m }
+
+// ListMyInboxRequestValidationError is the validation error returned by
+// ListMyInboxRequest.Validate if the designated constraints aren't met.
+type ListMyInboxRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMyInboxRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMyInboxRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMyInboxRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMyInboxRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMyInboxRequestValidationError) ErrorName() string {
+	return "ListMyInboxRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMyInboxRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMyInboxRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMyInboxRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMyInboxRequestValidationError{}
+
+// Validate checks the field values on ListMyInboxResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMyInboxResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMyInboxResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMyInboxResponseMultiError, or nil if none found.
+func (m *ListMyInboxResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMyInboxResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDocuments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMyInboxResponseValidationError{
+						field:  fmt.Sprintf("Documents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMyInboxResponseValidationError{
+						field:  fmt.Sprintf("Documents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMyInboxResponseValidationError{
+					field:  fmt.Sprintf("Documents[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListMyInboxResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMyInboxResponseMultiError is an error wrapping multiple validation
+// errors returned by ListMyInboxResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListMyInboxResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMyInboxResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMyInboxResponseMultiError) AllErrors() []error { return m }
+
+// ListMyInboxResponseValidationError is the validation error returned by
+// ListMyInboxResponse.Validate if the designated constraints aren't met.
+type ListMyInboxResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMyInboxResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMyInboxResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMyInboxResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMyInboxResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMyInboxResponseValidationError) ErrorName() string {
+	return "ListMyInboxResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMyInboxResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMyInboxResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMyInboxResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMyInboxResponseValidationError{}
+
+// Validate checks the field values on WatchDocumentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchDocumentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchDocumentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchDocumentsRequestMultiError, or nil if none found.
+func (m *WatchDocumentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchDocumentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IncludeSubcategories
+
+	// no validation rules for Tags
+
+	if m.CategoryId != nil {
+		// no validation rules for CategoryId
+	}
+
+	if len(errors) > 0 {
+		return WatchDocumentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchDocumentsRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchDocumentsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchDocumentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchDocumentsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchDocumentsRequestMultiError) AllErrors() []error { return m }
+
+// WatchDocumentsRequestValidationError is the validation error returned by
+// WatchDocumentsRequest.Validate if the designated constraints aren't met.
+type WatchDocumentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchDocumentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchDocumentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchDocumentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchDocumentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchDocumentsRequestValidationError) ErrorName() string {
+	return "WatchDocumentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchDocumentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchDocumentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchDocumentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchDocumentsRequestValidationError{}
+
+// Validate checks the field values on DocumentChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DocumentChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DocumentChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DocumentChangeMultiError,
+// or nil if none found.
+func (m *DocumentChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DocumentChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for DocumentId
+
+	if all {
+		switch v := interface{}(m.GetDocument()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DocumentChangeValidationError{
+					field:  "Document",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DocumentChangeValidationError{
+					field:  "Document",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDocument()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DocumentChangeValidationError{
+				field:  "Document",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetOccurTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DocumentChangeValidationError{
+					field:  "OccurTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DocumentChangeValidationError{
+					field:  "OccurTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOccurTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DocumentChangeValidationError{
+				field:  "OccurTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.PreviousCategoryId != nil {
+		// no validation rules for PreviousCategoryId
+	}
+
+	if len(errors) > 0 {
+		return DocumentChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// DocumentChangeMultiError is an error wrapping multiple validation errors
+// returned by DocumentChange.ValidateAll() if the designated constraints
+// aren't met.
+type DocumentChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DocumentChangeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DocumentChangeMultiError) AllErrors() []error { return m }
+
+// DocumentChangeValidationError is the validation error returned by
+// DocumentChange.Validate if the designated constraints aren't met.
+type DocumentChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DocumentChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DocumentChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DocumentChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DocumentChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DocumentChangeValidationError) ErrorName() string { return "DocumentChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DocumentChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDocumentChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DocumentChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DocumentChangeValidationError{}
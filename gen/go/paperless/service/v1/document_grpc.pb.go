@@ -28,8 +28,14 @@ const (
 	PaperlessDocumentService_MoveDocument_FullMethodName           = "/paperless.service.v1.PaperlessDocumentService/MoveDocument"
 	PaperlessDocumentService_DownloadDocument_FullMethodName       = "/paperless.service.v1.PaperlessDocumentService/DownloadDocument"
 	PaperlessDocumentService_GetDocumentDownloadUrl_FullMethodName = "/paperless.service.v1.PaperlessDocumentService/GetDocumentDownloadUrl"
+	PaperlessDocumentService_GetDocumentPreviewUrl_FullMethodName  = "/paperless.service.v1.PaperlessDocumentService/GetDocumentPreviewUrl"
 	PaperlessDocumentService_SearchDocuments_FullMethodName        = "/paperless.service.v1.PaperlessDocumentService/SearchDocuments"
 	PaperlessDocumentService_BatchDeleteDocuments_FullMethodName   = "/paperless.service.v1.PaperlessDocumentService/BatchDeleteDocuments"
+	PaperlessDocumentService_GetDocumentHistory_FullMethodName     = "/paperless.service.v1.PaperlessDocumentService/GetDocumentHistory"
+	PaperlessDocumentService_ListDocumentsDueSoon_FullMethodName   = "/paperless.service.v1.PaperlessDocumentService/ListDocumentsDueSoon"
+	PaperlessDocumentService_AssignDocument_FullMethodName         = "/paperless.service.v1.PaperlessDocumentService/AssignDocument"
+	PaperlessDocumentService_ListMyInbox_FullMethodName            = "/paperless.service.v1.PaperlessDocumentService/ListMyInbox"
+	PaperlessDocumentService_WatchDocuments_FullMethodName         = "/paperless.service.v1.PaperlessDocumentService/WatchDocuments"
 )
 
 // PaperlessDocumentServiceClient is the client API for PaperlessDocumentService service.
@@ -54,10 +60,22 @@ type PaperlessDocumentServiceClient interface {
 	DownloadDocument(ctx context.Context, in *DownloadDocumentRequest, opts ...grpc.CallOption) (*DownloadDocumentResponse, error)
 	// Get document download URL (presigned URL)
 	GetDocumentDownloadUrl(ctx context.Context, in *GetDocumentDownloadUrlRequest, opts ...grpc.CallOption) (*GetDocumentDownloadUrlResponse, error)
+	// Get a presigned URL of a document's PDF preview, converted on first use
+	GetDocumentPreviewUrl(ctx context.Context, in *GetDocumentPreviewUrlRequest, opts ...grpc.CallOption) (*GetDocumentPreviewUrlResponse, error)
 	// Search documents across categories
 	SearchDocuments(ctx context.Context, in *SearchDocumentsRequest, opts ...grpc.CallOption) (*SearchDocumentsResponse, error)
 	// Batch delete documents
 	BatchDeleteDocuments(ctx context.Context, in *BatchDeleteDocumentsRequest, opts ...grpc.CallOption) (*BatchDeleteDocumentsResponse, error)
+	// Lists the metadata changes of a document with the values before and after each, newest first
+	GetDocumentHistory(ctx context.Context, in *GetDocumentHistoryRequest, opts ...grpc.CallOption) (*GetDocumentHistoryResponse, error)
+	// Lists readable active documents due within the given number of days, soonest first
+	ListDocumentsDueSoon(ctx context.Context, in *ListDocumentsDueSoonRequest, opts ...grpc.CallOption) (*ListDocumentsDueSoonResponse, error)
+	// Assigns a document to a user for action, or unassigns it
+	AssignDocument(ctx context.Context, in *AssignDocumentRequest, opts ...grpc.CallOption) (*AssignDocumentResponse, error)
+	// Lists the active documents assigned to the caller, most recently assigned first
+	ListMyInbox(ctx context.Context, in *ListMyInboxRequest, opts ...grpc.CallOption) (*ListMyInboxResponse, error)
+	// Streams changes to documents the caller can read as they happen; gRPC only
+	WatchDocuments(ctx context.Context, in *WatchDocumentsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DocumentChange], error)
 }
 
 type paperlessDocumentServiceClient struct {
@@ -148,6 +166,16 @@ func (c *paperlessDocumentServiceClient) GetDocumentDownloadUrl(ctx context.Cont
 	return out, nil
 }
 
+func (c *paperlessDocumentServiceClient) GetDocumentPreviewUrl(ctx context.Context, in *GetDocumentPreviewUrlRequest, opts ...grpc.CallOption) (*GetDocumentPreviewUrlResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDocumentPreviewUrlResponse)
+	err := c.cc.Invoke(ctx, PaperlessDocumentService_GetDocumentPreviewUrl_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *paperlessDocumentServiceClient) SearchDocuments(ctx context.Context, in *SearchDocumentsRequest, opts ...grpc.CallOption) (*SearchDocumentsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SearchDocumentsResponse)
@@ -168,6 +196,65 @@ func (c *paperlessDocumentServiceClient) BatchDeleteDocuments(ctx context.Contex
 	return out, nil
 }
 
+func (c *paperlessDocumentServiceClient) GetDocumentHistory(ctx context.Context, in *GetDocumentHistoryRequest, opts ...grpc.CallOption) (*GetDocumentHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDocumentHistoryResponse)
+	err := c.cc.Invoke(ctx, PaperlessDocumentService_GetDocumentHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paperlessDocumentServiceClient) ListDocumentsDueSoon(ctx context.Context, in *ListDocumentsDueSoonRequest, opts ...grpc.CallOption) (*ListDocumentsDueSoonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDocumentsDueSoonResponse)
+	err := c.cc.Invoke(ctx, PaperlessDocumentService_ListDocumentsDueSoon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paperlessDocumentServiceClient) AssignDocument(ctx context.Context, in *AssignDocumentRequest, opts ...grpc.CallOption) (*AssignDocumentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignDocumentResponse)
+	err := c.cc.Invoke(ctx, PaperlessDocumentService_AssignDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paperlessDocumentServiceClient) ListMyInbox(ctx context.Context, in *ListMyInboxRequest, opts ...grpc.CallOption) (*ListMyInboxResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyInboxResponse)
+	err := c.cc.Invoke(ctx, PaperlessDocumentService_ListMyInbox_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paperlessDocumentServiceClient) WatchDocuments(ctx context.Context, in *WatchDocumentsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DocumentChange], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PaperlessDocumentService_ServiceDesc.Streams[0], PaperlessDocumentService_WatchDocuments_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchDocumentsRequest, DocumentChange]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PaperlessDocumentService_WatchDocumentsClient = grpc.ServerStreamingClient[DocumentChange]
+
 // PaperlessDocumentServiceServer is the server API for PaperlessDocumentService service.
 // All implementations must embed UnimplementedPaperlessDocumentServiceServer
 // for forward compatibility.
@@ -190,10 +277,22 @@ type PaperlessDocumentServiceServer interface {
 	DownloadDocument(context.Context, *DownloadDocumentRequest) (*DownloadDocumentResponse, error)
 	// Get document download URL (presigned URL)
 	GetDocumentDownloadUrl(context.Context, *GetDocumentDownloadUrlRequest) (*GetDocumentDownloadUrlResponse, error)
+	// Get a presigned URL of a document's PDF preview, converted on first use
+	GetDocumentPreviewUrl(context.Context, *GetDocumentPreviewUrlRequest) (*GetDocumentPreviewUrlResponse, error)
 	// Search documents across categories
 	SearchDocuments(context.Context, *SearchDocumentsRequest) (*SearchDocumentsResponse, error)
 	// Batch delete documents
 	BatchDeleteDocuments(context.Context, *BatchDeleteDocumentsRequest) (*BatchDeleteDocumentsResponse, error)
+	// Lists the metadata changes of a document with the values before and after each, newest first
+	GetDocumentHistory(context.Context, *GetDocumentHistoryRequest) (*GetDocumentHistoryResponse, error)
+	// Lists readable active documents due within the given number of days, soonest first
+	ListDocumentsDueSoon(context.Context, *ListDocumentsDueSoonRequest) (*ListDocumentsDueSoonResponse, error)
+	// Assigns a document to a user for action, or unassigns it
+	AssignDocument(context.Context, *AssignDocumentRequest) (*AssignDocumentResponse, error)
+	// Lists the active documents assigned to the caller, most recently assigned first
+	ListMyInbox(context.Context, *ListMyInboxRequest) (*ListMyInboxResponse, error)
+	// Streams changes to documents the caller can read as they happen; gRPC only
+	WatchDocuments(*WatchDocumentsRequest, grpc.ServerStreamingServer[DocumentChange]) error
 	mustEmbedUnimplementedPaperlessDocumentServiceServer()
 }
 
@@ -228,12 +327,30 @@ func (UnimplementedPaperlessDocumentServiceServer) DownloadDocument(context.Cont
 func (UnimplementedPaperlessDocumentServiceServer) GetDocumentDownloadUrl(context.Context, *GetDocumentDownloadUrlRequest) (*GetDocumentDownloadUrlResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetDocumentDownloadUrl not implemented")
 }
+func (UnimplementedPaperlessDocumentServiceServer) GetDocumentPreviewUrl(context.Context, *GetDocumentPreviewUrlRequest) (*GetDocumentPreviewUrlResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDocumentPreviewUrl not implemented")
+}
 func (UnimplementedPaperlessDocumentServiceServer) SearchDocuments(context.Context, *SearchDocumentsRequest) (*SearchDocumentsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SearchDocuments not implemented")
 }
 func (UnimplementedPaperlessDocumentServiceServer) BatchDeleteDocuments(context.Context, *BatchDeleteDocumentsRequest) (*BatchDeleteDocumentsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method BatchDeleteDocuments not implemented")
 }
+func (UnimplementedPaperlessDocumentServiceServer) GetDocumentHistory(context.Context, *GetDocumentHistoryRequest) (*GetDocumentHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDocumentHistory not implemented")
+}
+func (UnimplementedPaperlessDocumentServiceServer) ListDocumentsDueSoon(context.Context, *ListDocumentsDueSoonRequest) (*ListDocumentsDueSoonResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDocumentsDueSoon not implemented")
+}
+func (UnimplementedPaperlessDocumentServiceServer) AssignDocument(context.Context, *AssignDocumentRequest) (*AssignDocumentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AssignDocument not implemented")
+}
+func (UnimplementedPaperlessDocumentServiceServer) ListMyInbox(context.Context, *ListMyInboxRequest) (*ListMyInboxResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMyInbox not implemented")
+}
+func (UnimplementedPaperlessDocumentServiceServer) WatchDocuments(*WatchDocumentsRequest, grpc.ServerStreamingServer[DocumentChange]) error {
+	return status.Error(codes.Unimplemented, "method WatchDocuments not implemented")
+}
 func (UnimplementedPaperlessDocumentServiceServer) mustEmbedUnimplementedPaperlessDocumentServiceServer() {
 }
 func (UnimplementedPaperlessDocumentServiceServer) testEmbeddedByValue() {}
@@ -400,6 +517,24 @@ func _PaperlessDocumentService_GetDocumentDownloadUrl_Handler(srv interface{}, c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaperlessDocumentService_GetDocumentPreviewUrl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocumentPreviewUrlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaperlessDocumentServiceServer).GetDocumentPreviewUrl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaperlessDocumentService_GetDocumentPreviewUrl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaperlessDocumentServiceServer).GetDocumentPreviewUrl(ctx, req.(*GetDocumentPreviewUrlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PaperlessDocumentService_SearchDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SearchDocumentsRequest)
 	if err := dec(in); err != nil {
@@ -436,6 +571,89 @@ func _PaperlessDocumentService_BatchDeleteDocuments_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaperlessDocumentService_GetDocumentHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocumentHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaperlessDocumentServiceServer).GetDocumentHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaperlessDocumentService_GetDocumentHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaperlessDocumentServiceServer).GetDocumentHistory(ctx, req.(*GetDocumentHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaperlessDocumentService_ListDocumentsDueSoon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDocumentsDueSoonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaperlessDocumentServiceServer).ListDocumentsDueSoon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaperlessDocumentService_ListDocumentsDueSoon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaperlessDocumentServiceServer).ListDocumentsDueSoon(ctx, req.(*ListDocumentsDueSoonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaperlessDocumentService_AssignDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaperlessDocumentServiceServer).AssignDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaperlessDocumentService_AssignDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaperlessDocumentServiceServer).AssignDocument(ctx, req.(*AssignDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaperlessDocumentService_ListMyInbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyInboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaperlessDocumentServiceServer).ListMyInbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaperlessDocumentService_ListMyInbox_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaperlessDocumentServiceServer).ListMyInbox(ctx, req.(*ListMyInboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaperlessDocumentService_WatchDocuments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchDocumentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PaperlessDocumentServiceServer).WatchDocuments(m, &grpc.GenericServerStream[WatchDocumentsRequest, DocumentChange]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PaperlessDocumentService_WatchDocumentsServer = grpc.ServerStreamingServer[DocumentChange]
+
 // PaperlessDocumentService_ServiceDesc is the grpc.ServiceDesc for PaperlessDocumentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -475,6 +693,10 @@ var PaperlessDocumentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDocumentDownloadUrl",
 			Handler:    _PaperlessDocumentService_GetDocumentDownloadUrl_Handler,
 		},
+		{
+			MethodName: "GetDocumentPreviewUrl",
+			Handler:    _PaperlessDocumentService_GetDocumentPreviewUrl_Handler,
+		},
 		{
 			MethodName: "SearchDocuments",
 			Handler:    _PaperlessDocumentService_SearchDocuments_Handler,
@@ -483,7 +705,29 @@ var PaperlessDocumentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BatchDeleteDocuments",
 			Handler:    _PaperlessDocumentService_BatchDeleteDocuments_Handler,
 		},
+		{
+			MethodName: "GetDocumentHistory",
+			Handler:    _PaperlessDocumentService_GetDocumentHistory_Handler,
+		},
+		{
+			MethodName: "ListDocumentsDueSoon",
+			Handler:    _PaperlessDocumentService_ListDocumentsDueSoon_Handler,
+		},
+		{
+			MethodName: "AssignDocument",
+			Handler:    _PaperlessDocumentService_AssignDocument_Handler,
+		},
+		{
+			MethodName: "ListMyInbox",
+			Handler:    _PaperlessDocumentService_ListMyInbox_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchDocuments",
+			Handler:       _PaperlessDocumentService_WatchDocuments_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "paperless/service/v1/document.proto",
 }
@@ -20,18 +20,25 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationPaperlessDocumentServiceAssignDocument = "/paperless.service.v1.PaperlessDocumentService/AssignDocument"
 const OperationPaperlessDocumentServiceBatchDeleteDocuments = "/paperless.service.v1.PaperlessDocumentService/BatchDeleteDocuments"
 const OperationPaperlessDocumentServiceCreateDocument = "/paperless.service.v1.PaperlessDocumentService/CreateDocument"
 const OperationPaperlessDocumentServiceDeleteDocument = "/paperless.service.v1.PaperlessDocumentService/DeleteDocument"
 const OperationPaperlessDocumentServiceDownloadDocument = "/paperless.service.v1.PaperlessDocumentService/DownloadDocument"
 const OperationPaperlessDocumentServiceGetDocument = "/paperless.service.v1.PaperlessDocumentService/GetDocument"
 const OperationPaperlessDocumentServiceGetDocumentDownloadUrl = "/paperless.service.v1.PaperlessDocumentService/GetDocumentDownloadUrl"
+const OperationPaperlessDocumentServiceGetDocumentHistory = "/paperless.service.v1.PaperlessDocumentService/GetDocumentHistory"
+const OperationPaperlessDocumentServiceGetDocumentPreviewUrl = "/paperless.service.v1.PaperlessDocumentService/GetDocumentPreviewUrl"
 const OperationPaperlessDocumentServiceListDocuments = "/paperless.service.v1.PaperlessDocumentService/ListDocuments"
+const OperationPaperlessDocumentServiceListDocumentsDueSoon = "/paperless.service.v1.PaperlessDocumentService/ListDocumentsDueSoon"
+const OperationPaperlessDocumentServiceListMyInbox = "/paperless.service.v1.PaperlessDocumentService/ListMyInbox"
 const OperationPaperlessDocumentServiceMoveDocument = "/paperless.service.v1.PaperlessDocumentService/MoveDocument"
 const OperationPaperlessDocumentServiceSearchDocuments = "/paperless.service.v1.PaperlessDocumentService/SearchDocuments"
 const OperationPaperlessDocumentServiceUpdateDocument = "/paperless.service.v1.PaperlessDocumentService/UpdateDocument"
 
 type PaperlessDocumentServiceHTTPServer interface {
+	// AssignDocument Assigns a document to a user for action, or unassigns it
+	AssignDocument(context.Context, *AssignDocumentRequest) (*AssignDocumentResponse, error)
 	// BatchDeleteDocuments Batch delete documents
 	BatchDeleteDocuments(context.Context, *BatchDeleteDocumentsRequest) (*BatchDeleteDocumentsResponse, error)
 	// CreateDocument Create a new document (upload)
@@ -44,8 +51,16 @@ type PaperlessDocumentServiceHTTPServer interface {
 	GetDocument(context.Context, *GetDocumentRequest) (*GetDocumentResponse, error)
 	// GetDocumentDownloadUrl Get document download URL (presigned URL)
 	GetDocumentDownloadUrl(context.Context, *GetDocumentDownloadUrlRequest) (*GetDocumentDownloadUrlResponse, error)
+	// GetDocumentHistory Lists the metadata changes of a document with the values before and after each, newest first
+	GetDocumentHistory(context.Context, *GetDocumentHistoryRequest) (*GetDocumentHistoryResponse, error)
+	// GetDocumentPreviewUrl Get a presigned URL of a document's PDF preview, converted on first use
+	GetDocumentPreviewUrl(context.Context, *GetDocumentPreviewUrlRequest) (*GetDocumentPreviewUrlResponse, error)
 	// ListDocuments List documents in a category
 	ListDocuments(context.Context, *ListDocumentsRequest) (*ListDocumentsResponse, error)
+	// ListDocumentsDueSoon Lists readable active documents due within the given number of days, soonest first
+	ListDocumentsDueSoon(context.Context, *ListDocumentsDueSoonRequest) (*ListDocumentsDueSoonResponse, error)
+	// ListMyInbox Lists the active documents assigned to the caller, most recently assigned first
+	ListMyInbox(context.Context, *ListMyInboxRequest) (*ListMyInboxResponse, error)
 	// MoveDocument Move document to a different category
 	MoveDocument(context.Context, *MoveDocumentRequest) (*MoveDocumentResponse, error)
 	// SearchDocuments Search documents across categories
@@ -64,8 +79,13 @@ func RegisterPaperlessDocumentServiceHTTPServer(s *http.Server, srv PaperlessDoc
 	r.POST("/v1/documents/{id}/move", _PaperlessDocumentService_MoveDocument0_HTTP_Handler(srv))
 	r.GET("/v1/documents/{id}/download", _PaperlessDocumentService_DownloadDocument0_HTTP_Handler(srv))
 	r.GET("/v1/documents/{id}/download-url", _PaperlessDocumentService_GetDocumentDownloadUrl0_HTTP_Handler(srv))
+	r.GET("/v1/documents/{id}/preview-url", _PaperlessDocumentService_GetDocumentPreviewUrl0_HTTP_Handler(srv))
 	r.GET("/v1/documents/search", _PaperlessDocumentService_SearchDocuments0_HTTP_Handler(srv))
 	r.POST("/v1/documents/batch-delete", _PaperlessDocumentService_BatchDeleteDocuments0_HTTP_Handler(srv))
+	r.GET("/v1/documents/{id}/history", _PaperlessDocumentService_GetDocumentHistory0_HTTP_Handler(srv))
+	r.GET("/v1/documents/due-soon", _PaperlessDocumentService_ListDocumentsDueSoon0_HTTP_Handler(srv))
+	r.POST("/v1/documents/{id}/assign", _PaperlessDocumentService_AssignDocument0_HTTP_Handler(srv))
+	r.GET("/v1/documents/inbox", _PaperlessDocumentService_ListMyInbox0_HTTP_Handler(srv))
 }
 
 func _PaperlessDocumentService_CreateDocument0_HTTP_Handler(srv PaperlessDocumentServiceHTTPServer) func(ctx http.Context) error {
@@ -247,6 +267,28 @@ func _PaperlessDocumentService_GetDocumentDownloadUrl0_HTTP_Handler(srv Paperles
 	}
 }
 
+func _PaperlessDocumentService_GetDocumentPreviewUrl0_HTTP_Handler(srv PaperlessDocumentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetDocumentPreviewUrlRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPaperlessDocumentServiceGetDocumentPreviewUrl)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetDocumentPreviewUrl(ctx, req.(*GetDocumentPreviewUrlRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetDocumentPreviewUrlResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _PaperlessDocumentService_SearchDocuments0_HTTP_Handler(srv PaperlessDocumentServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in SearchDocumentsRequest
@@ -288,7 +330,94 @@ func _PaperlessDocumentService_BatchDeleteDocuments0_HTTP_Handler(srv PaperlessD
 	}
 }
 
+func _PaperlessDocumentService_GetDocumentHistory0_HTTP_Handler(srv PaperlessDocumentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetDocumentHistoryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPaperlessDocumentServiceGetDocumentHistory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetDocumentHistory(ctx, req.(*GetDocumentHistoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetDocumentHistoryResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PaperlessDocumentService_ListDocumentsDueSoon0_HTTP_Handler(srv PaperlessDocumentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListDocumentsDueSoonRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPaperlessDocumentServiceListDocumentsDueSoon)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListDocumentsDueSoon(ctx, req.(*ListDocumentsDueSoonRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListDocumentsDueSoonResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PaperlessDocumentService_AssignDocument0_HTTP_Handler(srv PaperlessDocumentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AssignDocumentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPaperlessDocumentServiceAssignDocument)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AssignDocument(ctx, req.(*AssignDocumentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AssignDocumentResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PaperlessDocumentService_ListMyInbox0_HTTP_Handler(srv PaperlessDocumentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListMyInboxRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPaperlessDocumentServiceListMyInbox)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListMyInbox(ctx, req.(*ListMyInboxRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListMyInboxResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type PaperlessDocumentServiceHTTPClient interface {
+	// AssignDocument Assigns a document to a user for action, or unassigns it
+	AssignDocument(ctx context.Context, req *AssignDocumentRequest, opts ...http.CallOption) (rsp *AssignDocumentResponse, err error)
 	// BatchDeleteDocuments Batch delete documents
 	BatchDeleteDocuments(ctx context.Context, req *BatchDeleteDocumentsRequest, opts ...http.CallOption) (rsp *BatchDeleteDocumentsResponse, err error)
 	// CreateDocument Create a new document (upload)
@@ -301,8 +430,16 @@ type PaperlessDocumentServiceHTTPClient interface {
 	GetDocument(ctx context.Context, req *GetDocumentRequest, opts ...http.CallOption) (rsp *GetDocumentResponse, err error)
 	// GetDocumentDownloadUrl Get document download URL (presigned URL)
 	GetDocumentDownloadUrl(ctx context.Context, req *GetDocumentDownloadUrlRequest, opts ...http.CallOption) (rsp *GetDocumentDownloadUrlResponse, err error)
+	// GetDocumentHistory Lists the metadata changes of a document with the values before and after each, newest first
+	GetDocumentHistory(ctx context.Context, req *GetDocumentHistoryRequest, opts ...http.CallOption) (rsp *GetDocumentHistoryResponse, err error)
+	// GetDocumentPreviewUrl Get a presigned URL of a document's PDF preview, converted on first use
+	GetDocumentPreviewUrl(ctx context.Context, req *GetDocumentPreviewUrlRequest, opts ...http.CallOption) (rsp *GetDocumentPreviewUrlResponse, err error)
 	// ListDocuments List documents in a category
 	ListDocuments(ctx context.Context, req *ListDocumentsRequest, opts ...http.CallOption) (rsp *ListDocumentsResponse, err error)
+	// ListDocumentsDueSoon Lists readable active documents due within the given number of days, soonest first
+	ListDocumentsDueSoon(ctx context.Context, req *ListDocumentsDueSoonRequest, opts ...http.CallOption) (rsp *ListDocumentsDueSoonResponse, err error)
+	// ListMyInbox Lists the active documents assigned to the caller, most recently assigned first
+	ListMyInbox(ctx context.Context, req *ListMyInboxRequest, opts ...http.CallOption) (rsp *ListMyInboxResponse, err error)
 	// MoveDocument Move document to a different category
 	MoveDocument(ctx context.Context, req *MoveDocumentRequest, opts ...http.CallOption) (rsp *MoveDocumentResponse, err error)
 	// SearchDocuments Search documents across categories
@@ -319,6 +456,20 @@ func NewPaperlessDocumentServiceHTTPClient(client *http.Client) PaperlessDocumen
 	return &PaperlessDocumentServiceHTTPClientImpl{client}
 }
 
+// AssignDocument Assigns a document to a user for action, or unassigns it
+func (c *PaperlessDocumentServiceHTTPClientImpl) AssignDocument(ctx context.Context, in *AssignDocumentRequest, opts ...http.CallOption) (*AssignDocumentResponse, error) {
+	var out AssignDocumentResponse
+	pattern := "/v1/documents/{id}/assign"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPaperlessDocumentServiceAssignDocument))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // BatchDeleteDocuments Batch delete documents
 func (c *PaperlessDocumentServiceHTTPClientImpl) BatchDeleteDocuments(ctx context.Context, in *BatchDeleteDocumentsRequest, opts ...http.CallOption) (*BatchDeleteDocumentsResponse, error) {
 	var out BatchDeleteDocumentsResponse
@@ -403,6 +554,34 @@ func (c *PaperlessDocumentServiceHTTPClientImpl) GetDocumentDownloadUrl(ctx cont
 	return &out, nil
 }
 
+// GetDocumentHistory Lists the metadata changes of a document with the values before and after each, newest first
+func (c *PaperlessDocumentServiceHTTPClientImpl) GetDocumentHistory(ctx context.Context, in *GetDocumentHistoryRequest, opts ...http.CallOption) (*GetDocumentHistoryResponse, error) {
+	var out GetDocumentHistoryResponse
+	pattern := "/v1/documents/{id}/history"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPaperlessDocumentServiceGetDocumentHistory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetDocumentPreviewUrl Get a presigned URL of a document's PDF preview, converted on first use
+func (c *PaperlessDocumentServiceHTTPClientImpl) GetDocumentPreviewUrl(ctx context.Context, in *GetDocumentPreviewUrlRequest, opts ...http.CallOption) (*GetDocumentPreviewUrlResponse, error) {
+	var out GetDocumentPreviewUrlResponse
+	pattern := "/v1/documents/{id}/preview-url"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPaperlessDocumentServiceGetDocumentPreviewUrl))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // ListDocuments List documents in a category
 func (c *PaperlessDocumentServiceHTTPClientImpl) ListDocuments(ctx context.Context, in *ListDocumentsRequest, opts ...http.CallOption) (*ListDocumentsResponse, error) {
 	var out ListDocumentsResponse
@@ -417,6 +596,34 @@ func (c *PaperlessDocumentServiceHTTPClientImpl) ListDocuments(ctx context.Conte
 	return &out, nil
 }
 
+// ListDocumentsDueSoon Lists readable active documents due within the given number of days, soonest first
+func (c *PaperlessDocumentServiceHTTPClientImpl) ListDocumentsDueSoon(ctx context.Context, in *ListDocumentsDueSoonRequest, opts ...http.CallOption) (*ListDocumentsDueSoonResponse, error) {
+	var out ListDocumentsDueSoonResponse
+	pattern := "/v1/documents/due-soon"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPaperlessDocumentServiceListDocumentsDueSoon))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListMyInbox Lists the active documents assigned to the caller, most recently assigned first
+func (c *PaperlessDocumentServiceHTTPClientImpl) ListMyInbox(ctx context.Context, in *ListMyInboxRequest, opts ...http.CallOption) (*ListMyInboxResponse, error) {
+	var out ListMyInboxResponse
+	pattern := "/v1/documents/inbox"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPaperlessDocumentServiceListMyInbox))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // MoveDocument Move document to a different category
 func (c *PaperlessDocumentServiceHTTPClientImpl) MoveDocument(ctx context.Context, in *MoveDocumentRequest, opts ...http.CallOption) (*MoveDocumentResponse, error) {
 	var out MoveDocumentResponse
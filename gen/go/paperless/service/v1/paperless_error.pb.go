@@ -35,6 +35,9 @@ const (
 	PaperlessErrorReason_CATEGORY_NOT_EMPTY          PaperlessErrorReason = 6
 	PaperlessErrorReason_INVALID_PERMISSION          PaperlessErrorReason = 7
 	PaperlessErrorReason_INVALID_FORMAT              PaperlessErrorReason = 8
+	PaperlessErrorReason_CATEGORY_QUOTA_EXCEEDED     PaperlessErrorReason = 9
+	PaperlessErrorReason_INVALID_STATUS_TRANSITION   PaperlessErrorReason = 10
+	PaperlessErrorReason_TENANT_QUOTA_EXCEEDED       PaperlessErrorReason = 11
 	// 401 - Unauthorized
 	PaperlessErrorReason_UNAUTHORIZED  PaperlessErrorReason = 100
 	PaperlessErrorReason_INVALID_TOKEN PaperlessErrorReason = 101
@@ -48,11 +51,18 @@ const (
 	PaperlessErrorReason_DOCUMENT_NOT_FOUND   PaperlessErrorReason = 402
 	PaperlessErrorReason_FILE_NOT_FOUND       PaperlessErrorReason = 403
 	PaperlessErrorReason_PERMISSION_NOT_FOUND PaperlessErrorReason = 404
+	PaperlessErrorReason_TAG_NOT_FOUND        PaperlessErrorReason = 405
 	// 409 - Conflict
-	PaperlessErrorReason_CONFLICT                  PaperlessErrorReason = 900
-	PaperlessErrorReason_CATEGORY_ALREADY_EXISTS   PaperlessErrorReason = 901
-	PaperlessErrorReason_DOCUMENT_ALREADY_EXISTS   PaperlessErrorReason = 902
-	PaperlessErrorReason_PERMISSION_ALREADY_EXISTS PaperlessErrorReason = 903
+	PaperlessErrorReason_CONFLICT                    PaperlessErrorReason = 900
+	PaperlessErrorReason_CATEGORY_ALREADY_EXISTS     PaperlessErrorReason = 901
+	PaperlessErrorReason_DOCUMENT_ALREADY_EXISTS     PaperlessErrorReason = 902
+	PaperlessErrorReason_PERMISSION_ALREADY_EXISTS   PaperlessErrorReason = 903
+	PaperlessErrorReason_VERSION_CONFLICT            PaperlessErrorReason = 904
+	PaperlessErrorReason_TAG_ALREADY_EXISTS          PaperlessErrorReason = 905
+	PaperlessErrorReason_IDEMPOTENCY_KEY_REUSED      PaperlessErrorReason = 906
+	PaperlessErrorReason_IDEMPOTENCY_KEY_IN_PROGRESS PaperlessErrorReason = 907
+	// 429 - Too Many Requests
+	PaperlessErrorReason_RATE_LIMITED PaperlessErrorReason = 2900
 	// 500 - Internal Server Error
 	PaperlessErrorReason_INTERNAL_SERVER_ERROR    PaperlessErrorReason = 2000
 	PaperlessErrorReason_STORAGE_CONNECTION_ERROR PaperlessErrorReason = 2001
@@ -75,6 +85,9 @@ var (
 		6:    "CATEGORY_NOT_EMPTY",
 		7:    "INVALID_PERMISSION",
 		8:    "INVALID_FORMAT",
+		9:    "CATEGORY_QUOTA_EXCEEDED",
+		10:   "INVALID_STATUS_TRANSITION",
+		11:   "TENANT_QUOTA_EXCEEDED",
 		100:  "UNAUTHORIZED",
 		101:  "INVALID_TOKEN",
 		300:  "FORBIDDEN",
@@ -85,10 +98,16 @@ var (
 		402:  "DOCUMENT_NOT_FOUND",
 		403:  "FILE_NOT_FOUND",
 		404:  "PERMISSION_NOT_FOUND",
+		405:  "TAG_NOT_FOUND",
 		900:  "CONFLICT",
 		901:  "CATEGORY_ALREADY_EXISTS",
 		902:  "DOCUMENT_ALREADY_EXISTS",
 		903:  "PERMISSION_ALREADY_EXISTS",
+		904:  "VERSION_CONFLICT",
+		905:  "TAG_ALREADY_EXISTS",
+		906:  "IDEMPOTENCY_KEY_REUSED",
+		907:  "IDEMPOTENCY_KEY_IN_PROGRESS",
+		2900: "RATE_LIMITED",
 		2000: "INTERNAL_SERVER_ERROR",
 		2001: "STORAGE_CONNECTION_ERROR",
 		2002: "STORAGE_OPERATION_ERROR",
@@ -106,6 +125,9 @@ var (
 		"CATEGORY_NOT_EMPTY":          6,
 		"INVALID_PERMISSION":          7,
 		"INVALID_FORMAT":              8,
+		"CATEGORY_QUOTA_EXCEEDED":     9,
+		"INVALID_STATUS_TRANSITION":   10,
+		"TENANT_QUOTA_EXCEEDED":       11,
 		"UNAUTHORIZED":                100,
 		"INVALID_TOKEN":               101,
 		"FORBIDDEN":                   300,
@@ -116,10 +138,16 @@ var (
 		"DOCUMENT_NOT_FOUND":          402,
 		"FILE_NOT_FOUND":              403,
 		"PERMISSION_NOT_FOUND":        404,
+		"TAG_NOT_FOUND":               405,
 		"CONFLICT":                    900,
 		"CATEGORY_ALREADY_EXISTS":     901,
 		"DOCUMENT_ALREADY_EXISTS":     902,
 		"PERMISSION_ALREADY_EXISTS":   903,
+		"VERSION_CONFLICT":            904,
+		"TAG_ALREADY_EXISTS":          905,
+		"IDEMPOTENCY_KEY_REUSED":      906,
+		"IDEMPOTENCY_KEY_IN_PROGRESS": 907,
+		"RATE_LIMITED":                2900,
 		"INTERNAL_SERVER_ERROR":       2000,
 		"STORAGE_CONNECTION_ERROR":    2001,
 		"STORAGE_OPERATION_ERROR":     2002,
@@ -160,7 +188,7 @@ var File_paperless_service_v1_paperless_error_proto protoreflect.FileDescriptor
 
 const file_paperless_service_v1_paperless_error_proto_rawDesc = "" +
 	"\n" +
-	"*paperless/service/v1/paperless_error.proto\x12\x14paperless.service.v1\x1a\x13errors/errors.proto*\x88\a\n" +
+	"*paperless/service/v1/paperless_error.proto\x12\x14paperless.service.v1\x1a\x13errors/errors.proto*\xab\t\n" +
 	"\x14PaperlessErrorReason\x12\x15\n" +
 	"\vBAD_REQUEST\x10\x00\x1a\x04\xa8E\x90\x03\x12\x1f\n" +
 	"\x15INVALID_CATEGORY_PATH\x10\x01\x1a\x04\xa8E\x90\x03\x12\x1f\n" +
@@ -170,7 +198,11 @@ const file_paperless_service_v1_paperless_error_proto_rawDesc = "" +
 	"\x1bCIRCULAR_CATEGORY_REFERENCE\x10\x05\x1a\x04\xa8E\x90\x03\x12\x1c\n" +
 	"\x12CATEGORY_NOT_EMPTY\x10\x06\x1a\x04\xa8E\x90\x03\x12\x1c\n" +
 	"\x12INVALID_PERMISSION\x10\a\x1a\x04\xa8E\x90\x03\x12\x18\n" +
-	"\x0eINVALID_FORMAT\x10\b\x1a\x04\xa8E\x90\x03\x12\x16\n" +
+	"\x0eINVALID_FORMAT\x10\b\x1a\x04\xa8E\x90\x03\x12!\n" +
+	"\x17CATEGORY_QUOTA_EXCEEDED\x10\t\x1a\x04\xa8E\x90\x03\x12#\n" +
+	"\x19INVALID_STATUS_TRANSITION\x10\n" +
+	"\x1a\x04\xa8E\x90\x03\x12\x1f\n" +
+	"\x15TENANT_QUOTA_EXCEEDED\x10\v\x1a\x04\xa8E\x90\x03\x12\x16\n" +
 	"\fUNAUTHORIZED\x10d\x1a\x04\xa8E\x91\x03\x12\x17\n" +
 	"\rINVALID_TOKEN\x10e\x1a\x04\xa8E\x91\x03\x12\x14\n" +
 	"\tFORBIDDEN\x10\xac\x02\x1a\x04\xa8E\x93\x03\x12\x18\n" +
@@ -180,11 +212,17 @@ const file_paperless_service_v1_paperless_error_proto_rawDesc = "" +
 	"\x12CATEGORY_NOT_FOUND\x10\x91\x03\x1a\x04\xa8E\x94\x03\x12\x1d\n" +
 	"\x12DOCUMENT_NOT_FOUND\x10\x92\x03\x1a\x04\xa8E\x94\x03\x12\x19\n" +
 	"\x0eFILE_NOT_FOUND\x10\x93\x03\x1a\x04\xa8E\x94\x03\x12\x1f\n" +
-	"\x14PERMISSION_NOT_FOUND\x10\x94\x03\x1a\x04\xa8E\x94\x03\x12\x13\n" +
+	"\x14PERMISSION_NOT_FOUND\x10\x94\x03\x1a\x04\xa8E\x94\x03\x12\x18\n" +
+	"\rTAG_NOT_FOUND\x10\x95\x03\x1a\x04\xa8E\x94\x03\x12\x13\n" +
 	"\bCONFLICT\x10\x84\a\x1a\x04\xa8E\x99\x03\x12\"\n" +
 	"\x17CATEGORY_ALREADY_EXISTS\x10\x85\a\x1a\x04\xa8E\x99\x03\x12\"\n" +
 	"\x17DOCUMENT_ALREADY_EXISTS\x10\x86\a\x1a\x04\xa8E\x99\x03\x12$\n" +
-	"\x19PERMISSION_ALREADY_EXISTS\x10\x87\a\x1a\x04\xa8E\x99\x03\x12 \n" +
+	"\x19PERMISSION_ALREADY_EXISTS\x10\x87\a\x1a\x04\xa8E\x99\x03\x12\x1b\n" +
+	"\x10VERSION_CONFLICT\x10\x88\a\x1a\x04\xa8E\x99\x03\x12\x1d\n" +
+	"\x12TAG_ALREADY_EXISTS\x10\x89\a\x1a\x04\xa8E\x99\x03\x12!\n" +
+	"\x16IDEMPOTENCY_KEY_REUSED\x10\x8a\a\x1a\x04\xa8E\x99\x03\x12&\n" +
+	"\x1bIDEMPOTENCY_KEY_IN_PROGRESS\x10\x8b\a\x1a\x04\xa8E\x99\x03\x12\x17\n" +
+	"\fRATE_LIMITED\x10\xd4\x16\x1a\x04\xa8E\xad\x03\x12 \n" +
 	"\x15INTERNAL_SERVER_ERROR\x10\xd0\x0f\x1a\x04\xa8E\xf4\x03\x12#\n" +
 	"\x18STORAGE_CONNECTION_ERROR\x10\xd1\x0f\x1a\x04\xa8E\xf4\x03\x12\"\n" +
 	"\x17STORAGE_OPERATION_ERROR\x10\xd2\x0f\x1a\x04\xa8E\xf4\x03\x12\x19\n" +
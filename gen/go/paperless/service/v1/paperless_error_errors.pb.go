@@ -121,6 +121,42 @@ func ErrorInvalidFormat(format string, args ...interface{}) *errors.Error {
 	return errors.New(400, PaperlessErrorReason_INVALID_FORMAT.String(), fmt.Sprintf(format, args...))
 }
 
+func IsCategoryQuotaExceeded(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == PaperlessErrorReason_CATEGORY_QUOTA_EXCEEDED.String() && e.Code == 400
+}
+
+func ErrorCategoryQuotaExceeded(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, PaperlessErrorReason_CATEGORY_QUOTA_EXCEEDED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsInvalidStatusTransition(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == PaperlessErrorReason_INVALID_STATUS_TRANSITION.String() && e.Code == 400
+}
+
+func ErrorInvalidStatusTransition(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, PaperlessErrorReason_INVALID_STATUS_TRANSITION.String(), fmt.Sprintf(format, args...))
+}
+
+func IsTenantQuotaExceeded(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == PaperlessErrorReason_TENANT_QUOTA_EXCEEDED.String() && e.Code == 400
+}
+
+func ErrorTenantQuotaExceeded(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, PaperlessErrorReason_TENANT_QUOTA_EXCEEDED.String(), fmt.Sprintf(format, args...))
+}
+
 // 401 - Unauthorized
 func IsUnauthorized(err error) bool {
 	if err == nil {
@@ -247,6 +283,18 @@ func ErrorPermissionNotFound(format string, args ...interface{}) *errors.Error {
 	return errors.New(404, PaperlessErrorReason_PERMISSION_NOT_FOUND.String(), fmt.Sprintf(format, args...))
 }
 
+func IsTagNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == PaperlessErrorReason_TAG_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorTagNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, PaperlessErrorReason_TAG_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
 // 409 - Conflict
 func IsConflict(err error) bool {
 	if err == nil {
@@ -297,6 +345,68 @@ func ErrorPermissionAlreadyExists(format string, args ...interface{}) *errors.Er
 	return errors.New(409, PaperlessErrorReason_PERMISSION_ALREADY_EXISTS.String(), fmt.Sprintf(format, args...))
 }
 
+func IsVersionConflict(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == PaperlessErrorReason_VERSION_CONFLICT.String() && e.Code == 409
+}
+
+func ErrorVersionConflict(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, PaperlessErrorReason_VERSION_CONFLICT.String(), fmt.Sprintf(format, args...))
+}
+
+func IsTagAlreadyExists(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == PaperlessErrorReason_TAG_ALREADY_EXISTS.String() && e.Code == 409
+}
+
+func ErrorTagAlreadyExists(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, PaperlessErrorReason_TAG_ALREADY_EXISTS.String(), fmt.Sprintf(format, args...))
+}
+
+func IsIdempotencyKeyReused(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == PaperlessErrorReason_IDEMPOTENCY_KEY_REUSED.String() && e.Code == 409
+}
+
+func ErrorIdempotencyKeyReused(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, PaperlessErrorReason_IDEMPOTENCY_KEY_REUSED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsIdempotencyKeyInProgress(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == PaperlessErrorReason_IDEMPOTENCY_KEY_IN_PROGRESS.String() && e.Code == 409
+}
+
+func ErrorIdempotencyKeyInProgress(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, PaperlessErrorReason_IDEMPOTENCY_KEY_IN_PROGRESS.String(), fmt.Sprintf(format, args...))
+}
+
+// 429 - Too Many Requests
+func IsRateLimited(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == PaperlessErrorReason_RATE_LIMITED.String() && e.Code == 429
+}
+
+// 429 - Too Many Requests
+func ErrorRateLimited(format string, args ...interface{}) *errors.Error {
+	return errors.New(429, PaperlessErrorReason_RATE_LIMITED.String(), fmt.Sprintf(format, args...))
+}
+
 // 500 - Internal Server Error
 func IsInternalServerError(err error) bool {
 	if err == nil {
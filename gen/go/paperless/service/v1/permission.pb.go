@@ -139,6 +139,7 @@ const (
 	SubjectType_SUBJECT_TYPE_USER        SubjectType = 1
 	SubjectType_SUBJECT_TYPE_ROLE        SubjectType = 2
 	SubjectType_SUBJECT_TYPE_TENANT      SubjectType = 3
+	SubjectType_SUBJECT_TYPE_GROUP       SubjectType = 4 // Directory group, membership synced from the identity module
 )
 
 // Enum value maps for SubjectType.
@@ -148,12 +149,14 @@ var (
 		1: "SUBJECT_TYPE_USER",
 		2: "SUBJECT_TYPE_ROLE",
 		3: "SUBJECT_TYPE_TENANT",
+		4: "SUBJECT_TYPE_GROUP",
 	}
 	SubjectType_value = map[string]int32{
 		"SUBJECT_TYPE_UNSPECIFIED": 0,
 		"SUBJECT_TYPE_USER":        1,
 		"SUBJECT_TYPE_ROLE":        2,
 		"SUBJECT_TYPE_TENANT":      3,
+		"SUBJECT_TYPE_GROUP":       4,
 	}
 )
 
@@ -243,6 +246,143 @@ func (Permission) EnumDescriptor() ([]byte, []int) {
 	return file_paperless_service_v1_permission_proto_rawDescGZIP(), []int{3}
 }
 
+// Daily time-of-day window during which a permission is in effect
+type TimeWindow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Start time in HH:MM (24h) format
+	Start string `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	// End time in HH:MM (24h) format; earlier than start wraps past midnight
+	End string `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	// IANA time zone (defaults to UTC)
+	Timezone *string `protobuf:"bytes,3,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
+	// Allowed weekdays (0 = Sunday ... 6 = Saturday); empty allows every day
+	Weekdays      []int32 `protobuf:"varint,4,rep,packed,name=weekdays,proto3" json:"weekdays,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeWindow) Reset() {
+	*x = TimeWindow{}
+	mi := &file_paperless_service_v1_permission_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeWindow) ProtoMessage() {}
+
+func (x *TimeWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_permission_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeWindow.ProtoReflect.Descriptor instead.
+func (*TimeWindow) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_permission_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TimeWindow) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *TimeWindow) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *TimeWindow) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
+func (x *TimeWindow) GetWeekdays() []int32 {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+// Conditions (caveats) evaluated against the request at check time
+type PermissionConditions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Client IP must fall into one of these CIDR ranges
+	AllowedCidrs []string `protobuf:"bytes,1,rep,name=allowed_cidrs,json=allowedCidrs,proto3" json:"allowed_cidrs,omitempty"`
+	// Time-of-day window
+	TimeWindow *TimeWindow `protobuf:"bytes,2,opt,name=time_window,json=timeWindow,proto3,oneof" json:"time_window,omitempty"`
+	// Caller must have completed multi-factor authentication
+	RequireMfa    bool `protobuf:"varint,3,opt,name=require_mfa,json=requireMfa,proto3" json:"require_mfa,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PermissionConditions) Reset() {
+	*x = PermissionConditions{}
+	mi := &file_paperless_service_v1_permission_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PermissionConditions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionConditions) ProtoMessage() {}
+
+func (x *PermissionConditions) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_permission_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermissionConditions.ProtoReflect.Descriptor instead.
+func (*PermissionConditions) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_permission_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PermissionConditions) GetAllowedCidrs() []string {
+	if x != nil {
+		return x.AllowedCidrs
+	}
+	return nil
+}
+
+func (x *PermissionConditions) GetTimeWindow() *TimeWindow {
+	if x != nil {
+		return x.TimeWindow
+	}
+	return nil
+}
+
+func (x *PermissionConditions) GetRequireMfa() bool {
+	if x != nil {
+		return x.RequireMfa
+	}
+	return false
+}
+
 // Permission tuple entity
 type PermissionTuple struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -256,13 +396,14 @@ type PermissionTuple struct {
 	GrantedBy     *uint32                `protobuf:"varint,8,opt,name=granted_by,json=grantedBy,proto3,oneof" json:"granted_by,omitempty"`
 	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
 	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	Conditions    *PermissionConditions  `protobuf:"bytes,11,opt,name=conditions,proto3,oneof" json:"conditions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PermissionTuple) Reset() {
 	*x = PermissionTuple{}
-	mi := &file_paperless_service_v1_permission_proto_msgTypes[0]
+	mi := &file_paperless_service_v1_permission_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -274,7 +415,7 @@ func (x *PermissionTuple) String() string {
 func (*PermissionTuple) ProtoMessage() {}
 
 func (x *PermissionTuple) ProtoReflect() protoreflect.Message {
-	mi := &file_paperless_service_v1_permission_proto_msgTypes[0]
+	mi := &file_paperless_service_v1_permission_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -287,7 +428,7 @@ func (x *PermissionTuple) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PermissionTuple.ProtoReflect.Descriptor instead.
 func (*PermissionTuple) Descriptor() ([]byte, []int) {
-	return file_paperless_service_v1_permission_proto_rawDescGZIP(), []int{0}
+	return file_paperless_service_v1_permission_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *PermissionTuple) GetId() uint32 {
@@ -360,6 +501,13 @@ func (x *PermissionTuple) GetCreateTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *PermissionTuple) GetConditions() *PermissionConditions {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
 // Request to grant access
 type GrantAccessRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -374,14 +522,16 @@ type GrantAccessRequest struct {
 	// Subject ID
 	SubjectId string `protobuf:"bytes,5,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
 	// Optional expiration time
-	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	// Optional conditions restricting when the grant applies
+	Conditions    *PermissionConditions `protobuf:"bytes,7,opt,name=conditions,proto3,oneof" json:"conditions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GrantAccessRequest) Reset() {
 	*x = GrantAccessRequest{}
-	mi := &file_paperless_service_v1_permission_proto_msgTypes[1]
+	mi := &file_paperless_service_v1_permission_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -393,7 +543,7 @@ func (x *GrantAccessRequest) String() string {
 func (*GrantAccessRequest) ProtoMessage() {}
 
 func (x *GrantAccessRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_paperless_service_v1_permission_proto_msgTypes[1]
+	mi := &file_paperless_service_v1_permission_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -406,7 +556,7 @@ func (x *GrantAccessRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GrantAccessRequest.ProtoReflect.Descriptor instead.
 func (*GrantAccessRequest) Descriptor() ([]byte, []int) {
-	return file_paperless_service_v1_permission_proto_rawDescGZIP(), []int{1}
+	return file_paperless_service_v1_permission_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GrantAccessRequest) GetResourceType() ResourceType {
@@ -451,6 +601,13 @@ func (x *GrantAccessRequest) GetExpiresAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *GrantAccessRequest) GetConditions() *PermissionConditions {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
 type GrantAccessResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Permission    *PermissionTuple       `protobuf:"bytes,1,opt,name=permission,proto3" json:"permission,omitempty"`
@@ -460,7 +617,7 @@ type GrantAccessResponse struct {
 
 func (x *GrantAccessResponse) Reset() {
 	*x = GrantAccessResponse{}
-	mi := &file_paperless_service_v1_permission_proto_msgTypes[2]
+	mi := &file_paperless_service_v1_permission_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -472,7 +629,7 @@ func (x *GrantAccessResponse) String() string {
 func (*GrantAccessResponse) ProtoMessage() {}
 
 func (x *GrantAccessResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_paperless_service_v1_permission_proto_msgTypes[2]
+	mi := &file_paperless_service_v1_permission_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -485,7 +642,7 @@ func (x *GrantAccessResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GrantAccessResponse.ProtoReflect.Descriptor instead.
 func (*GrantAccessResponse) Descriptor() ([]byte, []int) {
-	return file_paperless_service_v1_permission_proto_rawDescGZIP(), []int{2}
+	return file_paperless_service_v1_permission_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GrantAccessResponse) GetPermission() *PermissionTuple {
@@ -495,6 +652,149 @@ func (x *GrantAccessResponse) GetPermission() *PermissionTuple {
 	return nil
 }
 
+// Request to share a document
+type ShareDocumentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Document to share
+	DocumentId string `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	// Relation to grant; at most the caller's own relation to the document
+	Relation Relation `protobuf:"varint,2,opt,name=relation,proto3,enum=paperless.service.v1.Relation" json:"relation,omitempty"`
+	// SUBJECT_TYPE_USER or SUBJECT_TYPE_ROLE
+	SubjectType SubjectType `protobuf:"varint,3,opt,name=subject_type,json=subjectType,proto3,enum=paperless.service.v1.SubjectType" json:"subject_type,omitempty"`
+	// Subject ID
+	SubjectId string `protobuf:"bytes,4,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	// Optional expiration time
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareDocumentRequest) Reset() {
+	*x = ShareDocumentRequest{}
+	mi := &file_paperless_service_v1_permission_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareDocumentRequest) ProtoMessage() {}
+
+func (x *ShareDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_permission_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareDocumentRequest.ProtoReflect.Descriptor instead.
+func (*ShareDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_permission_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ShareDocumentRequest) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *ShareDocumentRequest) GetRelation() Relation {
+	if x != nil {
+		return x.Relation
+	}
+	return Relation_RELATION_UNSPECIFIED
+}
+
+func (x *ShareDocumentRequest) GetSubjectType() SubjectType {
+	if x != nil {
+		return x.SubjectType
+	}
+	return SubjectType_SUBJECT_TYPE_UNSPECIFIED
+}
+
+func (x *ShareDocumentRequest) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *ShareDocumentRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type ShareDocumentResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Permission *PermissionTuple       `protobuf:"bytes,1,opt,name=permission,proto3" json:"permission,omitempty"`
+	// What the subject can do with the document after the share
+	EffectivePermissions []Permission `protobuf:"varint,2,rep,packed,name=effective_permissions,json=effectivePermissions,proto3,enum=paperless.service.v1.Permission" json:"effective_permissions,omitempty"`
+	HighestRelation      Relation     `protobuf:"varint,3,opt,name=highest_relation,json=highestRelation,proto3,enum=paperless.service.v1.Relation" json:"highest_relation,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ShareDocumentResponse) Reset() {
+	*x = ShareDocumentResponse{}
+	mi := &file_paperless_service_v1_permission_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareDocumentResponse) ProtoMessage() {}
+
+func (x *ShareDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_permission_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareDocumentResponse.ProtoReflect.Descriptor instead.
+func (*ShareDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_permission_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ShareDocumentResponse) GetPermission() *PermissionTuple {
+	if x != nil {
+		return x.Permission
+	}
+	return nil
+}
+
+func (x *ShareDocumentResponse) GetEffectivePermissions() []Permission {
+	if x != nil {
+		return x.EffectivePermissions
+	}
+	return nil
+}
+
+func (x *ShareDocumentResponse) GetHighestRelation() Relation {
+	if x != nil {
+		return x.HighestRelation
+	}
+	return Relation_RELATION_UNSPECIFIED
+}
+
 // Request to revoke access
 type RevokeAccessRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -514,7 +814,7 @@ type RevokeAccessRequest struct {
 
 func (x *RevokeAccessRequest) Reset() {
 	*x = RevokeAccessRequest{}
-	mi := &file_paperless_service_v1_permission_proto_msgTypes[3]
+	mi := &file_paperless_service_v1_permission_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -526,7 +826,7 @@ func (x *RevokeAccessRequest) String() string {
 func (*RevokeAccessRequest) ProtoMessage() {}
 
 func (x *RevokeAccessRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_paperless_service_v1_permission_proto_msgTypes[3]
+	mi := &file_paperless_service_v1_permission_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -539,7 +839,7 @@ func (x *RevokeAccessRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeAccessRequest.ProtoReflect.Descriptor instead.
 func (*RevokeAccessRequest) Descriptor() ([]byte, []int) {
-	return file_paperless_service_v1_permission_proto_rawDescGZIP(), []int{3}
+	return file_paperless_service_v1_permission_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *RevokeAccessRequest) GetResourceType() ResourceType {
@@ -597,7 +897,7 @@ type ListPermissionsRequest struct {
 
 func (x *ListPermissionsRequest) Reset() {
 	*x = ListPermissionsRequest{}
-	mi := &file_paperless_service_v1_permission_proto_msgTypes[4]
+	mi := &file_paperless_service_v1_permission_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -609,7 +909,7 @@ func (x *ListPermissionsRequest) String() string {
 func (*ListPermissionsRequest) ProtoMessage() {}
 
 func (x *ListPermissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_paperless_service_v1_permission_proto_msgTypes[4]
+	mi := &file_paperless_service_v1_permission_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -622,7 +922,7 @@ func (x *ListPermissionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPermissionsRequest.ProtoReflect.Descriptor instead.
 func (*ListPermissionsRequest) Descriptor() ([]byte, []int) {
-	return file_paperless_service_v1_permission_proto_rawDescGZIP(), []int{4}
+	return file_paperless_service_v1_permission_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListPermissionsRequest) GetResourceType() ResourceType {
@@ -677,7 +977,7 @@ type ListPermissionsResponse struct {
 
 func (x *ListPermissionsResponse) Reset() {
 	*x = ListPermissionsResponse{}
-	mi := &file_paperless_service_v1_permission_proto_msgTypes[5]
+	mi := &file_paperless_service_v1_permission_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -689,7 +989,7 @@ func (x *ListPermissionsResponse) String() string {
 func (*ListPermissionsResponse) ProtoMessage() {}
 
 func (x *ListPermissionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_paperless_service_v1_permission_proto_msgTypes[5]
+	mi := &file_paperless_service_v1_permission_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -702,7 +1002,7 @@ func (x *ListPermissionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPermissionsResponse.ProtoReflect.Descriptor instead.
 func (*ListPermissionsResponse) Descriptor() ([]byte, []int) {
-	return file_paperless_service_v1_permission_proto_rawDescGZIP(), []int{5}
+	return file_paperless_service_v1_permission_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListPermissionsResponse) GetPermissions() []*PermissionTuple {
@@ -729,14 +1029,18 @@ type CheckAccessRequest struct {
 	// Resource ID
 	ResourceId string `protobuf:"bytes,3,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
 	// Permission to check
-	Permission    Permission `protobuf:"varint,4,opt,name=permission,proto3,enum=paperless.service.v1.Permission" json:"permission,omitempty"`
+	Permission Permission `protobuf:"varint,4,opt,name=permission,proto3,enum=paperless.service.v1.Permission" json:"permission,omitempty"`
+	// Client IP to evaluate conditions against (defaults to the caller's; tenant admins only)
+	ClientIp *string `protobuf:"bytes,5,opt,name=client_ip,json=clientIp,proto3,oneof" json:"client_ip,omitempty"`
+	// MFA state to evaluate conditions against (defaults to the caller's; tenant admins only)
+	MfaVerified   *bool `protobuf:"varint,6,opt,name=mfa_verified,json=mfaVerified,proto3,oneof" json:"mfa_verified,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CheckAccessRequest) Reset() {
 	*x = CheckAccessRequest{}
-	mi := &file_paperless_service_v1_permission_proto_msgTypes[6]
+	mi := &file_paperless_service_v1_permission_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -748,7 +1052,7 @@ func (x *CheckAccessRequest) String() string {
 func (*CheckAccessRequest) ProtoMessage() {}
 
 func (x *CheckAccessRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_paperless_service_v1_permission_proto_msgTypes[6]
+	mi := &file_paperless_service_v1_permission_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -761,7 +1065,7 @@ func (x *CheckAccessRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckAccessRequest.ProtoReflect.Descriptor instead.
 func (*CheckAccessRequest) Descriptor() ([]byte, []int) {
-	return file_paperless_service_v1_permission_proto_rawDescGZIP(), []int{6}
+	return file_paperless_service_v1_permission_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *CheckAccessRequest) GetUserId() string {
@@ -792,6 +1096,20 @@ func (x *CheckAccessRequest) GetPermission() Permission {
 	return Permission_PERMISSION_UNSPECIFIED
 }
 
+func (x *CheckAccessRequest) GetClientIp() string {
+	if x != nil && x.ClientIp != nil {
+		return *x.ClientIp
+	}
+	return ""
+}
+
+func (x *CheckAccessRequest) GetMfaVerified() bool {
+	if x != nil && x.MfaVerified != nil {
+		return *x.MfaVerified
+	}
+	return false
+}
+
 type CheckAccessResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Allowed       bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
@@ -802,7 +1120,7 @@ type CheckAccessResponse struct {
 
 func (x *CheckAccessResponse) Reset() {
 	*x = CheckAccessResponse{}
-	mi := &file_paperless_service_v1_permission_proto_msgTypes[7]
+	mi := &file_paperless_service_v1_permission_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -814,7 +1132,7 @@ func (x *CheckAccessResponse) String() string {
 func (*CheckAccessResponse) ProtoMessage() {}
 
 func (x *CheckAccessResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_paperless_service_v1_permission_proto_msgTypes[7]
+	mi := &file_paperless_service_v1_permission_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -827,7 +1145,7 @@ func (x *CheckAccessResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckAccessResponse.ProtoReflect.Descriptor instead.
 func (*CheckAccessResponse) Descriptor() ([]byte, []int) {
-	return file_paperless_service_v1_permission_proto_rawDescGZIP(), []int{7}
+	return file_paperless_service_v1_permission_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CheckAccessResponse) GetAllowed() bool {
@@ -851,7 +1169,7 @@ type ListAccessibleResourcesRequest struct {
 	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	// Resource type to list
 	ResourceType ResourceType `protobuf:"varint,2,opt,name=resource_type,json=resourceType,proto3,enum=paperless.service.v1.ResourceType" json:"resource_type,omitempty"`
-	// Minimum permission level
+	// Permission the subject must hold on each returned resource (e.g. read, delete, share)
 	Permission Permission `protobuf:"varint,3,opt,name=permission,proto3,enum=paperless.service.v1.Permission" json:"permission,omitempty"`
 	// Pagination
 	Page          *uint32 `protobuf:"varint,4,opt,name=page,proto3,oneof" json:"page,omitempty"`
@@ -862,7 +1180,7 @@ type ListAccessibleResourcesRequest struct {
 
 func (x *ListAccessibleResourcesRequest) Reset() {
 	*x = ListAccessibleResourcesRequest{}
-	mi := &file_paperless_service_v1_permission_proto_msgTypes[8]
+	mi := &file_paperless_service_v1_permission_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -874,7 +1192,7 @@ func (x *ListAccessibleResourcesRequest) String() string {
 func (*ListAccessibleResourcesRequest) ProtoMessage() {}
 
 func (x *ListAccessibleResourcesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_paperless_service_v1_permission_proto_msgTypes[8]
+	mi := &file_paperless_service_v1_permission_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -887,7 +1205,7 @@ func (x *ListAccessibleResourcesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAccessibleResourcesRequest.ProtoReflect.Descriptor instead.
 func (*ListAccessibleResourcesRequest) Descriptor() ([]byte, []int) {
-	return file_paperless_service_v1_permission_proto_rawDescGZIP(), []int{8}
+	return file_paperless_service_v1_permission_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListAccessibleResourcesRequest) GetUserId() string {
@@ -935,7 +1253,7 @@ type ListAccessibleResourcesResponse struct {
 
 func (x *ListAccessibleResourcesResponse) Reset() {
 	*x = ListAccessibleResourcesResponse{}
-	mi := &file_paperless_service_v1_permission_proto_msgTypes[9]
+	mi := &file_paperless_service_v1_permission_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -947,7 +1265,7 @@ func (x *ListAccessibleResourcesResponse) String() string {
 func (*ListAccessibleResourcesResponse) ProtoMessage() {}
 
 func (x *ListAccessibleResourcesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_paperless_service_v1_permission_proto_msgTypes[9]
+	mi := &file_paperless_service_v1_permission_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -960,7 +1278,7 @@ func (x *ListAccessibleResourcesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAccessibleResourcesResponse.ProtoReflect.Descriptor instead.
 func (*ListAccessibleResourcesResponse) Descriptor() ([]byte, []int) {
-	return file_paperless_service_v1_permission_proto_rawDescGZIP(), []int{9}
+	return file_paperless_service_v1_permission_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ListAccessibleResourcesResponse) GetResourceIds() []string {
@@ -992,7 +1310,7 @@ type GetEffectivePermissionsRequest struct {
 
 func (x *GetEffectivePermissionsRequest) Reset() {
 	*x = GetEffectivePermissionsRequest{}
-	mi := &file_paperless_service_v1_permission_proto_msgTypes[10]
+	mi := &file_paperless_service_v1_permission_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1004,7 +1322,7 @@ func (x *GetEffectivePermissionsRequest) String() string {
 func (*GetEffectivePermissionsRequest) ProtoMessage() {}
 
 func (x *GetEffectivePermissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_paperless_service_v1_permission_proto_msgTypes[10]
+	mi := &file_paperless_service_v1_permission_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1017,7 +1335,7 @@ func (x *GetEffectivePermissionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEffectivePermissionsRequest.ProtoReflect.Descriptor instead.
 func (*GetEffectivePermissionsRequest) Descriptor() ([]byte, []int) {
-	return file_paperless_service_v1_permission_proto_rawDescGZIP(), []int{10}
+	return file_paperless_service_v1_permission_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetEffectivePermissionsRequest) GetUserId() string {
@@ -1051,7 +1369,7 @@ type GetEffectivePermissionsResponse struct {
 
 func (x *GetEffectivePermissionsResponse) Reset() {
 	*x = GetEffectivePermissionsResponse{}
-	mi := &file_paperless_service_v1_permission_proto_msgTypes[11]
+	mi := &file_paperless_service_v1_permission_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1063,7 +1381,7 @@ func (x *GetEffectivePermissionsResponse) String() string {
 func (*GetEffectivePermissionsResponse) ProtoMessage() {}
 
 func (x *GetEffectivePermissionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_paperless_service_v1_permission_proto_msgTypes[11]
+	mi := &file_paperless_service_v1_permission_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1076,7 +1394,7 @@ func (x *GetEffectivePermissionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEffectivePermissionsResponse.ProtoReflect.Descriptor instead.
 func (*GetEffectivePermissionsResponse) Descriptor() ([]byte, []int) {
-	return file_paperless_service_v1_permission_proto_rawDescGZIP(), []int{11}
+	return file_paperless_service_v1_permission_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetEffectivePermissionsResponse) GetPermissions() []Permission {
@@ -1097,7 +1415,22 @@ var File_paperless_service_v1_permission_proto protoreflect.FileDescriptor
 
 const file_paperless_service_v1_permission_proto_rawDesc = "" +
 	"\n" +
-	"%paperless/service/v1/permission.proto\x12\x14paperless.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x88\x04\n" +
+	"%paperless/service/v1/permission.proto\x12\x14paperless.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe9\x01\n" +
+	"\n" +
+	"TimeWindow\x12<\n" +
+	"\x05start\x18\x01 \x01(\tB&\xbaH#r!2\x1f^([01][0-9]|2[0-3]):[0-5][0-9]$R\x05start\x128\n" +
+	"\x03end\x18\x02 \x01(\tB&\xbaH#r!2\x1f^([01][0-9]|2[0-3]):[0-5][0-9]$R\x03end\x12(\n" +
+	"\btimezone\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x18@H\x00R\btimezone\x88\x01\x01\x12,\n" +
+	"\bweekdays\x18\x04 \x03(\x05B\x10\xbaH\r\x92\x01\n" +
+	"\x10\a\"\x06\x1a\x04\x18\x06(\x00R\bweekdaysB\v\n" +
+	"\t_timezone\"\xc4\x01\n" +
+	"\x14PermissionConditions\x123\n" +
+	"\rallowed_cidrs\x18\x01 \x03(\tB\x0e\xbaH\v\x92\x01\b\x10 \"\x04r\x02\x18@R\fallowedCidrs\x12F\n" +
+	"\vtime_window\x18\x02 \x01(\v2 .paperless.service.v1.TimeWindowH\x00R\n" +
+	"timeWindow\x88\x01\x01\x12\x1f\n" +
+	"\vrequire_mfa\x18\x03 \x01(\bR\n" +
+	"requireMfaB\x0e\n" +
+	"\f_time_window\"\xe8\x04\n" +
 	"\x0fPermissionTuple\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1b\n" +
 	"\ttenant_id\x18\x02 \x01(\rR\btenantId\x12G\n" +
@@ -1114,42 +1447,66 @@ const file_paperless_service_v1_permission_proto_rawDesc = "" +
 	"expires_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x01R\texpiresAt\x88\x01\x01\x12;\n" +
 	"\vcreate_time\x18\n" +
 	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"createTimeB\r\n" +
+	"createTime\x12O\n" +
+	"\n" +
+	"conditions\x18\v \x01(\v2*.paperless.service.v1.PermissionConditionsH\x02R\n" +
+	"conditions\x88\x01\x01B\r\n" +
 	"\v_granted_byB\r\n" +
-	"\v_expires_at\"\xc9\x03\n" +
+	"\v_expires_atB\r\n" +
+	"\v_conditions\"\x8e\x05\n" +
 	"\x12GrantAccessRequest\x12V\n" +
-	"\rresource_type\x18\x01 \x01(\x0e2\".paperless.service.v1.ResourceTypeB\r\xe0A\x02\xbaH\a\x82\x01\x04\x10\x01 \x00R\fresourceType\x12?\n" +
-	"\vresource_id\x18\x02 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\n" +
+	"\rresource_type\x18\x01 \x01(\x0e2\".paperless.service.v1.ResourceTypeB\r\xe0A\x02\xbaH\a\x82\x01\x04\x10\x01 \x00R\fresourceType\x12\xa3\x01\n" +
+	"\vresource_id\x18\x02 \x01(\tB\x81\x01\xe0A\x02\xbaH{ry\x10\x01\x18$2s^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})$R\n" +
 	"resourceId\x12I\n" +
 	"\brelation\x18\x03 \x01(\x0e2\x1e.paperless.service.v1.RelationB\r\xe0A\x02\xbaH\a\x82\x01\x04\x10\x01 \x00R\brelation\x12S\n" +
 	"\fsubject_type\x18\x04 \x01(\x0e2!.paperless.service.v1.SubjectTypeB\r\xe0A\x02\xbaH\a\x82\x01\x04\x10\x01 \x00R\vsubjectType\x12+\n" +
 	"\n" +
 	"subject_id\x18\x05 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\tsubjectId\x12>\n" +
 	"\n" +
-	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\texpiresAt\x88\x01\x01B\r\n" +
-	"\v_expires_at\"\\\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\texpiresAt\x88\x01\x01\x12O\n" +
+	"\n" +
+	"conditions\x18\a \x01(\v2*.paperless.service.v1.PermissionConditionsH\x01R\n" +
+	"conditions\x88\x01\x01B\r\n" +
+	"\v_expires_atB\r\n" +
+	"\v_conditions\"\\\n" +
 	"\x13GrantAccessResponse\x12E\n" +
 	"\n" +
 	"permission\x18\x01 \x01(\v2%.paperless.service.v1.PermissionTupleR\n" +
-	"permission\"\xfe\x02\n" +
+	"permission\"\xd8\x03\n" +
+	"\x14ShareDocumentRequest\x12\xa3\x01\n" +
+	"\vdocument_id\x18\x01 \x01(\tB\x81\x01\xe0A\x02\xbaH{ry\x10\x01\x18$2s^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})$R\n" +
+	"documentId\x12I\n" +
+	"\brelation\x18\x02 \x01(\x0e2\x1e.paperless.service.v1.RelationB\r\xe0A\x02\xbaH\a\x82\x01\x04\x10\x01 \x00R\brelation\x12S\n" +
+	"\fsubject_type\x18\x03 \x01(\x0e2!.paperless.service.v1.SubjectTypeB\r\xe0A\x02\xbaH\a\x82\x01\x04\x18\x01\x18\x02R\vsubjectType\x12+\n" +
+	"\n" +
+	"subject_id\x18\x04 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\tsubjectId\x12>\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\texpiresAt\x88\x01\x01B\r\n" +
+	"\v_expires_at\"\x80\x02\n" +
+	"\x15ShareDocumentResponse\x12E\n" +
+	"\n" +
+	"permission\x18\x01 \x01(\v2%.paperless.service.v1.PermissionTupleR\n" +
+	"permission\x12U\n" +
+	"\x15effective_permissions\x18\x02 \x03(\x0e2 .paperless.service.v1.PermissionR\x14effectivePermissions\x12I\n" +
+	"\x10highest_relation\x18\x03 \x01(\x0e2\x1e.paperless.service.v1.RelationR\x0fhighestRelation\"\xed\x03\n" +
 	"\x13RevokeAccessRequest\x12V\n" +
-	"\rresource_type\x18\x01 \x01(\x0e2\".paperless.service.v1.ResourceTypeB\r\xe0A\x02\xbaH\a\x82\x01\x04\x10\x01 \x00R\fresourceType\x12?\n" +
-	"\vresource_id\x18\x02 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\n" +
-	"resourceId\x12?\n" +
-	"\brelation\x18\x03 \x01(\x0e2\x1e.paperless.service.v1.RelationH\x00R\brelation\x88\x01\x01\x12S\n" +
+	"\rresource_type\x18\x01 \x01(\x0e2\".paperless.service.v1.ResourceTypeB\r\xe0A\x02\xbaH\a\x82\x01\x04\x10\x01 \x00R\fresourceType\x12\xa3\x01\n" +
+	"\vresource_id\x18\x02 \x01(\tB\x81\x01\xe0A\x02\xbaH{ry\x10\x01\x18$2s^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})$R\n" +
+	"resourceId\x12I\n" +
+	"\brelation\x18\x03 \x01(\x0e2\x1e.paperless.service.v1.RelationB\b\xbaH\x05\x82\x01\x02\x10\x01H\x00R\brelation\x88\x01\x01\x12S\n" +
 	"\fsubject_type\x18\x04 \x01(\x0e2!.paperless.service.v1.SubjectTypeB\r\xe0A\x02\xbaH\a\x82\x01\x04\x10\x01 \x00R\vsubjectType\x12+\n" +
 	"\n" +
 	"subject_id\x18\x05 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\tsubjectIdB\v\n" +
-	"\t_relation\"\xb3\x03\n" +
-	"\x16ListPermissionsRequest\x12L\n" +
-	"\rresource_type\x18\x01 \x01(\x0e2\".paperless.service.v1.ResourceTypeH\x00R\fresourceType\x88\x01\x01\x12?\n" +
-	"\vresource_id\x18\x02 \x01(\tB\x19\xbaH\x16r\x14\x18$2\x10^[a-fA-F0-9\\-]*$H\x01R\n" +
-	"resourceId\x88\x01\x01\x12I\n" +
-	"\fsubject_type\x18\x03 \x01(\x0e2!.paperless.service.v1.SubjectTypeH\x02R\vsubjectType\x88\x01\x01\x12+\n" +
+	"\t_relation\"\xb6\x04\n" +
+	"\x16ListPermissionsRequest\x12V\n" +
+	"\rresource_type\x18\x01 \x01(\x0e2\".paperless.service.v1.ResourceTypeB\b\xbaH\x05\x82\x01\x02\x10\x01H\x00R\fresourceType\x88\x01\x01\x12\xa3\x01\n" +
+	"\vresource_id\x18\x02 \x01(\tB}\xbaHzrx\x18$2t^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})?$H\x01R\n" +
+	"resourceId\x88\x01\x01\x12S\n" +
+	"\fsubject_type\x18\x03 \x01(\x0e2!.paperless.service.v1.SubjectTypeB\b\xbaH\x05\x82\x01\x02\x10\x01H\x02R\vsubjectType\x88\x01\x01\x12+\n" +
 	"\n" +
 	"subject_id\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x18$H\x03R\tsubjectId\x88\x01\x01\x12\x17\n" +
-	"\x04page\x18\x05 \x01(\rH\x04R\x04page\x88\x01\x01\x12 \n" +
-	"\tpage_size\x18\x06 \x01(\rH\x05R\bpageSize\x88\x01\x01B\x10\n" +
+	"\x04page\x18\x05 \x01(\rH\x04R\x04page\x88\x01\x01\x12*\n" +
+	"\tpage_size\x18\x06 \x01(\rB\b\xbaH\x05*\x03\x18\xe8\aH\x05R\bpageSize\x88\x01\x01B\x10\n" +
 	"\x0e_resource_typeB\x0e\n" +
 	"\f_resource_idB\x0f\n" +
 	"\r_subject_typeB\r\n" +
@@ -1159,37 +1516,42 @@ const file_paperless_service_v1_permission_proto_rawDesc = "" +
 	"_page_size\"x\n" +
 	"\x17ListPermissionsResponse\x12G\n" +
 	"\vpermissions\x18\x01 \x03(\v2%.paperless.service.v1.PermissionTupleR\vpermissions\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\rR\x05total\"\xa5\x02\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total\"\xfc\x03\n" +
 	"\x12CheckAccessRequest\x12%\n" +
 	"\auser_id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\x06userId\x12V\n" +
-	"\rresource_type\x18\x02 \x01(\x0e2\".paperless.service.v1.ResourceTypeB\r\xe0A\x02\xbaH\a\x82\x01\x04\x10\x01 \x00R\fresourceType\x12?\n" +
-	"\vresource_id\x18\x03 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\n" +
+	"\rresource_type\x18\x02 \x01(\x0e2\".paperless.service.v1.ResourceTypeB\r\xe0A\x02\xbaH\a\x82\x01\x04\x10\x01 \x00R\fresourceType\x12\xa3\x01\n" +
+	"\vresource_id\x18\x03 \x01(\tB\x81\x01\xe0A\x02\xbaH{ry\x10\x01\x18$2s^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})$R\n" +
 	"resourceId\x12O\n" +
 	"\n" +
 	"permission\x18\x04 \x01(\x0e2 .paperless.service.v1.PermissionB\r\xe0A\x02\xbaH\a\x82\x01\x04\x10\x01 \x00R\n" +
-	"permission\"W\n" +
+	"permission\x12)\n" +
+	"\tclient_ip\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x18@H\x00R\bclientIp\x88\x01\x01\x12&\n" +
+	"\fmfa_verified\x18\x06 \x01(\bH\x01R\vmfaVerified\x88\x01\x01B\f\n" +
+	"\n" +
+	"_client_ipB\x0f\n" +
+	"\r_mfa_verified\"W\n" +
 	"\x13CheckAccessResponse\x12\x18\n" +
 	"\aallowed\x18\x01 \x01(\bR\aallowed\x12\x1b\n" +
 	"\x06reason\x18\x02 \x01(\tH\x00R\x06reason\x88\x01\x01B\t\n" +
-	"\a_reason\"\xc2\x02\n" +
+	"\a_reason\"\xcc\x02\n" +
 	"\x1eListAccessibleResourcesRequest\x12%\n" +
 	"\auser_id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\x06userId\x12V\n" +
 	"\rresource_type\x18\x02 \x01(\x0e2\".paperless.service.v1.ResourceTypeB\r\xe0A\x02\xbaH\a\x82\x01\x04\x10\x01 \x00R\fresourceType\x12O\n" +
 	"\n" +
 	"permission\x18\x03 \x01(\x0e2 .paperless.service.v1.PermissionB\r\xe0A\x02\xbaH\a\x82\x01\x04\x10\x01 \x00R\n" +
 	"permission\x12\x17\n" +
-	"\x04page\x18\x04 \x01(\rH\x00R\x04page\x88\x01\x01\x12 \n" +
-	"\tpage_size\x18\x05 \x01(\rH\x01R\bpageSize\x88\x01\x01B\a\n" +
+	"\x04page\x18\x04 \x01(\rH\x00R\x04page\x88\x01\x01\x12*\n" +
+	"\tpage_size\x18\x05 \x01(\rB\b\xbaH\x05*\x03\x18\xe8\aH\x01R\bpageSize\x88\x01\x01B\a\n" +
 	"\x05_pageB\f\n" +
 	"\n" +
 	"_page_size\"Z\n" +
 	"\x1fListAccessibleResourcesResponse\x12!\n" +
 	"\fresource_ids\x18\x01 \x03(\tR\vresourceIds\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\rR\x05total\"\xe0\x01\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total\"\xc5\x02\n" +
 	"\x1eGetEffectivePermissionsRequest\x12%\n" +
 	"\auser_id\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18$R\x06userId\x12V\n" +
-	"\rresource_type\x18\x02 \x01(\x0e2\".paperless.service.v1.ResourceTypeB\r\xe0A\x02\xbaH\a\x82\x01\x04\x10\x01 \x00R\fresourceType\x12?\n" +
-	"\vresource_id\x18\x03 \x01(\tB\x1e\xe0A\x02\xbaH\x18r\x16\x10\x01\x18$2\x10^[a-fA-F0-9\\-]+$R\n" +
+	"\rresource_type\x18\x02 \x01(\x0e2\".paperless.service.v1.ResourceTypeB\r\xe0A\x02\xbaH\a\x82\x01\x04\x10\x01 \x00R\fresourceType\x12\xa3\x01\n" +
+	"\vresource_id\x18\x03 \x01(\tB\x81\x01\xe0A\x02\xbaH{ry\x10\x01\x18$2s^([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26})$R\n" +
 	"resourceId\"\xb0\x01\n" +
 	"\x1fGetEffectivePermissionsResponse\x12B\n" +
 	"\vpermissions\x18\x01 \x03(\x0e2 .paperless.service.v1.PermissionR\vpermissions\x12I\n" +
@@ -1203,12 +1565,13 @@ const file_paperless_service_v1_permission_proto_rawDesc = "" +
 	"\x0eRELATION_OWNER\x10\x01\x12\x13\n" +
 	"\x0fRELATION_EDITOR\x10\x02\x12\x13\n" +
 	"\x0fRELATION_VIEWER\x10\x03\x12\x13\n" +
-	"\x0fRELATION_SHARER\x10\x04*r\n" +
+	"\x0fRELATION_SHARER\x10\x04*\x8a\x01\n" +
 	"\vSubjectType\x12\x1c\n" +
 	"\x18SUBJECT_TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11SUBJECT_TYPE_USER\x10\x01\x12\x15\n" +
 	"\x11SUBJECT_TYPE_ROLE\x10\x02\x12\x17\n" +
-	"\x13SUBJECT_TYPE_TENANT\x10\x03*\x99\x01\n" +
+	"\x13SUBJECT_TYPE_TENANT\x10\x03\x12\x16\n" +
+	"\x12SUBJECT_TYPE_GROUP\x10\x04*\x99\x01\n" +
 	"\n" +
 	"Permission\x12\x1a\n" +
 	"\x16PERMISSION_UNSPECIFIED\x10\x00\x12\x13\n" +
@@ -1216,13 +1579,14 @@ const file_paperless_service_v1_permission_proto_rawDesc = "" +
 	"\x10PERMISSION_WRITE\x10\x02\x12\x15\n" +
 	"\x11PERMISSION_DELETE\x10\x03\x12\x14\n" +
 	"\x10PERMISSION_SHARE\x10\x04\x12\x17\n" +
-	"\x13PERMISSION_DOWNLOAD\x10\x052\xf2\x06\n" +
+	"\x13PERMISSION_DOWNLOAD\x10\x052\x8b\b\n" +
 	"\x1aPaperlessPermissionService\x12~\n" +
 	"\vGrantAccess\x12(.paperless.service.v1.GrantAccessRequest\x1a).paperless.service.v1.GrantAccessResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/permissions\x12j\n" +
 	"\fRevokeAccess\x12).paperless.service.v1.RevokeAccessRequest\x1a\x16.google.protobuf.Empty\"\x17\x82\xd3\xe4\x93\x02\x11*\x0f/v1/permissions\x12\x87\x01\n" +
 	"\x0fListPermissions\x12,.paperless.service.v1.ListPermissionsRequest\x1a-.paperless.service.v1.ListPermissionsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/permissions\x12\x84\x01\n" +
 	"\vCheckAccess\x12(.paperless.service.v1.CheckAccessRequest\x1a).paperless.service.v1.CheckAccessResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/permissions/check\x12\xaa\x01\n" +
-	"\x17ListAccessibleResources\x124.paperless.service.v1.ListAccessibleResourcesRequest\x1a5.paperless.service.v1.ListAccessibleResourcesResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/permissions/accessible\x12\xa9\x01\n" +
+	"\x17ListAccessibleResources\x124.paperless.service.v1.ListAccessibleResourcesRequest\x1a5.paperless.service.v1.ListAccessibleResourcesResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/permissions/accessible\x12\x96\x01\n" +
+	"\rShareDocument\x12*.paperless.service.v1.ShareDocumentRequest\x1a+.paperless.service.v1.ShareDocumentResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/documents/{document_id}/share\x12\xa9\x01\n" +
 	"\x17GetEffectivePermissions\x124.paperless.service.v1.GetEffectivePermissionsRequest\x1a5.paperless.service.v1.GetEffectivePermissionsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/permissions/effectiveB\xef\x01\n" +
 	"\x18com.paperless.service.v1B\x0fPermissionProtoP\x01ZPgithub.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1;paperlesspb\xa2\x02\x03PSX\xaa\x02\x14Paperless.Service.V1\xca\x02\x14Paperless\\Service\\V1\xe2\x02 Paperless\\Service\\V1\\GPBMetadata\xea\x02\x16Paperless::Service::V1b\x06proto3"
 
@@ -1239,68 +1603,83 @@ func file_paperless_service_v1_permission_proto_rawDescGZIP() []byte {
 }
 
 var file_paperless_service_v1_permission_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_paperless_service_v1_permission_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_paperless_service_v1_permission_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_paperless_service_v1_permission_proto_goTypes = []any{
 	(ResourceType)(0),                       // 0: paperless.service.v1.ResourceType
 	(Relation)(0),                           // 1: paperless.service.v1.Relation
 	(SubjectType)(0),                        // 2: paperless.service.v1.SubjectType
 	(Permission)(0),                         // 3: paperless.service.v1.Permission
-	(*PermissionTuple)(nil),                 // 4: paperless.service.v1.PermissionTuple
-	(*GrantAccessRequest)(nil),              // 5: paperless.service.v1.GrantAccessRequest
-	(*GrantAccessResponse)(nil),             // 6: paperless.service.v1.GrantAccessResponse
-	(*RevokeAccessRequest)(nil),             // 7: paperless.service.v1.RevokeAccessRequest
-	(*ListPermissionsRequest)(nil),          // 8: paperless.service.v1.ListPermissionsRequest
-	(*ListPermissionsResponse)(nil),         // 9: paperless.service.v1.ListPermissionsResponse
-	(*CheckAccessRequest)(nil),              // 10: paperless.service.v1.CheckAccessRequest
-	(*CheckAccessResponse)(nil),             // 11: paperless.service.v1.CheckAccessResponse
-	(*ListAccessibleResourcesRequest)(nil),  // 12: paperless.service.v1.ListAccessibleResourcesRequest
-	(*ListAccessibleResourcesResponse)(nil), // 13: paperless.service.v1.ListAccessibleResourcesResponse
-	(*GetEffectivePermissionsRequest)(nil),  // 14: paperless.service.v1.GetEffectivePermissionsRequest
-	(*GetEffectivePermissionsResponse)(nil), // 15: paperless.service.v1.GetEffectivePermissionsResponse
-	(*timestamppb.Timestamp)(nil),           // 16: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                   // 17: google.protobuf.Empty
+	(*TimeWindow)(nil),                      // 4: paperless.service.v1.TimeWindow
+	(*PermissionConditions)(nil),            // 5: paperless.service.v1.PermissionConditions
+	(*PermissionTuple)(nil),                 // 6: paperless.service.v1.PermissionTuple
+	(*GrantAccessRequest)(nil),              // 7: paperless.service.v1.GrantAccessRequest
+	(*GrantAccessResponse)(nil),             // 8: paperless.service.v1.GrantAccessResponse
+	(*ShareDocumentRequest)(nil),            // 9: paperless.service.v1.ShareDocumentRequest
+	(*ShareDocumentResponse)(nil),           // 10: paperless.service.v1.ShareDocumentResponse
+	(*RevokeAccessRequest)(nil),             // 11: paperless.service.v1.RevokeAccessRequest
+	(*ListPermissionsRequest)(nil),          // 12: paperless.service.v1.ListPermissionsRequest
+	(*ListPermissionsResponse)(nil),         // 13: paperless.service.v1.ListPermissionsResponse
+	(*CheckAccessRequest)(nil),              // 14: paperless.service.v1.CheckAccessRequest
+	(*CheckAccessResponse)(nil),             // 15: paperless.service.v1.CheckAccessResponse
+	(*ListAccessibleResourcesRequest)(nil),  // 16: paperless.service.v1.ListAccessibleResourcesRequest
+	(*ListAccessibleResourcesResponse)(nil), // 17: paperless.service.v1.ListAccessibleResourcesResponse
+	(*GetEffectivePermissionsRequest)(nil),  // 18: paperless.service.v1.GetEffectivePermissionsRequest
+	(*GetEffectivePermissionsResponse)(nil), // 19: paperless.service.v1.GetEffectivePermissionsResponse
+	(*timestamppb.Timestamp)(nil),           // 20: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                   // 21: google.protobuf.Empty
 }
 var file_paperless_service_v1_permission_proto_depIdxs = []int32{
-	0,  // 0: paperless.service.v1.PermissionTuple.resource_type:type_name -> paperless.service.v1.ResourceType
-	1,  // 1: paperless.service.v1.PermissionTuple.relation:type_name -> paperless.service.v1.Relation
-	2,  // 2: paperless.service.v1.PermissionTuple.subject_type:type_name -> paperless.service.v1.SubjectType
-	16, // 3: paperless.service.v1.PermissionTuple.expires_at:type_name -> google.protobuf.Timestamp
-	16, // 4: paperless.service.v1.PermissionTuple.create_time:type_name -> google.protobuf.Timestamp
-	0,  // 5: paperless.service.v1.GrantAccessRequest.resource_type:type_name -> paperless.service.v1.ResourceType
-	1,  // 6: paperless.service.v1.GrantAccessRequest.relation:type_name -> paperless.service.v1.Relation
-	2,  // 7: paperless.service.v1.GrantAccessRequest.subject_type:type_name -> paperless.service.v1.SubjectType
-	16, // 8: paperless.service.v1.GrantAccessRequest.expires_at:type_name -> google.protobuf.Timestamp
-	4,  // 9: paperless.service.v1.GrantAccessResponse.permission:type_name -> paperless.service.v1.PermissionTuple
-	0,  // 10: paperless.service.v1.RevokeAccessRequest.resource_type:type_name -> paperless.service.v1.ResourceType
-	1,  // 11: paperless.service.v1.RevokeAccessRequest.relation:type_name -> paperless.service.v1.Relation
-	2,  // 12: paperless.service.v1.RevokeAccessRequest.subject_type:type_name -> paperless.service.v1.SubjectType
-	0,  // 13: paperless.service.v1.ListPermissionsRequest.resource_type:type_name -> paperless.service.v1.ResourceType
-	2,  // 14: paperless.service.v1.ListPermissionsRequest.subject_type:type_name -> paperless.service.v1.SubjectType
-	4,  // 15: paperless.service.v1.ListPermissionsResponse.permissions:type_name -> paperless.service.v1.PermissionTuple
-	0,  // 16: paperless.service.v1.CheckAccessRequest.resource_type:type_name -> paperless.service.v1.ResourceType
-	3,  // 17: paperless.service.v1.CheckAccessRequest.permission:type_name -> paperless.service.v1.Permission
-	0,  // 18: paperless.service.v1.ListAccessibleResourcesRequest.resource_type:type_name -> paperless.service.v1.ResourceType
-	3,  // 19: paperless.service.v1.ListAccessibleResourcesRequest.permission:type_name -> paperless.service.v1.Permission
-	0,  // 20: paperless.service.v1.GetEffectivePermissionsRequest.resource_type:type_name -> paperless.service.v1.ResourceType
-	3,  // 21: paperless.service.v1.GetEffectivePermissionsResponse.permissions:type_name -> paperless.service.v1.Permission
-	1,  // 22: paperless.service.v1.GetEffectivePermissionsResponse.highest_relation:type_name -> paperless.service.v1.Relation
-	5,  // 23: paperless.service.v1.PaperlessPermissionService.GrantAccess:input_type -> paperless.service.v1.GrantAccessRequest
-	7,  // 24: paperless.service.v1.PaperlessPermissionService.RevokeAccess:input_type -> paperless.service.v1.RevokeAccessRequest
-	8,  // 25: paperless.service.v1.PaperlessPermissionService.ListPermissions:input_type -> paperless.service.v1.ListPermissionsRequest
-	10, // 26: paperless.service.v1.PaperlessPermissionService.CheckAccess:input_type -> paperless.service.v1.CheckAccessRequest
-	12, // 27: paperless.service.v1.PaperlessPermissionService.ListAccessibleResources:input_type -> paperless.service.v1.ListAccessibleResourcesRequest
-	14, // 28: paperless.service.v1.PaperlessPermissionService.GetEffectivePermissions:input_type -> paperless.service.v1.GetEffectivePermissionsRequest
-	6,  // 29: paperless.service.v1.PaperlessPermissionService.GrantAccess:output_type -> paperless.service.v1.GrantAccessResponse
-	17, // 30: paperless.service.v1.PaperlessPermissionService.RevokeAccess:output_type -> google.protobuf.Empty
-	9,  // 31: paperless.service.v1.PaperlessPermissionService.ListPermissions:output_type -> paperless.service.v1.ListPermissionsResponse
-	11, // 32: paperless.service.v1.PaperlessPermissionService.CheckAccess:output_type -> paperless.service.v1.CheckAccessResponse
-	13, // 33: paperless.service.v1.PaperlessPermissionService.ListAccessibleResources:output_type -> paperless.service.v1.ListAccessibleResourcesResponse
-	15, // 34: paperless.service.v1.PaperlessPermissionService.GetEffectivePermissions:output_type -> paperless.service.v1.GetEffectivePermissionsResponse
-	29, // [29:35] is the sub-list for method output_type
-	23, // [23:29] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	4,  // 0: paperless.service.v1.PermissionConditions.time_window:type_name -> paperless.service.v1.TimeWindow
+	0,  // 1: paperless.service.v1.PermissionTuple.resource_type:type_name -> paperless.service.v1.ResourceType
+	1,  // 2: paperless.service.v1.PermissionTuple.relation:type_name -> paperless.service.v1.Relation
+	2,  // 3: paperless.service.v1.PermissionTuple.subject_type:type_name -> paperless.service.v1.SubjectType
+	20, // 4: paperless.service.v1.PermissionTuple.expires_at:type_name -> google.protobuf.Timestamp
+	20, // 5: paperless.service.v1.PermissionTuple.create_time:type_name -> google.protobuf.Timestamp
+	5,  // 6: paperless.service.v1.PermissionTuple.conditions:type_name -> paperless.service.v1.PermissionConditions
+	0,  // 7: paperless.service.v1.GrantAccessRequest.resource_type:type_name -> paperless.service.v1.ResourceType
+	1,  // 8: paperless.service.v1.GrantAccessRequest.relation:type_name -> paperless.service.v1.Relation
+	2,  // 9: paperless.service.v1.GrantAccessRequest.subject_type:type_name -> paperless.service.v1.SubjectType
+	20, // 10: paperless.service.v1.GrantAccessRequest.expires_at:type_name -> google.protobuf.Timestamp
+	5,  // 11: paperless.service.v1.GrantAccessRequest.conditions:type_name -> paperless.service.v1.PermissionConditions
+	6,  // 12: paperless.service.v1.GrantAccessResponse.permission:type_name -> paperless.service.v1.PermissionTuple
+	1,  // 13: paperless.service.v1.ShareDocumentRequest.relation:type_name -> paperless.service.v1.Relation
+	2,  // 14: paperless.service.v1.ShareDocumentRequest.subject_type:type_name -> paperless.service.v1.SubjectType
+	20, // 15: paperless.service.v1.ShareDocumentRequest.expires_at:type_name -> google.protobuf.Timestamp
+	6,  // 16: paperless.service.v1.ShareDocumentResponse.permission:type_name -> paperless.service.v1.PermissionTuple
+	3,  // 17: paperless.service.v1.ShareDocumentResponse.effective_permissions:type_name -> paperless.service.v1.Permission
+	1,  // 18: paperless.service.v1.ShareDocumentResponse.highest_relation:type_name -> paperless.service.v1.Relation
+	0,  // 19: paperless.service.v1.RevokeAccessRequest.resource_type:type_name -> paperless.service.v1.ResourceType
+	1,  // 20: paperless.service.v1.RevokeAccessRequest.relation:type_name -> paperless.service.v1.Relation
+	2,  // 21: paperless.service.v1.RevokeAccessRequest.subject_type:type_name -> paperless.service.v1.SubjectType
+	0,  // 22: paperless.service.v1.ListPermissionsRequest.resource_type:type_name -> paperless.service.v1.ResourceType
+	2,  // 23: paperless.service.v1.ListPermissionsRequest.subject_type:type_name -> paperless.service.v1.SubjectType
+	6,  // 24: paperless.service.v1.ListPermissionsResponse.permissions:type_name -> paperless.service.v1.PermissionTuple
+	0,  // 25: paperless.service.v1.CheckAccessRequest.resource_type:type_name -> paperless.service.v1.ResourceType
+	3,  // 26: paperless.service.v1.CheckAccessRequest.permission:type_name -> paperless.service.v1.Permission
+	0,  // 27: paperless.service.v1.ListAccessibleResourcesRequest.resource_type:type_name -> paperless.service.v1.ResourceType
+	3,  // 28: paperless.service.v1.ListAccessibleResourcesRequest.permission:type_name -> paperless.service.v1.Permission
+	0,  // 29: paperless.service.v1.GetEffectivePermissionsRequest.resource_type:type_name -> paperless.service.v1.ResourceType
+	3,  // 30: paperless.service.v1.GetEffectivePermissionsResponse.permissions:type_name -> paperless.service.v1.Permission
+	1,  // 31: paperless.service.v1.GetEffectivePermissionsResponse.highest_relation:type_name -> paperless.service.v1.Relation
+	7,  // 32: paperless.service.v1.PaperlessPermissionService.GrantAccess:input_type -> paperless.service.v1.GrantAccessRequest
+	11, // 33: paperless.service.v1.PaperlessPermissionService.RevokeAccess:input_type -> paperless.service.v1.RevokeAccessRequest
+	12, // 34: paperless.service.v1.PaperlessPermissionService.ListPermissions:input_type -> paperless.service.v1.ListPermissionsRequest
+	14, // 35: paperless.service.v1.PaperlessPermissionService.CheckAccess:input_type -> paperless.service.v1.CheckAccessRequest
+	16, // 36: paperless.service.v1.PaperlessPermissionService.ListAccessibleResources:input_type -> paperless.service.v1.ListAccessibleResourcesRequest
+	9,  // 37: paperless.service.v1.PaperlessPermissionService.ShareDocument:input_type -> paperless.service.v1.ShareDocumentRequest
+	18, // 38: paperless.service.v1.PaperlessPermissionService.GetEffectivePermissions:input_type -> paperless.service.v1.GetEffectivePermissionsRequest
+	8,  // 39: paperless.service.v1.PaperlessPermissionService.GrantAccess:output_type -> paperless.service.v1.GrantAccessResponse
+	21, // 40: paperless.service.v1.PaperlessPermissionService.RevokeAccess:output_type -> google.protobuf.Empty
+	13, // 41: paperless.service.v1.PaperlessPermissionService.ListPermissions:output_type -> paperless.service.v1.ListPermissionsResponse
+	15, // 42: paperless.service.v1.PaperlessPermissionService.CheckAccess:output_type -> paperless.service.v1.CheckAccessResponse
+	17, // 43: paperless.service.v1.PaperlessPermissionService.ListAccessibleResources:output_type -> paperless.service.v1.ListAccessibleResourcesResponse
+	10, // 44: paperless.service.v1.PaperlessPermissionService.ShareDocument:output_type -> paperless.service.v1.ShareDocumentResponse
+	19, // 45: paperless.service.v1.PaperlessPermissionService.GetEffectivePermissions:output_type -> paperless.service.v1.GetEffectivePermissionsResponse
+	39, // [39:46] is the sub-list for method output_type
+	32, // [32:39] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_paperless_service_v1_permission_proto_init() }
@@ -1310,17 +1689,21 @@ func file_paperless_service_v1_permission_proto_init() {
 	}
 	file_paperless_service_v1_permission_proto_msgTypes[0].OneofWrappers = []any{}
 	file_paperless_service_v1_permission_proto_msgTypes[1].OneofWrappers = []any{}
+	file_paperless_service_v1_permission_proto_msgTypes[2].OneofWrappers = []any{}
 	file_paperless_service_v1_permission_proto_msgTypes[3].OneofWrappers = []any{}
-	file_paperless_service_v1_permission_proto_msgTypes[4].OneofWrappers = []any{}
+	file_paperless_service_v1_permission_proto_msgTypes[5].OneofWrappers = []any{}
 	file_paperless_service_v1_permission_proto_msgTypes[7].OneofWrappers = []any{}
 	file_paperless_service_v1_permission_proto_msgTypes[8].OneofWrappers = []any{}
+	file_paperless_service_v1_permission_proto_msgTypes[10].OneofWrappers = []any{}
+	file_paperless_service_v1_permission_proto_msgTypes[11].OneofWrappers = []any{}
+	file_paperless_service_v1_permission_proto_msgTypes[12].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_paperless_service_v1_permission_proto_rawDesc), len(file_paperless_service_v1_permission_proto_rawDesc)),
 			NumEnums:      4,
-			NumMessages:   12,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
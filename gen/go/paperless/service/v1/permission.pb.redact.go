@@ -101,6 +101,17 @@ func (s *redactedPaperlessPermissionServiceServer) ListAccessibleResources(ctx c
 	return res, err
 }
 
+// ShareDocument is the redacted wrapper for the actual PaperlessPermissionServiceServer.ShareDocument method
+// Unary RPC
+func (s *redactedPaperlessPermissionServiceServer) ShareDocument(ctx context.Context, in *ShareDocumentRequest) (*ShareDocumentResponse, error) {
+	res, err := s.srv.ShareDocument(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // GetEffectivePermissions is the redacted wrapper for the actual PaperlessPermissionServiceServer.GetEffectivePermissions method
 // Unary RPC
 func (s *redactedPaperlessPermissionServiceServer) GetEffectivePermissions(ctx context.Context, in *GetEffectivePermissionsRequest) (*GetEffectivePermissionsResponse, error) {
@@ -112,6 +123,36 @@ func (s *redactedPaperlessPermissionServiceServer) GetEffectivePermissions(ctx c
 	return res, err
 }
 
+// Redact method implementation for TimeWindow
+func (x *TimeWindow) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Start
+
+	// Safe field: End
+
+	// Safe field: Timezone
+
+	// Safe field: Weekdays
+	return x.String()
+}
+
+// Redact method implementation for PermissionConditions
+func (x *PermissionConditions) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: AllowedCidrs
+
+	// Safe field: TimeWindow
+
+	// Safe field: RequireMfa
+	return x.String()
+}
+
 // Redact method implementation for PermissionTuple
 func (x *PermissionTuple) Redact() string {
 	if x == nil {
@@ -137,6 +178,8 @@ func (x *PermissionTuple) Redact() string {
 	// Safe field: ExpiresAt
 
 	// Safe field: CreateTime
+
+	// Safe field: Conditions
 	return x.String()
 }
 
@@ -157,6 +200,8 @@ func (x *GrantAccessRequest) Redact() string {
 	// Safe field: SubjectId
 
 	// Safe field: ExpiresAt
+
+	// Safe field: Conditions
 	return x.String()
 }
 
@@ -170,6 +215,38 @@ func (x *GrantAccessResponse) Redact() string {
 	return x.String()
 }
 
+// Redact method implementation for ShareDocumentRequest
+func (x *ShareDocumentRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: DocumentId
+
+	// Safe field: Relation
+
+	// Safe field: SubjectType
+
+	// Safe field: SubjectId
+
+	// Safe field: ExpiresAt
+	return x.String()
+}
+
+// Redact method implementation for ShareDocumentResponse
+func (x *ShareDocumentResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Permission
+
+	// Safe field: EffectivePermissions
+
+	// Safe field: HighestRelation
+	return x.String()
+}
+
 // Redact method implementation for RevokeAccessRequest
 func (x *RevokeAccessRequest) Redact() string {
 	if x == nil {
@@ -233,6 +310,10 @@ func (x *CheckAccessRequest) Redact() string {
 	// Safe field: ResourceId
 
 	// Safe field: Permission
+
+	// Safe field: ClientIp
+
+	// Safe field: MfaVerified
 	return x.String()
 }
 
@@ -35,6 +35,250 @@ var (
 	_ = sort.Sort
 )
 
+// Validate checks the field values on TimeWindow with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TimeWindow) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TimeWindow with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TimeWindowMultiError, or
+// nil if none found.
+func (m *TimeWindow) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TimeWindow) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Start
+
+	// no validation rules for End
+
+	if m.Timezone != nil {
+		// no validation rules for Timezone
+	}
+
+	if len(errors) > 0 {
+		return TimeWindowMultiError(errors)
+	}
+
+	return nil
+}
+
+// TimeWindowMultiError is an error wrapping multiple validation errors
+// returned by TimeWindow.ValidateAll() if the designated constraints aren't met.
+type TimeWindowMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TimeWindowMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TimeWindowMultiError) AllErrors() []error { return m }
+
+// TimeWindowValidationError is the validation error returned by
+// TimeWindow.Validate if the designated constraints aren't met.
+type TimeWindowValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TimeWindowValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TimeWindowValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TimeWindowValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TimeWindowValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TimeWindowValidationError) ErrorName() string { return "TimeWindowValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TimeWindowValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTimeWindow.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TimeWindowValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TimeWindowValidationError{}
+
+// Validate checks the field values on PermissionConditions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PermissionConditions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PermissionConditions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PermissionConditionsMultiError, or nil if none found.
+func (m *PermissionConditions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PermissionConditions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RequireMfa
+
+	if m.TimeWindow != nil {
+
+		if all {
+			switch v := interface{}(m.GetTimeWindow()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PermissionConditionsValidationError{
+						field:  "TimeWindow",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PermissionConditionsValidationError{
+						field:  "TimeWindow",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTimeWindow()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PermissionConditionsValidationError{
+					field:  "TimeWindow",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PermissionConditionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// PermissionConditionsMultiError is an error wrapping multiple validation
+// errors returned by PermissionConditions.ValidateAll() if the designated
+// constraints aren't met.
+type PermissionConditionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionConditionsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionConditionsMultiError) AllErrors() []error { return m }
+
+// PermissionConditionsValidationError is the validation error returned by
+// PermissionConditions.Validate if the designated constraints aren't met.
+type PermissionConditionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionConditionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionConditionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionConditionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionConditionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionConditionsValidationError) ErrorName() string {
+	return "PermissionConditionsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PermissionConditionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionConditions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionConditionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionConditionsValidationError{}
+
 // Validate checks the field values on PermissionTuple with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -137,6 +381,39 @@ func (m *PermissionTuple) validate(all bool) error {
 
 	}
 
+	if m.Conditions != nil {
+
+		if all {
+			switch v := interface{}(m.GetConditions()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PermissionTupleValidationError{
+						field:  "Conditions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PermissionTupleValidationError{
+						field:  "Conditions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetConditions()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PermissionTupleValidationError{
+					field:  "Conditions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return PermissionTupleMultiError(errors)
 	}
@@ -280,6 +557,39 @@ func (m *GrantAccessRequest) validate(all bool) error {
 
 	}
 
+	if m.Conditions != nil {
+
+		if all {
+			switch v := interface{}(m.GetConditions()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GrantAccessRequestValidationError{
+						field:  "Conditions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GrantAccessRequestValidationError{
+						field:  "Conditions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetConditions()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GrantAccessRequestValidationError{
+					field:  "Conditions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return GrantAccessRequestMultiError(errors)
 	}
@@ -491,6 +801,282 @@ var _ interface {
 	ErrorName() string
 } = GrantAccessResponseValidationError{}
 
+// Validate checks the field values on ShareDocumentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ShareDocumentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ShareDocumentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ShareDocumentRequestMultiError, or nil if none found.
+func (m *ShareDocumentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ShareDocumentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DocumentId
+
+	// no validation rules for Relation
+
+	// no validation rules for SubjectType
+
+	// no validation rules for SubjectId
+
+	if m.ExpiresAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetExpiresAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ShareDocumentRequestValidationError{
+						field:  "ExpiresAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ShareDocumentRequestValidationError{
+						field:  "ExpiresAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ShareDocumentRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ShareDocumentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ShareDocumentRequestMultiError is an error wrapping multiple validation
+// errors returned by ShareDocumentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ShareDocumentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShareDocumentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShareDocumentRequestMultiError) AllErrors() []error { return m }
+
+// ShareDocumentRequestValidationError is the validation error returned by
+// ShareDocumentRequest.Validate if the designated constraints aren't met.
+type ShareDocumentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShareDocumentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShareDocumentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShareDocumentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShareDocumentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShareDocumentRequestValidationError) ErrorName() string {
+	return "ShareDocumentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ShareDocumentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShareDocumentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShareDocumentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShareDocumentRequestValidationError{}
+
+// Validate checks the field values on ShareDocumentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ShareDocumentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ShareDocumentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ShareDocumentResponseMultiError, or nil if none found.
+func (m *ShareDocumentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ShareDocumentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPermission()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShareDocumentResponseValidationError{
+					field:  "Permission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShareDocumentResponseValidationError{
+					field:  "Permission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPermission()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShareDocumentResponseValidationError{
+				field:  "Permission",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for HighestRelation
+
+	if len(errors) > 0 {
+		return ShareDocumentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ShareDocumentResponseMultiError is an error wrapping multiple validation
+// errors returned by ShareDocumentResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ShareDocumentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShareDocumentResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShareDocumentResponseMultiError) AllErrors() []error { return m }
+
+// ShareDocumentResponseValidationError is the validation error returned by
+// ShareDocumentResponse.Validate if the designated constraints aren't met.
+type ShareDocumentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShareDocumentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShareDocumentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShareDocumentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShareDocumentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShareDocumentResponseValidationError) ErrorName() string {
+	return "ShareDocumentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ShareDocumentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShareDocumentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShareDocumentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShareDocumentResponseValidationError{}
+
 // Validate checks the field values on RevokeAccessRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -899,6 +1485,14 @@ func (m *CheckAccessRequest) validate(all bool) error {
 
 	// no validation rules for Permission
 
+	if m.ClientIp != nil {
+		// no validation rules for ClientIp
+	}
+
+	if m.MfaVerified != nil {
+		// no validation rules for MfaVerified
+	}
+
 	if len(errors) > 0 {
 		return CheckAccessRequestMultiError(errors)
 	}
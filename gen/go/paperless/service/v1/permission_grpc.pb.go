@@ -25,6 +25,7 @@ const (
 	PaperlessPermissionService_ListPermissions_FullMethodName         = "/paperless.service.v1.PaperlessPermissionService/ListPermissions"
 	PaperlessPermissionService_CheckAccess_FullMethodName             = "/paperless.service.v1.PaperlessPermissionService/CheckAccess"
 	PaperlessPermissionService_ListAccessibleResources_FullMethodName = "/paperless.service.v1.PaperlessPermissionService/ListAccessibleResources"
+	PaperlessPermissionService_ShareDocument_FullMethodName           = "/paperless.service.v1.PaperlessPermissionService/ShareDocument"
 	PaperlessPermissionService_GetEffectivePermissions_FullMethodName = "/paperless.service.v1.PaperlessPermissionService/GetEffectivePermissions"
 )
 
@@ -44,6 +45,8 @@ type PaperlessPermissionServiceClient interface {
 	CheckAccess(ctx context.Context, in *CheckAccessRequest, opts ...grpc.CallOption) (*CheckAccessResponse, error)
 	// List resources accessible by a subject
 	ListAccessibleResources(ctx context.Context, in *ListAccessibleResourcesRequest, opts ...grpc.CallOption) (*ListAccessibleResourcesResponse, error)
+	// Share a document with a user or role and notify them, returning their resulting permissions
+	ShareDocument(ctx context.Context, in *ShareDocumentRequest, opts ...grpc.CallOption) (*ShareDocumentResponse, error)
 	// Get effective permissions for a subject on a resource
 	GetEffectivePermissions(ctx context.Context, in *GetEffectivePermissionsRequest, opts ...grpc.CallOption) (*GetEffectivePermissionsResponse, error)
 }
@@ -106,6 +109,16 @@ func (c *paperlessPermissionServiceClient) ListAccessibleResources(ctx context.C
 	return out, nil
 }
 
+func (c *paperlessPermissionServiceClient) ShareDocument(ctx context.Context, in *ShareDocumentRequest, opts ...grpc.CallOption) (*ShareDocumentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareDocumentResponse)
+	err := c.cc.Invoke(ctx, PaperlessPermissionService_ShareDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *paperlessPermissionServiceClient) GetEffectivePermissions(ctx context.Context, in *GetEffectivePermissionsRequest, opts ...grpc.CallOption) (*GetEffectivePermissionsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetEffectivePermissionsResponse)
@@ -132,6 +145,8 @@ type PaperlessPermissionServiceServer interface {
 	CheckAccess(context.Context, *CheckAccessRequest) (*CheckAccessResponse, error)
 	// List resources accessible by a subject
 	ListAccessibleResources(context.Context, *ListAccessibleResourcesRequest) (*ListAccessibleResourcesResponse, error)
+	// Share a document with a user or role and notify them, returning their resulting permissions
+	ShareDocument(context.Context, *ShareDocumentRequest) (*ShareDocumentResponse, error)
 	// Get effective permissions for a subject on a resource
 	GetEffectivePermissions(context.Context, *GetEffectivePermissionsRequest) (*GetEffectivePermissionsResponse, error)
 	mustEmbedUnimplementedPaperlessPermissionServiceServer()
@@ -159,6 +174,9 @@ func (UnimplementedPaperlessPermissionServiceServer) CheckAccess(context.Context
 func (UnimplementedPaperlessPermissionServiceServer) ListAccessibleResources(context.Context, *ListAccessibleResourcesRequest) (*ListAccessibleResourcesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListAccessibleResources not implemented")
 }
+func (UnimplementedPaperlessPermissionServiceServer) ShareDocument(context.Context, *ShareDocumentRequest) (*ShareDocumentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ShareDocument not implemented")
+}
 func (UnimplementedPaperlessPermissionServiceServer) GetEffectivePermissions(context.Context, *GetEffectivePermissionsRequest) (*GetEffectivePermissionsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetEffectivePermissions not implemented")
 }
@@ -274,6 +292,24 @@ func _PaperlessPermissionService_ListAccessibleResources_Handler(srv interface{}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaperlessPermissionService_ShareDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaperlessPermissionServiceServer).ShareDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaperlessPermissionService_ShareDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaperlessPermissionServiceServer).ShareDocument(ctx, req.(*ShareDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PaperlessPermissionService_GetEffectivePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetEffectivePermissionsRequest)
 	if err := dec(in); err != nil {
@@ -319,6 +355,10 @@ var PaperlessPermissionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListAccessibleResources",
 			Handler:    _PaperlessPermissionService_ListAccessibleResources_Handler,
 		},
+		{
+			MethodName: "ShareDocument",
+			Handler:    _PaperlessPermissionService_ShareDocument_Handler,
+		},
 		{
 			MethodName: "GetEffectivePermissions",
 			Handler:    _PaperlessPermissionService_GetEffectivePermissions_Handler,
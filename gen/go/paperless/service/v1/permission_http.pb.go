@@ -26,6 +26,7 @@ const OperationPaperlessPermissionServiceGrantAccess = "/paperless.service.v1.Pa
 const OperationPaperlessPermissionServiceListAccessibleResources = "/paperless.service.v1.PaperlessPermissionService/ListAccessibleResources"
 const OperationPaperlessPermissionServiceListPermissions = "/paperless.service.v1.PaperlessPermissionService/ListPermissions"
 const OperationPaperlessPermissionServiceRevokeAccess = "/paperless.service.v1.PaperlessPermissionService/RevokeAccess"
+const OperationPaperlessPermissionServiceShareDocument = "/paperless.service.v1.PaperlessPermissionService/ShareDocument"
 
 type PaperlessPermissionServiceHTTPServer interface {
 	// CheckAccess Check if a subject has access to a resource
@@ -40,6 +41,8 @@ type PaperlessPermissionServiceHTTPServer interface {
 	ListPermissions(context.Context, *ListPermissionsRequest) (*ListPermissionsResponse, error)
 	// RevokeAccess Revoke access from a resource
 	RevokeAccess(context.Context, *RevokeAccessRequest) (*emptypb.Empty, error)
+	// ShareDocument Share a document with a user or role and notify them, returning their resulting permissions
+	ShareDocument(context.Context, *ShareDocumentRequest) (*ShareDocumentResponse, error)
 }
 
 func RegisterPaperlessPermissionServiceHTTPServer(s *http.Server, srv PaperlessPermissionServiceHTTPServer) {
@@ -49,6 +52,7 @@ func RegisterPaperlessPermissionServiceHTTPServer(s *http.Server, srv PaperlessP
 	r.GET("/v1/permissions", _PaperlessPermissionService_ListPermissions0_HTTP_Handler(srv))
 	r.POST("/v1/permissions/check", _PaperlessPermissionService_CheckAccess0_HTTP_Handler(srv))
 	r.GET("/v1/permissions/accessible", _PaperlessPermissionService_ListAccessibleResources0_HTTP_Handler(srv))
+	r.POST("/v1/documents/{document_id}/share", _PaperlessPermissionService_ShareDocument0_HTTP_Handler(srv))
 	r.GET("/v1/permissions/effective", _PaperlessPermissionService_GetEffectivePermissions0_HTTP_Handler(srv))
 }
 
@@ -153,6 +157,31 @@ func _PaperlessPermissionService_ListAccessibleResources0_HTTP_Handler(srv Paper
 	}
 }
 
+func _PaperlessPermissionService_ShareDocument0_HTTP_Handler(srv PaperlessPermissionServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ShareDocumentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPaperlessPermissionServiceShareDocument)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ShareDocument(ctx, req.(*ShareDocumentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ShareDocumentResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _PaperlessPermissionService_GetEffectivePermissions0_HTTP_Handler(srv PaperlessPermissionServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetEffectivePermissionsRequest
@@ -185,6 +214,8 @@ type PaperlessPermissionServiceHTTPClient interface {
 	ListPermissions(ctx context.Context, req *ListPermissionsRequest, opts ...http.CallOption) (rsp *ListPermissionsResponse, err error)
 	// RevokeAccess Revoke access from a resource
 	RevokeAccess(ctx context.Context, req *RevokeAccessRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// ShareDocument Share a document with a user or role and notify them, returning their resulting permissions
+	ShareDocument(ctx context.Context, req *ShareDocumentRequest, opts ...http.CallOption) (rsp *ShareDocumentResponse, err error)
 }
 
 type PaperlessPermissionServiceHTTPClientImpl struct {
@@ -278,3 +309,17 @@ func (c *PaperlessPermissionServiceHTTPClientImpl) RevokeAccess(ctx context.Cont
 	}
 	return &out, nil
 }
+
+// ShareDocument Share a document with a user or role and notify them, returning their resulting permissions
+func (c *PaperlessPermissionServiceHTTPClientImpl) ShareDocument(ctx context.Context, in *ShareDocumentRequest, opts ...http.CallOption) (*ShareDocumentResponse, error) {
+	var out ShareDocumentResponse
+	pattern := "/v1/documents/{document_id}/share"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPaperlessPermissionServiceShareDocument))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
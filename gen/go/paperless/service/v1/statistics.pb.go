@@ -7,6 +7,7 @@
 package paperlesspb
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -23,9 +24,69 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// TimeSeriesInterval is the bucket size of a time series
+type TimeSeriesInterval int32
+
+const (
+	TimeSeriesInterval_TIME_SERIES_INTERVAL_UNSPECIFIED TimeSeriesInterval = 0 // Defaults to day
+	TimeSeriesInterval_TIME_SERIES_INTERVAL_DAY         TimeSeriesInterval = 1
+	TimeSeriesInterval_TIME_SERIES_INTERVAL_WEEK        TimeSeriesInterval = 2 // Weeks start on Monday
+	TimeSeriesInterval_TIME_SERIES_INTERVAL_MONTH       TimeSeriesInterval = 3
+)
+
+// Enum value maps for TimeSeriesInterval.
+var (
+	TimeSeriesInterval_name = map[int32]string{
+		0: "TIME_SERIES_INTERVAL_UNSPECIFIED",
+		1: "TIME_SERIES_INTERVAL_DAY",
+		2: "TIME_SERIES_INTERVAL_WEEK",
+		3: "TIME_SERIES_INTERVAL_MONTH",
+	}
+	TimeSeriesInterval_value = map[string]int32{
+		"TIME_SERIES_INTERVAL_UNSPECIFIED": 0,
+		"TIME_SERIES_INTERVAL_DAY":         1,
+		"TIME_SERIES_INTERVAL_WEEK":        2,
+		"TIME_SERIES_INTERVAL_MONTH":       3,
+	}
+)
+
+func (x TimeSeriesInterval) Enum() *TimeSeriesInterval {
+	p := new(TimeSeriesInterval)
+	*p = x
+	return p
+}
+
+func (x TimeSeriesInterval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimeSeriesInterval) Descriptor() protoreflect.EnumDescriptor {
+	return file_paperless_service_v1_statistics_proto_enumTypes[0].Descriptor()
+}
+
+func (TimeSeriesInterval) Type() protoreflect.EnumType {
+	return &file_paperless_service_v1_statistics_proto_enumTypes[0]
+}
+
+func (x TimeSeriesInterval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimeSeriesInterval.Descriptor instead.
+func (TimeSeriesInterval) EnumDescriptor() ([]byte, []int) {
+	return file_paperless_service_v1_statistics_proto_rawDescGZIP(), []int{0}
+}
+
 // GetStatisticsRequest is the request message for GetStatistics
 type GetStatisticsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tenant to report on; defaults to the caller's tenant. Other tenants, or 0 for all
+	// tenants, require platform admin access.
+	TenantId *uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	// Number of most-used tags to return (default 20, max 100)
+	TopTagsLimit *uint32 `protobuf:"varint,2,opt,name=top_tags_limit,json=topTagsLimit,proto3,oneof" json:"top_tags_limit,omitempty"`
+	// Recompute instead of returning cached statistics
+	ForceRefresh  bool `protobuf:"varint,3,opt,name=force_refresh,json=forceRefresh,proto3" json:"force_refresh,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -60,6 +121,27 @@ func (*GetStatisticsRequest) Descriptor() ([]byte, []int) {
 	return file_paperless_service_v1_statistics_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *GetStatisticsRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *GetStatisticsRequest) GetTopTagsLimit() uint32 {
+	if x != nil && x.TopTagsLimit != nil {
+		return *x.TopTagsLimit
+	}
+	return 0
+}
+
+func (x *GetStatisticsRequest) GetForceRefresh() bool {
+	if x != nil {
+		return x.ForceRefresh
+	}
+	return false
+}
+
 // GetStatisticsResponse is the response message for GetStatistics
 type GetStatisticsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -67,8 +149,10 @@ type GetStatisticsResponse struct {
 	Documents *DocumentStatistics `protobuf:"bytes,1,opt,name=documents,proto3" json:"documents,omitempty"`
 	// Category statistics
 	Categories *CategoryStatistics `protobuf:"bytes,2,opt,name=categories,proto3" json:"categories,omitempty"`
-	// Statistics generation timestamp
-	GeneratedAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	// Statistics generation timestamp; cached statistics keep the time they were computed
+	GeneratedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	// Tenant the statistics cover; 0 when they cover all tenants
+	TenantId      uint32 `protobuf:"varint,11,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -124,6 +208,13 @@ func (x *GetStatisticsResponse) GetGeneratedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *GetStatisticsResponse) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
 // DocumentStatistics contains statistics about documents
 type DocumentStatistics struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -131,7 +222,7 @@ type DocumentStatistics struct {
 	TotalCount int64 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	// Documents grouped by status (active, archived, deleted)
 	ByStatus map[string]int64 `protobuf:"bytes,2,rep,name=by_status,json=byStatus,proto3" json:"by_status,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
-	// Documents grouped by source (upload, email)
+	// Documents grouped by source (upload, email, import)
 	BySource map[string]int64 `protobuf:"bytes,3,rep,name=by_source,json=bySource,proto3" json:"by_source,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
 	// Documents grouped by processing status (pending, processing, completed, failed, skipped)
 	ByProcessingStatus map[string]int64 `protobuf:"bytes,4,rep,name=by_processing_status,json=byProcessingStatus,proto3" json:"by_processing_status,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
@@ -143,8 +234,10 @@ type DocumentStatistics struct {
 	RecentUploads_24H int64 `protobuf:"varint,7,opt,name=recent_uploads_24h,json=recentUploads24h,proto3" json:"recent_uploads_24h,omitempty"`
 	// Documents uploaded in the last 7 days
 	RecentUploads_7D int64 `protobuf:"varint,8,opt,name=recent_uploads_7d,json=recentUploads7d,proto3" json:"recent_uploads_7d,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// Most-used tag keys, most documents first
+	TopTags       []*TagCount `protobuf:"bytes,9,rep,name=top_tags,json=topTags,proto3" json:"top_tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DocumentStatistics) Reset() {
@@ -233,6 +326,68 @@ func (x *DocumentStatistics) GetRecentUploads_7D() int64 {
 	return 0
 }
 
+func (x *DocumentStatistics) GetTopTags() []*TagCount {
+	if x != nil {
+		return x.TopTags
+	}
+	return nil
+}
+
+// TagCount is the number of documents carrying a tag key
+type TagCount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tag key
+	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Documents with the tag
+	DocumentCount int64 `protobuf:"varint,2,opt,name=document_count,json=documentCount,proto3" json:"document_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagCount) Reset() {
+	*x = TagCount{}
+	mi := &file_paperless_service_v1_statistics_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagCount) ProtoMessage() {}
+
+func (x *TagCount) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_statistics_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagCount.ProtoReflect.Descriptor instead.
+func (*TagCount) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_statistics_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TagCount) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TagCount) GetDocumentCount() int64 {
+	if x != nil {
+		return x.DocumentCount
+	}
+	return 0
+}
+
 // CategoryStatistics contains statistics about categories
 type CategoryStatistics struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -244,7 +399,7 @@ type CategoryStatistics struct {
 
 func (x *CategoryStatistics) Reset() {
 	*x = CategoryStatistics{}
-	mi := &file_paperless_service_v1_statistics_proto_msgTypes[3]
+	mi := &file_paperless_service_v1_statistics_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -256,7 +411,7 @@ func (x *CategoryStatistics) String() string {
 func (*CategoryStatistics) ProtoMessage() {}
 
 func (x *CategoryStatistics) ProtoReflect() protoreflect.Message {
-	mi := &file_paperless_service_v1_statistics_proto_msgTypes[3]
+	mi := &file_paperless_service_v1_statistics_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -269,7 +424,7 @@ func (x *CategoryStatistics) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CategoryStatistics.ProtoReflect.Descriptor instead.
 func (*CategoryStatistics) Descriptor() ([]byte, []int) {
-	return file_paperless_service_v1_statistics_proto_rawDescGZIP(), []int{3}
+	return file_paperless_service_v1_statistics_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CategoryStatistics) GetTotalCount() int64 {
@@ -279,47 +434,846 @@ func (x *CategoryStatistics) GetTotalCount() int64 {
 	return 0
 }
 
-var File_paperless_service_v1_statistics_proto protoreflect.FileDescriptor
+// GetUploadTimeSeriesRequest is the request message for GetUploadTimeSeries
+type GetUploadTimeSeriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket size
+	Interval TimeSeriesInterval `protobuf:"varint,1,opt,name=interval,proto3,enum=paperless.service.v1.TimeSeriesInterval" json:"interval,omitempty"`
+	// Start of the window; defaults to 30 days, 12 weeks or 12 months before end_time
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
+	// End of the window (exclusive); defaults to now
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
+	// Tenant to report on; defaults to the caller's tenant. Other tenants, or 0 for all
+	// tenants, require platform admin access.
+	TenantId      *uint32 `protobuf:"varint,4,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_paperless_service_v1_statistics_proto_rawDesc = "" +
-	"\n" +
-	"%paperless/service/v1/statistics.proto\x12\x14paperless.service.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x16\n" +
-	"\x14GetStatisticsRequest\"\xe8\x01\n" +
-	"\x15GetStatisticsResponse\x12F\n" +
-	"\tdocuments\x18\x01 \x01(\v2(.paperless.service.v1.DocumentStatisticsR\tdocuments\x12H\n" +
-	"\n" +
-	"categories\x18\x02 \x01(\v2(.paperless.service.v1.CategoryStatisticsR\n" +
-	"categories\x12=\n" +
-	"\fgenerated_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\"\xb9\x06\n" +
-	"\x12DocumentStatistics\x12\x1f\n" +
-	"\vtotal_count\x18\x01 \x01(\x03R\n" +
-	"totalCount\x12S\n" +
-	"\tby_status\x18\x02 \x03(\v26.paperless.service.v1.DocumentStatistics.ByStatusEntryR\bbyStatus\x12S\n" +
-	"\tby_source\x18\x03 \x03(\v26.paperless.service.v1.DocumentStatistics.BySourceEntryR\bbySource\x12r\n" +
-	"\x14by_processing_status\x18\x04 \x03(\v2@.paperless.service.v1.DocumentStatistics.ByProcessingStatusEntryR\x12byProcessingStatus\x12Z\n" +
-	"\fby_mime_type\x18\x05 \x03(\v28.paperless.service.v1.DocumentStatistics.ByMimeTypeEntryR\n" +
-	"byMimeType\x12.\n" +
-	"\x13total_storage_bytes\x18\x06 \x01(\x03R\x11totalStorageBytes\x12,\n" +
-	"\x12recent_uploads_24h\x18\a \x01(\x03R\x10recentUploads24h\x12*\n" +
-	"\x11recent_uploads_7d\x18\b \x01(\x03R\x0frecentUploads7d\x1a;\n" +
-	"\rByStatusEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1a;\n" +
-	"\rBySourceEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1aE\n" +
-	"\x17ByProcessingStatusEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1a=\n" +
-	"\x0fByMimeTypeEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"5\n" +
-	"\x12CategoryStatistics\x12\x1f\n" +
-	"\vtotal_count\x18\x01 \x01(\x03R\n" +
-	"totalCount2\x9f\x01\n" +
+func (x *GetUploadTimeSeriesRequest) Reset() {
+	*x = GetUploadTimeSeriesRequest{}
+	mi := &file_paperless_service_v1_statistics_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUploadTimeSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUploadTimeSeriesRequest) ProtoMessage() {}
+
+func (x *GetUploadTimeSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_statistics_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUploadTimeSeriesRequest.ProtoReflect.Descriptor instead.
+func (*GetUploadTimeSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_statistics_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetUploadTimeSeriesRequest) GetInterval() TimeSeriesInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return TimeSeriesInterval_TIME_SERIES_INTERVAL_UNSPECIFIED
+}
+
+func (x *GetUploadTimeSeriesRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetUploadTimeSeriesRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GetUploadTimeSeriesRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+// GetUploadTimeSeriesResponse is the response message for GetUploadTimeSeries
+type GetUploadTimeSeriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket size
+	Interval TimeSeriesInterval `protobuf:"varint,1,opt,name=interval,proto3,enum=paperless.service.v1.TimeSeriesInterval" json:"interval,omitempty"`
+	// One point per bucket in the window, oldest first, including empty buckets
+	Points []*UploadTimeSeriesPoint `protobuf:"bytes,2,rep,name=points,proto3" json:"points,omitempty"`
+	// Tenant the series covers; 0 when it covers all tenants
+	TenantId      uint32 `protobuf:"varint,3,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUploadTimeSeriesResponse) Reset() {
+	*x = GetUploadTimeSeriesResponse{}
+	mi := &file_paperless_service_v1_statistics_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUploadTimeSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUploadTimeSeriesResponse) ProtoMessage() {}
+
+func (x *GetUploadTimeSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_statistics_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUploadTimeSeriesResponse.ProtoReflect.Descriptor instead.
+func (*GetUploadTimeSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_statistics_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetUploadTimeSeriesResponse) GetInterval() TimeSeriesInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return TimeSeriesInterval_TIME_SERIES_INTERVAL_UNSPECIFIED
+}
+
+func (x *GetUploadTimeSeriesResponse) GetPoints() []*UploadTimeSeriesPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *GetUploadTimeSeriesResponse) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+// UploadTimeSeriesPoint holds the uploads of one bucket
+type UploadTimeSeriesPoint struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Start of the bucket (UTC)
+	BucketStart *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=bucket_start,json=bucketStart,proto3" json:"bucket_start,omitempty"`
+	// Documents uploaded in the bucket
+	DocumentCount int64 `protobuf:"varint,2,opt,name=document_count,json=documentCount,proto3" json:"document_count,omitempty"`
+	// Size of the documents uploaded in the bucket
+	TotalBytes    int64 `protobuf:"varint,3,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadTimeSeriesPoint) Reset() {
+	*x = UploadTimeSeriesPoint{}
+	mi := &file_paperless_service_v1_statistics_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadTimeSeriesPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadTimeSeriesPoint) ProtoMessage() {}
+
+func (x *UploadTimeSeriesPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_statistics_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadTimeSeriesPoint.ProtoReflect.Descriptor instead.
+func (*UploadTimeSeriesPoint) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_statistics_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UploadTimeSeriesPoint) GetBucketStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BucketStart
+	}
+	return nil
+}
+
+func (x *UploadTimeSeriesPoint) GetDocumentCount() int64 {
+	if x != nil {
+		return x.DocumentCount
+	}
+	return 0
+}
+
+func (x *UploadTimeSeriesPoint) GetTotalBytes() int64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+// GetTenantUsageReportRequest is the request message for GetTenantUsageReport
+type GetTenantUsageReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantUsageReportRequest) Reset() {
+	*x = GetTenantUsageReportRequest{}
+	mi := &file_paperless_service_v1_statistics_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantUsageReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantUsageReportRequest) ProtoMessage() {}
+
+func (x *GetTenantUsageReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_statistics_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantUsageReportRequest.ProtoReflect.Descriptor instead.
+func (*GetTenantUsageReportRequest) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_statistics_proto_rawDescGZIP(), []int{8}
+}
+
+// GetTenantUsageReportResponse is the response message for GetTenantUsageReport
+type GetTenantUsageReportResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Usage per tenant, largest storage first
+	Tenants []*TenantUsage `protobuf:"bytes,1,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	// Documents across all tenants
+	TotalDocumentCount int64 `protobuf:"varint,2,opt,name=total_document_count,json=totalDocumentCount,proto3" json:"total_document_count,omitempty"`
+	// Storage used across all tenants in bytes
+	TotalStorageBytes int64 `protobuf:"varint,3,opt,name=total_storage_bytes,json=totalStorageBytes,proto3" json:"total_storage_bytes,omitempty"`
+	// Report generation timestamp
+	GeneratedAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantUsageReportResponse) Reset() {
+	*x = GetTenantUsageReportResponse{}
+	mi := &file_paperless_service_v1_statistics_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantUsageReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantUsageReportResponse) ProtoMessage() {}
+
+func (x *GetTenantUsageReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_statistics_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantUsageReportResponse.ProtoReflect.Descriptor instead.
+func (*GetTenantUsageReportResponse) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_statistics_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetTenantUsageReportResponse) GetTenants() []*TenantUsage {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+func (x *GetTenantUsageReportResponse) GetTotalDocumentCount() int64 {
+	if x != nil {
+		return x.TotalDocumentCount
+	}
+	return 0
+}
+
+func (x *GetTenantUsageReportResponse) GetTotalStorageBytes() int64 {
+	if x != nil {
+		return x.TotalStorageBytes
+	}
+	return 0
+}
+
+func (x *GetTenantUsageReportResponse) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
+// TenantUsage contains the document and storage usage of one tenant
+type TenantUsage struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TenantId uint32                 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// Number of documents
+	DocumentCount int64 `protobuf:"varint,2,opt,name=document_count,json=documentCount,proto3" json:"document_count,omitempty"`
+	// Storage used in bytes
+	StorageBytes int64 `protobuf:"varint,3,opt,name=storage_bytes,json=storageBytes,proto3" json:"storage_bytes,omitempty"`
+	// Number of categories
+	CategoryCount int64 `protobuf:"varint,4,opt,name=category_count,json=categoryCount,proto3" json:"category_count,omitempty"`
+	// Documents and bytes uploaded in the last 7 days
+	Uploads_7D     int64 `protobuf:"varint,5,opt,name=uploads_7d,json=uploads7d,proto3" json:"uploads_7d,omitempty"`
+	UploadBytes_7D int64 `protobuf:"varint,6,opt,name=upload_bytes_7d,json=uploadBytes7d,proto3" json:"upload_bytes_7d,omitempty"`
+	// Documents and bytes uploaded in the last 30 days
+	Uploads_30D     int64 `protobuf:"varint,7,opt,name=uploads_30d,json=uploads30d,proto3" json:"uploads_30d,omitempty"`
+	UploadBytes_30D int64 `protobuf:"varint,8,opt,name=upload_bytes_30d,json=uploadBytes30d,proto3" json:"upload_bytes_30d,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TenantUsage) Reset() {
+	*x = TenantUsage{}
+	mi := &file_paperless_service_v1_statistics_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantUsage) ProtoMessage() {}
+
+func (x *TenantUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_statistics_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantUsage.ProtoReflect.Descriptor instead.
+func (*TenantUsage) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_statistics_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TenantUsage) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *TenantUsage) GetDocumentCount() int64 {
+	if x != nil {
+		return x.DocumentCount
+	}
+	return 0
+}
+
+func (x *TenantUsage) GetStorageBytes() int64 {
+	if x != nil {
+		return x.StorageBytes
+	}
+	return 0
+}
+
+func (x *TenantUsage) GetCategoryCount() int64 {
+	if x != nil {
+		return x.CategoryCount
+	}
+	return 0
+}
+
+func (x *TenantUsage) GetUploads_7D() int64 {
+	if x != nil {
+		return x.Uploads_7D
+	}
+	return 0
+}
+
+func (x *TenantUsage) GetUploadBytes_7D() int64 {
+	if x != nil {
+		return x.UploadBytes_7D
+	}
+	return 0
+}
+
+func (x *TenantUsage) GetUploads_30D() int64 {
+	if x != nil {
+		return x.Uploads_30D
+	}
+	return 0
+}
+
+func (x *TenantUsage) GetUploadBytes_30D() int64 {
+	if x != nil {
+		return x.UploadBytes_30D
+	}
+	return 0
+}
+
+// GetProcessingQueueStatusRequest is the request message for GetProcessingQueueStatus
+type GetProcessingQueueStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProcessingQueueStatusRequest) Reset() {
+	*x = GetProcessingQueueStatusRequest{}
+	mi := &file_paperless_service_v1_statistics_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProcessingQueueStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProcessingQueueStatusRequest) ProtoMessage() {}
+
+func (x *GetProcessingQueueStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_statistics_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProcessingQueueStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetProcessingQueueStatusRequest) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_statistics_proto_rawDescGZIP(), []int{11}
+}
+
+// GetProcessingQueueStatusResponse describes the processing queue of the serving instance
+type GetProcessingQueueStatusResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Documents waiting for a worker
+	QueueDepth uint32 `protobuf:"varint,1,opt,name=queue_depth,json=queueDepth,proto3" json:"queue_depth,omitempty"`
+	// Documents being processed
+	InFlight uint32 `protobuf:"varint,2,opt,name=in_flight,json=inFlight,proto3" json:"in_flight,omitempty"`
+	// Documents waiting to be retried after a failed attempt
+	RetryWaiting uint32 `protobuf:"varint,3,opt,name=retry_waiting,json=retryWaiting,proto3" json:"retry_waiting,omitempty"`
+	// Attempts scheduled for a retry since the instance started
+	TotalRetries uint64 `protobuf:"varint,4,opt,name=total_retries,json=totalRetries,proto3" json:"total_retries,omitempty"`
+	// Seconds the longest-waiting queued document has waited, 0 when the queue is empty
+	OldestPendingAgeSeconds uint64 `protobuf:"varint,5,opt,name=oldest_pending_age_seconds,json=oldestPendingAgeSeconds,proto3" json:"oldest_pending_age_seconds,omitempty"`
+	// Number of processing workers
+	Workers uint32 `protobuf:"varint,6,opt,name=workers,proto3" json:"workers,omitempty"`
+	// Retries after the first attempt before a document is marked failed
+	MaxRetries uint32 `protobuf:"varint,7,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Status generation timestamp
+	GeneratedAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProcessingQueueStatusResponse) Reset() {
+	*x = GetProcessingQueueStatusResponse{}
+	mi := &file_paperless_service_v1_statistics_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProcessingQueueStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProcessingQueueStatusResponse) ProtoMessage() {}
+
+func (x *GetProcessingQueueStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_statistics_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProcessingQueueStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetProcessingQueueStatusResponse) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_statistics_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetProcessingQueueStatusResponse) GetQueueDepth() uint32 {
+	if x != nil {
+		return x.QueueDepth
+	}
+	return 0
+}
+
+func (x *GetProcessingQueueStatusResponse) GetInFlight() uint32 {
+	if x != nil {
+		return x.InFlight
+	}
+	return 0
+}
+
+func (x *GetProcessingQueueStatusResponse) GetRetryWaiting() uint32 {
+	if x != nil {
+		return x.RetryWaiting
+	}
+	return 0
+}
+
+func (x *GetProcessingQueueStatusResponse) GetTotalRetries() uint64 {
+	if x != nil {
+		return x.TotalRetries
+	}
+	return 0
+}
+
+func (x *GetProcessingQueueStatusResponse) GetOldestPendingAgeSeconds() uint64 {
+	if x != nil {
+		return x.OldestPendingAgeSeconds
+	}
+	return 0
+}
+
+func (x *GetProcessingQueueStatusResponse) GetWorkers() uint32 {
+	if x != nil {
+		return x.Workers
+	}
+	return 0
+}
+
+func (x *GetProcessingQueueStatusResponse) GetMaxRetries() uint32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *GetProcessingQueueStatusResponse) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
+// ExportStatisticsRequest is the request message for ExportStatistics
+type ExportStatisticsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tenant to report on; defaults to the caller's tenant. Other tenants, or 0 for all
+	// tenants, require platform admin access.
+	TenantId *uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	// Start of the monthly upload window; defaults to 12 months before end_time
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
+	// End of the monthly upload window (exclusive); defaults to now
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportStatisticsRequest) Reset() {
+	*x = ExportStatisticsRequest{}
+	mi := &file_paperless_service_v1_statistics_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportStatisticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportStatisticsRequest) ProtoMessage() {}
+
+func (x *ExportStatisticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_statistics_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportStatisticsRequest.ProtoReflect.Descriptor instead.
+func (*ExportStatisticsRequest) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_statistics_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ExportStatisticsRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *ExportStatisticsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ExportStatisticsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+// ExportStatisticsResponse carries the statistics as a CSV file with the columns
+// section, key, documents and bytes. Sections are storage (total), month (uploads per
+// UTC month, YYYY-MM), category (category path) and mime_type.
+type ExportStatisticsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// CSV content
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Suggested file name
+	FileName string `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// Always text/csv
+	ContentType string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Tenant the statistics cover; 0 when they cover all tenants
+	TenantId uint32 `protobuf:"varint,4,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// Export generation timestamp
+	GeneratedAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportStatisticsResponse) Reset() {
+	*x = ExportStatisticsResponse{}
+	mi := &file_paperless_service_v1_statistics_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportStatisticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportStatisticsResponse) ProtoMessage() {}
+
+func (x *ExportStatisticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paperless_service_v1_statistics_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportStatisticsResponse.ProtoReflect.Descriptor instead.
+func (*ExportStatisticsResponse) Descriptor() ([]byte, []int) {
+	return file_paperless_service_v1_statistics_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ExportStatisticsResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportStatisticsResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportStatisticsResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportStatisticsResponse) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *ExportStatisticsResponse) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
+var File_paperless_service_v1_statistics_proto protoreflect.FileDescriptor
+
+const file_paperless_service_v1_statistics_proto_rawDesc = "" +
+	"\n" +
+	"%paperless/service/v1/statistics.proto\x12\x14paperless.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb2\x01\n" +
+	"\x14GetStatisticsRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01\x122\n" +
+	"\x0etop_tags_limit\x18\x02 \x01(\rB\a\xbaH\x04*\x02\x18dH\x01R\ftopTagsLimit\x88\x01\x01\x12#\n" +
+	"\rforce_refresh\x18\x03 \x01(\bR\fforceRefreshB\f\n" +
+	"\n" +
+	"_tenant_idB\x11\n" +
+	"\x0f_top_tags_limit\"\x85\x02\n" +
+	"\x15GetStatisticsResponse\x12F\n" +
+	"\tdocuments\x18\x01 \x01(\v2(.paperless.service.v1.DocumentStatisticsR\tdocuments\x12H\n" +
+	"\n" +
+	"categories\x18\x02 \x01(\v2(.paperless.service.v1.CategoryStatisticsR\n" +
+	"categories\x12=\n" +
+	"\fgenerated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\x12\x1b\n" +
+	"\ttenant_id\x18\v \x01(\rR\btenantId\"\xf4\x06\n" +
+	"\x12DocumentStatistics\x12\x1f\n" +
+	"\vtotal_count\x18\x01 \x01(\x03R\n" +
+	"totalCount\x12S\n" +
+	"\tby_status\x18\x02 \x03(\v26.paperless.service.v1.DocumentStatistics.ByStatusEntryR\bbyStatus\x12S\n" +
+	"\tby_source\x18\x03 \x03(\v26.paperless.service.v1.DocumentStatistics.BySourceEntryR\bbySource\x12r\n" +
+	"\x14by_processing_status\x18\x04 \x03(\v2@.paperless.service.v1.DocumentStatistics.ByProcessingStatusEntryR\x12byProcessingStatus\x12Z\n" +
+	"\fby_mime_type\x18\x05 \x03(\v28.paperless.service.v1.DocumentStatistics.ByMimeTypeEntryR\n" +
+	"byMimeType\x12.\n" +
+	"\x13total_storage_bytes\x18\x06 \x01(\x03R\x11totalStorageBytes\x12,\n" +
+	"\x12recent_uploads_24h\x18\a \x01(\x03R\x10recentUploads24h\x12*\n" +
+	"\x11recent_uploads_7d\x18\b \x01(\x03R\x0frecentUploads7d\x129\n" +
+	"\btop_tags\x18\t \x03(\v2\x1e.paperless.service.v1.TagCountR\atopTags\x1a;\n" +
+	"\rByStatusEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1a;\n" +
+	"\rBySourceEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1aE\n" +
+	"\x17ByProcessingStatusEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1a=\n" +
+	"\x0fByMimeTypeEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"C\n" +
+	"\bTagCount\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12%\n" +
+	"\x0edocument_count\x18\x02 \x01(\x03R\rdocumentCount\"5\n" +
+	"\x12CategoryStatistics\x12\x1f\n" +
+	"\vtotal_count\x18\x01 \x01(\x03R\n" +
+	"totalCount\"\xb4\x02\n" +
+	"\x1aGetUploadTimeSeriesRequest\x12N\n" +
+	"\binterval\x18\x01 \x01(\x0e2(.paperless.service.v1.TimeSeriesIntervalB\b\xbaH\x05\x82\x01\x02\x10\x01R\binterval\x12>\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tstartTime\x88\x01\x01\x12:\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\aendTime\x88\x01\x01\x12 \n" +
+	"\ttenant_id\x18\x04 \x01(\rH\x02R\btenantId\x88\x01\x01B\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_end_timeB\f\n" +
+	"\n" +
+	"_tenant_id\"\xc5\x01\n" +
+	"\x1bGetUploadTimeSeriesResponse\x12D\n" +
+	"\binterval\x18\x01 \x01(\x0e2(.paperless.service.v1.TimeSeriesIntervalR\binterval\x12C\n" +
+	"\x06points\x18\x02 \x03(\v2+.paperless.service.v1.UploadTimeSeriesPointR\x06points\x12\x1b\n" +
+	"\ttenant_id\x18\x03 \x01(\rR\btenantId\"\x9e\x01\n" +
+	"\x15UploadTimeSeriesPoint\x12=\n" +
+	"\fbucket_start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vbucketStart\x12%\n" +
+	"\x0edocument_count\x18\x02 \x01(\x03R\rdocumentCount\x12\x1f\n" +
+	"\vtotal_bytes\x18\x03 \x01(\x03R\n" +
+	"totalBytes\"\x1d\n" +
+	"\x1bGetTenantUsageReportRequest\"\xfc\x01\n" +
+	"\x1cGetTenantUsageReportResponse\x12;\n" +
+	"\atenants\x18\x01 \x03(\v2!.paperless.service.v1.TenantUsageR\atenants\x120\n" +
+	"\x14total_document_count\x18\x02 \x01(\x03R\x12totalDocumentCount\x12.\n" +
+	"\x13total_storage_bytes\x18\x03 \x01(\x03R\x11totalStorageBytes\x12=\n" +
+	"\fgenerated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\"\xaf\x02\n" +
+	"\vTenantUsage\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\rR\btenantId\x12%\n" +
+	"\x0edocument_count\x18\x02 \x01(\x03R\rdocumentCount\x12#\n" +
+	"\rstorage_bytes\x18\x03 \x01(\x03R\fstorageBytes\x12%\n" +
+	"\x0ecategory_count\x18\x04 \x01(\x03R\rcategoryCount\x12\x1d\n" +
+	"\n" +
+	"uploads_7d\x18\x05 \x01(\x03R\tuploads7d\x12&\n" +
+	"\x0fupload_bytes_7d\x18\x06 \x01(\x03R\ruploadBytes7d\x12\x1f\n" +
+	"\vuploads_30d\x18\a \x01(\x03R\n" +
+	"uploads30d\x12(\n" +
+	"\x10upload_bytes_30d\x18\b \x01(\x03R\x0euploadBytes30d\"!\n" +
+	"\x1fGetProcessingQueueStatusRequest\"\xe1\x02\n" +
+	" GetProcessingQueueStatusResponse\x12\x1f\n" +
+	"\vqueue_depth\x18\x01 \x01(\rR\n" +
+	"queueDepth\x12\x1b\n" +
+	"\tin_flight\x18\x02 \x01(\rR\binFlight\x12#\n" +
+	"\rretry_waiting\x18\x03 \x01(\rR\fretryWaiting\x12#\n" +
+	"\rtotal_retries\x18\x04 \x01(\x04R\ftotalRetries\x12;\n" +
+	"\x1aoldest_pending_age_seconds\x18\x05 \x01(\x04R\x17oldestPendingAgeSeconds\x12\x18\n" +
+	"\aworkers\x18\x06 \x01(\rR\aworkers\x12\x1f\n" +
+	"\vmax_retries\x18\a \x01(\rR\n" +
+	"maxRetries\x12=\n" +
+	"\fgenerated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\"\xe1\x01\n" +
+	"\x17ExportStatisticsRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01\x12>\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\tstartTime\x88\x01\x01\x12:\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\aendTime\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_idB\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_end_time\"\xca\x01\n" +
+	"\x18ExportStatisticsResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x1b\n" +
+	"\ttenant_id\x18\x04 \x01(\rR\btenantId\x12=\n" +
+	"\fgenerated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt*\x97\x01\n" +
+	"\x12TimeSeriesInterval\x12$\n" +
+	" TIME_SERIES_INTERVAL_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18TIME_SERIES_INTERVAL_DAY\x10\x01\x12\x1d\n" +
+	"\x19TIME_SERIES_INTERVAL_WEEK\x10\x02\x12\x1e\n" +
+	"\x1aTIME_SERIES_INTERVAL_MONTH\x10\x032\xa4\x06\n" +
 	"\x1aPaperlessStatisticsService\x12\x80\x01\n" +
-	"\rGetStatistics\x12*.paperless.service.v1.GetStatisticsRequest\x1a+.paperless.service.v1.GetStatisticsResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/statisticsB\xef\x01\n" +
+	"\rGetStatistics\x12*.paperless.service.v1.GetStatisticsRequest\x1a+.paperless.service.v1.GetStatisticsResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/statistics\x12\x9a\x01\n" +
+	"\x13GetUploadTimeSeries\x120.paperless.service.v1.GetUploadTimeSeriesRequest\x1a1.paperless.service.v1.GetUploadTimeSeriesResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/statistics/uploads\x12\x9d\x01\n" +
+	"\x14GetTenantUsageReport\x121.paperless.service.v1.GetTenantUsageReportRequest\x1a2.paperless.service.v1.GetTenantUsageReportResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/statistics/tenants\x12\xb2\x01\n" +
+	"\x18GetProcessingQueueStatus\x125.paperless.service.v1.GetProcessingQueueStatusRequest\x1a6.paperless.service.v1.GetProcessingQueueStatusResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/statistics/processing-queue\x12\x90\x01\n" +
+	"\x10ExportStatistics\x12-.paperless.service.v1.ExportStatisticsRequest\x1a..paperless.service.v1.ExportStatisticsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/statistics/exportB\xef\x01\n" +
 	"\x18com.paperless.service.v1B\x0fStatisticsProtoP\x01ZPgithub.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1;paperlesspb\xa2\x02\x03PSX\xaa\x02\x14Paperless.Service.V1\xca\x02\x14Paperless\\Service\\V1\xe2\x02 Paperless\\Service\\V1\\GPBMetadata\xea\x02\x16Paperless::Service::V1b\x06proto3"
 
 var (
@@ -334,33 +1288,67 @@ func file_paperless_service_v1_statistics_proto_rawDescGZIP() []byte {
 	return file_paperless_service_v1_statistics_proto_rawDescData
 }
 
-var file_paperless_service_v1_statistics_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_paperless_service_v1_statistics_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_paperless_service_v1_statistics_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_paperless_service_v1_statistics_proto_goTypes = []any{
-	(*GetStatisticsRequest)(nil),  // 0: paperless.service.v1.GetStatisticsRequest
-	(*GetStatisticsResponse)(nil), // 1: paperless.service.v1.GetStatisticsResponse
-	(*DocumentStatistics)(nil),    // 2: paperless.service.v1.DocumentStatistics
-	(*CategoryStatistics)(nil),    // 3: paperless.service.v1.CategoryStatistics
-	nil,                           // 4: paperless.service.v1.DocumentStatistics.ByStatusEntry
-	nil,                           // 5: paperless.service.v1.DocumentStatistics.BySourceEntry
-	nil,                           // 6: paperless.service.v1.DocumentStatistics.ByProcessingStatusEntry
-	nil,                           // 7: paperless.service.v1.DocumentStatistics.ByMimeTypeEntry
-	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+	(TimeSeriesInterval)(0),                  // 0: paperless.service.v1.TimeSeriesInterval
+	(*GetStatisticsRequest)(nil),             // 1: paperless.service.v1.GetStatisticsRequest
+	(*GetStatisticsResponse)(nil),            // 2: paperless.service.v1.GetStatisticsResponse
+	(*DocumentStatistics)(nil),               // 3: paperless.service.v1.DocumentStatistics
+	(*TagCount)(nil),                         // 4: paperless.service.v1.TagCount
+	(*CategoryStatistics)(nil),               // 5: paperless.service.v1.CategoryStatistics
+	(*GetUploadTimeSeriesRequest)(nil),       // 6: paperless.service.v1.GetUploadTimeSeriesRequest
+	(*GetUploadTimeSeriesResponse)(nil),      // 7: paperless.service.v1.GetUploadTimeSeriesResponse
+	(*UploadTimeSeriesPoint)(nil),            // 8: paperless.service.v1.UploadTimeSeriesPoint
+	(*GetTenantUsageReportRequest)(nil),      // 9: paperless.service.v1.GetTenantUsageReportRequest
+	(*GetTenantUsageReportResponse)(nil),     // 10: paperless.service.v1.GetTenantUsageReportResponse
+	(*TenantUsage)(nil),                      // 11: paperless.service.v1.TenantUsage
+	(*GetProcessingQueueStatusRequest)(nil),  // 12: paperless.service.v1.GetProcessingQueueStatusRequest
+	(*GetProcessingQueueStatusResponse)(nil), // 13: paperless.service.v1.GetProcessingQueueStatusResponse
+	(*ExportStatisticsRequest)(nil),          // 14: paperless.service.v1.ExportStatisticsRequest
+	(*ExportStatisticsResponse)(nil),         // 15: paperless.service.v1.ExportStatisticsResponse
+	nil,                                      // 16: paperless.service.v1.DocumentStatistics.ByStatusEntry
+	nil,                                      // 17: paperless.service.v1.DocumentStatistics.BySourceEntry
+	nil,                                      // 18: paperless.service.v1.DocumentStatistics.ByProcessingStatusEntry
+	nil,                                      // 19: paperless.service.v1.DocumentStatistics.ByMimeTypeEntry
+	(*timestamppb.Timestamp)(nil),            // 20: google.protobuf.Timestamp
 }
 var file_paperless_service_v1_statistics_proto_depIdxs = []int32{
-	2, // 0: paperless.service.v1.GetStatisticsResponse.documents:type_name -> paperless.service.v1.DocumentStatistics
-	3, // 1: paperless.service.v1.GetStatisticsResponse.categories:type_name -> paperless.service.v1.CategoryStatistics
-	8, // 2: paperless.service.v1.GetStatisticsResponse.generated_at:type_name -> google.protobuf.Timestamp
-	4, // 3: paperless.service.v1.DocumentStatistics.by_status:type_name -> paperless.service.v1.DocumentStatistics.ByStatusEntry
-	5, // 4: paperless.service.v1.DocumentStatistics.by_source:type_name -> paperless.service.v1.DocumentStatistics.BySourceEntry
-	6, // 5: paperless.service.v1.DocumentStatistics.by_processing_status:type_name -> paperless.service.v1.DocumentStatistics.ByProcessingStatusEntry
-	7, // 6: paperless.service.v1.DocumentStatistics.by_mime_type:type_name -> paperless.service.v1.DocumentStatistics.ByMimeTypeEntry
-	0, // 7: paperless.service.v1.PaperlessStatisticsService.GetStatistics:input_type -> paperless.service.v1.GetStatisticsRequest
-	1, // 8: paperless.service.v1.PaperlessStatisticsService.GetStatistics:output_type -> paperless.service.v1.GetStatisticsResponse
-	8, // [8:9] is the sub-list for method output_type
-	7, // [7:8] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	3,  // 0: paperless.service.v1.GetStatisticsResponse.documents:type_name -> paperless.service.v1.DocumentStatistics
+	5,  // 1: paperless.service.v1.GetStatisticsResponse.categories:type_name -> paperless.service.v1.CategoryStatistics
+	20, // 2: paperless.service.v1.GetStatisticsResponse.generated_at:type_name -> google.protobuf.Timestamp
+	16, // 3: paperless.service.v1.DocumentStatistics.by_status:type_name -> paperless.service.v1.DocumentStatistics.ByStatusEntry
+	17, // 4: paperless.service.v1.DocumentStatistics.by_source:type_name -> paperless.service.v1.DocumentStatistics.BySourceEntry
+	18, // 5: paperless.service.v1.DocumentStatistics.by_processing_status:type_name -> paperless.service.v1.DocumentStatistics.ByProcessingStatusEntry
+	19, // 6: paperless.service.v1.DocumentStatistics.by_mime_type:type_name -> paperless.service.v1.DocumentStatistics.ByMimeTypeEntry
+	4,  // 7: paperless.service.v1.DocumentStatistics.top_tags:type_name -> paperless.service.v1.TagCount
+	0,  // 8: paperless.service.v1.GetUploadTimeSeriesRequest.interval:type_name -> paperless.service.v1.TimeSeriesInterval
+	20, // 9: paperless.service.v1.GetUploadTimeSeriesRequest.start_time:type_name -> google.protobuf.Timestamp
+	20, // 10: paperless.service.v1.GetUploadTimeSeriesRequest.end_time:type_name -> google.protobuf.Timestamp
+	0,  // 11: paperless.service.v1.GetUploadTimeSeriesResponse.interval:type_name -> paperless.service.v1.TimeSeriesInterval
+	8,  // 12: paperless.service.v1.GetUploadTimeSeriesResponse.points:type_name -> paperless.service.v1.UploadTimeSeriesPoint
+	20, // 13: paperless.service.v1.UploadTimeSeriesPoint.bucket_start:type_name -> google.protobuf.Timestamp
+	11, // 14: paperless.service.v1.GetTenantUsageReportResponse.tenants:type_name -> paperless.service.v1.TenantUsage
+	20, // 15: paperless.service.v1.GetTenantUsageReportResponse.generated_at:type_name -> google.protobuf.Timestamp
+	20, // 16: paperless.service.v1.GetProcessingQueueStatusResponse.generated_at:type_name -> google.protobuf.Timestamp
+	20, // 17: paperless.service.v1.ExportStatisticsRequest.start_time:type_name -> google.protobuf.Timestamp
+	20, // 18: paperless.service.v1.ExportStatisticsRequest.end_time:type_name -> google.protobuf.Timestamp
+	20, // 19: paperless.service.v1.ExportStatisticsResponse.generated_at:type_name -> google.protobuf.Timestamp
+	1,  // 20: paperless.service.v1.PaperlessStatisticsService.GetStatistics:input_type -> paperless.service.v1.GetStatisticsRequest
+	6,  // 21: paperless.service.v1.PaperlessStatisticsService.GetUploadTimeSeries:input_type -> paperless.service.v1.GetUploadTimeSeriesRequest
+	9,  // 22: paperless.service.v1.PaperlessStatisticsService.GetTenantUsageReport:input_type -> paperless.service.v1.GetTenantUsageReportRequest
+	12, // 23: paperless.service.v1.PaperlessStatisticsService.GetProcessingQueueStatus:input_type -> paperless.service.v1.GetProcessingQueueStatusRequest
+	14, // 24: paperless.service.v1.PaperlessStatisticsService.ExportStatistics:input_type -> paperless.service.v1.ExportStatisticsRequest
+	2,  // 25: paperless.service.v1.PaperlessStatisticsService.GetStatistics:output_type -> paperless.service.v1.GetStatisticsResponse
+	7,  // 26: paperless.service.v1.PaperlessStatisticsService.GetUploadTimeSeries:output_type -> paperless.service.v1.GetUploadTimeSeriesResponse
+	10, // 27: paperless.service.v1.PaperlessStatisticsService.GetTenantUsageReport:output_type -> paperless.service.v1.GetTenantUsageReportResponse
+	13, // 28: paperless.service.v1.PaperlessStatisticsService.GetProcessingQueueStatus:output_type -> paperless.service.v1.GetProcessingQueueStatusResponse
+	15, // 29: paperless.service.v1.PaperlessStatisticsService.ExportStatistics:output_type -> paperless.service.v1.ExportStatisticsResponse
+	25, // [25:30] is the sub-list for method output_type
+	20, // [20:25] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_paperless_service_v1_statistics_proto_init() }
@@ -368,18 +1356,22 @@ func file_paperless_service_v1_statistics_proto_init() {
 	if File_paperless_service_v1_statistics_proto != nil {
 		return
 	}
+	file_paperless_service_v1_statistics_proto_msgTypes[0].OneofWrappers = []any{}
+	file_paperless_service_v1_statistics_proto_msgTypes[5].OneofWrappers = []any{}
+	file_paperless_service_v1_statistics_proto_msgTypes[13].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_paperless_service_v1_statistics_proto_rawDesc), len(file_paperless_service_v1_statistics_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      1,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_paperless_service_v1_statistics_proto_goTypes,
 		DependencyIndexes: file_paperless_service_v1_statistics_proto_depIdxs,
+		EnumInfos:         file_paperless_service_v1_statistics_proto_enumTypes,
 		MessageInfos:      file_paperless_service_v1_statistics_proto_msgTypes,
 	}.Build()
 	File_paperless_service_v1_statistics_proto = out.File
@@ -4,6 +4,7 @@
 package paperlesspb
 
 import (
+	validate "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	context "context"
 	redact "github.com/menta2k/protoc-gen-redact/v3/redact/v3"
 	grpc "google.golang.org/grpc"
@@ -19,6 +20,7 @@ var (
 	_ redact.Redactor
 	_ codes.Code
 	_ status.Status
+	_ validate.Rule
 	_ timestamppb.Timestamp
 )
 
@@ -51,11 +53,61 @@ func (s *redactedPaperlessStatisticsServiceServer) GetStatistics(ctx context.Con
 	return res, err
 }
 
+// GetUploadTimeSeries is the redacted wrapper for the actual PaperlessStatisticsServiceServer.GetUploadTimeSeries method
+// Unary RPC
+func (s *redactedPaperlessStatisticsServiceServer) GetUploadTimeSeries(ctx context.Context, in *GetUploadTimeSeriesRequest) (*GetUploadTimeSeriesResponse, error) {
+	res, err := s.srv.GetUploadTimeSeries(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// GetTenantUsageReport is the redacted wrapper for the actual PaperlessStatisticsServiceServer.GetTenantUsageReport method
+// Unary RPC
+func (s *redactedPaperlessStatisticsServiceServer) GetTenantUsageReport(ctx context.Context, in *GetTenantUsageReportRequest) (*GetTenantUsageReportResponse, error) {
+	res, err := s.srv.GetTenantUsageReport(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// GetProcessingQueueStatus is the redacted wrapper for the actual PaperlessStatisticsServiceServer.GetProcessingQueueStatus method
+// Unary RPC
+func (s *redactedPaperlessStatisticsServiceServer) GetProcessingQueueStatus(ctx context.Context, in *GetProcessingQueueStatusRequest) (*GetProcessingQueueStatusResponse, error) {
+	res, err := s.srv.GetProcessingQueueStatus(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ExportStatistics is the redacted wrapper for the actual PaperlessStatisticsServiceServer.ExportStatistics method
+// Unary RPC
+func (s *redactedPaperlessStatisticsServiceServer) ExportStatistics(ctx context.Context, in *ExportStatisticsRequest) (*ExportStatisticsResponse, error) {
+	res, err := s.srv.ExportStatistics(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // Redact method implementation for GetStatisticsRequest
 func (x *GetStatisticsRequest) Redact() string {
 	if x == nil {
 		return ""
 	}
+
+	// Safe field: TenantId
+
+	// Safe field: TopTagsLimit
+
+	// Safe field: ForceRefresh
 	return x.String()
 }
 
@@ -70,6 +122,8 @@ func (x *GetStatisticsResponse) Redact() string {
 	// Safe field: Categories
 
 	// Safe field: GeneratedAt
+
+	// Safe field: TenantId
 	return x.String()
 }
 
@@ -94,6 +148,20 @@ func (x *DocumentStatistics) Redact() string {
 	// Safe field: RecentUploads_24H
 
 	// Safe field: RecentUploads_7D
+
+	// Safe field: TopTags
+	return x.String()
+}
+
+// Redact method implementation for TagCount
+func (x *TagCount) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Tag
+
+	// Safe field: DocumentCount
 	return x.String()
 }
 
@@ -106,3 +174,159 @@ func (x *CategoryStatistics) Redact() string {
 	// Safe field: TotalCount
 	return x.String()
 }
+
+// Redact method implementation for GetUploadTimeSeriesRequest
+func (x *GetUploadTimeSeriesRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Interval
+
+	// Safe field: StartTime
+
+	// Safe field: EndTime
+
+	// Safe field: TenantId
+	return x.String()
+}
+
+// Redact method implementation for GetUploadTimeSeriesResponse
+func (x *GetUploadTimeSeriesResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Interval
+
+	// Safe field: Points
+
+	// Safe field: TenantId
+	return x.String()
+}
+
+// Redact method implementation for UploadTimeSeriesPoint
+func (x *UploadTimeSeriesPoint) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: BucketStart
+
+	// Safe field: DocumentCount
+
+	// Safe field: TotalBytes
+	return x.String()
+}
+
+// Redact method implementation for GetTenantUsageReportRequest
+func (x *GetTenantUsageReportRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+	return x.String()
+}
+
+// Redact method implementation for GetTenantUsageReportResponse
+func (x *GetTenantUsageReportResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Tenants
+
+	// Safe field: TotalDocumentCount
+
+	// Safe field: TotalStorageBytes
+
+	// Safe field: GeneratedAt
+	return x.String()
+}
+
+// Redact method implementation for TenantUsage
+func (x *TenantUsage) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+
+	// Safe field: DocumentCount
+
+	// Safe field: StorageBytes
+
+	// Safe field: CategoryCount
+
+	// Safe field: Uploads_7D
+
+	// Safe field: UploadBytes_7D
+
+	// Safe field: Uploads_30D
+
+	// Safe field: UploadBytes_30D
+	return x.String()
+}
+
+// Redact method implementation for GetProcessingQueueStatusRequest
+func (x *GetProcessingQueueStatusRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+	return x.String()
+}
+
+// Redact method implementation for GetProcessingQueueStatusResponse
+func (x *GetProcessingQueueStatusResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: QueueDepth
+
+	// Safe field: InFlight
+
+	// Safe field: RetryWaiting
+
+	// Safe field: TotalRetries
+
+	// Safe field: OldestPendingAgeSeconds
+
+	// Safe field: Workers
+
+	// Safe field: MaxRetries
+
+	// Safe field: GeneratedAt
+	return x.String()
+}
+
+// Redact method implementation for ExportStatisticsRequest
+func (x *ExportStatisticsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+
+	// Safe field: StartTime
+
+	// Safe field: EndTime
+	return x.String()
+}
+
+// Redact method implementation for ExportStatisticsResponse
+func (x *ExportStatisticsResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Data
+
+	// Safe field: FileName
+
+	// Safe field: ContentType
+
+	// Safe field: TenantId
+
+	// Safe field: GeneratedAt
+	return x.String()
+}
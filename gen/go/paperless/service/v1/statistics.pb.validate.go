@@ -57,6 +57,16 @@ func (m *GetStatisticsRequest) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for ForceRefresh
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if m.TopTagsLimit != nil {
+		// no validation rules for TopTagsLimit
+	}
+
 	if len(errors) > 0 {
 		return GetStatisticsRequestMultiError(errors)
 	}
@@ -246,6 +256,8 @@ func (m *GetStatisticsResponse) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for TenantId
+
 	if len(errors) > 0 {
 		return GetStatisticsResponseMultiError(errors)
 	}
@@ -364,6 +376,40 @@ func (m *DocumentStatistics) validate(all bool) error {
 
 	// no validation rules for RecentUploads_7D
 
+	for idx, item := range m.GetTopTags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DocumentStatisticsValidationError{
+						field:  fmt.Sprintf("TopTags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DocumentStatisticsValidationError{
+						field:  fmt.Sprintf("TopTags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DocumentStatisticsValidationError{
+					field:  fmt.Sprintf("TopTags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return DocumentStatisticsMultiError(errors)
 	}
@@ -444,6 +490,109 @@ var _ interface {
 	ErrorName() string
 } = DocumentStatisticsValidationError{}
 
+// Validate checks the field values on TagCount with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TagCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TagCount with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TagCountMultiError, or nil
+// if none found.
+func (m *TagCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TagCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tag
+
+	// no validation rules for DocumentCount
+
+	if len(errors) > 0 {
+		return TagCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// TagCountMultiError is an error wrapping multiple validation errors returned
+// by TagCount.ValidateAll() if the designated constraints aren't met.
+type TagCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TagCountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TagCountMultiError) AllErrors() []error { return m }
+
+// TagCountValidationError is the validation error returned by
+// TagCount.Validate if the designated constraints aren't met.
+type TagCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TagCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TagCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TagCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TagCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TagCountValidationError) ErrorName() string { return "TagCountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TagCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTagCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TagCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TagCountValidationError{}
+
 // Validate checks the field values on CategoryStatistics with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -547,3 +696,1403 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = CategoryStatisticsValidationError{}
+
+// Validate checks the field values on GetUploadTimeSeriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUploadTimeSeriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUploadTimeSeriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUploadTimeSeriesRequestMultiError, or nil if none found.
+func (m *GetUploadTimeSeriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUploadTimeSeriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Interval
+
+	if m.StartTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetStartTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetUploadTimeSeriesRequestValidationError{
+						field:  "StartTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetUploadTimeSeriesRequestValidationError{
+						field:  "StartTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUploadTimeSeriesRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.EndTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetEndTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetUploadTimeSeriesRequestValidationError{
+						field:  "EndTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetUploadTimeSeriesRequestValidationError{
+						field:  "EndTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUploadTimeSeriesRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if len(errors) > 0 {
+		return GetUploadTimeSeriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUploadTimeSeriesRequestMultiError is an error wrapping multiple
+// validation errors returned by GetUploadTimeSeriesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetUploadTimeSeriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUploadTimeSeriesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUploadTimeSeriesRequestMultiError) AllErrors() []error { return m }
+
+// GetUploadTimeSeriesRequestValidationError is the validation error returned
+// by GetUploadTimeSeriesRequest.Validate if the designated constraints aren't met.
+type GetUploadTimeSeriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUploadTimeSeriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUploadTimeSeriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUploadTimeSeriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUploadTimeSeriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUploadTimeSeriesRequestValidationError) ErrorName() string {
+	return "GetUploadTimeSeriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUploadTimeSeriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUploadTimeSeriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUploadTimeSeriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUploadTimeSeriesRequestValidationError{}
+
+// Validate checks the field values on GetUploadTimeSeriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUploadTimeSeriesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUploadTimeSeriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUploadTimeSeriesResponseMultiError, or nil if none found.
+func (m *GetUploadTimeSeriesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUploadTimeSeriesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Interval
+
+	for idx, item := range m.GetPoints() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetUploadTimeSeriesResponseValidationError{
+						field:  fmt.Sprintf("Points[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetUploadTimeSeriesResponseValidationError{
+						field:  fmt.Sprintf("Points[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUploadTimeSeriesResponseValidationError{
+					field:  fmt.Sprintf("Points[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TenantId
+
+	if len(errors) > 0 {
+		return GetUploadTimeSeriesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUploadTimeSeriesResponseMultiError is an error wrapping multiple
+// validation errors returned by GetUploadTimeSeriesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetUploadTimeSeriesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUploadTimeSeriesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUploadTimeSeriesResponseMultiError) AllErrors() []error { return m }
+
+// GetUploadTimeSeriesResponseValidationError is the validation error returned
+// by GetUploadTimeSeriesResponse.Validate if the designated constraints
+// aren't met.
+type GetUploadTimeSeriesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUploadTimeSeriesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUploadTimeSeriesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUploadTimeSeriesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUploadTimeSeriesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUploadTimeSeriesResponseValidationError) ErrorName() string {
+	return "GetUploadTimeSeriesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUploadTimeSeriesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUploadTimeSeriesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUploadTimeSeriesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUploadTimeSeriesResponseValidationError{}
+
+// Validate checks the field values on UploadTimeSeriesPoint with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadTimeSeriesPoint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadTimeSeriesPoint with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadTimeSeriesPointMultiError, or nil if none found.
+func (m *UploadTimeSeriesPoint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadTimeSeriesPoint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBucketStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UploadTimeSeriesPointValidationError{
+					field:  "BucketStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UploadTimeSeriesPointValidationError{
+					field:  "BucketStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBucketStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UploadTimeSeriesPointValidationError{
+				field:  "BucketStart",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DocumentCount
+
+	// no validation rules for TotalBytes
+
+	if len(errors) > 0 {
+		return UploadTimeSeriesPointMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadTimeSeriesPointMultiError is an error wrapping multiple validation
+// errors returned by UploadTimeSeriesPoint.ValidateAll() if the designated
+// constraints aren't met.
+type UploadTimeSeriesPointMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadTimeSeriesPointMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadTimeSeriesPointMultiError) AllErrors() []error { return m }
+
+// UploadTimeSeriesPointValidationError is the validation error returned by
+// UploadTimeSeriesPoint.Validate if the designated constraints aren't met.
+type UploadTimeSeriesPointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadTimeSeriesPointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadTimeSeriesPointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadTimeSeriesPointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadTimeSeriesPointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadTimeSeriesPointValidationError) ErrorName() string {
+	return "UploadTimeSeriesPointValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadTimeSeriesPointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadTimeSeriesPoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadTimeSeriesPointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadTimeSeriesPointValidationError{}
+
+// Validate checks the field values on GetTenantUsageReportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTenantUsageReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTenantUsageReportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTenantUsageReportRequestMultiError, or nil if none found.
+func (m *GetTenantUsageReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTenantUsageReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetTenantUsageReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTenantUsageReportRequestMultiError is an error wrapping multiple
+// validation errors returned by GetTenantUsageReportRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetTenantUsageReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTenantUsageReportRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTenantUsageReportRequestMultiError) AllErrors() []error { return m }
+
+// GetTenantUsageReportRequestValidationError is the validation error returned
+// by GetTenantUsageReportRequest.Validate if the designated constraints
+// aren't met.
+type GetTenantUsageReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTenantUsageReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTenantUsageReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTenantUsageReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTenantUsageReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTenantUsageReportRequestValidationError) ErrorName() string {
+	return "GetTenantUsageReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTenantUsageReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTenantUsageReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTenantUsageReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTenantUsageReportRequestValidationError{}
+
+// Validate checks the field values on GetTenantUsageReportResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTenantUsageReportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTenantUsageReportResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTenantUsageReportResponseMultiError, or nil if none found.
+func (m *GetTenantUsageReportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTenantUsageReportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTenants() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetTenantUsageReportResponseValidationError{
+						field:  fmt.Sprintf("Tenants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetTenantUsageReportResponseValidationError{
+						field:  fmt.Sprintf("Tenants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetTenantUsageReportResponseValidationError{
+					field:  fmt.Sprintf("Tenants[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalDocumentCount
+
+	// no validation rules for TotalStorageBytes
+
+	if all {
+		switch v := interface{}(m.GetGeneratedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTenantUsageReportResponseValidationError{
+					field:  "GeneratedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTenantUsageReportResponseValidationError{
+					field:  "GeneratedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGeneratedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTenantUsageReportResponseValidationError{
+				field:  "GeneratedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetTenantUsageReportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTenantUsageReportResponseMultiError is an error wrapping multiple
+// validation errors returned by GetTenantUsageReportResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetTenantUsageReportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTenantUsageReportResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTenantUsageReportResponseMultiError) AllErrors() []error { return m }
+
+// GetTenantUsageReportResponseValidationError is the validation error returned
+// by GetTenantUsageReportResponse.Validate if the designated constraints
+// aren't met.
+type GetTenantUsageReportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTenantUsageReportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTenantUsageReportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTenantUsageReportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTenantUsageReportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTenantUsageReportResponseValidationError) ErrorName() string {
+	return "GetTenantUsageReportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTenantUsageReportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTenantUsageReportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTenantUsageReportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTenantUsageReportResponseValidationError{}
+
+// Validate checks the field values on TenantUsage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TenantUsage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantUsage with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TenantUsageMultiError, or
+// nil if none found.
+func (m *TenantUsage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantUsage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for DocumentCount
+
+	// no validation rules for StorageBytes
+
+	// no validation rules for CategoryCount
+
+	// no validation rules for Uploads_7D
+
+	// no validation rules for UploadBytes_7D
+
+	// no validation rules for Uploads_30D
+
+	// no validation rules for UploadBytes_30D
+
+	if len(errors) > 0 {
+		return TenantUsageMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantUsageMultiError is an error wrapping multiple validation errors
+// returned by TenantUsage.ValidateAll() if the designated constraints aren't met.
+type TenantUsageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantUsageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantUsageMultiError) AllErrors() []error { return m }
+
+// TenantUsageValidationError is the validation error returned by
+// TenantUsage.Validate if the designated constraints aren't met.
+type TenantUsageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantUsageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantUsageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantUsageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantUsageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantUsageValidationError) ErrorName() string { return "TenantUsageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TenantUsageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantUsage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantUsageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantUsageValidationError{}
+
+// Validate checks the field values on GetProcessingQueueStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProcessingQueueStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProcessingQueueStatusRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetProcessingQueueStatusRequestMultiError, or nil if none found.
+func (m *GetProcessingQueueStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProcessingQueueStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetProcessingQueueStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProcessingQueueStatusRequestMultiError is an error wrapping multiple
+// validation errors returned by GetProcessingQueueStatusRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetProcessingQueueStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProcessingQueueStatusRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProcessingQueueStatusRequestMultiError) AllErrors() []error { return m }
+
+// GetProcessingQueueStatusRequestValidationError is the validation error
+// returned by GetProcessingQueueStatusRequest.Validate if the designated
+// constraints aren't met.
+type GetProcessingQueueStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProcessingQueueStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProcessingQueueStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProcessingQueueStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProcessingQueueStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProcessingQueueStatusRequestValidationError) ErrorName() string {
+	return "GetProcessingQueueStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProcessingQueueStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProcessingQueueStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProcessingQueueStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProcessingQueueStatusRequestValidationError{}
+
+// Validate checks the field values on GetProcessingQueueStatusResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetProcessingQueueStatusResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProcessingQueueStatusResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetProcessingQueueStatusResponseMultiError, or nil if none found.
+func (m *GetProcessingQueueStatusResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProcessingQueueStatusResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for QueueDepth
+
+	// no validation rules for InFlight
+
+	// no validation rules for RetryWaiting
+
+	// no validation rules for TotalRetries
+
+	// no validation rules for OldestPendingAgeSeconds
+
+	// no validation rules for Workers
+
+	// no validation rules for MaxRetries
+
+	if all {
+		switch v := interface{}(m.GetGeneratedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetProcessingQueueStatusResponseValidationError{
+					field:  "GeneratedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetProcessingQueueStatusResponseValidationError{
+					field:  "GeneratedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGeneratedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetProcessingQueueStatusResponseValidationError{
+				field:  "GeneratedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetProcessingQueueStatusResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProcessingQueueStatusResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetProcessingQueueStatusResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetProcessingQueueStatusResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProcessingQueueStatusResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProcessingQueueStatusResponseMultiError) AllErrors() []error { return m }
+
+// GetProcessingQueueStatusResponseValidationError is the validation error
+// returned by GetProcessingQueueStatusResponse.Validate if the designated
+// constraints aren't met.
+type GetProcessingQueueStatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProcessingQueueStatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProcessingQueueStatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProcessingQueueStatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProcessingQueueStatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProcessingQueueStatusResponseValidationError) ErrorName() string {
+	return "GetProcessingQueueStatusResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProcessingQueueStatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProcessingQueueStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProcessingQueueStatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProcessingQueueStatusResponseValidationError{}
+
+// Validate checks the field values on ExportStatisticsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportStatisticsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportStatisticsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportStatisticsRequestMultiError, or nil if none found.
+func (m *ExportStatisticsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportStatisticsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if m.StartTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetStartTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExportStatisticsRequestValidationError{
+						field:  "StartTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExportStatisticsRequestValidationError{
+						field:  "StartTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExportStatisticsRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.EndTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetEndTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExportStatisticsRequestValidationError{
+						field:  "EndTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExportStatisticsRequestValidationError{
+						field:  "EndTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExportStatisticsRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ExportStatisticsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportStatisticsRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportStatisticsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportStatisticsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportStatisticsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportStatisticsRequestMultiError) AllErrors() []error { return m }
+
+// ExportStatisticsRequestValidationError is the validation error returned by
+// ExportStatisticsRequest.Validate if the designated constraints aren't met.
+type ExportStatisticsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportStatisticsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportStatisticsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportStatisticsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportStatisticsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportStatisticsRequestValidationError) ErrorName() string {
+	return "ExportStatisticsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportStatisticsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportStatisticsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportStatisticsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportStatisticsRequestValidationError{}
+
+// Validate checks the field values on ExportStatisticsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportStatisticsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportStatisticsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportStatisticsResponseMultiError, or nil if none found.
+func (m *ExportStatisticsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportStatisticsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Data
+
+	// no validation rules for FileName
+
+	// no validation rules for ContentType
+
+	// no validation rules for TenantId
+
+	if all {
+		switch v := interface{}(m.GetGeneratedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportStatisticsResponseValidationError{
+					field:  "GeneratedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportStatisticsResponseValidationError{
+					field:  "GeneratedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGeneratedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportStatisticsResponseValidationError{
+				field:  "GeneratedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExportStatisticsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportStatisticsResponseMultiError is an error wrapping multiple validation
+// errors returned by ExportStatisticsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ExportStatisticsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportStatisticsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportStatisticsResponseMultiError) AllErrors() []error { return m }
+
+// ExportStatisticsResponseValidationError is the validation error returned by
+// ExportStatisticsResponse.Validate if the designated constraints aren't met.
+type ExportStatisticsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportStatisticsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportStatisticsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportStatisticsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportStatisticsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportStatisticsResponseValidationError) ErrorName() string {
+	return "ExportStatisticsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportStatisticsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportStatisticsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportStatisticsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportStatisticsResponseValidationError{}
@@ -19,7 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PaperlessStatisticsService_GetStatistics_FullMethodName = "/paperless.service.v1.PaperlessStatisticsService/GetStatistics"
+	PaperlessStatisticsService_GetStatistics_FullMethodName            = "/paperless.service.v1.PaperlessStatisticsService/GetStatistics"
+	PaperlessStatisticsService_GetUploadTimeSeries_FullMethodName      = "/paperless.service.v1.PaperlessStatisticsService/GetUploadTimeSeries"
+	PaperlessStatisticsService_GetTenantUsageReport_FullMethodName     = "/paperless.service.v1.PaperlessStatisticsService/GetTenantUsageReport"
+	PaperlessStatisticsService_GetProcessingQueueStatus_FullMethodName = "/paperless.service.v1.PaperlessStatisticsService/GetProcessingQueueStatus"
+	PaperlessStatisticsService_ExportStatistics_FullMethodName         = "/paperless.service.v1.PaperlessStatisticsService/ExportStatistics"
 )
 
 // PaperlessStatisticsServiceClient is the client API for PaperlessStatisticsService service.
@@ -30,6 +34,14 @@ const (
 type PaperlessStatisticsServiceClient interface {
 	// GetStatistics returns comprehensive statistics about the Paperless system
 	GetStatistics(ctx context.Context, in *GetStatisticsRequest, opts ...grpc.CallOption) (*GetStatisticsResponse, error)
+	// GetUploadTimeSeries returns document uploads per day, week or month over a time window
+	GetUploadTimeSeries(ctx context.Context, in *GetUploadTimeSeriesRequest, opts ...grpc.CallOption) (*GetUploadTimeSeriesResponse, error)
+	// GetTenantUsageReport returns document and storage usage of every tenant (platform admin only)
+	GetTenantUsageReport(ctx context.Context, in *GetTenantUsageReportRequest, opts ...grpc.CallOption) (*GetTenantUsageReportResponse, error)
+	// GetProcessingQueueStatus returns the backlog of the document processing queue (platform admin only)
+	GetProcessingQueueStatus(ctx context.Context, in *GetProcessingQueueStatusRequest, opts ...grpc.CallOption) (*GetProcessingQueueStatusResponse, error)
+	// ExportStatistics returns statistics as CSV for spreadsheets
+	ExportStatistics(ctx context.Context, in *ExportStatisticsRequest, opts ...grpc.CallOption) (*ExportStatisticsResponse, error)
 }
 
 type paperlessStatisticsServiceClient struct {
@@ -50,6 +62,46 @@ func (c *paperlessStatisticsServiceClient) GetStatistics(ctx context.Context, in
 	return out, nil
 }
 
+func (c *paperlessStatisticsServiceClient) GetUploadTimeSeries(ctx context.Context, in *GetUploadTimeSeriesRequest, opts ...grpc.CallOption) (*GetUploadTimeSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUploadTimeSeriesResponse)
+	err := c.cc.Invoke(ctx, PaperlessStatisticsService_GetUploadTimeSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paperlessStatisticsServiceClient) GetTenantUsageReport(ctx context.Context, in *GetTenantUsageReportRequest, opts ...grpc.CallOption) (*GetTenantUsageReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTenantUsageReportResponse)
+	err := c.cc.Invoke(ctx, PaperlessStatisticsService_GetTenantUsageReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paperlessStatisticsServiceClient) GetProcessingQueueStatus(ctx context.Context, in *GetProcessingQueueStatusRequest, opts ...grpc.CallOption) (*GetProcessingQueueStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProcessingQueueStatusResponse)
+	err := c.cc.Invoke(ctx, PaperlessStatisticsService_GetProcessingQueueStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paperlessStatisticsServiceClient) ExportStatistics(ctx context.Context, in *ExportStatisticsRequest, opts ...grpc.CallOption) (*ExportStatisticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportStatisticsResponse)
+	err := c.cc.Invoke(ctx, PaperlessStatisticsService_ExportStatistics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PaperlessStatisticsServiceServer is the server API for PaperlessStatisticsService service.
 // All implementations must embed UnimplementedPaperlessStatisticsServiceServer
 // for forward compatibility.
@@ -58,6 +110,14 @@ func (c *paperlessStatisticsServiceClient) GetStatistics(ctx context.Context, in
 type PaperlessStatisticsServiceServer interface {
 	// GetStatistics returns comprehensive statistics about the Paperless system
 	GetStatistics(context.Context, *GetStatisticsRequest) (*GetStatisticsResponse, error)
+	// GetUploadTimeSeries returns document uploads per day, week or month over a time window
+	GetUploadTimeSeries(context.Context, *GetUploadTimeSeriesRequest) (*GetUploadTimeSeriesResponse, error)
+	// GetTenantUsageReport returns document and storage usage of every tenant (platform admin only)
+	GetTenantUsageReport(context.Context, *GetTenantUsageReportRequest) (*GetTenantUsageReportResponse, error)
+	// GetProcessingQueueStatus returns the backlog of the document processing queue (platform admin only)
+	GetProcessingQueueStatus(context.Context, *GetProcessingQueueStatusRequest) (*GetProcessingQueueStatusResponse, error)
+	// ExportStatistics returns statistics as CSV for spreadsheets
+	ExportStatistics(context.Context, *ExportStatisticsRequest) (*ExportStatisticsResponse, error)
 	mustEmbedUnimplementedPaperlessStatisticsServiceServer()
 }
 
@@ -71,6 +131,18 @@ type UnimplementedPaperlessStatisticsServiceServer struct{}
 func (UnimplementedPaperlessStatisticsServiceServer) GetStatistics(context.Context, *GetStatisticsRequest) (*GetStatisticsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetStatistics not implemented")
 }
+func (UnimplementedPaperlessStatisticsServiceServer) GetUploadTimeSeries(context.Context, *GetUploadTimeSeriesRequest) (*GetUploadTimeSeriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUploadTimeSeries not implemented")
+}
+func (UnimplementedPaperlessStatisticsServiceServer) GetTenantUsageReport(context.Context, *GetTenantUsageReportRequest) (*GetTenantUsageReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTenantUsageReport not implemented")
+}
+func (UnimplementedPaperlessStatisticsServiceServer) GetProcessingQueueStatus(context.Context, *GetProcessingQueueStatusRequest) (*GetProcessingQueueStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProcessingQueueStatus not implemented")
+}
+func (UnimplementedPaperlessStatisticsServiceServer) ExportStatistics(context.Context, *ExportStatisticsRequest) (*ExportStatisticsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportStatistics not implemented")
+}
 func (UnimplementedPaperlessStatisticsServiceServer) mustEmbedUnimplementedPaperlessStatisticsServiceServer() {
 }
 func (UnimplementedPaperlessStatisticsServiceServer) testEmbeddedByValue() {}
@@ -111,6 +183,78 @@ func _PaperlessStatisticsService_GetStatistics_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaperlessStatisticsService_GetUploadTimeSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUploadTimeSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaperlessStatisticsServiceServer).GetUploadTimeSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaperlessStatisticsService_GetUploadTimeSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaperlessStatisticsServiceServer).GetUploadTimeSeries(ctx, req.(*GetUploadTimeSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaperlessStatisticsService_GetTenantUsageReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenantUsageReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaperlessStatisticsServiceServer).GetTenantUsageReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaperlessStatisticsService_GetTenantUsageReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaperlessStatisticsServiceServer).GetTenantUsageReport(ctx, req.(*GetTenantUsageReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaperlessStatisticsService_GetProcessingQueueStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProcessingQueueStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaperlessStatisticsServiceServer).GetProcessingQueueStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaperlessStatisticsService_GetProcessingQueueStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaperlessStatisticsServiceServer).GetProcessingQueueStatus(ctx, req.(*GetProcessingQueueStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaperlessStatisticsService_ExportStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaperlessStatisticsServiceServer).ExportStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaperlessStatisticsService_ExportStatistics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaperlessStatisticsServiceServer).ExportStatistics(ctx, req.(*ExportStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PaperlessStatisticsService_ServiceDesc is the grpc.ServiceDesc for PaperlessStatisticsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -122,6 +266,22 @@ var PaperlessStatisticsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStatistics",
 			Handler:    _PaperlessStatisticsService_GetStatistics_Handler,
 		},
+		{
+			MethodName: "GetUploadTimeSeries",
+			Handler:    _PaperlessStatisticsService_GetUploadTimeSeries_Handler,
+		},
+		{
+			MethodName: "GetTenantUsageReport",
+			Handler:    _PaperlessStatisticsService_GetTenantUsageReport_Handler,
+		},
+		{
+			MethodName: "GetProcessingQueueStatus",
+			Handler:    _PaperlessStatisticsService_GetProcessingQueueStatus_Handler,
+		},
+		{
+			MethodName: "ExportStatistics",
+			Handler:    _PaperlessStatisticsService_ExportStatistics_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "paperless/service/v1/statistics.proto",
@@ -19,16 +19,32 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationPaperlessStatisticsServiceExportStatistics = "/paperless.service.v1.PaperlessStatisticsService/ExportStatistics"
+const OperationPaperlessStatisticsServiceGetProcessingQueueStatus = "/paperless.service.v1.PaperlessStatisticsService/GetProcessingQueueStatus"
 const OperationPaperlessStatisticsServiceGetStatistics = "/paperless.service.v1.PaperlessStatisticsService/GetStatistics"
+const OperationPaperlessStatisticsServiceGetTenantUsageReport = "/paperless.service.v1.PaperlessStatisticsService/GetTenantUsageReport"
+const OperationPaperlessStatisticsServiceGetUploadTimeSeries = "/paperless.service.v1.PaperlessStatisticsService/GetUploadTimeSeries"
 
 type PaperlessStatisticsServiceHTTPServer interface {
+	// ExportStatistics ExportStatistics returns statistics as CSV for spreadsheets
+	ExportStatistics(context.Context, *ExportStatisticsRequest) (*ExportStatisticsResponse, error)
+	// GetProcessingQueueStatus GetProcessingQueueStatus returns the backlog of the document processing queue (platform admin only)
+	GetProcessingQueueStatus(context.Context, *GetProcessingQueueStatusRequest) (*GetProcessingQueueStatusResponse, error)
 	// GetStatistics GetStatistics returns comprehensive statistics about the Paperless system
 	GetStatistics(context.Context, *GetStatisticsRequest) (*GetStatisticsResponse, error)
+	// GetTenantUsageReport GetTenantUsageReport returns document and storage usage of every tenant (platform admin only)
+	GetTenantUsageReport(context.Context, *GetTenantUsageReportRequest) (*GetTenantUsageReportResponse, error)
+	// GetUploadTimeSeries GetUploadTimeSeries returns document uploads per day, week or month over a time window
+	GetUploadTimeSeries(context.Context, *GetUploadTimeSeriesRequest) (*GetUploadTimeSeriesResponse, error)
 }
 
 func RegisterPaperlessStatisticsServiceHTTPServer(s *http.Server, srv PaperlessStatisticsServiceHTTPServer) {
 	r := s.Route("/")
 	r.GET("/v1/statistics", _PaperlessStatisticsService_GetStatistics0_HTTP_Handler(srv))
+	r.GET("/v1/statistics/uploads", _PaperlessStatisticsService_GetUploadTimeSeries0_HTTP_Handler(srv))
+	r.GET("/v1/statistics/tenants", _PaperlessStatisticsService_GetTenantUsageReport0_HTTP_Handler(srv))
+	r.GET("/v1/statistics/processing-queue", _PaperlessStatisticsService_GetProcessingQueueStatus0_HTTP_Handler(srv))
+	r.GET("/v1/statistics/export", _PaperlessStatisticsService_ExportStatistics0_HTTP_Handler(srv))
 }
 
 func _PaperlessStatisticsService_GetStatistics0_HTTP_Handler(srv PaperlessStatisticsServiceHTTPServer) func(ctx http.Context) error {
@@ -50,9 +66,93 @@ func _PaperlessStatisticsService_GetStatistics0_HTTP_Handler(srv PaperlessStatis
 	}
 }
 
+func _PaperlessStatisticsService_GetUploadTimeSeries0_HTTP_Handler(srv PaperlessStatisticsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetUploadTimeSeriesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPaperlessStatisticsServiceGetUploadTimeSeries)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetUploadTimeSeries(ctx, req.(*GetUploadTimeSeriesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetUploadTimeSeriesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PaperlessStatisticsService_GetTenantUsageReport0_HTTP_Handler(srv PaperlessStatisticsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTenantUsageReportRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPaperlessStatisticsServiceGetTenantUsageReport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTenantUsageReport(ctx, req.(*GetTenantUsageReportRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetTenantUsageReportResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PaperlessStatisticsService_GetProcessingQueueStatus0_HTTP_Handler(srv PaperlessStatisticsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetProcessingQueueStatusRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPaperlessStatisticsServiceGetProcessingQueueStatus)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetProcessingQueueStatus(ctx, req.(*GetProcessingQueueStatusRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetProcessingQueueStatusResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PaperlessStatisticsService_ExportStatistics0_HTTP_Handler(srv PaperlessStatisticsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ExportStatisticsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPaperlessStatisticsServiceExportStatistics)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ExportStatistics(ctx, req.(*ExportStatisticsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ExportStatisticsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type PaperlessStatisticsServiceHTTPClient interface {
+	// ExportStatistics ExportStatistics returns statistics as CSV for spreadsheets
+	ExportStatistics(ctx context.Context, req *ExportStatisticsRequest, opts ...http.CallOption) (rsp *ExportStatisticsResponse, err error)
+	// GetProcessingQueueStatus GetProcessingQueueStatus returns the backlog of the document processing queue (platform admin only)
+	GetProcessingQueueStatus(ctx context.Context, req *GetProcessingQueueStatusRequest, opts ...http.CallOption) (rsp *GetProcessingQueueStatusResponse, err error)
 	// GetStatistics GetStatistics returns comprehensive statistics about the Paperless system
 	GetStatistics(ctx context.Context, req *GetStatisticsRequest, opts ...http.CallOption) (rsp *GetStatisticsResponse, err error)
+	// GetTenantUsageReport GetTenantUsageReport returns document and storage usage of every tenant (platform admin only)
+	GetTenantUsageReport(ctx context.Context, req *GetTenantUsageReportRequest, opts ...http.CallOption) (rsp *GetTenantUsageReportResponse, err error)
+	// GetUploadTimeSeries GetUploadTimeSeries returns document uploads per day, week or month over a time window
+	GetUploadTimeSeries(ctx context.Context, req *GetUploadTimeSeriesRequest, opts ...http.CallOption) (rsp *GetUploadTimeSeriesResponse, err error)
 }
 
 type PaperlessStatisticsServiceHTTPClientImpl struct {
@@ -63,6 +163,34 @@ func NewPaperlessStatisticsServiceHTTPClient(client *http.Client) PaperlessStati
 	return &PaperlessStatisticsServiceHTTPClientImpl{client}
 }
 
+// ExportStatistics ExportStatistics returns statistics as CSV for spreadsheets
+func (c *PaperlessStatisticsServiceHTTPClientImpl) ExportStatistics(ctx context.Context, in *ExportStatisticsRequest, opts ...http.CallOption) (*ExportStatisticsResponse, error) {
+	var out ExportStatisticsResponse
+	pattern := "/v1/statistics/export"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPaperlessStatisticsServiceExportStatistics))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetProcessingQueueStatus GetProcessingQueueStatus returns the backlog of the document processing queue (platform admin only)
+func (c *PaperlessStatisticsServiceHTTPClientImpl) GetProcessingQueueStatus(ctx context.Context, in *GetProcessingQueueStatusRequest, opts ...http.CallOption) (*GetProcessingQueueStatusResponse, error) {
+	var out GetProcessingQueueStatusResponse
+	pattern := "/v1/statistics/processing-queue"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPaperlessStatisticsServiceGetProcessingQueueStatus))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // GetStatistics GetStatistics returns comprehensive statistics about the Paperless system
 func (c *PaperlessStatisticsServiceHTTPClientImpl) GetStatistics(ctx context.Context, in *GetStatisticsRequest, opts ...http.CallOption) (*GetStatisticsResponse, error) {
 	var out GetStatisticsResponse
@@ -76,3 +204,31 @@ func (c *PaperlessStatisticsServiceHTTPClientImpl) GetStatistics(ctx context.Con
 	}
 	return &out, nil
 }
+
+// GetTenantUsageReport GetTenantUsageReport returns document and storage usage of every tenant (platform admin only)
+func (c *PaperlessStatisticsServiceHTTPClientImpl) GetTenantUsageReport(ctx context.Context, in *GetTenantUsageReportRequest, opts ...http.CallOption) (*GetTenantUsageReportResponse, error) {
+	var out GetTenantUsageReportResponse
+	pattern := "/v1/statistics/tenants"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPaperlessStatisticsServiceGetTenantUsageReport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetUploadTimeSeries GetUploadTimeSeries returns document uploads per day, week or month over a time window
+func (c *PaperlessStatisticsServiceHTTPClientImpl) GetUploadTimeSeries(ctx context.Context, in *GetUploadTimeSeriesRequest, opts ...http.CallOption) (*GetUploadTimeSeriesResponse, error) {
+	var out GetUploadTimeSeriesResponse
+	pattern := "/v1/statistics/uploads"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPaperlessStatisticsServiceGetUploadTimeSeries))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
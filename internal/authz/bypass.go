@@ -0,0 +1,83 @@
+package authz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// BypassPolicy controls how platform administrators are treated by the engine
+type BypassPolicy string
+
+const (
+	// BypassPolicyFull lets platform admins perform every action on every resource
+	BypassPolicyFull BypassPolicy = "full"
+	// BypassPolicyReadOnly lets platform admins read and download every resource;
+	// all other actions still require an explicit grant
+	BypassPolicyReadOnly BypassPolicy = "read_only"
+	// BypassPolicyNone treats platform admins like any other user
+	BypassPolicyNone BypassPolicy = "none"
+)
+
+// DefaultBypassPolicy is used when no policy is configured. Platform admins get no access
+// beyond their grants until a deployment opts in.
+const DefaultBypassPolicy = BypassPolicyNone
+
+// ParseBypassPolicy parses a bypass policy name (case-insensitive, "-" and "_" interchangeable)
+func ParseBypassPolicy(s string) (BypassPolicy, error) {
+	switch strings.ReplaceAll(strings.ToLower(strings.TrimSpace(s)), "-", "_") {
+	case "":
+		return DefaultBypassPolicy, nil
+	case string(BypassPolicyFull):
+		return BypassPolicyFull, nil
+	case string(BypassPolicyReadOnly), "readonly":
+		return BypassPolicyReadOnly, nil
+	case string(BypassPolicyNone):
+		return BypassPolicyNone, nil
+	default:
+		return "", fmt.Errorf("unknown admin bypass policy %q", s)
+	}
+}
+
+// Allows reports whether the policy lets a platform admin perform the permission
+func (p BypassPolicy) Allows(permission Permission) bool {
+	switch p {
+	case BypassPolicyFull:
+		return true
+	case BypassPolicyReadOnly:
+		return permission == PermissionRead || permission == PermissionDownload
+	default:
+		return false
+	}
+}
+
+// EngineOption configures an Engine
+type EngineOption func(*Engine)
+
+// WithBypassPolicy sets the platform-admin bypass policy
+func WithBypassPolicy(policy BypassPolicy) EngineOption {
+	return func(e *Engine) {
+		e.bypass = policy
+	}
+}
+
+// BypassPolicy returns the configured platform-admin bypass policy
+func (e *Engine) BypassPolicy() BypassPolicy {
+	return e.bypass
+}
+
+// AdminBypass reports whether userID is a platform admin whose action is allowed by the bypass policy
+func (e *Engine) AdminBypass(ctx context.Context, tenantID uint32, userID string, permission Permission) bool {
+	if e.bypass == BypassPolicyNone || !e.bypass.Allows(permission) {
+		return false
+	}
+	return e.lookup.IsPlatformAdmin(ctx, tenantID, userID)
+}
+
+// bypassRelation returns the relation reported for a bypassed check
+func (p BypassPolicy) bypassRelation() Relation {
+	if p == BypassPolicyFull {
+		return RelationOwner
+	}
+	return RelationViewer
+}
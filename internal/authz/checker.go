@@ -166,6 +166,20 @@ func (c *Checker) GetEffectivePermissions(ctx context.Context, tenantID uint32,
 	})
 }
 
+// ListReadable returns the IDs of the resources of a type a user can read, for filtering
+// queries before they are paginated. all is true when the platform-admin bypass lets the
+// user read every resource, in which case no IDs are returned.
+func (c *Checker) ListReadable(ctx context.Context, tenantID uint32, userID string, resourceType ResourceType) (ids []string, all bool, err error) {
+	if c.engine.AdminBypass(ctx, tenantID, userID, PermissionRead) {
+		return nil, true, nil
+	}
+	ids, err = c.engine.ListAccessibleResources(ctx, tenantID, userID, resourceType, PermissionRead)
+	if err != nil {
+		return nil, false, err
+	}
+	return ids, false, nil
+}
+
 // ListAccessibleCategories lists all categories accessible by a user
 func (c *Checker) ListAccessibleCategories(ctx context.Context, tenantID uint32, userID string) ([]string, error) {
 	return c.engine.ListAccessibleResources(ctx, tenantID, userID, ResourceTypeCategory, PermissionRead)
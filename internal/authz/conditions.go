@@ -0,0 +1,178 @@
+package authz
+
+import (
+	"fmt"
+	"net"
+	"time"
+)
+
+// Conditions are optional caveats attached to a permission tuple.
+// A tuple with conditions only grants access when every condition holds
+// for the request being checked.
+type Conditions struct {
+	// AllowedCIDRs restricts access to requests originating from these networks
+	AllowedCIDRs []string `json:"allowed_cidrs,omitempty"`
+	// TimeWindow restricts access to a time-of-day window
+	TimeWindow *TimeWindow `json:"time_window,omitempty"`
+	// RequireMFA requires the caller to have completed multi-factor authentication
+	RequireMFA bool `json:"require_mfa,omitempty"`
+}
+
+// TimeWindow is a daily time-of-day window, optionally limited to certain weekdays.
+// If End is before Start the window wraps past midnight.
+type TimeWindow struct {
+	// Start time in HH:MM (24h) format
+	Start string `json:"start"`
+	// End time in HH:MM (24h) format
+	End string `json:"end"`
+	// IANA time zone name (defaults to UTC)
+	Timezone string `json:"timezone,omitempty"`
+	// Allowed weekdays (0 = Sunday ... 6 = Saturday); empty allows all days
+	Weekdays []int32 `json:"weekdays,omitempty"`
+}
+
+// RequestAttributes holds the request properties conditions are evaluated against
+type RequestAttributes struct {
+	ClientIP    string
+	MFAVerified bool
+	Time        time.Time
+}
+
+// IsEmpty reports whether no condition is set
+func (c *Conditions) IsEmpty() bool {
+	return c == nil || (len(c.AllowedCIDRs) == 0 && c.TimeWindow == nil && !c.RequireMFA)
+}
+
+// Validate checks that the conditions are well-formed
+func (c *Conditions) Validate() error {
+	if c == nil {
+		return nil
+	}
+	for _, cidr := range c.AllowedCIDRs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return fmt.Errorf("invalid CIDR %q", cidr)
+		}
+	}
+	if w := c.TimeWindow; w != nil {
+		if _, err := parseClock(w.Start); err != nil {
+			return fmt.Errorf("invalid time window start %q", w.Start)
+		}
+		if _, err := parseClock(w.End); err != nil {
+			return fmt.Errorf("invalid time window end %q", w.End)
+		}
+		if w.Timezone != "" {
+			if _, err := time.LoadLocation(w.Timezone); err != nil {
+				return fmt.Errorf("invalid time zone %q", w.Timezone)
+			}
+		}
+		for _, d := range w.Weekdays {
+			if d < 0 || d > 6 {
+				return fmt.Errorf("invalid weekday %d", d)
+			}
+		}
+	}
+	return nil
+}
+
+// Evaluate checks the conditions against request attributes.
+// It returns false and the failing condition when access should not be granted.
+func (c *Conditions) Evaluate(attrs RequestAttributes) (bool, string) {
+	if c.IsEmpty() {
+		return true, ""
+	}
+
+	if c.RequireMFA && !attrs.MFAVerified {
+		return false, "condition not met: mfa required"
+	}
+
+	if len(c.AllowedCIDRs) > 0 && !ipInCIDRs(attrs.ClientIP, c.AllowedCIDRs) {
+		return false, "condition not met: client ip not allowed"
+	}
+
+	if c.TimeWindow != nil && !c.TimeWindow.contains(attrs.Time) {
+		return false, "condition not met: outside allowed time window"
+	}
+
+	return true, ""
+}
+
+// contains reports whether t falls inside the window
+func (w *TimeWindow) contains(t time.Time) bool {
+	loc := time.UTC
+	if w.Timezone != "" {
+		l, err := time.LoadLocation(w.Timezone)
+		if err != nil {
+			return false
+		}
+		loc = l
+	}
+	t = t.In(loc)
+
+	start, err := parseClock(w.Start)
+	if err != nil {
+		return false
+	}
+	end, err := parseClock(w.End)
+	if err != nil {
+		return false
+	}
+
+	minute := t.Hour()*60 + t.Minute()
+	day := t.Weekday()
+
+	var inWindow bool
+	if start <= end {
+		inWindow = minute >= start && minute < end
+	} else {
+		// Window wraps past midnight; the early-morning part belongs to the previous day
+		if minute >= start {
+			inWindow = true
+		} else if minute < end {
+			inWindow = true
+			day = (day + 6) % 7
+		}
+	}
+	if !inWindow {
+		return false
+	}
+
+	if len(w.Weekdays) == 0 {
+		return true
+	}
+	for _, d := range w.Weekdays {
+		if time.Weekday(d) == day {
+			return true
+		}
+	}
+	return false
+}
+
+// parseClock parses HH:MM into minutes since midnight
+func parseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// ipInCIDRs reports whether ip is contained in any of the networks
+func ipInCIDRs(ip string, cidrs []string) bool {
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			continue
+		}
+		if network.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
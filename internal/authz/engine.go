@@ -2,9 +2,14 @@ package authz
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/metrics"
+	"github.com/go-tangra/go-tangra-paperless/internal/tracing"
 )
 
 // PermissionTuple represents a permission relationship in the system
@@ -18,6 +23,7 @@ type PermissionTuple struct {
 	SubjectID    string
 	GrantedBy    *uint32
 	ExpiresAt    *time.Time
+	Conditions   *Conditions
 	CreateTime   time.Time
 }
 
@@ -29,6 +35,12 @@ type ResourceLookup interface {
 	GetDocumentCategoryID(ctx context.Context, tenantID uint32, documentID string) (*string, error)
 	// GetUserRoleIDs returns the role IDs for a user
 	GetUserRoleIDs(ctx context.Context, tenantID uint32, userID string) ([]string, error)
+	// GetUserGroupIDs returns the IDs of the directory groups a user belongs to
+	GetUserGroupIDs(ctx context.Context, tenantID uint32, userID string) ([]string, error)
+	// GetRequestAttributes returns the request attributes used to evaluate tuple conditions
+	GetRequestAttributes(ctx context.Context) RequestAttributes
+	// IsPlatformAdmin reports whether the user is a platform administrator
+	IsPlatformAdmin(ctx context.Context, tenantID uint32, userID string) bool
 }
 
 // PermissionStore provides methods to store and retrieve permissions
@@ -37,8 +49,8 @@ type PermissionStore interface {
 	GetDirectPermissions(ctx context.Context, tenantID uint32, resourceType ResourceType, resourceID string) ([]PermissionTuple, error)
 	// GetSubjectPermissions returns all permissions for a subject
 	GetSubjectPermissions(ctx context.Context, tenantID uint32, subjectType SubjectType, subjectID string) ([]PermissionTuple, error)
-	// HasPermission checks if a specific permission exists
-	HasPermission(ctx context.Context, tenantID uint32, resourceType ResourceType, resourceID string, subjectType SubjectType, subjectID string) (*PermissionTuple, error)
+	// FindPermissions returns every permission a subject holds on a resource
+	FindPermissions(ctx context.Context, tenantID uint32, resourceType ResourceType, resourceID string, subjectType SubjectType, subjectID string) ([]PermissionTuple, error)
 	// CreatePermission creates a new permission
 	CreatePermission(ctx context.Context, tuple PermissionTuple) (*PermissionTuple, error)
 	// DeletePermission deletes a permission
@@ -47,20 +59,51 @@ type PermissionStore interface {
 	ListResourcesBySubject(ctx context.Context, tenantID uint32, subjectType SubjectType, subjectID string, resourceType ResourceType) ([]string, error)
 }
 
+// IndexedGrant is an entry of the materialized access index: a tuple's relation
+// on a resource, either granted directly or inherited from a parent category
+type IndexedGrant struct {
+	ResourceID string
+	Relation   Relation
+	ExpiresAt  *time.Time
+	Conditions *Conditions
+}
+
+// AccessIndex provides materialized (subject -> accessible resource) lookups
+type AccessIndex interface {
+	// ListIndexedGrants returns the index entries of any of the subjects for a resource type
+	// in a single lookup
+	ListIndexedGrants(ctx context.Context, tenantID uint32, subjects []Subject, resourceType ResourceType) ([]IndexedGrant, error)
+}
+
 // Engine implements Zanzibar-like permission checking
 type Engine struct {
 	store  PermissionStore
 	lookup ResourceLookup
+	index  AccessIndex
+	bypass BypassPolicy
 	log    *log.Helper
 }
 
+// WithAccessIndex makes ListAccessibleResources use the materialized access index,
+// which also covers access inherited from parent categories
+func WithAccessIndex(index AccessIndex) EngineOption {
+	return func(e *Engine) {
+		e.index = index
+	}
+}
+
 // NewEngine creates a new authorization engine
-func NewEngine(store PermissionStore, lookup ResourceLookup, logger log.Logger) *Engine {
-	return &Engine{
+func NewEngine(store PermissionStore, lookup ResourceLookup, logger log.Logger, opts ...EngineOption) *Engine {
+	e := &Engine{
 		store:  store,
 		lookup: lookup,
+		bypass: DefaultBypassPolicy,
 		log:    log.NewHelper(log.With(logger, "module", "authz/engine")),
 	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
 }
 
 // CheckContext contains context for permission checks
@@ -70,6 +113,8 @@ type CheckContext struct {
 	ResourceType ResourceType
 	ResourceID   string
 	Permission   Permission
+	// Attributes overrides the request attributes used for conditions (resolved from ctx when nil)
+	Attributes *RequestAttributes
 }
 
 // CheckResult represents the result of a permission check
@@ -80,12 +125,49 @@ type CheckResult struct {
 }
 
 // Check performs a permission check following Zanzibar algorithm:
+// 0. Platform admins are allowed if the bypass policy covers the permission
 // 1. Check direct permission on resource
 // 2. If resource is Document, check parent Category permissions
 // 3. If Category has parent, recursively check parent permissions
-// 4. Check user's roles for indirect permissions
+// 4. Check user's roles and groups for indirect permissions
 // 5. Check tenant-level permissions
 func (e *Engine) Check(ctx context.Context, check CheckContext) CheckResult {
+	ctx, span := tracing.Start(ctx, "authz.check",
+		attribute.String("authz.resource_type", string(check.ResourceType)),
+		attribute.String("authz.resource_id", check.ResourceID),
+		attribute.String("authz.permission", string(check.Permission)),
+	)
+	start := time.Now()
+	result := e.check(ctx, check)
+	metrics.PermissionCheckDuration.
+		WithLabelValues(string(check.Permission), strconv.FormatBool(result.Allowed)).
+		Observe(time.Since(start).Seconds())
+	span.SetAttributes(
+		attribute.Bool("authz.allowed", result.Allowed),
+		attribute.String("authz.reason", result.Reason),
+	)
+	tracing.End(span, nil)
+	return result
+}
+
+// check runs the steps described on Check
+func (e *Engine) check(ctx context.Context, check CheckContext) CheckResult {
+	if check.Attributes == nil {
+		attrs := e.lookup.GetRequestAttributes(ctx)
+		check.Attributes = &attrs
+	}
+
+	// Step 0: Platform-admin bypass
+	if e.AdminBypass(ctx, check.TenantID, check.UserID, check.Permission) {
+		relation := e.bypass.bypassRelation()
+		e.log.Debugf("platform admin bypass: user=%s %s on %s:%s", check.UserID, check.Permission, check.ResourceType, check.ResourceID)
+		return CheckResult{
+			Allowed:  true,
+			Relation: &relation,
+			Reason:   "platform admin bypass",
+		}
+	}
+
 	// Step 1: Check direct user permission on resource
 	if result := e.checkDirectPermission(ctx, check, SubjectTypeUser, check.UserID); result.Allowed {
 		return result
@@ -103,13 +185,25 @@ func (e *Engine) Check(ctx context.Context, check CheckContext) CheckResult {
 		}
 	}
 
-	// Step 3: Check tenant-level permissions
+	// Step 3: Check user's group permissions on resource
+	groupIDs, err := e.lookup.GetUserGroupIDs(ctx, check.TenantID, check.UserID)
+	if err != nil {
+		e.log.Warnf("Failed to get user groups: %v", err)
+	} else {
+		for _, groupID := range groupIDs {
+			if result := e.checkDirectPermission(ctx, check, SubjectTypeGroup, groupID); result.Allowed {
+				return result
+			}
+		}
+	}
+
+	// Step 4: Check tenant-level permissions
 	if result := e.checkDirectPermission(ctx, check, SubjectTypeTenant, "all"); result.Allowed {
 		return result
 	}
 
-	// Step 4: Check parent category permissions (hierarchy)
-	if result := e.checkHierarchy(ctx, check, roleIDs); result.Allowed {
+	// Step 5: Check parent category permissions (hierarchy)
+	if result := e.checkHierarchy(ctx, check, roleIDs, groupIDs); result.Allowed {
 		return result
 	}
 
@@ -119,38 +213,50 @@ func (e *Engine) Check(ctx context.Context, check CheckContext) CheckResult {
 	}
 }
 
-// checkDirectPermission checks for a direct permission on a resource
+// checkDirectPermission checks for a direct permission on a resource. A subject can hold several
+// tuples on a resource, e.g. a conditional editor grant next to a plain viewer one, so it is
+// allowed if any active tuple whose conditions match grants the permission.
 func (e *Engine) checkDirectPermission(ctx context.Context, check CheckContext, subjectType SubjectType, subjectID string) CheckResult {
-	tuple, err := e.store.HasPermission(ctx, check.TenantID, check.ResourceType, check.ResourceID, subjectType, subjectID)
+	tuples, err := e.store.FindPermissions(ctx, check.TenantID, check.ResourceType, check.ResourceID, subjectType, subjectID)
 	if err != nil {
 		e.log.Warnf("error checking permission on %s:%s for %s:%s: %v", check.ResourceType, check.ResourceID, subjectType, subjectID, err)
 		return CheckResult{Allowed: false, Reason: "error checking permission"}
 	}
 
-	if tuple == nil {
-		return CheckResult{Allowed: false, Reason: "no direct permission"}
-	}
+	result := CheckResult{Allowed: false, Reason: "no direct permission"}
+	now := time.Now()
+	for _, tuple := range tuples {
+		// Check if permission has expired
+		if tuple.ExpiresAt != nil && tuple.ExpiresAt.Before(now) {
+			result.Reason = "permission expired"
+			continue
+		}
 
-	// Check if permission has expired
-	if tuple.ExpiresAt != nil && tuple.ExpiresAt.Before(time.Now()) {
-		return CheckResult{Allowed: false, Reason: "permission expired"}
-	}
+		// Check tuple conditions against the request
+		if check.Attributes != nil {
+			if ok, reason := tuple.Conditions.Evaluate(*check.Attributes); !ok {
+				result.Reason = reason
+				continue
+			}
+		}
 
-	// Check if the relation grants the required permission
-	if RelationGrantsPermission(tuple.Relation, check.Permission) {
-		relation := tuple.Relation
-		return CheckResult{
-			Allowed:  true,
-			Relation: &relation,
-			Reason:   "direct permission",
+		// Check if the relation grants the required permission
+		if RelationGrantsPermission(tuple.Relation, check.Permission) {
+			relation := tuple.Relation
+			return CheckResult{
+				Allowed:  true,
+				Relation: &relation,
+				Reason:   "direct permission",
+			}
 		}
+		result.Reason = "relation does not grant permission"
 	}
 
-	return CheckResult{Allowed: false, Reason: "relation does not grant permission"}
+	return result
 }
 
 // checkHierarchy checks parent category permissions
-func (e *Engine) checkHierarchy(ctx context.Context, check CheckContext, roleIDs []string) CheckResult {
+func (e *Engine) checkHierarchy(ctx context.Context, check CheckContext, roleIDs, groupIDs []string) CheckResult {
 	var parentCategoryID *string
 
 	// If resource is a document, get its category
@@ -189,6 +295,7 @@ func (e *Engine) checkHierarchy(ctx context.Context, check CheckContext, roleIDs
 			ResourceType: ResourceTypeCategory,
 			ResourceID:   categoryID,
 			Permission:   check.Permission,
+			Attributes:   check.Attributes,
 		}
 
 		// Check user permission on category
@@ -205,6 +312,14 @@ func (e *Engine) checkHierarchy(ctx context.Context, check CheckContext, roleIDs
 			}
 		}
 
+		// Check group permissions on category
+		for _, groupID := range groupIDs {
+			if result := e.checkDirectPermission(ctx, categoryCheck, SubjectTypeGroup, groupID); result.Allowed {
+				result.Reason = "inherited from parent category via group"
+				return result
+			}
+		}
+
 		// Check tenant permission on category
 		if result := e.checkDirectPermission(ctx, categoryCheck, SubjectTypeTenant, "all"); result.Allowed {
 			result.Reason = "inherited from parent category via tenant"
@@ -238,44 +353,51 @@ func (e *Engine) ListPermissions(ctx context.Context, tenantID uint32, resourceT
 	return e.store.GetDirectPermissions(ctx, tenantID, resourceType, resourceID)
 }
 
-// ListAccessibleResources lists all resources of a type accessible by a user
+// ListAccessibleResources lists all resources of a type on which a user holds the given permission.
+// Without an access index only direct grants are considered. Only explicit grants are listed;
+// the platform-admin bypass is not expanded here.
 func (e *Engine) ListAccessibleResources(ctx context.Context, tenantID uint32, userID string, resourceType ResourceType, permission Permission) ([]string, error) {
 	accessibleIDs := make(map[string]bool)
+	attrs := e.lookup.GetRequestAttributes(ctx)
+	subjects := e.subjectsOf(ctx, tenantID, userID)
 
-	// Get user's direct permissions
-	userResources, err := e.store.ListResourcesBySubject(ctx, tenantID, SubjectTypeUser, userID, resourceType)
-	if err != nil {
-		return nil, err
-	}
-	for _, id := range userResources {
-		accessibleIDs[id] = true
-	}
-
-	// Get user's role permissions
-	roleIDs, err := e.lookup.GetUserRoleIDs(ctx, tenantID, userID)
-	if err != nil {
-		e.log.Warnf("Failed to get user roles: %v", err)
+	if e.index != nil {
+		// A single lookup covers the user, their roles and groups and the tenant
+		grants, err := e.index.ListIndexedGrants(ctx, tenantID, subjects, resourceType)
+		if err != nil {
+			return nil, err
+		}
+		for _, grant := range grants {
+			if !RelationGrantsPermission(grant.Relation, permission) {
+				continue
+			}
+			if !e.tupleActive(PermissionTuple{ExpiresAt: grant.ExpiresAt, Conditions: grant.Conditions}, attrs) {
+				continue
+			}
+			accessibleIDs[grant.ResourceID] = true
+		}
 	} else {
-		for _, roleID := range roleIDs {
-			roleResources, err := e.store.ListResourcesBySubject(ctx, tenantID, SubjectTypeRole, roleID, resourceType)
+		for _, subject := range subjects {
+			tuples, err := e.store.GetSubjectPermissions(ctx, tenantID, subject.Type, subject.ID)
 			if err != nil {
-				e.log.Warnf("failed to list resources for role %s: %v", roleID, err)
+				if subject.Type == SubjectTypeUser {
+					return nil, err
+				}
+				e.log.Warnf("failed to list resources for %s %s: %v", subject.Type, subject.ID, err)
 				continue
 			}
-			for _, id := range roleResources {
-				accessibleIDs[id] = true
+			for _, tuple := range tuples {
+				if tuple.ResourceType != resourceType || !RelationGrantsPermission(tuple.Relation, permission) {
+					continue
+				}
+				if !e.tupleActive(tuple, attrs) {
+					continue
+				}
+				accessibleIDs[tuple.ResourceID] = true
 			}
 		}
 	}
 
-	// Get tenant-level permissions
-	tenantResources, err := e.store.ListResourcesBySubject(ctx, tenantID, SubjectTypeTenant, "all", resourceType)
-	if err == nil {
-		for _, id := range tenantResources {
-			accessibleIDs[id] = true
-		}
-	}
-
 	// Convert map to slice
 	result := make([]string, 0, len(accessibleIDs))
 	for id := range accessibleIDs {
@@ -285,6 +407,39 @@ func (e *Engine) ListAccessibleResources(ctx context.Context, tenantID uint32, u
 	return result, nil
 }
 
+// subjectsOf returns the user and the roles, groups and tenant whose grants the user holds.
+// Roles or groups that can't be resolved are left out.
+func (e *Engine) subjectsOf(ctx context.Context, tenantID uint32, userID string) []Subject {
+	subjects := []Subject{{Type: SubjectTypeUser, ID: userID}}
+
+	roleIDs, err := e.lookup.GetUserRoleIDs(ctx, tenantID, userID)
+	if err != nil {
+		e.log.Warnf("Failed to get user roles: %v", err)
+	}
+	for _, roleID := range roleIDs {
+		subjects = append(subjects, Subject{Type: SubjectTypeRole, ID: roleID})
+	}
+
+	groupIDs, err := e.lookup.GetUserGroupIDs(ctx, tenantID, userID)
+	if err != nil {
+		e.log.Warnf("Failed to get user groups: %v", err)
+	}
+	for _, groupID := range groupIDs {
+		subjects = append(subjects, Subject{Type: SubjectTypeGroup, ID: groupID})
+	}
+
+	return append(subjects, Subject{Type: SubjectTypeTenant, ID: "all"})
+}
+
+// tupleActive reports whether a tuple has not expired and its conditions hold
+func (e *Engine) tupleActive(tuple PermissionTuple, attrs RequestAttributes) bool {
+	if tuple.ExpiresAt != nil && tuple.ExpiresAt.Before(time.Now()) {
+		return false
+	}
+	ok, _ := tuple.Conditions.Evaluate(attrs)
+	return ok
+}
+
 // GetEffectivePermissions returns all permissions a user has on a resource
 func (e *Engine) GetEffectivePermissions(ctx context.Context, check CheckContext) ([]Permission, Relation) {
 	var highestRelation Relation
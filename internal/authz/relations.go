@@ -50,8 +50,16 @@ const (
 	SubjectTypeRole SubjectType = "SUBJECT_TYPE_ROLE"
 	// SubjectTypeTenant represents a tenant-wide subject
 	SubjectTypeTenant SubjectType = "SUBJECT_TYPE_TENANT"
+	// SubjectTypeGroup represents a directory group subject
+	SubjectTypeGroup SubjectType = "SUBJECT_TYPE_GROUP"
 )
 
+// Subject is an entity permissions are granted to
+type Subject struct {
+	Type SubjectType
+	ID   string
+}
+
 // relationPermissions defines which permissions each relation grants
 var relationPermissions = map[Relation][]Permission{
 	RelationOwner:  {PermissionRead, PermissionWrite, PermissionDelete, PermissionShare, PermissionDownload},
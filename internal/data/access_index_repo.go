@@ -0,0 +1,446 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/lib/pq"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/accessibleresource"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/category"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/document"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documentpermission"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+const (
+	resourceTypeCategory = "RESOURCE_TYPE_CATEGORY"
+	resourceTypeDocument = "RESOURCE_TYPE_DOCUMENT"
+
+	// accessIndexBatchSize limits the number of rows per bulk insert
+	accessIndexBatchSize = 500
+)
+
+// resourceRef identifies a category or document
+type resourceRef struct {
+	Type string
+	ID   string
+}
+
+// AccessIndexRepo maintains the materialized (subject -> accessible resource) index.
+// Every permission tuple is expanded onto the resource it is attached to and, for
+// category tuples, onto all descendant categories and the documents they contain.
+type AccessIndexRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+func NewAccessIndexRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *AccessIndexRepo {
+	return &AccessIndexRepo{
+		log:       ctx.NewLoggerHelper("paperless/access-index/repo"),
+		entClient: entClient,
+	}
+}
+
+// IndexPermission adds index entries for a newly granted permission tuple
+func (r *AccessIndexRepo) IndexPermission(ctx context.Context, p *ent.DocumentPermission) error {
+	tenantID := derefUint32(p.TenantID)
+
+	targets := []resourceRef{{Type: string(p.ResourceType), ID: p.ResourceID}}
+	if string(p.ResourceType) == resourceTypeCategory {
+		subtree, err := r.expandCategory(ctx, tenantID, p.ResourceID)
+		if err != nil {
+			return err
+		}
+		targets = subtree
+	}
+
+	return r.insertEntries(ctx, p, targets)
+}
+
+// RemovePermissions removes index entries derived from the given permission tuples
+func (r *AccessIndexRepo) RemovePermissions(ctx context.Context, permissionIDs []int) error {
+	if len(permissionIDs) == 0 {
+		return nil
+	}
+	_, err := clientFromContext(ctx, r.entClient).AccessibleResource.Delete().
+		Where(accessibleresource.PermissionIDIn(permissionIDs...)).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("remove access index entries failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("update access index failed")
+	}
+	return nil
+}
+
+// RemoveResource removes all index entries on a resource and all entries derived from tuples on it
+func (r *AccessIndexRepo) RemoveResource(ctx context.Context, tenantID uint32, resourceType, resourceID string) error {
+	_, err := clientFromContext(ctx, r.entClient).AccessibleResource.Delete().
+		Where(
+			accessibleresource.TenantIDEQ(tenantID),
+			accessibleresource.Or(
+				accessibleresource.And(
+					accessibleresource.ResourceTypeEQ(accessibleresource.ResourceType(resourceType)),
+					accessibleresource.ResourceIDEQ(resourceID),
+				),
+				accessibleresource.SourceIDEQ(resourceID),
+			),
+		).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("remove access index entries for resource failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("update access index failed")
+	}
+	return nil
+}
+
+// ReindexDocument recomputes the inherited entries of a document after it was created or moved
+func (r *AccessIndexRepo) ReindexDocument(ctx context.Context, tenantID uint32, documentID string, categoryID *string) error {
+	client := clientFromContext(ctx, r.entClient)
+
+	_, err := client.AccessibleResource.Delete().
+		Where(
+			accessibleresource.TenantIDEQ(tenantID),
+			accessibleresource.ResourceTypeEQ(accessibleresource.ResourceTypeRESOURCE_TYPE_DOCUMENT),
+			accessibleresource.ResourceIDEQ(documentID),
+			accessibleresource.Inherited(true),
+		).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("clear inherited access index entries failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("update access index failed")
+	}
+
+	if categoryID == nil || *categoryID == "" {
+		return nil
+	}
+
+	ancestors, err := r.ancestorIDs(ctx, *categoryID)
+	if err != nil {
+		return err
+	}
+
+	return r.indexFromCategories(ctx, tenantID, ancestors, []resourceRef{{Type: resourceTypeDocument, ID: documentID}})
+}
+
+// ReindexCategorySubtree recomputes the entries a category subtree inherits from its ancestors
+// after the category was created or moved. Entries from tuples inside the subtree are unaffected.
+func (r *AccessIndexRepo) ReindexCategorySubtree(ctx context.Context, tenantID uint32, categoryID string) error {
+	client := clientFromContext(ctx, r.entClient)
+
+	root, err := client.Category.Get(ctx, categoryID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		r.log.Errorf("get category failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("update access index failed")
+	}
+
+	targets, err := r.expandCategory(ctx, tenantID, categoryID)
+	if err != nil {
+		return err
+	}
+
+	subtree := make(map[string]struct{}, len(targets))
+	targetIDs := make([]string, 0, len(targets))
+	for _, t := range targets {
+		if t.Type == resourceTypeCategory {
+			subtree[t.ID] = struct{}{}
+		}
+		targetIDs = append(targetIDs, t.ID)
+	}
+
+	// Drop entries inherited from outside the subtree (i.e. from former ancestors). The subtree
+	// is matched in memory, since a NOT IN of a large subtree would exceed the bind parameter limit.
+	for _, chunk := range chunkStrings(targetIDs, accessIndexBatchSize) {
+		inherited, err := client.AccessibleResource.Query().
+			Where(
+				accessibleresource.TenantIDEQ(tenantID),
+				accessibleresource.ResourceIDIn(chunk...),
+				accessibleresource.Inherited(true),
+			).
+			Select(accessibleresource.FieldID, accessibleresource.FieldSourceID).
+			All(ctx)
+		if err != nil {
+			r.log.Errorf("list inherited access index entries failed: %s", err.Error())
+			return paperlessV1.ErrorInternalServerError("update access index failed")
+		}
+
+		var staleIDs []uint32
+		for _, e := range inherited {
+			if _, ok := subtree[e.SourceID]; !ok {
+				staleIDs = append(staleIDs, e.ID)
+			}
+		}
+		for start := 0; start < len(staleIDs); start += accessIndexBatchSize {
+			end := min(start+accessIndexBatchSize, len(staleIDs))
+			_, err = client.AccessibleResource.Delete().
+				Where(accessibleresource.IDIn(staleIDs[start:end]...)).
+				Exec(ctx)
+			if err != nil {
+				r.log.Errorf("clear inherited access index entries failed: %s", err.Error())
+				return paperlessV1.ErrorInternalServerError("update access index failed")
+			}
+		}
+	}
+
+	if root.ParentID == nil || *root.ParentID == "" {
+		return nil
+	}
+
+	ancestors, err := r.ancestorIDs(ctx, *root.ParentID)
+	if err != nil {
+		return err
+	}
+
+	return r.indexFromCategories(ctx, tenantID, ancestors, targets)
+}
+
+// Rebuild recreates the index from the permission tuples of a tenant (or of all tenants when tenantID is nil)
+func (r *AccessIndexRepo) Rebuild(ctx context.Context, tenantID *uint32) error {
+	client := clientFromContext(ctx, r.entClient)
+
+	del := client.AccessibleResource.Delete()
+	query := client.DocumentPermission.Query()
+	if tenantID != nil {
+		del = del.Where(accessibleresource.TenantIDEQ(*tenantID))
+		query = query.Where(documentpermission.TenantIDEQ(*tenantID))
+	}
+
+	if _, err := del.Exec(ctx); err != nil {
+		r.log.Errorf("clear access index failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("rebuild access index failed")
+	}
+
+	permissions, err := query.All(ctx)
+	if err != nil {
+		r.log.Errorf("list permissions for access index failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("rebuild access index failed")
+	}
+
+	for _, p := range permissions {
+		if err := r.IndexPermission(ctx, p); err != nil {
+			return err
+		}
+	}
+
+	r.log.Infof("rebuilt access index from %d permission tuples", len(permissions))
+	return nil
+}
+
+// ListBySubjects returns the unexpired index entries of a resource type for any of the
+// subjects, given as subject IDs by subject type, in a single query
+func (r *AccessIndexRepo) ListBySubjects(ctx context.Context, tenantID uint32, subjects map[string][]string, resourceType string) ([]*ent.AccessibleResource, error) {
+	bySubject := make([]predicate.AccessibleResource, 0, len(subjects))
+	for subjectType, subjectIDs := range subjects {
+		if len(subjectIDs) == 0 {
+			continue
+		}
+		bySubject = append(bySubject, accessibleresource.And(
+			accessibleresource.SubjectTypeEQ(accessibleresource.SubjectType(subjectType)),
+			accessibleresource.SubjectIDIn(subjectIDs...),
+		))
+	}
+	if len(bySubject) == 0 {
+		return nil, nil
+	}
+
+	entities, err := clientFromContext(ctx, r.entClient).AccessibleResource.Query().
+		Where(
+			accessibleresource.TenantIDEQ(tenantID),
+			accessibleresource.Or(bySubject...),
+			accessibleresource.ResourceTypeEQ(accessibleresource.ResourceType(resourceType)),
+			accessibleresource.Or(
+				accessibleresource.ExpiresAtIsNil(),
+				accessibleresource.ExpiresAtGT(time.Now()),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list access index entries failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("list accessible resources failed")
+	}
+	return entities, nil
+}
+
+// expandCategory returns a category, its descendant categories and all documents in them
+func (r *AccessIndexRepo) expandCategory(ctx context.Context, tenantID uint32, categoryID string) ([]resourceRef, error) {
+	client := clientFromContext(ctx, r.entClient)
+
+	root, err := client.Category.Get(ctx, categoryID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return []resourceRef{{Type: resourceTypeCategory, ID: categoryID}}, nil
+		}
+		r.log.Errorf("get category failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("update access index failed")
+	}
+
+	descendants, err := client.Category.Query().
+		Where(
+			category.TenantIDEQ(tenantID),
+			category.PathHasPrefix(root.Path+"/"),
+		).
+		IDs(ctx)
+	if err != nil {
+		r.log.Errorf("get descendant categories failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("update access index failed")
+	}
+
+	categoryIDs := append([]string{categoryID}, descendants...)
+	refs := make([]resourceRef, 0, len(categoryIDs))
+	for _, id := range categoryIDs {
+		refs = append(refs, resourceRef{Type: resourceTypeCategory, ID: id})
+	}
+
+	for _, chunk := range chunkStrings(categoryIDs, accessIndexBatchSize) {
+		documentIDs, err := client.Document.Query().
+			Where(
+				document.TenantIDEQ(tenantID),
+				document.CategoryIDIn(chunk...),
+			).
+			IDs(WithDeleted(ctx))
+		if err != nil {
+			r.log.Errorf("get documents in categories failed: %s", err.Error())
+			return nil, paperlessV1.ErrorInternalServerError("update access index failed")
+		}
+		for _, id := range documentIDs {
+			refs = append(refs, resourceRef{Type: resourceTypeDocument, ID: id})
+		}
+	}
+
+	return refs, nil
+}
+
+// ancestorIDs returns a category and all of its ancestors, nearest first
+func (r *AccessIndexRepo) ancestorIDs(ctx context.Context, categoryID string) ([]string, error) {
+	client := clientFromContext(ctx, r.entClient)
+
+	var ids []string
+	visited := make(map[string]bool)
+	current := &categoryID
+	for current != nil && *current != "" && !visited[*current] {
+		visited[*current] = true
+		c, err := client.Category.Get(ctx, *current)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				break
+			}
+			r.log.Errorf("get category failed: %s", err.Error())
+			return nil, paperlessV1.ErrorInternalServerError("update access index failed")
+		}
+		ids = append(ids, c.ID)
+		current = c.ParentID
+	}
+	return ids, nil
+}
+
+// indexFromCategories adds entries on targets for every tuple attached to the given categories
+func (r *AccessIndexRepo) indexFromCategories(ctx context.Context, tenantID uint32, categoryIDs []string, targets []resourceRef) error {
+	if len(categoryIDs) == 0 || len(targets) == 0 {
+		return nil
+	}
+
+	permissions, err := clientFromContext(ctx, r.entClient).DocumentPermission.Query().
+		Where(
+			documentpermission.TenantIDEQ(tenantID),
+			documentpermission.ResourceTypeEQ(documentpermission.ResourceTypeRESOURCE_TYPE_CATEGORY),
+			documentpermission.ResourceIDIn(categoryIDs...),
+		).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list category permissions failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("update access index failed")
+	}
+
+	for _, p := range permissions {
+		if err := r.insertEntries(ctx, p, targets); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// insertEntries writes index entries for a tuple on each target, ignoring existing ones
+func (r *AccessIndexRepo) insertEntries(ctx context.Context, p *ent.DocumentPermission, targets []resourceRef) error {
+	client := clientFromContext(ctx, r.entClient)
+	now := time.Now()
+
+	for start := 0; start < len(targets); start += accessIndexBatchSize {
+		end := min(start+accessIndexBatchSize, len(targets))
+
+		builders := make([]*ent.AccessibleResourceCreate, 0, end-start)
+		for _, t := range targets[start:end] {
+			builder := client.AccessibleResource.Create().
+				SetNillableTenantID(p.TenantID).
+				SetPermissionID(p.ID).
+				SetSubjectType(accessibleresource.SubjectType(p.SubjectType)).
+				SetSubjectID(p.SubjectID).
+				SetResourceType(accessibleresource.ResourceType(t.Type)).
+				SetResourceID(t.ID).
+				SetRelation(accessibleresource.Relation(p.Relation)).
+				SetSourceID(p.ResourceID).
+				SetInherited(t.ID != p.ResourceID).
+				SetNillableExpiresAt(p.ExpiresAt).
+				SetCreateTime(now)
+			if !p.Conditions.IsEmpty() {
+				builder.SetConditions(p.Conditions)
+			}
+			builders = append(builders, builder)
+		}
+
+		err := client.AccessibleResource.CreateBulk(builders...).
+			OnConflictColumns(
+				accessibleresource.FieldPermissionID,
+				accessibleresource.FieldResourceType,
+				accessibleresource.FieldResourceID,
+			).
+			DoNothing().
+			Exec(ctx)
+		if err != nil {
+			r.log.Errorf("insert access index entries failed: %s", err.Error())
+			return paperlessV1.ErrorInternalServerError("update access index failed")
+		}
+	}
+
+	return nil
+}
+
+// chunkStrings splits a slice into chunks of at most size elements
+func chunkStrings(items []string, size int) [][]string {
+	var chunks [][]string
+	for start := 0; start < len(items); start += size {
+		chunks = append(chunks, items[start:min(start+size, len(items))])
+	}
+	return chunks
+}
+
+// idIn returns a predicate matching rows whose column is one of ids. On PostgreSQL the IDs
+// are bound as a single array parameter, so the accessible set of a user can be arbitrarily
+// large without running into the bind parameter limit.
+func idIn(column string, ids []string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		if s.Dialect() == dialect.Postgres {
+			s.Where(sql.P(func(b *sql.Builder) {
+				b.WriteString(s.C(column)).WriteString(" = ANY(").Arg(pq.StringArray(ids)).WriteString(")")
+			}))
+			return
+		}
+
+		values := make([]any, len(ids))
+		for i, id := range ids {
+			values[i] = id
+		}
+		s.Where(sql.In(s.C(column), values...))
+	}
+}
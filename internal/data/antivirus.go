@@ -0,0 +1,46 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+)
+
+// ScanVerdict is the outcome of a malware scan
+type ScanVerdict struct {
+	Infected bool
+	// Threat names the malware found, if the scanner reported it
+	Threat string
+}
+
+// AntivirusScanner scans uploaded files for malware before they are processed
+type AntivirusScanner interface {
+	// Name identifies the scanner in logs and metrics
+	Name() string
+	// Scan checks a file streamed from content; an error means the file could not be scanned,
+	// not that it is infected
+	Scan(ctx context.Context, fileName, mimeType string, content io.Reader) (*ScanVerdict, error)
+}
+
+// NewAntivirusScanner creates the scanner selected by PAPERLESS_AV_BACKEND, or returns nil
+// when uploads are not scanned
+func NewAntivirusScanner(ctx *bootstrap.Context) (AntivirusScanner, error) {
+	l := ctx.NewLoggerHelper("paperless/antivirus")
+
+	switch backend := os.Getenv("PAPERLESS_AV_BACKEND"); backend {
+	case "":
+		return nil, nil
+	case "icap":
+		s, err := newICAPScanner()
+		if err != nil {
+			return nil, err
+		}
+		l.Infof("scanning uploads through ICAP service %s", s.serviceURL)
+		return s, nil
+	default:
+		return nil, fmt.Errorf("unknown PAPERLESS_AV_BACKEND %q (use icap)", backend)
+	}
+}
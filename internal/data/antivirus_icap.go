@@ -0,0 +1,218 @@
+package data
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"net/textproto"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/metrics"
+)
+
+const (
+	defaultICAPPort    = "1344"
+	defaultICAPSPort   = "11344"
+	defaultICAPTimeout = 2 * time.Minute
+
+	// icapChunkSize is the size of the chunks a file is sent to the gateway in
+	icapChunkSize = 64 * 1024
+)
+
+// icapScanner sends files to an ICAP (RFC 3507) antivirus gateway, such as Symantec
+// Protection Engine or Trend Micro InterScan, as RESPMOD requests. The gateway answers
+// 204 for a clean file and 200 with a replacement response for a blocked one.
+type icapScanner struct {
+	serviceURL string
+	address    string
+	host       string
+	tlsConfig  *tls.Config
+	timeout    time.Duration
+}
+
+func newICAPScanner() (*icapScanner, error) {
+	raw := os.Getenv("PAPERLESS_AV_ICAP_URL")
+	if raw == "" {
+		return nil, fmt.Errorf("PAPERLESS_AV_ICAP_URL is required for ICAP scanning")
+	}
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("invalid PAPERLESS_AV_ICAP_URL %q", raw)
+	}
+
+	s := &icapScanner{
+		host:    u.Hostname(),
+		timeout: defaultICAPTimeout,
+	}
+
+	port := u.Port()
+	switch u.Scheme {
+	case "icap":
+		if port == "" {
+			port = defaultICAPPort
+		}
+	case "icaps":
+		if port == "" {
+			port = defaultICAPSPort
+		}
+		s.tlsConfig = &tls.Config{ServerName: s.host, MinVersion: tls.VersionTLS12}
+	default:
+		return nil, fmt.Errorf("PAPERLESS_AV_ICAP_URL must use icap:// or icaps://, got %q", u.Scheme)
+	}
+	s.address = net.JoinHostPort(s.host, port)
+	// The request line always names the plain icap scheme
+	s.serviceURL = "icap://" + s.address + u.EscapedPath()
+
+	if v := os.Getenv("PAPERLESS_AV_ICAP_TIMEOUT"); v != "" {
+		timeout, err := time.ParseDuration(v)
+		if err != nil || timeout <= 0 {
+			return nil, fmt.Errorf("invalid PAPERLESS_AV_ICAP_TIMEOUT %q", v)
+		}
+		s.timeout = timeout
+	}
+
+	return s, nil
+}
+
+func (s *icapScanner) Name() string {
+	return "icap"
+}
+
+func (s *icapScanner) Scan(ctx context.Context, fileName, mimeType string, content io.Reader) (*ScanVerdict, error) {
+	start := time.Now()
+	verdict, err := s.scan(ctx, fileName, mimeType, content)
+	metrics.ObserveExternalRequest("icap", "respmod", start, err)
+	return verdict, err
+}
+
+func (s *icapScanner) scan(ctx context.Context, fileName, mimeType string, content io.Reader) (*ScanVerdict, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	var dialer interface {
+		DialContext(ctx context.Context, network, address string) (net.Conn, error)
+	} = &net.Dialer{}
+	if s.tlsConfig != nil {
+		dialer = &tls.Dialer{Config: s.tlsConfig}
+	}
+	conn, err := dialer.DialContext(ctx, "tcp", s.address)
+	if err != nil {
+		return nil, fmt.Errorf("connect to ICAP server: %w", err)
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	if err := s.writeRespmod(conn, fileName, mimeType, content); err != nil {
+		return nil, err
+	}
+
+	reader := textproto.NewReader(bufio.NewReader(conn))
+	statusLine, err := reader.ReadLine()
+	if err != nil {
+		return nil, fmt.Errorf("read ICAP response: %w", err)
+	}
+	code, err := icapStatusCode(statusLine)
+	if err != nil {
+		return nil, err
+	}
+	header, err := reader.ReadMIMEHeader()
+	if err != nil {
+		return nil, fmt.Errorf("read ICAP response headers: %w", err)
+	}
+
+	switch code {
+	case 204:
+		return &ScanVerdict{}, nil
+	case 200:
+		// The gateway replaced the file, e.g. with a block page
+		return &ScanVerdict{Infected: true, Threat: icapThreat(header)}, nil
+	default:
+		return nil, fmt.Errorf("ICAP server returned %q", statusLine)
+	}
+}
+
+// writeRespmod sends the file as the body of an HTTP response to a GET of its name. The body
+// is streamed in chunks, so the file is never held in memory as a whole; without a length
+// in the encapsulated headers, the chunks delimit it.
+func (s *icapScanner) writeRespmod(conn io.Writer, fileName, mimeType string, content io.Reader) error {
+	if mimeType == "" {
+		mimeType = "application/octet-stream"
+	}
+	reqHdr := "GET /" + url.PathEscape(path.Base(fileName)) + " HTTP/1.1\r\n" +
+		"Host: paperless\r\n\r\n"
+	resHdr := "HTTP/1.1 200 OK\r\n" +
+		"Content-Type: " + mimeType + "\r\n" +
+		"Transfer-Encoding: chunked\r\n\r\n"
+
+	w := bufio.NewWriterSize(conn, icapChunkSize+32)
+	fmt.Fprintf(w, "RESPMOD %s ICAP/1.0\r\n", s.serviceURL)
+	fmt.Fprintf(w, "Host: %s\r\n", s.host)
+	w.WriteString("Allow: 204\r\n")
+	fmt.Fprintf(w, "Encapsulated: req-hdr=0, res-hdr=%d, res-body=%d\r\n\r\n", len(reqHdr), len(reqHdr)+len(resHdr))
+	w.WriteString(reqHdr)
+	w.WriteString(resHdr)
+
+	buf := make([]byte, icapChunkSize)
+	for {
+		n, err := io.ReadFull(content, buf)
+		if n > 0 {
+			fmt.Fprintf(w, "%x\r\n", n)
+			if _, err := w.Write(buf[:n]); err != nil {
+				return fmt.Errorf("send ICAP request: %w", err)
+			}
+			w.WriteString("\r\n")
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("read file for ICAP scan: %w", err)
+		}
+	}
+	w.WriteString("0\r\n\r\n")
+
+	// bufio.Writer keeps the first write error, so this reports the failures of all writes
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("send ICAP request: %w", err)
+	}
+	return nil
+}
+
+// icapStatusCode parses an "ICAP/1.0 204 No Content" status line
+func icapStatusCode(line string) (int, error) {
+	parts := strings.SplitN(line, " ", 3)
+	if len(parts) < 2 || !strings.HasPrefix(parts[0], "ICAP/") {
+		return 0, fmt.Errorf("malformed ICAP status line %q", line)
+	}
+	code, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, fmt.Errorf("malformed ICAP status line %q", line)
+	}
+	return code, nil
+}
+
+// icapThreat extracts the threat name from the vendor headers gateways report it in
+func icapThreat(header textproto.MIMEHeader) string {
+	// X-Infection-Found: Type=0; Resolution=2; Threat=EICAR Test String;
+	if v := header.Get("X-Infection-Found"); v != "" {
+		for _, part := range strings.Split(v, ";") {
+			if threat, ok := strings.CutPrefix(strings.TrimSpace(part), "Threat="); ok {
+				return threat
+			}
+		}
+	}
+	if v := header.Get("X-Virus-ID"); v != "" {
+		return v
+	}
+	return "blocked by ICAP server"
+}
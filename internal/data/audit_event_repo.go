@@ -0,0 +1,145 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/auditevent"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// AuditEventRepo stores who created, changed, moved, deleted, downloaded or shared a document or category
+type AuditEventRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewAuditEventRepo creates a new AuditEventRepo
+func NewAuditEventRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *AuditEventRepo {
+	return &AuditEventRepo{
+		log:       ctx.NewLoggerHelper("paperless/audit_event_repo"),
+		entClient: entClient,
+	}
+}
+
+// AuditEventInput describes an action to record
+type AuditEventInput struct {
+	TenantID     uint32
+	UserID       string
+	Action       auditevent.Action
+	ResourceType auditevent.ResourceType
+	ResourceID   string
+	ResourceName string
+	Details      map[string]string
+}
+
+// Create records an audit event
+func (r *AuditEventRepo) Create(ctx context.Context, in *AuditEventInput) error {
+	builder := r.entClient.Client().AuditEvent.Create().
+		SetTenantID(in.TenantID).
+		SetAction(in.Action).
+		SetResourceType(in.ResourceType).
+		SetResourceID(in.ResourceID).
+		SetCreateTime(time.Now())
+
+	if in.UserID != "" {
+		builder.SetUserID(in.UserID)
+	}
+	if in.ResourceName != "" {
+		builder.SetResourceName(in.ResourceName)
+	}
+	if len(in.Details) > 0 {
+		builder.SetDetails(in.Details)
+	}
+
+	if err := builder.Exec(ctx); err != nil {
+		r.log.Errorf("create audit event failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("create audit event failed")
+	}
+	return nil
+}
+
+// AuditEventListOptions contains options for listing audit events
+type AuditEventListOptions struct {
+	TenantID     *uint32
+	UserID       *string
+	Action       *auditevent.Action
+	ResourceType *auditevent.ResourceType
+	ResourceID   *string
+	StartTime    *time.Time
+	EndTime      *time.Time
+	Limit        int
+	Offset       int
+}
+
+// List retrieves audit events, newest first, with filtering options
+func (r *AuditEventRepo) List(ctx context.Context, opts *AuditEventListOptions) ([]*ent.AuditEvent, int, error) {
+	query := r.entClient.Client().AuditEvent.Query()
+
+	if opts != nil {
+		if opts.TenantID != nil {
+			query = query.Where(auditevent.TenantIDEQ(*opts.TenantID))
+		}
+		if opts.UserID != nil {
+			query = query.Where(auditevent.UserIDEQ(*opts.UserID))
+		}
+		if opts.Action != nil {
+			query = query.Where(auditevent.ActionEQ(*opts.Action))
+		}
+		if opts.ResourceType != nil {
+			query = query.Where(auditevent.ResourceTypeEQ(*opts.ResourceType))
+		}
+		if opts.ResourceID != nil {
+			query = query.Where(auditevent.ResourceIDEQ(*opts.ResourceID))
+		}
+		if opts.StartTime != nil {
+			query = query.Where(auditevent.CreateTimeGTE(*opts.StartTime))
+		}
+		if opts.EndTime != nil {
+			query = query.Where(auditevent.CreateTimeLT(*opts.EndTime))
+		}
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count audit events failed: %s", err.Error())
+		return nil, 0, paperlessV1.ErrorInternalServerError("count audit events failed")
+	}
+
+	query = query.Order(ent.Desc(auditevent.FieldCreateTime), ent.Desc(auditevent.FieldID))
+	if opts != nil {
+		if opts.Limit > 0 {
+			query = query.Limit(opts.Limit)
+		}
+		if opts.Offset > 0 {
+			query = query.Offset(opts.Offset)
+		}
+	}
+
+	entities, err := query.All(ctx)
+	if err != nil {
+		r.log.Errorf("list audit events failed: %s", err.Error())
+		return nil, 0, paperlessV1.ErrorInternalServerError("list audit events failed")
+	}
+
+	return entities, total, nil
+}
+
+// DeleteOlderThan deletes audit events older than the specified time
+func (r *AuditEventRepo) DeleteOlderThan(ctx context.Context, before time.Time) (int, error) {
+	deleted, err := r.entClient.Client().AuditEvent.Delete().
+		Where(auditevent.CreateTimeLT(before)).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("delete old audit events failed: %s", err.Error())
+		return 0, paperlessV1.ErrorInternalServerError("delete old audit events failed")
+	}
+	return deleted, nil
+}
@@ -0,0 +1,374 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/category"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/document"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/hook"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// CategoryCountRepair is the result of recounting the documents of a tenant's categories
+type CategoryCountRepair struct {
+	Checked int
+	Fixed   int
+}
+
+// categoryUsage is how many documents a category counts and their total file size
+type categoryUsage struct {
+	Count int64
+	Bytes int64
+}
+
+// subtreeUsage returns the subtree counters of a category
+func subtreeUsage(c *ent.Category) categoryUsage {
+	return categoryUsage{Count: c.SubtreeDocumentCount, Bytes: c.SubtreeDocumentBytes}
+}
+
+// syncCategoryCounts keeps the document counters of categories current. Every document write
+// that can change which category counts a document or its size (create, delete, a new category,
+// status or file) compares the counted documents per category before and after the write and
+// applies the difference to the categories and their ancestors. Soft-deleted documents are not
+// counted. The write is also recorded as activity of the categories involved.
+func syncCategoryCounts() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.DocumentFunc(func(ctx context.Context, m *ent.DocumentMutation) (ent.Value, error) {
+			if !affectsCategoryCounts(m) {
+				return next.Mutate(ctx, m)
+			}
+
+			client := m.Client()
+			var (
+				ids    []string
+				before map[string]categoryUsage
+			)
+			if !m.Op().Is(ent.OpCreate) {
+				var err error
+				if ids, err = m.IDs(WithDeleted(ctx)); err != nil {
+					return nil, err
+				}
+				if before, err = countedDocumentsByCategory(ctx, client, ids); err != nil {
+					return nil, fmt.Errorf("count documents by category: %w", err)
+				}
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+
+			if doc, ok := v.(*ent.Document); ok && m.Op().Is(ent.OpCreate) {
+				ids = []string{doc.ID}
+			}
+			after := map[string]categoryUsage{}
+			if !m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+				if after, err = countedDocumentsByCategory(ctx, client, ids); err != nil {
+					return nil, fmt.Errorf("count documents by category: %w", err)
+				}
+			}
+
+			deltas := make(map[string]categoryUsage, len(before)+len(after))
+			for id, u := range after {
+				d := deltas[id]
+				d.Count += u.Count
+				d.Bytes += u.Bytes
+				deltas[id] = d
+			}
+			for id, u := range before {
+				d := deltas[id]
+				d.Count -= u.Count
+				d.Bytes -= u.Bytes
+				deltas[id] = d
+			}
+			if err := applyCategoryCountDeltas(ctx, client, deltas); err != nil {
+				return nil, fmt.Errorf("update category document counts: %w", err)
+			}
+			if err := touchCategoryActivity(ctx, client, slices.Collect(maps.Keys(deltas))); err != nil {
+				return nil, fmt.Errorf("update category activity: %w", err)
+			}
+			return v, nil
+		})
+	}, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne|ent.OpDelete|ent.OpDeleteOne)
+}
+
+// affectsCategoryCounts reports whether a document mutation can change a category's counters
+func affectsCategoryCounts(m *ent.DocumentMutation) bool {
+	if !m.Op().Is(ent.OpUpdate | ent.OpUpdateOne) {
+		return true
+	}
+	_, categoryChanged := m.CategoryID()
+	_, statusChanged := m.Status()
+	_, sizeChanged := m.FileSize()
+	_, sizeAdded := m.AddedFileSize()
+	return categoryChanged || statusChanged || sizeChanged || sizeAdded || m.CategoryIDCleared()
+}
+
+// countedDocumentsByCategory returns how many of the given documents each category counts and
+// their total size
+func countedDocumentsByCategory(ctx context.Context, client *ent.Client, ids []string) (map[string]categoryUsage, error) {
+	usage := make(map[string]categoryUsage)
+	if len(ids) == 0 {
+		return usage, nil
+	}
+
+	var rows []struct {
+		CategoryID string `json:"category_id"`
+		Count      int64  `json:"count"`
+		Bytes      int64  `json:"bytes"`
+	}
+	err := client.Document.Query().
+		Where(
+			predicate.Document(idIn(document.FieldID, ids)),
+			document.CategoryIDNotNil(),
+			document.StatusNEQ(document.StatusDOCUMENT_STATUS_DELETED),
+		).
+		GroupBy(document.FieldCategoryID).
+		Aggregate(ent.Count(), ent.As(ent.Sum(document.FieldFileSize), "bytes")).
+		Scan(WithDeleted(ctx), &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		usage[row.CategoryID] = categoryUsage{Count: row.Count, Bytes: row.Bytes}
+	}
+	return usage, nil
+}
+
+// applyCategoryCountDeltas adds each delta to the direct counters of its category and to the
+// subtree counters of the category and its ancestors. Categories are updated in ID order so
+// concurrent writers lock them in the same order.
+func applyCategoryCountDeltas(ctx context.Context, client *ent.Client, deltas map[string]categoryUsage) error {
+	ids := make([]string, 0, len(deltas))
+	for id, delta := range deltas {
+		if delta != (categoryUsage{}) {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+
+	for _, id := range ids {
+		c, err := client.Category.Get(ctx, id)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+
+		if err := client.Category.UpdateOneID(id).
+			AddDocumentCount(deltas[id].Count).
+			AddDocumentBytes(deltas[id].Bytes).
+			Exec(ctx); err != nil {
+			return err
+		}
+		if err := addSubtreeUsage(ctx, client, derefUint32(c.TenantID), categoryPathPrefixes(c.Path), deltas[id]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// touchCategoryActivity sets the activity time of the given categories and their ancestors
+func touchCategoryActivity(ctx context.Context, client *ent.Client, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	categories, err := client.Category.Query().
+		Where(category.IDIn(ids...)).
+		Select(category.FieldTenantID, category.FieldPath).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	paths := make(map[uint32][]string)
+	for _, c := range categories {
+		tenantID := derefUint32(c.TenantID)
+		paths[tenantID] = append(paths[tenantID], categoryPathPrefixes(c.Path)...)
+	}
+	now := time.Now()
+	for tenantID, p := range paths {
+		slices.Sort(p)
+		if _, err := client.Category.Update().
+			Where(
+				category.TenantIDEQ(tenantID),
+				category.PathIn(slices.Compact(p)...),
+			).
+			SetActivityTime(now).
+			Save(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addSubtreeUsage adds delta to the subtree counters of the categories at the given paths
+func addSubtreeUsage(ctx context.Context, client *ent.Client, tenantID uint32, paths []string, delta categoryUsage) error {
+	if delta == (categoryUsage{}) || len(paths) == 0 {
+		return nil
+	}
+	_, err := client.Category.Update().
+		Where(
+			category.TenantIDEQ(tenantID),
+			category.PathIn(paths...),
+		).
+		AddSubtreeDocumentCount(delta.Count).
+		AddSubtreeDocumentBytes(delta.Bytes).
+		Save(ctx)
+	return err
+}
+
+// categoryPathPrefixes returns the paths of a category and its ancestors, e.g. "/a", "/a/b"
+// and "/a/b/c" for "/a/b/c"
+func categoryPathPrefixes(path string) []string {
+	var paths []string
+	for i := 1; i < len(path); i++ {
+		if path[i] == '/' {
+			paths = append(paths, path[:i])
+		}
+	}
+	return append(paths, path)
+}
+
+// RecountDocuments recomputes the document counters of a tenant's categories from the documents
+// and the parent links and writes the rows that differ. The categories are locked first, so
+// document writes that commit during the recount are applied on top of the new values.
+func (r *CategoryRepo) RecountDocuments(ctx context.Context, tenantID uint32) (*CategoryCountRepair, error) {
+	client := clientFromContext(ctx, r.entClient)
+
+	categories, err := client.Category.Query().
+		Where(category.TenantIDEQ(tenantID)).
+		ForUpdate().
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("lock categories failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("recount category documents failed")
+	}
+
+	var rows []struct {
+		CategoryID string `json:"category_id"`
+		Count      int64  `json:"count"`
+		Bytes      int64  `json:"bytes"`
+	}
+	err = client.Document.Query().
+		Where(
+			document.TenantIDEQ(tenantID),
+			document.CategoryIDNotNil(),
+			document.StatusNEQ(document.StatusDOCUMENT_STATUS_DELETED),
+		).
+		GroupBy(document.FieldCategoryID).
+		Aggregate(ent.Count(), ent.As(ent.Sum(document.FieldFileSize), "bytes")).
+		Scan(WithDeleted(ctx), &rows)
+	if err != nil {
+		r.log.Errorf("count documents by category failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("recount category documents failed")
+	}
+	direct := make(map[string]categoryUsage, len(rows))
+	for _, row := range rows {
+		direct[row.CategoryID] = categoryUsage{Count: row.Count, Bytes: row.Bytes}
+	}
+
+	children := make(map[string][]string)
+	for _, c := range categories {
+		if c.ParentID != nil {
+			children[*c.ParentID] = append(children[*c.ParentID], c.ID)
+		}
+	}
+
+	// Subtree counters follow the parent links; visiting guards against cycles
+	subtree := make(map[string]categoryUsage, len(categories))
+	visiting := make(map[string]bool)
+	var sum func(id string) categoryUsage
+	sum = func(id string) categoryUsage {
+		if u, ok := subtree[id]; ok {
+			return u
+		}
+		if visiting[id] {
+			return categoryUsage{}
+		}
+		visiting[id] = true
+		u := direct[id]
+		for _, child := range children[id] {
+			c := sum(child)
+			u.Count += c.Count
+			u.Bytes += c.Bytes
+		}
+		subtree[id] = u
+		return u
+	}
+
+	result := &CategoryCountRepair{Checked: len(categories)}
+	for _, c := range categories {
+		own, sub := direct[c.ID], sum(c.ID)
+		if c.DocumentCount == own.Count && c.DocumentBytes == own.Bytes &&
+			c.SubtreeDocumentCount == sub.Count && c.SubtreeDocumentBytes == sub.Bytes {
+			continue
+		}
+
+		if err := client.Category.UpdateOneID(c.ID).
+			SetDocumentCount(own.Count).
+			SetDocumentBytes(own.Bytes).
+			SetSubtreeDocumentCount(sub.Count).
+			SetSubtreeDocumentBytes(sub.Bytes).
+			Exec(ctx); err != nil {
+			r.log.Errorf("update category document counts failed: %s", err.Error())
+			return nil, paperlessV1.ErrorInternalServerError("recount category documents failed")
+		}
+		result.Fixed++
+	}
+	return result, nil
+}
+
+// CountedTenantIDs returns the IDs of the tenants that have categories
+func (r *CategoryRepo) CountedTenantIDs(ctx context.Context) ([]uint32, error) {
+	ids, err := r.entClient.Client().Category.Query().
+		Where(category.TenantIDNotNil()).
+		Unique(true).
+		Select(category.FieldTenantID).
+		Ints(ctx)
+	if err != nil {
+		r.log.Errorf("list category tenants failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("list category tenants failed")
+	}
+
+	tenantIDs := make([]uint32, 0, len(ids))
+	for _, id := range ids {
+		tenantIDs = append(tenantIDs, uint32(id))
+	}
+	return tenantIDs, nil
+}
+
+// subcategoryCounts returns the number of children of each category of a tenant
+func (r *CategoryRepo) subcategoryCounts(ctx context.Context, tenantID uint32) (map[string]int, error) {
+	var rows []struct {
+		ParentID string `json:"parent_id"`
+		Count    int    `json:"count"`
+	}
+	err := clientFromContext(ctx, r.entClient).Category.Query().
+		Where(
+			category.TenantIDEQ(tenantID),
+			category.ParentIDNotNil(),
+		).
+		GroupBy(category.FieldParentID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		r.log.Errorf("count subcategories failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("count subcategories failed")
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.ParentID] = row.Count
+	}
+	return counts, nil
+}
@@ -0,0 +1,223 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/categorydeletejob"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// CategoryDeleteProgress counts the work done by a category deletion
+type CategoryDeleteProgress struct {
+	Total             int64
+	Processed         int64
+	CategoriesDeleted int64
+	CategoriesMoved   int64
+	DocumentsDeleted  int64
+	DocumentsMoved    int64
+}
+
+// CategoryDeleteJobRepo stores background category deletions
+type CategoryDeleteJobRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	ids       *IDGenerator
+	log       *log.Helper
+}
+
+// NewCategoryDeleteJobRepo creates a new CategoryDeleteJobRepo
+func NewCategoryDeleteJobRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], ids *IDGenerator) *CategoryDeleteJobRepo {
+	return &CategoryDeleteJobRepo{
+		log:       ctx.NewLoggerHelper("paperless/category_delete_job/repo"),
+		entClient: entClient,
+		ids:       ids,
+	}
+}
+
+// Create queues a deletion of the category
+func (r *CategoryDeleteJobRepo) Create(ctx context.Context, tenantID uint32, c *ent.Category, mode string, targetCategoryID *string, total int64, createdBy *uint32) (*ent.CategoryDeleteJob, error) {
+	builder := clientFromContext(ctx, r.entClient).CategoryDeleteJob.Create().
+		SetID(r.ids.New()).
+		SetTenantID(tenantID).
+		SetCategoryID(c.ID).
+		SetCategoryPath(c.Path).
+		SetMode(categorydeletejob.Mode(mode)).
+		SetNillableTargetCategoryID(targetCategoryID).
+		SetTotalItems(total).
+		SetCreateTime(time.Now())
+
+	if createdBy != nil {
+		builder.SetCreateBy(*createdBy)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("create category delete job failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("create category delete job failed")
+	}
+	return entity, nil
+}
+
+// Get returns a job of the tenant, or nil if there is none
+func (r *CategoryDeleteJobRepo) Get(ctx context.Context, tenantID uint32, id string) (*ent.CategoryDeleteJob, error) {
+	entity, err := clientFromContext(ctx, r.entClient).CategoryDeleteJob.Query().
+		Where(
+			categorydeletejob.IDEQ(id),
+			categorydeletejob.TenantIDEQ(tenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get category delete job failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("get category delete job failed")
+	}
+	return entity, nil
+}
+
+// ClaimNext returns the oldest job that is pending or whose worker's lease ran out, leased to
+// the caller until now+lease, or nil if there is none. A job is only claimed by one worker.
+func (r *CategoryDeleteJobRepo) ClaimNext(ctx context.Context, now time.Time, lease time.Duration) (*ent.CategoryDeleteJob, error) {
+	candidates, err := r.entClient.Client().CategoryDeleteJob.Query().
+		Where(
+			categorydeletejob.StatusIn(
+				categorydeletejob.StatusCATEGORY_DELETE_JOB_STATUS_PENDING,
+				categorydeletejob.StatusCATEGORY_DELETE_JOB_STATUS_RUNNING,
+			),
+			categorydeletejob.Or(
+				categorydeletejob.LeaseUntilIsNil(),
+				categorydeletejob.LeaseUntilLTE(now),
+			),
+		).
+		Order(ent.Asc(categorydeletejob.FieldCreateTime)).
+		Limit(5).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list category delete jobs failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("list category delete jobs failed")
+	}
+
+	for _, job := range candidates {
+		update := r.entClient.Client().CategoryDeleteJob.Update().
+			Where(categorydeletejob.IDEQ(job.ID), categorydeletejob.StatusEQ(job.Status)).
+			SetStatus(categorydeletejob.StatusCATEGORY_DELETE_JOB_STATUS_RUNNING).
+			SetLeaseUntil(now.Add(lease)).
+			SetUpdateTime(now)
+		if job.LeaseUntil == nil {
+			update.Where(categorydeletejob.LeaseUntilIsNil())
+		} else {
+			update.Where(categorydeletejob.LeaseUntilEQ(*job.LeaseUntil))
+		}
+
+		n, err := update.Save(ctx)
+		if err != nil {
+			r.log.Errorf("claim category delete job %s failed: %s", job.ID, err.Error())
+			continue
+		}
+		if n == 1 {
+			job.Status = categorydeletejob.StatusCATEGORY_DELETE_JOB_STATUS_RUNNING
+			return job, nil
+		}
+	}
+	return nil, nil
+}
+
+// Progress returns the progress recorded on a job
+func (r *CategoryDeleteJobRepo) Progress(job *ent.CategoryDeleteJob) *CategoryDeleteProgress {
+	return &CategoryDeleteProgress{
+		Total:             job.TotalItems,
+		Processed:         job.ProcessedItems,
+		CategoriesDeleted: job.CategoriesDeleted,
+		CategoriesMoved:   job.CategoriesMoved,
+		DocumentsDeleted:  job.DocumentsDeleted,
+		DocumentsMoved:    job.DocumentsMoved,
+	}
+}
+
+// UpdateProgress records the progress of a running job and extends its lease
+func (r *CategoryDeleteJobRepo) UpdateProgress(ctx context.Context, id string, progress *CategoryDeleteProgress, leaseUntil time.Time) error {
+	err := r.entClient.Client().CategoryDeleteJob.UpdateOneID(id).
+		SetProcessedItems(progress.Processed).
+		SetCategoriesDeleted(progress.CategoriesDeleted).
+		SetCategoriesMoved(progress.CategoriesMoved).
+		SetDocumentsDeleted(progress.DocumentsDeleted).
+		SetDocumentsMoved(progress.DocumentsMoved).
+		SetLeaseUntil(leaseUntil).
+		SetUpdateTime(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("update category delete job %s failed: %s", id, err.Error())
+		return paperlessV1.ErrorInternalServerError("update category delete job failed")
+	}
+	return nil
+}
+
+// Finish records the final progress of a job and marks it succeeded, or failed with errMsg
+func (r *CategoryDeleteJobRepo) Finish(ctx context.Context, id string, progress *CategoryDeleteProgress, errMsg string) error {
+	now := time.Now()
+	status := categorydeletejob.StatusCATEGORY_DELETE_JOB_STATUS_SUCCEEDED
+	if errMsg != "" {
+		status = categorydeletejob.StatusCATEGORY_DELETE_JOB_STATUS_FAILED
+	}
+
+	err := r.entClient.Client().CategoryDeleteJob.UpdateOneID(id).
+		SetStatus(status).
+		SetError(truncateError(errMsg)).
+		SetProcessedItems(progress.Processed).
+		SetCategoriesDeleted(progress.CategoriesDeleted).
+		SetCategoriesMoved(progress.CategoriesMoved).
+		SetDocumentsDeleted(progress.DocumentsDeleted).
+		SetDocumentsMoved(progress.DocumentsMoved).
+		ClearLeaseUntil().
+		SetFinishedAt(now).
+		SetUpdateTime(now).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("finish category delete job %s failed: %s", id, err.Error())
+		return paperlessV1.ErrorInternalServerError("finish category delete job failed")
+	}
+	return nil
+}
+
+// ToProto converts an ent.CategoryDeleteJob to paperlessV1.CategoryDeleteJob
+func (r *CategoryDeleteJobRepo) ToProto(entity *ent.CategoryDeleteJob) *paperlessV1.CategoryDeleteJob {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &paperlessV1.CategoryDeleteJob{
+		Id:                entity.ID,
+		CategoryId:        entity.CategoryID,
+		CategoryPath:      entity.CategoryPath,
+		Mode:              paperlessV1.CategoryDeleteMode(paperlessV1.CategoryDeleteMode_value[string(entity.Mode)]),
+		TargetCategoryId:  entity.TargetCategoryID,
+		Status:            paperlessV1.CategoryDeleteJobStatus(paperlessV1.CategoryDeleteJobStatus_value[string(entity.Status)]),
+		TotalItems:        entity.TotalItems,
+		ProcessedItems:    entity.ProcessedItems,
+		CategoriesDeleted: entity.CategoriesDeleted,
+		CategoriesMoved:   entity.CategoriesMoved,
+		DocumentsDeleted:  entity.DocumentsDeleted,
+		DocumentsMoved:    entity.DocumentsMoved,
+		Error:             entity.Error,
+		CreatedBy:         entity.CreateBy,
+	}
+	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+	if entity.UpdateTime != nil && !entity.UpdateTime.IsZero() {
+		proto.UpdateTime = timestamppb.New(*entity.UpdateTime)
+	}
+	if entity.FinishedAt != nil {
+		proto.FinishedAt = timestamppb.New(*entity.FinishedAt)
+	}
+	return proto
+}
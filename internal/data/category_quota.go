@@ -0,0 +1,71 @@
+package data
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/category"
+	"github.com/go-tangra/go-tangra-paperless/internal/errdetail"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// CheckQuota fails with CATEGORY_QUOTA_EXCEEDED if adding a document of the given size to the
+// category would take it or one of its ancestors over its quota. For a move, fromCategoryID is
+// the document's current category; ancestors that already count the document are not checked.
+// The categories with a quota are locked until the transaction ends, so concurrent writers are
+// checked one after another.
+func (r *CategoryRepo) CheckQuota(ctx context.Context, categoryID string, fromCategoryID *string, bytes int64) error {
+	c, err := r.GetByID(ctx, categoryID)
+	if err != nil {
+		return err
+	}
+	if c == nil {
+		return errdetail.With(paperlessV1.ErrorCategoryNotFound("category not found"), errdetail.KeyCategoryNotFound, "")
+	}
+
+	var fromPath string
+	if fromCategoryID != nil && *fromCategoryID != "" {
+		from, err := r.GetByID(ctx, *fromCategoryID)
+		if err != nil {
+			return err
+		}
+		if from != nil {
+			fromPath = from.Path
+		}
+	}
+
+	limited, err := clientFromContext(ctx, r.entClient).Category.Query().
+		Where(
+			category.TenantIDEQ(derefUint32(c.TenantID)),
+			category.PathIn(categoryPathPrefixes(c.Path)...),
+			category.Or(
+				category.MaxDocumentsNotNil(),
+				category.MaxBytesNotNil(),
+			),
+		).
+		Order(ent.Asc(category.FieldID)).
+		ForUpdate().
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("get category quotas failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("check category quota failed")
+	}
+
+	for _, a := range limited {
+		if fromPath == a.Path || strings.HasPrefix(fromPath, a.Path+"/") {
+			continue
+		}
+		if a.MaxDocuments != nil && a.SubtreeDocumentCount+1 > *a.MaxDocuments {
+			return errdetail.With(paperlessV1.ErrorCategoryQuotaExceeded("category %s holds at most %d documents", a.Path, *a.MaxDocuments),
+				errdetail.KeyCategoryQuotaDocuments, "", "path", a.Path, "max", *a.MaxDocuments)
+		}
+		if a.MaxBytes != nil && a.SubtreeDocumentBytes+bytes > *a.MaxBytes {
+			return errdetail.With(paperlessV1.ErrorCategoryQuotaExceeded("category %s holds at most %d bytes, %d are in use", a.Path, *a.MaxBytes, a.SubtreeDocumentBytes),
+				errdetail.KeyCategoryQuotaBytes, "",
+				"path", a.Path, "max", *a.MaxBytes, "used", a.SubtreeDocumentBytes)
+		}
+	}
+	return nil
+}
@@ -2,11 +2,15 @@ package data
 
 import (
 	"context"
+	"slices"
 	"strings"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
 	"github.com/go-kratos/kratos/v2/log"
-	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -15,6 +19,8 @@ import (
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/category"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/document"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-paperless/internal/errdetail"
 
 	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
 )
@@ -27,21 +33,51 @@ func derefUint32(p *uint32) uint32 {
 	return *p
 }
 
+// derefString safely dereferences a string pointer, returning "" if nil
+func derefString(p *string) string {
+	if p == nil {
+		return ""
+	}
+	return *p
+}
+
 type CategoryRepo struct {
-	entClient *entCrud.EntClient[*ent.Client]
-	log       *log.Helper
+	entClient   *entCrud.EntClient[*ent.Client]
+	accessIndex *AccessIndexRepo
+	ids         *IDGenerator
+	settings    *SettingRepo
+	cache       *metadataCache[*ent.Category]
+	treeCache   *categoryTreeCache
+	log         *log.Helper
 }
 
-func NewCategoryRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *CategoryRepo {
+func NewCategoryRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], rdb *redis.Client, accessIndex *AccessIndexRepo, ids *IDGenerator, settings *SettingRepo) *CategoryRepo {
+	l := ctx.NewLoggerHelper("paperless/category/repo")
+
+	cache := newMetadataCache(l,
+		func(c *ent.Category) uint32 { return derefUint32(c.TenantID) },
+		nil,
+		func(c *ent.Category) *ent.Category { cp := *c; return &cp },
+	)
+	entClient.Client().Category.Use(cache.evictOnWrite())
+
+	treeCache := newCategoryTreeCache(l, rdb)
+	entClient.Client().Category.Use(treeCache.invalidateOnWrite())
+
 	return &CategoryRepo{
-		log:       ctx.NewLoggerHelper("paperless/category/repo"),
-		entClient: entClient,
+		log:         l,
+		entClient:   entClient,
+		accessIndex: accessIndex,
+		ids:         ids,
+		settings:    settings,
+		cache:       cache,
+		treeCache:   treeCache,
 	}
 }
 
 // Create creates a new category
-func (r *CategoryRepo) Create(ctx context.Context, tenantID uint32, parentID *string, name, description string, sortOrder int32, createdBy *uint32) (*ent.Category, error) {
-	id := uuid.New().String()
+func (r *CategoryRepo) Create(ctx context.Context, tenantID uint32, parentID *string, name, description, color, icon string, sortOrder int32, createdBy *uint32) (*ent.Category, error) {
+	id := r.ids.New()
 
 	// Build path and calculate depth
 	path := "/" + name
@@ -53,13 +89,14 @@ func (r *CategoryRepo) Create(ctx context.Context, tenantID uint32, parentID *st
 			return nil, err
 		}
 		if parent == nil {
-			return nil, paperlessV1.ErrorCategoryNotFound("parent category not found")
+			return nil, errdetail.With(paperlessV1.ErrorCategoryNotFound("parent category not found"),
+				errdetail.KeyCategoryNotFound, "parent_id")
 		}
 		path = parent.Path + "/" + name
 		depth = parent.Depth + 1
 	}
 
-	builder := r.entClient.Client().Category.Create().
+	builder := clientFromContext(ctx, r.entClient).Category.Create().
 		SetID(id).
 		SetTenantID(tenantID).
 		SetName(name).
@@ -74,6 +111,12 @@ func (r *CategoryRepo) Create(ctx context.Context, tenantID uint32, parentID *st
 	if description != "" {
 		builder.SetDescription(description)
 	}
+	if color != "" {
+		builder.SetColor(color)
+	}
+	if icon != "" {
+		builder.SetIcon(icon)
+	}
 	if createdBy != nil {
 		builder.SetCreateBy(*createdBy)
 	}
@@ -81,18 +124,28 @@ func (r *CategoryRepo) Create(ctx context.Context, tenantID uint32, parentID *st
 	entity, err := builder.Save(ctx)
 	if err != nil {
 		if ent.IsConstraintError(err) {
-			return nil, paperlessV1.ErrorCategoryAlreadyExists("category already exists")
+			return nil, errdetail.With(paperlessV1.ErrorCategoryAlreadyExists("category already exists"),
+				errdetail.KeyCategoryAlreadyExists, "name")
 		}
 		r.log.Errorf("create category failed: %s", err.Error())
 		return nil, paperlessV1.ErrorInternalServerError("create category failed")
 	}
 
+	if err := r.accessIndex.ReindexCategorySubtree(ctx, tenantID, entity.ID); err != nil {
+		r.log.Warnf("failed to index inherited access for category %s: %v", entity.ID, err)
+	}
+
 	return entity, nil
 }
 
-// GetByID retrieves a category by ID
+// GetByID retrieves a category by ID, from the metadata cache if it holds it
 func (r *CategoryRepo) GetByID(ctx context.Context, id string) (*ent.Category, error) {
-	entity, err := r.entClient.Client().Category.Get(ctx, id)
+	cached, hit, epoch := r.cache.get(ctx, id)
+	if hit {
+		return cached, nil
+	}
+
+	entity, err := clientFromContext(ctx, r.entClient).Category.Get(ctx, id)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, nil
@@ -100,12 +153,40 @@ func (r *CategoryRepo) GetByID(ctx context.Context, id string) (*ent.Category, e
 		r.log.Errorf("get category failed: %s", err.Error())
 		return nil, paperlessV1.ErrorInternalServerError("get category failed")
 	}
+	r.cache.put(ctx, id, entity, epoch)
 	return entity, nil
 }
 
+// GetPaths returns the paths of the given categories by ID, in one query. Unknown IDs are
+// left out.
+func (r *CategoryRepo) GetPaths(ctx context.Context, ids []string) (map[string]string, error) {
+	if len(ids) == 0 {
+		return map[string]string{}, nil
+	}
+
+	var rows []struct {
+		ID   string `json:"id"`
+		Path string `json:"path"`
+	}
+	err := clientFromContext(ctx, r.entClient).Category.Query().
+		Where(category.IDIn(ids...)).
+		Select(category.FieldID, category.FieldPath).
+		Scan(ctx, &rows)
+	if err != nil {
+		r.log.Errorf("get category paths failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("get category paths failed")
+	}
+
+	paths := make(map[string]string, len(rows))
+	for _, row := range rows {
+		paths[row.ID] = row.Path
+	}
+	return paths, nil
+}
+
 // GetByTenantAndPath retrieves a category by tenant ID and path
 func (r *CategoryRepo) GetByTenantAndPath(ctx context.Context, tenantID uint32, path string) (*ent.Category, error) {
-	entity, err := r.entClient.Client().Category.Query().
+	entity, err := clientFromContext(ctx, r.entClient).Category.Query().
 		Where(
 			category.TenantIDEQ(tenantID),
 			category.PathEQ(path),
@@ -121,11 +202,16 @@ func (r *CategoryRepo) GetByTenantAndPath(ctx context.Context, tenantID uint32,
 	return entity, nil
 }
 
-// List lists categories with optional parent filter
-func (r *CategoryRepo) List(ctx context.Context, tenantID uint32, parentID *string, nameFilter *string, page, pageSize uint32) ([]*ent.Category, int, error) {
-	query := r.entClient.Client().Category.Query().
+// List lists categories with optional parent filter, limited to readableIDs when set and
+// ordered by sortMode (manually if empty)
+func (r *CategoryRepo) List(ctx context.Context, tenantID uint32, readableIDs []string, parentID *string, nameFilter *string, sortMode string, page, pageSize uint32) ([]*ent.Category, int, error) {
+	query := clientFromContext(ctx, r.entClient).Category.Query().
 		Where(category.TenantIDEQ(tenantID))
 
+	if readableIDs != nil {
+		query = query.Where(predicate.Category(idIn(category.FieldID, readableIDs)))
+	}
+
 	if parentID != nil {
 		if *parentID == "" {
 			// Root-level categories (no parent)
@@ -152,7 +238,7 @@ func (r *CategoryRepo) List(ctx context.Context, tenantID uint32, parentID *stri
 		query = query.Offset(offset).Limit(int(pageSize))
 	}
 
-	entities, err := query.Order(ent.Asc(category.FieldSortOrder), ent.Asc(category.FieldName)).All(ctx)
+	entities, err := query.Order(categoryOrder(sortMode)...).All(ctx)
 	if err != nil {
 		r.log.Errorf("list categories failed: %s", err.Error())
 		return nil, 0, paperlessV1.ErrorInternalServerError("list categories failed")
@@ -163,7 +249,7 @@ func (r *CategoryRepo) List(ctx context.Context, tenantID uint32, parentID *stri
 
 // ListByParentID lists child categories
 func (r *CategoryRepo) ListByParentID(ctx context.Context, tenantID uint32, parentID string) ([]*ent.Category, error) {
-	entities, err := r.entClient.Client().Category.Query().
+	entities, err := clientFromContext(ctx, r.entClient).Category.Query().
 		Where(
 			category.TenantIDEQ(tenantID),
 			category.ParentIDEQ(parentID),
@@ -177,28 +263,71 @@ func (r *CategoryRepo) ListByParentID(ctx context.Context, tenantID uint32, pare
 	return entities, nil
 }
 
-// Update updates a category
-func (r *CategoryRepo) Update(ctx context.Context, id string, name, description *string, sortOrder *int32) (*ent.Category, error) {
-	builder := r.entClient.Client().Category.UpdateOneID(id).
+// Update updates a category. A quota of 0 removes it. With expectedVersion set, the update only
+// applies to that version.
+func (r *CategoryRepo) Update(ctx context.Context, id string, name, description, color, icon *string, sortOrder *int32, maxDocuments, maxBytes *int64, rules *paperlessV1.CategoryRules, expectedVersion *uint32) (*ent.Category, error) {
+	builder := clientFromContext(ctx, r.entClient).Category.UpdateOneID(id).
 		SetUpdateTime(time.Now())
 
+	if expectedVersion != nil {
+		builder.Where(category.VersionEQ(*expectedVersion))
+	}
+
 	if name != nil {
 		builder.SetName(*name)
 	}
 	if description != nil {
 		builder.SetDescription(*description)
 	}
+	if color != nil {
+		builder.SetColor(*color)
+	}
+	if icon != nil {
+		builder.SetIcon(*icon)
+	}
 	if sortOrder != nil {
 		builder.SetSortOrder(*sortOrder)
 	}
+	if maxDocuments != nil {
+		if *maxDocuments > 0 {
+			builder.SetMaxDocuments(*maxDocuments)
+		} else {
+			builder.ClearMaxDocuments()
+		}
+	}
+	if maxBytes != nil {
+		if *maxBytes > 0 {
+			builder.SetMaxBytes(*maxBytes)
+		} else {
+			builder.ClearMaxBytes()
+		}
+	}
+	if rules != nil {
+		if len(rules.GetTags()) > 0 {
+			builder.SetRuleTags(rules.GetTags())
+		} else {
+			builder.ClearRuleTags()
+		}
+		if rules.GetDocumentType() != "" {
+			builder.SetRuleDocumentType(rules.GetDocumentType())
+		} else {
+			builder.ClearRuleDocumentType()
+		}
+		if rules.GetRetentionClass() != "" {
+			builder.SetRuleRetentionClass(rules.GetRetentionClass())
+		} else {
+			builder.ClearRuleRetentionClass()
+		}
+	}
 
 	entity, err := builder.Save(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return nil, paperlessV1.ErrorCategoryNotFound("category not found")
+			return nil, r.notFoundOrConflict(ctx, id, expectedVersion)
 		}
 		if ent.IsConstraintError(err) {
-			return nil, paperlessV1.ErrorCategoryAlreadyExists("category with this name already exists")
+			return nil, errdetail.With(paperlessV1.ErrorCategoryAlreadyExists("category with this name already exists"),
+				errdetail.KeyCategoryAlreadyExists, "name")
 		}
 		r.log.Errorf("update category failed: %s", err.Error())
 		return nil, paperlessV1.ErrorInternalServerError("update category failed")
@@ -207,15 +336,19 @@ func (r *CategoryRepo) Update(ctx context.Context, id string, name, description
 	return entity, nil
 }
 
-// Move moves a category to a new parent
-func (r *CategoryRepo) Move(ctx context.Context, id string, newParentID *string) (*ent.Category, error) {
+// Move moves a category to a new parent. With expectedVersion set, the move only applies to that version.
+func (r *CategoryRepo) Move(ctx context.Context, id string, newParentID *string, expectedVersion *uint32) (*ent.Category, error) {
 	// Get the category
 	c, err := r.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 	if c == nil {
-		return nil, paperlessV1.ErrorCategoryNotFound("category not found")
+		return nil, errdetail.With(paperlessV1.ErrorCategoryNotFound("category not found"), errdetail.KeyCategoryNotFound, "id")
+	}
+	if expectedVersion != nil && c.Version != *expectedVersion {
+		return nil, errdetail.With(paperlessV1.ErrorVersionConflict("category was changed since version %d", *expectedVersion),
+			errdetail.KeyCategoryVersionConflict, "expected_version", "version", *expectedVersion)
 	}
 
 	// Calculate new path and depth
@@ -225,7 +358,8 @@ func (r *CategoryRepo) Move(ctx context.Context, id string, newParentID *string)
 	if newParentID != nil && *newParentID != "" {
 		// Check for circular reference
 		if *newParentID == id {
-			return nil, paperlessV1.ErrorCircularCategoryReference("cannot move category to itself")
+			return nil, errdetail.With(paperlessV1.ErrorCircularCategoryReference("cannot move category to itself"),
+				errdetail.KeyCategoryOwnSubtree, "new_parent_id")
 		}
 
 		parent, err := r.GetByID(ctx, *newParentID)
@@ -233,12 +367,18 @@ func (r *CategoryRepo) Move(ctx context.Context, id string, newParentID *string)
 			return nil, err
 		}
 		if parent == nil {
-			return nil, paperlessV1.ErrorCategoryNotFound("new parent category not found")
+			return nil, errdetail.With(paperlessV1.ErrorCategoryNotFound("new parent category not found"),
+				errdetail.KeyCategoryNotFound, "new_parent_id")
 		}
 
 		// Check if new parent is a descendant of the category being moved
-		if strings.HasPrefix(parent.Path, c.Path+"/") {
-			return nil, paperlessV1.ErrorCircularCategoryReference("cannot move category to its own descendant")
+		descendant, err := r.isDescendant(ctx, parent, id)
+		if err != nil {
+			return nil, err
+		}
+		if descendant || strings.HasPrefix(parent.Path, c.Path+"/") {
+			return nil, errdetail.With(paperlessV1.ErrorCircularCategoryReference("cannot move category into its own subtree"),
+				errdetail.KeyCategoryOwnSubtree, "new_parent_id")
 		}
 
 		newPath = parent.Path + "/" + c.Name
@@ -246,7 +386,7 @@ func (r *CategoryRepo) Move(ctx context.Context, id string, newParentID *string)
 	}
 
 	// Update category
-	builder := r.entClient.Client().Category.UpdateOneID(id).
+	builder := clientFromContext(ctx, r.entClient).Category.UpdateOneID(id).
 		SetPath(newPath).
 		SetDepth(newDepth).
 		SetUpdateTime(time.Now())
@@ -256,54 +396,265 @@ func (r *CategoryRepo) Move(ctx context.Context, id string, newParentID *string)
 	} else {
 		builder.ClearParentID()
 	}
+	if expectedVersion != nil {
+		builder.Where(category.VersionEQ(*expectedVersion))
+	}
 
 	entity, err := builder.Save(ctx)
 	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, r.notFoundOrConflict(ctx, id, expectedVersion)
+		}
 		if ent.IsConstraintError(err) {
-			return nil, paperlessV1.ErrorCategoryAlreadyExists("category with this name already exists in the destination")
+			return nil, errdetail.With(paperlessV1.ErrorCategoryAlreadyExists("category with this name already exists in the destination"),
+				errdetail.KeyCategoryAlreadyExists, "new_parent_id")
 		}
 		r.log.Errorf("move category failed: %s", err.Error())
 		return nil, paperlessV1.ErrorInternalServerError("move category failed")
 	}
 
-	// Update paths of all descendant categories
-	if err := r.updateDescendantPaths(ctx, *c.TenantID, c.Path, newPath); err != nil {
+	// Update paths and depths of all descendant categories
+	if err := r.updateDescendantPaths(ctx, *c.TenantID, c.Path, newPath, newDepth-c.Depth); err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, errdetail.With(paperlessV1.ErrorCategoryAlreadyExists("a descendant's path already exists in the destination"),
+				errdetail.KeyCategoryAlreadyExists, "new_parent_id")
+		}
 		r.log.Errorf("update descendant paths failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("move category failed")
+	}
+
+	// Move the subtree's documents from the old ancestors' counters to the new ones
+	if err := r.moveSubtreeUsage(ctx, *c.TenantID, c.Path, newPath, subtreeUsage(c)); err != nil {
+		r.log.Errorf("update category document counts failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("move category failed")
+	}
+
+	if err := r.accessIndex.ReindexCategorySubtree(ctx, *c.TenantID, id); err != nil {
+		r.log.Warnf("failed to reindex inherited access for category %s: %v", id, err)
 	}
 
 	return entity, nil
 }
 
-// updateDescendantPaths updates paths of all categories under a path
-func (r *CategoryRepo) updateDescendantPaths(ctx context.Context, tenantID uint32, oldPathPrefix, newPathPrefix string) error {
-	descendants, err := r.entClient.Client().Category.Query().
+// moveSubtreeUsage moves the subtree counters of a moved category from the ancestors at its
+// old path to those at its new path
+func (r *CategoryRepo) moveSubtreeUsage(ctx context.Context, tenantID uint32, oldPath, newPath string, usage categoryUsage) error {
+	if usage == (categoryUsage{}) || oldPath == newPath {
+		return nil
+	}
+	client := clientFromContext(ctx, r.entClient)
+
+	oldAncestors := categoryPathPrefixes(oldPath)
+	if err := addSubtreeUsage(ctx, client, tenantID, oldAncestors[:len(oldAncestors)-1], categoryUsage{Count: -usage.Count, Bytes: -usage.Bytes}); err != nil {
+		return err
+	}
+	newAncestors := categoryPathPrefixes(newPath)
+	return addSubtreeUsage(ctx, client, tenantID, newAncestors[:len(newAncestors)-1], usage)
+}
+
+// notFoundOrConflict tells a missing category from one whose version no longer matches after
+// an update matched no row
+func (r *CategoryRepo) notFoundOrConflict(ctx context.Context, id string, expectedVersion *uint32) error {
+	if expectedVersion != nil {
+		exists, err := clientFromContext(ctx, r.entClient).Category.Query().
+			Where(category.IDEQ(id)).
+			Exist(ctx)
+		if err == nil && exists {
+			return errdetail.With(paperlessV1.ErrorVersionConflict("category was changed since version %d", *expectedVersion),
+				errdetail.KeyCategoryVersionConflict, "expected_version", "version", *expectedVersion)
+		}
+	}
+	return errdetail.With(paperlessV1.ErrorCategoryNotFound("category not found"), errdetail.KeyCategoryNotFound, "id")
+}
+
+// updateDescendantPaths rewrites the paths of all categories under a path to the new prefix and
+// shifts their depth by depthDelta, in a single UPDATE so the subtree never mixes old and new paths
+func (r *CategoryRepo) updateDescendantPaths(ctx context.Context, tenantID uint32, oldPathPrefix, newPathPrefix string, depthDelta int32) error {
+	_, err := clientFromContext(ctx, r.entClient).Category.Update().
 		Where(
 			category.TenantIDEQ(tenantID),
 			category.PathHasPrefix(oldPathPrefix+"/"),
 		).
+		AddDepth(depthDelta).
+		SetUpdateTime(time.Now()).
+		Modify(func(u *sql.UpdateBuilder) {
+			u.Set(category.FieldPath, replacePathPrefix(category.FieldPath, oldPathPrefix, newPathPrefix))
+		}).
+		Save(ctx)
+	return err
+}
+
+// replacePathPrefix returns the SQL expression of column with its leading oldPrefix replaced
+// by newPrefix; the caller's predicate guarantees the prefix
+func replacePathPrefix(column, oldPrefix, newPrefix string) sql.Querier {
+	return sql.ExprFunc(func(b *sql.Builder) {
+		switch b.Dialect() {
+		case dialect.MySQL:
+			b.WriteString("CONCAT(").Arg(newPrefix).WriteString(", SUBSTRING(").Ident(column).
+				WriteString(", CHAR_LENGTH(").Arg(oldPrefix).WriteString(") + 1))")
+		case dialect.SQLite:
+			b.Arg(newPrefix).WriteString(" || substr(").Ident(column).
+				WriteString(", length(").Arg(oldPrefix).WriteString(") + 1)")
+		default:
+			b.Arg(newPrefix).WriteString("::text || substr(").Ident(column).
+				WriteString(", char_length(").Arg(oldPrefix).WriteString("::text) + 1)")
+		}
+	})
+}
+
+// isDescendant reports whether the category candidate lies in the subtree of ancestorID,
+// following the parent links so a drifted path can't hide a cycle
+func (r *CategoryRepo) isDescendant(ctx context.Context, candidate *ent.Category, ancestorID string) (bool, error) {
+	visited := make(map[string]bool)
+	for c := candidate; c != nil; {
+		if c.ID == ancestorID {
+			return true, nil
+		}
+		if c.ParentID == nil || visited[c.ID] {
+			return false, nil
+		}
+		visited[c.ID] = true
+
+		parent, err := r.GetByID(ctx, *c.ParentID)
+		if err != nil {
+			return false, err
+		}
+		c = parent
+	}
+	return false, nil
+}
+
+// CategoryPathFix is a category whose materialized path or depth was recomputed
+type CategoryPathFix struct {
+	ID       string
+	Name     string
+	OldPath  string
+	NewPath  string
+	OldDepth int32
+	NewDepth int32
+}
+
+// CategoryPathRebuild is the result of RebuildPaths
+type CategoryPathRebuild struct {
+	Checked int
+	Fixed   []CategoryPathFix
+	// Unreachable lists categories whose parent is missing or part of a cycle
+	Unreachable []string
+}
+
+// RebuildPaths recomputes the path and depth of every category of a tenant from the parent
+// links and, unless dryRun, writes the rows that drifted. Categories that cannot be reached
+// from a root are reported and left unchanged. Call it in a transaction.
+func (r *CategoryRepo) RebuildPaths(ctx context.Context, tenantID uint32, dryRun bool) (*CategoryPathRebuild, error) {
+	categories, err := clientFromContext(ctx, r.entClient).Category.Query().
+		Where(category.TenantIDEQ(tenantID)).
+		Select(category.FieldID, category.FieldParentID, category.FieldName, category.FieldPath, category.FieldDepth).
 		All(ctx)
 	if err != nil {
-		return err
+		r.log.Errorf("list categories for path rebuild failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("rebuild category paths failed")
 	}
 
-	for _, d := range descendants {
-		newPath := strings.Replace(d.Path, oldPathPrefix, newPathPrefix, 1)
-		_, err := r.entClient.Client().Category.UpdateOneID(d.ID).
-			SetPath(newPath).
+	byID := make(map[string]*ent.Category, len(categories))
+	children := make(map[string][]*ent.Category)
+	var roots []*ent.Category
+	for _, c := range categories {
+		byID[c.ID] = c
+	}
+	for _, c := range categories {
+		if c.ParentID == nil || *c.ParentID == "" {
+			roots = append(roots, c)
+		} else {
+			children[*c.ParentID] = append(children[*c.ParentID], c)
+		}
+	}
+
+	result := &CategoryPathRebuild{Checked: len(categories)}
+
+	// Walk the tree breadth-first from the roots; whatever is not reached has a broken parent chain
+	type pending struct {
+		c     *ent.Category
+		path  string
+		depth int32
+	}
+	reached := make(map[string]bool, len(categories))
+	queue := make([]pending, 0, len(roots))
+	for _, c := range roots {
+		queue = append(queue, pending{c: c, path: "/" + c.Name})
+	}
+	for len(queue) > 0 {
+		next := queue[0]
+		queue = queue[1:]
+		reached[next.c.ID] = true
+
+		if next.c.Path != next.path || next.c.Depth != next.depth {
+			result.Fixed = append(result.Fixed, CategoryPathFix{
+				ID:       next.c.ID,
+				Name:     next.c.Name,
+				OldPath:  next.c.Path,
+				NewPath:  next.path,
+				OldDepth: next.c.Depth,
+				NewDepth: next.depth,
+			})
+		}
+		for _, child := range children[next.c.ID] {
+			queue = append(queue, pending{c: child, path: next.path + "/" + child.Name, depth: next.depth + 1})
+		}
+	}
+	for _, c := range categories {
+		if !reached[c.ID] {
+			result.Unreachable = append(result.Unreachable, c.ID)
+		}
+	}
+
+	if dryRun || len(result.Fixed) == 0 {
+		return result, nil
+	}
+
+	// Paths are unique per tenant, so when a new path is still held by another drifted row, move
+	// the drifted rows out of the way first
+	held := make(map[string]bool, len(result.Fixed))
+	for _, fix := range result.Fixed {
+		held[fix.OldPath] = true
+	}
+	staged := false
+	for _, fix := range result.Fixed {
+		if fix.NewPath != fix.OldPath && held[fix.NewPath] {
+			staged = true
+			break
+		}
+	}
+	client := clientFromContext(ctx, r.entClient)
+	if staged {
+		for _, fix := range result.Fixed {
+			if err := client.Category.UpdateOneID(fix.ID).SetPath("/.rebuild/" + fix.ID).Exec(ctx); err != nil {
+				r.log.Errorf("stage category path of %s failed: %s", fix.ID, err.Error())
+				return nil, paperlessV1.ErrorInternalServerError("rebuild category paths failed")
+			}
+		}
+	}
+	for _, fix := range result.Fixed {
+		err := client.Category.UpdateOneID(fix.ID).
+			SetPath(fix.NewPath).
+			SetDepth(fix.NewDepth).
 			SetUpdateTime(time.Now()).
-			Save(ctx)
+			Exec(ctx)
 		if err != nil {
-			return err
+			if ent.IsConstraintError(err) {
+				return nil, paperlessV1.ErrorCategoryAlreadyExists("rebuilt path %s of category %s is already taken", fix.NewPath, fix.ID)
+			}
+			r.log.Errorf("update category path of %s failed: %s", fix.ID, err.Error())
+			return nil, paperlessV1.ErrorInternalServerError("rebuild category paths failed")
 		}
 	}
 
-	return nil
+	return result, nil
 }
 
 // Delete deletes a category
 func (r *CategoryRepo) Delete(ctx context.Context, id string, force bool) error {
 	// Check if category has children
-	childCount, err := r.entClient.Client().Category.Query().
+	childCount, err := clientFromContext(ctx, r.entClient).Category.Query().
 		Where(category.ParentIDEQ(id)).
 		Count(ctx)
 	if err != nil {
@@ -311,22 +662,21 @@ func (r *CategoryRepo) Delete(ctx context.Context, id string, force bool) error
 		return paperlessV1.ErrorInternalServerError("delete category failed")
 	}
 	if childCount > 0 && !force {
-		return paperlessV1.ErrorCategoryNotEmpty("category has child categories")
+		return errdetail.With(paperlessV1.ErrorCategoryNotEmpty("category has child categories"),
+			errdetail.KeyCategoryHasChildren, "")
 	}
 
-	// Check if category has active documents (excluding deleted ones)
-	documentCount, err := r.entClient.Client().Document.Query().
-		Where(
-			document.CategoryIDEQ(id),
-			document.StatusNEQ(document.StatusDOCUMENT_STATUS_DELETED),
-		).
+	// Check if category has active documents (deleted ones are skipped by the query)
+	documentCount, err := clientFromContext(ctx, r.entClient).Document.Query().
+		Where(document.CategoryIDEQ(id)).
 		Count(ctx)
 	if err != nil {
 		r.log.Errorf("count documents failed: %s", err.Error())
 		return paperlessV1.ErrorInternalServerError("delete category failed")
 	}
 	if documentCount > 0 && !force {
-		return paperlessV1.ErrorCategoryNotEmpty("category contains documents")
+		return errdetail.With(paperlessV1.ErrorCategoryNotEmpty("category contains documents"),
+			errdetail.KeyCategoryHasDocuments, "")
 	}
 
 	if force {
@@ -336,21 +686,49 @@ func (r *CategoryRepo) Delete(ctx context.Context, id string, force bool) error
 			return err
 		}
 		if c != nil {
+			// The subtree's documents become uncategorized, so its ancestors stop counting them
+			ancestors := categoryPathPrefixes(c.Path)
+			removed := categoryUsage{Count: -c.SubtreeDocumentCount, Bytes: -c.SubtreeDocumentBytes}
+			if err := addSubtreeUsage(ctx, clientFromContext(ctx, r.entClient), derefUint32(c.TenantID), ancestors[:len(ancestors)-1], removed); err != nil {
+				r.log.Errorf("update category document counts failed: %s", err.Error())
+				return paperlessV1.ErrorInternalServerError("delete category failed")
+			}
+
+			descendantIDs, err := clientFromContext(ctx, r.entClient).Category.Query().
+				Where(
+					category.TenantIDEQ(derefUint32(c.TenantID)),
+					category.PathHasPrefix(c.Path+"/"),
+				).
+				IDs(ctx)
+			if err != nil {
+				r.log.Errorf("get descendant categories failed: %s", err.Error())
+				return paperlessV1.ErrorInternalServerError("delete category failed")
+			}
+
 			// Delete all descendant categories
-			_, err = r.entClient.Client().Category.Delete().
-				Where(category.PathHasPrefix(c.Path + "/")).
+			_, err = clientFromContext(ctx, r.entClient).Category.Delete().
+				Where(
+					category.TenantIDEQ(derefUint32(c.TenantID)),
+					category.PathHasPrefix(c.Path+"/"),
+				).
 				Exec(ctx)
 			if err != nil {
 				r.log.Errorf("delete descendant categories failed: %s", err.Error())
 				return paperlessV1.ErrorInternalServerError("delete category failed")
 			}
+
+			for _, descendantID := range descendantIDs {
+				if err := r.accessIndex.RemoveResource(ctx, derefUint32(c.TenantID), resourceTypeCategory, descendantID); err != nil {
+					r.log.Warnf("failed to remove access index entries for category %s: %v", descendantID, err)
+				}
+			}
 		}
 	}
 
-	err = r.entClient.Client().Category.DeleteOneID(id).Exec(ctx)
+	err = clientFromContext(ctx, r.entClient).Category.DeleteOneID(id).Exec(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return paperlessV1.ErrorCategoryNotFound("category not found")
+			return errdetail.With(paperlessV1.ErrorCategoryNotFound("category not found"), errdetail.KeyCategoryNotFound, "id")
 		}
 		r.log.Errorf("delete category failed: %s", err.Error())
 		return paperlessV1.ErrorInternalServerError("delete category failed")
@@ -358,21 +736,91 @@ func (r *CategoryRepo) Delete(ctx context.Context, id string, force bool) error
 	return nil
 }
 
-// CountDocuments counts documents in a category
-func (r *CategoryRepo) CountDocuments(ctx context.Context, categoryID string) (int, error) {
-	count, err := r.entClient.Client().Document.Query().
-		Where(document.CategoryIDEQ(categoryID)).
+// subtreePredicate matches a category and its descendants
+func subtreePredicate(c *ent.Category) predicate.Category {
+	return category.And(
+		category.TenantIDEQ(derefUint32(c.TenantID)),
+		category.Or(
+			category.IDEQ(c.ID),
+			category.PathHasPrefix(c.Path+"/"),
+		),
+	)
+}
+
+// CountSubtree counts a category and its descendants
+func (r *CategoryRepo) CountSubtree(ctx context.Context, c *ent.Category) (int, error) {
+	count, err := clientFromContext(ctx, r.entClient).Category.Query().
+		Where(subtreePredicate(c)).
 		Count(ctx)
 	if err != nil {
-		r.log.Errorf("count documents failed: %s", err.Error())
-		return 0, paperlessV1.ErrorInternalServerError("count documents failed")
+		r.log.Errorf("count subtree categories failed: %s", err.Error())
+		return 0, paperlessV1.ErrorInternalServerError("count categories failed")
 	}
 	return count, nil
 }
 
+// ListSubtree lists a category and its descendants in path order, so every category comes
+// after its parent
+func (r *CategoryRepo) ListSubtree(ctx context.Context, c *ent.Category) ([]*ent.Category, error) {
+	entities, err := clientFromContext(ctx, r.entClient).Category.Query().
+		Where(subtreePredicate(c)).
+		Order(ent.Asc(category.FieldPath)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list subtree categories failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("list categories failed")
+	}
+	return entities, nil
+}
+
+// ListAncestors lists a category's ancestors and the category itself, root first
+func (r *CategoryRepo) ListAncestors(ctx context.Context, c *ent.Category) ([]*ent.Category, error) {
+	entities, err := clientFromContext(ctx, r.entClient).Category.Query().
+		Where(
+			category.TenantIDEQ(derefUint32(c.TenantID)),
+			category.PathIn(categoryPathPrefixes(c.Path)...),
+		).
+		Order(ent.Asc(category.FieldDepth)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list ancestor categories failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("list categories failed")
+	}
+	return entities, nil
+}
+
+// ListSubtreeDeepestFirst lists up to limit categories of a category's subtree, deepest first,
+// so that every listed category's subcategories are listed before it
+func (r *CategoryRepo) ListSubtreeDeepestFirst(ctx context.Context, c *ent.Category, limit int) ([]*ent.Category, error) {
+	entities, err := clientFromContext(ctx, r.entClient).Category.Query().
+		Where(subtreePredicate(c)).
+		Order(ent.Desc(category.FieldDepth), ent.Asc(category.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list subtree categories failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("list categories failed")
+	}
+	return entities, nil
+}
+
+// ListChildren lists up to limit direct subcategories of a category
+func (r *CategoryRepo) ListChildren(ctx context.Context, categoryID string, limit int) ([]*ent.Category, error) {
+	entities, err := clientFromContext(ctx, r.entClient).Category.Query().
+		Where(category.ParentIDEQ(categoryID)).
+		Order(ent.Asc(category.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list child categories failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("list categories failed")
+	}
+	return entities, nil
+}
+
 // CountSubcategories counts subcategories in a category
 func (r *CategoryRepo) CountSubcategories(ctx context.Context, categoryID string) (int, error) {
-	count, err := r.entClient.Client().Category.Query().
+	count, err := clientFromContext(ctx, r.entClient).Category.Query().
 		Where(category.ParentIDEQ(categoryID)).
 		Count(ctx)
 	if err != nil {
@@ -382,8 +830,16 @@ func (r *CategoryRepo) CountSubcategories(ctx context.Context, categoryID string
 	return count, nil
 }
 
-// GetCategoryParentID returns the parent category ID
+// GetCategoryParentID returns the parent category ID, from the tenant's cached category tree
+// if it can be used
 func (r *CategoryRepo) GetCategoryParentID(ctx context.Context, tenantID uint32, categoryID string) (*string, error) {
+	if parentID, exists, ok := r.treeCache.parent(ctx, tenantID, categoryID, r.loadTree); ok {
+		if !exists || parentID == "" {
+			return nil, nil
+		}
+		return &parentID, nil
+	}
+
 	c, err := r.GetByID(ctx, categoryID)
 	if err != nil {
 		return nil, err
@@ -401,18 +857,36 @@ func (r *CategoryRepo) ToProto(entity *ent.Category) *paperlessV1.Category {
 	}
 
 	proto := &paperlessV1.Category{
-		Id:          entity.ID,
-		TenantId:    derefUint32(entity.TenantID),
-		Name:        entity.Name,
-		Path:        entity.Path,
-		Description: entity.Description,
-		Depth:       entity.Depth,
-		SortOrder:   entity.SortOrder,
+		Id:           entity.ID,
+		TenantId:     derefUint32(entity.TenantID),
+		Name:         entity.Name,
+		Path:         entity.Path,
+		Description:  entity.Description,
+		Color:        entity.Color,
+		Icon:         entity.Icon,
+		Depth:        entity.Depth,
+		SortOrder:    entity.SortOrder,
+		Version:      entity.Version,
+		MaxDocuments: entity.MaxDocuments,
+		MaxBytes:     entity.MaxBytes,
+	}
+	if v, ok := paperlessV1.CategorySortMode_value[string(entity.ChildSortMode)]; ok {
+		proto.ChildSortMode = paperlessV1.CategorySortMode(v)
+	}
+	if entity.ActivityTime != nil {
+		proto.ActivityTime = timestamppb.New(*entity.ActivityTime)
 	}
 
 	if entity.ParentID != nil {
 		proto.ParentId = entity.ParentID
 	}
+	if len(entity.RuleTags) > 0 || entity.RuleDocumentType != "" || entity.RuleRetentionClass != "" {
+		proto.Rules = &paperlessV1.CategoryRules{
+			Tags:           entity.RuleTags,
+			DocumentType:   entity.RuleDocumentType,
+			RetentionClass: entity.RuleRetentionClass,
+		}
+	}
 	if entity.CreateBy != nil {
 		proto.CreatedBy = entity.CreateBy
 	}
@@ -433,99 +907,244 @@ func (r *CategoryRepo) ToProtoWithCounts(ctx context.Context, entity *ent.Catego
 		return nil, nil
 	}
 
-	documentCount, err := r.CountDocuments(ctx, entity.ID)
-	if err != nil {
-		return nil, err
-	}
-	proto.DocumentCount = int32(documentCount)
-
 	subcategoryCount, err := r.CountSubcategories(ctx, entity.ID)
 	if err != nil {
 		return nil, err
 	}
-	proto.SubcategoryCount = int32(subcategoryCount)
+	setCategoryCounts(proto, entity, subcategoryCount)
 
 	return proto, nil
 }
 
-// BuildTree builds a category tree starting from root categories or a specific category
-func (r *CategoryRepo) BuildTree(ctx context.Context, tenantID uint32, rootID *string, maxDepth int32, includeCounts bool) ([]*paperlessV1.CategoryTreeNode, error) {
-	var roots []*ent.Category
-	var err error
+// setCategoryCounts fills in the counts of a category; document counts come from its counters
+func setCategoryCounts(proto *paperlessV1.Category, entity *ent.Category, subcategoryCount int) {
+	proto.DocumentCount = int32(entity.DocumentCount)
+	proto.SubtreeDocumentCount = int32(entity.SubtreeDocumentCount)
+	proto.SubtreeDocumentBytes = entity.SubtreeDocumentBytes
+	proto.SubcategoryCount = int32(subcategoryCount)
+}
+
+// BuildTree builds the category tree below the root categories or a specific category, down to
+// maxDepth levels below them (0 for no limit). The categories are loaded with one query. With
+// readableIDs set, the other categories are left out together with their subtrees. Children
+// are ordered by their parent's sort mode, root categories by rootSortMode. With the tenant's
+// category tree cached, it picks the categories and counts their children, so only the rows
+// of the picked categories are queried.
+func (r *CategoryRepo) BuildTree(ctx context.Context, tenantID uint32, rootID *string, maxDepth int32, includeCounts bool, readableIDs []string, rootSortMode string) ([]*paperlessV1.CategoryTreeNode, error) {
+	query := clientFromContext(ctx, r.entClient).Category.Query().
+		Where(category.TenantIDEQ(tenantID))
+
+	tree, cached := r.treeCache.tree(ctx, tenantID, r.loadTree)
+	var readable map[string]bool
+	if cached && readableIDs != nil {
+		readable = make(map[string]bool, len(readableIDs))
+		for _, id := range readableIDs {
+			readable[id] = true
+		}
+	}
 
+	var root *ent.Category
+	baseDepth := int32(0)
 	if rootID != nil && *rootID != "" {
-		root, err := r.GetByID(ctx, *rootID)
-		if err != nil {
+		var err error
+		if root, err = r.GetByID(ctx, *rootID); err != nil {
 			return nil, err
 		}
 		if root == nil {
 			return nil, paperlessV1.ErrorCategoryNotFound("root category not found")
 		}
-		roots = []*ent.Category{root}
-	} else {
-		roots, err = r.entClient.Client().Category.Query().
-			Where(
-				category.TenantIDEQ(tenantID),
-				category.ParentIDIsNil(),
-			).
-			Order(ent.Asc(category.FieldSortOrder), ent.Asc(category.FieldName)).
-			All(ctx)
-		if err != nil {
-			r.log.Errorf("get root categories failed: %s", err.Error())
-			return nil, paperlessV1.ErrorInternalServerError("get category tree failed")
+		baseDepth = root.Depth
+	}
+	switch {
+	case cached:
+		var depthLimit int32
+		if maxDepth > 0 {
+			depthLimit = baseDepth + maxDepth
+		}
+		var treeRootID string
+		if root != nil {
+			treeRootID = root.ID
+		}
+		query = query.Where(predicate.Category(idIn(category.FieldID, tree.selectIDs(treeRootID, depthLimit, readable))))
+	default:
+		if root != nil {
+			query = query.Where(category.Or(
+				category.IDEQ(root.ID),
+				category.PathHasPrefix(root.Path+"/"),
+			))
+		}
+		if maxDepth > 0 {
+			query = query.Where(category.DepthLTE(baseDepth + maxDepth))
+		}
+		if readableIDs != nil {
+			query = query.Where(predicate.Category(idIn(category.FieldID, readableIDs)))
 		}
 	}
 
-	nodes := make([]*paperlessV1.CategoryTreeNode, 0, len(roots))
-	for _, root := range roots {
-		node, err := r.buildTreeNode(ctx, root, 0, maxDepth, includeCounts)
-		if err != nil {
+	// Parents come before their children
+	categories, err := query.
+		Order(ent.Asc(category.FieldDepth), ent.Asc(category.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("get category tree failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("get category tree failed")
+	}
+
+	var subcategoryCounts map[string]int
+	switch {
+	case includeCounts && cached:
+		subcategoryCounts = tree.childCounts(nil)
+	case includeCounts:
+		if subcategoryCounts, err = r.subcategoryCounts(ctx, tenantID); err != nil {
 			return nil, err
 		}
-		nodes = append(nodes, node)
 	}
 
-	return nodes, nil
-}
+	var roots []*paperlessV1.CategoryTreeNode
+	var frontier []string
+	nodes := make(map[string]*paperlessV1.CategoryTreeNode, len(categories))
+	entities := make(map[string]*ent.Category, len(categories))
+	for _, c := range categories {
+		entities[c.ID] = c
+		node := &paperlessV1.CategoryTreeNode{
+			Category: r.ToProto(c),
+			Children: make([]*paperlessV1.CategoryTreeNode, 0),
+		}
+		if subcategoryCounts != nil {
+			setCategoryCounts(node.Category, c, subcategoryCounts[c.ID])
+		}
 
-func (r *CategoryRepo) buildTreeNode(ctx context.Context, c *ent.Category, currentDepth, maxDepth int32, includeCounts bool) (*paperlessV1.CategoryTreeNode, error) {
-	var categoryProto *paperlessV1.Category
-	var err error
+		switch {
+		case root != nil && c.ID == root.ID, root == nil && c.ParentID == nil:
+			roots = append(roots, node)
+		case c.ParentID != nil && nodes[*c.ParentID] != nil:
+			parent := nodes[*c.ParentID]
+			parent.Children = append(parent.Children, node)
+			parent.HasChildren = true
+		default:
+			// The parent was left out, so the category is too
+			continue
+		}
+		nodes[c.ID] = node
+		if maxDepth > 0 && c.Depth == baseDepth+maxDepth {
+			frontier = append(frontier, c.ID)
+		}
+	}
 
-	if includeCounts {
-		categoryProto, err = r.ToProtoWithCounts(ctx, c)
-		if err != nil {
+	// The children of the nodes at the depth limit are not loaded, only whether there are any
+	if len(frontier) > 0 {
+		var counts map[string]int
+		if cached {
+			counts = tree.childCounts(readable)
+		} else if counts, err = r.childCounts(ctx, tenantID, frontier, readableIDs); err != nil {
 			return nil, err
 		}
-	} else {
-		categoryProto = r.ToProto(c)
+		for _, id := range frontier {
+			nodes[id].HasChildren = counts[id] > 0
+		}
 	}
 
-	node := &paperlessV1.CategoryTreeNode{
-		Category: categoryProto,
-		Children: make([]*paperlessV1.CategoryTreeNode, 0),
+	sortNodes := func(nodes []*paperlessV1.CategoryTreeNode, mode string) {
+		slices.SortFunc(nodes, func(a, b *paperlessV1.CategoryTreeNode) int {
+			return compareCategories(mode, entities[a.Category.Id], entities[b.Category.Id])
+		})
+	}
+	// Only a specific root keeps its place among its siblings
+	if root == nil {
+		sortNodes(roots, rootSortMode)
+	}
+	for id, node := range nodes {
+		sortNodes(node.Children, string(entities[id].ChildSortMode))
 	}
 
-	// Check if we should continue building the tree
-	if maxDepth > 0 && currentDepth >= maxDepth {
-		return node, nil
+	if roots == nil {
+		roots = make([]*paperlessV1.CategoryTreeNode, 0)
 	}
+	return roots, nil
+}
 
-	// Get children
-	children, err := r.ListByParentID(ctx, *c.TenantID, c.ID)
+// loadTree reads the place of every category of a tenant in the category tree
+func (r *CategoryRepo) loadTree(ctx context.Context, tenantID uint32) (categoryTree, error) {
+	entities, err := clientFromContext(ctx, r.entClient).Category.Query().
+		Where(category.TenantIDEQ(tenantID)).
+		Select(category.FieldID, category.FieldParentID, category.FieldDepth).
+		All(ctx)
 	if err != nil {
-		return nil, err
+		r.log.Errorf("load category tree failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("load category tree failed")
 	}
 
-	for _, child := range children {
-		childNode, err := r.buildTreeNode(ctx, child, currentDepth+1, maxDepth, includeCounts)
-		if err != nil {
+	tree := make(categoryTree, len(entities))
+	for _, c := range entities {
+		tree[c.ID] = categoryTreeNode{ParentID: derefString(c.ParentID), Depth: c.Depth}
+	}
+	return tree, nil
+}
+
+// TreeNodes returns the tree nodes of categories without their children, marking the ones that
+// have children in readableIDs (or any children if it is nil)
+func (r *CategoryRepo) TreeNodes(ctx context.Context, tenantID uint32, categories []*ent.Category, includeCounts bool, readableIDs []string) ([]*paperlessV1.CategoryTreeNode, error) {
+	ids := make([]string, 0, len(categories))
+	for _, c := range categories {
+		ids = append(ids, c.ID)
+	}
+
+	readableChildren, err := r.childCounts(ctx, tenantID, ids, readableIDs)
+	if err != nil {
+		return nil, err
+	}
+	var subcategoryCounts map[string]int
+	if includeCounts {
+		if subcategoryCounts, err = r.childCounts(ctx, tenantID, ids, nil); err != nil {
 			return nil, err
 		}
-		node.Children = append(node.Children, childNode)
 	}
 
-	return node, nil
+	nodes := make([]*paperlessV1.CategoryTreeNode, 0, len(categories))
+	for _, c := range categories {
+		node := &paperlessV1.CategoryTreeNode{
+			Category:    r.ToProto(c),
+			Children:    make([]*paperlessV1.CategoryTreeNode, 0),
+			HasChildren: readableChildren[c.ID] > 0,
+		}
+		if subcategoryCounts != nil {
+			setCategoryCounts(node.Category, c, subcategoryCounts[c.ID])
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+// childCounts returns the number of children of each of the given categories, only counting
+// the children in readableIDs unless it is nil
+func (r *CategoryRepo) childCounts(ctx context.Context, tenantID uint32, parentIDs []string, readableIDs []string) (map[string]int, error) {
+	if len(parentIDs) == 0 {
+		return map[string]int{}, nil
+	}
+
+	query := clientFromContext(ctx, r.entClient).Category.Query().
+		Where(
+			category.TenantIDEQ(tenantID),
+			predicate.Category(idIn(category.FieldParentID, parentIDs)),
+		)
+	if readableIDs != nil {
+		query = query.Where(predicate.Category(idIn(category.FieldID, readableIDs)))
+	}
+
+	var rows []struct {
+		ParentID string `json:"parent_id"`
+		Count    int    `json:"count"`
+	}
+	if err := query.GroupBy(category.FieldParentID).Aggregate(ent.Count()).Scan(ctx, &rows); err != nil {
+		r.log.Errorf("count subcategories failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("count subcategories failed")
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.ParentID] = row.Count
+	}
+	return counts, nil
 }
 
 // GetAllDescendantIDs returns all descendant category IDs
@@ -538,7 +1157,7 @@ func (r *CategoryRepo) GetAllDescendantIDs(ctx context.Context, tenantID uint32,
 		return nil, nil
 	}
 
-	descendants, err := r.entClient.Client().Category.Query().
+	descendants, err := clientFromContext(ctx, r.entClient).Category.Query().
 		Where(
 			category.TenantIDEQ(tenantID),
 			category.PathHasPrefix(c.Path+"/"),
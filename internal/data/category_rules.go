@@ -0,0 +1,70 @@
+package data
+
+import (
+	"context"
+	"maps"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/hook"
+)
+
+type skipCategoryRulesKey struct{}
+
+// WithoutCategoryRules returns a context whose document writes keep their metadata as given
+// instead of applying the rules of the category, e.g. for restoring backups
+func WithoutCategoryRules(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipCategoryRulesKey{}, true)
+}
+
+// applyCategoryRules gives documents the tags, document type and retention class configured on
+// the category they are created in or moved to, on any code path. Rule tags are added to the
+// document's tags and replace the value of a tag it already has. Writes that keep a document in
+// its category don't apply the rules again, so they can be edited away afterwards.
+func applyCategoryRules() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.DocumentFunc(func(ctx context.Context, m *ent.DocumentMutation) (ent.Value, error) {
+			categoryID, ok := m.CategoryID()
+			if !ok || ctx.Value(skipCategoryRulesKey{}) != nil {
+				return next.Mutate(ctx, m)
+			}
+			if m.Op().Is(ent.OpUpdateOne) {
+				old, err := m.OldCategoryID(WithDeleted(ctx))
+				if err != nil {
+					return nil, err
+				}
+				if old != nil && *old == categoryID {
+					return next.Mutate(ctx, m)
+				}
+			}
+
+			c, err := m.Client().Category.Get(ctx, categoryID)
+			if err != nil {
+				if ent.IsNotFound(err) {
+					// The foreign key rejects the write
+					return next.Mutate(ctx, m)
+				}
+				return nil, err
+			}
+
+			if len(c.RuleTags) > 0 {
+				tags, set := m.Tags()
+				if !set && m.Op().Is(ent.OpUpdateOne) {
+					if tags, err = m.OldTags(WithDeleted(ctx)); err != nil {
+						return nil, err
+					}
+				}
+				merged := make(map[string]string, len(tags)+len(c.RuleTags))
+				maps.Copy(merged, tags)
+				maps.Copy(merged, c.RuleTags)
+				m.SetTags(merged)
+			}
+			if c.RuleDocumentType != "" {
+				m.SetDocumentType(c.RuleDocumentType)
+			}
+			if c.RuleRetentionClass != "" {
+				m.SetRetentionClass(c.RuleRetentionClass)
+			}
+			return next.Mutate(ctx, m)
+		})
+	}, ent.OpCreate|ent.OpUpdateOne)
+}
@@ -0,0 +1,154 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/category"
+	"github.com/go-tangra/go-tangra-paperless/internal/errdetail"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// settingRootCategorySortMode names the setting holding how a tenant's root categories are
+// ordered; the tenant ID is appended
+const settingRootCategorySortMode = "category.root_sort_mode."
+
+// categoryOrder returns the order of the subcategories under a sort mode; an empty or unknown
+// mode orders them manually
+func categoryOrder(mode string) []category.OrderOption {
+	switch category.ChildSortMode(mode) {
+	case category.ChildSortModeCATEGORY_SORT_MODE_ALPHABETICAL:
+		return []category.OrderOption{category.ByName(), category.ByID()}
+	case category.ChildSortModeCATEGORY_SORT_MODE_RECENT_ACTIVITY:
+		return []category.OrderOption{category.ByActivityTime(sql.OrderDesc(), sql.OrderNullsLast()), category.ByName(), category.ByID()}
+	default:
+		return []category.OrderOption{category.BySortOrder(), category.ByName(), category.ByID()}
+	}
+}
+
+// compareCategories orders two siblings under a sort mode, like categoryOrder
+func compareCategories(mode string, a, b *ent.Category) int {
+	switch category.ChildSortMode(mode) {
+	case category.ChildSortModeCATEGORY_SORT_MODE_ALPHABETICAL:
+	case category.ChildSortModeCATEGORY_SORT_MODE_RECENT_ACTIVITY:
+		switch {
+		case a.ActivityTime == nil && b.ActivityTime != nil:
+			return 1
+		case a.ActivityTime != nil && b.ActivityTime == nil:
+			return -1
+		case a.ActivityTime != nil && b.ActivityTime != nil:
+			if c := b.ActivityTime.Compare(*a.ActivityTime); c != 0 {
+				return c
+			}
+		}
+	default:
+		if a.SortOrder != b.SortOrder {
+			return int(a.SortOrder - b.SortOrder)
+		}
+	}
+	if c := strings.Compare(a.Name, b.Name); c != 0 {
+		return c
+	}
+	return strings.Compare(a.ID, b.ID)
+}
+
+// RootSortMode returns how a tenant's root categories are ordered
+func (r *CategoryRepo) RootSortMode(ctx context.Context, tenantID uint32) (string, error) {
+	mode, err := r.settings.Get(ctx, fmt.Sprintf("%s%d", settingRootCategorySortMode, tenantID))
+	if err != nil {
+		return "", err
+	}
+	if mode == "" {
+		return string(category.DefaultChildSortMode), nil
+	}
+	return mode, nil
+}
+
+// SetRootSortMode sets how a tenant's root categories are ordered
+func (r *CategoryRepo) SetRootSortMode(ctx context.Context, tenantID uint32, mode string) error {
+	return r.settings.Set(ctx, fmt.Sprintf("%s%d", settingRootCategorySortMode, tenantID), mode)
+}
+
+// SetChildSortMode sets how a category's subcategories are ordered
+func (r *CategoryRepo) SetChildSortMode(ctx context.Context, id, mode string) (*ent.Category, error) {
+	entity, err := clientFromContext(ctx, r.entClient).Category.UpdateOneID(id).
+		SetChildSortMode(category.ChildSortMode(mode)).
+		SetUpdateTime(time.Now()).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errdetail.With(paperlessV1.ErrorCategoryNotFound("category not found"), errdetail.KeyCategoryNotFound, "parent_id")
+		}
+		r.log.Errorf("set category sort mode failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("set category sort mode failed")
+	}
+	return entity, nil
+}
+
+// Reorder puts the subcategories of a category, or a tenant's root categories with an empty
+// parentID, in the given order by renumbering their sort order. Subcategories that are not
+// listed follow in their current manual order. The categories are locked while they are
+// renumbered, and only the ones whose position changed are written.
+func (r *CategoryRepo) Reorder(ctx context.Context, tenantID uint32, parentID string, orderedIDs []string) ([]*ent.Category, error) {
+	query := clientFromContext(ctx, r.entClient).Category.Query().
+		Where(category.TenantIDEQ(tenantID))
+	if parentID == "" {
+		query = query.Where(category.ParentIDIsNil())
+	} else {
+		query = query.Where(category.ParentIDEQ(parentID))
+	}
+	siblings, err := query.
+		Order(categoryOrder(string(category.ChildSortModeCATEGORY_SORT_MODE_MANUAL))...).
+		ForUpdate().
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list categories to reorder failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("reorder categories failed")
+	}
+
+	byID := make(map[string]*ent.Category, len(siblings))
+	for _, c := range siblings {
+		byID[c.ID] = c
+	}
+	ordered := make([]*ent.Category, 0, len(siblings))
+	for i, id := range orderedIDs {
+		c, ok := byID[id]
+		if !ok {
+			return nil, errdetail.With(paperlessV1.ErrorBadRequest("category %s is not a subcategory of the parent", id),
+				errdetail.KeyCategoryNotChild, fmt.Sprintf("category_ids[%d]", i), "id", id)
+		}
+		if slices.Contains(orderedIDs[:i], id) {
+			return nil, errdetail.With(paperlessV1.ErrorBadRequest("category %s is listed more than once", id),
+				errdetail.KeyCategoryListedTwice, fmt.Sprintf("category_ids[%d]", i), "id", id)
+		}
+		ordered = append(ordered, c)
+	}
+	for _, c := range siblings {
+		if !slices.Contains(orderedIDs, c.ID) {
+			ordered = append(ordered, c)
+		}
+	}
+
+	for i, c := range ordered {
+		if c.SortOrder == int32(i) {
+			continue
+		}
+		updated, err := clientFromContext(ctx, r.entClient).Category.UpdateOneID(c.ID).
+			SetSortOrder(int32(i)).
+			SetUpdateTime(time.Now()).
+			Save(ctx)
+		if err != nil {
+			r.log.Errorf("reorder category failed: %s", err.Error())
+			return nil, paperlessV1.ErrorInternalServerError("reorder categories failed")
+		}
+		ordered[i] = updated
+	}
+	return ordered, nil
+}
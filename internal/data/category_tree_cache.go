@@ -0,0 +1,338 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/tx7do/go-crud/viewer"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/category"
+)
+
+const (
+	defaultCategoryTreeCacheTTL = 5 * time.Minute
+
+	// categoryTreeKeyPrefix starts the key of a tenant's tree hash, followed by the tenant ID
+	categoryTreeKeyPrefix = "paperless:category_tree:"
+	// categoryTreeVersionKey is bumped by writes whose tenant is unknown; per-tenant versions
+	// append ":" and the tenant ID
+	categoryTreeVersionKey = "paperless:category_tree_version"
+	// categoryTreeLoadedField marks a stored tree, so tenants without categories are cached too.
+	// No category ID is a single dash.
+	categoryTreeLoadedField = "-"
+)
+
+// categoryTreeFields are the columns that place a category in its tenant's tree
+var categoryTreeFields = []string{category.FieldTenantID, category.FieldParentID, category.FieldDepth}
+
+// categoryTreeNode is the place of a category in its tenant's tree
+type categoryTreeNode struct {
+	ParentID string `json:"p,omitempty"`
+	Depth    int32  `json:"d"`
+}
+
+// categoryTree maps the IDs of a tenant's categories to their place in the tree
+type categoryTree map[string]categoryTreeNode
+
+// subtree returns the IDs of a category and all its descendants
+func (t categoryTree) subtree(rootID string) []string {
+	children := make(map[string][]string, len(t))
+	for id, node := range t {
+		if node.ParentID != "" {
+			children[node.ParentID] = append(children[node.ParentID], id)
+		}
+	}
+
+	ids := []string{rootID}
+	for i := 0; i < len(ids); i++ {
+		ids = append(ids, children[ids[i]]...)
+	}
+	return ids
+}
+
+// selectIDs returns the IDs of the categories in the subtree of rootID, or the whole tree if it
+// is empty, down to depthLimit (0 for no limit) that are in readable unless it is nil
+func (t categoryTree) selectIDs(rootID string, depthLimit int32, readable map[string]bool) []string {
+	var ids []string
+	if rootID != "" {
+		ids = t.subtree(rootID)
+	} else {
+		ids = slices.Collect(maps.Keys(t))
+	}
+	return slices.DeleteFunc(ids, func(id string) bool {
+		node, ok := t[id]
+		return !ok || (depthLimit > 0 && node.Depth > depthLimit) || (readable != nil && !readable[id])
+	})
+}
+
+// childCounts returns the number of children of each category, only counting the children in
+// readable unless it is nil
+func (t categoryTree) childCounts(readable map[string]bool) map[string]int {
+	counts := make(map[string]int)
+	for id, node := range t {
+		if node.ParentID != "" && (readable == nil || readable[id]) {
+			counts[node.ParentID]++
+		}
+	}
+	return counts
+}
+
+// categoryTreeCache keeps the category tree of each tenant in Redis, shared by all replicas, so
+// building the tree and walking up to a category's ancestors don't query the categories table
+// each time. Writes changing a category's parent or depth drop the tenant's tree, once more when
+// their transaction commits; a version key keeps a tree read before such a write from being
+// stored after it. Without Redis, inside transactions and for primary reads the database is
+// queried, as it is when Redis fails.
+type categoryTreeCache struct {
+	rdb *redis.Client
+	ttl time.Duration
+	log *log.Helper
+}
+
+// newCategoryTreeCache creates a cache whose trees live for PAPERLESS_CATEGORY_TREE_CACHE_TTL
+// (default 5m, 0 disables); a nil client disables it too
+func newCategoryTreeCache(l *log.Helper, rdb *redis.Client) *categoryTreeCache {
+	ttl := defaultCategoryTreeCacheTTL
+	if v := getEnvOrDefault("PAPERLESS_CATEGORY_TREE_CACHE_TTL", ""); v != "" {
+		if d, err := time.ParseDuration(v); err != nil || d < 0 {
+			l.Warnf("invalid PAPERLESS_CATEGORY_TREE_CACHE_TTL %q, using %s", v, ttl)
+		} else {
+			ttl = d
+		}
+	}
+
+	return &categoryTreeCache{rdb: rdb, ttl: ttl, log: l}
+}
+
+func categoryTreeKey(tenantID uint32) string {
+	return categoryTreeKeyPrefix + strconv.FormatUint(uint64(tenantID), 10)
+}
+
+func categoryTreeTenantVersionKey(tenantID uint32) string {
+	return categoryTreeVersionKey + ":" + strconv.FormatUint(uint64(tenantID), 10)
+}
+
+// usable reports whether the tree of tenantID may be read from and stored in the cache in ctx
+func (c *categoryTreeCache) usable(ctx context.Context, tenantID uint32) bool {
+	if c.rdb == nil || c.ttl <= 0 || ent.TxFromContext(ctx) != nil || isPrimaryRead(ctx) {
+		return false
+	}
+	// Without a viewer the privacy policy rejects the query, which the cache must not hide
+	if _, ok := viewer.FromContext(ctx); !ok {
+		return false
+	}
+	// A viewer of another tenant would load an empty tree
+	scoped, ok := scopedTenantID(ctx)
+	return !ok || scoped == tenantID
+}
+
+// tree returns the category tree of a tenant, loading and storing it on a miss. ok is false if
+// the cache can't be used, in which case the caller queries the database.
+func (c *categoryTreeCache) tree(ctx context.Context, tenantID uint32, load func(context.Context, uint32) (categoryTree, error)) (categoryTree, bool) {
+	if !c.usable(ctx, tenantID) {
+		return nil, false
+	}
+
+	fields, err := c.rdb.HGetAll(ctx, categoryTreeKey(tenantID)).Result()
+	if err != nil {
+		c.log.Warnf("read category tree of tenant %d failed: %v", tenantID, err)
+		return nil, false
+	}
+	if _, loaded := fields[categoryTreeLoadedField]; !loaded {
+		return c.fill(ctx, tenantID, load)
+	}
+
+	tree := make(categoryTree, len(fields)-1)
+	for id, raw := range fields {
+		if id == categoryTreeLoadedField {
+			continue
+		}
+		var node categoryTreeNode
+		if err := json.Unmarshal([]byte(raw), &node); err != nil {
+			c.log.Warnf("decode category tree of tenant %d failed: %v", tenantID, err)
+			return nil, false
+		}
+		tree[id] = node
+	}
+	return tree, true
+}
+
+// parent returns the parent of a category, "" for a root category, and whether the category
+// exists. ok is false if the cache can't be used.
+func (c *categoryTreeCache) parent(ctx context.Context, tenantID uint32, id string, load func(context.Context, uint32) (categoryTree, error)) (parentID string, exists, ok bool) {
+	if !c.usable(ctx, tenantID) {
+		return "", false, false
+	}
+
+	values, err := c.rdb.HMGet(ctx, categoryTreeKey(tenantID), categoryTreeLoadedField, id).Result()
+	if err != nil {
+		c.log.Warnf("read category tree of tenant %d failed: %v", tenantID, err)
+		return "", false, false
+	}
+	if values[0] == nil {
+		tree, ok := c.fill(ctx, tenantID, load)
+		if !ok {
+			return "", false, false
+		}
+		node, exists := tree[id]
+		return node.ParentID, exists, true
+	}
+
+	raw, exists := values[1].(string)
+	if !exists {
+		return "", false, true
+	}
+	var node categoryTreeNode
+	if err := json.Unmarshal([]byte(raw), &node); err != nil {
+		c.log.Warnf("decode category tree of tenant %d failed: %v", tenantID, err)
+		return "", false, false
+	}
+	return node.ParentID, true, true
+}
+
+// fill loads the tree of a tenant and stores it, unless a write changed the tree meanwhile
+func (c *categoryTreeCache) fill(ctx context.Context, tenantID uint32, load func(context.Context, uint32) (categoryTree, error)) (categoryTree, bool) {
+	versionKeys := []string{categoryTreeTenantVersionKey(tenantID), categoryTreeVersionKey}
+	versions, err := c.rdb.MGet(ctx, versionKeys...).Result()
+	if err != nil {
+		c.log.Warnf("read category tree version of tenant %d failed: %v", tenantID, err)
+		return nil, false
+	}
+
+	tree, err := load(ctx, tenantID)
+	if err != nil {
+		return nil, false
+	}
+
+	values := make(map[string]any, len(tree)+1)
+	values[categoryTreeLoadedField] = "1"
+	for id, node := range tree {
+		raw, _ := json.Marshal(node)
+		values[id] = raw
+	}
+
+	key := categoryTreeKey(tenantID)
+	err = c.rdb.Watch(ctx, func(tx *redis.Tx) error {
+		current, err := tx.MGet(ctx, versionKeys...).Result()
+		if err != nil {
+			return err
+		}
+		if !slices.Equal(current, versions) {
+			return nil
+		}
+		_, err = tx.TxPipelined(ctx, func(p redis.Pipeliner) error {
+			p.Del(ctx, key)
+			p.HSet(ctx, key, values)
+			p.Expire(ctx, key, c.ttl)
+			return nil
+		})
+		return err
+	}, versionKeys...)
+	if err != nil && !errors.Is(err, redis.TxFailedErr) {
+		c.log.Warnf("store category tree of tenant %d failed: %v", tenantID, err)
+	}
+	return tree, true
+}
+
+// invalidate drops the tree of a tenant, or of every tenant if the tenant is not known
+func (c *categoryTreeCache) invalidate(ctx context.Context, tenantID uint32, known bool) {
+	if known {
+		_, err := c.rdb.TxPipelined(ctx, func(p redis.Pipeliner) error {
+			p.Incr(ctx, categoryTreeTenantVersionKey(tenantID))
+			p.Del(ctx, categoryTreeKey(tenantID))
+			return nil
+		})
+		if err != nil {
+			c.log.Errorf("invalidate category tree of tenant %d failed: %v", tenantID, err)
+		}
+		return
+	}
+
+	if err := c.rdb.Incr(ctx, categoryTreeVersionKey).Err(); err != nil {
+		c.log.Errorf("invalidate category trees failed: %v", err)
+		return
+	}
+	iter := c.rdb.Scan(ctx, 0, categoryTreeKeyPrefix+"*", 100).Iterator()
+	for iter.Next(ctx) {
+		if err := c.rdb.Del(ctx, iter.Val()).Err(); err != nil {
+			c.log.Errorf("invalidate category trees failed: %v", err)
+			return
+		}
+	}
+	if err := iter.Err(); err != nil {
+		c.log.Errorf("invalidate category trees failed: %v", err)
+	}
+}
+
+// invalidateOnWrite is a hook dropping the tree of the tenant whose categories a mutation
+// creates, deletes or moves. Writes of other columns, such as names and document counters,
+// leave the tree alone.
+func (c *categoryTreeCache) invalidateOnWrite() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			cm, ok := m.(*ent.CategoryMutation)
+			if !ok || c.rdb == nil || !changesCategoryTree(cm) {
+				return next.Mutate(ctx, m)
+			}
+
+			// Deleted rows can't be asked for their tenant afterwards
+			tenantID, known := mutatedCategoryTenant(ctx, cm)
+
+			v, err := next.Mutate(ctx, m)
+			c.invalidate(ctx, tenantID, known)
+
+			// Reads outside the transaction may store the old tree until it commits
+			if tx, txErr := cm.Tx(); txErr == nil {
+				tx.OnCommit(func(next ent.Committer) ent.Committer {
+					return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+						err := next.Commit(ctx, tx)
+						c.invalidate(ctx, tenantID, known)
+						return err
+					})
+				})
+			}
+			return v, err
+		})
+	}
+}
+
+// changesCategoryTree reports whether a mutation adds, removes or moves categories
+func changesCategoryTree(m *ent.CategoryMutation) bool {
+	if !m.Op().Is(ent.OpUpdate | ent.OpUpdateOne) {
+		return true
+	}
+	for _, fields := range [][]string{m.Fields(), m.AddedFields(), m.ClearedFields()} {
+		for _, f := range fields {
+			if slices.Contains(categoryTreeFields, f) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// mutatedCategoryTenant returns the tenant of the categories a mutation writes, if it is known
+func mutatedCategoryTenant(ctx context.Context, m *ent.CategoryMutation) (uint32, bool) {
+	if m.Op().Is(ent.OpCreate) {
+		if tenantID, ok := m.TenantID(); ok {
+			return tenantID, true
+		}
+	}
+	if tenantID, ok := scopedTenantID(ctx); ok {
+		return tenantID, true
+	}
+	if m.Op().Is(ent.OpUpdateOne | ent.OpDeleteOne) {
+		if old, err := m.OldTenantID(ctx); err == nil && old != nil {
+			return *old, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,326 @@
+package data
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/document"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documentcontent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+const (
+	// defaultContentTextCompressThreshold is the text size from which extracted text is stored compressed
+	defaultContentTextCompressThreshold = 64 << 10
+	// defaultContentTextLimit is the text size above which only the start of extracted text is stored
+	defaultContentTextLimit = 1 << 20
+
+	// contentTextArchiveType is the content type of archived extracted text
+	contentTextArchiveType = "application/gzip"
+)
+
+// compressText gzips extracted text
+func compressText(text string) ([]byte, error) {
+	var buf bytes.Buffer
+	zw, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := zw.Write([]byte(text)); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// decompressText reverses compressText
+func decompressText(compressed []byte) (string, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return "", err
+	}
+	defer zr.Close()
+
+	text, err := io.ReadAll(zr)
+	if err != nil {
+		return "", err
+	}
+	return string(text), nil
+}
+
+// truncateText returns the first limit bytes of text, shortened to end on a character boundary
+func truncateText(text string, limit int) string {
+	if len(text) <= limit {
+		return text
+	}
+	for limit > 0 && !utf8.RuneStart(text[limit]) {
+		limit--
+	}
+	return text[:limit]
+}
+
+// contentTextArchiveName names the archived text of a file, e.g. "report.txt.gz" for "report.pdf"
+func contentTextArchiveName(fileName string) string {
+	return strings.TrimSuffix(fileName, path.Ext(fileName)) + ".txt.gz"
+}
+
+// ArchiveContentText stores the full text extracted from a document gzip-compressed in storage,
+// next to the document's file, and returns its key
+func ArchiveContentText(ctx context.Context, s Storage, doc *ent.Document, text string) (string, error) {
+	compressed, err := compressText(text)
+	if err != nil {
+		return "", fmt.Errorf("compress content text: %w", err)
+	}
+	return storeContentTextArchive(ctx, s, doc, compressed)
+}
+
+// CopyContentTextArchive stores a copy of the archived text under key for the document copy,
+// which must not share the original's archive, and returns the key of the copy
+func CopyContentTextArchive(ctx context.Context, s Storage, key string, copy *ent.Document) (string, error) {
+	compressed, err := s.Download(ctx, key)
+	if err != nil {
+		return "", fmt.Errorf("download archived content text: %w", err)
+	}
+	return storeContentTextArchive(ctx, s, copy, compressed)
+}
+
+func storeContentTextArchive(ctx context.Context, s Storage, doc *ent.Document, compressed []byte) (string, error) {
+	var categoryID string
+	if doc.CategoryID != nil {
+		categoryID = *doc.CategoryID
+	}
+	upload, err := s.Upload(ctx, derefUint32(doc.TenantID), categoryID, doc.ID, contentTextArchiveName(doc.FileName), compressed, contentTextArchiveType)
+	if err != nil {
+		return "", fmt.Errorf("archive content text: %w", err)
+	}
+	return upload.Key, nil
+}
+
+// splitWords splits text into lowercase words of letters and digits
+func splitWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+// buildSearchTerms returns the distinct words of text, sorted and space-delimited on both
+// ends. Even a long book has a vocabulary of a few thousand words, so this stays small.
+func buildSearchTerms(text string) string {
+	seen := make(map[string]struct{})
+	for _, word := range splitWords(text) {
+		seen[word] = struct{}{}
+	}
+
+	words := make([]string, 0, len(seen))
+	for word := range seen {
+		words = append(words, word)
+	}
+	sort.Strings(words)
+
+	return " " + strings.Join(words, " ") + " "
+}
+
+// searchTermsMatch matches contents whose search terms contain every word of query
+func searchTermsMatch(query string) predicate.DocumentContent {
+	words := splitWords(query)
+	if len(words) == 0 {
+		return documentcontent.SearchTermsContains(strings.ToLower(query))
+	}
+
+	preds := make([]predicate.DocumentContent, 0, len(words))
+	for _, word := range words {
+		preds = append(preds, documentcontent.SearchTermsContains(word))
+	}
+	return documentcontent.And(preds...)
+}
+
+// contentMatch matches documents whose extracted text contains query
+func contentMatch(query string) predicate.Document {
+	return document.HasContentWith(documentcontent.Or(
+		documentcontent.ContentTextContains(query),
+		searchTermsMatch(query),
+	))
+}
+
+// ContentText returns the extracted text of a document content, decompressing it when stored
+// compressed. A nil content has no text, and of archived text only the stored start is returned.
+func (r *DocumentRepo) ContentText(content *ent.DocumentContent) (string, error) {
+	if content == nil {
+		return "", nil
+	}
+	if len(content.ContentTextCompressed) == 0 {
+		return content.ContentText, nil
+	}
+
+	text, err := decompressText(content.ContentTextCompressed)
+	if err != nil {
+		return "", fmt.Errorf("decompress content text of document %s: %w", content.DocumentID, err)
+	}
+	return text, nil
+}
+
+// GetContent returns the text and metadata extracted from a document, or nil if its file was
+// not processed yet
+func (r *DocumentRepo) GetContent(ctx context.Context, documentID string) (*ent.DocumentContent, error) {
+	entity, err := clientFromContext(ctx, r.entClient).DocumentContent.Query().
+		Where(documentcontent.DocumentIDEQ(documentID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get document content failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("get document content failed")
+	}
+	return entity, nil
+}
+
+// GetContentTextKey returns the key of a document's archived text, or "" if its text is not
+// archived
+func (r *DocumentRepo) GetContentTextKey(ctx context.Context, documentID string) (string, error) {
+	keys, err := clientFromContext(ctx, r.entClient).DocumentContent.Query().
+		Where(
+			documentcontent.DocumentIDEQ(documentID),
+			documentcontent.ContentTextKeyNEQ(""),
+		).
+		Select(documentcontent.FieldContentTextKey).
+		Strings(ctx)
+	if err != nil {
+		r.log.Errorf("get document content text key failed: %s", err.Error())
+		return "", paperlessV1.ErrorInternalServerError("get document content failed")
+	}
+	if len(keys) == 0 {
+		return "", nil
+	}
+	return keys[0], nil
+}
+
+// LoadContent sets the extracted text and metadata of a converted document, which ToProto
+// leaves out
+func (r *DocumentRepo) LoadContent(ctx context.Context, proto *paperlessV1.Document) error {
+	content, err := r.GetContent(ctx, proto.Id)
+	if err != nil || content == nil {
+		return err
+	}
+
+	proto.ExtractedMetadata = content.ExtractedMetadata
+	proto.ContentTextTruncated = content.ContentTextKey != ""
+	if text, err := r.ContentText(content); err != nil {
+		r.log.Errorf("%s", err.Error())
+	} else {
+		proto.ContentText = text
+	}
+	return nil
+}
+
+// ContentTextOverLimit reports whether extracted text is longer than
+// PAPERLESS_CONTENT_TEXT_MAX_BYTES, so only its start is stored and the full text must be
+// archived with ArchiveContentText
+func (r *DocumentRepo) ContentTextOverLimit(text string) bool {
+	return r.textLimit > 0 && len(text) > r.textLimit
+}
+
+// saveExtractedContent stores the text and metadata extracted from a document's file. Empty
+// text and nil metadata keep what is stored. With the key of the text's archive only the
+// start of the text is stored.
+func (r *DocumentRepo) saveExtractedContent(ctx context.Context, doc *ent.Document, contentText, textKey string, extractedMetadata map[string]string) error {
+	builder := clientFromContext(ctx, r.entClient).DocumentContent.Create().
+		SetTenantID(derefUint32(doc.TenantID)).
+		SetDocumentID(doc.ID)
+
+	uncompressed, withoutTerms := false, false
+	if contentText != "" {
+		stored := contentText
+		if textKey != "" {
+			stored = truncateText(contentText, r.textLimit)
+		}
+		builder.SetContentTextKey(textKey)
+
+		if r.compressThreshold > 0 && len(stored) >= r.compressThreshold {
+			compressed, err := compressText(stored)
+			if err != nil {
+				return fmt.Errorf("compress content text: %w", err)
+			}
+			builder.SetContentText("").
+				SetContentTextCompressed(compressed)
+		} else {
+			builder.SetContentText(stored)
+			uncompressed = true
+		}
+
+		// Search falls back to the word list when the text can't be matched in SQL, because it
+		// is compressed or only its start is stored; the words are those of the full text
+		if !uncompressed || textKey != "" {
+			builder.SetSearchTerms(buildSearchTerms(contentText))
+		} else {
+			withoutTerms = true
+		}
+	}
+	if extractedMetadata != nil {
+		builder.SetExtractedMetadata(extractedMetadata)
+	}
+
+	return builder.
+		OnConflictColumns(documentcontent.FieldDocumentID).
+		UpdateNewValues().
+		Update(func(u *ent.DocumentContentUpsert) {
+			if uncompressed {
+				u.ClearContentTextCompressed()
+			}
+			if withoutTerms {
+				u.ClearSearchTerms()
+			}
+		}).
+		Exec(ctx)
+}
+
+// SaveDocumentContent replaces the extracted text and metadata of a document with the stored
+// columns of content, e.g. those of a backup or of the document a copy was made of. A nil
+// content removes them. The key of archived text is not carried over, since the archive
+// belongs to the document it was extracted from; see SetContentTextKey.
+func SaveDocumentContent(ctx context.Context, client *ent.Client, tenantID uint32, documentID string, content *ent.DocumentContent) error {
+	if content == nil {
+		_, err := client.DocumentContent.Delete().
+			Where(documentcontent.DocumentIDEQ(documentID)).
+			Exec(ctx)
+		return err
+	}
+
+	return client.DocumentContent.Create().
+		SetTenantID(tenantID).
+		SetDocumentID(documentID).
+		SetContentText(content.ContentText).
+		SetContentTextCompressed(content.ContentTextCompressed).
+		SetSearchTerms(content.SearchTerms).
+		SetExtractedMetadata(content.ExtractedMetadata).
+		OnConflictColumns(documentcontent.FieldDocumentID).
+		UpdateNewValues().
+		Exec(ctx)
+}
+
+// SetContentTextKey records the key of the archived text of a document, e.g. of a copy of the
+// archive made for a copy of the document
+func (r *DocumentRepo) SetContentTextKey(ctx context.Context, documentID, textKey string) error {
+	_, err := clientFromContext(ctx, r.entClient).DocumentContent.Update().
+		Where(documentcontent.DocumentIDEQ(documentID)).
+		SetContentTextKey(textKey).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("update document content failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("update document content failed")
+	}
+	return nil
+}
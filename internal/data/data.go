@@ -9,10 +9,11 @@ import (
 	redisClient "github.com/tx7do/kratos-bootstrap/cache/redis"
 )
 
-// NewRedisClient creates a Redis client
+// NewRedisClient creates a Redis client. Without a configured address the client is nil and
+// the caches that use Redis are off.
 func NewRedisClient(ctx *bootstrap.Context) (*redis.Client, func(), error) {
 	cfg := ctx.GetConfig()
-	if cfg == nil {
+	if cfg == nil || cfg.Data.GetRedis().GetAddr() == "" {
 		return nil, func() {}, nil
 	}
 
@@ -0,0 +1,114 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/document"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// ListDueSoon lists a tenant's active documents due until the given time, soonest first. With
+// from set, documents due before it are left out. With readableIDs set, only those documents
+// are listed. It reads from the read replica when one is configured.
+func (r *DocumentRepo) ListDueSoon(ctx context.Context, tenantID uint32, readableIDs []string, categoryID *string, includeSubcategories bool, from *time.Time, until time.Time, page, pageSize uint32) ([]*ent.Document, int, error) {
+	query := r.replica.Client(ctx).Document.Query().
+		Where(
+			document.TenantIDEQ(tenantID),
+			document.StatusEQ(document.StatusDOCUMENT_STATUS_ACTIVE),
+			document.DueDateLTE(until),
+		)
+
+	if readableIDs != nil {
+		query = query.Where(predicate.Document(idIn(document.FieldID, readableIDs)))
+	}
+	if from != nil {
+		query = query.Where(document.DueDateGTE(*from))
+	}
+
+	if categoryID != nil {
+		if *categoryID == "" {
+			query = query.Where(document.CategoryIDIsNil())
+		} else if includeSubcategories {
+			descendantIDs, err := r.categoryRepo.GetAllDescendantIDs(ctx, tenantID, *categoryID)
+			if err != nil {
+				return nil, 0, err
+			}
+			query = query.Where(document.CategoryIDIn(append([]string{*categoryID}, descendantIDs...)...))
+		} else {
+			query = query.Where(document.CategoryIDEQ(*categoryID))
+		}
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count documents due soon failed: %s", err.Error())
+		return nil, 0, paperlessV1.ErrorInternalServerError("count documents failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		query = query.Offset(int((page - 1) * pageSize)).Limit(int(pageSize))
+	}
+
+	entities, err := query.Order(ent.Asc(document.FieldDueDate), ent.Asc(document.FieldID)).All(ctx)
+	if err != nil {
+		r.log.Errorf("list documents due soon failed: %s", err.Error())
+		return nil, 0, paperlessV1.ErrorInternalServerError("list documents failed")
+	}
+
+	return entities, total, nil
+}
+
+// DueForReminder returns up to limit active documents of all tenants that are due between from
+// and until and whose owners were not reminded yet, soonest first
+func (r *DocumentRepo) DueForReminder(ctx context.Context, from, until time.Time, limit int) ([]*ent.Document, error) {
+	entities, err := r.entClient.Client().Document.Query().
+		Where(
+			document.StatusEQ(document.StatusDOCUMENT_STATUS_ACTIVE),
+			document.DueDateGTE(from),
+			document.DueDateLTE(until),
+			document.RemindedAtIsNil(),
+		).
+		Order(ent.Asc(document.FieldDueDate), ent.Asc(document.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list documents due for reminder failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("list documents failed")
+	}
+	return entities, nil
+}
+
+// ClaimReminder marks the reminder of a document's due date as sent. It reports false if another
+// replica claimed it first or the due date changed in the meantime.
+func (r *DocumentRepo) ClaimReminder(ctx context.Context, id string, dueDate time.Time) (bool, error) {
+	n, err := r.entClient.Client().Document.Update().
+		Where(
+			document.IDEQ(id),
+			document.DueDateEQ(dueDate),
+			document.RemindedAtIsNil(),
+		).
+		SetRemindedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("claim document reminder failed: %s", err.Error())
+		return false, paperlessV1.ErrorInternalServerError("update document failed")
+	}
+	return n == 1, nil
+}
+
+// ReleaseReminder undoes ClaimReminder, so the reminder is sent again by the next run
+func (r *DocumentRepo) ReleaseReminder(ctx context.Context, id string) error {
+	err := r.entClient.Client().Document.Update().
+		Where(document.IDEQ(id)).
+		ClearRemindedAt().
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("release document reminder failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("update document failed")
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+package data
+
+import (
+	"context"
+	"maps"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documenthistory"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/schema"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// DocumentHistoryRepo records the values before and after each change of a document's metadata
+type DocumentHistoryRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewDocumentHistoryRepo creates a new DocumentHistoryRepo
+func NewDocumentHistoryRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *DocumentHistoryRepo {
+	return &DocumentHistoryRepo{
+		log:       ctx.NewLoggerHelper("paperless/document_history_repo"),
+		entClient: entClient,
+	}
+}
+
+// Record stores the change from before to after, unless no tracked field changed
+func (r *DocumentHistoryRepo) Record(ctx context.Context, userID string, before, after *ent.Document) error {
+	if before == nil || after == nil {
+		return nil
+	}
+	from, to := documentSnapshot(before), documentSnapshot(after)
+	changed := changedFields(from, to)
+	if len(changed) == 0 {
+		return nil
+	}
+
+	builder := clientFromContext(ctx, r.entClient).DocumentHistory.Create().
+		SetDocumentID(after.ID).
+		SetVersion(after.Version).
+		SetChangedFields(changed).
+		SetBefore(from).
+		SetAfter(to).
+		SetCreateTime(time.Now())
+
+	if after.TenantID != nil {
+		builder.SetTenantID(*after.TenantID)
+	}
+	if userID != "" {
+		builder.SetUserID(userID)
+	}
+
+	if err := builder.Exec(ctx); err != nil {
+		r.log.Errorf("record document history failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("record document history failed")
+	}
+	return nil
+}
+
+// List returns the changes of a document, newest first, and the total number of changes
+func (r *DocumentHistoryRepo) List(ctx context.Context, tenantID uint32, documentID string, limit, offset int) ([]*ent.DocumentHistory, int, error) {
+	query := r.entClient.Client().DocumentHistory.Query().
+		Where(
+			documenthistory.TenantIDEQ(tenantID),
+			documenthistory.DocumentIDEQ(documentID),
+		)
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count document history failed: %s", err.Error())
+		return nil, 0, paperlessV1.ErrorInternalServerError("count document history failed")
+	}
+
+	entities, err := query.
+		Order(ent.Desc(documenthistory.FieldCreateTime), ent.Desc(documenthistory.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list document history failed: %s", err.Error())
+		return nil, 0, paperlessV1.ErrorInternalServerError("list document history failed")
+	}
+
+	return entities, total, nil
+}
+
+// DeleteByDocument deletes the history of a document
+func (r *DocumentHistoryRepo) DeleteByDocument(ctx context.Context, documentID string) error {
+	_, err := clientFromContext(ctx, r.entClient).DocumentHistory.Delete().
+		Where(documenthistory.DocumentIDEQ(documentID)).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("delete document history failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("delete document history failed")
+	}
+	return nil
+}
+
+// ToProto converts an ent.DocumentHistory to paperlessV1.DocumentHistoryEntry
+func (r *DocumentHistoryRepo) ToProto(entity *ent.DocumentHistory) *paperlessV1.DocumentHistoryEntry {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &paperlessV1.DocumentHistoryEntry{
+		Id:            entity.ID,
+		DocumentId:    entity.DocumentID,
+		UserId:        entity.UserID,
+		Version:       entity.Version,
+		ChangedFields: entity.ChangedFields,
+		Before:        snapshotToProto(entity.Before),
+		After:         snapshotToProto(entity.After),
+	}
+	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+	return proto
+}
+
+// documentSnapshot returns the tracked metadata of a document
+func documentSnapshot(d *ent.Document) schema.DocumentSnapshot {
+	return schema.DocumentSnapshot{
+		Name:           d.Name,
+		Description:    d.Description,
+		CategoryID:     d.CategoryID,
+		Status:         string(d.Status),
+		Tags:           d.Tags,
+		DocumentType:   d.DocumentType,
+		RetentionClass: d.RetentionClass,
+		DueDate:        d.DueDate,
+	}
+}
+
+// changedFields lists the fields that differ between two snapshots
+func changedFields(before, after schema.DocumentSnapshot) []string {
+	var changed []string
+	if before.Name != after.Name {
+		changed = append(changed, "name")
+	}
+	if before.Description != after.Description {
+		changed = append(changed, "description")
+	}
+	if derefString(before.CategoryID) != derefString(after.CategoryID) {
+		changed = append(changed, "category_id")
+	}
+	if before.Status != after.Status {
+		changed = append(changed, "status")
+	}
+	if !maps.Equal(before.Tags, after.Tags) {
+		changed = append(changed, "tags")
+	}
+	if before.DocumentType != after.DocumentType {
+		changed = append(changed, "document_type")
+	}
+	if before.RetentionClass != after.RetentionClass {
+		changed = append(changed, "retention_class")
+	}
+	if !timesEqual(before.DueDate, after.DueDate) {
+		changed = append(changed, "due_date")
+	}
+	return changed
+}
+
+func snapshotToProto(s schema.DocumentSnapshot) *paperlessV1.DocumentSnapshot {
+	proto := &paperlessV1.DocumentSnapshot{
+		Name:           s.Name,
+		Description:    s.Description,
+		CategoryId:     s.CategoryID,
+		Status:         paperlessV1.DocumentStatus(paperlessV1.DocumentStatus_value[s.Status]),
+		Tags:           s.Tags,
+		DocumentType:   s.DocumentType,
+		RetentionClass: s.RetentionClass,
+	}
+	if s.DueDate != nil {
+		proto.DueDate = timestamppb.New(*s.DueDate)
+	}
+	return proto
+}
+
+// timesEqual reports whether two optional times are both unset or the same instant
+func timesEqual(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
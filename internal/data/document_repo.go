@@ -2,10 +2,10 @@ package data
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
-	"github.com/google/uuid"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -13,29 +13,87 @@ import (
 
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/document"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documentcontent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/signaturerequest"
+	"github.com/go-tangra/go-tangra-paperless/internal/errdetail"
 
 	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
 )
 
 type DocumentRepo struct {
 	entClient    *entCrud.EntClient[*ent.Client]
+	replica      *ReadReplica
 	categoryRepo *CategoryRepo
+	accessIndex  *AccessIndexRepo
+	settings     *TenantSettingsRepo
+	cache        *metadataCache[*ent.Document]
 	log          *log.Helper
+
+	// compressThreshold is the extracted text size from which text is stored compressed (0 disables)
+	compressThreshold int
+	// textLimit is the extracted text size above which only the start of the text is stored (0 disables)
+	textLimit int
 }
 
-func NewDocumentRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], categoryRepo *CategoryRepo) *DocumentRepo {
+// NewDocumentRepo creates a DocumentRepo. Extracted text of at least
+// PAPERLESS_CONTENT_TEXT_COMPRESS_THRESHOLD bytes (default 64 KiB, 0 disables) is stored compressed,
+// and of text longer than PAPERLESS_CONTENT_TEXT_MAX_BYTES (default 1 MiB, 0 disables) only the
+// start is stored.
+func NewDocumentRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], replica *ReadReplica, categoryRepo *CategoryRepo, accessIndex *AccessIndexRepo, settings *TenantSettingsRepo) *DocumentRepo {
+	l := ctx.NewLoggerHelper("paperless/document/repo")
+
+	threshold := defaultContentTextCompressThreshold
+	if v := getEnvOrDefault("PAPERLESS_CONTENT_TEXT_COMPRESS_THRESHOLD", ""); v != "" {
+		if n, err := strconv.Atoi(v); err != nil || n < 0 {
+			l.Warnf("invalid PAPERLESS_CONTENT_TEXT_COMPRESS_THRESHOLD %q, using %d", v, threshold)
+		} else {
+			threshold = n
+		}
+	}
+
+	textLimit := defaultContentTextLimit
+	if v := getEnvOrDefault("PAPERLESS_CONTENT_TEXT_MAX_BYTES", ""); v != "" {
+		if n, err := strconv.Atoi(v); err != nil || n < 0 {
+			l.Warnf("invalid PAPERLESS_CONTENT_TEXT_MAX_BYTES %q, using %d", v, textLimit)
+		} else {
+			textLimit = n
+		}
+	}
+
+	cache := newMetadataCache(l,
+		func(d *ent.Document) uint32 { return derefUint32(d.TenantID) },
+		func(ctx context.Context, d *ent.Document) bool {
+			return d.Status != document.StatusDOCUMENT_STATUS_DELETED || schema.SoftDeleteSkipped(ctx)
+		},
+		func(d *ent.Document) *ent.Document { c := *d; return &c },
+	)
+	entClient.Client().Document.Use(cache.evictOnWrite())
+
 	return &DocumentRepo{
-		log:          ctx.NewLoggerHelper("paperless/document/repo"),
-		entClient:    entClient,
-		categoryRepo: categoryRepo,
+		log:               l,
+		entClient:         entClient,
+		replica:           replica,
+		categoryRepo:      categoryRepo,
+		accessIndex:       accessIndex,
+		settings:          settings,
+		cache:             cache,
+		compressThreshold: threshold,
+		textLimit:         textLimit,
 	}
 }
 
-// Create creates a new document
-func (r *DocumentRepo) Create(ctx context.Context, tenantID uint32, categoryID *string, name, description, fileKey, fileName string, fileSize int64, mimeType, checksum string, tags map[string]string, source string, createdBy *uint32) (*ent.Document, error) {
-	id := uuid.New().String()
+// WithDeleted returns a context whose document queries also match soft-deleted documents,
+// which every query skips by default
+func WithDeleted(ctx context.Context) context.Context {
+	return schema.SkipSoftDelete(ctx)
+}
 
-	builder := r.entClient.Client().Document.Create().
+// Create creates a new document with the given ID, which its file key is usually built from.
+// It gets the tenant's default retention class unless its category's rules set one.
+func (r *DocumentRepo) Create(ctx context.Context, id string, tenantID uint32, categoryID *string, name, description, fileKey, fileName string, fileSize int64, mimeType, checksum string, tags map[string]string, source string, createdBy *uint32) (*ent.Document, error) {
+	builder := clientFromContext(ctx, r.entClient).Document.Create().
 		SetID(id).
 		SetTenantID(tenantID).
 		SetName(name).
@@ -66,21 +124,91 @@ func (r *DocumentRepo) Create(ctx context.Context, tenantID uint32, categoryID *
 		builder.SetCreateBy(*createdBy)
 	}
 
+	settings, err := r.settings.For(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if settings.DefaultRetentionClass != "" {
+		builder.SetRetentionClass(settings.DefaultRetentionClass)
+	}
+
 	entity, err := builder.Save(ctx)
 	if err != nil {
 		if ent.IsConstraintError(err) {
-			return nil, paperlessV1.ErrorDocumentAlreadyExists("document already exists")
+			return nil, errdetail.With(paperlessV1.ErrorDocumentAlreadyExists("document already exists"), errdetail.KeyDocumentAlreadyExists, "name")
 		}
 		r.log.Errorf("create document failed: %s", err.Error())
 		return nil, paperlessV1.ErrorInternalServerError("create document failed")
 	}
 
+	if err := r.accessIndex.ReindexDocument(ctx, tenantID, entity.ID, entity.CategoryID); err != nil {
+		r.log.Warnf("failed to index inherited access for document %s: %v", entity.ID, err)
+	}
+
 	return entity, nil
 }
 
-// GetByID retrieves a document by ID
+// CreateCopy creates a copy of a document with the given ID in a category, keeping its metadata,
+// extracted text and processing results. fileKey is the copy of its file.
+func (r *DocumentRepo) CreateCopy(ctx context.Context, src *ent.Document, id string, categoryID *string, fileKey string, createdBy *uint32) (*ent.Document, error) {
+	// The copy keeps the tags of the original rather than taking its category's rules
+	ctx = WithoutCategoryRules(ctx)
+
+	entity, err := clientFromContext(ctx, r.entClient).Document.Create().
+		SetID(id).
+		SetTenantID(derefUint32(src.TenantID)).
+		SetNillableCategoryID(categoryID).
+		SetName(src.Name).
+		SetDescription(src.Description).
+		SetFileKey(fileKey).
+		SetFileName(src.FileName).
+		SetFileSize(src.FileSize).
+		SetMimeType(src.MimeType).
+		SetChecksum(src.Checksum).
+		SetTags(src.Tags).
+		SetDocumentType(src.DocumentType).
+		SetRetentionClass(src.RetentionClass).
+		SetStatus(src.Status).
+		SetSource(src.Source).
+		SetProcessingStatus(src.ProcessingStatus).
+		SetNillableDueDate(src.DueDate).
+		SetNillableCreateBy(createdBy).
+		SetCreateTime(time.Now()).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, errdetail.With(paperlessV1.ErrorDocumentAlreadyExists("document already exists"), errdetail.KeyDocumentAlreadyExists, "name")
+		}
+		r.log.Errorf("copy document failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("copy document failed")
+	}
+
+	content, err := r.GetContent(ctx, src.ID)
+	if err != nil {
+		return nil, err
+	}
+	if content != nil {
+		if err := SaveDocumentContent(ctx, clientFromContext(ctx, r.entClient), derefUint32(entity.TenantID), entity.ID, content); err != nil {
+			r.log.Errorf("copy document content failed: %s", err.Error())
+			return nil, paperlessV1.ErrorInternalServerError("copy document failed")
+		}
+	}
+
+	if err := r.accessIndex.ReindexDocument(ctx, derefUint32(entity.TenantID), entity.ID, entity.CategoryID); err != nil {
+		r.log.Warnf("failed to index inherited access for document %s: %v", entity.ID, err)
+	}
+
+	return entity, nil
+}
+
+// GetByID retrieves a document by ID, from the metadata cache if it holds it
 func (r *DocumentRepo) GetByID(ctx context.Context, id string) (*ent.Document, error) {
-	entity, err := r.entClient.Client().Document.Get(ctx, id)
+	cached, hit, epoch := r.cache.get(ctx, id)
+	if hit {
+		return cached, nil
+	}
+
+	entity, err := clientFromContext(ctx, r.entClient).Document.Get(ctx, id)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, nil
@@ -88,12 +216,13 @@ func (r *DocumentRepo) GetByID(ctx context.Context, id string) (*ent.Document, e
 		r.log.Errorf("get document failed: %s", err.Error())
 		return nil, paperlessV1.ErrorInternalServerError("get document failed")
 	}
+	r.cache.put(ctx, id, entity, epoch)
 	return entity, nil
 }
 
 // GetByFileKey retrieves a document by file key
 func (r *DocumentRepo) GetByFileKey(ctx context.Context, fileKey string) (*ent.Document, error) {
-	entity, err := r.entClient.Client().Document.Query().
+	entity, err := clientFromContext(ctx, r.entClient).Document.Query().
 		Where(document.FileKeyEQ(fileKey)).
 		Only(ctx)
 	if err != nil {
@@ -106,11 +235,304 @@ func (r *DocumentRepo) GetByFileKey(ctx context.Context, fileKey string) (*ent.D
 	return entity, nil
 }
 
-// List lists documents with optional filters
-func (r *DocumentRepo) List(ctx context.Context, tenantID uint32, categoryID *string, status *string, nameFilter, mimeTypeFilter *string, includeSubcategories bool, page, pageSize uint32) ([]*ent.Document, int, error) {
-	query := r.entClient.Client().Document.Query().
+// ReferencedFileKeys returns the subset of fileKeys that belong to a document of the tenant,
+// including soft-deleted documents, cached PDF renditions, archived extracted text and the
+// unsigned originals kept by signature requests
+func (r *DocumentRepo) ReferencedFileKeys(ctx context.Context, tenantID uint32, fileKeys []string) (map[string]bool, error) {
+	ctx = WithDeleted(ctx)
+	referenced := make(map[string]bool, len(fileKeys))
+
+	for _, chunk := range chunkStrings(fileKeys, accessIndexBatchSize) {
+		keys, err := clientFromContext(ctx, r.entClient).Document.Query().
+			Where(
+				document.TenantIDEQ(tenantID),
+				document.FileKeyIn(chunk...),
+			).
+			Select(document.FieldFileKey).
+			Strings(ctx)
+		if err != nil {
+			r.log.Errorf("query document file keys failed: %s", err.Error())
+			return nil, paperlessV1.ErrorInternalServerError("query document file keys failed")
+		}
+		for _, key := range keys {
+			referenced[key] = true
+		}
+
+		renditions, err := clientFromContext(ctx, r.entClient).Document.Query().
+			Where(
+				document.TenantIDEQ(tenantID),
+				document.RenditionKeyIn(chunk...),
+			).
+			Select(document.FieldRenditionKey).
+			Strings(ctx)
+		if err != nil {
+			r.log.Errorf("query document rendition keys failed: %s", err.Error())
+			return nil, paperlessV1.ErrorInternalServerError("query document file keys failed")
+		}
+		for _, key := range renditions {
+			referenced[key] = true
+		}
+
+		archives, err := clientFromContext(ctx, r.entClient).DocumentContent.Query().
+			Where(
+				documentcontent.TenantIDEQ(tenantID),
+				documentcontent.ContentTextKeyIn(chunk...),
+			).
+			Select(documentcontent.FieldContentTextKey).
+			Strings(ctx)
+		if err != nil {
+			r.log.Errorf("query document content text keys failed: %s", err.Error())
+			return nil, paperlessV1.ErrorInternalServerError("query document file keys failed")
+		}
+		for _, key := range archives {
+			referenced[key] = true
+		}
+
+		originals, err := clientFromContext(ctx, r.entClient).SignatureRequest.Query().
+			Where(
+				signaturerequest.TenantIDEQ(tenantID),
+				signaturerequest.OriginalFileKeyIn(chunk...),
+			).
+			Select(signaturerequest.FieldOriginalFileKey).
+			Strings(ctx)
+		if err != nil {
+			r.log.Errorf("query signature request file keys failed: %s", err.Error())
+			return nil, paperlessV1.ErrorInternalServerError("query document file keys failed")
+		}
+		for _, key := range originals {
+			referenced[key] = true
+		}
+	}
+
+	return referenced, nil
+}
+
+// ListTenantIDs returns the IDs of all tenants that own documents, soft-deleted ones included
+func (r *DocumentRepo) ListTenantIDs(ctx context.Context) ([]uint32, error) {
+	ctx = WithDeleted(ctx)
+	var rows []struct {
+		TenantID uint32 `json:"tenant_id"`
+	}
+	err := clientFromContext(ctx, r.entClient).Document.Query().
+		Where(document.TenantIDNotNil()).
+		GroupBy(document.FieldTenantID).
+		Scan(ctx, &rows)
+	if err != nil {
+		r.log.Errorf("list document tenants failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("list document tenants failed")
+	}
+
+	tenantIDs := make([]uint32, 0, len(rows))
+	for _, row := range rows {
+		tenantIDs = append(tenantIDs, row.TenantID)
+	}
+	return tenantIDs, nil
+}
+
+// ListBatch returns up to limit documents of a tenant with IDs greater than afterID, ordered by ID.
+// Soft-deleted documents are included since their files still have to be carried along.
+func (r *DocumentRepo) ListBatch(ctx context.Context, tenantID uint32, afterID string, limit int) ([]*ent.Document, error) {
+	ctx = WithDeleted(ctx)
+	entities, err := clientFromContext(ctx, r.entClient).Document.Query().
+		Where(
+			document.TenantIDEQ(tenantID),
+			document.IDGT(afterID),
+		).
+		Order(ent.Asc(document.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list documents batch failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("list documents failed")
+	}
+	return entities, nil
+}
+
+// CountInSubtree counts the documents of a category and its descendants
+func (r *DocumentRepo) CountInSubtree(ctx context.Context, c *ent.Category) (int, error) {
+	count, err := clientFromContext(ctx, r.entClient).Document.Query().
+		Where(document.HasCategoryWith(subtreePredicate(c))).
+		Count(ctx)
+	if err != nil {
+		r.log.Errorf("count subtree documents failed: %s", err.Error())
+		return 0, paperlessV1.ErrorInternalServerError("count documents failed")
+	}
+	return count, nil
+}
+
+// ListInSubtree lists up to limit documents of a category and its descendants with IDs greater
+// than afterID, in ID order
+func (r *DocumentRepo) ListInSubtree(ctx context.Context, c *ent.Category, afterID string, limit int) ([]*ent.Document, error) {
+	query := clientFromContext(ctx, r.entClient).Document.Query().
+		Where(document.HasCategoryWith(subtreePredicate(c)))
+	if afterID != "" {
+		query.Where(document.IDGT(afterID))
+	}
+
+	entities, err := query.
+		Order(ent.Asc(document.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list subtree documents failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("list documents failed")
+	}
+	return entities, nil
+}
+
+// CountInCategory counts the documents directly in a category
+func (r *DocumentRepo) CountInCategory(ctx context.Context, categoryID string) (int, error) {
+	count, err := clientFromContext(ctx, r.entClient).Document.Query().
+		Where(document.CategoryIDEQ(categoryID)).
+		Count(ctx)
+	if err != nil {
+		r.log.Errorf("count category documents failed: %s", err.Error())
+		return 0, paperlessV1.ErrorInternalServerError("count documents failed")
+	}
+	return count, nil
+}
+
+// ListInCategory lists up to limit documents directly in a category
+func (r *DocumentRepo) ListInCategory(ctx context.Context, categoryID string, limit int) ([]*ent.Document, error) {
+	entities, err := clientFromContext(ctx, r.entClient).Document.Query().
+		Where(document.CategoryIDEQ(categoryID)).
+		Order(ent.Asc(document.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list category documents failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("list documents failed")
+	}
+	return entities, nil
+}
+
+// UpdateFileKey points a document at a new storage key
+func (r *DocumentRepo) UpdateFileKey(ctx context.Context, id, fileKey string) error {
+	err := clientFromContext(ctx, r.entClient).Document.UpdateOneID(id).
+		SetFileKey(fileKey).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("update document file key failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("update document failed")
+	}
+	return nil
+}
+
+// ReplaceFile points a document at new file content in the hot tier, e.g. its signed PDF, and
+// queues it for processing again
+func (r *DocumentRepo) ReplaceFile(ctx context.Context, id, fileKey, fileName string, fileSize int64, mimeType, checksum string) (*ent.Document, error) {
+	entity, err := clientFromContext(ctx, r.entClient).Document.UpdateOneID(id).
+		SetFileKey(fileKey).
+		SetFileName(fileName).
+		SetFileSize(fileSize).
+		SetMimeType(mimeType).
+		SetChecksum(checksum).
+		SetStorageTier(document.StorageTierSTORAGE_TIER_HOT).
+		SetProcessingStatus(document.ProcessingStatusPROCESSING_STATUS_PENDING).
+		ClearRenditionKey().
+		SetUpdateTime(time.Now()).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errdetail.With(paperlessV1.ErrorDocumentNotFound("document not found"), errdetail.KeyDocumentNotFound, "id")
+		}
+		r.log.Errorf("replace document file failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("update document failed")
+	}
+	return entity, nil
+}
+
+// SetRenditionKey records the storage key of a document's cached PDF rendition. It reports
+// false if the document's file changed since the rendition was made from the file with checksum.
+func (r *DocumentRepo) SetRenditionKey(ctx context.Context, id, checksum, renditionKey string) (bool, error) {
+	n, err := clientFromContext(ctx, r.entClient).Document.Update().
+		Where(
+			document.IDEQ(id),
+			document.ChecksumEQ(checksum),
+		).
+		SetRenditionKey(renditionKey).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("update document rendition failed: %s", err.Error())
+		return false, paperlessV1.ErrorInternalServerError("update document failed")
+	}
+	return n > 0, nil
+}
+
+// ListTieringCandidates returns up to limit hot documents with IDs greater than afterID that
+// belong in cold storage: archived ones and, when idleBefore is set, active ones whose file
+// has not been accessed (or the document changed) since idleBefore
+func (r *DocumentRepo) ListTieringCandidates(ctx context.Context, idleBefore *time.Time, afterID string, limit int) ([]*ent.Document, error) {
+	due := document.StatusEQ(document.StatusDOCUMENT_STATUS_ARCHIVED)
+	if idleBefore != nil {
+		idle := document.And(
+			document.StatusEQ(document.StatusDOCUMENT_STATUS_ACTIVE),
+			document.Or(
+				document.LastAccessedAtLT(*idleBefore),
+				document.And(
+					document.LastAccessedAtIsNil(),
+					document.Or(
+						document.UpdateTimeLT(*idleBefore),
+						document.And(document.UpdateTimeIsNil(), document.CreateTimeLT(*idleBefore)),
+					),
+				),
+			),
+		)
+		due = document.Or(due, idle)
+	}
+
+	entities, err := clientFromContext(ctx, r.entClient).Document.Query().
+		Where(
+			document.StorageTierEQ(document.StorageTierSTORAGE_TIER_HOT),
+			document.IDGT(afterID),
+			due,
+		).
+		Order(ent.Asc(document.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list tiering candidates failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("list documents failed")
+	}
+	return entities, nil
+}
+
+// SetStorageTier records the tier and storage key of a document's file
+func (r *DocumentRepo) SetStorageTier(ctx context.Context, id, fileKey, tier string) error {
+	err := clientFromContext(ctx, r.entClient).Document.UpdateOneID(id).
+		SetFileKey(fileKey).
+		SetStorageTier(document.StorageTier(tier)).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("update document storage tier failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("update document failed")
+	}
+	return nil
+}
+
+// MarkAccessed records a download of a document's file
+func (r *DocumentRepo) MarkAccessed(ctx context.Context, id string) error {
+	err := clientFromContext(ctx, r.entClient).Document.UpdateOneID(id).
+		SetLastAccessedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("update document last access failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("update document failed")
+	}
+	return nil
+}
+
+// List lists documents with optional filters. With readableIDs set, only those documents are
+// listed, so pages and the total only count documents the caller can read. It reads from the
+// read replica when one is configured.
+func (r *DocumentRepo) List(ctx context.Context, tenantID uint32, readableIDs []string, categoryID *string, status *string, nameFilter, mimeTypeFilter *string, includeSubcategories bool, page, pageSize uint32) ([]*ent.Document, int, error) {
+	query := r.replica.Client(ctx).Document.Query().
 		Where(document.TenantIDEQ(tenantID))
 
+	if readableIDs != nil {
+		query = query.Where(predicate.Document(idIn(document.FieldID, readableIDs)))
+	}
+
 	if categoryID != nil {
 		if *categoryID == "" {
 			// Root-level documents (no category)
@@ -133,6 +555,9 @@ func (r *DocumentRepo) List(ctx context.Context, tenantID uint32, categoryID *st
 
 	if status != nil && *status != "" {
 		query = query.Where(document.StatusEQ(document.Status(*status)))
+		if *status == string(document.StatusDOCUMENT_STATUS_DELETED) {
+			ctx = WithDeleted(ctx)
+		}
 	}
 
 	if nameFilter != nil && *nameFilter != "" {
@@ -165,19 +590,24 @@ func (r *DocumentRepo) List(ctx context.Context, tenantID uint32, categoryID *st
 	return entities, total, nil
 }
 
-// Search searches documents
-func (r *DocumentRepo) Search(ctx context.Context, tenantID uint32, query string, categoryID *string, includeSubcategories bool, status, mimeTypeFilter *string, tags map[string]string, page, pageSize uint32) ([]*ent.Document, int, error) {
-	q := r.entClient.Client().Document.Query().
+// Search searches documents, limited to readableIDs when set. It reads from the read replica
+// when one is configured.
+func (r *DocumentRepo) Search(ctx context.Context, tenantID uint32, readableIDs []string, query string, categoryID *string, includeSubcategories bool, status, mimeTypeFilter *string, tags map[string]string, page, pageSize uint32) ([]*ent.Document, int, error) {
+	q := r.replica.Client(ctx).Document.Query().
 		Where(
 			document.TenantIDEQ(tenantID),
 			document.Or(
 				document.NameContains(query),
 				document.DescriptionContains(query),
 				document.FileNameContains(query),
-				document.ContentTextContains(query),
+				contentMatch(query),
 			),
 		)
 
+	if readableIDs != nil {
+		q = q.Where(predicate.Document(idIn(document.FieldID, readableIDs)))
+	}
+
 	if categoryID != nil && *categoryID != "" {
 		if includeSubcategories {
 			descendantIDs, err := r.categoryRepo.GetAllDescendantIDs(ctx, tenantID, *categoryID)
@@ -193,6 +623,9 @@ func (r *DocumentRepo) Search(ctx context.Context, tenantID uint32, query string
 
 	if status != nil && *status != "" {
 		q = q.Where(document.StatusEQ(document.Status(*status)))
+		if *status == string(document.StatusDOCUMENT_STATUS_DELETED) {
+			ctx = WithDeleted(ctx)
+		}
 	}
 
 	if mimeTypeFilter != nil && *mimeTypeFilter != "" {
@@ -221,11 +654,16 @@ func (r *DocumentRepo) Search(ctx context.Context, tenantID uint32, query string
 	return entities, total, nil
 }
 
-// Update updates a document
-func (r *DocumentRepo) Update(ctx context.Context, id string, name, description *string, status *string, tags map[string]string, updateTags bool, updatedBy *uint32) (*ent.Document, error) {
-	builder := r.entClient.Client().Document.UpdateOneID(id).
+// Update updates a document. With expectedVersion set, the update only applies to that version.
+// Setting or clearing the due date resets its reminder.
+func (r *DocumentRepo) Update(ctx context.Context, id string, name, description *string, status *string, tags map[string]string, updateTags bool, documentType, retentionClass *string, dueDate *time.Time, clearDueDate bool, updatedBy *uint32, expectedVersion *uint32) (*ent.Document, error) {
+	builder := clientFromContext(ctx, r.entClient).Document.UpdateOneID(id).
 		SetUpdateTime(time.Now())
 
+	if expectedVersion != nil {
+		builder.Where(document.VersionEQ(*expectedVersion))
+	}
+
 	if name != nil {
 		builder.SetName(*name)
 	}
@@ -238,6 +676,17 @@ func (r *DocumentRepo) Update(ctx context.Context, id string, name, description
 	if updateTags {
 		builder.SetTags(tags)
 	}
+	if documentType != nil {
+		builder.SetDocumentType(*documentType)
+	}
+	if retentionClass != nil {
+		builder.SetRetentionClass(*retentionClass)
+	}
+	if clearDueDate {
+		builder.ClearDueDate().ClearRemindedAt()
+	} else if dueDate != nil {
+		builder.SetDueDate(*dueDate).ClearRemindedAt()
+	}
 	if updatedBy != nil {
 		builder.SetUpdateBy(*updatedBy)
 	}
@@ -245,10 +694,11 @@ func (r *DocumentRepo) Update(ctx context.Context, id string, name, description
 	entity, err := builder.Save(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return nil, paperlessV1.ErrorDocumentNotFound("document not found")
+			return nil, r.notFoundOrConflict(ctx, id, expectedVersion)
 		}
 		if ent.IsConstraintError(err) {
-			return nil, paperlessV1.ErrorDocumentAlreadyExists("document with this name already exists")
+			return nil, errdetail.With(paperlessV1.ErrorDocumentAlreadyExists("document with this name already exists"),
+				errdetail.KeyDocumentAlreadyExists, "name")
 		}
 		r.log.Errorf("update document failed: %s", err.Error())
 		return nil, paperlessV1.ErrorInternalServerError("update document failed")
@@ -257,11 +707,15 @@ func (r *DocumentRepo) Update(ctx context.Context, id string, name, description
 	return entity, nil
 }
 
-// Move moves a document to a new category
-func (r *DocumentRepo) Move(ctx context.Context, id string, newCategoryID *string) (*ent.Document, error) {
-	builder := r.entClient.Client().Document.UpdateOneID(id).
+// Move moves a document to a new category. With expectedVersion set, the move only applies to that version.
+func (r *DocumentRepo) Move(ctx context.Context, id string, newCategoryID *string, expectedVersion *uint32) (*ent.Document, error) {
+	builder := clientFromContext(ctx, r.entClient).Document.UpdateOneID(id).
 		SetUpdateTime(time.Now())
 
+	if expectedVersion != nil {
+		builder.Where(document.VersionEQ(*expectedVersion))
+	}
+
 	if newCategoryID != nil && *newCategoryID != "" {
 		builder.SetCategoryID(*newCategoryID)
 	} else {
@@ -271,38 +725,58 @@ func (r *DocumentRepo) Move(ctx context.Context, id string, newCategoryID *strin
 	entity, err := builder.Save(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return nil, paperlessV1.ErrorDocumentNotFound("document not found")
+			return nil, r.notFoundOrConflict(ctx, id, expectedVersion)
 		}
 		if ent.IsConstraintError(err) {
-			return nil, paperlessV1.ErrorDocumentAlreadyExists("document with this name already exists in the destination")
+			return nil, errdetail.With(paperlessV1.ErrorDocumentAlreadyExists("document with this name already exists in the destination"),
+				errdetail.KeyDocumentAlreadyExists, "new_category_id")
 		}
 		r.log.Errorf("move document failed: %s", err.Error())
 		return nil, paperlessV1.ErrorInternalServerError("move document failed")
 	}
 
+	if err := r.accessIndex.ReindexDocument(ctx, derefUint32(entity.TenantID), entity.ID, entity.CategoryID); err != nil {
+		r.log.Warnf("failed to reindex inherited access for document %s: %v", entity.ID, err)
+	}
+
 	return entity, nil
 }
 
+// notFoundOrConflict tells a missing document from one whose version no longer matches after
+// an update matched no row
+func (r *DocumentRepo) notFoundOrConflict(ctx context.Context, id string, expectedVersion *uint32) error {
+	if expectedVersion != nil {
+		exists, err := clientFromContext(ctx, r.entClient).Document.Query().
+			Where(document.IDEQ(id)).
+			Exist(WithDeleted(ctx))
+		if err == nil && exists {
+			return errdetail.With(paperlessV1.ErrorVersionConflict("document was changed since version %d", *expectedVersion),
+				errdetail.KeyDocumentVersionConflict, "expected_version", "version", *expectedVersion)
+		}
+	}
+	return errdetail.With(paperlessV1.ErrorDocumentNotFound("document not found"), errdetail.KeyDocumentNotFound, "id")
+}
+
 // Delete deletes a document (soft delete by default)
 func (r *DocumentRepo) Delete(ctx context.Context, id string, permanent bool) error {
 	if permanent {
-		err := r.entClient.Client().Document.DeleteOneID(id).Exec(ctx)
+		err := clientFromContext(ctx, r.entClient).Document.DeleteOneID(id).Exec(ctx)
 		if err != nil {
 			if ent.IsNotFound(err) {
-				return paperlessV1.ErrorDocumentNotFound("document not found")
+				return errdetail.With(paperlessV1.ErrorDocumentNotFound("document not found"), errdetail.KeyDocumentNotFound, "id")
 			}
 			r.log.Errorf("delete document failed: %s", err.Error())
 			return paperlessV1.ErrorInternalServerError("delete document failed")
 		}
 	} else {
 		// Soft delete - set status to DELETED
-		_, err := r.entClient.Client().Document.UpdateOneID(id).
+		_, err := clientFromContext(ctx, r.entClient).Document.UpdateOneID(id).
 			SetStatus(document.StatusDOCUMENT_STATUS_DELETED).
 			SetUpdateTime(time.Now()).
 			Save(ctx)
 		if err != nil {
 			if ent.IsNotFound(err) {
-				return paperlessV1.ErrorDocumentNotFound("document not found")
+				return errdetail.With(paperlessV1.ErrorDocumentNotFound("document not found"), errdetail.KeyDocumentNotFound, "id")
 			}
 			r.log.Errorf("soft delete document failed: %s", err.Error())
 			return paperlessV1.ErrorInternalServerError("delete document failed")
@@ -311,39 +785,31 @@ func (r *DocumentRepo) Delete(ctx context.Context, id string, permanent bool) er
 	return nil
 }
 
-// BatchDelete deletes multiple documents
-func (r *DocumentRepo) BatchDelete(ctx context.Context, ids []string, permanent bool) (int, []string, error) {
-	deletedCount := 0
-	failedIDs := make([]string, 0)
-
-	for _, id := range ids {
-		err := r.Delete(ctx, id, permanent)
+// UpdateProcessingResult updates document with extracted content and processing status. A
+// non-empty textKey names the archive of contentText made with ArchiveContentText, and only the
+// start of the text is stored.
+func (r *DocumentRepo) UpdateProcessingResult(ctx context.Context, id, contentText, textKey string, extractedMetadata map[string]string, status string) error {
+	if contentText != "" || extractedMetadata != nil {
+		// Documents moved to the trash while they were processed keep their results
+		doc, err := r.GetByID(WithDeleted(ctx), id)
 		if err != nil {
-			failedIDs = append(failedIDs, id)
-		} else {
-			deletedCount++
+			return err
+		}
+		if doc == nil {
+			return errdetail.With(paperlessV1.ErrorDocumentNotFound("document not found"), errdetail.KeyDocumentNotFound, "id")
+		}
+		if err := r.saveExtractedContent(ctx, doc, contentText, textKey, extractedMetadata); err != nil {
+			r.log.Errorf("save extracted content failed: %s", err.Error())
+			return paperlessV1.ErrorInternalServerError("update processing result failed")
 		}
 	}
 
-	return deletedCount, failedIDs, nil
-}
-
-// UpdateProcessingResult updates document with extracted content and processing status
-func (r *DocumentRepo) UpdateProcessingResult(ctx context.Context, id, contentText string, extractedMetadata map[string]string, status string) error {
-	builder := r.entClient.Client().Document.UpdateOneID(id).
-		SetProcessingStatus(document.ProcessingStatus(status))
-
-	if contentText != "" {
-		builder.SetContentText(contentText)
-	}
-	if extractedMetadata != nil {
-		builder.SetExtractedMetadata(extractedMetadata)
-	}
-
-	_, err := builder.Save(ctx)
+	_, err := clientFromContext(ctx, r.entClient).Document.UpdateOneID(id).
+		SetProcessingStatus(document.ProcessingStatus(status)).
+		Save(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return paperlessV1.ErrorDocumentNotFound("document not found")
+			return errdetail.With(paperlessV1.ErrorDocumentNotFound("document not found"), errdetail.KeyDocumentNotFound, "id")
 		}
 		r.log.Errorf("update processing result failed: %s", err.Error())
 		return paperlessV1.ErrorInternalServerError("update processing result failed")
@@ -371,21 +837,23 @@ func (r *DocumentRepo) ToProto(entity *ent.Document) *paperlessV1.Document {
 	}
 
 	proto := &paperlessV1.Document{
-		Id:                entity.ID,
-		TenantId:          derefUint32(entity.TenantID),
-		Name:              entity.Name,
-		Description:       entity.Description,
-		FileKey:           entity.FileKey,
-		FileName:          entity.FileName,
-		FileSize:          entity.FileSize,
-		MimeType:          entity.MimeType,
-		Checksum:          entity.Checksum,
-		Status:            paperlessV1.DocumentStatus(paperlessV1.DocumentStatus_value[string(entity.Status)]),
-		Source:            paperlessV1.DocumentSource(paperlessV1.DocumentSource_value[string(entity.Source)]),
-		Tags:              entity.Tags,
-		ContentText:       entity.ContentText,
-		ExtractedMetadata: entity.ExtractedMetadata,
-		ProcessingStatus:  string(entity.ProcessingStatus),
+		Id:               entity.ID,
+		TenantId:         derefUint32(entity.TenantID),
+		Name:             entity.Name,
+		Description:      entity.Description,
+		FileKey:          entity.FileKey,
+		FileName:         entity.FileName,
+		FileSize:         entity.FileSize,
+		MimeType:         entity.MimeType,
+		Checksum:         entity.Checksum,
+		Status:           paperlessV1.DocumentStatus(paperlessV1.DocumentStatus_value[string(entity.Status)]),
+		Source:           paperlessV1.DocumentSource(paperlessV1.DocumentSource_value[string(entity.Source)]),
+		Tags:             entity.Tags,
+		DocumentType:     entity.DocumentType,
+		RetentionClass:   entity.RetentionClass,
+		ProcessingStatus: string(entity.ProcessingStatus),
+		StorageTier:      paperlessV1.StorageTier(paperlessV1.StorageTier_value[string(entity.StorageTier)]),
+		Version:          entity.Version,
 	}
 
 	if entity.CategoryID != nil {
@@ -403,6 +871,19 @@ func (r *DocumentRepo) ToProto(entity *ent.Document) *paperlessV1.Document {
 	if entity.UpdateTime != nil && !entity.UpdateTime.IsZero() {
 		proto.UpdateTime = timestamppb.New(*entity.UpdateTime)
 	}
+	if entity.LastAccessedAt != nil {
+		proto.LastAccessedAt = timestamppb.New(*entity.LastAccessedAt)
+	}
+	if entity.DueDate != nil {
+		proto.DueDate = timestamppb.New(*entity.DueDate)
+	}
+	if entity.AssigneeID != nil {
+		proto.AssigneeId = entity.AssigneeID
+		proto.AssignedBy = entity.AssignedBy
+	}
+	if entity.AssignedAt != nil {
+		proto.AssignedAt = timestamppb.New(*entity.AssignedAt)
+	}
 
 	return proto
 }
@@ -427,3 +908,35 @@ func (r *DocumentRepo) ToProtoWithCategoryPath(ctx context.Context, entity *ent.
 
 	return proto, nil
 }
+
+// ToProtosWithCategoryPath converts a page of documents like ToProtoWithCategoryPath, loading
+// the paths of their categories in one query instead of one per document
+func (r *DocumentRepo) ToProtosWithCategoryPath(ctx context.Context, entities []*ent.Document) ([]*paperlessV1.Document, error) {
+	seen := make(map[string]struct{})
+	categoryIDs := make([]string, 0, len(entities))
+	for _, entity := range entities {
+		if entity.CategoryID == nil || *entity.CategoryID == "" {
+			continue
+		}
+		if _, ok := seen[*entity.CategoryID]; ok {
+			continue
+		}
+		seen[*entity.CategoryID] = struct{}{}
+		categoryIDs = append(categoryIDs, *entity.CategoryID)
+	}
+
+	paths, err := r.categoryRepo.GetPaths(ctx, categoryIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	protos := make([]*paperlessV1.Document, 0, len(entities))
+	for _, entity := range entities {
+		proto := r.ToProto(entity)
+		if entity.CategoryID != nil {
+			proto.CategoryPath = paths[*entity.CategoryID]
+		}
+		protos = append(protos, proto)
+	}
+	return protos, nil
+}
@@ -0,0 +1,273 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-paperless/internal/authz"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/accessibleresource"
+)
+
+// AccessibleResource is the model entity for the AccessibleResource schema.
+type AccessibleResource struct {
+	config `json:"-"`
+	// ID of the ent.
+	// id
+	ID uint32 `json:"id,omitempty"`
+	// 创建时间
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// 更新时间
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// 删除时间
+	DeleteTime *time.Time `json:"delete_time,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// ID of the permission tuple this entry is derived from
+	PermissionID int `json:"permission_id,omitempty"`
+	// Type of subject (user, role, tenant, or group)
+	SubjectType accessibleresource.SubjectType `json:"subject_type,omitempty"`
+	// ID of the user, role, or tenant
+	SubjectID string `json:"subject_id,omitempty"`
+	// Type of the accessible resource
+	ResourceType accessibleresource.ResourceType `json:"resource_type,omitempty"`
+	// ID of the accessible category or document
+	ResourceID string `json:"resource_id,omitempty"`
+	// Relation granted by the source tuple
+	Relation accessibleresource.Relation `json:"relation,omitempty"`
+	// Resource the source tuple is attached to (differs from resource_id when inherited)
+	SourceID string `json:"source_id,omitempty"`
+	// Whether access is inherited from a parent category
+	Inherited bool `json:"inherited,omitempty"`
+	// Expiration time copied from the source tuple
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Conditions copied from the source tuple; conditional entries must be re-checked
+	Conditions   *authz.Conditions `json:"conditions,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AccessibleResource) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case accessibleresource.FieldConditions:
+			values[i] = new([]byte)
+		case accessibleresource.FieldInherited:
+			values[i] = new(sql.NullBool)
+		case accessibleresource.FieldID, accessibleresource.FieldTenantID, accessibleresource.FieldPermissionID:
+			values[i] = new(sql.NullInt64)
+		case accessibleresource.FieldSubjectType, accessibleresource.FieldSubjectID, accessibleresource.FieldResourceType, accessibleresource.FieldResourceID, accessibleresource.FieldRelation, accessibleresource.FieldSourceID:
+			values[i] = new(sql.NullString)
+		case accessibleresource.FieldCreateTime, accessibleresource.FieldUpdateTime, accessibleresource.FieldDeleteTime, accessibleresource.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AccessibleResource fields.
+func (_m *AccessibleResource) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case accessibleresource.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint32(value.Int64)
+		case accessibleresource.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = new(time.Time)
+				*_m.CreateTime = value.Time
+			}
+		case accessibleresource.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = new(time.Time)
+				*_m.UpdateTime = value.Time
+			}
+		case accessibleresource.FieldDeleteTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_time", values[i])
+			} else if value.Valid {
+				_m.DeleteTime = new(time.Time)
+				*_m.DeleteTime = value.Time
+			}
+		case accessibleresource.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case accessibleresource.FieldPermissionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field permission_id", values[i])
+			} else if value.Valid {
+				_m.PermissionID = int(value.Int64)
+			}
+		case accessibleresource.FieldSubjectType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject_type", values[i])
+			} else if value.Valid {
+				_m.SubjectType = accessibleresource.SubjectType(value.String)
+			}
+		case accessibleresource.FieldSubjectID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject_id", values[i])
+			} else if value.Valid {
+				_m.SubjectID = value.String
+			}
+		case accessibleresource.FieldResourceType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_type", values[i])
+			} else if value.Valid {
+				_m.ResourceType = accessibleresource.ResourceType(value.String)
+			}
+		case accessibleresource.FieldResourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_id", values[i])
+			} else if value.Valid {
+				_m.ResourceID = value.String
+			}
+		case accessibleresource.FieldRelation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field relation", values[i])
+			} else if value.Valid {
+				_m.Relation = accessibleresource.Relation(value.String)
+			}
+		case accessibleresource.FieldSourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_id", values[i])
+			} else if value.Valid {
+				_m.SourceID = value.String
+			}
+		case accessibleresource.FieldInherited:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field inherited", values[i])
+			} else if value.Valid {
+				_m.Inherited = value.Bool
+			}
+		case accessibleresource.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = new(time.Time)
+				*_m.ExpiresAt = value.Time
+			}
+		case accessibleresource.FieldConditions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field conditions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Conditions); err != nil {
+					return fmt.Errorf("unmarshal field conditions: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AccessibleResource.
+// This includes values selected through modifiers, order, etc.
+func (_m *AccessibleResource) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AccessibleResource.
+// Note that you need to call AccessibleResource.Unwrap() before calling this method if this AccessibleResource
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AccessibleResource) Update() *AccessibleResourceUpdateOne {
+	return NewAccessibleResourceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AccessibleResource entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AccessibleResource) Unwrap() *AccessibleResource {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AccessibleResource is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AccessibleResource) String() string {
+	var builder strings.Builder
+	builder.WriteString("AccessibleResource(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeleteTime; v != nil {
+		builder.WriteString("delete_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("permission_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PermissionID))
+	builder.WriteString(", ")
+	builder.WriteString("subject_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SubjectType))
+	builder.WriteString(", ")
+	builder.WriteString("subject_id=")
+	builder.WriteString(_m.SubjectID)
+	builder.WriteString(", ")
+	builder.WriteString("resource_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ResourceType))
+	builder.WriteString(", ")
+	builder.WriteString("resource_id=")
+	builder.WriteString(_m.ResourceID)
+	builder.WriteString(", ")
+	builder.WriteString("relation=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Relation))
+	builder.WriteString(", ")
+	builder.WriteString("source_id=")
+	builder.WriteString(_m.SourceID)
+	builder.WriteString(", ")
+	builder.WriteString("inherited=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Inherited))
+	builder.WriteString(", ")
+	if v := _m.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("conditions=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Conditions))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AccessibleResources is a parsable slice of AccessibleResource.
+type AccessibleResources []*AccessibleResource
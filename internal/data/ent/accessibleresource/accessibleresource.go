@@ -0,0 +1,247 @@
+// Code generated by ent, DO NOT EDIT.
+
+package accessibleresource
+
+import (
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the accessibleresource type in the database.
+	Label = "accessible_resource"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldDeleteTime holds the string denoting the delete_time field in the database.
+	FieldDeleteTime = "delete_time"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldPermissionID holds the string denoting the permission_id field in the database.
+	FieldPermissionID = "permission_id"
+	// FieldSubjectType holds the string denoting the subject_type field in the database.
+	FieldSubjectType = "subject_type"
+	// FieldSubjectID holds the string denoting the subject_id field in the database.
+	FieldSubjectID = "subject_id"
+	// FieldResourceType holds the string denoting the resource_type field in the database.
+	FieldResourceType = "resource_type"
+	// FieldResourceID holds the string denoting the resource_id field in the database.
+	FieldResourceID = "resource_id"
+	// FieldRelation holds the string denoting the relation field in the database.
+	FieldRelation = "relation"
+	// FieldSourceID holds the string denoting the source_id field in the database.
+	FieldSourceID = "source_id"
+	// FieldInherited holds the string denoting the inherited field in the database.
+	FieldInherited = "inherited"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldConditions holds the string denoting the conditions field in the database.
+	FieldConditions = "conditions"
+	// Table holds the table name of the accessibleresource in the database.
+	Table = "paperless_accessible_resources"
+)
+
+// Columns holds all SQL columns for accessibleresource fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldDeleteTime,
+	FieldTenantID,
+	FieldPermissionID,
+	FieldSubjectType,
+	FieldSubjectID,
+	FieldResourceType,
+	FieldResourceID,
+	FieldRelation,
+	FieldSourceID,
+	FieldInherited,
+	FieldExpiresAt,
+	FieldConditions,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/go-tangra/go-tangra-paperless/internal/data/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint32
+	// SubjectIDValidator is a validator for the "subject_id" field. It is called by the builders before save.
+	SubjectIDValidator func(string) error
+	// ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	ResourceIDValidator func(string) error
+	// SourceIDValidator is a validator for the "source_id" field. It is called by the builders before save.
+	SourceIDValidator func(string) error
+	// DefaultInherited holds the default value on creation for the "inherited" field.
+	DefaultInherited bool
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(uint32) error
+)
+
+// SubjectType defines the type for the "subject_type" enum field.
+type SubjectType string
+
+// SubjectType values.
+const (
+	SubjectTypeSUBJECT_TYPE_UNSPECIFIED SubjectType = "SUBJECT_TYPE_UNSPECIFIED"
+	SubjectTypeSUBJECT_TYPE_USER        SubjectType = "SUBJECT_TYPE_USER"
+	SubjectTypeSUBJECT_TYPE_ROLE        SubjectType = "SUBJECT_TYPE_ROLE"
+	SubjectTypeSUBJECT_TYPE_TENANT      SubjectType = "SUBJECT_TYPE_TENANT"
+	SubjectTypeSUBJECT_TYPE_GROUP       SubjectType = "SUBJECT_TYPE_GROUP"
+)
+
+func (st SubjectType) String() string {
+	return string(st)
+}
+
+// SubjectTypeValidator is a validator for the "subject_type" field enum values. It is called by the builders before save.
+func SubjectTypeValidator(st SubjectType) error {
+	switch st {
+	case SubjectTypeSUBJECT_TYPE_UNSPECIFIED, SubjectTypeSUBJECT_TYPE_USER, SubjectTypeSUBJECT_TYPE_ROLE, SubjectTypeSUBJECT_TYPE_TENANT, SubjectTypeSUBJECT_TYPE_GROUP:
+		return nil
+	default:
+		return fmt.Errorf("accessibleresource: invalid enum value for subject_type field: %q", st)
+	}
+}
+
+// ResourceType defines the type for the "resource_type" enum field.
+type ResourceType string
+
+// ResourceType values.
+const (
+	ResourceTypeRESOURCE_TYPE_UNSPECIFIED ResourceType = "RESOURCE_TYPE_UNSPECIFIED"
+	ResourceTypeRESOURCE_TYPE_CATEGORY    ResourceType = "RESOURCE_TYPE_CATEGORY"
+	ResourceTypeRESOURCE_TYPE_DOCUMENT    ResourceType = "RESOURCE_TYPE_DOCUMENT"
+)
+
+func (rt ResourceType) String() string {
+	return string(rt)
+}
+
+// ResourceTypeValidator is a validator for the "resource_type" field enum values. It is called by the builders before save.
+func ResourceTypeValidator(rt ResourceType) error {
+	switch rt {
+	case ResourceTypeRESOURCE_TYPE_UNSPECIFIED, ResourceTypeRESOURCE_TYPE_CATEGORY, ResourceTypeRESOURCE_TYPE_DOCUMENT:
+		return nil
+	default:
+		return fmt.Errorf("accessibleresource: invalid enum value for resource_type field: %q", rt)
+	}
+}
+
+// Relation defines the type for the "relation" enum field.
+type Relation string
+
+// Relation values.
+const (
+	RelationRELATION_UNSPECIFIED Relation = "RELATION_UNSPECIFIED"
+	RelationRELATION_OWNER       Relation = "RELATION_OWNER"
+	RelationRELATION_EDITOR      Relation = "RELATION_EDITOR"
+	RelationRELATION_VIEWER      Relation = "RELATION_VIEWER"
+	RelationRELATION_SHARER      Relation = "RELATION_SHARER"
+)
+
+func (r Relation) String() string {
+	return string(r)
+}
+
+// RelationValidator is a validator for the "relation" field enum values. It is called by the builders before save.
+func RelationValidator(r Relation) error {
+	switch r {
+	case RelationRELATION_UNSPECIFIED, RelationRELATION_OWNER, RelationRELATION_EDITOR, RelationRELATION_VIEWER, RelationRELATION_SHARER:
+		return nil
+	default:
+		return fmt.Errorf("accessibleresource: invalid enum value for relation field: %q", r)
+	}
+}
+
+// OrderOption defines the ordering options for the AccessibleResource queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByDeleteTime orders the results by the delete_time field.
+func ByDeleteTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeleteTime, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByPermissionID orders the results by the permission_id field.
+func ByPermissionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPermissionID, opts...).ToFunc()
+}
+
+// BySubjectType orders the results by the subject_type field.
+func BySubjectType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubjectType, opts...).ToFunc()
+}
+
+// BySubjectID orders the results by the subject_id field.
+func BySubjectID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubjectID, opts...).ToFunc()
+}
+
+// ByResourceType orders the results by the resource_type field.
+func ByResourceType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResourceType, opts...).ToFunc()
+}
+
+// ByResourceID orders the results by the resource_id field.
+func ByResourceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResourceID, opts...).ToFunc()
+}
+
+// ByRelation orders the results by the relation field.
+func ByRelation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRelation, opts...).ToFunc()
+}
+
+// BySourceID orders the results by the source_id field.
+func BySourceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceID, opts...).ToFunc()
+}
+
+// ByInherited orders the results by the inherited field.
+func ByInherited(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInherited, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
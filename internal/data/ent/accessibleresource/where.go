@@ -0,0 +1,685 @@
+// Code generated by ent, DO NOT EDIT.
+
+package accessibleresource
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldEQ(FieldTenantID, v))
+}
+
+// PermissionID applies equality check predicate on the "permission_id" field. It's identical to PermissionIDEQ.
+func PermissionID(v int) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldEQ(FieldPermissionID, v))
+}
+
+// SubjectID applies equality check predicate on the "subject_id" field. It's identical to SubjectIDEQ.
+func SubjectID(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldEQ(FieldSubjectID, v))
+}
+
+// ResourceID applies equality check predicate on the "resource_id" field. It's identical to ResourceIDEQ.
+func ResourceID(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldEQ(FieldResourceID, v))
+}
+
+// SourceID applies equality check predicate on the "source_id" field. It's identical to SourceIDEQ.
+func SourceID(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldEQ(FieldSourceID, v))
+}
+
+// Inherited applies equality check predicate on the "inherited" field. It's identical to InheritedEQ.
+func Inherited(v bool) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldEQ(FieldInherited, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldNotNull(FieldTenantID))
+}
+
+// PermissionIDEQ applies the EQ predicate on the "permission_id" field.
+func PermissionIDEQ(v int) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldEQ(FieldPermissionID, v))
+}
+
+// PermissionIDNEQ applies the NEQ predicate on the "permission_id" field.
+func PermissionIDNEQ(v int) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldNEQ(FieldPermissionID, v))
+}
+
+// PermissionIDIn applies the In predicate on the "permission_id" field.
+func PermissionIDIn(vs ...int) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldIn(FieldPermissionID, vs...))
+}
+
+// PermissionIDNotIn applies the NotIn predicate on the "permission_id" field.
+func PermissionIDNotIn(vs ...int) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldNotIn(FieldPermissionID, vs...))
+}
+
+// PermissionIDGT applies the GT predicate on the "permission_id" field.
+func PermissionIDGT(v int) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldGT(FieldPermissionID, v))
+}
+
+// PermissionIDGTE applies the GTE predicate on the "permission_id" field.
+func PermissionIDGTE(v int) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldGTE(FieldPermissionID, v))
+}
+
+// PermissionIDLT applies the LT predicate on the "permission_id" field.
+func PermissionIDLT(v int) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldLT(FieldPermissionID, v))
+}
+
+// PermissionIDLTE applies the LTE predicate on the "permission_id" field.
+func PermissionIDLTE(v int) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldLTE(FieldPermissionID, v))
+}
+
+// SubjectTypeEQ applies the EQ predicate on the "subject_type" field.
+func SubjectTypeEQ(v SubjectType) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldEQ(FieldSubjectType, v))
+}
+
+// SubjectTypeNEQ applies the NEQ predicate on the "subject_type" field.
+func SubjectTypeNEQ(v SubjectType) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldNEQ(FieldSubjectType, v))
+}
+
+// SubjectTypeIn applies the In predicate on the "subject_type" field.
+func SubjectTypeIn(vs ...SubjectType) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldIn(FieldSubjectType, vs...))
+}
+
+// SubjectTypeNotIn applies the NotIn predicate on the "subject_type" field.
+func SubjectTypeNotIn(vs ...SubjectType) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldNotIn(FieldSubjectType, vs...))
+}
+
+// SubjectIDEQ applies the EQ predicate on the "subject_id" field.
+func SubjectIDEQ(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldEQ(FieldSubjectID, v))
+}
+
+// SubjectIDNEQ applies the NEQ predicate on the "subject_id" field.
+func SubjectIDNEQ(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldNEQ(FieldSubjectID, v))
+}
+
+// SubjectIDIn applies the In predicate on the "subject_id" field.
+func SubjectIDIn(vs ...string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldIn(FieldSubjectID, vs...))
+}
+
+// SubjectIDNotIn applies the NotIn predicate on the "subject_id" field.
+func SubjectIDNotIn(vs ...string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldNotIn(FieldSubjectID, vs...))
+}
+
+// SubjectIDGT applies the GT predicate on the "subject_id" field.
+func SubjectIDGT(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldGT(FieldSubjectID, v))
+}
+
+// SubjectIDGTE applies the GTE predicate on the "subject_id" field.
+func SubjectIDGTE(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldGTE(FieldSubjectID, v))
+}
+
+// SubjectIDLT applies the LT predicate on the "subject_id" field.
+func SubjectIDLT(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldLT(FieldSubjectID, v))
+}
+
+// SubjectIDLTE applies the LTE predicate on the "subject_id" field.
+func SubjectIDLTE(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldLTE(FieldSubjectID, v))
+}
+
+// SubjectIDContains applies the Contains predicate on the "subject_id" field.
+func SubjectIDContains(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldContains(FieldSubjectID, v))
+}
+
+// SubjectIDHasPrefix applies the HasPrefix predicate on the "subject_id" field.
+func SubjectIDHasPrefix(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldHasPrefix(FieldSubjectID, v))
+}
+
+// SubjectIDHasSuffix applies the HasSuffix predicate on the "subject_id" field.
+func SubjectIDHasSuffix(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldHasSuffix(FieldSubjectID, v))
+}
+
+// SubjectIDEqualFold applies the EqualFold predicate on the "subject_id" field.
+func SubjectIDEqualFold(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldEqualFold(FieldSubjectID, v))
+}
+
+// SubjectIDContainsFold applies the ContainsFold predicate on the "subject_id" field.
+func SubjectIDContainsFold(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldContainsFold(FieldSubjectID, v))
+}
+
+// ResourceTypeEQ applies the EQ predicate on the "resource_type" field.
+func ResourceTypeEQ(v ResourceType) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldEQ(FieldResourceType, v))
+}
+
+// ResourceTypeNEQ applies the NEQ predicate on the "resource_type" field.
+func ResourceTypeNEQ(v ResourceType) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldNEQ(FieldResourceType, v))
+}
+
+// ResourceTypeIn applies the In predicate on the "resource_type" field.
+func ResourceTypeIn(vs ...ResourceType) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldIn(FieldResourceType, vs...))
+}
+
+// ResourceTypeNotIn applies the NotIn predicate on the "resource_type" field.
+func ResourceTypeNotIn(vs ...ResourceType) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldNotIn(FieldResourceType, vs...))
+}
+
+// ResourceIDEQ applies the EQ predicate on the "resource_id" field.
+func ResourceIDEQ(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldEQ(FieldResourceID, v))
+}
+
+// ResourceIDNEQ applies the NEQ predicate on the "resource_id" field.
+func ResourceIDNEQ(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldNEQ(FieldResourceID, v))
+}
+
+// ResourceIDIn applies the In predicate on the "resource_id" field.
+func ResourceIDIn(vs ...string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldIn(FieldResourceID, vs...))
+}
+
+// ResourceIDNotIn applies the NotIn predicate on the "resource_id" field.
+func ResourceIDNotIn(vs ...string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldNotIn(FieldResourceID, vs...))
+}
+
+// ResourceIDGT applies the GT predicate on the "resource_id" field.
+func ResourceIDGT(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldGT(FieldResourceID, v))
+}
+
+// ResourceIDGTE applies the GTE predicate on the "resource_id" field.
+func ResourceIDGTE(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldGTE(FieldResourceID, v))
+}
+
+// ResourceIDLT applies the LT predicate on the "resource_id" field.
+func ResourceIDLT(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldLT(FieldResourceID, v))
+}
+
+// ResourceIDLTE applies the LTE predicate on the "resource_id" field.
+func ResourceIDLTE(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldLTE(FieldResourceID, v))
+}
+
+// ResourceIDContains applies the Contains predicate on the "resource_id" field.
+func ResourceIDContains(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldContains(FieldResourceID, v))
+}
+
+// ResourceIDHasPrefix applies the HasPrefix predicate on the "resource_id" field.
+func ResourceIDHasPrefix(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldHasPrefix(FieldResourceID, v))
+}
+
+// ResourceIDHasSuffix applies the HasSuffix predicate on the "resource_id" field.
+func ResourceIDHasSuffix(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldHasSuffix(FieldResourceID, v))
+}
+
+// ResourceIDEqualFold applies the EqualFold predicate on the "resource_id" field.
+func ResourceIDEqualFold(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldEqualFold(FieldResourceID, v))
+}
+
+// ResourceIDContainsFold applies the ContainsFold predicate on the "resource_id" field.
+func ResourceIDContainsFold(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldContainsFold(FieldResourceID, v))
+}
+
+// RelationEQ applies the EQ predicate on the "relation" field.
+func RelationEQ(v Relation) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldEQ(FieldRelation, v))
+}
+
+// RelationNEQ applies the NEQ predicate on the "relation" field.
+func RelationNEQ(v Relation) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldNEQ(FieldRelation, v))
+}
+
+// RelationIn applies the In predicate on the "relation" field.
+func RelationIn(vs ...Relation) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldIn(FieldRelation, vs...))
+}
+
+// RelationNotIn applies the NotIn predicate on the "relation" field.
+func RelationNotIn(vs ...Relation) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldNotIn(FieldRelation, vs...))
+}
+
+// SourceIDEQ applies the EQ predicate on the "source_id" field.
+func SourceIDEQ(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldEQ(FieldSourceID, v))
+}
+
+// SourceIDNEQ applies the NEQ predicate on the "source_id" field.
+func SourceIDNEQ(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldNEQ(FieldSourceID, v))
+}
+
+// SourceIDIn applies the In predicate on the "source_id" field.
+func SourceIDIn(vs ...string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldIn(FieldSourceID, vs...))
+}
+
+// SourceIDNotIn applies the NotIn predicate on the "source_id" field.
+func SourceIDNotIn(vs ...string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldNotIn(FieldSourceID, vs...))
+}
+
+// SourceIDGT applies the GT predicate on the "source_id" field.
+func SourceIDGT(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldGT(FieldSourceID, v))
+}
+
+// SourceIDGTE applies the GTE predicate on the "source_id" field.
+func SourceIDGTE(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldGTE(FieldSourceID, v))
+}
+
+// SourceIDLT applies the LT predicate on the "source_id" field.
+func SourceIDLT(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldLT(FieldSourceID, v))
+}
+
+// SourceIDLTE applies the LTE predicate on the "source_id" field.
+func SourceIDLTE(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldLTE(FieldSourceID, v))
+}
+
+// SourceIDContains applies the Contains predicate on the "source_id" field.
+func SourceIDContains(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldContains(FieldSourceID, v))
+}
+
+// SourceIDHasPrefix applies the HasPrefix predicate on the "source_id" field.
+func SourceIDHasPrefix(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldHasPrefix(FieldSourceID, v))
+}
+
+// SourceIDHasSuffix applies the HasSuffix predicate on the "source_id" field.
+func SourceIDHasSuffix(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldHasSuffix(FieldSourceID, v))
+}
+
+// SourceIDEqualFold applies the EqualFold predicate on the "source_id" field.
+func SourceIDEqualFold(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldEqualFold(FieldSourceID, v))
+}
+
+// SourceIDContainsFold applies the ContainsFold predicate on the "source_id" field.
+func SourceIDContainsFold(v string) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldContainsFold(FieldSourceID, v))
+}
+
+// InheritedEQ applies the EQ predicate on the "inherited" field.
+func InheritedEQ(v bool) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldEQ(FieldInherited, v))
+}
+
+// InheritedNEQ applies the NEQ predicate on the "inherited" field.
+func InheritedNEQ(v bool) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldNEQ(FieldInherited, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// ConditionsIsNil applies the IsNil predicate on the "conditions" field.
+func ConditionsIsNil() predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldIsNull(FieldConditions))
+}
+
+// ConditionsNotNil applies the NotNil predicate on the "conditions" field.
+func ConditionsNotNil() predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.FieldNotNull(FieldConditions))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AccessibleResource) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AccessibleResource) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AccessibleResource) predicate.AccessibleResource {
+	return predicate.AccessibleResource(sql.NotPredicates(p))
+}
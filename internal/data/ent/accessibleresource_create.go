@@ -0,0 +1,1294 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-paperless/internal/authz"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/accessibleresource"
+)
+
+// AccessibleResourceCreate is the builder for creating a AccessibleResource entity.
+type AccessibleResourceCreate struct {
+	config
+	mutation *AccessibleResourceMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *AccessibleResourceCreate) SetCreateTime(v time.Time) *AccessibleResourceCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *AccessibleResourceCreate) SetNillableCreateTime(v *time.Time) *AccessibleResourceCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *AccessibleResourceCreate) SetUpdateTime(v time.Time) *AccessibleResourceCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *AccessibleResourceCreate) SetNillableUpdateTime(v *time.Time) *AccessibleResourceCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *AccessibleResourceCreate) SetDeleteTime(v time.Time) *AccessibleResourceCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *AccessibleResourceCreate) SetNillableDeleteTime(v *time.Time) *AccessibleResourceCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *AccessibleResourceCreate) SetTenantID(v uint32) *AccessibleResourceCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *AccessibleResourceCreate) SetNillableTenantID(v *uint32) *AccessibleResourceCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetPermissionID sets the "permission_id" field.
+func (_c *AccessibleResourceCreate) SetPermissionID(v int) *AccessibleResourceCreate {
+	_c.mutation.SetPermissionID(v)
+	return _c
+}
+
+// SetSubjectType sets the "subject_type" field.
+func (_c *AccessibleResourceCreate) SetSubjectType(v accessibleresource.SubjectType) *AccessibleResourceCreate {
+	_c.mutation.SetSubjectType(v)
+	return _c
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (_c *AccessibleResourceCreate) SetSubjectID(v string) *AccessibleResourceCreate {
+	_c.mutation.SetSubjectID(v)
+	return _c
+}
+
+// SetResourceType sets the "resource_type" field.
+func (_c *AccessibleResourceCreate) SetResourceType(v accessibleresource.ResourceType) *AccessibleResourceCreate {
+	_c.mutation.SetResourceType(v)
+	return _c
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_c *AccessibleResourceCreate) SetResourceID(v string) *AccessibleResourceCreate {
+	_c.mutation.SetResourceID(v)
+	return _c
+}
+
+// SetRelation sets the "relation" field.
+func (_c *AccessibleResourceCreate) SetRelation(v accessibleresource.Relation) *AccessibleResourceCreate {
+	_c.mutation.SetRelation(v)
+	return _c
+}
+
+// SetSourceID sets the "source_id" field.
+func (_c *AccessibleResourceCreate) SetSourceID(v string) *AccessibleResourceCreate {
+	_c.mutation.SetSourceID(v)
+	return _c
+}
+
+// SetInherited sets the "inherited" field.
+func (_c *AccessibleResourceCreate) SetInherited(v bool) *AccessibleResourceCreate {
+	_c.mutation.SetInherited(v)
+	return _c
+}
+
+// SetNillableInherited sets the "inherited" field if the given value is not nil.
+func (_c *AccessibleResourceCreate) SetNillableInherited(v *bool) *AccessibleResourceCreate {
+	if v != nil {
+		_c.SetInherited(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *AccessibleResourceCreate) SetExpiresAt(v time.Time) *AccessibleResourceCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *AccessibleResourceCreate) SetNillableExpiresAt(v *time.Time) *AccessibleResourceCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetConditions sets the "conditions" field.
+func (_c *AccessibleResourceCreate) SetConditions(v *authz.Conditions) *AccessibleResourceCreate {
+	_c.mutation.SetConditions(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AccessibleResourceCreate) SetID(v uint32) *AccessibleResourceCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the AccessibleResourceMutation object of the builder.
+func (_c *AccessibleResourceCreate) Mutation() *AccessibleResourceMutation {
+	return _c.mutation
+}
+
+// Save creates the AccessibleResource in the database.
+func (_c *AccessibleResourceCreate) Save(ctx context.Context) (*AccessibleResource, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AccessibleResourceCreate) SaveX(ctx context.Context) *AccessibleResource {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AccessibleResourceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AccessibleResourceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AccessibleResourceCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := accessibleresource.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.Inherited(); !ok {
+		v := accessibleresource.DefaultInherited
+		_c.mutation.SetInherited(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AccessibleResourceCreate) check() error {
+	if _, ok := _c.mutation.PermissionID(); !ok {
+		return &ValidationError{Name: "permission_id", err: errors.New(`ent: missing required field "AccessibleResource.permission_id"`)}
+	}
+	if _, ok := _c.mutation.SubjectType(); !ok {
+		return &ValidationError{Name: "subject_type", err: errors.New(`ent: missing required field "AccessibleResource.subject_type"`)}
+	}
+	if v, ok := _c.mutation.SubjectType(); ok {
+		if err := accessibleresource.SubjectTypeValidator(v); err != nil {
+			return &ValidationError{Name: "subject_type", err: fmt.Errorf(`ent: validator failed for field "AccessibleResource.subject_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SubjectID(); !ok {
+		return &ValidationError{Name: "subject_id", err: errors.New(`ent: missing required field "AccessibleResource.subject_id"`)}
+	}
+	if v, ok := _c.mutation.SubjectID(); ok {
+		if err := accessibleresource.SubjectIDValidator(v); err != nil {
+			return &ValidationError{Name: "subject_id", err: fmt.Errorf(`ent: validator failed for field "AccessibleResource.subject_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ResourceType(); !ok {
+		return &ValidationError{Name: "resource_type", err: errors.New(`ent: missing required field "AccessibleResource.resource_type"`)}
+	}
+	if v, ok := _c.mutation.ResourceType(); ok {
+		if err := accessibleresource.ResourceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "resource_type", err: fmt.Errorf(`ent: validator failed for field "AccessibleResource.resource_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ResourceID(); !ok {
+		return &ValidationError{Name: "resource_id", err: errors.New(`ent: missing required field "AccessibleResource.resource_id"`)}
+	}
+	if v, ok := _c.mutation.ResourceID(); ok {
+		if err := accessibleresource.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`ent: validator failed for field "AccessibleResource.resource_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Relation(); !ok {
+		return &ValidationError{Name: "relation", err: errors.New(`ent: missing required field "AccessibleResource.relation"`)}
+	}
+	if v, ok := _c.mutation.Relation(); ok {
+		if err := accessibleresource.RelationValidator(v); err != nil {
+			return &ValidationError{Name: "relation", err: fmt.Errorf(`ent: validator failed for field "AccessibleResource.relation": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SourceID(); !ok {
+		return &ValidationError{Name: "source_id", err: errors.New(`ent: missing required field "AccessibleResource.source_id"`)}
+	}
+	if v, ok := _c.mutation.SourceID(); ok {
+		if err := accessibleresource.SourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "source_id", err: fmt.Errorf(`ent: validator failed for field "AccessibleResource.source_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Inherited(); !ok {
+		return &ValidationError{Name: "inherited", err: errors.New(`ent: missing required field "AccessibleResource.inherited"`)}
+	}
+	if v, ok := _c.mutation.Conditions(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "conditions", err: fmt.Errorf(`ent: validator failed for field "AccessibleResource.conditions": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := accessibleresource.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "AccessibleResource.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *AccessibleResourceCreate) sqlSave(ctx context.Context) (*AccessibleResource, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AccessibleResourceCreate) createSpec() (*AccessibleResource, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AccessibleResource{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(accessibleresource.Table, sqlgraph.NewFieldSpec(accessibleresource.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(accessibleresource.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(accessibleresource.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(accessibleresource.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(accessibleresource.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.PermissionID(); ok {
+		_spec.SetField(accessibleresource.FieldPermissionID, field.TypeInt, value)
+		_node.PermissionID = value
+	}
+	if value, ok := _c.mutation.SubjectType(); ok {
+		_spec.SetField(accessibleresource.FieldSubjectType, field.TypeEnum, value)
+		_node.SubjectType = value
+	}
+	if value, ok := _c.mutation.SubjectID(); ok {
+		_spec.SetField(accessibleresource.FieldSubjectID, field.TypeString, value)
+		_node.SubjectID = value
+	}
+	if value, ok := _c.mutation.ResourceType(); ok {
+		_spec.SetField(accessibleresource.FieldResourceType, field.TypeEnum, value)
+		_node.ResourceType = value
+	}
+	if value, ok := _c.mutation.ResourceID(); ok {
+		_spec.SetField(accessibleresource.FieldResourceID, field.TypeString, value)
+		_node.ResourceID = value
+	}
+	if value, ok := _c.mutation.Relation(); ok {
+		_spec.SetField(accessibleresource.FieldRelation, field.TypeEnum, value)
+		_node.Relation = value
+	}
+	if value, ok := _c.mutation.SourceID(); ok {
+		_spec.SetField(accessibleresource.FieldSourceID, field.TypeString, value)
+		_node.SourceID = value
+	}
+	if value, ok := _c.mutation.Inherited(); ok {
+		_spec.SetField(accessibleresource.FieldInherited, field.TypeBool, value)
+		_node.Inherited = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(accessibleresource.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.Conditions(); ok {
+		_spec.SetField(accessibleresource.FieldConditions, field.TypeJSON, value)
+		_node.Conditions = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AccessibleResource.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AccessibleResourceUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AccessibleResourceCreate) OnConflict(opts ...sql.ConflictOption) *AccessibleResourceUpsertOne {
+	_c.conflict = opts
+	return &AccessibleResourceUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AccessibleResource.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AccessibleResourceCreate) OnConflictColumns(columns ...string) *AccessibleResourceUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AccessibleResourceUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AccessibleResourceUpsertOne is the builder for "upsert"-ing
+	//  one AccessibleResource node.
+	AccessibleResourceUpsertOne struct {
+		create *AccessibleResourceCreate
+	}
+
+	// AccessibleResourceUpsert is the "OnConflict" setter.
+	AccessibleResourceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *AccessibleResourceUpsert) SetUpdateTime(v time.Time) *AccessibleResourceUpsert {
+	u.Set(accessibleresource.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *AccessibleResourceUpsert) UpdateUpdateTime() *AccessibleResourceUpsert {
+	u.SetExcluded(accessibleresource.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *AccessibleResourceUpsert) ClearUpdateTime() *AccessibleResourceUpsert {
+	u.SetNull(accessibleresource.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *AccessibleResourceUpsert) SetDeleteTime(v time.Time) *AccessibleResourceUpsert {
+	u.Set(accessibleresource.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *AccessibleResourceUpsert) UpdateDeleteTime() *AccessibleResourceUpsert {
+	u.SetExcluded(accessibleresource.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *AccessibleResourceUpsert) ClearDeleteTime() *AccessibleResourceUpsert {
+	u.SetNull(accessibleresource.FieldDeleteTime)
+	return u
+}
+
+// SetPermissionID sets the "permission_id" field.
+func (u *AccessibleResourceUpsert) SetPermissionID(v int) *AccessibleResourceUpsert {
+	u.Set(accessibleresource.FieldPermissionID, v)
+	return u
+}
+
+// UpdatePermissionID sets the "permission_id" field to the value that was provided on create.
+func (u *AccessibleResourceUpsert) UpdatePermissionID() *AccessibleResourceUpsert {
+	u.SetExcluded(accessibleresource.FieldPermissionID)
+	return u
+}
+
+// AddPermissionID adds v to the "permission_id" field.
+func (u *AccessibleResourceUpsert) AddPermissionID(v int) *AccessibleResourceUpsert {
+	u.Add(accessibleresource.FieldPermissionID, v)
+	return u
+}
+
+// SetSubjectType sets the "subject_type" field.
+func (u *AccessibleResourceUpsert) SetSubjectType(v accessibleresource.SubjectType) *AccessibleResourceUpsert {
+	u.Set(accessibleresource.FieldSubjectType, v)
+	return u
+}
+
+// UpdateSubjectType sets the "subject_type" field to the value that was provided on create.
+func (u *AccessibleResourceUpsert) UpdateSubjectType() *AccessibleResourceUpsert {
+	u.SetExcluded(accessibleresource.FieldSubjectType)
+	return u
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (u *AccessibleResourceUpsert) SetSubjectID(v string) *AccessibleResourceUpsert {
+	u.Set(accessibleresource.FieldSubjectID, v)
+	return u
+}
+
+// UpdateSubjectID sets the "subject_id" field to the value that was provided on create.
+func (u *AccessibleResourceUpsert) UpdateSubjectID() *AccessibleResourceUpsert {
+	u.SetExcluded(accessibleresource.FieldSubjectID)
+	return u
+}
+
+// SetResourceType sets the "resource_type" field.
+func (u *AccessibleResourceUpsert) SetResourceType(v accessibleresource.ResourceType) *AccessibleResourceUpsert {
+	u.Set(accessibleresource.FieldResourceType, v)
+	return u
+}
+
+// UpdateResourceType sets the "resource_type" field to the value that was provided on create.
+func (u *AccessibleResourceUpsert) UpdateResourceType() *AccessibleResourceUpsert {
+	u.SetExcluded(accessibleresource.FieldResourceType)
+	return u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (u *AccessibleResourceUpsert) SetResourceID(v string) *AccessibleResourceUpsert {
+	u.Set(accessibleresource.FieldResourceID, v)
+	return u
+}
+
+// UpdateResourceID sets the "resource_id" field to the value that was provided on create.
+func (u *AccessibleResourceUpsert) UpdateResourceID() *AccessibleResourceUpsert {
+	u.SetExcluded(accessibleresource.FieldResourceID)
+	return u
+}
+
+// SetRelation sets the "relation" field.
+func (u *AccessibleResourceUpsert) SetRelation(v accessibleresource.Relation) *AccessibleResourceUpsert {
+	u.Set(accessibleresource.FieldRelation, v)
+	return u
+}
+
+// UpdateRelation sets the "relation" field to the value that was provided on create.
+func (u *AccessibleResourceUpsert) UpdateRelation() *AccessibleResourceUpsert {
+	u.SetExcluded(accessibleresource.FieldRelation)
+	return u
+}
+
+// SetSourceID sets the "source_id" field.
+func (u *AccessibleResourceUpsert) SetSourceID(v string) *AccessibleResourceUpsert {
+	u.Set(accessibleresource.FieldSourceID, v)
+	return u
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *AccessibleResourceUpsert) UpdateSourceID() *AccessibleResourceUpsert {
+	u.SetExcluded(accessibleresource.FieldSourceID)
+	return u
+}
+
+// SetInherited sets the "inherited" field.
+func (u *AccessibleResourceUpsert) SetInherited(v bool) *AccessibleResourceUpsert {
+	u.Set(accessibleresource.FieldInherited, v)
+	return u
+}
+
+// UpdateInherited sets the "inherited" field to the value that was provided on create.
+func (u *AccessibleResourceUpsert) UpdateInherited() *AccessibleResourceUpsert {
+	u.SetExcluded(accessibleresource.FieldInherited)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *AccessibleResourceUpsert) SetExpiresAt(v time.Time) *AccessibleResourceUpsert {
+	u.Set(accessibleresource.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *AccessibleResourceUpsert) UpdateExpiresAt() *AccessibleResourceUpsert {
+	u.SetExcluded(accessibleresource.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *AccessibleResourceUpsert) ClearExpiresAt() *AccessibleResourceUpsert {
+	u.SetNull(accessibleresource.FieldExpiresAt)
+	return u
+}
+
+// SetConditions sets the "conditions" field.
+func (u *AccessibleResourceUpsert) SetConditions(v *authz.Conditions) *AccessibleResourceUpsert {
+	u.Set(accessibleresource.FieldConditions, v)
+	return u
+}
+
+// UpdateConditions sets the "conditions" field to the value that was provided on create.
+func (u *AccessibleResourceUpsert) UpdateConditions() *AccessibleResourceUpsert {
+	u.SetExcluded(accessibleresource.FieldConditions)
+	return u
+}
+
+// ClearConditions clears the value of the "conditions" field.
+func (u *AccessibleResourceUpsert) ClearConditions() *AccessibleResourceUpsert {
+	u.SetNull(accessibleresource.FieldConditions)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AccessibleResource.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(accessibleresource.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AccessibleResourceUpsertOne) UpdateNewValues() *AccessibleResourceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(accessibleresource.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(accessibleresource.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(accessibleresource.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AccessibleResource.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AccessibleResourceUpsertOne) Ignore() *AccessibleResourceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AccessibleResourceUpsertOne) DoNothing() *AccessibleResourceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AccessibleResourceCreate.OnConflict
+// documentation for more info.
+func (u *AccessibleResourceUpsertOne) Update(set func(*AccessibleResourceUpsert)) *AccessibleResourceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AccessibleResourceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *AccessibleResourceUpsertOne) SetUpdateTime(v time.Time) *AccessibleResourceUpsertOne {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *AccessibleResourceUpsertOne) UpdateUpdateTime() *AccessibleResourceUpsertOne {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *AccessibleResourceUpsertOne) ClearUpdateTime() *AccessibleResourceUpsertOne {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *AccessibleResourceUpsertOne) SetDeleteTime(v time.Time) *AccessibleResourceUpsertOne {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *AccessibleResourceUpsertOne) UpdateDeleteTime() *AccessibleResourceUpsertOne {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *AccessibleResourceUpsertOne) ClearDeleteTime() *AccessibleResourceUpsertOne {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetPermissionID sets the "permission_id" field.
+func (u *AccessibleResourceUpsertOne) SetPermissionID(v int) *AccessibleResourceUpsertOne {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.SetPermissionID(v)
+	})
+}
+
+// AddPermissionID adds v to the "permission_id" field.
+func (u *AccessibleResourceUpsertOne) AddPermissionID(v int) *AccessibleResourceUpsertOne {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.AddPermissionID(v)
+	})
+}
+
+// UpdatePermissionID sets the "permission_id" field to the value that was provided on create.
+func (u *AccessibleResourceUpsertOne) UpdatePermissionID() *AccessibleResourceUpsertOne {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.UpdatePermissionID()
+	})
+}
+
+// SetSubjectType sets the "subject_type" field.
+func (u *AccessibleResourceUpsertOne) SetSubjectType(v accessibleresource.SubjectType) *AccessibleResourceUpsertOne {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.SetSubjectType(v)
+	})
+}
+
+// UpdateSubjectType sets the "subject_type" field to the value that was provided on create.
+func (u *AccessibleResourceUpsertOne) UpdateSubjectType() *AccessibleResourceUpsertOne {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.UpdateSubjectType()
+	})
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (u *AccessibleResourceUpsertOne) SetSubjectID(v string) *AccessibleResourceUpsertOne {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.SetSubjectID(v)
+	})
+}
+
+// UpdateSubjectID sets the "subject_id" field to the value that was provided on create.
+func (u *AccessibleResourceUpsertOne) UpdateSubjectID() *AccessibleResourceUpsertOne {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.UpdateSubjectID()
+	})
+}
+
+// SetResourceType sets the "resource_type" field.
+func (u *AccessibleResourceUpsertOne) SetResourceType(v accessibleresource.ResourceType) *AccessibleResourceUpsertOne {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.SetResourceType(v)
+	})
+}
+
+// UpdateResourceType sets the "resource_type" field to the value that was provided on create.
+func (u *AccessibleResourceUpsertOne) UpdateResourceType() *AccessibleResourceUpsertOne {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.UpdateResourceType()
+	})
+}
+
+// SetResourceID sets the "resource_id" field.
+func (u *AccessibleResourceUpsertOne) SetResourceID(v string) *AccessibleResourceUpsertOne {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.SetResourceID(v)
+	})
+}
+
+// UpdateResourceID sets the "resource_id" field to the value that was provided on create.
+func (u *AccessibleResourceUpsertOne) UpdateResourceID() *AccessibleResourceUpsertOne {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.UpdateResourceID()
+	})
+}
+
+// SetRelation sets the "relation" field.
+func (u *AccessibleResourceUpsertOne) SetRelation(v accessibleresource.Relation) *AccessibleResourceUpsertOne {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.SetRelation(v)
+	})
+}
+
+// UpdateRelation sets the "relation" field to the value that was provided on create.
+func (u *AccessibleResourceUpsertOne) UpdateRelation() *AccessibleResourceUpsertOne {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.UpdateRelation()
+	})
+}
+
+// SetSourceID sets the "source_id" field.
+func (u *AccessibleResourceUpsertOne) SetSourceID(v string) *AccessibleResourceUpsertOne {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.SetSourceID(v)
+	})
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *AccessibleResourceUpsertOne) UpdateSourceID() *AccessibleResourceUpsertOne {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.UpdateSourceID()
+	})
+}
+
+// SetInherited sets the "inherited" field.
+func (u *AccessibleResourceUpsertOne) SetInherited(v bool) *AccessibleResourceUpsertOne {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.SetInherited(v)
+	})
+}
+
+// UpdateInherited sets the "inherited" field to the value that was provided on create.
+func (u *AccessibleResourceUpsertOne) UpdateInherited() *AccessibleResourceUpsertOne {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.UpdateInherited()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *AccessibleResourceUpsertOne) SetExpiresAt(v time.Time) *AccessibleResourceUpsertOne {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *AccessibleResourceUpsertOne) UpdateExpiresAt() *AccessibleResourceUpsertOne {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *AccessibleResourceUpsertOne) ClearExpiresAt() *AccessibleResourceUpsertOne {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetConditions sets the "conditions" field.
+func (u *AccessibleResourceUpsertOne) SetConditions(v *authz.Conditions) *AccessibleResourceUpsertOne {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.SetConditions(v)
+	})
+}
+
+// UpdateConditions sets the "conditions" field to the value that was provided on create.
+func (u *AccessibleResourceUpsertOne) UpdateConditions() *AccessibleResourceUpsertOne {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.UpdateConditions()
+	})
+}
+
+// ClearConditions clears the value of the "conditions" field.
+func (u *AccessibleResourceUpsertOne) ClearConditions() *AccessibleResourceUpsertOne {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.ClearConditions()
+	})
+}
+
+// Exec executes the query.
+func (u *AccessibleResourceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AccessibleResourceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AccessibleResourceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AccessibleResourceUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AccessibleResourceUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AccessibleResourceCreateBulk is the builder for creating many AccessibleResource entities in bulk.
+type AccessibleResourceCreateBulk struct {
+	config
+	err      error
+	builders []*AccessibleResourceCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AccessibleResource entities in the database.
+func (_c *AccessibleResourceCreateBulk) Save(ctx context.Context) ([]*AccessibleResource, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AccessibleResource, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AccessibleResourceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AccessibleResourceCreateBulk) SaveX(ctx context.Context) []*AccessibleResource {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AccessibleResourceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AccessibleResourceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AccessibleResource.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AccessibleResourceUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AccessibleResourceCreateBulk) OnConflict(opts ...sql.ConflictOption) *AccessibleResourceUpsertBulk {
+	_c.conflict = opts
+	return &AccessibleResourceUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AccessibleResource.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AccessibleResourceCreateBulk) OnConflictColumns(columns ...string) *AccessibleResourceUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AccessibleResourceUpsertBulk{
+		create: _c,
+	}
+}
+
+// AccessibleResourceUpsertBulk is the builder for "upsert"-ing
+// a bulk of AccessibleResource nodes.
+type AccessibleResourceUpsertBulk struct {
+	create *AccessibleResourceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AccessibleResource.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(accessibleresource.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AccessibleResourceUpsertBulk) UpdateNewValues() *AccessibleResourceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(accessibleresource.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(accessibleresource.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(accessibleresource.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AccessibleResource.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AccessibleResourceUpsertBulk) Ignore() *AccessibleResourceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AccessibleResourceUpsertBulk) DoNothing() *AccessibleResourceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AccessibleResourceCreateBulk.OnConflict
+// documentation for more info.
+func (u *AccessibleResourceUpsertBulk) Update(set func(*AccessibleResourceUpsert)) *AccessibleResourceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AccessibleResourceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *AccessibleResourceUpsertBulk) SetUpdateTime(v time.Time) *AccessibleResourceUpsertBulk {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *AccessibleResourceUpsertBulk) UpdateUpdateTime() *AccessibleResourceUpsertBulk {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *AccessibleResourceUpsertBulk) ClearUpdateTime() *AccessibleResourceUpsertBulk {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *AccessibleResourceUpsertBulk) SetDeleteTime(v time.Time) *AccessibleResourceUpsertBulk {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *AccessibleResourceUpsertBulk) UpdateDeleteTime() *AccessibleResourceUpsertBulk {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *AccessibleResourceUpsertBulk) ClearDeleteTime() *AccessibleResourceUpsertBulk {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetPermissionID sets the "permission_id" field.
+func (u *AccessibleResourceUpsertBulk) SetPermissionID(v int) *AccessibleResourceUpsertBulk {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.SetPermissionID(v)
+	})
+}
+
+// AddPermissionID adds v to the "permission_id" field.
+func (u *AccessibleResourceUpsertBulk) AddPermissionID(v int) *AccessibleResourceUpsertBulk {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.AddPermissionID(v)
+	})
+}
+
+// UpdatePermissionID sets the "permission_id" field to the value that was provided on create.
+func (u *AccessibleResourceUpsertBulk) UpdatePermissionID() *AccessibleResourceUpsertBulk {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.UpdatePermissionID()
+	})
+}
+
+// SetSubjectType sets the "subject_type" field.
+func (u *AccessibleResourceUpsertBulk) SetSubjectType(v accessibleresource.SubjectType) *AccessibleResourceUpsertBulk {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.SetSubjectType(v)
+	})
+}
+
+// UpdateSubjectType sets the "subject_type" field to the value that was provided on create.
+func (u *AccessibleResourceUpsertBulk) UpdateSubjectType() *AccessibleResourceUpsertBulk {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.UpdateSubjectType()
+	})
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (u *AccessibleResourceUpsertBulk) SetSubjectID(v string) *AccessibleResourceUpsertBulk {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.SetSubjectID(v)
+	})
+}
+
+// UpdateSubjectID sets the "subject_id" field to the value that was provided on create.
+func (u *AccessibleResourceUpsertBulk) UpdateSubjectID() *AccessibleResourceUpsertBulk {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.UpdateSubjectID()
+	})
+}
+
+// SetResourceType sets the "resource_type" field.
+func (u *AccessibleResourceUpsertBulk) SetResourceType(v accessibleresource.ResourceType) *AccessibleResourceUpsertBulk {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.SetResourceType(v)
+	})
+}
+
+// UpdateResourceType sets the "resource_type" field to the value that was provided on create.
+func (u *AccessibleResourceUpsertBulk) UpdateResourceType() *AccessibleResourceUpsertBulk {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.UpdateResourceType()
+	})
+}
+
+// SetResourceID sets the "resource_id" field.
+func (u *AccessibleResourceUpsertBulk) SetResourceID(v string) *AccessibleResourceUpsertBulk {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.SetResourceID(v)
+	})
+}
+
+// UpdateResourceID sets the "resource_id" field to the value that was provided on create.
+func (u *AccessibleResourceUpsertBulk) UpdateResourceID() *AccessibleResourceUpsertBulk {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.UpdateResourceID()
+	})
+}
+
+// SetRelation sets the "relation" field.
+func (u *AccessibleResourceUpsertBulk) SetRelation(v accessibleresource.Relation) *AccessibleResourceUpsertBulk {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.SetRelation(v)
+	})
+}
+
+// UpdateRelation sets the "relation" field to the value that was provided on create.
+func (u *AccessibleResourceUpsertBulk) UpdateRelation() *AccessibleResourceUpsertBulk {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.UpdateRelation()
+	})
+}
+
+// SetSourceID sets the "source_id" field.
+func (u *AccessibleResourceUpsertBulk) SetSourceID(v string) *AccessibleResourceUpsertBulk {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.SetSourceID(v)
+	})
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *AccessibleResourceUpsertBulk) UpdateSourceID() *AccessibleResourceUpsertBulk {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.UpdateSourceID()
+	})
+}
+
+// SetInherited sets the "inherited" field.
+func (u *AccessibleResourceUpsertBulk) SetInherited(v bool) *AccessibleResourceUpsertBulk {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.SetInherited(v)
+	})
+}
+
+// UpdateInherited sets the "inherited" field to the value that was provided on create.
+func (u *AccessibleResourceUpsertBulk) UpdateInherited() *AccessibleResourceUpsertBulk {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.UpdateInherited()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *AccessibleResourceUpsertBulk) SetExpiresAt(v time.Time) *AccessibleResourceUpsertBulk {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *AccessibleResourceUpsertBulk) UpdateExpiresAt() *AccessibleResourceUpsertBulk {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *AccessibleResourceUpsertBulk) ClearExpiresAt() *AccessibleResourceUpsertBulk {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetConditions sets the "conditions" field.
+func (u *AccessibleResourceUpsertBulk) SetConditions(v *authz.Conditions) *AccessibleResourceUpsertBulk {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.SetConditions(v)
+	})
+}
+
+// UpdateConditions sets the "conditions" field to the value that was provided on create.
+func (u *AccessibleResourceUpsertBulk) UpdateConditions() *AccessibleResourceUpsertBulk {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.UpdateConditions()
+	})
+}
+
+// ClearConditions clears the value of the "conditions" field.
+func (u *AccessibleResourceUpsertBulk) ClearConditions() *AccessibleResourceUpsertBulk {
+	return u.Update(func(s *AccessibleResourceUpsert) {
+		s.ClearConditions()
+	})
+}
+
+// Exec executes the query.
+func (u *AccessibleResourceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AccessibleResourceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AccessibleResourceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AccessibleResourceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/accessibleresource"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+)
+
+// AccessibleResourceDelete is the builder for deleting a AccessibleResource entity.
+type AccessibleResourceDelete struct {
+	config
+	hooks    []Hook
+	mutation *AccessibleResourceMutation
+}
+
+// Where appends a list predicates to the AccessibleResourceDelete builder.
+func (_d *AccessibleResourceDelete) Where(ps ...predicate.AccessibleResource) *AccessibleResourceDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *AccessibleResourceDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AccessibleResourceDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *AccessibleResourceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(accessibleresource.Table, sqlgraph.NewFieldSpec(accessibleresource.FieldID, field.TypeUint32))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// AccessibleResourceDeleteOne is the builder for deleting a single AccessibleResource entity.
+type AccessibleResourceDeleteOne struct {
+	_d *AccessibleResourceDelete
+}
+
+// Where appends a list predicates to the AccessibleResourceDelete builder.
+func (_d *AccessibleResourceDeleteOne) Where(ps ...predicate.AccessibleResource) *AccessibleResourceDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *AccessibleResourceDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{accessibleresource.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AccessibleResourceDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,775 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-paperless/internal/authz"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/accessibleresource"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+)
+
+// AccessibleResourceUpdate is the builder for updating AccessibleResource entities.
+type AccessibleResourceUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AccessibleResourceMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AccessibleResourceUpdate builder.
+func (_u *AccessibleResourceUpdate) Where(ps ...predicate.AccessibleResource) *AccessibleResourceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *AccessibleResourceUpdate) SetUpdateTime(v time.Time) *AccessibleResourceUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *AccessibleResourceUpdate) SetNillableUpdateTime(v *time.Time) *AccessibleResourceUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *AccessibleResourceUpdate) ClearUpdateTime() *AccessibleResourceUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *AccessibleResourceUpdate) SetDeleteTime(v time.Time) *AccessibleResourceUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *AccessibleResourceUpdate) SetNillableDeleteTime(v *time.Time) *AccessibleResourceUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *AccessibleResourceUpdate) ClearDeleteTime() *AccessibleResourceUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetPermissionID sets the "permission_id" field.
+func (_u *AccessibleResourceUpdate) SetPermissionID(v int) *AccessibleResourceUpdate {
+	_u.mutation.ResetPermissionID()
+	_u.mutation.SetPermissionID(v)
+	return _u
+}
+
+// SetNillablePermissionID sets the "permission_id" field if the given value is not nil.
+func (_u *AccessibleResourceUpdate) SetNillablePermissionID(v *int) *AccessibleResourceUpdate {
+	if v != nil {
+		_u.SetPermissionID(*v)
+	}
+	return _u
+}
+
+// AddPermissionID adds value to the "permission_id" field.
+func (_u *AccessibleResourceUpdate) AddPermissionID(v int) *AccessibleResourceUpdate {
+	_u.mutation.AddPermissionID(v)
+	return _u
+}
+
+// SetSubjectType sets the "subject_type" field.
+func (_u *AccessibleResourceUpdate) SetSubjectType(v accessibleresource.SubjectType) *AccessibleResourceUpdate {
+	_u.mutation.SetSubjectType(v)
+	return _u
+}
+
+// SetNillableSubjectType sets the "subject_type" field if the given value is not nil.
+func (_u *AccessibleResourceUpdate) SetNillableSubjectType(v *accessibleresource.SubjectType) *AccessibleResourceUpdate {
+	if v != nil {
+		_u.SetSubjectType(*v)
+	}
+	return _u
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (_u *AccessibleResourceUpdate) SetSubjectID(v string) *AccessibleResourceUpdate {
+	_u.mutation.SetSubjectID(v)
+	return _u
+}
+
+// SetNillableSubjectID sets the "subject_id" field if the given value is not nil.
+func (_u *AccessibleResourceUpdate) SetNillableSubjectID(v *string) *AccessibleResourceUpdate {
+	if v != nil {
+		_u.SetSubjectID(*v)
+	}
+	return _u
+}
+
+// SetResourceType sets the "resource_type" field.
+func (_u *AccessibleResourceUpdate) SetResourceType(v accessibleresource.ResourceType) *AccessibleResourceUpdate {
+	_u.mutation.SetResourceType(v)
+	return _u
+}
+
+// SetNillableResourceType sets the "resource_type" field if the given value is not nil.
+func (_u *AccessibleResourceUpdate) SetNillableResourceType(v *accessibleresource.ResourceType) *AccessibleResourceUpdate {
+	if v != nil {
+		_u.SetResourceType(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *AccessibleResourceUpdate) SetResourceID(v string) *AccessibleResourceUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *AccessibleResourceUpdate) SetNillableResourceID(v *string) *AccessibleResourceUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetRelation sets the "relation" field.
+func (_u *AccessibleResourceUpdate) SetRelation(v accessibleresource.Relation) *AccessibleResourceUpdate {
+	_u.mutation.SetRelation(v)
+	return _u
+}
+
+// SetNillableRelation sets the "relation" field if the given value is not nil.
+func (_u *AccessibleResourceUpdate) SetNillableRelation(v *accessibleresource.Relation) *AccessibleResourceUpdate {
+	if v != nil {
+		_u.SetRelation(*v)
+	}
+	return _u
+}
+
+// SetSourceID sets the "source_id" field.
+func (_u *AccessibleResourceUpdate) SetSourceID(v string) *AccessibleResourceUpdate {
+	_u.mutation.SetSourceID(v)
+	return _u
+}
+
+// SetNillableSourceID sets the "source_id" field if the given value is not nil.
+func (_u *AccessibleResourceUpdate) SetNillableSourceID(v *string) *AccessibleResourceUpdate {
+	if v != nil {
+		_u.SetSourceID(*v)
+	}
+	return _u
+}
+
+// SetInherited sets the "inherited" field.
+func (_u *AccessibleResourceUpdate) SetInherited(v bool) *AccessibleResourceUpdate {
+	_u.mutation.SetInherited(v)
+	return _u
+}
+
+// SetNillableInherited sets the "inherited" field if the given value is not nil.
+func (_u *AccessibleResourceUpdate) SetNillableInherited(v *bool) *AccessibleResourceUpdate {
+	if v != nil {
+		_u.SetInherited(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *AccessibleResourceUpdate) SetExpiresAt(v time.Time) *AccessibleResourceUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *AccessibleResourceUpdate) SetNillableExpiresAt(v *time.Time) *AccessibleResourceUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *AccessibleResourceUpdate) ClearExpiresAt() *AccessibleResourceUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetConditions sets the "conditions" field.
+func (_u *AccessibleResourceUpdate) SetConditions(v *authz.Conditions) *AccessibleResourceUpdate {
+	_u.mutation.SetConditions(v)
+	return _u
+}
+
+// ClearConditions clears the value of the "conditions" field.
+func (_u *AccessibleResourceUpdate) ClearConditions() *AccessibleResourceUpdate {
+	_u.mutation.ClearConditions()
+	return _u
+}
+
+// Mutation returns the AccessibleResourceMutation object of the builder.
+func (_u *AccessibleResourceUpdate) Mutation() *AccessibleResourceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AccessibleResourceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AccessibleResourceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AccessibleResourceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AccessibleResourceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AccessibleResourceUpdate) check() error {
+	if v, ok := _u.mutation.SubjectType(); ok {
+		if err := accessibleresource.SubjectTypeValidator(v); err != nil {
+			return &ValidationError{Name: "subject_type", err: fmt.Errorf(`ent: validator failed for field "AccessibleResource.subject_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SubjectID(); ok {
+		if err := accessibleresource.SubjectIDValidator(v); err != nil {
+			return &ValidationError{Name: "subject_id", err: fmt.Errorf(`ent: validator failed for field "AccessibleResource.subject_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ResourceType(); ok {
+		if err := accessibleresource.ResourceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "resource_type", err: fmt.Errorf(`ent: validator failed for field "AccessibleResource.resource_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := accessibleresource.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`ent: validator failed for field "AccessibleResource.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Relation(); ok {
+		if err := accessibleresource.RelationValidator(v); err != nil {
+			return &ValidationError{Name: "relation", err: fmt.Errorf(`ent: validator failed for field "AccessibleResource.relation": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SourceID(); ok {
+		if err := accessibleresource.SourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "source_id", err: fmt.Errorf(`ent: validator failed for field "AccessibleResource.source_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Conditions(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "conditions", err: fmt.Errorf(`ent: validator failed for field "AccessibleResource.conditions": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AccessibleResourceUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AccessibleResourceUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AccessibleResourceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accessibleresource.Table, accessibleresource.Columns, sqlgraph.NewFieldSpec(accessibleresource.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(accessibleresource.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(accessibleresource.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(accessibleresource.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(accessibleresource.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(accessibleresource.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(accessibleresource.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.PermissionID(); ok {
+		_spec.SetField(accessibleresource.FieldPermissionID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPermissionID(); ok {
+		_spec.AddField(accessibleresource.FieldPermissionID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SubjectType(); ok {
+		_spec.SetField(accessibleresource.FieldSubjectType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.SubjectID(); ok {
+		_spec.SetField(accessibleresource.FieldSubjectID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ResourceType(); ok {
+		_spec.SetField(accessibleresource.FieldResourceType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(accessibleresource.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Relation(); ok {
+		_spec.SetField(accessibleresource.FieldRelation, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.SourceID(); ok {
+		_spec.SetField(accessibleresource.FieldSourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Inherited(); ok {
+		_spec.SetField(accessibleresource.FieldInherited, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(accessibleresource.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(accessibleresource.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Conditions(); ok {
+		_spec.SetField(accessibleresource.FieldConditions, field.TypeJSON, value)
+	}
+	if _u.mutation.ConditionsCleared() {
+		_spec.ClearField(accessibleresource.FieldConditions, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accessibleresource.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AccessibleResourceUpdateOne is the builder for updating a single AccessibleResource entity.
+type AccessibleResourceUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AccessibleResourceMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *AccessibleResourceUpdateOne) SetUpdateTime(v time.Time) *AccessibleResourceUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *AccessibleResourceUpdateOne) SetNillableUpdateTime(v *time.Time) *AccessibleResourceUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *AccessibleResourceUpdateOne) ClearUpdateTime() *AccessibleResourceUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *AccessibleResourceUpdateOne) SetDeleteTime(v time.Time) *AccessibleResourceUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *AccessibleResourceUpdateOne) SetNillableDeleteTime(v *time.Time) *AccessibleResourceUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *AccessibleResourceUpdateOne) ClearDeleteTime() *AccessibleResourceUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetPermissionID sets the "permission_id" field.
+func (_u *AccessibleResourceUpdateOne) SetPermissionID(v int) *AccessibleResourceUpdateOne {
+	_u.mutation.ResetPermissionID()
+	_u.mutation.SetPermissionID(v)
+	return _u
+}
+
+// SetNillablePermissionID sets the "permission_id" field if the given value is not nil.
+func (_u *AccessibleResourceUpdateOne) SetNillablePermissionID(v *int) *AccessibleResourceUpdateOne {
+	if v != nil {
+		_u.SetPermissionID(*v)
+	}
+	return _u
+}
+
+// AddPermissionID adds value to the "permission_id" field.
+func (_u *AccessibleResourceUpdateOne) AddPermissionID(v int) *AccessibleResourceUpdateOne {
+	_u.mutation.AddPermissionID(v)
+	return _u
+}
+
+// SetSubjectType sets the "subject_type" field.
+func (_u *AccessibleResourceUpdateOne) SetSubjectType(v accessibleresource.SubjectType) *AccessibleResourceUpdateOne {
+	_u.mutation.SetSubjectType(v)
+	return _u
+}
+
+// SetNillableSubjectType sets the "subject_type" field if the given value is not nil.
+func (_u *AccessibleResourceUpdateOne) SetNillableSubjectType(v *accessibleresource.SubjectType) *AccessibleResourceUpdateOne {
+	if v != nil {
+		_u.SetSubjectType(*v)
+	}
+	return _u
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (_u *AccessibleResourceUpdateOne) SetSubjectID(v string) *AccessibleResourceUpdateOne {
+	_u.mutation.SetSubjectID(v)
+	return _u
+}
+
+// SetNillableSubjectID sets the "subject_id" field if the given value is not nil.
+func (_u *AccessibleResourceUpdateOne) SetNillableSubjectID(v *string) *AccessibleResourceUpdateOne {
+	if v != nil {
+		_u.SetSubjectID(*v)
+	}
+	return _u
+}
+
+// SetResourceType sets the "resource_type" field.
+func (_u *AccessibleResourceUpdateOne) SetResourceType(v accessibleresource.ResourceType) *AccessibleResourceUpdateOne {
+	_u.mutation.SetResourceType(v)
+	return _u
+}
+
+// SetNillableResourceType sets the "resource_type" field if the given value is not nil.
+func (_u *AccessibleResourceUpdateOne) SetNillableResourceType(v *accessibleresource.ResourceType) *AccessibleResourceUpdateOne {
+	if v != nil {
+		_u.SetResourceType(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *AccessibleResourceUpdateOne) SetResourceID(v string) *AccessibleResourceUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *AccessibleResourceUpdateOne) SetNillableResourceID(v *string) *AccessibleResourceUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetRelation sets the "relation" field.
+func (_u *AccessibleResourceUpdateOne) SetRelation(v accessibleresource.Relation) *AccessibleResourceUpdateOne {
+	_u.mutation.SetRelation(v)
+	return _u
+}
+
+// SetNillableRelation sets the "relation" field if the given value is not nil.
+func (_u *AccessibleResourceUpdateOne) SetNillableRelation(v *accessibleresource.Relation) *AccessibleResourceUpdateOne {
+	if v != nil {
+		_u.SetRelation(*v)
+	}
+	return _u
+}
+
+// SetSourceID sets the "source_id" field.
+func (_u *AccessibleResourceUpdateOne) SetSourceID(v string) *AccessibleResourceUpdateOne {
+	_u.mutation.SetSourceID(v)
+	return _u
+}
+
+// SetNillableSourceID sets the "source_id" field if the given value is not nil.
+func (_u *AccessibleResourceUpdateOne) SetNillableSourceID(v *string) *AccessibleResourceUpdateOne {
+	if v != nil {
+		_u.SetSourceID(*v)
+	}
+	return _u
+}
+
+// SetInherited sets the "inherited" field.
+func (_u *AccessibleResourceUpdateOne) SetInherited(v bool) *AccessibleResourceUpdateOne {
+	_u.mutation.SetInherited(v)
+	return _u
+}
+
+// SetNillableInherited sets the "inherited" field if the given value is not nil.
+func (_u *AccessibleResourceUpdateOne) SetNillableInherited(v *bool) *AccessibleResourceUpdateOne {
+	if v != nil {
+		_u.SetInherited(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *AccessibleResourceUpdateOne) SetExpiresAt(v time.Time) *AccessibleResourceUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *AccessibleResourceUpdateOne) SetNillableExpiresAt(v *time.Time) *AccessibleResourceUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *AccessibleResourceUpdateOne) ClearExpiresAt() *AccessibleResourceUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetConditions sets the "conditions" field.
+func (_u *AccessibleResourceUpdateOne) SetConditions(v *authz.Conditions) *AccessibleResourceUpdateOne {
+	_u.mutation.SetConditions(v)
+	return _u
+}
+
+// ClearConditions clears the value of the "conditions" field.
+func (_u *AccessibleResourceUpdateOne) ClearConditions() *AccessibleResourceUpdateOne {
+	_u.mutation.ClearConditions()
+	return _u
+}
+
+// Mutation returns the AccessibleResourceMutation object of the builder.
+func (_u *AccessibleResourceUpdateOne) Mutation() *AccessibleResourceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AccessibleResourceUpdate builder.
+func (_u *AccessibleResourceUpdateOne) Where(ps ...predicate.AccessibleResource) *AccessibleResourceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AccessibleResourceUpdateOne) Select(field string, fields ...string) *AccessibleResourceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AccessibleResource entity.
+func (_u *AccessibleResourceUpdateOne) Save(ctx context.Context) (*AccessibleResource, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AccessibleResourceUpdateOne) SaveX(ctx context.Context) *AccessibleResource {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AccessibleResourceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AccessibleResourceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AccessibleResourceUpdateOne) check() error {
+	if v, ok := _u.mutation.SubjectType(); ok {
+		if err := accessibleresource.SubjectTypeValidator(v); err != nil {
+			return &ValidationError{Name: "subject_type", err: fmt.Errorf(`ent: validator failed for field "AccessibleResource.subject_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SubjectID(); ok {
+		if err := accessibleresource.SubjectIDValidator(v); err != nil {
+			return &ValidationError{Name: "subject_id", err: fmt.Errorf(`ent: validator failed for field "AccessibleResource.subject_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ResourceType(); ok {
+		if err := accessibleresource.ResourceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "resource_type", err: fmt.Errorf(`ent: validator failed for field "AccessibleResource.resource_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := accessibleresource.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`ent: validator failed for field "AccessibleResource.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Relation(); ok {
+		if err := accessibleresource.RelationValidator(v); err != nil {
+			return &ValidationError{Name: "relation", err: fmt.Errorf(`ent: validator failed for field "AccessibleResource.relation": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SourceID(); ok {
+		if err := accessibleresource.SourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "source_id", err: fmt.Errorf(`ent: validator failed for field "AccessibleResource.source_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Conditions(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "conditions", err: fmt.Errorf(`ent: validator failed for field "AccessibleResource.conditions": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AccessibleResourceUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AccessibleResourceUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AccessibleResourceUpdateOne) sqlSave(ctx context.Context) (_node *AccessibleResource, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accessibleresource.Table, accessibleresource.Columns, sqlgraph.NewFieldSpec(accessibleresource.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AccessibleResource.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, accessibleresource.FieldID)
+		for _, f := range fields {
+			if !accessibleresource.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != accessibleresource.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(accessibleresource.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(accessibleresource.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(accessibleresource.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(accessibleresource.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(accessibleresource.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(accessibleresource.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.PermissionID(); ok {
+		_spec.SetField(accessibleresource.FieldPermissionID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPermissionID(); ok {
+		_spec.AddField(accessibleresource.FieldPermissionID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SubjectType(); ok {
+		_spec.SetField(accessibleresource.FieldSubjectType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.SubjectID(); ok {
+		_spec.SetField(accessibleresource.FieldSubjectID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ResourceType(); ok {
+		_spec.SetField(accessibleresource.FieldResourceType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(accessibleresource.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Relation(); ok {
+		_spec.SetField(accessibleresource.FieldRelation, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.SourceID(); ok {
+		_spec.SetField(accessibleresource.FieldSourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Inherited(); ok {
+		_spec.SetField(accessibleresource.FieldInherited, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(accessibleresource.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(accessibleresource.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Conditions(); ok {
+		_spec.SetField(accessibleresource.FieldConditions, field.TypeJSON, value)
+	}
+	if _u.mutation.ConditionsCleared() {
+		_spec.ClearField(accessibleresource.FieldConditions, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &AccessibleResource{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accessibleresource.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
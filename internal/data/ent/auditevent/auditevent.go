@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package auditevent
+
+import (
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the auditevent type in the database.
+	Label = "audit_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldDeleteTime holds the string denoting the delete_time field in the database.
+	FieldDeleteTime = "delete_time"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldResourceType holds the string denoting the resource_type field in the database.
+	FieldResourceType = "resource_type"
+	// FieldResourceID holds the string denoting the resource_id field in the database.
+	FieldResourceID = "resource_id"
+	// FieldResourceName holds the string denoting the resource_name field in the database.
+	FieldResourceName = "resource_name"
+	// FieldDetails holds the string denoting the details field in the database.
+	FieldDetails = "details"
+	// Table holds the table name of the auditevent in the database.
+	Table = "paperless_audit_events"
+)
+
+// Columns holds all SQL columns for auditevent fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldDeleteTime,
+	FieldTenantID,
+	FieldUserID,
+	FieldAction,
+	FieldResourceType,
+	FieldResourceID,
+	FieldResourceName,
+	FieldDetails,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/go-tangra/go-tangra-paperless/internal/data/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint32
+	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	UserIDValidator func(string) error
+	// ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	ResourceIDValidator func(string) error
+	// ResourceNameValidator is a validator for the "resource_name" field. It is called by the builders before save.
+	ResourceNameValidator func(string) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(uint32) error
+)
+
+// Action defines the type for the "action" enum field.
+type Action string
+
+// Action values.
+const (
+	ActionAUDIT_ACTION_CREATE   Action = "AUDIT_ACTION_CREATE"
+	ActionAUDIT_ACTION_UPDATE   Action = "AUDIT_ACTION_UPDATE"
+	ActionAUDIT_ACTION_MOVE     Action = "AUDIT_ACTION_MOVE"
+	ActionAUDIT_ACTION_DELETE   Action = "AUDIT_ACTION_DELETE"
+	ActionAUDIT_ACTION_DOWNLOAD Action = "AUDIT_ACTION_DOWNLOAD"
+	ActionAUDIT_ACTION_SHARE    Action = "AUDIT_ACTION_SHARE"
+	ActionAUDIT_ACTION_UNSHARE  Action = "AUDIT_ACTION_UNSHARE"
+	ActionAUDIT_ACTION_REVIEW   Action = "AUDIT_ACTION_REVIEW"
+)
+
+func (a Action) String() string {
+	return string(a)
+}
+
+// ActionValidator is a validator for the "action" field enum values. It is called by the builders before save.
+func ActionValidator(a Action) error {
+	switch a {
+	case ActionAUDIT_ACTION_CREATE, ActionAUDIT_ACTION_UPDATE, ActionAUDIT_ACTION_MOVE, ActionAUDIT_ACTION_DELETE, ActionAUDIT_ACTION_DOWNLOAD, ActionAUDIT_ACTION_SHARE, ActionAUDIT_ACTION_UNSHARE, ActionAUDIT_ACTION_REVIEW:
+		return nil
+	default:
+		return fmt.Errorf("auditevent: invalid enum value for action field: %q", a)
+	}
+}
+
+// ResourceType defines the type for the "resource_type" enum field.
+type ResourceType string
+
+// ResourceType values.
+const (
+	ResourceTypeRESOURCE_TYPE_UNSPECIFIED ResourceType = "RESOURCE_TYPE_UNSPECIFIED"
+	ResourceTypeRESOURCE_TYPE_CATEGORY    ResourceType = "RESOURCE_TYPE_CATEGORY"
+	ResourceTypeRESOURCE_TYPE_DOCUMENT    ResourceType = "RESOURCE_TYPE_DOCUMENT"
+)
+
+func (rt ResourceType) String() string {
+	return string(rt)
+}
+
+// ResourceTypeValidator is a validator for the "resource_type" field enum values. It is called by the builders before save.
+func ResourceTypeValidator(rt ResourceType) error {
+	switch rt {
+	case ResourceTypeRESOURCE_TYPE_UNSPECIFIED, ResourceTypeRESOURCE_TYPE_CATEGORY, ResourceTypeRESOURCE_TYPE_DOCUMENT:
+		return nil
+	default:
+		return fmt.Errorf("auditevent: invalid enum value for resource_type field: %q", rt)
+	}
+}
+
+// OrderOption defines the ordering options for the AuditEvent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByDeleteTime orders the results by the delete_time field.
+func ByDeleteTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeleteTime, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// ByResourceType orders the results by the resource_type field.
+func ByResourceType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResourceType, opts...).ToFunc()
+}
+
+// ByResourceID orders the results by the resource_id field.
+func ByResourceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResourceID, opts...).ToFunc()
+}
+
+// ByResourceName orders the results by the resource_name field.
+func ByResourceName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResourceName, opts...).ToFunc()
+}
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -28,6 +29,8 @@ type Category struct {
 	DeleteTime *time.Time `json:"delete_time,omitempty"`
 	// 租户ID
 	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// 版本号/乐观锁
+	Version uint32 `json:"version,omitempty"`
 	// Parent category ID (null for root-level categories)
 	ParentID *string `json:"parent_id,omitempty"`
 	// Category name
@@ -36,10 +39,36 @@ type Category struct {
 	Path string `json:"path,omitempty"`
 	// Optional description
 	Description string `json:"description,omitempty"`
+	// Display color as #RRGGBB
+	Color string `json:"color,omitempty"`
+	// Icon name shown in the category tree
+	Icon string `json:"icon,omitempty"`
 	// Nesting depth level (0 for root categories)
 	Depth int32 `json:"depth,omitempty"`
 	// Sort order within parent (lower numbers appear first)
 	SortOrder int32 `json:"sort_order,omitempty"`
+	// Documents directly in the category, maintained on document writes
+	DocumentCount int64 `json:"document_count,omitempty"`
+	// Documents in the category and its descendants, maintained on document writes
+	SubtreeDocumentCount int64 `json:"subtree_document_count,omitempty"`
+	// Total file size of the documents directly in the category, maintained on document writes
+	DocumentBytes int64 `json:"document_bytes,omitempty"`
+	// Total file size of the documents in the category and its descendants, maintained on document writes
+	SubtreeDocumentBytes int64 `json:"subtree_document_bytes,omitempty"`
+	// Most documents the category and its descendants may hold (null for no limit)
+	MaxDocuments *int64 `json:"max_documents,omitempty"`
+	// Most bytes the documents in the category and its descendants may take (null for no limit)
+	MaxBytes *int64 `json:"max_bytes,omitempty"`
+	// Tags given to documents filed or moved into the category
+	RuleTags map[string]string `json:"rule_tags,omitempty"`
+	// Document type given to documents filed or moved into the category
+	RuleDocumentType string `json:"rule_document_type,omitempty"`
+	// Retention class given to documents filed or moved into the category
+	RuleRetentionClass string `json:"rule_retention_class,omitempty"`
+	// How the subcategories are ordered
+	ChildSortMode category.ChildSortMode `json:"child_sort_mode,omitempty"`
+	// Last time documents were added to, removed from or replaced in the category or its descendants, maintained on document writes
+	ActivityTime *time.Time `json:"activity_time,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CategoryQuery when eager-loading is set.
 	Edges        CategoryEdges `json:"edges"`
@@ -104,11 +133,13 @@ func (*Category) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case category.FieldCreateBy, category.FieldTenantID, category.FieldDepth, category.FieldSortOrder:
+		case category.FieldRuleTags:
+			values[i] = new([]byte)
+		case category.FieldCreateBy, category.FieldTenantID, category.FieldVersion, category.FieldDepth, category.FieldSortOrder, category.FieldDocumentCount, category.FieldSubtreeDocumentCount, category.FieldDocumentBytes, category.FieldSubtreeDocumentBytes, category.FieldMaxDocuments, category.FieldMaxBytes:
 			values[i] = new(sql.NullInt64)
-		case category.FieldID, category.FieldParentID, category.FieldName, category.FieldPath, category.FieldDescription:
+		case category.FieldID, category.FieldParentID, category.FieldName, category.FieldPath, category.FieldDescription, category.FieldColor, category.FieldIcon, category.FieldRuleDocumentType, category.FieldRuleRetentionClass, category.FieldChildSortMode:
 			values[i] = new(sql.NullString)
-		case category.FieldCreateTime, category.FieldUpdateTime, category.FieldDeleteTime:
+		case category.FieldCreateTime, category.FieldUpdateTime, category.FieldDeleteTime, category.FieldActivityTime:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -166,6 +197,12 @@ func (_m *Category) assignValues(columns []string, values []any) error {
 				_m.TenantID = new(uint32)
 				*_m.TenantID = uint32(value.Int64)
 			}
+		case category.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = uint32(value.Int64)
+			}
 		case category.FieldParentID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
@@ -191,6 +228,18 @@ func (_m *Category) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Description = value.String
 			}
+		case category.FieldColor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field color", values[i])
+			} else if value.Valid {
+				_m.Color = value.String
+			}
+		case category.FieldIcon:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field icon", values[i])
+			} else if value.Valid {
+				_m.Icon = value.String
+			}
 		case category.FieldDepth:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field depth", values[i])
@@ -203,6 +252,77 @@ func (_m *Category) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.SortOrder = int32(value.Int64)
 			}
+		case category.FieldDocumentCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field document_count", values[i])
+			} else if value.Valid {
+				_m.DocumentCount = value.Int64
+			}
+		case category.FieldSubtreeDocumentCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field subtree_document_count", values[i])
+			} else if value.Valid {
+				_m.SubtreeDocumentCount = value.Int64
+			}
+		case category.FieldDocumentBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field document_bytes", values[i])
+			} else if value.Valid {
+				_m.DocumentBytes = value.Int64
+			}
+		case category.FieldSubtreeDocumentBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field subtree_document_bytes", values[i])
+			} else if value.Valid {
+				_m.SubtreeDocumentBytes = value.Int64
+			}
+		case category.FieldMaxDocuments:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_documents", values[i])
+			} else if value.Valid {
+				_m.MaxDocuments = new(int64)
+				*_m.MaxDocuments = value.Int64
+			}
+		case category.FieldMaxBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_bytes", values[i])
+			} else if value.Valid {
+				_m.MaxBytes = new(int64)
+				*_m.MaxBytes = value.Int64
+			}
+		case category.FieldRuleTags:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field rule_tags", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RuleTags); err != nil {
+					return fmt.Errorf("unmarshal field rule_tags: %w", err)
+				}
+			}
+		case category.FieldRuleDocumentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rule_document_type", values[i])
+			} else if value.Valid {
+				_m.RuleDocumentType = value.String
+			}
+		case category.FieldRuleRetentionClass:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rule_retention_class", values[i])
+			} else if value.Valid {
+				_m.RuleRetentionClass = value.String
+			}
+		case category.FieldChildSortMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field child_sort_mode", values[i])
+			} else if value.Valid {
+				_m.ChildSortMode = category.ChildSortMode(value.String)
+			}
+		case category.FieldActivityTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field activity_time", values[i])
+			} else if value.Valid {
+				_m.ActivityTime = new(time.Time)
+				*_m.ActivityTime = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -284,6 +404,9 @@ func (_m *Category) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
 	if v := _m.ParentID; v != nil {
 		builder.WriteString("parent_id=")
 		builder.WriteString(*v)
@@ -298,11 +421,56 @@ func (_m *Category) String() string {
 	builder.WriteString("description=")
 	builder.WriteString(_m.Description)
 	builder.WriteString(", ")
+	builder.WriteString("color=")
+	builder.WriteString(_m.Color)
+	builder.WriteString(", ")
+	builder.WriteString("icon=")
+	builder.WriteString(_m.Icon)
+	builder.WriteString(", ")
 	builder.WriteString("depth=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Depth))
 	builder.WriteString(", ")
 	builder.WriteString("sort_order=")
 	builder.WriteString(fmt.Sprintf("%v", _m.SortOrder))
+	builder.WriteString(", ")
+	builder.WriteString("document_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DocumentCount))
+	builder.WriteString(", ")
+	builder.WriteString("subtree_document_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SubtreeDocumentCount))
+	builder.WriteString(", ")
+	builder.WriteString("document_bytes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DocumentBytes))
+	builder.WriteString(", ")
+	builder.WriteString("subtree_document_bytes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SubtreeDocumentBytes))
+	builder.WriteString(", ")
+	if v := _m.MaxDocuments; v != nil {
+		builder.WriteString("max_documents=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.MaxBytes; v != nil {
+		builder.WriteString("max_bytes=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("rule_tags=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RuleTags))
+	builder.WriteString(", ")
+	builder.WriteString("rule_document_type=")
+	builder.WriteString(_m.RuleDocumentType)
+	builder.WriteString(", ")
+	builder.WriteString("rule_retention_class=")
+	builder.WriteString(_m.RuleRetentionClass)
+	builder.WriteString(", ")
+	builder.WriteString("child_sort_mode=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChildSortMode))
+	builder.WriteString(", ")
+	if v := _m.ActivityTime; v != nil {
+		builder.WriteString("activity_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,8 @@
 package category
 
 import (
+	"fmt"
+
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -23,6 +25,8 @@ const (
 	FieldDeleteTime = "delete_time"
 	// FieldTenantID holds the string denoting the tenant_id field in the database.
 	FieldTenantID = "tenant_id"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
 	// FieldParentID holds the string denoting the parent_id field in the database.
 	FieldParentID = "parent_id"
 	// FieldName holds the string denoting the name field in the database.
@@ -31,10 +35,36 @@ const (
 	FieldPath = "path"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
+	// FieldColor holds the string denoting the color field in the database.
+	FieldColor = "color"
+	// FieldIcon holds the string denoting the icon field in the database.
+	FieldIcon = "icon"
 	// FieldDepth holds the string denoting the depth field in the database.
 	FieldDepth = "depth"
 	// FieldSortOrder holds the string denoting the sort_order field in the database.
 	FieldSortOrder = "sort_order"
+	// FieldDocumentCount holds the string denoting the document_count field in the database.
+	FieldDocumentCount = "document_count"
+	// FieldSubtreeDocumentCount holds the string denoting the subtree_document_count field in the database.
+	FieldSubtreeDocumentCount = "subtree_document_count"
+	// FieldDocumentBytes holds the string denoting the document_bytes field in the database.
+	FieldDocumentBytes = "document_bytes"
+	// FieldSubtreeDocumentBytes holds the string denoting the subtree_document_bytes field in the database.
+	FieldSubtreeDocumentBytes = "subtree_document_bytes"
+	// FieldMaxDocuments holds the string denoting the max_documents field in the database.
+	FieldMaxDocuments = "max_documents"
+	// FieldMaxBytes holds the string denoting the max_bytes field in the database.
+	FieldMaxBytes = "max_bytes"
+	// FieldRuleTags holds the string denoting the rule_tags field in the database.
+	FieldRuleTags = "rule_tags"
+	// FieldRuleDocumentType holds the string denoting the rule_document_type field in the database.
+	FieldRuleDocumentType = "rule_document_type"
+	// FieldRuleRetentionClass holds the string denoting the rule_retention_class field in the database.
+	FieldRuleRetentionClass = "rule_retention_class"
+	// FieldChildSortMode holds the string denoting the child_sort_mode field in the database.
+	FieldChildSortMode = "child_sort_mode"
+	// FieldActivityTime holds the string denoting the activity_time field in the database.
+	FieldActivityTime = "activity_time"
 	// EdgeParent holds the string denoting the parent edge name in mutations.
 	EdgeParent = "parent"
 	// EdgeChildren holds the string denoting the children edge name in mutations.
@@ -77,12 +107,26 @@ var Columns = []string{
 	FieldUpdateTime,
 	FieldDeleteTime,
 	FieldTenantID,
+	FieldVersion,
 	FieldParentID,
 	FieldName,
 	FieldPath,
 	FieldDescription,
+	FieldColor,
+	FieldIcon,
 	FieldDepth,
 	FieldSortOrder,
+	FieldDocumentCount,
+	FieldSubtreeDocumentCount,
+	FieldDocumentBytes,
+	FieldSubtreeDocumentBytes,
+	FieldMaxDocuments,
+	FieldMaxBytes,
+	FieldRuleTags,
+	FieldRuleDocumentType,
+	FieldRuleRetentionClass,
+	FieldChildSortMode,
+	FieldActivityTime,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -101,24 +145,69 @@ func ValidColumn(column string) bool {
 //
 //	import _ "github.com/go-tangra/go-tangra-paperless/internal/data/ent/runtime"
 var (
-	Hooks  [1]ent.Hook
+	Hooks  [2]ent.Hook
 	Policy ent.Policy
 	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
 	DefaultTenantID uint32
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion uint32
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
 	// PathValidator is a validator for the "path" field. It is called by the builders before save.
 	PathValidator func(string) error
 	// DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
 	DescriptionValidator func(string) error
+	// ColorValidator is a validator for the "color" field. It is called by the builders before save.
+	ColorValidator func(string) error
+	// IconValidator is a validator for the "icon" field. It is called by the builders before save.
+	IconValidator func(string) error
 	// DefaultDepth holds the default value on creation for the "depth" field.
 	DefaultDepth int32
 	// DefaultSortOrder holds the default value on creation for the "sort_order" field.
 	DefaultSortOrder int32
+	// DefaultDocumentCount holds the default value on creation for the "document_count" field.
+	DefaultDocumentCount int64
+	// DefaultSubtreeDocumentCount holds the default value on creation for the "subtree_document_count" field.
+	DefaultSubtreeDocumentCount int64
+	// DefaultDocumentBytes holds the default value on creation for the "document_bytes" field.
+	DefaultDocumentBytes int64
+	// DefaultSubtreeDocumentBytes holds the default value on creation for the "subtree_document_bytes" field.
+	DefaultSubtreeDocumentBytes int64
+	// RuleDocumentTypeValidator is a validator for the "rule_document_type" field. It is called by the builders before save.
+	RuleDocumentTypeValidator func(string) error
+	// RuleRetentionClassValidator is a validator for the "rule_retention_class" field. It is called by the builders before save.
+	RuleRetentionClassValidator func(string) error
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(string) error
 )
 
+// ChildSortMode defines the type for the "child_sort_mode" enum field.
+type ChildSortMode string
+
+// ChildSortModeCATEGORY_SORT_MODE_MANUAL is the default value of the ChildSortMode enum.
+const DefaultChildSortMode = ChildSortModeCATEGORY_SORT_MODE_MANUAL
+
+// ChildSortMode values.
+const (
+	ChildSortModeCATEGORY_SORT_MODE_MANUAL          ChildSortMode = "CATEGORY_SORT_MODE_MANUAL"
+	ChildSortModeCATEGORY_SORT_MODE_ALPHABETICAL    ChildSortMode = "CATEGORY_SORT_MODE_ALPHABETICAL"
+	ChildSortModeCATEGORY_SORT_MODE_RECENT_ACTIVITY ChildSortMode = "CATEGORY_SORT_MODE_RECENT_ACTIVITY"
+)
+
+func (csm ChildSortMode) String() string {
+	return string(csm)
+}
+
+// ChildSortModeValidator is a validator for the "child_sort_mode" field enum values. It is called by the builders before save.
+func ChildSortModeValidator(csm ChildSortMode) error {
+	switch csm {
+	case ChildSortModeCATEGORY_SORT_MODE_MANUAL, ChildSortModeCATEGORY_SORT_MODE_ALPHABETICAL, ChildSortModeCATEGORY_SORT_MODE_RECENT_ACTIVITY:
+		return nil
+	default:
+		return fmt.Errorf("category: invalid enum value for child_sort_mode field: %q", csm)
+	}
+}
+
 // OrderOption defines the ordering options for the Category queries.
 type OrderOption func(*sql.Selector)
 
@@ -152,6 +241,11 @@ func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
 }
 
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
 // ByParentID orders the results by the parent_id field.
 func ByParentID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldParentID, opts...).ToFunc()
@@ -172,6 +266,16 @@ func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
 }
 
+// ByColor orders the results by the color field.
+func ByColor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColor, opts...).ToFunc()
+}
+
+// ByIcon orders the results by the icon field.
+func ByIcon(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIcon, opts...).ToFunc()
+}
+
 // ByDepth orders the results by the depth field.
 func ByDepth(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDepth, opts...).ToFunc()
@@ -182,6 +286,56 @@ func BySortOrder(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSortOrder, opts...).ToFunc()
 }
 
+// ByDocumentCount orders the results by the document_count field.
+func ByDocumentCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDocumentCount, opts...).ToFunc()
+}
+
+// BySubtreeDocumentCount orders the results by the subtree_document_count field.
+func BySubtreeDocumentCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubtreeDocumentCount, opts...).ToFunc()
+}
+
+// ByDocumentBytes orders the results by the document_bytes field.
+func ByDocumentBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDocumentBytes, opts...).ToFunc()
+}
+
+// BySubtreeDocumentBytes orders the results by the subtree_document_bytes field.
+func BySubtreeDocumentBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubtreeDocumentBytes, opts...).ToFunc()
+}
+
+// ByMaxDocuments orders the results by the max_documents field.
+func ByMaxDocuments(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxDocuments, opts...).ToFunc()
+}
+
+// ByMaxBytes orders the results by the max_bytes field.
+func ByMaxBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxBytes, opts...).ToFunc()
+}
+
+// ByRuleDocumentType orders the results by the rule_document_type field.
+func ByRuleDocumentType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRuleDocumentType, opts...).ToFunc()
+}
+
+// ByRuleRetentionClass orders the results by the rule_retention_class field.
+func ByRuleRetentionClass(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRuleRetentionClass, opts...).ToFunc()
+}
+
+// ByChildSortMode orders the results by the child_sort_mode field.
+func ByChildSortMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChildSortMode, opts...).ToFunc()
+}
+
+// ByActivityTime orders the results by the activity_time field.
+func ByActivityTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActivityTime, opts...).ToFunc()
+}
+
 // ByParentField orders the results by parent field.
 func ByParentField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -90,6 +90,11 @@ func TenantID(v uint32) predicate.Category {
 	return predicate.Category(sql.FieldEQ(FieldTenantID, v))
 }
 
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v uint32) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldVersion, v))
+}
+
 // ParentID applies equality check predicate on the "parent_id" field. It's identical to ParentIDEQ.
 func ParentID(v string) predicate.Category {
 	return predicate.Category(sql.FieldEQ(FieldParentID, v))
@@ -110,6 +115,16 @@ func Description(v string) predicate.Category {
 	return predicate.Category(sql.FieldEQ(FieldDescription, v))
 }
 
+// Color applies equality check predicate on the "color" field. It's identical to ColorEQ.
+func Color(v string) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldColor, v))
+}
+
+// Icon applies equality check predicate on the "icon" field. It's identical to IconEQ.
+func Icon(v string) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldIcon, v))
+}
+
 // Depth applies equality check predicate on the "depth" field. It's identical to DepthEQ.
 func Depth(v int32) predicate.Category {
 	return predicate.Category(sql.FieldEQ(FieldDepth, v))
@@ -120,6 +135,51 @@ func SortOrder(v int32) predicate.Category {
 	return predicate.Category(sql.FieldEQ(FieldSortOrder, v))
 }
 
+// DocumentCount applies equality check predicate on the "document_count" field. It's identical to DocumentCountEQ.
+func DocumentCount(v int64) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldDocumentCount, v))
+}
+
+// SubtreeDocumentCount applies equality check predicate on the "subtree_document_count" field. It's identical to SubtreeDocumentCountEQ.
+func SubtreeDocumentCount(v int64) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldSubtreeDocumentCount, v))
+}
+
+// DocumentBytes applies equality check predicate on the "document_bytes" field. It's identical to DocumentBytesEQ.
+func DocumentBytes(v int64) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldDocumentBytes, v))
+}
+
+// SubtreeDocumentBytes applies equality check predicate on the "subtree_document_bytes" field. It's identical to SubtreeDocumentBytesEQ.
+func SubtreeDocumentBytes(v int64) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldSubtreeDocumentBytes, v))
+}
+
+// MaxDocuments applies equality check predicate on the "max_documents" field. It's identical to MaxDocumentsEQ.
+func MaxDocuments(v int64) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldMaxDocuments, v))
+}
+
+// MaxBytes applies equality check predicate on the "max_bytes" field. It's identical to MaxBytesEQ.
+func MaxBytes(v int64) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldMaxBytes, v))
+}
+
+// RuleDocumentType applies equality check predicate on the "rule_document_type" field. It's identical to RuleDocumentTypeEQ.
+func RuleDocumentType(v string) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldRuleDocumentType, v))
+}
+
+// RuleRetentionClass applies equality check predicate on the "rule_retention_class" field. It's identical to RuleRetentionClassEQ.
+func RuleRetentionClass(v string) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldRuleRetentionClass, v))
+}
+
+// ActivityTime applies equality check predicate on the "activity_time" field. It's identical to ActivityTimeEQ.
+func ActivityTime(v time.Time) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldActivityTime, v))
+}
+
 // CreateByEQ applies the EQ predicate on the "create_by" field.
 func CreateByEQ(v uint32) predicate.Category {
 	return predicate.Category(sql.FieldEQ(FieldCreateBy, v))
@@ -370,6 +430,46 @@ func TenantIDNotNil() predicate.Category {
 	return predicate.Category(sql.FieldNotNull(FieldTenantID))
 }
 
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v uint32) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v uint32) predicate.Category {
+	return predicate.Category(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...uint32) predicate.Category {
+	return predicate.Category(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...uint32) predicate.Category {
+	return predicate.Category(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v uint32) predicate.Category {
+	return predicate.Category(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v uint32) predicate.Category {
+	return predicate.Category(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v uint32) predicate.Category {
+	return predicate.Category(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v uint32) predicate.Category {
+	return predicate.Category(sql.FieldLTE(FieldVersion, v))
+}
+
 // ParentIDEQ applies the EQ predicate on the "parent_id" field.
 func ParentIDEQ(v string) predicate.Category {
 	return predicate.Category(sql.FieldEQ(FieldParentID, v))
@@ -650,6 +750,156 @@ func DescriptionContainsFold(v string) predicate.Category {
 	return predicate.Category(sql.FieldContainsFold(FieldDescription, v))
 }
 
+// ColorEQ applies the EQ predicate on the "color" field.
+func ColorEQ(v string) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldColor, v))
+}
+
+// ColorNEQ applies the NEQ predicate on the "color" field.
+func ColorNEQ(v string) predicate.Category {
+	return predicate.Category(sql.FieldNEQ(FieldColor, v))
+}
+
+// ColorIn applies the In predicate on the "color" field.
+func ColorIn(vs ...string) predicate.Category {
+	return predicate.Category(sql.FieldIn(FieldColor, vs...))
+}
+
+// ColorNotIn applies the NotIn predicate on the "color" field.
+func ColorNotIn(vs ...string) predicate.Category {
+	return predicate.Category(sql.FieldNotIn(FieldColor, vs...))
+}
+
+// ColorGT applies the GT predicate on the "color" field.
+func ColorGT(v string) predicate.Category {
+	return predicate.Category(sql.FieldGT(FieldColor, v))
+}
+
+// ColorGTE applies the GTE predicate on the "color" field.
+func ColorGTE(v string) predicate.Category {
+	return predicate.Category(sql.FieldGTE(FieldColor, v))
+}
+
+// ColorLT applies the LT predicate on the "color" field.
+func ColorLT(v string) predicate.Category {
+	return predicate.Category(sql.FieldLT(FieldColor, v))
+}
+
+// ColorLTE applies the LTE predicate on the "color" field.
+func ColorLTE(v string) predicate.Category {
+	return predicate.Category(sql.FieldLTE(FieldColor, v))
+}
+
+// ColorContains applies the Contains predicate on the "color" field.
+func ColorContains(v string) predicate.Category {
+	return predicate.Category(sql.FieldContains(FieldColor, v))
+}
+
+// ColorHasPrefix applies the HasPrefix predicate on the "color" field.
+func ColorHasPrefix(v string) predicate.Category {
+	return predicate.Category(sql.FieldHasPrefix(FieldColor, v))
+}
+
+// ColorHasSuffix applies the HasSuffix predicate on the "color" field.
+func ColorHasSuffix(v string) predicate.Category {
+	return predicate.Category(sql.FieldHasSuffix(FieldColor, v))
+}
+
+// ColorIsNil applies the IsNil predicate on the "color" field.
+func ColorIsNil() predicate.Category {
+	return predicate.Category(sql.FieldIsNull(FieldColor))
+}
+
+// ColorNotNil applies the NotNil predicate on the "color" field.
+func ColorNotNil() predicate.Category {
+	return predicate.Category(sql.FieldNotNull(FieldColor))
+}
+
+// ColorEqualFold applies the EqualFold predicate on the "color" field.
+func ColorEqualFold(v string) predicate.Category {
+	return predicate.Category(sql.FieldEqualFold(FieldColor, v))
+}
+
+// ColorContainsFold applies the ContainsFold predicate on the "color" field.
+func ColorContainsFold(v string) predicate.Category {
+	return predicate.Category(sql.FieldContainsFold(FieldColor, v))
+}
+
+// IconEQ applies the EQ predicate on the "icon" field.
+func IconEQ(v string) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldIcon, v))
+}
+
+// IconNEQ applies the NEQ predicate on the "icon" field.
+func IconNEQ(v string) predicate.Category {
+	return predicate.Category(sql.FieldNEQ(FieldIcon, v))
+}
+
+// IconIn applies the In predicate on the "icon" field.
+func IconIn(vs ...string) predicate.Category {
+	return predicate.Category(sql.FieldIn(FieldIcon, vs...))
+}
+
+// IconNotIn applies the NotIn predicate on the "icon" field.
+func IconNotIn(vs ...string) predicate.Category {
+	return predicate.Category(sql.FieldNotIn(FieldIcon, vs...))
+}
+
+// IconGT applies the GT predicate on the "icon" field.
+func IconGT(v string) predicate.Category {
+	return predicate.Category(sql.FieldGT(FieldIcon, v))
+}
+
+// IconGTE applies the GTE predicate on the "icon" field.
+func IconGTE(v string) predicate.Category {
+	return predicate.Category(sql.FieldGTE(FieldIcon, v))
+}
+
+// IconLT applies the LT predicate on the "icon" field.
+func IconLT(v string) predicate.Category {
+	return predicate.Category(sql.FieldLT(FieldIcon, v))
+}
+
+// IconLTE applies the LTE predicate on the "icon" field.
+func IconLTE(v string) predicate.Category {
+	return predicate.Category(sql.FieldLTE(FieldIcon, v))
+}
+
+// IconContains applies the Contains predicate on the "icon" field.
+func IconContains(v string) predicate.Category {
+	return predicate.Category(sql.FieldContains(FieldIcon, v))
+}
+
+// IconHasPrefix applies the HasPrefix predicate on the "icon" field.
+func IconHasPrefix(v string) predicate.Category {
+	return predicate.Category(sql.FieldHasPrefix(FieldIcon, v))
+}
+
+// IconHasSuffix applies the HasSuffix predicate on the "icon" field.
+func IconHasSuffix(v string) predicate.Category {
+	return predicate.Category(sql.FieldHasSuffix(FieldIcon, v))
+}
+
+// IconIsNil applies the IsNil predicate on the "icon" field.
+func IconIsNil() predicate.Category {
+	return predicate.Category(sql.FieldIsNull(FieldIcon))
+}
+
+// IconNotNil applies the NotNil predicate on the "icon" field.
+func IconNotNil() predicate.Category {
+	return predicate.Category(sql.FieldNotNull(FieldIcon))
+}
+
+// IconEqualFold applies the EqualFold predicate on the "icon" field.
+func IconEqualFold(v string) predicate.Category {
+	return predicate.Category(sql.FieldEqualFold(FieldIcon, v))
+}
+
+// IconContainsFold applies the ContainsFold predicate on the "icon" field.
+func IconContainsFold(v string) predicate.Category {
+	return predicate.Category(sql.FieldContainsFold(FieldIcon, v))
+}
+
 // DepthEQ applies the EQ predicate on the "depth" field.
 func DepthEQ(v int32) predicate.Category {
 	return predicate.Category(sql.FieldEQ(FieldDepth, v))
@@ -730,6 +980,496 @@ func SortOrderLTE(v int32) predicate.Category {
 	return predicate.Category(sql.FieldLTE(FieldSortOrder, v))
 }
 
+// DocumentCountEQ applies the EQ predicate on the "document_count" field.
+func DocumentCountEQ(v int64) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldDocumentCount, v))
+}
+
+// DocumentCountNEQ applies the NEQ predicate on the "document_count" field.
+func DocumentCountNEQ(v int64) predicate.Category {
+	return predicate.Category(sql.FieldNEQ(FieldDocumentCount, v))
+}
+
+// DocumentCountIn applies the In predicate on the "document_count" field.
+func DocumentCountIn(vs ...int64) predicate.Category {
+	return predicate.Category(sql.FieldIn(FieldDocumentCount, vs...))
+}
+
+// DocumentCountNotIn applies the NotIn predicate on the "document_count" field.
+func DocumentCountNotIn(vs ...int64) predicate.Category {
+	return predicate.Category(sql.FieldNotIn(FieldDocumentCount, vs...))
+}
+
+// DocumentCountGT applies the GT predicate on the "document_count" field.
+func DocumentCountGT(v int64) predicate.Category {
+	return predicate.Category(sql.FieldGT(FieldDocumentCount, v))
+}
+
+// DocumentCountGTE applies the GTE predicate on the "document_count" field.
+func DocumentCountGTE(v int64) predicate.Category {
+	return predicate.Category(sql.FieldGTE(FieldDocumentCount, v))
+}
+
+// DocumentCountLT applies the LT predicate on the "document_count" field.
+func DocumentCountLT(v int64) predicate.Category {
+	return predicate.Category(sql.FieldLT(FieldDocumentCount, v))
+}
+
+// DocumentCountLTE applies the LTE predicate on the "document_count" field.
+func DocumentCountLTE(v int64) predicate.Category {
+	return predicate.Category(sql.FieldLTE(FieldDocumentCount, v))
+}
+
+// SubtreeDocumentCountEQ applies the EQ predicate on the "subtree_document_count" field.
+func SubtreeDocumentCountEQ(v int64) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldSubtreeDocumentCount, v))
+}
+
+// SubtreeDocumentCountNEQ applies the NEQ predicate on the "subtree_document_count" field.
+func SubtreeDocumentCountNEQ(v int64) predicate.Category {
+	return predicate.Category(sql.FieldNEQ(FieldSubtreeDocumentCount, v))
+}
+
+// SubtreeDocumentCountIn applies the In predicate on the "subtree_document_count" field.
+func SubtreeDocumentCountIn(vs ...int64) predicate.Category {
+	return predicate.Category(sql.FieldIn(FieldSubtreeDocumentCount, vs...))
+}
+
+// SubtreeDocumentCountNotIn applies the NotIn predicate on the "subtree_document_count" field.
+func SubtreeDocumentCountNotIn(vs ...int64) predicate.Category {
+	return predicate.Category(sql.FieldNotIn(FieldSubtreeDocumentCount, vs...))
+}
+
+// SubtreeDocumentCountGT applies the GT predicate on the "subtree_document_count" field.
+func SubtreeDocumentCountGT(v int64) predicate.Category {
+	return predicate.Category(sql.FieldGT(FieldSubtreeDocumentCount, v))
+}
+
+// SubtreeDocumentCountGTE applies the GTE predicate on the "subtree_document_count" field.
+func SubtreeDocumentCountGTE(v int64) predicate.Category {
+	return predicate.Category(sql.FieldGTE(FieldSubtreeDocumentCount, v))
+}
+
+// SubtreeDocumentCountLT applies the LT predicate on the "subtree_document_count" field.
+func SubtreeDocumentCountLT(v int64) predicate.Category {
+	return predicate.Category(sql.FieldLT(FieldSubtreeDocumentCount, v))
+}
+
+// SubtreeDocumentCountLTE applies the LTE predicate on the "subtree_document_count" field.
+func SubtreeDocumentCountLTE(v int64) predicate.Category {
+	return predicate.Category(sql.FieldLTE(FieldSubtreeDocumentCount, v))
+}
+
+// DocumentBytesEQ applies the EQ predicate on the "document_bytes" field.
+func DocumentBytesEQ(v int64) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldDocumentBytes, v))
+}
+
+// DocumentBytesNEQ applies the NEQ predicate on the "document_bytes" field.
+func DocumentBytesNEQ(v int64) predicate.Category {
+	return predicate.Category(sql.FieldNEQ(FieldDocumentBytes, v))
+}
+
+// DocumentBytesIn applies the In predicate on the "document_bytes" field.
+func DocumentBytesIn(vs ...int64) predicate.Category {
+	return predicate.Category(sql.FieldIn(FieldDocumentBytes, vs...))
+}
+
+// DocumentBytesNotIn applies the NotIn predicate on the "document_bytes" field.
+func DocumentBytesNotIn(vs ...int64) predicate.Category {
+	return predicate.Category(sql.FieldNotIn(FieldDocumentBytes, vs...))
+}
+
+// DocumentBytesGT applies the GT predicate on the "document_bytes" field.
+func DocumentBytesGT(v int64) predicate.Category {
+	return predicate.Category(sql.FieldGT(FieldDocumentBytes, v))
+}
+
+// DocumentBytesGTE applies the GTE predicate on the "document_bytes" field.
+func DocumentBytesGTE(v int64) predicate.Category {
+	return predicate.Category(sql.FieldGTE(FieldDocumentBytes, v))
+}
+
+// DocumentBytesLT applies the LT predicate on the "document_bytes" field.
+func DocumentBytesLT(v int64) predicate.Category {
+	return predicate.Category(sql.FieldLT(FieldDocumentBytes, v))
+}
+
+// DocumentBytesLTE applies the LTE predicate on the "document_bytes" field.
+func DocumentBytesLTE(v int64) predicate.Category {
+	return predicate.Category(sql.FieldLTE(FieldDocumentBytes, v))
+}
+
+// SubtreeDocumentBytesEQ applies the EQ predicate on the "subtree_document_bytes" field.
+func SubtreeDocumentBytesEQ(v int64) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldSubtreeDocumentBytes, v))
+}
+
+// SubtreeDocumentBytesNEQ applies the NEQ predicate on the "subtree_document_bytes" field.
+func SubtreeDocumentBytesNEQ(v int64) predicate.Category {
+	return predicate.Category(sql.FieldNEQ(FieldSubtreeDocumentBytes, v))
+}
+
+// SubtreeDocumentBytesIn applies the In predicate on the "subtree_document_bytes" field.
+func SubtreeDocumentBytesIn(vs ...int64) predicate.Category {
+	return predicate.Category(sql.FieldIn(FieldSubtreeDocumentBytes, vs...))
+}
+
+// SubtreeDocumentBytesNotIn applies the NotIn predicate on the "subtree_document_bytes" field.
+func SubtreeDocumentBytesNotIn(vs ...int64) predicate.Category {
+	return predicate.Category(sql.FieldNotIn(FieldSubtreeDocumentBytes, vs...))
+}
+
+// SubtreeDocumentBytesGT applies the GT predicate on the "subtree_document_bytes" field.
+func SubtreeDocumentBytesGT(v int64) predicate.Category {
+	return predicate.Category(sql.FieldGT(FieldSubtreeDocumentBytes, v))
+}
+
+// SubtreeDocumentBytesGTE applies the GTE predicate on the "subtree_document_bytes" field.
+func SubtreeDocumentBytesGTE(v int64) predicate.Category {
+	return predicate.Category(sql.FieldGTE(FieldSubtreeDocumentBytes, v))
+}
+
+// SubtreeDocumentBytesLT applies the LT predicate on the "subtree_document_bytes" field.
+func SubtreeDocumentBytesLT(v int64) predicate.Category {
+	return predicate.Category(sql.FieldLT(FieldSubtreeDocumentBytes, v))
+}
+
+// SubtreeDocumentBytesLTE applies the LTE predicate on the "subtree_document_bytes" field.
+func SubtreeDocumentBytesLTE(v int64) predicate.Category {
+	return predicate.Category(sql.FieldLTE(FieldSubtreeDocumentBytes, v))
+}
+
+// MaxDocumentsEQ applies the EQ predicate on the "max_documents" field.
+func MaxDocumentsEQ(v int64) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldMaxDocuments, v))
+}
+
+// MaxDocumentsNEQ applies the NEQ predicate on the "max_documents" field.
+func MaxDocumentsNEQ(v int64) predicate.Category {
+	return predicate.Category(sql.FieldNEQ(FieldMaxDocuments, v))
+}
+
+// MaxDocumentsIn applies the In predicate on the "max_documents" field.
+func MaxDocumentsIn(vs ...int64) predicate.Category {
+	return predicate.Category(sql.FieldIn(FieldMaxDocuments, vs...))
+}
+
+// MaxDocumentsNotIn applies the NotIn predicate on the "max_documents" field.
+func MaxDocumentsNotIn(vs ...int64) predicate.Category {
+	return predicate.Category(sql.FieldNotIn(FieldMaxDocuments, vs...))
+}
+
+// MaxDocumentsGT applies the GT predicate on the "max_documents" field.
+func MaxDocumentsGT(v int64) predicate.Category {
+	return predicate.Category(sql.FieldGT(FieldMaxDocuments, v))
+}
+
+// MaxDocumentsGTE applies the GTE predicate on the "max_documents" field.
+func MaxDocumentsGTE(v int64) predicate.Category {
+	return predicate.Category(sql.FieldGTE(FieldMaxDocuments, v))
+}
+
+// MaxDocumentsLT applies the LT predicate on the "max_documents" field.
+func MaxDocumentsLT(v int64) predicate.Category {
+	return predicate.Category(sql.FieldLT(FieldMaxDocuments, v))
+}
+
+// MaxDocumentsLTE applies the LTE predicate on the "max_documents" field.
+func MaxDocumentsLTE(v int64) predicate.Category {
+	return predicate.Category(sql.FieldLTE(FieldMaxDocuments, v))
+}
+
+// MaxDocumentsIsNil applies the IsNil predicate on the "max_documents" field.
+func MaxDocumentsIsNil() predicate.Category {
+	return predicate.Category(sql.FieldIsNull(FieldMaxDocuments))
+}
+
+// MaxDocumentsNotNil applies the NotNil predicate on the "max_documents" field.
+func MaxDocumentsNotNil() predicate.Category {
+	return predicate.Category(sql.FieldNotNull(FieldMaxDocuments))
+}
+
+// MaxBytesEQ applies the EQ predicate on the "max_bytes" field.
+func MaxBytesEQ(v int64) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldMaxBytes, v))
+}
+
+// MaxBytesNEQ applies the NEQ predicate on the "max_bytes" field.
+func MaxBytesNEQ(v int64) predicate.Category {
+	return predicate.Category(sql.FieldNEQ(FieldMaxBytes, v))
+}
+
+// MaxBytesIn applies the In predicate on the "max_bytes" field.
+func MaxBytesIn(vs ...int64) predicate.Category {
+	return predicate.Category(sql.FieldIn(FieldMaxBytes, vs...))
+}
+
+// MaxBytesNotIn applies the NotIn predicate on the "max_bytes" field.
+func MaxBytesNotIn(vs ...int64) predicate.Category {
+	return predicate.Category(sql.FieldNotIn(FieldMaxBytes, vs...))
+}
+
+// MaxBytesGT applies the GT predicate on the "max_bytes" field.
+func MaxBytesGT(v int64) predicate.Category {
+	return predicate.Category(sql.FieldGT(FieldMaxBytes, v))
+}
+
+// MaxBytesGTE applies the GTE predicate on the "max_bytes" field.
+func MaxBytesGTE(v int64) predicate.Category {
+	return predicate.Category(sql.FieldGTE(FieldMaxBytes, v))
+}
+
+// MaxBytesLT applies the LT predicate on the "max_bytes" field.
+func MaxBytesLT(v int64) predicate.Category {
+	return predicate.Category(sql.FieldLT(FieldMaxBytes, v))
+}
+
+// MaxBytesLTE applies the LTE predicate on the "max_bytes" field.
+func MaxBytesLTE(v int64) predicate.Category {
+	return predicate.Category(sql.FieldLTE(FieldMaxBytes, v))
+}
+
+// MaxBytesIsNil applies the IsNil predicate on the "max_bytes" field.
+func MaxBytesIsNil() predicate.Category {
+	return predicate.Category(sql.FieldIsNull(FieldMaxBytes))
+}
+
+// MaxBytesNotNil applies the NotNil predicate on the "max_bytes" field.
+func MaxBytesNotNil() predicate.Category {
+	return predicate.Category(sql.FieldNotNull(FieldMaxBytes))
+}
+
+// RuleTagsIsNil applies the IsNil predicate on the "rule_tags" field.
+func RuleTagsIsNil() predicate.Category {
+	return predicate.Category(sql.FieldIsNull(FieldRuleTags))
+}
+
+// RuleTagsNotNil applies the NotNil predicate on the "rule_tags" field.
+func RuleTagsNotNil() predicate.Category {
+	return predicate.Category(sql.FieldNotNull(FieldRuleTags))
+}
+
+// RuleDocumentTypeEQ applies the EQ predicate on the "rule_document_type" field.
+func RuleDocumentTypeEQ(v string) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldRuleDocumentType, v))
+}
+
+// RuleDocumentTypeNEQ applies the NEQ predicate on the "rule_document_type" field.
+func RuleDocumentTypeNEQ(v string) predicate.Category {
+	return predicate.Category(sql.FieldNEQ(FieldRuleDocumentType, v))
+}
+
+// RuleDocumentTypeIn applies the In predicate on the "rule_document_type" field.
+func RuleDocumentTypeIn(vs ...string) predicate.Category {
+	return predicate.Category(sql.FieldIn(FieldRuleDocumentType, vs...))
+}
+
+// RuleDocumentTypeNotIn applies the NotIn predicate on the "rule_document_type" field.
+func RuleDocumentTypeNotIn(vs ...string) predicate.Category {
+	return predicate.Category(sql.FieldNotIn(FieldRuleDocumentType, vs...))
+}
+
+// RuleDocumentTypeGT applies the GT predicate on the "rule_document_type" field.
+func RuleDocumentTypeGT(v string) predicate.Category {
+	return predicate.Category(sql.FieldGT(FieldRuleDocumentType, v))
+}
+
+// RuleDocumentTypeGTE applies the GTE predicate on the "rule_document_type" field.
+func RuleDocumentTypeGTE(v string) predicate.Category {
+	return predicate.Category(sql.FieldGTE(FieldRuleDocumentType, v))
+}
+
+// RuleDocumentTypeLT applies the LT predicate on the "rule_document_type" field.
+func RuleDocumentTypeLT(v string) predicate.Category {
+	return predicate.Category(sql.FieldLT(FieldRuleDocumentType, v))
+}
+
+// RuleDocumentTypeLTE applies the LTE predicate on the "rule_document_type" field.
+func RuleDocumentTypeLTE(v string) predicate.Category {
+	return predicate.Category(sql.FieldLTE(FieldRuleDocumentType, v))
+}
+
+// RuleDocumentTypeContains applies the Contains predicate on the "rule_document_type" field.
+func RuleDocumentTypeContains(v string) predicate.Category {
+	return predicate.Category(sql.FieldContains(FieldRuleDocumentType, v))
+}
+
+// RuleDocumentTypeHasPrefix applies the HasPrefix predicate on the "rule_document_type" field.
+func RuleDocumentTypeHasPrefix(v string) predicate.Category {
+	return predicate.Category(sql.FieldHasPrefix(FieldRuleDocumentType, v))
+}
+
+// RuleDocumentTypeHasSuffix applies the HasSuffix predicate on the "rule_document_type" field.
+func RuleDocumentTypeHasSuffix(v string) predicate.Category {
+	return predicate.Category(sql.FieldHasSuffix(FieldRuleDocumentType, v))
+}
+
+// RuleDocumentTypeIsNil applies the IsNil predicate on the "rule_document_type" field.
+func RuleDocumentTypeIsNil() predicate.Category {
+	return predicate.Category(sql.FieldIsNull(FieldRuleDocumentType))
+}
+
+// RuleDocumentTypeNotNil applies the NotNil predicate on the "rule_document_type" field.
+func RuleDocumentTypeNotNil() predicate.Category {
+	return predicate.Category(sql.FieldNotNull(FieldRuleDocumentType))
+}
+
+// RuleDocumentTypeEqualFold applies the EqualFold predicate on the "rule_document_type" field.
+func RuleDocumentTypeEqualFold(v string) predicate.Category {
+	return predicate.Category(sql.FieldEqualFold(FieldRuleDocumentType, v))
+}
+
+// RuleDocumentTypeContainsFold applies the ContainsFold predicate on the "rule_document_type" field.
+func RuleDocumentTypeContainsFold(v string) predicate.Category {
+	return predicate.Category(sql.FieldContainsFold(FieldRuleDocumentType, v))
+}
+
+// RuleRetentionClassEQ applies the EQ predicate on the "rule_retention_class" field.
+func RuleRetentionClassEQ(v string) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldRuleRetentionClass, v))
+}
+
+// RuleRetentionClassNEQ applies the NEQ predicate on the "rule_retention_class" field.
+func RuleRetentionClassNEQ(v string) predicate.Category {
+	return predicate.Category(sql.FieldNEQ(FieldRuleRetentionClass, v))
+}
+
+// RuleRetentionClassIn applies the In predicate on the "rule_retention_class" field.
+func RuleRetentionClassIn(vs ...string) predicate.Category {
+	return predicate.Category(sql.FieldIn(FieldRuleRetentionClass, vs...))
+}
+
+// RuleRetentionClassNotIn applies the NotIn predicate on the "rule_retention_class" field.
+func RuleRetentionClassNotIn(vs ...string) predicate.Category {
+	return predicate.Category(sql.FieldNotIn(FieldRuleRetentionClass, vs...))
+}
+
+// RuleRetentionClassGT applies the GT predicate on the "rule_retention_class" field.
+func RuleRetentionClassGT(v string) predicate.Category {
+	return predicate.Category(sql.FieldGT(FieldRuleRetentionClass, v))
+}
+
+// RuleRetentionClassGTE applies the GTE predicate on the "rule_retention_class" field.
+func RuleRetentionClassGTE(v string) predicate.Category {
+	return predicate.Category(sql.FieldGTE(FieldRuleRetentionClass, v))
+}
+
+// RuleRetentionClassLT applies the LT predicate on the "rule_retention_class" field.
+func RuleRetentionClassLT(v string) predicate.Category {
+	return predicate.Category(sql.FieldLT(FieldRuleRetentionClass, v))
+}
+
+// RuleRetentionClassLTE applies the LTE predicate on the "rule_retention_class" field.
+func RuleRetentionClassLTE(v string) predicate.Category {
+	return predicate.Category(sql.FieldLTE(FieldRuleRetentionClass, v))
+}
+
+// RuleRetentionClassContains applies the Contains predicate on the "rule_retention_class" field.
+func RuleRetentionClassContains(v string) predicate.Category {
+	return predicate.Category(sql.FieldContains(FieldRuleRetentionClass, v))
+}
+
+// RuleRetentionClassHasPrefix applies the HasPrefix predicate on the "rule_retention_class" field.
+func RuleRetentionClassHasPrefix(v string) predicate.Category {
+	return predicate.Category(sql.FieldHasPrefix(FieldRuleRetentionClass, v))
+}
+
+// RuleRetentionClassHasSuffix applies the HasSuffix predicate on the "rule_retention_class" field.
+func RuleRetentionClassHasSuffix(v string) predicate.Category {
+	return predicate.Category(sql.FieldHasSuffix(FieldRuleRetentionClass, v))
+}
+
+// RuleRetentionClassIsNil applies the IsNil predicate on the "rule_retention_class" field.
+func RuleRetentionClassIsNil() predicate.Category {
+	return predicate.Category(sql.FieldIsNull(FieldRuleRetentionClass))
+}
+
+// RuleRetentionClassNotNil applies the NotNil predicate on the "rule_retention_class" field.
+func RuleRetentionClassNotNil() predicate.Category {
+	return predicate.Category(sql.FieldNotNull(FieldRuleRetentionClass))
+}
+
+// RuleRetentionClassEqualFold applies the EqualFold predicate on the "rule_retention_class" field.
+func RuleRetentionClassEqualFold(v string) predicate.Category {
+	return predicate.Category(sql.FieldEqualFold(FieldRuleRetentionClass, v))
+}
+
+// RuleRetentionClassContainsFold applies the ContainsFold predicate on the "rule_retention_class" field.
+func RuleRetentionClassContainsFold(v string) predicate.Category {
+	return predicate.Category(sql.FieldContainsFold(FieldRuleRetentionClass, v))
+}
+
+// ChildSortModeEQ applies the EQ predicate on the "child_sort_mode" field.
+func ChildSortModeEQ(v ChildSortMode) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldChildSortMode, v))
+}
+
+// ChildSortModeNEQ applies the NEQ predicate on the "child_sort_mode" field.
+func ChildSortModeNEQ(v ChildSortMode) predicate.Category {
+	return predicate.Category(sql.FieldNEQ(FieldChildSortMode, v))
+}
+
+// ChildSortModeIn applies the In predicate on the "child_sort_mode" field.
+func ChildSortModeIn(vs ...ChildSortMode) predicate.Category {
+	return predicate.Category(sql.FieldIn(FieldChildSortMode, vs...))
+}
+
+// ChildSortModeNotIn applies the NotIn predicate on the "child_sort_mode" field.
+func ChildSortModeNotIn(vs ...ChildSortMode) predicate.Category {
+	return predicate.Category(sql.FieldNotIn(FieldChildSortMode, vs...))
+}
+
+// ActivityTimeEQ applies the EQ predicate on the "activity_time" field.
+func ActivityTimeEQ(v time.Time) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldActivityTime, v))
+}
+
+// ActivityTimeNEQ applies the NEQ predicate on the "activity_time" field.
+func ActivityTimeNEQ(v time.Time) predicate.Category {
+	return predicate.Category(sql.FieldNEQ(FieldActivityTime, v))
+}
+
+// ActivityTimeIn applies the In predicate on the "activity_time" field.
+func ActivityTimeIn(vs ...time.Time) predicate.Category {
+	return predicate.Category(sql.FieldIn(FieldActivityTime, vs...))
+}
+
+// ActivityTimeNotIn applies the NotIn predicate on the "activity_time" field.
+func ActivityTimeNotIn(vs ...time.Time) predicate.Category {
+	return predicate.Category(sql.FieldNotIn(FieldActivityTime, vs...))
+}
+
+// ActivityTimeGT applies the GT predicate on the "activity_time" field.
+func ActivityTimeGT(v time.Time) predicate.Category {
+	return predicate.Category(sql.FieldGT(FieldActivityTime, v))
+}
+
+// ActivityTimeGTE applies the GTE predicate on the "activity_time" field.
+func ActivityTimeGTE(v time.Time) predicate.Category {
+	return predicate.Category(sql.FieldGTE(FieldActivityTime, v))
+}
+
+// ActivityTimeLT applies the LT predicate on the "activity_time" field.
+func ActivityTimeLT(v time.Time) predicate.Category {
+	return predicate.Category(sql.FieldLT(FieldActivityTime, v))
+}
+
+// ActivityTimeLTE applies the LTE predicate on the "activity_time" field.
+func ActivityTimeLTE(v time.Time) predicate.Category {
+	return predicate.Category(sql.FieldLTE(FieldActivityTime, v))
+}
+
+// ActivityTimeIsNil applies the IsNil predicate on the "activity_time" field.
+func ActivityTimeIsNil() predicate.Category {
+	return predicate.Category(sql.FieldIsNull(FieldActivityTime))
+}
+
+// ActivityTimeNotNil applies the NotNil predicate on the "activity_time" field.
+func ActivityTimeNotNil() predicate.Category {
+	return predicate.Category(sql.FieldNotNull(FieldActivityTime))
+}
+
 // HasParent applies the HasEdge predicate on the "parent" edge.
 func HasParent() predicate.Category {
 	return predicate.Category(func(s *sql.Selector) {
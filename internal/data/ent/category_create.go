@@ -95,6 +95,20 @@ func (_c *CategoryCreate) SetNillableTenantID(v *uint32) *CategoryCreate {
 	return _c
 }
 
+// SetVersion sets the "version" field.
+func (_c *CategoryCreate) SetVersion(v uint32) *CategoryCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *CategoryCreate) SetNillableVersion(v *uint32) *CategoryCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
 // SetParentID sets the "parent_id" field.
 func (_c *CategoryCreate) SetParentID(v string) *CategoryCreate {
 	_c.mutation.SetParentID(v)
@@ -135,6 +149,34 @@ func (_c *CategoryCreate) SetNillableDescription(v *string) *CategoryCreate {
 	return _c
 }
 
+// SetColor sets the "color" field.
+func (_c *CategoryCreate) SetColor(v string) *CategoryCreate {
+	_c.mutation.SetColor(v)
+	return _c
+}
+
+// SetNillableColor sets the "color" field if the given value is not nil.
+func (_c *CategoryCreate) SetNillableColor(v *string) *CategoryCreate {
+	if v != nil {
+		_c.SetColor(*v)
+	}
+	return _c
+}
+
+// SetIcon sets the "icon" field.
+func (_c *CategoryCreate) SetIcon(v string) *CategoryCreate {
+	_c.mutation.SetIcon(v)
+	return _c
+}
+
+// SetNillableIcon sets the "icon" field if the given value is not nil.
+func (_c *CategoryCreate) SetNillableIcon(v *string) *CategoryCreate {
+	if v != nil {
+		_c.SetIcon(*v)
+	}
+	return _c
+}
+
 // SetDepth sets the "depth" field.
 func (_c *CategoryCreate) SetDepth(v int32) *CategoryCreate {
 	_c.mutation.SetDepth(v)
@@ -163,6 +205,152 @@ func (_c *CategoryCreate) SetNillableSortOrder(v *int32) *CategoryCreate {
 	return _c
 }
 
+// SetDocumentCount sets the "document_count" field.
+func (_c *CategoryCreate) SetDocumentCount(v int64) *CategoryCreate {
+	_c.mutation.SetDocumentCount(v)
+	return _c
+}
+
+// SetNillableDocumentCount sets the "document_count" field if the given value is not nil.
+func (_c *CategoryCreate) SetNillableDocumentCount(v *int64) *CategoryCreate {
+	if v != nil {
+		_c.SetDocumentCount(*v)
+	}
+	return _c
+}
+
+// SetSubtreeDocumentCount sets the "subtree_document_count" field.
+func (_c *CategoryCreate) SetSubtreeDocumentCount(v int64) *CategoryCreate {
+	_c.mutation.SetSubtreeDocumentCount(v)
+	return _c
+}
+
+// SetNillableSubtreeDocumentCount sets the "subtree_document_count" field if the given value is not nil.
+func (_c *CategoryCreate) SetNillableSubtreeDocumentCount(v *int64) *CategoryCreate {
+	if v != nil {
+		_c.SetSubtreeDocumentCount(*v)
+	}
+	return _c
+}
+
+// SetDocumentBytes sets the "document_bytes" field.
+func (_c *CategoryCreate) SetDocumentBytes(v int64) *CategoryCreate {
+	_c.mutation.SetDocumentBytes(v)
+	return _c
+}
+
+// SetNillableDocumentBytes sets the "document_bytes" field if the given value is not nil.
+func (_c *CategoryCreate) SetNillableDocumentBytes(v *int64) *CategoryCreate {
+	if v != nil {
+		_c.SetDocumentBytes(*v)
+	}
+	return _c
+}
+
+// SetSubtreeDocumentBytes sets the "subtree_document_bytes" field.
+func (_c *CategoryCreate) SetSubtreeDocumentBytes(v int64) *CategoryCreate {
+	_c.mutation.SetSubtreeDocumentBytes(v)
+	return _c
+}
+
+// SetNillableSubtreeDocumentBytes sets the "subtree_document_bytes" field if the given value is not nil.
+func (_c *CategoryCreate) SetNillableSubtreeDocumentBytes(v *int64) *CategoryCreate {
+	if v != nil {
+		_c.SetSubtreeDocumentBytes(*v)
+	}
+	return _c
+}
+
+// SetMaxDocuments sets the "max_documents" field.
+func (_c *CategoryCreate) SetMaxDocuments(v int64) *CategoryCreate {
+	_c.mutation.SetMaxDocuments(v)
+	return _c
+}
+
+// SetNillableMaxDocuments sets the "max_documents" field if the given value is not nil.
+func (_c *CategoryCreate) SetNillableMaxDocuments(v *int64) *CategoryCreate {
+	if v != nil {
+		_c.SetMaxDocuments(*v)
+	}
+	return _c
+}
+
+// SetMaxBytes sets the "max_bytes" field.
+func (_c *CategoryCreate) SetMaxBytes(v int64) *CategoryCreate {
+	_c.mutation.SetMaxBytes(v)
+	return _c
+}
+
+// SetNillableMaxBytes sets the "max_bytes" field if the given value is not nil.
+func (_c *CategoryCreate) SetNillableMaxBytes(v *int64) *CategoryCreate {
+	if v != nil {
+		_c.SetMaxBytes(*v)
+	}
+	return _c
+}
+
+// SetRuleTags sets the "rule_tags" field.
+func (_c *CategoryCreate) SetRuleTags(v map[string]string) *CategoryCreate {
+	_c.mutation.SetRuleTags(v)
+	return _c
+}
+
+// SetRuleDocumentType sets the "rule_document_type" field.
+func (_c *CategoryCreate) SetRuleDocumentType(v string) *CategoryCreate {
+	_c.mutation.SetRuleDocumentType(v)
+	return _c
+}
+
+// SetNillableRuleDocumentType sets the "rule_document_type" field if the given value is not nil.
+func (_c *CategoryCreate) SetNillableRuleDocumentType(v *string) *CategoryCreate {
+	if v != nil {
+		_c.SetRuleDocumentType(*v)
+	}
+	return _c
+}
+
+// SetRuleRetentionClass sets the "rule_retention_class" field.
+func (_c *CategoryCreate) SetRuleRetentionClass(v string) *CategoryCreate {
+	_c.mutation.SetRuleRetentionClass(v)
+	return _c
+}
+
+// SetNillableRuleRetentionClass sets the "rule_retention_class" field if the given value is not nil.
+func (_c *CategoryCreate) SetNillableRuleRetentionClass(v *string) *CategoryCreate {
+	if v != nil {
+		_c.SetRuleRetentionClass(*v)
+	}
+	return _c
+}
+
+// SetChildSortMode sets the "child_sort_mode" field.
+func (_c *CategoryCreate) SetChildSortMode(v category.ChildSortMode) *CategoryCreate {
+	_c.mutation.SetChildSortMode(v)
+	return _c
+}
+
+// SetNillableChildSortMode sets the "child_sort_mode" field if the given value is not nil.
+func (_c *CategoryCreate) SetNillableChildSortMode(v *category.ChildSortMode) *CategoryCreate {
+	if v != nil {
+		_c.SetChildSortMode(*v)
+	}
+	return _c
+}
+
+// SetActivityTime sets the "activity_time" field.
+func (_c *CategoryCreate) SetActivityTime(v time.Time) *CategoryCreate {
+	_c.mutation.SetActivityTime(v)
+	return _c
+}
+
+// SetNillableActivityTime sets the "activity_time" field if the given value is not nil.
+func (_c *CategoryCreate) SetNillableActivityTime(v *time.Time) *CategoryCreate {
+	if v != nil {
+		_c.SetActivityTime(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *CategoryCreate) SetID(v string) *CategoryCreate {
 	_c.mutation.SetID(v)
@@ -260,6 +448,10 @@ func (_c *CategoryCreate) defaults() error {
 		v := category.DefaultTenantID
 		_c.mutation.SetTenantID(v)
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		v := category.DefaultVersion
+		_c.mutation.SetVersion(v)
+	}
 	if _, ok := _c.mutation.Depth(); !ok {
 		v := category.DefaultDepth
 		_c.mutation.SetDepth(v)
@@ -268,11 +460,34 @@ func (_c *CategoryCreate) defaults() error {
 		v := category.DefaultSortOrder
 		_c.mutation.SetSortOrder(v)
 	}
+	if _, ok := _c.mutation.DocumentCount(); !ok {
+		v := category.DefaultDocumentCount
+		_c.mutation.SetDocumentCount(v)
+	}
+	if _, ok := _c.mutation.SubtreeDocumentCount(); !ok {
+		v := category.DefaultSubtreeDocumentCount
+		_c.mutation.SetSubtreeDocumentCount(v)
+	}
+	if _, ok := _c.mutation.DocumentBytes(); !ok {
+		v := category.DefaultDocumentBytes
+		_c.mutation.SetDocumentBytes(v)
+	}
+	if _, ok := _c.mutation.SubtreeDocumentBytes(); !ok {
+		v := category.DefaultSubtreeDocumentBytes
+		_c.mutation.SetSubtreeDocumentBytes(v)
+	}
+	if _, ok := _c.mutation.ChildSortMode(); !ok {
+		v := category.DefaultChildSortMode
+		_c.mutation.SetChildSortMode(v)
+	}
 	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *CategoryCreate) check() error {
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Category.version"`)}
+	}
 	if _, ok := _c.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Category.name"`)}
 	}
@@ -294,12 +509,52 @@ func (_c *CategoryCreate) check() error {
 			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Category.description": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.Color(); ok {
+		if err := category.ColorValidator(v); err != nil {
+			return &ValidationError{Name: "color", err: fmt.Errorf(`ent: validator failed for field "Category.color": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Icon(); ok {
+		if err := category.IconValidator(v); err != nil {
+			return &ValidationError{Name: "icon", err: fmt.Errorf(`ent: validator failed for field "Category.icon": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Depth(); !ok {
 		return &ValidationError{Name: "depth", err: errors.New(`ent: missing required field "Category.depth"`)}
 	}
 	if _, ok := _c.mutation.SortOrder(); !ok {
 		return &ValidationError{Name: "sort_order", err: errors.New(`ent: missing required field "Category.sort_order"`)}
 	}
+	if _, ok := _c.mutation.DocumentCount(); !ok {
+		return &ValidationError{Name: "document_count", err: errors.New(`ent: missing required field "Category.document_count"`)}
+	}
+	if _, ok := _c.mutation.SubtreeDocumentCount(); !ok {
+		return &ValidationError{Name: "subtree_document_count", err: errors.New(`ent: missing required field "Category.subtree_document_count"`)}
+	}
+	if _, ok := _c.mutation.DocumentBytes(); !ok {
+		return &ValidationError{Name: "document_bytes", err: errors.New(`ent: missing required field "Category.document_bytes"`)}
+	}
+	if _, ok := _c.mutation.SubtreeDocumentBytes(); !ok {
+		return &ValidationError{Name: "subtree_document_bytes", err: errors.New(`ent: missing required field "Category.subtree_document_bytes"`)}
+	}
+	if v, ok := _c.mutation.RuleDocumentType(); ok {
+		if err := category.RuleDocumentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "rule_document_type", err: fmt.Errorf(`ent: validator failed for field "Category.rule_document_type": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.RuleRetentionClass(); ok {
+		if err := category.RuleRetentionClassValidator(v); err != nil {
+			return &ValidationError{Name: "rule_retention_class", err: fmt.Errorf(`ent: validator failed for field "Category.rule_retention_class": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ChildSortMode(); !ok {
+		return &ValidationError{Name: "child_sort_mode", err: errors.New(`ent: missing required field "Category.child_sort_mode"`)}
+	}
+	if v, ok := _c.mutation.ChildSortMode(); ok {
+		if err := category.ChildSortModeValidator(v); err != nil {
+			return &ValidationError{Name: "child_sort_mode", err: fmt.Errorf(`ent: validator failed for field "Category.child_sort_mode": %w`, err)}
+		}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := category.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Category.id": %w`, err)}
@@ -361,6 +616,10 @@ func (_c *CategoryCreate) createSpec() (*Category, *sqlgraph.CreateSpec) {
 		_spec.SetField(category.FieldTenantID, field.TypeUint32, value)
 		_node.TenantID = &value
 	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(category.FieldVersion, field.TypeUint32, value)
+		_node.Version = value
+	}
 	if value, ok := _c.mutation.Name(); ok {
 		_spec.SetField(category.FieldName, field.TypeString, value)
 		_node.Name = value
@@ -373,6 +632,14 @@ func (_c *CategoryCreate) createSpec() (*Category, *sqlgraph.CreateSpec) {
 		_spec.SetField(category.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
+	if value, ok := _c.mutation.Color(); ok {
+		_spec.SetField(category.FieldColor, field.TypeString, value)
+		_node.Color = value
+	}
+	if value, ok := _c.mutation.Icon(); ok {
+		_spec.SetField(category.FieldIcon, field.TypeString, value)
+		_node.Icon = value
+	}
 	if value, ok := _c.mutation.Depth(); ok {
 		_spec.SetField(category.FieldDepth, field.TypeInt32, value)
 		_node.Depth = value
@@ -381,6 +648,50 @@ func (_c *CategoryCreate) createSpec() (*Category, *sqlgraph.CreateSpec) {
 		_spec.SetField(category.FieldSortOrder, field.TypeInt32, value)
 		_node.SortOrder = value
 	}
+	if value, ok := _c.mutation.DocumentCount(); ok {
+		_spec.SetField(category.FieldDocumentCount, field.TypeInt64, value)
+		_node.DocumentCount = value
+	}
+	if value, ok := _c.mutation.SubtreeDocumentCount(); ok {
+		_spec.SetField(category.FieldSubtreeDocumentCount, field.TypeInt64, value)
+		_node.SubtreeDocumentCount = value
+	}
+	if value, ok := _c.mutation.DocumentBytes(); ok {
+		_spec.SetField(category.FieldDocumentBytes, field.TypeInt64, value)
+		_node.DocumentBytes = value
+	}
+	if value, ok := _c.mutation.SubtreeDocumentBytes(); ok {
+		_spec.SetField(category.FieldSubtreeDocumentBytes, field.TypeInt64, value)
+		_node.SubtreeDocumentBytes = value
+	}
+	if value, ok := _c.mutation.MaxDocuments(); ok {
+		_spec.SetField(category.FieldMaxDocuments, field.TypeInt64, value)
+		_node.MaxDocuments = &value
+	}
+	if value, ok := _c.mutation.MaxBytes(); ok {
+		_spec.SetField(category.FieldMaxBytes, field.TypeInt64, value)
+		_node.MaxBytes = &value
+	}
+	if value, ok := _c.mutation.RuleTags(); ok {
+		_spec.SetField(category.FieldRuleTags, field.TypeJSON, value)
+		_node.RuleTags = value
+	}
+	if value, ok := _c.mutation.RuleDocumentType(); ok {
+		_spec.SetField(category.FieldRuleDocumentType, field.TypeString, value)
+		_node.RuleDocumentType = value
+	}
+	if value, ok := _c.mutation.RuleRetentionClass(); ok {
+		_spec.SetField(category.FieldRuleRetentionClass, field.TypeString, value)
+		_node.RuleRetentionClass = value
+	}
+	if value, ok := _c.mutation.ChildSortMode(); ok {
+		_spec.SetField(category.FieldChildSortMode, field.TypeEnum, value)
+		_node.ChildSortMode = value
+	}
+	if value, ok := _c.mutation.ActivityTime(); ok {
+		_spec.SetField(category.FieldActivityTime, field.TypeTime, value)
+		_node.ActivityTime = &value
+	}
 	if nodes := _c.mutation.ParentIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -558,6 +869,24 @@ func (u *CategoryUpsert) ClearDeleteTime() *CategoryUpsert {
 	return u
 }
 
+// SetVersion sets the "version" field.
+func (u *CategoryUpsert) SetVersion(v uint32) *CategoryUpsert {
+	u.Set(category.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *CategoryUpsert) UpdateVersion() *CategoryUpsert {
+	u.SetExcluded(category.FieldVersion)
+	return u
+}
+
+// AddVersion adds v to the "version" field.
+func (u *CategoryUpsert) AddVersion(v uint32) *CategoryUpsert {
+	u.Add(category.FieldVersion, v)
+	return u
+}
+
 // SetParentID sets the "parent_id" field.
 func (u *CategoryUpsert) SetParentID(v string) *CategoryUpsert {
 	u.Set(category.FieldParentID, v)
@@ -618,6 +947,42 @@ func (u *CategoryUpsert) ClearDescription() *CategoryUpsert {
 	return u
 }
 
+// SetColor sets the "color" field.
+func (u *CategoryUpsert) SetColor(v string) *CategoryUpsert {
+	u.Set(category.FieldColor, v)
+	return u
+}
+
+// UpdateColor sets the "color" field to the value that was provided on create.
+func (u *CategoryUpsert) UpdateColor() *CategoryUpsert {
+	u.SetExcluded(category.FieldColor)
+	return u
+}
+
+// ClearColor clears the value of the "color" field.
+func (u *CategoryUpsert) ClearColor() *CategoryUpsert {
+	u.SetNull(category.FieldColor)
+	return u
+}
+
+// SetIcon sets the "icon" field.
+func (u *CategoryUpsert) SetIcon(v string) *CategoryUpsert {
+	u.Set(category.FieldIcon, v)
+	return u
+}
+
+// UpdateIcon sets the "icon" field to the value that was provided on create.
+func (u *CategoryUpsert) UpdateIcon() *CategoryUpsert {
+	u.SetExcluded(category.FieldIcon)
+	return u
+}
+
+// ClearIcon clears the value of the "icon" field.
+func (u *CategoryUpsert) ClearIcon() *CategoryUpsert {
+	u.SetNull(category.FieldIcon)
+	return u
+}
+
 // SetDepth sets the "depth" field.
 func (u *CategoryUpsert) SetDepth(v int32) *CategoryUpsert {
 	u.Set(category.FieldDepth, v)
@@ -654,239 +1019,744 @@ func (u *CategoryUpsert) AddSortOrder(v int32) *CategoryUpsert {
 	return u
 }
 
-// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
-// Using this option is equivalent to using:
-//
-//	client.Category.Create().
-//		OnConflict(
-//			sql.ResolveWithNewValues(),
-//			sql.ResolveWith(func(u *sql.UpdateSet) {
-//				u.SetIgnore(category.FieldID)
-//			}),
-//		).
-//		Exec(ctx)
-func (u *CategoryUpsertOne) UpdateNewValues() *CategoryUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
-	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
-		if _, exists := u.create.mutation.ID(); exists {
-			s.SetIgnore(category.FieldID)
-		}
-		if _, exists := u.create.mutation.CreateTime(); exists {
-			s.SetIgnore(category.FieldCreateTime)
-		}
-		if _, exists := u.create.mutation.TenantID(); exists {
-			s.SetIgnore(category.FieldTenantID)
-		}
-	}))
+// SetDocumentCount sets the "document_count" field.
+func (u *CategoryUpsert) SetDocumentCount(v int64) *CategoryUpsert {
+	u.Set(category.FieldDocumentCount, v)
 	return u
 }
 
-// Ignore sets each column to itself in case of conflict.
-// Using this option is equivalent to using:
-//
-//	client.Category.Create().
-//	    OnConflict(sql.ResolveWithIgnore()).
-//	    Exec(ctx)
-func (u *CategoryUpsertOne) Ignore() *CategoryUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+// UpdateDocumentCount sets the "document_count" field to the value that was provided on create.
+func (u *CategoryUpsert) UpdateDocumentCount() *CategoryUpsert {
+	u.SetExcluded(category.FieldDocumentCount)
 	return u
 }
 
-// DoNothing configures the conflict_action to `DO NOTHING`.
-// Supported only by SQLite and PostgreSQL.
-func (u *CategoryUpsertOne) DoNothing() *CategoryUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+// AddDocumentCount adds v to the "document_count" field.
+func (u *CategoryUpsert) AddDocumentCount(v int64) *CategoryUpsert {
+	u.Add(category.FieldDocumentCount, v)
 	return u
 }
 
-// Update allows overriding fields `UPDATE` values. See the CategoryCreate.OnConflict
-// documentation for more info.
-func (u *CategoryUpsertOne) Update(set func(*CategoryUpsert)) *CategoryUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
-		set(&CategoryUpsert{UpdateSet: update})
-	}))
+// SetSubtreeDocumentCount sets the "subtree_document_count" field.
+func (u *CategoryUpsert) SetSubtreeDocumentCount(v int64) *CategoryUpsert {
+	u.Set(category.FieldSubtreeDocumentCount, v)
 	return u
 }
 
-// SetCreateBy sets the "create_by" field.
-func (u *CategoryUpsertOne) SetCreateBy(v uint32) *CategoryUpsertOne {
-	return u.Update(func(s *CategoryUpsert) {
-		s.SetCreateBy(v)
-	})
+// UpdateSubtreeDocumentCount sets the "subtree_document_count" field to the value that was provided on create.
+func (u *CategoryUpsert) UpdateSubtreeDocumentCount() *CategoryUpsert {
+	u.SetExcluded(category.FieldSubtreeDocumentCount)
+	return u
 }
 
-// AddCreateBy adds v to the "create_by" field.
-func (u *CategoryUpsertOne) AddCreateBy(v uint32) *CategoryUpsertOne {
-	return u.Update(func(s *CategoryUpsert) {
-		s.AddCreateBy(v)
-	})
+// AddSubtreeDocumentCount adds v to the "subtree_document_count" field.
+func (u *CategoryUpsert) AddSubtreeDocumentCount(v int64) *CategoryUpsert {
+	u.Add(category.FieldSubtreeDocumentCount, v)
+	return u
 }
 
-// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
-func (u *CategoryUpsertOne) UpdateCreateBy() *CategoryUpsertOne {
-	return u.Update(func(s *CategoryUpsert) {
-		s.UpdateCreateBy()
-	})
+// SetDocumentBytes sets the "document_bytes" field.
+func (u *CategoryUpsert) SetDocumentBytes(v int64) *CategoryUpsert {
+	u.Set(category.FieldDocumentBytes, v)
+	return u
 }
 
-// ClearCreateBy clears the value of the "create_by" field.
-func (u *CategoryUpsertOne) ClearCreateBy() *CategoryUpsertOne {
-	return u.Update(func(s *CategoryUpsert) {
-		s.ClearCreateBy()
-	})
+// UpdateDocumentBytes sets the "document_bytes" field to the value that was provided on create.
+func (u *CategoryUpsert) UpdateDocumentBytes() *CategoryUpsert {
+	u.SetExcluded(category.FieldDocumentBytes)
+	return u
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (u *CategoryUpsertOne) SetUpdateTime(v time.Time) *CategoryUpsertOne {
-	return u.Update(func(s *CategoryUpsert) {
-		s.SetUpdateTime(v)
-	})
+// AddDocumentBytes adds v to the "document_bytes" field.
+func (u *CategoryUpsert) AddDocumentBytes(v int64) *CategoryUpsert {
+	u.Add(category.FieldDocumentBytes, v)
+	return u
 }
 
-// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
-func (u *CategoryUpsertOne) UpdateUpdateTime() *CategoryUpsertOne {
-	return u.Update(func(s *CategoryUpsert) {
-		s.UpdateUpdateTime()
-	})
+// SetSubtreeDocumentBytes sets the "subtree_document_bytes" field.
+func (u *CategoryUpsert) SetSubtreeDocumentBytes(v int64) *CategoryUpsert {
+	u.Set(category.FieldSubtreeDocumentBytes, v)
+	return u
 }
 
-// ClearUpdateTime clears the value of the "update_time" field.
-func (u *CategoryUpsertOne) ClearUpdateTime() *CategoryUpsertOne {
-	return u.Update(func(s *CategoryUpsert) {
-		s.ClearUpdateTime()
-	})
+// UpdateSubtreeDocumentBytes sets the "subtree_document_bytes" field to the value that was provided on create.
+func (u *CategoryUpsert) UpdateSubtreeDocumentBytes() *CategoryUpsert {
+	u.SetExcluded(category.FieldSubtreeDocumentBytes)
+	return u
 }
 
-// SetDeleteTime sets the "delete_time" field.
-func (u *CategoryUpsertOne) SetDeleteTime(v time.Time) *CategoryUpsertOne {
+// AddSubtreeDocumentBytes adds v to the "subtree_document_bytes" field.
+func (u *CategoryUpsert) AddSubtreeDocumentBytes(v int64) *CategoryUpsert {
+	u.Add(category.FieldSubtreeDocumentBytes, v)
+	return u
+}
+
+// SetMaxDocuments sets the "max_documents" field.
+func (u *CategoryUpsert) SetMaxDocuments(v int64) *CategoryUpsert {
+	u.Set(category.FieldMaxDocuments, v)
+	return u
+}
+
+// UpdateMaxDocuments sets the "max_documents" field to the value that was provided on create.
+func (u *CategoryUpsert) UpdateMaxDocuments() *CategoryUpsert {
+	u.SetExcluded(category.FieldMaxDocuments)
+	return u
+}
+
+// AddMaxDocuments adds v to the "max_documents" field.
+func (u *CategoryUpsert) AddMaxDocuments(v int64) *CategoryUpsert {
+	u.Add(category.FieldMaxDocuments, v)
+	return u
+}
+
+// ClearMaxDocuments clears the value of the "max_documents" field.
+func (u *CategoryUpsert) ClearMaxDocuments() *CategoryUpsert {
+	u.SetNull(category.FieldMaxDocuments)
+	return u
+}
+
+// SetMaxBytes sets the "max_bytes" field.
+func (u *CategoryUpsert) SetMaxBytes(v int64) *CategoryUpsert {
+	u.Set(category.FieldMaxBytes, v)
+	return u
+}
+
+// UpdateMaxBytes sets the "max_bytes" field to the value that was provided on create.
+func (u *CategoryUpsert) UpdateMaxBytes() *CategoryUpsert {
+	u.SetExcluded(category.FieldMaxBytes)
+	return u
+}
+
+// AddMaxBytes adds v to the "max_bytes" field.
+func (u *CategoryUpsert) AddMaxBytes(v int64) *CategoryUpsert {
+	u.Add(category.FieldMaxBytes, v)
+	return u
+}
+
+// ClearMaxBytes clears the value of the "max_bytes" field.
+func (u *CategoryUpsert) ClearMaxBytes() *CategoryUpsert {
+	u.SetNull(category.FieldMaxBytes)
+	return u
+}
+
+// SetRuleTags sets the "rule_tags" field.
+func (u *CategoryUpsert) SetRuleTags(v map[string]string) *CategoryUpsert {
+	u.Set(category.FieldRuleTags, v)
+	return u
+}
+
+// UpdateRuleTags sets the "rule_tags" field to the value that was provided on create.
+func (u *CategoryUpsert) UpdateRuleTags() *CategoryUpsert {
+	u.SetExcluded(category.FieldRuleTags)
+	return u
+}
+
+// ClearRuleTags clears the value of the "rule_tags" field.
+func (u *CategoryUpsert) ClearRuleTags() *CategoryUpsert {
+	u.SetNull(category.FieldRuleTags)
+	return u
+}
+
+// SetRuleDocumentType sets the "rule_document_type" field.
+func (u *CategoryUpsert) SetRuleDocumentType(v string) *CategoryUpsert {
+	u.Set(category.FieldRuleDocumentType, v)
+	return u
+}
+
+// UpdateRuleDocumentType sets the "rule_document_type" field to the value that was provided on create.
+func (u *CategoryUpsert) UpdateRuleDocumentType() *CategoryUpsert {
+	u.SetExcluded(category.FieldRuleDocumentType)
+	return u
+}
+
+// ClearRuleDocumentType clears the value of the "rule_document_type" field.
+func (u *CategoryUpsert) ClearRuleDocumentType() *CategoryUpsert {
+	u.SetNull(category.FieldRuleDocumentType)
+	return u
+}
+
+// SetRuleRetentionClass sets the "rule_retention_class" field.
+func (u *CategoryUpsert) SetRuleRetentionClass(v string) *CategoryUpsert {
+	u.Set(category.FieldRuleRetentionClass, v)
+	return u
+}
+
+// UpdateRuleRetentionClass sets the "rule_retention_class" field to the value that was provided on create.
+func (u *CategoryUpsert) UpdateRuleRetentionClass() *CategoryUpsert {
+	u.SetExcluded(category.FieldRuleRetentionClass)
+	return u
+}
+
+// ClearRuleRetentionClass clears the value of the "rule_retention_class" field.
+func (u *CategoryUpsert) ClearRuleRetentionClass() *CategoryUpsert {
+	u.SetNull(category.FieldRuleRetentionClass)
+	return u
+}
+
+// SetChildSortMode sets the "child_sort_mode" field.
+func (u *CategoryUpsert) SetChildSortMode(v category.ChildSortMode) *CategoryUpsert {
+	u.Set(category.FieldChildSortMode, v)
+	return u
+}
+
+// UpdateChildSortMode sets the "child_sort_mode" field to the value that was provided on create.
+func (u *CategoryUpsert) UpdateChildSortMode() *CategoryUpsert {
+	u.SetExcluded(category.FieldChildSortMode)
+	return u
+}
+
+// SetActivityTime sets the "activity_time" field.
+func (u *CategoryUpsert) SetActivityTime(v time.Time) *CategoryUpsert {
+	u.Set(category.FieldActivityTime, v)
+	return u
+}
+
+// UpdateActivityTime sets the "activity_time" field to the value that was provided on create.
+func (u *CategoryUpsert) UpdateActivityTime() *CategoryUpsert {
+	u.SetExcluded(category.FieldActivityTime)
+	return u
+}
+
+// ClearActivityTime clears the value of the "activity_time" field.
+func (u *CategoryUpsert) ClearActivityTime() *CategoryUpsert {
+	u.SetNull(category.FieldActivityTime)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Category.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(category.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CategoryUpsertOne) UpdateNewValues() *CategoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(category.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(category.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(category.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Category.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CategoryUpsertOne) Ignore() *CategoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CategoryUpsertOne) DoNothing() *CategoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CategoryCreate.OnConflict
+// documentation for more info.
+func (u *CategoryUpsertOne) Update(set func(*CategoryUpsert)) *CategoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CategoryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *CategoryUpsertOne) SetCreateBy(v uint32) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *CategoryUpsertOne) AddCreateBy(v uint32) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateCreateBy() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *CategoryUpsertOne) ClearCreateBy() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *CategoryUpsertOne) SetUpdateTime(v time.Time) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateUpdateTime() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *CategoryUpsertOne) ClearUpdateTime() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *CategoryUpsertOne) SetDeleteTime(v time.Time) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateDeleteTime() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *CategoryUpsertOne) ClearDeleteTime() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *CategoryUpsertOne) SetVersion(v uint32) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *CategoryUpsertOne) AddVersion(v uint32) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateVersion() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetParentID sets the "parent_id" field.
+func (u *CategoryUpsertOne) SetParentID(v string) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetParentID(v)
+	})
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateParentID() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateParentID()
+	})
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *CategoryUpsertOne) ClearParentID() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearParentID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *CategoryUpsertOne) SetName(v string) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateName() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetPath sets the "path" field.
+func (u *CategoryUpsertOne) SetPath(v string) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetPath(v)
+	})
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdatePath() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdatePath()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *CategoryUpsertOne) SetDescription(v string) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateDescription() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *CategoryUpsertOne) ClearDescription() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetColor sets the "color" field.
+func (u *CategoryUpsertOne) SetColor(v string) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetColor(v)
+	})
+}
+
+// UpdateColor sets the "color" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateColor() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateColor()
+	})
+}
+
+// ClearColor clears the value of the "color" field.
+func (u *CategoryUpsertOne) ClearColor() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearColor()
+	})
+}
+
+// SetIcon sets the "icon" field.
+func (u *CategoryUpsertOne) SetIcon(v string) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetIcon(v)
+	})
+}
+
+// UpdateIcon sets the "icon" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateIcon() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateIcon()
+	})
+}
+
+// ClearIcon clears the value of the "icon" field.
+func (u *CategoryUpsertOne) ClearIcon() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearIcon()
+	})
+}
+
+// SetDepth sets the "depth" field.
+func (u *CategoryUpsertOne) SetDepth(v int32) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetDepth(v)
+	})
+}
+
+// AddDepth adds v to the "depth" field.
+func (u *CategoryUpsertOne) AddDepth(v int32) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.AddDepth(v)
+	})
+}
+
+// UpdateDepth sets the "depth" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateDepth() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateDepth()
+	})
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (u *CategoryUpsertOne) SetSortOrder(v int32) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetSortOrder(v)
+	})
+}
+
+// AddSortOrder adds v to the "sort_order" field.
+func (u *CategoryUpsertOne) AddSortOrder(v int32) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.AddSortOrder(v)
+	})
+}
+
+// UpdateSortOrder sets the "sort_order" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateSortOrder() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateSortOrder()
+	})
+}
+
+// SetDocumentCount sets the "document_count" field.
+func (u *CategoryUpsertOne) SetDocumentCount(v int64) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetDocumentCount(v)
+	})
+}
+
+// AddDocumentCount adds v to the "document_count" field.
+func (u *CategoryUpsertOne) AddDocumentCount(v int64) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.AddDocumentCount(v)
+	})
+}
+
+// UpdateDocumentCount sets the "document_count" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateDocumentCount() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateDocumentCount()
+	})
+}
+
+// SetSubtreeDocumentCount sets the "subtree_document_count" field.
+func (u *CategoryUpsertOne) SetSubtreeDocumentCount(v int64) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetSubtreeDocumentCount(v)
+	})
+}
+
+// AddSubtreeDocumentCount adds v to the "subtree_document_count" field.
+func (u *CategoryUpsertOne) AddSubtreeDocumentCount(v int64) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.AddSubtreeDocumentCount(v)
+	})
+}
+
+// UpdateSubtreeDocumentCount sets the "subtree_document_count" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateSubtreeDocumentCount() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateSubtreeDocumentCount()
+	})
+}
+
+// SetDocumentBytes sets the "document_bytes" field.
+func (u *CategoryUpsertOne) SetDocumentBytes(v int64) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetDocumentBytes(v)
+	})
+}
+
+// AddDocumentBytes adds v to the "document_bytes" field.
+func (u *CategoryUpsertOne) AddDocumentBytes(v int64) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.AddDocumentBytes(v)
+	})
+}
+
+// UpdateDocumentBytes sets the "document_bytes" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateDocumentBytes() *CategoryUpsertOne {
 	return u.Update(func(s *CategoryUpsert) {
-		s.SetDeleteTime(v)
+		s.UpdateDocumentBytes()
 	})
 }
 
-// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
-func (u *CategoryUpsertOne) UpdateDeleteTime() *CategoryUpsertOne {
+// SetSubtreeDocumentBytes sets the "subtree_document_bytes" field.
+func (u *CategoryUpsertOne) SetSubtreeDocumentBytes(v int64) *CategoryUpsertOne {
 	return u.Update(func(s *CategoryUpsert) {
-		s.UpdateDeleteTime()
+		s.SetSubtreeDocumentBytes(v)
 	})
 }
 
-// ClearDeleteTime clears the value of the "delete_time" field.
-func (u *CategoryUpsertOne) ClearDeleteTime() *CategoryUpsertOne {
+// AddSubtreeDocumentBytes adds v to the "subtree_document_bytes" field.
+func (u *CategoryUpsertOne) AddSubtreeDocumentBytes(v int64) *CategoryUpsertOne {
 	return u.Update(func(s *CategoryUpsert) {
-		s.ClearDeleteTime()
+		s.AddSubtreeDocumentBytes(v)
 	})
 }
 
-// SetParentID sets the "parent_id" field.
-func (u *CategoryUpsertOne) SetParentID(v string) *CategoryUpsertOne {
+// UpdateSubtreeDocumentBytes sets the "subtree_document_bytes" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateSubtreeDocumentBytes() *CategoryUpsertOne {
 	return u.Update(func(s *CategoryUpsert) {
-		s.SetParentID(v)
+		s.UpdateSubtreeDocumentBytes()
 	})
 }
 
-// UpdateParentID sets the "parent_id" field to the value that was provided on create.
-func (u *CategoryUpsertOne) UpdateParentID() *CategoryUpsertOne {
+// SetMaxDocuments sets the "max_documents" field.
+func (u *CategoryUpsertOne) SetMaxDocuments(v int64) *CategoryUpsertOne {
 	return u.Update(func(s *CategoryUpsert) {
-		s.UpdateParentID()
+		s.SetMaxDocuments(v)
 	})
 }
 
-// ClearParentID clears the value of the "parent_id" field.
-func (u *CategoryUpsertOne) ClearParentID() *CategoryUpsertOne {
+// AddMaxDocuments adds v to the "max_documents" field.
+func (u *CategoryUpsertOne) AddMaxDocuments(v int64) *CategoryUpsertOne {
 	return u.Update(func(s *CategoryUpsert) {
-		s.ClearParentID()
+		s.AddMaxDocuments(v)
 	})
 }
 
-// SetName sets the "name" field.
-func (u *CategoryUpsertOne) SetName(v string) *CategoryUpsertOne {
+// UpdateMaxDocuments sets the "max_documents" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateMaxDocuments() *CategoryUpsertOne {
 	return u.Update(func(s *CategoryUpsert) {
-		s.SetName(v)
+		s.UpdateMaxDocuments()
 	})
 }
 
-// UpdateName sets the "name" field to the value that was provided on create.
-func (u *CategoryUpsertOne) UpdateName() *CategoryUpsertOne {
+// ClearMaxDocuments clears the value of the "max_documents" field.
+func (u *CategoryUpsertOne) ClearMaxDocuments() *CategoryUpsertOne {
 	return u.Update(func(s *CategoryUpsert) {
-		s.UpdateName()
+		s.ClearMaxDocuments()
 	})
 }
 
-// SetPath sets the "path" field.
-func (u *CategoryUpsertOne) SetPath(v string) *CategoryUpsertOne {
+// SetMaxBytes sets the "max_bytes" field.
+func (u *CategoryUpsertOne) SetMaxBytes(v int64) *CategoryUpsertOne {
 	return u.Update(func(s *CategoryUpsert) {
-		s.SetPath(v)
+		s.SetMaxBytes(v)
 	})
 }
 
-// UpdatePath sets the "path" field to the value that was provided on create.
-func (u *CategoryUpsertOne) UpdatePath() *CategoryUpsertOne {
+// AddMaxBytes adds v to the "max_bytes" field.
+func (u *CategoryUpsertOne) AddMaxBytes(v int64) *CategoryUpsertOne {
 	return u.Update(func(s *CategoryUpsert) {
-		s.UpdatePath()
+		s.AddMaxBytes(v)
 	})
 }
 
-// SetDescription sets the "description" field.
-func (u *CategoryUpsertOne) SetDescription(v string) *CategoryUpsertOne {
+// UpdateMaxBytes sets the "max_bytes" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateMaxBytes() *CategoryUpsertOne {
 	return u.Update(func(s *CategoryUpsert) {
-		s.SetDescription(v)
+		s.UpdateMaxBytes()
 	})
 }
 
-// UpdateDescription sets the "description" field to the value that was provided on create.
-func (u *CategoryUpsertOne) UpdateDescription() *CategoryUpsertOne {
+// ClearMaxBytes clears the value of the "max_bytes" field.
+func (u *CategoryUpsertOne) ClearMaxBytes() *CategoryUpsertOne {
 	return u.Update(func(s *CategoryUpsert) {
-		s.UpdateDescription()
+		s.ClearMaxBytes()
 	})
 }
 
-// ClearDescription clears the value of the "description" field.
-func (u *CategoryUpsertOne) ClearDescription() *CategoryUpsertOne {
+// SetRuleTags sets the "rule_tags" field.
+func (u *CategoryUpsertOne) SetRuleTags(v map[string]string) *CategoryUpsertOne {
 	return u.Update(func(s *CategoryUpsert) {
-		s.ClearDescription()
+		s.SetRuleTags(v)
 	})
 }
 
-// SetDepth sets the "depth" field.
-func (u *CategoryUpsertOne) SetDepth(v int32) *CategoryUpsertOne {
+// UpdateRuleTags sets the "rule_tags" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateRuleTags() *CategoryUpsertOne {
 	return u.Update(func(s *CategoryUpsert) {
-		s.SetDepth(v)
+		s.UpdateRuleTags()
 	})
 }
 
-// AddDepth adds v to the "depth" field.
-func (u *CategoryUpsertOne) AddDepth(v int32) *CategoryUpsertOne {
+// ClearRuleTags clears the value of the "rule_tags" field.
+func (u *CategoryUpsertOne) ClearRuleTags() *CategoryUpsertOne {
 	return u.Update(func(s *CategoryUpsert) {
-		s.AddDepth(v)
+		s.ClearRuleTags()
 	})
 }
 
-// UpdateDepth sets the "depth" field to the value that was provided on create.
-func (u *CategoryUpsertOne) UpdateDepth() *CategoryUpsertOne {
+// SetRuleDocumentType sets the "rule_document_type" field.
+func (u *CategoryUpsertOne) SetRuleDocumentType(v string) *CategoryUpsertOne {
 	return u.Update(func(s *CategoryUpsert) {
-		s.UpdateDepth()
+		s.SetRuleDocumentType(v)
 	})
 }
 
-// SetSortOrder sets the "sort_order" field.
-func (u *CategoryUpsertOne) SetSortOrder(v int32) *CategoryUpsertOne {
+// UpdateRuleDocumentType sets the "rule_document_type" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateRuleDocumentType() *CategoryUpsertOne {
 	return u.Update(func(s *CategoryUpsert) {
-		s.SetSortOrder(v)
+		s.UpdateRuleDocumentType()
 	})
 }
 
-// AddSortOrder adds v to the "sort_order" field.
-func (u *CategoryUpsertOne) AddSortOrder(v int32) *CategoryUpsertOne {
+// ClearRuleDocumentType clears the value of the "rule_document_type" field.
+func (u *CategoryUpsertOne) ClearRuleDocumentType() *CategoryUpsertOne {
 	return u.Update(func(s *CategoryUpsert) {
-		s.AddSortOrder(v)
+		s.ClearRuleDocumentType()
 	})
 }
 
-// UpdateSortOrder sets the "sort_order" field to the value that was provided on create.
-func (u *CategoryUpsertOne) UpdateSortOrder() *CategoryUpsertOne {
+// SetRuleRetentionClass sets the "rule_retention_class" field.
+func (u *CategoryUpsertOne) SetRuleRetentionClass(v string) *CategoryUpsertOne {
 	return u.Update(func(s *CategoryUpsert) {
-		s.UpdateSortOrder()
+		s.SetRuleRetentionClass(v)
+	})
+}
+
+// UpdateRuleRetentionClass sets the "rule_retention_class" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateRuleRetentionClass() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateRuleRetentionClass()
+	})
+}
+
+// ClearRuleRetentionClass clears the value of the "rule_retention_class" field.
+func (u *CategoryUpsertOne) ClearRuleRetentionClass() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearRuleRetentionClass()
+	})
+}
+
+// SetChildSortMode sets the "child_sort_mode" field.
+func (u *CategoryUpsertOne) SetChildSortMode(v category.ChildSortMode) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetChildSortMode(v)
+	})
+}
+
+// UpdateChildSortMode sets the "child_sort_mode" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateChildSortMode() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateChildSortMode()
+	})
+}
+
+// SetActivityTime sets the "activity_time" field.
+func (u *CategoryUpsertOne) SetActivityTime(v time.Time) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetActivityTime(v)
+	})
+}
+
+// UpdateActivityTime sets the "activity_time" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateActivityTime() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateActivityTime()
+	})
+}
+
+// ClearActivityTime clears the value of the "activity_time" field.
+func (u *CategoryUpsertOne) ClearActivityTime() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearActivityTime()
 	})
 }
 
@@ -1181,6 +2051,27 @@ func (u *CategoryUpsertBulk) ClearDeleteTime() *CategoryUpsertBulk {
 	})
 }
 
+// SetVersion sets the "version" field.
+func (u *CategoryUpsertBulk) SetVersion(v uint32) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *CategoryUpsertBulk) AddVersion(v uint32) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *CategoryUpsertBulk) UpdateVersion() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateVersion()
+	})
+}
+
 // SetParentID sets the "parent_id" field.
 func (u *CategoryUpsertBulk) SetParentID(v string) *CategoryUpsertBulk {
 	return u.Update(func(s *CategoryUpsert) {
@@ -1251,6 +2142,48 @@ func (u *CategoryUpsertBulk) ClearDescription() *CategoryUpsertBulk {
 	})
 }
 
+// SetColor sets the "color" field.
+func (u *CategoryUpsertBulk) SetColor(v string) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetColor(v)
+	})
+}
+
+// UpdateColor sets the "color" field to the value that was provided on create.
+func (u *CategoryUpsertBulk) UpdateColor() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateColor()
+	})
+}
+
+// ClearColor clears the value of the "color" field.
+func (u *CategoryUpsertBulk) ClearColor() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearColor()
+	})
+}
+
+// SetIcon sets the "icon" field.
+func (u *CategoryUpsertBulk) SetIcon(v string) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetIcon(v)
+	})
+}
+
+// UpdateIcon sets the "icon" field to the value that was provided on create.
+func (u *CategoryUpsertBulk) UpdateIcon() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateIcon()
+	})
+}
+
+// ClearIcon clears the value of the "icon" field.
+func (u *CategoryUpsertBulk) ClearIcon() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearIcon()
+	})
+}
+
 // SetDepth sets the "depth" field.
 func (u *CategoryUpsertBulk) SetDepth(v int32) *CategoryUpsertBulk {
 	return u.Update(func(s *CategoryUpsert) {
@@ -1293,6 +2226,244 @@ func (u *CategoryUpsertBulk) UpdateSortOrder() *CategoryUpsertBulk {
 	})
 }
 
+// SetDocumentCount sets the "document_count" field.
+func (u *CategoryUpsertBulk) SetDocumentCount(v int64) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetDocumentCount(v)
+	})
+}
+
+// AddDocumentCount adds v to the "document_count" field.
+func (u *CategoryUpsertBulk) AddDocumentCount(v int64) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.AddDocumentCount(v)
+	})
+}
+
+// UpdateDocumentCount sets the "document_count" field to the value that was provided on create.
+func (u *CategoryUpsertBulk) UpdateDocumentCount() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateDocumentCount()
+	})
+}
+
+// SetSubtreeDocumentCount sets the "subtree_document_count" field.
+func (u *CategoryUpsertBulk) SetSubtreeDocumentCount(v int64) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetSubtreeDocumentCount(v)
+	})
+}
+
+// AddSubtreeDocumentCount adds v to the "subtree_document_count" field.
+func (u *CategoryUpsertBulk) AddSubtreeDocumentCount(v int64) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.AddSubtreeDocumentCount(v)
+	})
+}
+
+// UpdateSubtreeDocumentCount sets the "subtree_document_count" field to the value that was provided on create.
+func (u *CategoryUpsertBulk) UpdateSubtreeDocumentCount() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateSubtreeDocumentCount()
+	})
+}
+
+// SetDocumentBytes sets the "document_bytes" field.
+func (u *CategoryUpsertBulk) SetDocumentBytes(v int64) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetDocumentBytes(v)
+	})
+}
+
+// AddDocumentBytes adds v to the "document_bytes" field.
+func (u *CategoryUpsertBulk) AddDocumentBytes(v int64) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.AddDocumentBytes(v)
+	})
+}
+
+// UpdateDocumentBytes sets the "document_bytes" field to the value that was provided on create.
+func (u *CategoryUpsertBulk) UpdateDocumentBytes() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateDocumentBytes()
+	})
+}
+
+// SetSubtreeDocumentBytes sets the "subtree_document_bytes" field.
+func (u *CategoryUpsertBulk) SetSubtreeDocumentBytes(v int64) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetSubtreeDocumentBytes(v)
+	})
+}
+
+// AddSubtreeDocumentBytes adds v to the "subtree_document_bytes" field.
+func (u *CategoryUpsertBulk) AddSubtreeDocumentBytes(v int64) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.AddSubtreeDocumentBytes(v)
+	})
+}
+
+// UpdateSubtreeDocumentBytes sets the "subtree_document_bytes" field to the value that was provided on create.
+func (u *CategoryUpsertBulk) UpdateSubtreeDocumentBytes() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateSubtreeDocumentBytes()
+	})
+}
+
+// SetMaxDocuments sets the "max_documents" field.
+func (u *CategoryUpsertBulk) SetMaxDocuments(v int64) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetMaxDocuments(v)
+	})
+}
+
+// AddMaxDocuments adds v to the "max_documents" field.
+func (u *CategoryUpsertBulk) AddMaxDocuments(v int64) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.AddMaxDocuments(v)
+	})
+}
+
+// UpdateMaxDocuments sets the "max_documents" field to the value that was provided on create.
+func (u *CategoryUpsertBulk) UpdateMaxDocuments() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateMaxDocuments()
+	})
+}
+
+// ClearMaxDocuments clears the value of the "max_documents" field.
+func (u *CategoryUpsertBulk) ClearMaxDocuments() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearMaxDocuments()
+	})
+}
+
+// SetMaxBytes sets the "max_bytes" field.
+func (u *CategoryUpsertBulk) SetMaxBytes(v int64) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetMaxBytes(v)
+	})
+}
+
+// AddMaxBytes adds v to the "max_bytes" field.
+func (u *CategoryUpsertBulk) AddMaxBytes(v int64) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.AddMaxBytes(v)
+	})
+}
+
+// UpdateMaxBytes sets the "max_bytes" field to the value that was provided on create.
+func (u *CategoryUpsertBulk) UpdateMaxBytes() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateMaxBytes()
+	})
+}
+
+// ClearMaxBytes clears the value of the "max_bytes" field.
+func (u *CategoryUpsertBulk) ClearMaxBytes() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearMaxBytes()
+	})
+}
+
+// SetRuleTags sets the "rule_tags" field.
+func (u *CategoryUpsertBulk) SetRuleTags(v map[string]string) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetRuleTags(v)
+	})
+}
+
+// UpdateRuleTags sets the "rule_tags" field to the value that was provided on create.
+func (u *CategoryUpsertBulk) UpdateRuleTags() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateRuleTags()
+	})
+}
+
+// ClearRuleTags clears the value of the "rule_tags" field.
+func (u *CategoryUpsertBulk) ClearRuleTags() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearRuleTags()
+	})
+}
+
+// SetRuleDocumentType sets the "rule_document_type" field.
+func (u *CategoryUpsertBulk) SetRuleDocumentType(v string) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetRuleDocumentType(v)
+	})
+}
+
+// UpdateRuleDocumentType sets the "rule_document_type" field to the value that was provided on create.
+func (u *CategoryUpsertBulk) UpdateRuleDocumentType() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateRuleDocumentType()
+	})
+}
+
+// ClearRuleDocumentType clears the value of the "rule_document_type" field.
+func (u *CategoryUpsertBulk) ClearRuleDocumentType() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearRuleDocumentType()
+	})
+}
+
+// SetRuleRetentionClass sets the "rule_retention_class" field.
+func (u *CategoryUpsertBulk) SetRuleRetentionClass(v string) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetRuleRetentionClass(v)
+	})
+}
+
+// UpdateRuleRetentionClass sets the "rule_retention_class" field to the value that was provided on create.
+func (u *CategoryUpsertBulk) UpdateRuleRetentionClass() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateRuleRetentionClass()
+	})
+}
+
+// ClearRuleRetentionClass clears the value of the "rule_retention_class" field.
+func (u *CategoryUpsertBulk) ClearRuleRetentionClass() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearRuleRetentionClass()
+	})
+}
+
+// SetChildSortMode sets the "child_sort_mode" field.
+func (u *CategoryUpsertBulk) SetChildSortMode(v category.ChildSortMode) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetChildSortMode(v)
+	})
+}
+
+// UpdateChildSortMode sets the "child_sort_mode" field to the value that was provided on create.
+func (u *CategoryUpsertBulk) UpdateChildSortMode() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateChildSortMode()
+	})
+}
+
+// SetActivityTime sets the "activity_time" field.
+func (u *CategoryUpsertBulk) SetActivityTime(v time.Time) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetActivityTime(v)
+	})
+}
+
+// UpdateActivityTime sets the "activity_time" field to the value that was provided on create.
+func (u *CategoryUpsertBulk) UpdateActivityTime() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateActivityTime()
+	})
+}
+
+// ClearActivityTime clears the value of the "activity_time" field.
+func (u *CategoryUpsertBulk) ClearActivityTime() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearActivityTime()
+	})
+}
+
 // Exec executes the query.
 func (u *CategoryUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
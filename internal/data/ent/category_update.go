@@ -98,6 +98,27 @@ func (_u *CategoryUpdate) ClearDeleteTime() *CategoryUpdate {
 	return _u
 }
 
+// SetVersion sets the "version" field.
+func (_u *CategoryUpdate) SetVersion(v uint32) *CategoryUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *CategoryUpdate) SetNillableVersion(v *uint32) *CategoryUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *CategoryUpdate) AddVersion(v int32) *CategoryUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
 // SetParentID sets the "parent_id" field.
 func (_u *CategoryUpdate) SetParentID(v string) *CategoryUpdate {
 	_u.mutation.SetParentID(v)
@@ -166,6 +187,46 @@ func (_u *CategoryUpdate) ClearDescription() *CategoryUpdate {
 	return _u
 }
 
+// SetColor sets the "color" field.
+func (_u *CategoryUpdate) SetColor(v string) *CategoryUpdate {
+	_u.mutation.SetColor(v)
+	return _u
+}
+
+// SetNillableColor sets the "color" field if the given value is not nil.
+func (_u *CategoryUpdate) SetNillableColor(v *string) *CategoryUpdate {
+	if v != nil {
+		_u.SetColor(*v)
+	}
+	return _u
+}
+
+// ClearColor clears the value of the "color" field.
+func (_u *CategoryUpdate) ClearColor() *CategoryUpdate {
+	_u.mutation.ClearColor()
+	return _u
+}
+
+// SetIcon sets the "icon" field.
+func (_u *CategoryUpdate) SetIcon(v string) *CategoryUpdate {
+	_u.mutation.SetIcon(v)
+	return _u
+}
+
+// SetNillableIcon sets the "icon" field if the given value is not nil.
+func (_u *CategoryUpdate) SetNillableIcon(v *string) *CategoryUpdate {
+	if v != nil {
+		_u.SetIcon(*v)
+	}
+	return _u
+}
+
+// ClearIcon clears the value of the "icon" field.
+func (_u *CategoryUpdate) ClearIcon() *CategoryUpdate {
+	_u.mutation.ClearIcon()
+	return _u
+}
+
 // SetDepth sets the "depth" field.
 func (_u *CategoryUpdate) SetDepth(v int32) *CategoryUpdate {
 	_u.mutation.ResetDepth()
@@ -208,6 +269,230 @@ func (_u *CategoryUpdate) AddSortOrder(v int32) *CategoryUpdate {
 	return _u
 }
 
+// SetDocumentCount sets the "document_count" field.
+func (_u *CategoryUpdate) SetDocumentCount(v int64) *CategoryUpdate {
+	_u.mutation.ResetDocumentCount()
+	_u.mutation.SetDocumentCount(v)
+	return _u
+}
+
+// SetNillableDocumentCount sets the "document_count" field if the given value is not nil.
+func (_u *CategoryUpdate) SetNillableDocumentCount(v *int64) *CategoryUpdate {
+	if v != nil {
+		_u.SetDocumentCount(*v)
+	}
+	return _u
+}
+
+// AddDocumentCount adds value to the "document_count" field.
+func (_u *CategoryUpdate) AddDocumentCount(v int64) *CategoryUpdate {
+	_u.mutation.AddDocumentCount(v)
+	return _u
+}
+
+// SetSubtreeDocumentCount sets the "subtree_document_count" field.
+func (_u *CategoryUpdate) SetSubtreeDocumentCount(v int64) *CategoryUpdate {
+	_u.mutation.ResetSubtreeDocumentCount()
+	_u.mutation.SetSubtreeDocumentCount(v)
+	return _u
+}
+
+// SetNillableSubtreeDocumentCount sets the "subtree_document_count" field if the given value is not nil.
+func (_u *CategoryUpdate) SetNillableSubtreeDocumentCount(v *int64) *CategoryUpdate {
+	if v != nil {
+		_u.SetSubtreeDocumentCount(*v)
+	}
+	return _u
+}
+
+// AddSubtreeDocumentCount adds value to the "subtree_document_count" field.
+func (_u *CategoryUpdate) AddSubtreeDocumentCount(v int64) *CategoryUpdate {
+	_u.mutation.AddSubtreeDocumentCount(v)
+	return _u
+}
+
+// SetDocumentBytes sets the "document_bytes" field.
+func (_u *CategoryUpdate) SetDocumentBytes(v int64) *CategoryUpdate {
+	_u.mutation.ResetDocumentBytes()
+	_u.mutation.SetDocumentBytes(v)
+	return _u
+}
+
+// SetNillableDocumentBytes sets the "document_bytes" field if the given value is not nil.
+func (_u *CategoryUpdate) SetNillableDocumentBytes(v *int64) *CategoryUpdate {
+	if v != nil {
+		_u.SetDocumentBytes(*v)
+	}
+	return _u
+}
+
+// AddDocumentBytes adds value to the "document_bytes" field.
+func (_u *CategoryUpdate) AddDocumentBytes(v int64) *CategoryUpdate {
+	_u.mutation.AddDocumentBytes(v)
+	return _u
+}
+
+// SetSubtreeDocumentBytes sets the "subtree_document_bytes" field.
+func (_u *CategoryUpdate) SetSubtreeDocumentBytes(v int64) *CategoryUpdate {
+	_u.mutation.ResetSubtreeDocumentBytes()
+	_u.mutation.SetSubtreeDocumentBytes(v)
+	return _u
+}
+
+// SetNillableSubtreeDocumentBytes sets the "subtree_document_bytes" field if the given value is not nil.
+func (_u *CategoryUpdate) SetNillableSubtreeDocumentBytes(v *int64) *CategoryUpdate {
+	if v != nil {
+		_u.SetSubtreeDocumentBytes(*v)
+	}
+	return _u
+}
+
+// AddSubtreeDocumentBytes adds value to the "subtree_document_bytes" field.
+func (_u *CategoryUpdate) AddSubtreeDocumentBytes(v int64) *CategoryUpdate {
+	_u.mutation.AddSubtreeDocumentBytes(v)
+	return _u
+}
+
+// SetMaxDocuments sets the "max_documents" field.
+func (_u *CategoryUpdate) SetMaxDocuments(v int64) *CategoryUpdate {
+	_u.mutation.ResetMaxDocuments()
+	_u.mutation.SetMaxDocuments(v)
+	return _u
+}
+
+// SetNillableMaxDocuments sets the "max_documents" field if the given value is not nil.
+func (_u *CategoryUpdate) SetNillableMaxDocuments(v *int64) *CategoryUpdate {
+	if v != nil {
+		_u.SetMaxDocuments(*v)
+	}
+	return _u
+}
+
+// AddMaxDocuments adds value to the "max_documents" field.
+func (_u *CategoryUpdate) AddMaxDocuments(v int64) *CategoryUpdate {
+	_u.mutation.AddMaxDocuments(v)
+	return _u
+}
+
+// ClearMaxDocuments clears the value of the "max_documents" field.
+func (_u *CategoryUpdate) ClearMaxDocuments() *CategoryUpdate {
+	_u.mutation.ClearMaxDocuments()
+	return _u
+}
+
+// SetMaxBytes sets the "max_bytes" field.
+func (_u *CategoryUpdate) SetMaxBytes(v int64) *CategoryUpdate {
+	_u.mutation.ResetMaxBytes()
+	_u.mutation.SetMaxBytes(v)
+	return _u
+}
+
+// SetNillableMaxBytes sets the "max_bytes" field if the given value is not nil.
+func (_u *CategoryUpdate) SetNillableMaxBytes(v *int64) *CategoryUpdate {
+	if v != nil {
+		_u.SetMaxBytes(*v)
+	}
+	return _u
+}
+
+// AddMaxBytes adds value to the "max_bytes" field.
+func (_u *CategoryUpdate) AddMaxBytes(v int64) *CategoryUpdate {
+	_u.mutation.AddMaxBytes(v)
+	return _u
+}
+
+// ClearMaxBytes clears the value of the "max_bytes" field.
+func (_u *CategoryUpdate) ClearMaxBytes() *CategoryUpdate {
+	_u.mutation.ClearMaxBytes()
+	return _u
+}
+
+// SetRuleTags sets the "rule_tags" field.
+func (_u *CategoryUpdate) SetRuleTags(v map[string]string) *CategoryUpdate {
+	_u.mutation.SetRuleTags(v)
+	return _u
+}
+
+// ClearRuleTags clears the value of the "rule_tags" field.
+func (_u *CategoryUpdate) ClearRuleTags() *CategoryUpdate {
+	_u.mutation.ClearRuleTags()
+	return _u
+}
+
+// SetRuleDocumentType sets the "rule_document_type" field.
+func (_u *CategoryUpdate) SetRuleDocumentType(v string) *CategoryUpdate {
+	_u.mutation.SetRuleDocumentType(v)
+	return _u
+}
+
+// SetNillableRuleDocumentType sets the "rule_document_type" field if the given value is not nil.
+func (_u *CategoryUpdate) SetNillableRuleDocumentType(v *string) *CategoryUpdate {
+	if v != nil {
+		_u.SetRuleDocumentType(*v)
+	}
+	return _u
+}
+
+// ClearRuleDocumentType clears the value of the "rule_document_type" field.
+func (_u *CategoryUpdate) ClearRuleDocumentType() *CategoryUpdate {
+	_u.mutation.ClearRuleDocumentType()
+	return _u
+}
+
+// SetRuleRetentionClass sets the "rule_retention_class" field.
+func (_u *CategoryUpdate) SetRuleRetentionClass(v string) *CategoryUpdate {
+	_u.mutation.SetRuleRetentionClass(v)
+	return _u
+}
+
+// SetNillableRuleRetentionClass sets the "rule_retention_class" field if the given value is not nil.
+func (_u *CategoryUpdate) SetNillableRuleRetentionClass(v *string) *CategoryUpdate {
+	if v != nil {
+		_u.SetRuleRetentionClass(*v)
+	}
+	return _u
+}
+
+// ClearRuleRetentionClass clears the value of the "rule_retention_class" field.
+func (_u *CategoryUpdate) ClearRuleRetentionClass() *CategoryUpdate {
+	_u.mutation.ClearRuleRetentionClass()
+	return _u
+}
+
+// SetChildSortMode sets the "child_sort_mode" field.
+func (_u *CategoryUpdate) SetChildSortMode(v category.ChildSortMode) *CategoryUpdate {
+	_u.mutation.SetChildSortMode(v)
+	return _u
+}
+
+// SetNillableChildSortMode sets the "child_sort_mode" field if the given value is not nil.
+func (_u *CategoryUpdate) SetNillableChildSortMode(v *category.ChildSortMode) *CategoryUpdate {
+	if v != nil {
+		_u.SetChildSortMode(*v)
+	}
+	return _u
+}
+
+// SetActivityTime sets the "activity_time" field.
+func (_u *CategoryUpdate) SetActivityTime(v time.Time) *CategoryUpdate {
+	_u.mutation.SetActivityTime(v)
+	return _u
+}
+
+// SetNillableActivityTime sets the "activity_time" field if the given value is not nil.
+func (_u *CategoryUpdate) SetNillableActivityTime(v *time.Time) *CategoryUpdate {
+	if v != nil {
+		_u.SetActivityTime(*v)
+	}
+	return _u
+}
+
+// ClearActivityTime clears the value of the "activity_time" field.
+func (_u *CategoryUpdate) ClearActivityTime() *CategoryUpdate {
+	_u.mutation.ClearActivityTime()
+	return _u
+}
+
 // SetParent sets the "parent" edge to the Category entity.
 func (_u *CategoryUpdate) SetParent(v *Category) *CategoryUpdate {
 	return _u.SetParentID(v.ID)
@@ -376,6 +661,31 @@ func (_u *CategoryUpdate) check() error {
 			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Category.description": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Color(); ok {
+		if err := category.ColorValidator(v); err != nil {
+			return &ValidationError{Name: "color", err: fmt.Errorf(`ent: validator failed for field "Category.color": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Icon(); ok {
+		if err := category.IconValidator(v); err != nil {
+			return &ValidationError{Name: "icon", err: fmt.Errorf(`ent: validator failed for field "Category.icon": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RuleDocumentType(); ok {
+		if err := category.RuleDocumentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "rule_document_type", err: fmt.Errorf(`ent: validator failed for field "Category.rule_document_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RuleRetentionClass(); ok {
+		if err := category.RuleRetentionClassValidator(v); err != nil {
+			return &ValidationError{Name: "rule_retention_class", err: fmt.Errorf(`ent: validator failed for field "Category.rule_retention_class": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ChildSortMode(); ok {
+		if err := category.ChildSortModeValidator(v); err != nil {
+			return &ValidationError{Name: "child_sort_mode", err: fmt.Errorf(`ent: validator failed for field "Category.child_sort_mode": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -424,6 +734,12 @@ func (_u *CategoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.TenantIDCleared() {
 		_spec.ClearField(category.FieldTenantID, field.TypeUint32)
 	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(category.FieldVersion, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(category.FieldVersion, field.TypeUint32, value)
+	}
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(category.FieldName, field.TypeString, value)
 	}
@@ -436,6 +752,18 @@ func (_u *CategoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.DescriptionCleared() {
 		_spec.ClearField(category.FieldDescription, field.TypeString)
 	}
+	if value, ok := _u.mutation.Color(); ok {
+		_spec.SetField(category.FieldColor, field.TypeString, value)
+	}
+	if _u.mutation.ColorCleared() {
+		_spec.ClearField(category.FieldColor, field.TypeString)
+	}
+	if value, ok := _u.mutation.Icon(); ok {
+		_spec.SetField(category.FieldIcon, field.TypeString, value)
+	}
+	if _u.mutation.IconCleared() {
+		_spec.ClearField(category.FieldIcon, field.TypeString)
+	}
 	if value, ok := _u.mutation.Depth(); ok {
 		_spec.SetField(category.FieldDepth, field.TypeInt32, value)
 	}
@@ -448,6 +776,75 @@ func (_u *CategoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedSortOrder(); ok {
 		_spec.AddField(category.FieldSortOrder, field.TypeInt32, value)
 	}
+	if value, ok := _u.mutation.DocumentCount(); ok {
+		_spec.SetField(category.FieldDocumentCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDocumentCount(); ok {
+		_spec.AddField(category.FieldDocumentCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.SubtreeDocumentCount(); ok {
+		_spec.SetField(category.FieldSubtreeDocumentCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSubtreeDocumentCount(); ok {
+		_spec.AddField(category.FieldSubtreeDocumentCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.DocumentBytes(); ok {
+		_spec.SetField(category.FieldDocumentBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDocumentBytes(); ok {
+		_spec.AddField(category.FieldDocumentBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.SubtreeDocumentBytes(); ok {
+		_spec.SetField(category.FieldSubtreeDocumentBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSubtreeDocumentBytes(); ok {
+		_spec.AddField(category.FieldSubtreeDocumentBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.MaxDocuments(); ok {
+		_spec.SetField(category.FieldMaxDocuments, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxDocuments(); ok {
+		_spec.AddField(category.FieldMaxDocuments, field.TypeInt64, value)
+	}
+	if _u.mutation.MaxDocumentsCleared() {
+		_spec.ClearField(category.FieldMaxDocuments, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.MaxBytes(); ok {
+		_spec.SetField(category.FieldMaxBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxBytes(); ok {
+		_spec.AddField(category.FieldMaxBytes, field.TypeInt64, value)
+	}
+	if _u.mutation.MaxBytesCleared() {
+		_spec.ClearField(category.FieldMaxBytes, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.RuleTags(); ok {
+		_spec.SetField(category.FieldRuleTags, field.TypeJSON, value)
+	}
+	if _u.mutation.RuleTagsCleared() {
+		_spec.ClearField(category.FieldRuleTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RuleDocumentType(); ok {
+		_spec.SetField(category.FieldRuleDocumentType, field.TypeString, value)
+	}
+	if _u.mutation.RuleDocumentTypeCleared() {
+		_spec.ClearField(category.FieldRuleDocumentType, field.TypeString)
+	}
+	if value, ok := _u.mutation.RuleRetentionClass(); ok {
+		_spec.SetField(category.FieldRuleRetentionClass, field.TypeString, value)
+	}
+	if _u.mutation.RuleRetentionClassCleared() {
+		_spec.ClearField(category.FieldRuleRetentionClass, field.TypeString)
+	}
+	if value, ok := _u.mutation.ChildSortMode(); ok {
+		_spec.SetField(category.FieldChildSortMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ActivityTime(); ok {
+		_spec.SetField(category.FieldActivityTime, field.TypeTime, value)
+	}
+	if _u.mutation.ActivityTimeCleared() {
+		_spec.ClearField(category.FieldActivityTime, field.TypeTime)
+	}
 	if _u.mutation.ParentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -701,6 +1098,27 @@ func (_u *CategoryUpdateOne) ClearDeleteTime() *CategoryUpdateOne {
 	return _u
 }
 
+// SetVersion sets the "version" field.
+func (_u *CategoryUpdateOne) SetVersion(v uint32) *CategoryUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *CategoryUpdateOne) SetNillableVersion(v *uint32) *CategoryUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *CategoryUpdateOne) AddVersion(v int32) *CategoryUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
 // SetParentID sets the "parent_id" field.
 func (_u *CategoryUpdateOne) SetParentID(v string) *CategoryUpdateOne {
 	_u.mutation.SetParentID(v)
@@ -769,6 +1187,46 @@ func (_u *CategoryUpdateOne) ClearDescription() *CategoryUpdateOne {
 	return _u
 }
 
+// SetColor sets the "color" field.
+func (_u *CategoryUpdateOne) SetColor(v string) *CategoryUpdateOne {
+	_u.mutation.SetColor(v)
+	return _u
+}
+
+// SetNillableColor sets the "color" field if the given value is not nil.
+func (_u *CategoryUpdateOne) SetNillableColor(v *string) *CategoryUpdateOne {
+	if v != nil {
+		_u.SetColor(*v)
+	}
+	return _u
+}
+
+// ClearColor clears the value of the "color" field.
+func (_u *CategoryUpdateOne) ClearColor() *CategoryUpdateOne {
+	_u.mutation.ClearColor()
+	return _u
+}
+
+// SetIcon sets the "icon" field.
+func (_u *CategoryUpdateOne) SetIcon(v string) *CategoryUpdateOne {
+	_u.mutation.SetIcon(v)
+	return _u
+}
+
+// SetNillableIcon sets the "icon" field if the given value is not nil.
+func (_u *CategoryUpdateOne) SetNillableIcon(v *string) *CategoryUpdateOne {
+	if v != nil {
+		_u.SetIcon(*v)
+	}
+	return _u
+}
+
+// ClearIcon clears the value of the "icon" field.
+func (_u *CategoryUpdateOne) ClearIcon() *CategoryUpdateOne {
+	_u.mutation.ClearIcon()
+	return _u
+}
+
 // SetDepth sets the "depth" field.
 func (_u *CategoryUpdateOne) SetDepth(v int32) *CategoryUpdateOne {
 	_u.mutation.ResetDepth()
@@ -811,6 +1269,230 @@ func (_u *CategoryUpdateOne) AddSortOrder(v int32) *CategoryUpdateOne {
 	return _u
 }
 
+// SetDocumentCount sets the "document_count" field.
+func (_u *CategoryUpdateOne) SetDocumentCount(v int64) *CategoryUpdateOne {
+	_u.mutation.ResetDocumentCount()
+	_u.mutation.SetDocumentCount(v)
+	return _u
+}
+
+// SetNillableDocumentCount sets the "document_count" field if the given value is not nil.
+func (_u *CategoryUpdateOne) SetNillableDocumentCount(v *int64) *CategoryUpdateOne {
+	if v != nil {
+		_u.SetDocumentCount(*v)
+	}
+	return _u
+}
+
+// AddDocumentCount adds value to the "document_count" field.
+func (_u *CategoryUpdateOne) AddDocumentCount(v int64) *CategoryUpdateOne {
+	_u.mutation.AddDocumentCount(v)
+	return _u
+}
+
+// SetSubtreeDocumentCount sets the "subtree_document_count" field.
+func (_u *CategoryUpdateOne) SetSubtreeDocumentCount(v int64) *CategoryUpdateOne {
+	_u.mutation.ResetSubtreeDocumentCount()
+	_u.mutation.SetSubtreeDocumentCount(v)
+	return _u
+}
+
+// SetNillableSubtreeDocumentCount sets the "subtree_document_count" field if the given value is not nil.
+func (_u *CategoryUpdateOne) SetNillableSubtreeDocumentCount(v *int64) *CategoryUpdateOne {
+	if v != nil {
+		_u.SetSubtreeDocumentCount(*v)
+	}
+	return _u
+}
+
+// AddSubtreeDocumentCount adds value to the "subtree_document_count" field.
+func (_u *CategoryUpdateOne) AddSubtreeDocumentCount(v int64) *CategoryUpdateOne {
+	_u.mutation.AddSubtreeDocumentCount(v)
+	return _u
+}
+
+// SetDocumentBytes sets the "document_bytes" field.
+func (_u *CategoryUpdateOne) SetDocumentBytes(v int64) *CategoryUpdateOne {
+	_u.mutation.ResetDocumentBytes()
+	_u.mutation.SetDocumentBytes(v)
+	return _u
+}
+
+// SetNillableDocumentBytes sets the "document_bytes" field if the given value is not nil.
+func (_u *CategoryUpdateOne) SetNillableDocumentBytes(v *int64) *CategoryUpdateOne {
+	if v != nil {
+		_u.SetDocumentBytes(*v)
+	}
+	return _u
+}
+
+// AddDocumentBytes adds value to the "document_bytes" field.
+func (_u *CategoryUpdateOne) AddDocumentBytes(v int64) *CategoryUpdateOne {
+	_u.mutation.AddDocumentBytes(v)
+	return _u
+}
+
+// SetSubtreeDocumentBytes sets the "subtree_document_bytes" field.
+func (_u *CategoryUpdateOne) SetSubtreeDocumentBytes(v int64) *CategoryUpdateOne {
+	_u.mutation.ResetSubtreeDocumentBytes()
+	_u.mutation.SetSubtreeDocumentBytes(v)
+	return _u
+}
+
+// SetNillableSubtreeDocumentBytes sets the "subtree_document_bytes" field if the given value is not nil.
+func (_u *CategoryUpdateOne) SetNillableSubtreeDocumentBytes(v *int64) *CategoryUpdateOne {
+	if v != nil {
+		_u.SetSubtreeDocumentBytes(*v)
+	}
+	return _u
+}
+
+// AddSubtreeDocumentBytes adds value to the "subtree_document_bytes" field.
+func (_u *CategoryUpdateOne) AddSubtreeDocumentBytes(v int64) *CategoryUpdateOne {
+	_u.mutation.AddSubtreeDocumentBytes(v)
+	return _u
+}
+
+// SetMaxDocuments sets the "max_documents" field.
+func (_u *CategoryUpdateOne) SetMaxDocuments(v int64) *CategoryUpdateOne {
+	_u.mutation.ResetMaxDocuments()
+	_u.mutation.SetMaxDocuments(v)
+	return _u
+}
+
+// SetNillableMaxDocuments sets the "max_documents" field if the given value is not nil.
+func (_u *CategoryUpdateOne) SetNillableMaxDocuments(v *int64) *CategoryUpdateOne {
+	if v != nil {
+		_u.SetMaxDocuments(*v)
+	}
+	return _u
+}
+
+// AddMaxDocuments adds value to the "max_documents" field.
+func (_u *CategoryUpdateOne) AddMaxDocuments(v int64) *CategoryUpdateOne {
+	_u.mutation.AddMaxDocuments(v)
+	return _u
+}
+
+// ClearMaxDocuments clears the value of the "max_documents" field.
+func (_u *CategoryUpdateOne) ClearMaxDocuments() *CategoryUpdateOne {
+	_u.mutation.ClearMaxDocuments()
+	return _u
+}
+
+// SetMaxBytes sets the "max_bytes" field.
+func (_u *CategoryUpdateOne) SetMaxBytes(v int64) *CategoryUpdateOne {
+	_u.mutation.ResetMaxBytes()
+	_u.mutation.SetMaxBytes(v)
+	return _u
+}
+
+// SetNillableMaxBytes sets the "max_bytes" field if the given value is not nil.
+func (_u *CategoryUpdateOne) SetNillableMaxBytes(v *int64) *CategoryUpdateOne {
+	if v != nil {
+		_u.SetMaxBytes(*v)
+	}
+	return _u
+}
+
+// AddMaxBytes adds value to the "max_bytes" field.
+func (_u *CategoryUpdateOne) AddMaxBytes(v int64) *CategoryUpdateOne {
+	_u.mutation.AddMaxBytes(v)
+	return _u
+}
+
+// ClearMaxBytes clears the value of the "max_bytes" field.
+func (_u *CategoryUpdateOne) ClearMaxBytes() *CategoryUpdateOne {
+	_u.mutation.ClearMaxBytes()
+	return _u
+}
+
+// SetRuleTags sets the "rule_tags" field.
+func (_u *CategoryUpdateOne) SetRuleTags(v map[string]string) *CategoryUpdateOne {
+	_u.mutation.SetRuleTags(v)
+	return _u
+}
+
+// ClearRuleTags clears the value of the "rule_tags" field.
+func (_u *CategoryUpdateOne) ClearRuleTags() *CategoryUpdateOne {
+	_u.mutation.ClearRuleTags()
+	return _u
+}
+
+// SetRuleDocumentType sets the "rule_document_type" field.
+func (_u *CategoryUpdateOne) SetRuleDocumentType(v string) *CategoryUpdateOne {
+	_u.mutation.SetRuleDocumentType(v)
+	return _u
+}
+
+// SetNillableRuleDocumentType sets the "rule_document_type" field if the given value is not nil.
+func (_u *CategoryUpdateOne) SetNillableRuleDocumentType(v *string) *CategoryUpdateOne {
+	if v != nil {
+		_u.SetRuleDocumentType(*v)
+	}
+	return _u
+}
+
+// ClearRuleDocumentType clears the value of the "rule_document_type" field.
+func (_u *CategoryUpdateOne) ClearRuleDocumentType() *CategoryUpdateOne {
+	_u.mutation.ClearRuleDocumentType()
+	return _u
+}
+
+// SetRuleRetentionClass sets the "rule_retention_class" field.
+func (_u *CategoryUpdateOne) SetRuleRetentionClass(v string) *CategoryUpdateOne {
+	_u.mutation.SetRuleRetentionClass(v)
+	return _u
+}
+
+// SetNillableRuleRetentionClass sets the "rule_retention_class" field if the given value is not nil.
+func (_u *CategoryUpdateOne) SetNillableRuleRetentionClass(v *string) *CategoryUpdateOne {
+	if v != nil {
+		_u.SetRuleRetentionClass(*v)
+	}
+	return _u
+}
+
+// ClearRuleRetentionClass clears the value of the "rule_retention_class" field.
+func (_u *CategoryUpdateOne) ClearRuleRetentionClass() *CategoryUpdateOne {
+	_u.mutation.ClearRuleRetentionClass()
+	return _u
+}
+
+// SetChildSortMode sets the "child_sort_mode" field.
+func (_u *CategoryUpdateOne) SetChildSortMode(v category.ChildSortMode) *CategoryUpdateOne {
+	_u.mutation.SetChildSortMode(v)
+	return _u
+}
+
+// SetNillableChildSortMode sets the "child_sort_mode" field if the given value is not nil.
+func (_u *CategoryUpdateOne) SetNillableChildSortMode(v *category.ChildSortMode) *CategoryUpdateOne {
+	if v != nil {
+		_u.SetChildSortMode(*v)
+	}
+	return _u
+}
+
+// SetActivityTime sets the "activity_time" field.
+func (_u *CategoryUpdateOne) SetActivityTime(v time.Time) *CategoryUpdateOne {
+	_u.mutation.SetActivityTime(v)
+	return _u
+}
+
+// SetNillableActivityTime sets the "activity_time" field if the given value is not nil.
+func (_u *CategoryUpdateOne) SetNillableActivityTime(v *time.Time) *CategoryUpdateOne {
+	if v != nil {
+		_u.SetActivityTime(*v)
+	}
+	return _u
+}
+
+// ClearActivityTime clears the value of the "activity_time" field.
+func (_u *CategoryUpdateOne) ClearActivityTime() *CategoryUpdateOne {
+	_u.mutation.ClearActivityTime()
+	return _u
+}
+
 // SetParent sets the "parent" edge to the Category entity.
 func (_u *CategoryUpdateOne) SetParent(v *Category) *CategoryUpdateOne {
 	return _u.SetParentID(v.ID)
@@ -992,6 +1674,31 @@ func (_u *CategoryUpdateOne) check() error {
 			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Category.description": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Color(); ok {
+		if err := category.ColorValidator(v); err != nil {
+			return &ValidationError{Name: "color", err: fmt.Errorf(`ent: validator failed for field "Category.color": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Icon(); ok {
+		if err := category.IconValidator(v); err != nil {
+			return &ValidationError{Name: "icon", err: fmt.Errorf(`ent: validator failed for field "Category.icon": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RuleDocumentType(); ok {
+		if err := category.RuleDocumentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "rule_document_type", err: fmt.Errorf(`ent: validator failed for field "Category.rule_document_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RuleRetentionClass(); ok {
+		if err := category.RuleRetentionClassValidator(v); err != nil {
+			return &ValidationError{Name: "rule_retention_class", err: fmt.Errorf(`ent: validator failed for field "Category.rule_retention_class": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ChildSortMode(); ok {
+		if err := category.ChildSortModeValidator(v); err != nil {
+			return &ValidationError{Name: "child_sort_mode", err: fmt.Errorf(`ent: validator failed for field "Category.child_sort_mode": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1057,6 +1764,12 @@ func (_u *CategoryUpdateOne) sqlSave(ctx context.Context) (_node *Category, err
 	if _u.mutation.TenantIDCleared() {
 		_spec.ClearField(category.FieldTenantID, field.TypeUint32)
 	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(category.FieldVersion, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(category.FieldVersion, field.TypeUint32, value)
+	}
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(category.FieldName, field.TypeString, value)
 	}
@@ -1069,6 +1782,18 @@ func (_u *CategoryUpdateOne) sqlSave(ctx context.Context) (_node *Category, err
 	if _u.mutation.DescriptionCleared() {
 		_spec.ClearField(category.FieldDescription, field.TypeString)
 	}
+	if value, ok := _u.mutation.Color(); ok {
+		_spec.SetField(category.FieldColor, field.TypeString, value)
+	}
+	if _u.mutation.ColorCleared() {
+		_spec.ClearField(category.FieldColor, field.TypeString)
+	}
+	if value, ok := _u.mutation.Icon(); ok {
+		_spec.SetField(category.FieldIcon, field.TypeString, value)
+	}
+	if _u.mutation.IconCleared() {
+		_spec.ClearField(category.FieldIcon, field.TypeString)
+	}
 	if value, ok := _u.mutation.Depth(); ok {
 		_spec.SetField(category.FieldDepth, field.TypeInt32, value)
 	}
@@ -1081,6 +1806,75 @@ func (_u *CategoryUpdateOne) sqlSave(ctx context.Context) (_node *Category, err
 	if value, ok := _u.mutation.AddedSortOrder(); ok {
 		_spec.AddField(category.FieldSortOrder, field.TypeInt32, value)
 	}
+	if value, ok := _u.mutation.DocumentCount(); ok {
+		_spec.SetField(category.FieldDocumentCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDocumentCount(); ok {
+		_spec.AddField(category.FieldDocumentCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.SubtreeDocumentCount(); ok {
+		_spec.SetField(category.FieldSubtreeDocumentCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSubtreeDocumentCount(); ok {
+		_spec.AddField(category.FieldSubtreeDocumentCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.DocumentBytes(); ok {
+		_spec.SetField(category.FieldDocumentBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDocumentBytes(); ok {
+		_spec.AddField(category.FieldDocumentBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.SubtreeDocumentBytes(); ok {
+		_spec.SetField(category.FieldSubtreeDocumentBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSubtreeDocumentBytes(); ok {
+		_spec.AddField(category.FieldSubtreeDocumentBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.MaxDocuments(); ok {
+		_spec.SetField(category.FieldMaxDocuments, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxDocuments(); ok {
+		_spec.AddField(category.FieldMaxDocuments, field.TypeInt64, value)
+	}
+	if _u.mutation.MaxDocumentsCleared() {
+		_spec.ClearField(category.FieldMaxDocuments, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.MaxBytes(); ok {
+		_spec.SetField(category.FieldMaxBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxBytes(); ok {
+		_spec.AddField(category.FieldMaxBytes, field.TypeInt64, value)
+	}
+	if _u.mutation.MaxBytesCleared() {
+		_spec.ClearField(category.FieldMaxBytes, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.RuleTags(); ok {
+		_spec.SetField(category.FieldRuleTags, field.TypeJSON, value)
+	}
+	if _u.mutation.RuleTagsCleared() {
+		_spec.ClearField(category.FieldRuleTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RuleDocumentType(); ok {
+		_spec.SetField(category.FieldRuleDocumentType, field.TypeString, value)
+	}
+	if _u.mutation.RuleDocumentTypeCleared() {
+		_spec.ClearField(category.FieldRuleDocumentType, field.TypeString)
+	}
+	if value, ok := _u.mutation.RuleRetentionClass(); ok {
+		_spec.SetField(category.FieldRuleRetentionClass, field.TypeString, value)
+	}
+	if _u.mutation.RuleRetentionClassCleared() {
+		_spec.ClearField(category.FieldRuleRetentionClass, field.TypeString)
+	}
+	if value, ok := _u.mutation.ChildSortMode(); ok {
+		_spec.SetField(category.FieldChildSortMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ActivityTime(); ok {
+		_spec.SetField(category.FieldActivityTime, field.TypeTime, value)
+	}
+	if _u.mutation.ActivityTimeCleared() {
+		_spec.ClearField(category.FieldActivityTime, field.TypeTime)
+	}
 	if _u.mutation.ParentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
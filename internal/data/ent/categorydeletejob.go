@@ -0,0 +1,329 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/categorydeletejob"
+)
+
+// CategoryDeleteJob is the model entity for the CategoryDeleteJob schema.
+type CategoryDeleteJob struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID primary key
+	ID string `json:"id,omitempty"`
+	// 创建者ID
+	CreateBy *uint32 `json:"create_by,omitempty"`
+	// 创建时间
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// 更新时间
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// 删除时间
+	DeleteTime *time.Time `json:"delete_time,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// Category being deleted
+	CategoryID string `json:"category_id,omitempty"`
+	// Path of the category when the job was queued
+	CategoryPath string `json:"category_path,omitempty"`
+	// What happens to the category's contents
+	Mode categorydeletejob.Mode `json:"mode,omitempty"`
+	// Category receiving the contents in reassign mode, null for the root level
+	TargetCategoryID *string `json:"target_category_id,omitempty"`
+	// Pending until a worker picks the job up, running until it is done
+	Status categorydeletejob.Status `json:"status,omitempty"`
+	// Categories and documents to process, counted when the job was queued
+	TotalItems int64 `json:"total_items,omitempty"`
+	// Categories and documents processed so far
+	ProcessedItems int64 `json:"processed_items,omitempty"`
+	// Categories deleted so far
+	CategoriesDeleted int64 `json:"categories_deleted,omitempty"`
+	// Subcategories moved to the target so far
+	CategoriesMoved int64 `json:"categories_moved,omitempty"`
+	// Documents moved to the trash so far
+	DocumentsDeleted int64 `json:"documents_deleted,omitempty"`
+	// Documents moved to the target so far
+	DocumentsMoved int64 `json:"documents_moved,omitempty"`
+	// Why the job failed
+	Error string `json:"error,omitempty"`
+	// Until when the running worker holds the job; another worker resumes it afterwards
+	LeaseUntil *time.Time `json:"lease_until,omitempty"`
+	// When the job succeeded or failed
+	FinishedAt   *time.Time `json:"finished_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CategoryDeleteJob) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case categorydeletejob.FieldCreateBy, categorydeletejob.FieldTenantID, categorydeletejob.FieldTotalItems, categorydeletejob.FieldProcessedItems, categorydeletejob.FieldCategoriesDeleted, categorydeletejob.FieldCategoriesMoved, categorydeletejob.FieldDocumentsDeleted, categorydeletejob.FieldDocumentsMoved:
+			values[i] = new(sql.NullInt64)
+		case categorydeletejob.FieldID, categorydeletejob.FieldCategoryID, categorydeletejob.FieldCategoryPath, categorydeletejob.FieldMode, categorydeletejob.FieldTargetCategoryID, categorydeletejob.FieldStatus, categorydeletejob.FieldError:
+			values[i] = new(sql.NullString)
+		case categorydeletejob.FieldCreateTime, categorydeletejob.FieldUpdateTime, categorydeletejob.FieldDeleteTime, categorydeletejob.FieldLeaseUntil, categorydeletejob.FieldFinishedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CategoryDeleteJob fields.
+func (_m *CategoryDeleteJob) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case categorydeletejob.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case categorydeletejob.FieldCreateBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field create_by", values[i])
+			} else if value.Valid {
+				_m.CreateBy = new(uint32)
+				*_m.CreateBy = uint32(value.Int64)
+			}
+		case categorydeletejob.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = new(time.Time)
+				*_m.CreateTime = value.Time
+			}
+		case categorydeletejob.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = new(time.Time)
+				*_m.UpdateTime = value.Time
+			}
+		case categorydeletejob.FieldDeleteTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_time", values[i])
+			} else if value.Valid {
+				_m.DeleteTime = new(time.Time)
+				*_m.DeleteTime = value.Time
+			}
+		case categorydeletejob.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case categorydeletejob.FieldCategoryID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category_id", values[i])
+			} else if value.Valid {
+				_m.CategoryID = value.String
+			}
+		case categorydeletejob.FieldCategoryPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category_path", values[i])
+			} else if value.Valid {
+				_m.CategoryPath = value.String
+			}
+		case categorydeletejob.FieldMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mode", values[i])
+			} else if value.Valid {
+				_m.Mode = categorydeletejob.Mode(value.String)
+			}
+		case categorydeletejob.FieldTargetCategoryID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_category_id", values[i])
+			} else if value.Valid {
+				_m.TargetCategoryID = new(string)
+				*_m.TargetCategoryID = value.String
+			}
+		case categorydeletejob.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = categorydeletejob.Status(value.String)
+			}
+		case categorydeletejob.FieldTotalItems:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_items", values[i])
+			} else if value.Valid {
+				_m.TotalItems = value.Int64
+			}
+		case categorydeletejob.FieldProcessedItems:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field processed_items", values[i])
+			} else if value.Valid {
+				_m.ProcessedItems = value.Int64
+			}
+		case categorydeletejob.FieldCategoriesDeleted:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field categories_deleted", values[i])
+			} else if value.Valid {
+				_m.CategoriesDeleted = value.Int64
+			}
+		case categorydeletejob.FieldCategoriesMoved:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field categories_moved", values[i])
+			} else if value.Valid {
+				_m.CategoriesMoved = value.Int64
+			}
+		case categorydeletejob.FieldDocumentsDeleted:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field documents_deleted", values[i])
+			} else if value.Valid {
+				_m.DocumentsDeleted = value.Int64
+			}
+		case categorydeletejob.FieldDocumentsMoved:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field documents_moved", values[i])
+			} else if value.Valid {
+				_m.DocumentsMoved = value.Int64
+			}
+		case categorydeletejob.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				_m.Error = value.String
+			}
+		case categorydeletejob.FieldLeaseUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field lease_until", values[i])
+			} else if value.Valid {
+				_m.LeaseUntil = new(time.Time)
+				*_m.LeaseUntil = value.Time
+			}
+		case categorydeletejob.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				_m.FinishedAt = new(time.Time)
+				*_m.FinishedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CategoryDeleteJob.
+// This includes values selected through modifiers, order, etc.
+func (_m *CategoryDeleteJob) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CategoryDeleteJob.
+// Note that you need to call CategoryDeleteJob.Unwrap() before calling this method if this CategoryDeleteJob
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CategoryDeleteJob) Update() *CategoryDeleteJobUpdateOne {
+	return NewCategoryDeleteJobClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CategoryDeleteJob entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CategoryDeleteJob) Unwrap() *CategoryDeleteJob {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CategoryDeleteJob is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CategoryDeleteJob) String() string {
+	var builder strings.Builder
+	builder.WriteString("CategoryDeleteJob(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreateBy; v != nil {
+		builder.WriteString("create_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeleteTime; v != nil {
+		builder.WriteString("delete_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("category_id=")
+	builder.WriteString(_m.CategoryID)
+	builder.WriteString(", ")
+	builder.WriteString("category_path=")
+	builder.WriteString(_m.CategoryPath)
+	builder.WriteString(", ")
+	builder.WriteString("mode=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Mode))
+	builder.WriteString(", ")
+	if v := _m.TargetCategoryID; v != nil {
+		builder.WriteString("target_category_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("total_items=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalItems))
+	builder.WriteString(", ")
+	builder.WriteString("processed_items=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProcessedItems))
+	builder.WriteString(", ")
+	builder.WriteString("categories_deleted=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CategoriesDeleted))
+	builder.WriteString(", ")
+	builder.WriteString("categories_moved=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CategoriesMoved))
+	builder.WriteString(", ")
+	builder.WriteString("documents_deleted=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DocumentsDeleted))
+	builder.WriteString(", ")
+	builder.WriteString("documents_moved=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DocumentsMoved))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(_m.Error)
+	builder.WriteString(", ")
+	if v := _m.LeaseUntil; v != nil {
+		builder.WriteString("lease_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.FinishedAt; v != nil {
+		builder.WriteString("finished_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CategoryDeleteJobs is a parsable slice of CategoryDeleteJob.
+type CategoryDeleteJobs []*CategoryDeleteJob
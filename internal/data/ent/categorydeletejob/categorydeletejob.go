@@ -0,0 +1,278 @@
+// Code generated by ent, DO NOT EDIT.
+
+package categorydeletejob
+
+import (
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the categorydeletejob type in the database.
+	Label = "category_delete_job"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateBy holds the string denoting the create_by field in the database.
+	FieldCreateBy = "create_by"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldDeleteTime holds the string denoting the delete_time field in the database.
+	FieldDeleteTime = "delete_time"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldCategoryID holds the string denoting the category_id field in the database.
+	FieldCategoryID = "category_id"
+	// FieldCategoryPath holds the string denoting the category_path field in the database.
+	FieldCategoryPath = "category_path"
+	// FieldMode holds the string denoting the mode field in the database.
+	FieldMode = "mode"
+	// FieldTargetCategoryID holds the string denoting the target_category_id field in the database.
+	FieldTargetCategoryID = "target_category_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldTotalItems holds the string denoting the total_items field in the database.
+	FieldTotalItems = "total_items"
+	// FieldProcessedItems holds the string denoting the processed_items field in the database.
+	FieldProcessedItems = "processed_items"
+	// FieldCategoriesDeleted holds the string denoting the categories_deleted field in the database.
+	FieldCategoriesDeleted = "categories_deleted"
+	// FieldCategoriesMoved holds the string denoting the categories_moved field in the database.
+	FieldCategoriesMoved = "categories_moved"
+	// FieldDocumentsDeleted holds the string denoting the documents_deleted field in the database.
+	FieldDocumentsDeleted = "documents_deleted"
+	// FieldDocumentsMoved holds the string denoting the documents_moved field in the database.
+	FieldDocumentsMoved = "documents_moved"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldLeaseUntil holds the string denoting the lease_until field in the database.
+	FieldLeaseUntil = "lease_until"
+	// FieldFinishedAt holds the string denoting the finished_at field in the database.
+	FieldFinishedAt = "finished_at"
+	// Table holds the table name of the categorydeletejob in the database.
+	Table = "paperless_category_delete_jobs"
+)
+
+// Columns holds all SQL columns for categorydeletejob fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateBy,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldDeleteTime,
+	FieldTenantID,
+	FieldCategoryID,
+	FieldCategoryPath,
+	FieldMode,
+	FieldTargetCategoryID,
+	FieldStatus,
+	FieldTotalItems,
+	FieldProcessedItems,
+	FieldCategoriesDeleted,
+	FieldCategoriesMoved,
+	FieldDocumentsDeleted,
+	FieldDocumentsMoved,
+	FieldError,
+	FieldLeaseUntil,
+	FieldFinishedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/go-tangra/go-tangra-paperless/internal/data/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint32
+	// CategoryIDValidator is a validator for the "category_id" field. It is called by the builders before save.
+	CategoryIDValidator func(string) error
+	// CategoryPathValidator is a validator for the "category_path" field. It is called by the builders before save.
+	CategoryPathValidator func(string) error
+	// DefaultTotalItems holds the default value on creation for the "total_items" field.
+	DefaultTotalItems int64
+	// DefaultProcessedItems holds the default value on creation for the "processed_items" field.
+	DefaultProcessedItems int64
+	// DefaultCategoriesDeleted holds the default value on creation for the "categories_deleted" field.
+	DefaultCategoriesDeleted int64
+	// DefaultCategoriesMoved holds the default value on creation for the "categories_moved" field.
+	DefaultCategoriesMoved int64
+	// DefaultDocumentsDeleted holds the default value on creation for the "documents_deleted" field.
+	DefaultDocumentsDeleted int64
+	// DefaultDocumentsMoved holds the default value on creation for the "documents_moved" field.
+	DefaultDocumentsMoved int64
+	// ErrorValidator is a validator for the "error" field. It is called by the builders before save.
+	ErrorValidator func(string) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// Mode defines the type for the "mode" enum field.
+type Mode string
+
+// Mode values.
+const (
+	ModeCATEGORY_DELETE_MODE_SUBTREE                Mode = "CATEGORY_DELETE_MODE_SUBTREE"
+	ModeCATEGORY_DELETE_MODE_SUBTREE_WITH_DOCUMENTS Mode = "CATEGORY_DELETE_MODE_SUBTREE_WITH_DOCUMENTS"
+	ModeCATEGORY_DELETE_MODE_REASSIGN               Mode = "CATEGORY_DELETE_MODE_REASSIGN"
+)
+
+func (m Mode) String() string {
+	return string(m)
+}
+
+// ModeValidator is a validator for the "mode" field enum values. It is called by the builders before save.
+func ModeValidator(m Mode) error {
+	switch m {
+	case ModeCATEGORY_DELETE_MODE_SUBTREE, ModeCATEGORY_DELETE_MODE_SUBTREE_WITH_DOCUMENTS, ModeCATEGORY_DELETE_MODE_REASSIGN:
+		return nil
+	default:
+		return fmt.Errorf("categorydeletejob: invalid enum value for mode field: %q", m)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusCATEGORY_DELETE_JOB_STATUS_PENDING is the default value of the Status enum.
+const DefaultStatus = StatusCATEGORY_DELETE_JOB_STATUS_PENDING
+
+// Status values.
+const (
+	StatusCATEGORY_DELETE_JOB_STATUS_PENDING   Status = "CATEGORY_DELETE_JOB_STATUS_PENDING"
+	StatusCATEGORY_DELETE_JOB_STATUS_RUNNING   Status = "CATEGORY_DELETE_JOB_STATUS_RUNNING"
+	StatusCATEGORY_DELETE_JOB_STATUS_SUCCEEDED Status = "CATEGORY_DELETE_JOB_STATUS_SUCCEEDED"
+	StatusCATEGORY_DELETE_JOB_STATUS_FAILED    Status = "CATEGORY_DELETE_JOB_STATUS_FAILED"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusCATEGORY_DELETE_JOB_STATUS_PENDING, StatusCATEGORY_DELETE_JOB_STATUS_RUNNING, StatusCATEGORY_DELETE_JOB_STATUS_SUCCEEDED, StatusCATEGORY_DELETE_JOB_STATUS_FAILED:
+		return nil
+	default:
+		return fmt.Errorf("categorydeletejob: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the CategoryDeleteJob queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateBy orders the results by the create_by field.
+func ByCreateBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateBy, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByDeleteTime orders the results by the delete_time field.
+func ByDeleteTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeleteTime, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByCategoryID orders the results by the category_id field.
+func ByCategoryID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategoryID, opts...).ToFunc()
+}
+
+// ByCategoryPath orders the results by the category_path field.
+func ByCategoryPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategoryPath, opts...).ToFunc()
+}
+
+// ByMode orders the results by the mode field.
+func ByMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMode, opts...).ToFunc()
+}
+
+// ByTargetCategoryID orders the results by the target_category_id field.
+func ByTargetCategoryID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetCategoryID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByTotalItems orders the results by the total_items field.
+func ByTotalItems(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalItems, opts...).ToFunc()
+}
+
+// ByProcessedItems orders the results by the processed_items field.
+func ByProcessedItems(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProcessedItems, opts...).ToFunc()
+}
+
+// ByCategoriesDeleted orders the results by the categories_deleted field.
+func ByCategoriesDeleted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategoriesDeleted, opts...).ToFunc()
+}
+
+// ByCategoriesMoved orders the results by the categories_moved field.
+func ByCategoriesMoved(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategoriesMoved, opts...).ToFunc()
+}
+
+// ByDocumentsDeleted orders the results by the documents_deleted field.
+func ByDocumentsDeleted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDocumentsDeleted, opts...).ToFunc()
+}
+
+// ByDocumentsMoved orders the results by the documents_moved field.
+func ByDocumentsMoved(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDocumentsMoved, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByLeaseUntil orders the results by the lease_until field.
+func ByLeaseUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLeaseUntil, opts...).ToFunc()
+}
+
+// ByFinishedAt orders the results by the finished_at field.
+func ByFinishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishedAt, opts...).ToFunc()
+}
@@ -0,0 +1,1075 @@
+// Code generated by ent, DO NOT EDIT.
+
+package categorydeletejob
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreateBy applies equality check predicate on the "create_by" field. It's identical to CreateByEQ.
+func CreateBy(v uint32) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldTenantID, v))
+}
+
+// CategoryID applies equality check predicate on the "category_id" field. It's identical to CategoryIDEQ.
+func CategoryID(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// CategoryPath applies equality check predicate on the "category_path" field. It's identical to CategoryPathEQ.
+func CategoryPath(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldCategoryPath, v))
+}
+
+// TargetCategoryID applies equality check predicate on the "target_category_id" field. It's identical to TargetCategoryIDEQ.
+func TargetCategoryID(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldTargetCategoryID, v))
+}
+
+// TotalItems applies equality check predicate on the "total_items" field. It's identical to TotalItemsEQ.
+func TotalItems(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldTotalItems, v))
+}
+
+// ProcessedItems applies equality check predicate on the "processed_items" field. It's identical to ProcessedItemsEQ.
+func ProcessedItems(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldProcessedItems, v))
+}
+
+// CategoriesDeleted applies equality check predicate on the "categories_deleted" field. It's identical to CategoriesDeletedEQ.
+func CategoriesDeleted(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldCategoriesDeleted, v))
+}
+
+// CategoriesMoved applies equality check predicate on the "categories_moved" field. It's identical to CategoriesMovedEQ.
+func CategoriesMoved(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldCategoriesMoved, v))
+}
+
+// DocumentsDeleted applies equality check predicate on the "documents_deleted" field. It's identical to DocumentsDeletedEQ.
+func DocumentsDeleted(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldDocumentsDeleted, v))
+}
+
+// DocumentsMoved applies equality check predicate on the "documents_moved" field. It's identical to DocumentsMovedEQ.
+func DocumentsMoved(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldDocumentsMoved, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldError, v))
+}
+
+// LeaseUntil applies equality check predicate on the "lease_until" field. It's identical to LeaseUntilEQ.
+func LeaseUntil(v time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldLeaseUntil, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// CreateByEQ applies the EQ predicate on the "create_by" field.
+func CreateByEQ(v uint32) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// CreateByNEQ applies the NEQ predicate on the "create_by" field.
+func CreateByNEQ(v uint32) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNEQ(FieldCreateBy, v))
+}
+
+// CreateByIn applies the In predicate on the "create_by" field.
+func CreateByIn(vs ...uint32) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldIn(FieldCreateBy, vs...))
+}
+
+// CreateByNotIn applies the NotIn predicate on the "create_by" field.
+func CreateByNotIn(vs ...uint32) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNotIn(FieldCreateBy, vs...))
+}
+
+// CreateByGT applies the GT predicate on the "create_by" field.
+func CreateByGT(v uint32) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGT(FieldCreateBy, v))
+}
+
+// CreateByGTE applies the GTE predicate on the "create_by" field.
+func CreateByGTE(v uint32) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGTE(FieldCreateBy, v))
+}
+
+// CreateByLT applies the LT predicate on the "create_by" field.
+func CreateByLT(v uint32) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLT(FieldCreateBy, v))
+}
+
+// CreateByLTE applies the LTE predicate on the "create_by" field.
+func CreateByLTE(v uint32) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLTE(FieldCreateBy, v))
+}
+
+// CreateByIsNil applies the IsNil predicate on the "create_by" field.
+func CreateByIsNil() predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldIsNull(FieldCreateBy))
+}
+
+// CreateByNotNil applies the NotNil predicate on the "create_by" field.
+func CreateByNotNil() predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNotNull(FieldCreateBy))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNotNull(FieldTenantID))
+}
+
+// CategoryIDEQ applies the EQ predicate on the "category_id" field.
+func CategoryIDEQ(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// CategoryIDNEQ applies the NEQ predicate on the "category_id" field.
+func CategoryIDNEQ(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNEQ(FieldCategoryID, v))
+}
+
+// CategoryIDIn applies the In predicate on the "category_id" field.
+func CategoryIDIn(vs ...string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDNotIn applies the NotIn predicate on the "category_id" field.
+func CategoryIDNotIn(vs ...string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNotIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDGT applies the GT predicate on the "category_id" field.
+func CategoryIDGT(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGT(FieldCategoryID, v))
+}
+
+// CategoryIDGTE applies the GTE predicate on the "category_id" field.
+func CategoryIDGTE(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGTE(FieldCategoryID, v))
+}
+
+// CategoryIDLT applies the LT predicate on the "category_id" field.
+func CategoryIDLT(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLT(FieldCategoryID, v))
+}
+
+// CategoryIDLTE applies the LTE predicate on the "category_id" field.
+func CategoryIDLTE(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLTE(FieldCategoryID, v))
+}
+
+// CategoryIDContains applies the Contains predicate on the "category_id" field.
+func CategoryIDContains(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldContains(FieldCategoryID, v))
+}
+
+// CategoryIDHasPrefix applies the HasPrefix predicate on the "category_id" field.
+func CategoryIDHasPrefix(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldHasPrefix(FieldCategoryID, v))
+}
+
+// CategoryIDHasSuffix applies the HasSuffix predicate on the "category_id" field.
+func CategoryIDHasSuffix(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldHasSuffix(FieldCategoryID, v))
+}
+
+// CategoryIDEqualFold applies the EqualFold predicate on the "category_id" field.
+func CategoryIDEqualFold(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEqualFold(FieldCategoryID, v))
+}
+
+// CategoryIDContainsFold applies the ContainsFold predicate on the "category_id" field.
+func CategoryIDContainsFold(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldContainsFold(FieldCategoryID, v))
+}
+
+// CategoryPathEQ applies the EQ predicate on the "category_path" field.
+func CategoryPathEQ(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldCategoryPath, v))
+}
+
+// CategoryPathNEQ applies the NEQ predicate on the "category_path" field.
+func CategoryPathNEQ(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNEQ(FieldCategoryPath, v))
+}
+
+// CategoryPathIn applies the In predicate on the "category_path" field.
+func CategoryPathIn(vs ...string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldIn(FieldCategoryPath, vs...))
+}
+
+// CategoryPathNotIn applies the NotIn predicate on the "category_path" field.
+func CategoryPathNotIn(vs ...string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNotIn(FieldCategoryPath, vs...))
+}
+
+// CategoryPathGT applies the GT predicate on the "category_path" field.
+func CategoryPathGT(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGT(FieldCategoryPath, v))
+}
+
+// CategoryPathGTE applies the GTE predicate on the "category_path" field.
+func CategoryPathGTE(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGTE(FieldCategoryPath, v))
+}
+
+// CategoryPathLT applies the LT predicate on the "category_path" field.
+func CategoryPathLT(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLT(FieldCategoryPath, v))
+}
+
+// CategoryPathLTE applies the LTE predicate on the "category_path" field.
+func CategoryPathLTE(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLTE(FieldCategoryPath, v))
+}
+
+// CategoryPathContains applies the Contains predicate on the "category_path" field.
+func CategoryPathContains(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldContains(FieldCategoryPath, v))
+}
+
+// CategoryPathHasPrefix applies the HasPrefix predicate on the "category_path" field.
+func CategoryPathHasPrefix(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldHasPrefix(FieldCategoryPath, v))
+}
+
+// CategoryPathHasSuffix applies the HasSuffix predicate on the "category_path" field.
+func CategoryPathHasSuffix(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldHasSuffix(FieldCategoryPath, v))
+}
+
+// CategoryPathEqualFold applies the EqualFold predicate on the "category_path" field.
+func CategoryPathEqualFold(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEqualFold(FieldCategoryPath, v))
+}
+
+// CategoryPathContainsFold applies the ContainsFold predicate on the "category_path" field.
+func CategoryPathContainsFold(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldContainsFold(FieldCategoryPath, v))
+}
+
+// ModeEQ applies the EQ predicate on the "mode" field.
+func ModeEQ(v Mode) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldMode, v))
+}
+
+// ModeNEQ applies the NEQ predicate on the "mode" field.
+func ModeNEQ(v Mode) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNEQ(FieldMode, v))
+}
+
+// ModeIn applies the In predicate on the "mode" field.
+func ModeIn(vs ...Mode) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldIn(FieldMode, vs...))
+}
+
+// ModeNotIn applies the NotIn predicate on the "mode" field.
+func ModeNotIn(vs ...Mode) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNotIn(FieldMode, vs...))
+}
+
+// TargetCategoryIDEQ applies the EQ predicate on the "target_category_id" field.
+func TargetCategoryIDEQ(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldTargetCategoryID, v))
+}
+
+// TargetCategoryIDNEQ applies the NEQ predicate on the "target_category_id" field.
+func TargetCategoryIDNEQ(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNEQ(FieldTargetCategoryID, v))
+}
+
+// TargetCategoryIDIn applies the In predicate on the "target_category_id" field.
+func TargetCategoryIDIn(vs ...string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldIn(FieldTargetCategoryID, vs...))
+}
+
+// TargetCategoryIDNotIn applies the NotIn predicate on the "target_category_id" field.
+func TargetCategoryIDNotIn(vs ...string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNotIn(FieldTargetCategoryID, vs...))
+}
+
+// TargetCategoryIDGT applies the GT predicate on the "target_category_id" field.
+func TargetCategoryIDGT(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGT(FieldTargetCategoryID, v))
+}
+
+// TargetCategoryIDGTE applies the GTE predicate on the "target_category_id" field.
+func TargetCategoryIDGTE(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGTE(FieldTargetCategoryID, v))
+}
+
+// TargetCategoryIDLT applies the LT predicate on the "target_category_id" field.
+func TargetCategoryIDLT(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLT(FieldTargetCategoryID, v))
+}
+
+// TargetCategoryIDLTE applies the LTE predicate on the "target_category_id" field.
+func TargetCategoryIDLTE(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLTE(FieldTargetCategoryID, v))
+}
+
+// TargetCategoryIDContains applies the Contains predicate on the "target_category_id" field.
+func TargetCategoryIDContains(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldContains(FieldTargetCategoryID, v))
+}
+
+// TargetCategoryIDHasPrefix applies the HasPrefix predicate on the "target_category_id" field.
+func TargetCategoryIDHasPrefix(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldHasPrefix(FieldTargetCategoryID, v))
+}
+
+// TargetCategoryIDHasSuffix applies the HasSuffix predicate on the "target_category_id" field.
+func TargetCategoryIDHasSuffix(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldHasSuffix(FieldTargetCategoryID, v))
+}
+
+// TargetCategoryIDIsNil applies the IsNil predicate on the "target_category_id" field.
+func TargetCategoryIDIsNil() predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldIsNull(FieldTargetCategoryID))
+}
+
+// TargetCategoryIDNotNil applies the NotNil predicate on the "target_category_id" field.
+func TargetCategoryIDNotNil() predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNotNull(FieldTargetCategoryID))
+}
+
+// TargetCategoryIDEqualFold applies the EqualFold predicate on the "target_category_id" field.
+func TargetCategoryIDEqualFold(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEqualFold(FieldTargetCategoryID, v))
+}
+
+// TargetCategoryIDContainsFold applies the ContainsFold predicate on the "target_category_id" field.
+func TargetCategoryIDContainsFold(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldContainsFold(FieldTargetCategoryID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// TotalItemsEQ applies the EQ predicate on the "total_items" field.
+func TotalItemsEQ(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldTotalItems, v))
+}
+
+// TotalItemsNEQ applies the NEQ predicate on the "total_items" field.
+func TotalItemsNEQ(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNEQ(FieldTotalItems, v))
+}
+
+// TotalItemsIn applies the In predicate on the "total_items" field.
+func TotalItemsIn(vs ...int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldIn(FieldTotalItems, vs...))
+}
+
+// TotalItemsNotIn applies the NotIn predicate on the "total_items" field.
+func TotalItemsNotIn(vs ...int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNotIn(FieldTotalItems, vs...))
+}
+
+// TotalItemsGT applies the GT predicate on the "total_items" field.
+func TotalItemsGT(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGT(FieldTotalItems, v))
+}
+
+// TotalItemsGTE applies the GTE predicate on the "total_items" field.
+func TotalItemsGTE(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGTE(FieldTotalItems, v))
+}
+
+// TotalItemsLT applies the LT predicate on the "total_items" field.
+func TotalItemsLT(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLT(FieldTotalItems, v))
+}
+
+// TotalItemsLTE applies the LTE predicate on the "total_items" field.
+func TotalItemsLTE(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLTE(FieldTotalItems, v))
+}
+
+// ProcessedItemsEQ applies the EQ predicate on the "processed_items" field.
+func ProcessedItemsEQ(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldProcessedItems, v))
+}
+
+// ProcessedItemsNEQ applies the NEQ predicate on the "processed_items" field.
+func ProcessedItemsNEQ(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNEQ(FieldProcessedItems, v))
+}
+
+// ProcessedItemsIn applies the In predicate on the "processed_items" field.
+func ProcessedItemsIn(vs ...int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldIn(FieldProcessedItems, vs...))
+}
+
+// ProcessedItemsNotIn applies the NotIn predicate on the "processed_items" field.
+func ProcessedItemsNotIn(vs ...int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNotIn(FieldProcessedItems, vs...))
+}
+
+// ProcessedItemsGT applies the GT predicate on the "processed_items" field.
+func ProcessedItemsGT(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGT(FieldProcessedItems, v))
+}
+
+// ProcessedItemsGTE applies the GTE predicate on the "processed_items" field.
+func ProcessedItemsGTE(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGTE(FieldProcessedItems, v))
+}
+
+// ProcessedItemsLT applies the LT predicate on the "processed_items" field.
+func ProcessedItemsLT(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLT(FieldProcessedItems, v))
+}
+
+// ProcessedItemsLTE applies the LTE predicate on the "processed_items" field.
+func ProcessedItemsLTE(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLTE(FieldProcessedItems, v))
+}
+
+// CategoriesDeletedEQ applies the EQ predicate on the "categories_deleted" field.
+func CategoriesDeletedEQ(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldCategoriesDeleted, v))
+}
+
+// CategoriesDeletedNEQ applies the NEQ predicate on the "categories_deleted" field.
+func CategoriesDeletedNEQ(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNEQ(FieldCategoriesDeleted, v))
+}
+
+// CategoriesDeletedIn applies the In predicate on the "categories_deleted" field.
+func CategoriesDeletedIn(vs ...int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldIn(FieldCategoriesDeleted, vs...))
+}
+
+// CategoriesDeletedNotIn applies the NotIn predicate on the "categories_deleted" field.
+func CategoriesDeletedNotIn(vs ...int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNotIn(FieldCategoriesDeleted, vs...))
+}
+
+// CategoriesDeletedGT applies the GT predicate on the "categories_deleted" field.
+func CategoriesDeletedGT(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGT(FieldCategoriesDeleted, v))
+}
+
+// CategoriesDeletedGTE applies the GTE predicate on the "categories_deleted" field.
+func CategoriesDeletedGTE(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGTE(FieldCategoriesDeleted, v))
+}
+
+// CategoriesDeletedLT applies the LT predicate on the "categories_deleted" field.
+func CategoriesDeletedLT(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLT(FieldCategoriesDeleted, v))
+}
+
+// CategoriesDeletedLTE applies the LTE predicate on the "categories_deleted" field.
+func CategoriesDeletedLTE(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLTE(FieldCategoriesDeleted, v))
+}
+
+// CategoriesMovedEQ applies the EQ predicate on the "categories_moved" field.
+func CategoriesMovedEQ(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldCategoriesMoved, v))
+}
+
+// CategoriesMovedNEQ applies the NEQ predicate on the "categories_moved" field.
+func CategoriesMovedNEQ(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNEQ(FieldCategoriesMoved, v))
+}
+
+// CategoriesMovedIn applies the In predicate on the "categories_moved" field.
+func CategoriesMovedIn(vs ...int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldIn(FieldCategoriesMoved, vs...))
+}
+
+// CategoriesMovedNotIn applies the NotIn predicate on the "categories_moved" field.
+func CategoriesMovedNotIn(vs ...int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNotIn(FieldCategoriesMoved, vs...))
+}
+
+// CategoriesMovedGT applies the GT predicate on the "categories_moved" field.
+func CategoriesMovedGT(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGT(FieldCategoriesMoved, v))
+}
+
+// CategoriesMovedGTE applies the GTE predicate on the "categories_moved" field.
+func CategoriesMovedGTE(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGTE(FieldCategoriesMoved, v))
+}
+
+// CategoriesMovedLT applies the LT predicate on the "categories_moved" field.
+func CategoriesMovedLT(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLT(FieldCategoriesMoved, v))
+}
+
+// CategoriesMovedLTE applies the LTE predicate on the "categories_moved" field.
+func CategoriesMovedLTE(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLTE(FieldCategoriesMoved, v))
+}
+
+// DocumentsDeletedEQ applies the EQ predicate on the "documents_deleted" field.
+func DocumentsDeletedEQ(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldDocumentsDeleted, v))
+}
+
+// DocumentsDeletedNEQ applies the NEQ predicate on the "documents_deleted" field.
+func DocumentsDeletedNEQ(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNEQ(FieldDocumentsDeleted, v))
+}
+
+// DocumentsDeletedIn applies the In predicate on the "documents_deleted" field.
+func DocumentsDeletedIn(vs ...int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldIn(FieldDocumentsDeleted, vs...))
+}
+
+// DocumentsDeletedNotIn applies the NotIn predicate on the "documents_deleted" field.
+func DocumentsDeletedNotIn(vs ...int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNotIn(FieldDocumentsDeleted, vs...))
+}
+
+// DocumentsDeletedGT applies the GT predicate on the "documents_deleted" field.
+func DocumentsDeletedGT(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGT(FieldDocumentsDeleted, v))
+}
+
+// DocumentsDeletedGTE applies the GTE predicate on the "documents_deleted" field.
+func DocumentsDeletedGTE(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGTE(FieldDocumentsDeleted, v))
+}
+
+// DocumentsDeletedLT applies the LT predicate on the "documents_deleted" field.
+func DocumentsDeletedLT(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLT(FieldDocumentsDeleted, v))
+}
+
+// DocumentsDeletedLTE applies the LTE predicate on the "documents_deleted" field.
+func DocumentsDeletedLTE(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLTE(FieldDocumentsDeleted, v))
+}
+
+// DocumentsMovedEQ applies the EQ predicate on the "documents_moved" field.
+func DocumentsMovedEQ(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldDocumentsMoved, v))
+}
+
+// DocumentsMovedNEQ applies the NEQ predicate on the "documents_moved" field.
+func DocumentsMovedNEQ(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNEQ(FieldDocumentsMoved, v))
+}
+
+// DocumentsMovedIn applies the In predicate on the "documents_moved" field.
+func DocumentsMovedIn(vs ...int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldIn(FieldDocumentsMoved, vs...))
+}
+
+// DocumentsMovedNotIn applies the NotIn predicate on the "documents_moved" field.
+func DocumentsMovedNotIn(vs ...int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNotIn(FieldDocumentsMoved, vs...))
+}
+
+// DocumentsMovedGT applies the GT predicate on the "documents_moved" field.
+func DocumentsMovedGT(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGT(FieldDocumentsMoved, v))
+}
+
+// DocumentsMovedGTE applies the GTE predicate on the "documents_moved" field.
+func DocumentsMovedGTE(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGTE(FieldDocumentsMoved, v))
+}
+
+// DocumentsMovedLT applies the LT predicate on the "documents_moved" field.
+func DocumentsMovedLT(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLT(FieldDocumentsMoved, v))
+}
+
+// DocumentsMovedLTE applies the LTE predicate on the "documents_moved" field.
+func DocumentsMovedLTE(v int64) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLTE(FieldDocumentsMoved, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldContainsFold(FieldError, v))
+}
+
+// LeaseUntilEQ applies the EQ predicate on the "lease_until" field.
+func LeaseUntilEQ(v time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldLeaseUntil, v))
+}
+
+// LeaseUntilNEQ applies the NEQ predicate on the "lease_until" field.
+func LeaseUntilNEQ(v time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNEQ(FieldLeaseUntil, v))
+}
+
+// LeaseUntilIn applies the In predicate on the "lease_until" field.
+func LeaseUntilIn(vs ...time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldIn(FieldLeaseUntil, vs...))
+}
+
+// LeaseUntilNotIn applies the NotIn predicate on the "lease_until" field.
+func LeaseUntilNotIn(vs ...time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNotIn(FieldLeaseUntil, vs...))
+}
+
+// LeaseUntilGT applies the GT predicate on the "lease_until" field.
+func LeaseUntilGT(v time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGT(FieldLeaseUntil, v))
+}
+
+// LeaseUntilGTE applies the GTE predicate on the "lease_until" field.
+func LeaseUntilGTE(v time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGTE(FieldLeaseUntil, v))
+}
+
+// LeaseUntilLT applies the LT predicate on the "lease_until" field.
+func LeaseUntilLT(v time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLT(FieldLeaseUntil, v))
+}
+
+// LeaseUntilLTE applies the LTE predicate on the "lease_until" field.
+func LeaseUntilLTE(v time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLTE(FieldLeaseUntil, v))
+}
+
+// LeaseUntilIsNil applies the IsNil predicate on the "lease_until" field.
+func LeaseUntilIsNil() predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldIsNull(FieldLeaseUntil))
+}
+
+// LeaseUntilNotNil applies the NotNil predicate on the "lease_until" field.
+func LeaseUntilNotNil() predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNotNull(FieldLeaseUntil))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CategoryDeleteJob) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CategoryDeleteJob) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CategoryDeleteJob) predicate.CategoryDeleteJob {
+	return predicate.CategoryDeleteJob(sql.NotPredicates(p))
+}
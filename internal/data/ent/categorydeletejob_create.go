@@ -0,0 +1,1828 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/categorydeletejob"
+)
+
+// CategoryDeleteJobCreate is the builder for creating a CategoryDeleteJob entity.
+type CategoryDeleteJobCreate struct {
+	config
+	mutation *CategoryDeleteJobMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_c *CategoryDeleteJobCreate) SetCreateBy(v uint32) *CategoryDeleteJobCreate {
+	_c.mutation.SetCreateBy(v)
+	return _c
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_c *CategoryDeleteJobCreate) SetNillableCreateBy(v *uint32) *CategoryDeleteJobCreate {
+	if v != nil {
+		_c.SetCreateBy(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *CategoryDeleteJobCreate) SetCreateTime(v time.Time) *CategoryDeleteJobCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *CategoryDeleteJobCreate) SetNillableCreateTime(v *time.Time) *CategoryDeleteJobCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *CategoryDeleteJobCreate) SetUpdateTime(v time.Time) *CategoryDeleteJobCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *CategoryDeleteJobCreate) SetNillableUpdateTime(v *time.Time) *CategoryDeleteJobCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *CategoryDeleteJobCreate) SetDeleteTime(v time.Time) *CategoryDeleteJobCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *CategoryDeleteJobCreate) SetNillableDeleteTime(v *time.Time) *CategoryDeleteJobCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *CategoryDeleteJobCreate) SetTenantID(v uint32) *CategoryDeleteJobCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *CategoryDeleteJobCreate) SetNillableTenantID(v *uint32) *CategoryDeleteJobCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_c *CategoryDeleteJobCreate) SetCategoryID(v string) *CategoryDeleteJobCreate {
+	_c.mutation.SetCategoryID(v)
+	return _c
+}
+
+// SetCategoryPath sets the "category_path" field.
+func (_c *CategoryDeleteJobCreate) SetCategoryPath(v string) *CategoryDeleteJobCreate {
+	_c.mutation.SetCategoryPath(v)
+	return _c
+}
+
+// SetMode sets the "mode" field.
+func (_c *CategoryDeleteJobCreate) SetMode(v categorydeletejob.Mode) *CategoryDeleteJobCreate {
+	_c.mutation.SetMode(v)
+	return _c
+}
+
+// SetTargetCategoryID sets the "target_category_id" field.
+func (_c *CategoryDeleteJobCreate) SetTargetCategoryID(v string) *CategoryDeleteJobCreate {
+	_c.mutation.SetTargetCategoryID(v)
+	return _c
+}
+
+// SetNillableTargetCategoryID sets the "target_category_id" field if the given value is not nil.
+func (_c *CategoryDeleteJobCreate) SetNillableTargetCategoryID(v *string) *CategoryDeleteJobCreate {
+	if v != nil {
+		_c.SetTargetCategoryID(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *CategoryDeleteJobCreate) SetStatus(v categorydeletejob.Status) *CategoryDeleteJobCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *CategoryDeleteJobCreate) SetNillableStatus(v *categorydeletejob.Status) *CategoryDeleteJobCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetTotalItems sets the "total_items" field.
+func (_c *CategoryDeleteJobCreate) SetTotalItems(v int64) *CategoryDeleteJobCreate {
+	_c.mutation.SetTotalItems(v)
+	return _c
+}
+
+// SetNillableTotalItems sets the "total_items" field if the given value is not nil.
+func (_c *CategoryDeleteJobCreate) SetNillableTotalItems(v *int64) *CategoryDeleteJobCreate {
+	if v != nil {
+		_c.SetTotalItems(*v)
+	}
+	return _c
+}
+
+// SetProcessedItems sets the "processed_items" field.
+func (_c *CategoryDeleteJobCreate) SetProcessedItems(v int64) *CategoryDeleteJobCreate {
+	_c.mutation.SetProcessedItems(v)
+	return _c
+}
+
+// SetNillableProcessedItems sets the "processed_items" field if the given value is not nil.
+func (_c *CategoryDeleteJobCreate) SetNillableProcessedItems(v *int64) *CategoryDeleteJobCreate {
+	if v != nil {
+		_c.SetProcessedItems(*v)
+	}
+	return _c
+}
+
+// SetCategoriesDeleted sets the "categories_deleted" field.
+func (_c *CategoryDeleteJobCreate) SetCategoriesDeleted(v int64) *CategoryDeleteJobCreate {
+	_c.mutation.SetCategoriesDeleted(v)
+	return _c
+}
+
+// SetNillableCategoriesDeleted sets the "categories_deleted" field if the given value is not nil.
+func (_c *CategoryDeleteJobCreate) SetNillableCategoriesDeleted(v *int64) *CategoryDeleteJobCreate {
+	if v != nil {
+		_c.SetCategoriesDeleted(*v)
+	}
+	return _c
+}
+
+// SetCategoriesMoved sets the "categories_moved" field.
+func (_c *CategoryDeleteJobCreate) SetCategoriesMoved(v int64) *CategoryDeleteJobCreate {
+	_c.mutation.SetCategoriesMoved(v)
+	return _c
+}
+
+// SetNillableCategoriesMoved sets the "categories_moved" field if the given value is not nil.
+func (_c *CategoryDeleteJobCreate) SetNillableCategoriesMoved(v *int64) *CategoryDeleteJobCreate {
+	if v != nil {
+		_c.SetCategoriesMoved(*v)
+	}
+	return _c
+}
+
+// SetDocumentsDeleted sets the "documents_deleted" field.
+func (_c *CategoryDeleteJobCreate) SetDocumentsDeleted(v int64) *CategoryDeleteJobCreate {
+	_c.mutation.SetDocumentsDeleted(v)
+	return _c
+}
+
+// SetNillableDocumentsDeleted sets the "documents_deleted" field if the given value is not nil.
+func (_c *CategoryDeleteJobCreate) SetNillableDocumentsDeleted(v *int64) *CategoryDeleteJobCreate {
+	if v != nil {
+		_c.SetDocumentsDeleted(*v)
+	}
+	return _c
+}
+
+// SetDocumentsMoved sets the "documents_moved" field.
+func (_c *CategoryDeleteJobCreate) SetDocumentsMoved(v int64) *CategoryDeleteJobCreate {
+	_c.mutation.SetDocumentsMoved(v)
+	return _c
+}
+
+// SetNillableDocumentsMoved sets the "documents_moved" field if the given value is not nil.
+func (_c *CategoryDeleteJobCreate) SetNillableDocumentsMoved(v *int64) *CategoryDeleteJobCreate {
+	if v != nil {
+		_c.SetDocumentsMoved(*v)
+	}
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *CategoryDeleteJobCreate) SetError(v string) *CategoryDeleteJobCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *CategoryDeleteJobCreate) SetNillableError(v *string) *CategoryDeleteJobCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetLeaseUntil sets the "lease_until" field.
+func (_c *CategoryDeleteJobCreate) SetLeaseUntil(v time.Time) *CategoryDeleteJobCreate {
+	_c.mutation.SetLeaseUntil(v)
+	return _c
+}
+
+// SetNillableLeaseUntil sets the "lease_until" field if the given value is not nil.
+func (_c *CategoryDeleteJobCreate) SetNillableLeaseUntil(v *time.Time) *CategoryDeleteJobCreate {
+	if v != nil {
+		_c.SetLeaseUntil(*v)
+	}
+	return _c
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_c *CategoryDeleteJobCreate) SetFinishedAt(v time.Time) *CategoryDeleteJobCreate {
+	_c.mutation.SetFinishedAt(v)
+	return _c
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_c *CategoryDeleteJobCreate) SetNillableFinishedAt(v *time.Time) *CategoryDeleteJobCreate {
+	if v != nil {
+		_c.SetFinishedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CategoryDeleteJobCreate) SetID(v string) *CategoryDeleteJobCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the CategoryDeleteJobMutation object of the builder.
+func (_c *CategoryDeleteJobCreate) Mutation() *CategoryDeleteJobMutation {
+	return _c.mutation
+}
+
+// Save creates the CategoryDeleteJob in the database.
+func (_c *CategoryDeleteJobCreate) Save(ctx context.Context) (*CategoryDeleteJob, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CategoryDeleteJobCreate) SaveX(ctx context.Context) *CategoryDeleteJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CategoryDeleteJobCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CategoryDeleteJobCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CategoryDeleteJobCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := categorydeletejob.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := categorydeletejob.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.TotalItems(); !ok {
+		v := categorydeletejob.DefaultTotalItems
+		_c.mutation.SetTotalItems(v)
+	}
+	if _, ok := _c.mutation.ProcessedItems(); !ok {
+		v := categorydeletejob.DefaultProcessedItems
+		_c.mutation.SetProcessedItems(v)
+	}
+	if _, ok := _c.mutation.CategoriesDeleted(); !ok {
+		v := categorydeletejob.DefaultCategoriesDeleted
+		_c.mutation.SetCategoriesDeleted(v)
+	}
+	if _, ok := _c.mutation.CategoriesMoved(); !ok {
+		v := categorydeletejob.DefaultCategoriesMoved
+		_c.mutation.SetCategoriesMoved(v)
+	}
+	if _, ok := _c.mutation.DocumentsDeleted(); !ok {
+		v := categorydeletejob.DefaultDocumentsDeleted
+		_c.mutation.SetDocumentsDeleted(v)
+	}
+	if _, ok := _c.mutation.DocumentsMoved(); !ok {
+		v := categorydeletejob.DefaultDocumentsMoved
+		_c.mutation.SetDocumentsMoved(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CategoryDeleteJobCreate) check() error {
+	if _, ok := _c.mutation.CategoryID(); !ok {
+		return &ValidationError{Name: "category_id", err: errors.New(`ent: missing required field "CategoryDeleteJob.category_id"`)}
+	}
+	if v, ok := _c.mutation.CategoryID(); ok {
+		if err := categorydeletejob.CategoryIDValidator(v); err != nil {
+			return &ValidationError{Name: "category_id", err: fmt.Errorf(`ent: validator failed for field "CategoryDeleteJob.category_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CategoryPath(); !ok {
+		return &ValidationError{Name: "category_path", err: errors.New(`ent: missing required field "CategoryDeleteJob.category_path"`)}
+	}
+	if v, ok := _c.mutation.CategoryPath(); ok {
+		if err := categorydeletejob.CategoryPathValidator(v); err != nil {
+			return &ValidationError{Name: "category_path", err: fmt.Errorf(`ent: validator failed for field "CategoryDeleteJob.category_path": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Mode(); !ok {
+		return &ValidationError{Name: "mode", err: errors.New(`ent: missing required field "CategoryDeleteJob.mode"`)}
+	}
+	if v, ok := _c.mutation.Mode(); ok {
+		if err := categorydeletejob.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "CategoryDeleteJob.mode": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "CategoryDeleteJob.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := categorydeletejob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CategoryDeleteJob.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TotalItems(); !ok {
+		return &ValidationError{Name: "total_items", err: errors.New(`ent: missing required field "CategoryDeleteJob.total_items"`)}
+	}
+	if _, ok := _c.mutation.ProcessedItems(); !ok {
+		return &ValidationError{Name: "processed_items", err: errors.New(`ent: missing required field "CategoryDeleteJob.processed_items"`)}
+	}
+	if _, ok := _c.mutation.CategoriesDeleted(); !ok {
+		return &ValidationError{Name: "categories_deleted", err: errors.New(`ent: missing required field "CategoryDeleteJob.categories_deleted"`)}
+	}
+	if _, ok := _c.mutation.CategoriesMoved(); !ok {
+		return &ValidationError{Name: "categories_moved", err: errors.New(`ent: missing required field "CategoryDeleteJob.categories_moved"`)}
+	}
+	if _, ok := _c.mutation.DocumentsDeleted(); !ok {
+		return &ValidationError{Name: "documents_deleted", err: errors.New(`ent: missing required field "CategoryDeleteJob.documents_deleted"`)}
+	}
+	if _, ok := _c.mutation.DocumentsMoved(); !ok {
+		return &ValidationError{Name: "documents_moved", err: errors.New(`ent: missing required field "CategoryDeleteJob.documents_moved"`)}
+	}
+	if v, ok := _c.mutation.Error(); ok {
+		if err := categorydeletejob.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "CategoryDeleteJob.error": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := categorydeletejob.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "CategoryDeleteJob.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *CategoryDeleteJobCreate) sqlSave(ctx context.Context) (*CategoryDeleteJob, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected CategoryDeleteJob.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CategoryDeleteJobCreate) createSpec() (*CategoryDeleteJob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CategoryDeleteJob{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(categorydeletejob.Table, sqlgraph.NewFieldSpec(categorydeletejob.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateBy(); ok {
+		_spec.SetField(categorydeletejob.FieldCreateBy, field.TypeUint32, value)
+		_node.CreateBy = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(categorydeletejob.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(categorydeletejob.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(categorydeletejob.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(categorydeletejob.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.CategoryID(); ok {
+		_spec.SetField(categorydeletejob.FieldCategoryID, field.TypeString, value)
+		_node.CategoryID = value
+	}
+	if value, ok := _c.mutation.CategoryPath(); ok {
+		_spec.SetField(categorydeletejob.FieldCategoryPath, field.TypeString, value)
+		_node.CategoryPath = value
+	}
+	if value, ok := _c.mutation.Mode(); ok {
+		_spec.SetField(categorydeletejob.FieldMode, field.TypeEnum, value)
+		_node.Mode = value
+	}
+	if value, ok := _c.mutation.TargetCategoryID(); ok {
+		_spec.SetField(categorydeletejob.FieldTargetCategoryID, field.TypeString, value)
+		_node.TargetCategoryID = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(categorydeletejob.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.TotalItems(); ok {
+		_spec.SetField(categorydeletejob.FieldTotalItems, field.TypeInt64, value)
+		_node.TotalItems = value
+	}
+	if value, ok := _c.mutation.ProcessedItems(); ok {
+		_spec.SetField(categorydeletejob.FieldProcessedItems, field.TypeInt64, value)
+		_node.ProcessedItems = value
+	}
+	if value, ok := _c.mutation.CategoriesDeleted(); ok {
+		_spec.SetField(categorydeletejob.FieldCategoriesDeleted, field.TypeInt64, value)
+		_node.CategoriesDeleted = value
+	}
+	if value, ok := _c.mutation.CategoriesMoved(); ok {
+		_spec.SetField(categorydeletejob.FieldCategoriesMoved, field.TypeInt64, value)
+		_node.CategoriesMoved = value
+	}
+	if value, ok := _c.mutation.DocumentsDeleted(); ok {
+		_spec.SetField(categorydeletejob.FieldDocumentsDeleted, field.TypeInt64, value)
+		_node.DocumentsDeleted = value
+	}
+	if value, ok := _c.mutation.DocumentsMoved(); ok {
+		_spec.SetField(categorydeletejob.FieldDocumentsMoved, field.TypeInt64, value)
+		_node.DocumentsMoved = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(categorydeletejob.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := _c.mutation.LeaseUntil(); ok {
+		_spec.SetField(categorydeletejob.FieldLeaseUntil, field.TypeTime, value)
+		_node.LeaseUntil = &value
+	}
+	if value, ok := _c.mutation.FinishedAt(); ok {
+		_spec.SetField(categorydeletejob.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CategoryDeleteJob.Create().
+//		SetCreateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CategoryDeleteJobUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CategoryDeleteJobCreate) OnConflict(opts ...sql.ConflictOption) *CategoryDeleteJobUpsertOne {
+	_c.conflict = opts
+	return &CategoryDeleteJobUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CategoryDeleteJob.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CategoryDeleteJobCreate) OnConflictColumns(columns ...string) *CategoryDeleteJobUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CategoryDeleteJobUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// CategoryDeleteJobUpsertOne is the builder for "upsert"-ing
+	//  one CategoryDeleteJob node.
+	CategoryDeleteJobUpsertOne struct {
+		create *CategoryDeleteJobCreate
+	}
+
+	// CategoryDeleteJobUpsert is the "OnConflict" setter.
+	CategoryDeleteJobUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateBy sets the "create_by" field.
+func (u *CategoryDeleteJobUpsert) SetCreateBy(v uint32) *CategoryDeleteJobUpsert {
+	u.Set(categorydeletejob.FieldCreateBy, v)
+	return u
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsert) UpdateCreateBy() *CategoryDeleteJobUpsert {
+	u.SetExcluded(categorydeletejob.FieldCreateBy)
+	return u
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *CategoryDeleteJobUpsert) AddCreateBy(v uint32) *CategoryDeleteJobUpsert {
+	u.Add(categorydeletejob.FieldCreateBy, v)
+	return u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *CategoryDeleteJobUpsert) ClearCreateBy() *CategoryDeleteJobUpsert {
+	u.SetNull(categorydeletejob.FieldCreateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *CategoryDeleteJobUpsert) SetUpdateTime(v time.Time) *CategoryDeleteJobUpsert {
+	u.Set(categorydeletejob.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsert) UpdateUpdateTime() *CategoryDeleteJobUpsert {
+	u.SetExcluded(categorydeletejob.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *CategoryDeleteJobUpsert) ClearUpdateTime() *CategoryDeleteJobUpsert {
+	u.SetNull(categorydeletejob.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *CategoryDeleteJobUpsert) SetDeleteTime(v time.Time) *CategoryDeleteJobUpsert {
+	u.Set(categorydeletejob.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsert) UpdateDeleteTime() *CategoryDeleteJobUpsert {
+	u.SetExcluded(categorydeletejob.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *CategoryDeleteJobUpsert) ClearDeleteTime() *CategoryDeleteJobUpsert {
+	u.SetNull(categorydeletejob.FieldDeleteTime)
+	return u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *CategoryDeleteJobUpsert) SetCategoryID(v string) *CategoryDeleteJobUpsert {
+	u.Set(categorydeletejob.FieldCategoryID, v)
+	return u
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsert) UpdateCategoryID() *CategoryDeleteJobUpsert {
+	u.SetExcluded(categorydeletejob.FieldCategoryID)
+	return u
+}
+
+// SetCategoryPath sets the "category_path" field.
+func (u *CategoryDeleteJobUpsert) SetCategoryPath(v string) *CategoryDeleteJobUpsert {
+	u.Set(categorydeletejob.FieldCategoryPath, v)
+	return u
+}
+
+// UpdateCategoryPath sets the "category_path" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsert) UpdateCategoryPath() *CategoryDeleteJobUpsert {
+	u.SetExcluded(categorydeletejob.FieldCategoryPath)
+	return u
+}
+
+// SetMode sets the "mode" field.
+func (u *CategoryDeleteJobUpsert) SetMode(v categorydeletejob.Mode) *CategoryDeleteJobUpsert {
+	u.Set(categorydeletejob.FieldMode, v)
+	return u
+}
+
+// UpdateMode sets the "mode" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsert) UpdateMode() *CategoryDeleteJobUpsert {
+	u.SetExcluded(categorydeletejob.FieldMode)
+	return u
+}
+
+// SetTargetCategoryID sets the "target_category_id" field.
+func (u *CategoryDeleteJobUpsert) SetTargetCategoryID(v string) *CategoryDeleteJobUpsert {
+	u.Set(categorydeletejob.FieldTargetCategoryID, v)
+	return u
+}
+
+// UpdateTargetCategoryID sets the "target_category_id" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsert) UpdateTargetCategoryID() *CategoryDeleteJobUpsert {
+	u.SetExcluded(categorydeletejob.FieldTargetCategoryID)
+	return u
+}
+
+// ClearTargetCategoryID clears the value of the "target_category_id" field.
+func (u *CategoryDeleteJobUpsert) ClearTargetCategoryID() *CategoryDeleteJobUpsert {
+	u.SetNull(categorydeletejob.FieldTargetCategoryID)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *CategoryDeleteJobUpsert) SetStatus(v categorydeletejob.Status) *CategoryDeleteJobUpsert {
+	u.Set(categorydeletejob.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsert) UpdateStatus() *CategoryDeleteJobUpsert {
+	u.SetExcluded(categorydeletejob.FieldStatus)
+	return u
+}
+
+// SetTotalItems sets the "total_items" field.
+func (u *CategoryDeleteJobUpsert) SetTotalItems(v int64) *CategoryDeleteJobUpsert {
+	u.Set(categorydeletejob.FieldTotalItems, v)
+	return u
+}
+
+// UpdateTotalItems sets the "total_items" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsert) UpdateTotalItems() *CategoryDeleteJobUpsert {
+	u.SetExcluded(categorydeletejob.FieldTotalItems)
+	return u
+}
+
+// AddTotalItems adds v to the "total_items" field.
+func (u *CategoryDeleteJobUpsert) AddTotalItems(v int64) *CategoryDeleteJobUpsert {
+	u.Add(categorydeletejob.FieldTotalItems, v)
+	return u
+}
+
+// SetProcessedItems sets the "processed_items" field.
+func (u *CategoryDeleteJobUpsert) SetProcessedItems(v int64) *CategoryDeleteJobUpsert {
+	u.Set(categorydeletejob.FieldProcessedItems, v)
+	return u
+}
+
+// UpdateProcessedItems sets the "processed_items" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsert) UpdateProcessedItems() *CategoryDeleteJobUpsert {
+	u.SetExcluded(categorydeletejob.FieldProcessedItems)
+	return u
+}
+
+// AddProcessedItems adds v to the "processed_items" field.
+func (u *CategoryDeleteJobUpsert) AddProcessedItems(v int64) *CategoryDeleteJobUpsert {
+	u.Add(categorydeletejob.FieldProcessedItems, v)
+	return u
+}
+
+// SetCategoriesDeleted sets the "categories_deleted" field.
+func (u *CategoryDeleteJobUpsert) SetCategoriesDeleted(v int64) *CategoryDeleteJobUpsert {
+	u.Set(categorydeletejob.FieldCategoriesDeleted, v)
+	return u
+}
+
+// UpdateCategoriesDeleted sets the "categories_deleted" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsert) UpdateCategoriesDeleted() *CategoryDeleteJobUpsert {
+	u.SetExcluded(categorydeletejob.FieldCategoriesDeleted)
+	return u
+}
+
+// AddCategoriesDeleted adds v to the "categories_deleted" field.
+func (u *CategoryDeleteJobUpsert) AddCategoriesDeleted(v int64) *CategoryDeleteJobUpsert {
+	u.Add(categorydeletejob.FieldCategoriesDeleted, v)
+	return u
+}
+
+// SetCategoriesMoved sets the "categories_moved" field.
+func (u *CategoryDeleteJobUpsert) SetCategoriesMoved(v int64) *CategoryDeleteJobUpsert {
+	u.Set(categorydeletejob.FieldCategoriesMoved, v)
+	return u
+}
+
+// UpdateCategoriesMoved sets the "categories_moved" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsert) UpdateCategoriesMoved() *CategoryDeleteJobUpsert {
+	u.SetExcluded(categorydeletejob.FieldCategoriesMoved)
+	return u
+}
+
+// AddCategoriesMoved adds v to the "categories_moved" field.
+func (u *CategoryDeleteJobUpsert) AddCategoriesMoved(v int64) *CategoryDeleteJobUpsert {
+	u.Add(categorydeletejob.FieldCategoriesMoved, v)
+	return u
+}
+
+// SetDocumentsDeleted sets the "documents_deleted" field.
+func (u *CategoryDeleteJobUpsert) SetDocumentsDeleted(v int64) *CategoryDeleteJobUpsert {
+	u.Set(categorydeletejob.FieldDocumentsDeleted, v)
+	return u
+}
+
+// UpdateDocumentsDeleted sets the "documents_deleted" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsert) UpdateDocumentsDeleted() *CategoryDeleteJobUpsert {
+	u.SetExcluded(categorydeletejob.FieldDocumentsDeleted)
+	return u
+}
+
+// AddDocumentsDeleted adds v to the "documents_deleted" field.
+func (u *CategoryDeleteJobUpsert) AddDocumentsDeleted(v int64) *CategoryDeleteJobUpsert {
+	u.Add(categorydeletejob.FieldDocumentsDeleted, v)
+	return u
+}
+
+// SetDocumentsMoved sets the "documents_moved" field.
+func (u *CategoryDeleteJobUpsert) SetDocumentsMoved(v int64) *CategoryDeleteJobUpsert {
+	u.Set(categorydeletejob.FieldDocumentsMoved, v)
+	return u
+}
+
+// UpdateDocumentsMoved sets the "documents_moved" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsert) UpdateDocumentsMoved() *CategoryDeleteJobUpsert {
+	u.SetExcluded(categorydeletejob.FieldDocumentsMoved)
+	return u
+}
+
+// AddDocumentsMoved adds v to the "documents_moved" field.
+func (u *CategoryDeleteJobUpsert) AddDocumentsMoved(v int64) *CategoryDeleteJobUpsert {
+	u.Add(categorydeletejob.FieldDocumentsMoved, v)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *CategoryDeleteJobUpsert) SetError(v string) *CategoryDeleteJobUpsert {
+	u.Set(categorydeletejob.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsert) UpdateError() *CategoryDeleteJobUpsert {
+	u.SetExcluded(categorydeletejob.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *CategoryDeleteJobUpsert) ClearError() *CategoryDeleteJobUpsert {
+	u.SetNull(categorydeletejob.FieldError)
+	return u
+}
+
+// SetLeaseUntil sets the "lease_until" field.
+func (u *CategoryDeleteJobUpsert) SetLeaseUntil(v time.Time) *CategoryDeleteJobUpsert {
+	u.Set(categorydeletejob.FieldLeaseUntil, v)
+	return u
+}
+
+// UpdateLeaseUntil sets the "lease_until" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsert) UpdateLeaseUntil() *CategoryDeleteJobUpsert {
+	u.SetExcluded(categorydeletejob.FieldLeaseUntil)
+	return u
+}
+
+// ClearLeaseUntil clears the value of the "lease_until" field.
+func (u *CategoryDeleteJobUpsert) ClearLeaseUntil() *CategoryDeleteJobUpsert {
+	u.SetNull(categorydeletejob.FieldLeaseUntil)
+	return u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *CategoryDeleteJobUpsert) SetFinishedAt(v time.Time) *CategoryDeleteJobUpsert {
+	u.Set(categorydeletejob.FieldFinishedAt, v)
+	return u
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsert) UpdateFinishedAt() *CategoryDeleteJobUpsert {
+	u.SetExcluded(categorydeletejob.FieldFinishedAt)
+	return u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *CategoryDeleteJobUpsert) ClearFinishedAt() *CategoryDeleteJobUpsert {
+	u.SetNull(categorydeletejob.FieldFinishedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CategoryDeleteJob.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(categorydeletejob.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CategoryDeleteJobUpsertOne) UpdateNewValues() *CategoryDeleteJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(categorydeletejob.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(categorydeletejob.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(categorydeletejob.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CategoryDeleteJob.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CategoryDeleteJobUpsertOne) Ignore() *CategoryDeleteJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CategoryDeleteJobUpsertOne) DoNothing() *CategoryDeleteJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CategoryDeleteJobCreate.OnConflict
+// documentation for more info.
+func (u *CategoryDeleteJobUpsertOne) Update(set func(*CategoryDeleteJobUpsert)) *CategoryDeleteJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CategoryDeleteJobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *CategoryDeleteJobUpsertOne) SetCreateBy(v uint32) *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *CategoryDeleteJobUpsertOne) AddCreateBy(v uint32) *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsertOne) UpdateCreateBy() *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *CategoryDeleteJobUpsertOne) ClearCreateBy() *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *CategoryDeleteJobUpsertOne) SetUpdateTime(v time.Time) *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsertOne) UpdateUpdateTime() *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *CategoryDeleteJobUpsertOne) ClearUpdateTime() *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *CategoryDeleteJobUpsertOne) SetDeleteTime(v time.Time) *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsertOne) UpdateDeleteTime() *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *CategoryDeleteJobUpsertOne) ClearDeleteTime() *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *CategoryDeleteJobUpsertOne) SetCategoryID(v string) *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.SetCategoryID(v)
+	})
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsertOne) UpdateCategoryID() *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.UpdateCategoryID()
+	})
+}
+
+// SetCategoryPath sets the "category_path" field.
+func (u *CategoryDeleteJobUpsertOne) SetCategoryPath(v string) *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.SetCategoryPath(v)
+	})
+}
+
+// UpdateCategoryPath sets the "category_path" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsertOne) UpdateCategoryPath() *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.UpdateCategoryPath()
+	})
+}
+
+// SetMode sets the "mode" field.
+func (u *CategoryDeleteJobUpsertOne) SetMode(v categorydeletejob.Mode) *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.SetMode(v)
+	})
+}
+
+// UpdateMode sets the "mode" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsertOne) UpdateMode() *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.UpdateMode()
+	})
+}
+
+// SetTargetCategoryID sets the "target_category_id" field.
+func (u *CategoryDeleteJobUpsertOne) SetTargetCategoryID(v string) *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.SetTargetCategoryID(v)
+	})
+}
+
+// UpdateTargetCategoryID sets the "target_category_id" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsertOne) UpdateTargetCategoryID() *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.UpdateTargetCategoryID()
+	})
+}
+
+// ClearTargetCategoryID clears the value of the "target_category_id" field.
+func (u *CategoryDeleteJobUpsertOne) ClearTargetCategoryID() *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.ClearTargetCategoryID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *CategoryDeleteJobUpsertOne) SetStatus(v categorydeletejob.Status) *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsertOne) UpdateStatus() *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetTotalItems sets the "total_items" field.
+func (u *CategoryDeleteJobUpsertOne) SetTotalItems(v int64) *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.SetTotalItems(v)
+	})
+}
+
+// AddTotalItems adds v to the "total_items" field.
+func (u *CategoryDeleteJobUpsertOne) AddTotalItems(v int64) *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.AddTotalItems(v)
+	})
+}
+
+// UpdateTotalItems sets the "total_items" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsertOne) UpdateTotalItems() *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.UpdateTotalItems()
+	})
+}
+
+// SetProcessedItems sets the "processed_items" field.
+func (u *CategoryDeleteJobUpsertOne) SetProcessedItems(v int64) *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.SetProcessedItems(v)
+	})
+}
+
+// AddProcessedItems adds v to the "processed_items" field.
+func (u *CategoryDeleteJobUpsertOne) AddProcessedItems(v int64) *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.AddProcessedItems(v)
+	})
+}
+
+// UpdateProcessedItems sets the "processed_items" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsertOne) UpdateProcessedItems() *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.UpdateProcessedItems()
+	})
+}
+
+// SetCategoriesDeleted sets the "categories_deleted" field.
+func (u *CategoryDeleteJobUpsertOne) SetCategoriesDeleted(v int64) *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.SetCategoriesDeleted(v)
+	})
+}
+
+// AddCategoriesDeleted adds v to the "categories_deleted" field.
+func (u *CategoryDeleteJobUpsertOne) AddCategoriesDeleted(v int64) *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.AddCategoriesDeleted(v)
+	})
+}
+
+// UpdateCategoriesDeleted sets the "categories_deleted" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsertOne) UpdateCategoriesDeleted() *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.UpdateCategoriesDeleted()
+	})
+}
+
+// SetCategoriesMoved sets the "categories_moved" field.
+func (u *CategoryDeleteJobUpsertOne) SetCategoriesMoved(v int64) *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.SetCategoriesMoved(v)
+	})
+}
+
+// AddCategoriesMoved adds v to the "categories_moved" field.
+func (u *CategoryDeleteJobUpsertOne) AddCategoriesMoved(v int64) *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.AddCategoriesMoved(v)
+	})
+}
+
+// UpdateCategoriesMoved sets the "categories_moved" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsertOne) UpdateCategoriesMoved() *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.UpdateCategoriesMoved()
+	})
+}
+
+// SetDocumentsDeleted sets the "documents_deleted" field.
+func (u *CategoryDeleteJobUpsertOne) SetDocumentsDeleted(v int64) *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.SetDocumentsDeleted(v)
+	})
+}
+
+// AddDocumentsDeleted adds v to the "documents_deleted" field.
+func (u *CategoryDeleteJobUpsertOne) AddDocumentsDeleted(v int64) *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.AddDocumentsDeleted(v)
+	})
+}
+
+// UpdateDocumentsDeleted sets the "documents_deleted" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsertOne) UpdateDocumentsDeleted() *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.UpdateDocumentsDeleted()
+	})
+}
+
+// SetDocumentsMoved sets the "documents_moved" field.
+func (u *CategoryDeleteJobUpsertOne) SetDocumentsMoved(v int64) *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.SetDocumentsMoved(v)
+	})
+}
+
+// AddDocumentsMoved adds v to the "documents_moved" field.
+func (u *CategoryDeleteJobUpsertOne) AddDocumentsMoved(v int64) *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.AddDocumentsMoved(v)
+	})
+}
+
+// UpdateDocumentsMoved sets the "documents_moved" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsertOne) UpdateDocumentsMoved() *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.UpdateDocumentsMoved()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *CategoryDeleteJobUpsertOne) SetError(v string) *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsertOne) UpdateError() *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *CategoryDeleteJobUpsertOne) ClearError() *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetLeaseUntil sets the "lease_until" field.
+func (u *CategoryDeleteJobUpsertOne) SetLeaseUntil(v time.Time) *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.SetLeaseUntil(v)
+	})
+}
+
+// UpdateLeaseUntil sets the "lease_until" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsertOne) UpdateLeaseUntil() *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.UpdateLeaseUntil()
+	})
+}
+
+// ClearLeaseUntil clears the value of the "lease_until" field.
+func (u *CategoryDeleteJobUpsertOne) ClearLeaseUntil() *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.ClearLeaseUntil()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *CategoryDeleteJobUpsertOne) SetFinishedAt(v time.Time) *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsertOne) UpdateFinishedAt() *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *CategoryDeleteJobUpsertOne) ClearFinishedAt() *CategoryDeleteJobUpsertOne {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CategoryDeleteJobUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CategoryDeleteJobCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CategoryDeleteJobUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CategoryDeleteJobUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CategoryDeleteJobUpsertOne.ID is not supported by MySQL driver. Use CategoryDeleteJobUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CategoryDeleteJobUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CategoryDeleteJobCreateBulk is the builder for creating many CategoryDeleteJob entities in bulk.
+type CategoryDeleteJobCreateBulk struct {
+	config
+	err      error
+	builders []*CategoryDeleteJobCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CategoryDeleteJob entities in the database.
+func (_c *CategoryDeleteJobCreateBulk) Save(ctx context.Context) ([]*CategoryDeleteJob, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CategoryDeleteJob, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CategoryDeleteJobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CategoryDeleteJobCreateBulk) SaveX(ctx context.Context) []*CategoryDeleteJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CategoryDeleteJobCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CategoryDeleteJobCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CategoryDeleteJob.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CategoryDeleteJobUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CategoryDeleteJobCreateBulk) OnConflict(opts ...sql.ConflictOption) *CategoryDeleteJobUpsertBulk {
+	_c.conflict = opts
+	return &CategoryDeleteJobUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CategoryDeleteJob.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CategoryDeleteJobCreateBulk) OnConflictColumns(columns ...string) *CategoryDeleteJobUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CategoryDeleteJobUpsertBulk{
+		create: _c,
+	}
+}
+
+// CategoryDeleteJobUpsertBulk is the builder for "upsert"-ing
+// a bulk of CategoryDeleteJob nodes.
+type CategoryDeleteJobUpsertBulk struct {
+	create *CategoryDeleteJobCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CategoryDeleteJob.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(categorydeletejob.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CategoryDeleteJobUpsertBulk) UpdateNewValues() *CategoryDeleteJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(categorydeletejob.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(categorydeletejob.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(categorydeletejob.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CategoryDeleteJob.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CategoryDeleteJobUpsertBulk) Ignore() *CategoryDeleteJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CategoryDeleteJobUpsertBulk) DoNothing() *CategoryDeleteJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CategoryDeleteJobCreateBulk.OnConflict
+// documentation for more info.
+func (u *CategoryDeleteJobUpsertBulk) Update(set func(*CategoryDeleteJobUpsert)) *CategoryDeleteJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CategoryDeleteJobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *CategoryDeleteJobUpsertBulk) SetCreateBy(v uint32) *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *CategoryDeleteJobUpsertBulk) AddCreateBy(v uint32) *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsertBulk) UpdateCreateBy() *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *CategoryDeleteJobUpsertBulk) ClearCreateBy() *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *CategoryDeleteJobUpsertBulk) SetUpdateTime(v time.Time) *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsertBulk) UpdateUpdateTime() *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *CategoryDeleteJobUpsertBulk) ClearUpdateTime() *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *CategoryDeleteJobUpsertBulk) SetDeleteTime(v time.Time) *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsertBulk) UpdateDeleteTime() *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *CategoryDeleteJobUpsertBulk) ClearDeleteTime() *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *CategoryDeleteJobUpsertBulk) SetCategoryID(v string) *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.SetCategoryID(v)
+	})
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsertBulk) UpdateCategoryID() *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.UpdateCategoryID()
+	})
+}
+
+// SetCategoryPath sets the "category_path" field.
+func (u *CategoryDeleteJobUpsertBulk) SetCategoryPath(v string) *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.SetCategoryPath(v)
+	})
+}
+
+// UpdateCategoryPath sets the "category_path" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsertBulk) UpdateCategoryPath() *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.UpdateCategoryPath()
+	})
+}
+
+// SetMode sets the "mode" field.
+func (u *CategoryDeleteJobUpsertBulk) SetMode(v categorydeletejob.Mode) *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.SetMode(v)
+	})
+}
+
+// UpdateMode sets the "mode" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsertBulk) UpdateMode() *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.UpdateMode()
+	})
+}
+
+// SetTargetCategoryID sets the "target_category_id" field.
+func (u *CategoryDeleteJobUpsertBulk) SetTargetCategoryID(v string) *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.SetTargetCategoryID(v)
+	})
+}
+
+// UpdateTargetCategoryID sets the "target_category_id" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsertBulk) UpdateTargetCategoryID() *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.UpdateTargetCategoryID()
+	})
+}
+
+// ClearTargetCategoryID clears the value of the "target_category_id" field.
+func (u *CategoryDeleteJobUpsertBulk) ClearTargetCategoryID() *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.ClearTargetCategoryID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *CategoryDeleteJobUpsertBulk) SetStatus(v categorydeletejob.Status) *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsertBulk) UpdateStatus() *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetTotalItems sets the "total_items" field.
+func (u *CategoryDeleteJobUpsertBulk) SetTotalItems(v int64) *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.SetTotalItems(v)
+	})
+}
+
+// AddTotalItems adds v to the "total_items" field.
+func (u *CategoryDeleteJobUpsertBulk) AddTotalItems(v int64) *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.AddTotalItems(v)
+	})
+}
+
+// UpdateTotalItems sets the "total_items" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsertBulk) UpdateTotalItems() *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.UpdateTotalItems()
+	})
+}
+
+// SetProcessedItems sets the "processed_items" field.
+func (u *CategoryDeleteJobUpsertBulk) SetProcessedItems(v int64) *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.SetProcessedItems(v)
+	})
+}
+
+// AddProcessedItems adds v to the "processed_items" field.
+func (u *CategoryDeleteJobUpsertBulk) AddProcessedItems(v int64) *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.AddProcessedItems(v)
+	})
+}
+
+// UpdateProcessedItems sets the "processed_items" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsertBulk) UpdateProcessedItems() *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.UpdateProcessedItems()
+	})
+}
+
+// SetCategoriesDeleted sets the "categories_deleted" field.
+func (u *CategoryDeleteJobUpsertBulk) SetCategoriesDeleted(v int64) *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.SetCategoriesDeleted(v)
+	})
+}
+
+// AddCategoriesDeleted adds v to the "categories_deleted" field.
+func (u *CategoryDeleteJobUpsertBulk) AddCategoriesDeleted(v int64) *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.AddCategoriesDeleted(v)
+	})
+}
+
+// UpdateCategoriesDeleted sets the "categories_deleted" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsertBulk) UpdateCategoriesDeleted() *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.UpdateCategoriesDeleted()
+	})
+}
+
+// SetCategoriesMoved sets the "categories_moved" field.
+func (u *CategoryDeleteJobUpsertBulk) SetCategoriesMoved(v int64) *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.SetCategoriesMoved(v)
+	})
+}
+
+// AddCategoriesMoved adds v to the "categories_moved" field.
+func (u *CategoryDeleteJobUpsertBulk) AddCategoriesMoved(v int64) *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.AddCategoriesMoved(v)
+	})
+}
+
+// UpdateCategoriesMoved sets the "categories_moved" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsertBulk) UpdateCategoriesMoved() *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.UpdateCategoriesMoved()
+	})
+}
+
+// SetDocumentsDeleted sets the "documents_deleted" field.
+func (u *CategoryDeleteJobUpsertBulk) SetDocumentsDeleted(v int64) *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.SetDocumentsDeleted(v)
+	})
+}
+
+// AddDocumentsDeleted adds v to the "documents_deleted" field.
+func (u *CategoryDeleteJobUpsertBulk) AddDocumentsDeleted(v int64) *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.AddDocumentsDeleted(v)
+	})
+}
+
+// UpdateDocumentsDeleted sets the "documents_deleted" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsertBulk) UpdateDocumentsDeleted() *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.UpdateDocumentsDeleted()
+	})
+}
+
+// SetDocumentsMoved sets the "documents_moved" field.
+func (u *CategoryDeleteJobUpsertBulk) SetDocumentsMoved(v int64) *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.SetDocumentsMoved(v)
+	})
+}
+
+// AddDocumentsMoved adds v to the "documents_moved" field.
+func (u *CategoryDeleteJobUpsertBulk) AddDocumentsMoved(v int64) *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.AddDocumentsMoved(v)
+	})
+}
+
+// UpdateDocumentsMoved sets the "documents_moved" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsertBulk) UpdateDocumentsMoved() *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.UpdateDocumentsMoved()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *CategoryDeleteJobUpsertBulk) SetError(v string) *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsertBulk) UpdateError() *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *CategoryDeleteJobUpsertBulk) ClearError() *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetLeaseUntil sets the "lease_until" field.
+func (u *CategoryDeleteJobUpsertBulk) SetLeaseUntil(v time.Time) *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.SetLeaseUntil(v)
+	})
+}
+
+// UpdateLeaseUntil sets the "lease_until" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsertBulk) UpdateLeaseUntil() *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.UpdateLeaseUntil()
+	})
+}
+
+// ClearLeaseUntil clears the value of the "lease_until" field.
+func (u *CategoryDeleteJobUpsertBulk) ClearLeaseUntil() *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.ClearLeaseUntil()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *CategoryDeleteJobUpsertBulk) SetFinishedAt(v time.Time) *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *CategoryDeleteJobUpsertBulk) UpdateFinishedAt() *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *CategoryDeleteJobUpsertBulk) ClearFinishedAt() *CategoryDeleteJobUpsertBulk {
+	return u.Update(func(s *CategoryDeleteJobUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CategoryDeleteJobUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CategoryDeleteJobCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CategoryDeleteJobCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CategoryDeleteJobUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1114 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/categorydeletejob"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+)
+
+// CategoryDeleteJobUpdate is the builder for updating CategoryDeleteJob entities.
+type CategoryDeleteJobUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CategoryDeleteJobMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CategoryDeleteJobUpdate builder.
+func (_u *CategoryDeleteJobUpdate) Where(ps ...predicate.CategoryDeleteJob) *CategoryDeleteJobUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *CategoryDeleteJobUpdate) SetCreateBy(v uint32) *CategoryDeleteJobUpdate {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *CategoryDeleteJobUpdate) SetNillableCreateBy(v *uint32) *CategoryDeleteJobUpdate {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *CategoryDeleteJobUpdate) AddCreateBy(v int32) *CategoryDeleteJobUpdate {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *CategoryDeleteJobUpdate) ClearCreateBy() *CategoryDeleteJobUpdate {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *CategoryDeleteJobUpdate) SetUpdateTime(v time.Time) *CategoryDeleteJobUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *CategoryDeleteJobUpdate) SetNillableUpdateTime(v *time.Time) *CategoryDeleteJobUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *CategoryDeleteJobUpdate) ClearUpdateTime() *CategoryDeleteJobUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *CategoryDeleteJobUpdate) SetDeleteTime(v time.Time) *CategoryDeleteJobUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *CategoryDeleteJobUpdate) SetNillableDeleteTime(v *time.Time) *CategoryDeleteJobUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *CategoryDeleteJobUpdate) ClearDeleteTime() *CategoryDeleteJobUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *CategoryDeleteJobUpdate) SetCategoryID(v string) *CategoryDeleteJobUpdate {
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *CategoryDeleteJobUpdate) SetNillableCategoryID(v *string) *CategoryDeleteJobUpdate {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// SetCategoryPath sets the "category_path" field.
+func (_u *CategoryDeleteJobUpdate) SetCategoryPath(v string) *CategoryDeleteJobUpdate {
+	_u.mutation.SetCategoryPath(v)
+	return _u
+}
+
+// SetNillableCategoryPath sets the "category_path" field if the given value is not nil.
+func (_u *CategoryDeleteJobUpdate) SetNillableCategoryPath(v *string) *CategoryDeleteJobUpdate {
+	if v != nil {
+		_u.SetCategoryPath(*v)
+	}
+	return _u
+}
+
+// SetMode sets the "mode" field.
+func (_u *CategoryDeleteJobUpdate) SetMode(v categorydeletejob.Mode) *CategoryDeleteJobUpdate {
+	_u.mutation.SetMode(v)
+	return _u
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_u *CategoryDeleteJobUpdate) SetNillableMode(v *categorydeletejob.Mode) *CategoryDeleteJobUpdate {
+	if v != nil {
+		_u.SetMode(*v)
+	}
+	return _u
+}
+
+// SetTargetCategoryID sets the "target_category_id" field.
+func (_u *CategoryDeleteJobUpdate) SetTargetCategoryID(v string) *CategoryDeleteJobUpdate {
+	_u.mutation.SetTargetCategoryID(v)
+	return _u
+}
+
+// SetNillableTargetCategoryID sets the "target_category_id" field if the given value is not nil.
+func (_u *CategoryDeleteJobUpdate) SetNillableTargetCategoryID(v *string) *CategoryDeleteJobUpdate {
+	if v != nil {
+		_u.SetTargetCategoryID(*v)
+	}
+	return _u
+}
+
+// ClearTargetCategoryID clears the value of the "target_category_id" field.
+func (_u *CategoryDeleteJobUpdate) ClearTargetCategoryID() *CategoryDeleteJobUpdate {
+	_u.mutation.ClearTargetCategoryID()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CategoryDeleteJobUpdate) SetStatus(v categorydeletejob.Status) *CategoryDeleteJobUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CategoryDeleteJobUpdate) SetNillableStatus(v *categorydeletejob.Status) *CategoryDeleteJobUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetTotalItems sets the "total_items" field.
+func (_u *CategoryDeleteJobUpdate) SetTotalItems(v int64) *CategoryDeleteJobUpdate {
+	_u.mutation.ResetTotalItems()
+	_u.mutation.SetTotalItems(v)
+	return _u
+}
+
+// SetNillableTotalItems sets the "total_items" field if the given value is not nil.
+func (_u *CategoryDeleteJobUpdate) SetNillableTotalItems(v *int64) *CategoryDeleteJobUpdate {
+	if v != nil {
+		_u.SetTotalItems(*v)
+	}
+	return _u
+}
+
+// AddTotalItems adds value to the "total_items" field.
+func (_u *CategoryDeleteJobUpdate) AddTotalItems(v int64) *CategoryDeleteJobUpdate {
+	_u.mutation.AddTotalItems(v)
+	return _u
+}
+
+// SetProcessedItems sets the "processed_items" field.
+func (_u *CategoryDeleteJobUpdate) SetProcessedItems(v int64) *CategoryDeleteJobUpdate {
+	_u.mutation.ResetProcessedItems()
+	_u.mutation.SetProcessedItems(v)
+	return _u
+}
+
+// SetNillableProcessedItems sets the "processed_items" field if the given value is not nil.
+func (_u *CategoryDeleteJobUpdate) SetNillableProcessedItems(v *int64) *CategoryDeleteJobUpdate {
+	if v != nil {
+		_u.SetProcessedItems(*v)
+	}
+	return _u
+}
+
+// AddProcessedItems adds value to the "processed_items" field.
+func (_u *CategoryDeleteJobUpdate) AddProcessedItems(v int64) *CategoryDeleteJobUpdate {
+	_u.mutation.AddProcessedItems(v)
+	return _u
+}
+
+// SetCategoriesDeleted sets the "categories_deleted" field.
+func (_u *CategoryDeleteJobUpdate) SetCategoriesDeleted(v int64) *CategoryDeleteJobUpdate {
+	_u.mutation.ResetCategoriesDeleted()
+	_u.mutation.SetCategoriesDeleted(v)
+	return _u
+}
+
+// SetNillableCategoriesDeleted sets the "categories_deleted" field if the given value is not nil.
+func (_u *CategoryDeleteJobUpdate) SetNillableCategoriesDeleted(v *int64) *CategoryDeleteJobUpdate {
+	if v != nil {
+		_u.SetCategoriesDeleted(*v)
+	}
+	return _u
+}
+
+// AddCategoriesDeleted adds value to the "categories_deleted" field.
+func (_u *CategoryDeleteJobUpdate) AddCategoriesDeleted(v int64) *CategoryDeleteJobUpdate {
+	_u.mutation.AddCategoriesDeleted(v)
+	return _u
+}
+
+// SetCategoriesMoved sets the "categories_moved" field.
+func (_u *CategoryDeleteJobUpdate) SetCategoriesMoved(v int64) *CategoryDeleteJobUpdate {
+	_u.mutation.ResetCategoriesMoved()
+	_u.mutation.SetCategoriesMoved(v)
+	return _u
+}
+
+// SetNillableCategoriesMoved sets the "categories_moved" field if the given value is not nil.
+func (_u *CategoryDeleteJobUpdate) SetNillableCategoriesMoved(v *int64) *CategoryDeleteJobUpdate {
+	if v != nil {
+		_u.SetCategoriesMoved(*v)
+	}
+	return _u
+}
+
+// AddCategoriesMoved adds value to the "categories_moved" field.
+func (_u *CategoryDeleteJobUpdate) AddCategoriesMoved(v int64) *CategoryDeleteJobUpdate {
+	_u.mutation.AddCategoriesMoved(v)
+	return _u
+}
+
+// SetDocumentsDeleted sets the "documents_deleted" field.
+func (_u *CategoryDeleteJobUpdate) SetDocumentsDeleted(v int64) *CategoryDeleteJobUpdate {
+	_u.mutation.ResetDocumentsDeleted()
+	_u.mutation.SetDocumentsDeleted(v)
+	return _u
+}
+
+// SetNillableDocumentsDeleted sets the "documents_deleted" field if the given value is not nil.
+func (_u *CategoryDeleteJobUpdate) SetNillableDocumentsDeleted(v *int64) *CategoryDeleteJobUpdate {
+	if v != nil {
+		_u.SetDocumentsDeleted(*v)
+	}
+	return _u
+}
+
+// AddDocumentsDeleted adds value to the "documents_deleted" field.
+func (_u *CategoryDeleteJobUpdate) AddDocumentsDeleted(v int64) *CategoryDeleteJobUpdate {
+	_u.mutation.AddDocumentsDeleted(v)
+	return _u
+}
+
+// SetDocumentsMoved sets the "documents_moved" field.
+func (_u *CategoryDeleteJobUpdate) SetDocumentsMoved(v int64) *CategoryDeleteJobUpdate {
+	_u.mutation.ResetDocumentsMoved()
+	_u.mutation.SetDocumentsMoved(v)
+	return _u
+}
+
+// SetNillableDocumentsMoved sets the "documents_moved" field if the given value is not nil.
+func (_u *CategoryDeleteJobUpdate) SetNillableDocumentsMoved(v *int64) *CategoryDeleteJobUpdate {
+	if v != nil {
+		_u.SetDocumentsMoved(*v)
+	}
+	return _u
+}
+
+// AddDocumentsMoved adds value to the "documents_moved" field.
+func (_u *CategoryDeleteJobUpdate) AddDocumentsMoved(v int64) *CategoryDeleteJobUpdate {
+	_u.mutation.AddDocumentsMoved(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *CategoryDeleteJobUpdate) SetError(v string) *CategoryDeleteJobUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *CategoryDeleteJobUpdate) SetNillableError(v *string) *CategoryDeleteJobUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *CategoryDeleteJobUpdate) ClearError() *CategoryDeleteJobUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetLeaseUntil sets the "lease_until" field.
+func (_u *CategoryDeleteJobUpdate) SetLeaseUntil(v time.Time) *CategoryDeleteJobUpdate {
+	_u.mutation.SetLeaseUntil(v)
+	return _u
+}
+
+// SetNillableLeaseUntil sets the "lease_until" field if the given value is not nil.
+func (_u *CategoryDeleteJobUpdate) SetNillableLeaseUntil(v *time.Time) *CategoryDeleteJobUpdate {
+	if v != nil {
+		_u.SetLeaseUntil(*v)
+	}
+	return _u
+}
+
+// ClearLeaseUntil clears the value of the "lease_until" field.
+func (_u *CategoryDeleteJobUpdate) ClearLeaseUntil() *CategoryDeleteJobUpdate {
+	_u.mutation.ClearLeaseUntil()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *CategoryDeleteJobUpdate) SetFinishedAt(v time.Time) *CategoryDeleteJobUpdate {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *CategoryDeleteJobUpdate) SetNillableFinishedAt(v *time.Time) *CategoryDeleteJobUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *CategoryDeleteJobUpdate) ClearFinishedAt() *CategoryDeleteJobUpdate {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// Mutation returns the CategoryDeleteJobMutation object of the builder.
+func (_u *CategoryDeleteJobUpdate) Mutation() *CategoryDeleteJobMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CategoryDeleteJobUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CategoryDeleteJobUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CategoryDeleteJobUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CategoryDeleteJobUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CategoryDeleteJobUpdate) check() error {
+	if v, ok := _u.mutation.CategoryID(); ok {
+		if err := categorydeletejob.CategoryIDValidator(v); err != nil {
+			return &ValidationError{Name: "category_id", err: fmt.Errorf(`ent: validator failed for field "CategoryDeleteJob.category_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CategoryPath(); ok {
+		if err := categorydeletejob.CategoryPathValidator(v); err != nil {
+			return &ValidationError{Name: "category_path", err: fmt.Errorf(`ent: validator failed for field "CategoryDeleteJob.category_path": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Mode(); ok {
+		if err := categorydeletejob.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "CategoryDeleteJob.mode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := categorydeletejob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CategoryDeleteJob.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Error(); ok {
+		if err := categorydeletejob.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "CategoryDeleteJob.error": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CategoryDeleteJobUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CategoryDeleteJobUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CategoryDeleteJobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(categorydeletejob.Table, categorydeletejob.Columns, sqlgraph.NewFieldSpec(categorydeletejob.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(categorydeletejob.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(categorydeletejob.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(categorydeletejob.FieldCreateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(categorydeletejob.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(categorydeletejob.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(categorydeletejob.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(categorydeletejob.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(categorydeletejob.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(categorydeletejob.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.CategoryID(); ok {
+		_spec.SetField(categorydeletejob.FieldCategoryID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CategoryPath(); ok {
+		_spec.SetField(categorydeletejob.FieldCategoryPath, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Mode(); ok {
+		_spec.SetField(categorydeletejob.FieldMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TargetCategoryID(); ok {
+		_spec.SetField(categorydeletejob.FieldTargetCategoryID, field.TypeString, value)
+	}
+	if _u.mutation.TargetCategoryIDCleared() {
+		_spec.ClearField(categorydeletejob.FieldTargetCategoryID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(categorydeletejob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TotalItems(); ok {
+		_spec.SetField(categorydeletejob.FieldTotalItems, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalItems(); ok {
+		_spec.AddField(categorydeletejob.FieldTotalItems, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ProcessedItems(); ok {
+		_spec.SetField(categorydeletejob.FieldProcessedItems, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedProcessedItems(); ok {
+		_spec.AddField(categorydeletejob.FieldProcessedItems, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CategoriesDeleted(); ok {
+		_spec.SetField(categorydeletejob.FieldCategoriesDeleted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCategoriesDeleted(); ok {
+		_spec.AddField(categorydeletejob.FieldCategoriesDeleted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CategoriesMoved(); ok {
+		_spec.SetField(categorydeletejob.FieldCategoriesMoved, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCategoriesMoved(); ok {
+		_spec.AddField(categorydeletejob.FieldCategoriesMoved, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.DocumentsDeleted(); ok {
+		_spec.SetField(categorydeletejob.FieldDocumentsDeleted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDocumentsDeleted(); ok {
+		_spec.AddField(categorydeletejob.FieldDocumentsDeleted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.DocumentsMoved(); ok {
+		_spec.SetField(categorydeletejob.FieldDocumentsMoved, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDocumentsMoved(); ok {
+		_spec.AddField(categorydeletejob.FieldDocumentsMoved, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(categorydeletejob.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(categorydeletejob.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.LeaseUntil(); ok {
+		_spec.SetField(categorydeletejob.FieldLeaseUntil, field.TypeTime, value)
+	}
+	if _u.mutation.LeaseUntilCleared() {
+		_spec.ClearField(categorydeletejob.FieldLeaseUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(categorydeletejob.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(categorydeletejob.FieldFinishedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{categorydeletejob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CategoryDeleteJobUpdateOne is the builder for updating a single CategoryDeleteJob entity.
+type CategoryDeleteJobUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CategoryDeleteJobMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *CategoryDeleteJobUpdateOne) SetCreateBy(v uint32) *CategoryDeleteJobUpdateOne {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *CategoryDeleteJobUpdateOne) SetNillableCreateBy(v *uint32) *CategoryDeleteJobUpdateOne {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *CategoryDeleteJobUpdateOne) AddCreateBy(v int32) *CategoryDeleteJobUpdateOne {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *CategoryDeleteJobUpdateOne) ClearCreateBy() *CategoryDeleteJobUpdateOne {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *CategoryDeleteJobUpdateOne) SetUpdateTime(v time.Time) *CategoryDeleteJobUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *CategoryDeleteJobUpdateOne) SetNillableUpdateTime(v *time.Time) *CategoryDeleteJobUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *CategoryDeleteJobUpdateOne) ClearUpdateTime() *CategoryDeleteJobUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *CategoryDeleteJobUpdateOne) SetDeleteTime(v time.Time) *CategoryDeleteJobUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *CategoryDeleteJobUpdateOne) SetNillableDeleteTime(v *time.Time) *CategoryDeleteJobUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *CategoryDeleteJobUpdateOne) ClearDeleteTime() *CategoryDeleteJobUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *CategoryDeleteJobUpdateOne) SetCategoryID(v string) *CategoryDeleteJobUpdateOne {
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *CategoryDeleteJobUpdateOne) SetNillableCategoryID(v *string) *CategoryDeleteJobUpdateOne {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// SetCategoryPath sets the "category_path" field.
+func (_u *CategoryDeleteJobUpdateOne) SetCategoryPath(v string) *CategoryDeleteJobUpdateOne {
+	_u.mutation.SetCategoryPath(v)
+	return _u
+}
+
+// SetNillableCategoryPath sets the "category_path" field if the given value is not nil.
+func (_u *CategoryDeleteJobUpdateOne) SetNillableCategoryPath(v *string) *CategoryDeleteJobUpdateOne {
+	if v != nil {
+		_u.SetCategoryPath(*v)
+	}
+	return _u
+}
+
+// SetMode sets the "mode" field.
+func (_u *CategoryDeleteJobUpdateOne) SetMode(v categorydeletejob.Mode) *CategoryDeleteJobUpdateOne {
+	_u.mutation.SetMode(v)
+	return _u
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_u *CategoryDeleteJobUpdateOne) SetNillableMode(v *categorydeletejob.Mode) *CategoryDeleteJobUpdateOne {
+	if v != nil {
+		_u.SetMode(*v)
+	}
+	return _u
+}
+
+// SetTargetCategoryID sets the "target_category_id" field.
+func (_u *CategoryDeleteJobUpdateOne) SetTargetCategoryID(v string) *CategoryDeleteJobUpdateOne {
+	_u.mutation.SetTargetCategoryID(v)
+	return _u
+}
+
+// SetNillableTargetCategoryID sets the "target_category_id" field if the given value is not nil.
+func (_u *CategoryDeleteJobUpdateOne) SetNillableTargetCategoryID(v *string) *CategoryDeleteJobUpdateOne {
+	if v != nil {
+		_u.SetTargetCategoryID(*v)
+	}
+	return _u
+}
+
+// ClearTargetCategoryID clears the value of the "target_category_id" field.
+func (_u *CategoryDeleteJobUpdateOne) ClearTargetCategoryID() *CategoryDeleteJobUpdateOne {
+	_u.mutation.ClearTargetCategoryID()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CategoryDeleteJobUpdateOne) SetStatus(v categorydeletejob.Status) *CategoryDeleteJobUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CategoryDeleteJobUpdateOne) SetNillableStatus(v *categorydeletejob.Status) *CategoryDeleteJobUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetTotalItems sets the "total_items" field.
+func (_u *CategoryDeleteJobUpdateOne) SetTotalItems(v int64) *CategoryDeleteJobUpdateOne {
+	_u.mutation.ResetTotalItems()
+	_u.mutation.SetTotalItems(v)
+	return _u
+}
+
+// SetNillableTotalItems sets the "total_items" field if the given value is not nil.
+func (_u *CategoryDeleteJobUpdateOne) SetNillableTotalItems(v *int64) *CategoryDeleteJobUpdateOne {
+	if v != nil {
+		_u.SetTotalItems(*v)
+	}
+	return _u
+}
+
+// AddTotalItems adds value to the "total_items" field.
+func (_u *CategoryDeleteJobUpdateOne) AddTotalItems(v int64) *CategoryDeleteJobUpdateOne {
+	_u.mutation.AddTotalItems(v)
+	return _u
+}
+
+// SetProcessedItems sets the "processed_items" field.
+func (_u *CategoryDeleteJobUpdateOne) SetProcessedItems(v int64) *CategoryDeleteJobUpdateOne {
+	_u.mutation.ResetProcessedItems()
+	_u.mutation.SetProcessedItems(v)
+	return _u
+}
+
+// SetNillableProcessedItems sets the "processed_items" field if the given value is not nil.
+func (_u *CategoryDeleteJobUpdateOne) SetNillableProcessedItems(v *int64) *CategoryDeleteJobUpdateOne {
+	if v != nil {
+		_u.SetProcessedItems(*v)
+	}
+	return _u
+}
+
+// AddProcessedItems adds value to the "processed_items" field.
+func (_u *CategoryDeleteJobUpdateOne) AddProcessedItems(v int64) *CategoryDeleteJobUpdateOne {
+	_u.mutation.AddProcessedItems(v)
+	return _u
+}
+
+// SetCategoriesDeleted sets the "categories_deleted" field.
+func (_u *CategoryDeleteJobUpdateOne) SetCategoriesDeleted(v int64) *CategoryDeleteJobUpdateOne {
+	_u.mutation.ResetCategoriesDeleted()
+	_u.mutation.SetCategoriesDeleted(v)
+	return _u
+}
+
+// SetNillableCategoriesDeleted sets the "categories_deleted" field if the given value is not nil.
+func (_u *CategoryDeleteJobUpdateOne) SetNillableCategoriesDeleted(v *int64) *CategoryDeleteJobUpdateOne {
+	if v != nil {
+		_u.SetCategoriesDeleted(*v)
+	}
+	return _u
+}
+
+// AddCategoriesDeleted adds value to the "categories_deleted" field.
+func (_u *CategoryDeleteJobUpdateOne) AddCategoriesDeleted(v int64) *CategoryDeleteJobUpdateOne {
+	_u.mutation.AddCategoriesDeleted(v)
+	return _u
+}
+
+// SetCategoriesMoved sets the "categories_moved" field.
+func (_u *CategoryDeleteJobUpdateOne) SetCategoriesMoved(v int64) *CategoryDeleteJobUpdateOne {
+	_u.mutation.ResetCategoriesMoved()
+	_u.mutation.SetCategoriesMoved(v)
+	return _u
+}
+
+// SetNillableCategoriesMoved sets the "categories_moved" field if the given value is not nil.
+func (_u *CategoryDeleteJobUpdateOne) SetNillableCategoriesMoved(v *int64) *CategoryDeleteJobUpdateOne {
+	if v != nil {
+		_u.SetCategoriesMoved(*v)
+	}
+	return _u
+}
+
+// AddCategoriesMoved adds value to the "categories_moved" field.
+func (_u *CategoryDeleteJobUpdateOne) AddCategoriesMoved(v int64) *CategoryDeleteJobUpdateOne {
+	_u.mutation.AddCategoriesMoved(v)
+	return _u
+}
+
+// SetDocumentsDeleted sets the "documents_deleted" field.
+func (_u *CategoryDeleteJobUpdateOne) SetDocumentsDeleted(v int64) *CategoryDeleteJobUpdateOne {
+	_u.mutation.ResetDocumentsDeleted()
+	_u.mutation.SetDocumentsDeleted(v)
+	return _u
+}
+
+// SetNillableDocumentsDeleted sets the "documents_deleted" field if the given value is not nil.
+func (_u *CategoryDeleteJobUpdateOne) SetNillableDocumentsDeleted(v *int64) *CategoryDeleteJobUpdateOne {
+	if v != nil {
+		_u.SetDocumentsDeleted(*v)
+	}
+	return _u
+}
+
+// AddDocumentsDeleted adds value to the "documents_deleted" field.
+func (_u *CategoryDeleteJobUpdateOne) AddDocumentsDeleted(v int64) *CategoryDeleteJobUpdateOne {
+	_u.mutation.AddDocumentsDeleted(v)
+	return _u
+}
+
+// SetDocumentsMoved sets the "documents_moved" field.
+func (_u *CategoryDeleteJobUpdateOne) SetDocumentsMoved(v int64) *CategoryDeleteJobUpdateOne {
+	_u.mutation.ResetDocumentsMoved()
+	_u.mutation.SetDocumentsMoved(v)
+	return _u
+}
+
+// SetNillableDocumentsMoved sets the "documents_moved" field if the given value is not nil.
+func (_u *CategoryDeleteJobUpdateOne) SetNillableDocumentsMoved(v *int64) *CategoryDeleteJobUpdateOne {
+	if v != nil {
+		_u.SetDocumentsMoved(*v)
+	}
+	return _u
+}
+
+// AddDocumentsMoved adds value to the "documents_moved" field.
+func (_u *CategoryDeleteJobUpdateOne) AddDocumentsMoved(v int64) *CategoryDeleteJobUpdateOne {
+	_u.mutation.AddDocumentsMoved(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *CategoryDeleteJobUpdateOne) SetError(v string) *CategoryDeleteJobUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *CategoryDeleteJobUpdateOne) SetNillableError(v *string) *CategoryDeleteJobUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *CategoryDeleteJobUpdateOne) ClearError() *CategoryDeleteJobUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetLeaseUntil sets the "lease_until" field.
+func (_u *CategoryDeleteJobUpdateOne) SetLeaseUntil(v time.Time) *CategoryDeleteJobUpdateOne {
+	_u.mutation.SetLeaseUntil(v)
+	return _u
+}
+
+// SetNillableLeaseUntil sets the "lease_until" field if the given value is not nil.
+func (_u *CategoryDeleteJobUpdateOne) SetNillableLeaseUntil(v *time.Time) *CategoryDeleteJobUpdateOne {
+	if v != nil {
+		_u.SetLeaseUntil(*v)
+	}
+	return _u
+}
+
+// ClearLeaseUntil clears the value of the "lease_until" field.
+func (_u *CategoryDeleteJobUpdateOne) ClearLeaseUntil() *CategoryDeleteJobUpdateOne {
+	_u.mutation.ClearLeaseUntil()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *CategoryDeleteJobUpdateOne) SetFinishedAt(v time.Time) *CategoryDeleteJobUpdateOne {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *CategoryDeleteJobUpdateOne) SetNillableFinishedAt(v *time.Time) *CategoryDeleteJobUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *CategoryDeleteJobUpdateOne) ClearFinishedAt() *CategoryDeleteJobUpdateOne {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// Mutation returns the CategoryDeleteJobMutation object of the builder.
+func (_u *CategoryDeleteJobUpdateOne) Mutation() *CategoryDeleteJobMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CategoryDeleteJobUpdate builder.
+func (_u *CategoryDeleteJobUpdateOne) Where(ps ...predicate.CategoryDeleteJob) *CategoryDeleteJobUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CategoryDeleteJobUpdateOne) Select(field string, fields ...string) *CategoryDeleteJobUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CategoryDeleteJob entity.
+func (_u *CategoryDeleteJobUpdateOne) Save(ctx context.Context) (*CategoryDeleteJob, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CategoryDeleteJobUpdateOne) SaveX(ctx context.Context) *CategoryDeleteJob {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CategoryDeleteJobUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CategoryDeleteJobUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CategoryDeleteJobUpdateOne) check() error {
+	if v, ok := _u.mutation.CategoryID(); ok {
+		if err := categorydeletejob.CategoryIDValidator(v); err != nil {
+			return &ValidationError{Name: "category_id", err: fmt.Errorf(`ent: validator failed for field "CategoryDeleteJob.category_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CategoryPath(); ok {
+		if err := categorydeletejob.CategoryPathValidator(v); err != nil {
+			return &ValidationError{Name: "category_path", err: fmt.Errorf(`ent: validator failed for field "CategoryDeleteJob.category_path": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Mode(); ok {
+		if err := categorydeletejob.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "CategoryDeleteJob.mode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := categorydeletejob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CategoryDeleteJob.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Error(); ok {
+		if err := categorydeletejob.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "CategoryDeleteJob.error": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CategoryDeleteJobUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CategoryDeleteJobUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CategoryDeleteJobUpdateOne) sqlSave(ctx context.Context) (_node *CategoryDeleteJob, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(categorydeletejob.Table, categorydeletejob.Columns, sqlgraph.NewFieldSpec(categorydeletejob.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CategoryDeleteJob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, categorydeletejob.FieldID)
+		for _, f := range fields {
+			if !categorydeletejob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != categorydeletejob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(categorydeletejob.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(categorydeletejob.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(categorydeletejob.FieldCreateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(categorydeletejob.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(categorydeletejob.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(categorydeletejob.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(categorydeletejob.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(categorydeletejob.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(categorydeletejob.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.CategoryID(); ok {
+		_spec.SetField(categorydeletejob.FieldCategoryID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CategoryPath(); ok {
+		_spec.SetField(categorydeletejob.FieldCategoryPath, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Mode(); ok {
+		_spec.SetField(categorydeletejob.FieldMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TargetCategoryID(); ok {
+		_spec.SetField(categorydeletejob.FieldTargetCategoryID, field.TypeString, value)
+	}
+	if _u.mutation.TargetCategoryIDCleared() {
+		_spec.ClearField(categorydeletejob.FieldTargetCategoryID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(categorydeletejob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TotalItems(); ok {
+		_spec.SetField(categorydeletejob.FieldTotalItems, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalItems(); ok {
+		_spec.AddField(categorydeletejob.FieldTotalItems, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ProcessedItems(); ok {
+		_spec.SetField(categorydeletejob.FieldProcessedItems, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedProcessedItems(); ok {
+		_spec.AddField(categorydeletejob.FieldProcessedItems, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CategoriesDeleted(); ok {
+		_spec.SetField(categorydeletejob.FieldCategoriesDeleted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCategoriesDeleted(); ok {
+		_spec.AddField(categorydeletejob.FieldCategoriesDeleted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CategoriesMoved(); ok {
+		_spec.SetField(categorydeletejob.FieldCategoriesMoved, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCategoriesMoved(); ok {
+		_spec.AddField(categorydeletejob.FieldCategoriesMoved, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.DocumentsDeleted(); ok {
+		_spec.SetField(categorydeletejob.FieldDocumentsDeleted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDocumentsDeleted(); ok {
+		_spec.AddField(categorydeletejob.FieldDocumentsDeleted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.DocumentsMoved(); ok {
+		_spec.SetField(categorydeletejob.FieldDocumentsMoved, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDocumentsMoved(); ok {
+		_spec.AddField(categorydeletejob.FieldDocumentsMoved, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(categorydeletejob.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(categorydeletejob.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.LeaseUntil(); ok {
+		_spec.SetField(categorydeletejob.FieldLeaseUntil, field.TypeTime, value)
+	}
+	if _u.mutation.LeaseUntilCleared() {
+		_spec.ClearField(categorydeletejob.FieldLeaseUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(categorydeletejob.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(categorydeletejob.FieldFinishedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &CategoryDeleteJob{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{categorydeletejob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
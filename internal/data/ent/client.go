@@ -15,10 +15,34 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/accessibleresource"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/auditevent"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/auditlog"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/category"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/categorydeletejob"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/document"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documentcontent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documenthistory"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documentpermission"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documenttag"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/groupmembership"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/idempotencykey"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importconnector"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importedfile"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importmapping"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/notificationpreference"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/outboxevent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/reviewtask"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/setting"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/signaturerequest"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/statisticsrollup"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/tag"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/tenantdeletejob"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/tenantkey"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/tenantquota"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/tenantsettings"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/webhookdelivery"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/webhooksubscription"
 )
 
 // Client is the client that holds all ent builders.
@@ -26,14 +50,62 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AccessibleResource is the client for interacting with the AccessibleResource builders.
+	AccessibleResource *AccessibleResourceClient
+	// AuditEvent is the client for interacting with the AuditEvent builders.
+	AuditEvent *AuditEventClient
 	// AuditLog is the client for interacting with the AuditLog builders.
 	AuditLog *AuditLogClient
 	// Category is the client for interacting with the Category builders.
 	Category *CategoryClient
+	// CategoryDeleteJob is the client for interacting with the CategoryDeleteJob builders.
+	CategoryDeleteJob *CategoryDeleteJobClient
 	// Document is the client for interacting with the Document builders.
 	Document *DocumentClient
+	// DocumentContent is the client for interacting with the DocumentContent builders.
+	DocumentContent *DocumentContentClient
+	// DocumentHistory is the client for interacting with the DocumentHistory builders.
+	DocumentHistory *DocumentHistoryClient
 	// DocumentPermission is the client for interacting with the DocumentPermission builders.
 	DocumentPermission *DocumentPermissionClient
+	// DocumentTag is the client for interacting with the DocumentTag builders.
+	DocumentTag *DocumentTagClient
+	// GroupMembership is the client for interacting with the GroupMembership builders.
+	GroupMembership *GroupMembershipClient
+	// IdempotencyKey is the client for interacting with the IdempotencyKey builders.
+	IdempotencyKey *IdempotencyKeyClient
+	// ImportConnector is the client for interacting with the ImportConnector builders.
+	ImportConnector *ImportConnectorClient
+	// ImportMapping is the client for interacting with the ImportMapping builders.
+	ImportMapping *ImportMappingClient
+	// ImportedFile is the client for interacting with the ImportedFile builders.
+	ImportedFile *ImportedFileClient
+	// NotificationPreference is the client for interacting with the NotificationPreference builders.
+	NotificationPreference *NotificationPreferenceClient
+	// OutboxEvent is the client for interacting with the OutboxEvent builders.
+	OutboxEvent *OutboxEventClient
+	// ReviewTask is the client for interacting with the ReviewTask builders.
+	ReviewTask *ReviewTaskClient
+	// Setting is the client for interacting with the Setting builders.
+	Setting *SettingClient
+	// SignatureRequest is the client for interacting with the SignatureRequest builders.
+	SignatureRequest *SignatureRequestClient
+	// StatisticsRollup is the client for interacting with the StatisticsRollup builders.
+	StatisticsRollup *StatisticsRollupClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
+	// TenantDeleteJob is the client for interacting with the TenantDeleteJob builders.
+	TenantDeleteJob *TenantDeleteJobClient
+	// TenantKey is the client for interacting with the TenantKey builders.
+	TenantKey *TenantKeyClient
+	// TenantQuota is the client for interacting with the TenantQuota builders.
+	TenantQuota *TenantQuotaClient
+	// TenantSettings is the client for interacting with the TenantSettings builders.
+	TenantSettings *TenantSettingsClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WebhookSubscription is the client for interacting with the WebhookSubscription builders.
+	WebhookSubscription *WebhookSubscriptionClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -45,10 +117,34 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AccessibleResource = NewAccessibleResourceClient(c.config)
+	c.AuditEvent = NewAuditEventClient(c.config)
 	c.AuditLog = NewAuditLogClient(c.config)
 	c.Category = NewCategoryClient(c.config)
+	c.CategoryDeleteJob = NewCategoryDeleteJobClient(c.config)
 	c.Document = NewDocumentClient(c.config)
+	c.DocumentContent = NewDocumentContentClient(c.config)
+	c.DocumentHistory = NewDocumentHistoryClient(c.config)
 	c.DocumentPermission = NewDocumentPermissionClient(c.config)
+	c.DocumentTag = NewDocumentTagClient(c.config)
+	c.GroupMembership = NewGroupMembershipClient(c.config)
+	c.IdempotencyKey = NewIdempotencyKeyClient(c.config)
+	c.ImportConnector = NewImportConnectorClient(c.config)
+	c.ImportMapping = NewImportMappingClient(c.config)
+	c.ImportedFile = NewImportedFileClient(c.config)
+	c.NotificationPreference = NewNotificationPreferenceClient(c.config)
+	c.OutboxEvent = NewOutboxEventClient(c.config)
+	c.ReviewTask = NewReviewTaskClient(c.config)
+	c.Setting = NewSettingClient(c.config)
+	c.SignatureRequest = NewSignatureRequestClient(c.config)
+	c.StatisticsRollup = NewStatisticsRollupClient(c.config)
+	c.Tag = NewTagClient(c.config)
+	c.TenantDeleteJob = NewTenantDeleteJobClient(c.config)
+	c.TenantKey = NewTenantKeyClient(c.config)
+	c.TenantQuota = NewTenantQuotaClient(c.config)
+	c.TenantSettings = NewTenantSettingsClient(c.config)
+	c.WebhookDelivery = NewWebhookDeliveryClient(c.config)
+	c.WebhookSubscription = NewWebhookSubscriptionClient(c.config)
 }
 
 type (
@@ -139,12 +235,36 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:                ctx,
-		config:             cfg,
-		AuditLog:           NewAuditLogClient(cfg),
-		Category:           NewCategoryClient(cfg),
-		Document:           NewDocumentClient(cfg),
-		DocumentPermission: NewDocumentPermissionClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		AccessibleResource:     NewAccessibleResourceClient(cfg),
+		AuditEvent:             NewAuditEventClient(cfg),
+		AuditLog:               NewAuditLogClient(cfg),
+		Category:               NewCategoryClient(cfg),
+		CategoryDeleteJob:      NewCategoryDeleteJobClient(cfg),
+		Document:               NewDocumentClient(cfg),
+		DocumentContent:        NewDocumentContentClient(cfg),
+		DocumentHistory:        NewDocumentHistoryClient(cfg),
+		DocumentPermission:     NewDocumentPermissionClient(cfg),
+		DocumentTag:            NewDocumentTagClient(cfg),
+		GroupMembership:        NewGroupMembershipClient(cfg),
+		IdempotencyKey:         NewIdempotencyKeyClient(cfg),
+		ImportConnector:        NewImportConnectorClient(cfg),
+		ImportMapping:          NewImportMappingClient(cfg),
+		ImportedFile:           NewImportedFileClient(cfg),
+		NotificationPreference: NewNotificationPreferenceClient(cfg),
+		OutboxEvent:            NewOutboxEventClient(cfg),
+		ReviewTask:             NewReviewTaskClient(cfg),
+		Setting:                NewSettingClient(cfg),
+		SignatureRequest:       NewSignatureRequestClient(cfg),
+		StatisticsRollup:       NewStatisticsRollupClient(cfg),
+		Tag:                    NewTagClient(cfg),
+		TenantDeleteJob:        NewTenantDeleteJobClient(cfg),
+		TenantKey:              NewTenantKeyClient(cfg),
+		TenantQuota:            NewTenantQuotaClient(cfg),
+		TenantSettings:         NewTenantSettingsClient(cfg),
+		WebhookDelivery:        NewWebhookDeliveryClient(cfg),
+		WebhookSubscription:    NewWebhookSubscriptionClient(cfg),
 	}, nil
 }
 
@@ -162,19 +282,43 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:                ctx,
-		config:             cfg,
-		AuditLog:           NewAuditLogClient(cfg),
-		Category:           NewCategoryClient(cfg),
-		Document:           NewDocumentClient(cfg),
-		DocumentPermission: NewDocumentPermissionClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		AccessibleResource:     NewAccessibleResourceClient(cfg),
+		AuditEvent:             NewAuditEventClient(cfg),
+		AuditLog:               NewAuditLogClient(cfg),
+		Category:               NewCategoryClient(cfg),
+		CategoryDeleteJob:      NewCategoryDeleteJobClient(cfg),
+		Document:               NewDocumentClient(cfg),
+		DocumentContent:        NewDocumentContentClient(cfg),
+		DocumentHistory:        NewDocumentHistoryClient(cfg),
+		DocumentPermission:     NewDocumentPermissionClient(cfg),
+		DocumentTag:            NewDocumentTagClient(cfg),
+		GroupMembership:        NewGroupMembershipClient(cfg),
+		IdempotencyKey:         NewIdempotencyKeyClient(cfg),
+		ImportConnector:        NewImportConnectorClient(cfg),
+		ImportMapping:          NewImportMappingClient(cfg),
+		ImportedFile:           NewImportedFileClient(cfg),
+		NotificationPreference: NewNotificationPreferenceClient(cfg),
+		OutboxEvent:            NewOutboxEventClient(cfg),
+		ReviewTask:             NewReviewTaskClient(cfg),
+		Setting:                NewSettingClient(cfg),
+		SignatureRequest:       NewSignatureRequestClient(cfg),
+		StatisticsRollup:       NewStatisticsRollupClient(cfg),
+		Tag:                    NewTagClient(cfg),
+		TenantDeleteJob:        NewTenantDeleteJobClient(cfg),
+		TenantKey:              NewTenantKeyClient(cfg),
+		TenantQuota:            NewTenantQuotaClient(cfg),
+		TenantSettings:         NewTenantSettingsClient(cfg),
+		WebhookDelivery:        NewWebhookDeliveryClient(cfg),
+		WebhookSubscription:    NewWebhookSubscriptionClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		AuditLog.
+//		AccessibleResource.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -196,37 +340,367 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.AuditLog.Use(hooks...)
-	c.Category.Use(hooks...)
-	c.Document.Use(hooks...)
-	c.DocumentPermission.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.AccessibleResource, c.AuditEvent, c.AuditLog, c.Category, c.CategoryDeleteJob,
+		c.Document, c.DocumentContent, c.DocumentHistory, c.DocumentPermission,
+		c.DocumentTag, c.GroupMembership, c.IdempotencyKey, c.ImportConnector,
+		c.ImportMapping, c.ImportedFile, c.NotificationPreference, c.OutboxEvent,
+		c.ReviewTask, c.Setting, c.SignatureRequest, c.StatisticsRollup, c.Tag,
+		c.TenantDeleteJob, c.TenantKey, c.TenantQuota, c.TenantSettings,
+		c.WebhookDelivery, c.WebhookSubscription,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.AuditLog.Intercept(interceptors...)
-	c.Category.Intercept(interceptors...)
-	c.Document.Intercept(interceptors...)
-	c.DocumentPermission.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.AccessibleResource, c.AuditEvent, c.AuditLog, c.Category, c.CategoryDeleteJob,
+		c.Document, c.DocumentContent, c.DocumentHistory, c.DocumentPermission,
+		c.DocumentTag, c.GroupMembership, c.IdempotencyKey, c.ImportConnector,
+		c.ImportMapping, c.ImportedFile, c.NotificationPreference, c.OutboxEvent,
+		c.ReviewTask, c.Setting, c.SignatureRequest, c.StatisticsRollup, c.Tag,
+		c.TenantDeleteJob, c.TenantKey, c.TenantQuota, c.TenantSettings,
+		c.WebhookDelivery, c.WebhookSubscription,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AccessibleResourceMutation:
+		return c.AccessibleResource.mutate(ctx, m)
+	case *AuditEventMutation:
+		return c.AuditEvent.mutate(ctx, m)
 	case *AuditLogMutation:
 		return c.AuditLog.mutate(ctx, m)
 	case *CategoryMutation:
 		return c.Category.mutate(ctx, m)
+	case *CategoryDeleteJobMutation:
+		return c.CategoryDeleteJob.mutate(ctx, m)
 	case *DocumentMutation:
 		return c.Document.mutate(ctx, m)
+	case *DocumentContentMutation:
+		return c.DocumentContent.mutate(ctx, m)
+	case *DocumentHistoryMutation:
+		return c.DocumentHistory.mutate(ctx, m)
 	case *DocumentPermissionMutation:
 		return c.DocumentPermission.mutate(ctx, m)
+	case *DocumentTagMutation:
+		return c.DocumentTag.mutate(ctx, m)
+	case *GroupMembershipMutation:
+		return c.GroupMembership.mutate(ctx, m)
+	case *IdempotencyKeyMutation:
+		return c.IdempotencyKey.mutate(ctx, m)
+	case *ImportConnectorMutation:
+		return c.ImportConnector.mutate(ctx, m)
+	case *ImportMappingMutation:
+		return c.ImportMapping.mutate(ctx, m)
+	case *ImportedFileMutation:
+		return c.ImportedFile.mutate(ctx, m)
+	case *NotificationPreferenceMutation:
+		return c.NotificationPreference.mutate(ctx, m)
+	case *OutboxEventMutation:
+		return c.OutboxEvent.mutate(ctx, m)
+	case *ReviewTaskMutation:
+		return c.ReviewTask.mutate(ctx, m)
+	case *SettingMutation:
+		return c.Setting.mutate(ctx, m)
+	case *SignatureRequestMutation:
+		return c.SignatureRequest.mutate(ctx, m)
+	case *StatisticsRollupMutation:
+		return c.StatisticsRollup.mutate(ctx, m)
+	case *TagMutation:
+		return c.Tag.mutate(ctx, m)
+	case *TenantDeleteJobMutation:
+		return c.TenantDeleteJob.mutate(ctx, m)
+	case *TenantKeyMutation:
+		return c.TenantKey.mutate(ctx, m)
+	case *TenantQuotaMutation:
+		return c.TenantQuota.mutate(ctx, m)
+	case *TenantSettingsMutation:
+		return c.TenantSettings.mutate(ctx, m)
+	case *WebhookDeliveryMutation:
+		return c.WebhookDelivery.mutate(ctx, m)
+	case *WebhookSubscriptionMutation:
+		return c.WebhookSubscription.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// AccessibleResourceClient is a client for the AccessibleResource schema.
+type AccessibleResourceClient struct {
+	config
+}
+
+// NewAccessibleResourceClient returns a client for the AccessibleResource from the given config.
+func NewAccessibleResourceClient(c config) *AccessibleResourceClient {
+	return &AccessibleResourceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `accessibleresource.Hooks(f(g(h())))`.
+func (c *AccessibleResourceClient) Use(hooks ...Hook) {
+	c.hooks.AccessibleResource = append(c.hooks.AccessibleResource, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `accessibleresource.Intercept(f(g(h())))`.
+func (c *AccessibleResourceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AccessibleResource = append(c.inters.AccessibleResource, interceptors...)
+}
+
+// Create returns a builder for creating a AccessibleResource entity.
+func (c *AccessibleResourceClient) Create() *AccessibleResourceCreate {
+	mutation := newAccessibleResourceMutation(c.config, OpCreate)
+	return &AccessibleResourceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AccessibleResource entities.
+func (c *AccessibleResourceClient) CreateBulk(builders ...*AccessibleResourceCreate) *AccessibleResourceCreateBulk {
+	return &AccessibleResourceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AccessibleResourceClient) MapCreateBulk(slice any, setFunc func(*AccessibleResourceCreate, int)) *AccessibleResourceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AccessibleResourceCreateBulk{err: fmt.Errorf("calling to AccessibleResourceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AccessibleResourceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AccessibleResourceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AccessibleResource.
+func (c *AccessibleResourceClient) Update() *AccessibleResourceUpdate {
+	mutation := newAccessibleResourceMutation(c.config, OpUpdate)
+	return &AccessibleResourceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AccessibleResourceClient) UpdateOne(_m *AccessibleResource) *AccessibleResourceUpdateOne {
+	mutation := newAccessibleResourceMutation(c.config, OpUpdateOne, withAccessibleResource(_m))
+	return &AccessibleResourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AccessibleResourceClient) UpdateOneID(id uint32) *AccessibleResourceUpdateOne {
+	mutation := newAccessibleResourceMutation(c.config, OpUpdateOne, withAccessibleResourceID(id))
+	return &AccessibleResourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AccessibleResource.
+func (c *AccessibleResourceClient) Delete() *AccessibleResourceDelete {
+	mutation := newAccessibleResourceMutation(c.config, OpDelete)
+	return &AccessibleResourceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AccessibleResourceClient) DeleteOne(_m *AccessibleResource) *AccessibleResourceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AccessibleResourceClient) DeleteOneID(id uint32) *AccessibleResourceDeleteOne {
+	builder := c.Delete().Where(accessibleresource.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AccessibleResourceDeleteOne{builder}
+}
+
+// Query returns a query builder for AccessibleResource.
+func (c *AccessibleResourceClient) Query() *AccessibleResourceQuery {
+	return &AccessibleResourceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAccessibleResource},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AccessibleResource entity by its id.
+func (c *AccessibleResourceClient) Get(ctx context.Context, id uint32) (*AccessibleResource, error) {
+	return c.Query().Where(accessibleresource.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AccessibleResourceClient) GetX(ctx context.Context, id uint32) *AccessibleResource {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AccessibleResourceClient) Hooks() []Hook {
+	hooks := c.hooks.AccessibleResource
+	return append(hooks[:len(hooks):len(hooks)], accessibleresource.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *AccessibleResourceClient) Interceptors() []Interceptor {
+	return c.inters.AccessibleResource
+}
+
+func (c *AccessibleResourceClient) mutate(ctx context.Context, m *AccessibleResourceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AccessibleResourceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AccessibleResourceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AccessibleResourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AccessibleResourceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AccessibleResource mutation op: %q", m.Op())
+	}
+}
+
+// AuditEventClient is a client for the AuditEvent schema.
+type AuditEventClient struct {
+	config
+}
+
+// NewAuditEventClient returns a client for the AuditEvent from the given config.
+func NewAuditEventClient(c config) *AuditEventClient {
+	return &AuditEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditevent.Hooks(f(g(h())))`.
+func (c *AuditEventClient) Use(hooks ...Hook) {
+	c.hooks.AuditEvent = append(c.hooks.AuditEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `auditevent.Intercept(f(g(h())))`.
+func (c *AuditEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditEvent = append(c.inters.AuditEvent, interceptors...)
+}
+
+// Create returns a builder for creating a AuditEvent entity.
+func (c *AuditEventClient) Create() *AuditEventCreate {
+	mutation := newAuditEventMutation(c.config, OpCreate)
+	return &AuditEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditEvent entities.
+func (c *AuditEventClient) CreateBulk(builders ...*AuditEventCreate) *AuditEventCreateBulk {
+	return &AuditEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuditEventClient) MapCreateBulk(slice any, setFunc func(*AuditEventCreate, int)) *AuditEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuditEventCreateBulk{err: fmt.Errorf("calling to AuditEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuditEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuditEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditEvent.
+func (c *AuditEventClient) Update() *AuditEventUpdate {
+	mutation := newAuditEventMutation(c.config, OpUpdate)
+	return &AuditEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditEventClient) UpdateOne(_m *AuditEvent) *AuditEventUpdateOne {
+	mutation := newAuditEventMutation(c.config, OpUpdateOne, withAuditEvent(_m))
+	return &AuditEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditEventClient) UpdateOneID(id uint32) *AuditEventUpdateOne {
+	mutation := newAuditEventMutation(c.config, OpUpdateOne, withAuditEventID(id))
+	return &AuditEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditEvent.
+func (c *AuditEventClient) Delete() *AuditEventDelete {
+	mutation := newAuditEventMutation(c.config, OpDelete)
+	return &AuditEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditEventClient) DeleteOne(_m *AuditEvent) *AuditEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuditEventClient) DeleteOneID(id uint32) *AuditEventDeleteOne {
+	builder := c.Delete().Where(auditevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditEventDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditEvent.
+func (c *AuditEventClient) Query() *AuditEventQuery {
+	return &AuditEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuditEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuditEvent entity by its id.
+func (c *AuditEventClient) Get(ctx context.Context, id uint32) (*AuditEvent, error) {
+	return c.Query().Where(auditevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditEventClient) GetX(ctx context.Context, id uint32) *AuditEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditEventClient) Hooks() []Hook {
+	hooks := c.hooks.AuditEvent
+	return append(hooks[:len(hooks):len(hooks)], auditevent.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditEventClient) Interceptors() []Interceptor {
+	return c.inters.AuditEvent
+}
+
+func (c *AuditEventClient) mutate(ctx context.Context, m *AuditEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AuditEvent mutation op: %q", m.Op())
+	}
+}
+
 // AuditLogClient is a client for the AuditLog schema.
 type AuditLogClient struct {
 	config
@@ -559,6 +1033,140 @@ func (c *CategoryClient) mutate(ctx context.Context, m *CategoryMutation) (Value
 	}
 }
 
+// CategoryDeleteJobClient is a client for the CategoryDeleteJob schema.
+type CategoryDeleteJobClient struct {
+	config
+}
+
+// NewCategoryDeleteJobClient returns a client for the CategoryDeleteJob from the given config.
+func NewCategoryDeleteJobClient(c config) *CategoryDeleteJobClient {
+	return &CategoryDeleteJobClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `categorydeletejob.Hooks(f(g(h())))`.
+func (c *CategoryDeleteJobClient) Use(hooks ...Hook) {
+	c.hooks.CategoryDeleteJob = append(c.hooks.CategoryDeleteJob, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `categorydeletejob.Intercept(f(g(h())))`.
+func (c *CategoryDeleteJobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CategoryDeleteJob = append(c.inters.CategoryDeleteJob, interceptors...)
+}
+
+// Create returns a builder for creating a CategoryDeleteJob entity.
+func (c *CategoryDeleteJobClient) Create() *CategoryDeleteJobCreate {
+	mutation := newCategoryDeleteJobMutation(c.config, OpCreate)
+	return &CategoryDeleteJobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CategoryDeleteJob entities.
+func (c *CategoryDeleteJobClient) CreateBulk(builders ...*CategoryDeleteJobCreate) *CategoryDeleteJobCreateBulk {
+	return &CategoryDeleteJobCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CategoryDeleteJobClient) MapCreateBulk(slice any, setFunc func(*CategoryDeleteJobCreate, int)) *CategoryDeleteJobCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CategoryDeleteJobCreateBulk{err: fmt.Errorf("calling to CategoryDeleteJobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CategoryDeleteJobCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CategoryDeleteJobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CategoryDeleteJob.
+func (c *CategoryDeleteJobClient) Update() *CategoryDeleteJobUpdate {
+	mutation := newCategoryDeleteJobMutation(c.config, OpUpdate)
+	return &CategoryDeleteJobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CategoryDeleteJobClient) UpdateOne(_m *CategoryDeleteJob) *CategoryDeleteJobUpdateOne {
+	mutation := newCategoryDeleteJobMutation(c.config, OpUpdateOne, withCategoryDeleteJob(_m))
+	return &CategoryDeleteJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CategoryDeleteJobClient) UpdateOneID(id string) *CategoryDeleteJobUpdateOne {
+	mutation := newCategoryDeleteJobMutation(c.config, OpUpdateOne, withCategoryDeleteJobID(id))
+	return &CategoryDeleteJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CategoryDeleteJob.
+func (c *CategoryDeleteJobClient) Delete() *CategoryDeleteJobDelete {
+	mutation := newCategoryDeleteJobMutation(c.config, OpDelete)
+	return &CategoryDeleteJobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CategoryDeleteJobClient) DeleteOne(_m *CategoryDeleteJob) *CategoryDeleteJobDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CategoryDeleteJobClient) DeleteOneID(id string) *CategoryDeleteJobDeleteOne {
+	builder := c.Delete().Where(categorydeletejob.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CategoryDeleteJobDeleteOne{builder}
+}
+
+// Query returns a query builder for CategoryDeleteJob.
+func (c *CategoryDeleteJobClient) Query() *CategoryDeleteJobQuery {
+	return &CategoryDeleteJobQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCategoryDeleteJob},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CategoryDeleteJob entity by its id.
+func (c *CategoryDeleteJobClient) Get(ctx context.Context, id string) (*CategoryDeleteJob, error) {
+	return c.Query().Where(categorydeletejob.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CategoryDeleteJobClient) GetX(ctx context.Context, id string) *CategoryDeleteJob {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CategoryDeleteJobClient) Hooks() []Hook {
+	hooks := c.hooks.CategoryDeleteJob
+	return append(hooks[:len(hooks):len(hooks)], categorydeletejob.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *CategoryDeleteJobClient) Interceptors() []Interceptor {
+	return c.inters.CategoryDeleteJob
+}
+
+func (c *CategoryDeleteJobClient) mutate(ctx context.Context, m *CategoryDeleteJobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CategoryDeleteJobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CategoryDeleteJobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CategoryDeleteJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CategoryDeleteJobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CategoryDeleteJob mutation op: %q", m.Op())
+	}
+}
+
 // DocumentClient is a client for the Document schema.
 type DocumentClient struct {
 	config
@@ -699,22 +1307,87 @@ func (c *DocumentClient) QueryPermissions(_m *Document) *DocumentPermissionQuery
 	return query
 }
 
-// Hooks returns the client hooks.
-func (c *DocumentClient) Hooks() []Hook {
-	hooks := c.hooks.Document
-	return append(hooks[:len(hooks):len(hooks)], document.Hooks[:]...)
+// QuerySignatureRequests queries the signature_requests edge of a Document.
+func (c *DocumentClient) QuerySignatureRequests(_m *Document) *SignatureRequestQuery {
+	query := (&SignatureRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(document.Table, document.FieldID, id),
+			sqlgraph.To(signaturerequest.Table, signaturerequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, document.SignatureRequestsTable, document.SignatureRequestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// Interceptors returns the client interceptors.
-func (c *DocumentClient) Interceptors() []Interceptor {
-	return c.inters.Document
+// QueryReviewTasks queries the review_tasks edge of a Document.
+func (c *DocumentClient) QueryReviewTasks(_m *Document) *ReviewTaskQuery {
+	query := (&ReviewTaskClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(document.Table, document.FieldID, id),
+			sqlgraph.To(reviewtask.Table, reviewtask.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, document.ReviewTasksTable, document.ReviewTasksColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-func (c *DocumentClient) mutate(ctx context.Context, m *DocumentMutation) (Value, error) {
-	switch m.Op() {
-	case OpCreate:
-		return (&DocumentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpUpdate:
+// QueryDocumentTags queries the document_tags edge of a Document.
+func (c *DocumentClient) QueryDocumentTags(_m *Document) *DocumentTagQuery {
+	query := (&DocumentTagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(document.Table, document.FieldID, id),
+			sqlgraph.To(documenttag.Table, documenttag.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, document.DocumentTagsTable, document.DocumentTagsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryContent queries the content edge of a Document.
+func (c *DocumentClient) QueryContent(_m *Document) *DocumentContentQuery {
+	query := (&DocumentContentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(document.Table, document.FieldID, id),
+			sqlgraph.To(documentcontent.Table, documentcontent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, document.ContentTable, document.ContentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DocumentClient) Hooks() []Hook {
+	hooks := c.hooks.Document
+	return append(hooks[:len(hooks):len(hooks)], document.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *DocumentClient) Interceptors() []Interceptor {
+	inters := c.inters.Document
+	return append(inters[:len(inters):len(inters)], document.Interceptors[:]...)
+}
+
+func (c *DocumentClient) mutate(ctx context.Context, m *DocumentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DocumentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
 		return (&DocumentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
 		return (&DocumentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
@@ -725,6 +1398,290 @@ func (c *DocumentClient) mutate(ctx context.Context, m *DocumentMutation) (Value
 	}
 }
 
+// DocumentContentClient is a client for the DocumentContent schema.
+type DocumentContentClient struct {
+	config
+}
+
+// NewDocumentContentClient returns a client for the DocumentContent from the given config.
+func NewDocumentContentClient(c config) *DocumentContentClient {
+	return &DocumentContentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `documentcontent.Hooks(f(g(h())))`.
+func (c *DocumentContentClient) Use(hooks ...Hook) {
+	c.hooks.DocumentContent = append(c.hooks.DocumentContent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `documentcontent.Intercept(f(g(h())))`.
+func (c *DocumentContentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DocumentContent = append(c.inters.DocumentContent, interceptors...)
+}
+
+// Create returns a builder for creating a DocumentContent entity.
+func (c *DocumentContentClient) Create() *DocumentContentCreate {
+	mutation := newDocumentContentMutation(c.config, OpCreate)
+	return &DocumentContentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DocumentContent entities.
+func (c *DocumentContentClient) CreateBulk(builders ...*DocumentContentCreate) *DocumentContentCreateBulk {
+	return &DocumentContentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DocumentContentClient) MapCreateBulk(slice any, setFunc func(*DocumentContentCreate, int)) *DocumentContentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DocumentContentCreateBulk{err: fmt.Errorf("calling to DocumentContentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DocumentContentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DocumentContentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DocumentContent.
+func (c *DocumentContentClient) Update() *DocumentContentUpdate {
+	mutation := newDocumentContentMutation(c.config, OpUpdate)
+	return &DocumentContentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DocumentContentClient) UpdateOne(_m *DocumentContent) *DocumentContentUpdateOne {
+	mutation := newDocumentContentMutation(c.config, OpUpdateOne, withDocumentContent(_m))
+	return &DocumentContentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DocumentContentClient) UpdateOneID(id uint32) *DocumentContentUpdateOne {
+	mutation := newDocumentContentMutation(c.config, OpUpdateOne, withDocumentContentID(id))
+	return &DocumentContentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DocumentContent.
+func (c *DocumentContentClient) Delete() *DocumentContentDelete {
+	mutation := newDocumentContentMutation(c.config, OpDelete)
+	return &DocumentContentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DocumentContentClient) DeleteOne(_m *DocumentContent) *DocumentContentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DocumentContentClient) DeleteOneID(id uint32) *DocumentContentDeleteOne {
+	builder := c.Delete().Where(documentcontent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DocumentContentDeleteOne{builder}
+}
+
+// Query returns a query builder for DocumentContent.
+func (c *DocumentContentClient) Query() *DocumentContentQuery {
+	return &DocumentContentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDocumentContent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DocumentContent entity by its id.
+func (c *DocumentContentClient) Get(ctx context.Context, id uint32) (*DocumentContent, error) {
+	return c.Query().Where(documentcontent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DocumentContentClient) GetX(ctx context.Context, id uint32) *DocumentContent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDocument queries the document edge of a DocumentContent.
+func (c *DocumentContentClient) QueryDocument(_m *DocumentContent) *DocumentQuery {
+	query := (&DocumentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(documentcontent.Table, documentcontent.FieldID, id),
+			sqlgraph.To(document.Table, document.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, documentcontent.DocumentTable, documentcontent.DocumentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DocumentContentClient) Hooks() []Hook {
+	hooks := c.hooks.DocumentContent
+	return append(hooks[:len(hooks):len(hooks)], documentcontent.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *DocumentContentClient) Interceptors() []Interceptor {
+	return c.inters.DocumentContent
+}
+
+func (c *DocumentContentClient) mutate(ctx context.Context, m *DocumentContentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DocumentContentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DocumentContentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DocumentContentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DocumentContentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DocumentContent mutation op: %q", m.Op())
+	}
+}
+
+// DocumentHistoryClient is a client for the DocumentHistory schema.
+type DocumentHistoryClient struct {
+	config
+}
+
+// NewDocumentHistoryClient returns a client for the DocumentHistory from the given config.
+func NewDocumentHistoryClient(c config) *DocumentHistoryClient {
+	return &DocumentHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `documenthistory.Hooks(f(g(h())))`.
+func (c *DocumentHistoryClient) Use(hooks ...Hook) {
+	c.hooks.DocumentHistory = append(c.hooks.DocumentHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `documenthistory.Intercept(f(g(h())))`.
+func (c *DocumentHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DocumentHistory = append(c.inters.DocumentHistory, interceptors...)
+}
+
+// Create returns a builder for creating a DocumentHistory entity.
+func (c *DocumentHistoryClient) Create() *DocumentHistoryCreate {
+	mutation := newDocumentHistoryMutation(c.config, OpCreate)
+	return &DocumentHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DocumentHistory entities.
+func (c *DocumentHistoryClient) CreateBulk(builders ...*DocumentHistoryCreate) *DocumentHistoryCreateBulk {
+	return &DocumentHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DocumentHistoryClient) MapCreateBulk(slice any, setFunc func(*DocumentHistoryCreate, int)) *DocumentHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DocumentHistoryCreateBulk{err: fmt.Errorf("calling to DocumentHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DocumentHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DocumentHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DocumentHistory.
+func (c *DocumentHistoryClient) Update() *DocumentHistoryUpdate {
+	mutation := newDocumentHistoryMutation(c.config, OpUpdate)
+	return &DocumentHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DocumentHistoryClient) UpdateOne(_m *DocumentHistory) *DocumentHistoryUpdateOne {
+	mutation := newDocumentHistoryMutation(c.config, OpUpdateOne, withDocumentHistory(_m))
+	return &DocumentHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DocumentHistoryClient) UpdateOneID(id uint32) *DocumentHistoryUpdateOne {
+	mutation := newDocumentHistoryMutation(c.config, OpUpdateOne, withDocumentHistoryID(id))
+	return &DocumentHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DocumentHistory.
+func (c *DocumentHistoryClient) Delete() *DocumentHistoryDelete {
+	mutation := newDocumentHistoryMutation(c.config, OpDelete)
+	return &DocumentHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DocumentHistoryClient) DeleteOne(_m *DocumentHistory) *DocumentHistoryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DocumentHistoryClient) DeleteOneID(id uint32) *DocumentHistoryDeleteOne {
+	builder := c.Delete().Where(documenthistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DocumentHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for DocumentHistory.
+func (c *DocumentHistoryClient) Query() *DocumentHistoryQuery {
+	return &DocumentHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDocumentHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DocumentHistory entity by its id.
+func (c *DocumentHistoryClient) Get(ctx context.Context, id uint32) (*DocumentHistory, error) {
+	return c.Query().Where(documenthistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DocumentHistoryClient) GetX(ctx context.Context, id uint32) *DocumentHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DocumentHistoryClient) Hooks() []Hook {
+	hooks := c.hooks.DocumentHistory
+	return append(hooks[:len(hooks):len(hooks)], documenthistory.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *DocumentHistoryClient) Interceptors() []Interceptor {
+	return c.inters.DocumentHistory
+}
+
+func (c *DocumentHistoryClient) mutate(ctx context.Context, m *DocumentHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DocumentHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DocumentHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DocumentHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DocumentHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DocumentHistory mutation op: %q", m.Op())
+	}
+}
+
 // DocumentPermissionClient is a client for the DocumentPermission schema.
 type DocumentPermissionClient struct {
 	config
@@ -891,12 +1848,2742 @@ func (c *DocumentPermissionClient) mutate(ctx context.Context, m *DocumentPermis
 	}
 }
 
+// DocumentTagClient is a client for the DocumentTag schema.
+type DocumentTagClient struct {
+	config
+}
+
+// NewDocumentTagClient returns a client for the DocumentTag from the given config.
+func NewDocumentTagClient(c config) *DocumentTagClient {
+	return &DocumentTagClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `documenttag.Hooks(f(g(h())))`.
+func (c *DocumentTagClient) Use(hooks ...Hook) {
+	c.hooks.DocumentTag = append(c.hooks.DocumentTag, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `documenttag.Intercept(f(g(h())))`.
+func (c *DocumentTagClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DocumentTag = append(c.inters.DocumentTag, interceptors...)
+}
+
+// Create returns a builder for creating a DocumentTag entity.
+func (c *DocumentTagClient) Create() *DocumentTagCreate {
+	mutation := newDocumentTagMutation(c.config, OpCreate)
+	return &DocumentTagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DocumentTag entities.
+func (c *DocumentTagClient) CreateBulk(builders ...*DocumentTagCreate) *DocumentTagCreateBulk {
+	return &DocumentTagCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DocumentTagClient) MapCreateBulk(slice any, setFunc func(*DocumentTagCreate, int)) *DocumentTagCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DocumentTagCreateBulk{err: fmt.Errorf("calling to DocumentTagClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DocumentTagCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DocumentTagCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DocumentTag.
+func (c *DocumentTagClient) Update() *DocumentTagUpdate {
+	mutation := newDocumentTagMutation(c.config, OpUpdate)
+	return &DocumentTagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DocumentTagClient) UpdateOne(_m *DocumentTag) *DocumentTagUpdateOne {
+	mutation := newDocumentTagMutation(c.config, OpUpdateOne, withDocumentTag(_m))
+	return &DocumentTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DocumentTagClient) UpdateOneID(id uint32) *DocumentTagUpdateOne {
+	mutation := newDocumentTagMutation(c.config, OpUpdateOne, withDocumentTagID(id))
+	return &DocumentTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DocumentTag.
+func (c *DocumentTagClient) Delete() *DocumentTagDelete {
+	mutation := newDocumentTagMutation(c.config, OpDelete)
+	return &DocumentTagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DocumentTagClient) DeleteOne(_m *DocumentTag) *DocumentTagDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DocumentTagClient) DeleteOneID(id uint32) *DocumentTagDeleteOne {
+	builder := c.Delete().Where(documenttag.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DocumentTagDeleteOne{builder}
+}
+
+// Query returns a query builder for DocumentTag.
+func (c *DocumentTagClient) Query() *DocumentTagQuery {
+	return &DocumentTagQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDocumentTag},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DocumentTag entity by its id.
+func (c *DocumentTagClient) Get(ctx context.Context, id uint32) (*DocumentTag, error) {
+	return c.Query().Where(documenttag.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DocumentTagClient) GetX(ctx context.Context, id uint32) *DocumentTag {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDocument queries the document edge of a DocumentTag.
+func (c *DocumentTagClient) QueryDocument(_m *DocumentTag) *DocumentQuery {
+	query := (&DocumentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(documenttag.Table, documenttag.FieldID, id),
+			sqlgraph.To(document.Table, document.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, documenttag.DocumentTable, documenttag.DocumentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTag queries the tag edge of a DocumentTag.
+func (c *DocumentTagClient) QueryTag(_m *DocumentTag) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(documenttag.Table, documenttag.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, documenttag.TagTable, documenttag.TagColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DocumentTagClient) Hooks() []Hook {
+	hooks := c.hooks.DocumentTag
+	return append(hooks[:len(hooks):len(hooks)], documenttag.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *DocumentTagClient) Interceptors() []Interceptor {
+	return c.inters.DocumentTag
+}
+
+func (c *DocumentTagClient) mutate(ctx context.Context, m *DocumentTagMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DocumentTagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DocumentTagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DocumentTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DocumentTagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DocumentTag mutation op: %q", m.Op())
+	}
+}
+
+// GroupMembershipClient is a client for the GroupMembership schema.
+type GroupMembershipClient struct {
+	config
+}
+
+// NewGroupMembershipClient returns a client for the GroupMembership from the given config.
+func NewGroupMembershipClient(c config) *GroupMembershipClient {
+	return &GroupMembershipClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `groupmembership.Hooks(f(g(h())))`.
+func (c *GroupMembershipClient) Use(hooks ...Hook) {
+	c.hooks.GroupMembership = append(c.hooks.GroupMembership, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `groupmembership.Intercept(f(g(h())))`.
+func (c *GroupMembershipClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GroupMembership = append(c.inters.GroupMembership, interceptors...)
+}
+
+// Create returns a builder for creating a GroupMembership entity.
+func (c *GroupMembershipClient) Create() *GroupMembershipCreate {
+	mutation := newGroupMembershipMutation(c.config, OpCreate)
+	return &GroupMembershipCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GroupMembership entities.
+func (c *GroupMembershipClient) CreateBulk(builders ...*GroupMembershipCreate) *GroupMembershipCreateBulk {
+	return &GroupMembershipCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GroupMembershipClient) MapCreateBulk(slice any, setFunc func(*GroupMembershipCreate, int)) *GroupMembershipCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GroupMembershipCreateBulk{err: fmt.Errorf("calling to GroupMembershipClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GroupMembershipCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GroupMembershipCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GroupMembership.
+func (c *GroupMembershipClient) Update() *GroupMembershipUpdate {
+	mutation := newGroupMembershipMutation(c.config, OpUpdate)
+	return &GroupMembershipUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GroupMembershipClient) UpdateOne(_m *GroupMembership) *GroupMembershipUpdateOne {
+	mutation := newGroupMembershipMutation(c.config, OpUpdateOne, withGroupMembership(_m))
+	return &GroupMembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GroupMembershipClient) UpdateOneID(id uint32) *GroupMembershipUpdateOne {
+	mutation := newGroupMembershipMutation(c.config, OpUpdateOne, withGroupMembershipID(id))
+	return &GroupMembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GroupMembership.
+func (c *GroupMembershipClient) Delete() *GroupMembershipDelete {
+	mutation := newGroupMembershipMutation(c.config, OpDelete)
+	return &GroupMembershipDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GroupMembershipClient) DeleteOne(_m *GroupMembership) *GroupMembershipDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GroupMembershipClient) DeleteOneID(id uint32) *GroupMembershipDeleteOne {
+	builder := c.Delete().Where(groupmembership.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GroupMembershipDeleteOne{builder}
+}
+
+// Query returns a query builder for GroupMembership.
+func (c *GroupMembershipClient) Query() *GroupMembershipQuery {
+	return &GroupMembershipQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGroupMembership},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GroupMembership entity by its id.
+func (c *GroupMembershipClient) Get(ctx context.Context, id uint32) (*GroupMembership, error) {
+	return c.Query().Where(groupmembership.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GroupMembershipClient) GetX(ctx context.Context, id uint32) *GroupMembership {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *GroupMembershipClient) Hooks() []Hook {
+	hooks := c.hooks.GroupMembership
+	return append(hooks[:len(hooks):len(hooks)], groupmembership.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *GroupMembershipClient) Interceptors() []Interceptor {
+	return c.inters.GroupMembership
+}
+
+func (c *GroupMembershipClient) mutate(ctx context.Context, m *GroupMembershipMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GroupMembershipCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GroupMembershipUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GroupMembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GroupMembershipDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GroupMembership mutation op: %q", m.Op())
+	}
+}
+
+// IdempotencyKeyClient is a client for the IdempotencyKey schema.
+type IdempotencyKeyClient struct {
+	config
+}
+
+// NewIdempotencyKeyClient returns a client for the IdempotencyKey from the given config.
+func NewIdempotencyKeyClient(c config) *IdempotencyKeyClient {
+	return &IdempotencyKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `idempotencykey.Hooks(f(g(h())))`.
+func (c *IdempotencyKeyClient) Use(hooks ...Hook) {
+	c.hooks.IdempotencyKey = append(c.hooks.IdempotencyKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `idempotencykey.Intercept(f(g(h())))`.
+func (c *IdempotencyKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IdempotencyKey = append(c.inters.IdempotencyKey, interceptors...)
+}
+
+// Create returns a builder for creating a IdempotencyKey entity.
+func (c *IdempotencyKeyClient) Create() *IdempotencyKeyCreate {
+	mutation := newIdempotencyKeyMutation(c.config, OpCreate)
+	return &IdempotencyKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IdempotencyKey entities.
+func (c *IdempotencyKeyClient) CreateBulk(builders ...*IdempotencyKeyCreate) *IdempotencyKeyCreateBulk {
+	return &IdempotencyKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IdempotencyKeyClient) MapCreateBulk(slice any, setFunc func(*IdempotencyKeyCreate, int)) *IdempotencyKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IdempotencyKeyCreateBulk{err: fmt.Errorf("calling to IdempotencyKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IdempotencyKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IdempotencyKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Update() *IdempotencyKeyUpdate {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdate)
+	return &IdempotencyKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IdempotencyKeyClient) UpdateOne(_m *IdempotencyKey) *IdempotencyKeyUpdateOne {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdateOne, withIdempotencyKey(_m))
+	return &IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IdempotencyKeyClient) UpdateOneID(id uint32) *IdempotencyKeyUpdateOne {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdateOne, withIdempotencyKeyID(id))
+	return &IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Delete() *IdempotencyKeyDelete {
+	mutation := newIdempotencyKeyMutation(c.config, OpDelete)
+	return &IdempotencyKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IdempotencyKeyClient) DeleteOne(_m *IdempotencyKey) *IdempotencyKeyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IdempotencyKeyClient) DeleteOneID(id uint32) *IdempotencyKeyDeleteOne {
+	builder := c.Delete().Where(idempotencykey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IdempotencyKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Query() *IdempotencyKeyQuery {
+	return &IdempotencyKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIdempotencyKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a IdempotencyKey entity by its id.
+func (c *IdempotencyKeyClient) Get(ctx context.Context, id uint32) (*IdempotencyKey, error) {
+	return c.Query().Where(idempotencykey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IdempotencyKeyClient) GetX(ctx context.Context, id uint32) *IdempotencyKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *IdempotencyKeyClient) Hooks() []Hook {
+	hooks := c.hooks.IdempotencyKey
+	return append(hooks[:len(hooks):len(hooks)], idempotencykey.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *IdempotencyKeyClient) Interceptors() []Interceptor {
+	return c.inters.IdempotencyKey
+}
+
+func (c *IdempotencyKeyClient) mutate(ctx context.Context, m *IdempotencyKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IdempotencyKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IdempotencyKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IdempotencyKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown IdempotencyKey mutation op: %q", m.Op())
+	}
+}
+
+// ImportConnectorClient is a client for the ImportConnector schema.
+type ImportConnectorClient struct {
+	config
+}
+
+// NewImportConnectorClient returns a client for the ImportConnector from the given config.
+func NewImportConnectorClient(c config) *ImportConnectorClient {
+	return &ImportConnectorClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `importconnector.Hooks(f(g(h())))`.
+func (c *ImportConnectorClient) Use(hooks ...Hook) {
+	c.hooks.ImportConnector = append(c.hooks.ImportConnector, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `importconnector.Intercept(f(g(h())))`.
+func (c *ImportConnectorClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ImportConnector = append(c.inters.ImportConnector, interceptors...)
+}
+
+// Create returns a builder for creating a ImportConnector entity.
+func (c *ImportConnectorClient) Create() *ImportConnectorCreate {
+	mutation := newImportConnectorMutation(c.config, OpCreate)
+	return &ImportConnectorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ImportConnector entities.
+func (c *ImportConnectorClient) CreateBulk(builders ...*ImportConnectorCreate) *ImportConnectorCreateBulk {
+	return &ImportConnectorCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ImportConnectorClient) MapCreateBulk(slice any, setFunc func(*ImportConnectorCreate, int)) *ImportConnectorCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ImportConnectorCreateBulk{err: fmt.Errorf("calling to ImportConnectorClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ImportConnectorCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ImportConnectorCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ImportConnector.
+func (c *ImportConnectorClient) Update() *ImportConnectorUpdate {
+	mutation := newImportConnectorMutation(c.config, OpUpdate)
+	return &ImportConnectorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ImportConnectorClient) UpdateOne(_m *ImportConnector) *ImportConnectorUpdateOne {
+	mutation := newImportConnectorMutation(c.config, OpUpdateOne, withImportConnector(_m))
+	return &ImportConnectorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ImportConnectorClient) UpdateOneID(id string) *ImportConnectorUpdateOne {
+	mutation := newImportConnectorMutation(c.config, OpUpdateOne, withImportConnectorID(id))
+	return &ImportConnectorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ImportConnector.
+func (c *ImportConnectorClient) Delete() *ImportConnectorDelete {
+	mutation := newImportConnectorMutation(c.config, OpDelete)
+	return &ImportConnectorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ImportConnectorClient) DeleteOne(_m *ImportConnector) *ImportConnectorDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ImportConnectorClient) DeleteOneID(id string) *ImportConnectorDeleteOne {
+	builder := c.Delete().Where(importconnector.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ImportConnectorDeleteOne{builder}
+}
+
+// Query returns a query builder for ImportConnector.
+func (c *ImportConnectorClient) Query() *ImportConnectorQuery {
+	return &ImportConnectorQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeImportConnector},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ImportConnector entity by its id.
+func (c *ImportConnectorClient) Get(ctx context.Context, id string) (*ImportConnector, error) {
+	return c.Query().Where(importconnector.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ImportConnectorClient) GetX(ctx context.Context, id string) *ImportConnector {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMappings queries the mappings edge of a ImportConnector.
+func (c *ImportConnectorClient) QueryMappings(_m *ImportConnector) *ImportMappingQuery {
+	query := (&ImportMappingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(importconnector.Table, importconnector.FieldID, id),
+			sqlgraph.To(importmapping.Table, importmapping.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, importconnector.MappingsTable, importconnector.MappingsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ImportConnectorClient) Hooks() []Hook {
+	hooks := c.hooks.ImportConnector
+	return append(hooks[:len(hooks):len(hooks)], importconnector.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ImportConnectorClient) Interceptors() []Interceptor {
+	return c.inters.ImportConnector
+}
+
+func (c *ImportConnectorClient) mutate(ctx context.Context, m *ImportConnectorMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ImportConnectorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ImportConnectorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ImportConnectorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ImportConnectorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ImportConnector mutation op: %q", m.Op())
+	}
+}
+
+// ImportMappingClient is a client for the ImportMapping schema.
+type ImportMappingClient struct {
+	config
+}
+
+// NewImportMappingClient returns a client for the ImportMapping from the given config.
+func NewImportMappingClient(c config) *ImportMappingClient {
+	return &ImportMappingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `importmapping.Hooks(f(g(h())))`.
+func (c *ImportMappingClient) Use(hooks ...Hook) {
+	c.hooks.ImportMapping = append(c.hooks.ImportMapping, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `importmapping.Intercept(f(g(h())))`.
+func (c *ImportMappingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ImportMapping = append(c.inters.ImportMapping, interceptors...)
+}
+
+// Create returns a builder for creating a ImportMapping entity.
+func (c *ImportMappingClient) Create() *ImportMappingCreate {
+	mutation := newImportMappingMutation(c.config, OpCreate)
+	return &ImportMappingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ImportMapping entities.
+func (c *ImportMappingClient) CreateBulk(builders ...*ImportMappingCreate) *ImportMappingCreateBulk {
+	return &ImportMappingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ImportMappingClient) MapCreateBulk(slice any, setFunc func(*ImportMappingCreate, int)) *ImportMappingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ImportMappingCreateBulk{err: fmt.Errorf("calling to ImportMappingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ImportMappingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ImportMappingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ImportMapping.
+func (c *ImportMappingClient) Update() *ImportMappingUpdate {
+	mutation := newImportMappingMutation(c.config, OpUpdate)
+	return &ImportMappingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ImportMappingClient) UpdateOne(_m *ImportMapping) *ImportMappingUpdateOne {
+	mutation := newImportMappingMutation(c.config, OpUpdateOne, withImportMapping(_m))
+	return &ImportMappingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ImportMappingClient) UpdateOneID(id string) *ImportMappingUpdateOne {
+	mutation := newImportMappingMutation(c.config, OpUpdateOne, withImportMappingID(id))
+	return &ImportMappingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ImportMapping.
+func (c *ImportMappingClient) Delete() *ImportMappingDelete {
+	mutation := newImportMappingMutation(c.config, OpDelete)
+	return &ImportMappingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ImportMappingClient) DeleteOne(_m *ImportMapping) *ImportMappingDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ImportMappingClient) DeleteOneID(id string) *ImportMappingDeleteOne {
+	builder := c.Delete().Where(importmapping.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ImportMappingDeleteOne{builder}
+}
+
+// Query returns a query builder for ImportMapping.
+func (c *ImportMappingClient) Query() *ImportMappingQuery {
+	return &ImportMappingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeImportMapping},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ImportMapping entity by its id.
+func (c *ImportMappingClient) Get(ctx context.Context, id string) (*ImportMapping, error) {
+	return c.Query().Where(importmapping.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ImportMappingClient) GetX(ctx context.Context, id string) *ImportMapping {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryConnector queries the connector edge of a ImportMapping.
+func (c *ImportMappingClient) QueryConnector(_m *ImportMapping) *ImportConnectorQuery {
+	query := (&ImportConnectorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(importmapping.Table, importmapping.FieldID, id),
+			sqlgraph.To(importconnector.Table, importconnector.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, importmapping.ConnectorTable, importmapping.ConnectorColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFiles queries the files edge of a ImportMapping.
+func (c *ImportMappingClient) QueryFiles(_m *ImportMapping) *ImportedFileQuery {
+	query := (&ImportedFileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(importmapping.Table, importmapping.FieldID, id),
+			sqlgraph.To(importedfile.Table, importedfile.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, importmapping.FilesTable, importmapping.FilesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ImportMappingClient) Hooks() []Hook {
+	hooks := c.hooks.ImportMapping
+	return append(hooks[:len(hooks):len(hooks)], importmapping.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ImportMappingClient) Interceptors() []Interceptor {
+	return c.inters.ImportMapping
+}
+
+func (c *ImportMappingClient) mutate(ctx context.Context, m *ImportMappingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ImportMappingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ImportMappingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ImportMappingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ImportMappingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ImportMapping mutation op: %q", m.Op())
+	}
+}
+
+// ImportedFileClient is a client for the ImportedFile schema.
+type ImportedFileClient struct {
+	config
+}
+
+// NewImportedFileClient returns a client for the ImportedFile from the given config.
+func NewImportedFileClient(c config) *ImportedFileClient {
+	return &ImportedFileClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `importedfile.Hooks(f(g(h())))`.
+func (c *ImportedFileClient) Use(hooks ...Hook) {
+	c.hooks.ImportedFile = append(c.hooks.ImportedFile, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `importedfile.Intercept(f(g(h())))`.
+func (c *ImportedFileClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ImportedFile = append(c.inters.ImportedFile, interceptors...)
+}
+
+// Create returns a builder for creating a ImportedFile entity.
+func (c *ImportedFileClient) Create() *ImportedFileCreate {
+	mutation := newImportedFileMutation(c.config, OpCreate)
+	return &ImportedFileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ImportedFile entities.
+func (c *ImportedFileClient) CreateBulk(builders ...*ImportedFileCreate) *ImportedFileCreateBulk {
+	return &ImportedFileCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ImportedFileClient) MapCreateBulk(slice any, setFunc func(*ImportedFileCreate, int)) *ImportedFileCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ImportedFileCreateBulk{err: fmt.Errorf("calling to ImportedFileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ImportedFileCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ImportedFileCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ImportedFile.
+func (c *ImportedFileClient) Update() *ImportedFileUpdate {
+	mutation := newImportedFileMutation(c.config, OpUpdate)
+	return &ImportedFileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ImportedFileClient) UpdateOne(_m *ImportedFile) *ImportedFileUpdateOne {
+	mutation := newImportedFileMutation(c.config, OpUpdateOne, withImportedFile(_m))
+	return &ImportedFileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ImportedFileClient) UpdateOneID(id uint32) *ImportedFileUpdateOne {
+	mutation := newImportedFileMutation(c.config, OpUpdateOne, withImportedFileID(id))
+	return &ImportedFileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ImportedFile.
+func (c *ImportedFileClient) Delete() *ImportedFileDelete {
+	mutation := newImportedFileMutation(c.config, OpDelete)
+	return &ImportedFileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ImportedFileClient) DeleteOne(_m *ImportedFile) *ImportedFileDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ImportedFileClient) DeleteOneID(id uint32) *ImportedFileDeleteOne {
+	builder := c.Delete().Where(importedfile.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ImportedFileDeleteOne{builder}
+}
+
+// Query returns a query builder for ImportedFile.
+func (c *ImportedFileClient) Query() *ImportedFileQuery {
+	return &ImportedFileQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeImportedFile},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ImportedFile entity by its id.
+func (c *ImportedFileClient) Get(ctx context.Context, id uint32) (*ImportedFile, error) {
+	return c.Query().Where(importedfile.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ImportedFileClient) GetX(ctx context.Context, id uint32) *ImportedFile {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMapping queries the mapping edge of a ImportedFile.
+func (c *ImportedFileClient) QueryMapping(_m *ImportedFile) *ImportMappingQuery {
+	query := (&ImportMappingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(importedfile.Table, importedfile.FieldID, id),
+			sqlgraph.To(importmapping.Table, importmapping.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, importedfile.MappingTable, importedfile.MappingColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ImportedFileClient) Hooks() []Hook {
+	hooks := c.hooks.ImportedFile
+	return append(hooks[:len(hooks):len(hooks)], importedfile.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ImportedFileClient) Interceptors() []Interceptor {
+	return c.inters.ImportedFile
+}
+
+func (c *ImportedFileClient) mutate(ctx context.Context, m *ImportedFileMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ImportedFileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ImportedFileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ImportedFileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ImportedFileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ImportedFile mutation op: %q", m.Op())
+	}
+}
+
+// NotificationPreferenceClient is a client for the NotificationPreference schema.
+type NotificationPreferenceClient struct {
+	config
+}
+
+// NewNotificationPreferenceClient returns a client for the NotificationPreference from the given config.
+func NewNotificationPreferenceClient(c config) *NotificationPreferenceClient {
+	return &NotificationPreferenceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notificationpreference.Hooks(f(g(h())))`.
+func (c *NotificationPreferenceClient) Use(hooks ...Hook) {
+	c.hooks.NotificationPreference = append(c.hooks.NotificationPreference, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notificationpreference.Intercept(f(g(h())))`.
+func (c *NotificationPreferenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NotificationPreference = append(c.inters.NotificationPreference, interceptors...)
+}
+
+// Create returns a builder for creating a NotificationPreference entity.
+func (c *NotificationPreferenceClient) Create() *NotificationPreferenceCreate {
+	mutation := newNotificationPreferenceMutation(c.config, OpCreate)
+	return &NotificationPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NotificationPreference entities.
+func (c *NotificationPreferenceClient) CreateBulk(builders ...*NotificationPreferenceCreate) *NotificationPreferenceCreateBulk {
+	return &NotificationPreferenceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NotificationPreferenceClient) MapCreateBulk(slice any, setFunc func(*NotificationPreferenceCreate, int)) *NotificationPreferenceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NotificationPreferenceCreateBulk{err: fmt.Errorf("calling to NotificationPreferenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NotificationPreferenceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NotificationPreferenceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Update() *NotificationPreferenceUpdate {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdate)
+	return &NotificationPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotificationPreferenceClient) UpdateOne(_m *NotificationPreference) *NotificationPreferenceUpdateOne {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdateOne, withNotificationPreference(_m))
+	return &NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotificationPreferenceClient) UpdateOneID(id uint32) *NotificationPreferenceUpdateOne {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdateOne, withNotificationPreferenceID(id))
+	return &NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Delete() *NotificationPreferenceDelete {
+	mutation := newNotificationPreferenceMutation(c.config, OpDelete)
+	return &NotificationPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotificationPreferenceClient) DeleteOne(_m *NotificationPreference) *NotificationPreferenceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotificationPreferenceClient) DeleteOneID(id uint32) *NotificationPreferenceDeleteOne {
+	builder := c.Delete().Where(notificationpreference.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationPreferenceDeleteOne{builder}
+}
+
+// Query returns a query builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Query() *NotificationPreferenceQuery {
+	return &NotificationPreferenceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotificationPreference},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NotificationPreference entity by its id.
+func (c *NotificationPreferenceClient) Get(ctx context.Context, id uint32) (*NotificationPreference, error) {
+	return c.Query().Where(notificationpreference.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationPreferenceClient) GetX(ctx context.Context, id uint32) *NotificationPreference {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *NotificationPreferenceClient) Hooks() []Hook {
+	hooks := c.hooks.NotificationPreference
+	return append(hooks[:len(hooks):len(hooks)], notificationpreference.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *NotificationPreferenceClient) Interceptors() []Interceptor {
+	return c.inters.NotificationPreference
+}
+
+func (c *NotificationPreferenceClient) mutate(ctx context.Context, m *NotificationPreferenceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NotificationPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NotificationPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NotificationPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown NotificationPreference mutation op: %q", m.Op())
+	}
+}
+
+// OutboxEventClient is a client for the OutboxEvent schema.
+type OutboxEventClient struct {
+	config
+}
+
+// NewOutboxEventClient returns a client for the OutboxEvent from the given config.
+func NewOutboxEventClient(c config) *OutboxEventClient {
+	return &OutboxEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `outboxevent.Hooks(f(g(h())))`.
+func (c *OutboxEventClient) Use(hooks ...Hook) {
+	c.hooks.OutboxEvent = append(c.hooks.OutboxEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `outboxevent.Intercept(f(g(h())))`.
+func (c *OutboxEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OutboxEvent = append(c.inters.OutboxEvent, interceptors...)
+}
+
+// Create returns a builder for creating a OutboxEvent entity.
+func (c *OutboxEventClient) Create() *OutboxEventCreate {
+	mutation := newOutboxEventMutation(c.config, OpCreate)
+	return &OutboxEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OutboxEvent entities.
+func (c *OutboxEventClient) CreateBulk(builders ...*OutboxEventCreate) *OutboxEventCreateBulk {
+	return &OutboxEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OutboxEventClient) MapCreateBulk(slice any, setFunc func(*OutboxEventCreate, int)) *OutboxEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OutboxEventCreateBulk{err: fmt.Errorf("calling to OutboxEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OutboxEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OutboxEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OutboxEvent.
+func (c *OutboxEventClient) Update() *OutboxEventUpdate {
+	mutation := newOutboxEventMutation(c.config, OpUpdate)
+	return &OutboxEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OutboxEventClient) UpdateOne(_m *OutboxEvent) *OutboxEventUpdateOne {
+	mutation := newOutboxEventMutation(c.config, OpUpdateOne, withOutboxEvent(_m))
+	return &OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OutboxEventClient) UpdateOneID(id uint32) *OutboxEventUpdateOne {
+	mutation := newOutboxEventMutation(c.config, OpUpdateOne, withOutboxEventID(id))
+	return &OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OutboxEvent.
+func (c *OutboxEventClient) Delete() *OutboxEventDelete {
+	mutation := newOutboxEventMutation(c.config, OpDelete)
+	return &OutboxEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OutboxEventClient) DeleteOne(_m *OutboxEvent) *OutboxEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OutboxEventClient) DeleteOneID(id uint32) *OutboxEventDeleteOne {
+	builder := c.Delete().Where(outboxevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OutboxEventDeleteOne{builder}
+}
+
+// Query returns a query builder for OutboxEvent.
+func (c *OutboxEventClient) Query() *OutboxEventQuery {
+	return &OutboxEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOutboxEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OutboxEvent entity by its id.
+func (c *OutboxEventClient) Get(ctx context.Context, id uint32) (*OutboxEvent, error) {
+	return c.Query().Where(outboxevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OutboxEventClient) GetX(ctx context.Context, id uint32) *OutboxEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OutboxEventClient) Hooks() []Hook {
+	hooks := c.hooks.OutboxEvent
+	return append(hooks[:len(hooks):len(hooks)], outboxevent.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *OutboxEventClient) Interceptors() []Interceptor {
+	return c.inters.OutboxEvent
+}
+
+func (c *OutboxEventClient) mutate(ctx context.Context, m *OutboxEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OutboxEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OutboxEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OutboxEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OutboxEvent mutation op: %q", m.Op())
+	}
+}
+
+// ReviewTaskClient is a client for the ReviewTask schema.
+type ReviewTaskClient struct {
+	config
+}
+
+// NewReviewTaskClient returns a client for the ReviewTask from the given config.
+func NewReviewTaskClient(c config) *ReviewTaskClient {
+	return &ReviewTaskClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `reviewtask.Hooks(f(g(h())))`.
+func (c *ReviewTaskClient) Use(hooks ...Hook) {
+	c.hooks.ReviewTask = append(c.hooks.ReviewTask, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `reviewtask.Intercept(f(g(h())))`.
+func (c *ReviewTaskClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReviewTask = append(c.inters.ReviewTask, interceptors...)
+}
+
+// Create returns a builder for creating a ReviewTask entity.
+func (c *ReviewTaskClient) Create() *ReviewTaskCreate {
+	mutation := newReviewTaskMutation(c.config, OpCreate)
+	return &ReviewTaskCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ReviewTask entities.
+func (c *ReviewTaskClient) CreateBulk(builders ...*ReviewTaskCreate) *ReviewTaskCreateBulk {
+	return &ReviewTaskCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReviewTaskClient) MapCreateBulk(slice any, setFunc func(*ReviewTaskCreate, int)) *ReviewTaskCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReviewTaskCreateBulk{err: fmt.Errorf("calling to ReviewTaskClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReviewTaskCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReviewTaskCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ReviewTask.
+func (c *ReviewTaskClient) Update() *ReviewTaskUpdate {
+	mutation := newReviewTaskMutation(c.config, OpUpdate)
+	return &ReviewTaskUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReviewTaskClient) UpdateOne(_m *ReviewTask) *ReviewTaskUpdateOne {
+	mutation := newReviewTaskMutation(c.config, OpUpdateOne, withReviewTask(_m))
+	return &ReviewTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReviewTaskClient) UpdateOneID(id string) *ReviewTaskUpdateOne {
+	mutation := newReviewTaskMutation(c.config, OpUpdateOne, withReviewTaskID(id))
+	return &ReviewTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ReviewTask.
+func (c *ReviewTaskClient) Delete() *ReviewTaskDelete {
+	mutation := newReviewTaskMutation(c.config, OpDelete)
+	return &ReviewTaskDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReviewTaskClient) DeleteOne(_m *ReviewTask) *ReviewTaskDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReviewTaskClient) DeleteOneID(id string) *ReviewTaskDeleteOne {
+	builder := c.Delete().Where(reviewtask.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReviewTaskDeleteOne{builder}
+}
+
+// Query returns a query builder for ReviewTask.
+func (c *ReviewTaskClient) Query() *ReviewTaskQuery {
+	return &ReviewTaskQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReviewTask},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ReviewTask entity by its id.
+func (c *ReviewTaskClient) Get(ctx context.Context, id string) (*ReviewTask, error) {
+	return c.Query().Where(reviewtask.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReviewTaskClient) GetX(ctx context.Context, id string) *ReviewTask {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDocument queries the document edge of a ReviewTask.
+func (c *ReviewTaskClient) QueryDocument(_m *ReviewTask) *DocumentQuery {
+	query := (&DocumentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reviewtask.Table, reviewtask.FieldID, id),
+			sqlgraph.To(document.Table, document.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, reviewtask.DocumentTable, reviewtask.DocumentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ReviewTaskClient) Hooks() []Hook {
+	hooks := c.hooks.ReviewTask
+	return append(hooks[:len(hooks):len(hooks)], reviewtask.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReviewTaskClient) Interceptors() []Interceptor {
+	return c.inters.ReviewTask
+}
+
+func (c *ReviewTaskClient) mutate(ctx context.Context, m *ReviewTaskMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReviewTaskCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReviewTaskUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReviewTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReviewTaskDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ReviewTask mutation op: %q", m.Op())
+	}
+}
+
+// SettingClient is a client for the Setting schema.
+type SettingClient struct {
+	config
+}
+
+// NewSettingClient returns a client for the Setting from the given config.
+func NewSettingClient(c config) *SettingClient {
+	return &SettingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `setting.Hooks(f(g(h())))`.
+func (c *SettingClient) Use(hooks ...Hook) {
+	c.hooks.Setting = append(c.hooks.Setting, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `setting.Intercept(f(g(h())))`.
+func (c *SettingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Setting = append(c.inters.Setting, interceptors...)
+}
+
+// Create returns a builder for creating a Setting entity.
+func (c *SettingClient) Create() *SettingCreate {
+	mutation := newSettingMutation(c.config, OpCreate)
+	return &SettingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Setting entities.
+func (c *SettingClient) CreateBulk(builders ...*SettingCreate) *SettingCreateBulk {
+	return &SettingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SettingClient) MapCreateBulk(slice any, setFunc func(*SettingCreate, int)) *SettingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SettingCreateBulk{err: fmt.Errorf("calling to SettingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SettingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SettingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Setting.
+func (c *SettingClient) Update() *SettingUpdate {
+	mutation := newSettingMutation(c.config, OpUpdate)
+	return &SettingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SettingClient) UpdateOne(_m *Setting) *SettingUpdateOne {
+	mutation := newSettingMutation(c.config, OpUpdateOne, withSetting(_m))
+	return &SettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SettingClient) UpdateOneID(id uint32) *SettingUpdateOne {
+	mutation := newSettingMutation(c.config, OpUpdateOne, withSettingID(id))
+	return &SettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Setting.
+func (c *SettingClient) Delete() *SettingDelete {
+	mutation := newSettingMutation(c.config, OpDelete)
+	return &SettingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SettingClient) DeleteOne(_m *Setting) *SettingDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SettingClient) DeleteOneID(id uint32) *SettingDeleteOne {
+	builder := c.Delete().Where(setting.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SettingDeleteOne{builder}
+}
+
+// Query returns a query builder for Setting.
+func (c *SettingClient) Query() *SettingQuery {
+	return &SettingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSetting},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Setting entity by its id.
+func (c *SettingClient) Get(ctx context.Context, id uint32) (*Setting, error) {
+	return c.Query().Where(setting.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SettingClient) GetX(ctx context.Context, id uint32) *Setting {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SettingClient) Hooks() []Hook {
+	return c.hooks.Setting
+}
+
+// Interceptors returns the client interceptors.
+func (c *SettingClient) Interceptors() []Interceptor {
+	return c.inters.Setting
+}
+
+func (c *SettingClient) mutate(ctx context.Context, m *SettingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SettingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SettingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SettingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Setting mutation op: %q", m.Op())
+	}
+}
+
+// SignatureRequestClient is a client for the SignatureRequest schema.
+type SignatureRequestClient struct {
+	config
+}
+
+// NewSignatureRequestClient returns a client for the SignatureRequest from the given config.
+func NewSignatureRequestClient(c config) *SignatureRequestClient {
+	return &SignatureRequestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `signaturerequest.Hooks(f(g(h())))`.
+func (c *SignatureRequestClient) Use(hooks ...Hook) {
+	c.hooks.SignatureRequest = append(c.hooks.SignatureRequest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `signaturerequest.Intercept(f(g(h())))`.
+func (c *SignatureRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SignatureRequest = append(c.inters.SignatureRequest, interceptors...)
+}
+
+// Create returns a builder for creating a SignatureRequest entity.
+func (c *SignatureRequestClient) Create() *SignatureRequestCreate {
+	mutation := newSignatureRequestMutation(c.config, OpCreate)
+	return &SignatureRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SignatureRequest entities.
+func (c *SignatureRequestClient) CreateBulk(builders ...*SignatureRequestCreate) *SignatureRequestCreateBulk {
+	return &SignatureRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SignatureRequestClient) MapCreateBulk(slice any, setFunc func(*SignatureRequestCreate, int)) *SignatureRequestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SignatureRequestCreateBulk{err: fmt.Errorf("calling to SignatureRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SignatureRequestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SignatureRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SignatureRequest.
+func (c *SignatureRequestClient) Update() *SignatureRequestUpdate {
+	mutation := newSignatureRequestMutation(c.config, OpUpdate)
+	return &SignatureRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SignatureRequestClient) UpdateOne(_m *SignatureRequest) *SignatureRequestUpdateOne {
+	mutation := newSignatureRequestMutation(c.config, OpUpdateOne, withSignatureRequest(_m))
+	return &SignatureRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SignatureRequestClient) UpdateOneID(id string) *SignatureRequestUpdateOne {
+	mutation := newSignatureRequestMutation(c.config, OpUpdateOne, withSignatureRequestID(id))
+	return &SignatureRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SignatureRequest.
+func (c *SignatureRequestClient) Delete() *SignatureRequestDelete {
+	mutation := newSignatureRequestMutation(c.config, OpDelete)
+	return &SignatureRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SignatureRequestClient) DeleteOne(_m *SignatureRequest) *SignatureRequestDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SignatureRequestClient) DeleteOneID(id string) *SignatureRequestDeleteOne {
+	builder := c.Delete().Where(signaturerequest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SignatureRequestDeleteOne{builder}
+}
+
+// Query returns a query builder for SignatureRequest.
+func (c *SignatureRequestClient) Query() *SignatureRequestQuery {
+	return &SignatureRequestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSignatureRequest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SignatureRequest entity by its id.
+func (c *SignatureRequestClient) Get(ctx context.Context, id string) (*SignatureRequest, error) {
+	return c.Query().Where(signaturerequest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SignatureRequestClient) GetX(ctx context.Context, id string) *SignatureRequest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDocument queries the document edge of a SignatureRequest.
+func (c *SignatureRequestClient) QueryDocument(_m *SignatureRequest) *DocumentQuery {
+	query := (&DocumentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(signaturerequest.Table, signaturerequest.FieldID, id),
+			sqlgraph.To(document.Table, document.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, signaturerequest.DocumentTable, signaturerequest.DocumentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SignatureRequestClient) Hooks() []Hook {
+	hooks := c.hooks.SignatureRequest
+	return append(hooks[:len(hooks):len(hooks)], signaturerequest.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *SignatureRequestClient) Interceptors() []Interceptor {
+	return c.inters.SignatureRequest
+}
+
+func (c *SignatureRequestClient) mutate(ctx context.Context, m *SignatureRequestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SignatureRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SignatureRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SignatureRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SignatureRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SignatureRequest mutation op: %q", m.Op())
+	}
+}
+
+// StatisticsRollupClient is a client for the StatisticsRollup schema.
+type StatisticsRollupClient struct {
+	config
+}
+
+// NewStatisticsRollupClient returns a client for the StatisticsRollup from the given config.
+func NewStatisticsRollupClient(c config) *StatisticsRollupClient {
+	return &StatisticsRollupClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `statisticsrollup.Hooks(f(g(h())))`.
+func (c *StatisticsRollupClient) Use(hooks ...Hook) {
+	c.hooks.StatisticsRollup = append(c.hooks.StatisticsRollup, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `statisticsrollup.Intercept(f(g(h())))`.
+func (c *StatisticsRollupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.StatisticsRollup = append(c.inters.StatisticsRollup, interceptors...)
+}
+
+// Create returns a builder for creating a StatisticsRollup entity.
+func (c *StatisticsRollupClient) Create() *StatisticsRollupCreate {
+	mutation := newStatisticsRollupMutation(c.config, OpCreate)
+	return &StatisticsRollupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of StatisticsRollup entities.
+func (c *StatisticsRollupClient) CreateBulk(builders ...*StatisticsRollupCreate) *StatisticsRollupCreateBulk {
+	return &StatisticsRollupCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *StatisticsRollupClient) MapCreateBulk(slice any, setFunc func(*StatisticsRollupCreate, int)) *StatisticsRollupCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &StatisticsRollupCreateBulk{err: fmt.Errorf("calling to StatisticsRollupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*StatisticsRollupCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &StatisticsRollupCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for StatisticsRollup.
+func (c *StatisticsRollupClient) Update() *StatisticsRollupUpdate {
+	mutation := newStatisticsRollupMutation(c.config, OpUpdate)
+	return &StatisticsRollupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StatisticsRollupClient) UpdateOne(_m *StatisticsRollup) *StatisticsRollupUpdateOne {
+	mutation := newStatisticsRollupMutation(c.config, OpUpdateOne, withStatisticsRollup(_m))
+	return &StatisticsRollupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StatisticsRollupClient) UpdateOneID(id uint32) *StatisticsRollupUpdateOne {
+	mutation := newStatisticsRollupMutation(c.config, OpUpdateOne, withStatisticsRollupID(id))
+	return &StatisticsRollupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for StatisticsRollup.
+func (c *StatisticsRollupClient) Delete() *StatisticsRollupDelete {
+	mutation := newStatisticsRollupMutation(c.config, OpDelete)
+	return &StatisticsRollupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *StatisticsRollupClient) DeleteOne(_m *StatisticsRollup) *StatisticsRollupDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *StatisticsRollupClient) DeleteOneID(id uint32) *StatisticsRollupDeleteOne {
+	builder := c.Delete().Where(statisticsrollup.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &StatisticsRollupDeleteOne{builder}
+}
+
+// Query returns a query builder for StatisticsRollup.
+func (c *StatisticsRollupClient) Query() *StatisticsRollupQuery {
+	return &StatisticsRollupQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeStatisticsRollup},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a StatisticsRollup entity by its id.
+func (c *StatisticsRollupClient) Get(ctx context.Context, id uint32) (*StatisticsRollup, error) {
+	return c.Query().Where(statisticsrollup.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *StatisticsRollupClient) GetX(ctx context.Context, id uint32) *StatisticsRollup {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *StatisticsRollupClient) Hooks() []Hook {
+	hooks := c.hooks.StatisticsRollup
+	return append(hooks[:len(hooks):len(hooks)], statisticsrollup.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *StatisticsRollupClient) Interceptors() []Interceptor {
+	return c.inters.StatisticsRollup
+}
+
+func (c *StatisticsRollupClient) mutate(ctx context.Context, m *StatisticsRollupMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&StatisticsRollupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&StatisticsRollupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&StatisticsRollupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&StatisticsRollupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown StatisticsRollup mutation op: %q", m.Op())
+	}
+}
+
+// TagClient is a client for the Tag schema.
+type TagClient struct {
+	config
+}
+
+// NewTagClient returns a client for the Tag from the given config.
+func NewTagClient(c config) *TagClient {
+	return &TagClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tag.Hooks(f(g(h())))`.
+func (c *TagClient) Use(hooks ...Hook) {
+	c.hooks.Tag = append(c.hooks.Tag, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tag.Intercept(f(g(h())))`.
+func (c *TagClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Tag = append(c.inters.Tag, interceptors...)
+}
+
+// Create returns a builder for creating a Tag entity.
+func (c *TagClient) Create() *TagCreate {
+	mutation := newTagMutation(c.config, OpCreate)
+	return &TagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Tag entities.
+func (c *TagClient) CreateBulk(builders ...*TagCreate) *TagCreateBulk {
+	return &TagCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TagClient) MapCreateBulk(slice any, setFunc func(*TagCreate, int)) *TagCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TagCreateBulk{err: fmt.Errorf("calling to TagClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TagCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TagCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Tag.
+func (c *TagClient) Update() *TagUpdate {
+	mutation := newTagMutation(c.config, OpUpdate)
+	return &TagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TagClient) UpdateOne(_m *Tag) *TagUpdateOne {
+	mutation := newTagMutation(c.config, OpUpdateOne, withTag(_m))
+	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TagClient) UpdateOneID(id uint32) *TagUpdateOne {
+	mutation := newTagMutation(c.config, OpUpdateOne, withTagID(id))
+	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Tag.
+func (c *TagClient) Delete() *TagDelete {
+	mutation := newTagMutation(c.config, OpDelete)
+	return &TagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TagClient) DeleteOne(_m *Tag) *TagDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TagClient) DeleteOneID(id uint32) *TagDeleteOne {
+	builder := c.Delete().Where(tag.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TagDeleteOne{builder}
+}
+
+// Query returns a query builder for Tag.
+func (c *TagClient) Query() *TagQuery {
+	return &TagQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTag},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Tag entity by its id.
+func (c *TagClient) Get(ctx context.Context, id uint32) (*Tag, error) {
+	return c.Query().Where(tag.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TagClient) GetX(ctx context.Context, id uint32) *Tag {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDocumentTags queries the document_tags edge of a Tag.
+func (c *TagClient) QueryDocumentTags(_m *Tag) *DocumentTagQuery {
+	query := (&DocumentTagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tag.Table, tag.FieldID, id),
+			sqlgraph.To(documenttag.Table, documenttag.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tag.DocumentTagsTable, tag.DocumentTagsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TagClient) Hooks() []Hook {
+	hooks := c.hooks.Tag
+	return append(hooks[:len(hooks):len(hooks)], tag.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *TagClient) Interceptors() []Interceptor {
+	return c.inters.Tag
+}
+
+func (c *TagClient) mutate(ctx context.Context, m *TagMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Tag mutation op: %q", m.Op())
+	}
+}
+
+// TenantDeleteJobClient is a client for the TenantDeleteJob schema.
+type TenantDeleteJobClient struct {
+	config
+}
+
+// NewTenantDeleteJobClient returns a client for the TenantDeleteJob from the given config.
+func NewTenantDeleteJobClient(c config) *TenantDeleteJobClient {
+	return &TenantDeleteJobClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tenantdeletejob.Hooks(f(g(h())))`.
+func (c *TenantDeleteJobClient) Use(hooks ...Hook) {
+	c.hooks.TenantDeleteJob = append(c.hooks.TenantDeleteJob, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tenantdeletejob.Intercept(f(g(h())))`.
+func (c *TenantDeleteJobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TenantDeleteJob = append(c.inters.TenantDeleteJob, interceptors...)
+}
+
+// Create returns a builder for creating a TenantDeleteJob entity.
+func (c *TenantDeleteJobClient) Create() *TenantDeleteJobCreate {
+	mutation := newTenantDeleteJobMutation(c.config, OpCreate)
+	return &TenantDeleteJobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TenantDeleteJob entities.
+func (c *TenantDeleteJobClient) CreateBulk(builders ...*TenantDeleteJobCreate) *TenantDeleteJobCreateBulk {
+	return &TenantDeleteJobCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TenantDeleteJobClient) MapCreateBulk(slice any, setFunc func(*TenantDeleteJobCreate, int)) *TenantDeleteJobCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TenantDeleteJobCreateBulk{err: fmt.Errorf("calling to TenantDeleteJobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TenantDeleteJobCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TenantDeleteJobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TenantDeleteJob.
+func (c *TenantDeleteJobClient) Update() *TenantDeleteJobUpdate {
+	mutation := newTenantDeleteJobMutation(c.config, OpUpdate)
+	return &TenantDeleteJobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TenantDeleteJobClient) UpdateOne(_m *TenantDeleteJob) *TenantDeleteJobUpdateOne {
+	mutation := newTenantDeleteJobMutation(c.config, OpUpdateOne, withTenantDeleteJob(_m))
+	return &TenantDeleteJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TenantDeleteJobClient) UpdateOneID(id string) *TenantDeleteJobUpdateOne {
+	mutation := newTenantDeleteJobMutation(c.config, OpUpdateOne, withTenantDeleteJobID(id))
+	return &TenantDeleteJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TenantDeleteJob.
+func (c *TenantDeleteJobClient) Delete() *TenantDeleteJobDelete {
+	mutation := newTenantDeleteJobMutation(c.config, OpDelete)
+	return &TenantDeleteJobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TenantDeleteJobClient) DeleteOne(_m *TenantDeleteJob) *TenantDeleteJobDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TenantDeleteJobClient) DeleteOneID(id string) *TenantDeleteJobDeleteOne {
+	builder := c.Delete().Where(tenantdeletejob.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TenantDeleteJobDeleteOne{builder}
+}
+
+// Query returns a query builder for TenantDeleteJob.
+func (c *TenantDeleteJobClient) Query() *TenantDeleteJobQuery {
+	return &TenantDeleteJobQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTenantDeleteJob},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TenantDeleteJob entity by its id.
+func (c *TenantDeleteJobClient) Get(ctx context.Context, id string) (*TenantDeleteJob, error) {
+	return c.Query().Where(tenantdeletejob.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TenantDeleteJobClient) GetX(ctx context.Context, id string) *TenantDeleteJob {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TenantDeleteJobClient) Hooks() []Hook {
+	return c.hooks.TenantDeleteJob
+}
+
+// Interceptors returns the client interceptors.
+func (c *TenantDeleteJobClient) Interceptors() []Interceptor {
+	return c.inters.TenantDeleteJob
+}
+
+func (c *TenantDeleteJobClient) mutate(ctx context.Context, m *TenantDeleteJobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TenantDeleteJobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TenantDeleteJobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TenantDeleteJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TenantDeleteJobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TenantDeleteJob mutation op: %q", m.Op())
+	}
+}
+
+// TenantKeyClient is a client for the TenantKey schema.
+type TenantKeyClient struct {
+	config
+}
+
+// NewTenantKeyClient returns a client for the TenantKey from the given config.
+func NewTenantKeyClient(c config) *TenantKeyClient {
+	return &TenantKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tenantkey.Hooks(f(g(h())))`.
+func (c *TenantKeyClient) Use(hooks ...Hook) {
+	c.hooks.TenantKey = append(c.hooks.TenantKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tenantkey.Intercept(f(g(h())))`.
+func (c *TenantKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TenantKey = append(c.inters.TenantKey, interceptors...)
+}
+
+// Create returns a builder for creating a TenantKey entity.
+func (c *TenantKeyClient) Create() *TenantKeyCreate {
+	mutation := newTenantKeyMutation(c.config, OpCreate)
+	return &TenantKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TenantKey entities.
+func (c *TenantKeyClient) CreateBulk(builders ...*TenantKeyCreate) *TenantKeyCreateBulk {
+	return &TenantKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TenantKeyClient) MapCreateBulk(slice any, setFunc func(*TenantKeyCreate, int)) *TenantKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TenantKeyCreateBulk{err: fmt.Errorf("calling to TenantKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TenantKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TenantKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TenantKey.
+func (c *TenantKeyClient) Update() *TenantKeyUpdate {
+	mutation := newTenantKeyMutation(c.config, OpUpdate)
+	return &TenantKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TenantKeyClient) UpdateOne(_m *TenantKey) *TenantKeyUpdateOne {
+	mutation := newTenantKeyMutation(c.config, OpUpdateOne, withTenantKey(_m))
+	return &TenantKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TenantKeyClient) UpdateOneID(id uint32) *TenantKeyUpdateOne {
+	mutation := newTenantKeyMutation(c.config, OpUpdateOne, withTenantKeyID(id))
+	return &TenantKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TenantKey.
+func (c *TenantKeyClient) Delete() *TenantKeyDelete {
+	mutation := newTenantKeyMutation(c.config, OpDelete)
+	return &TenantKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TenantKeyClient) DeleteOne(_m *TenantKey) *TenantKeyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TenantKeyClient) DeleteOneID(id uint32) *TenantKeyDeleteOne {
+	builder := c.Delete().Where(tenantkey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TenantKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for TenantKey.
+func (c *TenantKeyClient) Query() *TenantKeyQuery {
+	return &TenantKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTenantKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TenantKey entity by its id.
+func (c *TenantKeyClient) Get(ctx context.Context, id uint32) (*TenantKey, error) {
+	return c.Query().Where(tenantkey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TenantKeyClient) GetX(ctx context.Context, id uint32) *TenantKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TenantKeyClient) Hooks() []Hook {
+	hooks := c.hooks.TenantKey
+	return append(hooks[:len(hooks):len(hooks)], tenantkey.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *TenantKeyClient) Interceptors() []Interceptor {
+	return c.inters.TenantKey
+}
+
+func (c *TenantKeyClient) mutate(ctx context.Context, m *TenantKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TenantKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TenantKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TenantKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TenantKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TenantKey mutation op: %q", m.Op())
+	}
+}
+
+// TenantQuotaClient is a client for the TenantQuota schema.
+type TenantQuotaClient struct {
+	config
+}
+
+// NewTenantQuotaClient returns a client for the TenantQuota from the given config.
+func NewTenantQuotaClient(c config) *TenantQuotaClient {
+	return &TenantQuotaClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tenantquota.Hooks(f(g(h())))`.
+func (c *TenantQuotaClient) Use(hooks ...Hook) {
+	c.hooks.TenantQuota = append(c.hooks.TenantQuota, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tenantquota.Intercept(f(g(h())))`.
+func (c *TenantQuotaClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TenantQuota = append(c.inters.TenantQuota, interceptors...)
+}
+
+// Create returns a builder for creating a TenantQuota entity.
+func (c *TenantQuotaClient) Create() *TenantQuotaCreate {
+	mutation := newTenantQuotaMutation(c.config, OpCreate)
+	return &TenantQuotaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TenantQuota entities.
+func (c *TenantQuotaClient) CreateBulk(builders ...*TenantQuotaCreate) *TenantQuotaCreateBulk {
+	return &TenantQuotaCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TenantQuotaClient) MapCreateBulk(slice any, setFunc func(*TenantQuotaCreate, int)) *TenantQuotaCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TenantQuotaCreateBulk{err: fmt.Errorf("calling to TenantQuotaClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TenantQuotaCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TenantQuotaCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TenantQuota.
+func (c *TenantQuotaClient) Update() *TenantQuotaUpdate {
+	mutation := newTenantQuotaMutation(c.config, OpUpdate)
+	return &TenantQuotaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TenantQuotaClient) UpdateOne(_m *TenantQuota) *TenantQuotaUpdateOne {
+	mutation := newTenantQuotaMutation(c.config, OpUpdateOne, withTenantQuota(_m))
+	return &TenantQuotaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TenantQuotaClient) UpdateOneID(id uint32) *TenantQuotaUpdateOne {
+	mutation := newTenantQuotaMutation(c.config, OpUpdateOne, withTenantQuotaID(id))
+	return &TenantQuotaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TenantQuota.
+func (c *TenantQuotaClient) Delete() *TenantQuotaDelete {
+	mutation := newTenantQuotaMutation(c.config, OpDelete)
+	return &TenantQuotaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TenantQuotaClient) DeleteOne(_m *TenantQuota) *TenantQuotaDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TenantQuotaClient) DeleteOneID(id uint32) *TenantQuotaDeleteOne {
+	builder := c.Delete().Where(tenantquota.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TenantQuotaDeleteOne{builder}
+}
+
+// Query returns a query builder for TenantQuota.
+func (c *TenantQuotaClient) Query() *TenantQuotaQuery {
+	return &TenantQuotaQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTenantQuota},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TenantQuota entity by its id.
+func (c *TenantQuotaClient) Get(ctx context.Context, id uint32) (*TenantQuota, error) {
+	return c.Query().Where(tenantquota.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TenantQuotaClient) GetX(ctx context.Context, id uint32) *TenantQuota {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TenantQuotaClient) Hooks() []Hook {
+	hooks := c.hooks.TenantQuota
+	return append(hooks[:len(hooks):len(hooks)], tenantquota.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *TenantQuotaClient) Interceptors() []Interceptor {
+	return c.inters.TenantQuota
+}
+
+func (c *TenantQuotaClient) mutate(ctx context.Context, m *TenantQuotaMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TenantQuotaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TenantQuotaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TenantQuotaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TenantQuotaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TenantQuota mutation op: %q", m.Op())
+	}
+}
+
+// TenantSettingsClient is a client for the TenantSettings schema.
+type TenantSettingsClient struct {
+	config
+}
+
+// NewTenantSettingsClient returns a client for the TenantSettings from the given config.
+func NewTenantSettingsClient(c config) *TenantSettingsClient {
+	return &TenantSettingsClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tenantsettings.Hooks(f(g(h())))`.
+func (c *TenantSettingsClient) Use(hooks ...Hook) {
+	c.hooks.TenantSettings = append(c.hooks.TenantSettings, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tenantsettings.Intercept(f(g(h())))`.
+func (c *TenantSettingsClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TenantSettings = append(c.inters.TenantSettings, interceptors...)
+}
+
+// Create returns a builder for creating a TenantSettings entity.
+func (c *TenantSettingsClient) Create() *TenantSettingsCreate {
+	mutation := newTenantSettingsMutation(c.config, OpCreate)
+	return &TenantSettingsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TenantSettings entities.
+func (c *TenantSettingsClient) CreateBulk(builders ...*TenantSettingsCreate) *TenantSettingsCreateBulk {
+	return &TenantSettingsCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TenantSettingsClient) MapCreateBulk(slice any, setFunc func(*TenantSettingsCreate, int)) *TenantSettingsCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TenantSettingsCreateBulk{err: fmt.Errorf("calling to TenantSettingsClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TenantSettingsCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TenantSettingsCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TenantSettings.
+func (c *TenantSettingsClient) Update() *TenantSettingsUpdate {
+	mutation := newTenantSettingsMutation(c.config, OpUpdate)
+	return &TenantSettingsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TenantSettingsClient) UpdateOne(_m *TenantSettings) *TenantSettingsUpdateOne {
+	mutation := newTenantSettingsMutation(c.config, OpUpdateOne, withTenantSettings(_m))
+	return &TenantSettingsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TenantSettingsClient) UpdateOneID(id uint32) *TenantSettingsUpdateOne {
+	mutation := newTenantSettingsMutation(c.config, OpUpdateOne, withTenantSettingsID(id))
+	return &TenantSettingsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TenantSettings.
+func (c *TenantSettingsClient) Delete() *TenantSettingsDelete {
+	mutation := newTenantSettingsMutation(c.config, OpDelete)
+	return &TenantSettingsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TenantSettingsClient) DeleteOne(_m *TenantSettings) *TenantSettingsDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TenantSettingsClient) DeleteOneID(id uint32) *TenantSettingsDeleteOne {
+	builder := c.Delete().Where(tenantsettings.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TenantSettingsDeleteOne{builder}
+}
+
+// Query returns a query builder for TenantSettings.
+func (c *TenantSettingsClient) Query() *TenantSettingsQuery {
+	return &TenantSettingsQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTenantSettings},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TenantSettings entity by its id.
+func (c *TenantSettingsClient) Get(ctx context.Context, id uint32) (*TenantSettings, error) {
+	return c.Query().Where(tenantsettings.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TenantSettingsClient) GetX(ctx context.Context, id uint32) *TenantSettings {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TenantSettingsClient) Hooks() []Hook {
+	hooks := c.hooks.TenantSettings
+	return append(hooks[:len(hooks):len(hooks)], tenantsettings.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *TenantSettingsClient) Interceptors() []Interceptor {
+	return c.inters.TenantSettings
+}
+
+func (c *TenantSettingsClient) mutate(ctx context.Context, m *TenantSettingsMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TenantSettingsCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TenantSettingsUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TenantSettingsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TenantSettingsDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TenantSettings mutation op: %q", m.Op())
+	}
+}
+
+// WebhookDeliveryClient is a client for the WebhookDelivery schema.
+type WebhookDeliveryClient struct {
+	config
+}
+
+// NewWebhookDeliveryClient returns a client for the WebhookDelivery from the given config.
+func NewWebhookDeliveryClient(c config) *WebhookDeliveryClient {
+	return &WebhookDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookdelivery.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDelivery = append(c.hooks.WebhookDelivery, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookdelivery.Intercept(f(g(h())))`.
+func (c *WebhookDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookDelivery = append(c.inters.WebhookDelivery, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookDelivery entity.
+func (c *WebhookDeliveryClient) Create() *WebhookDeliveryCreate {
+	mutation := newWebhookDeliveryMutation(c.config, OpCreate)
+	return &WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookDelivery entities.
+func (c *WebhookDeliveryClient) CreateBulk(builders ...*WebhookDeliveryCreate) *WebhookDeliveryCreateBulk {
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookDeliveryClient) MapCreateBulk(slice any, setFunc func(*WebhookDeliveryCreate, int)) *WebhookDeliveryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookDeliveryCreateBulk{err: fmt.Errorf("calling to WebhookDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookDeliveryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Update() *WebhookDeliveryUpdate {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdate)
+	return &WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookDeliveryClient) UpdateOne(_m *WebhookDelivery) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDelivery(_m))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookDeliveryClient) UpdateOneID(id uint32) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDeliveryID(id))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Delete() *WebhookDeliveryDelete {
+	mutation := newWebhookDeliveryMutation(c.config, OpDelete)
+	return &WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookDeliveryClient) DeleteOne(_m *WebhookDelivery) *WebhookDeliveryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookDeliveryClient) DeleteOneID(id uint32) *WebhookDeliveryDeleteOne {
+	builder := c.Delete().Where(webhookdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Query() *WebhookDeliveryQuery {
+	return &WebhookDeliveryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookDelivery},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookDelivery entity by its id.
+func (c *WebhookDeliveryClient) Get(ctx context.Context, id uint32) (*WebhookDelivery, error) {
+	return c.Query().Where(webhookdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookDeliveryClient) GetX(ctx context.Context, id uint32) *WebhookDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySubscription queries the subscription edge of a WebhookDelivery.
+func (c *WebhookDeliveryClient) QuerySubscription(_m *WebhookDelivery) *WebhookSubscriptionQuery {
+	query := (&WebhookSubscriptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhookdelivery.Table, webhookdelivery.FieldID, id),
+			sqlgraph.To(webhooksubscription.Table, webhooksubscription.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhookdelivery.SubscriptionTable, webhookdelivery.SubscriptionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookDeliveryClient) Hooks() []Hook {
+	hooks := c.hooks.WebhookDelivery
+	return append(hooks[:len(hooks):len(hooks)], webhookdelivery.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.WebhookDelivery
+}
+
+func (c *WebhookDeliveryClient) mutate(ctx context.Context, m *WebhookDeliveryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WebhookDelivery mutation op: %q", m.Op())
+	}
+}
+
+// WebhookSubscriptionClient is a client for the WebhookSubscription schema.
+type WebhookSubscriptionClient struct {
+	config
+}
+
+// NewWebhookSubscriptionClient returns a client for the WebhookSubscription from the given config.
+func NewWebhookSubscriptionClient(c config) *WebhookSubscriptionClient {
+	return &WebhookSubscriptionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhooksubscription.Hooks(f(g(h())))`.
+func (c *WebhookSubscriptionClient) Use(hooks ...Hook) {
+	c.hooks.WebhookSubscription = append(c.hooks.WebhookSubscription, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhooksubscription.Intercept(f(g(h())))`.
+func (c *WebhookSubscriptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookSubscription = append(c.inters.WebhookSubscription, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookSubscription entity.
+func (c *WebhookSubscriptionClient) Create() *WebhookSubscriptionCreate {
+	mutation := newWebhookSubscriptionMutation(c.config, OpCreate)
+	return &WebhookSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookSubscription entities.
+func (c *WebhookSubscriptionClient) CreateBulk(builders ...*WebhookSubscriptionCreate) *WebhookSubscriptionCreateBulk {
+	return &WebhookSubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookSubscriptionClient) MapCreateBulk(slice any, setFunc func(*WebhookSubscriptionCreate, int)) *WebhookSubscriptionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookSubscriptionCreateBulk{err: fmt.Errorf("calling to WebhookSubscriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookSubscriptionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookSubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookSubscription.
+func (c *WebhookSubscriptionClient) Update() *WebhookSubscriptionUpdate {
+	mutation := newWebhookSubscriptionMutation(c.config, OpUpdate)
+	return &WebhookSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookSubscriptionClient) UpdateOne(_m *WebhookSubscription) *WebhookSubscriptionUpdateOne {
+	mutation := newWebhookSubscriptionMutation(c.config, OpUpdateOne, withWebhookSubscription(_m))
+	return &WebhookSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookSubscriptionClient) UpdateOneID(id string) *WebhookSubscriptionUpdateOne {
+	mutation := newWebhookSubscriptionMutation(c.config, OpUpdateOne, withWebhookSubscriptionID(id))
+	return &WebhookSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookSubscription.
+func (c *WebhookSubscriptionClient) Delete() *WebhookSubscriptionDelete {
+	mutation := newWebhookSubscriptionMutation(c.config, OpDelete)
+	return &WebhookSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookSubscriptionClient) DeleteOne(_m *WebhookSubscription) *WebhookSubscriptionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookSubscriptionClient) DeleteOneID(id string) *WebhookSubscriptionDeleteOne {
+	builder := c.Delete().Where(webhooksubscription.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookSubscriptionDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookSubscription.
+func (c *WebhookSubscriptionClient) Query() *WebhookSubscriptionQuery {
+	return &WebhookSubscriptionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookSubscription},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookSubscription entity by its id.
+func (c *WebhookSubscriptionClient) Get(ctx context.Context, id string) (*WebhookSubscription, error) {
+	return c.Query().Where(webhooksubscription.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookSubscriptionClient) GetX(ctx context.Context, id string) *WebhookSubscription {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDeliveries queries the deliveries edge of a WebhookSubscription.
+func (c *WebhookSubscriptionClient) QueryDeliveries(_m *WebhookSubscription) *WebhookDeliveryQuery {
+	query := (&WebhookDeliveryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhooksubscription.Table, webhooksubscription.FieldID, id),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, webhooksubscription.DeliveriesTable, webhooksubscription.DeliveriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookSubscriptionClient) Hooks() []Hook {
+	hooks := c.hooks.WebhookSubscription
+	return append(hooks[:len(hooks):len(hooks)], webhooksubscription.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookSubscriptionClient) Interceptors() []Interceptor {
+	return c.inters.WebhookSubscription
+}
+
+func (c *WebhookSubscriptionClient) mutate(ctx context.Context, m *WebhookSubscriptionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WebhookSubscription mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		AuditLog, Category, Document, DocumentPermission []ent.Hook
+		AccessibleResource, AuditEvent, AuditLog, Category, CategoryDeleteJob, Document,
+		DocumentContent, DocumentHistory, DocumentPermission, DocumentTag,
+		GroupMembership, IdempotencyKey, ImportConnector, ImportMapping, ImportedFile,
+		NotificationPreference, OutboxEvent, ReviewTask, Setting, SignatureRequest,
+		StatisticsRollup, Tag, TenantDeleteJob, TenantKey, TenantQuota, TenantSettings,
+		WebhookDelivery, WebhookSubscription []ent.Hook
 	}
 	inters struct {
-		AuditLog, Category, Document, DocumentPermission []ent.Interceptor
+		AccessibleResource, AuditEvent, AuditLog, Category, CategoryDeleteJob, Document,
+		DocumentContent, DocumentHistory, DocumentPermission, DocumentTag,
+		GroupMembership, IdempotencyKey, ImportConnector, ImportMapping, ImportedFile,
+		NotificationPreference, OutboxEvent, ReviewTask, Setting, SignatureRequest,
+		StatisticsRollup, Tag, TenantDeleteJob, TenantKey, TenantQuota, TenantSettings,
+		WebhookDelivery, WebhookSubscription []ent.Interceptor
 	}
 )
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/category"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/document"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documentcontent"
 )
 
 // Document is the model entity for the Document schema.
@@ -32,6 +33,8 @@ type Document struct {
 	DeleteTime *time.Time `json:"delete_time,omitempty"`
 	// 租户ID
 	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// 版本号/乐观锁
+	Version uint32 `json:"version,omitempty"`
 	// Parent category ID (null for root-level documents)
 	CategoryID *string `json:"category_id,omitempty"`
 	// Document display name
@@ -50,16 +53,32 @@ type Document struct {
 	Checksum string `json:"checksum,omitempty"`
 	// Custom tags (key-value pairs)
 	Tags map[string]string `json:"tags,omitempty"`
+	// Kind of document, e.g. invoice or contract
+	DocumentType string `json:"document_type,omitempty"`
+	// Retention class the document is kept under
+	RetentionClass string `json:"retention_class,omitempty"`
 	// Document status
 	Status document.Status `json:"status,omitempty"`
 	// Source of the document (upload, email, etc.)
 	Source document.Source `json:"source,omitempty"`
-	// Extracted text content for full-text search
-	ContentText string `json:"content_text,omitempty"`
-	// Metadata extracted by Tika (author, title, page_count, etc.)
-	ExtractedMetadata map[string]string `json:"extracted_metadata,omitempty"`
 	// Document content extraction status
 	ProcessingStatus document.ProcessingStatus `json:"processing_status,omitempty"`
+	// Storage tier currently holding the file
+	StorageTier document.StorageTier `json:"storage_tier,omitempty"`
+	// Last time the file was downloaded
+	LastAccessedAt *time.Time `json:"last_accessed_at,omitempty"`
+	// Date the document is due, e.g. a contract's renewal date
+	DueDate *time.Time `json:"due_date,omitempty"`
+	// When the owners were reminded of the due date, cleared when it changes
+	RemindedAt *time.Time `json:"reminded_at,omitempty"`
+	// User the document awaits action from
+	AssigneeID *uint32 `json:"assignee_id,omitempty"`
+	// User who assigned the document
+	AssignedBy *uint32 `json:"assigned_by,omitempty"`
+	// When the document was assigned
+	AssignedAt *time.Time `json:"assigned_at,omitempty"`
+	// Storage key of the cached PDF rendition of a file that is not a PDF
+	RenditionKey string `json:"rendition_key,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the DocumentQuery when eager-loading is set.
 	Edges        DocumentEdges `json:"edges"`
@@ -72,9 +91,17 @@ type DocumentEdges struct {
 	Category *Category `json:"category,omitempty"`
 	// Permissions on this document
 	Permissions []*DocumentPermission `json:"permissions,omitempty"`
+	// E-signature requests of this document
+	SignatureRequests []*SignatureRequest `json:"signature_requests,omitempty"`
+	// Review tasks of this document
+	ReviewTasks []*ReviewTask `json:"review_tasks,omitempty"`
+	// Tag references of this document
+	DocumentTags []*DocumentTag `json:"document_tags,omitempty"`
+	// Text and metadata extracted from the file
+	Content *DocumentContent `json:"content,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [6]bool
 }
 
 // CategoryOrErr returns the Category value or an error if the edge
@@ -97,18 +124,56 @@ func (e DocumentEdges) PermissionsOrErr() ([]*DocumentPermission, error) {
 	return nil, &NotLoadedError{edge: "permissions"}
 }
 
+// SignatureRequestsOrErr returns the SignatureRequests value or an error if the edge
+// was not loaded in eager-loading.
+func (e DocumentEdges) SignatureRequestsOrErr() ([]*SignatureRequest, error) {
+	if e.loadedTypes[2] {
+		return e.SignatureRequests, nil
+	}
+	return nil, &NotLoadedError{edge: "signature_requests"}
+}
+
+// ReviewTasksOrErr returns the ReviewTasks value or an error if the edge
+// was not loaded in eager-loading.
+func (e DocumentEdges) ReviewTasksOrErr() ([]*ReviewTask, error) {
+	if e.loadedTypes[3] {
+		return e.ReviewTasks, nil
+	}
+	return nil, &NotLoadedError{edge: "review_tasks"}
+}
+
+// DocumentTagsOrErr returns the DocumentTags value or an error if the edge
+// was not loaded in eager-loading.
+func (e DocumentEdges) DocumentTagsOrErr() ([]*DocumentTag, error) {
+	if e.loadedTypes[4] {
+		return e.DocumentTags, nil
+	}
+	return nil, &NotLoadedError{edge: "document_tags"}
+}
+
+// ContentOrErr returns the Content value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DocumentEdges) ContentOrErr() (*DocumentContent, error) {
+	if e.Content != nil {
+		return e.Content, nil
+	} else if e.loadedTypes[5] {
+		return nil, &NotFoundError{label: documentcontent.Label}
+	}
+	return nil, &NotLoadedError{edge: "content"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Document) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case document.FieldTags, document.FieldExtractedMetadata:
+		case document.FieldTags:
 			values[i] = new([]byte)
-		case document.FieldCreateBy, document.FieldUpdateBy, document.FieldTenantID, document.FieldFileSize:
+		case document.FieldCreateBy, document.FieldUpdateBy, document.FieldTenantID, document.FieldVersion, document.FieldFileSize, document.FieldAssigneeID, document.FieldAssignedBy:
 			values[i] = new(sql.NullInt64)
-		case document.FieldID, document.FieldCategoryID, document.FieldName, document.FieldDescription, document.FieldFileKey, document.FieldFileName, document.FieldMimeType, document.FieldChecksum, document.FieldStatus, document.FieldSource, document.FieldContentText, document.FieldProcessingStatus:
+		case document.FieldID, document.FieldCategoryID, document.FieldName, document.FieldDescription, document.FieldFileKey, document.FieldFileName, document.FieldMimeType, document.FieldChecksum, document.FieldDocumentType, document.FieldRetentionClass, document.FieldStatus, document.FieldSource, document.FieldProcessingStatus, document.FieldStorageTier, document.FieldRenditionKey:
 			values[i] = new(sql.NullString)
-		case document.FieldCreateTime, document.FieldUpdateTime, document.FieldDeleteTime:
+		case document.FieldCreateTime, document.FieldUpdateTime, document.FieldDeleteTime, document.FieldLastAccessedAt, document.FieldDueDate, document.FieldRemindedAt, document.FieldAssignedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -173,6 +238,12 @@ func (_m *Document) assignValues(columns []string, values []any) error {
 				_m.TenantID = new(uint32)
 				*_m.TenantID = uint32(value.Int64)
 			}
+		case document.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = uint32(value.Int64)
+			}
 		case document.FieldCategoryID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field category_id", values[i])
@@ -230,6 +301,18 @@ func (_m *Document) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field tags: %w", err)
 				}
 			}
+		case document.FieldDocumentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field document_type", values[i])
+			} else if value.Valid {
+				_m.DocumentType = value.String
+			}
+		case document.FieldRetentionClass:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field retention_class", values[i])
+			} else if value.Valid {
+				_m.RetentionClass = value.String
+			}
 		case document.FieldStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
@@ -242,25 +325,65 @@ func (_m *Document) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Source = document.Source(value.String)
 			}
-		case document.FieldContentText:
+		case document.FieldProcessingStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field content_text", values[i])
+				return fmt.Errorf("unexpected type %T for field processing_status", values[i])
 			} else if value.Valid {
-				_m.ContentText = value.String
+				_m.ProcessingStatus = document.ProcessingStatus(value.String)
 			}
-		case document.FieldExtractedMetadata:
-			if value, ok := values[i].(*[]byte); !ok {
-				return fmt.Errorf("unexpected type %T for field extracted_metadata", values[i])
-			} else if value != nil && len(*value) > 0 {
-				if err := json.Unmarshal(*value, &_m.ExtractedMetadata); err != nil {
-					return fmt.Errorf("unmarshal field extracted_metadata: %w", err)
-				}
+		case document.FieldStorageTier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_tier", values[i])
+			} else if value.Valid {
+				_m.StorageTier = document.StorageTier(value.String)
 			}
-		case document.FieldProcessingStatus:
+		case document.FieldLastAccessedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_accessed_at", values[i])
+			} else if value.Valid {
+				_m.LastAccessedAt = new(time.Time)
+				*_m.LastAccessedAt = value.Time
+			}
+		case document.FieldDueDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field due_date", values[i])
+			} else if value.Valid {
+				_m.DueDate = new(time.Time)
+				*_m.DueDate = value.Time
+			}
+		case document.FieldRemindedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reminded_at", values[i])
+			} else if value.Valid {
+				_m.RemindedAt = new(time.Time)
+				*_m.RemindedAt = value.Time
+			}
+		case document.FieldAssigneeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field assignee_id", values[i])
+			} else if value.Valid {
+				_m.AssigneeID = new(uint32)
+				*_m.AssigneeID = uint32(value.Int64)
+			}
+		case document.FieldAssignedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field assigned_by", values[i])
+			} else if value.Valid {
+				_m.AssignedBy = new(uint32)
+				*_m.AssignedBy = uint32(value.Int64)
+			}
+		case document.FieldAssignedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field assigned_at", values[i])
+			} else if value.Valid {
+				_m.AssignedAt = new(time.Time)
+				*_m.AssignedAt = value.Time
+			}
+		case document.FieldRenditionKey:
 			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field processing_status", values[i])
+				return fmt.Errorf("unexpected type %T for field rendition_key", values[i])
 			} else if value.Valid {
-				_m.ProcessingStatus = document.ProcessingStatus(value.String)
+				_m.RenditionKey = value.String
 			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
@@ -285,6 +408,26 @@ func (_m *Document) QueryPermissions() *DocumentPermissionQuery {
 	return NewDocumentClient(_m.config).QueryPermissions(_m)
 }
 
+// QuerySignatureRequests queries the "signature_requests" edge of the Document entity.
+func (_m *Document) QuerySignatureRequests() *SignatureRequestQuery {
+	return NewDocumentClient(_m.config).QuerySignatureRequests(_m)
+}
+
+// QueryReviewTasks queries the "review_tasks" edge of the Document entity.
+func (_m *Document) QueryReviewTasks() *ReviewTaskQuery {
+	return NewDocumentClient(_m.config).QueryReviewTasks(_m)
+}
+
+// QueryDocumentTags queries the "document_tags" edge of the Document entity.
+func (_m *Document) QueryDocumentTags() *DocumentTagQuery {
+	return NewDocumentClient(_m.config).QueryDocumentTags(_m)
+}
+
+// QueryContent queries the "content" edge of the Document entity.
+func (_m *Document) QueryContent() *DocumentContentQuery {
+	return NewDocumentClient(_m.config).QueryContent(_m)
+}
+
 // Update returns a builder for updating this Document.
 // Note that you need to call Document.Unwrap() before calling this method if this Document
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -338,6 +481,9 @@ func (_m *Document) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
 	if v := _m.CategoryID; v != nil {
 		builder.WriteString("category_id=")
 		builder.WriteString(*v)
@@ -367,20 +513,56 @@ func (_m *Document) String() string {
 	builder.WriteString("tags=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Tags))
 	builder.WriteString(", ")
+	builder.WriteString("document_type=")
+	builder.WriteString(_m.DocumentType)
+	builder.WriteString(", ")
+	builder.WriteString("retention_class=")
+	builder.WriteString(_m.RetentionClass)
+	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
 	builder.WriteString("source=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Source))
 	builder.WriteString(", ")
-	builder.WriteString("content_text=")
-	builder.WriteString(_m.ContentText)
-	builder.WriteString(", ")
-	builder.WriteString("extracted_metadata=")
-	builder.WriteString(fmt.Sprintf("%v", _m.ExtractedMetadata))
-	builder.WriteString(", ")
 	builder.WriteString("processing_status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ProcessingStatus))
+	builder.WriteString(", ")
+	builder.WriteString("storage_tier=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StorageTier))
+	builder.WriteString(", ")
+	if v := _m.LastAccessedAt; v != nil {
+		builder.WriteString("last_accessed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DueDate; v != nil {
+		builder.WriteString("due_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.RemindedAt; v != nil {
+		builder.WriteString("reminded_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.AssigneeID; v != nil {
+		builder.WriteString("assignee_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.AssignedBy; v != nil {
+		builder.WriteString("assigned_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.AssignedAt; v != nil {
+		builder.WriteString("assigned_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("rendition_key=")
+	builder.WriteString(_m.RenditionKey)
 	builder.WriteByte(')')
 	return builder.String()
 }
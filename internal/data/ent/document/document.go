@@ -27,6 +27,8 @@ const (
 	FieldDeleteTime = "delete_time"
 	// FieldTenantID holds the string denoting the tenant_id field in the database.
 	FieldTenantID = "tenant_id"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
 	// FieldCategoryID holds the string denoting the category_id field in the database.
 	FieldCategoryID = "category_id"
 	// FieldName holds the string denoting the name field in the database.
@@ -45,20 +47,44 @@ const (
 	FieldChecksum = "checksum"
 	// FieldTags holds the string denoting the tags field in the database.
 	FieldTags = "tags"
+	// FieldDocumentType holds the string denoting the document_type field in the database.
+	FieldDocumentType = "document_type"
+	// FieldRetentionClass holds the string denoting the retention_class field in the database.
+	FieldRetentionClass = "retention_class"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
 	// FieldSource holds the string denoting the source field in the database.
 	FieldSource = "source"
-	// FieldContentText holds the string denoting the content_text field in the database.
-	FieldContentText = "content_text"
-	// FieldExtractedMetadata holds the string denoting the extracted_metadata field in the database.
-	FieldExtractedMetadata = "extracted_metadata"
 	// FieldProcessingStatus holds the string denoting the processing_status field in the database.
 	FieldProcessingStatus = "processing_status"
+	// FieldStorageTier holds the string denoting the storage_tier field in the database.
+	FieldStorageTier = "storage_tier"
+	// FieldLastAccessedAt holds the string denoting the last_accessed_at field in the database.
+	FieldLastAccessedAt = "last_accessed_at"
+	// FieldDueDate holds the string denoting the due_date field in the database.
+	FieldDueDate = "due_date"
+	// FieldRemindedAt holds the string denoting the reminded_at field in the database.
+	FieldRemindedAt = "reminded_at"
+	// FieldAssigneeID holds the string denoting the assignee_id field in the database.
+	FieldAssigneeID = "assignee_id"
+	// FieldAssignedBy holds the string denoting the assigned_by field in the database.
+	FieldAssignedBy = "assigned_by"
+	// FieldAssignedAt holds the string denoting the assigned_at field in the database.
+	FieldAssignedAt = "assigned_at"
+	// FieldRenditionKey holds the string denoting the rendition_key field in the database.
+	FieldRenditionKey = "rendition_key"
 	// EdgeCategory holds the string denoting the category edge name in mutations.
 	EdgeCategory = "category"
 	// EdgePermissions holds the string denoting the permissions edge name in mutations.
 	EdgePermissions = "permissions"
+	// EdgeSignatureRequests holds the string denoting the signature_requests edge name in mutations.
+	EdgeSignatureRequests = "signature_requests"
+	// EdgeReviewTasks holds the string denoting the review_tasks edge name in mutations.
+	EdgeReviewTasks = "review_tasks"
+	// EdgeDocumentTags holds the string denoting the document_tags edge name in mutations.
+	EdgeDocumentTags = "document_tags"
+	// EdgeContent holds the string denoting the content edge name in mutations.
+	EdgeContent = "content"
 	// Table holds the table name of the document in the database.
 	Table = "paperless_documents"
 	// CategoryTable is the table that holds the category relation/edge.
@@ -75,6 +101,34 @@ const (
 	PermissionsInverseTable = "paperless_permissions"
 	// PermissionsColumn is the table column denoting the permissions relation/edge.
 	PermissionsColumn = "document_permissions"
+	// SignatureRequestsTable is the table that holds the signature_requests relation/edge.
+	SignatureRequestsTable = "paperless_signature_requests"
+	// SignatureRequestsInverseTable is the table name for the SignatureRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "signaturerequest" package.
+	SignatureRequestsInverseTable = "paperless_signature_requests"
+	// SignatureRequestsColumn is the table column denoting the signature_requests relation/edge.
+	SignatureRequestsColumn = "document_id"
+	// ReviewTasksTable is the table that holds the review_tasks relation/edge.
+	ReviewTasksTable = "paperless_review_tasks"
+	// ReviewTasksInverseTable is the table name for the ReviewTask entity.
+	// It exists in this package in order to avoid circular dependency with the "reviewtask" package.
+	ReviewTasksInverseTable = "paperless_review_tasks"
+	// ReviewTasksColumn is the table column denoting the review_tasks relation/edge.
+	ReviewTasksColumn = "document_id"
+	// DocumentTagsTable is the table that holds the document_tags relation/edge.
+	DocumentTagsTable = "paperless_document_tags"
+	// DocumentTagsInverseTable is the table name for the DocumentTag entity.
+	// It exists in this package in order to avoid circular dependency with the "documenttag" package.
+	DocumentTagsInverseTable = "paperless_document_tags"
+	// DocumentTagsColumn is the table column denoting the document_tags relation/edge.
+	DocumentTagsColumn = "document_id"
+	// ContentTable is the table that holds the content relation/edge.
+	ContentTable = "paperless_document_contents"
+	// ContentInverseTable is the table name for the DocumentContent entity.
+	// It exists in this package in order to avoid circular dependency with the "documentcontent" package.
+	ContentInverseTable = "paperless_document_contents"
+	// ContentColumn is the table column denoting the content relation/edge.
+	ContentColumn = "document_id"
 )
 
 // Columns holds all SQL columns for document fields.
@@ -86,6 +140,7 @@ var Columns = []string{
 	FieldUpdateTime,
 	FieldDeleteTime,
 	FieldTenantID,
+	FieldVersion,
 	FieldCategoryID,
 	FieldName,
 	FieldDescription,
@@ -95,11 +150,19 @@ var Columns = []string{
 	FieldMimeType,
 	FieldChecksum,
 	FieldTags,
+	FieldDocumentType,
+	FieldRetentionClass,
 	FieldStatus,
 	FieldSource,
-	FieldContentText,
-	FieldExtractedMetadata,
 	FieldProcessingStatus,
+	FieldStorageTier,
+	FieldLastAccessedAt,
+	FieldDueDate,
+	FieldRemindedAt,
+	FieldAssigneeID,
+	FieldAssignedBy,
+	FieldAssignedAt,
+	FieldRenditionKey,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -118,10 +181,13 @@ func ValidColumn(column string) bool {
 //
 //	import _ "github.com/go-tangra/go-tangra-paperless/internal/data/ent/runtime"
 var (
-	Hooks  [1]ent.Hook
-	Policy ent.Policy
+	Hooks        [2]ent.Hook
+	Interceptors [1]ent.Interceptor
+	Policy       ent.Policy
 	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
 	DefaultTenantID uint32
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion uint32
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
 	// DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
@@ -136,6 +202,10 @@ var (
 	MimeTypeValidator func(string) error
 	// ChecksumValidator is a validator for the "checksum" field. It is called by the builders before save.
 	ChecksumValidator func(string) error
+	// DocumentTypeValidator is a validator for the "document_type" field. It is called by the builders before save.
+	DocumentTypeValidator func(string) error
+	// RetentionClassValidator is a validator for the "retention_class" field. It is called by the builders before save.
+	RetentionClassValidator func(string) error
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(string) error
 )
@@ -179,6 +249,7 @@ const (
 	SourceDOCUMENT_SOURCE_UNSPECIFIED Source = "DOCUMENT_SOURCE_UNSPECIFIED"
 	SourceDOCUMENT_SOURCE_UPLOAD      Source = "DOCUMENT_SOURCE_UPLOAD"
 	SourceDOCUMENT_SOURCE_EMAIL       Source = "DOCUMENT_SOURCE_EMAIL"
+	SourceDOCUMENT_SOURCE_IMPORT      Source = "DOCUMENT_SOURCE_IMPORT"
 )
 
 func (s Source) String() string {
@@ -188,7 +259,7 @@ func (s Source) String() string {
 // SourceValidator is a validator for the "source" field enum values. It is called by the builders before save.
 func SourceValidator(s Source) error {
 	switch s {
-	case SourceDOCUMENT_SOURCE_UNSPECIFIED, SourceDOCUMENT_SOURCE_UPLOAD, SourceDOCUMENT_SOURCE_EMAIL:
+	case SourceDOCUMENT_SOURCE_UNSPECIFIED, SourceDOCUMENT_SOURCE_UPLOAD, SourceDOCUMENT_SOURCE_EMAIL, SourceDOCUMENT_SOURCE_IMPORT:
 		return nil
 	default:
 		return fmt.Errorf("document: invalid enum value for source field: %q", s)
@@ -208,6 +279,7 @@ const (
 	ProcessingStatusPROCESSING_STATUS_COMPLETED  ProcessingStatus = "PROCESSING_STATUS_COMPLETED"
 	ProcessingStatusPROCESSING_STATUS_FAILED     ProcessingStatus = "PROCESSING_STATUS_FAILED"
 	ProcessingStatusPROCESSING_STATUS_SKIPPED    ProcessingStatus = "PROCESSING_STATUS_SKIPPED"
+	ProcessingStatusPROCESSING_STATUS_INFECTED   ProcessingStatus = "PROCESSING_STATUS_INFECTED"
 )
 
 func (ps ProcessingStatus) String() string {
@@ -217,13 +289,40 @@ func (ps ProcessingStatus) String() string {
 // ProcessingStatusValidator is a validator for the "processing_status" field enum values. It is called by the builders before save.
 func ProcessingStatusValidator(ps ProcessingStatus) error {
 	switch ps {
-	case ProcessingStatusPROCESSING_STATUS_PENDING, ProcessingStatusPROCESSING_STATUS_PROCESSING, ProcessingStatusPROCESSING_STATUS_COMPLETED, ProcessingStatusPROCESSING_STATUS_FAILED, ProcessingStatusPROCESSING_STATUS_SKIPPED:
+	case ProcessingStatusPROCESSING_STATUS_PENDING, ProcessingStatusPROCESSING_STATUS_PROCESSING, ProcessingStatusPROCESSING_STATUS_COMPLETED, ProcessingStatusPROCESSING_STATUS_FAILED, ProcessingStatusPROCESSING_STATUS_SKIPPED, ProcessingStatusPROCESSING_STATUS_INFECTED:
 		return nil
 	default:
 		return fmt.Errorf("document: invalid enum value for processing_status field: %q", ps)
 	}
 }
 
+// StorageTier defines the type for the "storage_tier" enum field.
+type StorageTier string
+
+// StorageTierSTORAGE_TIER_HOT is the default value of the StorageTier enum.
+const DefaultStorageTier = StorageTierSTORAGE_TIER_HOT
+
+// StorageTier values.
+const (
+	StorageTierSTORAGE_TIER_UNSPECIFIED StorageTier = "STORAGE_TIER_UNSPECIFIED"
+	StorageTierSTORAGE_TIER_HOT         StorageTier = "STORAGE_TIER_HOT"
+	StorageTierSTORAGE_TIER_COLD        StorageTier = "STORAGE_TIER_COLD"
+)
+
+func (st StorageTier) String() string {
+	return string(st)
+}
+
+// StorageTierValidator is a validator for the "storage_tier" field enum values. It is called by the builders before save.
+func StorageTierValidator(st StorageTier) error {
+	switch st {
+	case StorageTierSTORAGE_TIER_UNSPECIFIED, StorageTierSTORAGE_TIER_HOT, StorageTierSTORAGE_TIER_COLD:
+		return nil
+	default:
+		return fmt.Errorf("document: invalid enum value for storage_tier field: %q", st)
+	}
+}
+
 // OrderOption defines the ordering options for the Document queries.
 type OrderOption func(*sql.Selector)
 
@@ -262,6 +361,11 @@ func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
 }
 
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
 // ByCategoryID orders the results by the category_id field.
 func ByCategoryID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCategoryID, opts...).ToFunc()
@@ -302,6 +406,16 @@ func ByChecksum(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldChecksum, opts...).ToFunc()
 }
 
+// ByDocumentType orders the results by the document_type field.
+func ByDocumentType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDocumentType, opts...).ToFunc()
+}
+
+// ByRetentionClass orders the results by the retention_class field.
+func ByRetentionClass(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetentionClass, opts...).ToFunc()
+}
+
 // ByStatus orders the results by the status field.
 func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
@@ -312,16 +426,51 @@ func BySource(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSource, opts...).ToFunc()
 }
 
-// ByContentText orders the results by the content_text field.
-func ByContentText(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldContentText, opts...).ToFunc()
-}
-
 // ByProcessingStatus orders the results by the processing_status field.
 func ByProcessingStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldProcessingStatus, opts...).ToFunc()
 }
 
+// ByStorageTier orders the results by the storage_tier field.
+func ByStorageTier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStorageTier, opts...).ToFunc()
+}
+
+// ByLastAccessedAt orders the results by the last_accessed_at field.
+func ByLastAccessedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastAccessedAt, opts...).ToFunc()
+}
+
+// ByDueDate orders the results by the due_date field.
+func ByDueDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDueDate, opts...).ToFunc()
+}
+
+// ByRemindedAt orders the results by the reminded_at field.
+func ByRemindedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemindedAt, opts...).ToFunc()
+}
+
+// ByAssigneeID orders the results by the assignee_id field.
+func ByAssigneeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssigneeID, opts...).ToFunc()
+}
+
+// ByAssignedBy orders the results by the assigned_by field.
+func ByAssignedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssignedBy, opts...).ToFunc()
+}
+
+// ByAssignedAt orders the results by the assigned_at field.
+func ByAssignedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssignedAt, opts...).ToFunc()
+}
+
+// ByRenditionKey orders the results by the rendition_key field.
+func ByRenditionKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRenditionKey, opts...).ToFunc()
+}
+
 // ByCategoryField orders the results by category field.
 func ByCategoryField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -342,6 +491,55 @@ func ByPermissions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newPermissionsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// BySignatureRequestsCount orders the results by signature_requests count.
+func BySignatureRequestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSignatureRequestsStep(), opts...)
+	}
+}
+
+// BySignatureRequests orders the results by signature_requests terms.
+func BySignatureRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSignatureRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReviewTasksCount orders the results by review_tasks count.
+func ByReviewTasksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReviewTasksStep(), opts...)
+	}
+}
+
+// ByReviewTasks orders the results by review_tasks terms.
+func ByReviewTasks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReviewTasksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDocumentTagsCount orders the results by document_tags count.
+func ByDocumentTagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDocumentTagsStep(), opts...)
+	}
+}
+
+// ByDocumentTags orders the results by document_tags terms.
+func ByDocumentTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDocumentTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByContentField orders the results by content field.
+func ByContentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newContentStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newCategoryStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -356,3 +554,31 @@ func newPermissionsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, PermissionsTable, PermissionsColumn),
 	)
 }
+func newSignatureRequestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SignatureRequestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SignatureRequestsTable, SignatureRequestsColumn),
+	)
+}
+func newReviewTasksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReviewTasksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReviewTasksTable, ReviewTasksColumn),
+	)
+}
+func newDocumentTagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DocumentTagsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DocumentTagsTable, DocumentTagsColumn),
+	)
+}
+func newContentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ContentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, ContentTable, ContentColumn),
+	)
+}
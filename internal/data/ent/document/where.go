@@ -95,6 +95,11 @@ func TenantID(v uint32) predicate.Document {
 	return predicate.Document(sql.FieldEQ(FieldTenantID, v))
 }
 
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v uint32) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldVersion, v))
+}
+
 // CategoryID applies equality check predicate on the "category_id" field. It's identical to CategoryIDEQ.
 func CategoryID(v string) predicate.Document {
 	return predicate.Document(sql.FieldEQ(FieldCategoryID, v))
@@ -135,9 +140,49 @@ func Checksum(v string) predicate.Document {
 	return predicate.Document(sql.FieldEQ(FieldChecksum, v))
 }
 
-// ContentText applies equality check predicate on the "content_text" field. It's identical to ContentTextEQ.
-func ContentText(v string) predicate.Document {
-	return predicate.Document(sql.FieldEQ(FieldContentText, v))
+// DocumentType applies equality check predicate on the "document_type" field. It's identical to DocumentTypeEQ.
+func DocumentType(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldDocumentType, v))
+}
+
+// RetentionClass applies equality check predicate on the "retention_class" field. It's identical to RetentionClassEQ.
+func RetentionClass(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldRetentionClass, v))
+}
+
+// LastAccessedAt applies equality check predicate on the "last_accessed_at" field. It's identical to LastAccessedAtEQ.
+func LastAccessedAt(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldLastAccessedAt, v))
+}
+
+// DueDate applies equality check predicate on the "due_date" field. It's identical to DueDateEQ.
+func DueDate(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldDueDate, v))
+}
+
+// RemindedAt applies equality check predicate on the "reminded_at" field. It's identical to RemindedAtEQ.
+func RemindedAt(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldRemindedAt, v))
+}
+
+// AssigneeID applies equality check predicate on the "assignee_id" field. It's identical to AssigneeIDEQ.
+func AssigneeID(v uint32) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldAssigneeID, v))
+}
+
+// AssignedBy applies equality check predicate on the "assigned_by" field. It's identical to AssignedByEQ.
+func AssignedBy(v uint32) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldAssignedBy, v))
+}
+
+// AssignedAt applies equality check predicate on the "assigned_at" field. It's identical to AssignedAtEQ.
+func AssignedAt(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldAssignedAt, v))
+}
+
+// RenditionKey applies equality check predicate on the "rendition_key" field. It's identical to RenditionKeyEQ.
+func RenditionKey(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldRenditionKey, v))
 }
 
 // CreateByEQ applies the EQ predicate on the "create_by" field.
@@ -440,6 +485,46 @@ func TenantIDNotNil() predicate.Document {
 	return predicate.Document(sql.FieldNotNull(FieldTenantID))
 }
 
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v uint32) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v uint32) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...uint32) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...uint32) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v uint32) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v uint32) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v uint32) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v uint32) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldVersion, v))
+}
+
 // CategoryIDEQ applies the EQ predicate on the "category_id" field.
 func CategoryIDEQ(v string) predicate.Document {
 	return predicate.Document(sql.FieldEQ(FieldCategoryID, v))
@@ -985,6 +1070,156 @@ func TagsNotNil() predicate.Document {
 	return predicate.Document(sql.FieldNotNull(FieldTags))
 }
 
+// DocumentTypeEQ applies the EQ predicate on the "document_type" field.
+func DocumentTypeEQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldDocumentType, v))
+}
+
+// DocumentTypeNEQ applies the NEQ predicate on the "document_type" field.
+func DocumentTypeNEQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldDocumentType, v))
+}
+
+// DocumentTypeIn applies the In predicate on the "document_type" field.
+func DocumentTypeIn(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldDocumentType, vs...))
+}
+
+// DocumentTypeNotIn applies the NotIn predicate on the "document_type" field.
+func DocumentTypeNotIn(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldDocumentType, vs...))
+}
+
+// DocumentTypeGT applies the GT predicate on the "document_type" field.
+func DocumentTypeGT(v string) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldDocumentType, v))
+}
+
+// DocumentTypeGTE applies the GTE predicate on the "document_type" field.
+func DocumentTypeGTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldDocumentType, v))
+}
+
+// DocumentTypeLT applies the LT predicate on the "document_type" field.
+func DocumentTypeLT(v string) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldDocumentType, v))
+}
+
+// DocumentTypeLTE applies the LTE predicate on the "document_type" field.
+func DocumentTypeLTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldDocumentType, v))
+}
+
+// DocumentTypeContains applies the Contains predicate on the "document_type" field.
+func DocumentTypeContains(v string) predicate.Document {
+	return predicate.Document(sql.FieldContains(FieldDocumentType, v))
+}
+
+// DocumentTypeHasPrefix applies the HasPrefix predicate on the "document_type" field.
+func DocumentTypeHasPrefix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasPrefix(FieldDocumentType, v))
+}
+
+// DocumentTypeHasSuffix applies the HasSuffix predicate on the "document_type" field.
+func DocumentTypeHasSuffix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasSuffix(FieldDocumentType, v))
+}
+
+// DocumentTypeIsNil applies the IsNil predicate on the "document_type" field.
+func DocumentTypeIsNil() predicate.Document {
+	return predicate.Document(sql.FieldIsNull(FieldDocumentType))
+}
+
+// DocumentTypeNotNil applies the NotNil predicate on the "document_type" field.
+func DocumentTypeNotNil() predicate.Document {
+	return predicate.Document(sql.FieldNotNull(FieldDocumentType))
+}
+
+// DocumentTypeEqualFold applies the EqualFold predicate on the "document_type" field.
+func DocumentTypeEqualFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldEqualFold(FieldDocumentType, v))
+}
+
+// DocumentTypeContainsFold applies the ContainsFold predicate on the "document_type" field.
+func DocumentTypeContainsFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldContainsFold(FieldDocumentType, v))
+}
+
+// RetentionClassEQ applies the EQ predicate on the "retention_class" field.
+func RetentionClassEQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldRetentionClass, v))
+}
+
+// RetentionClassNEQ applies the NEQ predicate on the "retention_class" field.
+func RetentionClassNEQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldRetentionClass, v))
+}
+
+// RetentionClassIn applies the In predicate on the "retention_class" field.
+func RetentionClassIn(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldRetentionClass, vs...))
+}
+
+// RetentionClassNotIn applies the NotIn predicate on the "retention_class" field.
+func RetentionClassNotIn(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldRetentionClass, vs...))
+}
+
+// RetentionClassGT applies the GT predicate on the "retention_class" field.
+func RetentionClassGT(v string) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldRetentionClass, v))
+}
+
+// RetentionClassGTE applies the GTE predicate on the "retention_class" field.
+func RetentionClassGTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldRetentionClass, v))
+}
+
+// RetentionClassLT applies the LT predicate on the "retention_class" field.
+func RetentionClassLT(v string) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldRetentionClass, v))
+}
+
+// RetentionClassLTE applies the LTE predicate on the "retention_class" field.
+func RetentionClassLTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldRetentionClass, v))
+}
+
+// RetentionClassContains applies the Contains predicate on the "retention_class" field.
+func RetentionClassContains(v string) predicate.Document {
+	return predicate.Document(sql.FieldContains(FieldRetentionClass, v))
+}
+
+// RetentionClassHasPrefix applies the HasPrefix predicate on the "retention_class" field.
+func RetentionClassHasPrefix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasPrefix(FieldRetentionClass, v))
+}
+
+// RetentionClassHasSuffix applies the HasSuffix predicate on the "retention_class" field.
+func RetentionClassHasSuffix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasSuffix(FieldRetentionClass, v))
+}
+
+// RetentionClassIsNil applies the IsNil predicate on the "retention_class" field.
+func RetentionClassIsNil() predicate.Document {
+	return predicate.Document(sql.FieldIsNull(FieldRetentionClass))
+}
+
+// RetentionClassNotNil applies the NotNil predicate on the "retention_class" field.
+func RetentionClassNotNil() predicate.Document {
+	return predicate.Document(sql.FieldNotNull(FieldRetentionClass))
+}
+
+// RetentionClassEqualFold applies the EqualFold predicate on the "retention_class" field.
+func RetentionClassEqualFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldEqualFold(FieldRetentionClass, v))
+}
+
+// RetentionClassContainsFold applies the ContainsFold predicate on the "retention_class" field.
+func RetentionClassContainsFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldContainsFold(FieldRetentionClass, v))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v Status) predicate.Document {
 	return predicate.Document(sql.FieldEQ(FieldStatus, v))
@@ -1025,109 +1260,419 @@ func SourceNotIn(vs ...Source) predicate.Document {
 	return predicate.Document(sql.FieldNotIn(FieldSource, vs...))
 }
 
-// ContentTextEQ applies the EQ predicate on the "content_text" field.
-func ContentTextEQ(v string) predicate.Document {
-	return predicate.Document(sql.FieldEQ(FieldContentText, v))
+// ProcessingStatusEQ applies the EQ predicate on the "processing_status" field.
+func ProcessingStatusEQ(v ProcessingStatus) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldProcessingStatus, v))
 }
 
-// ContentTextNEQ applies the NEQ predicate on the "content_text" field.
-func ContentTextNEQ(v string) predicate.Document {
-	return predicate.Document(sql.FieldNEQ(FieldContentText, v))
+// ProcessingStatusNEQ applies the NEQ predicate on the "processing_status" field.
+func ProcessingStatusNEQ(v ProcessingStatus) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldProcessingStatus, v))
 }
 
-// ContentTextIn applies the In predicate on the "content_text" field.
-func ContentTextIn(vs ...string) predicate.Document {
-	return predicate.Document(sql.FieldIn(FieldContentText, vs...))
+// ProcessingStatusIn applies the In predicate on the "processing_status" field.
+func ProcessingStatusIn(vs ...ProcessingStatus) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldProcessingStatus, vs...))
 }
 
-// ContentTextNotIn applies the NotIn predicate on the "content_text" field.
-func ContentTextNotIn(vs ...string) predicate.Document {
-	return predicate.Document(sql.FieldNotIn(FieldContentText, vs...))
+// ProcessingStatusNotIn applies the NotIn predicate on the "processing_status" field.
+func ProcessingStatusNotIn(vs ...ProcessingStatus) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldProcessingStatus, vs...))
 }
 
-// ContentTextGT applies the GT predicate on the "content_text" field.
-func ContentTextGT(v string) predicate.Document {
-	return predicate.Document(sql.FieldGT(FieldContentText, v))
+// StorageTierEQ applies the EQ predicate on the "storage_tier" field.
+func StorageTierEQ(v StorageTier) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldStorageTier, v))
 }
 
-// ContentTextGTE applies the GTE predicate on the "content_text" field.
-func ContentTextGTE(v string) predicate.Document {
-	return predicate.Document(sql.FieldGTE(FieldContentText, v))
+// StorageTierNEQ applies the NEQ predicate on the "storage_tier" field.
+func StorageTierNEQ(v StorageTier) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldStorageTier, v))
 }
 
-// ContentTextLT applies the LT predicate on the "content_text" field.
-func ContentTextLT(v string) predicate.Document {
-	return predicate.Document(sql.FieldLT(FieldContentText, v))
+// StorageTierIn applies the In predicate on the "storage_tier" field.
+func StorageTierIn(vs ...StorageTier) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldStorageTier, vs...))
 }
 
-// ContentTextLTE applies the LTE predicate on the "content_text" field.
-func ContentTextLTE(v string) predicate.Document {
-	return predicate.Document(sql.FieldLTE(FieldContentText, v))
+// StorageTierNotIn applies the NotIn predicate on the "storage_tier" field.
+func StorageTierNotIn(vs ...StorageTier) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldStorageTier, vs...))
 }
 
-// ContentTextContains applies the Contains predicate on the "content_text" field.
-func ContentTextContains(v string) predicate.Document {
-	return predicate.Document(sql.FieldContains(FieldContentText, v))
+// LastAccessedAtEQ applies the EQ predicate on the "last_accessed_at" field.
+func LastAccessedAtEQ(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldLastAccessedAt, v))
 }
 
-// ContentTextHasPrefix applies the HasPrefix predicate on the "content_text" field.
-func ContentTextHasPrefix(v string) predicate.Document {
-	return predicate.Document(sql.FieldHasPrefix(FieldContentText, v))
+// LastAccessedAtNEQ applies the NEQ predicate on the "last_accessed_at" field.
+func LastAccessedAtNEQ(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldLastAccessedAt, v))
 }
 
-// ContentTextHasSuffix applies the HasSuffix predicate on the "content_text" field.
-func ContentTextHasSuffix(v string) predicate.Document {
-	return predicate.Document(sql.FieldHasSuffix(FieldContentText, v))
+// LastAccessedAtIn applies the In predicate on the "last_accessed_at" field.
+func LastAccessedAtIn(vs ...time.Time) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldLastAccessedAt, vs...))
 }
 
-// ContentTextIsNil applies the IsNil predicate on the "content_text" field.
-func ContentTextIsNil() predicate.Document {
-	return predicate.Document(sql.FieldIsNull(FieldContentText))
+// LastAccessedAtNotIn applies the NotIn predicate on the "last_accessed_at" field.
+func LastAccessedAtNotIn(vs ...time.Time) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldLastAccessedAt, vs...))
 }
 
-// ContentTextNotNil applies the NotNil predicate on the "content_text" field.
-func ContentTextNotNil() predicate.Document {
-	return predicate.Document(sql.FieldNotNull(FieldContentText))
+// LastAccessedAtGT applies the GT predicate on the "last_accessed_at" field.
+func LastAccessedAtGT(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldLastAccessedAt, v))
 }
 
-// ContentTextEqualFold applies the EqualFold predicate on the "content_text" field.
-func ContentTextEqualFold(v string) predicate.Document {
-	return predicate.Document(sql.FieldEqualFold(FieldContentText, v))
+// LastAccessedAtGTE applies the GTE predicate on the "last_accessed_at" field.
+func LastAccessedAtGTE(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldLastAccessedAt, v))
 }
 
-// ContentTextContainsFold applies the ContainsFold predicate on the "content_text" field.
-func ContentTextContainsFold(v string) predicate.Document {
-	return predicate.Document(sql.FieldContainsFold(FieldContentText, v))
+// LastAccessedAtLT applies the LT predicate on the "last_accessed_at" field.
+func LastAccessedAtLT(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldLastAccessedAt, v))
 }
 
-// ExtractedMetadataIsNil applies the IsNil predicate on the "extracted_metadata" field.
-func ExtractedMetadataIsNil() predicate.Document {
-	return predicate.Document(sql.FieldIsNull(FieldExtractedMetadata))
+// LastAccessedAtLTE applies the LTE predicate on the "last_accessed_at" field.
+func LastAccessedAtLTE(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldLastAccessedAt, v))
 }
 
-// ExtractedMetadataNotNil applies the NotNil predicate on the "extracted_metadata" field.
-func ExtractedMetadataNotNil() predicate.Document {
-	return predicate.Document(sql.FieldNotNull(FieldExtractedMetadata))
+// LastAccessedAtIsNil applies the IsNil predicate on the "last_accessed_at" field.
+func LastAccessedAtIsNil() predicate.Document {
+	return predicate.Document(sql.FieldIsNull(FieldLastAccessedAt))
 }
 
-// ProcessingStatusEQ applies the EQ predicate on the "processing_status" field.
-func ProcessingStatusEQ(v ProcessingStatus) predicate.Document {
-	return predicate.Document(sql.FieldEQ(FieldProcessingStatus, v))
+// LastAccessedAtNotNil applies the NotNil predicate on the "last_accessed_at" field.
+func LastAccessedAtNotNil() predicate.Document {
+	return predicate.Document(sql.FieldNotNull(FieldLastAccessedAt))
 }
 
-// ProcessingStatusNEQ applies the NEQ predicate on the "processing_status" field.
-func ProcessingStatusNEQ(v ProcessingStatus) predicate.Document {
-	return predicate.Document(sql.FieldNEQ(FieldProcessingStatus, v))
+// DueDateEQ applies the EQ predicate on the "due_date" field.
+func DueDateEQ(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldDueDate, v))
 }
 
-// ProcessingStatusIn applies the In predicate on the "processing_status" field.
-func ProcessingStatusIn(vs ...ProcessingStatus) predicate.Document {
-	return predicate.Document(sql.FieldIn(FieldProcessingStatus, vs...))
+// DueDateNEQ applies the NEQ predicate on the "due_date" field.
+func DueDateNEQ(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldDueDate, v))
 }
 
-// ProcessingStatusNotIn applies the NotIn predicate on the "processing_status" field.
-func ProcessingStatusNotIn(vs ...ProcessingStatus) predicate.Document {
-	return predicate.Document(sql.FieldNotIn(FieldProcessingStatus, vs...))
+// DueDateIn applies the In predicate on the "due_date" field.
+func DueDateIn(vs ...time.Time) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldDueDate, vs...))
+}
+
+// DueDateNotIn applies the NotIn predicate on the "due_date" field.
+func DueDateNotIn(vs ...time.Time) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldDueDate, vs...))
+}
+
+// DueDateGT applies the GT predicate on the "due_date" field.
+func DueDateGT(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldDueDate, v))
+}
+
+// DueDateGTE applies the GTE predicate on the "due_date" field.
+func DueDateGTE(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldDueDate, v))
+}
+
+// DueDateLT applies the LT predicate on the "due_date" field.
+func DueDateLT(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldDueDate, v))
+}
+
+// DueDateLTE applies the LTE predicate on the "due_date" field.
+func DueDateLTE(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldDueDate, v))
+}
+
+// DueDateIsNil applies the IsNil predicate on the "due_date" field.
+func DueDateIsNil() predicate.Document {
+	return predicate.Document(sql.FieldIsNull(FieldDueDate))
+}
+
+// DueDateNotNil applies the NotNil predicate on the "due_date" field.
+func DueDateNotNil() predicate.Document {
+	return predicate.Document(sql.FieldNotNull(FieldDueDate))
+}
+
+// RemindedAtEQ applies the EQ predicate on the "reminded_at" field.
+func RemindedAtEQ(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldRemindedAt, v))
+}
+
+// RemindedAtNEQ applies the NEQ predicate on the "reminded_at" field.
+func RemindedAtNEQ(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldRemindedAt, v))
+}
+
+// RemindedAtIn applies the In predicate on the "reminded_at" field.
+func RemindedAtIn(vs ...time.Time) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldRemindedAt, vs...))
+}
+
+// RemindedAtNotIn applies the NotIn predicate on the "reminded_at" field.
+func RemindedAtNotIn(vs ...time.Time) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldRemindedAt, vs...))
+}
+
+// RemindedAtGT applies the GT predicate on the "reminded_at" field.
+func RemindedAtGT(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldRemindedAt, v))
+}
+
+// RemindedAtGTE applies the GTE predicate on the "reminded_at" field.
+func RemindedAtGTE(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldRemindedAt, v))
+}
+
+// RemindedAtLT applies the LT predicate on the "reminded_at" field.
+func RemindedAtLT(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldRemindedAt, v))
+}
+
+// RemindedAtLTE applies the LTE predicate on the "reminded_at" field.
+func RemindedAtLTE(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldRemindedAt, v))
+}
+
+// RemindedAtIsNil applies the IsNil predicate on the "reminded_at" field.
+func RemindedAtIsNil() predicate.Document {
+	return predicate.Document(sql.FieldIsNull(FieldRemindedAt))
+}
+
+// RemindedAtNotNil applies the NotNil predicate on the "reminded_at" field.
+func RemindedAtNotNil() predicate.Document {
+	return predicate.Document(sql.FieldNotNull(FieldRemindedAt))
+}
+
+// AssigneeIDEQ applies the EQ predicate on the "assignee_id" field.
+func AssigneeIDEQ(v uint32) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldAssigneeID, v))
+}
+
+// AssigneeIDNEQ applies the NEQ predicate on the "assignee_id" field.
+func AssigneeIDNEQ(v uint32) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldAssigneeID, v))
+}
+
+// AssigneeIDIn applies the In predicate on the "assignee_id" field.
+func AssigneeIDIn(vs ...uint32) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldAssigneeID, vs...))
+}
+
+// AssigneeIDNotIn applies the NotIn predicate on the "assignee_id" field.
+func AssigneeIDNotIn(vs ...uint32) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldAssigneeID, vs...))
+}
+
+// AssigneeIDGT applies the GT predicate on the "assignee_id" field.
+func AssigneeIDGT(v uint32) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldAssigneeID, v))
+}
+
+// AssigneeIDGTE applies the GTE predicate on the "assignee_id" field.
+func AssigneeIDGTE(v uint32) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldAssigneeID, v))
+}
+
+// AssigneeIDLT applies the LT predicate on the "assignee_id" field.
+func AssigneeIDLT(v uint32) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldAssigneeID, v))
+}
+
+// AssigneeIDLTE applies the LTE predicate on the "assignee_id" field.
+func AssigneeIDLTE(v uint32) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldAssigneeID, v))
+}
+
+// AssigneeIDIsNil applies the IsNil predicate on the "assignee_id" field.
+func AssigneeIDIsNil() predicate.Document {
+	return predicate.Document(sql.FieldIsNull(FieldAssigneeID))
+}
+
+// AssigneeIDNotNil applies the NotNil predicate on the "assignee_id" field.
+func AssigneeIDNotNil() predicate.Document {
+	return predicate.Document(sql.FieldNotNull(FieldAssigneeID))
+}
+
+// AssignedByEQ applies the EQ predicate on the "assigned_by" field.
+func AssignedByEQ(v uint32) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldAssignedBy, v))
+}
+
+// AssignedByNEQ applies the NEQ predicate on the "assigned_by" field.
+func AssignedByNEQ(v uint32) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldAssignedBy, v))
+}
+
+// AssignedByIn applies the In predicate on the "assigned_by" field.
+func AssignedByIn(vs ...uint32) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldAssignedBy, vs...))
+}
+
+// AssignedByNotIn applies the NotIn predicate on the "assigned_by" field.
+func AssignedByNotIn(vs ...uint32) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldAssignedBy, vs...))
+}
+
+// AssignedByGT applies the GT predicate on the "assigned_by" field.
+func AssignedByGT(v uint32) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldAssignedBy, v))
+}
+
+// AssignedByGTE applies the GTE predicate on the "assigned_by" field.
+func AssignedByGTE(v uint32) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldAssignedBy, v))
+}
+
+// AssignedByLT applies the LT predicate on the "assigned_by" field.
+func AssignedByLT(v uint32) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldAssignedBy, v))
+}
+
+// AssignedByLTE applies the LTE predicate on the "assigned_by" field.
+func AssignedByLTE(v uint32) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldAssignedBy, v))
+}
+
+// AssignedByIsNil applies the IsNil predicate on the "assigned_by" field.
+func AssignedByIsNil() predicate.Document {
+	return predicate.Document(sql.FieldIsNull(FieldAssignedBy))
+}
+
+// AssignedByNotNil applies the NotNil predicate on the "assigned_by" field.
+func AssignedByNotNil() predicate.Document {
+	return predicate.Document(sql.FieldNotNull(FieldAssignedBy))
+}
+
+// AssignedAtEQ applies the EQ predicate on the "assigned_at" field.
+func AssignedAtEQ(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldAssignedAt, v))
+}
+
+// AssignedAtNEQ applies the NEQ predicate on the "assigned_at" field.
+func AssignedAtNEQ(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldAssignedAt, v))
+}
+
+// AssignedAtIn applies the In predicate on the "assigned_at" field.
+func AssignedAtIn(vs ...time.Time) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldAssignedAt, vs...))
+}
+
+// AssignedAtNotIn applies the NotIn predicate on the "assigned_at" field.
+func AssignedAtNotIn(vs ...time.Time) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldAssignedAt, vs...))
+}
+
+// AssignedAtGT applies the GT predicate on the "assigned_at" field.
+func AssignedAtGT(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldAssignedAt, v))
+}
+
+// AssignedAtGTE applies the GTE predicate on the "assigned_at" field.
+func AssignedAtGTE(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldAssignedAt, v))
+}
+
+// AssignedAtLT applies the LT predicate on the "assigned_at" field.
+func AssignedAtLT(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldAssignedAt, v))
+}
+
+// AssignedAtLTE applies the LTE predicate on the "assigned_at" field.
+func AssignedAtLTE(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldAssignedAt, v))
+}
+
+// AssignedAtIsNil applies the IsNil predicate on the "assigned_at" field.
+func AssignedAtIsNil() predicate.Document {
+	return predicate.Document(sql.FieldIsNull(FieldAssignedAt))
+}
+
+// AssignedAtNotNil applies the NotNil predicate on the "assigned_at" field.
+func AssignedAtNotNil() predicate.Document {
+	return predicate.Document(sql.FieldNotNull(FieldAssignedAt))
+}
+
+// RenditionKeyEQ applies the EQ predicate on the "rendition_key" field.
+func RenditionKeyEQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldRenditionKey, v))
+}
+
+// RenditionKeyNEQ applies the NEQ predicate on the "rendition_key" field.
+func RenditionKeyNEQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldRenditionKey, v))
+}
+
+// RenditionKeyIn applies the In predicate on the "rendition_key" field.
+func RenditionKeyIn(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldRenditionKey, vs...))
+}
+
+// RenditionKeyNotIn applies the NotIn predicate on the "rendition_key" field.
+func RenditionKeyNotIn(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldRenditionKey, vs...))
+}
+
+// RenditionKeyGT applies the GT predicate on the "rendition_key" field.
+func RenditionKeyGT(v string) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldRenditionKey, v))
+}
+
+// RenditionKeyGTE applies the GTE predicate on the "rendition_key" field.
+func RenditionKeyGTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldRenditionKey, v))
+}
+
+// RenditionKeyLT applies the LT predicate on the "rendition_key" field.
+func RenditionKeyLT(v string) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldRenditionKey, v))
+}
+
+// RenditionKeyLTE applies the LTE predicate on the "rendition_key" field.
+func RenditionKeyLTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldRenditionKey, v))
+}
+
+// RenditionKeyContains applies the Contains predicate on the "rendition_key" field.
+func RenditionKeyContains(v string) predicate.Document {
+	return predicate.Document(sql.FieldContains(FieldRenditionKey, v))
+}
+
+// RenditionKeyHasPrefix applies the HasPrefix predicate on the "rendition_key" field.
+func RenditionKeyHasPrefix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasPrefix(FieldRenditionKey, v))
+}
+
+// RenditionKeyHasSuffix applies the HasSuffix predicate on the "rendition_key" field.
+func RenditionKeyHasSuffix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasSuffix(FieldRenditionKey, v))
+}
+
+// RenditionKeyIsNil applies the IsNil predicate on the "rendition_key" field.
+func RenditionKeyIsNil() predicate.Document {
+	return predicate.Document(sql.FieldIsNull(FieldRenditionKey))
+}
+
+// RenditionKeyNotNil applies the NotNil predicate on the "rendition_key" field.
+func RenditionKeyNotNil() predicate.Document {
+	return predicate.Document(sql.FieldNotNull(FieldRenditionKey))
+}
+
+// RenditionKeyEqualFold applies the EqualFold predicate on the "rendition_key" field.
+func RenditionKeyEqualFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldEqualFold(FieldRenditionKey, v))
+}
+
+// RenditionKeyContainsFold applies the ContainsFold predicate on the "rendition_key" field.
+func RenditionKeyContainsFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldContainsFold(FieldRenditionKey, v))
 }
 
 // HasCategory applies the HasEdge predicate on the "category" edge.
@@ -1176,6 +1721,98 @@ func HasPermissionsWith(preds ...predicate.DocumentPermission) predicate.Documen
 	})
 }
 
+// HasSignatureRequests applies the HasEdge predicate on the "signature_requests" edge.
+func HasSignatureRequests() predicate.Document {
+	return predicate.Document(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SignatureRequestsTable, SignatureRequestsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSignatureRequestsWith applies the HasEdge predicate on the "signature_requests" edge with a given conditions (other predicates).
+func HasSignatureRequestsWith(preds ...predicate.SignatureRequest) predicate.Document {
+	return predicate.Document(func(s *sql.Selector) {
+		step := newSignatureRequestsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReviewTasks applies the HasEdge predicate on the "review_tasks" edge.
+func HasReviewTasks() predicate.Document {
+	return predicate.Document(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReviewTasksTable, ReviewTasksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReviewTasksWith applies the HasEdge predicate on the "review_tasks" edge with a given conditions (other predicates).
+func HasReviewTasksWith(preds ...predicate.ReviewTask) predicate.Document {
+	return predicate.Document(func(s *sql.Selector) {
+		step := newReviewTasksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDocumentTags applies the HasEdge predicate on the "document_tags" edge.
+func HasDocumentTags() predicate.Document {
+	return predicate.Document(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DocumentTagsTable, DocumentTagsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDocumentTagsWith applies the HasEdge predicate on the "document_tags" edge with a given conditions (other predicates).
+func HasDocumentTagsWith(preds ...predicate.DocumentTag) predicate.Document {
+	return predicate.Document(func(s *sql.Selector) {
+		step := newDocumentTagsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasContent applies the HasEdge predicate on the "content" edge.
+func HasContent() predicate.Document {
+	return predicate.Document(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, ContentTable, ContentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasContentWith applies the HasEdge predicate on the "content" edge with a given conditions (other predicates).
+func HasContentWith(preds ...predicate.DocumentContent) predicate.Document {
+	return predicate.Document(func(s *sql.Selector) {
+		step := newContentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Document) predicate.Document {
 	return predicate.Document(sql.AndPredicates(predicates...))
@@ -14,7 +14,11 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/category"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/document"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documentcontent"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documentpermission"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documenttag"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/reviewtask"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/signaturerequest"
 )
 
 // DocumentCreate is the builder for creating a Document entity.
@@ -109,6 +113,20 @@ func (_c *DocumentCreate) SetNillableTenantID(v *uint32) *DocumentCreate {
 	return _c
 }
 
+// SetVersion sets the "version" field.
+func (_c *DocumentCreate) SetVersion(v uint32) *DocumentCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *DocumentCreate) SetNillableVersion(v *uint32) *DocumentCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
 // SetCategoryID sets the "category_id" field.
 func (_c *DocumentCreate) SetCategoryID(v string) *DocumentCreate {
 	_c.mutation.SetCategoryID(v)
@@ -203,6 +221,34 @@ func (_c *DocumentCreate) SetTags(v map[string]string) *DocumentCreate {
 	return _c
 }
 
+// SetDocumentType sets the "document_type" field.
+func (_c *DocumentCreate) SetDocumentType(v string) *DocumentCreate {
+	_c.mutation.SetDocumentType(v)
+	return _c
+}
+
+// SetNillableDocumentType sets the "document_type" field if the given value is not nil.
+func (_c *DocumentCreate) SetNillableDocumentType(v *string) *DocumentCreate {
+	if v != nil {
+		_c.SetDocumentType(*v)
+	}
+	return _c
+}
+
+// SetRetentionClass sets the "retention_class" field.
+func (_c *DocumentCreate) SetRetentionClass(v string) *DocumentCreate {
+	_c.mutation.SetRetentionClass(v)
+	return _c
+}
+
+// SetNillableRetentionClass sets the "retention_class" field if the given value is not nil.
+func (_c *DocumentCreate) SetNillableRetentionClass(v *string) *DocumentCreate {
+	if v != nil {
+		_c.SetRetentionClass(*v)
+	}
+	return _c
+}
+
 // SetStatus sets the "status" field.
 func (_c *DocumentCreate) SetStatus(v document.Status) *DocumentCreate {
 	_c.mutation.SetStatus(v)
@@ -231,36 +277,128 @@ func (_c *DocumentCreate) SetNillableSource(v *document.Source) *DocumentCreate
 	return _c
 }
 
-// SetContentText sets the "content_text" field.
-func (_c *DocumentCreate) SetContentText(v string) *DocumentCreate {
-	_c.mutation.SetContentText(v)
+// SetProcessingStatus sets the "processing_status" field.
+func (_c *DocumentCreate) SetProcessingStatus(v document.ProcessingStatus) *DocumentCreate {
+	_c.mutation.SetProcessingStatus(v)
 	return _c
 }
 
-// SetNillableContentText sets the "content_text" field if the given value is not nil.
-func (_c *DocumentCreate) SetNillableContentText(v *string) *DocumentCreate {
+// SetNillableProcessingStatus sets the "processing_status" field if the given value is not nil.
+func (_c *DocumentCreate) SetNillableProcessingStatus(v *document.ProcessingStatus) *DocumentCreate {
 	if v != nil {
-		_c.SetContentText(*v)
+		_c.SetProcessingStatus(*v)
 	}
 	return _c
 }
 
-// SetExtractedMetadata sets the "extracted_metadata" field.
-func (_c *DocumentCreate) SetExtractedMetadata(v map[string]string) *DocumentCreate {
-	_c.mutation.SetExtractedMetadata(v)
+// SetStorageTier sets the "storage_tier" field.
+func (_c *DocumentCreate) SetStorageTier(v document.StorageTier) *DocumentCreate {
+	_c.mutation.SetStorageTier(v)
 	return _c
 }
 
-// SetProcessingStatus sets the "processing_status" field.
-func (_c *DocumentCreate) SetProcessingStatus(v document.ProcessingStatus) *DocumentCreate {
-	_c.mutation.SetProcessingStatus(v)
+// SetNillableStorageTier sets the "storage_tier" field if the given value is not nil.
+func (_c *DocumentCreate) SetNillableStorageTier(v *document.StorageTier) *DocumentCreate {
+	if v != nil {
+		_c.SetStorageTier(*v)
+	}
 	return _c
 }
 
-// SetNillableProcessingStatus sets the "processing_status" field if the given value is not nil.
-func (_c *DocumentCreate) SetNillableProcessingStatus(v *document.ProcessingStatus) *DocumentCreate {
+// SetLastAccessedAt sets the "last_accessed_at" field.
+func (_c *DocumentCreate) SetLastAccessedAt(v time.Time) *DocumentCreate {
+	_c.mutation.SetLastAccessedAt(v)
+	return _c
+}
+
+// SetNillableLastAccessedAt sets the "last_accessed_at" field if the given value is not nil.
+func (_c *DocumentCreate) SetNillableLastAccessedAt(v *time.Time) *DocumentCreate {
 	if v != nil {
-		_c.SetProcessingStatus(*v)
+		_c.SetLastAccessedAt(*v)
+	}
+	return _c
+}
+
+// SetDueDate sets the "due_date" field.
+func (_c *DocumentCreate) SetDueDate(v time.Time) *DocumentCreate {
+	_c.mutation.SetDueDate(v)
+	return _c
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (_c *DocumentCreate) SetNillableDueDate(v *time.Time) *DocumentCreate {
+	if v != nil {
+		_c.SetDueDate(*v)
+	}
+	return _c
+}
+
+// SetRemindedAt sets the "reminded_at" field.
+func (_c *DocumentCreate) SetRemindedAt(v time.Time) *DocumentCreate {
+	_c.mutation.SetRemindedAt(v)
+	return _c
+}
+
+// SetNillableRemindedAt sets the "reminded_at" field if the given value is not nil.
+func (_c *DocumentCreate) SetNillableRemindedAt(v *time.Time) *DocumentCreate {
+	if v != nil {
+		_c.SetRemindedAt(*v)
+	}
+	return _c
+}
+
+// SetAssigneeID sets the "assignee_id" field.
+func (_c *DocumentCreate) SetAssigneeID(v uint32) *DocumentCreate {
+	_c.mutation.SetAssigneeID(v)
+	return _c
+}
+
+// SetNillableAssigneeID sets the "assignee_id" field if the given value is not nil.
+func (_c *DocumentCreate) SetNillableAssigneeID(v *uint32) *DocumentCreate {
+	if v != nil {
+		_c.SetAssigneeID(*v)
+	}
+	return _c
+}
+
+// SetAssignedBy sets the "assigned_by" field.
+func (_c *DocumentCreate) SetAssignedBy(v uint32) *DocumentCreate {
+	_c.mutation.SetAssignedBy(v)
+	return _c
+}
+
+// SetNillableAssignedBy sets the "assigned_by" field if the given value is not nil.
+func (_c *DocumentCreate) SetNillableAssignedBy(v *uint32) *DocumentCreate {
+	if v != nil {
+		_c.SetAssignedBy(*v)
+	}
+	return _c
+}
+
+// SetAssignedAt sets the "assigned_at" field.
+func (_c *DocumentCreate) SetAssignedAt(v time.Time) *DocumentCreate {
+	_c.mutation.SetAssignedAt(v)
+	return _c
+}
+
+// SetNillableAssignedAt sets the "assigned_at" field if the given value is not nil.
+func (_c *DocumentCreate) SetNillableAssignedAt(v *time.Time) *DocumentCreate {
+	if v != nil {
+		_c.SetAssignedAt(*v)
+	}
+	return _c
+}
+
+// SetRenditionKey sets the "rendition_key" field.
+func (_c *DocumentCreate) SetRenditionKey(v string) *DocumentCreate {
+	_c.mutation.SetRenditionKey(v)
+	return _c
+}
+
+// SetNillableRenditionKey sets the "rendition_key" field if the given value is not nil.
+func (_c *DocumentCreate) SetNillableRenditionKey(v *string) *DocumentCreate {
+	if v != nil {
+		_c.SetRenditionKey(*v)
 	}
 	return _c
 }
@@ -291,6 +429,70 @@ func (_c *DocumentCreate) AddPermissions(v ...*DocumentPermission) *DocumentCrea
 	return _c.AddPermissionIDs(ids...)
 }
 
+// AddSignatureRequestIDs adds the "signature_requests" edge to the SignatureRequest entity by IDs.
+func (_c *DocumentCreate) AddSignatureRequestIDs(ids ...string) *DocumentCreate {
+	_c.mutation.AddSignatureRequestIDs(ids...)
+	return _c
+}
+
+// AddSignatureRequests adds the "signature_requests" edges to the SignatureRequest entity.
+func (_c *DocumentCreate) AddSignatureRequests(v ...*SignatureRequest) *DocumentCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSignatureRequestIDs(ids...)
+}
+
+// AddReviewTaskIDs adds the "review_tasks" edge to the ReviewTask entity by IDs.
+func (_c *DocumentCreate) AddReviewTaskIDs(ids ...string) *DocumentCreate {
+	_c.mutation.AddReviewTaskIDs(ids...)
+	return _c
+}
+
+// AddReviewTasks adds the "review_tasks" edges to the ReviewTask entity.
+func (_c *DocumentCreate) AddReviewTasks(v ...*ReviewTask) *DocumentCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReviewTaskIDs(ids...)
+}
+
+// AddDocumentTagIDs adds the "document_tags" edge to the DocumentTag entity by IDs.
+func (_c *DocumentCreate) AddDocumentTagIDs(ids ...uint32) *DocumentCreate {
+	_c.mutation.AddDocumentTagIDs(ids...)
+	return _c
+}
+
+// AddDocumentTags adds the "document_tags" edges to the DocumentTag entity.
+func (_c *DocumentCreate) AddDocumentTags(v ...*DocumentTag) *DocumentCreate {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDocumentTagIDs(ids...)
+}
+
+// SetContentID sets the "content" edge to the DocumentContent entity by ID.
+func (_c *DocumentCreate) SetContentID(id uint32) *DocumentCreate {
+	_c.mutation.SetContentID(id)
+	return _c
+}
+
+// SetNillableContentID sets the "content" edge to the DocumentContent entity by ID if the given value is not nil.
+func (_c *DocumentCreate) SetNillableContentID(id *uint32) *DocumentCreate {
+	if id != nil {
+		_c = _c.SetContentID(*id)
+	}
+	return _c
+}
+
+// SetContent sets the "content" edge to the DocumentContent entity.
+func (_c *DocumentCreate) SetContent(v *DocumentContent) *DocumentCreate {
+	return _c.SetContentID(v.ID)
+}
+
 // Mutation returns the DocumentMutation object of the builder.
 func (_c *DocumentCreate) Mutation() *DocumentMutation {
 	return _c.mutation
@@ -332,6 +534,10 @@ func (_c *DocumentCreate) defaults() error {
 		v := document.DefaultTenantID
 		_c.mutation.SetTenantID(v)
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		v := document.DefaultVersion
+		_c.mutation.SetVersion(v)
+	}
 	if _, ok := _c.mutation.FileSize(); !ok {
 		v := document.DefaultFileSize
 		_c.mutation.SetFileSize(v)
@@ -348,11 +554,18 @@ func (_c *DocumentCreate) defaults() error {
 		v := document.DefaultProcessingStatus
 		_c.mutation.SetProcessingStatus(v)
 	}
+	if _, ok := _c.mutation.StorageTier(); !ok {
+		v := document.DefaultStorageTier
+		_c.mutation.SetStorageTier(v)
+	}
 	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *DocumentCreate) check() error {
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Document.version"`)}
+	}
 	if _, ok := _c.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Document.name"`)}
 	}
@@ -395,6 +608,16 @@ func (_c *DocumentCreate) check() error {
 			return &ValidationError{Name: "checksum", err: fmt.Errorf(`ent: validator failed for field "Document.checksum": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.DocumentType(); ok {
+		if err := document.DocumentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "document_type", err: fmt.Errorf(`ent: validator failed for field "Document.document_type": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.RetentionClass(); ok {
+		if err := document.RetentionClassValidator(v); err != nil {
+			return &ValidationError{Name: "retention_class", err: fmt.Errorf(`ent: validator failed for field "Document.retention_class": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Document.status"`)}
 	}
@@ -419,6 +642,14 @@ func (_c *DocumentCreate) check() error {
 			return &ValidationError{Name: "processing_status", err: fmt.Errorf(`ent: validator failed for field "Document.processing_status": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.StorageTier(); !ok {
+		return &ValidationError{Name: "storage_tier", err: errors.New(`ent: missing required field "Document.storage_tier"`)}
+	}
+	if v, ok := _c.mutation.StorageTier(); ok {
+		if err := document.StorageTierValidator(v); err != nil {
+			return &ValidationError{Name: "storage_tier", err: fmt.Errorf(`ent: validator failed for field "Document.storage_tier": %w`, err)}
+		}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := document.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Document.id": %w`, err)}
@@ -484,6 +715,10 @@ func (_c *DocumentCreate) createSpec() (*Document, *sqlgraph.CreateSpec) {
 		_spec.SetField(document.FieldTenantID, field.TypeUint32, value)
 		_node.TenantID = &value
 	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(document.FieldVersion, field.TypeUint32, value)
+		_node.Version = value
+	}
 	if value, ok := _c.mutation.Name(); ok {
 		_spec.SetField(document.FieldName, field.TypeString, value)
 		_node.Name = value
@@ -516,6 +751,14 @@ func (_c *DocumentCreate) createSpec() (*Document, *sqlgraph.CreateSpec) {
 		_spec.SetField(document.FieldTags, field.TypeJSON, value)
 		_node.Tags = value
 	}
+	if value, ok := _c.mutation.DocumentType(); ok {
+		_spec.SetField(document.FieldDocumentType, field.TypeString, value)
+		_node.DocumentType = value
+	}
+	if value, ok := _c.mutation.RetentionClass(); ok {
+		_spec.SetField(document.FieldRetentionClass, field.TypeString, value)
+		_node.RetentionClass = value
+	}
 	if value, ok := _c.mutation.Status(); ok {
 		_spec.SetField(document.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
@@ -524,18 +767,42 @@ func (_c *DocumentCreate) createSpec() (*Document, *sqlgraph.CreateSpec) {
 		_spec.SetField(document.FieldSource, field.TypeEnum, value)
 		_node.Source = value
 	}
-	if value, ok := _c.mutation.ContentText(); ok {
-		_spec.SetField(document.FieldContentText, field.TypeString, value)
-		_node.ContentText = value
-	}
-	if value, ok := _c.mutation.ExtractedMetadata(); ok {
-		_spec.SetField(document.FieldExtractedMetadata, field.TypeJSON, value)
-		_node.ExtractedMetadata = value
-	}
 	if value, ok := _c.mutation.ProcessingStatus(); ok {
 		_spec.SetField(document.FieldProcessingStatus, field.TypeEnum, value)
 		_node.ProcessingStatus = value
 	}
+	if value, ok := _c.mutation.StorageTier(); ok {
+		_spec.SetField(document.FieldStorageTier, field.TypeEnum, value)
+		_node.StorageTier = value
+	}
+	if value, ok := _c.mutation.LastAccessedAt(); ok {
+		_spec.SetField(document.FieldLastAccessedAt, field.TypeTime, value)
+		_node.LastAccessedAt = &value
+	}
+	if value, ok := _c.mutation.DueDate(); ok {
+		_spec.SetField(document.FieldDueDate, field.TypeTime, value)
+		_node.DueDate = &value
+	}
+	if value, ok := _c.mutation.RemindedAt(); ok {
+		_spec.SetField(document.FieldRemindedAt, field.TypeTime, value)
+		_node.RemindedAt = &value
+	}
+	if value, ok := _c.mutation.AssigneeID(); ok {
+		_spec.SetField(document.FieldAssigneeID, field.TypeUint32, value)
+		_node.AssigneeID = &value
+	}
+	if value, ok := _c.mutation.AssignedBy(); ok {
+		_spec.SetField(document.FieldAssignedBy, field.TypeUint32, value)
+		_node.AssignedBy = &value
+	}
+	if value, ok := _c.mutation.AssignedAt(); ok {
+		_spec.SetField(document.FieldAssignedAt, field.TypeTime, value)
+		_node.AssignedAt = &value
+	}
+	if value, ok := _c.mutation.RenditionKey(); ok {
+		_spec.SetField(document.FieldRenditionKey, field.TypeString, value)
+		_node.RenditionKey = value
+	}
 	if nodes := _c.mutation.CategoryIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -569,6 +836,70 @@ func (_c *DocumentCreate) createSpec() (*Document, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.SignatureRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   document.SignatureRequestsTable,
+			Columns: []string{document.SignatureRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(signaturerequest.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReviewTasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   document.ReviewTasksTable,
+			Columns: []string{document.ReviewTasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reviewtask.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DocumentTagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   document.DocumentTagsTable,
+			Columns: []string{document.DocumentTagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documenttag.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ContentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   document.ContentTable,
+			Columns: []string{document.ContentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documentcontent.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -705,6 +1036,24 @@ func (u *DocumentUpsert) ClearDeleteTime() *DocumentUpsert {
 	return u
 }
 
+// SetVersion sets the "version" field.
+func (u *DocumentUpsert) SetVersion(v uint32) *DocumentUpsert {
+	u.Set(document.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *DocumentUpsert) UpdateVersion() *DocumentUpsert {
+	u.SetExcluded(document.FieldVersion)
+	return u
+}
+
+// AddVersion adds v to the "version" field.
+func (u *DocumentUpsert) AddVersion(v uint32) *DocumentUpsert {
+	u.Add(document.FieldVersion, v)
+	return u
+}
+
 // SetCategoryID sets the "category_id" field.
 func (u *DocumentUpsert) SetCategoryID(v string) *DocumentUpsert {
 	u.Set(document.FieldCategoryID, v)
@@ -849,6 +1198,42 @@ func (u *DocumentUpsert) ClearTags() *DocumentUpsert {
 	return u
 }
 
+// SetDocumentType sets the "document_type" field.
+func (u *DocumentUpsert) SetDocumentType(v string) *DocumentUpsert {
+	u.Set(document.FieldDocumentType, v)
+	return u
+}
+
+// UpdateDocumentType sets the "document_type" field to the value that was provided on create.
+func (u *DocumentUpsert) UpdateDocumentType() *DocumentUpsert {
+	u.SetExcluded(document.FieldDocumentType)
+	return u
+}
+
+// ClearDocumentType clears the value of the "document_type" field.
+func (u *DocumentUpsert) ClearDocumentType() *DocumentUpsert {
+	u.SetNull(document.FieldDocumentType)
+	return u
+}
+
+// SetRetentionClass sets the "retention_class" field.
+func (u *DocumentUpsert) SetRetentionClass(v string) *DocumentUpsert {
+	u.Set(document.FieldRetentionClass, v)
+	return u
+}
+
+// UpdateRetentionClass sets the "retention_class" field to the value that was provided on create.
+func (u *DocumentUpsert) UpdateRetentionClass() *DocumentUpsert {
+	u.SetExcluded(document.FieldRetentionClass)
+	return u
+}
+
+// ClearRetentionClass clears the value of the "retention_class" field.
+func (u *DocumentUpsert) ClearRetentionClass() *DocumentUpsert {
+	u.SetNull(document.FieldRetentionClass)
+	return u
+}
+
 // SetStatus sets the "status" field.
 func (u *DocumentUpsert) SetStatus(v document.Status) *DocumentUpsert {
 	u.Set(document.FieldStatus, v)
@@ -873,51 +1258,165 @@ func (u *DocumentUpsert) UpdateSource() *DocumentUpsert {
 	return u
 }
 
-// SetContentText sets the "content_text" field.
-func (u *DocumentUpsert) SetContentText(v string) *DocumentUpsert {
-	u.Set(document.FieldContentText, v)
+// SetProcessingStatus sets the "processing_status" field.
+func (u *DocumentUpsert) SetProcessingStatus(v document.ProcessingStatus) *DocumentUpsert {
+	u.Set(document.FieldProcessingStatus, v)
+	return u
+}
+
+// UpdateProcessingStatus sets the "processing_status" field to the value that was provided on create.
+func (u *DocumentUpsert) UpdateProcessingStatus() *DocumentUpsert {
+	u.SetExcluded(document.FieldProcessingStatus)
+	return u
+}
+
+// SetStorageTier sets the "storage_tier" field.
+func (u *DocumentUpsert) SetStorageTier(v document.StorageTier) *DocumentUpsert {
+	u.Set(document.FieldStorageTier, v)
+	return u
+}
+
+// UpdateStorageTier sets the "storage_tier" field to the value that was provided on create.
+func (u *DocumentUpsert) UpdateStorageTier() *DocumentUpsert {
+	u.SetExcluded(document.FieldStorageTier)
+	return u
+}
+
+// SetLastAccessedAt sets the "last_accessed_at" field.
+func (u *DocumentUpsert) SetLastAccessedAt(v time.Time) *DocumentUpsert {
+	u.Set(document.FieldLastAccessedAt, v)
+	return u
+}
+
+// UpdateLastAccessedAt sets the "last_accessed_at" field to the value that was provided on create.
+func (u *DocumentUpsert) UpdateLastAccessedAt() *DocumentUpsert {
+	u.SetExcluded(document.FieldLastAccessedAt)
+	return u
+}
+
+// ClearLastAccessedAt clears the value of the "last_accessed_at" field.
+func (u *DocumentUpsert) ClearLastAccessedAt() *DocumentUpsert {
+	u.SetNull(document.FieldLastAccessedAt)
+	return u
+}
+
+// SetDueDate sets the "due_date" field.
+func (u *DocumentUpsert) SetDueDate(v time.Time) *DocumentUpsert {
+	u.Set(document.FieldDueDate, v)
+	return u
+}
+
+// UpdateDueDate sets the "due_date" field to the value that was provided on create.
+func (u *DocumentUpsert) UpdateDueDate() *DocumentUpsert {
+	u.SetExcluded(document.FieldDueDate)
+	return u
+}
+
+// ClearDueDate clears the value of the "due_date" field.
+func (u *DocumentUpsert) ClearDueDate() *DocumentUpsert {
+	u.SetNull(document.FieldDueDate)
+	return u
+}
+
+// SetRemindedAt sets the "reminded_at" field.
+func (u *DocumentUpsert) SetRemindedAt(v time.Time) *DocumentUpsert {
+	u.Set(document.FieldRemindedAt, v)
+	return u
+}
+
+// UpdateRemindedAt sets the "reminded_at" field to the value that was provided on create.
+func (u *DocumentUpsert) UpdateRemindedAt() *DocumentUpsert {
+	u.SetExcluded(document.FieldRemindedAt)
+	return u
+}
+
+// ClearRemindedAt clears the value of the "reminded_at" field.
+func (u *DocumentUpsert) ClearRemindedAt() *DocumentUpsert {
+	u.SetNull(document.FieldRemindedAt)
+	return u
+}
+
+// SetAssigneeID sets the "assignee_id" field.
+func (u *DocumentUpsert) SetAssigneeID(v uint32) *DocumentUpsert {
+	u.Set(document.FieldAssigneeID, v)
+	return u
+}
+
+// UpdateAssigneeID sets the "assignee_id" field to the value that was provided on create.
+func (u *DocumentUpsert) UpdateAssigneeID() *DocumentUpsert {
+	u.SetExcluded(document.FieldAssigneeID)
+	return u
+}
+
+// AddAssigneeID adds v to the "assignee_id" field.
+func (u *DocumentUpsert) AddAssigneeID(v uint32) *DocumentUpsert {
+	u.Add(document.FieldAssigneeID, v)
+	return u
+}
+
+// ClearAssigneeID clears the value of the "assignee_id" field.
+func (u *DocumentUpsert) ClearAssigneeID() *DocumentUpsert {
+	u.SetNull(document.FieldAssigneeID)
+	return u
+}
+
+// SetAssignedBy sets the "assigned_by" field.
+func (u *DocumentUpsert) SetAssignedBy(v uint32) *DocumentUpsert {
+	u.Set(document.FieldAssignedBy, v)
+	return u
+}
+
+// UpdateAssignedBy sets the "assigned_by" field to the value that was provided on create.
+func (u *DocumentUpsert) UpdateAssignedBy() *DocumentUpsert {
+	u.SetExcluded(document.FieldAssignedBy)
+	return u
+}
+
+// AddAssignedBy adds v to the "assigned_by" field.
+func (u *DocumentUpsert) AddAssignedBy(v uint32) *DocumentUpsert {
+	u.Add(document.FieldAssignedBy, v)
 	return u
 }
 
-// UpdateContentText sets the "content_text" field to the value that was provided on create.
-func (u *DocumentUpsert) UpdateContentText() *DocumentUpsert {
-	u.SetExcluded(document.FieldContentText)
+// ClearAssignedBy clears the value of the "assigned_by" field.
+func (u *DocumentUpsert) ClearAssignedBy() *DocumentUpsert {
+	u.SetNull(document.FieldAssignedBy)
 	return u
 }
 
-// ClearContentText clears the value of the "content_text" field.
-func (u *DocumentUpsert) ClearContentText() *DocumentUpsert {
-	u.SetNull(document.FieldContentText)
+// SetAssignedAt sets the "assigned_at" field.
+func (u *DocumentUpsert) SetAssignedAt(v time.Time) *DocumentUpsert {
+	u.Set(document.FieldAssignedAt, v)
 	return u
 }
 
-// SetExtractedMetadata sets the "extracted_metadata" field.
-func (u *DocumentUpsert) SetExtractedMetadata(v map[string]string) *DocumentUpsert {
-	u.Set(document.FieldExtractedMetadata, v)
+// UpdateAssignedAt sets the "assigned_at" field to the value that was provided on create.
+func (u *DocumentUpsert) UpdateAssignedAt() *DocumentUpsert {
+	u.SetExcluded(document.FieldAssignedAt)
 	return u
 }
 
-// UpdateExtractedMetadata sets the "extracted_metadata" field to the value that was provided on create.
-func (u *DocumentUpsert) UpdateExtractedMetadata() *DocumentUpsert {
-	u.SetExcluded(document.FieldExtractedMetadata)
+// ClearAssignedAt clears the value of the "assigned_at" field.
+func (u *DocumentUpsert) ClearAssignedAt() *DocumentUpsert {
+	u.SetNull(document.FieldAssignedAt)
 	return u
 }
 
-// ClearExtractedMetadata clears the value of the "extracted_metadata" field.
-func (u *DocumentUpsert) ClearExtractedMetadata() *DocumentUpsert {
-	u.SetNull(document.FieldExtractedMetadata)
+// SetRenditionKey sets the "rendition_key" field.
+func (u *DocumentUpsert) SetRenditionKey(v string) *DocumentUpsert {
+	u.Set(document.FieldRenditionKey, v)
 	return u
 }
 
-// SetProcessingStatus sets the "processing_status" field.
-func (u *DocumentUpsert) SetProcessingStatus(v document.ProcessingStatus) *DocumentUpsert {
-	u.Set(document.FieldProcessingStatus, v)
+// UpdateRenditionKey sets the "rendition_key" field to the value that was provided on create.
+func (u *DocumentUpsert) UpdateRenditionKey() *DocumentUpsert {
+	u.SetExcluded(document.FieldRenditionKey)
 	return u
 }
 
-// UpdateProcessingStatus sets the "processing_status" field to the value that was provided on create.
-func (u *DocumentUpsert) UpdateProcessingStatus() *DocumentUpsert {
-	u.SetExcluded(document.FieldProcessingStatus)
+// ClearRenditionKey clears the value of the "rendition_key" field.
+func (u *DocumentUpsert) ClearRenditionKey() *DocumentUpsert {
+	u.SetNull(document.FieldRenditionKey)
 	return u
 }
 
@@ -1073,6 +1572,27 @@ func (u *DocumentUpsertOne) ClearDeleteTime() *DocumentUpsertOne {
 	})
 }
 
+// SetVersion sets the "version" field.
+func (u *DocumentUpsertOne) SetVersion(v uint32) *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *DocumentUpsertOne) AddVersion(v uint32) *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *DocumentUpsertOne) UpdateVersion() *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateVersion()
+	})
+}
+
 // SetCategoryID sets the "category_id" field.
 func (u *DocumentUpsertOne) SetCategoryID(v string) *DocumentUpsertOne {
 	return u.Update(func(s *DocumentUpsert) {
@@ -1241,6 +1761,48 @@ func (u *DocumentUpsertOne) ClearTags() *DocumentUpsertOne {
 	})
 }
 
+// SetDocumentType sets the "document_type" field.
+func (u *DocumentUpsertOne) SetDocumentType(v string) *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetDocumentType(v)
+	})
+}
+
+// UpdateDocumentType sets the "document_type" field to the value that was provided on create.
+func (u *DocumentUpsertOne) UpdateDocumentType() *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateDocumentType()
+	})
+}
+
+// ClearDocumentType clears the value of the "document_type" field.
+func (u *DocumentUpsertOne) ClearDocumentType() *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.ClearDocumentType()
+	})
+}
+
+// SetRetentionClass sets the "retention_class" field.
+func (u *DocumentUpsertOne) SetRetentionClass(v string) *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetRetentionClass(v)
+	})
+}
+
+// UpdateRetentionClass sets the "retention_class" field to the value that was provided on create.
+func (u *DocumentUpsertOne) UpdateRetentionClass() *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateRetentionClass()
+	})
+}
+
+// ClearRetentionClass clears the value of the "retention_class" field.
+func (u *DocumentUpsertOne) ClearRetentionClass() *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.ClearRetentionClass()
+	})
+}
+
 // SetStatus sets the "status" field.
 func (u *DocumentUpsertOne) SetStatus(v document.Status) *DocumentUpsertOne {
 	return u.Update(func(s *DocumentUpsert) {
@@ -1269,59 +1831,192 @@ func (u *DocumentUpsertOne) UpdateSource() *DocumentUpsertOne {
 	})
 }
 
-// SetContentText sets the "content_text" field.
-func (u *DocumentUpsertOne) SetContentText(v string) *DocumentUpsertOne {
+// SetProcessingStatus sets the "processing_status" field.
+func (u *DocumentUpsertOne) SetProcessingStatus(v document.ProcessingStatus) *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetProcessingStatus(v)
+	})
+}
+
+// UpdateProcessingStatus sets the "processing_status" field to the value that was provided on create.
+func (u *DocumentUpsertOne) UpdateProcessingStatus() *DocumentUpsertOne {
 	return u.Update(func(s *DocumentUpsert) {
-		s.SetContentText(v)
+		s.UpdateProcessingStatus()
 	})
 }
 
-// UpdateContentText sets the "content_text" field to the value that was provided on create.
-func (u *DocumentUpsertOne) UpdateContentText() *DocumentUpsertOne {
+// SetStorageTier sets the "storage_tier" field.
+func (u *DocumentUpsertOne) SetStorageTier(v document.StorageTier) *DocumentUpsertOne {
 	return u.Update(func(s *DocumentUpsert) {
-		s.UpdateContentText()
+		s.SetStorageTier(v)
 	})
 }
 
-// ClearContentText clears the value of the "content_text" field.
-func (u *DocumentUpsertOne) ClearContentText() *DocumentUpsertOne {
+// UpdateStorageTier sets the "storage_tier" field to the value that was provided on create.
+func (u *DocumentUpsertOne) UpdateStorageTier() *DocumentUpsertOne {
 	return u.Update(func(s *DocumentUpsert) {
-		s.ClearContentText()
+		s.UpdateStorageTier()
 	})
 }
 
-// SetExtractedMetadata sets the "extracted_metadata" field.
-func (u *DocumentUpsertOne) SetExtractedMetadata(v map[string]string) *DocumentUpsertOne {
+// SetLastAccessedAt sets the "last_accessed_at" field.
+func (u *DocumentUpsertOne) SetLastAccessedAt(v time.Time) *DocumentUpsertOne {
 	return u.Update(func(s *DocumentUpsert) {
-		s.SetExtractedMetadata(v)
+		s.SetLastAccessedAt(v)
 	})
 }
 
-// UpdateExtractedMetadata sets the "extracted_metadata" field to the value that was provided on create.
-func (u *DocumentUpsertOne) UpdateExtractedMetadata() *DocumentUpsertOne {
+// UpdateLastAccessedAt sets the "last_accessed_at" field to the value that was provided on create.
+func (u *DocumentUpsertOne) UpdateLastAccessedAt() *DocumentUpsertOne {
 	return u.Update(func(s *DocumentUpsert) {
-		s.UpdateExtractedMetadata()
+		s.UpdateLastAccessedAt()
 	})
 }
 
-// ClearExtractedMetadata clears the value of the "extracted_metadata" field.
-func (u *DocumentUpsertOne) ClearExtractedMetadata() *DocumentUpsertOne {
+// ClearLastAccessedAt clears the value of the "last_accessed_at" field.
+func (u *DocumentUpsertOne) ClearLastAccessedAt() *DocumentUpsertOne {
 	return u.Update(func(s *DocumentUpsert) {
-		s.ClearExtractedMetadata()
+		s.ClearLastAccessedAt()
 	})
 }
 
-// SetProcessingStatus sets the "processing_status" field.
-func (u *DocumentUpsertOne) SetProcessingStatus(v document.ProcessingStatus) *DocumentUpsertOne {
+// SetDueDate sets the "due_date" field.
+func (u *DocumentUpsertOne) SetDueDate(v time.Time) *DocumentUpsertOne {
 	return u.Update(func(s *DocumentUpsert) {
-		s.SetProcessingStatus(v)
+		s.SetDueDate(v)
 	})
 }
 
-// UpdateProcessingStatus sets the "processing_status" field to the value that was provided on create.
-func (u *DocumentUpsertOne) UpdateProcessingStatus() *DocumentUpsertOne {
+// UpdateDueDate sets the "due_date" field to the value that was provided on create.
+func (u *DocumentUpsertOne) UpdateDueDate() *DocumentUpsertOne {
 	return u.Update(func(s *DocumentUpsert) {
-		s.UpdateProcessingStatus()
+		s.UpdateDueDate()
+	})
+}
+
+// ClearDueDate clears the value of the "due_date" field.
+func (u *DocumentUpsertOne) ClearDueDate() *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.ClearDueDate()
+	})
+}
+
+// SetRemindedAt sets the "reminded_at" field.
+func (u *DocumentUpsertOne) SetRemindedAt(v time.Time) *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetRemindedAt(v)
+	})
+}
+
+// UpdateRemindedAt sets the "reminded_at" field to the value that was provided on create.
+func (u *DocumentUpsertOne) UpdateRemindedAt() *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateRemindedAt()
+	})
+}
+
+// ClearRemindedAt clears the value of the "reminded_at" field.
+func (u *DocumentUpsertOne) ClearRemindedAt() *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.ClearRemindedAt()
+	})
+}
+
+// SetAssigneeID sets the "assignee_id" field.
+func (u *DocumentUpsertOne) SetAssigneeID(v uint32) *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetAssigneeID(v)
+	})
+}
+
+// AddAssigneeID adds v to the "assignee_id" field.
+func (u *DocumentUpsertOne) AddAssigneeID(v uint32) *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.AddAssigneeID(v)
+	})
+}
+
+// UpdateAssigneeID sets the "assignee_id" field to the value that was provided on create.
+func (u *DocumentUpsertOne) UpdateAssigneeID() *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateAssigneeID()
+	})
+}
+
+// ClearAssigneeID clears the value of the "assignee_id" field.
+func (u *DocumentUpsertOne) ClearAssigneeID() *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.ClearAssigneeID()
+	})
+}
+
+// SetAssignedBy sets the "assigned_by" field.
+func (u *DocumentUpsertOne) SetAssignedBy(v uint32) *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetAssignedBy(v)
+	})
+}
+
+// AddAssignedBy adds v to the "assigned_by" field.
+func (u *DocumentUpsertOne) AddAssignedBy(v uint32) *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.AddAssignedBy(v)
+	})
+}
+
+// UpdateAssignedBy sets the "assigned_by" field to the value that was provided on create.
+func (u *DocumentUpsertOne) UpdateAssignedBy() *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateAssignedBy()
+	})
+}
+
+// ClearAssignedBy clears the value of the "assigned_by" field.
+func (u *DocumentUpsertOne) ClearAssignedBy() *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.ClearAssignedBy()
+	})
+}
+
+// SetAssignedAt sets the "assigned_at" field.
+func (u *DocumentUpsertOne) SetAssignedAt(v time.Time) *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetAssignedAt(v)
+	})
+}
+
+// UpdateAssignedAt sets the "assigned_at" field to the value that was provided on create.
+func (u *DocumentUpsertOne) UpdateAssignedAt() *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateAssignedAt()
+	})
+}
+
+// ClearAssignedAt clears the value of the "assigned_at" field.
+func (u *DocumentUpsertOne) ClearAssignedAt() *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.ClearAssignedAt()
+	})
+}
+
+// SetRenditionKey sets the "rendition_key" field.
+func (u *DocumentUpsertOne) SetRenditionKey(v string) *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetRenditionKey(v)
+	})
+}
+
+// UpdateRenditionKey sets the "rendition_key" field to the value that was provided on create.
+func (u *DocumentUpsertOne) UpdateRenditionKey() *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateRenditionKey()
+	})
+}
+
+// ClearRenditionKey clears the value of the "rendition_key" field.
+func (u *DocumentUpsertOne) ClearRenditionKey() *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.ClearRenditionKey()
 	})
 }
 
@@ -1644,6 +2339,27 @@ func (u *DocumentUpsertBulk) ClearDeleteTime() *DocumentUpsertBulk {
 	})
 }
 
+// SetVersion sets the "version" field.
+func (u *DocumentUpsertBulk) SetVersion(v uint32) *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *DocumentUpsertBulk) AddVersion(v uint32) *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *DocumentUpsertBulk) UpdateVersion() *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateVersion()
+	})
+}
+
 // SetCategoryID sets the "category_id" field.
 func (u *DocumentUpsertBulk) SetCategoryID(v string) *DocumentUpsertBulk {
 	return u.Update(func(s *DocumentUpsert) {
@@ -1812,6 +2528,48 @@ func (u *DocumentUpsertBulk) ClearTags() *DocumentUpsertBulk {
 	})
 }
 
+// SetDocumentType sets the "document_type" field.
+func (u *DocumentUpsertBulk) SetDocumentType(v string) *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetDocumentType(v)
+	})
+}
+
+// UpdateDocumentType sets the "document_type" field to the value that was provided on create.
+func (u *DocumentUpsertBulk) UpdateDocumentType() *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateDocumentType()
+	})
+}
+
+// ClearDocumentType clears the value of the "document_type" field.
+func (u *DocumentUpsertBulk) ClearDocumentType() *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.ClearDocumentType()
+	})
+}
+
+// SetRetentionClass sets the "retention_class" field.
+func (u *DocumentUpsertBulk) SetRetentionClass(v string) *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetRetentionClass(v)
+	})
+}
+
+// UpdateRetentionClass sets the "retention_class" field to the value that was provided on create.
+func (u *DocumentUpsertBulk) UpdateRetentionClass() *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateRetentionClass()
+	})
+}
+
+// ClearRetentionClass clears the value of the "retention_class" field.
+func (u *DocumentUpsertBulk) ClearRetentionClass() *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.ClearRetentionClass()
+	})
+}
+
 // SetStatus sets the "status" field.
 func (u *DocumentUpsertBulk) SetStatus(v document.Status) *DocumentUpsertBulk {
 	return u.Update(func(s *DocumentUpsert) {
@@ -1840,59 +2598,192 @@ func (u *DocumentUpsertBulk) UpdateSource() *DocumentUpsertBulk {
 	})
 }
 
-// SetContentText sets the "content_text" field.
-func (u *DocumentUpsertBulk) SetContentText(v string) *DocumentUpsertBulk {
+// SetProcessingStatus sets the "processing_status" field.
+func (u *DocumentUpsertBulk) SetProcessingStatus(v document.ProcessingStatus) *DocumentUpsertBulk {
 	return u.Update(func(s *DocumentUpsert) {
-		s.SetContentText(v)
+		s.SetProcessingStatus(v)
 	})
 }
 
-// UpdateContentText sets the "content_text" field to the value that was provided on create.
-func (u *DocumentUpsertBulk) UpdateContentText() *DocumentUpsertBulk {
+// UpdateProcessingStatus sets the "processing_status" field to the value that was provided on create.
+func (u *DocumentUpsertBulk) UpdateProcessingStatus() *DocumentUpsertBulk {
 	return u.Update(func(s *DocumentUpsert) {
-		s.UpdateContentText()
+		s.UpdateProcessingStatus()
 	})
 }
 
-// ClearContentText clears the value of the "content_text" field.
-func (u *DocumentUpsertBulk) ClearContentText() *DocumentUpsertBulk {
+// SetStorageTier sets the "storage_tier" field.
+func (u *DocumentUpsertBulk) SetStorageTier(v document.StorageTier) *DocumentUpsertBulk {
 	return u.Update(func(s *DocumentUpsert) {
-		s.ClearContentText()
+		s.SetStorageTier(v)
 	})
 }
 
-// SetExtractedMetadata sets the "extracted_metadata" field.
-func (u *DocumentUpsertBulk) SetExtractedMetadata(v map[string]string) *DocumentUpsertBulk {
+// UpdateStorageTier sets the "storage_tier" field to the value that was provided on create.
+func (u *DocumentUpsertBulk) UpdateStorageTier() *DocumentUpsertBulk {
 	return u.Update(func(s *DocumentUpsert) {
-		s.SetExtractedMetadata(v)
+		s.UpdateStorageTier()
 	})
 }
 
-// UpdateExtractedMetadata sets the "extracted_metadata" field to the value that was provided on create.
-func (u *DocumentUpsertBulk) UpdateExtractedMetadata() *DocumentUpsertBulk {
+// SetLastAccessedAt sets the "last_accessed_at" field.
+func (u *DocumentUpsertBulk) SetLastAccessedAt(v time.Time) *DocumentUpsertBulk {
 	return u.Update(func(s *DocumentUpsert) {
-		s.UpdateExtractedMetadata()
+		s.SetLastAccessedAt(v)
 	})
 }
 
-// ClearExtractedMetadata clears the value of the "extracted_metadata" field.
-func (u *DocumentUpsertBulk) ClearExtractedMetadata() *DocumentUpsertBulk {
+// UpdateLastAccessedAt sets the "last_accessed_at" field to the value that was provided on create.
+func (u *DocumentUpsertBulk) UpdateLastAccessedAt() *DocumentUpsertBulk {
 	return u.Update(func(s *DocumentUpsert) {
-		s.ClearExtractedMetadata()
+		s.UpdateLastAccessedAt()
 	})
 }
 
-// SetProcessingStatus sets the "processing_status" field.
-func (u *DocumentUpsertBulk) SetProcessingStatus(v document.ProcessingStatus) *DocumentUpsertBulk {
+// ClearLastAccessedAt clears the value of the "last_accessed_at" field.
+func (u *DocumentUpsertBulk) ClearLastAccessedAt() *DocumentUpsertBulk {
 	return u.Update(func(s *DocumentUpsert) {
-		s.SetProcessingStatus(v)
+		s.ClearLastAccessedAt()
 	})
 }
 
-// UpdateProcessingStatus sets the "processing_status" field to the value that was provided on create.
-func (u *DocumentUpsertBulk) UpdateProcessingStatus() *DocumentUpsertBulk {
+// SetDueDate sets the "due_date" field.
+func (u *DocumentUpsertBulk) SetDueDate(v time.Time) *DocumentUpsertBulk {
 	return u.Update(func(s *DocumentUpsert) {
-		s.UpdateProcessingStatus()
+		s.SetDueDate(v)
+	})
+}
+
+// UpdateDueDate sets the "due_date" field to the value that was provided on create.
+func (u *DocumentUpsertBulk) UpdateDueDate() *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateDueDate()
+	})
+}
+
+// ClearDueDate clears the value of the "due_date" field.
+func (u *DocumentUpsertBulk) ClearDueDate() *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.ClearDueDate()
+	})
+}
+
+// SetRemindedAt sets the "reminded_at" field.
+func (u *DocumentUpsertBulk) SetRemindedAt(v time.Time) *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetRemindedAt(v)
+	})
+}
+
+// UpdateRemindedAt sets the "reminded_at" field to the value that was provided on create.
+func (u *DocumentUpsertBulk) UpdateRemindedAt() *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateRemindedAt()
+	})
+}
+
+// ClearRemindedAt clears the value of the "reminded_at" field.
+func (u *DocumentUpsertBulk) ClearRemindedAt() *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.ClearRemindedAt()
+	})
+}
+
+// SetAssigneeID sets the "assignee_id" field.
+func (u *DocumentUpsertBulk) SetAssigneeID(v uint32) *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetAssigneeID(v)
+	})
+}
+
+// AddAssigneeID adds v to the "assignee_id" field.
+func (u *DocumentUpsertBulk) AddAssigneeID(v uint32) *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.AddAssigneeID(v)
+	})
+}
+
+// UpdateAssigneeID sets the "assignee_id" field to the value that was provided on create.
+func (u *DocumentUpsertBulk) UpdateAssigneeID() *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateAssigneeID()
+	})
+}
+
+// ClearAssigneeID clears the value of the "assignee_id" field.
+func (u *DocumentUpsertBulk) ClearAssigneeID() *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.ClearAssigneeID()
+	})
+}
+
+// SetAssignedBy sets the "assigned_by" field.
+func (u *DocumentUpsertBulk) SetAssignedBy(v uint32) *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetAssignedBy(v)
+	})
+}
+
+// AddAssignedBy adds v to the "assigned_by" field.
+func (u *DocumentUpsertBulk) AddAssignedBy(v uint32) *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.AddAssignedBy(v)
+	})
+}
+
+// UpdateAssignedBy sets the "assigned_by" field to the value that was provided on create.
+func (u *DocumentUpsertBulk) UpdateAssignedBy() *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateAssignedBy()
+	})
+}
+
+// ClearAssignedBy clears the value of the "assigned_by" field.
+func (u *DocumentUpsertBulk) ClearAssignedBy() *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.ClearAssignedBy()
+	})
+}
+
+// SetAssignedAt sets the "assigned_at" field.
+func (u *DocumentUpsertBulk) SetAssignedAt(v time.Time) *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetAssignedAt(v)
+	})
+}
+
+// UpdateAssignedAt sets the "assigned_at" field to the value that was provided on create.
+func (u *DocumentUpsertBulk) UpdateAssignedAt() *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateAssignedAt()
+	})
+}
+
+// ClearAssignedAt clears the value of the "assigned_at" field.
+func (u *DocumentUpsertBulk) ClearAssignedAt() *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.ClearAssignedAt()
+	})
+}
+
+// SetRenditionKey sets the "rendition_key" field.
+func (u *DocumentUpsertBulk) SetRenditionKey(v string) *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetRenditionKey(v)
+	})
+}
+
+// UpdateRenditionKey sets the "rendition_key" field to the value that was provided on create.
+func (u *DocumentUpsertBulk) UpdateRenditionKey() *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateRenditionKey()
+	})
+}
+
+// ClearRenditionKey clears the value of the "rendition_key" field.
+func (u *DocumentUpsertBulk) ClearRenditionKey() *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.ClearRenditionKey()
 	})
 }
 
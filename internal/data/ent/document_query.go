@@ -16,20 +16,28 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/category"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/document"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documentcontent"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documentpermission"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documenttag"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/reviewtask"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/signaturerequest"
 )
 
 // DocumentQuery is the builder for querying Document entities.
 type DocumentQuery struct {
 	config
-	ctx             *QueryContext
-	order           []document.OrderOption
-	inters          []Interceptor
-	predicates      []predicate.Document
-	withCategory    *CategoryQuery
-	withPermissions *DocumentPermissionQuery
-	modifiers       []func(*sql.Selector)
+	ctx                   *QueryContext
+	order                 []document.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.Document
+	withCategory          *CategoryQuery
+	withPermissions       *DocumentPermissionQuery
+	withSignatureRequests *SignatureRequestQuery
+	withReviewTasks       *ReviewTaskQuery
+	withDocumentTags      *DocumentTagQuery
+	withContent           *DocumentContentQuery
+	modifiers             []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -110,6 +118,94 @@ func (_q *DocumentQuery) QueryPermissions() *DocumentPermissionQuery {
 	return query
 }
 
+// QuerySignatureRequests chains the current query on the "signature_requests" edge.
+func (_q *DocumentQuery) QuerySignatureRequests() *SignatureRequestQuery {
+	query := (&SignatureRequestClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(document.Table, document.FieldID, selector),
+			sqlgraph.To(signaturerequest.Table, signaturerequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, document.SignatureRequestsTable, document.SignatureRequestsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReviewTasks chains the current query on the "review_tasks" edge.
+func (_q *DocumentQuery) QueryReviewTasks() *ReviewTaskQuery {
+	query := (&ReviewTaskClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(document.Table, document.FieldID, selector),
+			sqlgraph.To(reviewtask.Table, reviewtask.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, document.ReviewTasksTable, document.ReviewTasksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDocumentTags chains the current query on the "document_tags" edge.
+func (_q *DocumentQuery) QueryDocumentTags() *DocumentTagQuery {
+	query := (&DocumentTagClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(document.Table, document.FieldID, selector),
+			sqlgraph.To(documenttag.Table, documenttag.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, document.DocumentTagsTable, document.DocumentTagsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryContent chains the current query on the "content" edge.
+func (_q *DocumentQuery) QueryContent() *DocumentContentQuery {
+	query := (&DocumentContentClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(document.Table, document.FieldID, selector),
+			sqlgraph.To(documentcontent.Table, documentcontent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, document.ContentTable, document.ContentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Document entity from the query.
 // Returns a *NotFoundError when no Document was found.
 func (_q *DocumentQuery) First(ctx context.Context) (*Document, error) {
@@ -297,13 +393,17 @@ func (_q *DocumentQuery) Clone() *DocumentQuery {
 		return nil
 	}
 	return &DocumentQuery{
-		config:          _q.config,
-		ctx:             _q.ctx.Clone(),
-		order:           append([]document.OrderOption{}, _q.order...),
-		inters:          append([]Interceptor{}, _q.inters...),
-		predicates:      append([]predicate.Document{}, _q.predicates...),
-		withCategory:    _q.withCategory.Clone(),
-		withPermissions: _q.withPermissions.Clone(),
+		config:                _q.config,
+		ctx:                   _q.ctx.Clone(),
+		order:                 append([]document.OrderOption{}, _q.order...),
+		inters:                append([]Interceptor{}, _q.inters...),
+		predicates:            append([]predicate.Document{}, _q.predicates...),
+		withCategory:          _q.withCategory.Clone(),
+		withPermissions:       _q.withPermissions.Clone(),
+		withSignatureRequests: _q.withSignatureRequests.Clone(),
+		withReviewTasks:       _q.withReviewTasks.Clone(),
+		withDocumentTags:      _q.withDocumentTags.Clone(),
+		withContent:           _q.withContent.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -333,6 +433,50 @@ func (_q *DocumentQuery) WithPermissions(opts ...func(*DocumentPermissionQuery))
 	return _q
 }
 
+// WithSignatureRequests tells the query-builder to eager-load the nodes that are connected to
+// the "signature_requests" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DocumentQuery) WithSignatureRequests(opts ...func(*SignatureRequestQuery)) *DocumentQuery {
+	query := (&SignatureRequestClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSignatureRequests = query
+	return _q
+}
+
+// WithReviewTasks tells the query-builder to eager-load the nodes that are connected to
+// the "review_tasks" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DocumentQuery) WithReviewTasks(opts ...func(*ReviewTaskQuery)) *DocumentQuery {
+	query := (&ReviewTaskClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReviewTasks = query
+	return _q
+}
+
+// WithDocumentTags tells the query-builder to eager-load the nodes that are connected to
+// the "document_tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DocumentQuery) WithDocumentTags(opts ...func(*DocumentTagQuery)) *DocumentQuery {
+	query := (&DocumentTagClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDocumentTags = query
+	return _q
+}
+
+// WithContent tells the query-builder to eager-load the nodes that are connected to
+// the "content" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DocumentQuery) WithContent(opts ...func(*DocumentContentQuery)) *DocumentQuery {
+	query := (&DocumentContentClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withContent = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -417,9 +561,13 @@ func (_q *DocumentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Doc
 	var (
 		nodes       = []*Document{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [6]bool{
 			_q.withCategory != nil,
 			_q.withPermissions != nil,
+			_q.withSignatureRequests != nil,
+			_q.withReviewTasks != nil,
+			_q.withDocumentTags != nil,
+			_q.withContent != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -456,6 +604,35 @@ func (_q *DocumentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Doc
 			return nil, err
 		}
 	}
+	if query := _q.withSignatureRequests; query != nil {
+		if err := _q.loadSignatureRequests(ctx, query, nodes,
+			func(n *Document) { n.Edges.SignatureRequests = []*SignatureRequest{} },
+			func(n *Document, e *SignatureRequest) {
+				n.Edges.SignatureRequests = append(n.Edges.SignatureRequests, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withReviewTasks; query != nil {
+		if err := _q.loadReviewTasks(ctx, query, nodes,
+			func(n *Document) { n.Edges.ReviewTasks = []*ReviewTask{} },
+			func(n *Document, e *ReviewTask) { n.Edges.ReviewTasks = append(n.Edges.ReviewTasks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withDocumentTags; query != nil {
+		if err := _q.loadDocumentTags(ctx, query, nodes,
+			func(n *Document) { n.Edges.DocumentTags = []*DocumentTag{} },
+			func(n *Document, e *DocumentTag) { n.Edges.DocumentTags = append(n.Edges.DocumentTags, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withContent; query != nil {
+		if err := _q.loadContent(ctx, query, nodes, nil,
+			func(n *Document, e *DocumentContent) { n.Edges.Content = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -522,6 +699,123 @@ func (_q *DocumentQuery) loadPermissions(ctx context.Context, query *DocumentPer
 	}
 	return nil
 }
+func (_q *DocumentQuery) loadSignatureRequests(ctx context.Context, query *SignatureRequestQuery, nodes []*Document, init func(*Document), assign func(*Document, *SignatureRequest)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Document)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(signaturerequest.FieldDocumentID)
+	}
+	query.Where(predicate.SignatureRequest(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(document.SignatureRequestsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.DocumentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "document_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *DocumentQuery) loadReviewTasks(ctx context.Context, query *ReviewTaskQuery, nodes []*Document, init func(*Document), assign func(*Document, *ReviewTask)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Document)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(reviewtask.FieldDocumentID)
+	}
+	query.Where(predicate.ReviewTask(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(document.ReviewTasksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.DocumentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "document_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *DocumentQuery) loadDocumentTags(ctx context.Context, query *DocumentTagQuery, nodes []*Document, init func(*Document), assign func(*Document, *DocumentTag)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Document)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(documenttag.FieldDocumentID)
+	}
+	query.Where(predicate.DocumentTag(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(document.DocumentTagsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.DocumentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "document_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *DocumentQuery) loadContent(ctx context.Context, query *DocumentContentQuery, nodes []*Document, init func(*Document), assign func(*Document, *DocumentContent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Document)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(documentcontent.FieldDocumentID)
+	}
+	query.Where(predicate.DocumentContent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(document.ContentColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.DocumentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "document_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *DocumentQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -13,8 +13,12 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/category"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/document"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documentcontent"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documentpermission"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documenttag"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/reviewtask"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/signaturerequest"
 )
 
 // DocumentUpdate is the builder for updating Document entities.
@@ -125,6 +129,27 @@ func (_u *DocumentUpdate) ClearDeleteTime() *DocumentUpdate {
 	return _u
 }
 
+// SetVersion sets the "version" field.
+func (_u *DocumentUpdate) SetVersion(v uint32) *DocumentUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *DocumentUpdate) SetNillableVersion(v *uint32) *DocumentUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *DocumentUpdate) AddVersion(v int32) *DocumentUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
 // SetCategoryID sets the "category_id" field.
 func (_u *DocumentUpdate) SetCategoryID(v string) *DocumentUpdate {
 	_u.mutation.SetCategoryID(v)
@@ -280,6 +305,46 @@ func (_u *DocumentUpdate) ClearTags() *DocumentUpdate {
 	return _u
 }
 
+// SetDocumentType sets the "document_type" field.
+func (_u *DocumentUpdate) SetDocumentType(v string) *DocumentUpdate {
+	_u.mutation.SetDocumentType(v)
+	return _u
+}
+
+// SetNillableDocumentType sets the "document_type" field if the given value is not nil.
+func (_u *DocumentUpdate) SetNillableDocumentType(v *string) *DocumentUpdate {
+	if v != nil {
+		_u.SetDocumentType(*v)
+	}
+	return _u
+}
+
+// ClearDocumentType clears the value of the "document_type" field.
+func (_u *DocumentUpdate) ClearDocumentType() *DocumentUpdate {
+	_u.mutation.ClearDocumentType()
+	return _u
+}
+
+// SetRetentionClass sets the "retention_class" field.
+func (_u *DocumentUpdate) SetRetentionClass(v string) *DocumentUpdate {
+	_u.mutation.SetRetentionClass(v)
+	return _u
+}
+
+// SetNillableRetentionClass sets the "retention_class" field if the given value is not nil.
+func (_u *DocumentUpdate) SetNillableRetentionClass(v *string) *DocumentUpdate {
+	if v != nil {
+		_u.SetRetentionClass(*v)
+	}
+	return _u
+}
+
+// ClearRetentionClass clears the value of the "retention_class" field.
+func (_u *DocumentUpdate) ClearRetentionClass() *DocumentUpdate {
+	_u.mutation.ClearRetentionClass()
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *DocumentUpdate) SetStatus(v document.Status) *DocumentUpdate {
 	_u.mutation.SetStatus(v)
@@ -308,52 +373,188 @@ func (_u *DocumentUpdate) SetNillableSource(v *document.Source) *DocumentUpdate
 	return _u
 }
 
-// SetContentText sets the "content_text" field.
-func (_u *DocumentUpdate) SetContentText(v string) *DocumentUpdate {
-	_u.mutation.SetContentText(v)
+// SetProcessingStatus sets the "processing_status" field.
+func (_u *DocumentUpdate) SetProcessingStatus(v document.ProcessingStatus) *DocumentUpdate {
+	_u.mutation.SetProcessingStatus(v)
 	return _u
 }
 
-// SetNillableContentText sets the "content_text" field if the given value is not nil.
-func (_u *DocumentUpdate) SetNillableContentText(v *string) *DocumentUpdate {
+// SetNillableProcessingStatus sets the "processing_status" field if the given value is not nil.
+func (_u *DocumentUpdate) SetNillableProcessingStatus(v *document.ProcessingStatus) *DocumentUpdate {
 	if v != nil {
-		_u.SetContentText(*v)
+		_u.SetProcessingStatus(*v)
 	}
 	return _u
 }
 
-// ClearContentText clears the value of the "content_text" field.
-func (_u *DocumentUpdate) ClearContentText() *DocumentUpdate {
-	_u.mutation.ClearContentText()
+// SetStorageTier sets the "storage_tier" field.
+func (_u *DocumentUpdate) SetStorageTier(v document.StorageTier) *DocumentUpdate {
+	_u.mutation.SetStorageTier(v)
 	return _u
 }
 
-// SetExtractedMetadata sets the "extracted_metadata" field.
-func (_u *DocumentUpdate) SetExtractedMetadata(v map[string]string) *DocumentUpdate {
-	_u.mutation.SetExtractedMetadata(v)
+// SetNillableStorageTier sets the "storage_tier" field if the given value is not nil.
+func (_u *DocumentUpdate) SetNillableStorageTier(v *document.StorageTier) *DocumentUpdate {
+	if v != nil {
+		_u.SetStorageTier(*v)
+	}
 	return _u
 }
 
-// ClearExtractedMetadata clears the value of the "extracted_metadata" field.
-func (_u *DocumentUpdate) ClearExtractedMetadata() *DocumentUpdate {
-	_u.mutation.ClearExtractedMetadata()
+// SetLastAccessedAt sets the "last_accessed_at" field.
+func (_u *DocumentUpdate) SetLastAccessedAt(v time.Time) *DocumentUpdate {
+	_u.mutation.SetLastAccessedAt(v)
 	return _u
 }
 
-// SetProcessingStatus sets the "processing_status" field.
-func (_u *DocumentUpdate) SetProcessingStatus(v document.ProcessingStatus) *DocumentUpdate {
-	_u.mutation.SetProcessingStatus(v)
+// SetNillableLastAccessedAt sets the "last_accessed_at" field if the given value is not nil.
+func (_u *DocumentUpdate) SetNillableLastAccessedAt(v *time.Time) *DocumentUpdate {
+	if v != nil {
+		_u.SetLastAccessedAt(*v)
+	}
 	return _u
 }
 
-// SetNillableProcessingStatus sets the "processing_status" field if the given value is not nil.
-func (_u *DocumentUpdate) SetNillableProcessingStatus(v *document.ProcessingStatus) *DocumentUpdate {
+// ClearLastAccessedAt clears the value of the "last_accessed_at" field.
+func (_u *DocumentUpdate) ClearLastAccessedAt() *DocumentUpdate {
+	_u.mutation.ClearLastAccessedAt()
+	return _u
+}
+
+// SetDueDate sets the "due_date" field.
+func (_u *DocumentUpdate) SetDueDate(v time.Time) *DocumentUpdate {
+	_u.mutation.SetDueDate(v)
+	return _u
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (_u *DocumentUpdate) SetNillableDueDate(v *time.Time) *DocumentUpdate {
 	if v != nil {
-		_u.SetProcessingStatus(*v)
+		_u.SetDueDate(*v)
+	}
+	return _u
+}
+
+// ClearDueDate clears the value of the "due_date" field.
+func (_u *DocumentUpdate) ClearDueDate() *DocumentUpdate {
+	_u.mutation.ClearDueDate()
+	return _u
+}
+
+// SetRemindedAt sets the "reminded_at" field.
+func (_u *DocumentUpdate) SetRemindedAt(v time.Time) *DocumentUpdate {
+	_u.mutation.SetRemindedAt(v)
+	return _u
+}
+
+// SetNillableRemindedAt sets the "reminded_at" field if the given value is not nil.
+func (_u *DocumentUpdate) SetNillableRemindedAt(v *time.Time) *DocumentUpdate {
+	if v != nil {
+		_u.SetRemindedAt(*v)
+	}
+	return _u
+}
+
+// ClearRemindedAt clears the value of the "reminded_at" field.
+func (_u *DocumentUpdate) ClearRemindedAt() *DocumentUpdate {
+	_u.mutation.ClearRemindedAt()
+	return _u
+}
+
+// SetAssigneeID sets the "assignee_id" field.
+func (_u *DocumentUpdate) SetAssigneeID(v uint32) *DocumentUpdate {
+	_u.mutation.ResetAssigneeID()
+	_u.mutation.SetAssigneeID(v)
+	return _u
+}
+
+// SetNillableAssigneeID sets the "assignee_id" field if the given value is not nil.
+func (_u *DocumentUpdate) SetNillableAssigneeID(v *uint32) *DocumentUpdate {
+	if v != nil {
+		_u.SetAssigneeID(*v)
+	}
+	return _u
+}
+
+// AddAssigneeID adds value to the "assignee_id" field.
+func (_u *DocumentUpdate) AddAssigneeID(v int32) *DocumentUpdate {
+	_u.mutation.AddAssigneeID(v)
+	return _u
+}
+
+// ClearAssigneeID clears the value of the "assignee_id" field.
+func (_u *DocumentUpdate) ClearAssigneeID() *DocumentUpdate {
+	_u.mutation.ClearAssigneeID()
+	return _u
+}
+
+// SetAssignedBy sets the "assigned_by" field.
+func (_u *DocumentUpdate) SetAssignedBy(v uint32) *DocumentUpdate {
+	_u.mutation.ResetAssignedBy()
+	_u.mutation.SetAssignedBy(v)
+	return _u
+}
+
+// SetNillableAssignedBy sets the "assigned_by" field if the given value is not nil.
+func (_u *DocumentUpdate) SetNillableAssignedBy(v *uint32) *DocumentUpdate {
+	if v != nil {
+		_u.SetAssignedBy(*v)
+	}
+	return _u
+}
+
+// AddAssignedBy adds value to the "assigned_by" field.
+func (_u *DocumentUpdate) AddAssignedBy(v int32) *DocumentUpdate {
+	_u.mutation.AddAssignedBy(v)
+	return _u
+}
+
+// ClearAssignedBy clears the value of the "assigned_by" field.
+func (_u *DocumentUpdate) ClearAssignedBy() *DocumentUpdate {
+	_u.mutation.ClearAssignedBy()
+	return _u
+}
+
+// SetAssignedAt sets the "assigned_at" field.
+func (_u *DocumentUpdate) SetAssignedAt(v time.Time) *DocumentUpdate {
+	_u.mutation.SetAssignedAt(v)
+	return _u
+}
+
+// SetNillableAssignedAt sets the "assigned_at" field if the given value is not nil.
+func (_u *DocumentUpdate) SetNillableAssignedAt(v *time.Time) *DocumentUpdate {
+	if v != nil {
+		_u.SetAssignedAt(*v)
+	}
+	return _u
+}
+
+// ClearAssignedAt clears the value of the "assigned_at" field.
+func (_u *DocumentUpdate) ClearAssignedAt() *DocumentUpdate {
+	_u.mutation.ClearAssignedAt()
+	return _u
+}
+
+// SetRenditionKey sets the "rendition_key" field.
+func (_u *DocumentUpdate) SetRenditionKey(v string) *DocumentUpdate {
+	_u.mutation.SetRenditionKey(v)
+	return _u
+}
+
+// SetNillableRenditionKey sets the "rendition_key" field if the given value is not nil.
+func (_u *DocumentUpdate) SetNillableRenditionKey(v *string) *DocumentUpdate {
+	if v != nil {
+		_u.SetRenditionKey(*v)
 	}
 	return _u
 }
 
+// ClearRenditionKey clears the value of the "rendition_key" field.
+func (_u *DocumentUpdate) ClearRenditionKey() *DocumentUpdate {
+	_u.mutation.ClearRenditionKey()
+	return _u
+}
+
 // SetCategory sets the "category" edge to the Category entity.
 func (_u *DocumentUpdate) SetCategory(v *Category) *DocumentUpdate {
 	return _u.SetCategoryID(v.ID)
@@ -374,6 +575,70 @@ func (_u *DocumentUpdate) AddPermissions(v ...*DocumentPermission) *DocumentUpda
 	return _u.AddPermissionIDs(ids...)
 }
 
+// AddSignatureRequestIDs adds the "signature_requests" edge to the SignatureRequest entity by IDs.
+func (_u *DocumentUpdate) AddSignatureRequestIDs(ids ...string) *DocumentUpdate {
+	_u.mutation.AddSignatureRequestIDs(ids...)
+	return _u
+}
+
+// AddSignatureRequests adds the "signature_requests" edges to the SignatureRequest entity.
+func (_u *DocumentUpdate) AddSignatureRequests(v ...*SignatureRequest) *DocumentUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSignatureRequestIDs(ids...)
+}
+
+// AddReviewTaskIDs adds the "review_tasks" edge to the ReviewTask entity by IDs.
+func (_u *DocumentUpdate) AddReviewTaskIDs(ids ...string) *DocumentUpdate {
+	_u.mutation.AddReviewTaskIDs(ids...)
+	return _u
+}
+
+// AddReviewTasks adds the "review_tasks" edges to the ReviewTask entity.
+func (_u *DocumentUpdate) AddReviewTasks(v ...*ReviewTask) *DocumentUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReviewTaskIDs(ids...)
+}
+
+// AddDocumentTagIDs adds the "document_tags" edge to the DocumentTag entity by IDs.
+func (_u *DocumentUpdate) AddDocumentTagIDs(ids ...uint32) *DocumentUpdate {
+	_u.mutation.AddDocumentTagIDs(ids...)
+	return _u
+}
+
+// AddDocumentTags adds the "document_tags" edges to the DocumentTag entity.
+func (_u *DocumentUpdate) AddDocumentTags(v ...*DocumentTag) *DocumentUpdate {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDocumentTagIDs(ids...)
+}
+
+// SetContentID sets the "content" edge to the DocumentContent entity by ID.
+func (_u *DocumentUpdate) SetContentID(id uint32) *DocumentUpdate {
+	_u.mutation.SetContentID(id)
+	return _u
+}
+
+// SetNillableContentID sets the "content" edge to the DocumentContent entity by ID if the given value is not nil.
+func (_u *DocumentUpdate) SetNillableContentID(id *uint32) *DocumentUpdate {
+	if id != nil {
+		_u = _u.SetContentID(*id)
+	}
+	return _u
+}
+
+// SetContent sets the "content" edge to the DocumentContent entity.
+func (_u *DocumentUpdate) SetContent(v *DocumentContent) *DocumentUpdate {
+	return _u.SetContentID(v.ID)
+}
+
 // Mutation returns the DocumentMutation object of the builder.
 func (_u *DocumentUpdate) Mutation() *DocumentMutation {
 	return _u.mutation
@@ -406,6 +671,75 @@ func (_u *DocumentUpdate) RemovePermissions(v ...*DocumentPermission) *DocumentU
 	return _u.RemovePermissionIDs(ids...)
 }
 
+// ClearSignatureRequests clears all "signature_requests" edges to the SignatureRequest entity.
+func (_u *DocumentUpdate) ClearSignatureRequests() *DocumentUpdate {
+	_u.mutation.ClearSignatureRequests()
+	return _u
+}
+
+// RemoveSignatureRequestIDs removes the "signature_requests" edge to SignatureRequest entities by IDs.
+func (_u *DocumentUpdate) RemoveSignatureRequestIDs(ids ...string) *DocumentUpdate {
+	_u.mutation.RemoveSignatureRequestIDs(ids...)
+	return _u
+}
+
+// RemoveSignatureRequests removes "signature_requests" edges to SignatureRequest entities.
+func (_u *DocumentUpdate) RemoveSignatureRequests(v ...*SignatureRequest) *DocumentUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSignatureRequestIDs(ids...)
+}
+
+// ClearReviewTasks clears all "review_tasks" edges to the ReviewTask entity.
+func (_u *DocumentUpdate) ClearReviewTasks() *DocumentUpdate {
+	_u.mutation.ClearReviewTasks()
+	return _u
+}
+
+// RemoveReviewTaskIDs removes the "review_tasks" edge to ReviewTask entities by IDs.
+func (_u *DocumentUpdate) RemoveReviewTaskIDs(ids ...string) *DocumentUpdate {
+	_u.mutation.RemoveReviewTaskIDs(ids...)
+	return _u
+}
+
+// RemoveReviewTasks removes "review_tasks" edges to ReviewTask entities.
+func (_u *DocumentUpdate) RemoveReviewTasks(v ...*ReviewTask) *DocumentUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReviewTaskIDs(ids...)
+}
+
+// ClearDocumentTags clears all "document_tags" edges to the DocumentTag entity.
+func (_u *DocumentUpdate) ClearDocumentTags() *DocumentUpdate {
+	_u.mutation.ClearDocumentTags()
+	return _u
+}
+
+// RemoveDocumentTagIDs removes the "document_tags" edge to DocumentTag entities by IDs.
+func (_u *DocumentUpdate) RemoveDocumentTagIDs(ids ...uint32) *DocumentUpdate {
+	_u.mutation.RemoveDocumentTagIDs(ids...)
+	return _u
+}
+
+// RemoveDocumentTags removes "document_tags" edges to DocumentTag entities.
+func (_u *DocumentUpdate) RemoveDocumentTags(v ...*DocumentTag) *DocumentUpdate {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDocumentTagIDs(ids...)
+}
+
+// ClearContent clears the "content" edge to the DocumentContent entity.
+func (_u *DocumentUpdate) ClearContent() *DocumentUpdate {
+	_u.mutation.ClearContent()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *DocumentUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -465,6 +799,16 @@ func (_u *DocumentUpdate) check() error {
 			return &ValidationError{Name: "checksum", err: fmt.Errorf(`ent: validator failed for field "Document.checksum": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.DocumentType(); ok {
+		if err := document.DocumentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "document_type", err: fmt.Errorf(`ent: validator failed for field "Document.document_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RetentionClass(); ok {
+		if err := document.RetentionClassValidator(v); err != nil {
+			return &ValidationError{Name: "retention_class", err: fmt.Errorf(`ent: validator failed for field "Document.retention_class": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Status(); ok {
 		if err := document.StatusValidator(v); err != nil {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Document.status": %w`, err)}
@@ -480,6 +824,11 @@ func (_u *DocumentUpdate) check() error {
 			return &ValidationError{Name: "processing_status", err: fmt.Errorf(`ent: validator failed for field "Document.processing_status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.StorageTier(); ok {
+		if err := document.StorageTierValidator(v); err != nil {
+			return &ValidationError{Name: "storage_tier", err: fmt.Errorf(`ent: validator failed for field "Document.storage_tier": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -537,6 +886,12 @@ func (_u *DocumentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.TenantIDCleared() {
 		_spec.ClearField(document.FieldTenantID, field.TypeUint32)
 	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(document.FieldVersion, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(document.FieldVersion, field.TypeUint32, value)
+	}
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(document.FieldName, field.TypeString, value)
 	}
@@ -576,26 +931,77 @@ func (_u *DocumentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.TagsCleared() {
 		_spec.ClearField(document.FieldTags, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.DocumentType(); ok {
+		_spec.SetField(document.FieldDocumentType, field.TypeString, value)
+	}
+	if _u.mutation.DocumentTypeCleared() {
+		_spec.ClearField(document.FieldDocumentType, field.TypeString)
+	}
+	if value, ok := _u.mutation.RetentionClass(); ok {
+		_spec.SetField(document.FieldRetentionClass, field.TypeString, value)
+	}
+	if _u.mutation.RetentionClassCleared() {
+		_spec.ClearField(document.FieldRetentionClass, field.TypeString)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(document.FieldStatus, field.TypeEnum, value)
 	}
 	if value, ok := _u.mutation.Source(); ok {
 		_spec.SetField(document.FieldSource, field.TypeEnum, value)
 	}
-	if value, ok := _u.mutation.ContentText(); ok {
-		_spec.SetField(document.FieldContentText, field.TypeString, value)
+	if value, ok := _u.mutation.ProcessingStatus(); ok {
+		_spec.SetField(document.FieldProcessingStatus, field.TypeEnum, value)
 	}
-	if _u.mutation.ContentTextCleared() {
-		_spec.ClearField(document.FieldContentText, field.TypeString)
+	if value, ok := _u.mutation.StorageTier(); ok {
+		_spec.SetField(document.FieldStorageTier, field.TypeEnum, value)
 	}
-	if value, ok := _u.mutation.ExtractedMetadata(); ok {
-		_spec.SetField(document.FieldExtractedMetadata, field.TypeJSON, value)
+	if value, ok := _u.mutation.LastAccessedAt(); ok {
+		_spec.SetField(document.FieldLastAccessedAt, field.TypeTime, value)
 	}
-	if _u.mutation.ExtractedMetadataCleared() {
-		_spec.ClearField(document.FieldExtractedMetadata, field.TypeJSON)
+	if _u.mutation.LastAccessedAtCleared() {
+		_spec.ClearField(document.FieldLastAccessedAt, field.TypeTime)
 	}
-	if value, ok := _u.mutation.ProcessingStatus(); ok {
-		_spec.SetField(document.FieldProcessingStatus, field.TypeEnum, value)
+	if value, ok := _u.mutation.DueDate(); ok {
+		_spec.SetField(document.FieldDueDate, field.TypeTime, value)
+	}
+	if _u.mutation.DueDateCleared() {
+		_spec.ClearField(document.FieldDueDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RemindedAt(); ok {
+		_spec.SetField(document.FieldRemindedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RemindedAtCleared() {
+		_spec.ClearField(document.FieldRemindedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AssigneeID(); ok {
+		_spec.SetField(document.FieldAssigneeID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedAssigneeID(); ok {
+		_spec.AddField(document.FieldAssigneeID, field.TypeUint32, value)
+	}
+	if _u.mutation.AssigneeIDCleared() {
+		_spec.ClearField(document.FieldAssigneeID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.AssignedBy(); ok {
+		_spec.SetField(document.FieldAssignedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedAssignedBy(); ok {
+		_spec.AddField(document.FieldAssignedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.AssignedByCleared() {
+		_spec.ClearField(document.FieldAssignedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.AssignedAt(); ok {
+		_spec.SetField(document.FieldAssignedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AssignedAtCleared() {
+		_spec.ClearField(document.FieldAssignedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RenditionKey(); ok {
+		_spec.SetField(document.FieldRenditionKey, field.TypeString, value)
+	}
+	if _u.mutation.RenditionKeyCleared() {
+		_spec.ClearField(document.FieldRenditionKey, field.TypeString)
 	}
 	if _u.mutation.CategoryCleared() {
 		edge := &sqlgraph.EdgeSpec{
@@ -671,39 +1077,203 @@ func (_u *DocumentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec.AddModifiers(_u.modifiers...)
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{document.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.SignatureRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   document.SignatureRequestsTable,
+			Columns: []string{document.SignatureRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(signaturerequest.FieldID, field.TypeString),
+			},
 		}
-		return 0, err
-	}
-	_u.mutation.done = true
-	return _node, nil
-}
-
-// DocumentUpdateOne is the builder for updating a single Document entity.
-type DocumentUpdateOne struct {
-	config
-	fields    []string
-	hooks     []Hook
-	mutation  *DocumentMutation
-	modifiers []func(*sql.UpdateBuilder)
-}
-
-// SetCreateBy sets the "create_by" field.
-func (_u *DocumentUpdateOne) SetCreateBy(v uint32) *DocumentUpdateOne {
-	_u.mutation.ResetCreateBy()
-	_u.mutation.SetCreateBy(v)
-	return _u
-}
-
-// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
-func (_u *DocumentUpdateOne) SetNillableCreateBy(v *uint32) *DocumentUpdateOne {
-	if v != nil {
-		_u.SetCreateBy(*v)
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSignatureRequestsIDs(); len(nodes) > 0 && !_u.mutation.SignatureRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   document.SignatureRequestsTable,
+			Columns: []string{document.SignatureRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(signaturerequest.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SignatureRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   document.SignatureRequestsTable,
+			Columns: []string{document.SignatureRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(signaturerequest.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReviewTasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   document.ReviewTasksTable,
+			Columns: []string{document.ReviewTasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reviewtask.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReviewTasksIDs(); len(nodes) > 0 && !_u.mutation.ReviewTasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   document.ReviewTasksTable,
+			Columns: []string{document.ReviewTasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reviewtask.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReviewTasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   document.ReviewTasksTable,
+			Columns: []string{document.ReviewTasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reviewtask.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DocumentTagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   document.DocumentTagsTable,
+			Columns: []string{document.DocumentTagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documenttag.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDocumentTagsIDs(); len(nodes) > 0 && !_u.mutation.DocumentTagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   document.DocumentTagsTable,
+			Columns: []string{document.DocumentTagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documenttag.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DocumentTagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   document.DocumentTagsTable,
+			Columns: []string{document.DocumentTagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documenttag.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ContentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   document.ContentTable,
+			Columns: []string{document.ContentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documentcontent.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ContentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   document.ContentTable,
+			Columns: []string{document.ContentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documentcontent.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{document.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DocumentUpdateOne is the builder for updating a single Document entity.
+type DocumentUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *DocumentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *DocumentUpdateOne) SetCreateBy(v uint32) *DocumentUpdateOne {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *DocumentUpdateOne) SetNillableCreateBy(v *uint32) *DocumentUpdateOne {
+	if v != nil {
+		_u.SetCreateBy(*v)
 	}
 	return _u
 }
@@ -787,6 +1357,27 @@ func (_u *DocumentUpdateOne) ClearDeleteTime() *DocumentUpdateOne {
 	return _u
 }
 
+// SetVersion sets the "version" field.
+func (_u *DocumentUpdateOne) SetVersion(v uint32) *DocumentUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *DocumentUpdateOne) SetNillableVersion(v *uint32) *DocumentUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *DocumentUpdateOne) AddVersion(v int32) *DocumentUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
 // SetCategoryID sets the "category_id" field.
 func (_u *DocumentUpdateOne) SetCategoryID(v string) *DocumentUpdateOne {
 	_u.mutation.SetCategoryID(v)
@@ -942,6 +1533,46 @@ func (_u *DocumentUpdateOne) ClearTags() *DocumentUpdateOne {
 	return _u
 }
 
+// SetDocumentType sets the "document_type" field.
+func (_u *DocumentUpdateOne) SetDocumentType(v string) *DocumentUpdateOne {
+	_u.mutation.SetDocumentType(v)
+	return _u
+}
+
+// SetNillableDocumentType sets the "document_type" field if the given value is not nil.
+func (_u *DocumentUpdateOne) SetNillableDocumentType(v *string) *DocumentUpdateOne {
+	if v != nil {
+		_u.SetDocumentType(*v)
+	}
+	return _u
+}
+
+// ClearDocumentType clears the value of the "document_type" field.
+func (_u *DocumentUpdateOne) ClearDocumentType() *DocumentUpdateOne {
+	_u.mutation.ClearDocumentType()
+	return _u
+}
+
+// SetRetentionClass sets the "retention_class" field.
+func (_u *DocumentUpdateOne) SetRetentionClass(v string) *DocumentUpdateOne {
+	_u.mutation.SetRetentionClass(v)
+	return _u
+}
+
+// SetNillableRetentionClass sets the "retention_class" field if the given value is not nil.
+func (_u *DocumentUpdateOne) SetNillableRetentionClass(v *string) *DocumentUpdateOne {
+	if v != nil {
+		_u.SetRetentionClass(*v)
+	}
+	return _u
+}
+
+// ClearRetentionClass clears the value of the "retention_class" field.
+func (_u *DocumentUpdateOne) ClearRetentionClass() *DocumentUpdateOne {
+	_u.mutation.ClearRetentionClass()
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *DocumentUpdateOne) SetStatus(v document.Status) *DocumentUpdateOne {
 	_u.mutation.SetStatus(v)
@@ -970,52 +1601,188 @@ func (_u *DocumentUpdateOne) SetNillableSource(v *document.Source) *DocumentUpda
 	return _u
 }
 
-// SetContentText sets the "content_text" field.
-func (_u *DocumentUpdateOne) SetContentText(v string) *DocumentUpdateOne {
-	_u.mutation.SetContentText(v)
+// SetProcessingStatus sets the "processing_status" field.
+func (_u *DocumentUpdateOne) SetProcessingStatus(v document.ProcessingStatus) *DocumentUpdateOne {
+	_u.mutation.SetProcessingStatus(v)
 	return _u
 }
 
-// SetNillableContentText sets the "content_text" field if the given value is not nil.
-func (_u *DocumentUpdateOne) SetNillableContentText(v *string) *DocumentUpdateOne {
+// SetNillableProcessingStatus sets the "processing_status" field if the given value is not nil.
+func (_u *DocumentUpdateOne) SetNillableProcessingStatus(v *document.ProcessingStatus) *DocumentUpdateOne {
 	if v != nil {
-		_u.SetContentText(*v)
+		_u.SetProcessingStatus(*v)
 	}
 	return _u
 }
 
-// ClearContentText clears the value of the "content_text" field.
-func (_u *DocumentUpdateOne) ClearContentText() *DocumentUpdateOne {
-	_u.mutation.ClearContentText()
+// SetStorageTier sets the "storage_tier" field.
+func (_u *DocumentUpdateOne) SetStorageTier(v document.StorageTier) *DocumentUpdateOne {
+	_u.mutation.SetStorageTier(v)
 	return _u
 }
 
-// SetExtractedMetadata sets the "extracted_metadata" field.
-func (_u *DocumentUpdateOne) SetExtractedMetadata(v map[string]string) *DocumentUpdateOne {
-	_u.mutation.SetExtractedMetadata(v)
+// SetNillableStorageTier sets the "storage_tier" field if the given value is not nil.
+func (_u *DocumentUpdateOne) SetNillableStorageTier(v *document.StorageTier) *DocumentUpdateOne {
+	if v != nil {
+		_u.SetStorageTier(*v)
+	}
 	return _u
 }
 
-// ClearExtractedMetadata clears the value of the "extracted_metadata" field.
-func (_u *DocumentUpdateOne) ClearExtractedMetadata() *DocumentUpdateOne {
-	_u.mutation.ClearExtractedMetadata()
+// SetLastAccessedAt sets the "last_accessed_at" field.
+func (_u *DocumentUpdateOne) SetLastAccessedAt(v time.Time) *DocumentUpdateOne {
+	_u.mutation.SetLastAccessedAt(v)
 	return _u
 }
 
-// SetProcessingStatus sets the "processing_status" field.
-func (_u *DocumentUpdateOne) SetProcessingStatus(v document.ProcessingStatus) *DocumentUpdateOne {
-	_u.mutation.SetProcessingStatus(v)
+// SetNillableLastAccessedAt sets the "last_accessed_at" field if the given value is not nil.
+func (_u *DocumentUpdateOne) SetNillableLastAccessedAt(v *time.Time) *DocumentUpdateOne {
+	if v != nil {
+		_u.SetLastAccessedAt(*v)
+	}
 	return _u
 }
 
-// SetNillableProcessingStatus sets the "processing_status" field if the given value is not nil.
-func (_u *DocumentUpdateOne) SetNillableProcessingStatus(v *document.ProcessingStatus) *DocumentUpdateOne {
+// ClearLastAccessedAt clears the value of the "last_accessed_at" field.
+func (_u *DocumentUpdateOne) ClearLastAccessedAt() *DocumentUpdateOne {
+	_u.mutation.ClearLastAccessedAt()
+	return _u
+}
+
+// SetDueDate sets the "due_date" field.
+func (_u *DocumentUpdateOne) SetDueDate(v time.Time) *DocumentUpdateOne {
+	_u.mutation.SetDueDate(v)
+	return _u
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (_u *DocumentUpdateOne) SetNillableDueDate(v *time.Time) *DocumentUpdateOne {
 	if v != nil {
-		_u.SetProcessingStatus(*v)
+		_u.SetDueDate(*v)
+	}
+	return _u
+}
+
+// ClearDueDate clears the value of the "due_date" field.
+func (_u *DocumentUpdateOne) ClearDueDate() *DocumentUpdateOne {
+	_u.mutation.ClearDueDate()
+	return _u
+}
+
+// SetRemindedAt sets the "reminded_at" field.
+func (_u *DocumentUpdateOne) SetRemindedAt(v time.Time) *DocumentUpdateOne {
+	_u.mutation.SetRemindedAt(v)
+	return _u
+}
+
+// SetNillableRemindedAt sets the "reminded_at" field if the given value is not nil.
+func (_u *DocumentUpdateOne) SetNillableRemindedAt(v *time.Time) *DocumentUpdateOne {
+	if v != nil {
+		_u.SetRemindedAt(*v)
+	}
+	return _u
+}
+
+// ClearRemindedAt clears the value of the "reminded_at" field.
+func (_u *DocumentUpdateOne) ClearRemindedAt() *DocumentUpdateOne {
+	_u.mutation.ClearRemindedAt()
+	return _u
+}
+
+// SetAssigneeID sets the "assignee_id" field.
+func (_u *DocumentUpdateOne) SetAssigneeID(v uint32) *DocumentUpdateOne {
+	_u.mutation.ResetAssigneeID()
+	_u.mutation.SetAssigneeID(v)
+	return _u
+}
+
+// SetNillableAssigneeID sets the "assignee_id" field if the given value is not nil.
+func (_u *DocumentUpdateOne) SetNillableAssigneeID(v *uint32) *DocumentUpdateOne {
+	if v != nil {
+		_u.SetAssigneeID(*v)
+	}
+	return _u
+}
+
+// AddAssigneeID adds value to the "assignee_id" field.
+func (_u *DocumentUpdateOne) AddAssigneeID(v int32) *DocumentUpdateOne {
+	_u.mutation.AddAssigneeID(v)
+	return _u
+}
+
+// ClearAssigneeID clears the value of the "assignee_id" field.
+func (_u *DocumentUpdateOne) ClearAssigneeID() *DocumentUpdateOne {
+	_u.mutation.ClearAssigneeID()
+	return _u
+}
+
+// SetAssignedBy sets the "assigned_by" field.
+func (_u *DocumentUpdateOne) SetAssignedBy(v uint32) *DocumentUpdateOne {
+	_u.mutation.ResetAssignedBy()
+	_u.mutation.SetAssignedBy(v)
+	return _u
+}
+
+// SetNillableAssignedBy sets the "assigned_by" field if the given value is not nil.
+func (_u *DocumentUpdateOne) SetNillableAssignedBy(v *uint32) *DocumentUpdateOne {
+	if v != nil {
+		_u.SetAssignedBy(*v)
+	}
+	return _u
+}
+
+// AddAssignedBy adds value to the "assigned_by" field.
+func (_u *DocumentUpdateOne) AddAssignedBy(v int32) *DocumentUpdateOne {
+	_u.mutation.AddAssignedBy(v)
+	return _u
+}
+
+// ClearAssignedBy clears the value of the "assigned_by" field.
+func (_u *DocumentUpdateOne) ClearAssignedBy() *DocumentUpdateOne {
+	_u.mutation.ClearAssignedBy()
+	return _u
+}
+
+// SetAssignedAt sets the "assigned_at" field.
+func (_u *DocumentUpdateOne) SetAssignedAt(v time.Time) *DocumentUpdateOne {
+	_u.mutation.SetAssignedAt(v)
+	return _u
+}
+
+// SetNillableAssignedAt sets the "assigned_at" field if the given value is not nil.
+func (_u *DocumentUpdateOne) SetNillableAssignedAt(v *time.Time) *DocumentUpdateOne {
+	if v != nil {
+		_u.SetAssignedAt(*v)
+	}
+	return _u
+}
+
+// ClearAssignedAt clears the value of the "assigned_at" field.
+func (_u *DocumentUpdateOne) ClearAssignedAt() *DocumentUpdateOne {
+	_u.mutation.ClearAssignedAt()
+	return _u
+}
+
+// SetRenditionKey sets the "rendition_key" field.
+func (_u *DocumentUpdateOne) SetRenditionKey(v string) *DocumentUpdateOne {
+	_u.mutation.SetRenditionKey(v)
+	return _u
+}
+
+// SetNillableRenditionKey sets the "rendition_key" field if the given value is not nil.
+func (_u *DocumentUpdateOne) SetNillableRenditionKey(v *string) *DocumentUpdateOne {
+	if v != nil {
+		_u.SetRenditionKey(*v)
 	}
 	return _u
 }
 
+// ClearRenditionKey clears the value of the "rendition_key" field.
+func (_u *DocumentUpdateOne) ClearRenditionKey() *DocumentUpdateOne {
+	_u.mutation.ClearRenditionKey()
+	return _u
+}
+
 // SetCategory sets the "category" edge to the Category entity.
 func (_u *DocumentUpdateOne) SetCategory(v *Category) *DocumentUpdateOne {
 	return _u.SetCategoryID(v.ID)
@@ -1036,6 +1803,70 @@ func (_u *DocumentUpdateOne) AddPermissions(v ...*DocumentPermission) *DocumentU
 	return _u.AddPermissionIDs(ids...)
 }
 
+// AddSignatureRequestIDs adds the "signature_requests" edge to the SignatureRequest entity by IDs.
+func (_u *DocumentUpdateOne) AddSignatureRequestIDs(ids ...string) *DocumentUpdateOne {
+	_u.mutation.AddSignatureRequestIDs(ids...)
+	return _u
+}
+
+// AddSignatureRequests adds the "signature_requests" edges to the SignatureRequest entity.
+func (_u *DocumentUpdateOne) AddSignatureRequests(v ...*SignatureRequest) *DocumentUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSignatureRequestIDs(ids...)
+}
+
+// AddReviewTaskIDs adds the "review_tasks" edge to the ReviewTask entity by IDs.
+func (_u *DocumentUpdateOne) AddReviewTaskIDs(ids ...string) *DocumentUpdateOne {
+	_u.mutation.AddReviewTaskIDs(ids...)
+	return _u
+}
+
+// AddReviewTasks adds the "review_tasks" edges to the ReviewTask entity.
+func (_u *DocumentUpdateOne) AddReviewTasks(v ...*ReviewTask) *DocumentUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReviewTaskIDs(ids...)
+}
+
+// AddDocumentTagIDs adds the "document_tags" edge to the DocumentTag entity by IDs.
+func (_u *DocumentUpdateOne) AddDocumentTagIDs(ids ...uint32) *DocumentUpdateOne {
+	_u.mutation.AddDocumentTagIDs(ids...)
+	return _u
+}
+
+// AddDocumentTags adds the "document_tags" edges to the DocumentTag entity.
+func (_u *DocumentUpdateOne) AddDocumentTags(v ...*DocumentTag) *DocumentUpdateOne {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDocumentTagIDs(ids...)
+}
+
+// SetContentID sets the "content" edge to the DocumentContent entity by ID.
+func (_u *DocumentUpdateOne) SetContentID(id uint32) *DocumentUpdateOne {
+	_u.mutation.SetContentID(id)
+	return _u
+}
+
+// SetNillableContentID sets the "content" edge to the DocumentContent entity by ID if the given value is not nil.
+func (_u *DocumentUpdateOne) SetNillableContentID(id *uint32) *DocumentUpdateOne {
+	if id != nil {
+		_u = _u.SetContentID(*id)
+	}
+	return _u
+}
+
+// SetContent sets the "content" edge to the DocumentContent entity.
+func (_u *DocumentUpdateOne) SetContent(v *DocumentContent) *DocumentUpdateOne {
+	return _u.SetContentID(v.ID)
+}
+
 // Mutation returns the DocumentMutation object of the builder.
 func (_u *DocumentUpdateOne) Mutation() *DocumentMutation {
 	return _u.mutation
@@ -1068,6 +1899,75 @@ func (_u *DocumentUpdateOne) RemovePermissions(v ...*DocumentPermission) *Docume
 	return _u.RemovePermissionIDs(ids...)
 }
 
+// ClearSignatureRequests clears all "signature_requests" edges to the SignatureRequest entity.
+func (_u *DocumentUpdateOne) ClearSignatureRequests() *DocumentUpdateOne {
+	_u.mutation.ClearSignatureRequests()
+	return _u
+}
+
+// RemoveSignatureRequestIDs removes the "signature_requests" edge to SignatureRequest entities by IDs.
+func (_u *DocumentUpdateOne) RemoveSignatureRequestIDs(ids ...string) *DocumentUpdateOne {
+	_u.mutation.RemoveSignatureRequestIDs(ids...)
+	return _u
+}
+
+// RemoveSignatureRequests removes "signature_requests" edges to SignatureRequest entities.
+func (_u *DocumentUpdateOne) RemoveSignatureRequests(v ...*SignatureRequest) *DocumentUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSignatureRequestIDs(ids...)
+}
+
+// ClearReviewTasks clears all "review_tasks" edges to the ReviewTask entity.
+func (_u *DocumentUpdateOne) ClearReviewTasks() *DocumentUpdateOne {
+	_u.mutation.ClearReviewTasks()
+	return _u
+}
+
+// RemoveReviewTaskIDs removes the "review_tasks" edge to ReviewTask entities by IDs.
+func (_u *DocumentUpdateOne) RemoveReviewTaskIDs(ids ...string) *DocumentUpdateOne {
+	_u.mutation.RemoveReviewTaskIDs(ids...)
+	return _u
+}
+
+// RemoveReviewTasks removes "review_tasks" edges to ReviewTask entities.
+func (_u *DocumentUpdateOne) RemoveReviewTasks(v ...*ReviewTask) *DocumentUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReviewTaskIDs(ids...)
+}
+
+// ClearDocumentTags clears all "document_tags" edges to the DocumentTag entity.
+func (_u *DocumentUpdateOne) ClearDocumentTags() *DocumentUpdateOne {
+	_u.mutation.ClearDocumentTags()
+	return _u
+}
+
+// RemoveDocumentTagIDs removes the "document_tags" edge to DocumentTag entities by IDs.
+func (_u *DocumentUpdateOne) RemoveDocumentTagIDs(ids ...uint32) *DocumentUpdateOne {
+	_u.mutation.RemoveDocumentTagIDs(ids...)
+	return _u
+}
+
+// RemoveDocumentTags removes "document_tags" edges to DocumentTag entities.
+func (_u *DocumentUpdateOne) RemoveDocumentTags(v ...*DocumentTag) *DocumentUpdateOne {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDocumentTagIDs(ids...)
+}
+
+// ClearContent clears the "content" edge to the DocumentContent entity.
+func (_u *DocumentUpdateOne) ClearContent() *DocumentUpdateOne {
+	_u.mutation.ClearContent()
+	return _u
+}
+
 // Where appends a list predicates to the DocumentUpdate builder.
 func (_u *DocumentUpdateOne) Where(ps ...predicate.Document) *DocumentUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1140,6 +2040,16 @@ func (_u *DocumentUpdateOne) check() error {
 			return &ValidationError{Name: "checksum", err: fmt.Errorf(`ent: validator failed for field "Document.checksum": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.DocumentType(); ok {
+		if err := document.DocumentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "document_type", err: fmt.Errorf(`ent: validator failed for field "Document.document_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RetentionClass(); ok {
+		if err := document.RetentionClassValidator(v); err != nil {
+			return &ValidationError{Name: "retention_class", err: fmt.Errorf(`ent: validator failed for field "Document.retention_class": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Status(); ok {
 		if err := document.StatusValidator(v); err != nil {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Document.status": %w`, err)}
@@ -1155,6 +2065,11 @@ func (_u *DocumentUpdateOne) check() error {
 			return &ValidationError{Name: "processing_status", err: fmt.Errorf(`ent: validator failed for field "Document.processing_status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.StorageTier(); ok {
+		if err := document.StorageTierValidator(v); err != nil {
+			return &ValidationError{Name: "storage_tier", err: fmt.Errorf(`ent: validator failed for field "Document.storage_tier": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1229,6 +2144,12 @@ func (_u *DocumentUpdateOne) sqlSave(ctx context.Context) (_node *Document, err
 	if _u.mutation.TenantIDCleared() {
 		_spec.ClearField(document.FieldTenantID, field.TypeUint32)
 	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(document.FieldVersion, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(document.FieldVersion, field.TypeUint32, value)
+	}
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(document.FieldName, field.TypeString, value)
 	}
@@ -1268,26 +2189,77 @@ func (_u *DocumentUpdateOne) sqlSave(ctx context.Context) (_node *Document, err
 	if _u.mutation.TagsCleared() {
 		_spec.ClearField(document.FieldTags, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.DocumentType(); ok {
+		_spec.SetField(document.FieldDocumentType, field.TypeString, value)
+	}
+	if _u.mutation.DocumentTypeCleared() {
+		_spec.ClearField(document.FieldDocumentType, field.TypeString)
+	}
+	if value, ok := _u.mutation.RetentionClass(); ok {
+		_spec.SetField(document.FieldRetentionClass, field.TypeString, value)
+	}
+	if _u.mutation.RetentionClassCleared() {
+		_spec.ClearField(document.FieldRetentionClass, field.TypeString)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(document.FieldStatus, field.TypeEnum, value)
 	}
 	if value, ok := _u.mutation.Source(); ok {
 		_spec.SetField(document.FieldSource, field.TypeEnum, value)
 	}
-	if value, ok := _u.mutation.ContentText(); ok {
-		_spec.SetField(document.FieldContentText, field.TypeString, value)
+	if value, ok := _u.mutation.ProcessingStatus(); ok {
+		_spec.SetField(document.FieldProcessingStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.StorageTier(); ok {
+		_spec.SetField(document.FieldStorageTier, field.TypeEnum, value)
 	}
-	if _u.mutation.ContentTextCleared() {
-		_spec.ClearField(document.FieldContentText, field.TypeString)
+	if value, ok := _u.mutation.LastAccessedAt(); ok {
+		_spec.SetField(document.FieldLastAccessedAt, field.TypeTime, value)
 	}
-	if value, ok := _u.mutation.ExtractedMetadata(); ok {
-		_spec.SetField(document.FieldExtractedMetadata, field.TypeJSON, value)
+	if _u.mutation.LastAccessedAtCleared() {
+		_spec.ClearField(document.FieldLastAccessedAt, field.TypeTime)
 	}
-	if _u.mutation.ExtractedMetadataCleared() {
-		_spec.ClearField(document.FieldExtractedMetadata, field.TypeJSON)
+	if value, ok := _u.mutation.DueDate(); ok {
+		_spec.SetField(document.FieldDueDate, field.TypeTime, value)
 	}
-	if value, ok := _u.mutation.ProcessingStatus(); ok {
-		_spec.SetField(document.FieldProcessingStatus, field.TypeEnum, value)
+	if _u.mutation.DueDateCleared() {
+		_spec.ClearField(document.FieldDueDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RemindedAt(); ok {
+		_spec.SetField(document.FieldRemindedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RemindedAtCleared() {
+		_spec.ClearField(document.FieldRemindedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AssigneeID(); ok {
+		_spec.SetField(document.FieldAssigneeID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedAssigneeID(); ok {
+		_spec.AddField(document.FieldAssigneeID, field.TypeUint32, value)
+	}
+	if _u.mutation.AssigneeIDCleared() {
+		_spec.ClearField(document.FieldAssigneeID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.AssignedBy(); ok {
+		_spec.SetField(document.FieldAssignedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedAssignedBy(); ok {
+		_spec.AddField(document.FieldAssignedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.AssignedByCleared() {
+		_spec.ClearField(document.FieldAssignedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.AssignedAt(); ok {
+		_spec.SetField(document.FieldAssignedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AssignedAtCleared() {
+		_spec.ClearField(document.FieldAssignedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RenditionKey(); ok {
+		_spec.SetField(document.FieldRenditionKey, field.TypeString, value)
+	}
+	if _u.mutation.RenditionKeyCleared() {
+		_spec.ClearField(document.FieldRenditionKey, field.TypeString)
 	}
 	if _u.mutation.CategoryCleared() {
 		edge := &sqlgraph.EdgeSpec{
@@ -1363,6 +2335,170 @@ func (_u *DocumentUpdateOne) sqlSave(ctx context.Context) (_node *Document, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SignatureRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   document.SignatureRequestsTable,
+			Columns: []string{document.SignatureRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(signaturerequest.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSignatureRequestsIDs(); len(nodes) > 0 && !_u.mutation.SignatureRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   document.SignatureRequestsTable,
+			Columns: []string{document.SignatureRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(signaturerequest.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SignatureRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   document.SignatureRequestsTable,
+			Columns: []string{document.SignatureRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(signaturerequest.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReviewTasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   document.ReviewTasksTable,
+			Columns: []string{document.ReviewTasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reviewtask.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReviewTasksIDs(); len(nodes) > 0 && !_u.mutation.ReviewTasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   document.ReviewTasksTable,
+			Columns: []string{document.ReviewTasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reviewtask.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReviewTasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   document.ReviewTasksTable,
+			Columns: []string{document.ReviewTasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reviewtask.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DocumentTagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   document.DocumentTagsTable,
+			Columns: []string{document.DocumentTagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documenttag.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDocumentTagsIDs(); len(nodes) > 0 && !_u.mutation.DocumentTagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   document.DocumentTagsTable,
+			Columns: []string{document.DocumentTagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documenttag.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DocumentTagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   document.DocumentTagsTable,
+			Columns: []string{document.DocumentTagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documenttag.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ContentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   document.ContentTable,
+			Columns: []string{document.ContentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documentcontent.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ContentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   document.ContentTable,
+			Columns: []string{document.ContentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documentcontent.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &Document{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -0,0 +1,207 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/document"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documentcontent"
+)
+
+// DocumentContent is the model entity for the DocumentContent schema.
+type DocumentContent struct {
+	config `json:"-"`
+	// ID of the ent.
+	// id
+	ID uint32 `json:"id,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// Document the content was extracted from
+	DocumentID string `json:"document_id,omitempty"`
+	// Extracted text content for full-text search (empty when stored compressed, the start of the text when archived)
+	ContentText string `json:"content_text,omitempty"`
+	// Gzip-compressed extracted text, used instead of content_text for large texts
+	ContentTextCompressed []byte `json:"content_text_compressed,omitempty"`
+	// Distinct lowercase words of compressed or archived extracted text, for full-text search
+	SearchTerms string `json:"search_terms,omitempty"`
+	// Storage key of the full extracted text when only its start is stored
+	ContentTextKey string `json:"content_text_key,omitempty"`
+	// Metadata extracted by Tika (author, title, page_count, etc.)
+	ExtractedMetadata map[string]string `json:"extracted_metadata,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DocumentContentQuery when eager-loading is set.
+	Edges        DocumentContentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// DocumentContentEdges holds the relations/edges for other nodes in the graph.
+type DocumentContentEdges struct {
+	// Document holds the value of the document edge.
+	Document *Document `json:"document,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// DocumentOrErr returns the Document value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DocumentContentEdges) DocumentOrErr() (*Document, error) {
+	if e.Document != nil {
+		return e.Document, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: document.Label}
+	}
+	return nil, &NotLoadedError{edge: "document"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DocumentContent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case documentcontent.FieldContentTextCompressed, documentcontent.FieldExtractedMetadata:
+			values[i] = new([]byte)
+		case documentcontent.FieldID, documentcontent.FieldTenantID:
+			values[i] = new(sql.NullInt64)
+		case documentcontent.FieldDocumentID, documentcontent.FieldContentText, documentcontent.FieldSearchTerms, documentcontent.FieldContentTextKey:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DocumentContent fields.
+func (_m *DocumentContent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case documentcontent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint32(value.Int64)
+		case documentcontent.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case documentcontent.FieldDocumentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field document_id", values[i])
+			} else if value.Valid {
+				_m.DocumentID = value.String
+			}
+		case documentcontent.FieldContentText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_text", values[i])
+			} else if value.Valid {
+				_m.ContentText = value.String
+			}
+		case documentcontent.FieldContentTextCompressed:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field content_text_compressed", values[i])
+			} else if value != nil {
+				_m.ContentTextCompressed = *value
+			}
+		case documentcontent.FieldSearchTerms:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field search_terms", values[i])
+			} else if value.Valid {
+				_m.SearchTerms = value.String
+			}
+		case documentcontent.FieldContentTextKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_text_key", values[i])
+			} else if value.Valid {
+				_m.ContentTextKey = value.String
+			}
+		case documentcontent.FieldExtractedMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field extracted_metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ExtractedMetadata); err != nil {
+					return fmt.Errorf("unmarshal field extracted_metadata: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DocumentContent.
+// This includes values selected through modifiers, order, etc.
+func (_m *DocumentContent) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryDocument queries the "document" edge of the DocumentContent entity.
+func (_m *DocumentContent) QueryDocument() *DocumentQuery {
+	return NewDocumentContentClient(_m.config).QueryDocument(_m)
+}
+
+// Update returns a builder for updating this DocumentContent.
+// Note that you need to call DocumentContent.Unwrap() before calling this method if this DocumentContent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DocumentContent) Update() *DocumentContentUpdateOne {
+	return NewDocumentContentClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DocumentContent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DocumentContent) Unwrap() *DocumentContent {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DocumentContent is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DocumentContent) String() string {
+	var builder strings.Builder
+	builder.WriteString("DocumentContent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("document_id=")
+	builder.WriteString(_m.DocumentID)
+	builder.WriteString(", ")
+	builder.WriteString("content_text=")
+	builder.WriteString(_m.ContentText)
+	builder.WriteString(", ")
+	builder.WriteString("content_text_compressed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ContentTextCompressed))
+	builder.WriteString(", ")
+	builder.WriteString("search_terms=")
+	builder.WriteString(_m.SearchTerms)
+	builder.WriteString(", ")
+	builder.WriteString("content_text_key=")
+	builder.WriteString(_m.ContentTextKey)
+	builder.WriteString(", ")
+	builder.WriteString("extracted_metadata=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExtractedMetadata))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DocumentContents is a parsable slice of DocumentContent.
+type DocumentContents []*DocumentContent
@@ -0,0 +1,126 @@
+// Code generated by ent, DO NOT EDIT.
+
+package documentcontent
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the documentcontent type in the database.
+	Label = "document_content"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldDocumentID holds the string denoting the document_id field in the database.
+	FieldDocumentID = "document_id"
+	// FieldContentText holds the string denoting the content_text field in the database.
+	FieldContentText = "content_text"
+	// FieldContentTextCompressed holds the string denoting the content_text_compressed field in the database.
+	FieldContentTextCompressed = "content_text_compressed"
+	// FieldSearchTerms holds the string denoting the search_terms field in the database.
+	FieldSearchTerms = "search_terms"
+	// FieldContentTextKey holds the string denoting the content_text_key field in the database.
+	FieldContentTextKey = "content_text_key"
+	// FieldExtractedMetadata holds the string denoting the extracted_metadata field in the database.
+	FieldExtractedMetadata = "extracted_metadata"
+	// EdgeDocument holds the string denoting the document edge name in mutations.
+	EdgeDocument = "document"
+	// Table holds the table name of the documentcontent in the database.
+	Table = "paperless_document_contents"
+	// DocumentTable is the table that holds the document relation/edge.
+	DocumentTable = "paperless_document_contents"
+	// DocumentInverseTable is the table name for the Document entity.
+	// It exists in this package in order to avoid circular dependency with the "document" package.
+	DocumentInverseTable = "paperless_documents"
+	// DocumentColumn is the table column denoting the document relation/edge.
+	DocumentColumn = "document_id"
+)
+
+// Columns holds all SQL columns for documentcontent fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldDocumentID,
+	FieldContentText,
+	FieldContentTextCompressed,
+	FieldSearchTerms,
+	FieldContentTextKey,
+	FieldExtractedMetadata,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/go-tangra/go-tangra-paperless/internal/data/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint32
+	// DocumentIDValidator is a validator for the "document_id" field. It is called by the builders before save.
+	DocumentIDValidator func(string) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(uint32) error
+)
+
+// OrderOption defines the ordering options for the DocumentContent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByDocumentID orders the results by the document_id field.
+func ByDocumentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDocumentID, opts...).ToFunc()
+}
+
+// ByContentText orders the results by the content_text field.
+func ByContentText(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentText, opts...).ToFunc()
+}
+
+// BySearchTerms orders the results by the search_terms field.
+func BySearchTerms(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSearchTerms, opts...).ToFunc()
+}
+
+// ByContentTextKey orders the results by the content_text_key field.
+func ByContentTextKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentTextKey, opts...).ToFunc()
+}
+
+// ByDocumentField orders the results by document field.
+func ByDocumentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDocumentStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newDocumentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DocumentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, DocumentTable, DocumentColumn),
+	)
+}
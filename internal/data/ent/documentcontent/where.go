@@ -0,0 +1,522 @@
+// Code generated by ent, DO NOT EDIT.
+
+package documentcontent
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEQ(FieldTenantID, v))
+}
+
+// DocumentID applies equality check predicate on the "document_id" field. It's identical to DocumentIDEQ.
+func DocumentID(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEQ(FieldDocumentID, v))
+}
+
+// ContentText applies equality check predicate on the "content_text" field. It's identical to ContentTextEQ.
+func ContentText(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEQ(FieldContentText, v))
+}
+
+// ContentTextCompressed applies equality check predicate on the "content_text_compressed" field. It's identical to ContentTextCompressedEQ.
+func ContentTextCompressed(v []byte) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEQ(FieldContentTextCompressed, v))
+}
+
+// SearchTerms applies equality check predicate on the "search_terms" field. It's identical to SearchTermsEQ.
+func SearchTerms(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEQ(FieldSearchTerms, v))
+}
+
+// ContentTextKey applies equality check predicate on the "content_text_key" field. It's identical to ContentTextKeyEQ.
+func ContentTextKey(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEQ(FieldContentTextKey, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNotNull(FieldTenantID))
+}
+
+// DocumentIDEQ applies the EQ predicate on the "document_id" field.
+func DocumentIDEQ(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEQ(FieldDocumentID, v))
+}
+
+// DocumentIDNEQ applies the NEQ predicate on the "document_id" field.
+func DocumentIDNEQ(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNEQ(FieldDocumentID, v))
+}
+
+// DocumentIDIn applies the In predicate on the "document_id" field.
+func DocumentIDIn(vs ...string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldIn(FieldDocumentID, vs...))
+}
+
+// DocumentIDNotIn applies the NotIn predicate on the "document_id" field.
+func DocumentIDNotIn(vs ...string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNotIn(FieldDocumentID, vs...))
+}
+
+// DocumentIDGT applies the GT predicate on the "document_id" field.
+func DocumentIDGT(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldGT(FieldDocumentID, v))
+}
+
+// DocumentIDGTE applies the GTE predicate on the "document_id" field.
+func DocumentIDGTE(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldGTE(FieldDocumentID, v))
+}
+
+// DocumentIDLT applies the LT predicate on the "document_id" field.
+func DocumentIDLT(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldLT(FieldDocumentID, v))
+}
+
+// DocumentIDLTE applies the LTE predicate on the "document_id" field.
+func DocumentIDLTE(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldLTE(FieldDocumentID, v))
+}
+
+// DocumentIDContains applies the Contains predicate on the "document_id" field.
+func DocumentIDContains(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldContains(FieldDocumentID, v))
+}
+
+// DocumentIDHasPrefix applies the HasPrefix predicate on the "document_id" field.
+func DocumentIDHasPrefix(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldHasPrefix(FieldDocumentID, v))
+}
+
+// DocumentIDHasSuffix applies the HasSuffix predicate on the "document_id" field.
+func DocumentIDHasSuffix(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldHasSuffix(FieldDocumentID, v))
+}
+
+// DocumentIDEqualFold applies the EqualFold predicate on the "document_id" field.
+func DocumentIDEqualFold(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEqualFold(FieldDocumentID, v))
+}
+
+// DocumentIDContainsFold applies the ContainsFold predicate on the "document_id" field.
+func DocumentIDContainsFold(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldContainsFold(FieldDocumentID, v))
+}
+
+// ContentTextEQ applies the EQ predicate on the "content_text" field.
+func ContentTextEQ(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEQ(FieldContentText, v))
+}
+
+// ContentTextNEQ applies the NEQ predicate on the "content_text" field.
+func ContentTextNEQ(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNEQ(FieldContentText, v))
+}
+
+// ContentTextIn applies the In predicate on the "content_text" field.
+func ContentTextIn(vs ...string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldIn(FieldContentText, vs...))
+}
+
+// ContentTextNotIn applies the NotIn predicate on the "content_text" field.
+func ContentTextNotIn(vs ...string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNotIn(FieldContentText, vs...))
+}
+
+// ContentTextGT applies the GT predicate on the "content_text" field.
+func ContentTextGT(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldGT(FieldContentText, v))
+}
+
+// ContentTextGTE applies the GTE predicate on the "content_text" field.
+func ContentTextGTE(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldGTE(FieldContentText, v))
+}
+
+// ContentTextLT applies the LT predicate on the "content_text" field.
+func ContentTextLT(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldLT(FieldContentText, v))
+}
+
+// ContentTextLTE applies the LTE predicate on the "content_text" field.
+func ContentTextLTE(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldLTE(FieldContentText, v))
+}
+
+// ContentTextContains applies the Contains predicate on the "content_text" field.
+func ContentTextContains(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldContains(FieldContentText, v))
+}
+
+// ContentTextHasPrefix applies the HasPrefix predicate on the "content_text" field.
+func ContentTextHasPrefix(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldHasPrefix(FieldContentText, v))
+}
+
+// ContentTextHasSuffix applies the HasSuffix predicate on the "content_text" field.
+func ContentTextHasSuffix(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldHasSuffix(FieldContentText, v))
+}
+
+// ContentTextIsNil applies the IsNil predicate on the "content_text" field.
+func ContentTextIsNil() predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldIsNull(FieldContentText))
+}
+
+// ContentTextNotNil applies the NotNil predicate on the "content_text" field.
+func ContentTextNotNil() predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNotNull(FieldContentText))
+}
+
+// ContentTextEqualFold applies the EqualFold predicate on the "content_text" field.
+func ContentTextEqualFold(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEqualFold(FieldContentText, v))
+}
+
+// ContentTextContainsFold applies the ContainsFold predicate on the "content_text" field.
+func ContentTextContainsFold(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldContainsFold(FieldContentText, v))
+}
+
+// ContentTextCompressedEQ applies the EQ predicate on the "content_text_compressed" field.
+func ContentTextCompressedEQ(v []byte) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEQ(FieldContentTextCompressed, v))
+}
+
+// ContentTextCompressedNEQ applies the NEQ predicate on the "content_text_compressed" field.
+func ContentTextCompressedNEQ(v []byte) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNEQ(FieldContentTextCompressed, v))
+}
+
+// ContentTextCompressedIn applies the In predicate on the "content_text_compressed" field.
+func ContentTextCompressedIn(vs ...[]byte) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldIn(FieldContentTextCompressed, vs...))
+}
+
+// ContentTextCompressedNotIn applies the NotIn predicate on the "content_text_compressed" field.
+func ContentTextCompressedNotIn(vs ...[]byte) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNotIn(FieldContentTextCompressed, vs...))
+}
+
+// ContentTextCompressedGT applies the GT predicate on the "content_text_compressed" field.
+func ContentTextCompressedGT(v []byte) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldGT(FieldContentTextCompressed, v))
+}
+
+// ContentTextCompressedGTE applies the GTE predicate on the "content_text_compressed" field.
+func ContentTextCompressedGTE(v []byte) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldGTE(FieldContentTextCompressed, v))
+}
+
+// ContentTextCompressedLT applies the LT predicate on the "content_text_compressed" field.
+func ContentTextCompressedLT(v []byte) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldLT(FieldContentTextCompressed, v))
+}
+
+// ContentTextCompressedLTE applies the LTE predicate on the "content_text_compressed" field.
+func ContentTextCompressedLTE(v []byte) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldLTE(FieldContentTextCompressed, v))
+}
+
+// ContentTextCompressedIsNil applies the IsNil predicate on the "content_text_compressed" field.
+func ContentTextCompressedIsNil() predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldIsNull(FieldContentTextCompressed))
+}
+
+// ContentTextCompressedNotNil applies the NotNil predicate on the "content_text_compressed" field.
+func ContentTextCompressedNotNil() predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNotNull(FieldContentTextCompressed))
+}
+
+// SearchTermsEQ applies the EQ predicate on the "search_terms" field.
+func SearchTermsEQ(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEQ(FieldSearchTerms, v))
+}
+
+// SearchTermsNEQ applies the NEQ predicate on the "search_terms" field.
+func SearchTermsNEQ(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNEQ(FieldSearchTerms, v))
+}
+
+// SearchTermsIn applies the In predicate on the "search_terms" field.
+func SearchTermsIn(vs ...string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldIn(FieldSearchTerms, vs...))
+}
+
+// SearchTermsNotIn applies the NotIn predicate on the "search_terms" field.
+func SearchTermsNotIn(vs ...string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNotIn(FieldSearchTerms, vs...))
+}
+
+// SearchTermsGT applies the GT predicate on the "search_terms" field.
+func SearchTermsGT(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldGT(FieldSearchTerms, v))
+}
+
+// SearchTermsGTE applies the GTE predicate on the "search_terms" field.
+func SearchTermsGTE(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldGTE(FieldSearchTerms, v))
+}
+
+// SearchTermsLT applies the LT predicate on the "search_terms" field.
+func SearchTermsLT(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldLT(FieldSearchTerms, v))
+}
+
+// SearchTermsLTE applies the LTE predicate on the "search_terms" field.
+func SearchTermsLTE(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldLTE(FieldSearchTerms, v))
+}
+
+// SearchTermsContains applies the Contains predicate on the "search_terms" field.
+func SearchTermsContains(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldContains(FieldSearchTerms, v))
+}
+
+// SearchTermsHasPrefix applies the HasPrefix predicate on the "search_terms" field.
+func SearchTermsHasPrefix(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldHasPrefix(FieldSearchTerms, v))
+}
+
+// SearchTermsHasSuffix applies the HasSuffix predicate on the "search_terms" field.
+func SearchTermsHasSuffix(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldHasSuffix(FieldSearchTerms, v))
+}
+
+// SearchTermsIsNil applies the IsNil predicate on the "search_terms" field.
+func SearchTermsIsNil() predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldIsNull(FieldSearchTerms))
+}
+
+// SearchTermsNotNil applies the NotNil predicate on the "search_terms" field.
+func SearchTermsNotNil() predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNotNull(FieldSearchTerms))
+}
+
+// SearchTermsEqualFold applies the EqualFold predicate on the "search_terms" field.
+func SearchTermsEqualFold(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEqualFold(FieldSearchTerms, v))
+}
+
+// SearchTermsContainsFold applies the ContainsFold predicate on the "search_terms" field.
+func SearchTermsContainsFold(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldContainsFold(FieldSearchTerms, v))
+}
+
+// ContentTextKeyEQ applies the EQ predicate on the "content_text_key" field.
+func ContentTextKeyEQ(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEQ(FieldContentTextKey, v))
+}
+
+// ContentTextKeyNEQ applies the NEQ predicate on the "content_text_key" field.
+func ContentTextKeyNEQ(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNEQ(FieldContentTextKey, v))
+}
+
+// ContentTextKeyIn applies the In predicate on the "content_text_key" field.
+func ContentTextKeyIn(vs ...string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldIn(FieldContentTextKey, vs...))
+}
+
+// ContentTextKeyNotIn applies the NotIn predicate on the "content_text_key" field.
+func ContentTextKeyNotIn(vs ...string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNotIn(FieldContentTextKey, vs...))
+}
+
+// ContentTextKeyGT applies the GT predicate on the "content_text_key" field.
+func ContentTextKeyGT(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldGT(FieldContentTextKey, v))
+}
+
+// ContentTextKeyGTE applies the GTE predicate on the "content_text_key" field.
+func ContentTextKeyGTE(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldGTE(FieldContentTextKey, v))
+}
+
+// ContentTextKeyLT applies the LT predicate on the "content_text_key" field.
+func ContentTextKeyLT(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldLT(FieldContentTextKey, v))
+}
+
+// ContentTextKeyLTE applies the LTE predicate on the "content_text_key" field.
+func ContentTextKeyLTE(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldLTE(FieldContentTextKey, v))
+}
+
+// ContentTextKeyContains applies the Contains predicate on the "content_text_key" field.
+func ContentTextKeyContains(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldContains(FieldContentTextKey, v))
+}
+
+// ContentTextKeyHasPrefix applies the HasPrefix predicate on the "content_text_key" field.
+func ContentTextKeyHasPrefix(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldHasPrefix(FieldContentTextKey, v))
+}
+
+// ContentTextKeyHasSuffix applies the HasSuffix predicate on the "content_text_key" field.
+func ContentTextKeyHasSuffix(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldHasSuffix(FieldContentTextKey, v))
+}
+
+// ContentTextKeyIsNil applies the IsNil predicate on the "content_text_key" field.
+func ContentTextKeyIsNil() predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldIsNull(FieldContentTextKey))
+}
+
+// ContentTextKeyNotNil applies the NotNil predicate on the "content_text_key" field.
+func ContentTextKeyNotNil() predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNotNull(FieldContentTextKey))
+}
+
+// ContentTextKeyEqualFold applies the EqualFold predicate on the "content_text_key" field.
+func ContentTextKeyEqualFold(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEqualFold(FieldContentTextKey, v))
+}
+
+// ContentTextKeyContainsFold applies the ContainsFold predicate on the "content_text_key" field.
+func ContentTextKeyContainsFold(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldContainsFold(FieldContentTextKey, v))
+}
+
+// ExtractedMetadataIsNil applies the IsNil predicate on the "extracted_metadata" field.
+func ExtractedMetadataIsNil() predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldIsNull(FieldExtractedMetadata))
+}
+
+// ExtractedMetadataNotNil applies the NotNil predicate on the "extracted_metadata" field.
+func ExtractedMetadataNotNil() predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNotNull(FieldExtractedMetadata))
+}
+
+// HasDocument applies the HasEdge predicate on the "document" edge.
+func HasDocument() predicate.DocumentContent {
+	return predicate.DocumentContent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, DocumentTable, DocumentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDocumentWith applies the HasEdge predicate on the "document" edge with a given conditions (other predicates).
+func HasDocumentWith(preds ...predicate.Document) predicate.DocumentContent {
+	return predicate.DocumentContent(func(s *sql.Selector) {
+		step := newDocumentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DocumentContent) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DocumentContent) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DocumentContent) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.NotPredicates(p))
+}
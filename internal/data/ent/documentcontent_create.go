@@ -0,0 +1,927 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/document"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documentcontent"
+)
+
+// DocumentContentCreate is the builder for creating a DocumentContent entity.
+type DocumentContentCreate struct {
+	config
+	mutation *DocumentContentMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *DocumentContentCreate) SetTenantID(v uint32) *DocumentContentCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *DocumentContentCreate) SetNillableTenantID(v *uint32) *DocumentContentCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetDocumentID sets the "document_id" field.
+func (_c *DocumentContentCreate) SetDocumentID(v string) *DocumentContentCreate {
+	_c.mutation.SetDocumentID(v)
+	return _c
+}
+
+// SetContentText sets the "content_text" field.
+func (_c *DocumentContentCreate) SetContentText(v string) *DocumentContentCreate {
+	_c.mutation.SetContentText(v)
+	return _c
+}
+
+// SetNillableContentText sets the "content_text" field if the given value is not nil.
+func (_c *DocumentContentCreate) SetNillableContentText(v *string) *DocumentContentCreate {
+	if v != nil {
+		_c.SetContentText(*v)
+	}
+	return _c
+}
+
+// SetContentTextCompressed sets the "content_text_compressed" field.
+func (_c *DocumentContentCreate) SetContentTextCompressed(v []byte) *DocumentContentCreate {
+	_c.mutation.SetContentTextCompressed(v)
+	return _c
+}
+
+// SetSearchTerms sets the "search_terms" field.
+func (_c *DocumentContentCreate) SetSearchTerms(v string) *DocumentContentCreate {
+	_c.mutation.SetSearchTerms(v)
+	return _c
+}
+
+// SetNillableSearchTerms sets the "search_terms" field if the given value is not nil.
+func (_c *DocumentContentCreate) SetNillableSearchTerms(v *string) *DocumentContentCreate {
+	if v != nil {
+		_c.SetSearchTerms(*v)
+	}
+	return _c
+}
+
+// SetContentTextKey sets the "content_text_key" field.
+func (_c *DocumentContentCreate) SetContentTextKey(v string) *DocumentContentCreate {
+	_c.mutation.SetContentTextKey(v)
+	return _c
+}
+
+// SetNillableContentTextKey sets the "content_text_key" field if the given value is not nil.
+func (_c *DocumentContentCreate) SetNillableContentTextKey(v *string) *DocumentContentCreate {
+	if v != nil {
+		_c.SetContentTextKey(*v)
+	}
+	return _c
+}
+
+// SetExtractedMetadata sets the "extracted_metadata" field.
+func (_c *DocumentContentCreate) SetExtractedMetadata(v map[string]string) *DocumentContentCreate {
+	_c.mutation.SetExtractedMetadata(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DocumentContentCreate) SetID(v uint32) *DocumentContentCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetDocument sets the "document" edge to the Document entity.
+func (_c *DocumentContentCreate) SetDocument(v *Document) *DocumentContentCreate {
+	return _c.SetDocumentID(v.ID)
+}
+
+// Mutation returns the DocumentContentMutation object of the builder.
+func (_c *DocumentContentCreate) Mutation() *DocumentContentMutation {
+	return _c.mutation
+}
+
+// Save creates the DocumentContent in the database.
+func (_c *DocumentContentCreate) Save(ctx context.Context) (*DocumentContent, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DocumentContentCreate) SaveX(ctx context.Context) *DocumentContent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DocumentContentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DocumentContentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DocumentContentCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := documentcontent.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DocumentContentCreate) check() error {
+	if _, ok := _c.mutation.DocumentID(); !ok {
+		return &ValidationError{Name: "document_id", err: errors.New(`ent: missing required field "DocumentContent.document_id"`)}
+	}
+	if v, ok := _c.mutation.DocumentID(); ok {
+		if err := documentcontent.DocumentIDValidator(v); err != nil {
+			return &ValidationError{Name: "document_id", err: fmt.Errorf(`ent: validator failed for field "DocumentContent.document_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := documentcontent.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "DocumentContent.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.DocumentIDs()) == 0 {
+		return &ValidationError{Name: "document", err: errors.New(`ent: missing required edge "DocumentContent.document"`)}
+	}
+	return nil
+}
+
+func (_c *DocumentContentCreate) sqlSave(ctx context.Context) (*DocumentContent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DocumentContentCreate) createSpec() (*DocumentContent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DocumentContent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(documentcontent.Table, sqlgraph.NewFieldSpec(documentcontent.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(documentcontent.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.ContentText(); ok {
+		_spec.SetField(documentcontent.FieldContentText, field.TypeString, value)
+		_node.ContentText = value
+	}
+	if value, ok := _c.mutation.ContentTextCompressed(); ok {
+		_spec.SetField(documentcontent.FieldContentTextCompressed, field.TypeBytes, value)
+		_node.ContentTextCompressed = value
+	}
+	if value, ok := _c.mutation.SearchTerms(); ok {
+		_spec.SetField(documentcontent.FieldSearchTerms, field.TypeString, value)
+		_node.SearchTerms = value
+	}
+	if value, ok := _c.mutation.ContentTextKey(); ok {
+		_spec.SetField(documentcontent.FieldContentTextKey, field.TypeString, value)
+		_node.ContentTextKey = value
+	}
+	if value, ok := _c.mutation.ExtractedMetadata(); ok {
+		_spec.SetField(documentcontent.FieldExtractedMetadata, field.TypeJSON, value)
+		_node.ExtractedMetadata = value
+	}
+	if nodes := _c.mutation.DocumentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   documentcontent.DocumentTable,
+			Columns: []string{documentcontent.DocumentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DocumentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DocumentContent.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DocumentContentUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DocumentContentCreate) OnConflict(opts ...sql.ConflictOption) *DocumentContentUpsertOne {
+	_c.conflict = opts
+	return &DocumentContentUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DocumentContent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DocumentContentCreate) OnConflictColumns(columns ...string) *DocumentContentUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DocumentContentUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DocumentContentUpsertOne is the builder for "upsert"-ing
+	//  one DocumentContent node.
+	DocumentContentUpsertOne struct {
+		create *DocumentContentCreate
+	}
+
+	// DocumentContentUpsert is the "OnConflict" setter.
+	DocumentContentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDocumentID sets the "document_id" field.
+func (u *DocumentContentUpsert) SetDocumentID(v string) *DocumentContentUpsert {
+	u.Set(documentcontent.FieldDocumentID, v)
+	return u
+}
+
+// UpdateDocumentID sets the "document_id" field to the value that was provided on create.
+func (u *DocumentContentUpsert) UpdateDocumentID() *DocumentContentUpsert {
+	u.SetExcluded(documentcontent.FieldDocumentID)
+	return u
+}
+
+// SetContentText sets the "content_text" field.
+func (u *DocumentContentUpsert) SetContentText(v string) *DocumentContentUpsert {
+	u.Set(documentcontent.FieldContentText, v)
+	return u
+}
+
+// UpdateContentText sets the "content_text" field to the value that was provided on create.
+func (u *DocumentContentUpsert) UpdateContentText() *DocumentContentUpsert {
+	u.SetExcluded(documentcontent.FieldContentText)
+	return u
+}
+
+// ClearContentText clears the value of the "content_text" field.
+func (u *DocumentContentUpsert) ClearContentText() *DocumentContentUpsert {
+	u.SetNull(documentcontent.FieldContentText)
+	return u
+}
+
+// SetContentTextCompressed sets the "content_text_compressed" field.
+func (u *DocumentContentUpsert) SetContentTextCompressed(v []byte) *DocumentContentUpsert {
+	u.Set(documentcontent.FieldContentTextCompressed, v)
+	return u
+}
+
+// UpdateContentTextCompressed sets the "content_text_compressed" field to the value that was provided on create.
+func (u *DocumentContentUpsert) UpdateContentTextCompressed() *DocumentContentUpsert {
+	u.SetExcluded(documentcontent.FieldContentTextCompressed)
+	return u
+}
+
+// ClearContentTextCompressed clears the value of the "content_text_compressed" field.
+func (u *DocumentContentUpsert) ClearContentTextCompressed() *DocumentContentUpsert {
+	u.SetNull(documentcontent.FieldContentTextCompressed)
+	return u
+}
+
+// SetSearchTerms sets the "search_terms" field.
+func (u *DocumentContentUpsert) SetSearchTerms(v string) *DocumentContentUpsert {
+	u.Set(documentcontent.FieldSearchTerms, v)
+	return u
+}
+
+// UpdateSearchTerms sets the "search_terms" field to the value that was provided on create.
+func (u *DocumentContentUpsert) UpdateSearchTerms() *DocumentContentUpsert {
+	u.SetExcluded(documentcontent.FieldSearchTerms)
+	return u
+}
+
+// ClearSearchTerms clears the value of the "search_terms" field.
+func (u *DocumentContentUpsert) ClearSearchTerms() *DocumentContentUpsert {
+	u.SetNull(documentcontent.FieldSearchTerms)
+	return u
+}
+
+// SetContentTextKey sets the "content_text_key" field.
+func (u *DocumentContentUpsert) SetContentTextKey(v string) *DocumentContentUpsert {
+	u.Set(documentcontent.FieldContentTextKey, v)
+	return u
+}
+
+// UpdateContentTextKey sets the "content_text_key" field to the value that was provided on create.
+func (u *DocumentContentUpsert) UpdateContentTextKey() *DocumentContentUpsert {
+	u.SetExcluded(documentcontent.FieldContentTextKey)
+	return u
+}
+
+// ClearContentTextKey clears the value of the "content_text_key" field.
+func (u *DocumentContentUpsert) ClearContentTextKey() *DocumentContentUpsert {
+	u.SetNull(documentcontent.FieldContentTextKey)
+	return u
+}
+
+// SetExtractedMetadata sets the "extracted_metadata" field.
+func (u *DocumentContentUpsert) SetExtractedMetadata(v map[string]string) *DocumentContentUpsert {
+	u.Set(documentcontent.FieldExtractedMetadata, v)
+	return u
+}
+
+// UpdateExtractedMetadata sets the "extracted_metadata" field to the value that was provided on create.
+func (u *DocumentContentUpsert) UpdateExtractedMetadata() *DocumentContentUpsert {
+	u.SetExcluded(documentcontent.FieldExtractedMetadata)
+	return u
+}
+
+// ClearExtractedMetadata clears the value of the "extracted_metadata" field.
+func (u *DocumentContentUpsert) ClearExtractedMetadata() *DocumentContentUpsert {
+	u.SetNull(documentcontent.FieldExtractedMetadata)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DocumentContent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(documentcontent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DocumentContentUpsertOne) UpdateNewValues() *DocumentContentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(documentcontent.FieldID)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(documentcontent.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DocumentContent.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DocumentContentUpsertOne) Ignore() *DocumentContentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DocumentContentUpsertOne) DoNothing() *DocumentContentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DocumentContentCreate.OnConflict
+// documentation for more info.
+func (u *DocumentContentUpsertOne) Update(set func(*DocumentContentUpsert)) *DocumentContentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DocumentContentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDocumentID sets the "document_id" field.
+func (u *DocumentContentUpsertOne) SetDocumentID(v string) *DocumentContentUpsertOne {
+	return u.Update(func(s *DocumentContentUpsert) {
+		s.SetDocumentID(v)
+	})
+}
+
+// UpdateDocumentID sets the "document_id" field to the value that was provided on create.
+func (u *DocumentContentUpsertOne) UpdateDocumentID() *DocumentContentUpsertOne {
+	return u.Update(func(s *DocumentContentUpsert) {
+		s.UpdateDocumentID()
+	})
+}
+
+// SetContentText sets the "content_text" field.
+func (u *DocumentContentUpsertOne) SetContentText(v string) *DocumentContentUpsertOne {
+	return u.Update(func(s *DocumentContentUpsert) {
+		s.SetContentText(v)
+	})
+}
+
+// UpdateContentText sets the "content_text" field to the value that was provided on create.
+func (u *DocumentContentUpsertOne) UpdateContentText() *DocumentContentUpsertOne {
+	return u.Update(func(s *DocumentContentUpsert) {
+		s.UpdateContentText()
+	})
+}
+
+// ClearContentText clears the value of the "content_text" field.
+func (u *DocumentContentUpsertOne) ClearContentText() *DocumentContentUpsertOne {
+	return u.Update(func(s *DocumentContentUpsert) {
+		s.ClearContentText()
+	})
+}
+
+// SetContentTextCompressed sets the "content_text_compressed" field.
+func (u *DocumentContentUpsertOne) SetContentTextCompressed(v []byte) *DocumentContentUpsertOne {
+	return u.Update(func(s *DocumentContentUpsert) {
+		s.SetContentTextCompressed(v)
+	})
+}
+
+// UpdateContentTextCompressed sets the "content_text_compressed" field to the value that was provided on create.
+func (u *DocumentContentUpsertOne) UpdateContentTextCompressed() *DocumentContentUpsertOne {
+	return u.Update(func(s *DocumentContentUpsert) {
+		s.UpdateContentTextCompressed()
+	})
+}
+
+// ClearContentTextCompressed clears the value of the "content_text_compressed" field.
+func (u *DocumentContentUpsertOne) ClearContentTextCompressed() *DocumentContentUpsertOne {
+	return u.Update(func(s *DocumentContentUpsert) {
+		s.ClearContentTextCompressed()
+	})
+}
+
+// SetSearchTerms sets the "search_terms" field.
+func (u *DocumentContentUpsertOne) SetSearchTerms(v string) *DocumentContentUpsertOne {
+	return u.Update(func(s *DocumentContentUpsert) {
+		s.SetSearchTerms(v)
+	})
+}
+
+// UpdateSearchTerms sets the "search_terms" field to the value that was provided on create.
+func (u *DocumentContentUpsertOne) UpdateSearchTerms() *DocumentContentUpsertOne {
+	return u.Update(func(s *DocumentContentUpsert) {
+		s.UpdateSearchTerms()
+	})
+}
+
+// ClearSearchTerms clears the value of the "search_terms" field.
+func (u *DocumentContentUpsertOne) ClearSearchTerms() *DocumentContentUpsertOne {
+	return u.Update(func(s *DocumentContentUpsert) {
+		s.ClearSearchTerms()
+	})
+}
+
+// SetContentTextKey sets the "content_text_key" field.
+func (u *DocumentContentUpsertOne) SetContentTextKey(v string) *DocumentContentUpsertOne {
+	return u.Update(func(s *DocumentContentUpsert) {
+		s.SetContentTextKey(v)
+	})
+}
+
+// UpdateContentTextKey sets the "content_text_key" field to the value that was provided on create.
+func (u *DocumentContentUpsertOne) UpdateContentTextKey() *DocumentContentUpsertOne {
+	return u.Update(func(s *DocumentContentUpsert) {
+		s.UpdateContentTextKey()
+	})
+}
+
+// ClearContentTextKey clears the value of the "content_text_key" field.
+func (u *DocumentContentUpsertOne) ClearContentTextKey() *DocumentContentUpsertOne {
+	return u.Update(func(s *DocumentContentUpsert) {
+		s.ClearContentTextKey()
+	})
+}
+
+// SetExtractedMetadata sets the "extracted_metadata" field.
+func (u *DocumentContentUpsertOne) SetExtractedMetadata(v map[string]string) *DocumentContentUpsertOne {
+	return u.Update(func(s *DocumentContentUpsert) {
+		s.SetExtractedMetadata(v)
+	})
+}
+
+// UpdateExtractedMetadata sets the "extracted_metadata" field to the value that was provided on create.
+func (u *DocumentContentUpsertOne) UpdateExtractedMetadata() *DocumentContentUpsertOne {
+	return u.Update(func(s *DocumentContentUpsert) {
+		s.UpdateExtractedMetadata()
+	})
+}
+
+// ClearExtractedMetadata clears the value of the "extracted_metadata" field.
+func (u *DocumentContentUpsertOne) ClearExtractedMetadata() *DocumentContentUpsertOne {
+	return u.Update(func(s *DocumentContentUpsert) {
+		s.ClearExtractedMetadata()
+	})
+}
+
+// Exec executes the query.
+func (u *DocumentContentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DocumentContentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DocumentContentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DocumentContentUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DocumentContentUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DocumentContentCreateBulk is the builder for creating many DocumentContent entities in bulk.
+type DocumentContentCreateBulk struct {
+	config
+	err      error
+	builders []*DocumentContentCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DocumentContent entities in the database.
+func (_c *DocumentContentCreateBulk) Save(ctx context.Context) ([]*DocumentContent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DocumentContent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DocumentContentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DocumentContentCreateBulk) SaveX(ctx context.Context) []*DocumentContent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DocumentContentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DocumentContentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DocumentContent.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DocumentContentUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DocumentContentCreateBulk) OnConflict(opts ...sql.ConflictOption) *DocumentContentUpsertBulk {
+	_c.conflict = opts
+	return &DocumentContentUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DocumentContent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DocumentContentCreateBulk) OnConflictColumns(columns ...string) *DocumentContentUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DocumentContentUpsertBulk{
+		create: _c,
+	}
+}
+
+// DocumentContentUpsertBulk is the builder for "upsert"-ing
+// a bulk of DocumentContent nodes.
+type DocumentContentUpsertBulk struct {
+	create *DocumentContentCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DocumentContent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(documentcontent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DocumentContentUpsertBulk) UpdateNewValues() *DocumentContentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(documentcontent.FieldID)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(documentcontent.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DocumentContent.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DocumentContentUpsertBulk) Ignore() *DocumentContentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DocumentContentUpsertBulk) DoNothing() *DocumentContentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DocumentContentCreateBulk.OnConflict
+// documentation for more info.
+func (u *DocumentContentUpsertBulk) Update(set func(*DocumentContentUpsert)) *DocumentContentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DocumentContentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDocumentID sets the "document_id" field.
+func (u *DocumentContentUpsertBulk) SetDocumentID(v string) *DocumentContentUpsertBulk {
+	return u.Update(func(s *DocumentContentUpsert) {
+		s.SetDocumentID(v)
+	})
+}
+
+// UpdateDocumentID sets the "document_id" field to the value that was provided on create.
+func (u *DocumentContentUpsertBulk) UpdateDocumentID() *DocumentContentUpsertBulk {
+	return u.Update(func(s *DocumentContentUpsert) {
+		s.UpdateDocumentID()
+	})
+}
+
+// SetContentText sets the "content_text" field.
+func (u *DocumentContentUpsertBulk) SetContentText(v string) *DocumentContentUpsertBulk {
+	return u.Update(func(s *DocumentContentUpsert) {
+		s.SetContentText(v)
+	})
+}
+
+// UpdateContentText sets the "content_text" field to the value that was provided on create.
+func (u *DocumentContentUpsertBulk) UpdateContentText() *DocumentContentUpsertBulk {
+	return u.Update(func(s *DocumentContentUpsert) {
+		s.UpdateContentText()
+	})
+}
+
+// ClearContentText clears the value of the "content_text" field.
+func (u *DocumentContentUpsertBulk) ClearContentText() *DocumentContentUpsertBulk {
+	return u.Update(func(s *DocumentContentUpsert) {
+		s.ClearContentText()
+	})
+}
+
+// SetContentTextCompressed sets the "content_text_compressed" field.
+func (u *DocumentContentUpsertBulk) SetContentTextCompressed(v []byte) *DocumentContentUpsertBulk {
+	return u.Update(func(s *DocumentContentUpsert) {
+		s.SetContentTextCompressed(v)
+	})
+}
+
+// UpdateContentTextCompressed sets the "content_text_compressed" field to the value that was provided on create.
+func (u *DocumentContentUpsertBulk) UpdateContentTextCompressed() *DocumentContentUpsertBulk {
+	return u.Update(func(s *DocumentContentUpsert) {
+		s.UpdateContentTextCompressed()
+	})
+}
+
+// ClearContentTextCompressed clears the value of the "content_text_compressed" field.
+func (u *DocumentContentUpsertBulk) ClearContentTextCompressed() *DocumentContentUpsertBulk {
+	return u.Update(func(s *DocumentContentUpsert) {
+		s.ClearContentTextCompressed()
+	})
+}
+
+// SetSearchTerms sets the "search_terms" field.
+func (u *DocumentContentUpsertBulk) SetSearchTerms(v string) *DocumentContentUpsertBulk {
+	return u.Update(func(s *DocumentContentUpsert) {
+		s.SetSearchTerms(v)
+	})
+}
+
+// UpdateSearchTerms sets the "search_terms" field to the value that was provided on create.
+func (u *DocumentContentUpsertBulk) UpdateSearchTerms() *DocumentContentUpsertBulk {
+	return u.Update(func(s *DocumentContentUpsert) {
+		s.UpdateSearchTerms()
+	})
+}
+
+// ClearSearchTerms clears the value of the "search_terms" field.
+func (u *DocumentContentUpsertBulk) ClearSearchTerms() *DocumentContentUpsertBulk {
+	return u.Update(func(s *DocumentContentUpsert) {
+		s.ClearSearchTerms()
+	})
+}
+
+// SetContentTextKey sets the "content_text_key" field.
+func (u *DocumentContentUpsertBulk) SetContentTextKey(v string) *DocumentContentUpsertBulk {
+	return u.Update(func(s *DocumentContentUpsert) {
+		s.SetContentTextKey(v)
+	})
+}
+
+// UpdateContentTextKey sets the "content_text_key" field to the value that was provided on create.
+func (u *DocumentContentUpsertBulk) UpdateContentTextKey() *DocumentContentUpsertBulk {
+	return u.Update(func(s *DocumentContentUpsert) {
+		s.UpdateContentTextKey()
+	})
+}
+
+// ClearContentTextKey clears the value of the "content_text_key" field.
+func (u *DocumentContentUpsertBulk) ClearContentTextKey() *DocumentContentUpsertBulk {
+	return u.Update(func(s *DocumentContentUpsert) {
+		s.ClearContentTextKey()
+	})
+}
+
+// SetExtractedMetadata sets the "extracted_metadata" field.
+func (u *DocumentContentUpsertBulk) SetExtractedMetadata(v map[string]string) *DocumentContentUpsertBulk {
+	return u.Update(func(s *DocumentContentUpsert) {
+		s.SetExtractedMetadata(v)
+	})
+}
+
+// UpdateExtractedMetadata sets the "extracted_metadata" field to the value that was provided on create.
+func (u *DocumentContentUpsertBulk) UpdateExtractedMetadata() *DocumentContentUpsertBulk {
+	return u.Update(func(s *DocumentContentUpsert) {
+		s.UpdateExtractedMetadata()
+	})
+}
+
+// ClearExtractedMetadata clears the value of the "extracted_metadata" field.
+func (u *DocumentContentUpsertBulk) ClearExtractedMetadata() *DocumentContentUpsertBulk {
+	return u.Update(func(s *DocumentContentUpsert) {
+		s.ClearExtractedMetadata()
+	})
+}
+
+// Exec executes the query.
+func (u *DocumentContentUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DocumentContentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DocumentContentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DocumentContentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
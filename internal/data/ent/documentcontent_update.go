@@ -0,0 +1,566 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/document"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documentcontent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+)
+
+// DocumentContentUpdate is the builder for updating DocumentContent entities.
+type DocumentContentUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *DocumentContentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the DocumentContentUpdate builder.
+func (_u *DocumentContentUpdate) Where(ps ...predicate.DocumentContent) *DocumentContentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDocumentID sets the "document_id" field.
+func (_u *DocumentContentUpdate) SetDocumentID(v string) *DocumentContentUpdate {
+	_u.mutation.SetDocumentID(v)
+	return _u
+}
+
+// SetNillableDocumentID sets the "document_id" field if the given value is not nil.
+func (_u *DocumentContentUpdate) SetNillableDocumentID(v *string) *DocumentContentUpdate {
+	if v != nil {
+		_u.SetDocumentID(*v)
+	}
+	return _u
+}
+
+// SetContentText sets the "content_text" field.
+func (_u *DocumentContentUpdate) SetContentText(v string) *DocumentContentUpdate {
+	_u.mutation.SetContentText(v)
+	return _u
+}
+
+// SetNillableContentText sets the "content_text" field if the given value is not nil.
+func (_u *DocumentContentUpdate) SetNillableContentText(v *string) *DocumentContentUpdate {
+	if v != nil {
+		_u.SetContentText(*v)
+	}
+	return _u
+}
+
+// ClearContentText clears the value of the "content_text" field.
+func (_u *DocumentContentUpdate) ClearContentText() *DocumentContentUpdate {
+	_u.mutation.ClearContentText()
+	return _u
+}
+
+// SetContentTextCompressed sets the "content_text_compressed" field.
+func (_u *DocumentContentUpdate) SetContentTextCompressed(v []byte) *DocumentContentUpdate {
+	_u.mutation.SetContentTextCompressed(v)
+	return _u
+}
+
+// ClearContentTextCompressed clears the value of the "content_text_compressed" field.
+func (_u *DocumentContentUpdate) ClearContentTextCompressed() *DocumentContentUpdate {
+	_u.mutation.ClearContentTextCompressed()
+	return _u
+}
+
+// SetSearchTerms sets the "search_terms" field.
+func (_u *DocumentContentUpdate) SetSearchTerms(v string) *DocumentContentUpdate {
+	_u.mutation.SetSearchTerms(v)
+	return _u
+}
+
+// SetNillableSearchTerms sets the "search_terms" field if the given value is not nil.
+func (_u *DocumentContentUpdate) SetNillableSearchTerms(v *string) *DocumentContentUpdate {
+	if v != nil {
+		_u.SetSearchTerms(*v)
+	}
+	return _u
+}
+
+// ClearSearchTerms clears the value of the "search_terms" field.
+func (_u *DocumentContentUpdate) ClearSearchTerms() *DocumentContentUpdate {
+	_u.mutation.ClearSearchTerms()
+	return _u
+}
+
+// SetContentTextKey sets the "content_text_key" field.
+func (_u *DocumentContentUpdate) SetContentTextKey(v string) *DocumentContentUpdate {
+	_u.mutation.SetContentTextKey(v)
+	return _u
+}
+
+// SetNillableContentTextKey sets the "content_text_key" field if the given value is not nil.
+func (_u *DocumentContentUpdate) SetNillableContentTextKey(v *string) *DocumentContentUpdate {
+	if v != nil {
+		_u.SetContentTextKey(*v)
+	}
+	return _u
+}
+
+// ClearContentTextKey clears the value of the "content_text_key" field.
+func (_u *DocumentContentUpdate) ClearContentTextKey() *DocumentContentUpdate {
+	_u.mutation.ClearContentTextKey()
+	return _u
+}
+
+// SetExtractedMetadata sets the "extracted_metadata" field.
+func (_u *DocumentContentUpdate) SetExtractedMetadata(v map[string]string) *DocumentContentUpdate {
+	_u.mutation.SetExtractedMetadata(v)
+	return _u
+}
+
+// ClearExtractedMetadata clears the value of the "extracted_metadata" field.
+func (_u *DocumentContentUpdate) ClearExtractedMetadata() *DocumentContentUpdate {
+	_u.mutation.ClearExtractedMetadata()
+	return _u
+}
+
+// SetDocument sets the "document" edge to the Document entity.
+func (_u *DocumentContentUpdate) SetDocument(v *Document) *DocumentContentUpdate {
+	return _u.SetDocumentID(v.ID)
+}
+
+// Mutation returns the DocumentContentMutation object of the builder.
+func (_u *DocumentContentUpdate) Mutation() *DocumentContentMutation {
+	return _u.mutation
+}
+
+// ClearDocument clears the "document" edge to the Document entity.
+func (_u *DocumentContentUpdate) ClearDocument() *DocumentContentUpdate {
+	_u.mutation.ClearDocument()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DocumentContentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DocumentContentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DocumentContentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DocumentContentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DocumentContentUpdate) check() error {
+	if v, ok := _u.mutation.DocumentID(); ok {
+		if err := documentcontent.DocumentIDValidator(v); err != nil {
+			return &ValidationError{Name: "document_id", err: fmt.Errorf(`ent: validator failed for field "DocumentContent.document_id": %w`, err)}
+		}
+	}
+	if _u.mutation.DocumentCleared() && len(_u.mutation.DocumentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DocumentContent.document"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *DocumentContentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DocumentContentUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *DocumentContentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(documentcontent.Table, documentcontent.Columns, sqlgraph.NewFieldSpec(documentcontent.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(documentcontent.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ContentText(); ok {
+		_spec.SetField(documentcontent.FieldContentText, field.TypeString, value)
+	}
+	if _u.mutation.ContentTextCleared() {
+		_spec.ClearField(documentcontent.FieldContentText, field.TypeString)
+	}
+	if value, ok := _u.mutation.ContentTextCompressed(); ok {
+		_spec.SetField(documentcontent.FieldContentTextCompressed, field.TypeBytes, value)
+	}
+	if _u.mutation.ContentTextCompressedCleared() {
+		_spec.ClearField(documentcontent.FieldContentTextCompressed, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.SearchTerms(); ok {
+		_spec.SetField(documentcontent.FieldSearchTerms, field.TypeString, value)
+	}
+	if _u.mutation.SearchTermsCleared() {
+		_spec.ClearField(documentcontent.FieldSearchTerms, field.TypeString)
+	}
+	if value, ok := _u.mutation.ContentTextKey(); ok {
+		_spec.SetField(documentcontent.FieldContentTextKey, field.TypeString, value)
+	}
+	if _u.mutation.ContentTextKeyCleared() {
+		_spec.ClearField(documentcontent.FieldContentTextKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExtractedMetadata(); ok {
+		_spec.SetField(documentcontent.FieldExtractedMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.ExtractedMetadataCleared() {
+		_spec.ClearField(documentcontent.FieldExtractedMetadata, field.TypeJSON)
+	}
+	if _u.mutation.DocumentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   documentcontent.DocumentTable,
+			Columns: []string{documentcontent.DocumentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DocumentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   documentcontent.DocumentTable,
+			Columns: []string{documentcontent.DocumentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{documentcontent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DocumentContentUpdateOne is the builder for updating a single DocumentContent entity.
+type DocumentContentUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *DocumentContentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetDocumentID sets the "document_id" field.
+func (_u *DocumentContentUpdateOne) SetDocumentID(v string) *DocumentContentUpdateOne {
+	_u.mutation.SetDocumentID(v)
+	return _u
+}
+
+// SetNillableDocumentID sets the "document_id" field if the given value is not nil.
+func (_u *DocumentContentUpdateOne) SetNillableDocumentID(v *string) *DocumentContentUpdateOne {
+	if v != nil {
+		_u.SetDocumentID(*v)
+	}
+	return _u
+}
+
+// SetContentText sets the "content_text" field.
+func (_u *DocumentContentUpdateOne) SetContentText(v string) *DocumentContentUpdateOne {
+	_u.mutation.SetContentText(v)
+	return _u
+}
+
+// SetNillableContentText sets the "content_text" field if the given value is not nil.
+func (_u *DocumentContentUpdateOne) SetNillableContentText(v *string) *DocumentContentUpdateOne {
+	if v != nil {
+		_u.SetContentText(*v)
+	}
+	return _u
+}
+
+// ClearContentText clears the value of the "content_text" field.
+func (_u *DocumentContentUpdateOne) ClearContentText() *DocumentContentUpdateOne {
+	_u.mutation.ClearContentText()
+	return _u
+}
+
+// SetContentTextCompressed sets the "content_text_compressed" field.
+func (_u *DocumentContentUpdateOne) SetContentTextCompressed(v []byte) *DocumentContentUpdateOne {
+	_u.mutation.SetContentTextCompressed(v)
+	return _u
+}
+
+// ClearContentTextCompressed clears the value of the "content_text_compressed" field.
+func (_u *DocumentContentUpdateOne) ClearContentTextCompressed() *DocumentContentUpdateOne {
+	_u.mutation.ClearContentTextCompressed()
+	return _u
+}
+
+// SetSearchTerms sets the "search_terms" field.
+func (_u *DocumentContentUpdateOne) SetSearchTerms(v string) *DocumentContentUpdateOne {
+	_u.mutation.SetSearchTerms(v)
+	return _u
+}
+
+// SetNillableSearchTerms sets the "search_terms" field if the given value is not nil.
+func (_u *DocumentContentUpdateOne) SetNillableSearchTerms(v *string) *DocumentContentUpdateOne {
+	if v != nil {
+		_u.SetSearchTerms(*v)
+	}
+	return _u
+}
+
+// ClearSearchTerms clears the value of the "search_terms" field.
+func (_u *DocumentContentUpdateOne) ClearSearchTerms() *DocumentContentUpdateOne {
+	_u.mutation.ClearSearchTerms()
+	return _u
+}
+
+// SetContentTextKey sets the "content_text_key" field.
+func (_u *DocumentContentUpdateOne) SetContentTextKey(v string) *DocumentContentUpdateOne {
+	_u.mutation.SetContentTextKey(v)
+	return _u
+}
+
+// SetNillableContentTextKey sets the "content_text_key" field if the given value is not nil.
+func (_u *DocumentContentUpdateOne) SetNillableContentTextKey(v *string) *DocumentContentUpdateOne {
+	if v != nil {
+		_u.SetContentTextKey(*v)
+	}
+	return _u
+}
+
+// ClearContentTextKey clears the value of the "content_text_key" field.
+func (_u *DocumentContentUpdateOne) ClearContentTextKey() *DocumentContentUpdateOne {
+	_u.mutation.ClearContentTextKey()
+	return _u
+}
+
+// SetExtractedMetadata sets the "extracted_metadata" field.
+func (_u *DocumentContentUpdateOne) SetExtractedMetadata(v map[string]string) *DocumentContentUpdateOne {
+	_u.mutation.SetExtractedMetadata(v)
+	return _u
+}
+
+// ClearExtractedMetadata clears the value of the "extracted_metadata" field.
+func (_u *DocumentContentUpdateOne) ClearExtractedMetadata() *DocumentContentUpdateOne {
+	_u.mutation.ClearExtractedMetadata()
+	return _u
+}
+
+// SetDocument sets the "document" edge to the Document entity.
+func (_u *DocumentContentUpdateOne) SetDocument(v *Document) *DocumentContentUpdateOne {
+	return _u.SetDocumentID(v.ID)
+}
+
+// Mutation returns the DocumentContentMutation object of the builder.
+func (_u *DocumentContentUpdateOne) Mutation() *DocumentContentMutation {
+	return _u.mutation
+}
+
+// ClearDocument clears the "document" edge to the Document entity.
+func (_u *DocumentContentUpdateOne) ClearDocument() *DocumentContentUpdateOne {
+	_u.mutation.ClearDocument()
+	return _u
+}
+
+// Where appends a list predicates to the DocumentContentUpdate builder.
+func (_u *DocumentContentUpdateOne) Where(ps ...predicate.DocumentContent) *DocumentContentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DocumentContentUpdateOne) Select(field string, fields ...string) *DocumentContentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DocumentContent entity.
+func (_u *DocumentContentUpdateOne) Save(ctx context.Context) (*DocumentContent, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DocumentContentUpdateOne) SaveX(ctx context.Context) *DocumentContent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DocumentContentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DocumentContentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DocumentContentUpdateOne) check() error {
+	if v, ok := _u.mutation.DocumentID(); ok {
+		if err := documentcontent.DocumentIDValidator(v); err != nil {
+			return &ValidationError{Name: "document_id", err: fmt.Errorf(`ent: validator failed for field "DocumentContent.document_id": %w`, err)}
+		}
+	}
+	if _u.mutation.DocumentCleared() && len(_u.mutation.DocumentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DocumentContent.document"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *DocumentContentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DocumentContentUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *DocumentContentUpdateOne) sqlSave(ctx context.Context) (_node *DocumentContent, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(documentcontent.Table, documentcontent.Columns, sqlgraph.NewFieldSpec(documentcontent.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DocumentContent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, documentcontent.FieldID)
+		for _, f := range fields {
+			if !documentcontent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != documentcontent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(documentcontent.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ContentText(); ok {
+		_spec.SetField(documentcontent.FieldContentText, field.TypeString, value)
+	}
+	if _u.mutation.ContentTextCleared() {
+		_spec.ClearField(documentcontent.FieldContentText, field.TypeString)
+	}
+	if value, ok := _u.mutation.ContentTextCompressed(); ok {
+		_spec.SetField(documentcontent.FieldContentTextCompressed, field.TypeBytes, value)
+	}
+	if _u.mutation.ContentTextCompressedCleared() {
+		_spec.ClearField(documentcontent.FieldContentTextCompressed, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.SearchTerms(); ok {
+		_spec.SetField(documentcontent.FieldSearchTerms, field.TypeString, value)
+	}
+	if _u.mutation.SearchTermsCleared() {
+		_spec.ClearField(documentcontent.FieldSearchTerms, field.TypeString)
+	}
+	if value, ok := _u.mutation.ContentTextKey(); ok {
+		_spec.SetField(documentcontent.FieldContentTextKey, field.TypeString, value)
+	}
+	if _u.mutation.ContentTextKeyCleared() {
+		_spec.ClearField(documentcontent.FieldContentTextKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExtractedMetadata(); ok {
+		_spec.SetField(documentcontent.FieldExtractedMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.ExtractedMetadataCleared() {
+		_spec.ClearField(documentcontent.FieldExtractedMetadata, field.TypeJSON)
+	}
+	if _u.mutation.DocumentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   documentcontent.DocumentTable,
+			Columns: []string{documentcontent.DocumentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DocumentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   documentcontent.DocumentTable,
+			Columns: []string{documentcontent.DocumentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &DocumentContent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{documentcontent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
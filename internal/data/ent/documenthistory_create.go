@@ -0,0 +1,1040 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documenthistory"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/schema"
+)
+
+// DocumentHistoryCreate is the builder for creating a DocumentHistory entity.
+type DocumentHistoryCreate struct {
+	config
+	mutation *DocumentHistoryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *DocumentHistoryCreate) SetCreateTime(v time.Time) *DocumentHistoryCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *DocumentHistoryCreate) SetNillableCreateTime(v *time.Time) *DocumentHistoryCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *DocumentHistoryCreate) SetUpdateTime(v time.Time) *DocumentHistoryCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *DocumentHistoryCreate) SetNillableUpdateTime(v *time.Time) *DocumentHistoryCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *DocumentHistoryCreate) SetDeleteTime(v time.Time) *DocumentHistoryCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *DocumentHistoryCreate) SetNillableDeleteTime(v *time.Time) *DocumentHistoryCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *DocumentHistoryCreate) SetTenantID(v uint32) *DocumentHistoryCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *DocumentHistoryCreate) SetNillableTenantID(v *uint32) *DocumentHistoryCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetDocumentID sets the "document_id" field.
+func (_c *DocumentHistoryCreate) SetDocumentID(v string) *DocumentHistoryCreate {
+	_c.mutation.SetDocumentID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *DocumentHistoryCreate) SetUserID(v string) *DocumentHistoryCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *DocumentHistoryCreate) SetNillableUserID(v *string) *DocumentHistoryCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *DocumentHistoryCreate) SetVersion(v uint32) *DocumentHistoryCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *DocumentHistoryCreate) SetNillableVersion(v *uint32) *DocumentHistoryCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
+// SetChangedFields sets the "changed_fields" field.
+func (_c *DocumentHistoryCreate) SetChangedFields(v []string) *DocumentHistoryCreate {
+	_c.mutation.SetChangedFields(v)
+	return _c
+}
+
+// SetBefore sets the "before" field.
+func (_c *DocumentHistoryCreate) SetBefore(v schema.DocumentSnapshot) *DocumentHistoryCreate {
+	_c.mutation.SetBefore(v)
+	return _c
+}
+
+// SetAfter sets the "after" field.
+func (_c *DocumentHistoryCreate) SetAfter(v schema.DocumentSnapshot) *DocumentHistoryCreate {
+	_c.mutation.SetAfter(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DocumentHistoryCreate) SetID(v uint32) *DocumentHistoryCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the DocumentHistoryMutation object of the builder.
+func (_c *DocumentHistoryCreate) Mutation() *DocumentHistoryMutation {
+	return _c.mutation
+}
+
+// Save creates the DocumentHistory in the database.
+func (_c *DocumentHistoryCreate) Save(ctx context.Context) (*DocumentHistory, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DocumentHistoryCreate) SaveX(ctx context.Context) *DocumentHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DocumentHistoryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DocumentHistoryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DocumentHistoryCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := documenthistory.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		v := documenthistory.DefaultVersion
+		_c.mutation.SetVersion(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DocumentHistoryCreate) check() error {
+	if _, ok := _c.mutation.DocumentID(); !ok {
+		return &ValidationError{Name: "document_id", err: errors.New(`ent: missing required field "DocumentHistory.document_id"`)}
+	}
+	if v, ok := _c.mutation.DocumentID(); ok {
+		if err := documenthistory.DocumentIDValidator(v); err != nil {
+			return &ValidationError{Name: "document_id", err: fmt.Errorf(`ent: validator failed for field "DocumentHistory.document_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := documenthistory.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "DocumentHistory.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "DocumentHistory.version"`)}
+	}
+	if _, ok := _c.mutation.ChangedFields(); !ok {
+		return &ValidationError{Name: "changed_fields", err: errors.New(`ent: missing required field "DocumentHistory.changed_fields"`)}
+	}
+	if _, ok := _c.mutation.Before(); !ok {
+		return &ValidationError{Name: "before", err: errors.New(`ent: missing required field "DocumentHistory.before"`)}
+	}
+	if _, ok := _c.mutation.After(); !ok {
+		return &ValidationError{Name: "after", err: errors.New(`ent: missing required field "DocumentHistory.after"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := documenthistory.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "DocumentHistory.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *DocumentHistoryCreate) sqlSave(ctx context.Context) (*DocumentHistory, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DocumentHistoryCreate) createSpec() (*DocumentHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DocumentHistory{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(documenthistory.Table, sqlgraph.NewFieldSpec(documenthistory.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(documenthistory.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(documenthistory.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(documenthistory.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(documenthistory.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.DocumentID(); ok {
+		_spec.SetField(documenthistory.FieldDocumentID, field.TypeString, value)
+		_node.DocumentID = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(documenthistory.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(documenthistory.FieldVersion, field.TypeUint32, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.ChangedFields(); ok {
+		_spec.SetField(documenthistory.FieldChangedFields, field.TypeJSON, value)
+		_node.ChangedFields = value
+	}
+	if value, ok := _c.mutation.Before(); ok {
+		_spec.SetField(documenthistory.FieldBefore, field.TypeJSON, value)
+		_node.Before = value
+	}
+	if value, ok := _c.mutation.After(); ok {
+		_spec.SetField(documenthistory.FieldAfter, field.TypeJSON, value)
+		_node.After = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DocumentHistory.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DocumentHistoryUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DocumentHistoryCreate) OnConflict(opts ...sql.ConflictOption) *DocumentHistoryUpsertOne {
+	_c.conflict = opts
+	return &DocumentHistoryUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DocumentHistory.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DocumentHistoryCreate) OnConflictColumns(columns ...string) *DocumentHistoryUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DocumentHistoryUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DocumentHistoryUpsertOne is the builder for "upsert"-ing
+	//  one DocumentHistory node.
+	DocumentHistoryUpsertOne struct {
+		create *DocumentHistoryCreate
+	}
+
+	// DocumentHistoryUpsert is the "OnConflict" setter.
+	DocumentHistoryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *DocumentHistoryUpsert) SetUpdateTime(v time.Time) *DocumentHistoryUpsert {
+	u.Set(documenthistory.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *DocumentHistoryUpsert) UpdateUpdateTime() *DocumentHistoryUpsert {
+	u.SetExcluded(documenthistory.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *DocumentHistoryUpsert) ClearUpdateTime() *DocumentHistoryUpsert {
+	u.SetNull(documenthistory.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *DocumentHistoryUpsert) SetDeleteTime(v time.Time) *DocumentHistoryUpsert {
+	u.Set(documenthistory.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *DocumentHistoryUpsert) UpdateDeleteTime() *DocumentHistoryUpsert {
+	u.SetExcluded(documenthistory.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *DocumentHistoryUpsert) ClearDeleteTime() *DocumentHistoryUpsert {
+	u.SetNull(documenthistory.FieldDeleteTime)
+	return u
+}
+
+// SetDocumentID sets the "document_id" field.
+func (u *DocumentHistoryUpsert) SetDocumentID(v string) *DocumentHistoryUpsert {
+	u.Set(documenthistory.FieldDocumentID, v)
+	return u
+}
+
+// UpdateDocumentID sets the "document_id" field to the value that was provided on create.
+func (u *DocumentHistoryUpsert) UpdateDocumentID() *DocumentHistoryUpsert {
+	u.SetExcluded(documenthistory.FieldDocumentID)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *DocumentHistoryUpsert) SetUserID(v string) *DocumentHistoryUpsert {
+	u.Set(documenthistory.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *DocumentHistoryUpsert) UpdateUserID() *DocumentHistoryUpsert {
+	u.SetExcluded(documenthistory.FieldUserID)
+	return u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *DocumentHistoryUpsert) ClearUserID() *DocumentHistoryUpsert {
+	u.SetNull(documenthistory.FieldUserID)
+	return u
+}
+
+// SetVersion sets the "version" field.
+func (u *DocumentHistoryUpsert) SetVersion(v uint32) *DocumentHistoryUpsert {
+	u.Set(documenthistory.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *DocumentHistoryUpsert) UpdateVersion() *DocumentHistoryUpsert {
+	u.SetExcluded(documenthistory.FieldVersion)
+	return u
+}
+
+// AddVersion adds v to the "version" field.
+func (u *DocumentHistoryUpsert) AddVersion(v uint32) *DocumentHistoryUpsert {
+	u.Add(documenthistory.FieldVersion, v)
+	return u
+}
+
+// SetChangedFields sets the "changed_fields" field.
+func (u *DocumentHistoryUpsert) SetChangedFields(v []string) *DocumentHistoryUpsert {
+	u.Set(documenthistory.FieldChangedFields, v)
+	return u
+}
+
+// UpdateChangedFields sets the "changed_fields" field to the value that was provided on create.
+func (u *DocumentHistoryUpsert) UpdateChangedFields() *DocumentHistoryUpsert {
+	u.SetExcluded(documenthistory.FieldChangedFields)
+	return u
+}
+
+// SetBefore sets the "before" field.
+func (u *DocumentHistoryUpsert) SetBefore(v schema.DocumentSnapshot) *DocumentHistoryUpsert {
+	u.Set(documenthistory.FieldBefore, v)
+	return u
+}
+
+// UpdateBefore sets the "before" field to the value that was provided on create.
+func (u *DocumentHistoryUpsert) UpdateBefore() *DocumentHistoryUpsert {
+	u.SetExcluded(documenthistory.FieldBefore)
+	return u
+}
+
+// SetAfter sets the "after" field.
+func (u *DocumentHistoryUpsert) SetAfter(v schema.DocumentSnapshot) *DocumentHistoryUpsert {
+	u.Set(documenthistory.FieldAfter, v)
+	return u
+}
+
+// UpdateAfter sets the "after" field to the value that was provided on create.
+func (u *DocumentHistoryUpsert) UpdateAfter() *DocumentHistoryUpsert {
+	u.SetExcluded(documenthistory.FieldAfter)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DocumentHistory.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(documenthistory.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DocumentHistoryUpsertOne) UpdateNewValues() *DocumentHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(documenthistory.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(documenthistory.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(documenthistory.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DocumentHistory.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DocumentHistoryUpsertOne) Ignore() *DocumentHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DocumentHistoryUpsertOne) DoNothing() *DocumentHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DocumentHistoryCreate.OnConflict
+// documentation for more info.
+func (u *DocumentHistoryUpsertOne) Update(set func(*DocumentHistoryUpsert)) *DocumentHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DocumentHistoryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *DocumentHistoryUpsertOne) SetUpdateTime(v time.Time) *DocumentHistoryUpsertOne {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *DocumentHistoryUpsertOne) UpdateUpdateTime() *DocumentHistoryUpsertOne {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *DocumentHistoryUpsertOne) ClearUpdateTime() *DocumentHistoryUpsertOne {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *DocumentHistoryUpsertOne) SetDeleteTime(v time.Time) *DocumentHistoryUpsertOne {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *DocumentHistoryUpsertOne) UpdateDeleteTime() *DocumentHistoryUpsertOne {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *DocumentHistoryUpsertOne) ClearDeleteTime() *DocumentHistoryUpsertOne {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetDocumentID sets the "document_id" field.
+func (u *DocumentHistoryUpsertOne) SetDocumentID(v string) *DocumentHistoryUpsertOne {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.SetDocumentID(v)
+	})
+}
+
+// UpdateDocumentID sets the "document_id" field to the value that was provided on create.
+func (u *DocumentHistoryUpsertOne) UpdateDocumentID() *DocumentHistoryUpsertOne {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.UpdateDocumentID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *DocumentHistoryUpsertOne) SetUserID(v string) *DocumentHistoryUpsertOne {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *DocumentHistoryUpsertOne) UpdateUserID() *DocumentHistoryUpsertOne {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *DocumentHistoryUpsertOne) ClearUserID() *DocumentHistoryUpsertOne {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *DocumentHistoryUpsertOne) SetVersion(v uint32) *DocumentHistoryUpsertOne {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *DocumentHistoryUpsertOne) AddVersion(v uint32) *DocumentHistoryUpsertOne {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *DocumentHistoryUpsertOne) UpdateVersion() *DocumentHistoryUpsertOne {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetChangedFields sets the "changed_fields" field.
+func (u *DocumentHistoryUpsertOne) SetChangedFields(v []string) *DocumentHistoryUpsertOne {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.SetChangedFields(v)
+	})
+}
+
+// UpdateChangedFields sets the "changed_fields" field to the value that was provided on create.
+func (u *DocumentHistoryUpsertOne) UpdateChangedFields() *DocumentHistoryUpsertOne {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.UpdateChangedFields()
+	})
+}
+
+// SetBefore sets the "before" field.
+func (u *DocumentHistoryUpsertOne) SetBefore(v schema.DocumentSnapshot) *DocumentHistoryUpsertOne {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.SetBefore(v)
+	})
+}
+
+// UpdateBefore sets the "before" field to the value that was provided on create.
+func (u *DocumentHistoryUpsertOne) UpdateBefore() *DocumentHistoryUpsertOne {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.UpdateBefore()
+	})
+}
+
+// SetAfter sets the "after" field.
+func (u *DocumentHistoryUpsertOne) SetAfter(v schema.DocumentSnapshot) *DocumentHistoryUpsertOne {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.SetAfter(v)
+	})
+}
+
+// UpdateAfter sets the "after" field to the value that was provided on create.
+func (u *DocumentHistoryUpsertOne) UpdateAfter() *DocumentHistoryUpsertOne {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.UpdateAfter()
+	})
+}
+
+// Exec executes the query.
+func (u *DocumentHistoryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DocumentHistoryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DocumentHistoryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DocumentHistoryUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DocumentHistoryUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DocumentHistoryCreateBulk is the builder for creating many DocumentHistory entities in bulk.
+type DocumentHistoryCreateBulk struct {
+	config
+	err      error
+	builders []*DocumentHistoryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DocumentHistory entities in the database.
+func (_c *DocumentHistoryCreateBulk) Save(ctx context.Context) ([]*DocumentHistory, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DocumentHistory, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DocumentHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DocumentHistoryCreateBulk) SaveX(ctx context.Context) []*DocumentHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DocumentHistoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DocumentHistoryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DocumentHistory.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DocumentHistoryUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DocumentHistoryCreateBulk) OnConflict(opts ...sql.ConflictOption) *DocumentHistoryUpsertBulk {
+	_c.conflict = opts
+	return &DocumentHistoryUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DocumentHistory.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DocumentHistoryCreateBulk) OnConflictColumns(columns ...string) *DocumentHistoryUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DocumentHistoryUpsertBulk{
+		create: _c,
+	}
+}
+
+// DocumentHistoryUpsertBulk is the builder for "upsert"-ing
+// a bulk of DocumentHistory nodes.
+type DocumentHistoryUpsertBulk struct {
+	create *DocumentHistoryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DocumentHistory.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(documenthistory.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DocumentHistoryUpsertBulk) UpdateNewValues() *DocumentHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(documenthistory.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(documenthistory.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(documenthistory.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DocumentHistory.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DocumentHistoryUpsertBulk) Ignore() *DocumentHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DocumentHistoryUpsertBulk) DoNothing() *DocumentHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DocumentHistoryCreateBulk.OnConflict
+// documentation for more info.
+func (u *DocumentHistoryUpsertBulk) Update(set func(*DocumentHistoryUpsert)) *DocumentHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DocumentHistoryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *DocumentHistoryUpsertBulk) SetUpdateTime(v time.Time) *DocumentHistoryUpsertBulk {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *DocumentHistoryUpsertBulk) UpdateUpdateTime() *DocumentHistoryUpsertBulk {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *DocumentHistoryUpsertBulk) ClearUpdateTime() *DocumentHistoryUpsertBulk {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *DocumentHistoryUpsertBulk) SetDeleteTime(v time.Time) *DocumentHistoryUpsertBulk {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *DocumentHistoryUpsertBulk) UpdateDeleteTime() *DocumentHistoryUpsertBulk {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *DocumentHistoryUpsertBulk) ClearDeleteTime() *DocumentHistoryUpsertBulk {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetDocumentID sets the "document_id" field.
+func (u *DocumentHistoryUpsertBulk) SetDocumentID(v string) *DocumentHistoryUpsertBulk {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.SetDocumentID(v)
+	})
+}
+
+// UpdateDocumentID sets the "document_id" field to the value that was provided on create.
+func (u *DocumentHistoryUpsertBulk) UpdateDocumentID() *DocumentHistoryUpsertBulk {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.UpdateDocumentID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *DocumentHistoryUpsertBulk) SetUserID(v string) *DocumentHistoryUpsertBulk {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *DocumentHistoryUpsertBulk) UpdateUserID() *DocumentHistoryUpsertBulk {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *DocumentHistoryUpsertBulk) ClearUserID() *DocumentHistoryUpsertBulk {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *DocumentHistoryUpsertBulk) SetVersion(v uint32) *DocumentHistoryUpsertBulk {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *DocumentHistoryUpsertBulk) AddVersion(v uint32) *DocumentHistoryUpsertBulk {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *DocumentHistoryUpsertBulk) UpdateVersion() *DocumentHistoryUpsertBulk {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetChangedFields sets the "changed_fields" field.
+func (u *DocumentHistoryUpsertBulk) SetChangedFields(v []string) *DocumentHistoryUpsertBulk {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.SetChangedFields(v)
+	})
+}
+
+// UpdateChangedFields sets the "changed_fields" field to the value that was provided on create.
+func (u *DocumentHistoryUpsertBulk) UpdateChangedFields() *DocumentHistoryUpsertBulk {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.UpdateChangedFields()
+	})
+}
+
+// SetBefore sets the "before" field.
+func (u *DocumentHistoryUpsertBulk) SetBefore(v schema.DocumentSnapshot) *DocumentHistoryUpsertBulk {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.SetBefore(v)
+	})
+}
+
+// UpdateBefore sets the "before" field to the value that was provided on create.
+func (u *DocumentHistoryUpsertBulk) UpdateBefore() *DocumentHistoryUpsertBulk {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.UpdateBefore()
+	})
+}
+
+// SetAfter sets the "after" field.
+func (u *DocumentHistoryUpsertBulk) SetAfter(v schema.DocumentSnapshot) *DocumentHistoryUpsertBulk {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.SetAfter(v)
+	})
+}
+
+// UpdateAfter sets the "after" field to the value that was provided on create.
+func (u *DocumentHistoryUpsertBulk) UpdateAfter() *DocumentHistoryUpsertBulk {
+	return u.Update(func(s *DocumentHistoryUpsert) {
+		s.UpdateAfter()
+	})
+}
+
+// Exec executes the query.
+func (u *DocumentHistoryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DocumentHistoryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DocumentHistoryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DocumentHistoryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,594 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documenthistory"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/schema"
+)
+
+// DocumentHistoryUpdate is the builder for updating DocumentHistory entities.
+type DocumentHistoryUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *DocumentHistoryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the DocumentHistoryUpdate builder.
+func (_u *DocumentHistoryUpdate) Where(ps ...predicate.DocumentHistory) *DocumentHistoryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *DocumentHistoryUpdate) SetUpdateTime(v time.Time) *DocumentHistoryUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *DocumentHistoryUpdate) SetNillableUpdateTime(v *time.Time) *DocumentHistoryUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *DocumentHistoryUpdate) ClearUpdateTime() *DocumentHistoryUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *DocumentHistoryUpdate) SetDeleteTime(v time.Time) *DocumentHistoryUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *DocumentHistoryUpdate) SetNillableDeleteTime(v *time.Time) *DocumentHistoryUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *DocumentHistoryUpdate) ClearDeleteTime() *DocumentHistoryUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetDocumentID sets the "document_id" field.
+func (_u *DocumentHistoryUpdate) SetDocumentID(v string) *DocumentHistoryUpdate {
+	_u.mutation.SetDocumentID(v)
+	return _u
+}
+
+// SetNillableDocumentID sets the "document_id" field if the given value is not nil.
+func (_u *DocumentHistoryUpdate) SetNillableDocumentID(v *string) *DocumentHistoryUpdate {
+	if v != nil {
+		_u.SetDocumentID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *DocumentHistoryUpdate) SetUserID(v string) *DocumentHistoryUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *DocumentHistoryUpdate) SetNillableUserID(v *string) *DocumentHistoryUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *DocumentHistoryUpdate) ClearUserID() *DocumentHistoryUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *DocumentHistoryUpdate) SetVersion(v uint32) *DocumentHistoryUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *DocumentHistoryUpdate) SetNillableVersion(v *uint32) *DocumentHistoryUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *DocumentHistoryUpdate) AddVersion(v int32) *DocumentHistoryUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetChangedFields sets the "changed_fields" field.
+func (_u *DocumentHistoryUpdate) SetChangedFields(v []string) *DocumentHistoryUpdate {
+	_u.mutation.SetChangedFields(v)
+	return _u
+}
+
+// AppendChangedFields appends value to the "changed_fields" field.
+func (_u *DocumentHistoryUpdate) AppendChangedFields(v []string) *DocumentHistoryUpdate {
+	_u.mutation.AppendChangedFields(v)
+	return _u
+}
+
+// SetBefore sets the "before" field.
+func (_u *DocumentHistoryUpdate) SetBefore(v schema.DocumentSnapshot) *DocumentHistoryUpdate {
+	_u.mutation.SetBefore(v)
+	return _u
+}
+
+// SetNillableBefore sets the "before" field if the given value is not nil.
+func (_u *DocumentHistoryUpdate) SetNillableBefore(v *schema.DocumentSnapshot) *DocumentHistoryUpdate {
+	if v != nil {
+		_u.SetBefore(*v)
+	}
+	return _u
+}
+
+// SetAfter sets the "after" field.
+func (_u *DocumentHistoryUpdate) SetAfter(v schema.DocumentSnapshot) *DocumentHistoryUpdate {
+	_u.mutation.SetAfter(v)
+	return _u
+}
+
+// SetNillableAfter sets the "after" field if the given value is not nil.
+func (_u *DocumentHistoryUpdate) SetNillableAfter(v *schema.DocumentSnapshot) *DocumentHistoryUpdate {
+	if v != nil {
+		_u.SetAfter(*v)
+	}
+	return _u
+}
+
+// Mutation returns the DocumentHistoryMutation object of the builder.
+func (_u *DocumentHistoryUpdate) Mutation() *DocumentHistoryMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DocumentHistoryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DocumentHistoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DocumentHistoryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DocumentHistoryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DocumentHistoryUpdate) check() error {
+	if v, ok := _u.mutation.DocumentID(); ok {
+		if err := documenthistory.DocumentIDValidator(v); err != nil {
+			return &ValidationError{Name: "document_id", err: fmt.Errorf(`ent: validator failed for field "DocumentHistory.document_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := documenthistory.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "DocumentHistory.user_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *DocumentHistoryUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DocumentHistoryUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *DocumentHistoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(documenthistory.Table, documenthistory.Columns, sqlgraph.NewFieldSpec(documenthistory.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(documenthistory.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(documenthistory.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(documenthistory.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(documenthistory.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(documenthistory.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(documenthistory.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DocumentID(); ok {
+		_spec.SetField(documenthistory.FieldDocumentID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(documenthistory.FieldUserID, field.TypeString, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(documenthistory.FieldUserID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(documenthistory.FieldVersion, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(documenthistory.FieldVersion, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.ChangedFields(); ok {
+		_spec.SetField(documenthistory.FieldChangedFields, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedChangedFields(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, documenthistory.FieldChangedFields, value)
+		})
+	}
+	if value, ok := _u.mutation.Before(); ok {
+		_spec.SetField(documenthistory.FieldBefore, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.After(); ok {
+		_spec.SetField(documenthistory.FieldAfter, field.TypeJSON, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{documenthistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DocumentHistoryUpdateOne is the builder for updating a single DocumentHistory entity.
+type DocumentHistoryUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *DocumentHistoryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *DocumentHistoryUpdateOne) SetUpdateTime(v time.Time) *DocumentHistoryUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *DocumentHistoryUpdateOne) SetNillableUpdateTime(v *time.Time) *DocumentHistoryUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *DocumentHistoryUpdateOne) ClearUpdateTime() *DocumentHistoryUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *DocumentHistoryUpdateOne) SetDeleteTime(v time.Time) *DocumentHistoryUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *DocumentHistoryUpdateOne) SetNillableDeleteTime(v *time.Time) *DocumentHistoryUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *DocumentHistoryUpdateOne) ClearDeleteTime() *DocumentHistoryUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetDocumentID sets the "document_id" field.
+func (_u *DocumentHistoryUpdateOne) SetDocumentID(v string) *DocumentHistoryUpdateOne {
+	_u.mutation.SetDocumentID(v)
+	return _u
+}
+
+// SetNillableDocumentID sets the "document_id" field if the given value is not nil.
+func (_u *DocumentHistoryUpdateOne) SetNillableDocumentID(v *string) *DocumentHistoryUpdateOne {
+	if v != nil {
+		_u.SetDocumentID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *DocumentHistoryUpdateOne) SetUserID(v string) *DocumentHistoryUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *DocumentHistoryUpdateOne) SetNillableUserID(v *string) *DocumentHistoryUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *DocumentHistoryUpdateOne) ClearUserID() *DocumentHistoryUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *DocumentHistoryUpdateOne) SetVersion(v uint32) *DocumentHistoryUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *DocumentHistoryUpdateOne) SetNillableVersion(v *uint32) *DocumentHistoryUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *DocumentHistoryUpdateOne) AddVersion(v int32) *DocumentHistoryUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetChangedFields sets the "changed_fields" field.
+func (_u *DocumentHistoryUpdateOne) SetChangedFields(v []string) *DocumentHistoryUpdateOne {
+	_u.mutation.SetChangedFields(v)
+	return _u
+}
+
+// AppendChangedFields appends value to the "changed_fields" field.
+func (_u *DocumentHistoryUpdateOne) AppendChangedFields(v []string) *DocumentHistoryUpdateOne {
+	_u.mutation.AppendChangedFields(v)
+	return _u
+}
+
+// SetBefore sets the "before" field.
+func (_u *DocumentHistoryUpdateOne) SetBefore(v schema.DocumentSnapshot) *DocumentHistoryUpdateOne {
+	_u.mutation.SetBefore(v)
+	return _u
+}
+
+// SetNillableBefore sets the "before" field if the given value is not nil.
+func (_u *DocumentHistoryUpdateOne) SetNillableBefore(v *schema.DocumentSnapshot) *DocumentHistoryUpdateOne {
+	if v != nil {
+		_u.SetBefore(*v)
+	}
+	return _u
+}
+
+// SetAfter sets the "after" field.
+func (_u *DocumentHistoryUpdateOne) SetAfter(v schema.DocumentSnapshot) *DocumentHistoryUpdateOne {
+	_u.mutation.SetAfter(v)
+	return _u
+}
+
+// SetNillableAfter sets the "after" field if the given value is not nil.
+func (_u *DocumentHistoryUpdateOne) SetNillableAfter(v *schema.DocumentSnapshot) *DocumentHistoryUpdateOne {
+	if v != nil {
+		_u.SetAfter(*v)
+	}
+	return _u
+}
+
+// Mutation returns the DocumentHistoryMutation object of the builder.
+func (_u *DocumentHistoryUpdateOne) Mutation() *DocumentHistoryMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DocumentHistoryUpdate builder.
+func (_u *DocumentHistoryUpdateOne) Where(ps ...predicate.DocumentHistory) *DocumentHistoryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DocumentHistoryUpdateOne) Select(field string, fields ...string) *DocumentHistoryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DocumentHistory entity.
+func (_u *DocumentHistoryUpdateOne) Save(ctx context.Context) (*DocumentHistory, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DocumentHistoryUpdateOne) SaveX(ctx context.Context) *DocumentHistory {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DocumentHistoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DocumentHistoryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DocumentHistoryUpdateOne) check() error {
+	if v, ok := _u.mutation.DocumentID(); ok {
+		if err := documenthistory.DocumentIDValidator(v); err != nil {
+			return &ValidationError{Name: "document_id", err: fmt.Errorf(`ent: validator failed for field "DocumentHistory.document_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := documenthistory.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "DocumentHistory.user_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *DocumentHistoryUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DocumentHistoryUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *DocumentHistoryUpdateOne) sqlSave(ctx context.Context) (_node *DocumentHistory, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(documenthistory.Table, documenthistory.Columns, sqlgraph.NewFieldSpec(documenthistory.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DocumentHistory.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, documenthistory.FieldID)
+		for _, f := range fields {
+			if !documenthistory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != documenthistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(documenthistory.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(documenthistory.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(documenthistory.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(documenthistory.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(documenthistory.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(documenthistory.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DocumentID(); ok {
+		_spec.SetField(documenthistory.FieldDocumentID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(documenthistory.FieldUserID, field.TypeString, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(documenthistory.FieldUserID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(documenthistory.FieldVersion, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(documenthistory.FieldVersion, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.ChangedFields(); ok {
+		_spec.SetField(documenthistory.FieldChangedFields, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedChangedFields(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, documenthistory.FieldChangedFields, value)
+		})
+	}
+	if value, ok := _u.mutation.Before(); ok {
+		_spec.SetField(documenthistory.FieldBefore, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.After(); ok {
+		_spec.SetField(documenthistory.FieldAfter, field.TypeJSON, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &DocumentHistory{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{documenthistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -3,12 +3,14 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-paperless/internal/authz"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/category"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/document"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documentpermission"
@@ -33,7 +35,7 @@ type DocumentPermission struct {
 	ResourceID string `json:"resource_id,omitempty"`
 	// Permission level (owner, editor, viewer, sharer)
 	Relation documentpermission.Relation `json:"relation,omitempty"`
-	// Type of subject (user, role, or tenant)
+	// Type of subject (user, role, tenant, or group)
 	SubjectType documentpermission.SubjectType `json:"subject_type,omitempty"`
 	// ID of the user, role, or tenant
 	SubjectID string `json:"subject_id,omitempty"`
@@ -41,6 +43,8 @@ type DocumentPermission struct {
 	GrantedBy *uint32 `json:"granted_by,omitempty"`
 	// Optional expiration time for temporary access
 	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Optional caveats (IP ranges, time window, MFA) evaluated at check time
+	Conditions *authz.Conditions `json:"conditions,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the DocumentPermissionQuery when eager-loading is set.
 	Edges                DocumentPermissionEdges `json:"edges"`
@@ -87,6 +91,8 @@ func (*DocumentPermission) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case documentpermission.FieldConditions:
+			values[i] = new([]byte)
 		case documentpermission.FieldID, documentpermission.FieldTenantID, documentpermission.FieldGrantedBy:
 			values[i] = new(sql.NullInt64)
 		case documentpermission.FieldResourceType, documentpermission.FieldResourceID, documentpermission.FieldRelation, documentpermission.FieldSubjectType, documentpermission.FieldSubjectID:
@@ -190,6 +196,14 @@ func (_m *DocumentPermission) assignValues(columns []string, values []any) error
 				_m.ExpiresAt = new(time.Time)
 				*_m.ExpiresAt = value.Time
 			}
+		case documentpermission.FieldConditions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field conditions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Conditions); err != nil {
+					return fmt.Errorf("unmarshal field conditions: %w", err)
+				}
+			}
 		case documentpermission.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field category_permissions", values[i])
@@ -294,6 +308,9 @@ func (_m *DocumentPermission) String() string {
 		builder.WriteString("expires_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("conditions=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Conditions))
 	builder.WriteByte(')')
 	return builder.String()
 }
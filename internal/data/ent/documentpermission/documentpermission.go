@@ -37,6 +37,8 @@ const (
 	FieldGrantedBy = "granted_by"
 	// FieldExpiresAt holds the string denoting the expires_at field in the database.
 	FieldExpiresAt = "expires_at"
+	// FieldConditions holds the string denoting the conditions field in the database.
+	FieldConditions = "conditions"
 	// EdgeCategory holds the string denoting the category edge name in mutations.
 	EdgeCategory = "category"
 	// EdgeDocument holds the string denoting the document edge name in mutations.
@@ -73,6 +75,7 @@ var Columns = []string{
 	FieldSubjectID,
 	FieldGrantedBy,
 	FieldExpiresAt,
+	FieldConditions,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "paperless_permissions"
@@ -172,6 +175,7 @@ const (
 	SubjectTypeSUBJECT_TYPE_USER        SubjectType = "SUBJECT_TYPE_USER"
 	SubjectTypeSUBJECT_TYPE_ROLE        SubjectType = "SUBJECT_TYPE_ROLE"
 	SubjectTypeSUBJECT_TYPE_TENANT      SubjectType = "SUBJECT_TYPE_TENANT"
+	SubjectTypeSUBJECT_TYPE_GROUP       SubjectType = "SUBJECT_TYPE_GROUP"
 )
 
 func (st SubjectType) String() string {
@@ -181,7 +185,7 @@ func (st SubjectType) String() string {
 // SubjectTypeValidator is a validator for the "subject_type" field enum values. It is called by the builders before save.
 func SubjectTypeValidator(st SubjectType) error {
 	switch st {
-	case SubjectTypeSUBJECT_TYPE_UNSPECIFIED, SubjectTypeSUBJECT_TYPE_USER, SubjectTypeSUBJECT_TYPE_ROLE, SubjectTypeSUBJECT_TYPE_TENANT:
+	case SubjectTypeSUBJECT_TYPE_UNSPECIFIED, SubjectTypeSUBJECT_TYPE_USER, SubjectTypeSUBJECT_TYPE_ROLE, SubjectTypeSUBJECT_TYPE_TENANT, SubjectTypeSUBJECT_TYPE_GROUP:
 		return nil
 	default:
 		return fmt.Errorf("documentpermission: invalid enum value for subject_type field: %q", st)
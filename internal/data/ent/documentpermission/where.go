@@ -585,6 +585,16 @@ func ExpiresAtNotNil() predicate.DocumentPermission {
 	return predicate.DocumentPermission(sql.FieldNotNull(FieldExpiresAt))
 }
 
+// ConditionsIsNil applies the IsNil predicate on the "conditions" field.
+func ConditionsIsNil() predicate.DocumentPermission {
+	return predicate.DocumentPermission(sql.FieldIsNull(FieldConditions))
+}
+
+// ConditionsNotNil applies the NotNil predicate on the "conditions" field.
+func ConditionsNotNil() predicate.DocumentPermission {
+	return predicate.DocumentPermission(sql.FieldNotNull(FieldConditions))
+}
+
 // HasCategory applies the HasEdge predicate on the "category" edge.
 func HasCategory() predicate.DocumentPermission {
 	return predicate.DocumentPermission(func(s *sql.Selector) {
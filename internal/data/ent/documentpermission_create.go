@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-paperless/internal/authz"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/category"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/document"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documentpermission"
@@ -138,6 +139,12 @@ func (_c *DocumentPermissionCreate) SetNillableExpiresAt(v *time.Time) *Document
 	return _c
 }
 
+// SetConditions sets the "conditions" field.
+func (_c *DocumentPermissionCreate) SetConditions(v *authz.Conditions) *DocumentPermissionCreate {
+	_c.mutation.SetConditions(v)
+	return _c
+}
+
 // SetCategoryID sets the "category" edge to the Category entity by ID.
 func (_c *DocumentPermissionCreate) SetCategoryID(id string) *DocumentPermissionCreate {
 	_c.mutation.SetCategoryID(id)
@@ -262,6 +269,11 @@ func (_c *DocumentPermissionCreate) check() error {
 			return &ValidationError{Name: "subject_id", err: fmt.Errorf(`ent: validator failed for field "DocumentPermission.subject_id": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.Conditions(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "conditions", err: fmt.Errorf(`ent: validator failed for field "DocumentPermission.conditions": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -333,6 +345,10 @@ func (_c *DocumentPermissionCreate) createSpec() (*DocumentPermission, *sqlgraph
 		_spec.SetField(documentpermission.FieldExpiresAt, field.TypeTime, value)
 		_node.ExpiresAt = &value
 	}
+	if value, ok := _c.mutation.Conditions(); ok {
+		_spec.SetField(documentpermission.FieldConditions, field.TypeJSON, value)
+		_node.Conditions = value
+	}
 	if nodes := _c.mutation.CategoryIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -557,6 +573,24 @@ func (u *DocumentPermissionUpsert) ClearExpiresAt() *DocumentPermissionUpsert {
 	return u
 }
 
+// SetConditions sets the "conditions" field.
+func (u *DocumentPermissionUpsert) SetConditions(v *authz.Conditions) *DocumentPermissionUpsert {
+	u.Set(documentpermission.FieldConditions, v)
+	return u
+}
+
+// UpdateConditions sets the "conditions" field to the value that was provided on create.
+func (u *DocumentPermissionUpsert) UpdateConditions() *DocumentPermissionUpsert {
+	u.SetExcluded(documentpermission.FieldConditions)
+	return u
+}
+
+// ClearConditions clears the value of the "conditions" field.
+func (u *DocumentPermissionUpsert) ClearConditions() *DocumentPermissionUpsert {
+	u.SetNull(documentpermission.FieldConditions)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -766,6 +800,27 @@ func (u *DocumentPermissionUpsertOne) ClearExpiresAt() *DocumentPermissionUpsert
 	})
 }
 
+// SetConditions sets the "conditions" field.
+func (u *DocumentPermissionUpsertOne) SetConditions(v *authz.Conditions) *DocumentPermissionUpsertOne {
+	return u.Update(func(s *DocumentPermissionUpsert) {
+		s.SetConditions(v)
+	})
+}
+
+// UpdateConditions sets the "conditions" field to the value that was provided on create.
+func (u *DocumentPermissionUpsertOne) UpdateConditions() *DocumentPermissionUpsertOne {
+	return u.Update(func(s *DocumentPermissionUpsert) {
+		s.UpdateConditions()
+	})
+}
+
+// ClearConditions clears the value of the "conditions" field.
+func (u *DocumentPermissionUpsertOne) ClearConditions() *DocumentPermissionUpsertOne {
+	return u.Update(func(s *DocumentPermissionUpsert) {
+		s.ClearConditions()
+	})
+}
+
 // Exec executes the query.
 func (u *DocumentPermissionUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1141,6 +1196,27 @@ func (u *DocumentPermissionUpsertBulk) ClearExpiresAt() *DocumentPermissionUpser
 	})
 }
 
+// SetConditions sets the "conditions" field.
+func (u *DocumentPermissionUpsertBulk) SetConditions(v *authz.Conditions) *DocumentPermissionUpsertBulk {
+	return u.Update(func(s *DocumentPermissionUpsert) {
+		s.SetConditions(v)
+	})
+}
+
+// UpdateConditions sets the "conditions" field to the value that was provided on create.
+func (u *DocumentPermissionUpsertBulk) UpdateConditions() *DocumentPermissionUpsertBulk {
+	return u.Update(func(s *DocumentPermissionUpsert) {
+		s.UpdateConditions()
+	})
+}
+
+// ClearConditions clears the value of the "conditions" field.
+func (u *DocumentPermissionUpsertBulk) ClearConditions() *DocumentPermissionUpsertBulk {
+	return u.Update(func(s *DocumentPermissionUpsert) {
+		s.ClearConditions()
+	})
+}
+
 // Exec executes the query.
 func (u *DocumentPermissionUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-paperless/internal/authz"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/category"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/document"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documentpermission"
@@ -188,6 +189,18 @@ func (_u *DocumentPermissionUpdate) ClearExpiresAt() *DocumentPermissionUpdate {
 	return _u
 }
 
+// SetConditions sets the "conditions" field.
+func (_u *DocumentPermissionUpdate) SetConditions(v *authz.Conditions) *DocumentPermissionUpdate {
+	_u.mutation.SetConditions(v)
+	return _u
+}
+
+// ClearConditions clears the value of the "conditions" field.
+func (_u *DocumentPermissionUpdate) ClearConditions() *DocumentPermissionUpdate {
+	_u.mutation.ClearConditions()
+	return _u
+}
+
 // SetCategoryID sets the "category" edge to the Category entity by ID.
 func (_u *DocumentPermissionUpdate) SetCategoryID(id string) *DocumentPermissionUpdate {
 	_u.mutation.SetCategoryID(id)
@@ -297,6 +310,11 @@ func (_u *DocumentPermissionUpdate) check() error {
 			return &ValidationError{Name: "subject_id", err: fmt.Errorf(`ent: validator failed for field "DocumentPermission.subject_id": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Conditions(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "conditions", err: fmt.Errorf(`ent: validator failed for field "DocumentPermission.conditions": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -366,6 +384,12 @@ func (_u *DocumentPermissionUpdate) sqlSave(ctx context.Context) (_node int, err
 	if _u.mutation.ExpiresAtCleared() {
 		_spec.ClearField(documentpermission.FieldExpiresAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.Conditions(); ok {
+		_spec.SetField(documentpermission.FieldConditions, field.TypeJSON, value)
+	}
+	if _u.mutation.ConditionsCleared() {
+		_spec.ClearField(documentpermission.FieldConditions, field.TypeJSON)
+	}
 	if _u.mutation.CategoryCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -603,6 +627,18 @@ func (_u *DocumentPermissionUpdateOne) ClearExpiresAt() *DocumentPermissionUpdat
 	return _u
 }
 
+// SetConditions sets the "conditions" field.
+func (_u *DocumentPermissionUpdateOne) SetConditions(v *authz.Conditions) *DocumentPermissionUpdateOne {
+	_u.mutation.SetConditions(v)
+	return _u
+}
+
+// ClearConditions clears the value of the "conditions" field.
+func (_u *DocumentPermissionUpdateOne) ClearConditions() *DocumentPermissionUpdateOne {
+	_u.mutation.ClearConditions()
+	return _u
+}
+
 // SetCategoryID sets the "category" edge to the Category entity by ID.
 func (_u *DocumentPermissionUpdateOne) SetCategoryID(id string) *DocumentPermissionUpdateOne {
 	_u.mutation.SetCategoryID(id)
@@ -725,6 +761,11 @@ func (_u *DocumentPermissionUpdateOne) check() error {
 			return &ValidationError{Name: "subject_id", err: fmt.Errorf(`ent: validator failed for field "DocumentPermission.subject_id": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Conditions(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "conditions", err: fmt.Errorf(`ent: validator failed for field "DocumentPermission.conditions": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -811,6 +852,12 @@ func (_u *DocumentPermissionUpdateOne) sqlSave(ctx context.Context) (_node *Docu
 	if _u.mutation.ExpiresAtCleared() {
 		_spec.ClearField(documentpermission.FieldExpiresAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.Conditions(); ok {
+		_spec.SetField(documentpermission.FieldConditions, field.TypeJSON, value)
+	}
+	if _u.mutation.ConditionsCleared() {
+		_spec.ClearField(documentpermission.FieldConditions, field.TypeJSON)
+	}
 	if _u.mutation.CategoryCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -0,0 +1,693 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/document"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documenttag"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/tag"
+)
+
+// DocumentTagCreate is the builder for creating a DocumentTag entity.
+type DocumentTagCreate struct {
+	config
+	mutation *DocumentTagMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *DocumentTagCreate) SetTenantID(v uint32) *DocumentTagCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *DocumentTagCreate) SetNillableTenantID(v *uint32) *DocumentTagCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetDocumentID sets the "document_id" field.
+func (_c *DocumentTagCreate) SetDocumentID(v string) *DocumentTagCreate {
+	_c.mutation.SetDocumentID(v)
+	return _c
+}
+
+// SetTagID sets the "tag_id" field.
+func (_c *DocumentTagCreate) SetTagID(v uint32) *DocumentTagCreate {
+	_c.mutation.SetTagID(v)
+	return _c
+}
+
+// SetValue sets the "value" field.
+func (_c *DocumentTagCreate) SetValue(v string) *DocumentTagCreate {
+	_c.mutation.SetValue(v)
+	return _c
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_c *DocumentTagCreate) SetNillableValue(v *string) *DocumentTagCreate {
+	if v != nil {
+		_c.SetValue(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DocumentTagCreate) SetID(v uint32) *DocumentTagCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetDocument sets the "document" edge to the Document entity.
+func (_c *DocumentTagCreate) SetDocument(v *Document) *DocumentTagCreate {
+	return _c.SetDocumentID(v.ID)
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (_c *DocumentTagCreate) SetTag(v *Tag) *DocumentTagCreate {
+	return _c.SetTagID(v.ID)
+}
+
+// Mutation returns the DocumentTagMutation object of the builder.
+func (_c *DocumentTagCreate) Mutation() *DocumentTagMutation {
+	return _c.mutation
+}
+
+// Save creates the DocumentTag in the database.
+func (_c *DocumentTagCreate) Save(ctx context.Context) (*DocumentTag, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DocumentTagCreate) SaveX(ctx context.Context) *DocumentTag {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DocumentTagCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DocumentTagCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DocumentTagCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := documenttag.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.Value(); !ok {
+		v := documenttag.DefaultValue
+		_c.mutation.SetValue(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DocumentTagCreate) check() error {
+	if _, ok := _c.mutation.DocumentID(); !ok {
+		return &ValidationError{Name: "document_id", err: errors.New(`ent: missing required field "DocumentTag.document_id"`)}
+	}
+	if v, ok := _c.mutation.DocumentID(); ok {
+		if err := documenttag.DocumentIDValidator(v); err != nil {
+			return &ValidationError{Name: "document_id", err: fmt.Errorf(`ent: validator failed for field "DocumentTag.document_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TagID(); !ok {
+		return &ValidationError{Name: "tag_id", err: errors.New(`ent: missing required field "DocumentTag.tag_id"`)}
+	}
+	if _, ok := _c.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "DocumentTag.value"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := documenttag.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "DocumentTag.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.DocumentIDs()) == 0 {
+		return &ValidationError{Name: "document", err: errors.New(`ent: missing required edge "DocumentTag.document"`)}
+	}
+	if len(_c.mutation.TagIDs()) == 0 {
+		return &ValidationError{Name: "tag", err: errors.New(`ent: missing required edge "DocumentTag.tag"`)}
+	}
+	return nil
+}
+
+func (_c *DocumentTagCreate) sqlSave(ctx context.Context) (*DocumentTag, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DocumentTagCreate) createSpec() (*DocumentTag, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DocumentTag{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(documenttag.Table, sqlgraph.NewFieldSpec(documenttag.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(documenttag.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.Value(); ok {
+		_spec.SetField(documenttag.FieldValue, field.TypeString, value)
+		_node.Value = value
+	}
+	if nodes := _c.mutation.DocumentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   documenttag.DocumentTable,
+			Columns: []string{documenttag.DocumentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DocumentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   documenttag.TagTable,
+			Columns: []string{documenttag.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TagID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DocumentTag.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DocumentTagUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DocumentTagCreate) OnConflict(opts ...sql.ConflictOption) *DocumentTagUpsertOne {
+	_c.conflict = opts
+	return &DocumentTagUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DocumentTag.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DocumentTagCreate) OnConflictColumns(columns ...string) *DocumentTagUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DocumentTagUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DocumentTagUpsertOne is the builder for "upsert"-ing
+	//  one DocumentTag node.
+	DocumentTagUpsertOne struct {
+		create *DocumentTagCreate
+	}
+
+	// DocumentTagUpsert is the "OnConflict" setter.
+	DocumentTagUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDocumentID sets the "document_id" field.
+func (u *DocumentTagUpsert) SetDocumentID(v string) *DocumentTagUpsert {
+	u.Set(documenttag.FieldDocumentID, v)
+	return u
+}
+
+// UpdateDocumentID sets the "document_id" field to the value that was provided on create.
+func (u *DocumentTagUpsert) UpdateDocumentID() *DocumentTagUpsert {
+	u.SetExcluded(documenttag.FieldDocumentID)
+	return u
+}
+
+// SetTagID sets the "tag_id" field.
+func (u *DocumentTagUpsert) SetTagID(v uint32) *DocumentTagUpsert {
+	u.Set(documenttag.FieldTagID, v)
+	return u
+}
+
+// UpdateTagID sets the "tag_id" field to the value that was provided on create.
+func (u *DocumentTagUpsert) UpdateTagID() *DocumentTagUpsert {
+	u.SetExcluded(documenttag.FieldTagID)
+	return u
+}
+
+// SetValue sets the "value" field.
+func (u *DocumentTagUpsert) SetValue(v string) *DocumentTagUpsert {
+	u.Set(documenttag.FieldValue, v)
+	return u
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *DocumentTagUpsert) UpdateValue() *DocumentTagUpsert {
+	u.SetExcluded(documenttag.FieldValue)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DocumentTag.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(documenttag.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DocumentTagUpsertOne) UpdateNewValues() *DocumentTagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(documenttag.FieldID)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(documenttag.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DocumentTag.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DocumentTagUpsertOne) Ignore() *DocumentTagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DocumentTagUpsertOne) DoNothing() *DocumentTagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DocumentTagCreate.OnConflict
+// documentation for more info.
+func (u *DocumentTagUpsertOne) Update(set func(*DocumentTagUpsert)) *DocumentTagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DocumentTagUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDocumentID sets the "document_id" field.
+func (u *DocumentTagUpsertOne) SetDocumentID(v string) *DocumentTagUpsertOne {
+	return u.Update(func(s *DocumentTagUpsert) {
+		s.SetDocumentID(v)
+	})
+}
+
+// UpdateDocumentID sets the "document_id" field to the value that was provided on create.
+func (u *DocumentTagUpsertOne) UpdateDocumentID() *DocumentTagUpsertOne {
+	return u.Update(func(s *DocumentTagUpsert) {
+		s.UpdateDocumentID()
+	})
+}
+
+// SetTagID sets the "tag_id" field.
+func (u *DocumentTagUpsertOne) SetTagID(v uint32) *DocumentTagUpsertOne {
+	return u.Update(func(s *DocumentTagUpsert) {
+		s.SetTagID(v)
+	})
+}
+
+// UpdateTagID sets the "tag_id" field to the value that was provided on create.
+func (u *DocumentTagUpsertOne) UpdateTagID() *DocumentTagUpsertOne {
+	return u.Update(func(s *DocumentTagUpsert) {
+		s.UpdateTagID()
+	})
+}
+
+// SetValue sets the "value" field.
+func (u *DocumentTagUpsertOne) SetValue(v string) *DocumentTagUpsertOne {
+	return u.Update(func(s *DocumentTagUpsert) {
+		s.SetValue(v)
+	})
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *DocumentTagUpsertOne) UpdateValue() *DocumentTagUpsertOne {
+	return u.Update(func(s *DocumentTagUpsert) {
+		s.UpdateValue()
+	})
+}
+
+// Exec executes the query.
+func (u *DocumentTagUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DocumentTagCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DocumentTagUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DocumentTagUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DocumentTagUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DocumentTagCreateBulk is the builder for creating many DocumentTag entities in bulk.
+type DocumentTagCreateBulk struct {
+	config
+	err      error
+	builders []*DocumentTagCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DocumentTag entities in the database.
+func (_c *DocumentTagCreateBulk) Save(ctx context.Context) ([]*DocumentTag, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DocumentTag, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DocumentTagMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DocumentTagCreateBulk) SaveX(ctx context.Context) []*DocumentTag {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DocumentTagCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DocumentTagCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DocumentTag.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DocumentTagUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DocumentTagCreateBulk) OnConflict(opts ...sql.ConflictOption) *DocumentTagUpsertBulk {
+	_c.conflict = opts
+	return &DocumentTagUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DocumentTag.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DocumentTagCreateBulk) OnConflictColumns(columns ...string) *DocumentTagUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DocumentTagUpsertBulk{
+		create: _c,
+	}
+}
+
+// DocumentTagUpsertBulk is the builder for "upsert"-ing
+// a bulk of DocumentTag nodes.
+type DocumentTagUpsertBulk struct {
+	create *DocumentTagCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DocumentTag.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(documenttag.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DocumentTagUpsertBulk) UpdateNewValues() *DocumentTagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(documenttag.FieldID)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(documenttag.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DocumentTag.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DocumentTagUpsertBulk) Ignore() *DocumentTagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DocumentTagUpsertBulk) DoNothing() *DocumentTagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DocumentTagCreateBulk.OnConflict
+// documentation for more info.
+func (u *DocumentTagUpsertBulk) Update(set func(*DocumentTagUpsert)) *DocumentTagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DocumentTagUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDocumentID sets the "document_id" field.
+func (u *DocumentTagUpsertBulk) SetDocumentID(v string) *DocumentTagUpsertBulk {
+	return u.Update(func(s *DocumentTagUpsert) {
+		s.SetDocumentID(v)
+	})
+}
+
+// UpdateDocumentID sets the "document_id" field to the value that was provided on create.
+func (u *DocumentTagUpsertBulk) UpdateDocumentID() *DocumentTagUpsertBulk {
+	return u.Update(func(s *DocumentTagUpsert) {
+		s.UpdateDocumentID()
+	})
+}
+
+// SetTagID sets the "tag_id" field.
+func (u *DocumentTagUpsertBulk) SetTagID(v uint32) *DocumentTagUpsertBulk {
+	return u.Update(func(s *DocumentTagUpsert) {
+		s.SetTagID(v)
+	})
+}
+
+// UpdateTagID sets the "tag_id" field to the value that was provided on create.
+func (u *DocumentTagUpsertBulk) UpdateTagID() *DocumentTagUpsertBulk {
+	return u.Update(func(s *DocumentTagUpsert) {
+		s.UpdateTagID()
+	})
+}
+
+// SetValue sets the "value" field.
+func (u *DocumentTagUpsertBulk) SetValue(v string) *DocumentTagUpsertBulk {
+	return u.Update(func(s *DocumentTagUpsert) {
+		s.SetValue(v)
+	})
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *DocumentTagUpsertBulk) UpdateValue() *DocumentTagUpsertBulk {
+	return u.Update(func(s *DocumentTagUpsert) {
+		s.UpdateValue()
+	})
+}
+
+// Exec executes the query.
+func (u *DocumentTagUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DocumentTagCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DocumentTagCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DocumentTagUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
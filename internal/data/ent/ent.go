@@ -12,10 +12,34 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/accessibleresource"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/auditevent"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/auditlog"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/category"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/categorydeletejob"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/document"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documentcontent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documenthistory"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documentpermission"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documenttag"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/groupmembership"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/idempotencykey"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importconnector"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importedfile"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importmapping"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/notificationpreference"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/outboxevent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/reviewtask"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/setting"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/signaturerequest"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/statisticsrollup"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/tag"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/tenantdeletejob"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/tenantkey"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/tenantquota"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/tenantsettings"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/webhookdelivery"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/webhooksubscription"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -76,10 +100,34 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			auditlog.Table:           auditlog.ValidColumn,
-			category.Table:           category.ValidColumn,
-			document.Table:           document.ValidColumn,
-			documentpermission.Table: documentpermission.ValidColumn,
+			accessibleresource.Table:     accessibleresource.ValidColumn,
+			auditevent.Table:             auditevent.ValidColumn,
+			auditlog.Table:               auditlog.ValidColumn,
+			category.Table:               category.ValidColumn,
+			categorydeletejob.Table:      categorydeletejob.ValidColumn,
+			document.Table:               document.ValidColumn,
+			documentcontent.Table:        documentcontent.ValidColumn,
+			documenthistory.Table:        documenthistory.ValidColumn,
+			documentpermission.Table:     documentpermission.ValidColumn,
+			documenttag.Table:            documenttag.ValidColumn,
+			groupmembership.Table:        groupmembership.ValidColumn,
+			idempotencykey.Table:         idempotencykey.ValidColumn,
+			importconnector.Table:        importconnector.ValidColumn,
+			importmapping.Table:          importmapping.ValidColumn,
+			importedfile.Table:           importedfile.ValidColumn,
+			notificationpreference.Table: notificationpreference.ValidColumn,
+			outboxevent.Table:            outboxevent.ValidColumn,
+			reviewtask.Table:             reviewtask.ValidColumn,
+			setting.Table:                setting.ValidColumn,
+			signaturerequest.Table:       signaturerequest.ValidColumn,
+			statisticsrollup.Table:       statisticsrollup.ValidColumn,
+			tag.Table:                    tag.ValidColumn,
+			tenantdeletejob.Table:        tenantdeletejob.ValidColumn,
+			tenantkey.Table:              tenantkey.ValidColumn,
+			tenantquota.Table:            tenantquota.ValidColumn,
+			tenantsettings.Table:         tenantsettings.ValidColumn,
+			webhookdelivery.Table:        webhookdelivery.ValidColumn,
+			webhooksubscription.Table:    webhooksubscription.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -0,0 +1,853 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/groupmembership"
+)
+
+// GroupMembershipCreate is the builder for creating a GroupMembership entity.
+type GroupMembershipCreate struct {
+	config
+	mutation *GroupMembershipMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *GroupMembershipCreate) SetCreateTime(v time.Time) *GroupMembershipCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *GroupMembershipCreate) SetNillableCreateTime(v *time.Time) *GroupMembershipCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *GroupMembershipCreate) SetUpdateTime(v time.Time) *GroupMembershipCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *GroupMembershipCreate) SetNillableUpdateTime(v *time.Time) *GroupMembershipCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *GroupMembershipCreate) SetDeleteTime(v time.Time) *GroupMembershipCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *GroupMembershipCreate) SetNillableDeleteTime(v *time.Time) *GroupMembershipCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *GroupMembershipCreate) SetTenantID(v uint32) *GroupMembershipCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *GroupMembershipCreate) SetNillableTenantID(v *uint32) *GroupMembershipCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetGroupID sets the "group_id" field.
+func (_c *GroupMembershipCreate) SetGroupID(v string) *GroupMembershipCreate {
+	_c.mutation.SetGroupID(v)
+	return _c
+}
+
+// SetGroupName sets the "group_name" field.
+func (_c *GroupMembershipCreate) SetGroupName(v string) *GroupMembershipCreate {
+	_c.mutation.SetGroupName(v)
+	return _c
+}
+
+// SetNillableGroupName sets the "group_name" field if the given value is not nil.
+func (_c *GroupMembershipCreate) SetNillableGroupName(v *string) *GroupMembershipCreate {
+	if v != nil {
+		_c.SetGroupName(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *GroupMembershipCreate) SetUserID(v string) *GroupMembershipCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *GroupMembershipCreate) SetID(v uint32) *GroupMembershipCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the GroupMembershipMutation object of the builder.
+func (_c *GroupMembershipCreate) Mutation() *GroupMembershipMutation {
+	return _c.mutation
+}
+
+// Save creates the GroupMembership in the database.
+func (_c *GroupMembershipCreate) Save(ctx context.Context) (*GroupMembership, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GroupMembershipCreate) SaveX(ctx context.Context) *GroupMembership {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GroupMembershipCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GroupMembershipCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *GroupMembershipCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := groupmembership.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GroupMembershipCreate) check() error {
+	if _, ok := _c.mutation.GroupID(); !ok {
+		return &ValidationError{Name: "group_id", err: errors.New(`ent: missing required field "GroupMembership.group_id"`)}
+	}
+	if v, ok := _c.mutation.GroupID(); ok {
+		if err := groupmembership.GroupIDValidator(v); err != nil {
+			return &ValidationError{Name: "group_id", err: fmt.Errorf(`ent: validator failed for field "GroupMembership.group_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.GroupName(); ok {
+		if err := groupmembership.GroupNameValidator(v); err != nil {
+			return &ValidationError{Name: "group_name", err: fmt.Errorf(`ent: validator failed for field "GroupMembership.group_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "GroupMembership.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := groupmembership.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "GroupMembership.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := groupmembership.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "GroupMembership.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *GroupMembershipCreate) sqlSave(ctx context.Context) (*GroupMembership, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GroupMembershipCreate) createSpec() (*GroupMembership, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GroupMembership{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(groupmembership.Table, sqlgraph.NewFieldSpec(groupmembership.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(groupmembership.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(groupmembership.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(groupmembership.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(groupmembership.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.GroupID(); ok {
+		_spec.SetField(groupmembership.FieldGroupID, field.TypeString, value)
+		_node.GroupID = value
+	}
+	if value, ok := _c.mutation.GroupName(); ok {
+		_spec.SetField(groupmembership.FieldGroupName, field.TypeString, value)
+		_node.GroupName = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(groupmembership.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GroupMembership.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GroupMembershipUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GroupMembershipCreate) OnConflict(opts ...sql.ConflictOption) *GroupMembershipUpsertOne {
+	_c.conflict = opts
+	return &GroupMembershipUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GroupMembership.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GroupMembershipCreate) OnConflictColumns(columns ...string) *GroupMembershipUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GroupMembershipUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// GroupMembershipUpsertOne is the builder for "upsert"-ing
+	//  one GroupMembership node.
+	GroupMembershipUpsertOne struct {
+		create *GroupMembershipCreate
+	}
+
+	// GroupMembershipUpsert is the "OnConflict" setter.
+	GroupMembershipUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *GroupMembershipUpsert) SetUpdateTime(v time.Time) *GroupMembershipUpsert {
+	u.Set(groupmembership.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *GroupMembershipUpsert) UpdateUpdateTime() *GroupMembershipUpsert {
+	u.SetExcluded(groupmembership.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *GroupMembershipUpsert) ClearUpdateTime() *GroupMembershipUpsert {
+	u.SetNull(groupmembership.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *GroupMembershipUpsert) SetDeleteTime(v time.Time) *GroupMembershipUpsert {
+	u.Set(groupmembership.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *GroupMembershipUpsert) UpdateDeleteTime() *GroupMembershipUpsert {
+	u.SetExcluded(groupmembership.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *GroupMembershipUpsert) ClearDeleteTime() *GroupMembershipUpsert {
+	u.SetNull(groupmembership.FieldDeleteTime)
+	return u
+}
+
+// SetGroupID sets the "group_id" field.
+func (u *GroupMembershipUpsert) SetGroupID(v string) *GroupMembershipUpsert {
+	u.Set(groupmembership.FieldGroupID, v)
+	return u
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *GroupMembershipUpsert) UpdateGroupID() *GroupMembershipUpsert {
+	u.SetExcluded(groupmembership.FieldGroupID)
+	return u
+}
+
+// SetGroupName sets the "group_name" field.
+func (u *GroupMembershipUpsert) SetGroupName(v string) *GroupMembershipUpsert {
+	u.Set(groupmembership.FieldGroupName, v)
+	return u
+}
+
+// UpdateGroupName sets the "group_name" field to the value that was provided on create.
+func (u *GroupMembershipUpsert) UpdateGroupName() *GroupMembershipUpsert {
+	u.SetExcluded(groupmembership.FieldGroupName)
+	return u
+}
+
+// ClearGroupName clears the value of the "group_name" field.
+func (u *GroupMembershipUpsert) ClearGroupName() *GroupMembershipUpsert {
+	u.SetNull(groupmembership.FieldGroupName)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *GroupMembershipUpsert) SetUserID(v string) *GroupMembershipUpsert {
+	u.Set(groupmembership.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *GroupMembershipUpsert) UpdateUserID() *GroupMembershipUpsert {
+	u.SetExcluded(groupmembership.FieldUserID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.GroupMembership.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(groupmembership.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GroupMembershipUpsertOne) UpdateNewValues() *GroupMembershipUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(groupmembership.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(groupmembership.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(groupmembership.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GroupMembership.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GroupMembershipUpsertOne) Ignore() *GroupMembershipUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GroupMembershipUpsertOne) DoNothing() *GroupMembershipUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GroupMembershipCreate.OnConflict
+// documentation for more info.
+func (u *GroupMembershipUpsertOne) Update(set func(*GroupMembershipUpsert)) *GroupMembershipUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GroupMembershipUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *GroupMembershipUpsertOne) SetUpdateTime(v time.Time) *GroupMembershipUpsertOne {
+	return u.Update(func(s *GroupMembershipUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *GroupMembershipUpsertOne) UpdateUpdateTime() *GroupMembershipUpsertOne {
+	return u.Update(func(s *GroupMembershipUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *GroupMembershipUpsertOne) ClearUpdateTime() *GroupMembershipUpsertOne {
+	return u.Update(func(s *GroupMembershipUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *GroupMembershipUpsertOne) SetDeleteTime(v time.Time) *GroupMembershipUpsertOne {
+	return u.Update(func(s *GroupMembershipUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *GroupMembershipUpsertOne) UpdateDeleteTime() *GroupMembershipUpsertOne {
+	return u.Update(func(s *GroupMembershipUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *GroupMembershipUpsertOne) ClearDeleteTime() *GroupMembershipUpsertOne {
+	return u.Update(func(s *GroupMembershipUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetGroupID sets the "group_id" field.
+func (u *GroupMembershipUpsertOne) SetGroupID(v string) *GroupMembershipUpsertOne {
+	return u.Update(func(s *GroupMembershipUpsert) {
+		s.SetGroupID(v)
+	})
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *GroupMembershipUpsertOne) UpdateGroupID() *GroupMembershipUpsertOne {
+	return u.Update(func(s *GroupMembershipUpsert) {
+		s.UpdateGroupID()
+	})
+}
+
+// SetGroupName sets the "group_name" field.
+func (u *GroupMembershipUpsertOne) SetGroupName(v string) *GroupMembershipUpsertOne {
+	return u.Update(func(s *GroupMembershipUpsert) {
+		s.SetGroupName(v)
+	})
+}
+
+// UpdateGroupName sets the "group_name" field to the value that was provided on create.
+func (u *GroupMembershipUpsertOne) UpdateGroupName() *GroupMembershipUpsertOne {
+	return u.Update(func(s *GroupMembershipUpsert) {
+		s.UpdateGroupName()
+	})
+}
+
+// ClearGroupName clears the value of the "group_name" field.
+func (u *GroupMembershipUpsertOne) ClearGroupName() *GroupMembershipUpsertOne {
+	return u.Update(func(s *GroupMembershipUpsert) {
+		s.ClearGroupName()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *GroupMembershipUpsertOne) SetUserID(v string) *GroupMembershipUpsertOne {
+	return u.Update(func(s *GroupMembershipUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *GroupMembershipUpsertOne) UpdateUserID() *GroupMembershipUpsertOne {
+	return u.Update(func(s *GroupMembershipUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// Exec executes the query.
+func (u *GroupMembershipUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GroupMembershipCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GroupMembershipUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *GroupMembershipUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *GroupMembershipUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// GroupMembershipCreateBulk is the builder for creating many GroupMembership entities in bulk.
+type GroupMembershipCreateBulk struct {
+	config
+	err      error
+	builders []*GroupMembershipCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the GroupMembership entities in the database.
+func (_c *GroupMembershipCreateBulk) Save(ctx context.Context) ([]*GroupMembership, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*GroupMembership, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GroupMembershipMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GroupMembershipCreateBulk) SaveX(ctx context.Context) []*GroupMembership {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GroupMembershipCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GroupMembershipCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GroupMembership.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GroupMembershipUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GroupMembershipCreateBulk) OnConflict(opts ...sql.ConflictOption) *GroupMembershipUpsertBulk {
+	_c.conflict = opts
+	return &GroupMembershipUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GroupMembership.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GroupMembershipCreateBulk) OnConflictColumns(columns ...string) *GroupMembershipUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GroupMembershipUpsertBulk{
+		create: _c,
+	}
+}
+
+// GroupMembershipUpsertBulk is the builder for "upsert"-ing
+// a bulk of GroupMembership nodes.
+type GroupMembershipUpsertBulk struct {
+	create *GroupMembershipCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.GroupMembership.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(groupmembership.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GroupMembershipUpsertBulk) UpdateNewValues() *GroupMembershipUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(groupmembership.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(groupmembership.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(groupmembership.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GroupMembership.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *GroupMembershipUpsertBulk) Ignore() *GroupMembershipUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GroupMembershipUpsertBulk) DoNothing() *GroupMembershipUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GroupMembershipCreateBulk.OnConflict
+// documentation for more info.
+func (u *GroupMembershipUpsertBulk) Update(set func(*GroupMembershipUpsert)) *GroupMembershipUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GroupMembershipUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *GroupMembershipUpsertBulk) SetUpdateTime(v time.Time) *GroupMembershipUpsertBulk {
+	return u.Update(func(s *GroupMembershipUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *GroupMembershipUpsertBulk) UpdateUpdateTime() *GroupMembershipUpsertBulk {
+	return u.Update(func(s *GroupMembershipUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *GroupMembershipUpsertBulk) ClearUpdateTime() *GroupMembershipUpsertBulk {
+	return u.Update(func(s *GroupMembershipUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *GroupMembershipUpsertBulk) SetDeleteTime(v time.Time) *GroupMembershipUpsertBulk {
+	return u.Update(func(s *GroupMembershipUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *GroupMembershipUpsertBulk) UpdateDeleteTime() *GroupMembershipUpsertBulk {
+	return u.Update(func(s *GroupMembershipUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *GroupMembershipUpsertBulk) ClearDeleteTime() *GroupMembershipUpsertBulk {
+	return u.Update(func(s *GroupMembershipUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetGroupID sets the "group_id" field.
+func (u *GroupMembershipUpsertBulk) SetGroupID(v string) *GroupMembershipUpsertBulk {
+	return u.Update(func(s *GroupMembershipUpsert) {
+		s.SetGroupID(v)
+	})
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *GroupMembershipUpsertBulk) UpdateGroupID() *GroupMembershipUpsertBulk {
+	return u.Update(func(s *GroupMembershipUpsert) {
+		s.UpdateGroupID()
+	})
+}
+
+// SetGroupName sets the "group_name" field.
+func (u *GroupMembershipUpsertBulk) SetGroupName(v string) *GroupMembershipUpsertBulk {
+	return u.Update(func(s *GroupMembershipUpsert) {
+		s.SetGroupName(v)
+	})
+}
+
+// UpdateGroupName sets the "group_name" field to the value that was provided on create.
+func (u *GroupMembershipUpsertBulk) UpdateGroupName() *GroupMembershipUpsertBulk {
+	return u.Update(func(s *GroupMembershipUpsert) {
+		s.UpdateGroupName()
+	})
+}
+
+// ClearGroupName clears the value of the "group_name" field.
+func (u *GroupMembershipUpsertBulk) ClearGroupName() *GroupMembershipUpsertBulk {
+	return u.Update(func(s *GroupMembershipUpsert) {
+		s.ClearGroupName()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *GroupMembershipUpsertBulk) SetUserID(v string) *GroupMembershipUpsertBulk {
+	return u.Update(func(s *GroupMembershipUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *GroupMembershipUpsertBulk) UpdateUserID() *GroupMembershipUpsertBulk {
+	return u.Update(func(s *GroupMembershipUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// Exec executes the query.
+func (u *GroupMembershipUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GroupMembershipCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GroupMembershipCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GroupMembershipUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1098 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importconnector"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importmapping"
+)
+
+// ImportConnectorCreate is the builder for creating a ImportConnector entity.
+type ImportConnectorCreate struct {
+	config
+	mutation *ImportConnectorMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_c *ImportConnectorCreate) SetCreateBy(v uint32) *ImportConnectorCreate {
+	_c.mutation.SetCreateBy(v)
+	return _c
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_c *ImportConnectorCreate) SetNillableCreateBy(v *uint32) *ImportConnectorCreate {
+	if v != nil {
+		_c.SetCreateBy(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *ImportConnectorCreate) SetCreateTime(v time.Time) *ImportConnectorCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *ImportConnectorCreate) SetNillableCreateTime(v *time.Time) *ImportConnectorCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *ImportConnectorCreate) SetUpdateTime(v time.Time) *ImportConnectorCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *ImportConnectorCreate) SetNillableUpdateTime(v *time.Time) *ImportConnectorCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *ImportConnectorCreate) SetDeleteTime(v time.Time) *ImportConnectorCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *ImportConnectorCreate) SetNillableDeleteTime(v *time.Time) *ImportConnectorCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ImportConnectorCreate) SetTenantID(v uint32) *ImportConnectorCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *ImportConnectorCreate) SetNillableTenantID(v *uint32) *ImportConnectorCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *ImportConnectorCreate) SetName(v string) *ImportConnectorCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetProvider sets the "provider" field.
+func (_c *ImportConnectorCreate) SetProvider(v importconnector.Provider) *ImportConnectorCreate {
+	_c.mutation.SetProvider(v)
+	return _c
+}
+
+// SetCredentials sets the "credentials" field.
+func (_c *ImportConnectorCreate) SetCredentials(v string) *ImportConnectorCreate {
+	_c.mutation.SetCredentials(v)
+	return _c
+}
+
+// SetConfig sets the "config" field.
+func (_c *ImportConnectorCreate) SetConfig(v map[string]string) *ImportConnectorCreate {
+	_c.mutation.SetConfig(v)
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *ImportConnectorCreate) SetEnabled(v bool) *ImportConnectorCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *ImportConnectorCreate) SetNillableEnabled(v *bool) *ImportConnectorCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ImportConnectorCreate) SetID(v string) *ImportConnectorCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// AddMappingIDs adds the "mappings" edge to the ImportMapping entity by IDs.
+func (_c *ImportConnectorCreate) AddMappingIDs(ids ...string) *ImportConnectorCreate {
+	_c.mutation.AddMappingIDs(ids...)
+	return _c
+}
+
+// AddMappings adds the "mappings" edges to the ImportMapping entity.
+func (_c *ImportConnectorCreate) AddMappings(v ...*ImportMapping) *ImportConnectorCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMappingIDs(ids...)
+}
+
+// Mutation returns the ImportConnectorMutation object of the builder.
+func (_c *ImportConnectorCreate) Mutation() *ImportConnectorMutation {
+	return _c.mutation
+}
+
+// Save creates the ImportConnector in the database.
+func (_c *ImportConnectorCreate) Save(ctx context.Context) (*ImportConnector, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ImportConnectorCreate) SaveX(ctx context.Context) *ImportConnector {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImportConnectorCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImportConnectorCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ImportConnectorCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := importconnector.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := importconnector.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ImportConnectorCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ImportConnector.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := importconnector.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ImportConnector.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "ImportConnector.provider"`)}
+	}
+	if v, ok := _c.mutation.Provider(); ok {
+		if err := importconnector.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "ImportConnector.provider": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Credentials(); !ok {
+		return &ValidationError{Name: "credentials", err: errors.New(`ent: missing required field "ImportConnector.credentials"`)}
+	}
+	if v, ok := _c.mutation.Credentials(); ok {
+		if err := importconnector.CredentialsValidator(v); err != nil {
+			return &ValidationError{Name: "credentials", err: fmt.Errorf(`ent: validator failed for field "ImportConnector.credentials": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "ImportConnector.enabled"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := importconnector.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "ImportConnector.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ImportConnectorCreate) sqlSave(ctx context.Context) (*ImportConnector, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ImportConnector.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ImportConnectorCreate) createSpec() (*ImportConnector, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ImportConnector{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(importconnector.Table, sqlgraph.NewFieldSpec(importconnector.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateBy(); ok {
+		_spec.SetField(importconnector.FieldCreateBy, field.TypeUint32, value)
+		_node.CreateBy = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(importconnector.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(importconnector.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(importconnector.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(importconnector.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(importconnector.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Provider(); ok {
+		_spec.SetField(importconnector.FieldProvider, field.TypeEnum, value)
+		_node.Provider = value
+	}
+	if value, ok := _c.mutation.Credentials(); ok {
+		_spec.SetField(importconnector.FieldCredentials, field.TypeString, value)
+		_node.Credentials = value
+	}
+	if value, ok := _c.mutation.Config(); ok {
+		_spec.SetField(importconnector.FieldConfig, field.TypeJSON, value)
+		_node.Config = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(importconnector.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if nodes := _c.mutation.MappingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   importconnector.MappingsTable,
+			Columns: []string{importconnector.MappingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importmapping.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ImportConnector.Create().
+//		SetCreateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ImportConnectorUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ImportConnectorCreate) OnConflict(opts ...sql.ConflictOption) *ImportConnectorUpsertOne {
+	_c.conflict = opts
+	return &ImportConnectorUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ImportConnector.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ImportConnectorCreate) OnConflictColumns(columns ...string) *ImportConnectorUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ImportConnectorUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ImportConnectorUpsertOne is the builder for "upsert"-ing
+	//  one ImportConnector node.
+	ImportConnectorUpsertOne struct {
+		create *ImportConnectorCreate
+	}
+
+	// ImportConnectorUpsert is the "OnConflict" setter.
+	ImportConnectorUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateBy sets the "create_by" field.
+func (u *ImportConnectorUpsert) SetCreateBy(v uint32) *ImportConnectorUpsert {
+	u.Set(importconnector.FieldCreateBy, v)
+	return u
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *ImportConnectorUpsert) UpdateCreateBy() *ImportConnectorUpsert {
+	u.SetExcluded(importconnector.FieldCreateBy)
+	return u
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *ImportConnectorUpsert) AddCreateBy(v uint32) *ImportConnectorUpsert {
+	u.Add(importconnector.FieldCreateBy, v)
+	return u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *ImportConnectorUpsert) ClearCreateBy() *ImportConnectorUpsert {
+	u.SetNull(importconnector.FieldCreateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ImportConnectorUpsert) SetUpdateTime(v time.Time) *ImportConnectorUpsert {
+	u.Set(importconnector.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ImportConnectorUpsert) UpdateUpdateTime() *ImportConnectorUpsert {
+	u.SetExcluded(importconnector.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ImportConnectorUpsert) ClearUpdateTime() *ImportConnectorUpsert {
+	u.SetNull(importconnector.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ImportConnectorUpsert) SetDeleteTime(v time.Time) *ImportConnectorUpsert {
+	u.Set(importconnector.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ImportConnectorUpsert) UpdateDeleteTime() *ImportConnectorUpsert {
+	u.SetExcluded(importconnector.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ImportConnectorUpsert) ClearDeleteTime() *ImportConnectorUpsert {
+	u.SetNull(importconnector.FieldDeleteTime)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ImportConnectorUpsert) SetName(v string) *ImportConnectorUpsert {
+	u.Set(importconnector.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ImportConnectorUpsert) UpdateName() *ImportConnectorUpsert {
+	u.SetExcluded(importconnector.FieldName)
+	return u
+}
+
+// SetProvider sets the "provider" field.
+func (u *ImportConnectorUpsert) SetProvider(v importconnector.Provider) *ImportConnectorUpsert {
+	u.Set(importconnector.FieldProvider, v)
+	return u
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *ImportConnectorUpsert) UpdateProvider() *ImportConnectorUpsert {
+	u.SetExcluded(importconnector.FieldProvider)
+	return u
+}
+
+// SetCredentials sets the "credentials" field.
+func (u *ImportConnectorUpsert) SetCredentials(v string) *ImportConnectorUpsert {
+	u.Set(importconnector.FieldCredentials, v)
+	return u
+}
+
+// UpdateCredentials sets the "credentials" field to the value that was provided on create.
+func (u *ImportConnectorUpsert) UpdateCredentials() *ImportConnectorUpsert {
+	u.SetExcluded(importconnector.FieldCredentials)
+	return u
+}
+
+// SetConfig sets the "config" field.
+func (u *ImportConnectorUpsert) SetConfig(v map[string]string) *ImportConnectorUpsert {
+	u.Set(importconnector.FieldConfig, v)
+	return u
+}
+
+// UpdateConfig sets the "config" field to the value that was provided on create.
+func (u *ImportConnectorUpsert) UpdateConfig() *ImportConnectorUpsert {
+	u.SetExcluded(importconnector.FieldConfig)
+	return u
+}
+
+// ClearConfig clears the value of the "config" field.
+func (u *ImportConnectorUpsert) ClearConfig() *ImportConnectorUpsert {
+	u.SetNull(importconnector.FieldConfig)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ImportConnectorUpsert) SetEnabled(v bool) *ImportConnectorUpsert {
+	u.Set(importconnector.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ImportConnectorUpsert) UpdateEnabled() *ImportConnectorUpsert {
+	u.SetExcluded(importconnector.FieldEnabled)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ImportConnector.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(importconnector.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ImportConnectorUpsertOne) UpdateNewValues() *ImportConnectorUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(importconnector.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(importconnector.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(importconnector.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ImportConnector.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ImportConnectorUpsertOne) Ignore() *ImportConnectorUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ImportConnectorUpsertOne) DoNothing() *ImportConnectorUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ImportConnectorCreate.OnConflict
+// documentation for more info.
+func (u *ImportConnectorUpsertOne) Update(set func(*ImportConnectorUpsert)) *ImportConnectorUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ImportConnectorUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *ImportConnectorUpsertOne) SetCreateBy(v uint32) *ImportConnectorUpsertOne {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *ImportConnectorUpsertOne) AddCreateBy(v uint32) *ImportConnectorUpsertOne {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *ImportConnectorUpsertOne) UpdateCreateBy() *ImportConnectorUpsertOne {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *ImportConnectorUpsertOne) ClearCreateBy() *ImportConnectorUpsertOne {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ImportConnectorUpsertOne) SetUpdateTime(v time.Time) *ImportConnectorUpsertOne {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ImportConnectorUpsertOne) UpdateUpdateTime() *ImportConnectorUpsertOne {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ImportConnectorUpsertOne) ClearUpdateTime() *ImportConnectorUpsertOne {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ImportConnectorUpsertOne) SetDeleteTime(v time.Time) *ImportConnectorUpsertOne {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ImportConnectorUpsertOne) UpdateDeleteTime() *ImportConnectorUpsertOne {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ImportConnectorUpsertOne) ClearDeleteTime() *ImportConnectorUpsertOne {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ImportConnectorUpsertOne) SetName(v string) *ImportConnectorUpsertOne {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ImportConnectorUpsertOne) UpdateName() *ImportConnectorUpsertOne {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *ImportConnectorUpsertOne) SetProvider(v importconnector.Provider) *ImportConnectorUpsertOne {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *ImportConnectorUpsertOne) UpdateProvider() *ImportConnectorUpsertOne {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetCredentials sets the "credentials" field.
+func (u *ImportConnectorUpsertOne) SetCredentials(v string) *ImportConnectorUpsertOne {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.SetCredentials(v)
+	})
+}
+
+// UpdateCredentials sets the "credentials" field to the value that was provided on create.
+func (u *ImportConnectorUpsertOne) UpdateCredentials() *ImportConnectorUpsertOne {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.UpdateCredentials()
+	})
+}
+
+// SetConfig sets the "config" field.
+func (u *ImportConnectorUpsertOne) SetConfig(v map[string]string) *ImportConnectorUpsertOne {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.SetConfig(v)
+	})
+}
+
+// UpdateConfig sets the "config" field to the value that was provided on create.
+func (u *ImportConnectorUpsertOne) UpdateConfig() *ImportConnectorUpsertOne {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.UpdateConfig()
+	})
+}
+
+// ClearConfig clears the value of the "config" field.
+func (u *ImportConnectorUpsertOne) ClearConfig() *ImportConnectorUpsertOne {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.ClearConfig()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ImportConnectorUpsertOne) SetEnabled(v bool) *ImportConnectorUpsertOne {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ImportConnectorUpsertOne) UpdateEnabled() *ImportConnectorUpsertOne {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// Exec executes the query.
+func (u *ImportConnectorUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ImportConnectorCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ImportConnectorUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ImportConnectorUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ImportConnectorUpsertOne.ID is not supported by MySQL driver. Use ImportConnectorUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ImportConnectorUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ImportConnectorCreateBulk is the builder for creating many ImportConnector entities in bulk.
+type ImportConnectorCreateBulk struct {
+	config
+	err      error
+	builders []*ImportConnectorCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ImportConnector entities in the database.
+func (_c *ImportConnectorCreateBulk) Save(ctx context.Context) ([]*ImportConnector, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ImportConnector, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ImportConnectorMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ImportConnectorCreateBulk) SaveX(ctx context.Context) []*ImportConnector {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImportConnectorCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImportConnectorCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ImportConnector.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ImportConnectorUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ImportConnectorCreateBulk) OnConflict(opts ...sql.ConflictOption) *ImportConnectorUpsertBulk {
+	_c.conflict = opts
+	return &ImportConnectorUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ImportConnector.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ImportConnectorCreateBulk) OnConflictColumns(columns ...string) *ImportConnectorUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ImportConnectorUpsertBulk{
+		create: _c,
+	}
+}
+
+// ImportConnectorUpsertBulk is the builder for "upsert"-ing
+// a bulk of ImportConnector nodes.
+type ImportConnectorUpsertBulk struct {
+	create *ImportConnectorCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ImportConnector.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(importconnector.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ImportConnectorUpsertBulk) UpdateNewValues() *ImportConnectorUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(importconnector.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(importconnector.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(importconnector.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ImportConnector.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ImportConnectorUpsertBulk) Ignore() *ImportConnectorUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ImportConnectorUpsertBulk) DoNothing() *ImportConnectorUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ImportConnectorCreateBulk.OnConflict
+// documentation for more info.
+func (u *ImportConnectorUpsertBulk) Update(set func(*ImportConnectorUpsert)) *ImportConnectorUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ImportConnectorUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *ImportConnectorUpsertBulk) SetCreateBy(v uint32) *ImportConnectorUpsertBulk {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *ImportConnectorUpsertBulk) AddCreateBy(v uint32) *ImportConnectorUpsertBulk {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *ImportConnectorUpsertBulk) UpdateCreateBy() *ImportConnectorUpsertBulk {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *ImportConnectorUpsertBulk) ClearCreateBy() *ImportConnectorUpsertBulk {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ImportConnectorUpsertBulk) SetUpdateTime(v time.Time) *ImportConnectorUpsertBulk {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ImportConnectorUpsertBulk) UpdateUpdateTime() *ImportConnectorUpsertBulk {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ImportConnectorUpsertBulk) ClearUpdateTime() *ImportConnectorUpsertBulk {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ImportConnectorUpsertBulk) SetDeleteTime(v time.Time) *ImportConnectorUpsertBulk {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ImportConnectorUpsertBulk) UpdateDeleteTime() *ImportConnectorUpsertBulk {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ImportConnectorUpsertBulk) ClearDeleteTime() *ImportConnectorUpsertBulk {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ImportConnectorUpsertBulk) SetName(v string) *ImportConnectorUpsertBulk {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ImportConnectorUpsertBulk) UpdateName() *ImportConnectorUpsertBulk {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *ImportConnectorUpsertBulk) SetProvider(v importconnector.Provider) *ImportConnectorUpsertBulk {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *ImportConnectorUpsertBulk) UpdateProvider() *ImportConnectorUpsertBulk {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetCredentials sets the "credentials" field.
+func (u *ImportConnectorUpsertBulk) SetCredentials(v string) *ImportConnectorUpsertBulk {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.SetCredentials(v)
+	})
+}
+
+// UpdateCredentials sets the "credentials" field to the value that was provided on create.
+func (u *ImportConnectorUpsertBulk) UpdateCredentials() *ImportConnectorUpsertBulk {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.UpdateCredentials()
+	})
+}
+
+// SetConfig sets the "config" field.
+func (u *ImportConnectorUpsertBulk) SetConfig(v map[string]string) *ImportConnectorUpsertBulk {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.SetConfig(v)
+	})
+}
+
+// UpdateConfig sets the "config" field to the value that was provided on create.
+func (u *ImportConnectorUpsertBulk) UpdateConfig() *ImportConnectorUpsertBulk {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.UpdateConfig()
+	})
+}
+
+// ClearConfig clears the value of the "config" field.
+func (u *ImportConnectorUpsertBulk) ClearConfig() *ImportConnectorUpsertBulk {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.ClearConfig()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ImportConnectorUpsertBulk) SetEnabled(v bool) *ImportConnectorUpsertBulk {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ImportConnectorUpsertBulk) UpdateEnabled() *ImportConnectorUpsertBulk {
+	return u.Update(func(s *ImportConnectorUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// Exec executes the query.
+func (u *ImportConnectorUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ImportConnectorCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ImportConnectorCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ImportConnectorUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,761 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importconnector"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importmapping"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+)
+
+// ImportConnectorUpdate is the builder for updating ImportConnector entities.
+type ImportConnectorUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ImportConnectorMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ImportConnectorUpdate builder.
+func (_u *ImportConnectorUpdate) Where(ps ...predicate.ImportConnector) *ImportConnectorUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *ImportConnectorUpdate) SetCreateBy(v uint32) *ImportConnectorUpdate {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *ImportConnectorUpdate) SetNillableCreateBy(v *uint32) *ImportConnectorUpdate {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *ImportConnectorUpdate) AddCreateBy(v int32) *ImportConnectorUpdate {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *ImportConnectorUpdate) ClearCreateBy() *ImportConnectorUpdate {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ImportConnectorUpdate) SetUpdateTime(v time.Time) *ImportConnectorUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *ImportConnectorUpdate) SetNillableUpdateTime(v *time.Time) *ImportConnectorUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *ImportConnectorUpdate) ClearUpdateTime() *ImportConnectorUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *ImportConnectorUpdate) SetDeleteTime(v time.Time) *ImportConnectorUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *ImportConnectorUpdate) SetNillableDeleteTime(v *time.Time) *ImportConnectorUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *ImportConnectorUpdate) ClearDeleteTime() *ImportConnectorUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ImportConnectorUpdate) SetName(v string) *ImportConnectorUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ImportConnectorUpdate) SetNillableName(v *string) *ImportConnectorUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *ImportConnectorUpdate) SetProvider(v importconnector.Provider) *ImportConnectorUpdate {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *ImportConnectorUpdate) SetNillableProvider(v *importconnector.Provider) *ImportConnectorUpdate {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetCredentials sets the "credentials" field.
+func (_u *ImportConnectorUpdate) SetCredentials(v string) *ImportConnectorUpdate {
+	_u.mutation.SetCredentials(v)
+	return _u
+}
+
+// SetNillableCredentials sets the "credentials" field if the given value is not nil.
+func (_u *ImportConnectorUpdate) SetNillableCredentials(v *string) *ImportConnectorUpdate {
+	if v != nil {
+		_u.SetCredentials(*v)
+	}
+	return _u
+}
+
+// SetConfig sets the "config" field.
+func (_u *ImportConnectorUpdate) SetConfig(v map[string]string) *ImportConnectorUpdate {
+	_u.mutation.SetConfig(v)
+	return _u
+}
+
+// ClearConfig clears the value of the "config" field.
+func (_u *ImportConnectorUpdate) ClearConfig() *ImportConnectorUpdate {
+	_u.mutation.ClearConfig()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *ImportConnectorUpdate) SetEnabled(v bool) *ImportConnectorUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *ImportConnectorUpdate) SetNillableEnabled(v *bool) *ImportConnectorUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// AddMappingIDs adds the "mappings" edge to the ImportMapping entity by IDs.
+func (_u *ImportConnectorUpdate) AddMappingIDs(ids ...string) *ImportConnectorUpdate {
+	_u.mutation.AddMappingIDs(ids...)
+	return _u
+}
+
+// AddMappings adds the "mappings" edges to the ImportMapping entity.
+func (_u *ImportConnectorUpdate) AddMappings(v ...*ImportMapping) *ImportConnectorUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMappingIDs(ids...)
+}
+
+// Mutation returns the ImportConnectorMutation object of the builder.
+func (_u *ImportConnectorUpdate) Mutation() *ImportConnectorMutation {
+	return _u.mutation
+}
+
+// ClearMappings clears all "mappings" edges to the ImportMapping entity.
+func (_u *ImportConnectorUpdate) ClearMappings() *ImportConnectorUpdate {
+	_u.mutation.ClearMappings()
+	return _u
+}
+
+// RemoveMappingIDs removes the "mappings" edge to ImportMapping entities by IDs.
+func (_u *ImportConnectorUpdate) RemoveMappingIDs(ids ...string) *ImportConnectorUpdate {
+	_u.mutation.RemoveMappingIDs(ids...)
+	return _u
+}
+
+// RemoveMappings removes "mappings" edges to ImportMapping entities.
+func (_u *ImportConnectorUpdate) RemoveMappings(v ...*ImportMapping) *ImportConnectorUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMappingIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ImportConnectorUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImportConnectorUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ImportConnectorUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImportConnectorUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImportConnectorUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := importconnector.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ImportConnector.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := importconnector.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "ImportConnector.provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Credentials(); ok {
+		if err := importconnector.CredentialsValidator(v); err != nil {
+			return &ValidationError{Name: "credentials", err: fmt.Errorf(`ent: validator failed for field "ImportConnector.credentials": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ImportConnectorUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ImportConnectorUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ImportConnectorUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(importconnector.Table, importconnector.Columns, sqlgraph.NewFieldSpec(importconnector.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(importconnector.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(importconnector.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(importconnector.FieldCreateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(importconnector.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(importconnector.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(importconnector.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(importconnector.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(importconnector.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(importconnector.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(importconnector.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(importconnector.FieldProvider, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Credentials(); ok {
+		_spec.SetField(importconnector.FieldCredentials, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Config(); ok {
+		_spec.SetField(importconnector.FieldConfig, field.TypeJSON, value)
+	}
+	if _u.mutation.ConfigCleared() {
+		_spec.ClearField(importconnector.FieldConfig, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(importconnector.FieldEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.MappingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   importconnector.MappingsTable,
+			Columns: []string{importconnector.MappingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importmapping.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMappingsIDs(); len(nodes) > 0 && !_u.mutation.MappingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   importconnector.MappingsTable,
+			Columns: []string{importconnector.MappingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importmapping.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MappingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   importconnector.MappingsTable,
+			Columns: []string{importconnector.MappingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importmapping.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{importconnector.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ImportConnectorUpdateOne is the builder for updating a single ImportConnector entity.
+type ImportConnectorUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ImportConnectorMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *ImportConnectorUpdateOne) SetCreateBy(v uint32) *ImportConnectorUpdateOne {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *ImportConnectorUpdateOne) SetNillableCreateBy(v *uint32) *ImportConnectorUpdateOne {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *ImportConnectorUpdateOne) AddCreateBy(v int32) *ImportConnectorUpdateOne {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *ImportConnectorUpdateOne) ClearCreateBy() *ImportConnectorUpdateOne {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ImportConnectorUpdateOne) SetUpdateTime(v time.Time) *ImportConnectorUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *ImportConnectorUpdateOne) SetNillableUpdateTime(v *time.Time) *ImportConnectorUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *ImportConnectorUpdateOne) ClearUpdateTime() *ImportConnectorUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *ImportConnectorUpdateOne) SetDeleteTime(v time.Time) *ImportConnectorUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *ImportConnectorUpdateOne) SetNillableDeleteTime(v *time.Time) *ImportConnectorUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *ImportConnectorUpdateOne) ClearDeleteTime() *ImportConnectorUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ImportConnectorUpdateOne) SetName(v string) *ImportConnectorUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ImportConnectorUpdateOne) SetNillableName(v *string) *ImportConnectorUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *ImportConnectorUpdateOne) SetProvider(v importconnector.Provider) *ImportConnectorUpdateOne {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *ImportConnectorUpdateOne) SetNillableProvider(v *importconnector.Provider) *ImportConnectorUpdateOne {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetCredentials sets the "credentials" field.
+func (_u *ImportConnectorUpdateOne) SetCredentials(v string) *ImportConnectorUpdateOne {
+	_u.mutation.SetCredentials(v)
+	return _u
+}
+
+// SetNillableCredentials sets the "credentials" field if the given value is not nil.
+func (_u *ImportConnectorUpdateOne) SetNillableCredentials(v *string) *ImportConnectorUpdateOne {
+	if v != nil {
+		_u.SetCredentials(*v)
+	}
+	return _u
+}
+
+// SetConfig sets the "config" field.
+func (_u *ImportConnectorUpdateOne) SetConfig(v map[string]string) *ImportConnectorUpdateOne {
+	_u.mutation.SetConfig(v)
+	return _u
+}
+
+// ClearConfig clears the value of the "config" field.
+func (_u *ImportConnectorUpdateOne) ClearConfig() *ImportConnectorUpdateOne {
+	_u.mutation.ClearConfig()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *ImportConnectorUpdateOne) SetEnabled(v bool) *ImportConnectorUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *ImportConnectorUpdateOne) SetNillableEnabled(v *bool) *ImportConnectorUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// AddMappingIDs adds the "mappings" edge to the ImportMapping entity by IDs.
+func (_u *ImportConnectorUpdateOne) AddMappingIDs(ids ...string) *ImportConnectorUpdateOne {
+	_u.mutation.AddMappingIDs(ids...)
+	return _u
+}
+
+// AddMappings adds the "mappings" edges to the ImportMapping entity.
+func (_u *ImportConnectorUpdateOne) AddMappings(v ...*ImportMapping) *ImportConnectorUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMappingIDs(ids...)
+}
+
+// Mutation returns the ImportConnectorMutation object of the builder.
+func (_u *ImportConnectorUpdateOne) Mutation() *ImportConnectorMutation {
+	return _u.mutation
+}
+
+// ClearMappings clears all "mappings" edges to the ImportMapping entity.
+func (_u *ImportConnectorUpdateOne) ClearMappings() *ImportConnectorUpdateOne {
+	_u.mutation.ClearMappings()
+	return _u
+}
+
+// RemoveMappingIDs removes the "mappings" edge to ImportMapping entities by IDs.
+func (_u *ImportConnectorUpdateOne) RemoveMappingIDs(ids ...string) *ImportConnectorUpdateOne {
+	_u.mutation.RemoveMappingIDs(ids...)
+	return _u
+}
+
+// RemoveMappings removes "mappings" edges to ImportMapping entities.
+func (_u *ImportConnectorUpdateOne) RemoveMappings(v ...*ImportMapping) *ImportConnectorUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMappingIDs(ids...)
+}
+
+// Where appends a list predicates to the ImportConnectorUpdate builder.
+func (_u *ImportConnectorUpdateOne) Where(ps ...predicate.ImportConnector) *ImportConnectorUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ImportConnectorUpdateOne) Select(field string, fields ...string) *ImportConnectorUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ImportConnector entity.
+func (_u *ImportConnectorUpdateOne) Save(ctx context.Context) (*ImportConnector, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImportConnectorUpdateOne) SaveX(ctx context.Context) *ImportConnector {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ImportConnectorUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImportConnectorUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImportConnectorUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := importconnector.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ImportConnector.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := importconnector.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "ImportConnector.provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Credentials(); ok {
+		if err := importconnector.CredentialsValidator(v); err != nil {
+			return &ValidationError{Name: "credentials", err: fmt.Errorf(`ent: validator failed for field "ImportConnector.credentials": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ImportConnectorUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ImportConnectorUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ImportConnectorUpdateOne) sqlSave(ctx context.Context) (_node *ImportConnector, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(importconnector.Table, importconnector.Columns, sqlgraph.NewFieldSpec(importconnector.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ImportConnector.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, importconnector.FieldID)
+		for _, f := range fields {
+			if !importconnector.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != importconnector.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(importconnector.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(importconnector.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(importconnector.FieldCreateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(importconnector.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(importconnector.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(importconnector.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(importconnector.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(importconnector.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(importconnector.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(importconnector.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(importconnector.FieldProvider, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Credentials(); ok {
+		_spec.SetField(importconnector.FieldCredentials, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Config(); ok {
+		_spec.SetField(importconnector.FieldConfig, field.TypeJSON, value)
+	}
+	if _u.mutation.ConfigCleared() {
+		_spec.ClearField(importconnector.FieldConfig, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(importconnector.FieldEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.MappingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   importconnector.MappingsTable,
+			Columns: []string{importconnector.MappingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importmapping.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMappingsIDs(); len(nodes) > 0 && !_u.mutation.MappingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   importconnector.MappingsTable,
+			Columns: []string{importconnector.MappingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importmapping.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MappingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   importconnector.MappingsTable,
+			Columns: []string{importconnector.MappingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importmapping.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ImportConnector{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{importconnector.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
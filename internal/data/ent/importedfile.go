@@ -0,0 +1,225 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importedfile"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importmapping"
+)
+
+// ImportedFile is the model entity for the ImportedFile schema.
+type ImportedFile struct {
+	config `json:"-"`
+	// ID of the ent.
+	// id
+	ID uint32 `json:"id,omitempty"`
+	// 创建时间
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// 更新时间
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// 删除时间
+	DeleteTime *time.Time `json:"delete_time,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// Mapping that imported the file
+	MappingID string `json:"mapping_id,omitempty"`
+	// ID of the remote file
+	RemoteFileID string `json:"remote_file_id,omitempty"`
+	// Modification time or ETag of the imported revision
+	RemoteVersion string `json:"remote_version,omitempty"`
+	// Document created from the file
+	DocumentID string `json:"document_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ImportedFileQuery when eager-loading is set.
+	Edges        ImportedFileEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ImportedFileEdges holds the relations/edges for other nodes in the graph.
+type ImportedFileEdges struct {
+	// Mapping holds the value of the mapping edge.
+	Mapping *ImportMapping `json:"mapping,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MappingOrErr returns the Mapping value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ImportedFileEdges) MappingOrErr() (*ImportMapping, error) {
+	if e.Mapping != nil {
+		return e.Mapping, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: importmapping.Label}
+	}
+	return nil, &NotLoadedError{edge: "mapping"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ImportedFile) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case importedfile.FieldID, importedfile.FieldTenantID:
+			values[i] = new(sql.NullInt64)
+		case importedfile.FieldMappingID, importedfile.FieldRemoteFileID, importedfile.FieldRemoteVersion, importedfile.FieldDocumentID:
+			values[i] = new(sql.NullString)
+		case importedfile.FieldCreateTime, importedfile.FieldUpdateTime, importedfile.FieldDeleteTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ImportedFile fields.
+func (_m *ImportedFile) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case importedfile.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint32(value.Int64)
+		case importedfile.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = new(time.Time)
+				*_m.CreateTime = value.Time
+			}
+		case importedfile.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = new(time.Time)
+				*_m.UpdateTime = value.Time
+			}
+		case importedfile.FieldDeleteTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_time", values[i])
+			} else if value.Valid {
+				_m.DeleteTime = new(time.Time)
+				*_m.DeleteTime = value.Time
+			}
+		case importedfile.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case importedfile.FieldMappingID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mapping_id", values[i])
+			} else if value.Valid {
+				_m.MappingID = value.String
+			}
+		case importedfile.FieldRemoteFileID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field remote_file_id", values[i])
+			} else if value.Valid {
+				_m.RemoteFileID = value.String
+			}
+		case importedfile.FieldRemoteVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field remote_version", values[i])
+			} else if value.Valid {
+				_m.RemoteVersion = value.String
+			}
+		case importedfile.FieldDocumentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field document_id", values[i])
+			} else if value.Valid {
+				_m.DocumentID = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ImportedFile.
+// This includes values selected through modifiers, order, etc.
+func (_m *ImportedFile) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryMapping queries the "mapping" edge of the ImportedFile entity.
+func (_m *ImportedFile) QueryMapping() *ImportMappingQuery {
+	return NewImportedFileClient(_m.config).QueryMapping(_m)
+}
+
+// Update returns a builder for updating this ImportedFile.
+// Note that you need to call ImportedFile.Unwrap() before calling this method if this ImportedFile
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ImportedFile) Update() *ImportedFileUpdateOne {
+	return NewImportedFileClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ImportedFile entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ImportedFile) Unwrap() *ImportedFile {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ImportedFile is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ImportedFile) String() string {
+	var builder strings.Builder
+	builder.WriteString("ImportedFile(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeleteTime; v != nil {
+		builder.WriteString("delete_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("mapping_id=")
+	builder.WriteString(_m.MappingID)
+	builder.WriteString(", ")
+	builder.WriteString("remote_file_id=")
+	builder.WriteString(_m.RemoteFileID)
+	builder.WriteString(", ")
+	builder.WriteString("remote_version=")
+	builder.WriteString(_m.RemoteVersion)
+	builder.WriteString(", ")
+	builder.WriteString("document_id=")
+	builder.WriteString(_m.DocumentID)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ImportedFiles is a parsable slice of ImportedFile.
+type ImportedFiles []*ImportedFile
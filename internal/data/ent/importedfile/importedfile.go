@@ -0,0 +1,150 @@
+// Code generated by ent, DO NOT EDIT.
+
+package importedfile
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the importedfile type in the database.
+	Label = "imported_file"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldDeleteTime holds the string denoting the delete_time field in the database.
+	FieldDeleteTime = "delete_time"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldMappingID holds the string denoting the mapping_id field in the database.
+	FieldMappingID = "mapping_id"
+	// FieldRemoteFileID holds the string denoting the remote_file_id field in the database.
+	FieldRemoteFileID = "remote_file_id"
+	// FieldRemoteVersion holds the string denoting the remote_version field in the database.
+	FieldRemoteVersion = "remote_version"
+	// FieldDocumentID holds the string denoting the document_id field in the database.
+	FieldDocumentID = "document_id"
+	// EdgeMapping holds the string denoting the mapping edge name in mutations.
+	EdgeMapping = "mapping"
+	// Table holds the table name of the importedfile in the database.
+	Table = "paperless_imported_files"
+	// MappingTable is the table that holds the mapping relation/edge.
+	MappingTable = "paperless_imported_files"
+	// MappingInverseTable is the table name for the ImportMapping entity.
+	// It exists in this package in order to avoid circular dependency with the "importmapping" package.
+	MappingInverseTable = "paperless_import_mappings"
+	// MappingColumn is the table column denoting the mapping relation/edge.
+	MappingColumn = "mapping_id"
+)
+
+// Columns holds all SQL columns for importedfile fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldDeleteTime,
+	FieldTenantID,
+	FieldMappingID,
+	FieldRemoteFileID,
+	FieldRemoteVersion,
+	FieldDocumentID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/go-tangra/go-tangra-paperless/internal/data/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint32
+	// MappingIDValidator is a validator for the "mapping_id" field. It is called by the builders before save.
+	MappingIDValidator func(string) error
+	// RemoteFileIDValidator is a validator for the "remote_file_id" field. It is called by the builders before save.
+	RemoteFileIDValidator func(string) error
+	// RemoteVersionValidator is a validator for the "remote_version" field. It is called by the builders before save.
+	RemoteVersionValidator func(string) error
+	// DocumentIDValidator is a validator for the "document_id" field. It is called by the builders before save.
+	DocumentIDValidator func(string) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(uint32) error
+)
+
+// OrderOption defines the ordering options for the ImportedFile queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByDeleteTime orders the results by the delete_time field.
+func ByDeleteTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeleteTime, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByMappingID orders the results by the mapping_id field.
+func ByMappingID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMappingID, opts...).ToFunc()
+}
+
+// ByRemoteFileID orders the results by the remote_file_id field.
+func ByRemoteFileID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemoteFileID, opts...).ToFunc()
+}
+
+// ByRemoteVersion orders the results by the remote_version field.
+func ByRemoteVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemoteVersion, opts...).ToFunc()
+}
+
+// ByDocumentID orders the results by the document_id field.
+func ByDocumentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDocumentID, opts...).ToFunc()
+}
+
+// ByMappingField orders the results by mapping field.
+func ByMappingField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMappingStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newMappingStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MappingInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, MappingTable, MappingColumn),
+	)
+}
@@ -0,0 +1,604 @@
+// Code generated by ent, DO NOT EDIT.
+
+package importedfile
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldEQ(FieldTenantID, v))
+}
+
+// MappingID applies equality check predicate on the "mapping_id" field. It's identical to MappingIDEQ.
+func MappingID(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldEQ(FieldMappingID, v))
+}
+
+// RemoteFileID applies equality check predicate on the "remote_file_id" field. It's identical to RemoteFileIDEQ.
+func RemoteFileID(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldEQ(FieldRemoteFileID, v))
+}
+
+// RemoteVersion applies equality check predicate on the "remote_version" field. It's identical to RemoteVersionEQ.
+func RemoteVersion(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldEQ(FieldRemoteVersion, v))
+}
+
+// DocumentID applies equality check predicate on the "document_id" field. It's identical to DocumentIDEQ.
+func DocumentID(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldEQ(FieldDocumentID, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldNotNull(FieldTenantID))
+}
+
+// MappingIDEQ applies the EQ predicate on the "mapping_id" field.
+func MappingIDEQ(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldEQ(FieldMappingID, v))
+}
+
+// MappingIDNEQ applies the NEQ predicate on the "mapping_id" field.
+func MappingIDNEQ(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldNEQ(FieldMappingID, v))
+}
+
+// MappingIDIn applies the In predicate on the "mapping_id" field.
+func MappingIDIn(vs ...string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldIn(FieldMappingID, vs...))
+}
+
+// MappingIDNotIn applies the NotIn predicate on the "mapping_id" field.
+func MappingIDNotIn(vs ...string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldNotIn(FieldMappingID, vs...))
+}
+
+// MappingIDGT applies the GT predicate on the "mapping_id" field.
+func MappingIDGT(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldGT(FieldMappingID, v))
+}
+
+// MappingIDGTE applies the GTE predicate on the "mapping_id" field.
+func MappingIDGTE(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldGTE(FieldMappingID, v))
+}
+
+// MappingIDLT applies the LT predicate on the "mapping_id" field.
+func MappingIDLT(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldLT(FieldMappingID, v))
+}
+
+// MappingIDLTE applies the LTE predicate on the "mapping_id" field.
+func MappingIDLTE(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldLTE(FieldMappingID, v))
+}
+
+// MappingIDContains applies the Contains predicate on the "mapping_id" field.
+func MappingIDContains(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldContains(FieldMappingID, v))
+}
+
+// MappingIDHasPrefix applies the HasPrefix predicate on the "mapping_id" field.
+func MappingIDHasPrefix(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldHasPrefix(FieldMappingID, v))
+}
+
+// MappingIDHasSuffix applies the HasSuffix predicate on the "mapping_id" field.
+func MappingIDHasSuffix(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldHasSuffix(FieldMappingID, v))
+}
+
+// MappingIDEqualFold applies the EqualFold predicate on the "mapping_id" field.
+func MappingIDEqualFold(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldEqualFold(FieldMappingID, v))
+}
+
+// MappingIDContainsFold applies the ContainsFold predicate on the "mapping_id" field.
+func MappingIDContainsFold(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldContainsFold(FieldMappingID, v))
+}
+
+// RemoteFileIDEQ applies the EQ predicate on the "remote_file_id" field.
+func RemoteFileIDEQ(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldEQ(FieldRemoteFileID, v))
+}
+
+// RemoteFileIDNEQ applies the NEQ predicate on the "remote_file_id" field.
+func RemoteFileIDNEQ(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldNEQ(FieldRemoteFileID, v))
+}
+
+// RemoteFileIDIn applies the In predicate on the "remote_file_id" field.
+func RemoteFileIDIn(vs ...string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldIn(FieldRemoteFileID, vs...))
+}
+
+// RemoteFileIDNotIn applies the NotIn predicate on the "remote_file_id" field.
+func RemoteFileIDNotIn(vs ...string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldNotIn(FieldRemoteFileID, vs...))
+}
+
+// RemoteFileIDGT applies the GT predicate on the "remote_file_id" field.
+func RemoteFileIDGT(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldGT(FieldRemoteFileID, v))
+}
+
+// RemoteFileIDGTE applies the GTE predicate on the "remote_file_id" field.
+func RemoteFileIDGTE(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldGTE(FieldRemoteFileID, v))
+}
+
+// RemoteFileIDLT applies the LT predicate on the "remote_file_id" field.
+func RemoteFileIDLT(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldLT(FieldRemoteFileID, v))
+}
+
+// RemoteFileIDLTE applies the LTE predicate on the "remote_file_id" field.
+func RemoteFileIDLTE(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldLTE(FieldRemoteFileID, v))
+}
+
+// RemoteFileIDContains applies the Contains predicate on the "remote_file_id" field.
+func RemoteFileIDContains(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldContains(FieldRemoteFileID, v))
+}
+
+// RemoteFileIDHasPrefix applies the HasPrefix predicate on the "remote_file_id" field.
+func RemoteFileIDHasPrefix(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldHasPrefix(FieldRemoteFileID, v))
+}
+
+// RemoteFileIDHasSuffix applies the HasSuffix predicate on the "remote_file_id" field.
+func RemoteFileIDHasSuffix(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldHasSuffix(FieldRemoteFileID, v))
+}
+
+// RemoteFileIDEqualFold applies the EqualFold predicate on the "remote_file_id" field.
+func RemoteFileIDEqualFold(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldEqualFold(FieldRemoteFileID, v))
+}
+
+// RemoteFileIDContainsFold applies the ContainsFold predicate on the "remote_file_id" field.
+func RemoteFileIDContainsFold(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldContainsFold(FieldRemoteFileID, v))
+}
+
+// RemoteVersionEQ applies the EQ predicate on the "remote_version" field.
+func RemoteVersionEQ(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldEQ(FieldRemoteVersion, v))
+}
+
+// RemoteVersionNEQ applies the NEQ predicate on the "remote_version" field.
+func RemoteVersionNEQ(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldNEQ(FieldRemoteVersion, v))
+}
+
+// RemoteVersionIn applies the In predicate on the "remote_version" field.
+func RemoteVersionIn(vs ...string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldIn(FieldRemoteVersion, vs...))
+}
+
+// RemoteVersionNotIn applies the NotIn predicate on the "remote_version" field.
+func RemoteVersionNotIn(vs ...string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldNotIn(FieldRemoteVersion, vs...))
+}
+
+// RemoteVersionGT applies the GT predicate on the "remote_version" field.
+func RemoteVersionGT(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldGT(FieldRemoteVersion, v))
+}
+
+// RemoteVersionGTE applies the GTE predicate on the "remote_version" field.
+func RemoteVersionGTE(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldGTE(FieldRemoteVersion, v))
+}
+
+// RemoteVersionLT applies the LT predicate on the "remote_version" field.
+func RemoteVersionLT(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldLT(FieldRemoteVersion, v))
+}
+
+// RemoteVersionLTE applies the LTE predicate on the "remote_version" field.
+func RemoteVersionLTE(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldLTE(FieldRemoteVersion, v))
+}
+
+// RemoteVersionContains applies the Contains predicate on the "remote_version" field.
+func RemoteVersionContains(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldContains(FieldRemoteVersion, v))
+}
+
+// RemoteVersionHasPrefix applies the HasPrefix predicate on the "remote_version" field.
+func RemoteVersionHasPrefix(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldHasPrefix(FieldRemoteVersion, v))
+}
+
+// RemoteVersionHasSuffix applies the HasSuffix predicate on the "remote_version" field.
+func RemoteVersionHasSuffix(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldHasSuffix(FieldRemoteVersion, v))
+}
+
+// RemoteVersionIsNil applies the IsNil predicate on the "remote_version" field.
+func RemoteVersionIsNil() predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldIsNull(FieldRemoteVersion))
+}
+
+// RemoteVersionNotNil applies the NotNil predicate on the "remote_version" field.
+func RemoteVersionNotNil() predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldNotNull(FieldRemoteVersion))
+}
+
+// RemoteVersionEqualFold applies the EqualFold predicate on the "remote_version" field.
+func RemoteVersionEqualFold(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldEqualFold(FieldRemoteVersion, v))
+}
+
+// RemoteVersionContainsFold applies the ContainsFold predicate on the "remote_version" field.
+func RemoteVersionContainsFold(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldContainsFold(FieldRemoteVersion, v))
+}
+
+// DocumentIDEQ applies the EQ predicate on the "document_id" field.
+func DocumentIDEQ(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldEQ(FieldDocumentID, v))
+}
+
+// DocumentIDNEQ applies the NEQ predicate on the "document_id" field.
+func DocumentIDNEQ(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldNEQ(FieldDocumentID, v))
+}
+
+// DocumentIDIn applies the In predicate on the "document_id" field.
+func DocumentIDIn(vs ...string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldIn(FieldDocumentID, vs...))
+}
+
+// DocumentIDNotIn applies the NotIn predicate on the "document_id" field.
+func DocumentIDNotIn(vs ...string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldNotIn(FieldDocumentID, vs...))
+}
+
+// DocumentIDGT applies the GT predicate on the "document_id" field.
+func DocumentIDGT(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldGT(FieldDocumentID, v))
+}
+
+// DocumentIDGTE applies the GTE predicate on the "document_id" field.
+func DocumentIDGTE(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldGTE(FieldDocumentID, v))
+}
+
+// DocumentIDLT applies the LT predicate on the "document_id" field.
+func DocumentIDLT(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldLT(FieldDocumentID, v))
+}
+
+// DocumentIDLTE applies the LTE predicate on the "document_id" field.
+func DocumentIDLTE(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldLTE(FieldDocumentID, v))
+}
+
+// DocumentIDContains applies the Contains predicate on the "document_id" field.
+func DocumentIDContains(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldContains(FieldDocumentID, v))
+}
+
+// DocumentIDHasPrefix applies the HasPrefix predicate on the "document_id" field.
+func DocumentIDHasPrefix(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldHasPrefix(FieldDocumentID, v))
+}
+
+// DocumentIDHasSuffix applies the HasSuffix predicate on the "document_id" field.
+func DocumentIDHasSuffix(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldHasSuffix(FieldDocumentID, v))
+}
+
+// DocumentIDEqualFold applies the EqualFold predicate on the "document_id" field.
+func DocumentIDEqualFold(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldEqualFold(FieldDocumentID, v))
+}
+
+// DocumentIDContainsFold applies the ContainsFold predicate on the "document_id" field.
+func DocumentIDContainsFold(v string) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.FieldContainsFold(FieldDocumentID, v))
+}
+
+// HasMapping applies the HasEdge predicate on the "mapping" edge.
+func HasMapping() predicate.ImportedFile {
+	return predicate.ImportedFile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MappingTable, MappingColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMappingWith applies the HasEdge predicate on the "mapping" edge with a given conditions (other predicates).
+func HasMappingWith(preds ...predicate.ImportMapping) predicate.ImportedFile {
+	return predicate.ImportedFile(func(s *sql.Selector) {
+		step := newMappingStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ImportedFile) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ImportedFile) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ImportedFile) predicate.ImportedFile {
+	return predicate.ImportedFile(sql.NotPredicates(p))
+}
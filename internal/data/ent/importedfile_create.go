@@ -0,0 +1,933 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importedfile"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importmapping"
+)
+
+// ImportedFileCreate is the builder for creating a ImportedFile entity.
+type ImportedFileCreate struct {
+	config
+	mutation *ImportedFileMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *ImportedFileCreate) SetCreateTime(v time.Time) *ImportedFileCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *ImportedFileCreate) SetNillableCreateTime(v *time.Time) *ImportedFileCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *ImportedFileCreate) SetUpdateTime(v time.Time) *ImportedFileCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *ImportedFileCreate) SetNillableUpdateTime(v *time.Time) *ImportedFileCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *ImportedFileCreate) SetDeleteTime(v time.Time) *ImportedFileCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *ImportedFileCreate) SetNillableDeleteTime(v *time.Time) *ImportedFileCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ImportedFileCreate) SetTenantID(v uint32) *ImportedFileCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *ImportedFileCreate) SetNillableTenantID(v *uint32) *ImportedFileCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetMappingID sets the "mapping_id" field.
+func (_c *ImportedFileCreate) SetMappingID(v string) *ImportedFileCreate {
+	_c.mutation.SetMappingID(v)
+	return _c
+}
+
+// SetRemoteFileID sets the "remote_file_id" field.
+func (_c *ImportedFileCreate) SetRemoteFileID(v string) *ImportedFileCreate {
+	_c.mutation.SetRemoteFileID(v)
+	return _c
+}
+
+// SetRemoteVersion sets the "remote_version" field.
+func (_c *ImportedFileCreate) SetRemoteVersion(v string) *ImportedFileCreate {
+	_c.mutation.SetRemoteVersion(v)
+	return _c
+}
+
+// SetNillableRemoteVersion sets the "remote_version" field if the given value is not nil.
+func (_c *ImportedFileCreate) SetNillableRemoteVersion(v *string) *ImportedFileCreate {
+	if v != nil {
+		_c.SetRemoteVersion(*v)
+	}
+	return _c
+}
+
+// SetDocumentID sets the "document_id" field.
+func (_c *ImportedFileCreate) SetDocumentID(v string) *ImportedFileCreate {
+	_c.mutation.SetDocumentID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ImportedFileCreate) SetID(v uint32) *ImportedFileCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetMapping sets the "mapping" edge to the ImportMapping entity.
+func (_c *ImportedFileCreate) SetMapping(v *ImportMapping) *ImportedFileCreate {
+	return _c.SetMappingID(v.ID)
+}
+
+// Mutation returns the ImportedFileMutation object of the builder.
+func (_c *ImportedFileCreate) Mutation() *ImportedFileMutation {
+	return _c.mutation
+}
+
+// Save creates the ImportedFile in the database.
+func (_c *ImportedFileCreate) Save(ctx context.Context) (*ImportedFile, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ImportedFileCreate) SaveX(ctx context.Context) *ImportedFile {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImportedFileCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImportedFileCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ImportedFileCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := importedfile.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ImportedFileCreate) check() error {
+	if _, ok := _c.mutation.MappingID(); !ok {
+		return &ValidationError{Name: "mapping_id", err: errors.New(`ent: missing required field "ImportedFile.mapping_id"`)}
+	}
+	if v, ok := _c.mutation.MappingID(); ok {
+		if err := importedfile.MappingIDValidator(v); err != nil {
+			return &ValidationError{Name: "mapping_id", err: fmt.Errorf(`ent: validator failed for field "ImportedFile.mapping_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RemoteFileID(); !ok {
+		return &ValidationError{Name: "remote_file_id", err: errors.New(`ent: missing required field "ImportedFile.remote_file_id"`)}
+	}
+	if v, ok := _c.mutation.RemoteFileID(); ok {
+		if err := importedfile.RemoteFileIDValidator(v); err != nil {
+			return &ValidationError{Name: "remote_file_id", err: fmt.Errorf(`ent: validator failed for field "ImportedFile.remote_file_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.RemoteVersion(); ok {
+		if err := importedfile.RemoteVersionValidator(v); err != nil {
+			return &ValidationError{Name: "remote_version", err: fmt.Errorf(`ent: validator failed for field "ImportedFile.remote_version": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DocumentID(); !ok {
+		return &ValidationError{Name: "document_id", err: errors.New(`ent: missing required field "ImportedFile.document_id"`)}
+	}
+	if v, ok := _c.mutation.DocumentID(); ok {
+		if err := importedfile.DocumentIDValidator(v); err != nil {
+			return &ValidationError{Name: "document_id", err: fmt.Errorf(`ent: validator failed for field "ImportedFile.document_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := importedfile.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "ImportedFile.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.MappingIDs()) == 0 {
+		return &ValidationError{Name: "mapping", err: errors.New(`ent: missing required edge "ImportedFile.mapping"`)}
+	}
+	return nil
+}
+
+func (_c *ImportedFileCreate) sqlSave(ctx context.Context) (*ImportedFile, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ImportedFileCreate) createSpec() (*ImportedFile, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ImportedFile{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(importedfile.Table, sqlgraph.NewFieldSpec(importedfile.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(importedfile.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(importedfile.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(importedfile.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(importedfile.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.RemoteFileID(); ok {
+		_spec.SetField(importedfile.FieldRemoteFileID, field.TypeString, value)
+		_node.RemoteFileID = value
+	}
+	if value, ok := _c.mutation.RemoteVersion(); ok {
+		_spec.SetField(importedfile.FieldRemoteVersion, field.TypeString, value)
+		_node.RemoteVersion = value
+	}
+	if value, ok := _c.mutation.DocumentID(); ok {
+		_spec.SetField(importedfile.FieldDocumentID, field.TypeString, value)
+		_node.DocumentID = value
+	}
+	if nodes := _c.mutation.MappingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importedfile.MappingTable,
+			Columns: []string{importedfile.MappingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importmapping.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MappingID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ImportedFile.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ImportedFileUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ImportedFileCreate) OnConflict(opts ...sql.ConflictOption) *ImportedFileUpsertOne {
+	_c.conflict = opts
+	return &ImportedFileUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ImportedFile.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ImportedFileCreate) OnConflictColumns(columns ...string) *ImportedFileUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ImportedFileUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ImportedFileUpsertOne is the builder for "upsert"-ing
+	//  one ImportedFile node.
+	ImportedFileUpsertOne struct {
+		create *ImportedFileCreate
+	}
+
+	// ImportedFileUpsert is the "OnConflict" setter.
+	ImportedFileUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ImportedFileUpsert) SetUpdateTime(v time.Time) *ImportedFileUpsert {
+	u.Set(importedfile.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ImportedFileUpsert) UpdateUpdateTime() *ImportedFileUpsert {
+	u.SetExcluded(importedfile.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ImportedFileUpsert) ClearUpdateTime() *ImportedFileUpsert {
+	u.SetNull(importedfile.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ImportedFileUpsert) SetDeleteTime(v time.Time) *ImportedFileUpsert {
+	u.Set(importedfile.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ImportedFileUpsert) UpdateDeleteTime() *ImportedFileUpsert {
+	u.SetExcluded(importedfile.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ImportedFileUpsert) ClearDeleteTime() *ImportedFileUpsert {
+	u.SetNull(importedfile.FieldDeleteTime)
+	return u
+}
+
+// SetMappingID sets the "mapping_id" field.
+func (u *ImportedFileUpsert) SetMappingID(v string) *ImportedFileUpsert {
+	u.Set(importedfile.FieldMappingID, v)
+	return u
+}
+
+// UpdateMappingID sets the "mapping_id" field to the value that was provided on create.
+func (u *ImportedFileUpsert) UpdateMappingID() *ImportedFileUpsert {
+	u.SetExcluded(importedfile.FieldMappingID)
+	return u
+}
+
+// SetRemoteFileID sets the "remote_file_id" field.
+func (u *ImportedFileUpsert) SetRemoteFileID(v string) *ImportedFileUpsert {
+	u.Set(importedfile.FieldRemoteFileID, v)
+	return u
+}
+
+// UpdateRemoteFileID sets the "remote_file_id" field to the value that was provided on create.
+func (u *ImportedFileUpsert) UpdateRemoteFileID() *ImportedFileUpsert {
+	u.SetExcluded(importedfile.FieldRemoteFileID)
+	return u
+}
+
+// SetRemoteVersion sets the "remote_version" field.
+func (u *ImportedFileUpsert) SetRemoteVersion(v string) *ImportedFileUpsert {
+	u.Set(importedfile.FieldRemoteVersion, v)
+	return u
+}
+
+// UpdateRemoteVersion sets the "remote_version" field to the value that was provided on create.
+func (u *ImportedFileUpsert) UpdateRemoteVersion() *ImportedFileUpsert {
+	u.SetExcluded(importedfile.FieldRemoteVersion)
+	return u
+}
+
+// ClearRemoteVersion clears the value of the "remote_version" field.
+func (u *ImportedFileUpsert) ClearRemoteVersion() *ImportedFileUpsert {
+	u.SetNull(importedfile.FieldRemoteVersion)
+	return u
+}
+
+// SetDocumentID sets the "document_id" field.
+func (u *ImportedFileUpsert) SetDocumentID(v string) *ImportedFileUpsert {
+	u.Set(importedfile.FieldDocumentID, v)
+	return u
+}
+
+// UpdateDocumentID sets the "document_id" field to the value that was provided on create.
+func (u *ImportedFileUpsert) UpdateDocumentID() *ImportedFileUpsert {
+	u.SetExcluded(importedfile.FieldDocumentID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ImportedFile.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(importedfile.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ImportedFileUpsertOne) UpdateNewValues() *ImportedFileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(importedfile.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(importedfile.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(importedfile.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ImportedFile.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ImportedFileUpsertOne) Ignore() *ImportedFileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ImportedFileUpsertOne) DoNothing() *ImportedFileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ImportedFileCreate.OnConflict
+// documentation for more info.
+func (u *ImportedFileUpsertOne) Update(set func(*ImportedFileUpsert)) *ImportedFileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ImportedFileUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ImportedFileUpsertOne) SetUpdateTime(v time.Time) *ImportedFileUpsertOne {
+	return u.Update(func(s *ImportedFileUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ImportedFileUpsertOne) UpdateUpdateTime() *ImportedFileUpsertOne {
+	return u.Update(func(s *ImportedFileUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ImportedFileUpsertOne) ClearUpdateTime() *ImportedFileUpsertOne {
+	return u.Update(func(s *ImportedFileUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ImportedFileUpsertOne) SetDeleteTime(v time.Time) *ImportedFileUpsertOne {
+	return u.Update(func(s *ImportedFileUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ImportedFileUpsertOne) UpdateDeleteTime() *ImportedFileUpsertOne {
+	return u.Update(func(s *ImportedFileUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ImportedFileUpsertOne) ClearDeleteTime() *ImportedFileUpsertOne {
+	return u.Update(func(s *ImportedFileUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetMappingID sets the "mapping_id" field.
+func (u *ImportedFileUpsertOne) SetMappingID(v string) *ImportedFileUpsertOne {
+	return u.Update(func(s *ImportedFileUpsert) {
+		s.SetMappingID(v)
+	})
+}
+
+// UpdateMappingID sets the "mapping_id" field to the value that was provided on create.
+func (u *ImportedFileUpsertOne) UpdateMappingID() *ImportedFileUpsertOne {
+	return u.Update(func(s *ImportedFileUpsert) {
+		s.UpdateMappingID()
+	})
+}
+
+// SetRemoteFileID sets the "remote_file_id" field.
+func (u *ImportedFileUpsertOne) SetRemoteFileID(v string) *ImportedFileUpsertOne {
+	return u.Update(func(s *ImportedFileUpsert) {
+		s.SetRemoteFileID(v)
+	})
+}
+
+// UpdateRemoteFileID sets the "remote_file_id" field to the value that was provided on create.
+func (u *ImportedFileUpsertOne) UpdateRemoteFileID() *ImportedFileUpsertOne {
+	return u.Update(func(s *ImportedFileUpsert) {
+		s.UpdateRemoteFileID()
+	})
+}
+
+// SetRemoteVersion sets the "remote_version" field.
+func (u *ImportedFileUpsertOne) SetRemoteVersion(v string) *ImportedFileUpsertOne {
+	return u.Update(func(s *ImportedFileUpsert) {
+		s.SetRemoteVersion(v)
+	})
+}
+
+// UpdateRemoteVersion sets the "remote_version" field to the value that was provided on create.
+func (u *ImportedFileUpsertOne) UpdateRemoteVersion() *ImportedFileUpsertOne {
+	return u.Update(func(s *ImportedFileUpsert) {
+		s.UpdateRemoteVersion()
+	})
+}
+
+// ClearRemoteVersion clears the value of the "remote_version" field.
+func (u *ImportedFileUpsertOne) ClearRemoteVersion() *ImportedFileUpsertOne {
+	return u.Update(func(s *ImportedFileUpsert) {
+		s.ClearRemoteVersion()
+	})
+}
+
+// SetDocumentID sets the "document_id" field.
+func (u *ImportedFileUpsertOne) SetDocumentID(v string) *ImportedFileUpsertOne {
+	return u.Update(func(s *ImportedFileUpsert) {
+		s.SetDocumentID(v)
+	})
+}
+
+// UpdateDocumentID sets the "document_id" field to the value that was provided on create.
+func (u *ImportedFileUpsertOne) UpdateDocumentID() *ImportedFileUpsertOne {
+	return u.Update(func(s *ImportedFileUpsert) {
+		s.UpdateDocumentID()
+	})
+}
+
+// Exec executes the query.
+func (u *ImportedFileUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ImportedFileCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ImportedFileUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ImportedFileUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ImportedFileUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ImportedFileCreateBulk is the builder for creating many ImportedFile entities in bulk.
+type ImportedFileCreateBulk struct {
+	config
+	err      error
+	builders []*ImportedFileCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ImportedFile entities in the database.
+func (_c *ImportedFileCreateBulk) Save(ctx context.Context) ([]*ImportedFile, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ImportedFile, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ImportedFileMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ImportedFileCreateBulk) SaveX(ctx context.Context) []*ImportedFile {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImportedFileCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImportedFileCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ImportedFile.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ImportedFileUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ImportedFileCreateBulk) OnConflict(opts ...sql.ConflictOption) *ImportedFileUpsertBulk {
+	_c.conflict = opts
+	return &ImportedFileUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ImportedFile.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ImportedFileCreateBulk) OnConflictColumns(columns ...string) *ImportedFileUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ImportedFileUpsertBulk{
+		create: _c,
+	}
+}
+
+// ImportedFileUpsertBulk is the builder for "upsert"-ing
+// a bulk of ImportedFile nodes.
+type ImportedFileUpsertBulk struct {
+	create *ImportedFileCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ImportedFile.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(importedfile.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ImportedFileUpsertBulk) UpdateNewValues() *ImportedFileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(importedfile.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(importedfile.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(importedfile.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ImportedFile.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ImportedFileUpsertBulk) Ignore() *ImportedFileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ImportedFileUpsertBulk) DoNothing() *ImportedFileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ImportedFileCreateBulk.OnConflict
+// documentation for more info.
+func (u *ImportedFileUpsertBulk) Update(set func(*ImportedFileUpsert)) *ImportedFileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ImportedFileUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ImportedFileUpsertBulk) SetUpdateTime(v time.Time) *ImportedFileUpsertBulk {
+	return u.Update(func(s *ImportedFileUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ImportedFileUpsertBulk) UpdateUpdateTime() *ImportedFileUpsertBulk {
+	return u.Update(func(s *ImportedFileUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ImportedFileUpsertBulk) ClearUpdateTime() *ImportedFileUpsertBulk {
+	return u.Update(func(s *ImportedFileUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ImportedFileUpsertBulk) SetDeleteTime(v time.Time) *ImportedFileUpsertBulk {
+	return u.Update(func(s *ImportedFileUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ImportedFileUpsertBulk) UpdateDeleteTime() *ImportedFileUpsertBulk {
+	return u.Update(func(s *ImportedFileUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ImportedFileUpsertBulk) ClearDeleteTime() *ImportedFileUpsertBulk {
+	return u.Update(func(s *ImportedFileUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetMappingID sets the "mapping_id" field.
+func (u *ImportedFileUpsertBulk) SetMappingID(v string) *ImportedFileUpsertBulk {
+	return u.Update(func(s *ImportedFileUpsert) {
+		s.SetMappingID(v)
+	})
+}
+
+// UpdateMappingID sets the "mapping_id" field to the value that was provided on create.
+func (u *ImportedFileUpsertBulk) UpdateMappingID() *ImportedFileUpsertBulk {
+	return u.Update(func(s *ImportedFileUpsert) {
+		s.UpdateMappingID()
+	})
+}
+
+// SetRemoteFileID sets the "remote_file_id" field.
+func (u *ImportedFileUpsertBulk) SetRemoteFileID(v string) *ImportedFileUpsertBulk {
+	return u.Update(func(s *ImportedFileUpsert) {
+		s.SetRemoteFileID(v)
+	})
+}
+
+// UpdateRemoteFileID sets the "remote_file_id" field to the value that was provided on create.
+func (u *ImportedFileUpsertBulk) UpdateRemoteFileID() *ImportedFileUpsertBulk {
+	return u.Update(func(s *ImportedFileUpsert) {
+		s.UpdateRemoteFileID()
+	})
+}
+
+// SetRemoteVersion sets the "remote_version" field.
+func (u *ImportedFileUpsertBulk) SetRemoteVersion(v string) *ImportedFileUpsertBulk {
+	return u.Update(func(s *ImportedFileUpsert) {
+		s.SetRemoteVersion(v)
+	})
+}
+
+// UpdateRemoteVersion sets the "remote_version" field to the value that was provided on create.
+func (u *ImportedFileUpsertBulk) UpdateRemoteVersion() *ImportedFileUpsertBulk {
+	return u.Update(func(s *ImportedFileUpsert) {
+		s.UpdateRemoteVersion()
+	})
+}
+
+// ClearRemoteVersion clears the value of the "remote_version" field.
+func (u *ImportedFileUpsertBulk) ClearRemoteVersion() *ImportedFileUpsertBulk {
+	return u.Update(func(s *ImportedFileUpsert) {
+		s.ClearRemoteVersion()
+	})
+}
+
+// SetDocumentID sets the "document_id" field.
+func (u *ImportedFileUpsertBulk) SetDocumentID(v string) *ImportedFileUpsertBulk {
+	return u.Update(func(s *ImportedFileUpsert) {
+		s.SetDocumentID(v)
+	})
+}
+
+// UpdateDocumentID sets the "document_id" field to the value that was provided on create.
+func (u *ImportedFileUpsertBulk) UpdateDocumentID() *ImportedFileUpsertBulk {
+	return u.Update(func(s *ImportedFileUpsert) {
+		s.UpdateDocumentID()
+	})
+}
+
+// Exec executes the query.
+func (u *ImportedFileUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ImportedFileCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ImportedFileCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ImportedFileUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
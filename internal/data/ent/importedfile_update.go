@@ -0,0 +1,599 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importedfile"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importmapping"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+)
+
+// ImportedFileUpdate is the builder for updating ImportedFile entities.
+type ImportedFileUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ImportedFileMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ImportedFileUpdate builder.
+func (_u *ImportedFileUpdate) Where(ps ...predicate.ImportedFile) *ImportedFileUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ImportedFileUpdate) SetUpdateTime(v time.Time) *ImportedFileUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *ImportedFileUpdate) SetNillableUpdateTime(v *time.Time) *ImportedFileUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *ImportedFileUpdate) ClearUpdateTime() *ImportedFileUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *ImportedFileUpdate) SetDeleteTime(v time.Time) *ImportedFileUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *ImportedFileUpdate) SetNillableDeleteTime(v *time.Time) *ImportedFileUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *ImportedFileUpdate) ClearDeleteTime() *ImportedFileUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetMappingID sets the "mapping_id" field.
+func (_u *ImportedFileUpdate) SetMappingID(v string) *ImportedFileUpdate {
+	_u.mutation.SetMappingID(v)
+	return _u
+}
+
+// SetNillableMappingID sets the "mapping_id" field if the given value is not nil.
+func (_u *ImportedFileUpdate) SetNillableMappingID(v *string) *ImportedFileUpdate {
+	if v != nil {
+		_u.SetMappingID(*v)
+	}
+	return _u
+}
+
+// SetRemoteFileID sets the "remote_file_id" field.
+func (_u *ImportedFileUpdate) SetRemoteFileID(v string) *ImportedFileUpdate {
+	_u.mutation.SetRemoteFileID(v)
+	return _u
+}
+
+// SetNillableRemoteFileID sets the "remote_file_id" field if the given value is not nil.
+func (_u *ImportedFileUpdate) SetNillableRemoteFileID(v *string) *ImportedFileUpdate {
+	if v != nil {
+		_u.SetRemoteFileID(*v)
+	}
+	return _u
+}
+
+// SetRemoteVersion sets the "remote_version" field.
+func (_u *ImportedFileUpdate) SetRemoteVersion(v string) *ImportedFileUpdate {
+	_u.mutation.SetRemoteVersion(v)
+	return _u
+}
+
+// SetNillableRemoteVersion sets the "remote_version" field if the given value is not nil.
+func (_u *ImportedFileUpdate) SetNillableRemoteVersion(v *string) *ImportedFileUpdate {
+	if v != nil {
+		_u.SetRemoteVersion(*v)
+	}
+	return _u
+}
+
+// ClearRemoteVersion clears the value of the "remote_version" field.
+func (_u *ImportedFileUpdate) ClearRemoteVersion() *ImportedFileUpdate {
+	_u.mutation.ClearRemoteVersion()
+	return _u
+}
+
+// SetDocumentID sets the "document_id" field.
+func (_u *ImportedFileUpdate) SetDocumentID(v string) *ImportedFileUpdate {
+	_u.mutation.SetDocumentID(v)
+	return _u
+}
+
+// SetNillableDocumentID sets the "document_id" field if the given value is not nil.
+func (_u *ImportedFileUpdate) SetNillableDocumentID(v *string) *ImportedFileUpdate {
+	if v != nil {
+		_u.SetDocumentID(*v)
+	}
+	return _u
+}
+
+// SetMapping sets the "mapping" edge to the ImportMapping entity.
+func (_u *ImportedFileUpdate) SetMapping(v *ImportMapping) *ImportedFileUpdate {
+	return _u.SetMappingID(v.ID)
+}
+
+// Mutation returns the ImportedFileMutation object of the builder.
+func (_u *ImportedFileUpdate) Mutation() *ImportedFileMutation {
+	return _u.mutation
+}
+
+// ClearMapping clears the "mapping" edge to the ImportMapping entity.
+func (_u *ImportedFileUpdate) ClearMapping() *ImportedFileUpdate {
+	_u.mutation.ClearMapping()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ImportedFileUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImportedFileUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ImportedFileUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImportedFileUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImportedFileUpdate) check() error {
+	if v, ok := _u.mutation.MappingID(); ok {
+		if err := importedfile.MappingIDValidator(v); err != nil {
+			return &ValidationError{Name: "mapping_id", err: fmt.Errorf(`ent: validator failed for field "ImportedFile.mapping_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RemoteFileID(); ok {
+		if err := importedfile.RemoteFileIDValidator(v); err != nil {
+			return &ValidationError{Name: "remote_file_id", err: fmt.Errorf(`ent: validator failed for field "ImportedFile.remote_file_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RemoteVersion(); ok {
+		if err := importedfile.RemoteVersionValidator(v); err != nil {
+			return &ValidationError{Name: "remote_version", err: fmt.Errorf(`ent: validator failed for field "ImportedFile.remote_version": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DocumentID(); ok {
+		if err := importedfile.DocumentIDValidator(v); err != nil {
+			return &ValidationError{Name: "document_id", err: fmt.Errorf(`ent: validator failed for field "ImportedFile.document_id": %w`, err)}
+		}
+	}
+	if _u.mutation.MappingCleared() && len(_u.mutation.MappingIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ImportedFile.mapping"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ImportedFileUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ImportedFileUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ImportedFileUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(importedfile.Table, importedfile.Columns, sqlgraph.NewFieldSpec(importedfile.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(importedfile.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(importedfile.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(importedfile.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(importedfile.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(importedfile.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(importedfile.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.RemoteFileID(); ok {
+		_spec.SetField(importedfile.FieldRemoteFileID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RemoteVersion(); ok {
+		_spec.SetField(importedfile.FieldRemoteVersion, field.TypeString, value)
+	}
+	if _u.mutation.RemoteVersionCleared() {
+		_spec.ClearField(importedfile.FieldRemoteVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.DocumentID(); ok {
+		_spec.SetField(importedfile.FieldDocumentID, field.TypeString, value)
+	}
+	if _u.mutation.MappingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importedfile.MappingTable,
+			Columns: []string{importedfile.MappingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importmapping.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MappingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importedfile.MappingTable,
+			Columns: []string{importedfile.MappingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importmapping.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{importedfile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ImportedFileUpdateOne is the builder for updating a single ImportedFile entity.
+type ImportedFileUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ImportedFileMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ImportedFileUpdateOne) SetUpdateTime(v time.Time) *ImportedFileUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *ImportedFileUpdateOne) SetNillableUpdateTime(v *time.Time) *ImportedFileUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *ImportedFileUpdateOne) ClearUpdateTime() *ImportedFileUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *ImportedFileUpdateOne) SetDeleteTime(v time.Time) *ImportedFileUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *ImportedFileUpdateOne) SetNillableDeleteTime(v *time.Time) *ImportedFileUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *ImportedFileUpdateOne) ClearDeleteTime() *ImportedFileUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetMappingID sets the "mapping_id" field.
+func (_u *ImportedFileUpdateOne) SetMappingID(v string) *ImportedFileUpdateOne {
+	_u.mutation.SetMappingID(v)
+	return _u
+}
+
+// SetNillableMappingID sets the "mapping_id" field if the given value is not nil.
+func (_u *ImportedFileUpdateOne) SetNillableMappingID(v *string) *ImportedFileUpdateOne {
+	if v != nil {
+		_u.SetMappingID(*v)
+	}
+	return _u
+}
+
+// SetRemoteFileID sets the "remote_file_id" field.
+func (_u *ImportedFileUpdateOne) SetRemoteFileID(v string) *ImportedFileUpdateOne {
+	_u.mutation.SetRemoteFileID(v)
+	return _u
+}
+
+// SetNillableRemoteFileID sets the "remote_file_id" field if the given value is not nil.
+func (_u *ImportedFileUpdateOne) SetNillableRemoteFileID(v *string) *ImportedFileUpdateOne {
+	if v != nil {
+		_u.SetRemoteFileID(*v)
+	}
+	return _u
+}
+
+// SetRemoteVersion sets the "remote_version" field.
+func (_u *ImportedFileUpdateOne) SetRemoteVersion(v string) *ImportedFileUpdateOne {
+	_u.mutation.SetRemoteVersion(v)
+	return _u
+}
+
+// SetNillableRemoteVersion sets the "remote_version" field if the given value is not nil.
+func (_u *ImportedFileUpdateOne) SetNillableRemoteVersion(v *string) *ImportedFileUpdateOne {
+	if v != nil {
+		_u.SetRemoteVersion(*v)
+	}
+	return _u
+}
+
+// ClearRemoteVersion clears the value of the "remote_version" field.
+func (_u *ImportedFileUpdateOne) ClearRemoteVersion() *ImportedFileUpdateOne {
+	_u.mutation.ClearRemoteVersion()
+	return _u
+}
+
+// SetDocumentID sets the "document_id" field.
+func (_u *ImportedFileUpdateOne) SetDocumentID(v string) *ImportedFileUpdateOne {
+	_u.mutation.SetDocumentID(v)
+	return _u
+}
+
+// SetNillableDocumentID sets the "document_id" field if the given value is not nil.
+func (_u *ImportedFileUpdateOne) SetNillableDocumentID(v *string) *ImportedFileUpdateOne {
+	if v != nil {
+		_u.SetDocumentID(*v)
+	}
+	return _u
+}
+
+// SetMapping sets the "mapping" edge to the ImportMapping entity.
+func (_u *ImportedFileUpdateOne) SetMapping(v *ImportMapping) *ImportedFileUpdateOne {
+	return _u.SetMappingID(v.ID)
+}
+
+// Mutation returns the ImportedFileMutation object of the builder.
+func (_u *ImportedFileUpdateOne) Mutation() *ImportedFileMutation {
+	return _u.mutation
+}
+
+// ClearMapping clears the "mapping" edge to the ImportMapping entity.
+func (_u *ImportedFileUpdateOne) ClearMapping() *ImportedFileUpdateOne {
+	_u.mutation.ClearMapping()
+	return _u
+}
+
+// Where appends a list predicates to the ImportedFileUpdate builder.
+func (_u *ImportedFileUpdateOne) Where(ps ...predicate.ImportedFile) *ImportedFileUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ImportedFileUpdateOne) Select(field string, fields ...string) *ImportedFileUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ImportedFile entity.
+func (_u *ImportedFileUpdateOne) Save(ctx context.Context) (*ImportedFile, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImportedFileUpdateOne) SaveX(ctx context.Context) *ImportedFile {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ImportedFileUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImportedFileUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImportedFileUpdateOne) check() error {
+	if v, ok := _u.mutation.MappingID(); ok {
+		if err := importedfile.MappingIDValidator(v); err != nil {
+			return &ValidationError{Name: "mapping_id", err: fmt.Errorf(`ent: validator failed for field "ImportedFile.mapping_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RemoteFileID(); ok {
+		if err := importedfile.RemoteFileIDValidator(v); err != nil {
+			return &ValidationError{Name: "remote_file_id", err: fmt.Errorf(`ent: validator failed for field "ImportedFile.remote_file_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RemoteVersion(); ok {
+		if err := importedfile.RemoteVersionValidator(v); err != nil {
+			return &ValidationError{Name: "remote_version", err: fmt.Errorf(`ent: validator failed for field "ImportedFile.remote_version": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DocumentID(); ok {
+		if err := importedfile.DocumentIDValidator(v); err != nil {
+			return &ValidationError{Name: "document_id", err: fmt.Errorf(`ent: validator failed for field "ImportedFile.document_id": %w`, err)}
+		}
+	}
+	if _u.mutation.MappingCleared() && len(_u.mutation.MappingIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ImportedFile.mapping"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ImportedFileUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ImportedFileUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ImportedFileUpdateOne) sqlSave(ctx context.Context) (_node *ImportedFile, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(importedfile.Table, importedfile.Columns, sqlgraph.NewFieldSpec(importedfile.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ImportedFile.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, importedfile.FieldID)
+		for _, f := range fields {
+			if !importedfile.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != importedfile.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(importedfile.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(importedfile.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(importedfile.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(importedfile.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(importedfile.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(importedfile.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.RemoteFileID(); ok {
+		_spec.SetField(importedfile.FieldRemoteFileID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RemoteVersion(); ok {
+		_spec.SetField(importedfile.FieldRemoteVersion, field.TypeString, value)
+	}
+	if _u.mutation.RemoteVersionCleared() {
+		_spec.ClearField(importedfile.FieldRemoteVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.DocumentID(); ok {
+		_spec.SetField(importedfile.FieldDocumentID, field.TypeString, value)
+	}
+	if _u.mutation.MappingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importedfile.MappingTable,
+			Columns: []string{importedfile.MappingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importmapping.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MappingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importedfile.MappingTable,
+			Columns: []string{importedfile.MappingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importmapping.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ImportedFile{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{importedfile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,376 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importconnector"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importmapping"
+)
+
+// ImportMapping is the model entity for the ImportMapping schema.
+type ImportMapping struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID primary key
+	ID string `json:"id,omitempty"`
+	// 创建者ID
+	CreateBy *uint32 `json:"create_by,omitempty"`
+	// 创建时间
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// 更新时间
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// 删除时间
+	DeleteTime *time.Time `json:"delete_time,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// Connector the folder is read through
+	ConnectorID string `json:"connector_id,omitempty"`
+	// ID of the remote folder
+	RemoteFolderID string `json:"remote_folder_id,omitempty"`
+	// Display name of the remote folder
+	RemoteFolderName string `json:"remote_folder_name,omitempty"`
+	// Category receiving the documents, null for root level
+	CategoryID *string `json:"category_id,omitempty"`
+	// Also import the files of subfolders
+	IncludeSubfolders bool `json:"include_subfolders,omitempty"`
+	// Minutes between scheduled syncs, 0 for manual syncs only
+	SyncIntervalMinutes int32 `json:"sync_interval_minutes,omitempty"`
+	// Whether scheduled syncs run
+	Enabled bool `json:"enabled,omitempty"`
+	// When the next sync is due, null when none is scheduled
+	NextSyncAt *time.Time `json:"next_sync_at,omitempty"`
+	// Outcome of the last sync
+	LastSyncStatus importmapping.LastSyncStatus `json:"last_sync_status,omitempty"`
+	// When the last sync finished
+	LastSyncAt *time.Time `json:"last_sync_at,omitempty"`
+	// Why the last sync or one of its files failed
+	LastError string `json:"last_error,omitempty"`
+	// Files imported by the last sync
+	LastImportedCount int32 `json:"last_imported_count,omitempty"`
+	// Files the last sync skipped as already imported
+	LastSkippedCount int32 `json:"last_skipped_count,omitempty"`
+	// Files the last sync failed to import
+	LastFailedCount int32 `json:"last_failed_count,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ImportMappingQuery when eager-loading is set.
+	Edges        ImportMappingEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ImportMappingEdges holds the relations/edges for other nodes in the graph.
+type ImportMappingEdges struct {
+	// Connector holds the value of the connector edge.
+	Connector *ImportConnector `json:"connector,omitempty"`
+	// Files imported through this mapping
+	Files []*ImportedFile `json:"files,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ConnectorOrErr returns the Connector value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ImportMappingEdges) ConnectorOrErr() (*ImportConnector, error) {
+	if e.Connector != nil {
+		return e.Connector, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: importconnector.Label}
+	}
+	return nil, &NotLoadedError{edge: "connector"}
+}
+
+// FilesOrErr returns the Files value or an error if the edge
+// was not loaded in eager-loading.
+func (e ImportMappingEdges) FilesOrErr() ([]*ImportedFile, error) {
+	if e.loadedTypes[1] {
+		return e.Files, nil
+	}
+	return nil, &NotLoadedError{edge: "files"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ImportMapping) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case importmapping.FieldIncludeSubfolders, importmapping.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case importmapping.FieldCreateBy, importmapping.FieldTenantID, importmapping.FieldSyncIntervalMinutes, importmapping.FieldLastImportedCount, importmapping.FieldLastSkippedCount, importmapping.FieldLastFailedCount:
+			values[i] = new(sql.NullInt64)
+		case importmapping.FieldID, importmapping.FieldConnectorID, importmapping.FieldRemoteFolderID, importmapping.FieldRemoteFolderName, importmapping.FieldCategoryID, importmapping.FieldLastSyncStatus, importmapping.FieldLastError:
+			values[i] = new(sql.NullString)
+		case importmapping.FieldCreateTime, importmapping.FieldUpdateTime, importmapping.FieldDeleteTime, importmapping.FieldNextSyncAt, importmapping.FieldLastSyncAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ImportMapping fields.
+func (_m *ImportMapping) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case importmapping.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case importmapping.FieldCreateBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field create_by", values[i])
+			} else if value.Valid {
+				_m.CreateBy = new(uint32)
+				*_m.CreateBy = uint32(value.Int64)
+			}
+		case importmapping.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = new(time.Time)
+				*_m.CreateTime = value.Time
+			}
+		case importmapping.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = new(time.Time)
+				*_m.UpdateTime = value.Time
+			}
+		case importmapping.FieldDeleteTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_time", values[i])
+			} else if value.Valid {
+				_m.DeleteTime = new(time.Time)
+				*_m.DeleteTime = value.Time
+			}
+		case importmapping.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case importmapping.FieldConnectorID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field connector_id", values[i])
+			} else if value.Valid {
+				_m.ConnectorID = value.String
+			}
+		case importmapping.FieldRemoteFolderID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field remote_folder_id", values[i])
+			} else if value.Valid {
+				_m.RemoteFolderID = value.String
+			}
+		case importmapping.FieldRemoteFolderName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field remote_folder_name", values[i])
+			} else if value.Valid {
+				_m.RemoteFolderName = value.String
+			}
+		case importmapping.FieldCategoryID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category_id", values[i])
+			} else if value.Valid {
+				_m.CategoryID = new(string)
+				*_m.CategoryID = value.String
+			}
+		case importmapping.FieldIncludeSubfolders:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field include_subfolders", values[i])
+			} else if value.Valid {
+				_m.IncludeSubfolders = value.Bool
+			}
+		case importmapping.FieldSyncIntervalMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sync_interval_minutes", values[i])
+			} else if value.Valid {
+				_m.SyncIntervalMinutes = int32(value.Int64)
+			}
+		case importmapping.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case importmapping.FieldNextSyncAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_sync_at", values[i])
+			} else if value.Valid {
+				_m.NextSyncAt = new(time.Time)
+				*_m.NextSyncAt = value.Time
+			}
+		case importmapping.FieldLastSyncStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_sync_status", values[i])
+			} else if value.Valid {
+				_m.LastSyncStatus = importmapping.LastSyncStatus(value.String)
+			}
+		case importmapping.FieldLastSyncAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_sync_at", values[i])
+			} else if value.Valid {
+				_m.LastSyncAt = new(time.Time)
+				*_m.LastSyncAt = value.Time
+			}
+		case importmapping.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				_m.LastError = value.String
+			}
+		case importmapping.FieldLastImportedCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_imported_count", values[i])
+			} else if value.Valid {
+				_m.LastImportedCount = int32(value.Int64)
+			}
+		case importmapping.FieldLastSkippedCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_skipped_count", values[i])
+			} else if value.Valid {
+				_m.LastSkippedCount = int32(value.Int64)
+			}
+		case importmapping.FieldLastFailedCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_failed_count", values[i])
+			} else if value.Valid {
+				_m.LastFailedCount = int32(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ImportMapping.
+// This includes values selected through modifiers, order, etc.
+func (_m *ImportMapping) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryConnector queries the "connector" edge of the ImportMapping entity.
+func (_m *ImportMapping) QueryConnector() *ImportConnectorQuery {
+	return NewImportMappingClient(_m.config).QueryConnector(_m)
+}
+
+// QueryFiles queries the "files" edge of the ImportMapping entity.
+func (_m *ImportMapping) QueryFiles() *ImportedFileQuery {
+	return NewImportMappingClient(_m.config).QueryFiles(_m)
+}
+
+// Update returns a builder for updating this ImportMapping.
+// Note that you need to call ImportMapping.Unwrap() before calling this method if this ImportMapping
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ImportMapping) Update() *ImportMappingUpdateOne {
+	return NewImportMappingClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ImportMapping entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ImportMapping) Unwrap() *ImportMapping {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ImportMapping is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ImportMapping) String() string {
+	var builder strings.Builder
+	builder.WriteString("ImportMapping(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreateBy; v != nil {
+		builder.WriteString("create_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeleteTime; v != nil {
+		builder.WriteString("delete_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("connector_id=")
+	builder.WriteString(_m.ConnectorID)
+	builder.WriteString(", ")
+	builder.WriteString("remote_folder_id=")
+	builder.WriteString(_m.RemoteFolderID)
+	builder.WriteString(", ")
+	builder.WriteString("remote_folder_name=")
+	builder.WriteString(_m.RemoteFolderName)
+	builder.WriteString(", ")
+	if v := _m.CategoryID; v != nil {
+		builder.WriteString("category_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("include_subfolders=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IncludeSubfolders))
+	builder.WriteString(", ")
+	builder.WriteString("sync_interval_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SyncIntervalMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	if v := _m.NextSyncAt; v != nil {
+		builder.WriteString("next_sync_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_sync_status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LastSyncStatus))
+	builder.WriteString(", ")
+	if v := _m.LastSyncAt; v != nil {
+		builder.WriteString("last_sync_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(_m.LastError)
+	builder.WriteString(", ")
+	builder.WriteString("last_imported_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LastImportedCount))
+	builder.WriteString(", ")
+	builder.WriteString("last_skipped_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LastSkippedCount))
+	builder.WriteString(", ")
+	builder.WriteString("last_failed_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LastFailedCount))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ImportMappings is a parsable slice of ImportMapping.
+type ImportMappings []*ImportMapping
@@ -0,0 +1,312 @@
+// Code generated by ent, DO NOT EDIT.
+
+package importmapping
+
+import (
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the importmapping type in the database.
+	Label = "import_mapping"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateBy holds the string denoting the create_by field in the database.
+	FieldCreateBy = "create_by"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldDeleteTime holds the string denoting the delete_time field in the database.
+	FieldDeleteTime = "delete_time"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldConnectorID holds the string denoting the connector_id field in the database.
+	FieldConnectorID = "connector_id"
+	// FieldRemoteFolderID holds the string denoting the remote_folder_id field in the database.
+	FieldRemoteFolderID = "remote_folder_id"
+	// FieldRemoteFolderName holds the string denoting the remote_folder_name field in the database.
+	FieldRemoteFolderName = "remote_folder_name"
+	// FieldCategoryID holds the string denoting the category_id field in the database.
+	FieldCategoryID = "category_id"
+	// FieldIncludeSubfolders holds the string denoting the include_subfolders field in the database.
+	FieldIncludeSubfolders = "include_subfolders"
+	// FieldSyncIntervalMinutes holds the string denoting the sync_interval_minutes field in the database.
+	FieldSyncIntervalMinutes = "sync_interval_minutes"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldNextSyncAt holds the string denoting the next_sync_at field in the database.
+	FieldNextSyncAt = "next_sync_at"
+	// FieldLastSyncStatus holds the string denoting the last_sync_status field in the database.
+	FieldLastSyncStatus = "last_sync_status"
+	// FieldLastSyncAt holds the string denoting the last_sync_at field in the database.
+	FieldLastSyncAt = "last_sync_at"
+	// FieldLastError holds the string denoting the last_error field in the database.
+	FieldLastError = "last_error"
+	// FieldLastImportedCount holds the string denoting the last_imported_count field in the database.
+	FieldLastImportedCount = "last_imported_count"
+	// FieldLastSkippedCount holds the string denoting the last_skipped_count field in the database.
+	FieldLastSkippedCount = "last_skipped_count"
+	// FieldLastFailedCount holds the string denoting the last_failed_count field in the database.
+	FieldLastFailedCount = "last_failed_count"
+	// EdgeConnector holds the string denoting the connector edge name in mutations.
+	EdgeConnector = "connector"
+	// EdgeFiles holds the string denoting the files edge name in mutations.
+	EdgeFiles = "files"
+	// Table holds the table name of the importmapping in the database.
+	Table = "paperless_import_mappings"
+	// ConnectorTable is the table that holds the connector relation/edge.
+	ConnectorTable = "paperless_import_mappings"
+	// ConnectorInverseTable is the table name for the ImportConnector entity.
+	// It exists in this package in order to avoid circular dependency with the "importconnector" package.
+	ConnectorInverseTable = "paperless_import_connectors"
+	// ConnectorColumn is the table column denoting the connector relation/edge.
+	ConnectorColumn = "connector_id"
+	// FilesTable is the table that holds the files relation/edge.
+	FilesTable = "paperless_imported_files"
+	// FilesInverseTable is the table name for the ImportedFile entity.
+	// It exists in this package in order to avoid circular dependency with the "importedfile" package.
+	FilesInverseTable = "paperless_imported_files"
+	// FilesColumn is the table column denoting the files relation/edge.
+	FilesColumn = "mapping_id"
+)
+
+// Columns holds all SQL columns for importmapping fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateBy,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldDeleteTime,
+	FieldTenantID,
+	FieldConnectorID,
+	FieldRemoteFolderID,
+	FieldRemoteFolderName,
+	FieldCategoryID,
+	FieldIncludeSubfolders,
+	FieldSyncIntervalMinutes,
+	FieldEnabled,
+	FieldNextSyncAt,
+	FieldLastSyncStatus,
+	FieldLastSyncAt,
+	FieldLastError,
+	FieldLastImportedCount,
+	FieldLastSkippedCount,
+	FieldLastFailedCount,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/go-tangra/go-tangra-paperless/internal/data/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint32
+	// ConnectorIDValidator is a validator for the "connector_id" field. It is called by the builders before save.
+	ConnectorIDValidator func(string) error
+	// RemoteFolderIDValidator is a validator for the "remote_folder_id" field. It is called by the builders before save.
+	RemoteFolderIDValidator func(string) error
+	// RemoteFolderNameValidator is a validator for the "remote_folder_name" field. It is called by the builders before save.
+	RemoteFolderNameValidator func(string) error
+	// DefaultIncludeSubfolders holds the default value on creation for the "include_subfolders" field.
+	DefaultIncludeSubfolders bool
+	// DefaultSyncIntervalMinutes holds the default value on creation for the "sync_interval_minutes" field.
+	DefaultSyncIntervalMinutes int32
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// LastErrorValidator is a validator for the "last_error" field. It is called by the builders before save.
+	LastErrorValidator func(string) error
+	// DefaultLastImportedCount holds the default value on creation for the "last_imported_count" field.
+	DefaultLastImportedCount int32
+	// DefaultLastSkippedCount holds the default value on creation for the "last_skipped_count" field.
+	DefaultLastSkippedCount int32
+	// DefaultLastFailedCount holds the default value on creation for the "last_failed_count" field.
+	DefaultLastFailedCount int32
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// LastSyncStatus defines the type for the "last_sync_status" enum field.
+type LastSyncStatus string
+
+// LastSyncStatusIMPORT_SYNC_STATUS_NEVER is the default value of the LastSyncStatus enum.
+const DefaultLastSyncStatus = LastSyncStatusIMPORT_SYNC_STATUS_NEVER
+
+// LastSyncStatus values.
+const (
+	LastSyncStatusIMPORT_SYNC_STATUS_NEVER     LastSyncStatus = "IMPORT_SYNC_STATUS_NEVER"
+	LastSyncStatusIMPORT_SYNC_STATUS_QUEUED    LastSyncStatus = "IMPORT_SYNC_STATUS_QUEUED"
+	LastSyncStatusIMPORT_SYNC_STATUS_RUNNING   LastSyncStatus = "IMPORT_SYNC_STATUS_RUNNING"
+	LastSyncStatusIMPORT_SYNC_STATUS_SUCCEEDED LastSyncStatus = "IMPORT_SYNC_STATUS_SUCCEEDED"
+	LastSyncStatusIMPORT_SYNC_STATUS_PARTIAL   LastSyncStatus = "IMPORT_SYNC_STATUS_PARTIAL"
+	LastSyncStatusIMPORT_SYNC_STATUS_FAILED    LastSyncStatus = "IMPORT_SYNC_STATUS_FAILED"
+)
+
+func (lss LastSyncStatus) String() string {
+	return string(lss)
+}
+
+// LastSyncStatusValidator is a validator for the "last_sync_status" field enum values. It is called by the builders before save.
+func LastSyncStatusValidator(lss LastSyncStatus) error {
+	switch lss {
+	case LastSyncStatusIMPORT_SYNC_STATUS_NEVER, LastSyncStatusIMPORT_SYNC_STATUS_QUEUED, LastSyncStatusIMPORT_SYNC_STATUS_RUNNING, LastSyncStatusIMPORT_SYNC_STATUS_SUCCEEDED, LastSyncStatusIMPORT_SYNC_STATUS_PARTIAL, LastSyncStatusIMPORT_SYNC_STATUS_FAILED:
+		return nil
+	default:
+		return fmt.Errorf("importmapping: invalid enum value for last_sync_status field: %q", lss)
+	}
+}
+
+// OrderOption defines the ordering options for the ImportMapping queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateBy orders the results by the create_by field.
+func ByCreateBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateBy, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByDeleteTime orders the results by the delete_time field.
+func ByDeleteTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeleteTime, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByConnectorID orders the results by the connector_id field.
+func ByConnectorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConnectorID, opts...).ToFunc()
+}
+
+// ByRemoteFolderID orders the results by the remote_folder_id field.
+func ByRemoteFolderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemoteFolderID, opts...).ToFunc()
+}
+
+// ByRemoteFolderName orders the results by the remote_folder_name field.
+func ByRemoteFolderName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemoteFolderName, opts...).ToFunc()
+}
+
+// ByCategoryID orders the results by the category_id field.
+func ByCategoryID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategoryID, opts...).ToFunc()
+}
+
+// ByIncludeSubfolders orders the results by the include_subfolders field.
+func ByIncludeSubfolders(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIncludeSubfolders, opts...).ToFunc()
+}
+
+// BySyncIntervalMinutes orders the results by the sync_interval_minutes field.
+func BySyncIntervalMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSyncIntervalMinutes, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByNextSyncAt orders the results by the next_sync_at field.
+func ByNextSyncAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextSyncAt, opts...).ToFunc()
+}
+
+// ByLastSyncStatus orders the results by the last_sync_status field.
+func ByLastSyncStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSyncStatus, opts...).ToFunc()
+}
+
+// ByLastSyncAt orders the results by the last_sync_at field.
+func ByLastSyncAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSyncAt, opts...).ToFunc()
+}
+
+// ByLastError orders the results by the last_error field.
+func ByLastError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastError, opts...).ToFunc()
+}
+
+// ByLastImportedCount orders the results by the last_imported_count field.
+func ByLastImportedCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastImportedCount, opts...).ToFunc()
+}
+
+// ByLastSkippedCount orders the results by the last_skipped_count field.
+func ByLastSkippedCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSkippedCount, opts...).ToFunc()
+}
+
+// ByLastFailedCount orders the results by the last_failed_count field.
+func ByLastFailedCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastFailedCount, opts...).ToFunc()
+}
+
+// ByConnectorField orders the results by connector field.
+func ByConnectorField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newConnectorStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByFilesCount orders the results by files count.
+func ByFilesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFilesStep(), opts...)
+	}
+}
+
+// ByFiles orders the results by files terms.
+func ByFiles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFilesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newConnectorStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ConnectorInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ConnectorTable, ConnectorColumn),
+	)
+}
+func newFilesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FilesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, FilesTable, FilesColumn),
+	)
+}
@@ -0,0 +1,1122 @@
+// Code generated by ent, DO NOT EDIT.
+
+package importmapping
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreateBy applies equality check predicate on the "create_by" field. It's identical to CreateByEQ.
+func CreateBy(v uint32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldTenantID, v))
+}
+
+// ConnectorID applies equality check predicate on the "connector_id" field. It's identical to ConnectorIDEQ.
+func ConnectorID(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldConnectorID, v))
+}
+
+// RemoteFolderID applies equality check predicate on the "remote_folder_id" field. It's identical to RemoteFolderIDEQ.
+func RemoteFolderID(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldRemoteFolderID, v))
+}
+
+// RemoteFolderName applies equality check predicate on the "remote_folder_name" field. It's identical to RemoteFolderNameEQ.
+func RemoteFolderName(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldRemoteFolderName, v))
+}
+
+// CategoryID applies equality check predicate on the "category_id" field. It's identical to CategoryIDEQ.
+func CategoryID(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// IncludeSubfolders applies equality check predicate on the "include_subfolders" field. It's identical to IncludeSubfoldersEQ.
+func IncludeSubfolders(v bool) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldIncludeSubfolders, v))
+}
+
+// SyncIntervalMinutes applies equality check predicate on the "sync_interval_minutes" field. It's identical to SyncIntervalMinutesEQ.
+func SyncIntervalMinutes(v int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldSyncIntervalMinutes, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldEnabled, v))
+}
+
+// NextSyncAt applies equality check predicate on the "next_sync_at" field. It's identical to NextSyncAtEQ.
+func NextSyncAt(v time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldNextSyncAt, v))
+}
+
+// LastSyncAt applies equality check predicate on the "last_sync_at" field. It's identical to LastSyncAtEQ.
+func LastSyncAt(v time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldLastSyncAt, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastImportedCount applies equality check predicate on the "last_imported_count" field. It's identical to LastImportedCountEQ.
+func LastImportedCount(v int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldLastImportedCount, v))
+}
+
+// LastSkippedCount applies equality check predicate on the "last_skipped_count" field. It's identical to LastSkippedCountEQ.
+func LastSkippedCount(v int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldLastSkippedCount, v))
+}
+
+// LastFailedCount applies equality check predicate on the "last_failed_count" field. It's identical to LastFailedCountEQ.
+func LastFailedCount(v int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldLastFailedCount, v))
+}
+
+// CreateByEQ applies the EQ predicate on the "create_by" field.
+func CreateByEQ(v uint32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// CreateByNEQ applies the NEQ predicate on the "create_by" field.
+func CreateByNEQ(v uint32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNEQ(FieldCreateBy, v))
+}
+
+// CreateByIn applies the In predicate on the "create_by" field.
+func CreateByIn(vs ...uint32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldIn(FieldCreateBy, vs...))
+}
+
+// CreateByNotIn applies the NotIn predicate on the "create_by" field.
+func CreateByNotIn(vs ...uint32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNotIn(FieldCreateBy, vs...))
+}
+
+// CreateByGT applies the GT predicate on the "create_by" field.
+func CreateByGT(v uint32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldGT(FieldCreateBy, v))
+}
+
+// CreateByGTE applies the GTE predicate on the "create_by" field.
+func CreateByGTE(v uint32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldGTE(FieldCreateBy, v))
+}
+
+// CreateByLT applies the LT predicate on the "create_by" field.
+func CreateByLT(v uint32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldLT(FieldCreateBy, v))
+}
+
+// CreateByLTE applies the LTE predicate on the "create_by" field.
+func CreateByLTE(v uint32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldLTE(FieldCreateBy, v))
+}
+
+// CreateByIsNil applies the IsNil predicate on the "create_by" field.
+func CreateByIsNil() predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldIsNull(FieldCreateBy))
+}
+
+// CreateByNotNil applies the NotNil predicate on the "create_by" field.
+func CreateByNotNil() predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNotNull(FieldCreateBy))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNotNull(FieldTenantID))
+}
+
+// ConnectorIDEQ applies the EQ predicate on the "connector_id" field.
+func ConnectorIDEQ(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldConnectorID, v))
+}
+
+// ConnectorIDNEQ applies the NEQ predicate on the "connector_id" field.
+func ConnectorIDNEQ(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNEQ(FieldConnectorID, v))
+}
+
+// ConnectorIDIn applies the In predicate on the "connector_id" field.
+func ConnectorIDIn(vs ...string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldIn(FieldConnectorID, vs...))
+}
+
+// ConnectorIDNotIn applies the NotIn predicate on the "connector_id" field.
+func ConnectorIDNotIn(vs ...string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNotIn(FieldConnectorID, vs...))
+}
+
+// ConnectorIDGT applies the GT predicate on the "connector_id" field.
+func ConnectorIDGT(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldGT(FieldConnectorID, v))
+}
+
+// ConnectorIDGTE applies the GTE predicate on the "connector_id" field.
+func ConnectorIDGTE(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldGTE(FieldConnectorID, v))
+}
+
+// ConnectorIDLT applies the LT predicate on the "connector_id" field.
+func ConnectorIDLT(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldLT(FieldConnectorID, v))
+}
+
+// ConnectorIDLTE applies the LTE predicate on the "connector_id" field.
+func ConnectorIDLTE(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldLTE(FieldConnectorID, v))
+}
+
+// ConnectorIDContains applies the Contains predicate on the "connector_id" field.
+func ConnectorIDContains(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldContains(FieldConnectorID, v))
+}
+
+// ConnectorIDHasPrefix applies the HasPrefix predicate on the "connector_id" field.
+func ConnectorIDHasPrefix(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldHasPrefix(FieldConnectorID, v))
+}
+
+// ConnectorIDHasSuffix applies the HasSuffix predicate on the "connector_id" field.
+func ConnectorIDHasSuffix(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldHasSuffix(FieldConnectorID, v))
+}
+
+// ConnectorIDEqualFold applies the EqualFold predicate on the "connector_id" field.
+func ConnectorIDEqualFold(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEqualFold(FieldConnectorID, v))
+}
+
+// ConnectorIDContainsFold applies the ContainsFold predicate on the "connector_id" field.
+func ConnectorIDContainsFold(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldContainsFold(FieldConnectorID, v))
+}
+
+// RemoteFolderIDEQ applies the EQ predicate on the "remote_folder_id" field.
+func RemoteFolderIDEQ(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldRemoteFolderID, v))
+}
+
+// RemoteFolderIDNEQ applies the NEQ predicate on the "remote_folder_id" field.
+func RemoteFolderIDNEQ(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNEQ(FieldRemoteFolderID, v))
+}
+
+// RemoteFolderIDIn applies the In predicate on the "remote_folder_id" field.
+func RemoteFolderIDIn(vs ...string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldIn(FieldRemoteFolderID, vs...))
+}
+
+// RemoteFolderIDNotIn applies the NotIn predicate on the "remote_folder_id" field.
+func RemoteFolderIDNotIn(vs ...string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNotIn(FieldRemoteFolderID, vs...))
+}
+
+// RemoteFolderIDGT applies the GT predicate on the "remote_folder_id" field.
+func RemoteFolderIDGT(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldGT(FieldRemoteFolderID, v))
+}
+
+// RemoteFolderIDGTE applies the GTE predicate on the "remote_folder_id" field.
+func RemoteFolderIDGTE(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldGTE(FieldRemoteFolderID, v))
+}
+
+// RemoteFolderIDLT applies the LT predicate on the "remote_folder_id" field.
+func RemoteFolderIDLT(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldLT(FieldRemoteFolderID, v))
+}
+
+// RemoteFolderIDLTE applies the LTE predicate on the "remote_folder_id" field.
+func RemoteFolderIDLTE(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldLTE(FieldRemoteFolderID, v))
+}
+
+// RemoteFolderIDContains applies the Contains predicate on the "remote_folder_id" field.
+func RemoteFolderIDContains(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldContains(FieldRemoteFolderID, v))
+}
+
+// RemoteFolderIDHasPrefix applies the HasPrefix predicate on the "remote_folder_id" field.
+func RemoteFolderIDHasPrefix(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldHasPrefix(FieldRemoteFolderID, v))
+}
+
+// RemoteFolderIDHasSuffix applies the HasSuffix predicate on the "remote_folder_id" field.
+func RemoteFolderIDHasSuffix(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldHasSuffix(FieldRemoteFolderID, v))
+}
+
+// RemoteFolderIDEqualFold applies the EqualFold predicate on the "remote_folder_id" field.
+func RemoteFolderIDEqualFold(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEqualFold(FieldRemoteFolderID, v))
+}
+
+// RemoteFolderIDContainsFold applies the ContainsFold predicate on the "remote_folder_id" field.
+func RemoteFolderIDContainsFold(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldContainsFold(FieldRemoteFolderID, v))
+}
+
+// RemoteFolderNameEQ applies the EQ predicate on the "remote_folder_name" field.
+func RemoteFolderNameEQ(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldRemoteFolderName, v))
+}
+
+// RemoteFolderNameNEQ applies the NEQ predicate on the "remote_folder_name" field.
+func RemoteFolderNameNEQ(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNEQ(FieldRemoteFolderName, v))
+}
+
+// RemoteFolderNameIn applies the In predicate on the "remote_folder_name" field.
+func RemoteFolderNameIn(vs ...string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldIn(FieldRemoteFolderName, vs...))
+}
+
+// RemoteFolderNameNotIn applies the NotIn predicate on the "remote_folder_name" field.
+func RemoteFolderNameNotIn(vs ...string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNotIn(FieldRemoteFolderName, vs...))
+}
+
+// RemoteFolderNameGT applies the GT predicate on the "remote_folder_name" field.
+func RemoteFolderNameGT(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldGT(FieldRemoteFolderName, v))
+}
+
+// RemoteFolderNameGTE applies the GTE predicate on the "remote_folder_name" field.
+func RemoteFolderNameGTE(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldGTE(FieldRemoteFolderName, v))
+}
+
+// RemoteFolderNameLT applies the LT predicate on the "remote_folder_name" field.
+func RemoteFolderNameLT(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldLT(FieldRemoteFolderName, v))
+}
+
+// RemoteFolderNameLTE applies the LTE predicate on the "remote_folder_name" field.
+func RemoteFolderNameLTE(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldLTE(FieldRemoteFolderName, v))
+}
+
+// RemoteFolderNameContains applies the Contains predicate on the "remote_folder_name" field.
+func RemoteFolderNameContains(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldContains(FieldRemoteFolderName, v))
+}
+
+// RemoteFolderNameHasPrefix applies the HasPrefix predicate on the "remote_folder_name" field.
+func RemoteFolderNameHasPrefix(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldHasPrefix(FieldRemoteFolderName, v))
+}
+
+// RemoteFolderNameHasSuffix applies the HasSuffix predicate on the "remote_folder_name" field.
+func RemoteFolderNameHasSuffix(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldHasSuffix(FieldRemoteFolderName, v))
+}
+
+// RemoteFolderNameIsNil applies the IsNil predicate on the "remote_folder_name" field.
+func RemoteFolderNameIsNil() predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldIsNull(FieldRemoteFolderName))
+}
+
+// RemoteFolderNameNotNil applies the NotNil predicate on the "remote_folder_name" field.
+func RemoteFolderNameNotNil() predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNotNull(FieldRemoteFolderName))
+}
+
+// RemoteFolderNameEqualFold applies the EqualFold predicate on the "remote_folder_name" field.
+func RemoteFolderNameEqualFold(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEqualFold(FieldRemoteFolderName, v))
+}
+
+// RemoteFolderNameContainsFold applies the ContainsFold predicate on the "remote_folder_name" field.
+func RemoteFolderNameContainsFold(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldContainsFold(FieldRemoteFolderName, v))
+}
+
+// CategoryIDEQ applies the EQ predicate on the "category_id" field.
+func CategoryIDEQ(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// CategoryIDNEQ applies the NEQ predicate on the "category_id" field.
+func CategoryIDNEQ(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNEQ(FieldCategoryID, v))
+}
+
+// CategoryIDIn applies the In predicate on the "category_id" field.
+func CategoryIDIn(vs ...string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDNotIn applies the NotIn predicate on the "category_id" field.
+func CategoryIDNotIn(vs ...string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNotIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDGT applies the GT predicate on the "category_id" field.
+func CategoryIDGT(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldGT(FieldCategoryID, v))
+}
+
+// CategoryIDGTE applies the GTE predicate on the "category_id" field.
+func CategoryIDGTE(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldGTE(FieldCategoryID, v))
+}
+
+// CategoryIDLT applies the LT predicate on the "category_id" field.
+func CategoryIDLT(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldLT(FieldCategoryID, v))
+}
+
+// CategoryIDLTE applies the LTE predicate on the "category_id" field.
+func CategoryIDLTE(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldLTE(FieldCategoryID, v))
+}
+
+// CategoryIDContains applies the Contains predicate on the "category_id" field.
+func CategoryIDContains(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldContains(FieldCategoryID, v))
+}
+
+// CategoryIDHasPrefix applies the HasPrefix predicate on the "category_id" field.
+func CategoryIDHasPrefix(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldHasPrefix(FieldCategoryID, v))
+}
+
+// CategoryIDHasSuffix applies the HasSuffix predicate on the "category_id" field.
+func CategoryIDHasSuffix(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldHasSuffix(FieldCategoryID, v))
+}
+
+// CategoryIDIsNil applies the IsNil predicate on the "category_id" field.
+func CategoryIDIsNil() predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldIsNull(FieldCategoryID))
+}
+
+// CategoryIDNotNil applies the NotNil predicate on the "category_id" field.
+func CategoryIDNotNil() predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNotNull(FieldCategoryID))
+}
+
+// CategoryIDEqualFold applies the EqualFold predicate on the "category_id" field.
+func CategoryIDEqualFold(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEqualFold(FieldCategoryID, v))
+}
+
+// CategoryIDContainsFold applies the ContainsFold predicate on the "category_id" field.
+func CategoryIDContainsFold(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldContainsFold(FieldCategoryID, v))
+}
+
+// IncludeSubfoldersEQ applies the EQ predicate on the "include_subfolders" field.
+func IncludeSubfoldersEQ(v bool) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldIncludeSubfolders, v))
+}
+
+// IncludeSubfoldersNEQ applies the NEQ predicate on the "include_subfolders" field.
+func IncludeSubfoldersNEQ(v bool) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNEQ(FieldIncludeSubfolders, v))
+}
+
+// SyncIntervalMinutesEQ applies the EQ predicate on the "sync_interval_minutes" field.
+func SyncIntervalMinutesEQ(v int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldSyncIntervalMinutes, v))
+}
+
+// SyncIntervalMinutesNEQ applies the NEQ predicate on the "sync_interval_minutes" field.
+func SyncIntervalMinutesNEQ(v int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNEQ(FieldSyncIntervalMinutes, v))
+}
+
+// SyncIntervalMinutesIn applies the In predicate on the "sync_interval_minutes" field.
+func SyncIntervalMinutesIn(vs ...int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldIn(FieldSyncIntervalMinutes, vs...))
+}
+
+// SyncIntervalMinutesNotIn applies the NotIn predicate on the "sync_interval_minutes" field.
+func SyncIntervalMinutesNotIn(vs ...int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNotIn(FieldSyncIntervalMinutes, vs...))
+}
+
+// SyncIntervalMinutesGT applies the GT predicate on the "sync_interval_minutes" field.
+func SyncIntervalMinutesGT(v int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldGT(FieldSyncIntervalMinutes, v))
+}
+
+// SyncIntervalMinutesGTE applies the GTE predicate on the "sync_interval_minutes" field.
+func SyncIntervalMinutesGTE(v int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldGTE(FieldSyncIntervalMinutes, v))
+}
+
+// SyncIntervalMinutesLT applies the LT predicate on the "sync_interval_minutes" field.
+func SyncIntervalMinutesLT(v int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldLT(FieldSyncIntervalMinutes, v))
+}
+
+// SyncIntervalMinutesLTE applies the LTE predicate on the "sync_interval_minutes" field.
+func SyncIntervalMinutesLTE(v int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldLTE(FieldSyncIntervalMinutes, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// NextSyncAtEQ applies the EQ predicate on the "next_sync_at" field.
+func NextSyncAtEQ(v time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldNextSyncAt, v))
+}
+
+// NextSyncAtNEQ applies the NEQ predicate on the "next_sync_at" field.
+func NextSyncAtNEQ(v time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNEQ(FieldNextSyncAt, v))
+}
+
+// NextSyncAtIn applies the In predicate on the "next_sync_at" field.
+func NextSyncAtIn(vs ...time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldIn(FieldNextSyncAt, vs...))
+}
+
+// NextSyncAtNotIn applies the NotIn predicate on the "next_sync_at" field.
+func NextSyncAtNotIn(vs ...time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNotIn(FieldNextSyncAt, vs...))
+}
+
+// NextSyncAtGT applies the GT predicate on the "next_sync_at" field.
+func NextSyncAtGT(v time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldGT(FieldNextSyncAt, v))
+}
+
+// NextSyncAtGTE applies the GTE predicate on the "next_sync_at" field.
+func NextSyncAtGTE(v time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldGTE(FieldNextSyncAt, v))
+}
+
+// NextSyncAtLT applies the LT predicate on the "next_sync_at" field.
+func NextSyncAtLT(v time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldLT(FieldNextSyncAt, v))
+}
+
+// NextSyncAtLTE applies the LTE predicate on the "next_sync_at" field.
+func NextSyncAtLTE(v time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldLTE(FieldNextSyncAt, v))
+}
+
+// NextSyncAtIsNil applies the IsNil predicate on the "next_sync_at" field.
+func NextSyncAtIsNil() predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldIsNull(FieldNextSyncAt))
+}
+
+// NextSyncAtNotNil applies the NotNil predicate on the "next_sync_at" field.
+func NextSyncAtNotNil() predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNotNull(FieldNextSyncAt))
+}
+
+// LastSyncStatusEQ applies the EQ predicate on the "last_sync_status" field.
+func LastSyncStatusEQ(v LastSyncStatus) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldLastSyncStatus, v))
+}
+
+// LastSyncStatusNEQ applies the NEQ predicate on the "last_sync_status" field.
+func LastSyncStatusNEQ(v LastSyncStatus) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNEQ(FieldLastSyncStatus, v))
+}
+
+// LastSyncStatusIn applies the In predicate on the "last_sync_status" field.
+func LastSyncStatusIn(vs ...LastSyncStatus) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldIn(FieldLastSyncStatus, vs...))
+}
+
+// LastSyncStatusNotIn applies the NotIn predicate on the "last_sync_status" field.
+func LastSyncStatusNotIn(vs ...LastSyncStatus) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNotIn(FieldLastSyncStatus, vs...))
+}
+
+// LastSyncAtEQ applies the EQ predicate on the "last_sync_at" field.
+func LastSyncAtEQ(v time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldLastSyncAt, v))
+}
+
+// LastSyncAtNEQ applies the NEQ predicate on the "last_sync_at" field.
+func LastSyncAtNEQ(v time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNEQ(FieldLastSyncAt, v))
+}
+
+// LastSyncAtIn applies the In predicate on the "last_sync_at" field.
+func LastSyncAtIn(vs ...time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldIn(FieldLastSyncAt, vs...))
+}
+
+// LastSyncAtNotIn applies the NotIn predicate on the "last_sync_at" field.
+func LastSyncAtNotIn(vs ...time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNotIn(FieldLastSyncAt, vs...))
+}
+
+// LastSyncAtGT applies the GT predicate on the "last_sync_at" field.
+func LastSyncAtGT(v time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldGT(FieldLastSyncAt, v))
+}
+
+// LastSyncAtGTE applies the GTE predicate on the "last_sync_at" field.
+func LastSyncAtGTE(v time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldGTE(FieldLastSyncAt, v))
+}
+
+// LastSyncAtLT applies the LT predicate on the "last_sync_at" field.
+func LastSyncAtLT(v time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldLT(FieldLastSyncAt, v))
+}
+
+// LastSyncAtLTE applies the LTE predicate on the "last_sync_at" field.
+func LastSyncAtLTE(v time.Time) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldLTE(FieldLastSyncAt, v))
+}
+
+// LastSyncAtIsNil applies the IsNil predicate on the "last_sync_at" field.
+func LastSyncAtIsNil() predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldIsNull(FieldLastSyncAt))
+}
+
+// LastSyncAtNotNil applies the NotNil predicate on the "last_sync_at" field.
+func LastSyncAtNotNil() predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNotNull(FieldLastSyncAt))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// LastImportedCountEQ applies the EQ predicate on the "last_imported_count" field.
+func LastImportedCountEQ(v int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldLastImportedCount, v))
+}
+
+// LastImportedCountNEQ applies the NEQ predicate on the "last_imported_count" field.
+func LastImportedCountNEQ(v int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNEQ(FieldLastImportedCount, v))
+}
+
+// LastImportedCountIn applies the In predicate on the "last_imported_count" field.
+func LastImportedCountIn(vs ...int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldIn(FieldLastImportedCount, vs...))
+}
+
+// LastImportedCountNotIn applies the NotIn predicate on the "last_imported_count" field.
+func LastImportedCountNotIn(vs ...int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNotIn(FieldLastImportedCount, vs...))
+}
+
+// LastImportedCountGT applies the GT predicate on the "last_imported_count" field.
+func LastImportedCountGT(v int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldGT(FieldLastImportedCount, v))
+}
+
+// LastImportedCountGTE applies the GTE predicate on the "last_imported_count" field.
+func LastImportedCountGTE(v int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldGTE(FieldLastImportedCount, v))
+}
+
+// LastImportedCountLT applies the LT predicate on the "last_imported_count" field.
+func LastImportedCountLT(v int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldLT(FieldLastImportedCount, v))
+}
+
+// LastImportedCountLTE applies the LTE predicate on the "last_imported_count" field.
+func LastImportedCountLTE(v int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldLTE(FieldLastImportedCount, v))
+}
+
+// LastSkippedCountEQ applies the EQ predicate on the "last_skipped_count" field.
+func LastSkippedCountEQ(v int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldLastSkippedCount, v))
+}
+
+// LastSkippedCountNEQ applies the NEQ predicate on the "last_skipped_count" field.
+func LastSkippedCountNEQ(v int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNEQ(FieldLastSkippedCount, v))
+}
+
+// LastSkippedCountIn applies the In predicate on the "last_skipped_count" field.
+func LastSkippedCountIn(vs ...int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldIn(FieldLastSkippedCount, vs...))
+}
+
+// LastSkippedCountNotIn applies the NotIn predicate on the "last_skipped_count" field.
+func LastSkippedCountNotIn(vs ...int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNotIn(FieldLastSkippedCount, vs...))
+}
+
+// LastSkippedCountGT applies the GT predicate on the "last_skipped_count" field.
+func LastSkippedCountGT(v int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldGT(FieldLastSkippedCount, v))
+}
+
+// LastSkippedCountGTE applies the GTE predicate on the "last_skipped_count" field.
+func LastSkippedCountGTE(v int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldGTE(FieldLastSkippedCount, v))
+}
+
+// LastSkippedCountLT applies the LT predicate on the "last_skipped_count" field.
+func LastSkippedCountLT(v int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldLT(FieldLastSkippedCount, v))
+}
+
+// LastSkippedCountLTE applies the LTE predicate on the "last_skipped_count" field.
+func LastSkippedCountLTE(v int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldLTE(FieldLastSkippedCount, v))
+}
+
+// LastFailedCountEQ applies the EQ predicate on the "last_failed_count" field.
+func LastFailedCountEQ(v int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldEQ(FieldLastFailedCount, v))
+}
+
+// LastFailedCountNEQ applies the NEQ predicate on the "last_failed_count" field.
+func LastFailedCountNEQ(v int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNEQ(FieldLastFailedCount, v))
+}
+
+// LastFailedCountIn applies the In predicate on the "last_failed_count" field.
+func LastFailedCountIn(vs ...int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldIn(FieldLastFailedCount, vs...))
+}
+
+// LastFailedCountNotIn applies the NotIn predicate on the "last_failed_count" field.
+func LastFailedCountNotIn(vs ...int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldNotIn(FieldLastFailedCount, vs...))
+}
+
+// LastFailedCountGT applies the GT predicate on the "last_failed_count" field.
+func LastFailedCountGT(v int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldGT(FieldLastFailedCount, v))
+}
+
+// LastFailedCountGTE applies the GTE predicate on the "last_failed_count" field.
+func LastFailedCountGTE(v int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldGTE(FieldLastFailedCount, v))
+}
+
+// LastFailedCountLT applies the LT predicate on the "last_failed_count" field.
+func LastFailedCountLT(v int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldLT(FieldLastFailedCount, v))
+}
+
+// LastFailedCountLTE applies the LTE predicate on the "last_failed_count" field.
+func LastFailedCountLTE(v int32) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.FieldLTE(FieldLastFailedCount, v))
+}
+
+// HasConnector applies the HasEdge predicate on the "connector" edge.
+func HasConnector() predicate.ImportMapping {
+	return predicate.ImportMapping(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ConnectorTable, ConnectorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasConnectorWith applies the HasEdge predicate on the "connector" edge with a given conditions (other predicates).
+func HasConnectorWith(preds ...predicate.ImportConnector) predicate.ImportMapping {
+	return predicate.ImportMapping(func(s *sql.Selector) {
+		step := newConnectorStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFiles applies the HasEdge predicate on the "files" edge.
+func HasFiles() predicate.ImportMapping {
+	return predicate.ImportMapping(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, FilesTable, FilesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFilesWith applies the HasEdge predicate on the "files" edge with a given conditions (other predicates).
+func HasFilesWith(preds ...predicate.ImportedFile) predicate.ImportMapping {
+	return predicate.ImportMapping(func(s *sql.Selector) {
+		step := newFilesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ImportMapping) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ImportMapping) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ImportMapping) predicate.ImportMapping {
+	return predicate.ImportMapping(sql.NotPredicates(p))
+}
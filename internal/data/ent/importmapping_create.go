@@ -0,0 +1,1867 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importconnector"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importedfile"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importmapping"
+)
+
+// ImportMappingCreate is the builder for creating a ImportMapping entity.
+type ImportMappingCreate struct {
+	config
+	mutation *ImportMappingMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_c *ImportMappingCreate) SetCreateBy(v uint32) *ImportMappingCreate {
+	_c.mutation.SetCreateBy(v)
+	return _c
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_c *ImportMappingCreate) SetNillableCreateBy(v *uint32) *ImportMappingCreate {
+	if v != nil {
+		_c.SetCreateBy(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *ImportMappingCreate) SetCreateTime(v time.Time) *ImportMappingCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *ImportMappingCreate) SetNillableCreateTime(v *time.Time) *ImportMappingCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *ImportMappingCreate) SetUpdateTime(v time.Time) *ImportMappingCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *ImportMappingCreate) SetNillableUpdateTime(v *time.Time) *ImportMappingCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *ImportMappingCreate) SetDeleteTime(v time.Time) *ImportMappingCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *ImportMappingCreate) SetNillableDeleteTime(v *time.Time) *ImportMappingCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ImportMappingCreate) SetTenantID(v uint32) *ImportMappingCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *ImportMappingCreate) SetNillableTenantID(v *uint32) *ImportMappingCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetConnectorID sets the "connector_id" field.
+func (_c *ImportMappingCreate) SetConnectorID(v string) *ImportMappingCreate {
+	_c.mutation.SetConnectorID(v)
+	return _c
+}
+
+// SetRemoteFolderID sets the "remote_folder_id" field.
+func (_c *ImportMappingCreate) SetRemoteFolderID(v string) *ImportMappingCreate {
+	_c.mutation.SetRemoteFolderID(v)
+	return _c
+}
+
+// SetRemoteFolderName sets the "remote_folder_name" field.
+func (_c *ImportMappingCreate) SetRemoteFolderName(v string) *ImportMappingCreate {
+	_c.mutation.SetRemoteFolderName(v)
+	return _c
+}
+
+// SetNillableRemoteFolderName sets the "remote_folder_name" field if the given value is not nil.
+func (_c *ImportMappingCreate) SetNillableRemoteFolderName(v *string) *ImportMappingCreate {
+	if v != nil {
+		_c.SetRemoteFolderName(*v)
+	}
+	return _c
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_c *ImportMappingCreate) SetCategoryID(v string) *ImportMappingCreate {
+	_c.mutation.SetCategoryID(v)
+	return _c
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_c *ImportMappingCreate) SetNillableCategoryID(v *string) *ImportMappingCreate {
+	if v != nil {
+		_c.SetCategoryID(*v)
+	}
+	return _c
+}
+
+// SetIncludeSubfolders sets the "include_subfolders" field.
+func (_c *ImportMappingCreate) SetIncludeSubfolders(v bool) *ImportMappingCreate {
+	_c.mutation.SetIncludeSubfolders(v)
+	return _c
+}
+
+// SetNillableIncludeSubfolders sets the "include_subfolders" field if the given value is not nil.
+func (_c *ImportMappingCreate) SetNillableIncludeSubfolders(v *bool) *ImportMappingCreate {
+	if v != nil {
+		_c.SetIncludeSubfolders(*v)
+	}
+	return _c
+}
+
+// SetSyncIntervalMinutes sets the "sync_interval_minutes" field.
+func (_c *ImportMappingCreate) SetSyncIntervalMinutes(v int32) *ImportMappingCreate {
+	_c.mutation.SetSyncIntervalMinutes(v)
+	return _c
+}
+
+// SetNillableSyncIntervalMinutes sets the "sync_interval_minutes" field if the given value is not nil.
+func (_c *ImportMappingCreate) SetNillableSyncIntervalMinutes(v *int32) *ImportMappingCreate {
+	if v != nil {
+		_c.SetSyncIntervalMinutes(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *ImportMappingCreate) SetEnabled(v bool) *ImportMappingCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *ImportMappingCreate) SetNillableEnabled(v *bool) *ImportMappingCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetNextSyncAt sets the "next_sync_at" field.
+func (_c *ImportMappingCreate) SetNextSyncAt(v time.Time) *ImportMappingCreate {
+	_c.mutation.SetNextSyncAt(v)
+	return _c
+}
+
+// SetNillableNextSyncAt sets the "next_sync_at" field if the given value is not nil.
+func (_c *ImportMappingCreate) SetNillableNextSyncAt(v *time.Time) *ImportMappingCreate {
+	if v != nil {
+		_c.SetNextSyncAt(*v)
+	}
+	return _c
+}
+
+// SetLastSyncStatus sets the "last_sync_status" field.
+func (_c *ImportMappingCreate) SetLastSyncStatus(v importmapping.LastSyncStatus) *ImportMappingCreate {
+	_c.mutation.SetLastSyncStatus(v)
+	return _c
+}
+
+// SetNillableLastSyncStatus sets the "last_sync_status" field if the given value is not nil.
+func (_c *ImportMappingCreate) SetNillableLastSyncStatus(v *importmapping.LastSyncStatus) *ImportMappingCreate {
+	if v != nil {
+		_c.SetLastSyncStatus(*v)
+	}
+	return _c
+}
+
+// SetLastSyncAt sets the "last_sync_at" field.
+func (_c *ImportMappingCreate) SetLastSyncAt(v time.Time) *ImportMappingCreate {
+	_c.mutation.SetLastSyncAt(v)
+	return _c
+}
+
+// SetNillableLastSyncAt sets the "last_sync_at" field if the given value is not nil.
+func (_c *ImportMappingCreate) SetNillableLastSyncAt(v *time.Time) *ImportMappingCreate {
+	if v != nil {
+		_c.SetLastSyncAt(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *ImportMappingCreate) SetLastError(v string) *ImportMappingCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *ImportMappingCreate) SetNillableLastError(v *string) *ImportMappingCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetLastImportedCount sets the "last_imported_count" field.
+func (_c *ImportMappingCreate) SetLastImportedCount(v int32) *ImportMappingCreate {
+	_c.mutation.SetLastImportedCount(v)
+	return _c
+}
+
+// SetNillableLastImportedCount sets the "last_imported_count" field if the given value is not nil.
+func (_c *ImportMappingCreate) SetNillableLastImportedCount(v *int32) *ImportMappingCreate {
+	if v != nil {
+		_c.SetLastImportedCount(*v)
+	}
+	return _c
+}
+
+// SetLastSkippedCount sets the "last_skipped_count" field.
+func (_c *ImportMappingCreate) SetLastSkippedCount(v int32) *ImportMappingCreate {
+	_c.mutation.SetLastSkippedCount(v)
+	return _c
+}
+
+// SetNillableLastSkippedCount sets the "last_skipped_count" field if the given value is not nil.
+func (_c *ImportMappingCreate) SetNillableLastSkippedCount(v *int32) *ImportMappingCreate {
+	if v != nil {
+		_c.SetLastSkippedCount(*v)
+	}
+	return _c
+}
+
+// SetLastFailedCount sets the "last_failed_count" field.
+func (_c *ImportMappingCreate) SetLastFailedCount(v int32) *ImportMappingCreate {
+	_c.mutation.SetLastFailedCount(v)
+	return _c
+}
+
+// SetNillableLastFailedCount sets the "last_failed_count" field if the given value is not nil.
+func (_c *ImportMappingCreate) SetNillableLastFailedCount(v *int32) *ImportMappingCreate {
+	if v != nil {
+		_c.SetLastFailedCount(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ImportMappingCreate) SetID(v string) *ImportMappingCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetConnector sets the "connector" edge to the ImportConnector entity.
+func (_c *ImportMappingCreate) SetConnector(v *ImportConnector) *ImportMappingCreate {
+	return _c.SetConnectorID(v.ID)
+}
+
+// AddFileIDs adds the "files" edge to the ImportedFile entity by IDs.
+func (_c *ImportMappingCreate) AddFileIDs(ids ...uint32) *ImportMappingCreate {
+	_c.mutation.AddFileIDs(ids...)
+	return _c
+}
+
+// AddFiles adds the "files" edges to the ImportedFile entity.
+func (_c *ImportMappingCreate) AddFiles(v ...*ImportedFile) *ImportMappingCreate {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddFileIDs(ids...)
+}
+
+// Mutation returns the ImportMappingMutation object of the builder.
+func (_c *ImportMappingCreate) Mutation() *ImportMappingMutation {
+	return _c.mutation
+}
+
+// Save creates the ImportMapping in the database.
+func (_c *ImportMappingCreate) Save(ctx context.Context) (*ImportMapping, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ImportMappingCreate) SaveX(ctx context.Context) *ImportMapping {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImportMappingCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImportMappingCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ImportMappingCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := importmapping.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.IncludeSubfolders(); !ok {
+		v := importmapping.DefaultIncludeSubfolders
+		_c.mutation.SetIncludeSubfolders(v)
+	}
+	if _, ok := _c.mutation.SyncIntervalMinutes(); !ok {
+		v := importmapping.DefaultSyncIntervalMinutes
+		_c.mutation.SetSyncIntervalMinutes(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := importmapping.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.LastSyncStatus(); !ok {
+		v := importmapping.DefaultLastSyncStatus
+		_c.mutation.SetLastSyncStatus(v)
+	}
+	if _, ok := _c.mutation.LastImportedCount(); !ok {
+		v := importmapping.DefaultLastImportedCount
+		_c.mutation.SetLastImportedCount(v)
+	}
+	if _, ok := _c.mutation.LastSkippedCount(); !ok {
+		v := importmapping.DefaultLastSkippedCount
+		_c.mutation.SetLastSkippedCount(v)
+	}
+	if _, ok := _c.mutation.LastFailedCount(); !ok {
+		v := importmapping.DefaultLastFailedCount
+		_c.mutation.SetLastFailedCount(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ImportMappingCreate) check() error {
+	if _, ok := _c.mutation.ConnectorID(); !ok {
+		return &ValidationError{Name: "connector_id", err: errors.New(`ent: missing required field "ImportMapping.connector_id"`)}
+	}
+	if v, ok := _c.mutation.ConnectorID(); ok {
+		if err := importmapping.ConnectorIDValidator(v); err != nil {
+			return &ValidationError{Name: "connector_id", err: fmt.Errorf(`ent: validator failed for field "ImportMapping.connector_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RemoteFolderID(); !ok {
+		return &ValidationError{Name: "remote_folder_id", err: errors.New(`ent: missing required field "ImportMapping.remote_folder_id"`)}
+	}
+	if v, ok := _c.mutation.RemoteFolderID(); ok {
+		if err := importmapping.RemoteFolderIDValidator(v); err != nil {
+			return &ValidationError{Name: "remote_folder_id", err: fmt.Errorf(`ent: validator failed for field "ImportMapping.remote_folder_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.RemoteFolderName(); ok {
+		if err := importmapping.RemoteFolderNameValidator(v); err != nil {
+			return &ValidationError{Name: "remote_folder_name", err: fmt.Errorf(`ent: validator failed for field "ImportMapping.remote_folder_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IncludeSubfolders(); !ok {
+		return &ValidationError{Name: "include_subfolders", err: errors.New(`ent: missing required field "ImportMapping.include_subfolders"`)}
+	}
+	if _, ok := _c.mutation.SyncIntervalMinutes(); !ok {
+		return &ValidationError{Name: "sync_interval_minutes", err: errors.New(`ent: missing required field "ImportMapping.sync_interval_minutes"`)}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "ImportMapping.enabled"`)}
+	}
+	if _, ok := _c.mutation.LastSyncStatus(); !ok {
+		return &ValidationError{Name: "last_sync_status", err: errors.New(`ent: missing required field "ImportMapping.last_sync_status"`)}
+	}
+	if v, ok := _c.mutation.LastSyncStatus(); ok {
+		if err := importmapping.LastSyncStatusValidator(v); err != nil {
+			return &ValidationError{Name: "last_sync_status", err: fmt.Errorf(`ent: validator failed for field "ImportMapping.last_sync_status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.LastError(); ok {
+		if err := importmapping.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`ent: validator failed for field "ImportMapping.last_error": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LastImportedCount(); !ok {
+		return &ValidationError{Name: "last_imported_count", err: errors.New(`ent: missing required field "ImportMapping.last_imported_count"`)}
+	}
+	if _, ok := _c.mutation.LastSkippedCount(); !ok {
+		return &ValidationError{Name: "last_skipped_count", err: errors.New(`ent: missing required field "ImportMapping.last_skipped_count"`)}
+	}
+	if _, ok := _c.mutation.LastFailedCount(); !ok {
+		return &ValidationError{Name: "last_failed_count", err: errors.New(`ent: missing required field "ImportMapping.last_failed_count"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := importmapping.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "ImportMapping.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.ConnectorIDs()) == 0 {
+		return &ValidationError{Name: "connector", err: errors.New(`ent: missing required edge "ImportMapping.connector"`)}
+	}
+	return nil
+}
+
+func (_c *ImportMappingCreate) sqlSave(ctx context.Context) (*ImportMapping, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ImportMapping.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ImportMappingCreate) createSpec() (*ImportMapping, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ImportMapping{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(importmapping.Table, sqlgraph.NewFieldSpec(importmapping.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateBy(); ok {
+		_spec.SetField(importmapping.FieldCreateBy, field.TypeUint32, value)
+		_node.CreateBy = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(importmapping.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(importmapping.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(importmapping.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(importmapping.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.RemoteFolderID(); ok {
+		_spec.SetField(importmapping.FieldRemoteFolderID, field.TypeString, value)
+		_node.RemoteFolderID = value
+	}
+	if value, ok := _c.mutation.RemoteFolderName(); ok {
+		_spec.SetField(importmapping.FieldRemoteFolderName, field.TypeString, value)
+		_node.RemoteFolderName = value
+	}
+	if value, ok := _c.mutation.CategoryID(); ok {
+		_spec.SetField(importmapping.FieldCategoryID, field.TypeString, value)
+		_node.CategoryID = &value
+	}
+	if value, ok := _c.mutation.IncludeSubfolders(); ok {
+		_spec.SetField(importmapping.FieldIncludeSubfolders, field.TypeBool, value)
+		_node.IncludeSubfolders = value
+	}
+	if value, ok := _c.mutation.SyncIntervalMinutes(); ok {
+		_spec.SetField(importmapping.FieldSyncIntervalMinutes, field.TypeInt32, value)
+		_node.SyncIntervalMinutes = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(importmapping.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.NextSyncAt(); ok {
+		_spec.SetField(importmapping.FieldNextSyncAt, field.TypeTime, value)
+		_node.NextSyncAt = &value
+	}
+	if value, ok := _c.mutation.LastSyncStatus(); ok {
+		_spec.SetField(importmapping.FieldLastSyncStatus, field.TypeEnum, value)
+		_node.LastSyncStatus = value
+	}
+	if value, ok := _c.mutation.LastSyncAt(); ok {
+		_spec.SetField(importmapping.FieldLastSyncAt, field.TypeTime, value)
+		_node.LastSyncAt = &value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(importmapping.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := _c.mutation.LastImportedCount(); ok {
+		_spec.SetField(importmapping.FieldLastImportedCount, field.TypeInt32, value)
+		_node.LastImportedCount = value
+	}
+	if value, ok := _c.mutation.LastSkippedCount(); ok {
+		_spec.SetField(importmapping.FieldLastSkippedCount, field.TypeInt32, value)
+		_node.LastSkippedCount = value
+	}
+	if value, ok := _c.mutation.LastFailedCount(); ok {
+		_spec.SetField(importmapping.FieldLastFailedCount, field.TypeInt32, value)
+		_node.LastFailedCount = value
+	}
+	if nodes := _c.mutation.ConnectorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importmapping.ConnectorTable,
+			Columns: []string{importmapping.ConnectorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importconnector.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ConnectorID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.FilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   importmapping.FilesTable,
+			Columns: []string{importmapping.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importedfile.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ImportMapping.Create().
+//		SetCreateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ImportMappingUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ImportMappingCreate) OnConflict(opts ...sql.ConflictOption) *ImportMappingUpsertOne {
+	_c.conflict = opts
+	return &ImportMappingUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ImportMapping.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ImportMappingCreate) OnConflictColumns(columns ...string) *ImportMappingUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ImportMappingUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ImportMappingUpsertOne is the builder for "upsert"-ing
+	//  one ImportMapping node.
+	ImportMappingUpsertOne struct {
+		create *ImportMappingCreate
+	}
+
+	// ImportMappingUpsert is the "OnConflict" setter.
+	ImportMappingUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateBy sets the "create_by" field.
+func (u *ImportMappingUpsert) SetCreateBy(v uint32) *ImportMappingUpsert {
+	u.Set(importmapping.FieldCreateBy, v)
+	return u
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *ImportMappingUpsert) UpdateCreateBy() *ImportMappingUpsert {
+	u.SetExcluded(importmapping.FieldCreateBy)
+	return u
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *ImportMappingUpsert) AddCreateBy(v uint32) *ImportMappingUpsert {
+	u.Add(importmapping.FieldCreateBy, v)
+	return u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *ImportMappingUpsert) ClearCreateBy() *ImportMappingUpsert {
+	u.SetNull(importmapping.FieldCreateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ImportMappingUpsert) SetUpdateTime(v time.Time) *ImportMappingUpsert {
+	u.Set(importmapping.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ImportMappingUpsert) UpdateUpdateTime() *ImportMappingUpsert {
+	u.SetExcluded(importmapping.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ImportMappingUpsert) ClearUpdateTime() *ImportMappingUpsert {
+	u.SetNull(importmapping.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ImportMappingUpsert) SetDeleteTime(v time.Time) *ImportMappingUpsert {
+	u.Set(importmapping.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ImportMappingUpsert) UpdateDeleteTime() *ImportMappingUpsert {
+	u.SetExcluded(importmapping.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ImportMappingUpsert) ClearDeleteTime() *ImportMappingUpsert {
+	u.SetNull(importmapping.FieldDeleteTime)
+	return u
+}
+
+// SetConnectorID sets the "connector_id" field.
+func (u *ImportMappingUpsert) SetConnectorID(v string) *ImportMappingUpsert {
+	u.Set(importmapping.FieldConnectorID, v)
+	return u
+}
+
+// UpdateConnectorID sets the "connector_id" field to the value that was provided on create.
+func (u *ImportMappingUpsert) UpdateConnectorID() *ImportMappingUpsert {
+	u.SetExcluded(importmapping.FieldConnectorID)
+	return u
+}
+
+// SetRemoteFolderID sets the "remote_folder_id" field.
+func (u *ImportMappingUpsert) SetRemoteFolderID(v string) *ImportMappingUpsert {
+	u.Set(importmapping.FieldRemoteFolderID, v)
+	return u
+}
+
+// UpdateRemoteFolderID sets the "remote_folder_id" field to the value that was provided on create.
+func (u *ImportMappingUpsert) UpdateRemoteFolderID() *ImportMappingUpsert {
+	u.SetExcluded(importmapping.FieldRemoteFolderID)
+	return u
+}
+
+// SetRemoteFolderName sets the "remote_folder_name" field.
+func (u *ImportMappingUpsert) SetRemoteFolderName(v string) *ImportMappingUpsert {
+	u.Set(importmapping.FieldRemoteFolderName, v)
+	return u
+}
+
+// UpdateRemoteFolderName sets the "remote_folder_name" field to the value that was provided on create.
+func (u *ImportMappingUpsert) UpdateRemoteFolderName() *ImportMappingUpsert {
+	u.SetExcluded(importmapping.FieldRemoteFolderName)
+	return u
+}
+
+// ClearRemoteFolderName clears the value of the "remote_folder_name" field.
+func (u *ImportMappingUpsert) ClearRemoteFolderName() *ImportMappingUpsert {
+	u.SetNull(importmapping.FieldRemoteFolderName)
+	return u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *ImportMappingUpsert) SetCategoryID(v string) *ImportMappingUpsert {
+	u.Set(importmapping.FieldCategoryID, v)
+	return u
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *ImportMappingUpsert) UpdateCategoryID() *ImportMappingUpsert {
+	u.SetExcluded(importmapping.FieldCategoryID)
+	return u
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (u *ImportMappingUpsert) ClearCategoryID() *ImportMappingUpsert {
+	u.SetNull(importmapping.FieldCategoryID)
+	return u
+}
+
+// SetIncludeSubfolders sets the "include_subfolders" field.
+func (u *ImportMappingUpsert) SetIncludeSubfolders(v bool) *ImportMappingUpsert {
+	u.Set(importmapping.FieldIncludeSubfolders, v)
+	return u
+}
+
+// UpdateIncludeSubfolders sets the "include_subfolders" field to the value that was provided on create.
+func (u *ImportMappingUpsert) UpdateIncludeSubfolders() *ImportMappingUpsert {
+	u.SetExcluded(importmapping.FieldIncludeSubfolders)
+	return u
+}
+
+// SetSyncIntervalMinutes sets the "sync_interval_minutes" field.
+func (u *ImportMappingUpsert) SetSyncIntervalMinutes(v int32) *ImportMappingUpsert {
+	u.Set(importmapping.FieldSyncIntervalMinutes, v)
+	return u
+}
+
+// UpdateSyncIntervalMinutes sets the "sync_interval_minutes" field to the value that was provided on create.
+func (u *ImportMappingUpsert) UpdateSyncIntervalMinutes() *ImportMappingUpsert {
+	u.SetExcluded(importmapping.FieldSyncIntervalMinutes)
+	return u
+}
+
+// AddSyncIntervalMinutes adds v to the "sync_interval_minutes" field.
+func (u *ImportMappingUpsert) AddSyncIntervalMinutes(v int32) *ImportMappingUpsert {
+	u.Add(importmapping.FieldSyncIntervalMinutes, v)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ImportMappingUpsert) SetEnabled(v bool) *ImportMappingUpsert {
+	u.Set(importmapping.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ImportMappingUpsert) UpdateEnabled() *ImportMappingUpsert {
+	u.SetExcluded(importmapping.FieldEnabled)
+	return u
+}
+
+// SetNextSyncAt sets the "next_sync_at" field.
+func (u *ImportMappingUpsert) SetNextSyncAt(v time.Time) *ImportMappingUpsert {
+	u.Set(importmapping.FieldNextSyncAt, v)
+	return u
+}
+
+// UpdateNextSyncAt sets the "next_sync_at" field to the value that was provided on create.
+func (u *ImportMappingUpsert) UpdateNextSyncAt() *ImportMappingUpsert {
+	u.SetExcluded(importmapping.FieldNextSyncAt)
+	return u
+}
+
+// ClearNextSyncAt clears the value of the "next_sync_at" field.
+func (u *ImportMappingUpsert) ClearNextSyncAt() *ImportMappingUpsert {
+	u.SetNull(importmapping.FieldNextSyncAt)
+	return u
+}
+
+// SetLastSyncStatus sets the "last_sync_status" field.
+func (u *ImportMappingUpsert) SetLastSyncStatus(v importmapping.LastSyncStatus) *ImportMappingUpsert {
+	u.Set(importmapping.FieldLastSyncStatus, v)
+	return u
+}
+
+// UpdateLastSyncStatus sets the "last_sync_status" field to the value that was provided on create.
+func (u *ImportMappingUpsert) UpdateLastSyncStatus() *ImportMappingUpsert {
+	u.SetExcluded(importmapping.FieldLastSyncStatus)
+	return u
+}
+
+// SetLastSyncAt sets the "last_sync_at" field.
+func (u *ImportMappingUpsert) SetLastSyncAt(v time.Time) *ImportMappingUpsert {
+	u.Set(importmapping.FieldLastSyncAt, v)
+	return u
+}
+
+// UpdateLastSyncAt sets the "last_sync_at" field to the value that was provided on create.
+func (u *ImportMappingUpsert) UpdateLastSyncAt() *ImportMappingUpsert {
+	u.SetExcluded(importmapping.FieldLastSyncAt)
+	return u
+}
+
+// ClearLastSyncAt clears the value of the "last_sync_at" field.
+func (u *ImportMappingUpsert) ClearLastSyncAt() *ImportMappingUpsert {
+	u.SetNull(importmapping.FieldLastSyncAt)
+	return u
+}
+
+// SetLastError sets the "last_error" field.
+func (u *ImportMappingUpsert) SetLastError(v string) *ImportMappingUpsert {
+	u.Set(importmapping.FieldLastError, v)
+	return u
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *ImportMappingUpsert) UpdateLastError() *ImportMappingUpsert {
+	u.SetExcluded(importmapping.FieldLastError)
+	return u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *ImportMappingUpsert) ClearLastError() *ImportMappingUpsert {
+	u.SetNull(importmapping.FieldLastError)
+	return u
+}
+
+// SetLastImportedCount sets the "last_imported_count" field.
+func (u *ImportMappingUpsert) SetLastImportedCount(v int32) *ImportMappingUpsert {
+	u.Set(importmapping.FieldLastImportedCount, v)
+	return u
+}
+
+// UpdateLastImportedCount sets the "last_imported_count" field to the value that was provided on create.
+func (u *ImportMappingUpsert) UpdateLastImportedCount() *ImportMappingUpsert {
+	u.SetExcluded(importmapping.FieldLastImportedCount)
+	return u
+}
+
+// AddLastImportedCount adds v to the "last_imported_count" field.
+func (u *ImportMappingUpsert) AddLastImportedCount(v int32) *ImportMappingUpsert {
+	u.Add(importmapping.FieldLastImportedCount, v)
+	return u
+}
+
+// SetLastSkippedCount sets the "last_skipped_count" field.
+func (u *ImportMappingUpsert) SetLastSkippedCount(v int32) *ImportMappingUpsert {
+	u.Set(importmapping.FieldLastSkippedCount, v)
+	return u
+}
+
+// UpdateLastSkippedCount sets the "last_skipped_count" field to the value that was provided on create.
+func (u *ImportMappingUpsert) UpdateLastSkippedCount() *ImportMappingUpsert {
+	u.SetExcluded(importmapping.FieldLastSkippedCount)
+	return u
+}
+
+// AddLastSkippedCount adds v to the "last_skipped_count" field.
+func (u *ImportMappingUpsert) AddLastSkippedCount(v int32) *ImportMappingUpsert {
+	u.Add(importmapping.FieldLastSkippedCount, v)
+	return u
+}
+
+// SetLastFailedCount sets the "last_failed_count" field.
+func (u *ImportMappingUpsert) SetLastFailedCount(v int32) *ImportMappingUpsert {
+	u.Set(importmapping.FieldLastFailedCount, v)
+	return u
+}
+
+// UpdateLastFailedCount sets the "last_failed_count" field to the value that was provided on create.
+func (u *ImportMappingUpsert) UpdateLastFailedCount() *ImportMappingUpsert {
+	u.SetExcluded(importmapping.FieldLastFailedCount)
+	return u
+}
+
+// AddLastFailedCount adds v to the "last_failed_count" field.
+func (u *ImportMappingUpsert) AddLastFailedCount(v int32) *ImportMappingUpsert {
+	u.Add(importmapping.FieldLastFailedCount, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ImportMapping.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(importmapping.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ImportMappingUpsertOne) UpdateNewValues() *ImportMappingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(importmapping.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(importmapping.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(importmapping.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ImportMapping.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ImportMappingUpsertOne) Ignore() *ImportMappingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ImportMappingUpsertOne) DoNothing() *ImportMappingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ImportMappingCreate.OnConflict
+// documentation for more info.
+func (u *ImportMappingUpsertOne) Update(set func(*ImportMappingUpsert)) *ImportMappingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ImportMappingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *ImportMappingUpsertOne) SetCreateBy(v uint32) *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *ImportMappingUpsertOne) AddCreateBy(v uint32) *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *ImportMappingUpsertOne) UpdateCreateBy() *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *ImportMappingUpsertOne) ClearCreateBy() *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ImportMappingUpsertOne) SetUpdateTime(v time.Time) *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ImportMappingUpsertOne) UpdateUpdateTime() *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ImportMappingUpsertOne) ClearUpdateTime() *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ImportMappingUpsertOne) SetDeleteTime(v time.Time) *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ImportMappingUpsertOne) UpdateDeleteTime() *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ImportMappingUpsertOne) ClearDeleteTime() *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetConnectorID sets the "connector_id" field.
+func (u *ImportMappingUpsertOne) SetConnectorID(v string) *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.SetConnectorID(v)
+	})
+}
+
+// UpdateConnectorID sets the "connector_id" field to the value that was provided on create.
+func (u *ImportMappingUpsertOne) UpdateConnectorID() *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.UpdateConnectorID()
+	})
+}
+
+// SetRemoteFolderID sets the "remote_folder_id" field.
+func (u *ImportMappingUpsertOne) SetRemoteFolderID(v string) *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.SetRemoteFolderID(v)
+	})
+}
+
+// UpdateRemoteFolderID sets the "remote_folder_id" field to the value that was provided on create.
+func (u *ImportMappingUpsertOne) UpdateRemoteFolderID() *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.UpdateRemoteFolderID()
+	})
+}
+
+// SetRemoteFolderName sets the "remote_folder_name" field.
+func (u *ImportMappingUpsertOne) SetRemoteFolderName(v string) *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.SetRemoteFolderName(v)
+	})
+}
+
+// UpdateRemoteFolderName sets the "remote_folder_name" field to the value that was provided on create.
+func (u *ImportMappingUpsertOne) UpdateRemoteFolderName() *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.UpdateRemoteFolderName()
+	})
+}
+
+// ClearRemoteFolderName clears the value of the "remote_folder_name" field.
+func (u *ImportMappingUpsertOne) ClearRemoteFolderName() *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.ClearRemoteFolderName()
+	})
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *ImportMappingUpsertOne) SetCategoryID(v string) *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.SetCategoryID(v)
+	})
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *ImportMappingUpsertOne) UpdateCategoryID() *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.UpdateCategoryID()
+	})
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (u *ImportMappingUpsertOne) ClearCategoryID() *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.ClearCategoryID()
+	})
+}
+
+// SetIncludeSubfolders sets the "include_subfolders" field.
+func (u *ImportMappingUpsertOne) SetIncludeSubfolders(v bool) *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.SetIncludeSubfolders(v)
+	})
+}
+
+// UpdateIncludeSubfolders sets the "include_subfolders" field to the value that was provided on create.
+func (u *ImportMappingUpsertOne) UpdateIncludeSubfolders() *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.UpdateIncludeSubfolders()
+	})
+}
+
+// SetSyncIntervalMinutes sets the "sync_interval_minutes" field.
+func (u *ImportMappingUpsertOne) SetSyncIntervalMinutes(v int32) *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.SetSyncIntervalMinutes(v)
+	})
+}
+
+// AddSyncIntervalMinutes adds v to the "sync_interval_minutes" field.
+func (u *ImportMappingUpsertOne) AddSyncIntervalMinutes(v int32) *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.AddSyncIntervalMinutes(v)
+	})
+}
+
+// UpdateSyncIntervalMinutes sets the "sync_interval_minutes" field to the value that was provided on create.
+func (u *ImportMappingUpsertOne) UpdateSyncIntervalMinutes() *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.UpdateSyncIntervalMinutes()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ImportMappingUpsertOne) SetEnabled(v bool) *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ImportMappingUpsertOne) UpdateEnabled() *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetNextSyncAt sets the "next_sync_at" field.
+func (u *ImportMappingUpsertOne) SetNextSyncAt(v time.Time) *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.SetNextSyncAt(v)
+	})
+}
+
+// UpdateNextSyncAt sets the "next_sync_at" field to the value that was provided on create.
+func (u *ImportMappingUpsertOne) UpdateNextSyncAt() *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.UpdateNextSyncAt()
+	})
+}
+
+// ClearNextSyncAt clears the value of the "next_sync_at" field.
+func (u *ImportMappingUpsertOne) ClearNextSyncAt() *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.ClearNextSyncAt()
+	})
+}
+
+// SetLastSyncStatus sets the "last_sync_status" field.
+func (u *ImportMappingUpsertOne) SetLastSyncStatus(v importmapping.LastSyncStatus) *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.SetLastSyncStatus(v)
+	})
+}
+
+// UpdateLastSyncStatus sets the "last_sync_status" field to the value that was provided on create.
+func (u *ImportMappingUpsertOne) UpdateLastSyncStatus() *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.UpdateLastSyncStatus()
+	})
+}
+
+// SetLastSyncAt sets the "last_sync_at" field.
+func (u *ImportMappingUpsertOne) SetLastSyncAt(v time.Time) *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.SetLastSyncAt(v)
+	})
+}
+
+// UpdateLastSyncAt sets the "last_sync_at" field to the value that was provided on create.
+func (u *ImportMappingUpsertOne) UpdateLastSyncAt() *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.UpdateLastSyncAt()
+	})
+}
+
+// ClearLastSyncAt clears the value of the "last_sync_at" field.
+func (u *ImportMappingUpsertOne) ClearLastSyncAt() *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.ClearLastSyncAt()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *ImportMappingUpsertOne) SetLastError(v string) *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *ImportMappingUpsertOne) UpdateLastError() *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *ImportMappingUpsertOne) ClearLastError() *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// SetLastImportedCount sets the "last_imported_count" field.
+func (u *ImportMappingUpsertOne) SetLastImportedCount(v int32) *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.SetLastImportedCount(v)
+	})
+}
+
+// AddLastImportedCount adds v to the "last_imported_count" field.
+func (u *ImportMappingUpsertOne) AddLastImportedCount(v int32) *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.AddLastImportedCount(v)
+	})
+}
+
+// UpdateLastImportedCount sets the "last_imported_count" field to the value that was provided on create.
+func (u *ImportMappingUpsertOne) UpdateLastImportedCount() *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.UpdateLastImportedCount()
+	})
+}
+
+// SetLastSkippedCount sets the "last_skipped_count" field.
+func (u *ImportMappingUpsertOne) SetLastSkippedCount(v int32) *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.SetLastSkippedCount(v)
+	})
+}
+
+// AddLastSkippedCount adds v to the "last_skipped_count" field.
+func (u *ImportMappingUpsertOne) AddLastSkippedCount(v int32) *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.AddLastSkippedCount(v)
+	})
+}
+
+// UpdateLastSkippedCount sets the "last_skipped_count" field to the value that was provided on create.
+func (u *ImportMappingUpsertOne) UpdateLastSkippedCount() *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.UpdateLastSkippedCount()
+	})
+}
+
+// SetLastFailedCount sets the "last_failed_count" field.
+func (u *ImportMappingUpsertOne) SetLastFailedCount(v int32) *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.SetLastFailedCount(v)
+	})
+}
+
+// AddLastFailedCount adds v to the "last_failed_count" field.
+func (u *ImportMappingUpsertOne) AddLastFailedCount(v int32) *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.AddLastFailedCount(v)
+	})
+}
+
+// UpdateLastFailedCount sets the "last_failed_count" field to the value that was provided on create.
+func (u *ImportMappingUpsertOne) UpdateLastFailedCount() *ImportMappingUpsertOne {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.UpdateLastFailedCount()
+	})
+}
+
+// Exec executes the query.
+func (u *ImportMappingUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ImportMappingCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ImportMappingUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ImportMappingUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ImportMappingUpsertOne.ID is not supported by MySQL driver. Use ImportMappingUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ImportMappingUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ImportMappingCreateBulk is the builder for creating many ImportMapping entities in bulk.
+type ImportMappingCreateBulk struct {
+	config
+	err      error
+	builders []*ImportMappingCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ImportMapping entities in the database.
+func (_c *ImportMappingCreateBulk) Save(ctx context.Context) ([]*ImportMapping, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ImportMapping, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ImportMappingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ImportMappingCreateBulk) SaveX(ctx context.Context) []*ImportMapping {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImportMappingCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImportMappingCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ImportMapping.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ImportMappingUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ImportMappingCreateBulk) OnConflict(opts ...sql.ConflictOption) *ImportMappingUpsertBulk {
+	_c.conflict = opts
+	return &ImportMappingUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ImportMapping.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ImportMappingCreateBulk) OnConflictColumns(columns ...string) *ImportMappingUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ImportMappingUpsertBulk{
+		create: _c,
+	}
+}
+
+// ImportMappingUpsertBulk is the builder for "upsert"-ing
+// a bulk of ImportMapping nodes.
+type ImportMappingUpsertBulk struct {
+	create *ImportMappingCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ImportMapping.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(importmapping.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ImportMappingUpsertBulk) UpdateNewValues() *ImportMappingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(importmapping.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(importmapping.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(importmapping.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ImportMapping.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ImportMappingUpsertBulk) Ignore() *ImportMappingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ImportMappingUpsertBulk) DoNothing() *ImportMappingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ImportMappingCreateBulk.OnConflict
+// documentation for more info.
+func (u *ImportMappingUpsertBulk) Update(set func(*ImportMappingUpsert)) *ImportMappingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ImportMappingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *ImportMappingUpsertBulk) SetCreateBy(v uint32) *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *ImportMappingUpsertBulk) AddCreateBy(v uint32) *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *ImportMappingUpsertBulk) UpdateCreateBy() *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *ImportMappingUpsertBulk) ClearCreateBy() *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ImportMappingUpsertBulk) SetUpdateTime(v time.Time) *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ImportMappingUpsertBulk) UpdateUpdateTime() *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ImportMappingUpsertBulk) ClearUpdateTime() *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ImportMappingUpsertBulk) SetDeleteTime(v time.Time) *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ImportMappingUpsertBulk) UpdateDeleteTime() *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ImportMappingUpsertBulk) ClearDeleteTime() *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetConnectorID sets the "connector_id" field.
+func (u *ImportMappingUpsertBulk) SetConnectorID(v string) *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.SetConnectorID(v)
+	})
+}
+
+// UpdateConnectorID sets the "connector_id" field to the value that was provided on create.
+func (u *ImportMappingUpsertBulk) UpdateConnectorID() *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.UpdateConnectorID()
+	})
+}
+
+// SetRemoteFolderID sets the "remote_folder_id" field.
+func (u *ImportMappingUpsertBulk) SetRemoteFolderID(v string) *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.SetRemoteFolderID(v)
+	})
+}
+
+// UpdateRemoteFolderID sets the "remote_folder_id" field to the value that was provided on create.
+func (u *ImportMappingUpsertBulk) UpdateRemoteFolderID() *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.UpdateRemoteFolderID()
+	})
+}
+
+// SetRemoteFolderName sets the "remote_folder_name" field.
+func (u *ImportMappingUpsertBulk) SetRemoteFolderName(v string) *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.SetRemoteFolderName(v)
+	})
+}
+
+// UpdateRemoteFolderName sets the "remote_folder_name" field to the value that was provided on create.
+func (u *ImportMappingUpsertBulk) UpdateRemoteFolderName() *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.UpdateRemoteFolderName()
+	})
+}
+
+// ClearRemoteFolderName clears the value of the "remote_folder_name" field.
+func (u *ImportMappingUpsertBulk) ClearRemoteFolderName() *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.ClearRemoteFolderName()
+	})
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *ImportMappingUpsertBulk) SetCategoryID(v string) *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.SetCategoryID(v)
+	})
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *ImportMappingUpsertBulk) UpdateCategoryID() *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.UpdateCategoryID()
+	})
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (u *ImportMappingUpsertBulk) ClearCategoryID() *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.ClearCategoryID()
+	})
+}
+
+// SetIncludeSubfolders sets the "include_subfolders" field.
+func (u *ImportMappingUpsertBulk) SetIncludeSubfolders(v bool) *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.SetIncludeSubfolders(v)
+	})
+}
+
+// UpdateIncludeSubfolders sets the "include_subfolders" field to the value that was provided on create.
+func (u *ImportMappingUpsertBulk) UpdateIncludeSubfolders() *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.UpdateIncludeSubfolders()
+	})
+}
+
+// SetSyncIntervalMinutes sets the "sync_interval_minutes" field.
+func (u *ImportMappingUpsertBulk) SetSyncIntervalMinutes(v int32) *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.SetSyncIntervalMinutes(v)
+	})
+}
+
+// AddSyncIntervalMinutes adds v to the "sync_interval_minutes" field.
+func (u *ImportMappingUpsertBulk) AddSyncIntervalMinutes(v int32) *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.AddSyncIntervalMinutes(v)
+	})
+}
+
+// UpdateSyncIntervalMinutes sets the "sync_interval_minutes" field to the value that was provided on create.
+func (u *ImportMappingUpsertBulk) UpdateSyncIntervalMinutes() *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.UpdateSyncIntervalMinutes()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ImportMappingUpsertBulk) SetEnabled(v bool) *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ImportMappingUpsertBulk) UpdateEnabled() *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetNextSyncAt sets the "next_sync_at" field.
+func (u *ImportMappingUpsertBulk) SetNextSyncAt(v time.Time) *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.SetNextSyncAt(v)
+	})
+}
+
+// UpdateNextSyncAt sets the "next_sync_at" field to the value that was provided on create.
+func (u *ImportMappingUpsertBulk) UpdateNextSyncAt() *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.UpdateNextSyncAt()
+	})
+}
+
+// ClearNextSyncAt clears the value of the "next_sync_at" field.
+func (u *ImportMappingUpsertBulk) ClearNextSyncAt() *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.ClearNextSyncAt()
+	})
+}
+
+// SetLastSyncStatus sets the "last_sync_status" field.
+func (u *ImportMappingUpsertBulk) SetLastSyncStatus(v importmapping.LastSyncStatus) *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.SetLastSyncStatus(v)
+	})
+}
+
+// UpdateLastSyncStatus sets the "last_sync_status" field to the value that was provided on create.
+func (u *ImportMappingUpsertBulk) UpdateLastSyncStatus() *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.UpdateLastSyncStatus()
+	})
+}
+
+// SetLastSyncAt sets the "last_sync_at" field.
+func (u *ImportMappingUpsertBulk) SetLastSyncAt(v time.Time) *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.SetLastSyncAt(v)
+	})
+}
+
+// UpdateLastSyncAt sets the "last_sync_at" field to the value that was provided on create.
+func (u *ImportMappingUpsertBulk) UpdateLastSyncAt() *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.UpdateLastSyncAt()
+	})
+}
+
+// ClearLastSyncAt clears the value of the "last_sync_at" field.
+func (u *ImportMappingUpsertBulk) ClearLastSyncAt() *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.ClearLastSyncAt()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *ImportMappingUpsertBulk) SetLastError(v string) *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *ImportMappingUpsertBulk) UpdateLastError() *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *ImportMappingUpsertBulk) ClearLastError() *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// SetLastImportedCount sets the "last_imported_count" field.
+func (u *ImportMappingUpsertBulk) SetLastImportedCount(v int32) *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.SetLastImportedCount(v)
+	})
+}
+
+// AddLastImportedCount adds v to the "last_imported_count" field.
+func (u *ImportMappingUpsertBulk) AddLastImportedCount(v int32) *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.AddLastImportedCount(v)
+	})
+}
+
+// UpdateLastImportedCount sets the "last_imported_count" field to the value that was provided on create.
+func (u *ImportMappingUpsertBulk) UpdateLastImportedCount() *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.UpdateLastImportedCount()
+	})
+}
+
+// SetLastSkippedCount sets the "last_skipped_count" field.
+func (u *ImportMappingUpsertBulk) SetLastSkippedCount(v int32) *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.SetLastSkippedCount(v)
+	})
+}
+
+// AddLastSkippedCount adds v to the "last_skipped_count" field.
+func (u *ImportMappingUpsertBulk) AddLastSkippedCount(v int32) *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.AddLastSkippedCount(v)
+	})
+}
+
+// UpdateLastSkippedCount sets the "last_skipped_count" field to the value that was provided on create.
+func (u *ImportMappingUpsertBulk) UpdateLastSkippedCount() *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.UpdateLastSkippedCount()
+	})
+}
+
+// SetLastFailedCount sets the "last_failed_count" field.
+func (u *ImportMappingUpsertBulk) SetLastFailedCount(v int32) *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.SetLastFailedCount(v)
+	})
+}
+
+// AddLastFailedCount adds v to the "last_failed_count" field.
+func (u *ImportMappingUpsertBulk) AddLastFailedCount(v int32) *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.AddLastFailedCount(v)
+	})
+}
+
+// UpdateLastFailedCount sets the "last_failed_count" field to the value that was provided on create.
+func (u *ImportMappingUpsertBulk) UpdateLastFailedCount() *ImportMappingUpsertBulk {
+	return u.Update(func(s *ImportMappingUpsert) {
+		s.UpdateLastFailedCount()
+	})
+}
+
+// Exec executes the query.
+func (u *ImportMappingUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ImportMappingCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ImportMappingCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ImportMappingUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1336 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importconnector"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importedfile"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importmapping"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+)
+
+// ImportMappingUpdate is the builder for updating ImportMapping entities.
+type ImportMappingUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ImportMappingMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ImportMappingUpdate builder.
+func (_u *ImportMappingUpdate) Where(ps ...predicate.ImportMapping) *ImportMappingUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *ImportMappingUpdate) SetCreateBy(v uint32) *ImportMappingUpdate {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *ImportMappingUpdate) SetNillableCreateBy(v *uint32) *ImportMappingUpdate {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *ImportMappingUpdate) AddCreateBy(v int32) *ImportMappingUpdate {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *ImportMappingUpdate) ClearCreateBy() *ImportMappingUpdate {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ImportMappingUpdate) SetUpdateTime(v time.Time) *ImportMappingUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *ImportMappingUpdate) SetNillableUpdateTime(v *time.Time) *ImportMappingUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *ImportMappingUpdate) ClearUpdateTime() *ImportMappingUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *ImportMappingUpdate) SetDeleteTime(v time.Time) *ImportMappingUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *ImportMappingUpdate) SetNillableDeleteTime(v *time.Time) *ImportMappingUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *ImportMappingUpdate) ClearDeleteTime() *ImportMappingUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetConnectorID sets the "connector_id" field.
+func (_u *ImportMappingUpdate) SetConnectorID(v string) *ImportMappingUpdate {
+	_u.mutation.SetConnectorID(v)
+	return _u
+}
+
+// SetNillableConnectorID sets the "connector_id" field if the given value is not nil.
+func (_u *ImportMappingUpdate) SetNillableConnectorID(v *string) *ImportMappingUpdate {
+	if v != nil {
+		_u.SetConnectorID(*v)
+	}
+	return _u
+}
+
+// SetRemoteFolderID sets the "remote_folder_id" field.
+func (_u *ImportMappingUpdate) SetRemoteFolderID(v string) *ImportMappingUpdate {
+	_u.mutation.SetRemoteFolderID(v)
+	return _u
+}
+
+// SetNillableRemoteFolderID sets the "remote_folder_id" field if the given value is not nil.
+func (_u *ImportMappingUpdate) SetNillableRemoteFolderID(v *string) *ImportMappingUpdate {
+	if v != nil {
+		_u.SetRemoteFolderID(*v)
+	}
+	return _u
+}
+
+// SetRemoteFolderName sets the "remote_folder_name" field.
+func (_u *ImportMappingUpdate) SetRemoteFolderName(v string) *ImportMappingUpdate {
+	_u.mutation.SetRemoteFolderName(v)
+	return _u
+}
+
+// SetNillableRemoteFolderName sets the "remote_folder_name" field if the given value is not nil.
+func (_u *ImportMappingUpdate) SetNillableRemoteFolderName(v *string) *ImportMappingUpdate {
+	if v != nil {
+		_u.SetRemoteFolderName(*v)
+	}
+	return _u
+}
+
+// ClearRemoteFolderName clears the value of the "remote_folder_name" field.
+func (_u *ImportMappingUpdate) ClearRemoteFolderName() *ImportMappingUpdate {
+	_u.mutation.ClearRemoteFolderName()
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *ImportMappingUpdate) SetCategoryID(v string) *ImportMappingUpdate {
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *ImportMappingUpdate) SetNillableCategoryID(v *string) *ImportMappingUpdate {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (_u *ImportMappingUpdate) ClearCategoryID() *ImportMappingUpdate {
+	_u.mutation.ClearCategoryID()
+	return _u
+}
+
+// SetIncludeSubfolders sets the "include_subfolders" field.
+func (_u *ImportMappingUpdate) SetIncludeSubfolders(v bool) *ImportMappingUpdate {
+	_u.mutation.SetIncludeSubfolders(v)
+	return _u
+}
+
+// SetNillableIncludeSubfolders sets the "include_subfolders" field if the given value is not nil.
+func (_u *ImportMappingUpdate) SetNillableIncludeSubfolders(v *bool) *ImportMappingUpdate {
+	if v != nil {
+		_u.SetIncludeSubfolders(*v)
+	}
+	return _u
+}
+
+// SetSyncIntervalMinutes sets the "sync_interval_minutes" field.
+func (_u *ImportMappingUpdate) SetSyncIntervalMinutes(v int32) *ImportMappingUpdate {
+	_u.mutation.ResetSyncIntervalMinutes()
+	_u.mutation.SetSyncIntervalMinutes(v)
+	return _u
+}
+
+// SetNillableSyncIntervalMinutes sets the "sync_interval_minutes" field if the given value is not nil.
+func (_u *ImportMappingUpdate) SetNillableSyncIntervalMinutes(v *int32) *ImportMappingUpdate {
+	if v != nil {
+		_u.SetSyncIntervalMinutes(*v)
+	}
+	return _u
+}
+
+// AddSyncIntervalMinutes adds value to the "sync_interval_minutes" field.
+func (_u *ImportMappingUpdate) AddSyncIntervalMinutes(v int32) *ImportMappingUpdate {
+	_u.mutation.AddSyncIntervalMinutes(v)
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *ImportMappingUpdate) SetEnabled(v bool) *ImportMappingUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *ImportMappingUpdate) SetNillableEnabled(v *bool) *ImportMappingUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetNextSyncAt sets the "next_sync_at" field.
+func (_u *ImportMappingUpdate) SetNextSyncAt(v time.Time) *ImportMappingUpdate {
+	_u.mutation.SetNextSyncAt(v)
+	return _u
+}
+
+// SetNillableNextSyncAt sets the "next_sync_at" field if the given value is not nil.
+func (_u *ImportMappingUpdate) SetNillableNextSyncAt(v *time.Time) *ImportMappingUpdate {
+	if v != nil {
+		_u.SetNextSyncAt(*v)
+	}
+	return _u
+}
+
+// ClearNextSyncAt clears the value of the "next_sync_at" field.
+func (_u *ImportMappingUpdate) ClearNextSyncAt() *ImportMappingUpdate {
+	_u.mutation.ClearNextSyncAt()
+	return _u
+}
+
+// SetLastSyncStatus sets the "last_sync_status" field.
+func (_u *ImportMappingUpdate) SetLastSyncStatus(v importmapping.LastSyncStatus) *ImportMappingUpdate {
+	_u.mutation.SetLastSyncStatus(v)
+	return _u
+}
+
+// SetNillableLastSyncStatus sets the "last_sync_status" field if the given value is not nil.
+func (_u *ImportMappingUpdate) SetNillableLastSyncStatus(v *importmapping.LastSyncStatus) *ImportMappingUpdate {
+	if v != nil {
+		_u.SetLastSyncStatus(*v)
+	}
+	return _u
+}
+
+// SetLastSyncAt sets the "last_sync_at" field.
+func (_u *ImportMappingUpdate) SetLastSyncAt(v time.Time) *ImportMappingUpdate {
+	_u.mutation.SetLastSyncAt(v)
+	return _u
+}
+
+// SetNillableLastSyncAt sets the "last_sync_at" field if the given value is not nil.
+func (_u *ImportMappingUpdate) SetNillableLastSyncAt(v *time.Time) *ImportMappingUpdate {
+	if v != nil {
+		_u.SetLastSyncAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSyncAt clears the value of the "last_sync_at" field.
+func (_u *ImportMappingUpdate) ClearLastSyncAt() *ImportMappingUpdate {
+	_u.mutation.ClearLastSyncAt()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *ImportMappingUpdate) SetLastError(v string) *ImportMappingUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *ImportMappingUpdate) SetNillableLastError(v *string) *ImportMappingUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *ImportMappingUpdate) ClearLastError() *ImportMappingUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetLastImportedCount sets the "last_imported_count" field.
+func (_u *ImportMappingUpdate) SetLastImportedCount(v int32) *ImportMappingUpdate {
+	_u.mutation.ResetLastImportedCount()
+	_u.mutation.SetLastImportedCount(v)
+	return _u
+}
+
+// SetNillableLastImportedCount sets the "last_imported_count" field if the given value is not nil.
+func (_u *ImportMappingUpdate) SetNillableLastImportedCount(v *int32) *ImportMappingUpdate {
+	if v != nil {
+		_u.SetLastImportedCount(*v)
+	}
+	return _u
+}
+
+// AddLastImportedCount adds value to the "last_imported_count" field.
+func (_u *ImportMappingUpdate) AddLastImportedCount(v int32) *ImportMappingUpdate {
+	_u.mutation.AddLastImportedCount(v)
+	return _u
+}
+
+// SetLastSkippedCount sets the "last_skipped_count" field.
+func (_u *ImportMappingUpdate) SetLastSkippedCount(v int32) *ImportMappingUpdate {
+	_u.mutation.ResetLastSkippedCount()
+	_u.mutation.SetLastSkippedCount(v)
+	return _u
+}
+
+// SetNillableLastSkippedCount sets the "last_skipped_count" field if the given value is not nil.
+func (_u *ImportMappingUpdate) SetNillableLastSkippedCount(v *int32) *ImportMappingUpdate {
+	if v != nil {
+		_u.SetLastSkippedCount(*v)
+	}
+	return _u
+}
+
+// AddLastSkippedCount adds value to the "last_skipped_count" field.
+func (_u *ImportMappingUpdate) AddLastSkippedCount(v int32) *ImportMappingUpdate {
+	_u.mutation.AddLastSkippedCount(v)
+	return _u
+}
+
+// SetLastFailedCount sets the "last_failed_count" field.
+func (_u *ImportMappingUpdate) SetLastFailedCount(v int32) *ImportMappingUpdate {
+	_u.mutation.ResetLastFailedCount()
+	_u.mutation.SetLastFailedCount(v)
+	return _u
+}
+
+// SetNillableLastFailedCount sets the "last_failed_count" field if the given value is not nil.
+func (_u *ImportMappingUpdate) SetNillableLastFailedCount(v *int32) *ImportMappingUpdate {
+	if v != nil {
+		_u.SetLastFailedCount(*v)
+	}
+	return _u
+}
+
+// AddLastFailedCount adds value to the "last_failed_count" field.
+func (_u *ImportMappingUpdate) AddLastFailedCount(v int32) *ImportMappingUpdate {
+	_u.mutation.AddLastFailedCount(v)
+	return _u
+}
+
+// SetConnector sets the "connector" edge to the ImportConnector entity.
+func (_u *ImportMappingUpdate) SetConnector(v *ImportConnector) *ImportMappingUpdate {
+	return _u.SetConnectorID(v.ID)
+}
+
+// AddFileIDs adds the "files" edge to the ImportedFile entity by IDs.
+func (_u *ImportMappingUpdate) AddFileIDs(ids ...uint32) *ImportMappingUpdate {
+	_u.mutation.AddFileIDs(ids...)
+	return _u
+}
+
+// AddFiles adds the "files" edges to the ImportedFile entity.
+func (_u *ImportMappingUpdate) AddFiles(v ...*ImportedFile) *ImportMappingUpdate {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddFileIDs(ids...)
+}
+
+// Mutation returns the ImportMappingMutation object of the builder.
+func (_u *ImportMappingUpdate) Mutation() *ImportMappingMutation {
+	return _u.mutation
+}
+
+// ClearConnector clears the "connector" edge to the ImportConnector entity.
+func (_u *ImportMappingUpdate) ClearConnector() *ImportMappingUpdate {
+	_u.mutation.ClearConnector()
+	return _u
+}
+
+// ClearFiles clears all "files" edges to the ImportedFile entity.
+func (_u *ImportMappingUpdate) ClearFiles() *ImportMappingUpdate {
+	_u.mutation.ClearFiles()
+	return _u
+}
+
+// RemoveFileIDs removes the "files" edge to ImportedFile entities by IDs.
+func (_u *ImportMappingUpdate) RemoveFileIDs(ids ...uint32) *ImportMappingUpdate {
+	_u.mutation.RemoveFileIDs(ids...)
+	return _u
+}
+
+// RemoveFiles removes "files" edges to ImportedFile entities.
+func (_u *ImportMappingUpdate) RemoveFiles(v ...*ImportedFile) *ImportMappingUpdate {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFileIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ImportMappingUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImportMappingUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ImportMappingUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImportMappingUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImportMappingUpdate) check() error {
+	if v, ok := _u.mutation.ConnectorID(); ok {
+		if err := importmapping.ConnectorIDValidator(v); err != nil {
+			return &ValidationError{Name: "connector_id", err: fmt.Errorf(`ent: validator failed for field "ImportMapping.connector_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RemoteFolderID(); ok {
+		if err := importmapping.RemoteFolderIDValidator(v); err != nil {
+			return &ValidationError{Name: "remote_folder_id", err: fmt.Errorf(`ent: validator failed for field "ImportMapping.remote_folder_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RemoteFolderName(); ok {
+		if err := importmapping.RemoteFolderNameValidator(v); err != nil {
+			return &ValidationError{Name: "remote_folder_name", err: fmt.Errorf(`ent: validator failed for field "ImportMapping.remote_folder_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastSyncStatus(); ok {
+		if err := importmapping.LastSyncStatusValidator(v); err != nil {
+			return &ValidationError{Name: "last_sync_status", err: fmt.Errorf(`ent: validator failed for field "ImportMapping.last_sync_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastError(); ok {
+		if err := importmapping.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`ent: validator failed for field "ImportMapping.last_error": %w`, err)}
+		}
+	}
+	if _u.mutation.ConnectorCleared() && len(_u.mutation.ConnectorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ImportMapping.connector"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ImportMappingUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ImportMappingUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ImportMappingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(importmapping.Table, importmapping.Columns, sqlgraph.NewFieldSpec(importmapping.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(importmapping.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(importmapping.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(importmapping.FieldCreateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(importmapping.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(importmapping.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(importmapping.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(importmapping.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(importmapping.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(importmapping.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.RemoteFolderID(); ok {
+		_spec.SetField(importmapping.FieldRemoteFolderID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RemoteFolderName(); ok {
+		_spec.SetField(importmapping.FieldRemoteFolderName, field.TypeString, value)
+	}
+	if _u.mutation.RemoteFolderNameCleared() {
+		_spec.ClearField(importmapping.FieldRemoteFolderName, field.TypeString)
+	}
+	if value, ok := _u.mutation.CategoryID(); ok {
+		_spec.SetField(importmapping.FieldCategoryID, field.TypeString, value)
+	}
+	if _u.mutation.CategoryIDCleared() {
+		_spec.ClearField(importmapping.FieldCategoryID, field.TypeString)
+	}
+	if value, ok := _u.mutation.IncludeSubfolders(); ok {
+		_spec.SetField(importmapping.FieldIncludeSubfolders, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SyncIntervalMinutes(); ok {
+		_spec.SetField(importmapping.FieldSyncIntervalMinutes, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedSyncIntervalMinutes(); ok {
+		_spec.AddField(importmapping.FieldSyncIntervalMinutes, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(importmapping.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NextSyncAt(); ok {
+		_spec.SetField(importmapping.FieldNextSyncAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextSyncAtCleared() {
+		_spec.ClearField(importmapping.FieldNextSyncAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastSyncStatus(); ok {
+		_spec.SetField(importmapping.FieldLastSyncStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.LastSyncAt(); ok {
+		_spec.SetField(importmapping.FieldLastSyncAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSyncAtCleared() {
+		_spec.ClearField(importmapping.FieldLastSyncAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(importmapping.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(importmapping.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastImportedCount(); ok {
+		_spec.SetField(importmapping.FieldLastImportedCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedLastImportedCount(); ok {
+		_spec.AddField(importmapping.FieldLastImportedCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.LastSkippedCount(); ok {
+		_spec.SetField(importmapping.FieldLastSkippedCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedLastSkippedCount(); ok {
+		_spec.AddField(importmapping.FieldLastSkippedCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.LastFailedCount(); ok {
+		_spec.SetField(importmapping.FieldLastFailedCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedLastFailedCount(); ok {
+		_spec.AddField(importmapping.FieldLastFailedCount, field.TypeInt32, value)
+	}
+	if _u.mutation.ConnectorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importmapping.ConnectorTable,
+			Columns: []string{importmapping.ConnectorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importconnector.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ConnectorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importmapping.ConnectorTable,
+			Columns: []string{importmapping.ConnectorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importconnector.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.FilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   importmapping.FilesTable,
+			Columns: []string{importmapping.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importedfile.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedFilesIDs(); len(nodes) > 0 && !_u.mutation.FilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   importmapping.FilesTable,
+			Columns: []string{importmapping.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importedfile.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   importmapping.FilesTable,
+			Columns: []string{importmapping.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importedfile.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{importmapping.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ImportMappingUpdateOne is the builder for updating a single ImportMapping entity.
+type ImportMappingUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ImportMappingMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *ImportMappingUpdateOne) SetCreateBy(v uint32) *ImportMappingUpdateOne {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *ImportMappingUpdateOne) SetNillableCreateBy(v *uint32) *ImportMappingUpdateOne {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *ImportMappingUpdateOne) AddCreateBy(v int32) *ImportMappingUpdateOne {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *ImportMappingUpdateOne) ClearCreateBy() *ImportMappingUpdateOne {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ImportMappingUpdateOne) SetUpdateTime(v time.Time) *ImportMappingUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *ImportMappingUpdateOne) SetNillableUpdateTime(v *time.Time) *ImportMappingUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *ImportMappingUpdateOne) ClearUpdateTime() *ImportMappingUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *ImportMappingUpdateOne) SetDeleteTime(v time.Time) *ImportMappingUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *ImportMappingUpdateOne) SetNillableDeleteTime(v *time.Time) *ImportMappingUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *ImportMappingUpdateOne) ClearDeleteTime() *ImportMappingUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetConnectorID sets the "connector_id" field.
+func (_u *ImportMappingUpdateOne) SetConnectorID(v string) *ImportMappingUpdateOne {
+	_u.mutation.SetConnectorID(v)
+	return _u
+}
+
+// SetNillableConnectorID sets the "connector_id" field if the given value is not nil.
+func (_u *ImportMappingUpdateOne) SetNillableConnectorID(v *string) *ImportMappingUpdateOne {
+	if v != nil {
+		_u.SetConnectorID(*v)
+	}
+	return _u
+}
+
+// SetRemoteFolderID sets the "remote_folder_id" field.
+func (_u *ImportMappingUpdateOne) SetRemoteFolderID(v string) *ImportMappingUpdateOne {
+	_u.mutation.SetRemoteFolderID(v)
+	return _u
+}
+
+// SetNillableRemoteFolderID sets the "remote_folder_id" field if the given value is not nil.
+func (_u *ImportMappingUpdateOne) SetNillableRemoteFolderID(v *string) *ImportMappingUpdateOne {
+	if v != nil {
+		_u.SetRemoteFolderID(*v)
+	}
+	return _u
+}
+
+// SetRemoteFolderName sets the "remote_folder_name" field.
+func (_u *ImportMappingUpdateOne) SetRemoteFolderName(v string) *ImportMappingUpdateOne {
+	_u.mutation.SetRemoteFolderName(v)
+	return _u
+}
+
+// SetNillableRemoteFolderName sets the "remote_folder_name" field if the given value is not nil.
+func (_u *ImportMappingUpdateOne) SetNillableRemoteFolderName(v *string) *ImportMappingUpdateOne {
+	if v != nil {
+		_u.SetRemoteFolderName(*v)
+	}
+	return _u
+}
+
+// ClearRemoteFolderName clears the value of the "remote_folder_name" field.
+func (_u *ImportMappingUpdateOne) ClearRemoteFolderName() *ImportMappingUpdateOne {
+	_u.mutation.ClearRemoteFolderName()
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *ImportMappingUpdateOne) SetCategoryID(v string) *ImportMappingUpdateOne {
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *ImportMappingUpdateOne) SetNillableCategoryID(v *string) *ImportMappingUpdateOne {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (_u *ImportMappingUpdateOne) ClearCategoryID() *ImportMappingUpdateOne {
+	_u.mutation.ClearCategoryID()
+	return _u
+}
+
+// SetIncludeSubfolders sets the "include_subfolders" field.
+func (_u *ImportMappingUpdateOne) SetIncludeSubfolders(v bool) *ImportMappingUpdateOne {
+	_u.mutation.SetIncludeSubfolders(v)
+	return _u
+}
+
+// SetNillableIncludeSubfolders sets the "include_subfolders" field if the given value is not nil.
+func (_u *ImportMappingUpdateOne) SetNillableIncludeSubfolders(v *bool) *ImportMappingUpdateOne {
+	if v != nil {
+		_u.SetIncludeSubfolders(*v)
+	}
+	return _u
+}
+
+// SetSyncIntervalMinutes sets the "sync_interval_minutes" field.
+func (_u *ImportMappingUpdateOne) SetSyncIntervalMinutes(v int32) *ImportMappingUpdateOne {
+	_u.mutation.ResetSyncIntervalMinutes()
+	_u.mutation.SetSyncIntervalMinutes(v)
+	return _u
+}
+
+// SetNillableSyncIntervalMinutes sets the "sync_interval_minutes" field if the given value is not nil.
+func (_u *ImportMappingUpdateOne) SetNillableSyncIntervalMinutes(v *int32) *ImportMappingUpdateOne {
+	if v != nil {
+		_u.SetSyncIntervalMinutes(*v)
+	}
+	return _u
+}
+
+// AddSyncIntervalMinutes adds value to the "sync_interval_minutes" field.
+func (_u *ImportMappingUpdateOne) AddSyncIntervalMinutes(v int32) *ImportMappingUpdateOne {
+	_u.mutation.AddSyncIntervalMinutes(v)
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *ImportMappingUpdateOne) SetEnabled(v bool) *ImportMappingUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *ImportMappingUpdateOne) SetNillableEnabled(v *bool) *ImportMappingUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetNextSyncAt sets the "next_sync_at" field.
+func (_u *ImportMappingUpdateOne) SetNextSyncAt(v time.Time) *ImportMappingUpdateOne {
+	_u.mutation.SetNextSyncAt(v)
+	return _u
+}
+
+// SetNillableNextSyncAt sets the "next_sync_at" field if the given value is not nil.
+func (_u *ImportMappingUpdateOne) SetNillableNextSyncAt(v *time.Time) *ImportMappingUpdateOne {
+	if v != nil {
+		_u.SetNextSyncAt(*v)
+	}
+	return _u
+}
+
+// ClearNextSyncAt clears the value of the "next_sync_at" field.
+func (_u *ImportMappingUpdateOne) ClearNextSyncAt() *ImportMappingUpdateOne {
+	_u.mutation.ClearNextSyncAt()
+	return _u
+}
+
+// SetLastSyncStatus sets the "last_sync_status" field.
+func (_u *ImportMappingUpdateOne) SetLastSyncStatus(v importmapping.LastSyncStatus) *ImportMappingUpdateOne {
+	_u.mutation.SetLastSyncStatus(v)
+	return _u
+}
+
+// SetNillableLastSyncStatus sets the "last_sync_status" field if the given value is not nil.
+func (_u *ImportMappingUpdateOne) SetNillableLastSyncStatus(v *importmapping.LastSyncStatus) *ImportMappingUpdateOne {
+	if v != nil {
+		_u.SetLastSyncStatus(*v)
+	}
+	return _u
+}
+
+// SetLastSyncAt sets the "last_sync_at" field.
+func (_u *ImportMappingUpdateOne) SetLastSyncAt(v time.Time) *ImportMappingUpdateOne {
+	_u.mutation.SetLastSyncAt(v)
+	return _u
+}
+
+// SetNillableLastSyncAt sets the "last_sync_at" field if the given value is not nil.
+func (_u *ImportMappingUpdateOne) SetNillableLastSyncAt(v *time.Time) *ImportMappingUpdateOne {
+	if v != nil {
+		_u.SetLastSyncAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSyncAt clears the value of the "last_sync_at" field.
+func (_u *ImportMappingUpdateOne) ClearLastSyncAt() *ImportMappingUpdateOne {
+	_u.mutation.ClearLastSyncAt()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *ImportMappingUpdateOne) SetLastError(v string) *ImportMappingUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *ImportMappingUpdateOne) SetNillableLastError(v *string) *ImportMappingUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *ImportMappingUpdateOne) ClearLastError() *ImportMappingUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetLastImportedCount sets the "last_imported_count" field.
+func (_u *ImportMappingUpdateOne) SetLastImportedCount(v int32) *ImportMappingUpdateOne {
+	_u.mutation.ResetLastImportedCount()
+	_u.mutation.SetLastImportedCount(v)
+	return _u
+}
+
+// SetNillableLastImportedCount sets the "last_imported_count" field if the given value is not nil.
+func (_u *ImportMappingUpdateOne) SetNillableLastImportedCount(v *int32) *ImportMappingUpdateOne {
+	if v != nil {
+		_u.SetLastImportedCount(*v)
+	}
+	return _u
+}
+
+// AddLastImportedCount adds value to the "last_imported_count" field.
+func (_u *ImportMappingUpdateOne) AddLastImportedCount(v int32) *ImportMappingUpdateOne {
+	_u.mutation.AddLastImportedCount(v)
+	return _u
+}
+
+// SetLastSkippedCount sets the "last_skipped_count" field.
+func (_u *ImportMappingUpdateOne) SetLastSkippedCount(v int32) *ImportMappingUpdateOne {
+	_u.mutation.ResetLastSkippedCount()
+	_u.mutation.SetLastSkippedCount(v)
+	return _u
+}
+
+// SetNillableLastSkippedCount sets the "last_skipped_count" field if the given value is not nil.
+func (_u *ImportMappingUpdateOne) SetNillableLastSkippedCount(v *int32) *ImportMappingUpdateOne {
+	if v != nil {
+		_u.SetLastSkippedCount(*v)
+	}
+	return _u
+}
+
+// AddLastSkippedCount adds value to the "last_skipped_count" field.
+func (_u *ImportMappingUpdateOne) AddLastSkippedCount(v int32) *ImportMappingUpdateOne {
+	_u.mutation.AddLastSkippedCount(v)
+	return _u
+}
+
+// SetLastFailedCount sets the "last_failed_count" field.
+func (_u *ImportMappingUpdateOne) SetLastFailedCount(v int32) *ImportMappingUpdateOne {
+	_u.mutation.ResetLastFailedCount()
+	_u.mutation.SetLastFailedCount(v)
+	return _u
+}
+
+// SetNillableLastFailedCount sets the "last_failed_count" field if the given value is not nil.
+func (_u *ImportMappingUpdateOne) SetNillableLastFailedCount(v *int32) *ImportMappingUpdateOne {
+	if v != nil {
+		_u.SetLastFailedCount(*v)
+	}
+	return _u
+}
+
+// AddLastFailedCount adds value to the "last_failed_count" field.
+func (_u *ImportMappingUpdateOne) AddLastFailedCount(v int32) *ImportMappingUpdateOne {
+	_u.mutation.AddLastFailedCount(v)
+	return _u
+}
+
+// SetConnector sets the "connector" edge to the ImportConnector entity.
+func (_u *ImportMappingUpdateOne) SetConnector(v *ImportConnector) *ImportMappingUpdateOne {
+	return _u.SetConnectorID(v.ID)
+}
+
+// AddFileIDs adds the "files" edge to the ImportedFile entity by IDs.
+func (_u *ImportMappingUpdateOne) AddFileIDs(ids ...uint32) *ImportMappingUpdateOne {
+	_u.mutation.AddFileIDs(ids...)
+	return _u
+}
+
+// AddFiles adds the "files" edges to the ImportedFile entity.
+func (_u *ImportMappingUpdateOne) AddFiles(v ...*ImportedFile) *ImportMappingUpdateOne {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddFileIDs(ids...)
+}
+
+// Mutation returns the ImportMappingMutation object of the builder.
+func (_u *ImportMappingUpdateOne) Mutation() *ImportMappingMutation {
+	return _u.mutation
+}
+
+// ClearConnector clears the "connector" edge to the ImportConnector entity.
+func (_u *ImportMappingUpdateOne) ClearConnector() *ImportMappingUpdateOne {
+	_u.mutation.ClearConnector()
+	return _u
+}
+
+// ClearFiles clears all "files" edges to the ImportedFile entity.
+func (_u *ImportMappingUpdateOne) ClearFiles() *ImportMappingUpdateOne {
+	_u.mutation.ClearFiles()
+	return _u
+}
+
+// RemoveFileIDs removes the "files" edge to ImportedFile entities by IDs.
+func (_u *ImportMappingUpdateOne) RemoveFileIDs(ids ...uint32) *ImportMappingUpdateOne {
+	_u.mutation.RemoveFileIDs(ids...)
+	return _u
+}
+
+// RemoveFiles removes "files" edges to ImportedFile entities.
+func (_u *ImportMappingUpdateOne) RemoveFiles(v ...*ImportedFile) *ImportMappingUpdateOne {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFileIDs(ids...)
+}
+
+// Where appends a list predicates to the ImportMappingUpdate builder.
+func (_u *ImportMappingUpdateOne) Where(ps ...predicate.ImportMapping) *ImportMappingUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ImportMappingUpdateOne) Select(field string, fields ...string) *ImportMappingUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ImportMapping entity.
+func (_u *ImportMappingUpdateOne) Save(ctx context.Context) (*ImportMapping, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImportMappingUpdateOne) SaveX(ctx context.Context) *ImportMapping {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ImportMappingUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImportMappingUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImportMappingUpdateOne) check() error {
+	if v, ok := _u.mutation.ConnectorID(); ok {
+		if err := importmapping.ConnectorIDValidator(v); err != nil {
+			return &ValidationError{Name: "connector_id", err: fmt.Errorf(`ent: validator failed for field "ImportMapping.connector_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RemoteFolderID(); ok {
+		if err := importmapping.RemoteFolderIDValidator(v); err != nil {
+			return &ValidationError{Name: "remote_folder_id", err: fmt.Errorf(`ent: validator failed for field "ImportMapping.remote_folder_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RemoteFolderName(); ok {
+		if err := importmapping.RemoteFolderNameValidator(v); err != nil {
+			return &ValidationError{Name: "remote_folder_name", err: fmt.Errorf(`ent: validator failed for field "ImportMapping.remote_folder_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastSyncStatus(); ok {
+		if err := importmapping.LastSyncStatusValidator(v); err != nil {
+			return &ValidationError{Name: "last_sync_status", err: fmt.Errorf(`ent: validator failed for field "ImportMapping.last_sync_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastError(); ok {
+		if err := importmapping.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`ent: validator failed for field "ImportMapping.last_error": %w`, err)}
+		}
+	}
+	if _u.mutation.ConnectorCleared() && len(_u.mutation.ConnectorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ImportMapping.connector"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ImportMappingUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ImportMappingUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ImportMappingUpdateOne) sqlSave(ctx context.Context) (_node *ImportMapping, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(importmapping.Table, importmapping.Columns, sqlgraph.NewFieldSpec(importmapping.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ImportMapping.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, importmapping.FieldID)
+		for _, f := range fields {
+			if !importmapping.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != importmapping.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(importmapping.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(importmapping.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(importmapping.FieldCreateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(importmapping.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(importmapping.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(importmapping.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(importmapping.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(importmapping.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(importmapping.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.RemoteFolderID(); ok {
+		_spec.SetField(importmapping.FieldRemoteFolderID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RemoteFolderName(); ok {
+		_spec.SetField(importmapping.FieldRemoteFolderName, field.TypeString, value)
+	}
+	if _u.mutation.RemoteFolderNameCleared() {
+		_spec.ClearField(importmapping.FieldRemoteFolderName, field.TypeString)
+	}
+	if value, ok := _u.mutation.CategoryID(); ok {
+		_spec.SetField(importmapping.FieldCategoryID, field.TypeString, value)
+	}
+	if _u.mutation.CategoryIDCleared() {
+		_spec.ClearField(importmapping.FieldCategoryID, field.TypeString)
+	}
+	if value, ok := _u.mutation.IncludeSubfolders(); ok {
+		_spec.SetField(importmapping.FieldIncludeSubfolders, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SyncIntervalMinutes(); ok {
+		_spec.SetField(importmapping.FieldSyncIntervalMinutes, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedSyncIntervalMinutes(); ok {
+		_spec.AddField(importmapping.FieldSyncIntervalMinutes, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(importmapping.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NextSyncAt(); ok {
+		_spec.SetField(importmapping.FieldNextSyncAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextSyncAtCleared() {
+		_spec.ClearField(importmapping.FieldNextSyncAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastSyncStatus(); ok {
+		_spec.SetField(importmapping.FieldLastSyncStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.LastSyncAt(); ok {
+		_spec.SetField(importmapping.FieldLastSyncAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSyncAtCleared() {
+		_spec.ClearField(importmapping.FieldLastSyncAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(importmapping.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(importmapping.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastImportedCount(); ok {
+		_spec.SetField(importmapping.FieldLastImportedCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedLastImportedCount(); ok {
+		_spec.AddField(importmapping.FieldLastImportedCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.LastSkippedCount(); ok {
+		_spec.SetField(importmapping.FieldLastSkippedCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedLastSkippedCount(); ok {
+		_spec.AddField(importmapping.FieldLastSkippedCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.LastFailedCount(); ok {
+		_spec.SetField(importmapping.FieldLastFailedCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedLastFailedCount(); ok {
+		_spec.AddField(importmapping.FieldLastFailedCount, field.TypeInt32, value)
+	}
+	if _u.mutation.ConnectorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importmapping.ConnectorTable,
+			Columns: []string{importmapping.ConnectorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importconnector.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ConnectorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importmapping.ConnectorTable,
+			Columns: []string{importmapping.ConnectorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importconnector.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.FilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   importmapping.FilesTable,
+			Columns: []string{importmapping.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importedfile.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedFilesIDs(); len(nodes) > 0 && !_u.mutation.FilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   importmapping.FilesTable,
+			Columns: []string{importmapping.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importedfile.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   importmapping.FilesTable,
+			Columns: []string{importmapping.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importedfile.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ImportMapping{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{importmapping.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -9,6 +9,94 @@ import (
 )
 
 var (
+	// PaperlessAccessibleResourcesColumns holds the columns for the "paperless_accessible_resources" table.
+	PaperlessAccessibleResourcesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "permission_id", Type: field.TypeInt, Comment: "ID of the permission tuple this entry is derived from"},
+		{Name: "subject_type", Type: field.TypeEnum, Comment: "Type of subject (user, role, tenant, or group)", Enums: []string{"SUBJECT_TYPE_UNSPECIFIED", "SUBJECT_TYPE_USER", "SUBJECT_TYPE_ROLE", "SUBJECT_TYPE_TENANT", "SUBJECT_TYPE_GROUP"}},
+		{Name: "subject_id", Type: field.TypeString, Size: 36, Comment: "ID of the user, role, or tenant"},
+		{Name: "resource_type", Type: field.TypeEnum, Comment: "Type of the accessible resource", Enums: []string{"RESOURCE_TYPE_UNSPECIFIED", "RESOURCE_TYPE_CATEGORY", "RESOURCE_TYPE_DOCUMENT"}},
+		{Name: "resource_id", Type: field.TypeString, Size: 36, Comment: "ID of the accessible category or document"},
+		{Name: "relation", Type: field.TypeEnum, Comment: "Relation granted by the source tuple", Enums: []string{"RELATION_UNSPECIFIED", "RELATION_OWNER", "RELATION_EDITOR", "RELATION_VIEWER", "RELATION_SHARER"}},
+		{Name: "source_id", Type: field.TypeString, Size: 36, Comment: "Resource the source tuple is attached to (differs from resource_id when inherited)"},
+		{Name: "inherited", Type: field.TypeBool, Comment: "Whether access is inherited from a parent category", Default: false},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true, Comment: "Expiration time copied from the source tuple"},
+		{Name: "conditions", Type: field.TypeJSON, Nullable: true, Comment: "Conditions copied from the source tuple; conditional entries must be re-checked"},
+	}
+	// PaperlessAccessibleResourcesTable holds the schema information for the "paperless_accessible_resources" table.
+	PaperlessAccessibleResourcesTable = &schema.Table{
+		Name:       "paperless_accessible_resources",
+		Columns:    PaperlessAccessibleResourcesColumns,
+		PrimaryKey: []*schema.Column{PaperlessAccessibleResourcesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "accessibleresource_permission_id_resource_type_resource_id",
+				Unique:  true,
+				Columns: []*schema.Column{PaperlessAccessibleResourcesColumns[5], PaperlessAccessibleResourcesColumns[8], PaperlessAccessibleResourcesColumns[9]},
+			},
+			{
+				Name:    "accessibleresource_tenant_id_subject_type_subject_id_resource_type",
+				Unique:  false,
+				Columns: []*schema.Column{PaperlessAccessibleResourcesColumns[4], PaperlessAccessibleResourcesColumns[6], PaperlessAccessibleResourcesColumns[7], PaperlessAccessibleResourcesColumns[8]},
+			},
+			{
+				Name:    "accessibleresource_tenant_id_resource_type_resource_id",
+				Unique:  false,
+				Columns: []*schema.Column{PaperlessAccessibleResourcesColumns[4], PaperlessAccessibleResourcesColumns[8], PaperlessAccessibleResourcesColumns[9]},
+			},
+			{
+				Name:    "accessibleresource_tenant_id_source_id",
+				Unique:  false,
+				Columns: []*schema.Column{PaperlessAccessibleResourcesColumns[4], PaperlessAccessibleResourcesColumns[11]},
+			},
+		},
+	}
+	// PaperlessAuditEventsColumns holds the columns for the "paperless_audit_events" table.
+	PaperlessAuditEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "user_id", Type: field.TypeString, Nullable: true, Size: 64, Comment: "ID of the user who performed the action, empty for system actions"},
+		{Name: "action", Type: field.TypeEnum, Comment: "What was done", Enums: []string{"AUDIT_ACTION_CREATE", "AUDIT_ACTION_UPDATE", "AUDIT_ACTION_MOVE", "AUDIT_ACTION_DELETE", "AUDIT_ACTION_DOWNLOAD", "AUDIT_ACTION_SHARE", "AUDIT_ACTION_UNSHARE", "AUDIT_ACTION_REVIEW"}},
+		{Name: "resource_type", Type: field.TypeEnum, Comment: "Type of resource acted on", Enums: []string{"RESOURCE_TYPE_UNSPECIFIED", "RESOURCE_TYPE_CATEGORY", "RESOURCE_TYPE_DOCUMENT"}},
+		{Name: "resource_id", Type: field.TypeString, Size: 36, Comment: "ID of the category or document"},
+		{Name: "resource_name", Type: field.TypeString, Nullable: true, Size: 255, Comment: "Name of the resource at the time of the action"},
+		{Name: "details", Type: field.TypeJSON, Nullable: true, Comment: "Action specific details, e.g. the target category of a move"},
+	}
+	// PaperlessAuditEventsTable holds the schema information for the "paperless_audit_events" table.
+	PaperlessAuditEventsTable = &schema.Table{
+		Name:       "paperless_audit_events",
+		Columns:    PaperlessAuditEventsColumns,
+		PrimaryKey: []*schema.Column{PaperlessAuditEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditevent_tenant_id_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{PaperlessAuditEventsColumns[4], PaperlessAuditEventsColumns[1]},
+			},
+			{
+				Name:    "auditevent_tenant_id_resource_type_resource_id",
+				Unique:  false,
+				Columns: []*schema.Column{PaperlessAuditEventsColumns[4], PaperlessAuditEventsColumns[7], PaperlessAuditEventsColumns[8]},
+			},
+			{
+				Name:    "auditevent_tenant_id_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{PaperlessAuditEventsColumns[4], PaperlessAuditEventsColumns[5]},
+			},
+			{
+				Name:    "auditevent_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{PaperlessAuditEventsColumns[1]},
+			},
+		},
+	}
 	// PaperlessAuditLogsColumns holds the columns for the "paperless_audit_logs" table.
 	PaperlessAuditLogsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
@@ -91,11 +179,25 @@ var (
 		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
 		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
 		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "version", Type: field.TypeUint32, Comment: "版本号/乐观锁", Default: 1},
 		{Name: "name", Type: field.TypeString, Size: 255, Comment: "Category name"},
 		{Name: "path", Type: field.TypeString, Size: 4096, Comment: "Materialized path (e.g., /root/sub/current)"},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Optional description"},
+		{Name: "color", Type: field.TypeString, Nullable: true, Size: 7, Comment: "Display color as #RRGGBB"},
+		{Name: "icon", Type: field.TypeString, Nullable: true, Size: 64, Comment: "Icon name shown in the category tree"},
 		{Name: "depth", Type: field.TypeInt32, Comment: "Nesting depth level (0 for root categories)", Default: 0},
 		{Name: "sort_order", Type: field.TypeInt32, Comment: "Sort order within parent (lower numbers appear first)", Default: 0},
+		{Name: "document_count", Type: field.TypeInt64, Comment: "Documents directly in the category, maintained on document writes", Default: 0},
+		{Name: "subtree_document_count", Type: field.TypeInt64, Comment: "Documents in the category and its descendants, maintained on document writes", Default: 0},
+		{Name: "document_bytes", Type: field.TypeInt64, Comment: "Total file size of the documents directly in the category, maintained on document writes", Default: 0},
+		{Name: "subtree_document_bytes", Type: field.TypeInt64, Comment: "Total file size of the documents in the category and its descendants, maintained on document writes", Default: 0},
+		{Name: "max_documents", Type: field.TypeInt64, Nullable: true, Comment: "Most documents the category and its descendants may hold (null for no limit)"},
+		{Name: "max_bytes", Type: field.TypeInt64, Nullable: true, Comment: "Most bytes the documents in the category and its descendants may take (null for no limit)"},
+		{Name: "rule_tags", Type: field.TypeJSON, Nullable: true, Comment: "Tags given to documents filed or moved into the category"},
+		{Name: "rule_document_type", Type: field.TypeString, Nullable: true, Size: 64, Comment: "Document type given to documents filed or moved into the category"},
+		{Name: "rule_retention_class", Type: field.TypeString, Nullable: true, Size: 64, Comment: "Retention class given to documents filed or moved into the category"},
+		{Name: "child_sort_mode", Type: field.TypeEnum, Comment: "How the subcategories are ordered", Enums: []string{"CATEGORY_SORT_MODE_MANUAL", "CATEGORY_SORT_MODE_ALPHABETICAL", "CATEGORY_SORT_MODE_RECENT_ACTIVITY"}, Default: "CATEGORY_SORT_MODE_MANUAL"},
+		{Name: "activity_time", Type: field.TypeTime, Nullable: true, Comment: "Last time documents were added to, removed from or replaced in the category or its descendants, maintained on document writes"},
 		{Name: "parent_id", Type: field.TypeString, Nullable: true, Comment: "Parent category ID (null for root-level categories)"},
 	}
 	// PaperlessCategoriesTable holds the schema information for the "paperless_categories" table.
@@ -106,7 +208,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "paperless_categories_paperless_categories_children",
-				Columns:    []*schema.Column{PaperlessCategoriesColumns[11]},
+				Columns:    []*schema.Column{PaperlessCategoriesColumns[25]},
 				RefColumns: []*schema.Column{PaperlessCategoriesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -115,12 +217,12 @@ var (
 			{
 				Name:    "category_tenant_id_parent_id_name",
 				Unique:  true,
-				Columns: []*schema.Column{PaperlessCategoriesColumns[5], PaperlessCategoriesColumns[11], PaperlessCategoriesColumns[6]},
+				Columns: []*schema.Column{PaperlessCategoriesColumns[5], PaperlessCategoriesColumns[25], PaperlessCategoriesColumns[7]},
 			},
 			{
 				Name:    "category_tenant_id_path",
 				Unique:  true,
-				Columns: []*schema.Column{PaperlessCategoriesColumns[5], PaperlessCategoriesColumns[7]},
+				Columns: []*schema.Column{PaperlessCategoriesColumns[5], PaperlessCategoriesColumns[8]},
 			},
 			{
 				Name:    "category_tenant_id",
@@ -130,17 +232,58 @@ var (
 			{
 				Name:    "category_parent_id",
 				Unique:  false,
-				Columns: []*schema.Column{PaperlessCategoriesColumns[11]},
+				Columns: []*schema.Column{PaperlessCategoriesColumns[25]},
 			},
 			{
 				Name:    "category_path",
 				Unique:  false,
-				Columns: []*schema.Column{PaperlessCategoriesColumns[7]},
+				Columns: []*schema.Column{PaperlessCategoriesColumns[8]},
 			},
 			{
 				Name:    "category_tenant_id_sort_order",
 				Unique:  false,
-				Columns: []*schema.Column{PaperlessCategoriesColumns[5], PaperlessCategoriesColumns[10]},
+				Columns: []*schema.Column{PaperlessCategoriesColumns[5], PaperlessCategoriesColumns[13]},
+			},
+		},
+	}
+	// PaperlessCategoryDeleteJobsColumns holds the columns for the "paperless_category_delete_jobs" table.
+	PaperlessCategoryDeleteJobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "UUID primary key"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "category_id", Type: field.TypeString, Comment: "Category being deleted"},
+		{Name: "category_path", Type: field.TypeString, Size: 4096, Comment: "Path of the category when the job was queued"},
+		{Name: "mode", Type: field.TypeEnum, Comment: "What happens to the category's contents", Enums: []string{"CATEGORY_DELETE_MODE_SUBTREE", "CATEGORY_DELETE_MODE_SUBTREE_WITH_DOCUMENTS", "CATEGORY_DELETE_MODE_REASSIGN"}},
+		{Name: "target_category_id", Type: field.TypeString, Nullable: true, Comment: "Category receiving the contents in reassign mode, null for the root level"},
+		{Name: "status", Type: field.TypeEnum, Comment: "Pending until a worker picks the job up, running until it is done", Enums: []string{"CATEGORY_DELETE_JOB_STATUS_PENDING", "CATEGORY_DELETE_JOB_STATUS_RUNNING", "CATEGORY_DELETE_JOB_STATUS_SUCCEEDED", "CATEGORY_DELETE_JOB_STATUS_FAILED"}, Default: "CATEGORY_DELETE_JOB_STATUS_PENDING"},
+		{Name: "total_items", Type: field.TypeInt64, Comment: "Categories and documents to process, counted when the job was queued", Default: 0},
+		{Name: "processed_items", Type: field.TypeInt64, Comment: "Categories and documents processed so far", Default: 0},
+		{Name: "categories_deleted", Type: field.TypeInt64, Comment: "Categories deleted so far", Default: 0},
+		{Name: "categories_moved", Type: field.TypeInt64, Comment: "Subcategories moved to the target so far", Default: 0},
+		{Name: "documents_deleted", Type: field.TypeInt64, Comment: "Documents moved to the trash so far", Default: 0},
+		{Name: "documents_moved", Type: field.TypeInt64, Comment: "Documents moved to the target so far", Default: 0},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Why the job failed"},
+		{Name: "lease_until", Type: field.TypeTime, Nullable: true, Comment: "Until when the running worker holds the job; another worker resumes it afterwards"},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true, Comment: "When the job succeeded or failed"},
+	}
+	// PaperlessCategoryDeleteJobsTable holds the schema information for the "paperless_category_delete_jobs" table.
+	PaperlessCategoryDeleteJobsTable = &schema.Table{
+		Name:       "paperless_category_delete_jobs",
+		Columns:    PaperlessCategoryDeleteJobsColumns,
+		PrimaryKey: []*schema.Column{PaperlessCategoryDeleteJobsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "categorydeletejob_status_lease_until",
+				Unique:  false,
+				Columns: []*schema.Column{PaperlessCategoryDeleteJobsColumns[10], PaperlessCategoryDeleteJobsColumns[18]},
+			},
+			{
+				Name:    "categorydeletejob_tenant_id_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{PaperlessCategoryDeleteJobsColumns[5], PaperlessCategoryDeleteJobsColumns[2]},
 			},
 		},
 	}
@@ -153,6 +296,7 @@ var (
 		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
 		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
 		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "version", Type: field.TypeUint32, Comment: "版本号/乐观锁", Default: 1},
 		{Name: "name", Type: field.TypeString, Size: 255, Comment: "Document display name"},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 4096, Comment: "Document description"},
 		{Name: "file_key", Type: field.TypeString, Size: 512, Comment: "Storage key in RustFS/S3"},
@@ -161,11 +305,19 @@ var (
 		{Name: "mime_type", Type: field.TypeString, Nullable: true, Size: 255, Comment: "MIME type of the file"},
 		{Name: "checksum", Type: field.TypeString, Nullable: true, Size: 64, Comment: "SHA-256 checksum of the file"},
 		{Name: "tags", Type: field.TypeJSON, Nullable: true, Comment: "Custom tags (key-value pairs)"},
+		{Name: "document_type", Type: field.TypeString, Nullable: true, Size: 64, Comment: "Kind of document, e.g. invoice or contract"},
+		{Name: "retention_class", Type: field.TypeString, Nullable: true, Size: 64, Comment: "Retention class the document is kept under"},
 		{Name: "status", Type: field.TypeEnum, Comment: "Document status", Enums: []string{"DOCUMENT_STATUS_UNSPECIFIED", "DOCUMENT_STATUS_ACTIVE", "DOCUMENT_STATUS_ARCHIVED", "DOCUMENT_STATUS_DELETED"}, Default: "DOCUMENT_STATUS_ACTIVE"},
-		{Name: "source", Type: field.TypeEnum, Comment: "Source of the document (upload, email, etc.)", Enums: []string{"DOCUMENT_SOURCE_UNSPECIFIED", "DOCUMENT_SOURCE_UPLOAD", "DOCUMENT_SOURCE_EMAIL"}, Default: "DOCUMENT_SOURCE_UPLOAD"},
-		{Name: "content_text", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "Extracted text content for full-text search"},
-		{Name: "extracted_metadata", Type: field.TypeJSON, Nullable: true, Comment: "Metadata extracted by Tika (author, title, page_count, etc.)"},
-		{Name: "processing_status", Type: field.TypeEnum, Comment: "Document content extraction status", Enums: []string{"PROCESSING_STATUS_PENDING", "PROCESSING_STATUS_PROCESSING", "PROCESSING_STATUS_COMPLETED", "PROCESSING_STATUS_FAILED", "PROCESSING_STATUS_SKIPPED"}, Default: "PROCESSING_STATUS_PENDING"},
+		{Name: "source", Type: field.TypeEnum, Comment: "Source of the document (upload, email, etc.)", Enums: []string{"DOCUMENT_SOURCE_UNSPECIFIED", "DOCUMENT_SOURCE_UPLOAD", "DOCUMENT_SOURCE_EMAIL", "DOCUMENT_SOURCE_IMPORT"}, Default: "DOCUMENT_SOURCE_UPLOAD"},
+		{Name: "processing_status", Type: field.TypeEnum, Comment: "Document content extraction status", Enums: []string{"PROCESSING_STATUS_PENDING", "PROCESSING_STATUS_PROCESSING", "PROCESSING_STATUS_COMPLETED", "PROCESSING_STATUS_FAILED", "PROCESSING_STATUS_SKIPPED", "PROCESSING_STATUS_INFECTED"}, Default: "PROCESSING_STATUS_PENDING"},
+		{Name: "storage_tier", Type: field.TypeEnum, Comment: "Storage tier currently holding the file", Enums: []string{"STORAGE_TIER_UNSPECIFIED", "STORAGE_TIER_HOT", "STORAGE_TIER_COLD"}, Default: "STORAGE_TIER_HOT"},
+		{Name: "last_accessed_at", Type: field.TypeTime, Nullable: true, Comment: "Last time the file was downloaded"},
+		{Name: "due_date", Type: field.TypeTime, Nullable: true, Comment: "Date the document is due, e.g. a contract's renewal date"},
+		{Name: "reminded_at", Type: field.TypeTime, Nullable: true, Comment: "When the owners were reminded of the due date, cleared when it changes"},
+		{Name: "assignee_id", Type: field.TypeUint32, Nullable: true, Comment: "User the document awaits action from"},
+		{Name: "assigned_by", Type: field.TypeUint32, Nullable: true, Comment: "User who assigned the document"},
+		{Name: "assigned_at", Type: field.TypeTime, Nullable: true, Comment: "When the document was assigned"},
+		{Name: "rendition_key", Type: field.TypeString, Nullable: true, Comment: "Storage key of the cached PDF rendition of a file that is not a PDF"},
 		{Name: "category_id", Type: field.TypeString, Nullable: true, Comment: "Parent category ID (null for root-level documents)"},
 	}
 	// PaperlessDocumentsTable holds the schema information for the "paperless_documents" table.
@@ -176,7 +328,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "paperless_documents_paperless_categories_documents",
-				Columns:    []*schema.Column{PaperlessDocumentsColumns[20]},
+				Columns:    []*schema.Column{PaperlessDocumentsColumns[29]},
 				RefColumns: []*schema.Column{PaperlessCategoriesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -185,7 +337,7 @@ var (
 			{
 				Name:    "document_tenant_id_category_id_name",
 				Unique:  true,
-				Columns: []*schema.Column{PaperlessDocumentsColumns[6], PaperlessDocumentsColumns[20], PaperlessDocumentsColumns[7]},
+				Columns: []*schema.Column{PaperlessDocumentsColumns[6], PaperlessDocumentsColumns[29], PaperlessDocumentsColumns[8]},
 			},
 			{
 				Name:    "document_tenant_id",
@@ -195,27 +347,101 @@ var (
 			{
 				Name:    "document_category_id",
 				Unique:  false,
-				Columns: []*schema.Column{PaperlessDocumentsColumns[20]},
+				Columns: []*schema.Column{PaperlessDocumentsColumns[29]},
 			},
 			{
 				Name:    "document_tenant_id_name",
 				Unique:  false,
-				Columns: []*schema.Column{PaperlessDocumentsColumns[6], PaperlessDocumentsColumns[7]},
+				Columns: []*schema.Column{PaperlessDocumentsColumns[6], PaperlessDocumentsColumns[8]},
 			},
 			{
 				Name:    "document_status",
 				Unique:  false,
-				Columns: []*schema.Column{PaperlessDocumentsColumns[15]},
+				Columns: []*schema.Column{PaperlessDocumentsColumns[18]},
 			},
 			{
 				Name:    "document_file_key",
 				Unique:  true,
-				Columns: []*schema.Column{PaperlessDocumentsColumns[9]},
+				Columns: []*schema.Column{PaperlessDocumentsColumns[10]},
 			},
 			{
 				Name:    "document_tenant_id_mime_type",
 				Unique:  false,
-				Columns: []*schema.Column{PaperlessDocumentsColumns[6], PaperlessDocumentsColumns[12]},
+				Columns: []*schema.Column{PaperlessDocumentsColumns[6], PaperlessDocumentsColumns[13]},
+			},
+			{
+				Name:    "document_storage_tier_status",
+				Unique:  false,
+				Columns: []*schema.Column{PaperlessDocumentsColumns[21], PaperlessDocumentsColumns[18]},
+			},
+			{
+				Name:    "document_tenant_id_due_date",
+				Unique:  false,
+				Columns: []*schema.Column{PaperlessDocumentsColumns[6], PaperlessDocumentsColumns[23]},
+			},
+			{
+				Name:    "document_tenant_id_assignee_id",
+				Unique:  false,
+				Columns: []*schema.Column{PaperlessDocumentsColumns[6], PaperlessDocumentsColumns[25]},
+			},
+		},
+	}
+	// PaperlessDocumentContentsColumns holds the columns for the "paperless_document_contents" table.
+	PaperlessDocumentContentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "content_text", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "Extracted text content for full-text search (empty when stored compressed, the start of the text when archived)"},
+		{Name: "content_text_compressed", Type: field.TypeBytes, Nullable: true, Comment: "Gzip-compressed extracted text, used instead of content_text for large texts", SchemaType: map[string]string{"mysql": "longblob"}},
+		{Name: "search_terms", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "Distinct lowercase words of compressed or archived extracted text, for full-text search"},
+		{Name: "content_text_key", Type: field.TypeString, Nullable: true, Comment: "Storage key of the full extracted text when only its start is stored"},
+		{Name: "extracted_metadata", Type: field.TypeJSON, Nullable: true, Comment: "Metadata extracted by Tika (author, title, page_count, etc.)"},
+		{Name: "document_id", Type: field.TypeString, Unique: true, Comment: "Document the content was extracted from"},
+	}
+	// PaperlessDocumentContentsTable holds the schema information for the "paperless_document_contents" table.
+	PaperlessDocumentContentsTable = &schema.Table{
+		Name:       "paperless_document_contents",
+		Columns:    PaperlessDocumentContentsColumns,
+		PrimaryKey: []*schema.Column{PaperlessDocumentContentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "paperless_document_contents_paperless_documents_content",
+				Columns:    []*schema.Column{PaperlessDocumentContentsColumns[7]},
+				RefColumns: []*schema.Column{PaperlessDocumentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "documentcontent_document_id",
+				Unique:  true,
+				Columns: []*schema.Column{PaperlessDocumentContentsColumns[7]},
+			},
+		},
+	}
+	// PaperlessDocumentHistoryColumns holds the columns for the "paperless_document_history" table.
+	PaperlessDocumentHistoryColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "document_id", Type: field.TypeString, Size: 36, Comment: "ID of the changed document"},
+		{Name: "user_id", Type: field.TypeString, Nullable: true, Size: 64, Comment: "ID of the user who made the change, empty for system changes"},
+		{Name: "version", Type: field.TypeUint32, Comment: "Version of the document after the change", Default: 0},
+		{Name: "changed_fields", Type: field.TypeJSON, Comment: "Names of the metadata fields that changed"},
+		{Name: "before", Type: field.TypeJSON, Comment: "Metadata before the change"},
+		{Name: "after", Type: field.TypeJSON, Comment: "Metadata after the change"},
+	}
+	// PaperlessDocumentHistoryTable holds the schema information for the "paperless_document_history" table.
+	PaperlessDocumentHistoryTable = &schema.Table{
+		Name:       "paperless_document_history",
+		Columns:    PaperlessDocumentHistoryColumns,
+		PrimaryKey: []*schema.Column{PaperlessDocumentHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "documenthistory_tenant_id_document_id_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{PaperlessDocumentHistoryColumns[4], PaperlessDocumentHistoryColumns[5], PaperlessDocumentHistoryColumns[1]},
 			},
 		},
 	}
@@ -229,10 +455,11 @@ var (
 		{Name: "resource_type", Type: field.TypeEnum, Comment: "Type of resource (category or document)", Enums: []string{"RESOURCE_TYPE_UNSPECIFIED", "RESOURCE_TYPE_CATEGORY", "RESOURCE_TYPE_DOCUMENT"}},
 		{Name: "resource_id", Type: field.TypeString, Size: 36, Comment: "ID of the category or document"},
 		{Name: "relation", Type: field.TypeEnum, Comment: "Permission level (owner, editor, viewer, sharer)", Enums: []string{"RELATION_UNSPECIFIED", "RELATION_OWNER", "RELATION_EDITOR", "RELATION_VIEWER", "RELATION_SHARER"}},
-		{Name: "subject_type", Type: field.TypeEnum, Comment: "Type of subject (user, role, or tenant)", Enums: []string{"SUBJECT_TYPE_UNSPECIFIED", "SUBJECT_TYPE_USER", "SUBJECT_TYPE_ROLE", "SUBJECT_TYPE_TENANT"}},
+		{Name: "subject_type", Type: field.TypeEnum, Comment: "Type of subject (user, role, tenant, or group)", Enums: []string{"SUBJECT_TYPE_UNSPECIFIED", "SUBJECT_TYPE_USER", "SUBJECT_TYPE_ROLE", "SUBJECT_TYPE_TENANT", "SUBJECT_TYPE_GROUP"}},
 		{Name: "subject_id", Type: field.TypeString, Size: 36, Comment: "ID of the user, role, or tenant"},
 		{Name: "granted_by", Type: field.TypeUint32, Nullable: true, Comment: "User ID who granted this permission"},
 		{Name: "expires_at", Type: field.TypeTime, Nullable: true, Comment: "Optional expiration time for temporary access"},
+		{Name: "conditions", Type: field.TypeJSON, Nullable: true, Comment: "Optional caveats (IP ranges, time window, MFA) evaluated at check time"},
 		{Name: "category_permissions", Type: field.TypeString, Nullable: true},
 		{Name: "document_permissions", Type: field.TypeString, Nullable: true},
 	}
@@ -244,13 +471,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "paperless_permissions_paperless_categories_permissions",
-				Columns:    []*schema.Column{PaperlessPermissionsColumns[12]},
+				Columns:    []*schema.Column{PaperlessPermissionsColumns[13]},
 				RefColumns: []*schema.Column{PaperlessCategoriesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "paperless_permissions_paperless_documents_permissions",
-				Columns:    []*schema.Column{PaperlessPermissionsColumns[13]},
+				Columns:    []*schema.Column{PaperlessPermissionsColumns[14]},
 				RefColumns: []*schema.Column{PaperlessDocumentsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -283,16 +510,661 @@ var (
 			},
 		},
 	}
+	// PaperlessDocumentTagsColumns holds the columns for the "paperless_document_tags" table.
+	PaperlessDocumentTagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "value", Type: field.TypeString, Comment: "Value of the tag on this document", Default: ""},
+		{Name: "document_id", Type: field.TypeString, Comment: "Tagged document"},
+		{Name: "tag_id", Type: field.TypeUint32, Comment: "Referenced tag"},
+	}
+	// PaperlessDocumentTagsTable holds the schema information for the "paperless_document_tags" table.
+	PaperlessDocumentTagsTable = &schema.Table{
+		Name:       "paperless_document_tags",
+		Columns:    PaperlessDocumentTagsColumns,
+		PrimaryKey: []*schema.Column{PaperlessDocumentTagsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "paperless_document_tags_paperless_documents_document_tags",
+				Columns:    []*schema.Column{PaperlessDocumentTagsColumns[3]},
+				RefColumns: []*schema.Column{PaperlessDocumentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "paperless_document_tags_paperless_tags_document_tags",
+				Columns:    []*schema.Column{PaperlessDocumentTagsColumns[4]},
+				RefColumns: []*schema.Column{PaperlessTagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "documenttag_document_id_tag_id",
+				Unique:  true,
+				Columns: []*schema.Column{PaperlessDocumentTagsColumns[3], PaperlessDocumentTagsColumns[4]},
+			},
+			{
+				Name:    "documenttag_tag_id",
+				Unique:  false,
+				Columns: []*schema.Column{PaperlessDocumentTagsColumns[4]},
+			},
+		},
+	}
+	// PaperlessGroupMembershipsColumns holds the columns for the "paperless_group_memberships" table.
+	PaperlessGroupMembershipsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "group_id", Type: field.TypeString, Size: 36, Comment: "Directory group ID, the subject ID of group grants"},
+		{Name: "group_name", Type: field.TypeString, Nullable: true, Size: 255, Comment: "Display name of the group"},
+		{Name: "user_id", Type: field.TypeString, Size: 36, Comment: "Member user ID"},
+	}
+	// PaperlessGroupMembershipsTable holds the schema information for the "paperless_group_memberships" table.
+	PaperlessGroupMembershipsTable = &schema.Table{
+		Name:       "paperless_group_memberships",
+		Columns:    PaperlessGroupMembershipsColumns,
+		PrimaryKey: []*schema.Column{PaperlessGroupMembershipsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "groupmembership_tenant_id_group_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{PaperlessGroupMembershipsColumns[4], PaperlessGroupMembershipsColumns[5], PaperlessGroupMembershipsColumns[7]},
+			},
+			{
+				Name:    "groupmembership_tenant_id_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{PaperlessGroupMembershipsColumns[4], PaperlessGroupMembershipsColumns[7]},
+			},
+		},
+	}
+	// PaperlessIdempotencyKeysColumns holds the columns for the "paperless_idempotency_keys" table.
+	PaperlessIdempotencyKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "user_id", Type: field.TypeString, Size: 64, Comment: "User who sent the request; keys are scoped to their sender", Default: ""},
+		{Name: "key", Type: field.TypeString, Size: 255, Comment: "Idempotency key chosen by the client"},
+		{Name: "operation", Type: field.TypeString, Size: 255, Comment: "RPC the key was used for"},
+		{Name: "request_hash", Type: field.TypeString, Size: 64, Comment: "SHA-256 of the request, to refuse a key reused for another request"},
+		{Name: "completed", Type: field.TypeBool, Comment: "The request succeeded and its response is stored; until then it is in progress", Default: false},
+		{Name: "response", Type: field.TypeBytes, Nullable: true, Comment: "Response of the request, as a protobuf Any"},
+		{Name: "expires_at", Type: field.TypeTime, Comment: "When the key can be used again and the record is removed"},
+	}
+	// PaperlessIdempotencyKeysTable holds the schema information for the "paperless_idempotency_keys" table.
+	PaperlessIdempotencyKeysTable = &schema.Table{
+		Name:       "paperless_idempotency_keys",
+		Columns:    PaperlessIdempotencyKeysColumns,
+		PrimaryKey: []*schema.Column{PaperlessIdempotencyKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idempotencykey_tenant_id_user_id_key",
+				Unique:  true,
+				Columns: []*schema.Column{PaperlessIdempotencyKeysColumns[4], PaperlessIdempotencyKeysColumns[5], PaperlessIdempotencyKeysColumns[6]},
+			},
+			{
+				Name:    "idempotencykey_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{PaperlessIdempotencyKeysColumns[11]},
+			},
+		},
+	}
+	// PaperlessImportConnectorsColumns holds the columns for the "paperless_import_connectors" table.
+	PaperlessImportConnectorsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "UUID primary key"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "name", Type: field.TypeString, Size: 255, Comment: "Display name"},
+		{Name: "provider", Type: field.TypeEnum, Comment: "Remote file service", Enums: []string{"IMPORT_PROVIDER_GOOGLE_DRIVE", "IMPORT_PROVIDER_SHAREPOINT"}},
+		{Name: "credentials", Type: field.TypeString, Size: 2147483647, Comment: "Google service account key or Microsoft Entra client secret"},
+		{Name: "config", Type: field.TypeJSON, Nullable: true, Comment: "Provider settings, e.g. the SharePoint drive"},
+		{Name: "enabled", Type: field.TypeBool, Comment: "Whether the connector's folders are synced", Default: true},
+	}
+	// PaperlessImportConnectorsTable holds the schema information for the "paperless_import_connectors" table.
+	PaperlessImportConnectorsTable = &schema.Table{
+		Name:       "paperless_import_connectors",
+		Columns:    PaperlessImportConnectorsColumns,
+		PrimaryKey: []*schema.Column{PaperlessImportConnectorsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "importconnector_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{PaperlessImportConnectorsColumns[5]},
+			},
+		},
+	}
+	// PaperlessImportMappingsColumns holds the columns for the "paperless_import_mappings" table.
+	PaperlessImportMappingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "UUID primary key"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "remote_folder_id", Type: field.TypeString, Size: 512, Comment: "ID of the remote folder"},
+		{Name: "remote_folder_name", Type: field.TypeString, Nullable: true, Size: 255, Comment: "Display name of the remote folder"},
+		{Name: "category_id", Type: field.TypeString, Nullable: true, Comment: "Category receiving the documents, null for root level"},
+		{Name: "include_subfolders", Type: field.TypeBool, Comment: "Also import the files of subfolders", Default: false},
+		{Name: "sync_interval_minutes", Type: field.TypeInt32, Comment: "Minutes between scheduled syncs, 0 for manual syncs only", Default: 0},
+		{Name: "enabled", Type: field.TypeBool, Comment: "Whether scheduled syncs run", Default: true},
+		{Name: "next_sync_at", Type: field.TypeTime, Nullable: true, Comment: "When the next sync is due, null when none is scheduled"},
+		{Name: "last_sync_status", Type: field.TypeEnum, Comment: "Outcome of the last sync", Enums: []string{"IMPORT_SYNC_STATUS_NEVER", "IMPORT_SYNC_STATUS_QUEUED", "IMPORT_SYNC_STATUS_RUNNING", "IMPORT_SYNC_STATUS_SUCCEEDED", "IMPORT_SYNC_STATUS_PARTIAL", "IMPORT_SYNC_STATUS_FAILED"}, Default: "IMPORT_SYNC_STATUS_NEVER"},
+		{Name: "last_sync_at", Type: field.TypeTime, Nullable: true, Comment: "When the last sync finished"},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Why the last sync or one of its files failed"},
+		{Name: "last_imported_count", Type: field.TypeInt32, Comment: "Files imported by the last sync", Default: 0},
+		{Name: "last_skipped_count", Type: field.TypeInt32, Comment: "Files the last sync skipped as already imported", Default: 0},
+		{Name: "last_failed_count", Type: field.TypeInt32, Comment: "Files the last sync failed to import", Default: 0},
+		{Name: "connector_id", Type: field.TypeString, Comment: "Connector the folder is read through"},
+	}
+	// PaperlessImportMappingsTable holds the schema information for the "paperless_import_mappings" table.
+	PaperlessImportMappingsTable = &schema.Table{
+		Name:       "paperless_import_mappings",
+		Columns:    PaperlessImportMappingsColumns,
+		PrimaryKey: []*schema.Column{PaperlessImportMappingsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "paperless_import_mappings_paperless_import_connectors_mappings",
+				Columns:    []*schema.Column{PaperlessImportMappingsColumns[19]},
+				RefColumns: []*schema.Column{PaperlessImportConnectorsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "importmapping_connector_id",
+				Unique:  false,
+				Columns: []*schema.Column{PaperlessImportMappingsColumns[19]},
+			},
+			{
+				Name:    "importmapping_enabled_next_sync_at",
+				Unique:  false,
+				Columns: []*schema.Column{PaperlessImportMappingsColumns[11], PaperlessImportMappingsColumns[12]},
+			},
+		},
+	}
+	// PaperlessImportedFilesColumns holds the columns for the "paperless_imported_files" table.
+	PaperlessImportedFilesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "remote_file_id", Type: field.TypeString, Size: 512, Comment: "ID of the remote file"},
+		{Name: "remote_version", Type: field.TypeString, Nullable: true, Size: 255, Comment: "Modification time or ETag of the imported revision"},
+		{Name: "document_id", Type: field.TypeString, Comment: "Document created from the file"},
+		{Name: "mapping_id", Type: field.TypeString, Comment: "Mapping that imported the file"},
+	}
+	// PaperlessImportedFilesTable holds the schema information for the "paperless_imported_files" table.
+	PaperlessImportedFilesTable = &schema.Table{
+		Name:       "paperless_imported_files",
+		Columns:    PaperlessImportedFilesColumns,
+		PrimaryKey: []*schema.Column{PaperlessImportedFilesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "paperless_imported_files_paperless_import_mappings_files",
+				Columns:    []*schema.Column{PaperlessImportedFilesColumns[8]},
+				RefColumns: []*schema.Column{PaperlessImportMappingsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "importedfile_mapping_id_remote_file_id",
+				Unique:  true,
+				Columns: []*schema.Column{PaperlessImportedFilesColumns[8], PaperlessImportedFilesColumns[5]},
+			},
+		},
+	}
+	// PaperlessNotificationPreferencesColumns holds the columns for the "paperless_notification_preferences" table.
+	PaperlessNotificationPreferencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "user_id", Type: field.TypeUint32, Comment: "User the preferences belong to"},
+		{Name: "share_enabled", Type: field.TypeBool, Comment: "Notify the user when something is shared with them", Default: true},
+		{Name: "mention_enabled", Type: field.TypeBool, Comment: "Notify the user when they are mentioned", Default: true},
+		{Name: "reminder_enabled", Type: field.TypeBool, Comment: "Remind the user of due dates of documents they own", Default: true},
+		{Name: "assignment_enabled", Type: field.TypeBool, Comment: "Notify the user when a document is assigned to them", Default: true},
+	}
+	// PaperlessNotificationPreferencesTable holds the schema information for the "paperless_notification_preferences" table.
+	PaperlessNotificationPreferencesTable = &schema.Table{
+		Name:       "paperless_notification_preferences",
+		Columns:    PaperlessNotificationPreferencesColumns,
+		PrimaryKey: []*schema.Column{PaperlessNotificationPreferencesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notificationpreference_tenant_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{PaperlessNotificationPreferencesColumns[4], PaperlessNotificationPreferencesColumns[5]},
+			},
+		},
+	}
+	// PaperlessEventOutboxColumns holds the columns for the "paperless_event_outbox" table.
+	PaperlessEventOutboxColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "event_id", Type: field.TypeString, Size: 64, Comment: "CloudEvents id"},
+		{Name: "event_type", Type: field.TypeString, Size: 64, Comment: "CloudEvents type"},
+		{Name: "subject", Type: field.TypeString, Nullable: true, Size: 64, Comment: "Resource the event is about, used as the broker message key"},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647, Comment: "CloudEvent encoded as JSON"},
+		{Name: "attempts", Type: field.TypeInt32, Comment: "Failed publish attempts so far", Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime, Comment: "When the event is due to be published"},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Why the last publish attempt failed"},
+	}
+	// PaperlessEventOutboxTable holds the schema information for the "paperless_event_outbox" table.
+	PaperlessEventOutboxTable = &schema.Table{
+		Name:       "paperless_event_outbox",
+		Columns:    PaperlessEventOutboxColumns,
+		PrimaryKey: []*schema.Column{PaperlessEventOutboxColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "outboxevent_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{PaperlessEventOutboxColumns[10]},
+			},
+		},
+	}
+	// PaperlessReviewTasksColumns holds the columns for the "paperless_review_tasks" table.
+	PaperlessReviewTasksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "UUID primary key"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "reviewer_id", Type: field.TypeUint32, Comment: "User asked to review the document"},
+		{Name: "status", Type: field.TypeEnum, Comment: "Status of the review", Enums: []string{"REVIEW_STATUS_PENDING", "REVIEW_STATUS_APPROVED", "REVIEW_STATUS_REJECTED", "REVIEW_STATUS_CANCELLED"}, Default: "REVIEW_STATUS_PENDING"},
+		{Name: "message", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Note of the requester to the reviewer"},
+		{Name: "comment", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "Comment of the reviewer on their decision"},
+		{Name: "reviewed_version", Type: field.TypeUint32, Nullable: true, Comment: "Version of the document the reviewer approved or rejected"},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true, Comment: "When the review was approved, rejected or cancelled"},
+		{Name: "completed_by", Type: field.TypeUint32, Nullable: true, Comment: "User who approved, rejected or cancelled the review"},
+		{Name: "document_id", Type: field.TypeString, Comment: "Document to review"},
+	}
+	// PaperlessReviewTasksTable holds the schema information for the "paperless_review_tasks" table.
+	PaperlessReviewTasksTable = &schema.Table{
+		Name:       "paperless_review_tasks",
+		Columns:    PaperlessReviewTasksColumns,
+		PrimaryKey: []*schema.Column{PaperlessReviewTasksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "paperless_review_tasks_paperless_documents_review_tasks",
+				Columns:    []*schema.Column{PaperlessReviewTasksColumns[13]},
+				RefColumns: []*schema.Column{PaperlessDocumentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reviewtask_document_id",
+				Unique:  false,
+				Columns: []*schema.Column{PaperlessReviewTasksColumns[13]},
+			},
+			{
+				Name:    "reviewtask_tenant_id_reviewer_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{PaperlessReviewTasksColumns[5], PaperlessReviewTasksColumns[6], PaperlessReviewTasksColumns[7]},
+			},
+		},
+	}
+	// PaperlessSettingsColumns holds the columns for the "paperless_settings" table.
+	PaperlessSettingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "name", Type: field.TypeString, Unique: true, Size: 128, Comment: "Setting name"},
+		{Name: "value", Type: field.TypeString, Comment: "Setting value"},
+	}
+	// PaperlessSettingsTable holds the schema information for the "paperless_settings" table.
+	PaperlessSettingsTable = &schema.Table{
+		Name:       "paperless_settings",
+		Columns:    PaperlessSettingsColumns,
+		PrimaryKey: []*schema.Column{PaperlessSettingsColumns[0]},
+	}
+	// PaperlessSignatureRequestsColumns holds the columns for the "paperless_signature_requests" table.
+	PaperlessSignatureRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "UUID primary key"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "provider", Type: field.TypeString, Size: 32, Comment: "E-signature provider, e.g. docusign"},
+		{Name: "envelope_id", Type: field.TypeString, Nullable: true, Size: 255, Comment: "ID of the envelope at the provider"},
+		{Name: "status", Type: field.TypeEnum, Comment: "Status of the envelope", Enums: []string{"SIGNATURE_STATUS_SENT", "SIGNATURE_STATUS_DELIVERED", "SIGNATURE_STATUS_COMPLETED", "SIGNATURE_STATUS_DECLINED", "SIGNATURE_STATUS_VOIDED", "SIGNATURE_STATUS_FAILED"}, Default: "SIGNATURE_STATUS_SENT"},
+		{Name: "signers", Type: field.TypeJSON, Comment: "Recipients who sign, in signing order"},
+		{Name: "subject", Type: field.TypeString, Size: 255, Comment: "Email subject sent to the signers"},
+		{Name: "message", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "Email message sent to the signers"},
+		{Name: "original_file_key", Type: field.TypeString, Comment: "Storage key of the unsigned file, kept after the signed PDF replaces it"},
+		{Name: "signed_file_key", Type: field.TypeString, Nullable: true, Comment: "Storage key of the signed PDF"},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true, Comment: "When the signed PDF was attached"},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Why sending, voiding or attaching the signed PDF failed"},
+		{Name: "document_id", Type: field.TypeString, Comment: "Document being signed"},
+	}
+	// PaperlessSignatureRequestsTable holds the schema information for the "paperless_signature_requests" table.
+	PaperlessSignatureRequestsTable = &schema.Table{
+		Name:       "paperless_signature_requests",
+		Columns:    PaperlessSignatureRequestsColumns,
+		PrimaryKey: []*schema.Column{PaperlessSignatureRequestsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "paperless_signature_requests_paperless_documents_signature_requests",
+				Columns:    []*schema.Column{PaperlessSignatureRequestsColumns[16]},
+				RefColumns: []*schema.Column{PaperlessDocumentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "signaturerequest_document_id",
+				Unique:  false,
+				Columns: []*schema.Column{PaperlessSignatureRequestsColumns[16]},
+			},
+			{
+				Name:    "signaturerequest_provider_envelope_id",
+				Unique:  false,
+				Columns: []*schema.Column{PaperlessSignatureRequestsColumns[6], PaperlessSignatureRequestsColumns[7]},
+			},
+		},
+	}
+	// PaperlessStatisticsRollupsColumns holds the columns for the "paperless_statistics_rollups" table.
+	PaperlessStatisticsRollupsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "all_tenants", Type: field.TypeBool, Comment: "Whether the statistics cover all tenants instead of tenant_id", Default: false},
+		{Name: "statistics", Type: field.TypeBytes, Comment: "Protobuf-encoded GetStatisticsResponse, with the most top tags a request may ask for"},
+		{Name: "generated_at", Type: field.TypeTime, Comment: "When the statistics were computed"},
+	}
+	// PaperlessStatisticsRollupsTable holds the schema information for the "paperless_statistics_rollups" table.
+	PaperlessStatisticsRollupsTable = &schema.Table{
+		Name:       "paperless_statistics_rollups",
+		Columns:    PaperlessStatisticsRollupsColumns,
+		PrimaryKey: []*schema.Column{PaperlessStatisticsRollupsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "statisticsrollup_tenant_id_all_tenants",
+				Unique:  true,
+				Columns: []*schema.Column{PaperlessStatisticsRollupsColumns[4], PaperlessStatisticsRollupsColumns[5]},
+			},
+		},
+	}
+	// PaperlessTagsColumns holds the columns for the "paperless_tags" table.
+	PaperlessTagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "name", Type: field.TypeString, Size: 255, Comment: "Tag name, the key in a document's tags"},
+		{Name: "color", Type: field.TypeString, Nullable: true, Size: 7, Comment: "Display color as #RRGGBB"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Tag description"},
+	}
+	// PaperlessTagsTable holds the schema information for the "paperless_tags" table.
+	PaperlessTagsTable = &schema.Table{
+		Name:       "paperless_tags",
+		Columns:    PaperlessTagsColumns,
+		PrimaryKey: []*schema.Column{PaperlessTagsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tag_tenant_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{PaperlessTagsColumns[5], PaperlessTagsColumns[6]},
+			},
+		},
+	}
+	// PaperlessTenantDeleteJobsColumns holds the columns for the "paperless_tenant_delete_jobs" table.
+	PaperlessTenantDeleteJobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "UUID primary key"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Comment: "Tenant whose data is deleted"},
+		{Name: "status", Type: field.TypeEnum, Comment: "Pending until a worker picks the job up, running until it is done", Enums: []string{"TENANT_DELETE_JOB_STATUS_PENDING", "TENANT_DELETE_JOB_STATUS_RUNNING", "TENANT_DELETE_JOB_STATUS_SUCCEEDED", "TENANT_DELETE_JOB_STATUS_FAILED"}, Default: "TENANT_DELETE_JOB_STATUS_PENDING"},
+		{Name: "stage", Type: field.TypeString, Nullable: true, Size: 64, Comment: "Kind of data the job is deleting"},
+		{Name: "total_items", Type: field.TypeInt64, Comment: "Records to delete, counted when the job was queued", Default: 0},
+		{Name: "processed_items", Type: field.TypeInt64, Comment: "Records deleted so far", Default: 0},
+		{Name: "documents_deleted", Type: field.TypeInt64, Comment: "Documents deleted so far, trashed ones included", Default: 0},
+		{Name: "categories_deleted", Type: field.TypeInt64, Comment: "Categories deleted so far", Default: 0},
+		{Name: "permissions_deleted", Type: field.TypeInt64, Comment: "Permission grants deleted so far", Default: 0},
+		{Name: "records_deleted", Type: field.TypeInt64, Comment: "Other records, such as tags, history and audit entries, deleted so far", Default: 0},
+		{Name: "objects_deleted", Type: field.TypeInt64, Comment: "Storage objects deleted so far", Default: 0},
+		{Name: "bytes_deleted", Type: field.TypeInt64, Comment: "Size of the storage objects deleted so far", Default: 0},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Why the job failed"},
+		{Name: "lease_until", Type: field.TypeTime, Nullable: true, Comment: "Until when the running worker holds the job; another worker resumes it afterwards"},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true, Comment: "When the job succeeded or failed"},
+	}
+	// PaperlessTenantDeleteJobsTable holds the schema information for the "paperless_tenant_delete_jobs" table.
+	PaperlessTenantDeleteJobsTable = &schema.Table{
+		Name:       "paperless_tenant_delete_jobs",
+		Columns:    PaperlessTenantDeleteJobsColumns,
+		PrimaryKey: []*schema.Column{PaperlessTenantDeleteJobsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tenantdeletejob_status_lease_until",
+				Unique:  false,
+				Columns: []*schema.Column{PaperlessTenantDeleteJobsColumns[6], PaperlessTenantDeleteJobsColumns[17]},
+			},
+			{
+				Name:    "tenantdeletejob_tenant_id_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{PaperlessTenantDeleteJobsColumns[5], PaperlessTenantDeleteJobsColumns[2]},
+			},
+		},
+	}
+	// PaperlessTenantKeysColumns holds the columns for the "paperless_tenant_keys" table.
+	PaperlessTenantKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "wrapped_key", Type: field.TypeBytes, Comment: "Data key encrypted with the master key"},
+		{Name: "master_key_id", Type: field.TypeString, Size: 255, Comment: "Identifier of the master key that wrapped the data key"},
+	}
+	// PaperlessTenantKeysTable holds the schema information for the "paperless_tenant_keys" table.
+	PaperlessTenantKeysTable = &schema.Table{
+		Name:       "paperless_tenant_keys",
+		Columns:    PaperlessTenantKeysColumns,
+		PrimaryKey: []*schema.Column{PaperlessTenantKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tenantkey_tenant_id",
+				Unique:  true,
+				Columns: []*schema.Column{PaperlessTenantKeysColumns[4]},
+			},
+		},
+	}
+	// PaperlessTenantQuotasColumns holds the columns for the "paperless_tenant_quotas" table.
+	PaperlessTenantQuotasColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "max_documents", Type: field.TypeInt64, Nullable: true, Comment: "Most documents the tenant may hold (null for no limit)"},
+		{Name: "max_bytes", Type: field.TypeInt64, Nullable: true, Comment: "Most bytes the tenant's documents may take (null for no limit)"},
+		{Name: "max_monthly_upload_bytes", Type: field.TypeInt64, Nullable: true, Comment: "Most bytes the tenant may upload per calendar month (null for no limit)"},
+		{Name: "upload_month", Type: field.TypeString, Nullable: true, Size: 7, Comment: "Month the upload counter refers to, e.g. 2026-10 (UTC)"},
+		{Name: "month_upload_bytes", Type: field.TypeInt64, Comment: "Bytes uploaded in upload_month", Default: 0},
+	}
+	// PaperlessTenantQuotasTable holds the schema information for the "paperless_tenant_quotas" table.
+	PaperlessTenantQuotasTable = &schema.Table{
+		Name:       "paperless_tenant_quotas",
+		Columns:    PaperlessTenantQuotasColumns,
+		PrimaryKey: []*schema.Column{PaperlessTenantQuotasColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tenantquota_tenant_id",
+				Unique:  true,
+				Columns: []*schema.Column{PaperlessTenantQuotasColumns[4]},
+			},
+		},
+	}
+	// PaperlessTenantSettingsColumns holds the columns for the "paperless_tenant_settings" table.
+	PaperlessTenantSettingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "ocr_language", Type: field.TypeString, Nullable: true, Size: 64, Comment: "Tesseract languages used for OCR, e.g. eng+deu"},
+		{Name: "disabled_processing_steps", Type: field.TypeJSON, Nullable: true, Comment: "Processing steps skipped for the tenant's documents"},
+		{Name: "allowed_mime_types", Type: field.TypeJSON, Nullable: true, Comment: "MIME types accepted for uploads, e.g. application/pdf or image/* (empty accepts all)"},
+		{Name: "default_retention_class", Type: field.TypeString, Nullable: true, Size: 64, Comment: "Retention class of new documents that get none from their category"},
+		{Name: "storage_backend", Type: field.TypeString, Nullable: true, Size: 64, Comment: "Storage backend receiving the tenant's uploads (empty follows the active backend)"},
+	}
+	// PaperlessTenantSettingsTable holds the schema information for the "paperless_tenant_settings" table.
+	PaperlessTenantSettingsTable = &schema.Table{
+		Name:       "paperless_tenant_settings",
+		Columns:    PaperlessTenantSettingsColumns,
+		PrimaryKey: []*schema.Column{PaperlessTenantSettingsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tenantsettings_tenant_id",
+				Unique:  true,
+				Columns: []*schema.Column{PaperlessTenantSettingsColumns[4]},
+			},
+		},
+	}
+	// PaperlessWebhookDeliveriesColumns holds the columns for the "paperless_webhook_deliveries" table.
+	PaperlessWebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "event_id", Type: field.TypeString, Size: 64, Comment: "ID of the delivered event"},
+		{Name: "event_type", Type: field.TypeString, Size: 64, Comment: "Type of the delivered event"},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647, Comment: "JSON request body"},
+		{Name: "status", Type: field.TypeEnum, Comment: "Pending until delivered or out of attempts", Enums: []string{"WEBHOOK_DELIVERY_STATUS_PENDING", "WEBHOOK_DELIVERY_STATUS_SUCCEEDED", "WEBHOOK_DELIVERY_STATUS_FAILED"}, Default: "WEBHOOK_DELIVERY_STATUS_PENDING"},
+		{Name: "attempts", Type: field.TypeInt32, Comment: "Delivery attempts so far", Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime, Nullable: true, Comment: "When the next attempt is due, null once the delivery is finished"},
+		{Name: "response_status", Type: field.TypeInt32, Nullable: true, Comment: "HTTP status of the last attempt, 0 if no response was received"},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "Why the last attempt failed"},
+		{Name: "duration_ms", Type: field.TypeInt64, Nullable: true, Comment: "Duration of the last attempt"},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true, Comment: "When the delivery succeeded"},
+		{Name: "subscription_id", Type: field.TypeString, Comment: "Subscription the event is delivered to"},
+	}
+	// PaperlessWebhookDeliveriesTable holds the schema information for the "paperless_webhook_deliveries" table.
+	PaperlessWebhookDeliveriesTable = &schema.Table{
+		Name:       "paperless_webhook_deliveries",
+		Columns:    PaperlessWebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{PaperlessWebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "paperless_webhook_deliveries_paperless_webhook_subscriptions_deliveries",
+				Columns:    []*schema.Column{PaperlessWebhookDeliveriesColumns[15]},
+				RefColumns: []*schema.Column{PaperlessWebhookSubscriptionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_subscription_id_event_id",
+				Unique:  true,
+				Columns: []*schema.Column{PaperlessWebhookDeliveriesColumns[15], PaperlessWebhookDeliveriesColumns[5]},
+			},
+			{
+				Name:    "webhookdelivery_subscription_id_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{PaperlessWebhookDeliveriesColumns[15], PaperlessWebhookDeliveriesColumns[1]},
+			},
+			{
+				Name:    "webhookdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{PaperlessWebhookDeliveriesColumns[8], PaperlessWebhookDeliveriesColumns[10]},
+			},
+			{
+				Name:    "webhookdelivery_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{PaperlessWebhookDeliveriesColumns[1]},
+			},
+		},
+	}
+	// PaperlessWebhookSubscriptionsColumns holds the columns for the "paperless_webhook_subscriptions" table.
+	PaperlessWebhookSubscriptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "UUID primary key"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "name", Type: field.TypeString, Size: 255, Comment: "Display name"},
+		{Name: "url", Type: field.TypeString, Size: 2048, Comment: "Endpoint receiving the deliveries"},
+		{Name: "secret", Type: field.TypeString, Comment: "HMAC-SHA256 key signing the deliveries"},
+		{Name: "event_types", Type: field.TypeJSON, Nullable: true, Comment: "Event types to deliver; all events when empty"},
+		{Name: "enabled", Type: field.TypeBool, Comment: "Whether events are delivered", Default: true},
+	}
+	// PaperlessWebhookSubscriptionsTable holds the schema information for the "paperless_webhook_subscriptions" table.
+	PaperlessWebhookSubscriptionsTable = &schema.Table{
+		Name:       "paperless_webhook_subscriptions",
+		Columns:    PaperlessWebhookSubscriptionsColumns,
+		PrimaryKey: []*schema.Column{PaperlessWebhookSubscriptionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhooksubscription_tenant_id_enabled",
+				Unique:  false,
+				Columns: []*schema.Column{PaperlessWebhookSubscriptionsColumns[5], PaperlessWebhookSubscriptionsColumns[10]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		PaperlessAccessibleResourcesTable,
+		PaperlessAuditEventsTable,
 		PaperlessAuditLogsTable,
 		PaperlessCategoriesTable,
+		PaperlessCategoryDeleteJobsTable,
 		PaperlessDocumentsTable,
+		PaperlessDocumentContentsTable,
+		PaperlessDocumentHistoryTable,
 		PaperlessPermissionsTable,
+		PaperlessDocumentTagsTable,
+		PaperlessGroupMembershipsTable,
+		PaperlessIdempotencyKeysTable,
+		PaperlessImportConnectorsTable,
+		PaperlessImportMappingsTable,
+		PaperlessImportedFilesTable,
+		PaperlessNotificationPreferencesTable,
+		PaperlessEventOutboxTable,
+		PaperlessReviewTasksTable,
+		PaperlessSettingsTable,
+		PaperlessSignatureRequestsTable,
+		PaperlessStatisticsRollupsTable,
+		PaperlessTagsTable,
+		PaperlessTenantDeleteJobsTable,
+		PaperlessTenantKeysTable,
+		PaperlessTenantQuotasTable,
+		PaperlessTenantSettingsTable,
+		PaperlessWebhookDeliveriesTable,
+		PaperlessWebhookSubscriptionsTable,
 	}
 )
 
 func init() {
+	PaperlessAccessibleResourcesTable.Annotation = &entsql.Annotation{
+		Table: "paperless_accessible_resources",
+	}
+	PaperlessAuditEventsTable.Annotation = &entsql.Annotation{
+		Table: "paperless_audit_events",
+	}
 	PaperlessAuditLogsTable.Annotation = &entsql.Annotation{
 		Table: "paperless_audit_logs",
 	}
@@ -300,13 +1172,87 @@ func init() {
 	PaperlessCategoriesTable.Annotation = &entsql.Annotation{
 		Table: "paperless_categories",
 	}
+	PaperlessCategoryDeleteJobsTable.Annotation = &entsql.Annotation{
+		Table: "paperless_category_delete_jobs",
+	}
 	PaperlessDocumentsTable.ForeignKeys[0].RefTable = PaperlessCategoriesTable
 	PaperlessDocumentsTable.Annotation = &entsql.Annotation{
 		Table: "paperless_documents",
 	}
+	PaperlessDocumentContentsTable.ForeignKeys[0].RefTable = PaperlessDocumentsTable
+	PaperlessDocumentContentsTable.Annotation = &entsql.Annotation{
+		Table: "paperless_document_contents",
+	}
+	PaperlessDocumentHistoryTable.Annotation = &entsql.Annotation{
+		Table: "paperless_document_history",
+	}
 	PaperlessPermissionsTable.ForeignKeys[0].RefTable = PaperlessCategoriesTable
 	PaperlessPermissionsTable.ForeignKeys[1].RefTable = PaperlessDocumentsTable
 	PaperlessPermissionsTable.Annotation = &entsql.Annotation{
 		Table: "paperless_permissions",
 	}
+	PaperlessDocumentTagsTable.ForeignKeys[0].RefTable = PaperlessDocumentsTable
+	PaperlessDocumentTagsTable.ForeignKeys[1].RefTable = PaperlessTagsTable
+	PaperlessDocumentTagsTable.Annotation = &entsql.Annotation{
+		Table: "paperless_document_tags",
+	}
+	PaperlessGroupMembershipsTable.Annotation = &entsql.Annotation{
+		Table: "paperless_group_memberships",
+	}
+	PaperlessIdempotencyKeysTable.Annotation = &entsql.Annotation{
+		Table: "paperless_idempotency_keys",
+	}
+	PaperlessImportConnectorsTable.Annotation = &entsql.Annotation{
+		Table: "paperless_import_connectors",
+	}
+	PaperlessImportMappingsTable.ForeignKeys[0].RefTable = PaperlessImportConnectorsTable
+	PaperlessImportMappingsTable.Annotation = &entsql.Annotation{
+		Table: "paperless_import_mappings",
+	}
+	PaperlessImportedFilesTable.ForeignKeys[0].RefTable = PaperlessImportMappingsTable
+	PaperlessImportedFilesTable.Annotation = &entsql.Annotation{
+		Table: "paperless_imported_files",
+	}
+	PaperlessNotificationPreferencesTable.Annotation = &entsql.Annotation{
+		Table: "paperless_notification_preferences",
+	}
+	PaperlessEventOutboxTable.Annotation = &entsql.Annotation{
+		Table: "paperless_event_outbox",
+	}
+	PaperlessReviewTasksTable.ForeignKeys[0].RefTable = PaperlessDocumentsTable
+	PaperlessReviewTasksTable.Annotation = &entsql.Annotation{
+		Table: "paperless_review_tasks",
+	}
+	PaperlessSettingsTable.Annotation = &entsql.Annotation{
+		Table: "paperless_settings",
+	}
+	PaperlessSignatureRequestsTable.ForeignKeys[0].RefTable = PaperlessDocumentsTable
+	PaperlessSignatureRequestsTable.Annotation = &entsql.Annotation{
+		Table: "paperless_signature_requests",
+	}
+	PaperlessStatisticsRollupsTable.Annotation = &entsql.Annotation{
+		Table: "paperless_statistics_rollups",
+	}
+	PaperlessTagsTable.Annotation = &entsql.Annotation{
+		Table: "paperless_tags",
+	}
+	PaperlessTenantDeleteJobsTable.Annotation = &entsql.Annotation{
+		Table: "paperless_tenant_delete_jobs",
+	}
+	PaperlessTenantKeysTable.Annotation = &entsql.Annotation{
+		Table: "paperless_tenant_keys",
+	}
+	PaperlessTenantQuotasTable.Annotation = &entsql.Annotation{
+		Table: "paperless_tenant_quotas",
+	}
+	PaperlessTenantSettingsTable.Annotation = &entsql.Annotation{
+		Table: "paperless_tenant_settings",
+	}
+	PaperlessWebhookDeliveriesTable.ForeignKeys[0].RefTable = PaperlessWebhookSubscriptionsTable
+	PaperlessWebhookDeliveriesTable.Annotation = &entsql.Annotation{
+		Table: "paperless_webhook_deliveries",
+	}
+	PaperlessWebhookSubscriptionsTable.Annotation = &entsql.Annotation{
+		Table: "paperless_webhook_subscriptions",
+	}
 }
@@ -0,0 +1,134 @@
+// Code generated by ent, DO NOT EDIT.
+
+package notificationpreference
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the notificationpreference type in the database.
+	Label = "notification_preference"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldDeleteTime holds the string denoting the delete_time field in the database.
+	FieldDeleteTime = "delete_time"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldShareEnabled holds the string denoting the share_enabled field in the database.
+	FieldShareEnabled = "share_enabled"
+	// FieldMentionEnabled holds the string denoting the mention_enabled field in the database.
+	FieldMentionEnabled = "mention_enabled"
+	// FieldReminderEnabled holds the string denoting the reminder_enabled field in the database.
+	FieldReminderEnabled = "reminder_enabled"
+	// FieldAssignmentEnabled holds the string denoting the assignment_enabled field in the database.
+	FieldAssignmentEnabled = "assignment_enabled"
+	// Table holds the table name of the notificationpreference in the database.
+	Table = "paperless_notification_preferences"
+)
+
+// Columns holds all SQL columns for notificationpreference fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldDeleteTime,
+	FieldTenantID,
+	FieldUserID,
+	FieldShareEnabled,
+	FieldMentionEnabled,
+	FieldReminderEnabled,
+	FieldAssignmentEnabled,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/go-tangra/go-tangra-paperless/internal/data/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint32
+	// DefaultShareEnabled holds the default value on creation for the "share_enabled" field.
+	DefaultShareEnabled bool
+	// DefaultMentionEnabled holds the default value on creation for the "mention_enabled" field.
+	DefaultMentionEnabled bool
+	// DefaultReminderEnabled holds the default value on creation for the "reminder_enabled" field.
+	DefaultReminderEnabled bool
+	// DefaultAssignmentEnabled holds the default value on creation for the "assignment_enabled" field.
+	DefaultAssignmentEnabled bool
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(uint32) error
+)
+
+// OrderOption defines the ordering options for the NotificationPreference queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByDeleteTime orders the results by the delete_time field.
+func ByDeleteTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeleteTime, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByShareEnabled orders the results by the share_enabled field.
+func ByShareEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShareEnabled, opts...).ToFunc()
+}
+
+// ByMentionEnabled orders the results by the mention_enabled field.
+func ByMentionEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMentionEnabled, opts...).ToFunc()
+}
+
+// ByReminderEnabled orders the results by the reminder_enabled field.
+func ByReminderEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReminderEnabled, opts...).ToFunc()
+}
+
+// ByAssignmentEnabled orders the results by the assignment_enabled field.
+func ByAssignmentEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssignmentEnabled, opts...).ToFunc()
+}
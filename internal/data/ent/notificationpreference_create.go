@@ -0,0 +1,987 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/notificationpreference"
+)
+
+// NotificationPreferenceCreate is the builder for creating a NotificationPreference entity.
+type NotificationPreferenceCreate struct {
+	config
+	mutation *NotificationPreferenceMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *NotificationPreferenceCreate) SetCreateTime(v time.Time) *NotificationPreferenceCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *NotificationPreferenceCreate) SetNillableCreateTime(v *time.Time) *NotificationPreferenceCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *NotificationPreferenceCreate) SetUpdateTime(v time.Time) *NotificationPreferenceCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *NotificationPreferenceCreate) SetNillableUpdateTime(v *time.Time) *NotificationPreferenceCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *NotificationPreferenceCreate) SetDeleteTime(v time.Time) *NotificationPreferenceCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *NotificationPreferenceCreate) SetNillableDeleteTime(v *time.Time) *NotificationPreferenceCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *NotificationPreferenceCreate) SetTenantID(v uint32) *NotificationPreferenceCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *NotificationPreferenceCreate) SetNillableTenantID(v *uint32) *NotificationPreferenceCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *NotificationPreferenceCreate) SetUserID(v uint32) *NotificationPreferenceCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetShareEnabled sets the "share_enabled" field.
+func (_c *NotificationPreferenceCreate) SetShareEnabled(v bool) *NotificationPreferenceCreate {
+	_c.mutation.SetShareEnabled(v)
+	return _c
+}
+
+// SetNillableShareEnabled sets the "share_enabled" field if the given value is not nil.
+func (_c *NotificationPreferenceCreate) SetNillableShareEnabled(v *bool) *NotificationPreferenceCreate {
+	if v != nil {
+		_c.SetShareEnabled(*v)
+	}
+	return _c
+}
+
+// SetMentionEnabled sets the "mention_enabled" field.
+func (_c *NotificationPreferenceCreate) SetMentionEnabled(v bool) *NotificationPreferenceCreate {
+	_c.mutation.SetMentionEnabled(v)
+	return _c
+}
+
+// SetNillableMentionEnabled sets the "mention_enabled" field if the given value is not nil.
+func (_c *NotificationPreferenceCreate) SetNillableMentionEnabled(v *bool) *NotificationPreferenceCreate {
+	if v != nil {
+		_c.SetMentionEnabled(*v)
+	}
+	return _c
+}
+
+// SetReminderEnabled sets the "reminder_enabled" field.
+func (_c *NotificationPreferenceCreate) SetReminderEnabled(v bool) *NotificationPreferenceCreate {
+	_c.mutation.SetReminderEnabled(v)
+	return _c
+}
+
+// SetNillableReminderEnabled sets the "reminder_enabled" field if the given value is not nil.
+func (_c *NotificationPreferenceCreate) SetNillableReminderEnabled(v *bool) *NotificationPreferenceCreate {
+	if v != nil {
+		_c.SetReminderEnabled(*v)
+	}
+	return _c
+}
+
+// SetAssignmentEnabled sets the "assignment_enabled" field.
+func (_c *NotificationPreferenceCreate) SetAssignmentEnabled(v bool) *NotificationPreferenceCreate {
+	_c.mutation.SetAssignmentEnabled(v)
+	return _c
+}
+
+// SetNillableAssignmentEnabled sets the "assignment_enabled" field if the given value is not nil.
+func (_c *NotificationPreferenceCreate) SetNillableAssignmentEnabled(v *bool) *NotificationPreferenceCreate {
+	if v != nil {
+		_c.SetAssignmentEnabled(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *NotificationPreferenceCreate) SetID(v uint32) *NotificationPreferenceCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the NotificationPreferenceMutation object of the builder.
+func (_c *NotificationPreferenceCreate) Mutation() *NotificationPreferenceMutation {
+	return _c.mutation
+}
+
+// Save creates the NotificationPreference in the database.
+func (_c *NotificationPreferenceCreate) Save(ctx context.Context) (*NotificationPreference, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *NotificationPreferenceCreate) SaveX(ctx context.Context) *NotificationPreference {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NotificationPreferenceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NotificationPreferenceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *NotificationPreferenceCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := notificationpreference.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.ShareEnabled(); !ok {
+		v := notificationpreference.DefaultShareEnabled
+		_c.mutation.SetShareEnabled(v)
+	}
+	if _, ok := _c.mutation.MentionEnabled(); !ok {
+		v := notificationpreference.DefaultMentionEnabled
+		_c.mutation.SetMentionEnabled(v)
+	}
+	if _, ok := _c.mutation.ReminderEnabled(); !ok {
+		v := notificationpreference.DefaultReminderEnabled
+		_c.mutation.SetReminderEnabled(v)
+	}
+	if _, ok := _c.mutation.AssignmentEnabled(); !ok {
+		v := notificationpreference.DefaultAssignmentEnabled
+		_c.mutation.SetAssignmentEnabled(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *NotificationPreferenceCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "NotificationPreference.user_id"`)}
+	}
+	if _, ok := _c.mutation.ShareEnabled(); !ok {
+		return &ValidationError{Name: "share_enabled", err: errors.New(`ent: missing required field "NotificationPreference.share_enabled"`)}
+	}
+	if _, ok := _c.mutation.MentionEnabled(); !ok {
+		return &ValidationError{Name: "mention_enabled", err: errors.New(`ent: missing required field "NotificationPreference.mention_enabled"`)}
+	}
+	if _, ok := _c.mutation.ReminderEnabled(); !ok {
+		return &ValidationError{Name: "reminder_enabled", err: errors.New(`ent: missing required field "NotificationPreference.reminder_enabled"`)}
+	}
+	if _, ok := _c.mutation.AssignmentEnabled(); !ok {
+		return &ValidationError{Name: "assignment_enabled", err: errors.New(`ent: missing required field "NotificationPreference.assignment_enabled"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := notificationpreference.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *NotificationPreferenceCreate) sqlSave(ctx context.Context) (*NotificationPreference, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *NotificationPreferenceCreate) createSpec() (*NotificationPreference, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NotificationPreference{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(notificationpreference.Table, sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(notificationpreference.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(notificationpreference.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(notificationpreference.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(notificationpreference.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(notificationpreference.FieldUserID, field.TypeUint32, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.ShareEnabled(); ok {
+		_spec.SetField(notificationpreference.FieldShareEnabled, field.TypeBool, value)
+		_node.ShareEnabled = value
+	}
+	if value, ok := _c.mutation.MentionEnabled(); ok {
+		_spec.SetField(notificationpreference.FieldMentionEnabled, field.TypeBool, value)
+		_node.MentionEnabled = value
+	}
+	if value, ok := _c.mutation.ReminderEnabled(); ok {
+		_spec.SetField(notificationpreference.FieldReminderEnabled, field.TypeBool, value)
+		_node.ReminderEnabled = value
+	}
+	if value, ok := _c.mutation.AssignmentEnabled(); ok {
+		_spec.SetField(notificationpreference.FieldAssignmentEnabled, field.TypeBool, value)
+		_node.AssignmentEnabled = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NotificationPreference.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NotificationPreferenceUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *NotificationPreferenceCreate) OnConflict(opts ...sql.ConflictOption) *NotificationPreferenceUpsertOne {
+	_c.conflict = opts
+	return &NotificationPreferenceUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NotificationPreference.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *NotificationPreferenceCreate) OnConflictColumns(columns ...string) *NotificationPreferenceUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &NotificationPreferenceUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// NotificationPreferenceUpsertOne is the builder for "upsert"-ing
+	//  one NotificationPreference node.
+	NotificationPreferenceUpsertOne struct {
+		create *NotificationPreferenceCreate
+	}
+
+	// NotificationPreferenceUpsert is the "OnConflict" setter.
+	NotificationPreferenceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *NotificationPreferenceUpsert) SetUpdateTime(v time.Time) *NotificationPreferenceUpsert {
+	u.Set(notificationpreference.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsert) UpdateUpdateTime() *NotificationPreferenceUpsert {
+	u.SetExcluded(notificationpreference.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *NotificationPreferenceUpsert) ClearUpdateTime() *NotificationPreferenceUpsert {
+	u.SetNull(notificationpreference.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *NotificationPreferenceUpsert) SetDeleteTime(v time.Time) *NotificationPreferenceUpsert {
+	u.Set(notificationpreference.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsert) UpdateDeleteTime() *NotificationPreferenceUpsert {
+	u.SetExcluded(notificationpreference.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *NotificationPreferenceUpsert) ClearDeleteTime() *NotificationPreferenceUpsert {
+	u.SetNull(notificationpreference.FieldDeleteTime)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *NotificationPreferenceUpsert) SetUserID(v uint32) *NotificationPreferenceUpsert {
+	u.Set(notificationpreference.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsert) UpdateUserID() *NotificationPreferenceUpsert {
+	u.SetExcluded(notificationpreference.FieldUserID)
+	return u
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *NotificationPreferenceUpsert) AddUserID(v uint32) *NotificationPreferenceUpsert {
+	u.Add(notificationpreference.FieldUserID, v)
+	return u
+}
+
+// SetShareEnabled sets the "share_enabled" field.
+func (u *NotificationPreferenceUpsert) SetShareEnabled(v bool) *NotificationPreferenceUpsert {
+	u.Set(notificationpreference.FieldShareEnabled, v)
+	return u
+}
+
+// UpdateShareEnabled sets the "share_enabled" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsert) UpdateShareEnabled() *NotificationPreferenceUpsert {
+	u.SetExcluded(notificationpreference.FieldShareEnabled)
+	return u
+}
+
+// SetMentionEnabled sets the "mention_enabled" field.
+func (u *NotificationPreferenceUpsert) SetMentionEnabled(v bool) *NotificationPreferenceUpsert {
+	u.Set(notificationpreference.FieldMentionEnabled, v)
+	return u
+}
+
+// UpdateMentionEnabled sets the "mention_enabled" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsert) UpdateMentionEnabled() *NotificationPreferenceUpsert {
+	u.SetExcluded(notificationpreference.FieldMentionEnabled)
+	return u
+}
+
+// SetReminderEnabled sets the "reminder_enabled" field.
+func (u *NotificationPreferenceUpsert) SetReminderEnabled(v bool) *NotificationPreferenceUpsert {
+	u.Set(notificationpreference.FieldReminderEnabled, v)
+	return u
+}
+
+// UpdateReminderEnabled sets the "reminder_enabled" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsert) UpdateReminderEnabled() *NotificationPreferenceUpsert {
+	u.SetExcluded(notificationpreference.FieldReminderEnabled)
+	return u
+}
+
+// SetAssignmentEnabled sets the "assignment_enabled" field.
+func (u *NotificationPreferenceUpsert) SetAssignmentEnabled(v bool) *NotificationPreferenceUpsert {
+	u.Set(notificationpreference.FieldAssignmentEnabled, v)
+	return u
+}
+
+// UpdateAssignmentEnabled sets the "assignment_enabled" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsert) UpdateAssignmentEnabled() *NotificationPreferenceUpsert {
+	u.SetExcluded(notificationpreference.FieldAssignmentEnabled)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.NotificationPreference.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(notificationpreference.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *NotificationPreferenceUpsertOne) UpdateNewValues() *NotificationPreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(notificationpreference.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(notificationpreference.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(notificationpreference.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NotificationPreference.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *NotificationPreferenceUpsertOne) Ignore() *NotificationPreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NotificationPreferenceUpsertOne) DoNothing() *NotificationPreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NotificationPreferenceCreate.OnConflict
+// documentation for more info.
+func (u *NotificationPreferenceUpsertOne) Update(set func(*NotificationPreferenceUpsert)) *NotificationPreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NotificationPreferenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *NotificationPreferenceUpsertOne) SetUpdateTime(v time.Time) *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertOne) UpdateUpdateTime() *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *NotificationPreferenceUpsertOne) ClearUpdateTime() *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *NotificationPreferenceUpsertOne) SetDeleteTime(v time.Time) *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertOne) UpdateDeleteTime() *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *NotificationPreferenceUpsertOne) ClearDeleteTime() *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *NotificationPreferenceUpsertOne) SetUserID(v uint32) *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *NotificationPreferenceUpsertOne) AddUserID(v uint32) *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertOne) UpdateUserID() *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetShareEnabled sets the "share_enabled" field.
+func (u *NotificationPreferenceUpsertOne) SetShareEnabled(v bool) *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetShareEnabled(v)
+	})
+}
+
+// UpdateShareEnabled sets the "share_enabled" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertOne) UpdateShareEnabled() *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateShareEnabled()
+	})
+}
+
+// SetMentionEnabled sets the "mention_enabled" field.
+func (u *NotificationPreferenceUpsertOne) SetMentionEnabled(v bool) *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetMentionEnabled(v)
+	})
+}
+
+// UpdateMentionEnabled sets the "mention_enabled" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertOne) UpdateMentionEnabled() *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateMentionEnabled()
+	})
+}
+
+// SetReminderEnabled sets the "reminder_enabled" field.
+func (u *NotificationPreferenceUpsertOne) SetReminderEnabled(v bool) *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetReminderEnabled(v)
+	})
+}
+
+// UpdateReminderEnabled sets the "reminder_enabled" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertOne) UpdateReminderEnabled() *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateReminderEnabled()
+	})
+}
+
+// SetAssignmentEnabled sets the "assignment_enabled" field.
+func (u *NotificationPreferenceUpsertOne) SetAssignmentEnabled(v bool) *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetAssignmentEnabled(v)
+	})
+}
+
+// UpdateAssignmentEnabled sets the "assignment_enabled" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertOne) UpdateAssignmentEnabled() *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateAssignmentEnabled()
+	})
+}
+
+// Exec executes the query.
+func (u *NotificationPreferenceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NotificationPreferenceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NotificationPreferenceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *NotificationPreferenceUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *NotificationPreferenceUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// NotificationPreferenceCreateBulk is the builder for creating many NotificationPreference entities in bulk.
+type NotificationPreferenceCreateBulk struct {
+	config
+	err      error
+	builders []*NotificationPreferenceCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the NotificationPreference entities in the database.
+func (_c *NotificationPreferenceCreateBulk) Save(ctx context.Context) ([]*NotificationPreference, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*NotificationPreference, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NotificationPreferenceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *NotificationPreferenceCreateBulk) SaveX(ctx context.Context) []*NotificationPreference {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NotificationPreferenceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NotificationPreferenceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NotificationPreference.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NotificationPreferenceUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *NotificationPreferenceCreateBulk) OnConflict(opts ...sql.ConflictOption) *NotificationPreferenceUpsertBulk {
+	_c.conflict = opts
+	return &NotificationPreferenceUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NotificationPreference.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *NotificationPreferenceCreateBulk) OnConflictColumns(columns ...string) *NotificationPreferenceUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &NotificationPreferenceUpsertBulk{
+		create: _c,
+	}
+}
+
+// NotificationPreferenceUpsertBulk is the builder for "upsert"-ing
+// a bulk of NotificationPreference nodes.
+type NotificationPreferenceUpsertBulk struct {
+	create *NotificationPreferenceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.NotificationPreference.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(notificationpreference.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *NotificationPreferenceUpsertBulk) UpdateNewValues() *NotificationPreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(notificationpreference.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(notificationpreference.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(notificationpreference.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NotificationPreference.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *NotificationPreferenceUpsertBulk) Ignore() *NotificationPreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NotificationPreferenceUpsertBulk) DoNothing() *NotificationPreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NotificationPreferenceCreateBulk.OnConflict
+// documentation for more info.
+func (u *NotificationPreferenceUpsertBulk) Update(set func(*NotificationPreferenceUpsert)) *NotificationPreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NotificationPreferenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *NotificationPreferenceUpsertBulk) SetUpdateTime(v time.Time) *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertBulk) UpdateUpdateTime() *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *NotificationPreferenceUpsertBulk) ClearUpdateTime() *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *NotificationPreferenceUpsertBulk) SetDeleteTime(v time.Time) *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertBulk) UpdateDeleteTime() *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *NotificationPreferenceUpsertBulk) ClearDeleteTime() *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *NotificationPreferenceUpsertBulk) SetUserID(v uint32) *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *NotificationPreferenceUpsertBulk) AddUserID(v uint32) *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertBulk) UpdateUserID() *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetShareEnabled sets the "share_enabled" field.
+func (u *NotificationPreferenceUpsertBulk) SetShareEnabled(v bool) *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetShareEnabled(v)
+	})
+}
+
+// UpdateShareEnabled sets the "share_enabled" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertBulk) UpdateShareEnabled() *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateShareEnabled()
+	})
+}
+
+// SetMentionEnabled sets the "mention_enabled" field.
+func (u *NotificationPreferenceUpsertBulk) SetMentionEnabled(v bool) *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetMentionEnabled(v)
+	})
+}
+
+// UpdateMentionEnabled sets the "mention_enabled" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertBulk) UpdateMentionEnabled() *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateMentionEnabled()
+	})
+}
+
+// SetReminderEnabled sets the "reminder_enabled" field.
+func (u *NotificationPreferenceUpsertBulk) SetReminderEnabled(v bool) *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetReminderEnabled(v)
+	})
+}
+
+// UpdateReminderEnabled sets the "reminder_enabled" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertBulk) UpdateReminderEnabled() *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateReminderEnabled()
+	})
+}
+
+// SetAssignmentEnabled sets the "assignment_enabled" field.
+func (u *NotificationPreferenceUpsertBulk) SetAssignmentEnabled(v bool) *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetAssignmentEnabled(v)
+	})
+}
+
+// UpdateAssignmentEnabled sets the "assignment_enabled" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertBulk) UpdateAssignmentEnabled() *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateAssignmentEnabled()
+	})
+}
+
+// Exec executes the query.
+func (u *NotificationPreferenceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the NotificationPreferenceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NotificationPreferenceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NotificationPreferenceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
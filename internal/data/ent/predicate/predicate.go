@@ -6,14 +6,86 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AccessibleResource is the predicate function for accessibleresource builders.
+type AccessibleResource func(*sql.Selector)
+
+// AuditEvent is the predicate function for auditevent builders.
+type AuditEvent func(*sql.Selector)
+
 // AuditLog is the predicate function for auditlog builders.
 type AuditLog func(*sql.Selector)
 
 // Category is the predicate function for category builders.
 type Category func(*sql.Selector)
 
+// CategoryDeleteJob is the predicate function for categorydeletejob builders.
+type CategoryDeleteJob func(*sql.Selector)
+
 // Document is the predicate function for document builders.
 type Document func(*sql.Selector)
 
+// DocumentContent is the predicate function for documentcontent builders.
+type DocumentContent func(*sql.Selector)
+
+// DocumentHistory is the predicate function for documenthistory builders.
+type DocumentHistory func(*sql.Selector)
+
 // DocumentPermission is the predicate function for documentpermission builders.
 type DocumentPermission func(*sql.Selector)
+
+// DocumentTag is the predicate function for documenttag builders.
+type DocumentTag func(*sql.Selector)
+
+// GroupMembership is the predicate function for groupmembership builders.
+type GroupMembership func(*sql.Selector)
+
+// IdempotencyKey is the predicate function for idempotencykey builders.
+type IdempotencyKey func(*sql.Selector)
+
+// ImportConnector is the predicate function for importconnector builders.
+type ImportConnector func(*sql.Selector)
+
+// ImportMapping is the predicate function for importmapping builders.
+type ImportMapping func(*sql.Selector)
+
+// ImportedFile is the predicate function for importedfile builders.
+type ImportedFile func(*sql.Selector)
+
+// NotificationPreference is the predicate function for notificationpreference builders.
+type NotificationPreference func(*sql.Selector)
+
+// OutboxEvent is the predicate function for outboxevent builders.
+type OutboxEvent func(*sql.Selector)
+
+// ReviewTask is the predicate function for reviewtask builders.
+type ReviewTask func(*sql.Selector)
+
+// Setting is the predicate function for setting builders.
+type Setting func(*sql.Selector)
+
+// SignatureRequest is the predicate function for signaturerequest builders.
+type SignatureRequest func(*sql.Selector)
+
+// StatisticsRollup is the predicate function for statisticsrollup builders.
+type StatisticsRollup func(*sql.Selector)
+
+// Tag is the predicate function for tag builders.
+type Tag func(*sql.Selector)
+
+// TenantDeleteJob is the predicate function for tenantdeletejob builders.
+type TenantDeleteJob func(*sql.Selector)
+
+// TenantKey is the predicate function for tenantkey builders.
+type TenantKey func(*sql.Selector)
+
+// TenantQuota is the predicate function for tenantquota builders.
+type TenantQuota func(*sql.Selector)
+
+// TenantSettings is the predicate function for tenantsettings builders.
+type TenantSettings func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
+
+// WebhookSubscription is the predicate function for webhooksubscription builders.
+type WebhookSubscription func(*sql.Selector)
@@ -0,0 +1,839 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reviewtask
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreateBy applies equality check predicate on the "create_by" field. It's identical to CreateByEQ.
+func CreateBy(v uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldEQ(FieldTenantID, v))
+}
+
+// DocumentID applies equality check predicate on the "document_id" field. It's identical to DocumentIDEQ.
+func DocumentID(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldEQ(FieldDocumentID, v))
+}
+
+// ReviewerID applies equality check predicate on the "reviewer_id" field. It's identical to ReviewerIDEQ.
+func ReviewerID(v uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldEQ(FieldReviewerID, v))
+}
+
+// Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
+func Message(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldEQ(FieldMessage, v))
+}
+
+// Comment applies equality check predicate on the "comment" field. It's identical to CommentEQ.
+func Comment(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldEQ(FieldComment, v))
+}
+
+// ReviewedVersion applies equality check predicate on the "reviewed_version" field. It's identical to ReviewedVersionEQ.
+func ReviewedVersion(v uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldEQ(FieldReviewedVersion, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedBy applies equality check predicate on the "completed_by" field. It's identical to CompletedByEQ.
+func CompletedBy(v uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldEQ(FieldCompletedBy, v))
+}
+
+// CreateByEQ applies the EQ predicate on the "create_by" field.
+func CreateByEQ(v uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// CreateByNEQ applies the NEQ predicate on the "create_by" field.
+func CreateByNEQ(v uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNEQ(FieldCreateBy, v))
+}
+
+// CreateByIn applies the In predicate on the "create_by" field.
+func CreateByIn(vs ...uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldIn(FieldCreateBy, vs...))
+}
+
+// CreateByNotIn applies the NotIn predicate on the "create_by" field.
+func CreateByNotIn(vs ...uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNotIn(FieldCreateBy, vs...))
+}
+
+// CreateByGT applies the GT predicate on the "create_by" field.
+func CreateByGT(v uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldGT(FieldCreateBy, v))
+}
+
+// CreateByGTE applies the GTE predicate on the "create_by" field.
+func CreateByGTE(v uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldGTE(FieldCreateBy, v))
+}
+
+// CreateByLT applies the LT predicate on the "create_by" field.
+func CreateByLT(v uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldLT(FieldCreateBy, v))
+}
+
+// CreateByLTE applies the LTE predicate on the "create_by" field.
+func CreateByLTE(v uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldLTE(FieldCreateBy, v))
+}
+
+// CreateByIsNil applies the IsNil predicate on the "create_by" field.
+func CreateByIsNil() predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldIsNull(FieldCreateBy))
+}
+
+// CreateByNotNil applies the NotNil predicate on the "create_by" field.
+func CreateByNotNil() predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNotNull(FieldCreateBy))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNotNull(FieldTenantID))
+}
+
+// DocumentIDEQ applies the EQ predicate on the "document_id" field.
+func DocumentIDEQ(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldEQ(FieldDocumentID, v))
+}
+
+// DocumentIDNEQ applies the NEQ predicate on the "document_id" field.
+func DocumentIDNEQ(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNEQ(FieldDocumentID, v))
+}
+
+// DocumentIDIn applies the In predicate on the "document_id" field.
+func DocumentIDIn(vs ...string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldIn(FieldDocumentID, vs...))
+}
+
+// DocumentIDNotIn applies the NotIn predicate on the "document_id" field.
+func DocumentIDNotIn(vs ...string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNotIn(FieldDocumentID, vs...))
+}
+
+// DocumentIDGT applies the GT predicate on the "document_id" field.
+func DocumentIDGT(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldGT(FieldDocumentID, v))
+}
+
+// DocumentIDGTE applies the GTE predicate on the "document_id" field.
+func DocumentIDGTE(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldGTE(FieldDocumentID, v))
+}
+
+// DocumentIDLT applies the LT predicate on the "document_id" field.
+func DocumentIDLT(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldLT(FieldDocumentID, v))
+}
+
+// DocumentIDLTE applies the LTE predicate on the "document_id" field.
+func DocumentIDLTE(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldLTE(FieldDocumentID, v))
+}
+
+// DocumentIDContains applies the Contains predicate on the "document_id" field.
+func DocumentIDContains(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldContains(FieldDocumentID, v))
+}
+
+// DocumentIDHasPrefix applies the HasPrefix predicate on the "document_id" field.
+func DocumentIDHasPrefix(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldHasPrefix(FieldDocumentID, v))
+}
+
+// DocumentIDHasSuffix applies the HasSuffix predicate on the "document_id" field.
+func DocumentIDHasSuffix(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldHasSuffix(FieldDocumentID, v))
+}
+
+// DocumentIDEqualFold applies the EqualFold predicate on the "document_id" field.
+func DocumentIDEqualFold(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldEqualFold(FieldDocumentID, v))
+}
+
+// DocumentIDContainsFold applies the ContainsFold predicate on the "document_id" field.
+func DocumentIDContainsFold(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldContainsFold(FieldDocumentID, v))
+}
+
+// ReviewerIDEQ applies the EQ predicate on the "reviewer_id" field.
+func ReviewerIDEQ(v uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldEQ(FieldReviewerID, v))
+}
+
+// ReviewerIDNEQ applies the NEQ predicate on the "reviewer_id" field.
+func ReviewerIDNEQ(v uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNEQ(FieldReviewerID, v))
+}
+
+// ReviewerIDIn applies the In predicate on the "reviewer_id" field.
+func ReviewerIDIn(vs ...uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldIn(FieldReviewerID, vs...))
+}
+
+// ReviewerIDNotIn applies the NotIn predicate on the "reviewer_id" field.
+func ReviewerIDNotIn(vs ...uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNotIn(FieldReviewerID, vs...))
+}
+
+// ReviewerIDGT applies the GT predicate on the "reviewer_id" field.
+func ReviewerIDGT(v uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldGT(FieldReviewerID, v))
+}
+
+// ReviewerIDGTE applies the GTE predicate on the "reviewer_id" field.
+func ReviewerIDGTE(v uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldGTE(FieldReviewerID, v))
+}
+
+// ReviewerIDLT applies the LT predicate on the "reviewer_id" field.
+func ReviewerIDLT(v uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldLT(FieldReviewerID, v))
+}
+
+// ReviewerIDLTE applies the LTE predicate on the "reviewer_id" field.
+func ReviewerIDLTE(v uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldLTE(FieldReviewerID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// MessageEQ applies the EQ predicate on the "message" field.
+func MessageEQ(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldEQ(FieldMessage, v))
+}
+
+// MessageNEQ applies the NEQ predicate on the "message" field.
+func MessageNEQ(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNEQ(FieldMessage, v))
+}
+
+// MessageIn applies the In predicate on the "message" field.
+func MessageIn(vs ...string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldIn(FieldMessage, vs...))
+}
+
+// MessageNotIn applies the NotIn predicate on the "message" field.
+func MessageNotIn(vs ...string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNotIn(FieldMessage, vs...))
+}
+
+// MessageGT applies the GT predicate on the "message" field.
+func MessageGT(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldGT(FieldMessage, v))
+}
+
+// MessageGTE applies the GTE predicate on the "message" field.
+func MessageGTE(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldGTE(FieldMessage, v))
+}
+
+// MessageLT applies the LT predicate on the "message" field.
+func MessageLT(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldLT(FieldMessage, v))
+}
+
+// MessageLTE applies the LTE predicate on the "message" field.
+func MessageLTE(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldLTE(FieldMessage, v))
+}
+
+// MessageContains applies the Contains predicate on the "message" field.
+func MessageContains(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldContains(FieldMessage, v))
+}
+
+// MessageHasPrefix applies the HasPrefix predicate on the "message" field.
+func MessageHasPrefix(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldHasPrefix(FieldMessage, v))
+}
+
+// MessageHasSuffix applies the HasSuffix predicate on the "message" field.
+func MessageHasSuffix(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldHasSuffix(FieldMessage, v))
+}
+
+// MessageIsNil applies the IsNil predicate on the "message" field.
+func MessageIsNil() predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldIsNull(FieldMessage))
+}
+
+// MessageNotNil applies the NotNil predicate on the "message" field.
+func MessageNotNil() predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNotNull(FieldMessage))
+}
+
+// MessageEqualFold applies the EqualFold predicate on the "message" field.
+func MessageEqualFold(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldEqualFold(FieldMessage, v))
+}
+
+// MessageContainsFold applies the ContainsFold predicate on the "message" field.
+func MessageContainsFold(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldContainsFold(FieldMessage, v))
+}
+
+// CommentEQ applies the EQ predicate on the "comment" field.
+func CommentEQ(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldEQ(FieldComment, v))
+}
+
+// CommentNEQ applies the NEQ predicate on the "comment" field.
+func CommentNEQ(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNEQ(FieldComment, v))
+}
+
+// CommentIn applies the In predicate on the "comment" field.
+func CommentIn(vs ...string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldIn(FieldComment, vs...))
+}
+
+// CommentNotIn applies the NotIn predicate on the "comment" field.
+func CommentNotIn(vs ...string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNotIn(FieldComment, vs...))
+}
+
+// CommentGT applies the GT predicate on the "comment" field.
+func CommentGT(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldGT(FieldComment, v))
+}
+
+// CommentGTE applies the GTE predicate on the "comment" field.
+func CommentGTE(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldGTE(FieldComment, v))
+}
+
+// CommentLT applies the LT predicate on the "comment" field.
+func CommentLT(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldLT(FieldComment, v))
+}
+
+// CommentLTE applies the LTE predicate on the "comment" field.
+func CommentLTE(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldLTE(FieldComment, v))
+}
+
+// CommentContains applies the Contains predicate on the "comment" field.
+func CommentContains(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldContains(FieldComment, v))
+}
+
+// CommentHasPrefix applies the HasPrefix predicate on the "comment" field.
+func CommentHasPrefix(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldHasPrefix(FieldComment, v))
+}
+
+// CommentHasSuffix applies the HasSuffix predicate on the "comment" field.
+func CommentHasSuffix(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldHasSuffix(FieldComment, v))
+}
+
+// CommentIsNil applies the IsNil predicate on the "comment" field.
+func CommentIsNil() predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldIsNull(FieldComment))
+}
+
+// CommentNotNil applies the NotNil predicate on the "comment" field.
+func CommentNotNil() predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNotNull(FieldComment))
+}
+
+// CommentEqualFold applies the EqualFold predicate on the "comment" field.
+func CommentEqualFold(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldEqualFold(FieldComment, v))
+}
+
+// CommentContainsFold applies the ContainsFold predicate on the "comment" field.
+func CommentContainsFold(v string) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldContainsFold(FieldComment, v))
+}
+
+// ReviewedVersionEQ applies the EQ predicate on the "reviewed_version" field.
+func ReviewedVersionEQ(v uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldEQ(FieldReviewedVersion, v))
+}
+
+// ReviewedVersionNEQ applies the NEQ predicate on the "reviewed_version" field.
+func ReviewedVersionNEQ(v uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNEQ(FieldReviewedVersion, v))
+}
+
+// ReviewedVersionIn applies the In predicate on the "reviewed_version" field.
+func ReviewedVersionIn(vs ...uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldIn(FieldReviewedVersion, vs...))
+}
+
+// ReviewedVersionNotIn applies the NotIn predicate on the "reviewed_version" field.
+func ReviewedVersionNotIn(vs ...uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNotIn(FieldReviewedVersion, vs...))
+}
+
+// ReviewedVersionGT applies the GT predicate on the "reviewed_version" field.
+func ReviewedVersionGT(v uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldGT(FieldReviewedVersion, v))
+}
+
+// ReviewedVersionGTE applies the GTE predicate on the "reviewed_version" field.
+func ReviewedVersionGTE(v uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldGTE(FieldReviewedVersion, v))
+}
+
+// ReviewedVersionLT applies the LT predicate on the "reviewed_version" field.
+func ReviewedVersionLT(v uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldLT(FieldReviewedVersion, v))
+}
+
+// ReviewedVersionLTE applies the LTE predicate on the "reviewed_version" field.
+func ReviewedVersionLTE(v uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldLTE(FieldReviewedVersion, v))
+}
+
+// ReviewedVersionIsNil applies the IsNil predicate on the "reviewed_version" field.
+func ReviewedVersionIsNil() predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldIsNull(FieldReviewedVersion))
+}
+
+// ReviewedVersionNotNil applies the NotNil predicate on the "reviewed_version" field.
+func ReviewedVersionNotNil() predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNotNull(FieldReviewedVersion))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldIsNull(FieldCompletedAt))
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNotNull(FieldCompletedAt))
+}
+
+// CompletedByEQ applies the EQ predicate on the "completed_by" field.
+func CompletedByEQ(v uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldEQ(FieldCompletedBy, v))
+}
+
+// CompletedByNEQ applies the NEQ predicate on the "completed_by" field.
+func CompletedByNEQ(v uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNEQ(FieldCompletedBy, v))
+}
+
+// CompletedByIn applies the In predicate on the "completed_by" field.
+func CompletedByIn(vs ...uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldIn(FieldCompletedBy, vs...))
+}
+
+// CompletedByNotIn applies the NotIn predicate on the "completed_by" field.
+func CompletedByNotIn(vs ...uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNotIn(FieldCompletedBy, vs...))
+}
+
+// CompletedByGT applies the GT predicate on the "completed_by" field.
+func CompletedByGT(v uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldGT(FieldCompletedBy, v))
+}
+
+// CompletedByGTE applies the GTE predicate on the "completed_by" field.
+func CompletedByGTE(v uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldGTE(FieldCompletedBy, v))
+}
+
+// CompletedByLT applies the LT predicate on the "completed_by" field.
+func CompletedByLT(v uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldLT(FieldCompletedBy, v))
+}
+
+// CompletedByLTE applies the LTE predicate on the "completed_by" field.
+func CompletedByLTE(v uint32) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldLTE(FieldCompletedBy, v))
+}
+
+// CompletedByIsNil applies the IsNil predicate on the "completed_by" field.
+func CompletedByIsNil() predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldIsNull(FieldCompletedBy))
+}
+
+// CompletedByNotNil applies the NotNil predicate on the "completed_by" field.
+func CompletedByNotNil() predicate.ReviewTask {
+	return predicate.ReviewTask(sql.FieldNotNull(FieldCompletedBy))
+}
+
+// HasDocument applies the HasEdge predicate on the "document" edge.
+func HasDocument() predicate.ReviewTask {
+	return predicate.ReviewTask(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DocumentTable, DocumentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDocumentWith applies the HasEdge predicate on the "document" edge with a given conditions (other predicates).
+func HasDocumentWith(preds ...predicate.Document) predicate.ReviewTask {
+	return predicate.ReviewTask(func(s *sql.Selector) {
+		step := newDocumentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReviewTask) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReviewTask) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReviewTask) predicate.ReviewTask {
+	return predicate.ReviewTask(sql.NotPredicates(p))
+}
@@ -0,0 +1,1415 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/document"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/reviewtask"
+)
+
+// ReviewTaskCreate is the builder for creating a ReviewTask entity.
+type ReviewTaskCreate struct {
+	config
+	mutation *ReviewTaskMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_c *ReviewTaskCreate) SetCreateBy(v uint32) *ReviewTaskCreate {
+	_c.mutation.SetCreateBy(v)
+	return _c
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_c *ReviewTaskCreate) SetNillableCreateBy(v *uint32) *ReviewTaskCreate {
+	if v != nil {
+		_c.SetCreateBy(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *ReviewTaskCreate) SetCreateTime(v time.Time) *ReviewTaskCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *ReviewTaskCreate) SetNillableCreateTime(v *time.Time) *ReviewTaskCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *ReviewTaskCreate) SetUpdateTime(v time.Time) *ReviewTaskCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *ReviewTaskCreate) SetNillableUpdateTime(v *time.Time) *ReviewTaskCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *ReviewTaskCreate) SetDeleteTime(v time.Time) *ReviewTaskCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *ReviewTaskCreate) SetNillableDeleteTime(v *time.Time) *ReviewTaskCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ReviewTaskCreate) SetTenantID(v uint32) *ReviewTaskCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *ReviewTaskCreate) SetNillableTenantID(v *uint32) *ReviewTaskCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetDocumentID sets the "document_id" field.
+func (_c *ReviewTaskCreate) SetDocumentID(v string) *ReviewTaskCreate {
+	_c.mutation.SetDocumentID(v)
+	return _c
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (_c *ReviewTaskCreate) SetReviewerID(v uint32) *ReviewTaskCreate {
+	_c.mutation.SetReviewerID(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ReviewTaskCreate) SetStatus(v reviewtask.Status) *ReviewTaskCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ReviewTaskCreate) SetNillableStatus(v *reviewtask.Status) *ReviewTaskCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetMessage sets the "message" field.
+func (_c *ReviewTaskCreate) SetMessage(v string) *ReviewTaskCreate {
+	_c.mutation.SetMessage(v)
+	return _c
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_c *ReviewTaskCreate) SetNillableMessage(v *string) *ReviewTaskCreate {
+	if v != nil {
+		_c.SetMessage(*v)
+	}
+	return _c
+}
+
+// SetComment sets the "comment" field.
+func (_c *ReviewTaskCreate) SetComment(v string) *ReviewTaskCreate {
+	_c.mutation.SetComment(v)
+	return _c
+}
+
+// SetNillableComment sets the "comment" field if the given value is not nil.
+func (_c *ReviewTaskCreate) SetNillableComment(v *string) *ReviewTaskCreate {
+	if v != nil {
+		_c.SetComment(*v)
+	}
+	return _c
+}
+
+// SetReviewedVersion sets the "reviewed_version" field.
+func (_c *ReviewTaskCreate) SetReviewedVersion(v uint32) *ReviewTaskCreate {
+	_c.mutation.SetReviewedVersion(v)
+	return _c
+}
+
+// SetNillableReviewedVersion sets the "reviewed_version" field if the given value is not nil.
+func (_c *ReviewTaskCreate) SetNillableReviewedVersion(v *uint32) *ReviewTaskCreate {
+	if v != nil {
+		_c.SetReviewedVersion(*v)
+	}
+	return _c
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_c *ReviewTaskCreate) SetCompletedAt(v time.Time) *ReviewTaskCreate {
+	_c.mutation.SetCompletedAt(v)
+	return _c
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_c *ReviewTaskCreate) SetNillableCompletedAt(v *time.Time) *ReviewTaskCreate {
+	if v != nil {
+		_c.SetCompletedAt(*v)
+	}
+	return _c
+}
+
+// SetCompletedBy sets the "completed_by" field.
+func (_c *ReviewTaskCreate) SetCompletedBy(v uint32) *ReviewTaskCreate {
+	_c.mutation.SetCompletedBy(v)
+	return _c
+}
+
+// SetNillableCompletedBy sets the "completed_by" field if the given value is not nil.
+func (_c *ReviewTaskCreate) SetNillableCompletedBy(v *uint32) *ReviewTaskCreate {
+	if v != nil {
+		_c.SetCompletedBy(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ReviewTaskCreate) SetID(v string) *ReviewTaskCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetDocument sets the "document" edge to the Document entity.
+func (_c *ReviewTaskCreate) SetDocument(v *Document) *ReviewTaskCreate {
+	return _c.SetDocumentID(v.ID)
+}
+
+// Mutation returns the ReviewTaskMutation object of the builder.
+func (_c *ReviewTaskCreate) Mutation() *ReviewTaskMutation {
+	return _c.mutation
+}
+
+// Save creates the ReviewTask in the database.
+func (_c *ReviewTaskCreate) Save(ctx context.Context) (*ReviewTask, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ReviewTaskCreate) SaveX(ctx context.Context) *ReviewTask {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReviewTaskCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReviewTaskCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ReviewTaskCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := reviewtask.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := reviewtask.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ReviewTaskCreate) check() error {
+	if _, ok := _c.mutation.DocumentID(); !ok {
+		return &ValidationError{Name: "document_id", err: errors.New(`ent: missing required field "ReviewTask.document_id"`)}
+	}
+	if v, ok := _c.mutation.DocumentID(); ok {
+		if err := reviewtask.DocumentIDValidator(v); err != nil {
+			return &ValidationError{Name: "document_id", err: fmt.Errorf(`ent: validator failed for field "ReviewTask.document_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ReviewerID(); !ok {
+		return &ValidationError{Name: "reviewer_id", err: errors.New(`ent: missing required field "ReviewTask.reviewer_id"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ReviewTask.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := reviewtask.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ReviewTask.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Message(); ok {
+		if err := reviewtask.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "ReviewTask.message": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := reviewtask.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "ReviewTask.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.DocumentIDs()) == 0 {
+		return &ValidationError{Name: "document", err: errors.New(`ent: missing required edge "ReviewTask.document"`)}
+	}
+	return nil
+}
+
+func (_c *ReviewTaskCreate) sqlSave(ctx context.Context) (*ReviewTask, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ReviewTask.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ReviewTaskCreate) createSpec() (*ReviewTask, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReviewTask{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(reviewtask.Table, sqlgraph.NewFieldSpec(reviewtask.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateBy(); ok {
+		_spec.SetField(reviewtask.FieldCreateBy, field.TypeUint32, value)
+		_node.CreateBy = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(reviewtask.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(reviewtask.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(reviewtask.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(reviewtask.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.ReviewerID(); ok {
+		_spec.SetField(reviewtask.FieldReviewerID, field.TypeUint32, value)
+		_node.ReviewerID = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(reviewtask.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Message(); ok {
+		_spec.SetField(reviewtask.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	if value, ok := _c.mutation.Comment(); ok {
+		_spec.SetField(reviewtask.FieldComment, field.TypeString, value)
+		_node.Comment = value
+	}
+	if value, ok := _c.mutation.ReviewedVersion(); ok {
+		_spec.SetField(reviewtask.FieldReviewedVersion, field.TypeUint32, value)
+		_node.ReviewedVersion = &value
+	}
+	if value, ok := _c.mutation.CompletedAt(); ok {
+		_spec.SetField(reviewtask.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if value, ok := _c.mutation.CompletedBy(); ok {
+		_spec.SetField(reviewtask.FieldCompletedBy, field.TypeUint32, value)
+		_node.CompletedBy = &value
+	}
+	if nodes := _c.mutation.DocumentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reviewtask.DocumentTable,
+			Columns: []string{reviewtask.DocumentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DocumentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReviewTask.Create().
+//		SetCreateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReviewTaskUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReviewTaskCreate) OnConflict(opts ...sql.ConflictOption) *ReviewTaskUpsertOne {
+	_c.conflict = opts
+	return &ReviewTaskUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReviewTask.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReviewTaskCreate) OnConflictColumns(columns ...string) *ReviewTaskUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReviewTaskUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ReviewTaskUpsertOne is the builder for "upsert"-ing
+	//  one ReviewTask node.
+	ReviewTaskUpsertOne struct {
+		create *ReviewTaskCreate
+	}
+
+	// ReviewTaskUpsert is the "OnConflict" setter.
+	ReviewTaskUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateBy sets the "create_by" field.
+func (u *ReviewTaskUpsert) SetCreateBy(v uint32) *ReviewTaskUpsert {
+	u.Set(reviewtask.FieldCreateBy, v)
+	return u
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *ReviewTaskUpsert) UpdateCreateBy() *ReviewTaskUpsert {
+	u.SetExcluded(reviewtask.FieldCreateBy)
+	return u
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *ReviewTaskUpsert) AddCreateBy(v uint32) *ReviewTaskUpsert {
+	u.Add(reviewtask.FieldCreateBy, v)
+	return u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *ReviewTaskUpsert) ClearCreateBy() *ReviewTaskUpsert {
+	u.SetNull(reviewtask.FieldCreateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ReviewTaskUpsert) SetUpdateTime(v time.Time) *ReviewTaskUpsert {
+	u.Set(reviewtask.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ReviewTaskUpsert) UpdateUpdateTime() *ReviewTaskUpsert {
+	u.SetExcluded(reviewtask.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ReviewTaskUpsert) ClearUpdateTime() *ReviewTaskUpsert {
+	u.SetNull(reviewtask.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ReviewTaskUpsert) SetDeleteTime(v time.Time) *ReviewTaskUpsert {
+	u.Set(reviewtask.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ReviewTaskUpsert) UpdateDeleteTime() *ReviewTaskUpsert {
+	u.SetExcluded(reviewtask.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ReviewTaskUpsert) ClearDeleteTime() *ReviewTaskUpsert {
+	u.SetNull(reviewtask.FieldDeleteTime)
+	return u
+}
+
+// SetDocumentID sets the "document_id" field.
+func (u *ReviewTaskUpsert) SetDocumentID(v string) *ReviewTaskUpsert {
+	u.Set(reviewtask.FieldDocumentID, v)
+	return u
+}
+
+// UpdateDocumentID sets the "document_id" field to the value that was provided on create.
+func (u *ReviewTaskUpsert) UpdateDocumentID() *ReviewTaskUpsert {
+	u.SetExcluded(reviewtask.FieldDocumentID)
+	return u
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (u *ReviewTaskUpsert) SetReviewerID(v uint32) *ReviewTaskUpsert {
+	u.Set(reviewtask.FieldReviewerID, v)
+	return u
+}
+
+// UpdateReviewerID sets the "reviewer_id" field to the value that was provided on create.
+func (u *ReviewTaskUpsert) UpdateReviewerID() *ReviewTaskUpsert {
+	u.SetExcluded(reviewtask.FieldReviewerID)
+	return u
+}
+
+// AddReviewerID adds v to the "reviewer_id" field.
+func (u *ReviewTaskUpsert) AddReviewerID(v uint32) *ReviewTaskUpsert {
+	u.Add(reviewtask.FieldReviewerID, v)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ReviewTaskUpsert) SetStatus(v reviewtask.Status) *ReviewTaskUpsert {
+	u.Set(reviewtask.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReviewTaskUpsert) UpdateStatus() *ReviewTaskUpsert {
+	u.SetExcluded(reviewtask.FieldStatus)
+	return u
+}
+
+// SetMessage sets the "message" field.
+func (u *ReviewTaskUpsert) SetMessage(v string) *ReviewTaskUpsert {
+	u.Set(reviewtask.FieldMessage, v)
+	return u
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *ReviewTaskUpsert) UpdateMessage() *ReviewTaskUpsert {
+	u.SetExcluded(reviewtask.FieldMessage)
+	return u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *ReviewTaskUpsert) ClearMessage() *ReviewTaskUpsert {
+	u.SetNull(reviewtask.FieldMessage)
+	return u
+}
+
+// SetComment sets the "comment" field.
+func (u *ReviewTaskUpsert) SetComment(v string) *ReviewTaskUpsert {
+	u.Set(reviewtask.FieldComment, v)
+	return u
+}
+
+// UpdateComment sets the "comment" field to the value that was provided on create.
+func (u *ReviewTaskUpsert) UpdateComment() *ReviewTaskUpsert {
+	u.SetExcluded(reviewtask.FieldComment)
+	return u
+}
+
+// ClearComment clears the value of the "comment" field.
+func (u *ReviewTaskUpsert) ClearComment() *ReviewTaskUpsert {
+	u.SetNull(reviewtask.FieldComment)
+	return u
+}
+
+// SetReviewedVersion sets the "reviewed_version" field.
+func (u *ReviewTaskUpsert) SetReviewedVersion(v uint32) *ReviewTaskUpsert {
+	u.Set(reviewtask.FieldReviewedVersion, v)
+	return u
+}
+
+// UpdateReviewedVersion sets the "reviewed_version" field to the value that was provided on create.
+func (u *ReviewTaskUpsert) UpdateReviewedVersion() *ReviewTaskUpsert {
+	u.SetExcluded(reviewtask.FieldReviewedVersion)
+	return u
+}
+
+// AddReviewedVersion adds v to the "reviewed_version" field.
+func (u *ReviewTaskUpsert) AddReviewedVersion(v uint32) *ReviewTaskUpsert {
+	u.Add(reviewtask.FieldReviewedVersion, v)
+	return u
+}
+
+// ClearReviewedVersion clears the value of the "reviewed_version" field.
+func (u *ReviewTaskUpsert) ClearReviewedVersion() *ReviewTaskUpsert {
+	u.SetNull(reviewtask.FieldReviewedVersion)
+	return u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *ReviewTaskUpsert) SetCompletedAt(v time.Time) *ReviewTaskUpsert {
+	u.Set(reviewtask.FieldCompletedAt, v)
+	return u
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *ReviewTaskUpsert) UpdateCompletedAt() *ReviewTaskUpsert {
+	u.SetExcluded(reviewtask.FieldCompletedAt)
+	return u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *ReviewTaskUpsert) ClearCompletedAt() *ReviewTaskUpsert {
+	u.SetNull(reviewtask.FieldCompletedAt)
+	return u
+}
+
+// SetCompletedBy sets the "completed_by" field.
+func (u *ReviewTaskUpsert) SetCompletedBy(v uint32) *ReviewTaskUpsert {
+	u.Set(reviewtask.FieldCompletedBy, v)
+	return u
+}
+
+// UpdateCompletedBy sets the "completed_by" field to the value that was provided on create.
+func (u *ReviewTaskUpsert) UpdateCompletedBy() *ReviewTaskUpsert {
+	u.SetExcluded(reviewtask.FieldCompletedBy)
+	return u
+}
+
+// AddCompletedBy adds v to the "completed_by" field.
+func (u *ReviewTaskUpsert) AddCompletedBy(v uint32) *ReviewTaskUpsert {
+	u.Add(reviewtask.FieldCompletedBy, v)
+	return u
+}
+
+// ClearCompletedBy clears the value of the "completed_by" field.
+func (u *ReviewTaskUpsert) ClearCompletedBy() *ReviewTaskUpsert {
+	u.SetNull(reviewtask.FieldCompletedBy)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ReviewTask.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reviewtask.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReviewTaskUpsertOne) UpdateNewValues() *ReviewTaskUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(reviewtask.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(reviewtask.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(reviewtask.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReviewTask.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReviewTaskUpsertOne) Ignore() *ReviewTaskUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReviewTaskUpsertOne) DoNothing() *ReviewTaskUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReviewTaskCreate.OnConflict
+// documentation for more info.
+func (u *ReviewTaskUpsertOne) Update(set func(*ReviewTaskUpsert)) *ReviewTaskUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReviewTaskUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *ReviewTaskUpsertOne) SetCreateBy(v uint32) *ReviewTaskUpsertOne {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *ReviewTaskUpsertOne) AddCreateBy(v uint32) *ReviewTaskUpsertOne {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *ReviewTaskUpsertOne) UpdateCreateBy() *ReviewTaskUpsertOne {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *ReviewTaskUpsertOne) ClearCreateBy() *ReviewTaskUpsertOne {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ReviewTaskUpsertOne) SetUpdateTime(v time.Time) *ReviewTaskUpsertOne {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ReviewTaskUpsertOne) UpdateUpdateTime() *ReviewTaskUpsertOne {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ReviewTaskUpsertOne) ClearUpdateTime() *ReviewTaskUpsertOne {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ReviewTaskUpsertOne) SetDeleteTime(v time.Time) *ReviewTaskUpsertOne {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ReviewTaskUpsertOne) UpdateDeleteTime() *ReviewTaskUpsertOne {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ReviewTaskUpsertOne) ClearDeleteTime() *ReviewTaskUpsertOne {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetDocumentID sets the "document_id" field.
+func (u *ReviewTaskUpsertOne) SetDocumentID(v string) *ReviewTaskUpsertOne {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.SetDocumentID(v)
+	})
+}
+
+// UpdateDocumentID sets the "document_id" field to the value that was provided on create.
+func (u *ReviewTaskUpsertOne) UpdateDocumentID() *ReviewTaskUpsertOne {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.UpdateDocumentID()
+	})
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (u *ReviewTaskUpsertOne) SetReviewerID(v uint32) *ReviewTaskUpsertOne {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.SetReviewerID(v)
+	})
+}
+
+// AddReviewerID adds v to the "reviewer_id" field.
+func (u *ReviewTaskUpsertOne) AddReviewerID(v uint32) *ReviewTaskUpsertOne {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.AddReviewerID(v)
+	})
+}
+
+// UpdateReviewerID sets the "reviewer_id" field to the value that was provided on create.
+func (u *ReviewTaskUpsertOne) UpdateReviewerID() *ReviewTaskUpsertOne {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.UpdateReviewerID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ReviewTaskUpsertOne) SetStatus(v reviewtask.Status) *ReviewTaskUpsertOne {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReviewTaskUpsertOne) UpdateStatus() *ReviewTaskUpsertOne {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetMessage sets the "message" field.
+func (u *ReviewTaskUpsertOne) SetMessage(v string) *ReviewTaskUpsertOne {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.SetMessage(v)
+	})
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *ReviewTaskUpsertOne) UpdateMessage() *ReviewTaskUpsertOne {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.UpdateMessage()
+	})
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *ReviewTaskUpsertOne) ClearMessage() *ReviewTaskUpsertOne {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.ClearMessage()
+	})
+}
+
+// SetComment sets the "comment" field.
+func (u *ReviewTaskUpsertOne) SetComment(v string) *ReviewTaskUpsertOne {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.SetComment(v)
+	})
+}
+
+// UpdateComment sets the "comment" field to the value that was provided on create.
+func (u *ReviewTaskUpsertOne) UpdateComment() *ReviewTaskUpsertOne {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.UpdateComment()
+	})
+}
+
+// ClearComment clears the value of the "comment" field.
+func (u *ReviewTaskUpsertOne) ClearComment() *ReviewTaskUpsertOne {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.ClearComment()
+	})
+}
+
+// SetReviewedVersion sets the "reviewed_version" field.
+func (u *ReviewTaskUpsertOne) SetReviewedVersion(v uint32) *ReviewTaskUpsertOne {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.SetReviewedVersion(v)
+	})
+}
+
+// AddReviewedVersion adds v to the "reviewed_version" field.
+func (u *ReviewTaskUpsertOne) AddReviewedVersion(v uint32) *ReviewTaskUpsertOne {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.AddReviewedVersion(v)
+	})
+}
+
+// UpdateReviewedVersion sets the "reviewed_version" field to the value that was provided on create.
+func (u *ReviewTaskUpsertOne) UpdateReviewedVersion() *ReviewTaskUpsertOne {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.UpdateReviewedVersion()
+	})
+}
+
+// ClearReviewedVersion clears the value of the "reviewed_version" field.
+func (u *ReviewTaskUpsertOne) ClearReviewedVersion() *ReviewTaskUpsertOne {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.ClearReviewedVersion()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *ReviewTaskUpsertOne) SetCompletedAt(v time.Time) *ReviewTaskUpsertOne {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *ReviewTaskUpsertOne) UpdateCompletedAt() *ReviewTaskUpsertOne {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *ReviewTaskUpsertOne) ClearCompletedAt() *ReviewTaskUpsertOne {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// SetCompletedBy sets the "completed_by" field.
+func (u *ReviewTaskUpsertOne) SetCompletedBy(v uint32) *ReviewTaskUpsertOne {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.SetCompletedBy(v)
+	})
+}
+
+// AddCompletedBy adds v to the "completed_by" field.
+func (u *ReviewTaskUpsertOne) AddCompletedBy(v uint32) *ReviewTaskUpsertOne {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.AddCompletedBy(v)
+	})
+}
+
+// UpdateCompletedBy sets the "completed_by" field to the value that was provided on create.
+func (u *ReviewTaskUpsertOne) UpdateCompletedBy() *ReviewTaskUpsertOne {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.UpdateCompletedBy()
+	})
+}
+
+// ClearCompletedBy clears the value of the "completed_by" field.
+func (u *ReviewTaskUpsertOne) ClearCompletedBy() *ReviewTaskUpsertOne {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.ClearCompletedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *ReviewTaskUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReviewTaskCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReviewTaskUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReviewTaskUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ReviewTaskUpsertOne.ID is not supported by MySQL driver. Use ReviewTaskUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReviewTaskUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReviewTaskCreateBulk is the builder for creating many ReviewTask entities in bulk.
+type ReviewTaskCreateBulk struct {
+	config
+	err      error
+	builders []*ReviewTaskCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ReviewTask entities in the database.
+func (_c *ReviewTaskCreateBulk) Save(ctx context.Context) ([]*ReviewTask, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ReviewTask, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReviewTaskMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ReviewTaskCreateBulk) SaveX(ctx context.Context) []*ReviewTask {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReviewTaskCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReviewTaskCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReviewTask.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReviewTaskUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReviewTaskCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReviewTaskUpsertBulk {
+	_c.conflict = opts
+	return &ReviewTaskUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReviewTask.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReviewTaskCreateBulk) OnConflictColumns(columns ...string) *ReviewTaskUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReviewTaskUpsertBulk{
+		create: _c,
+	}
+}
+
+// ReviewTaskUpsertBulk is the builder for "upsert"-ing
+// a bulk of ReviewTask nodes.
+type ReviewTaskUpsertBulk struct {
+	create *ReviewTaskCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ReviewTask.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reviewtask.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReviewTaskUpsertBulk) UpdateNewValues() *ReviewTaskUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(reviewtask.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(reviewtask.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(reviewtask.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReviewTask.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReviewTaskUpsertBulk) Ignore() *ReviewTaskUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReviewTaskUpsertBulk) DoNothing() *ReviewTaskUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReviewTaskCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReviewTaskUpsertBulk) Update(set func(*ReviewTaskUpsert)) *ReviewTaskUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReviewTaskUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *ReviewTaskUpsertBulk) SetCreateBy(v uint32) *ReviewTaskUpsertBulk {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *ReviewTaskUpsertBulk) AddCreateBy(v uint32) *ReviewTaskUpsertBulk {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *ReviewTaskUpsertBulk) UpdateCreateBy() *ReviewTaskUpsertBulk {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *ReviewTaskUpsertBulk) ClearCreateBy() *ReviewTaskUpsertBulk {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ReviewTaskUpsertBulk) SetUpdateTime(v time.Time) *ReviewTaskUpsertBulk {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ReviewTaskUpsertBulk) UpdateUpdateTime() *ReviewTaskUpsertBulk {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ReviewTaskUpsertBulk) ClearUpdateTime() *ReviewTaskUpsertBulk {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ReviewTaskUpsertBulk) SetDeleteTime(v time.Time) *ReviewTaskUpsertBulk {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ReviewTaskUpsertBulk) UpdateDeleteTime() *ReviewTaskUpsertBulk {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ReviewTaskUpsertBulk) ClearDeleteTime() *ReviewTaskUpsertBulk {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetDocumentID sets the "document_id" field.
+func (u *ReviewTaskUpsertBulk) SetDocumentID(v string) *ReviewTaskUpsertBulk {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.SetDocumentID(v)
+	})
+}
+
+// UpdateDocumentID sets the "document_id" field to the value that was provided on create.
+func (u *ReviewTaskUpsertBulk) UpdateDocumentID() *ReviewTaskUpsertBulk {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.UpdateDocumentID()
+	})
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (u *ReviewTaskUpsertBulk) SetReviewerID(v uint32) *ReviewTaskUpsertBulk {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.SetReviewerID(v)
+	})
+}
+
+// AddReviewerID adds v to the "reviewer_id" field.
+func (u *ReviewTaskUpsertBulk) AddReviewerID(v uint32) *ReviewTaskUpsertBulk {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.AddReviewerID(v)
+	})
+}
+
+// UpdateReviewerID sets the "reviewer_id" field to the value that was provided on create.
+func (u *ReviewTaskUpsertBulk) UpdateReviewerID() *ReviewTaskUpsertBulk {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.UpdateReviewerID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ReviewTaskUpsertBulk) SetStatus(v reviewtask.Status) *ReviewTaskUpsertBulk {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReviewTaskUpsertBulk) UpdateStatus() *ReviewTaskUpsertBulk {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetMessage sets the "message" field.
+func (u *ReviewTaskUpsertBulk) SetMessage(v string) *ReviewTaskUpsertBulk {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.SetMessage(v)
+	})
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *ReviewTaskUpsertBulk) UpdateMessage() *ReviewTaskUpsertBulk {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.UpdateMessage()
+	})
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *ReviewTaskUpsertBulk) ClearMessage() *ReviewTaskUpsertBulk {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.ClearMessage()
+	})
+}
+
+// SetComment sets the "comment" field.
+func (u *ReviewTaskUpsertBulk) SetComment(v string) *ReviewTaskUpsertBulk {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.SetComment(v)
+	})
+}
+
+// UpdateComment sets the "comment" field to the value that was provided on create.
+func (u *ReviewTaskUpsertBulk) UpdateComment() *ReviewTaskUpsertBulk {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.UpdateComment()
+	})
+}
+
+// ClearComment clears the value of the "comment" field.
+func (u *ReviewTaskUpsertBulk) ClearComment() *ReviewTaskUpsertBulk {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.ClearComment()
+	})
+}
+
+// SetReviewedVersion sets the "reviewed_version" field.
+func (u *ReviewTaskUpsertBulk) SetReviewedVersion(v uint32) *ReviewTaskUpsertBulk {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.SetReviewedVersion(v)
+	})
+}
+
+// AddReviewedVersion adds v to the "reviewed_version" field.
+func (u *ReviewTaskUpsertBulk) AddReviewedVersion(v uint32) *ReviewTaskUpsertBulk {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.AddReviewedVersion(v)
+	})
+}
+
+// UpdateReviewedVersion sets the "reviewed_version" field to the value that was provided on create.
+func (u *ReviewTaskUpsertBulk) UpdateReviewedVersion() *ReviewTaskUpsertBulk {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.UpdateReviewedVersion()
+	})
+}
+
+// ClearReviewedVersion clears the value of the "reviewed_version" field.
+func (u *ReviewTaskUpsertBulk) ClearReviewedVersion() *ReviewTaskUpsertBulk {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.ClearReviewedVersion()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *ReviewTaskUpsertBulk) SetCompletedAt(v time.Time) *ReviewTaskUpsertBulk {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *ReviewTaskUpsertBulk) UpdateCompletedAt() *ReviewTaskUpsertBulk {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *ReviewTaskUpsertBulk) ClearCompletedAt() *ReviewTaskUpsertBulk {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// SetCompletedBy sets the "completed_by" field.
+func (u *ReviewTaskUpsertBulk) SetCompletedBy(v uint32) *ReviewTaskUpsertBulk {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.SetCompletedBy(v)
+	})
+}
+
+// AddCompletedBy adds v to the "completed_by" field.
+func (u *ReviewTaskUpsertBulk) AddCompletedBy(v uint32) *ReviewTaskUpsertBulk {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.AddCompletedBy(v)
+	})
+}
+
+// UpdateCompletedBy sets the "completed_by" field to the value that was provided on create.
+func (u *ReviewTaskUpsertBulk) UpdateCompletedBy() *ReviewTaskUpsertBulk {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.UpdateCompletedBy()
+	})
+}
+
+// ClearCompletedBy clears the value of the "completed_by" field.
+func (u *ReviewTaskUpsertBulk) ClearCompletedBy() *ReviewTaskUpsertBulk {
+	return u.Update(func(s *ReviewTaskUpsert) {
+		s.ClearCompletedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *ReviewTaskUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReviewTaskCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReviewTaskCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReviewTaskUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,929 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/document"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/reviewtask"
+)
+
+// ReviewTaskUpdate is the builder for updating ReviewTask entities.
+type ReviewTaskUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ReviewTaskMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ReviewTaskUpdate builder.
+func (_u *ReviewTaskUpdate) Where(ps ...predicate.ReviewTask) *ReviewTaskUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *ReviewTaskUpdate) SetCreateBy(v uint32) *ReviewTaskUpdate {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *ReviewTaskUpdate) SetNillableCreateBy(v *uint32) *ReviewTaskUpdate {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *ReviewTaskUpdate) AddCreateBy(v int32) *ReviewTaskUpdate {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *ReviewTaskUpdate) ClearCreateBy() *ReviewTaskUpdate {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ReviewTaskUpdate) SetUpdateTime(v time.Time) *ReviewTaskUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *ReviewTaskUpdate) SetNillableUpdateTime(v *time.Time) *ReviewTaskUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *ReviewTaskUpdate) ClearUpdateTime() *ReviewTaskUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *ReviewTaskUpdate) SetDeleteTime(v time.Time) *ReviewTaskUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *ReviewTaskUpdate) SetNillableDeleteTime(v *time.Time) *ReviewTaskUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *ReviewTaskUpdate) ClearDeleteTime() *ReviewTaskUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetDocumentID sets the "document_id" field.
+func (_u *ReviewTaskUpdate) SetDocumentID(v string) *ReviewTaskUpdate {
+	_u.mutation.SetDocumentID(v)
+	return _u
+}
+
+// SetNillableDocumentID sets the "document_id" field if the given value is not nil.
+func (_u *ReviewTaskUpdate) SetNillableDocumentID(v *string) *ReviewTaskUpdate {
+	if v != nil {
+		_u.SetDocumentID(*v)
+	}
+	return _u
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (_u *ReviewTaskUpdate) SetReviewerID(v uint32) *ReviewTaskUpdate {
+	_u.mutation.ResetReviewerID()
+	_u.mutation.SetReviewerID(v)
+	return _u
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (_u *ReviewTaskUpdate) SetNillableReviewerID(v *uint32) *ReviewTaskUpdate {
+	if v != nil {
+		_u.SetReviewerID(*v)
+	}
+	return _u
+}
+
+// AddReviewerID adds value to the "reviewer_id" field.
+func (_u *ReviewTaskUpdate) AddReviewerID(v int32) *ReviewTaskUpdate {
+	_u.mutation.AddReviewerID(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ReviewTaskUpdate) SetStatus(v reviewtask.Status) *ReviewTaskUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ReviewTaskUpdate) SetNillableStatus(v *reviewtask.Status) *ReviewTaskUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *ReviewTaskUpdate) SetMessage(v string) *ReviewTaskUpdate {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *ReviewTaskUpdate) SetNillableMessage(v *string) *ReviewTaskUpdate {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *ReviewTaskUpdate) ClearMessage() *ReviewTaskUpdate {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// SetComment sets the "comment" field.
+func (_u *ReviewTaskUpdate) SetComment(v string) *ReviewTaskUpdate {
+	_u.mutation.SetComment(v)
+	return _u
+}
+
+// SetNillableComment sets the "comment" field if the given value is not nil.
+func (_u *ReviewTaskUpdate) SetNillableComment(v *string) *ReviewTaskUpdate {
+	if v != nil {
+		_u.SetComment(*v)
+	}
+	return _u
+}
+
+// ClearComment clears the value of the "comment" field.
+func (_u *ReviewTaskUpdate) ClearComment() *ReviewTaskUpdate {
+	_u.mutation.ClearComment()
+	return _u
+}
+
+// SetReviewedVersion sets the "reviewed_version" field.
+func (_u *ReviewTaskUpdate) SetReviewedVersion(v uint32) *ReviewTaskUpdate {
+	_u.mutation.ResetReviewedVersion()
+	_u.mutation.SetReviewedVersion(v)
+	return _u
+}
+
+// SetNillableReviewedVersion sets the "reviewed_version" field if the given value is not nil.
+func (_u *ReviewTaskUpdate) SetNillableReviewedVersion(v *uint32) *ReviewTaskUpdate {
+	if v != nil {
+		_u.SetReviewedVersion(*v)
+	}
+	return _u
+}
+
+// AddReviewedVersion adds value to the "reviewed_version" field.
+func (_u *ReviewTaskUpdate) AddReviewedVersion(v int32) *ReviewTaskUpdate {
+	_u.mutation.AddReviewedVersion(v)
+	return _u
+}
+
+// ClearReviewedVersion clears the value of the "reviewed_version" field.
+func (_u *ReviewTaskUpdate) ClearReviewedVersion() *ReviewTaskUpdate {
+	_u.mutation.ClearReviewedVersion()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *ReviewTaskUpdate) SetCompletedAt(v time.Time) *ReviewTaskUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *ReviewTaskUpdate) SetNillableCompletedAt(v *time.Time) *ReviewTaskUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *ReviewTaskUpdate) ClearCompletedAt() *ReviewTaskUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetCompletedBy sets the "completed_by" field.
+func (_u *ReviewTaskUpdate) SetCompletedBy(v uint32) *ReviewTaskUpdate {
+	_u.mutation.ResetCompletedBy()
+	_u.mutation.SetCompletedBy(v)
+	return _u
+}
+
+// SetNillableCompletedBy sets the "completed_by" field if the given value is not nil.
+func (_u *ReviewTaskUpdate) SetNillableCompletedBy(v *uint32) *ReviewTaskUpdate {
+	if v != nil {
+		_u.SetCompletedBy(*v)
+	}
+	return _u
+}
+
+// AddCompletedBy adds value to the "completed_by" field.
+func (_u *ReviewTaskUpdate) AddCompletedBy(v int32) *ReviewTaskUpdate {
+	_u.mutation.AddCompletedBy(v)
+	return _u
+}
+
+// ClearCompletedBy clears the value of the "completed_by" field.
+func (_u *ReviewTaskUpdate) ClearCompletedBy() *ReviewTaskUpdate {
+	_u.mutation.ClearCompletedBy()
+	return _u
+}
+
+// SetDocument sets the "document" edge to the Document entity.
+func (_u *ReviewTaskUpdate) SetDocument(v *Document) *ReviewTaskUpdate {
+	return _u.SetDocumentID(v.ID)
+}
+
+// Mutation returns the ReviewTaskMutation object of the builder.
+func (_u *ReviewTaskUpdate) Mutation() *ReviewTaskMutation {
+	return _u.mutation
+}
+
+// ClearDocument clears the "document" edge to the Document entity.
+func (_u *ReviewTaskUpdate) ClearDocument() *ReviewTaskUpdate {
+	_u.mutation.ClearDocument()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ReviewTaskUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReviewTaskUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ReviewTaskUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReviewTaskUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReviewTaskUpdate) check() error {
+	if v, ok := _u.mutation.DocumentID(); ok {
+		if err := reviewtask.DocumentIDValidator(v); err != nil {
+			return &ValidationError{Name: "document_id", err: fmt.Errorf(`ent: validator failed for field "ReviewTask.document_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := reviewtask.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ReviewTask.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Message(); ok {
+		if err := reviewtask.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "ReviewTask.message": %w`, err)}
+		}
+	}
+	if _u.mutation.DocumentCleared() && len(_u.mutation.DocumentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReviewTask.document"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ReviewTaskUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReviewTaskUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ReviewTaskUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reviewtask.Table, reviewtask.Columns, sqlgraph.NewFieldSpec(reviewtask.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(reviewtask.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(reviewtask.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(reviewtask.FieldCreateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(reviewtask.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(reviewtask.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(reviewtask.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(reviewtask.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(reviewtask.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(reviewtask.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ReviewerID(); ok {
+		_spec.SetField(reviewtask.FieldReviewerID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedReviewerID(); ok {
+		_spec.AddField(reviewtask.FieldReviewerID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(reviewtask.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(reviewtask.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(reviewtask.FieldMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Comment(); ok {
+		_spec.SetField(reviewtask.FieldComment, field.TypeString, value)
+	}
+	if _u.mutation.CommentCleared() {
+		_spec.ClearField(reviewtask.FieldComment, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReviewedVersion(); ok {
+		_spec.SetField(reviewtask.FieldReviewedVersion, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedReviewedVersion(); ok {
+		_spec.AddField(reviewtask.FieldReviewedVersion, field.TypeUint32, value)
+	}
+	if _u.mutation.ReviewedVersionCleared() {
+		_spec.ClearField(reviewtask.FieldReviewedVersion, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(reviewtask.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(reviewtask.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedBy(); ok {
+		_spec.SetField(reviewtask.FieldCompletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCompletedBy(); ok {
+		_spec.AddField(reviewtask.FieldCompletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CompletedByCleared() {
+		_spec.ClearField(reviewtask.FieldCompletedBy, field.TypeUint32)
+	}
+	if _u.mutation.DocumentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reviewtask.DocumentTable,
+			Columns: []string{reviewtask.DocumentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DocumentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reviewtask.DocumentTable,
+			Columns: []string{reviewtask.DocumentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reviewtask.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ReviewTaskUpdateOne is the builder for updating a single ReviewTask entity.
+type ReviewTaskUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ReviewTaskMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *ReviewTaskUpdateOne) SetCreateBy(v uint32) *ReviewTaskUpdateOne {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *ReviewTaskUpdateOne) SetNillableCreateBy(v *uint32) *ReviewTaskUpdateOne {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *ReviewTaskUpdateOne) AddCreateBy(v int32) *ReviewTaskUpdateOne {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *ReviewTaskUpdateOne) ClearCreateBy() *ReviewTaskUpdateOne {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ReviewTaskUpdateOne) SetUpdateTime(v time.Time) *ReviewTaskUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *ReviewTaskUpdateOne) SetNillableUpdateTime(v *time.Time) *ReviewTaskUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *ReviewTaskUpdateOne) ClearUpdateTime() *ReviewTaskUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *ReviewTaskUpdateOne) SetDeleteTime(v time.Time) *ReviewTaskUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *ReviewTaskUpdateOne) SetNillableDeleteTime(v *time.Time) *ReviewTaskUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *ReviewTaskUpdateOne) ClearDeleteTime() *ReviewTaskUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetDocumentID sets the "document_id" field.
+func (_u *ReviewTaskUpdateOne) SetDocumentID(v string) *ReviewTaskUpdateOne {
+	_u.mutation.SetDocumentID(v)
+	return _u
+}
+
+// SetNillableDocumentID sets the "document_id" field if the given value is not nil.
+func (_u *ReviewTaskUpdateOne) SetNillableDocumentID(v *string) *ReviewTaskUpdateOne {
+	if v != nil {
+		_u.SetDocumentID(*v)
+	}
+	return _u
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (_u *ReviewTaskUpdateOne) SetReviewerID(v uint32) *ReviewTaskUpdateOne {
+	_u.mutation.ResetReviewerID()
+	_u.mutation.SetReviewerID(v)
+	return _u
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (_u *ReviewTaskUpdateOne) SetNillableReviewerID(v *uint32) *ReviewTaskUpdateOne {
+	if v != nil {
+		_u.SetReviewerID(*v)
+	}
+	return _u
+}
+
+// AddReviewerID adds value to the "reviewer_id" field.
+func (_u *ReviewTaskUpdateOne) AddReviewerID(v int32) *ReviewTaskUpdateOne {
+	_u.mutation.AddReviewerID(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ReviewTaskUpdateOne) SetStatus(v reviewtask.Status) *ReviewTaskUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ReviewTaskUpdateOne) SetNillableStatus(v *reviewtask.Status) *ReviewTaskUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *ReviewTaskUpdateOne) SetMessage(v string) *ReviewTaskUpdateOne {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *ReviewTaskUpdateOne) SetNillableMessage(v *string) *ReviewTaskUpdateOne {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *ReviewTaskUpdateOne) ClearMessage() *ReviewTaskUpdateOne {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// SetComment sets the "comment" field.
+func (_u *ReviewTaskUpdateOne) SetComment(v string) *ReviewTaskUpdateOne {
+	_u.mutation.SetComment(v)
+	return _u
+}
+
+// SetNillableComment sets the "comment" field if the given value is not nil.
+func (_u *ReviewTaskUpdateOne) SetNillableComment(v *string) *ReviewTaskUpdateOne {
+	if v != nil {
+		_u.SetComment(*v)
+	}
+	return _u
+}
+
+// ClearComment clears the value of the "comment" field.
+func (_u *ReviewTaskUpdateOne) ClearComment() *ReviewTaskUpdateOne {
+	_u.mutation.ClearComment()
+	return _u
+}
+
+// SetReviewedVersion sets the "reviewed_version" field.
+func (_u *ReviewTaskUpdateOne) SetReviewedVersion(v uint32) *ReviewTaskUpdateOne {
+	_u.mutation.ResetReviewedVersion()
+	_u.mutation.SetReviewedVersion(v)
+	return _u
+}
+
+// SetNillableReviewedVersion sets the "reviewed_version" field if the given value is not nil.
+func (_u *ReviewTaskUpdateOne) SetNillableReviewedVersion(v *uint32) *ReviewTaskUpdateOne {
+	if v != nil {
+		_u.SetReviewedVersion(*v)
+	}
+	return _u
+}
+
+// AddReviewedVersion adds value to the "reviewed_version" field.
+func (_u *ReviewTaskUpdateOne) AddReviewedVersion(v int32) *ReviewTaskUpdateOne {
+	_u.mutation.AddReviewedVersion(v)
+	return _u
+}
+
+// ClearReviewedVersion clears the value of the "reviewed_version" field.
+func (_u *ReviewTaskUpdateOne) ClearReviewedVersion() *ReviewTaskUpdateOne {
+	_u.mutation.ClearReviewedVersion()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *ReviewTaskUpdateOne) SetCompletedAt(v time.Time) *ReviewTaskUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *ReviewTaskUpdateOne) SetNillableCompletedAt(v *time.Time) *ReviewTaskUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *ReviewTaskUpdateOne) ClearCompletedAt() *ReviewTaskUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetCompletedBy sets the "completed_by" field.
+func (_u *ReviewTaskUpdateOne) SetCompletedBy(v uint32) *ReviewTaskUpdateOne {
+	_u.mutation.ResetCompletedBy()
+	_u.mutation.SetCompletedBy(v)
+	return _u
+}
+
+// SetNillableCompletedBy sets the "completed_by" field if the given value is not nil.
+func (_u *ReviewTaskUpdateOne) SetNillableCompletedBy(v *uint32) *ReviewTaskUpdateOne {
+	if v != nil {
+		_u.SetCompletedBy(*v)
+	}
+	return _u
+}
+
+// AddCompletedBy adds value to the "completed_by" field.
+func (_u *ReviewTaskUpdateOne) AddCompletedBy(v int32) *ReviewTaskUpdateOne {
+	_u.mutation.AddCompletedBy(v)
+	return _u
+}
+
+// ClearCompletedBy clears the value of the "completed_by" field.
+func (_u *ReviewTaskUpdateOne) ClearCompletedBy() *ReviewTaskUpdateOne {
+	_u.mutation.ClearCompletedBy()
+	return _u
+}
+
+// SetDocument sets the "document" edge to the Document entity.
+func (_u *ReviewTaskUpdateOne) SetDocument(v *Document) *ReviewTaskUpdateOne {
+	return _u.SetDocumentID(v.ID)
+}
+
+// Mutation returns the ReviewTaskMutation object of the builder.
+func (_u *ReviewTaskUpdateOne) Mutation() *ReviewTaskMutation {
+	return _u.mutation
+}
+
+// ClearDocument clears the "document" edge to the Document entity.
+func (_u *ReviewTaskUpdateOne) ClearDocument() *ReviewTaskUpdateOne {
+	_u.mutation.ClearDocument()
+	return _u
+}
+
+// Where appends a list predicates to the ReviewTaskUpdate builder.
+func (_u *ReviewTaskUpdateOne) Where(ps ...predicate.ReviewTask) *ReviewTaskUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ReviewTaskUpdateOne) Select(field string, fields ...string) *ReviewTaskUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ReviewTask entity.
+func (_u *ReviewTaskUpdateOne) Save(ctx context.Context) (*ReviewTask, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReviewTaskUpdateOne) SaveX(ctx context.Context) *ReviewTask {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ReviewTaskUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReviewTaskUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReviewTaskUpdateOne) check() error {
+	if v, ok := _u.mutation.DocumentID(); ok {
+		if err := reviewtask.DocumentIDValidator(v); err != nil {
+			return &ValidationError{Name: "document_id", err: fmt.Errorf(`ent: validator failed for field "ReviewTask.document_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := reviewtask.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ReviewTask.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Message(); ok {
+		if err := reviewtask.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "ReviewTask.message": %w`, err)}
+		}
+	}
+	if _u.mutation.DocumentCleared() && len(_u.mutation.DocumentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReviewTask.document"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ReviewTaskUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReviewTaskUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ReviewTaskUpdateOne) sqlSave(ctx context.Context) (_node *ReviewTask, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reviewtask.Table, reviewtask.Columns, sqlgraph.NewFieldSpec(reviewtask.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReviewTask.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reviewtask.FieldID)
+		for _, f := range fields {
+			if !reviewtask.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reviewtask.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(reviewtask.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(reviewtask.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(reviewtask.FieldCreateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(reviewtask.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(reviewtask.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(reviewtask.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(reviewtask.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(reviewtask.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(reviewtask.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ReviewerID(); ok {
+		_spec.SetField(reviewtask.FieldReviewerID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedReviewerID(); ok {
+		_spec.AddField(reviewtask.FieldReviewerID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(reviewtask.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(reviewtask.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(reviewtask.FieldMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Comment(); ok {
+		_spec.SetField(reviewtask.FieldComment, field.TypeString, value)
+	}
+	if _u.mutation.CommentCleared() {
+		_spec.ClearField(reviewtask.FieldComment, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReviewedVersion(); ok {
+		_spec.SetField(reviewtask.FieldReviewedVersion, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedReviewedVersion(); ok {
+		_spec.AddField(reviewtask.FieldReviewedVersion, field.TypeUint32, value)
+	}
+	if _u.mutation.ReviewedVersionCleared() {
+		_spec.ClearField(reviewtask.FieldReviewedVersion, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(reviewtask.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(reviewtask.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedBy(); ok {
+		_spec.SetField(reviewtask.FieldCompletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCompletedBy(); ok {
+		_spec.AddField(reviewtask.FieldCompletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CompletedByCleared() {
+		_spec.ClearField(reviewtask.FieldCompletedBy, field.TypeUint32)
+	}
+	if _u.mutation.DocumentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reviewtask.DocumentTable,
+			Columns: []string{reviewtask.DocumentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DocumentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reviewtask.DocumentTable,
+			Columns: []string{reviewtask.DocumentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ReviewTask{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reviewtask.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
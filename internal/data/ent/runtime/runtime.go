@@ -5,11 +5,35 @@ package runtime
 import (
 	"context"
 
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/accessibleresource"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/auditevent"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/auditlog"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/category"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/categorydeletejob"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/document"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documentcontent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documenthistory"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documentpermission"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documenttag"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/groupmembership"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/idempotencykey"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importconnector"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importedfile"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importmapping"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/notificationpreference"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/outboxevent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/reviewtask"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/setting"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/signaturerequest"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/statisticsrollup"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/tag"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/tenantdeletejob"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/tenantkey"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/tenantquota"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/tenantsettings"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/webhookdelivery"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/webhooksubscription"
 
 	"entgo.io/ent"
 	"entgo.io/ent/privacy"
@@ -19,6 +43,138 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	accessibleresourceMixin := schema.AccessibleResource{}.Mixin()
+	accessibleresource.Policy = privacy.NewPolicies(accessibleresourceMixin[2], schema.AccessibleResource{})
+	accessibleresource.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := accessibleresource.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	accessibleresourceMixinFields0 := accessibleresourceMixin[0].Fields()
+	_ = accessibleresourceMixinFields0
+	accessibleresourceMixinFields2 := accessibleresourceMixin[2].Fields()
+	_ = accessibleresourceMixinFields2
+	accessibleresourceFields := schema.AccessibleResource{}.Fields()
+	_ = accessibleresourceFields
+	// accessibleresourceDescTenantID is the schema descriptor for tenant_id field.
+	accessibleresourceDescTenantID := accessibleresourceMixinFields2[0].Descriptor()
+	// accessibleresource.DefaultTenantID holds the default value on creation for the tenant_id field.
+	accessibleresource.DefaultTenantID = accessibleresourceDescTenantID.Default.(uint32)
+	// accessibleresourceDescSubjectID is the schema descriptor for subject_id field.
+	accessibleresourceDescSubjectID := accessibleresourceFields[2].Descriptor()
+	// accessibleresource.SubjectIDValidator is a validator for the "subject_id" field. It is called by the builders before save.
+	accessibleresource.SubjectIDValidator = func() func(string) error {
+		validators := accessibleresourceDescSubjectID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(subject_id string) error {
+			for _, fn := range fns {
+				if err := fn(subject_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// accessibleresourceDescResourceID is the schema descriptor for resource_id field.
+	accessibleresourceDescResourceID := accessibleresourceFields[4].Descriptor()
+	// accessibleresource.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	accessibleresource.ResourceIDValidator = func() func(string) error {
+		validators := accessibleresourceDescResourceID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(resource_id string) error {
+			for _, fn := range fns {
+				if err := fn(resource_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// accessibleresourceDescSourceID is the schema descriptor for source_id field.
+	accessibleresourceDescSourceID := accessibleresourceFields[6].Descriptor()
+	// accessibleresource.SourceIDValidator is a validator for the "source_id" field. It is called by the builders before save.
+	accessibleresource.SourceIDValidator = func() func(string) error {
+		validators := accessibleresourceDescSourceID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(source_id string) error {
+			for _, fn := range fns {
+				if err := fn(source_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// accessibleresourceDescInherited is the schema descriptor for inherited field.
+	accessibleresourceDescInherited := accessibleresourceFields[7].Descriptor()
+	// accessibleresource.DefaultInherited holds the default value on creation for the inherited field.
+	accessibleresource.DefaultInherited = accessibleresourceDescInherited.Default.(bool)
+	// accessibleresourceDescID is the schema descriptor for id field.
+	accessibleresourceDescID := accessibleresourceMixinFields0[0].Descriptor()
+	// accessibleresource.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	accessibleresource.IDValidator = accessibleresourceDescID.Validators[0].(func(uint32) error)
+	auditeventMixin := schema.AuditEvent{}.Mixin()
+	auditevent.Policy = privacy.NewPolicies(auditeventMixin[2], schema.AuditEvent{})
+	auditevent.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := auditevent.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	auditeventMixinFields0 := auditeventMixin[0].Fields()
+	_ = auditeventMixinFields0
+	auditeventMixinFields2 := auditeventMixin[2].Fields()
+	_ = auditeventMixinFields2
+	auditeventFields := schema.AuditEvent{}.Fields()
+	_ = auditeventFields
+	// auditeventDescTenantID is the schema descriptor for tenant_id field.
+	auditeventDescTenantID := auditeventMixinFields2[0].Descriptor()
+	// auditevent.DefaultTenantID holds the default value on creation for the tenant_id field.
+	auditevent.DefaultTenantID = auditeventDescTenantID.Default.(uint32)
+	// auditeventDescUserID is the schema descriptor for user_id field.
+	auditeventDescUserID := auditeventFields[0].Descriptor()
+	// auditevent.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	auditevent.UserIDValidator = auditeventDescUserID.Validators[0].(func(string) error)
+	// auditeventDescResourceID is the schema descriptor for resource_id field.
+	auditeventDescResourceID := auditeventFields[3].Descriptor()
+	// auditevent.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	auditevent.ResourceIDValidator = func() func(string) error {
+		validators := auditeventDescResourceID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(resource_id string) error {
+			for _, fn := range fns {
+				if err := fn(resource_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// auditeventDescResourceName is the schema descriptor for resource_name field.
+	auditeventDescResourceName := auditeventFields[4].Descriptor()
+	// auditevent.ResourceNameValidator is a validator for the "resource_name" field. It is called by the builders before save.
+	auditevent.ResourceNameValidator = auditeventDescResourceName.Validators[0].(func(string) error)
+	// auditeventDescID is the schema descriptor for id field.
+	auditeventDescID := auditeventMixinFields0[0].Descriptor()
+	// auditevent.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	auditevent.IDValidator = auditeventDescID.Validators[0].(func(uint32) error)
 	auditlogMixin := schema.AuditLog{}.Mixin()
 	auditlog.Policy = privacy.NewPolicies(auditlogMixin[2], schema.AuditLog{})
 	auditlog.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -77,14 +233,23 @@ func init() {
 			return next.Mutate(ctx, m)
 		})
 	}
+	categoryMixinHooks3 := categoryMixin[3].Hooks()
+
+	category.Hooks[1] = categoryMixinHooks3[0]
 	categoryMixinFields2 := categoryMixin[2].Fields()
 	_ = categoryMixinFields2
+	categoryMixinFields3 := categoryMixin[3].Fields()
+	_ = categoryMixinFields3
 	categoryFields := schema.Category{}.Fields()
 	_ = categoryFields
 	// categoryDescTenantID is the schema descriptor for tenant_id field.
 	categoryDescTenantID := categoryMixinFields2[0].Descriptor()
 	// category.DefaultTenantID holds the default value on creation for the tenant_id field.
 	category.DefaultTenantID = categoryDescTenantID.Default.(uint32)
+	// categoryDescVersion is the schema descriptor for version field.
+	categoryDescVersion := categoryMixinFields3[0].Descriptor()
+	// category.DefaultVersion holds the default value on creation for the version field.
+	category.DefaultVersion = categoryDescVersion.Default.(uint32)
 	// categoryDescName is the schema descriptor for name field.
 	categoryDescName := categoryFields[2].Descriptor()
 	// category.NameValidator is a validator for the "name" field. It is called by the builders before save.
@@ -125,18 +290,122 @@ func init() {
 	categoryDescDescription := categoryFields[4].Descriptor()
 	// category.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
 	category.DescriptionValidator = categoryDescDescription.Validators[0].(func(string) error)
+	// categoryDescColor is the schema descriptor for color field.
+	categoryDescColor := categoryFields[5].Descriptor()
+	// category.ColorValidator is a validator for the "color" field. It is called by the builders before save.
+	category.ColorValidator = categoryDescColor.Validators[0].(func(string) error)
+	// categoryDescIcon is the schema descriptor for icon field.
+	categoryDescIcon := categoryFields[6].Descriptor()
+	// category.IconValidator is a validator for the "icon" field. It is called by the builders before save.
+	category.IconValidator = categoryDescIcon.Validators[0].(func(string) error)
 	// categoryDescDepth is the schema descriptor for depth field.
-	categoryDescDepth := categoryFields[5].Descriptor()
+	categoryDescDepth := categoryFields[7].Descriptor()
 	// category.DefaultDepth holds the default value on creation for the depth field.
 	category.DefaultDepth = categoryDescDepth.Default.(int32)
 	// categoryDescSortOrder is the schema descriptor for sort_order field.
-	categoryDescSortOrder := categoryFields[6].Descriptor()
+	categoryDescSortOrder := categoryFields[8].Descriptor()
 	// category.DefaultSortOrder holds the default value on creation for the sort_order field.
 	category.DefaultSortOrder = categoryDescSortOrder.Default.(int32)
+	// categoryDescDocumentCount is the schema descriptor for document_count field.
+	categoryDescDocumentCount := categoryFields[9].Descriptor()
+	// category.DefaultDocumentCount holds the default value on creation for the document_count field.
+	category.DefaultDocumentCount = categoryDescDocumentCount.Default.(int64)
+	// categoryDescSubtreeDocumentCount is the schema descriptor for subtree_document_count field.
+	categoryDescSubtreeDocumentCount := categoryFields[10].Descriptor()
+	// category.DefaultSubtreeDocumentCount holds the default value on creation for the subtree_document_count field.
+	category.DefaultSubtreeDocumentCount = categoryDescSubtreeDocumentCount.Default.(int64)
+	// categoryDescDocumentBytes is the schema descriptor for document_bytes field.
+	categoryDescDocumentBytes := categoryFields[11].Descriptor()
+	// category.DefaultDocumentBytes holds the default value on creation for the document_bytes field.
+	category.DefaultDocumentBytes = categoryDescDocumentBytes.Default.(int64)
+	// categoryDescSubtreeDocumentBytes is the schema descriptor for subtree_document_bytes field.
+	categoryDescSubtreeDocumentBytes := categoryFields[12].Descriptor()
+	// category.DefaultSubtreeDocumentBytes holds the default value on creation for the subtree_document_bytes field.
+	category.DefaultSubtreeDocumentBytes = categoryDescSubtreeDocumentBytes.Default.(int64)
+	// categoryDescRuleDocumentType is the schema descriptor for rule_document_type field.
+	categoryDescRuleDocumentType := categoryFields[16].Descriptor()
+	// category.RuleDocumentTypeValidator is a validator for the "rule_document_type" field. It is called by the builders before save.
+	category.RuleDocumentTypeValidator = categoryDescRuleDocumentType.Validators[0].(func(string) error)
+	// categoryDescRuleRetentionClass is the schema descriptor for rule_retention_class field.
+	categoryDescRuleRetentionClass := categoryFields[17].Descriptor()
+	// category.RuleRetentionClassValidator is a validator for the "rule_retention_class" field. It is called by the builders before save.
+	category.RuleRetentionClassValidator = categoryDescRuleRetentionClass.Validators[0].(func(string) error)
 	// categoryDescID is the schema descriptor for id field.
 	categoryDescID := categoryFields[0].Descriptor()
 	// category.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	category.IDValidator = categoryDescID.Validators[0].(func(string) error)
+	categorydeletejobMixin := schema.CategoryDeleteJob{}.Mixin()
+	categorydeletejob.Policy = privacy.NewPolicies(categorydeletejobMixin[2], schema.CategoryDeleteJob{})
+	categorydeletejob.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := categorydeletejob.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	categorydeletejobMixinFields2 := categorydeletejobMixin[2].Fields()
+	_ = categorydeletejobMixinFields2
+	categorydeletejobFields := schema.CategoryDeleteJob{}.Fields()
+	_ = categorydeletejobFields
+	// categorydeletejobDescTenantID is the schema descriptor for tenant_id field.
+	categorydeletejobDescTenantID := categorydeletejobMixinFields2[0].Descriptor()
+	// categorydeletejob.DefaultTenantID holds the default value on creation for the tenant_id field.
+	categorydeletejob.DefaultTenantID = categorydeletejobDescTenantID.Default.(uint32)
+	// categorydeletejobDescCategoryID is the schema descriptor for category_id field.
+	categorydeletejobDescCategoryID := categorydeletejobFields[1].Descriptor()
+	// categorydeletejob.CategoryIDValidator is a validator for the "category_id" field. It is called by the builders before save.
+	categorydeletejob.CategoryIDValidator = categorydeletejobDescCategoryID.Validators[0].(func(string) error)
+	// categorydeletejobDescCategoryPath is the schema descriptor for category_path field.
+	categorydeletejobDescCategoryPath := categorydeletejobFields[2].Descriptor()
+	// categorydeletejob.CategoryPathValidator is a validator for the "category_path" field. It is called by the builders before save.
+	categorydeletejob.CategoryPathValidator = func() func(string) error {
+		validators := categorydeletejobDescCategoryPath.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(category_path string) error {
+			for _, fn := range fns {
+				if err := fn(category_path); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// categorydeletejobDescTotalItems is the schema descriptor for total_items field.
+	categorydeletejobDescTotalItems := categorydeletejobFields[6].Descriptor()
+	// categorydeletejob.DefaultTotalItems holds the default value on creation for the total_items field.
+	categorydeletejob.DefaultTotalItems = categorydeletejobDescTotalItems.Default.(int64)
+	// categorydeletejobDescProcessedItems is the schema descriptor for processed_items field.
+	categorydeletejobDescProcessedItems := categorydeletejobFields[7].Descriptor()
+	// categorydeletejob.DefaultProcessedItems holds the default value on creation for the processed_items field.
+	categorydeletejob.DefaultProcessedItems = categorydeletejobDescProcessedItems.Default.(int64)
+	// categorydeletejobDescCategoriesDeleted is the schema descriptor for categories_deleted field.
+	categorydeletejobDescCategoriesDeleted := categorydeletejobFields[8].Descriptor()
+	// categorydeletejob.DefaultCategoriesDeleted holds the default value on creation for the categories_deleted field.
+	categorydeletejob.DefaultCategoriesDeleted = categorydeletejobDescCategoriesDeleted.Default.(int64)
+	// categorydeletejobDescCategoriesMoved is the schema descriptor for categories_moved field.
+	categorydeletejobDescCategoriesMoved := categorydeletejobFields[9].Descriptor()
+	// categorydeletejob.DefaultCategoriesMoved holds the default value on creation for the categories_moved field.
+	categorydeletejob.DefaultCategoriesMoved = categorydeletejobDescCategoriesMoved.Default.(int64)
+	// categorydeletejobDescDocumentsDeleted is the schema descriptor for documents_deleted field.
+	categorydeletejobDescDocumentsDeleted := categorydeletejobFields[10].Descriptor()
+	// categorydeletejob.DefaultDocumentsDeleted holds the default value on creation for the documents_deleted field.
+	categorydeletejob.DefaultDocumentsDeleted = categorydeletejobDescDocumentsDeleted.Default.(int64)
+	// categorydeletejobDescDocumentsMoved is the schema descriptor for documents_moved field.
+	categorydeletejobDescDocumentsMoved := categorydeletejobFields[11].Descriptor()
+	// categorydeletejob.DefaultDocumentsMoved holds the default value on creation for the documents_moved field.
+	categorydeletejob.DefaultDocumentsMoved = categorydeletejobDescDocumentsMoved.Default.(int64)
+	// categorydeletejobDescError is the schema descriptor for error field.
+	categorydeletejobDescError := categorydeletejobFields[12].Descriptor()
+	// categorydeletejob.ErrorValidator is a validator for the "error" field. It is called by the builders before save.
+	categorydeletejob.ErrorValidator = categorydeletejobDescError.Validators[0].(func(string) error)
+	// categorydeletejobDescID is the schema descriptor for id field.
+	categorydeletejobDescID := categorydeletejobFields[0].Descriptor()
+	// categorydeletejob.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	categorydeletejob.IDValidator = categorydeletejobDescID.Validators[0].(func(string) error)
 	documentMixin := schema.Document{}.Mixin()
 	document.Policy = privacy.NewPolicies(documentMixin[3], schema.Document{})
 	document.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -147,14 +416,25 @@ func init() {
 			return next.Mutate(ctx, m)
 		})
 	}
+	documentMixinHooks5 := documentMixin[5].Hooks()
+
+	document.Hooks[1] = documentMixinHooks5[0]
+	documentMixinInters4 := documentMixin[4].Interceptors()
+	document.Interceptors[0] = documentMixinInters4[0]
 	documentMixinFields3 := documentMixin[3].Fields()
 	_ = documentMixinFields3
+	documentMixinFields5 := documentMixin[5].Fields()
+	_ = documentMixinFields5
 	documentFields := schema.Document{}.Fields()
 	_ = documentFields
 	// documentDescTenantID is the schema descriptor for tenant_id field.
 	documentDescTenantID := documentMixinFields3[0].Descriptor()
 	// document.DefaultTenantID holds the default value on creation for the tenant_id field.
 	document.DefaultTenantID = documentDescTenantID.Default.(uint32)
+	// documentDescVersion is the schema descriptor for version field.
+	documentDescVersion := documentMixinFields5[0].Descriptor()
+	// document.DefaultVersion holds the default value on creation for the version field.
+	document.DefaultVersion = documentDescVersion.Default.(uint32)
 	// documentDescName is the schema descriptor for name field.
 	documentDescName := documentFields[2].Descriptor()
 	// document.NameValidator is a validator for the "name" field. It is called by the builders before save.
@@ -225,10 +505,96 @@ func init() {
 	documentDescChecksum := documentFields[8].Descriptor()
 	// document.ChecksumValidator is a validator for the "checksum" field. It is called by the builders before save.
 	document.ChecksumValidator = documentDescChecksum.Validators[0].(func(string) error)
+	// documentDescDocumentType is the schema descriptor for document_type field.
+	documentDescDocumentType := documentFields[10].Descriptor()
+	// document.DocumentTypeValidator is a validator for the "document_type" field. It is called by the builders before save.
+	document.DocumentTypeValidator = documentDescDocumentType.Validators[0].(func(string) error)
+	// documentDescRetentionClass is the schema descriptor for retention_class field.
+	documentDescRetentionClass := documentFields[11].Descriptor()
+	// document.RetentionClassValidator is a validator for the "retention_class" field. It is called by the builders before save.
+	document.RetentionClassValidator = documentDescRetentionClass.Validators[0].(func(string) error)
 	// documentDescID is the schema descriptor for id field.
 	documentDescID := documentFields[0].Descriptor()
 	// document.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	document.IDValidator = documentDescID.Validators[0].(func(string) error)
+	documentcontentMixin := schema.DocumentContent{}.Mixin()
+	documentcontent.Policy = privacy.NewPolicies(documentcontentMixin[1], schema.DocumentContent{})
+	documentcontent.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := documentcontent.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	documentcontentMixinFields0 := documentcontentMixin[0].Fields()
+	_ = documentcontentMixinFields0
+	documentcontentMixinFields1 := documentcontentMixin[1].Fields()
+	_ = documentcontentMixinFields1
+	documentcontentFields := schema.DocumentContent{}.Fields()
+	_ = documentcontentFields
+	// documentcontentDescTenantID is the schema descriptor for tenant_id field.
+	documentcontentDescTenantID := documentcontentMixinFields1[0].Descriptor()
+	// documentcontent.DefaultTenantID holds the default value on creation for the tenant_id field.
+	documentcontent.DefaultTenantID = documentcontentDescTenantID.Default.(uint32)
+	// documentcontentDescDocumentID is the schema descriptor for document_id field.
+	documentcontentDescDocumentID := documentcontentFields[0].Descriptor()
+	// documentcontent.DocumentIDValidator is a validator for the "document_id" field. It is called by the builders before save.
+	documentcontent.DocumentIDValidator = documentcontentDescDocumentID.Validators[0].(func(string) error)
+	// documentcontentDescID is the schema descriptor for id field.
+	documentcontentDescID := documentcontentMixinFields0[0].Descriptor()
+	// documentcontent.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	documentcontent.IDValidator = documentcontentDescID.Validators[0].(func(uint32) error)
+	documenthistoryMixin := schema.DocumentHistory{}.Mixin()
+	documenthistory.Policy = privacy.NewPolicies(documenthistoryMixin[2], schema.DocumentHistory{})
+	documenthistory.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := documenthistory.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	documenthistoryMixinFields0 := documenthistoryMixin[0].Fields()
+	_ = documenthistoryMixinFields0
+	documenthistoryMixinFields2 := documenthistoryMixin[2].Fields()
+	_ = documenthistoryMixinFields2
+	documenthistoryFields := schema.DocumentHistory{}.Fields()
+	_ = documenthistoryFields
+	// documenthistoryDescTenantID is the schema descriptor for tenant_id field.
+	documenthistoryDescTenantID := documenthistoryMixinFields2[0].Descriptor()
+	// documenthistory.DefaultTenantID holds the default value on creation for the tenant_id field.
+	documenthistory.DefaultTenantID = documenthistoryDescTenantID.Default.(uint32)
+	// documenthistoryDescDocumentID is the schema descriptor for document_id field.
+	documenthistoryDescDocumentID := documenthistoryFields[0].Descriptor()
+	// documenthistory.DocumentIDValidator is a validator for the "document_id" field. It is called by the builders before save.
+	documenthistory.DocumentIDValidator = func() func(string) error {
+		validators := documenthistoryDescDocumentID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(document_id string) error {
+			for _, fn := range fns {
+				if err := fn(document_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// documenthistoryDescUserID is the schema descriptor for user_id field.
+	documenthistoryDescUserID := documenthistoryFields[1].Descriptor()
+	// documenthistory.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	documenthistory.UserIDValidator = documenthistoryDescUserID.Validators[0].(func(string) error)
+	// documenthistoryDescVersion is the schema descriptor for version field.
+	documenthistoryDescVersion := documenthistoryFields[2].Descriptor()
+	// documenthistory.DefaultVersion holds the default value on creation for the version field.
+	documenthistory.DefaultVersion = documenthistoryDescVersion.Default.(uint32)
+	// documenthistoryDescID is the schema descriptor for id field.
+	documenthistoryDescID := documenthistoryMixinFields0[0].Descriptor()
+	// documenthistory.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	documenthistory.IDValidator = documenthistoryDescID.Validators[0].(func(uint32) error)
 	documentpermissionMixin := schema.DocumentPermission{}.Mixin()
 	documentpermission.Policy = privacy.NewPolicies(documentpermissionMixin[1], schema.DocumentPermission{})
 	documentpermission.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -283,6 +649,983 @@ func init() {
 			return nil
 		}
 	}()
+	documenttagMixin := schema.DocumentTag{}.Mixin()
+	documenttag.Policy = privacy.NewPolicies(documenttagMixin[1], schema.DocumentTag{})
+	documenttag.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := documenttag.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	documenttagMixinFields0 := documenttagMixin[0].Fields()
+	_ = documenttagMixinFields0
+	documenttagMixinFields1 := documenttagMixin[1].Fields()
+	_ = documenttagMixinFields1
+	documenttagFields := schema.DocumentTag{}.Fields()
+	_ = documenttagFields
+	// documenttagDescTenantID is the schema descriptor for tenant_id field.
+	documenttagDescTenantID := documenttagMixinFields1[0].Descriptor()
+	// documenttag.DefaultTenantID holds the default value on creation for the tenant_id field.
+	documenttag.DefaultTenantID = documenttagDescTenantID.Default.(uint32)
+	// documenttagDescDocumentID is the schema descriptor for document_id field.
+	documenttagDescDocumentID := documenttagFields[0].Descriptor()
+	// documenttag.DocumentIDValidator is a validator for the "document_id" field. It is called by the builders before save.
+	documenttag.DocumentIDValidator = documenttagDescDocumentID.Validators[0].(func(string) error)
+	// documenttagDescValue is the schema descriptor for value field.
+	documenttagDescValue := documenttagFields[2].Descriptor()
+	// documenttag.DefaultValue holds the default value on creation for the value field.
+	documenttag.DefaultValue = documenttagDescValue.Default.(string)
+	// documenttagDescID is the schema descriptor for id field.
+	documenttagDescID := documenttagMixinFields0[0].Descriptor()
+	// documenttag.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	documenttag.IDValidator = documenttagDescID.Validators[0].(func(uint32) error)
+	groupmembershipMixin := schema.GroupMembership{}.Mixin()
+	groupmembership.Policy = privacy.NewPolicies(groupmembershipMixin[2], schema.GroupMembership{})
+	groupmembership.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := groupmembership.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	groupmembershipMixinFields0 := groupmembershipMixin[0].Fields()
+	_ = groupmembershipMixinFields0
+	groupmembershipMixinFields2 := groupmembershipMixin[2].Fields()
+	_ = groupmembershipMixinFields2
+	groupmembershipFields := schema.GroupMembership{}.Fields()
+	_ = groupmembershipFields
+	// groupmembershipDescTenantID is the schema descriptor for tenant_id field.
+	groupmembershipDescTenantID := groupmembershipMixinFields2[0].Descriptor()
+	// groupmembership.DefaultTenantID holds the default value on creation for the tenant_id field.
+	groupmembership.DefaultTenantID = groupmembershipDescTenantID.Default.(uint32)
+	// groupmembershipDescGroupID is the schema descriptor for group_id field.
+	groupmembershipDescGroupID := groupmembershipFields[0].Descriptor()
+	// groupmembership.GroupIDValidator is a validator for the "group_id" field. It is called by the builders before save.
+	groupmembership.GroupIDValidator = func() func(string) error {
+		validators := groupmembershipDescGroupID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(group_id string) error {
+			for _, fn := range fns {
+				if err := fn(group_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// groupmembershipDescGroupName is the schema descriptor for group_name field.
+	groupmembershipDescGroupName := groupmembershipFields[1].Descriptor()
+	// groupmembership.GroupNameValidator is a validator for the "group_name" field. It is called by the builders before save.
+	groupmembership.GroupNameValidator = groupmembershipDescGroupName.Validators[0].(func(string) error)
+	// groupmembershipDescUserID is the schema descriptor for user_id field.
+	groupmembershipDescUserID := groupmembershipFields[2].Descriptor()
+	// groupmembership.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	groupmembership.UserIDValidator = func() func(string) error {
+		validators := groupmembershipDescUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(user_id string) error {
+			for _, fn := range fns {
+				if err := fn(user_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// groupmembershipDescID is the schema descriptor for id field.
+	groupmembershipDescID := groupmembershipMixinFields0[0].Descriptor()
+	// groupmembership.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	groupmembership.IDValidator = groupmembershipDescID.Validators[0].(func(uint32) error)
+	idempotencykeyMixin := schema.IdempotencyKey{}.Mixin()
+	idempotencykey.Policy = privacy.NewPolicies(idempotencykeyMixin[2], schema.IdempotencyKey{})
+	idempotencykey.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := idempotencykey.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	idempotencykeyMixinFields0 := idempotencykeyMixin[0].Fields()
+	_ = idempotencykeyMixinFields0
+	idempotencykeyMixinFields2 := idempotencykeyMixin[2].Fields()
+	_ = idempotencykeyMixinFields2
+	idempotencykeyFields := schema.IdempotencyKey{}.Fields()
+	_ = idempotencykeyFields
+	// idempotencykeyDescTenantID is the schema descriptor for tenant_id field.
+	idempotencykeyDescTenantID := idempotencykeyMixinFields2[0].Descriptor()
+	// idempotencykey.DefaultTenantID holds the default value on creation for the tenant_id field.
+	idempotencykey.DefaultTenantID = idempotencykeyDescTenantID.Default.(uint32)
+	// idempotencykeyDescUserID is the schema descriptor for user_id field.
+	idempotencykeyDescUserID := idempotencykeyFields[0].Descriptor()
+	// idempotencykey.DefaultUserID holds the default value on creation for the user_id field.
+	idempotencykey.DefaultUserID = idempotencykeyDescUserID.Default.(string)
+	// idempotencykey.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	idempotencykey.UserIDValidator = idempotencykeyDescUserID.Validators[0].(func(string) error)
+	// idempotencykeyDescKey is the schema descriptor for key field.
+	idempotencykeyDescKey := idempotencykeyFields[1].Descriptor()
+	// idempotencykey.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	idempotencykey.KeyValidator = func() func(string) error {
+		validators := idempotencykeyDescKey.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(key string) error {
+			for _, fn := range fns {
+				if err := fn(key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// idempotencykeyDescOperation is the schema descriptor for operation field.
+	idempotencykeyDescOperation := idempotencykeyFields[2].Descriptor()
+	// idempotencykey.OperationValidator is a validator for the "operation" field. It is called by the builders before save.
+	idempotencykey.OperationValidator = func() func(string) error {
+		validators := idempotencykeyDescOperation.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(operation string) error {
+			for _, fn := range fns {
+				if err := fn(operation); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// idempotencykeyDescRequestHash is the schema descriptor for request_hash field.
+	idempotencykeyDescRequestHash := idempotencykeyFields[3].Descriptor()
+	// idempotencykey.RequestHashValidator is a validator for the "request_hash" field. It is called by the builders before save.
+	idempotencykey.RequestHashValidator = func() func(string) error {
+		validators := idempotencykeyDescRequestHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(request_hash string) error {
+			for _, fn := range fns {
+				if err := fn(request_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// idempotencykeyDescCompleted is the schema descriptor for completed field.
+	idempotencykeyDescCompleted := idempotencykeyFields[4].Descriptor()
+	// idempotencykey.DefaultCompleted holds the default value on creation for the completed field.
+	idempotencykey.DefaultCompleted = idempotencykeyDescCompleted.Default.(bool)
+	// idempotencykeyDescID is the schema descriptor for id field.
+	idempotencykeyDescID := idempotencykeyMixinFields0[0].Descriptor()
+	// idempotencykey.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	idempotencykey.IDValidator = idempotencykeyDescID.Validators[0].(func(uint32) error)
+	importconnectorMixin := schema.ImportConnector{}.Mixin()
+	importconnector.Policy = privacy.NewPolicies(importconnectorMixin[2], schema.ImportConnector{})
+	importconnector.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := importconnector.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	importconnectorMixinFields2 := importconnectorMixin[2].Fields()
+	_ = importconnectorMixinFields2
+	importconnectorFields := schema.ImportConnector{}.Fields()
+	_ = importconnectorFields
+	// importconnectorDescTenantID is the schema descriptor for tenant_id field.
+	importconnectorDescTenantID := importconnectorMixinFields2[0].Descriptor()
+	// importconnector.DefaultTenantID holds the default value on creation for the tenant_id field.
+	importconnector.DefaultTenantID = importconnectorDescTenantID.Default.(uint32)
+	// importconnectorDescName is the schema descriptor for name field.
+	importconnectorDescName := importconnectorFields[1].Descriptor()
+	// importconnector.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	importconnector.NameValidator = func() func(string) error {
+		validators := importconnectorDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// importconnectorDescCredentials is the schema descriptor for credentials field.
+	importconnectorDescCredentials := importconnectorFields[3].Descriptor()
+	// importconnector.CredentialsValidator is a validator for the "credentials" field. It is called by the builders before save.
+	importconnector.CredentialsValidator = importconnectorDescCredentials.Validators[0].(func(string) error)
+	// importconnectorDescEnabled is the schema descriptor for enabled field.
+	importconnectorDescEnabled := importconnectorFields[5].Descriptor()
+	// importconnector.DefaultEnabled holds the default value on creation for the enabled field.
+	importconnector.DefaultEnabled = importconnectorDescEnabled.Default.(bool)
+	// importconnectorDescID is the schema descriptor for id field.
+	importconnectorDescID := importconnectorFields[0].Descriptor()
+	// importconnector.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	importconnector.IDValidator = importconnectorDescID.Validators[0].(func(string) error)
+	importmappingMixin := schema.ImportMapping{}.Mixin()
+	importmapping.Policy = privacy.NewPolicies(importmappingMixin[2], schema.ImportMapping{})
+	importmapping.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := importmapping.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	importmappingMixinFields2 := importmappingMixin[2].Fields()
+	_ = importmappingMixinFields2
+	importmappingFields := schema.ImportMapping{}.Fields()
+	_ = importmappingFields
+	// importmappingDescTenantID is the schema descriptor for tenant_id field.
+	importmappingDescTenantID := importmappingMixinFields2[0].Descriptor()
+	// importmapping.DefaultTenantID holds the default value on creation for the tenant_id field.
+	importmapping.DefaultTenantID = importmappingDescTenantID.Default.(uint32)
+	// importmappingDescConnectorID is the schema descriptor for connector_id field.
+	importmappingDescConnectorID := importmappingFields[1].Descriptor()
+	// importmapping.ConnectorIDValidator is a validator for the "connector_id" field. It is called by the builders before save.
+	importmapping.ConnectorIDValidator = importmappingDescConnectorID.Validators[0].(func(string) error)
+	// importmappingDescRemoteFolderID is the schema descriptor for remote_folder_id field.
+	importmappingDescRemoteFolderID := importmappingFields[2].Descriptor()
+	// importmapping.RemoteFolderIDValidator is a validator for the "remote_folder_id" field. It is called by the builders before save.
+	importmapping.RemoteFolderIDValidator = func() func(string) error {
+		validators := importmappingDescRemoteFolderID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(remote_folder_id string) error {
+			for _, fn := range fns {
+				if err := fn(remote_folder_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// importmappingDescRemoteFolderName is the schema descriptor for remote_folder_name field.
+	importmappingDescRemoteFolderName := importmappingFields[3].Descriptor()
+	// importmapping.RemoteFolderNameValidator is a validator for the "remote_folder_name" field. It is called by the builders before save.
+	importmapping.RemoteFolderNameValidator = importmappingDescRemoteFolderName.Validators[0].(func(string) error)
+	// importmappingDescIncludeSubfolders is the schema descriptor for include_subfolders field.
+	importmappingDescIncludeSubfolders := importmappingFields[5].Descriptor()
+	// importmapping.DefaultIncludeSubfolders holds the default value on creation for the include_subfolders field.
+	importmapping.DefaultIncludeSubfolders = importmappingDescIncludeSubfolders.Default.(bool)
+	// importmappingDescSyncIntervalMinutes is the schema descriptor for sync_interval_minutes field.
+	importmappingDescSyncIntervalMinutes := importmappingFields[6].Descriptor()
+	// importmapping.DefaultSyncIntervalMinutes holds the default value on creation for the sync_interval_minutes field.
+	importmapping.DefaultSyncIntervalMinutes = importmappingDescSyncIntervalMinutes.Default.(int32)
+	// importmappingDescEnabled is the schema descriptor for enabled field.
+	importmappingDescEnabled := importmappingFields[7].Descriptor()
+	// importmapping.DefaultEnabled holds the default value on creation for the enabled field.
+	importmapping.DefaultEnabled = importmappingDescEnabled.Default.(bool)
+	// importmappingDescLastError is the schema descriptor for last_error field.
+	importmappingDescLastError := importmappingFields[11].Descriptor()
+	// importmapping.LastErrorValidator is a validator for the "last_error" field. It is called by the builders before save.
+	importmapping.LastErrorValidator = importmappingDescLastError.Validators[0].(func(string) error)
+	// importmappingDescLastImportedCount is the schema descriptor for last_imported_count field.
+	importmappingDescLastImportedCount := importmappingFields[12].Descriptor()
+	// importmapping.DefaultLastImportedCount holds the default value on creation for the last_imported_count field.
+	importmapping.DefaultLastImportedCount = importmappingDescLastImportedCount.Default.(int32)
+	// importmappingDescLastSkippedCount is the schema descriptor for last_skipped_count field.
+	importmappingDescLastSkippedCount := importmappingFields[13].Descriptor()
+	// importmapping.DefaultLastSkippedCount holds the default value on creation for the last_skipped_count field.
+	importmapping.DefaultLastSkippedCount = importmappingDescLastSkippedCount.Default.(int32)
+	// importmappingDescLastFailedCount is the schema descriptor for last_failed_count field.
+	importmappingDescLastFailedCount := importmappingFields[14].Descriptor()
+	// importmapping.DefaultLastFailedCount holds the default value on creation for the last_failed_count field.
+	importmapping.DefaultLastFailedCount = importmappingDescLastFailedCount.Default.(int32)
+	// importmappingDescID is the schema descriptor for id field.
+	importmappingDescID := importmappingFields[0].Descriptor()
+	// importmapping.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	importmapping.IDValidator = importmappingDescID.Validators[0].(func(string) error)
+	importedfileMixin := schema.ImportedFile{}.Mixin()
+	importedfile.Policy = privacy.NewPolicies(importedfileMixin[2], schema.ImportedFile{})
+	importedfile.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := importedfile.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	importedfileMixinFields0 := importedfileMixin[0].Fields()
+	_ = importedfileMixinFields0
+	importedfileMixinFields2 := importedfileMixin[2].Fields()
+	_ = importedfileMixinFields2
+	importedfileFields := schema.ImportedFile{}.Fields()
+	_ = importedfileFields
+	// importedfileDescTenantID is the schema descriptor for tenant_id field.
+	importedfileDescTenantID := importedfileMixinFields2[0].Descriptor()
+	// importedfile.DefaultTenantID holds the default value on creation for the tenant_id field.
+	importedfile.DefaultTenantID = importedfileDescTenantID.Default.(uint32)
+	// importedfileDescMappingID is the schema descriptor for mapping_id field.
+	importedfileDescMappingID := importedfileFields[0].Descriptor()
+	// importedfile.MappingIDValidator is a validator for the "mapping_id" field. It is called by the builders before save.
+	importedfile.MappingIDValidator = importedfileDescMappingID.Validators[0].(func(string) error)
+	// importedfileDescRemoteFileID is the schema descriptor for remote_file_id field.
+	importedfileDescRemoteFileID := importedfileFields[1].Descriptor()
+	// importedfile.RemoteFileIDValidator is a validator for the "remote_file_id" field. It is called by the builders before save.
+	importedfile.RemoteFileIDValidator = func() func(string) error {
+		validators := importedfileDescRemoteFileID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(remote_file_id string) error {
+			for _, fn := range fns {
+				if err := fn(remote_file_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// importedfileDescRemoteVersion is the schema descriptor for remote_version field.
+	importedfileDescRemoteVersion := importedfileFields[2].Descriptor()
+	// importedfile.RemoteVersionValidator is a validator for the "remote_version" field. It is called by the builders before save.
+	importedfile.RemoteVersionValidator = importedfileDescRemoteVersion.Validators[0].(func(string) error)
+	// importedfileDescDocumentID is the schema descriptor for document_id field.
+	importedfileDescDocumentID := importedfileFields[3].Descriptor()
+	// importedfile.DocumentIDValidator is a validator for the "document_id" field. It is called by the builders before save.
+	importedfile.DocumentIDValidator = importedfileDescDocumentID.Validators[0].(func(string) error)
+	// importedfileDescID is the schema descriptor for id field.
+	importedfileDescID := importedfileMixinFields0[0].Descriptor()
+	// importedfile.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	importedfile.IDValidator = importedfileDescID.Validators[0].(func(uint32) error)
+	notificationpreferenceMixin := schema.NotificationPreference{}.Mixin()
+	notificationpreference.Policy = privacy.NewPolicies(notificationpreferenceMixin[2], schema.NotificationPreference{})
+	notificationpreference.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := notificationpreference.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	notificationpreferenceMixinFields0 := notificationpreferenceMixin[0].Fields()
+	_ = notificationpreferenceMixinFields0
+	notificationpreferenceMixinFields2 := notificationpreferenceMixin[2].Fields()
+	_ = notificationpreferenceMixinFields2
+	notificationpreferenceFields := schema.NotificationPreference{}.Fields()
+	_ = notificationpreferenceFields
+	// notificationpreferenceDescTenantID is the schema descriptor for tenant_id field.
+	notificationpreferenceDescTenantID := notificationpreferenceMixinFields2[0].Descriptor()
+	// notificationpreference.DefaultTenantID holds the default value on creation for the tenant_id field.
+	notificationpreference.DefaultTenantID = notificationpreferenceDescTenantID.Default.(uint32)
+	// notificationpreferenceDescShareEnabled is the schema descriptor for share_enabled field.
+	notificationpreferenceDescShareEnabled := notificationpreferenceFields[1].Descriptor()
+	// notificationpreference.DefaultShareEnabled holds the default value on creation for the share_enabled field.
+	notificationpreference.DefaultShareEnabled = notificationpreferenceDescShareEnabled.Default.(bool)
+	// notificationpreferenceDescMentionEnabled is the schema descriptor for mention_enabled field.
+	notificationpreferenceDescMentionEnabled := notificationpreferenceFields[2].Descriptor()
+	// notificationpreference.DefaultMentionEnabled holds the default value on creation for the mention_enabled field.
+	notificationpreference.DefaultMentionEnabled = notificationpreferenceDescMentionEnabled.Default.(bool)
+	// notificationpreferenceDescReminderEnabled is the schema descriptor for reminder_enabled field.
+	notificationpreferenceDescReminderEnabled := notificationpreferenceFields[3].Descriptor()
+	// notificationpreference.DefaultReminderEnabled holds the default value on creation for the reminder_enabled field.
+	notificationpreference.DefaultReminderEnabled = notificationpreferenceDescReminderEnabled.Default.(bool)
+	// notificationpreferenceDescAssignmentEnabled is the schema descriptor for assignment_enabled field.
+	notificationpreferenceDescAssignmentEnabled := notificationpreferenceFields[4].Descriptor()
+	// notificationpreference.DefaultAssignmentEnabled holds the default value on creation for the assignment_enabled field.
+	notificationpreference.DefaultAssignmentEnabled = notificationpreferenceDescAssignmentEnabled.Default.(bool)
+	// notificationpreferenceDescID is the schema descriptor for id field.
+	notificationpreferenceDescID := notificationpreferenceMixinFields0[0].Descriptor()
+	// notificationpreference.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	notificationpreference.IDValidator = notificationpreferenceDescID.Validators[0].(func(uint32) error)
+	outboxeventMixin := schema.OutboxEvent{}.Mixin()
+	outboxevent.Policy = privacy.NewPolicies(outboxeventMixin[2], schema.OutboxEvent{})
+	outboxevent.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := outboxevent.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	outboxeventMixinFields0 := outboxeventMixin[0].Fields()
+	_ = outboxeventMixinFields0
+	outboxeventMixinFields2 := outboxeventMixin[2].Fields()
+	_ = outboxeventMixinFields2
+	outboxeventFields := schema.OutboxEvent{}.Fields()
+	_ = outboxeventFields
+	// outboxeventDescTenantID is the schema descriptor for tenant_id field.
+	outboxeventDescTenantID := outboxeventMixinFields2[0].Descriptor()
+	// outboxevent.DefaultTenantID holds the default value on creation for the tenant_id field.
+	outboxevent.DefaultTenantID = outboxeventDescTenantID.Default.(uint32)
+	// outboxeventDescEventID is the schema descriptor for event_id field.
+	outboxeventDescEventID := outboxeventFields[0].Descriptor()
+	// outboxevent.EventIDValidator is a validator for the "event_id" field. It is called by the builders before save.
+	outboxevent.EventIDValidator = func() func(string) error {
+		validators := outboxeventDescEventID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(event_id string) error {
+			for _, fn := range fns {
+				if err := fn(event_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// outboxeventDescEventType is the schema descriptor for event_type field.
+	outboxeventDescEventType := outboxeventFields[1].Descriptor()
+	// outboxevent.EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	outboxevent.EventTypeValidator = func() func(string) error {
+		validators := outboxeventDescEventType.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(event_type string) error {
+			for _, fn := range fns {
+				if err := fn(event_type); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// outboxeventDescSubject is the schema descriptor for subject field.
+	outboxeventDescSubject := outboxeventFields[2].Descriptor()
+	// outboxevent.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	outboxevent.SubjectValidator = outboxeventDescSubject.Validators[0].(func(string) error)
+	// outboxeventDescAttempts is the schema descriptor for attempts field.
+	outboxeventDescAttempts := outboxeventFields[4].Descriptor()
+	// outboxevent.DefaultAttempts holds the default value on creation for the attempts field.
+	outboxevent.DefaultAttempts = outboxeventDescAttempts.Default.(int32)
+	// outboxeventDescLastError is the schema descriptor for last_error field.
+	outboxeventDescLastError := outboxeventFields[6].Descriptor()
+	// outboxevent.LastErrorValidator is a validator for the "last_error" field. It is called by the builders before save.
+	outboxevent.LastErrorValidator = outboxeventDescLastError.Validators[0].(func(string) error)
+	// outboxeventDescID is the schema descriptor for id field.
+	outboxeventDescID := outboxeventMixinFields0[0].Descriptor()
+	// outboxevent.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	outboxevent.IDValidator = outboxeventDescID.Validators[0].(func(uint32) error)
+	reviewtaskMixin := schema.ReviewTask{}.Mixin()
+	reviewtask.Policy = privacy.NewPolicies(reviewtaskMixin[2], schema.ReviewTask{})
+	reviewtask.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := reviewtask.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	reviewtaskMixinFields2 := reviewtaskMixin[2].Fields()
+	_ = reviewtaskMixinFields2
+	reviewtaskFields := schema.ReviewTask{}.Fields()
+	_ = reviewtaskFields
+	// reviewtaskDescTenantID is the schema descriptor for tenant_id field.
+	reviewtaskDescTenantID := reviewtaskMixinFields2[0].Descriptor()
+	// reviewtask.DefaultTenantID holds the default value on creation for the tenant_id field.
+	reviewtask.DefaultTenantID = reviewtaskDescTenantID.Default.(uint32)
+	// reviewtaskDescDocumentID is the schema descriptor for document_id field.
+	reviewtaskDescDocumentID := reviewtaskFields[1].Descriptor()
+	// reviewtask.DocumentIDValidator is a validator for the "document_id" field. It is called by the builders before save.
+	reviewtask.DocumentIDValidator = reviewtaskDescDocumentID.Validators[0].(func(string) error)
+	// reviewtaskDescMessage is the schema descriptor for message field.
+	reviewtaskDescMessage := reviewtaskFields[4].Descriptor()
+	// reviewtask.MessageValidator is a validator for the "message" field. It is called by the builders before save.
+	reviewtask.MessageValidator = reviewtaskDescMessage.Validators[0].(func(string) error)
+	// reviewtaskDescID is the schema descriptor for id field.
+	reviewtaskDescID := reviewtaskFields[0].Descriptor()
+	// reviewtask.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	reviewtask.IDValidator = reviewtaskDescID.Validators[0].(func(string) error)
+	settingMixin := schema.Setting{}.Mixin()
+	settingMixinFields0 := settingMixin[0].Fields()
+	_ = settingMixinFields0
+	settingFields := schema.Setting{}.Fields()
+	_ = settingFields
+	// settingDescName is the schema descriptor for name field.
+	settingDescName := settingFields[0].Descriptor()
+	// setting.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	setting.NameValidator = func() func(string) error {
+		validators := settingDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// settingDescID is the schema descriptor for id field.
+	settingDescID := settingMixinFields0[0].Descriptor()
+	// setting.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	setting.IDValidator = settingDescID.Validators[0].(func(uint32) error)
+	signaturerequestMixin := schema.SignatureRequest{}.Mixin()
+	signaturerequest.Policy = privacy.NewPolicies(signaturerequestMixin[2], schema.SignatureRequest{})
+	signaturerequest.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := signaturerequest.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	signaturerequestMixinFields2 := signaturerequestMixin[2].Fields()
+	_ = signaturerequestMixinFields2
+	signaturerequestFields := schema.SignatureRequest{}.Fields()
+	_ = signaturerequestFields
+	// signaturerequestDescTenantID is the schema descriptor for tenant_id field.
+	signaturerequestDescTenantID := signaturerequestMixinFields2[0].Descriptor()
+	// signaturerequest.DefaultTenantID holds the default value on creation for the tenant_id field.
+	signaturerequest.DefaultTenantID = signaturerequestDescTenantID.Default.(uint32)
+	// signaturerequestDescDocumentID is the schema descriptor for document_id field.
+	signaturerequestDescDocumentID := signaturerequestFields[1].Descriptor()
+	// signaturerequest.DocumentIDValidator is a validator for the "document_id" field. It is called by the builders before save.
+	signaturerequest.DocumentIDValidator = signaturerequestDescDocumentID.Validators[0].(func(string) error)
+	// signaturerequestDescProvider is the schema descriptor for provider field.
+	signaturerequestDescProvider := signaturerequestFields[2].Descriptor()
+	// signaturerequest.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	signaturerequest.ProviderValidator = func() func(string) error {
+		validators := signaturerequestDescProvider.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(provider string) error {
+			for _, fn := range fns {
+				if err := fn(provider); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// signaturerequestDescEnvelopeID is the schema descriptor for envelope_id field.
+	signaturerequestDescEnvelopeID := signaturerequestFields[3].Descriptor()
+	// signaturerequest.EnvelopeIDValidator is a validator for the "envelope_id" field. It is called by the builders before save.
+	signaturerequest.EnvelopeIDValidator = signaturerequestDescEnvelopeID.Validators[0].(func(string) error)
+	// signaturerequestDescSubject is the schema descriptor for subject field.
+	signaturerequestDescSubject := signaturerequestFields[6].Descriptor()
+	// signaturerequest.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	signaturerequest.SubjectValidator = func() func(string) error {
+		validators := signaturerequestDescSubject.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(subject string) error {
+			for _, fn := range fns {
+				if err := fn(subject); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// signaturerequestDescOriginalFileKey is the schema descriptor for original_file_key field.
+	signaturerequestDescOriginalFileKey := signaturerequestFields[8].Descriptor()
+	// signaturerequest.OriginalFileKeyValidator is a validator for the "original_file_key" field. It is called by the builders before save.
+	signaturerequest.OriginalFileKeyValidator = signaturerequestDescOriginalFileKey.Validators[0].(func(string) error)
+	// signaturerequestDescLastError is the schema descriptor for last_error field.
+	signaturerequestDescLastError := signaturerequestFields[11].Descriptor()
+	// signaturerequest.LastErrorValidator is a validator for the "last_error" field. It is called by the builders before save.
+	signaturerequest.LastErrorValidator = signaturerequestDescLastError.Validators[0].(func(string) error)
+	// signaturerequestDescID is the schema descriptor for id field.
+	signaturerequestDescID := signaturerequestFields[0].Descriptor()
+	// signaturerequest.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	signaturerequest.IDValidator = signaturerequestDescID.Validators[0].(func(string) error)
+	statisticsrollupMixin := schema.StatisticsRollup{}.Mixin()
+	statisticsrollup.Policy = privacy.NewPolicies(statisticsrollupMixin[2], schema.StatisticsRollup{})
+	statisticsrollup.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := statisticsrollup.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	statisticsrollupMixinFields0 := statisticsrollupMixin[0].Fields()
+	_ = statisticsrollupMixinFields0
+	statisticsrollupMixinFields2 := statisticsrollupMixin[2].Fields()
+	_ = statisticsrollupMixinFields2
+	statisticsrollupFields := schema.StatisticsRollup{}.Fields()
+	_ = statisticsrollupFields
+	// statisticsrollupDescTenantID is the schema descriptor for tenant_id field.
+	statisticsrollupDescTenantID := statisticsrollupMixinFields2[0].Descriptor()
+	// statisticsrollup.DefaultTenantID holds the default value on creation for the tenant_id field.
+	statisticsrollup.DefaultTenantID = statisticsrollupDescTenantID.Default.(uint32)
+	// statisticsrollupDescAllTenants is the schema descriptor for all_tenants field.
+	statisticsrollupDescAllTenants := statisticsrollupFields[0].Descriptor()
+	// statisticsrollup.DefaultAllTenants holds the default value on creation for the all_tenants field.
+	statisticsrollup.DefaultAllTenants = statisticsrollupDescAllTenants.Default.(bool)
+	// statisticsrollupDescID is the schema descriptor for id field.
+	statisticsrollupDescID := statisticsrollupMixinFields0[0].Descriptor()
+	// statisticsrollup.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	statisticsrollup.IDValidator = statisticsrollupDescID.Validators[0].(func(uint32) error)
+	tagMixin := schema.Tag{}.Mixin()
+	tag.Policy = privacy.NewPolicies(tagMixin[3], schema.Tag{})
+	tag.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := tag.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	tagMixinFields0 := tagMixin[0].Fields()
+	_ = tagMixinFields0
+	tagMixinFields3 := tagMixin[3].Fields()
+	_ = tagMixinFields3
+	tagFields := schema.Tag{}.Fields()
+	_ = tagFields
+	// tagDescTenantID is the schema descriptor for tenant_id field.
+	tagDescTenantID := tagMixinFields3[0].Descriptor()
+	// tag.DefaultTenantID holds the default value on creation for the tenant_id field.
+	tag.DefaultTenantID = tagDescTenantID.Default.(uint32)
+	// tagDescName is the schema descriptor for name field.
+	tagDescName := tagFields[0].Descriptor()
+	// tag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tag.NameValidator = func() func(string) error {
+		validators := tagDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// tagDescColor is the schema descriptor for color field.
+	tagDescColor := tagFields[1].Descriptor()
+	// tag.ColorValidator is a validator for the "color" field. It is called by the builders before save.
+	tag.ColorValidator = tagDescColor.Validators[0].(func(string) error)
+	// tagDescDescription is the schema descriptor for description field.
+	tagDescDescription := tagFields[2].Descriptor()
+	// tag.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	tag.DescriptionValidator = tagDescDescription.Validators[0].(func(string) error)
+	// tagDescID is the schema descriptor for id field.
+	tagDescID := tagMixinFields0[0].Descriptor()
+	// tag.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	tag.IDValidator = tagDescID.Validators[0].(func(uint32) error)
+	tenantdeletejobFields := schema.TenantDeleteJob{}.Fields()
+	_ = tenantdeletejobFields
+	// tenantdeletejobDescStage is the schema descriptor for stage field.
+	tenantdeletejobDescStage := tenantdeletejobFields[3].Descriptor()
+	// tenantdeletejob.StageValidator is a validator for the "stage" field. It is called by the builders before save.
+	tenantdeletejob.StageValidator = tenantdeletejobDescStage.Validators[0].(func(string) error)
+	// tenantdeletejobDescTotalItems is the schema descriptor for total_items field.
+	tenantdeletejobDescTotalItems := tenantdeletejobFields[4].Descriptor()
+	// tenantdeletejob.DefaultTotalItems holds the default value on creation for the total_items field.
+	tenantdeletejob.DefaultTotalItems = tenantdeletejobDescTotalItems.Default.(int64)
+	// tenantdeletejobDescProcessedItems is the schema descriptor for processed_items field.
+	tenantdeletejobDescProcessedItems := tenantdeletejobFields[5].Descriptor()
+	// tenantdeletejob.DefaultProcessedItems holds the default value on creation for the processed_items field.
+	tenantdeletejob.DefaultProcessedItems = tenantdeletejobDescProcessedItems.Default.(int64)
+	// tenantdeletejobDescDocumentsDeleted is the schema descriptor for documents_deleted field.
+	tenantdeletejobDescDocumentsDeleted := tenantdeletejobFields[6].Descriptor()
+	// tenantdeletejob.DefaultDocumentsDeleted holds the default value on creation for the documents_deleted field.
+	tenantdeletejob.DefaultDocumentsDeleted = tenantdeletejobDescDocumentsDeleted.Default.(int64)
+	// tenantdeletejobDescCategoriesDeleted is the schema descriptor for categories_deleted field.
+	tenantdeletejobDescCategoriesDeleted := tenantdeletejobFields[7].Descriptor()
+	// tenantdeletejob.DefaultCategoriesDeleted holds the default value on creation for the categories_deleted field.
+	tenantdeletejob.DefaultCategoriesDeleted = tenantdeletejobDescCategoriesDeleted.Default.(int64)
+	// tenantdeletejobDescPermissionsDeleted is the schema descriptor for permissions_deleted field.
+	tenantdeletejobDescPermissionsDeleted := tenantdeletejobFields[8].Descriptor()
+	// tenantdeletejob.DefaultPermissionsDeleted holds the default value on creation for the permissions_deleted field.
+	tenantdeletejob.DefaultPermissionsDeleted = tenantdeletejobDescPermissionsDeleted.Default.(int64)
+	// tenantdeletejobDescRecordsDeleted is the schema descriptor for records_deleted field.
+	tenantdeletejobDescRecordsDeleted := tenantdeletejobFields[9].Descriptor()
+	// tenantdeletejob.DefaultRecordsDeleted holds the default value on creation for the records_deleted field.
+	tenantdeletejob.DefaultRecordsDeleted = tenantdeletejobDescRecordsDeleted.Default.(int64)
+	// tenantdeletejobDescObjectsDeleted is the schema descriptor for objects_deleted field.
+	tenantdeletejobDescObjectsDeleted := tenantdeletejobFields[10].Descriptor()
+	// tenantdeletejob.DefaultObjectsDeleted holds the default value on creation for the objects_deleted field.
+	tenantdeletejob.DefaultObjectsDeleted = tenantdeletejobDescObjectsDeleted.Default.(int64)
+	// tenantdeletejobDescBytesDeleted is the schema descriptor for bytes_deleted field.
+	tenantdeletejobDescBytesDeleted := tenantdeletejobFields[11].Descriptor()
+	// tenantdeletejob.DefaultBytesDeleted holds the default value on creation for the bytes_deleted field.
+	tenantdeletejob.DefaultBytesDeleted = tenantdeletejobDescBytesDeleted.Default.(int64)
+	// tenantdeletejobDescError is the schema descriptor for error field.
+	tenantdeletejobDescError := tenantdeletejobFields[12].Descriptor()
+	// tenantdeletejob.ErrorValidator is a validator for the "error" field. It is called by the builders before save.
+	tenantdeletejob.ErrorValidator = tenantdeletejobDescError.Validators[0].(func(string) error)
+	// tenantdeletejobDescID is the schema descriptor for id field.
+	tenantdeletejobDescID := tenantdeletejobFields[0].Descriptor()
+	// tenantdeletejob.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	tenantdeletejob.IDValidator = tenantdeletejobDescID.Validators[0].(func(string) error)
+	tenantkeyMixin := schema.TenantKey{}.Mixin()
+	tenantkey.Policy = privacy.NewPolicies(tenantkeyMixin[2], schema.TenantKey{})
+	tenantkey.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := tenantkey.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	tenantkeyMixinFields0 := tenantkeyMixin[0].Fields()
+	_ = tenantkeyMixinFields0
+	tenantkeyMixinFields2 := tenantkeyMixin[2].Fields()
+	_ = tenantkeyMixinFields2
+	tenantkeyFields := schema.TenantKey{}.Fields()
+	_ = tenantkeyFields
+	// tenantkeyDescTenantID is the schema descriptor for tenant_id field.
+	tenantkeyDescTenantID := tenantkeyMixinFields2[0].Descriptor()
+	// tenantkey.DefaultTenantID holds the default value on creation for the tenant_id field.
+	tenantkey.DefaultTenantID = tenantkeyDescTenantID.Default.(uint32)
+	// tenantkeyDescMasterKeyID is the schema descriptor for master_key_id field.
+	tenantkeyDescMasterKeyID := tenantkeyFields[1].Descriptor()
+	// tenantkey.MasterKeyIDValidator is a validator for the "master_key_id" field. It is called by the builders before save.
+	tenantkey.MasterKeyIDValidator = func() func(string) error {
+		validators := tenantkeyDescMasterKeyID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(master_key_id string) error {
+			for _, fn := range fns {
+				if err := fn(master_key_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// tenantkeyDescID is the schema descriptor for id field.
+	tenantkeyDescID := tenantkeyMixinFields0[0].Descriptor()
+	// tenantkey.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	tenantkey.IDValidator = tenantkeyDescID.Validators[0].(func(uint32) error)
+	tenantquotaMixin := schema.TenantQuota{}.Mixin()
+	tenantquota.Policy = privacy.NewPolicies(tenantquotaMixin[2], schema.TenantQuota{})
+	tenantquota.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := tenantquota.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	tenantquotaMixinFields0 := tenantquotaMixin[0].Fields()
+	_ = tenantquotaMixinFields0
+	tenantquotaMixinFields2 := tenantquotaMixin[2].Fields()
+	_ = tenantquotaMixinFields2
+	tenantquotaFields := schema.TenantQuota{}.Fields()
+	_ = tenantquotaFields
+	// tenantquotaDescTenantID is the schema descriptor for tenant_id field.
+	tenantquotaDescTenantID := tenantquotaMixinFields2[0].Descriptor()
+	// tenantquota.DefaultTenantID holds the default value on creation for the tenant_id field.
+	tenantquota.DefaultTenantID = tenantquotaDescTenantID.Default.(uint32)
+	// tenantquotaDescUploadMonth is the schema descriptor for upload_month field.
+	tenantquotaDescUploadMonth := tenantquotaFields[3].Descriptor()
+	// tenantquota.UploadMonthValidator is a validator for the "upload_month" field. It is called by the builders before save.
+	tenantquota.UploadMonthValidator = tenantquotaDescUploadMonth.Validators[0].(func(string) error)
+	// tenantquotaDescMonthUploadBytes is the schema descriptor for month_upload_bytes field.
+	tenantquotaDescMonthUploadBytes := tenantquotaFields[4].Descriptor()
+	// tenantquota.DefaultMonthUploadBytes holds the default value on creation for the month_upload_bytes field.
+	tenantquota.DefaultMonthUploadBytes = tenantquotaDescMonthUploadBytes.Default.(int64)
+	// tenantquotaDescID is the schema descriptor for id field.
+	tenantquotaDescID := tenantquotaMixinFields0[0].Descriptor()
+	// tenantquota.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	tenantquota.IDValidator = tenantquotaDescID.Validators[0].(func(uint32) error)
+	tenantsettingsMixin := schema.TenantSettings{}.Mixin()
+	tenantsettings.Policy = privacy.NewPolicies(tenantsettingsMixin[2], schema.TenantSettings{})
+	tenantsettings.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := tenantsettings.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	tenantsettingsMixinFields0 := tenantsettingsMixin[0].Fields()
+	_ = tenantsettingsMixinFields0
+	tenantsettingsMixinFields2 := tenantsettingsMixin[2].Fields()
+	_ = tenantsettingsMixinFields2
+	tenantsettingsFields := schema.TenantSettings{}.Fields()
+	_ = tenantsettingsFields
+	// tenantsettingsDescTenantID is the schema descriptor for tenant_id field.
+	tenantsettingsDescTenantID := tenantsettingsMixinFields2[0].Descriptor()
+	// tenantsettings.DefaultTenantID holds the default value on creation for the tenant_id field.
+	tenantsettings.DefaultTenantID = tenantsettingsDescTenantID.Default.(uint32)
+	// tenantsettingsDescOcrLanguage is the schema descriptor for ocr_language field.
+	tenantsettingsDescOcrLanguage := tenantsettingsFields[0].Descriptor()
+	// tenantsettings.OcrLanguageValidator is a validator for the "ocr_language" field. It is called by the builders before save.
+	tenantsettings.OcrLanguageValidator = tenantsettingsDescOcrLanguage.Validators[0].(func(string) error)
+	// tenantsettingsDescDefaultRetentionClass is the schema descriptor for default_retention_class field.
+	tenantsettingsDescDefaultRetentionClass := tenantsettingsFields[3].Descriptor()
+	// tenantsettings.DefaultRetentionClassValidator is a validator for the "default_retention_class" field. It is called by the builders before save.
+	tenantsettings.DefaultRetentionClassValidator = tenantsettingsDescDefaultRetentionClass.Validators[0].(func(string) error)
+	// tenantsettingsDescStorageBackend is the schema descriptor for storage_backend field.
+	tenantsettingsDescStorageBackend := tenantsettingsFields[4].Descriptor()
+	// tenantsettings.StorageBackendValidator is a validator for the "storage_backend" field. It is called by the builders before save.
+	tenantsettings.StorageBackendValidator = tenantsettingsDescStorageBackend.Validators[0].(func(string) error)
+	// tenantsettingsDescID is the schema descriptor for id field.
+	tenantsettingsDescID := tenantsettingsMixinFields0[0].Descriptor()
+	// tenantsettings.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	tenantsettings.IDValidator = tenantsettingsDescID.Validators[0].(func(uint32) error)
+	webhookdeliveryMixin := schema.WebhookDelivery{}.Mixin()
+	webhookdelivery.Policy = privacy.NewPolicies(webhookdeliveryMixin[2], schema.WebhookDelivery{})
+	webhookdelivery.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := webhookdelivery.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	webhookdeliveryMixinFields0 := webhookdeliveryMixin[0].Fields()
+	_ = webhookdeliveryMixinFields0
+	webhookdeliveryMixinFields2 := webhookdeliveryMixin[2].Fields()
+	_ = webhookdeliveryMixinFields2
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescTenantID is the schema descriptor for tenant_id field.
+	webhookdeliveryDescTenantID := webhookdeliveryMixinFields2[0].Descriptor()
+	// webhookdelivery.DefaultTenantID holds the default value on creation for the tenant_id field.
+	webhookdelivery.DefaultTenantID = webhookdeliveryDescTenantID.Default.(uint32)
+	// webhookdeliveryDescSubscriptionID is the schema descriptor for subscription_id field.
+	webhookdeliveryDescSubscriptionID := webhookdeliveryFields[0].Descriptor()
+	// webhookdelivery.SubscriptionIDValidator is a validator for the "subscription_id" field. It is called by the builders before save.
+	webhookdelivery.SubscriptionIDValidator = webhookdeliveryDescSubscriptionID.Validators[0].(func(string) error)
+	// webhookdeliveryDescEventID is the schema descriptor for event_id field.
+	webhookdeliveryDescEventID := webhookdeliveryFields[1].Descriptor()
+	// webhookdelivery.EventIDValidator is a validator for the "event_id" field. It is called by the builders before save.
+	webhookdelivery.EventIDValidator = func() func(string) error {
+		validators := webhookdeliveryDescEventID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(event_id string) error {
+			for _, fn := range fns {
+				if err := fn(event_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webhookdeliveryDescEventType is the schema descriptor for event_type field.
+	webhookdeliveryDescEventType := webhookdeliveryFields[2].Descriptor()
+	// webhookdelivery.EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	webhookdelivery.EventTypeValidator = func() func(string) error {
+		validators := webhookdeliveryDescEventType.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(event_type string) error {
+			for _, fn := range fns {
+				if err := fn(event_type); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[5].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int32)
+	// webhookdeliveryDescLastError is the schema descriptor for last_error field.
+	webhookdeliveryDescLastError := webhookdeliveryFields[8].Descriptor()
+	// webhookdelivery.LastErrorValidator is a validator for the "last_error" field. It is called by the builders before save.
+	webhookdelivery.LastErrorValidator = webhookdeliveryDescLastError.Validators[0].(func(string) error)
+	// webhookdeliveryDescID is the schema descriptor for id field.
+	webhookdeliveryDescID := webhookdeliveryMixinFields0[0].Descriptor()
+	// webhookdelivery.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	webhookdelivery.IDValidator = webhookdeliveryDescID.Validators[0].(func(uint32) error)
+	webhooksubscriptionMixin := schema.WebhookSubscription{}.Mixin()
+	webhooksubscription.Policy = privacy.NewPolicies(webhooksubscriptionMixin[2], schema.WebhookSubscription{})
+	webhooksubscription.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := webhooksubscription.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	webhooksubscriptionMixinFields2 := webhooksubscriptionMixin[2].Fields()
+	_ = webhooksubscriptionMixinFields2
+	webhooksubscriptionFields := schema.WebhookSubscription{}.Fields()
+	_ = webhooksubscriptionFields
+	// webhooksubscriptionDescTenantID is the schema descriptor for tenant_id field.
+	webhooksubscriptionDescTenantID := webhooksubscriptionMixinFields2[0].Descriptor()
+	// webhooksubscription.DefaultTenantID holds the default value on creation for the tenant_id field.
+	webhooksubscription.DefaultTenantID = webhooksubscriptionDescTenantID.Default.(uint32)
+	// webhooksubscriptionDescName is the schema descriptor for name field.
+	webhooksubscriptionDescName := webhooksubscriptionFields[1].Descriptor()
+	// webhooksubscription.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	webhooksubscription.NameValidator = func() func(string) error {
+		validators := webhooksubscriptionDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webhooksubscriptionDescURL is the schema descriptor for url field.
+	webhooksubscriptionDescURL := webhooksubscriptionFields[2].Descriptor()
+	// webhooksubscription.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhooksubscription.URLValidator = func() func(string) error {
+		validators := webhooksubscriptionDescURL.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(url string) error {
+			for _, fn := range fns {
+				if err := fn(url); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webhooksubscriptionDescSecret is the schema descriptor for secret field.
+	webhooksubscriptionDescSecret := webhooksubscriptionFields[3].Descriptor()
+	// webhooksubscription.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	webhooksubscription.SecretValidator = webhooksubscriptionDescSecret.Validators[0].(func(string) error)
+	// webhooksubscriptionDescEnabled is the schema descriptor for enabled field.
+	webhooksubscriptionDescEnabled := webhooksubscriptionFields[5].Descriptor()
+	// webhooksubscription.DefaultEnabled holds the default value on creation for the enabled field.
+	webhooksubscription.DefaultEnabled = webhooksubscriptionDescEnabled.Default.(bool)
+	// webhooksubscriptionDescID is the schema descriptor for id field.
+	webhooksubscriptionDescID := webhooksubscriptionFields[0].Descriptor()
+	// webhooksubscription.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	webhooksubscription.IDValidator = webhooksubscriptionDescID.Validators[0].(func(string) error)
 }
 
 const (
@@ -0,0 +1,103 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/authz"
+)
+
+// AccessibleResource holds the schema definition for the AccessibleResource entity.
+// It is a denormalized (subject -> resource) index derived from permission tuples,
+// expanded down the category hierarchy so list and search queries can join against it.
+type AccessibleResource struct {
+	ent.Schema
+}
+
+// Annotations of the AccessibleResource.
+func (AccessibleResource) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "paperless_accessible_resources"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the AccessibleResource.
+func (AccessibleResource) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("permission_id").
+			Comment("ID of the permission tuple this entry is derived from"),
+
+		field.Enum("subject_type").
+			Values("SUBJECT_TYPE_UNSPECIFIED", "SUBJECT_TYPE_USER", "SUBJECT_TYPE_ROLE", "SUBJECT_TYPE_TENANT", "SUBJECT_TYPE_GROUP").
+			Comment("Type of subject (user, role, tenant, or group)"),
+
+		field.String("subject_id").
+			NotEmpty().
+			MaxLen(36).
+			Comment("ID of the user, role, or tenant"),
+
+		field.Enum("resource_type").
+			Values("RESOURCE_TYPE_UNSPECIFIED", "RESOURCE_TYPE_CATEGORY", "RESOURCE_TYPE_DOCUMENT").
+			Comment("Type of the accessible resource"),
+
+		field.String("resource_id").
+			NotEmpty().
+			MaxLen(36).
+			Comment("ID of the accessible category or document"),
+
+		field.Enum("relation").
+			Values("RELATION_UNSPECIFIED", "RELATION_OWNER", "RELATION_EDITOR", "RELATION_VIEWER", "RELATION_SHARER").
+			Comment("Relation granted by the source tuple"),
+
+		field.String("source_id").
+			NotEmpty().
+			MaxLen(36).
+			Comment("Resource the source tuple is attached to (differs from resource_id when inherited)"),
+
+		field.Bool("inherited").
+			Default(false).
+			Comment("Whether access is inherited from a parent category"),
+
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Comment("Expiration time copied from the source tuple"),
+
+		field.JSON("conditions", &authz.Conditions{}).
+			Optional().
+			Comment("Conditions copied from the source tuple; conditional entries must be re-checked"),
+	}
+}
+
+// Edges of the AccessibleResource.
+func (AccessibleResource) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the AccessibleResource.
+func (AccessibleResource) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the AccessibleResource.
+func (AccessibleResource) Indexes() []ent.Index {
+	return []ent.Index{
+		// One entry per source tuple and resource
+		index.Fields("permission_id", "resource_type", "resource_id").Unique(),
+		// For listing a subject's accessible resources
+		index.Fields("tenant_id", "subject_type", "subject_id", "resource_type"),
+		// For maintaining entries of a resource
+		index.Fields("tenant_id", "resource_type", "resource_id"),
+		// For maintaining entries derived from a resource
+		index.Fields("tenant_id", "source_id"),
+	}
+}
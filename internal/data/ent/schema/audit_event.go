@@ -0,0 +1,90 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// AuditEvent holds the schema definition for the AuditEvent entity.
+// Records who changed, downloaded or shared which document or category, unlike AuditLog
+// which records gRPC calls and the client certificate that made them.
+type AuditEvent struct {
+	ent.Schema
+}
+
+// Annotations of the AuditEvent.
+func (AuditEvent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "paperless_audit_events"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the AuditEvent.
+func (AuditEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("user_id").
+			Optional().
+			MaxLen(64).
+			Comment("ID of the user who performed the action, empty for system actions"),
+
+		field.Enum("action").
+			Values(
+				"AUDIT_ACTION_CREATE",
+				"AUDIT_ACTION_UPDATE",
+				"AUDIT_ACTION_MOVE",
+				"AUDIT_ACTION_DELETE",
+				"AUDIT_ACTION_DOWNLOAD",
+				"AUDIT_ACTION_SHARE",
+				"AUDIT_ACTION_UNSHARE",
+				"AUDIT_ACTION_REVIEW",
+			).
+			Comment("What was done"),
+
+		field.Enum("resource_type").
+			Values("RESOURCE_TYPE_UNSPECIFIED", "RESOURCE_TYPE_CATEGORY", "RESOURCE_TYPE_DOCUMENT").
+			Comment("Type of resource acted on"),
+
+		field.String("resource_id").
+			NotEmpty().
+			MaxLen(36).
+			Comment("ID of the category or document"),
+
+		field.String("resource_name").
+			Optional().
+			MaxLen(255).
+			Comment("Name of the resource at the time of the action"),
+
+		field.JSON("details", map[string]string{}).
+			Optional().
+			Comment("Action specific details, e.g. the target category of a move"),
+	}
+}
+
+// Edges of the AuditEvent.
+func (AuditEvent) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the AuditEvent.
+func (AuditEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the AuditEvent.
+func (AuditEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "create_time"),
+		index.Fields("tenant_id", "resource_type", "resource_id"),
+		index.Fields("tenant_id", "user_id"),
+		index.Fields("create_time"),
+	}
+}
@@ -52,6 +52,16 @@ func (Category) Fields() []ent.Field {
 			MaxLen(1024).
 			Comment("Optional description"),
 
+		field.String("color").
+			Optional().
+			MaxLen(7).
+			Comment("Display color as #RRGGBB"),
+
+		field.String("icon").
+			Optional().
+			MaxLen(64).
+			Comment("Icon name shown in the category tree"),
+
 		field.Int32("depth").
 			Default(0).
 			Comment("Nesting depth level (0 for root categories)"),
@@ -59,6 +69,56 @@ func (Category) Fields() []ent.Field {
 		field.Int32("sort_order").
 			Default(0).
 			Comment("Sort order within parent (lower numbers appear first)"),
+
+		field.Int64("document_count").
+			Default(0).
+			Comment("Documents directly in the category, maintained on document writes"),
+
+		field.Int64("subtree_document_count").
+			Default(0).
+			Comment("Documents in the category and its descendants, maintained on document writes"),
+
+		field.Int64("document_bytes").
+			Default(0).
+			Comment("Total file size of the documents directly in the category, maintained on document writes"),
+
+		field.Int64("subtree_document_bytes").
+			Default(0).
+			Comment("Total file size of the documents in the category and its descendants, maintained on document writes"),
+
+		field.Int64("max_documents").
+			Optional().
+			Nillable().
+			Comment("Most documents the category and its descendants may hold (null for no limit)"),
+
+		field.Int64("max_bytes").
+			Optional().
+			Nillable().
+			Comment("Most bytes the documents in the category and its descendants may take (null for no limit)"),
+
+		field.JSON("rule_tags", map[string]string{}).
+			Optional().
+			Comment("Tags given to documents filed or moved into the category"),
+
+		field.String("rule_document_type").
+			Optional().
+			MaxLen(64).
+			Comment("Document type given to documents filed or moved into the category"),
+
+		field.String("rule_retention_class").
+			Optional().
+			MaxLen(64).
+			Comment("Retention class given to documents filed or moved into the category"),
+
+		field.Enum("child_sort_mode").
+			Values("CATEGORY_SORT_MODE_MANUAL", "CATEGORY_SORT_MODE_ALPHABETICAL", "CATEGORY_SORT_MODE_RECENT_ACTIVITY").
+			Default("CATEGORY_SORT_MODE_MANUAL").
+			Comment("How the subcategories are ordered"),
+
+		field.Time("activity_time").
+			Optional().
+			Nillable().
+			Comment("Last time documents were added to, removed from or replaced in the category or its descendants, maintained on document writes"),
 	}
 }
 
@@ -88,6 +148,7 @@ func (Category) Mixin() []ent.Mixin {
 		mixin.CreateBy{},
 		mixin.Time{},
 		mixin.TenantID[uint32]{},
+		Versioned{Ignore: []string{"document_count", "subtree_document_count", "document_bytes", "subtree_document_bytes", "activity_time"}},
 	}
 }
 
@@ -0,0 +1,123 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// CategoryDeleteJob holds the schema definition for the CategoryDeleteJob entity.
+// Deletions of large category subtrees are queued here and carried out in batches by a
+// background worker, which records its progress on the job.
+type CategoryDeleteJob struct {
+	ent.Schema
+}
+
+// Annotations of the CategoryDeleteJob.
+func (CategoryDeleteJob) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "paperless_category_delete_jobs"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the CategoryDeleteJob.
+func (CategoryDeleteJob) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("UUID primary key"),
+
+		field.String("category_id").
+			NotEmpty().
+			Comment("Category being deleted"),
+
+		field.String("category_path").
+			NotEmpty().
+			MaxLen(4096).
+			Comment("Path of the category when the job was queued"),
+
+		field.Enum("mode").
+			Values(
+				"CATEGORY_DELETE_MODE_SUBTREE",
+				"CATEGORY_DELETE_MODE_SUBTREE_WITH_DOCUMENTS",
+				"CATEGORY_DELETE_MODE_REASSIGN",
+			).
+			Comment("What happens to the category's contents"),
+
+		field.String("target_category_id").
+			Optional().
+			Nillable().
+			Comment("Category receiving the contents in reassign mode, null for the root level"),
+
+		field.Enum("status").
+			Values(
+				"CATEGORY_DELETE_JOB_STATUS_PENDING",
+				"CATEGORY_DELETE_JOB_STATUS_RUNNING",
+				"CATEGORY_DELETE_JOB_STATUS_SUCCEEDED",
+				"CATEGORY_DELETE_JOB_STATUS_FAILED",
+			).
+			Default("CATEGORY_DELETE_JOB_STATUS_PENDING").
+			Comment("Pending until a worker picks the job up, running until it is done"),
+
+		field.Int64("total_items").
+			Default(0).
+			Comment("Categories and documents to process, counted when the job was queued"),
+
+		field.Int64("processed_items").
+			Default(0).
+			Comment("Categories and documents processed so far"),
+
+		field.Int64("categories_deleted").
+			Default(0).
+			Comment("Categories deleted so far"),
+
+		field.Int64("categories_moved").
+			Default(0).
+			Comment("Subcategories moved to the target so far"),
+
+		field.Int64("documents_deleted").
+			Default(0).
+			Comment("Documents moved to the trash so far"),
+
+		field.Int64("documents_moved").
+			Default(0).
+			Comment("Documents moved to the target so far"),
+
+		field.String("error").
+			Optional().
+			MaxLen(1024).
+			Comment("Why the job failed"),
+
+		field.Time("lease_until").
+			Optional().
+			Nillable().
+			Comment("Until when the running worker holds the job; another worker resumes it afterwards"),
+
+		field.Time("finished_at").
+			Optional().
+			Nillable().
+			Comment("When the job succeeded or failed"),
+	}
+}
+
+// Mixin of the CategoryDeleteJob.
+func (CategoryDeleteJob) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the CategoryDeleteJob.
+func (CategoryDeleteJob) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "lease_until"),
+		index.Fields("tenant_id", "create_time"),
+	}
+}
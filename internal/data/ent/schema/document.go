@@ -75,28 +75,69 @@ func (Document) Fields() []ent.Field {
 			Optional().
 			Comment("Custom tags (key-value pairs)"),
 
+		field.String("document_type").
+			Optional().
+			MaxLen(64).
+			Comment("Kind of document, e.g. invoice or contract"),
+
+		field.String("retention_class").
+			Optional().
+			MaxLen(64).
+			Comment("Retention class the document is kept under"),
+
 		field.Enum("status").
 			Values("DOCUMENT_STATUS_UNSPECIFIED", "DOCUMENT_STATUS_ACTIVE", "DOCUMENT_STATUS_ARCHIVED", "DOCUMENT_STATUS_DELETED").
 			Default("DOCUMENT_STATUS_ACTIVE").
 			Comment("Document status"),
 
 		field.Enum("source").
-			Values("DOCUMENT_SOURCE_UNSPECIFIED", "DOCUMENT_SOURCE_UPLOAD", "DOCUMENT_SOURCE_EMAIL").
+			Values("DOCUMENT_SOURCE_UNSPECIFIED", "DOCUMENT_SOURCE_UPLOAD", "DOCUMENT_SOURCE_EMAIL", "DOCUMENT_SOURCE_IMPORT").
 			Default("DOCUMENT_SOURCE_UPLOAD").
 			Comment("Source of the document (upload, email, etc.)"),
 
-		field.Text("content_text").
+		field.Enum("processing_status").
+			Values("PROCESSING_STATUS_PENDING", "PROCESSING_STATUS_PROCESSING", "PROCESSING_STATUS_COMPLETED", "PROCESSING_STATUS_FAILED", "PROCESSING_STATUS_SKIPPED", "PROCESSING_STATUS_INFECTED").
+			Default("PROCESSING_STATUS_PENDING").
+			Comment("Document content extraction status"),
+
+		field.Enum("storage_tier").
+			Values("STORAGE_TIER_UNSPECIFIED", "STORAGE_TIER_HOT", "STORAGE_TIER_COLD").
+			Default("STORAGE_TIER_HOT").
+			Comment("Storage tier currently holding the file"),
+
+		field.Time("last_accessed_at").
 			Optional().
-			Comment("Extracted text content for full-text search"),
+			Nillable().
+			Comment("Last time the file was downloaded"),
 
-		field.JSON("extracted_metadata", map[string]string{}).
+		field.Time("due_date").
 			Optional().
-			Comment("Metadata extracted by Tika (author, title, page_count, etc.)"),
+			Nillable().
+			Comment("Date the document is due, e.g. a contract's renewal date"),
 
-		field.Enum("processing_status").
-			Values("PROCESSING_STATUS_PENDING", "PROCESSING_STATUS_PROCESSING", "PROCESSING_STATUS_COMPLETED", "PROCESSING_STATUS_FAILED", "PROCESSING_STATUS_SKIPPED").
-			Default("PROCESSING_STATUS_PENDING").
-			Comment("Document content extraction status"),
+		field.Time("reminded_at").
+			Optional().
+			Nillable().
+			Comment("When the owners were reminded of the due date, cleared when it changes"),
+
+		field.Uint32("assignee_id").
+			Optional().
+			Nillable().
+			Comment("User the document awaits action from"),
+
+		field.Uint32("assigned_by").
+			Optional().
+			Nillable().
+			Comment("User who assigned the document"),
+
+		field.Time("assigned_at").
+			Optional().
+			Nillable().
+			Comment("When the document was assigned"),
+
+		field.String("rendition_key").
+			Optional().
+			Comment("Storage key of the cached PDF rendition of a file that is not a PDF"),
 	}
 }
 
@@ -113,6 +154,27 @@ func (Document) Edges() []ent.Edge {
 		// Permissions on this document
 		edge.To("permissions", DocumentPermission.Type).
 			Comment("Permissions on this document"),
+
+		// E-signature requests of this document
+		edge.To("signature_requests", SignatureRequest.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("E-signature requests of this document"),
+
+		// Review tasks of this document
+		edge.To("review_tasks", ReviewTask.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Review tasks of this document"),
+
+		// Tag references mirroring the tags column
+		edge.To("document_tags", DocumentTag.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Tag references of this document"),
+
+		// Extracted text and metadata, only loaded when asked for
+		edge.To("content", DocumentContent.Type).
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Text and metadata extracted from the file"),
 	}
 }
 
@@ -123,6 +185,8 @@ func (Document) Mixin() []ent.Mixin {
 		mixin.UpdateBy{},
 		mixin.Time{},
 		mixin.TenantID[uint32]{},
+		SoftDelete{Field: "status", Deleted: "DOCUMENT_STATUS_DELETED"},
+		Versioned{Ignore: []string{"last_accessed_at", "reminded_at", "rendition_key"}},
 	}
 }
 
@@ -143,5 +207,11 @@ func (Document) Indexes() []ent.Index {
 		index.Fields("file_key").Unique(),
 		// For filtering by MIME type
 		index.Fields("tenant_id", "mime_type"),
+		// For finding tiering candidates
+		index.Fields("storage_tier", "status"),
+		// For finding documents due soon
+		index.Fields("tenant_id", "due_date"),
+		// For listing a user's inbox
+		index.Fields("tenant_id", "assignee_id"),
 	}
 }
@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// DocumentContent holds the schema definition for the DocumentContent entity.
+// It keeps the text and metadata extracted from a document's file apart from the document
+// row, so listing documents doesn't read megabytes of text. A document has no content row
+// until its file was processed.
+type DocumentContent struct {
+	ent.Schema
+}
+
+// Annotations of the DocumentContent.
+func (DocumentContent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "paperless_document_contents"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the DocumentContent.
+func (DocumentContent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("document_id").
+			NotEmpty().
+			Comment("Document the content was extracted from"),
+
+		field.Text("content_text").
+			Optional().
+			Comment("Extracted text content for full-text search (empty when stored compressed, the start of the text when archived)"),
+
+		field.Bytes("content_text_compressed").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "longblob"}).
+			Comment("Gzip-compressed extracted text, used instead of content_text for large texts"),
+
+		field.Text("search_terms").
+			Optional().
+			Comment("Distinct lowercase words of compressed or archived extracted text, for full-text search"),
+
+		field.String("content_text_key").
+			Optional().
+			Comment("Storage key of the full extracted text when only its start is stored"),
+
+		field.JSON("extracted_metadata", map[string]string{}).
+			Optional().
+			Comment("Metadata extracted by Tika (author, title, page_count, etc.)"),
+	}
+}
+
+// Edges of the DocumentContent.
+func (DocumentContent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("document", Document.Type).
+			Ref("content").
+			Field("document_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Mixin of the DocumentContent.
+func (DocumentContent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the DocumentContent.
+func (DocumentContent) Indexes() []ent.Index {
+	return []ent.Index{
+		// One content row per document
+		index.Fields("document_id").Unique(),
+	}
+}
@@ -0,0 +1,88 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// DocumentSnapshot is the metadata of a document at one point in time
+type DocumentSnapshot struct {
+	Name           string            `json:"name"`
+	Description    string            `json:"description,omitempty"`
+	CategoryID     *string           `json:"category_id,omitempty"`
+	Status         string            `json:"status"`
+	Tags           map[string]string `json:"tags,omitempty"`
+	DocumentType   string            `json:"document_type,omitempty"`
+	RetentionClass string            `json:"retention_class,omitempty"`
+	DueDate        *time.Time        `json:"due_date,omitempty"`
+}
+
+// DocumentHistory holds the schema definition for the DocumentHistory entity.
+// Each row is one change of a document's metadata with the values before and after it, so
+// edits can be reviewed and reverted.
+type DocumentHistory struct {
+	ent.Schema
+}
+
+// Annotations of the DocumentHistory.
+func (DocumentHistory) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "paperless_document_history"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the DocumentHistory.
+func (DocumentHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("document_id").
+			NotEmpty().
+			MaxLen(36).
+			Comment("ID of the changed document"),
+
+		field.String("user_id").
+			Optional().
+			MaxLen(64).
+			Comment("ID of the user who made the change, empty for system changes"),
+
+		field.Uint32("version").
+			Default(0).
+			Comment("Version of the document after the change"),
+
+		field.Strings("changed_fields").
+			Comment("Names of the metadata fields that changed"),
+
+		field.JSON("before", DocumentSnapshot{}).
+			Comment("Metadata before the change"),
+
+		field.JSON("after", DocumentSnapshot{}).
+			Comment("Metadata after the change"),
+	}
+}
+
+// Edges of the DocumentHistory.
+func (DocumentHistory) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the DocumentHistory.
+func (DocumentHistory) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the DocumentHistory.
+func (DocumentHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "document_id", "create_time"),
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"github.com/tx7do/go-crud/entgo/mixin"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/authz"
 )
 
 // DocumentPermission holds the schema definition for the DocumentPermission entity.
@@ -41,8 +43,8 @@ func (DocumentPermission) Fields() []ent.Field {
 			Comment("Permission level (owner, editor, viewer, sharer)"),
 
 		field.Enum("subject_type").
-			Values("SUBJECT_TYPE_UNSPECIFIED", "SUBJECT_TYPE_USER", "SUBJECT_TYPE_ROLE", "SUBJECT_TYPE_TENANT").
-			Comment("Type of subject (user, role, or tenant)"),
+			Values("SUBJECT_TYPE_UNSPECIFIED", "SUBJECT_TYPE_USER", "SUBJECT_TYPE_ROLE", "SUBJECT_TYPE_TENANT", "SUBJECT_TYPE_GROUP").
+			Comment("Type of subject (user, role, tenant, or group)"),
 
 		field.String("subject_id").
 			NotEmpty().
@@ -58,6 +60,10 @@ func (DocumentPermission) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("Optional expiration time for temporary access"),
+
+		field.JSON("conditions", &authz.Conditions{}).
+			Optional().
+			Comment("Optional caveats (IP ranges, time window, MFA) evaluated at check time"),
 	}
 }
 
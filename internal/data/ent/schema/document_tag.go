@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// DocumentTag holds the schema definition for the DocumentTag entity.
+// A document tag references a Tag and carries the value the document stores under it. The
+// rows mirror the document's tags column and are kept in sync whenever the column is written.
+type DocumentTag struct {
+	ent.Schema
+}
+
+// Annotations of the DocumentTag.
+func (DocumentTag) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "paperless_document_tags"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the DocumentTag.
+func (DocumentTag) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("document_id").
+			NotEmpty().
+			Comment("Tagged document"),
+
+		field.Uint32("tag_id").
+			Comment("Referenced tag"),
+
+		field.String("value").
+			Default("").
+			Comment("Value of the tag on this document"),
+	}
+}
+
+// Edges of the DocumentTag.
+func (DocumentTag) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("document", Document.Type).
+			Ref("document_tags").
+			Field("document_id").
+			Unique().
+			Required(),
+
+		edge.From("tag", Tag.Type).
+			Ref("document_tags").
+			Field("tag_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Mixin of the DocumentTag.
+func (DocumentTag) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the DocumentTag.
+func (DocumentTag) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("document_id", "tag_id").Unique(),
+		index.Fields("tag_id"),
+	}
+}
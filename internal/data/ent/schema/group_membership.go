@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// GroupMembership holds the schema definition for the GroupMembership entity.
+// Local copy of the directory's group memberships, replaced by each group sync, so that
+// SUBJECT_TYPE_GROUP grants resolve to users.
+type GroupMembership struct {
+	ent.Schema
+}
+
+// Annotations of the GroupMembership.
+func (GroupMembership) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "paperless_group_memberships"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the GroupMembership.
+func (GroupMembership) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("group_id").
+			NotEmpty().
+			MaxLen(36).
+			Comment("Directory group ID, the subject ID of group grants"),
+
+		field.String("group_name").
+			Optional().
+			MaxLen(255).
+			Comment("Display name of the group"),
+
+		field.String("user_id").
+			NotEmpty().
+			MaxLen(36).
+			Comment("Member user ID"),
+	}
+}
+
+// Edges of the GroupMembership.
+func (GroupMembership) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the GroupMembership.
+func (GroupMembership) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the GroupMembership.
+func (GroupMembership) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "group_id", "user_id").Unique(),
+		// For resolving a user's groups during permission checks
+		index.Fields("tenant_id", "user_id"),
+	}
+}
@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// IdempotencyKey holds the schema definition for the IdempotencyKey entity.
+// It records a mutating request sent with an idempotency key and, once it succeeded, its
+// response, so a retry with the same key gets the response instead of applying it again.
+type IdempotencyKey struct {
+	ent.Schema
+}
+
+// Annotations of the IdempotencyKey.
+func (IdempotencyKey) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "paperless_idempotency_keys"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the IdempotencyKey.
+func (IdempotencyKey) Fields() []ent.Field {
+	return []ent.Field{
+		// Not nullable, so keys of requests without a user are unique too
+		field.String("user_id").
+			Default("").
+			MaxLen(64).
+			Comment("User who sent the request; keys are scoped to their sender"),
+
+		field.String("key").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Idempotency key chosen by the client"),
+
+		field.String("operation").
+			NotEmpty().
+			MaxLen(255).
+			Comment("RPC the key was used for"),
+
+		field.String("request_hash").
+			NotEmpty().
+			MaxLen(64).
+			Comment("SHA-256 of the request, to refuse a key reused for another request"),
+
+		field.Bool("completed").
+			Default(false).
+			Comment("The request succeeded and its response is stored; until then it is in progress"),
+
+		field.Bytes("response").
+			Optional().
+			Comment("Response of the request, as a protobuf Any"),
+
+		field.Time("expires_at").
+			Comment("When the key can be used again and the record is removed"),
+	}
+}
+
+// Mixin of the IdempotencyKey.
+func (IdempotencyKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the IdempotencyKey.
+func (IdempotencyKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "user_id", "key").Unique(),
+		index.Fields("expires_at"),
+	}
+}
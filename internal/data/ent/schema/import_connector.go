@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// ImportConnector holds the schema definition for the ImportConnector entity.
+// A connector holds a tenant's credentials for a remote file service that documents are imported from.
+type ImportConnector struct {
+	ent.Schema
+}
+
+// Annotations of the ImportConnector.
+func (ImportConnector) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "paperless_import_connectors"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the ImportConnector.
+func (ImportConnector) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("UUID primary key"),
+
+		field.String("name").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Display name"),
+
+		field.Enum("provider").
+			Values(
+				"IMPORT_PROVIDER_GOOGLE_DRIVE",
+				"IMPORT_PROVIDER_SHAREPOINT",
+			).
+			Comment("Remote file service"),
+
+		field.Text("credentials").
+			NotEmpty().
+			Sensitive().
+			Comment("Google service account key or Microsoft Entra client secret"),
+
+		field.JSON("config", map[string]string{}).
+			Optional().
+			Comment("Provider settings, e.g. the SharePoint drive"),
+
+		field.Bool("enabled").
+			Default(true).
+			Comment("Whether the connector's folders are synced"),
+	}
+}
+
+// Edges of the ImportConnector.
+func (ImportConnector) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("mappings", ImportMapping.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Remote folders imported through this connector"),
+	}
+}
+
+// Mixin of the ImportConnector.
+func (ImportConnector) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the ImportConnector.
+func (ImportConnector) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id"),
+	}
+}
@@ -0,0 +1,136 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// ImportMapping holds the schema definition for the ImportMapping entity.
+// A mapping imports the files of a remote folder into a category, once or on a schedule.
+type ImportMapping struct {
+	ent.Schema
+}
+
+// Annotations of the ImportMapping.
+func (ImportMapping) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "paperless_import_mappings"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the ImportMapping.
+func (ImportMapping) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("UUID primary key"),
+
+		field.String("connector_id").
+			NotEmpty().
+			Comment("Connector the folder is read through"),
+
+		field.String("remote_folder_id").
+			NotEmpty().
+			MaxLen(512).
+			Comment("ID of the remote folder"),
+
+		field.String("remote_folder_name").
+			Optional().
+			MaxLen(255).
+			Comment("Display name of the remote folder"),
+
+		field.String("category_id").
+			Optional().
+			Nillable().
+			Comment("Category receiving the documents, null for root level"),
+
+		field.Bool("include_subfolders").
+			Default(false).
+			Comment("Also import the files of subfolders"),
+
+		field.Int32("sync_interval_minutes").
+			Default(0).
+			Comment("Minutes between scheduled syncs, 0 for manual syncs only"),
+
+		field.Bool("enabled").
+			Default(true).
+			Comment("Whether scheduled syncs run"),
+
+		field.Time("next_sync_at").
+			Optional().
+			Nillable().
+			Comment("When the next sync is due, null when none is scheduled"),
+
+		field.Enum("last_sync_status").
+			Values(
+				"IMPORT_SYNC_STATUS_NEVER",
+				"IMPORT_SYNC_STATUS_QUEUED",
+				"IMPORT_SYNC_STATUS_RUNNING",
+				"IMPORT_SYNC_STATUS_SUCCEEDED",
+				"IMPORT_SYNC_STATUS_PARTIAL",
+				"IMPORT_SYNC_STATUS_FAILED",
+			).
+			Default("IMPORT_SYNC_STATUS_NEVER").
+			Comment("Outcome of the last sync"),
+
+		field.Time("last_sync_at").
+			Optional().
+			Nillable().
+			Comment("When the last sync finished"),
+
+		field.String("last_error").
+			Optional().
+			MaxLen(1024).
+			Comment("Why the last sync or one of its files failed"),
+
+		field.Int32("last_imported_count").
+			Default(0).
+			Comment("Files imported by the last sync"),
+
+		field.Int32("last_skipped_count").
+			Default(0).
+			Comment("Files the last sync skipped as already imported"),
+
+		field.Int32("last_failed_count").
+			Default(0).
+			Comment("Files the last sync failed to import"),
+	}
+}
+
+// Edges of the ImportMapping.
+func (ImportMapping) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("connector", ImportConnector.Type).
+			Ref("mappings").
+			Field("connector_id").
+			Unique().
+			Required(),
+		edge.To("files", ImportedFile.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Files imported through this mapping"),
+	}
+}
+
+// Mixin of the ImportMapping.
+func (ImportMapping) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the ImportMapping.
+func (ImportMapping) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("connector_id"),
+		index.Fields("enabled", "next_sync_at"),
+	}
+}
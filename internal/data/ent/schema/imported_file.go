@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// ImportedFile holds the schema definition for the ImportedFile entity.
+// It records which remote files a mapping already imported, so later syncs skip them.
+type ImportedFile struct {
+	ent.Schema
+}
+
+// Annotations of the ImportedFile.
+func (ImportedFile) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "paperless_imported_files"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the ImportedFile.
+func (ImportedFile) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("mapping_id").
+			NotEmpty().
+			Comment("Mapping that imported the file"),
+
+		field.String("remote_file_id").
+			NotEmpty().
+			MaxLen(512).
+			Comment("ID of the remote file"),
+
+		field.String("remote_version").
+			Optional().
+			MaxLen(255).
+			Comment("Modification time or ETag of the imported revision"),
+
+		field.String("document_id").
+			NotEmpty().
+			Comment("Document created from the file"),
+	}
+}
+
+// Edges of the ImportedFile.
+func (ImportedFile) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("mapping", ImportMapping.Type).
+			Ref("files").
+			Field("mapping_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Mixin of the ImportedFile.
+func (ImportedFile) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the ImportedFile.
+func (ImportedFile) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("mapping_id", "remote_file_id").Unique(),
+	}
+}
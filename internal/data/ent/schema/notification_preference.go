@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// NotificationPreference holds the schema definition for the NotificationPreference entity.
+// Per-user opt-outs for in-app notifications; users without a row get every notification.
+type NotificationPreference struct {
+	ent.Schema
+}
+
+// Annotations of the NotificationPreference.
+func (NotificationPreference) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "paperless_notification_preferences"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the NotificationPreference.
+func (NotificationPreference) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint32("user_id").
+			Comment("User the preferences belong to"),
+
+		field.Bool("share_enabled").
+			Default(true).
+			Comment("Notify the user when something is shared with them"),
+
+		field.Bool("mention_enabled").
+			Default(true).
+			Comment("Notify the user when they are mentioned"),
+
+		field.Bool("reminder_enabled").
+			Default(true).
+			Comment("Remind the user of due dates of documents they own"),
+
+		field.Bool("assignment_enabled").
+			Default(true).
+			Comment("Notify the user when a document is assigned to them"),
+	}
+}
+
+// Edges of the NotificationPreference.
+func (NotificationPreference) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the NotificationPreference.
+func (NotificationPreference) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the NotificationPreference.
+func (NotificationPreference) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "user_id").Unique(),
+	}
+}
@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// OutboxEvent holds the schema definition for the OutboxEvent entity.
+// Lifecycle events are written here in the transaction of the change they describe and
+// removed by the relay once they were published.
+type OutboxEvent struct {
+	ent.Schema
+}
+
+// Annotations of the OutboxEvent.
+func (OutboxEvent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "paperless_event_outbox"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the OutboxEvent.
+func (OutboxEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("event_id").
+			NotEmpty().
+			MaxLen(64).
+			Comment("CloudEvents id"),
+
+		field.String("event_type").
+			NotEmpty().
+			MaxLen(64).
+			Comment("CloudEvents type"),
+
+		field.String("subject").
+			Optional().
+			MaxLen(64).
+			Comment("Resource the event is about, used as the broker message key"),
+
+		field.Text("payload").
+			Comment("CloudEvent encoded as JSON"),
+
+		field.Int32("attempts").
+			Default(0).
+			Comment("Failed publish attempts so far"),
+
+		field.Time("next_attempt_at").
+			Comment("When the event is due to be published"),
+
+		field.String("last_error").
+			Optional().
+			MaxLen(1024).
+			Comment("Why the last publish attempt failed"),
+	}
+}
+
+// Mixin of the OutboxEvent.
+func (OutboxEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the OutboxEvent.
+func (OutboxEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("next_attempt_at"),
+	}
+}
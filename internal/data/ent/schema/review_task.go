@@ -0,0 +1,104 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// ReviewTask holds the schema definition for the ReviewTask entity.
+// A review task asks a user to review a document and records their sign-off or rejection.
+type ReviewTask struct {
+	ent.Schema
+}
+
+// Annotations of the ReviewTask.
+func (ReviewTask) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "paperless_review_tasks"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the ReviewTask.
+func (ReviewTask) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("UUID primary key"),
+
+		field.String("document_id").
+			NotEmpty().
+			Comment("Document to review"),
+
+		field.Uint32("reviewer_id").
+			Comment("User asked to review the document"),
+
+		field.Enum("status").
+			Values(
+				"REVIEW_STATUS_PENDING",
+				"REVIEW_STATUS_APPROVED",
+				"REVIEW_STATUS_REJECTED",
+				"REVIEW_STATUS_CANCELLED",
+			).
+			Default("REVIEW_STATUS_PENDING").
+			Comment("Status of the review"),
+
+		field.String("message").
+			Optional().
+			MaxLen(1024).
+			Comment("Note of the requester to the reviewer"),
+
+		field.Text("comment").
+			Optional().
+			Comment("Comment of the reviewer on their decision"),
+
+		field.Uint32("reviewed_version").
+			Optional().
+			Nillable().
+			Comment("Version of the document the reviewer approved or rejected"),
+
+		field.Time("completed_at").
+			Optional().
+			Nillable().
+			Comment("When the review was approved, rejected or cancelled"),
+
+		field.Uint32("completed_by").
+			Optional().
+			Nillable().
+			Comment("User who approved, rejected or cancelled the review"),
+	}
+}
+
+// Edges of the ReviewTask.
+func (ReviewTask) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("document", Document.Type).
+			Ref("review_tasks").
+			Field("document_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Mixin of the ReviewTask.
+func (ReviewTask) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the ReviewTask.
+func (ReviewTask) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("document_id"),
+		index.Fields("tenant_id", "reviewer_id", "status"),
+	}
+}
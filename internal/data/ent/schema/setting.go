@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// Setting holds the schema definition for the Setting entity.
+// Service-wide runtime settings shared by all replicas (e.g. the active storage backend).
+type Setting struct {
+	ent.Schema
+}
+
+// Annotations of the Setting.
+func (Setting) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "paperless_settings"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the Setting.
+func (Setting) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			MaxLen(128).
+			Unique().
+			Comment("Setting name"),
+
+		field.String("value").
+			Comment("Setting value"),
+	}
+}
+
+// Edges of the Setting.
+func (Setting) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the Setting.
+func (Setting) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.Time{},
+	}
+}
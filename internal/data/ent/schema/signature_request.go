@@ -0,0 +1,128 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// SignatureSigner is a recipient who signs a document
+type SignatureSigner struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	// Status is the recipient's status reported by the provider, e.g. "completed"
+	Status string `json:"status,omitempty"`
+}
+
+// SignatureRequest holds the schema definition for the SignatureRequest entity.
+// A signature request sends a document to an e-signature provider and tracks it until the
+// signed PDF replaces the document's file.
+type SignatureRequest struct {
+	ent.Schema
+}
+
+// Annotations of the SignatureRequest.
+func (SignatureRequest) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "paperless_signature_requests"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the SignatureRequest.
+func (SignatureRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("UUID primary key"),
+
+		field.String("document_id").
+			NotEmpty().
+			Comment("Document being signed"),
+
+		field.String("provider").
+			NotEmpty().
+			MaxLen(32).
+			Comment("E-signature provider, e.g. docusign"),
+
+		field.String("envelope_id").
+			Optional().
+			MaxLen(255).
+			Comment("ID of the envelope at the provider"),
+
+		field.Enum("status").
+			Values(
+				"SIGNATURE_STATUS_SENT",
+				"SIGNATURE_STATUS_DELIVERED",
+				"SIGNATURE_STATUS_COMPLETED",
+				"SIGNATURE_STATUS_DECLINED",
+				"SIGNATURE_STATUS_VOIDED",
+				"SIGNATURE_STATUS_FAILED",
+			).
+			Default("SIGNATURE_STATUS_SENT").
+			Comment("Status of the envelope"),
+
+		field.JSON("signers", []SignatureSigner{}).
+			Comment("Recipients who sign, in signing order"),
+
+		field.String("subject").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Email subject sent to the signers"),
+
+		field.Text("message").
+			Optional().
+			Comment("Email message sent to the signers"),
+
+		field.String("original_file_key").
+			NotEmpty().
+			Comment("Storage key of the unsigned file, kept after the signed PDF replaces it"),
+
+		field.String("signed_file_key").
+			Optional().
+			Comment("Storage key of the signed PDF"),
+
+		field.Time("completed_at").
+			Optional().
+			Nillable().
+			Comment("When the signed PDF was attached"),
+
+		field.String("last_error").
+			Optional().
+			MaxLen(1024).
+			Comment("Why sending, voiding or attaching the signed PDF failed"),
+	}
+}
+
+// Edges of the SignatureRequest.
+func (SignatureRequest) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("document", Document.Type).
+			Ref("signature_requests").
+			Field("document_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Mixin of the SignatureRequest.
+func (SignatureRequest) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the SignatureRequest.
+func (SignatureRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("document_id"),
+		index.Fields("provider", "envelope_id"),
+	}
+}
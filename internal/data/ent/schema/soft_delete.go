@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"context"
+	"reflect"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	entMixin "entgo.io/ent/schema/mixin"
+)
+
+type softDeleteKey struct{}
+
+// SkipSoftDelete returns a context whose queries also match soft-deleted rows
+func SkipSoftDelete(parent context.Context) context.Context {
+	return context.WithValue(parent, softDeleteKey{}, true)
+}
+
+// SoftDeleteSkipped reports whether ctx was marked with SkipSoftDelete
+func SoftDeleteSkipped(ctx context.Context) bool {
+	skip, _ := ctx.Value(softDeleteKey{}).(bool)
+	return skip
+}
+
+// SoftDelete hides rows whose status field holds the deleted value from every query,
+// unless the context was marked with SkipSoftDelete
+type SoftDelete struct {
+	entMixin.Schema
+
+	// Field is the status column that marks a row as deleted
+	Field string
+	// Deleted is the status value of a soft-deleted row
+	Deleted string
+}
+
+// Interceptors of the SoftDelete mixin.
+func (m SoftDelete) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		ent.TraverseFunc(func(ctx context.Context, q ent.Query) error {
+			if SoftDeleteSkipped(ctx) {
+				return nil
+			}
+			wherePredicate(q, func(s *sql.Selector) {
+				s.Where(sql.NEQ(s.C(m.Field), m.Deleted))
+			})
+			return nil
+		}),
+	}
+}
+
+// wherePredicate appends a storage-level predicate through the generated Where method of the
+// query builder. The schema package can't import the generated code, so the predicate is
+// converted to the builder's own predicate type by reflection.
+func wherePredicate(q ent.Query, p func(*sql.Selector)) {
+	where := reflect.ValueOf(q).MethodByName("Where")
+	if !where.IsValid() || !where.Type().IsVariadic() || where.Type().NumIn() != 1 {
+		return
+	}
+	predicateType := where.Type().In(0).Elem()
+	fn := reflect.ValueOf(p)
+	if !fn.Type().ConvertibleTo(predicateType) {
+		return
+	}
+
+	predicates := reflect.MakeSlice(reflect.SliceOf(predicateType), 1, 1)
+	predicates.Index(0).Set(fn.Convert(predicateType))
+	where.CallSlice([]reflect.Value{predicates})
+}
@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// StatisticsRollup holds the schema definition for the StatisticsRollup entity.
+// It stores the statistics of a tenant, or of all tenants, as last computed by the background
+// aggregator, so reading them doesn't run the aggregate queries again.
+type StatisticsRollup struct {
+	ent.Schema
+}
+
+// Annotations of the StatisticsRollup.
+func (StatisticsRollup) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "paperless_statistics_rollups"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the StatisticsRollup.
+func (StatisticsRollup) Fields() []ent.Field {
+	return []ent.Field{
+		field.Bool("all_tenants").
+			Default(false).
+			Comment("Whether the statistics cover all tenants instead of tenant_id"),
+
+		field.Bytes("statistics").
+			Comment("Protobuf-encoded GetStatisticsResponse, with the most top tags a request may ask for"),
+
+		field.Time("generated_at").
+			Comment("When the statistics were computed"),
+	}
+}
+
+// Edges of the StatisticsRollup.
+func (StatisticsRollup) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the StatisticsRollup.
+func (StatisticsRollup) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the StatisticsRollup.
+func (StatisticsRollup) Indexes() []ent.Index {
+	return []ent.Index{
+		// One rollup per tenant, and one of all tenants
+		index.Fields("tenant_id", "all_tenants").Unique(),
+	}
+}
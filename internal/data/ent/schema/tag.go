@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// Tag holds the schema definition for the Tag entity.
+// Tags are unique per tenant by name; documents reference them through DocumentTag.
+type Tag struct {
+	ent.Schema
+}
+
+// Annotations of the Tag.
+func (Tag) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "paperless_tags"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the Tag.
+func (Tag) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Tag name, the key in a document's tags"),
+
+		field.String("color").
+			Optional().
+			MaxLen(7).
+			Comment("Display color as #RRGGBB"),
+
+		field.String("description").
+			Optional().
+			MaxLen(1024).
+			Comment("Tag description"),
+	}
+}
+
+// Edges of the Tag.
+func (Tag) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("document_tags", DocumentTag.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Documents carrying this tag"),
+	}
+}
+
+// Mixin of the Tag.
+func (Tag) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.CreateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the Tag.
+func (Tag) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "name").Unique(),
+	}
+}
@@ -0,0 +1,117 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// TenantDeleteJob holds the schema definition for the TenantDeleteJob entity.
+// Offboarding a tenant queues a job here that a background worker carries out in batches,
+// recording its progress on the job. Jobs are platform records, so they outlive the tenant's
+// data and don't use the TenantID mixin.
+type TenantDeleteJob struct {
+	ent.Schema
+}
+
+// Annotations of the TenantDeleteJob.
+func (TenantDeleteJob) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "paperless_tenant_delete_jobs"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the TenantDeleteJob.
+func (TenantDeleteJob) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("UUID primary key"),
+
+		field.Uint32("tenant_id").
+			Comment("Tenant whose data is deleted"),
+
+		field.Enum("status").
+			Values(
+				"TENANT_DELETE_JOB_STATUS_PENDING",
+				"TENANT_DELETE_JOB_STATUS_RUNNING",
+				"TENANT_DELETE_JOB_STATUS_SUCCEEDED",
+				"TENANT_DELETE_JOB_STATUS_FAILED",
+			).
+			Default("TENANT_DELETE_JOB_STATUS_PENDING").
+			Comment("Pending until a worker picks the job up, running until it is done"),
+
+		field.String("stage").
+			Optional().
+			MaxLen(64).
+			Comment("Kind of data the job is deleting"),
+
+		field.Int64("total_items").
+			Default(0).
+			Comment("Records to delete, counted when the job was queued"),
+
+		field.Int64("processed_items").
+			Default(0).
+			Comment("Records deleted so far"),
+
+		field.Int64("documents_deleted").
+			Default(0).
+			Comment("Documents deleted so far, trashed ones included"),
+
+		field.Int64("categories_deleted").
+			Default(0).
+			Comment("Categories deleted so far"),
+
+		field.Int64("permissions_deleted").
+			Default(0).
+			Comment("Permission grants deleted so far"),
+
+		field.Int64("records_deleted").
+			Default(0).
+			Comment("Other records, such as tags, history and audit entries, deleted so far"),
+
+		field.Int64("objects_deleted").
+			Default(0).
+			Comment("Storage objects deleted so far"),
+
+		field.Int64("bytes_deleted").
+			Default(0).
+			Comment("Size of the storage objects deleted so far"),
+
+		field.String("error").
+			Optional().
+			MaxLen(1024).
+			Comment("Why the job failed"),
+
+		field.Time("lease_until").
+			Optional().
+			Nillable().
+			Comment("Until when the running worker holds the job; another worker resumes it afterwards"),
+
+		field.Time("finished_at").
+			Optional().
+			Nillable().
+			Comment("When the job succeeded or failed"),
+	}
+}
+
+// Mixin of the TenantDeleteJob.
+func (TenantDeleteJob) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.Time{},
+	}
+}
+
+// Indexes of the TenantDeleteJob.
+func (TenantDeleteJob) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "lease_until"),
+		index.Fields("tenant_id", "create_time"),
+	}
+}
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// TenantKey holds the schema definition for the TenantKey entity.
+// Stores each tenant's document data key, wrapped (encrypted) by the master key.
+type TenantKey struct {
+	ent.Schema
+}
+
+// Annotations of the TenantKey.
+func (TenantKey) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "paperless_tenant_keys"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the TenantKey.
+func (TenantKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.Bytes("wrapped_key").
+			Sensitive().
+			Comment("Data key encrypted with the master key"),
+
+		field.String("master_key_id").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Identifier of the master key that wrapped the data key"),
+	}
+}
+
+// Edges of the TenantKey.
+func (TenantKey) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the TenantKey.
+func (TenantKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the TenantKey.
+func (TenantKey) Indexes() []ent.Index {
+	return []ent.Index{
+		// One data key per tenant
+		index.Fields("tenant_id").Unique(),
+	}
+}
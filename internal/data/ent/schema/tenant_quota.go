@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// TenantQuota holds the schema definition for the TenantQuota entity.
+// It stores the limits platform admins set for a tenant and counts the tenant's uploads of the
+// current month, which the monthly limit is checked against.
+type TenantQuota struct {
+	ent.Schema
+}
+
+// Annotations of the TenantQuota.
+func (TenantQuota) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "paperless_tenant_quotas"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the TenantQuota.
+func (TenantQuota) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("max_documents").
+			Optional().
+			Nillable().
+			Comment("Most documents the tenant may hold (null for no limit)"),
+
+		field.Int64("max_bytes").
+			Optional().
+			Nillable().
+			Comment("Most bytes the tenant's documents may take (null for no limit)"),
+
+		field.Int64("max_monthly_upload_bytes").
+			Optional().
+			Nillable().
+			Comment("Most bytes the tenant may upload per calendar month (null for no limit)"),
+
+		field.String("upload_month").
+			Optional().
+			MaxLen(7).
+			Comment("Month the upload counter refers to, e.g. 2026-10 (UTC)"),
+
+		field.Int64("month_upload_bytes").
+			Default(0).
+			Comment("Bytes uploaded in upload_month"),
+	}
+}
+
+// Edges of the TenantQuota.
+func (TenantQuota) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the TenantQuota.
+func (TenantQuota) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the TenantQuota.
+func (TenantQuota) Indexes() []ent.Index {
+	return []ent.Index{
+		// One quota per tenant
+		index.Fields("tenant_id").Unique(),
+	}
+}
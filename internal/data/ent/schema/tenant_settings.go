@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// TenantSettings holds the schema definition for the TenantSettings entity.
+// It stores the settings a tenant overrides the service's defaults with. Empty values keep
+// the default.
+type TenantSettings struct {
+	ent.Schema
+}
+
+// Annotations of the TenantSettings.
+func (TenantSettings) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "paperless_tenant_settings"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the TenantSettings.
+func (TenantSettings) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("ocr_language").
+			Optional().
+			MaxLen(64).
+			Comment("Tesseract languages used for OCR, e.g. eng+deu"),
+
+		field.Strings("disabled_processing_steps").
+			Optional().
+			Comment("Processing steps skipped for the tenant's documents"),
+
+		field.Strings("allowed_mime_types").
+			Optional().
+			Comment("MIME types accepted for uploads, e.g. application/pdf or image/* (empty accepts all)"),
+
+		field.String("default_retention_class").
+			Optional().
+			MaxLen(64).
+			Comment("Retention class of new documents that get none from their category"),
+
+		field.String("storage_backend").
+			Optional().
+			MaxLen(64).
+			Comment("Storage backend receiving the tenant's uploads (empty follows the active backend)"),
+	}
+}
+
+// Edges of the TenantSettings.
+func (TenantSettings) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the TenantSettings.
+func (TenantSettings) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the TenantSettings.
+func (TenantSettings) Indexes() []ent.Index {
+	return []ent.Index{
+		// One settings row per tenant
+		index.Fields("tenant_id").Unique(),
+	}
+}
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"context"
+	"slices"
+
+	"entgo.io/ent"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// Versioned adds a version field that every update increments by one, the base for optimistic
+// locking and for clients that sync changes
+type Versioned struct {
+	mixin.Version
+
+	// Ignore lists fields whose changes alone don't count as a new version, e.g. access bookkeeping
+	Ignore []string
+}
+
+// Hooks of the Versioned mixin.
+func (m Versioned) Hooks() []ent.Hook {
+	return []ent.Hook{
+		func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, mu ent.Mutation) (ent.Value, error) {
+				if mu.Op().Is(ent.OpUpdate|ent.OpUpdateOne) && m.changed(mu) {
+					if err := mu.AddField("version", int32(1)); err != nil {
+						return nil, err
+					}
+				}
+				return next.Mutate(ctx, mu)
+			})
+		},
+	}
+}
+
+// changed reports whether the mutation touches a field that isn't ignored
+func (m Versioned) changed(mu ent.Mutation) bool {
+	for _, name := range append(mu.Fields(), mu.ClearedFields()...) {
+		if !slices.Contains(m.Ignore, name) {
+			return true
+		}
+	}
+	return false
+}
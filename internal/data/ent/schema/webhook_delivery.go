@@ -0,0 +1,114 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery entity.
+// Each delivery sends one event to one subscription and is retried until it succeeds or gives up.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Annotations of the WebhookDelivery.
+func (WebhookDelivery) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "paperless_webhook_deliveries"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("subscription_id").
+			NotEmpty().
+			Comment("Subscription the event is delivered to"),
+
+		field.String("event_id").
+			NotEmpty().
+			MaxLen(64).
+			Comment("ID of the delivered event"),
+
+		field.String("event_type").
+			NotEmpty().
+			MaxLen(64).
+			Comment("Type of the delivered event"),
+
+		field.Text("payload").
+			Comment("JSON request body"),
+
+		field.Enum("status").
+			Values(
+				"WEBHOOK_DELIVERY_STATUS_PENDING",
+				"WEBHOOK_DELIVERY_STATUS_SUCCEEDED",
+				"WEBHOOK_DELIVERY_STATUS_FAILED",
+			).
+			Default("WEBHOOK_DELIVERY_STATUS_PENDING").
+			Comment("Pending until delivered or out of attempts"),
+
+		field.Int32("attempts").
+			Default(0).
+			Comment("Delivery attempts so far"),
+
+		field.Time("next_attempt_at").
+			Optional().
+			Nillable().
+			Comment("When the next attempt is due, null once the delivery is finished"),
+
+		field.Int32("response_status").
+			Optional().
+			Comment("HTTP status of the last attempt, 0 if no response was received"),
+
+		field.String("last_error").
+			Optional().
+			MaxLen(1024).
+			Comment("Why the last attempt failed"),
+
+		field.Int64("duration_ms").
+			Optional().
+			Comment("Duration of the last attempt"),
+
+		field.Time("delivered_at").
+			Optional().
+			Nillable().
+			Comment("When the delivery succeeded"),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("subscription", WebhookSubscription.Type).
+			Ref("deliveries").
+			Field("subscription_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Mixin of the WebhookDelivery.
+func (WebhookDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the WebhookDelivery.
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		// The outbox relay may hand an event over more than once
+		index.Fields("subscription_id", "event_id").Unique(),
+		index.Fields("subscription_id", "create_time"),
+		index.Fields("status", "next_attempt_at"),
+		index.Fields("create_time"),
+	}
+}
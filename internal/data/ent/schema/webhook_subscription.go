@@ -0,0 +1,83 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// WebhookSubscription holds the schema definition for the WebhookSubscription entity.
+// Subscriptions receive a tenant's lifecycle events as signed HTTP POST requests.
+type WebhookSubscription struct {
+	ent.Schema
+}
+
+// Annotations of the WebhookSubscription.
+func (WebhookSubscription) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "paperless_webhook_subscriptions"},
+		entsql.WithComments(true),
+	}
+}
+
+// Fields of the WebhookSubscription.
+func (WebhookSubscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("UUID primary key"),
+
+		field.String("name").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Display name"),
+
+		field.String("url").
+			NotEmpty().
+			MaxLen(2048).
+			Comment("Endpoint receiving the deliveries"),
+
+		field.String("secret").
+			NotEmpty().
+			Sensitive().
+			Comment("HMAC-SHA256 key signing the deliveries"),
+
+		field.JSON("event_types", []string{}).
+			Optional().
+			Comment("Event types to deliver; all events when empty"),
+
+		field.Bool("enabled").
+			Default(true).
+			Comment("Whether events are delivered"),
+	}
+}
+
+// Edges of the WebhookSubscription.
+func (WebhookSubscription) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("deliveries", WebhookDelivery.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Delivery attempts of this subscription"),
+	}
+}
+
+// Mixin of the WebhookSubscription.
+func (WebhookSubscription) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the WebhookSubscription.
+func (WebhookSubscription) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "enabled"),
+	}
+}
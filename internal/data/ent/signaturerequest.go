@@ -0,0 +1,325 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/document"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/signaturerequest"
+)
+
+// SignatureRequest is the model entity for the SignatureRequest schema.
+type SignatureRequest struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID primary key
+	ID string `json:"id,omitempty"`
+	// 创建者ID
+	CreateBy *uint32 `json:"create_by,omitempty"`
+	// 创建时间
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// 更新时间
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// 删除时间
+	DeleteTime *time.Time `json:"delete_time,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// Document being signed
+	DocumentID string `json:"document_id,omitempty"`
+	// E-signature provider, e.g. docusign
+	Provider string `json:"provider,omitempty"`
+	// ID of the envelope at the provider
+	EnvelopeID string `json:"envelope_id,omitempty"`
+	// Status of the envelope
+	Status signaturerequest.Status `json:"status,omitempty"`
+	// Recipients who sign, in signing order
+	Signers []schema.SignatureSigner `json:"signers,omitempty"`
+	// Email subject sent to the signers
+	Subject string `json:"subject,omitempty"`
+	// Email message sent to the signers
+	Message string `json:"message,omitempty"`
+	// Storage key of the unsigned file, kept after the signed PDF replaces it
+	OriginalFileKey string `json:"original_file_key,omitempty"`
+	// Storage key of the signed PDF
+	SignedFileKey string `json:"signed_file_key,omitempty"`
+	// When the signed PDF was attached
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// Why sending, voiding or attaching the signed PDF failed
+	LastError string `json:"last_error,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SignatureRequestQuery when eager-loading is set.
+	Edges        SignatureRequestEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SignatureRequestEdges holds the relations/edges for other nodes in the graph.
+type SignatureRequestEdges struct {
+	// Document holds the value of the document edge.
+	Document *Document `json:"document,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// DocumentOrErr returns the Document value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SignatureRequestEdges) DocumentOrErr() (*Document, error) {
+	if e.Document != nil {
+		return e.Document, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: document.Label}
+	}
+	return nil, &NotLoadedError{edge: "document"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SignatureRequest) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case signaturerequest.FieldSigners:
+			values[i] = new([]byte)
+		case signaturerequest.FieldCreateBy, signaturerequest.FieldTenantID:
+			values[i] = new(sql.NullInt64)
+		case signaturerequest.FieldID, signaturerequest.FieldDocumentID, signaturerequest.FieldProvider, signaturerequest.FieldEnvelopeID, signaturerequest.FieldStatus, signaturerequest.FieldSubject, signaturerequest.FieldMessage, signaturerequest.FieldOriginalFileKey, signaturerequest.FieldSignedFileKey, signaturerequest.FieldLastError:
+			values[i] = new(sql.NullString)
+		case signaturerequest.FieldCreateTime, signaturerequest.FieldUpdateTime, signaturerequest.FieldDeleteTime, signaturerequest.FieldCompletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SignatureRequest fields.
+func (_m *SignatureRequest) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case signaturerequest.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case signaturerequest.FieldCreateBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field create_by", values[i])
+			} else if value.Valid {
+				_m.CreateBy = new(uint32)
+				*_m.CreateBy = uint32(value.Int64)
+			}
+		case signaturerequest.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = new(time.Time)
+				*_m.CreateTime = value.Time
+			}
+		case signaturerequest.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = new(time.Time)
+				*_m.UpdateTime = value.Time
+			}
+		case signaturerequest.FieldDeleteTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_time", values[i])
+			} else if value.Valid {
+				_m.DeleteTime = new(time.Time)
+				*_m.DeleteTime = value.Time
+			}
+		case signaturerequest.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case signaturerequest.FieldDocumentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field document_id", values[i])
+			} else if value.Valid {
+				_m.DocumentID = value.String
+			}
+		case signaturerequest.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				_m.Provider = value.String
+			}
+		case signaturerequest.FieldEnvelopeID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field envelope_id", values[i])
+			} else if value.Valid {
+				_m.EnvelopeID = value.String
+			}
+		case signaturerequest.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = signaturerequest.Status(value.String)
+			}
+		case signaturerequest.FieldSigners:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field signers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Signers); err != nil {
+					return fmt.Errorf("unmarshal field signers: %w", err)
+				}
+			}
+		case signaturerequest.FieldSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject", values[i])
+			} else if value.Valid {
+				_m.Subject = value.String
+			}
+		case signaturerequest.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				_m.Message = value.String
+			}
+		case signaturerequest.FieldOriginalFileKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field original_file_key", values[i])
+			} else if value.Valid {
+				_m.OriginalFileKey = value.String
+			}
+		case signaturerequest.FieldSignedFileKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signed_file_key", values[i])
+			} else if value.Valid {
+				_m.SignedFileKey = value.String
+			}
+		case signaturerequest.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				_m.CompletedAt = new(time.Time)
+				*_m.CompletedAt = value.Time
+			}
+		case signaturerequest.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				_m.LastError = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SignatureRequest.
+// This includes values selected through modifiers, order, etc.
+func (_m *SignatureRequest) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryDocument queries the "document" edge of the SignatureRequest entity.
+func (_m *SignatureRequest) QueryDocument() *DocumentQuery {
+	return NewSignatureRequestClient(_m.config).QueryDocument(_m)
+}
+
+// Update returns a builder for updating this SignatureRequest.
+// Note that you need to call SignatureRequest.Unwrap() before calling this method if this SignatureRequest
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SignatureRequest) Update() *SignatureRequestUpdateOne {
+	return NewSignatureRequestClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SignatureRequest entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SignatureRequest) Unwrap() *SignatureRequest {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SignatureRequest is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SignatureRequest) String() string {
+	var builder strings.Builder
+	builder.WriteString("SignatureRequest(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreateBy; v != nil {
+		builder.WriteString("create_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeleteTime; v != nil {
+		builder.WriteString("delete_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("document_id=")
+	builder.WriteString(_m.DocumentID)
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(_m.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("envelope_id=")
+	builder.WriteString(_m.EnvelopeID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("signers=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Signers))
+	builder.WriteString(", ")
+	builder.WriteString("subject=")
+	builder.WriteString(_m.Subject)
+	builder.WriteString(", ")
+	builder.WriteString("message=")
+	builder.WriteString(_m.Message)
+	builder.WriteString(", ")
+	builder.WriteString("original_file_key=")
+	builder.WriteString(_m.OriginalFileKey)
+	builder.WriteString(", ")
+	builder.WriteString("signed_file_key=")
+	builder.WriteString(_m.SignedFileKey)
+	builder.WriteString(", ")
+	if v := _m.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(_m.LastError)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SignatureRequests is a parsable slice of SignatureRequest.
+type SignatureRequests []*SignatureRequest
@@ -0,0 +1,245 @@
+// Code generated by ent, DO NOT EDIT.
+
+package signaturerequest
+
+import (
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the signaturerequest type in the database.
+	Label = "signature_request"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateBy holds the string denoting the create_by field in the database.
+	FieldCreateBy = "create_by"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldDeleteTime holds the string denoting the delete_time field in the database.
+	FieldDeleteTime = "delete_time"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldDocumentID holds the string denoting the document_id field in the database.
+	FieldDocumentID = "document_id"
+	// FieldProvider holds the string denoting the provider field in the database.
+	FieldProvider = "provider"
+	// FieldEnvelopeID holds the string denoting the envelope_id field in the database.
+	FieldEnvelopeID = "envelope_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldSigners holds the string denoting the signers field in the database.
+	FieldSigners = "signers"
+	// FieldSubject holds the string denoting the subject field in the database.
+	FieldSubject = "subject"
+	// FieldMessage holds the string denoting the message field in the database.
+	FieldMessage = "message"
+	// FieldOriginalFileKey holds the string denoting the original_file_key field in the database.
+	FieldOriginalFileKey = "original_file_key"
+	// FieldSignedFileKey holds the string denoting the signed_file_key field in the database.
+	FieldSignedFileKey = "signed_file_key"
+	// FieldCompletedAt holds the string denoting the completed_at field in the database.
+	FieldCompletedAt = "completed_at"
+	// FieldLastError holds the string denoting the last_error field in the database.
+	FieldLastError = "last_error"
+	// EdgeDocument holds the string denoting the document edge name in mutations.
+	EdgeDocument = "document"
+	// Table holds the table name of the signaturerequest in the database.
+	Table = "paperless_signature_requests"
+	// DocumentTable is the table that holds the document relation/edge.
+	DocumentTable = "paperless_signature_requests"
+	// DocumentInverseTable is the table name for the Document entity.
+	// It exists in this package in order to avoid circular dependency with the "document" package.
+	DocumentInverseTable = "paperless_documents"
+	// DocumentColumn is the table column denoting the document relation/edge.
+	DocumentColumn = "document_id"
+)
+
+// Columns holds all SQL columns for signaturerequest fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateBy,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldDeleteTime,
+	FieldTenantID,
+	FieldDocumentID,
+	FieldProvider,
+	FieldEnvelopeID,
+	FieldStatus,
+	FieldSigners,
+	FieldSubject,
+	FieldMessage,
+	FieldOriginalFileKey,
+	FieldSignedFileKey,
+	FieldCompletedAt,
+	FieldLastError,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/go-tangra/go-tangra-paperless/internal/data/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint32
+	// DocumentIDValidator is a validator for the "document_id" field. It is called by the builders before save.
+	DocumentIDValidator func(string) error
+	// ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	ProviderValidator func(string) error
+	// EnvelopeIDValidator is a validator for the "envelope_id" field. It is called by the builders before save.
+	EnvelopeIDValidator func(string) error
+	// SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	SubjectValidator func(string) error
+	// OriginalFileKeyValidator is a validator for the "original_file_key" field. It is called by the builders before save.
+	OriginalFileKeyValidator func(string) error
+	// LastErrorValidator is a validator for the "last_error" field. It is called by the builders before save.
+	LastErrorValidator func(string) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusSIGNATURE_STATUS_SENT is the default value of the Status enum.
+const DefaultStatus = StatusSIGNATURE_STATUS_SENT
+
+// Status values.
+const (
+	StatusSIGNATURE_STATUS_SENT      Status = "SIGNATURE_STATUS_SENT"
+	StatusSIGNATURE_STATUS_DELIVERED Status = "SIGNATURE_STATUS_DELIVERED"
+	StatusSIGNATURE_STATUS_COMPLETED Status = "SIGNATURE_STATUS_COMPLETED"
+	StatusSIGNATURE_STATUS_DECLINED  Status = "SIGNATURE_STATUS_DECLINED"
+	StatusSIGNATURE_STATUS_VOIDED    Status = "SIGNATURE_STATUS_VOIDED"
+	StatusSIGNATURE_STATUS_FAILED    Status = "SIGNATURE_STATUS_FAILED"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusSIGNATURE_STATUS_SENT, StatusSIGNATURE_STATUS_DELIVERED, StatusSIGNATURE_STATUS_COMPLETED, StatusSIGNATURE_STATUS_DECLINED, StatusSIGNATURE_STATUS_VOIDED, StatusSIGNATURE_STATUS_FAILED:
+		return nil
+	default:
+		return fmt.Errorf("signaturerequest: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the SignatureRequest queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateBy orders the results by the create_by field.
+func ByCreateBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateBy, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByDeleteTime orders the results by the delete_time field.
+func ByDeleteTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeleteTime, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByDocumentID orders the results by the document_id field.
+func ByDocumentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDocumentID, opts...).ToFunc()
+}
+
+// ByProvider orders the results by the provider field.
+func ByProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvider, opts...).ToFunc()
+}
+
+// ByEnvelopeID orders the results by the envelope_id field.
+func ByEnvelopeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnvelopeID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// BySubject orders the results by the subject field.
+func BySubject(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubject, opts...).ToFunc()
+}
+
+// ByMessage orders the results by the message field.
+func ByMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessage, opts...).ToFunc()
+}
+
+// ByOriginalFileKey orders the results by the original_file_key field.
+func ByOriginalFileKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOriginalFileKey, opts...).ToFunc()
+}
+
+// BySignedFileKey orders the results by the signed_file_key field.
+func BySignedFileKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSignedFileKey, opts...).ToFunc()
+}
+
+// ByCompletedAt orders the results by the completed_at field.
+func ByCompletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompletedAt, opts...).ToFunc()
+}
+
+// ByLastError orders the results by the last_error field.
+func ByLastError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastError, opts...).ToFunc()
+}
+
+// ByDocumentField orders the results by document field.
+func ByDocumentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDocumentStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newDocumentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DocumentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, DocumentTable, DocumentColumn),
+	)
+}
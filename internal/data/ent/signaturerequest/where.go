@@ -0,0 +1,1054 @@
+// Code generated by ent, DO NOT EDIT.
+
+package signaturerequest
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreateBy applies equality check predicate on the "create_by" field. It's identical to CreateByEQ.
+func CreateBy(v uint32) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEQ(FieldTenantID, v))
+}
+
+// DocumentID applies equality check predicate on the "document_id" field. It's identical to DocumentIDEQ.
+func DocumentID(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEQ(FieldDocumentID, v))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEQ(FieldProvider, v))
+}
+
+// EnvelopeID applies equality check predicate on the "envelope_id" field. It's identical to EnvelopeIDEQ.
+func EnvelopeID(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEQ(FieldEnvelopeID, v))
+}
+
+// Subject applies equality check predicate on the "subject" field. It's identical to SubjectEQ.
+func Subject(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEQ(FieldSubject, v))
+}
+
+// Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
+func Message(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEQ(FieldMessage, v))
+}
+
+// OriginalFileKey applies equality check predicate on the "original_file_key" field. It's identical to OriginalFileKeyEQ.
+func OriginalFileKey(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEQ(FieldOriginalFileKey, v))
+}
+
+// SignedFileKey applies equality check predicate on the "signed_file_key" field. It's identical to SignedFileKeyEQ.
+func SignedFileKey(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEQ(FieldSignedFileKey, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEQ(FieldLastError, v))
+}
+
+// CreateByEQ applies the EQ predicate on the "create_by" field.
+func CreateByEQ(v uint32) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// CreateByNEQ applies the NEQ predicate on the "create_by" field.
+func CreateByNEQ(v uint32) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNEQ(FieldCreateBy, v))
+}
+
+// CreateByIn applies the In predicate on the "create_by" field.
+func CreateByIn(vs ...uint32) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldIn(FieldCreateBy, vs...))
+}
+
+// CreateByNotIn applies the NotIn predicate on the "create_by" field.
+func CreateByNotIn(vs ...uint32) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNotIn(FieldCreateBy, vs...))
+}
+
+// CreateByGT applies the GT predicate on the "create_by" field.
+func CreateByGT(v uint32) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldGT(FieldCreateBy, v))
+}
+
+// CreateByGTE applies the GTE predicate on the "create_by" field.
+func CreateByGTE(v uint32) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldGTE(FieldCreateBy, v))
+}
+
+// CreateByLT applies the LT predicate on the "create_by" field.
+func CreateByLT(v uint32) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldLT(FieldCreateBy, v))
+}
+
+// CreateByLTE applies the LTE predicate on the "create_by" field.
+func CreateByLTE(v uint32) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldLTE(FieldCreateBy, v))
+}
+
+// CreateByIsNil applies the IsNil predicate on the "create_by" field.
+func CreateByIsNil() predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldIsNull(FieldCreateBy))
+}
+
+// CreateByNotNil applies the NotNil predicate on the "create_by" field.
+func CreateByNotNil() predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNotNull(FieldCreateBy))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNotNull(FieldTenantID))
+}
+
+// DocumentIDEQ applies the EQ predicate on the "document_id" field.
+func DocumentIDEQ(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEQ(FieldDocumentID, v))
+}
+
+// DocumentIDNEQ applies the NEQ predicate on the "document_id" field.
+func DocumentIDNEQ(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNEQ(FieldDocumentID, v))
+}
+
+// DocumentIDIn applies the In predicate on the "document_id" field.
+func DocumentIDIn(vs ...string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldIn(FieldDocumentID, vs...))
+}
+
+// DocumentIDNotIn applies the NotIn predicate on the "document_id" field.
+func DocumentIDNotIn(vs ...string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNotIn(FieldDocumentID, vs...))
+}
+
+// DocumentIDGT applies the GT predicate on the "document_id" field.
+func DocumentIDGT(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldGT(FieldDocumentID, v))
+}
+
+// DocumentIDGTE applies the GTE predicate on the "document_id" field.
+func DocumentIDGTE(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldGTE(FieldDocumentID, v))
+}
+
+// DocumentIDLT applies the LT predicate on the "document_id" field.
+func DocumentIDLT(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldLT(FieldDocumentID, v))
+}
+
+// DocumentIDLTE applies the LTE predicate on the "document_id" field.
+func DocumentIDLTE(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldLTE(FieldDocumentID, v))
+}
+
+// DocumentIDContains applies the Contains predicate on the "document_id" field.
+func DocumentIDContains(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldContains(FieldDocumentID, v))
+}
+
+// DocumentIDHasPrefix applies the HasPrefix predicate on the "document_id" field.
+func DocumentIDHasPrefix(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldHasPrefix(FieldDocumentID, v))
+}
+
+// DocumentIDHasSuffix applies the HasSuffix predicate on the "document_id" field.
+func DocumentIDHasSuffix(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldHasSuffix(FieldDocumentID, v))
+}
+
+// DocumentIDEqualFold applies the EqualFold predicate on the "document_id" field.
+func DocumentIDEqualFold(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEqualFold(FieldDocumentID, v))
+}
+
+// DocumentIDContainsFold applies the ContainsFold predicate on the "document_id" field.
+func DocumentIDContainsFold(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldContainsFold(FieldDocumentID, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// EnvelopeIDEQ applies the EQ predicate on the "envelope_id" field.
+func EnvelopeIDEQ(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEQ(FieldEnvelopeID, v))
+}
+
+// EnvelopeIDNEQ applies the NEQ predicate on the "envelope_id" field.
+func EnvelopeIDNEQ(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNEQ(FieldEnvelopeID, v))
+}
+
+// EnvelopeIDIn applies the In predicate on the "envelope_id" field.
+func EnvelopeIDIn(vs ...string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldIn(FieldEnvelopeID, vs...))
+}
+
+// EnvelopeIDNotIn applies the NotIn predicate on the "envelope_id" field.
+func EnvelopeIDNotIn(vs ...string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNotIn(FieldEnvelopeID, vs...))
+}
+
+// EnvelopeIDGT applies the GT predicate on the "envelope_id" field.
+func EnvelopeIDGT(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldGT(FieldEnvelopeID, v))
+}
+
+// EnvelopeIDGTE applies the GTE predicate on the "envelope_id" field.
+func EnvelopeIDGTE(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldGTE(FieldEnvelopeID, v))
+}
+
+// EnvelopeIDLT applies the LT predicate on the "envelope_id" field.
+func EnvelopeIDLT(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldLT(FieldEnvelopeID, v))
+}
+
+// EnvelopeIDLTE applies the LTE predicate on the "envelope_id" field.
+func EnvelopeIDLTE(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldLTE(FieldEnvelopeID, v))
+}
+
+// EnvelopeIDContains applies the Contains predicate on the "envelope_id" field.
+func EnvelopeIDContains(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldContains(FieldEnvelopeID, v))
+}
+
+// EnvelopeIDHasPrefix applies the HasPrefix predicate on the "envelope_id" field.
+func EnvelopeIDHasPrefix(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldHasPrefix(FieldEnvelopeID, v))
+}
+
+// EnvelopeIDHasSuffix applies the HasSuffix predicate on the "envelope_id" field.
+func EnvelopeIDHasSuffix(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldHasSuffix(FieldEnvelopeID, v))
+}
+
+// EnvelopeIDIsNil applies the IsNil predicate on the "envelope_id" field.
+func EnvelopeIDIsNil() predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldIsNull(FieldEnvelopeID))
+}
+
+// EnvelopeIDNotNil applies the NotNil predicate on the "envelope_id" field.
+func EnvelopeIDNotNil() predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNotNull(FieldEnvelopeID))
+}
+
+// EnvelopeIDEqualFold applies the EqualFold predicate on the "envelope_id" field.
+func EnvelopeIDEqualFold(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEqualFold(FieldEnvelopeID, v))
+}
+
+// EnvelopeIDContainsFold applies the ContainsFold predicate on the "envelope_id" field.
+func EnvelopeIDContainsFold(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldContainsFold(FieldEnvelopeID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// SubjectEQ applies the EQ predicate on the "subject" field.
+func SubjectEQ(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEQ(FieldSubject, v))
+}
+
+// SubjectNEQ applies the NEQ predicate on the "subject" field.
+func SubjectNEQ(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNEQ(FieldSubject, v))
+}
+
+// SubjectIn applies the In predicate on the "subject" field.
+func SubjectIn(vs ...string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldIn(FieldSubject, vs...))
+}
+
+// SubjectNotIn applies the NotIn predicate on the "subject" field.
+func SubjectNotIn(vs ...string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNotIn(FieldSubject, vs...))
+}
+
+// SubjectGT applies the GT predicate on the "subject" field.
+func SubjectGT(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldGT(FieldSubject, v))
+}
+
+// SubjectGTE applies the GTE predicate on the "subject" field.
+func SubjectGTE(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldGTE(FieldSubject, v))
+}
+
+// SubjectLT applies the LT predicate on the "subject" field.
+func SubjectLT(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldLT(FieldSubject, v))
+}
+
+// SubjectLTE applies the LTE predicate on the "subject" field.
+func SubjectLTE(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldLTE(FieldSubject, v))
+}
+
+// SubjectContains applies the Contains predicate on the "subject" field.
+func SubjectContains(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldContains(FieldSubject, v))
+}
+
+// SubjectHasPrefix applies the HasPrefix predicate on the "subject" field.
+func SubjectHasPrefix(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldHasPrefix(FieldSubject, v))
+}
+
+// SubjectHasSuffix applies the HasSuffix predicate on the "subject" field.
+func SubjectHasSuffix(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldHasSuffix(FieldSubject, v))
+}
+
+// SubjectEqualFold applies the EqualFold predicate on the "subject" field.
+func SubjectEqualFold(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEqualFold(FieldSubject, v))
+}
+
+// SubjectContainsFold applies the ContainsFold predicate on the "subject" field.
+func SubjectContainsFold(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldContainsFold(FieldSubject, v))
+}
+
+// MessageEQ applies the EQ predicate on the "message" field.
+func MessageEQ(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEQ(FieldMessage, v))
+}
+
+// MessageNEQ applies the NEQ predicate on the "message" field.
+func MessageNEQ(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNEQ(FieldMessage, v))
+}
+
+// MessageIn applies the In predicate on the "message" field.
+func MessageIn(vs ...string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldIn(FieldMessage, vs...))
+}
+
+// MessageNotIn applies the NotIn predicate on the "message" field.
+func MessageNotIn(vs ...string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNotIn(FieldMessage, vs...))
+}
+
+// MessageGT applies the GT predicate on the "message" field.
+func MessageGT(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldGT(FieldMessage, v))
+}
+
+// MessageGTE applies the GTE predicate on the "message" field.
+func MessageGTE(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldGTE(FieldMessage, v))
+}
+
+// MessageLT applies the LT predicate on the "message" field.
+func MessageLT(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldLT(FieldMessage, v))
+}
+
+// MessageLTE applies the LTE predicate on the "message" field.
+func MessageLTE(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldLTE(FieldMessage, v))
+}
+
+// MessageContains applies the Contains predicate on the "message" field.
+func MessageContains(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldContains(FieldMessage, v))
+}
+
+// MessageHasPrefix applies the HasPrefix predicate on the "message" field.
+func MessageHasPrefix(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldHasPrefix(FieldMessage, v))
+}
+
+// MessageHasSuffix applies the HasSuffix predicate on the "message" field.
+func MessageHasSuffix(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldHasSuffix(FieldMessage, v))
+}
+
+// MessageIsNil applies the IsNil predicate on the "message" field.
+func MessageIsNil() predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldIsNull(FieldMessage))
+}
+
+// MessageNotNil applies the NotNil predicate on the "message" field.
+func MessageNotNil() predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNotNull(FieldMessage))
+}
+
+// MessageEqualFold applies the EqualFold predicate on the "message" field.
+func MessageEqualFold(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEqualFold(FieldMessage, v))
+}
+
+// MessageContainsFold applies the ContainsFold predicate on the "message" field.
+func MessageContainsFold(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldContainsFold(FieldMessage, v))
+}
+
+// OriginalFileKeyEQ applies the EQ predicate on the "original_file_key" field.
+func OriginalFileKeyEQ(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEQ(FieldOriginalFileKey, v))
+}
+
+// OriginalFileKeyNEQ applies the NEQ predicate on the "original_file_key" field.
+func OriginalFileKeyNEQ(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNEQ(FieldOriginalFileKey, v))
+}
+
+// OriginalFileKeyIn applies the In predicate on the "original_file_key" field.
+func OriginalFileKeyIn(vs ...string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldIn(FieldOriginalFileKey, vs...))
+}
+
+// OriginalFileKeyNotIn applies the NotIn predicate on the "original_file_key" field.
+func OriginalFileKeyNotIn(vs ...string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNotIn(FieldOriginalFileKey, vs...))
+}
+
+// OriginalFileKeyGT applies the GT predicate on the "original_file_key" field.
+func OriginalFileKeyGT(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldGT(FieldOriginalFileKey, v))
+}
+
+// OriginalFileKeyGTE applies the GTE predicate on the "original_file_key" field.
+func OriginalFileKeyGTE(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldGTE(FieldOriginalFileKey, v))
+}
+
+// OriginalFileKeyLT applies the LT predicate on the "original_file_key" field.
+func OriginalFileKeyLT(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldLT(FieldOriginalFileKey, v))
+}
+
+// OriginalFileKeyLTE applies the LTE predicate on the "original_file_key" field.
+func OriginalFileKeyLTE(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldLTE(FieldOriginalFileKey, v))
+}
+
+// OriginalFileKeyContains applies the Contains predicate on the "original_file_key" field.
+func OriginalFileKeyContains(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldContains(FieldOriginalFileKey, v))
+}
+
+// OriginalFileKeyHasPrefix applies the HasPrefix predicate on the "original_file_key" field.
+func OriginalFileKeyHasPrefix(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldHasPrefix(FieldOriginalFileKey, v))
+}
+
+// OriginalFileKeyHasSuffix applies the HasSuffix predicate on the "original_file_key" field.
+func OriginalFileKeyHasSuffix(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldHasSuffix(FieldOriginalFileKey, v))
+}
+
+// OriginalFileKeyEqualFold applies the EqualFold predicate on the "original_file_key" field.
+func OriginalFileKeyEqualFold(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEqualFold(FieldOriginalFileKey, v))
+}
+
+// OriginalFileKeyContainsFold applies the ContainsFold predicate on the "original_file_key" field.
+func OriginalFileKeyContainsFold(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldContainsFold(FieldOriginalFileKey, v))
+}
+
+// SignedFileKeyEQ applies the EQ predicate on the "signed_file_key" field.
+func SignedFileKeyEQ(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEQ(FieldSignedFileKey, v))
+}
+
+// SignedFileKeyNEQ applies the NEQ predicate on the "signed_file_key" field.
+func SignedFileKeyNEQ(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNEQ(FieldSignedFileKey, v))
+}
+
+// SignedFileKeyIn applies the In predicate on the "signed_file_key" field.
+func SignedFileKeyIn(vs ...string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldIn(FieldSignedFileKey, vs...))
+}
+
+// SignedFileKeyNotIn applies the NotIn predicate on the "signed_file_key" field.
+func SignedFileKeyNotIn(vs ...string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNotIn(FieldSignedFileKey, vs...))
+}
+
+// SignedFileKeyGT applies the GT predicate on the "signed_file_key" field.
+func SignedFileKeyGT(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldGT(FieldSignedFileKey, v))
+}
+
+// SignedFileKeyGTE applies the GTE predicate on the "signed_file_key" field.
+func SignedFileKeyGTE(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldGTE(FieldSignedFileKey, v))
+}
+
+// SignedFileKeyLT applies the LT predicate on the "signed_file_key" field.
+func SignedFileKeyLT(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldLT(FieldSignedFileKey, v))
+}
+
+// SignedFileKeyLTE applies the LTE predicate on the "signed_file_key" field.
+func SignedFileKeyLTE(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldLTE(FieldSignedFileKey, v))
+}
+
+// SignedFileKeyContains applies the Contains predicate on the "signed_file_key" field.
+func SignedFileKeyContains(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldContains(FieldSignedFileKey, v))
+}
+
+// SignedFileKeyHasPrefix applies the HasPrefix predicate on the "signed_file_key" field.
+func SignedFileKeyHasPrefix(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldHasPrefix(FieldSignedFileKey, v))
+}
+
+// SignedFileKeyHasSuffix applies the HasSuffix predicate on the "signed_file_key" field.
+func SignedFileKeyHasSuffix(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldHasSuffix(FieldSignedFileKey, v))
+}
+
+// SignedFileKeyIsNil applies the IsNil predicate on the "signed_file_key" field.
+func SignedFileKeyIsNil() predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldIsNull(FieldSignedFileKey))
+}
+
+// SignedFileKeyNotNil applies the NotNil predicate on the "signed_file_key" field.
+func SignedFileKeyNotNil() predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNotNull(FieldSignedFileKey))
+}
+
+// SignedFileKeyEqualFold applies the EqualFold predicate on the "signed_file_key" field.
+func SignedFileKeyEqualFold(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEqualFold(FieldSignedFileKey, v))
+}
+
+// SignedFileKeyContainsFold applies the ContainsFold predicate on the "signed_file_key" field.
+func SignedFileKeyContainsFold(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldContainsFold(FieldSignedFileKey, v))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldIsNull(FieldCompletedAt))
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNotNull(FieldCompletedAt))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// HasDocument applies the HasEdge predicate on the "document" edge.
+func HasDocument() predicate.SignatureRequest {
+	return predicate.SignatureRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DocumentTable, DocumentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDocumentWith applies the HasEdge predicate on the "document" edge with a given conditions (other predicates).
+func HasDocumentWith(preds ...predicate.Document) predicate.SignatureRequest {
+	return predicate.SignatureRequest(func(s *sql.Selector) {
+		step := newDocumentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SignatureRequest) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SignatureRequest) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SignatureRequest) predicate.SignatureRequest {
+	return predicate.SignatureRequest(sql.NotPredicates(p))
+}
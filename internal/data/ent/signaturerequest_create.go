@@ -0,0 +1,1535 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/document"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/signaturerequest"
+)
+
+// SignatureRequestCreate is the builder for creating a SignatureRequest entity.
+type SignatureRequestCreate struct {
+	config
+	mutation *SignatureRequestMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_c *SignatureRequestCreate) SetCreateBy(v uint32) *SignatureRequestCreate {
+	_c.mutation.SetCreateBy(v)
+	return _c
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_c *SignatureRequestCreate) SetNillableCreateBy(v *uint32) *SignatureRequestCreate {
+	if v != nil {
+		_c.SetCreateBy(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *SignatureRequestCreate) SetCreateTime(v time.Time) *SignatureRequestCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *SignatureRequestCreate) SetNillableCreateTime(v *time.Time) *SignatureRequestCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *SignatureRequestCreate) SetUpdateTime(v time.Time) *SignatureRequestCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *SignatureRequestCreate) SetNillableUpdateTime(v *time.Time) *SignatureRequestCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *SignatureRequestCreate) SetDeleteTime(v time.Time) *SignatureRequestCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *SignatureRequestCreate) SetNillableDeleteTime(v *time.Time) *SignatureRequestCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *SignatureRequestCreate) SetTenantID(v uint32) *SignatureRequestCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *SignatureRequestCreate) SetNillableTenantID(v *uint32) *SignatureRequestCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetDocumentID sets the "document_id" field.
+func (_c *SignatureRequestCreate) SetDocumentID(v string) *SignatureRequestCreate {
+	_c.mutation.SetDocumentID(v)
+	return _c
+}
+
+// SetProvider sets the "provider" field.
+func (_c *SignatureRequestCreate) SetProvider(v string) *SignatureRequestCreate {
+	_c.mutation.SetProvider(v)
+	return _c
+}
+
+// SetEnvelopeID sets the "envelope_id" field.
+func (_c *SignatureRequestCreate) SetEnvelopeID(v string) *SignatureRequestCreate {
+	_c.mutation.SetEnvelopeID(v)
+	return _c
+}
+
+// SetNillableEnvelopeID sets the "envelope_id" field if the given value is not nil.
+func (_c *SignatureRequestCreate) SetNillableEnvelopeID(v *string) *SignatureRequestCreate {
+	if v != nil {
+		_c.SetEnvelopeID(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *SignatureRequestCreate) SetStatus(v signaturerequest.Status) *SignatureRequestCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *SignatureRequestCreate) SetNillableStatus(v *signaturerequest.Status) *SignatureRequestCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetSigners sets the "signers" field.
+func (_c *SignatureRequestCreate) SetSigners(v []schema.SignatureSigner) *SignatureRequestCreate {
+	_c.mutation.SetSigners(v)
+	return _c
+}
+
+// SetSubject sets the "subject" field.
+func (_c *SignatureRequestCreate) SetSubject(v string) *SignatureRequestCreate {
+	_c.mutation.SetSubject(v)
+	return _c
+}
+
+// SetMessage sets the "message" field.
+func (_c *SignatureRequestCreate) SetMessage(v string) *SignatureRequestCreate {
+	_c.mutation.SetMessage(v)
+	return _c
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_c *SignatureRequestCreate) SetNillableMessage(v *string) *SignatureRequestCreate {
+	if v != nil {
+		_c.SetMessage(*v)
+	}
+	return _c
+}
+
+// SetOriginalFileKey sets the "original_file_key" field.
+func (_c *SignatureRequestCreate) SetOriginalFileKey(v string) *SignatureRequestCreate {
+	_c.mutation.SetOriginalFileKey(v)
+	return _c
+}
+
+// SetSignedFileKey sets the "signed_file_key" field.
+func (_c *SignatureRequestCreate) SetSignedFileKey(v string) *SignatureRequestCreate {
+	_c.mutation.SetSignedFileKey(v)
+	return _c
+}
+
+// SetNillableSignedFileKey sets the "signed_file_key" field if the given value is not nil.
+func (_c *SignatureRequestCreate) SetNillableSignedFileKey(v *string) *SignatureRequestCreate {
+	if v != nil {
+		_c.SetSignedFileKey(*v)
+	}
+	return _c
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_c *SignatureRequestCreate) SetCompletedAt(v time.Time) *SignatureRequestCreate {
+	_c.mutation.SetCompletedAt(v)
+	return _c
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_c *SignatureRequestCreate) SetNillableCompletedAt(v *time.Time) *SignatureRequestCreate {
+	if v != nil {
+		_c.SetCompletedAt(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *SignatureRequestCreate) SetLastError(v string) *SignatureRequestCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *SignatureRequestCreate) SetNillableLastError(v *string) *SignatureRequestCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SignatureRequestCreate) SetID(v string) *SignatureRequestCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetDocument sets the "document" edge to the Document entity.
+func (_c *SignatureRequestCreate) SetDocument(v *Document) *SignatureRequestCreate {
+	return _c.SetDocumentID(v.ID)
+}
+
+// Mutation returns the SignatureRequestMutation object of the builder.
+func (_c *SignatureRequestCreate) Mutation() *SignatureRequestMutation {
+	return _c.mutation
+}
+
+// Save creates the SignatureRequest in the database.
+func (_c *SignatureRequestCreate) Save(ctx context.Context) (*SignatureRequest, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SignatureRequestCreate) SaveX(ctx context.Context) *SignatureRequest {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SignatureRequestCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SignatureRequestCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SignatureRequestCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := signaturerequest.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := signaturerequest.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SignatureRequestCreate) check() error {
+	if _, ok := _c.mutation.DocumentID(); !ok {
+		return &ValidationError{Name: "document_id", err: errors.New(`ent: missing required field "SignatureRequest.document_id"`)}
+	}
+	if v, ok := _c.mutation.DocumentID(); ok {
+		if err := signaturerequest.DocumentIDValidator(v); err != nil {
+			return &ValidationError{Name: "document_id", err: fmt.Errorf(`ent: validator failed for field "SignatureRequest.document_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "SignatureRequest.provider"`)}
+	}
+	if v, ok := _c.mutation.Provider(); ok {
+		if err := signaturerequest.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "SignatureRequest.provider": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.EnvelopeID(); ok {
+		if err := signaturerequest.EnvelopeIDValidator(v); err != nil {
+			return &ValidationError{Name: "envelope_id", err: fmt.Errorf(`ent: validator failed for field "SignatureRequest.envelope_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "SignatureRequest.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := signaturerequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SignatureRequest.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Signers(); !ok {
+		return &ValidationError{Name: "signers", err: errors.New(`ent: missing required field "SignatureRequest.signers"`)}
+	}
+	if _, ok := _c.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required field "SignatureRequest.subject"`)}
+	}
+	if v, ok := _c.mutation.Subject(); ok {
+		if err := signaturerequest.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "SignatureRequest.subject": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.OriginalFileKey(); !ok {
+		return &ValidationError{Name: "original_file_key", err: errors.New(`ent: missing required field "SignatureRequest.original_file_key"`)}
+	}
+	if v, ok := _c.mutation.OriginalFileKey(); ok {
+		if err := signaturerequest.OriginalFileKeyValidator(v); err != nil {
+			return &ValidationError{Name: "original_file_key", err: fmt.Errorf(`ent: validator failed for field "SignatureRequest.original_file_key": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.LastError(); ok {
+		if err := signaturerequest.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`ent: validator failed for field "SignatureRequest.last_error": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := signaturerequest.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "SignatureRequest.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.DocumentIDs()) == 0 {
+		return &ValidationError{Name: "document", err: errors.New(`ent: missing required edge "SignatureRequest.document"`)}
+	}
+	return nil
+}
+
+func (_c *SignatureRequestCreate) sqlSave(ctx context.Context) (*SignatureRequest, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected SignatureRequest.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SignatureRequestCreate) createSpec() (*SignatureRequest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SignatureRequest{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(signaturerequest.Table, sqlgraph.NewFieldSpec(signaturerequest.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateBy(); ok {
+		_spec.SetField(signaturerequest.FieldCreateBy, field.TypeUint32, value)
+		_node.CreateBy = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(signaturerequest.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(signaturerequest.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(signaturerequest.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(signaturerequest.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.Provider(); ok {
+		_spec.SetField(signaturerequest.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := _c.mutation.EnvelopeID(); ok {
+		_spec.SetField(signaturerequest.FieldEnvelopeID, field.TypeString, value)
+		_node.EnvelopeID = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(signaturerequest.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Signers(); ok {
+		_spec.SetField(signaturerequest.FieldSigners, field.TypeJSON, value)
+		_node.Signers = value
+	}
+	if value, ok := _c.mutation.Subject(); ok {
+		_spec.SetField(signaturerequest.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := _c.mutation.Message(); ok {
+		_spec.SetField(signaturerequest.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	if value, ok := _c.mutation.OriginalFileKey(); ok {
+		_spec.SetField(signaturerequest.FieldOriginalFileKey, field.TypeString, value)
+		_node.OriginalFileKey = value
+	}
+	if value, ok := _c.mutation.SignedFileKey(); ok {
+		_spec.SetField(signaturerequest.FieldSignedFileKey, field.TypeString, value)
+		_node.SignedFileKey = value
+	}
+	if value, ok := _c.mutation.CompletedAt(); ok {
+		_spec.SetField(signaturerequest.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(signaturerequest.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if nodes := _c.mutation.DocumentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   signaturerequest.DocumentTable,
+			Columns: []string{signaturerequest.DocumentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DocumentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SignatureRequest.Create().
+//		SetCreateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SignatureRequestUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SignatureRequestCreate) OnConflict(opts ...sql.ConflictOption) *SignatureRequestUpsertOne {
+	_c.conflict = opts
+	return &SignatureRequestUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SignatureRequest.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SignatureRequestCreate) OnConflictColumns(columns ...string) *SignatureRequestUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SignatureRequestUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// SignatureRequestUpsertOne is the builder for "upsert"-ing
+	//  one SignatureRequest node.
+	SignatureRequestUpsertOne struct {
+		create *SignatureRequestCreate
+	}
+
+	// SignatureRequestUpsert is the "OnConflict" setter.
+	SignatureRequestUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateBy sets the "create_by" field.
+func (u *SignatureRequestUpsert) SetCreateBy(v uint32) *SignatureRequestUpsert {
+	u.Set(signaturerequest.FieldCreateBy, v)
+	return u
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *SignatureRequestUpsert) UpdateCreateBy() *SignatureRequestUpsert {
+	u.SetExcluded(signaturerequest.FieldCreateBy)
+	return u
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *SignatureRequestUpsert) AddCreateBy(v uint32) *SignatureRequestUpsert {
+	u.Add(signaturerequest.FieldCreateBy, v)
+	return u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *SignatureRequestUpsert) ClearCreateBy() *SignatureRequestUpsert {
+	u.SetNull(signaturerequest.FieldCreateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *SignatureRequestUpsert) SetUpdateTime(v time.Time) *SignatureRequestUpsert {
+	u.Set(signaturerequest.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *SignatureRequestUpsert) UpdateUpdateTime() *SignatureRequestUpsert {
+	u.SetExcluded(signaturerequest.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *SignatureRequestUpsert) ClearUpdateTime() *SignatureRequestUpsert {
+	u.SetNull(signaturerequest.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *SignatureRequestUpsert) SetDeleteTime(v time.Time) *SignatureRequestUpsert {
+	u.Set(signaturerequest.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *SignatureRequestUpsert) UpdateDeleteTime() *SignatureRequestUpsert {
+	u.SetExcluded(signaturerequest.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *SignatureRequestUpsert) ClearDeleteTime() *SignatureRequestUpsert {
+	u.SetNull(signaturerequest.FieldDeleteTime)
+	return u
+}
+
+// SetDocumentID sets the "document_id" field.
+func (u *SignatureRequestUpsert) SetDocumentID(v string) *SignatureRequestUpsert {
+	u.Set(signaturerequest.FieldDocumentID, v)
+	return u
+}
+
+// UpdateDocumentID sets the "document_id" field to the value that was provided on create.
+func (u *SignatureRequestUpsert) UpdateDocumentID() *SignatureRequestUpsert {
+	u.SetExcluded(signaturerequest.FieldDocumentID)
+	return u
+}
+
+// SetProvider sets the "provider" field.
+func (u *SignatureRequestUpsert) SetProvider(v string) *SignatureRequestUpsert {
+	u.Set(signaturerequest.FieldProvider, v)
+	return u
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *SignatureRequestUpsert) UpdateProvider() *SignatureRequestUpsert {
+	u.SetExcluded(signaturerequest.FieldProvider)
+	return u
+}
+
+// SetEnvelopeID sets the "envelope_id" field.
+func (u *SignatureRequestUpsert) SetEnvelopeID(v string) *SignatureRequestUpsert {
+	u.Set(signaturerequest.FieldEnvelopeID, v)
+	return u
+}
+
+// UpdateEnvelopeID sets the "envelope_id" field to the value that was provided on create.
+func (u *SignatureRequestUpsert) UpdateEnvelopeID() *SignatureRequestUpsert {
+	u.SetExcluded(signaturerequest.FieldEnvelopeID)
+	return u
+}
+
+// ClearEnvelopeID clears the value of the "envelope_id" field.
+func (u *SignatureRequestUpsert) ClearEnvelopeID() *SignatureRequestUpsert {
+	u.SetNull(signaturerequest.FieldEnvelopeID)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *SignatureRequestUpsert) SetStatus(v signaturerequest.Status) *SignatureRequestUpsert {
+	u.Set(signaturerequest.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SignatureRequestUpsert) UpdateStatus() *SignatureRequestUpsert {
+	u.SetExcluded(signaturerequest.FieldStatus)
+	return u
+}
+
+// SetSigners sets the "signers" field.
+func (u *SignatureRequestUpsert) SetSigners(v []schema.SignatureSigner) *SignatureRequestUpsert {
+	u.Set(signaturerequest.FieldSigners, v)
+	return u
+}
+
+// UpdateSigners sets the "signers" field to the value that was provided on create.
+func (u *SignatureRequestUpsert) UpdateSigners() *SignatureRequestUpsert {
+	u.SetExcluded(signaturerequest.FieldSigners)
+	return u
+}
+
+// SetSubject sets the "subject" field.
+func (u *SignatureRequestUpsert) SetSubject(v string) *SignatureRequestUpsert {
+	u.Set(signaturerequest.FieldSubject, v)
+	return u
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *SignatureRequestUpsert) UpdateSubject() *SignatureRequestUpsert {
+	u.SetExcluded(signaturerequest.FieldSubject)
+	return u
+}
+
+// SetMessage sets the "message" field.
+func (u *SignatureRequestUpsert) SetMessage(v string) *SignatureRequestUpsert {
+	u.Set(signaturerequest.FieldMessage, v)
+	return u
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *SignatureRequestUpsert) UpdateMessage() *SignatureRequestUpsert {
+	u.SetExcluded(signaturerequest.FieldMessage)
+	return u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *SignatureRequestUpsert) ClearMessage() *SignatureRequestUpsert {
+	u.SetNull(signaturerequest.FieldMessage)
+	return u
+}
+
+// SetOriginalFileKey sets the "original_file_key" field.
+func (u *SignatureRequestUpsert) SetOriginalFileKey(v string) *SignatureRequestUpsert {
+	u.Set(signaturerequest.FieldOriginalFileKey, v)
+	return u
+}
+
+// UpdateOriginalFileKey sets the "original_file_key" field to the value that was provided on create.
+func (u *SignatureRequestUpsert) UpdateOriginalFileKey() *SignatureRequestUpsert {
+	u.SetExcluded(signaturerequest.FieldOriginalFileKey)
+	return u
+}
+
+// SetSignedFileKey sets the "signed_file_key" field.
+func (u *SignatureRequestUpsert) SetSignedFileKey(v string) *SignatureRequestUpsert {
+	u.Set(signaturerequest.FieldSignedFileKey, v)
+	return u
+}
+
+// UpdateSignedFileKey sets the "signed_file_key" field to the value that was provided on create.
+func (u *SignatureRequestUpsert) UpdateSignedFileKey() *SignatureRequestUpsert {
+	u.SetExcluded(signaturerequest.FieldSignedFileKey)
+	return u
+}
+
+// ClearSignedFileKey clears the value of the "signed_file_key" field.
+func (u *SignatureRequestUpsert) ClearSignedFileKey() *SignatureRequestUpsert {
+	u.SetNull(signaturerequest.FieldSignedFileKey)
+	return u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *SignatureRequestUpsert) SetCompletedAt(v time.Time) *SignatureRequestUpsert {
+	u.Set(signaturerequest.FieldCompletedAt, v)
+	return u
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *SignatureRequestUpsert) UpdateCompletedAt() *SignatureRequestUpsert {
+	u.SetExcluded(signaturerequest.FieldCompletedAt)
+	return u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *SignatureRequestUpsert) ClearCompletedAt() *SignatureRequestUpsert {
+	u.SetNull(signaturerequest.FieldCompletedAt)
+	return u
+}
+
+// SetLastError sets the "last_error" field.
+func (u *SignatureRequestUpsert) SetLastError(v string) *SignatureRequestUpsert {
+	u.Set(signaturerequest.FieldLastError, v)
+	return u
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *SignatureRequestUpsert) UpdateLastError() *SignatureRequestUpsert {
+	u.SetExcluded(signaturerequest.FieldLastError)
+	return u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *SignatureRequestUpsert) ClearLastError() *SignatureRequestUpsert {
+	u.SetNull(signaturerequest.FieldLastError)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.SignatureRequest.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(signaturerequest.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SignatureRequestUpsertOne) UpdateNewValues() *SignatureRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(signaturerequest.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(signaturerequest.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(signaturerequest.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SignatureRequest.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SignatureRequestUpsertOne) Ignore() *SignatureRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SignatureRequestUpsertOne) DoNothing() *SignatureRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SignatureRequestCreate.OnConflict
+// documentation for more info.
+func (u *SignatureRequestUpsertOne) Update(set func(*SignatureRequestUpsert)) *SignatureRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SignatureRequestUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *SignatureRequestUpsertOne) SetCreateBy(v uint32) *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *SignatureRequestUpsertOne) AddCreateBy(v uint32) *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *SignatureRequestUpsertOne) UpdateCreateBy() *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *SignatureRequestUpsertOne) ClearCreateBy() *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *SignatureRequestUpsertOne) SetUpdateTime(v time.Time) *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *SignatureRequestUpsertOne) UpdateUpdateTime() *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *SignatureRequestUpsertOne) ClearUpdateTime() *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *SignatureRequestUpsertOne) SetDeleteTime(v time.Time) *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *SignatureRequestUpsertOne) UpdateDeleteTime() *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *SignatureRequestUpsertOne) ClearDeleteTime() *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetDocumentID sets the "document_id" field.
+func (u *SignatureRequestUpsertOne) SetDocumentID(v string) *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.SetDocumentID(v)
+	})
+}
+
+// UpdateDocumentID sets the "document_id" field to the value that was provided on create.
+func (u *SignatureRequestUpsertOne) UpdateDocumentID() *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.UpdateDocumentID()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *SignatureRequestUpsertOne) SetProvider(v string) *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *SignatureRequestUpsertOne) UpdateProvider() *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetEnvelopeID sets the "envelope_id" field.
+func (u *SignatureRequestUpsertOne) SetEnvelopeID(v string) *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.SetEnvelopeID(v)
+	})
+}
+
+// UpdateEnvelopeID sets the "envelope_id" field to the value that was provided on create.
+func (u *SignatureRequestUpsertOne) UpdateEnvelopeID() *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.UpdateEnvelopeID()
+	})
+}
+
+// ClearEnvelopeID clears the value of the "envelope_id" field.
+func (u *SignatureRequestUpsertOne) ClearEnvelopeID() *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.ClearEnvelopeID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *SignatureRequestUpsertOne) SetStatus(v signaturerequest.Status) *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SignatureRequestUpsertOne) UpdateStatus() *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetSigners sets the "signers" field.
+func (u *SignatureRequestUpsertOne) SetSigners(v []schema.SignatureSigner) *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.SetSigners(v)
+	})
+}
+
+// UpdateSigners sets the "signers" field to the value that was provided on create.
+func (u *SignatureRequestUpsertOne) UpdateSigners() *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.UpdateSigners()
+	})
+}
+
+// SetSubject sets the "subject" field.
+func (u *SignatureRequestUpsertOne) SetSubject(v string) *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.SetSubject(v)
+	})
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *SignatureRequestUpsertOne) UpdateSubject() *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.UpdateSubject()
+	})
+}
+
+// SetMessage sets the "message" field.
+func (u *SignatureRequestUpsertOne) SetMessage(v string) *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.SetMessage(v)
+	})
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *SignatureRequestUpsertOne) UpdateMessage() *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.UpdateMessage()
+	})
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *SignatureRequestUpsertOne) ClearMessage() *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.ClearMessage()
+	})
+}
+
+// SetOriginalFileKey sets the "original_file_key" field.
+func (u *SignatureRequestUpsertOne) SetOriginalFileKey(v string) *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.SetOriginalFileKey(v)
+	})
+}
+
+// UpdateOriginalFileKey sets the "original_file_key" field to the value that was provided on create.
+func (u *SignatureRequestUpsertOne) UpdateOriginalFileKey() *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.UpdateOriginalFileKey()
+	})
+}
+
+// SetSignedFileKey sets the "signed_file_key" field.
+func (u *SignatureRequestUpsertOne) SetSignedFileKey(v string) *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.SetSignedFileKey(v)
+	})
+}
+
+// UpdateSignedFileKey sets the "signed_file_key" field to the value that was provided on create.
+func (u *SignatureRequestUpsertOne) UpdateSignedFileKey() *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.UpdateSignedFileKey()
+	})
+}
+
+// ClearSignedFileKey clears the value of the "signed_file_key" field.
+func (u *SignatureRequestUpsertOne) ClearSignedFileKey() *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.ClearSignedFileKey()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *SignatureRequestUpsertOne) SetCompletedAt(v time.Time) *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *SignatureRequestUpsertOne) UpdateCompletedAt() *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *SignatureRequestUpsertOne) ClearCompletedAt() *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *SignatureRequestUpsertOne) SetLastError(v string) *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *SignatureRequestUpsertOne) UpdateLastError() *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *SignatureRequestUpsertOne) ClearLastError() *SignatureRequestUpsertOne {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// Exec executes the query.
+func (u *SignatureRequestUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SignatureRequestCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SignatureRequestUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SignatureRequestUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: SignatureRequestUpsertOne.ID is not supported by MySQL driver. Use SignatureRequestUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SignatureRequestUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SignatureRequestCreateBulk is the builder for creating many SignatureRequest entities in bulk.
+type SignatureRequestCreateBulk struct {
+	config
+	err      error
+	builders []*SignatureRequestCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SignatureRequest entities in the database.
+func (_c *SignatureRequestCreateBulk) Save(ctx context.Context) ([]*SignatureRequest, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SignatureRequest, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SignatureRequestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SignatureRequestCreateBulk) SaveX(ctx context.Context) []*SignatureRequest {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SignatureRequestCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SignatureRequestCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SignatureRequest.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SignatureRequestUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SignatureRequestCreateBulk) OnConflict(opts ...sql.ConflictOption) *SignatureRequestUpsertBulk {
+	_c.conflict = opts
+	return &SignatureRequestUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SignatureRequest.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SignatureRequestCreateBulk) OnConflictColumns(columns ...string) *SignatureRequestUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SignatureRequestUpsertBulk{
+		create: _c,
+	}
+}
+
+// SignatureRequestUpsertBulk is the builder for "upsert"-ing
+// a bulk of SignatureRequest nodes.
+type SignatureRequestUpsertBulk struct {
+	create *SignatureRequestCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SignatureRequest.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(signaturerequest.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SignatureRequestUpsertBulk) UpdateNewValues() *SignatureRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(signaturerequest.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(signaturerequest.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(signaturerequest.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SignatureRequest.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SignatureRequestUpsertBulk) Ignore() *SignatureRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SignatureRequestUpsertBulk) DoNothing() *SignatureRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SignatureRequestCreateBulk.OnConflict
+// documentation for more info.
+func (u *SignatureRequestUpsertBulk) Update(set func(*SignatureRequestUpsert)) *SignatureRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SignatureRequestUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *SignatureRequestUpsertBulk) SetCreateBy(v uint32) *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *SignatureRequestUpsertBulk) AddCreateBy(v uint32) *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *SignatureRequestUpsertBulk) UpdateCreateBy() *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *SignatureRequestUpsertBulk) ClearCreateBy() *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *SignatureRequestUpsertBulk) SetUpdateTime(v time.Time) *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *SignatureRequestUpsertBulk) UpdateUpdateTime() *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *SignatureRequestUpsertBulk) ClearUpdateTime() *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *SignatureRequestUpsertBulk) SetDeleteTime(v time.Time) *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *SignatureRequestUpsertBulk) UpdateDeleteTime() *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *SignatureRequestUpsertBulk) ClearDeleteTime() *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetDocumentID sets the "document_id" field.
+func (u *SignatureRequestUpsertBulk) SetDocumentID(v string) *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.SetDocumentID(v)
+	})
+}
+
+// UpdateDocumentID sets the "document_id" field to the value that was provided on create.
+func (u *SignatureRequestUpsertBulk) UpdateDocumentID() *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.UpdateDocumentID()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *SignatureRequestUpsertBulk) SetProvider(v string) *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *SignatureRequestUpsertBulk) UpdateProvider() *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetEnvelopeID sets the "envelope_id" field.
+func (u *SignatureRequestUpsertBulk) SetEnvelopeID(v string) *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.SetEnvelopeID(v)
+	})
+}
+
+// UpdateEnvelopeID sets the "envelope_id" field to the value that was provided on create.
+func (u *SignatureRequestUpsertBulk) UpdateEnvelopeID() *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.UpdateEnvelopeID()
+	})
+}
+
+// ClearEnvelopeID clears the value of the "envelope_id" field.
+func (u *SignatureRequestUpsertBulk) ClearEnvelopeID() *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.ClearEnvelopeID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *SignatureRequestUpsertBulk) SetStatus(v signaturerequest.Status) *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SignatureRequestUpsertBulk) UpdateStatus() *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetSigners sets the "signers" field.
+func (u *SignatureRequestUpsertBulk) SetSigners(v []schema.SignatureSigner) *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.SetSigners(v)
+	})
+}
+
+// UpdateSigners sets the "signers" field to the value that was provided on create.
+func (u *SignatureRequestUpsertBulk) UpdateSigners() *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.UpdateSigners()
+	})
+}
+
+// SetSubject sets the "subject" field.
+func (u *SignatureRequestUpsertBulk) SetSubject(v string) *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.SetSubject(v)
+	})
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *SignatureRequestUpsertBulk) UpdateSubject() *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.UpdateSubject()
+	})
+}
+
+// SetMessage sets the "message" field.
+func (u *SignatureRequestUpsertBulk) SetMessage(v string) *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.SetMessage(v)
+	})
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *SignatureRequestUpsertBulk) UpdateMessage() *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.UpdateMessage()
+	})
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *SignatureRequestUpsertBulk) ClearMessage() *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.ClearMessage()
+	})
+}
+
+// SetOriginalFileKey sets the "original_file_key" field.
+func (u *SignatureRequestUpsertBulk) SetOriginalFileKey(v string) *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.SetOriginalFileKey(v)
+	})
+}
+
+// UpdateOriginalFileKey sets the "original_file_key" field to the value that was provided on create.
+func (u *SignatureRequestUpsertBulk) UpdateOriginalFileKey() *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.UpdateOriginalFileKey()
+	})
+}
+
+// SetSignedFileKey sets the "signed_file_key" field.
+func (u *SignatureRequestUpsertBulk) SetSignedFileKey(v string) *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.SetSignedFileKey(v)
+	})
+}
+
+// UpdateSignedFileKey sets the "signed_file_key" field to the value that was provided on create.
+func (u *SignatureRequestUpsertBulk) UpdateSignedFileKey() *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.UpdateSignedFileKey()
+	})
+}
+
+// ClearSignedFileKey clears the value of the "signed_file_key" field.
+func (u *SignatureRequestUpsertBulk) ClearSignedFileKey() *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.ClearSignedFileKey()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *SignatureRequestUpsertBulk) SetCompletedAt(v time.Time) *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *SignatureRequestUpsertBulk) UpdateCompletedAt() *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *SignatureRequestUpsertBulk) ClearCompletedAt() *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *SignatureRequestUpsertBulk) SetLastError(v string) *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *SignatureRequestUpsertBulk) UpdateLastError() *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *SignatureRequestUpsertBulk) ClearLastError() *SignatureRequestUpsertBulk {
+	return u.Update(func(s *SignatureRequestUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// Exec executes the query.
+func (u *SignatureRequestUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SignatureRequestCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SignatureRequestCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SignatureRequestUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1019 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/document"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/signaturerequest"
+)
+
+// SignatureRequestUpdate is the builder for updating SignatureRequest entities.
+type SignatureRequestUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SignatureRequestMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SignatureRequestUpdate builder.
+func (_u *SignatureRequestUpdate) Where(ps ...predicate.SignatureRequest) *SignatureRequestUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *SignatureRequestUpdate) SetCreateBy(v uint32) *SignatureRequestUpdate {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *SignatureRequestUpdate) SetNillableCreateBy(v *uint32) *SignatureRequestUpdate {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *SignatureRequestUpdate) AddCreateBy(v int32) *SignatureRequestUpdate {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *SignatureRequestUpdate) ClearCreateBy() *SignatureRequestUpdate {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *SignatureRequestUpdate) SetUpdateTime(v time.Time) *SignatureRequestUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *SignatureRequestUpdate) SetNillableUpdateTime(v *time.Time) *SignatureRequestUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *SignatureRequestUpdate) ClearUpdateTime() *SignatureRequestUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *SignatureRequestUpdate) SetDeleteTime(v time.Time) *SignatureRequestUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *SignatureRequestUpdate) SetNillableDeleteTime(v *time.Time) *SignatureRequestUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *SignatureRequestUpdate) ClearDeleteTime() *SignatureRequestUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetDocumentID sets the "document_id" field.
+func (_u *SignatureRequestUpdate) SetDocumentID(v string) *SignatureRequestUpdate {
+	_u.mutation.SetDocumentID(v)
+	return _u
+}
+
+// SetNillableDocumentID sets the "document_id" field if the given value is not nil.
+func (_u *SignatureRequestUpdate) SetNillableDocumentID(v *string) *SignatureRequestUpdate {
+	if v != nil {
+		_u.SetDocumentID(*v)
+	}
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *SignatureRequestUpdate) SetProvider(v string) *SignatureRequestUpdate {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *SignatureRequestUpdate) SetNillableProvider(v *string) *SignatureRequestUpdate {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetEnvelopeID sets the "envelope_id" field.
+func (_u *SignatureRequestUpdate) SetEnvelopeID(v string) *SignatureRequestUpdate {
+	_u.mutation.SetEnvelopeID(v)
+	return _u
+}
+
+// SetNillableEnvelopeID sets the "envelope_id" field if the given value is not nil.
+func (_u *SignatureRequestUpdate) SetNillableEnvelopeID(v *string) *SignatureRequestUpdate {
+	if v != nil {
+		_u.SetEnvelopeID(*v)
+	}
+	return _u
+}
+
+// ClearEnvelopeID clears the value of the "envelope_id" field.
+func (_u *SignatureRequestUpdate) ClearEnvelopeID() *SignatureRequestUpdate {
+	_u.mutation.ClearEnvelopeID()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *SignatureRequestUpdate) SetStatus(v signaturerequest.Status) *SignatureRequestUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SignatureRequestUpdate) SetNillableStatus(v *signaturerequest.Status) *SignatureRequestUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetSigners sets the "signers" field.
+func (_u *SignatureRequestUpdate) SetSigners(v []schema.SignatureSigner) *SignatureRequestUpdate {
+	_u.mutation.SetSigners(v)
+	return _u
+}
+
+// AppendSigners appends value to the "signers" field.
+func (_u *SignatureRequestUpdate) AppendSigners(v []schema.SignatureSigner) *SignatureRequestUpdate {
+	_u.mutation.AppendSigners(v)
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *SignatureRequestUpdate) SetSubject(v string) *SignatureRequestUpdate {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *SignatureRequestUpdate) SetNillableSubject(v *string) *SignatureRequestUpdate {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *SignatureRequestUpdate) SetMessage(v string) *SignatureRequestUpdate {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *SignatureRequestUpdate) SetNillableMessage(v *string) *SignatureRequestUpdate {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *SignatureRequestUpdate) ClearMessage() *SignatureRequestUpdate {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// SetOriginalFileKey sets the "original_file_key" field.
+func (_u *SignatureRequestUpdate) SetOriginalFileKey(v string) *SignatureRequestUpdate {
+	_u.mutation.SetOriginalFileKey(v)
+	return _u
+}
+
+// SetNillableOriginalFileKey sets the "original_file_key" field if the given value is not nil.
+func (_u *SignatureRequestUpdate) SetNillableOriginalFileKey(v *string) *SignatureRequestUpdate {
+	if v != nil {
+		_u.SetOriginalFileKey(*v)
+	}
+	return _u
+}
+
+// SetSignedFileKey sets the "signed_file_key" field.
+func (_u *SignatureRequestUpdate) SetSignedFileKey(v string) *SignatureRequestUpdate {
+	_u.mutation.SetSignedFileKey(v)
+	return _u
+}
+
+// SetNillableSignedFileKey sets the "signed_file_key" field if the given value is not nil.
+func (_u *SignatureRequestUpdate) SetNillableSignedFileKey(v *string) *SignatureRequestUpdate {
+	if v != nil {
+		_u.SetSignedFileKey(*v)
+	}
+	return _u
+}
+
+// ClearSignedFileKey clears the value of the "signed_file_key" field.
+func (_u *SignatureRequestUpdate) ClearSignedFileKey() *SignatureRequestUpdate {
+	_u.mutation.ClearSignedFileKey()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *SignatureRequestUpdate) SetCompletedAt(v time.Time) *SignatureRequestUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *SignatureRequestUpdate) SetNillableCompletedAt(v *time.Time) *SignatureRequestUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *SignatureRequestUpdate) ClearCompletedAt() *SignatureRequestUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *SignatureRequestUpdate) SetLastError(v string) *SignatureRequestUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *SignatureRequestUpdate) SetNillableLastError(v *string) *SignatureRequestUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *SignatureRequestUpdate) ClearLastError() *SignatureRequestUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetDocument sets the "document" edge to the Document entity.
+func (_u *SignatureRequestUpdate) SetDocument(v *Document) *SignatureRequestUpdate {
+	return _u.SetDocumentID(v.ID)
+}
+
+// Mutation returns the SignatureRequestMutation object of the builder.
+func (_u *SignatureRequestUpdate) Mutation() *SignatureRequestMutation {
+	return _u.mutation
+}
+
+// ClearDocument clears the "document" edge to the Document entity.
+func (_u *SignatureRequestUpdate) ClearDocument() *SignatureRequestUpdate {
+	_u.mutation.ClearDocument()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SignatureRequestUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SignatureRequestUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SignatureRequestUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SignatureRequestUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SignatureRequestUpdate) check() error {
+	if v, ok := _u.mutation.DocumentID(); ok {
+		if err := signaturerequest.DocumentIDValidator(v); err != nil {
+			return &ValidationError{Name: "document_id", err: fmt.Errorf(`ent: validator failed for field "SignatureRequest.document_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := signaturerequest.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "SignatureRequest.provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EnvelopeID(); ok {
+		if err := signaturerequest.EnvelopeIDValidator(v); err != nil {
+			return &ValidationError{Name: "envelope_id", err: fmt.Errorf(`ent: validator failed for field "SignatureRequest.envelope_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := signaturerequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SignatureRequest.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Subject(); ok {
+		if err := signaturerequest.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "SignatureRequest.subject": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OriginalFileKey(); ok {
+		if err := signaturerequest.OriginalFileKeyValidator(v); err != nil {
+			return &ValidationError{Name: "original_file_key", err: fmt.Errorf(`ent: validator failed for field "SignatureRequest.original_file_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastError(); ok {
+		if err := signaturerequest.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`ent: validator failed for field "SignatureRequest.last_error": %w`, err)}
+		}
+	}
+	if _u.mutation.DocumentCleared() && len(_u.mutation.DocumentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SignatureRequest.document"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SignatureRequestUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SignatureRequestUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SignatureRequestUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(signaturerequest.Table, signaturerequest.Columns, sqlgraph.NewFieldSpec(signaturerequest.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(signaturerequest.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(signaturerequest.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(signaturerequest.FieldCreateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(signaturerequest.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(signaturerequest.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(signaturerequest.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(signaturerequest.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(signaturerequest.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(signaturerequest.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(signaturerequest.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EnvelopeID(); ok {
+		_spec.SetField(signaturerequest.FieldEnvelopeID, field.TypeString, value)
+	}
+	if _u.mutation.EnvelopeIDCleared() {
+		_spec.ClearField(signaturerequest.FieldEnvelopeID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(signaturerequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Signers(); ok {
+		_spec.SetField(signaturerequest.FieldSigners, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSigners(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, signaturerequest.FieldSigners, value)
+		})
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(signaturerequest.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(signaturerequest.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(signaturerequest.FieldMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.OriginalFileKey(); ok {
+		_spec.SetField(signaturerequest.FieldOriginalFileKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SignedFileKey(); ok {
+		_spec.SetField(signaturerequest.FieldSignedFileKey, field.TypeString, value)
+	}
+	if _u.mutation.SignedFileKeyCleared() {
+		_spec.ClearField(signaturerequest.FieldSignedFileKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(signaturerequest.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(signaturerequest.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(signaturerequest.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(signaturerequest.FieldLastError, field.TypeString)
+	}
+	if _u.mutation.DocumentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   signaturerequest.DocumentTable,
+			Columns: []string{signaturerequest.DocumentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DocumentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   signaturerequest.DocumentTable,
+			Columns: []string{signaturerequest.DocumentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{signaturerequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SignatureRequestUpdateOne is the builder for updating a single SignatureRequest entity.
+type SignatureRequestUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SignatureRequestMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *SignatureRequestUpdateOne) SetCreateBy(v uint32) *SignatureRequestUpdateOne {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *SignatureRequestUpdateOne) SetNillableCreateBy(v *uint32) *SignatureRequestUpdateOne {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *SignatureRequestUpdateOne) AddCreateBy(v int32) *SignatureRequestUpdateOne {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *SignatureRequestUpdateOne) ClearCreateBy() *SignatureRequestUpdateOne {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *SignatureRequestUpdateOne) SetUpdateTime(v time.Time) *SignatureRequestUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *SignatureRequestUpdateOne) SetNillableUpdateTime(v *time.Time) *SignatureRequestUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *SignatureRequestUpdateOne) ClearUpdateTime() *SignatureRequestUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *SignatureRequestUpdateOne) SetDeleteTime(v time.Time) *SignatureRequestUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *SignatureRequestUpdateOne) SetNillableDeleteTime(v *time.Time) *SignatureRequestUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *SignatureRequestUpdateOne) ClearDeleteTime() *SignatureRequestUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetDocumentID sets the "document_id" field.
+func (_u *SignatureRequestUpdateOne) SetDocumentID(v string) *SignatureRequestUpdateOne {
+	_u.mutation.SetDocumentID(v)
+	return _u
+}
+
+// SetNillableDocumentID sets the "document_id" field if the given value is not nil.
+func (_u *SignatureRequestUpdateOne) SetNillableDocumentID(v *string) *SignatureRequestUpdateOne {
+	if v != nil {
+		_u.SetDocumentID(*v)
+	}
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *SignatureRequestUpdateOne) SetProvider(v string) *SignatureRequestUpdateOne {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *SignatureRequestUpdateOne) SetNillableProvider(v *string) *SignatureRequestUpdateOne {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetEnvelopeID sets the "envelope_id" field.
+func (_u *SignatureRequestUpdateOne) SetEnvelopeID(v string) *SignatureRequestUpdateOne {
+	_u.mutation.SetEnvelopeID(v)
+	return _u
+}
+
+// SetNillableEnvelopeID sets the "envelope_id" field if the given value is not nil.
+func (_u *SignatureRequestUpdateOne) SetNillableEnvelopeID(v *string) *SignatureRequestUpdateOne {
+	if v != nil {
+		_u.SetEnvelopeID(*v)
+	}
+	return _u
+}
+
+// ClearEnvelopeID clears the value of the "envelope_id" field.
+func (_u *SignatureRequestUpdateOne) ClearEnvelopeID() *SignatureRequestUpdateOne {
+	_u.mutation.ClearEnvelopeID()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *SignatureRequestUpdateOne) SetStatus(v signaturerequest.Status) *SignatureRequestUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SignatureRequestUpdateOne) SetNillableStatus(v *signaturerequest.Status) *SignatureRequestUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetSigners sets the "signers" field.
+func (_u *SignatureRequestUpdateOne) SetSigners(v []schema.SignatureSigner) *SignatureRequestUpdateOne {
+	_u.mutation.SetSigners(v)
+	return _u
+}
+
+// AppendSigners appends value to the "signers" field.
+func (_u *SignatureRequestUpdateOne) AppendSigners(v []schema.SignatureSigner) *SignatureRequestUpdateOne {
+	_u.mutation.AppendSigners(v)
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *SignatureRequestUpdateOne) SetSubject(v string) *SignatureRequestUpdateOne {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *SignatureRequestUpdateOne) SetNillableSubject(v *string) *SignatureRequestUpdateOne {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *SignatureRequestUpdateOne) SetMessage(v string) *SignatureRequestUpdateOne {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *SignatureRequestUpdateOne) SetNillableMessage(v *string) *SignatureRequestUpdateOne {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *SignatureRequestUpdateOne) ClearMessage() *SignatureRequestUpdateOne {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// SetOriginalFileKey sets the "original_file_key" field.
+func (_u *SignatureRequestUpdateOne) SetOriginalFileKey(v string) *SignatureRequestUpdateOne {
+	_u.mutation.SetOriginalFileKey(v)
+	return _u
+}
+
+// SetNillableOriginalFileKey sets the "original_file_key" field if the given value is not nil.
+func (_u *SignatureRequestUpdateOne) SetNillableOriginalFileKey(v *string) *SignatureRequestUpdateOne {
+	if v != nil {
+		_u.SetOriginalFileKey(*v)
+	}
+	return _u
+}
+
+// SetSignedFileKey sets the "signed_file_key" field.
+func (_u *SignatureRequestUpdateOne) SetSignedFileKey(v string) *SignatureRequestUpdateOne {
+	_u.mutation.SetSignedFileKey(v)
+	return _u
+}
+
+// SetNillableSignedFileKey sets the "signed_file_key" field if the given value is not nil.
+func (_u *SignatureRequestUpdateOne) SetNillableSignedFileKey(v *string) *SignatureRequestUpdateOne {
+	if v != nil {
+		_u.SetSignedFileKey(*v)
+	}
+	return _u
+}
+
+// ClearSignedFileKey clears the value of the "signed_file_key" field.
+func (_u *SignatureRequestUpdateOne) ClearSignedFileKey() *SignatureRequestUpdateOne {
+	_u.mutation.ClearSignedFileKey()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *SignatureRequestUpdateOne) SetCompletedAt(v time.Time) *SignatureRequestUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *SignatureRequestUpdateOne) SetNillableCompletedAt(v *time.Time) *SignatureRequestUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *SignatureRequestUpdateOne) ClearCompletedAt() *SignatureRequestUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *SignatureRequestUpdateOne) SetLastError(v string) *SignatureRequestUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *SignatureRequestUpdateOne) SetNillableLastError(v *string) *SignatureRequestUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *SignatureRequestUpdateOne) ClearLastError() *SignatureRequestUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetDocument sets the "document" edge to the Document entity.
+func (_u *SignatureRequestUpdateOne) SetDocument(v *Document) *SignatureRequestUpdateOne {
+	return _u.SetDocumentID(v.ID)
+}
+
+// Mutation returns the SignatureRequestMutation object of the builder.
+func (_u *SignatureRequestUpdateOne) Mutation() *SignatureRequestMutation {
+	return _u.mutation
+}
+
+// ClearDocument clears the "document" edge to the Document entity.
+func (_u *SignatureRequestUpdateOne) ClearDocument() *SignatureRequestUpdateOne {
+	_u.mutation.ClearDocument()
+	return _u
+}
+
+// Where appends a list predicates to the SignatureRequestUpdate builder.
+func (_u *SignatureRequestUpdateOne) Where(ps ...predicate.SignatureRequest) *SignatureRequestUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SignatureRequestUpdateOne) Select(field string, fields ...string) *SignatureRequestUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SignatureRequest entity.
+func (_u *SignatureRequestUpdateOne) Save(ctx context.Context) (*SignatureRequest, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SignatureRequestUpdateOne) SaveX(ctx context.Context) *SignatureRequest {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SignatureRequestUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SignatureRequestUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SignatureRequestUpdateOne) check() error {
+	if v, ok := _u.mutation.DocumentID(); ok {
+		if err := signaturerequest.DocumentIDValidator(v); err != nil {
+			return &ValidationError{Name: "document_id", err: fmt.Errorf(`ent: validator failed for field "SignatureRequest.document_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := signaturerequest.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "SignatureRequest.provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EnvelopeID(); ok {
+		if err := signaturerequest.EnvelopeIDValidator(v); err != nil {
+			return &ValidationError{Name: "envelope_id", err: fmt.Errorf(`ent: validator failed for field "SignatureRequest.envelope_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := signaturerequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SignatureRequest.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Subject(); ok {
+		if err := signaturerequest.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "SignatureRequest.subject": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OriginalFileKey(); ok {
+		if err := signaturerequest.OriginalFileKeyValidator(v); err != nil {
+			return &ValidationError{Name: "original_file_key", err: fmt.Errorf(`ent: validator failed for field "SignatureRequest.original_file_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastError(); ok {
+		if err := signaturerequest.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`ent: validator failed for field "SignatureRequest.last_error": %w`, err)}
+		}
+	}
+	if _u.mutation.DocumentCleared() && len(_u.mutation.DocumentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SignatureRequest.document"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SignatureRequestUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SignatureRequestUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SignatureRequestUpdateOne) sqlSave(ctx context.Context) (_node *SignatureRequest, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(signaturerequest.Table, signaturerequest.Columns, sqlgraph.NewFieldSpec(signaturerequest.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SignatureRequest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, signaturerequest.FieldID)
+		for _, f := range fields {
+			if !signaturerequest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != signaturerequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(signaturerequest.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(signaturerequest.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(signaturerequest.FieldCreateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(signaturerequest.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(signaturerequest.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(signaturerequest.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(signaturerequest.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(signaturerequest.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(signaturerequest.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(signaturerequest.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EnvelopeID(); ok {
+		_spec.SetField(signaturerequest.FieldEnvelopeID, field.TypeString, value)
+	}
+	if _u.mutation.EnvelopeIDCleared() {
+		_spec.ClearField(signaturerequest.FieldEnvelopeID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(signaturerequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Signers(); ok {
+		_spec.SetField(signaturerequest.FieldSigners, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSigners(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, signaturerequest.FieldSigners, value)
+		})
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(signaturerequest.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(signaturerequest.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(signaturerequest.FieldMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.OriginalFileKey(); ok {
+		_spec.SetField(signaturerequest.FieldOriginalFileKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SignedFileKey(); ok {
+		_spec.SetField(signaturerequest.FieldSignedFileKey, field.TypeString, value)
+	}
+	if _u.mutation.SignedFileKeyCleared() {
+		_spec.ClearField(signaturerequest.FieldSignedFileKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(signaturerequest.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(signaturerequest.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(signaturerequest.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(signaturerequest.FieldLastError, field.TypeString)
+	}
+	if _u.mutation.DocumentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   signaturerequest.DocumentTable,
+			Columns: []string{signaturerequest.DocumentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DocumentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   signaturerequest.DocumentTable,
+			Columns: []string{signaturerequest.DocumentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &SignatureRequest{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{signaturerequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
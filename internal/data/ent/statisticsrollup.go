@@ -0,0 +1,187 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/statisticsrollup"
+)
+
+// StatisticsRollup is the model entity for the StatisticsRollup schema.
+type StatisticsRollup struct {
+	config `json:"-"`
+	// ID of the ent.
+	// id
+	ID uint32 `json:"id,omitempty"`
+	// 创建时间
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// 更新时间
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// 删除时间
+	DeleteTime *time.Time `json:"delete_time,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// Whether the statistics cover all tenants instead of tenant_id
+	AllTenants bool `json:"all_tenants,omitempty"`
+	// Protobuf-encoded GetStatisticsResponse, with the most top tags a request may ask for
+	Statistics []byte `json:"statistics,omitempty"`
+	// When the statistics were computed
+	GeneratedAt  time.Time `json:"generated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StatisticsRollup) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case statisticsrollup.FieldStatistics:
+			values[i] = new([]byte)
+		case statisticsrollup.FieldAllTenants:
+			values[i] = new(sql.NullBool)
+		case statisticsrollup.FieldID, statisticsrollup.FieldTenantID:
+			values[i] = new(sql.NullInt64)
+		case statisticsrollup.FieldCreateTime, statisticsrollup.FieldUpdateTime, statisticsrollup.FieldDeleteTime, statisticsrollup.FieldGeneratedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StatisticsRollup fields.
+func (_m *StatisticsRollup) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case statisticsrollup.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint32(value.Int64)
+		case statisticsrollup.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = new(time.Time)
+				*_m.CreateTime = value.Time
+			}
+		case statisticsrollup.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = new(time.Time)
+				*_m.UpdateTime = value.Time
+			}
+		case statisticsrollup.FieldDeleteTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_time", values[i])
+			} else if value.Valid {
+				_m.DeleteTime = new(time.Time)
+				*_m.DeleteTime = value.Time
+			}
+		case statisticsrollup.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case statisticsrollup.FieldAllTenants:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field all_tenants", values[i])
+			} else if value.Valid {
+				_m.AllTenants = value.Bool
+			}
+		case statisticsrollup.FieldStatistics:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field statistics", values[i])
+			} else if value != nil {
+				_m.Statistics = *value
+			}
+		case statisticsrollup.FieldGeneratedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field generated_at", values[i])
+			} else if value.Valid {
+				_m.GeneratedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the StatisticsRollup.
+// This includes values selected through modifiers, order, etc.
+func (_m *StatisticsRollup) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this StatisticsRollup.
+// Note that you need to call StatisticsRollup.Unwrap() before calling this method if this StatisticsRollup
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *StatisticsRollup) Update() *StatisticsRollupUpdateOne {
+	return NewStatisticsRollupClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the StatisticsRollup entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *StatisticsRollup) Unwrap() *StatisticsRollup {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StatisticsRollup is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *StatisticsRollup) String() string {
+	var builder strings.Builder
+	builder.WriteString("StatisticsRollup(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeleteTime; v != nil {
+		builder.WriteString("delete_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("all_tenants=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AllTenants))
+	builder.WriteString(", ")
+	builder.WriteString("statistics=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Statistics))
+	builder.WriteString(", ")
+	builder.WriteString("generated_at=")
+	builder.WriteString(_m.GeneratedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StatisticsRollups is a parsable slice of StatisticsRollup.
+type StatisticsRollups []*StatisticsRollup
@@ -0,0 +1,395 @@
+// Code generated by ent, DO NOT EDIT.
+
+package statisticsrollup
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldEQ(FieldTenantID, v))
+}
+
+// AllTenants applies equality check predicate on the "all_tenants" field. It's identical to AllTenantsEQ.
+func AllTenants(v bool) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldEQ(FieldAllTenants, v))
+}
+
+// Statistics applies equality check predicate on the "statistics" field. It's identical to StatisticsEQ.
+func Statistics(v []byte) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldEQ(FieldStatistics, v))
+}
+
+// GeneratedAt applies equality check predicate on the "generated_at" field. It's identical to GeneratedAtEQ.
+func GeneratedAt(v time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldEQ(FieldGeneratedAt, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldNotNull(FieldTenantID))
+}
+
+// AllTenantsEQ applies the EQ predicate on the "all_tenants" field.
+func AllTenantsEQ(v bool) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldEQ(FieldAllTenants, v))
+}
+
+// AllTenantsNEQ applies the NEQ predicate on the "all_tenants" field.
+func AllTenantsNEQ(v bool) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldNEQ(FieldAllTenants, v))
+}
+
+// StatisticsEQ applies the EQ predicate on the "statistics" field.
+func StatisticsEQ(v []byte) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldEQ(FieldStatistics, v))
+}
+
+// StatisticsNEQ applies the NEQ predicate on the "statistics" field.
+func StatisticsNEQ(v []byte) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldNEQ(FieldStatistics, v))
+}
+
+// StatisticsIn applies the In predicate on the "statistics" field.
+func StatisticsIn(vs ...[]byte) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldIn(FieldStatistics, vs...))
+}
+
+// StatisticsNotIn applies the NotIn predicate on the "statistics" field.
+func StatisticsNotIn(vs ...[]byte) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldNotIn(FieldStatistics, vs...))
+}
+
+// StatisticsGT applies the GT predicate on the "statistics" field.
+func StatisticsGT(v []byte) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldGT(FieldStatistics, v))
+}
+
+// StatisticsGTE applies the GTE predicate on the "statistics" field.
+func StatisticsGTE(v []byte) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldGTE(FieldStatistics, v))
+}
+
+// StatisticsLT applies the LT predicate on the "statistics" field.
+func StatisticsLT(v []byte) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldLT(FieldStatistics, v))
+}
+
+// StatisticsLTE applies the LTE predicate on the "statistics" field.
+func StatisticsLTE(v []byte) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldLTE(FieldStatistics, v))
+}
+
+// GeneratedAtEQ applies the EQ predicate on the "generated_at" field.
+func GeneratedAtEQ(v time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldEQ(FieldGeneratedAt, v))
+}
+
+// GeneratedAtNEQ applies the NEQ predicate on the "generated_at" field.
+func GeneratedAtNEQ(v time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldNEQ(FieldGeneratedAt, v))
+}
+
+// GeneratedAtIn applies the In predicate on the "generated_at" field.
+func GeneratedAtIn(vs ...time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldIn(FieldGeneratedAt, vs...))
+}
+
+// GeneratedAtNotIn applies the NotIn predicate on the "generated_at" field.
+func GeneratedAtNotIn(vs ...time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldNotIn(FieldGeneratedAt, vs...))
+}
+
+// GeneratedAtGT applies the GT predicate on the "generated_at" field.
+func GeneratedAtGT(v time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldGT(FieldGeneratedAt, v))
+}
+
+// GeneratedAtGTE applies the GTE predicate on the "generated_at" field.
+func GeneratedAtGTE(v time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldGTE(FieldGeneratedAt, v))
+}
+
+// GeneratedAtLT applies the LT predicate on the "generated_at" field.
+func GeneratedAtLT(v time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldLT(FieldGeneratedAt, v))
+}
+
+// GeneratedAtLTE applies the LTE predicate on the "generated_at" field.
+func GeneratedAtLTE(v time.Time) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.FieldLTE(FieldGeneratedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.StatisticsRollup) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.StatisticsRollup) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.StatisticsRollup) predicate.StatisticsRollup {
+	return predicate.StatisticsRollup(sql.NotPredicates(p))
+}
@@ -0,0 +1,825 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/statisticsrollup"
+)
+
+// StatisticsRollupCreate is the builder for creating a StatisticsRollup entity.
+type StatisticsRollupCreate struct {
+	config
+	mutation *StatisticsRollupMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *StatisticsRollupCreate) SetCreateTime(v time.Time) *StatisticsRollupCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *StatisticsRollupCreate) SetNillableCreateTime(v *time.Time) *StatisticsRollupCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *StatisticsRollupCreate) SetUpdateTime(v time.Time) *StatisticsRollupCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *StatisticsRollupCreate) SetNillableUpdateTime(v *time.Time) *StatisticsRollupCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *StatisticsRollupCreate) SetDeleteTime(v time.Time) *StatisticsRollupCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *StatisticsRollupCreate) SetNillableDeleteTime(v *time.Time) *StatisticsRollupCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *StatisticsRollupCreate) SetTenantID(v uint32) *StatisticsRollupCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *StatisticsRollupCreate) SetNillableTenantID(v *uint32) *StatisticsRollupCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetAllTenants sets the "all_tenants" field.
+func (_c *StatisticsRollupCreate) SetAllTenants(v bool) *StatisticsRollupCreate {
+	_c.mutation.SetAllTenants(v)
+	return _c
+}
+
+// SetNillableAllTenants sets the "all_tenants" field if the given value is not nil.
+func (_c *StatisticsRollupCreate) SetNillableAllTenants(v *bool) *StatisticsRollupCreate {
+	if v != nil {
+		_c.SetAllTenants(*v)
+	}
+	return _c
+}
+
+// SetStatistics sets the "statistics" field.
+func (_c *StatisticsRollupCreate) SetStatistics(v []byte) *StatisticsRollupCreate {
+	_c.mutation.SetStatistics(v)
+	return _c
+}
+
+// SetGeneratedAt sets the "generated_at" field.
+func (_c *StatisticsRollupCreate) SetGeneratedAt(v time.Time) *StatisticsRollupCreate {
+	_c.mutation.SetGeneratedAt(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *StatisticsRollupCreate) SetID(v uint32) *StatisticsRollupCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the StatisticsRollupMutation object of the builder.
+func (_c *StatisticsRollupCreate) Mutation() *StatisticsRollupMutation {
+	return _c.mutation
+}
+
+// Save creates the StatisticsRollup in the database.
+func (_c *StatisticsRollupCreate) Save(ctx context.Context) (*StatisticsRollup, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *StatisticsRollupCreate) SaveX(ctx context.Context) *StatisticsRollup {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StatisticsRollupCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StatisticsRollupCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *StatisticsRollupCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := statisticsrollup.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.AllTenants(); !ok {
+		v := statisticsrollup.DefaultAllTenants
+		_c.mutation.SetAllTenants(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *StatisticsRollupCreate) check() error {
+	if _, ok := _c.mutation.AllTenants(); !ok {
+		return &ValidationError{Name: "all_tenants", err: errors.New(`ent: missing required field "StatisticsRollup.all_tenants"`)}
+	}
+	if _, ok := _c.mutation.Statistics(); !ok {
+		return &ValidationError{Name: "statistics", err: errors.New(`ent: missing required field "StatisticsRollup.statistics"`)}
+	}
+	if _, ok := _c.mutation.GeneratedAt(); !ok {
+		return &ValidationError{Name: "generated_at", err: errors.New(`ent: missing required field "StatisticsRollup.generated_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := statisticsrollup.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "StatisticsRollup.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *StatisticsRollupCreate) sqlSave(ctx context.Context) (*StatisticsRollup, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *StatisticsRollupCreate) createSpec() (*StatisticsRollup, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StatisticsRollup{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(statisticsrollup.Table, sqlgraph.NewFieldSpec(statisticsrollup.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(statisticsrollup.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(statisticsrollup.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(statisticsrollup.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(statisticsrollup.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.AllTenants(); ok {
+		_spec.SetField(statisticsrollup.FieldAllTenants, field.TypeBool, value)
+		_node.AllTenants = value
+	}
+	if value, ok := _c.mutation.Statistics(); ok {
+		_spec.SetField(statisticsrollup.FieldStatistics, field.TypeBytes, value)
+		_node.Statistics = value
+	}
+	if value, ok := _c.mutation.GeneratedAt(); ok {
+		_spec.SetField(statisticsrollup.FieldGeneratedAt, field.TypeTime, value)
+		_node.GeneratedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.StatisticsRollup.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.StatisticsRollupUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *StatisticsRollupCreate) OnConflict(opts ...sql.ConflictOption) *StatisticsRollupUpsertOne {
+	_c.conflict = opts
+	return &StatisticsRollupUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.StatisticsRollup.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *StatisticsRollupCreate) OnConflictColumns(columns ...string) *StatisticsRollupUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &StatisticsRollupUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// StatisticsRollupUpsertOne is the builder for "upsert"-ing
+	//  one StatisticsRollup node.
+	StatisticsRollupUpsertOne struct {
+		create *StatisticsRollupCreate
+	}
+
+	// StatisticsRollupUpsert is the "OnConflict" setter.
+	StatisticsRollupUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *StatisticsRollupUpsert) SetUpdateTime(v time.Time) *StatisticsRollupUpsert {
+	u.Set(statisticsrollup.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *StatisticsRollupUpsert) UpdateUpdateTime() *StatisticsRollupUpsert {
+	u.SetExcluded(statisticsrollup.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *StatisticsRollupUpsert) ClearUpdateTime() *StatisticsRollupUpsert {
+	u.SetNull(statisticsrollup.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *StatisticsRollupUpsert) SetDeleteTime(v time.Time) *StatisticsRollupUpsert {
+	u.Set(statisticsrollup.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *StatisticsRollupUpsert) UpdateDeleteTime() *StatisticsRollupUpsert {
+	u.SetExcluded(statisticsrollup.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *StatisticsRollupUpsert) ClearDeleteTime() *StatisticsRollupUpsert {
+	u.SetNull(statisticsrollup.FieldDeleteTime)
+	return u
+}
+
+// SetAllTenants sets the "all_tenants" field.
+func (u *StatisticsRollupUpsert) SetAllTenants(v bool) *StatisticsRollupUpsert {
+	u.Set(statisticsrollup.FieldAllTenants, v)
+	return u
+}
+
+// UpdateAllTenants sets the "all_tenants" field to the value that was provided on create.
+func (u *StatisticsRollupUpsert) UpdateAllTenants() *StatisticsRollupUpsert {
+	u.SetExcluded(statisticsrollup.FieldAllTenants)
+	return u
+}
+
+// SetStatistics sets the "statistics" field.
+func (u *StatisticsRollupUpsert) SetStatistics(v []byte) *StatisticsRollupUpsert {
+	u.Set(statisticsrollup.FieldStatistics, v)
+	return u
+}
+
+// UpdateStatistics sets the "statistics" field to the value that was provided on create.
+func (u *StatisticsRollupUpsert) UpdateStatistics() *StatisticsRollupUpsert {
+	u.SetExcluded(statisticsrollup.FieldStatistics)
+	return u
+}
+
+// SetGeneratedAt sets the "generated_at" field.
+func (u *StatisticsRollupUpsert) SetGeneratedAt(v time.Time) *StatisticsRollupUpsert {
+	u.Set(statisticsrollup.FieldGeneratedAt, v)
+	return u
+}
+
+// UpdateGeneratedAt sets the "generated_at" field to the value that was provided on create.
+func (u *StatisticsRollupUpsert) UpdateGeneratedAt() *StatisticsRollupUpsert {
+	u.SetExcluded(statisticsrollup.FieldGeneratedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.StatisticsRollup.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(statisticsrollup.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *StatisticsRollupUpsertOne) UpdateNewValues() *StatisticsRollupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(statisticsrollup.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(statisticsrollup.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(statisticsrollup.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.StatisticsRollup.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *StatisticsRollupUpsertOne) Ignore() *StatisticsRollupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *StatisticsRollupUpsertOne) DoNothing() *StatisticsRollupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the StatisticsRollupCreate.OnConflict
+// documentation for more info.
+func (u *StatisticsRollupUpsertOne) Update(set func(*StatisticsRollupUpsert)) *StatisticsRollupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&StatisticsRollupUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *StatisticsRollupUpsertOne) SetUpdateTime(v time.Time) *StatisticsRollupUpsertOne {
+	return u.Update(func(s *StatisticsRollupUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *StatisticsRollupUpsertOne) UpdateUpdateTime() *StatisticsRollupUpsertOne {
+	return u.Update(func(s *StatisticsRollupUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *StatisticsRollupUpsertOne) ClearUpdateTime() *StatisticsRollupUpsertOne {
+	return u.Update(func(s *StatisticsRollupUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *StatisticsRollupUpsertOne) SetDeleteTime(v time.Time) *StatisticsRollupUpsertOne {
+	return u.Update(func(s *StatisticsRollupUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *StatisticsRollupUpsertOne) UpdateDeleteTime() *StatisticsRollupUpsertOne {
+	return u.Update(func(s *StatisticsRollupUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *StatisticsRollupUpsertOne) ClearDeleteTime() *StatisticsRollupUpsertOne {
+	return u.Update(func(s *StatisticsRollupUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetAllTenants sets the "all_tenants" field.
+func (u *StatisticsRollupUpsertOne) SetAllTenants(v bool) *StatisticsRollupUpsertOne {
+	return u.Update(func(s *StatisticsRollupUpsert) {
+		s.SetAllTenants(v)
+	})
+}
+
+// UpdateAllTenants sets the "all_tenants" field to the value that was provided on create.
+func (u *StatisticsRollupUpsertOne) UpdateAllTenants() *StatisticsRollupUpsertOne {
+	return u.Update(func(s *StatisticsRollupUpsert) {
+		s.UpdateAllTenants()
+	})
+}
+
+// SetStatistics sets the "statistics" field.
+func (u *StatisticsRollupUpsertOne) SetStatistics(v []byte) *StatisticsRollupUpsertOne {
+	return u.Update(func(s *StatisticsRollupUpsert) {
+		s.SetStatistics(v)
+	})
+}
+
+// UpdateStatistics sets the "statistics" field to the value that was provided on create.
+func (u *StatisticsRollupUpsertOne) UpdateStatistics() *StatisticsRollupUpsertOne {
+	return u.Update(func(s *StatisticsRollupUpsert) {
+		s.UpdateStatistics()
+	})
+}
+
+// SetGeneratedAt sets the "generated_at" field.
+func (u *StatisticsRollupUpsertOne) SetGeneratedAt(v time.Time) *StatisticsRollupUpsertOne {
+	return u.Update(func(s *StatisticsRollupUpsert) {
+		s.SetGeneratedAt(v)
+	})
+}
+
+// UpdateGeneratedAt sets the "generated_at" field to the value that was provided on create.
+func (u *StatisticsRollupUpsertOne) UpdateGeneratedAt() *StatisticsRollupUpsertOne {
+	return u.Update(func(s *StatisticsRollupUpsert) {
+		s.UpdateGeneratedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *StatisticsRollupUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for StatisticsRollupCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *StatisticsRollupUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *StatisticsRollupUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *StatisticsRollupUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// StatisticsRollupCreateBulk is the builder for creating many StatisticsRollup entities in bulk.
+type StatisticsRollupCreateBulk struct {
+	config
+	err      error
+	builders []*StatisticsRollupCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the StatisticsRollup entities in the database.
+func (_c *StatisticsRollupCreateBulk) Save(ctx context.Context) ([]*StatisticsRollup, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*StatisticsRollup, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StatisticsRollupMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *StatisticsRollupCreateBulk) SaveX(ctx context.Context) []*StatisticsRollup {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StatisticsRollupCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StatisticsRollupCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.StatisticsRollup.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.StatisticsRollupUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *StatisticsRollupCreateBulk) OnConflict(opts ...sql.ConflictOption) *StatisticsRollupUpsertBulk {
+	_c.conflict = opts
+	return &StatisticsRollupUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.StatisticsRollup.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *StatisticsRollupCreateBulk) OnConflictColumns(columns ...string) *StatisticsRollupUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &StatisticsRollupUpsertBulk{
+		create: _c,
+	}
+}
+
+// StatisticsRollupUpsertBulk is the builder for "upsert"-ing
+// a bulk of StatisticsRollup nodes.
+type StatisticsRollupUpsertBulk struct {
+	create *StatisticsRollupCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.StatisticsRollup.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(statisticsrollup.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *StatisticsRollupUpsertBulk) UpdateNewValues() *StatisticsRollupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(statisticsrollup.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(statisticsrollup.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(statisticsrollup.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.StatisticsRollup.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *StatisticsRollupUpsertBulk) Ignore() *StatisticsRollupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *StatisticsRollupUpsertBulk) DoNothing() *StatisticsRollupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the StatisticsRollupCreateBulk.OnConflict
+// documentation for more info.
+func (u *StatisticsRollupUpsertBulk) Update(set func(*StatisticsRollupUpsert)) *StatisticsRollupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&StatisticsRollupUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *StatisticsRollupUpsertBulk) SetUpdateTime(v time.Time) *StatisticsRollupUpsertBulk {
+	return u.Update(func(s *StatisticsRollupUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *StatisticsRollupUpsertBulk) UpdateUpdateTime() *StatisticsRollupUpsertBulk {
+	return u.Update(func(s *StatisticsRollupUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *StatisticsRollupUpsertBulk) ClearUpdateTime() *StatisticsRollupUpsertBulk {
+	return u.Update(func(s *StatisticsRollupUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *StatisticsRollupUpsertBulk) SetDeleteTime(v time.Time) *StatisticsRollupUpsertBulk {
+	return u.Update(func(s *StatisticsRollupUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *StatisticsRollupUpsertBulk) UpdateDeleteTime() *StatisticsRollupUpsertBulk {
+	return u.Update(func(s *StatisticsRollupUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *StatisticsRollupUpsertBulk) ClearDeleteTime() *StatisticsRollupUpsertBulk {
+	return u.Update(func(s *StatisticsRollupUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetAllTenants sets the "all_tenants" field.
+func (u *StatisticsRollupUpsertBulk) SetAllTenants(v bool) *StatisticsRollupUpsertBulk {
+	return u.Update(func(s *StatisticsRollupUpsert) {
+		s.SetAllTenants(v)
+	})
+}
+
+// UpdateAllTenants sets the "all_tenants" field to the value that was provided on create.
+func (u *StatisticsRollupUpsertBulk) UpdateAllTenants() *StatisticsRollupUpsertBulk {
+	return u.Update(func(s *StatisticsRollupUpsert) {
+		s.UpdateAllTenants()
+	})
+}
+
+// SetStatistics sets the "statistics" field.
+func (u *StatisticsRollupUpsertBulk) SetStatistics(v []byte) *StatisticsRollupUpsertBulk {
+	return u.Update(func(s *StatisticsRollupUpsert) {
+		s.SetStatistics(v)
+	})
+}
+
+// UpdateStatistics sets the "statistics" field to the value that was provided on create.
+func (u *StatisticsRollupUpsertBulk) UpdateStatistics() *StatisticsRollupUpsertBulk {
+	return u.Update(func(s *StatisticsRollupUpsert) {
+		s.UpdateStatistics()
+	})
+}
+
+// SetGeneratedAt sets the "generated_at" field.
+func (u *StatisticsRollupUpsertBulk) SetGeneratedAt(v time.Time) *StatisticsRollupUpsertBulk {
+	return u.Update(func(s *StatisticsRollupUpsert) {
+		s.SetGeneratedAt(v)
+	})
+}
+
+// UpdateGeneratedAt sets the "generated_at" field to the value that was provided on create.
+func (u *StatisticsRollupUpsertBulk) UpdateGeneratedAt() *StatisticsRollupUpsertBulk {
+	return u.Update(func(s *StatisticsRollupUpsert) {
+		s.UpdateGeneratedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *StatisticsRollupUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the StatisticsRollupCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for StatisticsRollupCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *StatisticsRollupUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
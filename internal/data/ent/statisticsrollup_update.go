@@ -0,0 +1,394 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/statisticsrollup"
+)
+
+// StatisticsRollupUpdate is the builder for updating StatisticsRollup entities.
+type StatisticsRollupUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *StatisticsRollupMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the StatisticsRollupUpdate builder.
+func (_u *StatisticsRollupUpdate) Where(ps ...predicate.StatisticsRollup) *StatisticsRollupUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *StatisticsRollupUpdate) SetUpdateTime(v time.Time) *StatisticsRollupUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *StatisticsRollupUpdate) SetNillableUpdateTime(v *time.Time) *StatisticsRollupUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *StatisticsRollupUpdate) ClearUpdateTime() *StatisticsRollupUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *StatisticsRollupUpdate) SetDeleteTime(v time.Time) *StatisticsRollupUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *StatisticsRollupUpdate) SetNillableDeleteTime(v *time.Time) *StatisticsRollupUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *StatisticsRollupUpdate) ClearDeleteTime() *StatisticsRollupUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetAllTenants sets the "all_tenants" field.
+func (_u *StatisticsRollupUpdate) SetAllTenants(v bool) *StatisticsRollupUpdate {
+	_u.mutation.SetAllTenants(v)
+	return _u
+}
+
+// SetNillableAllTenants sets the "all_tenants" field if the given value is not nil.
+func (_u *StatisticsRollupUpdate) SetNillableAllTenants(v *bool) *StatisticsRollupUpdate {
+	if v != nil {
+		_u.SetAllTenants(*v)
+	}
+	return _u
+}
+
+// SetStatistics sets the "statistics" field.
+func (_u *StatisticsRollupUpdate) SetStatistics(v []byte) *StatisticsRollupUpdate {
+	_u.mutation.SetStatistics(v)
+	return _u
+}
+
+// SetGeneratedAt sets the "generated_at" field.
+func (_u *StatisticsRollupUpdate) SetGeneratedAt(v time.Time) *StatisticsRollupUpdate {
+	_u.mutation.SetGeneratedAt(v)
+	return _u
+}
+
+// SetNillableGeneratedAt sets the "generated_at" field if the given value is not nil.
+func (_u *StatisticsRollupUpdate) SetNillableGeneratedAt(v *time.Time) *StatisticsRollupUpdate {
+	if v != nil {
+		_u.SetGeneratedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the StatisticsRollupMutation object of the builder.
+func (_u *StatisticsRollupUpdate) Mutation() *StatisticsRollupMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *StatisticsRollupUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StatisticsRollupUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *StatisticsRollupUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StatisticsRollupUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *StatisticsRollupUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *StatisticsRollupUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *StatisticsRollupUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(statisticsrollup.Table, statisticsrollup.Columns, sqlgraph.NewFieldSpec(statisticsrollup.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(statisticsrollup.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(statisticsrollup.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(statisticsrollup.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(statisticsrollup.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(statisticsrollup.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(statisticsrollup.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.AllTenants(); ok {
+		_spec.SetField(statisticsrollup.FieldAllTenants, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Statistics(); ok {
+		_spec.SetField(statisticsrollup.FieldStatistics, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.GeneratedAt(); ok {
+		_spec.SetField(statisticsrollup.FieldGeneratedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{statisticsrollup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// StatisticsRollupUpdateOne is the builder for updating a single StatisticsRollup entity.
+type StatisticsRollupUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *StatisticsRollupMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *StatisticsRollupUpdateOne) SetUpdateTime(v time.Time) *StatisticsRollupUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *StatisticsRollupUpdateOne) SetNillableUpdateTime(v *time.Time) *StatisticsRollupUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *StatisticsRollupUpdateOne) ClearUpdateTime() *StatisticsRollupUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *StatisticsRollupUpdateOne) SetDeleteTime(v time.Time) *StatisticsRollupUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *StatisticsRollupUpdateOne) SetNillableDeleteTime(v *time.Time) *StatisticsRollupUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *StatisticsRollupUpdateOne) ClearDeleteTime() *StatisticsRollupUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetAllTenants sets the "all_tenants" field.
+func (_u *StatisticsRollupUpdateOne) SetAllTenants(v bool) *StatisticsRollupUpdateOne {
+	_u.mutation.SetAllTenants(v)
+	return _u
+}
+
+// SetNillableAllTenants sets the "all_tenants" field if the given value is not nil.
+func (_u *StatisticsRollupUpdateOne) SetNillableAllTenants(v *bool) *StatisticsRollupUpdateOne {
+	if v != nil {
+		_u.SetAllTenants(*v)
+	}
+	return _u
+}
+
+// SetStatistics sets the "statistics" field.
+func (_u *StatisticsRollupUpdateOne) SetStatistics(v []byte) *StatisticsRollupUpdateOne {
+	_u.mutation.SetStatistics(v)
+	return _u
+}
+
+// SetGeneratedAt sets the "generated_at" field.
+func (_u *StatisticsRollupUpdateOne) SetGeneratedAt(v time.Time) *StatisticsRollupUpdateOne {
+	_u.mutation.SetGeneratedAt(v)
+	return _u
+}
+
+// SetNillableGeneratedAt sets the "generated_at" field if the given value is not nil.
+func (_u *StatisticsRollupUpdateOne) SetNillableGeneratedAt(v *time.Time) *StatisticsRollupUpdateOne {
+	if v != nil {
+		_u.SetGeneratedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the StatisticsRollupMutation object of the builder.
+func (_u *StatisticsRollupUpdateOne) Mutation() *StatisticsRollupMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the StatisticsRollupUpdate builder.
+func (_u *StatisticsRollupUpdateOne) Where(ps ...predicate.StatisticsRollup) *StatisticsRollupUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *StatisticsRollupUpdateOne) Select(field string, fields ...string) *StatisticsRollupUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated StatisticsRollup entity.
+func (_u *StatisticsRollupUpdateOne) Save(ctx context.Context) (*StatisticsRollup, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StatisticsRollupUpdateOne) SaveX(ctx context.Context) *StatisticsRollup {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *StatisticsRollupUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StatisticsRollupUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *StatisticsRollupUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *StatisticsRollupUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *StatisticsRollupUpdateOne) sqlSave(ctx context.Context) (_node *StatisticsRollup, err error) {
+	_spec := sqlgraph.NewUpdateSpec(statisticsrollup.Table, statisticsrollup.Columns, sqlgraph.NewFieldSpec(statisticsrollup.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "StatisticsRollup.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, statisticsrollup.FieldID)
+		for _, f := range fields {
+			if !statisticsrollup.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != statisticsrollup.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(statisticsrollup.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(statisticsrollup.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(statisticsrollup.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(statisticsrollup.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(statisticsrollup.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(statisticsrollup.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.AllTenants(); ok {
+		_spec.SetField(statisticsrollup.FieldAllTenants, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Statistics(); ok {
+		_spec.SetField(statisticsrollup.FieldStatistics, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.GeneratedAt(); ok {
+		_spec.SetField(statisticsrollup.FieldGeneratedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &StatisticsRollup{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{statisticsrollup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,312 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/tenantdeletejob"
+)
+
+// TenantDeleteJob is the model entity for the TenantDeleteJob schema.
+type TenantDeleteJob struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUID primary key
+	ID string `json:"id,omitempty"`
+	// 创建者ID
+	CreateBy *uint32 `json:"create_by,omitempty"`
+	// 创建时间
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// 更新时间
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// 删除时间
+	DeleteTime *time.Time `json:"delete_time,omitempty"`
+	// Tenant whose data is deleted
+	TenantID uint32 `json:"tenant_id,omitempty"`
+	// Pending until a worker picks the job up, running until it is done
+	Status tenantdeletejob.Status `json:"status,omitempty"`
+	// Kind of data the job is deleting
+	Stage string `json:"stage,omitempty"`
+	// Records to delete, counted when the job was queued
+	TotalItems int64 `json:"total_items,omitempty"`
+	// Records deleted so far
+	ProcessedItems int64 `json:"processed_items,omitempty"`
+	// Documents deleted so far, trashed ones included
+	DocumentsDeleted int64 `json:"documents_deleted,omitempty"`
+	// Categories deleted so far
+	CategoriesDeleted int64 `json:"categories_deleted,omitempty"`
+	// Permission grants deleted so far
+	PermissionsDeleted int64 `json:"permissions_deleted,omitempty"`
+	// Other records, such as tags, history and audit entries, deleted so far
+	RecordsDeleted int64 `json:"records_deleted,omitempty"`
+	// Storage objects deleted so far
+	ObjectsDeleted int64 `json:"objects_deleted,omitempty"`
+	// Size of the storage objects deleted so far
+	BytesDeleted int64 `json:"bytes_deleted,omitempty"`
+	// Why the job failed
+	Error string `json:"error,omitempty"`
+	// Until when the running worker holds the job; another worker resumes it afterwards
+	LeaseUntil *time.Time `json:"lease_until,omitempty"`
+	// When the job succeeded or failed
+	FinishedAt   *time.Time `json:"finished_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TenantDeleteJob) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tenantdeletejob.FieldCreateBy, tenantdeletejob.FieldTenantID, tenantdeletejob.FieldTotalItems, tenantdeletejob.FieldProcessedItems, tenantdeletejob.FieldDocumentsDeleted, tenantdeletejob.FieldCategoriesDeleted, tenantdeletejob.FieldPermissionsDeleted, tenantdeletejob.FieldRecordsDeleted, tenantdeletejob.FieldObjectsDeleted, tenantdeletejob.FieldBytesDeleted:
+			values[i] = new(sql.NullInt64)
+		case tenantdeletejob.FieldID, tenantdeletejob.FieldStatus, tenantdeletejob.FieldStage, tenantdeletejob.FieldError:
+			values[i] = new(sql.NullString)
+		case tenantdeletejob.FieldCreateTime, tenantdeletejob.FieldUpdateTime, tenantdeletejob.FieldDeleteTime, tenantdeletejob.FieldLeaseUntil, tenantdeletejob.FieldFinishedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TenantDeleteJob fields.
+func (_m *TenantDeleteJob) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tenantdeletejob.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case tenantdeletejob.FieldCreateBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field create_by", values[i])
+			} else if value.Valid {
+				_m.CreateBy = new(uint32)
+				*_m.CreateBy = uint32(value.Int64)
+			}
+		case tenantdeletejob.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = new(time.Time)
+				*_m.CreateTime = value.Time
+			}
+		case tenantdeletejob.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = new(time.Time)
+				*_m.UpdateTime = value.Time
+			}
+		case tenantdeletejob.FieldDeleteTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_time", values[i])
+			} else if value.Valid {
+				_m.DeleteTime = new(time.Time)
+				*_m.DeleteTime = value.Time
+			}
+		case tenantdeletejob.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = uint32(value.Int64)
+			}
+		case tenantdeletejob.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = tenantdeletejob.Status(value.String)
+			}
+		case tenantdeletejob.FieldStage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field stage", values[i])
+			} else if value.Valid {
+				_m.Stage = value.String
+			}
+		case tenantdeletejob.FieldTotalItems:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_items", values[i])
+			} else if value.Valid {
+				_m.TotalItems = value.Int64
+			}
+		case tenantdeletejob.FieldProcessedItems:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field processed_items", values[i])
+			} else if value.Valid {
+				_m.ProcessedItems = value.Int64
+			}
+		case tenantdeletejob.FieldDocumentsDeleted:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field documents_deleted", values[i])
+			} else if value.Valid {
+				_m.DocumentsDeleted = value.Int64
+			}
+		case tenantdeletejob.FieldCategoriesDeleted:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field categories_deleted", values[i])
+			} else if value.Valid {
+				_m.CategoriesDeleted = value.Int64
+			}
+		case tenantdeletejob.FieldPermissionsDeleted:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field permissions_deleted", values[i])
+			} else if value.Valid {
+				_m.PermissionsDeleted = value.Int64
+			}
+		case tenantdeletejob.FieldRecordsDeleted:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field records_deleted", values[i])
+			} else if value.Valid {
+				_m.RecordsDeleted = value.Int64
+			}
+		case tenantdeletejob.FieldObjectsDeleted:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field objects_deleted", values[i])
+			} else if value.Valid {
+				_m.ObjectsDeleted = value.Int64
+			}
+		case tenantdeletejob.FieldBytesDeleted:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field bytes_deleted", values[i])
+			} else if value.Valid {
+				_m.BytesDeleted = value.Int64
+			}
+		case tenantdeletejob.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				_m.Error = value.String
+			}
+		case tenantdeletejob.FieldLeaseUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field lease_until", values[i])
+			} else if value.Valid {
+				_m.LeaseUntil = new(time.Time)
+				*_m.LeaseUntil = value.Time
+			}
+		case tenantdeletejob.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				_m.FinishedAt = new(time.Time)
+				*_m.FinishedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TenantDeleteJob.
+// This includes values selected through modifiers, order, etc.
+func (_m *TenantDeleteJob) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TenantDeleteJob.
+// Note that you need to call TenantDeleteJob.Unwrap() before calling this method if this TenantDeleteJob
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TenantDeleteJob) Update() *TenantDeleteJobUpdateOne {
+	return NewTenantDeleteJobClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TenantDeleteJob entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TenantDeleteJob) Unwrap() *TenantDeleteJob {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TenantDeleteJob is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TenantDeleteJob) String() string {
+	var builder strings.Builder
+	builder.WriteString("TenantDeleteJob(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreateBy; v != nil {
+		builder.WriteString("create_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeleteTime; v != nil {
+		builder.WriteString("delete_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("stage=")
+	builder.WriteString(_m.Stage)
+	builder.WriteString(", ")
+	builder.WriteString("total_items=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalItems))
+	builder.WriteString(", ")
+	builder.WriteString("processed_items=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProcessedItems))
+	builder.WriteString(", ")
+	builder.WriteString("documents_deleted=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DocumentsDeleted))
+	builder.WriteString(", ")
+	builder.WriteString("categories_deleted=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CategoriesDeleted))
+	builder.WriteString(", ")
+	builder.WriteString("permissions_deleted=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PermissionsDeleted))
+	builder.WriteString(", ")
+	builder.WriteString("records_deleted=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RecordsDeleted))
+	builder.WriteString(", ")
+	builder.WriteString("objects_deleted=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ObjectsDeleted))
+	builder.WriteString(", ")
+	builder.WriteString("bytes_deleted=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BytesDeleted))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(_m.Error)
+	builder.WriteString(", ")
+	if v := _m.LeaseUntil; v != nil {
+		builder.WriteString("lease_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.FinishedAt; v != nil {
+		builder.WriteString("finished_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TenantDeleteJobs is a parsable slice of TenantDeleteJob.
+type TenantDeleteJobs []*TenantDeleteJob
@@ -0,0 +1,238 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tenantdeletejob
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the tenantdeletejob type in the database.
+	Label = "tenant_delete_job"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateBy holds the string denoting the create_by field in the database.
+	FieldCreateBy = "create_by"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldDeleteTime holds the string denoting the delete_time field in the database.
+	FieldDeleteTime = "delete_time"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldStage holds the string denoting the stage field in the database.
+	FieldStage = "stage"
+	// FieldTotalItems holds the string denoting the total_items field in the database.
+	FieldTotalItems = "total_items"
+	// FieldProcessedItems holds the string denoting the processed_items field in the database.
+	FieldProcessedItems = "processed_items"
+	// FieldDocumentsDeleted holds the string denoting the documents_deleted field in the database.
+	FieldDocumentsDeleted = "documents_deleted"
+	// FieldCategoriesDeleted holds the string denoting the categories_deleted field in the database.
+	FieldCategoriesDeleted = "categories_deleted"
+	// FieldPermissionsDeleted holds the string denoting the permissions_deleted field in the database.
+	FieldPermissionsDeleted = "permissions_deleted"
+	// FieldRecordsDeleted holds the string denoting the records_deleted field in the database.
+	FieldRecordsDeleted = "records_deleted"
+	// FieldObjectsDeleted holds the string denoting the objects_deleted field in the database.
+	FieldObjectsDeleted = "objects_deleted"
+	// FieldBytesDeleted holds the string denoting the bytes_deleted field in the database.
+	FieldBytesDeleted = "bytes_deleted"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldLeaseUntil holds the string denoting the lease_until field in the database.
+	FieldLeaseUntil = "lease_until"
+	// FieldFinishedAt holds the string denoting the finished_at field in the database.
+	FieldFinishedAt = "finished_at"
+	// Table holds the table name of the tenantdeletejob in the database.
+	Table = "paperless_tenant_delete_jobs"
+)
+
+// Columns holds all SQL columns for tenantdeletejob fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateBy,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldDeleteTime,
+	FieldTenantID,
+	FieldStatus,
+	FieldStage,
+	FieldTotalItems,
+	FieldProcessedItems,
+	FieldDocumentsDeleted,
+	FieldCategoriesDeleted,
+	FieldPermissionsDeleted,
+	FieldRecordsDeleted,
+	FieldObjectsDeleted,
+	FieldBytesDeleted,
+	FieldError,
+	FieldLeaseUntil,
+	FieldFinishedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// StageValidator is a validator for the "stage" field. It is called by the builders before save.
+	StageValidator func(string) error
+	// DefaultTotalItems holds the default value on creation for the "total_items" field.
+	DefaultTotalItems int64
+	// DefaultProcessedItems holds the default value on creation for the "processed_items" field.
+	DefaultProcessedItems int64
+	// DefaultDocumentsDeleted holds the default value on creation for the "documents_deleted" field.
+	DefaultDocumentsDeleted int64
+	// DefaultCategoriesDeleted holds the default value on creation for the "categories_deleted" field.
+	DefaultCategoriesDeleted int64
+	// DefaultPermissionsDeleted holds the default value on creation for the "permissions_deleted" field.
+	DefaultPermissionsDeleted int64
+	// DefaultRecordsDeleted holds the default value on creation for the "records_deleted" field.
+	DefaultRecordsDeleted int64
+	// DefaultObjectsDeleted holds the default value on creation for the "objects_deleted" field.
+	DefaultObjectsDeleted int64
+	// DefaultBytesDeleted holds the default value on creation for the "bytes_deleted" field.
+	DefaultBytesDeleted int64
+	// ErrorValidator is a validator for the "error" field. It is called by the builders before save.
+	ErrorValidator func(string) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusTENANT_DELETE_JOB_STATUS_PENDING is the default value of the Status enum.
+const DefaultStatus = StatusTENANT_DELETE_JOB_STATUS_PENDING
+
+// Status values.
+const (
+	StatusTENANT_DELETE_JOB_STATUS_PENDING   Status = "TENANT_DELETE_JOB_STATUS_PENDING"
+	StatusTENANT_DELETE_JOB_STATUS_RUNNING   Status = "TENANT_DELETE_JOB_STATUS_RUNNING"
+	StatusTENANT_DELETE_JOB_STATUS_SUCCEEDED Status = "TENANT_DELETE_JOB_STATUS_SUCCEEDED"
+	StatusTENANT_DELETE_JOB_STATUS_FAILED    Status = "TENANT_DELETE_JOB_STATUS_FAILED"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusTENANT_DELETE_JOB_STATUS_PENDING, StatusTENANT_DELETE_JOB_STATUS_RUNNING, StatusTENANT_DELETE_JOB_STATUS_SUCCEEDED, StatusTENANT_DELETE_JOB_STATUS_FAILED:
+		return nil
+	default:
+		return fmt.Errorf("tenantdeletejob: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the TenantDeleteJob queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateBy orders the results by the create_by field.
+func ByCreateBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateBy, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByDeleteTime orders the results by the delete_time field.
+func ByDeleteTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeleteTime, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByStage orders the results by the stage field.
+func ByStage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStage, opts...).ToFunc()
+}
+
+// ByTotalItems orders the results by the total_items field.
+func ByTotalItems(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalItems, opts...).ToFunc()
+}
+
+// ByProcessedItems orders the results by the processed_items field.
+func ByProcessedItems(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProcessedItems, opts...).ToFunc()
+}
+
+// ByDocumentsDeleted orders the results by the documents_deleted field.
+func ByDocumentsDeleted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDocumentsDeleted, opts...).ToFunc()
+}
+
+// ByCategoriesDeleted orders the results by the categories_deleted field.
+func ByCategoriesDeleted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategoriesDeleted, opts...).ToFunc()
+}
+
+// ByPermissionsDeleted orders the results by the permissions_deleted field.
+func ByPermissionsDeleted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPermissionsDeleted, opts...).ToFunc()
+}
+
+// ByRecordsDeleted orders the results by the records_deleted field.
+func ByRecordsDeleted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecordsDeleted, opts...).ToFunc()
+}
+
+// ByObjectsDeleted orders the results by the objects_deleted field.
+func ByObjectsDeleted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldObjectsDeleted, opts...).ToFunc()
+}
+
+// ByBytesDeleted orders the results by the bytes_deleted field.
+func ByBytesDeleted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBytesDeleted, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByLeaseUntil orders the results by the lease_until field.
+func ByLeaseUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLeaseUntil, opts...).ToFunc()
+}
+
+// ByFinishedAt orders the results by the finished_at field.
+func ByFinishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishedAt, opts...).ToFunc()
+}
@@ -0,0 +1,995 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tenantdeletejob
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreateBy applies equality check predicate on the "create_by" field. It's identical to CreateByEQ.
+func CreateBy(v uint32) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Stage applies equality check predicate on the "stage" field. It's identical to StageEQ.
+func Stage(v string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldStage, v))
+}
+
+// TotalItems applies equality check predicate on the "total_items" field. It's identical to TotalItemsEQ.
+func TotalItems(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldTotalItems, v))
+}
+
+// ProcessedItems applies equality check predicate on the "processed_items" field. It's identical to ProcessedItemsEQ.
+func ProcessedItems(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldProcessedItems, v))
+}
+
+// DocumentsDeleted applies equality check predicate on the "documents_deleted" field. It's identical to DocumentsDeletedEQ.
+func DocumentsDeleted(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldDocumentsDeleted, v))
+}
+
+// CategoriesDeleted applies equality check predicate on the "categories_deleted" field. It's identical to CategoriesDeletedEQ.
+func CategoriesDeleted(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldCategoriesDeleted, v))
+}
+
+// PermissionsDeleted applies equality check predicate on the "permissions_deleted" field. It's identical to PermissionsDeletedEQ.
+func PermissionsDeleted(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldPermissionsDeleted, v))
+}
+
+// RecordsDeleted applies equality check predicate on the "records_deleted" field. It's identical to RecordsDeletedEQ.
+func RecordsDeleted(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldRecordsDeleted, v))
+}
+
+// ObjectsDeleted applies equality check predicate on the "objects_deleted" field. It's identical to ObjectsDeletedEQ.
+func ObjectsDeleted(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldObjectsDeleted, v))
+}
+
+// BytesDeleted applies equality check predicate on the "bytes_deleted" field. It's identical to BytesDeletedEQ.
+func BytesDeleted(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldBytesDeleted, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldError, v))
+}
+
+// LeaseUntil applies equality check predicate on the "lease_until" field. It's identical to LeaseUntilEQ.
+func LeaseUntil(v time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldLeaseUntil, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// CreateByEQ applies the EQ predicate on the "create_by" field.
+func CreateByEQ(v uint32) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// CreateByNEQ applies the NEQ predicate on the "create_by" field.
+func CreateByNEQ(v uint32) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNEQ(FieldCreateBy, v))
+}
+
+// CreateByIn applies the In predicate on the "create_by" field.
+func CreateByIn(vs ...uint32) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldIn(FieldCreateBy, vs...))
+}
+
+// CreateByNotIn applies the NotIn predicate on the "create_by" field.
+func CreateByNotIn(vs ...uint32) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNotIn(FieldCreateBy, vs...))
+}
+
+// CreateByGT applies the GT predicate on the "create_by" field.
+func CreateByGT(v uint32) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGT(FieldCreateBy, v))
+}
+
+// CreateByGTE applies the GTE predicate on the "create_by" field.
+func CreateByGTE(v uint32) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGTE(FieldCreateBy, v))
+}
+
+// CreateByLT applies the LT predicate on the "create_by" field.
+func CreateByLT(v uint32) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLT(FieldCreateBy, v))
+}
+
+// CreateByLTE applies the LTE predicate on the "create_by" field.
+func CreateByLTE(v uint32) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLTE(FieldCreateBy, v))
+}
+
+// CreateByIsNil applies the IsNil predicate on the "create_by" field.
+func CreateByIsNil() predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldIsNull(FieldCreateBy))
+}
+
+// CreateByNotNil applies the NotNil predicate on the "create_by" field.
+func CreateByNotNil() predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNotNull(FieldCreateBy))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLTE(FieldTenantID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StageEQ applies the EQ predicate on the "stage" field.
+func StageEQ(v string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldStage, v))
+}
+
+// StageNEQ applies the NEQ predicate on the "stage" field.
+func StageNEQ(v string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNEQ(FieldStage, v))
+}
+
+// StageIn applies the In predicate on the "stage" field.
+func StageIn(vs ...string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldIn(FieldStage, vs...))
+}
+
+// StageNotIn applies the NotIn predicate on the "stage" field.
+func StageNotIn(vs ...string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNotIn(FieldStage, vs...))
+}
+
+// StageGT applies the GT predicate on the "stage" field.
+func StageGT(v string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGT(FieldStage, v))
+}
+
+// StageGTE applies the GTE predicate on the "stage" field.
+func StageGTE(v string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGTE(FieldStage, v))
+}
+
+// StageLT applies the LT predicate on the "stage" field.
+func StageLT(v string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLT(FieldStage, v))
+}
+
+// StageLTE applies the LTE predicate on the "stage" field.
+func StageLTE(v string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLTE(FieldStage, v))
+}
+
+// StageContains applies the Contains predicate on the "stage" field.
+func StageContains(v string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldContains(FieldStage, v))
+}
+
+// StageHasPrefix applies the HasPrefix predicate on the "stage" field.
+func StageHasPrefix(v string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldHasPrefix(FieldStage, v))
+}
+
+// StageHasSuffix applies the HasSuffix predicate on the "stage" field.
+func StageHasSuffix(v string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldHasSuffix(FieldStage, v))
+}
+
+// StageIsNil applies the IsNil predicate on the "stage" field.
+func StageIsNil() predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldIsNull(FieldStage))
+}
+
+// StageNotNil applies the NotNil predicate on the "stage" field.
+func StageNotNil() predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNotNull(FieldStage))
+}
+
+// StageEqualFold applies the EqualFold predicate on the "stage" field.
+func StageEqualFold(v string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEqualFold(FieldStage, v))
+}
+
+// StageContainsFold applies the ContainsFold predicate on the "stage" field.
+func StageContainsFold(v string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldContainsFold(FieldStage, v))
+}
+
+// TotalItemsEQ applies the EQ predicate on the "total_items" field.
+func TotalItemsEQ(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldTotalItems, v))
+}
+
+// TotalItemsNEQ applies the NEQ predicate on the "total_items" field.
+func TotalItemsNEQ(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNEQ(FieldTotalItems, v))
+}
+
+// TotalItemsIn applies the In predicate on the "total_items" field.
+func TotalItemsIn(vs ...int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldIn(FieldTotalItems, vs...))
+}
+
+// TotalItemsNotIn applies the NotIn predicate on the "total_items" field.
+func TotalItemsNotIn(vs ...int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNotIn(FieldTotalItems, vs...))
+}
+
+// TotalItemsGT applies the GT predicate on the "total_items" field.
+func TotalItemsGT(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGT(FieldTotalItems, v))
+}
+
+// TotalItemsGTE applies the GTE predicate on the "total_items" field.
+func TotalItemsGTE(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGTE(FieldTotalItems, v))
+}
+
+// TotalItemsLT applies the LT predicate on the "total_items" field.
+func TotalItemsLT(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLT(FieldTotalItems, v))
+}
+
+// TotalItemsLTE applies the LTE predicate on the "total_items" field.
+func TotalItemsLTE(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLTE(FieldTotalItems, v))
+}
+
+// ProcessedItemsEQ applies the EQ predicate on the "processed_items" field.
+func ProcessedItemsEQ(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldProcessedItems, v))
+}
+
+// ProcessedItemsNEQ applies the NEQ predicate on the "processed_items" field.
+func ProcessedItemsNEQ(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNEQ(FieldProcessedItems, v))
+}
+
+// ProcessedItemsIn applies the In predicate on the "processed_items" field.
+func ProcessedItemsIn(vs ...int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldIn(FieldProcessedItems, vs...))
+}
+
+// ProcessedItemsNotIn applies the NotIn predicate on the "processed_items" field.
+func ProcessedItemsNotIn(vs ...int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNotIn(FieldProcessedItems, vs...))
+}
+
+// ProcessedItemsGT applies the GT predicate on the "processed_items" field.
+func ProcessedItemsGT(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGT(FieldProcessedItems, v))
+}
+
+// ProcessedItemsGTE applies the GTE predicate on the "processed_items" field.
+func ProcessedItemsGTE(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGTE(FieldProcessedItems, v))
+}
+
+// ProcessedItemsLT applies the LT predicate on the "processed_items" field.
+func ProcessedItemsLT(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLT(FieldProcessedItems, v))
+}
+
+// ProcessedItemsLTE applies the LTE predicate on the "processed_items" field.
+func ProcessedItemsLTE(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLTE(FieldProcessedItems, v))
+}
+
+// DocumentsDeletedEQ applies the EQ predicate on the "documents_deleted" field.
+func DocumentsDeletedEQ(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldDocumentsDeleted, v))
+}
+
+// DocumentsDeletedNEQ applies the NEQ predicate on the "documents_deleted" field.
+func DocumentsDeletedNEQ(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNEQ(FieldDocumentsDeleted, v))
+}
+
+// DocumentsDeletedIn applies the In predicate on the "documents_deleted" field.
+func DocumentsDeletedIn(vs ...int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldIn(FieldDocumentsDeleted, vs...))
+}
+
+// DocumentsDeletedNotIn applies the NotIn predicate on the "documents_deleted" field.
+func DocumentsDeletedNotIn(vs ...int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNotIn(FieldDocumentsDeleted, vs...))
+}
+
+// DocumentsDeletedGT applies the GT predicate on the "documents_deleted" field.
+func DocumentsDeletedGT(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGT(FieldDocumentsDeleted, v))
+}
+
+// DocumentsDeletedGTE applies the GTE predicate on the "documents_deleted" field.
+func DocumentsDeletedGTE(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGTE(FieldDocumentsDeleted, v))
+}
+
+// DocumentsDeletedLT applies the LT predicate on the "documents_deleted" field.
+func DocumentsDeletedLT(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLT(FieldDocumentsDeleted, v))
+}
+
+// DocumentsDeletedLTE applies the LTE predicate on the "documents_deleted" field.
+func DocumentsDeletedLTE(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLTE(FieldDocumentsDeleted, v))
+}
+
+// CategoriesDeletedEQ applies the EQ predicate on the "categories_deleted" field.
+func CategoriesDeletedEQ(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldCategoriesDeleted, v))
+}
+
+// CategoriesDeletedNEQ applies the NEQ predicate on the "categories_deleted" field.
+func CategoriesDeletedNEQ(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNEQ(FieldCategoriesDeleted, v))
+}
+
+// CategoriesDeletedIn applies the In predicate on the "categories_deleted" field.
+func CategoriesDeletedIn(vs ...int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldIn(FieldCategoriesDeleted, vs...))
+}
+
+// CategoriesDeletedNotIn applies the NotIn predicate on the "categories_deleted" field.
+func CategoriesDeletedNotIn(vs ...int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNotIn(FieldCategoriesDeleted, vs...))
+}
+
+// CategoriesDeletedGT applies the GT predicate on the "categories_deleted" field.
+func CategoriesDeletedGT(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGT(FieldCategoriesDeleted, v))
+}
+
+// CategoriesDeletedGTE applies the GTE predicate on the "categories_deleted" field.
+func CategoriesDeletedGTE(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGTE(FieldCategoriesDeleted, v))
+}
+
+// CategoriesDeletedLT applies the LT predicate on the "categories_deleted" field.
+func CategoriesDeletedLT(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLT(FieldCategoriesDeleted, v))
+}
+
+// CategoriesDeletedLTE applies the LTE predicate on the "categories_deleted" field.
+func CategoriesDeletedLTE(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLTE(FieldCategoriesDeleted, v))
+}
+
+// PermissionsDeletedEQ applies the EQ predicate on the "permissions_deleted" field.
+func PermissionsDeletedEQ(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldPermissionsDeleted, v))
+}
+
+// PermissionsDeletedNEQ applies the NEQ predicate on the "permissions_deleted" field.
+func PermissionsDeletedNEQ(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNEQ(FieldPermissionsDeleted, v))
+}
+
+// PermissionsDeletedIn applies the In predicate on the "permissions_deleted" field.
+func PermissionsDeletedIn(vs ...int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldIn(FieldPermissionsDeleted, vs...))
+}
+
+// PermissionsDeletedNotIn applies the NotIn predicate on the "permissions_deleted" field.
+func PermissionsDeletedNotIn(vs ...int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNotIn(FieldPermissionsDeleted, vs...))
+}
+
+// PermissionsDeletedGT applies the GT predicate on the "permissions_deleted" field.
+func PermissionsDeletedGT(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGT(FieldPermissionsDeleted, v))
+}
+
+// PermissionsDeletedGTE applies the GTE predicate on the "permissions_deleted" field.
+func PermissionsDeletedGTE(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGTE(FieldPermissionsDeleted, v))
+}
+
+// PermissionsDeletedLT applies the LT predicate on the "permissions_deleted" field.
+func PermissionsDeletedLT(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLT(FieldPermissionsDeleted, v))
+}
+
+// PermissionsDeletedLTE applies the LTE predicate on the "permissions_deleted" field.
+func PermissionsDeletedLTE(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLTE(FieldPermissionsDeleted, v))
+}
+
+// RecordsDeletedEQ applies the EQ predicate on the "records_deleted" field.
+func RecordsDeletedEQ(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldRecordsDeleted, v))
+}
+
+// RecordsDeletedNEQ applies the NEQ predicate on the "records_deleted" field.
+func RecordsDeletedNEQ(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNEQ(FieldRecordsDeleted, v))
+}
+
+// RecordsDeletedIn applies the In predicate on the "records_deleted" field.
+func RecordsDeletedIn(vs ...int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldIn(FieldRecordsDeleted, vs...))
+}
+
+// RecordsDeletedNotIn applies the NotIn predicate on the "records_deleted" field.
+func RecordsDeletedNotIn(vs ...int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNotIn(FieldRecordsDeleted, vs...))
+}
+
+// RecordsDeletedGT applies the GT predicate on the "records_deleted" field.
+func RecordsDeletedGT(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGT(FieldRecordsDeleted, v))
+}
+
+// RecordsDeletedGTE applies the GTE predicate on the "records_deleted" field.
+func RecordsDeletedGTE(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGTE(FieldRecordsDeleted, v))
+}
+
+// RecordsDeletedLT applies the LT predicate on the "records_deleted" field.
+func RecordsDeletedLT(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLT(FieldRecordsDeleted, v))
+}
+
+// RecordsDeletedLTE applies the LTE predicate on the "records_deleted" field.
+func RecordsDeletedLTE(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLTE(FieldRecordsDeleted, v))
+}
+
+// ObjectsDeletedEQ applies the EQ predicate on the "objects_deleted" field.
+func ObjectsDeletedEQ(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldObjectsDeleted, v))
+}
+
+// ObjectsDeletedNEQ applies the NEQ predicate on the "objects_deleted" field.
+func ObjectsDeletedNEQ(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNEQ(FieldObjectsDeleted, v))
+}
+
+// ObjectsDeletedIn applies the In predicate on the "objects_deleted" field.
+func ObjectsDeletedIn(vs ...int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldIn(FieldObjectsDeleted, vs...))
+}
+
+// ObjectsDeletedNotIn applies the NotIn predicate on the "objects_deleted" field.
+func ObjectsDeletedNotIn(vs ...int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNotIn(FieldObjectsDeleted, vs...))
+}
+
+// ObjectsDeletedGT applies the GT predicate on the "objects_deleted" field.
+func ObjectsDeletedGT(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGT(FieldObjectsDeleted, v))
+}
+
+// ObjectsDeletedGTE applies the GTE predicate on the "objects_deleted" field.
+func ObjectsDeletedGTE(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGTE(FieldObjectsDeleted, v))
+}
+
+// ObjectsDeletedLT applies the LT predicate on the "objects_deleted" field.
+func ObjectsDeletedLT(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLT(FieldObjectsDeleted, v))
+}
+
+// ObjectsDeletedLTE applies the LTE predicate on the "objects_deleted" field.
+func ObjectsDeletedLTE(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLTE(FieldObjectsDeleted, v))
+}
+
+// BytesDeletedEQ applies the EQ predicate on the "bytes_deleted" field.
+func BytesDeletedEQ(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldBytesDeleted, v))
+}
+
+// BytesDeletedNEQ applies the NEQ predicate on the "bytes_deleted" field.
+func BytesDeletedNEQ(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNEQ(FieldBytesDeleted, v))
+}
+
+// BytesDeletedIn applies the In predicate on the "bytes_deleted" field.
+func BytesDeletedIn(vs ...int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldIn(FieldBytesDeleted, vs...))
+}
+
+// BytesDeletedNotIn applies the NotIn predicate on the "bytes_deleted" field.
+func BytesDeletedNotIn(vs ...int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNotIn(FieldBytesDeleted, vs...))
+}
+
+// BytesDeletedGT applies the GT predicate on the "bytes_deleted" field.
+func BytesDeletedGT(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGT(FieldBytesDeleted, v))
+}
+
+// BytesDeletedGTE applies the GTE predicate on the "bytes_deleted" field.
+func BytesDeletedGTE(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGTE(FieldBytesDeleted, v))
+}
+
+// BytesDeletedLT applies the LT predicate on the "bytes_deleted" field.
+func BytesDeletedLT(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLT(FieldBytesDeleted, v))
+}
+
+// BytesDeletedLTE applies the LTE predicate on the "bytes_deleted" field.
+func BytesDeletedLTE(v int64) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLTE(FieldBytesDeleted, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldContainsFold(FieldError, v))
+}
+
+// LeaseUntilEQ applies the EQ predicate on the "lease_until" field.
+func LeaseUntilEQ(v time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldLeaseUntil, v))
+}
+
+// LeaseUntilNEQ applies the NEQ predicate on the "lease_until" field.
+func LeaseUntilNEQ(v time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNEQ(FieldLeaseUntil, v))
+}
+
+// LeaseUntilIn applies the In predicate on the "lease_until" field.
+func LeaseUntilIn(vs ...time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldIn(FieldLeaseUntil, vs...))
+}
+
+// LeaseUntilNotIn applies the NotIn predicate on the "lease_until" field.
+func LeaseUntilNotIn(vs ...time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNotIn(FieldLeaseUntil, vs...))
+}
+
+// LeaseUntilGT applies the GT predicate on the "lease_until" field.
+func LeaseUntilGT(v time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGT(FieldLeaseUntil, v))
+}
+
+// LeaseUntilGTE applies the GTE predicate on the "lease_until" field.
+func LeaseUntilGTE(v time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGTE(FieldLeaseUntil, v))
+}
+
+// LeaseUntilLT applies the LT predicate on the "lease_until" field.
+func LeaseUntilLT(v time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLT(FieldLeaseUntil, v))
+}
+
+// LeaseUntilLTE applies the LTE predicate on the "lease_until" field.
+func LeaseUntilLTE(v time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLTE(FieldLeaseUntil, v))
+}
+
+// LeaseUntilIsNil applies the IsNil predicate on the "lease_until" field.
+func LeaseUntilIsNil() predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldIsNull(FieldLeaseUntil))
+}
+
+// LeaseUntilNotNil applies the NotNil predicate on the "lease_until" field.
+func LeaseUntilNotNil() predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNotNull(FieldLeaseUntil))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TenantDeleteJob) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TenantDeleteJob) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TenantDeleteJob) predicate.TenantDeleteJob {
+	return predicate.TenantDeleteJob(sql.NotPredicates(p))
+}
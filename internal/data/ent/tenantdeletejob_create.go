@@ -0,0 +1,1871 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/tenantdeletejob"
+)
+
+// TenantDeleteJobCreate is the builder for creating a TenantDeleteJob entity.
+type TenantDeleteJobCreate struct {
+	config
+	mutation *TenantDeleteJobMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_c *TenantDeleteJobCreate) SetCreateBy(v uint32) *TenantDeleteJobCreate {
+	_c.mutation.SetCreateBy(v)
+	return _c
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_c *TenantDeleteJobCreate) SetNillableCreateBy(v *uint32) *TenantDeleteJobCreate {
+	if v != nil {
+		_c.SetCreateBy(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *TenantDeleteJobCreate) SetCreateTime(v time.Time) *TenantDeleteJobCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *TenantDeleteJobCreate) SetNillableCreateTime(v *time.Time) *TenantDeleteJobCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *TenantDeleteJobCreate) SetUpdateTime(v time.Time) *TenantDeleteJobCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *TenantDeleteJobCreate) SetNillableUpdateTime(v *time.Time) *TenantDeleteJobCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *TenantDeleteJobCreate) SetDeleteTime(v time.Time) *TenantDeleteJobCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *TenantDeleteJobCreate) SetNillableDeleteTime(v *time.Time) *TenantDeleteJobCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *TenantDeleteJobCreate) SetTenantID(v uint32) *TenantDeleteJobCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *TenantDeleteJobCreate) SetStatus(v tenantdeletejob.Status) *TenantDeleteJobCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *TenantDeleteJobCreate) SetNillableStatus(v *tenantdeletejob.Status) *TenantDeleteJobCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetStage sets the "stage" field.
+func (_c *TenantDeleteJobCreate) SetStage(v string) *TenantDeleteJobCreate {
+	_c.mutation.SetStage(v)
+	return _c
+}
+
+// SetNillableStage sets the "stage" field if the given value is not nil.
+func (_c *TenantDeleteJobCreate) SetNillableStage(v *string) *TenantDeleteJobCreate {
+	if v != nil {
+		_c.SetStage(*v)
+	}
+	return _c
+}
+
+// SetTotalItems sets the "total_items" field.
+func (_c *TenantDeleteJobCreate) SetTotalItems(v int64) *TenantDeleteJobCreate {
+	_c.mutation.SetTotalItems(v)
+	return _c
+}
+
+// SetNillableTotalItems sets the "total_items" field if the given value is not nil.
+func (_c *TenantDeleteJobCreate) SetNillableTotalItems(v *int64) *TenantDeleteJobCreate {
+	if v != nil {
+		_c.SetTotalItems(*v)
+	}
+	return _c
+}
+
+// SetProcessedItems sets the "processed_items" field.
+func (_c *TenantDeleteJobCreate) SetProcessedItems(v int64) *TenantDeleteJobCreate {
+	_c.mutation.SetProcessedItems(v)
+	return _c
+}
+
+// SetNillableProcessedItems sets the "processed_items" field if the given value is not nil.
+func (_c *TenantDeleteJobCreate) SetNillableProcessedItems(v *int64) *TenantDeleteJobCreate {
+	if v != nil {
+		_c.SetProcessedItems(*v)
+	}
+	return _c
+}
+
+// SetDocumentsDeleted sets the "documents_deleted" field.
+func (_c *TenantDeleteJobCreate) SetDocumentsDeleted(v int64) *TenantDeleteJobCreate {
+	_c.mutation.SetDocumentsDeleted(v)
+	return _c
+}
+
+// SetNillableDocumentsDeleted sets the "documents_deleted" field if the given value is not nil.
+func (_c *TenantDeleteJobCreate) SetNillableDocumentsDeleted(v *int64) *TenantDeleteJobCreate {
+	if v != nil {
+		_c.SetDocumentsDeleted(*v)
+	}
+	return _c
+}
+
+// SetCategoriesDeleted sets the "categories_deleted" field.
+func (_c *TenantDeleteJobCreate) SetCategoriesDeleted(v int64) *TenantDeleteJobCreate {
+	_c.mutation.SetCategoriesDeleted(v)
+	return _c
+}
+
+// SetNillableCategoriesDeleted sets the "categories_deleted" field if the given value is not nil.
+func (_c *TenantDeleteJobCreate) SetNillableCategoriesDeleted(v *int64) *TenantDeleteJobCreate {
+	if v != nil {
+		_c.SetCategoriesDeleted(*v)
+	}
+	return _c
+}
+
+// SetPermissionsDeleted sets the "permissions_deleted" field.
+func (_c *TenantDeleteJobCreate) SetPermissionsDeleted(v int64) *TenantDeleteJobCreate {
+	_c.mutation.SetPermissionsDeleted(v)
+	return _c
+}
+
+// SetNillablePermissionsDeleted sets the "permissions_deleted" field if the given value is not nil.
+func (_c *TenantDeleteJobCreate) SetNillablePermissionsDeleted(v *int64) *TenantDeleteJobCreate {
+	if v != nil {
+		_c.SetPermissionsDeleted(*v)
+	}
+	return _c
+}
+
+// SetRecordsDeleted sets the "records_deleted" field.
+func (_c *TenantDeleteJobCreate) SetRecordsDeleted(v int64) *TenantDeleteJobCreate {
+	_c.mutation.SetRecordsDeleted(v)
+	return _c
+}
+
+// SetNillableRecordsDeleted sets the "records_deleted" field if the given value is not nil.
+func (_c *TenantDeleteJobCreate) SetNillableRecordsDeleted(v *int64) *TenantDeleteJobCreate {
+	if v != nil {
+		_c.SetRecordsDeleted(*v)
+	}
+	return _c
+}
+
+// SetObjectsDeleted sets the "objects_deleted" field.
+func (_c *TenantDeleteJobCreate) SetObjectsDeleted(v int64) *TenantDeleteJobCreate {
+	_c.mutation.SetObjectsDeleted(v)
+	return _c
+}
+
+// SetNillableObjectsDeleted sets the "objects_deleted" field if the given value is not nil.
+func (_c *TenantDeleteJobCreate) SetNillableObjectsDeleted(v *int64) *TenantDeleteJobCreate {
+	if v != nil {
+		_c.SetObjectsDeleted(*v)
+	}
+	return _c
+}
+
+// SetBytesDeleted sets the "bytes_deleted" field.
+func (_c *TenantDeleteJobCreate) SetBytesDeleted(v int64) *TenantDeleteJobCreate {
+	_c.mutation.SetBytesDeleted(v)
+	return _c
+}
+
+// SetNillableBytesDeleted sets the "bytes_deleted" field if the given value is not nil.
+func (_c *TenantDeleteJobCreate) SetNillableBytesDeleted(v *int64) *TenantDeleteJobCreate {
+	if v != nil {
+		_c.SetBytesDeleted(*v)
+	}
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *TenantDeleteJobCreate) SetError(v string) *TenantDeleteJobCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *TenantDeleteJobCreate) SetNillableError(v *string) *TenantDeleteJobCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetLeaseUntil sets the "lease_until" field.
+func (_c *TenantDeleteJobCreate) SetLeaseUntil(v time.Time) *TenantDeleteJobCreate {
+	_c.mutation.SetLeaseUntil(v)
+	return _c
+}
+
+// SetNillableLeaseUntil sets the "lease_until" field if the given value is not nil.
+func (_c *TenantDeleteJobCreate) SetNillableLeaseUntil(v *time.Time) *TenantDeleteJobCreate {
+	if v != nil {
+		_c.SetLeaseUntil(*v)
+	}
+	return _c
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_c *TenantDeleteJobCreate) SetFinishedAt(v time.Time) *TenantDeleteJobCreate {
+	_c.mutation.SetFinishedAt(v)
+	return _c
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_c *TenantDeleteJobCreate) SetNillableFinishedAt(v *time.Time) *TenantDeleteJobCreate {
+	if v != nil {
+		_c.SetFinishedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TenantDeleteJobCreate) SetID(v string) *TenantDeleteJobCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the TenantDeleteJobMutation object of the builder.
+func (_c *TenantDeleteJobCreate) Mutation() *TenantDeleteJobMutation {
+	return _c.mutation
+}
+
+// Save creates the TenantDeleteJob in the database.
+func (_c *TenantDeleteJobCreate) Save(ctx context.Context) (*TenantDeleteJob, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TenantDeleteJobCreate) SaveX(ctx context.Context) *TenantDeleteJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TenantDeleteJobCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TenantDeleteJobCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TenantDeleteJobCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := tenantdeletejob.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.TotalItems(); !ok {
+		v := tenantdeletejob.DefaultTotalItems
+		_c.mutation.SetTotalItems(v)
+	}
+	if _, ok := _c.mutation.ProcessedItems(); !ok {
+		v := tenantdeletejob.DefaultProcessedItems
+		_c.mutation.SetProcessedItems(v)
+	}
+	if _, ok := _c.mutation.DocumentsDeleted(); !ok {
+		v := tenantdeletejob.DefaultDocumentsDeleted
+		_c.mutation.SetDocumentsDeleted(v)
+	}
+	if _, ok := _c.mutation.CategoriesDeleted(); !ok {
+		v := tenantdeletejob.DefaultCategoriesDeleted
+		_c.mutation.SetCategoriesDeleted(v)
+	}
+	if _, ok := _c.mutation.PermissionsDeleted(); !ok {
+		v := tenantdeletejob.DefaultPermissionsDeleted
+		_c.mutation.SetPermissionsDeleted(v)
+	}
+	if _, ok := _c.mutation.RecordsDeleted(); !ok {
+		v := tenantdeletejob.DefaultRecordsDeleted
+		_c.mutation.SetRecordsDeleted(v)
+	}
+	if _, ok := _c.mutation.ObjectsDeleted(); !ok {
+		v := tenantdeletejob.DefaultObjectsDeleted
+		_c.mutation.SetObjectsDeleted(v)
+	}
+	if _, ok := _c.mutation.BytesDeleted(); !ok {
+		v := tenantdeletejob.DefaultBytesDeleted
+		_c.mutation.SetBytesDeleted(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TenantDeleteJobCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "TenantDeleteJob.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "TenantDeleteJob.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := tenantdeletejob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TenantDeleteJob.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Stage(); ok {
+		if err := tenantdeletejob.StageValidator(v); err != nil {
+			return &ValidationError{Name: "stage", err: fmt.Errorf(`ent: validator failed for field "TenantDeleteJob.stage": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TotalItems(); !ok {
+		return &ValidationError{Name: "total_items", err: errors.New(`ent: missing required field "TenantDeleteJob.total_items"`)}
+	}
+	if _, ok := _c.mutation.ProcessedItems(); !ok {
+		return &ValidationError{Name: "processed_items", err: errors.New(`ent: missing required field "TenantDeleteJob.processed_items"`)}
+	}
+	if _, ok := _c.mutation.DocumentsDeleted(); !ok {
+		return &ValidationError{Name: "documents_deleted", err: errors.New(`ent: missing required field "TenantDeleteJob.documents_deleted"`)}
+	}
+	if _, ok := _c.mutation.CategoriesDeleted(); !ok {
+		return &ValidationError{Name: "categories_deleted", err: errors.New(`ent: missing required field "TenantDeleteJob.categories_deleted"`)}
+	}
+	if _, ok := _c.mutation.PermissionsDeleted(); !ok {
+		return &ValidationError{Name: "permissions_deleted", err: errors.New(`ent: missing required field "TenantDeleteJob.permissions_deleted"`)}
+	}
+	if _, ok := _c.mutation.RecordsDeleted(); !ok {
+		return &ValidationError{Name: "records_deleted", err: errors.New(`ent: missing required field "TenantDeleteJob.records_deleted"`)}
+	}
+	if _, ok := _c.mutation.ObjectsDeleted(); !ok {
+		return &ValidationError{Name: "objects_deleted", err: errors.New(`ent: missing required field "TenantDeleteJob.objects_deleted"`)}
+	}
+	if _, ok := _c.mutation.BytesDeleted(); !ok {
+		return &ValidationError{Name: "bytes_deleted", err: errors.New(`ent: missing required field "TenantDeleteJob.bytes_deleted"`)}
+	}
+	if v, ok := _c.mutation.Error(); ok {
+		if err := tenantdeletejob.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "TenantDeleteJob.error": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := tenantdeletejob.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "TenantDeleteJob.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *TenantDeleteJobCreate) sqlSave(ctx context.Context) (*TenantDeleteJob, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected TenantDeleteJob.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TenantDeleteJobCreate) createSpec() (*TenantDeleteJob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TenantDeleteJob{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(tenantdeletejob.Table, sqlgraph.NewFieldSpec(tenantdeletejob.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateBy(); ok {
+		_spec.SetField(tenantdeletejob.FieldCreateBy, field.TypeUint32, value)
+		_node.CreateBy = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(tenantdeletejob.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(tenantdeletejob.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(tenantdeletejob.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(tenantdeletejob.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(tenantdeletejob.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Stage(); ok {
+		_spec.SetField(tenantdeletejob.FieldStage, field.TypeString, value)
+		_node.Stage = value
+	}
+	if value, ok := _c.mutation.TotalItems(); ok {
+		_spec.SetField(tenantdeletejob.FieldTotalItems, field.TypeInt64, value)
+		_node.TotalItems = value
+	}
+	if value, ok := _c.mutation.ProcessedItems(); ok {
+		_spec.SetField(tenantdeletejob.FieldProcessedItems, field.TypeInt64, value)
+		_node.ProcessedItems = value
+	}
+	if value, ok := _c.mutation.DocumentsDeleted(); ok {
+		_spec.SetField(tenantdeletejob.FieldDocumentsDeleted, field.TypeInt64, value)
+		_node.DocumentsDeleted = value
+	}
+	if value, ok := _c.mutation.CategoriesDeleted(); ok {
+		_spec.SetField(tenantdeletejob.FieldCategoriesDeleted, field.TypeInt64, value)
+		_node.CategoriesDeleted = value
+	}
+	if value, ok := _c.mutation.PermissionsDeleted(); ok {
+		_spec.SetField(tenantdeletejob.FieldPermissionsDeleted, field.TypeInt64, value)
+		_node.PermissionsDeleted = value
+	}
+	if value, ok := _c.mutation.RecordsDeleted(); ok {
+		_spec.SetField(tenantdeletejob.FieldRecordsDeleted, field.TypeInt64, value)
+		_node.RecordsDeleted = value
+	}
+	if value, ok := _c.mutation.ObjectsDeleted(); ok {
+		_spec.SetField(tenantdeletejob.FieldObjectsDeleted, field.TypeInt64, value)
+		_node.ObjectsDeleted = value
+	}
+	if value, ok := _c.mutation.BytesDeleted(); ok {
+		_spec.SetField(tenantdeletejob.FieldBytesDeleted, field.TypeInt64, value)
+		_node.BytesDeleted = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(tenantdeletejob.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := _c.mutation.LeaseUntil(); ok {
+		_spec.SetField(tenantdeletejob.FieldLeaseUntil, field.TypeTime, value)
+		_node.LeaseUntil = &value
+	}
+	if value, ok := _c.mutation.FinishedAt(); ok {
+		_spec.SetField(tenantdeletejob.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TenantDeleteJob.Create().
+//		SetCreateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TenantDeleteJobUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TenantDeleteJobCreate) OnConflict(opts ...sql.ConflictOption) *TenantDeleteJobUpsertOne {
+	_c.conflict = opts
+	return &TenantDeleteJobUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TenantDeleteJob.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TenantDeleteJobCreate) OnConflictColumns(columns ...string) *TenantDeleteJobUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TenantDeleteJobUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// TenantDeleteJobUpsertOne is the builder for "upsert"-ing
+	//  one TenantDeleteJob node.
+	TenantDeleteJobUpsertOne struct {
+		create *TenantDeleteJobCreate
+	}
+
+	// TenantDeleteJobUpsert is the "OnConflict" setter.
+	TenantDeleteJobUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateBy sets the "create_by" field.
+func (u *TenantDeleteJobUpsert) SetCreateBy(v uint32) *TenantDeleteJobUpsert {
+	u.Set(tenantdeletejob.FieldCreateBy, v)
+	return u
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsert) UpdateCreateBy() *TenantDeleteJobUpsert {
+	u.SetExcluded(tenantdeletejob.FieldCreateBy)
+	return u
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *TenantDeleteJobUpsert) AddCreateBy(v uint32) *TenantDeleteJobUpsert {
+	u.Add(tenantdeletejob.FieldCreateBy, v)
+	return u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *TenantDeleteJobUpsert) ClearCreateBy() *TenantDeleteJobUpsert {
+	u.SetNull(tenantdeletejob.FieldCreateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *TenantDeleteJobUpsert) SetUpdateTime(v time.Time) *TenantDeleteJobUpsert {
+	u.Set(tenantdeletejob.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsert) UpdateUpdateTime() *TenantDeleteJobUpsert {
+	u.SetExcluded(tenantdeletejob.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *TenantDeleteJobUpsert) ClearUpdateTime() *TenantDeleteJobUpsert {
+	u.SetNull(tenantdeletejob.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *TenantDeleteJobUpsert) SetDeleteTime(v time.Time) *TenantDeleteJobUpsert {
+	u.Set(tenantdeletejob.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsert) UpdateDeleteTime() *TenantDeleteJobUpsert {
+	u.SetExcluded(tenantdeletejob.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *TenantDeleteJobUpsert) ClearDeleteTime() *TenantDeleteJobUpsert {
+	u.SetNull(tenantdeletejob.FieldDeleteTime)
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *TenantDeleteJobUpsert) SetTenantID(v uint32) *TenantDeleteJobUpsert {
+	u.Set(tenantdeletejob.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsert) UpdateTenantID() *TenantDeleteJobUpsert {
+	u.SetExcluded(tenantdeletejob.FieldTenantID)
+	return u
+}
+
+// AddTenantID adds v to the "tenant_id" field.
+func (u *TenantDeleteJobUpsert) AddTenantID(v uint32) *TenantDeleteJobUpsert {
+	u.Add(tenantdeletejob.FieldTenantID, v)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *TenantDeleteJobUpsert) SetStatus(v tenantdeletejob.Status) *TenantDeleteJobUpsert {
+	u.Set(tenantdeletejob.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsert) UpdateStatus() *TenantDeleteJobUpsert {
+	u.SetExcluded(tenantdeletejob.FieldStatus)
+	return u
+}
+
+// SetStage sets the "stage" field.
+func (u *TenantDeleteJobUpsert) SetStage(v string) *TenantDeleteJobUpsert {
+	u.Set(tenantdeletejob.FieldStage, v)
+	return u
+}
+
+// UpdateStage sets the "stage" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsert) UpdateStage() *TenantDeleteJobUpsert {
+	u.SetExcluded(tenantdeletejob.FieldStage)
+	return u
+}
+
+// ClearStage clears the value of the "stage" field.
+func (u *TenantDeleteJobUpsert) ClearStage() *TenantDeleteJobUpsert {
+	u.SetNull(tenantdeletejob.FieldStage)
+	return u
+}
+
+// SetTotalItems sets the "total_items" field.
+func (u *TenantDeleteJobUpsert) SetTotalItems(v int64) *TenantDeleteJobUpsert {
+	u.Set(tenantdeletejob.FieldTotalItems, v)
+	return u
+}
+
+// UpdateTotalItems sets the "total_items" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsert) UpdateTotalItems() *TenantDeleteJobUpsert {
+	u.SetExcluded(tenantdeletejob.FieldTotalItems)
+	return u
+}
+
+// AddTotalItems adds v to the "total_items" field.
+func (u *TenantDeleteJobUpsert) AddTotalItems(v int64) *TenantDeleteJobUpsert {
+	u.Add(tenantdeletejob.FieldTotalItems, v)
+	return u
+}
+
+// SetProcessedItems sets the "processed_items" field.
+func (u *TenantDeleteJobUpsert) SetProcessedItems(v int64) *TenantDeleteJobUpsert {
+	u.Set(tenantdeletejob.FieldProcessedItems, v)
+	return u
+}
+
+// UpdateProcessedItems sets the "processed_items" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsert) UpdateProcessedItems() *TenantDeleteJobUpsert {
+	u.SetExcluded(tenantdeletejob.FieldProcessedItems)
+	return u
+}
+
+// AddProcessedItems adds v to the "processed_items" field.
+func (u *TenantDeleteJobUpsert) AddProcessedItems(v int64) *TenantDeleteJobUpsert {
+	u.Add(tenantdeletejob.FieldProcessedItems, v)
+	return u
+}
+
+// SetDocumentsDeleted sets the "documents_deleted" field.
+func (u *TenantDeleteJobUpsert) SetDocumentsDeleted(v int64) *TenantDeleteJobUpsert {
+	u.Set(tenantdeletejob.FieldDocumentsDeleted, v)
+	return u
+}
+
+// UpdateDocumentsDeleted sets the "documents_deleted" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsert) UpdateDocumentsDeleted() *TenantDeleteJobUpsert {
+	u.SetExcluded(tenantdeletejob.FieldDocumentsDeleted)
+	return u
+}
+
+// AddDocumentsDeleted adds v to the "documents_deleted" field.
+func (u *TenantDeleteJobUpsert) AddDocumentsDeleted(v int64) *TenantDeleteJobUpsert {
+	u.Add(tenantdeletejob.FieldDocumentsDeleted, v)
+	return u
+}
+
+// SetCategoriesDeleted sets the "categories_deleted" field.
+func (u *TenantDeleteJobUpsert) SetCategoriesDeleted(v int64) *TenantDeleteJobUpsert {
+	u.Set(tenantdeletejob.FieldCategoriesDeleted, v)
+	return u
+}
+
+// UpdateCategoriesDeleted sets the "categories_deleted" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsert) UpdateCategoriesDeleted() *TenantDeleteJobUpsert {
+	u.SetExcluded(tenantdeletejob.FieldCategoriesDeleted)
+	return u
+}
+
+// AddCategoriesDeleted adds v to the "categories_deleted" field.
+func (u *TenantDeleteJobUpsert) AddCategoriesDeleted(v int64) *TenantDeleteJobUpsert {
+	u.Add(tenantdeletejob.FieldCategoriesDeleted, v)
+	return u
+}
+
+// SetPermissionsDeleted sets the "permissions_deleted" field.
+func (u *TenantDeleteJobUpsert) SetPermissionsDeleted(v int64) *TenantDeleteJobUpsert {
+	u.Set(tenantdeletejob.FieldPermissionsDeleted, v)
+	return u
+}
+
+// UpdatePermissionsDeleted sets the "permissions_deleted" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsert) UpdatePermissionsDeleted() *TenantDeleteJobUpsert {
+	u.SetExcluded(tenantdeletejob.FieldPermissionsDeleted)
+	return u
+}
+
+// AddPermissionsDeleted adds v to the "permissions_deleted" field.
+func (u *TenantDeleteJobUpsert) AddPermissionsDeleted(v int64) *TenantDeleteJobUpsert {
+	u.Add(tenantdeletejob.FieldPermissionsDeleted, v)
+	return u
+}
+
+// SetRecordsDeleted sets the "records_deleted" field.
+func (u *TenantDeleteJobUpsert) SetRecordsDeleted(v int64) *TenantDeleteJobUpsert {
+	u.Set(tenantdeletejob.FieldRecordsDeleted, v)
+	return u
+}
+
+// UpdateRecordsDeleted sets the "records_deleted" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsert) UpdateRecordsDeleted() *TenantDeleteJobUpsert {
+	u.SetExcluded(tenantdeletejob.FieldRecordsDeleted)
+	return u
+}
+
+// AddRecordsDeleted adds v to the "records_deleted" field.
+func (u *TenantDeleteJobUpsert) AddRecordsDeleted(v int64) *TenantDeleteJobUpsert {
+	u.Add(tenantdeletejob.FieldRecordsDeleted, v)
+	return u
+}
+
+// SetObjectsDeleted sets the "objects_deleted" field.
+func (u *TenantDeleteJobUpsert) SetObjectsDeleted(v int64) *TenantDeleteJobUpsert {
+	u.Set(tenantdeletejob.FieldObjectsDeleted, v)
+	return u
+}
+
+// UpdateObjectsDeleted sets the "objects_deleted" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsert) UpdateObjectsDeleted() *TenantDeleteJobUpsert {
+	u.SetExcluded(tenantdeletejob.FieldObjectsDeleted)
+	return u
+}
+
+// AddObjectsDeleted adds v to the "objects_deleted" field.
+func (u *TenantDeleteJobUpsert) AddObjectsDeleted(v int64) *TenantDeleteJobUpsert {
+	u.Add(tenantdeletejob.FieldObjectsDeleted, v)
+	return u
+}
+
+// SetBytesDeleted sets the "bytes_deleted" field.
+func (u *TenantDeleteJobUpsert) SetBytesDeleted(v int64) *TenantDeleteJobUpsert {
+	u.Set(tenantdeletejob.FieldBytesDeleted, v)
+	return u
+}
+
+// UpdateBytesDeleted sets the "bytes_deleted" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsert) UpdateBytesDeleted() *TenantDeleteJobUpsert {
+	u.SetExcluded(tenantdeletejob.FieldBytesDeleted)
+	return u
+}
+
+// AddBytesDeleted adds v to the "bytes_deleted" field.
+func (u *TenantDeleteJobUpsert) AddBytesDeleted(v int64) *TenantDeleteJobUpsert {
+	u.Add(tenantdeletejob.FieldBytesDeleted, v)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *TenantDeleteJobUpsert) SetError(v string) *TenantDeleteJobUpsert {
+	u.Set(tenantdeletejob.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsert) UpdateError() *TenantDeleteJobUpsert {
+	u.SetExcluded(tenantdeletejob.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *TenantDeleteJobUpsert) ClearError() *TenantDeleteJobUpsert {
+	u.SetNull(tenantdeletejob.FieldError)
+	return u
+}
+
+// SetLeaseUntil sets the "lease_until" field.
+func (u *TenantDeleteJobUpsert) SetLeaseUntil(v time.Time) *TenantDeleteJobUpsert {
+	u.Set(tenantdeletejob.FieldLeaseUntil, v)
+	return u
+}
+
+// UpdateLeaseUntil sets the "lease_until" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsert) UpdateLeaseUntil() *TenantDeleteJobUpsert {
+	u.SetExcluded(tenantdeletejob.FieldLeaseUntil)
+	return u
+}
+
+// ClearLeaseUntil clears the value of the "lease_until" field.
+func (u *TenantDeleteJobUpsert) ClearLeaseUntil() *TenantDeleteJobUpsert {
+	u.SetNull(tenantdeletejob.FieldLeaseUntil)
+	return u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *TenantDeleteJobUpsert) SetFinishedAt(v time.Time) *TenantDeleteJobUpsert {
+	u.Set(tenantdeletejob.FieldFinishedAt, v)
+	return u
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsert) UpdateFinishedAt() *TenantDeleteJobUpsert {
+	u.SetExcluded(tenantdeletejob.FieldFinishedAt)
+	return u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *TenantDeleteJobUpsert) ClearFinishedAt() *TenantDeleteJobUpsert {
+	u.SetNull(tenantdeletejob.FieldFinishedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TenantDeleteJob.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tenantdeletejob.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TenantDeleteJobUpsertOne) UpdateNewValues() *TenantDeleteJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(tenantdeletejob.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(tenantdeletejob.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TenantDeleteJob.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TenantDeleteJobUpsertOne) Ignore() *TenantDeleteJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TenantDeleteJobUpsertOne) DoNothing() *TenantDeleteJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TenantDeleteJobCreate.OnConflict
+// documentation for more info.
+func (u *TenantDeleteJobUpsertOne) Update(set func(*TenantDeleteJobUpsert)) *TenantDeleteJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TenantDeleteJobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *TenantDeleteJobUpsertOne) SetCreateBy(v uint32) *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *TenantDeleteJobUpsertOne) AddCreateBy(v uint32) *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsertOne) UpdateCreateBy() *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *TenantDeleteJobUpsertOne) ClearCreateBy() *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *TenantDeleteJobUpsertOne) SetUpdateTime(v time.Time) *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsertOne) UpdateUpdateTime() *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *TenantDeleteJobUpsertOne) ClearUpdateTime() *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *TenantDeleteJobUpsertOne) SetDeleteTime(v time.Time) *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsertOne) UpdateDeleteTime() *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *TenantDeleteJobUpsertOne) ClearDeleteTime() *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *TenantDeleteJobUpsertOne) SetTenantID(v uint32) *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// AddTenantID adds v to the "tenant_id" field.
+func (u *TenantDeleteJobUpsertOne) AddTenantID(v uint32) *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.AddTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsertOne) UpdateTenantID() *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TenantDeleteJobUpsertOne) SetStatus(v tenantdeletejob.Status) *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsertOne) UpdateStatus() *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetStage sets the "stage" field.
+func (u *TenantDeleteJobUpsertOne) SetStage(v string) *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.SetStage(v)
+	})
+}
+
+// UpdateStage sets the "stage" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsertOne) UpdateStage() *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.UpdateStage()
+	})
+}
+
+// ClearStage clears the value of the "stage" field.
+func (u *TenantDeleteJobUpsertOne) ClearStage() *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.ClearStage()
+	})
+}
+
+// SetTotalItems sets the "total_items" field.
+func (u *TenantDeleteJobUpsertOne) SetTotalItems(v int64) *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.SetTotalItems(v)
+	})
+}
+
+// AddTotalItems adds v to the "total_items" field.
+func (u *TenantDeleteJobUpsertOne) AddTotalItems(v int64) *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.AddTotalItems(v)
+	})
+}
+
+// UpdateTotalItems sets the "total_items" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsertOne) UpdateTotalItems() *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.UpdateTotalItems()
+	})
+}
+
+// SetProcessedItems sets the "processed_items" field.
+func (u *TenantDeleteJobUpsertOne) SetProcessedItems(v int64) *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.SetProcessedItems(v)
+	})
+}
+
+// AddProcessedItems adds v to the "processed_items" field.
+func (u *TenantDeleteJobUpsertOne) AddProcessedItems(v int64) *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.AddProcessedItems(v)
+	})
+}
+
+// UpdateProcessedItems sets the "processed_items" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsertOne) UpdateProcessedItems() *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.UpdateProcessedItems()
+	})
+}
+
+// SetDocumentsDeleted sets the "documents_deleted" field.
+func (u *TenantDeleteJobUpsertOne) SetDocumentsDeleted(v int64) *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.SetDocumentsDeleted(v)
+	})
+}
+
+// AddDocumentsDeleted adds v to the "documents_deleted" field.
+func (u *TenantDeleteJobUpsertOne) AddDocumentsDeleted(v int64) *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.AddDocumentsDeleted(v)
+	})
+}
+
+// UpdateDocumentsDeleted sets the "documents_deleted" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsertOne) UpdateDocumentsDeleted() *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.UpdateDocumentsDeleted()
+	})
+}
+
+// SetCategoriesDeleted sets the "categories_deleted" field.
+func (u *TenantDeleteJobUpsertOne) SetCategoriesDeleted(v int64) *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.SetCategoriesDeleted(v)
+	})
+}
+
+// AddCategoriesDeleted adds v to the "categories_deleted" field.
+func (u *TenantDeleteJobUpsertOne) AddCategoriesDeleted(v int64) *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.AddCategoriesDeleted(v)
+	})
+}
+
+// UpdateCategoriesDeleted sets the "categories_deleted" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsertOne) UpdateCategoriesDeleted() *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.UpdateCategoriesDeleted()
+	})
+}
+
+// SetPermissionsDeleted sets the "permissions_deleted" field.
+func (u *TenantDeleteJobUpsertOne) SetPermissionsDeleted(v int64) *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.SetPermissionsDeleted(v)
+	})
+}
+
+// AddPermissionsDeleted adds v to the "permissions_deleted" field.
+func (u *TenantDeleteJobUpsertOne) AddPermissionsDeleted(v int64) *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.AddPermissionsDeleted(v)
+	})
+}
+
+// UpdatePermissionsDeleted sets the "permissions_deleted" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsertOne) UpdatePermissionsDeleted() *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.UpdatePermissionsDeleted()
+	})
+}
+
+// SetRecordsDeleted sets the "records_deleted" field.
+func (u *TenantDeleteJobUpsertOne) SetRecordsDeleted(v int64) *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.SetRecordsDeleted(v)
+	})
+}
+
+// AddRecordsDeleted adds v to the "records_deleted" field.
+func (u *TenantDeleteJobUpsertOne) AddRecordsDeleted(v int64) *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.AddRecordsDeleted(v)
+	})
+}
+
+// UpdateRecordsDeleted sets the "records_deleted" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsertOne) UpdateRecordsDeleted() *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.UpdateRecordsDeleted()
+	})
+}
+
+// SetObjectsDeleted sets the "objects_deleted" field.
+func (u *TenantDeleteJobUpsertOne) SetObjectsDeleted(v int64) *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.SetObjectsDeleted(v)
+	})
+}
+
+// AddObjectsDeleted adds v to the "objects_deleted" field.
+func (u *TenantDeleteJobUpsertOne) AddObjectsDeleted(v int64) *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.AddObjectsDeleted(v)
+	})
+}
+
+// UpdateObjectsDeleted sets the "objects_deleted" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsertOne) UpdateObjectsDeleted() *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.UpdateObjectsDeleted()
+	})
+}
+
+// SetBytesDeleted sets the "bytes_deleted" field.
+func (u *TenantDeleteJobUpsertOne) SetBytesDeleted(v int64) *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.SetBytesDeleted(v)
+	})
+}
+
+// AddBytesDeleted adds v to the "bytes_deleted" field.
+func (u *TenantDeleteJobUpsertOne) AddBytesDeleted(v int64) *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.AddBytesDeleted(v)
+	})
+}
+
+// UpdateBytesDeleted sets the "bytes_deleted" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsertOne) UpdateBytesDeleted() *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.UpdateBytesDeleted()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *TenantDeleteJobUpsertOne) SetError(v string) *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsertOne) UpdateError() *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *TenantDeleteJobUpsertOne) ClearError() *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetLeaseUntil sets the "lease_until" field.
+func (u *TenantDeleteJobUpsertOne) SetLeaseUntil(v time.Time) *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.SetLeaseUntil(v)
+	})
+}
+
+// UpdateLeaseUntil sets the "lease_until" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsertOne) UpdateLeaseUntil() *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.UpdateLeaseUntil()
+	})
+}
+
+// ClearLeaseUntil clears the value of the "lease_until" field.
+func (u *TenantDeleteJobUpsertOne) ClearLeaseUntil() *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.ClearLeaseUntil()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *TenantDeleteJobUpsertOne) SetFinishedAt(v time.Time) *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsertOne) UpdateFinishedAt() *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *TenantDeleteJobUpsertOne) ClearFinishedAt() *TenantDeleteJobUpsertOne {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TenantDeleteJobUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TenantDeleteJobCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TenantDeleteJobUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TenantDeleteJobUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: TenantDeleteJobUpsertOne.ID is not supported by MySQL driver. Use TenantDeleteJobUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TenantDeleteJobUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TenantDeleteJobCreateBulk is the builder for creating many TenantDeleteJob entities in bulk.
+type TenantDeleteJobCreateBulk struct {
+	config
+	err      error
+	builders []*TenantDeleteJobCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TenantDeleteJob entities in the database.
+func (_c *TenantDeleteJobCreateBulk) Save(ctx context.Context) ([]*TenantDeleteJob, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TenantDeleteJob, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TenantDeleteJobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TenantDeleteJobCreateBulk) SaveX(ctx context.Context) []*TenantDeleteJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TenantDeleteJobCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TenantDeleteJobCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TenantDeleteJob.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TenantDeleteJobUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TenantDeleteJobCreateBulk) OnConflict(opts ...sql.ConflictOption) *TenantDeleteJobUpsertBulk {
+	_c.conflict = opts
+	return &TenantDeleteJobUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TenantDeleteJob.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TenantDeleteJobCreateBulk) OnConflictColumns(columns ...string) *TenantDeleteJobUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TenantDeleteJobUpsertBulk{
+		create: _c,
+	}
+}
+
+// TenantDeleteJobUpsertBulk is the builder for "upsert"-ing
+// a bulk of TenantDeleteJob nodes.
+type TenantDeleteJobUpsertBulk struct {
+	create *TenantDeleteJobCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TenantDeleteJob.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tenantdeletejob.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TenantDeleteJobUpsertBulk) UpdateNewValues() *TenantDeleteJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(tenantdeletejob.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(tenantdeletejob.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TenantDeleteJob.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TenantDeleteJobUpsertBulk) Ignore() *TenantDeleteJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TenantDeleteJobUpsertBulk) DoNothing() *TenantDeleteJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TenantDeleteJobCreateBulk.OnConflict
+// documentation for more info.
+func (u *TenantDeleteJobUpsertBulk) Update(set func(*TenantDeleteJobUpsert)) *TenantDeleteJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TenantDeleteJobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *TenantDeleteJobUpsertBulk) SetCreateBy(v uint32) *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *TenantDeleteJobUpsertBulk) AddCreateBy(v uint32) *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsertBulk) UpdateCreateBy() *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *TenantDeleteJobUpsertBulk) ClearCreateBy() *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *TenantDeleteJobUpsertBulk) SetUpdateTime(v time.Time) *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsertBulk) UpdateUpdateTime() *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *TenantDeleteJobUpsertBulk) ClearUpdateTime() *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *TenantDeleteJobUpsertBulk) SetDeleteTime(v time.Time) *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsertBulk) UpdateDeleteTime() *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *TenantDeleteJobUpsertBulk) ClearDeleteTime() *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *TenantDeleteJobUpsertBulk) SetTenantID(v uint32) *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// AddTenantID adds v to the "tenant_id" field.
+func (u *TenantDeleteJobUpsertBulk) AddTenantID(v uint32) *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.AddTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsertBulk) UpdateTenantID() *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TenantDeleteJobUpsertBulk) SetStatus(v tenantdeletejob.Status) *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsertBulk) UpdateStatus() *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetStage sets the "stage" field.
+func (u *TenantDeleteJobUpsertBulk) SetStage(v string) *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.SetStage(v)
+	})
+}
+
+// UpdateStage sets the "stage" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsertBulk) UpdateStage() *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.UpdateStage()
+	})
+}
+
+// ClearStage clears the value of the "stage" field.
+func (u *TenantDeleteJobUpsertBulk) ClearStage() *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.ClearStage()
+	})
+}
+
+// SetTotalItems sets the "total_items" field.
+func (u *TenantDeleteJobUpsertBulk) SetTotalItems(v int64) *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.SetTotalItems(v)
+	})
+}
+
+// AddTotalItems adds v to the "total_items" field.
+func (u *TenantDeleteJobUpsertBulk) AddTotalItems(v int64) *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.AddTotalItems(v)
+	})
+}
+
+// UpdateTotalItems sets the "total_items" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsertBulk) UpdateTotalItems() *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.UpdateTotalItems()
+	})
+}
+
+// SetProcessedItems sets the "processed_items" field.
+func (u *TenantDeleteJobUpsertBulk) SetProcessedItems(v int64) *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.SetProcessedItems(v)
+	})
+}
+
+// AddProcessedItems adds v to the "processed_items" field.
+func (u *TenantDeleteJobUpsertBulk) AddProcessedItems(v int64) *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.AddProcessedItems(v)
+	})
+}
+
+// UpdateProcessedItems sets the "processed_items" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsertBulk) UpdateProcessedItems() *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.UpdateProcessedItems()
+	})
+}
+
+// SetDocumentsDeleted sets the "documents_deleted" field.
+func (u *TenantDeleteJobUpsertBulk) SetDocumentsDeleted(v int64) *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.SetDocumentsDeleted(v)
+	})
+}
+
+// AddDocumentsDeleted adds v to the "documents_deleted" field.
+func (u *TenantDeleteJobUpsertBulk) AddDocumentsDeleted(v int64) *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.AddDocumentsDeleted(v)
+	})
+}
+
+// UpdateDocumentsDeleted sets the "documents_deleted" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsertBulk) UpdateDocumentsDeleted() *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.UpdateDocumentsDeleted()
+	})
+}
+
+// SetCategoriesDeleted sets the "categories_deleted" field.
+func (u *TenantDeleteJobUpsertBulk) SetCategoriesDeleted(v int64) *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.SetCategoriesDeleted(v)
+	})
+}
+
+// AddCategoriesDeleted adds v to the "categories_deleted" field.
+func (u *TenantDeleteJobUpsertBulk) AddCategoriesDeleted(v int64) *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.AddCategoriesDeleted(v)
+	})
+}
+
+// UpdateCategoriesDeleted sets the "categories_deleted" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsertBulk) UpdateCategoriesDeleted() *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.UpdateCategoriesDeleted()
+	})
+}
+
+// SetPermissionsDeleted sets the "permissions_deleted" field.
+func (u *TenantDeleteJobUpsertBulk) SetPermissionsDeleted(v int64) *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.SetPermissionsDeleted(v)
+	})
+}
+
+// AddPermissionsDeleted adds v to the "permissions_deleted" field.
+func (u *TenantDeleteJobUpsertBulk) AddPermissionsDeleted(v int64) *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.AddPermissionsDeleted(v)
+	})
+}
+
+// UpdatePermissionsDeleted sets the "permissions_deleted" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsertBulk) UpdatePermissionsDeleted() *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.UpdatePermissionsDeleted()
+	})
+}
+
+// SetRecordsDeleted sets the "records_deleted" field.
+func (u *TenantDeleteJobUpsertBulk) SetRecordsDeleted(v int64) *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.SetRecordsDeleted(v)
+	})
+}
+
+// AddRecordsDeleted adds v to the "records_deleted" field.
+func (u *TenantDeleteJobUpsertBulk) AddRecordsDeleted(v int64) *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.AddRecordsDeleted(v)
+	})
+}
+
+// UpdateRecordsDeleted sets the "records_deleted" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsertBulk) UpdateRecordsDeleted() *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.UpdateRecordsDeleted()
+	})
+}
+
+// SetObjectsDeleted sets the "objects_deleted" field.
+func (u *TenantDeleteJobUpsertBulk) SetObjectsDeleted(v int64) *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.SetObjectsDeleted(v)
+	})
+}
+
+// AddObjectsDeleted adds v to the "objects_deleted" field.
+func (u *TenantDeleteJobUpsertBulk) AddObjectsDeleted(v int64) *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.AddObjectsDeleted(v)
+	})
+}
+
+// UpdateObjectsDeleted sets the "objects_deleted" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsertBulk) UpdateObjectsDeleted() *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.UpdateObjectsDeleted()
+	})
+}
+
+// SetBytesDeleted sets the "bytes_deleted" field.
+func (u *TenantDeleteJobUpsertBulk) SetBytesDeleted(v int64) *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.SetBytesDeleted(v)
+	})
+}
+
+// AddBytesDeleted adds v to the "bytes_deleted" field.
+func (u *TenantDeleteJobUpsertBulk) AddBytesDeleted(v int64) *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.AddBytesDeleted(v)
+	})
+}
+
+// UpdateBytesDeleted sets the "bytes_deleted" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsertBulk) UpdateBytesDeleted() *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.UpdateBytesDeleted()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *TenantDeleteJobUpsertBulk) SetError(v string) *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsertBulk) UpdateError() *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *TenantDeleteJobUpsertBulk) ClearError() *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetLeaseUntil sets the "lease_until" field.
+func (u *TenantDeleteJobUpsertBulk) SetLeaseUntil(v time.Time) *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.SetLeaseUntil(v)
+	})
+}
+
+// UpdateLeaseUntil sets the "lease_until" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsertBulk) UpdateLeaseUntil() *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.UpdateLeaseUntil()
+	})
+}
+
+// ClearLeaseUntil clears the value of the "lease_until" field.
+func (u *TenantDeleteJobUpsertBulk) ClearLeaseUntil() *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.ClearLeaseUntil()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *TenantDeleteJobUpsertBulk) SetFinishedAt(v time.Time) *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *TenantDeleteJobUpsertBulk) UpdateFinishedAt() *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *TenantDeleteJobUpsertBulk) ClearFinishedAt() *TenantDeleteJobUpsertBulk {
+	return u.Update(func(s *TenantDeleteJobUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TenantDeleteJobUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TenantDeleteJobCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TenantDeleteJobCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TenantDeleteJobUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
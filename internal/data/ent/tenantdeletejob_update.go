@@ -0,0 +1,1148 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/tenantdeletejob"
+)
+
+// TenantDeleteJobUpdate is the builder for updating TenantDeleteJob entities.
+type TenantDeleteJobUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TenantDeleteJobMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TenantDeleteJobUpdate builder.
+func (_u *TenantDeleteJobUpdate) Where(ps ...predicate.TenantDeleteJob) *TenantDeleteJobUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *TenantDeleteJobUpdate) SetCreateBy(v uint32) *TenantDeleteJobUpdate {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *TenantDeleteJobUpdate) SetNillableCreateBy(v *uint32) *TenantDeleteJobUpdate {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *TenantDeleteJobUpdate) AddCreateBy(v int32) *TenantDeleteJobUpdate {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *TenantDeleteJobUpdate) ClearCreateBy() *TenantDeleteJobUpdate {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *TenantDeleteJobUpdate) SetUpdateTime(v time.Time) *TenantDeleteJobUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *TenantDeleteJobUpdate) SetNillableUpdateTime(v *time.Time) *TenantDeleteJobUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *TenantDeleteJobUpdate) ClearUpdateTime() *TenantDeleteJobUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *TenantDeleteJobUpdate) SetDeleteTime(v time.Time) *TenantDeleteJobUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *TenantDeleteJobUpdate) SetNillableDeleteTime(v *time.Time) *TenantDeleteJobUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *TenantDeleteJobUpdate) ClearDeleteTime() *TenantDeleteJobUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *TenantDeleteJobUpdate) SetTenantID(v uint32) *TenantDeleteJobUpdate {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *TenantDeleteJobUpdate) SetNillableTenantID(v *uint32) *TenantDeleteJobUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *TenantDeleteJobUpdate) AddTenantID(v int32) *TenantDeleteJobUpdate {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *TenantDeleteJobUpdate) SetStatus(v tenantdeletejob.Status) *TenantDeleteJobUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *TenantDeleteJobUpdate) SetNillableStatus(v *tenantdeletejob.Status) *TenantDeleteJobUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetStage sets the "stage" field.
+func (_u *TenantDeleteJobUpdate) SetStage(v string) *TenantDeleteJobUpdate {
+	_u.mutation.SetStage(v)
+	return _u
+}
+
+// SetNillableStage sets the "stage" field if the given value is not nil.
+func (_u *TenantDeleteJobUpdate) SetNillableStage(v *string) *TenantDeleteJobUpdate {
+	if v != nil {
+		_u.SetStage(*v)
+	}
+	return _u
+}
+
+// ClearStage clears the value of the "stage" field.
+func (_u *TenantDeleteJobUpdate) ClearStage() *TenantDeleteJobUpdate {
+	_u.mutation.ClearStage()
+	return _u
+}
+
+// SetTotalItems sets the "total_items" field.
+func (_u *TenantDeleteJobUpdate) SetTotalItems(v int64) *TenantDeleteJobUpdate {
+	_u.mutation.ResetTotalItems()
+	_u.mutation.SetTotalItems(v)
+	return _u
+}
+
+// SetNillableTotalItems sets the "total_items" field if the given value is not nil.
+func (_u *TenantDeleteJobUpdate) SetNillableTotalItems(v *int64) *TenantDeleteJobUpdate {
+	if v != nil {
+		_u.SetTotalItems(*v)
+	}
+	return _u
+}
+
+// AddTotalItems adds value to the "total_items" field.
+func (_u *TenantDeleteJobUpdate) AddTotalItems(v int64) *TenantDeleteJobUpdate {
+	_u.mutation.AddTotalItems(v)
+	return _u
+}
+
+// SetProcessedItems sets the "processed_items" field.
+func (_u *TenantDeleteJobUpdate) SetProcessedItems(v int64) *TenantDeleteJobUpdate {
+	_u.mutation.ResetProcessedItems()
+	_u.mutation.SetProcessedItems(v)
+	return _u
+}
+
+// SetNillableProcessedItems sets the "processed_items" field if the given value is not nil.
+func (_u *TenantDeleteJobUpdate) SetNillableProcessedItems(v *int64) *TenantDeleteJobUpdate {
+	if v != nil {
+		_u.SetProcessedItems(*v)
+	}
+	return _u
+}
+
+// AddProcessedItems adds value to the "processed_items" field.
+func (_u *TenantDeleteJobUpdate) AddProcessedItems(v int64) *TenantDeleteJobUpdate {
+	_u.mutation.AddProcessedItems(v)
+	return _u
+}
+
+// SetDocumentsDeleted sets the "documents_deleted" field.
+func (_u *TenantDeleteJobUpdate) SetDocumentsDeleted(v int64) *TenantDeleteJobUpdate {
+	_u.mutation.ResetDocumentsDeleted()
+	_u.mutation.SetDocumentsDeleted(v)
+	return _u
+}
+
+// SetNillableDocumentsDeleted sets the "documents_deleted" field if the given value is not nil.
+func (_u *TenantDeleteJobUpdate) SetNillableDocumentsDeleted(v *int64) *TenantDeleteJobUpdate {
+	if v != nil {
+		_u.SetDocumentsDeleted(*v)
+	}
+	return _u
+}
+
+// AddDocumentsDeleted adds value to the "documents_deleted" field.
+func (_u *TenantDeleteJobUpdate) AddDocumentsDeleted(v int64) *TenantDeleteJobUpdate {
+	_u.mutation.AddDocumentsDeleted(v)
+	return _u
+}
+
+// SetCategoriesDeleted sets the "categories_deleted" field.
+func (_u *TenantDeleteJobUpdate) SetCategoriesDeleted(v int64) *TenantDeleteJobUpdate {
+	_u.mutation.ResetCategoriesDeleted()
+	_u.mutation.SetCategoriesDeleted(v)
+	return _u
+}
+
+// SetNillableCategoriesDeleted sets the "categories_deleted" field if the given value is not nil.
+func (_u *TenantDeleteJobUpdate) SetNillableCategoriesDeleted(v *int64) *TenantDeleteJobUpdate {
+	if v != nil {
+		_u.SetCategoriesDeleted(*v)
+	}
+	return _u
+}
+
+// AddCategoriesDeleted adds value to the "categories_deleted" field.
+func (_u *TenantDeleteJobUpdate) AddCategoriesDeleted(v int64) *TenantDeleteJobUpdate {
+	_u.mutation.AddCategoriesDeleted(v)
+	return _u
+}
+
+// SetPermissionsDeleted sets the "permissions_deleted" field.
+func (_u *TenantDeleteJobUpdate) SetPermissionsDeleted(v int64) *TenantDeleteJobUpdate {
+	_u.mutation.ResetPermissionsDeleted()
+	_u.mutation.SetPermissionsDeleted(v)
+	return _u
+}
+
+// SetNillablePermissionsDeleted sets the "permissions_deleted" field if the given value is not nil.
+func (_u *TenantDeleteJobUpdate) SetNillablePermissionsDeleted(v *int64) *TenantDeleteJobUpdate {
+	if v != nil {
+		_u.SetPermissionsDeleted(*v)
+	}
+	return _u
+}
+
+// AddPermissionsDeleted adds value to the "permissions_deleted" field.
+func (_u *TenantDeleteJobUpdate) AddPermissionsDeleted(v int64) *TenantDeleteJobUpdate {
+	_u.mutation.AddPermissionsDeleted(v)
+	return _u
+}
+
+// SetRecordsDeleted sets the "records_deleted" field.
+func (_u *TenantDeleteJobUpdate) SetRecordsDeleted(v int64) *TenantDeleteJobUpdate {
+	_u.mutation.ResetRecordsDeleted()
+	_u.mutation.SetRecordsDeleted(v)
+	return _u
+}
+
+// SetNillableRecordsDeleted sets the "records_deleted" field if the given value is not nil.
+func (_u *TenantDeleteJobUpdate) SetNillableRecordsDeleted(v *int64) *TenantDeleteJobUpdate {
+	if v != nil {
+		_u.SetRecordsDeleted(*v)
+	}
+	return _u
+}
+
+// AddRecordsDeleted adds value to the "records_deleted" field.
+func (_u *TenantDeleteJobUpdate) AddRecordsDeleted(v int64) *TenantDeleteJobUpdate {
+	_u.mutation.AddRecordsDeleted(v)
+	return _u
+}
+
+// SetObjectsDeleted sets the "objects_deleted" field.
+func (_u *TenantDeleteJobUpdate) SetObjectsDeleted(v int64) *TenantDeleteJobUpdate {
+	_u.mutation.ResetObjectsDeleted()
+	_u.mutation.SetObjectsDeleted(v)
+	return _u
+}
+
+// SetNillableObjectsDeleted sets the "objects_deleted" field if the given value is not nil.
+func (_u *TenantDeleteJobUpdate) SetNillableObjectsDeleted(v *int64) *TenantDeleteJobUpdate {
+	if v != nil {
+		_u.SetObjectsDeleted(*v)
+	}
+	return _u
+}
+
+// AddObjectsDeleted adds value to the "objects_deleted" field.
+func (_u *TenantDeleteJobUpdate) AddObjectsDeleted(v int64) *TenantDeleteJobUpdate {
+	_u.mutation.AddObjectsDeleted(v)
+	return _u
+}
+
+// SetBytesDeleted sets the "bytes_deleted" field.
+func (_u *TenantDeleteJobUpdate) SetBytesDeleted(v int64) *TenantDeleteJobUpdate {
+	_u.mutation.ResetBytesDeleted()
+	_u.mutation.SetBytesDeleted(v)
+	return _u
+}
+
+// SetNillableBytesDeleted sets the "bytes_deleted" field if the given value is not nil.
+func (_u *TenantDeleteJobUpdate) SetNillableBytesDeleted(v *int64) *TenantDeleteJobUpdate {
+	if v != nil {
+		_u.SetBytesDeleted(*v)
+	}
+	return _u
+}
+
+// AddBytesDeleted adds value to the "bytes_deleted" field.
+func (_u *TenantDeleteJobUpdate) AddBytesDeleted(v int64) *TenantDeleteJobUpdate {
+	_u.mutation.AddBytesDeleted(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *TenantDeleteJobUpdate) SetError(v string) *TenantDeleteJobUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *TenantDeleteJobUpdate) SetNillableError(v *string) *TenantDeleteJobUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *TenantDeleteJobUpdate) ClearError() *TenantDeleteJobUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetLeaseUntil sets the "lease_until" field.
+func (_u *TenantDeleteJobUpdate) SetLeaseUntil(v time.Time) *TenantDeleteJobUpdate {
+	_u.mutation.SetLeaseUntil(v)
+	return _u
+}
+
+// SetNillableLeaseUntil sets the "lease_until" field if the given value is not nil.
+func (_u *TenantDeleteJobUpdate) SetNillableLeaseUntil(v *time.Time) *TenantDeleteJobUpdate {
+	if v != nil {
+		_u.SetLeaseUntil(*v)
+	}
+	return _u
+}
+
+// ClearLeaseUntil clears the value of the "lease_until" field.
+func (_u *TenantDeleteJobUpdate) ClearLeaseUntil() *TenantDeleteJobUpdate {
+	_u.mutation.ClearLeaseUntil()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *TenantDeleteJobUpdate) SetFinishedAt(v time.Time) *TenantDeleteJobUpdate {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *TenantDeleteJobUpdate) SetNillableFinishedAt(v *time.Time) *TenantDeleteJobUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *TenantDeleteJobUpdate) ClearFinishedAt() *TenantDeleteJobUpdate {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// Mutation returns the TenantDeleteJobMutation object of the builder.
+func (_u *TenantDeleteJobUpdate) Mutation() *TenantDeleteJobMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TenantDeleteJobUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantDeleteJobUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TenantDeleteJobUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantDeleteJobUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TenantDeleteJobUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := tenantdeletejob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TenantDeleteJob.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Stage(); ok {
+		if err := tenantdeletejob.StageValidator(v); err != nil {
+			return &ValidationError{Name: "stage", err: fmt.Errorf(`ent: validator failed for field "TenantDeleteJob.stage": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Error(); ok {
+		if err := tenantdeletejob.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "TenantDeleteJob.error": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TenantDeleteJobUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TenantDeleteJobUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TenantDeleteJobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantdeletejob.Table, tenantdeletejob.Columns, sqlgraph.NewFieldSpec(tenantdeletejob.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(tenantdeletejob.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(tenantdeletejob.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(tenantdeletejob.FieldCreateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(tenantdeletejob.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(tenantdeletejob.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(tenantdeletejob.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(tenantdeletejob.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(tenantdeletejob.FieldDeleteTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(tenantdeletejob.FieldTenantID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(tenantdeletejob.FieldTenantID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(tenantdeletejob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Stage(); ok {
+		_spec.SetField(tenantdeletejob.FieldStage, field.TypeString, value)
+	}
+	if _u.mutation.StageCleared() {
+		_spec.ClearField(tenantdeletejob.FieldStage, field.TypeString)
+	}
+	if value, ok := _u.mutation.TotalItems(); ok {
+		_spec.SetField(tenantdeletejob.FieldTotalItems, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalItems(); ok {
+		_spec.AddField(tenantdeletejob.FieldTotalItems, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ProcessedItems(); ok {
+		_spec.SetField(tenantdeletejob.FieldProcessedItems, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedProcessedItems(); ok {
+		_spec.AddField(tenantdeletejob.FieldProcessedItems, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.DocumentsDeleted(); ok {
+		_spec.SetField(tenantdeletejob.FieldDocumentsDeleted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDocumentsDeleted(); ok {
+		_spec.AddField(tenantdeletejob.FieldDocumentsDeleted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CategoriesDeleted(); ok {
+		_spec.SetField(tenantdeletejob.FieldCategoriesDeleted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCategoriesDeleted(); ok {
+		_spec.AddField(tenantdeletejob.FieldCategoriesDeleted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PermissionsDeleted(); ok {
+		_spec.SetField(tenantdeletejob.FieldPermissionsDeleted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPermissionsDeleted(); ok {
+		_spec.AddField(tenantdeletejob.FieldPermissionsDeleted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.RecordsDeleted(); ok {
+		_spec.SetField(tenantdeletejob.FieldRecordsDeleted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRecordsDeleted(); ok {
+		_spec.AddField(tenantdeletejob.FieldRecordsDeleted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ObjectsDeleted(); ok {
+		_spec.SetField(tenantdeletejob.FieldObjectsDeleted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedObjectsDeleted(); ok {
+		_spec.AddField(tenantdeletejob.FieldObjectsDeleted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.BytesDeleted(); ok {
+		_spec.SetField(tenantdeletejob.FieldBytesDeleted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedBytesDeleted(); ok {
+		_spec.AddField(tenantdeletejob.FieldBytesDeleted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(tenantdeletejob.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(tenantdeletejob.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.LeaseUntil(); ok {
+		_spec.SetField(tenantdeletejob.FieldLeaseUntil, field.TypeTime, value)
+	}
+	if _u.mutation.LeaseUntilCleared() {
+		_spec.ClearField(tenantdeletejob.FieldLeaseUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(tenantdeletejob.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(tenantdeletejob.FieldFinishedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantdeletejob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TenantDeleteJobUpdateOne is the builder for updating a single TenantDeleteJob entity.
+type TenantDeleteJobUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TenantDeleteJobMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *TenantDeleteJobUpdateOne) SetCreateBy(v uint32) *TenantDeleteJobUpdateOne {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *TenantDeleteJobUpdateOne) SetNillableCreateBy(v *uint32) *TenantDeleteJobUpdateOne {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *TenantDeleteJobUpdateOne) AddCreateBy(v int32) *TenantDeleteJobUpdateOne {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *TenantDeleteJobUpdateOne) ClearCreateBy() *TenantDeleteJobUpdateOne {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *TenantDeleteJobUpdateOne) SetUpdateTime(v time.Time) *TenantDeleteJobUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *TenantDeleteJobUpdateOne) SetNillableUpdateTime(v *time.Time) *TenantDeleteJobUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *TenantDeleteJobUpdateOne) ClearUpdateTime() *TenantDeleteJobUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *TenantDeleteJobUpdateOne) SetDeleteTime(v time.Time) *TenantDeleteJobUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *TenantDeleteJobUpdateOne) SetNillableDeleteTime(v *time.Time) *TenantDeleteJobUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *TenantDeleteJobUpdateOne) ClearDeleteTime() *TenantDeleteJobUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *TenantDeleteJobUpdateOne) SetTenantID(v uint32) *TenantDeleteJobUpdateOne {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *TenantDeleteJobUpdateOne) SetNillableTenantID(v *uint32) *TenantDeleteJobUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *TenantDeleteJobUpdateOne) AddTenantID(v int32) *TenantDeleteJobUpdateOne {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *TenantDeleteJobUpdateOne) SetStatus(v tenantdeletejob.Status) *TenantDeleteJobUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *TenantDeleteJobUpdateOne) SetNillableStatus(v *tenantdeletejob.Status) *TenantDeleteJobUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetStage sets the "stage" field.
+func (_u *TenantDeleteJobUpdateOne) SetStage(v string) *TenantDeleteJobUpdateOne {
+	_u.mutation.SetStage(v)
+	return _u
+}
+
+// SetNillableStage sets the "stage" field if the given value is not nil.
+func (_u *TenantDeleteJobUpdateOne) SetNillableStage(v *string) *TenantDeleteJobUpdateOne {
+	if v != nil {
+		_u.SetStage(*v)
+	}
+	return _u
+}
+
+// ClearStage clears the value of the "stage" field.
+func (_u *TenantDeleteJobUpdateOne) ClearStage() *TenantDeleteJobUpdateOne {
+	_u.mutation.ClearStage()
+	return _u
+}
+
+// SetTotalItems sets the "total_items" field.
+func (_u *TenantDeleteJobUpdateOne) SetTotalItems(v int64) *TenantDeleteJobUpdateOne {
+	_u.mutation.ResetTotalItems()
+	_u.mutation.SetTotalItems(v)
+	return _u
+}
+
+// SetNillableTotalItems sets the "total_items" field if the given value is not nil.
+func (_u *TenantDeleteJobUpdateOne) SetNillableTotalItems(v *int64) *TenantDeleteJobUpdateOne {
+	if v != nil {
+		_u.SetTotalItems(*v)
+	}
+	return _u
+}
+
+// AddTotalItems adds value to the "total_items" field.
+func (_u *TenantDeleteJobUpdateOne) AddTotalItems(v int64) *TenantDeleteJobUpdateOne {
+	_u.mutation.AddTotalItems(v)
+	return _u
+}
+
+// SetProcessedItems sets the "processed_items" field.
+func (_u *TenantDeleteJobUpdateOne) SetProcessedItems(v int64) *TenantDeleteJobUpdateOne {
+	_u.mutation.ResetProcessedItems()
+	_u.mutation.SetProcessedItems(v)
+	return _u
+}
+
+// SetNillableProcessedItems sets the "processed_items" field if the given value is not nil.
+func (_u *TenantDeleteJobUpdateOne) SetNillableProcessedItems(v *int64) *TenantDeleteJobUpdateOne {
+	if v != nil {
+		_u.SetProcessedItems(*v)
+	}
+	return _u
+}
+
+// AddProcessedItems adds value to the "processed_items" field.
+func (_u *TenantDeleteJobUpdateOne) AddProcessedItems(v int64) *TenantDeleteJobUpdateOne {
+	_u.mutation.AddProcessedItems(v)
+	return _u
+}
+
+// SetDocumentsDeleted sets the "documents_deleted" field.
+func (_u *TenantDeleteJobUpdateOne) SetDocumentsDeleted(v int64) *TenantDeleteJobUpdateOne {
+	_u.mutation.ResetDocumentsDeleted()
+	_u.mutation.SetDocumentsDeleted(v)
+	return _u
+}
+
+// SetNillableDocumentsDeleted sets the "documents_deleted" field if the given value is not nil.
+func (_u *TenantDeleteJobUpdateOne) SetNillableDocumentsDeleted(v *int64) *TenantDeleteJobUpdateOne {
+	if v != nil {
+		_u.SetDocumentsDeleted(*v)
+	}
+	return _u
+}
+
+// AddDocumentsDeleted adds value to the "documents_deleted" field.
+func (_u *TenantDeleteJobUpdateOne) AddDocumentsDeleted(v int64) *TenantDeleteJobUpdateOne {
+	_u.mutation.AddDocumentsDeleted(v)
+	return _u
+}
+
+// SetCategoriesDeleted sets the "categories_deleted" field.
+func (_u *TenantDeleteJobUpdateOne) SetCategoriesDeleted(v int64) *TenantDeleteJobUpdateOne {
+	_u.mutation.ResetCategoriesDeleted()
+	_u.mutation.SetCategoriesDeleted(v)
+	return _u
+}
+
+// SetNillableCategoriesDeleted sets the "categories_deleted" field if the given value is not nil.
+func (_u *TenantDeleteJobUpdateOne) SetNillableCategoriesDeleted(v *int64) *TenantDeleteJobUpdateOne {
+	if v != nil {
+		_u.SetCategoriesDeleted(*v)
+	}
+	return _u
+}
+
+// AddCategoriesDeleted adds value to the "categories_deleted" field.
+func (_u *TenantDeleteJobUpdateOne) AddCategoriesDeleted(v int64) *TenantDeleteJobUpdateOne {
+	_u.mutation.AddCategoriesDeleted(v)
+	return _u
+}
+
+// SetPermissionsDeleted sets the "permissions_deleted" field.
+func (_u *TenantDeleteJobUpdateOne) SetPermissionsDeleted(v int64) *TenantDeleteJobUpdateOne {
+	_u.mutation.ResetPermissionsDeleted()
+	_u.mutation.SetPermissionsDeleted(v)
+	return _u
+}
+
+// SetNillablePermissionsDeleted sets the "permissions_deleted" field if the given value is not nil.
+func (_u *TenantDeleteJobUpdateOne) SetNillablePermissionsDeleted(v *int64) *TenantDeleteJobUpdateOne {
+	if v != nil {
+		_u.SetPermissionsDeleted(*v)
+	}
+	return _u
+}
+
+// AddPermissionsDeleted adds value to the "permissions_deleted" field.
+func (_u *TenantDeleteJobUpdateOne) AddPermissionsDeleted(v int64) *TenantDeleteJobUpdateOne {
+	_u.mutation.AddPermissionsDeleted(v)
+	return _u
+}
+
+// SetRecordsDeleted sets the "records_deleted" field.
+func (_u *TenantDeleteJobUpdateOne) SetRecordsDeleted(v int64) *TenantDeleteJobUpdateOne {
+	_u.mutation.ResetRecordsDeleted()
+	_u.mutation.SetRecordsDeleted(v)
+	return _u
+}
+
+// SetNillableRecordsDeleted sets the "records_deleted" field if the given value is not nil.
+func (_u *TenantDeleteJobUpdateOne) SetNillableRecordsDeleted(v *int64) *TenantDeleteJobUpdateOne {
+	if v != nil {
+		_u.SetRecordsDeleted(*v)
+	}
+	return _u
+}
+
+// AddRecordsDeleted adds value to the "records_deleted" field.
+func (_u *TenantDeleteJobUpdateOne) AddRecordsDeleted(v int64) *TenantDeleteJobUpdateOne {
+	_u.mutation.AddRecordsDeleted(v)
+	return _u
+}
+
+// SetObjectsDeleted sets the "objects_deleted" field.
+func (_u *TenantDeleteJobUpdateOne) SetObjectsDeleted(v int64) *TenantDeleteJobUpdateOne {
+	_u.mutation.ResetObjectsDeleted()
+	_u.mutation.SetObjectsDeleted(v)
+	return _u
+}
+
+// SetNillableObjectsDeleted sets the "objects_deleted" field if the given value is not nil.
+func (_u *TenantDeleteJobUpdateOne) SetNillableObjectsDeleted(v *int64) *TenantDeleteJobUpdateOne {
+	if v != nil {
+		_u.SetObjectsDeleted(*v)
+	}
+	return _u
+}
+
+// AddObjectsDeleted adds value to the "objects_deleted" field.
+func (_u *TenantDeleteJobUpdateOne) AddObjectsDeleted(v int64) *TenantDeleteJobUpdateOne {
+	_u.mutation.AddObjectsDeleted(v)
+	return _u
+}
+
+// SetBytesDeleted sets the "bytes_deleted" field.
+func (_u *TenantDeleteJobUpdateOne) SetBytesDeleted(v int64) *TenantDeleteJobUpdateOne {
+	_u.mutation.ResetBytesDeleted()
+	_u.mutation.SetBytesDeleted(v)
+	return _u
+}
+
+// SetNillableBytesDeleted sets the "bytes_deleted" field if the given value is not nil.
+func (_u *TenantDeleteJobUpdateOne) SetNillableBytesDeleted(v *int64) *TenantDeleteJobUpdateOne {
+	if v != nil {
+		_u.SetBytesDeleted(*v)
+	}
+	return _u
+}
+
+// AddBytesDeleted adds value to the "bytes_deleted" field.
+func (_u *TenantDeleteJobUpdateOne) AddBytesDeleted(v int64) *TenantDeleteJobUpdateOne {
+	_u.mutation.AddBytesDeleted(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *TenantDeleteJobUpdateOne) SetError(v string) *TenantDeleteJobUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *TenantDeleteJobUpdateOne) SetNillableError(v *string) *TenantDeleteJobUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *TenantDeleteJobUpdateOne) ClearError() *TenantDeleteJobUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetLeaseUntil sets the "lease_until" field.
+func (_u *TenantDeleteJobUpdateOne) SetLeaseUntil(v time.Time) *TenantDeleteJobUpdateOne {
+	_u.mutation.SetLeaseUntil(v)
+	return _u
+}
+
+// SetNillableLeaseUntil sets the "lease_until" field if the given value is not nil.
+func (_u *TenantDeleteJobUpdateOne) SetNillableLeaseUntil(v *time.Time) *TenantDeleteJobUpdateOne {
+	if v != nil {
+		_u.SetLeaseUntil(*v)
+	}
+	return _u
+}
+
+// ClearLeaseUntil clears the value of the "lease_until" field.
+func (_u *TenantDeleteJobUpdateOne) ClearLeaseUntil() *TenantDeleteJobUpdateOne {
+	_u.mutation.ClearLeaseUntil()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *TenantDeleteJobUpdateOne) SetFinishedAt(v time.Time) *TenantDeleteJobUpdateOne {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *TenantDeleteJobUpdateOne) SetNillableFinishedAt(v *time.Time) *TenantDeleteJobUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *TenantDeleteJobUpdateOne) ClearFinishedAt() *TenantDeleteJobUpdateOne {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// Mutation returns the TenantDeleteJobMutation object of the builder.
+func (_u *TenantDeleteJobUpdateOne) Mutation() *TenantDeleteJobMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TenantDeleteJobUpdate builder.
+func (_u *TenantDeleteJobUpdateOne) Where(ps ...predicate.TenantDeleteJob) *TenantDeleteJobUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TenantDeleteJobUpdateOne) Select(field string, fields ...string) *TenantDeleteJobUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TenantDeleteJob entity.
+func (_u *TenantDeleteJobUpdateOne) Save(ctx context.Context) (*TenantDeleteJob, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantDeleteJobUpdateOne) SaveX(ctx context.Context) *TenantDeleteJob {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TenantDeleteJobUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantDeleteJobUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TenantDeleteJobUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := tenantdeletejob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TenantDeleteJob.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Stage(); ok {
+		if err := tenantdeletejob.StageValidator(v); err != nil {
+			return &ValidationError{Name: "stage", err: fmt.Errorf(`ent: validator failed for field "TenantDeleteJob.stage": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Error(); ok {
+		if err := tenantdeletejob.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "TenantDeleteJob.error": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TenantDeleteJobUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TenantDeleteJobUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TenantDeleteJobUpdateOne) sqlSave(ctx context.Context) (_node *TenantDeleteJob, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantdeletejob.Table, tenantdeletejob.Columns, sqlgraph.NewFieldSpec(tenantdeletejob.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TenantDeleteJob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tenantdeletejob.FieldID)
+		for _, f := range fields {
+			if !tenantdeletejob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tenantdeletejob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(tenantdeletejob.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(tenantdeletejob.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(tenantdeletejob.FieldCreateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(tenantdeletejob.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(tenantdeletejob.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(tenantdeletejob.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(tenantdeletejob.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(tenantdeletejob.FieldDeleteTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(tenantdeletejob.FieldTenantID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(tenantdeletejob.FieldTenantID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(tenantdeletejob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Stage(); ok {
+		_spec.SetField(tenantdeletejob.FieldStage, field.TypeString, value)
+	}
+	if _u.mutation.StageCleared() {
+		_spec.ClearField(tenantdeletejob.FieldStage, field.TypeString)
+	}
+	if value, ok := _u.mutation.TotalItems(); ok {
+		_spec.SetField(tenantdeletejob.FieldTotalItems, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalItems(); ok {
+		_spec.AddField(tenantdeletejob.FieldTotalItems, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ProcessedItems(); ok {
+		_spec.SetField(tenantdeletejob.FieldProcessedItems, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedProcessedItems(); ok {
+		_spec.AddField(tenantdeletejob.FieldProcessedItems, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.DocumentsDeleted(); ok {
+		_spec.SetField(tenantdeletejob.FieldDocumentsDeleted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDocumentsDeleted(); ok {
+		_spec.AddField(tenantdeletejob.FieldDocumentsDeleted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CategoriesDeleted(); ok {
+		_spec.SetField(tenantdeletejob.FieldCategoriesDeleted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCategoriesDeleted(); ok {
+		_spec.AddField(tenantdeletejob.FieldCategoriesDeleted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PermissionsDeleted(); ok {
+		_spec.SetField(tenantdeletejob.FieldPermissionsDeleted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPermissionsDeleted(); ok {
+		_spec.AddField(tenantdeletejob.FieldPermissionsDeleted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.RecordsDeleted(); ok {
+		_spec.SetField(tenantdeletejob.FieldRecordsDeleted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRecordsDeleted(); ok {
+		_spec.AddField(tenantdeletejob.FieldRecordsDeleted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ObjectsDeleted(); ok {
+		_spec.SetField(tenantdeletejob.FieldObjectsDeleted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedObjectsDeleted(); ok {
+		_spec.AddField(tenantdeletejob.FieldObjectsDeleted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.BytesDeleted(); ok {
+		_spec.SetField(tenantdeletejob.FieldBytesDeleted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedBytesDeleted(); ok {
+		_spec.AddField(tenantdeletejob.FieldBytesDeleted, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(tenantdeletejob.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(tenantdeletejob.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.LeaseUntil(); ok {
+		_spec.SetField(tenantdeletejob.FieldLeaseUntil, field.TypeTime, value)
+	}
+	if _u.mutation.LeaseUntilCleared() {
+		_spec.ClearField(tenantdeletejob.FieldLeaseUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(tenantdeletejob.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(tenantdeletejob.FieldFinishedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &TenantDeleteJob{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantdeletejob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
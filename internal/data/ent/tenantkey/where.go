@@ -0,0 +1,405 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tenantkey
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldEQ(FieldTenantID, v))
+}
+
+// WrappedKey applies equality check predicate on the "wrapped_key" field. It's identical to WrappedKeyEQ.
+func WrappedKey(v []byte) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldEQ(FieldWrappedKey, v))
+}
+
+// MasterKeyID applies equality check predicate on the "master_key_id" field. It's identical to MasterKeyIDEQ.
+func MasterKeyID(v string) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldEQ(FieldMasterKeyID, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldNotNull(FieldTenantID))
+}
+
+// WrappedKeyEQ applies the EQ predicate on the "wrapped_key" field.
+func WrappedKeyEQ(v []byte) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldEQ(FieldWrappedKey, v))
+}
+
+// WrappedKeyNEQ applies the NEQ predicate on the "wrapped_key" field.
+func WrappedKeyNEQ(v []byte) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldNEQ(FieldWrappedKey, v))
+}
+
+// WrappedKeyIn applies the In predicate on the "wrapped_key" field.
+func WrappedKeyIn(vs ...[]byte) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldIn(FieldWrappedKey, vs...))
+}
+
+// WrappedKeyNotIn applies the NotIn predicate on the "wrapped_key" field.
+func WrappedKeyNotIn(vs ...[]byte) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldNotIn(FieldWrappedKey, vs...))
+}
+
+// WrappedKeyGT applies the GT predicate on the "wrapped_key" field.
+func WrappedKeyGT(v []byte) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldGT(FieldWrappedKey, v))
+}
+
+// WrappedKeyGTE applies the GTE predicate on the "wrapped_key" field.
+func WrappedKeyGTE(v []byte) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldGTE(FieldWrappedKey, v))
+}
+
+// WrappedKeyLT applies the LT predicate on the "wrapped_key" field.
+func WrappedKeyLT(v []byte) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldLT(FieldWrappedKey, v))
+}
+
+// WrappedKeyLTE applies the LTE predicate on the "wrapped_key" field.
+func WrappedKeyLTE(v []byte) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldLTE(FieldWrappedKey, v))
+}
+
+// MasterKeyIDEQ applies the EQ predicate on the "master_key_id" field.
+func MasterKeyIDEQ(v string) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldEQ(FieldMasterKeyID, v))
+}
+
+// MasterKeyIDNEQ applies the NEQ predicate on the "master_key_id" field.
+func MasterKeyIDNEQ(v string) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldNEQ(FieldMasterKeyID, v))
+}
+
+// MasterKeyIDIn applies the In predicate on the "master_key_id" field.
+func MasterKeyIDIn(vs ...string) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldIn(FieldMasterKeyID, vs...))
+}
+
+// MasterKeyIDNotIn applies the NotIn predicate on the "master_key_id" field.
+func MasterKeyIDNotIn(vs ...string) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldNotIn(FieldMasterKeyID, vs...))
+}
+
+// MasterKeyIDGT applies the GT predicate on the "master_key_id" field.
+func MasterKeyIDGT(v string) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldGT(FieldMasterKeyID, v))
+}
+
+// MasterKeyIDGTE applies the GTE predicate on the "master_key_id" field.
+func MasterKeyIDGTE(v string) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldGTE(FieldMasterKeyID, v))
+}
+
+// MasterKeyIDLT applies the LT predicate on the "master_key_id" field.
+func MasterKeyIDLT(v string) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldLT(FieldMasterKeyID, v))
+}
+
+// MasterKeyIDLTE applies the LTE predicate on the "master_key_id" field.
+func MasterKeyIDLTE(v string) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldLTE(FieldMasterKeyID, v))
+}
+
+// MasterKeyIDContains applies the Contains predicate on the "master_key_id" field.
+func MasterKeyIDContains(v string) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldContains(FieldMasterKeyID, v))
+}
+
+// MasterKeyIDHasPrefix applies the HasPrefix predicate on the "master_key_id" field.
+func MasterKeyIDHasPrefix(v string) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldHasPrefix(FieldMasterKeyID, v))
+}
+
+// MasterKeyIDHasSuffix applies the HasSuffix predicate on the "master_key_id" field.
+func MasterKeyIDHasSuffix(v string) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldHasSuffix(FieldMasterKeyID, v))
+}
+
+// MasterKeyIDEqualFold applies the EqualFold predicate on the "master_key_id" field.
+func MasterKeyIDEqualFold(v string) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldEqualFold(FieldMasterKeyID, v))
+}
+
+// MasterKeyIDContainsFold applies the ContainsFold predicate on the "master_key_id" field.
+func MasterKeyIDContainsFold(v string) predicate.TenantKey {
+	return predicate.TenantKey(sql.FieldContainsFold(FieldMasterKeyID, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TenantKey) predicate.TenantKey {
+	return predicate.TenantKey(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TenantKey) predicate.TenantKey {
+	return predicate.TenantKey(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TenantKey) predicate.TenantKey {
+	return predicate.TenantKey(sql.NotPredicates(p))
+}
@@ -0,0 +1,765 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/tenantkey"
+)
+
+// TenantKeyCreate is the builder for creating a TenantKey entity.
+type TenantKeyCreate struct {
+	config
+	mutation *TenantKeyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *TenantKeyCreate) SetCreateTime(v time.Time) *TenantKeyCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *TenantKeyCreate) SetNillableCreateTime(v *time.Time) *TenantKeyCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *TenantKeyCreate) SetUpdateTime(v time.Time) *TenantKeyCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *TenantKeyCreate) SetNillableUpdateTime(v *time.Time) *TenantKeyCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *TenantKeyCreate) SetDeleteTime(v time.Time) *TenantKeyCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *TenantKeyCreate) SetNillableDeleteTime(v *time.Time) *TenantKeyCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *TenantKeyCreate) SetTenantID(v uint32) *TenantKeyCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *TenantKeyCreate) SetNillableTenantID(v *uint32) *TenantKeyCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetWrappedKey sets the "wrapped_key" field.
+func (_c *TenantKeyCreate) SetWrappedKey(v []byte) *TenantKeyCreate {
+	_c.mutation.SetWrappedKey(v)
+	return _c
+}
+
+// SetMasterKeyID sets the "master_key_id" field.
+func (_c *TenantKeyCreate) SetMasterKeyID(v string) *TenantKeyCreate {
+	_c.mutation.SetMasterKeyID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TenantKeyCreate) SetID(v uint32) *TenantKeyCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the TenantKeyMutation object of the builder.
+func (_c *TenantKeyCreate) Mutation() *TenantKeyMutation {
+	return _c.mutation
+}
+
+// Save creates the TenantKey in the database.
+func (_c *TenantKeyCreate) Save(ctx context.Context) (*TenantKey, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TenantKeyCreate) SaveX(ctx context.Context) *TenantKey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TenantKeyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TenantKeyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TenantKeyCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := tenantkey.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TenantKeyCreate) check() error {
+	if _, ok := _c.mutation.WrappedKey(); !ok {
+		return &ValidationError{Name: "wrapped_key", err: errors.New(`ent: missing required field "TenantKey.wrapped_key"`)}
+	}
+	if _, ok := _c.mutation.MasterKeyID(); !ok {
+		return &ValidationError{Name: "master_key_id", err: errors.New(`ent: missing required field "TenantKey.master_key_id"`)}
+	}
+	if v, ok := _c.mutation.MasterKeyID(); ok {
+		if err := tenantkey.MasterKeyIDValidator(v); err != nil {
+			return &ValidationError{Name: "master_key_id", err: fmt.Errorf(`ent: validator failed for field "TenantKey.master_key_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := tenantkey.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "TenantKey.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *TenantKeyCreate) sqlSave(ctx context.Context) (*TenantKey, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TenantKeyCreate) createSpec() (*TenantKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TenantKey{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(tenantkey.Table, sqlgraph.NewFieldSpec(tenantkey.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(tenantkey.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(tenantkey.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(tenantkey.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(tenantkey.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.WrappedKey(); ok {
+		_spec.SetField(tenantkey.FieldWrappedKey, field.TypeBytes, value)
+		_node.WrappedKey = value
+	}
+	if value, ok := _c.mutation.MasterKeyID(); ok {
+		_spec.SetField(tenantkey.FieldMasterKeyID, field.TypeString, value)
+		_node.MasterKeyID = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TenantKey.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TenantKeyUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TenantKeyCreate) OnConflict(opts ...sql.ConflictOption) *TenantKeyUpsertOne {
+	_c.conflict = opts
+	return &TenantKeyUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TenantKey.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TenantKeyCreate) OnConflictColumns(columns ...string) *TenantKeyUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TenantKeyUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// TenantKeyUpsertOne is the builder for "upsert"-ing
+	//  one TenantKey node.
+	TenantKeyUpsertOne struct {
+		create *TenantKeyCreate
+	}
+
+	// TenantKeyUpsert is the "OnConflict" setter.
+	TenantKeyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *TenantKeyUpsert) SetUpdateTime(v time.Time) *TenantKeyUpsert {
+	u.Set(tenantkey.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *TenantKeyUpsert) UpdateUpdateTime() *TenantKeyUpsert {
+	u.SetExcluded(tenantkey.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *TenantKeyUpsert) ClearUpdateTime() *TenantKeyUpsert {
+	u.SetNull(tenantkey.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *TenantKeyUpsert) SetDeleteTime(v time.Time) *TenantKeyUpsert {
+	u.Set(tenantkey.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *TenantKeyUpsert) UpdateDeleteTime() *TenantKeyUpsert {
+	u.SetExcluded(tenantkey.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *TenantKeyUpsert) ClearDeleteTime() *TenantKeyUpsert {
+	u.SetNull(tenantkey.FieldDeleteTime)
+	return u
+}
+
+// SetWrappedKey sets the "wrapped_key" field.
+func (u *TenantKeyUpsert) SetWrappedKey(v []byte) *TenantKeyUpsert {
+	u.Set(tenantkey.FieldWrappedKey, v)
+	return u
+}
+
+// UpdateWrappedKey sets the "wrapped_key" field to the value that was provided on create.
+func (u *TenantKeyUpsert) UpdateWrappedKey() *TenantKeyUpsert {
+	u.SetExcluded(tenantkey.FieldWrappedKey)
+	return u
+}
+
+// SetMasterKeyID sets the "master_key_id" field.
+func (u *TenantKeyUpsert) SetMasterKeyID(v string) *TenantKeyUpsert {
+	u.Set(tenantkey.FieldMasterKeyID, v)
+	return u
+}
+
+// UpdateMasterKeyID sets the "master_key_id" field to the value that was provided on create.
+func (u *TenantKeyUpsert) UpdateMasterKeyID() *TenantKeyUpsert {
+	u.SetExcluded(tenantkey.FieldMasterKeyID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TenantKey.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tenantkey.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TenantKeyUpsertOne) UpdateNewValues() *TenantKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(tenantkey.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(tenantkey.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(tenantkey.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TenantKey.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TenantKeyUpsertOne) Ignore() *TenantKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TenantKeyUpsertOne) DoNothing() *TenantKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TenantKeyCreate.OnConflict
+// documentation for more info.
+func (u *TenantKeyUpsertOne) Update(set func(*TenantKeyUpsert)) *TenantKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TenantKeyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *TenantKeyUpsertOne) SetUpdateTime(v time.Time) *TenantKeyUpsertOne {
+	return u.Update(func(s *TenantKeyUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *TenantKeyUpsertOne) UpdateUpdateTime() *TenantKeyUpsertOne {
+	return u.Update(func(s *TenantKeyUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *TenantKeyUpsertOne) ClearUpdateTime() *TenantKeyUpsertOne {
+	return u.Update(func(s *TenantKeyUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *TenantKeyUpsertOne) SetDeleteTime(v time.Time) *TenantKeyUpsertOne {
+	return u.Update(func(s *TenantKeyUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *TenantKeyUpsertOne) UpdateDeleteTime() *TenantKeyUpsertOne {
+	return u.Update(func(s *TenantKeyUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *TenantKeyUpsertOne) ClearDeleteTime() *TenantKeyUpsertOne {
+	return u.Update(func(s *TenantKeyUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetWrappedKey sets the "wrapped_key" field.
+func (u *TenantKeyUpsertOne) SetWrappedKey(v []byte) *TenantKeyUpsertOne {
+	return u.Update(func(s *TenantKeyUpsert) {
+		s.SetWrappedKey(v)
+	})
+}
+
+// UpdateWrappedKey sets the "wrapped_key" field to the value that was provided on create.
+func (u *TenantKeyUpsertOne) UpdateWrappedKey() *TenantKeyUpsertOne {
+	return u.Update(func(s *TenantKeyUpsert) {
+		s.UpdateWrappedKey()
+	})
+}
+
+// SetMasterKeyID sets the "master_key_id" field.
+func (u *TenantKeyUpsertOne) SetMasterKeyID(v string) *TenantKeyUpsertOne {
+	return u.Update(func(s *TenantKeyUpsert) {
+		s.SetMasterKeyID(v)
+	})
+}
+
+// UpdateMasterKeyID sets the "master_key_id" field to the value that was provided on create.
+func (u *TenantKeyUpsertOne) UpdateMasterKeyID() *TenantKeyUpsertOne {
+	return u.Update(func(s *TenantKeyUpsert) {
+		s.UpdateMasterKeyID()
+	})
+}
+
+// Exec executes the query.
+func (u *TenantKeyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TenantKeyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TenantKeyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TenantKeyUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TenantKeyUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TenantKeyCreateBulk is the builder for creating many TenantKey entities in bulk.
+type TenantKeyCreateBulk struct {
+	config
+	err      error
+	builders []*TenantKeyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TenantKey entities in the database.
+func (_c *TenantKeyCreateBulk) Save(ctx context.Context) ([]*TenantKey, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TenantKey, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TenantKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TenantKeyCreateBulk) SaveX(ctx context.Context) []*TenantKey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TenantKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TenantKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TenantKey.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TenantKeyUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TenantKeyCreateBulk) OnConflict(opts ...sql.ConflictOption) *TenantKeyUpsertBulk {
+	_c.conflict = opts
+	return &TenantKeyUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TenantKey.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TenantKeyCreateBulk) OnConflictColumns(columns ...string) *TenantKeyUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TenantKeyUpsertBulk{
+		create: _c,
+	}
+}
+
+// TenantKeyUpsertBulk is the builder for "upsert"-ing
+// a bulk of TenantKey nodes.
+type TenantKeyUpsertBulk struct {
+	create *TenantKeyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TenantKey.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tenantkey.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TenantKeyUpsertBulk) UpdateNewValues() *TenantKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(tenantkey.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(tenantkey.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(tenantkey.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TenantKey.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TenantKeyUpsertBulk) Ignore() *TenantKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TenantKeyUpsertBulk) DoNothing() *TenantKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TenantKeyCreateBulk.OnConflict
+// documentation for more info.
+func (u *TenantKeyUpsertBulk) Update(set func(*TenantKeyUpsert)) *TenantKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TenantKeyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *TenantKeyUpsertBulk) SetUpdateTime(v time.Time) *TenantKeyUpsertBulk {
+	return u.Update(func(s *TenantKeyUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *TenantKeyUpsertBulk) UpdateUpdateTime() *TenantKeyUpsertBulk {
+	return u.Update(func(s *TenantKeyUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *TenantKeyUpsertBulk) ClearUpdateTime() *TenantKeyUpsertBulk {
+	return u.Update(func(s *TenantKeyUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *TenantKeyUpsertBulk) SetDeleteTime(v time.Time) *TenantKeyUpsertBulk {
+	return u.Update(func(s *TenantKeyUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *TenantKeyUpsertBulk) UpdateDeleteTime() *TenantKeyUpsertBulk {
+	return u.Update(func(s *TenantKeyUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *TenantKeyUpsertBulk) ClearDeleteTime() *TenantKeyUpsertBulk {
+	return u.Update(func(s *TenantKeyUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetWrappedKey sets the "wrapped_key" field.
+func (u *TenantKeyUpsertBulk) SetWrappedKey(v []byte) *TenantKeyUpsertBulk {
+	return u.Update(func(s *TenantKeyUpsert) {
+		s.SetWrappedKey(v)
+	})
+}
+
+// UpdateWrappedKey sets the "wrapped_key" field to the value that was provided on create.
+func (u *TenantKeyUpsertBulk) UpdateWrappedKey() *TenantKeyUpsertBulk {
+	return u.Update(func(s *TenantKeyUpsert) {
+		s.UpdateWrappedKey()
+	})
+}
+
+// SetMasterKeyID sets the "master_key_id" field.
+func (u *TenantKeyUpsertBulk) SetMasterKeyID(v string) *TenantKeyUpsertBulk {
+	return u.Update(func(s *TenantKeyUpsert) {
+		s.SetMasterKeyID(v)
+	})
+}
+
+// UpdateMasterKeyID sets the "master_key_id" field to the value that was provided on create.
+func (u *TenantKeyUpsertBulk) UpdateMasterKeyID() *TenantKeyUpsertBulk {
+	return u.Update(func(s *TenantKeyUpsert) {
+		s.UpdateMasterKeyID()
+	})
+}
+
+// Exec executes the query.
+func (u *TenantKeyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TenantKeyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TenantKeyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TenantKeyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,386 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/tenantkey"
+)
+
+// TenantKeyUpdate is the builder for updating TenantKey entities.
+type TenantKeyUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TenantKeyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TenantKeyUpdate builder.
+func (_u *TenantKeyUpdate) Where(ps ...predicate.TenantKey) *TenantKeyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *TenantKeyUpdate) SetUpdateTime(v time.Time) *TenantKeyUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *TenantKeyUpdate) SetNillableUpdateTime(v *time.Time) *TenantKeyUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *TenantKeyUpdate) ClearUpdateTime() *TenantKeyUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *TenantKeyUpdate) SetDeleteTime(v time.Time) *TenantKeyUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *TenantKeyUpdate) SetNillableDeleteTime(v *time.Time) *TenantKeyUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *TenantKeyUpdate) ClearDeleteTime() *TenantKeyUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetWrappedKey sets the "wrapped_key" field.
+func (_u *TenantKeyUpdate) SetWrappedKey(v []byte) *TenantKeyUpdate {
+	_u.mutation.SetWrappedKey(v)
+	return _u
+}
+
+// SetMasterKeyID sets the "master_key_id" field.
+func (_u *TenantKeyUpdate) SetMasterKeyID(v string) *TenantKeyUpdate {
+	_u.mutation.SetMasterKeyID(v)
+	return _u
+}
+
+// SetNillableMasterKeyID sets the "master_key_id" field if the given value is not nil.
+func (_u *TenantKeyUpdate) SetNillableMasterKeyID(v *string) *TenantKeyUpdate {
+	if v != nil {
+		_u.SetMasterKeyID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the TenantKeyMutation object of the builder.
+func (_u *TenantKeyUpdate) Mutation() *TenantKeyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TenantKeyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TenantKeyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantKeyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TenantKeyUpdate) check() error {
+	if v, ok := _u.mutation.MasterKeyID(); ok {
+		if err := tenantkey.MasterKeyIDValidator(v); err != nil {
+			return &ValidationError{Name: "master_key_id", err: fmt.Errorf(`ent: validator failed for field "TenantKey.master_key_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TenantKeyUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TenantKeyUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TenantKeyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantkey.Table, tenantkey.Columns, sqlgraph.NewFieldSpec(tenantkey.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(tenantkey.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(tenantkey.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(tenantkey.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(tenantkey.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(tenantkey.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(tenantkey.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.WrappedKey(); ok {
+		_spec.SetField(tenantkey.FieldWrappedKey, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.MasterKeyID(); ok {
+		_spec.SetField(tenantkey.FieldMasterKeyID, field.TypeString, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TenantKeyUpdateOne is the builder for updating a single TenantKey entity.
+type TenantKeyUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TenantKeyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *TenantKeyUpdateOne) SetUpdateTime(v time.Time) *TenantKeyUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *TenantKeyUpdateOne) SetNillableUpdateTime(v *time.Time) *TenantKeyUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *TenantKeyUpdateOne) ClearUpdateTime() *TenantKeyUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *TenantKeyUpdateOne) SetDeleteTime(v time.Time) *TenantKeyUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *TenantKeyUpdateOne) SetNillableDeleteTime(v *time.Time) *TenantKeyUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *TenantKeyUpdateOne) ClearDeleteTime() *TenantKeyUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetWrappedKey sets the "wrapped_key" field.
+func (_u *TenantKeyUpdateOne) SetWrappedKey(v []byte) *TenantKeyUpdateOne {
+	_u.mutation.SetWrappedKey(v)
+	return _u
+}
+
+// SetMasterKeyID sets the "master_key_id" field.
+func (_u *TenantKeyUpdateOne) SetMasterKeyID(v string) *TenantKeyUpdateOne {
+	_u.mutation.SetMasterKeyID(v)
+	return _u
+}
+
+// SetNillableMasterKeyID sets the "master_key_id" field if the given value is not nil.
+func (_u *TenantKeyUpdateOne) SetNillableMasterKeyID(v *string) *TenantKeyUpdateOne {
+	if v != nil {
+		_u.SetMasterKeyID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the TenantKeyMutation object of the builder.
+func (_u *TenantKeyUpdateOne) Mutation() *TenantKeyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TenantKeyUpdate builder.
+func (_u *TenantKeyUpdateOne) Where(ps ...predicate.TenantKey) *TenantKeyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TenantKeyUpdateOne) Select(field string, fields ...string) *TenantKeyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TenantKey entity.
+func (_u *TenantKeyUpdateOne) Save(ctx context.Context) (*TenantKey, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantKeyUpdateOne) SaveX(ctx context.Context) *TenantKey {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TenantKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TenantKeyUpdateOne) check() error {
+	if v, ok := _u.mutation.MasterKeyID(); ok {
+		if err := tenantkey.MasterKeyIDValidator(v); err != nil {
+			return &ValidationError{Name: "master_key_id", err: fmt.Errorf(`ent: validator failed for field "TenantKey.master_key_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TenantKeyUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TenantKeyUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TenantKeyUpdateOne) sqlSave(ctx context.Context) (_node *TenantKey, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantkey.Table, tenantkey.Columns, sqlgraph.NewFieldSpec(tenantkey.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TenantKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tenantkey.FieldID)
+		for _, f := range fields {
+			if !tenantkey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tenantkey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(tenantkey.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(tenantkey.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(tenantkey.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(tenantkey.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(tenantkey.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(tenantkey.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.WrappedKey(); ok {
+		_spec.SetField(tenantkey.FieldWrappedKey, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.MasterKeyID(); ok {
+		_spec.SetField(tenantkey.FieldMasterKeyID, field.TypeString, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &TenantKey{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
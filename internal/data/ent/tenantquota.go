@@ -0,0 +1,216 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/tenantquota"
+)
+
+// TenantQuota is the model entity for the TenantQuota schema.
+type TenantQuota struct {
+	config `json:"-"`
+	// ID of the ent.
+	// id
+	ID uint32 `json:"id,omitempty"`
+	// 创建时间
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// 更新时间
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// 删除时间
+	DeleteTime *time.Time `json:"delete_time,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// Most documents the tenant may hold (null for no limit)
+	MaxDocuments *int64 `json:"max_documents,omitempty"`
+	// Most bytes the tenant's documents may take (null for no limit)
+	MaxBytes *int64 `json:"max_bytes,omitempty"`
+	// Most bytes the tenant may upload per calendar month (null for no limit)
+	MaxMonthlyUploadBytes *int64 `json:"max_monthly_upload_bytes,omitempty"`
+	// Month the upload counter refers to, e.g. 2026-10 (UTC)
+	UploadMonth string `json:"upload_month,omitempty"`
+	// Bytes uploaded in upload_month
+	MonthUploadBytes int64 `json:"month_upload_bytes,omitempty"`
+	selectValues     sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TenantQuota) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tenantquota.FieldID, tenantquota.FieldTenantID, tenantquota.FieldMaxDocuments, tenantquota.FieldMaxBytes, tenantquota.FieldMaxMonthlyUploadBytes, tenantquota.FieldMonthUploadBytes:
+			values[i] = new(sql.NullInt64)
+		case tenantquota.FieldUploadMonth:
+			values[i] = new(sql.NullString)
+		case tenantquota.FieldCreateTime, tenantquota.FieldUpdateTime, tenantquota.FieldDeleteTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TenantQuota fields.
+func (_m *TenantQuota) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tenantquota.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint32(value.Int64)
+		case tenantquota.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = new(time.Time)
+				*_m.CreateTime = value.Time
+			}
+		case tenantquota.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = new(time.Time)
+				*_m.UpdateTime = value.Time
+			}
+		case tenantquota.FieldDeleteTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_time", values[i])
+			} else if value.Valid {
+				_m.DeleteTime = new(time.Time)
+				*_m.DeleteTime = value.Time
+			}
+		case tenantquota.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case tenantquota.FieldMaxDocuments:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_documents", values[i])
+			} else if value.Valid {
+				_m.MaxDocuments = new(int64)
+				*_m.MaxDocuments = value.Int64
+			}
+		case tenantquota.FieldMaxBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_bytes", values[i])
+			} else if value.Valid {
+				_m.MaxBytes = new(int64)
+				*_m.MaxBytes = value.Int64
+			}
+		case tenantquota.FieldMaxMonthlyUploadBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_monthly_upload_bytes", values[i])
+			} else if value.Valid {
+				_m.MaxMonthlyUploadBytes = new(int64)
+				*_m.MaxMonthlyUploadBytes = value.Int64
+			}
+		case tenantquota.FieldUploadMonth:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field upload_month", values[i])
+			} else if value.Valid {
+				_m.UploadMonth = value.String
+			}
+		case tenantquota.FieldMonthUploadBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field month_upload_bytes", values[i])
+			} else if value.Valid {
+				_m.MonthUploadBytes = value.Int64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TenantQuota.
+// This includes values selected through modifiers, order, etc.
+func (_m *TenantQuota) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TenantQuota.
+// Note that you need to call TenantQuota.Unwrap() before calling this method if this TenantQuota
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TenantQuota) Update() *TenantQuotaUpdateOne {
+	return NewTenantQuotaClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TenantQuota entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TenantQuota) Unwrap() *TenantQuota {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TenantQuota is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TenantQuota) String() string {
+	var builder strings.Builder
+	builder.WriteString("TenantQuota(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeleteTime; v != nil {
+		builder.WriteString("delete_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.MaxDocuments; v != nil {
+		builder.WriteString("max_documents=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.MaxBytes; v != nil {
+		builder.WriteString("max_bytes=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.MaxMonthlyUploadBytes; v != nil {
+		builder.WriteString("max_monthly_upload_bytes=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("upload_month=")
+	builder.WriteString(_m.UploadMonth)
+	builder.WriteString(", ")
+	builder.WriteString("month_upload_bytes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MonthUploadBytes))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TenantQuotaSlice is a parsable slice of TenantQuota.
+type TenantQuotaSlice []*TenantQuota
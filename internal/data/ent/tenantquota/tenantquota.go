@@ -0,0 +1,130 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tenantquota
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the tenantquota type in the database.
+	Label = "tenant_quota"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldDeleteTime holds the string denoting the delete_time field in the database.
+	FieldDeleteTime = "delete_time"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldMaxDocuments holds the string denoting the max_documents field in the database.
+	FieldMaxDocuments = "max_documents"
+	// FieldMaxBytes holds the string denoting the max_bytes field in the database.
+	FieldMaxBytes = "max_bytes"
+	// FieldMaxMonthlyUploadBytes holds the string denoting the max_monthly_upload_bytes field in the database.
+	FieldMaxMonthlyUploadBytes = "max_monthly_upload_bytes"
+	// FieldUploadMonth holds the string denoting the upload_month field in the database.
+	FieldUploadMonth = "upload_month"
+	// FieldMonthUploadBytes holds the string denoting the month_upload_bytes field in the database.
+	FieldMonthUploadBytes = "month_upload_bytes"
+	// Table holds the table name of the tenantquota in the database.
+	Table = "paperless_tenant_quotas"
+)
+
+// Columns holds all SQL columns for tenantquota fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldDeleteTime,
+	FieldTenantID,
+	FieldMaxDocuments,
+	FieldMaxBytes,
+	FieldMaxMonthlyUploadBytes,
+	FieldUploadMonth,
+	FieldMonthUploadBytes,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/go-tangra/go-tangra-paperless/internal/data/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint32
+	// UploadMonthValidator is a validator for the "upload_month" field. It is called by the builders before save.
+	UploadMonthValidator func(string) error
+	// DefaultMonthUploadBytes holds the default value on creation for the "month_upload_bytes" field.
+	DefaultMonthUploadBytes int64
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(uint32) error
+)
+
+// OrderOption defines the ordering options for the TenantQuota queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByDeleteTime orders the results by the delete_time field.
+func ByDeleteTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeleteTime, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByMaxDocuments orders the results by the max_documents field.
+func ByMaxDocuments(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxDocuments, opts...).ToFunc()
+}
+
+// ByMaxBytes orders the results by the max_bytes field.
+func ByMaxBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxBytes, opts...).ToFunc()
+}
+
+// ByMaxMonthlyUploadBytes orders the results by the max_monthly_upload_bytes field.
+func ByMaxMonthlyUploadBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxMonthlyUploadBytes, opts...).ToFunc()
+}
+
+// ByUploadMonth orders the results by the upload_month field.
+func ByUploadMonth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUploadMonth, opts...).ToFunc()
+}
+
+// ByMonthUploadBytes orders the results by the month_upload_bytes field.
+func ByMonthUploadBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMonthUploadBytes, opts...).ToFunc()
+}
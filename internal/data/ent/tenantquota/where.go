@@ -0,0 +1,580 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tenantquota
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldEQ(FieldTenantID, v))
+}
+
+// MaxDocuments applies equality check predicate on the "max_documents" field. It's identical to MaxDocumentsEQ.
+func MaxDocuments(v int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldEQ(FieldMaxDocuments, v))
+}
+
+// MaxBytes applies equality check predicate on the "max_bytes" field. It's identical to MaxBytesEQ.
+func MaxBytes(v int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldEQ(FieldMaxBytes, v))
+}
+
+// MaxMonthlyUploadBytes applies equality check predicate on the "max_monthly_upload_bytes" field. It's identical to MaxMonthlyUploadBytesEQ.
+func MaxMonthlyUploadBytes(v int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldEQ(FieldMaxMonthlyUploadBytes, v))
+}
+
+// UploadMonth applies equality check predicate on the "upload_month" field. It's identical to UploadMonthEQ.
+func UploadMonth(v string) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldEQ(FieldUploadMonth, v))
+}
+
+// MonthUploadBytes applies equality check predicate on the "month_upload_bytes" field. It's identical to MonthUploadBytesEQ.
+func MonthUploadBytes(v int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldEQ(FieldMonthUploadBytes, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldNotNull(FieldTenantID))
+}
+
+// MaxDocumentsEQ applies the EQ predicate on the "max_documents" field.
+func MaxDocumentsEQ(v int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldEQ(FieldMaxDocuments, v))
+}
+
+// MaxDocumentsNEQ applies the NEQ predicate on the "max_documents" field.
+func MaxDocumentsNEQ(v int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldNEQ(FieldMaxDocuments, v))
+}
+
+// MaxDocumentsIn applies the In predicate on the "max_documents" field.
+func MaxDocumentsIn(vs ...int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldIn(FieldMaxDocuments, vs...))
+}
+
+// MaxDocumentsNotIn applies the NotIn predicate on the "max_documents" field.
+func MaxDocumentsNotIn(vs ...int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldNotIn(FieldMaxDocuments, vs...))
+}
+
+// MaxDocumentsGT applies the GT predicate on the "max_documents" field.
+func MaxDocumentsGT(v int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldGT(FieldMaxDocuments, v))
+}
+
+// MaxDocumentsGTE applies the GTE predicate on the "max_documents" field.
+func MaxDocumentsGTE(v int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldGTE(FieldMaxDocuments, v))
+}
+
+// MaxDocumentsLT applies the LT predicate on the "max_documents" field.
+func MaxDocumentsLT(v int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldLT(FieldMaxDocuments, v))
+}
+
+// MaxDocumentsLTE applies the LTE predicate on the "max_documents" field.
+func MaxDocumentsLTE(v int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldLTE(FieldMaxDocuments, v))
+}
+
+// MaxDocumentsIsNil applies the IsNil predicate on the "max_documents" field.
+func MaxDocumentsIsNil() predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldIsNull(FieldMaxDocuments))
+}
+
+// MaxDocumentsNotNil applies the NotNil predicate on the "max_documents" field.
+func MaxDocumentsNotNil() predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldNotNull(FieldMaxDocuments))
+}
+
+// MaxBytesEQ applies the EQ predicate on the "max_bytes" field.
+func MaxBytesEQ(v int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldEQ(FieldMaxBytes, v))
+}
+
+// MaxBytesNEQ applies the NEQ predicate on the "max_bytes" field.
+func MaxBytesNEQ(v int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldNEQ(FieldMaxBytes, v))
+}
+
+// MaxBytesIn applies the In predicate on the "max_bytes" field.
+func MaxBytesIn(vs ...int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldIn(FieldMaxBytes, vs...))
+}
+
+// MaxBytesNotIn applies the NotIn predicate on the "max_bytes" field.
+func MaxBytesNotIn(vs ...int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldNotIn(FieldMaxBytes, vs...))
+}
+
+// MaxBytesGT applies the GT predicate on the "max_bytes" field.
+func MaxBytesGT(v int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldGT(FieldMaxBytes, v))
+}
+
+// MaxBytesGTE applies the GTE predicate on the "max_bytes" field.
+func MaxBytesGTE(v int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldGTE(FieldMaxBytes, v))
+}
+
+// MaxBytesLT applies the LT predicate on the "max_bytes" field.
+func MaxBytesLT(v int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldLT(FieldMaxBytes, v))
+}
+
+// MaxBytesLTE applies the LTE predicate on the "max_bytes" field.
+func MaxBytesLTE(v int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldLTE(FieldMaxBytes, v))
+}
+
+// MaxBytesIsNil applies the IsNil predicate on the "max_bytes" field.
+func MaxBytesIsNil() predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldIsNull(FieldMaxBytes))
+}
+
+// MaxBytesNotNil applies the NotNil predicate on the "max_bytes" field.
+func MaxBytesNotNil() predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldNotNull(FieldMaxBytes))
+}
+
+// MaxMonthlyUploadBytesEQ applies the EQ predicate on the "max_monthly_upload_bytes" field.
+func MaxMonthlyUploadBytesEQ(v int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldEQ(FieldMaxMonthlyUploadBytes, v))
+}
+
+// MaxMonthlyUploadBytesNEQ applies the NEQ predicate on the "max_monthly_upload_bytes" field.
+func MaxMonthlyUploadBytesNEQ(v int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldNEQ(FieldMaxMonthlyUploadBytes, v))
+}
+
+// MaxMonthlyUploadBytesIn applies the In predicate on the "max_monthly_upload_bytes" field.
+func MaxMonthlyUploadBytesIn(vs ...int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldIn(FieldMaxMonthlyUploadBytes, vs...))
+}
+
+// MaxMonthlyUploadBytesNotIn applies the NotIn predicate on the "max_monthly_upload_bytes" field.
+func MaxMonthlyUploadBytesNotIn(vs ...int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldNotIn(FieldMaxMonthlyUploadBytes, vs...))
+}
+
+// MaxMonthlyUploadBytesGT applies the GT predicate on the "max_monthly_upload_bytes" field.
+func MaxMonthlyUploadBytesGT(v int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldGT(FieldMaxMonthlyUploadBytes, v))
+}
+
+// MaxMonthlyUploadBytesGTE applies the GTE predicate on the "max_monthly_upload_bytes" field.
+func MaxMonthlyUploadBytesGTE(v int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldGTE(FieldMaxMonthlyUploadBytes, v))
+}
+
+// MaxMonthlyUploadBytesLT applies the LT predicate on the "max_monthly_upload_bytes" field.
+func MaxMonthlyUploadBytesLT(v int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldLT(FieldMaxMonthlyUploadBytes, v))
+}
+
+// MaxMonthlyUploadBytesLTE applies the LTE predicate on the "max_monthly_upload_bytes" field.
+func MaxMonthlyUploadBytesLTE(v int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldLTE(FieldMaxMonthlyUploadBytes, v))
+}
+
+// MaxMonthlyUploadBytesIsNil applies the IsNil predicate on the "max_monthly_upload_bytes" field.
+func MaxMonthlyUploadBytesIsNil() predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldIsNull(FieldMaxMonthlyUploadBytes))
+}
+
+// MaxMonthlyUploadBytesNotNil applies the NotNil predicate on the "max_monthly_upload_bytes" field.
+func MaxMonthlyUploadBytesNotNil() predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldNotNull(FieldMaxMonthlyUploadBytes))
+}
+
+// UploadMonthEQ applies the EQ predicate on the "upload_month" field.
+func UploadMonthEQ(v string) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldEQ(FieldUploadMonth, v))
+}
+
+// UploadMonthNEQ applies the NEQ predicate on the "upload_month" field.
+func UploadMonthNEQ(v string) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldNEQ(FieldUploadMonth, v))
+}
+
+// UploadMonthIn applies the In predicate on the "upload_month" field.
+func UploadMonthIn(vs ...string) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldIn(FieldUploadMonth, vs...))
+}
+
+// UploadMonthNotIn applies the NotIn predicate on the "upload_month" field.
+func UploadMonthNotIn(vs ...string) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldNotIn(FieldUploadMonth, vs...))
+}
+
+// UploadMonthGT applies the GT predicate on the "upload_month" field.
+func UploadMonthGT(v string) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldGT(FieldUploadMonth, v))
+}
+
+// UploadMonthGTE applies the GTE predicate on the "upload_month" field.
+func UploadMonthGTE(v string) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldGTE(FieldUploadMonth, v))
+}
+
+// UploadMonthLT applies the LT predicate on the "upload_month" field.
+func UploadMonthLT(v string) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldLT(FieldUploadMonth, v))
+}
+
+// UploadMonthLTE applies the LTE predicate on the "upload_month" field.
+func UploadMonthLTE(v string) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldLTE(FieldUploadMonth, v))
+}
+
+// UploadMonthContains applies the Contains predicate on the "upload_month" field.
+func UploadMonthContains(v string) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldContains(FieldUploadMonth, v))
+}
+
+// UploadMonthHasPrefix applies the HasPrefix predicate on the "upload_month" field.
+func UploadMonthHasPrefix(v string) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldHasPrefix(FieldUploadMonth, v))
+}
+
+// UploadMonthHasSuffix applies the HasSuffix predicate on the "upload_month" field.
+func UploadMonthHasSuffix(v string) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldHasSuffix(FieldUploadMonth, v))
+}
+
+// UploadMonthIsNil applies the IsNil predicate on the "upload_month" field.
+func UploadMonthIsNil() predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldIsNull(FieldUploadMonth))
+}
+
+// UploadMonthNotNil applies the NotNil predicate on the "upload_month" field.
+func UploadMonthNotNil() predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldNotNull(FieldUploadMonth))
+}
+
+// UploadMonthEqualFold applies the EqualFold predicate on the "upload_month" field.
+func UploadMonthEqualFold(v string) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldEqualFold(FieldUploadMonth, v))
+}
+
+// UploadMonthContainsFold applies the ContainsFold predicate on the "upload_month" field.
+func UploadMonthContainsFold(v string) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldContainsFold(FieldUploadMonth, v))
+}
+
+// MonthUploadBytesEQ applies the EQ predicate on the "month_upload_bytes" field.
+func MonthUploadBytesEQ(v int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldEQ(FieldMonthUploadBytes, v))
+}
+
+// MonthUploadBytesNEQ applies the NEQ predicate on the "month_upload_bytes" field.
+func MonthUploadBytesNEQ(v int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldNEQ(FieldMonthUploadBytes, v))
+}
+
+// MonthUploadBytesIn applies the In predicate on the "month_upload_bytes" field.
+func MonthUploadBytesIn(vs ...int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldIn(FieldMonthUploadBytes, vs...))
+}
+
+// MonthUploadBytesNotIn applies the NotIn predicate on the "month_upload_bytes" field.
+func MonthUploadBytesNotIn(vs ...int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldNotIn(FieldMonthUploadBytes, vs...))
+}
+
+// MonthUploadBytesGT applies the GT predicate on the "month_upload_bytes" field.
+func MonthUploadBytesGT(v int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldGT(FieldMonthUploadBytes, v))
+}
+
+// MonthUploadBytesGTE applies the GTE predicate on the "month_upload_bytes" field.
+func MonthUploadBytesGTE(v int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldGTE(FieldMonthUploadBytes, v))
+}
+
+// MonthUploadBytesLT applies the LT predicate on the "month_upload_bytes" field.
+func MonthUploadBytesLT(v int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldLT(FieldMonthUploadBytes, v))
+}
+
+// MonthUploadBytesLTE applies the LTE predicate on the "month_upload_bytes" field.
+func MonthUploadBytesLTE(v int64) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.FieldLTE(FieldMonthUploadBytes, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TenantQuota) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TenantQuota) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TenantQuota) predicate.TenantQuota {
+	return predicate.TenantQuota(sql.NotPredicates(p))
+}
@@ -0,0 +1,1116 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/tenantquota"
+)
+
+// TenantQuotaCreate is the builder for creating a TenantQuota entity.
+type TenantQuotaCreate struct {
+	config
+	mutation *TenantQuotaMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *TenantQuotaCreate) SetCreateTime(v time.Time) *TenantQuotaCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *TenantQuotaCreate) SetNillableCreateTime(v *time.Time) *TenantQuotaCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *TenantQuotaCreate) SetUpdateTime(v time.Time) *TenantQuotaCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *TenantQuotaCreate) SetNillableUpdateTime(v *time.Time) *TenantQuotaCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *TenantQuotaCreate) SetDeleteTime(v time.Time) *TenantQuotaCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *TenantQuotaCreate) SetNillableDeleteTime(v *time.Time) *TenantQuotaCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *TenantQuotaCreate) SetTenantID(v uint32) *TenantQuotaCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *TenantQuotaCreate) SetNillableTenantID(v *uint32) *TenantQuotaCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetMaxDocuments sets the "max_documents" field.
+func (_c *TenantQuotaCreate) SetMaxDocuments(v int64) *TenantQuotaCreate {
+	_c.mutation.SetMaxDocuments(v)
+	return _c
+}
+
+// SetNillableMaxDocuments sets the "max_documents" field if the given value is not nil.
+func (_c *TenantQuotaCreate) SetNillableMaxDocuments(v *int64) *TenantQuotaCreate {
+	if v != nil {
+		_c.SetMaxDocuments(*v)
+	}
+	return _c
+}
+
+// SetMaxBytes sets the "max_bytes" field.
+func (_c *TenantQuotaCreate) SetMaxBytes(v int64) *TenantQuotaCreate {
+	_c.mutation.SetMaxBytes(v)
+	return _c
+}
+
+// SetNillableMaxBytes sets the "max_bytes" field if the given value is not nil.
+func (_c *TenantQuotaCreate) SetNillableMaxBytes(v *int64) *TenantQuotaCreate {
+	if v != nil {
+		_c.SetMaxBytes(*v)
+	}
+	return _c
+}
+
+// SetMaxMonthlyUploadBytes sets the "max_monthly_upload_bytes" field.
+func (_c *TenantQuotaCreate) SetMaxMonthlyUploadBytes(v int64) *TenantQuotaCreate {
+	_c.mutation.SetMaxMonthlyUploadBytes(v)
+	return _c
+}
+
+// SetNillableMaxMonthlyUploadBytes sets the "max_monthly_upload_bytes" field if the given value is not nil.
+func (_c *TenantQuotaCreate) SetNillableMaxMonthlyUploadBytes(v *int64) *TenantQuotaCreate {
+	if v != nil {
+		_c.SetMaxMonthlyUploadBytes(*v)
+	}
+	return _c
+}
+
+// SetUploadMonth sets the "upload_month" field.
+func (_c *TenantQuotaCreate) SetUploadMonth(v string) *TenantQuotaCreate {
+	_c.mutation.SetUploadMonth(v)
+	return _c
+}
+
+// SetNillableUploadMonth sets the "upload_month" field if the given value is not nil.
+func (_c *TenantQuotaCreate) SetNillableUploadMonth(v *string) *TenantQuotaCreate {
+	if v != nil {
+		_c.SetUploadMonth(*v)
+	}
+	return _c
+}
+
+// SetMonthUploadBytes sets the "month_upload_bytes" field.
+func (_c *TenantQuotaCreate) SetMonthUploadBytes(v int64) *TenantQuotaCreate {
+	_c.mutation.SetMonthUploadBytes(v)
+	return _c
+}
+
+// SetNillableMonthUploadBytes sets the "month_upload_bytes" field if the given value is not nil.
+func (_c *TenantQuotaCreate) SetNillableMonthUploadBytes(v *int64) *TenantQuotaCreate {
+	if v != nil {
+		_c.SetMonthUploadBytes(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TenantQuotaCreate) SetID(v uint32) *TenantQuotaCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the TenantQuotaMutation object of the builder.
+func (_c *TenantQuotaCreate) Mutation() *TenantQuotaMutation {
+	return _c.mutation
+}
+
+// Save creates the TenantQuota in the database.
+func (_c *TenantQuotaCreate) Save(ctx context.Context) (*TenantQuota, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TenantQuotaCreate) SaveX(ctx context.Context) *TenantQuota {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TenantQuotaCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TenantQuotaCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TenantQuotaCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := tenantquota.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.MonthUploadBytes(); !ok {
+		v := tenantquota.DefaultMonthUploadBytes
+		_c.mutation.SetMonthUploadBytes(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TenantQuotaCreate) check() error {
+	if v, ok := _c.mutation.UploadMonth(); ok {
+		if err := tenantquota.UploadMonthValidator(v); err != nil {
+			return &ValidationError{Name: "upload_month", err: fmt.Errorf(`ent: validator failed for field "TenantQuota.upload_month": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MonthUploadBytes(); !ok {
+		return &ValidationError{Name: "month_upload_bytes", err: errors.New(`ent: missing required field "TenantQuota.month_upload_bytes"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := tenantquota.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "TenantQuota.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *TenantQuotaCreate) sqlSave(ctx context.Context) (*TenantQuota, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TenantQuotaCreate) createSpec() (*TenantQuota, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TenantQuota{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(tenantquota.Table, sqlgraph.NewFieldSpec(tenantquota.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(tenantquota.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(tenantquota.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(tenantquota.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(tenantquota.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.MaxDocuments(); ok {
+		_spec.SetField(tenantquota.FieldMaxDocuments, field.TypeInt64, value)
+		_node.MaxDocuments = &value
+	}
+	if value, ok := _c.mutation.MaxBytes(); ok {
+		_spec.SetField(tenantquota.FieldMaxBytes, field.TypeInt64, value)
+		_node.MaxBytes = &value
+	}
+	if value, ok := _c.mutation.MaxMonthlyUploadBytes(); ok {
+		_spec.SetField(tenantquota.FieldMaxMonthlyUploadBytes, field.TypeInt64, value)
+		_node.MaxMonthlyUploadBytes = &value
+	}
+	if value, ok := _c.mutation.UploadMonth(); ok {
+		_spec.SetField(tenantquota.FieldUploadMonth, field.TypeString, value)
+		_node.UploadMonth = value
+	}
+	if value, ok := _c.mutation.MonthUploadBytes(); ok {
+		_spec.SetField(tenantquota.FieldMonthUploadBytes, field.TypeInt64, value)
+		_node.MonthUploadBytes = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TenantQuota.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TenantQuotaUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TenantQuotaCreate) OnConflict(opts ...sql.ConflictOption) *TenantQuotaUpsertOne {
+	_c.conflict = opts
+	return &TenantQuotaUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TenantQuota.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TenantQuotaCreate) OnConflictColumns(columns ...string) *TenantQuotaUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TenantQuotaUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// TenantQuotaUpsertOne is the builder for "upsert"-ing
+	//  one TenantQuota node.
+	TenantQuotaUpsertOne struct {
+		create *TenantQuotaCreate
+	}
+
+	// TenantQuotaUpsert is the "OnConflict" setter.
+	TenantQuotaUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *TenantQuotaUpsert) SetUpdateTime(v time.Time) *TenantQuotaUpsert {
+	u.Set(tenantquota.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *TenantQuotaUpsert) UpdateUpdateTime() *TenantQuotaUpsert {
+	u.SetExcluded(tenantquota.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *TenantQuotaUpsert) ClearUpdateTime() *TenantQuotaUpsert {
+	u.SetNull(tenantquota.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *TenantQuotaUpsert) SetDeleteTime(v time.Time) *TenantQuotaUpsert {
+	u.Set(tenantquota.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *TenantQuotaUpsert) UpdateDeleteTime() *TenantQuotaUpsert {
+	u.SetExcluded(tenantquota.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *TenantQuotaUpsert) ClearDeleteTime() *TenantQuotaUpsert {
+	u.SetNull(tenantquota.FieldDeleteTime)
+	return u
+}
+
+// SetMaxDocuments sets the "max_documents" field.
+func (u *TenantQuotaUpsert) SetMaxDocuments(v int64) *TenantQuotaUpsert {
+	u.Set(tenantquota.FieldMaxDocuments, v)
+	return u
+}
+
+// UpdateMaxDocuments sets the "max_documents" field to the value that was provided on create.
+func (u *TenantQuotaUpsert) UpdateMaxDocuments() *TenantQuotaUpsert {
+	u.SetExcluded(tenantquota.FieldMaxDocuments)
+	return u
+}
+
+// AddMaxDocuments adds v to the "max_documents" field.
+func (u *TenantQuotaUpsert) AddMaxDocuments(v int64) *TenantQuotaUpsert {
+	u.Add(tenantquota.FieldMaxDocuments, v)
+	return u
+}
+
+// ClearMaxDocuments clears the value of the "max_documents" field.
+func (u *TenantQuotaUpsert) ClearMaxDocuments() *TenantQuotaUpsert {
+	u.SetNull(tenantquota.FieldMaxDocuments)
+	return u
+}
+
+// SetMaxBytes sets the "max_bytes" field.
+func (u *TenantQuotaUpsert) SetMaxBytes(v int64) *TenantQuotaUpsert {
+	u.Set(tenantquota.FieldMaxBytes, v)
+	return u
+}
+
+// UpdateMaxBytes sets the "max_bytes" field to the value that was provided on create.
+func (u *TenantQuotaUpsert) UpdateMaxBytes() *TenantQuotaUpsert {
+	u.SetExcluded(tenantquota.FieldMaxBytes)
+	return u
+}
+
+// AddMaxBytes adds v to the "max_bytes" field.
+func (u *TenantQuotaUpsert) AddMaxBytes(v int64) *TenantQuotaUpsert {
+	u.Add(tenantquota.FieldMaxBytes, v)
+	return u
+}
+
+// ClearMaxBytes clears the value of the "max_bytes" field.
+func (u *TenantQuotaUpsert) ClearMaxBytes() *TenantQuotaUpsert {
+	u.SetNull(tenantquota.FieldMaxBytes)
+	return u
+}
+
+// SetMaxMonthlyUploadBytes sets the "max_monthly_upload_bytes" field.
+func (u *TenantQuotaUpsert) SetMaxMonthlyUploadBytes(v int64) *TenantQuotaUpsert {
+	u.Set(tenantquota.FieldMaxMonthlyUploadBytes, v)
+	return u
+}
+
+// UpdateMaxMonthlyUploadBytes sets the "max_monthly_upload_bytes" field to the value that was provided on create.
+func (u *TenantQuotaUpsert) UpdateMaxMonthlyUploadBytes() *TenantQuotaUpsert {
+	u.SetExcluded(tenantquota.FieldMaxMonthlyUploadBytes)
+	return u
+}
+
+// AddMaxMonthlyUploadBytes adds v to the "max_monthly_upload_bytes" field.
+func (u *TenantQuotaUpsert) AddMaxMonthlyUploadBytes(v int64) *TenantQuotaUpsert {
+	u.Add(tenantquota.FieldMaxMonthlyUploadBytes, v)
+	return u
+}
+
+// ClearMaxMonthlyUploadBytes clears the value of the "max_monthly_upload_bytes" field.
+func (u *TenantQuotaUpsert) ClearMaxMonthlyUploadBytes() *TenantQuotaUpsert {
+	u.SetNull(tenantquota.FieldMaxMonthlyUploadBytes)
+	return u
+}
+
+// SetUploadMonth sets the "upload_month" field.
+func (u *TenantQuotaUpsert) SetUploadMonth(v string) *TenantQuotaUpsert {
+	u.Set(tenantquota.FieldUploadMonth, v)
+	return u
+}
+
+// UpdateUploadMonth sets the "upload_month" field to the value that was provided on create.
+func (u *TenantQuotaUpsert) UpdateUploadMonth() *TenantQuotaUpsert {
+	u.SetExcluded(tenantquota.FieldUploadMonth)
+	return u
+}
+
+// ClearUploadMonth clears the value of the "upload_month" field.
+func (u *TenantQuotaUpsert) ClearUploadMonth() *TenantQuotaUpsert {
+	u.SetNull(tenantquota.FieldUploadMonth)
+	return u
+}
+
+// SetMonthUploadBytes sets the "month_upload_bytes" field.
+func (u *TenantQuotaUpsert) SetMonthUploadBytes(v int64) *TenantQuotaUpsert {
+	u.Set(tenantquota.FieldMonthUploadBytes, v)
+	return u
+}
+
+// UpdateMonthUploadBytes sets the "month_upload_bytes" field to the value that was provided on create.
+func (u *TenantQuotaUpsert) UpdateMonthUploadBytes() *TenantQuotaUpsert {
+	u.SetExcluded(tenantquota.FieldMonthUploadBytes)
+	return u
+}
+
+// AddMonthUploadBytes adds v to the "month_upload_bytes" field.
+func (u *TenantQuotaUpsert) AddMonthUploadBytes(v int64) *TenantQuotaUpsert {
+	u.Add(tenantquota.FieldMonthUploadBytes, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TenantQuota.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tenantquota.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TenantQuotaUpsertOne) UpdateNewValues() *TenantQuotaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(tenantquota.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(tenantquota.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(tenantquota.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TenantQuota.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TenantQuotaUpsertOne) Ignore() *TenantQuotaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TenantQuotaUpsertOne) DoNothing() *TenantQuotaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TenantQuotaCreate.OnConflict
+// documentation for more info.
+func (u *TenantQuotaUpsertOne) Update(set func(*TenantQuotaUpsert)) *TenantQuotaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TenantQuotaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *TenantQuotaUpsertOne) SetUpdateTime(v time.Time) *TenantQuotaUpsertOne {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *TenantQuotaUpsertOne) UpdateUpdateTime() *TenantQuotaUpsertOne {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *TenantQuotaUpsertOne) ClearUpdateTime() *TenantQuotaUpsertOne {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *TenantQuotaUpsertOne) SetDeleteTime(v time.Time) *TenantQuotaUpsertOne {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *TenantQuotaUpsertOne) UpdateDeleteTime() *TenantQuotaUpsertOne {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *TenantQuotaUpsertOne) ClearDeleteTime() *TenantQuotaUpsertOne {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetMaxDocuments sets the "max_documents" field.
+func (u *TenantQuotaUpsertOne) SetMaxDocuments(v int64) *TenantQuotaUpsertOne {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.SetMaxDocuments(v)
+	})
+}
+
+// AddMaxDocuments adds v to the "max_documents" field.
+func (u *TenantQuotaUpsertOne) AddMaxDocuments(v int64) *TenantQuotaUpsertOne {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.AddMaxDocuments(v)
+	})
+}
+
+// UpdateMaxDocuments sets the "max_documents" field to the value that was provided on create.
+func (u *TenantQuotaUpsertOne) UpdateMaxDocuments() *TenantQuotaUpsertOne {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.UpdateMaxDocuments()
+	})
+}
+
+// ClearMaxDocuments clears the value of the "max_documents" field.
+func (u *TenantQuotaUpsertOne) ClearMaxDocuments() *TenantQuotaUpsertOne {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.ClearMaxDocuments()
+	})
+}
+
+// SetMaxBytes sets the "max_bytes" field.
+func (u *TenantQuotaUpsertOne) SetMaxBytes(v int64) *TenantQuotaUpsertOne {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.SetMaxBytes(v)
+	})
+}
+
+// AddMaxBytes adds v to the "max_bytes" field.
+func (u *TenantQuotaUpsertOne) AddMaxBytes(v int64) *TenantQuotaUpsertOne {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.AddMaxBytes(v)
+	})
+}
+
+// UpdateMaxBytes sets the "max_bytes" field to the value that was provided on create.
+func (u *TenantQuotaUpsertOne) UpdateMaxBytes() *TenantQuotaUpsertOne {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.UpdateMaxBytes()
+	})
+}
+
+// ClearMaxBytes clears the value of the "max_bytes" field.
+func (u *TenantQuotaUpsertOne) ClearMaxBytes() *TenantQuotaUpsertOne {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.ClearMaxBytes()
+	})
+}
+
+// SetMaxMonthlyUploadBytes sets the "max_monthly_upload_bytes" field.
+func (u *TenantQuotaUpsertOne) SetMaxMonthlyUploadBytes(v int64) *TenantQuotaUpsertOne {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.SetMaxMonthlyUploadBytes(v)
+	})
+}
+
+// AddMaxMonthlyUploadBytes adds v to the "max_monthly_upload_bytes" field.
+func (u *TenantQuotaUpsertOne) AddMaxMonthlyUploadBytes(v int64) *TenantQuotaUpsertOne {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.AddMaxMonthlyUploadBytes(v)
+	})
+}
+
+// UpdateMaxMonthlyUploadBytes sets the "max_monthly_upload_bytes" field to the value that was provided on create.
+func (u *TenantQuotaUpsertOne) UpdateMaxMonthlyUploadBytes() *TenantQuotaUpsertOne {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.UpdateMaxMonthlyUploadBytes()
+	})
+}
+
+// ClearMaxMonthlyUploadBytes clears the value of the "max_monthly_upload_bytes" field.
+func (u *TenantQuotaUpsertOne) ClearMaxMonthlyUploadBytes() *TenantQuotaUpsertOne {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.ClearMaxMonthlyUploadBytes()
+	})
+}
+
+// SetUploadMonth sets the "upload_month" field.
+func (u *TenantQuotaUpsertOne) SetUploadMonth(v string) *TenantQuotaUpsertOne {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.SetUploadMonth(v)
+	})
+}
+
+// UpdateUploadMonth sets the "upload_month" field to the value that was provided on create.
+func (u *TenantQuotaUpsertOne) UpdateUploadMonth() *TenantQuotaUpsertOne {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.UpdateUploadMonth()
+	})
+}
+
+// ClearUploadMonth clears the value of the "upload_month" field.
+func (u *TenantQuotaUpsertOne) ClearUploadMonth() *TenantQuotaUpsertOne {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.ClearUploadMonth()
+	})
+}
+
+// SetMonthUploadBytes sets the "month_upload_bytes" field.
+func (u *TenantQuotaUpsertOne) SetMonthUploadBytes(v int64) *TenantQuotaUpsertOne {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.SetMonthUploadBytes(v)
+	})
+}
+
+// AddMonthUploadBytes adds v to the "month_upload_bytes" field.
+func (u *TenantQuotaUpsertOne) AddMonthUploadBytes(v int64) *TenantQuotaUpsertOne {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.AddMonthUploadBytes(v)
+	})
+}
+
+// UpdateMonthUploadBytes sets the "month_upload_bytes" field to the value that was provided on create.
+func (u *TenantQuotaUpsertOne) UpdateMonthUploadBytes() *TenantQuotaUpsertOne {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.UpdateMonthUploadBytes()
+	})
+}
+
+// Exec executes the query.
+func (u *TenantQuotaUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TenantQuotaCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TenantQuotaUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TenantQuotaUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TenantQuotaUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TenantQuotaCreateBulk is the builder for creating many TenantQuota entities in bulk.
+type TenantQuotaCreateBulk struct {
+	config
+	err      error
+	builders []*TenantQuotaCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TenantQuota entities in the database.
+func (_c *TenantQuotaCreateBulk) Save(ctx context.Context) ([]*TenantQuota, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TenantQuota, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TenantQuotaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TenantQuotaCreateBulk) SaveX(ctx context.Context) []*TenantQuota {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TenantQuotaCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TenantQuotaCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TenantQuota.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TenantQuotaUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TenantQuotaCreateBulk) OnConflict(opts ...sql.ConflictOption) *TenantQuotaUpsertBulk {
+	_c.conflict = opts
+	return &TenantQuotaUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TenantQuota.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TenantQuotaCreateBulk) OnConflictColumns(columns ...string) *TenantQuotaUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TenantQuotaUpsertBulk{
+		create: _c,
+	}
+}
+
+// TenantQuotaUpsertBulk is the builder for "upsert"-ing
+// a bulk of TenantQuota nodes.
+type TenantQuotaUpsertBulk struct {
+	create *TenantQuotaCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TenantQuota.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tenantquota.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TenantQuotaUpsertBulk) UpdateNewValues() *TenantQuotaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(tenantquota.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(tenantquota.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(tenantquota.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TenantQuota.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TenantQuotaUpsertBulk) Ignore() *TenantQuotaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TenantQuotaUpsertBulk) DoNothing() *TenantQuotaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TenantQuotaCreateBulk.OnConflict
+// documentation for more info.
+func (u *TenantQuotaUpsertBulk) Update(set func(*TenantQuotaUpsert)) *TenantQuotaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TenantQuotaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *TenantQuotaUpsertBulk) SetUpdateTime(v time.Time) *TenantQuotaUpsertBulk {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *TenantQuotaUpsertBulk) UpdateUpdateTime() *TenantQuotaUpsertBulk {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *TenantQuotaUpsertBulk) ClearUpdateTime() *TenantQuotaUpsertBulk {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *TenantQuotaUpsertBulk) SetDeleteTime(v time.Time) *TenantQuotaUpsertBulk {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *TenantQuotaUpsertBulk) UpdateDeleteTime() *TenantQuotaUpsertBulk {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *TenantQuotaUpsertBulk) ClearDeleteTime() *TenantQuotaUpsertBulk {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetMaxDocuments sets the "max_documents" field.
+func (u *TenantQuotaUpsertBulk) SetMaxDocuments(v int64) *TenantQuotaUpsertBulk {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.SetMaxDocuments(v)
+	})
+}
+
+// AddMaxDocuments adds v to the "max_documents" field.
+func (u *TenantQuotaUpsertBulk) AddMaxDocuments(v int64) *TenantQuotaUpsertBulk {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.AddMaxDocuments(v)
+	})
+}
+
+// UpdateMaxDocuments sets the "max_documents" field to the value that was provided on create.
+func (u *TenantQuotaUpsertBulk) UpdateMaxDocuments() *TenantQuotaUpsertBulk {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.UpdateMaxDocuments()
+	})
+}
+
+// ClearMaxDocuments clears the value of the "max_documents" field.
+func (u *TenantQuotaUpsertBulk) ClearMaxDocuments() *TenantQuotaUpsertBulk {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.ClearMaxDocuments()
+	})
+}
+
+// SetMaxBytes sets the "max_bytes" field.
+func (u *TenantQuotaUpsertBulk) SetMaxBytes(v int64) *TenantQuotaUpsertBulk {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.SetMaxBytes(v)
+	})
+}
+
+// AddMaxBytes adds v to the "max_bytes" field.
+func (u *TenantQuotaUpsertBulk) AddMaxBytes(v int64) *TenantQuotaUpsertBulk {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.AddMaxBytes(v)
+	})
+}
+
+// UpdateMaxBytes sets the "max_bytes" field to the value that was provided on create.
+func (u *TenantQuotaUpsertBulk) UpdateMaxBytes() *TenantQuotaUpsertBulk {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.UpdateMaxBytes()
+	})
+}
+
+// ClearMaxBytes clears the value of the "max_bytes" field.
+func (u *TenantQuotaUpsertBulk) ClearMaxBytes() *TenantQuotaUpsertBulk {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.ClearMaxBytes()
+	})
+}
+
+// SetMaxMonthlyUploadBytes sets the "max_monthly_upload_bytes" field.
+func (u *TenantQuotaUpsertBulk) SetMaxMonthlyUploadBytes(v int64) *TenantQuotaUpsertBulk {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.SetMaxMonthlyUploadBytes(v)
+	})
+}
+
+// AddMaxMonthlyUploadBytes adds v to the "max_monthly_upload_bytes" field.
+func (u *TenantQuotaUpsertBulk) AddMaxMonthlyUploadBytes(v int64) *TenantQuotaUpsertBulk {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.AddMaxMonthlyUploadBytes(v)
+	})
+}
+
+// UpdateMaxMonthlyUploadBytes sets the "max_monthly_upload_bytes" field to the value that was provided on create.
+func (u *TenantQuotaUpsertBulk) UpdateMaxMonthlyUploadBytes() *TenantQuotaUpsertBulk {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.UpdateMaxMonthlyUploadBytes()
+	})
+}
+
+// ClearMaxMonthlyUploadBytes clears the value of the "max_monthly_upload_bytes" field.
+func (u *TenantQuotaUpsertBulk) ClearMaxMonthlyUploadBytes() *TenantQuotaUpsertBulk {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.ClearMaxMonthlyUploadBytes()
+	})
+}
+
+// SetUploadMonth sets the "upload_month" field.
+func (u *TenantQuotaUpsertBulk) SetUploadMonth(v string) *TenantQuotaUpsertBulk {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.SetUploadMonth(v)
+	})
+}
+
+// UpdateUploadMonth sets the "upload_month" field to the value that was provided on create.
+func (u *TenantQuotaUpsertBulk) UpdateUploadMonth() *TenantQuotaUpsertBulk {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.UpdateUploadMonth()
+	})
+}
+
+// ClearUploadMonth clears the value of the "upload_month" field.
+func (u *TenantQuotaUpsertBulk) ClearUploadMonth() *TenantQuotaUpsertBulk {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.ClearUploadMonth()
+	})
+}
+
+// SetMonthUploadBytes sets the "month_upload_bytes" field.
+func (u *TenantQuotaUpsertBulk) SetMonthUploadBytes(v int64) *TenantQuotaUpsertBulk {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.SetMonthUploadBytes(v)
+	})
+}
+
+// AddMonthUploadBytes adds v to the "month_upload_bytes" field.
+func (u *TenantQuotaUpsertBulk) AddMonthUploadBytes(v int64) *TenantQuotaUpsertBulk {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.AddMonthUploadBytes(v)
+	})
+}
+
+// UpdateMonthUploadBytes sets the "month_upload_bytes" field to the value that was provided on create.
+func (u *TenantQuotaUpsertBulk) UpdateMonthUploadBytes() *TenantQuotaUpsertBulk {
+	return u.Update(func(s *TenantQuotaUpsert) {
+		s.UpdateMonthUploadBytes()
+	})
+}
+
+// Exec executes the query.
+func (u *TenantQuotaUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TenantQuotaCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TenantQuotaCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TenantQuotaUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
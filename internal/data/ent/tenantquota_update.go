@@ -0,0 +1,656 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/tenantquota"
+)
+
+// TenantQuotaUpdate is the builder for updating TenantQuota entities.
+type TenantQuotaUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TenantQuotaMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TenantQuotaUpdate builder.
+func (_u *TenantQuotaUpdate) Where(ps ...predicate.TenantQuota) *TenantQuotaUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *TenantQuotaUpdate) SetUpdateTime(v time.Time) *TenantQuotaUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *TenantQuotaUpdate) SetNillableUpdateTime(v *time.Time) *TenantQuotaUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *TenantQuotaUpdate) ClearUpdateTime() *TenantQuotaUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *TenantQuotaUpdate) SetDeleteTime(v time.Time) *TenantQuotaUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *TenantQuotaUpdate) SetNillableDeleteTime(v *time.Time) *TenantQuotaUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *TenantQuotaUpdate) ClearDeleteTime() *TenantQuotaUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetMaxDocuments sets the "max_documents" field.
+func (_u *TenantQuotaUpdate) SetMaxDocuments(v int64) *TenantQuotaUpdate {
+	_u.mutation.ResetMaxDocuments()
+	_u.mutation.SetMaxDocuments(v)
+	return _u
+}
+
+// SetNillableMaxDocuments sets the "max_documents" field if the given value is not nil.
+func (_u *TenantQuotaUpdate) SetNillableMaxDocuments(v *int64) *TenantQuotaUpdate {
+	if v != nil {
+		_u.SetMaxDocuments(*v)
+	}
+	return _u
+}
+
+// AddMaxDocuments adds value to the "max_documents" field.
+func (_u *TenantQuotaUpdate) AddMaxDocuments(v int64) *TenantQuotaUpdate {
+	_u.mutation.AddMaxDocuments(v)
+	return _u
+}
+
+// ClearMaxDocuments clears the value of the "max_documents" field.
+func (_u *TenantQuotaUpdate) ClearMaxDocuments() *TenantQuotaUpdate {
+	_u.mutation.ClearMaxDocuments()
+	return _u
+}
+
+// SetMaxBytes sets the "max_bytes" field.
+func (_u *TenantQuotaUpdate) SetMaxBytes(v int64) *TenantQuotaUpdate {
+	_u.mutation.ResetMaxBytes()
+	_u.mutation.SetMaxBytes(v)
+	return _u
+}
+
+// SetNillableMaxBytes sets the "max_bytes" field if the given value is not nil.
+func (_u *TenantQuotaUpdate) SetNillableMaxBytes(v *int64) *TenantQuotaUpdate {
+	if v != nil {
+		_u.SetMaxBytes(*v)
+	}
+	return _u
+}
+
+// AddMaxBytes adds value to the "max_bytes" field.
+func (_u *TenantQuotaUpdate) AddMaxBytes(v int64) *TenantQuotaUpdate {
+	_u.mutation.AddMaxBytes(v)
+	return _u
+}
+
+// ClearMaxBytes clears the value of the "max_bytes" field.
+func (_u *TenantQuotaUpdate) ClearMaxBytes() *TenantQuotaUpdate {
+	_u.mutation.ClearMaxBytes()
+	return _u
+}
+
+// SetMaxMonthlyUploadBytes sets the "max_monthly_upload_bytes" field.
+func (_u *TenantQuotaUpdate) SetMaxMonthlyUploadBytes(v int64) *TenantQuotaUpdate {
+	_u.mutation.ResetMaxMonthlyUploadBytes()
+	_u.mutation.SetMaxMonthlyUploadBytes(v)
+	return _u
+}
+
+// SetNillableMaxMonthlyUploadBytes sets the "max_monthly_upload_bytes" field if the given value is not nil.
+func (_u *TenantQuotaUpdate) SetNillableMaxMonthlyUploadBytes(v *int64) *TenantQuotaUpdate {
+	if v != nil {
+		_u.SetMaxMonthlyUploadBytes(*v)
+	}
+	return _u
+}
+
+// AddMaxMonthlyUploadBytes adds value to the "max_monthly_upload_bytes" field.
+func (_u *TenantQuotaUpdate) AddMaxMonthlyUploadBytes(v int64) *TenantQuotaUpdate {
+	_u.mutation.AddMaxMonthlyUploadBytes(v)
+	return _u
+}
+
+// ClearMaxMonthlyUploadBytes clears the value of the "max_monthly_upload_bytes" field.
+func (_u *TenantQuotaUpdate) ClearMaxMonthlyUploadBytes() *TenantQuotaUpdate {
+	_u.mutation.ClearMaxMonthlyUploadBytes()
+	return _u
+}
+
+// SetUploadMonth sets the "upload_month" field.
+func (_u *TenantQuotaUpdate) SetUploadMonth(v string) *TenantQuotaUpdate {
+	_u.mutation.SetUploadMonth(v)
+	return _u
+}
+
+// SetNillableUploadMonth sets the "upload_month" field if the given value is not nil.
+func (_u *TenantQuotaUpdate) SetNillableUploadMonth(v *string) *TenantQuotaUpdate {
+	if v != nil {
+		_u.SetUploadMonth(*v)
+	}
+	return _u
+}
+
+// ClearUploadMonth clears the value of the "upload_month" field.
+func (_u *TenantQuotaUpdate) ClearUploadMonth() *TenantQuotaUpdate {
+	_u.mutation.ClearUploadMonth()
+	return _u
+}
+
+// SetMonthUploadBytes sets the "month_upload_bytes" field.
+func (_u *TenantQuotaUpdate) SetMonthUploadBytes(v int64) *TenantQuotaUpdate {
+	_u.mutation.ResetMonthUploadBytes()
+	_u.mutation.SetMonthUploadBytes(v)
+	return _u
+}
+
+// SetNillableMonthUploadBytes sets the "month_upload_bytes" field if the given value is not nil.
+func (_u *TenantQuotaUpdate) SetNillableMonthUploadBytes(v *int64) *TenantQuotaUpdate {
+	if v != nil {
+		_u.SetMonthUploadBytes(*v)
+	}
+	return _u
+}
+
+// AddMonthUploadBytes adds value to the "month_upload_bytes" field.
+func (_u *TenantQuotaUpdate) AddMonthUploadBytes(v int64) *TenantQuotaUpdate {
+	_u.mutation.AddMonthUploadBytes(v)
+	return _u
+}
+
+// Mutation returns the TenantQuotaMutation object of the builder.
+func (_u *TenantQuotaUpdate) Mutation() *TenantQuotaMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TenantQuotaUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantQuotaUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TenantQuotaUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantQuotaUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TenantQuotaUpdate) check() error {
+	if v, ok := _u.mutation.UploadMonth(); ok {
+		if err := tenantquota.UploadMonthValidator(v); err != nil {
+			return &ValidationError{Name: "upload_month", err: fmt.Errorf(`ent: validator failed for field "TenantQuota.upload_month": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TenantQuotaUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TenantQuotaUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TenantQuotaUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantquota.Table, tenantquota.Columns, sqlgraph.NewFieldSpec(tenantquota.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(tenantquota.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(tenantquota.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(tenantquota.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(tenantquota.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(tenantquota.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(tenantquota.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.MaxDocuments(); ok {
+		_spec.SetField(tenantquota.FieldMaxDocuments, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxDocuments(); ok {
+		_spec.AddField(tenantquota.FieldMaxDocuments, field.TypeInt64, value)
+	}
+	if _u.mutation.MaxDocumentsCleared() {
+		_spec.ClearField(tenantquota.FieldMaxDocuments, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.MaxBytes(); ok {
+		_spec.SetField(tenantquota.FieldMaxBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxBytes(); ok {
+		_spec.AddField(tenantquota.FieldMaxBytes, field.TypeInt64, value)
+	}
+	if _u.mutation.MaxBytesCleared() {
+		_spec.ClearField(tenantquota.FieldMaxBytes, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.MaxMonthlyUploadBytes(); ok {
+		_spec.SetField(tenantquota.FieldMaxMonthlyUploadBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxMonthlyUploadBytes(); ok {
+		_spec.AddField(tenantquota.FieldMaxMonthlyUploadBytes, field.TypeInt64, value)
+	}
+	if _u.mutation.MaxMonthlyUploadBytesCleared() {
+		_spec.ClearField(tenantquota.FieldMaxMonthlyUploadBytes, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.UploadMonth(); ok {
+		_spec.SetField(tenantquota.FieldUploadMonth, field.TypeString, value)
+	}
+	if _u.mutation.UploadMonthCleared() {
+		_spec.ClearField(tenantquota.FieldUploadMonth, field.TypeString)
+	}
+	if value, ok := _u.mutation.MonthUploadBytes(); ok {
+		_spec.SetField(tenantquota.FieldMonthUploadBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMonthUploadBytes(); ok {
+		_spec.AddField(tenantquota.FieldMonthUploadBytes, field.TypeInt64, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantquota.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TenantQuotaUpdateOne is the builder for updating a single TenantQuota entity.
+type TenantQuotaUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TenantQuotaMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *TenantQuotaUpdateOne) SetUpdateTime(v time.Time) *TenantQuotaUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *TenantQuotaUpdateOne) SetNillableUpdateTime(v *time.Time) *TenantQuotaUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *TenantQuotaUpdateOne) ClearUpdateTime() *TenantQuotaUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *TenantQuotaUpdateOne) SetDeleteTime(v time.Time) *TenantQuotaUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *TenantQuotaUpdateOne) SetNillableDeleteTime(v *time.Time) *TenantQuotaUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *TenantQuotaUpdateOne) ClearDeleteTime() *TenantQuotaUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetMaxDocuments sets the "max_documents" field.
+func (_u *TenantQuotaUpdateOne) SetMaxDocuments(v int64) *TenantQuotaUpdateOne {
+	_u.mutation.ResetMaxDocuments()
+	_u.mutation.SetMaxDocuments(v)
+	return _u
+}
+
+// SetNillableMaxDocuments sets the "max_documents" field if the given value is not nil.
+func (_u *TenantQuotaUpdateOne) SetNillableMaxDocuments(v *int64) *TenantQuotaUpdateOne {
+	if v != nil {
+		_u.SetMaxDocuments(*v)
+	}
+	return _u
+}
+
+// AddMaxDocuments adds value to the "max_documents" field.
+func (_u *TenantQuotaUpdateOne) AddMaxDocuments(v int64) *TenantQuotaUpdateOne {
+	_u.mutation.AddMaxDocuments(v)
+	return _u
+}
+
+// ClearMaxDocuments clears the value of the "max_documents" field.
+func (_u *TenantQuotaUpdateOne) ClearMaxDocuments() *TenantQuotaUpdateOne {
+	_u.mutation.ClearMaxDocuments()
+	return _u
+}
+
+// SetMaxBytes sets the "max_bytes" field.
+func (_u *TenantQuotaUpdateOne) SetMaxBytes(v int64) *TenantQuotaUpdateOne {
+	_u.mutation.ResetMaxBytes()
+	_u.mutation.SetMaxBytes(v)
+	return _u
+}
+
+// SetNillableMaxBytes sets the "max_bytes" field if the given value is not nil.
+func (_u *TenantQuotaUpdateOne) SetNillableMaxBytes(v *int64) *TenantQuotaUpdateOne {
+	if v != nil {
+		_u.SetMaxBytes(*v)
+	}
+	return _u
+}
+
+// AddMaxBytes adds value to the "max_bytes" field.
+func (_u *TenantQuotaUpdateOne) AddMaxBytes(v int64) *TenantQuotaUpdateOne {
+	_u.mutation.AddMaxBytes(v)
+	return _u
+}
+
+// ClearMaxBytes clears the value of the "max_bytes" field.
+func (_u *TenantQuotaUpdateOne) ClearMaxBytes() *TenantQuotaUpdateOne {
+	_u.mutation.ClearMaxBytes()
+	return _u
+}
+
+// SetMaxMonthlyUploadBytes sets the "max_monthly_upload_bytes" field.
+func (_u *TenantQuotaUpdateOne) SetMaxMonthlyUploadBytes(v int64) *TenantQuotaUpdateOne {
+	_u.mutation.ResetMaxMonthlyUploadBytes()
+	_u.mutation.SetMaxMonthlyUploadBytes(v)
+	return _u
+}
+
+// SetNillableMaxMonthlyUploadBytes sets the "max_monthly_upload_bytes" field if the given value is not nil.
+func (_u *TenantQuotaUpdateOne) SetNillableMaxMonthlyUploadBytes(v *int64) *TenantQuotaUpdateOne {
+	if v != nil {
+		_u.SetMaxMonthlyUploadBytes(*v)
+	}
+	return _u
+}
+
+// AddMaxMonthlyUploadBytes adds value to the "max_monthly_upload_bytes" field.
+func (_u *TenantQuotaUpdateOne) AddMaxMonthlyUploadBytes(v int64) *TenantQuotaUpdateOne {
+	_u.mutation.AddMaxMonthlyUploadBytes(v)
+	return _u
+}
+
+// ClearMaxMonthlyUploadBytes clears the value of the "max_monthly_upload_bytes" field.
+func (_u *TenantQuotaUpdateOne) ClearMaxMonthlyUploadBytes() *TenantQuotaUpdateOne {
+	_u.mutation.ClearMaxMonthlyUploadBytes()
+	return _u
+}
+
+// SetUploadMonth sets the "upload_month" field.
+func (_u *TenantQuotaUpdateOne) SetUploadMonth(v string) *TenantQuotaUpdateOne {
+	_u.mutation.SetUploadMonth(v)
+	return _u
+}
+
+// SetNillableUploadMonth sets the "upload_month" field if the given value is not nil.
+func (_u *TenantQuotaUpdateOne) SetNillableUploadMonth(v *string) *TenantQuotaUpdateOne {
+	if v != nil {
+		_u.SetUploadMonth(*v)
+	}
+	return _u
+}
+
+// ClearUploadMonth clears the value of the "upload_month" field.
+func (_u *TenantQuotaUpdateOne) ClearUploadMonth() *TenantQuotaUpdateOne {
+	_u.mutation.ClearUploadMonth()
+	return _u
+}
+
+// SetMonthUploadBytes sets the "month_upload_bytes" field.
+func (_u *TenantQuotaUpdateOne) SetMonthUploadBytes(v int64) *TenantQuotaUpdateOne {
+	_u.mutation.ResetMonthUploadBytes()
+	_u.mutation.SetMonthUploadBytes(v)
+	return _u
+}
+
+// SetNillableMonthUploadBytes sets the "month_upload_bytes" field if the given value is not nil.
+func (_u *TenantQuotaUpdateOne) SetNillableMonthUploadBytes(v *int64) *TenantQuotaUpdateOne {
+	if v != nil {
+		_u.SetMonthUploadBytes(*v)
+	}
+	return _u
+}
+
+// AddMonthUploadBytes adds value to the "month_upload_bytes" field.
+func (_u *TenantQuotaUpdateOne) AddMonthUploadBytes(v int64) *TenantQuotaUpdateOne {
+	_u.mutation.AddMonthUploadBytes(v)
+	return _u
+}
+
+// Mutation returns the TenantQuotaMutation object of the builder.
+func (_u *TenantQuotaUpdateOne) Mutation() *TenantQuotaMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TenantQuotaUpdate builder.
+func (_u *TenantQuotaUpdateOne) Where(ps ...predicate.TenantQuota) *TenantQuotaUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TenantQuotaUpdateOne) Select(field string, fields ...string) *TenantQuotaUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TenantQuota entity.
+func (_u *TenantQuotaUpdateOne) Save(ctx context.Context) (*TenantQuota, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantQuotaUpdateOne) SaveX(ctx context.Context) *TenantQuota {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TenantQuotaUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantQuotaUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TenantQuotaUpdateOne) check() error {
+	if v, ok := _u.mutation.UploadMonth(); ok {
+		if err := tenantquota.UploadMonthValidator(v); err != nil {
+			return &ValidationError{Name: "upload_month", err: fmt.Errorf(`ent: validator failed for field "TenantQuota.upload_month": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TenantQuotaUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TenantQuotaUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TenantQuotaUpdateOne) sqlSave(ctx context.Context) (_node *TenantQuota, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantquota.Table, tenantquota.Columns, sqlgraph.NewFieldSpec(tenantquota.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TenantQuota.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tenantquota.FieldID)
+		for _, f := range fields {
+			if !tenantquota.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tenantquota.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(tenantquota.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(tenantquota.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(tenantquota.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(tenantquota.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(tenantquota.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(tenantquota.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.MaxDocuments(); ok {
+		_spec.SetField(tenantquota.FieldMaxDocuments, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxDocuments(); ok {
+		_spec.AddField(tenantquota.FieldMaxDocuments, field.TypeInt64, value)
+	}
+	if _u.mutation.MaxDocumentsCleared() {
+		_spec.ClearField(tenantquota.FieldMaxDocuments, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.MaxBytes(); ok {
+		_spec.SetField(tenantquota.FieldMaxBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxBytes(); ok {
+		_spec.AddField(tenantquota.FieldMaxBytes, field.TypeInt64, value)
+	}
+	if _u.mutation.MaxBytesCleared() {
+		_spec.ClearField(tenantquota.FieldMaxBytes, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.MaxMonthlyUploadBytes(); ok {
+		_spec.SetField(tenantquota.FieldMaxMonthlyUploadBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxMonthlyUploadBytes(); ok {
+		_spec.AddField(tenantquota.FieldMaxMonthlyUploadBytes, field.TypeInt64, value)
+	}
+	if _u.mutation.MaxMonthlyUploadBytesCleared() {
+		_spec.ClearField(tenantquota.FieldMaxMonthlyUploadBytes, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.UploadMonth(); ok {
+		_spec.SetField(tenantquota.FieldUploadMonth, field.TypeString, value)
+	}
+	if _u.mutation.UploadMonthCleared() {
+		_spec.ClearField(tenantquota.FieldUploadMonth, field.TypeString)
+	}
+	if value, ok := _u.mutation.MonthUploadBytes(); ok {
+		_spec.SetField(tenantquota.FieldMonthUploadBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMonthUploadBytes(); ok {
+		_spec.AddField(tenantquota.FieldMonthUploadBytes, field.TypeInt64, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &TenantQuota{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantquota.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
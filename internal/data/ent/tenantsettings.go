@@ -0,0 +1,214 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/tenantsettings"
+)
+
+// TenantSettings is the model entity for the TenantSettings schema.
+type TenantSettings struct {
+	config `json:"-"`
+	// ID of the ent.
+	// id
+	ID uint32 `json:"id,omitempty"`
+	// 创建时间
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// 更新时间
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// 删除时间
+	DeleteTime *time.Time `json:"delete_time,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// Tesseract languages used for OCR, e.g. eng+deu
+	OcrLanguage string `json:"ocr_language,omitempty"`
+	// Processing steps skipped for the tenant's documents
+	DisabledProcessingSteps []string `json:"disabled_processing_steps,omitempty"`
+	// MIME types accepted for uploads, e.g. application/pdf or image/* (empty accepts all)
+	AllowedMimeTypes []string `json:"allowed_mime_types,omitempty"`
+	// Retention class of new documents that get none from their category
+	DefaultRetentionClass string `json:"default_retention_class,omitempty"`
+	// Storage backend receiving the tenant's uploads (empty follows the active backend)
+	StorageBackend string `json:"storage_backend,omitempty"`
+	selectValues   sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TenantSettings) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tenantsettings.FieldDisabledProcessingSteps, tenantsettings.FieldAllowedMimeTypes:
+			values[i] = new([]byte)
+		case tenantsettings.FieldID, tenantsettings.FieldTenantID:
+			values[i] = new(sql.NullInt64)
+		case tenantsettings.FieldOcrLanguage, tenantsettings.FieldDefaultRetentionClass, tenantsettings.FieldStorageBackend:
+			values[i] = new(sql.NullString)
+		case tenantsettings.FieldCreateTime, tenantsettings.FieldUpdateTime, tenantsettings.FieldDeleteTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TenantSettings fields.
+func (_m *TenantSettings) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tenantsettings.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint32(value.Int64)
+		case tenantsettings.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = new(time.Time)
+				*_m.CreateTime = value.Time
+			}
+		case tenantsettings.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = new(time.Time)
+				*_m.UpdateTime = value.Time
+			}
+		case tenantsettings.FieldDeleteTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_time", values[i])
+			} else if value.Valid {
+				_m.DeleteTime = new(time.Time)
+				*_m.DeleteTime = value.Time
+			}
+		case tenantsettings.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case tenantsettings.FieldOcrLanguage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ocr_language", values[i])
+			} else if value.Valid {
+				_m.OcrLanguage = value.String
+			}
+		case tenantsettings.FieldDisabledProcessingSteps:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field disabled_processing_steps", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.DisabledProcessingSteps); err != nil {
+					return fmt.Errorf("unmarshal field disabled_processing_steps: %w", err)
+				}
+			}
+		case tenantsettings.FieldAllowedMimeTypes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field allowed_mime_types", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AllowedMimeTypes); err != nil {
+					return fmt.Errorf("unmarshal field allowed_mime_types: %w", err)
+				}
+			}
+		case tenantsettings.FieldDefaultRetentionClass:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field default_retention_class", values[i])
+			} else if value.Valid {
+				_m.DefaultRetentionClass = value.String
+			}
+		case tenantsettings.FieldStorageBackend:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_backend", values[i])
+			} else if value.Valid {
+				_m.StorageBackend = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TenantSettings.
+// This includes values selected through modifiers, order, etc.
+func (_m *TenantSettings) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TenantSettings.
+// Note that you need to call TenantSettings.Unwrap() before calling this method if this TenantSettings
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TenantSettings) Update() *TenantSettingsUpdateOne {
+	return NewTenantSettingsClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TenantSettings entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TenantSettings) Unwrap() *TenantSettings {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TenantSettings is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TenantSettings) String() string {
+	var builder strings.Builder
+	builder.WriteString("TenantSettings(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeleteTime; v != nil {
+		builder.WriteString("delete_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("ocr_language=")
+	builder.WriteString(_m.OcrLanguage)
+	builder.WriteString(", ")
+	builder.WriteString("disabled_processing_steps=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DisabledProcessingSteps))
+	builder.WriteString(", ")
+	builder.WriteString("allowed_mime_types=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AllowedMimeTypes))
+	builder.WriteString(", ")
+	builder.WriteString("default_retention_class=")
+	builder.WriteString(_m.DefaultRetentionClass)
+	builder.WriteString(", ")
+	builder.WriteString("storage_backend=")
+	builder.WriteString(_m.StorageBackend)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TenantSettingsSlice is a parsable slice of TenantSettings.
+type TenantSettingsSlice []*TenantSettings
@@ -0,0 +1,122 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tenantsettings
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the tenantsettings type in the database.
+	Label = "tenant_settings"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldDeleteTime holds the string denoting the delete_time field in the database.
+	FieldDeleteTime = "delete_time"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldOcrLanguage holds the string denoting the ocr_language field in the database.
+	FieldOcrLanguage = "ocr_language"
+	// FieldDisabledProcessingSteps holds the string denoting the disabled_processing_steps field in the database.
+	FieldDisabledProcessingSteps = "disabled_processing_steps"
+	// FieldAllowedMimeTypes holds the string denoting the allowed_mime_types field in the database.
+	FieldAllowedMimeTypes = "allowed_mime_types"
+	// FieldDefaultRetentionClass holds the string denoting the default_retention_class field in the database.
+	FieldDefaultRetentionClass = "default_retention_class"
+	// FieldStorageBackend holds the string denoting the storage_backend field in the database.
+	FieldStorageBackend = "storage_backend"
+	// Table holds the table name of the tenantsettings in the database.
+	Table = "paperless_tenant_settings"
+)
+
+// Columns holds all SQL columns for tenantsettings fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldDeleteTime,
+	FieldTenantID,
+	FieldOcrLanguage,
+	FieldDisabledProcessingSteps,
+	FieldAllowedMimeTypes,
+	FieldDefaultRetentionClass,
+	FieldStorageBackend,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/go-tangra/go-tangra-paperless/internal/data/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint32
+	// OcrLanguageValidator is a validator for the "ocr_language" field. It is called by the builders before save.
+	OcrLanguageValidator func(string) error
+	// DefaultRetentionClassValidator is a validator for the "default_retention_class" field. It is called by the builders before save.
+	DefaultRetentionClassValidator func(string) error
+	// StorageBackendValidator is a validator for the "storage_backend" field. It is called by the builders before save.
+	StorageBackendValidator func(string) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(uint32) error
+)
+
+// OrderOption defines the ordering options for the TenantSettings queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByDeleteTime orders the results by the delete_time field.
+func ByDeleteTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeleteTime, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByOcrLanguage orders the results by the ocr_language field.
+func ByOcrLanguage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOcrLanguage, opts...).ToFunc()
+}
+
+// ByDefaultRetentionClass orders the results by the default_retention_class field.
+func ByDefaultRetentionClass(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDefaultRetentionClass, opts...).ToFunc()
+}
+
+// ByStorageBackend orders the results by the storage_backend field.
+func ByStorageBackend(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStorageBackend, opts...).ToFunc()
+}
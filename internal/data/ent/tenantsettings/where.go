@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tenantsettings
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldEQ(FieldTenantID, v))
+}
+
+// OcrLanguage applies equality check predicate on the "ocr_language" field. It's identical to OcrLanguageEQ.
+func OcrLanguage(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldEQ(FieldOcrLanguage, v))
+}
+
+// DefaultRetentionClass applies equality check predicate on the "default_retention_class" field. It's identical to DefaultRetentionClassEQ.
+func DefaultRetentionClass(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldEQ(FieldDefaultRetentionClass, v))
+}
+
+// StorageBackend applies equality check predicate on the "storage_backend" field. It's identical to StorageBackendEQ.
+func StorageBackend(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldEQ(FieldStorageBackend, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldNotNull(FieldTenantID))
+}
+
+// OcrLanguageEQ applies the EQ predicate on the "ocr_language" field.
+func OcrLanguageEQ(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldEQ(FieldOcrLanguage, v))
+}
+
+// OcrLanguageNEQ applies the NEQ predicate on the "ocr_language" field.
+func OcrLanguageNEQ(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldNEQ(FieldOcrLanguage, v))
+}
+
+// OcrLanguageIn applies the In predicate on the "ocr_language" field.
+func OcrLanguageIn(vs ...string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldIn(FieldOcrLanguage, vs...))
+}
+
+// OcrLanguageNotIn applies the NotIn predicate on the "ocr_language" field.
+func OcrLanguageNotIn(vs ...string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldNotIn(FieldOcrLanguage, vs...))
+}
+
+// OcrLanguageGT applies the GT predicate on the "ocr_language" field.
+func OcrLanguageGT(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldGT(FieldOcrLanguage, v))
+}
+
+// OcrLanguageGTE applies the GTE predicate on the "ocr_language" field.
+func OcrLanguageGTE(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldGTE(FieldOcrLanguage, v))
+}
+
+// OcrLanguageLT applies the LT predicate on the "ocr_language" field.
+func OcrLanguageLT(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldLT(FieldOcrLanguage, v))
+}
+
+// OcrLanguageLTE applies the LTE predicate on the "ocr_language" field.
+func OcrLanguageLTE(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldLTE(FieldOcrLanguage, v))
+}
+
+// OcrLanguageContains applies the Contains predicate on the "ocr_language" field.
+func OcrLanguageContains(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldContains(FieldOcrLanguage, v))
+}
+
+// OcrLanguageHasPrefix applies the HasPrefix predicate on the "ocr_language" field.
+func OcrLanguageHasPrefix(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldHasPrefix(FieldOcrLanguage, v))
+}
+
+// OcrLanguageHasSuffix applies the HasSuffix predicate on the "ocr_language" field.
+func OcrLanguageHasSuffix(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldHasSuffix(FieldOcrLanguage, v))
+}
+
+// OcrLanguageIsNil applies the IsNil predicate on the "ocr_language" field.
+func OcrLanguageIsNil() predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldIsNull(FieldOcrLanguage))
+}
+
+// OcrLanguageNotNil applies the NotNil predicate on the "ocr_language" field.
+func OcrLanguageNotNil() predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldNotNull(FieldOcrLanguage))
+}
+
+// OcrLanguageEqualFold applies the EqualFold predicate on the "ocr_language" field.
+func OcrLanguageEqualFold(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldEqualFold(FieldOcrLanguage, v))
+}
+
+// OcrLanguageContainsFold applies the ContainsFold predicate on the "ocr_language" field.
+func OcrLanguageContainsFold(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldContainsFold(FieldOcrLanguage, v))
+}
+
+// DisabledProcessingStepsIsNil applies the IsNil predicate on the "disabled_processing_steps" field.
+func DisabledProcessingStepsIsNil() predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldIsNull(FieldDisabledProcessingSteps))
+}
+
+// DisabledProcessingStepsNotNil applies the NotNil predicate on the "disabled_processing_steps" field.
+func DisabledProcessingStepsNotNil() predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldNotNull(FieldDisabledProcessingSteps))
+}
+
+// AllowedMimeTypesIsNil applies the IsNil predicate on the "allowed_mime_types" field.
+func AllowedMimeTypesIsNil() predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldIsNull(FieldAllowedMimeTypes))
+}
+
+// AllowedMimeTypesNotNil applies the NotNil predicate on the "allowed_mime_types" field.
+func AllowedMimeTypesNotNil() predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldNotNull(FieldAllowedMimeTypes))
+}
+
+// DefaultRetentionClassEQ applies the EQ predicate on the "default_retention_class" field.
+func DefaultRetentionClassEQ(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldEQ(FieldDefaultRetentionClass, v))
+}
+
+// DefaultRetentionClassNEQ applies the NEQ predicate on the "default_retention_class" field.
+func DefaultRetentionClassNEQ(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldNEQ(FieldDefaultRetentionClass, v))
+}
+
+// DefaultRetentionClassIn applies the In predicate on the "default_retention_class" field.
+func DefaultRetentionClassIn(vs ...string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldIn(FieldDefaultRetentionClass, vs...))
+}
+
+// DefaultRetentionClassNotIn applies the NotIn predicate on the "default_retention_class" field.
+func DefaultRetentionClassNotIn(vs ...string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldNotIn(FieldDefaultRetentionClass, vs...))
+}
+
+// DefaultRetentionClassGT applies the GT predicate on the "default_retention_class" field.
+func DefaultRetentionClassGT(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldGT(FieldDefaultRetentionClass, v))
+}
+
+// DefaultRetentionClassGTE applies the GTE predicate on the "default_retention_class" field.
+func DefaultRetentionClassGTE(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldGTE(FieldDefaultRetentionClass, v))
+}
+
+// DefaultRetentionClassLT applies the LT predicate on the "default_retention_class" field.
+func DefaultRetentionClassLT(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldLT(FieldDefaultRetentionClass, v))
+}
+
+// DefaultRetentionClassLTE applies the LTE predicate on the "default_retention_class" field.
+func DefaultRetentionClassLTE(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldLTE(FieldDefaultRetentionClass, v))
+}
+
+// DefaultRetentionClassContains applies the Contains predicate on the "default_retention_class" field.
+func DefaultRetentionClassContains(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldContains(FieldDefaultRetentionClass, v))
+}
+
+// DefaultRetentionClassHasPrefix applies the HasPrefix predicate on the "default_retention_class" field.
+func DefaultRetentionClassHasPrefix(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldHasPrefix(FieldDefaultRetentionClass, v))
+}
+
+// DefaultRetentionClassHasSuffix applies the HasSuffix predicate on the "default_retention_class" field.
+func DefaultRetentionClassHasSuffix(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldHasSuffix(FieldDefaultRetentionClass, v))
+}
+
+// DefaultRetentionClassIsNil applies the IsNil predicate on the "default_retention_class" field.
+func DefaultRetentionClassIsNil() predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldIsNull(FieldDefaultRetentionClass))
+}
+
+// DefaultRetentionClassNotNil applies the NotNil predicate on the "default_retention_class" field.
+func DefaultRetentionClassNotNil() predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldNotNull(FieldDefaultRetentionClass))
+}
+
+// DefaultRetentionClassEqualFold applies the EqualFold predicate on the "default_retention_class" field.
+func DefaultRetentionClassEqualFold(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldEqualFold(FieldDefaultRetentionClass, v))
+}
+
+// DefaultRetentionClassContainsFold applies the ContainsFold predicate on the "default_retention_class" field.
+func DefaultRetentionClassContainsFold(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldContainsFold(FieldDefaultRetentionClass, v))
+}
+
+// StorageBackendEQ applies the EQ predicate on the "storage_backend" field.
+func StorageBackendEQ(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldEQ(FieldStorageBackend, v))
+}
+
+// StorageBackendNEQ applies the NEQ predicate on the "storage_backend" field.
+func StorageBackendNEQ(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldNEQ(FieldStorageBackend, v))
+}
+
+// StorageBackendIn applies the In predicate on the "storage_backend" field.
+func StorageBackendIn(vs ...string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldIn(FieldStorageBackend, vs...))
+}
+
+// StorageBackendNotIn applies the NotIn predicate on the "storage_backend" field.
+func StorageBackendNotIn(vs ...string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldNotIn(FieldStorageBackend, vs...))
+}
+
+// StorageBackendGT applies the GT predicate on the "storage_backend" field.
+func StorageBackendGT(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldGT(FieldStorageBackend, v))
+}
+
+// StorageBackendGTE applies the GTE predicate on the "storage_backend" field.
+func StorageBackendGTE(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldGTE(FieldStorageBackend, v))
+}
+
+// StorageBackendLT applies the LT predicate on the "storage_backend" field.
+func StorageBackendLT(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldLT(FieldStorageBackend, v))
+}
+
+// StorageBackendLTE applies the LTE predicate on the "storage_backend" field.
+func StorageBackendLTE(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldLTE(FieldStorageBackend, v))
+}
+
+// StorageBackendContains applies the Contains predicate on the "storage_backend" field.
+func StorageBackendContains(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldContains(FieldStorageBackend, v))
+}
+
+// StorageBackendHasPrefix applies the HasPrefix predicate on the "storage_backend" field.
+func StorageBackendHasPrefix(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldHasPrefix(FieldStorageBackend, v))
+}
+
+// StorageBackendHasSuffix applies the HasSuffix predicate on the "storage_backend" field.
+func StorageBackendHasSuffix(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldHasSuffix(FieldStorageBackend, v))
+}
+
+// StorageBackendIsNil applies the IsNil predicate on the "storage_backend" field.
+func StorageBackendIsNil() predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldIsNull(FieldStorageBackend))
+}
+
+// StorageBackendNotNil applies the NotNil predicate on the "storage_backend" field.
+func StorageBackendNotNil() predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldNotNull(FieldStorageBackend))
+}
+
+// StorageBackendEqualFold applies the EqualFold predicate on the "storage_backend" field.
+func StorageBackendEqualFold(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldEqualFold(FieldStorageBackend, v))
+}
+
+// StorageBackendContainsFold applies the ContainsFold predicate on the "storage_backend" field.
+func StorageBackendContainsFold(v string) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.FieldContainsFold(FieldStorageBackend, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TenantSettings) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TenantSettings) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TenantSettings) predicate.TenantSettings {
+	return predicate.TenantSettings(sql.NotPredicates(p))
+}
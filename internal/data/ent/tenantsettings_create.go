@@ -0,0 +1,1043 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/tenantsettings"
+)
+
+// TenantSettingsCreate is the builder for creating a TenantSettings entity.
+type TenantSettingsCreate struct {
+	config
+	mutation *TenantSettingsMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *TenantSettingsCreate) SetCreateTime(v time.Time) *TenantSettingsCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *TenantSettingsCreate) SetNillableCreateTime(v *time.Time) *TenantSettingsCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *TenantSettingsCreate) SetUpdateTime(v time.Time) *TenantSettingsCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *TenantSettingsCreate) SetNillableUpdateTime(v *time.Time) *TenantSettingsCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *TenantSettingsCreate) SetDeleteTime(v time.Time) *TenantSettingsCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *TenantSettingsCreate) SetNillableDeleteTime(v *time.Time) *TenantSettingsCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *TenantSettingsCreate) SetTenantID(v uint32) *TenantSettingsCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *TenantSettingsCreate) SetNillableTenantID(v *uint32) *TenantSettingsCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetOcrLanguage sets the "ocr_language" field.
+func (_c *TenantSettingsCreate) SetOcrLanguage(v string) *TenantSettingsCreate {
+	_c.mutation.SetOcrLanguage(v)
+	return _c
+}
+
+// SetNillableOcrLanguage sets the "ocr_language" field if the given value is not nil.
+func (_c *TenantSettingsCreate) SetNillableOcrLanguage(v *string) *TenantSettingsCreate {
+	if v != nil {
+		_c.SetOcrLanguage(*v)
+	}
+	return _c
+}
+
+// SetDisabledProcessingSteps sets the "disabled_processing_steps" field.
+func (_c *TenantSettingsCreate) SetDisabledProcessingSteps(v []string) *TenantSettingsCreate {
+	_c.mutation.SetDisabledProcessingSteps(v)
+	return _c
+}
+
+// SetAllowedMimeTypes sets the "allowed_mime_types" field.
+func (_c *TenantSettingsCreate) SetAllowedMimeTypes(v []string) *TenantSettingsCreate {
+	_c.mutation.SetAllowedMimeTypes(v)
+	return _c
+}
+
+// SetDefaultRetentionClass sets the "default_retention_class" field.
+func (_c *TenantSettingsCreate) SetDefaultRetentionClass(v string) *TenantSettingsCreate {
+	_c.mutation.SetDefaultRetentionClass(v)
+	return _c
+}
+
+// SetNillableDefaultRetentionClass sets the "default_retention_class" field if the given value is not nil.
+func (_c *TenantSettingsCreate) SetNillableDefaultRetentionClass(v *string) *TenantSettingsCreate {
+	if v != nil {
+		_c.SetDefaultRetentionClass(*v)
+	}
+	return _c
+}
+
+// SetStorageBackend sets the "storage_backend" field.
+func (_c *TenantSettingsCreate) SetStorageBackend(v string) *TenantSettingsCreate {
+	_c.mutation.SetStorageBackend(v)
+	return _c
+}
+
+// SetNillableStorageBackend sets the "storage_backend" field if the given value is not nil.
+func (_c *TenantSettingsCreate) SetNillableStorageBackend(v *string) *TenantSettingsCreate {
+	if v != nil {
+		_c.SetStorageBackend(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TenantSettingsCreate) SetID(v uint32) *TenantSettingsCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the TenantSettingsMutation object of the builder.
+func (_c *TenantSettingsCreate) Mutation() *TenantSettingsMutation {
+	return _c.mutation
+}
+
+// Save creates the TenantSettings in the database.
+func (_c *TenantSettingsCreate) Save(ctx context.Context) (*TenantSettings, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TenantSettingsCreate) SaveX(ctx context.Context) *TenantSettings {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TenantSettingsCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TenantSettingsCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TenantSettingsCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := tenantsettings.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TenantSettingsCreate) check() error {
+	if v, ok := _c.mutation.OcrLanguage(); ok {
+		if err := tenantsettings.OcrLanguageValidator(v); err != nil {
+			return &ValidationError{Name: "ocr_language", err: fmt.Errorf(`ent: validator failed for field "TenantSettings.ocr_language": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DefaultRetentionClass(); ok {
+		if err := tenantsettings.DefaultRetentionClassValidator(v); err != nil {
+			return &ValidationError{Name: "default_retention_class", err: fmt.Errorf(`ent: validator failed for field "TenantSettings.default_retention_class": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.StorageBackend(); ok {
+		if err := tenantsettings.StorageBackendValidator(v); err != nil {
+			return &ValidationError{Name: "storage_backend", err: fmt.Errorf(`ent: validator failed for field "TenantSettings.storage_backend": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := tenantsettings.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "TenantSettings.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *TenantSettingsCreate) sqlSave(ctx context.Context) (*TenantSettings, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TenantSettingsCreate) createSpec() (*TenantSettings, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TenantSettings{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(tenantsettings.Table, sqlgraph.NewFieldSpec(tenantsettings.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(tenantsettings.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(tenantsettings.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(tenantsettings.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(tenantsettings.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.OcrLanguage(); ok {
+		_spec.SetField(tenantsettings.FieldOcrLanguage, field.TypeString, value)
+		_node.OcrLanguage = value
+	}
+	if value, ok := _c.mutation.DisabledProcessingSteps(); ok {
+		_spec.SetField(tenantsettings.FieldDisabledProcessingSteps, field.TypeJSON, value)
+		_node.DisabledProcessingSteps = value
+	}
+	if value, ok := _c.mutation.AllowedMimeTypes(); ok {
+		_spec.SetField(tenantsettings.FieldAllowedMimeTypes, field.TypeJSON, value)
+		_node.AllowedMimeTypes = value
+	}
+	if value, ok := _c.mutation.DefaultRetentionClass(); ok {
+		_spec.SetField(tenantsettings.FieldDefaultRetentionClass, field.TypeString, value)
+		_node.DefaultRetentionClass = value
+	}
+	if value, ok := _c.mutation.StorageBackend(); ok {
+		_spec.SetField(tenantsettings.FieldStorageBackend, field.TypeString, value)
+		_node.StorageBackend = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TenantSettings.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TenantSettingsUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TenantSettingsCreate) OnConflict(opts ...sql.ConflictOption) *TenantSettingsUpsertOne {
+	_c.conflict = opts
+	return &TenantSettingsUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TenantSettings.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TenantSettingsCreate) OnConflictColumns(columns ...string) *TenantSettingsUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TenantSettingsUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// TenantSettingsUpsertOne is the builder for "upsert"-ing
+	//  one TenantSettings node.
+	TenantSettingsUpsertOne struct {
+		create *TenantSettingsCreate
+	}
+
+	// TenantSettingsUpsert is the "OnConflict" setter.
+	TenantSettingsUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *TenantSettingsUpsert) SetUpdateTime(v time.Time) *TenantSettingsUpsert {
+	u.Set(tenantsettings.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *TenantSettingsUpsert) UpdateUpdateTime() *TenantSettingsUpsert {
+	u.SetExcluded(tenantsettings.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *TenantSettingsUpsert) ClearUpdateTime() *TenantSettingsUpsert {
+	u.SetNull(tenantsettings.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *TenantSettingsUpsert) SetDeleteTime(v time.Time) *TenantSettingsUpsert {
+	u.Set(tenantsettings.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *TenantSettingsUpsert) UpdateDeleteTime() *TenantSettingsUpsert {
+	u.SetExcluded(tenantsettings.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *TenantSettingsUpsert) ClearDeleteTime() *TenantSettingsUpsert {
+	u.SetNull(tenantsettings.FieldDeleteTime)
+	return u
+}
+
+// SetOcrLanguage sets the "ocr_language" field.
+func (u *TenantSettingsUpsert) SetOcrLanguage(v string) *TenantSettingsUpsert {
+	u.Set(tenantsettings.FieldOcrLanguage, v)
+	return u
+}
+
+// UpdateOcrLanguage sets the "ocr_language" field to the value that was provided on create.
+func (u *TenantSettingsUpsert) UpdateOcrLanguage() *TenantSettingsUpsert {
+	u.SetExcluded(tenantsettings.FieldOcrLanguage)
+	return u
+}
+
+// ClearOcrLanguage clears the value of the "ocr_language" field.
+func (u *TenantSettingsUpsert) ClearOcrLanguage() *TenantSettingsUpsert {
+	u.SetNull(tenantsettings.FieldOcrLanguage)
+	return u
+}
+
+// SetDisabledProcessingSteps sets the "disabled_processing_steps" field.
+func (u *TenantSettingsUpsert) SetDisabledProcessingSteps(v []string) *TenantSettingsUpsert {
+	u.Set(tenantsettings.FieldDisabledProcessingSteps, v)
+	return u
+}
+
+// UpdateDisabledProcessingSteps sets the "disabled_processing_steps" field to the value that was provided on create.
+func (u *TenantSettingsUpsert) UpdateDisabledProcessingSteps() *TenantSettingsUpsert {
+	u.SetExcluded(tenantsettings.FieldDisabledProcessingSteps)
+	return u
+}
+
+// ClearDisabledProcessingSteps clears the value of the "disabled_processing_steps" field.
+func (u *TenantSettingsUpsert) ClearDisabledProcessingSteps() *TenantSettingsUpsert {
+	u.SetNull(tenantsettings.FieldDisabledProcessingSteps)
+	return u
+}
+
+// SetAllowedMimeTypes sets the "allowed_mime_types" field.
+func (u *TenantSettingsUpsert) SetAllowedMimeTypes(v []string) *TenantSettingsUpsert {
+	u.Set(tenantsettings.FieldAllowedMimeTypes, v)
+	return u
+}
+
+// UpdateAllowedMimeTypes sets the "allowed_mime_types" field to the value that was provided on create.
+func (u *TenantSettingsUpsert) UpdateAllowedMimeTypes() *TenantSettingsUpsert {
+	u.SetExcluded(tenantsettings.FieldAllowedMimeTypes)
+	return u
+}
+
+// ClearAllowedMimeTypes clears the value of the "allowed_mime_types" field.
+func (u *TenantSettingsUpsert) ClearAllowedMimeTypes() *TenantSettingsUpsert {
+	u.SetNull(tenantsettings.FieldAllowedMimeTypes)
+	return u
+}
+
+// SetDefaultRetentionClass sets the "default_retention_class" field.
+func (u *TenantSettingsUpsert) SetDefaultRetentionClass(v string) *TenantSettingsUpsert {
+	u.Set(tenantsettings.FieldDefaultRetentionClass, v)
+	return u
+}
+
+// UpdateDefaultRetentionClass sets the "default_retention_class" field to the value that was provided on create.
+func (u *TenantSettingsUpsert) UpdateDefaultRetentionClass() *TenantSettingsUpsert {
+	u.SetExcluded(tenantsettings.FieldDefaultRetentionClass)
+	return u
+}
+
+// ClearDefaultRetentionClass clears the value of the "default_retention_class" field.
+func (u *TenantSettingsUpsert) ClearDefaultRetentionClass() *TenantSettingsUpsert {
+	u.SetNull(tenantsettings.FieldDefaultRetentionClass)
+	return u
+}
+
+// SetStorageBackend sets the "storage_backend" field.
+func (u *TenantSettingsUpsert) SetStorageBackend(v string) *TenantSettingsUpsert {
+	u.Set(tenantsettings.FieldStorageBackend, v)
+	return u
+}
+
+// UpdateStorageBackend sets the "storage_backend" field to the value that was provided on create.
+func (u *TenantSettingsUpsert) UpdateStorageBackend() *TenantSettingsUpsert {
+	u.SetExcluded(tenantsettings.FieldStorageBackend)
+	return u
+}
+
+// ClearStorageBackend clears the value of the "storage_backend" field.
+func (u *TenantSettingsUpsert) ClearStorageBackend() *TenantSettingsUpsert {
+	u.SetNull(tenantsettings.FieldStorageBackend)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TenantSettings.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tenantsettings.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TenantSettingsUpsertOne) UpdateNewValues() *TenantSettingsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(tenantsettings.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(tenantsettings.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(tenantsettings.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TenantSettings.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TenantSettingsUpsertOne) Ignore() *TenantSettingsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TenantSettingsUpsertOne) DoNothing() *TenantSettingsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TenantSettingsCreate.OnConflict
+// documentation for more info.
+func (u *TenantSettingsUpsertOne) Update(set func(*TenantSettingsUpsert)) *TenantSettingsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TenantSettingsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *TenantSettingsUpsertOne) SetUpdateTime(v time.Time) *TenantSettingsUpsertOne {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *TenantSettingsUpsertOne) UpdateUpdateTime() *TenantSettingsUpsertOne {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *TenantSettingsUpsertOne) ClearUpdateTime() *TenantSettingsUpsertOne {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *TenantSettingsUpsertOne) SetDeleteTime(v time.Time) *TenantSettingsUpsertOne {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *TenantSettingsUpsertOne) UpdateDeleteTime() *TenantSettingsUpsertOne {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *TenantSettingsUpsertOne) ClearDeleteTime() *TenantSettingsUpsertOne {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetOcrLanguage sets the "ocr_language" field.
+func (u *TenantSettingsUpsertOne) SetOcrLanguage(v string) *TenantSettingsUpsertOne {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.SetOcrLanguage(v)
+	})
+}
+
+// UpdateOcrLanguage sets the "ocr_language" field to the value that was provided on create.
+func (u *TenantSettingsUpsertOne) UpdateOcrLanguage() *TenantSettingsUpsertOne {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.UpdateOcrLanguage()
+	})
+}
+
+// ClearOcrLanguage clears the value of the "ocr_language" field.
+func (u *TenantSettingsUpsertOne) ClearOcrLanguage() *TenantSettingsUpsertOne {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.ClearOcrLanguage()
+	})
+}
+
+// SetDisabledProcessingSteps sets the "disabled_processing_steps" field.
+func (u *TenantSettingsUpsertOne) SetDisabledProcessingSteps(v []string) *TenantSettingsUpsertOne {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.SetDisabledProcessingSteps(v)
+	})
+}
+
+// UpdateDisabledProcessingSteps sets the "disabled_processing_steps" field to the value that was provided on create.
+func (u *TenantSettingsUpsertOne) UpdateDisabledProcessingSteps() *TenantSettingsUpsertOne {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.UpdateDisabledProcessingSteps()
+	})
+}
+
+// ClearDisabledProcessingSteps clears the value of the "disabled_processing_steps" field.
+func (u *TenantSettingsUpsertOne) ClearDisabledProcessingSteps() *TenantSettingsUpsertOne {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.ClearDisabledProcessingSteps()
+	})
+}
+
+// SetAllowedMimeTypes sets the "allowed_mime_types" field.
+func (u *TenantSettingsUpsertOne) SetAllowedMimeTypes(v []string) *TenantSettingsUpsertOne {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.SetAllowedMimeTypes(v)
+	})
+}
+
+// UpdateAllowedMimeTypes sets the "allowed_mime_types" field to the value that was provided on create.
+func (u *TenantSettingsUpsertOne) UpdateAllowedMimeTypes() *TenantSettingsUpsertOne {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.UpdateAllowedMimeTypes()
+	})
+}
+
+// ClearAllowedMimeTypes clears the value of the "allowed_mime_types" field.
+func (u *TenantSettingsUpsertOne) ClearAllowedMimeTypes() *TenantSettingsUpsertOne {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.ClearAllowedMimeTypes()
+	})
+}
+
+// SetDefaultRetentionClass sets the "default_retention_class" field.
+func (u *TenantSettingsUpsertOne) SetDefaultRetentionClass(v string) *TenantSettingsUpsertOne {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.SetDefaultRetentionClass(v)
+	})
+}
+
+// UpdateDefaultRetentionClass sets the "default_retention_class" field to the value that was provided on create.
+func (u *TenantSettingsUpsertOne) UpdateDefaultRetentionClass() *TenantSettingsUpsertOne {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.UpdateDefaultRetentionClass()
+	})
+}
+
+// ClearDefaultRetentionClass clears the value of the "default_retention_class" field.
+func (u *TenantSettingsUpsertOne) ClearDefaultRetentionClass() *TenantSettingsUpsertOne {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.ClearDefaultRetentionClass()
+	})
+}
+
+// SetStorageBackend sets the "storage_backend" field.
+func (u *TenantSettingsUpsertOne) SetStorageBackend(v string) *TenantSettingsUpsertOne {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.SetStorageBackend(v)
+	})
+}
+
+// UpdateStorageBackend sets the "storage_backend" field to the value that was provided on create.
+func (u *TenantSettingsUpsertOne) UpdateStorageBackend() *TenantSettingsUpsertOne {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.UpdateStorageBackend()
+	})
+}
+
+// ClearStorageBackend clears the value of the "storage_backend" field.
+func (u *TenantSettingsUpsertOne) ClearStorageBackend() *TenantSettingsUpsertOne {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.ClearStorageBackend()
+	})
+}
+
+// Exec executes the query.
+func (u *TenantSettingsUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TenantSettingsCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TenantSettingsUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TenantSettingsUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TenantSettingsUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TenantSettingsCreateBulk is the builder for creating many TenantSettings entities in bulk.
+type TenantSettingsCreateBulk struct {
+	config
+	err      error
+	builders []*TenantSettingsCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TenantSettings entities in the database.
+func (_c *TenantSettingsCreateBulk) Save(ctx context.Context) ([]*TenantSettings, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TenantSettings, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TenantSettingsMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TenantSettingsCreateBulk) SaveX(ctx context.Context) []*TenantSettings {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TenantSettingsCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TenantSettingsCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TenantSettings.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TenantSettingsUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TenantSettingsCreateBulk) OnConflict(opts ...sql.ConflictOption) *TenantSettingsUpsertBulk {
+	_c.conflict = opts
+	return &TenantSettingsUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TenantSettings.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TenantSettingsCreateBulk) OnConflictColumns(columns ...string) *TenantSettingsUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TenantSettingsUpsertBulk{
+		create: _c,
+	}
+}
+
+// TenantSettingsUpsertBulk is the builder for "upsert"-ing
+// a bulk of TenantSettings nodes.
+type TenantSettingsUpsertBulk struct {
+	create *TenantSettingsCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TenantSettings.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tenantsettings.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TenantSettingsUpsertBulk) UpdateNewValues() *TenantSettingsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(tenantsettings.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(tenantsettings.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(tenantsettings.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TenantSettings.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TenantSettingsUpsertBulk) Ignore() *TenantSettingsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TenantSettingsUpsertBulk) DoNothing() *TenantSettingsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TenantSettingsCreateBulk.OnConflict
+// documentation for more info.
+func (u *TenantSettingsUpsertBulk) Update(set func(*TenantSettingsUpsert)) *TenantSettingsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TenantSettingsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *TenantSettingsUpsertBulk) SetUpdateTime(v time.Time) *TenantSettingsUpsertBulk {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *TenantSettingsUpsertBulk) UpdateUpdateTime() *TenantSettingsUpsertBulk {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *TenantSettingsUpsertBulk) ClearUpdateTime() *TenantSettingsUpsertBulk {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *TenantSettingsUpsertBulk) SetDeleteTime(v time.Time) *TenantSettingsUpsertBulk {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *TenantSettingsUpsertBulk) UpdateDeleteTime() *TenantSettingsUpsertBulk {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *TenantSettingsUpsertBulk) ClearDeleteTime() *TenantSettingsUpsertBulk {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetOcrLanguage sets the "ocr_language" field.
+func (u *TenantSettingsUpsertBulk) SetOcrLanguage(v string) *TenantSettingsUpsertBulk {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.SetOcrLanguage(v)
+	})
+}
+
+// UpdateOcrLanguage sets the "ocr_language" field to the value that was provided on create.
+func (u *TenantSettingsUpsertBulk) UpdateOcrLanguage() *TenantSettingsUpsertBulk {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.UpdateOcrLanguage()
+	})
+}
+
+// ClearOcrLanguage clears the value of the "ocr_language" field.
+func (u *TenantSettingsUpsertBulk) ClearOcrLanguage() *TenantSettingsUpsertBulk {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.ClearOcrLanguage()
+	})
+}
+
+// SetDisabledProcessingSteps sets the "disabled_processing_steps" field.
+func (u *TenantSettingsUpsertBulk) SetDisabledProcessingSteps(v []string) *TenantSettingsUpsertBulk {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.SetDisabledProcessingSteps(v)
+	})
+}
+
+// UpdateDisabledProcessingSteps sets the "disabled_processing_steps" field to the value that was provided on create.
+func (u *TenantSettingsUpsertBulk) UpdateDisabledProcessingSteps() *TenantSettingsUpsertBulk {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.UpdateDisabledProcessingSteps()
+	})
+}
+
+// ClearDisabledProcessingSteps clears the value of the "disabled_processing_steps" field.
+func (u *TenantSettingsUpsertBulk) ClearDisabledProcessingSteps() *TenantSettingsUpsertBulk {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.ClearDisabledProcessingSteps()
+	})
+}
+
+// SetAllowedMimeTypes sets the "allowed_mime_types" field.
+func (u *TenantSettingsUpsertBulk) SetAllowedMimeTypes(v []string) *TenantSettingsUpsertBulk {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.SetAllowedMimeTypes(v)
+	})
+}
+
+// UpdateAllowedMimeTypes sets the "allowed_mime_types" field to the value that was provided on create.
+func (u *TenantSettingsUpsertBulk) UpdateAllowedMimeTypes() *TenantSettingsUpsertBulk {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.UpdateAllowedMimeTypes()
+	})
+}
+
+// ClearAllowedMimeTypes clears the value of the "allowed_mime_types" field.
+func (u *TenantSettingsUpsertBulk) ClearAllowedMimeTypes() *TenantSettingsUpsertBulk {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.ClearAllowedMimeTypes()
+	})
+}
+
+// SetDefaultRetentionClass sets the "default_retention_class" field.
+func (u *TenantSettingsUpsertBulk) SetDefaultRetentionClass(v string) *TenantSettingsUpsertBulk {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.SetDefaultRetentionClass(v)
+	})
+}
+
+// UpdateDefaultRetentionClass sets the "default_retention_class" field to the value that was provided on create.
+func (u *TenantSettingsUpsertBulk) UpdateDefaultRetentionClass() *TenantSettingsUpsertBulk {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.UpdateDefaultRetentionClass()
+	})
+}
+
+// ClearDefaultRetentionClass clears the value of the "default_retention_class" field.
+func (u *TenantSettingsUpsertBulk) ClearDefaultRetentionClass() *TenantSettingsUpsertBulk {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.ClearDefaultRetentionClass()
+	})
+}
+
+// SetStorageBackend sets the "storage_backend" field.
+func (u *TenantSettingsUpsertBulk) SetStorageBackend(v string) *TenantSettingsUpsertBulk {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.SetStorageBackend(v)
+	})
+}
+
+// UpdateStorageBackend sets the "storage_backend" field to the value that was provided on create.
+func (u *TenantSettingsUpsertBulk) UpdateStorageBackend() *TenantSettingsUpsertBulk {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.UpdateStorageBackend()
+	})
+}
+
+// ClearStorageBackend clears the value of the "storage_backend" field.
+func (u *TenantSettingsUpsertBulk) ClearStorageBackend() *TenantSettingsUpsertBulk {
+	return u.Update(func(s *TenantSettingsUpsert) {
+		s.ClearStorageBackend()
+	})
+}
+
+// Exec executes the query.
+func (u *TenantSettingsUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TenantSettingsCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TenantSettingsCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TenantSettingsUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,627 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/tenantsettings"
+)
+
+// TenantSettingsUpdate is the builder for updating TenantSettings entities.
+type TenantSettingsUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TenantSettingsMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TenantSettingsUpdate builder.
+func (_u *TenantSettingsUpdate) Where(ps ...predicate.TenantSettings) *TenantSettingsUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *TenantSettingsUpdate) SetUpdateTime(v time.Time) *TenantSettingsUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *TenantSettingsUpdate) SetNillableUpdateTime(v *time.Time) *TenantSettingsUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *TenantSettingsUpdate) ClearUpdateTime() *TenantSettingsUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *TenantSettingsUpdate) SetDeleteTime(v time.Time) *TenantSettingsUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *TenantSettingsUpdate) SetNillableDeleteTime(v *time.Time) *TenantSettingsUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *TenantSettingsUpdate) ClearDeleteTime() *TenantSettingsUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetOcrLanguage sets the "ocr_language" field.
+func (_u *TenantSettingsUpdate) SetOcrLanguage(v string) *TenantSettingsUpdate {
+	_u.mutation.SetOcrLanguage(v)
+	return _u
+}
+
+// SetNillableOcrLanguage sets the "ocr_language" field if the given value is not nil.
+func (_u *TenantSettingsUpdate) SetNillableOcrLanguage(v *string) *TenantSettingsUpdate {
+	if v != nil {
+		_u.SetOcrLanguage(*v)
+	}
+	return _u
+}
+
+// ClearOcrLanguage clears the value of the "ocr_language" field.
+func (_u *TenantSettingsUpdate) ClearOcrLanguage() *TenantSettingsUpdate {
+	_u.mutation.ClearOcrLanguage()
+	return _u
+}
+
+// SetDisabledProcessingSteps sets the "disabled_processing_steps" field.
+func (_u *TenantSettingsUpdate) SetDisabledProcessingSteps(v []string) *TenantSettingsUpdate {
+	_u.mutation.SetDisabledProcessingSteps(v)
+	return _u
+}
+
+// AppendDisabledProcessingSteps appends value to the "disabled_processing_steps" field.
+func (_u *TenantSettingsUpdate) AppendDisabledProcessingSteps(v []string) *TenantSettingsUpdate {
+	_u.mutation.AppendDisabledProcessingSteps(v)
+	return _u
+}
+
+// ClearDisabledProcessingSteps clears the value of the "disabled_processing_steps" field.
+func (_u *TenantSettingsUpdate) ClearDisabledProcessingSteps() *TenantSettingsUpdate {
+	_u.mutation.ClearDisabledProcessingSteps()
+	return _u
+}
+
+// SetAllowedMimeTypes sets the "allowed_mime_types" field.
+func (_u *TenantSettingsUpdate) SetAllowedMimeTypes(v []string) *TenantSettingsUpdate {
+	_u.mutation.SetAllowedMimeTypes(v)
+	return _u
+}
+
+// AppendAllowedMimeTypes appends value to the "allowed_mime_types" field.
+func (_u *TenantSettingsUpdate) AppendAllowedMimeTypes(v []string) *TenantSettingsUpdate {
+	_u.mutation.AppendAllowedMimeTypes(v)
+	return _u
+}
+
+// ClearAllowedMimeTypes clears the value of the "allowed_mime_types" field.
+func (_u *TenantSettingsUpdate) ClearAllowedMimeTypes() *TenantSettingsUpdate {
+	_u.mutation.ClearAllowedMimeTypes()
+	return _u
+}
+
+// SetDefaultRetentionClass sets the "default_retention_class" field.
+func (_u *TenantSettingsUpdate) SetDefaultRetentionClass(v string) *TenantSettingsUpdate {
+	_u.mutation.SetDefaultRetentionClass(v)
+	return _u
+}
+
+// SetNillableDefaultRetentionClass sets the "default_retention_class" field if the given value is not nil.
+func (_u *TenantSettingsUpdate) SetNillableDefaultRetentionClass(v *string) *TenantSettingsUpdate {
+	if v != nil {
+		_u.SetDefaultRetentionClass(*v)
+	}
+	return _u
+}
+
+// ClearDefaultRetentionClass clears the value of the "default_retention_class" field.
+func (_u *TenantSettingsUpdate) ClearDefaultRetentionClass() *TenantSettingsUpdate {
+	_u.mutation.ClearDefaultRetentionClass()
+	return _u
+}
+
+// SetStorageBackend sets the "storage_backend" field.
+func (_u *TenantSettingsUpdate) SetStorageBackend(v string) *TenantSettingsUpdate {
+	_u.mutation.SetStorageBackend(v)
+	return _u
+}
+
+// SetNillableStorageBackend sets the "storage_backend" field if the given value is not nil.
+func (_u *TenantSettingsUpdate) SetNillableStorageBackend(v *string) *TenantSettingsUpdate {
+	if v != nil {
+		_u.SetStorageBackend(*v)
+	}
+	return _u
+}
+
+// ClearStorageBackend clears the value of the "storage_backend" field.
+func (_u *TenantSettingsUpdate) ClearStorageBackend() *TenantSettingsUpdate {
+	_u.mutation.ClearStorageBackend()
+	return _u
+}
+
+// Mutation returns the TenantSettingsMutation object of the builder.
+func (_u *TenantSettingsUpdate) Mutation() *TenantSettingsMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TenantSettingsUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantSettingsUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TenantSettingsUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantSettingsUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TenantSettingsUpdate) check() error {
+	if v, ok := _u.mutation.OcrLanguage(); ok {
+		if err := tenantsettings.OcrLanguageValidator(v); err != nil {
+			return &ValidationError{Name: "ocr_language", err: fmt.Errorf(`ent: validator failed for field "TenantSettings.ocr_language": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DefaultRetentionClass(); ok {
+		if err := tenantsettings.DefaultRetentionClassValidator(v); err != nil {
+			return &ValidationError{Name: "default_retention_class", err: fmt.Errorf(`ent: validator failed for field "TenantSettings.default_retention_class": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StorageBackend(); ok {
+		if err := tenantsettings.StorageBackendValidator(v); err != nil {
+			return &ValidationError{Name: "storage_backend", err: fmt.Errorf(`ent: validator failed for field "TenantSettings.storage_backend": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TenantSettingsUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TenantSettingsUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TenantSettingsUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantsettings.Table, tenantsettings.Columns, sqlgraph.NewFieldSpec(tenantsettings.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(tenantsettings.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(tenantsettings.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(tenantsettings.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(tenantsettings.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(tenantsettings.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(tenantsettings.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.OcrLanguage(); ok {
+		_spec.SetField(tenantsettings.FieldOcrLanguage, field.TypeString, value)
+	}
+	if _u.mutation.OcrLanguageCleared() {
+		_spec.ClearField(tenantsettings.FieldOcrLanguage, field.TypeString)
+	}
+	if value, ok := _u.mutation.DisabledProcessingSteps(); ok {
+		_spec.SetField(tenantsettings.FieldDisabledProcessingSteps, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDisabledProcessingSteps(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tenantsettings.FieldDisabledProcessingSteps, value)
+		})
+	}
+	if _u.mutation.DisabledProcessingStepsCleared() {
+		_spec.ClearField(tenantsettings.FieldDisabledProcessingSteps, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AllowedMimeTypes(); ok {
+		_spec.SetField(tenantsettings.FieldAllowedMimeTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedMimeTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tenantsettings.FieldAllowedMimeTypes, value)
+		})
+	}
+	if _u.mutation.AllowedMimeTypesCleared() {
+		_spec.ClearField(tenantsettings.FieldAllowedMimeTypes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DefaultRetentionClass(); ok {
+		_spec.SetField(tenantsettings.FieldDefaultRetentionClass, field.TypeString, value)
+	}
+	if _u.mutation.DefaultRetentionClassCleared() {
+		_spec.ClearField(tenantsettings.FieldDefaultRetentionClass, field.TypeString)
+	}
+	if value, ok := _u.mutation.StorageBackend(); ok {
+		_spec.SetField(tenantsettings.FieldStorageBackend, field.TypeString, value)
+	}
+	if _u.mutation.StorageBackendCleared() {
+		_spec.ClearField(tenantsettings.FieldStorageBackend, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantsettings.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TenantSettingsUpdateOne is the builder for updating a single TenantSettings entity.
+type TenantSettingsUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TenantSettingsMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *TenantSettingsUpdateOne) SetUpdateTime(v time.Time) *TenantSettingsUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *TenantSettingsUpdateOne) SetNillableUpdateTime(v *time.Time) *TenantSettingsUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *TenantSettingsUpdateOne) ClearUpdateTime() *TenantSettingsUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *TenantSettingsUpdateOne) SetDeleteTime(v time.Time) *TenantSettingsUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *TenantSettingsUpdateOne) SetNillableDeleteTime(v *time.Time) *TenantSettingsUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *TenantSettingsUpdateOne) ClearDeleteTime() *TenantSettingsUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetOcrLanguage sets the "ocr_language" field.
+func (_u *TenantSettingsUpdateOne) SetOcrLanguage(v string) *TenantSettingsUpdateOne {
+	_u.mutation.SetOcrLanguage(v)
+	return _u
+}
+
+// SetNillableOcrLanguage sets the "ocr_language" field if the given value is not nil.
+func (_u *TenantSettingsUpdateOne) SetNillableOcrLanguage(v *string) *TenantSettingsUpdateOne {
+	if v != nil {
+		_u.SetOcrLanguage(*v)
+	}
+	return _u
+}
+
+// ClearOcrLanguage clears the value of the "ocr_language" field.
+func (_u *TenantSettingsUpdateOne) ClearOcrLanguage() *TenantSettingsUpdateOne {
+	_u.mutation.ClearOcrLanguage()
+	return _u
+}
+
+// SetDisabledProcessingSteps sets the "disabled_processing_steps" field.
+func (_u *TenantSettingsUpdateOne) SetDisabledProcessingSteps(v []string) *TenantSettingsUpdateOne {
+	_u.mutation.SetDisabledProcessingSteps(v)
+	return _u
+}
+
+// AppendDisabledProcessingSteps appends value to the "disabled_processing_steps" field.
+func (_u *TenantSettingsUpdateOne) AppendDisabledProcessingSteps(v []string) *TenantSettingsUpdateOne {
+	_u.mutation.AppendDisabledProcessingSteps(v)
+	return _u
+}
+
+// ClearDisabledProcessingSteps clears the value of the "disabled_processing_steps" field.
+func (_u *TenantSettingsUpdateOne) ClearDisabledProcessingSteps() *TenantSettingsUpdateOne {
+	_u.mutation.ClearDisabledProcessingSteps()
+	return _u
+}
+
+// SetAllowedMimeTypes sets the "allowed_mime_types" field.
+func (_u *TenantSettingsUpdateOne) SetAllowedMimeTypes(v []string) *TenantSettingsUpdateOne {
+	_u.mutation.SetAllowedMimeTypes(v)
+	return _u
+}
+
+// AppendAllowedMimeTypes appends value to the "allowed_mime_types" field.
+func (_u *TenantSettingsUpdateOne) AppendAllowedMimeTypes(v []string) *TenantSettingsUpdateOne {
+	_u.mutation.AppendAllowedMimeTypes(v)
+	return _u
+}
+
+// ClearAllowedMimeTypes clears the value of the "allowed_mime_types" field.
+func (_u *TenantSettingsUpdateOne) ClearAllowedMimeTypes() *TenantSettingsUpdateOne {
+	_u.mutation.ClearAllowedMimeTypes()
+	return _u
+}
+
+// SetDefaultRetentionClass sets the "default_retention_class" field.
+func (_u *TenantSettingsUpdateOne) SetDefaultRetentionClass(v string) *TenantSettingsUpdateOne {
+	_u.mutation.SetDefaultRetentionClass(v)
+	return _u
+}
+
+// SetNillableDefaultRetentionClass sets the "default_retention_class" field if the given value is not nil.
+func (_u *TenantSettingsUpdateOne) SetNillableDefaultRetentionClass(v *string) *TenantSettingsUpdateOne {
+	if v != nil {
+		_u.SetDefaultRetentionClass(*v)
+	}
+	return _u
+}
+
+// ClearDefaultRetentionClass clears the value of the "default_retention_class" field.
+func (_u *TenantSettingsUpdateOne) ClearDefaultRetentionClass() *TenantSettingsUpdateOne {
+	_u.mutation.ClearDefaultRetentionClass()
+	return _u
+}
+
+// SetStorageBackend sets the "storage_backend" field.
+func (_u *TenantSettingsUpdateOne) SetStorageBackend(v string) *TenantSettingsUpdateOne {
+	_u.mutation.SetStorageBackend(v)
+	return _u
+}
+
+// SetNillableStorageBackend sets the "storage_backend" field if the given value is not nil.
+func (_u *TenantSettingsUpdateOne) SetNillableStorageBackend(v *string) *TenantSettingsUpdateOne {
+	if v != nil {
+		_u.SetStorageBackend(*v)
+	}
+	return _u
+}
+
+// ClearStorageBackend clears the value of the "storage_backend" field.
+func (_u *TenantSettingsUpdateOne) ClearStorageBackend() *TenantSettingsUpdateOne {
+	_u.mutation.ClearStorageBackend()
+	return _u
+}
+
+// Mutation returns the TenantSettingsMutation object of the builder.
+func (_u *TenantSettingsUpdateOne) Mutation() *TenantSettingsMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TenantSettingsUpdate builder.
+func (_u *TenantSettingsUpdateOne) Where(ps ...predicate.TenantSettings) *TenantSettingsUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TenantSettingsUpdateOne) Select(field string, fields ...string) *TenantSettingsUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TenantSettings entity.
+func (_u *TenantSettingsUpdateOne) Save(ctx context.Context) (*TenantSettings, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantSettingsUpdateOne) SaveX(ctx context.Context) *TenantSettings {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TenantSettingsUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantSettingsUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TenantSettingsUpdateOne) check() error {
+	if v, ok := _u.mutation.OcrLanguage(); ok {
+		if err := tenantsettings.OcrLanguageValidator(v); err != nil {
+			return &ValidationError{Name: "ocr_language", err: fmt.Errorf(`ent: validator failed for field "TenantSettings.ocr_language": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DefaultRetentionClass(); ok {
+		if err := tenantsettings.DefaultRetentionClassValidator(v); err != nil {
+			return &ValidationError{Name: "default_retention_class", err: fmt.Errorf(`ent: validator failed for field "TenantSettings.default_retention_class": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StorageBackend(); ok {
+		if err := tenantsettings.StorageBackendValidator(v); err != nil {
+			return &ValidationError{Name: "storage_backend", err: fmt.Errorf(`ent: validator failed for field "TenantSettings.storage_backend": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TenantSettingsUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TenantSettingsUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TenantSettingsUpdateOne) sqlSave(ctx context.Context) (_node *TenantSettings, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantsettings.Table, tenantsettings.Columns, sqlgraph.NewFieldSpec(tenantsettings.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TenantSettings.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tenantsettings.FieldID)
+		for _, f := range fields {
+			if !tenantsettings.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tenantsettings.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(tenantsettings.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(tenantsettings.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(tenantsettings.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(tenantsettings.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(tenantsettings.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(tenantsettings.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.OcrLanguage(); ok {
+		_spec.SetField(tenantsettings.FieldOcrLanguage, field.TypeString, value)
+	}
+	if _u.mutation.OcrLanguageCleared() {
+		_spec.ClearField(tenantsettings.FieldOcrLanguage, field.TypeString)
+	}
+	if value, ok := _u.mutation.DisabledProcessingSteps(); ok {
+		_spec.SetField(tenantsettings.FieldDisabledProcessingSteps, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDisabledProcessingSteps(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tenantsettings.FieldDisabledProcessingSteps, value)
+		})
+	}
+	if _u.mutation.DisabledProcessingStepsCleared() {
+		_spec.ClearField(tenantsettings.FieldDisabledProcessingSteps, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AllowedMimeTypes(); ok {
+		_spec.SetField(tenantsettings.FieldAllowedMimeTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedMimeTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tenantsettings.FieldAllowedMimeTypes, value)
+		})
+	}
+	if _u.mutation.AllowedMimeTypesCleared() {
+		_spec.ClearField(tenantsettings.FieldAllowedMimeTypes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DefaultRetentionClass(); ok {
+		_spec.SetField(tenantsettings.FieldDefaultRetentionClass, field.TypeString, value)
+	}
+	if _u.mutation.DefaultRetentionClassCleared() {
+		_spec.ClearField(tenantsettings.FieldDefaultRetentionClass, field.TypeString)
+	}
+	if value, ok := _u.mutation.StorageBackend(); ok {
+		_spec.SetField(tenantsettings.FieldStorageBackend, field.TypeString, value)
+	}
+	if _u.mutation.StorageBackendCleared() {
+		_spec.ClearField(tenantsettings.FieldStorageBackend, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &TenantSettings{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantsettings.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
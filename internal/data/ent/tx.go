@@ -12,14 +12,62 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AccessibleResource is the client for interacting with the AccessibleResource builders.
+	AccessibleResource *AccessibleResourceClient
+	// AuditEvent is the client for interacting with the AuditEvent builders.
+	AuditEvent *AuditEventClient
 	// AuditLog is the client for interacting with the AuditLog builders.
 	AuditLog *AuditLogClient
 	// Category is the client for interacting with the Category builders.
 	Category *CategoryClient
+	// CategoryDeleteJob is the client for interacting with the CategoryDeleteJob builders.
+	CategoryDeleteJob *CategoryDeleteJobClient
 	// Document is the client for interacting with the Document builders.
 	Document *DocumentClient
+	// DocumentContent is the client for interacting with the DocumentContent builders.
+	DocumentContent *DocumentContentClient
+	// DocumentHistory is the client for interacting with the DocumentHistory builders.
+	DocumentHistory *DocumentHistoryClient
 	// DocumentPermission is the client for interacting with the DocumentPermission builders.
 	DocumentPermission *DocumentPermissionClient
+	// DocumentTag is the client for interacting with the DocumentTag builders.
+	DocumentTag *DocumentTagClient
+	// GroupMembership is the client for interacting with the GroupMembership builders.
+	GroupMembership *GroupMembershipClient
+	// IdempotencyKey is the client for interacting with the IdempotencyKey builders.
+	IdempotencyKey *IdempotencyKeyClient
+	// ImportConnector is the client for interacting with the ImportConnector builders.
+	ImportConnector *ImportConnectorClient
+	// ImportMapping is the client for interacting with the ImportMapping builders.
+	ImportMapping *ImportMappingClient
+	// ImportedFile is the client for interacting with the ImportedFile builders.
+	ImportedFile *ImportedFileClient
+	// NotificationPreference is the client for interacting with the NotificationPreference builders.
+	NotificationPreference *NotificationPreferenceClient
+	// OutboxEvent is the client for interacting with the OutboxEvent builders.
+	OutboxEvent *OutboxEventClient
+	// ReviewTask is the client for interacting with the ReviewTask builders.
+	ReviewTask *ReviewTaskClient
+	// Setting is the client for interacting with the Setting builders.
+	Setting *SettingClient
+	// SignatureRequest is the client for interacting with the SignatureRequest builders.
+	SignatureRequest *SignatureRequestClient
+	// StatisticsRollup is the client for interacting with the StatisticsRollup builders.
+	StatisticsRollup *StatisticsRollupClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
+	// TenantDeleteJob is the client for interacting with the TenantDeleteJob builders.
+	TenantDeleteJob *TenantDeleteJobClient
+	// TenantKey is the client for interacting with the TenantKey builders.
+	TenantKey *TenantKeyClient
+	// TenantQuota is the client for interacting with the TenantQuota builders.
+	TenantQuota *TenantQuotaClient
+	// TenantSettings is the client for interacting with the TenantSettings builders.
+	TenantSettings *TenantSettingsClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WebhookSubscription is the client for interacting with the WebhookSubscription builders.
+	WebhookSubscription *WebhookSubscriptionClient
 
 	// lazily loaded.
 	client     *Client
@@ -151,10 +199,34 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AccessibleResource = NewAccessibleResourceClient(tx.config)
+	tx.AuditEvent = NewAuditEventClient(tx.config)
 	tx.AuditLog = NewAuditLogClient(tx.config)
 	tx.Category = NewCategoryClient(tx.config)
+	tx.CategoryDeleteJob = NewCategoryDeleteJobClient(tx.config)
 	tx.Document = NewDocumentClient(tx.config)
+	tx.DocumentContent = NewDocumentContentClient(tx.config)
+	tx.DocumentHistory = NewDocumentHistoryClient(tx.config)
 	tx.DocumentPermission = NewDocumentPermissionClient(tx.config)
+	tx.DocumentTag = NewDocumentTagClient(tx.config)
+	tx.GroupMembership = NewGroupMembershipClient(tx.config)
+	tx.IdempotencyKey = NewIdempotencyKeyClient(tx.config)
+	tx.ImportConnector = NewImportConnectorClient(tx.config)
+	tx.ImportMapping = NewImportMappingClient(tx.config)
+	tx.ImportedFile = NewImportedFileClient(tx.config)
+	tx.NotificationPreference = NewNotificationPreferenceClient(tx.config)
+	tx.OutboxEvent = NewOutboxEventClient(tx.config)
+	tx.ReviewTask = NewReviewTaskClient(tx.config)
+	tx.Setting = NewSettingClient(tx.config)
+	tx.SignatureRequest = NewSignatureRequestClient(tx.config)
+	tx.StatisticsRollup = NewStatisticsRollupClient(tx.config)
+	tx.Tag = NewTagClient(tx.config)
+	tx.TenantDeleteJob = NewTenantDeleteJobClient(tx.config)
+	tx.TenantKey = NewTenantKeyClient(tx.config)
+	tx.TenantQuota = NewTenantQuotaClient(tx.config)
+	tx.TenantSettings = NewTenantSettingsClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
+	tx.WebhookSubscription = NewWebhookSubscriptionClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -164,7 +236,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: AuditLog.QueryXXX(), the query will be executed
+// applies a query, for example: AccessibleResource.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
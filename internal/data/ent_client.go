@@ -3,7 +3,9 @@ package data
 import (
 	"context"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
 
 	_ "github.com/go-sql-driver/mysql"
 	_ "github.com/jackc/pgx/v5/stdlib"
@@ -43,10 +45,20 @@ func NewEntClient(ctx *bootstrap.Context) (*entCrud.EntClient[*ent.Client], func
 			return nil
 		}
 
+		// Spans per query and mutation; SQL statement spans come from database.enable_trace
+		client.Intercept(traceQueries())
+		client.Use(traceMutations())
+
+		// Tenant users can only update and delete their own tenant's rows
+		client.Use(scopeTenantMutations())
+
+		// Keep the tag references and the category document counters in line with the documents
+		client.Document.Use(applyCategoryRules(), syncDocumentTags(), syncCategoryCounts())
+
 		// Run database migrations
 		if cfg.Data.Database.GetMigrate() {
-			if err := client.Schema.Create(context.Background(), migrate.WithForeignKeys(true)); err != nil {
-				l.Fatalf("failed creating schema resources: %v", err)
+			if err := migrateSchema(context.Background(), drv, client, l); err != nil {
+				l.Fatalf("failed migrating database schema: %v", err)
 			}
 		}
 
@@ -59,3 +71,25 @@ func NewEntClient(ctx *bootstrap.Context) (*entCrud.EntClient[*ent.Client], func
 		}
 	}, nil
 }
+
+// migrateSchema applies the pending versioned migrations. The migrations are written for
+// PostgreSQL; other dialects fall back to ent's auto-migration.
+func migrateSchema(ctx context.Context, drv *sql.Driver, client *ent.Client, l *log.Helper) error {
+	if drv.Dialect() != dialect.Postgres {
+		l.Warnf("versioned migrations require PostgreSQL, falling back to auto-migration for %s", drv.Dialect())
+		return client.Schema.Create(ctx, migrate.WithForeignKeys(true))
+	}
+
+	migrator, err := NewMigrator(drv.DB(), l)
+	if err != nil {
+		return err
+	}
+	applied, err := migrator.Up(ctx, 0)
+	if err != nil {
+		return err
+	}
+	if applied > 0 {
+		l.Infof("applied %d database migrations", applied)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/signaturerequest"
+)
+
+// SignatureEnvelope is a document sent to signers
+type SignatureEnvelope struct {
+	FileName string
+	Content  []byte
+	Subject  string
+	Message  string
+	// Signers sign in the given order
+	Signers []schema.SignatureSigner
+}
+
+// SignatureCallback is a verified status notification of a provider
+type SignatureCallback struct {
+	EnvelopeID string
+	// Status is empty for notifications that only change signers
+	Status  signaturerequest.Status
+	Signers []schema.SignatureSigner
+}
+
+// SignatureProvider sends documents for e-signature
+type SignatureProvider interface {
+	// Name identifies the provider on signature requests
+	Name() string
+	// Send creates an envelope and emails it to its signers, returning the envelope ID
+	Send(ctx context.Context, envelope *SignatureEnvelope) (string, error)
+	// Void cancels an envelope that is not completed yet
+	Void(ctx context.Context, envelopeID, reason string) error
+	// DownloadSigned returns the signed PDF of a completed envelope
+	DownloadSigned(ctx context.Context, envelopeID string) ([]byte, error)
+	// ParseCallback verifies the signature of a status notification and decodes it
+	ParseCallback(header http.Header, body []byte) (*SignatureCallback, error)
+}
+
+// NewSignatureProvider creates the provider selected by PAPERLESS_ESIGN_PROVIDER, or
+// returns nil when e-signatures are disabled
+func NewSignatureProvider(ctx *bootstrap.Context) (SignatureProvider, error) {
+	l := ctx.NewLoggerHelper("paperless/esign")
+
+	switch provider := os.Getenv("PAPERLESS_ESIGN_PROVIDER"); provider {
+	case "":
+		return nil, nil
+	case "docusign":
+		p, err := newDocuSignProvider()
+		if err != nil {
+			return nil, err
+		}
+		l.Infof("sending e-signature requests through DocuSign at %s", p.baseURL)
+		return p, nil
+	default:
+		return nil, fmt.Errorf("unknown PAPERLESS_ESIGN_PROVIDER %q (use docusign)", provider)
+	}
+}
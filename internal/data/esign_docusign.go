@@ -0,0 +1,302 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/signaturerequest"
+)
+
+const (
+	docuSignDefaultOAuthURL = "https://account.docusign.com"
+	docuSignScope           = "signature impersonation"
+	docuSignTimeout         = 2 * time.Minute
+	// docuSignMaxSignedSize bounds the signed PDF read into memory
+	docuSignMaxSignedSize = 512 << 20
+)
+
+// docuSignEvents are the Connect events requested for each envelope
+var docuSignEvents = []string{
+	"envelope-sent",
+	"envelope-delivered",
+	"envelope-completed",
+	"envelope-declined",
+	"envelope-voided",
+	"recipient-completed",
+	"recipient-declined",
+}
+
+// docuSignProvider sends envelopes through the DocuSign eSignature REST API v2.1, or any
+// API compatible with it, authenticated with the JWT grant as the configured user.
+// Status changes arrive as Connect notifications in JSON, signed with HMAC-SHA256.
+type docuSignProvider struct {
+	http        *http.Client
+	token       *accessToken
+	baseURL     string
+	accountID   string
+	callbackURL string
+	hmacSecret  []byte
+}
+
+func newDocuSignProvider() (*docuSignProvider, error) {
+	baseURL := strings.TrimSuffix(os.Getenv("PAPERLESS_ESIGN_BASE_URL"), "/")
+	accountID := os.Getenv("PAPERLESS_ESIGN_ACCOUNT_ID")
+	integrationKey := os.Getenv("PAPERLESS_ESIGN_INTEGRATION_KEY")
+	userID := os.Getenv("PAPERLESS_ESIGN_USER_ID")
+	callbackURL := os.Getenv("PAPERLESS_ESIGN_CALLBACK_URL")
+	hmacSecret := os.Getenv("PAPERLESS_ESIGN_HMAC_SECRET")
+	if baseURL == "" || accountID == "" || integrationKey == "" || userID == "" {
+		return nil, errors.New("docusign needs PAPERLESS_ESIGN_BASE_URL, PAPERLESS_ESIGN_ACCOUNT_ID, PAPERLESS_ESIGN_INTEGRATION_KEY and PAPERLESS_ESIGN_USER_ID")
+	}
+	// Unsigned callbacks could complete any envelope, so both are required
+	if callbackURL == "" || hmacSecret == "" {
+		return nil, errors.New("docusign needs PAPERLESS_ESIGN_CALLBACK_URL and PAPERLESS_ESIGN_HMAC_SECRET to receive status callbacks")
+	}
+
+	keyFile := os.Getenv("PAPERLESS_ESIGN_PRIVATE_KEY_FILE")
+	if keyFile == "" {
+		return nil, errors.New("PAPERLESS_ESIGN_PRIVATE_KEY_FILE is required for docusign")
+	}
+	raw, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("read PAPERLESS_ESIGN_PRIVATE_KEY_FILE: %w", err)
+	}
+	privateKey, err := parseRSAPrivateKey(raw)
+	if err != nil {
+		return nil, fmt.Errorf("parse docusign private key: %w", err)
+	}
+
+	oauthURL := strings.TrimSuffix(os.Getenv("PAPERLESS_ESIGN_OAUTH_URL"), "/")
+	if oauthURL == "" {
+		oauthURL = docuSignDefaultOAuthURL
+	}
+	parsedOAuthURL, err := url.Parse(oauthURL)
+	if err != nil || parsedOAuthURL.Host == "" {
+		return nil, fmt.Errorf("invalid PAPERLESS_ESIGN_OAUTH_URL %q", oauthURL)
+	}
+
+	httpClient := &http.Client{Timeout: docuSignTimeout}
+	p := &docuSignProvider{
+		http:        httpClient,
+		baseURL:     baseURL,
+		accountID:   accountID,
+		callbackURL: callbackURL,
+		hmacSecret:  []byte(hmacSecret),
+	}
+	p.token = &accessToken{fetch: func(ctx context.Context) (string, time.Duration, error) {
+		now := time.Now()
+		assertion, err := signRS256JWT(map[string]any{
+			"iss":   integrationKey,
+			"sub":   userID,
+			"aud":   parsedOAuthURL.Host,
+			"scope": docuSignScope,
+			"iat":   now.Unix(),
+			"exp":   now.Add(time.Hour).Unix(),
+		}, privateKey)
+		if err != nil {
+			return "", 0, err
+		}
+		return requestToken(ctx, httpClient, oauthURL+"/oauth/token", url.Values{
+			"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+			"assertion":  {assertion},
+		})
+	}}
+	return p, nil
+}
+
+func (p *docuSignProvider) Name() string {
+	return "docusign"
+}
+
+func (p *docuSignProvider) envelopesURL() string {
+	return p.baseURL + "/v2.1/accounts/" + url.PathEscape(p.accountID) + "/envelopes"
+}
+
+func (p *docuSignProvider) Send(ctx context.Context, envelope *SignatureEnvelope) (string, error) {
+	signers := make([]map[string]string, 0, len(envelope.Signers))
+	for i, s := range envelope.Signers {
+		n := strconv.Itoa(i + 1)
+		signers = append(signers, map[string]string{
+			"email":        s.Email,
+			"name":         s.Name,
+			"recipientId":  n,
+			"routingOrder": n,
+		})
+	}
+
+	body := map[string]any{
+		"emailSubject": envelope.Subject,
+		"emailBlurb":   envelope.Message,
+		"status":       "sent",
+		"documents": []map[string]string{{
+			"documentId":     "1",
+			"name":           envelope.FileName,
+			"fileExtension":  strings.TrimPrefix(path.Ext(envelope.FileName), "."),
+			"documentBase64": base64.StdEncoding.EncodeToString(envelope.Content),
+		}},
+		"recipients": map[string]any{"signers": signers},
+		"eventNotification": map[string]any{
+			"url":                   p.callbackURL,
+			"requireAcknowledgment": "true",
+			"includeHMAC":           "true",
+			"deliveryMode":          "SIM",
+			"events":                docuSignEvents,
+			"eventData": map[string]any{
+				"version":     "restv2.1",
+				"format":      "json",
+				"includeData": []string{"recipients"},
+			},
+		},
+	}
+
+	var created struct {
+		EnvelopeID string `json:"envelopeId"`
+	}
+	if err := p.send(ctx, http.MethodPost, p.envelopesURL(), body, &created); err != nil {
+		return "", fmt.Errorf("docusign: create envelope: %w", err)
+	}
+	if created.EnvelopeID == "" {
+		return "", errors.New("docusign: create envelope: response has no envelope ID")
+	}
+	return created.EnvelopeID, nil
+}
+
+func (p *docuSignProvider) Void(ctx context.Context, envelopeID, reason string) error {
+	body := map[string]string{
+		"status":       "voided",
+		"voidedReason": reason,
+	}
+	if err := p.send(ctx, http.MethodPut, p.envelopesURL()+"/"+url.PathEscape(envelopeID), body, nil); err != nil {
+		return fmt.Errorf("docusign: void envelope %s: %w", envelopeID, err)
+	}
+	return nil
+}
+
+func (p *docuSignProvider) DownloadSigned(ctx context.Context, envelopeID string) ([]byte, error) {
+	content, _, err := downloadAuthorized(ctx, p.http, p.token, p.envelopesURL()+"/"+url.PathEscape(envelopeID)+"/documents/combined", docuSignMaxSignedSize)
+	if err != nil {
+		return nil, fmt.Errorf("docusign: download envelope %s: %w", envelopeID, err)
+	}
+	return content, nil
+}
+
+// send sends an authorized JSON request and decodes the JSON response into v, if set
+func (p *docuSignProvider) send(ctx context.Context, method, rawURL string, body, v any) error {
+	bearer, err := p.token.get(ctx)
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+bearer)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%s", responseError(resp))
+	}
+	if v == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// docuSignStatuses maps envelope statuses to signature request statuses
+var docuSignStatuses = map[string]signaturerequest.Status{
+	"sent":      signaturerequest.StatusSIGNATURE_STATUS_SENT,
+	"delivered": signaturerequest.StatusSIGNATURE_STATUS_DELIVERED,
+	"completed": signaturerequest.StatusSIGNATURE_STATUS_COMPLETED,
+	"declined":  signaturerequest.StatusSIGNATURE_STATUS_DECLINED,
+	"voided":    signaturerequest.StatusSIGNATURE_STATUS_VOIDED,
+}
+
+func (p *docuSignProvider) ParseCallback(header http.Header, body []byte) (*SignatureCallback, error) {
+	if !p.verifyHMAC(header, body) {
+		return nil, errors.New("invalid callback signature")
+	}
+
+	var notification struct {
+		Event string `json:"event"`
+		Data  struct {
+			EnvelopeID      string `json:"envelopeId"`
+			EnvelopeSummary struct {
+				Status     string `json:"status"`
+				Recipients struct {
+					Signers []struct {
+						Name   string `json:"name"`
+						Email  string `json:"email"`
+						Status string `json:"status"`
+					} `json:"signers"`
+				} `json:"recipients"`
+			} `json:"envelopeSummary"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &notification); err != nil {
+		return nil, fmt.Errorf("invalid callback: %w", err)
+	}
+	if notification.Data.EnvelopeID == "" {
+		return nil, errors.New("invalid callback: no envelope ID")
+	}
+
+	callback := &SignatureCallback{EnvelopeID: notification.Data.EnvelopeID}
+	status := notification.Data.EnvelopeSummary.Status
+	if status == "" && strings.HasPrefix(notification.Event, "envelope-") {
+		status = strings.TrimPrefix(notification.Event, "envelope-")
+	}
+	callback.Status = docuSignStatuses[status]
+	for _, s := range notification.Data.EnvelopeSummary.Recipients.Signers {
+		callback.Signers = append(callback.Signers, schema.SignatureSigner{
+			Name:   s.Name,
+			Email:  s.Email,
+			Status: s.Status,
+		})
+	}
+	return callback, nil
+}
+
+// verifyHMAC checks the X-DocuSign-Signature-N headers, one per active Connect key, against
+// the base64 HMAC-SHA256 of the body
+func (p *docuSignProvider) verifyHMAC(header http.Header, body []byte) bool {
+	mac := hmac.New(sha256.New, p.hmacSecret)
+	mac.Write(body)
+	expected := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	for i := 1; ; i++ {
+		signature := header.Get("X-DocuSign-Signature-" + strconv.Itoa(i))
+		if signature == "" {
+			return false
+		}
+		if hmac.Equal([]byte(signature), []byte(expected)) {
+			return true
+		}
+	}
+}
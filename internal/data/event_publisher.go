@@ -0,0 +1,422 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	appViewer "github.com/go-tangra/go-tangra-common/viewer"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	"github.com/segmentio/kafka-go"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/metrics"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+const (
+	// cloudEventsSpecVersion is the CloudEvents version of published events
+	cloudEventsSpecVersion = "1.0"
+	// CloudEventsContentType is the media type of a CloudEvent in structured JSON mode
+	CloudEventsContentType = "application/cloudevents+json"
+
+	// defaultEventSource identifies this module as the source of published events
+	defaultEventSource = "/paperless"
+
+	eventBatchSize       = 100
+	eventPublishTimeout  = 10 * time.Second
+	eventShutdownTimeout = 10 * time.Second
+	eventPollInterval    = 2 * time.Second
+	// eventRetryDelay doubles per failed attempt up to eventMaxRetryDelay
+	eventRetryDelay      = time.Second
+	eventMaxRetryDelay   = time.Minute
+	defaultNATSURL       = "nats://localhost:4222"
+	defaultKafkaBrokers  = "localhost:9092"
+	defaultKafkaTopic    = "paperless.events"
+	defaultSubjectPrefix = "paperless"
+)
+
+// CloudEvent is a lifecycle event in the CloudEvents 1.0 JSON format, so standard consumers,
+// brokers and routers can handle it without a custom adapter
+type CloudEvent struct {
+	SpecVersion string `json:"specversion"`
+	ID          string `json:"id"`
+	Source      string `json:"source"`
+	Type        string `json:"type"`
+	// Subject is the ID of the document or category the event is about
+	Subject         string    `json:"subject,omitempty"`
+	Time            time.Time `json:"time"`
+	DataContentType string    `json:"datacontenttype"`
+	Data            any       `json:"data"`
+	// TenantID is the tenantid extension attribute
+	TenantID uint32 `json:"tenantid"`
+}
+
+// eventMessage is an encoded event ready to be sent
+type eventMessage struct {
+	id    string
+	typ   string
+	key   string
+	value []byte
+}
+
+// eventSink sends encoded events to a broker
+type eventSink interface {
+	publish(ctx context.Context, messages []eventMessage) error
+	close() error
+}
+
+// EventHandler receives published events in-process. It is called by the outbox relay, and
+// an event whose handler fails is handed over again later, so handlers must be idempotent.
+type EventHandler func(ctx context.Context, event *CloudEvent) error
+
+// EventListener is told about events published by this replica as soon as their transaction
+// commits, without waiting for the relay. It is called on the publishing request's
+// goroutine, so it must not block. Events published by other replicas are not seen.
+type EventListener func(event *CloudEvent)
+
+// EventPublisher publishes document and permission lifecycle events to NATS or Kafka,
+// selected by PAPERLESS_EVENTS_BROKER, and to in-process subscribers. Events are written to
+// an outbox table in the transaction of the change they describe, and a relay publishes and
+// then removes them, retrying while the broker is unavailable. Events are therefore
+// published at least once, and never for changes that were rolled back. Without a broker or
+// subscribers, Publish does nothing.
+type EventPublisher struct {
+	log    *log.Helper
+	outbox *OutboxRepo
+	sink   eventSink
+	source string
+
+	mu        sync.RWMutex
+	handlers  []EventHandler
+	listeners []EventListener
+
+	relayOnce sync.Once
+	wake      chan struct{}
+	cancel    context.CancelFunc
+	done      chan struct{}
+}
+
+// NewEventPublisher creates an EventPublisher configured by PAPERLESS_EVENTS_BROKER (nats or
+// kafka), PAPERLESS_EVENTS_SOURCE, PAPERLESS_EVENTS_NATS_URL, PAPERLESS_EVENTS_SUBJECT_PREFIX,
+// PAPERLESS_EVENTS_KAFKA_BROKERS and PAPERLESS_EVENTS_KAFKA_TOPIC
+func NewEventPublisher(ctx *bootstrap.Context, outbox *OutboxRepo) (*EventPublisher, func(), error) {
+	l := ctx.NewLoggerHelper("events/data/paperless-service")
+
+	p := &EventPublisher{
+		log:    l,
+		outbox: outbox,
+		source: getEnvOrDefault("PAPERLESS_EVENTS_SOURCE", defaultEventSource),
+		wake:   make(chan struct{}, 1),
+	}
+
+	broker := strings.ToLower(getEnvOrDefault("PAPERLESS_EVENTS_BROKER", ""))
+	switch broker {
+	case "":
+		return p, p.close, nil
+	case "nats":
+		sink, err := newNATSSink(getEnvOrDefault("PAPERLESS_EVENTS_NATS_URL", defaultNATSURL),
+			getEnvOrDefault("PAPERLESS_EVENTS_SUBJECT_PREFIX", defaultSubjectPrefix))
+		if err != nil {
+			return nil, func() {}, err
+		}
+		p.sink = sink
+	case "kafka":
+		p.sink = newKafkaSink(strings.Split(getEnvOrDefault("PAPERLESS_EVENTS_KAFKA_BROKERS", defaultKafkaBrokers), ","),
+			getEnvOrDefault("PAPERLESS_EVENTS_KAFKA_TOPIC", defaultKafkaTopic))
+	default:
+		return nil, func() {}, fmt.Errorf("unknown PAPERLESS_EVENTS_BROKER %q (use nats or kafka)", broker)
+	}
+
+	p.startRelay()
+
+	l.Infof("publishing lifecycle events to %s", broker)
+
+	return p, p.close, nil
+}
+
+// Subscribe registers an in-process handler for every published event
+func (p *EventPublisher) Subscribe(handler EventHandler) {
+	p.mu.Lock()
+	p.handlers = append(p.handlers, handler)
+	p.mu.Unlock()
+
+	p.startRelay()
+}
+
+// Listen registers a listener for events published by this replica
+func (p *EventPublisher) Listen(listener EventListener) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.listeners = append(p.listeners, listener)
+}
+
+// Enabled reports whether published events go anywhere
+func (p *EventPublisher) Enabled() bool {
+	if p == nil {
+		return false
+	}
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.sink != nil || len(p.handlers) > 0 || len(p.listeners) > 0
+}
+
+// Publish adds an event of a tenant about a resource to the outbox. Called with the context
+// of a Transaction, the event is only published if the transaction commits, and a failure
+// to store it should roll the transaction back.
+func (p *EventPublisher) Publish(ctx context.Context, tenantID uint32, resourceID, eventType string, data any) error {
+	if !p.Enabled() {
+		return nil
+	}
+
+	event := &CloudEvent{
+		SpecVersion:     cloudEventsSpecVersion,
+		ID:              uuid.NewString(),
+		Source:          p.source,
+		Type:            eventType,
+		Subject:         resourceID,
+		Time:            time.Now().UTC(),
+		DataContentType: "application/json",
+		Data:            data,
+		TenantID:        tenantID,
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		p.log.Errorf("failed to encode %s event: %v", eventType, err)
+		return paperlessV1.ErrorInternalServerError("failed to encode event")
+	}
+	if err := p.outbox.Add(ctx, event, payload); err != nil {
+		return err
+	}
+
+	// Announce the event once it is committed
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		tx.OnCommit(func(next ent.Committer) ent.Committer {
+			return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+				err := next.Commit(ctx, tx)
+				if err == nil {
+					p.committed(event)
+				}
+				return err
+			})
+		})
+	} else {
+		p.committed(event)
+	}
+	return nil
+}
+
+// committed notifies the listeners of a stored event and wakes the relay to publish it
+func (p *EventPublisher) committed(event *CloudEvent) {
+	p.mu.RLock()
+	listeners := p.listeners
+	p.mu.RUnlock()
+	for _, listener := range listeners {
+		listener(event)
+	}
+
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+}
+
+// startRelay starts the relay once there is somewhere to publish to
+func (p *EventPublisher) startRelay() {
+	p.relayOnce.Do(func() {
+		// The relay has no caller, so it acts as the system for ent privacy
+		ctx, cancel := context.WithCancel(appViewer.NewSystemViewerContext(context.Background()))
+		p.cancel = cancel
+		p.done = make(chan struct{})
+		go p.relay(ctx)
+	})
+}
+
+// relay publishes due outbox events when new ones are added and periodically for retries
+func (p *EventPublisher) relay(ctx context.Context) {
+	defer close(p.done)
+
+	ticker := time.NewTicker(eventPollInterval)
+	defer ticker.Stop()
+
+	for {
+		// Keep going while full batches come back, so a backlog drains quickly
+		for ctx.Err() == nil && p.relayBatch(ctx) == eventBatchSize {
+		}
+		if n, err := p.outbox.Count(ctx); err == nil {
+			metrics.EventOutboxPending.Set(float64(n))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-p.wake:
+		case <-ticker.C:
+		}
+	}
+}
+
+// relayBatch publishes a batch of due events and hands them to the subscribers. Events that
+// went everywhere are removed; the others are retried with a growing delay. It returns how
+// many events were claimed.
+func (p *EventPublisher) relayBatch(ctx context.Context) int {
+	rows, err := p.outbox.ClaimDue(ctx, time.Now(), eventPublishTimeout+30*time.Second, eventBatchSize)
+	if err != nil || len(rows) == 0 {
+		return 0
+	}
+
+	failed := make(map[uint32]string)
+
+	if p.sink != nil {
+		messages := make([]eventMessage, 0, len(rows))
+		for _, row := range rows {
+			messages = append(messages, eventMessage{
+				id:    row.EventID,
+				typ:   row.EventType,
+				key:   row.Subject,
+				value: []byte(row.Payload),
+			})
+		}
+
+		publishCtx, cancel := context.WithTimeout(ctx, eventPublishTimeout)
+		err := p.sink.publish(publishCtx, messages)
+		cancel()
+		if err != nil {
+			p.log.Errorf("failed to publish %d events: %v", len(messages), err)
+			for _, row := range rows {
+				failed[row.ID] = err.Error()
+			}
+		}
+		for _, m := range messages {
+			metrics.EventsPublished.WithLabelValues(m.typ, metrics.Result(err)).Inc()
+		}
+	}
+
+	p.mu.RLock()
+	handlers := p.handlers
+	p.mu.RUnlock()
+	if len(handlers) > 0 {
+		for _, row := range rows {
+			// Keep data encoded, handlers pass it on as is
+			event := &CloudEvent{Data: &json.RawMessage{}}
+			if err := json.Unmarshal([]byte(row.Payload), event); err != nil {
+				p.log.Errorf("failed to decode outbox event %s: %v", row.EventID, err)
+				continue
+			}
+			for _, handler := range handlers {
+				if err := handler(ctx, event); err != nil {
+					failed[row.ID] = err.Error()
+				}
+			}
+		}
+	}
+
+	published := make([]uint32, 0, len(rows))
+	for _, row := range rows {
+		msg, ok := failed[row.ID]
+		if !ok {
+			published = append(published, row.ID)
+			continue
+		}
+		delay := min(eventRetryDelay<<min(row.Attempts, 16), eventMaxRetryDelay)
+		_ = p.outbox.RecordFailure(ctx, row.ID, msg, time.Now().Add(delay))
+	}
+	_ = p.outbox.Delete(ctx, published)
+
+	return len(rows)
+}
+
+// close stops the relay and disconnects from the broker. Events left in the outbox are
+// published after the next start.
+func (p *EventPublisher) close() {
+	if p.cancel != nil {
+		p.cancel()
+		select {
+		case <-p.done:
+		case <-time.After(eventShutdownTimeout):
+			p.log.Warnf("timed out waiting for the event relay on shutdown")
+		}
+	}
+
+	if p.sink == nil {
+		return
+	}
+	if err := p.sink.close(); err != nil {
+		p.log.Errorf("failed to close event broker connection: %v", err)
+	}
+}
+
+// natsSink publishes each event to <prefix>.<event type>
+type natsSink struct {
+	conn   *nats.Conn
+	prefix string
+}
+
+func newNATSSink(url, prefix string) (*natsSink, error) {
+	conn, err := nats.Connect(url, nats.Name("paperless-service"), nats.MaxReconnects(-1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS at %s: %w", url, err)
+	}
+	return &natsSink{conn: conn, prefix: prefix}, nil
+}
+
+func (s *natsSink) publish(ctx context.Context, messages []eventMessage) error {
+	for _, m := range messages {
+		msg := nats.NewMsg(s.prefix + "." + m.typ)
+		msg.Data = m.value
+		msg.Header.Set("Content-Type", CloudEventsContentType)
+		// Lets JetStream streams drop duplicates
+		msg.Header.Set(nats.MsgIdHdr, m.id)
+		if err := s.conn.PublishMsg(msg); err != nil {
+			return err
+		}
+	}
+	return s.conn.FlushWithContext(ctx)
+}
+
+func (s *natsSink) close() error {
+	return s.conn.Drain()
+}
+
+// kafkaSink writes all events to one topic, keyed by resource so each resource's events stay ordered
+type kafkaSink struct {
+	writer *kafka.Writer
+}
+
+func newKafkaSink(brokers []string, topic string) *kafkaSink {
+	return &kafkaSink{writer: &kafka.Writer{
+		Addr:         kafka.TCP(brokers...),
+		Topic:        topic,
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireAll,
+		BatchTimeout: 10 * time.Millisecond,
+	}}
+}
+
+func (s *kafkaSink) publish(ctx context.Context, messages []eventMessage) error {
+	kms := make([]kafka.Message, 0, len(messages))
+	for _, m := range messages {
+		kms = append(kms, kafka.Message{
+			Key:   []byte(m.key),
+			Value: m.value,
+			Headers: []kafka.Header{
+				{Key: "content-type", Value: []byte(CloudEventsContentType)},
+				{Key: "event-id", Value: []byte(m.id)},
+				{Key: "event-type", Value: []byte(m.typ)},
+			},
+		})
+	}
+	return s.writer.WriteMessages(ctx, kms...)
+}
+
+func (s *kafkaSink) close() error {
+	return s.writer.Close()
+}
@@ -1,15 +1,19 @@
 package data
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"io"
 	"mime/multipart"
 	"net/http"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/metrics"
+	"github.com/go-tangra/go-tangra-paperless/internal/tracing"
 )
 
 // GotenbergClient wraps the Gotenberg HTTP API for document conversion
@@ -36,49 +40,88 @@ func NewGotenbergClient(ctx *bootstrap.Context) (*GotenbergClient, func(), error
 	}, nil
 }
 
-// ConvertToPDF converts a document (DOC/DOCX) to PDF via Gotenberg's LibreOffice endpoint
-func (c *GotenbergClient) ConvertToPDF(ctx context.Context, content []byte, fileName string) ([]byte, error) {
-	var buf bytes.Buffer
-	writer := multipart.NewWriter(&buf)
-
-	part, err := writer.CreateFormFile("files", fileName)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create form file: %w", err)
-	}
-
-	if _, err := part.Write(content); err != nil {
-		return nil, fmt.Errorf("failed to write file content: %w", err)
-	}
+// ConvertToPDF converts a document (DOC/DOCX) to PDF via Gotenberg's LibreOffice endpoint and
+// writes the PDF to dst, returning its size. The document is streamed from content into the
+// multipart request without being buffered.
+func (c *GotenbergClient) ConvertToPDF(ctx context.Context, content io.Reader, fileName string, dst io.Writer) (int64, error) {
+	ctx, span := tracing.Start(ctx, "gotenberg.convert_to_pdf")
+	start := time.Now()
+	counted := &countingReader{r: content}
+	written, err := c.convertToPDF(ctx, counted, fileName, dst)
+	metrics.ObserveExternalRequest("gotenberg", "convert_to_pdf", start, err)
+	span.SetAttributes(
+		attribute.Int64("gotenberg.request_size", counted.n),
+		attribute.Int64("gotenberg.response_size", written),
+	)
+	tracing.End(span, err)
+	return written, err
+}
 
-	if err := writer.Close(); err != nil {
-		return nil, fmt.Errorf("failed to close multipart writer: %w", err)
-	}
+func (c *GotenbergClient) convertToPDF(ctx context.Context, content io.Reader, fileName string, dst io.Writer) (int64, error) {
+	// The form is written into a pipe the request body is read from
+	body, pw := io.Pipe()
+	writer := multipart.NewWriter(pw)
+	go func() {
+		part, err := writer.CreateFormFile("files", fileName)
+		if err != nil {
+			pw.CloseWithError(fmt.Errorf("failed to create form file: %w", err))
+			return
+		}
+		if _, err := io.Copy(part, content); err != nil {
+			pw.CloseWithError(fmt.Errorf("failed to write file content: %w", err))
+			return
+		}
+		pw.CloseWithError(writer.Close())
+	}()
+	// Stops the writer if the request ends before it has read the whole form
+	defer body.Close()
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint+"/forms/libreoffice/convert", &buf)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint+"/forms/libreoffice/convert", body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create gotenberg request: %w", err)
+		return 0, fmt.Errorf("failed to create gotenberg request: %w", err)
 	}
 
 	req.Header.Set("Content-Type", writer.FormDataContentType())
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("gotenberg conversion failed: %w", err)
+		return 0, fmt.Errorf("gotenberg conversion failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
 		body, readErr := io.ReadAll(resp.Body)
 		if readErr != nil {
-			return nil, fmt.Errorf("gotenberg returned status %d (failed to read response body: %w)", resp.StatusCode, readErr)
+			return 0, fmt.Errorf("gotenberg returned status %d (failed to read response body: %w)", resp.StatusCode, readErr)
 		}
-		return nil, fmt.Errorf("gotenberg returned status %d: %s", resp.StatusCode, string(body))
+		return 0, fmt.Errorf("gotenberg returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	written, err := io.Copy(dst, resp.Body)
+	if err != nil {
+		return written, fmt.Errorf("failed to read gotenberg response: %w", err)
 	}
 
-	pdfContent, err := io.ReadAll(resp.Body)
+	return written, nil
+}
+
+// Ping checks Gotenberg's /health endpoint, which reports whether its modules are up
+func (c *GotenbergClient) Ping(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.endpoint+"/health", nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read gotenberg response: %w", err)
+		return fmt.Errorf("failed to create gotenberg request: %w", err)
 	}
 
-	return pdfContent, nil
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("gotenberg health check failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("gotenberg returned status %d: %s", resp.StatusCode, string(body))
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
 }
@@ -0,0 +1,161 @@
+package data
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documentpermission"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/groupmembership"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// groupMembershipBatchSize limits the number of rows per bulk insert or delete
+const groupMembershipBatchSize = 500
+
+// GroupMember is a user's membership of a directory group
+type GroupMember struct {
+	GroupID   string
+	GroupName string
+	UserID    string
+}
+
+// GroupRepo stores the group memberships synced from the identity directory
+type GroupRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewGroupRepo creates a new GroupRepo
+func NewGroupRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *GroupRepo {
+	return &GroupRepo{
+		log:       ctx.NewLoggerHelper("paperless/group_repo"),
+		entClient: entClient,
+	}
+}
+
+// ListUserGroupIDs returns the IDs of the groups a user belongs to
+func (r *GroupRepo) ListUserGroupIDs(ctx context.Context, tenantID uint32, userID string) ([]string, error) {
+	groupIDs, err := clientFromContext(ctx, r.entClient).GroupMembership.Query().
+		Where(
+			groupmembership.TenantIDEQ(tenantID),
+			groupmembership.UserIDEQ(userID),
+		).
+		Select(groupmembership.FieldGroupID).
+		Strings(ctx)
+	if err != nil {
+		r.log.Errorf("list user groups failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("list user groups failed")
+	}
+	return groupIDs, nil
+}
+
+// ListGroupGrantTenantIDs returns the IDs of all tenants with grants to groups, the tenants
+// whose memberships need syncing
+func (r *GroupRepo) ListGroupGrantTenantIDs(ctx context.Context) ([]uint32, error) {
+	var rows []struct {
+		TenantID uint32 `json:"tenant_id"`
+	}
+	err := clientFromContext(ctx, r.entClient).DocumentPermission.Query().
+		Where(
+			documentpermission.TenantIDNotNil(),
+			documentpermission.SubjectTypeEQ(documentpermission.SubjectTypeSUBJECT_TYPE_GROUP),
+		).
+		GroupBy(documentpermission.FieldTenantID).
+		Scan(ctx, &rows)
+	if err != nil {
+		r.log.Errorf("list group grant tenants failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("list group grant tenants failed")
+	}
+
+	tenantIDs := make([]uint32, 0, len(rows))
+	for _, row := range rows {
+		tenantIDs = append(tenantIDs, row.TenantID)
+	}
+	return tenantIDs, nil
+}
+
+// ReplaceMemberships makes members the tenant's complete set of memberships, returning how
+// many were added and removed. Call it in a transaction so checks never see a partial set.
+func (r *GroupRepo) ReplaceMemberships(ctx context.Context, tenantID uint32, members []GroupMember) (added, removed int, err error) {
+	client := clientFromContext(ctx, r.entClient)
+
+	existing, err := client.GroupMembership.Query().
+		Where(groupmembership.TenantIDEQ(tenantID)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list group memberships failed: %s", err.Error())
+		return 0, 0, paperlessV1.ErrorInternalServerError("list group memberships failed")
+	}
+
+	type key struct{ groupID, userID string }
+	wanted := make(map[key]GroupMember, len(members))
+	for _, m := range members {
+		wanted[key{m.GroupID, m.UserID}] = m
+	}
+
+	var staleIDs []uint32
+	renamed := make(map[string]string)
+	for _, e := range existing {
+		k := key{e.GroupID, e.UserID}
+		m, ok := wanted[k]
+		if !ok {
+			staleIDs = append(staleIDs, e.ID)
+			continue
+		}
+		if m.GroupName != e.GroupName {
+			renamed[m.GroupID] = m.GroupName
+		}
+		delete(wanted, k)
+	}
+
+	for start := 0; start < len(staleIDs); start += groupMembershipBatchSize {
+		end := min(start+groupMembershipBatchSize, len(staleIDs))
+		if _, err := client.GroupMembership.Delete().
+			Where(groupmembership.IDIn(staleIDs[start:end]...)).
+			Exec(ctx); err != nil {
+			r.log.Errorf("delete group memberships failed: %s", err.Error())
+			return 0, 0, paperlessV1.ErrorInternalServerError("delete group memberships failed")
+		}
+	}
+
+	for groupID, name := range renamed {
+		if _, err := client.GroupMembership.Update().
+			Where(
+				groupmembership.TenantIDEQ(tenantID),
+				groupmembership.GroupIDEQ(groupID),
+			).
+			SetGroupName(name).
+			Save(ctx); err != nil {
+			r.log.Errorf("rename group failed: %s", err.Error())
+			return 0, 0, paperlessV1.ErrorInternalServerError("rename group failed")
+		}
+	}
+
+	newMembers := make([]GroupMember, 0, len(wanted))
+	for _, m := range wanted {
+		newMembers = append(newMembers, m)
+	}
+	for start := 0; start < len(newMembers); start += groupMembershipBatchSize {
+		end := min(start+groupMembershipBatchSize, len(newMembers))
+		builders := make([]*ent.GroupMembershipCreate, 0, end-start)
+		for _, m := range newMembers[start:end] {
+			builders = append(builders, client.GroupMembership.Create().
+				SetTenantID(tenantID).
+				SetGroupID(m.GroupID).
+				SetGroupName(m.GroupName).
+				SetUserID(m.UserID))
+		}
+		if err := client.GroupMembership.CreateBulk(builders...).Exec(ctx); err != nil {
+			r.log.Errorf("create group memberships failed: %s", err.Error())
+			return 0, 0, paperlessV1.ErrorInternalServerError("create group memberships failed")
+		}
+	}
+
+	return len(wanted), len(staleIDs), nil
+}
@@ -0,0 +1,104 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/idempotencykey"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// IdempotencyRepo stores the requests sent with an idempotency key and their responses
+type IdempotencyRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewIdempotencyRepo creates a new IdempotencyRepo
+func NewIdempotencyRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *IdempotencyRepo {
+	return &IdempotencyRepo{
+		log:       ctx.NewLoggerHelper("paperless/idempotency_repo"),
+		entClient: entClient,
+	}
+}
+
+// Claim records a request in progress under a key of a tenant's user. If the key is taken,
+// it returns the existing record instead and false. The unique index decides between
+// concurrent claims, so exactly one of them gets true.
+func (r *IdempotencyRepo) Claim(ctx context.Context, tenantID uint32, userID, key, operation, requestHash string, expiresAt time.Time) (*ent.IdempotencyKey, bool, error) {
+	entity, err := r.entClient.Client().IdempotencyKey.Create().
+		SetTenantID(tenantID).
+		SetUserID(userID).
+		SetKey(key).
+		SetOperation(operation).
+		SetRequestHash(requestHash).
+		SetExpiresAt(expiresAt).
+		SetCreateTime(time.Now()).
+		Save(ctx)
+	if err == nil {
+		return entity, true, nil
+	}
+	if !ent.IsConstraintError(err) {
+		r.log.Errorf("claim idempotency key failed: %s", err.Error())
+		return nil, false, paperlessV1.ErrorInternalServerError("claim idempotency key failed")
+	}
+
+	existing, err := r.entClient.Client().IdempotencyKey.Query().
+		Where(
+			idempotencykey.TenantIDEQ(tenantID),
+			idempotencykey.UserIDEQ(userID),
+			idempotencykey.KeyEQ(key),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			// Released between the insert and the query; the client can retry
+			return nil, false, paperlessV1.ErrorIdempotencyKeyInProgress("a request with this idempotency key is in progress")
+		}
+		r.log.Errorf("get idempotency key failed: %s", err.Error())
+		return nil, false, paperlessV1.ErrorInternalServerError("get idempotency key failed")
+	}
+	return existing, false, nil
+}
+
+// Complete stores the response of a claimed request
+func (r *IdempotencyRepo) Complete(ctx context.Context, id uint32, response []byte) error {
+	err := r.entClient.Client().IdempotencyKey.UpdateOneID(id).
+		SetCompleted(true).
+		SetResponse(response).
+		SetUpdateTime(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("complete idempotency key failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("complete idempotency key failed")
+	}
+	return nil
+}
+
+// Release removes a record, e.g. of a request that failed, so the key can be used again
+func (r *IdempotencyRepo) Release(ctx context.Context, id uint32) error {
+	if _, err := r.entClient.Client().IdempotencyKey.Delete().Where(idempotencykey.IDEQ(id)).Exec(ctx); err != nil {
+		r.log.Errorf("release idempotency key failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("release idempotency key failed")
+	}
+	return nil
+}
+
+// DeleteExpired removes the records that expired before now and returns how many
+func (r *IdempotencyRepo) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	n, err := r.entClient.Client().IdempotencyKey.Delete().
+		Where(idempotencykey.ExpiresAtLT(now)).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("delete expired idempotency keys failed: %s", err.Error())
+		return 0, paperlessV1.ErrorInternalServerError("delete expired idempotency keys failed")
+	}
+	return n, nil
+}
@@ -0,0 +1,106 @@
+package data
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+)
+
+// ID strategies selectable with PAPERLESS_ID_STRATEGY
+const (
+	// IDStrategyUUIDv7 generates time-ordered UUIDs (RFC 9562), so new rows are appended to
+	// the primary key index instead of landing on random pages
+	IDStrategyUUIDv7 = "uuidv7"
+	// IDStrategyULID generates time-ordered 26-character ULIDs
+	IDStrategyULID = "ulid"
+	// IDStrategyUUIDv4 generates random UUIDs
+	IDStrategyUUIDv4 = "uuidv4"
+
+	defaultIDStrategy = IDStrategyUUIDv7
+)
+
+// crockford is the Crockford base32 alphabet used by ULIDs
+const crockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// IDGenerator creates the string primary keys of documents and categories. All strategies
+// produce IDs that fit the 36-character ID columns, so a deployment can switch strategies
+// without migrating existing rows.
+type IDGenerator struct {
+	strategy string
+	generate func() (string, error)
+}
+
+// NewIDGenerator creates an IDGenerator using PAPERLESS_ID_STRATEGY (uuidv7, ulid or uuidv4)
+func NewIDGenerator(ctx *bootstrap.Context) *IDGenerator {
+	l := ctx.NewLoggerHelper("paperless/idgen")
+
+	strategy := getEnvOrDefault("PAPERLESS_ID_STRATEGY", defaultIDStrategy)
+	g, err := newIDGenerator(strategy)
+	if err != nil {
+		l.Warnf("%v, using %s", err, defaultIDStrategy)
+		g, _ = newIDGenerator(defaultIDStrategy)
+	}
+	return g
+}
+
+func newIDGenerator(strategy string) (*IDGenerator, error) {
+	g := &IDGenerator{strategy: strategy}
+	switch strategy {
+	case IDStrategyUUIDv7:
+		g.generate = func() (string, error) {
+			id, err := uuid.NewV7()
+			return id.String(), err
+		}
+	case IDStrategyULID:
+		g.generate = newULID
+	case IDStrategyUUIDv4:
+		g.generate = func() (string, error) {
+			id, err := uuid.NewRandom()
+			return id.String(), err
+		}
+	default:
+		return nil, fmt.Errorf("unknown PAPERLESS_ID_STRATEGY %q", strategy)
+	}
+	return g, nil
+}
+
+// Strategy returns the configured strategy
+func (g *IDGenerator) Strategy() string {
+	return g.strategy
+}
+
+// New returns a new ID. It panics if the system's random source fails, like uuid.New.
+func (g *IDGenerator) New() string {
+	id, err := g.generate()
+	if err != nil {
+		panic(fmt.Sprintf("generate %s ID: %v", g.strategy, err))
+	}
+	return id
+}
+
+// newULID encodes a 48-bit millisecond timestamp and 80 random bits as 26 Crockford base32
+// characters, most significant first, so IDs sort by creation time
+func newULID() (string, error) {
+	var b [16]byte
+	ms := uint64(time.Now().UnixMilli())
+	binary.BigEndian.PutUint16(b[0:2], uint16(ms>>32))
+	binary.BigEndian.PutUint32(b[2:6], uint32(ms))
+	if _, err := rand.Read(b[6:]); err != nil {
+		return "", err
+	}
+
+	// 128 bits in 26 characters of 5 bits: the first character carries the top 3 bits
+	hi := binary.BigEndian.Uint64(b[0:8])
+	lo := binary.BigEndian.Uint64(b[8:16])
+	var out [26]byte
+	for i := 25; i >= 0; i-- {
+		out[i] = crockford[lo&0x1f]
+		lo = lo>>5 | hi<<59
+		hi >>= 5
+	}
+	return string(out[:]), nil
+}
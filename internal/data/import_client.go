@@ -0,0 +1,115 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importconnector"
+)
+
+// importHTTPTimeout bounds a single request to a remote file service, including downloads
+const importHTTPTimeout = 10 * time.Minute
+
+// RemoteItem is a file or folder of a remote file service
+type RemoteItem struct {
+	ID       string
+	Name     string
+	Folder   bool
+	MimeType string
+	Size     int64
+	// Version changes whenever the content of the file changes
+	Version      string
+	ModifiedTime time.Time
+}
+
+// ImportClient reads folders and files of a remote file service
+type ImportClient interface {
+	// Folder returns a folder, or an error if the ID is not a readable folder
+	Folder(ctx context.Context, folderID string) (*RemoteItem, error)
+	// List returns the files and folders directly inside a folder
+	List(ctx context.Context, folderID string) ([]RemoteItem, error)
+	// Download returns the content of a file, failing for files larger than maxSize, and
+	// the name and MIME type to store it with
+	Download(ctx context.Context, item RemoteItem, maxSize int64) ([]byte, string, string, error)
+}
+
+// NewImportClient creates the client of a connector's provider
+func NewImportClient(provider importconnector.Provider, credentials string, config map[string]string) (ImportClient, error) {
+	httpClient := &http.Client{Timeout: importHTTPTimeout}
+
+	switch provider {
+	case importconnector.ProviderIMPORT_PROVIDER_GOOGLE_DRIVE:
+		return newGoogleDriveClient(httpClient, credentials, config)
+	case importconnector.ProviderIMPORT_PROVIDER_SHAREPOINT:
+		return newSharePointClient(httpClient, credentials, config)
+	default:
+		return nil, fmt.Errorf("unsupported import provider %q", provider)
+	}
+}
+
+// getAuthorized sends an authorized GET request, returning the response of a 2xx status
+func getAuthorized(ctx context.Context, client *http.Client, token *accessToken, rawURL string) (*http.Response, error) {
+	bearer, err := token.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+bearer)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		defer resp.Body.Close()
+		return nil, fmt.Errorf("%s", responseError(resp))
+	}
+	return resp, nil
+}
+
+// getJSON sends an authorized GET request and decodes its JSON response into v
+func getJSON(ctx context.Context, client *http.Client, token *accessToken, rawURL string, v any) error {
+	resp, err := getAuthorized(ctx, client, token, rawURL)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// downloadAuthorized reads the body of an authorized GET request, up to maxSize bytes
+func downloadAuthorized(ctx context.Context, client *http.Client, token *accessToken, rawURL string, maxSize int64) ([]byte, string, error) {
+	resp, err := getAuthorized(ctx, client, token, rawURL)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read file: %w", err)
+	}
+	if int64(len(content)) > maxSize {
+		return nil, "", fmt.Errorf("file is larger than %d bytes", maxSize)
+	}
+	return content, resp.Header.Get("Content-Type"), nil
+}
+
+// responseError describes an unsuccessful response with the start of its body
+func responseError(resp *http.Response) string {
+	snippet, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return fmt.Sprintf("status %d: %s", resp.StatusCode, strings.TrimSpace(string(snippet)))
+}
@@ -0,0 +1,190 @@
+package data
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	googleDriveAPI        = "https://www.googleapis.com/drive/v3"
+	googleDriveScope      = "https://www.googleapis.com/auth/drive.readonly"
+	googleDefaultTokenURL = "https://oauth2.googleapis.com/token"
+	googleFolderMimeType  = "application/vnd.google-apps.folder"
+	googleAppsMimePrefix  = "application/vnd.google-apps."
+)
+
+// googleExportable lists the Google Workspace file types that are imported as PDF exports
+var googleExportable = map[string]bool{
+	"application/vnd.google-apps.document":     true,
+	"application/vnd.google-apps.spreadsheet":  true,
+	"application/vnd.google-apps.presentation": true,
+	"application/vnd.google-apps.drawing":      true,
+}
+
+// googleServiceAccountKey is the JSON key file of a Google service account
+type googleServiceAccountKey struct {
+	Type        string `json:"type"`
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"`
+	TokenURI    string `json:"token_uri"`
+}
+
+// googleDriveClient reads Google Drive through the Drive v3 REST API, authenticated as a
+// service account. The folders must be shared with the service account, or the config's
+// "subject" names a user to impersonate through domain-wide delegation.
+type googleDriveClient struct {
+	http  *http.Client
+	token *accessToken
+}
+
+func newGoogleDriveClient(httpClient *http.Client, credentials string, config map[string]string) (*googleDriveClient, error) {
+	var key googleServiceAccountKey
+	if err := json.Unmarshal([]byte(credentials), &key); err != nil {
+		return nil, fmt.Errorf("invalid service account key: %w", err)
+	}
+	if key.Type != "service_account" || key.ClientEmail == "" || key.PrivateKey == "" {
+		return nil, errors.New("invalid service account key: not a service account key")
+	}
+	if key.TokenURI == "" {
+		key.TokenURI = googleDefaultTokenURL
+	}
+
+	privateKey, err := parseRSAPrivateKey([]byte(key.PrivateKey))
+	if err != nil {
+		return nil, fmt.Errorf("invalid service account key: %w", err)
+	}
+
+	c := &googleDriveClient{http: httpClient}
+	c.token = &accessToken{fetch: func(ctx context.Context) (string, time.Duration, error) {
+		assertion, err := googleJWT(key, privateKey, config["subject"])
+		if err != nil {
+			return "", 0, err
+		}
+		return requestToken(ctx, httpClient, key.TokenURI, url.Values{
+			"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+			"assertion":  {assertion},
+		})
+	}}
+	return c, nil
+}
+
+// googleJWT signs the assertion exchanged for an access token
+func googleJWT(key googleServiceAccountKey, privateKey *rsa.PrivateKey, subject string) (string, error) {
+	now := time.Now()
+	claims := map[string]any{
+		"iss":   key.ClientEmail,
+		"scope": googleDriveScope,
+		"aud":   key.TokenURI,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	}
+	if subject != "" {
+		claims["sub"] = subject
+	}
+
+	return signRS256JWT(claims, privateKey)
+}
+
+// googleDriveFile is a file resource of the Drive API
+type googleDriveFile struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	MimeType     string    `json:"mimeType"`
+	Size         string    `json:"size"`
+	ModifiedTime time.Time `json:"modifiedTime"`
+	MD5Checksum  string    `json:"md5Checksum"`
+}
+
+func (f *googleDriveFile) item() RemoteItem {
+	size, _ := strconv.ParseInt(f.Size, 10, 64)
+	version := f.MD5Checksum
+	if version == "" {
+		version = f.ModifiedTime.UTC().Format(time.RFC3339Nano)
+	}
+	return RemoteItem{
+		ID:           f.ID,
+		Name:         f.Name,
+		Folder:       f.MimeType == googleFolderMimeType,
+		MimeType:     f.MimeType,
+		Size:         size,
+		Version:      version,
+		ModifiedTime: f.ModifiedTime,
+	}
+}
+
+func (c *googleDriveClient) Folder(ctx context.Context, folderID string) (*RemoteItem, error) {
+	q := url.Values{
+		"fields":            {"id,name,mimeType,modifiedTime"},
+		"supportsAllDrives": {"true"},
+	}
+	var file googleDriveFile
+	if err := getJSON(ctx, c.http, c.token, googleDriveAPI+"/files/"+url.PathEscape(folderID)+"?"+q.Encode(), &file); err != nil {
+		return nil, fmt.Errorf("google drive: get folder %s: %w", folderID, err)
+	}
+	if file.MimeType != googleFolderMimeType {
+		return nil, fmt.Errorf("google drive: %s is not a folder", folderID)
+	}
+	item := file.item()
+	return &item, nil
+}
+
+func (c *googleDriveClient) List(ctx context.Context, folderID string) ([]RemoteItem, error) {
+	var items []RemoteItem
+	pageToken := ""
+	for {
+		q := url.Values{
+			"q":                         {fmt.Sprintf("'%s' in parents and trashed = false", strings.ReplaceAll(folderID, "'", `\'`))},
+			"fields":                    {"nextPageToken,files(id,name,mimeType,size,modifiedTime,md5Checksum)"},
+			"pageSize":                  {"1000"},
+			"supportsAllDrives":         {"true"},
+			"includeItemsFromAllDrives": {"true"},
+		}
+		if pageToken != "" {
+			q.Set("pageToken", pageToken)
+		}
+
+		var page struct {
+			NextPageToken string            `json:"nextPageToken"`
+			Files         []googleDriveFile `json:"files"`
+		}
+		if err := getJSON(ctx, c.http, c.token, googleDriveAPI+"/files?"+q.Encode(), &page); err != nil {
+			return nil, fmt.Errorf("google drive: list folder %s: %w", folderID, err)
+		}
+
+		for _, f := range page.Files {
+			// Forms, shortcuts and other Google types have no file content
+			if strings.HasPrefix(f.MimeType, googleAppsMimePrefix) && f.MimeType != googleFolderMimeType && !googleExportable[f.MimeType] {
+				continue
+			}
+			items = append(items, f.item())
+		}
+
+		if page.NextPageToken == "" {
+			return items, nil
+		}
+		pageToken = page.NextPageToken
+	}
+}
+
+func (c *googleDriveClient) Download(ctx context.Context, item RemoteItem, maxSize int64) ([]byte, string, string, error) {
+	name := item.Name
+	rawURL := googleDriveAPI + "/files/" + url.PathEscape(item.ID) + "?alt=media&supportsAllDrives=true"
+	if googleExportable[item.MimeType] {
+		rawURL = googleDriveAPI + "/files/" + url.PathEscape(item.ID) + "/export?mimeType=" + url.QueryEscape("application/pdf")
+		name += ".pdf"
+	}
+
+	content, mimeType, err := downloadAuthorized(ctx, c.http, c.token, rawURL, maxSize)
+	if err != nil {
+		return nil, "", "", fmt.Errorf("google drive: download %s: %w", item.ID, err)
+	}
+	return content, name, mimeType, nil
+}
@@ -0,0 +1,482 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importconnector"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importedfile"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importmapping"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// ImportRepo stores import connectors, their folder mappings and the files they imported
+type ImportRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	ids       *IDGenerator
+	log       *log.Helper
+}
+
+// NewImportRepo creates a new ImportRepo
+func NewImportRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], ids *IDGenerator) *ImportRepo {
+	return &ImportRepo{
+		log:       ctx.NewLoggerHelper("paperless/import_repo"),
+		entClient: entClient,
+		ids:       ids,
+	}
+}
+
+// CreateConnector creates an import connector
+func (r *ImportRepo) CreateConnector(ctx context.Context, tenantID uint32, name string, provider importconnector.Provider, credentials string, config map[string]string, enabled bool, createdBy *uint32) (*ent.ImportConnector, error) {
+	builder := r.entClient.Client().ImportConnector.Create().
+		SetID(r.ids.New()).
+		SetTenantID(tenantID).
+		SetName(name).
+		SetProvider(provider).
+		SetCredentials(credentials).
+		SetConfig(config).
+		SetEnabled(enabled).
+		SetCreateTime(time.Now())
+
+	if createdBy != nil {
+		builder.SetCreateBy(*createdBy)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("create import connector failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("create import connector failed")
+	}
+	return entity, nil
+}
+
+// GetConnector returns a tenant's connector, or nil if it does not exist
+func (r *ImportRepo) GetConnector(ctx context.Context, tenantID uint32, id string) (*ent.ImportConnector, error) {
+	entity, err := r.entClient.Client().ImportConnector.Query().
+		Where(
+			importconnector.IDEQ(id),
+			importconnector.TenantIDEQ(tenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get import connector failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("get import connector failed")
+	}
+	return entity, nil
+}
+
+// ListConnectors lists a tenant's connectors, newest first
+func (r *ImportRepo) ListConnectors(ctx context.Context, tenantID uint32, page, pageSize uint32) ([]*ent.ImportConnector, int, error) {
+	query := r.entClient.Client().ImportConnector.Query().
+		Where(importconnector.TenantIDEQ(tenantID))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count import connectors failed: %s", err.Error())
+		return nil, 0, paperlessV1.ErrorInternalServerError("count import connectors failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		query = query.Offset(int((page - 1) * pageSize)).Limit(int(pageSize))
+	}
+
+	entities, err := query.Order(ent.Desc(importconnector.FieldCreateTime)).All(ctx)
+	if err != nil {
+		r.log.Errorf("list import connectors failed: %s", err.Error())
+		return nil, 0, paperlessV1.ErrorInternalServerError("list import connectors failed")
+	}
+	return entities, total, nil
+}
+
+// UpdateConnector updates the given fields of a connector
+func (r *ImportRepo) UpdateConnector(ctx context.Context, id string, name, credentials *string, config map[string]string, updateConfig bool, enabled *bool) (*ent.ImportConnector, error) {
+	builder := r.entClient.Client().ImportConnector.UpdateOneID(id).
+		SetUpdateTime(time.Now())
+
+	if name != nil {
+		builder.SetName(*name)
+	}
+	if credentials != nil {
+		builder.SetCredentials(*credentials)
+	}
+	if updateConfig {
+		builder.SetConfig(config)
+	}
+	if enabled != nil {
+		builder.SetEnabled(*enabled)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, paperlessV1.ErrorNotFound("import connector not found")
+		}
+		r.log.Errorf("update import connector failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("update import connector failed")
+	}
+	return entity, nil
+}
+
+// DeleteConnector deletes a connector together with its mappings
+func (r *ImportRepo) DeleteConnector(ctx context.Context, id string) error {
+	if err := r.entClient.Client().ImportConnector.DeleteOneID(id).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return paperlessV1.ErrorNotFound("import connector not found")
+		}
+		r.log.Errorf("delete import connector failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("delete import connector failed")
+	}
+	return nil
+}
+
+// CreateMapping maps a remote folder to a category. nextSyncAt schedules the first sync,
+// nil leaves the mapping unscheduled.
+func (r *ImportRepo) CreateMapping(ctx context.Context, tenantID uint32, connectorID, remoteFolderID, remoteFolderName string, categoryID *string, includeSubfolders bool, syncIntervalMinutes int32, enabled bool, nextSyncAt *time.Time, createdBy *uint32) (*ent.ImportMapping, error) {
+	builder := r.entClient.Client().ImportMapping.Create().
+		SetID(r.ids.New()).
+		SetTenantID(tenantID).
+		SetConnectorID(connectorID).
+		SetRemoteFolderID(remoteFolderID).
+		SetRemoteFolderName(remoteFolderName).
+		SetNillableCategoryID(categoryID).
+		SetIncludeSubfolders(includeSubfolders).
+		SetSyncIntervalMinutes(syncIntervalMinutes).
+		SetEnabled(enabled).
+		SetNillableNextSyncAt(nextSyncAt).
+		SetCreateTime(time.Now())
+
+	if createdBy != nil {
+		builder.SetCreateBy(*createdBy)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("create import mapping failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("create import mapping failed")
+	}
+	return entity, nil
+}
+
+// GetMapping returns a tenant's mapping, or nil if it does not exist
+func (r *ImportRepo) GetMapping(ctx context.Context, tenantID uint32, id string) (*ent.ImportMapping, error) {
+	entity, err := r.entClient.Client().ImportMapping.Query().
+		Where(
+			importmapping.IDEQ(id),
+			importmapping.TenantIDEQ(tenantID),
+		).
+		WithConnector().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get import mapping failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("get import mapping failed")
+	}
+	return entity, nil
+}
+
+// ListMappings lists a tenant's mappings, optionally of one connector, newest first
+func (r *ImportRepo) ListMappings(ctx context.Context, tenantID uint32, connectorID *string, page, pageSize uint32) ([]*ent.ImportMapping, int, error) {
+	query := r.entClient.Client().ImportMapping.Query().
+		Where(importmapping.TenantIDEQ(tenantID))
+	if connectorID != nil {
+		query = query.Where(importmapping.ConnectorIDEQ(*connectorID))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count import mappings failed: %s", err.Error())
+		return nil, 0, paperlessV1.ErrorInternalServerError("count import mappings failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		query = query.Offset(int((page - 1) * pageSize)).Limit(int(pageSize))
+	}
+
+	entities, err := query.Order(ent.Desc(importmapping.FieldCreateTime)).All(ctx)
+	if err != nil {
+		r.log.Errorf("list import mappings failed: %s", err.Error())
+		return nil, 0, paperlessV1.ErrorInternalServerError("list import mappings failed")
+	}
+	return entities, total, nil
+}
+
+// UpdateMapping updates the given fields of a mapping. An empty category ID moves the
+// imports to root level; updateNextSync reschedules the next sync to nextSyncAt.
+func (r *ImportRepo) UpdateMapping(ctx context.Context, id string, categoryID *string, includeSubfolders *bool, syncIntervalMinutes *int32, enabled *bool, nextSyncAt *time.Time, updateNextSync bool) (*ent.ImportMapping, error) {
+	builder := r.entClient.Client().ImportMapping.UpdateOneID(id).
+		SetUpdateTime(time.Now())
+
+	if categoryID != nil {
+		if *categoryID == "" {
+			builder.ClearCategoryID()
+		} else {
+			builder.SetCategoryID(*categoryID)
+		}
+	}
+	if includeSubfolders != nil {
+		builder.SetIncludeSubfolders(*includeSubfolders)
+	}
+	if syncIntervalMinutes != nil {
+		builder.SetSyncIntervalMinutes(*syncIntervalMinutes)
+	}
+	if enabled != nil {
+		builder.SetEnabled(*enabled)
+	}
+	if updateNextSync {
+		if nextSyncAt != nil {
+			builder.SetNextSyncAt(*nextSyncAt)
+		} else {
+			builder.ClearNextSyncAt()
+		}
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, paperlessV1.ErrorNotFound("import mapping not found")
+		}
+		r.log.Errorf("update import mapping failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("update import mapping failed")
+	}
+	return entity, nil
+}
+
+// DeleteMapping deletes a mapping together with its record of imported files
+func (r *ImportRepo) DeleteMapping(ctx context.Context, id string) error {
+	if err := r.entClient.Client().ImportMapping.DeleteOneID(id).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return paperlessV1.ErrorNotFound("import mapping not found")
+		}
+		r.log.Errorf("delete import mapping failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("delete import mapping failed")
+	}
+	return nil
+}
+
+// QueueSync makes a mapping due for a sync now, even if its scheduled syncs are disabled
+func (r *ImportRepo) QueueSync(ctx context.Context, id string) (*ent.ImportMapping, error) {
+	now := time.Now()
+	entity, err := r.entClient.Client().ImportMapping.UpdateOneID(id).
+		SetLastSyncStatus(importmapping.LastSyncStatusIMPORT_SYNC_STATUS_QUEUED).
+		SetNextSyncAt(now).
+		SetUpdateTime(now).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, paperlessV1.ErrorNotFound("import mapping not found")
+		}
+		r.log.Errorf("queue import sync failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("queue import sync failed")
+	}
+	return entity, nil
+}
+
+// ClaimDueMappings returns up to limit mappings of enabled connectors whose sync is due,
+// with their connector. Each is leased by moving its next sync to now+lease, so other
+// replicas skip it while it syncs; a mapping whose syncer crashes is synced after the lease.
+func (r *ImportRepo) ClaimDueMappings(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*ent.ImportMapping, error) {
+	due, err := r.entClient.Client().ImportMapping.Query().
+		Where(
+			importmapping.NextSyncAtLTE(now),
+			importmapping.Or(
+				importmapping.EnabledEQ(true),
+				importmapping.LastSyncStatusEQ(importmapping.LastSyncStatusIMPORT_SYNC_STATUS_QUEUED),
+			),
+			importmapping.HasConnectorWith(importconnector.EnabledEQ(true)),
+		).
+		Order(ent.Asc(importmapping.FieldNextSyncAt)).
+		Limit(limit).
+		WithConnector().
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list due import mappings failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("list due import mappings failed")
+	}
+
+	claimed := due[:0]
+	for _, m := range due {
+		n, err := r.entClient.Client().ImportMapping.Update().
+			Where(
+				importmapping.IDEQ(m.ID),
+				importmapping.NextSyncAtEQ(*m.NextSyncAt),
+			).
+			SetNextSyncAt(now.Add(lease)).
+			SetLastSyncStatus(importmapping.LastSyncStatusIMPORT_SYNC_STATUS_RUNNING).
+			Save(ctx)
+		if err != nil {
+			r.log.Errorf("claim import mapping %s failed: %s", m.ID, err.Error())
+			continue
+		}
+		if n == 1 {
+			claimed = append(claimed, m)
+		}
+	}
+	return claimed, nil
+}
+
+// ImportSyncResult is the outcome of one sync of a mapping
+type ImportSyncResult struct {
+	Status   importmapping.LastSyncStatus
+	Imported int32
+	Skipped  int32
+	Failed   int32
+	Error    string
+	// NextSyncAt schedules the next sync; nil leaves the mapping unscheduled
+	NextSyncAt *time.Time
+}
+
+// FinishSync stores the outcome of a sync and schedules the next one
+func (r *ImportRepo) FinishSync(ctx context.Context, id string, result *ImportSyncResult) error {
+	now := time.Now()
+	builder := r.entClient.Client().ImportMapping.UpdateOneID(id).
+		SetLastSyncStatus(result.Status).
+		SetLastSyncAt(now).
+		SetLastError(truncateError(result.Error)).
+		SetLastImportedCount(result.Imported).
+		SetLastSkippedCount(result.Skipped).
+		SetLastFailedCount(result.Failed).
+		SetUpdateTime(now)
+
+	if result.NextSyncAt != nil {
+		builder.SetNextSyncAt(*result.NextSyncAt)
+	} else {
+		builder.ClearNextSyncAt()
+	}
+
+	if err := builder.Exec(ctx); err != nil {
+		// The mapping was deleted while it synced
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		r.log.Errorf("finish import sync failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("finish import sync failed")
+	}
+	return nil
+}
+
+// ImportedFileIDs returns the IDs of the remote files a mapping already imported
+func (r *ImportRepo) ImportedFileIDs(ctx context.Context, mappingID string) (map[string]struct{}, error) {
+	ids, err := r.entClient.Client().ImportedFile.Query().
+		Where(importedfile.MappingIDEQ(mappingID)).
+		Select(importedfile.FieldRemoteFileID).
+		Strings(ctx)
+	if err != nil {
+		r.log.Errorf("list imported files failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("list imported files failed")
+	}
+
+	imported := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		imported[id] = struct{}{}
+	}
+	return imported, nil
+}
+
+// RecordImportedFile records that a mapping imported a remote file as a document
+func (r *ImportRepo) RecordImportedFile(ctx context.Context, tenantID uint32, mappingID, remoteFileID, remoteVersion, documentID string) error {
+	err := r.entClient.Client().ImportedFile.Create().
+		SetTenantID(tenantID).
+		SetMappingID(mappingID).
+		SetRemoteFileID(remoteFileID).
+		SetRemoteVersion(remoteVersion).
+		SetDocumentID(documentID).
+		SetCreateTime(time.Now()).
+		OnConflictColumns(importedfile.FieldMappingID, importedfile.FieldRemoteFileID).
+		DoNothing().
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("record imported file failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("record imported file failed")
+	}
+	return nil
+}
+
+// ConnectorToProto converts an ent.ImportConnector to paperlessV1.ImportConnector, without its credentials
+func (r *ImportRepo) ConnectorToProto(entity *ent.ImportConnector) *paperlessV1.ImportConnector {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &paperlessV1.ImportConnector{
+		Id:        entity.ID,
+		TenantId:  derefUint32(entity.TenantID),
+		Name:      entity.Name,
+		Provider:  paperlessV1.ImportProvider(paperlessV1.ImportProvider_value[string(entity.Provider)]),
+		Config:    entity.Config,
+		Enabled:   entity.Enabled,
+		CreatedBy: entity.CreateBy,
+	}
+	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+	if entity.UpdateTime != nil && !entity.UpdateTime.IsZero() {
+		proto.UpdateTime = timestamppb.New(*entity.UpdateTime)
+	}
+	return proto
+}
+
+// MappingToProto converts an ent.ImportMapping to paperlessV1.ImportMapping
+func (r *ImportRepo) MappingToProto(entity *ent.ImportMapping) *paperlessV1.ImportMapping {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &paperlessV1.ImportMapping{
+		Id:                  entity.ID,
+		TenantId:            derefUint32(entity.TenantID),
+		ConnectorId:         entity.ConnectorID,
+		RemoteFolderId:      entity.RemoteFolderID,
+		RemoteFolderName:    entity.RemoteFolderName,
+		CategoryId:          entity.CategoryID,
+		IncludeSubfolders:   entity.IncludeSubfolders,
+		SyncIntervalMinutes: entity.SyncIntervalMinutes,
+		Enabled:             entity.Enabled,
+		LastSyncStatus:      paperlessV1.ImportSyncStatus(paperlessV1.ImportSyncStatus_value[string(entity.LastSyncStatus)]),
+		LastError:           entity.LastError,
+		LastImportedCount:   entity.LastImportedCount,
+		LastSkippedCount:    entity.LastSkippedCount,
+		LastFailedCount:     entity.LastFailedCount,
+		CreatedBy:           entity.CreateBy,
+	}
+	if entity.NextSyncAt != nil {
+		proto.NextSyncAt = timestamppb.New(*entity.NextSyncAt)
+	}
+	if entity.LastSyncAt != nil {
+		proto.LastSyncAt = timestamppb.New(*entity.LastSyncAt)
+	}
+	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+	if entity.UpdateTime != nil && !entity.UpdateTime.IsZero() {
+		proto.UpdateTime = timestamppb.New(*entity.UpdateTime)
+	}
+	return proto
+}
+
+// RemoteItemToProto converts a RemoteItem to paperlessV1.RemoteItem
+func (r *ImportRepo) RemoteItemToProto(item RemoteItem) *paperlessV1.RemoteItem {
+	proto := &paperlessV1.RemoteItem{
+		Id:       item.ID,
+		Name:     item.Name,
+		Folder:   item.Folder,
+		MimeType: item.MimeType,
+		Size:     item.Size,
+	}
+	if !item.ModifiedTime.IsZero() {
+		proto.ModifiedTime = timestamppb.New(item.ModifiedTime)
+	}
+	return proto
+}
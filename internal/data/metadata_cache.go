@@ -0,0 +1,206 @@
+package data
+
+import (
+	"container/list"
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-crud/viewer"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+)
+
+const (
+	defaultMetadataCacheSize = 10000
+	defaultMetadataCacheTTL  = 10 * time.Second
+)
+
+// metadataCache is a per-replica LRU cache of rows read by ID, such as the documents and
+// categories every permission check walks through. Writes of this replica evict the rows they
+// touch, once more when their transaction commits; writes of other replicas show up when the
+// entry expires. Reads inside transactions and primary reads bypass the cache, and a hit is
+// only returned to a caller the database would return the row to.
+type metadataCache[T any] struct {
+	size int
+	ttl  time.Duration
+
+	// tenantOf returns the tenant owning a row
+	tenantOf func(T) uint32
+	// visible reports whether a query in ctx would match the row, e.g. skipping soft-deleted
+	// rows; nil means always
+	visible func(ctx context.Context, value T) bool
+	// clone copies a row, so callers can't change the cached one
+	clone func(T) T
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+	// epoch counts evictions, so a row read before an eviction isn't stored after it
+	epoch uint64
+}
+
+type metadataCacheEntry[T any] struct {
+	id        string
+	value     T
+	expiresAt time.Time
+}
+
+// newMetadataCache creates a cache sized by PAPERLESS_METADATA_CACHE_SIZE entries (default
+// 10000, 0 disables) whose entries live for PAPERLESS_METADATA_CACHE_TTL (default 10s)
+func newMetadataCache[T any](l *log.Helper, tenantOf func(T) uint32, visible func(context.Context, T) bool, clone func(T) T) *metadataCache[T] {
+	size := defaultMetadataCacheSize
+	if v := getEnvOrDefault("PAPERLESS_METADATA_CACHE_SIZE", ""); v != "" {
+		if n, err := strconv.Atoi(v); err != nil || n < 0 {
+			l.Warnf("invalid PAPERLESS_METADATA_CACHE_SIZE %q, using %d", v, size)
+		} else {
+			size = n
+		}
+	}
+
+	ttl := defaultMetadataCacheTTL
+	if v := getEnvOrDefault("PAPERLESS_METADATA_CACHE_TTL", ""); v != "" {
+		if d, err := time.ParseDuration(v); err != nil || d < 0 {
+			l.Warnf("invalid PAPERLESS_METADATA_CACHE_TTL %q, using %s", v, ttl)
+		} else {
+			ttl = d
+		}
+	}
+
+	return &metadataCache[T]{
+		size:     size,
+		ttl:      ttl,
+		tenantOf: tenantOf,
+		visible:  visible,
+		clone:    clone,
+		entries:  make(map[string]*list.Element),
+		lru:      list.New(),
+	}
+}
+
+// usable reports whether reads in ctx may be served from and stored in the cache
+func (c *metadataCache[T]) usable(ctx context.Context) bool {
+	if c.size <= 0 || c.ttl <= 0 || ent.TxFromContext(ctx) != nil || isPrimaryRead(ctx) {
+		return false
+	}
+	// Without a viewer the privacy policy rejects the query, which the cache must not hide
+	_, ok := viewer.FromContext(ctx)
+	return ok
+}
+
+// get returns the cached row of id. On a hit the row is the zero value if the database would
+// not return it to ctx, e.g. because it belongs to another tenant. The returned epoch must be
+// passed to put when a miss is filled.
+func (c *metadataCache[T]) get(ctx context.Context, id string) (value T, hit bool, epoch uint64) {
+	var zero T
+	if !c.usable(ctx) {
+		return zero, false, 0
+	}
+
+	c.mu.Lock()
+	epoch = c.epoch
+	elem, ok := c.entries[id]
+	if !ok {
+		c.mu.Unlock()
+		return zero, false, epoch
+	}
+	entry := elem.Value.(*metadataCacheEntry[T])
+	if time.Now().After(entry.expiresAt) {
+		c.lru.Remove(elem)
+		delete(c.entries, id)
+		c.mu.Unlock()
+		return zero, false, epoch
+	}
+	c.lru.MoveToFront(elem)
+	value = entry.value
+	c.mu.Unlock()
+
+	if tenantID, scoped := scopedTenantID(ctx); scoped && c.tenantOf(value) != tenantID {
+		return zero, true, epoch
+	}
+	if c.visible != nil && !c.visible(ctx, value) {
+		return zero, true, epoch
+	}
+	return c.clone(value), true, epoch
+}
+
+// put stores a row read after get missed, unless a write evicted rows in between
+func (c *metadataCache[T]) put(ctx context.Context, id string, value T, epoch uint64) {
+	if !c.usable(ctx) {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.epoch != epoch {
+		return
+	}
+	entry := &metadataCacheEntry[T]{id: id, value: c.clone(value), expiresAt: time.Now().Add(c.ttl)}
+	if elem, ok := c.entries[id]; ok {
+		elem.Value = entry
+		c.lru.MoveToFront(elem)
+		return
+	}
+	c.entries[id] = c.lru.PushFront(entry)
+	for c.lru.Len() > c.size {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*metadataCacheEntry[T]).id)
+	}
+}
+
+// evict drops the row of id, or every row if id is empty
+func (c *metadataCache[T]) evict(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.epoch++
+	if id == "" {
+		clear(c.entries)
+		c.lru.Init()
+		return
+	}
+	if elem, ok := c.entries[id]; ok {
+		c.lru.Remove(elem)
+		delete(c.entries, id)
+	}
+}
+
+// evictOnWrite is a hook evicting the rows a mutation changes. Updates and deletes of a
+// single row evict it; bulk ones, which don't name their rows, evict everything.
+func (c *metadataCache[T]) evictOnWrite() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if m.Op().Is(ent.OpCreate) {
+				return next.Mutate(ctx, m)
+			}
+
+			var id string
+			if m.Op().Is(ent.OpUpdateOne | ent.OpDeleteOne) {
+				if withID, ok := m.(interface{ ID() (string, bool) }); ok {
+					id, _ = withID.ID()
+				}
+			}
+
+			v, err := next.Mutate(ctx, m)
+			c.evict(id)
+
+			// Reads outside the transaction may cache the old row until it commits
+			if withTx, ok := m.(interface{ Tx() (*ent.Tx, error) }); ok {
+				if tx, txErr := withTx.Tx(); txErr == nil {
+					tx.OnCommit(func(next ent.Committer) ent.Committer {
+						return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+							err := next.Commit(ctx, tx)
+							c.evict(id)
+							return err
+						})
+					})
+				}
+			}
+			return v, err
+		})
+	}
+}
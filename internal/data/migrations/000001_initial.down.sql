@@ -0,0 +1,18 @@
+DROP TABLE IF EXISTS "paperless_webhook_deliveries";
+DROP TABLE IF EXISTS "paperless_webhook_subscriptions";
+DROP TABLE IF EXISTS "paperless_signature_requests";
+DROP TABLE IF EXISTS "paperless_permissions";
+DROP TABLE IF EXISTS "paperless_imported_files";
+DROP TABLE IF EXISTS "paperless_import_mappings";
+DROP TABLE IF EXISTS "paperless_import_connectors";
+DROP TABLE IF EXISTS "paperless_documents";
+DROP TABLE IF EXISTS "paperless_event_outbox";
+DROP TABLE IF EXISTS "paperless_settings";
+DROP TABLE IF EXISTS "paperless_group_memberships";
+DROP TABLE IF EXISTS "paperless_tenant_keys";
+DROP TABLE IF EXISTS "paperless_document_history";
+DROP TABLE IF EXISTS "paperless_accessible_resources";
+DROP TABLE IF EXISTS "paperless_categories";
+DROP TABLE IF EXISTS "paperless_audit_logs";
+DROP TABLE IF EXISTS "paperless_audit_events";
+DROP TABLE IF EXISTS "paperless_notification_preferences";
@@ -0,0 +1,308 @@
+CREATE TABLE "paperless_notification_preferences" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "user_id" bigint NOT NULL, "share_enabled" boolean NOT NULL DEFAULT true, "mention_enabled" boolean NOT NULL DEFAULT true, PRIMARY KEY ("id"));
+CREATE UNIQUE INDEX "notificationpreference_tenant_id_user_id" ON "paperless_notification_preferences" ("tenant_id", "user_id");
+COMMENT ON COLUMN "paperless_notification_preferences"."id" IS 'id';
+COMMENT ON COLUMN "paperless_notification_preferences"."create_time" IS '创建时间';
+COMMENT ON COLUMN "paperless_notification_preferences"."update_time" IS '更新时间';
+COMMENT ON COLUMN "paperless_notification_preferences"."delete_time" IS '删除时间';
+COMMENT ON COLUMN "paperless_notification_preferences"."tenant_id" IS '租户ID';
+COMMENT ON COLUMN "paperless_notification_preferences"."user_id" IS 'User the preferences belong to';
+COMMENT ON COLUMN "paperless_notification_preferences"."share_enabled" IS 'Notify the user when something is shared with them';
+COMMENT ON COLUMN "paperless_notification_preferences"."mention_enabled" IS 'Notify the user when they are mentioned';
+CREATE TABLE "paperless_audit_events" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "user_id" character varying NULL, "action" character varying NOT NULL, "resource_type" character varying NOT NULL, "resource_id" character varying NOT NULL, "resource_name" character varying NULL, "details" jsonb NULL, PRIMARY KEY ("id"));
+CREATE INDEX "auditevent_tenant_id_create_time" ON "paperless_audit_events" ("tenant_id", "create_time");
+CREATE INDEX "auditevent_tenant_id_resource_type_resource_id" ON "paperless_audit_events" ("tenant_id", "resource_type", "resource_id");
+CREATE INDEX "auditevent_tenant_id_user_id" ON "paperless_audit_events" ("tenant_id", "user_id");
+CREATE INDEX "auditevent_create_time" ON "paperless_audit_events" ("create_time");
+COMMENT ON COLUMN "paperless_audit_events"."id" IS 'id';
+COMMENT ON COLUMN "paperless_audit_events"."create_time" IS '创建时间';
+COMMENT ON COLUMN "paperless_audit_events"."update_time" IS '更新时间';
+COMMENT ON COLUMN "paperless_audit_events"."delete_time" IS '删除时间';
+COMMENT ON COLUMN "paperless_audit_events"."tenant_id" IS '租户ID';
+COMMENT ON COLUMN "paperless_audit_events"."user_id" IS 'ID of the user who performed the action, empty for system actions';
+COMMENT ON COLUMN "paperless_audit_events"."action" IS 'What was done';
+COMMENT ON COLUMN "paperless_audit_events"."resource_type" IS 'Type of resource acted on';
+COMMENT ON COLUMN "paperless_audit_events"."resource_id" IS 'ID of the category or document';
+COMMENT ON COLUMN "paperless_audit_events"."resource_name" IS 'Name of the resource at the time of the action';
+COMMENT ON COLUMN "paperless_audit_events"."details" IS 'Action specific details, e.g. the target category of a move';
+CREATE TABLE "paperless_audit_logs" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "audit_id" character varying NOT NULL, "request_id" character varying NULL, "operation" character varying NOT NULL, "service_name" character varying NOT NULL DEFAULT 'paperless-service', "client_id" character varying NULL, "client_common_name" character varying NULL, "client_organization" character varying NULL, "client_serial_number" character varying NULL, "is_authenticated" boolean NOT NULL DEFAULT false, "success" boolean NOT NULL DEFAULT true, "error_code" integer NULL, "error_message" character varying NULL, "latency_ms" bigint NOT NULL DEFAULT 0, "peer_address" character varying NULL, "geo_location" jsonb NULL, "log_hash" character varying NULL, "signature" bytea NULL, "metadata" jsonb NULL, PRIMARY KEY ("id"));
+CREATE UNIQUE INDEX "paperless_audit_logs_audit_id_key" ON "paperless_audit_logs" ("audit_id");
+CREATE INDEX "paperless_auditlog_tenant_id" ON "paperless_audit_logs" ("tenant_id");
+CREATE INDEX "paperless_auditlog_tenant_client" ON "paperless_audit_logs" ("tenant_id", "client_id");
+CREATE INDEX "paperless_auditlog_tenant_operation" ON "paperless_audit_logs" ("tenant_id", "operation");
+CREATE INDEX "paperless_auditlog_tenant_success" ON "paperless_audit_logs" ("tenant_id", "success");
+CREATE INDEX "paperless_auditlog_operation" ON "paperless_audit_logs" ("operation");
+CREATE INDEX "paperless_auditlog_client_id" ON "paperless_audit_logs" ("client_id");
+CREATE INDEX "paperless_auditlog_success" ON "paperless_audit_logs" ("success");
+CREATE INDEX "paperless_auditlog_peer_address" ON "paperless_audit_logs" ("peer_address");
+COMMENT ON COLUMN "paperless_audit_logs"."id" IS 'id';
+COMMENT ON COLUMN "paperless_audit_logs"."create_time" IS '创建时间';
+COMMENT ON COLUMN "paperless_audit_logs"."update_time" IS '更新时间';
+COMMENT ON COLUMN "paperless_audit_logs"."delete_time" IS '删除时间';
+COMMENT ON COLUMN "paperless_audit_logs"."tenant_id" IS '租户ID';
+COMMENT ON COLUMN "paperless_audit_logs"."audit_id" IS 'Unique audit log identifier (UUID)';
+COMMENT ON COLUMN "paperless_audit_logs"."request_id" IS 'Request ID from metadata';
+COMMENT ON COLUMN "paperless_audit_logs"."operation" IS 'gRPC operation path';
+COMMENT ON COLUMN "paperless_audit_logs"."service_name" IS 'Service name';
+COMMENT ON COLUMN "paperless_audit_logs"."client_id" IS 'Client ID from certificate CN';
+COMMENT ON COLUMN "paperless_audit_logs"."client_common_name" IS 'Client certificate common name';
+COMMENT ON COLUMN "paperless_audit_logs"."client_organization" IS 'Client certificate organization';
+COMMENT ON COLUMN "paperless_audit_logs"."client_serial_number" IS 'Client certificate serial number';
+COMMENT ON COLUMN "paperless_audit_logs"."is_authenticated" IS 'Whether the client was authenticated via mTLS';
+COMMENT ON COLUMN "paperless_audit_logs"."success" IS 'Whether the operation succeeded';
+COMMENT ON COLUMN "paperless_audit_logs"."error_code" IS 'Error code if failed';
+COMMENT ON COLUMN "paperless_audit_logs"."error_message" IS 'Error message if failed';
+COMMENT ON COLUMN "paperless_audit_logs"."latency_ms" IS 'Operation latency in milliseconds';
+COMMENT ON COLUMN "paperless_audit_logs"."peer_address" IS 'Client IP address';
+COMMENT ON COLUMN "paperless_audit_logs"."geo_location" IS 'Geographic location info';
+COMMENT ON COLUMN "paperless_audit_logs"."log_hash" IS 'SHA-256 hash of the log content';
+COMMENT ON COLUMN "paperless_audit_logs"."signature" IS 'ECDSA signature for integrity verification';
+COMMENT ON COLUMN "paperless_audit_logs"."metadata" IS 'Additional metadata';
+CREATE TABLE "paperless_categories" ("id" character varying NOT NULL, "create_by" bigint NULL, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "version" bigint NOT NULL DEFAULT 1, "name" character varying NOT NULL, "path" character varying NOT NULL, "description" character varying NULL, "depth" integer NOT NULL DEFAULT 0, "sort_order" integer NOT NULL DEFAULT 0, "parent_id" character varying NULL, PRIMARY KEY ("id"), CONSTRAINT "paperless_categories_paperless_categories_children" FOREIGN KEY ("parent_id") REFERENCES "paperless_categories" ("id") ON DELETE SET NULL);
+CREATE UNIQUE INDEX "category_tenant_id_parent_id_name" ON "paperless_categories" ("tenant_id", "parent_id", "name");
+CREATE UNIQUE INDEX "category_tenant_id_path" ON "paperless_categories" ("tenant_id", "path");
+CREATE INDEX "category_tenant_id" ON "paperless_categories" ("tenant_id");
+CREATE INDEX "category_parent_id" ON "paperless_categories" ("parent_id");
+CREATE INDEX "category_path" ON "paperless_categories" ("path");
+CREATE INDEX "category_tenant_id_sort_order" ON "paperless_categories" ("tenant_id", "sort_order");
+COMMENT ON COLUMN "paperless_categories"."id" IS 'UUID primary key';
+COMMENT ON COLUMN "paperless_categories"."create_by" IS '创建者ID';
+COMMENT ON COLUMN "paperless_categories"."create_time" IS '创建时间';
+COMMENT ON COLUMN "paperless_categories"."update_time" IS '更新时间';
+COMMENT ON COLUMN "paperless_categories"."delete_time" IS '删除时间';
+COMMENT ON COLUMN "paperless_categories"."tenant_id" IS '租户ID';
+COMMENT ON COLUMN "paperless_categories"."version" IS '版本号/乐观锁';
+COMMENT ON COLUMN "paperless_categories"."name" IS 'Category name';
+COMMENT ON COLUMN "paperless_categories"."path" IS 'Materialized path (e.g., /root/sub/current)';
+COMMENT ON COLUMN "paperless_categories"."description" IS 'Optional description';
+COMMENT ON COLUMN "paperless_categories"."depth" IS 'Nesting depth level (0 for root categories)';
+COMMENT ON COLUMN "paperless_categories"."sort_order" IS 'Sort order within parent (lower numbers appear first)';
+COMMENT ON COLUMN "paperless_categories"."parent_id" IS 'Parent category ID (null for root-level categories)';
+CREATE TABLE "paperless_accessible_resources" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "permission_id" bigint NOT NULL, "subject_type" character varying NOT NULL, "subject_id" character varying NOT NULL, "resource_type" character varying NOT NULL, "resource_id" character varying NOT NULL, "relation" character varying NOT NULL, "source_id" character varying NOT NULL, "inherited" boolean NOT NULL DEFAULT false, "expires_at" timestamptz NULL, "conditions" jsonb NULL, PRIMARY KEY ("id"));
+CREATE UNIQUE INDEX "accessibleresource_permission_id_resource_type_resource_id" ON "paperless_accessible_resources" ("permission_id", "resource_type", "resource_id");
+CREATE INDEX "accessibleresource_tenant_id_s_d933f6917c8071b02447555160def7ad" ON "paperless_accessible_resources" ("tenant_id", "subject_type", "subject_id", "resource_type");
+CREATE INDEX "accessibleresource_tenant_id_resource_type_resource_id" ON "paperless_accessible_resources" ("tenant_id", "resource_type", "resource_id");
+CREATE INDEX "accessibleresource_tenant_id_source_id" ON "paperless_accessible_resources" ("tenant_id", "source_id");
+COMMENT ON COLUMN "paperless_accessible_resources"."id" IS 'id';
+COMMENT ON COLUMN "paperless_accessible_resources"."create_time" IS '创建时间';
+COMMENT ON COLUMN "paperless_accessible_resources"."update_time" IS '更新时间';
+COMMENT ON COLUMN "paperless_accessible_resources"."delete_time" IS '删除时间';
+COMMENT ON COLUMN "paperless_accessible_resources"."tenant_id" IS '租户ID';
+COMMENT ON COLUMN "paperless_accessible_resources"."permission_id" IS 'ID of the permission tuple this entry is derived from';
+COMMENT ON COLUMN "paperless_accessible_resources"."subject_type" IS 'Type of subject (user, role, tenant, or group)';
+COMMENT ON COLUMN "paperless_accessible_resources"."subject_id" IS 'ID of the user, role, or tenant';
+COMMENT ON COLUMN "paperless_accessible_resources"."resource_type" IS 'Type of the accessible resource';
+COMMENT ON COLUMN "paperless_accessible_resources"."resource_id" IS 'ID of the accessible category or document';
+COMMENT ON COLUMN "paperless_accessible_resources"."relation" IS 'Relation granted by the source tuple';
+COMMENT ON COLUMN "paperless_accessible_resources"."source_id" IS 'Resource the source tuple is attached to (differs from resource_id when inherited)';
+COMMENT ON COLUMN "paperless_accessible_resources"."inherited" IS 'Whether access is inherited from a parent category';
+COMMENT ON COLUMN "paperless_accessible_resources"."expires_at" IS 'Expiration time copied from the source tuple';
+COMMENT ON COLUMN "paperless_accessible_resources"."conditions" IS 'Conditions copied from the source tuple; conditional entries must be re-checked';
+CREATE TABLE "paperless_document_history" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "document_id" character varying NOT NULL, "user_id" character varying NULL, "version" bigint NOT NULL DEFAULT 0, "changed_fields" jsonb NOT NULL, "before" jsonb NOT NULL, "after" jsonb NOT NULL, PRIMARY KEY ("id"));
+CREATE INDEX "documenthistory_tenant_id_document_id_create_time" ON "paperless_document_history" ("tenant_id", "document_id", "create_time");
+COMMENT ON COLUMN "paperless_document_history"."id" IS 'id';
+COMMENT ON COLUMN "paperless_document_history"."create_time" IS '创建时间';
+COMMENT ON COLUMN "paperless_document_history"."update_time" IS '更新时间';
+COMMENT ON COLUMN "paperless_document_history"."delete_time" IS '删除时间';
+COMMENT ON COLUMN "paperless_document_history"."tenant_id" IS '租户ID';
+COMMENT ON COLUMN "paperless_document_history"."document_id" IS 'ID of the changed document';
+COMMENT ON COLUMN "paperless_document_history"."user_id" IS 'ID of the user who made the change, empty for system changes';
+COMMENT ON COLUMN "paperless_document_history"."version" IS 'Version of the document after the change';
+COMMENT ON COLUMN "paperless_document_history"."changed_fields" IS 'Names of the metadata fields that changed';
+COMMENT ON COLUMN "paperless_document_history"."before" IS 'Metadata before the change';
+COMMENT ON COLUMN "paperless_document_history"."after" IS 'Metadata after the change';
+CREATE TABLE "paperless_tenant_keys" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "wrapped_key" bytea NOT NULL, "master_key_id" character varying NOT NULL, PRIMARY KEY ("id"));
+CREATE UNIQUE INDEX "tenantkey_tenant_id" ON "paperless_tenant_keys" ("tenant_id");
+COMMENT ON COLUMN "paperless_tenant_keys"."id" IS 'id';
+COMMENT ON COLUMN "paperless_tenant_keys"."create_time" IS '创建时间';
+COMMENT ON COLUMN "paperless_tenant_keys"."update_time" IS '更新时间';
+COMMENT ON COLUMN "paperless_tenant_keys"."delete_time" IS '删除时间';
+COMMENT ON COLUMN "paperless_tenant_keys"."tenant_id" IS '租户ID';
+COMMENT ON COLUMN "paperless_tenant_keys"."wrapped_key" IS 'Data key encrypted with the master key';
+COMMENT ON COLUMN "paperless_tenant_keys"."master_key_id" IS 'Identifier of the master key that wrapped the data key';
+CREATE TABLE "paperless_group_memberships" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "group_id" character varying NOT NULL, "group_name" character varying NULL, "user_id" character varying NOT NULL, PRIMARY KEY ("id"));
+CREATE UNIQUE INDEX "groupmembership_tenant_id_group_id_user_id" ON "paperless_group_memberships" ("tenant_id", "group_id", "user_id");
+CREATE INDEX "groupmembership_tenant_id_user_id" ON "paperless_group_memberships" ("tenant_id", "user_id");
+COMMENT ON COLUMN "paperless_group_memberships"."id" IS 'id';
+COMMENT ON COLUMN "paperless_group_memberships"."create_time" IS '创建时间';
+COMMENT ON COLUMN "paperless_group_memberships"."update_time" IS '更新时间';
+COMMENT ON COLUMN "paperless_group_memberships"."delete_time" IS '删除时间';
+COMMENT ON COLUMN "paperless_group_memberships"."tenant_id" IS '租户ID';
+COMMENT ON COLUMN "paperless_group_memberships"."group_id" IS 'Directory group ID, the subject ID of group grants';
+COMMENT ON COLUMN "paperless_group_memberships"."group_name" IS 'Display name of the group';
+COMMENT ON COLUMN "paperless_group_memberships"."user_id" IS 'Member user ID';
+CREATE TABLE "paperless_settings" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "name" character varying NOT NULL, "value" character varying NOT NULL, PRIMARY KEY ("id"));
+CREATE UNIQUE INDEX "paperless_settings_name_key" ON "paperless_settings" ("name");
+COMMENT ON COLUMN "paperless_settings"."id" IS 'id';
+COMMENT ON COLUMN "paperless_settings"."create_time" IS '创建时间';
+COMMENT ON COLUMN "paperless_settings"."update_time" IS '更新时间';
+COMMENT ON COLUMN "paperless_settings"."delete_time" IS '删除时间';
+COMMENT ON COLUMN "paperless_settings"."name" IS 'Setting name';
+COMMENT ON COLUMN "paperless_settings"."value" IS 'Setting value';
+CREATE TABLE "paperless_event_outbox" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "event_id" character varying NOT NULL, "event_type" character varying NOT NULL, "subject" character varying NULL, "payload" text NOT NULL, "attempts" integer NOT NULL DEFAULT 0, "next_attempt_at" timestamptz NOT NULL, "last_error" character varying NULL, PRIMARY KEY ("id"));
+CREATE INDEX "outboxevent_next_attempt_at" ON "paperless_event_outbox" ("next_attempt_at");
+COMMENT ON COLUMN "paperless_event_outbox"."id" IS 'id';
+COMMENT ON COLUMN "paperless_event_outbox"."create_time" IS '创建时间';
+COMMENT ON COLUMN "paperless_event_outbox"."update_time" IS '更新时间';
+COMMENT ON COLUMN "paperless_event_outbox"."delete_time" IS '删除时间';
+COMMENT ON COLUMN "paperless_event_outbox"."tenant_id" IS '租户ID';
+COMMENT ON COLUMN "paperless_event_outbox"."event_id" IS 'CloudEvents id';
+COMMENT ON COLUMN "paperless_event_outbox"."event_type" IS 'CloudEvents type';
+COMMENT ON COLUMN "paperless_event_outbox"."subject" IS 'Resource the event is about, used as the broker message key';
+COMMENT ON COLUMN "paperless_event_outbox"."payload" IS 'CloudEvent encoded as JSON';
+COMMENT ON COLUMN "paperless_event_outbox"."attempts" IS 'Failed publish attempts so far';
+COMMENT ON COLUMN "paperless_event_outbox"."next_attempt_at" IS 'When the event is due to be published';
+COMMENT ON COLUMN "paperless_event_outbox"."last_error" IS 'Why the last publish attempt failed';
+CREATE TABLE "paperless_documents" ("id" character varying NOT NULL, "create_by" bigint NULL, "update_by" bigint NULL, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "version" bigint NOT NULL DEFAULT 1, "name" character varying NOT NULL, "description" character varying NULL, "file_key" character varying NOT NULL, "file_name" character varying NOT NULL, "file_size" bigint NOT NULL DEFAULT 0, "mime_type" character varying NULL, "checksum" character varying NULL, "tags" jsonb NULL, "status" character varying NOT NULL DEFAULT 'DOCUMENT_STATUS_ACTIVE', "source" character varying NOT NULL DEFAULT 'DOCUMENT_SOURCE_UPLOAD', "content_text" text NULL, "content_text_compressed" bytea NULL, "search_terms" text NULL, "extracted_metadata" jsonb NULL, "processing_status" character varying NOT NULL DEFAULT 'PROCESSING_STATUS_PENDING', "storage_tier" character varying NOT NULL DEFAULT 'STORAGE_TIER_HOT', "last_accessed_at" timestamptz NULL, "category_id" character varying NULL, PRIMARY KEY ("id"), CONSTRAINT "paperless_documents_paperless_categories_documents" FOREIGN KEY ("category_id") REFERENCES "paperless_categories" ("id") ON DELETE SET NULL);
+CREATE UNIQUE INDEX "document_tenant_id_category_id_name" ON "paperless_documents" ("tenant_id", "category_id", "name");
+CREATE INDEX "document_tenant_id" ON "paperless_documents" ("tenant_id");
+CREATE INDEX "document_category_id" ON "paperless_documents" ("category_id");
+CREATE INDEX "document_tenant_id_name" ON "paperless_documents" ("tenant_id", "name");
+CREATE INDEX "document_status" ON "paperless_documents" ("status");
+CREATE UNIQUE INDEX "document_file_key" ON "paperless_documents" ("file_key");
+CREATE INDEX "document_tenant_id_mime_type" ON "paperless_documents" ("tenant_id", "mime_type");
+CREATE INDEX "document_storage_tier_status" ON "paperless_documents" ("storage_tier", "status");
+COMMENT ON COLUMN "paperless_documents"."id" IS 'UUID primary key';
+COMMENT ON COLUMN "paperless_documents"."create_by" IS '创建者ID';
+COMMENT ON COLUMN "paperless_documents"."update_by" IS '更新者ID';
+COMMENT ON COLUMN "paperless_documents"."create_time" IS '创建时间';
+COMMENT ON COLUMN "paperless_documents"."update_time" IS '更新时间';
+COMMENT ON COLUMN "paperless_documents"."delete_time" IS '删除时间';
+COMMENT ON COLUMN "paperless_documents"."tenant_id" IS '租户ID';
+COMMENT ON COLUMN "paperless_documents"."version" IS '版本号/乐观锁';
+COMMENT ON COLUMN "paperless_documents"."name" IS 'Document display name';
+COMMENT ON COLUMN "paperless_documents"."description" IS 'Document description';
+COMMENT ON COLUMN "paperless_documents"."file_key" IS 'Storage key in RustFS/S3';
+COMMENT ON COLUMN "paperless_documents"."file_name" IS 'Original file name';
+COMMENT ON COLUMN "paperless_documents"."file_size" IS 'File size in bytes';
+COMMENT ON COLUMN "paperless_documents"."mime_type" IS 'MIME type of the file';
+COMMENT ON COLUMN "paperless_documents"."checksum" IS 'SHA-256 checksum of the file';
+COMMENT ON COLUMN "paperless_documents"."tags" IS 'Custom tags (key-value pairs)';
+COMMENT ON COLUMN "paperless_documents"."status" IS 'Document status';
+COMMENT ON COLUMN "paperless_documents"."source" IS 'Source of the document (upload, email, etc.)';
+COMMENT ON COLUMN "paperless_documents"."content_text" IS 'Extracted text content for full-text search (empty when stored compressed)';
+COMMENT ON COLUMN "paperless_documents"."content_text_compressed" IS 'Gzip-compressed extracted text, used instead of content_text for large texts';
+COMMENT ON COLUMN "paperless_documents"."search_terms" IS 'Distinct lowercase words of compressed extracted text, for full-text search';
+COMMENT ON COLUMN "paperless_documents"."extracted_metadata" IS 'Metadata extracted by Tika (author, title, page_count, etc.)';
+COMMENT ON COLUMN "paperless_documents"."processing_status" IS 'Document content extraction status';
+COMMENT ON COLUMN "paperless_documents"."storage_tier" IS 'Storage tier currently holding the file';
+COMMENT ON COLUMN "paperless_documents"."last_accessed_at" IS 'Last time the file was downloaded';
+COMMENT ON COLUMN "paperless_documents"."category_id" IS 'Parent category ID (null for root-level documents)';
+CREATE TABLE "paperless_import_connectors" ("id" character varying NOT NULL, "create_by" bigint NULL, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "name" character varying NOT NULL, "provider" character varying NOT NULL, "credentials" text NOT NULL, "config" jsonb NULL, "enabled" boolean NOT NULL DEFAULT true, PRIMARY KEY ("id"));
+CREATE INDEX "importconnector_tenant_id" ON "paperless_import_connectors" ("tenant_id");
+COMMENT ON COLUMN "paperless_import_connectors"."id" IS 'UUID primary key';
+COMMENT ON COLUMN "paperless_import_connectors"."create_by" IS '创建者ID';
+COMMENT ON COLUMN "paperless_import_connectors"."create_time" IS '创建时间';
+COMMENT ON COLUMN "paperless_import_connectors"."update_time" IS '更新时间';
+COMMENT ON COLUMN "paperless_import_connectors"."delete_time" IS '删除时间';
+COMMENT ON COLUMN "paperless_import_connectors"."tenant_id" IS '租户ID';
+COMMENT ON COLUMN "paperless_import_connectors"."name" IS 'Display name';
+COMMENT ON COLUMN "paperless_import_connectors"."provider" IS 'Remote file service';
+COMMENT ON COLUMN "paperless_import_connectors"."credentials" IS 'Google service account key or Microsoft Entra client secret';
+COMMENT ON COLUMN "paperless_import_connectors"."config" IS 'Provider settings, e.g. the SharePoint drive';
+COMMENT ON COLUMN "paperless_import_connectors"."enabled" IS 'Whether the connector''s folders are synced';
+CREATE TABLE "paperless_import_mappings" ("id" character varying NOT NULL, "create_by" bigint NULL, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "remote_folder_id" character varying NOT NULL, "remote_folder_name" character varying NULL, "category_id" character varying NULL, "include_subfolders" boolean NOT NULL DEFAULT false, "sync_interval_minutes" integer NOT NULL DEFAULT 0, "enabled" boolean NOT NULL DEFAULT true, "next_sync_at" timestamptz NULL, "last_sync_status" character varying NOT NULL DEFAULT 'IMPORT_SYNC_STATUS_NEVER', "last_sync_at" timestamptz NULL, "last_error" character varying NULL, "last_imported_count" integer NOT NULL DEFAULT 0, "last_skipped_count" integer NOT NULL DEFAULT 0, "last_failed_count" integer NOT NULL DEFAULT 0, "connector_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "paperless_import_mappings_paperless_import_connectors_mappings" FOREIGN KEY ("connector_id") REFERENCES "paperless_import_connectors" ("id") ON DELETE CASCADE);
+CREATE INDEX "importmapping_connector_id" ON "paperless_import_mappings" ("connector_id");
+CREATE INDEX "importmapping_enabled_next_sync_at" ON "paperless_import_mappings" ("enabled", "next_sync_at");
+COMMENT ON COLUMN "paperless_import_mappings"."id" IS 'UUID primary key';
+COMMENT ON COLUMN "paperless_import_mappings"."create_by" IS '创建者ID';
+COMMENT ON COLUMN "paperless_import_mappings"."create_time" IS '创建时间';
+COMMENT ON COLUMN "paperless_import_mappings"."update_time" IS '更新时间';
+COMMENT ON COLUMN "paperless_import_mappings"."delete_time" IS '删除时间';
+COMMENT ON COLUMN "paperless_import_mappings"."tenant_id" IS '租户ID';
+COMMENT ON COLUMN "paperless_import_mappings"."remote_folder_id" IS 'ID of the remote folder';
+COMMENT ON COLUMN "paperless_import_mappings"."remote_folder_name" IS 'Display name of the remote folder';
+COMMENT ON COLUMN "paperless_import_mappings"."category_id" IS 'Category receiving the documents, null for root level';
+COMMENT ON COLUMN "paperless_import_mappings"."include_subfolders" IS 'Also import the files of subfolders';
+COMMENT ON COLUMN "paperless_import_mappings"."sync_interval_minutes" IS 'Minutes between scheduled syncs, 0 for manual syncs only';
+COMMENT ON COLUMN "paperless_import_mappings"."enabled" IS 'Whether scheduled syncs run';
+COMMENT ON COLUMN "paperless_import_mappings"."next_sync_at" IS 'When the next sync is due, null when none is scheduled';
+COMMENT ON COLUMN "paperless_import_mappings"."last_sync_status" IS 'Outcome of the last sync';
+COMMENT ON COLUMN "paperless_import_mappings"."last_sync_at" IS 'When the last sync finished';
+COMMENT ON COLUMN "paperless_import_mappings"."last_error" IS 'Why the last sync or one of its files failed';
+COMMENT ON COLUMN "paperless_import_mappings"."last_imported_count" IS 'Files imported by the last sync';
+COMMENT ON COLUMN "paperless_import_mappings"."last_skipped_count" IS 'Files the last sync skipped as already imported';
+COMMENT ON COLUMN "paperless_import_mappings"."last_failed_count" IS 'Files the last sync failed to import';
+COMMENT ON COLUMN "paperless_import_mappings"."connector_id" IS 'Connector the folder is read through';
+CREATE TABLE "paperless_imported_files" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "remote_file_id" character varying NOT NULL, "remote_version" character varying NULL, "document_id" character varying NOT NULL, "mapping_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "paperless_imported_files_paperless_import_mappings_files" FOREIGN KEY ("mapping_id") REFERENCES "paperless_import_mappings" ("id") ON DELETE CASCADE);
+CREATE UNIQUE INDEX "importedfile_mapping_id_remote_file_id" ON "paperless_imported_files" ("mapping_id", "remote_file_id");
+COMMENT ON COLUMN "paperless_imported_files"."id" IS 'id';
+COMMENT ON COLUMN "paperless_imported_files"."create_time" IS '创建时间';
+COMMENT ON COLUMN "paperless_imported_files"."update_time" IS '更新时间';
+COMMENT ON COLUMN "paperless_imported_files"."delete_time" IS '删除时间';
+COMMENT ON COLUMN "paperless_imported_files"."tenant_id" IS '租户ID';
+COMMENT ON COLUMN "paperless_imported_files"."remote_file_id" IS 'ID of the remote file';
+COMMENT ON COLUMN "paperless_imported_files"."remote_version" IS 'Modification time or ETag of the imported revision';
+COMMENT ON COLUMN "paperless_imported_files"."document_id" IS 'Document created from the file';
+COMMENT ON COLUMN "paperless_imported_files"."mapping_id" IS 'Mapping that imported the file';
+CREATE TABLE "paperless_permissions" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "resource_type" character varying NOT NULL, "resource_id" character varying NOT NULL, "relation" character varying NOT NULL, "subject_type" character varying NOT NULL, "subject_id" character varying NOT NULL, "granted_by" bigint NULL, "expires_at" timestamptz NULL, "conditions" jsonb NULL, "category_permissions" character varying NULL, "document_permissions" character varying NULL, PRIMARY KEY ("id"), CONSTRAINT "paperless_permissions_paperless_categories_permissions" FOREIGN KEY ("category_permissions") REFERENCES "paperless_categories" ("id") ON DELETE SET NULL, CONSTRAINT "paperless_permissions_paperless_documents_permissions" FOREIGN KEY ("document_permissions") REFERENCES "paperless_documents" ("id") ON DELETE SET NULL);
+CREATE UNIQUE INDEX "documentpermission_tenant_id_r_da3c2a03a1d498c85e4d7fd9d78bc280" ON "paperless_permissions" ("tenant_id", "resource_type", "resource_id", "relation", "subject_type", "subject_id");
+CREATE INDEX "documentpermission_tenant_id_resource_type_resource_id" ON "paperless_permissions" ("tenant_id", "resource_type", "resource_id");
+CREATE INDEX "documentpermission_subject_type_subject_id" ON "paperless_permissions" ("subject_type", "subject_id");
+CREATE INDEX "documentpermission_tenant_id" ON "paperless_permissions" ("tenant_id");
+CREATE INDEX "documentpermission_expires_at" ON "paperless_permissions" ("expires_at");
+COMMENT ON COLUMN "paperless_permissions"."create_time" IS '创建时间';
+COMMENT ON COLUMN "paperless_permissions"."update_time" IS '更新时间';
+COMMENT ON COLUMN "paperless_permissions"."delete_time" IS '删除时间';
+COMMENT ON COLUMN "paperless_permissions"."tenant_id" IS '租户ID';
+COMMENT ON COLUMN "paperless_permissions"."resource_type" IS 'Type of resource (category or document)';
+COMMENT ON COLUMN "paperless_permissions"."resource_id" IS 'ID of the category or document';
+COMMENT ON COLUMN "paperless_permissions"."relation" IS 'Permission level (owner, editor, viewer, sharer)';
+COMMENT ON COLUMN "paperless_permissions"."subject_type" IS 'Type of subject (user, role, tenant, or group)';
+COMMENT ON COLUMN "paperless_permissions"."subject_id" IS 'ID of the user, role, or tenant';
+COMMENT ON COLUMN "paperless_permissions"."granted_by" IS 'User ID who granted this permission';
+COMMENT ON COLUMN "paperless_permissions"."expires_at" IS 'Optional expiration time for temporary access';
+COMMENT ON COLUMN "paperless_permissions"."conditions" IS 'Optional caveats (IP ranges, time window, MFA) evaluated at check time';
+CREATE TABLE "paperless_signature_requests" ("id" character varying NOT NULL, "create_by" bigint NULL, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "provider" character varying NOT NULL, "envelope_id" character varying NULL, "status" character varying NOT NULL DEFAULT 'SIGNATURE_STATUS_SENT', "signers" jsonb NOT NULL, "subject" character varying NOT NULL, "message" text NULL, "original_file_key" character varying NOT NULL, "signed_file_key" character varying NULL, "completed_at" timestamptz NULL, "last_error" character varying NULL, "document_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "paperless_signature_requests_p_c2b60b091b00e69bad186efbc77cd834" FOREIGN KEY ("document_id") REFERENCES "paperless_documents" ("id") ON DELETE CASCADE);
+CREATE INDEX "signaturerequest_document_id" ON "paperless_signature_requests" ("document_id");
+CREATE INDEX "signaturerequest_provider_envelope_id" ON "paperless_signature_requests" ("provider", "envelope_id");
+COMMENT ON COLUMN "paperless_signature_requests"."id" IS 'UUID primary key';
+COMMENT ON COLUMN "paperless_signature_requests"."create_by" IS '创建者ID';
+COMMENT ON COLUMN "paperless_signature_requests"."create_time" IS '创建时间';
+COMMENT ON COLUMN "paperless_signature_requests"."update_time" IS '更新时间';
+COMMENT ON COLUMN "paperless_signature_requests"."delete_time" IS '删除时间';
+COMMENT ON COLUMN "paperless_signature_requests"."tenant_id" IS '租户ID';
+COMMENT ON COLUMN "paperless_signature_requests"."provider" IS 'E-signature provider, e.g. docusign';
+COMMENT ON COLUMN "paperless_signature_requests"."envelope_id" IS 'ID of the envelope at the provider';
+COMMENT ON COLUMN "paperless_signature_requests"."status" IS 'Status of the envelope';
+COMMENT ON COLUMN "paperless_signature_requests"."signers" IS 'Recipients who sign, in signing order';
+COMMENT ON COLUMN "paperless_signature_requests"."subject" IS 'Email subject sent to the signers';
+COMMENT ON COLUMN "paperless_signature_requests"."message" IS 'Email message sent to the signers';
+COMMENT ON COLUMN "paperless_signature_requests"."original_file_key" IS 'Storage key of the unsigned file, kept after the signed PDF replaces it';
+COMMENT ON COLUMN "paperless_signature_requests"."signed_file_key" IS 'Storage key of the signed PDF';
+COMMENT ON COLUMN "paperless_signature_requests"."completed_at" IS 'When the signed PDF was attached';
+COMMENT ON COLUMN "paperless_signature_requests"."last_error" IS 'Why sending, voiding or attaching the signed PDF failed';
+COMMENT ON COLUMN "paperless_signature_requests"."document_id" IS 'Document being signed';
+CREATE TABLE "paperless_webhook_subscriptions" ("id" character varying NOT NULL, "create_by" bigint NULL, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "name" character varying NOT NULL, "url" character varying NOT NULL, "secret" character varying NOT NULL, "event_types" jsonb NULL, "enabled" boolean NOT NULL DEFAULT true, PRIMARY KEY ("id"));
+CREATE INDEX "webhooksubscription_tenant_id_enabled" ON "paperless_webhook_subscriptions" ("tenant_id", "enabled");
+COMMENT ON COLUMN "paperless_webhook_subscriptions"."id" IS 'UUID primary key';
+COMMENT ON COLUMN "paperless_webhook_subscriptions"."create_by" IS '创建者ID';
+COMMENT ON COLUMN "paperless_webhook_subscriptions"."create_time" IS '创建时间';
+COMMENT ON COLUMN "paperless_webhook_subscriptions"."update_time" IS '更新时间';
+COMMENT ON COLUMN "paperless_webhook_subscriptions"."delete_time" IS '删除时间';
+COMMENT ON COLUMN "paperless_webhook_subscriptions"."tenant_id" IS '租户ID';
+COMMENT ON COLUMN "paperless_webhook_subscriptions"."name" IS 'Display name';
+COMMENT ON COLUMN "paperless_webhook_subscriptions"."url" IS 'Endpoint receiving the deliveries';
+COMMENT ON COLUMN "paperless_webhook_subscriptions"."secret" IS 'HMAC-SHA256 key signing the deliveries';
+COMMENT ON COLUMN "paperless_webhook_subscriptions"."event_types" IS 'Event types to deliver; all events when empty';
+COMMENT ON COLUMN "paperless_webhook_subscriptions"."enabled" IS 'Whether events are delivered';
+CREATE TABLE "paperless_webhook_deliveries" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "event_id" character varying NOT NULL, "event_type" character varying NOT NULL, "payload" text NOT NULL, "status" character varying NOT NULL DEFAULT 'WEBHOOK_DELIVERY_STATUS_PENDING', "attempts" integer NOT NULL DEFAULT 0, "next_attempt_at" timestamptz NULL, "response_status" integer NULL, "last_error" character varying NULL, "duration_ms" bigint NULL, "delivered_at" timestamptz NULL, "subscription_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "paperless_webhook_deliveries_p_ec17a6eb09a8f062a8657d7490b6bafc" FOREIGN KEY ("subscription_id") REFERENCES "paperless_webhook_subscriptions" ("id") ON DELETE CASCADE);
+CREATE UNIQUE INDEX "webhookdelivery_subscription_id_event_id" ON "paperless_webhook_deliveries" ("subscription_id", "event_id");
+CREATE INDEX "webhookdelivery_subscription_id_create_time" ON "paperless_webhook_deliveries" ("subscription_id", "create_time");
+CREATE INDEX "webhookdelivery_status_next_attempt_at" ON "paperless_webhook_deliveries" ("status", "next_attempt_at");
+CREATE INDEX "webhookdelivery_create_time" ON "paperless_webhook_deliveries" ("create_time");
+COMMENT ON COLUMN "paperless_webhook_deliveries"."id" IS 'id';
+COMMENT ON COLUMN "paperless_webhook_deliveries"."create_time" IS '创建时间';
+COMMENT ON COLUMN "paperless_webhook_deliveries"."update_time" IS '更新时间';
+COMMENT ON COLUMN "paperless_webhook_deliveries"."delete_time" IS '删除时间';
+COMMENT ON COLUMN "paperless_webhook_deliveries"."tenant_id" IS '租户ID';
+COMMENT ON COLUMN "paperless_webhook_deliveries"."event_id" IS 'ID of the delivered event';
+COMMENT ON COLUMN "paperless_webhook_deliveries"."event_type" IS 'Type of the delivered event';
+COMMENT ON COLUMN "paperless_webhook_deliveries"."payload" IS 'JSON request body';
+COMMENT ON COLUMN "paperless_webhook_deliveries"."status" IS 'Pending until delivered or out of attempts';
+COMMENT ON COLUMN "paperless_webhook_deliveries"."attempts" IS 'Delivery attempts so far';
+COMMENT ON COLUMN "paperless_webhook_deliveries"."next_attempt_at" IS 'When the next attempt is due, null once the delivery is finished';
+COMMENT ON COLUMN "paperless_webhook_deliveries"."response_status" IS 'HTTP status of the last attempt, 0 if no response was received';
+COMMENT ON COLUMN "paperless_webhook_deliveries"."last_error" IS 'Why the last attempt failed';
+COMMENT ON COLUMN "paperless_webhook_deliveries"."duration_ms" IS 'Duration of the last attempt';
+COMMENT ON COLUMN "paperless_webhook_deliveries"."delivered_at" IS 'When the delivery succeeded';
+COMMENT ON COLUMN "paperless_webhook_deliveries"."subscription_id" IS 'Subscription the event is delivered to';
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "paperless_document_tags";
+DROP TABLE IF EXISTS "paperless_tags";
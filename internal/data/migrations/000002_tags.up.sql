@@ -0,0 +1,32 @@
+CREATE TABLE "paperless_tags" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_by" bigint NULL, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "name" character varying NOT NULL, "color" character varying NULL, "description" character varying NULL, PRIMARY KEY ("id"));
+CREATE UNIQUE INDEX "tag_tenant_id_name" ON "paperless_tags" ("tenant_id", "name");
+COMMENT ON COLUMN "paperless_tags"."id" IS 'id';
+COMMENT ON COLUMN "paperless_tags"."create_by" IS '创建者ID';
+COMMENT ON COLUMN "paperless_tags"."create_time" IS '创建时间';
+COMMENT ON COLUMN "paperless_tags"."update_time" IS '更新时间';
+COMMENT ON COLUMN "paperless_tags"."delete_time" IS '删除时间';
+COMMENT ON COLUMN "paperless_tags"."tenant_id" IS '租户ID';
+COMMENT ON COLUMN "paperless_tags"."name" IS 'Tag name, the key in a document''s tags';
+COMMENT ON COLUMN "paperless_tags"."color" IS 'Display color as #RRGGBB';
+COMMENT ON COLUMN "paperless_tags"."description" IS 'Tag description';
+CREATE TABLE "paperless_document_tags" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "tenant_id" bigint NULL DEFAULT 0, "value" character varying NOT NULL DEFAULT '', "document_id" character varying NOT NULL, "tag_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "paperless_document_tags_paperless_documents_document_tags" FOREIGN KEY ("document_id") REFERENCES "paperless_documents" ("id") ON DELETE CASCADE, CONSTRAINT "paperless_document_tags_paperless_tags_document_tags" FOREIGN KEY ("tag_id") REFERENCES "paperless_tags" ("id") ON DELETE CASCADE);
+CREATE UNIQUE INDEX "documenttag_document_id_tag_id" ON "paperless_document_tags" ("document_id", "tag_id");
+CREATE INDEX "documenttag_tag_id" ON "paperless_document_tags" ("tag_id");
+COMMENT ON COLUMN "paperless_document_tags"."id" IS 'id';
+COMMENT ON COLUMN "paperless_document_tags"."tenant_id" IS '租户ID';
+COMMENT ON COLUMN "paperless_document_tags"."value" IS 'Value of the tag on this document';
+COMMENT ON COLUMN "paperless_document_tags"."document_id" IS 'Tagged document';
+COMMENT ON COLUMN "paperless_document_tags"."tag_id" IS 'Referenced tag';
+-- Backfill tags and references from the documents' tags column; empty and over-long names
+-- stay in the column only, like names written later
+INSERT INTO "paperless_tags" ("tenant_id", "name", "create_time")
+SELECT DISTINCT COALESCE(d."tenant_id", 0), t."key", now()
+FROM "paperless_documents" d
+CROSS JOIN LATERAL jsonb_each_text(CASE WHEN jsonb_typeof(d."tags") = 'object' THEN d."tags" ELSE '{}'::jsonb END) t
+WHERE t."key" <> '' AND octet_length(t."key") <= 255
+ON CONFLICT ("tenant_id", "name") DO NOTHING;
+INSERT INTO "paperless_document_tags" ("tenant_id", "document_id", "tag_id", "value")
+SELECT COALESCE(d."tenant_id", 0), d."id", g."id", COALESCE(t."value", '')
+FROM "paperless_documents" d
+CROSS JOIN LATERAL jsonb_each_text(CASE WHEN jsonb_typeof(d."tags") = 'object' THEN d."tags" ELSE '{}'::jsonb END) t
+JOIN "paperless_tags" g ON g."tenant_id" = COALESCE(d."tenant_id", 0) AND g."name" = t."key";
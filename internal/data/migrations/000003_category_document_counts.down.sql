@@ -0,0 +1 @@
+ALTER TABLE "paperless_categories" DROP COLUMN "subtree_document_count", DROP COLUMN "document_count";
@@ -0,0 +1,16 @@
+ALTER TABLE "paperless_categories" ADD COLUMN "document_count" bigint NOT NULL DEFAULT 0, ADD COLUMN "subtree_document_count" bigint NOT NULL DEFAULT 0;
+COMMENT ON COLUMN "paperless_categories"."document_count" IS 'Documents directly in the category, maintained on document writes';
+COMMENT ON COLUMN "paperless_categories"."subtree_document_count" IS 'Documents in the category and its descendants, maintained on document writes';
+-- Backfill the counters; soft-deleted documents are not counted
+UPDATE "paperless_categories" c SET "document_count" = d."count"
+FROM (
+  SELECT "category_id", count(*) AS "count" FROM "paperless_documents"
+  WHERE "category_id" IS NOT NULL AND "status" <> 'DOCUMENT_STATUS_DELETED'
+  GROUP BY "category_id"
+) d
+WHERE c."id" = d."category_id";
+UPDATE "paperless_categories" c SET "subtree_document_count" = (
+  SELECT COALESCE(sum(s."document_count"), 0) FROM "paperless_categories" s
+  WHERE s."tenant_id" IS NOT DISTINCT FROM c."tenant_id"
+    AND (s."path" = c."path" OR left(s."path", length(c."path") + 1) = c."path" || '/')
+);
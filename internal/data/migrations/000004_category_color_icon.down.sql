@@ -0,0 +1 @@
+ALTER TABLE "paperless_categories" DROP COLUMN "icon", DROP COLUMN "color";
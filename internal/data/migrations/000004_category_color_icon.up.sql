@@ -0,0 +1,3 @@
+ALTER TABLE "paperless_categories" ADD COLUMN "color" character varying NULL, ADD COLUMN "icon" character varying NULL;
+COMMENT ON COLUMN "paperless_categories"."color" IS 'Display color as #RRGGBB';
+COMMENT ON COLUMN "paperless_categories"."icon" IS 'Icon name shown in the category tree';
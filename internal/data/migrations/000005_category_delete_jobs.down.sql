@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "paperless_category_delete_jobs";
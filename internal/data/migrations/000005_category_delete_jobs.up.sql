@@ -0,0 +1,23 @@
+CREATE TABLE "paperless_category_delete_jobs" ("id" character varying NOT NULL, "create_by" bigint NULL, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "category_id" character varying NOT NULL, "category_path" character varying NOT NULL, "mode" character varying NOT NULL, "target_category_id" character varying NULL, "status" character varying NOT NULL DEFAULT 'CATEGORY_DELETE_JOB_STATUS_PENDING', "total_items" bigint NOT NULL DEFAULT 0, "processed_items" bigint NOT NULL DEFAULT 0, "categories_deleted" bigint NOT NULL DEFAULT 0, "categories_moved" bigint NOT NULL DEFAULT 0, "documents_deleted" bigint NOT NULL DEFAULT 0, "documents_moved" bigint NOT NULL DEFAULT 0, "error" character varying NULL, "lease_until" timestamptz NULL, "finished_at" timestamptz NULL, PRIMARY KEY ("id"));
+CREATE INDEX "categorydeletejob_status_lease_until" ON "paperless_category_delete_jobs" ("status", "lease_until");
+CREATE INDEX "categorydeletejob_tenant_id_create_time" ON "paperless_category_delete_jobs" ("tenant_id", "create_time");
+COMMENT ON COLUMN "paperless_category_delete_jobs"."id" IS 'UUID primary key';
+COMMENT ON COLUMN "paperless_category_delete_jobs"."create_by" IS '创建者ID';
+COMMENT ON COLUMN "paperless_category_delete_jobs"."create_time" IS '创建时间';
+COMMENT ON COLUMN "paperless_category_delete_jobs"."update_time" IS '更新时间';
+COMMENT ON COLUMN "paperless_category_delete_jobs"."delete_time" IS '删除时间';
+COMMENT ON COLUMN "paperless_category_delete_jobs"."tenant_id" IS '租户ID';
+COMMENT ON COLUMN "paperless_category_delete_jobs"."category_id" IS 'Category being deleted';
+COMMENT ON COLUMN "paperless_category_delete_jobs"."category_path" IS 'Path of the category when the job was queued';
+COMMENT ON COLUMN "paperless_category_delete_jobs"."mode" IS 'What happens to the category''s contents';
+COMMENT ON COLUMN "paperless_category_delete_jobs"."target_category_id" IS 'Category receiving the contents in reassign mode, null for the root level';
+COMMENT ON COLUMN "paperless_category_delete_jobs"."status" IS 'Pending until a worker picks the job up, running until it is done';
+COMMENT ON COLUMN "paperless_category_delete_jobs"."total_items" IS 'Categories and documents to process, counted when the job was queued';
+COMMENT ON COLUMN "paperless_category_delete_jobs"."processed_items" IS 'Categories and documents processed so far';
+COMMENT ON COLUMN "paperless_category_delete_jobs"."categories_deleted" IS 'Categories deleted so far';
+COMMENT ON COLUMN "paperless_category_delete_jobs"."categories_moved" IS 'Subcategories moved to the target so far';
+COMMENT ON COLUMN "paperless_category_delete_jobs"."documents_deleted" IS 'Documents moved to the trash so far';
+COMMENT ON COLUMN "paperless_category_delete_jobs"."documents_moved" IS 'Documents moved to the target so far';
+COMMENT ON COLUMN "paperless_category_delete_jobs"."error" IS 'Why the job failed';
+COMMENT ON COLUMN "paperless_category_delete_jobs"."lease_until" IS 'Until when the running worker holds the job; another worker resumes it afterwards';
+COMMENT ON COLUMN "paperless_category_delete_jobs"."finished_at" IS 'When the job succeeded or failed';
@@ -0,0 +1 @@
+ALTER TABLE "paperless_categories" DROP COLUMN "max_bytes", DROP COLUMN "max_documents", DROP COLUMN "subtree_document_bytes", DROP COLUMN "document_bytes";
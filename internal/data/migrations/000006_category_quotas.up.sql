@@ -0,0 +1,18 @@
+ALTER TABLE "paperless_categories" ADD COLUMN "document_bytes" bigint NOT NULL DEFAULT 0, ADD COLUMN "subtree_document_bytes" bigint NOT NULL DEFAULT 0, ADD COLUMN "max_documents" bigint NULL, ADD COLUMN "max_bytes" bigint NULL;
+COMMENT ON COLUMN "paperless_categories"."document_bytes" IS 'Total file size of the documents directly in the category, maintained on document writes';
+COMMENT ON COLUMN "paperless_categories"."subtree_document_bytes" IS 'Total file size of the documents in the category and its descendants, maintained on document writes';
+COMMENT ON COLUMN "paperless_categories"."max_documents" IS 'Most documents the category and its descendants may hold (null for no limit)';
+COMMENT ON COLUMN "paperless_categories"."max_bytes" IS 'Most bytes the documents in the category and its descendants may take (null for no limit)';
+-- Backfill the byte counters; soft-deleted documents are not counted
+UPDATE "paperless_categories" c SET "document_bytes" = d."bytes"
+FROM (
+  SELECT "category_id", sum("file_size") AS "bytes" FROM "paperless_documents"
+  WHERE "category_id" IS NOT NULL AND "status" <> 'DOCUMENT_STATUS_DELETED'
+  GROUP BY "category_id"
+) d
+WHERE c."id" = d."category_id";
+UPDATE "paperless_categories" c SET "subtree_document_bytes" = (
+  SELECT COALESCE(sum(s."document_bytes"), 0) FROM "paperless_categories" s
+  WHERE s."tenant_id" IS NOT DISTINCT FROM c."tenant_id"
+    AND (s."path" = c."path" OR left(s."path", length(c."path") + 1) = c."path" || '/')
+);
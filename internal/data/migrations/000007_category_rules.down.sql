@@ -0,0 +1,2 @@
+ALTER TABLE "paperless_documents" DROP COLUMN "retention_class", DROP COLUMN "document_type";
+ALTER TABLE "paperless_categories" DROP COLUMN "rule_retention_class", DROP COLUMN "rule_document_type", DROP COLUMN "rule_tags";
@@ -0,0 +1,7 @@
+ALTER TABLE "paperless_categories" ADD COLUMN "rule_tags" jsonb NULL, ADD COLUMN "rule_document_type" character varying NULL, ADD COLUMN "rule_retention_class" character varying NULL;
+COMMENT ON COLUMN "paperless_categories"."rule_tags" IS 'Tags given to documents filed or moved into the category';
+COMMENT ON COLUMN "paperless_categories"."rule_document_type" IS 'Document type given to documents filed or moved into the category';
+COMMENT ON COLUMN "paperless_categories"."rule_retention_class" IS 'Retention class given to documents filed or moved into the category';
+ALTER TABLE "paperless_documents" ADD COLUMN "document_type" character varying NULL, ADD COLUMN "retention_class" character varying NULL;
+COMMENT ON COLUMN "paperless_documents"."document_type" IS 'Kind of document, e.g. invoice or contract';
+COMMENT ON COLUMN "paperless_documents"."retention_class" IS 'Retention class the document is kept under';
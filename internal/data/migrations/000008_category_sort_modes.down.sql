@@ -0,0 +1 @@
+ALTER TABLE "paperless_categories" DROP COLUMN "activity_time", DROP COLUMN "child_sort_mode";
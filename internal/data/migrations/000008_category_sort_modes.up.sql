@@ -0,0 +1,10 @@
+ALTER TABLE "paperless_categories" ADD COLUMN "child_sort_mode" character varying NOT NULL DEFAULT 'CATEGORY_SORT_MODE_MANUAL', ADD COLUMN "activity_time" timestamptz NULL;
+COMMENT ON COLUMN "paperless_categories"."child_sort_mode" IS 'How the subcategories are ordered';
+COMMENT ON COLUMN "paperless_categories"."activity_time" IS 'Last time documents were added to, removed from or replaced in the category or its descendants, maintained on document writes';
+-- Backfill the activity from the latest document write in each subtree
+UPDATE "paperless_categories" c SET "activity_time" = (
+  SELECT max(COALESCE(d."update_time", d."create_time")) FROM "paperless_documents" d
+  JOIN "paperless_categories" s ON s."id" = d."category_id"
+  WHERE s."tenant_id" IS NOT DISTINCT FROM c."tenant_id"
+    AND (s."path" = c."path" OR left(s."path", length(c."path") + 1) = c."path" || '/')
+);
@@ -0,0 +1,3 @@
+ALTER TABLE "paperless_notification_preferences" DROP COLUMN "reminder_enabled";
+DROP INDEX "document_tenant_id_due_date";
+ALTER TABLE "paperless_documents" DROP COLUMN "reminded_at", DROP COLUMN "due_date";
@@ -0,0 +1,6 @@
+ALTER TABLE "paperless_documents" ADD COLUMN "due_date" timestamptz NULL, ADD COLUMN "reminded_at" timestamptz NULL;
+CREATE INDEX "document_tenant_id_due_date" ON "paperless_documents" ("tenant_id", "due_date");
+COMMENT ON COLUMN "paperless_documents"."due_date" IS 'Date the document is due, e.g. a contract''s renewal date';
+COMMENT ON COLUMN "paperless_documents"."reminded_at" IS 'When the owners were reminded of the due date, cleared when it changes';
+ALTER TABLE "paperless_notification_preferences" ADD COLUMN "reminder_enabled" boolean NOT NULL DEFAULT true;
+COMMENT ON COLUMN "paperless_notification_preferences"."reminder_enabled" IS 'Remind the user of due dates of documents they own';
@@ -0,0 +1,3 @@
+ALTER TABLE "paperless_notification_preferences" DROP COLUMN "assignment_enabled";
+DROP INDEX "document_tenant_id_assignee_id";
+ALTER TABLE "paperless_documents" DROP COLUMN "assigned_at", DROP COLUMN "assigned_by", DROP COLUMN "assignee_id";
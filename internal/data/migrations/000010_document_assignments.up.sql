@@ -0,0 +1,7 @@
+ALTER TABLE "paperless_documents" ADD COLUMN "assignee_id" bigint NULL, ADD COLUMN "assigned_by" bigint NULL, ADD COLUMN "assigned_at" timestamptz NULL;
+CREATE INDEX "document_tenant_id_assignee_id" ON "paperless_documents" ("tenant_id", "assignee_id");
+COMMENT ON COLUMN "paperless_documents"."assignee_id" IS 'User the document awaits action from';
+COMMENT ON COLUMN "paperless_documents"."assigned_by" IS 'User who assigned the document';
+COMMENT ON COLUMN "paperless_documents"."assigned_at" IS 'When the document was assigned';
+ALTER TABLE "paperless_notification_preferences" ADD COLUMN "assignment_enabled" boolean NOT NULL DEFAULT true;
+COMMENT ON COLUMN "paperless_notification_preferences"."assignment_enabled" IS 'Notify the user when a document is assigned to them';
@@ -0,0 +1,17 @@
+CREATE TABLE "paperless_review_tasks" ("id" character varying NOT NULL, "create_by" bigint NULL, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "reviewer_id" bigint NOT NULL, "status" character varying NOT NULL DEFAULT 'REVIEW_STATUS_PENDING', "message" character varying NULL, "comment" text NULL, "reviewed_version" bigint NULL, "completed_at" timestamptz NULL, "completed_by" bigint NULL, "document_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "paperless_review_tasks_paperless_documents_review_tasks" FOREIGN KEY ("document_id") REFERENCES "paperless_documents" ("id") ON DELETE CASCADE);
+CREATE INDEX "reviewtask_document_id" ON "paperless_review_tasks" ("document_id");
+CREATE INDEX "reviewtask_tenant_id_reviewer_id_status" ON "paperless_review_tasks" ("tenant_id", "reviewer_id", "status");
+COMMENT ON COLUMN "paperless_review_tasks"."id" IS 'UUID primary key';
+COMMENT ON COLUMN "paperless_review_tasks"."create_by" IS '创建者ID';
+COMMENT ON COLUMN "paperless_review_tasks"."create_time" IS '创建时间';
+COMMENT ON COLUMN "paperless_review_tasks"."update_time" IS '更新时间';
+COMMENT ON COLUMN "paperless_review_tasks"."delete_time" IS '删除时间';
+COMMENT ON COLUMN "paperless_review_tasks"."tenant_id" IS '租户ID';
+COMMENT ON COLUMN "paperless_review_tasks"."reviewer_id" IS 'User asked to review the document';
+COMMENT ON COLUMN "paperless_review_tasks"."status" IS 'Status of the review';
+COMMENT ON COLUMN "paperless_review_tasks"."message" IS 'Note of the requester to the reviewer';
+COMMENT ON COLUMN "paperless_review_tasks"."comment" IS 'Comment of the reviewer on their decision';
+COMMENT ON COLUMN "paperless_review_tasks"."reviewed_version" IS 'Version of the document the reviewer approved or rejected';
+COMMENT ON COLUMN "paperless_review_tasks"."completed_at" IS 'When the review was approved, rejected or cancelled';
+COMMENT ON COLUMN "paperless_review_tasks"."completed_by" IS 'User who approved, rejected or cancelled the review';
+COMMENT ON COLUMN "paperless_review_tasks"."document_id" IS 'Document to review';
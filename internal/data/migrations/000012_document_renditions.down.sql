@@ -0,0 +1 @@
+ALTER TABLE "paperless_documents" DROP COLUMN "rendition_key";
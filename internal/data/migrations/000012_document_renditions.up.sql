@@ -0,0 +1,2 @@
+ALTER TABLE "paperless_documents" ADD COLUMN "rendition_key" character varying NULL;
+COMMENT ON COLUMN "paperless_documents"."rendition_key" IS 'Storage key of the cached PDF rendition of a file that is not a PDF';
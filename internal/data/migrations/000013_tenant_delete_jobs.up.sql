@@ -0,0 +1,22 @@
+CREATE TABLE "paperless_tenant_delete_jobs" ("id" character varying NOT NULL, "create_by" bigint NULL, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NOT NULL, "status" character varying NOT NULL DEFAULT 'TENANT_DELETE_JOB_STATUS_PENDING', "stage" character varying NULL, "total_items" bigint NOT NULL DEFAULT 0, "processed_items" bigint NOT NULL DEFAULT 0, "documents_deleted" bigint NOT NULL DEFAULT 0, "categories_deleted" bigint NOT NULL DEFAULT 0, "permissions_deleted" bigint NOT NULL DEFAULT 0, "records_deleted" bigint NOT NULL DEFAULT 0, "objects_deleted" bigint NOT NULL DEFAULT 0, "bytes_deleted" bigint NOT NULL DEFAULT 0, "error" character varying NULL, "lease_until" timestamptz NULL, "finished_at" timestamptz NULL, PRIMARY KEY ("id"));
+CREATE INDEX "tenantdeletejob_status_lease_until" ON "paperless_tenant_delete_jobs" ("status", "lease_until");
+CREATE INDEX "tenantdeletejob_tenant_id_create_time" ON "paperless_tenant_delete_jobs" ("tenant_id", "create_time");
+COMMENT ON COLUMN "paperless_tenant_delete_jobs"."id" IS 'UUID primary key';
+COMMENT ON COLUMN "paperless_tenant_delete_jobs"."create_by" IS '创建者ID';
+COMMENT ON COLUMN "paperless_tenant_delete_jobs"."create_time" IS '创建时间';
+COMMENT ON COLUMN "paperless_tenant_delete_jobs"."update_time" IS '更新时间';
+COMMENT ON COLUMN "paperless_tenant_delete_jobs"."delete_time" IS '删除时间';
+COMMENT ON COLUMN "paperless_tenant_delete_jobs"."tenant_id" IS 'Tenant whose data is deleted';
+COMMENT ON COLUMN "paperless_tenant_delete_jobs"."status" IS 'Pending until a worker picks the job up, running until it is done';
+COMMENT ON COLUMN "paperless_tenant_delete_jobs"."stage" IS 'Kind of data the job is deleting';
+COMMENT ON COLUMN "paperless_tenant_delete_jobs"."total_items" IS 'Records to delete, counted when the job was queued';
+COMMENT ON COLUMN "paperless_tenant_delete_jobs"."processed_items" IS 'Records deleted so far';
+COMMENT ON COLUMN "paperless_tenant_delete_jobs"."documents_deleted" IS 'Documents deleted so far, trashed ones included';
+COMMENT ON COLUMN "paperless_tenant_delete_jobs"."categories_deleted" IS 'Categories deleted so far';
+COMMENT ON COLUMN "paperless_tenant_delete_jobs"."permissions_deleted" IS 'Permission grants deleted so far';
+COMMENT ON COLUMN "paperless_tenant_delete_jobs"."records_deleted" IS 'Other records, such as tags, history and audit entries, deleted so far';
+COMMENT ON COLUMN "paperless_tenant_delete_jobs"."objects_deleted" IS 'Storage objects deleted so far';
+COMMENT ON COLUMN "paperless_tenant_delete_jobs"."bytes_deleted" IS 'Size of the storage objects deleted so far';
+COMMENT ON COLUMN "paperless_tenant_delete_jobs"."error" IS 'Why the job failed';
+COMMENT ON COLUMN "paperless_tenant_delete_jobs"."lease_until" IS 'Until when the running worker holds the job; another worker resumes it afterwards';
+COMMENT ON COLUMN "paperless_tenant_delete_jobs"."finished_at" IS 'When the job succeeded or failed';
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "paperless_tenant_quotas";
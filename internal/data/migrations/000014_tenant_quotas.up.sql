@@ -0,0 +1,12 @@
+CREATE TABLE "paperless_tenant_quotas" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "max_documents" bigint NULL, "max_bytes" bigint NULL, "max_monthly_upload_bytes" bigint NULL, "upload_month" character varying NULL, "month_upload_bytes" bigint NOT NULL DEFAULT 0, PRIMARY KEY ("id"));
+CREATE UNIQUE INDEX "tenantquota_tenant_id" ON "paperless_tenant_quotas" ("tenant_id");
+COMMENT ON COLUMN "paperless_tenant_quotas"."id" IS 'id';
+COMMENT ON COLUMN "paperless_tenant_quotas"."create_time" IS '创建时间';
+COMMENT ON COLUMN "paperless_tenant_quotas"."update_time" IS '更新时间';
+COMMENT ON COLUMN "paperless_tenant_quotas"."delete_time" IS '删除时间';
+COMMENT ON COLUMN "paperless_tenant_quotas"."tenant_id" IS '租户ID';
+COMMENT ON COLUMN "paperless_tenant_quotas"."max_documents" IS 'Most documents the tenant may hold (null for no limit)';
+COMMENT ON COLUMN "paperless_tenant_quotas"."max_bytes" IS 'Most bytes the tenant''s documents may take (null for no limit)';
+COMMENT ON COLUMN "paperless_tenant_quotas"."max_monthly_upload_bytes" IS 'Most bytes the tenant may upload per calendar month (null for no limit)';
+COMMENT ON COLUMN "paperless_tenant_quotas"."upload_month" IS 'Month the upload counter refers to, e.g. 2026-10 (UTC)';
+COMMENT ON COLUMN "paperless_tenant_quotas"."month_upload_bytes" IS 'Bytes uploaded in upload_month';
@@ -0,0 +1,12 @@
+CREATE TABLE "paperless_tenant_settings" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "ocr_language" character varying NULL, "disabled_processing_steps" jsonb NULL, "allowed_mime_types" jsonb NULL, "default_retention_class" character varying NULL, "storage_backend" character varying NULL, PRIMARY KEY ("id"));
+CREATE UNIQUE INDEX "tenantsettings_tenant_id" ON "paperless_tenant_settings" ("tenant_id");
+COMMENT ON COLUMN "paperless_tenant_settings"."id" IS 'id';
+COMMENT ON COLUMN "paperless_tenant_settings"."create_time" IS '创建时间';
+COMMENT ON COLUMN "paperless_tenant_settings"."update_time" IS '更新时间';
+COMMENT ON COLUMN "paperless_tenant_settings"."delete_time" IS '删除时间';
+COMMENT ON COLUMN "paperless_tenant_settings"."tenant_id" IS '租户ID';
+COMMENT ON COLUMN "paperless_tenant_settings"."ocr_language" IS 'Tesseract languages used for OCR, e.g. eng+deu';
+COMMENT ON COLUMN "paperless_tenant_settings"."disabled_processing_steps" IS 'Processing steps skipped for the tenant''s documents';
+COMMENT ON COLUMN "paperless_tenant_settings"."allowed_mime_types" IS 'MIME types accepted for uploads, e.g. application/pdf or image/* (empty accepts all)';
+COMMENT ON COLUMN "paperless_tenant_settings"."default_retention_class" IS 'Retention class of new documents that get none from their category';
+COMMENT ON COLUMN "paperless_tenant_settings"."storage_backend" IS 'Storage backend receiving the tenant''s uploads (empty follows the active backend)';
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "paperless_idempotency_keys";
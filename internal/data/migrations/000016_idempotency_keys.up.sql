@@ -0,0 +1,15 @@
+CREATE TABLE "paperless_idempotency_keys" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "user_id" character varying NOT NULL DEFAULT '', "key" character varying NOT NULL, "operation" character varying NOT NULL, "request_hash" character varying NOT NULL, "completed" boolean NOT NULL DEFAULT false, "response" bytea NULL, "expires_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+CREATE UNIQUE INDEX "idempotencykey_tenant_id_user_id_key" ON "paperless_idempotency_keys" ("tenant_id", "user_id", "key");
+CREATE INDEX "idempotencykey_expires_at" ON "paperless_idempotency_keys" ("expires_at");
+COMMENT ON COLUMN "paperless_idempotency_keys"."id" IS 'id';
+COMMENT ON COLUMN "paperless_idempotency_keys"."create_time" IS '创建时间';
+COMMENT ON COLUMN "paperless_idempotency_keys"."update_time" IS '更新时间';
+COMMENT ON COLUMN "paperless_idempotency_keys"."delete_time" IS '删除时间';
+COMMENT ON COLUMN "paperless_idempotency_keys"."tenant_id" IS '租户ID';
+COMMENT ON COLUMN "paperless_idempotency_keys"."user_id" IS 'User who sent the request; keys are scoped to their sender';
+COMMENT ON COLUMN "paperless_idempotency_keys"."key" IS 'Idempotency key chosen by the client';
+COMMENT ON COLUMN "paperless_idempotency_keys"."operation" IS 'RPC the key was used for';
+COMMENT ON COLUMN "paperless_idempotency_keys"."request_hash" IS 'SHA-256 of the request, to refuse a key reused for another request';
+COMMENT ON COLUMN "paperless_idempotency_keys"."completed" IS 'The request succeeded and its response is stored; until then it is in progress';
+COMMENT ON COLUMN "paperless_idempotency_keys"."response" IS 'Response of the request, as a protobuf Any';
+COMMENT ON COLUMN "paperless_idempotency_keys"."expires_at" IS 'When the key can be used again and the record is removed';
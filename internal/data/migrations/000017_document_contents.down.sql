@@ -0,0 +1,10 @@
+ALTER TABLE "paperless_documents" ADD COLUMN "content_text" text NULL, ADD COLUMN "content_text_compressed" bytea NULL, ADD COLUMN "search_terms" text NULL, ADD COLUMN "extracted_metadata" jsonb NULL;
+COMMENT ON COLUMN "paperless_documents"."content_text" IS 'Extracted text content for full-text search (empty when stored compressed)';
+COMMENT ON COLUMN "paperless_documents"."content_text_compressed" IS 'Gzip-compressed extracted text, used instead of content_text for large texts';
+COMMENT ON COLUMN "paperless_documents"."search_terms" IS 'Distinct lowercase words of compressed extracted text, for full-text search';
+COMMENT ON COLUMN "paperless_documents"."extracted_metadata" IS 'Metadata extracted by Tika (author, title, page_count, etc.)';
+UPDATE "paperless_documents" d
+SET "content_text" = c."content_text", "content_text_compressed" = c."content_text_compressed", "search_terms" = c."search_terms", "extracted_metadata" = c."extracted_metadata"
+FROM "paperless_document_contents" c
+WHERE c."document_id" = d."id";
+DROP TABLE IF EXISTS "paperless_document_contents";
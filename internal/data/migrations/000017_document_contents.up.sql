@@ -0,0 +1,15 @@
+CREATE TABLE "paperless_document_contents" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "tenant_id" bigint NULL DEFAULT 0, "content_text" text NULL, "content_text_compressed" bytea NULL, "search_terms" text NULL, "extracted_metadata" jsonb NULL, "document_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "paperless_document_contents_paperless_documents_content" FOREIGN KEY ("document_id") REFERENCES "paperless_documents" ("id") ON DELETE CASCADE);
+CREATE UNIQUE INDEX "documentcontent_document_id" ON "paperless_document_contents" ("document_id");
+COMMENT ON COLUMN "paperless_document_contents"."id" IS 'id';
+COMMENT ON COLUMN "paperless_document_contents"."tenant_id" IS '租户ID';
+COMMENT ON COLUMN "paperless_document_contents"."content_text" IS 'Extracted text content for full-text search (empty when stored compressed)';
+COMMENT ON COLUMN "paperless_document_contents"."content_text_compressed" IS 'Gzip-compressed extracted text, used instead of content_text for large texts';
+COMMENT ON COLUMN "paperless_document_contents"."search_terms" IS 'Distinct lowercase words of compressed extracted text, for full-text search';
+COMMENT ON COLUMN "paperless_document_contents"."extracted_metadata" IS 'Metadata extracted by Tika (author, title, page_count, etc.)';
+COMMENT ON COLUMN "paperless_document_contents"."document_id" IS 'Document the content was extracted from';
+-- Move the extracted text and metadata of processed documents out of the documents table
+INSERT INTO "paperless_document_contents" ("tenant_id", "document_id", "content_text", "content_text_compressed", "search_terms", "extracted_metadata")
+SELECT COALESCE("tenant_id", 0), "id", "content_text", "content_text_compressed", "search_terms", "extracted_metadata"
+FROM "paperless_documents"
+WHERE COALESCE("content_text", '') <> '' OR "content_text_compressed" IS NOT NULL OR "search_terms" IS NOT NULL OR "extracted_metadata" IS NOT NULL;
+ALTER TABLE "paperless_documents" DROP COLUMN "content_text", DROP COLUMN "content_text_compressed", DROP COLUMN "search_terms", DROP COLUMN "extracted_metadata";
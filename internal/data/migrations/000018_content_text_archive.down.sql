@@ -0,0 +1,3 @@
+ALTER TABLE "paperless_document_contents" DROP COLUMN "content_text_key";
+COMMENT ON COLUMN "paperless_document_contents"."content_text" IS 'Extracted text content for full-text search (empty when stored compressed)';
+COMMENT ON COLUMN "paperless_document_contents"."search_terms" IS 'Distinct lowercase words of compressed extracted text, for full-text search';
@@ -0,0 +1,4 @@
+ALTER TABLE "paperless_document_contents" ADD COLUMN "content_text_key" character varying NULL;
+COMMENT ON COLUMN "paperless_document_contents"."content_text" IS 'Extracted text content for full-text search (empty when stored compressed, the start of the text when archived)';
+COMMENT ON COLUMN "paperless_document_contents"."search_terms" IS 'Distinct lowercase words of compressed or archived extracted text, for full-text search';
+COMMENT ON COLUMN "paperless_document_contents"."content_text_key" IS 'Storage key of the full extracted text when only its start is stored';
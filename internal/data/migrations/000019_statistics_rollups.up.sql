@@ -0,0 +1,10 @@
+CREATE TABLE "paperless_statistics_rollups" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NULL, "update_time" timestamptz NULL, "delete_time" timestamptz NULL, "tenant_id" bigint NULL DEFAULT 0, "all_tenants" boolean NOT NULL DEFAULT false, "statistics" bytea NOT NULL, "generated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+CREATE UNIQUE INDEX "statisticsrollup_tenant_id_all_tenants" ON "paperless_statistics_rollups" ("tenant_id", "all_tenants");
+COMMENT ON COLUMN "paperless_statistics_rollups"."id" IS 'id';
+COMMENT ON COLUMN "paperless_statistics_rollups"."create_time" IS '创建时间';
+COMMENT ON COLUMN "paperless_statistics_rollups"."update_time" IS '更新时间';
+COMMENT ON COLUMN "paperless_statistics_rollups"."delete_time" IS '删除时间';
+COMMENT ON COLUMN "paperless_statistics_rollups"."tenant_id" IS '租户ID';
+COMMENT ON COLUMN "paperless_statistics_rollups"."all_tenants" IS 'Whether the statistics cover all tenants instead of tenant_id';
+COMMENT ON COLUMN "paperless_statistics_rollups"."statistics" IS 'Protobuf-encoded GetStatisticsResponse, with the most top tags a request may ask for';
+COMMENT ON COLUMN "paperless_statistics_rollups"."generated_at" IS 'When the statistics were computed';
@@ -0,0 +1,13 @@
+// Package migrations embeds the versioned PostgreSQL schema migrations.
+//
+// Files follow the golang-migrate naming scheme ({version}_{title}.up.sql and
+// {version}_{title}.down.sql) so they can also be applied with the migrate CLI.
+// New migrations are generated from the ent schema with atlas, see README.
+package migrations
+
+import "embed"
+
+// FS holds the migration files
+//
+//go:embed *.sql
+var FS embed.FS
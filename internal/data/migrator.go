@@ -0,0 +1,335 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/migrations"
+)
+
+const (
+	// migrationsTable records the applied version in the same layout golang-migrate uses
+	migrationsTable = "schema_migrations"
+	// migrationLockID is the advisory lock key that serialises concurrent migrators
+	migrationLockID = 7_351_622_908_114
+	// baselineTable exists in every database created before versioned migrations
+	baselineTable = "paperless_documents"
+)
+
+// NoMigrationVersion is the version of a database without any applied migration
+const NoMigrationVersion = -1
+
+var migrationFileRe = regexp.MustCompile(`^(\d+)_([A-Za-z0-9_\-]+)\.(up|down)\.sql$`)
+
+// ErrDirtyMigration is returned when a previous migration failed half-way and has to be
+// resolved by hand (fix the schema, then force the version)
+var ErrDirtyMigration = errors.New("database is in a dirty migration state, fix it and run 'migrate force'")
+
+// ErrUnversionedSchema is returned when the schema was created by auto-migration and has
+// no recorded version yet
+var ErrUnversionedSchema = errors.New("schema exists but has no migration version, run 'migrate force 1' once to baseline it")
+
+type migration struct {
+	version int64
+	name    string
+	up      string
+	down    string
+}
+
+// MigrationStatus describes the migration state of a database
+type MigrationStatus struct {
+	Version int64
+	Dirty   bool
+	Latest  int64
+	Pending []string
+}
+
+// Migrator applies the embedded versioned migrations to a PostgreSQL database. Each
+// migration runs in its own transaction together with the version bump, so a failed
+// migration leaves the previous version in place.
+type Migrator struct {
+	db         *sql.DB
+	migrations []migration
+	log        *log.Helper
+}
+
+// NewMigrator creates a Migrator for db using the embedded migration files
+func NewMigrator(db *sql.DB, l *log.Helper) (*Migrator, error) {
+	list, err := loadMigrations(migrations.FS)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: list, log: l}, nil
+}
+
+// loadMigrations reads and pairs the up/down files, ordered by version
+func loadMigrations(fsys fs.FS) ([]migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("read migrations: %w", err)
+	}
+
+	byVersion := make(map[int64]*migration)
+	for _, entry := range entries {
+		match := migrationFileRe.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("migration %s: %w", entry.Name(), err)
+		}
+		body, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("read migration %s: %w", entry.Name(), err)
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &migration{version: version, name: match[2]}
+			byVersion[version] = m
+		} else if m.name != match[2] {
+			return nil, fmt.Errorf("migration version %d used by %s and %s", version, m.name, match[2])
+		}
+		if match[3] == "up" {
+			m.up = string(body)
+		} else {
+			m.down = string(body)
+		}
+	}
+
+	list := make([]migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.up == "" {
+			return nil, fmt.Errorf("migration %d_%s has no up file", m.version, m.name)
+		}
+		list = append(list, *m)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].version < list[j].version })
+	return list, nil
+}
+
+// Up applies up to steps pending migrations, or all of them when steps <= 0,
+// and returns how many were applied
+func (m *Migrator) Up(ctx context.Context, steps int) (int, error) {
+	applied := 0
+	err := m.locked(ctx, func(conn *sql.Conn) error {
+		current, err := m.currentVersion(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if current == NoMigrationVersion {
+			exists, err := tableExists(ctx, conn, baselineTable)
+			if err != nil {
+				return err
+			}
+			if exists {
+				return ErrUnversionedSchema
+			}
+		}
+
+		for _, mig := range m.migrations {
+			if mig.version <= current {
+				continue
+			}
+			if steps > 0 && applied >= steps {
+				break
+			}
+			m.log.Infof("applying migration %d_%s", mig.version, mig.name)
+			if err := m.apply(ctx, conn, mig.up, mig.version); err != nil {
+				return fmt.Errorf("migration %d_%s up: %w", mig.version, mig.name, err)
+			}
+			applied++
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down reverts the last steps applied migrations (at least one) and returns how many were reverted
+func (m *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	if steps <= 0 {
+		steps = 1
+	}
+	reverted := 0
+	err := m.locked(ctx, func(conn *sql.Conn) error {
+		current, err := m.currentVersion(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for i := len(m.migrations) - 1; i >= 0 && reverted < steps; i-- {
+			mig := m.migrations[i]
+			if mig.version > current {
+				continue
+			}
+			if mig.down == "" {
+				return fmt.Errorf("migration %d_%s has no down file", mig.version, mig.name)
+			}
+			previous := int64(NoMigrationVersion)
+			if i > 0 {
+				previous = m.migrations[i-1].version
+			}
+			m.log.Infof("reverting migration %d_%s", mig.version, mig.name)
+			if err := m.apply(ctx, conn, mig.down, previous); err != nil {
+				return fmt.Errorf("migration %d_%s down: %w", mig.version, mig.name, err)
+			}
+			current = previous
+			reverted++
+		}
+		return nil
+	})
+	return reverted, err
+}
+
+// Force records version as applied without running any migration, clearing the dirty
+// flag. NoMigrationVersion removes the record altogether.
+func (m *Migrator) Force(ctx context.Context, version int64) error {
+	if version < NoMigrationVersion {
+		return fmt.Errorf("invalid migration version %d", version)
+	}
+	return m.locked(ctx, func(conn *sql.Conn) error {
+		tx, err := conn.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		if err := setVersion(ctx, tx, version); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+		return tx.Commit()
+	})
+}
+
+// Status reports the applied version and the migrations that are still pending
+func (m *Migrator) Status(ctx context.Context) (*MigrationStatus, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if err := ensureMigrationsTable(ctx, conn); err != nil {
+		return nil, err
+	}
+	version, dirty, err := readVersion(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &MigrationStatus{Version: version, Dirty: dirty, Latest: NoMigrationVersion}
+	for _, mig := range m.migrations {
+		status.Latest = mig.version
+		if mig.version > version {
+			status.Pending = append(status.Pending, fmt.Sprintf("%d_%s", mig.version, mig.name))
+		}
+	}
+	return status, nil
+}
+
+// locked runs fn on a dedicated connection holding the migration advisory lock, so
+// replicas starting at the same time do not migrate concurrently
+func (m *Migrator) locked(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", migrationLockID); err != nil {
+		return fmt.Errorf("acquire migration lock: %w", err)
+	}
+	defer func() {
+		if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", migrationLockID); err != nil {
+			m.log.Warnf("release migration lock failed: %v", err)
+		}
+	}()
+
+	if err := ensureMigrationsTable(ctx, conn); err != nil {
+		return err
+	}
+	return fn(conn)
+}
+
+// currentVersion returns the applied version, refusing to continue from a dirty state
+func (m *Migrator) currentVersion(ctx context.Context, conn *sql.Conn) (int64, error) {
+	version, dirty, err := readVersion(ctx, conn)
+	if err != nil {
+		return 0, err
+	}
+	if dirty {
+		return 0, fmt.Errorf("version %d: %w", version, ErrDirtyMigration)
+	}
+	return version, nil
+}
+
+// apply runs one migration script and records the resulting version in a single transaction
+func (m *Migrator) apply(ctx context.Context, conn *sql.Conn, script string, version int64) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, script); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := setVersion(ctx, tx, version); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func ensureMigrationsTable(ctx context.Context, conn *sql.Conn) error {
+	_, err := conn.ExecContext(ctx,
+		`CREATE TABLE IF NOT EXISTS "`+migrationsTable+`" ("version" bigint NOT NULL PRIMARY KEY, "dirty" boolean NOT NULL)`)
+	if err != nil {
+		return fmt.Errorf("create %s: %w", migrationsTable, err)
+	}
+	return nil
+}
+
+func readVersion(ctx context.Context, conn *sql.Conn) (int64, bool, error) {
+	var (
+		version int64
+		dirty   bool
+	)
+	err := conn.QueryRowContext(ctx, `SELECT "version", "dirty" FROM "`+migrationsTable+`" LIMIT 1`).Scan(&version, &dirty)
+	if errors.Is(err, sql.ErrNoRows) {
+		return NoMigrationVersion, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("read migration version: %w", err)
+	}
+	return version, dirty, nil
+}
+
+func setVersion(ctx context.Context, tx *sql.Tx, version int64) error {
+	if _, err := tx.ExecContext(ctx, `TRUNCATE "`+migrationsTable+`"`); err != nil {
+		return fmt.Errorf("reset migration version: %w", err)
+	}
+	if version == NoMigrationVersion {
+		return nil
+	}
+	if _, err := tx.ExecContext(ctx,
+		`INSERT INTO "`+migrationsTable+`" ("version", "dirty") VALUES ($1, false)`, version); err != nil {
+		return fmt.Errorf("record migration version: %w", err)
+	}
+	return nil
+}
+
+func tableExists(ctx context.Context, conn *sql.Conn, table string) (bool, error) {
+	var exists bool
+	if err := conn.QueryRowContext(ctx, "SELECT to_regclass($1) IS NOT NULL", table).Scan(&exists); err != nil {
+		return false, fmt.Errorf("check table %s: %w", table, err)
+	}
+	return exists, nil
+}
@@ -0,0 +1,114 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/metrics"
+)
+
+// notificationTimeout bounds a single call to the notification module
+const notificationTimeout = 10 * time.Second
+
+// Notification kinds sent to the platform notification module
+const (
+	NotificationTypeShare      = "paperless.share"
+	NotificationTypeMention    = "paperless.mention"
+	NotificationTypeReminder   = "paperless.reminder"
+	NotificationTypeAssignment = "paperless.assignment"
+	NotificationTypeReview     = "paperless.review"
+)
+
+// Notification is an in-app message for one user
+type Notification struct {
+	TenantID     uint32 `json:"tenantId"`
+	UserID       uint32 `json:"userId"`
+	Type         string `json:"type"`
+	Title        string `json:"title"`
+	Message      string `json:"message"`
+	ResourceType string `json:"resourceType,omitempty"`
+	ResourceID   string `json:"resourceId,omitempty"`
+	ActorID      string `json:"actorId,omitempty"`
+}
+
+// NotificationClient delivers in-app notifications through the platform notification module
+type NotificationClient struct {
+	endpoint   string
+	token      string
+	httpClient *http.Client
+	log        *log.Helper
+}
+
+// NewNotificationClient creates a client for the notification module at
+// PAPERLESS_NOTIFICATION_ENDPOINT; notifications are disabled when it is unset
+func NewNotificationClient(ctx *bootstrap.Context) (*NotificationClient, func()) {
+	l := ctx.NewLoggerHelper("paperless/notification")
+
+	nc := &NotificationClient{
+		endpoint:   strings.TrimRight(os.Getenv("PAPERLESS_NOTIFICATION_ENDPOINT"), "/"),
+		token:      os.Getenv("PAPERLESS_NOTIFICATION_TOKEN"),
+		httpClient: &http.Client{Timeout: notificationTimeout},
+		log:        l,
+	}
+	if nc.endpoint == "" {
+		l.Info("PAPERLESS_NOTIFICATION_ENDPOINT not set, in-app notifications disabled")
+	}
+
+	return nc, func() {
+		nc.httpClient.CloseIdleConnections()
+	}
+}
+
+// Enabled reports whether a notification module is configured
+func (c *NotificationClient) Enabled() bool {
+	return c.endpoint != ""
+}
+
+// Send delivers a notification to its user
+func (c *NotificationClient) Send(ctx context.Context, n *Notification) error {
+	if !c.Enabled() {
+		return nil
+	}
+	start := time.Now()
+	err := c.send(ctx, n)
+	metrics.ObserveExternalRequest("notification", "send", start, err)
+	return err
+}
+
+func (c *NotificationClient) send(ctx context.Context, n *Notification) error {
+	body, err := json.Marshal(n)
+	if err != nil {
+		return fmt.Errorf("failed to encode notification: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint+"/v1/notifications", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create notification request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("notification request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("notification module returned status %d: %s", resp.StatusCode, string(respBody))
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package data
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/notificationpreference"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// NotificationPreferenceRepo stores the users' in-app notification opt-outs
+type NotificationPreferenceRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewNotificationPreferenceRepo creates a new NotificationPreferenceRepo
+func NewNotificationPreferenceRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *NotificationPreferenceRepo {
+	return &NotificationPreferenceRepo{
+		log:       ctx.NewLoggerHelper("paperless/notification_preference_repo"),
+		entClient: entClient,
+	}
+}
+
+// Get returns a user's preferences, with everything enabled if the user never changed them
+func (r *NotificationPreferenceRepo) Get(ctx context.Context, tenantID, userID uint32) (*paperlessV1.NotificationPreferences, error) {
+	entity, err := r.entClient.Client().NotificationPreference.Query().
+		Where(
+			notificationpreference.TenantIDEQ(tenantID),
+			notificationpreference.UserIDEQ(userID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &paperlessV1.NotificationPreferences{ShareEnabled: true, MentionEnabled: true, ReminderEnabled: true, AssignmentEnabled: true}, nil
+		}
+		r.log.Errorf("get notification preferences failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("get notification preferences failed")
+	}
+	return r.ToProto(entity), nil
+}
+
+// Set creates or replaces a user's preferences
+func (r *NotificationPreferenceRepo) Set(ctx context.Context, tenantID, userID uint32, prefs *paperlessV1.NotificationPreferences) error {
+	err := r.entClient.Client().NotificationPreference.Create().
+		SetTenantID(tenantID).
+		SetUserID(userID).
+		SetShareEnabled(prefs.ShareEnabled).
+		SetMentionEnabled(prefs.MentionEnabled).
+		SetReminderEnabled(prefs.ReminderEnabled).
+		SetAssignmentEnabled(prefs.AssignmentEnabled).
+		OnConflictColumns(notificationpreference.FieldTenantID, notificationpreference.FieldUserID).
+		UpdateShareEnabled().
+		UpdateMentionEnabled().
+		UpdateReminderEnabled().
+		UpdateAssignmentEnabled().
+		UpdateUpdateTime().
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("set notification preferences failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("set notification preferences failed")
+	}
+	return nil
+}
+
+// ToProto converts an ent.NotificationPreference to paperlessV1.NotificationPreferences
+func (r *NotificationPreferenceRepo) ToProto(entity *ent.NotificationPreference) *paperlessV1.NotificationPreferences {
+	if entity == nil {
+		return nil
+	}
+	return &paperlessV1.NotificationPreferences{
+		ShareEnabled:      entity.ShareEnabled,
+		MentionEnabled:    entity.MentionEnabled,
+		ReminderEnabled:   entity.ReminderEnabled,
+		AssignmentEnabled: entity.AssignmentEnabled,
+	}
+}
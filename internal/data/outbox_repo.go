@@ -0,0 +1,121 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/outboxevent"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// OutboxRepo stores lifecycle events until they were published
+type OutboxRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewOutboxRepo creates a new OutboxRepo
+func NewOutboxRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *OutboxRepo {
+	return &OutboxRepo{
+		log:       ctx.NewLoggerHelper("paperless/outbox_repo"),
+		entClient: entClient,
+	}
+}
+
+// Add stores an encoded event, due immediately. It joins the transaction in ctx, so the
+// event is only stored if the change it describes is committed.
+func (r *OutboxRepo) Add(ctx context.Context, event *CloudEvent, payload []byte) error {
+	now := time.Now()
+	err := clientFromContext(ctx, r.entClient).OutboxEvent.Create().
+		SetTenantID(event.TenantID).
+		SetEventID(event.ID).
+		SetEventType(event.Type).
+		SetSubject(event.Subject).
+		SetPayload(string(payload)).
+		SetNextAttemptAt(now).
+		SetCreateTime(now).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("add outbox event failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("add outbox event failed")
+	}
+	return nil
+}
+
+// ClaimDue returns up to limit due events in the order they were added. Each is leased by
+// moving its next attempt to now+lease, so other replicas skip it while it is being
+// published; an event whose relay crashes is published again after the lease.
+func (r *OutboxRepo) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*ent.OutboxEvent, error) {
+	due, err := r.entClient.Client().OutboxEvent.Query().
+		Where(outboxevent.NextAttemptAtLTE(now)).
+		Order(ent.Asc(outboxevent.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list due outbox events failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("list due outbox events failed")
+	}
+
+	claimed := due[:0]
+	for _, e := range due {
+		n, err := r.entClient.Client().OutboxEvent.Update().
+			Where(
+				outboxevent.IDEQ(e.ID),
+				outboxevent.NextAttemptAtEQ(e.NextAttemptAt),
+			).
+			SetNextAttemptAt(now.Add(lease)).
+			Save(ctx)
+		if err != nil {
+			r.log.Errorf("claim outbox event %d failed: %s", e.ID, err.Error())
+			continue
+		}
+		if n == 1 {
+			claimed = append(claimed, e)
+		}
+	}
+	return claimed, nil
+}
+
+// Delete removes published events
+func (r *OutboxRepo) Delete(ctx context.Context, ids []uint32) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	if _, err := r.entClient.Client().OutboxEvent.Delete().Where(outboxevent.IDIn(ids...)).Exec(ctx); err != nil {
+		r.log.Errorf("delete outbox events failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("delete outbox events failed")
+	}
+	return nil
+}
+
+// RecordFailure stores why an event could not be published and when to try again
+func (r *OutboxRepo) RecordFailure(ctx context.Context, id uint32, msg string, nextAttemptAt time.Time) error {
+	err := r.entClient.Client().OutboxEvent.UpdateOneID(id).
+		AddAttempts(1).
+		SetLastError(truncateError(msg)).
+		SetNextAttemptAt(nextAttemptAt).
+		SetUpdateTime(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("record outbox failure failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("record outbox failure failed")
+	}
+	return nil
+}
+
+// Count returns the number of events waiting to be published
+func (r *OutboxRepo) Count(ctx context.Context) (int, error) {
+	n, err := r.entClient.Client().OutboxEvent.Query().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count outbox events failed: %s", err.Error())
+		return 0, paperlessV1.ErrorInternalServerError("count outbox events failed")
+	}
+	return n, nil
+}
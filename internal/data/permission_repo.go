@@ -13,25 +13,28 @@ import (
 	"github.com/go-tangra/go-tangra-paperless/internal/authz"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documentpermission"
+	"github.com/go-tangra/go-tangra-paperless/internal/errdetail"
 
 	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
 )
 
 type PermissionRepo struct {
-	entClient *entCrud.EntClient[*ent.Client]
-	log       *log.Helper
+	entClient   *entCrud.EntClient[*ent.Client]
+	accessIndex *AccessIndexRepo
+	log         *log.Helper
 }
 
-func NewPermissionRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *PermissionRepo {
+func NewPermissionRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], accessIndex *AccessIndexRepo) *PermissionRepo {
 	return &PermissionRepo{
-		log:       ctx.NewLoggerHelper("paperless/permission/repo"),
-		entClient: entClient,
+		log:         ctx.NewLoggerHelper("paperless/permission/repo"),
+		entClient:   entClient,
+		accessIndex: accessIndex,
 	}
 }
 
 // Create creates a new permission
-func (r *PermissionRepo) Create(ctx context.Context, tenantID uint32, resourceType, resourceID, relation, subjectType, subjectID string, grantedBy *uint32, expiresAt *time.Time) (*ent.DocumentPermission, error) {
-	builder := r.entClient.Client().DocumentPermission.Create().
+func (r *PermissionRepo) Create(ctx context.Context, tenantID uint32, resourceType, resourceID, relation, subjectType, subjectID string, grantedBy *uint32, expiresAt *time.Time, conditions *authz.Conditions) (*ent.DocumentPermission, error) {
+	builder := clientFromContext(ctx, r.entClient).DocumentPermission.Create().
 		SetTenantID(tenantID).
 		SetResourceType(documentpermission.ResourceType(resourceType)).
 		SetResourceID(resourceID).
@@ -46,22 +49,30 @@ func (r *PermissionRepo) Create(ctx context.Context, tenantID uint32, resourceTy
 	if expiresAt != nil {
 		builder.SetExpiresAt(*expiresAt)
 	}
+	if !conditions.IsEmpty() {
+		builder.SetConditions(conditions)
+	}
 
 	entity, err := builder.Save(ctx)
 	if err != nil {
 		if ent.IsConstraintError(err) {
-			return nil, paperlessV1.ErrorPermissionAlreadyExists("permission already exists")
+			return nil, errdetail.With(paperlessV1.ErrorPermissionAlreadyExists("permission already exists"),
+				errdetail.KeyPermissionAlreadyExists, "")
 		}
 		r.log.Errorf("create permission failed: %s", err.Error())
 		return nil, paperlessV1.ErrorInternalServerError("create permission failed")
 	}
 
+	if err := r.accessIndex.IndexPermission(ctx, entity); err != nil {
+		r.log.Warnf("failed to index permission %d: %v", entity.ID, err)
+	}
+
 	return entity, nil
 }
 
 // GetByTuple retrieves a permission by its tuple
 func (r *PermissionRepo) GetByTuple(ctx context.Context, tenantID uint32, resourceType, resourceID, relation, subjectType, subjectID string) (*ent.DocumentPermission, error) {
-	entity, err := r.entClient.Client().DocumentPermission.Query().
+	entity, err := clientFromContext(ctx, r.entClient).DocumentPermission.Query().
 		Where(
 			documentpermission.TenantIDEQ(tenantID),
 			documentpermission.ResourceTypeEQ(documentpermission.ResourceType(resourceType)),
@@ -83,7 +94,7 @@ func (r *PermissionRepo) GetByTuple(ctx context.Context, tenantID uint32, resour
 
 // List lists permissions with optional filters
 func (r *PermissionRepo) List(ctx context.Context, tenantID uint32, resourceType, resourceID, subjectType, subjectID *string, page, pageSize uint32) ([]*ent.DocumentPermission, int, error) {
-	query := r.entClient.Client().DocumentPermission.Query().
+	query := clientFromContext(ctx, r.entClient).DocumentPermission.Query().
 		Where(documentpermission.TenantIDEQ(tenantID))
 
 	if resourceType != nil && *resourceType != "" {
@@ -123,7 +134,7 @@ func (r *PermissionRepo) List(ctx context.Context, tenantID uint32, resourceType
 
 // ListByResource lists permissions for a specific resource
 func (r *PermissionRepo) ListByResource(ctx context.Context, tenantID uint32, resourceType, resourceID string) ([]*ent.DocumentPermission, error) {
-	entities, err := r.entClient.Client().DocumentPermission.Query().
+	entities, err := clientFromContext(ctx, r.entClient).DocumentPermission.Query().
 		Where(
 			documentpermission.TenantIDEQ(tenantID),
 			documentpermission.ResourceTypeEQ(documentpermission.ResourceType(resourceType)),
@@ -139,7 +150,7 @@ func (r *PermissionRepo) ListByResource(ctx context.Context, tenantID uint32, re
 
 // ListBySubject lists permissions for a specific subject
 func (r *PermissionRepo) ListBySubject(ctx context.Context, tenantID uint32, subjectType, subjectID string) ([]*ent.DocumentPermission, error) {
-	entities, err := r.entClient.Client().DocumentPermission.Query().
+	entities, err := clientFromContext(ctx, r.entClient).DocumentPermission.Query().
 		Where(
 			documentpermission.TenantIDEQ(tenantID),
 			documentpermission.SubjectTypeEQ(documentpermission.SubjectType(subjectType)),
@@ -155,7 +166,7 @@ func (r *PermissionRepo) ListBySubject(ctx context.Context, tenantID uint32, sub
 
 // Delete deletes a permission by tuple
 func (r *PermissionRepo) Delete(ctx context.Context, tenantID uint32, resourceType, resourceID string, relation *string, subjectType, subjectID string) error {
-	query := r.entClient.Client().DocumentPermission.Delete().
+	query := clientFromContext(ctx, r.entClient).DocumentPermission.Query().
 		Where(
 			documentpermission.TenantIDEQ(tenantID),
 			documentpermission.ResourceTypeEQ(documentpermission.ResourceType(resourceType)),
@@ -168,33 +179,55 @@ func (r *PermissionRepo) Delete(ctx context.Context, tenantID uint32, resourceTy
 		query = query.Where(documentpermission.RelationEQ(documentpermission.Relation(*relation)))
 	}
 
-	_, err := query.Exec(ctx)
+	return r.deleteMatching(ctx, query, "delete permission failed")
+}
+
+// deleteMatching deletes the permissions matched by query along with their access index entries
+func (r *PermissionRepo) deleteMatching(ctx context.Context, query *ent.DocumentPermissionQuery, failMsg string) error {
+	ids, err := query.IDs(ctx)
+	if err != nil {
+		r.log.Errorf("%s: %s", failMsg, err.Error())
+		return paperlessV1.ErrorInternalServerError("%s", failMsg)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err = clientFromContext(ctx, r.entClient).DocumentPermission.Delete().
+		Where(documentpermission.IDIn(ids...)).
+		Exec(ctx)
 	if err != nil {
-		r.log.Errorf("delete permission failed: %s", err.Error())
-		return paperlessV1.ErrorInternalServerError("delete permission failed")
+		r.log.Errorf("%s: %s", failMsg, err.Error())
+		return paperlessV1.ErrorInternalServerError("%s", failMsg)
+	}
+
+	if err := r.accessIndex.RemovePermissions(ctx, ids); err != nil {
+		r.log.Warnf("failed to remove access index entries: %v", err)
 	}
 	return nil
 }
 
 // DeleteByResource deletes all permissions for a resource
 func (r *PermissionRepo) DeleteByResource(ctx context.Context, tenantID uint32, resourceType, resourceID string) error {
-	_, err := r.entClient.Client().DocumentPermission.Delete().
+	query := clientFromContext(ctx, r.entClient).DocumentPermission.Query().
 		Where(
 			documentpermission.TenantIDEQ(tenantID),
 			documentpermission.ResourceTypeEQ(documentpermission.ResourceType(resourceType)),
 			documentpermission.ResourceIDEQ(resourceID),
-		).
-		Exec(ctx)
-	if err != nil {
-		r.log.Errorf("delete permissions by resource failed: %s", err.Error())
-		return paperlessV1.ErrorInternalServerError("delete permissions failed")
+		)
+	if err := r.deleteMatching(ctx, query, "delete permissions failed"); err != nil {
+		return err
+	}
+
+	if err := r.accessIndex.RemoveResource(ctx, tenantID, resourceType, resourceID); err != nil {
+		r.log.Warnf("failed to remove access index entries for %s %s: %v", resourceType, resourceID, err)
 	}
 	return nil
 }
 
 // HasPermission checks if a subject has a specific relation on a resource
 func (r *PermissionRepo) HasPermission(ctx context.Context, tenantID uint32, resourceType, resourceID, relation, subjectType, subjectID string) (bool, error) {
-	count, err := r.entClient.Client().DocumentPermission.Query().
+	count, err := clientFromContext(ctx, r.entClient).DocumentPermission.Query().
 		Where(
 			documentpermission.TenantIDEQ(tenantID),
 			documentpermission.ResourceTypeEQ(documentpermission.ResourceType(resourceType)),
@@ -217,7 +250,7 @@ func (r *PermissionRepo) HasPermission(ctx context.Context, tenantID uint32, res
 
 // GetHighestRelation returns the highest relation a subject has on a resource
 func (r *PermissionRepo) GetHighestRelation(ctx context.Context, tenantID uint32, resourceType, resourceID, subjectType, subjectID string) (string, error) {
-	entities, err := r.entClient.Client().DocumentPermission.Query().
+	entities, err := clientFromContext(ctx, r.entClient).DocumentPermission.Query().
 		Where(
 			documentpermission.TenantIDEQ(tenantID),
 			documentpermission.ResourceTypeEQ(documentpermission.ResourceType(resourceType)),
@@ -262,7 +295,7 @@ func (r *PermissionRepo) GetHighestRelation(ctx context.Context, tenantID uint32
 
 // ListAccessibleResources lists resources accessible by a subject
 func (r *PermissionRepo) ListAccessibleResources(ctx context.Context, tenantID uint32, subjectType, subjectID, resourceType string, page, pageSize uint32) ([]string, int, error) {
-	query := r.entClient.Client().DocumentPermission.Query().
+	query := clientFromContext(ctx, r.entClient).DocumentPermission.Query().
 		Where(
 			documentpermission.TenantIDEQ(tenantID),
 			documentpermission.SubjectTypeEQ(documentpermission.SubjectType(subjectType)),
@@ -315,7 +348,7 @@ func (r *PermissionRepo) ListAccessibleResources(ctx context.Context, tenantID u
 
 // GetDirectPermissions returns permissions directly on a resource
 func (r *PermissionRepo) GetDirectPermissions(ctx context.Context, tenantID uint32, resourceType authz.ResourceType, resourceID string) ([]authz.PermissionTuple, error) {
-	entities, err := r.entClient.Client().DocumentPermission.Query().
+	entities, err := clientFromContext(ctx, r.entClient).DocumentPermission.Query().
 		Where(
 			documentpermission.TenantIDEQ(tenantID),
 			documentpermission.ResourceTypeEQ(documentpermission.ResourceType(resourceType)),
@@ -337,7 +370,7 @@ func (r *PermissionRepo) GetDirectPermissions(ctx context.Context, tenantID uint
 
 // GetSubjectPermissions returns all permissions for a subject
 func (r *PermissionRepo) GetSubjectPermissions(ctx context.Context, tenantID uint32, subjectType authz.SubjectType, subjectID string) ([]authz.PermissionTuple, error) {
-	entities, err := r.entClient.Client().DocumentPermission.Query().
+	entities, err := clientFromContext(ctx, r.entClient).DocumentPermission.Query().
 		Where(
 			documentpermission.TenantIDEQ(tenantID),
 			documentpermission.SubjectTypeEQ(documentpermission.SubjectType(subjectType)),
@@ -357,9 +390,9 @@ func (r *PermissionRepo) GetSubjectPermissions(ctx context.Context, tenantID uin
 	return tuples, nil
 }
 
-// HasAuthzPermission checks if a specific permission exists (implements authz.PermissionStore)
-func (r *PermissionRepo) HasAuthzPermission(ctx context.Context, tenantID uint32, resourceType authz.ResourceType, resourceID string, subjectType authz.SubjectType, subjectID string) (*authz.PermissionTuple, error) {
-	entity, err := r.entClient.Client().DocumentPermission.Query().
+// FindAuthzPermissions returns every permission a subject holds on a resource (implements authz.PermissionStore)
+func (r *PermissionRepo) FindAuthzPermissions(ctx context.Context, tenantID uint32, resourceType authz.ResourceType, resourceID string, subjectType authz.SubjectType, subjectID string) ([]authz.PermissionTuple, error) {
+	entities, err := clientFromContext(ctx, r.entClient).DocumentPermission.Query().
 		Where(
 			documentpermission.TenantIDEQ(tenantID),
 			documentpermission.ResourceTypeEQ(documentpermission.ResourceType(resourceType)),
@@ -367,22 +400,23 @@ func (r *PermissionRepo) HasAuthzPermission(ctx context.Context, tenantID uint32
 			documentpermission.SubjectTypeEQ(documentpermission.SubjectType(subjectType)),
 			documentpermission.SubjectIDEQ(subjectID),
 		).
-		First(ctx)
+		All(ctx)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return nil, nil
-		}
 		r.log.Errorf("check permission failed: %s", err.Error())
 		return nil, paperlessV1.ErrorInternalServerError("check permission failed")
 	}
 
-	tuple := r.toAuthzTuple(entity)
-	return &tuple, nil
+	tuples := make([]authz.PermissionTuple, 0, len(entities))
+	for _, e := range entities {
+		tuples = append(tuples, r.toAuthzTuple(e))
+	}
+
+	return tuples, nil
 }
 
 // CreateAuthzPermission creates a new permission (implements authz.PermissionStore)
 func (r *PermissionRepo) CreateAuthzPermission(ctx context.Context, tuple authz.PermissionTuple) (*authz.PermissionTuple, error) {
-	entity, err := r.Create(ctx, tuple.TenantID, string(tuple.ResourceType), tuple.ResourceID, string(tuple.Relation), string(tuple.SubjectType), tuple.SubjectID, tuple.GrantedBy, tuple.ExpiresAt)
+	entity, err := r.Create(ctx, tuple.TenantID, string(tuple.ResourceType), tuple.ResourceID, string(tuple.Relation), string(tuple.SubjectType), tuple.SubjectID, tuple.GrantedBy, tuple.ExpiresAt, tuple.Conditions)
 	if err != nil {
 		return nil, err
 	}
@@ -403,7 +437,7 @@ func (r *PermissionRepo) DeleteAuthzPermission(ctx context.Context, tenantID uin
 
 // ListResourcesBySubject lists resources accessible by a subject (implements authz.PermissionStore)
 func (r *PermissionRepo) ListResourcesBySubject(ctx context.Context, tenantID uint32, subjectType authz.SubjectType, subjectID string, resourceType authz.ResourceType) ([]string, error) {
-	entities, err := r.entClient.Client().DocumentPermission.Query().
+	entities, err := clientFromContext(ctx, r.entClient).DocumentPermission.Query().
 		Where(
 			documentpermission.TenantIDEQ(tenantID),
 			documentpermission.SubjectTypeEQ(documentpermission.SubjectType(subjectType)),
@@ -436,6 +470,7 @@ func (r *PermissionRepo) toAuthzTuple(entity *ent.DocumentPermission) authz.Perm
 		SubjectID:    entity.SubjectID,
 		GrantedBy:    entity.GrantedBy,
 		ExpiresAt:    entity.ExpiresAt,
+		Conditions:   entity.Conditions,
 	}
 	if entity.CreateTime != nil {
 		tuple.CreateTime = *entity.CreateTime
@@ -468,6 +503,52 @@ func (r *PermissionRepo) ToProto(entity *ent.DocumentPermission) *paperlessV1.Pe
 	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
 		proto.CreateTime = timestamppb.New(*entity.CreateTime)
 	}
+	if !entity.Conditions.IsEmpty() {
+		proto.Conditions = conditionsToProto(entity.Conditions)
+	}
 
 	return proto
 }
+
+// conditionsToProto converts authz.Conditions to paperlessV1.PermissionConditions
+func conditionsToProto(c *authz.Conditions) *paperlessV1.PermissionConditions {
+	proto := &paperlessV1.PermissionConditions{
+		AllowedCidrs: c.AllowedCIDRs,
+		RequireMfa:   c.RequireMFA,
+	}
+	if w := c.TimeWindow; w != nil {
+		proto.TimeWindow = &paperlessV1.TimeWindow{
+			Start:    w.Start,
+			End:      w.End,
+			Weekdays: w.Weekdays,
+		}
+		if w.Timezone != "" {
+			proto.TimeWindow.Timezone = &w.Timezone
+		}
+	}
+	return proto
+}
+
+// ConditionsFromProto converts paperlessV1.PermissionConditions to authz.Conditions
+func ConditionsFromProto(proto *paperlessV1.PermissionConditions) *authz.Conditions {
+	if proto == nil {
+		return nil
+	}
+
+	c := &authz.Conditions{
+		AllowedCIDRs: proto.AllowedCidrs,
+		RequireMFA:   proto.RequireMfa,
+	}
+	if w := proto.TimeWindow; w != nil {
+		c.TimeWindow = &authz.TimeWindow{
+			Start:    w.Start,
+			End:      w.End,
+			Timezone: w.GetTimezone(),
+			Weekdays: w.Weekdays,
+		}
+	}
+	if c.IsEmpty() {
+		return nil
+	}
+	return c
+}
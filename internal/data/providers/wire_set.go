@@ -17,12 +17,40 @@ import (
 var ProviderSet = wire.NewSet(
 	data.NewRedisClient,
 	data.NewEntClient,
-	data.NewStorageClient,
+	data.NewReadReplica,
+	data.NewTransaction,
+	data.NewIDGenerator,
+	data.NewStorageRouter,
+	data.NewStorage,
 	data.NewTikaClient,
 	data.NewGotenbergClient,
+	data.NewOutboxRepo,
+	data.NewEventPublisher,
+	data.NewAccessIndexRepo,
 	data.NewCategoryRepo,
+	data.NewCategoryDeleteJobRepo,
+	data.NewTenantDeleteJobRepo,
+	data.NewTenantDataRepo,
+	data.NewTenantQuotaRepo,
+	data.NewTenantSettingsRepo,
+	data.NewIdempotencyRepo,
 	data.NewDocumentRepo,
+	data.NewDocumentHistoryRepo,
 	data.NewPermissionRepo,
 	data.NewAuditLogRepo,
+	data.NewAuditEventRepo,
 	data.NewStatisticsRepo,
+	data.NewTenantKeyRepo,
+	data.NewSettingRepo,
+	data.NewWebhookRepo,
+	data.NewTagRepo,
+	data.NewImportRepo,
+	data.NewSignatureRepo,
+	data.NewSignatureProvider,
+	data.NewReviewRepo,
+	data.NewNotificationClient,
+	data.NewNotificationPreferenceRepo,
+	data.NewGroupRepo,
+	data.NewGroupDirectory,
+	data.NewAntivirusScanner,
 )
@@ -0,0 +1,196 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/metrics"
+)
+
+const (
+	defaultReplicaMaxLag        = "5s"
+	defaultReplicaCheckInterval = "2s"
+	replicaCheckTimeout         = 2 * time.Second
+)
+
+// replicaLagQuery returns the replica's replay lag in seconds. A replica that has replayed
+// everything it received reports 0, even when the primary has been idle for a while.
+const replicaLagQuery = `SELECT CASE
+	WHEN NOT pg_is_in_recovery() OR pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+	ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+END`
+
+type primaryReadKey struct{}
+
+// WithPrimaryRead returns a context whose reads are served by the primary database, for
+// callers that must see their own recent writes
+func WithPrimaryRead(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryReadKey{}, true)
+}
+
+// isPrimaryRead reports whether ctx was marked with WithPrimaryRead
+func isPrimaryRead(ctx context.Context) bool {
+	primary, _ := ctx.Value(primaryReadKey{}).(bool)
+	return primary
+}
+
+// ReadReplica routes read-only queries that tolerate slightly stale data, such as lists,
+// searches and statistics, to a read replica configured with PAPERLESS_DB_REPLICA_SOURCE.
+// Queries fall back to the primary inside transactions, for WithPrimaryRead contexts and
+// while the replica lags behind by more than PAPERLESS_DB_REPLICA_MAX_LAG or is unreachable.
+type ReadReplica struct {
+	primary *entCrud.EntClient[*ent.Client]
+	replica *ent.Client
+	db      *sql.DB
+	log     *log.Helper
+
+	maxLag   time.Duration
+	interval time.Duration
+	healthy  atomic.Bool
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewReadReplica connects to the read replica, if one is configured. Without
+// PAPERLESS_DB_REPLICA_SOURCE every query is served by the primary.
+func NewReadReplica(ctx *bootstrap.Context, primary *entCrud.EntClient[*ent.Client]) (*ReadReplica, func(), error) {
+	l := ctx.NewLoggerHelper("paperless/read_replica")
+
+	r := &ReadReplica{primary: primary, log: l}
+
+	source := getEnvOrDefault("PAPERLESS_DB_REPLICA_SOURCE", "")
+	if source == "" {
+		return r, func() {}, nil
+	}
+
+	var err error
+	if r.maxLag, err = time.ParseDuration(getEnvOrDefault("PAPERLESS_DB_REPLICA_MAX_LAG", defaultReplicaMaxLag)); err != nil || r.maxLag < 0 {
+		return nil, func() {}, fmt.Errorf("invalid PAPERLESS_DB_REPLICA_MAX_LAG")
+	}
+	if r.interval, err = time.ParseDuration(getEnvOrDefault("PAPERLESS_DB_REPLICA_CHECK_INTERVAL", defaultReplicaCheckInterval)); err != nil || r.interval <= 0 {
+		return nil, func() {}, fmt.Errorf("invalid PAPERLESS_DB_REPLICA_CHECK_INTERVAL")
+	}
+
+	cfg := ctx.GetConfig()
+	if cfg == nil || cfg.Data == nil || cfg.Data.Database == nil {
+		return nil, func() {}, fmt.Errorf("PAPERLESS_DB_REPLICA_SOURCE needs a primary database configuration")
+	}
+	database := cfg.Data.Database
+
+	drv, err := entCrud.CreateDriver(database.GetDriver(), source, database.GetEnableTrace(), database.GetEnableMetrics())
+	if err != nil {
+		return nil, func() {}, err
+	}
+	if database.MaxIdleConnections != nil && database.MaxOpenConnections != nil && database.ConnectionMaxLifetime != nil {
+		drv.DB().SetMaxIdleConns(int(database.GetMaxIdleConnections()))
+		drv.DB().SetMaxOpenConns(int(database.GetMaxOpenConnections()))
+		drv.DB().SetConnMaxLifetime(database.GetConnectionMaxLifetime().AsDuration())
+	}
+
+	r.db = drv.DB()
+	r.replica = ent.NewClient(
+		ent.Driver(drv),
+		ent.Log(func(a ...any) {
+			l.Debug(a...)
+		}),
+	)
+	r.replica.Intercept(traceQueries())
+
+	if drv.Dialect() != dialect.Postgres {
+		// Lag is only measured on PostgreSQL; other replicas are trusted to keep up
+		l.Warnf("replica lag is not checked for %s", drv.Dialect())
+		r.healthy.Store(true)
+	} else {
+		r.check(context.Background())
+		r.startChecks()
+	}
+
+	l.Infof("routing read-only queries to the read replica (max lag %s)", r.maxLag)
+
+	return r, r.close, nil
+}
+
+// Client returns the client for a read-only query: the transaction in ctx, the primary for
+// WithPrimaryRead contexts or when the replica is unavailable, and the replica otherwise
+func (r *ReadReplica) Client(ctx context.Context) *ent.Client {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return tx.Client()
+	}
+	if r.replica == nil || !r.healthy.Load() {
+		return r.primary.Client()
+	}
+	if isPrimaryRead(ctx) {
+		return r.primary.Client()
+	}
+	return r.replica
+}
+
+// startChecks measures the replica lag every interval until the replica is closed
+func (r *ReadReplica) startChecks() {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.done = make(chan struct{})
+
+	go func() {
+		defer close(r.done)
+
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.check(ctx)
+			}
+		}
+	}()
+}
+
+// check marks the replica healthy when it answers and lags at most maxLag behind the primary
+func (r *ReadReplica) check(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, replicaCheckTimeout)
+	defer cancel()
+
+	var lag float64
+	if err := r.db.QueryRowContext(ctx, replicaLagQuery).Scan(&lag); err != nil {
+		if r.healthy.Swap(false) {
+			r.log.Warnf("read replica unavailable, reading from the primary: %v", err)
+		}
+		return
+	}
+	metrics.DatabaseReplicaLag.Set(lag)
+
+	healthy := r.maxLag == 0 || lag <= r.maxLag.Seconds()
+	if was := r.healthy.Swap(healthy); was != healthy {
+		if healthy {
+			r.log.Infof("read replica caught up (lag %.1fs), reading from the replica", lag)
+		} else {
+			r.log.Warnf("read replica lags %.1fs behind, reading from the primary", lag)
+		}
+	}
+}
+
+func (r *ReadReplica) close() {
+	if r.cancel != nil {
+		r.cancel()
+		<-r.done
+	}
+	if r.replica != nil {
+		if err := r.replica.Close(); err != nil {
+			r.log.Error(err)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/reviewtask"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// ReviewRepo stores review tasks of documents
+type ReviewRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	ids       *IDGenerator
+	log       *log.Helper
+}
+
+// NewReviewRepo creates a new ReviewRepo
+func NewReviewRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], ids *IDGenerator) *ReviewRepo {
+	return &ReviewRepo{
+		log:       ctx.NewLoggerHelper("paperless/review_repo"),
+		entClient: entClient,
+		ids:       ids,
+	}
+}
+
+// Create stores a pending review of a document by a user
+func (r *ReviewRepo) Create(ctx context.Context, tenantID uint32, documentID string, reviewerID uint32, message string, createdBy *uint32) (*ent.ReviewTask, error) {
+	builder := clientFromContext(ctx, r.entClient).ReviewTask.Create().
+		SetID(r.ids.New()).
+		SetTenantID(tenantID).
+		SetDocumentID(documentID).
+		SetReviewerID(reviewerID).
+		SetMessage(message).
+		SetCreateTime(time.Now())
+
+	if createdBy != nil {
+		builder.SetCreateBy(*createdBy)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("create review task failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("create review failed")
+	}
+	return entity, nil
+}
+
+// Get returns a tenant's review task, or nil if it does not exist
+func (r *ReviewRepo) Get(ctx context.Context, tenantID uint32, id string) (*ent.ReviewTask, error) {
+	entity, err := clientFromContext(ctx, r.entClient).ReviewTask.Query().
+		Where(
+			reviewtask.IDEQ(id),
+			reviewtask.TenantIDEQ(tenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get review task failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("get review failed")
+	}
+	return entity, nil
+}
+
+// HasPending reports whether a user still has to review a document
+func (r *ReviewRepo) HasPending(ctx context.Context, documentID string, reviewerID uint32) (bool, error) {
+	exists, err := clientFromContext(ctx, r.entClient).ReviewTask.Query().
+		Where(
+			reviewtask.DocumentIDEQ(documentID),
+			reviewtask.ReviewerIDEQ(reviewerID),
+			reviewtask.StatusEQ(reviewtask.StatusREVIEW_STATUS_PENDING),
+		).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("check pending review tasks failed: %s", err.Error())
+		return false, paperlessV1.ErrorInternalServerError("check pending reviews failed")
+	}
+	return exists, nil
+}
+
+// Complete closes a pending review with the given status. It returns nil if the review is no
+// longer pending, so concurrent decisions can't overwrite each other.
+func (r *ReviewRepo) Complete(ctx context.Context, id string, status reviewtask.Status, comment string, reviewedVersion, completedBy *uint32) (*ent.ReviewTask, error) {
+	now := time.Now()
+	builder := clientFromContext(ctx, r.entClient).ReviewTask.UpdateOneID(id).
+		Where(reviewtask.StatusEQ(reviewtask.StatusREVIEW_STATUS_PENDING)).
+		SetStatus(status).
+		SetComment(comment).
+		SetNillableReviewedVersion(reviewedVersion).
+		SetNillableCompletedBy(completedBy).
+		SetCompletedAt(now).
+		SetUpdateTime(now)
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("complete review task failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("complete review failed")
+	}
+	return entity, nil
+}
+
+// ListByDocument lists a document's reviews, newest first, optionally only those with a status
+func (r *ReviewRepo) ListByDocument(ctx context.Context, documentID string, status *reviewtask.Status, page, pageSize uint32) ([]*ent.ReviewTask, int, error) {
+	query := clientFromContext(ctx, r.entClient).ReviewTask.Query().
+		Where(reviewtask.DocumentIDEQ(documentID))
+
+	if status != nil {
+		query = query.Where(reviewtask.StatusEQ(*status))
+	}
+
+	return r.list(ctx, query, page, pageSize)
+}
+
+// ListByReviewer lists the reviews requested from a user, newest first, optionally only those
+// with a status. With readableIDs set, only reviews of those documents are listed.
+func (r *ReviewRepo) ListByReviewer(ctx context.Context, tenantID, reviewerID uint32, status *reviewtask.Status, readableIDs []string, page, pageSize uint32) ([]*ent.ReviewTask, int, error) {
+	query := clientFromContext(ctx, r.entClient).ReviewTask.Query().
+		Where(
+			reviewtask.TenantIDEQ(tenantID),
+			reviewtask.ReviewerIDEQ(reviewerID),
+		)
+
+	if status != nil {
+		query = query.Where(reviewtask.StatusEQ(*status))
+	}
+	if readableIDs != nil {
+		query = query.Where(predicate.ReviewTask(idIn(reviewtask.FieldDocumentID, readableIDs)))
+	}
+
+	return r.list(ctx, query, page, pageSize)
+}
+
+func (r *ReviewRepo) list(ctx context.Context, query *ent.ReviewTaskQuery, page, pageSize uint32) ([]*ent.ReviewTask, int, error) {
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count review tasks failed: %s", err.Error())
+		return nil, 0, paperlessV1.ErrorInternalServerError("count reviews failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		query = query.Offset(int((page - 1) * pageSize)).Limit(int(pageSize))
+	}
+
+	entities, err := query.Order(ent.Desc(reviewtask.FieldCreateTime), ent.Asc(reviewtask.FieldID)).All(ctx)
+	if err != nil {
+		r.log.Errorf("list review tasks failed: %s", err.Error())
+		return nil, 0, paperlessV1.ErrorInternalServerError("list reviews failed")
+	}
+	return entities, total, nil
+}
+
+// ToProto converts an ent.ReviewTask to paperlessV1.ReviewTask
+func (r *ReviewRepo) ToProto(entity *ent.ReviewTask) *paperlessV1.ReviewTask {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &paperlessV1.ReviewTask{
+		Id:              entity.ID,
+		TenantId:        derefUint32(entity.TenantID),
+		DocumentId:      entity.DocumentID,
+		ReviewerId:      entity.ReviewerID,
+		Status:          paperlessV1.ReviewStatus(paperlessV1.ReviewStatus_value[string(entity.Status)]),
+		Message:         entity.Message,
+		Comment:         entity.Comment,
+		ReviewedVersion: entity.ReviewedVersion,
+		CompletedBy:     entity.CompletedBy,
+		CreatedBy:       entity.CreateBy,
+	}
+	if entity.CompletedAt != nil {
+		proto.CompletedAt = timestamppb.New(*entity.CompletedAt)
+	}
+	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+	if entity.UpdateTime != nil && !entity.UpdateTime.IsZero() {
+		proto.UpdateTime = timestamppb.New(*entity.UpdateTime)
+	}
+	return proto
+}
@@ -0,0 +1,60 @@
+package data
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/setting"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// SettingRepo stores service-wide runtime settings
+type SettingRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewSettingRepo creates a new SettingRepo
+func NewSettingRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *SettingRepo {
+	return &SettingRepo{
+		log:       ctx.NewLoggerHelper("paperless/setting_repo"),
+		entClient: entClient,
+	}
+}
+
+// Get returns a setting's value, or "" if it is not set
+func (r *SettingRepo) Get(ctx context.Context, name string) (string, error) {
+	entity, err := clientFromContext(ctx, r.entClient).Setting.Query().
+		Where(setting.NameEQ(name)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", nil
+		}
+		r.log.Errorf("get setting failed: %s", err.Error())
+		return "", paperlessV1.ErrorInternalServerError("get setting failed")
+	}
+	return entity.Value, nil
+}
+
+// Set creates or updates a setting
+func (r *SettingRepo) Set(ctx context.Context, name, value string) error {
+	err := clientFromContext(ctx, r.entClient).Setting.Create().
+		SetName(name).
+		SetValue(value).
+		OnConflictColumns(setting.FieldName).
+		UpdateValue().
+		UpdateUpdateTime().
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("set setting failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("set setting failed")
+	}
+	return nil
+}
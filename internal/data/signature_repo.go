@@ -0,0 +1,231 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/signaturerequest"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// SignatureRepo stores e-signature requests
+type SignatureRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	ids       *IDGenerator
+	log       *log.Helper
+}
+
+// NewSignatureRepo creates a new SignatureRepo
+func NewSignatureRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], ids *IDGenerator) *SignatureRepo {
+	return &SignatureRepo{
+		log:       ctx.NewLoggerHelper("paperless/signature_repo"),
+		entClient: entClient,
+		ids:       ids,
+	}
+}
+
+// Create stores a signature request for an envelope sent to the provider
+func (r *SignatureRepo) Create(ctx context.Context, tenantID uint32, documentID, provider, envelopeID string, signers []schema.SignatureSigner, subject, message, originalFileKey string, createdBy *uint32) (*ent.SignatureRequest, error) {
+	builder := r.entClient.Client().SignatureRequest.Create().
+		SetID(r.ids.New()).
+		SetTenantID(tenantID).
+		SetDocumentID(documentID).
+		SetProvider(provider).
+		SetEnvelopeID(envelopeID).
+		SetSigners(signers).
+		SetSubject(subject).
+		SetMessage(message).
+		SetOriginalFileKey(originalFileKey).
+		SetCreateTime(time.Now())
+
+	if createdBy != nil {
+		builder.SetCreateBy(*createdBy)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("create signature request failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("create signature request failed")
+	}
+	return entity, nil
+}
+
+// Get returns a tenant's signature request, or nil if it does not exist
+func (r *SignatureRepo) Get(ctx context.Context, tenantID uint32, id string) (*ent.SignatureRequest, error) {
+	entity, err := r.entClient.Client().SignatureRequest.Query().
+		Where(
+			signaturerequest.IDEQ(id),
+			signaturerequest.TenantIDEQ(tenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get signature request failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("get signature request failed")
+	}
+	return entity, nil
+}
+
+// GetByEnvelope returns the signature request of a provider's envelope, or nil if it does not exist
+func (r *SignatureRepo) GetByEnvelope(ctx context.Context, provider, envelopeID string) (*ent.SignatureRequest, error) {
+	entity, err := r.entClient.Client().SignatureRequest.Query().
+		Where(
+			signaturerequest.ProviderEQ(provider),
+			signaturerequest.EnvelopeIDEQ(envelopeID),
+		).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get signature request by envelope failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("get signature request failed")
+	}
+	return entity, nil
+}
+
+// HasActive reports whether a document has a signature request that is still out for signing
+func (r *SignatureRepo) HasActive(ctx context.Context, documentID string) (bool, error) {
+	exists, err := r.entClient.Client().SignatureRequest.Query().
+		Where(
+			signaturerequest.DocumentIDEQ(documentID),
+			signaturerequest.StatusIn(signaturerequest.StatusSIGNATURE_STATUS_SENT, signaturerequest.StatusSIGNATURE_STATUS_DELIVERED),
+		).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("check active signature requests failed: %s", err.Error())
+		return false, paperlessV1.ErrorInternalServerError("check active signature requests failed")
+	}
+	return exists, nil
+}
+
+// ListByDocument lists a document's signature requests, newest first
+func (r *SignatureRepo) ListByDocument(ctx context.Context, documentID string, page, pageSize uint32) ([]*ent.SignatureRequest, int, error) {
+	query := r.entClient.Client().SignatureRequest.Query().
+		Where(signaturerequest.DocumentIDEQ(documentID))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count signature requests failed: %s", err.Error())
+		return nil, 0, paperlessV1.ErrorInternalServerError("count signature requests failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		query = query.Offset(int((page - 1) * pageSize)).Limit(int(pageSize))
+	}
+
+	entities, err := query.Order(ent.Desc(signaturerequest.FieldCreateTime)).All(ctx)
+	if err != nil {
+		r.log.Errorf("list signature requests failed: %s", err.Error())
+		return nil, 0, paperlessV1.ErrorInternalServerError("list signature requests failed")
+	}
+	return entities, total, nil
+}
+
+// UpdateStatus stores a status change reported by the provider. Signers are kept when nil.
+func (r *SignatureRepo) UpdateStatus(ctx context.Context, id string, status signaturerequest.Status, signers []schema.SignatureSigner, lastError string) (*ent.SignatureRequest, error) {
+	builder := clientFromContext(ctx, r.entClient).SignatureRequest.UpdateOneID(id).
+		SetStatus(status).
+		SetLastError(truncateError(lastError)).
+		SetUpdateTime(time.Now())
+
+	if signers != nil {
+		builder.SetSigners(signers)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, paperlessV1.ErrorNotFound("signature request not found")
+		}
+		r.log.Errorf("update signature request failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("update signature request failed")
+	}
+	return entity, nil
+}
+
+// Complete marks a signature request completed with the signed PDF attached to its document
+func (r *SignatureRepo) Complete(ctx context.Context, id, signedFileKey string, signers []schema.SignatureSigner) (*ent.SignatureRequest, error) {
+	now := time.Now()
+	builder := clientFromContext(ctx, r.entClient).SignatureRequest.UpdateOneID(id).
+		SetStatus(signaturerequest.StatusSIGNATURE_STATUS_COMPLETED).
+		SetSignedFileKey(signedFileKey).
+		SetCompletedAt(now).
+		SetLastError("").
+		SetUpdateTime(now)
+
+	if signers != nil {
+		builder.SetSigners(signers)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, paperlessV1.ErrorNotFound("signature request not found")
+		}
+		r.log.Errorf("complete signature request failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("complete signature request failed")
+	}
+	return entity, nil
+}
+
+// SetLastError records why handling a signature request failed, keeping its status
+func (r *SignatureRepo) SetLastError(ctx context.Context, id, lastError string) error {
+	err := r.entClient.Client().SignatureRequest.UpdateOneID(id).
+		SetLastError(truncateError(lastError)).
+		SetUpdateTime(time.Now()).
+		Exec(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		r.log.Errorf("record signature request error failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("record signature request error failed")
+	}
+	return nil
+}
+
+// ToProto converts an ent.SignatureRequest to paperlessV1.SignatureRequest
+func (r *SignatureRepo) ToProto(entity *ent.SignatureRequest) *paperlessV1.SignatureRequest {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &paperlessV1.SignatureRequest{
+		Id:         entity.ID,
+		TenantId:   derefUint32(entity.TenantID),
+		DocumentId: entity.DocumentID,
+		Provider:   entity.Provider,
+		EnvelopeId: entity.EnvelopeID,
+		Status:     paperlessV1.SignatureStatus(paperlessV1.SignatureStatus_value[string(entity.Status)]),
+		Subject:    entity.Subject,
+		Message:    entity.Message,
+		LastError:  entity.LastError,
+		CreatedBy:  entity.CreateBy,
+	}
+	for _, s := range entity.Signers {
+		proto.Signers = append(proto.Signers, &paperlessV1.Signer{
+			Name:   s.Name,
+			Email:  s.Email,
+			Status: s.Status,
+		})
+	}
+	if entity.CompletedAt != nil {
+		proto.CompletedAt = timestamppb.New(*entity.CompletedAt)
+	}
+	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+	if entity.UpdateTime != nil && !entity.UpdateTime.IsZero() {
+		proto.UpdateTime = timestamppb.New(*entity.UpdateTime)
+	}
+	return proto
+}
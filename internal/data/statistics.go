@@ -2,13 +2,17 @@ package data
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/go-kratos/kratos/v2/log"
 	entCrud "github.com/tx7do/go-crud/entgo"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/category"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/document"
 )
 
@@ -22,22 +26,90 @@ type DocumentStats struct {
 	TotalStorageBytes  int64
 }
 
-// StatisticsRepo provides methods for collecting statistics
+// TagCount is the number of documents carrying a tag key
+type TagCount struct {
+	Tag   string
+	Count int64
+}
+
+// UploadBucket holds the uploads of one time series bucket
+type UploadBucket struct {
+	Start      time.Time
+	Count      int64
+	TotalBytes int64
+}
+
+// DocumentUsage holds the number and total size of a group of documents
+type DocumentUsage struct {
+	Count      int64
+	TotalBytes int64
+}
+
+// CategoryUsage holds the number and total size of the documents directly in a category
+type CategoryUsage struct {
+	// CategoryID is empty for documents without a category
+	CategoryID string
+	// Path is the category's path, empty when the category no longer exists
+	Path       string
+	Count      int64
+	TotalBytes int64
+}
+
+// StatisticsRepo provides methods for collecting statistics. Its aggregate queries are served
+// by the read replica when one is configured; precomputed rollups are written to the primary.
 type StatisticsRepo struct {
 	entClient *entCrud.EntClient[*ent.Client]
+	replica   *ReadReplica
 	log       *log.Helper
 }
 
 // NewStatisticsRepo creates a new StatisticsRepo
-func NewStatisticsRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *StatisticsRepo {
+func NewStatisticsRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], replica *ReadReplica) *StatisticsRepo {
 	return &StatisticsRepo{
 		entClient: entClient,
+		replica:   replica,
 		log:       ctx.NewLoggerHelper("paperless/statistics/repo"),
 	}
 }
 
-// GetDocumentStats returns aggregated document statistics
-func (r *StatisticsRepo) GetDocumentStats(ctx context.Context) (*DocumentStats, error) {
+// documentQuery returns a document query limited to tenantID, or across all tenants when nil
+func (r *StatisticsRepo) documentQuery(ctx context.Context, tenantID *uint32) *ent.DocumentQuery {
+	query := r.replica.Client(ctx).Document.Query()
+	if tenantID != nil {
+		query = query.Where(document.TenantID(*tenantID))
+	}
+	return query
+}
+
+// countBy returns the number of documents per distinct value of field, computed with a single GROUP BY
+func (r *StatisticsRepo) countBy(ctx context.Context, tenantID *uint32, field string) (map[string]int64, error) {
+	var rows []struct {
+		Value sql.NullString `json:"value"`
+		Count int64          `json:"count"`
+	}
+	err := r.documentQuery(ctx, tenantID).
+		Modify(func(s *sql.Selector) {
+			s.Select(
+				sql.As(s.C(field), "value"),
+				sql.As(sql.Count("*"), "count"),
+			).GroupBy(s.C(field))
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		// NULL and empty values are counted together under ""
+		counts[row.Value.String] += row.Count
+	}
+	return counts, nil
+}
+
+// GetDocumentStats returns aggregated document statistics of tenantID, or of all tenants when nil.
+// Every breakdown is an aggregate query, so no document rows are loaded.
+func (r *StatisticsRepo) GetDocumentStats(ctx context.Context, tenantID *uint32) (*DocumentStats, error) {
 	stats := &DocumentStats{
 		ByStatus:           make(map[string]int64),
 		BySource:           make(map[string]int64),
@@ -45,88 +117,79 @@ func (r *StatisticsRepo) GetDocumentStats(ctx context.Context) (*DocumentStats,
 		ByMimeType:         make(map[string]int64),
 	}
 
-	client := r.entClient.Client()
-
-	// Total count
-	total, err := client.Document.Query().Count(ctx)
+	// Total count and storage
+	var totals []struct {
+		Count int64         `json:"count"`
+		Sum   sql.NullInt64 `json:"sum"`
+	}
+	err := r.documentQuery(ctx, tenantID).
+		Aggregate(ent.Count(), ent.Sum(document.FieldFileSize)).
+		Scan(ctx, &totals)
 	if err != nil {
 		return nil, err
 	}
-	stats.TotalCount = int64(total)
+	if len(totals) > 0 {
+		stats.TotalCount = totals[0].Count
+		stats.TotalStorageBytes = totals[0].Sum.Int64
+	}
 
-	// Count by status
-	statuses := []document.Status{
+	// Report every known value, including those without documents
+	for _, s := range []document.Status{
 		document.StatusDOCUMENT_STATUS_UNSPECIFIED,
 		document.StatusDOCUMENT_STATUS_ACTIVE,
 		document.StatusDOCUMENT_STATUS_ARCHIVED,
-		document.StatusDOCUMENT_STATUS_DELETED,
+	} {
+		stats.ByStatus[string(s)] = 0
 	}
-	for _, s := range statuses {
-		count, err := client.Document.Query().Where(document.StatusEQ(s)).Count(ctx)
-		if err != nil {
-			r.log.Warnf("Failed to count documents by status %s: %v", s, err)
-			continue
-		}
-		stats.ByStatus[string(s)] = int64(count)
-	}
-
-	// Count by source
-	sources := []document.Source{
+	for _, s := range []document.Source{
 		document.SourceDOCUMENT_SOURCE_UNSPECIFIED,
 		document.SourceDOCUMENT_SOURCE_UPLOAD,
 		document.SourceDOCUMENT_SOURCE_EMAIL,
+		document.SourceDOCUMENT_SOURCE_IMPORT,
+	} {
+		stats.BySource[string(s)] = 0
 	}
-	for _, s := range sources {
-		count, err := client.Document.Query().Where(document.SourceEQ(s)).Count(ctx)
-		if err != nil {
-			r.log.Warnf("Failed to count documents by source %s: %v", s, err)
-			continue
-		}
-		stats.BySource[string(s)] = int64(count)
-	}
-
-	// Count by processing status
-	processingStatuses := []document.ProcessingStatus{
+	for _, s := range []document.ProcessingStatus{
 		document.ProcessingStatusPROCESSING_STATUS_PENDING,
 		document.ProcessingStatusPROCESSING_STATUS_PROCESSING,
 		document.ProcessingStatusPROCESSING_STATUS_COMPLETED,
 		document.ProcessingStatusPROCESSING_STATUS_FAILED,
 		document.ProcessingStatusPROCESSING_STATUS_SKIPPED,
+		document.ProcessingStatusPROCESSING_STATUS_INFECTED,
+	} {
+		stats.ByProcessingStatus[string(s)] = 0
+	}
+
+	breakdowns := []struct {
+		field  string
+		counts map[string]int64
+	}{
+		{document.FieldStatus, stats.ByStatus},
+		{document.FieldSource, stats.BySource},
+		{document.FieldProcessingStatus, stats.ByProcessingStatus},
+		{document.FieldMimeType, stats.ByMimeType},
 	}
-	for _, s := range processingStatuses {
-		count, err := client.Document.Query().Where(document.ProcessingStatusEQ(s)).Count(ctx)
+	for _, b := range breakdowns {
+		counts, err := r.countBy(ctx, tenantID, b.field)
 		if err != nil {
-			r.log.Warnf("Failed to count documents by processing status %s: %v", s, err)
+			r.log.Warnf("Failed to count documents by %s: %v", b.field, err)
 			continue
 		}
-		stats.ByProcessingStatus[string(s)] = int64(count)
-	}
-
-	// Sum file sizes for total storage and count by MIME type
-	docs, err := client.Document.Query().All(ctx)
-	if err != nil {
-		r.log.Warnf("Failed to get documents for storage calculation: %v", err)
-	} else {
-		var totalBytes int64
-		mimeTypeCounts := make(map[string]int64)
-		for _, d := range docs {
-			totalBytes += d.FileSize
-			if d.MimeType != "" {
-				mimeTypeCounts[d.MimeType]++
-			}
+		for value, count := range counts {
+			b.counts[value] = count
 		}
-		stats.TotalStorageBytes = totalBytes
-		stats.ByMimeType = mimeTypeCounts
 	}
 
+	// Documents without a MIME type are not reported
+	delete(stats.ByMimeType, "")
+
 	return stats, nil
 }
 
-// GetDocumentTimeStats returns the count of documents created since the given time
-func (r *StatisticsRepo) GetDocumentTimeStats(ctx context.Context, since time.Time) (int64, error) {
-	client := r.entClient.Client()
-
-	count, err := client.Document.Query().
+// GetDocumentTimeStats returns the count of documents of tenantID, or of all tenants when nil,
+// created since the given time
+func (r *StatisticsRepo) GetDocumentTimeStats(ctx context.Context, tenantID *uint32, since time.Time) (int64, error) {
+	count, err := r.documentQuery(ctx, tenantID).
 		Where(document.CreateTimeGTE(since)).
 		Count(ctx)
 	if err != nil {
@@ -136,11 +199,217 @@ func (r *StatisticsRepo) GetDocumentTimeStats(ctx context.Context, since time.Ti
 	return int64(count), nil
 }
 
-// GetCategoryStats returns the total count of categories
-func (r *StatisticsRepo) GetCategoryStats(ctx context.Context) (int64, error) {
-	client := r.entClient.Client()
+// GetUsageByCategory returns the number and size of documents of tenantID, or of all tenants
+// when nil, per category, ordered by category path
+func (r *StatisticsRepo) GetUsageByCategory(ctx context.Context, tenantID *uint32) ([]CategoryUsage, error) {
+	var rows []struct {
+		CategoryID sql.NullString `json:"category_id"`
+		Count      int64          `json:"count"`
+		Sum        int64          `json:"sum"`
+	}
+	err := r.documentQuery(ctx, tenantID).
+		GroupBy(document.FieldCategoryID).
+		Aggregate(ent.Count(), ent.Sum(document.FieldFileSize)).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(rows))
+	for _, row := range rows {
+		if row.CategoryID.Valid {
+			ids = append(ids, row.CategoryID.String)
+		}
+	}
+	paths := make(map[string]string, len(ids))
+	if len(ids) > 0 {
+		categories, err := r.replica.Client(ctx).Category.Query().
+			Where(category.IDIn(ids...)).
+			Select(category.FieldID, category.FieldPath).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range categories {
+			paths[c.ID] = c.Path
+		}
+	}
+
+	usage := make([]CategoryUsage, 0, len(rows))
+	for _, row := range rows {
+		usage = append(usage, CategoryUsage{
+			CategoryID: row.CategoryID.String,
+			Path:       paths[row.CategoryID.String],
+			Count:      row.Count,
+			TotalBytes: row.Sum,
+		})
+	}
+	sort.Slice(usage, func(i, j int) bool {
+		if usage[i].Path != usage[j].Path {
+			return usage[i].Path < usage[j].Path
+		}
+		return usage[i].CategoryID < usage[j].CategoryID
+	})
+	return usage, nil
+}
+
+// GetUsageByMimeType returns the number and size of documents of tenantID, or of all tenants
+// when nil, per MIME type; documents without a MIME type are reported under ""
+func (r *StatisticsRepo) GetUsageByMimeType(ctx context.Context, tenantID *uint32) (map[string]DocumentUsage, error) {
+	var rows []struct {
+		MimeType sql.NullString `json:"mime_type"`
+		Count    int64          `json:"count"`
+		Sum      int64          `json:"sum"`
+	}
+	err := r.documentQuery(ctx, tenantID).
+		GroupBy(document.FieldMimeType).
+		Aggregate(ent.Count(), ent.Sum(document.FieldFileSize)).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := make(map[string]DocumentUsage, len(rows))
+	for _, row := range rows {
+		u := usage[row.MimeType.String]
+		u.Count += row.Count
+		u.TotalBytes += row.Sum
+		usage[row.MimeType.String] = u
+	}
+	return usage, nil
+}
+
+// GetTopTags returns up to limit tag keys of the documents of tenantID, or of all tenants
+// when nil, ordered by the number of documents carrying them
+func (r *StatisticsRepo) GetTopTags(ctx context.Context, tenantID *uint32, limit int) ([]TagCount, error) {
+	var rows []struct {
+		Tag   string `json:"tag"`
+		Count int64  `json:"count"`
+	}
+	err := r.documentQuery(ctx, tenantID).
+		Modify(func(s *sql.Selector) {
+			// Expand each document into one row per tag key; documents without tags are stored
+			// as NULL or a JSON null, which jsonb_object_keys rejects
+			s.Where(sql.ExprP(fmt.Sprintf("jsonb_typeof(%s) = 'object'", s.C(document.FieldTags))))
+			s.AppendFromExpr(sql.Expr(fmt.Sprintf("jsonb_object_keys(%s) AS tag", s.C(document.FieldTags))))
+			s.Select(
+				sql.As("tag", "tag"),
+				sql.As(sql.Count("*"), "count"),
+			).
+				GroupBy("tag").
+				OrderBy(sql.Desc("count"), "tag").
+				Limit(limit)
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]TagCount, 0, len(rows))
+	for _, row := range rows {
+		tags = append(tags, TagCount{Tag: row.Tag, Count: row.Count})
+	}
+	return tags, nil
+}
+
+// GetUploadTimeSeries returns the documents of tenantID, or of all tenants when nil, created
+// in [start, end) grouped by unit ("day", "week" or "month") in UTC. Empty buckets are omitted.
+func (r *StatisticsRepo) GetUploadTimeSeries(ctx context.Context, tenantID *uint32, unit string, start, end time.Time) ([]UploadBucket, error) {
+	var rows []struct {
+		Bucket time.Time `json:"bucket"`
+		Count  int64     `json:"count"`
+		Bytes  int64     `json:"bytes"`
+	}
+	err := r.documentQuery(ctx, tenantID).
+		Where(
+			document.CreateTimeGTE(start),
+			document.CreateTimeLT(end),
+		).
+		Modify(func(s *sql.Selector) {
+			bucket := fmt.Sprintf("date_trunc('%s', %s AT TIME ZONE 'UTC')", unit, s.C(document.FieldCreateTime))
+			s.Select(
+				sql.As(bucket, "bucket"),
+				sql.As(sql.Count("*"), "count"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)::bigint", s.C(document.FieldFileSize)), "bytes"),
+			).
+				GroupBy(bucket).
+				OrderBy(bucket)
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	buckets := make([]UploadBucket, 0, len(rows))
+	for _, row := range rows {
+		buckets = append(buckets, UploadBucket{
+			Start:      time.Date(row.Bucket.Year(), row.Bucket.Month(), row.Bucket.Day(), 0, 0, 0, 0, time.UTC),
+			Count:      row.Count,
+			TotalBytes: row.Bytes,
+		})
+	}
+	return buckets, nil
+}
+
+// GetDocumentUsageByTenant returns the number and size of documents per tenant, counting
+// only documents created since the given time unless it is zero
+func (r *StatisticsRepo) GetDocumentUsageByTenant(ctx context.Context, since time.Time) (map[uint32]DocumentUsage, error) {
+	query := r.replica.Client(ctx).Document.Query().
+		Where(document.TenantIDNotNil())
+	if !since.IsZero() {
+		query = query.Where(document.CreateTimeGTE(since))
+	}
+
+	var rows []struct {
+		TenantID uint32 `json:"tenant_id"`
+		Count    int64  `json:"count"`
+		Sum      int64  `json:"sum"`
+	}
+	err := query.
+		GroupBy(document.FieldTenantID).
+		Aggregate(ent.Count(), ent.Sum(document.FieldFileSize)).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := make(map[uint32]DocumentUsage, len(rows))
+	for _, row := range rows {
+		usage[row.TenantID] = DocumentUsage{Count: row.Count, TotalBytes: row.Sum}
+	}
+	return usage, nil
+}
+
+// GetCategoryCountByTenant returns the number of categories per tenant
+func (r *StatisticsRepo) GetCategoryCountByTenant(ctx context.Context) (map[uint32]int64, error) {
+	var rows []struct {
+		TenantID uint32 `json:"tenant_id"`
+		Count    int64  `json:"count"`
+	}
+	err := r.replica.Client(ctx).Category.Query().
+		Where(category.TenantIDNotNil()).
+		GroupBy(category.FieldTenantID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[uint32]int64, len(rows))
+	for _, row := range rows {
+		counts[row.TenantID] = row.Count
+	}
+	return counts, nil
+}
+
+// GetCategoryStats returns the count of categories of tenantID, or of all tenants when nil
+func (r *StatisticsRepo) GetCategoryStats(ctx context.Context, tenantID *uint32) (int64, error) {
+	query := r.replica.Client(ctx).Category.Query()
+	if tenantID != nil {
+		query = query.Where(category.TenantID(*tenantID))
+	}
 
-	count, err := client.Category.Query().Count(ctx)
+	count, err := query.Count(ctx)
 	if err != nil {
 		return 0, err
 	}
@@ -0,0 +1,89 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/category"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/document"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/statisticsrollup"
+)
+
+// ListTenantIDs returns the tenants having documents or categories, i.e. those with statistics
+// worth precomputing
+func (r *StatisticsRepo) ListTenantIDs(ctx context.Context) ([]uint32, error) {
+	client := r.replica.Client(ctx)
+
+	var documentTenants, categoryTenants []struct {
+		TenantID uint32 `json:"tenant_id"`
+	}
+	err := client.Document.Query().
+		Where(document.TenantIDNotNil()).
+		GroupBy(document.FieldTenantID).
+		Scan(ctx, &documentTenants)
+	if err != nil {
+		return nil, err
+	}
+	err = client.Category.Query().
+		Where(category.TenantIDNotNil()).
+		GroupBy(category.FieldTenantID).
+		Scan(ctx, &categoryTenants)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[uint32]struct{}, len(documentTenants)+len(categoryTenants))
+	tenantIDs := make([]uint32, 0, len(documentTenants)+len(categoryTenants))
+	for _, row := range append(documentTenants, categoryTenants...) {
+		if _, ok := seen[row.TenantID]; ok {
+			continue
+		}
+		seen[row.TenantID] = struct{}{}
+		tenantIDs = append(tenantIDs, row.TenantID)
+	}
+	return tenantIDs, nil
+}
+
+// GetRollup returns the precomputed statistics of tenantID, or of all tenants when nil.
+// It returns nil if none were stored yet.
+func (r *StatisticsRepo) GetRollup(ctx context.Context, tenantID *uint32) (*ent.StatisticsRollup, error) {
+	query := r.replica.Client(ctx).StatisticsRollup.Query()
+	if tenantID != nil {
+		query = query.Where(statisticsrollup.TenantID(*tenantID), statisticsrollup.AllTenants(false))
+	} else {
+		query = query.Where(statisticsrollup.AllTenants(true))
+	}
+
+	rollup, err := query.Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return rollup, nil
+}
+
+// SaveRollup stores the statistics of tenantID, or of all tenants when nil, replacing the
+// ones stored before
+func (r *StatisticsRepo) SaveRollup(ctx context.Context, tenantID *uint32, statistics []byte, generatedAt time.Time) error {
+	builder := r.entClient.Client().StatisticsRollup.Create().
+		SetStatistics(statistics).
+		SetGeneratedAt(generatedAt).
+		SetCreateTime(time.Now()).
+		SetUpdateTime(time.Now())
+	if tenantID != nil {
+		builder.SetTenantID(*tenantID)
+	} else {
+		// The rollup of all tenants belongs to none of them
+		builder.SetTenantID(0).SetAllTenants(true)
+	}
+
+	return builder.
+		OnConflictColumns(statisticsrollup.FieldTenantID, statisticsrollup.FieldAllTenants).
+		UpdateStatistics().
+		UpdateGeneratedAt().
+		UpdateUpdateTime().
+		Exec(ctx)
+}
@@ -1,118 +1,177 @@
 package data
 
 import (
-	"bytes"
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
-	"github.com/minio/minio-go/v7"
-	"github.com/minio/minio-go/v7/pkg/credentials"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 )
 
-// StorageConfig holds S3/RustFS configuration
-type StorageConfig struct {
-	Endpoint        string
-	AccessKeyID     string
-	SecretAccessKey string
-	Bucket          string
-	UseSSL          bool
-	Region          string
+const (
+	// StorageDriverS3 stores documents in an S3-compatible object store
+	StorageDriverS3 = "s3"
+	// StorageDriverLocal stores documents on the local filesystem
+	StorageDriverLocal = "local"
+	// StorageDriverAzure stores documents in Azure Blob Storage
+	StorageDriverAzure = "azure"
+)
+
+var (
+	// ErrObjectNotFound is returned when a storage key does not exist
+	ErrObjectNotFound = errors.New("object not found")
+	// ErrPresignNotSupported is returned when the storage cannot issue presigned URLs
+	ErrPresignNotSupported = errors.New("presigned URLs are not supported by this storage")
+	// ErrObjectRestoring is returned while an archived object is being restored and cannot be read yet
+	ErrObjectRestoring = errors.New("object is being restored from archive storage")
+)
+
+// Storage is the interface implemented by document storage backends
+type Storage interface {
+	// Upload stores a document file and returns its key, size and checksum
+	Upload(ctx context.Context, tenantID uint32, categoryID, documentID, fileName string, content []byte, mimeType string) (*UploadResult, error)
+	// Put stores content under an explicit key, e.g. when copying objects between backends
+	Put(ctx context.Context, key string, content []byte, contentType string, metadata map[string]string) error
+	// Download returns the content stored under key
+	Download(ctx context.Context, key string) ([]byte, error)
+	// Open streams length bytes of the object stored under key from offset. The length must be
+	// positive, or -1 to read to the end.
+	Open(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error)
+	// Delete removes the object stored under key
+	Delete(ctx context.Context, key string) error
+	// GetPresignedURL returns a time-limited download URL for key
+	GetPresignedURL(ctx context.Context, key string, expiresIn time.Duration, opts PresignOptions) (string, error)
+	// Stat returns information about the object stored under key (ErrObjectNotFound if missing)
+	Stat(ctx context.Context, key string) (*ObjectInfo, error)
+	// List lists objects whose keys start with prefix
+	List(ctx context.Context, prefix string) ([]ObjectInfo, error)
+	// Exists checks whether an object is stored under key
+	Exists(ctx context.Context, key string) (bool, error)
+}
+
+// UploadResult contains the result of an upload operation
+type UploadResult struct {
+	Key      string
+	Size     int64
+	Checksum string
 }
 
-// StorageClient wraps MinIO client for S3-compatible storage
-type StorageClient struct {
-	client *minio.Client
-	bucket string
-	log    *log.Helper
+// PresignOptions overrides response headers of a presigned download
+type PresignOptions struct {
+	// ContentDisposition is sent as Content-Disposition, e.g. `attachment; filename="a.pdf"`
+	ContentDisposition string
+	// ContentType is sent as Content-Type
+	ContentType string
 }
 
-// NewStorageClient creates a new S3-compatible storage client
-func NewStorageClient(ctx *bootstrap.Context) (*StorageClient, func(), error) {
-	l := ctx.NewLoggerHelper("storage/data/paperless-service")
+// ObjectInfo describes a stored object
+type ObjectInfo struct {
+	Key          string
+	Size         int64
+	ContentType  string
+	LastModified time.Time
+	Checksum     string
+	Metadata     map[string]string
+}
+
+// StorageEnv is the environment variable prefix a storage backend is configured from
+type StorageEnv string
 
-	cfg := &StorageConfig{
-		Endpoint:        getEnvOrDefault("PAPERLESS_S3_ENDPOINT", "localhost:9000"),
-		AccessKeyID:     getEnvOrDefault("PAPERLESS_S3_ACCESS_KEY", "minioadmin"),
-		SecretAccessKey: getEnvOrDefault("PAPERLESS_S3_SECRET_KEY", "minioadmin"),
-		Bucket:          getEnvOrDefault("PAPERLESS_S3_BUCKET", "paperless"),
-		UseSSL:          getEnvOrDefault("PAPERLESS_S3_USE_SSL", "false") == "true",
-		Region:          getEnvOrDefault("PAPERLESS_S3_REGION", "us-east-1"),
+const (
+	// PrimaryStorageEnv configures the primary backend (PAPERLESS_STORAGE_DRIVER, PAPERLESS_S3_*, ...)
+	PrimaryStorageEnv StorageEnv = "PAPERLESS_"
+	// MigrationStorageEnv configures a migration target (PAPERLESS_MIGRATION_STORAGE_DRIVER, PAPERLESS_MIGRATION_S3_*, ...)
+	MigrationStorageEnv StorageEnv = "PAPERLESS_MIGRATION_"
+	// ColdStorageEnv configures the cold tier (PAPERLESS_COLD_STORAGE_DRIVER, PAPERLESS_COLD_S3_*, ...)
+	ColdStorageEnv StorageEnv = "PAPERLESS_COLD_"
+)
+
+// name returns the full environment variable name for key
+func (e StorageEnv) name(key string) string {
+	return string(e) + key
+}
+
+// label returns a short backend name for metrics: primary, migration or cold
+func (e StorageEnv) label() string {
+	if label := strings.ToLower(strings.Trim(strings.TrimPrefix(string(e), "PAPERLESS"), "_")); label != "" {
+		return label
 	}
+	return "primary"
+}
 
-	client, err := minio.New(cfg.Endpoint, &minio.Options{
-		Creds:  credentials.NewStaticV4(cfg.AccessKeyID, cfg.SecretAccessKey, ""),
-		Secure: cfg.UseSSL,
-		Region: cfg.Region,
-	})
-	if err != nil {
-		l.Errorf("failed to create MinIO client: %v", err)
-		return nil, func() {}, err
+// get reads a backend setting from the environment
+func (e StorageEnv) get(key, defaultValue string) string {
+	return getEnvOrDefault(e.name(key), defaultValue)
+}
+
+// NewStorage creates the document storage on top of the router's backends.
+// With PAPERLESS_CLIENT_ENCRYPTION=true content is encrypted with per-tenant data keys
+// wrapped by PAPERLESS_ENCRYPTION_MASTER_KEY before it reaches the backend.
+func NewStorage(ctx *bootstrap.Context, router *StorageRouter, keys *TenantKeyRepo) (Storage, error) {
+	if getEnvOrDefault("PAPERLESS_CLIENT_ENCRYPTION", "false") != "true" {
+		return router, nil
 	}
 
-	// Ensure bucket exists
-	bgCtx := context.Background()
-	exists, err := client.BucketExists(bgCtx, cfg.Bucket)
+	l := ctx.NewLoggerHelper("storage/data/paperless-service")
+
+	wrapper, err := NewMasterKeyWrapper(
+		getEnvOrDefault("PAPERLESS_ENCRYPTION_MASTER_KEY_ID", "local"),
+		getEnvOrDefault("PAPERLESS_ENCRYPTION_MASTER_KEY", ""),
+	)
 	if err != nil {
-		l.Warnf("failed to check bucket existence: %v", err)
-	} else if !exists {
-		err = client.MakeBucket(bgCtx, cfg.Bucket, minio.MakeBucketOptions{Region: cfg.Region})
-		if err != nil {
-			l.Warnf("failed to create bucket: %v", err)
-		} else {
-			l.Infof("created bucket: %s", cfg.Bucket)
-		}
+		return nil, fmt.Errorf("client-side encryption: %w", err)
 	}
+	l.Infof("client-side encryption enabled with master key %s", wrapper.KeyID())
 
-	sc := &StorageClient{
-		client: client,
-		bucket: cfg.Bucket,
-		log:    l,
+	return NewEncryptedStorage(router, keys, wrapper, l), nil
+}
+
+// newStorageBackend creates the backend selected by STORAGE_DRIVER (s3, azure or local),
+// with retries and a circuit breaker, fronted by a CDN for downloads when CDN_URL is set
+func newStorageBackend(l *log.Helper, env StorageEnv) (Storage, error) {
+	driver := env.get("STORAGE_DRIVER", StorageDriverS3)
+	l.Infof("using %s storage driver (%s*)", driver, env)
+
+	var (
+		backend Storage
+		err     error
+	)
+	switch driver {
+	case StorageDriverS3:
+		backend, err = NewS3Storage(l, env)
+	case StorageDriverAzure:
+		backend, err = NewAzureStorage(l, env)
+	case StorageDriverLocal:
+		backend, err = NewLocalStorage(l, env)
+	default:
+		return nil, fmt.Errorf("unknown storage driver %q", driver)
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	return sc, func() {
-		// MinIO client doesn't need explicit cleanup
-	}, nil
-}
+	if backend, err = newResilientStorage(l, env, backend); err != nil {
+		return nil, err
+	}
 
-// UploadResult contains the result of an upload operation
-type UploadResult struct {
-	Key      string
-	Size     int64
-	Checksum string
+	return newCDNStorage(l, env, backend)
 }
 
-// Upload uploads a file to storage
-func (s *StorageClient) Upload(ctx context.Context, tenantID uint32, categoryID, documentID, fileName string, content []byte, mimeType string) (*UploadResult, error) {
-	// Generate storage key: {tenant_id}/{category_id}/{document_id}/{filename}
-	var key string
-	if categoryID != "" {
-		key = fmt.Sprintf("%d/%s/%s/%s", tenantID, categoryID, documentID, fileName)
-	} else {
-		key = fmt.Sprintf("%d/root/%s/%s", tenantID, documentID, fileName)
-	}
+// uploadDocument stores a document file under its generated key via s.Put
+func uploadDocument(ctx context.Context, s Storage, tenantID uint32, categoryID, documentID, fileName string, content []byte, mimeType string) (*UploadResult, error) {
+	key := buildObjectKey(tenantID, categoryID, documentID, fileName)
+	checksum := computeChecksum(content)
 
-	// Calculate checksum
-	hash := sha256.Sum256(content)
-	checksum := hex.EncodeToString(hash[:])
-
-	// Upload to storage
-	reader := bytes.NewReader(content)
-	_, err := s.client.PutObject(ctx, s.bucket, key, reader, int64(len(content)), minio.PutObjectOptions{
-		ContentType: mimeType,
-		UserMetadata: map[string]string{
-			"checksum":    checksum,
-			"document_id": documentID,
-		},
-	})
+	err := s.Put(ctx, key, content, mimeType, documentMetadata(tenantID, categoryID, documentID, checksum))
 	if err != nil {
-		s.log.Errorf("failed to upload file: %v", err)
-		return nil, fmt.Errorf("failed to upload file: %w", err)
+		return nil, err
 	}
 
 	return &UploadResult{
@@ -122,53 +181,50 @@ func (s *StorageClient) Upload(ctx context.Context, tenantID uint32, categoryID,
 	}, nil
 }
 
-// Download downloads a file from storage
-func (s *StorageClient) Download(ctx context.Context, key string) ([]byte, error) {
-	obj, err := s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
-	if err != nil {
-		s.log.Errorf("failed to get object: %v", err)
-		return nil, fmt.Errorf("failed to get object: %w", err)
-	}
-	defer obj.Close()
+// objectTagKeys are the metadata entries backends also write as object tags,
+// so bucket lifecycle rules and cost allocation can select on them
+var objectTagKeys = []string{"tenant_id", "document_id", "category_id"}
 
-	content, err := io.ReadAll(obj)
-	if err != nil {
-		s.log.Errorf("failed to read object: %v", err)
-		return nil, fmt.Errorf("failed to read object: %w", err)
+// documentMetadata returns the metadata stored with a document's object. It identifies
+// the owner of every object for operators without a database lookup.
+func documentMetadata(tenantID uint32, categoryID, documentID, checksum string) map[string]string {
+	if categoryID == "" {
+		categoryID = "root"
+	}
+	return map[string]string{
+		"checksum":    checksum,
+		"tenant_id":   strconv.FormatUint(uint64(tenantID), 10),
+		"document_id": documentID,
+		"category_id": categoryID,
 	}
-
-	return content, nil
 }
 
-// Delete deletes a file from storage
-func (s *StorageClient) Delete(ctx context.Context, key string) error {
-	err := s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{})
-	if err != nil {
-		s.log.Errorf("failed to delete object: %v", err)
-		return fmt.Errorf("failed to delete object: %w", err)
+// objectTags returns the entries of metadata that are written as object tags
+func objectTags(metadata map[string]string) map[string]string {
+	tags := make(map[string]string, len(objectTagKeys))
+	for _, k := range objectTagKeys {
+		if v := metadata[k]; v != "" {
+			tags[k] = v
+		}
 	}
-	return nil
+	return tags
 }
 
-// GetPresignedURL generates a presigned URL for downloading
-func (s *StorageClient) GetPresignedURL(ctx context.Context, key string, expiresIn time.Duration) (string, error) {
-	url, err := s.client.PresignedGetObject(ctx, s.bucket, key, expiresIn, nil)
-	if err != nil {
-		s.log.Errorf("failed to generate presigned URL: %v", err)
-		return "", fmt.Errorf("failed to generate presigned URL: %w", err)
-	}
-	return url.String(), nil
+// ObjectKey returns the key Upload stores a document file under
+func ObjectKey(tenantID uint32, categoryID, documentID, fileName string) string {
+	return buildObjectKey(tenantID, categoryID, documentID, fileName)
 }
 
-// Exists checks if a file exists in storage
-func (s *StorageClient) Exists(ctx context.Context, key string) (bool, error) {
-	_, err := s.client.StatObject(ctx, s.bucket, key, minio.StatObjectOptions{})
-	if err != nil {
-		errResponse := minio.ToErrorResponse(err)
-		if errResponse.Code == "NoSuchKey" {
-			return false, nil
-		}
-		return false, err
+// buildObjectKey generates the storage key: {tenant_id}/{category_id}/{document_id}/{filename}
+func buildObjectKey(tenantID uint32, categoryID, documentID, fileName string) string {
+	if categoryID != "" {
+		return fmt.Sprintf("%d/%s/%s/%s", tenantID, categoryID, documentID, fileName)
 	}
-	return true, nil
+	return fmt.Sprintf("%d/root/%s/%s", tenantID, documentID, fileName)
+}
+
+// computeChecksum returns the hex-encoded SHA-256 checksum of content
+func computeChecksum(content []byte) string {
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:])
 }
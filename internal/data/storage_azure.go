@@ -0,0 +1,568 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// azureAPIVersion is the Blob service REST API version used for requests and SAS tokens
+const azureAPIVersion = "2021-08-06"
+
+// azureMetaPrefix is the header prefix of user-defined blob metadata
+const azureMetaPrefix = "x-ms-meta-"
+
+// AzureConfig holds Azure Blob Storage configuration
+type AzureConfig struct {
+	AccountName string
+	AccountKey  string
+	Container   string
+	Endpoint    string
+}
+
+// AzureStorage implements Storage on Azure Blob Storage using the Blob REST API with Shared Key auth
+type AzureStorage struct {
+	client      *http.Client
+	endpoint    *url.URL
+	accountName string
+	accountKey  []byte
+	containers  *tenantBuckets
+	log         *log.Helper
+}
+
+// azureListResult is the XML body returned by List Blobs
+type azureListResult struct {
+	Blobs struct {
+		Blob []struct {
+			Name       string `xml:"Name"`
+			Properties struct {
+				LastModified  string `xml:"Last-Modified"`
+				ContentLength int64  `xml:"Content-Length"`
+				ContentType   string `xml:"Content-Type"`
+			} `xml:"Properties"`
+			Metadata struct {
+				Items []struct {
+					XMLName xml.Name
+					Value   string `xml:",chardata"`
+				} `xml:",any"`
+			} `xml:"Metadata"`
+		} `xml:"Blob"`
+	} `xml:"Blobs"`
+	NextMarker string `xml:"NextMarker"`
+}
+
+// NewAzureStorage creates a new Azure Blob Storage backend.
+// AZURE_ENDPOINT defaults to https://{account}.blob.core.windows.net and
+// may point to a path-style endpoint such as Azurite (http://127.0.0.1:10000/devstoreaccount1).
+func NewAzureStorage(l *log.Helper, env StorageEnv) (*AzureStorage, error) {
+	cfg := &AzureConfig{
+		AccountName: env.get("AZURE_ACCOUNT_NAME", ""),
+		AccountKey:  env.get("AZURE_ACCOUNT_KEY", ""),
+		Container:   env.get("AZURE_CONTAINER", "paperless"),
+	}
+	cfg.Endpoint = env.get("AZURE_ENDPOINT", fmt.Sprintf("https://%s.blob.core.windows.net", cfg.AccountName))
+
+	if cfg.AccountName == "" || cfg.AccountKey == "" {
+		return nil, fmt.Errorf("%s and %s are required for the azure storage driver", env.name("AZURE_ACCOUNT_NAME"), env.name("AZURE_ACCOUNT_KEY"))
+	}
+
+	key, err := base64.StdEncoding.DecodeString(cfg.AccountKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Azure account key: %w", err)
+	}
+
+	endpoint, err := url.Parse(strings.TrimRight(cfg.Endpoint, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid Azure endpoint: %w", err)
+	}
+
+	s := &AzureStorage{
+		client:      &http.Client{Timeout: 5 * time.Minute},
+		endpoint:    endpoint,
+		accountName: cfg.AccountName,
+		accountKey:  key,
+		log:         l,
+	}
+
+	s.containers, err = newTenantBuckets(env, cfg.Container, s.ensureContainer)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure the shared container exists; per-tenant containers are created on first upload
+	if s.containers.isolation == TenantIsolationPrefix {
+		if err = s.ensureContainer(context.Background(), cfg.Container); err != nil {
+			l.Warnf("failed to ensure container: %v", err)
+		}
+	}
+
+	return s, nil
+}
+
+// Upload uploads a file to storage
+func (s *AzureStorage) Upload(ctx context.Context, tenantID uint32, categoryID, documentID, fileName string, content []byte, mimeType string) (*UploadResult, error) {
+	return uploadDocument(ctx, s, tenantID, categoryID, documentID, fileName, content, mimeType)
+}
+
+// Put stores content under key
+func (s *AzureStorage) Put(ctx context.Context, key string, content []byte, contentType string, metadata map[string]string) error {
+	header := http.Header{}
+	header.Set("x-ms-blob-type", "BlockBlob")
+	header.Set("Content-Type", contentType)
+	for name, value := range metadata {
+		header.Set(azureMetaPrefix+name, value)
+	}
+	// Blob index tags can be queried and used in lifecycle rules, unlike metadata
+	tags := url.Values{}
+	for name, value := range objectTags(metadata) {
+		tags.Set(name, value)
+	}
+	if len(tags) > 0 {
+		header.Set("x-ms-tags", tags.Encode())
+	}
+
+	container, err := s.containers.bucketFor(ctx, key, true)
+	if err != nil {
+		s.log.Errorf("failed to upload file: %v", err)
+		return fmt.Errorf("failed to upload file: %w", err)
+	}
+
+	resp, err := s.do(ctx, http.MethodPut, container, key, nil, header, content)
+	if err != nil {
+		s.log.Errorf("failed to upload file: %v", err)
+		return fmt.Errorf("failed to upload file: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		err = azureResponseError(resp)
+		s.log.Errorf("failed to upload file: %v", err)
+		return fmt.Errorf("failed to upload file: %w", err)
+	}
+	return nil
+}
+
+// Download downloads a file from storage
+func (s *AzureStorage) Download(ctx context.Context, key string) ([]byte, error) {
+	container, err := s.containers.bucketFor(ctx, key, false)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.do(ctx, http.MethodGet, container, key, nil, nil, nil)
+	if err != nil {
+		s.log.Errorf("failed to get object: %v", err)
+		return nil, fmt.Errorf("failed to get object: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrObjectNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		err = azureResponseError(resp)
+		s.log.Errorf("failed to get object: %v", err)
+		return nil, fmt.Errorf("failed to get object: %w", err)
+	}
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		s.log.Errorf("failed to read object: %v", err)
+		return nil, fmt.Errorf("failed to read object: %w", err)
+	}
+
+	return content, nil
+}
+
+// Open streams part of a file from storage
+func (s *AzureStorage) Open(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error) {
+	container, err := s.containers.bucketFor(ctx, key, false)
+	if err != nil {
+		return nil, err
+	}
+
+	header := http.Header{}
+	switch {
+	case length > 0:
+		header.Set("x-ms-range", fmt.Sprintf("bytes=%d-%d", offset, offset+length-1))
+	case offset > 0:
+		header.Set("x-ms-range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	resp, err := s.do(ctx, http.MethodGet, container, key, nil, header, nil)
+	if err != nil {
+		s.log.Errorf("failed to get object: %v", err)
+		return nil, fmt.Errorf("failed to get object: %w", err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusPartialContent:
+		return resp.Body, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, ErrObjectNotFound
+	}
+	err = azureResponseError(resp)
+	resp.Body.Close()
+	s.log.Errorf("failed to get object: %v", err)
+	return nil, fmt.Errorf("failed to get object: %w", err)
+}
+
+// Delete deletes a file from storage
+func (s *AzureStorage) Delete(ctx context.Context, key string) error {
+	container, err := s.containers.bucketFor(ctx, key, false)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.do(ctx, http.MethodDelete, container, key, nil, nil, nil)
+	if err != nil {
+		s.log.Errorf("failed to delete object: %v", err)
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusNotFound {
+		err = azureResponseError(resp)
+		s.log.Errorf("failed to delete object: %v", err)
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+	return nil
+}
+
+// GetPresignedURL generates a read-only service SAS URL for downloading
+func (s *AzureStorage) GetPresignedURL(ctx context.Context, key string, expiresIn time.Duration, opts PresignOptions) (string, error) {
+	container, err := s.containers.bucketFor(ctx, key, false)
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now().UTC()
+	// Allow for clock skew between us and the storage service
+	start := now.Add(-5 * time.Minute).Format(time.RFC3339)
+	expiry := now.Add(expiresIn).Format(time.RFC3339)
+
+	protocol := "https"
+	if s.endpoint.Scheme == "http" {
+		protocol = "https,http"
+	}
+
+	stringToSign := strings.Join([]string{
+		"r",    // signedPermissions
+		start,  // signedStart
+		expiry, // signedExpiry
+		fmt.Sprintf("/blob/%s/%s/%s", s.accountName, container, key), // canonicalizedResource
+		"",                      // signedIdentifier
+		"",                      // signedIP
+		protocol,                // signedProtocol
+		azureAPIVersion,         // signedVersion
+		"b",                     // signedResource
+		"",                      // signedSnapshotTime
+		"",                      // signedEncryptionScope
+		"",                      // rscc
+		opts.ContentDisposition, // rscd
+		"",                      // rsce
+		"",                      // rscl
+		opts.ContentType,        // rsct
+	}, "\n")
+
+	query := url.Values{}
+	query.Set("sv", azureAPIVersion)
+	query.Set("sr", "b")
+	query.Set("sp", "r")
+	query.Set("st", start)
+	query.Set("se", expiry)
+	query.Set("spr", protocol)
+	if opts.ContentDisposition != "" {
+		query.Set("rscd", opts.ContentDisposition)
+	}
+	if opts.ContentType != "" {
+		query.Set("rsct", opts.ContentType)
+	}
+	query.Set("sig", s.sign(stringToSign))
+
+	u := s.blobURL(container, key)
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
+// Stat returns information about a stored object
+func (s *AzureStorage) Stat(ctx context.Context, key string) (*ObjectInfo, error) {
+	container, err := s.containers.bucketFor(ctx, key, false)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.do(ctx, http.MethodHead, container, key, nil, nil, nil)
+	if err != nil {
+		s.log.Errorf("failed to stat object: %v", err)
+		return nil, fmt.Errorf("failed to stat object: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrObjectNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		err = azureResponseError(resp)
+		s.log.Errorf("failed to stat object: %v", err)
+		return nil, fmt.Errorf("failed to stat object: %w", err)
+	}
+
+	info := &ObjectInfo{
+		Key:         key,
+		Size:        resp.ContentLength,
+		ContentType: resp.Header.Get("Content-Type"),
+		Metadata:    map[string]string{},
+	}
+	if t, err := http.ParseTime(resp.Header.Get("Last-Modified")); err == nil {
+		info.LastModified = t
+	}
+	for name, values := range resp.Header {
+		lower := strings.ToLower(name)
+		if strings.HasPrefix(lower, azureMetaPrefix) && len(values) > 0 {
+			info.Metadata[strings.TrimPrefix(lower, azureMetaPrefix)] = values[0]
+		}
+	}
+	info.Checksum = info.Metadata["checksum"]
+
+	return info, nil
+}
+
+// List lists objects whose keys start with prefix
+func (s *AzureStorage) List(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	container, err := s.containers.bucketForPrefix(prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var objects []ObjectInfo
+	marker := ""
+
+	for {
+		query := url.Values{
+			"restype": {"container"},
+			"comp":    {"list"},
+			"include": {"metadata"},
+		}
+		if prefix != "" {
+			query.Set("prefix", prefix)
+		}
+		if marker != "" {
+			query.Set("marker", marker)
+		}
+
+		result, err := s.listPage(ctx, container, query)
+		if err != nil {
+			s.log.Errorf("failed to list objects: %v", err)
+			return nil, fmt.Errorf("failed to list objects: %w", err)
+		}
+
+		for _, blob := range result.Blobs.Blob {
+			info := ObjectInfo{
+				Key:         blob.Name,
+				Size:        blob.Properties.ContentLength,
+				ContentType: blob.Properties.ContentType,
+				Metadata:    map[string]string{},
+			}
+			if t, err := http.ParseTime(blob.Properties.LastModified); err == nil {
+				info.LastModified = t
+			}
+			for _, item := range blob.Metadata.Items {
+				info.Metadata[strings.ToLower(item.XMLName.Local)] = item.Value
+			}
+			info.Checksum = info.Metadata["checksum"]
+			objects = append(objects, info)
+		}
+
+		if result.NextMarker == "" {
+			return objects, nil
+		}
+		marker = result.NextMarker
+	}
+}
+
+// Exists checks if a file exists in storage
+func (s *AzureStorage) Exists(ctx context.Context, key string) (bool, error) {
+	_, err := s.Stat(ctx, key)
+	if err != nil {
+		if errors.Is(err, ErrObjectNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// listPage fetches and decodes one page of List Blobs results
+func (s *AzureStorage) listPage(ctx context.Context, container string, query url.Values) (*azureListResult, error) {
+	resp, err := s.do(ctx, http.MethodGet, container, "", query, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// A per-tenant container that was never provisioned holds no objects
+	if resp.StatusCode == http.StatusNotFound {
+		return &azureListResult{}, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, azureResponseError(resp)
+	}
+
+	var result azureListResult
+	if err := xml.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode list response: %w", err)
+	}
+	return &result, nil
+}
+
+// ensureContainer creates container if it does not exist
+func (s *AzureStorage) ensureContainer(ctx context.Context, container string) error {
+	resp, err := s.do(ctx, http.MethodPut, container, "", url.Values{"restype": {"container"}}, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusCreated:
+		s.log.Infof("created container: %s", container)
+		return nil
+	case http.StatusConflict:
+		return nil
+	default:
+		return azureResponseError(resp)
+	}
+}
+
+// blobURL returns the URL of a blob, or of the container when key is empty
+func (s *AzureStorage) blobURL(container, key string) *url.URL {
+	u := *s.endpoint
+	u.Path = s.endpoint.Path + "/" + container
+	u.RawPath = s.endpoint.EscapedPath() + "/" + url.PathEscape(container)
+	if key != "" {
+		u.Path += "/" + key
+		u.RawPath += "/" + escapeKey(key)
+	}
+	return &u
+}
+
+// do sends a request signed with the account's Shared Key
+func (s *AzureStorage) do(ctx context.Context, method, container, key string, query url.Values, header http.Header, body []byte) (*http.Response, error) {
+	u := s.blobURL(container, key)
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range header {
+		for _, v := range values {
+			req.Header.Add(name, v)
+		}
+	}
+	req.ContentLength = int64(len(body))
+	if len(body) == 0 {
+		req.Body = http.NoBody
+	}
+	req.Header.Set("x-ms-date", time.Now().UTC().Format(http.TimeFormat))
+	req.Header.Set("x-ms-version", azureAPIVersion)
+	req.Header.Set("Authorization", "SharedKey "+s.accountName+":"+s.sign(s.stringToSign(req, u, query)))
+
+	return s.client.Do(req)
+}
+
+// stringToSign builds the Shared Key string-to-sign for a request
+func (s *AzureStorage) stringToSign(req *http.Request, u *url.URL, query url.Values) string {
+	contentLength := ""
+	if req.ContentLength > 0 {
+		contentLength = strconv.FormatInt(req.ContentLength, 10)
+	}
+
+	// Canonicalized headers: all x-ms-* headers, lowercased and sorted
+	var msHeaders []string
+	for name := range req.Header {
+		lower := strings.ToLower(name)
+		if strings.HasPrefix(lower, "x-ms-") {
+			msHeaders = append(msHeaders, lower)
+		}
+	}
+	sort.Strings(msHeaders)
+
+	var b strings.Builder
+	b.WriteString(strings.Join([]string{
+		req.Method,
+		req.Header.Get("Content-Encoding"),
+		req.Header.Get("Content-Language"),
+		contentLength,
+		req.Header.Get("Content-MD5"),
+		req.Header.Get("Content-Type"),
+		"", // Date (x-ms-date is used instead)
+		req.Header.Get("If-Modified-Since"),
+		req.Header.Get("If-Match"),
+		req.Header.Get("If-None-Match"),
+		req.Header.Get("If-Unmodified-Since"),
+		req.Header.Get("Range"),
+	}, "\n"))
+	b.WriteString("\n")
+	for _, name := range msHeaders {
+		b.WriteString(name + ":" + strings.TrimSpace(req.Header.Get(name)) + "\n")
+	}
+
+	// Canonicalized resource: /account/path followed by sorted query parameters
+	b.WriteString("/" + s.accountName + u.EscapedPath())
+	params := make([]string, 0, len(query))
+	for name := range query {
+		params = append(params, name)
+	}
+	sort.Strings(params)
+	for _, name := range params {
+		values := append([]string(nil), query[name]...)
+		sort.Strings(values)
+		b.WriteString("\n" + strings.ToLower(name) + ":" + strings.Join(values, ","))
+	}
+
+	return b.String()
+}
+
+// sign returns the base64 HMAC-SHA256 signature of stringToSign using the account key
+func (s *AzureStorage) sign(stringToSign string) string {
+	mac := hmac.New(sha256.New, s.accountKey)
+	mac.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// azureResponseError converts an unexpected Blob service response into an error
+func azureResponseError(resp *http.Response) error {
+	code := resp.Header.Get("x-ms-error-code")
+	if code == "" {
+		code = resp.Status
+	}
+	return &azureError{Code: code, StatusCode: resp.StatusCode}
+}
+
+// azureError is an error response from Azure Blob Storage
+type azureError struct {
+	Code       string
+	StatusCode int
+}
+
+func (e *azureError) Error() string {
+	return fmt.Sprintf("azure blob storage: %s (HTTP %d)", e.Code, e.StatusCode)
+}
@@ -0,0 +1,434 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// encryptedObjectMagic prefixes objects sealed as a whole by EncryptedStorage (format
+// version 1), which it still reads but no longer writes
+var encryptedObjectMagic = []byte("PLENC1")
+
+// encryptedChunkedMagic prefixes objects written by EncryptedStorage (format version 2), whose
+// plaintext is sealed in chunks of encryptedChunkSize so it can be read a part at a time
+var encryptedChunkedMagic = []byte("PLENC2")
+
+// encryptedChunkSize is the plaintext size of every chunk of a version 2 object but the last
+const encryptedChunkSize = 64 << 10
+
+// isEncryptedObject reports whether content, as stored, was written by EncryptedStorage
+func isEncryptedObject(content []byte) bool {
+	return bytes.HasPrefix(content, encryptedObjectMagic) || bytes.HasPrefix(content, encryptedChunkedMagic)
+}
+
+// dataKeySize is the size of per-tenant AES-256 data keys
+const dataKeySize = 32
+
+// KeyWrapper wraps and unwraps per-tenant data keys with a master key (local key or KMS)
+type KeyWrapper interface {
+	// KeyID identifies the master key, stored alongside wrapped keys
+	KeyID() string
+	// Wrap encrypts a tenant's data key
+	Wrap(ctx context.Context, tenantID uint32, dataKey []byte) ([]byte, error)
+	// Unwrap decrypts a tenant's data key
+	Unwrap(ctx context.Context, tenantID uint32, wrappedKey []byte) ([]byte, error)
+}
+
+// MasterKeyWrapper wraps data keys with a locally configured AES-256-GCM master key
+type MasterKeyWrapper struct {
+	id   string
+	aead cipher.AEAD
+}
+
+// NewMasterKeyWrapper creates a KeyWrapper from a base64-encoded 32-byte master key
+func NewMasterKeyWrapper(id, encodedKey string) (*MasterKeyWrapper, error) {
+	key, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid master key encoding: %w", err)
+	}
+	if len(key) != dataKeySize {
+		return nil, fmt.Errorf("master key must be %d bytes, got %d", dataKeySize, len(key))
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	return &MasterKeyWrapper{id: id, aead: aead}, nil
+}
+
+// KeyID implements KeyWrapper
+func (w *MasterKeyWrapper) KeyID() string {
+	return w.id
+}
+
+// Wrap implements KeyWrapper
+func (w *MasterKeyWrapper) Wrap(_ context.Context, tenantID uint32, dataKey []byte) ([]byte, error) {
+	return seal(w.aead, tenantAAD(tenantID), dataKey)
+}
+
+// Unwrap implements KeyWrapper
+func (w *MasterKeyWrapper) Unwrap(_ context.Context, tenantID uint32, wrappedKey []byte) ([]byte, error) {
+	return open(w.aead, tenantAAD(tenantID), wrappedKey)
+}
+
+// EncryptedStorage encrypts document content with a per-tenant data key before it
+// reaches the underlying Storage, so storage operators never see plaintext.
+// Objects written before encryption was enabled are returned unchanged.
+type EncryptedStorage struct {
+	Storage
+
+	keys    *TenantKeyRepo
+	wrapper KeyWrapper
+	log     *log.Helper
+
+	mu    sync.Mutex
+	aeads map[uint32]cipher.AEAD
+}
+
+// NewEncryptedStorage wraps backend with client-side envelope encryption
+func NewEncryptedStorage(backend Storage, keys *TenantKeyRepo, wrapper KeyWrapper, l *log.Helper) *EncryptedStorage {
+	return &EncryptedStorage{
+		Storage: backend,
+		keys:    keys,
+		wrapper: wrapper,
+		log:     l,
+		aeads:   make(map[uint32]cipher.AEAD),
+	}
+}
+
+// Upload encrypts content and uploads it. Size and checksum refer to the plaintext.
+func (s *EncryptedStorage) Upload(ctx context.Context, tenantID uint32, categoryID, documentID, fileName string, content []byte, mimeType string) (*UploadResult, error) {
+	return uploadDocument(ctx, s, tenantID, categoryID, documentID, fileName, content, mimeType)
+}
+
+// Put encrypts content with the data key of the tenant owning key and stores it
+func (s *EncryptedStorage) Put(ctx context.Context, key string, content []byte, contentType string, metadata map[string]string) error {
+	tenantID, err := tenantFromKey(key)
+	if err != nil {
+		return err
+	}
+	aead, err := s.tenantAEAD(ctx, tenantID)
+	if err != nil {
+		return fmt.Errorf("failed to upload file: %w", err)
+	}
+
+	sealed := bytes.Clone(encryptedChunkedMagic)
+	for index := uint64(0); ; index++ {
+		chunk := content[:min(len(content), encryptedChunkSize)]
+		content = content[len(chunk):]
+		last := len(content) == 0
+
+		// Bind every chunk to its object key, position and whether it ends the object, so
+		// objects cannot be swapped and chunks cannot be reordered, dropped or cut off
+		part, err := seal(aead, chunkAAD(key, index, last), chunk)
+		if err != nil {
+			return fmt.Errorf("failed to encrypt file: %w", err)
+		}
+		sealed = append(sealed, part...)
+		if last {
+			break
+		}
+	}
+
+	return s.Storage.Put(ctx, key, sealed, contentType, metadata)
+}
+
+// Download downloads and decrypts an object
+func (s *EncryptedStorage) Download(ctx context.Context, key string) ([]byte, error) {
+	content, err := s.Storage.Download(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if !isEncryptedObject(content) {
+		return content, nil
+	}
+
+	aead, err := s.objectAEAD(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download file: %w", err)
+	}
+
+	if bytes.HasPrefix(content, encryptedChunkedMagic) {
+		r := &chunkReader{
+			src:    io.NopCloser(bytes.NewReader(content[len(encryptedChunkedMagic):])),
+			aead:   aead,
+			key:    key,
+			remain: -1,
+		}
+		plaintext, err := io.ReadAll(r)
+		if err != nil {
+			s.log.Errorf("failed to decrypt object %s: %v", key, err)
+			return nil, err
+		}
+		return plaintext, nil
+	}
+
+	plaintext, err := open(aead, []byte(key), content[len(encryptedObjectMagic):])
+	if err != nil {
+		s.log.Errorf("failed to decrypt object %s: %v", key, err)
+		return nil, fmt.Errorf("failed to decrypt object: %w", err)
+	}
+	return plaintext, nil
+}
+
+// Open streams the requested part of an object. Only the chunks covering it are read and
+// decrypted, one at a time. Version 1 objects can only be authenticated as a whole, so they
+// are decrypted in memory first.
+func (s *EncryptedStorage) Open(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error) {
+	header, err := s.readHeader(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case bytes.Equal(header, encryptedChunkedMagic):
+		aead, err := s.objectAEAD(ctx, key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to download file: %w", err)
+		}
+
+		sealedChunkSize := int64(aead.NonceSize() + encryptedChunkSize + aead.Overhead())
+		first := offset / encryptedChunkSize
+		readLength := int64(-1)
+		if length >= 0 {
+			readLength = ((offset+length-1)/encryptedChunkSize - first + 1) * sealedChunkSize
+		}
+		src, err := s.Storage.Open(ctx, key, int64(len(encryptedChunkedMagic))+first*sealedChunkSize, readLength)
+		if err != nil {
+			return nil, err
+		}
+		return &chunkReader{
+			src:    src,
+			aead:   aead,
+			key:    key,
+			index:  uint64(first),
+			skip:   offset - first*encryptedChunkSize,
+			remain: length,
+		}, nil
+
+	case bytes.Equal(header, encryptedObjectMagic):
+		content, err := s.Download(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		offset = min(offset, int64(len(content)))
+		if length < 0 || offset+length > int64(len(content)) {
+			length = int64(len(content)) - offset
+		}
+		return io.NopCloser(bytes.NewReader(content[offset : offset+length])), nil
+
+	default:
+		return s.Storage.Open(ctx, key, offset, length)
+	}
+}
+
+// readHeader returns the first bytes of an object, as long as the format magic
+func (s *EncryptedStorage) readHeader(ctx context.Context, key string) ([]byte, error) {
+	r, err := s.Storage.Open(ctx, key, 0, int64(len(encryptedChunkedMagic)))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	header, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read object: %w", err)
+	}
+	return header, nil
+}
+
+// objectAEAD returns the cipher of the tenant owning key
+func (s *EncryptedStorage) objectAEAD(ctx context.Context, key string) (cipher.AEAD, error) {
+	tenantID, err := tenantFromKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return s.tenantAEAD(ctx, tenantID)
+}
+
+// GetPresignedURL is not supported: a presigned URL would hand out ciphertext
+func (s *EncryptedStorage) GetPresignedURL(context.Context, string, time.Duration, PresignOptions) (string, error) {
+	return "", ErrPresignNotSupported
+}
+
+// tenantAEAD returns the cipher for a tenant's data key, creating the key on first use
+func (s *EncryptedStorage) tenantAEAD(ctx context.Context, tenantID uint32) (cipher.AEAD, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if aead, ok := s.aeads[tenantID]; ok {
+		return aead, nil
+	}
+
+	stored, err := s.keys.Get(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if stored == nil {
+		dataKey := make([]byte, dataKeySize)
+		if _, err = rand.Read(dataKey); err != nil {
+			return nil, fmt.Errorf("failed to generate data key: %w", err)
+		}
+		wrapped, err := s.wrapper.Wrap(ctx, tenantID, dataKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to wrap data key: %w", err)
+		}
+		if stored, err = s.keys.CreateIfMissing(ctx, tenantID, wrapped, s.wrapper.KeyID()); err != nil {
+			return nil, err
+		}
+		s.log.Infof("created data key for tenant %d", tenantID)
+	}
+
+	if stored.MasterKeyID != s.wrapper.KeyID() {
+		return nil, fmt.Errorf("data key of tenant %d is wrapped by master key %q, configured key is %q", tenantID, stored.MasterKeyID, s.wrapper.KeyID())
+	}
+
+	dataKey, err := s.wrapper.Unwrap(ctx, tenantID, stored.WrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key: %w", err)
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	s.aeads[tenantID] = aead
+	return aead, nil
+}
+
+// newAEAD creates an AES-GCM cipher
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts plaintext, returning nonce || ciphertext
+func seal(aead cipher.AEAD, additionalData, plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// open decrypts nonce || ciphertext
+func open(aead cipher.AEAD, additionalData, sealed []byte) ([]byte, error) {
+	if len(sealed) < aead.NonceSize() {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, additionalData)
+}
+
+// chunkAAD binds a chunk of a version 2 object to the object key, its index and whether it
+// is the last one
+func chunkAAD(key string, index uint64, last bool) []byte {
+	aad := binary.BigEndian.AppendUint64([]byte(key), index)
+	if last {
+		return append(aad, 1)
+	}
+	return append(aad, 0)
+}
+
+// chunkReader decrypts the chunks of a version 2 object read from src, which starts at the
+// chunk with the given index
+type chunkReader struct {
+	src   io.ReadCloser
+	aead  cipher.AEAD
+	key   string
+	index uint64
+
+	// skip is the plaintext to drop from the first chunk, remain what is left to return, or
+	// -1 to read to the end of the object
+	skip   int64
+	remain int64
+
+	sealed []byte
+	buf    []byte
+	last   bool
+	err    error
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	if r.remain == 0 {
+		return 0, io.EOF
+	}
+	for len(r.buf) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		if r.last {
+			return 0, io.EOF
+		}
+		r.err = r.next()
+	}
+
+	if r.remain >= 0 && int64(len(p)) > r.remain {
+		p = p[:r.remain]
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	if r.remain > 0 {
+		r.remain -= int64(n)
+	}
+	return n, nil
+}
+
+// next decrypts the next chunk into buf. Only the last chunk of an object may be shorter
+// than the others, and it is sealed as the last, so a cut off object fails to decrypt.
+func (r *chunkReader) next() error {
+	first := r.sealed == nil
+	if first {
+		r.sealed = make([]byte, r.aead.NonceSize()+encryptedChunkSize+r.aead.Overhead())
+	}
+	n, err := io.ReadFull(r.src, r.sealed)
+	if err == io.EOF {
+		// A read starting past the last chunk is empty, like other backends' reads past the end
+		if first && r.index > 0 {
+			r.last = true
+			return nil
+		}
+		return fmt.Errorf("failed to decrypt object: last chunk is missing")
+	}
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return fmt.Errorf("failed to read object: %w", err)
+	}
+
+	plaintext, err := open(r.aead, chunkAAD(r.key, r.index, false), r.sealed[:n])
+	if err != nil {
+		if plaintext, err = open(r.aead, chunkAAD(r.key, r.index, true), r.sealed[:n]); err != nil {
+			return fmt.Errorf("failed to decrypt object: %w", err)
+		}
+		r.last = true
+	}
+	r.index++
+
+	r.buf = plaintext[min(r.skip, int64(len(plaintext))):]
+	r.skip = 0
+	return nil
+}
+
+func (r *chunkReader) Close() error {
+	return r.src.Close()
+}
+
+// tenantAAD binds a wrapped data key to its tenant
+func tenantAAD(tenantID uint32) []byte {
+	return binary.BigEndian.AppendUint32([]byte("paperless-tenant-key"), tenantID)
+}
@@ -0,0 +1,368 @@
+package data
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// localMetaSuffix is the suffix of the sidecar file holding object metadata
+const localMetaSuffix = ".meta.json"
+
+// localObjectMeta is persisted next to every stored file
+type localObjectMeta struct {
+	ContentType string            `json:"contentType,omitempty"`
+	Checksum    string            `json:"checksum,omitempty"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+}
+
+// LocalStorage implements Storage on the local filesystem, for small deployments without S3
+type LocalStorage struct {
+	root       string
+	publicURL  string
+	signingKey []byte
+	log        *log.Helper
+}
+
+// NewLocalStorage creates a filesystem storage backend rooted at LOCAL_STORAGE_PATH.
+// Presigned URLs point to LOCAL_STORAGE_PUBLIC_URL and are signed with
+// LOCAL_STORAGE_SIGNING_KEY (a random key is generated when unset).
+func NewLocalStorage(l *log.Helper, env StorageEnv) (*LocalStorage, error) {
+	root, err := filepath.Abs(env.get("LOCAL_STORAGE_PATH", "./data/documents"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid local storage path: %w", err)
+	}
+	if err := os.MkdirAll(root, 0o750); err != nil {
+		l.Errorf("failed to create local storage directory: %v", err)
+		return nil, fmt.Errorf("failed to create local storage directory: %w", err)
+	}
+
+	signingKey := []byte(env.get("LOCAL_STORAGE_SIGNING_KEY", ""))
+	if len(signingKey) == 0 {
+		signingKey = make([]byte, 32)
+		if _, err := rand.Read(signingKey); err != nil {
+			return nil, fmt.Errorf("failed to generate signing key: %w", err)
+		}
+		l.Warnf("%s not set, presigned URLs will not survive restarts", env.name("LOCAL_STORAGE_SIGNING_KEY"))
+	}
+
+	l.Infof("local storage root: %s", root)
+
+	return &LocalStorage{
+		root:       root,
+		publicURL:  strings.TrimRight(env.get("LOCAL_STORAGE_PUBLIC_URL", ""), "/"),
+		signingKey: signingKey,
+		log:        l,
+	}, nil
+}
+
+// Upload uploads a file to storage
+func (s *LocalStorage) Upload(ctx context.Context, tenantID uint32, categoryID, documentID, fileName string, content []byte, mimeType string) (*UploadResult, error) {
+	return uploadDocument(ctx, s, tenantID, categoryID, documentID, fileName, content, mimeType)
+}
+
+// Put stores content under key
+func (s *LocalStorage) Put(ctx context.Context, key string, content []byte, contentType string, metadata map[string]string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	meta := localObjectMeta{
+		ContentType: contentType,
+		Checksum:    metadata["checksum"],
+		Metadata:    metadata,
+	}
+	metaContent, err := json.Marshal(meta)
+	if err != nil {
+		return fmt.Errorf("failed to encode metadata: %w", err)
+	}
+
+	if err := writeFileAtomic(path, content); err != nil {
+		s.log.Errorf("failed to upload file: %v", err)
+		return fmt.Errorf("failed to upload file: %w", err)
+	}
+	if err := writeFileAtomic(path+localMetaSuffix, metaContent); err != nil {
+		s.log.Errorf("failed to write file metadata: %v", err)
+		return fmt.Errorf("failed to upload file: %w", err)
+	}
+	return nil
+}
+
+// Download downloads a file from storage
+func (s *LocalStorage) Download(ctx context.Context, key string) ([]byte, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrObjectNotFound
+		}
+		s.log.Errorf("failed to read object: %v", err)
+		return nil, fmt.Errorf("failed to read object: %w", err)
+	}
+	return content, nil
+}
+
+// Open streams part of a file from storage
+func (s *LocalStorage) Open(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrObjectNotFound
+		}
+		s.log.Errorf("failed to open object: %v", err)
+		return nil, fmt.Errorf("failed to open object: %w", err)
+	}
+	if length < 0 {
+		if _, err := f.Seek(offset, io.SeekStart); err != nil {
+			f.Close()
+			return nil, fmt.Errorf("failed to open object: %w", err)
+		}
+		return f, nil
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{io.NewSectionReader(f, offset, length), f}, nil
+}
+
+// Delete deletes a file from storage
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range []string{path, path + localMetaSuffix} {
+		if err := os.Remove(p); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			s.log.Errorf("failed to delete object: %v", err)
+			return fmt.Errorf("failed to delete object: %w", err)
+		}
+	}
+
+	s.pruneEmptyDirs(filepath.Dir(path))
+	return nil
+}
+
+// GetPresignedURL generates a signed URL below LOCAL_STORAGE_PUBLIC_URL, which must lead to
+// the service's local file endpoint. That endpoint checks the URL with VerifyPresignedURL and
+// sends the response-content-disposition and response-content-type parameters as headers.
+func (s *LocalStorage) GetPresignedURL(ctx context.Context, key string, expiresIn time.Duration, opts PresignOptions) (string, error) {
+	if s.publicURL == "" {
+		return "", fmt.Errorf("failed to generate presigned URL: no public URL configured")
+	}
+	if _, err := s.path(key); err != nil {
+		return "", err
+	}
+
+	expires := strconv.FormatInt(time.Now().Add(expiresIn).Unix(), 10)
+
+	query := url.Values{}
+	query.Set("expires", expires)
+	if opts.ContentDisposition != "" {
+		query.Set("response-content-disposition", opts.ContentDisposition)
+	}
+	if opts.ContentType != "" {
+		query.Set("response-content-type", opts.ContentType)
+	}
+	query.Set("signature", s.sign(key, expires, opts.ContentDisposition, opts.ContentType))
+
+	return s.publicURL + "/" + escapeKey(key) + "?" + query.Encode(), nil
+}
+
+// VerifyPresignedURL checks the signature and expiry of a presigned URL's key and query parameters
+func (s *LocalStorage) VerifyPresignedURL(key string, query url.Values) bool {
+	expires := query.Get("expires")
+	ts, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || time.Now().Unix() > ts {
+		return false
+	}
+	expected := s.sign(key, expires, query.Get("response-content-disposition"), query.Get("response-content-type"))
+	return hmac.Equal([]byte(query.Get("signature")), []byte(expected))
+}
+
+// Stat returns information about a stored object
+func (s *LocalStorage) Stat(ctx context.Context, key string) (*ObjectInfo, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	return s.stat(key, path)
+}
+
+// List lists objects whose keys start with prefix
+func (s *LocalStorage) List(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	var objects []ObjectInfo
+
+	err := filepath.WalkDir(s.root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || strings.HasSuffix(path, localMetaSuffix) || strings.Contains(d.Name(), ".tmp-") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(s.root, path)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+
+		info, err := s.stat(key, path)
+		if err != nil {
+			return err
+		}
+		objects = append(objects, *info)
+		return nil
+	})
+	if err != nil {
+		s.log.Errorf("failed to list objects: %v", err)
+		return nil, fmt.Errorf("failed to list objects: %w", err)
+	}
+
+	return objects, nil
+}
+
+// Exists checks if a file exists in storage
+func (s *LocalStorage) Exists(ctx context.Context, key string) (bool, error) {
+	_, err := s.Stat(ctx, key)
+	if err != nil {
+		if errors.Is(err, ErrObjectNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// stat builds ObjectInfo from a file and its metadata sidecar
+func (s *LocalStorage) stat(key, path string) (*ObjectInfo, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("failed to stat object: %w", err)
+	}
+
+	info := &ObjectInfo{
+		Key:          key,
+		Size:         fi.Size(),
+		LastModified: fi.ModTime(),
+	}
+
+	if raw, err := os.ReadFile(path + localMetaSuffix); err == nil {
+		var meta localObjectMeta
+		if err := json.Unmarshal(raw, &meta); err == nil {
+			info.ContentType = meta.ContentType
+			info.Checksum = meta.Checksum
+			info.Metadata = meta.Metadata
+		}
+	}
+
+	return info, nil
+}
+
+// path maps a storage key to a filesystem path, rejecting keys that escape the root
+// or fall outside the {tenant_id}/... template. Every tenant already lives in its own
+// directory, so STORAGE_TENANT_ISOLATION does not change the layout.
+func (s *LocalStorage) path(key string) (string, error) {
+	if key == "" || strings.HasPrefix(key, "/") {
+		return "", fmt.Errorf("invalid storage key %q", key)
+	}
+	if _, err := tenantFromKey(key); err != nil {
+		return "", err
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == ".." || part == "." || part == "" {
+			return "", fmt.Errorf("invalid storage key %q", key)
+		}
+	}
+
+	path := filepath.Join(s.root, filepath.FromSlash(key))
+	if !strings.HasPrefix(path, s.root+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid storage key %q", key)
+	}
+	return path, nil
+}
+
+// pruneEmptyDirs removes empty parent directories up to the storage root
+func (s *LocalStorage) pruneEmptyDirs(dir string) {
+	for dir != s.root && strings.HasPrefix(dir, s.root) {
+		if err := os.Remove(dir); err != nil {
+			return
+		}
+		dir = filepath.Dir(dir)
+	}
+}
+
+// sign returns the HMAC signature of a key, expiry and response header overrides
+func (s *LocalStorage) sign(key, expires, disposition, contentType string) string {
+	mac := hmac.New(sha256.New, s.signingKey)
+	mac.Write([]byte(strings.Join([]string{key, expires, disposition, contentType}, "\n")))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// escapeKey URL-escapes each segment of a storage key
+func escapeKey(key string) string {
+	parts := strings.Split(key, "/")
+	for i, p := range parts {
+		parts[i] = url.PathEscape(p)
+	}
+	return strings.Join(parts, "/")
+}
+
+// writeFileAtomic writes content to a temporary file and renames it into place
+func writeFileAtomic(path string, content []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	tmpName := tmp.Name()
+
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		os.Remove(tmpName)
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmpName)
+		return err
+	}
+	if err := os.Rename(tmpName, path); err != nil {
+		os.Remove(tmpName)
+		return err
+	}
+	return nil
+}
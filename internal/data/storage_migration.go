@@ -0,0 +1,85 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+)
+
+// MigrationOutcome describes what happened to a single object during a storage migration
+type MigrationOutcome int
+
+const (
+	// MigrationCopied means the object was copied (or would be, in a dry run)
+	MigrationCopied MigrationOutcome = iota
+	// MigrationSkipped means the target already held an identical object
+	MigrationSkipped
+)
+
+// MigrateObject copies a document's object from one backend to another and verifies it.
+// Plaintext objects are written under the document's canonical key, which is returned so
+// the caller can rewrite the file key; envelope-encrypted objects keep their key because
+// their ciphertext is bound to it. Nothing is written when dryRun is set.
+func MigrateObject(ctx context.Context, from, to Storage, doc *ent.Document, dryRun bool) (string, MigrationOutcome, error) {
+	content, err := from.Download(ctx, doc.FileKey)
+	if errors.Is(err, ErrObjectNotFound) {
+		// Already moved by an earlier run, e.g. one that was interrupted after rewriting the key
+		if exists, existsErr := to.Exists(ctx, doc.FileKey); existsErr == nil && exists {
+			return doc.FileKey, MigrationSkipped, nil
+		}
+		return "", 0, fmt.Errorf("object %s missing in source", doc.FileKey)
+	}
+	if err != nil {
+		return "", 0, fmt.Errorf("download %s: %w", doc.FileKey, err)
+	}
+
+	checksum := computeChecksum(content)
+	encrypted := isEncryptedObject(content)
+	if !encrypted && doc.Checksum != "" && checksum != doc.Checksum {
+		return "", 0, fmt.Errorf("object %s does not match the document checksum", doc.FileKey)
+	}
+
+	var tenantID uint32
+	if doc.TenantID != nil {
+		tenantID = *doc.TenantID
+	}
+	var categoryID string
+	if doc.CategoryID != nil {
+		categoryID = *doc.CategoryID
+	}
+
+	key := doc.FileKey
+	if !encrypted {
+		key = buildObjectKey(tenantID, categoryID, doc.ID, doc.FileName)
+	}
+
+	existing, err := to.Download(ctx, key)
+	switch {
+	case err == nil && computeChecksum(existing) == checksum:
+		return key, MigrationSkipped, nil
+	case err != nil && !errors.Is(err, ErrObjectNotFound):
+		return "", 0, fmt.Errorf("check target %s: %w", key, err)
+	}
+
+	if dryRun {
+		return key, MigrationCopied, nil
+	}
+
+	err = to.Put(ctx, key, content, doc.MimeType, documentMetadata(tenantID, categoryID, doc.ID, doc.Checksum))
+	if err != nil {
+		return "", 0, fmt.Errorf("upload %s: %w", key, err)
+	}
+
+	// Read back to make sure the target holds exactly what the source had
+	copied, err := to.Download(ctx, key)
+	if err != nil {
+		return "", 0, fmt.Errorf("verify %s: %w", key, err)
+	}
+	if computeChecksum(copied) != checksum {
+		return "", 0, fmt.Errorf("verify %s: checksum mismatch after copy", key)
+	}
+
+	return key, MigrationCopied, nil
+}
@@ -0,0 +1,303 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/minio/minio-go/v7"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/metrics"
+	"github.com/go-tangra/go-tangra-paperless/internal/tracing"
+)
+
+// ErrStorageUnavailable is returned when a backend keeps failing transiently or its circuit breaker is open
+var ErrStorageUnavailable = errors.New("storage temporarily unavailable")
+
+// ResilientStorage retries transient backend failures with jittered exponential backoff and
+// stops calling a failing backend for a cooldown once a run of operations failed
+type ResilientStorage struct {
+	Storage
+	name    string
+	label   string
+	log     *log.Helper
+	breaker *circuitBreaker
+
+	retries   int
+	baseDelay time.Duration
+	maxDelay  time.Duration
+}
+
+// newResilientStorage wraps backend, configured by STORAGE_RETRIES, STORAGE_RETRY_BASE_DELAY,
+// STORAGE_RETRY_MAX_DELAY, STORAGE_BREAKER_THRESHOLD and STORAGE_BREAKER_COOLDOWN
+func newResilientStorage(l *log.Helper, env StorageEnv, backend Storage) (*ResilientStorage, error) {
+	s := &ResilientStorage{
+		Storage: backend,
+		name:    string(env),
+		label:   env.label(),
+		log:     l,
+	}
+
+	var err error
+	if s.retries, err = strconv.Atoi(env.get("STORAGE_RETRIES", "3")); err != nil || s.retries < 0 {
+		return nil, fmt.Errorf("invalid %s", env.name("STORAGE_RETRIES"))
+	}
+	if s.baseDelay, err = time.ParseDuration(env.get("STORAGE_RETRY_BASE_DELAY", "200ms")); err != nil || s.baseDelay <= 0 {
+		return nil, fmt.Errorf("invalid %s", env.name("STORAGE_RETRY_BASE_DELAY"))
+	}
+	if s.maxDelay, err = time.ParseDuration(env.get("STORAGE_RETRY_MAX_DELAY", "5s")); err != nil || s.maxDelay < s.baseDelay {
+		return nil, fmt.Errorf("invalid %s", env.name("STORAGE_RETRY_MAX_DELAY"))
+	}
+
+	threshold, err := strconv.Atoi(env.get("STORAGE_BREAKER_THRESHOLD", "5"))
+	if err != nil || threshold < 1 {
+		return nil, fmt.Errorf("invalid %s", env.name("STORAGE_BREAKER_THRESHOLD"))
+	}
+	cooldown, err := time.ParseDuration(env.get("STORAGE_BREAKER_COOLDOWN", "30s"))
+	if err != nil || cooldown <= 0 {
+		return nil, fmt.Errorf("invalid %s", env.name("STORAGE_BREAKER_COOLDOWN"))
+	}
+	s.breaker = &circuitBreaker{threshold: threshold, cooldown: cooldown}
+
+	return s, nil
+}
+
+// Upload uploads a file to storage
+func (s *ResilientStorage) Upload(ctx context.Context, tenantID uint32, categoryID, documentID, fileName string, content []byte, mimeType string) (*UploadResult, error) {
+	ctx, span := tracing.Start(ctx, "storage.upload",
+		attribute.String("storage.backend", s.label),
+		attribute.Int64("paperless.tenant_id", int64(tenantID)),
+		attribute.String("paperless.document_id", documentID),
+		attribute.Int("storage.size", len(content)),
+	)
+	result, err := uploadDocument(ctx, s, tenantID, categoryID, documentID, fileName, content, mimeType)
+	tracing.End(span, err)
+	return result, err
+}
+
+// Put stores content under key
+func (s *ResilientStorage) Put(ctx context.Context, key string, content []byte, contentType string, metadata map[string]string) error {
+	_, err := withRetry(ctx, s, "put", func() (struct{}, error) {
+		return struct{}{}, s.Storage.Put(ctx, key, content, contentType, metadata)
+	})
+	return err
+}
+
+// Download downloads a file from storage
+func (s *ResilientStorage) Download(ctx context.Context, key string) ([]byte, error) {
+	return withRetry(ctx, s, "download", func() ([]byte, error) {
+		return s.Storage.Download(ctx, key)
+	})
+}
+
+// Open streams part of a file from storage. Only opening is retried; a stream that breaks
+// off fails the read.
+func (s *ResilientStorage) Open(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error) {
+	return withRetry(ctx, s, "open", func() (io.ReadCloser, error) {
+		return s.Storage.Open(ctx, key, offset, length)
+	})
+}
+
+// Delete deletes a file from storage
+func (s *ResilientStorage) Delete(ctx context.Context, key string) error {
+	_, err := withRetry(ctx, s, "delete", func() (struct{}, error) {
+		return struct{}{}, s.Storage.Delete(ctx, key)
+	})
+	return err
+}
+
+// GetPresignedURL generates a presigned URL for downloading
+func (s *ResilientStorage) GetPresignedURL(ctx context.Context, key string, expiresIn time.Duration, opts PresignOptions) (string, error) {
+	return withRetry(ctx, s, "presign", func() (string, error) {
+		return s.Storage.GetPresignedURL(ctx, key, expiresIn, opts)
+	})
+}
+
+// Stat returns information about a stored object
+func (s *ResilientStorage) Stat(ctx context.Context, key string) (*ObjectInfo, error) {
+	return withRetry(ctx, s, "stat", func() (*ObjectInfo, error) {
+		return s.Storage.Stat(ctx, key)
+	})
+}
+
+// List lists objects whose keys start with prefix
+func (s *ResilientStorage) List(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	return withRetry(ctx, s, "list", func() ([]ObjectInfo, error) {
+		return s.Storage.List(ctx, prefix)
+	})
+}
+
+// Exists checks if a file exists in storage
+func (s *ResilientStorage) Exists(ctx context.Context, key string) (bool, error) {
+	_, err := s.Stat(ctx, key)
+	if err != nil {
+		if errors.Is(err, ErrObjectNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// withRetry runs op until it succeeds, fails permanently or the retries are used up.
+// Transient failures that outlast the retries are reported as ErrStorageUnavailable.
+func withRetry[T any](ctx context.Context, s *ResilientStorage, op string, fn func() (T, error)) (result T, err error) {
+	var zero T
+
+	ctx, span := tracing.Start(ctx, "storage."+op, attribute.String("storage.backend", s.label))
+	start := time.Now()
+	attempts := 0
+	defer func() {
+		span.SetAttributes(attribute.Int("storage.attempts", attempts))
+		// A missing object is an answer, not a backend failure
+		if errors.Is(err, ErrObjectNotFound) {
+			metrics.ObserveStorageOperation(s.label, op, start, nil)
+			tracing.End(span, nil)
+		} else {
+			metrics.ObserveStorageOperation(s.label, op, start, err)
+			tracing.End(span, err)
+		}
+	}()
+
+	for attempt := 0; ; attempt++ {
+		attempts = attempt + 1
+		if !s.breaker.allow() {
+			return zero, fmt.Errorf("%w: circuit open for %s backend", ErrStorageUnavailable, s.name)
+		}
+
+		result, err = fn()
+		if ctx.Err() != nil {
+			// The caller gave up; that says nothing about the backend's health
+			return result, err
+		}
+
+		transient := isTransientStorageError(err)
+		if opened, closed := s.breaker.record(transient); opened {
+			s.log.Warnf("storage circuit opened for %s backend after repeated failures: %v", s.name, err)
+		} else if closed {
+			s.log.Infof("storage circuit closed for %s backend", s.name)
+		}
+
+		if !transient {
+			return result, err
+		}
+		if attempt >= s.retries {
+			return zero, fmt.Errorf("%w: %s failed: %v", ErrStorageUnavailable, op, err)
+		}
+
+		delay := s.backoff(attempt)
+		s.log.Warnf("storage %s failed (attempt %d), retrying in %s: %v", op, attempt+1, delay, err)
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return zero, ctx.Err()
+		}
+	}
+}
+
+// backoff returns a full-jitter delay for the given attempt
+func (s *ResilientStorage) backoff(attempt int) time.Duration {
+	ceiling := s.maxDelay
+	if attempt < 30 {
+		if d := s.baseDelay << attempt; d < ceiling {
+			ceiling = d
+		}
+	}
+	return time.Duration(rand.Int64N(int64(ceiling))) + time.Millisecond
+}
+
+// isTransientStorageError reports whether err is a failure worth retrying: network errors
+// (including client timeouts) and throttling or server errors from S3 or Azure.
+// Missing objects and invalid keys are not.
+func isTransientStorageError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, ErrObjectNotFound) || errors.Is(err, ErrObjectRestoring) || errors.Is(err, ErrPresignNotSupported) {
+		return false
+	}
+
+	var azErr *azureError
+	if errors.As(err, &azErr) {
+		return isTransientStatus(azErr.StatusCode)
+	}
+	var s3Err minio.ErrorResponse
+	if errors.As(err, &s3Err) {
+		switch s3Err.Code {
+		case "SlowDown", "RequestTimeout", "InternalError", "ServiceUnavailable":
+			return true
+		}
+		return isTransientStatus(s3Err.StatusCode)
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.EPIPE)
+}
+
+// isTransientStatus reports whether an HTTP status signals throttling or a server-side failure
+func isTransientStatus(status int) bool {
+	return status == http.StatusRequestTimeout || status == http.StatusTooManyRequests || status >= 500
+}
+
+// circuitBreaker opens after threshold consecutive transient failures, rejects calls for
+// cooldown and then lets a single probe through to decide whether to close again
+type circuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+// allow reports whether a call may go to the backend
+func (b *circuitBreaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures < b.threshold {
+		return true
+	}
+	if time.Now().Before(b.openUntil) || b.probing {
+		return false
+	}
+	b.probing = true
+	return true
+}
+
+// record updates the breaker with the outcome of a call and reports state changes
+func (b *circuitBreaker) record(failed bool) (opened, closed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	wasOpen := b.failures >= b.threshold
+	b.probing = false
+
+	if !failed {
+		b.failures = 0
+		return false, wasOpen
+	}
+
+	b.failures++
+	if b.failures >= b.threshold {
+		b.openUntil = time.Now().Add(b.cooldown)
+		return !wasOpen, false
+	}
+	return false, false
+}
@@ -0,0 +1,302 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+)
+
+// settingActiveStorageBackend names the setting holding the backend that receives new uploads
+const settingActiveStorageBackend = "storage.active_backend"
+
+// activeBackendRefresh is how long a replica caches the active backend before re-reading it
+const activeBackendRefresh = 30 * time.Second
+
+// StorageRouter implements Storage on top of the primary backend and, while a migration
+// is configured, a migration target. New objects go to the active backend, or to the backend
+// a tenant's settings pin it to; reads fall back to the other backend so objects are
+// reachable wherever they currently live.
+// An optional cold tier holds the files of archived and idle documents and is read last.
+type StorageRouter struct {
+	log            *log.Helper
+	settings       *SettingRepo
+	tenantSettings *TenantSettingsRepo
+
+	primaryName string
+	targetName  string
+	backends    map[string]Storage
+	cold        Storage
+
+	mu        sync.RWMutex
+	active    string
+	checkedAt time.Time
+}
+
+// NewStorageRouter creates the primary backend from PAPERLESS_*, a migration target from
+// PAPERLESS_MIGRATION_* when PAPERLESS_MIGRATION_STORAGE_DRIVER is set and a cold tier
+// from PAPERLESS_COLD_* when PAPERLESS_COLD_STORAGE_DRIVER is set
+func NewStorageRouter(ctx *bootstrap.Context, settings *SettingRepo, tenantSettings *TenantSettingsRepo) (*StorageRouter, func(), error) {
+	l := ctx.NewLoggerHelper("storage/data/paperless-service")
+
+	r := &StorageRouter{
+		log:            l,
+		settings:       settings,
+		tenantSettings: tenantSettings,
+		primaryName:    PrimaryStorageEnv.get("STORAGE_NAME", "primary"),
+		backends:       make(map[string]Storage),
+	}
+
+	primary, err := newStorageBackend(l, PrimaryStorageEnv)
+	if err != nil {
+		return nil, func() {}, err
+	}
+	r.backends[r.primaryName] = primary
+	r.active = r.primaryName
+
+	if MigrationStorageEnv.get("STORAGE_DRIVER", "") != "" {
+		r.targetName = MigrationStorageEnv.get("STORAGE_NAME", "migration")
+		if r.targetName == r.primaryName {
+			return nil, func() {}, fmt.Errorf("migration storage name must differ from %q", r.primaryName)
+		}
+
+		target, err := newStorageBackend(l, MigrationStorageEnv)
+		if err != nil {
+			return nil, func() {}, fmt.Errorf("migration storage: %w", err)
+		}
+		r.backends[r.targetName] = target
+		l.Infof("storage migration target %q configured", r.targetName)
+	}
+
+	if ColdStorageEnv.get("STORAGE_DRIVER", "") != "" {
+		if r.cold, err = newStorageBackend(l, ColdStorageEnv); err != nil {
+			return nil, func() {}, fmt.Errorf("cold storage: %w", err)
+		}
+		l.Infof("cold storage tier configured")
+	}
+
+	return r, func() {}, nil
+}
+
+// Names returns the primary backend and the migration target ("" when none is configured)
+func (r *StorageRouter) Names() (primary, target string) {
+	return r.primaryName, r.targetName
+}
+
+// Backend returns a backend by name, or nil
+func (r *StorageRouter) Backend(name string) Storage {
+	return r.backends[name]
+}
+
+// Cold returns the cold tier, or nil when none is configured
+func (r *StorageRouter) Cold() Storage {
+	return r.cold
+}
+
+// LocalBackends returns the backends using the local driver that issue presigned URLs, i.e.
+// have a public URL and no CDN in front. Their URLs are served by this service.
+func (r *StorageRouter) LocalBackends() []*LocalStorage {
+	var locals []*LocalStorage
+	for _, backend := range []Storage{r.backends[r.primaryName], r.backends[r.targetName], r.cold} {
+		if resilient, ok := backend.(*ResilientStorage); ok {
+			backend = resilient.Storage
+		}
+		if local, ok := backend.(*LocalStorage); ok && local.publicURL != "" {
+			locals = append(locals, local)
+		}
+	}
+	return locals
+}
+
+// ActiveName returns the backend that receives new uploads
+func (r *StorageRouter) ActiveName(ctx context.Context) string {
+	if r.targetName == "" {
+		return r.primaryName
+	}
+
+	r.mu.RLock()
+	active, fresh := r.active, time.Since(r.checkedAt) < activeBackendRefresh
+	r.mu.RUnlock()
+	if fresh {
+		return active
+	}
+
+	stored, err := r.settings.Get(ctx, settingActiveStorageBackend)
+	if err != nil {
+		// Keep serving with the last known backend
+		return active
+	}
+	if _, ok := r.backends[stored]; ok {
+		active = stored
+	} else {
+		active = r.primaryName
+	}
+
+	r.mu.Lock()
+	r.active, r.checkedAt = active, time.Now()
+	r.mu.Unlock()
+	return active
+}
+
+// SetActive switches new uploads to the named backend on every replica
+func (r *StorageRouter) SetActive(ctx context.Context, name string) error {
+	if _, ok := r.backends[name]; !ok {
+		return fmt.Errorf("unknown storage backend %q", name)
+	}
+	if err := r.settings.Set(ctx, settingActiveStorageBackend, name); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	r.active, r.checkedAt = name, time.Now()
+	r.mu.Unlock()
+
+	r.log.Infof("active storage backend switched to %q", name)
+	return nil
+}
+
+// ordered returns the backends with the active one first
+func (r *StorageRouter) ordered(ctx context.Context) []Storage {
+	if r.targetName == "" {
+		return []Storage{r.backends[r.primaryName]}
+	}
+	if r.ActiveName(ctx) == r.targetName {
+		return []Storage{r.backends[r.targetName], r.backends[r.primaryName]}
+	}
+	return []Storage{r.backends[r.primaryName], r.backends[r.targetName]}
+}
+
+// readOrder returns the hot backends, active first, followed by the cold tier
+func (r *StorageRouter) readOrder(ctx context.Context) []Storage {
+	backends := r.ordered(ctx)
+	if r.cold != nil {
+		backends = append(backends, r.cold)
+	}
+	return backends
+}
+
+// writeBackend returns the backend a new object goes to: the one the settings of the key's
+// tenant pin it to if that is configured, otherwise the active backend
+func (r *StorageRouter) writeBackend(ctx context.Context, key string) Storage {
+	if tenantID, err := tenantFromKey(key); err == nil {
+		settings, err := r.tenantSettings.For(ctx, tenantID)
+		switch {
+		case err != nil:
+			r.log.Warnf("failed to load storage settings of tenant %d, using the active backend: %v", tenantID, err)
+		case settings.StorageBackend != "":
+			if backend, ok := r.backends[settings.StorageBackend]; ok {
+				return backend
+			}
+			r.log.Warnf("storage backend %q of tenant %d is not configured, using the active backend", settings.StorageBackend, tenantID)
+		}
+	}
+	return r.ordered(ctx)[0]
+}
+
+// Upload uploads a file to the active backend, or the backend its tenant is pinned to
+func (r *StorageRouter) Upload(ctx context.Context, tenantID uint32, categoryID, documentID, fileName string, content []byte, mimeType string) (*UploadResult, error) {
+	return uploadDocument(ctx, r, tenantID, categoryID, documentID, fileName, content, mimeType)
+}
+
+// Put stores content in the active backend, or the backend its tenant is pinned to
+func (r *StorageRouter) Put(ctx context.Context, key string, content []byte, contentType string, metadata map[string]string) error {
+	return r.writeBackend(ctx, key).Put(ctx, key, content, contentType, metadata)
+}
+
+// Download downloads a file from whichever backend holds it
+func (r *StorageRouter) Download(ctx context.Context, key string) ([]byte, error) {
+	var err error
+	for _, backend := range r.readOrder(ctx) {
+		var content []byte
+		if content, err = backend.Download(ctx, key); !errors.Is(err, ErrObjectNotFound) {
+			return content, err
+		}
+	}
+	return nil, err
+}
+
+// Open streams part of a file from whichever backend holds it
+func (r *StorageRouter) Open(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error) {
+	var err error
+	for _, backend := range r.readOrder(ctx) {
+		var body io.ReadCloser
+		if body, err = backend.Open(ctx, key, offset, length); !errors.Is(err, ErrObjectNotFound) {
+			return body, err
+		}
+	}
+	return nil, err
+}
+
+// Delete deletes a file from every backend
+func (r *StorageRouter) Delete(ctx context.Context, key string) error {
+	for _, backend := range r.readOrder(ctx) {
+		if err := backend.Delete(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetPresignedURL generates a presigned URL on the backend holding key
+func (r *StorageRouter) GetPresignedURL(ctx context.Context, key string, expiresIn time.Duration, opts PresignOptions) (string, error) {
+	backends := r.readOrder(ctx)
+
+	// Only probe while objects may live in another backend
+	if len(backends) > 1 {
+		for _, backend := range backends {
+			if exists, err := backend.Exists(ctx, key); err == nil && exists {
+				return backend.GetPresignedURL(ctx, key, expiresIn, opts)
+			}
+		}
+	}
+	return backends[0].GetPresignedURL(ctx, key, expiresIn, opts)
+}
+
+// Stat returns information about a stored object from whichever backend holds it
+func (r *StorageRouter) Stat(ctx context.Context, key string) (*ObjectInfo, error) {
+	var err error
+	for _, backend := range r.readOrder(ctx) {
+		var info *ObjectInfo
+		if info, err = backend.Stat(ctx, key); !errors.Is(err, ErrObjectNotFound) {
+			return info, err
+		}
+	}
+	return nil, err
+}
+
+// List lists objects across all backends; keys present in several are reported once
+func (r *StorageRouter) List(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	var objects []ObjectInfo
+	seen := make(map[string]bool)
+
+	for _, backend := range r.readOrder(ctx) {
+		list, err := backend.List(ctx, prefix)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range list {
+			if !seen[obj.Key] {
+				seen[obj.Key] = true
+				objects = append(objects, obj)
+			}
+		}
+	}
+	return objects, nil
+}
+
+// Exists checks if a file exists in any backend
+func (r *StorageRouter) Exists(ctx context.Context, key string) (bool, error) {
+	_, err := r.Stat(ctx, key)
+	if err != nil {
+		if errors.Is(err, ErrObjectNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
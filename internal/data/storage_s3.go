@@ -0,0 +1,368 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// S3Config holds S3/RustFS configuration
+type S3Config struct {
+	Endpoint        string
+	AccessKeyID     string
+	SecretAccessKey string
+	Bucket          string
+	UseSSL          bool
+	Region          string
+	Encryption      *S3EncryptionConfig
+	Multipart       *S3MultipartConfig
+	StorageClass    string
+	RestoreDays     int
+}
+
+// S3Storage implements Storage on top of an S3-compatible object store (RustFS, MinIO, AWS S3)
+type S3Storage struct {
+	client     *minio.Client
+	buckets    *tenantBuckets
+	region     string
+	encryption *S3EncryptionConfig
+	multipart  *S3MultipartConfig
+	class      string
+	restore    int
+	log        *log.Helper
+}
+
+// NewS3Storage creates a new S3-compatible storage backend
+func NewS3Storage(l *log.Helper, env StorageEnv) (*S3Storage, error) {
+	cfg := &S3Config{
+		Endpoint:        env.get("S3_ENDPOINT", "localhost:9000"),
+		AccessKeyID:     env.get("S3_ACCESS_KEY", "minioadmin"),
+		SecretAccessKey: env.get("S3_SECRET_KEY", "minioadmin"),
+		Bucket:          env.get("S3_BUCKET", "paperless"),
+		UseSSL:          env.get("S3_USE_SSL", "false") == "true",
+		Region:          env.get("S3_REGION", "us-east-1"),
+		StorageClass:    env.get("S3_STORAGE_CLASS", ""),
+	}
+
+	encryption, err := loadS3EncryptionConfig(env)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Encryption = encryption
+
+	multipart, err := loadS3MultipartConfig(env)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Multipart = multipart
+
+	restoreDays, err := strconv.Atoi(env.get("S3_RESTORE_DAYS", "7"))
+	if err != nil || restoreDays < 1 {
+		return nil, fmt.Errorf("invalid %s", env.name("S3_RESTORE_DAYS"))
+	}
+	cfg.RestoreDays = restoreDays
+
+	client, err := minio.New(cfg.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.AccessKeyID, cfg.SecretAccessKey, ""),
+		Secure: cfg.UseSSL,
+		Region: cfg.Region,
+		// Retries are handled by ResilientStorage for every driver alike
+		MaxRetries: 1,
+	})
+	if err != nil {
+		l.Errorf("failed to create MinIO client: %v", err)
+		return nil, err
+	}
+
+	st := &S3Storage{
+		client:     client,
+		region:     cfg.Region,
+		encryption: cfg.Encryption,
+		multipart:  cfg.Multipart,
+		class:      cfg.StorageClass,
+		restore:    cfg.RestoreDays,
+		log:        l,
+	}
+	if cfg.Encryption.Mode != S3EncryptionNone {
+		l.Infof("S3 server-side encryption: %s", cfg.Encryption.Mode)
+	}
+
+	st.buckets, err = newTenantBuckets(env, cfg.Bucket, st.ensureBucket)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure the shared bucket exists; per-tenant buckets are created on first upload
+	if st.buckets.isolation == TenantIsolationPrefix {
+		if err = st.ensureBucket(context.Background(), cfg.Bucket); err != nil {
+			l.Warnf("failed to ensure bucket: %v", err)
+		}
+	}
+
+	return st, nil
+}
+
+// Upload uploads a file to storage
+func (s *S3Storage) Upload(ctx context.Context, tenantID uint32, categoryID, documentID, fileName string, content []byte, mimeType string) (*UploadResult, error) {
+	return uploadDocument(ctx, s, tenantID, categoryID, documentID, fileName, content, mimeType)
+}
+
+// Put stores content under key
+func (s *S3Storage) Put(ctx context.Context, key string, content []byte, contentType string, metadata map[string]string) error {
+	bucket, err := s.buckets.bucketFor(ctx, key, true)
+	if err != nil {
+		s.log.Errorf("failed to upload file: %v", err)
+		return fmt.Errorf("failed to upload file: %w", err)
+	}
+
+	tenantID, err := tenantFromKey(key)
+	if err != nil {
+		return err
+	}
+	sse, err := s.encryption.serverSide(tenantID)
+	if err != nil {
+		s.log.Errorf("failed to upload file: %v", err)
+		return fmt.Errorf("failed to upload file: %w", err)
+	}
+
+	opts := minio.PutObjectOptions{
+		ContentType:          contentType,
+		ServerSideEncryption: sse,
+		UserMetadata:         metadata,
+		UserTags:             objectTags(metadata),
+		StorageClass:         s.class,
+	}
+
+	// Large files go up in retryable parts instead of one long PUT
+	if int64(len(content)) >= s.multipart.Threshold {
+		err = s.putMultipart(ctx, bucket, key, content, opts)
+	} else {
+		opts.DisableMultipart = true
+		_, err = s.client.PutObject(ctx, bucket, key, bytes.NewReader(content), int64(len(content)), opts)
+	}
+	if err != nil {
+		s.log.Errorf("failed to upload file: %v", err)
+		return fmt.Errorf("failed to upload file: %w", err)
+	}
+	return nil
+}
+
+// Download downloads a file from storage
+func (s *S3Storage) Download(ctx context.Context, key string) ([]byte, error) {
+	bucket, err := s.buckets.bucketFor(ctx, key, false)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := s.client.GetObject(ctx, bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		s.log.Errorf("failed to get object: %v", err)
+		return nil, fmt.Errorf("failed to get object: %w", err)
+	}
+	defer obj.Close()
+
+	content, err := io.ReadAll(obj)
+	if err != nil {
+		switch minio.ToErrorResponse(err).Code {
+		case "NoSuchKey", "NoSuchBucket":
+			return nil, ErrObjectNotFound
+		case "InvalidObjectState":
+			// Archived (GLACIER/DEEP_ARCHIVE) objects must be restored before they can be read
+			return nil, s.requestRestore(ctx, bucket, key)
+		}
+		s.log.Errorf("failed to read object: %v", err)
+		return nil, fmt.Errorf("failed to read object: %w", err)
+	}
+
+	return content, nil
+}
+
+// Open streams part of a file from storage
+func (s *S3Storage) Open(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error) {
+	bucket, err := s.buckets.bucketFor(ctx, key, false)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := minio.GetObjectOptions{}
+	switch {
+	case length > 0:
+		err = opts.SetRange(offset, offset+length-1)
+	case offset > 0:
+		// An end of 0 reads to the end of the object
+		err = opts.SetRange(offset, 0)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object: %w", err)
+	}
+
+	obj, err := s.client.GetObject(ctx, bucket, key, opts)
+	if err != nil {
+		s.log.Errorf("failed to get object: %v", err)
+		return nil, fmt.Errorf("failed to get object: %w", err)
+	}
+
+	// GetObject is lazy; Stat sends the request, so a missing or archived object fails here
+	if _, err := obj.Stat(); err != nil {
+		obj.Close()
+		switch minio.ToErrorResponse(err).Code {
+		case "NoSuchKey", "NoSuchBucket":
+			return nil, ErrObjectNotFound
+		case "InvalidObjectState":
+			return nil, s.requestRestore(ctx, bucket, key)
+		}
+		s.log.Errorf("failed to get object: %v", err)
+		return nil, fmt.Errorf("failed to get object: %w", err)
+	}
+	return obj, nil
+}
+
+// Delete deletes a file from storage
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	bucket, err := s.buckets.bucketFor(ctx, key, false)
+	if err != nil {
+		return err
+	}
+
+	err = s.client.RemoveObject(ctx, bucket, key, minio.RemoveObjectOptions{})
+	if err != nil {
+		s.log.Errorf("failed to delete object: %v", err)
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+	return nil
+}
+
+// requestRestore starts restoring an archived object for S3_RESTORE_DAYS and reports it as
+// not yet readable; a restore that is already running is not an error
+func (s *S3Storage) requestRestore(ctx context.Context, bucket, key string) error {
+	req := minio.RestoreRequest{}
+	req.SetDays(s.restore)
+	req.SetGlacierJobParameters(minio.GlacierJobParameters{Tier: minio.TierStandard})
+
+	err := s.client.RestoreObject(ctx, bucket, key, "", req)
+	if err != nil && minio.ToErrorResponse(err).Code != "RestoreAlreadyInProgress" {
+		s.log.Errorf("failed to restore archived object %s: %v", key, err)
+		return fmt.Errorf("failed to restore archived object: %w", err)
+	}
+
+	s.log.Infof("restore of archived object %s requested", key)
+	return ErrObjectRestoring
+}
+
+// GetPresignedURL generates a presigned URL for downloading.
+// SSE-S3 and SSE-KMS objects are decrypted transparently for SigV4-signed requests,
+// so no encryption headers are needed on the URL.
+func (s *S3Storage) GetPresignedURL(ctx context.Context, key string, expiresIn time.Duration, opts PresignOptions) (string, error) {
+	bucket, err := s.buckets.bucketFor(ctx, key, false)
+	if err != nil {
+		return "", err
+	}
+
+	params := make(url.Values)
+	if opts.ContentDisposition != "" {
+		params.Set("response-content-disposition", opts.ContentDisposition)
+	}
+	if opts.ContentType != "" {
+		params.Set("response-content-type", opts.ContentType)
+	}
+
+	u, err := s.client.PresignedGetObject(ctx, bucket, key, expiresIn, params)
+	if err != nil {
+		s.log.Errorf("failed to generate presigned URL: %v", err)
+		return "", fmt.Errorf("failed to generate presigned URL: %w", err)
+	}
+	return u.String(), nil
+}
+
+// Exists checks if a file exists in storage
+func (s *S3Storage) Exists(ctx context.Context, key string) (bool, error) {
+	_, err := s.Stat(ctx, key)
+	if err != nil {
+		if errors.Is(err, ErrObjectNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// Stat returns information about a stored object
+func (s *S3Storage) Stat(ctx context.Context, key string) (*ObjectInfo, error) {
+	bucket, err := s.buckets.bucketFor(ctx, key, false)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := s.client.StatObject(ctx, bucket, key, minio.StatObjectOptions{})
+	if err != nil {
+		if code := minio.ToErrorResponse(err).Code; code == "NoSuchKey" || code == "NoSuchBucket" {
+			return nil, ErrObjectNotFound
+		}
+		s.log.Errorf("failed to stat object: %v", err)
+		return nil, fmt.Errorf("failed to stat object: %w", err)
+	}
+	return s3ObjectInfo(info), nil
+}
+
+// List lists objects whose keys start with prefix
+func (s *S3Storage) List(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	bucket, err := s.buckets.bucketForPrefix(prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var objects []ObjectInfo
+	for obj := range s.client.ListObjects(ctx, bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if obj.Err != nil {
+			if minio.ToErrorResponse(obj.Err).Code == "NoSuchBucket" {
+				return nil, nil
+			}
+			s.log.Errorf("failed to list objects: %v", obj.Err)
+			return nil, fmt.Errorf("failed to list objects: %w", obj.Err)
+		}
+		objects = append(objects, *s3ObjectInfo(obj))
+	}
+	return objects, nil
+}
+
+// ensureBucket creates bucket if it does not exist
+func (s *S3Storage) ensureBucket(ctx context.Context, bucket string) error {
+	exists, err := s.client.BucketExists(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+
+	if err = s.client.MakeBucket(ctx, bucket, minio.MakeBucketOptions{Region: s.region}); err != nil {
+		// Another replica may have created it concurrently
+		if code := minio.ToErrorResponse(err).Code; code == "BucketAlreadyOwnedByYou" || code == "BucketAlreadyExists" {
+			return nil
+		}
+		return err
+	}
+	s.log.Infof("created bucket: %s", bucket)
+	return nil
+}
+
+// s3ObjectInfo converts a MinIO object info to ObjectInfo
+func s3ObjectInfo(info minio.ObjectInfo) *ObjectInfo {
+	return &ObjectInfo{
+		Key:          info.Key,
+		Size:         info.Size,
+		ContentType:  info.ContentType,
+		LastModified: info.LastModified,
+		Checksum:     info.UserMetadata["Checksum"],
+		Metadata:     info.UserMetadata,
+	}
+}
@@ -0,0 +1,199 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+)
+
+const (
+	// s3MinPartSize is the smallest part S3 accepts (except for the last part)
+	s3MinPartSize = 5 << 20
+	// s3MaxParts is the maximum number of parts in a multipart upload
+	s3MaxParts = 10000
+	// s3MaxSinglePut is the largest object S3 accepts in a single PUT
+	s3MaxSinglePut = 5 << 30
+)
+
+// S3MultipartConfig controls when and how large objects are uploaded in parts
+type S3MultipartConfig struct {
+	// Threshold is the object size from which multipart upload is used
+	Threshold int64
+	// PartSize is the size of each part (grown automatically to stay within 10000 parts)
+	PartSize int64
+	// Retries is how often a failed part is retried before the upload is aborted
+	Retries int
+	// Concurrency is the number of parts uploaded in parallel
+	Concurrency int
+}
+
+// loadS3MultipartConfig reads S3_MULTIPART_THRESHOLD, S3_MULTIPART_PART_SIZE (bytes),
+// S3_MULTIPART_RETRIES and S3_MULTIPART_CONCURRENCY
+func loadS3MultipartConfig(env StorageEnv) (*S3MultipartConfig, error) {
+	cfg := &S3MultipartConfig{}
+
+	for _, opt := range []struct {
+		key    string
+		def    int64
+		min    int64
+		max    int64
+		target *int64
+	}{
+		{"S3_MULTIPART_THRESHOLD", 64 << 20, s3MinPartSize, s3MaxSinglePut, &cfg.Threshold},
+		{"S3_MULTIPART_PART_SIZE", 16 << 20, s3MinPartSize, s3MaxSinglePut, &cfg.PartSize},
+	} {
+		v, err := strconv.ParseInt(env.get(opt.key, strconv.FormatInt(opt.def, 10)), 10, 64)
+		if err != nil || v < opt.min || v > opt.max {
+			return nil, fmt.Errorf("%s must be between %d and %d bytes", env.name(opt.key), opt.min, opt.max)
+		}
+		*opt.target = v
+	}
+
+	retries, err := strconv.Atoi(env.get("S3_MULTIPART_RETRIES", "3"))
+	if err != nil || retries < 0 {
+		return nil, fmt.Errorf("invalid %s", env.name("S3_MULTIPART_RETRIES"))
+	}
+	cfg.Retries = retries
+
+	concurrency, err := strconv.Atoi(env.get("S3_MULTIPART_CONCURRENCY", "4"))
+	if err != nil || concurrency < 1 {
+		return nil, fmt.Errorf("invalid %s", env.name("S3_MULTIPART_CONCURRENCY"))
+	}
+	cfg.Concurrency = concurrency
+
+	return cfg, nil
+}
+
+// partSizeFor returns the part size for an object, keeping the part count within the S3 limit
+func (c *S3MultipartConfig) partSizeFor(size int64) int64 {
+	partSize := c.PartSize
+	if minSize := (size + s3MaxParts - 1) / s3MaxParts; partSize < minSize {
+		partSize = minSize
+	}
+	return partSize
+}
+
+// putMultipart uploads content in parts, retrying individual parts and aborting the
+// upload on failure so no incomplete parts are left behind in the bucket
+func (s *S3Storage) putMultipart(ctx context.Context, bucket, key string, content []byte, opts minio.PutObjectOptions) error {
+	core := minio.Core{Client: s.client}
+
+	uploadID, err := core.NewMultipartUpload(ctx, bucket, key, opts)
+	if err != nil {
+		return fmt.Errorf("initiate multipart upload: %w", err)
+	}
+
+	parts, err := s.uploadParts(ctx, core, bucket, key, uploadID, content)
+	if err == nil {
+		_, err = core.CompleteMultipartUpload(ctx, bucket, key, uploadID, parts, opts)
+		if err != nil {
+			err = fmt.Errorf("complete multipart upload: %w", err)
+		}
+	}
+	if err != nil {
+		// The request context may already be cancelled; the abort must still go out
+		abortCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		if abortErr := core.AbortMultipartUpload(abortCtx, bucket, key, uploadID); abortErr != nil {
+			s.log.Warnf("failed to abort multipart upload %s of %s: %v", uploadID, key, abortErr)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// uploadParts uploads all parts with bounded concurrency, stopping at the first part that exhausts its retries
+func (s *S3Storage) uploadParts(ctx context.Context, core minio.Core, bucket, key, uploadID string, content []byte) ([]minio.CompletePart, error) {
+	size := int64(len(content))
+	partSize := s.multipart.partSizeFor(size)
+	partCount := int((size + partSize - 1) / partSize)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		parts    = make([]minio.CompletePart, 0, partCount)
+		firstErr error
+		wg       sync.WaitGroup
+	)
+
+	jobs := make(chan int)
+	for w := 0; w < min(s.multipart.Concurrency, partCount); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for partNumber := range jobs {
+				start := int64(partNumber-1) * partSize
+				end := min(start+partSize, size)
+
+				part, err := s.uploadPart(ctx, core, bucket, key, uploadID, partNumber, content[start:end])
+
+				mu.Lock()
+				if err != nil {
+					if firstErr == nil {
+						firstErr = err
+						cancel()
+					}
+				} else {
+					parts = append(parts, minio.CompletePart{PartNumber: part.PartNumber, ETag: part.ETag})
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for partNumber := 1; partNumber <= partCount; partNumber++ {
+		select {
+		case jobs <- partNumber:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(parts, func(i, j int) bool { return parts[i].PartNumber < parts[j].PartNumber })
+	return parts, nil
+}
+
+// uploadPart uploads one part, retrying with linear backoff
+func (s *S3Storage) uploadPart(ctx context.Context, core minio.Core, bucket, key, uploadID string, partNumber int, data []byte) (minio.ObjectPart, error) {
+	var lastErr error
+	for attempt := 0; attempt <= s.multipart.Retries; attempt++ {
+		if attempt > 0 {
+			s.log.Warnf("retrying part %d of %s (attempt %d): %v", partNumber, key, attempt+1, lastErr)
+			select {
+			case <-time.After(time.Duration(attempt) * time.Second):
+			case <-ctx.Done():
+				return minio.ObjectPart{}, ctx.Err()
+			}
+		}
+
+		part, err := core.PutObjectPart(ctx, bucket, key, uploadID, partNumber, bytes.NewReader(data), int64(len(data)), minio.PutObjectPartOptions{})
+		if err == nil {
+			return part, nil
+		}
+		if ctx.Err() != nil {
+			return minio.ObjectPart{}, ctx.Err()
+		}
+		lastErr = err
+	}
+	return minio.ObjectPart{}, fmt.Errorf("upload part %d: %w", partNumber, lastErr)
+}
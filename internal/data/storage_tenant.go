@@ -0,0 +1,142 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// TenantIsolation controls how tenants' objects are separated in the storage backend
+type TenantIsolation string
+
+const (
+	// TenantIsolationPrefix stores all tenants in one bucket under {tenant_id}/ key prefixes
+	TenantIsolationPrefix TenantIsolation = "prefix"
+	// TenantIsolationBucket stores every tenant in its own bucket (or Azure container)
+	TenantIsolationBucket TenantIsolation = "bucket"
+)
+
+// defaultTenantBucketTemplate names per-tenant buckets when no template is configured
+const defaultTenantBucketTemplate = "{bucket}-{tenant_id}"
+
+// bucketNamePattern matches names valid both as S3 buckets and Azure containers
+var bucketNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$`)
+
+// tenantBuckets resolves the bucket an object key lives in and lazily provisions per-tenant buckets
+type tenantBuckets struct {
+	isolation TenantIsolation
+	template  string
+	base      string
+	ensure    func(ctx context.Context, bucket string) error
+
+	mu      sync.Mutex
+	ensured map[string]bool
+}
+
+// newTenantBuckets reads STORAGE_TENANT_ISOLATION and STORAGE_TENANT_BUCKET_TEMPLATE.
+// base is the shared bucket; ensure creates a bucket if it does not exist yet.
+func newTenantBuckets(env StorageEnv, base string, ensure func(ctx context.Context, bucket string) error) (*tenantBuckets, error) {
+	isolation := TenantIsolation(strings.ToLower(env.get("STORAGE_TENANT_ISOLATION", string(TenantIsolationPrefix))))
+	switch isolation {
+	case TenantIsolationPrefix, TenantIsolationBucket:
+	default:
+		return nil, fmt.Errorf("unknown tenant isolation mode %q", isolation)
+	}
+
+	t := &tenantBuckets{
+		isolation: isolation,
+		template:  env.get("STORAGE_TENANT_BUCKET_TEMPLATE", defaultTenantBucketTemplate),
+		base:      base,
+		ensure:    ensure,
+		ensured:   make(map[string]bool),
+	}
+
+	if isolation == TenantIsolationBucket {
+		if !strings.Contains(t.template, "{tenant_id}") {
+			return nil, fmt.Errorf("tenant bucket template %q must contain {tenant_id}", t.template)
+		}
+		if _, err := t.bucketName(1); err != nil {
+			return nil, err
+		}
+	}
+
+	return t, nil
+}
+
+// bucketFor returns the bucket holding key. With provision set, a missing per-tenant bucket is created.
+func (t *tenantBuckets) bucketFor(ctx context.Context, key string, provision bool) (string, error) {
+	tenantID, err := tenantFromKey(key)
+	if err != nil {
+		return "", err
+	}
+	if t.isolation != TenantIsolationBucket {
+		return t.base, nil
+	}
+
+	bucket, err := t.bucketName(tenantID)
+	if err != nil {
+		return "", err
+	}
+	if provision {
+		if err := t.provision(ctx, bucket); err != nil {
+			return "", err
+		}
+	}
+	return bucket, nil
+}
+
+// bucketForPrefix returns the bucket to list for a key prefix
+func (t *tenantBuckets) bucketForPrefix(prefix string) (string, error) {
+	if t.isolation != TenantIsolationBucket {
+		return t.base, nil
+	}
+	tenantID, err := tenantFromKey(prefix)
+	if err != nil {
+		return "", fmt.Errorf("listing with bucket-per-tenant isolation requires a {tenant_id}/ prefix: %w", err)
+	}
+	return t.bucketName(tenantID)
+}
+
+// bucketName renders the per-tenant bucket template
+func (t *tenantBuckets) bucketName(tenantID uint32) (string, error) {
+	name := strings.NewReplacer(
+		"{bucket}", t.base,
+		"{tenant_id}", strconv.FormatUint(uint64(tenantID), 10),
+	).Replace(t.template)
+
+	if !bucketNamePattern.MatchString(name) {
+		return "", fmt.Errorf("invalid tenant bucket name %q", name)
+	}
+	return name, nil
+}
+
+// provision creates a per-tenant bucket once per process
+func (t *tenantBuckets) provision(ctx context.Context, bucket string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.ensured[bucket] {
+		return nil
+	}
+	if err := t.ensure(ctx, bucket); err != nil {
+		return fmt.Errorf("failed to provision tenant bucket %s: %w", bucket, err)
+	}
+	t.ensured[bucket] = true
+	return nil
+}
+
+// tenantFromKey extracts the tenant ID from a {tenant_id}/... object key, rejecting keys outside the template
+func tenantFromKey(key string) (uint32, error) {
+	head, _, found := strings.Cut(key, "/")
+	if !found {
+		return 0, fmt.Errorf("storage key %q does not match {tenant_id}/...", key)
+	}
+	tenantID, err := strconv.ParseUint(head, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("storage key %q does not match {tenant_id}/...", key)
+	}
+	return uint32(tenantID), nil
+}
@@ -0,0 +1,51 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+)
+
+// errNoColdTier is returned when tiering is requested without PAPERLESS_COLD_STORAGE_DRIVER
+var errNoColdTier = errors.New("no cold storage tier configured")
+
+// HasColdTier reports whether a cold storage tier is configured
+func (r *StorageRouter) HasColdTier() bool {
+	return r.cold != nil
+}
+
+// MoveTier copies a document's object to the cold tier (cold) or back to the hot backend new
+// objects of its tenant go to (!cold) and verifies the copy. commit is called with the object's new key before
+// the source copy is removed, so the document never points at a deleted object.
+func (r *StorageRouter) MoveTier(ctx context.Context, doc *ent.Document, cold bool, commit func(key string) error) error {
+	if r.cold == nil {
+		return errNoColdTier
+	}
+
+	from, to := Storage(r), r.cold
+	if !cold {
+		from, to = r.cold, r.writeBackend(ctx, doc.FileKey)
+	}
+
+	key, _, err := MigrateObject(ctx, from, to, doc, false)
+	if err != nil {
+		return err
+	}
+	if err := commit(key); err != nil {
+		return err
+	}
+
+	sources := []Storage{r.cold}
+	if cold {
+		sources = r.ordered(ctx)
+	}
+	for _, backend := range sources {
+		if err := backend.Delete(ctx, doc.FileKey); err != nil {
+			// The document already points at the new copy; the leftover is collected as an orphan
+			return fmt.Errorf("remove source %s: %w", doc.FileKey, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,400 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documenttag"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/hook"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/tag"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// maxTagNameLength is the longest tag name; longer keys in a document's tags are not referenced
+const maxTagNameLength = 255
+
+// TagRepo manages a tenant's tags. A document's tags column holds tag names and values; the
+// references in paperless_document_tags are derived from it by syncDocumentTags.
+type TagRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewTagRepo creates a new TagRepo
+func NewTagRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *TagRepo {
+	return &TagRepo{
+		log:       ctx.NewLoggerHelper("paperless/tag_repo"),
+		entClient: entClient,
+	}
+}
+
+// Create creates a tag
+func (r *TagRepo) Create(ctx context.Context, tenantID uint32, name, color, description string, createdBy *uint32) (*ent.Tag, error) {
+	builder := clientFromContext(ctx, r.entClient).Tag.Create().
+		SetTenantID(tenantID).
+		SetName(name).
+		SetColor(color).
+		SetDescription(description).
+		SetCreateTime(time.Now())
+
+	if createdBy != nil {
+		builder.SetCreateBy(*createdBy)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, paperlessV1.ErrorTagAlreadyExists("tag %q already exists", name)
+		}
+		r.log.Errorf("create tag failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("create tag failed")
+	}
+	return entity, nil
+}
+
+// Get returns a tenant's tag, or nil if it does not exist
+func (r *TagRepo) Get(ctx context.Context, tenantID uint32, id uint32) (*ent.Tag, error) {
+	entity, err := clientFromContext(ctx, r.entClient).Tag.Query().
+		Where(
+			tag.IDEQ(id),
+			tag.TenantIDEQ(tenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get tag failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("get tag failed")
+	}
+	return entity, nil
+}
+
+// List lists a tenant's tags by name, optionally only those whose name contains query
+func (r *TagRepo) List(ctx context.Context, tenantID uint32, query string, page, pageSize uint32) ([]*ent.Tag, int, error) {
+	q := r.entClient.Client().Tag.Query().
+		Where(tag.TenantIDEQ(tenantID))
+	if query != "" {
+		q = q.Where(tag.NameContainsFold(query))
+	}
+
+	total, err := q.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count tags failed: %s", err.Error())
+		return nil, 0, paperlessV1.ErrorInternalServerError("count tags failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		q = q.Offset(int((page - 1) * pageSize)).Limit(int(pageSize))
+	}
+
+	entities, err := q.Order(ent.Asc(tag.FieldName)).All(ctx)
+	if err != nil {
+		r.log.Errorf("list tags failed: %s", err.Error())
+		return nil, 0, paperlessV1.ErrorInternalServerError("list tags failed")
+	}
+	return entities, total, nil
+}
+
+// ListByNames returns a tenant's tags with the given names; names without a tag are skipped
+func (r *TagRepo) ListByNames(ctx context.Context, tenantID uint32, names []string) ([]*ent.Tag, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	entities, err := r.entClient.Client().Tag.Query().
+		Where(
+			tag.TenantIDEQ(tenantID),
+			tag.NameIn(names...),
+		).
+		Order(ent.Asc(tag.FieldName)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list tags by name failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("list tags failed")
+	}
+	return entities, nil
+}
+
+// DocumentCounts returns the number of documents carrying each of the given tags
+func (r *TagRepo) DocumentCounts(ctx context.Context, ids []uint32) (map[uint32]int, error) {
+	counts := make(map[uint32]int, len(ids))
+	if len(ids) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		TagID uint32 `json:"tag_id"`
+		Count int    `json:"count"`
+	}
+	err := clientFromContext(ctx, r.entClient).DocumentTag.Query().
+		Where(documenttag.TagIDIn(ids...)).
+		GroupBy(documenttag.FieldTagID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		r.log.Errorf("count tagged documents failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("count tagged documents failed")
+	}
+
+	for _, row := range rows {
+		counts[row.TagID] = row.Count
+	}
+	return counts, nil
+}
+
+// Update updates the given fields of a tag. A rename is applied to the tags of every document
+// carrying the tag; the number of rewritten documents is returned.
+func (r *TagRepo) Update(ctx context.Context, entity *ent.Tag, name, color, description *string) (*ent.Tag, int, error) {
+	client := clientFromContext(ctx, r.entClient)
+	oldName := entity.Name
+
+	builder := client.Tag.UpdateOneID(entity.ID).
+		SetUpdateTime(time.Now())
+	if name != nil {
+		builder.SetName(*name)
+	}
+	if color != nil {
+		builder.SetColor(*color)
+	}
+	if description != nil {
+		builder.SetDescription(*description)
+	}
+
+	updated, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, 0, paperlessV1.ErrorTagAlreadyExists("tag %q already exists", *name)
+		}
+		r.log.Errorf("update tag failed: %s", err.Error())
+		return nil, 0, paperlessV1.ErrorInternalServerError("update tag failed")
+	}
+
+	if updated.Name == oldName {
+		return updated, 0, nil
+	}
+
+	count, err := r.rewriteDocumentTags(ctx, client, []uint32{entity.ID}, func(tags map[string]string) {
+		tags[updated.Name] = tags[oldName]
+		delete(tags, oldName)
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return updated, count, nil
+}
+
+// Delete removes a tag from every document carrying it and deletes the tag
+func (r *TagRepo) Delete(ctx context.Context, entity *ent.Tag) error {
+	client := clientFromContext(ctx, r.entClient)
+
+	if _, err := r.rewriteDocumentTags(ctx, client, []uint32{entity.ID}, func(tags map[string]string) {
+		delete(tags, entity.Name)
+	}); err != nil {
+		return err
+	}
+
+	if err := client.Tag.DeleteOneID(entity.ID).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return paperlessV1.ErrorTagNotFound("tag not found")
+		}
+		r.log.Errorf("delete tag failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("delete tag failed")
+	}
+	return nil
+}
+
+// Merge replaces the sources with the target on every document and deletes the sources. A
+// document keeps its value of the target; otherwise it takes the value of the first source it
+// carries, in the given order. The number of rewritten documents is returned.
+func (r *TagRepo) Merge(ctx context.Context, target *ent.Tag, sources []*ent.Tag) (int, error) {
+	client := clientFromContext(ctx, r.entClient)
+
+	sourceIDs := make([]uint32, 0, len(sources))
+	for _, source := range sources {
+		sourceIDs = append(sourceIDs, source.ID)
+	}
+
+	count, err := r.rewriteDocumentTags(ctx, client, sourceIDs, func(tags map[string]string) {
+		for _, source := range sources {
+			value, ok := tags[source.Name]
+			if !ok {
+				continue
+			}
+			if _, exists := tags[target.Name]; !exists {
+				tags[target.Name] = value
+			}
+			delete(tags, source.Name)
+		}
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := client.Tag.Delete().Where(tag.IDIn(sourceIDs...)).Exec(ctx); err != nil {
+		r.log.Errorf("delete merged tags failed: %s", err.Error())
+		return 0, paperlessV1.ErrorInternalServerError("delete merged tags failed")
+	}
+	return count, nil
+}
+
+// rewriteDocumentTags applies edit to the tags of every document, soft-deleted ones included,
+// that references one of the tags. Saving the tags column resyncs the document's references.
+// The number of documents whose tags changed is returned.
+func (r *TagRepo) rewriteDocumentTags(ctx context.Context, client *ent.Client, tagIDs []uint32, edit func(tags map[string]string)) (int, error) {
+	ctx = WithDeleted(ctx)
+
+	documentIDs, err := client.DocumentTag.Query().
+		Where(documenttag.TagIDIn(tagIDs...)).
+		Unique(true).
+		Select(documenttag.FieldDocumentID).
+		Strings(ctx)
+	if err != nil {
+		r.log.Errorf("query tagged documents failed: %s", err.Error())
+		return 0, paperlessV1.ErrorInternalServerError("query tagged documents failed")
+	}
+
+	changed := 0
+	for _, id := range documentIDs {
+		doc, err := client.Document.Get(ctx, id)
+		if err != nil {
+			r.log.Errorf("get tagged document failed: %s", err.Error())
+			return 0, paperlessV1.ErrorInternalServerError("get tagged document failed")
+		}
+
+		tags := maps.Clone(doc.Tags)
+		if tags == nil {
+			tags = map[string]string{}
+		}
+		edit(tags)
+		if maps.Equal(tags, doc.Tags) {
+			continue
+		}
+
+		if err := client.Document.UpdateOneID(id).
+			SetTags(tags).
+			SetUpdateTime(time.Now()).
+			Exec(ctx); err != nil {
+			r.log.Errorf("update document tags failed: %s", err.Error())
+			return 0, paperlessV1.ErrorInternalServerError("update document tags failed")
+		}
+		changed++
+	}
+	return changed, nil
+}
+
+// ToProto converts an ent.Tag to paperlessV1.Tag
+func (r *TagRepo) ToProto(entity *ent.Tag, documentCount int) *paperlessV1.Tag {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &paperlessV1.Tag{
+		Id:            entity.ID,
+		TenantId:      derefUint32(entity.TenantID),
+		Name:          entity.Name,
+		Color:         entity.Color,
+		Description:   entity.Description,
+		DocumentCount: uint32(documentCount),
+		CreatedBy:     entity.CreateBy,
+	}
+	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+	if entity.UpdateTime != nil && !entity.UpdateTime.IsZero() {
+		proto.UpdateTime = timestamppb.New(*entity.UpdateTime)
+	}
+	return proto
+}
+
+// syncDocumentTags replaces a document's tag references whenever its tags column is written.
+// Tags are created on first use, so clients can keep tagging documents with free-form names.
+func syncDocumentTags() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.DocumentFunc(func(ctx context.Context, m *ent.DocumentMutation) (ent.Value, error) {
+			tags, ok := m.Tags()
+			v, err := next.Mutate(ctx, m)
+			if err != nil || !ok {
+				return v, err
+			}
+
+			doc, isDoc := v.(*ent.Document)
+			if !isDoc {
+				return v, nil
+			}
+			if err := replaceDocumentTags(ctx, m.Client(), derefUint32(doc.TenantID), doc.ID, tags); err != nil {
+				return nil, fmt.Errorf("sync document tags: %w", err)
+			}
+			return v, nil
+		})
+	}, ent.OpCreate|ent.OpUpdateOne)
+}
+
+// replaceDocumentTags points a document's references at the tags named in tags, creating
+// missing tags. Empty names and names longer than maxTagNameLength are not referenced.
+func replaceDocumentTags(ctx context.Context, client *ent.Client, tenantID uint32, documentID string, tags map[string]string) error {
+	if _, err := client.DocumentTag.Delete().
+		Where(documenttag.DocumentIDEQ(documentID)).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(tags))
+	for name := range tags {
+		if name != "" && len(name) <= maxTagNameLength {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	// Insert in a stable order so concurrent writers lock the tag rows in the same order
+	slices.Sort(names)
+
+	now := time.Now()
+	creates := make([]*ent.TagCreate, 0, len(names))
+	for _, name := range names {
+		creates = append(creates, client.Tag.Create().
+			SetTenantID(tenantID).
+			SetName(name).
+			SetCreateTime(now))
+	}
+	if err := client.Tag.CreateBulk(creates...).
+		OnConflict(sql.ConflictColumns(tag.FieldTenantID, tag.FieldName)).
+		DoNothing().
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	existing, err := client.Tag.Query().
+		Where(
+			tag.TenantIDEQ(tenantID),
+			tag.NameIn(names...),
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	refs := make([]*ent.DocumentTagCreate, 0, len(existing))
+	for _, t := range existing {
+		refs = append(refs, client.DocumentTag.Create().
+			SetTenantID(tenantID).
+			SetDocumentID(documentID).
+			SetTagID(t.ID).
+			SetValue(tags[t.Name]))
+	}
+	return client.DocumentTag.CreateBulk(refs...).Exec(ctx)
+}
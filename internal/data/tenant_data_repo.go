@@ -0,0 +1,419 @@
+package data
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/accessibleresource"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/auditevent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/auditlog"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/category"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/categorydeletejob"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/document"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documentcontent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documenthistory"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documentpermission"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documenttag"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/groupmembership"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importconnector"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importedfile"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importmapping"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/notificationpreference"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/outboxevent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/reviewtask"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/signaturerequest"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/statisticsrollup"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/tag"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/tenantquota"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/tenantsettings"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/webhookdelivery"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/webhooksubscription"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// Stages of a tenant data deletion that have their own counters
+const (
+	TenantDataPermissions = "permissions"
+	TenantDataDocuments   = "documents"
+	TenantDataCategories  = "categories"
+)
+
+// tenantTable deletes one kind of a tenant's records
+type tenantTable struct {
+	stage string
+	count func(ctx context.Context, c *ent.Client, tenantID uint32) (int, error)
+	purge func(ctx context.Context, c *ent.Client, tenantID uint32, limit int) (int, error)
+}
+
+// tenantTables lists a tenant's records in the order they are deleted, rows referencing
+// others first. Tenant data keys are not listed: other replicas keep them cached, so they are
+// left in place.
+var tenantTables = []tenantTable{
+	{
+		stage: TenantDataPermissions,
+		count: func(ctx context.Context, c *ent.Client, tenantID uint32) (int, error) {
+			return c.DocumentPermission.Query().Where(documentpermission.TenantIDEQ(tenantID)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tenantID uint32, limit int) (int, error) {
+			ids, err := c.DocumentPermission.Query().Where(documentpermission.TenantIDEQ(tenantID)).Limit(limit).IDs(ctx)
+			if err != nil || len(ids) == 0 {
+				return 0, err
+			}
+			return c.DocumentPermission.Delete().Where(documentpermission.IDIn(ids...)).Exec(ctx)
+		},
+	},
+	{
+		stage: "access_index",
+		count: func(ctx context.Context, c *ent.Client, tenantID uint32) (int, error) {
+			return c.AccessibleResource.Query().Where(accessibleresource.TenantIDEQ(tenantID)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tenantID uint32, limit int) (int, error) {
+			ids, err := c.AccessibleResource.Query().Where(accessibleresource.TenantIDEQ(tenantID)).Limit(limit).IDs(ctx)
+			if err != nil || len(ids) == 0 {
+				return 0, err
+			}
+			return c.AccessibleResource.Delete().Where(accessibleresource.IDIn(ids...)).Exec(ctx)
+		},
+	},
+	{
+		stage: "review_tasks",
+		count: func(ctx context.Context, c *ent.Client, tenantID uint32) (int, error) {
+			return c.ReviewTask.Query().Where(reviewtask.TenantIDEQ(tenantID)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tenantID uint32, limit int) (int, error) {
+			ids, err := c.ReviewTask.Query().Where(reviewtask.TenantIDEQ(tenantID)).Limit(limit).IDs(ctx)
+			if err != nil || len(ids) == 0 {
+				return 0, err
+			}
+			return c.ReviewTask.Delete().Where(reviewtask.IDIn(ids...)).Exec(ctx)
+		},
+	},
+	{
+		stage: "signature_requests",
+		count: func(ctx context.Context, c *ent.Client, tenantID uint32) (int, error) {
+			return c.SignatureRequest.Query().Where(signaturerequest.TenantIDEQ(tenantID)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tenantID uint32, limit int) (int, error) {
+			ids, err := c.SignatureRequest.Query().Where(signaturerequest.TenantIDEQ(tenantID)).Limit(limit).IDs(ctx)
+			if err != nil || len(ids) == 0 {
+				return 0, err
+			}
+			return c.SignatureRequest.Delete().Where(signaturerequest.IDIn(ids...)).Exec(ctx)
+		},
+	},
+	{
+		stage: "document_tags",
+		count: func(ctx context.Context, c *ent.Client, tenantID uint32) (int, error) {
+			return c.DocumentTag.Query().Where(documenttag.TenantIDEQ(tenantID)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tenantID uint32, limit int) (int, error) {
+			ids, err := c.DocumentTag.Query().Where(documenttag.TenantIDEQ(tenantID)).Limit(limit).IDs(ctx)
+			if err != nil || len(ids) == 0 {
+				return 0, err
+			}
+			return c.DocumentTag.Delete().Where(documenttag.IDIn(ids...)).Exec(ctx)
+		},
+	},
+	{
+		stage: "document_history",
+		count: func(ctx context.Context, c *ent.Client, tenantID uint32) (int, error) {
+			return c.DocumentHistory.Query().Where(documenthistory.TenantIDEQ(tenantID)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tenantID uint32, limit int) (int, error) {
+			ids, err := c.DocumentHistory.Query().Where(documenthistory.TenantIDEQ(tenantID)).Limit(limit).IDs(ctx)
+			if err != nil || len(ids) == 0 {
+				return 0, err
+			}
+			return c.DocumentHistory.Delete().Where(documenthistory.IDIn(ids...)).Exec(ctx)
+		},
+	},
+	{
+		// The documents' extracted text, which full-text search reads
+		stage: "document_contents",
+		count: func(ctx context.Context, c *ent.Client, tenantID uint32) (int, error) {
+			return c.DocumentContent.Query().Where(documentcontent.TenantIDEQ(tenantID)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tenantID uint32, limit int) (int, error) {
+			ids, err := c.DocumentContent.Query().Where(documentcontent.TenantIDEQ(tenantID)).Limit(limit).IDs(ctx)
+			if err != nil || len(ids) == 0 {
+				return 0, err
+			}
+			return c.DocumentContent.Delete().Where(documentcontent.IDIn(ids...)).Exec(ctx)
+		},
+	},
+	{
+		stage: TenantDataDocuments,
+		count: func(ctx context.Context, c *ent.Client, tenantID uint32) (int, error) {
+			return c.Document.Query().Where(document.TenantIDEQ(tenantID)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tenantID uint32, limit int) (int, error) {
+			ids, err := c.Document.Query().Where(document.TenantIDEQ(tenantID)).Limit(limit).IDs(ctx)
+			if err != nil || len(ids) == 0 {
+				return 0, err
+			}
+			return c.Document.Delete().Where(document.IDIn(ids...)).Exec(ctx)
+		},
+	},
+	{
+		stage: TenantDataCategories,
+		count: func(ctx context.Context, c *ent.Client, tenantID uint32) (int, error) {
+			return c.Category.Query().Where(category.TenantIDEQ(tenantID)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tenantID uint32, limit int) (int, error) {
+			// Deepest first, so a category is a leaf when it is deleted
+			ids, err := c.Category.Query().
+				Where(category.TenantIDEQ(tenantID)).
+				Order(ent.Desc(category.FieldDepth), ent.Asc(category.FieldID)).
+				Limit(limit).
+				IDs(ctx)
+			if err != nil || len(ids) == 0 {
+				return 0, err
+			}
+			return c.Category.Delete().Where(category.IDIn(ids...)).Exec(ctx)
+		},
+	},
+	{
+		stage: "tags",
+		count: func(ctx context.Context, c *ent.Client, tenantID uint32) (int, error) {
+			return c.Tag.Query().Where(tag.TenantIDEQ(tenantID)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tenantID uint32, limit int) (int, error) {
+			ids, err := c.Tag.Query().Where(tag.TenantIDEQ(tenantID)).Limit(limit).IDs(ctx)
+			if err != nil || len(ids) == 0 {
+				return 0, err
+			}
+			return c.Tag.Delete().Where(tag.IDIn(ids...)).Exec(ctx)
+		},
+	},
+	{
+		stage: "group_memberships",
+		count: func(ctx context.Context, c *ent.Client, tenantID uint32) (int, error) {
+			return c.GroupMembership.Query().Where(groupmembership.TenantIDEQ(tenantID)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tenantID uint32, limit int) (int, error) {
+			ids, err := c.GroupMembership.Query().Where(groupmembership.TenantIDEQ(tenantID)).Limit(limit).IDs(ctx)
+			if err != nil || len(ids) == 0 {
+				return 0, err
+			}
+			return c.GroupMembership.Delete().Where(groupmembership.IDIn(ids...)).Exec(ctx)
+		},
+	},
+	{
+		stage: "imported_files",
+		count: func(ctx context.Context, c *ent.Client, tenantID uint32) (int, error) {
+			return c.ImportedFile.Query().Where(importedfile.TenantIDEQ(tenantID)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tenantID uint32, limit int) (int, error) {
+			ids, err := c.ImportedFile.Query().Where(importedfile.TenantIDEQ(tenantID)).Limit(limit).IDs(ctx)
+			if err != nil || len(ids) == 0 {
+				return 0, err
+			}
+			return c.ImportedFile.Delete().Where(importedfile.IDIn(ids...)).Exec(ctx)
+		},
+	},
+	{
+		stage: "import_mappings",
+		count: func(ctx context.Context, c *ent.Client, tenantID uint32) (int, error) {
+			return c.ImportMapping.Query().Where(importmapping.TenantIDEQ(tenantID)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tenantID uint32, limit int) (int, error) {
+			ids, err := c.ImportMapping.Query().Where(importmapping.TenantIDEQ(tenantID)).Limit(limit).IDs(ctx)
+			if err != nil || len(ids) == 0 {
+				return 0, err
+			}
+			return c.ImportMapping.Delete().Where(importmapping.IDIn(ids...)).Exec(ctx)
+		},
+	},
+	{
+		stage: "import_connectors",
+		count: func(ctx context.Context, c *ent.Client, tenantID uint32) (int, error) {
+			return c.ImportConnector.Query().Where(importconnector.TenantIDEQ(tenantID)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tenantID uint32, limit int) (int, error) {
+			ids, err := c.ImportConnector.Query().Where(importconnector.TenantIDEQ(tenantID)).Limit(limit).IDs(ctx)
+			if err != nil || len(ids) == 0 {
+				return 0, err
+			}
+			return c.ImportConnector.Delete().Where(importconnector.IDIn(ids...)).Exec(ctx)
+		},
+	},
+	{
+		stage: "notification_preferences",
+		count: func(ctx context.Context, c *ent.Client, tenantID uint32) (int, error) {
+			return c.NotificationPreference.Query().Where(notificationpreference.TenantIDEQ(tenantID)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tenantID uint32, limit int) (int, error) {
+			ids, err := c.NotificationPreference.Query().Where(notificationpreference.TenantIDEQ(tenantID)).Limit(limit).IDs(ctx)
+			if err != nil || len(ids) == 0 {
+				return 0, err
+			}
+			return c.NotificationPreference.Delete().Where(notificationpreference.IDIn(ids...)).Exec(ctx)
+		},
+	},
+	{
+		stage: "webhook_deliveries",
+		count: func(ctx context.Context, c *ent.Client, tenantID uint32) (int, error) {
+			return c.WebhookDelivery.Query().Where(webhookdelivery.TenantIDEQ(tenantID)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tenantID uint32, limit int) (int, error) {
+			ids, err := c.WebhookDelivery.Query().Where(webhookdelivery.TenantIDEQ(tenantID)).Limit(limit).IDs(ctx)
+			if err != nil || len(ids) == 0 {
+				return 0, err
+			}
+			return c.WebhookDelivery.Delete().Where(webhookdelivery.IDIn(ids...)).Exec(ctx)
+		},
+	},
+	{
+		stage: "webhook_subscriptions",
+		count: func(ctx context.Context, c *ent.Client, tenantID uint32) (int, error) {
+			return c.WebhookSubscription.Query().Where(webhooksubscription.TenantIDEQ(tenantID)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tenantID uint32, limit int) (int, error) {
+			ids, err := c.WebhookSubscription.Query().Where(webhooksubscription.TenantIDEQ(tenantID)).Limit(limit).IDs(ctx)
+			if err != nil || len(ids) == 0 {
+				return 0, err
+			}
+			return c.WebhookSubscription.Delete().Where(webhooksubscription.IDIn(ids...)).Exec(ctx)
+		},
+	},
+	{
+		stage: "outbox_events",
+		count: func(ctx context.Context, c *ent.Client, tenantID uint32) (int, error) {
+			return c.OutboxEvent.Query().Where(outboxevent.TenantIDEQ(tenantID)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tenantID uint32, limit int) (int, error) {
+			ids, err := c.OutboxEvent.Query().Where(outboxevent.TenantIDEQ(tenantID)).Limit(limit).IDs(ctx)
+			if err != nil || len(ids) == 0 {
+				return 0, err
+			}
+			return c.OutboxEvent.Delete().Where(outboxevent.IDIn(ids...)).Exec(ctx)
+		},
+	},
+	{
+		stage: "category_delete_jobs",
+		count: func(ctx context.Context, c *ent.Client, tenantID uint32) (int, error) {
+			return c.CategoryDeleteJob.Query().Where(categorydeletejob.TenantIDEQ(tenantID)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tenantID uint32, limit int) (int, error) {
+			ids, err := c.CategoryDeleteJob.Query().Where(categorydeletejob.TenantIDEQ(tenantID)).Limit(limit).IDs(ctx)
+			if err != nil || len(ids) == 0 {
+				return 0, err
+			}
+			return c.CategoryDeleteJob.Delete().Where(categorydeletejob.IDIn(ids...)).Exec(ctx)
+		},
+	},
+	{
+		stage: "audit_events",
+		count: func(ctx context.Context, c *ent.Client, tenantID uint32) (int, error) {
+			return c.AuditEvent.Query().Where(auditevent.TenantIDEQ(tenantID)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tenantID uint32, limit int) (int, error) {
+			ids, err := c.AuditEvent.Query().Where(auditevent.TenantIDEQ(tenantID)).Limit(limit).IDs(ctx)
+			if err != nil || len(ids) == 0 {
+				return 0, err
+			}
+			return c.AuditEvent.Delete().Where(auditevent.IDIn(ids...)).Exec(ctx)
+		},
+	},
+	{
+		stage: "audit_logs",
+		count: func(ctx context.Context, c *ent.Client, tenantID uint32) (int, error) {
+			return c.AuditLog.Query().Where(auditlog.TenantIDEQ(tenantID)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tenantID uint32, limit int) (int, error) {
+			ids, err := c.AuditLog.Query().Where(auditlog.TenantIDEQ(tenantID)).Limit(limit).IDs(ctx)
+			if err != nil || len(ids) == 0 {
+				return 0, err
+			}
+			return c.AuditLog.Delete().Where(auditlog.IDIn(ids...)).Exec(ctx)
+		},
+	},
+	{
+		stage: "statistics_rollups",
+		count: func(ctx context.Context, c *ent.Client, tenantID uint32) (int, error) {
+			return c.StatisticsRollup.Query().
+				Where(statisticsrollup.TenantIDEQ(tenantID), statisticsrollup.AllTenants(false)).
+				Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tenantID uint32, limit int) (int, error) {
+			return c.StatisticsRollup.Delete().
+				Where(statisticsrollup.TenantIDEQ(tenantID), statisticsrollup.AllTenants(false)).
+				Exec(ctx)
+		},
+	},
+	{
+		stage: "tenant_quotas",
+		count: func(ctx context.Context, c *ent.Client, tenantID uint32) (int, error) {
+			return c.TenantQuota.Query().Where(tenantquota.TenantIDEQ(tenantID)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tenantID uint32, limit int) (int, error) {
+			return c.TenantQuota.Delete().Where(tenantquota.TenantIDEQ(tenantID)).Exec(ctx)
+		},
+	},
+	{
+		stage: "tenant_settings",
+		count: func(ctx context.Context, c *ent.Client, tenantID uint32) (int, error) {
+			return c.TenantSettings.Query().Where(tenantsettings.TenantIDEQ(tenantID)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tenantID uint32, limit int) (int, error) {
+			return c.TenantSettings.Delete().Where(tenantsettings.TenantIDEQ(tenantID)).Exec(ctx)
+		},
+	},
+}
+
+// TenantDataRepo deletes all records of a tenant, for offboarding
+type TenantDataRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewTenantDataRepo creates a new TenantDataRepo
+func NewTenantDataRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *TenantDataRepo {
+	return &TenantDataRepo{
+		log:       ctx.NewLoggerHelper("paperless/tenant_data/repo"),
+		entClient: entClient,
+	}
+}
+
+// Count returns how many records of the tenant a deletion removes, trashed documents included
+func (r *TenantDataRepo) Count(ctx context.Context, tenantID uint32) (int64, error) {
+	ctx = WithDeleted(ctx)
+	client := clientFromContext(ctx, r.entClient)
+
+	var total int64
+	for _, t := range tenantTables {
+		n, err := t.count(ctx, client, tenantID)
+		if err != nil {
+			r.log.Errorf("count tenant %d %s failed: %s", tenantID, t.stage, err.Error())
+			return 0, paperlessV1.ErrorInternalServerError("count tenant data failed")
+		}
+		total += int64(n)
+	}
+	return total, nil
+}
+
+// PurgeNext deletes up to limit records of the first kind the tenant still has and returns
+// the kind and how many were deleted. It returns 0 once no records are left, so repeated
+// calls pick up where an interrupted deletion stopped.
+func (r *TenantDataRepo) PurgeNext(ctx context.Context, tenantID uint32, limit int) (string, int, error) {
+	ctx = WithDeleted(ctx)
+	client := clientFromContext(ctx, r.entClient)
+
+	for _, t := range tenantTables {
+		n, err := t.purge(ctx, client, tenantID, limit)
+		if err != nil {
+			r.log.Errorf("delete tenant %d %s failed: %s", tenantID, t.stage, err.Error())
+			return t.stage, 0, paperlessV1.ErrorInternalServerError("delete tenant data failed")
+		}
+		if n > 0 {
+			return t.stage, n, nil
+		}
+	}
+	return "", 0, nil
+}
@@ -0,0 +1,247 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/tenantdeletejob"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// TenantDeleteProgress counts the work done by a tenant data deletion
+type TenantDeleteProgress struct {
+	Stage              string
+	Total              int64
+	Processed          int64
+	DocumentsDeleted   int64
+	CategoriesDeleted  int64
+	PermissionsDeleted int64
+	RecordsDeleted     int64
+	ObjectsDeleted     int64
+	BytesDeleted       int64
+}
+
+// TenantDeleteJobRepo stores background deletions of tenant data
+type TenantDeleteJobRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	ids       *IDGenerator
+	log       *log.Helper
+}
+
+// NewTenantDeleteJobRepo creates a new TenantDeleteJobRepo
+func NewTenantDeleteJobRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], ids *IDGenerator) *TenantDeleteJobRepo {
+	return &TenantDeleteJobRepo{
+		log:       ctx.NewLoggerHelper("paperless/tenant_delete_job/repo"),
+		entClient: entClient,
+		ids:       ids,
+	}
+}
+
+// Create queues a deletion of the tenant's data
+func (r *TenantDeleteJobRepo) Create(ctx context.Context, tenantID uint32, total int64, createdBy *uint32) (*ent.TenantDeleteJob, error) {
+	builder := r.entClient.Client().TenantDeleteJob.Create().
+		SetID(r.ids.New()).
+		SetTenantID(tenantID).
+		SetTotalItems(total).
+		SetCreateTime(time.Now())
+
+	if createdBy != nil {
+		builder.SetCreateBy(*createdBy)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("create tenant delete job failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("create tenant delete job failed")
+	}
+	return entity, nil
+}
+
+// Get returns a job, or nil if there is none
+func (r *TenantDeleteJobRepo) Get(ctx context.Context, id string) (*ent.TenantDeleteJob, error) {
+	entity, err := r.entClient.Client().TenantDeleteJob.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get tenant delete job failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("get tenant delete job failed")
+	}
+	return entity, nil
+}
+
+// GetActive returns the pending or running job of a tenant, or nil if there is none
+func (r *TenantDeleteJobRepo) GetActive(ctx context.Context, tenantID uint32) (*ent.TenantDeleteJob, error) {
+	entity, err := r.entClient.Client().TenantDeleteJob.Query().
+		Where(
+			tenantdeletejob.TenantIDEQ(tenantID),
+			tenantdeletejob.StatusIn(
+				tenantdeletejob.StatusTENANT_DELETE_JOB_STATUS_PENDING,
+				tenantdeletejob.StatusTENANT_DELETE_JOB_STATUS_RUNNING,
+			),
+		).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get active tenant delete job failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("get tenant delete job failed")
+	}
+	return entity, nil
+}
+
+// ClaimNext returns the oldest job that is pending or whose worker's lease ran out, leased to
+// the caller until now+lease, or nil if there is none. A job is only claimed by one worker.
+func (r *TenantDeleteJobRepo) ClaimNext(ctx context.Context, now time.Time, lease time.Duration) (*ent.TenantDeleteJob, error) {
+	candidates, err := r.entClient.Client().TenantDeleteJob.Query().
+		Where(
+			tenantdeletejob.StatusIn(
+				tenantdeletejob.StatusTENANT_DELETE_JOB_STATUS_PENDING,
+				tenantdeletejob.StatusTENANT_DELETE_JOB_STATUS_RUNNING,
+			),
+			tenantdeletejob.Or(
+				tenantdeletejob.LeaseUntilIsNil(),
+				tenantdeletejob.LeaseUntilLTE(now),
+			),
+		).
+		Order(ent.Asc(tenantdeletejob.FieldCreateTime)).
+		Limit(5).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list tenant delete jobs failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("list tenant delete jobs failed")
+	}
+
+	for _, job := range candidates {
+		update := r.entClient.Client().TenantDeleteJob.Update().
+			Where(tenantdeletejob.IDEQ(job.ID), tenantdeletejob.StatusEQ(job.Status)).
+			SetStatus(tenantdeletejob.StatusTENANT_DELETE_JOB_STATUS_RUNNING).
+			SetLeaseUntil(now.Add(lease)).
+			SetUpdateTime(now)
+		if job.LeaseUntil == nil {
+			update.Where(tenantdeletejob.LeaseUntilIsNil())
+		} else {
+			update.Where(tenantdeletejob.LeaseUntilEQ(*job.LeaseUntil))
+		}
+
+		n, err := update.Save(ctx)
+		if err != nil {
+			r.log.Errorf("claim tenant delete job %s failed: %s", job.ID, err.Error())
+			continue
+		}
+		if n == 1 {
+			job.Status = tenantdeletejob.StatusTENANT_DELETE_JOB_STATUS_RUNNING
+			return job, nil
+		}
+	}
+	return nil, nil
+}
+
+// Progress returns the progress recorded on a job
+func (r *TenantDeleteJobRepo) Progress(job *ent.TenantDeleteJob) *TenantDeleteProgress {
+	return &TenantDeleteProgress{
+		Stage:              job.Stage,
+		Total:              job.TotalItems,
+		Processed:          job.ProcessedItems,
+		DocumentsDeleted:   job.DocumentsDeleted,
+		CategoriesDeleted:  job.CategoriesDeleted,
+		PermissionsDeleted: job.PermissionsDeleted,
+		RecordsDeleted:     job.RecordsDeleted,
+		ObjectsDeleted:     job.ObjectsDeleted,
+		BytesDeleted:       job.BytesDeleted,
+	}
+}
+
+// UpdateProgress records the progress of a running job and extends its lease
+func (r *TenantDeleteJobRepo) UpdateProgress(ctx context.Context, id string, progress *TenantDeleteProgress, leaseUntil time.Time) error {
+	err := r.entClient.Client().TenantDeleteJob.UpdateOneID(id).
+		SetStage(progress.Stage).
+		SetProcessedItems(progress.Processed).
+		SetDocumentsDeleted(progress.DocumentsDeleted).
+		SetCategoriesDeleted(progress.CategoriesDeleted).
+		SetPermissionsDeleted(progress.PermissionsDeleted).
+		SetRecordsDeleted(progress.RecordsDeleted).
+		SetObjectsDeleted(progress.ObjectsDeleted).
+		SetBytesDeleted(progress.BytesDeleted).
+		SetLeaseUntil(leaseUntil).
+		SetUpdateTime(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("update tenant delete job %s failed: %s", id, err.Error())
+		return paperlessV1.ErrorInternalServerError("update tenant delete job failed")
+	}
+	return nil
+}
+
+// Finish records the final progress of a job and marks it succeeded, or failed with errMsg
+func (r *TenantDeleteJobRepo) Finish(ctx context.Context, id string, progress *TenantDeleteProgress, errMsg string) error {
+	now := time.Now()
+	status := tenantdeletejob.StatusTENANT_DELETE_JOB_STATUS_SUCCEEDED
+	if errMsg != "" {
+		status = tenantdeletejob.StatusTENANT_DELETE_JOB_STATUS_FAILED
+	}
+
+	err := r.entClient.Client().TenantDeleteJob.UpdateOneID(id).
+		SetStatus(status).
+		SetError(truncateError(errMsg)).
+		SetStage(progress.Stage).
+		SetProcessedItems(progress.Processed).
+		SetDocumentsDeleted(progress.DocumentsDeleted).
+		SetCategoriesDeleted(progress.CategoriesDeleted).
+		SetPermissionsDeleted(progress.PermissionsDeleted).
+		SetRecordsDeleted(progress.RecordsDeleted).
+		SetObjectsDeleted(progress.ObjectsDeleted).
+		SetBytesDeleted(progress.BytesDeleted).
+		ClearLeaseUntil().
+		SetFinishedAt(now).
+		SetUpdateTime(now).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("finish tenant delete job %s failed: %s", id, err.Error())
+		return paperlessV1.ErrorInternalServerError("finish tenant delete job failed")
+	}
+	return nil
+}
+
+// ToProto converts an ent.TenantDeleteJob to paperlessV1.TenantDeleteJob
+func (r *TenantDeleteJobRepo) ToProto(entity *ent.TenantDeleteJob) *paperlessV1.TenantDeleteJob {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &paperlessV1.TenantDeleteJob{
+		Id:                 entity.ID,
+		TenantId:           entity.TenantID,
+		Status:             paperlessV1.TenantDeleteJobStatus(paperlessV1.TenantDeleteJobStatus_value[string(entity.Status)]),
+		Stage:              entity.Stage,
+		TotalItems:         entity.TotalItems,
+		ProcessedItems:     entity.ProcessedItems,
+		DocumentsDeleted:   entity.DocumentsDeleted,
+		CategoriesDeleted:  entity.CategoriesDeleted,
+		PermissionsDeleted: entity.PermissionsDeleted,
+		RecordsDeleted:     entity.RecordsDeleted,
+		ObjectsDeleted:     entity.ObjectsDeleted,
+		BytesDeleted:       entity.BytesDeleted,
+		Error:              entity.Error,
+		CreatedBy:          entity.CreateBy,
+	}
+	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+	if entity.UpdateTime != nil && !entity.UpdateTime.IsZero() {
+		proto.UpdateTime = timestamppb.New(*entity.UpdateTime)
+	}
+	if entity.FinishedAt != nil {
+		proto.FinishedAt = timestamppb.New(*entity.FinishedAt)
+	}
+	return proto
+}
@@ -0,0 +1,71 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/tenantkey"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// TenantKeyRepo stores wrapped per-tenant data keys
+type TenantKeyRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewTenantKeyRepo creates a new TenantKeyRepo
+func NewTenantKeyRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *TenantKeyRepo {
+	return &TenantKeyRepo{
+		log:       ctx.NewLoggerHelper("paperless/tenant_key_repo"),
+		entClient: entClient,
+	}
+}
+
+// Get returns the tenant's wrapped data key, or nil if none exists yet
+func (r *TenantKeyRepo) Get(ctx context.Context, tenantID uint32) (*ent.TenantKey, error) {
+	entity, err := r.entClient.Client().TenantKey.Query().
+		Where(tenantkey.TenantIDEQ(tenantID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("query tenant key failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("query tenant key failed")
+	}
+	return entity, nil
+}
+
+// CreateIfMissing stores a wrapped data key unless the tenant already has one,
+// and returns the key that is stored afterwards (which may have been created concurrently)
+func (r *TenantKeyRepo) CreateIfMissing(ctx context.Context, tenantID uint32, wrappedKey []byte, masterKeyID string) (*ent.TenantKey, error) {
+	err := r.entClient.Client().TenantKey.Create().
+		SetTenantID(tenantID).
+		SetWrappedKey(wrappedKey).
+		SetMasterKeyID(masterKeyID).
+		OnConflictColumns(tenantkey.FieldTenantID).
+		DoNothing().
+		Exec(ctx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		r.log.Errorf("create tenant key failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("create tenant key failed")
+	}
+
+	entity, err := r.Get(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, paperlessV1.ErrorInternalServerError("create tenant key failed")
+	}
+	return entity, nil
+}
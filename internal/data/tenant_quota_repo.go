@@ -0,0 +1,241 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/document"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/tenantquota"
+	"github.com/go-tangra/go-tangra-paperless/internal/errdetail"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// TenantUsage is what a tenant's quota is checked against
+type TenantUsage struct {
+	Documents        int64
+	Bytes            int64
+	Month            string
+	MonthUploadBytes int64
+}
+
+// quotaMonth returns the calendar month monthly limits count uploads in, e.g. "2026-10"
+func quotaMonth(t time.Time) string {
+	return t.UTC().Format("2006-01")
+}
+
+// TenantQuotaRepo stores the quotas of tenants and counts their uploads per month
+type TenantQuotaRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewTenantQuotaRepo creates a new TenantQuotaRepo
+func NewTenantQuotaRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *TenantQuotaRepo {
+	return &TenantQuotaRepo{
+		log:       ctx.NewLoggerHelper("paperless/tenant_quota/repo"),
+		entClient: entClient,
+	}
+}
+
+// Get returns a tenant's quota, or nil if the tenant has neither limits nor uploads yet
+func (r *TenantQuotaRepo) Get(ctx context.Context, tenantID uint32) (*ent.TenantQuota, error) {
+	entity, err := clientFromContext(ctx, r.entClient).TenantQuota.Query().
+		Where(tenantquota.TenantIDEQ(tenantID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get tenant quota failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("get tenant quota failed")
+	}
+	return entity, nil
+}
+
+// Set changes a tenant's limits. A nil limit stays as it is and 0 removes it.
+func (r *TenantQuotaRepo) Set(ctx context.Context, tenantID uint32, maxDocuments, maxBytes, maxMonthlyUploadBytes *int64) (*ent.TenantQuota, error) {
+	if err := r.ensure(ctx, tenantID); err != nil {
+		return nil, err
+	}
+
+	builder := clientFromContext(ctx, r.entClient).TenantQuota.Update().
+		Where(tenantquota.TenantIDEQ(tenantID)).
+		SetUpdateTime(time.Now())
+
+	if maxDocuments != nil {
+		if *maxDocuments > 0 {
+			builder.SetMaxDocuments(*maxDocuments)
+		} else {
+			builder.ClearMaxDocuments()
+		}
+	}
+	if maxBytes != nil {
+		if *maxBytes > 0 {
+			builder.SetMaxBytes(*maxBytes)
+		} else {
+			builder.ClearMaxBytes()
+		}
+	}
+	if maxMonthlyUploadBytes != nil {
+		if *maxMonthlyUploadBytes > 0 {
+			builder.SetMaxMonthlyUploadBytes(*maxMonthlyUploadBytes)
+		} else {
+			builder.ClearMaxMonthlyUploadBytes()
+		}
+	}
+
+	if err := builder.Exec(ctx); err != nil {
+		r.log.Errorf("update tenant quota failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("update tenant quota failed")
+	}
+	return r.Get(ctx, tenantID)
+}
+
+// Usage returns a tenant's current usage. Documents in the trash count, since they still take
+// up storage and can be restored.
+func (r *TenantQuotaRepo) Usage(ctx context.Context, tenantID uint32) (*TenantUsage, error) {
+	quota, err := r.Get(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	usage, err := r.documentUsage(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	usage.Month = quotaMonth(time.Now())
+	if quota != nil && quota.UploadMonth == usage.Month {
+		usage.MonthUploadBytes = quota.MonthUploadBytes
+	}
+	return usage, nil
+}
+
+// Reserve fails with TENANT_QUOTA_EXCEEDED if storing a document of the given size would take
+// the tenant over one of its limits, and otherwise counts the upload towards the month. The
+// tenant's quota is locked until the transaction ends, so concurrent uploads are checked one
+// after another and the count is rolled back with a failed upload.
+func (r *TenantQuotaRepo) Reserve(ctx context.Context, tenantID uint32, bytes int64) error {
+	if err := r.ensure(ctx, tenantID); err != nil {
+		return err
+	}
+
+	client := clientFromContext(ctx, r.entClient)
+	quota, err := client.TenantQuota.Query().
+		Where(tenantquota.TenantIDEQ(tenantID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		r.log.Errorf("lock tenant quota failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("check tenant quota failed")
+	}
+
+	month := quotaMonth(time.Now())
+	var monthBytes int64
+	if quota.UploadMonth == month {
+		monthBytes = quota.MonthUploadBytes
+	}
+
+	if quota.MaxDocuments != nil || quota.MaxBytes != nil {
+		usage, err := r.documentUsage(ctx, tenantID)
+		if err != nil {
+			return err
+		}
+		if quota.MaxDocuments != nil && usage.Documents+1 > *quota.MaxDocuments {
+			return errdetail.With(paperlessV1.ErrorTenantQuotaExceeded("the tenant holds at most %d documents", *quota.MaxDocuments),
+				errdetail.KeyTenantQuotaDocuments, "", "max", *quota.MaxDocuments)
+		}
+		if quota.MaxBytes != nil && usage.Bytes+bytes > *quota.MaxBytes {
+			return errdetail.With(paperlessV1.ErrorTenantQuotaExceeded("the tenant's documents take at most %d bytes, %d are in use", *quota.MaxBytes, usage.Bytes),
+				errdetail.KeyTenantQuotaBytes, "", "max", *quota.MaxBytes, "used", usage.Bytes)
+		}
+	}
+	if quota.MaxMonthlyUploadBytes != nil && monthBytes+bytes > *quota.MaxMonthlyUploadBytes {
+		return errdetail.With(paperlessV1.ErrorTenantQuotaExceeded("the tenant uploads at most %d bytes per month, %d were uploaded in %s", *quota.MaxMonthlyUploadBytes, monthBytes, month),
+			errdetail.KeyTenantQuotaMonthlyUploads, "",
+			"max", *quota.MaxMonthlyUploadBytes, "used", monthBytes, "month", month)
+	}
+
+	err = client.TenantQuota.UpdateOneID(quota.ID).
+		SetUploadMonth(month).
+		SetMonthUploadBytes(monthBytes + bytes).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("count tenant upload failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("check tenant quota failed")
+	}
+	return nil
+}
+
+// ensure creates a tenant's quota row without limits if it does not exist yet
+func (r *TenantQuotaRepo) ensure(ctx context.Context, tenantID uint32) error {
+	err := clientFromContext(ctx, r.entClient).TenantQuota.Create().
+		SetTenantID(tenantID).
+		SetCreateTime(time.Now()).
+		OnConflictColumns(tenantquota.FieldTenantID).
+		Ignore().
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("create tenant quota failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("create tenant quota failed")
+	}
+	return nil
+}
+
+// documentUsage counts a tenant's documents, trashed ones included, and their total size
+func (r *TenantQuotaRepo) documentUsage(ctx context.Context, tenantID uint32) (*TenantUsage, error) {
+	var rows []struct {
+		Count int64         `json:"count"`
+		Sum   sql.NullInt64 `json:"sum"`
+	}
+	ctx = WithDeleted(ctx)
+	err := clientFromContext(ctx, r.entClient).Document.Query().
+		Where(document.TenantIDEQ(tenantID)).
+		Aggregate(ent.Count(), ent.Sum(document.FieldFileSize)).
+		Scan(ctx, &rows)
+	if err != nil {
+		r.log.Errorf("get tenant usage failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("get tenant usage failed")
+	}
+
+	usage := &TenantUsage{}
+	if len(rows) > 0 {
+		usage.Documents = rows[0].Count
+		usage.Bytes = rows[0].Sum.Int64
+	}
+	return usage, nil
+}
+
+// ToProto converts a tenant's quota, which may be nil, and usage to paperlessV1.TenantQuota
+func (r *TenantQuotaRepo) ToProto(tenantID uint32, entity *ent.TenantQuota, usage *TenantUsage) *paperlessV1.TenantQuota {
+	proto := &paperlessV1.TenantQuota{
+		TenantId: tenantID,
+	}
+	if usage != nil {
+		proto.Usage = &paperlessV1.TenantQuotaUsage{
+			Documents:        usage.Documents,
+			Bytes:            usage.Bytes,
+			Month:            usage.Month,
+			MonthUploadBytes: usage.MonthUploadBytes,
+		}
+	}
+	if entity == nil {
+		return proto
+	}
+
+	proto.MaxDocuments = entity.MaxDocuments
+	proto.MaxBytes = entity.MaxBytes
+	proto.MaxMonthlyUploadBytes = entity.MaxMonthlyUploadBytes
+	if entity.UpdateTime != nil && !entity.UpdateTime.IsZero() {
+		proto.UpdateTime = timestamppb.New(*entity.UpdateTime)
+	}
+	return proto
+}
@@ -0,0 +1,69 @@
+package data
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/tx7do/go-crud/viewer"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+)
+
+// tenantViewer is the ent privacy viewer of a tenant user. The TenantID mixin's policy limits
+// its queries to the tenant's rows and stamps the tenant on created rows; scopeTenantMutations
+// does the same for updates and deletes.
+type tenantViewer struct {
+	tenantID uint32
+	userID   uint64
+}
+
+// NewTenantViewerContext returns a context that can only read and write rows of tenantID
+func NewTenantViewerContext(ctx context.Context, tenantID uint32, userID uint64) context.Context {
+	return viewer.WithContext(ctx, tenantViewer{tenantID: tenantID, userID: userID})
+}
+
+func (v tenantViewer) UserID() uint64                 { return v.userID }
+func (v tenantViewer) TenantID() uint64               { return uint64(v.tenantID) }
+func (v tenantViewer) OrgUnitID() uint64              { return 0 }
+func (v tenantViewer) Permissions() []string          { return nil }
+func (v tenantViewer) Roles() []string                { return nil }
+func (v tenantViewer) DataScope() []viewer.DataScope  { return nil }
+func (v tenantViewer) TraceID() string                { return "" }
+func (v tenantViewer) HasPermission(_, _ string) bool { return false }
+func (v tenantViewer) IsPlatformContext() bool        { return false }
+func (v tenantViewer) IsTenantContext() bool          { return true }
+func (v tenantViewer) IsSystemContext() bool          { return false }
+func (v tenantViewer) ShouldAudit() bool              { return true }
+
+// scopedTenantID returns the tenant a context is limited to, if any
+func scopedTenantID(ctx context.Context) (uint32, bool) {
+	vc, ok := viewer.FromContext(ctx)
+	if !ok || !vc.IsTenantContext() || vc.IsPlatformContext() || vc.IsSystemContext() {
+		return 0, false
+	}
+	return uint32(vc.TenantID()), true
+}
+
+// scopeTenantMutations limits updates and deletes of tenant-owned rows to the viewer's tenant,
+// so an ID of another tenant's row matches nothing and the write fails as not found
+func scopeTenantMutations() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if !m.Op().Is(ent.OpUpdate | ent.OpUpdateOne | ent.OpDelete | ent.OpDeleteOne) {
+				return next.Mutate(ctx, m)
+			}
+			tenantID, ok := scopedTenantID(ctx)
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+
+			// Only schemas with the TenantID mixin have a tenant_id getter
+			if _, owned := m.(interface{ TenantID() (uint32, bool) }); owned {
+				if w, ok := m.(interface{ WhereP(...func(*sql.Selector)) }); ok {
+					w.WhereP(sql.FieldEQ("tenant_id", tenantID))
+				}
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package data
+
+import (
+	"context"
+	"mime"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/tenantsettings"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// tenantSettingsRefresh is how long a replica caches a tenant's settings before re-reading them
+const tenantSettingsRefresh = 30 * time.Second
+
+// TenantSettings are the settings in effect for a tenant. Empty values use the service's defaults.
+type TenantSettings struct {
+	OCRLanguage             string
+	DisabledProcessingSteps []string
+	AllowedMimeTypes        []string
+	DefaultRetentionClass   string
+	StorageBackend          string
+}
+
+// StepEnabled reports whether the tenant's documents go through a processing step
+func (s *TenantSettings) StepEnabled(step paperlessV1.ProcessingStep) bool {
+	return !slices.Contains(s.DisabledProcessingSteps, step.String())
+}
+
+// AllowsMimeType reports whether the tenant accepts uploads of a MIME type. Parameters such as
+// the charset are ignored, and an allowed type of image/* accepts every image type.
+func (s *TenantSettings) AllowsMimeType(mimeType string) bool {
+	if len(s.AllowedMimeTypes) == 0 {
+		return true
+	}
+
+	mediaType, _, err := mime.ParseMediaType(mimeType)
+	if err != nil {
+		mediaType = strings.ToLower(strings.TrimSpace(mimeType))
+	}
+	major, _, _ := strings.Cut(mediaType, "/")
+
+	for _, allowed := range s.AllowedMimeTypes {
+		if allowed == mediaType || allowed == major+"/*" {
+			return true
+		}
+	}
+	return false
+}
+
+type cachedTenantSettings struct {
+	settings *TenantSettings
+	loadedAt time.Time
+}
+
+// TenantSettingsRepo stores the settings tenants override the service's defaults with and
+// provides them to the other services. Settings are cached per replica for
+// tenantSettingsRefresh, so other replicas see a change within that time.
+type TenantSettingsRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	mu    sync.Mutex
+	cache map[uint32]cachedTenantSettings
+}
+
+// NewTenantSettingsRepo creates a new TenantSettingsRepo
+func NewTenantSettingsRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *TenantSettingsRepo {
+	return &TenantSettingsRepo{
+		log:       ctx.NewLoggerHelper("paperless/tenant_settings/repo"),
+		entClient: entClient,
+		cache:     make(map[uint32]cachedTenantSettings),
+	}
+}
+
+// For returns the settings in effect for a tenant
+func (r *TenantSettingsRepo) For(ctx context.Context, tenantID uint32) (*TenantSettings, error) {
+	r.mu.Lock()
+	cached, ok := r.cache[tenantID]
+	r.mu.Unlock()
+	if ok && time.Since(cached.loadedAt) < tenantSettingsRefresh {
+		return cached.settings, nil
+	}
+
+	entity, err := r.Get(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	settings := &TenantSettings{}
+	if entity != nil {
+		settings = &TenantSettings{
+			OCRLanguage:             entity.OcrLanguage,
+			DisabledProcessingSteps: entity.DisabledProcessingSteps,
+			AllowedMimeTypes:        entity.AllowedMimeTypes,
+			DefaultRetentionClass:   entity.DefaultRetentionClass,
+			StorageBackend:          entity.StorageBackend,
+		}
+	}
+
+	r.mu.Lock()
+	r.cache[tenantID] = cachedTenantSettings{settings: settings, loadedAt: time.Now()}
+	r.mu.Unlock()
+	return settings, nil
+}
+
+// Get returns a tenant's stored settings, or nil if the tenant never changed them
+func (r *TenantSettingsRepo) Get(ctx context.Context, tenantID uint32) (*ent.TenantSettings, error) {
+	entity, err := r.entClient.Client().TenantSettings.Query().
+		Where(tenantsettings.TenantIDEQ(tenantID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get tenant settings failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("get tenant settings failed")
+	}
+	return entity, nil
+}
+
+// Update changes a tenant's settings; fields the request leaves out are left unchanged
+func (r *TenantSettingsRepo) Update(ctx context.Context, tenantID uint32, req *paperlessV1.UpdateTenantSettingsRequest) (*ent.TenantSettings, error) {
+	client := r.entClient.Client()
+
+	err := client.TenantSettings.Create().
+		SetTenantID(tenantID).
+		SetCreateTime(time.Now()).
+		OnConflictColumns(tenantsettings.FieldTenantID).
+		Ignore().
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("create tenant settings failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("create tenant settings failed")
+	}
+
+	builder := client.TenantSettings.Update().
+		Where(tenantsettings.TenantIDEQ(tenantID)).
+		SetUpdateTime(time.Now())
+
+	if req.OcrLanguage != nil {
+		builder.SetOcrLanguage(*req.OcrLanguage)
+	}
+	if req.UpdateDisabledProcessingSteps {
+		steps := make([]string, 0, len(req.DisabledProcessingSteps))
+		for _, step := range req.DisabledProcessingSteps {
+			steps = append(steps, step.String())
+		}
+		builder.SetDisabledProcessingSteps(steps)
+	}
+	if req.UpdateAllowedMimeTypes {
+		builder.SetAllowedMimeTypes(req.AllowedMimeTypes)
+	}
+	if req.DefaultRetentionClass != nil {
+		builder.SetDefaultRetentionClass(*req.DefaultRetentionClass)
+	}
+	if req.StorageBackend != nil {
+		builder.SetStorageBackend(*req.StorageBackend)
+	}
+
+	if err := builder.Exec(ctx); err != nil {
+		r.log.Errorf("update tenant settings failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("update tenant settings failed")
+	}
+
+	// This replica applies the change right away; others once their cache expires
+	r.mu.Lock()
+	delete(r.cache, tenantID)
+	r.mu.Unlock()
+
+	return r.Get(ctx, tenantID)
+}
+
+// ToProto converts a tenant's stored settings, which may be nil, to paperlessV1.TenantSettings
+func (r *TenantSettingsRepo) ToProto(tenantID uint32, entity *ent.TenantSettings) *paperlessV1.TenantSettings {
+	proto := &paperlessV1.TenantSettings{
+		TenantId: tenantID,
+	}
+	if entity == nil {
+		return proto
+	}
+
+	proto.OcrLanguage = entity.OcrLanguage
+	for _, step := range entity.DisabledProcessingSteps {
+		if value, ok := paperlessV1.ProcessingStep_value[step]; ok {
+			proto.DisabledProcessingSteps = append(proto.DisabledProcessingSteps, paperlessV1.ProcessingStep(value))
+		}
+	}
+	proto.AllowedMimeTypes = entity.AllowedMimeTypes
+	proto.DefaultRetentionClass = entity.DefaultRetentionClass
+	proto.StorageBackend = entity.StorageBackend
+	if entity.UpdateTime != nil && !entity.UpdateTime.IsZero() {
+		proto.UpdateTime = timestamppb.New(*entity.UpdateTime)
+	}
+	return proto
+}
@@ -1,17 +1,41 @@
 package data
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/metrics"
+	"github.com/go-tangra/go-tangra-paperless/internal/tracing"
 )
 
+// TikaOptions tunes an extraction for a tenant
+type TikaOptions struct {
+	// OCRLanguage selects the Tesseract languages, e.g. "eng+deu"; empty uses the server's default
+	OCRLanguage string
+	// SkipOCR extracts only text embedded in the document, without OCR of scans and images
+	SkipOCR bool
+}
+
+// setHeaders passes the options to Tika as per-request configuration headers
+func (o TikaOptions) setHeaders(req *http.Request) {
+	if o.OCRLanguage != "" {
+		req.Header.Set("X-Tika-OCRLanguage", o.OCRLanguage)
+	}
+	if o.SkipOCR {
+		req.Header.Set("X-Tika-OCRskipOcr", "true")
+		req.Header.Set("X-Tika-PDFOcrStrategy", "no_ocr")
+	}
+}
+
 // TikaClient wraps Apache Tika HTTP API for text and metadata extraction
 type TikaClient struct {
 	endpoint   string
@@ -36,9 +60,21 @@ func NewTikaClient(ctx *bootstrap.Context) (*TikaClient, func(), error) {
 	}, nil
 }
 
-// ExtractText extracts plain text content from a document via Tika
-func (c *TikaClient) ExtractText(ctx context.Context, content []byte, mimeType string) (string, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.endpoint+"/tika", bytes.NewReader(content))
+// ExtractText extracts plain text content from a document via Tika. The document is streamed
+// from content as the request body.
+func (c *TikaClient) ExtractText(ctx context.Context, content io.Reader, mimeType string, opts TikaOptions) (string, error) {
+	ctx, span := tracing.Start(ctx, "tika.extract_text")
+	start := time.Now()
+	counted := &countingReader{r: content}
+	result, err := c.extractText(ctx, counted, mimeType, opts)
+	metrics.ObserveExternalRequest("tika", "extract_text", start, err)
+	span.SetAttributes(attribute.Int64("tika.request_size", counted.n))
+	tracing.End(span, err)
+	return result, err
+}
+
+func (c *TikaClient) extractText(ctx context.Context, content io.Reader, mimeType string, opts TikaOptions) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.endpoint+"/tika", content)
 	if err != nil {
 		return "", fmt.Errorf("failed to create tika request: %w", err)
 	}
@@ -47,6 +83,7 @@ func (c *TikaClient) ExtractText(ctx context.Context, content []byte, mimeType s
 	if mimeType != "" {
 		req.Header.Set("Content-Type", mimeType)
 	}
+	opts.setHeaders(req)
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
@@ -62,17 +99,30 @@ func (c *TikaClient) ExtractText(ctx context.Context, content []byte, mimeType s
 		return "", fmt.Errorf("tika returned status %d: %s", resp.StatusCode, string(body))
 	}
 
-	text, err := io.ReadAll(resp.Body)
-	if err != nil {
+	// Read straight into the string, without a byte slice copy of the text
+	var text strings.Builder
+	if _, err := io.Copy(&text, resp.Body); err != nil {
 		return "", fmt.Errorf("failed to read tika response: %w", err)
 	}
 
-	return string(text), nil
+	return text.String(), nil
 }
 
-// ExtractMetadata extracts metadata from a document via Tika /meta endpoint
-func (c *TikaClient) ExtractMetadata(ctx context.Context, content []byte, mimeType string) (map[string]string, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.endpoint+"/meta", bytes.NewReader(content))
+// ExtractMetadata extracts metadata from a document via Tika /meta endpoint, streaming the
+// document from content
+func (c *TikaClient) ExtractMetadata(ctx context.Context, content io.Reader, mimeType string, opts TikaOptions) (map[string]string, error) {
+	ctx, span := tracing.Start(ctx, "tika.extract_metadata")
+	start := time.Now()
+	counted := &countingReader{r: content}
+	result, err := c.extractMetadata(ctx, counted, mimeType, opts)
+	metrics.ObserveExternalRequest("tika", "extract_metadata", start, err)
+	span.SetAttributes(attribute.Int64("tika.request_size", counted.n))
+	tracing.End(span, err)
+	return result, err
+}
+
+func (c *TikaClient) extractMetadata(ctx context.Context, content io.Reader, mimeType string, opts TikaOptions) (map[string]string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.endpoint+"/meta", content)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create tika meta request: %w", err)
 	}
@@ -81,6 +131,7 @@ func (c *TikaClient) ExtractMetadata(ctx context.Context, content []byte, mimeTy
 	if mimeType != "" {
 		req.Header.Set("Content-Type", mimeType)
 	}
+	opts.setHeaders(req)
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
@@ -118,3 +169,36 @@ func (c *TikaClient) ExtractMetadata(ctx context.Context, content []byte, mimeTy
 
 	return metadata, nil
 }
+
+// Ping checks that the Tika server answers on its /tika endpoint
+func (c *TikaClient) Ping(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.endpoint+"/tika", nil)
+	if err != nil {
+		return fmt.Errorf("failed to create tika request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("tika ping failed: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("tika returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// countingReader counts the bytes read through it, e.g. to record the size of a streamed
+// request body
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
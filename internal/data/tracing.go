@@ -0,0 +1,46 @@
+package data
+
+import (
+	"context"
+
+	entgo "entgo.io/ent"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/tracing"
+)
+
+// traceQueries starts a span for every ent query, e.g. "ent.Document.All"
+func traceQueries() ent.Interceptor {
+	return ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+		return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+			qc := entgo.QueryFromContext(ctx)
+			if qc == nil {
+				return next.Query(ctx, q)
+			}
+
+			ctx, span := tracing.Start(ctx, "ent."+qc.Type+"."+qc.Op,
+				attribute.String("ent.type", qc.Type),
+				attribute.String("ent.op", qc.Op),
+			)
+			v, err := next.Query(ctx, q)
+			tracing.End(span, err)
+			return v, err
+		})
+	})
+}
+
+// traceMutations starts a span for every ent mutation, e.g. "ent.Document.OpUpdateOne"
+func traceMutations() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			ctx, span := tracing.Start(ctx, "ent."+m.Type()+"."+m.Op().String(),
+				attribute.String("ent.type", m.Type()),
+				attribute.String("ent.op", m.Op().String()),
+			)
+			v, err := next.Mutate(ctx, m)
+			tracing.End(span, err)
+			return v, err
+		})
+	}
+}
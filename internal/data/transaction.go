@@ -0,0 +1,70 @@
+package data
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// Transaction runs repository calls in one database transaction
+type Transaction struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+// NewTransaction creates a new Transaction
+func NewTransaction(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *Transaction {
+	return &Transaction{
+		log:       ctx.NewLoggerHelper("paperless/transaction"),
+		entClient: entClient,
+	}
+}
+
+// InTx runs fn in a transaction that commits when fn returns nil and rolls back otherwise.
+// Repository calls made with the context passed to fn join the transaction, and so do
+// nested InTx calls.
+func (t *Transaction) InTx(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	if ent.TxFromContext(ctx) != nil {
+		return fn(ctx)
+	}
+
+	tx, err := t.entClient.Client().Tx(ctx)
+	if err != nil {
+		t.log.Errorf("begin transaction failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("begin transaction failed")
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	if err = fn(ent.NewTxContext(ctx, tx)); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			t.log.Errorf("rollback transaction failed: %s", rbErr.Error())
+		}
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		t.log.Errorf("commit transaction failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("commit transaction failed")
+	}
+	return nil
+}
+
+// clientFromContext returns the client of the transaction in ctx, or the default client
+func clientFromContext(ctx context.Context, entClient *entCrud.EntClient[*ent.Client]) *ent.Client {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return tx.Client()
+	}
+	return entClient.Client()
+}
@@ -0,0 +1,373 @@
+package data
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/webhookdelivery"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/webhooksubscription"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// WebhookRepo stores webhook subscriptions and their deliveries
+type WebhookRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	ids       *IDGenerator
+	log       *log.Helper
+}
+
+// NewWebhookRepo creates a new WebhookRepo
+func NewWebhookRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], ids *IDGenerator) *WebhookRepo {
+	return &WebhookRepo{
+		log:       ctx.NewLoggerHelper("paperless/webhook_repo"),
+		entClient: entClient,
+		ids:       ids,
+	}
+}
+
+// CreateSubscription creates a webhook subscription
+func (r *WebhookRepo) CreateSubscription(ctx context.Context, tenantID uint32, name, url, secret string, eventTypes []string, enabled bool, createdBy *uint32) (*ent.WebhookSubscription, error) {
+	builder := r.entClient.Client().WebhookSubscription.Create().
+		SetID(r.ids.New()).
+		SetTenantID(tenantID).
+		SetName(name).
+		SetURL(url).
+		SetSecret(secret).
+		SetEventTypes(eventTypes).
+		SetEnabled(enabled).
+		SetCreateTime(time.Now())
+
+	if createdBy != nil {
+		builder.SetCreateBy(*createdBy)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("create webhook subscription failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("create webhook subscription failed")
+	}
+	return entity, nil
+}
+
+// GetSubscription returns a tenant's subscription, or nil if it does not exist
+func (r *WebhookRepo) GetSubscription(ctx context.Context, tenantID uint32, id string) (*ent.WebhookSubscription, error) {
+	entity, err := r.entClient.Client().WebhookSubscription.Query().
+		Where(
+			webhooksubscription.IDEQ(id),
+			webhooksubscription.TenantIDEQ(tenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get webhook subscription failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("get webhook subscription failed")
+	}
+	return entity, nil
+}
+
+// ListSubscriptions lists a tenant's subscriptions, newest first
+func (r *WebhookRepo) ListSubscriptions(ctx context.Context, tenantID uint32, page, pageSize uint32) ([]*ent.WebhookSubscription, int, error) {
+	query := r.entClient.Client().WebhookSubscription.Query().
+		Where(webhooksubscription.TenantIDEQ(tenantID))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count webhook subscriptions failed: %s", err.Error())
+		return nil, 0, paperlessV1.ErrorInternalServerError("count webhook subscriptions failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		query = query.Offset(int((page - 1) * pageSize)).Limit(int(pageSize))
+	}
+
+	entities, err := query.Order(ent.Desc(webhooksubscription.FieldCreateTime)).All(ctx)
+	if err != nil {
+		r.log.Errorf("list webhook subscriptions failed: %s", err.Error())
+		return nil, 0, paperlessV1.ErrorInternalServerError("list webhook subscriptions failed")
+	}
+	return entities, total, nil
+}
+
+// UpdateSubscription updates the given fields of a subscription
+func (r *WebhookRepo) UpdateSubscription(ctx context.Context, id string, name, url, secret *string, eventTypes []string, updateEventTypes bool, enabled *bool) (*ent.WebhookSubscription, error) {
+	builder := r.entClient.Client().WebhookSubscription.UpdateOneID(id).
+		SetUpdateTime(time.Now())
+
+	if name != nil {
+		builder.SetName(*name)
+	}
+	if url != nil {
+		builder.SetURL(*url)
+	}
+	if secret != nil {
+		builder.SetSecret(*secret)
+	}
+	if updateEventTypes {
+		builder.SetEventTypes(eventTypes)
+	}
+	if enabled != nil {
+		builder.SetEnabled(*enabled)
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, paperlessV1.ErrorNotFound("webhook subscription not found")
+		}
+		r.log.Errorf("update webhook subscription failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("update webhook subscription failed")
+	}
+	return entity, nil
+}
+
+// DeleteSubscription deletes a subscription together with its deliveries
+func (r *WebhookRepo) DeleteSubscription(ctx context.Context, id string) error {
+	if err := r.entClient.Client().WebhookSubscription.DeleteOneID(id).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return paperlessV1.ErrorNotFound("webhook subscription not found")
+		}
+		r.log.Errorf("delete webhook subscription failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("delete webhook subscription failed")
+	}
+	return nil
+}
+
+// ListSubscriptionsForEvent returns a tenant's enabled subscriptions that receive eventType
+func (r *WebhookRepo) ListSubscriptionsForEvent(ctx context.Context, tenantID uint32, eventType string) ([]*ent.WebhookSubscription, error) {
+	entities, err := r.entClient.Client().WebhookSubscription.Query().
+		Where(
+			webhooksubscription.TenantIDEQ(tenantID),
+			webhooksubscription.EnabledEQ(true),
+		).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list webhook subscriptions for event failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("list webhook subscriptions failed")
+	}
+
+	matching := entities[:0]
+	for _, e := range entities {
+		if len(e.EventTypes) == 0 || slices.Contains(e.EventTypes, eventType) {
+			matching = append(matching, e)
+		}
+	}
+	return matching, nil
+}
+
+// CreateDeliveries queues an event for each subscription, due immediately. Deliveries that
+// already exist for the event are kept, so an event handed over twice is delivered once.
+func (r *WebhookRepo) CreateDeliveries(ctx context.Context, subscriptions []*ent.WebhookSubscription, eventID, eventType string, payload []byte) error {
+	now := time.Now()
+	builders := make([]*ent.WebhookDeliveryCreate, 0, len(subscriptions))
+	for _, sub := range subscriptions {
+		builders = append(builders, r.entClient.Client().WebhookDelivery.Create().
+			SetTenantID(derefUint32(sub.TenantID)).
+			SetSubscriptionID(sub.ID).
+			SetEventID(eventID).
+			SetEventType(eventType).
+			SetPayload(string(payload)).
+			SetNextAttemptAt(now).
+			SetCreateTime(now))
+	}
+
+	err := r.entClient.Client().WebhookDelivery.CreateBulk(builders...).
+		OnConflictColumns(webhookdelivery.FieldSubscriptionID, webhookdelivery.FieldEventID).
+		DoNothing().
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("create webhook deliveries failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("create webhook deliveries failed")
+	}
+	return nil
+}
+
+// ClaimDueDeliveries returns up to limit pending deliveries that are due, with their
+// subscription. Each is leased by moving its next attempt to now+lease, so other replicas
+// skip it while it is being sent; a delivery whose sender crashes is retried after the lease.
+func (r *WebhookRepo) ClaimDueDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*ent.WebhookDelivery, error) {
+	due, err := r.entClient.Client().WebhookDelivery.Query().
+		Where(
+			webhookdelivery.StatusEQ(webhookdelivery.StatusWEBHOOK_DELIVERY_STATUS_PENDING),
+			webhookdelivery.NextAttemptAtLTE(now),
+		).
+		Order(ent.Asc(webhookdelivery.FieldNextAttemptAt)).
+		Limit(limit).
+		WithSubscription().
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list due webhook deliveries failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("list due webhook deliveries failed")
+	}
+
+	claimed := due[:0]
+	for _, d := range due {
+		n, err := r.entClient.Client().WebhookDelivery.Update().
+			Where(
+				webhookdelivery.IDEQ(d.ID),
+				webhookdelivery.NextAttemptAtEQ(*d.NextAttemptAt),
+			).
+			SetNextAttemptAt(now.Add(lease)).
+			Save(ctx)
+		if err != nil {
+			r.log.Errorf("claim webhook delivery %d failed: %s", d.ID, err.Error())
+			continue
+		}
+		if n == 1 {
+			claimed = append(claimed, d)
+		}
+	}
+	return claimed, nil
+}
+
+// WebhookAttempt is the outcome of one delivery attempt
+type WebhookAttempt struct {
+	ResponseStatus int32
+	Error          string
+	Duration       time.Duration
+	// NextAttemptAt schedules a retry of a failed attempt; nil gives up
+	NextAttemptAt *time.Time
+}
+
+// RecordAttempt stores the outcome of an attempt and marks the delivery succeeded, failed or pending a retry
+func (r *WebhookRepo) RecordAttempt(ctx context.Context, id uint32, attempt *WebhookAttempt) error {
+	now := time.Now()
+	builder := r.entClient.Client().WebhookDelivery.UpdateOneID(id).
+		AddAttempts(1).
+		SetResponseStatus(attempt.ResponseStatus).
+		SetLastError(truncateError(attempt.Error)).
+		SetDurationMs(attempt.Duration.Milliseconds()).
+		SetUpdateTime(now)
+
+	switch {
+	case attempt.Error == "":
+		builder.SetStatus(webhookdelivery.StatusWEBHOOK_DELIVERY_STATUS_SUCCEEDED).
+			SetDeliveredAt(now).
+			ClearNextAttemptAt()
+	case attempt.NextAttemptAt != nil:
+		builder.SetNextAttemptAt(*attempt.NextAttemptAt)
+	default:
+		builder.SetStatus(webhookdelivery.StatusWEBHOOK_DELIVERY_STATUS_FAILED).
+			ClearNextAttemptAt()
+	}
+
+	if err := builder.Exec(ctx); err != nil {
+		r.log.Errorf("record webhook attempt failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("record webhook attempt failed")
+	}
+	return nil
+}
+
+// truncateError keeps an error message within the last_error column
+func truncateError(msg string) string {
+	const maxLen = 1024
+	if len(msg) <= maxLen {
+		return msg
+	}
+	return msg[:maxLen]
+}
+
+// ListDeliveries lists a subscription's deliveries, newest first
+func (r *WebhookRepo) ListDeliveries(ctx context.Context, subscriptionID string, status *string, page, pageSize uint32) ([]*ent.WebhookDelivery, int, error) {
+	query := r.entClient.Client().WebhookDelivery.Query().
+		Where(webhookdelivery.SubscriptionIDEQ(subscriptionID))
+	if status != nil {
+		query = query.Where(webhookdelivery.StatusEQ(webhookdelivery.Status(*status)))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count webhook deliveries failed: %s", err.Error())
+		return nil, 0, paperlessV1.ErrorInternalServerError("count webhook deliveries failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		query = query.Offset(int((page - 1) * pageSize)).Limit(int(pageSize))
+	}
+
+	entities, err := query.Order(ent.Desc(webhookdelivery.FieldCreateTime), ent.Desc(webhookdelivery.FieldID)).All(ctx)
+	if err != nil {
+		r.log.Errorf("list webhook deliveries failed: %s", err.Error())
+		return nil, 0, paperlessV1.ErrorInternalServerError("list webhook deliveries failed")
+	}
+	return entities, total, nil
+}
+
+// DeleteDeliveriesOlderThan deletes finished deliveries created before the given time
+func (r *WebhookRepo) DeleteDeliveriesOlderThan(ctx context.Context, before time.Time) (int, error) {
+	deleted, err := r.entClient.Client().WebhookDelivery.Delete().
+		Where(
+			webhookdelivery.CreateTimeLT(before),
+			webhookdelivery.StatusNEQ(webhookdelivery.StatusWEBHOOK_DELIVERY_STATUS_PENDING),
+		).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("delete old webhook deliveries failed: %s", err.Error())
+		return 0, paperlessV1.ErrorInternalServerError("delete old webhook deliveries failed")
+	}
+	return deleted, nil
+}
+
+// SubscriptionToProto converts an ent.WebhookSubscription to paperlessV1.WebhookSubscription, without its secret
+func (r *WebhookRepo) SubscriptionToProto(entity *ent.WebhookSubscription) *paperlessV1.WebhookSubscription {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &paperlessV1.WebhookSubscription{
+		Id:         entity.ID,
+		TenantId:   derefUint32(entity.TenantID),
+		Name:       entity.Name,
+		Url:        entity.URL,
+		EventTypes: entity.EventTypes,
+		Enabled:    entity.Enabled,
+		CreatedBy:  entity.CreateBy,
+	}
+	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+	if entity.UpdateTime != nil && !entity.UpdateTime.IsZero() {
+		proto.UpdateTime = timestamppb.New(*entity.UpdateTime)
+	}
+	return proto
+}
+
+// DeliveryToProto converts an ent.WebhookDelivery to paperlessV1.WebhookDelivery
+func (r *WebhookRepo) DeliveryToProto(entity *ent.WebhookDelivery) *paperlessV1.WebhookDelivery {
+	if entity == nil {
+		return nil
+	}
+
+	proto := &paperlessV1.WebhookDelivery{
+		Id:             entity.ID,
+		SubscriptionId: entity.SubscriptionID,
+		EventId:        entity.EventID,
+		EventType:      entity.EventType,
+		Payload:        entity.Payload,
+		Status:         paperlessV1.WebhookDeliveryStatus(paperlessV1.WebhookDeliveryStatus_value[string(entity.Status)]),
+		Attempts:       entity.Attempts,
+		ResponseStatus: entity.ResponseStatus,
+		LastError:      entity.LastError,
+		DurationMs:     entity.DurationMs,
+	}
+	if entity.CreateTime != nil && !entity.CreateTime.IsZero() {
+		proto.CreateTime = timestamppb.New(*entity.CreateTime)
+	}
+	if entity.NextAttemptAt != nil {
+		proto.NextAttemptAt = timestamppb.New(*entity.NextAttemptAt)
+	}
+	if entity.DeliveredAt != nil {
+		proto.DeliveredAt = timestamppb.New(*entity.DeliveredAt)
+	}
+	return proto
+}
@@ -0,0 +1,64 @@
+// Package errdetail adds structured details to service errors so frontends can render them
+// in the user's language. The English message of an error stays for logs and API users;
+// next to its reason, an error's metadata carries the key of a translatable message, the
+// request field at fault, and the values the message refers to. On gRPC, the metadata is
+// part of the google.rpc.ErrorInfo detail of the status.
+package errdetail
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+)
+
+// Metadata keys of the structured details. Other metadata entries are message parameters.
+const (
+	// MessageKeyMetadata holds the key of the translatable message, see the Key constants
+	MessageKeyMetadata = "message_key"
+
+	// FieldMetadata holds the request field at fault as a protobuf field path, e.g. "parent_id"
+	FieldMetadata = "field"
+)
+
+// genericKeyPrefix starts the key of errors without a specific message, followed by the
+// reason in lower case, e.g. "paperless.error.document_not_found"
+const genericKeyPrefix = "paperless.error."
+
+// With returns a copy of err carrying the message key, the request field at fault (empty if
+// no single field is) and the message parameters, given as alternating names and values.
+// Metadata already on err is kept.
+func With(err *kerrors.Error, key, field string, params ...any) *kerrors.Error {
+	e := kerrors.Clone(err)
+	if e.Metadata == nil {
+		e.Metadata = make(map[string]string, 2+len(params)/2)
+	}
+	e.Metadata[MessageKeyMetadata] = key
+	if field != "" {
+		e.Metadata[FieldMetadata] = field
+	}
+	for i := 0; i+1 < len(params); i += 2 {
+		e.Metadata[fmt.Sprint(params[i])] = fmt.Sprint(params[i+1])
+	}
+	return e
+}
+
+// GenericKey returns the message key of errors of a reason that carry no specific key
+func GenericKey(reason string) string {
+	return genericKeyPrefix + strings.ToLower(reason)
+}
+
+// Ensure gives a service error without a message key the generic key of its reason, so every
+// error a client gets can be translated. Errors with a key and errors that are not service
+// errors are returned unchanged.
+func Ensure(err error) error {
+	se := new(kerrors.Error)
+	if err == nil || !errors.As(err, &se) || se.Reason == "" {
+		return err
+	}
+	if _, ok := se.Metadata[MessageKeyMetadata]; ok {
+		return err
+	}
+	return With(se, GenericKey(se.Reason), "")
+}
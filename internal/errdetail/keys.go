@@ -0,0 +1,110 @@
+package errdetail
+
+// Message keys of the errors of the document, category and permission RPCs. The parameters
+// each message refers to are listed with its key; all other errors carry GenericKey of their
+// reason.
+const (
+	// KeyRequestInvalid means the request breaks validation rules; the metadata maps each field
+	// path to its violations
+	KeyRequestInvalid = "paperless.request.invalid"
+
+	// KeyAccessDenied means the caller lacks a permission on a resource (permission, resource)
+	KeyAccessDenied = "paperless.access.denied"
+	// KeyTenantAdminRequired means only tenant admins may do this (action)
+	KeyTenantAdminRequired = "paperless.access.tenant_admin_required"
+	// KeyPlatformAdminRequired means only platform admins may do this (action)
+	KeyPlatformAdminRequired = "paperless.access.platform_admin_required"
+	// KeyUserRequired means the call needs a user, not just a tenant or service
+	KeyUserRequired = "paperless.access.user_required"
+
+	// KeyDocumentNotFound means the document doesn't exist or was deleted
+	KeyDocumentNotFound = "paperless.document.not_found"
+	// KeyDocumentAlreadyExists means a document with this name already exists in the category
+	KeyDocumentAlreadyExists = "paperless.document.already_exists"
+	// KeyDocumentVersionConflict means the document was changed since the expected version (version)
+	KeyDocumentVersionConflict = "paperless.document.version_conflict"
+	// KeyDocumentFileTypeNotAccepted means the tenant doesn't accept files of this type (mime_type)
+	KeyDocumentFileTypeNotAccepted = "paperless.document.file_type_not_accepted"
+	// KeyDocumentQuarantined means malware was detected in the document's file
+	KeyDocumentQuarantined = "paperless.document.quarantined"
+	// KeyDocumentRestoring means the file is being restored from cold storage
+	KeyDocumentRestoring = "paperless.document.restoring"
+	// KeyDocumentURLUnavailable means presigned URLs are not available with encrypted storage
+	KeyDocumentURLUnavailable = "paperless.document.url_unavailable"
+	// KeyDocumentPDFUnsupported means documents of this type can't be converted to PDF (mime_type)
+	KeyDocumentPDFUnsupported = "paperless.document.pdf_unsupported"
+	// KeyDocumentPDFUnavailable means PDF conversion is temporarily unavailable
+	KeyDocumentPDFUnavailable = "paperless.document.pdf_unavailable"
+	// KeyDocumentPreviewUnsupported means documents of this type can't be previewed (mime_type)
+	KeyDocumentPreviewUnsupported = "paperless.document.preview_unsupported"
+	// KeyDocumentPreviewUnavailable means previews are temporarily unavailable
+	KeyDocumentPreviewUnavailable = "paperless.document.preview_unavailable"
+	// KeyDocumentStatusTransition means the status can't change this way (from, to)
+	KeyDocumentStatusTransition = "paperless.document.status_transition"
+	// KeyDocumentStatusPermission means this status change needs another permission
+	// (status, permission)
+	KeyDocumentStatusPermission = "paperless.document.status_permission"
+	// KeyDocumentAssigneeCannotRead means the assignee can't read the document (user)
+	KeyDocumentAssigneeCannotRead = "paperless.document.assignee_cannot_read"
+	// KeyDocumentWatchOverflow means the client fell behind on document changes and must reload
+	KeyDocumentWatchOverflow = "paperless.document.watch_overflow"
+
+	// KeyCategoryNotFound means the category doesn't exist
+	KeyCategoryNotFound = "paperless.category.not_found"
+	// KeyCategoryAlreadyExists means a category with this name already exists in the parent
+	KeyCategoryAlreadyExists = "paperless.category.already_exists"
+	// KeyCategoryVersionConflict means the category was changed since the expected version (version)
+	KeyCategoryVersionConflict = "paperless.category.version_conflict"
+	// KeyCategoryOwnSubtree means a category can't be moved, copied or reassigned into its own
+	// subtree
+	KeyCategoryOwnSubtree = "paperless.category.own_subtree"
+	// KeyCategoryHasChildren means the category has subcategories
+	KeyCategoryHasChildren = "paperless.category.has_children"
+	// KeyCategoryHasDocuments means the category contains documents
+	KeyCategoryHasDocuments = "paperless.category.has_documents"
+	// KeyCategoryQuotaDocuments means the category holds no more documents (path, max)
+	KeyCategoryQuotaDocuments = "paperless.category.quota_documents"
+	// KeyCategoryQuotaBytes means the category holds no more bytes (path, max, used)
+	KeyCategoryQuotaBytes = "paperless.category.quota_bytes"
+	// KeyCategoryDeleteModeRequired means background deletion needs a mode or force
+	KeyCategoryDeleteModeRequired = "paperless.category.delete_mode_required"
+	// KeyCategoryTargetWithoutReassign means a target category is only used when reassigning
+	KeyCategoryTargetWithoutReassign = "paperless.category.target_without_reassign"
+	// KeyCategoryDeleteJobNotFound means the category delete job doesn't exist
+	KeyCategoryDeleteJobNotFound = "paperless.category.delete_job_not_found"
+	// KeyCategoryPathTargetRequired means exactly one of a category and a document is required
+	KeyCategoryPathTargetRequired = "paperless.category.path_target_required"
+	// KeyCategorySortModeRequired means a sort mode is required
+	KeyCategorySortModeRequired = "paperless.category.sort_mode_required"
+	// KeyCategoryNotChild means the category is not a subcategory of the parent (id)
+	KeyCategoryNotChild = "paperless.category.not_child"
+	// KeyCategoryListedTwice means the category is listed more than once (id)
+	KeyCategoryListedTwice = "paperless.category.listed_twice"
+
+	// KeyTenantQuotaDocuments means the tenant holds no more documents (max)
+	KeyTenantQuotaDocuments = "paperless.tenant.quota_documents"
+	// KeyTenantQuotaBytes means the tenant's documents take no more bytes (max, used)
+	KeyTenantQuotaBytes = "paperless.tenant.quota_bytes"
+	// KeyTenantQuotaMonthlyUploads means the tenant uploads no more bytes this month
+	// (max, used, month)
+	KeyTenantQuotaMonthlyUploads = "paperless.tenant.quota_monthly_uploads"
+
+	// KeyPermissionAlreadyExists means the subject already has this relation to the resource
+	KeyPermissionAlreadyExists = "paperless.permission.already_exists"
+	// KeyPermissionConditionsInvalid means the conditions of a grant are invalid (reason)
+	KeyPermissionConditionsInvalid = "paperless.permission.conditions_invalid"
+	// KeyPermissionSubjectUnsupported means documents can only be shared with users and roles
+	KeyPermissionSubjectUnsupported = "paperless.permission.subject_unsupported"
+	// KeyPermissionRelationRequired means a relation is required
+	KeyPermissionRelationRequired = "paperless.permission.relation_required"
+	// KeyPermissionGrantExceedsAccess means the caller can't grant more than their own access
+	// (relation, access)
+	KeyPermissionGrantExceedsAccess = "paperless.permission.grant_exceeds_access"
+	// KeyPermissionExpiryPast means the expiry of a grant must be in the future
+	KeyPermissionExpiryPast = "paperless.permission.expiry_past"
+
+	// KeyStorageUnavailable means storage is temporarily unavailable
+	KeyStorageUnavailable = "paperless.storage.unavailable"
+	// KeyStorageFailed means a storage operation failed
+	KeyStorageFailed = "paperless.storage.failed"
+)
@@ -0,0 +1,203 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+const namespace = "paperless"
+
+// Result label values
+const (
+	ResultSuccess = "success"
+	ResultError   = "error"
+)
+
+// Registry holds every paperless collector together with the Go runtime and process collectors
+var Registry = prometheus.NewRegistry()
+
+var (
+	// Uploads counts document uploads by source and result
+	Uploads = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "uploads_total",
+		Help:      "Document uploads by source and result.",
+	}, []string{"source", "result"})
+
+	// UploadBytes observes the size of successfully uploaded documents
+	UploadBytes = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "upload_size_bytes",
+		Help:      "Size of uploaded documents.",
+		Buckets:   prometheus.ExponentialBuckets(16<<10, 4, 8), // 16 KiB .. 256 MiB
+	})
+
+	// ProcessingDuration observes processing attempts by outcome (completed, skipped, infected, retrying, failed)
+	ProcessingDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "processing_duration_seconds",
+		Help:      "Duration of document processing attempts by outcome.",
+		Buckets:   []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300},
+	}, []string{"status"})
+
+	// ProcessingQueueDepth is the number of documents waiting for a processing worker
+	ProcessingQueueDepth = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "processing_queue_depth",
+		Help:      "Documents waiting for a processing worker.",
+	})
+
+	// ProcessingInFlight is the number of documents being processed
+	ProcessingInFlight = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "processing_in_flight",
+		Help:      "Documents being processed.",
+	})
+
+	// ProcessingRetryWaiting is the number of failed documents waiting for their retry delay
+	ProcessingRetryWaiting = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "processing_retry_waiting",
+		Help:      "Documents waiting to be retried after a failed processing attempt.",
+	})
+
+	// AntivirusScans counts malware scans of uploads by backend and result (clean, infected, error)
+	AntivirusScans = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "antivirus_scans_total",
+		Help:      "Malware scans of uploaded documents by backend and result.",
+	}, []string{"backend", "result"})
+
+	// ProcessingRetries counts processing attempts that are retried
+	ProcessingRetries = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "processing_retries_total",
+		Help:      "Failed processing attempts that were scheduled for a retry.",
+	})
+
+	// ProcessingOldestPending is when the longest-waiting queued document was queued, 0 when the queue is empty
+	ProcessingOldestPending = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "processing_oldest_pending_timestamp_seconds",
+		Help:      "Unix time the longest-waiting queued document was queued, 0 when the queue is empty.",
+	})
+
+	// ExternalRequestDuration observes calls to Tika and Gotenberg
+	ExternalRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "external_request_duration_seconds",
+		Help:      "Duration of requests to external processing services by service, operation and result.",
+		Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120},
+	}, []string{"service", "operation", "result"})
+
+	// StorageOperationDuration observes storage backend operations, including retries
+	StorageOperationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "storage_operation_duration_seconds",
+		Help:      "Duration of storage operations by backend, operation and result, including retries.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"backend", "operation", "result"})
+
+	// PermissionCheckDuration observes permission checks of the authorization engine
+	PermissionCheckDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "permission_check_duration_seconds",
+		Help:      "Duration of permission checks by permission and outcome.",
+		Buckets:   []float64{0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1},
+	}, []string{"permission", "allowed"})
+
+	// EventsPublished counts attempts to publish lifecycle events by type and result (success, error)
+	EventsPublished = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "events_published_total",
+		Help:      "Lifecycle events sent to the message broker by type and result.",
+	}, []string{"type", "result"})
+
+	// EventOutboxPending tracks lifecycle events waiting in the outbox
+	EventOutboxPending = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "event_outbox_pending",
+		Help:      "Lifecycle events waiting in the outbox to be published.",
+	})
+
+	// DatabaseReplicaLag is how far the read replica's replay lags behind the primary
+	DatabaseReplicaLag = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "db_replica_lag_seconds",
+		Help:      "Replay lag of the read replica behind the primary.",
+	})
+
+	// WebhookDeliveries counts webhook delivery attempts by result (success, retrying, failed)
+	WebhookDeliveries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "webhook_deliveries_total",
+		Help:      "Webhook delivery attempts by result.",
+	}, []string{"result"})
+
+	// ImportSyncs counts syncs of import mappings by provider and result (succeeded, partial, failed)
+	ImportSyncs = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "import_syncs_total",
+		Help:      "Syncs of Google Drive and SharePoint import mappings by provider and result.",
+	}, []string{"provider", "result"})
+
+	// GroupSyncs counts per-tenant syncs of directory group memberships by source and result
+	GroupSyncs = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "group_syncs_total",
+		Help:      "Per-tenant syncs of group memberships from the identity directory by source and result.",
+	}, []string{"source", "result"})
+
+	// RateLimited counts uploads, downloads and GraphQL queries rejected by a rate limit, by direction and limit
+	RateLimited = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "rate_limited_requests_total",
+		Help:      "Uploads, downloads and GraphQL queries rejected by a rate limit by direction and limit.",
+	}, []string{"direction", "limit"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		Uploads,
+		UploadBytes,
+		ProcessingDuration,
+		ProcessingQueueDepth,
+		ProcessingInFlight,
+		ProcessingRetryWaiting,
+		ProcessingRetries,
+		AntivirusScans,
+		ProcessingOldestPending,
+		ExternalRequestDuration,
+		StorageOperationDuration,
+		PermissionCheckDuration,
+		EventsPublished,
+		EventOutboxPending,
+		DatabaseReplicaLag,
+		WebhookDeliveries,
+		ImportSyncs,
+		GroupSyncs,
+		RateLimited,
+	)
+}
+
+// Result returns the result label value for err
+func Result(err error) string {
+	if err != nil {
+		return ResultError
+	}
+	return ResultSuccess
+}
+
+// ObserveExternalRequest records a call to an external service that started at start
+func ObserveExternalRequest(service, operation string, start time.Time, err error) {
+	ExternalRequestDuration.WithLabelValues(service, operation, Result(err)).Observe(time.Since(start).Seconds())
+}
+
+// ObserveStorageOperation records a storage operation that started at start
+func ObserveStorageOperation(backend, operation string, start time.Time, err error) {
+	StorageOperationDuration.WithLabelValues(backend, operation, Result(err)).Observe(time.Since(start).Seconds())
+}
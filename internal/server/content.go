@@ -0,0 +1,332 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/logging"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/grpc/credentials"
+	grpcMD "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+	"github.com/go-tangra/go-tangra-paperless/internal/authz"
+	"github.com/go-tangra/go-tangra-paperless/internal/cert"
+	"github.com/go-tangra/go-tangra-paperless/internal/errdetail"
+	"github.com/go-tangra/go-tangra-paperless/internal/service"
+
+	"github.com/go-tangra/go-tangra-common/middleware/mtls"
+)
+
+const (
+	// contentOperation identifies content requests to middleware, e.g. in rate limits and logs
+	contentOperation = "/v1/documents/{id}/content"
+)
+
+// ContentServer streams document files over HTTP on GET /v1/documents/{id}/content, for
+// clients that can't reach the storage behind presigned URLs. Callers authenticate as on
+// gRPC: with a client certificate, and the x-md-global-* headers naming tenant and user.
+type ContentServer struct {
+	httpListener
+}
+
+// contentReply is what the content handler returns to middleware: the bytes it sent
+type contentReply struct {
+	written int64
+}
+
+// NewContentServer creates a ContentServer listening on PAPERLESS_CONTENT_ADDR, e.g.
+// 0.0.0.0:9404. The endpoint is disabled unless it is set, or when it is "off".
+func NewContentServer(ctx *bootstrap.Context, certManager *cert.CertManager, engine *authz.Engine, documentSvc *service.DocumentService, limiter *RateLimiter) *ContentServer {
+	addr := os.Getenv("PAPERLESS_CONTENT_ADDR")
+
+	s := &ContentServer{httpListener{
+		log:  ctx.NewLoggerHelper("paperless/content"),
+		addr: addr,
+		name: "document content",
+		path: contentOperation,
+	}}
+	if addr == "" || addr == "off" {
+		return s
+	}
+
+	// The middleware of the gRPC server that applies to downloads
+	ms := []middleware.Middleware{
+		recovery.Recovery(),
+		viewerMiddleware(engine),
+		tracing.Server(),
+		logging.Server(ctx.GetLogger()),
+		mtls.MTLSMiddleware(ctx.GetLogger()),
+	}
+	if limiter != nil {
+		ms = append(ms, rateLimitMiddleware(limiter))
+	}
+	chain := middleware.Chain(ms...)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+contentOperation, func(w http.ResponseWriter, r *http.Request) {
+		handler := chain(func(ctx context.Context, _ interface{}) (interface{}, error) {
+			return s.serveContent(ctx, w, r, documentSvc)
+		})
+		if _, err := handler(httpRequestContext(r, w, contentOperation), r.URL.Path); err != nil {
+			writeHTTPError(w, err)
+		}
+	})
+
+	s.serve(mux, serverTLSConfig(s.log, certManager))
+	return s
+}
+
+// serveContent writes a document's file, or the single byte range the request asks for.
+// Errors are returned before anything is written, so they get a proper status.
+func (s *ContentServer) serveContent(ctx context.Context, w http.ResponseWriter, r *http.Request, documentSvc *service.DocumentService) (*contentReply, error) {
+	disposition := paperlessV1.ContentDisposition_CONTENT_DISPOSITION_ATTACHMENT
+	if r.URL.Query().Get("disposition") == "inline" {
+		disposition = paperlessV1.ContentDisposition_CONTENT_DISPOSITION_INLINE
+	}
+
+	content, err := documentSvc.GetDocumentContent(ctx, r.PathValue("id"), disposition)
+	if err != nil {
+		return nil, err
+	}
+
+	written, err := writeFile(ctx, s.log, w, r, storedFile{
+		mimeType:    content.MimeType,
+		disposition: content.ContentDisposition,
+		size:        content.Size,
+		checksum:    content.Checksum,
+		modTime:     content.ModTime,
+		open:        content.Open,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &contentReply{written: written}, nil
+}
+
+// storedFile is a file in storage sent over HTTP by writeFile
+type storedFile struct {
+	mimeType    string
+	disposition string
+	size        int64
+	checksum    string
+	modTime     time.Time
+	// open streams length bytes of the file, starting at offset
+	open func(ctx context.Context, offset, length int64) (io.ReadCloser, error)
+}
+
+// writeFile writes a stored file, or the single byte range the request asks for, and returns
+// the number of bytes sent. Errors are returned before anything is written, so they get a
+// proper status.
+func writeFile(ctx context.Context, l *log.Helper, w http.ResponseWriter, r *http.Request, f storedFile) (int64, error) {
+	h := w.Header()
+	etag := ""
+	if f.checksum != "" {
+		etag = `"` + f.checksum + `"`
+		h.Set("ETag", etag)
+	}
+	if !f.modTime.IsZero() {
+		h.Set("Last-Modified", f.modTime.UTC().Format(http.TimeFormat))
+	}
+	// Permissions can change, so clients revalidate before reusing a cached copy
+	h.Set("Cache-Control", "private, no-cache")
+	h.Set("Accept-Ranges", "bytes")
+
+	if etag != "" && etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return 0, nil
+	}
+
+	offset, length, status := int64(0), f.size, http.StatusOK
+	if rangeHeader := r.Header.Get("Range"); rangeHeader != "" && ifRangeMatches(r.Header.Get("If-Range"), etag, f.modTime) {
+		start, n, ok := parseByteRange(rangeHeader, f.size)
+		switch {
+		case !ok:
+			// Malformed or multi-range requests get the whole file, as RFC 9110 allows
+		case n == 0:
+			h.Set("Content-Range", fmt.Sprintf("bytes */%d", f.size))
+			http.Error(w, "requested range not satisfiable", http.StatusRequestedRangeNotSatisfiable)
+			return 0, nil
+		default:
+			offset, length, status = start, n, http.StatusPartialContent
+			h.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, start+n-1, f.size))
+		}
+	}
+
+	// Ask storage for exactly the bytes sent, so a short stored object shows up as an error
+	var body io.ReadCloser
+	if r.Method != http.MethodHead && length > 0 {
+		var err error
+		if body, err = f.open(ctx, offset, length); err != nil {
+			h.Del("Content-Range")
+			return 0, err
+		}
+		defer body.Close()
+	}
+
+	mimeType := f.mimeType
+	if mimeType == "" {
+		mimeType = "application/octet-stream"
+	}
+	h.Set("Content-Type", mimeType)
+	if f.disposition != "" {
+		h.Set("Content-Disposition", f.disposition)
+	}
+	// Never let browsers reinterpret inline content, e.g. as HTML
+	h.Set("X-Content-Type-Options", "nosniff")
+	h.Set("Content-Length", strconv.FormatInt(length, 10))
+	w.WriteHeader(status)
+	if body == nil {
+		return 0, nil
+	}
+
+	written, err := io.CopyN(w, body, length)
+	if err != nil {
+		// The status is already sent; the client sees a short body and can resume with a range
+		l.Warnf("streaming %s stopped after %d of %d bytes: %v", r.URL.Path, written, length, err)
+	}
+	return written, nil
+}
+
+// parseByteRange parses a Range header of a single byte range against a file of size bytes.
+// ok is false for headers that are malformed or ask for several ranges; a length of 0 means
+// the range is not satisfiable.
+func parseByteRange(header string, size int64) (offset, length int64, ok bool) {
+	spec, found := strings.CutPrefix(header, "bytes=")
+	if !found || strings.Contains(spec, ",") {
+		return 0, 0, false
+	}
+	first, last, found := strings.Cut(strings.TrimSpace(spec), "-")
+	if !found {
+		return 0, 0, false
+	}
+
+	if first == "" {
+		// bytes=-N: the last N bytes
+		n, err := strconv.ParseInt(last, 10, 64)
+		if err != nil || n < 0 {
+			return 0, 0, false
+		}
+		n = min(n, size)
+		return size - n, n, true
+	}
+
+	start, err := strconv.ParseInt(first, 10, 64)
+	if err != nil || start < 0 {
+		return 0, 0, false
+	}
+	if start >= size {
+		return 0, 0, true
+	}
+	end := size - 1
+	if last != "" {
+		if end, err = strconv.ParseInt(last, 10, 64); err != nil || end < start {
+			return 0, 0, false
+		}
+		end = min(end, size-1)
+	}
+	return start, end - start + 1, true
+}
+
+// etagMatches reports whether an If-None-Match header lists etag
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// ifRangeMatches reports whether a Range header applies: If-Range is unset, or names the
+// current entity tag or modification time
+func ifRangeMatches(header, etag string, modTime time.Time) bool {
+	if header == "" {
+		return true
+	}
+	if strings.HasPrefix(header, `"`) {
+		return etag != "" && header == etag
+	}
+	t, err := http.ParseTime(header)
+	return err == nil && !modTime.IsZero() && !modTime.Truncate(time.Second).After(t)
+}
+
+// writeHTTPError writes err as a JSON error body with the status of its reason
+func writeHTTPError(w http.ResponseWriter, err error) {
+	se := kerrors.FromError(errdetail.Ensure(err))
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(int(se.Code))
+	_ = json.NewEncoder(w).Encode(se)
+}
+
+// httpRequestContext prepares the context the middleware expects from a gRPC call: the request
+// headers as incoming metadata, the client certificate as the peer, and the transport
+func httpRequestContext(r *http.Request, w http.ResponseWriter, operation string) context.Context {
+	md := grpcMD.MD{}
+	for name, values := range r.Header {
+		md.Append(name, values...)
+	}
+	ctx := grpcMD.NewIncomingContext(r.Context(), md)
+
+	p := &peer.Peer{}
+	if addr, err := net.ResolveTCPAddr("tcp", r.RemoteAddr); err == nil {
+		p.Addr = addr
+	}
+	if r.TLS != nil {
+		p.AuthInfo = credentials.TLSInfo{State: *r.TLS}
+	}
+	ctx = peer.NewContext(ctx, p)
+
+	return transport.NewServerContext(ctx, &httpTransport{
+		endpoint:    "http://" + r.Host,
+		operation:   operation,
+		reqHeader:   headerCarrier(r.Header),
+		replyHeader: headerCarrier(w.Header()),
+	})
+}
+
+// httpTransport is the transport.Transporter of a request to the content or GraphQL endpoint
+type httpTransport struct {
+	endpoint    string
+	operation   string
+	reqHeader   headerCarrier
+	replyHeader headerCarrier
+}
+
+func (t *httpTransport) Kind() transport.Kind            { return transport.KindHTTP }
+func (t *httpTransport) Endpoint() string                { return t.endpoint }
+func (t *httpTransport) Operation() string               { return t.operation }
+func (t *httpTransport) RequestHeader() transport.Header { return t.reqHeader }
+func (t *httpTransport) ReplyHeader() transport.Header   { return t.replyHeader }
+
+// headerCarrier adapts http.Header to transport.Header
+type headerCarrier http.Header
+
+func (h headerCarrier) Get(key string) string      { return http.Header(h).Get(key) }
+func (h headerCarrier) Set(key, value string)      { http.Header(h).Set(key, value) }
+func (h headerCarrier) Add(key, value string)      { http.Header(h).Add(key, value) }
+func (h headerCarrier) Values(key string) []string { return http.Header(h).Values(key) }
+func (h headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	return keys
+}
@@ -0,0 +1,20 @@
+package server
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/errdetail"
+)
+
+// errorDetailsMiddleware gives every service error a message key, so clients can translate
+// errors the services don't describe in detail by their reason
+func errorDetailsMiddleware() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			reply, err := handler(ctx, req)
+			return reply, errdetail.Ensure(err)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/logging"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+	"github.com/go-tangra/go-tangra-paperless/internal/authz"
+	"github.com/go-tangra/go-tangra-paperless/internal/cert"
+	"github.com/go-tangra/go-tangra-paperless/internal/data"
+	"github.com/go-tangra/go-tangra-paperless/internal/service"
+
+	"github.com/go-tangra/go-tangra-common/middleware/mtls"
+)
+
+const (
+	// graphqlOperation identifies GraphQL requests to middleware, e.g. in logs
+	graphqlOperation = "/graphql"
+
+	// maxGraphQLRequestSize bounds the JSON body of a query
+	maxGraphQLRequestSize = 1 << 20
+)
+
+// GraphQLServer serves GraphQL queries on POST /graphql. Callers authenticate as on gRPC:
+// with a client certificate, and the x-md-global-* headers naming tenant and user.
+type GraphQLServer struct {
+	httpListener
+}
+
+// graphqlRequest is the body of a GraphQL request
+type graphqlRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// NewGraphQLServer creates a GraphQLServer listening on PAPERLESS_GRAPHQL_ADDR, e.g.
+// 0.0.0.0:9405. The endpoint is disabled unless it is set, or when it is "off".
+func NewGraphQLServer(ctx *bootstrap.Context, certManager *cert.CertManager, engine *authz.Engine, auditLogRepo *data.AuditLogRepo, graphqlSvc *service.GraphQLService, limiter *RateLimiter) *GraphQLServer {
+	addr := os.Getenv("PAPERLESS_GRAPHQL_ADDR")
+
+	s := &GraphQLServer{httpListener{
+		log:  ctx.NewLoggerHelper("paperless/graphql"),
+		addr: addr,
+		name: "GraphQL",
+		path: graphqlOperation,
+	}}
+	if addr == "" || addr == "off" {
+		return s
+	}
+
+	// The middleware of the gRPC server that applies to queries
+	ms := []middleware.Middleware{
+		recovery.Recovery(),
+		viewerMiddleware(engine),
+		tracing.Server(),
+		logging.Server(ctx.GetLogger()),
+		mtls.MTLSMiddleware(ctx.GetLogger()),
+		auditMiddleware(ctx, auditLogRepo),
+	}
+	if limiter != nil {
+		ms = append(ms, rateLimitMiddleware(limiter))
+	}
+	chain := middleware.Chain(ms...)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST "+graphqlOperation, func(w http.ResponseWriter, r *http.Request) {
+		var req graphqlRequest
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxGraphQLRequestSize)).Decode(&req); err != nil {
+			writeHTTPError(w, paperlessV1.ErrorBadRequest("invalid GraphQL request: %v", err))
+			return
+		}
+
+		handler := chain(func(ctx context.Context, _ interface{}) (interface{}, error) {
+			return graphqlSvc.Exec(ctx, req.Query, req.OperationName, req.Variables), nil
+		})
+		resp, err := handler(httpRequestContext(r, w, graphqlOperation), &req)
+		if err != nil {
+			writeHTTPError(w, err)
+			return
+		}
+
+		// Query errors are part of the response, which is sent with 200 as GraphQL clients expect
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			s.log.Warnf("failed to write GraphQL response: %v", err)
+		}
+	})
+
+	s.serve(mux, serverTLSConfig(s.log, certManager))
+	return s
+}
@@ -2,47 +2,139 @@ package server
 
 import (
 	"context"
+	"strconv"
+	"strings"
 
 	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/middleware/logging"
 	"github.com/go-kratos/kratos/v2/middleware/metadata"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
-	"github.com/go-kratos/kratos/v2/middleware/validate"
+	"github.com/go-kratos/kratos/v2/transport"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	gogrpc "google.golang.org/grpc"
 
 	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+	"github.com/go-tangra/go-tangra-paperless/internal/authz"
 	"github.com/go-tangra/go-tangra-paperless/internal/cert"
 	"github.com/go-tangra/go-tangra-paperless/internal/data"
 	"github.com/go-tangra/go-tangra-paperless/internal/service"
 
+	"github.com/go-tangra/go-tangra-common/grpcx"
 	"github.com/go-tangra/go-tangra-common/middleware/audit"
 	"github.com/go-tangra/go-tangra-common/middleware/mtls"
 	appViewer "github.com/go-tangra/go-tangra-common/viewer"
 )
 
-// systemViewerMiddleware injects system viewer context for all requests
-// This allows the paperless service to bypass tenant privacy checks
-func systemViewerMiddleware() middleware.Middleware {
+// readOperationPrefixes are the method name prefixes of the RPCs that don't change anything
+var readOperationPrefixes = []string{"Get", "List", "Search", "Download", "Export", "Watch", "Check"}
+
+// viewerMiddleware injects the ent privacy viewer for each request. Tenant callers get a
+// viewer scoped to their tenant, so rows of other tenants are neither readable nor writable
+// even by ID. Tenant-less callers get the system viewer, and so do platform admins when the
+// bypass policy lets them perform the operation; otherwise they are scoped like anyone else.
+func viewerMiddleware(engine *authz.Engine) middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
-			ctx = appViewer.NewSystemViewerContext(ctx)
+			tenantID := grpcx.GetTenantIDFromContext(ctx)
+			userID := grpcx.GetUserIDFromContext(ctx)
+			if tenantID == 0 || engine.AdminBypass(ctx, tenantID, userID, operationPermission(ctx)) {
+				ctx = appViewer.NewSystemViewerContext(ctx)
+			} else {
+				uid, _ := strconv.ParseUint(userID, 10, 64)
+				ctx = data.NewTenantViewerContext(ctx, tenantID, uid)
+			}
 			return handler(ctx, req)
 		}
 	}
 }
 
+// operationPermission returns the permission the request's operation needs under the bypass
+// policy: downloads for the content endpoint, reads for GraphQL queries and RPCs that only
+// read, and writes for everything else
+func operationPermission(ctx context.Context) authz.Permission {
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return authz.PermissionWrite
+	}
+
+	switch operation := tr.Operation(); operation {
+	case contentOperation:
+		return authz.PermissionDownload
+	case graphqlOperation:
+		return authz.PermissionRead
+	default:
+		method := operation[strings.LastIndex(operation, "/")+1:]
+		for _, prefix := range readOperationPrefixes {
+			if strings.HasPrefix(method, prefix) {
+				return authz.PermissionRead
+			}
+		}
+		return authz.PermissionWrite
+	}
+}
+
+// streamMiddlewareInterceptor runs unary middleware once per stream against the stream
+// context. Kratos only applies its middleware to unary calls, so without this streaming
+// handlers would miss the system viewer and the mTLS check.
+func streamMiddlewareInterceptor(ms ...middleware.Middleware) gogrpc.StreamServerInterceptor {
+	chain := middleware.Chain(ms...)
+	return func(srv interface{}, ss gogrpc.ServerStream, info *gogrpc.StreamServerInfo, handler gogrpc.StreamHandler) error {
+		_, err := chain(func(ctx context.Context, _ interface{}) (interface{}, error) {
+			return nil, handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+		})(ss.Context(), nil)
+		return err
+	}
+}
+
+// contextStream overrides the context of a server stream
+type contextStream struct {
+	gogrpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+// auditMiddleware writes every request but the skipped operations to the request audit log
+func auditMiddleware(ctx *bootstrap.Context, auditLogRepo *data.AuditLogRepo, skipOperations ...string) middleware.Middleware {
+	return audit.Server(
+		ctx.GetLogger(),
+		audit.WithServiceName("paperless-service"),
+		audit.WithWriteAuditLogFunc(func(ctx context.Context, log *audit.AuditLog) error {
+			return auditLogRepo.CreateFromEntry(ctx, log.ToEntry())
+		}),
+		audit.WithSkipOperations(skipOperations...),
+	)
+}
+
 // NewGRPCServer creates a gRPC server with mTLS and audit logging
 func NewGRPCServer(
 	ctx *bootstrap.Context,
 	certManager *cert.CertManager,
+	engine *authz.Engine,
 	auditLogRepo *data.AuditLogRepo,
 	categorySvc *service.CategoryService,
 	documentSvc *service.DocumentService,
 	permissionSvc *service.PermissionService,
 	statisticsSvc *service.StatisticsService,
 	backupSvc *service.BackupService,
+	storageSvc *service.StorageService,
+	tenantSvc *service.TenantService,
+	quotaSvc *service.QuotaService,
+	settingsSvc *service.SettingsService,
+	auditSvc *service.AuditService,
+	healthSvc *service.HealthService,
+	webhookSvc *service.WebhookService,
+	importSvc *service.ImportService,
+	signatureSvc *service.SignatureService,
+	reviewSvc *service.ReviewService,
+	notificationSvc *service.NotificationService,
+	tagSvc *service.TagService,
+	limiter *RateLimiter,
+	idem *Idempotency,
 ) *grpc.Server {
 	cfg := ctx.GetConfig()
 	l := ctx.NewLoggerHelper("paperless/grpc")
@@ -77,40 +169,64 @@ func NewGRPCServer(
 
 	// Add middleware
 	var ms []middleware.Middleware
+	ms = append(ms, errorDetailsMiddleware()) // Give every error a translatable message key
 	ms = append(ms, recovery.Recovery())
-	ms = append(ms, systemViewerMiddleware()) // Inject system viewer for ENT privacy
+	ms = append(ms, viewerMiddleware(engine)) // Inject tenant or system viewer for ENT privacy
 	ms = append(ms, tracing.Server())
 	ms = append(ms, metadata.Server())
 	ms = append(ms, logging.Server(ctx.GetLogger()))
 
 	// Add mTLS middleware to extract client info from certificates
-	ms = append(ms, mtls.MTLSMiddleware(
+	mtlsMiddleware := mtls.MTLSMiddleware(
 		ctx.GetLogger(),
 		mtls.WithPublicEndpoints(
 			"/grpc.health.v1.Health/Check",
 			"/grpc.health.v1.Health/Watch",
 		),
-	))
+	)
+	ms = append(ms, mtlsMiddleware)
 
 	// Add audit logging middleware
-	ms = append(ms, audit.Server(
-		ctx.GetLogger(),
-		audit.WithServiceName("paperless-service"),
-		audit.WithWriteAuditLogFunc(func(ctx context.Context, log *audit.AuditLog) error {
-			return auditLogRepo.CreateFromEntry(ctx, log.ToEntry())
-		}),
-		audit.WithSkipOperations(
-			"/grpc.health.v1.Health/Check",
-			"/grpc.health.v1.Health/Watch",
-			"/paperless.service.v1.BackupService/ExportBackup",
-			"/paperless.service.v1.BackupService/ImportBackup",
-		),
+	ms = append(ms, auditMiddleware(ctx, auditLogRepo,
+		"/grpc.health.v1.Health/Check",
+		"/grpc.health.v1.Health/Watch",
+		"/paperless.service.v1.PaperlessHealthService/CheckHealth",
+		"/paperless.service.v1.BackupService/ExportBackup",
+		"/paperless.service.v1.BackupService/ImportBackup",
+		"/paperless.service.v1.BackupService/RestoreFromBackup",
 	))
 
-	ms = append(ms, validate.Validator())
+	// Throttle uploads and downloads per tenant and user
+	if limiter != nil {
+		ms = append(ms, rateLimitMiddleware(limiter))
+	}
+
+	// Reject requests that break the buf.validate rules of their messages
+	ms = append(ms, validationMiddleware())
+
+	// Replay the response of a mutating RPC retried with the same idempotency key
+	if idem != nil {
+		ms = append(ms, idempotencyMiddleware(idem))
+	}
 
 	opts = append(opts, grpc.Middleware(ms...))
 
+	// Streaming RPCs (backup export/import) get the system viewer, mTLS check and rate limits
+	sms := []middleware.Middleware{
+		errorDetailsMiddleware(),
+		recovery.Recovery(),
+		viewerMiddleware(engine),
+		mtlsMiddleware,
+	}
+	if limiter != nil {
+		sms = append(sms, rateLimitMiddleware(limiter))
+	}
+	streamInts := []gogrpc.StreamServerInterceptor{streamMiddlewareInterceptor(sms...), streamValidationInterceptor()}
+	if limiter != nil {
+		streamInts = append(streamInts, streamRateLimitInterceptor(limiter))
+	}
+	opts = append(opts, grpc.StreamInterceptor(streamInts...))
+
 	// Create gRPC server
 	srv := grpc.NewServer(opts...)
 
@@ -120,6 +236,18 @@ func NewGRPCServer(
 	paperlessV1.RegisterRedactedPaperlessPermissionServiceServer(srv, permissionSvc, nil)
 	paperlessV1.RegisterRedactedPaperlessStatisticsServiceServer(srv, statisticsSvc, nil)
 	paperlessV1.RegisterRedactedBackupServiceServer(srv, backupSvc, nil)
+	paperlessV1.RegisterRedactedPaperlessStorageServiceServer(srv, storageSvc, nil)
+	paperlessV1.RegisterRedactedPaperlessTenantServiceServer(srv, tenantSvc, nil)
+	paperlessV1.RegisterRedactedPaperlessQuotaServiceServer(srv, quotaSvc, nil)
+	paperlessV1.RegisterRedactedPaperlessSettingsServiceServer(srv, settingsSvc, nil)
+	paperlessV1.RegisterRedactedPaperlessAuditServiceServer(srv, auditSvc, nil)
+	paperlessV1.RegisterRedactedPaperlessHealthServiceServer(srv, healthSvc, nil)
+	paperlessV1.RegisterRedactedPaperlessWebhookServiceServer(srv, webhookSvc, nil)
+	paperlessV1.RegisterRedactedPaperlessImportServiceServer(srv, importSvc, nil)
+	paperlessV1.RegisterRedactedPaperlessSignatureServiceServer(srv, signatureSvc, nil)
+	paperlessV1.RegisterRedactedPaperlessReviewServiceServer(srv, reviewSvc, nil)
+	paperlessV1.RegisterRedactedPaperlessNotificationServiceServer(srv, notificationSvc, nil)
+	paperlessV1.RegisterRedactedPaperlessTagServiceServer(srv, tagSvc, nil)
 
 	return srv
 }
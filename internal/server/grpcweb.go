@@ -0,0 +1,93 @@
+package server
+
+import (
+	"crypto/tls"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/cert"
+)
+
+const (
+	// defaultGRPCWebAddr is where gRPC-Web is served unless PAPERLESS_GRPC_WEB_ADDR says otherwise
+	defaultGRPCWebAddr = "0.0.0.0:9403"
+
+	// grpcWebPingInterval keeps idle websocket streams such as WatchDocuments open behind proxies
+	grpcWebPingInterval = 30 * time.Second
+
+	// maxGRPCWebMessageSize bounds a websocket frame, which carries one request message
+	maxGRPCWebMessageSize = 64 << 20
+)
+
+// GRPCWebServer serves the gRPC services to browsers over gRPC-Web, with websockets for
+// streaming RPCs. Requests go through the gRPC server itself, so they pass the same
+// middleware, including the mTLS check.
+type GRPCWebServer struct {
+	httpListener
+}
+
+// NewGRPCWebServer creates a GRPCWebServer listening on PAPERLESS_GRPC_WEB_ADDR. Setting it to
+// "off" disables gRPC-Web. PAPERLESS_GRPC_WEB_ALLOWED_ORIGINS is a comma-separated list of
+// origins browsers may call from, or "*" for any; by default only same-origin requests pass.
+func NewGRPCWebServer(ctx *bootstrap.Context, gs *grpc.Server, certManager *cert.CertManager) *GRPCWebServer {
+	addr := defaultGRPCWebAddr
+	if v, ok := os.LookupEnv("PAPERLESS_GRPC_WEB_ADDR"); ok {
+		addr = v
+	}
+
+	s := &GRPCWebServer{httpListener{
+		log:  ctx.NewLoggerHelper("paperless/grpc-web"),
+		addr: addr,
+		name: "gRPC-Web",
+	}}
+	if addr == "off" {
+		return s
+	}
+
+	var origins []string
+	for _, origin := range strings.Split(os.Getenv("PAPERLESS_GRPC_WEB_ALLOWED_ORIGINS"), ",") {
+		if origin = strings.TrimRight(strings.TrimSpace(origin), "/"); origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+	allowOrigin := func(origin string) bool {
+		return slices.Contains(origins, "*") || slices.Contains(origins, origin)
+	}
+
+	wrapped := grpcweb.WrapServer(gs.Server,
+		grpcweb.WithOriginFunc(allowOrigin),
+		grpcweb.WithWebsockets(true),
+		grpcweb.WithWebsocketOriginFunc(func(r *http.Request) bool {
+			origin := r.Header.Get("Origin")
+			// Same-origin and non-browser clients are always allowed, as without websockets
+			return origin == "" || strings.TrimPrefix(strings.TrimPrefix(origin, "https://"), "http://") == r.Host || allowOrigin(origin)
+		}),
+		grpcweb.WithWebsocketPingInterval(grpcWebPingInterval),
+		grpcweb.WithWebsocketsMessageReadLimit(maxGRPCWebMessageSize),
+	)
+
+	s.serve(wrapped, serverTLSConfig(s.log, certManager))
+	return s
+}
+
+// serverTLSConfig returns the gRPC server's TLS config for an HTTP listener, so client
+// certificates reach the mTLS check, or nil when TLS is not enabled
+func serverTLSConfig(l *log.Helper, certManager *cert.CertManager) *tls.Config {
+	if certManager == nil || !certManager.IsTLSEnabled() {
+		return nil
+	}
+	tlsConfig, err := certManager.GetServerTLSConfig()
+	if err != nil {
+		l.Warnf("Failed to get TLS config, running without TLS: %v", err)
+		return nil
+	}
+	return tlsConfig
+}
@@ -0,0 +1,71 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// httpListener runs one of the optional HTTP endpoints next to the gRPC server. Servers embed
+// it for their transport.Server implementation; until serve is called the endpoint is disabled.
+type httpListener struct {
+	log  *log.Helper
+	addr string
+	srv  *http.Server
+
+	// name says what is served, for the logs
+	name string
+	// path is the route logged with the address, if the endpoint has a single one
+	path string
+}
+
+// serve sets up the endpoint to serve handler, over TLS when tlsConfig is set
+func (l *httpListener) serve(handler http.Handler, tlsConfig *tls.Config) {
+	l.srv = &http.Server{
+		Addr:              l.addr,
+		Handler:           handler,
+		ReadHeaderTimeout: 5 * time.Second,
+		TLSConfig:         tlsConfig,
+	}
+}
+
+// Start implements transport.Server
+func (l *httpListener) Start(ctx context.Context) error {
+	if l.srv == nil {
+		l.log.Infof("%s disabled", l.name)
+		return nil
+	}
+
+	// Listen up front so a taken port fails startup instead of being logged later
+	lis, err := net.Listen("tcp", l.addr)
+	if err != nil {
+		return err
+	}
+	l.log.Infof("serving %s on %s%s", l.name, l.addr, l.path)
+
+	go func() {
+		var err error
+		if l.srv.TLSConfig != nil {
+			err = l.srv.ServeTLS(lis, "", "")
+		} else {
+			err = l.srv.Serve(lis)
+		}
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			l.log.Errorf("%s server failed: %v", l.name, err)
+		}
+	}()
+	return nil
+}
+
+// Stop implements transport.Server
+func (l *httpListener) Stop(ctx context.Context) error {
+	if l.srv == nil {
+		return nil
+	}
+	return l.srv.Shutdown(ctx)
+}
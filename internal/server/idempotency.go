@@ -0,0 +1,276 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+	"github.com/go-tangra/go-tangra-paperless/internal/data"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+
+	"github.com/go-tangra/go-tangra-common/grpcx"
+	appViewer "github.com/go-tangra/go-tangra-common/viewer"
+)
+
+const (
+	// idempotencyKeyHeader carries the key a client chose for a request
+	idempotencyKeyHeader = "idempotency-key"
+
+	// idempotencyReplayedHeader tells a client that the response is the stored one
+	idempotencyReplayedHeader = "idempotency-replayed"
+
+	// defaultIdempotencyTTL is how long keys are kept unless PAPERLESS_IDEMPOTENCY_TTL says otherwise
+	defaultIdempotencyTTL = 24 * time.Hour
+
+	// maxIdempotencyKeyLength is the longest key accepted, the size of its column
+	maxIdempotencyKeyLength = 255
+
+	// idempotencyStaleAfter is when a request in progress is taken to have died with its
+	// replica. No RPC runs this long, so its key is free for a retry again.
+	idempotencyStaleAfter = 10 * time.Minute
+
+	// idempotencySweepInterval is how often expired keys are removed
+	idempotencySweepInterval = time.Hour
+)
+
+// idempotentOperations are the mutating RPCs that honor idempotency keys
+var idempotentOperations = map[string]bool{
+	"/paperless.service.v1.PaperlessDocumentService/CreateDocument":          true,
+	"/paperless.service.v1.PaperlessDocumentService/UpdateDocument":          true,
+	"/paperless.service.v1.PaperlessDocumentService/DeleteDocument":          true,
+	"/paperless.service.v1.PaperlessDocumentService/MoveDocument":            true,
+	"/paperless.service.v1.PaperlessDocumentService/BatchDeleteDocuments":    true,
+	"/paperless.service.v1.PaperlessDocumentService/AssignDocument":          true,
+	"/paperless.service.v1.PaperlessCategoryService/CreateCategory":          true,
+	"/paperless.service.v1.PaperlessCategoryService/UpdateCategory":          true,
+	"/paperless.service.v1.PaperlessCategoryService/DeleteCategory":          true,
+	"/paperless.service.v1.PaperlessCategoryService/MoveCategory":            true,
+	"/paperless.service.v1.PaperlessCategoryService/CopyCategoryTree":        true,
+	"/paperless.service.v1.PaperlessPermissionService/GrantAccess":           true,
+	"/paperless.service.v1.PaperlessPermissionService/RevokeAccess":          true,
+	"/paperless.service.v1.PaperlessPermissionService/ShareDocument":         true,
+	"/paperless.service.v1.PaperlessTagService/CreateTag":                    true,
+	"/paperless.service.v1.PaperlessTagService/DeleteTag":                    true,
+	"/paperless.service.v1.PaperlessTagService/MergeTags":                    true,
+	"/paperless.service.v1.PaperlessReviewService/RequestReview":             true,
+	"/paperless.service.v1.PaperlessReviewService/CompleteReview":            true,
+	"/paperless.service.v1.PaperlessReviewService/CancelReview":              true,
+	"/paperless.service.v1.PaperlessSignatureService/CreateSignatureRequest": true,
+	"/paperless.service.v1.PaperlessSignatureService/CancelSignatureRequest": true,
+	"/paperless.service.v1.PaperlessWebhookService/CreateWebhook":            true,
+	"/paperless.service.v1.PaperlessWebhookService/DeleteWebhook":            true,
+	"/paperless.service.v1.PaperlessImportService/CreateImportConnector":     true,
+	"/paperless.service.v1.PaperlessImportService/CreateImportMapping":       true,
+	"/paperless.service.v1.PaperlessImportService/SyncImportMapping":         true,
+	"/paperless.service.v1.PaperlessTenantService/DeleteTenantData":          true,
+}
+
+// Idempotency makes the mutating RPCs safe to retry. A request sent with an idempotency-key
+// header is recorded with a hash of its content; a retry with the same key gets the response
+// of the first request instead of applying it again. Keys are scoped to tenant and user, and
+// kept in the database, so every replica sees them.
+type Idempotency struct {
+	log  *log.Helper
+	repo *data.IdempotencyRepo
+	ttl  time.Duration
+
+	mu        sync.Mutex
+	lastSweep time.Time
+}
+
+// NewIdempotency creates an Idempotency keeping keys for PAPERLESS_IDEMPOTENCY_TTL, a Go
+// duration (default 24h). It returns nil when the TTL is 0, which ignores idempotency keys.
+func NewIdempotency(ctx *bootstrap.Context, repo *data.IdempotencyRepo) *Idempotency {
+	l := ctx.NewLoggerHelper("paperless/idempotency")
+
+	ttl := defaultIdempotencyTTL
+	if v := os.Getenv("PAPERLESS_IDEMPOTENCY_TTL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			l.Warnf("invalid PAPERLESS_IDEMPOTENCY_TTL %q, using %s", v, defaultIdempotencyTTL)
+		} else {
+			ttl = d
+		}
+	}
+	if ttl == 0 {
+		l.Info("idempotency keys disabled")
+		return nil
+	}
+
+	return &Idempotency{
+		log:  l,
+		repo: repo,
+		ttl:  ttl,
+	}
+}
+
+// idempotencyMiddleware runs a request with an idempotency key at most once per key. A retry
+// gets the stored response; a retry while the request is still running fails with
+// IDEMPOTENCY_KEY_IN_PROGRESS, and a key reused for another request with
+// IDEMPOTENCY_KEY_REUSED. Failed requests are not stored, so their retries run again.
+func idempotencyMiddleware(idem *Idempotency) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok || !idempotentOperations[tr.Operation()] {
+				return handler(ctx, req)
+			}
+			key := tr.RequestHeader().Get(idempotencyKeyHeader)
+			msg, isProto := req.(proto.Message)
+			if key == "" || !isProto {
+				return handler(ctx, req)
+			}
+			if len(key) > maxIdempotencyKeyLength {
+				return nil, paperlessV1.ErrorBadRequest("idempotency key is longer than %d characters", maxIdempotencyKeyLength)
+			}
+
+			hash, err := requestHash(msg)
+			if err != nil {
+				return nil, paperlessV1.ErrorInternalServerError("failed to hash request")
+			}
+
+			idem.maybeSweep()
+
+			record, err := idem.claim(ctx, grpcx.GetTenantIDFromContext(ctx), grpcx.GetUserIDFromContext(ctx), key, tr.Operation(), hash)
+			if err != nil {
+				return nil, err
+			}
+			if record.replay != nil {
+				tr.ReplyHeader().Set(idempotencyReplayedHeader, "true")
+				return record.replay, nil
+			}
+
+			reply, err := handler(ctx, req)
+
+			// Record the outcome even if the client went away, since that is when it retries
+			storeCtx := context.WithoutCancel(ctx)
+			if err != nil {
+				if releaseErr := idem.repo.Release(storeCtx, record.id); releaseErr != nil {
+					idem.log.Warnf("failed to release idempotency key after a failed request: %v", releaseErr)
+				}
+				return reply, err
+			}
+			if replyMsg, ok := reply.(proto.Message); ok {
+				if err := idem.complete(storeCtx, record.id, replyMsg); err != nil {
+					// The request was applied, so its response goes out regardless; the key
+					// stays in progress until it is stale
+					idem.log.Errorf("failed to store idempotent response: %v", err)
+				}
+			}
+			return reply, nil
+		}
+	}
+}
+
+// claimedKey is the outcome of claiming a key: the record to complete, or the stored reply
+type claimedKey struct {
+	id     uint32
+	replay proto.Message
+}
+
+// claim records a request under its key, or returns the stored response of an earlier one
+func (i *Idempotency) claim(ctx context.Context, tenantID uint32, userID, key, operation, hash string) (*claimedKey, error) {
+	// A second attempt follows when the key is held by an expired or stale record
+	for attempt := 0; ; attempt++ {
+		now := time.Now()
+		record, claimed, err := i.repo.Claim(ctx, tenantID, userID, key, operation, hash, now.Add(i.ttl))
+		if err != nil {
+			return nil, err
+		}
+		if claimed {
+			return &claimedKey{id: record.ID}, nil
+		}
+
+		if attempt == 0 && (record.ExpiresAt.Before(now) || (!record.Completed && createdBefore(record, now.Add(-idempotencyStaleAfter)))) {
+			if err := i.repo.Release(ctx, record.ID); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if record.Operation != operation || record.RequestHash != hash {
+			return nil, paperlessV1.ErrorIdempotencyKeyReused("idempotency key was used for a different request")
+		}
+		if !record.Completed {
+			return nil, paperlessV1.ErrorIdempotencyKeyInProgress("a request with this idempotency key is in progress")
+		}
+
+		stored := &anypb.Any{}
+		if err := proto.Unmarshal(record.Response, stored); err != nil {
+			i.log.Errorf("failed to decode stored response of idempotency key %d: %v", record.ID, err)
+			return nil, paperlessV1.ErrorInternalServerError("failed to decode stored response")
+		}
+		reply, err := stored.UnmarshalNew()
+		if err != nil {
+			i.log.Errorf("failed to decode stored response of idempotency key %d: %v", record.ID, err)
+			return nil, paperlessV1.ErrorInternalServerError("failed to decode stored response")
+		}
+		return &claimedKey{id: record.ID, replay: reply}, nil
+	}
+}
+
+// complete stores the response of a claimed request
+func (i *Idempotency) complete(ctx context.Context, id uint32, reply proto.Message) error {
+	stored, err := anypb.New(reply)
+	if err != nil {
+		return err
+	}
+	encoded, err := proto.Marshal(stored)
+	if err != nil {
+		return err
+	}
+	return i.repo.Complete(ctx, id, encoded)
+}
+
+// maybeSweep removes expired keys in the background, at most once per idempotencySweepInterval
+func (i *Idempotency) maybeSweep() {
+	i.mu.Lock()
+	now := time.Now()
+	if now.Sub(i.lastSweep) < idempotencySweepInterval {
+		i.mu.Unlock()
+		return
+	}
+	i.lastSweep = now
+	i.mu.Unlock()
+
+	go func() {
+		// The sweep covers every tenant, so it acts as the system for ent privacy
+		ctx, cancel := context.WithTimeout(appViewer.NewSystemViewerContext(context.Background()), time.Minute)
+		defer cancel()
+
+		n, err := i.repo.DeleteExpired(ctx, now)
+		if err != nil {
+			i.log.Warnf("failed to remove expired idempotency keys: %v", err)
+			return
+		}
+		if n > 0 {
+			i.log.Infof("removed %d expired idempotency keys", n)
+		}
+	}()
+}
+
+// requestHash identifies the content of a request
+func requestHash(msg proto.Message) (string, error) {
+	encoded, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// createdBefore reports whether a record was created before t
+func createdBefore(record *ent.IdempotencyKey, t time.Time) bool {
+	return record.CreateTime != nil && record.CreateTime.Before(t)
+}
@@ -0,0 +1,103 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data"
+)
+
+// defaultLocalFilesAddr is where presigned URLs of the local storage driver are served unless
+// PAPERLESS_LOCAL_FILES_ADDR says otherwise
+const defaultLocalFilesAddr = "0.0.0.0:9406"
+
+// LocalFileServer serves the presigned URLs issued by backends using the local storage driver,
+// which point below their LOCAL_STORAGE_PUBLIC_URL. As with S3, the URL's signature is the
+// only credential, so no client certificate is asked for. It only listens when such a backend
+// is configured.
+type LocalFileServer struct {
+	httpListener
+	backends []*data.LocalStorage
+}
+
+// NewLocalFileServer creates a LocalFileServer listening on PAPERLESS_LOCAL_FILES_ADDR.
+// Setting it to "off" disables the endpoint.
+func NewLocalFileServer(ctx *bootstrap.Context, router *data.StorageRouter) *LocalFileServer {
+	addr := defaultLocalFilesAddr
+	if v, ok := os.LookupEnv("PAPERLESS_LOCAL_FILES_ADDR"); ok {
+		addr = v
+	}
+
+	s := &LocalFileServer{
+		httpListener: httpListener{
+			log:  ctx.NewLoggerHelper("paperless/local-files"),
+			addr: addr,
+			name: "local storage files",
+		},
+		backends: router.LocalBackends(),
+	}
+	if addr == "off" || len(s.backends) == 0 {
+		return s
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{key...}", s.serveFile)
+	s.serve(mux, nil)
+	return s
+}
+
+// serveFile streams the object of a presigned URL from the backend that signed it. The key
+// may exist on several backends, e.g. during a migration, so each one is asked in turn.
+func (s *LocalFileServer) serveFile(w http.ResponseWriter, r *http.Request) {
+	key := r.PathValue("key")
+	query := r.URL.Query()
+
+	signed := false
+	for _, backend := range s.backends {
+		if !backend.VerifyPresignedURL(key, query) {
+			continue
+		}
+		signed = true
+
+		info, err := backend.Stat(r.Context(), key)
+		if errors.Is(err, data.ErrObjectNotFound) {
+			continue
+		}
+		if err != nil {
+			s.log.Errorf("stat %s failed: %v", key, err)
+			http.Error(w, "failed to read file", http.StatusInternalServerError)
+			return
+		}
+
+		contentType := query.Get("response-content-type")
+		if contentType == "" {
+			contentType = info.ContentType
+		}
+		_, err = writeFile(r.Context(), s.log, w, r, storedFile{
+			mimeType:    contentType,
+			disposition: query.Get("response-content-disposition"),
+			size:        info.Size,
+			checksum:    info.Checksum,
+			modTime:     info.LastModified,
+			open: func(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+				return backend.Open(ctx, key, offset, length)
+			},
+		})
+		if err != nil {
+			s.log.Errorf("open %s failed: %v", key, err)
+			http.Error(w, "failed to read file", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	if signed {
+		http.Error(w, "file not found", http.StatusNotFound)
+		return
+	}
+	http.Error(w, "invalid or expired URL", http.StatusForbidden)
+}
@@ -0,0 +1,43 @@
+package server
+
+import (
+	"net/http"
+	"os"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/metrics"
+)
+
+// defaultMetricsAddr is where Prometheus metrics are served unless PAPERLESS_METRICS_ADDR says otherwise
+const defaultMetricsAddr = "0.0.0.0:9401"
+
+// MetricsServer serves Prometheus metrics on /metrics
+type MetricsServer struct {
+	httpListener
+}
+
+// NewMetricsServer creates a MetricsServer listening on PAPERLESS_METRICS_ADDR.
+// Setting it to "off" disables the endpoint.
+func NewMetricsServer(ctx *bootstrap.Context) *MetricsServer {
+	addr := defaultMetricsAddr
+	if v, ok := os.LookupEnv("PAPERLESS_METRICS_ADDR"); ok {
+		addr = v
+	}
+
+	s := &MetricsServer{httpListener{
+		log:  ctx.NewLoggerHelper("paperless/metrics"),
+		addr: addr,
+		name: "metrics",
+		path: "/metrics",
+	}}
+	if addr == "off" {
+		return s
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(metrics.Registry, promhttp.HandlerOpts{Registry: metrics.Registry}))
+	s.serve(mux, nil)
+	return s
+}
@@ -18,4 +18,12 @@ import (
 var ProviderSet = wire.NewSet(
 	cert.NewCertManager,
 	server.NewGRPCServer,
+	server.NewMetricsServer,
+	server.NewGRPCWebServer,
+	server.NewContentServer,
+	server.NewLocalFileServer,
+	server.NewGraphQLServer,
+	server.NewRateLimiter,
+	server.NewIdempotency,
+	server.NewSignatureCallbackServer,
 )
@@ -0,0 +1,321 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	gogrpc "google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+	"github.com/go-tangra/go-tangra-paperless/internal/metrics"
+
+	"github.com/go-tangra/go-tangra-common/grpcx"
+)
+
+// Directions of rate limited traffic; uploads, downloads and GraphQL queries have separate
+// budgets
+const (
+	rateLimitUpload   = "upload"
+	rateLimitDownload = "download"
+	rateLimitQuery    = "query"
+)
+
+// rateLimitedOperations maps the rate limited RPCs to their direction
+var rateLimitedOperations = map[string]string{
+	"/paperless.service.v1.PaperlessDocumentService/CreateDocument":         rateLimitUpload,
+	"/paperless.service.v1.PaperlessDocumentService/DownloadDocument":       rateLimitDownload,
+	"/paperless.service.v1.PaperlessDocumentService/GetDocumentDownloadUrl": rateLimitDownload,
+	"/paperless.service.v1.PaperlessDocumentService/GetDocumentPreviewUrl":  rateLimitDownload,
+	contentOperation: rateLimitDownload,
+	graphqlOperation: rateLimitQuery,
+
+	// Streams are admitted like unary calls and charged the bytes they send
+	"/paperless.service.v1.PaperlessCategoryService/ExportCategory": rateLimitDownload,
+	"/paperless.service.v1.BackupService/ExportBackupStream":        rateLimitDownload,
+}
+
+// rateLimitSweepInterval is how often buckets that refilled completely are dropped
+const rateLimitSweepInterval = time.Minute
+
+// rateLimit is one configured limit, refilling rate tokens per second up to burst
+type rateLimit struct {
+	name  string
+	rate  float64
+	burst float64
+}
+
+// tokenBucket is the budget one tenant or user has left under a rateLimit
+type tokenBucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+// rateCharge is what a request takes from one bucket
+type rateCharge struct {
+	limit *rateLimit
+	key   string
+	cost  float64
+}
+
+// RateLimiter keeps token buckets per tenant and per user for requests per minute and bytes
+// per hour. A file larger than a byte limit is let through on a full bucket and leaves it in
+// debt, so it is throttled rather than refused for good. Buckets live in memory, so every
+// replica enforces the limits on its own.
+type RateLimiter struct {
+	tenantRequests *rateLimit
+	userRequests   *rateLimit
+	tenantBytes    *rateLimit
+	userBytes      *rateLimit
+
+	mu        sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+
+// NewRateLimiter reads PAPERLESS_RATE_LIMIT_TENANT_REQUESTS_PER_MINUTE,
+// PAPERLESS_RATE_LIMIT_USER_REQUESTS_PER_MINUTE, PAPERLESS_RATE_LIMIT_TENANT_BYTES_PER_HOUR and
+// PAPERLESS_RATE_LIMIT_USER_BYTES_PER_HOUR. It returns nil when no limit is set. The gRPC and
+// HTTP servers share one limiter, so a download counts the same on either.
+func NewRateLimiter(ctx *bootstrap.Context) *RateLimiter {
+	l := ctx.NewLoggerHelper("paperless/ratelimit")
+	r := &RateLimiter{
+		tenantRequests: rateLimitFromEnv(l, "PAPERLESS_RATE_LIMIT_TENANT_REQUESTS_PER_MINUTE", "tenant_requests", time.Minute),
+		userRequests:   rateLimitFromEnv(l, "PAPERLESS_RATE_LIMIT_USER_REQUESTS_PER_MINUTE", "user_requests", time.Minute),
+		tenantBytes:    rateLimitFromEnv(l, "PAPERLESS_RATE_LIMIT_TENANT_BYTES_PER_HOUR", "tenant_bytes", time.Hour),
+		userBytes:      rateLimitFromEnv(l, "PAPERLESS_RATE_LIMIT_USER_BYTES_PER_HOUR", "user_bytes", time.Hour),
+		buckets:        make(map[string]*tokenBucket),
+	}
+	if r.tenantRequests == nil && r.userRequests == nil && r.tenantBytes == nil && r.userBytes == nil {
+		return nil
+	}
+	l.Infof("upload and download rate limits enabled")
+	return r
+}
+
+// rateLimitFromEnv returns the limit set in env, or nil if it is unset or 0
+func rateLimitFromEnv(l *log.Helper, env, name string, per time.Duration) *rateLimit {
+	v := os.Getenv(env)
+	if v == "" {
+		return nil
+	}
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || n < 0 {
+		l.Warnf("invalid %s %q, not limiting", env, v)
+		return nil
+	}
+	if n == 0 {
+		return nil
+	}
+	return &rateLimit{
+		name:  name,
+		rate:  float64(n) / per.Seconds(),
+		burst: float64(n),
+	}
+}
+
+// charges returns what a request takes from the buckets of its tenant and user. bytes is
+// the size of an upload, or 0 to only require that the byte budget is not in debt.
+func (r *RateLimiter) charges(direction string, tenantID uint32, userID string, bytes int64) []rateCharge {
+	var charges []rateCharge
+	add := func(limit *rateLimit, key string, cost float64) {
+		if limit != nil {
+			charges = append(charges, rateCharge{limit: limit, key: limit.name + "/" + direction + "/" + key, cost: cost})
+		}
+	}
+
+	if tenantID != 0 {
+		tenantKey := strconv.FormatUint(uint64(tenantID), 10)
+		add(r.tenantRequests, tenantKey, 1)
+		add(r.tenantBytes, tenantKey, float64(bytes))
+	}
+	if userID != "" {
+		userKey := fmt.Sprintf("%d/%s", tenantID, userID)
+		add(r.userRequests, userKey, 1)
+		add(r.userBytes, userKey, float64(bytes))
+	}
+	return charges
+}
+
+// byteCharges returns the charges of bytes sent or received after a request was admitted,
+// which only take from the byte budgets
+func (r *RateLimiter) byteCharges(direction string, tenantID uint32, userID string, bytes int64) []rateCharge {
+	var charges []rateCharge
+	for _, c := range r.charges(direction, tenantID, userID, bytes) {
+		if c.limit == r.tenantBytes || c.limit == r.userBytes {
+			charges = append(charges, c)
+		}
+	}
+	return charges
+}
+
+// admit takes the charges if every bucket can afford them. Otherwise nothing is taken and
+// it returns how long until they can, and the limit that ran out.
+func (r *RateLimiter) admit(charges []rateCharge, now time.Time) (time.Duration, string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sweep(now)
+
+	var (
+		wait    time.Duration
+		limited string
+	)
+	for _, c := range charges {
+		b := r.bucket(c, now)
+		if need := math.Min(c.cost, c.limit.burst); b.tokens < need {
+			if w := time.Duration((need - b.tokens) / c.limit.rate * float64(time.Second)); w > wait {
+				wait, limited = w, c.limit.name
+			}
+		}
+	}
+	if wait > 0 {
+		return wait, limited
+	}
+
+	for _, c := range charges {
+		r.bucket(c, now).tokens -= c.cost
+	}
+	return 0, ""
+}
+
+// charge takes costs known only after the request, e.g. the bytes of a download
+func (r *RateLimiter) charge(charges []rateCharge, now time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, c := range charges {
+		r.bucket(c, now).tokens -= c.cost
+	}
+}
+
+// bucket returns the refilled bucket of a charge, creating a full one on first use
+func (r *RateLimiter) bucket(c rateCharge, now time.Time) *tokenBucket {
+	b, ok := r.buckets[c.key]
+	if !ok {
+		b = &tokenBucket{tokens: c.limit.burst, updated: now}
+		r.buckets[c.key] = b
+		return b
+	}
+	b.tokens = math.Min(c.limit.burst, b.tokens+now.Sub(b.updated).Seconds()*c.limit.rate)
+	b.updated = now
+	return b
+}
+
+// sweep drops buckets that have refilled completely, since a new bucket starts full anyway
+func (r *RateLimiter) sweep(now time.Time) {
+	if now.Sub(r.lastSweep) < rateLimitSweepInterval {
+		return
+	}
+	r.lastSweep = now
+
+	for _, limit := range []*rateLimit{r.tenantRequests, r.userRequests, r.tenantBytes, r.userBytes} {
+		if limit == nil {
+			continue
+		}
+		prefix := limit.name + "/"
+		for key, b := range r.buckets {
+			if strings.HasPrefix(key, prefix) &&
+				b.tokens+now.Sub(b.updated).Seconds()*limit.rate >= limit.burst {
+				delete(r.buckets, key)
+			}
+		}
+	}
+}
+
+// rateLimitMiddleware rejects uploads, downloads and GraphQL queries of tenants and users over
+// their limits with RATE_LIMITED (RESOURCE_EXHAUSTED in gRPC), telling them in the retry-after
+// header and error metadata how many seconds to wait
+func rateLimitMiddleware(limiter *RateLimiter) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			direction, limited := rateLimitedOperations[tr.Operation()]
+			if !limited {
+				return handler(ctx, req)
+			}
+
+			tenantID := grpcx.GetTenantIDFromContext(ctx)
+			userID := grpcx.GetUserIDFromContext(ctx)
+
+			var size int64
+			if upload, ok := req.(*paperlessV1.CreateDocumentRequest); ok {
+				size = int64(len(upload.FileContent))
+			}
+
+			if wait, limit := limiter.admit(limiter.charges(direction, tenantID, userID, size), time.Now()); wait > 0 {
+				metrics.RateLimited.WithLabelValues(direction, limit).Inc()
+				retryAfter := strconv.FormatInt(int64(math.Ceil(wait.Seconds())), 10)
+				tr.ReplyHeader().Set("retry-after", retryAfter)
+				return nil, paperlessV1.ErrorRateLimited("%s rate limit exceeded, retry in %s seconds", direction, retryAfter).
+					WithMetadata(map[string]string{"retry_after": retryAfter, "limit": limit})
+			}
+
+			reply, err := handler(ctx, req)
+
+			// Downloads served through the service count their bytes once they are known;
+			// presigned URLs only count as requests
+			var downloaded int64
+			switch reply := reply.(type) {
+			case *paperlessV1.DownloadDocumentResponse:
+				downloaded = int64(len(reply.Content))
+			case *contentReply:
+				downloaded = reply.written
+			}
+			if err == nil && downloaded > 0 {
+				limiter.charge(limiter.byteCharges(direction, tenantID, userID, downloaded), time.Now())
+			}
+			return reply, err
+		}
+	}
+}
+
+// streamRateLimitInterceptor charges the messages a rate limited stream sends to the byte
+// budgets of its tenant and user as they go out. The stream is admitted by rateLimitMiddleware,
+// which runs in the stream middleware chain.
+func streamRateLimitInterceptor(limiter *RateLimiter) gogrpc.StreamServerInterceptor {
+	return func(srv interface{}, ss gogrpc.ServerStream, info *gogrpc.StreamServerInfo, handler gogrpc.StreamHandler) error {
+		direction, limited := rateLimitedOperations[info.FullMethod]
+		if !limited {
+			return handler(srv, ss)
+		}
+		return handler(srv, &meteredStream{
+			ServerStream: ss,
+			limiter:      limiter,
+			direction:    direction,
+			tenantID:     grpcx.GetTenantIDFromContext(ss.Context()),
+			userID:       grpcx.GetUserIDFromContext(ss.Context()),
+		})
+	}
+}
+
+// meteredStream charges the size of every message sent on a server stream to the byte budgets
+type meteredStream struct {
+	gogrpc.ServerStream
+	limiter   *RateLimiter
+	direction string
+	tenantID  uint32
+	userID    string
+}
+
+func (s *meteredStream) SendMsg(m interface{}) error {
+	if err := s.ServerStream.SendMsg(m); err != nil {
+		return err
+	}
+	if msg, ok := m.(proto.Message); ok {
+		s.limiter.charge(s.limiter.byteCharges(s.direction, s.tenantID, s.userID, int64(proto.Size(msg))), time.Now())
+	}
+	return nil
+}
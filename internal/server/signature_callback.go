@@ -0,0 +1,69 @@
+package server
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/service"
+)
+
+const (
+	// defaultSignatureCallbackAddr is where e-signature callbacks are received unless
+	// PAPERLESS_ESIGN_CALLBACK_ADDR says otherwise
+	defaultSignatureCallbackAddr = "0.0.0.0:9402"
+
+	signatureCallbackPath = "/v1/signatures/callback"
+
+	// maxSignatureCallbackSize bounds a notification, which carries envelope metadata only
+	maxSignatureCallbackSize = 1 << 20
+)
+
+// SignatureCallbackServer receives the status notifications of the e-signature provider on
+// /v1/signatures/callback. It only listens when a provider is configured.
+type SignatureCallbackServer struct {
+	httpListener
+}
+
+// NewSignatureCallbackServer creates a SignatureCallbackServer listening on PAPERLESS_ESIGN_CALLBACK_ADDR
+func NewSignatureCallbackServer(ctx *bootstrap.Context, signatureSvc *service.SignatureService) *SignatureCallbackServer {
+	addr := defaultSignatureCallbackAddr
+	if v := os.Getenv("PAPERLESS_ESIGN_CALLBACK_ADDR"); v != "" {
+		addr = v
+	}
+
+	s := &SignatureCallbackServer{httpListener{
+		log:  ctx.NewLoggerHelper("paperless/signature-callback"),
+		addr: addr,
+		name: "e-signature callbacks",
+		path: signatureCallbackPath,
+	}}
+	if !signatureSvc.Enabled() {
+		return s
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST "+signatureCallbackPath, func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxSignatureCallbackSize))
+		if err != nil {
+			http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		if err := signatureSvc.HandleCallback(r.Context(), r.Header, body); err != nil {
+			if errors.Is(err, service.ErrInvalidSignatureCallback) {
+				http.Error(w, "invalid callback", http.StatusUnauthorized)
+				return
+			}
+			// Any other status makes the provider retry the notification
+			http.Error(w, "callback failed", http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	s.serve(mux, nil)
+	return s
+}
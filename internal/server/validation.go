@@ -0,0 +1,126 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"buf.build/go/protovalidate"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	gogrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/errdetail"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// validationMiddleware checks each request against the buf.validate rules of its message
+// before it reaches a service, so repos never see empty names, absurd page sizes or
+// malformed IDs
+func validationMiddleware() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if err := validateRequest(req); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// streamValidationInterceptor checks every message a client sends on a stream, like
+// validationMiddleware does for unary requests
+func streamValidationInterceptor() gogrpc.StreamServerInterceptor {
+	return func(srv interface{}, ss gogrpc.ServerStream, _ *gogrpc.StreamServerInfo, handler gogrpc.StreamHandler) error {
+		return handler(srv, &validatedStream{ServerStream: ss})
+	}
+}
+
+// validatedStream validates the messages received on a server stream
+type validatedStream struct {
+	gogrpc.ServerStream
+}
+
+func (s *validatedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return validateRequest(m)
+}
+
+// validateRequest returns a BAD_REQUEST listing every rule req violates, or nil
+func validateRequest(req interface{}) error {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	err := protovalidate.Validate(msg)
+	if err == nil {
+		return nil
+	}
+	var valErr *protovalidate.ValidationError
+	if !errors.As(err, &valErr) {
+		// The rules of the message could not be compiled or evaluated; that is not the client's fault
+		return paperlessV1.ErrorInternalServerError("failed to validate request")
+	}
+
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(valErr.Violations))
+	descriptions := make([]string, 0, len(valErr.Violations))
+	fields := make(map[string]string, len(valErr.Violations)+1)
+	for _, v := range valErr.Violations {
+		field := protovalidate.FieldPathString(v.Proto.GetField())
+		if field == "" {
+			// A rule on the message itself, such as a CEL expression
+			field = v.Proto.GetRuleId()
+		}
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       field,
+			Description: v.Proto.GetMessage(),
+		})
+		descriptions = append(descriptions, field+": "+v.Proto.GetMessage())
+		if prev, ok := fields[field]; ok {
+			fields[field] = prev + "; " + v.Proto.GetMessage()
+		} else {
+			fields[field] = v.Proto.GetMessage()
+		}
+	}
+
+	fields[errdetail.MessageKeyMetadata] = errdetail.KeyRequestInvalid
+
+	return &validationError{
+		err:        paperlessV1.ErrorBadRequest("invalid request: %s", strings.Join(descriptions, ", ")).WithMetadata(fields),
+		violations: violations,
+	}
+}
+
+// validationError is a BAD_REQUEST whose gRPC status carries a google.rpc.BadRequest with
+// one violation per field, next to the ErrorInfo every service error has. Clients that only
+// know service errors still find the violated fields in its metadata.
+type validationError struct {
+	err        *kerrors.Error
+	violations []*errdetails.BadRequest_FieldViolation
+}
+
+func (e *validationError) Error() string {
+	return e.err.Error()
+}
+
+// GRPCStatus returns the INVALID_ARGUMENT status with the violations attached
+func (e *validationError) GRPCStatus() *status.Status {
+	st := e.err.GRPCStatus()
+	withDetails, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: e.violations})
+	if err != nil {
+		return st
+	}
+	return withDetails
+}
+
+// Unwrap returns the service error, so errors.FromError and the generated Is helpers see it
+func (e *validationError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data"
+)
+
+// auditRetentionInterval is how often expired audit records are purged
+const auditRetentionInterval = 24 * time.Hour
+
+// AuditRetention purges audit events and request audit logs older than
+// PAPERLESS_AUDIT_RETENTION. Audit records are kept forever when it is unset.
+// It runs as an app server, purging at startup and then daily.
+type AuditRetention struct {
+	backgroundJob
+
+	log          *log.Helper
+	auditRepo    *data.AuditEventRepo
+	auditLogRepo *data.AuditLogRepo
+
+	retention time.Duration
+}
+
+// NewAuditRetention creates an AuditRetention configured by PAPERLESS_AUDIT_RETENTION,
+// a Go duration or a number of days such as "365d"
+func NewAuditRetention(ctx *bootstrap.Context, auditRepo *data.AuditEventRepo, auditLogRepo *data.AuditLogRepo) *AuditRetention {
+	l := ctx.NewLoggerHelper("paperless/service/audit_retention")
+
+	r := &AuditRetention{
+		backgroundJob: backgroundJob{immediate: true, log: l},
+		log:           l,
+		auditRepo:     auditRepo,
+		auditLogRepo:  auditLogRepo,
+	}
+
+	if v := os.Getenv("PAPERLESS_AUDIT_RETENTION"); v != "" {
+		retention, err := parseRetention(v)
+		if err != nil || retention <= 0 {
+			l.Warnf("invalid PAPERLESS_AUDIT_RETENTION %q, audit records are kept forever", v)
+		} else {
+			r.retention = retention
+		}
+	}
+
+	if r.retention > 0 {
+		r.interval = auditRetentionInterval
+	}
+	r.name = fmt.Sprintf("audit retention %s, purging", r.retention)
+	r.tick = r.Purge
+
+	return r
+}
+
+// parseRetention parses a Go duration, or whole days with a "d" suffix
+func parseRetention(v string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(v, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, err
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(v)
+}
+
+// Purge deletes audit records older than the retention period
+func (r *AuditRetention) Purge(ctx context.Context) {
+	before := time.Now().Add(-r.retention)
+
+	events, err := r.auditRepo.DeleteOlderThan(ctx, before)
+	if err != nil {
+		r.log.Errorf("purge audit events failed: %s", err.Error())
+	}
+	logs, err := r.auditLogRepo.DeleteOlderThan(ctx, before)
+	if err != nil {
+		r.log.Errorf("purge audit logs failed: %s", err.Error())
+	}
+
+	if events > 0 || logs > 0 {
+		r.log.Infof("purged %d audit events and %d audit logs older than %s", events, logs, before.Format(time.RFC3339))
+	}
+}
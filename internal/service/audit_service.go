@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/authz"
+	"github.com/go-tangra/go-tangra-paperless/internal/data"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/auditevent"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+const (
+	defaultAuditPageSize = 20
+	maxAuditPageSize     = 100
+)
+
+type AuditService struct {
+	paperlessV1.UnimplementedPaperlessAuditServiceServer
+
+	log       *log.Helper
+	auditRepo *data.AuditEventRepo
+	engine    *authz.Engine
+	checker   *authz.Checker
+}
+
+func NewAuditService(
+	ctx *bootstrap.Context,
+	auditRepo *data.AuditEventRepo,
+	engine *authz.Engine,
+	checker *authz.Checker,
+) *AuditService {
+	return &AuditService{
+		log:       ctx.NewLoggerHelper("paperless/service/audit"),
+		auditRepo: auditRepo,
+		engine:    engine,
+		checker:   checker,
+	}
+}
+
+// ListAuditEvents lists audit events. Platform admins see every event of the tenant, or of
+// another tenant when tenant_id is set (0 for all tenants). Other users may list their own
+// events or the events of a resource they can read.
+func (s *AuditService) ListAuditEvents(ctx context.Context, req *paperlessV1.ListAuditEventsRequest) (*paperlessV1.ListAuditEventsResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	opts := &data.AuditEventListOptions{
+		TenantID:   &tenantID,
+		UserID:     req.UserId,
+		ResourceID: req.ResourceId,
+	}
+
+	if s.engine.AdminBypass(ctx, tenantID, userID, authz.PermissionRead) {
+		if req.TenantId != nil {
+			opts.TenantID = req.TenantId
+			if req.GetTenantId() == 0 {
+				opts.TenantID = nil
+			}
+		}
+	} else {
+		if req.TenantId != nil && req.GetTenantId() != tenantID {
+			return nil, paperlessV1.ErrorAccessDenied("audit events of other tenants require platform admin access")
+		}
+		if err := s.checkResourceAccess(ctx, tenantID, userID, req); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Action != nil && req.GetAction() != paperlessV1.AuditAction_AUDIT_ACTION_UNSPECIFIED {
+		action := auditevent.Action(req.GetAction().String())
+		opts.Action = &action
+	}
+	if req.ResourceType != nil && req.GetResourceType() != paperlessV1.ResourceType_RESOURCE_TYPE_UNSPECIFIED {
+		resourceType := auditevent.ResourceType(req.GetResourceType().String())
+		opts.ResourceType = &resourceType
+	}
+	if req.StartTime != nil {
+		t := req.GetStartTime().AsTime()
+		opts.StartTime = &t
+	}
+	if req.EndTime != nil {
+		t := req.GetEndTime().AsTime()
+		opts.EndTime = &t
+	}
+
+	page := uint32(1)
+	if req.Page != nil && *req.Page > 0 {
+		page = *req.Page
+	}
+	pageSize := uint32(defaultAuditPageSize)
+	if req.PageSize != nil && *req.PageSize > 0 {
+		pageSize = min(*req.PageSize, maxAuditPageSize)
+	}
+	opts.Limit = int(pageSize)
+	opts.Offset = int((page - 1) * pageSize)
+
+	events, total, err := s.auditRepo.List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	protoEvents := make([]*paperlessV1.AuditEvent, 0, len(events))
+	for _, e := range events {
+		protoEvents = append(protoEvents, auditEventToProto(e))
+	}
+
+	return &paperlessV1.ListAuditEventsResponse{
+		Events: protoEvents,
+		Total:  uint32(total),
+	}, nil
+}
+
+// checkResourceAccess allows non-admins to list their own events, or the events of a single
+// resource they can read
+func (s *AuditService) checkResourceAccess(ctx context.Context, tenantID uint32, userID string, req *paperlessV1.ListAuditEventsRequest) error {
+	if req.GetResourceId() == "" {
+		if req.UserId != nil && req.GetUserId() == userID {
+			return nil
+		}
+		return paperlessV1.ErrorAccessDenied("listing audit events requires a resource, your own user ID or platform admin access")
+	}
+
+	var err error
+	switch req.GetResourceType() {
+	case paperlessV1.ResourceType_RESOURCE_TYPE_CATEGORY:
+		err = s.checker.CanReadCategory(ctx, tenantID, userID, req.GetResourceId())
+	case paperlessV1.ResourceType_RESOURCE_TYPE_DOCUMENT:
+		err = s.checker.CanReadDocument(ctx, tenantID, userID, req.GetResourceId())
+	default:
+		return paperlessV1.ErrorBadRequest("resource_type is required with resource_id")
+	}
+	if err != nil {
+		return paperlessV1.ErrorAccessDenied("no read access to resource")
+	}
+	return nil
+}
+
+func auditEventToProto(e *ent.AuditEvent) *paperlessV1.AuditEvent {
+	event := &paperlessV1.AuditEvent{
+		Id:           e.ID,
+		UserId:       e.UserID,
+		Action:       paperlessV1.AuditAction(paperlessV1.AuditAction_value[string(e.Action)]),
+		ResourceType: paperlessV1.ResourceType(paperlessV1.ResourceType_value[string(e.ResourceType)]),
+		ResourceId:   e.ResourceID,
+		ResourceName: e.ResourceName,
+		Details:      e.Details,
+	}
+	if e.TenantID != nil {
+		event.TenantId = *e.TenantID
+	}
+	if e.CreateTime != nil {
+		event.CreateTime = timestamppb.New(*e.CreateTime)
+	}
+	return event
+}
+
+// recordAudit records an action of the calling user. Failures are logged by the repo and do
+// not fail the audited request.
+func recordAudit(ctx context.Context, repo *data.AuditEventRepo, action auditevent.Action, resourceType auditevent.ResourceType, resourceID, resourceName string, details map[string]string) {
+	_ = repo.Create(ctx, &data.AuditEventInput{
+		TenantID:     getTenantIDFromContext(ctx),
+		UserID:       getUserIDFromContext(ctx),
+		Action:       action,
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		ResourceName: resourceName,
+		Details:      details,
+	})
+}
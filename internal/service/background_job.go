@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"runtime/debug"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	appViewer "github.com/go-tangra/go-tangra-common/viewer"
+)
+
+// backgroundJob runs a worker's tick every interval as an app server. Workers embed it, so its
+// Start and Stop implement transport.Server for them; one with no positive interval never runs.
+//
+// Background runs have no caller, so ticks run as the system for ent privacy. They see the
+// rows of every tenant and must scope what they read and write themselves.
+type backgroundJob struct {
+	interval time.Duration
+	tick     func(ctx context.Context)
+
+	// immediate runs a tick on start instead of waiting for the first interval
+	immediate bool
+	// wake, if set, lets trigger run a tick before the interval is up
+	wake chan struct{}
+
+	// log reports ticks that panic and announces the job on start as "<name> every <interval>",
+	// unless name is empty
+	log  *log.Helper
+	name string
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// Start implements transport.Server
+func (j *backgroundJob) Start(ctx context.Context) error {
+	if j.interval <= 0 {
+		return nil
+	}
+
+	runCtx, cancel := context.WithCancel(appViewer.NewSystemViewerContext(context.Background()))
+	j.cancel = cancel
+	j.done = make(chan struct{})
+
+	if j.name != "" {
+		j.log.Infof("%s every %s", j.name, j.interval)
+	}
+
+	go func() {
+		defer close(j.done)
+
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		if j.immediate {
+			j.runTick(runCtx)
+		}
+
+		for {
+			select {
+			case <-runCtx.Done():
+				return
+			case <-j.wake:
+			case <-ticker.C:
+			}
+			j.runTick(runCtx)
+		}
+	}()
+
+	return nil
+}
+
+// runTick runs one tick. A panicking tick is logged rather than ending the job, so the next
+// interval tries again.
+func (j *backgroundJob) runTick(ctx context.Context) {
+	defer func() {
+		if v := recover(); v != nil {
+			j.log.Errorf("tick panicked: %v\n%s", v, debug.Stack())
+		}
+	}()
+	j.tick(ctx)
+}
+
+// Stop implements transport.Server
+func (j *backgroundJob) Stop(ctx context.Context) error {
+	if j.cancel == nil {
+		return nil
+	}
+	j.cancel()
+
+	select {
+	case <-j.done:
+	case <-ctx.Done():
+	}
+	return nil
+}
+
+// trigger runs a tick now rather than at the next interval. Triggers arriving while one is
+// pending are merged into it.
+func (j *backgroundJob) trigger() {
+	select {
+	case j.wake <- struct{}{}:
+	default:
+	}
+}
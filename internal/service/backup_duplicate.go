@@ -0,0 +1,391 @@
+package service
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+	"github.com/go-tangra/go-tangra-paperless/internal/data"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/category"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/document"
+)
+
+// maxRestoredNameAttempts bounds the search for a free name for a duplicated entity
+const maxRestoredNameAttempts = 100
+
+// backupDuplicator restores a backup under freshly generated IDs, so it can sit next to
+// the data it was taken from. References between restored entities follow the new IDs.
+type backupDuplicator struct {
+	s        *BackupService
+	client   *ent.Client
+	tenantID uint32
+	full     bool
+	progress *importProgress
+
+	// ids maps the backup ID of each restored category and document to its new ID
+	ids map[string]string
+	// paths holds the path of each restored category by new ID
+	paths map[string]string
+	// filed holds the documents whose file is in the archive and is uploaded with the files
+	filed map[string]bool
+}
+
+// importDuplicate restores every section of a backup under new IDs
+func (s *BackupService) importDuplicate(ctx context.Context, client *ent.Client, backup *backupData, entries map[string]*zip.File, tenantID uint32, progress *importProgress) ([]*paperlessV1.EntityImportResult, []string) {
+	d := &backupDuplicator{
+		s:        s,
+		client:   client,
+		tenantID: tenantID,
+		full:     backup.FullBackup,
+		progress: progress,
+		ids:      make(map[string]string),
+		paths:    make(map[string]string),
+		filed:    make(map[string]bool, len(backup.Files)),
+	}
+	for _, f := range backup.Files {
+		d.filed[f.DocumentID] = true
+	}
+
+	var (
+		results  []*paperlessV1.EntityImportResult
+		warnings []string
+	)
+	sections := []struct {
+		items []json.RawMessage
+		fn    func(ctx context.Context, items []json.RawMessage) (*paperlessV1.EntityImportResult, []string)
+	}{
+		{backup.Data.Categories, d.importCategories},
+		{backup.Data.Documents, d.importDocuments},
+		{backup.Data.DocumentPermissions, d.importDocumentPermissions},
+	}
+	for _, sec := range sections {
+		if len(sec.items) == 0 {
+			continue
+		}
+		result, w := sec.fn(ctx, sec.items)
+		results = append(results, result)
+		warnings = append(warnings, w...)
+		progress.finish(result, w)
+	}
+
+	if len(backup.Files) > 0 {
+		result, w := d.importFiles(ctx, backup.Files, entries)
+		results = append(results, result)
+		warnings = append(warnings, w...)
+		progress.finish(result, w)
+	}
+
+	return results, warnings
+}
+
+// targetTenant returns the tenant an entity is restored into
+func (d *backupDuplicator) targetTenant(tenantID *uint32) uint32 {
+	if d.full && tenantID != nil {
+		return *tenantID
+	}
+	return d.tenantID
+}
+
+// restoredNames yields name, then "name (restored)", "name (restored 2)", and so on
+func restoredNames(name string, yield func(candidate string) (bool, error)) (string, error) {
+	for i := 0; i < maxRestoredNameAttempts; i++ {
+		candidate := name
+		switch {
+		case i == 1:
+			candidate = name + " (restored)"
+		case i > 1:
+			candidate = fmt.Sprintf("%s (restored %d)", name, i)
+		}
+		free, err := yield(candidate)
+		if err != nil {
+			return "", err
+		}
+		if free {
+			return candidate, nil
+		}
+	}
+	return "", fmt.Errorf("no free name for %q", name)
+}
+
+func (d *backupDuplicator) importCategories(ctx context.Context, items []json.RawMessage) (*paperlessV1.EntityImportResult, []string) {
+	result := &paperlessV1.EntityImportResult{EntityType: "categories", Total: int64(len(items))}
+	var warnings []string
+
+	var entities []*ent.Category
+	for _, raw := range items {
+		var e ent.Category
+		if err := json.Unmarshal(raw, &e); err != nil {
+			warnings = append(warnings, fmt.Sprintf("categories: unmarshal error: %v", err))
+			result.Failed++
+			continue
+		}
+		entities = append(entities, &e)
+	}
+
+	sorted := topologicalSortByParentID(entities,
+		func(e *ent.Category) string { return e.ID },
+		func(e *ent.Category) string {
+			if e.ParentID == nil {
+				return ""
+			}
+			return *e.ParentID
+		},
+	)
+
+	for _, e := range sorted {
+		d.progress.update(result, warnings)
+
+		tid := d.targetTenant(e.TenantID)
+
+		// Children of restored categories go under the copy; others keep their existing parent
+		var parentID *string
+		parentPath := ""
+		if e.ParentID != nil {
+			if id, ok := d.ids[*e.ParentID]; ok {
+				parentID = &id
+				parentPath = d.paths[id]
+			} else {
+				parent, err := d.client.Category.Get(ctx, *e.ParentID)
+				if err != nil || parent.TenantID == nil || *parent.TenantID != tid {
+					warnings = append(warnings, fmt.Sprintf("categories: %s: parent %s does not exist", e.ID, *e.ParentID))
+					result.Failed++
+					continue
+				}
+				parentID = &parent.ID
+				parentPath = parent.Path
+			}
+		}
+
+		name, err := restoredNames(e.Name, func(candidate string) (bool, error) {
+			taken, err := d.client.Category.Query().
+				Where(category.TenantID(tid), category.Path(parentPath+"/"+candidate)).
+				Exist(ctx)
+			return !taken, err
+		})
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("categories: %s: %v", e.ID, err))
+			result.Failed++
+			continue
+		}
+		path := parentPath + "/" + name
+
+		id := d.s.ids.New()
+		_, err = d.client.Category.Create().
+			SetID(id).
+			SetTenantID(tid).
+			SetName(name).
+			SetPath(path).
+			SetDescription(e.Description).
+			SetColor(e.Color).
+			SetIcon(e.Icon).
+			SetRuleTags(e.RuleTags).
+			SetRuleDocumentType(e.RuleDocumentType).
+			SetRuleRetentionClass(e.RuleRetentionClass).
+			SetDepth(int32(strings.Count(path, "/") - 1)).
+			SetSortOrder(e.SortOrder).
+			SetNillableParentID(parentID).
+			SetNillableCreateBy(e.CreateBy).
+			SetNillableCreateTime(e.CreateTime).
+			SetNillableMaxDocuments(e.MaxDocuments).
+			SetNillableMaxBytes(e.MaxBytes).
+			Save(ctx)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("categories: create %s: %v", e.ID, err))
+			result.Failed++
+			continue
+		}
+		d.ids[e.ID] = id
+		d.paths[id] = path
+		result.Created++
+	}
+
+	return result, warnings
+}
+
+func (d *backupDuplicator) importDocuments(ctx context.Context, items []json.RawMessage) (*paperlessV1.EntityImportResult, []string) {
+	result := &paperlessV1.EntityImportResult{EntityType: "documents", Total: int64(len(items))}
+	var warnings []string
+
+	// Copies keep the metadata of the originals rather than taking their category's rules
+	ctx = data.WithoutCategoryRules(ctx)
+
+	fail := func(id, format string, args ...any) {
+		warnings = append(warnings, fmt.Sprintf("documents: %s: ", id)+fmt.Sprintf(format, args...))
+		result.Failed++
+	}
+
+	for _, raw := range items {
+		d.progress.update(result, warnings)
+
+		var e backupDocument
+		if err := json.Unmarshal(raw, &e); err != nil {
+			warnings = append(warnings, fmt.Sprintf("documents: unmarshal error: %v", err))
+			result.Failed++
+			continue
+		}
+
+		tid := d.targetTenant(e.TenantID)
+		categoryID := e.CategoryID
+		if categoryID != nil {
+			if id, ok := d.ids[*categoryID]; ok {
+				categoryID = &id
+			}
+		}
+
+		name, err := restoredNames(e.Name, func(candidate string) (bool, error) {
+			query := d.client.Document.Query().Where(document.TenantID(tid), document.Name(candidate))
+			if categoryID != nil {
+				query = query.Where(document.CategoryID(*categoryID))
+			} else {
+				query = query.Where(document.CategoryIDIsNil())
+			}
+			// Deleted documents still hold their name in the unique index
+			taken, err := query.Exist(data.WithDeleted(ctx))
+			return !taken, err
+		})
+		if err != nil {
+			fail(e.ID, "%v", err)
+			continue
+		}
+
+		id := d.s.ids.New()
+		var categoryKey string
+		if categoryID != nil {
+			categoryKey = *categoryID
+		}
+
+		// Files in the archive are uploaded under this key once the documents exist;
+		// otherwise the stored object of the original document is copied now
+		key := data.ObjectKey(tid, categoryKey, id, e.FileName)
+		copied := false
+		if !d.filed[e.ID] {
+			content, err := d.s.storage.Download(ctx, e.FileKey)
+			if err != nil {
+				fail(e.ID, "copy file: %v", err)
+				continue
+			}
+			upload, err := d.s.storage.Upload(ctx, tid, categoryKey, id, e.FileName, content, e.MimeType)
+			if err != nil {
+				fail(e.ID, "copy file: %v", err)
+				continue
+			}
+			key = upload.Key
+			copied = true
+		}
+
+		_, err = d.client.Document.Create().
+			SetID(id).
+			SetTenantID(tid).
+			SetNillableCategoryID(categoryID).
+			SetName(name).
+			SetDescription(e.Description).
+			SetFileKey(key).
+			SetFileName(e.FileName).
+			SetFileSize(e.FileSize).
+			SetMimeType(e.MimeType).
+			SetChecksum(e.Checksum).
+			SetTags(e.Tags).
+			SetDocumentType(e.DocumentType).
+			SetRetentionClass(e.RetentionClass).
+			SetStatus(e.Status).
+			SetSource(e.Source).
+			SetProcessingStatus(e.ProcessingStatus).
+			SetStorageTier(document.StorageTierSTORAGE_TIER_HOT).
+			SetNillableDueDate(e.DueDate).
+			SetNillableRemindedAt(e.RemindedAt).
+			SetNillableAssigneeID(e.AssigneeID).
+			SetNillableAssignedBy(e.AssignedBy).
+			SetNillableAssignedAt(e.AssignedAt).
+			SetNillableCreateBy(e.CreateBy).
+			SetNillableUpdateBy(e.UpdateBy).
+			SetNillableCreateTime(e.CreateTime).
+			Save(ctx)
+		if err != nil {
+			if copied {
+				_ = d.s.storage.Delete(ctx, key)
+			}
+			fail(e.ID, "create: %v", err)
+			continue
+		}
+		if content := e.content(); content != nil {
+			if err := data.SaveDocumentContent(ctx, d.client, tid, id, content); err != nil {
+				warnings = append(warnings, fmt.Sprintf("documents: %s: create content: %v", e.ID, err))
+			}
+		}
+		d.ids[e.ID] = id
+		result.Created++
+	}
+
+	return result, warnings
+}
+
+func (d *backupDuplicator) importDocumentPermissions(ctx context.Context, items []json.RawMessage) (*paperlessV1.EntityImportResult, []string) {
+	result := &paperlessV1.EntityImportResult{EntityType: "documentPermissions", Total: int64(len(items))}
+	var warnings []string
+
+	for _, raw := range items {
+		d.progress.update(result, warnings)
+
+		var e ent.DocumentPermission
+		if err := json.Unmarshal(raw, &e); err != nil {
+			warnings = append(warnings, fmt.Sprintf("documentPermissions: unmarshal error: %v", err))
+			result.Failed++
+			continue
+		}
+
+		// Permissions on resources outside the backup already apply to the originals
+		resourceID, ok := d.ids[e.ResourceID]
+		if !ok {
+			result.Skipped++
+			continue
+		}
+
+		tid := d.targetTenant(e.TenantID)
+		_, err := d.client.DocumentPermission.Create().
+			SetTenantID(tid).
+			SetResourceType(e.ResourceType).
+			SetResourceID(resourceID).
+			SetRelation(e.Relation).
+			SetSubjectType(e.SubjectType).
+			SetSubjectID(e.SubjectID).
+			SetNillableGrantedBy(e.GrantedBy).
+			SetNillableExpiresAt(e.ExpiresAt).
+			SetConditions(e.Conditions).
+			SetNillableCreateTime(e.CreateTime).
+			Save(ctx)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("documentPermissions: create %d: %v", e.ID, err))
+			result.Failed++
+			continue
+		}
+		result.Created++
+	}
+
+	return result, warnings
+}
+
+// importFiles uploads archived files for the restored copies of their documents
+func (d *backupDuplicator) importFiles(ctx context.Context, files []backupFile, entries map[string]*zip.File) (*paperlessV1.EntityImportResult, []string) {
+	var (
+		remapped []backupFile
+		warnings []string
+	)
+	for _, f := range files {
+		id, ok := d.ids[f.DocumentID]
+		if !ok {
+			warnings = append(warnings, fmt.Sprintf("files: %s: document was not restored", f.DocumentID))
+			continue
+		}
+		f.DocumentID = id
+		remapped = append(remapped, f)
+	}
+
+	result, w := d.s.importFiles(ctx, remapped, entries, d.tenantID, d.full, paperlessV1.RestoreMode_RESTORE_MODE_DUPLICATE, d.progress)
+	result.Total = int64(len(files))
+	result.Failed += int64(len(warnings))
+	return result, append(warnings, w...)
+}
@@ -0,0 +1,223 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+	"github.com/go-tangra/go-tangra-paperless/internal/data"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+)
+
+const (
+	// backupManifestName is the archive entry holding the backup JSON
+	backupManifestName = "backup.json"
+	// backupFilesDir is the archive directory holding document files, named by document ID
+	backupFilesDir = "files/"
+)
+
+// backupFile describes a document file included in a backup archive
+type backupFile struct {
+	DocumentID string `json:"documentId"`
+	TenantID   uint32 `json:"tenantId"`
+	Path       string `json:"path"`
+	Size       int64  `json:"size"`
+	Checksum   string `json:"checksum"`
+}
+
+// writeBackupArchive writes a zip with the backup manifest and the file of every exported
+// document to w. Files are fetched one at a time and written straight into the archive.
+// Files that cannot be read are left out and reported as warnings.
+func (s *BackupService) writeBackupArchive(ctx context.Context, w io.Writer, backup *backupData) ([]string, error) {
+	zw := zip.NewWriter(w)
+	var warnings []string
+
+	for _, raw := range backup.Data.Documents {
+		var doc ent.Document
+		if err := json.Unmarshal(raw, &doc); err != nil {
+			return nil, fmt.Errorf("decode document: %w", err)
+		}
+
+		content, err := s.storage.Download(ctx, doc.FileKey)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("files: %s: %v", doc.ID, err))
+			continue
+		}
+
+		path := backupFilesDir + doc.ID
+		fw, err := zw.Create(path)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := fw.Write(content); err != nil {
+			return nil, err
+		}
+
+		var tenantID uint32
+		if doc.TenantID != nil {
+			tenantID = *doc.TenantID
+		}
+		backup.Files = append(backup.Files, backupFile{
+			DocumentID: doc.ID,
+			TenantID:   tenantID,
+			Path:       path,
+			Size:       int64(len(content)),
+			Checksum:   sha256Hex(content),
+		})
+	}
+
+	manifest, err := newBackupManifest(backup)
+	if err != nil {
+		return nil, fmt.Errorf("build backup manifest: %w", err)
+	}
+	backup.Manifest = manifest
+
+	raw, err := json.Marshal(backup)
+	if err != nil {
+		return nil, fmt.Errorf("marshal backup: %w", err)
+	}
+	fw, err := zw.Create(backupManifestName)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := fw.Write(raw); err != nil {
+		return nil, err
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return warnings, nil
+}
+
+// isBackupArchive reports whether backup data is a zip archive rather than plain JSON
+func isBackupArchive(r io.ReaderAt) bool {
+	magic := make([]byte, 4)
+	if _, err := r.ReadAt(magic, 0); err != nil {
+		return false
+	}
+	return bytes.Equal(magic, []byte("PK\x03\x04"))
+}
+
+// readBackupArchive returns the manifest of a backup archive and its entries by name
+func readBackupArchive(r io.ReaderAt, size int64) ([]byte, map[string]*zip.File, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	entries := make(map[string]*zip.File, len(zr.File))
+	for _, f := range zr.File {
+		entries[f.Name] = f
+	}
+
+	manifest, ok := entries[backupManifestName]
+	if !ok {
+		return nil, nil, fmt.Errorf("archive has no %s", backupManifestName)
+	}
+	content, err := readArchiveEntry(manifest, int64(manifest.UncompressedSize64))
+	if err != nil {
+		return nil, nil, err
+	}
+	return content, entries, nil
+}
+
+// readArchiveEntry reads an entry, refusing entries larger than size
+func readArchiveEntry(f *zip.File, size int64) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	content, err := io.ReadAll(io.LimitReader(rc, size+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(content)) > size {
+		return nil, fmt.Errorf("%s is larger than declared", f.Name)
+	}
+	return content, nil
+}
+
+// importFiles uploads the files of restored documents under the restoring tenant's keys
+// and points the documents at them. In skip mode, documents whose object still exists keep it.
+func (s *BackupService) importFiles(ctx context.Context, files []backupFile, entries map[string]*zip.File, tenantID uint32, full bool, mode paperlessV1.RestoreMode, progress *importProgress) (*paperlessV1.EntityImportResult, []string) {
+	result := &paperlessV1.EntityImportResult{EntityType: "files", Total: int64(len(files))}
+	var warnings []string
+
+	fail := func(f backupFile, format string, args ...any) {
+		warnings = append(warnings, fmt.Sprintf("files: %s: ", f.DocumentID)+fmt.Sprintf(format, args...))
+		result.Failed++
+	}
+
+	for _, f := range files {
+		progress.update(result, warnings)
+
+		tid := tenantID
+		if full {
+			tid = f.TenantID
+		}
+
+		doc, err := s.documentRepo.GetByID(data.WithDeleted(ctx), f.DocumentID)
+		if err != nil || doc == nil {
+			fail(f, "document was not restored")
+			continue
+		}
+		if doc.TenantID == nil || *doc.TenantID != tid {
+			fail(f, "document belongs to another tenant")
+			continue
+		}
+
+		if mode == paperlessV1.RestoreMode_RESTORE_MODE_SKIP {
+			if exists, err := s.storage.Exists(ctx, doc.FileKey); err == nil && exists {
+				result.Skipped++
+				continue
+			}
+		}
+
+		entry, ok := entries[f.Path]
+		if !ok {
+			fail(f, "%s missing from archive", f.Path)
+			continue
+		}
+		content, err := readArchiveEntry(entry, f.Size)
+		if err != nil {
+			fail(f, "%v", err)
+			continue
+		}
+		if sha256Hex(content) != f.Checksum {
+			fail(f, "checksum mismatch")
+			continue
+		}
+
+		var categoryID string
+		if doc.CategoryID != nil {
+			categoryID = *doc.CategoryID
+		}
+		upload, err := s.storage.Upload(ctx, tid, categoryID, doc.ID, doc.FileName, content, doc.MimeType)
+		if err != nil {
+			fail(f, "upload: %v", err)
+			continue
+		}
+		if err := s.documentRepo.SetStorageTier(ctx, doc.ID, upload.Key, paperlessV1.StorageTier_STORAGE_TIER_HOT.String()); err != nil {
+			fail(f, "update document: %v", err)
+			continue
+		}
+		result.Created++
+	}
+
+	return result, warnings
+}
+
+// sha256Hex returns the hex-encoded SHA-256 of content
+func sha256Hex(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
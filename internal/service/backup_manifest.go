@@ -0,0 +1,95 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// backupManifest records the size and digest of every backup section, so truncated or
+// altered backups are rejected before anything is restored
+type backupManifest struct {
+	Counts    map[string]int64  `json:"counts"`
+	Checksums map[string]string `json:"checksums"`
+	Digest    string            `json:"digest"`
+}
+
+// backupSection is a named list of serialized records in a backup
+type backupSection struct {
+	name  string
+	items []json.RawMessage
+}
+
+// backupSections returns the sections of a backup in digest order
+func backupSections(backup *backupData) ([]backupSection, error) {
+	files := make([]json.RawMessage, 0, len(backup.Files))
+	for _, f := range backup.Files {
+		raw, err := json.Marshal(f)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, raw)
+	}
+
+	return []backupSection{
+		{"categories", backup.Data.Categories},
+		{"documents", backup.Data.Documents},
+		{"documentPermissions", backup.Data.DocumentPermissions},
+		{"files", files},
+	}, nil
+}
+
+// newBackupManifest computes the manifest of a backup. Each section checksum is the
+// SHA-256 of its records, one per line; the digest covers every section checksum.
+func newBackupManifest(backup *backupData) (*backupManifest, error) {
+	sections, err := backupSections(backup)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &backupManifest{
+		Counts:    make(map[string]int64, len(sections)),
+		Checksums: make(map[string]string, len(sections)),
+	}
+	digest := sha256.New()
+	for _, sec := range sections {
+		h := sha256.New()
+		for _, raw := range sec.items {
+			h.Write(raw)
+			h.Write([]byte{'\n'})
+		}
+		sum := hex.EncodeToString(h.Sum(nil))
+
+		m.Counts[sec.name] = int64(len(sec.items))
+		m.Checksums[sec.name] = sum
+		_, _ = io.WriteString(digest, sec.name+":"+sum+"\n")
+	}
+	m.Digest = hex.EncodeToString(digest.Sum(nil))
+	return m, nil
+}
+
+// verifyBackupManifest checks the backup against its manifest
+func verifyBackupManifest(backup *backupData) error {
+	if backup.Manifest == nil {
+		return fmt.Errorf("backup has no manifest")
+	}
+
+	actual, err := newBackupManifest(backup)
+	if err != nil {
+		return err
+	}
+	for name, count := range actual.Counts {
+		if backup.Manifest.Counts[name] != count {
+			return fmt.Errorf("%s: manifest lists %d records, backup has %d", name, backup.Manifest.Counts[name], count)
+		}
+		if backup.Manifest.Checksums[name] != actual.Checksums[name] {
+			return fmt.Errorf("%s: checksum mismatch", name)
+		}
+	}
+	if backup.Manifest.Digest != actual.Digest {
+		return fmt.Errorf("digest mismatch")
+	}
+	return nil
+}
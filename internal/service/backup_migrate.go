@@ -0,0 +1,72 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// backupMigration upgrades a backup from one format version to the next
+type backupMigration struct {
+	from    string
+	to      string
+	migrate func(backup *backupData) error
+}
+
+// backupMigrations is the upgrade chain, oldest first. When the export format changes,
+// bump backupVersion and append a step from the previous version.
+var backupMigrations = []backupMigration{
+	{from: "1.0", to: "1.1", migrate: migrateBackupV10},
+	{from: "1.1", to: "1.2", migrate: func(*backupData) error { return nil }}, // adds the manifest only
+}
+
+// migrateBackup upgrades backup to backupVersion by applying the chain in order
+func migrateBackup(backup *backupData) error {
+	for _, m := range backupMigrations {
+		if backup.Version == backupVersion {
+			break
+		}
+		if backup.Version != m.from {
+			continue
+		}
+		if err := m.migrate(backup); err != nil {
+			return fmt.Errorf("%s to %s: %w", m.from, m.to, err)
+		}
+		backup.Version = m.to
+	}
+
+	if backup.Version != backupVersion {
+		return fmt.Errorf("unsupported backup version %s, expected %s or older", backup.Version, backupVersion)
+	}
+	return nil
+}
+
+// migrateBackupV10 fills in the document storage tier, which 1.0 backups taken before
+// tiering existed do not carry
+func migrateBackupV10(backup *backupData) error {
+	return patchEntities(backup.Data.Documents, func(fields map[string]json.RawMessage) error {
+		if _, ok := fields["storage_tier"]; !ok {
+			fields["storage_tier"] = json.RawMessage(`"STORAGE_TIER_HOT"`)
+		}
+		return nil
+	})
+}
+
+// patchEntities rewrites each serialized entity through fn. Fields are kept as raw JSON,
+// so values fn does not touch are preserved exactly.
+func patchEntities(items []json.RawMessage, fn func(fields map[string]json.RawMessage) error) error {
+	for i, raw := range items {
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &fields); err != nil {
+			return err
+		}
+		if err := fn(fields); err != nil {
+			return err
+		}
+		patched, err := json.Marshal(fields)
+		if err != nil {
+			return err
+		}
+		items[i] = patched
+	}
+	return nil
+}
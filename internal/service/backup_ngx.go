@@ -0,0 +1,199 @@
+package service
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+)
+
+// ngxManifestName is the fixture file paperless-ngx's document_importer reads
+const ngxManifestName = "manifest.json"
+
+// ngxRecord is a Django fixture record as written by paperless-ngx's document_exporter
+type ngxRecord struct {
+	Model  string         `json:"model"`
+	PK     int            `json:"pk"`
+	Fields map[string]any `json:"fields"`
+
+	ExportedFileName string `json:"__exported_file_name__,omitempty"`
+}
+
+// writeNGXArchive writes the exported documents as a paperless-ngx export: a zip holding
+// manifest.json and one original file per document. Categories become storage paths and
+// tags become paperless-ngx tags; permissions have no paperless-ngx equivalent and are
+// left out. It returns the number of files written and the documents left out.
+func (s *BackupService) writeNGXArchive(ctx context.Context, w io.Writer, backup *backupData) (int, []string, error) {
+	zw := zip.NewWriter(w)
+	var (
+		records  []ngxRecord
+		warnings []string
+		files    int
+	)
+
+	storagePaths := make(map[string]int, len(backup.Data.Categories))
+	for _, raw := range backup.Data.Categories {
+		var c ent.Category
+		if err := json.Unmarshal(raw, &c); err != nil {
+			return 0, nil, fmt.Errorf("decode category: %w", err)
+		}
+		pk := len(storagePaths) + 1
+		storagePaths[c.ID] = pk
+		records = append(records, ngxRecord{
+			Model: "documents.storagepath",
+			PK:    pk,
+			Fields: map[string]any{
+				"name":               c.Name,
+				"path":               strings.TrimPrefix(c.Path, "/") + "/{title}",
+				"match":              "",
+				"matching_algorithm": 0,
+				"is_insensitive":     true,
+				"owner":              nil,
+			},
+		})
+	}
+
+	tags := make(map[string]int)
+	var documents []ngxRecord
+	for _, raw := range backup.Data.Documents {
+		var doc backupDocument
+		if err := json.Unmarshal(raw, &doc); err != nil {
+			return 0, nil, fmt.Errorf("decode document: %w", err)
+		}
+
+		content, err := s.storage.Download(ctx, doc.FileKey)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("documents: %s: %v", doc.ID, err))
+			continue
+		}
+		text, err := s.documentRepo.ContentText(doc.content())
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("documents: %s: %v", doc.ID, err))
+		}
+
+		pk := len(documents) + 1
+		fileName := fmt.Sprintf("%07d%s", pk, strings.ToLower(path.Ext(doc.FileName)))
+		fw, err := zw.Create(fileName)
+		if err != nil {
+			return 0, nil, err
+		}
+		if _, err := fw.Write(content); err != nil {
+			return 0, nil, err
+		}
+		files++
+
+		var tagPKs []int
+		for _, name := range ngxTagNames(doc.Tags) {
+			tagPK, ok := tags[name]
+			if !ok {
+				tagPK = len(tags) + 1
+				tags[name] = tagPK
+			}
+			tagPKs = append(tagPKs, tagPK)
+		}
+
+		var storagePath any
+		if doc.CategoryID != nil {
+			if pk, ok := storagePaths[*doc.CategoryID]; ok {
+				storagePath = pk
+			}
+		}
+
+		md5sum := md5.Sum(content)
+		documents = append(documents, ngxRecord{
+			Model: "documents.document",
+			PK:    pk,
+			Fields: map[string]any{
+				"correspondent":         nil,
+				"storage_path":          storagePath,
+				"title":                 doc.Name,
+				"content":               text,
+				"mime_type":             doc.MimeType,
+				"checksum":              hex.EncodeToString(md5sum[:]),
+				"archive_checksum":      nil,
+				"created":               ngxTime(doc.CreateTime),
+				"modified":              ngxTime(doc.UpdateTime),
+				"storage_type":          "unencrypted",
+				"filename":              fileName,
+				"archive_filename":      nil,
+				"original_filename":     doc.FileName,
+				"added":                 ngxTime(doc.CreateTime),
+				"archive_serial_number": nil,
+				"owner":                 nil,
+				"document_type":         nil,
+				"tags":                  tagPKs,
+			},
+			ExportedFileName: fileName,
+		})
+	}
+
+	// Tags are written before the documents that reference them
+	tagNames := make([]string, len(tags))
+	for name, pk := range tags {
+		tagNames[pk-1] = name
+	}
+	for i, name := range tagNames {
+		records = append(records, ngxRecord{
+			Model: "documents.tag",
+			PK:    i + 1,
+			Fields: map[string]any{
+				"name":               name,
+				"color":              "#a6cee3",
+				"match":              "",
+				"matching_algorithm": 0,
+				"is_insensitive":     true,
+				"is_inbox_tag":       false,
+				"owner":              nil,
+			},
+		})
+	}
+	records = append(records, documents...)
+
+	manifest, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return 0, nil, fmt.Errorf("marshal manifest: %w", err)
+	}
+	fw, err := zw.Create(ngxManifestName)
+	if err != nil {
+		return 0, nil, err
+	}
+	if _, err := fw.Write(manifest); err != nil {
+		return 0, nil, err
+	}
+
+	if err := zw.Close(); err != nil {
+		return 0, nil, err
+	}
+	return files, warnings, nil
+}
+
+// ngxTagNames flattens document tags to paperless-ngx tag names, "key" or "key:value"
+func ngxTagNames(tags map[string]string) []string {
+	names := make([]string, 0, len(tags))
+	for k, v := range tags {
+		if v == "" {
+			names = append(names, k)
+		} else {
+			names = append(names, k+":"+v)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ngxTime formats an optional timestamp the way Django fixtures do
+func ngxTime(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+	return t.UTC().Format("2006-01-02T15:04:05.000Z")
+}
@@ -0,0 +1,436 @@
+package service
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+	"github.com/go-tangra/go-tangra-paperless/internal/data"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/category"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/document"
+)
+
+// ngxManifestRecord is a record of a paperless-ngx manifest.json
+type ngxManifestRecord struct {
+	Model            string          `json:"model"`
+	PK               int             `json:"pk"`
+	Fields           json.RawMessage `json:"fields"`
+	ExportedFileName string          `json:"__exported_file_name__"`
+}
+
+// ngxNamed holds the fields of tags, correspondents and document types that are imported
+type ngxNamed struct {
+	Name string `json:"name"`
+}
+
+type ngxStoragePath struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+}
+
+type ngxDocument struct {
+	Title               string `json:"title"`
+	Content             string `json:"content"`
+	MimeType            string `json:"mime_type"`
+	Created             string `json:"created"`
+	Added               string `json:"added"`
+	OriginalFilename    string `json:"original_filename"`
+	StorageType         string `json:"storage_type"`
+	Tags                []int  `json:"tags"`
+	Correspondent       *int   `json:"correspondent"`
+	DocumentType        *int   `json:"document_type"`
+	StoragePath         *int   `json:"storage_path"`
+	ArchiveSerialNumber *int   `json:"archive_serial_number"`
+}
+
+// openNGXArchive reports whether r holds a paperless-ngx export: a zip with manifest.json,
+// at the root or in a single top-level directory, and no backup.json. It returns the
+// manifest entry, the directory prefix of the export and every entry by name.
+func openNGXArchive(r io.ReaderAt, size int64) (*zip.File, string, map[string]*zip.File, bool) {
+	if !isBackupArchive(r) {
+		return nil, "", nil, false
+	}
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, "", nil, false
+	}
+
+	entries := make(map[string]*zip.File, len(zr.File))
+	var manifest *zip.File
+	for _, f := range zr.File {
+		entries[f.Name] = f
+		if f.Name == backupManifestName {
+			return nil, "", nil, false
+		}
+		if f.Name == ngxManifestName || (strings.Count(f.Name, "/") == 1 && strings.HasSuffix(f.Name, "/"+ngxManifestName)) {
+			manifest = f
+		}
+	}
+	if manifest == nil {
+		return nil, "", nil, false
+	}
+	return manifest, strings.TrimSuffix(manifest.Name, ngxManifestName), entries, true
+}
+
+// ngxImporter maps a paperless-ngx export onto our entities. Storage paths become
+// categories, tags become document tags, and correspondents and document types become
+// the "correspondent" and "document_type" tags. Everything goes into the caller's tenant.
+type ngxImporter struct {
+	s            *BackupService
+	client       *ent.Client
+	tenantID     uint32
+	createdBy    *uint32
+	userID       string
+	mode         paperlessV1.RestoreMode
+	validateOnly bool
+	progress     *importProgress
+
+	tags           map[int]string
+	correspondents map[int]string
+	documentTypes  map[int]string
+	storagePaths   map[int]string
+
+	// categories caches resolved category IDs by path; planned marks paths a dry run would create
+	categories map[string]string
+	planned    map[string]bool
+	catResult  *paperlessV1.EntityImportResult
+	warnings   []string
+}
+
+// importNGX imports a paperless-ngx export
+func (s *BackupService) importNGX(ctx context.Context, manifest *zip.File, prefix string, entries map[string]*zip.File, mode paperlessV1.RestoreMode, validateOnly bool, progress *importProgress) (*paperlessV1.ImportBackupResponse, error) {
+	raw, err := readArchiveEntry(manifest, int64(manifest.UncompressedSize64))
+	if err != nil {
+		return nil, fmt.Errorf("read paperless-ngx manifest: %w", err)
+	}
+	var records []ngxManifestRecord
+	if err := json.Unmarshal(raw, &records); err != nil {
+		return nil, fmt.Errorf("invalid paperless-ngx manifest: %w", err)
+	}
+
+	tenantID := getTenantIDFromContext(ctx)
+	imp := &ngxImporter{
+		s:              s,
+		client:         s.entClient.Client(),
+		tenantID:       tenantID,
+		createdBy:      getUserIDAsUint32(ctx),
+		userID:         getUserIDFromContext(ctx),
+		mode:           mode,
+		validateOnly:   validateOnly,
+		progress:       progress,
+		tags:           make(map[int]string),
+		correspondents: make(map[int]string),
+		documentTypes:  make(map[int]string),
+		storagePaths:   make(map[int]string),
+		categories:     make(map[string]string),
+		planned:        make(map[string]bool),
+		catResult:      &paperlessV1.EntityImportResult{EntityType: "categories"},
+	}
+
+	var documents []ngxManifestRecord
+	for _, rec := range records {
+		switch rec.Model {
+		case "documents.tag", "documents.correspondent", "documents.documenttype":
+			var f ngxNamed
+			if err := json.Unmarshal(rec.Fields, &f); err != nil {
+				imp.warnings = append(imp.warnings, fmt.Sprintf("%s %d: %v", rec.Model, rec.PK, err))
+				continue
+			}
+			switch rec.Model {
+			case "documents.tag":
+				imp.tags[rec.PK] = f.Name
+			case "documents.correspondent":
+				imp.correspondents[rec.PK] = f.Name
+			default:
+				imp.documentTypes[rec.PK] = f.Name
+			}
+		case "documents.storagepath":
+			var f ngxStoragePath
+			if err := json.Unmarshal(rec.Fields, &f); err != nil {
+				imp.warnings = append(imp.warnings, fmt.Sprintf("%s %d: %v", rec.Model, rec.PK, err))
+				continue
+			}
+			imp.storagePaths[rec.PK] = f.Path
+		case "documents.document":
+			documents = append(documents, rec)
+		}
+	}
+
+	docResult := imp.importDocuments(ctx, documents, prefix, entries)
+	results := []*paperlessV1.EntityImportResult{imp.catResult, docResult}
+
+	if !validateOnly {
+		// Categories are created directly, so rebuild the access index for the tenant
+		if err := s.accessIndex.Rebuild(ctx, &tenantID); err != nil {
+			imp.warnings = append(imp.warnings, fmt.Sprintf("access index rebuild failed: %v", err))
+		}
+	}
+
+	s.log.Infof("imported paperless-ngx export: tenant=%d mode=%v validateOnly=%v documents=%d warnings=%d", tenantID, mode, validateOnly, len(documents), len(imp.warnings))
+
+	return &paperlessV1.ImportBackupResponse{
+		Success:  true,
+		Results:  results,
+		Warnings: imp.warnings,
+	}, nil
+}
+
+func (imp *ngxImporter) importDocuments(ctx context.Context, records []ngxManifestRecord, prefix string, entries map[string]*zip.File) *paperlessV1.EntityImportResult {
+	result := &paperlessV1.EntityImportResult{EntityType: "documents", Total: int64(len(records))}
+	var warnings []string
+
+	fail := func(pk int, format string, args ...any) {
+		warnings = append(warnings, fmt.Sprintf("documents: %d: ", pk)+fmt.Sprintf(format, args...))
+		result.Failed++
+	}
+
+	for _, rec := range records {
+		imp.progress.update(result, warnings)
+
+		var f ngxDocument
+		if err := json.Unmarshal(rec.Fields, &f); err != nil {
+			fail(rec.PK, "%v", err)
+			continue
+		}
+		if f.StorageType == "gpg" {
+			fail(rec.PK, "GPG-encrypted documents are not supported; decrypt them in paperless-ngx first")
+			continue
+		}
+
+		entry, ok := entries[prefix+rec.ExportedFileName]
+		if rec.ExportedFileName == "" || !ok {
+			fail(rec.PK, "file %q missing from archive", rec.ExportedFileName)
+			continue
+		}
+		content, err := readArchiveEntry(entry, int64(entry.UncompressedSize64))
+		if err != nil {
+			fail(rec.PK, "%v", err)
+			continue
+		}
+		checksum := sha256Hex(content)
+
+		if imp.mode == paperlessV1.RestoreMode_RESTORE_MODE_SKIP {
+			exists, err := imp.client.Document.Query().
+				Where(document.TenantID(imp.tenantID), document.Checksum(checksum)).
+				Exist(ctx)
+			if err == nil && exists {
+				result.Skipped++
+				continue
+			}
+		}
+
+		categoryID, err := imp.category(ctx, f.StoragePath)
+		if err != nil {
+			fail(rec.PK, "category: %v", err)
+			continue
+		}
+
+		title := f.Title
+		if title == "" {
+			title = f.OriginalFilename
+		}
+		fileName := f.OriginalFilename
+		if fileName == "" {
+			fileName = rec.ExportedFileName[strings.LastIndex(rec.ExportedFileName, "/")+1:]
+		}
+		mimeType := f.MimeType
+		if mimeType == "" {
+			mimeType = http.DetectContentType(content)
+		}
+
+		if imp.validateOnly {
+			result.Created++
+			continue
+		}
+
+		name, err := restoredNames(title, func(candidate string) (bool, error) {
+			query := imp.client.Document.Query().Where(document.TenantID(imp.tenantID), document.Name(candidate))
+			if categoryID != nil {
+				query = query.Where(document.CategoryID(*categoryID))
+			} else {
+				query = query.Where(document.CategoryIDIsNil())
+			}
+			// Deleted documents still hold their name in the unique index
+			taken, err := query.Exist(data.WithDeleted(ctx))
+			return !taken, err
+		})
+		if err != nil {
+			fail(rec.PK, "%v", err)
+			continue
+		}
+
+		var categoryKey string
+		if categoryID != nil {
+			categoryKey = *categoryID
+		}
+		documentID := imp.s.ids.New()
+		upload, err := imp.s.storage.Upload(ctx, imp.tenantID, categoryKey, documentID, fileName, content, mimeType)
+		if err != nil {
+			fail(rec.PK, "upload: %v", err)
+			continue
+		}
+
+		doc, err := imp.s.documentRepo.Create(ctx, documentID, imp.tenantID, categoryID, name, "",
+			upload.Key, fileName, upload.Size, mimeType, upload.Checksum,
+			imp.documentTags(f), "DOCUMENT_SOURCE_UPLOAD", imp.createdBy)
+		if err != nil {
+			_ = imp.s.storage.Delete(ctx, upload.Key)
+			fail(rec.PK, "create: %v", err)
+			continue
+		}
+
+		if imp.createdBy != nil {
+			_, err := imp.client.DocumentPermission.Create().
+				SetTenantID(imp.tenantID).
+				SetResourceType("RESOURCE_TYPE_DOCUMENT").
+				SetResourceID(doc.ID).
+				SetRelation("RELATION_OWNER").
+				SetSubjectType("SUBJECT_TYPE_USER").
+				SetSubjectID(imp.userID).
+				SetGrantedBy(*imp.createdBy).
+				Save(ctx)
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("documents: %d: grant owner permission: %v", rec.PK, err))
+			}
+		}
+
+		// paperless-ngx has already extracted the text, so the document needs no processing
+		metadata := map[string]string{"paperless_ngx_id": strconv.Itoa(rec.PK)}
+		if f.Created != "" {
+			metadata["created"] = f.Created
+		}
+		if f.Added != "" {
+			metadata["added"] = f.Added
+		}
+		if f.ArchiveSerialNumber != nil {
+			metadata["archive_serial_number"] = strconv.Itoa(*f.ArchiveSerialNumber)
+		}
+		// Text over the stored limit is archived like extracted text; if that fails it is stored in full
+		var textKey string
+		if imp.s.documentRepo.ContentTextOverLimit(f.Content) {
+			if textKey, err = data.ArchiveContentText(ctx, imp.s.storage, doc, f.Content); err != nil {
+				warnings = append(warnings, fmt.Sprintf("documents: %d: archive content: %v", rec.PK, err))
+			}
+		}
+		if err := imp.s.documentRepo.UpdateProcessingResult(ctx, doc.ID, f.Content, textKey, metadata, "PROCESSING_STATUS_COMPLETED"); err != nil {
+			warnings = append(warnings, fmt.Sprintf("documents: %d: store content: %v", rec.PK, err))
+		}
+
+		result.Created++
+	}
+
+	imp.progress.finish(result, warnings)
+	imp.warnings = append(imp.warnings, warnings...)
+	return result
+}
+
+// documentTags maps the tags, correspondent and document type of a document to tags
+func (imp *ngxImporter) documentTags(f ngxDocument) map[string]string {
+	tags := make(map[string]string, len(f.Tags)+2)
+	for _, pk := range f.Tags {
+		name, ok := imp.tags[pk]
+		if !ok {
+			continue
+		}
+		if key, value, found := strings.Cut(name, ":"); found {
+			tags[key] = value
+		} else {
+			tags[name] = ""
+		}
+	}
+	if f.Correspondent != nil {
+		if name, ok := imp.correspondents[*f.Correspondent]; ok {
+			tags["correspondent"] = name
+		}
+	}
+	if f.DocumentType != nil {
+		if name, ok := imp.documentTypes[*f.DocumentType]; ok {
+			tags["document_type"] = name
+		}
+	}
+	return tags
+}
+
+// category resolves the category for a storage path, creating missing categories.
+// The literal directories of the path template become the category hierarchy.
+func (imp *ngxImporter) category(ctx context.Context, storagePath *int) (*string, error) {
+	if storagePath == nil {
+		return nil, nil
+	}
+	template, ok := imp.storagePaths[*storagePath]
+	if !ok {
+		return nil, nil
+	}
+
+	segments := strings.Split(template, "/")
+	var (
+		parentID *string
+		path     string
+	)
+	for _, seg := range segments[:len(segments)-1] {
+		seg = strings.TrimSpace(seg)
+		if seg == "" || strings.Contains(seg, "{") {
+			continue
+		}
+		path += "/" + seg
+
+		if id, ok := imp.categories[path]; ok {
+			parentID = &id
+			continue
+		}
+		if imp.planned[path] {
+			parentID = nil
+			continue
+		}
+
+		existing, err := imp.client.Category.Query().
+			Where(category.TenantID(imp.tenantID), category.Path(path)).
+			Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, err
+		}
+		if existing != nil {
+			imp.categories[path] = existing.ID
+			imp.catResult.Total++
+			imp.catResult.Skipped++
+			parentID = &existing.ID
+			continue
+		}
+
+		imp.catResult.Total++
+		if imp.validateOnly {
+			imp.planned[path] = true
+			imp.catResult.Created++
+			continue
+		}
+
+		id := imp.s.ids.New()
+		_, err = imp.client.Category.Create().
+			SetID(id).
+			SetTenantID(imp.tenantID).
+			SetName(seg).
+			SetPath(path).
+			SetDepth(int32(strings.Count(path, "/") - 1)).
+			SetNillableParentID(parentID).
+			SetNillableCreateBy(imp.createdBy).
+			SetCreateTime(time.Now()).
+			Save(ctx)
+		if err != nil {
+			imp.catResult.Failed++
+			return nil, err
+		}
+		imp.catResult.Created++
+		imp.categories[path] = id
+		parentID = &id
+	}
+
+	return parentID, nil
+}
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"bytes"
+	"time"
+
+	"google.golang.org/grpc"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// importProgressInterval is the minimum time between progress updates for one entity type
+const importProgressInterval = 500 * time.Millisecond
+
+// importProgress streams running import counts. Updates are throttled, and each carries
+// the warnings raised since the previous one. A nil *importProgress does nothing.
+type importProgress struct {
+	send func(*paperlessV1.ImportBackupProgress) error
+	err  error
+
+	entityType string
+	lastSent   time.Time
+	sentWarn   int
+}
+
+func newImportProgress(send func(*paperlessV1.ImportBackupProgress) error) *importProgress {
+	return &importProgress{send: send}
+}
+
+// update reports the counts so far unless an update was sent recently
+func (p *importProgress) update(result *paperlessV1.EntityImportResult, warnings []string) {
+	if p == nil {
+		return
+	}
+	if result.GetEntityType() == p.entityType && time.Since(p.lastSent) < importProgressInterval {
+		return
+	}
+	p.emit(result, warnings)
+}
+
+// finish reports the final counts of an entity type
+func (p *importProgress) finish(result *paperlessV1.EntityImportResult, warnings []string) {
+	if p == nil || result == nil {
+		return
+	}
+	p.emit(result, warnings)
+}
+
+func (p *importProgress) emit(result *paperlessV1.EntityImportResult, warnings []string) {
+	if p.err != nil {
+		return
+	}
+	if result.GetEntityType() != p.entityType {
+		p.entityType = result.GetEntityType()
+		p.sentWarn = 0
+	}
+
+	p.err = p.send(&paperlessV1.ImportBackupProgress{
+		Event:    &paperlessV1.ImportBackupProgress_Progress{Progress: result},
+		Warnings: warnings[p.sentWarn:],
+	})
+	p.sentWarn = len(warnings)
+	p.lastSent = time.Now()
+}
+
+// ImportBackupWithProgress imports like ImportBackup while streaming per-entity-type
+// progress and warnings, and sends the result as the last message.
+func (s *BackupService) ImportBackupWithProgress(req *paperlessV1.ImportBackupRequest, stream grpc.ServerStreamingServer[paperlessV1.ImportBackupProgress]) error {
+	ctx := stream.Context()
+	progress := newImportProgress(stream.Send)
+
+	data := req.GetData()
+	resp, err := s.importBackup(ctx, bytes.NewReader(data), int64(len(data)), req.GetMode(), req.GetValidateOnly(), progress)
+	if err != nil {
+		return err
+	}
+	if progress.err != nil {
+		return progress.err
+	}
+
+	return stream.Send(&paperlessV1.ImportBackupProgress{
+		Event: &paperlessV1.ImportBackupProgress_Result{Result: resp},
+	})
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/category"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/document"
+)
+
+// backupScope limits an export to some entity types and, optionally, one category subtree
+type backupScope struct {
+	types map[paperlessV1.BackupEntityType]bool
+
+	// subtree is set when the export is limited to a category subtree
+	subtree     bool
+	categoryIDs []string
+	documentIDs []string
+}
+
+// includes reports whether entities of type t are exported
+func (sc *backupScope) includes(t paperlessV1.BackupEntityType) bool {
+	return len(sc.types) == 0 || sc.types[t]
+}
+
+// resourceIDs returns the categories and documents whose permissions are exported
+func (sc *backupScope) resourceIDs() []string {
+	ids := make([]string, 0, len(sc.categoryIDs)+len(sc.documentIDs))
+	ids = append(ids, sc.categoryIDs...)
+	return append(ids, sc.documentIDs...)
+}
+
+// newBackupScope resolves the entity-type and category-subtree filters of an export request
+func (s *BackupService) newBackupScope(ctx context.Context, client *ent.Client, req *paperlessV1.ExportBackupRequest, tenantID uint32, full bool) (*backupScope, error) {
+	sc := &backupScope{types: make(map[paperlessV1.BackupEntityType]bool)}
+	for _, t := range req.GetEntityTypes() {
+		if t != paperlessV1.BackupEntityType_BACKUP_ENTITY_TYPE_UNSPECIFIED {
+			sc.types[t] = true
+		}
+	}
+
+	if req.CategoryId == nil {
+		return sc, nil
+	}
+
+	query := client.Category.Query().Where(category.ID(req.GetCategoryId()))
+	if !full {
+		query = query.Where(category.TenantID(tenantID))
+	}
+	root, err := query.Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, paperlessV1.ErrorCategoryNotFound("category not found")
+		}
+		return nil, err
+	}
+
+	descendantQuery := client.Category.Query().Where(category.PathHasPrefix(root.Path + "/"))
+	if root.TenantID != nil {
+		descendantQuery = descendantQuery.Where(category.TenantID(*root.TenantID))
+	}
+	descendants, err := descendantQuery.IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sc.subtree = true
+	sc.categoryIDs = append([]string{root.ID}, descendants...)
+
+	sc.documentIDs, err = client.Document.Query().
+		Where(document.CategoryIDIn(sc.categoryIDs...)).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return sc, nil
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+)
+
+// RestoreFromBackup restores only the given documents, or a category subtree, from a
+// backup: their categories, the permissions on them and their files
+func (s *BackupService) RestoreFromBackup(ctx context.Context, req *paperlessV1.RestoreFromBackupRequest) (*paperlessV1.ImportBackupResponse, error) {
+	if len(req.GetDocumentIds()) == 0 && req.CategoryId == nil {
+		return nil, paperlessV1.ErrorBadRequest("document_ids or category_id is required")
+	}
+
+	data := req.GetData()
+	backup, entries, err := s.readBackup(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+
+	selected, err := selectFromBackup(backup, req.GetDocumentIds(), req.CategoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.restoreBackup(ctx, selected, entries, req.GetMode(), req.GetValidateOnly(), nil)
+}
+
+// selectFromBackup returns a copy of backup holding only the requested documents and the
+// documents of the requested category subtree. The categories they are filed under come
+// along with their ancestors, so the subtree can be rebuilt where it was deleted.
+// Permissions are kept for the requested documents and subtree categories only.
+func selectFromBackup(backup *backupData, documentIDs []string, categoryID *string) (*backupData, error) {
+	categories := make(map[string]*ent.Category, len(backup.Data.Categories))
+	order := make([]string, 0, len(backup.Data.Categories))
+	for _, raw := range backup.Data.Categories {
+		var e ent.Category
+		if err := json.Unmarshal(raw, &e); err != nil {
+			return nil, fmt.Errorf("decode category: %w", err)
+		}
+		categories[e.ID] = &e
+		order = append(order, e.ID)
+	}
+
+	// Resources whose permissions are restored
+	resources := make(map[string]bool)
+	// Categories restored. Once a category is needed, so are all its ancestors.
+	needed := make(map[string]bool)
+	addWithAncestors := func(id *string) {
+		for id != nil && !needed[*id] {
+			c, ok := categories[*id]
+			if !ok {
+				return
+			}
+			needed[c.ID] = true
+			id = c.ParentID
+		}
+	}
+
+	if categoryID != nil {
+		root, ok := categories[*categoryID]
+		if !ok {
+			return nil, paperlessV1.ErrorCategoryNotFound("category %s is not in the backup", *categoryID)
+		}
+		addWithAncestors(&root.ID)
+		for id, c := range categories {
+			if id == root.ID || (sameTenant(c.TenantID, root.TenantID) && strings.HasPrefix(c.Path, root.Path+"/")) {
+				resources[id] = true
+				needed[id] = true
+			}
+		}
+	}
+
+	wanted := make(map[string]bool, len(documentIDs))
+	for _, id := range documentIDs {
+		wanted[id] = true
+	}
+
+	selected := &backupData{
+		Module:     backup.Module,
+		Version:    backup.Version,
+		ExportedAt: backup.ExportedAt,
+		TenantID:   backup.TenantID,
+		FullBackup: backup.FullBackup,
+	}
+
+	for _, raw := range backup.Data.Documents {
+		var e ent.Document
+		if err := json.Unmarshal(raw, &e); err != nil {
+			return nil, fmt.Errorf("decode document: %w", err)
+		}
+		inSubtree := e.CategoryID != nil && categoryID != nil && resources[*e.CategoryID]
+		if !wanted[e.ID] && !inSubtree {
+			continue
+		}
+		delete(wanted, e.ID)
+
+		selected.Data.Documents = append(selected.Data.Documents, raw)
+		resources[e.ID] = true
+		addWithAncestors(e.CategoryID)
+	}
+	if len(wanted) > 0 {
+		missing := make([]string, 0, len(wanted))
+		for id := range wanted {
+			missing = append(missing, id)
+		}
+		return nil, paperlessV1.ErrorDocumentNotFound("documents not in the backup: %s", strings.Join(missing, ", "))
+	}
+
+	// Keep the backup's category order; the import sorts parents first
+	for i, id := range order {
+		if needed[id] {
+			selected.Data.Categories = append(selected.Data.Categories, backup.Data.Categories[i])
+		}
+	}
+
+	for _, raw := range backup.Data.DocumentPermissions {
+		var e ent.DocumentPermission
+		if err := json.Unmarshal(raw, &e); err != nil {
+			return nil, fmt.Errorf("decode document permission: %w", err)
+		}
+		if resources[e.ResourceID] {
+			selected.Data.DocumentPermissions = append(selected.Data.DocumentPermissions, raw)
+		}
+	}
+
+	for _, f := range backup.Files {
+		if resources[f.DocumentID] {
+			selected.Files = append(selected.Files, f)
+		}
+	}
+
+	return selected, nil
+}
+
+// sameTenant reports whether two optional tenant IDs are equal
+func sameTenant(a, b *uint32) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -1,9 +1,12 @@
 package service
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -15,6 +18,8 @@ import (
 	"github.com/go-tangra/go-tangra-common/grpcx"
 
 	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+	"github.com/go-tangra/go-tangra-paperless/internal/authz"
+	"github.com/go-tangra/go-tangra-paperless/internal/data"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/category"
 	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/document"
@@ -23,30 +28,42 @@ import (
 
 const (
 	backupModule  = "paperless"
-	backupVersion = "1.0"
+	backupVersion = "1.2"
 )
 
 type BackupService struct {
 	paperlessV1.UnimplementedBackupServiceServer
 
-	log       *log.Helper
-	entClient *entCrud.EntClient[*ent.Client]
+	log          *log.Helper
+	entClient    *entCrud.EntClient[*ent.Client]
+	engine       *authz.Engine
+	accessIndex  *data.AccessIndexRepo
+	documentRepo *data.DocumentRepo
+	storage      data.Storage
+	ids          *data.IDGenerator
 }
 
-func NewBackupService(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *BackupService {
+func NewBackupService(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], engine *authz.Engine, accessIndex *data.AccessIndexRepo, documentRepo *data.DocumentRepo, storage data.Storage, ids *data.IDGenerator) *BackupService {
 	return &BackupService{
-		log:       ctx.NewLoggerHelper("paperless/service/backup"),
-		entClient: entClient,
+		log:          ctx.NewLoggerHelper("paperless/service/backup"),
+		entClient:    entClient,
+		engine:       engine,
+		accessIndex:  accessIndex,
+		documentRepo: documentRepo,
+		storage:      storage,
+		ids:          ids,
 	}
 }
 
 type backupData struct {
 	Module     string          `json:"module"`
 	Version    string          `json:"version"`
-	ExportedAt time.Time      `json:"exportedAt"`
-	TenantID   uint32         `json:"tenantId"`
-	FullBackup bool           `json:"fullBackup"`
-	Data       backupEntities `json:"data"`
+	ExportedAt time.Time       `json:"exportedAt"`
+	TenantID   uint32          `json:"tenantId"`
+	FullBackup bool            `json:"fullBackup"`
+	Data       backupEntities  `json:"data"`
+	Files      []backupFile    `json:"files,omitempty"`
+	Manifest   *backupManifest `json:"manifest,omitempty"`
 }
 
 type backupEntities struct {
@@ -55,15 +72,81 @@ type backupEntities struct {
 	DocumentPermissions []json.RawMessage `json:"documentPermissions,omitempty"`
 }
 
+// backupDocument is a document in a backup. The text and metadata extracted from its file,
+// which are stored in their own table, are kept next to its other fields.
+type backupDocument struct {
+	ent.Document
+	ContentText           string            `json:"content_text,omitempty"`
+	ContentTextCompressed []byte            `json:"content_text_compressed,omitempty"`
+	SearchTerms           string            `json:"search_terms,omitempty"`
+	ExtractedMetadata     map[string]string `json:"extracted_metadata,omitempty"`
+}
+
+// newBackupDocument returns the backup form of a document loaded with its content
+func newBackupDocument(e *ent.Document) *backupDocument {
+	d := &backupDocument{Document: *e}
+	if c := e.Edges.Content; c != nil {
+		d.ContentText = c.ContentText
+		d.ContentTextCompressed = c.ContentTextCompressed
+		d.SearchTerms = c.SearchTerms
+		d.ExtractedMetadata = c.ExtractedMetadata
+	}
+	d.Edges = ent.DocumentEdges{}
+	return d
+}
+
+// content returns the extracted text and metadata of the document, or nil if it has none
+func (d *backupDocument) content() *ent.DocumentContent {
+	if d.ContentText == "" && len(d.ContentTextCompressed) == 0 && d.SearchTerms == "" && d.ExtractedMetadata == nil {
+		return nil
+	}
+	return &ent.DocumentContent{
+		DocumentID:            d.ID,
+		ContentText:           d.ContentText,
+		ContentTextCompressed: d.ContentTextCompressed,
+		SearchTerms:           d.SearchTerms,
+		ExtractedMetadata:     d.ExtractedMetadata,
+	}
+}
+
+// backupExport describes a backup written by exportBackup
+type backupExport struct {
+	TenantID     uint32
+	ExportedAt   time.Time
+	EntityCounts map[string]int64
+	Warnings     []string
+}
+
 func (s *BackupService) ExportBackup(ctx context.Context, req *paperlessV1.ExportBackupRequest) (*paperlessV1.ExportBackupResponse, error) {
+	var buf bytes.Buffer
+	exp, err := s.exportBackup(ctx, req, &buf)
+	if err != nil {
+		return nil, err
+	}
+
+	return &paperlessV1.ExportBackupResponse{
+		Data:         buf.Bytes(),
+		Module:       backupModule,
+		Version:      backupVersion,
+		ExportedAt:   timestamppb.New(exp.ExportedAt),
+		TenantId:     exp.TenantID,
+		EntityCounts: exp.EntityCounts,
+		Warnings:     exp.Warnings,
+	}, nil
+}
+
+// exportBackup writes the backup requested by req to w
+func (s *BackupService) exportBackup(ctx context.Context, req *paperlessV1.ExportBackupRequest, w io.Writer) (*backupExport, error) {
 	tenantID := grpcx.GetTenantIDFromContext(ctx)
 	full := false
 
-	if grpcx.IsPlatformAdmin(ctx) && req.TenantId != nil && *req.TenantId == 0 {
+	// Cross-tenant exports are governed by the platform-admin bypass policy
+	canBypass := s.engine.AdminBypass(ctx, tenantID, grpcx.GetUserIDFromContext(ctx), authz.PermissionRead)
+	if canBypass && req.TenantId != nil && *req.TenantId == 0 {
 		full = true
 		tenantID = 0
 	} else if req.TenantId != nil && *req.TenantId != 0 {
-		if grpcx.IsPlatformAdmin(ctx) {
+		if canBypass {
 			tenantID = *req.TenantId
 		}
 	}
@@ -71,17 +154,30 @@ func (s *BackupService) ExportBackup(ctx context.Context, req *paperlessV1.Expor
 	client := s.entClient.Client()
 	now := time.Now()
 
-	categories, err := s.exportCategories(ctx, client, tenantID, full)
+	scope, err := s.newBackupScope(ctx, client, req, tenantID, full)
 	if err != nil {
-		return nil, fmt.Errorf("export categories: %w", err)
+		return nil, err
 	}
-	documents, err := s.exportDocuments(ctx, client, tenantID, full)
-	if err != nil {
-		return nil, fmt.Errorf("export documents: %w", err)
+
+	var categories, documents, documentPermissions []json.RawMessage
+	entityCounts := make(map[string]int64)
+	if scope.includes(paperlessV1.BackupEntityType_BACKUP_ENTITY_TYPE_CATEGORIES) {
+		if categories, err = s.exportCategories(ctx, client, tenantID, full, scope); err != nil {
+			return nil, fmt.Errorf("export categories: %w", err)
+		}
+		entityCounts["categories"] = int64(len(categories))
 	}
-	documentPermissions, err := s.exportDocumentPermissions(ctx, client, tenantID, full)
-	if err != nil {
-		return nil, fmt.Errorf("export document permissions: %w", err)
+	if scope.includes(paperlessV1.BackupEntityType_BACKUP_ENTITY_TYPE_DOCUMENTS) {
+		if documents, err = s.exportDocuments(ctx, client, tenantID, full, scope); err != nil {
+			return nil, fmt.Errorf("export documents: %w", err)
+		}
+		entityCounts["documents"] = int64(len(documents))
+	}
+	if scope.includes(paperlessV1.BackupEntityType_BACKUP_ENTITY_TYPE_DOCUMENT_PERMISSIONS) {
+		if documentPermissions, err = s.exportDocumentPermissions(ctx, client, tenantID, full, scope); err != nil {
+			return nil, fmt.Errorf("export document permissions: %w", err)
+		}
+		entityCounts["documentPermissions"] = int64(len(documentPermissions))
 	}
 
 	backup := backupData{
@@ -97,46 +193,115 @@ func (s *BackupService) ExportBackup(ctx context.Context, req *paperlessV1.Expor
 		},
 	}
 
-	data, err := json.Marshal(backup)
-	if err != nil {
-		return nil, fmt.Errorf("marshal backup: %w", err)
-	}
-
-	entityCounts := map[string]int64{
-		"categories":          int64(len(categories)),
-		"documents":           int64(len(documents)),
-		"documentPermissions": int64(len(documentPermissions)),
+	var warnings []string
+	switch {
+	case req.GetFormat() == paperlessV1.BackupFormat_BACKUP_FORMAT_PAPERLESS_NGX:
+		var files int
+		files, warnings, err = s.writeNGXArchive(ctx, w, &backup)
+		if err != nil {
+			return nil, fmt.Errorf("write paperless-ngx archive: %w", err)
+		}
+		entityCounts["files"] = int64(files)
+	case req.GetIncludeFiles():
+		warnings, err = s.writeBackupArchive(ctx, w, &backup)
+		if err != nil {
+			return nil, fmt.Errorf("write backup archive: %w", err)
+		}
+		entityCounts["files"] = int64(len(backup.Files))
+	default:
+		if backup.Manifest, err = newBackupManifest(&backup); err != nil {
+			return nil, fmt.Errorf("build backup manifest: %w", err)
+		}
+		data, err := json.Marshal(backup)
+		if err != nil {
+			return nil, fmt.Errorf("marshal backup: %w", err)
+		}
+		if _, err := w.Write(data); err != nil {
+			return nil, fmt.Errorf("write backup: %w", err)
+		}
 	}
 
 	s.log.Infof("exported backup: module=%s tenant=%d full=%v entities=%v", backupModule, tenantID, full, entityCounts)
 
-	return &paperlessV1.ExportBackupResponse{
-		Data:         data,
-		Module:       backupModule,
-		Version:      backupVersion,
-		ExportedAt:   timestamppb.New(now),
-		TenantId:     tenantID,
+	return &backupExport{
+		TenantID:     tenantID,
+		ExportedAt:   now,
 		EntityCounts: entityCounts,
+		Warnings:     warnings,
 	}, nil
 }
 
 func (s *BackupService) ImportBackup(ctx context.Context, req *paperlessV1.ImportBackupRequest) (*paperlessV1.ImportBackupResponse, error) {
-	tenantID := grpcx.GetTenantIDFromContext(ctx)
-	isPlatformAdmin := grpcx.IsPlatformAdmin(ctx)
-	mode := req.GetMode()
+	data := req.GetData()
+	return s.importBackup(ctx, bytes.NewReader(data), int64(len(data)), req.GetMode(), req.GetValidateOnly(), nil)
+}
+
+// importBackup restores the backup of the given size read from r. With validateOnly, it
+// reports what the restore would do and writes nothing. progress, if not nil, receives
+// running counts and warnings while entities are imported.
+func (s *BackupService) importBackup(ctx context.Context, r io.ReaderAt, size int64, mode paperlessV1.RestoreMode, validateOnly bool, progress *importProgress) (*paperlessV1.ImportBackupResponse, error) {
+	if manifest, prefix, entries, ok := openNGXArchive(r, size); ok {
+		return s.importNGX(ctx, manifest, prefix, entries, mode, validateOnly, progress)
+	}
+
+	backup, entries, err := s.readBackup(r, size)
+	if err != nil {
+		return nil, err
+	}
+	return s.restoreBackup(ctx, backup, entries, mode, validateOnly, progress)
+}
+
+// readBackup parses the backup of the given size read from r, verifies its manifest and
+// upgrades it to the current format. entries is nil unless the backup is an archive.
+func (s *BackupService) readBackup(r io.ReaderAt, size int64) (*backupData, map[string]*zip.File, error) {
+	var (
+		raw     []byte
+		entries map[string]*zip.File
+		err     error
+	)
+	if isBackupArchive(r) {
+		if raw, entries, err = readBackupArchive(r, size); err != nil {
+			return nil, nil, fmt.Errorf("invalid backup archive: %w", err)
+		}
+	} else if raw, err = io.ReadAll(io.NewSectionReader(r, 0, size)); err != nil {
+		return nil, nil, fmt.Errorf("read backup data: %w", err)
+	}
 
 	var backup backupData
-	if err := json.Unmarshal(req.GetData(), &backup); err != nil {
-		return nil, fmt.Errorf("invalid backup data: %w", err)
+	if err := json.Unmarshal(raw, &backup); err != nil {
+		return nil, nil, fmt.Errorf("invalid backup data: %w", err)
 	}
 
 	if backup.Module != backupModule {
-		return nil, fmt.Errorf("backup module mismatch: expected %s, got %s", backupModule, backup.Module)
+		return nil, nil, fmt.Errorf("backup module mismatch: expected %s, got %s", backupModule, backup.Module)
+	}
+	// Backups carry a manifest since 1.2; older ones are verified only if they happen to
+	// have one. Verification runs before migration, which rewrites records.
+	if backup.Manifest != nil || backup.Version == backupVersion {
+		if err := verifyBackupManifest(&backup); err != nil {
+			return nil, nil, fmt.Errorf("backup manifest verification failed: %w", err)
+		}
 	}
-	if backup.Version != backupVersion {
-		return nil, fmt.Errorf("backup version mismatch: expected %s, got %s", backupVersion, backup.Version)
+
+	// Older backups are upgraded to the current format before anything is imported
+	fromVersion := backup.Version
+	if err := migrateBackup(&backup); err != nil {
+		return nil, nil, fmt.Errorf("migrate backup: %w", err)
+	}
+	if fromVersion != backup.Version {
+		s.log.Infof("migrated backup from version %s to %s", fromVersion, backup.Version)
 	}
 
+	return &backup, entries, nil
+}
+
+// restoreBackup imports a parsed backup into the caller's tenant, or into each entity's
+// own tenant for full backups restored by a platform admin
+func (s *BackupService) restoreBackup(ctx context.Context, backup *backupData, entries map[string]*zip.File, mode paperlessV1.RestoreMode, validateOnly bool, progress *importProgress) (*paperlessV1.ImportBackupResponse, error) {
+	tenantID := grpcx.GetTenantIDFromContext(ctx)
+	// Full restores are governed by the platform-admin bypass policy
+	isPlatformAdmin := s.engine.AdminBypass(ctx, tenantID, grpcx.GetUserIDFromContext(ctx), authz.PermissionWrite)
+
 	// For full backups, only platform admins can restore
 	if backup.FullBackup && !isPlatformAdmin {
 		return nil, fmt.Errorf("only platform admins can restore full backups")
@@ -149,36 +314,68 @@ func (s *BackupService) ImportBackup(ctx context.Context, req *paperlessV1.Impor
 		tenantID = 0 // Signal for full backup restore — each entity carries its own tenant_id
 	}
 
+	if validateOnly {
+		results, warnings := s.validateBackup(ctx, backup, entries, tenantID, mode)
+		s.log.Infof("validated backup: module=%s tenant=%d mode=%v results=%d warnings=%d", backupModule, tenantID, mode, len(results), len(warnings))
+		return &paperlessV1.ImportBackupResponse{
+			Success:  true,
+			Results:  results,
+			Warnings: warnings,
+		}, nil
+	}
+
 	client := s.entClient.Client()
 	var results []*paperlessV1.EntityImportResult
 	var warnings []string
 
-	// Import in FK dependency order
-	importFuncs := []struct {
-		name string
-		fn   func(ctx context.Context, client *ent.Client, items []json.RawMessage, tenantID uint32, full bool, mode paperlessV1.RestoreMode) (*paperlessV1.EntityImportResult, []string)
-	}{
-		{"categories", s.importCategories},
-		{"documents", s.importDocuments},
-		{"documentPermissions", s.importDocumentPermissions},
-	}
+	if mode == paperlessV1.RestoreMode_RESTORE_MODE_DUPLICATE {
+		results, warnings = s.importDuplicate(ctx, client, backup, entries, tenantID, progress)
+	} else {
+		// Import in FK dependency order
+		importFuncs := []struct {
+			name string
+			fn   func(ctx context.Context, client *ent.Client, items []json.RawMessage, tenantID uint32, full bool, mode paperlessV1.RestoreMode, progress *importProgress) (*paperlessV1.EntityImportResult, []string)
+		}{
+			{"categories", s.importCategories},
+			{"documents", s.importDocuments},
+			{"documentPermissions", s.importDocumentPermissions},
+		}
 
-	dataMap := map[string][]json.RawMessage{
-		"categories":          backup.Data.Categories,
-		"documents":           backup.Data.Documents,
-		"documentPermissions": backup.Data.DocumentPermissions,
-	}
+		dataMap := map[string][]json.RawMessage{
+			"categories":          backup.Data.Categories,
+			"documents":           backup.Data.Documents,
+			"documentPermissions": backup.Data.DocumentPermissions,
+		}
 
-	for _, imp := range importFuncs {
-		items := dataMap[imp.name]
-		if len(items) == 0 {
-			continue
+		for _, imp := range importFuncs {
+			items := dataMap[imp.name]
+			if len(items) == 0 {
+				continue
+			}
+			result, w := imp.fn(ctx, client, items, tenantID, backup.FullBackup, mode, progress)
+			if result != nil {
+				results = append(results, result)
+			}
+			warnings = append(warnings, w...)
+			progress.finish(result, w)
 		}
-		result, w := imp.fn(ctx, client, items, tenantID, backup.FullBackup, mode)
-		if result != nil {
+
+		// Files go last, once their documents exist
+		if len(backup.Files) > 0 {
+			result, w := s.importFiles(ctx, backup.Files, entries, tenantID, backup.FullBackup, mode, progress)
 			results = append(results, result)
+			warnings = append(warnings, w...)
+			progress.finish(result, w)
 		}
-		warnings = append(warnings, w...)
+	}
+
+	// Imports bypass the repositories, so rebuild the access index from the restored tuples
+	var rebuildTenant *uint32
+	if !backup.FullBackup {
+		rebuildTenant = &tenantID
+	}
+	if err := s.accessIndex.Rebuild(ctx, rebuildTenant); err != nil {
+		warnings = append(warnings, fmt.Sprintf("access index rebuild failed: %v", err))
 	}
 
 	s.log.Infof("imported backup: module=%s tenant=%d mode=%v results=%d warnings=%d", backupModule, tenantID, mode, len(results), len(warnings))
@@ -236,11 +433,14 @@ func marshalEntities[T any](entities []*T) ([]json.RawMessage, error) {
 
 // --- Export helpers ---
 
-func (s *BackupService) exportCategories(ctx context.Context, client *ent.Client, tenantID uint32, full bool) ([]json.RawMessage, error) {
+func (s *BackupService) exportCategories(ctx context.Context, client *ent.Client, tenantID uint32, full bool, scope *backupScope) ([]json.RawMessage, error) {
 	query := client.Category.Query()
 	if !full {
 		query = query.Where(category.TenantID(tenantID))
 	}
+	if scope.subtree {
+		query = query.Where(category.IDIn(scope.categoryIDs...))
+	}
 	entities, err := query.All(ctx)
 	if err != nil {
 		return nil, err
@@ -248,23 +448,44 @@ func (s *BackupService) exportCategories(ctx context.Context, client *ent.Client
 	return marshalEntities(entities)
 }
 
-func (s *BackupService) exportDocuments(ctx context.Context, client *ent.Client, tenantID uint32, full bool) ([]json.RawMessage, error) {
-	query := client.Document.Query()
+func (s *BackupService) exportDocuments(ctx context.Context, client *ent.Client, tenantID uint32, full bool, scope *backupScope) ([]json.RawMessage, error) {
+	query := client.Document.Query().WithContent()
 	if !full {
 		query = query.Where(document.TenantID(tenantID))
 	}
+	if scope.subtree {
+		query = query.Where(document.IDIn(scope.documentIDs...))
+	}
 	entities, err := query.All(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return marshalEntities(entities)
+
+	documents := make([]*backupDocument, 0, len(entities))
+	for _, e := range entities {
+		d := newBackupDocument(e)
+		// Backups hold the full text, not the start the database keeps of archived text
+		if c := e.Edges.Content; c != nil && c.ContentTextKey != "" {
+			if archive, err := s.storage.Download(ctx, c.ContentTextKey); err != nil {
+				s.log.Warnf("failed to read archived text of document %s, backing up its start: %v", e.ID, err)
+			} else {
+				d.ContentText = ""
+				d.ContentTextCompressed = archive
+			}
+		}
+		documents = append(documents, d)
+	}
+	return marshalEntities(documents)
 }
 
-func (s *BackupService) exportDocumentPermissions(ctx context.Context, client *ent.Client, tenantID uint32, full bool) ([]json.RawMessage, error) {
+func (s *BackupService) exportDocumentPermissions(ctx context.Context, client *ent.Client, tenantID uint32, full bool, scope *backupScope) ([]json.RawMessage, error) {
 	query := client.DocumentPermission.Query()
 	if !full {
 		query = query.Where(documentpermission.TenantID(tenantID))
 	}
+	if scope.subtree {
+		query = query.Where(documentpermission.ResourceIDIn(scope.resourceIDs()...))
+	}
 	entities, err := query.All(ctx)
 	if err != nil {
 		return nil, err
@@ -274,7 +495,7 @@ func (s *BackupService) exportDocumentPermissions(ctx context.Context, client *e
 
 // --- Import helpers ---
 
-func (s *BackupService) importCategories(ctx context.Context, client *ent.Client, items []json.RawMessage, tenantID uint32, full bool, mode paperlessV1.RestoreMode) (*paperlessV1.EntityImportResult, []string) {
+func (s *BackupService) importCategories(ctx context.Context, client *ent.Client, items []json.RawMessage, tenantID uint32, full bool, mode paperlessV1.RestoreMode, progress *importProgress) (*paperlessV1.EntityImportResult, []string) {
 	result := &paperlessV1.EntityImportResult{EntityType: "categories", Total: int64(len(items))}
 	var warnings []string
 
@@ -301,6 +522,8 @@ func (s *BackupService) importCategories(ctx context.Context, client *ent.Client
 	)
 
 	for _, e := range sorted {
+		progress.update(result, warnings)
+
 		tid := tenantID
 		if full && e.TenantID != nil {
 			tid = *e.TenantID
@@ -317,10 +540,25 @@ func (s *BackupService) importCategories(ctx context.Context, client *ent.Client
 				SetName(e.Name).
 				SetPath(e.Path).
 				SetDescription(e.Description).
+				SetColor(e.Color).
+				SetIcon(e.Icon).
+				SetRuleTags(e.RuleTags).
+				SetRuleDocumentType(e.RuleDocumentType).
+				SetRuleRetentionClass(e.RuleRetentionClass).
 				SetDepth(e.Depth).
 				SetSortOrder(e.SortOrder).
 				SetNillableParentID(e.ParentID).
 				SetNillableCreateBy(e.CreateBy)
+			if e.MaxDocuments != nil {
+				update.SetMaxDocuments(*e.MaxDocuments)
+			} else {
+				update.ClearMaxDocuments()
+			}
+			if e.MaxBytes != nil {
+				update.SetMaxBytes(*e.MaxBytes)
+			} else {
+				update.ClearMaxBytes()
+			}
 			_, err := update.Save(ctx)
 			if err != nil {
 				warnings = append(warnings, fmt.Sprintf("categories: update %s: %v", e.ID, err))
@@ -336,11 +574,18 @@ func (s *BackupService) importCategories(ctx context.Context, client *ent.Client
 				SetName(e.Name).
 				SetPath(e.Path).
 				SetDescription(e.Description).
+				SetColor(e.Color).
+				SetIcon(e.Icon).
+				SetRuleTags(e.RuleTags).
+				SetRuleDocumentType(e.RuleDocumentType).
+				SetRuleRetentionClass(e.RuleRetentionClass).
 				SetDepth(e.Depth).
 				SetSortOrder(e.SortOrder).
 				SetNillableParentID(e.ParentID).
 				SetNillableCreateBy(e.CreateBy).
-				SetNillableCreateTime(e.CreateTime)
+				SetNillableCreateTime(e.CreateTime).
+				SetNillableMaxDocuments(e.MaxDocuments).
+				SetNillableMaxBytes(e.MaxBytes)
 			_, err := create.Save(ctx)
 			if err != nil {
 				warnings = append(warnings, fmt.Sprintf("categories: create %s: %v", e.ID, err))
@@ -354,12 +599,17 @@ func (s *BackupService) importCategories(ctx context.Context, client *ent.Client
 	return result, warnings
 }
 
-func (s *BackupService) importDocuments(ctx context.Context, client *ent.Client, items []json.RawMessage, tenantID uint32, full bool, mode paperlessV1.RestoreMode) (*paperlessV1.EntityImportResult, []string) {
+func (s *BackupService) importDocuments(ctx context.Context, client *ent.Client, items []json.RawMessage, tenantID uint32, full bool, mode paperlessV1.RestoreMode, progress *importProgress) (*paperlessV1.EntityImportResult, []string) {
 	result := &paperlessV1.EntityImportResult{EntityType: "documents", Total: int64(len(items))}
 	var warnings []string
 
+	// Restored documents keep their metadata rather than taking their category's rules
+	ctx = data.WithoutCategoryRules(ctx)
+
 	for _, raw := range items {
-		var e ent.Document
+		progress.update(result, warnings)
+
+		var e backupDocument
 		if err := json.Unmarshal(raw, &e); err != nil {
 			warnings = append(warnings, fmt.Sprintf("documents: unmarshal error: %v", err))
 			result.Failed++
@@ -387,11 +637,18 @@ func (s *BackupService) importDocuments(ctx context.Context, client *ent.Client,
 				SetMimeType(e.MimeType).
 				SetChecksum(e.Checksum).
 				SetTags(e.Tags).
+				SetDocumentType(e.DocumentType).
+				SetRetentionClass(e.RetentionClass).
 				SetStatus(e.Status).
 				SetSource(e.Source).
-				SetContentText(e.ContentText).
-				SetExtractedMetadata(e.ExtractedMetadata).
 				SetProcessingStatus(e.ProcessingStatus).
+				SetStorageTier(e.StorageTier).
+				SetNillableLastAccessedAt(e.LastAccessedAt).
+				SetNillableDueDate(e.DueDate).
+				SetNillableRemindedAt(e.RemindedAt).
+				SetNillableAssigneeID(e.AssigneeID).
+				SetNillableAssignedBy(e.AssignedBy).
+				SetNillableAssignedAt(e.AssignedAt).
 				SetNillableCreateBy(e.CreateBy).
 				SetNillableUpdateBy(e.UpdateBy).
 				Save(ctx)
@@ -400,6 +657,9 @@ func (s *BackupService) importDocuments(ctx context.Context, client *ent.Client,
 				result.Failed++
 				continue
 			}
+			if err := data.SaveDocumentContent(ctx, client, tid, e.ID, e.content()); err != nil {
+				warnings = append(warnings, fmt.Sprintf("documents: update content of %s: %v", e.ID, err))
+			}
 			result.Updated++
 		} else {
 			_, err := client.Document.Create().
@@ -414,11 +674,18 @@ func (s *BackupService) importDocuments(ctx context.Context, client *ent.Client,
 				SetMimeType(e.MimeType).
 				SetChecksum(e.Checksum).
 				SetTags(e.Tags).
+				SetDocumentType(e.DocumentType).
+				SetRetentionClass(e.RetentionClass).
 				SetStatus(e.Status).
 				SetSource(e.Source).
-				SetContentText(e.ContentText).
-				SetExtractedMetadata(e.ExtractedMetadata).
 				SetProcessingStatus(e.ProcessingStatus).
+				SetStorageTier(e.StorageTier).
+				SetNillableLastAccessedAt(e.LastAccessedAt).
+				SetNillableDueDate(e.DueDate).
+				SetNillableRemindedAt(e.RemindedAt).
+				SetNillableAssigneeID(e.AssigneeID).
+				SetNillableAssignedBy(e.AssignedBy).
+				SetNillableAssignedAt(e.AssignedAt).
 				SetNillableCreateBy(e.CreateBy).
 				SetNillableUpdateBy(e.UpdateBy).
 				SetNillableCreateTime(e.CreateTime).
@@ -428,6 +695,11 @@ func (s *BackupService) importDocuments(ctx context.Context, client *ent.Client,
 				result.Failed++
 				continue
 			}
+			if content := e.content(); content != nil {
+				if err := data.SaveDocumentContent(ctx, client, tid, e.ID, content); err != nil {
+					warnings = append(warnings, fmt.Sprintf("documents: create content of %s: %v", e.ID, err))
+				}
+			}
 			result.Created++
 		}
 	}
@@ -435,11 +707,13 @@ func (s *BackupService) importDocuments(ctx context.Context, client *ent.Client,
 	return result, warnings
 }
 
-func (s *BackupService) importDocumentPermissions(ctx context.Context, client *ent.Client, items []json.RawMessage, tenantID uint32, full bool, mode paperlessV1.RestoreMode) (*paperlessV1.EntityImportResult, []string) {
+func (s *BackupService) importDocumentPermissions(ctx context.Context, client *ent.Client, items []json.RawMessage, tenantID uint32, full bool, mode paperlessV1.RestoreMode, progress *importProgress) (*paperlessV1.EntityImportResult, []string) {
 	result := &paperlessV1.EntityImportResult{EntityType: "documentPermissions", Total: int64(len(items))}
 	var warnings []string
 
 	for _, raw := range items {
+		progress.update(result, warnings)
+
 		var e ent.DocumentPermission
 		if err := json.Unmarshal(raw, &e); err != nil {
 			warnings = append(warnings, fmt.Sprintf("documentPermissions: unmarshal error: %v", err))
@@ -466,6 +740,7 @@ func (s *BackupService) importDocumentPermissions(ctx context.Context, client *e
 				SetSubjectID(e.SubjectID).
 				SetNillableGrantedBy(e.GrantedBy).
 				SetNillableExpiresAt(e.ExpiresAt).
+				SetConditions(e.Conditions).
 				Save(ctx)
 			if err != nil {
 				warnings = append(warnings, fmt.Sprintf("documentPermissions: update %d: %v", e.ID, err))
@@ -483,6 +758,7 @@ func (s *BackupService) importDocumentPermissions(ctx context.Context, client *e
 				SetSubjectID(e.SubjectID).
 				SetNillableGrantedBy(e.GrantedBy).
 				SetNillableExpiresAt(e.ExpiresAt).
+				SetConditions(e.Conditions).
 				SetNillableCreateTime(e.CreateTime).
 				Save(ctx)
 			if err != nil {
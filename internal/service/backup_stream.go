@@ -0,0 +1,178 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"io"
+	"os"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// backupChunkSize is the payload size of each streamed backup chunk, well below the 4 MiB gRPC message limit
+const backupChunkSize = 1 << 20
+
+// backupChunkWriter frames everything written to it into backup chunks of backupChunkSize
+type backupChunkWriter struct {
+	send     func(*paperlessV1.BackupChunk) error
+	buf      []byte
+	sequence uint64
+	size     uint64
+	hash     hash.Hash
+}
+
+func newBackupChunkWriter(send func(*paperlessV1.BackupChunk) error) *backupChunkWriter {
+	return &backupChunkWriter{
+		send: send,
+		buf:  make([]byte, 0, backupChunkSize),
+		hash: sha256.New(),
+	}
+}
+
+func (w *backupChunkWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		n := copy(w.buf[len(w.buf):cap(w.buf)], p)
+		w.buf = w.buf[:len(w.buf)+n]
+		w.hash.Write(p[:n])
+		w.size += uint64(n)
+		written += n
+		p = p[n:]
+
+		if len(w.buf) == cap(w.buf) {
+			if err := w.Flush(); err != nil {
+				return written, err
+			}
+		}
+	}
+	return written, nil
+}
+
+// Flush sends any buffered data as a final, possibly short, chunk
+func (w *backupChunkWriter) Flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+	// The chunk is serialized before send returns, so the buffer can be reused
+	if err := w.send(&paperlessV1.BackupChunk{Sequence: w.sequence, Data: w.buf}); err != nil {
+		return err
+	}
+	w.sequence++
+	w.buf = w.buf[:0]
+	return nil
+}
+
+// Sum returns the hex-encoded SHA-256 of everything written
+func (w *backupChunkWriter) Sum() string {
+	return hex.EncodeToString(w.hash.Sum(nil))
+}
+
+// ExportBackupStream writes the backup as a sequence of chunks followed by a summary,
+// so backups larger than a single gRPC message can be exported.
+func (s *BackupService) ExportBackupStream(req *paperlessV1.ExportBackupRequest, stream grpc.ServerStreamingServer[paperlessV1.ExportBackupStreamResponse]) error {
+	ctx := stream.Context()
+
+	w := newBackupChunkWriter(func(chunk *paperlessV1.BackupChunk) error {
+		return stream.Send(&paperlessV1.ExportBackupStreamResponse{
+			Payload: &paperlessV1.ExportBackupStreamResponse_Chunk{Chunk: chunk},
+		})
+	})
+
+	exp, err := s.exportBackup(ctx, req, w)
+	if err != nil {
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	return stream.Send(&paperlessV1.ExportBackupStreamResponse{
+		Payload: &paperlessV1.ExportBackupStreamResponse_Summary{Summary: &paperlessV1.ExportBackupStreamSummary{
+			Module:       backupModule,
+			Version:      backupVersion,
+			ExportedAt:   timestamppb.New(exp.ExportedAt),
+			TenantId:     exp.TenantID,
+			EntityCounts: exp.EntityCounts,
+			Warnings:     exp.Warnings,
+			Size:         w.size,
+			Sha256:       w.Sum(),
+		}},
+	})
+}
+
+// ImportBackupStream restores a backup sent as a header followed by chunks. The chunks are
+// spooled to a temporary file (PAPERLESS_BACKUP_SPOOL_DIR, default the system temp directory)
+// and nothing is restored until the whole backup has arrived and its checksum matches.
+func (s *BackupService) ImportBackupStream(stream grpc.ClientStreamingServer[paperlessV1.ImportBackupStreamRequest, paperlessV1.ImportBackupResponse]) error {
+	ctx := stream.Context()
+
+	first, err := stream.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return paperlessV1.ErrorBadRequest("backup stream is empty")
+		}
+		return err
+	}
+	header := first.GetHeader()
+	if header == nil {
+		return paperlessV1.ErrorBadRequest("backup stream must start with a header")
+	}
+
+	spool, err := os.CreateTemp(os.Getenv("PAPERLESS_BACKUP_SPOOL_DIR"), "paperless-backup-*")
+	if err != nil {
+		s.log.Errorf("create backup spool file failed: %s", err.Error())
+		return paperlessV1.ErrorInternalServerError("create backup spool file failed")
+	}
+	defer func() {
+		_ = spool.Close()
+		_ = os.Remove(spool.Name())
+	}()
+
+	sum := sha256.New()
+	dst := io.MultiWriter(spool, sum)
+	var (
+		sequence uint64
+		size     int64
+	)
+	for {
+		msg, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		chunk := msg.GetChunk()
+		if chunk == nil {
+			return paperlessV1.ErrorBadRequest("backup stream has more than one header")
+		}
+		if chunk.GetSequence() != sequence {
+			return paperlessV1.ErrorBadRequest("backup chunk %d out of order, expected %d", chunk.GetSequence(), sequence)
+		}
+		sequence++
+
+		n, err := dst.Write(chunk.GetData())
+		if err != nil {
+			s.log.Errorf("write backup spool file failed: %s", err.Error())
+			return paperlessV1.ErrorInternalServerError("write backup spool file failed")
+		}
+		size += int64(n)
+	}
+
+	if header.Sha256 != nil && !strings.EqualFold(header.GetSha256(), hex.EncodeToString(sum.Sum(nil))) {
+		return paperlessV1.ErrorBadRequest("backup checksum mismatch")
+	}
+
+	resp, err := s.importBackup(ctx, spool, size, header.GetMode(), header.GetValidateOnly(), nil)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(resp)
+}
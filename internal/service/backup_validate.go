@@ -0,0 +1,262 @@
+package service
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+	"github.com/go-tangra/go-tangra-paperless/internal/data"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/documentpermission"
+)
+
+// backupValidator works out what an import would do without writing anything.
+// It tracks the IDs the backup would create so references between entities
+// in the same backup resolve the way they would during the real import.
+type backupValidator struct {
+	client   *ent.Client
+	storage  data.Storage
+	tenantID uint32
+	full     bool
+	mode     paperlessV1.RestoreMode
+
+	categories map[string]bool
+	documents  map[string]*ent.Document
+	warnings   []string
+}
+
+// validateBackup returns the would-be import results for a parsed backup
+func (s *BackupService) validateBackup(ctx context.Context, backup *backupData, entries map[string]*zip.File, tenantID uint32, mode paperlessV1.RestoreMode) ([]*paperlessV1.EntityImportResult, []string) {
+	v := &backupValidator{
+		client:     s.entClient.Client(),
+		storage:    s.storage,
+		tenantID:   tenantID,
+		full:       backup.FullBackup,
+		mode:       mode,
+		categories: make(map[string]bool),
+		documents:  make(map[string]*ent.Document),
+	}
+
+	var results []*paperlessV1.EntityImportResult
+	if len(backup.Data.Categories) > 0 {
+		results = append(results, v.validateCategories(ctx, backup.Data.Categories))
+	}
+	if len(backup.Data.Documents) > 0 {
+		results = append(results, v.validateDocuments(ctx, backup.Data.Documents))
+	}
+	if len(backup.Data.DocumentPermissions) > 0 {
+		results = append(results, v.validateDocumentPermissions(ctx, backup.Data.DocumentPermissions))
+	}
+	if len(backup.Files) > 0 {
+		results = append(results, v.validateFiles(ctx, backup.Files, entries))
+	}
+
+	return results, v.warnings
+}
+
+func (v *backupValidator) warnf(entityType, format string, args ...any) {
+	v.warnings = append(v.warnings, entityType+": "+fmt.Sprintf(format, args...))
+}
+
+// targetTenant returns the tenant an entity would be restored into
+func (v *backupValidator) targetTenant(tenantID *uint32) uint32 {
+	if v.full && tenantID != nil {
+		return *tenantID
+	}
+	return v.tenantID
+}
+
+// count records whether an entity would be created, updated or skipped. Existing rows
+// of another tenant are counted as the import would treat them but reported as conflicts.
+func (v *backupValidator) count(result *paperlessV1.EntityImportResult, id string, existingTenant *uint32, exists bool, tid uint32) {
+	// Duplicates get new IDs, so they never collide with existing rows
+	if !exists || v.mode == paperlessV1.RestoreMode_RESTORE_MODE_DUPLICATE {
+		result.Created++
+		return
+	}
+	if existingTenant != nil && *existingTenant != tid {
+		v.warnf(result.EntityType, "%s conflicts with an existing record of tenant %d", id, *existingTenant)
+	}
+	if v.mode == paperlessV1.RestoreMode_RESTORE_MODE_SKIP {
+		result.Skipped++
+	} else {
+		result.Updated++
+	}
+}
+
+func (v *backupValidator) categoryExists(ctx context.Context, id string) bool {
+	if v.categories[id] {
+		return true
+	}
+	existing, _ := v.client.Category.Get(ctx, id)
+	return existing != nil
+}
+
+func (v *backupValidator) documentExists(ctx context.Context, id string) bool {
+	if _, ok := v.documents[id]; ok {
+		return true
+	}
+	existing, _ := v.client.Document.Get(ctx, id)
+	return existing != nil
+}
+
+func (v *backupValidator) validateCategories(ctx context.Context, items []json.RawMessage) *paperlessV1.EntityImportResult {
+	result := &paperlessV1.EntityImportResult{EntityType: "categories", Total: int64(len(items))}
+
+	var entities []*ent.Category
+	for _, raw := range items {
+		var e ent.Category
+		if err := json.Unmarshal(raw, &e); err != nil {
+			v.warnf(result.EntityType, "unmarshal error: %v", err)
+			result.Failed++
+			continue
+		}
+		entities = append(entities, &e)
+		v.categories[e.ID] = true
+	}
+
+	for _, e := range entities {
+		if e.ParentID != nil && !v.categoryExists(ctx, *e.ParentID) {
+			v.warnf(result.EntityType, "%s: parent %s does not exist", e.ID, *e.ParentID)
+			result.Failed++
+			continue
+		}
+
+		existing, _ := v.client.Category.Get(ctx, e.ID)
+		var existingTenant *uint32
+		if existing != nil {
+			existingTenant = existing.TenantID
+		}
+		v.count(result, e.ID, existingTenant, existing != nil, v.targetTenant(e.TenantID))
+	}
+
+	return result
+}
+
+func (v *backupValidator) validateDocuments(ctx context.Context, items []json.RawMessage) *paperlessV1.EntityImportResult {
+	result := &paperlessV1.EntityImportResult{EntityType: "documents", Total: int64(len(items))}
+
+	for _, raw := range items {
+		var e ent.Document
+		if err := json.Unmarshal(raw, &e); err != nil {
+			v.warnf(result.EntityType, "unmarshal error: %v", err)
+			result.Failed++
+			continue
+		}
+
+		if e.CategoryID != nil && !v.categoryExists(ctx, *e.CategoryID) {
+			v.warnf(result.EntityType, "%s: category %s does not exist", e.ID, *e.CategoryID)
+			result.Failed++
+			continue
+		}
+
+		existing, _ := v.client.Document.Get(ctx, e.ID)
+		var existingTenant *uint32
+		if existing != nil {
+			existingTenant = existing.TenantID
+		}
+		tid := v.targetTenant(e.TenantID)
+		v.count(result, e.ID, existingTenant, existing != nil, tid)
+
+		e.TenantID = &tid
+		v.documents[e.ID] = &e
+	}
+
+	return result
+}
+
+func (v *backupValidator) validateDocumentPermissions(ctx context.Context, items []json.RawMessage) *paperlessV1.EntityImportResult {
+	result := &paperlessV1.EntityImportResult{EntityType: "documentPermissions", Total: int64(len(items))}
+
+	for _, raw := range items {
+		var e ent.DocumentPermission
+		if err := json.Unmarshal(raw, &e); err != nil {
+			v.warnf(result.EntityType, "unmarshal error: %v", err)
+			result.Failed++
+			continue
+		}
+
+		var resolved bool
+		switch e.ResourceType {
+		case documentpermission.ResourceTypeRESOURCE_TYPE_CATEGORY:
+			resolved = v.categoryExists(ctx, e.ResourceID)
+		case documentpermission.ResourceTypeRESOURCE_TYPE_DOCUMENT:
+			resolved = v.documentExists(ctx, e.ResourceID)
+		}
+		if !resolved {
+			v.warnf(result.EntityType, "%d: %s %s does not exist", e.ID, e.ResourceType, e.ResourceID)
+			result.Failed++
+			continue
+		}
+
+		if v.mode == paperlessV1.RestoreMode_RESTORE_MODE_DUPLICATE && !v.categories[e.ResourceID] && v.documents[e.ResourceID] == nil {
+			result.Skipped++
+			continue
+		}
+
+		existing, _ := v.client.DocumentPermission.Get(ctx, e.ID)
+		var existingTenant *uint32
+		if existing != nil {
+			existingTenant = existing.TenantID
+		}
+		v.count(result, fmt.Sprint(e.ID), existingTenant, existing != nil, v.targetTenant(e.TenantID))
+	}
+
+	return result
+}
+
+// validateFiles checks that every file is present in the archive, matches its checksum
+// and belongs to a document the import would restore into the same tenant
+func (v *backupValidator) validateFiles(ctx context.Context, files []backupFile, entries map[string]*zip.File) *paperlessV1.EntityImportResult {
+	result := &paperlessV1.EntityImportResult{EntityType: "files", Total: int64(len(files))}
+
+	for _, f := range files {
+		doc, ok := v.documents[f.DocumentID]
+		if !ok {
+			v.warnf(result.EntityType, "%s: document is not in the backup", f.DocumentID)
+			result.Failed++
+			continue
+		}
+		tid := v.tenantID
+		if v.full {
+			tid = f.TenantID
+		}
+		if *doc.TenantID != tid {
+			v.warnf(result.EntityType, "%s: document belongs to another tenant", f.DocumentID)
+			result.Failed++
+			continue
+		}
+
+		if v.mode == paperlessV1.RestoreMode_RESTORE_MODE_SKIP {
+			if existing, _ := v.client.Document.Get(ctx, f.DocumentID); existing != nil {
+				if exists, err := v.storage.Exists(ctx, existing.FileKey); err == nil && exists {
+					result.Skipped++
+					continue
+				}
+			}
+		}
+
+		entry, ok := entries[f.Path]
+		if !ok {
+			v.warnf(result.EntityType, "%s: %s missing from archive", f.DocumentID, f.Path)
+			result.Failed++
+			continue
+		}
+		content, err := readArchiveEntry(entry, f.Size)
+		if err != nil {
+			v.warnf(result.EntityType, "%s: %v", f.DocumentID, err)
+			result.Failed++
+			continue
+		}
+		if sha256Hex(content) != f.Checksum {
+			v.warnf(result.EntityType, "%s: checksum mismatch", f.DocumentID)
+			result.Failed++
+			continue
+		}
+		result.Created++
+	}
+
+	return result
+}
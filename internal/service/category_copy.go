@@ -0,0 +1,383 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	appViewer "github.com/go-tangra/go-tangra-common/viewer"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/authz"
+	"github.com/go-tangra/go-tangra-paperless/internal/data"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/auditevent"
+	"github.com/go-tangra/go-tangra-paperless/internal/errdetail"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// categoryCopyBatch is how many documents are loaded at a time while copying a category
+const categoryCopyBatch = 100
+
+// categoryCopy is the state of one CopyCategoryTree call
+type categoryCopy struct {
+	s        *CategoryService
+	req      *paperlessV1.CopyCategoryTreeRequest
+	tenantID uint32
+	userID   string
+	// createdBy owns every copy
+	createdBy *uint32
+
+	// ids maps each copied category to its copy, originals the other way round
+	ids       map[string]string
+	originals map[string]string
+	root      *ent.Category
+	copies    []*ent.Category
+	documents []*ent.Document
+	// unprocessed holds the file keys of copies whose original has not finished processing
+	unprocessed map[string]string
+	warnings    []string
+	undo        compensations
+}
+
+// CopyCategoryTree copies a category and the descendants the caller can read under a new
+// parent. With include_documents the readable documents in the subtree are copied too, each
+// with a copy of its file; with include_permissions the copies get the explicit permissions of
+// the originals. Everything is created in one transaction, so a failed copy leaves nothing
+// behind.
+func (s *CategoryService) CopyCategoryTree(ctx context.Context, req *paperlessV1.CopyCategoryTreeRequest) (*paperlessV1.CopyCategoryTreeResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	if err := s.checker.CanReadCategory(ctx, tenantID, userID, req.Id); err != nil {
+		return nil, errNoAccess("no read access to category", "read", "category", "id")
+	}
+	if req.NewParentId != nil && *req.NewParentId != "" {
+		if err := s.checker.CanWriteCategory(ctx, tenantID, userID, *req.NewParentId); err != nil {
+			return nil, errNoAccess("no write access to target parent category", "write", "category", "new_parent_id")
+		}
+	}
+
+	src, err := s.categoryRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if src == nil {
+		return nil, errCategoryNotFound("category not found", "id")
+	}
+
+	// The copy is listed from the subtree, so it can't be placed inside it
+	if req.NewParentId != nil && *req.NewParentId != "" {
+		parent, err := s.categoryRepo.GetByID(ctx, *req.NewParentId)
+		if err != nil {
+			return nil, err
+		}
+		if parent == nil {
+			return nil, errCategoryNotFound("target parent category not found", "new_parent_id")
+		}
+		if parent.ID == src.ID || strings.HasPrefix(parent.Path, src.Path+"/") {
+			return nil, errdetail.With(paperlessV1.ErrorCircularCategoryReference("cannot copy a category into its own subtree"),
+				errdetail.KeyCategoryOwnSubtree, "new_parent_id")
+		}
+	}
+
+	c := &categoryCopy{
+		s:           s,
+		req:         req,
+		tenantID:    tenantID,
+		userID:      userID,
+		createdBy:   getUserIDAsUint32(ctx),
+		ids:         make(map[string]string),
+		originals:   make(map[string]string),
+		unprocessed: make(map[string]string),
+	}
+	err = s.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := c.copyCategories(ctx, src); err != nil {
+			return err
+		}
+		if req.IncludeDocuments {
+			return c.copyDocuments(ctx, src)
+		}
+		return nil
+	})
+	if err != nil {
+		c.undo.run(ctx, s.log)
+		return nil, err
+	}
+
+	for _, copied := range c.copies {
+		recordAudit(ctx, s.auditRepo, auditevent.ActionAUDIT_ACTION_CREATE, auditevent.ResourceTypeRESOURCE_TYPE_CATEGORY, copied.ID, copied.Name, map[string]string{
+			"path":        copied.Path,
+			"copied_from": c.originals[copied.ID],
+		})
+	}
+	for _, doc := range c.documents {
+		recordAudit(ctx, s.auditRepo, auditevent.ActionAUDIT_ACTION_CREATE, auditevent.ResourceTypeRESOURCE_TYPE_DOCUMENT, doc.ID, doc.Name, map[string]string{
+			"category_id": *doc.CategoryID,
+			"file_name":   doc.FileName,
+			"copied_from": c.originals[doc.ID],
+		})
+	}
+
+	// Copies of documents that were still being processed get processed themselves
+	processCtx := trace.ContextWithSpanContext(appViewer.NewSystemViewerContext(context.Background()), trace.SpanContextFromContext(ctx))
+	for _, doc := range c.documents {
+		if fileKey, ok := c.unprocessed[doc.ID]; ok {
+			s.processor.Enqueue(processCtx, c.tenantID, doc.ID, fileKey, doc.MimeType)
+		}
+	}
+
+	s.log.Infof("copied category %s to %s: %d categories, %d documents", src.ID, c.root.ID, len(c.copies), len(c.documents))
+
+	return &paperlessV1.CopyCategoryTreeResponse{
+		Category:      s.categoryRepo.ToProto(c.root),
+		CategoryCount: uint32(len(c.copies)),
+		DocumentCount: uint32(len(c.documents)),
+		Warnings:      c.warnings,
+	}, nil
+}
+
+// copyCategories creates a copy of every readable category of the subtree, parents first. A
+// subcategory the caller can't read is left out together with everything below it.
+func (c *categoryCopy) copyCategories(ctx context.Context, src *ent.Category) error {
+	readable, err := readableSet(ctx, c.s.checker, c.tenantID, c.userID, authz.ResourceTypeCategory)
+	if err != nil {
+		return err
+	}
+	categories, err := c.s.categoryRepo.ListSubtree(ctx, src)
+	if err != nil {
+		return err
+	}
+
+	for _, cat := range categories {
+		parentID := c.req.NewParentId
+		name := cat.Name
+		if cat.ID == src.ID {
+			if c.req.Name != nil {
+				name = c.req.GetName()
+			}
+		} else {
+			if cat.ParentID == nil {
+				continue
+			}
+			parent, ok := c.ids[*cat.ParentID]
+			if !ok || (readable != nil && !readable[cat.ID]) {
+				continue
+			}
+			parentID = &parent
+		}
+
+		copied, err := c.s.categoryRepo.Create(ctx, c.tenantID, parentID, name, cat.Description, cat.Color, cat.Icon, cat.SortOrder, c.createdBy)
+		if err != nil {
+			return err
+		}
+		if len(cat.RuleTags) > 0 || cat.RuleDocumentType != "" || cat.RuleRetentionClass != "" {
+			rules := &paperlessV1.CategoryRules{
+				Tags:           cat.RuleTags,
+				DocumentType:   cat.RuleDocumentType,
+				RetentionClass: cat.RuleRetentionClass,
+			}
+			if copied, err = c.s.categoryRepo.Update(ctx, copied.ID, nil, nil, nil, nil, nil, nil, nil, rules, nil); err != nil {
+				return err
+			}
+		}
+
+		var grants []permissionGrant
+		if c.req.IncludePermissions {
+			if grants, err = c.sourceGrants(ctx, "RESOURCE_TYPE_CATEGORY", cat.ID); err != nil {
+				return err
+			}
+		} else if parentID != nil && *parentID != "" {
+			for _, d := range c.s.subcategoryPermissions.defaults {
+				grants = append(grants, permissionGrant{subcategoryGrant: d})
+			}
+		}
+		if err := c.grant(ctx, "RESOURCE_TYPE_CATEGORY", copied.ID, grants); err != nil {
+			return err
+		}
+
+		c.ids[cat.ID] = copied.ID
+		c.originals[copied.ID] = cat.ID
+		c.copies = append(c.copies, copied)
+		if cat.ID == src.ID {
+			c.root = copied
+		}
+	}
+	return nil
+}
+
+// copyDocuments copies the readable documents of the copied categories, with their files
+func (c *categoryCopy) copyDocuments(ctx context.Context, src *ent.Category) error {
+	readable, err := readableSet(ctx, c.s.checker, c.tenantID, c.userID, authz.ResourceTypeDocument)
+	if err != nil {
+		return err
+	}
+
+	after := ""
+	for {
+		documents, err := c.s.documentRepo.ListInSubtree(ctx, src, after, categoryCopyBatch)
+		if err != nil {
+			return err
+		}
+		if len(documents) == 0 {
+			return nil
+		}
+		after = documents[len(documents)-1].ID
+
+		for _, doc := range documents {
+			if doc.CategoryID == nil {
+				continue
+			}
+			categoryID, ok := c.ids[*doc.CategoryID]
+			if !ok || (readable != nil && !readable[doc.ID]) {
+				continue
+			}
+			if err := c.copyDocument(ctx, doc, categoryID); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// copyDocument copies a document and its file into categoryID. Quarantined documents and files
+// that are being restored from cold storage are skipped with a warning.
+func (c *categoryCopy) copyDocument(ctx context.Context, doc *ent.Document, categoryID string) error {
+	if string(doc.ProcessingStatus) == statusInfected {
+		c.warnings = append(c.warnings, fmt.Sprintf("%s: quarantined, not copied", doc.ID))
+		return nil
+	}
+
+	content, err := c.s.storage.Download(ctx, doc.FileKey)
+	if err != nil {
+		if errors.Is(err, data.ErrObjectRestoring) {
+			c.warnings = append(c.warnings, fmt.Sprintf("%s: being restored from cold storage, not copied", doc.ID))
+			return nil
+		}
+		c.s.log.Errorf("failed to download file of document %s: %v", doc.ID, err)
+		return storageError(err, "failed to download file")
+	}
+
+	if err := c.s.categoryRepo.CheckQuota(ctx, categoryID, nil, doc.FileSize); err != nil {
+		return err
+	}
+
+	id := c.s.ids.New()
+	upload, err := c.s.storage.Upload(ctx, c.tenantID, categoryID, id, doc.FileName, content, doc.MimeType)
+	if err != nil {
+		c.s.log.Errorf("failed to upload copy of document %s: %v", doc.ID, err)
+		return storageError(err, "failed to upload file")
+	}
+	c.undo.add("delete copied file "+upload.Key, func(ctx context.Context) error {
+		return c.s.storage.Delete(ctx, upload.Key)
+	})
+
+	copied, err := c.s.documentRepo.CreateCopy(ctx, doc, id, &categoryID, upload.Key, c.createdBy)
+	if err != nil {
+		return err
+	}
+	if err := c.copyTextArchive(ctx, doc, copied); err != nil {
+		return err
+	}
+
+	var grants []permissionGrant
+	if c.req.IncludePermissions {
+		if grants, err = c.sourceGrants(ctx, "RESOURCE_TYPE_DOCUMENT", doc.ID); err != nil {
+			return err
+		}
+	}
+	if err := c.grant(ctx, "RESOURCE_TYPE_DOCUMENT", copied.ID, grants); err != nil {
+		return err
+	}
+	if err := c.s.events.Publish(ctx, c.tenantID, copied.ID, EventDocumentCreated, newDocumentEvent(ctx, copied)); err != nil {
+		return err
+	}
+
+	switch string(doc.ProcessingStatus) {
+	case statusCompleted, statusSkipped:
+	default:
+		c.unprocessed[copied.ID] = copied.FileKey
+	}
+
+	c.originals[copied.ID] = doc.ID
+	c.documents = append(c.documents, copied)
+	return nil
+}
+
+// copyTextArchive gives the copy of a document whose text is archived its own copy of the
+// archive. If the archive can't be read, the copy keeps only the stored start of the text.
+func (c *categoryCopy) copyTextArchive(ctx context.Context, doc, copied *ent.Document) error {
+	key, err := c.s.documentRepo.GetContentTextKey(ctx, doc.ID)
+	if err != nil || key == "" {
+		return err
+	}
+
+	copyKey, err := data.CopyContentTextArchive(ctx, c.s.storage, key, copied)
+	if err != nil {
+		c.s.log.Warnf("failed to copy archived text of document %s: %v", doc.ID, err)
+		c.warnings = append(c.warnings, fmt.Sprintf("%s: archived text not copied, only its start", doc.ID))
+		return nil
+	}
+	c.undo.add("delete copied text "+copyKey, func(ctx context.Context) error {
+		return c.s.storage.Delete(ctx, copyKey)
+	})
+	return c.s.documentRepo.SetContentTextKey(ctx, copied.ID, copyKey)
+}
+
+// permissionGrant is an explicit permission given to a copy
+type permissionGrant struct {
+	subcategoryGrant
+	expiresAt  *time.Time
+	conditions *authz.Conditions
+}
+
+// sourceGrants returns the unexpired explicit permissions of the original of a copy
+func (c *categoryCopy) sourceGrants(ctx context.Context, resourceType, resourceID string) ([]permissionGrant, error) {
+	tuples, err := c.s.permRepo.ListByResource(ctx, c.tenantID, resourceType, resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	grants := make([]permissionGrant, 0, len(tuples))
+	for _, t := range tuples {
+		if t.ExpiresAt != nil && !t.ExpiresAt.After(now) {
+			continue
+		}
+		grants = append(grants, permissionGrant{
+			subcategoryGrant: subcategoryGrant{
+				relation:    string(t.Relation),
+				subjectType: string(t.SubjectType),
+				subjectID:   t.SubjectID,
+			},
+			expiresAt:  t.ExpiresAt,
+			conditions: t.Conditions,
+		})
+	}
+	return grants, nil
+}
+
+// grant makes the caller the owner of a copy and grants it the given permissions, each once.
+// Inside the transaction a failed grant fails the copy.
+func (c *categoryCopy) grant(ctx context.Context, resourceType, resourceID string, grants []permissionGrant) error {
+	seen := make(map[subcategoryGrant]bool, len(grants)+1)
+	if c.createdBy != nil {
+		owner := permissionGrant{subcategoryGrant: subcategoryGrant{relation: "RELATION_OWNER", subjectType: "SUBJECT_TYPE_USER", subjectID: c.userID}}
+		grants = append([]permissionGrant{owner}, grants...)
+	}
+
+	for _, g := range grants {
+		if seen[g.subcategoryGrant] {
+			continue
+		}
+		seen[g.subcategoryGrant] = true
+
+		if _, err := c.s.permRepo.Create(ctx, c.tenantID, resourceType, resourceID, g.relation, g.subjectType, g.subjectID, c.createdBy, g.expiresAt, g.conditions); err != nil {
+			return err
+		}
+	}
+	return nil
+}
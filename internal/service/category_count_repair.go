@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data"
+)
+
+// defaultCategoryCountRepairInterval is how often the category document counters are recounted
+const defaultCategoryCountRepairInterval = 24 * time.Hour
+
+// CategoryCountRepair recounts the document counters of every tenant's categories, repairing
+// drift left by writes that bypass ent, e.g. manual SQL. It runs as an app server every
+// PAPERLESS_CATEGORY_COUNT_REPAIR_INTERVAL (default 24h, "0" disables it).
+type CategoryCountRepair struct {
+	backgroundJob
+
+	log          *log.Helper
+	categoryRepo *data.CategoryRepo
+	tx           *data.Transaction
+}
+
+// NewCategoryCountRepair creates a CategoryCountRepair configured by PAPERLESS_CATEGORY_COUNT_REPAIR_INTERVAL
+func NewCategoryCountRepair(ctx *bootstrap.Context, categoryRepo *data.CategoryRepo, tx *data.Transaction) *CategoryCountRepair {
+	l := ctx.NewLoggerHelper("paperless/service/category_count_repair")
+
+	r := &CategoryCountRepair{
+		backgroundJob: backgroundJob{
+			interval: defaultCategoryCountRepairInterval,
+			log:      l,
+			name:     "recounting category documents",
+		},
+		log:          l,
+		categoryRepo: categoryRepo,
+		tx:           tx,
+	}
+	r.tick = r.Repair
+
+	if v := os.Getenv("PAPERLESS_CATEGORY_COUNT_REPAIR_INTERVAL"); v != "" {
+		interval, err := time.ParseDuration(v)
+		if err != nil || interval < 0 {
+			l.Warnf("invalid PAPERLESS_CATEGORY_COUNT_REPAIR_INTERVAL %q, using %s", v, defaultCategoryCountRepairInterval)
+		} else {
+			r.interval = interval
+		}
+	}
+
+	return r
+}
+
+// Repair recounts the categories of every tenant, one transaction per tenant
+func (r *CategoryCountRepair) Repair(ctx context.Context) {
+	tenantIDs, err := r.categoryRepo.CountedTenantIDs(ctx)
+	if err != nil {
+		r.log.Errorf("recount category documents failed: %s", err.Error())
+		return
+	}
+
+	for _, tenantID := range tenantIDs {
+		if ctx.Err() != nil {
+			return
+		}
+
+		var result *data.CategoryCountRepair
+		err := r.tx.InTx(ctx, func(ctx context.Context) error {
+			var err error
+			result, err = r.categoryRepo.RecountDocuments(ctx, tenantID)
+			return err
+		})
+		if err != nil {
+			r.log.Errorf("recount category documents of tenant %d failed: %s", tenantID, err.Error())
+			continue
+		}
+		if result.Fixed > 0 {
+			r.log.Warnf("repaired document counts of %d of %d categories of tenant %d", result.Fixed, result.Checked, tenantID)
+		}
+	}
+}
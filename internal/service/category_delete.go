@@ -0,0 +1,327 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+const (
+	// categoryDeleteBatch is how many categories or documents are processed per transaction
+	// by a background deletion
+	categoryDeleteBatch = 100
+
+	categoryDeletePollInterval = 5 * time.Second
+	// categoryDeleteLease outlasts a batch; a job whose worker died is resumed after it
+	categoryDeleteLease = 2 * time.Minute
+)
+
+// categoryDeletion describes what to delete and what happens to the contents
+type categoryDeletion struct {
+	TenantID   uint32
+	CategoryID string
+	Mode       paperlessV1.CategoryDeleteMode
+	// TargetID receives the contents in reassign mode; nil or empty is the root level
+	TargetID *string
+	// UserID is recorded in the history of moved documents
+	UserID string
+}
+
+// categoryDeleter carries out category deletions in batches, for DeleteCategory and for the
+// background worker
+type categoryDeleter struct {
+	log          *log.Helper
+	categoryRepo *data.CategoryRepo
+	documentRepo *data.DocumentRepo
+	permRepo     *data.PermissionRepo
+	history      *data.DocumentHistoryRepo
+	events       *data.EventPublisher
+	tx           *data.Transaction
+}
+
+// plan counts the categories and documents a deletion of c processes
+func (d *categoryDeleter) plan(ctx context.Context, c *ent.Category, mode paperlessV1.CategoryDeleteMode) (int64, error) {
+	switch mode {
+	case paperlessV1.CategoryDeleteMode_CATEGORY_DELETE_MODE_REASSIGN:
+		documents, err := d.documentRepo.CountInCategory(data.WithDeleted(ctx), c.ID)
+		if err != nil {
+			return 0, err
+		}
+		children, err := d.categoryRepo.CountSubcategories(ctx, c.ID)
+		if err != nil {
+			return 0, err
+		}
+		return int64(documents + children + 1), nil
+
+	default:
+		categories, err := d.categoryRepo.CountSubtree(ctx, c)
+		if err != nil {
+			return 0, err
+		}
+		total := int64(categories)
+		if mode == paperlessV1.CategoryDeleteMode_CATEGORY_DELETE_MODE_SUBTREE_WITH_DOCUMENTS {
+			documents, err := d.documentRepo.CountInSubtree(ctx, c)
+			if err != nil {
+				return 0, err
+			}
+			total += int64(documents)
+		}
+		return total, nil
+	}
+}
+
+// run carries out a deletion one batch per transaction, adding the work done to progress and
+// calling report after every batch. It picks up where an interrupted run stopped, since
+// processed categories and documents drop out of the batches.
+func (d *categoryDeleter) run(ctx context.Context, del *categoryDeletion, progress *data.CategoryDeleteProgress, report func(context.Context) error) error {
+	root, err := d.categoryRepo.GetByID(ctx, del.CategoryID)
+	if err != nil {
+		return err
+	}
+	if root == nil {
+		// An interrupted run may have deleted the category already
+		if progress.CategoriesDeleted > 0 {
+			return nil
+		}
+		return errCategoryNotFound("category not found", "id")
+	}
+
+	step := func(fn func(ctx context.Context) (int, error)) error {
+		for {
+			var n int
+			before := *progress
+			err := d.tx.InTx(ctx, func(ctx context.Context) error {
+				var err error
+				n, err = fn(ctx)
+				return err
+			})
+			if err != nil {
+				// The batch was rolled back, so its counts are too
+				*progress = before
+				return err
+			}
+			if n == 0 {
+				return nil
+			}
+			progress.Processed += int64(n)
+			if report != nil {
+				if err := report(ctx); err != nil {
+					return err
+				}
+			}
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+	}
+
+	switch del.Mode {
+	case paperlessV1.CategoryDeleteMode_CATEGORY_DELETE_MODE_REASSIGN:
+		if err := step(func(ctx context.Context) (int, error) {
+			return d.moveDocuments(ctx, del, root, progress)
+		}); err != nil {
+			return err
+		}
+		if err := step(func(ctx context.Context) (int, error) {
+			return d.moveSubcategories(ctx, del, root, progress)
+		}); err != nil {
+			return err
+		}
+		return step(func(ctx context.Context) (int, error) {
+			return d.deleteCategories(ctx, del, root, progress)
+		})
+
+	default:
+		if del.Mode == paperlessV1.CategoryDeleteMode_CATEGORY_DELETE_MODE_SUBTREE_WITH_DOCUMENTS {
+			if err := step(func(ctx context.Context) (int, error) {
+				return d.deleteDocuments(ctx, del, root, progress)
+			}); err != nil {
+				return err
+			}
+		}
+		return step(func(ctx context.Context) (int, error) {
+			return d.deleteCategories(ctx, del, root, progress)
+		})
+	}
+}
+
+// moveDocuments moves a batch of the category's documents, trashed ones included, to the target
+func (d *categoryDeleter) moveDocuments(ctx context.Context, del *categoryDeletion, root *ent.Category, progress *data.CategoryDeleteProgress) (int, error) {
+	ctx = data.WithDeleted(ctx)
+	documents, err := d.documentRepo.ListInCategory(ctx, root.ID, categoryDeleteBatch)
+	if err != nil {
+		return 0, err
+	}
+	for _, before := range documents {
+		after, err := d.documentRepo.Move(ctx, before.ID, del.TargetID, nil)
+		if err != nil {
+			return 0, err
+		}
+		if err := d.history.Record(ctx, del.UserID, before, after); err != nil {
+			return 0, err
+		}
+		moved := newDocumentEvent(ctx, after)
+		moved.PreviousCategoryID = root.ID
+		moved.UserID = del.UserID
+		if err := d.events.Publish(ctx, del.TenantID, after.ID, EventDocumentMoved, moved); err != nil {
+			return 0, err
+		}
+		progress.DocumentsMoved++
+	}
+	return len(documents), nil
+}
+
+// moveSubcategories moves a batch of the category's direct subcategories to the target
+func (d *categoryDeleter) moveSubcategories(ctx context.Context, del *categoryDeletion, root *ent.Category, progress *data.CategoryDeleteProgress) (int, error) {
+	children, err := d.categoryRepo.ListChildren(ctx, root.ID, categoryDeleteBatch)
+	if err != nil {
+		return 0, err
+	}
+	for _, child := range children {
+		if _, err := d.categoryRepo.Move(ctx, child.ID, del.TargetID, nil); err != nil {
+			return 0, err
+		}
+		progress.CategoriesMoved++
+	}
+	return len(children), nil
+}
+
+// deleteDocuments moves a batch of the subtree's documents to the trash
+func (d *categoryDeleter) deleteDocuments(ctx context.Context, del *categoryDeletion, root *ent.Category, progress *data.CategoryDeleteProgress) (int, error) {
+	documents, err := d.documentRepo.ListInSubtree(ctx, root, "", categoryDeleteBatch)
+	if err != nil {
+		return 0, err
+	}
+	for _, doc := range documents {
+		if err := d.documentRepo.Delete(ctx, doc.ID, false); err != nil {
+			return 0, err
+		}
+		if err := d.permRepo.DeleteByResource(ctx, del.TenantID, "RESOURCE_TYPE_DOCUMENT", doc.ID); err != nil {
+			return 0, err
+		}
+		deleted := newDocumentEvent(ctx, doc)
+		deleted.UserID = del.UserID
+		if err := d.events.Publish(ctx, del.TenantID, doc.ID, EventDocumentDeleted, deleted); err != nil {
+			return 0, err
+		}
+		progress.DocumentsDeleted++
+	}
+	return len(documents), nil
+}
+
+// deleteCategories deletes a batch of the subtree's categories, deepest first, so each one is
+// a leaf when it is deleted. Documents still in them become uncategorized.
+func (d *categoryDeleter) deleteCategories(ctx context.Context, del *categoryDeletion, root *ent.Category, progress *data.CategoryDeleteProgress) (int, error) {
+	categories, err := d.categoryRepo.ListSubtreeDeepestFirst(ctx, root, categoryDeleteBatch)
+	if err != nil {
+		return 0, err
+	}
+	for _, c := range categories {
+		if err := d.categoryRepo.Delete(ctx, c.ID, true); err != nil {
+			return 0, err
+		}
+		if err := d.permRepo.DeleteByResource(ctx, del.TenantID, "RESOURCE_TYPE_CATEGORY", c.ID); err != nil {
+			return 0, err
+		}
+		progress.CategoriesDeleted++
+	}
+	return len(categories), nil
+}
+
+// CategoryDeleteWorker carries out the queued background category deletions. Jobs are claimed
+// in the database with a lease that each finished batch extends, so several replicas can run
+// the worker and a job whose worker died is resumed by another one.
+type CategoryDeleteWorker struct {
+	backgroundJob
+
+	log     *log.Helper
+	jobs    *data.CategoryDeleteJobRepo
+	deleter *categoryDeleter
+}
+
+// NewCategoryDeleteWorker creates a CategoryDeleteWorker
+func NewCategoryDeleteWorker(
+	ctx *bootstrap.Context,
+	jobs *data.CategoryDeleteJobRepo,
+	categoryRepo *data.CategoryRepo,
+	documentRepo *data.DocumentRepo,
+	permRepo *data.PermissionRepo,
+	history *data.DocumentHistoryRepo,
+	events *data.EventPublisher,
+	tx *data.Transaction,
+) *CategoryDeleteWorker {
+	l := ctx.NewLoggerHelper("paperless/service/category_delete_worker")
+
+	w := &CategoryDeleteWorker{
+		backgroundJob: backgroundJob{interval: categoryDeletePollInterval, log: l},
+		log:           l,
+		jobs:          jobs,
+		deleter: &categoryDeleter{
+			log:          l,
+			categoryRepo: categoryRepo,
+			documentRepo: documentRepo,
+			permRepo:     permRepo,
+			history:      history,
+			events:       events,
+			tx:           tx,
+		},
+	}
+	w.tick = w.drain
+	return w
+}
+
+// drain runs the queued jobs, going on while there are any so a queue drains quickly
+func (w *CategoryDeleteWorker) drain(ctx context.Context) {
+	for ctx.Err() == nil && w.runNext(ctx) {
+	}
+}
+
+// runNext claims and runs one job, reporting whether there was one
+func (w *CategoryDeleteWorker) runNext(ctx context.Context) bool {
+	job, err := w.jobs.ClaimNext(ctx, time.Now(), categoryDeleteLease)
+	if err != nil || job == nil {
+		return false
+	}
+
+	del := &categoryDeletion{
+		CategoryID: job.CategoryID,
+		Mode:       paperlessV1.CategoryDeleteMode(paperlessV1.CategoryDeleteMode_value[string(job.Mode)]),
+		TargetID:   job.TargetCategoryID,
+	}
+	if job.TenantID != nil {
+		del.TenantID = *job.TenantID
+	}
+	if job.CreateBy != nil {
+		del.UserID = strconv.FormatUint(uint64(*job.CreateBy), 10)
+	}
+
+	progress := w.jobs.Progress(job)
+	err = w.deleter.run(ctx, del, progress, func(ctx context.Context) error {
+		return w.jobs.UpdateProgress(ctx, job.ID, progress, time.Now().Add(categoryDeleteLease))
+	})
+	if ctx.Err() != nil {
+		// Shutting down; the job is resumed once its lease runs out
+		return false
+	}
+
+	errMsg := ""
+	if err != nil {
+		errMsg = err.Error()
+		w.log.Errorf("category delete job %s failed: %s", job.ID, errMsg)
+	} else {
+		w.log.Infof("category delete job %s deleted %d categories and %d documents, moved %d categories and %d documents",
+			job.ID, progress.CategoriesDeleted, progress.DocumentsDeleted, progress.CategoriesMoved, progress.DocumentsMoved)
+	}
+	if err := w.jobs.Finish(ctx, job.ID, progress, errMsg); err != nil {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/authz"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/auditevent"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// subcategoryGrant is a permission granted on every new subcategory
+type subcategoryGrant struct {
+	relation    string
+	subjectType string
+	subjectID   string
+}
+
+// subcategoryPermissions decides which permissions a new subcategory starts with besides its
+// creator's ownership
+type subcategoryPermissions struct {
+	// copyParent copies the parent's explicit permissions unless the request says otherwise
+	copyParent bool
+	// defaults are granted on every new subcategory
+	defaults []subcategoryGrant
+}
+
+// loadSubcategoryPermissions reads PAPERLESS_SUBCATEGORY_COPY_PERMISSIONS and
+// PAPERLESS_SUBCATEGORY_DEFAULT_PERMISSIONS
+func loadSubcategoryPermissions(l *log.Helper) subcategoryPermissions {
+	var p subcategoryPermissions
+
+	if v := os.Getenv("PAPERLESS_SUBCATEGORY_COPY_PERMISSIONS"); v != "" {
+		copyParent, err := strconv.ParseBool(v)
+		if err != nil {
+			l.Warnf("invalid PAPERLESS_SUBCATEGORY_COPY_PERMISSIONS %q, parent permissions are only copied on request", v)
+		}
+		p.copyParent = copyParent
+	}
+
+	if v := os.Getenv("PAPERLESS_SUBCATEGORY_DEFAULT_PERMISSIONS"); v != "" {
+		defaults, err := parseSubcategoryGrants(v)
+		if err != nil {
+			l.Warnf("invalid PAPERLESS_SUBCATEGORY_DEFAULT_PERMISSIONS: %v, no default permissions are granted", err)
+		} else {
+			p.defaults = defaults
+		}
+	}
+
+	return p
+}
+
+// parseSubcategoryGrants parses a comma-separated list of relation:subject_type:subject_id
+// grants, e.g. "RELATION_VIEWER:SUBJECT_TYPE_TENANT:all,RELATION_EDITOR:SUBJECT_TYPE_ROLE:finance"
+func parseSubcategoryGrants(v string) ([]subcategoryGrant, error) {
+	var grants []subcategoryGrant
+	for _, entry := range strings.Split(v, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.SplitN(entry, ":", 3)
+		if len(parts) != 3 || parts[2] == "" {
+			return nil, fmt.Errorf("%q is not relation:subject_type:subject_id", entry)
+		}
+		if r, ok := paperlessV1.Relation_value[parts[0]]; !ok || r == int32(paperlessV1.Relation_RELATION_UNSPECIFIED) {
+			return nil, fmt.Errorf("unknown relation %q", parts[0])
+		}
+		if t, ok := paperlessV1.SubjectType_value[parts[1]]; !ok || t == int32(paperlessV1.SubjectType_SUBJECT_TYPE_UNSPECIFIED) {
+			return nil, fmt.Errorf("unknown subject type %q", parts[1])
+		}
+
+		grants = append(grants, subcategoryGrant{relation: parts[0], subjectType: parts[1], subjectID: parts[2]})
+	}
+	return grants, nil
+}
+
+// grantSubcategoryPermissions grants the configured default permissions on a new subcategory
+// and, with copyParent, copies the parent's unexpired explicit permissions including their
+// conditions and expiry. Grants the subcategory already has are skipped; other failures are
+// logged and don't fail the create.
+func (s *CategoryService) grantSubcategoryPermissions(ctx context.Context, tenantID uint32, parentID, categoryID string, copyParent bool, grantedBy *uint32) {
+	type grant struct {
+		subcategoryGrant
+		expiresAt  *time.Time
+		conditions *authz.Conditions
+		source     string
+	}
+
+	var grants []grant
+	if copyParent {
+		tuples, err := s.permRepo.ListByResource(ctx, tenantID, "RESOURCE_TYPE_CATEGORY", parentID)
+		if err != nil {
+			s.log.Warnf("failed to list permissions of parent category %s: %v", parentID, err)
+		}
+		now := time.Now()
+		for _, t := range tuples {
+			if t.ExpiresAt != nil && !t.ExpiresAt.After(now) {
+				continue
+			}
+			grants = append(grants, grant{
+				subcategoryGrant: subcategoryGrant{
+					relation:    string(t.Relation),
+					subjectType: string(t.SubjectType),
+					subjectID:   t.SubjectID,
+				},
+				expiresAt:  t.ExpiresAt,
+				conditions: t.Conditions,
+				source:     "parent",
+			})
+		}
+	}
+	for _, d := range s.subcategoryPermissions.defaults {
+		grants = append(grants, grant{subcategoryGrant: d, source: "default"})
+	}
+
+	for _, g := range grants {
+		if _, err := s.permRepo.Create(ctx, tenantID, "RESOURCE_TYPE_CATEGORY", categoryID, g.relation, g.subjectType, g.subjectID, grantedBy, g.expiresAt, g.conditions); err != nil {
+			if !paperlessV1.IsPermissionAlreadyExists(err) {
+				s.log.Warnf("failed to grant %s to %s %s on category %s: %v", g.relation, g.subjectType, g.subjectID, categoryID, err)
+			}
+			continue
+		}
+
+		recordAudit(ctx, s.auditRepo, auditevent.ActionAUDIT_ACTION_SHARE, auditevent.ResourceTypeRESOURCE_TYPE_CATEGORY, categoryID, "", map[string]string{
+			"relation":     g.relation,
+			"subject_type": g.subjectType,
+			"subject_id":   g.subjectID,
+			"source":       g.source,
+		})
+	}
+}
@@ -2,13 +2,17 @@ package service
 
 import (
 	"context"
+	"strconv"
+	"strings"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
-	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/go-tangra/go-tangra-paperless/internal/authz"
 	"github.com/go-tangra/go-tangra-paperless/internal/data"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/auditevent"
+	"github.com/go-tangra/go-tangra-paperless/internal/errdetail"
 
 	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
 )
@@ -18,21 +22,65 @@ type CategoryService struct {
 
 	log          *log.Helper
 	categoryRepo *data.CategoryRepo
+	documentRepo *data.DocumentRepo
 	permRepo     *data.PermissionRepo
+	auditRepo    *data.AuditEventRepo
+	jobRepo      *data.CategoryDeleteJobRepo
+	events       *data.EventPublisher
+	tx           *data.Transaction
+	storage      data.Storage
+	processor    *DocumentProcessor
+	ids          *data.IDGenerator
 	checker      *authz.Checker
+	engine       *authz.Engine
+	deleter      *categoryDeleter
+
+	subcategoryPermissions subcategoryPermissions
 }
 
 func NewCategoryService(
 	ctx *bootstrap.Context,
 	categoryRepo *data.CategoryRepo,
 	permRepo *data.PermissionRepo,
+	auditRepo *data.AuditEventRepo,
+	jobRepo *data.CategoryDeleteJobRepo,
+	documentRepo *data.DocumentRepo,
+	history *data.DocumentHistoryRepo,
+	events *data.EventPublisher,
+	tx *data.Transaction,
+	storage data.Storage,
+	processor *DocumentProcessor,
+	ids *data.IDGenerator,
 	checker *authz.Checker,
+	engine *authz.Engine,
 ) *CategoryService {
+	l := ctx.NewLoggerHelper("paperless/service/category")
+
 	return &CategoryService{
-		log:          ctx.NewLoggerHelper("paperless/service/category"),
+		log:          l,
 		categoryRepo: categoryRepo,
+		documentRepo: documentRepo,
 		permRepo:     permRepo,
+		auditRepo:    auditRepo,
+		jobRepo:      jobRepo,
+		events:       events,
+		tx:           tx,
+		storage:      storage,
+		processor:    processor,
+		ids:          ids,
 		checker:      checker,
+		engine:       engine,
+		deleter: &categoryDeleter{
+			log:          l,
+			categoryRepo: categoryRepo,
+			documentRepo: documentRepo,
+			permRepo:     permRepo,
+			history:      history,
+			events:       events,
+			tx:           tx,
+		},
+
+		subcategoryPermissions: loadSubcategoryPermissions(l),
 	}
 }
 
@@ -45,24 +93,37 @@ func (s *CategoryService) CreateCategory(ctx context.Context, req *paperlessV1.C
 	// Check write permission on parent category if creating a subcategory
 	if req.ParentId != nil && *req.ParentId != "" {
 		if err := s.checker.CanWriteCategory(ctx, tenantID, userID, *req.ParentId); err != nil {
-			return nil, paperlessV1.ErrorAccessDenied("no write access to parent category")
+			return nil, errNoAccess("no write access to parent category", "write", "category", "parent_id")
 		}
 	}
 
 	// Create category
-	category, err := s.categoryRepo.Create(ctx, tenantID, req.ParentId, req.Name, req.Description, req.SortOrder, createdBy)
+	category, err := s.categoryRepo.Create(ctx, tenantID, req.ParentId, req.Name, req.Description, req.Color, req.Icon, req.SortOrder, createdBy)
 	if err != nil {
 		return nil, err
 	}
 
 	// Grant owner permission to creator
 	if createdBy != nil {
-		_, err = s.permRepo.Create(ctx, tenantID, "RESOURCE_TYPE_CATEGORY", category.ID, "RELATION_OWNER", "SUBJECT_TYPE_USER", userID, createdBy, nil)
+		_, err = s.permRepo.Create(ctx, tenantID, "RESOURCE_TYPE_CATEGORY", category.ID, "RELATION_OWNER", "SUBJECT_TYPE_USER", userID, createdBy, nil, nil)
 		if err != nil {
 			s.log.Warnf("failed to grant owner permission: %v", err)
 		}
 	}
 
+	// Start subcategories with the configured and, if asked for, the parent's permissions
+	if req.ParentId != nil && *req.ParentId != "" {
+		copyParent := s.subcategoryPermissions.copyParent
+		if req.CopyParentPermissions != nil {
+			copyParent = req.GetCopyParentPermissions()
+		}
+		s.grantSubcategoryPermissions(ctx, tenantID, *req.ParentId, category.ID, copyParent, createdBy)
+	}
+
+	recordAudit(ctx, s.auditRepo, auditevent.ActionAUDIT_ACTION_CREATE, auditevent.ResourceTypeRESOURCE_TYPE_CATEGORY, category.ID, category.Name, map[string]string{
+		"path": category.Path,
+	})
+
 	return &paperlessV1.CreateCategoryResponse{
 		Category: s.categoryRepo.ToProto(category),
 	}, nil
@@ -75,7 +136,7 @@ func (s *CategoryService) GetCategory(ctx context.Context, req *paperlessV1.GetC
 
 	// Check read permission
 	if err := s.checker.CanReadCategory(ctx, tenantID, userID, req.Id); err != nil {
-		return nil, paperlessV1.ErrorAccessDenied("no read access to category")
+		return nil, errNoAccess("no read access to category", "read", "category", "id")
 	}
 
 	category, err := s.categoryRepo.GetByID(ctx, req.Id)
@@ -83,7 +144,7 @@ func (s *CategoryService) GetCategory(ctx context.Context, req *paperlessV1.GetC
 		return nil, err
 	}
 	if category == nil {
-		return nil, paperlessV1.ErrorCategoryNotFound("category not found")
+		return nil, errCategoryNotFound("category not found", "id")
 	}
 
 	var categoryProto *paperlessV1.Category
@@ -109,7 +170,7 @@ func (s *CategoryService) ListCategories(ctx context.Context, req *paperlessV1.L
 	// Check read permission on parent category if filtering by parent
 	if req.ParentId != nil && *req.ParentId != "" {
 		if err := s.checker.CanReadCategory(ctx, tenantID, userID, *req.ParentId); err != nil {
-			return nil, paperlessV1.ErrorAccessDenied("no read access to parent category")
+			return nil, errNoAccess("no read access to parent category", "read", "category", "parent_id")
 		}
 	}
 
@@ -122,17 +183,26 @@ func (s *CategoryService) ListCategories(ctx context.Context, req *paperlessV1.L
 		pageSize = *req.PageSize
 	}
 
-	categories, total, err := s.categoryRepo.List(ctx, tenantID, req.ParentId, req.NameFilter, page, pageSize)
+	// Restrict the query to readable categories, so pages are full and the total is accurate
+	readableIDs, err := listReadableIDs(ctx, s.checker, tenantID, userID, authz.ResourceTypeCategory)
+	if err != nil {
+		return nil, err
+	}
+
+	var sortMode string
+	if req.ParentId != nil {
+		if sortMode, err = s.childSortMode(ctx, tenantID, *req.ParentId); err != nil {
+			return nil, err
+		}
+	}
+
+	categories, total, err := s.categoryRepo.List(ctx, tenantID, readableIDs, req.ParentId, req.NameFilter, sortMode, page, pageSize)
 	if err != nil {
 		return nil, err
 	}
 
-	// Filter results by read permission
 	protoCategories := make([]*paperlessV1.Category, 0, len(categories))
 	for _, category := range categories {
-		if err := s.checker.CanReadCategory(ctx, tenantID, userID, category.ID); err != nil {
-			continue
-		}
 		protoCategories = append(protoCategories, s.categoryRepo.ToProto(category))
 	}
 
@@ -149,39 +219,196 @@ func (s *CategoryService) UpdateCategory(ctx context.Context, req *paperlessV1.U
 
 	// Check write permission
 	if err := s.checker.CanWriteCategory(ctx, tenantID, userID, req.Id); err != nil {
-		return nil, paperlessV1.ErrorAccessDenied("no write access to category")
+		return nil, errNoAccess("no write access to category", "write", "category", "id")
 	}
 
-	category, err := s.categoryRepo.Update(ctx, req.Id, req.Name, req.Description, req.SortOrder)
+	// Quotas limit what the category's writers can store, so only tenant admins change them
+	if (req.MaxDocuments != nil || req.MaxBytes != nil) && !isTenantAdmin(ctx) {
+		return nil, errTenantAdminRequired("only tenant admins can change category quotas", "change_category_quota")
+	}
+
+	category, err := s.categoryRepo.Update(ctx, req.Id, req.Name, req.Description, req.Color, req.Icon, req.SortOrder, req.MaxDocuments, req.MaxBytes, req.Rules, req.ExpectedVersion)
 	if err != nil {
 		return nil, err
 	}
 
+	details := make(map[string]string)
+	if req.Name != nil {
+		details["name"] = req.GetName()
+	}
+	if req.Description != nil {
+		details["description_changed"] = "true"
+	}
+	if req.SortOrder != nil {
+		details["sort_order"] = strconv.Itoa(int(req.GetSortOrder()))
+	}
+	if req.Color != nil {
+		details["color"] = req.GetColor()
+	}
+	if req.Icon != nil {
+		details["icon"] = req.GetIcon()
+	}
+	if req.MaxDocuments != nil {
+		details["max_documents"] = strconv.FormatInt(req.GetMaxDocuments(), 10)
+	}
+	if req.MaxBytes != nil {
+		details["max_bytes"] = strconv.FormatInt(req.GetMaxBytes(), 10)
+	}
+	if req.Rules != nil {
+		details["rules_changed"] = "true"
+	}
+	recordAudit(ctx, s.auditRepo, auditevent.ActionAUDIT_ACTION_UPDATE, auditevent.ResourceTypeRESOURCE_TYPE_CATEGORY, category.ID, category.Name, details)
+
 	return &paperlessV1.UpdateCategoryResponse{
 		Category: s.categoryRepo.ToProto(category),
 	}, nil
 }
 
-// DeleteCategory deletes a category
-func (s *CategoryService) DeleteCategory(ctx context.Context, req *paperlessV1.DeleteCategoryRequest) (*emptypb.Empty, error) {
+// DeleteCategory deletes a category. Without a mode it must be empty, unless force is set;
+// the modes delete or reassign its contents, in one transaction or as a background job.
+func (s *CategoryService) DeleteCategory(ctx context.Context, req *paperlessV1.DeleteCategoryRequest) (*paperlessV1.DeleteCategoryResponse, error) {
 	tenantID := getTenantIDFromContext(ctx)
 	userID := getUserIDFromContext(ctx)
 
 	// Check delete permission
 	if err := s.checker.CanDeleteCategory(ctx, tenantID, userID, req.Id); err != nil {
-		return nil, paperlessV1.ErrorAccessDenied("no delete access to category")
+		return nil, errNoAccess("no delete access to category", "delete", "category", "id")
 	}
 
-	if err := s.categoryRepo.Delete(ctx, req.Id, req.Force); err != nil {
+	category, err := s.categoryRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if category == nil {
+		return nil, errCategoryNotFound("category not found", "id")
+	}
+
+	mode := req.Mode
+	if mode == paperlessV1.CategoryDeleteMode_CATEGORY_DELETE_MODE_UNSPECIFIED {
+		if !req.Background {
+			return s.deleteCategory(ctx, tenantID, category, req.Force)
+		}
+		if !req.Force {
+			return nil, errdetail.With(paperlessV1.ErrorBadRequest("background deletion needs a mode or force"),
+				errdetail.KeyCategoryDeleteModeRequired, "mode")
+		}
+		mode = paperlessV1.CategoryDeleteMode_CATEGORY_DELETE_MODE_SUBTREE
+	}
+
+	var targetID *string
+	if target := req.GetTargetCategoryId(); target != "" {
+		if mode != paperlessV1.CategoryDeleteMode_CATEGORY_DELETE_MODE_REASSIGN {
+			return nil, errdetail.With(paperlessV1.ErrorBadRequest("target category is only used when reassigning"),
+				errdetail.KeyCategoryTargetWithoutReassign, "target_category_id")
+		}
+		if err := s.checker.CanWriteCategory(ctx, tenantID, userID, target); err != nil {
+			return nil, errNoAccess("no write access to target category", "write", "category", "target_category_id")
+		}
+		t, err := s.categoryRepo.GetByID(ctx, target)
+		if err != nil {
+			return nil, err
+		}
+		if t == nil {
+			return nil, errCategoryNotFound("target category not found", "target_category_id")
+		}
+		if t.ID == category.ID || strings.HasPrefix(t.Path, category.Path+"/") {
+			return nil, errdetail.With(paperlessV1.ErrorCircularCategoryReference("cannot reassign into the deleted category's subtree"),
+				errdetail.KeyCategoryOwnSubtree, "target_category_id")
+		}
+		targetID = &t.ID
+	}
+
+	details := map[string]string{
+		"path": category.Path,
+		"mode": mode.String(),
+	}
+	if targetID != nil {
+		details["target_category_id"] = *targetID
+	}
+
+	if req.Background {
+		total, err := s.deleter.plan(ctx, category, mode)
+		if err != nil {
+			return nil, err
+		}
+		job, err := s.jobRepo.Create(ctx, tenantID, category, mode.String(), targetID, total, getUserIDAsUint32(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		details["job_id"] = job.ID
+		recordAudit(ctx, s.auditRepo, auditevent.ActionAUDIT_ACTION_DELETE, auditevent.ResourceTypeRESOURCE_TYPE_CATEGORY, category.ID, category.Name, details)
+
+		return &paperlessV1.DeleteCategoryResponse{
+			Job: s.jobRepo.ToProto(job),
+		}, nil
+	}
+
+	del := &categoryDeletion{
+		TenantID:   tenantID,
+		CategoryID: category.ID,
+		Mode:       mode,
+		TargetID:   targetID,
+		UserID:     userID,
+	}
+	progress := &data.CategoryDeleteProgress{}
+	if err := s.tx.InTx(ctx, func(ctx context.Context) error {
+		return s.deleter.run(ctx, del, progress, nil)
+	}); err != nil {
+		return nil, err
+	}
+
+	details["categories_deleted"] = strconv.FormatInt(progress.CategoriesDeleted, 10)
+	details["categories_moved"] = strconv.FormatInt(progress.CategoriesMoved, 10)
+	details["documents_deleted"] = strconv.FormatInt(progress.DocumentsDeleted, 10)
+	details["documents_moved"] = strconv.FormatInt(progress.DocumentsMoved, 10)
+	recordAudit(ctx, s.auditRepo, auditevent.ActionAUDIT_ACTION_DELETE, auditevent.ResourceTypeRESOURCE_TYPE_CATEGORY, category.ID, category.Name, details)
+
+	return &paperlessV1.DeleteCategoryResponse{}, nil
+}
+
+// deleteCategory deletes an empty category, or with force its whole subtree
+func (s *CategoryService) deleteCategory(ctx context.Context, tenantID uint32, category *ent.Category, force bool) (*paperlessV1.DeleteCategoryResponse, error) {
+	if err := s.tx.InTx(ctx, func(ctx context.Context) error {
+		return s.categoryRepo.Delete(ctx, category.ID, force)
+	}); err != nil {
 		return nil, err
 	}
 
 	// Delete associated permissions
-	if err := s.permRepo.DeleteByResource(ctx, tenantID, "RESOURCE_TYPE_CATEGORY", req.Id); err != nil {
-		s.log.Warnf("failed to delete permissions for category %s: %v", req.Id, err)
+	if err := s.permRepo.DeleteByResource(ctx, tenantID, "RESOURCE_TYPE_CATEGORY", category.ID); err != nil {
+		s.log.Warnf("failed to delete permissions for category %s: %v", category.ID, err)
 	}
 
-	return &emptypb.Empty{}, nil
+	recordAudit(ctx, s.auditRepo, auditevent.ActionAUDIT_ACTION_DELETE, auditevent.ResourceTypeRESOURCE_TYPE_CATEGORY, category.ID, category.Name, map[string]string{
+		"path":  category.Path,
+		"force": strconv.FormatBool(force),
+	})
+
+	return &paperlessV1.DeleteCategoryResponse{}, nil
+}
+
+// GetCategoryDeleteJob returns a background category deletion to the user who queued it or a
+// tenant admin
+func (s *CategoryService) GetCategoryDeleteJob(ctx context.Context, req *paperlessV1.GetCategoryDeleteJobRequest) (*paperlessV1.GetCategoryDeleteJobResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+
+	job, err := s.jobRepo.Get(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if job == nil {
+		return nil, errdetail.With(paperlessV1.ErrorNotFound("category delete job not found"), errdetail.KeyCategoryDeleteJobNotFound, "id")
+	}
+
+	createdBy := getUserIDAsUint32(ctx)
+	if (job.CreateBy == nil || createdBy == nil || *job.CreateBy != *createdBy) && !isTenantAdmin(ctx) {
+		return nil, errNoAccess("no access to category delete job", "read", "category_delete_job", "id")
+	}
+
+	return &paperlessV1.GetCategoryDeleteJobResponse{
+		Job: s.jobRepo.ToProto(job),
+	}, nil
 }
 
 // MoveCategory moves a category to a new parent
@@ -191,21 +418,41 @@ func (s *CategoryService) MoveCategory(ctx context.Context, req *paperlessV1.Mov
 
 	// Check write permission on the category being moved
 	if err := s.checker.CanWriteCategory(ctx, tenantID, userID, req.Id); err != nil {
-		return nil, paperlessV1.ErrorAccessDenied("no write access to category")
+		return nil, errNoAccess("no write access to category", "write", "category", "id")
 	}
 
 	// Check write permission on the new parent category
 	if req.NewParentId != nil && *req.NewParentId != "" {
 		if err := s.checker.CanWriteCategory(ctx, tenantID, userID, *req.NewParentId); err != nil {
-			return nil, paperlessV1.ErrorAccessDenied("no write access to destination category")
+			return nil, errNoAccess("no write access to destination category", "write", "category", "new_parent_id")
 		}
 	}
 
-	category, err := s.categoryRepo.Move(ctx, req.Id, req.NewParentId)
+	existing, err := s.categoryRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, errCategoryNotFound("category not found", "id")
+	}
+
+	// The path and counter updates of the subtree and its ancestors apply together
+	var category *ent.Category
+	err = s.tx.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		category, err = s.categoryRepo.Move(ctx, req.Id, req.NewParentId, req.ExpectedVersion)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	recordAudit(ctx, s.auditRepo, auditevent.ActionAUDIT_ACTION_MOVE, auditevent.ResourceTypeRESOURCE_TYPE_CATEGORY, category.ID, category.Name, map[string]string{
+		"from_path":    existing.Path,
+		"to_path":      category.Path,
+		"to_parent_id": req.GetNewParentId(),
+	})
+
 	return &paperlessV1.MoveCategoryResponse{
 		Category: s.categoryRepo.ToProto(category),
 	}, nil
@@ -221,29 +468,198 @@ func (s *CategoryService) GetCategoryTree(ctx context.Context, req *paperlessV1.
 		maxDepth = *req.MaxDepth
 	}
 
-	roots, err := s.categoryRepo.BuildTree(ctx, tenantID, req.RootId, maxDepth, req.IncludeCounts)
+	// Unreadable categories are left out of the query together with their subtrees
+	readableIDs, err := listReadableIDs(ctx, s.checker, tenantID, userID, authz.ResourceTypeCategory)
 	if err != nil {
 		return nil, err
 	}
 
-	// Filter tree nodes by read permission
-	filteredRoots := filterTreeNodes(ctx, roots, s.checker, tenantID, userID)
+	rootSortMode, err := s.categoryRepo.RootSortMode(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	roots, err := s.categoryRepo.BuildTree(ctx, tenantID, req.RootId, maxDepth, req.IncludeCounts, readableIDs, rootSortMode)
+	if err != nil {
+		return nil, err
+	}
 
 	return &paperlessV1.GetCategoryTreeResponse{
-		Roots: filteredRoots,
+		Roots: roots,
 	}, nil
 }
 
-// filterTreeNodes recursively filters tree nodes by read permission
-func filterTreeNodes(ctx context.Context, nodes []*paperlessV1.CategoryTreeNode, checker *authz.Checker, tenantID uint32, userID string) []*paperlessV1.CategoryTreeNode {
-	filtered := make([]*paperlessV1.CategoryTreeNode, 0, len(nodes))
-	for _, node := range nodes {
-		if err := checker.CanReadCategory(ctx, tenantID, userID, node.Category.Id); err != nil {
-			continue
+// GetCategoryChildren gets a page of the readable children of a category, or of the root level
+func (s *CategoryService) GetCategoryChildren(ctx context.Context, req *paperlessV1.GetCategoryChildrenRequest) (*paperlessV1.GetCategoryChildrenResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	parentID := req.GetParentId()
+	if parentID != "" {
+		if err := s.checker.CanReadCategory(ctx, tenantID, userID, parentID); err != nil {
+			return nil, errNoAccess("no read access to parent category", "read", "category", "parent_id")
 		}
-		// Recursively filter children
-		node.Children = filterTreeNodes(ctx, node.Children, checker, tenantID, userID)
-		filtered = append(filtered, node)
 	}
-	return filtered
+
+	page := uint32(1)
+	if req.Page != nil {
+		page = *req.Page
+	}
+	pageSize := uint32(100)
+	if req.PageSize != nil {
+		pageSize = *req.PageSize
+	}
+
+	readableIDs, err := listReadableIDs(ctx, s.checker, tenantID, userID, authz.ResourceTypeCategory)
+	if err != nil {
+		return nil, err
+	}
+
+	sortMode, err := s.childSortMode(ctx, tenantID, parentID)
+	if err != nil {
+		return nil, err
+	}
+
+	children, total, err := s.categoryRepo.List(ctx, tenantID, readableIDs, &parentID, nil, sortMode, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, err := s.categoryRepo.TreeNodes(ctx, tenantID, children, req.IncludeCounts, readableIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &paperlessV1.GetCategoryChildrenResponse{
+		Children: nodes,
+		Total:    uint32(total),
+	}, nil
+}
+
+// GetCategoryPath gets the ancestor chain of a category or of a document's category. Ancestors
+// the caller can't read are included, since the category's path names them anyway, but are
+// marked as not readable.
+func (s *CategoryService) GetCategoryPath(ctx context.Context, req *paperlessV1.GetCategoryPathRequest) (*paperlessV1.GetCategoryPathResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	if (req.CategoryId == nil) == (req.DocumentId == nil) {
+		return nil, errdetail.With(paperlessV1.ErrorBadRequest("exactly one of categoryId and documentId is required"),
+			errdetail.KeyCategoryPathTargetRequired, "")
+	}
+
+	categoryID := req.GetCategoryId()
+	if req.DocumentId != nil {
+		if err := s.checker.CanReadDocument(ctx, tenantID, userID, req.GetDocumentId()); err != nil {
+			return nil, errNoAccess("no read access to document", "read", "document", "document_id")
+		}
+		document, err := s.documentRepo.GetByID(ctx, req.GetDocumentId())
+		if err != nil {
+			return nil, err
+		}
+		if document == nil {
+			return nil, errDocumentNotFound("document_id")
+		}
+		if document.CategoryID == nil || *document.CategoryID == "" {
+			return &paperlessV1.GetCategoryPathResponse{Segments: []*paperlessV1.CategoryPathSegment{}}, nil
+		}
+		categoryID = *document.CategoryID
+	} else if err := s.checker.CanReadCategory(ctx, tenantID, userID, categoryID); err != nil {
+		return nil, errNoAccess("no read access to category", "read", "category", "category_id")
+	}
+
+	category, err := s.categoryRepo.GetByID(ctx, categoryID)
+	if err != nil {
+		return nil, err
+	}
+	if category == nil {
+		return nil, errCategoryNotFound("category not found", "category_id")
+	}
+
+	ancestors, err := s.categoryRepo.ListAncestors(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+
+	segments := make([]*paperlessV1.CategoryPathSegment, 0, len(ancestors))
+	for _, a := range ancestors {
+		segments = append(segments, &paperlessV1.CategoryPathSegment{
+			Id:       a.ID,
+			Name:     a.Name,
+			Readable: s.checker.CanReadCategory(ctx, tenantID, userID, a.ID) == nil,
+		})
+	}
+
+	return &paperlessV1.GetCategoryPathResponse{
+		Segments: segments,
+	}, nil
+}
+
+// RebuildCategoryPaths recomputes the materialized path and depth of a tenant's categories from
+// their parent links and repairs the rows that drifted
+func (s *CategoryService) RebuildCategoryPaths(ctx context.Context, req *paperlessV1.RebuildCategoryPathsRequest) (*paperlessV1.RebuildCategoryPathsResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	// Tree repair is a platform operation governed by the admin bypass policy
+	permission := authz.PermissionWrite
+	if req.DryRun {
+		permission = authz.PermissionRead
+	}
+	if !s.engine.AdminBypass(ctx, tenantID, userID, permission) {
+		return nil, errdetail.With(paperlessV1.ErrorAccessDenied("rebuilding category paths requires platform admin access"),
+			errdetail.KeyPlatformAdminRequired, "", "action", "rebuild_category_paths")
+	}
+
+	target := tenantID
+	if req.TenantId != nil {
+		target = *req.TenantId
+	}
+
+	var result *data.CategoryPathRebuild
+	err := s.tx.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = s.categoryRepo.RebuildPaths(ctx, target, req.DryRun)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &paperlessV1.RebuildCategoryPathsResponse{
+		Checked:        uint32(result.Checked),
+		Fixed:          make([]*paperlessV1.CategoryPathFix, 0, len(result.Fixed)),
+		UnreachableIds: result.Unreachable,
+	}
+	for _, fix := range result.Fixed {
+		resp.Fixed = append(resp.Fixed, &paperlessV1.CategoryPathFix{
+			Id:       fix.ID,
+			Name:     fix.Name,
+			OldPath:  fix.OldPath,
+			NewPath:  fix.NewPath,
+			OldDepth: fix.OldDepth,
+			NewDepth: fix.NewDepth,
+		})
+
+		if !req.DryRun {
+			_ = s.auditRepo.Create(ctx, &data.AuditEventInput{
+				TenantID:     target,
+				UserID:       userID,
+				Action:       auditevent.ActionAUDIT_ACTION_UPDATE,
+				ResourceType: auditevent.ResourceTypeRESOURCE_TYPE_CATEGORY,
+				ResourceID:   fix.ID,
+				ResourceName: fix.Name,
+				Details: map[string]string{
+					"path_rebuilt":  "true",
+					"previous_path": fix.OldPath,
+					"path":          fix.NewPath,
+				},
+			})
+		}
+	}
+
+	s.log.Infof("category path rebuild of tenant %d by user %s: %d checked, %d fixed, %d unreachable (dry run: %t)",
+		target, userID, result.Checked, len(result.Fixed), len(result.Unreachable), req.DryRun)
+
+	return resp, nil
 }
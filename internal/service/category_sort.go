@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/authz"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/auditevent"
+	"github.com/go-tangra/go-tangra-paperless/internal/errdetail"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// childSortMode returns how the subcategories of a category, or with an empty parentID the root
+// categories, are ordered
+func (s *CategoryService) childSortMode(ctx context.Context, tenantID uint32, parentID string) (string, error) {
+	if parentID == "" {
+		return s.categoryRepo.RootSortMode(ctx, tenantID)
+	}
+	parent, err := s.categoryRepo.GetByID(ctx, parentID)
+	if err != nil {
+		return "", err
+	}
+	if parent == nil {
+		return "", errCategoryNotFound("parent category not found", "parent_id")
+	}
+	return string(parent.ChildSortMode), nil
+}
+
+// canOrderChildren checks that the caller may change the order of a category's subcategories,
+// or with an empty parentID of the root categories, which only tenant admins can
+func (s *CategoryService) canOrderChildren(ctx context.Context, tenantID uint32, userID, parentID string) error {
+	if parentID == "" {
+		if !isTenantAdmin(ctx) {
+			return errTenantAdminRequired("only tenant admins can order the root categories", "order_root_categories")
+		}
+		return nil
+	}
+	if err := s.checker.CanWriteCategory(ctx, tenantID, userID, parentID); err != nil {
+		return errNoAccess("no write access to parent category", "write", "category", "parent_id")
+	}
+	return nil
+}
+
+// setChildSortMode stores the sort mode of a category's subcategories or of the root categories
+func (s *CategoryService) setChildSortMode(ctx context.Context, tenantID uint32, parentID, mode string) error {
+	if parentID == "" {
+		return s.categoryRepo.SetRootSortMode(ctx, tenantID, mode)
+	}
+	_, err := s.categoryRepo.SetChildSortMode(ctx, parentID, mode)
+	return err
+}
+
+// SetCategorySortMode sets how the subcategories of a category, or the root categories, are
+// ordered
+func (s *CategoryService) SetCategorySortMode(ctx context.Context, req *paperlessV1.SetCategorySortModeRequest) (*paperlessV1.SetCategorySortModeResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+	parentID := req.GetParentId()
+
+	if err := s.canOrderChildren(ctx, tenantID, userID, parentID); err != nil {
+		return nil, err
+	}
+	if req.Mode == paperlessV1.CategorySortMode_CATEGORY_SORT_MODE_UNSPECIFIED {
+		return nil, errdetail.With(paperlessV1.ErrorBadRequest("sort mode is required"), errdetail.KeyCategorySortModeRequired, "mode")
+	}
+
+	if err := s.setChildSortMode(ctx, tenantID, parentID, req.Mode.String()); err != nil {
+		return nil, err
+	}
+
+	recordAudit(ctx, s.auditRepo, auditevent.ActionAUDIT_ACTION_UPDATE, auditevent.ResourceTypeRESOURCE_TYPE_CATEGORY, parentID, "", map[string]string{
+		"child_sort_mode": req.Mode.String(),
+	})
+
+	return &paperlessV1.SetCategorySortModeResponse{
+		Mode: req.Mode,
+	}, nil
+}
+
+// ReorderCategories renumbers the sort order of the subcategories of a category, or of the root
+// categories, to the given order and switches them to manual ordering
+func (s *CategoryService) ReorderCategories(ctx context.Context, req *paperlessV1.ReorderCategoriesRequest) (*paperlessV1.ReorderCategoriesResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+	parentID := req.GetParentId()
+
+	if err := s.canOrderChildren(ctx, tenantID, userID, parentID); err != nil {
+		return nil, err
+	}
+
+	var ordered []*ent.Category
+	err := s.tx.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		if ordered, err = s.categoryRepo.Reorder(ctx, tenantID, parentID, req.CategoryIds); err != nil {
+			return err
+		}
+		return s.setChildSortMode(ctx, tenantID, parentID, paperlessV1.CategorySortMode_CATEGORY_SORT_MODE_MANUAL.String())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	recordAudit(ctx, s.auditRepo, auditevent.ActionAUDIT_ACTION_UPDATE, auditevent.ResourceTypeRESOURCE_TYPE_CATEGORY, parentID, "", map[string]string{
+		"child_sort_mode": paperlessV1.CategorySortMode_CATEGORY_SORT_MODE_MANUAL.String(),
+		"reordered":       strconv.Itoa(len(req.CategoryIds)),
+	})
+
+	// Siblings the caller can't read keep their place but are not returned
+	readable, err := readableSet(ctx, s.checker, tenantID, userID, authz.ResourceTypeCategory)
+	if err != nil {
+		return nil, err
+	}
+	categories := make([]*paperlessV1.Category, 0, len(ordered))
+	for _, c := range ordered {
+		if readable == nil || readable[c.ID] {
+			categories = append(categories, s.categoryRepo.ToProto(c))
+		}
+	}
+
+	return &paperlessV1.ReorderCategoriesResponse{
+		Categories: categories,
+	}, nil
+}
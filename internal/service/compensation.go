@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// compensationTimeout bounds the undo steps of a failed operation
+const compensationTimeout = 30 * time.Second
+
+// compensation undoes one side effect of an operation that failed later on
+type compensation struct {
+	name string
+	undo func(ctx context.Context) error
+}
+
+// compensations records the side effects an operation makes outside its database transaction,
+// such as storage uploads, so they can be undone when a later step fails. Steps are undone in
+// reverse order.
+type compensations struct {
+	steps []compensation
+}
+
+// add records how to undo a side effect that has just happened
+func (c *compensations) add(name string, undo func(ctx context.Context) error) {
+	c.steps = append(c.steps, compensation{name: name, undo: undo})
+}
+
+// run undoes the recorded side effects, newest first. They are undone even if ctx was canceled,
+// which is often why the operation failed. A step that fails is logged and the rest still run;
+// what it leaves behind is for the background cleanup (e.g. the storage GC) to collect.
+func (c *compensations) run(ctx context.Context, l *log.Helper) {
+	if len(c.steps) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), compensationTimeout)
+	defer cancel()
+
+	for i := len(c.steps) - 1; i >= 0; i-- {
+		step := c.steps[i]
+		if err := step.undo(ctx); err != nil {
+			l.Warnf("compensation %q failed: %v", step.name, err)
+		}
+	}
+	c.steps = nil
+}
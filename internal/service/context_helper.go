@@ -1,10 +1,128 @@
 package service
 
-import "github.com/go-tangra/go-tangra-common/grpcx"
+import (
+	"context"
+	"net"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-tangra/go-tangra-common/grpcx"
+	"github.com/go-tangra/go-tangra-common/middleware/mtls"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/authz"
+)
+
+// mdMFAVerified is the metadata key set by the gateway once the caller has passed MFA
+const mdMFAVerified = "x-md-global-mfa-verified"
+
+// mfaTrustedClients are the common names of the client certificates of the gateways allowed
+// to attest MFA, from the comma-separated PAPERLESS_MFA_TRUSTED_CLIENTS
+var mfaTrustedClients = sync.OnceValue(func() []string {
+	return envList("PAPERLESS_MFA_TRUSTED_CLIENTS", "")
+})
+
+// clientIPTrustedClients are the common names of the client certificates of the gateways
+// allowed to forward the client IP, from the comma-separated PAPERLESS_CLIENT_IP_TRUSTED_CLIENTS
+var clientIPTrustedClients = sync.OnceValue(func() []string {
+	return envList("PAPERLESS_CLIENT_IP_TRUSTED_CLIENTS", "")
+})
+
+// tenantAdminRoles are the roles that make a user an admin of their tenant, from the
+// comma-separated PAPERLESS_TENANT_ADMIN_ROLES (default "tenant:admin")
+var tenantAdminRoles = sync.OnceValue(func() []string {
+	return envList("PAPERLESS_TENANT_ADMIN_ROLES", "tenant:admin")
+})
+
+// envList reads a comma-separated list from the environment, falling back to def when unset
+func envList(key, def string) []string {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		value = def
+	}
+
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
 
 var (
 	getTenantIDFromContext = grpcx.GetTenantIDFromContext
 	getUserIDFromContext   = grpcx.GetUserIDFromContext
 	getUserIDAsUint32     = grpcx.GetUserIDAsUint32
+	getUsernameFromContext = grpcx.GetUsernameFromContext
 	getRolesFromContext   = grpcx.GetRolesFromContext
+	isPlatformAdmin       = grpcx.IsPlatformAdmin
 )
+
+// RequestAttributesFromContext extracts the attributes used to evaluate permission conditions
+func RequestAttributesFromContext(ctx context.Context) authz.RequestAttributes {
+	return authz.RequestAttributes{
+		ClientIP:    clientIP(ctx),
+		MFAVerified: mfaVerified(ctx),
+		Time:        time.Now(),
+	}
+}
+
+// mfaVerified reports whether the caller passed MFA. The gateway checks the MFA claim of the
+// user's session token and forwards the result as mdMFAVerified, but any client could send
+// that header, so it only counts on requests whose mTLS certificate names a trusted gateway.
+// Without PAPERLESS_MFA_TRUSTED_CLIENTS no caller is considered MFA verified.
+func mfaVerified(ctx context.Context) bool {
+	if grpcx.GetMetadataValue(ctx, mdMFAVerified) != "true" || !mtls.IsClientAuthenticated(ctx) {
+		return false
+	}
+	return slices.Contains(mfaTrustedClients(), mtls.GetClientID(ctx))
+}
+
+// isTenantAdmin reports whether the caller holds one of the tenantAdminRoles. Tenant
+// administration is granted by role alone; it is independent of the admin bypass policy, which
+// only governs platform admins' access to documents and categories.
+func isTenantAdmin(ctx context.Context) bool {
+	admins := tenantAdminRoles()
+	for _, role := range getRolesFromContext(ctx) {
+		if slices.Contains(admins, role) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientIP returns the IP of the client the request came from. Gateways forward it as
+// x-client-ip, but any client could send that header, so it only counts on requests whose mTLS
+// certificate names a trusted gateway; otherwise the address of the connection is used.
+func clientIP(ctx context.Context) string {
+	if ip := grpcx.GetClientIPFromContext(ctx); ip != "" && mtls.IsClientAuthenticated(ctx) &&
+		slices.Contains(clientIPTrustedClients(), mtls.GetClientID(ctx)) {
+		return ip
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+// newCallerContext makes background work act as a user of a tenant, as if the user had
+// called the service, so ownership and audit events are attributed to them
+func newCallerContext(ctx context.Context, tenantID uint32, userID *uint32) context.Context {
+	md := metadata.Pairs(grpcx.MDTenantID, strconv.FormatUint(uint64(tenantID), 10))
+	if userID != nil {
+		md.Set(grpcx.MDUserID, strconv.FormatUint(uint64(*userID), 10))
+	}
+	return metadata.NewIncomingContext(ctx, md)
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/authz"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/auditevent"
+	"github.com/go-tangra/go-tangra-paperless/internal/errdetail"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+const (
+	defaultInboxPageSize = 20
+	maxInboxPageSize     = 100
+)
+
+// AssignDocument assigns a document to a user for action, or unassigns it, and notifies the new
+// assignee. The assignee must be able to read the document.
+func (s *DocumentService) AssignDocument(ctx context.Context, req *paperlessV1.AssignDocumentRequest) (*paperlessV1.AssignDocumentResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+	assignedBy := getUserIDAsUint32(ctx)
+
+	if err := s.checker.CanWriteDocument(ctx, tenantID, userID, req.Id); err != nil {
+		return nil, errNoAccess("no write access to document", "write", "document", "id")
+	}
+	if req.AssigneeId != nil {
+		assignee := strconv.FormatUint(uint64(req.GetAssigneeId()), 10)
+		if err := s.checker.CanReadDocument(ctx, tenantID, assignee, req.Id); err != nil {
+			return nil, errdetail.With(paperlessV1.ErrorBadRequest("user %s can't read the document", assignee),
+				errdetail.KeyDocumentAssigneeCannotRead, "assignee_id", "user", assignee)
+		}
+	}
+
+	var before, document *ent.Document
+	err := s.tx.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		before, err = s.documentRepo.GetByID(ctx, req.Id)
+		if err != nil {
+			return err
+		}
+		if before == nil {
+			return errDocumentNotFound("id")
+		}
+		document, err = s.documentRepo.Assign(ctx, req.Id, req.AssigneeId, assignedBy, req.ExpectedVersion)
+		if err != nil {
+			return err
+		}
+		return s.events.Publish(ctx, tenantID, document.ID, EventDocumentUpdated, newDocumentEvent(ctx, document))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	details := map[string]string{"assignee": ""}
+	if req.AssigneeId != nil {
+		details["assignee"] = strconv.FormatUint(uint64(req.GetAssigneeId()), 10)
+	}
+	if before.AssigneeID != nil {
+		details["previous_assignee"] = strconv.FormatUint(uint64(*before.AssigneeID), 10)
+	}
+	recordAudit(ctx, s.auditRepo, auditevent.ActionAUDIT_ACTION_UPDATE, auditevent.ResourceTypeRESOURCE_TYPE_DOCUMENT, document.ID, document.Name, details)
+
+	// Only a new assignee is notified
+	if req.AssigneeId != nil && (before.AssigneeID == nil || *before.AssigneeID != req.GetAssigneeId()) {
+		s.notifier.NotifyAssignment(ctx, tenantID, req.GetAssigneeId(), document, req.Note)
+	}
+
+	proto, err := s.documentRepo.ToProtoWithCategoryPath(ctx, document)
+	if err != nil {
+		return nil, err
+	}
+
+	return &paperlessV1.AssignDocumentResponse{
+		Document: proto,
+	}, nil
+}
+
+// ListMyInbox lists the active documents assigned to the caller that the caller can read, most
+// recently assigned first
+func (s *DocumentService) ListMyInbox(ctx context.Context, req *paperlessV1.ListMyInboxRequest) (*paperlessV1.ListMyInboxResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+	assigneeID := getUserIDAsUint32(ctx)
+	if assigneeID == nil {
+		return nil, errdetail.With(paperlessV1.ErrorAccessDenied("the inbox requires a user"), errdetail.KeyUserRequired, "")
+	}
+
+	page := uint32(1)
+	if req.Page != nil && *req.Page > 0 {
+		page = *req.Page
+	}
+	pageSize := uint32(defaultInboxPageSize)
+	if req.PageSize != nil && *req.PageSize > 0 {
+		pageSize = min(*req.PageSize, maxInboxPageSize)
+	}
+
+	// Documents the caller lost access to since they were assigned stay hidden
+	readableIDs, err := listReadableIDs(ctx, s.checker, tenantID, userID, authz.ResourceTypeDocument)
+	if err != nil {
+		return nil, err
+	}
+
+	documents, total, err := s.documentRepo.ListInbox(ctx, tenantID, *assigneeID, readableIDs, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	protoDocuments, err := s.documentRepo.ToProtosWithCategoryPath(ctx, documents)
+	if err != nil {
+		return nil, err
+	}
+
+	return &paperlessV1.ListMyInboxResponse{
+		Documents: protoDocuments,
+		Total:     uint32(total),
+	}, nil
+}
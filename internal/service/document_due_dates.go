@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/authz"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+const (
+	defaultDueSoonDays     = 30
+	defaultDueSoonPageSize = 20
+	maxDueSoonPageSize     = 100
+)
+
+// ListDocumentsDueSoon lists the readable active documents due within the given number of days,
+// soonest first, optionally with the overdue ones
+func (s *DocumentService) ListDocumentsDueSoon(ctx context.Context, req *paperlessV1.ListDocumentsDueSoonRequest) (*paperlessV1.ListDocumentsDueSoonResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	days := uint32(defaultDueSoonDays)
+	if req.WithinDays != nil {
+		days = *req.WithinDays
+	}
+	now := time.Now()
+	until := now.AddDate(0, 0, int(days))
+	var from *time.Time
+	if !req.IncludeOverdue {
+		from = &now
+	}
+
+	page := uint32(1)
+	if req.Page != nil && *req.Page > 0 {
+		page = *req.Page
+	}
+	pageSize := uint32(defaultDueSoonPageSize)
+	if req.PageSize != nil && *req.PageSize > 0 {
+		pageSize = min(*req.PageSize, maxDueSoonPageSize)
+	}
+
+	readableIDs, err := listReadableIDs(ctx, s.checker, tenantID, userID, authz.ResourceTypeDocument)
+	if err != nil {
+		return nil, err
+	}
+
+	documents, total, err := s.documentRepo.ListDueSoon(ctx, tenantID, readableIDs, req.CategoryId, req.IncludeSubcategories, from, until, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	protoDocuments, err := s.documentRepo.ToProtosWithCategoryPath(ctx, documents)
+	if err != nil {
+		return nil, err
+	}
+
+	return &paperlessV1.ListDocumentsDueSoonResponse{
+		Documents: protoDocuments,
+		Total:     uint32(total),
+	}, nil
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+const (
+	defaultHistoryPageSize = 20
+	maxHistoryPageSize     = 100
+)
+
+// GetDocumentHistory lists the metadata changes of a document the caller can read, newest first
+func (s *DocumentService) GetDocumentHistory(ctx context.Context, req *paperlessV1.GetDocumentHistoryRequest) (*paperlessV1.GetDocumentHistoryResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	if err := s.checker.CanReadDocument(ctx, tenantID, userID, req.Id); err != nil {
+		return nil, errNoAccess("no read access to document", "read", "document", "id")
+	}
+
+	page := uint32(1)
+	if req.Page != nil && *req.Page > 0 {
+		page = *req.Page
+	}
+	pageSize := uint32(defaultHistoryPageSize)
+	if req.PageSize != nil && *req.PageSize > 0 {
+		pageSize = min(*req.PageSize, maxHistoryPageSize)
+	}
+
+	entries, total, err := s.history.List(ctx, tenantID, req.Id, int(pageSize), int((page-1)*pageSize))
+	if err != nil {
+		return nil, err
+	}
+
+	protoEntries := make([]*paperlessV1.DocumentHistoryEntry, 0, len(entries))
+	for _, e := range entries {
+		protoEntries = append(protoEntries, s.history.ToProto(e))
+	}
+
+	return &paperlessV1.GetDocumentHistoryResponse{
+		Entries: protoEntries,
+		Total:   uint32(total),
+	}, nil
+}
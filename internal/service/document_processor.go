@@ -2,11 +2,23 @@ package service
 
 import (
 	"context"
+	"errors"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/go-tangra/go-tangra-paperless/internal/data"
+	"github.com/go-tangra/go-tangra-paperless/internal/errdetail"
+	"github.com/go-tangra/go-tangra-paperless/internal/metrics"
+	"github.com/go-tangra/go-tangra-paperless/internal/tracing"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
 )
 
 const (
@@ -14,50 +26,163 @@ const (
 	mimeTypeDOC  = "application/msword"
 	mimeTypeDOCX = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
 
+	statusPending    = "PROCESSING_STATUS_PENDING"
 	statusProcessing = "PROCESSING_STATUS_PROCESSING"
 	statusCompleted  = "PROCESSING_STATUS_COMPLETED"
 	statusFailed     = "PROCESSING_STATUS_FAILED"
 	statusSkipped    = "PROCESSING_STATUS_SKIPPED"
+	statusInfected   = "PROCESSING_STATUS_INFECTED"
+)
+
+const (
+	defaultProcessingWorkers    = 4
+	defaultProcessingRetries    = 2
+	defaultProcessingRetryDelay = 10 * time.Second
 )
 
-// DocumentProcessor handles async document content extraction
+// errProcessingSkipped reports a document whose type has no text extraction
+var errProcessingSkipped = errors.New("unsupported mime type")
+
+// errDocumentInfected reports a document the antivirus scanner found malware in
+var errDocumentInfected = errors.New("malware detected")
+
+// errQuarantined refuses to hand out the file of an infected document
+func errQuarantined() error {
+	return errdetail.With(paperlessV1.ErrorForbidden("document is quarantined: malware was detected in its file"),
+		errdetail.KeyDocumentQuarantined, "")
+}
+
+// DocumentProcessor handles async document content extraction. Uploads are queued and
+// processed by a pool of workers; failed extractions are retried with a growing delay.
+// It runs as an app server so the workers stop with the application.
 type DocumentProcessor struct {
 	log          *log.Helper
 	tika         *data.TikaClient
 	gotenberg    *data.GotenbergClient
 	documentRepo *data.DocumentRepo
+	settings     *data.TenantSettingsRepo
+	events       *data.EventPublisher
+	scanner      data.AntivirusScanner
+	storage      data.Storage
+
+	workers    int
+	maxRetries int
+	retryDelay time.Duration
+
+	mu       sync.Mutex
+	wake     *sync.Cond
+	queue    []*processingJob
+	inFlight int
+	retrying int
+	retries  uint64
+	stopped  bool
+	wg       sync.WaitGroup
 }
 
-// NewDocumentProcessor creates a new DocumentProcessor
+// NewDocumentProcessor creates a new DocumentProcessor configured by PAPERLESS_PROCESSING_WORKERS,
+// PAPERLESS_PROCESSING_MAX_RETRIES and PAPERLESS_PROCESSING_RETRY_DELAY
 func NewDocumentProcessor(
 	ctx *bootstrap.Context,
 	tika *data.TikaClient,
 	gotenberg *data.GotenbergClient,
 	documentRepo *data.DocumentRepo,
+	settings *data.TenantSettingsRepo,
+	events *data.EventPublisher,
+	scanner data.AntivirusScanner,
+	storage data.Storage,
 ) *DocumentProcessor {
-	return &DocumentProcessor{
-		log:          ctx.NewLoggerHelper("paperless/service/document-processor"),
+	l := ctx.NewLoggerHelper("paperless/service/document-processor")
+
+	p := &DocumentProcessor{
+		log:          l,
 		tika:         tika,
 		gotenberg:    gotenberg,
 		documentRepo: documentRepo,
+		settings:     settings,
+		events:       events,
+		scanner:      scanner,
+		storage:      storage,
+		workers:      defaultProcessingWorkers,
+		maxRetries:   defaultProcessingRetries,
+		retryDelay:   defaultProcessingRetryDelay,
 	}
+	p.wake = sync.NewCond(&p.mu)
+
+	if v := os.Getenv("PAPERLESS_PROCESSING_WORKERS"); v != "" {
+		workers, err := strconv.Atoi(v)
+		if err != nil || workers < 1 {
+			l.Warnf("invalid PAPERLESS_PROCESSING_WORKERS %q, using %d", v, defaultProcessingWorkers)
+		} else {
+			p.workers = workers
+		}
+	}
+	if v := os.Getenv("PAPERLESS_PROCESSING_MAX_RETRIES"); v != "" {
+		retries, err := strconv.Atoi(v)
+		if err != nil || retries < 0 {
+			l.Warnf("invalid PAPERLESS_PROCESSING_MAX_RETRIES %q, using %d", v, defaultProcessingRetries)
+		} else {
+			p.maxRetries = retries
+		}
+	}
+	if v := os.Getenv("PAPERLESS_PROCESSING_RETRY_DELAY"); v != "" {
+		delay, err := time.ParseDuration(v)
+		if err != nil || delay <= 0 {
+			l.Warnf("invalid PAPERLESS_PROCESSING_RETRY_DELAY %q, using %s", v, defaultProcessingRetryDelay)
+		} else {
+			p.retryDelay = delay
+		}
+	}
+
+	return p
 }
 
-// ProcessDocument extracts text and metadata from a document asynchronously
-func (p *DocumentProcessor) ProcessDocument(ctx context.Context, documentID string, fileContent []byte, mimeType string) {
+// ProcessDocument runs one extraction attempt: the file is scanned for malware if a scanner is
+// configured, then text and metadata are extracted and stored, as far as the tenant's settings
+// enable these steps. Infected files are quarantined, and unsupported types and documents of
+// tenants that disabled both extractions are marked skipped. Failures are returned without
+// marking the document, so the caller can decide between a retry and giving up.
+//
+// The file stored under fileKey is streamed from storage to each service that reads it, rather
+// than held in memory; DOC and DOCX files are converted to a temporary PDF file on disk.
+func (p *DocumentProcessor) ProcessDocument(ctx context.Context, tenantID uint32, documentID, fileKey, mimeType string) error {
 	p.log.Infof("starting document processing: id=%s, mimeType=%s", documentID, mimeType)
 
 	// Set status to PROCESSING
-	if err := p.documentRepo.UpdateProcessingResult(ctx, documentID, "", nil, statusProcessing); err != nil {
+	if err := p.documentRepo.UpdateProcessingResult(ctx, documentID, "", "", nil, statusProcessing); err != nil {
 		p.log.Errorf("failed to set processing status: %v", err)
-		return
+		return err
+	}
+
+	if err := p.scan(ctx, documentID, fileKey, mimeType); err != nil {
+		return err
+	}
+
+	settings, err := p.settings.For(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	extractText := settings.StepEnabled(paperlessV1.ProcessingStep_PROCESSING_STEP_TEXT_EXTRACTION)
+	extractMetadata := settings.StepEnabled(paperlessV1.ProcessingStep_PROCESSING_STEP_METADATA_EXTRACTION)
+	if !extractText && !extractMetadata {
+		p.log.Infof("skipping document %s: text and metadata extraction are disabled for tenant %d", documentID, tenantID)
+		if updateErr := p.documentRepo.UpdateProcessingResult(ctx, documentID, "", "", nil, statusSkipped); updateErr != nil {
+			p.log.Errorf("failed to set processing status to SKIPPED for document %s: %v", documentID, updateErr)
+		}
+		return errProcessingSkipped
+	}
+	opts := data.TikaOptions{
+		OCRLanguage: settings.OCRLanguage,
+		SkipOCR:     !settings.StepEnabled(paperlessV1.ProcessingStep_PROCESSING_STEP_OCR),
 	}
 
-	var pdfContent []byte
+	// openPDF opens the PDF to extract from; each extraction reads it once
+	var openPDF func() (io.ReadCloser, error)
 
 	switch mimeType {
 	case mimeTypePDF:
-		pdfContent = fileContent
+		openPDF = func() (io.ReadCloser, error) {
+			return p.storage.Open(ctx, fileKey, 0, -1)
+		}
 	case mimeTypeDOC, mimeTypeDOCX:
 		// Convert to PDF via Gotenberg first
 		// Use an ASCII filename with correct extension — Gotenberg needs the extension to pick the converter
@@ -65,46 +190,226 @@ func (p *DocumentProcessor) ProcessDocument(ctx context.Context, documentID stri
 		if mimeType == mimeTypeDOCX {
 			ext = ".docx"
 		}
-		converted, err := p.gotenberg.ConvertToPDF(ctx, fileContent, "document"+ext)
+		pdfPath, err := p.convertToPDF(ctx, fileKey, "document"+ext)
 		if err != nil {
 			p.log.Errorf("gotenberg conversion failed for document %s: %v", documentID, err)
-			if updateErr := p.documentRepo.UpdateProcessingResult(ctx, documentID, "", nil, statusFailed); updateErr != nil {
-				p.log.Errorf("failed to set processing status to FAILED for document %s: %v", documentID, updateErr)
-			}
-			return
+			return err
+		}
+		defer os.Remove(pdfPath)
+		openPDF = func() (io.ReadCloser, error) {
+			return os.Open(pdfPath)
 		}
-		pdfContent = converted
 	default:
 		p.log.Infof("skipping unsupported mime type for document %s: %s", documentID, mimeType)
-		if updateErr := p.documentRepo.UpdateProcessingResult(ctx, documentID, "", nil, statusSkipped); updateErr != nil {
+		if updateErr := p.documentRepo.UpdateProcessingResult(ctx, documentID, "", "", nil, statusSkipped); updateErr != nil {
 			p.log.Errorf("failed to set processing status to SKIPPED for document %s: %v", documentID, updateErr)
 		}
-		return
+		return errProcessingSkipped
 	}
 
 	// Extract text via Tika
-	text, err := p.tika.ExtractText(ctx, pdfContent, mimeTypePDF)
-	if err != nil {
-		p.log.Errorf("tika text extraction failed for document %s: %v", documentID, err)
-		if updateErr := p.documentRepo.UpdateProcessingResult(ctx, documentID, "", nil, statusFailed); updateErr != nil {
-			p.log.Errorf("failed to set processing status to FAILED for document %s: %v", documentID, updateErr)
+	var text string
+	if extractText {
+		err = readPDF(openPDF, func(pdf io.Reader) (err error) {
+			text, err = p.tika.ExtractText(ctx, pdf, mimeTypePDF, opts)
+			return err
+		})
+		if err != nil {
+			p.log.Errorf("tika text extraction failed for document %s: %v", documentID, err)
+			return err
 		}
-		return
 	}
 
 	// Extract metadata via Tika
-	metadata, err := p.tika.ExtractMetadata(ctx, pdfContent, mimeTypePDF)
-	if err != nil {
-		p.log.Warnf("tika metadata extraction failed for document %s: %v", documentID, err)
-		// Continue with text only - metadata is not critical
-		metadata = nil
+	var metadata map[string]string
+	if extractMetadata {
+		err = readPDF(openPDF, func(pdf io.Reader) (err error) {
+			metadata, err = p.tika.ExtractMetadata(ctx, pdf, mimeTypePDF, opts)
+			return err
+		})
+		if err != nil {
+			p.log.Warnf("tika metadata extraction failed for document %s: %v", documentID, err)
+			// Continue with text only - metadata is not critical
+			metadata = nil
+		}
+	}
+
+	// Text over the stored limit is archived in full, and only its start goes into the database
+	var textKey, previousKey string
+	if text != "" {
+		if previousKey, err = p.documentRepo.GetContentTextKey(ctx, documentID); err != nil {
+			return err
+		}
+		if p.documentRepo.ContentTextOverLimit(text) {
+			if textKey, err = p.archiveText(ctx, documentID, text); err != nil {
+				p.log.Errorf("failed to archive content text of document %s: %v", documentID, err)
+				return err
+			}
+		}
 	}
 
 	// Update document with extracted content
-	if err := p.documentRepo.UpdateProcessingResult(ctx, documentID, text, metadata, statusCompleted); err != nil {
+	if err := p.documentRepo.UpdateProcessingResult(ctx, documentID, text, textKey, metadata, statusCompleted); err != nil {
 		p.log.Errorf("failed to update processing result for document %s: %v", documentID, err)
-		return
+		if textKey != "" && textKey != previousKey {
+			p.deleteArchive(ctx, textKey)
+		}
+		return err
+	}
+	// The text of an earlier attempt was replaced; an archive under the same key was overwritten
+	if previousKey != "" && previousKey != textKey {
+		p.deleteArchive(ctx, previousKey)
 	}
 
 	p.log.Infof("document processing completed: id=%s, textLen=%d", documentID, len(text))
+	return nil
+}
+
+// scan checks a file for malware and quarantines the document if it is infected. A scanner
+// that can't be reached fails the attempt, so the file is retried instead of let through.
+func (p *DocumentProcessor) scan(ctx context.Context, documentID, fileKey, mimeType string) error {
+	if p.scanner == nil {
+		return nil
+	}
+
+	file, err := p.storage.Open(ctx, fileKey, 0, -1)
+	if err != nil {
+		p.log.Errorf("failed to open file of document %s for scanning: %v", documentID, err)
+		return err
+	}
+	defer file.Close()
+
+	verdict, err := p.scanner.Scan(ctx, documentID, mimeType, file)
+	if err != nil {
+		metrics.AntivirusScans.WithLabelValues(p.scanner.Name(), metrics.ResultError).Inc()
+		p.log.Errorf("antivirus scan failed for document %s: %v", documentID, err)
+		return err
+	}
+	if !verdict.Infected {
+		metrics.AntivirusScans.WithLabelValues(p.scanner.Name(), "clean").Inc()
+		return nil
+	}
+
+	metrics.AntivirusScans.WithLabelValues(p.scanner.Name(), "infected").Inc()
+	p.log.Warnf("malware found in document %s: %s", documentID, verdict.Threat)
+	if err := p.documentRepo.UpdateProcessingResult(ctx, documentID, "", "", map[string]string{"antivirus_threat": verdict.Threat}, statusInfected); err != nil {
+		p.log.Errorf("failed to quarantine document %s: %v", documentID, err)
+		return err
+	}
+	return errDocumentInfected
+}
+
+// convertToPDF converts the DOC or DOCX file stored under fileKey to a temporary PDF file and
+// returns its path. Neither file is held in memory: the file is streamed from storage to
+// Gotenberg, and the PDF from Gotenberg to disk, where both extractions can read it. The
+// caller removes the PDF.
+func (p *DocumentProcessor) convertToPDF(ctx context.Context, fileKey, fileName string) (string, error) {
+	src, err := p.storage.Open(ctx, fileKey, 0, -1)
+	if err != nil {
+		return "", err
+	}
+	defer src.Close()
+
+	pdf, err := os.CreateTemp("", "paperless-*.pdf")
+	if err != nil {
+		return "", err
+	}
+	_, err = p.gotenberg.ConvertToPDF(ctx, src, fileName, pdf)
+	if closeErr := pdf.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(pdf.Name())
+		return "", err
+	}
+	return pdf.Name(), nil
+}
+
+// archiveText stores the full text extracted from a document in storage and returns its key
+func (p *DocumentProcessor) archiveText(ctx context.Context, documentID, text string) (string, error) {
+	doc, err := p.documentRepo.GetByID(data.WithDeleted(ctx), documentID)
+	if err != nil {
+		return "", err
+	}
+	if doc == nil {
+		return "", errDocumentNotFound("id")
+	}
+	return data.ArchiveContentText(ctx, p.storage, doc, text)
+}
+
+// deleteArchive removes archived text that no document refers to anymore. A failure leaves an
+// orphaned object for storage GC.
+func (p *DocumentProcessor) deleteArchive(ctx context.Context, key string) {
+	if err := p.storage.Delete(ctx, key); err != nil {
+		p.log.Warnf("failed to delete archived content text %s: %v", key, err)
+	}
+}
+
+// readPDF opens a PDF and passes it to read, closing it afterwards
+func readPDF(open func() (io.ReadCloser, error), read func(io.Reader) error) error {
+	pdf, err := open()
+	if err != nil {
+		return err
+	}
+	defer pdf.Close()
+	return read(pdf)
+}
+
+// run processes a dequeued job and requeues or fails it if the attempt did not succeed
+func (p *DocumentProcessor) run(job *processingJob) {
+	ctx, span := tracing.Start(job.ctx, "document.process",
+		attribute.String("paperless.document_id", job.documentID),
+		attribute.String("paperless.mime_type", job.mimeType),
+		attribute.Int("paperless.processing_attempt", job.attempt+1),
+	)
+	start := time.Now()
+
+	err := p.ProcessDocument(ctx, job.tenantID, job.documentID, job.fileKey, job.mimeType)
+
+	outcome := "completed"
+	switch {
+	case errors.Is(err, errProcessingSkipped):
+		outcome = "skipped"
+		err = nil
+	case errors.Is(err, errDocumentInfected):
+		outcome = "infected"
+		err = nil
+	case err != nil && job.attempt < p.maxRetries:
+		outcome = "retrying"
+		// Waiting for the retry is reported as pending, not as still processing
+		if updateErr := p.documentRepo.UpdateProcessingResult(ctx, job.documentID, "", "", nil, statusPending); updateErr != nil {
+			p.log.Errorf("failed to set processing status to PENDING for document %s: %v", job.documentID, updateErr)
+		}
+		p.retry(job)
+	case err != nil:
+		outcome = "failed"
+		if updateErr := p.documentRepo.UpdateProcessingResult(ctx, job.documentID, "", "", nil, statusFailed); updateErr != nil {
+			p.log.Errorf("failed to set processing status to FAILED for document %s: %v", job.documentID, updateErr)
+		}
+	}
+
+	if outcome != "retrying" {
+		p.publishProcessed(ctx, job.documentID)
+	}
+
+	metrics.ProcessingDuration.WithLabelValues(outcome).Observe(time.Since(start).Seconds())
+	span.SetAttributes(attribute.String("paperless.processing_status", outcome))
+	tracing.End(span, err)
+}
+
+// publishProcessed announces the final processing status of a document
+func (p *DocumentProcessor) publishProcessed(ctx context.Context, documentID string) {
+	if !p.events.Enabled() {
+		return
+	}
+
+	document, err := p.documentRepo.GetByID(ctx, documentID)
+	if err != nil || document == nil {
+		p.log.Warnf("failed to load document %s for its processed event: %v", documentID, err)
+		return
+	}
+
+	// The status is already stored, so a failure here only loses the event; the repo logs it
+	event := newDocumentEvent(ctx, document)
+	_ = p.events.Publish(ctx, event.TenantID, document.ID, EventDocumentProcessed, event)
 }
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/metrics"
+)
+
+// processingJob is a document waiting for or undergoing text extraction
+type processingJob struct {
+	// ctx carries the viewer and trace of the upload, without its cancellation
+	ctx        context.Context
+	tenantID   uint32
+	documentID string
+	// fileKey is read from storage by every attempt, so queued jobs don't hold their files
+	fileKey  string
+	mimeType string
+
+	attempt  int
+	queuedAt time.Time
+}
+
+// ProcessingQueueStatus is a snapshot of the processing queue
+type ProcessingQueueStatus struct {
+	QueueDepth    int
+	InFlight      int
+	RetryWaiting  int
+	TotalRetries  uint64
+	OldestPending time.Duration
+	Workers       int
+	MaxRetries    int
+}
+
+// Enqueue queues the file stored under fileKey for text extraction. It never blocks; the
+// queue holds every upload until a worker is free.
+func (p *DocumentProcessor) Enqueue(ctx context.Context, tenantID uint32, documentID, fileKey, mimeType string) {
+	p.push(&processingJob{
+		ctx:        ctx,
+		tenantID:   tenantID,
+		documentID: documentID,
+		fileKey:    fileKey,
+		mimeType:   mimeType,
+	})
+}
+
+func (p *DocumentProcessor) push(job *processingJob) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	job.queuedAt = time.Now()
+	p.queue = append(p.queue, job)
+	p.updateQueueMetrics()
+	p.wake.Signal()
+}
+
+// retry requeues job after a delay that doubles with every attempt
+func (p *DocumentProcessor) retry(job *processingJob) {
+	delay := p.retryDelay << job.attempt
+	job.attempt++
+
+	p.mu.Lock()
+	p.retrying++
+	p.retries++
+	p.updateQueueMetrics()
+	p.mu.Unlock()
+	metrics.ProcessingRetries.Inc()
+
+	p.log.Infof("retrying processing of document %s in %s (attempt %d of %d)", job.documentID, delay, job.attempt+1, p.maxRetries+1)
+
+	time.AfterFunc(delay, func() {
+		p.mu.Lock()
+		p.retrying--
+		p.mu.Unlock()
+		p.push(job)
+	})
+}
+
+// updateQueueMetrics publishes the queue gauges; p.mu must be held
+func (p *DocumentProcessor) updateQueueMetrics() {
+	metrics.ProcessingQueueDepth.Set(float64(len(p.queue)))
+	metrics.ProcessingInFlight.Set(float64(p.inFlight))
+	metrics.ProcessingRetryWaiting.Set(float64(p.retrying))
+	if len(p.queue) > 0 {
+		metrics.ProcessingOldestPending.Set(float64(p.queue[0].queuedAt.Unix()))
+	} else {
+		metrics.ProcessingOldestPending.Set(0)
+	}
+}
+
+// QueueStatus returns a snapshot of the processing queue
+func (p *DocumentProcessor) QueueStatus() ProcessingQueueStatus {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	status := ProcessingQueueStatus{
+		QueueDepth:   len(p.queue),
+		InFlight:     p.inFlight,
+		RetryWaiting: p.retrying,
+		TotalRetries: p.retries,
+		Workers:      p.workers,
+		MaxRetries:   p.maxRetries,
+	}
+	// Jobs are queued in order, so the first one has waited longest
+	if len(p.queue) > 0 {
+		status.OldestPending = time.Since(p.queue[0].queuedAt)
+	}
+	return status
+}
+
+// Start implements transport.Server, starting the processing workers
+func (p *DocumentProcessor) Start(ctx context.Context) error {
+	p.log.Infof("document processing with %d workers (%d retries, first after %s)", p.workers, p.maxRetries, p.retryDelay)
+
+	for i := 0; i < p.workers; i++ {
+		p.wg.Add(1)
+		go p.work()
+	}
+	return nil
+}
+
+// Stop implements transport.Server. Jobs being processed are finished; queued documents
+// stay pending.
+func (p *DocumentProcessor) Stop(ctx context.Context) error {
+	p.mu.Lock()
+	p.stopped = true
+	p.wake.Broadcast()
+	p.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		p.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+	return nil
+}
+
+func (p *DocumentProcessor) work() {
+	defer p.wg.Done()
+
+	for {
+		p.mu.Lock()
+		for len(p.queue) == 0 && !p.stopped {
+			p.wake.Wait()
+		}
+		if p.stopped {
+			p.mu.Unlock()
+			return
+		}
+		job := p.queue[0]
+		p.queue[0] = nil
+		p.queue = p.queue[1:]
+		p.inFlight++
+		p.updateQueueMetrics()
+		p.mu.Unlock()
+
+		p.run(job)
+
+		p.mu.Lock()
+		p.inFlight--
+		p.updateQueueMetrics()
+		p.mu.Unlock()
+	}
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"path"
+	"strings"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/errdetail"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// renditionExtensions maps the types that can be rendered as PDF to the file extension
+// Gotenberg's LibreOffice route picks its converter by
+var renditionExtensions = map[string]string{
+	// Office documents
+	mimeTypeDOC:                     ".doc",
+	mimeTypeDOCX:                    ".docx",
+	"application/vnd.ms-excel":      ".xls",
+	"application/vnd.ms-powerpoint": ".ppt",
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet":         ".xlsx",
+	"application/vnd.openxmlformats-officedocument.presentationml.presentation": ".pptx",
+	"application/vnd.oasis.opendocument.text":                                   ".odt",
+	"application/vnd.oasis.opendocument.spreadsheet":                            ".ods",
+	"application/vnd.oasis.opendocument.presentation":                           ".odp",
+
+	// Text
+	"application/rtf": ".rtf",
+	"text/rtf":        ".rtf",
+	"text/plain":      ".txt",
+	"text/csv":        ".csv",
+	"text/html":       ".html",
+
+	// Images
+	"image/png":  ".png",
+	"image/jpeg": ".jpg",
+	"image/gif":  ".gif",
+	"image/bmp":  ".bmp",
+	"image/tiff": ".tiff",
+}
+
+// baseMimeType strips parameters such as the charset from a MIME type
+func baseMimeType(mimeType string) string {
+	base, _, _ := strings.Cut(mimeType, ";")
+	return strings.ToLower(strings.TrimSpace(base))
+}
+
+// renditionFileName names the PDF rendition of a file, e.g. "report.pdf" for "report.docx"
+func renditionFileName(fileName string) string {
+	return strings.TrimSuffix(fileName, path.Ext(fileName)) + ".pdf"
+}
+
+// pdfRendition returns a document's file as PDF. PDFs are returned as stored. Other files are
+// converted by Gotenberg on first use and the rendition is cached in storage until the file
+// changes.
+func (s *DocumentService) pdfRendition(ctx context.Context, doc *ent.Document) ([]byte, error) {
+	mimeType := baseMimeType(doc.MimeType)
+	if mimeType == mimeTypePDF {
+		return s.downloadFile(ctx, doc.FileKey)
+	}
+
+	ext, ok := renditionExtensions[mimeType]
+	if !ok {
+		return nil, errdetail.With(paperlessV1.ErrorBadRequest("documents of type %s can't be downloaded as PDF", doc.MimeType),
+			errdetail.KeyDocumentPDFUnsupported, "", "mime_type", doc.MimeType)
+	}
+
+	if doc.RenditionKey != "" {
+		content, err := s.storage.Download(ctx, doc.RenditionKey)
+		if err == nil {
+			return content, nil
+		}
+		// The cache is only a shortcut; convert again
+		s.log.Warnf("failed to download PDF rendition of document %s: %v", doc.ID, err)
+	}
+
+	rendition, err := s.convertToPDF(ctx, doc, ext)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cacheRendition(ctx, doc, rendition)
+	return rendition, nil
+}
+
+// previewKey returns the storage key of a document's PDF preview: the file itself for PDFs,
+// otherwise the cached rendition, which is created on first use.
+func (s *DocumentService) previewKey(ctx context.Context, doc *ent.Document) (string, error) {
+	mimeType := baseMimeType(doc.MimeType)
+	if mimeType == mimeTypePDF {
+		return doc.FileKey, nil
+	}
+
+	ext, ok := renditionExtensions[mimeType]
+	if !ok {
+		return "", errdetail.With(paperlessV1.ErrorBadRequest("documents of type %s can't be previewed", doc.MimeType),
+			errdetail.KeyDocumentPreviewUnsupported, "", "mime_type", doc.MimeType)
+	}
+
+	if doc.RenditionKey != "" {
+		exists, err := s.storage.Exists(ctx, doc.RenditionKey)
+		if err == nil && exists {
+			return doc.RenditionKey, nil
+		}
+		s.log.Warnf("PDF rendition %s of document %s is missing: %v", doc.RenditionKey, doc.ID, err)
+	}
+
+	rendition, err := s.convertToPDF(ctx, doc, ext)
+	if err != nil {
+		return "", err
+	}
+
+	key := s.cacheRendition(ctx, doc, rendition)
+	if key == "" {
+		return "", errdetail.With(paperlessV1.ErrorServiceUnavailable("preview is not available, try again later"),
+			errdetail.KeyDocumentPreviewUnavailable, "")
+	}
+	return key, nil
+}
+
+// convertToPDF converts a document's file with Gotenberg
+func (s *DocumentService) convertToPDF(ctx context.Context, doc *ent.Document, ext string) ([]byte, error) {
+	content, err := s.downloadFile(ctx, doc.FileKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Use an ASCII filename with correct extension — Gotenberg needs the extension to pick the converter
+	var rendition bytes.Buffer
+	_, err = s.processor.gotenberg.ConvertToPDF(ctx, bytes.NewReader(content), "document"+ext, &rendition)
+	if err != nil {
+		s.log.Errorf("PDF conversion of document %s failed: %v", doc.ID, err)
+		return nil, errdetail.With(paperlessV1.ErrorServiceUnavailable("PDF conversion is not available, try again later"),
+			errdetail.KeyDocumentPDFUnavailable, "")
+	}
+	return rendition.Bytes(), nil
+}
+
+// cacheRendition stores a PDF rendition for later downloads and returns its key. Failures are
+// logged and return an empty key; the next download converts again.
+func (s *DocumentService) cacheRendition(ctx context.Context, doc *ent.Document, rendition []byte) string {
+	var categoryID string
+	if doc.CategoryID != nil {
+		categoryID = *doc.CategoryID
+	}
+
+	uploadResult, err := s.storage.Upload(ctx, derefTenantID(doc.TenantID), categoryID, doc.ID, renditionFileName(doc.FileName), rendition, mimeTypePDF)
+	if err != nil {
+		s.log.Warnf("failed to store PDF rendition of document %s: %v", doc.ID, err)
+		return ""
+	}
+
+	// The file may have been replaced while it was converted
+	stored, err := s.documentRepo.SetRenditionKey(ctx, doc.ID, doc.Checksum, uploadResult.Key)
+	if err == nil && stored {
+		if doc.RenditionKey != "" && doc.RenditionKey != uploadResult.Key {
+			// Replaces a rendition that could no longer be read
+			if err := s.storage.Delete(ctx, doc.RenditionKey); err != nil {
+				s.log.Warnf("failed to delete old PDF rendition %s: %v", doc.RenditionKey, err)
+			}
+		}
+		return uploadResult.Key
+	}
+	if err := s.storage.Delete(ctx, uploadResult.Key); err != nil {
+		s.log.Warnf("failed to delete unused PDF rendition %s: %v", uploadResult.Key, err)
+	}
+	return ""
+}
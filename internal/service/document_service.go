@@ -2,16 +2,29 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"mime"
 	"net/http"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/go-tangra/go-tangra-paperless/internal/authz"
 	"github.com/go-tangra/go-tangra-paperless/internal/data"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/auditevent"
+	"github.com/go-tangra/go-tangra-paperless/internal/errdetail"
+	"github.com/go-tangra/go-tangra-paperless/internal/metrics"
 	appViewer "github.com/go-tangra/go-tangra-common/viewer"
 
 	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
@@ -23,53 +36,126 @@ type DocumentService struct {
 	log          *log.Helper
 	documentRepo *data.DocumentRepo
 	categoryRepo *data.CategoryRepo
+	quotaRepo    *data.TenantQuotaRepo
+	settings     *data.TenantSettingsRepo
 	permRepo     *data.PermissionRepo
-	storage      *data.StorageClient
+	auditRepo    *data.AuditEventRepo
+	history      *data.DocumentHistoryRepo
+	events       *data.EventPublisher
+	tx           *data.Transaction
+	storage      data.Storage
 	processor    *DocumentProcessor
+	tiering      *StorageTiering
+	notifier     *NotificationService
 	checker      *authz.Checker
+	ids          *data.IDGenerator
+	watchers     *documentWatchHub
+	statuses     *documentStatusMachine
 }
 
 func NewDocumentService(
 	ctx *bootstrap.Context,
 	documentRepo *data.DocumentRepo,
 	categoryRepo *data.CategoryRepo,
+	quotaRepo *data.TenantQuotaRepo,
+	settings *data.TenantSettingsRepo,
 	permRepo *data.PermissionRepo,
-	storage *data.StorageClient,
+	auditRepo *data.AuditEventRepo,
+	history *data.DocumentHistoryRepo,
+	events *data.EventPublisher,
+	tx *data.Transaction,
+	storage data.Storage,
 	processor *DocumentProcessor,
+	tiering *StorageTiering,
+	notifier *NotificationService,
 	checker *authz.Checker,
+	ids *data.IDGenerator,
 ) *DocumentService {
-	return &DocumentService{
-		log:          ctx.NewLoggerHelper("paperless/service/document"),
+	l := ctx.NewLoggerHelper("paperless/service/document")
+	s := &DocumentService{
+		log:          l,
 		documentRepo: documentRepo,
 		categoryRepo: categoryRepo,
+		quotaRepo:    quotaRepo,
+		settings:     settings,
 		permRepo:     permRepo,
+		auditRepo:    auditRepo,
+		history:      history,
+		events:       events,
+		tx:           tx,
 		storage:      storage,
 		processor:    processor,
+		tiering:      tiering,
+		notifier:     notifier,
 		checker:      checker,
+		ids:          ids,
+		watchers:     newDocumentWatchHub(),
+		statuses:     loadDocumentStatusMachine(l),
 	}
+	events.Listen(s.watchers.broadcast)
+
+	// Archived files go to cold storage; reactivated and restored ones come back
+	s.statuses.onTransition("", paperlessV1.DocumentStatus_DOCUMENT_STATUS_ARCHIVED.String(), func(_ context.Context, _, after *ent.Document) {
+		s.tiering.ArchiveAsync(after)
+	})
+	s.statuses.onTransition("", paperlessV1.DocumentStatus_DOCUMENT_STATUS_ACTIVE.String(), func(_ context.Context, _, after *ent.Document) {
+		s.tiering.RestoreAsync(after)
+	})
+
+	return s
 }
 
 // CreateDocument creates a new document with file upload
 func (s *DocumentService) CreateDocument(ctx context.Context, req *paperlessV1.CreateDocumentRequest) (*paperlessV1.CreateDocumentResponse, error) {
 	tenantID := getTenantIDFromContext(ctx)
-	createdBy := getUserIDAsUint32(ctx)
 	userID := getUserIDFromContext(ctx)
 
 	// Check write permission on target category
 	if req.CategoryId != nil && *req.CategoryId != "" {
 		if err := s.checker.CanWriteCategory(ctx, tenantID, userID, *req.CategoryId); err != nil {
-			return nil, paperlessV1.ErrorAccessDenied("no write access to category")
+			return nil, errNoAccess("no write access to category", "write", "category", "category_id")
 		}
 	}
 
+	document, err := s.createDocument(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	proto, err := s.documentRepo.ToProtoWithCategoryPath(ctx, document)
+	if err != nil {
+		return nil, err
+	}
+
+	return &paperlessV1.CreateDocumentResponse{
+		Document: proto,
+	}, nil
+}
+
+// createDocument stores the file and creates a document owned by the calling user, without
+// checking access to the category
+func (s *DocumentService) createDocument(ctx context.Context, req *paperlessV1.CreateDocumentRequest) (*ent.Document, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	createdBy := getUserIDAsUint32(ctx)
+	userID := getUserIDFromContext(ctx)
+
 	// Detect MIME type if not provided
 	mimeType := req.MimeType
 	if mimeType == "" {
 		mimeType = http.DetectContentType(req.FileContent)
 	}
 
+	settings, err := s.settings.For(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if !settings.AllowsMimeType(mimeType) {
+		return nil, errdetail.With(paperlessV1.ErrorInvalidFileType("files of type %s are not accepted", mimeType),
+			errdetail.KeyDocumentFileTypeNotAccepted, "file_content", "mime_type", mimeType)
+	}
+
 	// Generate document ID first for storage path
-	documentID := generateUUID()
+	documentID := s.ids.New()
 
 	// Get category ID for storage path
 	var categoryID string
@@ -77,50 +163,72 @@ func (s *DocumentService) CreateDocument(ctx context.Context, req *paperlessV1.C
 		categoryID = *req.CategoryId
 	}
 
-	// Upload to storage
-	uploadResult, err := s.storage.Upload(ctx, tenantID, categoryID, documentID, req.FileName, req.FileContent, mimeType)
-	if err != nil {
-		s.log.Errorf("failed to upload file: %v", err)
-		return nil, paperlessV1.ErrorStorageOperationError("failed to upload file")
-	}
-
 	// Determine source
 	source := "DOCUMENT_SOURCE_UPLOAD"
 	if req.Source != paperlessV1.DocumentSource_DOCUMENT_SOURCE_UNSPECIFIED {
 		source = req.Source.String()
 	}
 
-	// Create document record
-	document, err := s.documentRepo.Create(ctx, tenantID, req.CategoryId, req.Name, req.Description,
-		uploadResult.Key, req.FileName, uploadResult.Size, mimeType, uploadResult.Checksum,
-		req.Tags, source, createdBy)
+	// Upload to storage. The object exists before the row referencing it, so it is deleted
+	// again if the document can't be created.
+	var undo compensations
+	uploadResult, err := s.storage.Upload(ctx, tenantID, categoryID, documentID, req.FileName, req.FileContent, mimeType)
 	if err != nil {
-		// Cleanup uploaded file on failure
-		if delErr := s.storage.Delete(ctx, uploadResult.Key); delErr != nil {
-			s.log.Warnf("failed to clean up uploaded file %s after document creation failure: %v", uploadResult.Key, delErr)
+		metrics.Uploads.WithLabelValues(source, metrics.ResultError).Inc()
+		s.log.Errorf("failed to upload file: %v", err)
+		return nil, storageError(err, "failed to upload file")
+	}
+	undo.add("delete uploaded file "+uploadResult.Key, func(ctx context.Context) error {
+		return s.storage.Delete(ctx, uploadResult.Key)
+	})
+
+	// Create the document record, its owner tuple and its created event together, so a
+	// document never exists without an owner
+	var document *ent.Document
+	err = s.tx.InTx(ctx, func(ctx context.Context) error {
+		// The tenant's quota stays locked until the commit, so concurrent uploads can't
+		// together go over it, and a failed upload doesn't count towards the month
+		if err := s.quotaRepo.Reserve(ctx, tenantID, uploadResult.Size); err != nil {
+			return err
 		}
-		return nil, err
-	}
-
-	// Grant owner permission to creator
-	if createdBy != nil {
-		_, err = s.permRepo.Create(ctx, tenantID, "RESOURCE_TYPE_DOCUMENT", document.ID, "RELATION_OWNER", "SUBJECT_TYPE_USER", userID, createdBy, nil)
+		if categoryID != "" {
+			if err := s.categoryRepo.CheckQuota(ctx, categoryID, nil, uploadResult.Size); err != nil {
+				return err
+			}
+		}
+		var err error
+		document, err = s.documentRepo.Create(ctx, documentID, tenantID, req.CategoryId, req.Name, req.Description,
+			uploadResult.Key, req.FileName, uploadResult.Size, mimeType, uploadResult.Checksum,
+			req.Tags, source, createdBy)
 		if err != nil {
-			s.log.Warnf("failed to grant owner permission: %v", err)
+			return err
 		}
-	}
-
-	// Trigger async document processing for text extraction
-	go s.processor.ProcessDocument(appViewer.NewSystemViewerContext(context.Background()), document.ID, req.FileContent, mimeType)
-
-	proto, err := s.documentRepo.ToProtoWithCategoryPath(ctx, document)
+		if createdBy != nil {
+			if _, err := s.permRepo.Create(ctx, tenantID, "RESOURCE_TYPE_DOCUMENT", document.ID, "RELATION_OWNER", "SUBJECT_TYPE_USER", userID, createdBy, nil, nil); err != nil {
+				s.log.Errorf("failed to grant owner permission on document %s: %v", document.ID, err)
+				return err
+			}
+		}
+		return s.events.Publish(ctx, tenantID, document.ID, EventDocumentCreated, newDocumentEvent(ctx, document))
+	})
 	if err != nil {
+		undo.run(ctx, s.log)
+		metrics.Uploads.WithLabelValues(source, metrics.ResultError).Inc()
 		return nil, err
 	}
+	metrics.Uploads.WithLabelValues(source, metrics.ResultSuccess).Inc()
+	metrics.UploadBytes.Observe(float64(uploadResult.Size))
 
-	return &paperlessV1.CreateDocumentResponse{
-		Document: proto,
-	}, nil
+	recordAudit(ctx, s.auditRepo, auditevent.ActionAUDIT_ACTION_CREATE, auditevent.ResourceTypeRESOURCE_TYPE_DOCUMENT, document.ID, document.Name, map[string]string{
+		"category_id": categoryID,
+		"file_name":   req.FileName,
+	})
+
+	// Queue async document processing for text extraction, traced as part of this request
+	processCtx := trace.ContextWithSpanContext(appViewer.NewSystemViewerContext(context.Background()), trace.SpanContextFromContext(ctx))
+	s.processor.Enqueue(processCtx, tenantID, document.ID, document.FileKey, mimeType)
+
+	return document, nil
 }
 
 // GetDocument gets a document by ID
@@ -130,7 +238,7 @@ func (s *DocumentService) GetDocument(ctx context.Context, req *paperlessV1.GetD
 
 	// Check read permission
 	if err := s.checker.CanReadDocument(ctx, tenantID, userID, req.Id); err != nil {
-		return nil, paperlessV1.ErrorAccessDenied("no read access to document")
+		return nil, errNoAccess("no read access to document", "read", "document", "id")
 	}
 
 	document, err := s.documentRepo.GetByID(ctx, req.Id)
@@ -138,13 +246,17 @@ func (s *DocumentService) GetDocument(ctx context.Context, req *paperlessV1.GetD
 		return nil, err
 	}
 	if document == nil {
-		return nil, paperlessV1.ErrorDocumentNotFound("document not found")
+		return nil, errDocumentNotFound("id")
 	}
 
 	proto, err := s.documentRepo.ToProtoWithCategoryPath(ctx, document)
 	if err != nil {
 		return nil, err
 	}
+	// Only a single document carries its extracted text and metadata
+	if err := s.documentRepo.LoadContent(ctx, proto); err != nil {
+		return nil, err
+	}
 
 	return &paperlessV1.GetDocumentResponse{
 		Document: proto,
@@ -159,7 +271,7 @@ func (s *DocumentService) ListDocuments(ctx context.Context, req *paperlessV1.Li
 	// Check read permission on category if filtering by category
 	if req.CategoryId != nil && *req.CategoryId != "" {
 		if err := s.checker.CanReadCategory(ctx, tenantID, userID, *req.CategoryId); err != nil {
-			return nil, paperlessV1.ErrorAccessDenied("no read access to category")
+			return nil, errNoAccess("no read access to category", "read", "category", "category_id")
 		}
 	}
 
@@ -178,22 +290,24 @@ func (s *DocumentService) ListDocuments(ctx context.Context, req *paperlessV1.Li
 		status = &s
 	}
 
-	documents, total, err := s.documentRepo.List(ctx, tenantID, req.CategoryId, status, req.NameFilter, req.MimeTypeFilter, req.IncludeSubcategories, page, pageSize)
+	if req.ConsistentRead {
+		ctx = data.WithPrimaryRead(ctx)
+	}
+
+	// Restrict the query to readable documents, so pages are full and the total is accurate
+	readableIDs, err := listReadableIDs(ctx, s.checker, tenantID, userID, authz.ResourceTypeDocument)
 	if err != nil {
 		return nil, err
 	}
 
-	// Filter results by read permission
-	protoDocuments := make([]*paperlessV1.Document, 0, len(documents))
-	for _, doc := range documents {
-		if err := s.checker.CanReadDocument(ctx, tenantID, userID, doc.ID); err != nil {
-			continue
-		}
-		proto, err := s.documentRepo.ToProtoWithCategoryPath(ctx, doc)
-		if err != nil {
-			return nil, err
-		}
-		protoDocuments = append(protoDocuments, proto)
+	documents, total, err := s.documentRepo.List(ctx, tenantID, readableIDs, req.CategoryId, status, req.NameFilter, req.MimeTypeFilter, req.IncludeSubcategories, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	protoDocuments, err := s.documentRepo.ToProtosWithCategoryPath(ctx, documents)
+	if err != nil {
+		return nil, err
 	}
 
 	return &paperlessV1.ListDocumentsResponse{
@@ -210,7 +324,7 @@ func (s *DocumentService) UpdateDocument(ctx context.Context, req *paperlessV1.U
 
 	// Check write permission
 	if err := s.checker.CanWriteDocument(ctx, tenantID, userID, req.Id); err != nil {
-		return nil, paperlessV1.ErrorAccessDenied("no write access to document")
+		return nil, errNoAccess("no write access to document", "write", "document", "id")
 	}
 
 	var status *string
@@ -219,11 +333,49 @@ func (s *DocumentService) UpdateDocument(ctx context.Context, req *paperlessV1.U
 		status = &s
 	}
 
-	document, err := s.documentRepo.Update(ctx, req.Id, req.Name, req.Description, status, req.Tags, req.UpdateTags, updatedBy)
+	var dueDate *time.Time
+	if req.DueDate != nil {
+		t := req.DueDate.AsTime()
+		dueDate = &t
+	}
+
+	var before, document *ent.Document
+	err := s.tx.InTx(ctx, func(ctx context.Context) error {
+		// A deleted document can be restored, so look it up in the trash too
+		var err error
+		before, err = s.documentRepo.GetByID(data.WithDeleted(ctx), req.Id)
+		if err != nil {
+			return err
+		}
+		if before == nil {
+			return errDocumentNotFound("id")
+		}
+		if status != nil {
+			if err := s.statuses.check(ctx, s.checker, tenantID, userID, before, *status); err != nil {
+				return err
+			}
+		}
+		// Resending the due date must not repeat its reminder
+		if dueDate != nil && before.DueDate != nil && before.DueDate.Equal(*dueDate) {
+			dueDate = nil
+		}
+		document, err = s.documentRepo.Update(ctx, req.Id, req.Name, req.Description, status, req.Tags, req.UpdateTags, req.DocumentType, req.RetentionClass, dueDate, req.ClearDueDate, updatedBy, req.ExpectedVersion)
+		if err != nil {
+			return err
+		}
+		if err := s.history.Record(ctx, userID, before, document); err != nil {
+			return err
+		}
+		return s.events.Publish(ctx, tenantID, document.ID, EventDocumentUpdated, newDocumentEvent(ctx, document))
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	s.statuses.fire(ctx, before, document)
+
+	recordAudit(ctx, s.auditRepo, auditevent.ActionAUDIT_ACTION_UPDATE, auditevent.ResourceTypeRESOURCE_TYPE_DOCUMENT, document.ID, document.Name, updatedDocumentFields(req))
+
 	proto, err := s.documentRepo.ToProtoWithCategoryPath(ctx, document)
 	if err != nil {
 		return nil, err
@@ -241,28 +393,69 @@ func (s *DocumentService) DeleteDocument(ctx context.Context, req *paperlessV1.D
 
 	// Check delete permission
 	if err := s.checker.CanDeleteDocument(ctx, tenantID, userID, req.Id); err != nil {
-		return nil, paperlessV1.ErrorAccessDenied("no delete access to document")
+		return nil, errNoAccess("no delete access to document", "delete", "document", "id")
 	}
 
-	// Get document to retrieve file key
-	document, err := s.documentRepo.GetByID(ctx, req.Id)
+	// Get document to retrieve file key; a permanent delete may purge a document already in the trash
+	lookupCtx := ctx
+	if req.Permanent {
+		lookupCtx = data.WithDeleted(ctx)
+	}
+	document, err := s.documentRepo.GetByID(lookupCtx, req.Id)
 	if err != nil {
 		return nil, err
 	}
 	if document == nil {
-		return nil, paperlessV1.ErrorDocumentNotFound("document not found")
+		return nil, errDocumentNotFound("id")
+	}
+	// The key of archived text is read before the delete removes the document's content
+	var textKey string
+	if req.Permanent {
+		if textKey, err = s.documentRepo.GetContentTextKey(ctx, req.Id); err != nil {
+			return nil, err
+		}
+	} else if err := s.statuses.check(ctx, s.checker, tenantID, userID, document, paperlessV1.DocumentStatus_DOCUMENT_STATUS_DELETED.String()); err != nil {
+		// Moving a document to the trash is a status change like any other
+		return nil, err
 	}
 
-	// Delete document record
-	if err := s.documentRepo.Delete(ctx, req.Id, req.Permanent); err != nil {
+	// Delete document record together with its deleted event
+	err = s.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := s.documentRepo.Delete(ctx, req.Id, req.Permanent); err != nil {
+			return err
+		}
+		if req.Permanent {
+			if err := s.history.DeleteByDocument(ctx, req.Id); err != nil {
+				return err
+			}
+		}
+		deleted := newDocumentEvent(ctx, document)
+		deleted.Permanent = req.Permanent
+		return s.events.Publish(ctx, tenantID, document.ID, EventDocumentDeleted, deleted)
+	})
+	if err != nil {
 		return nil, err
 	}
 
+	if !req.Permanent {
+		s.statuses.fire(ctx, document, trashed(document))
+	}
+
 	// If permanent delete, also delete from storage
 	if req.Permanent {
 		if err := s.storage.Delete(ctx, document.FileKey); err != nil {
 			s.log.Warnf("failed to delete file from storage: %v", err)
 		}
+		if document.RenditionKey != "" {
+			if err := s.storage.Delete(ctx, document.RenditionKey); err != nil {
+				s.log.Warnf("failed to delete PDF rendition from storage: %v", err)
+			}
+		}
+		if textKey != "" {
+			if err := s.storage.Delete(ctx, textKey); err != nil {
+				s.log.Warnf("failed to delete archived content text from storage: %v", err)
+			}
+		}
 	}
 
 	// Delete associated permissions
@@ -270,6 +463,10 @@ func (s *DocumentService) DeleteDocument(ctx context.Context, req *paperlessV1.D
 		s.log.Warnf("failed to delete permissions for document %s: %v", req.Id, err)
 	}
 
+	recordAudit(ctx, s.auditRepo, auditevent.ActionAUDIT_ACTION_DELETE, auditevent.ResourceTypeRESOURCE_TYPE_DOCUMENT, document.ID, document.Name, map[string]string{
+		"permanent": strconv.FormatBool(req.Permanent),
+	})
+
 	return &emptypb.Empty{}, nil
 }
 
@@ -280,21 +477,54 @@ func (s *DocumentService) MoveDocument(ctx context.Context, req *paperlessV1.Mov
 
 	// Check write permission on the document
 	if err := s.checker.CanWriteDocument(ctx, tenantID, userID, req.Id); err != nil {
-		return nil, paperlessV1.ErrorAccessDenied("no write access to document")
+		return nil, errNoAccess("no write access to document", "write", "document", "id")
 	}
 
 	// Check write permission on the target category
 	if req.NewCategoryId != nil && *req.NewCategoryId != "" {
 		if err := s.checker.CanWriteCategory(ctx, tenantID, userID, *req.NewCategoryId); err != nil {
-			return nil, paperlessV1.ErrorAccessDenied("no write access to destination category")
+			return nil, errNoAccess("no write access to destination category", "write", "category", "new_category_id")
 		}
 	}
 
-	document, err := s.documentRepo.Move(ctx, req.Id, req.NewCategoryId)
+	oldCategoryID, err := s.documentRepo.GetDocumentCategoryID(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	moveDetails := map[string]string{"to_category_id": req.GetNewCategoryId()}
+	if oldCategoryID != nil {
+		moveDetails["from_category_id"] = *oldCategoryID
+	}
+
+	var document *ent.Document
+	err = s.tx.InTx(ctx, func(ctx context.Context) error {
+		before, err := s.documentRepo.GetByID(ctx, req.Id)
+		if err != nil {
+			return err
+		}
+		if before != nil && req.GetNewCategoryId() != "" {
+			if err := s.categoryRepo.CheckQuota(ctx, req.GetNewCategoryId(), before.CategoryID, before.FileSize); err != nil {
+				return err
+			}
+		}
+		document, err = s.documentRepo.Move(ctx, req.Id, req.NewCategoryId, req.ExpectedVersion)
+		if err != nil {
+			return err
+		}
+		if err := s.history.Record(ctx, userID, before, document); err != nil {
+			return err
+		}
+		moved := newDocumentEvent(ctx, document)
+		moved.PreviousCategoryID = moveDetails["from_category_id"]
+		return s.events.Publish(ctx, tenantID, document.ID, EventDocumentMoved, moved)
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	recordAudit(ctx, s.auditRepo, auditevent.ActionAUDIT_ACTION_MOVE, auditevent.ResourceTypeRESOURCE_TYPE_DOCUMENT, document.ID, document.Name, moveDetails)
+
 	proto, err := s.documentRepo.ToProtoWithCategoryPath(ctx, document)
 	if err != nil {
 		return nil, err
@@ -312,7 +542,7 @@ func (s *DocumentService) DownloadDocument(ctx context.Context, req *paperlessV1
 
 	// Check read permission (download implies read)
 	if err := s.checker.CanReadDocument(ctx, tenantID, userID, req.Id); err != nil {
-		return nil, paperlessV1.ErrorAccessDenied("no read access to document")
+		return nil, errNoAccess("no read access to document", "read", "document", "id")
 	}
 
 	document, err := s.documentRepo.GetByID(ctx, req.Id)
@@ -320,16 +550,39 @@ func (s *DocumentService) DownloadDocument(ctx context.Context, req *paperlessV1
 		return nil, err
 	}
 	if document == nil {
-		return nil, paperlessV1.ErrorDocumentNotFound("document not found")
+		return nil, errDocumentNotFound("id")
+	}
+	if string(document.ProcessingStatus) == statusInfected {
+		return nil, errQuarantined()
 	}
 
-	// Download from storage
-	content, err := s.storage.Download(ctx, document.FileKey)
+	if req.GetFormat() == paperlessV1.DownloadFormat_DOWNLOAD_FORMAT_PDF {
+		content, err := s.pdfRendition(ctx, document)
+		if err != nil {
+			return nil, err
+		}
+
+		s.markAccessed(ctx, document)
+		recordAudit(ctx, s.auditRepo, auditevent.ActionAUDIT_ACTION_DOWNLOAD, auditevent.ResourceTypeRESOURCE_TYPE_DOCUMENT, document.ID, document.Name, map[string]string{
+			"format": "pdf",
+		})
+
+		return &paperlessV1.DownloadDocumentResponse{
+			Content:  content,
+			FileName: renditionFileName(document.FileName),
+			MimeType: mimeTypePDF,
+			FileSize: int64(len(content)),
+		}, nil
+	}
+
+	content, err := s.downloadFile(ctx, document.FileKey)
 	if err != nil {
-		s.log.Errorf("failed to download file: %v", err)
-		return nil, paperlessV1.ErrorStorageOperationError("failed to download file")
+		return nil, err
 	}
 
+	s.markAccessed(ctx, document)
+	recordAudit(ctx, s.auditRepo, auditevent.ActionAUDIT_ACTION_DOWNLOAD, auditevent.ResourceTypeRESOURCE_TYPE_DOCUMENT, document.ID, document.Name, nil)
+
 	return &paperlessV1.DownloadDocumentResponse{
 		Content:  content,
 		FileName: document.FileName,
@@ -338,6 +591,19 @@ func (s *DocumentService) DownloadDocument(ctx context.Context, req *paperlessV1
 	}, nil
 }
 
+// downloadFile reads a file from storage (cold files are read from the cold tier)
+func (s *DocumentService) downloadFile(ctx context.Context, key string) ([]byte, error) {
+	content, err := s.storage.Download(ctx, key)
+	if err != nil {
+		if errors.Is(err, data.ErrObjectRestoring) {
+			return nil, errDocumentRestoring()
+		}
+		s.log.Errorf("failed to download file: %v", err)
+		return nil, storageError(err, "failed to download file")
+	}
+	return content, nil
+}
+
 // GetDocumentDownloadUrl generates a presigned download URL
 func (s *DocumentService) GetDocumentDownloadUrl(ctx context.Context, req *paperlessV1.GetDocumentDownloadUrlRequest) (*paperlessV1.GetDocumentDownloadUrlResponse, error) {
 	tenantID := getTenantIDFromContext(ctx)
@@ -345,7 +611,7 @@ func (s *DocumentService) GetDocumentDownloadUrl(ctx context.Context, req *paper
 
 	// Check read permission
 	if err := s.checker.CanReadDocument(ctx, tenantID, userID, req.Id); err != nil {
-		return nil, paperlessV1.ErrorAccessDenied("no read access to document")
+		return nil, errNoAccess("no read access to document", "read", "document", "id")
 	}
 
 	document, err := s.documentRepo.GetByID(ctx, req.Id)
@@ -353,7 +619,10 @@ func (s *DocumentService) GetDocumentDownloadUrl(ctx context.Context, req *paper
 		return nil, err
 	}
 	if document == nil {
-		return nil, paperlessV1.ErrorDocumentNotFound("document not found")
+		return nil, errDocumentNotFound("id")
+	}
+	if string(document.ProcessingStatus) == statusInfected {
+		return nil, errQuarantined()
 	}
 
 	// Default expiration: 1 hour
@@ -362,13 +631,30 @@ func (s *DocumentService) GetDocumentDownloadUrl(ctx context.Context, req *paper
 		expiresIn = time.Duration(*req.ExpiresIn) * time.Second
 	}
 
-	url, err := s.storage.GetPresignedURL(ctx, document.FileKey, expiresIn)
+	fileName := document.FileName
+	if req.GetFileName() != "" {
+		fileName = req.GetFileName()
+	}
+
+	url, err := s.storage.GetPresignedURL(ctx, document.FileKey, expiresIn, data.PresignOptions{
+		ContentDisposition: contentDisposition(req.GetDisposition(), fileName),
+		ContentType:        document.MimeType,
+	})
 	if err != nil {
+		if errors.Is(err, data.ErrPresignNotSupported) {
+			return nil, errdetail.With(paperlessV1.ErrorStorageOperationError("download URLs are not available for encrypted storage, use DownloadDocument"),
+				errdetail.KeyDocumentURLUnavailable, "")
+		}
 		s.log.Errorf("failed to generate presigned URL: %v", err)
-		return nil, paperlessV1.ErrorStorageOperationError("failed to generate download URL")
+		return nil, storageError(err, "failed to generate download URL")
 	}
 
+	s.markAccessed(ctx, document)
+
 	expiresAt := time.Now().Add(expiresIn)
+	recordAudit(ctx, s.auditRepo, auditevent.ActionAUDIT_ACTION_DOWNLOAD, auditevent.ResourceTypeRESOURCE_TYPE_DOCUMENT, document.ID, document.Name, map[string]string{
+		"download_url_expires_at": expiresAt.UTC().Format(time.RFC3339),
+	})
 
 	return &paperlessV1.GetDocumentDownloadUrlResponse{
 		Url:       url,
@@ -376,6 +662,65 @@ func (s *DocumentService) GetDocumentDownloadUrl(ctx context.Context, req *paper
 	}, nil
 }
 
+// GetDocumentPreviewUrl returns a presigned URL of a document's PDF preview
+func (s *DocumentService) GetDocumentPreviewUrl(ctx context.Context, req *paperlessV1.GetDocumentPreviewUrlRequest) (*paperlessV1.GetDocumentPreviewUrlResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	// Check read permission
+	if err := s.checker.CanReadDocument(ctx, tenantID, userID, req.Id); err != nil {
+		return nil, errNoAccess("no read access to document", "read", "document", "id")
+	}
+
+	document, err := s.documentRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if document == nil {
+		return nil, errDocumentNotFound("id")
+	}
+	if string(document.ProcessingStatus) == statusInfected {
+		return nil, errQuarantined()
+	}
+
+	// Default expiration: 1 hour
+	expiresIn := time.Hour
+	if req.ExpiresIn != nil && *req.ExpiresIn > 0 {
+		expiresIn = time.Duration(*req.ExpiresIn) * time.Second
+	}
+
+	key, err := s.previewKey(ctx, document)
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := s.storage.GetPresignedURL(ctx, key, expiresIn, data.PresignOptions{
+		ContentDisposition: contentDisposition(paperlessV1.ContentDisposition_CONTENT_DISPOSITION_INLINE, renditionFileName(document.FileName)),
+		ContentType:        mimeTypePDF,
+	})
+	if err != nil {
+		if errors.Is(err, data.ErrPresignNotSupported) {
+			return nil, errdetail.With(paperlessV1.ErrorStorageOperationError("preview URLs are not available for encrypted storage, use DownloadDocument"),
+				errdetail.KeyDocumentURLUnavailable, "")
+		}
+		s.log.Errorf("failed to generate presigned URL: %v", err)
+		return nil, storageError(err, "failed to generate preview URL")
+	}
+
+	s.markAccessed(ctx, document)
+
+	expiresAt := time.Now().Add(expiresIn)
+	recordAudit(ctx, s.auditRepo, auditevent.ActionAUDIT_ACTION_DOWNLOAD, auditevent.ResourceTypeRESOURCE_TYPE_DOCUMENT, document.ID, document.Name, map[string]string{
+		"format":                 "pdf",
+		"preview_url_expires_at": expiresAt.UTC().Format(time.RFC3339),
+	})
+
+	return &paperlessV1.GetDocumentPreviewUrlResponse{
+		Url:       url,
+		ExpiresAt: timestamppb.New(expiresAt),
+	}, nil
+}
+
 // SearchDocuments searches documents
 func (s *DocumentService) SearchDocuments(ctx context.Context, req *paperlessV1.SearchDocumentsRequest) (*paperlessV1.SearchDocumentsResponse, error) {
 	tenantID := getTenantIDFromContext(ctx)
@@ -396,22 +741,23 @@ func (s *DocumentService) SearchDocuments(ctx context.Context, req *paperlessV1.
 		status = &s
 	}
 
-	documents, total, err := s.documentRepo.Search(ctx, tenantID, req.Query, req.CategoryId, req.IncludeSubcategories, status, req.MimeTypeFilter, req.Tags, page, pageSize)
+	if req.ConsistentRead {
+		ctx = data.WithPrimaryRead(ctx)
+	}
+
+	readableIDs, err := listReadableIDs(ctx, s.checker, tenantID, userID, authz.ResourceTypeDocument)
 	if err != nil {
 		return nil, err
 	}
 
-	// Filter results by read permission
-	protoDocuments := make([]*paperlessV1.Document, 0, len(documents))
-	for _, doc := range documents {
-		if err := s.checker.CanReadDocument(ctx, tenantID, userID, doc.ID); err != nil {
-			continue
-		}
-		proto, err := s.documentRepo.ToProtoWithCategoryPath(ctx, doc)
-		if err != nil {
-			return nil, err
-		}
-		protoDocuments = append(protoDocuments, proto)
+	documents, total, err := s.documentRepo.Search(ctx, tenantID, readableIDs, req.Query, req.CategoryId, req.IncludeSubcategories, status, req.MimeTypeFilter, req.Tags, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	protoDocuments, err := s.documentRepo.ToProtosWithCategoryPath(ctx, documents)
+	if err != nil {
+		return nil, err
 	}
 
 	return &paperlessV1.SearchDocumentsResponse{
@@ -420,6 +766,10 @@ func (s *DocumentService) SearchDocuments(ctx context.Context, req *paperlessV1.
 	}, nil
 }
 
+// batchDeleteConcurrency bounds the documents whose files and permissions a batch delete
+// removes at the same time
+const batchDeleteConcurrency = 16
+
 // BatchDeleteDocuments batch deletes documents
 func (s *DocumentService) BatchDeleteDocuments(ctx context.Context, req *paperlessV1.BatchDeleteDocumentsRequest) (*paperlessV1.BatchDeleteDocumentsResponse, error) {
 	tenantID := getTenantIDFromContext(ctx)
@@ -441,20 +791,66 @@ func (s *DocumentService) BatchDeleteDocuments(ctx context.Context, req *paperle
 		}, nil
 	}
 
-	// For permanent deletes, get file keys first
-	var fileKeys []string
+	// For permanent deletes, get file keys first, including those of documents already in the trash
+	fileKeys := make(map[string][]string)
 	if req.Permanent {
 		for _, id := range allowedIDs {
-			doc, err := s.documentRepo.GetByID(ctx, id)
+			doc, err := s.documentRepo.GetByID(data.WithDeleted(ctx), id)
 			if err == nil && doc != nil {
-				fileKeys = append(fileKeys, doc.FileKey)
+				fileKeys[id] = append(fileKeys[id], doc.FileKey)
+				if doc.RenditionKey != "" {
+					fileKeys[id] = append(fileKeys[id], doc.RenditionKey)
+				}
+				if textKey, err := s.documentRepo.GetContentTextKey(ctx, id); err == nil && textKey != "" {
+					fileKeys[id] = append(fileKeys[id], textKey)
+				}
 			}
 		}
 	}
 
-	deletedCount, failedIDs, err := s.documentRepo.BatchDelete(ctx, allowedIDs, req.Permanent)
-	if err != nil {
-		return nil, err
+	// Each document is deleted together with its deleted event
+	deletedCount := 0
+	failedIDs := make([]string, 0)
+	for _, id := range allowedIDs {
+		var before *ent.Document
+		err := s.tx.InTx(ctx, func(ctx context.Context) error {
+			// Moving a document to the trash is a status change like any other
+			if !req.Permanent {
+				var err error
+				if before, err = s.documentRepo.GetByID(ctx, id); err != nil {
+					return err
+				}
+				if before == nil {
+					return errDocumentNotFound("ids")
+				}
+				if err := s.statuses.check(ctx, s.checker, tenantID, userID, before, paperlessV1.DocumentStatus_DOCUMENT_STATUS_DELETED.String()); err != nil {
+					return err
+				}
+			}
+			if err := s.documentRepo.Delete(ctx, id, req.Permanent); err != nil {
+				return err
+			}
+			if req.Permanent {
+				if err := s.history.DeleteByDocument(ctx, id); err != nil {
+					return err
+				}
+			}
+			return s.events.Publish(ctx, tenantID, id, EventDocumentDeleted, &DocumentEvent{
+				TenantID:   tenantID,
+				DocumentID: id,
+				Permanent:  req.Permanent,
+				UserID:     userID,
+				OccurredAt: time.Now(),
+			})
+		})
+		if err != nil {
+			failedIDs = append(failedIDs, id)
+			continue
+		}
+		deletedCount++
+		if before != nil {
+			s.statuses.fire(ctx, before, trashed(before))
+		}
 	}
 
 	// Add unauthorized IDs to failed list
@@ -471,38 +867,155 @@ func (s *DocumentService) BatchDeleteDocuments(ctx context.Context, req *paperle
 		}
 	}
 
-	// Delete files from storage for permanent deletes
-	if req.Permanent {
-		for _, key := range fileKeys {
-			if err := s.storage.Delete(ctx, key); err != nil {
-				s.log.Warnf("failed to delete file from storage: %v", err)
-			}
+	deletedIDs := make([]string, 0, deletedCount)
+	for _, id := range allowedIDs {
+		if !slices.Contains(failedIDs, id) {
+			deletedIDs = append(deletedIDs, id)
 		}
 	}
 
-	// Delete permissions for successfully deleted documents
-	for _, id := range allowedIDs {
-		found := false
-		for _, failedID := range failedIDs {
-			if id == failedID {
-				found = true
-				break
+	// Delete the files of permanently deleted documents and the permissions of all deleted ones
+	cleanupFailedIDs := s.cleanupDeletedDocuments(ctx, tenantID, deletedIDs, fileKeys)
+
+	for _, id := range deletedIDs {
+		recordAudit(ctx, s.auditRepo, auditevent.ActionAUDIT_ACTION_DELETE, auditevent.ResourceTypeRESOURCE_TYPE_DOCUMENT, id, "", map[string]string{
+			"permanent": strconv.FormatBool(req.Permanent),
+			"batch":     "true",
+		})
+	}
+
+	return &paperlessV1.BatchDeleteDocumentsResponse{
+		DeletedCount:     uint32(deletedCount),
+		FailedIds:        failedIDs,
+		CleanupFailedIds: cleanupFailedIDs,
+	}, nil
+}
+
+// cleanupDeletedDocuments deletes the stored files and the permissions of deleted documents,
+// up to batchDeleteConcurrency documents at a time. The failures are logged together; the
+// IDs of the documents whose cleanup failed are returned.
+func (s *DocumentService) cleanupDeletedDocuments(ctx context.Context, tenantID uint32, ids []string, fileKeys map[string][]string) []string {
+	errs := make([]error, len(ids))
+	var g errgroup.Group
+	g.SetLimit(batchDeleteConcurrency)
+	for i, id := range ids {
+		g.Go(func() error {
+			var docErrs []error
+			for _, key := range fileKeys[id] {
+				if err := s.storage.Delete(ctx, key); err != nil {
+					docErrs = append(docErrs, fmt.Errorf("delete file %s: %w", key, err))
+				}
 			}
-		}
-		if !found {
 			if err := s.permRepo.DeleteByResource(ctx, tenantID, "RESOURCE_TYPE_DOCUMENT", id); err != nil {
-				s.log.Warnf("failed to delete permissions for document %s: %v", id, err)
+				docErrs = append(docErrs, fmt.Errorf("delete permissions: %w", err))
 			}
+			if len(docErrs) > 0 {
+				errs[i] = fmt.Errorf("document %s: %w", id, errors.Join(docErrs...))
+			}
+			// Every document is cleaned up, whatever happens to the others
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	failedIDs := make([]string, 0)
+	var failures []error
+	for i, err := range errs {
+		if err != nil {
+			failedIDs = append(failedIDs, ids[i])
+			failures = append(failures, err)
 		}
 	}
+	if len(failures) > 0 {
+		s.log.Warnf("cleanup failed for %d of %d deleted documents: %v", len(failures), len(ids), errors.Join(failures...))
+	}
+	return failedIDs
+}
 
-	return &paperlessV1.BatchDeleteDocumentsResponse{
-		DeletedCount: uint32(deletedCount),
-		FailedIds:    failedIDs,
-	}, nil
+// updatedDocumentFields lists the fields an update request changes for the audit log
+func updatedDocumentFields(req *paperlessV1.UpdateDocumentRequest) map[string]string {
+	details := make(map[string]string)
+	if req.Name != nil {
+		details["name"] = req.GetName()
+	}
+	if req.Description != nil {
+		details["description_changed"] = "true"
+	}
+	if req.Status != nil {
+		details["status"] = req.GetStatus().String()
+	}
+	if req.UpdateTags {
+		details["tags_changed"] = "true"
+	}
+	if req.DocumentType != nil {
+		details["document_type"] = req.GetDocumentType()
+	}
+	if req.RetentionClass != nil {
+		details["retention_class"] = req.GetRetentionClass()
+	}
+	if req.ClearDueDate {
+		details["due_date"] = ""
+	} else if req.DueDate != nil {
+		details["due_date"] = req.DueDate.AsTime().Format(time.RFC3339)
+	}
+	return details
+}
+
+// markAccessed records a download and brings idle cold documents back to hot storage.
+// Archived documents stay cold and are served from there.
+func (s *DocumentService) markAccessed(ctx context.Context, document *ent.Document) {
+	if err := s.documentRepo.MarkAccessed(ctx, document.ID); err != nil {
+		s.log.Warnf("failed to record access to document %s: %v", document.ID, err)
+	}
+
+	if string(document.Status) != paperlessV1.DocumentStatus_DOCUMENT_STATUS_ARCHIVED.String() {
+		s.tiering.RestoreAsync(document)
+	}
 }
 
-// generateUUID generates a new UUID
-func generateUUID() string {
-	return "00000000-0000-0000-0000-000000000000" // Placeholder - will use github.com/google/uuid in actual implementation
+// contentDisposition builds a Content-Disposition header value offering fileName.
+// Non-ASCII names are encoded per RFC 2231 so browsers keep them intact.
+func contentDisposition(disposition paperlessV1.ContentDisposition, fileName string) string {
+	dispositionType := "attachment"
+	if disposition == paperlessV1.ContentDisposition_CONTENT_DISPOSITION_INLINE {
+		dispositionType = "inline"
+	}
+
+	// Never let the name carry a path or control characters
+	fileName = strings.Map(func(r rune) rune {
+		if r == '/' || r == '\\' || unicode.IsControl(r) {
+			return '_'
+		}
+		return r
+	}, fileName)
+
+	if value := mime.FormatMediaType(dispositionType, map[string]string{"filename": fileName}); value != "" {
+		return value
+	}
+	return dispositionType
+}
+
+// storageError maps a storage failure to an API error, telling outages apart from other failures
+func storageError(err error, msg string) error {
+	if errors.Is(err, data.ErrStorageUnavailable) {
+		return errdetail.With(paperlessV1.ErrorStorageUnavailable("storage is temporarily unavailable, try again later"),
+			errdetail.KeyStorageUnavailable, "")
+	}
+	return errdetail.With(paperlessV1.ErrorStorageOperationError("%s", msg), errdetail.KeyStorageFailed, "")
+}
+
+// listReadableIDs returns the IDs of the resources of a type the user can read, to filter list
+// queries before pagination. It returns nil when the user can read every resource.
+func listReadableIDs(ctx context.Context, checker *authz.Checker, tenantID uint32, userID string, resourceType authz.ResourceType) ([]string, error) {
+	ids, all, err := checker.ListReadable(ctx, tenantID, userID, resourceType)
+	if err != nil {
+		return nil, err
+	}
+	if all {
+		return nil, nil
+	}
+	if ids == nil {
+		ids = []string{}
+	}
+	return ids, nil
 }
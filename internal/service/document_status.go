@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/authz"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/document"
+	"github.com/go-tangra/go-tangra-paperless/internal/errdetail"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// statusTransition is a change of a document's status
+type statusTransition struct {
+	from string
+	to   string
+}
+
+// statusHook runs after a matching status change is committed; an empty from or to matches
+// any status
+type statusHook struct {
+	statusTransition
+	run func(ctx context.Context, before, after *ent.Document)
+}
+
+// documentStatusMachine decides which status changes UpdateDocument allows, which permission
+// each of them requires, and what happens after one
+type documentStatusMachine struct {
+	transitions map[statusTransition]authz.Permission
+	hooks       []statusHook
+}
+
+// defaultStatusTransitions lets documents be archived, reactivated, trashed and restored
+var defaultStatusTransitions = map[statusTransition]authz.Permission{
+	{from: "DOCUMENT_STATUS_ACTIVE", to: "DOCUMENT_STATUS_ARCHIVED"}:  authz.PermissionWrite,
+	{from: "DOCUMENT_STATUS_ARCHIVED", to: "DOCUMENT_STATUS_ACTIVE"}:  authz.PermissionWrite,
+	{from: "DOCUMENT_STATUS_ACTIVE", to: "DOCUMENT_STATUS_DELETED"}:   authz.PermissionDelete,
+	{from: "DOCUMENT_STATUS_ARCHIVED", to: "DOCUMENT_STATUS_DELETED"}: authz.PermissionDelete,
+	{from: "DOCUMENT_STATUS_DELETED", to: "DOCUMENT_STATUS_ACTIVE"}:   authz.PermissionWrite,
+}
+
+// loadDocumentStatusMachine reads PAPERLESS_DOCUMENT_STATUS_TRANSITIONS, which replaces the
+// default transitions when set
+func loadDocumentStatusMachine(l *log.Helper) *documentStatusMachine {
+	m := &documentStatusMachine{transitions: defaultStatusTransitions}
+
+	if v := os.Getenv("PAPERLESS_DOCUMENT_STATUS_TRANSITIONS"); v != "" {
+		transitions, err := parseStatusTransitions(v)
+		if err != nil {
+			l.Warnf("invalid PAPERLESS_DOCUMENT_STATUS_TRANSITIONS: %v, the default transitions are used", err)
+		} else {
+			m.transitions = transitions
+		}
+	}
+
+	return m
+}
+
+// parseStatusTransitions parses a comma-separated list of from:to:permission transitions, e.g.
+// "DOCUMENT_STATUS_ACTIVE:DOCUMENT_STATUS_ARCHIVED:PERMISSION_WRITE"
+func parseStatusTransitions(v string) (map[statusTransition]authz.Permission, error) {
+	transitions := make(map[statusTransition]authz.Permission)
+	for _, entry := range strings.Split(v, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.SplitN(entry, ":", 3)
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("%q is not from:to:permission", entry)
+		}
+		for _, status := range parts[:2] {
+			if s, ok := paperlessV1.DocumentStatus_value[status]; !ok || s == int32(paperlessV1.DocumentStatus_DOCUMENT_STATUS_UNSPECIFIED) {
+				return nil, fmt.Errorf("unknown document status %q", status)
+			}
+		}
+		if parts[0] == parts[1] {
+			return nil, fmt.Errorf("%q does not change the status", entry)
+		}
+		if p, ok := paperlessV1.Permission_value[parts[2]]; !ok || p == int32(paperlessV1.Permission_PERMISSION_UNSPECIFIED) {
+			return nil, fmt.Errorf("unknown permission %q", parts[2])
+		}
+
+		transitions[statusTransition{from: parts[0], to: parts[1]}] = authz.Permission(parts[2])
+	}
+	if len(transitions) == 0 {
+		return nil, fmt.Errorf("no transitions")
+	}
+	return transitions, nil
+}
+
+// onTransition registers a hook for status changes from one status to another; an empty from
+// or to matches any status
+func (m *documentStatusMachine) onTransition(from, to string, run func(ctx context.Context, before, after *ent.Document)) {
+	m.hooks = append(m.hooks, statusHook{statusTransition: statusTransition{from: from, to: to}, run: run})
+}
+
+// check fails with INVALID_STATUS_TRANSITION if the document's status may not change to the
+// given one, and with ACCESS_DENIED if the caller lacks the permission the change requires.
+// Keeping the current status is always allowed.
+func (m *documentStatusMachine) check(ctx context.Context, checker *authz.Checker, tenantID uint32, userID string, doc *ent.Document, to string) error {
+	from := string(doc.Status)
+	if from == to {
+		return nil
+	}
+
+	permission, ok := m.transitions[statusTransition{from: from, to: to}]
+	if !ok {
+		return errdetail.With(paperlessV1.ErrorInvalidStatusTransition("document status can't change from %s to %s", from, to),
+			errdetail.KeyDocumentStatusTransition, "status", "from", from, "to", to)
+	}
+	if err := checker.RequirePermission(ctx, tenantID, userID, authz.ResourceTypeDocument, doc.ID, permission); err != nil {
+		return errdetail.With(paperlessV1.ErrorAccessDenied("changing the document status to %s requires %s", to, permission),
+			errdetail.KeyDocumentStatusPermission, "status", "status", to, "permission", permission)
+	}
+	return nil
+}
+
+// fire runs the hooks matching a committed status change
+func (m *documentStatusMachine) fire(ctx context.Context, before, after *ent.Document) {
+	from, to := string(before.Status), string(after.Status)
+	if from == to {
+		return
+	}
+	for _, h := range m.hooks {
+		if (h.from == "" || h.from == from) && (h.to == "" || h.to == to) {
+			h.run(ctx, before, after)
+		}
+	}
+}
+
+// trashed returns a copy of doc as a soft delete leaves it, to fire the hooks of the change
+func trashed(doc *ent.Document) *ent.Document {
+	after := *doc
+	after.Status = document.StatusDOCUMENT_STATUS_DELETED
+	return &after
+}
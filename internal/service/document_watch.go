@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/errdetail"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// documentWatchBuffer is how many changes a watcher may fall behind before its stream is ended
+const documentWatchBuffer = 256
+
+var documentChangeTypes = map[string]paperlessV1.DocumentChangeType{
+	EventDocumentCreated:   paperlessV1.DocumentChangeType_DOCUMENT_CHANGE_TYPE_CREATED,
+	EventDocumentUpdated:   paperlessV1.DocumentChangeType_DOCUMENT_CHANGE_TYPE_UPDATED,
+	EventDocumentMoved:     paperlessV1.DocumentChangeType_DOCUMENT_CHANGE_TYPE_MOVED,
+	EventDocumentProcessed: paperlessV1.DocumentChangeType_DOCUMENT_CHANGE_TYPE_PROCESSED,
+	EventDocumentDeleted:   paperlessV1.DocumentChangeType_DOCUMENT_CHANGE_TYPE_DELETED,
+	// The signed PDF replaced the document's file
+	EventDocumentSigned: paperlessV1.DocumentChangeType_DOCUMENT_CHANGE_TYPE_UPDATED,
+}
+
+// documentWatchHub hands the document events committed on this replica to the
+// WatchDocuments streams of the same tenant
+type documentWatchHub struct {
+	mu       sync.Mutex
+	watchers map[*documentWatcher]struct{}
+}
+
+// documentWatcher is the queue of one WatchDocuments stream
+type documentWatcher struct {
+	tenantID uint32
+	events   chan *data.CloudEvent
+
+	// overflow is closed when the stream fell too far behind
+	overflow     chan struct{}
+	overflowOnce sync.Once
+}
+
+func newDocumentWatchHub() *documentWatchHub {
+	return &documentWatchHub{watchers: make(map[*documentWatcher]struct{})}
+}
+
+func (h *documentWatchHub) add(tenantID uint32) *documentWatcher {
+	w := &documentWatcher{
+		tenantID: tenantID,
+		events:   make(chan *data.CloudEvent, documentWatchBuffer),
+		overflow: make(chan struct{}),
+	}
+	h.mu.Lock()
+	h.watchers[w] = struct{}{}
+	h.mu.Unlock()
+	return w
+}
+
+func (h *documentWatchHub) remove(w *documentWatcher) {
+	h.mu.Lock()
+	delete(h.watchers, w)
+	h.mu.Unlock()
+}
+
+// broadcast implements data.EventListener without blocking the publishing request
+func (h *documentWatchHub) broadcast(event *data.CloudEvent) {
+	if _, ok := documentChangeTypes[event.Type]; !ok {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for w := range h.watchers {
+		if w.tenantID != event.TenantID {
+			continue
+		}
+		select {
+		case w.events <- event:
+		default:
+			w.overflowOnce.Do(func() { close(w.overflow) })
+		}
+	}
+}
+
+// WatchDocuments streams changes to the documents the caller can read, optionally limited to
+// a category and to documents with given tags. Changes are those committed on the replica
+// serving the stream. A client that falls too far behind gets an error and should reload
+// its documents before watching again.
+func (s *DocumentService) WatchDocuments(req *paperlessV1.WatchDocumentsRequest, stream grpc.ServerStreamingServer[paperlessV1.DocumentChange]) error {
+	ctx := stream.Context()
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	var watched *ent.Category
+	if req.GetCategoryId() != "" {
+		if err := s.checker.CanReadCategory(ctx, tenantID, userID, req.GetCategoryId()); err != nil {
+			return errNoAccess("no read access to category", "read", "category", "category_id")
+		}
+		var err error
+		watched, err = s.categoryRepo.GetByID(ctx, req.GetCategoryId())
+		if err != nil {
+			return err
+		}
+		if watched == nil {
+			return errCategoryNotFound("category not found", "category_id")
+		}
+	}
+
+	w := s.watchers.add(tenantID)
+	defer s.watchers.remove(w)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-w.overflow:
+			return errdetail.With(paperlessV1.ErrorServiceUnavailable("too many pending document changes, reload the documents and watch again"),
+				errdetail.KeyDocumentWatchOverflow, "")
+		case event := <-w.events:
+			change, err := s.documentChange(ctx, tenantID, userID, req, watched, event)
+			if err != nil {
+				return err
+			}
+			if change == nil {
+				continue
+			}
+			if err := stream.Send(change); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// documentChange describes an event to a watcher, or returns nil when the watcher may not
+// see the document or filtered it out
+func (s *DocumentService) documentChange(ctx context.Context, tenantID uint32, userID string, req *paperlessV1.WatchDocumentsRequest, watched *ent.Category, cloudEvent *data.CloudEvent) (*paperlessV1.DocumentChange, error) {
+	changeType := documentChangeTypes[cloudEvent.Type]
+	event, ok := cloudEvent.Data.(*DocumentEvent)
+	if !ok {
+		return nil, nil
+	}
+
+	change := &paperlessV1.DocumentChange{
+		Type:       changeType,
+		DocumentId: event.DocumentID,
+		OccurTime:  timestamppb.New(event.OccurredAt),
+	}
+	if event.PreviousCategoryID != "" {
+		change.PreviousCategoryId = &event.PreviousCategoryID
+	}
+
+	// Access to a deleted document can no longer be checked, so only its ID is sent
+	if changeType == paperlessV1.DocumentChangeType_DOCUMENT_CHANGE_TYPE_DELETED {
+		if event.CategoryID != "" && !s.inWatchedCategory(ctx, tenantID, req, watched, event.CategoryID) {
+			return nil, nil
+		}
+		return change, nil
+	}
+
+	document, err := s.documentRepo.GetByID(ctx, event.DocumentID)
+	if err != nil {
+		return nil, err
+	}
+	if document == nil || s.checker.CanReadDocument(ctx, tenantID, userID, document.ID) != nil {
+		return nil, nil
+	}
+
+	// A document moved out of the watched category is still reported, so it can be removed
+	var categoryID string
+	if document.CategoryID != nil {
+		categoryID = *document.CategoryID
+	}
+	if !s.inWatchedCategory(ctx, tenantID, req, watched, categoryID) &&
+		(event.PreviousCategoryID == "" || !s.inWatchedCategory(ctx, tenantID, req, watched, event.PreviousCategoryID)) {
+		return nil, nil
+	}
+	for k, v := range req.GetTags() {
+		if document.Tags[k] != v {
+			return nil, nil
+		}
+	}
+
+	change.Document, err = s.documentRepo.ToProtoWithCategoryPath(ctx, document)
+	if err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+// inWatchedCategory reports whether a category is the watched one or, with
+// include_subcategories, below it. Every category matches when none is watched.
+func (s *DocumentService) inWatchedCategory(ctx context.Context, tenantID uint32, req *paperlessV1.WatchDocumentsRequest, watched *ent.Category, categoryID string) bool {
+	if watched == nil {
+		return true
+	}
+	if categoryID == watched.ID {
+		return true
+	}
+	if !req.GetIncludeSubcategories() || categoryID == "" {
+		return false
+	}
+
+	c, err := s.categoryRepo.GetByID(ctx, categoryID)
+	if err != nil || c == nil || c.TenantID == nil || *c.TenantID != tenantID {
+		return false
+	}
+	return strings.HasPrefix(c.Path, watched.Path+"/")
+}
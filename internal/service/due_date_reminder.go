@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/authz"
+	"github.com/go-tangra/go-tangra-paperless/internal/data"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+)
+
+const (
+	// defaultDueReminderInterval is how often documents coming due are looked for
+	defaultDueReminderInterval = time.Hour
+	// defaultDueReminderLead is how long before its due date a document is reminded of
+	defaultDueReminderLead = 7 * 24 * time.Hour
+	// dueReminderBatchSize bounds the documents loaded at once
+	dueReminderBatchSize = 100
+)
+
+// DueDateReminder notifies the owners of active documents once their due date is near. Each
+// due date is reminded of once; changing it arms the reminder again. It runs as an app server
+// every PAPERLESS_DUE_REMINDER_INTERVAL (default 1h, "0" disables it) and only while in-app
+// notifications are enabled.
+type DueDateReminder struct {
+	backgroundJob
+
+	log           *log.Helper
+	documentRepo  *data.DocumentRepo
+	permRepo      *data.PermissionRepo
+	notifications *NotificationService
+	checker       *authz.Checker
+
+	lead time.Duration
+}
+
+// NewDueDateReminder creates a DueDateReminder configured by PAPERLESS_DUE_REMINDER_INTERVAL
+// and PAPERLESS_DUE_REMINDER_LEAD
+func NewDueDateReminder(
+	ctx *bootstrap.Context,
+	documentRepo *data.DocumentRepo,
+	permRepo *data.PermissionRepo,
+	notifications *NotificationService,
+	checker *authz.Checker,
+) *DueDateReminder {
+	l := ctx.NewLoggerHelper("paperless/service/due_date_reminder")
+
+	r := &DueDateReminder{
+		backgroundJob: backgroundJob{
+			interval: defaultDueReminderInterval,
+			log:      l,
+		},
+		log:           l,
+		documentRepo:  documentRepo,
+		permRepo:      permRepo,
+		notifications: notifications,
+		checker:       checker,
+		lead:          defaultDueReminderLead,
+	}
+
+	if v := os.Getenv("PAPERLESS_DUE_REMINDER_INTERVAL"); v != "" {
+		interval, err := time.ParseDuration(v)
+		if err != nil || interval < 0 {
+			l.Warnf("invalid PAPERLESS_DUE_REMINDER_INTERVAL %q, using %s", v, defaultDueReminderInterval)
+		} else {
+			r.interval = interval
+		}
+	}
+	if v := os.Getenv("PAPERLESS_DUE_REMINDER_LEAD"); v != "" {
+		lead, err := time.ParseDuration(v)
+		if err != nil || lead <= 0 {
+			l.Warnf("invalid PAPERLESS_DUE_REMINDER_LEAD %q, using %s", v, defaultDueReminderLead)
+		} else {
+			r.lead = lead
+		}
+	}
+
+	if r.interval > 0 && !notifications.client.Enabled() {
+		l.Info("in-app notifications disabled, due date reminders are not sent")
+		r.interval = 0
+	}
+	r.name = fmt.Sprintf("reminding of due dates %s ahead", r.lead)
+	r.tick = r.Run
+
+	return r
+}
+
+// Run reminds the owners of every document due within the lead time that wasn't reminded of
+// yet. Documents whose due date already passed are not reminded of.
+func (r *DueDateReminder) Run(ctx context.Context) {
+	for ctx.Err() == nil {
+		now := time.Now()
+		documents, err := r.documentRepo.DueForReminder(ctx, now, now.Add(r.lead), dueReminderBatchSize)
+		if err != nil {
+			r.log.Errorf("list documents due for reminder failed: %s", err.Error())
+			return
+		}
+
+		for _, doc := range documents {
+			if ctx.Err() != nil {
+				return
+			}
+			// Another replica may be reminding of the same document
+			claimed, err := r.documentRepo.ClaimReminder(ctx, doc.ID, *doc.DueDate)
+			if err != nil {
+				return
+			}
+			if !claimed {
+				continue
+			}
+			if !r.remind(ctx, doc) {
+				// Nobody was reached; try again with the next run
+				if err := r.documentRepo.ReleaseReminder(ctx, doc.ID); err != nil {
+					r.log.Warnf("failed to release reminder of document %s: %v", doc.ID, err)
+				}
+				return
+			}
+		}
+
+		if len(documents) < dueReminderBatchSize {
+			return
+		}
+	}
+}
+
+// remind notifies the owners of a document of its due date. It reports false if every
+// notification failed.
+func (r *DueDateReminder) remind(ctx context.Context, doc *ent.Document) bool {
+	var tenantID uint32
+	if doc.TenantID != nil {
+		tenantID = *doc.TenantID
+	}
+	recipients := r.recipients(ctx, tenantID, doc)
+
+	failed := 0
+	for _, userID := range recipients {
+		if err := r.notifications.remindDueDate(ctx, tenantID, userID, doc); err != nil {
+			r.log.Warnf("remind user %d of due date of document %s failed: %v", userID, doc.ID, err)
+			failed++
+		}
+	}
+	return len(recipients) == 0 || failed < len(recipients)
+}
+
+// recipients returns the creator and the users explicitly owning a document who can still read it
+func (r *DueDateReminder) recipients(ctx context.Context, tenantID uint32, doc *ent.Document) []uint32 {
+	var candidates []uint32
+	if doc.CreateBy != nil {
+		candidates = append(candidates, *doc.CreateBy)
+	}
+
+	tuples, err := r.permRepo.ListByResource(ctx, tenantID, "RESOURCE_TYPE_DOCUMENT", doc.ID)
+	if err != nil {
+		r.log.Warnf("failed to list owners of document %s: %v", doc.ID, err)
+	}
+	now := time.Now()
+	for _, t := range tuples {
+		if string(t.Relation) != "RELATION_OWNER" || string(t.SubjectType) != "SUBJECT_TYPE_USER" {
+			continue
+		}
+		if t.ExpiresAt != nil && !t.ExpiresAt.After(now) {
+			continue
+		}
+		if id, err := strconv.ParseUint(t.SubjectID, 10, 32); err == nil {
+			candidates = append(candidates, uint32(id))
+		}
+	}
+
+	slices.Sort(candidates)
+	candidates = slices.Compact(candidates)
+
+	recipients := candidates[:0]
+	for _, userID := range candidates {
+		if err := r.checker.CanReadDocument(ctx, tenantID, strconv.FormatUint(uint64(userID), 10), doc.ID); err == nil {
+			recipients = append(recipients, userID)
+		}
+	}
+	return recipients
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"github.com/go-tangra/go-tangra-paperless/internal/errdetail"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// errNoAccess reports a permission the caller lacks on a resource named by the request field.
+// msg is the English message; permission and resource are the parameters of its translation.
+func errNoAccess(msg, permission, resource, field string) error {
+	return errdetail.With(paperlessV1.ErrorAccessDenied("%s", msg), errdetail.KeyAccessDenied, field,
+		"permission", permission, "resource", resource)
+}
+
+// errDocumentNotFound reports a missing document named by the request field
+func errDocumentNotFound(field string) error {
+	return errdetail.With(paperlessV1.ErrorDocumentNotFound("document not found"), errdetail.KeyDocumentNotFound, field)
+}
+
+// errCategoryNotFound reports a missing category named by the request field
+func errCategoryNotFound(msg, field string) error {
+	return errdetail.With(paperlessV1.ErrorCategoryNotFound("%s", msg), errdetail.KeyCategoryNotFound, field)
+}
+
+// errTenantAdminRequired refuses an action only tenant admins may take
+func errTenantAdminRequired(msg, action string) error {
+	return errdetail.With(paperlessV1.ErrorAccessDenied("%s", msg), errdetail.KeyTenantAdminRequired, "",
+		"action", action)
+}
+
+// errPlatformAdminRequired refuses a platform operation to callers without a platform admin role
+func errPlatformAdminRequired(msg, action string) error {
+	return errdetail.With(paperlessV1.ErrorAccessDenied("%s", msg), errdetail.KeyPlatformAdminRequired, "",
+		"action", action)
+}
+
+// errDocumentRestoring asks the client to retry once a cold file is back in the hot tier
+func errDocumentRestoring() error {
+	return errdetail.With(paperlessV1.ErrorStorageUnavailable("document is being restored from cold storage, try again later"),
+		errdetail.KeyDocumentRestoring, "")
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+)
+
+// Lifecycle event types published through data.EventPublisher
+const (
+	EventDocumentCreated   = "document.created"
+	EventDocumentProcessed = "document.processed"
+	EventDocumentUpdated   = "document.updated"
+	EventDocumentMoved     = "document.moved"
+	EventDocumentDeleted   = "document.deleted"
+	EventDocumentSigned    = "document.signed"
+	EventPermissionGranted = "permission.granted"
+	EventPermissionRevoked = "permission.revoked"
+)
+
+// lifecycleEventTypes lists every event type, e.g. to validate webhook filters
+var lifecycleEventTypes = []string{
+	EventDocumentCreated,
+	EventDocumentProcessed,
+	EventDocumentUpdated,
+	EventDocumentMoved,
+	EventDocumentDeleted,
+	EventDocumentSigned,
+	EventPermissionGranted,
+	EventPermissionRevoked,
+}
+
+// DocumentEvent is the payload of document events
+type DocumentEvent struct {
+	TenantID           uint32            `json:"tenant_id"`
+	DocumentID         string            `json:"document_id"`
+	Name               string            `json:"name,omitempty"`
+	CategoryID         string            `json:"category_id,omitempty"`
+	PreviousCategoryID string            `json:"previous_category_id,omitempty"`
+	FileName           string            `json:"file_name,omitempty"`
+	FileSize           int64             `json:"file_size,omitempty"`
+	MimeType           string            `json:"mime_type,omitempty"`
+	Checksum           string            `json:"checksum,omitempty"`
+	Status             string            `json:"status,omitempty"`
+	ProcessingStatus   string            `json:"processing_status,omitempty"`
+	Tags               map[string]string `json:"tags,omitempty"`
+	Permanent          bool              `json:"permanent,omitempty"`
+	SignatureRequestID string            `json:"signature_request_id,omitempty"`
+	UserID             string            `json:"user_id,omitempty"`
+	OccurredAt         time.Time         `json:"occurred_at"`
+}
+
+// PermissionEvent is the payload of permission events
+type PermissionEvent struct {
+	TenantID     uint32    `json:"tenant_id"`
+	ResourceType string    `json:"resource_type"`
+	ResourceID   string    `json:"resource_id"`
+	Relation     string    `json:"relation,omitempty"`
+	SubjectType  string    `json:"subject_type"`
+	SubjectID    string    `json:"subject_id"`
+	UserID       string    `json:"user_id,omitempty"`
+	OccurredAt   time.Time `json:"occurred_at"`
+}
+
+// newDocumentEvent describes a document as changed by the calling user
+func newDocumentEvent(ctx context.Context, doc *ent.Document) *DocumentEvent {
+	event := &DocumentEvent{
+		DocumentID:       doc.ID,
+		Name:             doc.Name,
+		FileName:         doc.FileName,
+		FileSize:         doc.FileSize,
+		MimeType:         doc.MimeType,
+		Checksum:         doc.Checksum,
+		Status:           string(doc.Status),
+		ProcessingStatus: string(doc.ProcessingStatus),
+		Tags:             doc.Tags,
+		UserID:           getUserIDFromContext(ctx),
+		OccurredAt:       time.Now(),
+	}
+	if doc.TenantID != nil {
+		event.TenantID = *doc.TenantID
+	}
+	if doc.CategoryID != nil {
+		event.CategoryID = *doc.CategoryID
+	}
+	return event
+}
@@ -0,0 +1,521 @@
+package service
+
+import (
+	"context"
+	_ "embed"
+	"strconv"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/errdetail"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+const (
+	defaultGraphQLPageSize = 20
+	maxGraphQLPageSize     = 100
+
+	// maxGraphQLDepth bounds how deeply queries nest, e.g. category children of children
+	maxGraphQLDepth = 10
+)
+
+//go:embed graphql_schema.graphql
+var graphqlSchema string
+
+// GraphQLService resolves GraphQL queries over documents, categories, tags, permissions and
+// reviews, so a client can fetch a document with everything around it in one request. Every
+// field is resolved through the gRPC services, which check the caller's access as they do
+// for RPCs.
+type GraphQLService struct {
+	log    *log.Helper
+	schema *graphql.Schema
+}
+
+// NewGraphQLService creates a new GraphQLService
+func NewGraphQLService(
+	ctx *bootstrap.Context,
+	documentSvc *DocumentService,
+	categorySvc *CategoryService,
+	tagSvc *TagService,
+	permissionSvc *PermissionService,
+	reviewSvc *ReviewService,
+) (*GraphQLService, error) {
+	root := &graphqlResolver{
+		documents:   documentSvc,
+		categories:  categorySvc,
+		tags:        tagSvc,
+		permissions: permissionSvc,
+		reviews:     reviewSvc,
+	}
+
+	schema, err := graphql.ParseSchema(graphqlSchema, root,
+		graphql.UseStringDescriptions(),
+		graphql.MaxDepth(maxGraphQLDepth),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GraphQLService{
+		log:    ctx.NewLoggerHelper("paperless/service/graphql"),
+		schema: schema,
+	}, nil
+}
+
+// Exec runs a query. Errors of resolvers carry the message of the service error, with its
+// reason, status and structured details as the code, status and metadata extensions; other
+// failures are not disclosed.
+func (s *GraphQLService) Exec(ctx context.Context, query, operationName string, variables map[string]interface{}) *graphql.Response {
+	resp := s.schema.Exec(ctx, query, operationName, variables)
+	for _, qe := range resp.Errors {
+		if qe.ResolverError == nil {
+			continue
+		}
+		se := kerrors.FromError(errdetail.Ensure(qe.ResolverError))
+		if se.Reason == kerrors.UnknownReason {
+			s.log.Errorf("graphql resolver failed: %v", qe.ResolverError)
+			qe.Message = "internal error"
+			qe.Extensions = map[string]interface{}{"code": paperlessV1.PaperlessErrorReason_INTERNAL_SERVER_ERROR.String(), "status": 500}
+			continue
+		}
+		qe.Message = se.Message
+		qe.Extensions = map[string]interface{}{"code": se.Reason, "status": se.Code, "metadata": se.Metadata}
+	}
+	return resp
+}
+
+// graphqlResolver resolves the Query type
+type graphqlResolver struct {
+	documents   *DocumentService
+	categories  *CategoryService
+	tags        *TagService
+	permissions *PermissionService
+	reviews     *ReviewService
+}
+
+type pageArgs struct {
+	Page     *int32
+	PageSize *int32
+}
+
+// page returns the requested page, bounded to maxGraphQLPageSize items
+func (a pageArgs) page() (*uint32, *uint32) {
+	page, pageSize := uint32(1), uint32(defaultGraphQLPageSize)
+	if a.Page != nil && *a.Page > 0 {
+		page = uint32(*a.Page)
+	}
+	if a.PageSize != nil && *a.PageSize > 0 {
+		pageSize = min(uint32(*a.PageSize), maxGraphQLPageSize)
+	}
+	return &page, &pageSize
+}
+
+func (r *graphqlResolver) Document(ctx context.Context, args struct{ ID graphql.ID }) (*documentResolver, error) {
+	resp, err := r.documents.GetDocument(ctx, &paperlessV1.GetDocumentRequest{Id: string(args.ID)})
+	if err != nil {
+		if paperlessV1.IsDocumentNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &documentResolver{root: r, doc: resp.Document}, nil
+}
+
+func (r *graphqlResolver) Documents(ctx context.Context, args struct {
+	CategoryID           *graphql.ID
+	IncludeSubcategories *bool
+	pageArgs
+}) (*documentPageResolver, error) {
+	req := &paperlessV1.ListDocumentsRequest{}
+	if args.CategoryID != nil {
+		req.CategoryId = graphqlString(string(*args.CategoryID))
+	}
+	if args.IncludeSubcategories != nil {
+		req.IncludeSubcategories = *args.IncludeSubcategories
+	}
+	req.Page, req.PageSize = args.page()
+	return r.listDocuments(ctx, req)
+}
+
+func (r *graphqlResolver) listDocuments(ctx context.Context, req *paperlessV1.ListDocumentsRequest) (*documentPageResolver, error) {
+	resp, err := r.documents.ListDocuments(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*documentResolver, 0, len(resp.Documents))
+	for _, doc := range resp.Documents {
+		items = append(items, &documentResolver{root: r, doc: doc})
+	}
+	return &documentPageResolver{items: items, total: int32(resp.Total)}, nil
+}
+
+func (r *graphqlResolver) Category(ctx context.Context, args struct{ ID graphql.ID }) (*categoryResolver, error) {
+	resp, err := r.categories.GetCategory(ctx, &paperlessV1.GetCategoryRequest{Id: string(args.ID), IncludeCounts: true})
+	if err != nil {
+		if paperlessV1.IsCategoryNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &categoryResolver{root: r, category: resp.Category}, nil
+}
+
+func (r *graphqlResolver) Categories(ctx context.Context, args struct {
+	ParentID   *graphql.ID
+	NameFilter *string
+	pageArgs
+}) (*categoryPageResolver, error) {
+	req := &paperlessV1.ListCategoriesRequest{NameFilter: args.NameFilter}
+	if args.ParentID != nil {
+		req.ParentId = graphqlString(string(*args.ParentID))
+	}
+	req.Page, req.PageSize = args.page()
+	return r.listCategories(ctx, req)
+}
+
+func (r *graphqlResolver) listCategories(ctx context.Context, req *paperlessV1.ListCategoriesRequest) (*categoryPageResolver, error) {
+	resp, err := r.categories.ListCategories(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*categoryResolver, 0, len(resp.Categories))
+	for _, category := range resp.Categories {
+		items = append(items, &categoryResolver{root: r, category: category})
+	}
+	return &categoryPageResolver{items: items, total: int32(resp.Total)}, nil
+}
+
+func (r *graphqlResolver) Tags(ctx context.Context, args struct {
+	Query *string
+	pageArgs
+}) (*tagPageResolver, error) {
+	req := &paperlessV1.ListTagsRequest{Query: args.Query}
+	req.Page, req.PageSize = args.page()
+
+	resp, err := r.tags.ListTags(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*tagResolver, 0, len(resp.Tags))
+	for _, tag := range resp.Tags {
+		items = append(items, &tagResolver{tag: tag})
+	}
+	return &tagPageResolver{items: items, total: int32(resp.Total)}, nil
+}
+
+// documentResolver resolves the Document type
+type documentResolver struct {
+	root *graphqlResolver
+	doc  *paperlessV1.Document
+}
+
+func (d *documentResolver) ID() graphql.ID           { return graphql.ID(d.doc.Id) }
+func (d *documentResolver) Name() string             { return d.doc.Name }
+func (d *documentResolver) Description() string      { return d.doc.Description }
+func (d *documentResolver) FileName() string         { return d.doc.FileName }
+func (d *documentResolver) FileSize() float64        { return float64(d.doc.FileSize) }
+func (d *documentResolver) MimeType() string         { return d.doc.MimeType }
+func (d *documentResolver) Checksum() string         { return d.doc.Checksum }
+func (d *documentResolver) Status() string           { return d.doc.Status.String() }
+func (d *documentResolver) ProcessingStatus() string { return d.doc.ProcessingStatus }
+func (d *documentResolver) DocumentType() string     { return d.doc.DocumentType }
+func (d *documentResolver) RetentionClass() string   { return d.doc.RetentionClass }
+func (d *documentResolver) Version() int32           { return int32(d.doc.Version) }
+func (d *documentResolver) CreateTime() *string      { return graphqlTime(d.doc.CreateTime) }
+func (d *documentResolver) UpdateTime() *string      { return graphqlTime(d.doc.UpdateTime) }
+func (d *documentResolver) DueDate() *string         { return graphqlTime(d.doc.DueDate) }
+func (d *documentResolver) AssigneeID() *int32       { return graphqlInt(d.doc.AssigneeId) }
+
+func (d *documentResolver) Category(ctx context.Context) (*categoryResolver, error) {
+	if d.doc.GetCategoryId() == "" {
+		return nil, nil
+	}
+	resp, err := d.root.categories.GetCategory(ctx, &paperlessV1.GetCategoryRequest{Id: d.doc.GetCategoryId(), IncludeCounts: true})
+	if err != nil {
+		// A document can be shared without its category
+		if paperlessV1.IsAccessDenied(err) || paperlessV1.IsCategoryNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &categoryResolver{root: d.root, category: resp.Category}, nil
+}
+
+func (d *documentResolver) CategoryPath(ctx context.Context) ([]*pathSegmentResolver, error) {
+	return d.root.categoryPath(ctx, &paperlessV1.GetCategoryPathRequest{DocumentId: graphqlString(d.doc.Id)})
+}
+
+func (d *documentResolver) Tags(ctx context.Context) ([]*documentTagResolver, error) {
+	if len(d.doc.Tags) == 0 {
+		return []*documentTagResolver{}, nil
+	}
+
+	names := make([]string, 0, len(d.doc.Tags))
+	for name := range d.doc.Tags {
+		names = append(names, name)
+	}
+	tags, err := d.root.tags.TagsByName(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string]*paperlessV1.Tag, len(tags))
+	for _, tag := range tags {
+		byName[tag.Name] = tag
+	}
+
+	// Tagged names come in the tag order, by name, followed by names without a tag
+	result := make([]*documentTagResolver, 0, len(d.doc.Tags))
+	for _, tag := range tags {
+		result = append(result, &documentTagResolver{name: tag.Name, value: d.doc.Tags[tag.Name], tag: tag})
+	}
+	for _, name := range names {
+		if byName[name] == nil {
+			result = append(result, &documentTagResolver{name: name, value: d.doc.Tags[name]})
+		}
+	}
+	return result, nil
+}
+
+func (d *documentResolver) Permissions(ctx context.Context) ([]*permissionResolver, error) {
+	resourceType := paperlessV1.ResourceType_RESOURCE_TYPE_DOCUMENT
+	// A page size of 0 lists every permission of the document
+	var page, pageSize uint32 = 1, 0
+	resp, err := d.root.permissions.ListPermissions(ctx, &paperlessV1.ListPermissionsRequest{
+		ResourceType: &resourceType,
+		ResourceId:   graphqlString(d.doc.Id),
+		Page:         &page,
+		PageSize:     &pageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*permissionResolver, 0, len(resp.Permissions))
+	for _, perm := range resp.Permissions {
+		result = append(result, &permissionResolver{perm: perm})
+	}
+	return result, nil
+}
+
+func (d *documentResolver) MyPermissions(ctx context.Context) ([]string, error) {
+	resp, err := d.root.permissions.GetEffectivePermissions(ctx, &paperlessV1.GetEffectivePermissionsRequest{
+		UserId:       getUserIDFromContext(ctx),
+		ResourceType: paperlessV1.ResourceType_RESOURCE_TYPE_DOCUMENT,
+		ResourceId:   d.doc.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]string, 0, len(resp.Permissions))
+	for _, perm := range resp.Permissions {
+		result = append(result, perm.String())
+	}
+	return result, nil
+}
+
+func (d *documentResolver) Reviews(ctx context.Context) ([]*reviewResolver, error) {
+	var pageSize uint32 = maxGraphQLPageSize
+	resp, err := d.root.reviews.ListDocumentReviews(ctx, &paperlessV1.ListDocumentReviewsRequest{
+		DocumentId: d.doc.Id,
+		PageSize:   &pageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*reviewResolver, 0, len(resp.Reviews))
+	for _, review := range resp.Reviews {
+		result = append(result, &reviewResolver{review: review})
+	}
+	return result, nil
+}
+
+// categoryPath resolves the path segments of a category or a document's category
+func (r *graphqlResolver) categoryPath(ctx context.Context, req *paperlessV1.GetCategoryPathRequest) ([]*pathSegmentResolver, error) {
+	resp, err := r.categories.GetCategoryPath(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*pathSegmentResolver, 0, len(resp.Segments))
+	for _, segment := range resp.Segments {
+		result = append(result, &pathSegmentResolver{segment: segment})
+	}
+	return result, nil
+}
+
+// categoryResolver resolves the Category type
+type categoryResolver struct {
+	root     *graphqlResolver
+	category *paperlessV1.Category
+}
+
+func (c *categoryResolver) ID() graphql.ID          { return graphql.ID(c.category.Id) }
+func (c *categoryResolver) ParentID() *graphql.ID   { return graphqlID(c.category.ParentId) }
+func (c *categoryResolver) Name() string            { return c.category.Name }
+func (c *categoryResolver) Path() string            { return c.category.Path }
+func (c *categoryResolver) Description() string     { return c.category.Description }
+func (c *categoryResolver) Depth() int32            { return c.category.Depth }
+func (c *categoryResolver) DocumentCount() int32    { return c.category.DocumentCount }
+func (c *categoryResolver) SubcategoryCount() int32 { return c.category.SubcategoryCount }
+func (c *categoryResolver) Color() string           { return c.category.Color }
+func (c *categoryResolver) Icon() string            { return c.category.Icon }
+func (c *categoryResolver) CreateTime() *string     { return graphqlTime(c.category.CreateTime) }
+func (c *categoryResolver) UpdateTime() *string     { return graphqlTime(c.category.UpdateTime) }
+
+func (c *categoryResolver) PathSegments(ctx context.Context) ([]*pathSegmentResolver, error) {
+	return c.root.categoryPath(ctx, &paperlessV1.GetCategoryPathRequest{CategoryId: graphqlString(c.category.Id)})
+}
+
+func (c *categoryResolver) Children(ctx context.Context) ([]*categoryResolver, error) {
+	// A page size of 0 lists every readable subcategory
+	var page, pageSize uint32 = 1, 0
+	page2, err := c.root.listCategories(ctx, &paperlessV1.ListCategoriesRequest{
+		ParentId: graphqlString(c.category.Id),
+		Page:     &page,
+		PageSize: &pageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return page2.items, nil
+}
+
+func (c *categoryResolver) Documents(ctx context.Context, args struct {
+	IncludeSubcategories *bool
+	pageArgs
+}) (*documentPageResolver, error) {
+	req := &paperlessV1.ListDocumentsRequest{CategoryId: graphqlString(c.category.Id)}
+	if args.IncludeSubcategories != nil {
+		req.IncludeSubcategories = *args.IncludeSubcategories
+	}
+	req.Page, req.PageSize = args.page()
+	return c.root.listDocuments(ctx, req)
+}
+
+// pathSegmentResolver resolves the CategoryPathSegment type
+type pathSegmentResolver struct {
+	segment *paperlessV1.CategoryPathSegment
+}
+
+func (p *pathSegmentResolver) ID() graphql.ID { return graphql.ID(p.segment.Id) }
+func (p *pathSegmentResolver) Name() string   { return p.segment.Name }
+func (p *pathSegmentResolver) Readable() bool { return p.segment.Readable }
+
+// documentTagResolver resolves the DocumentTag type
+type documentTagResolver struct {
+	name  string
+	value string
+	tag   *paperlessV1.Tag
+}
+
+func (t *documentTagResolver) Name() string  { return t.name }
+func (t *documentTagResolver) Value() string { return t.value }
+
+func (t *documentTagResolver) Tag() *tagResolver {
+	if t.tag == nil {
+		return nil
+	}
+	return &tagResolver{tag: t.tag}
+}
+
+// tagResolver resolves the Tag type
+type tagResolver struct {
+	tag *paperlessV1.Tag
+}
+
+func (t *tagResolver) ID() graphql.ID       { return graphql.ID(strconv.FormatUint(uint64(t.tag.Id), 10)) }
+func (t *tagResolver) Name() string         { return t.tag.Name }
+func (t *tagResolver) Color() string        { return t.tag.Color }
+func (t *tagResolver) Description() string  { return t.tag.Description }
+func (t *tagResolver) DocumentCount() int32 { return int32(t.tag.DocumentCount) }
+
+// permissionResolver resolves the Permission type
+type permissionResolver struct {
+	perm *paperlessV1.PermissionTuple
+}
+
+func (p *permissionResolver) ID() graphql.ID {
+	return graphql.ID(strconv.FormatUint(uint64(p.perm.Id), 10))
+}
+func (p *permissionResolver) Relation() string    { return p.perm.Relation.String() }
+func (p *permissionResolver) SubjectType() string { return p.perm.SubjectType.String() }
+func (p *permissionResolver) SubjectID() string   { return p.perm.SubjectId }
+func (p *permissionResolver) GrantedBy() *int32   { return graphqlInt(p.perm.GrantedBy) }
+func (p *permissionResolver) ExpiresAt() *string  { return graphqlTime(p.perm.ExpiresAt) }
+func (p *permissionResolver) CreateTime() *string { return graphqlTime(p.perm.CreateTime) }
+
+// reviewResolver resolves the Review type
+type reviewResolver struct {
+	review *paperlessV1.ReviewTask
+}
+
+func (r *reviewResolver) ID() graphql.ID          { return graphql.ID(r.review.Id) }
+func (r *reviewResolver) ReviewerID() int32       { return int32(r.review.ReviewerId) }
+func (r *reviewResolver) Status() string          { return r.review.Status.String() }
+func (r *reviewResolver) Message() string         { return r.review.Message }
+func (r *reviewResolver) Comment() string         { return r.review.Comment }
+func (r *reviewResolver) ReviewedVersion() *int32 { return graphqlInt(r.review.ReviewedVersion) }
+func (r *reviewResolver) CompletedAt() *string    { return graphqlTime(r.review.CompletedAt) }
+func (r *reviewResolver) CompletedBy() *int32     { return graphqlInt(r.review.CompletedBy) }
+func (r *reviewResolver) CreateTime() *string     { return graphqlTime(r.review.CreateTime) }
+
+// Page resolvers
+type documentPageResolver struct {
+	items []*documentResolver
+	total int32
+}
+
+func (p *documentPageResolver) Items() []*documentResolver { return p.items }
+func (p *documentPageResolver) Total() int32               { return p.total }
+
+type categoryPageResolver struct {
+	items []*categoryResolver
+	total int32
+}
+
+func (p *categoryPageResolver) Items() []*categoryResolver { return p.items }
+func (p *categoryPageResolver) Total() int32               { return p.total }
+
+type tagPageResolver struct {
+	items []*tagResolver
+	total int32
+}
+
+func (p *tagPageResolver) Items() []*tagResolver { return p.items }
+func (p *tagPageResolver) Total() int32          { return p.total }
+
+// graphqlTime formats a timestamp as RFC 3339, or returns nil if it is unset
+func graphqlTime(ts *timestamppb.Timestamp) *string {
+	if ts == nil {
+		return nil
+	}
+	v := ts.AsTime().UTC().Format(time.RFC3339)
+	return &v
+}
+
+// graphqlInt converts an optional uint32 to a GraphQL Int
+func graphqlInt(v *uint32) *int32 {
+	if v == nil {
+		return nil
+	}
+	i := int32(*v)
+	return &i
+}
+
+// graphqlString returns a pointer to s, for optional request fields
+func graphqlString(s string) *string {
+	return &s
+}
+
+// graphqlID converts an optional string to a GraphQL ID
+func graphqlID(v *string) *graphql.ID {
+	if v == nil || *v == "" {
+		return nil
+	}
+	id := graphql.ID(*v)
+	return &id
+}
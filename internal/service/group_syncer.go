@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data"
+	"github.com/go-tangra/go-tangra-paperless/internal/metrics"
+)
+
+const (
+	defaultGroupSyncInterval = 15 * time.Minute
+
+	// maxGroupSubjectIDLen is the longest ID a permission subject can have
+	maxGroupSubjectIDLen = 36
+)
+
+// GroupSyncer copies group memberships from the identity directory into the local table that
+// SUBJECT_TYPE_GROUP grants are resolved against, so group grants follow people as they move
+// between groups. Only tenants with group grants are synced. It runs as an app server,
+// syncing at startup and then every PAPERLESS_GROUP_SYNC_INTERVAL. Every replica syncs; each
+// sync replaces a tenant's memberships in one transaction, so concurrent syncs are harmless.
+type GroupSyncer struct {
+	backgroundJob
+
+	log       *log.Helper
+	repo      *data.GroupRepo
+	tx        *data.Transaction
+	directory data.GroupDirectory
+}
+
+// NewGroupSyncer creates a GroupSyncer configured by PAPERLESS_GROUP_SYNC_INTERVAL. It does
+// nothing when no directory is configured.
+func NewGroupSyncer(ctx *bootstrap.Context, repo *data.GroupRepo, tx *data.Transaction, directory data.GroupDirectory) *GroupSyncer {
+	l := ctx.NewLoggerHelper("paperless/service/group-syncer")
+
+	s := &GroupSyncer{
+		backgroundJob: backgroundJob{
+			interval:  defaultGroupSyncInterval,
+			immediate: true,
+			log:       l,
+		},
+		log:       l,
+		repo:      repo,
+		tx:        tx,
+		directory: directory,
+	}
+
+	if v := os.Getenv("PAPERLESS_GROUP_SYNC_INTERVAL"); v != "" {
+		interval, err := time.ParseDuration(v)
+		if err != nil || interval <= 0 {
+			l.Warnf("invalid PAPERLESS_GROUP_SYNC_INTERVAL %q, using %s", v, defaultGroupSyncInterval)
+		} else {
+			s.interval = interval
+		}
+	}
+
+	if directory == nil {
+		s.interval = 0
+	} else {
+		s.name = fmt.Sprintf("syncing groups from %s", directory.Name())
+	}
+	s.tick = s.Sync
+
+	return s
+}
+
+// Sync replaces the memberships of every tenant with group grants with the directory's.
+// A tenant whose groups cannot be listed keeps its previous memberships.
+func (s *GroupSyncer) Sync(ctx context.Context) {
+	tenantIDs, err := s.repo.ListGroupGrantTenantIDs(ctx)
+	if err != nil {
+		return
+	}
+
+	for _, tenantID := range tenantIDs {
+		if ctx.Err() != nil {
+			return
+		}
+		err := s.syncTenant(ctx, tenantID)
+		metrics.GroupSyncs.WithLabelValues(s.directory.Name(), metrics.Result(err)).Inc()
+		if err != nil {
+			s.log.Errorf("sync groups of tenant %d failed: %v", tenantID, err)
+		}
+	}
+}
+
+func (s *GroupSyncer) syncTenant(ctx context.Context, tenantID uint32) error {
+	groups, err := s.directory.ListGroups(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	members := flattenGroups(groups)
+
+	var added, removed int
+	err = s.tx.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		added, removed, err = s.repo.ReplaceMemberships(ctx, tenantID, members)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("replace memberships: %w", err)
+	}
+
+	if added > 0 || removed > 0 {
+		s.log.Infof("synced %d groups of tenant %d: %d memberships added, %d removed", len(groups), tenantID, added, removed)
+	}
+	return nil
+}
+
+// flattenGroups lists the users of every group, including the members of nested groups.
+// Groups and users whose IDs are too long to be permission subjects are skipped.
+func flattenGroups(groups []data.DirectoryGroup) []data.GroupMember {
+	byID := make(map[string]*data.DirectoryGroup, len(groups))
+	for i := range groups {
+		byID[groups[i].ID] = &groups[i]
+	}
+
+	var members []data.GroupMember
+	for _, g := range groups {
+		if g.ID == "" || len(g.ID) > maxGroupSubjectIDLen {
+			continue
+		}
+
+		users := make(map[string]bool)
+		// Walk nested groups breadth-first; the visited set stops membership cycles
+		visited := map[string]bool{g.ID: true}
+		queue := []*data.DirectoryGroup{&g}
+		for len(queue) > 0 {
+			current := queue[0]
+			queue = queue[1:]
+
+			for _, userID := range current.UserIDs {
+				if userID != "" && len(userID) <= maxGroupSubjectIDLen {
+					users[userID] = true
+				}
+			}
+			for _, nestedID := range current.GroupIDs {
+				nested, ok := byID[nestedID]
+				if !ok || visited[nestedID] {
+					continue
+				}
+				visited[nestedID] = true
+				queue = append(queue, nested)
+			}
+		}
+
+		for userID := range users {
+			members = append(members, data.GroupMember{
+				GroupID:   g.ID,
+				GroupName: g.Name,
+				UserID:    userID,
+			})
+		}
+	}
+	return members
+}
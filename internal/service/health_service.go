@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+const (
+	defaultHealthCheckTimeout = 5 * time.Second
+
+	// healthCheckReuse is how long a result is reused, so frequent probes don't load the dependencies
+	healthCheckReuse = 5 * time.Second
+
+	// healthCheckKey is looked up to check storage access; it is never written
+	healthCheckKey = "0/.healthcheck"
+)
+
+// dependencyCheck checks a single dependency
+type dependencyCheck struct {
+	name     string
+	critical bool
+	check    func(ctx context.Context) error
+}
+
+type HealthService struct {
+	paperlessV1.UnimplementedPaperlessHealthServiceServer
+
+	log     *log.Helper
+	checks  []dependencyCheck
+	timeout time.Duration
+
+	mu   sync.Mutex
+	last *paperlessV1.CheckHealthResponse
+}
+
+// NewHealthService creates a HealthService checking the database, every storage backend,
+// Tika and Gotenberg. Each check is limited by PAPERLESS_HEALTH_CHECK_TIMEOUT.
+func NewHealthService(
+	ctx *bootstrap.Context,
+	entClient *entCrud.EntClient[*ent.Client],
+	router *data.StorageRouter,
+	tika *data.TikaClient,
+	gotenberg *data.GotenbergClient,
+) *HealthService {
+	l := ctx.NewLoggerHelper("paperless/service/health")
+
+	s := &HealthService{
+		log:     l,
+		timeout: defaultHealthCheckTimeout,
+	}
+
+	if v := os.Getenv("PAPERLESS_HEALTH_CHECK_TIMEOUT"); v != "" {
+		timeout, err := time.ParseDuration(v)
+		if err != nil || timeout <= 0 {
+			l.Warnf("invalid PAPERLESS_HEALTH_CHECK_TIMEOUT %q, using %s", v, defaultHealthCheckTimeout)
+		} else {
+			s.timeout = timeout
+		}
+	}
+
+	s.checks = append(s.checks, dependencyCheck{
+		name:     "database",
+		critical: true,
+		check: func(ctx context.Context) error {
+			return entClient.DB().PingContext(ctx)
+		},
+	})
+
+	primary, target := router.Names()
+	s.checks = append(s.checks, storageCheck(primary, router.Backend(primary), true))
+	if target != "" {
+		s.checks = append(s.checks, storageCheck(target, router.Backend(target), true))
+	}
+	if cold := router.Cold(); cold != nil {
+		// Only archived and idle documents live in the cold tier
+		s.checks = append(s.checks, storageCheck("cold", cold, false))
+	}
+
+	// Tika and Gotenberg only extract text, so uploads and downloads work without them
+	s.checks = append(s.checks,
+		dependencyCheck{name: "tika", check: tika.Ping},
+		dependencyCheck{name: "gotenberg", check: gotenberg.Ping},
+	)
+
+	return s
+}
+
+// storageCheck looks up a key that does not exist, which needs working credentials and connectivity
+func storageCheck(name string, backend data.Storage, critical bool) dependencyCheck {
+	return dependencyCheck{
+		name:     "storage." + name,
+		critical: critical,
+		check: func(ctx context.Context) error {
+			_, err := backend.Exists(ctx, healthCheckKey)
+			return err
+		},
+	}
+}
+
+// CheckHealth checks every dependency concurrently. Results are reused for a few seconds,
+// and error messages are only returned to platform admins.
+func (s *HealthService) CheckHealth(ctx context.Context, _ *paperlessV1.CheckHealthRequest) (*paperlessV1.CheckHealthResponse, error) {
+	response := s.check(ctx)
+
+	if !isPlatformAdmin(ctx) {
+		for _, dep := range response.Dependencies {
+			dep.Error = ""
+		}
+	}
+
+	return response, nil
+}
+
+// check returns a copy of the latest result, running the checks again once it is too old
+func (s *HealthService) check(ctx context.Context) *paperlessV1.CheckHealthResponse {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.last == nil || time.Since(s.last.GetCheckedAt().AsTime()) >= healthCheckReuse {
+		s.last = s.runChecks(ctx)
+	}
+	return proto.Clone(s.last).(*paperlessV1.CheckHealthResponse)
+}
+
+func (s *HealthService) runChecks(ctx context.Context) *paperlessV1.CheckHealthResponse {
+	// Checks must finish even if the probing client gives up, since the result is shared
+	ctx = context.WithoutCancel(ctx)
+
+	deps := make([]*paperlessV1.DependencyHealth, len(s.checks))
+	var wg sync.WaitGroup
+	for i, c := range s.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			deps[i] = s.runCheck(ctx, c)
+		}()
+	}
+	wg.Wait()
+
+	status := paperlessV1.HealthStatus_HEALTH_STATUS_UP
+	for _, dep := range deps {
+		if dep.Status != paperlessV1.HealthStatus_HEALTH_STATUS_DOWN {
+			continue
+		}
+		if dep.Critical {
+			status = paperlessV1.HealthStatus_HEALTH_STATUS_DOWN
+			break
+		}
+		status = paperlessV1.HealthStatus_HEALTH_STATUS_DEGRADED
+	}
+
+	return &paperlessV1.CheckHealthResponse{
+		Status:       status,
+		Dependencies: deps,
+		CheckedAt:    timestamppb.Now(),
+	}
+}
+
+func (s *HealthService) runCheck(ctx context.Context, c dependencyCheck) *paperlessV1.DependencyHealth {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := c.check(ctx)
+
+	dep := &paperlessV1.DependencyHealth{
+		Name:      c.name,
+		Status:    paperlessV1.HealthStatus_HEALTH_STATUS_UP,
+		LatencyMs: time.Since(start).Milliseconds(),
+		Critical:  c.critical,
+	}
+	if err != nil {
+		s.log.Warnf("health check of %s failed: %v", c.name, err)
+		dep.Status = paperlessV1.HealthStatus_HEALTH_STATUS_DOWN
+		dep.Error = err.Error()
+	}
+	return dep
+}
@@ -0,0 +1,448 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importconnector"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importmapping"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// remoteRootFolder is the top-level folder ID understood by both Google Drive and Microsoft Graph
+const remoteRootFolder = "root"
+
+// ImportService manages a tenant's import connectors and folder mappings; syncs are run by ImportSyncer
+type ImportService struct {
+	paperlessV1.UnimplementedPaperlessImportServiceServer
+
+	log          *log.Helper
+	repo         *data.ImportRepo
+	categoryRepo *data.CategoryRepo
+	syncer       *ImportSyncer
+}
+
+func NewImportService(
+	ctx *bootstrap.Context,
+	repo *data.ImportRepo,
+	categoryRepo *data.CategoryRepo,
+	syncer *ImportSyncer,
+) *ImportService {
+	return &ImportService{
+		log:          ctx.NewLoggerHelper("paperless/service/import"),
+		repo:         repo,
+		categoryRepo: categoryRepo,
+		syncer:       syncer,
+	}
+}
+
+// CreateImportConnector stores the credentials of a remote file service
+func (s *ImportService) CreateImportConnector(ctx context.Context, req *paperlessV1.CreateImportConnectorRequest) (*paperlessV1.CreateImportConnectorResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if req.GetName() == "" {
+		return nil, paperlessV1.ErrorBadRequest("name is required")
+	}
+	provider, err := importProvider(req.GetProvider())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := data.NewImportClient(provider, req.GetCredentials(), req.GetConfig()); err != nil {
+		return nil, paperlessV1.ErrorBadRequest("invalid connector: %s", err.Error())
+	}
+
+	enabled := true
+	if req.Enabled != nil {
+		enabled = req.GetEnabled()
+	}
+
+	entity, err := s.repo.CreateConnector(ctx, tenantID, req.GetName(), provider, req.GetCredentials(), req.GetConfig(), enabled, getUserIDAsUint32(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &paperlessV1.CreateImportConnectorResponse{
+		Connector: s.repo.ConnectorToProto(entity),
+	}, nil
+}
+
+// GetImportConnector gets an import connector
+func (s *ImportService) GetImportConnector(ctx context.Context, req *paperlessV1.GetImportConnectorRequest) (*paperlessV1.GetImportConnectorResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	entity, err := s.getConnector(ctx, tenantID, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &paperlessV1.GetImportConnectorResponse{
+		Connector: s.repo.ConnectorToProto(entity),
+	}, nil
+}
+
+// ListImportConnectors lists the tenant's import connectors
+func (s *ImportService) ListImportConnectors(ctx context.Context, req *paperlessV1.ListImportConnectorsRequest) (*paperlessV1.ListImportConnectorsResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	page, pageSize := webhookPage(req.Page, req.PageSize)
+	entities, total, err := s.repo.ListConnectors(ctx, tenantID, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	connectors := make([]*paperlessV1.ImportConnector, 0, len(entities))
+	for _, e := range entities {
+		connectors = append(connectors, s.repo.ConnectorToProto(e))
+	}
+
+	return &paperlessV1.ListImportConnectorsResponse{
+		Connectors: connectors,
+		Total:      uint32(total),
+	}, nil
+}
+
+// UpdateImportConnector updates an import connector
+func (s *ImportService) UpdateImportConnector(ctx context.Context, req *paperlessV1.UpdateImportConnectorRequest) (*paperlessV1.UpdateImportConnectorResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.getConnector(ctx, tenantID, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil && req.GetName() == "" {
+		return nil, paperlessV1.ErrorBadRequest("name must not be empty")
+	}
+
+	// Validate the connector as it will be after the update
+	credentials, config := existing.Credentials, existing.Config
+	if req.Credentials != nil {
+		credentials = req.GetCredentials()
+	}
+	if req.GetUpdateConfig() {
+		config = req.GetConfig()
+	}
+	if _, err := data.NewImportClient(existing.Provider, credentials, config); err != nil {
+		return nil, paperlessV1.ErrorBadRequest("invalid connector: %s", err.Error())
+	}
+
+	entity, err := s.repo.UpdateConnector(ctx, req.GetId(), req.Name, req.Credentials, req.GetConfig(), req.GetUpdateConfig(), req.Enabled)
+	if err != nil {
+		return nil, err
+	}
+
+	return &paperlessV1.UpdateImportConnectorResponse{
+		Connector: s.repo.ConnectorToProto(entity),
+	}, nil
+}
+
+// DeleteImportConnector deletes an import connector and its mappings
+func (s *ImportService) DeleteImportConnector(ctx context.Context, req *paperlessV1.DeleteImportConnectorRequest) (*emptypb.Empty, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getConnector(ctx, tenantID, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.DeleteConnector(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListRemoteItems lists a remote folder through a connector
+func (s *ImportService) ListRemoteItems(ctx context.Context, req *paperlessV1.ListRemoteItemsRequest) (*paperlessV1.ListRemoteItemsResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	connector, err := s.getConnector(ctx, tenantID, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	client, err := data.NewImportClient(connector.Provider, connector.Credentials, connector.Config)
+	if err != nil {
+		return nil, paperlessV1.ErrorBadRequest("invalid connector: %s", err.Error())
+	}
+
+	folderID := req.GetFolderId()
+	if folderID == "" {
+		folderID = remoteRootFolder
+	}
+
+	items, err := client.List(ctx, folderID)
+	if err != nil {
+		s.log.Warnf("list remote folder %s of connector %s failed: %v", folderID, connector.ID, err)
+		return nil, paperlessV1.ErrorBadRequest("failed to list remote folder: %s", err.Error())
+	}
+
+	protoItems := make([]*paperlessV1.RemoteItem, 0, len(items))
+	for _, item := range items {
+		protoItems = append(protoItems, s.repo.RemoteItemToProto(item))
+	}
+
+	return &paperlessV1.ListRemoteItemsResponse{
+		Items: protoItems,
+	}, nil
+}
+
+// CreateImportMapping maps a remote folder to a category
+func (s *ImportService) CreateImportMapping(ctx context.Context, req *paperlessV1.CreateImportMappingRequest) (*paperlessV1.CreateImportMappingResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	connector, err := s.getConnector(ctx, tenantID, req.GetConnectorId())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validateCategory(ctx, tenantID, req.CategoryId); err != nil {
+		return nil, err
+	}
+	if req.GetSyncIntervalMinutes() < 0 {
+		return nil, paperlessV1.ErrorBadRequest("sync_interval_minutes must not be negative")
+	}
+
+	// Check the folder is readable, and remember its name
+	client, err := data.NewImportClient(connector.Provider, connector.Credentials, connector.Config)
+	if err != nil {
+		return nil, paperlessV1.ErrorBadRequest("invalid connector: %s", err.Error())
+	}
+	folder, err := client.Folder(ctx, req.GetRemoteFolderId())
+	if err != nil {
+		s.log.Warnf("get remote folder %s of connector %s failed: %v", req.GetRemoteFolderId(), connector.ID, err)
+		return nil, paperlessV1.ErrorBadRequest("remote folder is not readable: %s", err.Error())
+	}
+
+	enabled := true
+	if req.Enabled != nil {
+		enabled = req.GetEnabled()
+	}
+
+	var nextSyncAt *time.Time
+	if req.GetSyncNow() {
+		now := time.Now()
+		nextSyncAt = &now
+	} else {
+		nextSyncAt = nextScheduledSync(req.GetSyncIntervalMinutes())
+	}
+
+	var categoryID *string
+	if req.GetCategoryId() != "" {
+		categoryID = req.CategoryId
+	}
+
+	entity, err := s.repo.CreateMapping(ctx, tenantID, connector.ID, req.GetRemoteFolderId(), folder.Name, categoryID,
+		req.GetIncludeSubfolders(), req.GetSyncIntervalMinutes(), enabled, nextSyncAt, getUserIDAsUint32(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if req.GetSyncNow() {
+		s.syncer.Wake()
+	}
+
+	return &paperlessV1.CreateImportMappingResponse{
+		Mapping: s.repo.MappingToProto(entity),
+	}, nil
+}
+
+// ListImportMappings lists the tenant's import mappings
+func (s *ImportService) ListImportMappings(ctx context.Context, req *paperlessV1.ListImportMappingsRequest) (*paperlessV1.ListImportMappingsResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	page, pageSize := webhookPage(req.Page, req.PageSize)
+	entities, total, err := s.repo.ListMappings(ctx, tenantID, req.ConnectorId, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	mappings := make([]*paperlessV1.ImportMapping, 0, len(entities))
+	for _, e := range entities {
+		mappings = append(mappings, s.repo.MappingToProto(e))
+	}
+
+	return &paperlessV1.ListImportMappingsResponse{
+		Mappings: mappings,
+		Total:    uint32(total),
+	}, nil
+}
+
+// UpdateImportMapping updates an import mapping, rescheduling it when its interval changes
+func (s *ImportService) UpdateImportMapping(ctx context.Context, req *paperlessV1.UpdateImportMappingRequest) (*paperlessV1.UpdateImportMappingResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.getMapping(ctx, tenantID, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetCategoryId() != "" {
+		if err := s.validateCategory(ctx, tenantID, req.CategoryId); err != nil {
+			return nil, err
+		}
+	}
+	if req.SyncIntervalMinutes != nil && req.GetSyncIntervalMinutes() < 0 {
+		return nil, paperlessV1.ErrorBadRequest("sync_interval_minutes must not be negative")
+	}
+
+	// A new interval takes effect from now, unless a sync is queued or running
+	rescheduled := req.SyncIntervalMinutes != nil && req.GetSyncIntervalMinutes() != existing.SyncIntervalMinutes &&
+		existing.LastSyncStatus != importmapping.LastSyncStatusIMPORT_SYNC_STATUS_QUEUED &&
+		existing.LastSyncStatus != importmapping.LastSyncStatusIMPORT_SYNC_STATUS_RUNNING
+	var nextSyncAt *time.Time
+	if rescheduled {
+		nextSyncAt = nextScheduledSync(req.GetSyncIntervalMinutes())
+	}
+
+	entity, err := s.repo.UpdateMapping(ctx, req.GetId(), req.CategoryId, req.IncludeSubfolders, req.SyncIntervalMinutes, req.Enabled, nextSyncAt, rescheduled)
+	if err != nil {
+		return nil, err
+	}
+
+	return &paperlessV1.UpdateImportMappingResponse{
+		Mapping: s.repo.MappingToProto(entity),
+	}, nil
+}
+
+// DeleteImportMapping deletes an import mapping; documents it imported are kept
+func (s *ImportService) DeleteImportMapping(ctx context.Context, req *paperlessV1.DeleteImportMappingRequest) (*emptypb.Empty, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getMapping(ctx, tenantID, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.DeleteMapping(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// SyncImportMapping queues a one-time sync of an import mapping
+func (s *ImportService) SyncImportMapping(ctx context.Context, req *paperlessV1.SyncImportMappingRequest) (*paperlessV1.SyncImportMappingResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.getMapping(ctx, tenantID, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if existing.Edges.Connector != nil && !existing.Edges.Connector.Enabled {
+		return nil, paperlessV1.ErrorBadRequest("the mapping's connector is disabled")
+	}
+	if existing.LastSyncStatus == importmapping.LastSyncStatusIMPORT_SYNC_STATUS_RUNNING {
+		return nil, paperlessV1.ErrorBadRequest("a sync of the mapping is already running")
+	}
+
+	entity, err := s.repo.QueueSync(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	s.syncer.Wake()
+
+	return &paperlessV1.SyncImportMappingResponse{
+		Mapping: s.repo.MappingToProto(entity),
+	}, nil
+}
+
+// requireAdmin restricts import management to tenant admins
+func (s *ImportService) requireAdmin(ctx context.Context) error {
+	if !isTenantAdmin(ctx) {
+		return errTenantAdminRequired("only tenant admins can manage imports", "manage_imports")
+	}
+	return nil
+}
+
+// getConnector returns a connector of the tenant, or a not found error
+func (s *ImportService) getConnector(ctx context.Context, tenantID uint32, id string) (*ent.ImportConnector, error) {
+	entity, err := s.repo.GetConnector(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, paperlessV1.ErrorNotFound("import connector not found")
+	}
+	return entity, nil
+}
+
+// getMapping returns a mapping of the tenant with its connector, or a not found error
+func (s *ImportService) getMapping(ctx context.Context, tenantID uint32, id string) (*ent.ImportMapping, error) {
+	entity, err := s.repo.GetMapping(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, paperlessV1.ErrorNotFound("import mapping not found")
+	}
+	return entity, nil
+}
+
+// validateCategory checks that a mapping's category belongs to the tenant
+func (s *ImportService) validateCategory(ctx context.Context, tenantID uint32, categoryID *string) error {
+	if categoryID == nil || *categoryID == "" {
+		return nil
+	}
+	category, err := s.categoryRepo.GetByID(ctx, *categoryID)
+	if err != nil {
+		return err
+	}
+	if category == nil || category.TenantID == nil || *category.TenantID != tenantID {
+		return paperlessV1.ErrorCategoryNotFound("category not found")
+	}
+	return nil
+}
+
+// importProvider maps a proto provider to its ent value
+func importProvider(provider paperlessV1.ImportProvider) (importconnector.Provider, error) {
+	switch provider {
+	case paperlessV1.ImportProvider_IMPORT_PROVIDER_GOOGLE_DRIVE:
+		return importconnector.ProviderIMPORT_PROVIDER_GOOGLE_DRIVE, nil
+	case paperlessV1.ImportProvider_IMPORT_PROVIDER_SHAREPOINT:
+		return importconnector.ProviderIMPORT_PROVIDER_SHAREPOINT, nil
+	default:
+		return "", paperlessV1.ErrorBadRequest("provider is required")
+	}
+}
+
+// nextScheduledSync returns when a mapping with the given interval next syncs, nil for manual syncs only
+func nextScheduledSync(intervalMinutes int32) *time.Time {
+	if intervalMinutes <= 0 {
+		return nil
+	}
+	next := time.Now().Add(time.Duration(intervalMinutes) * time.Minute)
+	return &next
+}
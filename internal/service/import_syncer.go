@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/importmapping"
+	"github.com/go-tangra/go-tangra-paperless/internal/metrics"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+const (
+	defaultImportMaxFileSize = 100 << 20
+
+	importPollInterval = 30 * time.Second
+	importClaimBatch   = 5
+
+	// importSyncLease keeps other replicas off a mapping while it syncs; a sync running
+	// longer may be picked up a second time, which only skips the files already imported
+	importSyncLease = time.Hour
+)
+
+// ImportSyncer imports the files of mapped Google Drive and SharePoint folders as documents.
+// A background loop claims mappings whose sync is due, scheduled by their interval or queued
+// through SyncImportMapping, and imports every file it has not imported before. Files changed
+// after their import are not imported again. Mappings are claimed in the database, so several
+// replicas can run the loop.
+type ImportSyncer struct {
+	backgroundJob
+
+	log       *log.Helper
+	repo      *data.ImportRepo
+	documents *DocumentService
+
+	maxFileSize int64
+}
+
+// NewImportSyncer creates an ImportSyncer, configured by PAPERLESS_IMPORT_MAX_FILE_SIZE
+func NewImportSyncer(ctx *bootstrap.Context, repo *data.ImportRepo, documents *DocumentService) *ImportSyncer {
+	l := ctx.NewLoggerHelper("paperless/service/import-syncer")
+
+	s := &ImportSyncer{
+		backgroundJob: backgroundJob{
+			interval: importPollInterval,
+			wake:     make(chan struct{}, 1),
+			log:      l,
+		},
+		log:         l,
+		repo:        repo,
+		documents:   documents,
+		maxFileSize: defaultImportMaxFileSize,
+	}
+	s.tick = s.syncDue
+
+	if v := os.Getenv("PAPERLESS_IMPORT_MAX_FILE_SIZE"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || n <= 0 {
+			l.Warnf("invalid PAPERLESS_IMPORT_MAX_FILE_SIZE %q, using %d", v, defaultImportMaxFileSize)
+		} else {
+			s.maxFileSize = n
+		}
+	}
+
+	return s
+}
+
+// Wake makes the loop look for due mappings now, after a sync was queued
+func (s *ImportSyncer) Wake() {
+	s.trigger()
+}
+
+// syncDue syncs due mappings, run when a sync is queued and periodically for scheduled syncs
+func (s *ImportSyncer) syncDue(ctx context.Context) {
+	for ctx.Err() == nil {
+		mappings, err := s.repo.ClaimDueMappings(ctx, time.Now(), importSyncLease, importClaimBatch)
+		if err != nil || len(mappings) == 0 {
+			return
+		}
+		for _, m := range mappings {
+			s.syncMapping(ctx, m)
+		}
+	}
+}
+
+// syncMapping imports the new files of a claimed mapping and records the outcome
+func (s *ImportSyncer) syncMapping(ctx context.Context, mapping *ent.ImportMapping) {
+	connector := mapping.Edges.Connector
+	result := &data.ImportSyncResult{
+		Status:     importmapping.LastSyncStatusIMPORT_SYNC_STATUS_SUCCEEDED,
+		NextSyncAt: nextScheduledSync(mapping.SyncIntervalMinutes),
+	}
+	if !mapping.Enabled {
+		// A queued sync of a disabled mapping runs once without scheduling more
+		result.NextSyncAt = nil
+	}
+
+	if err := s.importFolder(ctx, mapping, result); err != nil {
+		result.Status = importmapping.LastSyncStatusIMPORT_SYNC_STATUS_FAILED
+		result.Error = err.Error()
+	} else if result.Failed > 0 {
+		result.Status = importmapping.LastSyncStatusIMPORT_SYNC_STATUS_PARTIAL
+	}
+
+	if ctx.Err() != nil {
+		// Shutting down; the lease expires and the sync is picked up again
+		return
+	}
+
+	s.log.Infof("synced import mapping %s: %d imported, %d skipped, %d failed",
+		mapping.ID, result.Imported, result.Skipped, result.Failed)
+	metrics.ImportSyncs.WithLabelValues(string(connector.Provider), strings.ToLower(strings.TrimPrefix(string(result.Status), "IMPORT_SYNC_STATUS_"))).Inc()
+
+	if err := s.repo.FinishSync(ctx, mapping.ID, result); err != nil {
+		s.log.Errorf("finish sync of import mapping %s failed: %v", mapping.ID, err)
+	}
+}
+
+// importFolder walks the mapped folder, and its subfolders if enabled, importing new files.
+// It fails only if the mapped folder itself cannot be read; other failures are counted.
+func (s *ImportSyncer) importFolder(ctx context.Context, mapping *ent.ImportMapping, result *data.ImportSyncResult) error {
+	connector := mapping.Edges.Connector
+	tenantID := derefTenantID(mapping.TenantID)
+
+	client, err := data.NewImportClient(connector.Provider, connector.Credentials, connector.Config)
+	if err != nil {
+		return fmt.Errorf("invalid connector: %w", err)
+	}
+
+	imported, err := s.repo.ImportedFileIDs(ctx, mapping.ID)
+	if err != nil {
+		return err
+	}
+
+	// Documents are created as the user who created the mapping
+	callerCtx := newCallerContext(ctx, tenantID, mapping.CreateBy)
+
+	folders := []string{mapping.RemoteFolderID}
+	visited := map[string]bool{mapping.RemoteFolderID: true}
+	for len(folders) > 0 && ctx.Err() == nil {
+		folderID := folders[0]
+		folders = folders[1:]
+
+		items, err := client.List(ctx, folderID)
+		if err != nil {
+			if folderID == mapping.RemoteFolderID {
+				return err
+			}
+			s.log.Warnf("import mapping %s: %v", mapping.ID, err)
+			result.Failed++
+			result.Error = err.Error()
+			continue
+		}
+
+		for _, item := range items {
+			if ctx.Err() != nil {
+				break
+			}
+
+			if item.Folder {
+				if mapping.IncludeSubfolders && !visited[item.ID] {
+					visited[item.ID] = true
+					folders = append(folders, item.ID)
+				}
+				continue
+			}
+			if _, ok := imported[item.ID]; ok {
+				result.Skipped++
+				continue
+			}
+
+			if err := s.importFile(callerCtx, client, mapping, item); err != nil {
+				s.log.Warnf("import mapping %s: import of %q failed: %v", mapping.ID, item.Name, err)
+				result.Failed++
+				result.Error = fmt.Sprintf("%s: %v", item.Name, err)
+				continue
+			}
+			imported[item.ID] = struct{}{}
+			result.Imported++
+		}
+	}
+	return nil
+}
+
+// importFile downloads a remote file and creates a document from it
+func (s *ImportSyncer) importFile(ctx context.Context, client data.ImportClient, mapping *ent.ImportMapping, item data.RemoteItem) error {
+	if item.Size > s.maxFileSize {
+		return fmt.Errorf("file is larger than %d bytes", s.maxFileSize)
+	}
+
+	content, fileName, mimeType, err := client.Download(ctx, item, s.maxFileSize)
+	if err != nil {
+		return err
+	}
+
+	name := strings.TrimSuffix(item.Name, path.Ext(item.Name))
+	if name == "" {
+		name = item.Name
+	}
+
+	document, err := s.documents.createDocument(ctx, &paperlessV1.CreateDocumentRequest{
+		CategoryId:  mapping.CategoryID,
+		Name:        name,
+		Description: fmt.Sprintf("Imported from %s", mapping.RemoteFolderName),
+		FileName:    fileName,
+		FileContent: content,
+		MimeType:    mimeType,
+		Source:      paperlessV1.DocumentSource_DOCUMENT_SOURCE_IMPORT,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.repo.RecordImportedFile(ctx, derefTenantID(mapping.TenantID), mapping.ID, item.ID, item.Version, document.ID)
+}
+
+func derefTenantID(tenantID *uint32) uint32 {
+	if tenantID == nil {
+		return 0
+	}
+	return *tenantID
+}
@@ -0,0 +1,295 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// NotificationService manages notification preferences and notifies users in-app, through
+// the platform notification module, about things that concern them
+type NotificationService struct {
+	paperlessV1.UnimplementedPaperlessNotificationServiceServer
+
+	log          *log.Helper
+	client       *data.NotificationClient
+	prefs        *data.NotificationPreferenceRepo
+	documentRepo *data.DocumentRepo
+	categoryRepo *data.CategoryRepo
+}
+
+func NewNotificationService(
+	ctx *bootstrap.Context,
+	client *data.NotificationClient,
+	prefs *data.NotificationPreferenceRepo,
+	documentRepo *data.DocumentRepo,
+	categoryRepo *data.CategoryRepo,
+) *NotificationService {
+	return &NotificationService{
+		log:          ctx.NewLoggerHelper("paperless/service/notification"),
+		client:       client,
+		prefs:        prefs,
+		documentRepo: documentRepo,
+		categoryRepo: categoryRepo,
+	}
+}
+
+// GetNotificationPreferences returns the caller's notification preferences
+func (s *NotificationService) GetNotificationPreferences(ctx context.Context, _ *paperlessV1.GetNotificationPreferencesRequest) (*paperlessV1.GetNotificationPreferencesResponse, error) {
+	userID := getUserIDAsUint32(ctx)
+	if userID == nil {
+		return nil, paperlessV1.ErrorAccessDenied("notification preferences require a user")
+	}
+
+	prefs, err := s.prefs.Get(ctx, getTenantIDFromContext(ctx), *userID)
+	if err != nil {
+		return nil, err
+	}
+	return &paperlessV1.GetNotificationPreferencesResponse{Preferences: prefs}, nil
+}
+
+// UpdateNotificationPreferences changes the caller's notification preferences
+func (s *NotificationService) UpdateNotificationPreferences(ctx context.Context, req *paperlessV1.UpdateNotificationPreferencesRequest) (*paperlessV1.UpdateNotificationPreferencesResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDAsUint32(ctx)
+	if userID == nil {
+		return nil, paperlessV1.ErrorAccessDenied("notification preferences require a user")
+	}
+
+	prefs, err := s.prefs.Get(ctx, tenantID, *userID)
+	if err != nil {
+		return nil, err
+	}
+	if req.ShareEnabled != nil {
+		prefs.ShareEnabled = req.GetShareEnabled()
+	}
+	if req.MentionEnabled != nil {
+		prefs.MentionEnabled = req.GetMentionEnabled()
+	}
+	if req.ReminderEnabled != nil {
+		prefs.ReminderEnabled = req.GetReminderEnabled()
+	}
+	if req.AssignmentEnabled != nil {
+		prefs.AssignmentEnabled = req.GetAssignmentEnabled()
+	}
+	if err := s.prefs.Set(ctx, tenantID, *userID, prefs); err != nil {
+		return nil, err
+	}
+	return &paperlessV1.UpdateNotificationPreferencesResponse{Preferences: prefs}, nil
+}
+
+// NotifyShare tells a user that a document or category was shared with them. It runs in the
+// background and never fails the share: delivery is best effort.
+func (s *NotificationService) NotifyShare(ctx context.Context, tenantID uint32, resourceType paperlessV1.ResourceType, resourceID string, relation paperlessV1.Relation, subjectType paperlessV1.SubjectType, subjectID string) {
+	if !s.client.Enabled() || subjectType != paperlessV1.SubjectType_SUBJECT_TYPE_USER {
+		return
+	}
+	// Sharing with yourself is not news
+	actorID := getUserIDFromContext(ctx)
+	if subjectID == actorID {
+		return
+	}
+	recipient, err := strconv.ParseUint(subjectID, 10, 32)
+	if err != nil {
+		return
+	}
+	actor := getUsernameFromContext(ctx)
+	if actor == "" {
+		actor = "Someone"
+	}
+
+	// The share has committed; don't let the end of the request cancel the delivery
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		if err := s.notifyShare(ctx, tenantID, uint32(recipient), resourceType, resourceID, relation, actor, actorID); err != nil {
+			s.log.Warnf("notify user %d about share of %s %s failed: %v", recipient, resourceType, resourceID, err)
+		}
+	}()
+}
+
+func (s *NotificationService) notifyShare(ctx context.Context, tenantID, userID uint32, resourceType paperlessV1.ResourceType, resourceID string, relation paperlessV1.Relation, actor, actorID string) error {
+	prefs, err := s.prefs.Get(ctx, tenantID, userID)
+	if err != nil {
+		return err
+	}
+	if !prefs.ShareEnabled {
+		return nil
+	}
+
+	kind, name, err := s.resourceName(ctx, resourceType, resourceID)
+	if err != nil {
+		return err
+	}
+	if name == "" {
+		// Deleted in the meantime
+		return nil
+	}
+
+	return s.client.Send(ctx, &data.Notification{
+		TenantID:     tenantID,
+		UserID:       userID,
+		Type:         data.NotificationTypeShare,
+		Title:        fmt.Sprintf("%s shared with you", strings.ToUpper(kind[:1])+kind[1:]),
+		Message:      fmt.Sprintf("%s shared the %s %q with you as %s", actor, kind, name, relationName(relation)),
+		ResourceType: resourceType.String(),
+		ResourceID:   resourceID,
+		ActorID:      actorID,
+	})
+}
+
+// NotifyAssignment tells a user that a document was assigned to them. It runs in the background
+// and never fails the assignment: delivery is best effort.
+func (s *NotificationService) NotifyAssignment(ctx context.Context, tenantID, assigneeID uint32, doc *ent.Document, note string) {
+	if !s.client.Enabled() {
+		return
+	}
+	// Taking a document yourself is not news
+	actorID := getUserIDFromContext(ctx)
+	if strconv.FormatUint(uint64(assigneeID), 10) == actorID {
+		return
+	}
+	actor := getUsernameFromContext(ctx)
+	if actor == "" {
+		actor = "Someone"
+	}
+
+	// The assignment has committed; don't let the end of the request cancel the delivery
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		if err := s.notifyAssignment(ctx, tenantID, assigneeID, doc, note, actor, actorID); err != nil {
+			s.log.Warnf("notify user %d about assignment of document %s failed: %v", assigneeID, doc.ID, err)
+		}
+	}()
+}
+
+func (s *NotificationService) notifyAssignment(ctx context.Context, tenantID, userID uint32, doc *ent.Document, note, actor, actorID string) error {
+	prefs, err := s.prefs.Get(ctx, tenantID, userID)
+	if err != nil {
+		return err
+	}
+	if !prefs.AssignmentEnabled {
+		return nil
+	}
+
+	message := fmt.Sprintf("%s assigned the document %q to you", actor, doc.Name)
+	if note != "" {
+		message += ": " + note
+	}
+
+	return s.client.Send(ctx, &data.Notification{
+		TenantID:     tenantID,
+		UserID:       userID,
+		Type:         data.NotificationTypeAssignment,
+		Title:        "Document assigned to you",
+		Message:      message,
+		ResourceType: paperlessV1.ResourceType_RESOURCE_TYPE_DOCUMENT.String(),
+		ResourceID:   doc.ID,
+		ActorID:      actorID,
+	})
+}
+
+// NotifyReviewRequest asks a user to review a document. Like assignments, it runs in the
+// background, is best effort and follows the user's assignment preference.
+func (s *NotificationService) NotifyReviewRequest(ctx context.Context, tenantID, reviewerID uint32, doc *ent.Document, message string) {
+	if !s.client.Enabled() {
+		return
+	}
+	actorID := getUserIDFromContext(ctx)
+	if strconv.FormatUint(uint64(reviewerID), 10) == actorID {
+		return
+	}
+	actor := getUsernameFromContext(ctx)
+	if actor == "" {
+		actor = "Someone"
+	}
+
+	// The review has committed; don't let the end of the request cancel the delivery
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		if err := s.notifyReviewRequest(ctx, tenantID, reviewerID, doc, message, actor, actorID); err != nil {
+			s.log.Warnf("notify user %d about review of document %s failed: %v", reviewerID, doc.ID, err)
+		}
+	}()
+}
+
+func (s *NotificationService) notifyReviewRequest(ctx context.Context, tenantID, userID uint32, doc *ent.Document, note, actor, actorID string) error {
+	prefs, err := s.prefs.Get(ctx, tenantID, userID)
+	if err != nil {
+		return err
+	}
+	if !prefs.AssignmentEnabled {
+		return nil
+	}
+
+	message := fmt.Sprintf("%s asked you to review the document %q", actor, doc.Name)
+	if note != "" {
+		message += ": " + note
+	}
+
+	return s.client.Send(ctx, &data.Notification{
+		TenantID:     tenantID,
+		UserID:       userID,
+		Type:         data.NotificationTypeReview,
+		Title:        "Review requested",
+		Message:      message,
+		ResourceType: paperlessV1.ResourceType_RESOURCE_TYPE_DOCUMENT.String(),
+		ResourceID:   doc.ID,
+		ActorID:      actorID,
+	})
+}
+
+// remindDueDate reminds a user that a document is due, unless the user turned reminders off
+func (s *NotificationService) remindDueDate(ctx context.Context, tenantID, userID uint32, doc *ent.Document) error {
+	prefs, err := s.prefs.Get(ctx, tenantID, userID)
+	if err != nil {
+		return err
+	}
+	if !prefs.ReminderEnabled || doc.DueDate == nil {
+		return nil
+	}
+
+	return s.client.Send(ctx, &data.Notification{
+		TenantID:     tenantID,
+		UserID:       userID,
+		Type:         data.NotificationTypeReminder,
+		Title:        "Document due soon",
+		Message:      fmt.Sprintf("The document %q is due on %s", doc.Name, doc.DueDate.Format(time.DateOnly)),
+		ResourceType: paperlessV1.ResourceType_RESOURCE_TYPE_DOCUMENT.String(),
+		ResourceID:   doc.ID,
+	})
+}
+
+// resourceName returns what a resource is called, or "" if it no longer exists
+func (s *NotificationService) resourceName(ctx context.Context, resourceType paperlessV1.ResourceType, resourceID string) (kind, name string, err error) {
+	switch resourceType {
+	case paperlessV1.ResourceType_RESOURCE_TYPE_DOCUMENT:
+		doc, err := s.documentRepo.GetByID(ctx, resourceID)
+		if err != nil || doc == nil {
+			return "document", "", err
+		}
+		return "document", doc.Name, nil
+	case paperlessV1.ResourceType_RESOURCE_TYPE_CATEGORY:
+		category, err := s.categoryRepo.GetByID(ctx, resourceID)
+		if err != nil || category == nil {
+			return "category", "", err
+		}
+		return "category", category.Name, nil
+	default:
+		return "", "", fmt.Errorf("unknown resource type %s", resourceType)
+	}
+}
+
+// relationName turns RELATION_VIEWER into "viewer"
+func relationName(relation paperlessV1.Relation) string {
+	return strings.ToLower(strings.TrimPrefix(relation.String(), "RELATION_"))
+}
@@ -2,6 +2,9 @@ package service
 
 import (
 	"context"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
@@ -9,6 +12,9 @@ import (
 
 	"github.com/go-tangra/go-tangra-paperless/internal/authz"
 	"github.com/go-tangra/go-tangra-paperless/internal/data"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/auditevent"
+	"github.com/go-tangra/go-tangra-paperless/internal/errdetail"
 
 	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
 )
@@ -16,36 +22,47 @@ import (
 type PermissionService struct {
 	paperlessV1.UnimplementedPaperlessPermissionServiceServer
 
-	log      *log.Helper
-	permRepo *data.PermissionRepo
-	engine   *authz.Engine
+	log       *log.Helper
+	permRepo  *data.PermissionRepo
+	auditRepo *data.AuditEventRepo
+	events    *data.EventPublisher
+	tx        *data.Transaction
+	engine    *authz.Engine
+	notifier  *NotificationService
 }
 
 func NewPermissionService(
 	ctx *bootstrap.Context,
 	permRepo *data.PermissionRepo,
+	auditRepo *data.AuditEventRepo,
+	events *data.EventPublisher,
+	tx *data.Transaction,
 	engine *authz.Engine,
+	notifier *NotificationService,
 ) *PermissionService {
 	return &PermissionService{
-		log:      ctx.NewLoggerHelper("paperless/service/permission"),
-		permRepo: permRepo,
-		engine:   engine,
+		log:       ctx.NewLoggerHelper("paperless/service/permission"),
+		permRepo:  permRepo,
+		auditRepo: auditRepo,
+		events:    events,
+		tx:        tx,
+		engine:    engine,
+		notifier:  notifier,
 	}
 }
 
-// GrantAccess grants access to a resource
+// GrantAccess grants access to a resource. The caller needs share access to it and can't
+// grant more than their own relation.
 func (s *PermissionService) GrantAccess(ctx context.Context, req *paperlessV1.GrantAccessRequest) (*paperlessV1.GrantAccessResponse, error) {
-	tenantID := getTenantIDFromContext(ctx)
-	grantedBy := getUserIDAsUint32(ctx)
+	conditions := data.ConditionsFromProto(req.Conditions)
+	if err := conditions.Validate(); err != nil {
+		return nil, errdetail.With(paperlessV1.ErrorInvalidPermission("invalid conditions: %s", err.Error()),
+			errdetail.KeyPermissionConditionsInvalid, "conditions", "reason", err.Error())
+	}
 
-	permission, err := s.permRepo.Create(ctx, tenantID,
-		req.ResourceType.String(),
-		req.ResourceId,
-		req.Relation.String(),
-		req.SubjectType.String(),
-		req.SubjectId,
-		grantedBy,
+	permission, err := s.grant(ctx, req.ResourceType, req.ResourceId, "resource_id", req.Relation, req.SubjectType, req.SubjectId,
 		nil, // expiresAt - simplified for now
+		conditions,
 	)
 	if err != nil {
 		return nil, err
@@ -56,27 +73,211 @@ func (s *PermissionService) GrantAccess(ctx context.Context, req *paperlessV1.Gr
 	}, nil
 }
 
-// RevokeAccess revokes access from a resource
+// ShareDocument grants a user or role a relation to a document and notifies them in one call.
+// Like GrantAccess, the caller needs share access and can't grant more than their own relation.
+func (s *PermissionService) ShareDocument(ctx context.Context, req *paperlessV1.ShareDocumentRequest) (*paperlessV1.ShareDocumentResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+
+	if req.SubjectType != paperlessV1.SubjectType_SUBJECT_TYPE_USER && req.SubjectType != paperlessV1.SubjectType_SUBJECT_TYPE_ROLE {
+		return nil, errdetail.With(paperlessV1.ErrorBadRequest("documents can only be shared with users and roles"),
+			errdetail.KeyPermissionSubjectUnsupported, "subject_type")
+	}
+	if req.Relation == paperlessV1.Relation_RELATION_UNSPECIFIED {
+		return nil, errdetail.With(paperlessV1.ErrorBadRequest("relation is required"), errdetail.KeyPermissionRelationRequired, "relation")
+	}
+
+	var expiresAt *time.Time
+	if req.ExpiresAt != nil {
+		t := req.ExpiresAt.AsTime()
+		if !t.After(time.Now()) {
+			return nil, errdetail.With(paperlessV1.ErrorBadRequest("expiresAt must be in the future"), errdetail.KeyPermissionExpiryPast, "expires_at")
+		}
+		expiresAt = &t
+	}
+
+	permission, err := s.grant(ctx, paperlessV1.ResourceType_RESOURCE_TYPE_DOCUMENT, req.DocumentId, "document_id", req.Relation, req.SubjectType, req.SubjectId, expiresAt, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	effective, highestRelation := s.subjectPermissions(ctx, tenantID, req.DocumentId, req.SubjectType, req.SubjectId)
+
+	return &paperlessV1.ShareDocumentResponse{
+		Permission:           s.permRepo.ToProto(permission),
+		EffectivePermissions: effective,
+		HighestRelation:      highestRelation,
+	}, nil
+}
+
+// grant stores a permission tuple, publishes and audits it, and notifies a user it was shared
+// with. The caller needs share access to the resource and can't grant more than their own
+// relation to it; field names the request field holding the resource ID.
+func (s *PermissionService) grant(ctx context.Context, resourceType paperlessV1.ResourceType, resourceID, field string, relation paperlessV1.Relation, subjectType paperlessV1.SubjectType, subjectID string, expiresAt *time.Time, conditions *authz.Conditions) (*ent.DocumentPermission, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	grantedBy := getUserIDAsUint32(ctx)
+
+	highest, err := s.requireShare(ctx, tenantID, resourceType, resourceID, field)
+	if err != nil {
+		return nil, err
+	}
+	if granted := authz.Relation(relation.String()); !authz.IsRelationAtLeast(highest, granted) {
+		return nil, errdetail.With(paperlessV1.ErrorAccessDenied("can't grant %s with %s access", granted, highest),
+			errdetail.KeyPermissionGrantExceedsAccess, "relation", "relation", granted, "access", highest)
+	}
+
+	var permission *ent.DocumentPermission
+	err = s.tx.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		permission, err = s.permRepo.Create(ctx, tenantID,
+			resourceType.String(),
+			resourceID,
+			relation.String(),
+			subjectType.String(),
+			subjectID,
+			grantedBy,
+			expiresAt,
+			conditions,
+		)
+		if err != nil {
+			return err
+		}
+		return s.events.Publish(ctx, tenantID, resourceID, EventPermissionGranted, &PermissionEvent{
+			TenantID:     tenantID,
+			ResourceType: resourceType.String(),
+			ResourceID:   resourceID,
+			Relation:     relation.String(),
+			SubjectType:  subjectType.String(),
+			SubjectID:    subjectID,
+			UserID:       getUserIDFromContext(ctx),
+			OccurredAt:   time.Now(),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	recordAudit(ctx, s.auditRepo, auditevent.ActionAUDIT_ACTION_SHARE, auditevent.ResourceType(resourceType.String()), resourceID, "", map[string]string{
+		"relation":     relation.String(),
+		"subject_type": subjectType.String(),
+		"subject_id":   subjectID,
+	})
+
+	s.notifier.NotifyShare(ctx, tenantID, resourceType, resourceID, relation, subjectType, subjectID)
+
+	return permission, nil
+}
+
+// requireShare returns the caller's highest relation to a resource, failing unless it grants
+// share access
+func (s *PermissionService) requireShare(ctx context.Context, tenantID uint32, resourceType paperlessV1.ResourceType, resourceID, field string) (authz.Relation, error) {
+	permissions, highest := s.engine.GetEffectivePermissions(ctx, authz.CheckContext{
+		TenantID:     tenantID,
+		UserID:       getUserIDFromContext(ctx),
+		ResourceType: authz.ResourceType(resourceType.String()),
+		ResourceID:   resourceID,
+	})
+	if !slices.Contains(permissions, authz.PermissionShare) {
+		resource := strings.ToLower(strings.TrimPrefix(resourceType.String(), "RESOURCE_TYPE_"))
+		return "", errNoAccess("no share access to "+resource, "share", resource, field)
+	}
+	return highest, nil
+}
+
+// subjectPermissions returns what a subject can do with a document. Users get their effective
+// permissions; roles the permissions of their unexpired grants on the document itself.
+func (s *PermissionService) subjectPermissions(ctx context.Context, tenantID uint32, documentID string, subjectType paperlessV1.SubjectType, subjectID string) ([]paperlessV1.Permission, paperlessV1.Relation) {
+	var permissions []authz.Permission
+	var highest authz.Relation
+
+	if subjectType == paperlessV1.SubjectType_SUBJECT_TYPE_USER {
+		permissions, highest = s.engine.GetEffectivePermissions(ctx, authz.CheckContext{
+			TenantID:     tenantID,
+			UserID:       subjectID,
+			ResourceType: authz.ResourceTypeDocument,
+			ResourceID:   documentID,
+		})
+	} else {
+		tuples, err := s.engine.ListPermissions(ctx, tenantID, authz.ResourceTypeDocument, documentID)
+		if err != nil {
+			s.log.Warnf("failed to list permissions of document %s: %v", documentID, err)
+		}
+		var relations []authz.Relation
+		for _, t := range tuples {
+			if string(t.SubjectType) != subjectType.String() || t.SubjectID != subjectID {
+				continue
+			}
+			if t.ExpiresAt != nil && !t.ExpiresAt.After(time.Now()) {
+				continue
+			}
+			relations = append(relations, t.Relation)
+			permissions = append(permissions, authz.GetPermissionsForRelation(t.Relation)...)
+		}
+		highest = authz.GetHighestRelation(relations)
+	}
+
+	protoPermissions := make([]paperlessV1.Permission, 0, len(permissions))
+	for _, p := range permissions {
+		if pv, ok := paperlessV1.Permission_value[string(p)]; ok {
+			protoPermissions = append(protoPermissions, paperlessV1.Permission(pv))
+		}
+	}
+	slices.Sort(protoPermissions)
+
+	return slices.Compact(protoPermissions), paperlessV1.Relation(paperlessV1.Relation_value[string(highest)])
+}
+
+// RevokeAccess revokes access from a resource. The caller needs share access to it.
 func (s *PermissionService) RevokeAccess(ctx context.Context, req *paperlessV1.RevokeAccessRequest) (*emptypb.Empty, error) {
 	tenantID := getTenantIDFromContext(ctx)
 
+	if _, err := s.requireShare(ctx, tenantID, req.ResourceType, req.ResourceId, "resource_id"); err != nil {
+		return nil, err
+	}
+
 	var relation *string
 	if req.Relation != nil && *req.Relation != paperlessV1.Relation_RELATION_UNSPECIFIED {
 		r := req.Relation.String()
 		relation = &r
 	}
 
-	err := s.permRepo.Delete(ctx, tenantID,
-		req.ResourceType.String(),
-		req.ResourceId,
-		relation,
-		req.SubjectType.String(),
-		req.SubjectId,
-	)
+	err := s.tx.InTx(ctx, func(ctx context.Context) error {
+		err := s.permRepo.Delete(ctx, tenantID,
+			req.ResourceType.String(),
+			req.ResourceId,
+			relation,
+			req.SubjectType.String(),
+			req.SubjectId,
+		)
+		if err != nil {
+			return err
+		}
+		event := &PermissionEvent{
+			TenantID:     tenantID,
+			ResourceType: req.ResourceType.String(),
+			ResourceID:   req.ResourceId,
+			SubjectType:  req.SubjectType.String(),
+			SubjectID:    req.SubjectId,
+			UserID:       getUserIDFromContext(ctx),
+			OccurredAt:   time.Now(),
+		}
+		if relation != nil {
+			event.Relation = *relation
+		}
+		return s.events.Publish(ctx, tenantID, req.ResourceId, EventPermissionRevoked, event)
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	details := map[string]string{
+		"subject_type": req.SubjectType.String(),
+		"subject_id":   req.SubjectId,
+	}
+	if relation != nil {
+		details["relation"] = *relation
+	}
+	recordAudit(ctx, s.auditRepo, auditevent.ActionAUDIT_ACTION_UNSHARE, auditevent.ResourceType(req.ResourceType.String()), req.ResourceId, "", details)
+
 	return &emptypb.Empty{}, nil
 }
 
@@ -123,12 +324,28 @@ func (s *PermissionService) ListPermissions(ctx context.Context, req *paperlessV
 func (s *PermissionService) CheckAccess(ctx context.Context, req *paperlessV1.CheckAccessRequest) (*paperlessV1.CheckAccessResponse, error) {
 	tenantID := getTenantIDFromContext(ctx)
 
+	// Another client IP or MFA state would let callers satisfy conditions they don't meet, so
+	// only tenant admins may ask what a check would return with them
+	if (req.ClientIp != nil || req.MfaVerified != nil) && !isTenantAdmin(ctx) {
+		return nil, errTenantAdminRequired("only tenant admins can check access with another client IP or MFA state", "check_access_what_if")
+	}
+
+	// Evaluate conditions against the caller's request unless overridden
+	attrs := RequestAttributesFromContext(ctx)
+	if req.ClientIp != nil {
+		attrs.ClientIP = *req.ClientIp
+	}
+	if req.MfaVerified != nil {
+		attrs.MFAVerified = *req.MfaVerified
+	}
+
 	result := s.engine.Check(ctx, authz.CheckContext{
 		TenantID:     tenantID,
 		UserID:       req.UserId,
 		ResourceType: authz.ResourceType(req.ResourceType.String()),
 		ResourceID:   req.ResourceId,
 		Permission:   authz.Permission(req.Permission.String()),
+		Attributes:   &attrs,
 	})
 
 	var reason *string
@@ -146,10 +363,17 @@ func (s *PermissionService) CheckAccess(ctx context.Context, req *paperlessV1.Ch
 func (s *PermissionService) ListAccessibleResources(ctx context.Context, req *paperlessV1.ListAccessibleResourcesRequest) (*paperlessV1.ListAccessibleResourcesResponse, error) {
 	tenantID := getTenantIDFromContext(ctx)
 
-	resourceIDs, err := s.engine.ListAccessibleResources(ctx, tenantID, req.UserId, authz.ResourceType(req.ResourceType.String()), authz.PermissionRead)
+	permission := authz.PermissionRead
+	if req.Permission != paperlessV1.Permission_PERMISSION_UNSPECIFIED {
+		permission = authz.Permission(req.Permission.String())
+	}
+
+	resourceIDs, err := s.engine.ListAccessibleResources(ctx, tenantID, req.UserId, authz.ResourceType(req.ResourceType.String()), permission)
 	if err != nil {
 		return nil, err
 	}
+	// the engine returns the IDs in map order, so pages are only stable once sorted
+	slices.Sort(resourceIDs)
 
 	total := uint32(len(resourceIDs))
 
@@ -2,19 +2,22 @@ package providers
 
 import (
 	"context"
+	"os"
 
 	"github.com/go-tangra/go-tangra-common/grpcx"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 
 	"github.com/go-tangra/go-tangra-paperless/internal/authz"
 	"github.com/go-tangra/go-tangra-paperless/internal/data"
+	"github.com/go-tangra/go-tangra-paperless/internal/service"
 )
 
 // ProvideResourceLookup creates a ResourceLookup from repositories
-func ProvideResourceLookup(categoryRepo *data.CategoryRepo, documentRepo *data.DocumentRepo) authz.ResourceLookup {
+func ProvideResourceLookup(categoryRepo *data.CategoryRepo, documentRepo *data.DocumentRepo, groupRepo *data.GroupRepo) authz.ResourceLookup {
 	return &resourceLookupImpl{
 		categoryRepo: categoryRepo,
 		documentRepo: documentRepo,
+		groupRepo:    groupRepo,
 	}
 }
 
@@ -23,9 +26,25 @@ func ProvidePermissionStore(permRepo *data.PermissionRepo) authz.PermissionStore
 	return &permissionStoreAdapter{permRepo: permRepo}
 }
 
-// ProvideAuthzEngine creates the authorization engine
-func ProvideAuthzEngine(store authz.PermissionStore, lookup authz.ResourceLookup, ctx *bootstrap.Context) *authz.Engine {
-	return authz.NewEngine(store, lookup, ctx.GetLogger())
+// ProvideAccessIndex creates an AccessIndex from the access index repo
+func ProvideAccessIndex(accessIndexRepo *data.AccessIndexRepo) authz.AccessIndex {
+	return &accessIndexAdapter{repo: accessIndexRepo}
+}
+
+// ProvideAuthzEngine creates the authorization engine.
+// The platform-admin bypass policy is read from PAPERLESS_ADMIN_BYPASS_POLICY (full, read_only, none).
+// An unknown policy fails startup rather than granting admins more or less than intended.
+func ProvideAuthzEngine(store authz.PermissionStore, lookup authz.ResourceLookup, index authz.AccessIndex, ctx *bootstrap.Context) (*authz.Engine, error) {
+	l := ctx.NewLoggerHelper("paperless/authz/provider")
+
+	policy, err := authz.ParseBypassPolicy(os.Getenv("PAPERLESS_ADMIN_BYPASS_POLICY"))
+	if err != nil {
+		l.Errorf("invalid PAPERLESS_ADMIN_BYPASS_POLICY: %v", err)
+		return nil, err
+	}
+	l.Infof("platform admin bypass policy: %s", policy)
+
+	return authz.NewEngine(store, lookup, ctx.GetLogger(), authz.WithBypassPolicy(policy), authz.WithAccessIndex(index)), nil
 }
 
 // ProvideAuthzChecker creates the authorization checker
@@ -37,6 +56,7 @@ func ProvideAuthzChecker(engine *authz.Engine) *authz.Checker {
 type resourceLookupImpl struct {
 	categoryRepo *data.CategoryRepo
 	documentRepo *data.DocumentRepo
+	groupRepo    *data.GroupRepo
 }
 
 func (r *resourceLookupImpl) GetCategoryParentID(ctx context.Context, tenantID uint32, categoryID string) (*string, error) {
@@ -48,9 +68,27 @@ func (r *resourceLookupImpl) GetDocumentCategoryID(ctx context.Context, tenantID
 }
 
 func (r *resourceLookupImpl) GetUserRoleIDs(ctx context.Context, tenantID uint32, userID string) ([]string, error) {
+	// Roles are only known for the caller, so checks of other users only see their own grants,
+	// group grants and tenant grants
+	if grpcx.GetUserIDFromContext(ctx) != userID {
+		return nil, nil
+	}
 	return grpcx.GetRolesFromContext(ctx), nil
 }
 
+func (r *resourceLookupImpl) GetUserGroupIDs(ctx context.Context, tenantID uint32, userID string) ([]string, error) {
+	return r.groupRepo.ListUserGroupIDs(ctx, tenantID, userID)
+}
+
+func (r *resourceLookupImpl) IsPlatformAdmin(ctx context.Context, tenantID uint32, userID string) bool {
+	// Admin status is only known for the caller, so never extend it to other users
+	return grpcx.IsPlatformAdmin(ctx) && grpcx.GetUserIDFromContext(ctx) == userID
+}
+
+func (r *resourceLookupImpl) GetRequestAttributes(ctx context.Context) authz.RequestAttributes {
+	return service.RequestAttributesFromContext(ctx)
+}
+
 // permissionStoreAdapter adapts PermissionRepo to authz.PermissionStore
 type permissionStoreAdapter struct {
 	permRepo *data.PermissionRepo
@@ -64,8 +102,8 @@ func (a *permissionStoreAdapter) GetSubjectPermissions(ctx context.Context, tena
 	return a.permRepo.GetSubjectPermissions(ctx, tenantID, subjectType, subjectID)
 }
 
-func (a *permissionStoreAdapter) HasPermission(ctx context.Context, tenantID uint32, resourceType authz.ResourceType, resourceID string, subjectType authz.SubjectType, subjectID string) (*authz.PermissionTuple, error) {
-	return a.permRepo.HasAuthzPermission(ctx, tenantID, resourceType, resourceID, subjectType, subjectID)
+func (a *permissionStoreAdapter) FindPermissions(ctx context.Context, tenantID uint32, resourceType authz.ResourceType, resourceID string, subjectType authz.SubjectType, subjectID string) ([]authz.PermissionTuple, error) {
+	return a.permRepo.FindAuthzPermissions(ctx, tenantID, resourceType, resourceID, subjectType, subjectID)
 }
 
 func (a *permissionStoreAdapter) CreatePermission(ctx context.Context, tuple authz.PermissionTuple) (*authz.PermissionTuple, error) {
@@ -79,3 +117,31 @@ func (a *permissionStoreAdapter) DeletePermission(ctx context.Context, tenantID
 func (a *permissionStoreAdapter) ListResourcesBySubject(ctx context.Context, tenantID uint32, subjectType authz.SubjectType, subjectID string, resourceType authz.ResourceType) ([]string, error) {
 	return a.permRepo.ListResourcesBySubject(ctx, tenantID, subjectType, subjectID, resourceType)
 }
+
+// accessIndexAdapter adapts AccessIndexRepo to authz.AccessIndex
+type accessIndexAdapter struct {
+	repo *data.AccessIndexRepo
+}
+
+func (a *accessIndexAdapter) ListIndexedGrants(ctx context.Context, tenantID uint32, subjects []authz.Subject, resourceType authz.ResourceType) ([]authz.IndexedGrant, error) {
+	subjectIDs := make(map[string][]string)
+	for _, subject := range subjects {
+		subjectIDs[string(subject.Type)] = append(subjectIDs[string(subject.Type)], subject.ID)
+	}
+
+	entries, err := a.repo.ListBySubjects(ctx, tenantID, subjectIDs, string(resourceType))
+	if err != nil {
+		return nil, err
+	}
+
+	grants := make([]authz.IndexedGrant, 0, len(entries))
+	for _, e := range entries {
+		grants = append(grants, authz.IndexedGrant{
+			ResourceID: e.ResourceID,
+			Relation:   authz.Relation(e.Relation),
+			ExpiresAt:  e.ExpiresAt,
+			Conditions: e.Conditions,
+		})
+	}
+	return grants, nil
+}
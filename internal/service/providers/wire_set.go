@@ -18,9 +18,35 @@ var ProviderSet = wire.NewSet(
 	service.NewDocumentProcessor,
 	service.NewPermissionService,
 	service.NewStatisticsService,
+	service.NewStatisticsAggregator,
+	service.NewAuditService,
+	service.NewAuditRetention,
+	service.NewCategoryCountRepair,
+	service.NewDueDateReminder,
+	service.NewCategoryDeleteWorker,
+	service.NewTenantDeleteWorker,
+	service.NewHealthService,
+	service.NewWebhookService,
+	service.NewWebhookDispatcher,
+	service.NewTagService,
+	service.NewImportService,
+	service.NewImportSyncer,
+	service.NewSignatureService,
+	service.NewReviewService,
+	service.NewNotificationService,
+	service.NewGroupSyncer,
 	service.NewBackupService,
+	service.NewStorageGC,
+	service.NewStorageMigrator,
+	service.NewStorageTiering,
+	service.NewStorageService,
+	service.NewTenantService,
+	service.NewQuotaService,
+	service.NewSettingsService,
+	service.NewGraphQLService,
 	ProvideResourceLookup,
 	ProvidePermissionStore,
+	ProvideAccessIndex,
 	ProvideAuthzEngine,
 	ProvideAuthzChecker,
 )
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// QuotaService implements per-tenant quotas. Platform admins set the limits; tenants read
+// their own limits and usage.
+type QuotaService struct {
+	paperlessV1.UnimplementedPaperlessQuotaServiceServer
+
+	log       *log.Helper
+	quotaRepo *data.TenantQuotaRepo
+}
+
+// NewQuotaService creates a new QuotaService
+func NewQuotaService(ctx *bootstrap.Context, quotaRepo *data.TenantQuotaRepo) *QuotaService {
+	return &QuotaService{
+		log:       ctx.NewLoggerHelper("paperless/service/quota"),
+		quotaRepo: quotaRepo,
+	}
+}
+
+// GetTenantQuota returns a tenant's limits and usage
+func (s *QuotaService) GetTenantQuota(ctx context.Context, req *paperlessV1.GetTenantQuotaRequest) (*paperlessV1.GetTenantQuotaResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+
+	target := tenantID
+	if req.TenantId != nil && *req.TenantId != tenantID {
+		if !isPlatformAdmin(ctx) {
+			return nil, errPlatformAdminRequired("reading another tenant's quota requires platform admin access", "get_tenant_quota")
+		}
+		target = *req.TenantId
+	}
+
+	return s.tenantQuota(ctx, target)
+}
+
+// SetTenantQuota changes a tenant's limits
+func (s *QuotaService) SetTenantQuota(ctx context.Context, req *paperlessV1.SetTenantQuotaRequest) (*paperlessV1.SetTenantQuotaResponse, error) {
+	userID := getUserIDFromContext(ctx)
+
+	// Limits are a platform decision, so they need the caller's platform admin role rather than
+	// the admin bypass policy
+	if !isPlatformAdmin(ctx) {
+		return nil, errPlatformAdminRequired("setting tenant quotas requires platform admin access", "set_tenant_quota")
+	}
+	if req.TenantId == 0 {
+		return nil, paperlessV1.ErrorBadRequest("tenant_id is required")
+	}
+
+	if _, err := s.quotaRepo.Set(ctx, req.TenantId, req.MaxDocuments, req.MaxBytes, req.MaxMonthlyUploadBytes); err != nil {
+		return nil, err
+	}
+	s.log.Infof("quota of tenant %d set by user %s", req.TenantId, userID)
+
+	resp, err := s.tenantQuota(ctx, req.TenantId)
+	if err != nil {
+		return nil, err
+	}
+	return &paperlessV1.SetTenantQuotaResponse{
+		Quota: resp.Quota,
+	}, nil
+}
+
+// tenantQuota loads a tenant's limits together with its usage
+func (s *QuotaService) tenantQuota(ctx context.Context, tenantID uint32) (*paperlessV1.GetTenantQuotaResponse, error) {
+	quota, err := s.quotaRepo.Get(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	usage, err := s.quotaRepo.Usage(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &paperlessV1.GetTenantQuotaResponse{
+		Quota: s.quotaRepo.ToProto(tenantID, quota, usage),
+	}, nil
+}
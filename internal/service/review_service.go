@@ -0,0 +1,321 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/authz"
+	"github.com/go-tangra/go-tangra-paperless/internal/data"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/auditevent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/reviewtask"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// ReviewService asks users to review documents and records their sign-off. Every request,
+// decision and cancellation is audited, so the reviews of a document can be traced later.
+type ReviewService struct {
+	paperlessV1.UnimplementedPaperlessReviewServiceServer
+
+	log          *log.Helper
+	repo         *data.ReviewRepo
+	documentRepo *data.DocumentRepo
+	auditRepo    *data.AuditEventRepo
+	notifier     *NotificationService
+	checker      *authz.Checker
+}
+
+func NewReviewService(
+	ctx *bootstrap.Context,
+	repo *data.ReviewRepo,
+	documentRepo *data.DocumentRepo,
+	auditRepo *data.AuditEventRepo,
+	notifier *NotificationService,
+	checker *authz.Checker,
+) *ReviewService {
+	return &ReviewService{
+		log:          ctx.NewLoggerHelper("paperless/service/review"),
+		repo:         repo,
+		documentRepo: documentRepo,
+		auditRepo:    auditRepo,
+		notifier:     notifier,
+		checker:      checker,
+	}
+}
+
+// RequestReview asks a user who can read a document to review it
+func (s *ReviewService) RequestReview(ctx context.Context, req *paperlessV1.RequestReviewRequest) (*paperlessV1.RequestReviewResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	if err := s.checker.CanWriteDocument(ctx, tenantID, userID, req.GetDocumentId()); err != nil {
+		return nil, paperlessV1.ErrorAccessDenied("no write access to document")
+	}
+	if req.GetReviewerId() == 0 {
+		return nil, paperlessV1.ErrorBadRequest("reviewer is required")
+	}
+	reviewer := strconv.FormatUint(uint64(req.GetReviewerId()), 10)
+	if err := s.checker.CanReadDocument(ctx, tenantID, reviewer, req.GetDocumentId()); err != nil {
+		return nil, paperlessV1.ErrorBadRequest("user %s can't read the document", reviewer)
+	}
+
+	doc, err := s.documentRepo.GetByID(ctx, req.GetDocumentId())
+	if err != nil {
+		return nil, err
+	}
+	if doc == nil {
+		return nil, paperlessV1.ErrorDocumentNotFound("document not found")
+	}
+
+	pending, err := s.repo.HasPending(ctx, doc.ID, req.GetReviewerId())
+	if err != nil {
+		return nil, err
+	}
+	if pending {
+		return nil, paperlessV1.ErrorBadRequest("user %s already has a pending review of the document", reviewer)
+	}
+
+	entity, err := s.repo.Create(ctx, tenantID, doc.ID, req.GetReviewerId(), req.GetMessage(), getUserIDAsUint32(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	s.audit(ctx, doc, entity, "requested")
+	s.notifier.NotifyReviewRequest(ctx, tenantID, entity.ReviewerID, doc, req.GetMessage())
+
+	return &paperlessV1.RequestReviewResponse{
+		Review: s.repo.ToProto(entity),
+	}, nil
+}
+
+// CompleteReview records the caller's approval or rejection of a review requested from them,
+// together with the document version they reviewed
+func (s *ReviewService) CompleteReview(ctx context.Context, req *paperlessV1.CompleteReviewRequest) (*paperlessV1.CompleteReviewResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+
+	var status reviewtask.Status
+	switch req.GetDecision() {
+	case paperlessV1.ReviewStatus_REVIEW_STATUS_APPROVED:
+		status = reviewtask.StatusREVIEW_STATUS_APPROVED
+	case paperlessV1.ReviewStatus_REVIEW_STATUS_REJECTED:
+		status = reviewtask.StatusREVIEW_STATUS_REJECTED
+	default:
+		return nil, paperlessV1.ErrorBadRequest("decision must be REVIEW_STATUS_APPROVED or REVIEW_STATUS_REJECTED")
+	}
+
+	entity, err := s.getReview(ctx, tenantID, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	caller := getUserIDAsUint32(ctx)
+	if caller == nil || *caller != entity.ReviewerID {
+		return nil, paperlessV1.ErrorAccessDenied("only the reviewer can complete the review")
+	}
+	if err := s.checker.CanReadDocument(ctx, tenantID, getUserIDFromContext(ctx), entity.DocumentID); err != nil {
+		return nil, paperlessV1.ErrorAccessDenied("no read access to document")
+	}
+
+	doc, err := s.pendingReviewDocument(ctx, entity)
+	if err != nil {
+		return nil, err
+	}
+
+	entity, err = s.complete(ctx, entity.ID, status, req.GetComment(), &doc.Version, caller)
+	if err != nil {
+		return nil, err
+	}
+
+	s.audit(ctx, doc, entity, reviewEventName(status))
+
+	return &paperlessV1.CompleteReviewResponse{
+		Review: s.repo.ToProto(entity),
+	}, nil
+}
+
+// CancelReview withdraws a pending review. The user who requested it and users with write
+// access to the document can cancel it.
+func (s *ReviewService) CancelReview(ctx context.Context, req *paperlessV1.CancelReviewRequest) (*paperlessV1.CancelReviewResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+
+	entity, err := s.getReview(ctx, tenantID, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	caller := getUserIDAsUint32(ctx)
+	requester := caller != nil && entity.CreateBy != nil && *caller == *entity.CreateBy
+	if !requester {
+		if err := s.checker.CanWriteDocument(ctx, tenantID, getUserIDFromContext(ctx), entity.DocumentID); err != nil {
+			return nil, paperlessV1.ErrorAccessDenied("no write access to document")
+		}
+	}
+
+	doc, err := s.pendingReviewDocument(ctx, entity)
+	if err != nil {
+		return nil, err
+	}
+
+	entity, err = s.complete(ctx, entity.ID, reviewtask.StatusREVIEW_STATUS_CANCELLED, "", nil, caller)
+	if err != nil {
+		return nil, err
+	}
+
+	s.audit(ctx, doc, entity, reviewEventName(reviewtask.StatusREVIEW_STATUS_CANCELLED))
+
+	return &paperlessV1.CancelReviewResponse{
+		Review: s.repo.ToProto(entity),
+	}, nil
+}
+
+// GetReview gets a review of a document the caller can read
+func (s *ReviewService) GetReview(ctx context.Context, req *paperlessV1.GetReviewRequest) (*paperlessV1.GetReviewResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+
+	entity, err := s.getReview(ctx, tenantID, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checker.CanReadDocument(ctx, tenantID, getUserIDFromContext(ctx), entity.DocumentID); err != nil {
+		return nil, paperlessV1.ErrorAccessDenied("no read access to document")
+	}
+
+	return &paperlessV1.GetReviewResponse{
+		Review: s.repo.ToProto(entity),
+	}, nil
+}
+
+// ListDocumentReviews lists a document's reviews
+func (s *ReviewService) ListDocumentReviews(ctx context.Context, req *paperlessV1.ListDocumentReviewsRequest) (*paperlessV1.ListDocumentReviewsResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+
+	if err := s.checker.CanReadDocument(ctx, tenantID, getUserIDFromContext(ctx), req.GetDocumentId()); err != nil {
+		return nil, paperlessV1.ErrorAccessDenied("no read access to document")
+	}
+
+	page, pageSize := webhookPage(req.Page, req.PageSize)
+	entities, total, err := s.repo.ListByDocument(ctx, req.GetDocumentId(), reviewStatusFilter(req.Status), page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &paperlessV1.ListDocumentReviewsResponse{
+		Reviews: s.toProtos(entities),
+		Total:   uint32(total),
+	}, nil
+}
+
+// ListReviews lists the reviews requested from a user, by default the caller. Only reviews of
+// documents the caller can read are listed.
+func (s *ReviewService) ListReviews(ctx context.Context, req *paperlessV1.ListReviewsRequest) (*paperlessV1.ListReviewsResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	reviewerID := req.ReviewerId
+	if reviewerID == nil {
+		reviewerID = getUserIDAsUint32(ctx)
+	}
+	if reviewerID == nil {
+		return nil, paperlessV1.ErrorBadRequest("reviewer is required")
+	}
+
+	readableIDs, err := listReadableIDs(ctx, s.checker, tenantID, userID, authz.ResourceTypeDocument)
+	if err != nil {
+		return nil, err
+	}
+
+	page, pageSize := webhookPage(req.Page, req.PageSize)
+	entities, total, err := s.repo.ListByReviewer(ctx, tenantID, *reviewerID, reviewStatusFilter(req.Status), readableIDs, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &paperlessV1.ListReviewsResponse{
+		Reviews: s.toProtos(entities),
+		Total:   uint32(total),
+	}, nil
+}
+
+func (s *ReviewService) getReview(ctx context.Context, tenantID uint32, id string) (*ent.ReviewTask, error) {
+	entity, err := s.repo.Get(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, paperlessV1.ErrorNotFound("review not found")
+	}
+	return entity, nil
+}
+
+// pendingReviewDocument returns the document of a review that can still be completed
+func (s *ReviewService) pendingReviewDocument(ctx context.Context, entity *ent.ReviewTask) (*ent.Document, error) {
+	if entity.Status != reviewtask.StatusREVIEW_STATUS_PENDING {
+		return nil, paperlessV1.ErrorBadRequest("review is no longer pending")
+	}
+	doc, err := s.documentRepo.GetByID(ctx, entity.DocumentID)
+	if err != nil {
+		return nil, err
+	}
+	if doc == nil {
+		return nil, paperlessV1.ErrorDocumentNotFound("document not found")
+	}
+	return doc, nil
+}
+
+// complete closes a pending review, failing if another decision got there first
+func (s *ReviewService) complete(ctx context.Context, id string, status reviewtask.Status, comment string, reviewedVersion, completedBy *uint32) (*ent.ReviewTask, error) {
+	entity, err := s.repo.Complete(ctx, id, status, comment, reviewedVersion, completedBy)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, paperlessV1.ErrorBadRequest("review is no longer pending")
+	}
+	return entity, nil
+}
+
+// audit records a review event on the document's audit trail
+func (s *ReviewService) audit(ctx context.Context, doc *ent.Document, entity *ent.ReviewTask, event string) {
+	details := map[string]string{
+		"review":    event,
+		"review_id": entity.ID,
+		"reviewer":  strconv.FormatUint(uint64(entity.ReviewerID), 10),
+	}
+	if entity.ReviewedVersion != nil {
+		details["version"] = strconv.FormatUint(uint64(*entity.ReviewedVersion), 10)
+	}
+	recordAudit(ctx, s.auditRepo, auditevent.ActionAUDIT_ACTION_REVIEW, auditevent.ResourceTypeRESOURCE_TYPE_DOCUMENT, doc.ID, doc.Name, details)
+}
+
+func (s *ReviewService) toProtos(entities []*ent.ReviewTask) []*paperlessV1.ReviewTask {
+	reviews := make([]*paperlessV1.ReviewTask, 0, len(entities))
+	for _, e := range entities {
+		reviews = append(reviews, s.repo.ToProto(e))
+	}
+	return reviews
+}
+
+// reviewEventName names a review status in audit details
+func reviewEventName(status reviewtask.Status) string {
+	switch status {
+	case reviewtask.StatusREVIEW_STATUS_APPROVED:
+		return "approved"
+	case reviewtask.StatusREVIEW_STATUS_REJECTED:
+		return "rejected"
+	case reviewtask.StatusREVIEW_STATUS_CANCELLED:
+		return "cancelled"
+	default:
+		return "requested"
+	}
+}
+
+// reviewStatusFilter converts an optional status filter; unspecified lists every status
+func reviewStatusFilter(status *paperlessV1.ReviewStatus) *reviewtask.Status {
+	if status == nil || *status == paperlessV1.ReviewStatus_REVIEW_STATUS_UNSPECIFIED {
+		return nil
+	}
+	s := reviewtask.Status(status.String())
+	return &s
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// SettingsService implements the settings tenants override the service's defaults with
+type SettingsService struct {
+	paperlessV1.UnimplementedPaperlessSettingsServiceServer
+
+	log      *log.Helper
+	settings *data.TenantSettingsRepo
+	router   *data.StorageRouter
+}
+
+// NewSettingsService creates a new SettingsService
+func NewSettingsService(ctx *bootstrap.Context, settings *data.TenantSettingsRepo, router *data.StorageRouter) *SettingsService {
+	return &SettingsService{
+		log:      ctx.NewLoggerHelper("paperless/service/settings"),
+		settings: settings,
+		router:   router,
+	}
+}
+
+// GetTenantSettings returns the caller's tenant settings
+func (s *SettingsService) GetTenantSettings(ctx context.Context, _ *paperlessV1.GetTenantSettingsRequest) (*paperlessV1.GetTenantSettingsResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+
+	entity, err := s.settings.Get(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &paperlessV1.GetTenantSettingsResponse{
+		Settings: s.settings.ToProto(tenantID, entity),
+	}, nil
+}
+
+// UpdateTenantSettings changes the caller's tenant settings
+func (s *SettingsService) UpdateTenantSettings(ctx context.Context, req *paperlessV1.UpdateTenantSettingsRequest) (*paperlessV1.UpdateTenantSettingsResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	if !isTenantAdmin(ctx) {
+		return nil, errTenantAdminRequired("only tenant admins can change tenant settings", "update_tenant_settings")
+	}
+
+	if req.GetStorageBackend() != "" {
+		primary, target := s.router.Names()
+		if backend := req.GetStorageBackend(); backend != primary && backend != target {
+			return nil, paperlessV1.ErrorBadRequest("unknown storage backend %q", backend)
+		}
+	}
+
+	entity, err := s.settings.Update(ctx, tenantID, req)
+	if err != nil {
+		return nil, err
+	}
+	s.log.Infof("settings of tenant %d updated by user %s", tenantID, userID)
+
+	return &paperlessV1.UpdateTenantSettingsResponse{
+		Settings: s.settings.ToProto(tenantID, entity),
+	}, nil
+}
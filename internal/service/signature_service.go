@@ -0,0 +1,411 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"path"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	appViewer "github.com/go-tangra/go-tangra-common/viewer"
+	"github.com/go-tangra/go-tangra-paperless/internal/authz"
+	"github.com/go-tangra/go-tangra-paperless/internal/data"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/auditevent"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/document"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent/signaturerequest"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// ErrInvalidSignatureCallback rejects callbacks whose signature does not verify
+var ErrInvalidSignatureCallback = errors.New("invalid signature callback")
+
+// SignatureService sends documents for e-signature and attaches the signed PDF when the
+// provider reports the envelope completed
+type SignatureService struct {
+	paperlessV1.UnimplementedPaperlessSignatureServiceServer
+
+	log          *log.Helper
+	repo         *data.SignatureRepo
+	documentRepo *data.DocumentRepo
+	auditRepo    *data.AuditEventRepo
+	events       *data.EventPublisher
+	tx           *data.Transaction
+	storage      data.Storage
+	processor    *DocumentProcessor
+	checker      *authz.Checker
+	provider     data.SignatureProvider
+}
+
+func NewSignatureService(
+	ctx *bootstrap.Context,
+	repo *data.SignatureRepo,
+	documentRepo *data.DocumentRepo,
+	auditRepo *data.AuditEventRepo,
+	events *data.EventPublisher,
+	tx *data.Transaction,
+	storage data.Storage,
+	processor *DocumentProcessor,
+	checker *authz.Checker,
+	provider data.SignatureProvider,
+) *SignatureService {
+	return &SignatureService{
+		log:          ctx.NewLoggerHelper("paperless/service/signature"),
+		repo:         repo,
+		documentRepo: documentRepo,
+		auditRepo:    auditRepo,
+		events:       events,
+		tx:           tx,
+		storage:      storage,
+		processor:    processor,
+		checker:      checker,
+		provider:     provider,
+	}
+}
+
+// Enabled reports whether an e-signature provider is configured
+func (s *SignatureService) Enabled() bool {
+	return s.provider != nil
+}
+
+// CreateSignatureRequest sends a document's file to signers
+func (s *SignatureService) CreateSignatureRequest(ctx context.Context, req *paperlessV1.CreateSignatureRequestRequest) (*paperlessV1.CreateSignatureRequestResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	if s.provider == nil {
+		return nil, paperlessV1.ErrorServiceUnavailable("e-signatures are not configured")
+	}
+
+	// Signing replaces the document's file, so it needs write access
+	if err := s.checker.CanWriteDocument(ctx, tenantID, userID, req.GetDocumentId()); err != nil {
+		return nil, paperlessV1.ErrorAccessDenied("no write access to document")
+	}
+
+	signers, err := validateSigners(req.GetSigners())
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := s.documentRepo.GetByID(ctx, req.GetDocumentId())
+	if err != nil {
+		return nil, err
+	}
+	if doc == nil {
+		return nil, paperlessV1.ErrorDocumentNotFound("document not found")
+	}
+	if doc.ProcessingStatus == document.ProcessingStatusPROCESSING_STATUS_INFECTED {
+		return nil, errQuarantined()
+	}
+
+	active, err := s.repo.HasActive(ctx, doc.ID)
+	if err != nil {
+		return nil, err
+	}
+	if active {
+		return nil, paperlessV1.ErrorBadRequest("document is already out for signature")
+	}
+
+	content, err := s.storage.Download(ctx, doc.FileKey)
+	if err != nil {
+		if errors.Is(err, data.ErrObjectRestoring) {
+			return nil, paperlessV1.ErrorStorageUnavailable("document is being restored from cold storage, try again later")
+		}
+		s.log.Errorf("failed to download file: %v", err)
+		return nil, storageError(err, "failed to download file")
+	}
+
+	subject := req.GetSubject()
+	if subject == "" {
+		subject = truncateRunes("Please sign: "+doc.Name, 100)
+	}
+
+	envelopeID, err := s.provider.Send(ctx, &data.SignatureEnvelope{
+		FileName: doc.FileName,
+		Content:  content,
+		Subject:  subject,
+		Message:  req.GetMessage(),
+		Signers:  signers,
+	})
+	if err != nil {
+		s.log.Errorf("send document %s for signature failed: %v", doc.ID, err)
+		return nil, paperlessV1.ErrorServiceUnavailable("e-signature provider rejected the request")
+	}
+
+	entity, err := s.repo.Create(ctx, tenantID, doc.ID, s.provider.Name(), envelopeID, signers, subject, req.GetMessage(), doc.FileKey, getUserIDAsUint32(ctx))
+	if err != nil {
+		// Don't leave signers with an envelope nobody tracks
+		if voidErr := s.provider.Void(ctx, envelopeID, "Request could not be recorded"); voidErr != nil {
+			s.log.Warnf("failed to void untracked envelope %s: %v", envelopeID, voidErr)
+		}
+		return nil, err
+	}
+
+	return &paperlessV1.CreateSignatureRequestResponse{
+		SignatureRequest: s.repo.ToProto(entity),
+	}, nil
+}
+
+// GetSignatureRequest gets a signature request
+func (s *SignatureService) GetSignatureRequest(ctx context.Context, req *paperlessV1.GetSignatureRequestRequest) (*paperlessV1.GetSignatureRequestResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+
+	entity, err := s.getSignatureRequest(ctx, tenantID, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checker.CanReadDocument(ctx, tenantID, getUserIDFromContext(ctx), entity.DocumentID); err != nil {
+		return nil, paperlessV1.ErrorAccessDenied("no read access to document")
+	}
+
+	return &paperlessV1.GetSignatureRequestResponse{
+		SignatureRequest: s.repo.ToProto(entity),
+	}, nil
+}
+
+// ListSignatureRequests lists a document's signature requests
+func (s *SignatureService) ListSignatureRequests(ctx context.Context, req *paperlessV1.ListSignatureRequestsRequest) (*paperlessV1.ListSignatureRequestsResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+
+	if err := s.checker.CanReadDocument(ctx, tenantID, getUserIDFromContext(ctx), req.GetDocumentId()); err != nil {
+		return nil, paperlessV1.ErrorAccessDenied("no read access to document")
+	}
+
+	page, pageSize := webhookPage(req.Page, req.PageSize)
+	entities, total, err := s.repo.ListByDocument(ctx, req.GetDocumentId(), page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	requests := make([]*paperlessV1.SignatureRequest, 0, len(entities))
+	for _, e := range entities {
+		requests = append(requests, s.repo.ToProto(e))
+	}
+
+	return &paperlessV1.ListSignatureRequestsResponse{
+		SignatureRequests: requests,
+		Total:             uint32(total),
+	}, nil
+}
+
+// CancelSignatureRequest voids a signature request that is still out for signing
+func (s *SignatureService) CancelSignatureRequest(ctx context.Context, req *paperlessV1.CancelSignatureRequestRequest) (*paperlessV1.CancelSignatureRequestResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+
+	entity, err := s.getSignatureRequest(ctx, tenantID, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checker.CanWriteDocument(ctx, tenantID, getUserIDFromContext(ctx), entity.DocumentID); err != nil {
+		return nil, paperlessV1.ErrorAccessDenied("no write access to document")
+	}
+	if !signatureActive(entity.Status) {
+		return nil, paperlessV1.ErrorBadRequest("signature request is no longer out for signing")
+	}
+	if s.provider == nil || s.provider.Name() != entity.Provider {
+		return nil, paperlessV1.ErrorServiceUnavailable("e-signature provider %s is not configured", entity.Provider)
+	}
+
+	reason := req.GetReason()
+	if reason == "" {
+		reason = "Cancelled by the sender"
+	}
+	if err := s.provider.Void(ctx, entity.EnvelopeID, reason); err != nil {
+		s.log.Errorf("void signature request %s failed: %v", entity.ID, err)
+		return nil, paperlessV1.ErrorServiceUnavailable("e-signature provider could not void the request")
+	}
+
+	entity, err = s.repo.UpdateStatus(ctx, entity.ID, signaturerequest.StatusSIGNATURE_STATUS_VOIDED, nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &paperlessV1.CancelSignatureRequestResponse{
+		SignatureRequest: s.repo.ToProto(entity),
+	}, nil
+}
+
+// HandleCallback applies a status notification of the provider. It returns
+// ErrInvalidSignatureCallback for notifications that don't verify; other errors ask the
+// provider to retry.
+func (s *SignatureService) HandleCallback(ctx context.Context, header http.Header, body []byte) error {
+	if s.provider == nil {
+		return ErrInvalidSignatureCallback
+	}
+
+	callback, err := s.provider.ParseCallback(header, body)
+	if err != nil {
+		s.log.Warnf("rejected e-signature callback: %v", err)
+		return ErrInvalidSignatureCallback
+	}
+
+	// Callbacks have no caller, so they act as the system for ent privacy
+	ctx = appViewer.NewSystemViewerContext(ctx)
+
+	entity, err := s.repo.GetByEnvelope(ctx, s.provider.Name(), callback.EnvelopeID)
+	if err != nil {
+		return err
+	}
+	if entity == nil {
+		// Envelopes sent by other systems on the same account
+		s.log.Debugf("ignoring callback for unknown envelope %s", callback.EnvelopeID)
+		return nil
+	}
+	// Notifications can arrive late or twice; finished requests keep their outcome
+	if !signatureActive(entity.Status) {
+		return nil
+	}
+
+	if callback.Status == signaturerequest.StatusSIGNATURE_STATUS_COMPLETED {
+		if err := s.attachSigned(ctx, entity, callback.Signers); err != nil {
+			_ = s.repo.SetLastError(ctx, entity.ID, err.Error())
+			return err
+		}
+		return nil
+	}
+
+	status := entity.Status
+	// Sent never follows delivered
+	if callback.Status != "" && !(callback.Status == signaturerequest.StatusSIGNATURE_STATUS_SENT && status == signaturerequest.StatusSIGNATURE_STATUS_DELIVERED) {
+		status = callback.Status
+	}
+	_, err = s.repo.UpdateStatus(ctx, entity.ID, status, callback.Signers, "")
+	return err
+}
+
+// attachSigned replaces the document's file with the signed PDF. The unsigned original stays
+// in storage and is referenced by the signature request.
+func (s *SignatureService) attachSigned(ctx context.Context, request *ent.SignatureRequest, signers []schema.SignatureSigner) error {
+	doc, err := s.documentRepo.GetByID(ctx, request.DocumentID)
+	if err != nil {
+		return err
+	}
+	if doc == nil {
+		return nil
+	}
+
+	content, err := s.provider.DownloadSigned(ctx, request.EnvelopeID)
+	if err != nil {
+		s.log.Errorf("download signed document of request %s failed: %v", request.ID, err)
+		return err
+	}
+
+	tenantID := derefTenantID(doc.TenantID)
+	var categoryID string
+	if doc.CategoryID != nil {
+		categoryID = *doc.CategoryID
+	}
+	fileName := signedFileName(doc.FileName)
+
+	var undo compensations
+	uploadResult, err := s.storage.Upload(ctx, tenantID, categoryID, doc.ID, fileName, content, mimeTypePDF)
+	if err != nil {
+		s.log.Errorf("upload signed document of request %s failed: %v", request.ID, err)
+		return err
+	}
+	undo.add("delete signed file "+uploadResult.Key, func(ctx context.Context) error {
+		return s.storage.Delete(ctx, uploadResult.Key)
+	})
+
+	// Attributed to the tenant, since no user made the change
+	callerCtx := newCallerContext(ctx, tenantID, nil)
+	err = s.tx.InTx(callerCtx, func(ctx context.Context) error {
+		signed, err := s.documentRepo.ReplaceFile(ctx, doc.ID, uploadResult.Key, fileName, uploadResult.Size, mimeTypePDF, uploadResult.Checksum)
+		if err != nil {
+			return err
+		}
+		doc = signed
+		if _, err := s.repo.Complete(ctx, request.ID, uploadResult.Key, signers); err != nil {
+			return err
+		}
+
+		event := newDocumentEvent(ctx, signed)
+		event.SignatureRequestID = request.ID
+		return s.events.Publish(ctx, tenantID, signed.ID, EventDocumentSigned, event)
+	})
+	if err != nil {
+		undo.run(ctx, s.log)
+		return err
+	}
+
+	recordAudit(callerCtx, s.auditRepo, auditevent.ActionAUDIT_ACTION_UPDATE, auditevent.ResourceTypeRESOURCE_TYPE_DOCUMENT, doc.ID, doc.Name, map[string]string{
+		"signature_request_id": request.ID,
+		"file_name":            fileName,
+	})
+
+	// Extract the text of the signed PDF, which may carry new content such as signature pages
+	s.processor.Enqueue(appViewer.NewSystemViewerContext(context.Background()), tenantID, doc.ID, uploadResult.Key, mimeTypePDF)
+
+	s.log.Infof("attached signed PDF of request %s to document %s", request.ID, doc.ID)
+	return nil
+}
+
+// getSignatureRequest returns a signature request of the tenant, or a not found error
+func (s *SignatureService) getSignatureRequest(ctx context.Context, tenantID uint32, id string) (*ent.SignatureRequest, error) {
+	entity, err := s.repo.Get(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, paperlessV1.ErrorNotFound("signature request not found")
+	}
+	return entity, nil
+}
+
+// signatureActive reports whether a request is still out for signing
+func signatureActive(status signaturerequest.Status) bool {
+	return status == signaturerequest.StatusSIGNATURE_STATUS_SENT || status == signaturerequest.StatusSIGNATURE_STATUS_DELIVERED
+}
+
+// validateSigners requires one to ten distinct signers with a name and a valid email address
+func validateSigners(signers []*paperlessV1.Signer) ([]schema.SignatureSigner, error) {
+	if len(signers) == 0 || len(signers) > 10 {
+		return nil, paperlessV1.ErrorBadRequest("between 1 and 10 signers are required")
+	}
+
+	result := make([]schema.SignatureSigner, 0, len(signers))
+	seen := make(map[string]bool, len(signers))
+	for _, signer := range signers {
+		name := strings.TrimSpace(signer.GetName())
+		if name == "" {
+			return nil, paperlessV1.ErrorBadRequest("signer name is required")
+		}
+		addr, err := mail.ParseAddress(signer.GetEmail())
+		if err != nil || addr.Address != signer.GetEmail() {
+			return nil, paperlessV1.ErrorBadRequest("invalid signer email %q", signer.GetEmail())
+		}
+		key := strings.ToLower(addr.Address)
+		if seen[key] {
+			return nil, paperlessV1.ErrorBadRequest("duplicate signer email %q", signer.GetEmail())
+		}
+		seen[key] = true
+
+		result = append(result, schema.SignatureSigner{Name: name, Email: addr.Address})
+	}
+	return result, nil
+}
+
+// signedFileName names the signed PDF after the original file, e.g. "contract (signed).pdf"
+func signedFileName(fileName string) string {
+	base := strings.TrimSuffix(fileName, path.Ext(fileName))
+	if base == "" {
+		base = "document"
+	}
+	return fmt.Sprintf("%s (signed).pdf", base)
+}
+
+// truncateRunes shortens s to at most n characters
+func truncateRunes(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n])
+}
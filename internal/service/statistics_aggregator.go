@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultStatisticsRollupInterval is how often the statistics rollups are recomputed
+const defaultStatisticsRollupInterval = 5 * time.Minute
+
+// StatisticsAggregator precomputes the statistics of every tenant, and of all tenants, into
+// rollup rows which GetStatistics serves instead of running the aggregate queries for each
+// dashboard. It runs as an app server every PAPERLESS_STATISTICS_ROLLUP_INTERVAL (default 5m,
+// "0" disables it and statistics are computed on request again).
+type StatisticsAggregator struct {
+	backgroundJob
+
+	log   *log.Helper
+	stats *StatisticsService
+}
+
+// NewStatisticsAggregator creates a StatisticsAggregator running at the rollup interval of stats
+func NewStatisticsAggregator(ctx *bootstrap.Context, stats *StatisticsService) *StatisticsAggregator {
+	l := ctx.NewLoggerHelper("paperless/service/statistics_aggregator")
+
+	a := &StatisticsAggregator{
+		backgroundJob: backgroundJob{
+			interval: stats.rollupInterval,
+			// Rollups missing after a deployment would leave requests computing live until the first tick
+			immediate: true,
+			log:       l,
+			name:      "precomputing statistics",
+		},
+		log:   l,
+		stats: stats,
+	}
+	a.tick = a.Aggregate
+	return a
+}
+
+// Aggregate recomputes the rollup of every tenant with documents or categories and the rollup
+// of all tenants. Rollups another replica refreshed within the last half interval are skipped,
+// so replicas share the work instead of each running every query.
+func (a *StatisticsAggregator) Aggregate(ctx context.Context) {
+	tenantIDs, err := a.stats.statsRepo.ListTenantIDs(ctx)
+	if err != nil {
+		a.log.Errorf("list statistics tenants failed: %s", err.Error())
+		return
+	}
+
+	scopes := make([]*uint32, 0, len(tenantIDs)+1)
+	for i := range tenantIDs {
+		scopes = append(scopes, &tenantIDs[i])
+	}
+	scopes = append(scopes, nil)
+
+	for _, scope := range scopes {
+		if ctx.Err() != nil {
+			return
+		}
+		a.refresh(ctx, scope)
+	}
+}
+
+// refresh recomputes the rollup of scope unless it is recent enough
+func (a *StatisticsAggregator) refresh(ctx context.Context, scope *uint32) {
+	name := "all tenants"
+	if scope != nil {
+		name = fmt.Sprintf("tenant %d", *scope)
+	}
+
+	rollup, err := a.stats.statsRepo.GetRollup(ctx, scope)
+	if err != nil {
+		a.log.Warnf("get statistics rollup of %s failed: %s", name, err.Error())
+	} else if rollup != nil && time.Since(rollup.GeneratedAt) < a.interval/2 {
+		return
+	}
+
+	// Store the most top tags a request may ask for; smaller limits are cut from them
+	response := a.stats.computeStatistics(ctx, scope, maxTopTagsLimit)
+	if response.Documents == nil || response.Categories == nil {
+		// Partial results would be served until the next run; the previous rollup is kept
+		return
+	}
+
+	statistics, err := proto.Marshal(response)
+	if err != nil {
+		a.log.Errorf("encode statistics of %s failed: %s", name, err.Error())
+		return
+	}
+	if err := a.stats.statsRepo.SaveRollup(ctx, scope, statistics, response.GeneratedAt.AsTime()); err != nil {
+		a.log.Errorf("save statistics rollup of %s failed: %s", name, err.Error())
+	}
+}
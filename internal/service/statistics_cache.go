@@ -0,0 +1,83 @@
+package service
+
+import (
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// defaultStatisticsCacheTTL is how long computed statistics are served before they are recomputed
+const defaultStatisticsCacheTTL = time.Minute
+
+// statisticsCacheKey identifies one cached statistics result
+type statisticsCacheKey struct {
+	allTenants   bool
+	tenantID     uint32
+	topTagsLimit int
+}
+
+type statisticsCacheEntry struct {
+	response  *paperlessV1.GetStatisticsResponse
+	expiresAt time.Time
+}
+
+// statisticsCache keeps computed statistics per scope for a short TTL. A zero TTL disables it.
+type statisticsCache struct {
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[statisticsCacheKey]statisticsCacheEntry
+}
+
+func newStatisticsCache(ttl time.Duration) *statisticsCache {
+	return &statisticsCache{
+		ttl:     ttl,
+		entries: make(map[statisticsCacheKey]statisticsCacheEntry),
+	}
+}
+
+func newStatisticsCacheKey(scope *uint32, topTagsLimit int) statisticsCacheKey {
+	if scope == nil {
+		return statisticsCacheKey{allTenants: true, topTagsLimit: topTagsLimit}
+	}
+	return statisticsCacheKey{tenantID: *scope, topTagsLimit: topTagsLimit}
+}
+
+// get returns a copy of the cached statistics, so callers may modify it
+func (c *statisticsCache) get(key statisticsCacheKey) (*paperlessV1.GetStatisticsResponse, bool) {
+	if c.ttl <= 0 {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+	return proto.Clone(entry.response).(*paperlessV1.GetStatisticsResponse), true
+}
+
+// put stores a copy of response and drops expired entries
+func (c *statisticsCache) put(key statisticsCacheKey, response *paperlessV1.GetStatisticsResponse) {
+	if c.ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for k, entry := range c.entries {
+		if now.After(entry.expiresAt) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = statisticsCacheEntry{
+		response:  proto.Clone(response).(*paperlessV1.GetStatisticsResponse),
+		expiresAt: now.Add(c.ttl),
+	}
+}
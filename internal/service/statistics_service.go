@@ -2,10 +2,13 @@ package service
 
 import (
 	"context"
+	"os"
+	"sort"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/go-tangra/go-tangra-paperless/internal/data"
@@ -17,36 +20,159 @@ type StatisticsService struct {
 	paperlessV1.UnimplementedPaperlessStatisticsServiceServer
 
 	statsRepo *data.StatisticsRepo
+	processor *DocumentProcessor
+	cache     *statisticsCache
 	log       *log.Helper
+
+	// rollupInterval is how often the StatisticsAggregator precomputes statistics, 0 if it doesn't
+	rollupInterval time.Duration
 }
 
-// NewStatisticsService creates a new StatisticsService
-func NewStatisticsService(ctx *bootstrap.Context, statsRepo *data.StatisticsRepo) *StatisticsService {
+// NewStatisticsService creates a new StatisticsService. Computed statistics are cached
+// for PAPERLESS_STATISTICS_CACHE_TTL (default 1m, 0 disables caching). Statistics precomputed
+// every PAPERLESS_STATISTICS_ROLLUP_INTERVAL are served instead of computing them, see
+// StatisticsAggregator.
+func NewStatisticsService(ctx *bootstrap.Context, statsRepo *data.StatisticsRepo, processor *DocumentProcessor) *StatisticsService {
+	l := ctx.NewLoggerHelper("paperless/service/statistics")
+
+	ttl := defaultStatisticsCacheTTL
+	if v := os.Getenv("PAPERLESS_STATISTICS_CACHE_TTL"); v != "" {
+		parsed, err := time.ParseDuration(v)
+		if err != nil || parsed < 0 {
+			l.Warnf("invalid PAPERLESS_STATISTICS_CACHE_TTL %q, using %s", v, defaultStatisticsCacheTTL)
+		} else {
+			ttl = parsed
+		}
+	}
+
+	rollupInterval := defaultStatisticsRollupInterval
+	if v := os.Getenv("PAPERLESS_STATISTICS_ROLLUP_INTERVAL"); v != "" {
+		parsed, err := time.ParseDuration(v)
+		if err != nil || parsed < 0 {
+			l.Warnf("invalid PAPERLESS_STATISTICS_ROLLUP_INTERVAL %q, using %s", v, defaultStatisticsRollupInterval)
+		} else {
+			rollupInterval = parsed
+		}
+	}
+
 	return &StatisticsService{
-		statsRepo: statsRepo,
-		log:       ctx.NewLoggerHelper("paperless/service/statistics"),
+		statsRepo:      statsRepo,
+		processor:      processor,
+		cache:          newStatisticsCache(ttl),
+		log:            l,
+		rollupInterval: rollupInterval,
 	}
 }
 
-// GetStatistics returns comprehensive statistics about the Paperless system
+const (
+	defaultTopTagsLimit = 20
+	maxTopTagsLimit     = 100
+)
+
+// maxTimeSeriesPoints caps the number of buckets a single time series request may return
+const maxTimeSeriesPoints = 1000
+
+// statisticsScope returns the tenant statistics are computed for, or nil for all tenants.
+// Statistics cover the caller's tenant; other tenants and the platform-wide view need the
+// caller's platform admin role, whatever the admin bypass policy.
+func (s *StatisticsService) statisticsScope(ctx context.Context, requested *uint32) (*uint32, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	if requested == nil || *requested == tenantID {
+		return &tenantID, nil
+	}
+	if !isPlatformAdmin(ctx) {
+		return nil, errPlatformAdminRequired("statistics of other tenants require platform admin access", "read_tenant_statistics")
+	}
+	if *requested == 0 {
+		return nil, nil
+	}
+	return requested, nil
+}
+
+// GetStatistics returns comprehensive statistics about the Paperless system. Precomputed
+// statistics are served when they are recent; ForceRefresh computes them from the documents.
 func (s *StatisticsService) GetStatistics(ctx context.Context, req *paperlessV1.GetStatisticsRequest) (*paperlessV1.GetStatisticsResponse, error) {
+	scope, err := s.statisticsScope(ctx, req.TenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := defaultTopTagsLimit
+	if req.TopTagsLimit != nil {
+		limit = min(int(req.GetTopTagsLimit()), maxTopTagsLimit)
+	}
+
+	key := newStatisticsCacheKey(scope, limit)
+	if !req.GetForceRefresh() {
+		if response, ok := s.cache.get(key); ok {
+			return response, nil
+		}
+	}
+
+	var response *paperlessV1.GetStatisticsResponse
+	if !req.GetForceRefresh() {
+		response = s.rollupStatistics(ctx, scope, limit)
+	}
+	if response == nil {
+		response = s.computeStatistics(ctx, scope, limit)
+	}
+	// Partial results from a failed query are not worth serving again
+	if response.Documents != nil && response.Categories != nil {
+		s.cache.put(key, response)
+	}
+	return response, nil
+}
+
+// rollupStatistics returns the statistics the StatisticsAggregator precomputed for scope, with
+// at most topTagsLimit top tags. It returns nil when there are none or they are older than two
+// aggregation intervals, e.g. because the aggregator stopped, so the caller computes them.
+func (s *StatisticsService) rollupStatistics(ctx context.Context, scope *uint32, topTagsLimit int) *paperlessV1.GetStatisticsResponse {
+	if s.rollupInterval <= 0 {
+		return nil
+	}
+
+	rollup, err := s.statsRepo.GetRollup(ctx, scope)
+	if err != nil {
+		s.log.Warnf("failed to get statistics rollup: %v", err)
+		return nil
+	}
+	if rollup == nil || time.Since(rollup.GeneratedAt) > 2*s.rollupInterval {
+		return nil
+	}
+
+	response := &paperlessV1.GetStatisticsResponse{}
+	if err := proto.Unmarshal(rollup.Statistics, response); err != nil {
+		s.log.Warnf("failed to decode statistics rollup: %v", err)
+		return nil
+	}
+	if response.Documents != nil && len(response.Documents.TopTags) > topTagsLimit {
+		response.Documents.TopTags = response.Documents.TopTags[:topTagsLimit]
+	}
+	return response
+}
+
+// computeStatistics computes the statistics of scope, leaving out the sections whose queries fail
+func (s *StatisticsService) computeStatistics(ctx context.Context, scope *uint32, topTagsLimit int) *paperlessV1.GetStatisticsResponse {
 	response := &paperlessV1.GetStatisticsResponse{
 		GeneratedAt: timestamppb.Now(),
 	}
+	if scope != nil {
+		response.TenantId = *scope
+	}
 
 	// Get document statistics
-	docStats, err := s.statsRepo.GetDocumentStats(ctx)
+	docStats, err := s.statsRepo.GetDocumentStats(ctx, scope)
 	if err != nil {
 		s.log.Errorf("Failed to get document stats: %v", err)
 	} else {
 		last24Hours := time.Now().Add(-24 * time.Hour)
 		last7Days := time.Now().Add(-7 * 24 * time.Hour)
 
-		recentUploads24h, err := s.statsRepo.GetDocumentTimeStats(ctx, last24Hours)
+		recentUploads24h, err := s.statsRepo.GetDocumentTimeStats(ctx, scope, last24Hours)
 		if err != nil {
 			s.log.Warnf("failed to get 24h document time stats: %v", err)
 		}
-		recentUploads7d, err := s.statsRepo.GetDocumentTimeStats(ctx, last7Days)
+		recentUploads7d, err := s.statsRepo.GetDocumentTimeStats(ctx, scope, last7Days)
 		if err != nil {
 			s.log.Warnf("failed to get 7d document time stats: %v", err)
 		}
@@ -61,10 +187,23 @@ func (s *StatisticsService) GetStatistics(ctx context.Context, req *paperlessV1.
 			RecentUploads_24H:  recentUploads24h,
 			RecentUploads_7D:   recentUploads7d,
 		}
+
+		if topTagsLimit > 0 {
+			topTags, err := s.statsRepo.GetTopTags(ctx, scope, topTagsLimit)
+			if err != nil {
+				s.log.Warnf("failed to get top tags: %v", err)
+			}
+			for _, t := range topTags {
+				response.Documents.TopTags = append(response.Documents.TopTags, &paperlessV1.TagCount{
+					Tag:           t.Tag,
+					DocumentCount: t.Count,
+				})
+			}
+		}
 	}
 
 	// Get category statistics
-	categoryCount, err := s.statsRepo.GetCategoryStats(ctx)
+	categoryCount, err := s.statsRepo.GetCategoryStats(ctx, scope)
 	if err != nil {
 		s.log.Errorf("Failed to get category stats: %v", err)
 	} else {
@@ -73,5 +212,197 @@ func (s *StatisticsService) GetStatistics(ctx context.Context, req *paperlessV1.
 		}
 	}
 
-	return response, nil
+	return response
+}
+
+// GetUploadTimeSeries returns document uploads per day, week or month over a time window
+func (s *StatisticsService) GetUploadTimeSeries(ctx context.Context, req *paperlessV1.GetUploadTimeSeriesRequest) (*paperlessV1.GetUploadTimeSeriesResponse, error) {
+	scope, err := s.statisticsScope(ctx, req.TenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	interval := req.GetInterval()
+	if interval == paperlessV1.TimeSeriesInterval_TIME_SERIES_INTERVAL_UNSPECIFIED {
+		interval = paperlessV1.TimeSeriesInterval_TIME_SERIES_INTERVAL_DAY
+	}
+
+	points, err := s.uploadTimeSeries(ctx, scope, interval, req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &paperlessV1.GetUploadTimeSeriesResponse{
+		Interval: interval,
+		Points:   points,
+	}
+	if scope != nil {
+		resp.TenantId = *scope
+	}
+	return resp, nil
+}
+
+// uploadTimeSeries returns one point per interval bucket between startTime and endTime,
+// defaulting to a window that ends now
+func (s *StatisticsService) uploadTimeSeries(ctx context.Context, scope *uint32, interval paperlessV1.TimeSeriesInterval, startTime, endTime *timestamppb.Timestamp) ([]*paperlessV1.UploadTimeSeriesPoint, error) {
+	var (
+		unit string
+		step func(time.Time) time.Time
+	)
+	end := time.Now().UTC()
+	if endTime != nil {
+		end = endTime.AsTime().UTC()
+	}
+	var start time.Time
+	switch interval {
+	case paperlessV1.TimeSeriesInterval_TIME_SERIES_INTERVAL_DAY:
+		unit = "day"
+		step = func(t time.Time) time.Time { return t.AddDate(0, 0, 1) }
+		start = end.AddDate(0, 0, -30)
+	case paperlessV1.TimeSeriesInterval_TIME_SERIES_INTERVAL_WEEK:
+		unit = "week"
+		step = func(t time.Time) time.Time { return t.AddDate(0, 0, 7) }
+		start = end.AddDate(0, 0, -7*12)
+	case paperlessV1.TimeSeriesInterval_TIME_SERIES_INTERVAL_MONTH:
+		unit = "month"
+		step = func(t time.Time) time.Time { return t.AddDate(0, 1, 0) }
+		start = end.AddDate(0, -12, 0)
+	default:
+		return nil, paperlessV1.ErrorBadRequest("unknown time series interval")
+	}
+	if startTime != nil {
+		start = startTime.AsTime().UTC()
+	}
+	if !start.Before(end) {
+		return nil, paperlessV1.ErrorBadRequest("start_time must be before end_time")
+	}
+
+	// Align the window to whole buckets so the first and last points are complete
+	first := truncateToInterval(start, interval)
+	var points []*paperlessV1.UploadTimeSeriesPoint
+	for t := first; t.Before(end); t = step(t) {
+		if len(points) == maxTimeSeriesPoints {
+			return nil, paperlessV1.ErrorBadRequest("time window exceeds %d buckets", maxTimeSeriesPoints)
+		}
+		points = append(points, &paperlessV1.UploadTimeSeriesPoint{BucketStart: timestamppb.New(t)})
+	}
+	last := step(points[len(points)-1].BucketStart.AsTime())
+
+	buckets, err := s.statsRepo.GetUploadTimeSeries(ctx, scope, unit, first, last)
+	if err != nil {
+		s.log.Errorf("get upload time series failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("get upload time series failed")
+	}
+
+	index := make(map[time.Time]*paperlessV1.UploadTimeSeriesPoint, len(points))
+	for _, p := range points {
+		index[p.BucketStart.AsTime()] = p
+	}
+	for _, b := range buckets {
+		if p, ok := index[b.Start]; ok {
+			p.DocumentCount = b.Count
+			p.TotalBytes = b.TotalBytes
+		}
+	}
+	return points, nil
+}
+
+// truncateToInterval returns the start of the UTC bucket containing t; weeks start on Monday
+func truncateToInterval(t time.Time, interval paperlessV1.TimeSeriesInterval) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	switch interval {
+	case paperlessV1.TimeSeriesInterval_TIME_SERIES_INTERVAL_WEEK:
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	case paperlessV1.TimeSeriesInterval_TIME_SERIES_INTERVAL_MONTH:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return day
+	}
+}
+
+// GetTenantUsageReport returns document and storage usage of every tenant. It exposes
+// all tenants, so it is restricted to platform admins.
+func (s *StatisticsService) GetTenantUsageReport(ctx context.Context, _ *paperlessV1.GetTenantUsageReportRequest) (*paperlessV1.GetTenantUsageReportResponse, error) {
+	if !isPlatformAdmin(ctx) {
+		return nil, errPlatformAdminRequired("tenant usage report requires platform admin access", "get_tenant_usage_report")
+	}
+
+	now := time.Now()
+	totals, err := s.statsRepo.GetDocumentUsageByTenant(ctx, time.Time{})
+	if err != nil {
+		s.log.Errorf("get tenant document usage failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("get tenant usage failed")
+	}
+	recent7d, err := s.statsRepo.GetDocumentUsageByTenant(ctx, now.Add(-7*24*time.Hour))
+	if err != nil {
+		s.log.Errorf("get 7d tenant document usage failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("get tenant usage failed")
+	}
+	recent30d, err := s.statsRepo.GetDocumentUsageByTenant(ctx, now.Add(-30*24*time.Hour))
+	if err != nil {
+		s.log.Errorf("get 30d tenant document usage failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("get tenant usage failed")
+	}
+	categories, err := s.statsRepo.GetCategoryCountByTenant(ctx)
+	if err != nil {
+		s.log.Errorf("get tenant category counts failed: %s", err.Error())
+		return nil, paperlessV1.ErrorInternalServerError("get tenant usage failed")
+	}
+
+	// Tenants with only categories are reported too
+	tenantIDs := make(map[uint32]struct{}, len(totals))
+	for id := range totals {
+		tenantIDs[id] = struct{}{}
+	}
+	for id := range categories {
+		tenantIDs[id] = struct{}{}
+	}
+
+	resp := &paperlessV1.GetTenantUsageReportResponse{
+		GeneratedAt: timestamppb.New(now),
+	}
+	for id := range tenantIDs {
+		usage := &paperlessV1.TenantUsage{
+			TenantId:        id,
+			DocumentCount:   totals[id].Count,
+			StorageBytes:    totals[id].TotalBytes,
+			CategoryCount:   categories[id],
+			Uploads_7D:      recent7d[id].Count,
+			UploadBytes_7D:  recent7d[id].TotalBytes,
+			Uploads_30D:     recent30d[id].Count,
+			UploadBytes_30D: recent30d[id].TotalBytes,
+		}
+		resp.Tenants = append(resp.Tenants, usage)
+		resp.TotalDocumentCount += usage.DocumentCount
+		resp.TotalStorageBytes += usage.StorageBytes
+	}
+	sort.Slice(resp.Tenants, func(i, j int) bool {
+		a, b := resp.Tenants[i], resp.Tenants[j]
+		if a.StorageBytes != b.StorageBytes {
+			return a.StorageBytes > b.StorageBytes
+		}
+		return a.TenantId < b.TenantId
+	})
+
+	return resp, nil
+}
+
+// GetProcessingQueueStatus returns the backlog of this instance's document processing queue.
+// The queue holds documents of all tenants, so it is restricted to platform admins.
+func (s *StatisticsService) GetProcessingQueueStatus(ctx context.Context, _ *paperlessV1.GetProcessingQueueStatusRequest) (*paperlessV1.GetProcessingQueueStatusResponse, error) {
+	if !isPlatformAdmin(ctx) {
+		return nil, errPlatformAdminRequired("processing queue status requires platform admin access", "get_processing_queue_status")
+	}
+
+	status := s.processor.QueueStatus()
+	return &paperlessV1.GetProcessingQueueStatusResponse{
+		QueueDepth:              uint32(status.QueueDepth),
+		InFlight:                uint32(status.InFlight),
+		RetryWaiting:            uint32(status.RetryWaiting),
+		TotalRetries:            status.TotalRetries,
+		OldestPendingAgeSeconds: uint64(status.OldestPending.Seconds()),
+		Workers:                 uint32(status.Workers),
+		MaxRetries:              uint32(status.MaxRetries),
+		GeneratedAt:             timestamppb.Now(),
+	}, nil
 }
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// defaultGCMinAge protects uploads whose document row has not been written yet
+const defaultGCMinAge = time.Hour
+
+// StorageGC finds and removes stored objects that no document references.
+// Such orphans are left behind by failed CreateDocument calls and crashed processes.
+// When PAPERLESS_STORAGE_GC_INTERVAL is set it also runs periodically as an app server.
+type StorageGC struct {
+	backgroundJob
+
+	log          *log.Helper
+	storage      data.Storage
+	documentRepo *data.DocumentRepo
+
+	minAge time.Duration
+	dryRun bool
+
+	// running serializes collections so manual and periodic runs don't overlap
+	running sync.Mutex
+}
+
+// NewStorageGC creates a StorageGC configured by PAPERLESS_STORAGE_GC_INTERVAL,
+// PAPERLESS_STORAGE_GC_MIN_AGE and PAPERLESS_STORAGE_GC_DRY_RUN
+func NewStorageGC(ctx *bootstrap.Context, storage data.Storage, documentRepo *data.DocumentRepo) *StorageGC {
+	l := ctx.NewLoggerHelper("paperless/service/storage_gc")
+
+	gc := &StorageGC{
+		backgroundJob: backgroundJob{log: l},
+		log:           l,
+		storage:       storage,
+		documentRepo:  documentRepo,
+		minAge:        defaultGCMinAge,
+		dryRun:        os.Getenv("PAPERLESS_STORAGE_GC_DRY_RUN") == "true",
+	}
+
+	if v := os.Getenv("PAPERLESS_STORAGE_GC_INTERVAL"); v != "" {
+		interval, err := time.ParseDuration(v)
+		if err != nil || interval <= 0 {
+			l.Warnf("invalid PAPERLESS_STORAGE_GC_INTERVAL %q, periodic storage GC disabled", v)
+		} else {
+			gc.interval = interval
+		}
+	}
+	if v := os.Getenv("PAPERLESS_STORAGE_GC_MIN_AGE"); v != "" {
+		minAge, err := time.ParseDuration(v)
+		if err != nil || minAge < 0 {
+			l.Warnf("invalid PAPERLESS_STORAGE_GC_MIN_AGE %q, using %s", v, defaultGCMinAge)
+		} else {
+			gc.minAge = minAge
+		}
+	}
+
+	gc.name = fmt.Sprintf("periodic storage GC (min age %s, dry run %t)", gc.minAge, gc.dryRun)
+	gc.tick = gc.collectPeriodically
+
+	return gc
+}
+
+// collectPeriodically runs a collection of every tenant with the configured minimum age and dry run
+func (gc *StorageGC) collectPeriodically(ctx context.Context) {
+	report := gc.Collect(ctx, nil, gc.minAge, gc.dryRun)
+	gc.log.Infof("storage GC scanned %d objects, found %d orphans, deleted %d (%d bytes), %d errors",
+		report.ScannedObjects, len(report.Orphans), report.DeletedObjects, report.ReclaimedBytes, len(report.Errors))
+}
+
+// Collect scans the given tenant (every tenant owning documents when nil) for orphaned
+// objects older than minAge and deletes them unless dryRun is set.
+// Failures are recorded in the report rather than aborting the run.
+func (gc *StorageGC) Collect(ctx context.Context, tenantID *uint32, minAge time.Duration, dryRun bool) *paperlessV1.CollectOrphanedObjectsResponse {
+	gc.running.Lock()
+	defer gc.running.Unlock()
+
+	report := &paperlessV1.CollectOrphanedObjectsResponse{}
+
+	var tenantIDs []uint32
+	if tenantID != nil {
+		tenantIDs = []uint32{*tenantID}
+	} else {
+		var err error
+		if tenantIDs, err = gc.documentRepo.ListTenantIDs(ctx); err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("list tenants: %v", err))
+			return report
+		}
+	}
+
+	cutoff := time.Now().Add(-minAge)
+	for _, id := range tenantIDs {
+		if ctx.Err() != nil {
+			report.Errors = append(report.Errors, ctx.Err().Error())
+			break
+		}
+		gc.collectTenant(ctx, id, cutoff, dryRun, report)
+	}
+
+	return report
+}
+
+// collectTenant scans the {tenant_id}/ prefix of a single tenant
+func (gc *StorageGC) collectTenant(ctx context.Context, tenantID uint32, cutoff time.Time, dryRun bool, report *paperlessV1.CollectOrphanedObjectsResponse) {
+	objects, err := gc.storage.List(ctx, fmt.Sprintf("%d/", tenantID))
+	if err != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("tenant %d: list objects: %v", tenantID, err))
+		return
+	}
+	report.ScannedObjects += int64(len(objects))
+
+	var candidates []data.ObjectInfo
+	for _, obj := range objects {
+		if obj.LastModified.Before(cutoff) {
+			candidates = append(candidates, obj)
+		}
+	}
+	if len(candidates) == 0 {
+		return
+	}
+
+	keys := make([]string, 0, len(candidates))
+	for _, obj := range candidates {
+		keys = append(keys, obj.Key)
+	}
+	referenced, err := gc.documentRepo.ReferencedFileKeys(ctx, tenantID, keys)
+	if err != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("tenant %d: query documents: %v", tenantID, err))
+		return
+	}
+
+	orphans := 0
+	for _, obj := range candidates {
+		if referenced[obj.Key] {
+			continue
+		}
+		orphans++
+
+		orphan := &paperlessV1.OrphanedObject{
+			TenantId:     tenantID,
+			Key:          obj.Key,
+			Size:         obj.Size,
+			LastModified: timestamppb.New(obj.LastModified),
+		}
+		report.Orphans = append(report.Orphans, orphan)
+
+		if dryRun {
+			continue
+		}
+		if err := gc.storage.Delete(ctx, obj.Key); err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("delete %s: %v", obj.Key, err))
+			continue
+		}
+		orphan.Deleted = true
+		report.DeletedObjects++
+		report.ReclaimedBytes += obj.Size
+	}
+
+	if orphans > 0 {
+		gc.log.Infof("tenant %d: %d orphaned objects (dry run %t)", tenantID, orphans, dryRun)
+	}
+}
@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	appViewer "github.com/go-tangra/go-tangra-common/viewer"
+	"github.com/go-tangra/go-tangra-paperless/internal/data"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+const (
+	// migrationBatchSize is the number of documents loaded per query
+	migrationBatchSize = 200
+	// maxMigrationErrors caps the errors kept in the migration status
+	maxMigrationErrors = 100
+)
+
+// StorageMigrator copies all document objects from the active storage backend to the
+// configured migration target, then optionally makes the target the active backend.
+// Reads fall back across both backends throughout, so no object is unreachable at any point.
+type StorageMigrator struct {
+	log          *log.Helper
+	router       *data.StorageRouter
+	documentRepo *data.DocumentRepo
+
+	mu     sync.Mutex
+	status *paperlessV1.StorageMigrationStatus
+}
+
+// NewStorageMigrator creates a new StorageMigrator
+func NewStorageMigrator(ctx *bootstrap.Context, router *data.StorageRouter, documentRepo *data.DocumentRepo) *StorageMigrator {
+	return &StorageMigrator{
+		log:          ctx.NewLoggerHelper("paperless/service/storage_migration"),
+		router:       router,
+		documentRepo: documentRepo,
+		status:       &paperlessV1.StorageMigrationStatus{},
+	}
+}
+
+// Start begins a migration in the background and returns its initial status
+func (m *StorageMigrator) Start(dryRun, switchBackend bool) (*paperlessV1.StorageMigrationStatus, error) {
+	primary, target := m.router.Names()
+	if target == "" {
+		return nil, paperlessV1.ErrorBadRequest("no migration target configured (PAPERLESS_MIGRATION_STORAGE_DRIVER)")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.status.State == paperlessV1.StorageMigrationState_STORAGE_MIGRATION_STATE_RUNNING {
+		return nil, paperlessV1.ErrorConflict("a storage migration is already running")
+	}
+
+	ctx := appViewer.NewSystemViewerContext(context.Background())
+
+	source := m.router.ActiveName(ctx)
+	dest := target
+	if source == target {
+		dest = primary
+	}
+
+	m.status = &paperlessV1.StorageMigrationStatus{
+		State:     paperlessV1.StorageMigrationState_STORAGE_MIGRATION_STATE_RUNNING,
+		Source:    source,
+		Target:    dest,
+		DryRun:    dryRun,
+		StartedAt: timestamppb.Now(),
+	}
+
+	go m.run(ctx, source, dest, dryRun, switchBackend)
+
+	return proto.Clone(m.status).(*paperlessV1.StorageMigrationStatus), nil
+}
+
+// Status returns a snapshot of the current or last migration
+func (m *StorageMigrator) Status() *paperlessV1.StorageMigrationStatus {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return proto.Clone(m.status).(*paperlessV1.StorageMigrationStatus)
+}
+
+// run migrates every tenant's documents and switches the active backend when asked and safe
+func (m *StorageMigrator) run(ctx context.Context, source, target string, dryRun, switchBackend bool) {
+	m.log.Infof("storage migration %s -> %s started (dry run %t)", source, target, dryRun)
+
+	from, to := m.router.Backend(source), m.router.Backend(target)
+
+	tenantIDs, err := m.documentRepo.ListTenantIDs(ctx)
+	if err != nil {
+		m.finish(fmt.Errorf("list tenants: %w", err))
+		return
+	}
+
+	for _, tenantID := range tenantIDs {
+		afterID := ""
+		for {
+			docs, err := m.documentRepo.ListBatch(ctx, tenantID, afterID, migrationBatchSize)
+			if err != nil {
+				m.finish(fmt.Errorf("tenant %d: list documents: %w", tenantID, err))
+				return
+			}
+			if len(docs) == 0 {
+				break
+			}
+
+			for _, doc := range docs {
+				// Cold files live in the cold tier, which is not part of the migration
+				if isCold(doc) {
+					m.update(func(s *paperlessV1.StorageMigrationStatus) {
+						s.ProcessedDocuments++
+						s.SkippedObjects++
+					})
+					continue
+				}
+
+				key, outcome, err := data.MigrateObject(ctx, from, to, doc, dryRun)
+				if err == nil && !dryRun && key != doc.FileKey {
+					if err = m.documentRepo.UpdateFileKey(ctx, doc.ID, key); err == nil {
+						m.update(func(s *paperlessV1.StorageMigrationStatus) { s.RewrittenKeys++ })
+					}
+				}
+
+				m.update(func(s *paperlessV1.StorageMigrationStatus) {
+					s.ProcessedDocuments++
+					switch {
+					case err != nil:
+						s.FailedDocuments++
+						if len(s.Errors) < maxMigrationErrors {
+							s.Errors = append(s.Errors, fmt.Sprintf("document %s: %v", doc.ID, err))
+						}
+					case outcome == data.MigrationSkipped:
+						s.SkippedObjects++
+					default:
+						s.CopiedObjects++
+					}
+				})
+			}
+
+			afterID = docs[len(docs)-1].ID
+		}
+	}
+
+	status := m.Status()
+	if switchBackend && !dryRun {
+		if status.FailedDocuments > 0 {
+			m.finish(fmt.Errorf("%d documents failed, active backend not switched", status.FailedDocuments))
+			return
+		}
+		if err := m.router.SetActive(ctx, target); err != nil {
+			m.finish(fmt.Errorf("switch active backend: %w", err))
+			return
+		}
+		m.update(func(s *paperlessV1.StorageMigrationStatus) { s.Switched = true })
+	}
+
+	m.finish(nil)
+}
+
+// update applies fn to the status under the lock
+func (m *StorageMigrator) update(fn func(s *paperlessV1.StorageMigrationStatus)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	fn(m.status)
+}
+
+// finish records the end of a migration
+func (m *StorageMigrator) finish(err error) {
+	m.update(func(s *paperlessV1.StorageMigrationStatus) {
+		s.FinishedAt = timestamppb.New(time.Now())
+		s.State = paperlessV1.StorageMigrationState_STORAGE_MIGRATION_STATE_COMPLETED
+		if err != nil {
+			s.State = paperlessV1.StorageMigrationState_STORAGE_MIGRATION_STATE_FAILED
+			s.Errors = append(s.Errors, err.Error())
+		}
+	})
+
+	status := m.Status()
+	m.log.Infof("storage migration %s -> %s finished: %s, %d copied, %d skipped, %d keys rewritten, %d failed",
+		status.Source, status.Target, status.State, status.CopiedObjects, status.SkippedObjects, status.RewrittenKeys, status.FailedDocuments)
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// StorageService implements storage maintenance operations
+type StorageService struct {
+	paperlessV1.UnimplementedPaperlessStorageServiceServer
+
+	log      *log.Helper
+	gc       *StorageGC
+	migrator *StorageMigrator
+}
+
+// NewStorageService creates a new StorageService
+func NewStorageService(ctx *bootstrap.Context, gc *StorageGC, migrator *StorageMigrator) *StorageService {
+	return &StorageService{
+		log:      ctx.NewLoggerHelper("paperless/service/storage"),
+		gc:       gc,
+		migrator: migrator,
+	}
+}
+
+// CollectOrphanedObjects finds stored objects without a document row and deletes or reports them
+func (s *StorageService) CollectOrphanedObjects(ctx context.Context, req *paperlessV1.CollectOrphanedObjectsRequest) (*paperlessV1.CollectOrphanedObjectsResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	userID := getUserIDFromContext(ctx)
+
+	// Storage maintenance is a platform operation, so it needs the caller's platform admin role
+	// rather than the admin bypass policy
+	if !isPlatformAdmin(ctx) {
+		return nil, errPlatformAdminRequired("storage maintenance requires platform admin access", "collect_orphaned_objects")
+	}
+
+	var scope *uint32
+	switch {
+	case req.TenantId == nil:
+		scope = &tenantID
+	case *req.TenantId != 0:
+		scope = req.TenantId
+	}
+
+	minAge := defaultGCMinAge
+	if req.MinAgeSeconds != nil {
+		minAge = time.Duration(*req.MinAgeSeconds) * time.Second
+	}
+
+	report := s.gc.Collect(ctx, scope, minAge, req.DryRun)
+	s.log.Infof("orphan collection by user %s: %d orphans, %d deleted", userID, len(report.Orphans), report.DeletedObjects)
+
+	return report, nil
+}
+
+// StartStorageMigration starts copying all objects to the other configured backend
+func (s *StorageService) StartStorageMigration(ctx context.Context, req *paperlessV1.StartStorageMigrationRequest) (*paperlessV1.StorageMigrationStatus, error) {
+	userID := getUserIDFromContext(ctx)
+
+	if !isPlatformAdmin(ctx) {
+		return nil, errPlatformAdminRequired("storage maintenance requires platform admin access", "start_storage_migration")
+	}
+
+	status, err := s.migrator.Start(req.DryRun, req.SwitchBackend)
+	if err != nil {
+		return nil, err
+	}
+	s.log.Infof("storage migration %s -> %s started by user %s", status.Source, status.Target, userID)
+
+	return status, nil
+}
+
+// GetStorageMigrationStatus returns the progress of the current or last storage migration
+func (s *StorageService) GetStorageMigrationStatus(ctx context.Context, _ *paperlessV1.GetStorageMigrationStatusRequest) (*paperlessV1.StorageMigrationStatus, error) {
+	if !isPlatformAdmin(ctx) {
+		return nil, errPlatformAdminRequired("storage maintenance requires platform admin access", "get_storage_migration_status")
+	}
+
+	return s.migrator.Status(), nil
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	appViewer "github.com/go-tangra/go-tangra-common/viewer"
+	"github.com/go-tangra/go-tangra-paperless/internal/data"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+const (
+	// defaultTieringInterval is how often documents due for cold storage are looked for
+	defaultTieringInterval = time.Hour
+	// tieringBatchSize is the number of candidates loaded per query
+	tieringBatchSize = 100
+	// tieringMoveTimeout bounds a single background tier transition
+	tieringMoveTimeout = 30 * time.Minute
+)
+
+// StorageTiering moves the files of archived documents, and of documents nobody has
+// accessed for PAPERLESS_STORAGE_COLD_AFTER_DAYS, to the cold storage tier and brings
+// them back when they are needed again. It is idle unless PAPERLESS_COLD_STORAGE_DRIVER is set.
+type StorageTiering struct {
+	backgroundJob
+
+	log          *log.Helper
+	router       *data.StorageRouter
+	documentRepo *data.DocumentRepo
+
+	coldAfter time.Duration
+
+	// moving holds the IDs of documents with a transition in flight
+	moving sync.Map
+}
+
+// NewStorageTiering creates a StorageTiering configured by PAPERLESS_STORAGE_TIERING_INTERVAL
+// and PAPERLESS_STORAGE_COLD_AFTER_DAYS (0 or unset keeps idle documents hot)
+func NewStorageTiering(ctx *bootstrap.Context, router *data.StorageRouter, documentRepo *data.DocumentRepo) *StorageTiering {
+	l := ctx.NewLoggerHelper("paperless/service/storage_tiering")
+
+	t := &StorageTiering{
+		backgroundJob: backgroundJob{
+			interval: defaultTieringInterval,
+			log:      l,
+		},
+		log:          l,
+		router:       router,
+		documentRepo: documentRepo,
+	}
+
+	if v := os.Getenv("PAPERLESS_STORAGE_TIERING_INTERVAL"); v != "" {
+		interval, err := time.ParseDuration(v)
+		if err != nil || interval <= 0 {
+			l.Warnf("invalid PAPERLESS_STORAGE_TIERING_INTERVAL %q, using %s", v, defaultTieringInterval)
+		} else {
+			t.interval = interval
+		}
+	}
+	if v := os.Getenv("PAPERLESS_STORAGE_COLD_AFTER_DAYS"); v != "" {
+		days, err := strconv.Atoi(v)
+		if err != nil || days < 0 {
+			l.Warnf("invalid PAPERLESS_STORAGE_COLD_AFTER_DAYS %q, idle documents stay hot", v)
+		} else {
+			t.coldAfter = time.Duration(days) * 24 * time.Hour
+		}
+	}
+
+	if !router.HasColdTier() {
+		t.interval = 0
+	}
+	t.name = fmt.Sprintf("storage tiering (cold after %s of inactivity)", t.coldAfter)
+	t.tick = t.Sweep
+
+	return t
+}
+
+// Sweep moves every document that is due for cold storage
+func (t *StorageTiering) Sweep(ctx context.Context) {
+	var idleBefore *time.Time
+	if t.coldAfter > 0 {
+		cutoff := time.Now().Add(-t.coldAfter)
+		idleBefore = &cutoff
+	}
+
+	moved, failed := 0, 0
+	afterID := ""
+	for ctx.Err() == nil {
+		docs, err := t.documentRepo.ListTieringCandidates(ctx, idleBefore, afterID, tieringBatchSize)
+		if err != nil {
+			t.log.Errorf("storage tiering: %v", err)
+			return
+		}
+		if len(docs) == 0 {
+			break
+		}
+
+		for _, doc := range docs {
+			if err := t.move(ctx, doc, true); err != nil {
+				t.log.Errorf("failed to move document %s to cold storage: %v", doc.ID, err)
+				failed++
+				continue
+			}
+			moved++
+		}
+		afterID = docs[len(docs)-1].ID
+	}
+
+	if moved > 0 || failed > 0 {
+		t.log.Infof("storage tiering moved %d documents to cold storage, %d failed", moved, failed)
+	}
+}
+
+// ArchiveAsync moves a document to cold storage in the background, e.g. after it was archived
+func (t *StorageTiering) ArchiveAsync(doc *ent.Document) {
+	t.moveAsync(doc, true)
+}
+
+// RestoreAsync brings a cold document back to hot storage in the background, e.g. after it was read
+func (t *StorageTiering) RestoreAsync(doc *ent.Document) {
+	t.moveAsync(doc, false)
+}
+
+// moveAsync runs a transition detached from the request that triggered it
+func (t *StorageTiering) moveAsync(doc *ent.Document, cold bool) {
+	if !t.router.HasColdTier() || isCold(doc) == cold {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(appViewer.NewSystemViewerContext(context.Background()), tieringMoveTimeout)
+		defer cancel()
+
+		if err := t.move(ctx, doc, cold); err != nil {
+			t.log.Errorf("failed to move document %s between storage tiers: %v", doc.ID, err)
+		}
+	}()
+}
+
+// move transitions a single document, skipping documents that are already being moved
+func (t *StorageTiering) move(ctx context.Context, doc *ent.Document, cold bool) error {
+	if _, busy := t.moving.LoadOrStore(doc.ID, struct{}{}); busy {
+		return nil
+	}
+	defer t.moving.Delete(doc.ID)
+
+	tier := paperlessV1.StorageTier_STORAGE_TIER_HOT
+	if cold {
+		tier = paperlessV1.StorageTier_STORAGE_TIER_COLD
+	}
+
+	err := t.router.MoveTier(ctx, doc, cold, func(key string) error {
+		return t.documentRepo.SetStorageTier(ctx, doc.ID, key, tier.String())
+	})
+	if err != nil {
+		return err
+	}
+
+	t.log.Infof("document %s moved to %s", doc.ID, tier)
+	return nil
+}
+
+// isCold reports whether a document's file lives in the cold tier
+func isCold(doc *ent.Document) bool {
+	return string(doc.StorageTier) == paperlessV1.StorageTier_STORAGE_TIER_COLD.String()
+}
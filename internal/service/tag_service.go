@@ -0,0 +1,274 @@
+package service
+
+import (
+	"context"
+	"slices"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+const (
+	defaultTagPageSize = 50
+	maxTagPageSize     = 500
+)
+
+// TagService manages a tenant's tags. Any tenant user can create and list tags; renaming,
+// deleting and merging rewrite documents across the tenant and are restricted to admins.
+type TagService struct {
+	paperlessV1.UnimplementedPaperlessTagServiceServer
+
+	log  *log.Helper
+	repo *data.TagRepo
+	tx   *data.Transaction
+}
+
+func NewTagService(
+	ctx *bootstrap.Context,
+	repo *data.TagRepo,
+	tx *data.Transaction,
+) *TagService {
+	return &TagService{
+		log:  ctx.NewLoggerHelper("paperless/service/tag"),
+		repo: repo,
+		tx:   tx,
+	}
+}
+
+// CreateTag creates a tag
+func (s *TagService) CreateTag(ctx context.Context, req *paperlessV1.CreateTagRequest) (*paperlessV1.CreateTagResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+
+	if req.GetName() == "" {
+		return nil, paperlessV1.ErrorBadRequest("name is required")
+	}
+
+	entity, err := s.repo.Create(ctx, tenantID, req.GetName(), req.GetColor(), req.GetDescription(), getUserIDAsUint32(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &paperlessV1.CreateTagResponse{
+		Tag: s.repo.ToProto(entity, 0),
+	}, nil
+}
+
+// GetTag gets a tag
+func (s *TagService) GetTag(ctx context.Context, req *paperlessV1.GetTagRequest) (*paperlessV1.GetTagResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+
+	entity, err := s.getTag(ctx, tenantID, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := s.toProto(ctx, entity)
+	if err != nil {
+		return nil, err
+	}
+	return &paperlessV1.GetTagResponse{Tag: tag}, nil
+}
+
+// ListTags lists the tenant's tags by name with their document counts
+func (s *TagService) ListTags(ctx context.Context, req *paperlessV1.ListTagsRequest) (*paperlessV1.ListTagsResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+
+	page := uint32(1)
+	if req.Page != nil && req.GetPage() > 0 {
+		page = req.GetPage()
+	}
+	pageSize := uint32(defaultTagPageSize)
+	if req.PageSize != nil && req.GetPageSize() > 0 {
+		pageSize = min(req.GetPageSize(), maxTagPageSize)
+	}
+
+	entities, total, err := s.repo.List(ctx, tenantID, req.GetQuery(), page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint32, 0, len(entities))
+	for _, e := range entities {
+		ids = append(ids, e.ID)
+	}
+	counts, err := s.repo.DocumentCounts(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]*paperlessV1.Tag, 0, len(entities))
+	for _, e := range entities {
+		tags = append(tags, s.repo.ToProto(e, counts[e.ID]))
+	}
+
+	return &paperlessV1.ListTagsResponse{
+		Tags:  tags,
+		Total: uint32(total),
+	}, nil
+}
+
+// TagsByName returns the caller's tenant's tags with the given names, e.g. those of a
+// document's tags map, with their document counts
+func (s *TagService) TagsByName(ctx context.Context, names []string) ([]*paperlessV1.Tag, error) {
+	entities, err := s.repo.ListByNames(ctx, getTenantIDFromContext(ctx), names)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint32, 0, len(entities))
+	for _, e := range entities {
+		ids = append(ids, e.ID)
+	}
+	counts, err := s.repo.DocumentCounts(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]*paperlessV1.Tag, 0, len(entities))
+	for _, e := range entities {
+		tags = append(tags, s.repo.ToProto(e, counts[e.ID]))
+	}
+	return tags, nil
+}
+
+// UpdateTag updates a tag; a rename is applied to every document carrying the tag
+func (s *TagService) UpdateTag(ctx context.Context, req *paperlessV1.UpdateTagRequest) (*paperlessV1.UpdateTagResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil && req.GetName() == "" {
+		return nil, paperlessV1.ErrorBadRequest("name must not be empty")
+	}
+
+	var (
+		updated *ent.Tag
+		count   int
+	)
+	err := s.tx.InTx(ctx, func(ctx context.Context) error {
+		entity, err := s.getTag(ctx, tenantID, req.GetId())
+		if err != nil {
+			return err
+		}
+		updated, count, err = s.repo.Update(ctx, entity, req.Name, req.Color, req.Description)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := s.toProto(ctx, updated)
+	if err != nil {
+		return nil, err
+	}
+	return &paperlessV1.UpdateTagResponse{
+		Tag:              tag,
+		UpdatedDocuments: uint32(count),
+	}, nil
+}
+
+// DeleteTag removes a tag from every document and deletes it
+func (s *TagService) DeleteTag(ctx context.Context, req *paperlessV1.DeleteTagRequest) (*emptypb.Empty, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	err := s.tx.InTx(ctx, func(ctx context.Context) error {
+		entity, err := s.getTag(ctx, tenantID, req.GetId())
+		if err != nil {
+			return err
+		}
+		return s.repo.Delete(ctx, entity)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// MergeTags merges the source tags into the target tag and deletes the sources
+func (s *TagService) MergeTags(ctx context.Context, req *paperlessV1.MergeTagsRequest) (*paperlessV1.MergeTagsResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if len(req.GetSourceIds()) == 0 {
+		return nil, paperlessV1.ErrorBadRequest("source_ids is required")
+	}
+	if slices.Contains(req.GetSourceIds(), req.GetTargetId()) {
+		return nil, paperlessV1.ErrorBadRequest("a tag cannot be merged into itself")
+	}
+
+	var (
+		target *ent.Tag
+		count  int
+	)
+	err := s.tx.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		if target, err = s.getTag(ctx, tenantID, req.GetTargetId()); err != nil {
+			return err
+		}
+
+		sources := make([]*ent.Tag, 0, len(req.GetSourceIds()))
+		for _, id := range req.GetSourceIds() {
+			source, err := s.getTag(ctx, tenantID, id)
+			if err != nil {
+				return err
+			}
+			sources = append(sources, source)
+		}
+
+		count, err = s.repo.Merge(ctx, target, sources)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := s.toProto(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+	return &paperlessV1.MergeTagsResponse{
+		Tag:              tag,
+		UpdatedDocuments: uint32(count),
+	}, nil
+}
+
+// requireAdmin restricts changes that rewrite documents across the tenant to tenant admins
+func (s *TagService) requireAdmin(ctx context.Context) error {
+	if !isTenantAdmin(ctx) {
+		return errTenantAdminRequired("only tenant admins can rename, delete or merge tags", "manage_tags")
+	}
+	return nil
+}
+
+// getTag returns a tag of the tenant, or a not found error
+func (s *TagService) getTag(ctx context.Context, tenantID uint32, id uint32) (*ent.Tag, error) {
+	entity, err := s.repo.Get(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, paperlessV1.ErrorTagNotFound("tag not found")
+	}
+	return entity, nil
+}
+
+// toProto converts a tag together with its document count
+func (s *TagService) toProto(ctx context.Context, entity *ent.Tag) (*paperlessV1.Tag, error) {
+	counts, err := s.repo.DocumentCounts(ctx, []uint32{entity.ID})
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ToProto(entity, counts[entity.ID]), nil
+}
@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data"
+)
+
+const (
+	// tenantDeleteBatch is how many records are deleted per transaction, and how many storage
+	// objects between progress reports
+	tenantDeleteBatch = 500
+
+	tenantDeletePollInterval = 5 * time.Second
+	// tenantDeleteLease outlasts a batch; a job whose worker died is resumed after it
+	tenantDeleteLease = 2 * time.Minute
+
+	// tenantDeleteStageObjects is the stage of a job while it deletes storage objects
+	tenantDeleteStageObjects = "storage_objects"
+)
+
+// TenantDeleteWorker carries out queued tenant data deletions. It deletes the tenant's records
+// one batch per transaction, then every stored object under the tenant's key prefix, which
+// also catches objects no document references. Jobs are claimed in the database with a lease
+// that each batch extends, so several replicas can run the worker and a job whose worker died
+// is resumed by another one. Deleted records and objects drop out of later batches, so a
+// resumed job picks up where it stopped.
+type TenantDeleteWorker struct {
+	backgroundJob
+
+	log        *log.Helper
+	jobs       *data.TenantDeleteJobRepo
+	tenantData *data.TenantDataRepo
+	storage    data.Storage
+	tx         *data.Transaction
+}
+
+// NewTenantDeleteWorker creates a TenantDeleteWorker
+func NewTenantDeleteWorker(
+	ctx *bootstrap.Context,
+	jobs *data.TenantDeleteJobRepo,
+	tenantData *data.TenantDataRepo,
+	storage data.Storage,
+	tx *data.Transaction,
+) *TenantDeleteWorker {
+	l := ctx.NewLoggerHelper("paperless/service/tenant_delete_worker")
+
+	w := &TenantDeleteWorker{
+		backgroundJob: backgroundJob{interval: tenantDeletePollInterval, log: l},
+		log:           l,
+		jobs:          jobs,
+		tenantData:    tenantData,
+		storage:       storage,
+		tx:            tx,
+	}
+	w.tick = w.drain
+	return w
+}
+
+// drain runs the queued jobs, going on while there are any so a queue drains quickly
+func (w *TenantDeleteWorker) drain(ctx context.Context) {
+	for ctx.Err() == nil && w.runNext(ctx) {
+	}
+}
+
+// runNext claims and runs one job, reporting whether there was one
+func (w *TenantDeleteWorker) runNext(ctx context.Context) bool {
+	job, err := w.jobs.ClaimNext(ctx, time.Now(), tenantDeleteLease)
+	if err != nil || job == nil {
+		return false
+	}
+
+	progress := w.jobs.Progress(job)
+	report := func(ctx context.Context) error {
+		return w.jobs.UpdateProgress(ctx, job.ID, progress, time.Now().Add(tenantDeleteLease))
+	}
+
+	err = w.deleteRecords(ctx, job.TenantID, progress, report)
+	if err == nil {
+		err = w.deleteObjects(ctx, job.TenantID, progress, report)
+	}
+	if ctx.Err() != nil {
+		// Shutting down; the job is resumed once its lease runs out
+		return false
+	}
+
+	errMsg := ""
+	if err != nil {
+		errMsg = err.Error()
+		w.log.Errorf("tenant delete job %s for tenant %d failed in stage %s: %s", job.ID, job.TenantID, progress.Stage, errMsg)
+	} else {
+		progress.Stage = ""
+		w.log.Infof("tenant delete job %s deleted %d documents, %d categories, %d permissions, %d other records and %d storage objects (%d bytes) of tenant %d",
+			job.ID, progress.DocumentsDeleted, progress.CategoriesDeleted, progress.PermissionsDeleted, progress.RecordsDeleted,
+			progress.ObjectsDeleted, progress.BytesDeleted, job.TenantID)
+	}
+	if err := w.jobs.Finish(ctx, job.ID, progress, errMsg); err != nil {
+		return false
+	}
+	return true
+}
+
+// deleteRecords deletes the tenant's records one batch per transaction until none are left
+func (w *TenantDeleteWorker) deleteRecords(ctx context.Context, tenantID uint32, progress *data.TenantDeleteProgress, report func(context.Context) error) error {
+	for {
+		var (
+			stage string
+			n     int
+		)
+		err := w.tx.InTx(ctx, func(ctx context.Context) error {
+			var err error
+			stage, n, err = w.tenantData.PurgeNext(ctx, tenantID, tenantDeleteBatch)
+			return err
+		})
+		if err != nil {
+			progress.Stage = stage
+			return err
+		}
+		if n == 0 {
+			return nil
+		}
+
+		progress.Stage = stage
+		progress.Processed += int64(n)
+		switch stage {
+		case data.TenantDataDocuments:
+			progress.DocumentsDeleted += int64(n)
+		case data.TenantDataCategories:
+			progress.CategoriesDeleted += int64(n)
+		case data.TenantDataPermissions:
+			progress.PermissionsDeleted += int64(n)
+		default:
+			progress.RecordsDeleted += int64(n)
+		}
+
+		if err := report(ctx); err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+}
+
+// deleteObjects deletes every stored object under the tenant's key prefix. Objects that
+// can't be deleted fail the job once the others are gone, so a new deletion can retry them.
+func (w *TenantDeleteWorker) deleteObjects(ctx context.Context, tenantID uint32, progress *data.TenantDeleteProgress, report func(context.Context) error) error {
+	progress.Stage = tenantDeleteStageObjects
+	if err := report(ctx); err != nil {
+		return err
+	}
+
+	objects, err := w.storage.List(ctx, fmt.Sprintf("%d/", tenantID))
+	if err != nil {
+		return fmt.Errorf("list storage objects: %w", err)
+	}
+
+	var (
+		failed  int
+		lastErr error
+	)
+	for i, obj := range objects {
+		if err := w.storage.Delete(ctx, obj.Key); err != nil {
+			w.log.Warnf("failed to delete object %s of tenant %d: %v", obj.Key, tenantID, err)
+			failed++
+			lastErr = err
+		} else {
+			progress.ObjectsDeleted++
+			progress.BytesDeleted += obj.Size
+		}
+
+		if (i+1)%tenantDeleteBatch == 0 {
+			if err := report(ctx); err != nil {
+				return err
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d storage objects could not be deleted: %w", failed, len(objects), lastErr)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+// TenantService implements tenant lifecycle operations for platform administrators
+type TenantService struct {
+	paperlessV1.UnimplementedPaperlessTenantServiceServer
+
+	log        *log.Helper
+	jobs       *data.TenantDeleteJobRepo
+	tenantData *data.TenantDataRepo
+}
+
+// NewTenantService creates a new TenantService
+func NewTenantService(ctx *bootstrap.Context, jobs *data.TenantDeleteJobRepo, tenantData *data.TenantDataRepo) *TenantService {
+	return &TenantService{
+		log:        ctx.NewLoggerHelper("paperless/service/tenant"),
+		jobs:       jobs,
+		tenantData: tenantData,
+	}
+}
+
+// DeleteTenantData queues a background deletion of all of a tenant's data
+func (s *TenantService) DeleteTenantData(ctx context.Context, req *paperlessV1.DeleteTenantDataRequest) (*paperlessV1.DeleteTenantDataResponse, error) {
+	userID := getUserIDFromContext(ctx)
+
+	// Offboarding is a platform operation, so it needs the caller's platform admin role rather
+	// than the admin bypass policy, which governs access to documents and categories
+	if !isPlatformAdmin(ctx) {
+		return nil, errPlatformAdminRequired("tenant offboarding requires platform admin access", "delete_tenant_data")
+	}
+	if req.TenantId == 0 {
+		return nil, paperlessV1.ErrorBadRequest("tenant_id is required")
+	}
+
+	active, err := s.jobs.GetActive(ctx, req.TenantId)
+	if err != nil {
+		return nil, err
+	}
+	if active != nil {
+		return nil, paperlessV1.ErrorConflict("data of tenant %d is already being deleted by job %s", req.TenantId, active.ID)
+	}
+
+	total, err := s.tenantData.Count(ctx, req.TenantId)
+	if err != nil {
+		return nil, err
+	}
+	job, err := s.jobs.Create(ctx, req.TenantId, total, getUserIDAsUint32(ctx))
+	if err != nil {
+		return nil, err
+	}
+	s.log.Infof("deletion of tenant %d data (%d records) queued as job %s by user %s", req.TenantId, total, job.ID, userID)
+
+	return &paperlessV1.DeleteTenantDataResponse{
+		Job: s.jobs.ToProto(job),
+	}, nil
+}
+
+// GetTenantDeleteJob returns the progress or final report of a tenant data deletion
+func (s *TenantService) GetTenantDeleteJob(ctx context.Context, req *paperlessV1.GetTenantDeleteJobRequest) (*paperlessV1.GetTenantDeleteJobResponse, error) {
+	if !isPlatformAdmin(ctx) {
+		return nil, errPlatformAdminRequired("tenant offboarding requires platform admin access", "get_tenant_delete_job")
+	}
+
+	job, err := s.jobs.Get(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if job == nil {
+		return nil, paperlessV1.ErrorNotFound("tenant delete job not found")
+	}
+
+	return &paperlessV1.GetTenantDeleteJobResponse{
+		Job: s.jobs.ToProto(job),
+	}, nil
+}
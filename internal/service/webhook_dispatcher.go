@@ -0,0 +1,252 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+	"github.com/go-tangra/go-tangra-paperless/internal/metrics"
+)
+
+const (
+	defaultWebhookMaxAttempts = 6
+	defaultWebhookTimeout     = 10 * time.Second
+	defaultWebhookRetention   = 30 * 24 * time.Hour
+
+	// webhookFirstRetryDelay grows fourfold per failed attempt: 30s, 2m, 8m, 32m, ~2h
+	webhookFirstRetryDelay = 30 * time.Second
+
+	webhookPollInterval  = 5 * time.Second
+	webhookPurgeInterval = time.Hour
+	webhookClaimBatch    = 50
+	webhookConcurrency   = 8
+
+	// webhookSignatureHeader carries t=<unix time>,v1=<hex HMAC-SHA256 of "<t>.<body>">
+	webhookSignatureHeader = "X-Paperless-Signature"
+)
+
+// WebhookDispatcher delivers lifecycle events to webhook subscriptions. The outbox relay hands
+// it every published event, which is stored as one delivery per matching subscription and
+// sent by a background loop, which
+// retries failed attempts with a growing delay until PAPERLESS_WEBHOOK_MAX_ATTEMPTS is reached.
+// Deliveries are claimed in the database, so several replicas can run the loop.
+type WebhookDispatcher struct {
+	backgroundJob
+
+	log    *log.Helper
+	repo   *data.WebhookRepo
+	client *http.Client
+
+	maxAttempts int
+	retention   time.Duration
+
+	// lastPurge is when expired deliveries were last deleted
+	lastPurge time.Time
+}
+
+// NewWebhookDispatcher creates a WebhookDispatcher subscribed to published events, configured by
+// PAPERLESS_WEBHOOK_MAX_ATTEMPTS, PAPERLESS_WEBHOOK_TIMEOUT and PAPERLESS_WEBHOOK_DELIVERY_RETENTION
+func NewWebhookDispatcher(ctx *bootstrap.Context, repo *data.WebhookRepo, events *data.EventPublisher) *WebhookDispatcher {
+	l := ctx.NewLoggerHelper("paperless/service/webhook-dispatcher")
+
+	d := &WebhookDispatcher{
+		backgroundJob: backgroundJob{
+			interval: webhookPollInterval,
+			wake:     make(chan struct{}, 1),
+			log:      l,
+		},
+		log:         l,
+		repo:        repo,
+		maxAttempts: defaultWebhookMaxAttempts,
+		retention:   defaultWebhookRetention,
+	}
+	d.tick = d.deliverPending
+
+	timeout := defaultWebhookTimeout
+	if v := os.Getenv("PAPERLESS_WEBHOOK_TIMEOUT"); v != "" {
+		t, err := time.ParseDuration(v)
+		if err != nil || t <= 0 {
+			l.Warnf("invalid PAPERLESS_WEBHOOK_TIMEOUT %q, using %s", v, defaultWebhookTimeout)
+		} else {
+			timeout = t
+		}
+	}
+	if v := os.Getenv("PAPERLESS_WEBHOOK_MAX_ATTEMPTS"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			l.Warnf("invalid PAPERLESS_WEBHOOK_MAX_ATTEMPTS %q, using %d", v, defaultWebhookMaxAttempts)
+		} else {
+			d.maxAttempts = n
+		}
+	}
+	if v := os.Getenv("PAPERLESS_WEBHOOK_DELIVERY_RETENTION"); v != "" {
+		r, err := parseRetention(v)
+		if err != nil || r < 0 {
+			l.Warnf("invalid PAPERLESS_WEBHOOK_DELIVERY_RETENTION %q, using %s", v, defaultWebhookRetention)
+		} else {
+			d.retention = r
+		}
+	}
+
+	d.client = &http.Client{
+		Timeout: timeout,
+		// A redirect could send the signed payload elsewhere, so it counts as a failed attempt
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	events.Subscribe(d.createDeliveries)
+
+	return d
+}
+
+// createDeliveries stores a delivery of a published event for every matching subscription
+func (d *WebhookDispatcher) createDeliveries(ctx context.Context, event *data.CloudEvent) error {
+	subscriptions, err := d.repo.ListSubscriptionsForEvent(ctx, event.TenantID, event.Type)
+	if err != nil || len(subscriptions) == 0 {
+		return err
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	if err := d.repo.CreateDeliveries(ctx, subscriptions, event.ID, event.Type, payload); err != nil {
+		return err
+	}
+
+	d.trigger()
+	return nil
+}
+
+// deliverPending sends due deliveries, run when new ones are stored and periodically for retries
+func (d *WebhookDispatcher) deliverPending(ctx context.Context) {
+	// Keep going while full batches come back, so a backlog drains quickly
+	for d.deliverDue(ctx) == webhookClaimBatch {
+	}
+
+	if d.retention > 0 && time.Since(d.lastPurge) >= webhookPurgeInterval {
+		d.lastPurge = time.Now()
+		if n, err := d.repo.DeleteDeliveriesOlderThan(ctx, time.Now().Add(-d.retention)); err == nil && n > 0 {
+			d.log.Infof("purged %d webhook deliveries older than %s", n, d.retention)
+		}
+	}
+}
+
+// deliverDue claims and sends a batch of due deliveries, returning how many were claimed
+func (d *WebhookDispatcher) deliverDue(ctx context.Context) int {
+	// The lease outlasts an attempt, so a delivery is never sent twice at the same time
+	lease := d.client.Timeout + time.Minute
+	deliveries, err := d.repo.ClaimDueDeliveries(ctx, time.Now(), lease, webhookClaimBatch)
+	if err != nil {
+		return 0
+	}
+
+	sem := make(chan struct{}, webhookConcurrency)
+	var wg sync.WaitGroup
+	for _, delivery := range deliveries {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			d.deliver(ctx, delivery)
+		}()
+	}
+	wg.Wait()
+
+	return len(deliveries)
+}
+
+// deliver makes one attempt and records its outcome
+func (d *WebhookDispatcher) deliver(ctx context.Context, delivery *ent.WebhookDelivery) {
+	sub := delivery.Edges.Subscription
+	attempt := &data.WebhookAttempt{}
+
+	start := time.Now()
+	switch {
+	case sub == nil:
+		attempt.Error = "subscription no longer exists"
+	case !sub.Enabled:
+		attempt.Error = "subscription is disabled"
+	default:
+		attempt.ResponseStatus, attempt.Error = d.send(ctx, sub, delivery)
+	}
+	attempt.Duration = time.Since(start)
+
+	result := "success"
+	if attempt.Error != "" {
+		result = "failed"
+		attempts := int(delivery.Attempts) + 1
+		if sub != nil && sub.Enabled && attempts < d.maxAttempts {
+			next := time.Now().Add(webhookFirstRetryDelay << (2 * (attempts - 1)))
+			attempt.NextAttemptAt = &next
+			result = "retrying"
+		}
+		d.log.Warnf("webhook delivery %d of %s event to subscription %s failed (%s): %s",
+			delivery.ID, delivery.EventType, delivery.SubscriptionID, result, attempt.Error)
+	}
+	metrics.WebhookDeliveries.WithLabelValues(result).Inc()
+
+	_ = d.repo.RecordAttempt(ctx, delivery.ID, attempt)
+}
+
+// send posts a delivery's payload, returning the response status and an error message for
+// anything but a 2xx response
+func (d *WebhookDispatcher) send(ctx context.Context, sub *ent.WebhookSubscription, delivery *ent.WebhookDelivery) (int32, string) {
+	body := []byte(delivery.Payload)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err.Error()
+	}
+	// CloudEvents HTTP binding in structured mode
+	req.Header.Set("Content-Type", data.CloudEventsContentType)
+	req.Header.Set("User-Agent", "paperless-webhook/1.0")
+	req.Header.Set("X-Paperless-Event", delivery.EventType)
+	req.Header.Set("X-Paperless-Event-Id", delivery.EventID)
+	req.Header.Set("X-Paperless-Delivery", strconv.FormatUint(uint64(delivery.ID), 10))
+	req.Header.Set(webhookSignatureHeader, signWebhook(sub.Secret, time.Now(), body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err.Error()
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		snippet, _ := io.ReadAll(io.LimitReader(resp.Body, 256))
+		return int32(resp.StatusCode), fmt.Sprintf("endpoint returned status %d: %s", resp.StatusCode, string(snippet))
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	return int32(resp.StatusCode), ""
+}
+
+// signWebhook returns the signature header value for a body sent at t. Receivers recompute
+// the HMAC over "<t>.<body>" and should reject old timestamps to prevent replays.
+func signWebhook(secret string, t time.Time, body []byte) string {
+	ts := strconv.FormatInt(t.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "t=" + ts + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,293 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/url"
+	"slices"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/go-tangra/go-tangra-paperless/internal/data"
+	"github.com/go-tangra/go-tangra-paperless/internal/data/ent"
+
+	paperlessV1 "github.com/go-tangra/go-tangra-paperless/gen/go/paperless/service/v1"
+)
+
+const (
+	defaultWebhookPageSize = 20
+	maxWebhookPageSize     = 100
+)
+
+// WebhookService manages a tenant's webhook subscriptions; deliveries are sent by WebhookDispatcher
+type WebhookService struct {
+	paperlessV1.UnimplementedPaperlessWebhookServiceServer
+
+	log  *log.Helper
+	repo *data.WebhookRepo
+}
+
+func NewWebhookService(
+	ctx *bootstrap.Context,
+	repo *data.WebhookRepo,
+) *WebhookService {
+	return &WebhookService{
+		log:  ctx.NewLoggerHelper("paperless/service/webhook"),
+		repo: repo,
+	}
+}
+
+// CreateWebhook subscribes an endpoint to the tenant's lifecycle events
+func (s *WebhookService) CreateWebhook(ctx context.Context, req *paperlessV1.CreateWebhookRequest) (*paperlessV1.CreateWebhookResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if req.GetName() == "" {
+		return nil, paperlessV1.ErrorBadRequest("name is required")
+	}
+	if err := validateWebhookURL(req.GetUrl()); err != nil {
+		return nil, err
+	}
+	if err := validateWebhookEventTypes(req.GetEventTypes()); err != nil {
+		return nil, err
+	}
+
+	secret := req.GetSecret()
+	if secret == "" {
+		var err error
+		if secret, err = generateWebhookSecret(); err != nil {
+			s.log.Errorf("generate webhook secret failed: %v", err)
+			return nil, paperlessV1.ErrorInternalServerError("generate webhook secret failed")
+		}
+	}
+
+	enabled := true
+	if req.Enabled != nil {
+		enabled = req.GetEnabled()
+	}
+
+	entity, err := s.repo.CreateSubscription(ctx, tenantID, req.GetName(), req.GetUrl(), secret, req.GetEventTypes(), enabled, getUserIDAsUint32(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &paperlessV1.CreateWebhookResponse{
+		Webhook: s.repo.SubscriptionToProto(entity),
+		Secret:  secret,
+	}, nil
+}
+
+// GetWebhook gets a webhook subscription
+func (s *WebhookService) GetWebhook(ctx context.Context, req *paperlessV1.GetWebhookRequest) (*paperlessV1.GetWebhookResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	entity, err := s.getSubscription(ctx, tenantID, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &paperlessV1.GetWebhookResponse{
+		Webhook: s.repo.SubscriptionToProto(entity),
+	}, nil
+}
+
+// ListWebhooks lists the tenant's webhook subscriptions
+func (s *WebhookService) ListWebhooks(ctx context.Context, req *paperlessV1.ListWebhooksRequest) (*paperlessV1.ListWebhooksResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	page, pageSize := webhookPage(req.Page, req.PageSize)
+	entities, total, err := s.repo.ListSubscriptions(ctx, tenantID, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks := make([]*paperlessV1.WebhookSubscription, 0, len(entities))
+	for _, e := range entities {
+		webhooks = append(webhooks, s.repo.SubscriptionToProto(e))
+	}
+
+	return &paperlessV1.ListWebhooksResponse{
+		Webhooks: webhooks,
+		Total:    uint32(total),
+	}, nil
+}
+
+// UpdateWebhook updates a webhook subscription, optionally rotating its signing secret
+func (s *WebhookService) UpdateWebhook(ctx context.Context, req *paperlessV1.UpdateWebhookRequest) (*paperlessV1.UpdateWebhookResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getSubscription(ctx, tenantID, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil && req.GetName() == "" {
+		return nil, paperlessV1.ErrorBadRequest("name must not be empty")
+	}
+	if req.Url != nil {
+		if err := validateWebhookURL(req.GetUrl()); err != nil {
+			return nil, err
+		}
+	}
+	if req.GetUpdateEventTypes() {
+		if err := validateWebhookEventTypes(req.GetEventTypes()); err != nil {
+			return nil, err
+		}
+	}
+
+	var secret *string
+	if req.GetRotateSecret() {
+		newSecret, err := generateWebhookSecret()
+		if err != nil {
+			s.log.Errorf("generate webhook secret failed: %v", err)
+			return nil, paperlessV1.ErrorInternalServerError("generate webhook secret failed")
+		}
+		secret = &newSecret
+	}
+
+	entity, err := s.repo.UpdateSubscription(ctx, req.GetId(), req.Name, req.Url, secret, req.GetEventTypes(), req.GetUpdateEventTypes(), req.Enabled)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &paperlessV1.UpdateWebhookResponse{
+		Webhook: s.repo.SubscriptionToProto(entity),
+	}
+	if secret != nil {
+		resp.Secret = *secret
+	}
+	return resp, nil
+}
+
+// DeleteWebhook deletes a webhook subscription and its delivery log
+func (s *WebhookService) DeleteWebhook(ctx context.Context, req *paperlessV1.DeleteWebhookRequest) (*emptypb.Empty, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getSubscription(ctx, tenantID, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.DeleteSubscription(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListWebhookDeliveries lists a subscription's delivery attempts, newest first
+func (s *WebhookService) ListWebhookDeliveries(ctx context.Context, req *paperlessV1.ListWebhookDeliveriesRequest) (*paperlessV1.ListWebhookDeliveriesResponse, error) {
+	tenantID := getTenantIDFromContext(ctx)
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getSubscription(ctx, tenantID, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	var status *string
+	if req.Status != nil && req.GetStatus() != paperlessV1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED {
+		st := req.GetStatus().String()
+		status = &st
+	}
+
+	page, pageSize := webhookPage(req.Page, req.PageSize)
+	entities, total, err := s.repo.ListDeliveries(ctx, req.GetId(), status, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]*paperlessV1.WebhookDelivery, 0, len(entities))
+	for _, e := range entities {
+		deliveries = append(deliveries, s.repo.DeliveryToProto(e))
+	}
+
+	return &paperlessV1.ListWebhookDeliveriesResponse{
+		Deliveries: deliveries,
+		Total:      uint32(total),
+	}, nil
+}
+
+// requireAdmin restricts webhook management to tenant admins
+func (s *WebhookService) requireAdmin(ctx context.Context) error {
+	if !isTenantAdmin(ctx) {
+		return errTenantAdminRequired("only tenant admins can manage webhooks", "manage_webhooks")
+	}
+	return nil
+}
+
+// getSubscription returns a subscription of the tenant, or a not found error
+func (s *WebhookService) getSubscription(ctx context.Context, tenantID uint32, id string) (*ent.WebhookSubscription, error) {
+	entity, err := s.repo.GetSubscription(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, paperlessV1.ErrorNotFound("webhook subscription not found")
+	}
+	return entity, nil
+}
+
+func webhookPage(reqPage, reqPageSize *uint32) (uint32, uint32) {
+	page := uint32(1)
+	if reqPage != nil && *reqPage > 0 {
+		page = *reqPage
+	}
+	pageSize := uint32(defaultWebhookPageSize)
+	if reqPageSize != nil && *reqPageSize > 0 {
+		pageSize = min(*reqPageSize, maxWebhookPageSize)
+	}
+	return page, pageSize
+}
+
+// validateWebhookURL accepts absolute http and https URLs
+func validateWebhookURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return paperlessV1.ErrorBadRequest("url must be an absolute http or https URL")
+	}
+	if len(raw) > 2048 {
+		return paperlessV1.ErrorBadRequest("url must be at most 2048 characters")
+	}
+	return nil
+}
+
+// validateWebhookEventTypes accepts known, distinct event types
+func validateWebhookEventTypes(eventTypes []string) error {
+	if len(eventTypes) > 32 {
+		return paperlessV1.ErrorBadRequest("at most 32 event types are allowed")
+	}
+	for i, t := range eventTypes {
+		if !slices.Contains(lifecycleEventTypes, t) {
+			return paperlessV1.ErrorBadRequest("unknown event type %q", t)
+		}
+		if slices.Contains(eventTypes[:i], t) {
+			return paperlessV1.ErrorBadRequest("duplicate event type %q", t)
+		}
+	}
+	return nil
+}
+
+// generateWebhookSecret returns a random 256-bit signing secret
+func generateWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}